@@ -17,6 +17,7 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"net/url"
 )
@@ -25,6 +26,16 @@ type ListApisParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ListApisParams) Clone() *ListApisParams {
+	c := &ListApisParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListApisParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -44,17 +55,43 @@ func (p *ListApisParams) SetName(v string) {
 	return
 }
 
+// SetNameIfUnset sets name to v, unless it is already set
+func (p *ListApisParams) SetNameIfUnset(v string) {
+	if _, found := p.p["name"]; !found {
+		p.SetName(v)
+	}
+}
+
 // You should always use this function to get a new ListApisParams instance,
-// as then you are sure you have configured all required params
-func (s *APIDiscoveryService) NewListApisParams() *ListApisParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *APIDiscoveryService) NewListApisParams(opts ...func(*ListApisParams)) *ListApisParams {
 	p := &ListApisParams{}
 	p.p = make(map[string]interface{})
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // lists all available apis on the server, provided by the Api Discovery plugin
 func (s *APIDiscoveryService) ListApis(p *ListApisParams) (*ListApisResponse, error) {
-	resp, err := s.cs.newRequest("listApis", p.toURLValues())
+	return s.ListApisWithContext(context.Background(), p)
+}
+
+// ListApisWithContext is the same as ListApis, but the request is cancelled when the context is done
+func (s *APIDiscoveryService) ListApisWithContext(ctx context.Context, p *ListApisParams) (*ListApisResponse, error) {
+	return s.ListApisRawWithContext(ctx, p.toURLValues())
+}
+
+// ListApisRaw is the same as ListApis, but takes the params as url.Values instead of typed params
+func (s *APIDiscoveryService) ListApisRaw(v url.Values) (*ListApisResponse, error) {
+	return s.ListApisRawWithContext(context.Background(), v)
+}
+
+// ListApisRawWithContext is the same as ListApisRaw, but the request is cancelled when the context is done
+func (s *APIDiscoveryService) ListApisRawWithContext(ctx context.Context, v url.Values) (*ListApisResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "listApis", "listapisresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -67,6 +104,22 @@ func (s *APIDiscoveryService) ListApis(p *ListApisParams) (*ListApisResponse, er
 	return &r, nil
 }
 
+// ListApisWhere returns all results of ListApis for which pred returns true. Note that the results are
+// filtered client-side, so all results matching the params are fetched first.
+func (s *APIDiscoveryService) ListApisWhere(p *ListApisParams, pred func(*Api) bool) ([]*Api, error) {
+	var r []*Api
+	l, err := s.ListApis(p)
+	if err != nil {
+		return nil, err
+	}
+	for _, v := range l.Apis {
+		if pred(v) {
+			r = append(r, v)
+		}
+	}
+	return r, nil
+}
+
 type ListApisResponse struct {
 	Count int    `json:"count"`
 	Apis  []*Api `json:"api"`
@@ -17,17 +17,151 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/url"
 	"strconv"
 	"strings"
+	"time"
 )
 
+// AccountInventory contains the resources owned by an account, as listed by ListAccountResources
+type AccountInventory struct {
+	Account  string
+	DomainID string
+
+	VirtualMachines   []*VirtualMachine
+	Volumes           []*Volume
+	Networks          []*Network
+	PublicIpAddresses []*PublicIpAddress
+	Snapshots         []*Snapshot
+}
+
+// The max number of concurrent list calls made by ListAccountResources
+const accountResourcesConcurrency = 3
+
+// The page size used by ListAccountResources
+const accountResourcesPageSize = 500
+
+// ListAccountResources lists the virtual machines, volumes, networks, public IP addresses and snapshots
+// owned by the account in the given domain. The resources are listed concurrently (at most 3 list calls
+// at a time) and page by page. When listing some of the resources fails, the inventory is returned with
+// all other resources together with an error containing every failure.
+func (cs *CloudStackClient) ListAccountResources(account, domainid string) (*AccountInventory, error) {
+	inv := &AccountInventory{Account: account, DomainID: domainid}
+
+	// Every list function appends the resources of one page to its own field of the inventory
+	// and returns the number of resources in the page and the total number of resources
+	lists := []struct {
+		name string
+		list func(page int) (int, int, error)
+	}{
+		{"virtual machines", func(page int) (int, int, error) {
+			p := cs.VirtualMachine.NewListVirtualMachinesParams()
+			p.SetAccount(account)
+			p.SetDomainid(domainid)
+			p.SetPage(page)
+			p.SetPagesize(accountResourcesPageSize)
+
+			l, err := cs.VirtualMachine.ListVirtualMachines(p)
+			if err != nil {
+				return 0, 0, err
+			}
+			inv.VirtualMachines = append(inv.VirtualMachines, l.VirtualMachines...)
+			return len(l.VirtualMachines), l.Count, nil
+		}},
+		{"volumes", func(page int) (int, int, error) {
+			p := cs.Volume.NewListVolumesParams()
+			p.SetAccount(account)
+			p.SetDomainid(domainid)
+			p.SetPage(page)
+			p.SetPagesize(accountResourcesPageSize)
+
+			l, err := cs.Volume.ListVolumes(p)
+			if err != nil {
+				return 0, 0, err
+			}
+			inv.Volumes = append(inv.Volumes, l.Volumes...)
+			return len(l.Volumes), l.Count, nil
+		}},
+		{"networks", func(page int) (int, int, error) {
+			p := cs.Network.NewListNetworksParams()
+			p.SetAccount(account)
+			p.SetDomainid(domainid)
+			p.SetPage(page)
+			p.SetPagesize(accountResourcesPageSize)
+
+			l, err := cs.Network.ListNetworks(p)
+			if err != nil {
+				return 0, 0, err
+			}
+			inv.Networks = append(inv.Networks, l.Networks...)
+			return len(l.Networks), l.Count, nil
+		}},
+		{"public IP addresses", func(page int) (int, int, error) {
+			p := cs.Address.NewListPublicIpAddressesParams()
+			p.SetAccount(account)
+			p.SetDomainid(domainid)
+			p.SetPage(page)
+			p.SetPagesize(accountResourcesPageSize)
+
+			l, err := cs.Address.ListPublicIpAddresses(p)
+			if err != nil {
+				return 0, 0, err
+			}
+			inv.PublicIpAddresses = append(inv.PublicIpAddresses, l.PublicIpAddresses...)
+			return len(l.PublicIpAddresses), l.Count, nil
+		}},
+		{"snapshots", func(page int) (int, int, error) {
+			p := cs.Snapshot.NewListSnapshotsParams()
+			p.SetAccount(account)
+			p.SetDomainid(domainid)
+			p.SetPage(page)
+			p.SetPagesize(accountResourcesPageSize)
+
+			l, err := cs.Snapshot.ListSnapshots(p)
+			if err != nil {
+				return 0, 0, err
+			}
+			inv.Snapshots = append(inv.Snapshots, l.Snapshots...)
+			return len(l.Snapshots), l.Count, nil
+		}},
+	}
+
+	errs := make([]error, len(lists))
+	runConcurrently(len(lists), accountResourcesConcurrency, func(i int) {
+		for page, total := 1, 0; ; page++ {
+			n, count, err := lists[i].list(page)
+			if err != nil {
+				errs[i] = fmt.Errorf("Failed to list the %s of account %s: %v", lists[i].name, account, err)
+				return
+			}
+			total += n
+			if n < accountResourcesPageSize || total >= count {
+				return
+			}
+		}
+	})
+
+	return inv, errors.Join(errs...)
+}
+
 type AddAccountToProjectParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *AddAccountToProjectParams) Clone() *AddAccountToProjectParams {
+	c := &AddAccountToProjectParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *AddAccountToProjectParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -45,6 +179,16 @@ func (p *AddAccountToProjectParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *AddAccountToProjectParams) validate() error {
+	for _, k := range []string{"projectid"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of addAccountToProject", k)
+		}
+	}
+	return nil
+}
+
 func (p *AddAccountToProjectParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -53,6 +197,13 @@ func (p *AddAccountToProjectParams) SetAccount(v string) {
 	return
 }
 
+// SetAccountIfUnset sets account to v, unless it is already set
+func (p *AddAccountToProjectParams) SetAccountIfUnset(v string) {
+	if _, found := p.p["account"]; !found {
+		p.SetAccount(v)
+	}
+}
+
 func (p *AddAccountToProjectParams) SetEmail(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -61,6 +212,13 @@ func (p *AddAccountToProjectParams) SetEmail(v string) {
 	return
 }
 
+// SetEmailIfUnset sets email to v, unless it is already set
+func (p *AddAccountToProjectParams) SetEmailIfUnset(v string) {
+	if _, found := p.p["email"]; !found {
+		p.SetEmail(v)
+	}
+}
+
 func (p *AddAccountToProjectParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -69,18 +227,54 @@ func (p *AddAccountToProjectParams) SetProjectid(v string) {
 	return
 }
 
+// SetProjectidIfUnset sets projectid to v, unless it is already set
+func (p *AddAccountToProjectParams) SetProjectidIfUnset(v string) {
+	if _, found := p.p["projectid"]; !found {
+		p.SetProjectid(v)
+	}
+}
+
 // You should always use this function to get a new AddAccountToProjectParams instance,
-// as then you are sure you have configured all required params
-func (s *AccountService) NewAddAccountToProjectParams(projectid string) *AddAccountToProjectParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *AccountService) NewAddAccountToProjectParams(projectid string, opts ...func(*AddAccountToProjectParams)) *AddAccountToProjectParams {
 	p := &AddAccountToProjectParams{}
 	p.p = make(map[string]interface{})
 	p.p["projectid"] = projectid
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Adds account to a project
-func (s *AccountService) AddAccountToProject(p *AddAccountToProjectParams) (*AddAccountToProjectResponse, error) {
-	resp, err := s.cs.newRequest("addAccountToProject", p.toURLValues())
+func (s *AccountService) AddAccountToProject(p *AddAccountToProjectParams, opts ...OptionFunc) (*AddAccountToProjectResponse, error) {
+	return s.AddAccountToProjectWithContext(context.Background(), p, opts...)
+}
+
+// AddAccountToProjectWithContext is the same as AddAccountToProject, but the request is cancelled when the context is done
+func (s *AccountService) AddAccountToProjectWithContext(ctx context.Context, p *AddAccountToProjectParams, opts ...OptionFunc) (*AddAccountToProjectResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.AddAccountToProjectRawWithContext(ctx, p.toURLValues())
+}
+
+// AddAccountToProjectRaw is the same as AddAccountToProject, but takes the params as url.Values instead of typed params
+func (s *AccountService) AddAccountToProjectRaw(v url.Values) (*AddAccountToProjectResponse, error) {
+	return s.AddAccountToProjectRawWithContext(context.Background(), v)
+}
+
+// AddAccountToProjectRawWithContext is the same as AddAccountToProjectRaw, but the request is cancelled when the context is done
+func (s *AccountService) AddAccountToProjectRawWithContext(ctx context.Context, v url.Values) (*AddAccountToProjectResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "addAccountToProject", "addaccounttoprojectresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -92,9 +286,9 @@ func (s *AccountService) AddAccountToProject(p *AddAccountToProjectParams) (*Add
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -118,6 +312,16 @@ type CreateAccountParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *CreateAccountParams) Clone() *CreateAccountParams {
+	c := &CreateAccountParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *CreateAccountParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -127,11 +331,10 @@ func (p *CreateAccountParams) toURLValues() url.Values {
 		u.Set("account", v.(string))
 	}
 	if v, found := p.p["accountdetails"]; found {
-		i := 0
-		for k, vv := range v.(map[string]string) {
+		m := v.(map[string]string)
+		for i, k := range getSortedKeysFromMap(m) {
 			u.Set(fmt.Sprintf("accountdetails[%d].key", i), k)
-			u.Set(fmt.Sprintf("accountdetails[%d].value", i), vv)
-			i++
+			u.Set(fmt.Sprintf("accountdetails[%d].value", i), m[k])
 		}
 	}
 	if v, found := p.p["accountid"]; found {
@@ -174,6 +377,16 @@ func (p *CreateAccountParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *CreateAccountParams) validate() error {
+	for _, k := range []string{"email", "firstname", "lastname", "password", "username"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of createAccount", k)
+		}
+	}
+	return nil
+}
+
 func (p *CreateAccountParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -182,6 +395,13 @@ func (p *CreateAccountParams) SetAccount(v string) {
 	return
 }
 
+// SetAccountIfUnset sets account to v, unless it is already set
+func (p *CreateAccountParams) SetAccountIfUnset(v string) {
+	if _, found := p.p["account"]; !found {
+		p.SetAccount(v)
+	}
+}
+
 func (p *CreateAccountParams) SetAccountdetails(v map[string]string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -190,6 +410,13 @@ func (p *CreateAccountParams) SetAccountdetails(v map[string]string) {
 	return
 }
 
+// SetAccountdetailsIfUnset sets accountdetails to v, unless it is already set
+func (p *CreateAccountParams) SetAccountdetailsIfUnset(v map[string]string) {
+	if _, found := p.p["accountdetails"]; !found {
+		p.SetAccountdetails(v)
+	}
+}
+
 func (p *CreateAccountParams) SetAccountid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -198,6 +425,13 @@ func (p *CreateAccountParams) SetAccountid(v string) {
 	return
 }
 
+// SetAccountidIfUnset sets accountid to v, unless it is already set
+func (p *CreateAccountParams) SetAccountidIfUnset(v string) {
+	if _, found := p.p["accountid"]; !found {
+		p.SetAccountid(v)
+	}
+}
+
 func (p *CreateAccountParams) SetAccounttype(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -206,6 +440,13 @@ func (p *CreateAccountParams) SetAccounttype(v int) {
 	return
 }
 
+// SetAccounttypeIfUnset sets accounttype to v, unless it is already set
+func (p *CreateAccountParams) SetAccounttypeIfUnset(v int) {
+	if _, found := p.p["accounttype"]; !found {
+		p.SetAccounttype(v)
+	}
+}
+
 func (p *CreateAccountParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -214,6 +455,13 @@ func (p *CreateAccountParams) SetDomainid(v string) {
 	return
 }
 
+// SetDomainidIfUnset sets domainid to v, unless it is already set
+func (p *CreateAccountParams) SetDomainidIfUnset(v string) {
+	if _, found := p.p["domainid"]; !found {
+		p.SetDomainid(v)
+	}
+}
+
 func (p *CreateAccountParams) SetEmail(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -222,6 +470,13 @@ func (p *CreateAccountParams) SetEmail(v string) {
 	return
 }
 
+// SetEmailIfUnset sets email to v, unless it is already set
+func (p *CreateAccountParams) SetEmailIfUnset(v string) {
+	if _, found := p.p["email"]; !found {
+		p.SetEmail(v)
+	}
+}
+
 func (p *CreateAccountParams) SetFirstname(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -230,6 +485,13 @@ func (p *CreateAccountParams) SetFirstname(v string) {
 	return
 }
 
+// SetFirstnameIfUnset sets firstname to v, unless it is already set
+func (p *CreateAccountParams) SetFirstnameIfUnset(v string) {
+	if _, found := p.p["firstname"]; !found {
+		p.SetFirstname(v)
+	}
+}
+
 func (p *CreateAccountParams) SetLastname(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -238,6 +500,13 @@ func (p *CreateAccountParams) SetLastname(v string) {
 	return
 }
 
+// SetLastnameIfUnset sets lastname to v, unless it is already set
+func (p *CreateAccountParams) SetLastnameIfUnset(v string) {
+	if _, found := p.p["lastname"]; !found {
+		p.SetLastname(v)
+	}
+}
+
 func (p *CreateAccountParams) SetNetworkdomain(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -246,6 +515,13 @@ func (p *CreateAccountParams) SetNetworkdomain(v string) {
 	return
 }
 
+// SetNetworkdomainIfUnset sets networkdomain to v, unless it is already set
+func (p *CreateAccountParams) SetNetworkdomainIfUnset(v string) {
+	if _, found := p.p["networkdomain"]; !found {
+		p.SetNetworkdomain(v)
+	}
+}
+
 func (p *CreateAccountParams) SetPassword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -254,6 +530,13 @@ func (p *CreateAccountParams) SetPassword(v string) {
 	return
 }
 
+// SetPasswordIfUnset sets password to v, unless it is already set
+func (p *CreateAccountParams) SetPasswordIfUnset(v string) {
+	if _, found := p.p["password"]; !found {
+		p.SetPassword(v)
+	}
+}
+
 func (p *CreateAccountParams) SetRoleid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -262,6 +545,13 @@ func (p *CreateAccountParams) SetRoleid(v string) {
 	return
 }
 
+// SetRoleidIfUnset sets roleid to v, unless it is already set
+func (p *CreateAccountParams) SetRoleidIfUnset(v string) {
+	if _, found := p.p["roleid"]; !found {
+		p.SetRoleid(v)
+	}
+}
+
 func (p *CreateAccountParams) SetTimezone(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -270,6 +560,13 @@ func (p *CreateAccountParams) SetTimezone(v string) {
 	return
 }
 
+// SetTimezoneIfUnset sets timezone to v, unless it is already set
+func (p *CreateAccountParams) SetTimezoneIfUnset(v string) {
+	if _, found := p.p["timezone"]; !found {
+		p.SetTimezone(v)
+	}
+}
+
 func (p *CreateAccountParams) SetUserid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -278,6 +575,13 @@ func (p *CreateAccountParams) SetUserid(v string) {
 	return
 }
 
+// SetUseridIfUnset sets userid to v, unless it is already set
+func (p *CreateAccountParams) SetUseridIfUnset(v string) {
+	if _, found := p.p["userid"]; !found {
+		p.SetUserid(v)
+	}
+}
+
 func (p *CreateAccountParams) SetUsername(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -286,9 +590,17 @@ func (p *CreateAccountParams) SetUsername(v string) {
 	return
 }
 
+// SetUsernameIfUnset sets username to v, unless it is already set
+func (p *CreateAccountParams) SetUsernameIfUnset(v string) {
+	if _, found := p.p["username"]; !found {
+		p.SetUsername(v)
+	}
+}
+
 // You should always use this function to get a new CreateAccountParams instance,
-// as then you are sure you have configured all required params
-func (s *AccountService) NewCreateAccountParams(email string, firstname string, lastname string, password string, username string) *CreateAccountParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *AccountService) NewCreateAccountParams(email string, firstname string, lastname string, password string, username string, opts ...func(*CreateAccountParams)) *CreateAccountParams {
 	p := &CreateAccountParams{}
 	p.p = make(map[string]interface{})
 	p.p["email"] = email
@@ -296,17 +608,98 @@ func (s *AccountService) NewCreateAccountParams(email string, firstname string,
 	p.p["lastname"] = lastname
 	p.p["password"] = password
 	p.p["username"] = username
+	for _, fn := range opts {
+		fn(p)
+	}
+	return p
+}
+
+// CreateAccountOpts contains all params that can be set on a CreateAccountParams.
+// Optional params are pointers (or slices and maps) which are only set when not nil.
+type CreateAccountOpts struct {
+	Account        *string
+	Accountdetails map[string]string
+	Accountid      *string
+	Accounttype    *int
+	Domainid       *string
+	Email          string
+	Firstname      string
+	Lastname       string
+	Networkdomain  *string
+	Password       string
+	Roleid         *string
+	Timezone       *string
+	Userid         *string
+	Username       string
+}
+
+// NewCreateAccountParamsFromOpts is an alternative for NewCreateAccountParams which takes all params using
+// an options struct, as that is easier to read for APIs with many required params
+func (s *AccountService) NewCreateAccountParamsFromOpts(o CreateAccountOpts) *CreateAccountParams {
+	p := s.NewCreateAccountParams(o.Email, o.Firstname, o.Lastname, o.Password, o.Username)
+	if o.Account != nil {
+		p.p["account"] = *o.Account
+	}
+	if o.Accountdetails != nil {
+		p.p["accountdetails"] = o.Accountdetails
+	}
+	if o.Accountid != nil {
+		p.p["accountid"] = *o.Accountid
+	}
+	if o.Accounttype != nil {
+		p.p["accounttype"] = *o.Accounttype
+	}
+	if o.Domainid != nil {
+		p.p["domainid"] = *o.Domainid
+	}
+	if o.Networkdomain != nil {
+		p.p["networkdomain"] = *o.Networkdomain
+	}
+	if o.Roleid != nil {
+		p.p["roleid"] = *o.Roleid
+	}
+	if o.Timezone != nil {
+		p.p["timezone"] = *o.Timezone
+	}
+	if o.Userid != nil {
+		p.p["userid"] = *o.Userid
+	}
 	return p
 }
 
 // Creates an account
-func (s *AccountService) CreateAccount(p *CreateAccountParams) (*CreateAccountResponse, error) {
-	resp, err := s.cs.newRequest("createAccount", p.toURLValues())
+func (s *AccountService) CreateAccount(p *CreateAccountParams, opts ...OptionFunc) (*CreateAccountResponse, error) {
+	return s.CreateAccountWithContext(context.Background(), p, opts...)
+}
+
+// CreateAccountWithContext is the same as CreateAccount, but the request is cancelled when the context is done
+func (s *AccountService) CreateAccountWithContext(ctx context.Context, p *CreateAccountParams, opts ...OptionFunc) (*CreateAccountResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.CreateAccountRawWithContext(ctx, p.toURLValues())
+}
+
+// CreateAccountRaw is the same as CreateAccount, but takes the params as url.Values instead of typed params
+func (s *AccountService) CreateAccountRaw(v url.Values) (*CreateAccountResponse, error) {
+	return s.CreateAccountRawWithContext(context.Background(), v)
+}
+
+// CreateAccountRawWithContext is the same as CreateAccountRaw, but the request is cancelled when the context is done
+func (s *AccountService) CreateAccountRawWithContext(ctx context.Context, v url.Values) (*CreateAccountResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "createAccount", "createaccountresponse", v)
 	if err != nil {
 		return nil, err
 	}
 
-	if resp, err = getRawValue(resp); err != nil {
+	if resp, err = getRawValueByKey(resp, "account"); err != nil {
 		return nil, err
 	}
 
@@ -319,50 +712,50 @@ func (s *AccountService) CreateAccount(p *CreateAccountParams) (*CreateAccountRe
 }
 
 type CreateAccountResponse struct {
-	Accountdetails            map[string]string `json:"accountdetails"`
-	Accounttype               int               `json:"accounttype"`
-	Cpuavailable              string            `json:"cpuavailable"`
-	Cpulimit                  string            `json:"cpulimit"`
-	Cputotal                  int64             `json:"cputotal"`
-	Defaultzoneid             string            `json:"defaultzoneid"`
-	Domain                    string            `json:"domain"`
-	Domainid                  string            `json:"domainid"`
-	Groups                    []string          `json:"groups"`
-	Id                        string            `json:"id"`
-	Ipavailable               string            `json:"ipavailable"`
-	Iplimit                   string            `json:"iplimit"`
-	Iptotal                   int64             `json:"iptotal"`
-	Iscleanuprequired         bool              `json:"iscleanuprequired"`
-	Isdefault                 bool              `json:"isdefault"`
-	Memoryavailable           string            `json:"memoryavailable"`
-	Memorylimit               string            `json:"memorylimit"`
-	Memorytotal               int64             `json:"memorytotal"`
-	Name                      string            `json:"name"`
-	Networkavailable          string            `json:"networkavailable"`
-	Networkdomain             string            `json:"networkdomain"`
-	Networklimit              string            `json:"networklimit"`
-	Networktotal              int64             `json:"networktotal"`
-	Primarystorageavailable   string            `json:"primarystorageavailable"`
-	Primarystoragelimit       string            `json:"primarystoragelimit"`
-	Primarystoragetotal       int64             `json:"primarystoragetotal"`
-	Projectavailable          string            `json:"projectavailable"`
-	Projectlimit              string            `json:"projectlimit"`
-	Projecttotal              int64             `json:"projecttotal"`
-	Receivedbytes             int64             `json:"receivedbytes"`
-	Roleid                    string            `json:"roleid"`
-	Rolename                  string            `json:"rolename"`
-	Roletype                  string            `json:"roletype"`
-	Secondarystorageavailable string            `json:"secondarystorageavailable"`
-	Secondarystoragelimit     string            `json:"secondarystoragelimit"`
-	Secondarystoragetotal     int64             `json:"secondarystoragetotal"`
-	Sentbytes                 int64             `json:"sentbytes"`
-	Snapshotavailable         string            `json:"snapshotavailable"`
-	Snapshotlimit             string            `json:"snapshotlimit"`
-	Snapshottotal             int64             `json:"snapshottotal"`
-	State                     string            `json:"state"`
-	Templateavailable         string            `json:"templateavailable"`
-	Templatelimit             string            `json:"templatelimit"`
-	Templatetotal             int64             `json:"templatetotal"`
+	Accountdetails            StringMap `json:"accountdetails"`
+	Accounttype               int       `json:"accounttype"`
+	Cpuavailable              string    `json:"cpuavailable"`
+	Cpulimit                  string    `json:"cpulimit"`
+	Cputotal                  int64     `json:"cputotal"`
+	Defaultzoneid             string    `json:"defaultzoneid"`
+	Domain                    string    `json:"domain"`
+	Domainid                  string    `json:"domainid"`
+	Groups                    []string  `json:"groups"`
+	Id                        string    `json:"id"`
+	Ipavailable               string    `json:"ipavailable"`
+	Iplimit                   string    `json:"iplimit"`
+	Iptotal                   int64     `json:"iptotal"`
+	Iscleanuprequired         bool      `json:"iscleanuprequired"`
+	Isdefault                 bool      `json:"isdefault"`
+	Memoryavailable           string    `json:"memoryavailable"`
+	Memorylimit               string    `json:"memorylimit"`
+	Memorytotal               int64     `json:"memorytotal"`
+	Name                      string    `json:"name"`
+	Networkavailable          string    `json:"networkavailable"`
+	Networkdomain             string    `json:"networkdomain"`
+	Networklimit              string    `json:"networklimit"`
+	Networktotal              int64     `json:"networktotal"`
+	Primarystorageavailable   string    `json:"primarystorageavailable"`
+	Primarystoragelimit       string    `json:"primarystoragelimit"`
+	Primarystoragetotal       int64     `json:"primarystoragetotal"`
+	Projectavailable          string    `json:"projectavailable"`
+	Projectlimit              string    `json:"projectlimit"`
+	Projecttotal              int64     `json:"projecttotal"`
+	Receivedbytes             int64     `json:"receivedbytes"`
+	Roleid                    string    `json:"roleid"`
+	Rolename                  string    `json:"rolename"`
+	Roletype                  string    `json:"roletype"`
+	Secondarystorageavailable string    `json:"secondarystorageavailable"`
+	Secondarystoragelimit     string    `json:"secondarystoragelimit"`
+	Secondarystoragetotal     int64     `json:"secondarystoragetotal"`
+	Sentbytes                 int64     `json:"sentbytes"`
+	Snapshotavailable         string    `json:"snapshotavailable"`
+	Snapshotlimit             string    `json:"snapshotlimit"`
+	Snapshottotal             int64     `json:"snapshottotal"`
+	State                     string    `json:"state"`
+	Templateavailable         string    `json:"templateavailable"`
+	Templatelimit             string    `json:"templatelimit"`
+	Templatetotal             int64     `json:"templatetotal"`
 	User                      []struct {
 		Account             string `json:"account"`
 		Accountid           string `json:"accountid"`
@@ -402,6 +795,16 @@ type DeleteAccountParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *DeleteAccountParams) Clone() *DeleteAccountParams {
+	c := &DeleteAccountParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeleteAccountParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -413,6 +816,16 @@ func (p *DeleteAccountParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *DeleteAccountParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of deleteAccount", k)
+		}
+	}
+	return nil
+}
+
 func (p *DeleteAccountParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -421,18 +834,54 @@ func (p *DeleteAccountParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *DeleteAccountParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 // You should always use this function to get a new DeleteAccountParams instance,
-// as then you are sure you have configured all required params
-func (s *AccountService) NewDeleteAccountParams(id string) *DeleteAccountParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *AccountService) NewDeleteAccountParams(id string, opts ...func(*DeleteAccountParams)) *DeleteAccountParams {
 	p := &DeleteAccountParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Deletes a account, and all users associated with this account
-func (s *AccountService) DeleteAccount(p *DeleteAccountParams) (*DeleteAccountResponse, error) {
-	resp, err := s.cs.newRequest("deleteAccount", p.toURLValues())
+func (s *AccountService) DeleteAccount(p *DeleteAccountParams, opts ...OptionFunc) (*DeleteAccountResponse, error) {
+	return s.DeleteAccountWithContext(context.Background(), p, opts...)
+}
+
+// DeleteAccountWithContext is the same as DeleteAccount, but the request is cancelled when the context is done
+func (s *AccountService) DeleteAccountWithContext(ctx context.Context, p *DeleteAccountParams, opts ...OptionFunc) (*DeleteAccountResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.DeleteAccountRawWithContext(ctx, p.toURLValues())
+}
+
+// DeleteAccountRaw is the same as DeleteAccount, but takes the params as url.Values instead of typed params
+func (s *AccountService) DeleteAccountRaw(v url.Values) (*DeleteAccountResponse, error) {
+	return s.DeleteAccountRawWithContext(context.Background(), v)
+}
+
+// DeleteAccountRawWithContext is the same as DeleteAccountRaw, but the request is cancelled when the context is done
+func (s *AccountService) DeleteAccountRawWithContext(ctx context.Context, v url.Values) (*DeleteAccountResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteAccount", "deleteaccountresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -444,9 +893,9 @@ func (s *AccountService) DeleteAccount(p *DeleteAccountParams) (*DeleteAccountRe
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -460,6 +909,34 @@ func (s *AccountService) DeleteAccount(p *DeleteAccountParams) (*DeleteAccountRe
 	return &r, nil
 }
 
+// WaitForAccountDeleted polls until the Account with the given ID no longer exists, the timeout
+// expires or the context is cancelled. Deleted resources may still be listed for a short while
+// after the delete command finished, so this can be used to make sure they are really gone. The
+// options are passed to GetAccountByID, so a resource outside of the default scope can be found.
+func (s *AccountService) WaitForAccountDeleted(ctx context.Context, id string, timeout time.Duration, opts ...OptionFunc) error {
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	for {
+		_, count, err := s.GetAccountByID(id, opts...)
+		if count == 0 {
+			return nil
+		}
+		if count < 0 {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(2 * time.Second):
+		}
+	}
+}
+
 type DeleteAccountResponse struct {
 	JobID       string `json:"jobid"`
 	Displaytext string `json:"displaytext"`
@@ -470,6 +947,16 @@ type DeleteAccountFromProjectParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *DeleteAccountFromProjectParams) Clone() *DeleteAccountFromProjectParams {
+	c := &DeleteAccountFromProjectParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeleteAccountFromProjectParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -484,6 +971,16 @@ func (p *DeleteAccountFromProjectParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *DeleteAccountFromProjectParams) validate() error {
+	for _, k := range []string{"account", "projectid"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of deleteAccountFromProject", k)
+		}
+	}
+	return nil
+}
+
 func (p *DeleteAccountFromProjectParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -492,6 +989,13 @@ func (p *DeleteAccountFromProjectParams) SetAccount(v string) {
 	return
 }
 
+// SetAccountIfUnset sets account to v, unless it is already set
+func (p *DeleteAccountFromProjectParams) SetAccountIfUnset(v string) {
+	if _, found := p.p["account"]; !found {
+		p.SetAccount(v)
+	}
+}
+
 func (p *DeleteAccountFromProjectParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -500,19 +1004,55 @@ func (p *DeleteAccountFromProjectParams) SetProjectid(v string) {
 	return
 }
 
+// SetProjectidIfUnset sets projectid to v, unless it is already set
+func (p *DeleteAccountFromProjectParams) SetProjectidIfUnset(v string) {
+	if _, found := p.p["projectid"]; !found {
+		p.SetProjectid(v)
+	}
+}
+
 // You should always use this function to get a new DeleteAccountFromProjectParams instance,
-// as then you are sure you have configured all required params
-func (s *AccountService) NewDeleteAccountFromProjectParams(account string, projectid string) *DeleteAccountFromProjectParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *AccountService) NewDeleteAccountFromProjectParams(account string, projectid string, opts ...func(*DeleteAccountFromProjectParams)) *DeleteAccountFromProjectParams {
 	p := &DeleteAccountFromProjectParams{}
 	p.p = make(map[string]interface{})
 	p.p["account"] = account
 	p.p["projectid"] = projectid
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Deletes account from the project
-func (s *AccountService) DeleteAccountFromProject(p *DeleteAccountFromProjectParams) (*DeleteAccountFromProjectResponse, error) {
-	resp, err := s.cs.newRequest("deleteAccountFromProject", p.toURLValues())
+func (s *AccountService) DeleteAccountFromProject(p *DeleteAccountFromProjectParams, opts ...OptionFunc) (*DeleteAccountFromProjectResponse, error) {
+	return s.DeleteAccountFromProjectWithContext(context.Background(), p, opts...)
+}
+
+// DeleteAccountFromProjectWithContext is the same as DeleteAccountFromProject, but the request is cancelled when the context is done
+func (s *AccountService) DeleteAccountFromProjectWithContext(ctx context.Context, p *DeleteAccountFromProjectParams, opts ...OptionFunc) (*DeleteAccountFromProjectResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.DeleteAccountFromProjectRawWithContext(ctx, p.toURLValues())
+}
+
+// DeleteAccountFromProjectRaw is the same as DeleteAccountFromProject, but takes the params as url.Values instead of typed params
+func (s *AccountService) DeleteAccountFromProjectRaw(v url.Values) (*DeleteAccountFromProjectResponse, error) {
+	return s.DeleteAccountFromProjectRawWithContext(context.Background(), v)
+}
+
+// DeleteAccountFromProjectRawWithContext is the same as DeleteAccountFromProjectRaw, but the request is cancelled when the context is done
+func (s *AccountService) DeleteAccountFromProjectRawWithContext(ctx context.Context, v url.Values) (*DeleteAccountFromProjectResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteAccountFromProject", "deleteaccountfromprojectresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -524,9 +1064,9 @@ func (s *AccountService) DeleteAccountFromProject(p *DeleteAccountFromProjectPar
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -550,6 +1090,16 @@ type DisableAccountParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *DisableAccountParams) Clone() *DisableAccountParams {
+	c := &DisableAccountParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DisableAccountParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -571,6 +1121,16 @@ func (p *DisableAccountParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *DisableAccountParams) validate() error {
+	for _, k := range []string{"lock"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of disableAccount", k)
+		}
+	}
+	return nil
+}
+
 func (p *DisableAccountParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -579,6 +1139,13 @@ func (p *DisableAccountParams) SetAccount(v string) {
 	return
 }
 
+// SetAccountIfUnset sets account to v, unless it is already set
+func (p *DisableAccountParams) SetAccountIfUnset(v string) {
+	if _, found := p.p["account"]; !found {
+		p.SetAccount(v)
+	}
+}
+
 func (p *DisableAccountParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -587,6 +1154,13 @@ func (p *DisableAccountParams) SetDomainid(v string) {
 	return
 }
 
+// SetDomainidIfUnset sets domainid to v, unless it is already set
+func (p *DisableAccountParams) SetDomainidIfUnset(v string) {
+	if _, found := p.p["domainid"]; !found {
+		p.SetDomainid(v)
+	}
+}
+
 func (p *DisableAccountParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -595,6 +1169,13 @@ func (p *DisableAccountParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *DisableAccountParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 func (p *DisableAccountParams) SetLock(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -603,18 +1184,54 @@ func (p *DisableAccountParams) SetLock(v bool) {
 	return
 }
 
+// SetLockIfUnset sets lock to v, unless it is already set
+func (p *DisableAccountParams) SetLockIfUnset(v bool) {
+	if _, found := p.p["lock"]; !found {
+		p.SetLock(v)
+	}
+}
+
 // You should always use this function to get a new DisableAccountParams instance,
-// as then you are sure you have configured all required params
-func (s *AccountService) NewDisableAccountParams(lock bool) *DisableAccountParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *AccountService) NewDisableAccountParams(lock bool, opts ...func(*DisableAccountParams)) *DisableAccountParams {
 	p := &DisableAccountParams{}
 	p.p = make(map[string]interface{})
 	p.p["lock"] = lock
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Disables an account
-func (s *AccountService) DisableAccount(p *DisableAccountParams) (*DisableAccountResponse, error) {
-	resp, err := s.cs.newRequest("disableAccount", p.toURLValues())
+func (s *AccountService) DisableAccount(p *DisableAccountParams, opts ...OptionFunc) (*DisableAccountResponse, error) {
+	return s.DisableAccountWithContext(context.Background(), p, opts...)
+}
+
+// DisableAccountWithContext is the same as DisableAccount, but the request is cancelled when the context is done
+func (s *AccountService) DisableAccountWithContext(ctx context.Context, p *DisableAccountParams, opts ...OptionFunc) (*DisableAccountResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.DisableAccountRawWithContext(ctx, p.toURLValues())
+}
+
+// DisableAccountRaw is the same as DisableAccount, but takes the params as url.Values instead of typed params
+func (s *AccountService) DisableAccountRaw(v url.Values) (*DisableAccountResponse, error) {
+	return s.DisableAccountRawWithContext(context.Background(), v)
+}
+
+// DisableAccountRawWithContext is the same as DisableAccountRaw, but the request is cancelled when the context is done
+func (s *AccountService) DisableAccountRawWithContext(ctx context.Context, v url.Values) (*DisableAccountResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "disableAccount", "disableaccountresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -626,9 +1243,9 @@ func (s *AccountService) DisableAccount(p *DisableAccountParams) (*DisableAccoun
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -648,51 +1265,51 @@ func (s *AccountService) DisableAccount(p *DisableAccountParams) (*DisableAccoun
 }
 
 type DisableAccountResponse struct {
-	JobID                     string            `json:"jobid"`
-	Accountdetails            map[string]string `json:"accountdetails"`
-	Accounttype               int               `json:"accounttype"`
-	Cpuavailable              string            `json:"cpuavailable"`
-	Cpulimit                  string            `json:"cpulimit"`
-	Cputotal                  int64             `json:"cputotal"`
-	Defaultzoneid             string            `json:"defaultzoneid"`
-	Domain                    string            `json:"domain"`
-	Domainid                  string            `json:"domainid"`
-	Groups                    []string          `json:"groups"`
-	Id                        string            `json:"id"`
-	Ipavailable               string            `json:"ipavailable"`
-	Iplimit                   string            `json:"iplimit"`
-	Iptotal                   int64             `json:"iptotal"`
-	Iscleanuprequired         bool              `json:"iscleanuprequired"`
-	Isdefault                 bool              `json:"isdefault"`
-	Memoryavailable           string            `json:"memoryavailable"`
-	Memorylimit               string            `json:"memorylimit"`
-	Memorytotal               int64             `json:"memorytotal"`
-	Name                      string            `json:"name"`
-	Networkavailable          string            `json:"networkavailable"`
-	Networkdomain             string            `json:"networkdomain"`
-	Networklimit              string            `json:"networklimit"`
-	Networktotal              int64             `json:"networktotal"`
-	Primarystorageavailable   string            `json:"primarystorageavailable"`
-	Primarystoragelimit       string            `json:"primarystoragelimit"`
-	Primarystoragetotal       int64             `json:"primarystoragetotal"`
-	Projectavailable          string            `json:"projectavailable"`
-	Projectlimit              string            `json:"projectlimit"`
-	Projecttotal              int64             `json:"projecttotal"`
-	Receivedbytes             int64             `json:"receivedbytes"`
-	Roleid                    string            `json:"roleid"`
-	Rolename                  string            `json:"rolename"`
-	Roletype                  string            `json:"roletype"`
-	Secondarystorageavailable string            `json:"secondarystorageavailable"`
-	Secondarystoragelimit     string            `json:"secondarystoragelimit"`
-	Secondarystoragetotal     int64             `json:"secondarystoragetotal"`
-	Sentbytes                 int64             `json:"sentbytes"`
-	Snapshotavailable         string            `json:"snapshotavailable"`
-	Snapshotlimit             string            `json:"snapshotlimit"`
-	Snapshottotal             int64             `json:"snapshottotal"`
-	State                     string            `json:"state"`
-	Templateavailable         string            `json:"templateavailable"`
-	Templatelimit             string            `json:"templatelimit"`
-	Templatetotal             int64             `json:"templatetotal"`
+	JobID                     string    `json:"jobid"`
+	Accountdetails            StringMap `json:"accountdetails"`
+	Accounttype               int       `json:"accounttype"`
+	Cpuavailable              string    `json:"cpuavailable"`
+	Cpulimit                  string    `json:"cpulimit"`
+	Cputotal                  int64     `json:"cputotal"`
+	Defaultzoneid             string    `json:"defaultzoneid"`
+	Domain                    string    `json:"domain"`
+	Domainid                  string    `json:"domainid"`
+	Groups                    []string  `json:"groups"`
+	Id                        string    `json:"id"`
+	Ipavailable               string    `json:"ipavailable"`
+	Iplimit                   string    `json:"iplimit"`
+	Iptotal                   int64     `json:"iptotal"`
+	Iscleanuprequired         bool      `json:"iscleanuprequired"`
+	Isdefault                 bool      `json:"isdefault"`
+	Memoryavailable           string    `json:"memoryavailable"`
+	Memorylimit               string    `json:"memorylimit"`
+	Memorytotal               int64     `json:"memorytotal"`
+	Name                      string    `json:"name"`
+	Networkavailable          string    `json:"networkavailable"`
+	Networkdomain             string    `json:"networkdomain"`
+	Networklimit              string    `json:"networklimit"`
+	Networktotal              int64     `json:"networktotal"`
+	Primarystorageavailable   string    `json:"primarystorageavailable"`
+	Primarystoragelimit       string    `json:"primarystoragelimit"`
+	Primarystoragetotal       int64     `json:"primarystoragetotal"`
+	Projectavailable          string    `json:"projectavailable"`
+	Projectlimit              string    `json:"projectlimit"`
+	Projecttotal              int64     `json:"projecttotal"`
+	Receivedbytes             int64     `json:"receivedbytes"`
+	Roleid                    string    `json:"roleid"`
+	Rolename                  string    `json:"rolename"`
+	Roletype                  string    `json:"roletype"`
+	Secondarystorageavailable string    `json:"secondarystorageavailable"`
+	Secondarystoragelimit     string    `json:"secondarystoragelimit"`
+	Secondarystoragetotal     int64     `json:"secondarystoragetotal"`
+	Sentbytes                 int64     `json:"sentbytes"`
+	Snapshotavailable         string    `json:"snapshotavailable"`
+	Snapshotlimit             string    `json:"snapshotlimit"`
+	Snapshottotal             int64     `json:"snapshottotal"`
+	State                     string    `json:"state"`
+	Templateavailable         string    `json:"templateavailable"`
+	Templatelimit             string    `json:"templatelimit"`
+	Templatetotal             int64     `json:"templatetotal"`
 	User                      []struct {
 		Account             string `json:"account"`
 		Accountid           string `json:"accountid"`
@@ -732,6 +1349,16 @@ type EnableAccountParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *EnableAccountParams) Clone() *EnableAccountParams {
+	c := &EnableAccountParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *EnableAccountParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -757,6 +1384,13 @@ func (p *EnableAccountParams) SetAccount(v string) {
 	return
 }
 
+// SetAccountIfUnset sets account to v, unless it is already set
+func (p *EnableAccountParams) SetAccountIfUnset(v string) {
+	if _, found := p.p["account"]; !found {
+		p.SetAccount(v)
+	}
+}
+
 func (p *EnableAccountParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -765,6 +1399,13 @@ func (p *EnableAccountParams) SetDomainid(v string) {
 	return
 }
 
+// SetDomainidIfUnset sets domainid to v, unless it is already set
+func (p *EnableAccountParams) SetDomainidIfUnset(v string) {
+	if _, found := p.p["domainid"]; !found {
+		p.SetDomainid(v)
+	}
+}
+
 func (p *EnableAccountParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -773,17 +1414,49 @@ func (p *EnableAccountParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *EnableAccountParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 // You should always use this function to get a new EnableAccountParams instance,
-// as then you are sure you have configured all required params
-func (s *AccountService) NewEnableAccountParams() *EnableAccountParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *AccountService) NewEnableAccountParams(opts ...func(*EnableAccountParams)) *EnableAccountParams {
 	p := &EnableAccountParams{}
 	p.p = make(map[string]interface{})
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Enables an account
-func (s *AccountService) EnableAccount(p *EnableAccountParams) (*EnableAccountResponse, error) {
-	resp, err := s.cs.newRequest("enableAccount", p.toURLValues())
+func (s *AccountService) EnableAccount(p *EnableAccountParams, opts ...OptionFunc) (*EnableAccountResponse, error) {
+	return s.EnableAccountWithContext(context.Background(), p, opts...)
+}
+
+// EnableAccountWithContext is the same as EnableAccount, but the request is cancelled when the context is done
+func (s *AccountService) EnableAccountWithContext(ctx context.Context, p *EnableAccountParams, opts ...OptionFunc) (*EnableAccountResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	return s.EnableAccountRawWithContext(ctx, p.toURLValues())
+}
+
+// EnableAccountRaw is the same as EnableAccount, but takes the params as url.Values instead of typed params
+func (s *AccountService) EnableAccountRaw(v url.Values) (*EnableAccountResponse, error) {
+	return s.EnableAccountRawWithContext(context.Background(), v)
+}
+
+// EnableAccountRawWithContext is the same as EnableAccountRaw, but the request is cancelled when the context is done
+func (s *AccountService) EnableAccountRawWithContext(ctx context.Context, v url.Values) (*EnableAccountResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "enableAccount", "enableaccountresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -797,50 +1470,50 @@ func (s *AccountService) EnableAccount(p *EnableAccountParams) (*EnableAccountRe
 }
 
 type EnableAccountResponse struct {
-	Accountdetails            map[string]string `json:"accountdetails"`
-	Accounttype               int               `json:"accounttype"`
-	Cpuavailable              string            `json:"cpuavailable"`
-	Cpulimit                  string            `json:"cpulimit"`
-	Cputotal                  int64             `json:"cputotal"`
-	Defaultzoneid             string            `json:"defaultzoneid"`
-	Domain                    string            `json:"domain"`
-	Domainid                  string            `json:"domainid"`
-	Groups                    []string          `json:"groups"`
-	Id                        string            `json:"id"`
-	Ipavailable               string            `json:"ipavailable"`
-	Iplimit                   string            `json:"iplimit"`
-	Iptotal                   int64             `json:"iptotal"`
-	Iscleanuprequired         bool              `json:"iscleanuprequired"`
-	Isdefault                 bool              `json:"isdefault"`
-	Memoryavailable           string            `json:"memoryavailable"`
-	Memorylimit               string            `json:"memorylimit"`
-	Memorytotal               int64             `json:"memorytotal"`
-	Name                      string            `json:"name"`
-	Networkavailable          string            `json:"networkavailable"`
-	Networkdomain             string            `json:"networkdomain"`
-	Networklimit              string            `json:"networklimit"`
-	Networktotal              int64             `json:"networktotal"`
-	Primarystorageavailable   string            `json:"primarystorageavailable"`
-	Primarystoragelimit       string            `json:"primarystoragelimit"`
-	Primarystoragetotal       int64             `json:"primarystoragetotal"`
-	Projectavailable          string            `json:"projectavailable"`
-	Projectlimit              string            `json:"projectlimit"`
-	Projecttotal              int64             `json:"projecttotal"`
-	Receivedbytes             int64             `json:"receivedbytes"`
-	Roleid                    string            `json:"roleid"`
-	Rolename                  string            `json:"rolename"`
-	Roletype                  string            `json:"roletype"`
-	Secondarystorageavailable string            `json:"secondarystorageavailable"`
-	Secondarystoragelimit     string            `json:"secondarystoragelimit"`
-	Secondarystoragetotal     int64             `json:"secondarystoragetotal"`
-	Sentbytes                 int64             `json:"sentbytes"`
-	Snapshotavailable         string            `json:"snapshotavailable"`
-	Snapshotlimit             string            `json:"snapshotlimit"`
-	Snapshottotal             int64             `json:"snapshottotal"`
-	State                     string            `json:"state"`
-	Templateavailable         string            `json:"templateavailable"`
-	Templatelimit             string            `json:"templatelimit"`
-	Templatetotal             int64             `json:"templatetotal"`
+	Accountdetails            StringMap `json:"accountdetails"`
+	Accounttype               int       `json:"accounttype"`
+	Cpuavailable              string    `json:"cpuavailable"`
+	Cpulimit                  string    `json:"cpulimit"`
+	Cputotal                  int64     `json:"cputotal"`
+	Defaultzoneid             string    `json:"defaultzoneid"`
+	Domain                    string    `json:"domain"`
+	Domainid                  string    `json:"domainid"`
+	Groups                    []string  `json:"groups"`
+	Id                        string    `json:"id"`
+	Ipavailable               string    `json:"ipavailable"`
+	Iplimit                   string    `json:"iplimit"`
+	Iptotal                   int64     `json:"iptotal"`
+	Iscleanuprequired         bool      `json:"iscleanuprequired"`
+	Isdefault                 bool      `json:"isdefault"`
+	Memoryavailable           string    `json:"memoryavailable"`
+	Memorylimit               string    `json:"memorylimit"`
+	Memorytotal               int64     `json:"memorytotal"`
+	Name                      string    `json:"name"`
+	Networkavailable          string    `json:"networkavailable"`
+	Networkdomain             string    `json:"networkdomain"`
+	Networklimit              string    `json:"networklimit"`
+	Networktotal              int64     `json:"networktotal"`
+	Primarystorageavailable   string    `json:"primarystorageavailable"`
+	Primarystoragelimit       string    `json:"primarystoragelimit"`
+	Primarystoragetotal       int64     `json:"primarystoragetotal"`
+	Projectavailable          string    `json:"projectavailable"`
+	Projectlimit              string    `json:"projectlimit"`
+	Projecttotal              int64     `json:"projecttotal"`
+	Receivedbytes             int64     `json:"receivedbytes"`
+	Roleid                    string    `json:"roleid"`
+	Rolename                  string    `json:"rolename"`
+	Roletype                  string    `json:"roletype"`
+	Secondarystorageavailable string    `json:"secondarystorageavailable"`
+	Secondarystoragelimit     string    `json:"secondarystoragelimit"`
+	Secondarystoragetotal     int64     `json:"secondarystoragetotal"`
+	Sentbytes                 int64     `json:"sentbytes"`
+	Snapshotavailable         string    `json:"snapshotavailable"`
+	Snapshotlimit             string    `json:"snapshotlimit"`
+	Snapshottotal             int64     `json:"snapshottotal"`
+	State                     string    `json:"state"`
+	Templateavailable         string    `json:"templateavailable"`
+	Templatelimit             string    `json:"templatelimit"`
+	Templatetotal             int64     `json:"templatetotal"`
 	User                      []struct {
 		Account             string `json:"account"`
 		Accountid           string `json:"accountid"`
@@ -880,6 +1553,16 @@ type GetSolidFireAccountIdParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *GetSolidFireAccountIdParams) Clone() *GetSolidFireAccountIdParams {
+	c := &GetSolidFireAccountIdParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *GetSolidFireAccountIdParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -894,6 +1577,16 @@ func (p *GetSolidFireAccountIdParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *GetSolidFireAccountIdParams) validate() error {
+	for _, k := range []string{"accountid", "storageid"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of getSolidFireAccountId", k)
+		}
+	}
+	return nil
+}
+
 func (p *GetSolidFireAccountIdParams) SetAccountid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -902,6 +1595,13 @@ func (p *GetSolidFireAccountIdParams) SetAccountid(v string) {
 	return
 }
 
+// SetAccountidIfUnset sets accountid to v, unless it is already set
+func (p *GetSolidFireAccountIdParams) SetAccountidIfUnset(v string) {
+	if _, found := p.p["accountid"]; !found {
+		p.SetAccountid(v)
+	}
+}
+
 func (p *GetSolidFireAccountIdParams) SetStorageid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -910,21 +1610,57 @@ func (p *GetSolidFireAccountIdParams) SetStorageid(v string) {
 	return
 }
 
+// SetStorageidIfUnset sets storageid to v, unless it is already set
+func (p *GetSolidFireAccountIdParams) SetStorageidIfUnset(v string) {
+	if _, found := p.p["storageid"]; !found {
+		p.SetStorageid(v)
+	}
+}
+
 // You should always use this function to get a new GetSolidFireAccountIdParams instance,
-// as then you are sure you have configured all required params
-func (s *AccountService) NewGetSolidFireAccountIdParams(accountid string, storageid string) *GetSolidFireAccountIdParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *AccountService) NewGetSolidFireAccountIdParams(accountid string, storageid string, opts ...func(*GetSolidFireAccountIdParams)) *GetSolidFireAccountIdParams {
 	p := &GetSolidFireAccountIdParams{}
 	p.p = make(map[string]interface{})
 	p.p["accountid"] = accountid
 	p.p["storageid"] = storageid
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Get SolidFire Account ID
-func (s *AccountService) GetSolidFireAccountId(p *GetSolidFireAccountIdParams) (*GetSolidFireAccountIdResponse, error) {
-	resp, err := s.cs.newRequest("getSolidFireAccountId", p.toURLValues())
-	if err != nil {
-		return nil, err
+func (s *AccountService) GetSolidFireAccountId(p *GetSolidFireAccountIdParams, opts ...OptionFunc) (*GetSolidFireAccountIdResponse, error) {
+	return s.GetSolidFireAccountIdWithContext(context.Background(), p, opts...)
+}
+
+// GetSolidFireAccountIdWithContext is the same as GetSolidFireAccountId, but the request is cancelled when the context is done
+func (s *AccountService) GetSolidFireAccountIdWithContext(ctx context.Context, p *GetSolidFireAccountIdParams, opts ...OptionFunc) (*GetSolidFireAccountIdResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.GetSolidFireAccountIdRawWithContext(ctx, p.toURLValues())
+}
+
+// GetSolidFireAccountIdRaw is the same as GetSolidFireAccountId, but takes the params as url.Values instead of typed params
+func (s *AccountService) GetSolidFireAccountIdRaw(v url.Values) (*GetSolidFireAccountIdResponse, error) {
+	return s.GetSolidFireAccountIdRawWithContext(context.Background(), v)
+}
+
+// GetSolidFireAccountIdRawWithContext is the same as GetSolidFireAccountIdRaw, but the request is cancelled when the context is done
+func (s *AccountService) GetSolidFireAccountIdRawWithContext(ctx context.Context, v url.Values) (*GetSolidFireAccountIdResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "getSolidFireAccountId", "getsolidfireaccountidresponse", v)
+	if err != nil {
+		return nil, err
 	}
 
 	var r GetSolidFireAccountIdResponse
@@ -943,6 +1679,16 @@ type ListAccountsParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ListAccountsParams) Clone() *ListAccountsParams {
+	c := &ListAccountsParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListAccountsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -998,6 +1744,13 @@ func (p *ListAccountsParams) SetAccounttype(v int64) {
 	return
 }
 
+// SetAccounttypeIfUnset sets accounttype to v, unless it is already set
+func (p *ListAccountsParams) SetAccounttypeIfUnset(v int64) {
+	if _, found := p.p["accounttype"]; !found {
+		p.SetAccounttype(v)
+	}
+}
+
 func (p *ListAccountsParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1006,6 +1759,13 @@ func (p *ListAccountsParams) SetDomainid(v string) {
 	return
 }
 
+// SetDomainidIfUnset sets domainid to v, unless it is already set
+func (p *ListAccountsParams) SetDomainidIfUnset(v string) {
+	if _, found := p.p["domainid"]; !found {
+		p.SetDomainid(v)
+	}
+}
+
 func (p *ListAccountsParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1014,6 +1774,13 @@ func (p *ListAccountsParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *ListAccountsParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 func (p *ListAccountsParams) SetIscleanuprequired(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1022,6 +1789,13 @@ func (p *ListAccountsParams) SetIscleanuprequired(v bool) {
 	return
 }
 
+// SetIscleanuprequiredIfUnset sets iscleanuprequired to v, unless it is already set
+func (p *ListAccountsParams) SetIscleanuprequiredIfUnset(v bool) {
+	if _, found := p.p["iscleanuprequired"]; !found {
+		p.SetIscleanuprequired(v)
+	}
+}
+
 func (p *ListAccountsParams) SetIsrecursive(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1030,6 +1804,13 @@ func (p *ListAccountsParams) SetIsrecursive(v bool) {
 	return
 }
 
+// SetIsrecursiveIfUnset sets isrecursive to v, unless it is already set
+func (p *ListAccountsParams) SetIsrecursiveIfUnset(v bool) {
+	if _, found := p.p["isrecursive"]; !found {
+		p.SetIsrecursive(v)
+	}
+}
+
 func (p *ListAccountsParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1038,6 +1819,13 @@ func (p *ListAccountsParams) SetKeyword(v string) {
 	return
 }
 
+// SetKeywordIfUnset sets keyword to v, unless it is already set
+func (p *ListAccountsParams) SetKeywordIfUnset(v string) {
+	if _, found := p.p["keyword"]; !found {
+		p.SetKeyword(v)
+	}
+}
+
 func (p *ListAccountsParams) SetListall(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1046,6 +1834,13 @@ func (p *ListAccountsParams) SetListall(v bool) {
 	return
 }
 
+// SetListallIfUnset sets listall to v, unless it is already set
+func (p *ListAccountsParams) SetListallIfUnset(v bool) {
+	if _, found := p.p["listall"]; !found {
+		p.SetListall(v)
+	}
+}
+
 func (p *ListAccountsParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1054,6 +1849,13 @@ func (p *ListAccountsParams) SetName(v string) {
 	return
 }
 
+// SetNameIfUnset sets name to v, unless it is already set
+func (p *ListAccountsParams) SetNameIfUnset(v string) {
+	if _, found := p.p["name"]; !found {
+		p.SetName(v)
+	}
+}
+
 func (p *ListAccountsParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1062,6 +1864,13 @@ func (p *ListAccountsParams) SetPage(v int) {
 	return
 }
 
+// SetPageIfUnset sets page to v, unless it is already set
+func (p *ListAccountsParams) SetPageIfUnset(v int) {
+	if _, found := p.p["page"]; !found {
+		p.SetPage(v)
+	}
+}
+
 func (p *ListAccountsParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1070,6 +1879,13 @@ func (p *ListAccountsParams) SetPagesize(v int) {
 	return
 }
 
+// SetPagesizeIfUnset sets pagesize to v, unless it is already set
+func (p *ListAccountsParams) SetPagesizeIfUnset(v int) {
+	if _, found := p.p["pagesize"]; !found {
+		p.SetPagesize(v)
+	}
+}
+
 func (p *ListAccountsParams) SetState(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1078,14 +1894,34 @@ func (p *ListAccountsParams) SetState(v string) {
 	return
 }
 
+// SetStateIfUnset sets state to v, unless it is already set
+func (p *ListAccountsParams) SetStateIfUnset(v string) {
+	if _, found := p.p["state"]; !found {
+		p.SetState(v)
+	}
+}
+
+var _ ListAllSetter = (*ListAccountsParams)(nil)
+
 // You should always use this function to get a new ListAccountsParams instance,
-// as then you are sure you have configured all required params
-func (s *AccountService) NewListAccountsParams() *ListAccountsParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *AccountService) NewListAccountsParams(opts ...func(*ListAccountsParams)) *ListAccountsParams {
 	p := &ListAccountsParams{}
 	p.p = make(map[string]interface{})
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
+func (p *ListAccountsParams) setFirstMatch() {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p[firstMatchKey] = true
+}
+
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *AccountService) GetAccountID(name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListAccountsParams{}
@@ -1093,7 +1929,7 @@ func (s *AccountService) GetAccountID(name string, opts ...OptionFunc) (string,
 
 	p.p["name"] = name
 
-	for _, fn := range append(s.cs.options, opts...) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
 		if err := fn(s.cs, p); err != nil {
 			return "", -1, err
 		}
@@ -1105,7 +1941,7 @@ func (s *AccountService) GetAccountID(name string, opts ...OptionFunc) (string,
 	}
 
 	if l.Count == 0 {
-		return "", l.Count, fmt.Errorf("No match found for %s: %+v", name, l)
+		return "", l.Count, fmt.Errorf("%w for %s: %+v", ErrNotFound, name, l)
 	}
 
 	if l.Count == 1 {
@@ -1118,6 +1954,10 @@ func (s *AccountService) GetAccountID(name string, opts ...OptionFunc) (string,
 				return v.Id, l.Count, nil
 			}
 		}
+
+		if _, ok := p.p[firstMatchKey]; ok && len(l.Accounts) > 0 {
+			return l.Accounts[0].Id, l.Count, nil
+		}
 	}
 	return "", l.Count, fmt.Errorf("Could not find an exact match for %s: %+v", name, l)
 }
@@ -1143,7 +1983,7 @@ func (s *AccountService) GetAccountByID(id string, opts ...OptionFunc) (*Account
 
 	p.p["id"] = id
 
-	for _, fn := range append(s.cs.options, opts...) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
 		if err := fn(s.cs, p); err != nil {
 			return nil, -1, err
 		}
@@ -1154,24 +1994,56 @@ func (s *AccountService) GetAccountByID(id string, opts ...OptionFunc) (*Account
 		if strings.Contains(err.Error(), fmt.Sprintf(
 			"Invalid parameter id value=%s due to incorrect long value format, "+
 				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+			return nil, 0, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
 	}
 
 	if l.Count == 1 {
 		return l.Accounts[0], l.Count, nil
 	}
+
+	if _, ok := p.p[firstMatchKey]; ok && len(l.Accounts) > 0 {
+		return l.Accounts[0], l.Count, nil
+	}
 	return nil, l.Count, fmt.Errorf("There is more then one result for Account UUID: %s!", id)
 }
 
+// AccountExists returns true if a Account with the given ID exists. When no match is found
+// it returns false, while any other error is returned as is.
+func (s *AccountService) AccountExists(id string, opts ...OptionFunc) (bool, error) {
+	_, count, err := s.GetAccountByID(id, opts...)
+	if errors.Is(err, ErrNotFound) {
+		return false, nil
+	}
+	if count > 0 {
+		return true, nil
+	}
+	return false, err
+}
+
 // Lists accounts and provides detailed account information for listed accounts
 func (s *AccountService) ListAccounts(p *ListAccountsParams) (*ListAccountsResponse, error) {
-	resp, err := s.cs.newRequest("listAccounts", p.toURLValues())
+	return s.ListAccountsWithContext(context.Background(), p)
+}
+
+// ListAccountsWithContext is the same as ListAccounts, but the request is cancelled when the context is done
+func (s *AccountService) ListAccountsWithContext(ctx context.Context, p *ListAccountsParams) (*ListAccountsResponse, error) {
+	return s.ListAccountsRawWithContext(ctx, p.toURLValues())
+}
+
+// ListAccountsRaw is the same as ListAccounts, but takes the params as url.Values instead of typed params
+func (s *AccountService) ListAccountsRaw(v url.Values) (*ListAccountsResponse, error) {
+	return s.ListAccountsRawWithContext(context.Background(), v)
+}
+
+// ListAccountsRawWithContext is the same as ListAccountsRaw, but the request is cancelled when the context is done
+func (s *AccountService) ListAccountsRawWithContext(ctx context.Context, v url.Values) (*ListAccountsResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "listAccounts", "listaccountsresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -1184,56 +2056,143 @@ func (s *AccountService) ListAccounts(p *ListAccountsParams) (*ListAccountsRespo
 	return &r, nil
 }
 
+// StreamAccounts fetches the results of ListAccounts page by page in the background and sends them on the
+// returned channel. Both returned channels are closed when done. If fetching a page fails, or the context
+// is done before all results are sent, the error is sent on the error channel and fetching stops. A
+// request for a page in flight is cancelled when the context is done. The page and pagesize set in the
+// params are ignored.
+func (s *AccountService) StreamAccounts(ctx context.Context, p *ListAccountsParams) (<-chan *Account, <-chan error) {
+	ch := make(chan *Account)
+	errs := make(chan error, 1)
+
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesize(500)
+
+	go func() {
+		defer close(errs)
+		defer close(ch)
+
+		for page, count := 1, 0; ; page++ {
+			pp.SetPage(page)
+
+			l, err := s.ListAccountsWithContext(ctx, pp)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			for _, v := range l.Accounts {
+				select {
+				case ch <- v:
+				case <-ctx.Done():
+					errs <- ctx.Err()
+					return
+				}
+			}
+
+			count += len(l.Accounts)
+			if len(l.Accounts) < 500 || count >= l.Count {
+				return
+			}
+		}
+	}()
+
+	return ch, errs
+}
+
+// ListAccountsWhere returns all results of ListAccounts for which pred returns true. Note that the results are
+// filtered client-side, so all results matching the params are fetched first.
+// All pages are fetched, so the page and pagesize set in the params are ignored.
+func (s *AccountService) ListAccountsWhere(p *ListAccountsParams, pred func(*Account) bool) ([]*Account, error) {
+	var r []*Account
+	ch, errs := s.StreamAccounts(context.Background(), p)
+	for v := range ch {
+		if pred(v) {
+			r = append(r, v)
+		}
+	}
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ListAllAccounts returns the results of ListAccounts across all pages, by calling ListAccounts page by page until all
+// results are fetched. The pagesize set in the params is used for every page (500 if not set), and the
+// page set in the params is ignored.
+func (s *AccountService) ListAllAccounts(p *ListAccountsParams) ([]*Account, error) {
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesizeIfUnset(500)
+	pagesize := pp.p["pagesize"].(int)
+
+	var r []*Account
+	for page := 1; ; page++ {
+		pp.SetPage(page)
+
+		l, err := s.ListAccounts(pp)
+		if err != nil {
+			return nil, err
+		}
+		r = append(r, l.Accounts...)
+
+		if len(l.Accounts) < pagesize || len(r) >= l.Count {
+			return r, nil
+		}
+	}
+}
+
 type ListAccountsResponse struct {
 	Count    int        `json:"count"`
 	Accounts []*Account `json:"account"`
 }
 
 type Account struct {
-	Accountdetails            map[string]string `json:"accountdetails"`
-	Accounttype               int               `json:"accounttype"`
-	Cpuavailable              string            `json:"cpuavailable"`
-	Cpulimit                  string            `json:"cpulimit"`
-	Cputotal                  int64             `json:"cputotal"`
-	Defaultzoneid             string            `json:"defaultzoneid"`
-	Domain                    string            `json:"domain"`
-	Domainid                  string            `json:"domainid"`
-	Groups                    []string          `json:"groups"`
-	Id                        string            `json:"id"`
-	Ipavailable               string            `json:"ipavailable"`
-	Iplimit                   string            `json:"iplimit"`
-	Iptotal                   int64             `json:"iptotal"`
-	Iscleanuprequired         bool              `json:"iscleanuprequired"`
-	Isdefault                 bool              `json:"isdefault"`
-	Memoryavailable           string            `json:"memoryavailable"`
-	Memorylimit               string            `json:"memorylimit"`
-	Memorytotal               int64             `json:"memorytotal"`
-	Name                      string            `json:"name"`
-	Networkavailable          string            `json:"networkavailable"`
-	Networkdomain             string            `json:"networkdomain"`
-	Networklimit              string            `json:"networklimit"`
-	Networktotal              int64             `json:"networktotal"`
-	Primarystorageavailable   string            `json:"primarystorageavailable"`
-	Primarystoragelimit       string            `json:"primarystoragelimit"`
-	Primarystoragetotal       int64             `json:"primarystoragetotal"`
-	Projectavailable          string            `json:"projectavailable"`
-	Projectlimit              string            `json:"projectlimit"`
-	Projecttotal              int64             `json:"projecttotal"`
-	Receivedbytes             int64             `json:"receivedbytes"`
-	Roleid                    string            `json:"roleid"`
-	Rolename                  string            `json:"rolename"`
-	Roletype                  string            `json:"roletype"`
-	Secondarystorageavailable string            `json:"secondarystorageavailable"`
-	Secondarystoragelimit     string            `json:"secondarystoragelimit"`
-	Secondarystoragetotal     int64             `json:"secondarystoragetotal"`
-	Sentbytes                 int64             `json:"sentbytes"`
-	Snapshotavailable         string            `json:"snapshotavailable"`
-	Snapshotlimit             string            `json:"snapshotlimit"`
-	Snapshottotal             int64             `json:"snapshottotal"`
-	State                     string            `json:"state"`
-	Templateavailable         string            `json:"templateavailable"`
-	Templatelimit             string            `json:"templatelimit"`
-	Templatetotal             int64             `json:"templatetotal"`
+	Accountdetails            StringMap `json:"accountdetails"`
+	Accounttype               int       `json:"accounttype"`
+	Cpuavailable              string    `json:"cpuavailable"`
+	Cpulimit                  string    `json:"cpulimit"`
+	Cputotal                  int64     `json:"cputotal"`
+	Defaultzoneid             string    `json:"defaultzoneid"`
+	Domain                    string    `json:"domain"`
+	Domainid                  string    `json:"domainid"`
+	Groups                    []string  `json:"groups"`
+	Id                        string    `json:"id"`
+	Ipavailable               string    `json:"ipavailable"`
+	Iplimit                   string    `json:"iplimit"`
+	Iptotal                   int64     `json:"iptotal"`
+	Iscleanuprequired         bool      `json:"iscleanuprequired"`
+	Isdefault                 bool      `json:"isdefault"`
+	Memoryavailable           string    `json:"memoryavailable"`
+	Memorylimit               string    `json:"memorylimit"`
+	Memorytotal               int64     `json:"memorytotal"`
+	Name                      string    `json:"name"`
+	Networkavailable          string    `json:"networkavailable"`
+	Networkdomain             string    `json:"networkdomain"`
+	Networklimit              string    `json:"networklimit"`
+	Networktotal              int64     `json:"networktotal"`
+	Primarystorageavailable   string    `json:"primarystorageavailable"`
+	Primarystoragelimit       string    `json:"primarystoragelimit"`
+	Primarystoragetotal       int64     `json:"primarystoragetotal"`
+	Projectavailable          string    `json:"projectavailable"`
+	Projectlimit              string    `json:"projectlimit"`
+	Projecttotal              int64     `json:"projecttotal"`
+	Receivedbytes             int64     `json:"receivedbytes"`
+	Roleid                    string    `json:"roleid"`
+	Rolename                  string    `json:"rolename"`
+	Roletype                  string    `json:"roletype"`
+	Secondarystorageavailable string    `json:"secondarystorageavailable"`
+	Secondarystoragelimit     string    `json:"secondarystoragelimit"`
+	Secondarystoragetotal     int64     `json:"secondarystoragetotal"`
+	Sentbytes                 int64     `json:"sentbytes"`
+	Snapshotavailable         string    `json:"snapshotavailable"`
+	Snapshotlimit             string    `json:"snapshotlimit"`
+	Snapshottotal             int64     `json:"snapshottotal"`
+	State                     string    `json:"state"`
+	Templateavailable         string    `json:"templateavailable"`
+	Templatelimit             string    `json:"templatelimit"`
+	Templatetotal             int64     `json:"templatetotal"`
 	User                      []struct {
 		Account             string `json:"account"`
 		Accountid           string `json:"accountid"`
@@ -1273,6 +2232,16 @@ type ListProjectAccountsParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ListProjectAccountsParams) Clone() *ListProjectAccountsParams {
+	c := &ListProjectAccountsParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListProjectAccountsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1301,6 +2270,16 @@ func (p *ListProjectAccountsParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *ListProjectAccountsParams) validate() error {
+	for _, k := range []string{"projectid"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of listProjectAccounts", k)
+		}
+	}
+	return nil
+}
+
 func (p *ListProjectAccountsParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1309,6 +2288,13 @@ func (p *ListProjectAccountsParams) SetAccount(v string) {
 	return
 }
 
+// SetAccountIfUnset sets account to v, unless it is already set
+func (p *ListProjectAccountsParams) SetAccountIfUnset(v string) {
+	if _, found := p.p["account"]; !found {
+		p.SetAccount(v)
+	}
+}
+
 func (p *ListProjectAccountsParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1317,6 +2303,13 @@ func (p *ListProjectAccountsParams) SetKeyword(v string) {
 	return
 }
 
+// SetKeywordIfUnset sets keyword to v, unless it is already set
+func (p *ListProjectAccountsParams) SetKeywordIfUnset(v string) {
+	if _, found := p.p["keyword"]; !found {
+		p.SetKeyword(v)
+	}
+}
+
 func (p *ListProjectAccountsParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1325,6 +2318,13 @@ func (p *ListProjectAccountsParams) SetPage(v int) {
 	return
 }
 
+// SetPageIfUnset sets page to v, unless it is already set
+func (p *ListProjectAccountsParams) SetPageIfUnset(v int) {
+	if _, found := p.p["page"]; !found {
+		p.SetPage(v)
+	}
+}
+
 func (p *ListProjectAccountsParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1333,6 +2333,13 @@ func (p *ListProjectAccountsParams) SetPagesize(v int) {
 	return
 }
 
+// SetPagesizeIfUnset sets pagesize to v, unless it is already set
+func (p *ListProjectAccountsParams) SetPagesizeIfUnset(v int) {
+	if _, found := p.p["pagesize"]; !found {
+		p.SetPagesize(v)
+	}
+}
+
 func (p *ListProjectAccountsParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1341,6 +2348,13 @@ func (p *ListProjectAccountsParams) SetProjectid(v string) {
 	return
 }
 
+// SetProjectidIfUnset sets projectid to v, unless it is already set
+func (p *ListProjectAccountsParams) SetProjectidIfUnset(v string) {
+	if _, found := p.p["projectid"]; !found {
+		p.SetProjectid(v)
+	}
+}
+
 func (p *ListProjectAccountsParams) SetRole(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1349,15 +2363,33 @@ func (p *ListProjectAccountsParams) SetRole(v string) {
 	return
 }
 
+// SetRoleIfUnset sets role to v, unless it is already set
+func (p *ListProjectAccountsParams) SetRoleIfUnset(v string) {
+	if _, found := p.p["role"]; !found {
+		p.SetRole(v)
+	}
+}
+
 // You should always use this function to get a new ListProjectAccountsParams instance,
-// as then you are sure you have configured all required params
-func (s *AccountService) NewListProjectAccountsParams(projectid string) *ListProjectAccountsParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *AccountService) NewListProjectAccountsParams(projectid string, opts ...func(*ListProjectAccountsParams)) *ListProjectAccountsParams {
 	p := &ListProjectAccountsParams{}
 	p.p = make(map[string]interface{})
 	p.p["projectid"] = projectid
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
+func (p *ListProjectAccountsParams) setFirstMatch() {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p[firstMatchKey] = true
+}
+
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *AccountService) GetProjectAccountID(keyword string, projectid string, opts ...OptionFunc) (string, int, error) {
 	p := &ListProjectAccountsParams{}
@@ -1366,7 +2398,7 @@ func (s *AccountService) GetProjectAccountID(keyword string, projectid string, o
 	p.p["keyword"] = keyword
 	p.p["projectid"] = projectid
 
-	for _, fn := range append(s.cs.options, opts...) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
 		if err := fn(s.cs, p); err != nil {
 			return "", -1, err
 		}
@@ -1378,7 +2410,7 @@ func (s *AccountService) GetProjectAccountID(keyword string, projectid string, o
 	}
 
 	if l.Count == 0 {
-		return "", l.Count, fmt.Errorf("No match found for %s: %+v", keyword, l)
+		return "", l.Count, fmt.Errorf("%w for %s: %+v", ErrNotFound, keyword, l)
 	}
 
 	if l.Count == 1 {
@@ -1391,13 +2423,36 @@ func (s *AccountService) GetProjectAccountID(keyword string, projectid string, o
 				return v.Id, l.Count, nil
 			}
 		}
+
+		if _, ok := p.p[firstMatchKey]; ok && len(l.ProjectAccounts) > 0 {
+			return l.ProjectAccounts[0].Id, l.Count, nil
+		}
 	}
 	return "", l.Count, fmt.Errorf("Could not find an exact match for %s: %+v", keyword, l)
 }
 
 // Lists project's accounts
 func (s *AccountService) ListProjectAccounts(p *ListProjectAccountsParams) (*ListProjectAccountsResponse, error) {
-	resp, err := s.cs.newRequest("listProjectAccounts", p.toURLValues())
+	return s.ListProjectAccountsWithContext(context.Background(), p)
+}
+
+// ListProjectAccountsWithContext is the same as ListProjectAccounts, but the request is cancelled when the context is done
+func (s *AccountService) ListProjectAccountsWithContext(ctx context.Context, p *ListProjectAccountsParams) (*ListProjectAccountsResponse, error) {
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.ListProjectAccountsRawWithContext(ctx, p.toURLValues())
+}
+
+// ListProjectAccountsRaw is the same as ListProjectAccounts, but takes the params as url.Values instead of typed params
+func (s *AccountService) ListProjectAccountsRaw(v url.Values) (*ListProjectAccountsResponse, error) {
+	return s.ListProjectAccountsRawWithContext(context.Background(), v)
+}
+
+// ListProjectAccountsRawWithContext is the same as ListProjectAccountsRaw, but the request is cancelled when the context is done
+func (s *AccountService) ListProjectAccountsRawWithContext(ctx context.Context, v url.Values) (*ListProjectAccountsResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "listProjectAccounts", "listprojectaccountsresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -1410,6 +2465,93 @@ func (s *AccountService) ListProjectAccounts(p *ListProjectAccountsParams) (*Lis
 	return &r, nil
 }
 
+// StreamProjectAccounts fetches the results of ListProjectAccounts page by page in the background and sends them on the
+// returned channel. Both returned channels are closed when done. If fetching a page fails, or the context
+// is done before all results are sent, the error is sent on the error channel and fetching stops. A
+// request for a page in flight is cancelled when the context is done. The page and pagesize set in the
+// params are ignored.
+func (s *AccountService) StreamProjectAccounts(ctx context.Context, p *ListProjectAccountsParams) (<-chan *ProjectAccount, <-chan error) {
+	ch := make(chan *ProjectAccount)
+	errs := make(chan error, 1)
+
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesize(500)
+
+	go func() {
+		defer close(errs)
+		defer close(ch)
+
+		for page, count := 1, 0; ; page++ {
+			pp.SetPage(page)
+
+			l, err := s.ListProjectAccountsWithContext(ctx, pp)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			for _, v := range l.ProjectAccounts {
+				select {
+				case ch <- v:
+				case <-ctx.Done():
+					errs <- ctx.Err()
+					return
+				}
+			}
+
+			count += len(l.ProjectAccounts)
+			if len(l.ProjectAccounts) < 500 || count >= l.Count {
+				return
+			}
+		}
+	}()
+
+	return ch, errs
+}
+
+// ListProjectAccountsWhere returns all results of ListProjectAccounts for which pred returns true. Note that the results are
+// filtered client-side, so all results matching the params are fetched first.
+// All pages are fetched, so the page and pagesize set in the params are ignored.
+func (s *AccountService) ListProjectAccountsWhere(p *ListProjectAccountsParams, pred func(*ProjectAccount) bool) ([]*ProjectAccount, error) {
+	var r []*ProjectAccount
+	ch, errs := s.StreamProjectAccounts(context.Background(), p)
+	for v := range ch {
+		if pred(v) {
+			r = append(r, v)
+		}
+	}
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ListAllProjectAccounts returns the results of ListProjectAccounts across all pages, by calling ListProjectAccounts page by page until all
+// results are fetched. The pagesize set in the params is used for every page (500 if not set), and the
+// page set in the params is ignored.
+func (s *AccountService) ListAllProjectAccounts(p *ListProjectAccountsParams) ([]*ProjectAccount, error) {
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesizeIfUnset(500)
+	pagesize := pp.p["pagesize"].(int)
+
+	var r []*ProjectAccount
+	for page := 1; ; page++ {
+		pp.SetPage(page)
+
+		l, err := s.ListProjectAccounts(pp)
+		if err != nil {
+			return nil, err
+		}
+		r = append(r, l.ProjectAccounts...)
+
+		if len(l.ProjectAccounts) < pagesize || len(r) >= l.Count {
+			return r, nil
+		}
+	}
+}
+
 type ListProjectAccountsResponse struct {
 	Count           int               `json:"count"`
 	ProjectAccounts []*ProjectAccount `json:"projectaccount"`
@@ -1476,6 +2618,16 @@ type LockAccountParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *LockAccountParams) Clone() *LockAccountParams {
+	c := &LockAccountParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *LockAccountParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1490,6 +2642,16 @@ func (p *LockAccountParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *LockAccountParams) validate() error {
+	for _, k := range []string{"account", "domainid"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of lockAccount", k)
+		}
+	}
+	return nil
+}
+
 func (p *LockAccountParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1498,6 +2660,13 @@ func (p *LockAccountParams) SetAccount(v string) {
 	return
 }
 
+// SetAccountIfUnset sets account to v, unless it is already set
+func (p *LockAccountParams) SetAccountIfUnset(v string) {
+	if _, found := p.p["account"]; !found {
+		p.SetAccount(v)
+	}
+}
+
 func (p *LockAccountParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1506,19 +2675,55 @@ func (p *LockAccountParams) SetDomainid(v string) {
 	return
 }
 
+// SetDomainidIfUnset sets domainid to v, unless it is already set
+func (p *LockAccountParams) SetDomainidIfUnset(v string) {
+	if _, found := p.p["domainid"]; !found {
+		p.SetDomainid(v)
+	}
+}
+
 // You should always use this function to get a new LockAccountParams instance,
-// as then you are sure you have configured all required params
-func (s *AccountService) NewLockAccountParams(account string, domainid string) *LockAccountParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *AccountService) NewLockAccountParams(account string, domainid string, opts ...func(*LockAccountParams)) *LockAccountParams {
 	p := &LockAccountParams{}
 	p.p = make(map[string]interface{})
 	p.p["account"] = account
 	p.p["domainid"] = domainid
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // This deprecated function used to locks an account. Look for the API DisableAccount instead
-func (s *AccountService) LockAccount(p *LockAccountParams) (*LockAccountResponse, error) {
-	resp, err := s.cs.newRequest("lockAccount", p.toURLValues())
+func (s *AccountService) LockAccount(p *LockAccountParams, opts ...OptionFunc) (*LockAccountResponse, error) {
+	return s.LockAccountWithContext(context.Background(), p, opts...)
+}
+
+// LockAccountWithContext is the same as LockAccount, but the request is cancelled when the context is done
+func (s *AccountService) LockAccountWithContext(ctx context.Context, p *LockAccountParams, opts ...OptionFunc) (*LockAccountResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.LockAccountRawWithContext(ctx, p.toURLValues())
+}
+
+// LockAccountRaw is the same as LockAccount, but takes the params as url.Values instead of typed params
+func (s *AccountService) LockAccountRaw(v url.Values) (*LockAccountResponse, error) {
+	return s.LockAccountRawWithContext(context.Background(), v)
+}
+
+// LockAccountRawWithContext is the same as LockAccountRaw, but the request is cancelled when the context is done
+func (s *AccountService) LockAccountRawWithContext(ctx context.Context, v url.Values) (*LockAccountResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "lockAccount", "lockaccountresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -1532,50 +2737,50 @@ func (s *AccountService) LockAccount(p *LockAccountParams) (*LockAccountResponse
 }
 
 type LockAccountResponse struct {
-	Accountdetails            map[string]string `json:"accountdetails"`
-	Accounttype               int               `json:"accounttype"`
-	Cpuavailable              string            `json:"cpuavailable"`
-	Cpulimit                  string            `json:"cpulimit"`
-	Cputotal                  int64             `json:"cputotal"`
-	Defaultzoneid             string            `json:"defaultzoneid"`
-	Domain                    string            `json:"domain"`
-	Domainid                  string            `json:"domainid"`
-	Groups                    []string          `json:"groups"`
-	Id                        string            `json:"id"`
-	Ipavailable               string            `json:"ipavailable"`
-	Iplimit                   string            `json:"iplimit"`
-	Iptotal                   int64             `json:"iptotal"`
-	Iscleanuprequired         bool              `json:"iscleanuprequired"`
-	Isdefault                 bool              `json:"isdefault"`
-	Memoryavailable           string            `json:"memoryavailable"`
-	Memorylimit               string            `json:"memorylimit"`
-	Memorytotal               int64             `json:"memorytotal"`
-	Name                      string            `json:"name"`
-	Networkavailable          string            `json:"networkavailable"`
-	Networkdomain             string            `json:"networkdomain"`
-	Networklimit              string            `json:"networklimit"`
-	Networktotal              int64             `json:"networktotal"`
-	Primarystorageavailable   string            `json:"primarystorageavailable"`
-	Primarystoragelimit       string            `json:"primarystoragelimit"`
-	Primarystoragetotal       int64             `json:"primarystoragetotal"`
-	Projectavailable          string            `json:"projectavailable"`
-	Projectlimit              string            `json:"projectlimit"`
-	Projecttotal              int64             `json:"projecttotal"`
-	Receivedbytes             int64             `json:"receivedbytes"`
-	Roleid                    string            `json:"roleid"`
-	Rolename                  string            `json:"rolename"`
-	Roletype                  string            `json:"roletype"`
-	Secondarystorageavailable string            `json:"secondarystorageavailable"`
-	Secondarystoragelimit     string            `json:"secondarystoragelimit"`
-	Secondarystoragetotal     int64             `json:"secondarystoragetotal"`
-	Sentbytes                 int64             `json:"sentbytes"`
-	Snapshotavailable         string            `json:"snapshotavailable"`
-	Snapshotlimit             string            `json:"snapshotlimit"`
-	Snapshottotal             int64             `json:"snapshottotal"`
-	State                     string            `json:"state"`
-	Templateavailable         string            `json:"templateavailable"`
-	Templatelimit             string            `json:"templatelimit"`
-	Templatetotal             int64             `json:"templatetotal"`
+	Accountdetails            StringMap `json:"accountdetails"`
+	Accounttype               int       `json:"accounttype"`
+	Cpuavailable              string    `json:"cpuavailable"`
+	Cpulimit                  string    `json:"cpulimit"`
+	Cputotal                  int64     `json:"cputotal"`
+	Defaultzoneid             string    `json:"defaultzoneid"`
+	Domain                    string    `json:"domain"`
+	Domainid                  string    `json:"domainid"`
+	Groups                    []string  `json:"groups"`
+	Id                        string    `json:"id"`
+	Ipavailable               string    `json:"ipavailable"`
+	Iplimit                   string    `json:"iplimit"`
+	Iptotal                   int64     `json:"iptotal"`
+	Iscleanuprequired         bool      `json:"iscleanuprequired"`
+	Isdefault                 bool      `json:"isdefault"`
+	Memoryavailable           string    `json:"memoryavailable"`
+	Memorylimit               string    `json:"memorylimit"`
+	Memorytotal               int64     `json:"memorytotal"`
+	Name                      string    `json:"name"`
+	Networkavailable          string    `json:"networkavailable"`
+	Networkdomain             string    `json:"networkdomain"`
+	Networklimit              string    `json:"networklimit"`
+	Networktotal              int64     `json:"networktotal"`
+	Primarystorageavailable   string    `json:"primarystorageavailable"`
+	Primarystoragelimit       string    `json:"primarystoragelimit"`
+	Primarystoragetotal       int64     `json:"primarystoragetotal"`
+	Projectavailable          string    `json:"projectavailable"`
+	Projectlimit              string    `json:"projectlimit"`
+	Projecttotal              int64     `json:"projecttotal"`
+	Receivedbytes             int64     `json:"receivedbytes"`
+	Roleid                    string    `json:"roleid"`
+	Rolename                  string    `json:"rolename"`
+	Roletype                  string    `json:"roletype"`
+	Secondarystorageavailable string    `json:"secondarystorageavailable"`
+	Secondarystoragelimit     string    `json:"secondarystoragelimit"`
+	Secondarystoragetotal     int64     `json:"secondarystoragetotal"`
+	Sentbytes                 int64     `json:"sentbytes"`
+	Snapshotavailable         string    `json:"snapshotavailable"`
+	Snapshotlimit             string    `json:"snapshotlimit"`
+	Snapshottotal             int64     `json:"snapshottotal"`
+	State                     string    `json:"state"`
+	Templateavailable         string    `json:"templateavailable"`
+	Templatelimit             string    `json:"templatelimit"`
+	Templatetotal             int64     `json:"templatetotal"`
 	User                      []struct {
 		Account             string `json:"account"`
 		Accountid           string `json:"accountid"`
@@ -1615,6 +2820,16 @@ type MarkDefaultZoneForAccountParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *MarkDefaultZoneForAccountParams) Clone() *MarkDefaultZoneForAccountParams {
+	c := &MarkDefaultZoneForAccountParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *MarkDefaultZoneForAccountParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1632,6 +2847,16 @@ func (p *MarkDefaultZoneForAccountParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *MarkDefaultZoneForAccountParams) validate() error {
+	for _, k := range []string{"account", "domainid", "zoneid"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of markDefaultZoneForAccount", k)
+		}
+	}
+	return nil
+}
+
 func (p *MarkDefaultZoneForAccountParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1640,6 +2865,13 @@ func (p *MarkDefaultZoneForAccountParams) SetAccount(v string) {
 	return
 }
 
+// SetAccountIfUnset sets account to v, unless it is already set
+func (p *MarkDefaultZoneForAccountParams) SetAccountIfUnset(v string) {
+	if _, found := p.p["account"]; !found {
+		p.SetAccount(v)
+	}
+}
+
 func (p *MarkDefaultZoneForAccountParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1648,6 +2880,13 @@ func (p *MarkDefaultZoneForAccountParams) SetDomainid(v string) {
 	return
 }
 
+// SetDomainidIfUnset sets domainid to v, unless it is already set
+func (p *MarkDefaultZoneForAccountParams) SetDomainidIfUnset(v string) {
+	if _, found := p.p["domainid"]; !found {
+		p.SetDomainid(v)
+	}
+}
+
 func (p *MarkDefaultZoneForAccountParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1656,20 +2895,71 @@ func (p *MarkDefaultZoneForAccountParams) SetZoneid(v string) {
 	return
 }
 
+// SetZoneidIfUnset sets zoneid to v, unless it is already set
+func (p *MarkDefaultZoneForAccountParams) SetZoneidIfUnset(v string) {
+	if _, found := p.p["zoneid"]; !found {
+		p.SetZoneid(v)
+	}
+}
+
 // You should always use this function to get a new MarkDefaultZoneForAccountParams instance,
-// as then you are sure you have configured all required params
-func (s *AccountService) NewMarkDefaultZoneForAccountParams(account string, domainid string, zoneid string) *MarkDefaultZoneForAccountParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *AccountService) NewMarkDefaultZoneForAccountParams(account string, domainid string, zoneid string, opts ...func(*MarkDefaultZoneForAccountParams)) *MarkDefaultZoneForAccountParams {
 	p := &MarkDefaultZoneForAccountParams{}
 	p.p = make(map[string]interface{})
 	p.p["account"] = account
 	p.p["domainid"] = domainid
 	p.p["zoneid"] = zoneid
+	for _, fn := range opts {
+		fn(p)
+	}
+	return p
+}
+
+// MarkDefaultZoneForAccountOpts contains all params that can be set on a MarkDefaultZoneForAccountParams.
+// Optional params are pointers (or slices and maps) which are only set when not nil.
+type MarkDefaultZoneForAccountOpts struct {
+	Account  string
+	Domainid string
+	Zoneid   string
+}
+
+// NewMarkDefaultZoneForAccountParamsFromOpts is an alternative for NewMarkDefaultZoneForAccountParams which takes all params using
+// an options struct, as that is easier to read for APIs with many required params
+func (s *AccountService) NewMarkDefaultZoneForAccountParamsFromOpts(o MarkDefaultZoneForAccountOpts) *MarkDefaultZoneForAccountParams {
+	p := s.NewMarkDefaultZoneForAccountParams(o.Account, o.Domainid, o.Zoneid)
 	return p
 }
 
 // Marks a default zone for this account
-func (s *AccountService) MarkDefaultZoneForAccount(p *MarkDefaultZoneForAccountParams) (*MarkDefaultZoneForAccountResponse, error) {
-	resp, err := s.cs.newRequest("markDefaultZoneForAccount", p.toURLValues())
+func (s *AccountService) MarkDefaultZoneForAccount(p *MarkDefaultZoneForAccountParams, opts ...OptionFunc) (*MarkDefaultZoneForAccountResponse, error) {
+	return s.MarkDefaultZoneForAccountWithContext(context.Background(), p, opts...)
+}
+
+// MarkDefaultZoneForAccountWithContext is the same as MarkDefaultZoneForAccount, but the request is cancelled when the context is done
+func (s *AccountService) MarkDefaultZoneForAccountWithContext(ctx context.Context, p *MarkDefaultZoneForAccountParams, opts ...OptionFunc) (*MarkDefaultZoneForAccountResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.MarkDefaultZoneForAccountRawWithContext(ctx, p.toURLValues())
+}
+
+// MarkDefaultZoneForAccountRaw is the same as MarkDefaultZoneForAccount, but takes the params as url.Values instead of typed params
+func (s *AccountService) MarkDefaultZoneForAccountRaw(v url.Values) (*MarkDefaultZoneForAccountResponse, error) {
+	return s.MarkDefaultZoneForAccountRawWithContext(context.Background(), v)
+}
+
+// MarkDefaultZoneForAccountRawWithContext is the same as MarkDefaultZoneForAccountRaw, but the request is cancelled when the context is done
+func (s *AccountService) MarkDefaultZoneForAccountRawWithContext(ctx context.Context, v url.Values) (*MarkDefaultZoneForAccountResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "markDefaultZoneForAccount", "markdefaultzoneforaccountresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -1681,9 +2971,9 @@ func (s *AccountService) MarkDefaultZoneForAccount(p *MarkDefaultZoneForAccountP
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -1703,51 +2993,51 @@ func (s *AccountService) MarkDefaultZoneForAccount(p *MarkDefaultZoneForAccountP
 }
 
 type MarkDefaultZoneForAccountResponse struct {
-	JobID                     string            `json:"jobid"`
-	Accountdetails            map[string]string `json:"accountdetails"`
-	Accounttype               int               `json:"accounttype"`
-	Cpuavailable              string            `json:"cpuavailable"`
-	Cpulimit                  string            `json:"cpulimit"`
-	Cputotal                  int64             `json:"cputotal"`
-	Defaultzoneid             string            `json:"defaultzoneid"`
-	Domain                    string            `json:"domain"`
-	Domainid                  string            `json:"domainid"`
-	Groups                    []string          `json:"groups"`
-	Id                        string            `json:"id"`
-	Ipavailable               string            `json:"ipavailable"`
-	Iplimit                   string            `json:"iplimit"`
-	Iptotal                   int64             `json:"iptotal"`
-	Iscleanuprequired         bool              `json:"iscleanuprequired"`
-	Isdefault                 bool              `json:"isdefault"`
-	Memoryavailable           string            `json:"memoryavailable"`
-	Memorylimit               string            `json:"memorylimit"`
-	Memorytotal               int64             `json:"memorytotal"`
-	Name                      string            `json:"name"`
-	Networkavailable          string            `json:"networkavailable"`
-	Networkdomain             string            `json:"networkdomain"`
-	Networklimit              string            `json:"networklimit"`
-	Networktotal              int64             `json:"networktotal"`
-	Primarystorageavailable   string            `json:"primarystorageavailable"`
-	Primarystoragelimit       string            `json:"primarystoragelimit"`
-	Primarystoragetotal       int64             `json:"primarystoragetotal"`
-	Projectavailable          string            `json:"projectavailable"`
-	Projectlimit              string            `json:"projectlimit"`
-	Projecttotal              int64             `json:"projecttotal"`
-	Receivedbytes             int64             `json:"receivedbytes"`
-	Roleid                    string            `json:"roleid"`
-	Rolename                  string            `json:"rolename"`
-	Roletype                  string            `json:"roletype"`
-	Secondarystorageavailable string            `json:"secondarystorageavailable"`
-	Secondarystoragelimit     string            `json:"secondarystoragelimit"`
-	Secondarystoragetotal     int64             `json:"secondarystoragetotal"`
-	Sentbytes                 int64             `json:"sentbytes"`
-	Snapshotavailable         string            `json:"snapshotavailable"`
-	Snapshotlimit             string            `json:"snapshotlimit"`
-	Snapshottotal             int64             `json:"snapshottotal"`
-	State                     string            `json:"state"`
-	Templateavailable         string            `json:"templateavailable"`
-	Templatelimit             string            `json:"templatelimit"`
-	Templatetotal             int64             `json:"templatetotal"`
+	JobID                     string    `json:"jobid"`
+	Accountdetails            StringMap `json:"accountdetails"`
+	Accounttype               int       `json:"accounttype"`
+	Cpuavailable              string    `json:"cpuavailable"`
+	Cpulimit                  string    `json:"cpulimit"`
+	Cputotal                  int64     `json:"cputotal"`
+	Defaultzoneid             string    `json:"defaultzoneid"`
+	Domain                    string    `json:"domain"`
+	Domainid                  string    `json:"domainid"`
+	Groups                    []string  `json:"groups"`
+	Id                        string    `json:"id"`
+	Ipavailable               string    `json:"ipavailable"`
+	Iplimit                   string    `json:"iplimit"`
+	Iptotal                   int64     `json:"iptotal"`
+	Iscleanuprequired         bool      `json:"iscleanuprequired"`
+	Isdefault                 bool      `json:"isdefault"`
+	Memoryavailable           string    `json:"memoryavailable"`
+	Memorylimit               string    `json:"memorylimit"`
+	Memorytotal               int64     `json:"memorytotal"`
+	Name                      string    `json:"name"`
+	Networkavailable          string    `json:"networkavailable"`
+	Networkdomain             string    `json:"networkdomain"`
+	Networklimit              string    `json:"networklimit"`
+	Networktotal              int64     `json:"networktotal"`
+	Primarystorageavailable   string    `json:"primarystorageavailable"`
+	Primarystoragelimit       string    `json:"primarystoragelimit"`
+	Primarystoragetotal       int64     `json:"primarystoragetotal"`
+	Projectavailable          string    `json:"projectavailable"`
+	Projectlimit              string    `json:"projectlimit"`
+	Projecttotal              int64     `json:"projecttotal"`
+	Receivedbytes             int64     `json:"receivedbytes"`
+	Roleid                    string    `json:"roleid"`
+	Rolename                  string    `json:"rolename"`
+	Roletype                  string    `json:"roletype"`
+	Secondarystorageavailable string    `json:"secondarystorageavailable"`
+	Secondarystoragelimit     string    `json:"secondarystoragelimit"`
+	Secondarystoragetotal     int64     `json:"secondarystoragetotal"`
+	Sentbytes                 int64     `json:"sentbytes"`
+	Snapshotavailable         string    `json:"snapshotavailable"`
+	Snapshotlimit             string    `json:"snapshotlimit"`
+	Snapshottotal             int64     `json:"snapshottotal"`
+	State                     string    `json:"state"`
+	Templateavailable         string    `json:"templateavailable"`
+	Templatelimit             string    `json:"templatelimit"`
+	Templatetotal             int64     `json:"templatetotal"`
 	User                      []struct {
 		Account             string `json:"account"`
 		Accountid           string `json:"accountid"`
@@ -1787,6 +3077,16 @@ type UpdateAccountParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *UpdateAccountParams) Clone() *UpdateAccountParams {
+	c := &UpdateAccountParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *UpdateAccountParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1796,11 +3096,10 @@ func (p *UpdateAccountParams) toURLValues() url.Values {
 		u.Set("account", v.(string))
 	}
 	if v, found := p.p["accountdetails"]; found {
-		i := 0
-		for k, vv := range v.(map[string]string) {
+		m := v.(map[string]string)
+		for i, k := range getSortedKeysFromMap(m) {
 			u.Set(fmt.Sprintf("accountdetails[%d].key", i), k)
-			u.Set(fmt.Sprintf("accountdetails[%d].value", i), vv)
-			i++
+			u.Set(fmt.Sprintf("accountdetails[%d].value", i), m[k])
 		}
 	}
 	if v, found := p.p["domainid"]; found {
@@ -1818,6 +3117,16 @@ func (p *UpdateAccountParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *UpdateAccountParams) validate() error {
+	for _, k := range []string{"newname"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of updateAccount", k)
+		}
+	}
+	return nil
+}
+
 func (p *UpdateAccountParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1826,6 +3135,13 @@ func (p *UpdateAccountParams) SetAccount(v string) {
 	return
 }
 
+// SetAccountIfUnset sets account to v, unless it is already set
+func (p *UpdateAccountParams) SetAccountIfUnset(v string) {
+	if _, found := p.p["account"]; !found {
+		p.SetAccount(v)
+	}
+}
+
 func (p *UpdateAccountParams) SetAccountdetails(v map[string]string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1834,6 +3150,13 @@ func (p *UpdateAccountParams) SetAccountdetails(v map[string]string) {
 	return
 }
 
+// SetAccountdetailsIfUnset sets accountdetails to v, unless it is already set
+func (p *UpdateAccountParams) SetAccountdetailsIfUnset(v map[string]string) {
+	if _, found := p.p["accountdetails"]; !found {
+		p.SetAccountdetails(v)
+	}
+}
+
 func (p *UpdateAccountParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1842,6 +3165,13 @@ func (p *UpdateAccountParams) SetDomainid(v string) {
 	return
 }
 
+// SetDomainidIfUnset sets domainid to v, unless it is already set
+func (p *UpdateAccountParams) SetDomainidIfUnset(v string) {
+	if _, found := p.p["domainid"]; !found {
+		p.SetDomainid(v)
+	}
+}
+
 func (p *UpdateAccountParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1850,6 +3180,13 @@ func (p *UpdateAccountParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *UpdateAccountParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 func (p *UpdateAccountParams) SetNetworkdomain(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1858,6 +3195,13 @@ func (p *UpdateAccountParams) SetNetworkdomain(v string) {
 	return
 }
 
+// SetNetworkdomainIfUnset sets networkdomain to v, unless it is already set
+func (p *UpdateAccountParams) SetNetworkdomainIfUnset(v string) {
+	if _, found := p.p["networkdomain"]; !found {
+		p.SetNetworkdomain(v)
+	}
+}
+
 func (p *UpdateAccountParams) SetNewname(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1866,18 +3210,54 @@ func (p *UpdateAccountParams) SetNewname(v string) {
 	return
 }
 
+// SetNewnameIfUnset sets newname to v, unless it is already set
+func (p *UpdateAccountParams) SetNewnameIfUnset(v string) {
+	if _, found := p.p["newname"]; !found {
+		p.SetNewname(v)
+	}
+}
+
 // You should always use this function to get a new UpdateAccountParams instance,
-// as then you are sure you have configured all required params
-func (s *AccountService) NewUpdateAccountParams(newname string) *UpdateAccountParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *AccountService) NewUpdateAccountParams(newname string, opts ...func(*UpdateAccountParams)) *UpdateAccountParams {
 	p := &UpdateAccountParams{}
 	p.p = make(map[string]interface{})
 	p.p["newname"] = newname
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Updates account information for the authenticated user
-func (s *AccountService) UpdateAccount(p *UpdateAccountParams) (*UpdateAccountResponse, error) {
-	resp, err := s.cs.newRequest("updateAccount", p.toURLValues())
+func (s *AccountService) UpdateAccount(p *UpdateAccountParams, opts ...OptionFunc) (*UpdateAccountResponse, error) {
+	return s.UpdateAccountWithContext(context.Background(), p, opts...)
+}
+
+// UpdateAccountWithContext is the same as UpdateAccount, but the request is cancelled when the context is done
+func (s *AccountService) UpdateAccountWithContext(ctx context.Context, p *UpdateAccountParams, opts ...OptionFunc) (*UpdateAccountResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.UpdateAccountRawWithContext(ctx, p.toURLValues())
+}
+
+// UpdateAccountRaw is the same as UpdateAccount, but takes the params as url.Values instead of typed params
+func (s *AccountService) UpdateAccountRaw(v url.Values) (*UpdateAccountResponse, error) {
+	return s.UpdateAccountRawWithContext(context.Background(), v)
+}
+
+// UpdateAccountRawWithContext is the same as UpdateAccountRaw, but the request is cancelled when the context is done
+func (s *AccountService) UpdateAccountRawWithContext(ctx context.Context, v url.Values) (*UpdateAccountResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "updateAccount", "updateaccountresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -1891,50 +3271,50 @@ func (s *AccountService) UpdateAccount(p *UpdateAccountParams) (*UpdateAccountRe
 }
 
 type UpdateAccountResponse struct {
-	Accountdetails            map[string]string `json:"accountdetails"`
-	Accounttype               int               `json:"accounttype"`
-	Cpuavailable              string            `json:"cpuavailable"`
-	Cpulimit                  string            `json:"cpulimit"`
-	Cputotal                  int64             `json:"cputotal"`
-	Defaultzoneid             string            `json:"defaultzoneid"`
-	Domain                    string            `json:"domain"`
-	Domainid                  string            `json:"domainid"`
-	Groups                    []string          `json:"groups"`
-	Id                        string            `json:"id"`
-	Ipavailable               string            `json:"ipavailable"`
-	Iplimit                   string            `json:"iplimit"`
-	Iptotal                   int64             `json:"iptotal"`
-	Iscleanuprequired         bool              `json:"iscleanuprequired"`
-	Isdefault                 bool              `json:"isdefault"`
-	Memoryavailable           string            `json:"memoryavailable"`
-	Memorylimit               string            `json:"memorylimit"`
-	Memorytotal               int64             `json:"memorytotal"`
-	Name                      string            `json:"name"`
-	Networkavailable          string            `json:"networkavailable"`
-	Networkdomain             string            `json:"networkdomain"`
-	Networklimit              string            `json:"networklimit"`
-	Networktotal              int64             `json:"networktotal"`
-	Primarystorageavailable   string            `json:"primarystorageavailable"`
-	Primarystoragelimit       string            `json:"primarystoragelimit"`
-	Primarystoragetotal       int64             `json:"primarystoragetotal"`
-	Projectavailable          string            `json:"projectavailable"`
-	Projectlimit              string            `json:"projectlimit"`
-	Projecttotal              int64             `json:"projecttotal"`
-	Receivedbytes             int64             `json:"receivedbytes"`
-	Roleid                    string            `json:"roleid"`
-	Rolename                  string            `json:"rolename"`
-	Roletype                  string            `json:"roletype"`
-	Secondarystorageavailable string            `json:"secondarystorageavailable"`
-	Secondarystoragelimit     string            `json:"secondarystoragelimit"`
-	Secondarystoragetotal     int64             `json:"secondarystoragetotal"`
-	Sentbytes                 int64             `json:"sentbytes"`
-	Snapshotavailable         string            `json:"snapshotavailable"`
-	Snapshotlimit             string            `json:"snapshotlimit"`
-	Snapshottotal             int64             `json:"snapshottotal"`
-	State                     string            `json:"state"`
-	Templateavailable         string            `json:"templateavailable"`
-	Templatelimit             string            `json:"templatelimit"`
-	Templatetotal             int64             `json:"templatetotal"`
+	Accountdetails            StringMap `json:"accountdetails"`
+	Accounttype               int       `json:"accounttype"`
+	Cpuavailable              string    `json:"cpuavailable"`
+	Cpulimit                  string    `json:"cpulimit"`
+	Cputotal                  int64     `json:"cputotal"`
+	Defaultzoneid             string    `json:"defaultzoneid"`
+	Domain                    string    `json:"domain"`
+	Domainid                  string    `json:"domainid"`
+	Groups                    []string  `json:"groups"`
+	Id                        string    `json:"id"`
+	Ipavailable               string    `json:"ipavailable"`
+	Iplimit                   string    `json:"iplimit"`
+	Iptotal                   int64     `json:"iptotal"`
+	Iscleanuprequired         bool      `json:"iscleanuprequired"`
+	Isdefault                 bool      `json:"isdefault"`
+	Memoryavailable           string    `json:"memoryavailable"`
+	Memorylimit               string    `json:"memorylimit"`
+	Memorytotal               int64     `json:"memorytotal"`
+	Name                      string    `json:"name"`
+	Networkavailable          string    `json:"networkavailable"`
+	Networkdomain             string    `json:"networkdomain"`
+	Networklimit              string    `json:"networklimit"`
+	Networktotal              int64     `json:"networktotal"`
+	Primarystorageavailable   string    `json:"primarystorageavailable"`
+	Primarystoragelimit       string    `json:"primarystoragelimit"`
+	Primarystoragetotal       int64     `json:"primarystoragetotal"`
+	Projectavailable          string    `json:"projectavailable"`
+	Projectlimit              string    `json:"projectlimit"`
+	Projecttotal              int64     `json:"projecttotal"`
+	Receivedbytes             int64     `json:"receivedbytes"`
+	Roleid                    string    `json:"roleid"`
+	Rolename                  string    `json:"rolename"`
+	Roletype                  string    `json:"roletype"`
+	Secondarystorageavailable string    `json:"secondarystorageavailable"`
+	Secondarystoragelimit     string    `json:"secondarystoragelimit"`
+	Secondarystoragetotal     int64     `json:"secondarystoragetotal"`
+	Sentbytes                 int64     `json:"sentbytes"`
+	Snapshotavailable         string    `json:"snapshotavailable"`
+	Snapshotlimit             string    `json:"snapshotlimit"`
+	Snapshottotal             int64     `json:"snapshottotal"`
+	State                     string    `json:"state"`
+	Templateavailable         string    `json:"templateavailable"`
+	Templatelimit             string    `json:"templatelimit"`
+	Templatetotal             int64     `json:"templatetotal"`
 	User                      []struct {
 		Account             string `json:"account"`
 		Accountid           string `json:"accountid"`
@@ -17,17 +17,89 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/url"
 	"strconv"
 	"strings"
+	"time"
 )
 
+// AllocateAndWait associates a new public IP address in the given zone, waits for the async job
+// to finish and then waits until the IP address is allocated. The options are applied to the
+// associate IP address params and used when getting the IP address.
+func (s *AddressService) AllocateAndWait(zoneid string, opts ...OptionFunc) (*PublicIpAddress, error) {
+	p := s.NewAssociateIpAddressParams()
+	p.SetZoneid(zoneid)
+
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	r, err := s.AssociateIpAddress(p)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to associate a public IP address in zone %s: %v", zoneid, err)
+	}
+
+	// An async client already waited for the job to finish
+	if !s.cs.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		if err != nil {
+			return nil, fmt.Errorf("Failed to associate a public IP address in zone %s: %v", zoneid, err)
+		}
+		if b, err = getRawValue(b); err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(b, r); err != nil {
+			return nil, err
+		}
+	}
+
+	if r.Id == "" {
+		return nil, fmt.Errorf("Failed to associate a public IP address in zone %s: no ID returned", zoneid)
+	}
+
+	deadline := time.Now().Add(time.Duration(s.cs.timeout) * time.Second)
+	for {
+		ip, _, err := s.GetPublicIpAddressByID(r.Id, opts...)
+		if err != nil {
+			return nil, err
+		}
+
+		switch ip.State {
+		case "Allocated":
+			return ip, nil
+		case "Allocating":
+		default:
+			return nil, fmt.Errorf("Public IP address %s is in unexpected state %s", ip.Ipaddress, ip.State)
+		}
+
+		if time.Now().After(deadline) {
+			return nil, AsyncTimeoutErr
+		}
+
+		time.Sleep(2 * time.Second)
+	}
+}
+
 type AssociateIpAddressParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *AssociateIpAddressParams) Clone() *AssociateIpAddressParams {
+	c := &AssociateIpAddressParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *AssociateIpAddressParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -74,6 +146,13 @@ func (p *AssociateIpAddressParams) SetAccount(v string) {
 	return
 }
 
+// SetAccountIfUnset sets account to v, unless it is already set
+func (p *AssociateIpAddressParams) SetAccountIfUnset(v string) {
+	if _, found := p.p["account"]; !found {
+		p.SetAccount(v)
+	}
+}
+
 func (p *AssociateIpAddressParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -82,6 +161,13 @@ func (p *AssociateIpAddressParams) SetDomainid(v string) {
 	return
 }
 
+// SetDomainidIfUnset sets domainid to v, unless it is already set
+func (p *AssociateIpAddressParams) SetDomainidIfUnset(v string) {
+	if _, found := p.p["domainid"]; !found {
+		p.SetDomainid(v)
+	}
+}
+
 func (p *AssociateIpAddressParams) SetFordisplay(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -90,6 +176,13 @@ func (p *AssociateIpAddressParams) SetFordisplay(v bool) {
 	return
 }
 
+// SetFordisplayIfUnset sets fordisplay to v, unless it is already set
+func (p *AssociateIpAddressParams) SetFordisplayIfUnset(v bool) {
+	if _, found := p.p["fordisplay"]; !found {
+		p.SetFordisplay(v)
+	}
+}
+
 func (p *AssociateIpAddressParams) SetIsportable(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -98,6 +191,13 @@ func (p *AssociateIpAddressParams) SetIsportable(v bool) {
 	return
 }
 
+// SetIsportableIfUnset sets isportable to v, unless it is already set
+func (p *AssociateIpAddressParams) SetIsportableIfUnset(v bool) {
+	if _, found := p.p["isportable"]; !found {
+		p.SetIsportable(v)
+	}
+}
+
 func (p *AssociateIpAddressParams) SetNetworkid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -106,6 +206,13 @@ func (p *AssociateIpAddressParams) SetNetworkid(v string) {
 	return
 }
 
+// SetNetworkidIfUnset sets networkid to v, unless it is already set
+func (p *AssociateIpAddressParams) SetNetworkidIfUnset(v string) {
+	if _, found := p.p["networkid"]; !found {
+		p.SetNetworkid(v)
+	}
+}
+
 func (p *AssociateIpAddressParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -114,6 +221,13 @@ func (p *AssociateIpAddressParams) SetProjectid(v string) {
 	return
 }
 
+// SetProjectidIfUnset sets projectid to v, unless it is already set
+func (p *AssociateIpAddressParams) SetProjectidIfUnset(v string) {
+	if _, found := p.p["projectid"]; !found {
+		p.SetProjectid(v)
+	}
+}
+
 func (p *AssociateIpAddressParams) SetRegionid(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -122,6 +236,13 @@ func (p *AssociateIpAddressParams) SetRegionid(v int) {
 	return
 }
 
+// SetRegionidIfUnset sets regionid to v, unless it is already set
+func (p *AssociateIpAddressParams) SetRegionidIfUnset(v int) {
+	if _, found := p.p["regionid"]; !found {
+		p.SetRegionid(v)
+	}
+}
+
 func (p *AssociateIpAddressParams) SetVpcid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -130,6 +251,13 @@ func (p *AssociateIpAddressParams) SetVpcid(v string) {
 	return
 }
 
+// SetVpcidIfUnset sets vpcid to v, unless it is already set
+func (p *AssociateIpAddressParams) SetVpcidIfUnset(v string) {
+	if _, found := p.p["vpcid"]; !found {
+		p.SetVpcid(v)
+	}
+}
+
 func (p *AssociateIpAddressParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -138,17 +266,49 @@ func (p *AssociateIpAddressParams) SetZoneid(v string) {
 	return
 }
 
+// SetZoneidIfUnset sets zoneid to v, unless it is already set
+func (p *AssociateIpAddressParams) SetZoneidIfUnset(v string) {
+	if _, found := p.p["zoneid"]; !found {
+		p.SetZoneid(v)
+	}
+}
+
 // You should always use this function to get a new AssociateIpAddressParams instance,
-// as then you are sure you have configured all required params
-func (s *AddressService) NewAssociateIpAddressParams() *AssociateIpAddressParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *AddressService) NewAssociateIpAddressParams(opts ...func(*AssociateIpAddressParams)) *AssociateIpAddressParams {
 	p := &AssociateIpAddressParams{}
 	p.p = make(map[string]interface{})
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Acquires and associates a public IP to an account.
-func (s *AddressService) AssociateIpAddress(p *AssociateIpAddressParams) (*AssociateIpAddressResponse, error) {
-	resp, err := s.cs.newRequest("associateIpAddress", p.toURLValues())
+func (s *AddressService) AssociateIpAddress(p *AssociateIpAddressParams, opts ...OptionFunc) (*AssociateIpAddressResponse, error) {
+	return s.AssociateIpAddressWithContext(context.Background(), p, opts...)
+}
+
+// AssociateIpAddressWithContext is the same as AssociateIpAddress, but the request is cancelled when the context is done
+func (s *AddressService) AssociateIpAddressWithContext(ctx context.Context, p *AssociateIpAddressParams, opts ...OptionFunc) (*AssociateIpAddressResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	return s.AssociateIpAddressRawWithContext(ctx, p.toURLValues())
+}
+
+// AssociateIpAddressRaw is the same as AssociateIpAddress, but takes the params as url.Values instead of typed params
+func (s *AddressService) AssociateIpAddressRaw(v url.Values) (*AssociateIpAddressResponse, error) {
+	return s.AssociateIpAddressRawWithContext(context.Background(), v)
+}
+
+// AssociateIpAddressRawWithContext is the same as AssociateIpAddressRaw, but the request is cancelled when the context is done
+func (s *AddressService) AssociateIpAddressRawWithContext(ctx context.Context, v url.Values) (*AssociateIpAddressResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "associateIpAddress", "associateipaddressresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -160,15 +320,15 @@ func (s *AddressService) AssociateIpAddress(p *AssociateIpAddressParams) (*Assoc
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
+		b, err = getRawValueByKey(b, "ipaddress")
 		if err != nil {
 			return nil, err
 		}
@@ -230,6 +390,16 @@ type DisassociateIpAddressParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *DisassociateIpAddressParams) Clone() *DisassociateIpAddressParams {
+	c := &DisassociateIpAddressParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DisassociateIpAddressParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -241,6 +411,16 @@ func (p *DisassociateIpAddressParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *DisassociateIpAddressParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of disassociateIpAddress", k)
+		}
+	}
+	return nil
+}
+
 func (p *DisassociateIpAddressParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -249,18 +429,54 @@ func (p *DisassociateIpAddressParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *DisassociateIpAddressParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 // You should always use this function to get a new DisassociateIpAddressParams instance,
-// as then you are sure you have configured all required params
-func (s *AddressService) NewDisassociateIpAddressParams(id string) *DisassociateIpAddressParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *AddressService) NewDisassociateIpAddressParams(id string, opts ...func(*DisassociateIpAddressParams)) *DisassociateIpAddressParams {
 	p := &DisassociateIpAddressParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Disassociates an IP address from the account.
-func (s *AddressService) DisassociateIpAddress(p *DisassociateIpAddressParams) (*DisassociateIpAddressResponse, error) {
-	resp, err := s.cs.newRequest("disassociateIpAddress", p.toURLValues())
+func (s *AddressService) DisassociateIpAddress(p *DisassociateIpAddressParams, opts ...OptionFunc) (*DisassociateIpAddressResponse, error) {
+	return s.DisassociateIpAddressWithContext(context.Background(), p, opts...)
+}
+
+// DisassociateIpAddressWithContext is the same as DisassociateIpAddress, but the request is cancelled when the context is done
+func (s *AddressService) DisassociateIpAddressWithContext(ctx context.Context, p *DisassociateIpAddressParams, opts ...OptionFunc) (*DisassociateIpAddressResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.DisassociateIpAddressRawWithContext(ctx, p.toURLValues())
+}
+
+// DisassociateIpAddressRaw is the same as DisassociateIpAddress, but takes the params as url.Values instead of typed params
+func (s *AddressService) DisassociateIpAddressRaw(v url.Values) (*DisassociateIpAddressResponse, error) {
+	return s.DisassociateIpAddressRawWithContext(context.Background(), v)
+}
+
+// DisassociateIpAddressRawWithContext is the same as DisassociateIpAddressRaw, but the request is cancelled when the context is done
+func (s *AddressService) DisassociateIpAddressRawWithContext(ctx context.Context, v url.Values) (*DisassociateIpAddressResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "disassociateIpAddress", "disassociateipaddressresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -272,9 +488,9 @@ func (s *AddressService) DisassociateIpAddress(p *DisassociateIpAddressParams) (
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -298,6 +514,16 @@ type ListPublicIpAddressesParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ListPublicIpAddressesParams) Clone() *ListPublicIpAddressesParams {
+	c := &ListPublicIpAddressesParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListPublicIpAddressesParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -371,11 +597,10 @@ func (p *ListPublicIpAddressesParams) toURLValues() url.Values {
 		u.Set("state", v.(string))
 	}
 	if v, found := p.p["tags"]; found {
-		i := 0
-		for k, vv := range v.(map[string]string) {
+		m := v.(map[string]string)
+		for i, k := range getSortedKeysFromMap(m) {
 			u.Set(fmt.Sprintf("tags[%d].key", i), k)
-			u.Set(fmt.Sprintf("tags[%d].value", i), vv)
-			i++
+			u.Set(fmt.Sprintf("tags[%d].value", i), m[k])
 		}
 	}
 	if v, found := p.p["vlanid"]; found {
@@ -398,6 +623,13 @@ func (p *ListPublicIpAddressesParams) SetAccount(v string) {
 	return
 }
 
+// SetAccountIfUnset sets account to v, unless it is already set
+func (p *ListPublicIpAddressesParams) SetAccountIfUnset(v string) {
+	if _, found := p.p["account"]; !found {
+		p.SetAccount(v)
+	}
+}
+
 func (p *ListPublicIpAddressesParams) SetAllocatedonly(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -406,6 +638,13 @@ func (p *ListPublicIpAddressesParams) SetAllocatedonly(v bool) {
 	return
 }
 
+// SetAllocatedonlyIfUnset sets allocatedonly to v, unless it is already set
+func (p *ListPublicIpAddressesParams) SetAllocatedonlyIfUnset(v bool) {
+	if _, found := p.p["allocatedonly"]; !found {
+		p.SetAllocatedonly(v)
+	}
+}
+
 func (p *ListPublicIpAddressesParams) SetAssociatednetworkid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -414,6 +653,13 @@ func (p *ListPublicIpAddressesParams) SetAssociatednetworkid(v string) {
 	return
 }
 
+// SetAssociatednetworkidIfUnset sets associatednetworkid to v, unless it is already set
+func (p *ListPublicIpAddressesParams) SetAssociatednetworkidIfUnset(v string) {
+	if _, found := p.p["associatednetworkid"]; !found {
+		p.SetAssociatednetworkid(v)
+	}
+}
+
 func (p *ListPublicIpAddressesParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -422,6 +668,13 @@ func (p *ListPublicIpAddressesParams) SetDomainid(v string) {
 	return
 }
 
+// SetDomainidIfUnset sets domainid to v, unless it is already set
+func (p *ListPublicIpAddressesParams) SetDomainidIfUnset(v string) {
+	if _, found := p.p["domainid"]; !found {
+		p.SetDomainid(v)
+	}
+}
+
 func (p *ListPublicIpAddressesParams) SetFordisplay(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -430,6 +683,13 @@ func (p *ListPublicIpAddressesParams) SetFordisplay(v bool) {
 	return
 }
 
+// SetFordisplayIfUnset sets fordisplay to v, unless it is already set
+func (p *ListPublicIpAddressesParams) SetFordisplayIfUnset(v bool) {
+	if _, found := p.p["fordisplay"]; !found {
+		p.SetFordisplay(v)
+	}
+}
+
 func (p *ListPublicIpAddressesParams) SetForloadbalancing(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -438,6 +698,13 @@ func (p *ListPublicIpAddressesParams) SetForloadbalancing(v bool) {
 	return
 }
 
+// SetForloadbalancingIfUnset sets forloadbalancing to v, unless it is already set
+func (p *ListPublicIpAddressesParams) SetForloadbalancingIfUnset(v bool) {
+	if _, found := p.p["forloadbalancing"]; !found {
+		p.SetForloadbalancing(v)
+	}
+}
+
 func (p *ListPublicIpAddressesParams) SetForvirtualnetwork(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -446,6 +713,13 @@ func (p *ListPublicIpAddressesParams) SetForvirtualnetwork(v bool) {
 	return
 }
 
+// SetForvirtualnetworkIfUnset sets forvirtualnetwork to v, unless it is already set
+func (p *ListPublicIpAddressesParams) SetForvirtualnetworkIfUnset(v bool) {
+	if _, found := p.p["forvirtualnetwork"]; !found {
+		p.SetForvirtualnetwork(v)
+	}
+}
+
 func (p *ListPublicIpAddressesParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -454,6 +728,13 @@ func (p *ListPublicIpAddressesParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *ListPublicIpAddressesParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 func (p *ListPublicIpAddressesParams) SetIpaddress(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -462,6 +743,13 @@ func (p *ListPublicIpAddressesParams) SetIpaddress(v string) {
 	return
 }
 
+// SetIpaddressIfUnset sets ipaddress to v, unless it is already set
+func (p *ListPublicIpAddressesParams) SetIpaddressIfUnset(v string) {
+	if _, found := p.p["ipaddress"]; !found {
+		p.SetIpaddress(v)
+	}
+}
+
 func (p *ListPublicIpAddressesParams) SetIsrecursive(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -470,6 +758,13 @@ func (p *ListPublicIpAddressesParams) SetIsrecursive(v bool) {
 	return
 }
 
+// SetIsrecursiveIfUnset sets isrecursive to v, unless it is already set
+func (p *ListPublicIpAddressesParams) SetIsrecursiveIfUnset(v bool) {
+	if _, found := p.p["isrecursive"]; !found {
+		p.SetIsrecursive(v)
+	}
+}
+
 func (p *ListPublicIpAddressesParams) SetIssourcenat(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -478,6 +773,13 @@ func (p *ListPublicIpAddressesParams) SetIssourcenat(v bool) {
 	return
 }
 
+// SetIssourcenatIfUnset sets issourcenat to v, unless it is already set
+func (p *ListPublicIpAddressesParams) SetIssourcenatIfUnset(v bool) {
+	if _, found := p.p["issourcenat"]; !found {
+		p.SetIssourcenat(v)
+	}
+}
+
 func (p *ListPublicIpAddressesParams) SetIsstaticnat(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -486,6 +788,13 @@ func (p *ListPublicIpAddressesParams) SetIsstaticnat(v bool) {
 	return
 }
 
+// SetIsstaticnatIfUnset sets isstaticnat to v, unless it is already set
+func (p *ListPublicIpAddressesParams) SetIsstaticnatIfUnset(v bool) {
+	if _, found := p.p["isstaticnat"]; !found {
+		p.SetIsstaticnat(v)
+	}
+}
+
 func (p *ListPublicIpAddressesParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -494,6 +803,13 @@ func (p *ListPublicIpAddressesParams) SetKeyword(v string) {
 	return
 }
 
+// SetKeywordIfUnset sets keyword to v, unless it is already set
+func (p *ListPublicIpAddressesParams) SetKeywordIfUnset(v string) {
+	if _, found := p.p["keyword"]; !found {
+		p.SetKeyword(v)
+	}
+}
+
 func (p *ListPublicIpAddressesParams) SetListall(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -502,6 +818,13 @@ func (p *ListPublicIpAddressesParams) SetListall(v bool) {
 	return
 }
 
+// SetListallIfUnset sets listall to v, unless it is already set
+func (p *ListPublicIpAddressesParams) SetListallIfUnset(v bool) {
+	if _, found := p.p["listall"]; !found {
+		p.SetListall(v)
+	}
+}
+
 func (p *ListPublicIpAddressesParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -510,6 +833,13 @@ func (p *ListPublicIpAddressesParams) SetPage(v int) {
 	return
 }
 
+// SetPageIfUnset sets page to v, unless it is already set
+func (p *ListPublicIpAddressesParams) SetPageIfUnset(v int) {
+	if _, found := p.p["page"]; !found {
+		p.SetPage(v)
+	}
+}
+
 func (p *ListPublicIpAddressesParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -518,6 +848,13 @@ func (p *ListPublicIpAddressesParams) SetPagesize(v int) {
 	return
 }
 
+// SetPagesizeIfUnset sets pagesize to v, unless it is already set
+func (p *ListPublicIpAddressesParams) SetPagesizeIfUnset(v int) {
+	if _, found := p.p["pagesize"]; !found {
+		p.SetPagesize(v)
+	}
+}
+
 func (p *ListPublicIpAddressesParams) SetPhysicalnetworkid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -526,6 +863,13 @@ func (p *ListPublicIpAddressesParams) SetPhysicalnetworkid(v string) {
 	return
 }
 
+// SetPhysicalnetworkidIfUnset sets physicalnetworkid to v, unless it is already set
+func (p *ListPublicIpAddressesParams) SetPhysicalnetworkidIfUnset(v string) {
+	if _, found := p.p["physicalnetworkid"]; !found {
+		p.SetPhysicalnetworkid(v)
+	}
+}
+
 func (p *ListPublicIpAddressesParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -534,6 +878,13 @@ func (p *ListPublicIpAddressesParams) SetProjectid(v string) {
 	return
 }
 
+// SetProjectidIfUnset sets projectid to v, unless it is already set
+func (p *ListPublicIpAddressesParams) SetProjectidIfUnset(v string) {
+	if _, found := p.p["projectid"]; !found {
+		p.SetProjectid(v)
+	}
+}
+
 func (p *ListPublicIpAddressesParams) SetState(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -542,6 +893,13 @@ func (p *ListPublicIpAddressesParams) SetState(v string) {
 	return
 }
 
+// SetStateIfUnset sets state to v, unless it is already set
+func (p *ListPublicIpAddressesParams) SetStateIfUnset(v string) {
+	if _, found := p.p["state"]; !found {
+		p.SetState(v)
+	}
+}
+
 func (p *ListPublicIpAddressesParams) SetTags(v map[string]string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -550,6 +908,13 @@ func (p *ListPublicIpAddressesParams) SetTags(v map[string]string) {
 	return
 }
 
+// SetTagsIfUnset sets tags to v, unless it is already set
+func (p *ListPublicIpAddressesParams) SetTagsIfUnset(v map[string]string) {
+	if _, found := p.p["tags"]; !found {
+		p.SetTags(v)
+	}
+}
+
 func (p *ListPublicIpAddressesParams) SetVlanid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -558,6 +923,13 @@ func (p *ListPublicIpAddressesParams) SetVlanid(v string) {
 	return
 }
 
+// SetVlanidIfUnset sets vlanid to v, unless it is already set
+func (p *ListPublicIpAddressesParams) SetVlanidIfUnset(v string) {
+	if _, found := p.p["vlanid"]; !found {
+		p.SetVlanid(v)
+	}
+}
+
 func (p *ListPublicIpAddressesParams) SetVpcid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -566,6 +938,13 @@ func (p *ListPublicIpAddressesParams) SetVpcid(v string) {
 	return
 }
 
+// SetVpcidIfUnset sets vpcid to v, unless it is already set
+func (p *ListPublicIpAddressesParams) SetVpcidIfUnset(v string) {
+	if _, found := p.p["vpcid"]; !found {
+		p.SetVpcid(v)
+	}
+}
+
 func (p *ListPublicIpAddressesParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -574,14 +953,34 @@ func (p *ListPublicIpAddressesParams) SetZoneid(v string) {
 	return
 }
 
+// SetZoneidIfUnset sets zoneid to v, unless it is already set
+func (p *ListPublicIpAddressesParams) SetZoneidIfUnset(v string) {
+	if _, found := p.p["zoneid"]; !found {
+		p.SetZoneid(v)
+	}
+}
+
+var _ ListAllSetter = (*ListPublicIpAddressesParams)(nil)
+
 // You should always use this function to get a new ListPublicIpAddressesParams instance,
-// as then you are sure you have configured all required params
-func (s *AddressService) NewListPublicIpAddressesParams() *ListPublicIpAddressesParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *AddressService) NewListPublicIpAddressesParams(opts ...func(*ListPublicIpAddressesParams)) *ListPublicIpAddressesParams {
 	p := &ListPublicIpAddressesParams{}
 	p.p = make(map[string]interface{})
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
+func (p *ListPublicIpAddressesParams) setFirstMatch() {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p[firstMatchKey] = true
+}
+
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *AddressService) GetPublicIpAddressByID(id string, opts ...OptionFunc) (*PublicIpAddress, int, error) {
 	p := &ListPublicIpAddressesParams{}
@@ -589,7 +988,7 @@ func (s *AddressService) GetPublicIpAddressByID(id string, opts ...OptionFunc) (
 
 	p.p["id"] = id
 
-	for _, fn := range append(s.cs.options, opts...) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
 		if err := fn(s.cs, p); err != nil {
 			return nil, -1, err
 		}
@@ -600,24 +999,60 @@ func (s *AddressService) GetPublicIpAddressByID(id string, opts ...OptionFunc) (
 		if strings.Contains(err.Error(), fmt.Sprintf(
 			"Invalid parameter id value=%s due to incorrect long value format, "+
 				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+			return nil, 0, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
 	}
 
 	if l.Count == 1 {
 		return l.PublicIpAddresses[0], l.Count, nil
 	}
+
+	if _, ok := p.p[firstMatchKey]; ok && len(l.PublicIpAddresses) > 0 {
+		return l.PublicIpAddresses[0], l.Count, nil
+	}
 	return nil, l.Count, fmt.Errorf("There is more then one result for PublicIpAddress UUID: %s!", id)
 }
 
+// PublicIpAddressExists returns true if a PublicIpAddress with the given ID exists. When no match is found
+// it returns false, while any other error is returned as is.
+func (s *AddressService) PublicIpAddressExists(id string, opts ...OptionFunc) (bool, error) {
+	_, count, err := s.GetPublicIpAddressByID(id, opts...)
+	if errors.Is(err, ErrNotFound) {
+		return false, nil
+	}
+	if count > 0 {
+		return true, nil
+	}
+	return false, err
+}
+
 // Lists all public ip addresses
 func (s *AddressService) ListPublicIpAddresses(p *ListPublicIpAddressesParams) (*ListPublicIpAddressesResponse, error) {
-	resp, err := s.cs.newRequest("listPublicIpAddresses", p.toURLValues())
+	return s.ListPublicIpAddressesWithContext(context.Background(), p)
+}
+
+// ListPublicIpAddressesWithContext is the same as ListPublicIpAddresses, but the request is cancelled when the context is done
+func (s *AddressService) ListPublicIpAddressesWithContext(ctx context.Context, p *ListPublicIpAddressesParams) (*ListPublicIpAddressesResponse, error) {
+	if err := checkMutuallyExclusive(p.p, "account", "projectid"); err != nil {
+		return nil, err
+	}
+
+	return s.ListPublicIpAddressesRawWithContext(ctx, p.toURLValues())
+}
+
+// ListPublicIpAddressesRaw is the same as ListPublicIpAddresses, but takes the params as url.Values instead of typed params
+func (s *AddressService) ListPublicIpAddressesRaw(v url.Values) (*ListPublicIpAddressesResponse, error) {
+	return s.ListPublicIpAddressesRawWithContext(context.Background(), v)
+}
+
+// ListPublicIpAddressesRawWithContext is the same as ListPublicIpAddressesRaw, but the request is cancelled when the context is done
+func (s *AddressService) ListPublicIpAddressesRawWithContext(ctx context.Context, v url.Values) (*ListPublicIpAddressesResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "listPublicIpAddresses", "listpublicipaddressesresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -630,6 +1065,93 @@ func (s *AddressService) ListPublicIpAddresses(p *ListPublicIpAddressesParams) (
 	return &r, nil
 }
 
+// StreamPublicIpAddresses fetches the results of ListPublicIpAddresses page by page in the background and sends them on the
+// returned channel. Both returned channels are closed when done. If fetching a page fails, or the context
+// is done before all results are sent, the error is sent on the error channel and fetching stops. A
+// request for a page in flight is cancelled when the context is done. The page and pagesize set in the
+// params are ignored.
+func (s *AddressService) StreamPublicIpAddresses(ctx context.Context, p *ListPublicIpAddressesParams) (<-chan *PublicIpAddress, <-chan error) {
+	ch := make(chan *PublicIpAddress)
+	errs := make(chan error, 1)
+
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesize(500)
+
+	go func() {
+		defer close(errs)
+		defer close(ch)
+
+		for page, count := 1, 0; ; page++ {
+			pp.SetPage(page)
+
+			l, err := s.ListPublicIpAddressesWithContext(ctx, pp)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			for _, v := range l.PublicIpAddresses {
+				select {
+				case ch <- v:
+				case <-ctx.Done():
+					errs <- ctx.Err()
+					return
+				}
+			}
+
+			count += len(l.PublicIpAddresses)
+			if len(l.PublicIpAddresses) < 500 || count >= l.Count {
+				return
+			}
+		}
+	}()
+
+	return ch, errs
+}
+
+// ListPublicIpAddressesWhere returns all results of ListPublicIpAddresses for which pred returns true. Note that the results are
+// filtered client-side, so all results matching the params are fetched first.
+// All pages are fetched, so the page and pagesize set in the params are ignored.
+func (s *AddressService) ListPublicIpAddressesWhere(p *ListPublicIpAddressesParams, pred func(*PublicIpAddress) bool) ([]*PublicIpAddress, error) {
+	var r []*PublicIpAddress
+	ch, errs := s.StreamPublicIpAddresses(context.Background(), p)
+	for v := range ch {
+		if pred(v) {
+			r = append(r, v)
+		}
+	}
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ListAllPublicIpAddresses returns the results of ListPublicIpAddresses across all pages, by calling ListPublicIpAddresses page by page until all
+// results are fetched. The pagesize set in the params is used for every page (500 if not set), and the
+// page set in the params is ignored.
+func (s *AddressService) ListAllPublicIpAddresses(p *ListPublicIpAddressesParams) ([]*PublicIpAddress, error) {
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesizeIfUnset(500)
+	pagesize := pp.p["pagesize"].(int)
+
+	var r []*PublicIpAddress
+	for page := 1; ; page++ {
+		pp.SetPage(page)
+
+		l, err := s.ListPublicIpAddresses(pp)
+		if err != nil {
+			return nil, err
+		}
+		r = append(r, l.PublicIpAddresses...)
+
+		if len(l.PublicIpAddresses) < pagesize || len(r) >= l.Count {
+			return r, nil
+		}
+	}
+}
+
 type ListPublicIpAddressesResponse struct {
 	Count             int                `json:"count"`
 	PublicIpAddresses []*PublicIpAddress `json:"publicipaddress"`
@@ -683,6 +1205,16 @@ type UpdateIpAddressParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *UpdateIpAddressParams) Clone() *UpdateIpAddressParams {
+	c := &UpdateIpAddressParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *UpdateIpAddressParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -701,6 +1233,16 @@ func (p *UpdateIpAddressParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *UpdateIpAddressParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of updateIpAddress", k)
+		}
+	}
+	return nil
+}
+
 func (p *UpdateIpAddressParams) SetCustomid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -709,6 +1251,13 @@ func (p *UpdateIpAddressParams) SetCustomid(v string) {
 	return
 }
 
+// SetCustomidIfUnset sets customid to v, unless it is already set
+func (p *UpdateIpAddressParams) SetCustomidIfUnset(v string) {
+	if _, found := p.p["customid"]; !found {
+		p.SetCustomid(v)
+	}
+}
+
 func (p *UpdateIpAddressParams) SetFordisplay(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -717,6 +1266,13 @@ func (p *UpdateIpAddressParams) SetFordisplay(v bool) {
 	return
 }
 
+// SetFordisplayIfUnset sets fordisplay to v, unless it is already set
+func (p *UpdateIpAddressParams) SetFordisplayIfUnset(v bool) {
+	if _, found := p.p["fordisplay"]; !found {
+		p.SetFordisplay(v)
+	}
+}
+
 func (p *UpdateIpAddressParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -725,18 +1281,54 @@ func (p *UpdateIpAddressParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *UpdateIpAddressParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 // You should always use this function to get a new UpdateIpAddressParams instance,
-// as then you are sure you have configured all required params
-func (s *AddressService) NewUpdateIpAddressParams(id string) *UpdateIpAddressParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *AddressService) NewUpdateIpAddressParams(id string, opts ...func(*UpdateIpAddressParams)) *UpdateIpAddressParams {
 	p := &UpdateIpAddressParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Updates an IP address
-func (s *AddressService) UpdateIpAddress(p *UpdateIpAddressParams) (*UpdateIpAddressResponse, error) {
-	resp, err := s.cs.newRequest("updateIpAddress", p.toURLValues())
+func (s *AddressService) UpdateIpAddress(p *UpdateIpAddressParams, opts ...OptionFunc) (*UpdateIpAddressResponse, error) {
+	return s.UpdateIpAddressWithContext(context.Background(), p, opts...)
+}
+
+// UpdateIpAddressWithContext is the same as UpdateIpAddress, but the request is cancelled when the context is done
+func (s *AddressService) UpdateIpAddressWithContext(ctx context.Context, p *UpdateIpAddressParams, opts ...OptionFunc) (*UpdateIpAddressResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.UpdateIpAddressRawWithContext(ctx, p.toURLValues())
+}
+
+// UpdateIpAddressRaw is the same as UpdateIpAddress, but takes the params as url.Values instead of typed params
+func (s *AddressService) UpdateIpAddressRaw(v url.Values) (*UpdateIpAddressResponse, error) {
+	return s.UpdateIpAddressRawWithContext(context.Background(), v)
+}
+
+// UpdateIpAddressRawWithContext is the same as UpdateIpAddressRaw, but the request is cancelled when the context is done
+func (s *AddressService) UpdateIpAddressRawWithContext(ctx context.Context, v url.Values) (*UpdateIpAddressResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "updateIpAddress", "updateipaddressresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -748,9 +1340,9 @@ func (s *AddressService) UpdateIpAddress(p *UpdateIpAddressParams) (*UpdateIpAdd
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -17,17 +17,30 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/url"
 	"strconv"
 	"strings"
+	"time"
 )
 
 type CreateAffinityGroupParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *CreateAffinityGroupParams) Clone() *CreateAffinityGroupParams {
+	c := &CreateAffinityGroupParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *CreateAffinityGroupParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -54,6 +67,16 @@ func (p *CreateAffinityGroupParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *CreateAffinityGroupParams) validate() error {
+	for _, k := range []string{"name", "type"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of createAffinityGroup", k)
+		}
+	}
+	return nil
+}
+
 func (p *CreateAffinityGroupParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -62,6 +85,13 @@ func (p *CreateAffinityGroupParams) SetAccount(v string) {
 	return
 }
 
+// SetAccountIfUnset sets account to v, unless it is already set
+func (p *CreateAffinityGroupParams) SetAccountIfUnset(v string) {
+	if _, found := p.p["account"]; !found {
+		p.SetAccount(v)
+	}
+}
+
 func (p *CreateAffinityGroupParams) SetDescription(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -70,6 +100,13 @@ func (p *CreateAffinityGroupParams) SetDescription(v string) {
 	return
 }
 
+// SetDescriptionIfUnset sets description to v, unless it is already set
+func (p *CreateAffinityGroupParams) SetDescriptionIfUnset(v string) {
+	if _, found := p.p["description"]; !found {
+		p.SetDescription(v)
+	}
+}
+
 func (p *CreateAffinityGroupParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -78,6 +115,13 @@ func (p *CreateAffinityGroupParams) SetDomainid(v string) {
 	return
 }
 
+// SetDomainidIfUnset sets domainid to v, unless it is already set
+func (p *CreateAffinityGroupParams) SetDomainidIfUnset(v string) {
+	if _, found := p.p["domainid"]; !found {
+		p.SetDomainid(v)
+	}
+}
+
 func (p *CreateAffinityGroupParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -86,6 +130,13 @@ func (p *CreateAffinityGroupParams) SetName(v string) {
 	return
 }
 
+// SetNameIfUnset sets name to v, unless it is already set
+func (p *CreateAffinityGroupParams) SetNameIfUnset(v string) {
+	if _, found := p.p["name"]; !found {
+		p.SetName(v)
+	}
+}
+
 func (p *CreateAffinityGroupParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -94,6 +145,13 @@ func (p *CreateAffinityGroupParams) SetProjectid(v string) {
 	return
 }
 
+// SetProjectidIfUnset sets projectid to v, unless it is already set
+func (p *CreateAffinityGroupParams) SetProjectidIfUnset(v string) {
+	if _, found := p.p["projectid"]; !found {
+		p.SetProjectid(v)
+	}
+}
+
 func (p *CreateAffinityGroupParams) SetType(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -102,19 +160,55 @@ func (p *CreateAffinityGroupParams) SetType(v string) {
 	return
 }
 
+// SetTypeIfUnset sets type to v, unless it is already set
+func (p *CreateAffinityGroupParams) SetTypeIfUnset(v string) {
+	if _, found := p.p["type"]; !found {
+		p.SetType(v)
+	}
+}
+
 // You should always use this function to get a new CreateAffinityGroupParams instance,
-// as then you are sure you have configured all required params
-func (s *AffinityGroupService) NewCreateAffinityGroupParams(name string, affinityGroupType string) *CreateAffinityGroupParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *AffinityGroupService) NewCreateAffinityGroupParams(name string, affinityGroupType string, opts ...func(*CreateAffinityGroupParams)) *CreateAffinityGroupParams {
 	p := &CreateAffinityGroupParams{}
 	p.p = make(map[string]interface{})
 	p.p["name"] = name
 	p.p["type"] = affinityGroupType
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Creates an affinity/anti-affinity group
-func (s *AffinityGroupService) CreateAffinityGroup(p *CreateAffinityGroupParams) (*CreateAffinityGroupResponse, error) {
-	resp, err := s.cs.newRequest("createAffinityGroup", p.toURLValues())
+func (s *AffinityGroupService) CreateAffinityGroup(p *CreateAffinityGroupParams, opts ...OptionFunc) (*CreateAffinityGroupResponse, error) {
+	return s.CreateAffinityGroupWithContext(context.Background(), p, opts...)
+}
+
+// CreateAffinityGroupWithContext is the same as CreateAffinityGroup, but the request is cancelled when the context is done
+func (s *AffinityGroupService) CreateAffinityGroupWithContext(ctx context.Context, p *CreateAffinityGroupParams, opts ...OptionFunc) (*CreateAffinityGroupResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.CreateAffinityGroupRawWithContext(ctx, p.toURLValues())
+}
+
+// CreateAffinityGroupRaw is the same as CreateAffinityGroup, but takes the params as url.Values instead of typed params
+func (s *AffinityGroupService) CreateAffinityGroupRaw(v url.Values) (*CreateAffinityGroupResponse, error) {
+	return s.CreateAffinityGroupRawWithContext(context.Background(), v)
+}
+
+// CreateAffinityGroupRawWithContext is the same as CreateAffinityGroupRaw, but the request is cancelled when the context is done
+func (s *AffinityGroupService) CreateAffinityGroupRawWithContext(ctx context.Context, v url.Values) (*CreateAffinityGroupResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "createAffinityGroup", "createaffinitygroupresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -126,9 +220,9 @@ func (s *AffinityGroupService) CreateAffinityGroup(p *CreateAffinityGroupParams)
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -165,6 +259,16 @@ type DeleteAffinityGroupParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *DeleteAffinityGroupParams) Clone() *DeleteAffinityGroupParams {
+	c := &DeleteAffinityGroupParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeleteAffinityGroupParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -196,6 +300,13 @@ func (p *DeleteAffinityGroupParams) SetAccount(v string) {
 	return
 }
 
+// SetAccountIfUnset sets account to v, unless it is already set
+func (p *DeleteAffinityGroupParams) SetAccountIfUnset(v string) {
+	if _, found := p.p["account"]; !found {
+		p.SetAccount(v)
+	}
+}
+
 func (p *DeleteAffinityGroupParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -204,6 +315,13 @@ func (p *DeleteAffinityGroupParams) SetDomainid(v string) {
 	return
 }
 
+// SetDomainidIfUnset sets domainid to v, unless it is already set
+func (p *DeleteAffinityGroupParams) SetDomainidIfUnset(v string) {
+	if _, found := p.p["domainid"]; !found {
+		p.SetDomainid(v)
+	}
+}
+
 func (p *DeleteAffinityGroupParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -212,6 +330,13 @@ func (p *DeleteAffinityGroupParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *DeleteAffinityGroupParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 func (p *DeleteAffinityGroupParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -220,6 +345,13 @@ func (p *DeleteAffinityGroupParams) SetName(v string) {
 	return
 }
 
+// SetNameIfUnset sets name to v, unless it is already set
+func (p *DeleteAffinityGroupParams) SetNameIfUnset(v string) {
+	if _, found := p.p["name"]; !found {
+		p.SetName(v)
+	}
+}
+
 func (p *DeleteAffinityGroupParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -228,17 +360,49 @@ func (p *DeleteAffinityGroupParams) SetProjectid(v string) {
 	return
 }
 
+// SetProjectidIfUnset sets projectid to v, unless it is already set
+func (p *DeleteAffinityGroupParams) SetProjectidIfUnset(v string) {
+	if _, found := p.p["projectid"]; !found {
+		p.SetProjectid(v)
+	}
+}
+
 // You should always use this function to get a new DeleteAffinityGroupParams instance,
-// as then you are sure you have configured all required params
-func (s *AffinityGroupService) NewDeleteAffinityGroupParams() *DeleteAffinityGroupParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *AffinityGroupService) NewDeleteAffinityGroupParams(opts ...func(*DeleteAffinityGroupParams)) *DeleteAffinityGroupParams {
 	p := &DeleteAffinityGroupParams{}
 	p.p = make(map[string]interface{})
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Deletes affinity group
-func (s *AffinityGroupService) DeleteAffinityGroup(p *DeleteAffinityGroupParams) (*DeleteAffinityGroupResponse, error) {
-	resp, err := s.cs.newRequest("deleteAffinityGroup", p.toURLValues())
+func (s *AffinityGroupService) DeleteAffinityGroup(p *DeleteAffinityGroupParams, opts ...OptionFunc) (*DeleteAffinityGroupResponse, error) {
+	return s.DeleteAffinityGroupWithContext(context.Background(), p, opts...)
+}
+
+// DeleteAffinityGroupWithContext is the same as DeleteAffinityGroup, but the request is cancelled when the context is done
+func (s *AffinityGroupService) DeleteAffinityGroupWithContext(ctx context.Context, p *DeleteAffinityGroupParams, opts ...OptionFunc) (*DeleteAffinityGroupResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	return s.DeleteAffinityGroupRawWithContext(ctx, p.toURLValues())
+}
+
+// DeleteAffinityGroupRaw is the same as DeleteAffinityGroup, but takes the params as url.Values instead of typed params
+func (s *AffinityGroupService) DeleteAffinityGroupRaw(v url.Values) (*DeleteAffinityGroupResponse, error) {
+	return s.DeleteAffinityGroupRawWithContext(context.Background(), v)
+}
+
+// DeleteAffinityGroupRawWithContext is the same as DeleteAffinityGroupRaw, but the request is cancelled when the context is done
+func (s *AffinityGroupService) DeleteAffinityGroupRawWithContext(ctx context.Context, v url.Values) (*DeleteAffinityGroupResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteAffinityGroup", "deleteaffinitygroupresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -250,9 +414,9 @@ func (s *AffinityGroupService) DeleteAffinityGroup(p *DeleteAffinityGroupParams)
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -266,6 +430,34 @@ func (s *AffinityGroupService) DeleteAffinityGroup(p *DeleteAffinityGroupParams)
 	return &r, nil
 }
 
+// WaitForAffinityGroupDeleted polls until the AffinityGroup with the given ID no longer exists, the timeout
+// expires or the context is cancelled. Deleted resources may still be listed for a short while
+// after the delete command finished, so this can be used to make sure they are really gone. The
+// options are passed to GetAffinityGroupByID, so a resource outside of the default scope can be found.
+func (s *AffinityGroupService) WaitForAffinityGroupDeleted(ctx context.Context, id string, timeout time.Duration, opts ...OptionFunc) error {
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	for {
+		_, count, err := s.GetAffinityGroupByID(id, opts...)
+		if count == 0 {
+			return nil
+		}
+		if count < 0 {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(2 * time.Second):
+		}
+	}
+}
+
 type DeleteAffinityGroupResponse struct {
 	JobID       string `json:"jobid"`
 	Displaytext string `json:"displaytext"`
@@ -276,6 +468,16 @@ type ListAffinityGroupTypesParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ListAffinityGroupTypesParams) Clone() *ListAffinityGroupTypesParams {
+	c := &ListAffinityGroupTypesParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListAffinityGroupTypesParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -303,6 +505,13 @@ func (p *ListAffinityGroupTypesParams) SetKeyword(v string) {
 	return
 }
 
+// SetKeywordIfUnset sets keyword to v, unless it is already set
+func (p *ListAffinityGroupTypesParams) SetKeywordIfUnset(v string) {
+	if _, found := p.p["keyword"]; !found {
+		p.SetKeyword(v)
+	}
+}
+
 func (p *ListAffinityGroupTypesParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -311,6 +520,13 @@ func (p *ListAffinityGroupTypesParams) SetPage(v int) {
 	return
 }
 
+// SetPageIfUnset sets page to v, unless it is already set
+func (p *ListAffinityGroupTypesParams) SetPageIfUnset(v int) {
+	if _, found := p.p["page"]; !found {
+		p.SetPage(v)
+	}
+}
+
 func (p *ListAffinityGroupTypesParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -319,17 +535,43 @@ func (p *ListAffinityGroupTypesParams) SetPagesize(v int) {
 	return
 }
 
+// SetPagesizeIfUnset sets pagesize to v, unless it is already set
+func (p *ListAffinityGroupTypesParams) SetPagesizeIfUnset(v int) {
+	if _, found := p.p["pagesize"]; !found {
+		p.SetPagesize(v)
+	}
+}
+
 // You should always use this function to get a new ListAffinityGroupTypesParams instance,
-// as then you are sure you have configured all required params
-func (s *AffinityGroupService) NewListAffinityGroupTypesParams() *ListAffinityGroupTypesParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *AffinityGroupService) NewListAffinityGroupTypesParams(opts ...func(*ListAffinityGroupTypesParams)) *ListAffinityGroupTypesParams {
 	p := &ListAffinityGroupTypesParams{}
 	p.p = make(map[string]interface{})
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Lists affinity group types available
 func (s *AffinityGroupService) ListAffinityGroupTypes(p *ListAffinityGroupTypesParams) (*ListAffinityGroupTypesResponse, error) {
-	resp, err := s.cs.newRequest("listAffinityGroupTypes", p.toURLValues())
+	return s.ListAffinityGroupTypesWithContext(context.Background(), p)
+}
+
+// ListAffinityGroupTypesWithContext is the same as ListAffinityGroupTypes, but the request is cancelled when the context is done
+func (s *AffinityGroupService) ListAffinityGroupTypesWithContext(ctx context.Context, p *ListAffinityGroupTypesParams) (*ListAffinityGroupTypesResponse, error) {
+	return s.ListAffinityGroupTypesRawWithContext(ctx, p.toURLValues())
+}
+
+// ListAffinityGroupTypesRaw is the same as ListAffinityGroupTypes, but takes the params as url.Values instead of typed params
+func (s *AffinityGroupService) ListAffinityGroupTypesRaw(v url.Values) (*ListAffinityGroupTypesResponse, error) {
+	return s.ListAffinityGroupTypesRawWithContext(context.Background(), v)
+}
+
+// ListAffinityGroupTypesRawWithContext is the same as ListAffinityGroupTypesRaw, but the request is cancelled when the context is done
+func (s *AffinityGroupService) ListAffinityGroupTypesRawWithContext(ctx context.Context, v url.Values) (*ListAffinityGroupTypesResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "listAffinityGroupTypes", "listaffinitygrouptypesresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -342,6 +584,93 @@ func (s *AffinityGroupService) ListAffinityGroupTypes(p *ListAffinityGroupTypesP
 	return &r, nil
 }
 
+// StreamAffinityGroupTypes fetches the results of ListAffinityGroupTypes page by page in the background and sends them on the
+// returned channel. Both returned channels are closed when done. If fetching a page fails, or the context
+// is done before all results are sent, the error is sent on the error channel and fetching stops. A
+// request for a page in flight is cancelled when the context is done. The page and pagesize set in the
+// params are ignored.
+func (s *AffinityGroupService) StreamAffinityGroupTypes(ctx context.Context, p *ListAffinityGroupTypesParams) (<-chan *AffinityGroupType, <-chan error) {
+	ch := make(chan *AffinityGroupType)
+	errs := make(chan error, 1)
+
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesize(500)
+
+	go func() {
+		defer close(errs)
+		defer close(ch)
+
+		for page, count := 1, 0; ; page++ {
+			pp.SetPage(page)
+
+			l, err := s.ListAffinityGroupTypesWithContext(ctx, pp)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			for _, v := range l.AffinityGroupTypes {
+				select {
+				case ch <- v:
+				case <-ctx.Done():
+					errs <- ctx.Err()
+					return
+				}
+			}
+
+			count += len(l.AffinityGroupTypes)
+			if len(l.AffinityGroupTypes) < 500 || count >= l.Count {
+				return
+			}
+		}
+	}()
+
+	return ch, errs
+}
+
+// ListAffinityGroupTypesWhere returns all results of ListAffinityGroupTypes for which pred returns true. Note that the results are
+// filtered client-side, so all results matching the params are fetched first.
+// All pages are fetched, so the page and pagesize set in the params are ignored.
+func (s *AffinityGroupService) ListAffinityGroupTypesWhere(p *ListAffinityGroupTypesParams, pred func(*AffinityGroupType) bool) ([]*AffinityGroupType, error) {
+	var r []*AffinityGroupType
+	ch, errs := s.StreamAffinityGroupTypes(context.Background(), p)
+	for v := range ch {
+		if pred(v) {
+			r = append(r, v)
+		}
+	}
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ListAllAffinityGroupTypes returns the results of ListAffinityGroupTypes across all pages, by calling ListAffinityGroupTypes page by page until all
+// results are fetched. The pagesize set in the params is used for every page (500 if not set), and the
+// page set in the params is ignored.
+func (s *AffinityGroupService) ListAllAffinityGroupTypes(p *ListAffinityGroupTypesParams) ([]*AffinityGroupType, error) {
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesizeIfUnset(500)
+	pagesize := pp.p["pagesize"].(int)
+
+	var r []*AffinityGroupType
+	for page := 1; ; page++ {
+		pp.SetPage(page)
+
+		l, err := s.ListAffinityGroupTypes(pp)
+		if err != nil {
+			return nil, err
+		}
+		r = append(r, l.AffinityGroupTypes...)
+
+		if len(l.AffinityGroupTypes) < pagesize || len(r) >= l.Count {
+			return r, nil
+		}
+	}
+}
+
 type ListAffinityGroupTypesResponse struct {
 	Count              int                  `json:"count"`
 	AffinityGroupTypes []*AffinityGroupType `json:"affinitygrouptype"`
@@ -355,6 +684,16 @@ type ListAffinityGroupsParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ListAffinityGroupsParams) Clone() *ListAffinityGroupsParams {
+	c := &ListAffinityGroupsParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListAffinityGroupsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -411,6 +750,13 @@ func (p *ListAffinityGroupsParams) SetAccount(v string) {
 	return
 }
 
+// SetAccountIfUnset sets account to v, unless it is already set
+func (p *ListAffinityGroupsParams) SetAccountIfUnset(v string) {
+	if _, found := p.p["account"]; !found {
+		p.SetAccount(v)
+	}
+}
+
 func (p *ListAffinityGroupsParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -419,6 +765,13 @@ func (p *ListAffinityGroupsParams) SetDomainid(v string) {
 	return
 }
 
+// SetDomainidIfUnset sets domainid to v, unless it is already set
+func (p *ListAffinityGroupsParams) SetDomainidIfUnset(v string) {
+	if _, found := p.p["domainid"]; !found {
+		p.SetDomainid(v)
+	}
+}
+
 func (p *ListAffinityGroupsParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -427,6 +780,13 @@ func (p *ListAffinityGroupsParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *ListAffinityGroupsParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 func (p *ListAffinityGroupsParams) SetIsrecursive(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -435,6 +795,13 @@ func (p *ListAffinityGroupsParams) SetIsrecursive(v bool) {
 	return
 }
 
+// SetIsrecursiveIfUnset sets isrecursive to v, unless it is already set
+func (p *ListAffinityGroupsParams) SetIsrecursiveIfUnset(v bool) {
+	if _, found := p.p["isrecursive"]; !found {
+		p.SetIsrecursive(v)
+	}
+}
+
 func (p *ListAffinityGroupsParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -443,6 +810,13 @@ func (p *ListAffinityGroupsParams) SetKeyword(v string) {
 	return
 }
 
+// SetKeywordIfUnset sets keyword to v, unless it is already set
+func (p *ListAffinityGroupsParams) SetKeywordIfUnset(v string) {
+	if _, found := p.p["keyword"]; !found {
+		p.SetKeyword(v)
+	}
+}
+
 func (p *ListAffinityGroupsParams) SetListall(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -451,6 +825,13 @@ func (p *ListAffinityGroupsParams) SetListall(v bool) {
 	return
 }
 
+// SetListallIfUnset sets listall to v, unless it is already set
+func (p *ListAffinityGroupsParams) SetListallIfUnset(v bool) {
+	if _, found := p.p["listall"]; !found {
+		p.SetListall(v)
+	}
+}
+
 func (p *ListAffinityGroupsParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -459,6 +840,13 @@ func (p *ListAffinityGroupsParams) SetName(v string) {
 	return
 }
 
+// SetNameIfUnset sets name to v, unless it is already set
+func (p *ListAffinityGroupsParams) SetNameIfUnset(v string) {
+	if _, found := p.p["name"]; !found {
+		p.SetName(v)
+	}
+}
+
 func (p *ListAffinityGroupsParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -467,6 +855,13 @@ func (p *ListAffinityGroupsParams) SetPage(v int) {
 	return
 }
 
+// SetPageIfUnset sets page to v, unless it is already set
+func (p *ListAffinityGroupsParams) SetPageIfUnset(v int) {
+	if _, found := p.p["page"]; !found {
+		p.SetPage(v)
+	}
+}
+
 func (p *ListAffinityGroupsParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -475,6 +870,13 @@ func (p *ListAffinityGroupsParams) SetPagesize(v int) {
 	return
 }
 
+// SetPagesizeIfUnset sets pagesize to v, unless it is already set
+func (p *ListAffinityGroupsParams) SetPagesizeIfUnset(v int) {
+	if _, found := p.p["pagesize"]; !found {
+		p.SetPagesize(v)
+	}
+}
+
 func (p *ListAffinityGroupsParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -483,6 +885,13 @@ func (p *ListAffinityGroupsParams) SetProjectid(v string) {
 	return
 }
 
+// SetProjectidIfUnset sets projectid to v, unless it is already set
+func (p *ListAffinityGroupsParams) SetProjectidIfUnset(v string) {
+	if _, found := p.p["projectid"]; !found {
+		p.SetProjectid(v)
+	}
+}
+
 func (p *ListAffinityGroupsParams) SetType(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -491,6 +900,13 @@ func (p *ListAffinityGroupsParams) SetType(v string) {
 	return
 }
 
+// SetTypeIfUnset sets type to v, unless it is already set
+func (p *ListAffinityGroupsParams) SetTypeIfUnset(v string) {
+	if _, found := p.p["type"]; !found {
+		p.SetType(v)
+	}
+}
+
 func (p *ListAffinityGroupsParams) SetVirtualmachineid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -499,14 +915,34 @@ func (p *ListAffinityGroupsParams) SetVirtualmachineid(v string) {
 	return
 }
 
+// SetVirtualmachineidIfUnset sets virtualmachineid to v, unless it is already set
+func (p *ListAffinityGroupsParams) SetVirtualmachineidIfUnset(v string) {
+	if _, found := p.p["virtualmachineid"]; !found {
+		p.SetVirtualmachineid(v)
+	}
+}
+
+var _ ListAllSetter = (*ListAffinityGroupsParams)(nil)
+
 // You should always use this function to get a new ListAffinityGroupsParams instance,
-// as then you are sure you have configured all required params
-func (s *AffinityGroupService) NewListAffinityGroupsParams() *ListAffinityGroupsParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *AffinityGroupService) NewListAffinityGroupsParams(opts ...func(*ListAffinityGroupsParams)) *ListAffinityGroupsParams {
 	p := &ListAffinityGroupsParams{}
 	p.p = make(map[string]interface{})
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
+func (p *ListAffinityGroupsParams) setFirstMatch() {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p[firstMatchKey] = true
+}
+
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *AffinityGroupService) GetAffinityGroupID(name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListAffinityGroupsParams{}
@@ -514,7 +950,7 @@ func (s *AffinityGroupService) GetAffinityGroupID(name string, opts ...OptionFun
 
 	p.p["name"] = name
 
-	for _, fn := range append(s.cs.options, opts...) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
 		if err := fn(s.cs, p); err != nil {
 			return "", -1, err
 		}
@@ -530,7 +966,7 @@ func (s *AffinityGroupService) GetAffinityGroupID(name string, opts ...OptionFun
 	l.Count = len(l.AffinityGroups)
 
 	if l.Count == 0 {
-		return "", l.Count, fmt.Errorf("No match found for %s: %+v", name, l)
+		return "", l.Count, fmt.Errorf("%w for %s: %+v", ErrNotFound, name, l)
 	}
 
 	if l.Count == 1 {
@@ -543,6 +979,10 @@ func (s *AffinityGroupService) GetAffinityGroupID(name string, opts ...OptionFun
 				return v.Id, l.Count, nil
 			}
 		}
+
+		if _, ok := p.p[firstMatchKey]; ok && len(l.AffinityGroups) > 0 {
+			return l.AffinityGroups[0].Id, l.Count, nil
+		}
 	}
 	return "", l.Count, fmt.Errorf("Could not find an exact match for %s: %+v", name, l)
 }
@@ -568,7 +1008,7 @@ func (s *AffinityGroupService) GetAffinityGroupByID(id string, opts ...OptionFun
 
 	p.p["id"] = id
 
-	for _, fn := range append(s.cs.options, opts...) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
 		if err := fn(s.cs, p); err != nil {
 			return nil, -1, err
 		}
@@ -579,7 +1019,7 @@ func (s *AffinityGroupService) GetAffinityGroupByID(id string, opts ...OptionFun
 		if strings.Contains(err.Error(), fmt.Sprintf(
 			"Invalid parameter id value=%s due to incorrect long value format, "+
 				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+			return nil, 0, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
 		}
 		return nil, -1, err
 	}
@@ -589,18 +1029,50 @@ func (s *AffinityGroupService) GetAffinityGroupByID(id string, opts ...OptionFun
 	l.Count = len(l.AffinityGroups)
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
 	}
 
 	if l.Count == 1 {
 		return l.AffinityGroups[0], l.Count, nil
 	}
+
+	if _, ok := p.p[firstMatchKey]; ok && len(l.AffinityGroups) > 0 {
+		return l.AffinityGroups[0], l.Count, nil
+	}
 	return nil, l.Count, fmt.Errorf("There is more then one result for AffinityGroup UUID: %s!", id)
 }
 
+// AffinityGroupExists returns true if a AffinityGroup with the given ID exists. When no match is found
+// it returns false, while any other error is returned as is.
+func (s *AffinityGroupService) AffinityGroupExists(id string, opts ...OptionFunc) (bool, error) {
+	_, count, err := s.GetAffinityGroupByID(id, opts...)
+	if errors.Is(err, ErrNotFound) {
+		return false, nil
+	}
+	if count > 0 {
+		return true, nil
+	}
+	return false, err
+}
+
 // Lists affinity groups
 func (s *AffinityGroupService) ListAffinityGroups(p *ListAffinityGroupsParams) (*ListAffinityGroupsResponse, error) {
-	resp, err := s.cs.newRequest("listAffinityGroups", p.toURLValues())
+	return s.ListAffinityGroupsWithContext(context.Background(), p)
+}
+
+// ListAffinityGroupsWithContext is the same as ListAffinityGroups, but the request is cancelled when the context is done
+func (s *AffinityGroupService) ListAffinityGroupsWithContext(ctx context.Context, p *ListAffinityGroupsParams) (*ListAffinityGroupsResponse, error) {
+	return s.ListAffinityGroupsRawWithContext(ctx, p.toURLValues())
+}
+
+// ListAffinityGroupsRaw is the same as ListAffinityGroups, but takes the params as url.Values instead of typed params
+func (s *AffinityGroupService) ListAffinityGroupsRaw(v url.Values) (*ListAffinityGroupsResponse, error) {
+	return s.ListAffinityGroupsRawWithContext(context.Background(), v)
+}
+
+// ListAffinityGroupsRawWithContext is the same as ListAffinityGroupsRaw, but the request is cancelled when the context is done
+func (s *AffinityGroupService) ListAffinityGroupsRawWithContext(ctx context.Context, v url.Values) (*ListAffinityGroupsResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "listAffinityGroups", "listaffinitygroupsresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -613,6 +1085,93 @@ func (s *AffinityGroupService) ListAffinityGroups(p *ListAffinityGroupsParams) (
 	return &r, nil
 }
 
+// StreamAffinityGroups fetches the results of ListAffinityGroups page by page in the background and sends them on the
+// returned channel. Both returned channels are closed when done. If fetching a page fails, or the context
+// is done before all results are sent, the error is sent on the error channel and fetching stops. A
+// request for a page in flight is cancelled when the context is done. The page and pagesize set in the
+// params are ignored.
+func (s *AffinityGroupService) StreamAffinityGroups(ctx context.Context, p *ListAffinityGroupsParams) (<-chan *AffinityGroup, <-chan error) {
+	ch := make(chan *AffinityGroup)
+	errs := make(chan error, 1)
+
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesize(500)
+
+	go func() {
+		defer close(errs)
+		defer close(ch)
+
+		for page, count := 1, 0; ; page++ {
+			pp.SetPage(page)
+
+			l, err := s.ListAffinityGroupsWithContext(ctx, pp)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			for _, v := range l.AffinityGroups {
+				select {
+				case ch <- v:
+				case <-ctx.Done():
+					errs <- ctx.Err()
+					return
+				}
+			}
+
+			count += len(l.AffinityGroups)
+			if len(l.AffinityGroups) < 500 || count >= l.Count {
+				return
+			}
+		}
+	}()
+
+	return ch, errs
+}
+
+// ListAffinityGroupsWhere returns all results of ListAffinityGroups for which pred returns true. Note that the results are
+// filtered client-side, so all results matching the params are fetched first.
+// All pages are fetched, so the page and pagesize set in the params are ignored.
+func (s *AffinityGroupService) ListAffinityGroupsWhere(p *ListAffinityGroupsParams, pred func(*AffinityGroup) bool) ([]*AffinityGroup, error) {
+	var r []*AffinityGroup
+	ch, errs := s.StreamAffinityGroups(context.Background(), p)
+	for v := range ch {
+		if pred(v) {
+			r = append(r, v)
+		}
+	}
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ListAllAffinityGroups returns the results of ListAffinityGroups across all pages, by calling ListAffinityGroups page by page until all
+// results are fetched. The pagesize set in the params is used for every page (500 if not set), and the
+// page set in the params is ignored.
+func (s *AffinityGroupService) ListAllAffinityGroups(p *ListAffinityGroupsParams) ([]*AffinityGroup, error) {
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesizeIfUnset(500)
+	pagesize := pp.p["pagesize"].(int)
+
+	var r []*AffinityGroup
+	for page := 1; ; page++ {
+		pp.SetPage(page)
+
+		l, err := s.ListAffinityGroups(pp)
+		if err != nil {
+			return nil, err
+		}
+		r = append(r, l.AffinityGroups...)
+
+		if len(l.AffinityGroups) < pagesize || len(r) >= l.Count {
+			return r, nil
+		}
+	}
+}
+
 type ListAffinityGroupsResponse struct {
 	Count          int              `json:"count"`
 	AffinityGroups []*AffinityGroup `json:"affinitygroup"`
@@ -635,6 +1194,16 @@ type UpdateVMAffinityGroupParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *UpdateVMAffinityGroupParams) Clone() *UpdateVMAffinityGroupParams {
+	c := &UpdateVMAffinityGroupParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *UpdateVMAffinityGroupParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -654,6 +1223,16 @@ func (p *UpdateVMAffinityGroupParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *UpdateVMAffinityGroupParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of updateVMAffinityGroup", k)
+		}
+	}
+	return nil
+}
+
 func (p *UpdateVMAffinityGroupParams) SetAffinitygroupids(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -662,6 +1241,18 @@ func (p *UpdateVMAffinityGroupParams) SetAffinitygroupids(v []string) {
 	return
 }
 
+// SetAffinitygroupidsIfUnset sets affinitygroupids to v, unless it is already set
+func (p *UpdateVMAffinityGroupParams) SetAffinitygroupidsIfUnset(v []string) {
+	if _, found := p.p["affinitygroupids"]; !found {
+		p.SetAffinitygroupids(v)
+	}
+}
+
+// SetAffinitygroupidsCSV splits v on commas and sets the resulting values
+func (p *UpdateVMAffinityGroupParams) SetAffinitygroupidsCSV(v string) {
+	p.SetAffinitygroupids(splitCSV(v))
+}
+
 func (p *UpdateVMAffinityGroupParams) SetAffinitygroupnames(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -670,6 +1261,18 @@ func (p *UpdateVMAffinityGroupParams) SetAffinitygroupnames(v []string) {
 	return
 }
 
+// SetAffinitygroupnamesIfUnset sets affinitygroupnames to v, unless it is already set
+func (p *UpdateVMAffinityGroupParams) SetAffinitygroupnamesIfUnset(v []string) {
+	if _, found := p.p["affinitygroupnames"]; !found {
+		p.SetAffinitygroupnames(v)
+	}
+}
+
+// SetAffinitygroupnamesCSV splits v on commas and sets the resulting values
+func (p *UpdateVMAffinityGroupParams) SetAffinitygroupnamesCSV(v string) {
+	p.SetAffinitygroupnames(splitCSV(v))
+}
+
 func (p *UpdateVMAffinityGroupParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -678,18 +1281,54 @@ func (p *UpdateVMAffinityGroupParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *UpdateVMAffinityGroupParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 // You should always use this function to get a new UpdateVMAffinityGroupParams instance,
-// as then you are sure you have configured all required params
-func (s *AffinityGroupService) NewUpdateVMAffinityGroupParams(id string) *UpdateVMAffinityGroupParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *AffinityGroupService) NewUpdateVMAffinityGroupParams(id string, opts ...func(*UpdateVMAffinityGroupParams)) *UpdateVMAffinityGroupParams {
 	p := &UpdateVMAffinityGroupParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Updates the affinity/anti-affinity group associations of a virtual machine. The VM has to be stopped and restarted for the new properties to take effect.
-func (s *AffinityGroupService) UpdateVMAffinityGroup(p *UpdateVMAffinityGroupParams) (*UpdateVMAffinityGroupResponse, error) {
-	resp, err := s.cs.newRequest("updateVMAffinityGroup", p.toURLValues())
+func (s *AffinityGroupService) UpdateVMAffinityGroup(p *UpdateVMAffinityGroupParams, opts ...OptionFunc) (*UpdateVMAffinityGroupResponse, error) {
+	return s.UpdateVMAffinityGroupWithContext(context.Background(), p, opts...)
+}
+
+// UpdateVMAffinityGroupWithContext is the same as UpdateVMAffinityGroup, but the request is cancelled when the context is done
+func (s *AffinityGroupService) UpdateVMAffinityGroupWithContext(ctx context.Context, p *UpdateVMAffinityGroupParams, opts ...OptionFunc) (*UpdateVMAffinityGroupResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.UpdateVMAffinityGroupRawWithContext(ctx, p.toURLValues())
+}
+
+// UpdateVMAffinityGroupRaw is the same as UpdateVMAffinityGroup, but takes the params as url.Values instead of typed params
+func (s *AffinityGroupService) UpdateVMAffinityGroupRaw(v url.Values) (*UpdateVMAffinityGroupResponse, error) {
+	return s.UpdateVMAffinityGroupRawWithContext(context.Background(), v)
+}
+
+// UpdateVMAffinityGroupRawWithContext is the same as UpdateVMAffinityGroupRaw, but the request is cancelled when the context is done
+func (s *AffinityGroupService) UpdateVMAffinityGroupRawWithContext(ctx context.Context, v url.Values) (*UpdateVMAffinityGroupResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "updateVMAffinityGroup", "updatevirtualmachineresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -701,9 +1340,9 @@ func (s *AffinityGroupService) UpdateVMAffinityGroup(p *UpdateVMAffinityGroupPar
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -737,43 +1376,43 @@ type UpdateVMAffinityGroupResponse struct {
 		Type              string   `json:"type"`
 		VirtualmachineIds []string `json:"virtualmachineIds"`
 	} `json:"affinitygroup"`
-	Cpunumber             int               `json:"cpunumber"`
-	Cpuspeed              int               `json:"cpuspeed"`
-	Cpuused               string            `json:"cpuused"`
-	Created               string            `json:"created"`
-	Details               map[string]string `json:"details"`
-	Diskioread            int64             `json:"diskioread"`
-	Diskiowrite           int64             `json:"diskiowrite"`
-	Diskkbsread           int64             `json:"diskkbsread"`
-	Diskkbswrite          int64             `json:"diskkbswrite"`
-	Diskofferingid        string            `json:"diskofferingid"`
-	Diskofferingname      string            `json:"diskofferingname"`
-	Displayname           string            `json:"displayname"`
-	Displayvm             bool              `json:"displayvm"`
-	Domain                string            `json:"domain"`
-	Domainid              string            `json:"domainid"`
-	Forvirtualnetwork     bool              `json:"forvirtualnetwork"`
-	Group                 string            `json:"group"`
-	Groupid               string            `json:"groupid"`
-	Guestosid             string            `json:"guestosid"`
-	Haenable              bool              `json:"haenable"`
-	Hostid                string            `json:"hostid"`
-	Hostname              string            `json:"hostname"`
-	Hypervisor            string            `json:"hypervisor"`
-	Id                    string            `json:"id"`
-	Instancename          string            `json:"instancename"`
-	Isdynamicallyscalable bool              `json:"isdynamicallyscalable"`
-	Isodisplaytext        string            `json:"isodisplaytext"`
-	Isoid                 string            `json:"isoid"`
-	Isoname               string            `json:"isoname"`
-	Keypair               string            `json:"keypair"`
-	Memory                int               `json:"memory"`
-	Memoryintfreekbs      int64             `json:"memoryintfreekbs"`
-	Memorykbs             int64             `json:"memorykbs"`
-	Memorytargetkbs       int64             `json:"memorytargetkbs"`
-	Name                  string            `json:"name"`
-	Networkkbsread        int64             `json:"networkkbsread"`
-	Networkkbswrite       int64             `json:"networkkbswrite"`
+	Cpunumber             int       `json:"cpunumber"`
+	Cpuspeed              int       `json:"cpuspeed"`
+	Cpuused               string    `json:"cpuused"`
+	Created               string    `json:"created"`
+	Details               StringMap `json:"details"`
+	Diskioread            int64     `json:"diskioread"`
+	Diskiowrite           int64     `json:"diskiowrite"`
+	Diskkbsread           int64     `json:"diskkbsread"`
+	Diskkbswrite          int64     `json:"diskkbswrite"`
+	Diskofferingid        string    `json:"diskofferingid"`
+	Diskofferingname      string    `json:"diskofferingname"`
+	Displayname           string    `json:"displayname"`
+	Displayvm             bool      `json:"displayvm"`
+	Domain                string    `json:"domain"`
+	Domainid              string    `json:"domainid"`
+	Forvirtualnetwork     bool      `json:"forvirtualnetwork"`
+	Group                 string    `json:"group"`
+	Groupid               string    `json:"groupid"`
+	Guestosid             string    `json:"guestosid"`
+	Haenable              bool      `json:"haenable"`
+	Hostid                string    `json:"hostid"`
+	Hostname              string    `json:"hostname"`
+	Hypervisor            string    `json:"hypervisor"`
+	Id                    string    `json:"id"`
+	Instancename          string    `json:"instancename"`
+	Isdynamicallyscalable bool      `json:"isdynamicallyscalable"`
+	Isodisplaytext        string    `json:"isodisplaytext"`
+	Isoid                 string    `json:"isoid"`
+	Isoname               string    `json:"isoname"`
+	Keypair               string    `json:"keypair"`
+	Memory                int       `json:"memory"`
+	Memoryintfreekbs      int64     `json:"memoryintfreekbs"`
+	Memorykbs             int64     `json:"memorykbs"`
+	Memorytargetkbs       int64     `json:"memorytargetkbs"`
+	Name                  string    `json:"name"`
+	Networkkbsread        int64     `json:"networkkbsread"`
+	Networkkbswrite       int64     `json:"networkkbswrite"`
 	Nic                   []struct {
 		Broadcasturi         string `json:"broadcasturi"`
 		Deviceid             string `json:"deviceid"`
@@ -17,7 +17,9 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/url"
 	"strconv"
@@ -28,6 +30,16 @@ type ArchiveAlertsParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ArchiveAlertsParams) Clone() *ArchiveAlertsParams {
+	c := &ArchiveAlertsParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ArchiveAlertsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -57,6 +69,13 @@ func (p *ArchiveAlertsParams) SetEnddate(v string) {
 	return
 }
 
+// SetEnddateIfUnset sets enddate to v, unless it is already set
+func (p *ArchiveAlertsParams) SetEnddateIfUnset(v string) {
+	if _, found := p.p["enddate"]; !found {
+		p.SetEnddate(v)
+	}
+}
+
 func (p *ArchiveAlertsParams) SetIds(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -65,6 +84,18 @@ func (p *ArchiveAlertsParams) SetIds(v []string) {
 	return
 }
 
+// SetIdsIfUnset sets ids to v, unless it is already set
+func (p *ArchiveAlertsParams) SetIdsIfUnset(v []string) {
+	if _, found := p.p["ids"]; !found {
+		p.SetIds(v)
+	}
+}
+
+// SetIdsCSV splits v on commas and sets the resulting values
+func (p *ArchiveAlertsParams) SetIdsCSV(v string) {
+	p.SetIds(splitCSV(v))
+}
+
 func (p *ArchiveAlertsParams) SetStartdate(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -73,6 +104,13 @@ func (p *ArchiveAlertsParams) SetStartdate(v string) {
 	return
 }
 
+// SetStartdateIfUnset sets startdate to v, unless it is already set
+func (p *ArchiveAlertsParams) SetStartdateIfUnset(v string) {
+	if _, found := p.p["startdate"]; !found {
+		p.SetStartdate(v)
+	}
+}
+
 func (p *ArchiveAlertsParams) SetType(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -81,17 +119,49 @@ func (p *ArchiveAlertsParams) SetType(v string) {
 	return
 }
 
+// SetTypeIfUnset sets type to v, unless it is already set
+func (p *ArchiveAlertsParams) SetTypeIfUnset(v string) {
+	if _, found := p.p["type"]; !found {
+		p.SetType(v)
+	}
+}
+
 // You should always use this function to get a new ArchiveAlertsParams instance,
-// as then you are sure you have configured all required params
-func (s *AlertService) NewArchiveAlertsParams() *ArchiveAlertsParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *AlertService) NewArchiveAlertsParams(opts ...func(*ArchiveAlertsParams)) *ArchiveAlertsParams {
 	p := &ArchiveAlertsParams{}
 	p.p = make(map[string]interface{})
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Archive one or more alerts.
-func (s *AlertService) ArchiveAlerts(p *ArchiveAlertsParams) (*ArchiveAlertsResponse, error) {
-	resp, err := s.cs.newRequest("archiveAlerts", p.toURLValues())
+func (s *AlertService) ArchiveAlerts(p *ArchiveAlertsParams, opts ...OptionFunc) (*ArchiveAlertsResponse, error) {
+	return s.ArchiveAlertsWithContext(context.Background(), p, opts...)
+}
+
+// ArchiveAlertsWithContext is the same as ArchiveAlerts, but the request is cancelled when the context is done
+func (s *AlertService) ArchiveAlertsWithContext(ctx context.Context, p *ArchiveAlertsParams, opts ...OptionFunc) (*ArchiveAlertsResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	return s.ArchiveAlertsRawWithContext(ctx, p.toURLValues())
+}
+
+// ArchiveAlertsRaw is the same as ArchiveAlerts, but takes the params as url.Values instead of typed params
+func (s *AlertService) ArchiveAlertsRaw(v url.Values) (*ArchiveAlertsResponse, error) {
+	return s.ArchiveAlertsRawWithContext(context.Background(), v)
+}
+
+// ArchiveAlertsRawWithContext is the same as ArchiveAlertsRaw, but the request is cancelled when the context is done
+func (s *AlertService) ArchiveAlertsRawWithContext(ctx context.Context, v url.Values) (*ArchiveAlertsResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "archiveAlerts", "archivealertsresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -132,6 +202,16 @@ type DeleteAlertsParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *DeleteAlertsParams) Clone() *DeleteAlertsParams {
+	c := &DeleteAlertsParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeleteAlertsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -161,6 +241,13 @@ func (p *DeleteAlertsParams) SetEnddate(v string) {
 	return
 }
 
+// SetEnddateIfUnset sets enddate to v, unless it is already set
+func (p *DeleteAlertsParams) SetEnddateIfUnset(v string) {
+	if _, found := p.p["enddate"]; !found {
+		p.SetEnddate(v)
+	}
+}
+
 func (p *DeleteAlertsParams) SetIds(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -169,6 +256,18 @@ func (p *DeleteAlertsParams) SetIds(v []string) {
 	return
 }
 
+// SetIdsIfUnset sets ids to v, unless it is already set
+func (p *DeleteAlertsParams) SetIdsIfUnset(v []string) {
+	if _, found := p.p["ids"]; !found {
+		p.SetIds(v)
+	}
+}
+
+// SetIdsCSV splits v on commas and sets the resulting values
+func (p *DeleteAlertsParams) SetIdsCSV(v string) {
+	p.SetIds(splitCSV(v))
+}
+
 func (p *DeleteAlertsParams) SetStartdate(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -177,6 +276,13 @@ func (p *DeleteAlertsParams) SetStartdate(v string) {
 	return
 }
 
+// SetStartdateIfUnset sets startdate to v, unless it is already set
+func (p *DeleteAlertsParams) SetStartdateIfUnset(v string) {
+	if _, found := p.p["startdate"]; !found {
+		p.SetStartdate(v)
+	}
+}
+
 func (p *DeleteAlertsParams) SetType(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -185,17 +291,49 @@ func (p *DeleteAlertsParams) SetType(v string) {
 	return
 }
 
+// SetTypeIfUnset sets type to v, unless it is already set
+func (p *DeleteAlertsParams) SetTypeIfUnset(v string) {
+	if _, found := p.p["type"]; !found {
+		p.SetType(v)
+	}
+}
+
 // You should always use this function to get a new DeleteAlertsParams instance,
-// as then you are sure you have configured all required params
-func (s *AlertService) NewDeleteAlertsParams() *DeleteAlertsParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *AlertService) NewDeleteAlertsParams(opts ...func(*DeleteAlertsParams)) *DeleteAlertsParams {
 	p := &DeleteAlertsParams{}
 	p.p = make(map[string]interface{})
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Delete one or more alerts.
-func (s *AlertService) DeleteAlerts(p *DeleteAlertsParams) (*DeleteAlertsResponse, error) {
-	resp, err := s.cs.newRequest("deleteAlerts", p.toURLValues())
+func (s *AlertService) DeleteAlerts(p *DeleteAlertsParams, opts ...OptionFunc) (*DeleteAlertsResponse, error) {
+	return s.DeleteAlertsWithContext(context.Background(), p, opts...)
+}
+
+// DeleteAlertsWithContext is the same as DeleteAlerts, but the request is cancelled when the context is done
+func (s *AlertService) DeleteAlertsWithContext(ctx context.Context, p *DeleteAlertsParams, opts ...OptionFunc) (*DeleteAlertsResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	return s.DeleteAlertsRawWithContext(ctx, p.toURLValues())
+}
+
+// DeleteAlertsRaw is the same as DeleteAlerts, but takes the params as url.Values instead of typed params
+func (s *AlertService) DeleteAlertsRaw(v url.Values) (*DeleteAlertsResponse, error) {
+	return s.DeleteAlertsRawWithContext(context.Background(), v)
+}
+
+// DeleteAlertsRawWithContext is the same as DeleteAlertsRaw, but the request is cancelled when the context is done
+func (s *AlertService) DeleteAlertsRawWithContext(ctx context.Context, v url.Values) (*DeleteAlertsResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteAlerts", "deletealertsresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -236,6 +374,16 @@ type GenerateAlertParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *GenerateAlertParams) Clone() *GenerateAlertParams {
+	c := &GenerateAlertParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *GenerateAlertParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -260,6 +408,16 @@ func (p *GenerateAlertParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *GenerateAlertParams) validate() error {
+	for _, k := range []string{"description", "name", "type"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of generateAlert", k)
+		}
+	}
+	return nil
+}
+
 func (p *GenerateAlertParams) SetDescription(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -268,6 +426,13 @@ func (p *GenerateAlertParams) SetDescription(v string) {
 	return
 }
 
+// SetDescriptionIfUnset sets description to v, unless it is already set
+func (p *GenerateAlertParams) SetDescriptionIfUnset(v string) {
+	if _, found := p.p["description"]; !found {
+		p.SetDescription(v)
+	}
+}
+
 func (p *GenerateAlertParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -276,6 +441,13 @@ func (p *GenerateAlertParams) SetName(v string) {
 	return
 }
 
+// SetNameIfUnset sets name to v, unless it is already set
+func (p *GenerateAlertParams) SetNameIfUnset(v string) {
+	if _, found := p.p["name"]; !found {
+		p.SetName(v)
+	}
+}
+
 func (p *GenerateAlertParams) SetPodid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -284,6 +456,13 @@ func (p *GenerateAlertParams) SetPodid(v string) {
 	return
 }
 
+// SetPodidIfUnset sets podid to v, unless it is already set
+func (p *GenerateAlertParams) SetPodidIfUnset(v string) {
+	if _, found := p.p["podid"]; !found {
+		p.SetPodid(v)
+	}
+}
+
 func (p *GenerateAlertParams) SetType(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -292,6 +471,13 @@ func (p *GenerateAlertParams) SetType(v int) {
 	return
 }
 
+// SetTypeIfUnset sets type to v, unless it is already set
+func (p *GenerateAlertParams) SetTypeIfUnset(v int) {
+	if _, found := p.p["type"]; !found {
+		p.SetType(v)
+	}
+}
+
 func (p *GenerateAlertParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -300,20 +486,79 @@ func (p *GenerateAlertParams) SetZoneid(v string) {
 	return
 }
 
+// SetZoneidIfUnset sets zoneid to v, unless it is already set
+func (p *GenerateAlertParams) SetZoneidIfUnset(v string) {
+	if _, found := p.p["zoneid"]; !found {
+		p.SetZoneid(v)
+	}
+}
+
 // You should always use this function to get a new GenerateAlertParams instance,
-// as then you are sure you have configured all required params
-func (s *AlertService) NewGenerateAlertParams(description string, name string, alertType int) *GenerateAlertParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *AlertService) NewGenerateAlertParams(description string, name string, alertType int, opts ...func(*GenerateAlertParams)) *GenerateAlertParams {
 	p := &GenerateAlertParams{}
 	p.p = make(map[string]interface{})
 	p.p["description"] = description
 	p.p["name"] = name
 	p.p["type"] = alertType
+	for _, fn := range opts {
+		fn(p)
+	}
+	return p
+}
+
+// GenerateAlertOpts contains all params that can be set on a GenerateAlertParams.
+// Optional params are pointers (or slices and maps) which are only set when not nil.
+type GenerateAlertOpts struct {
+	Description string
+	Name        string
+	Podid       *string
+	Type        int
+	Zoneid      *string
+}
+
+// NewGenerateAlertParamsFromOpts is an alternative for NewGenerateAlertParams which takes all params using
+// an options struct, as that is easier to read for APIs with many required params
+func (s *AlertService) NewGenerateAlertParamsFromOpts(o GenerateAlertOpts) *GenerateAlertParams {
+	p := s.NewGenerateAlertParams(o.Description, o.Name, o.Type)
+	if o.Podid != nil {
+		p.p["podid"] = *o.Podid
+	}
+	if o.Zoneid != nil {
+		p.p["zoneid"] = *o.Zoneid
+	}
 	return p
 }
 
 // Generates an alert
-func (s *AlertService) GenerateAlert(p *GenerateAlertParams) (*GenerateAlertResponse, error) {
-	resp, err := s.cs.newRequest("generateAlert", p.toURLValues())
+func (s *AlertService) GenerateAlert(p *GenerateAlertParams, opts ...OptionFunc) (*GenerateAlertResponse, error) {
+	return s.GenerateAlertWithContext(context.Background(), p, opts...)
+}
+
+// GenerateAlertWithContext is the same as GenerateAlert, but the request is cancelled when the context is done
+func (s *AlertService) GenerateAlertWithContext(ctx context.Context, p *GenerateAlertParams, opts ...OptionFunc) (*GenerateAlertResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.GenerateAlertRawWithContext(ctx, p.toURLValues())
+}
+
+// GenerateAlertRaw is the same as GenerateAlert, but takes the params as url.Values instead of typed params
+func (s *AlertService) GenerateAlertRaw(v url.Values) (*GenerateAlertResponse, error) {
+	return s.GenerateAlertRawWithContext(context.Background(), v)
+}
+
+// GenerateAlertRawWithContext is the same as GenerateAlertRaw, but the request is cancelled when the context is done
+func (s *AlertService) GenerateAlertRawWithContext(ctx context.Context, v url.Values) (*GenerateAlertResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "generateAlert", "generatealertresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -325,9 +570,9 @@ func (s *AlertService) GenerateAlert(p *GenerateAlertParams) (*GenerateAlertResp
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -351,6 +596,16 @@ type ListAlertsParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ListAlertsParams) Clone() *ListAlertsParams {
+	c := &ListAlertsParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListAlertsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -387,6 +642,13 @@ func (p *ListAlertsParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *ListAlertsParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 func (p *ListAlertsParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -395,6 +657,13 @@ func (p *ListAlertsParams) SetKeyword(v string) {
 	return
 }
 
+// SetKeywordIfUnset sets keyword to v, unless it is already set
+func (p *ListAlertsParams) SetKeywordIfUnset(v string) {
+	if _, found := p.p["keyword"]; !found {
+		p.SetKeyword(v)
+	}
+}
+
 func (p *ListAlertsParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -403,6 +672,13 @@ func (p *ListAlertsParams) SetName(v string) {
 	return
 }
 
+// SetNameIfUnset sets name to v, unless it is already set
+func (p *ListAlertsParams) SetNameIfUnset(v string) {
+	if _, found := p.p["name"]; !found {
+		p.SetName(v)
+	}
+}
+
 func (p *ListAlertsParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -411,6 +687,13 @@ func (p *ListAlertsParams) SetPage(v int) {
 	return
 }
 
+// SetPageIfUnset sets page to v, unless it is already set
+func (p *ListAlertsParams) SetPageIfUnset(v int) {
+	if _, found := p.p["page"]; !found {
+		p.SetPage(v)
+	}
+}
+
 func (p *ListAlertsParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -419,6 +702,13 @@ func (p *ListAlertsParams) SetPagesize(v int) {
 	return
 }
 
+// SetPagesizeIfUnset sets pagesize to v, unless it is already set
+func (p *ListAlertsParams) SetPagesizeIfUnset(v int) {
+	if _, found := p.p["pagesize"]; !found {
+		p.SetPagesize(v)
+	}
+}
+
 func (p *ListAlertsParams) SetType(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -427,14 +717,32 @@ func (p *ListAlertsParams) SetType(v string) {
 	return
 }
 
+// SetTypeIfUnset sets type to v, unless it is already set
+func (p *ListAlertsParams) SetTypeIfUnset(v string) {
+	if _, found := p.p["type"]; !found {
+		p.SetType(v)
+	}
+}
+
 // You should always use this function to get a new ListAlertsParams instance,
-// as then you are sure you have configured all required params
-func (s *AlertService) NewListAlertsParams() *ListAlertsParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *AlertService) NewListAlertsParams(opts ...func(*ListAlertsParams)) *ListAlertsParams {
 	p := &ListAlertsParams{}
 	p.p = make(map[string]interface{})
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
+func (p *ListAlertsParams) setFirstMatch() {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p[firstMatchKey] = true
+}
+
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *AlertService) GetAlertID(name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListAlertsParams{}
@@ -442,7 +750,7 @@ func (s *AlertService) GetAlertID(name string, opts ...OptionFunc) (string, int,
 
 	p.p["name"] = name
 
-	for _, fn := range append(s.cs.options, opts...) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
 		if err := fn(s.cs, p); err != nil {
 			return "", -1, err
 		}
@@ -454,7 +762,7 @@ func (s *AlertService) GetAlertID(name string, opts ...OptionFunc) (string, int,
 	}
 
 	if l.Count == 0 {
-		return "", l.Count, fmt.Errorf("No match found for %s: %+v", name, l)
+		return "", l.Count, fmt.Errorf("%w for %s: %+v", ErrNotFound, name, l)
 	}
 
 	if l.Count == 1 {
@@ -467,6 +775,10 @@ func (s *AlertService) GetAlertID(name string, opts ...OptionFunc) (string, int,
 				return v.Id, l.Count, nil
 			}
 		}
+
+		if _, ok := p.p[firstMatchKey]; ok && len(l.Alerts) > 0 {
+			return l.Alerts[0].Id, l.Count, nil
+		}
 	}
 	return "", l.Count, fmt.Errorf("Could not find an exact match for %s: %+v", name, l)
 }
@@ -492,7 +804,7 @@ func (s *AlertService) GetAlertByID(id string, opts ...OptionFunc) (*Alert, int,
 
 	p.p["id"] = id
 
-	for _, fn := range append(s.cs.options, opts...) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
 		if err := fn(s.cs, p); err != nil {
 			return nil, -1, err
 		}
@@ -503,24 +815,56 @@ func (s *AlertService) GetAlertByID(id string, opts ...OptionFunc) (*Alert, int,
 		if strings.Contains(err.Error(), fmt.Sprintf(
 			"Invalid parameter id value=%s due to incorrect long value format, "+
 				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+			return nil, 0, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
 	}
 
 	if l.Count == 1 {
 		return l.Alerts[0], l.Count, nil
 	}
+
+	if _, ok := p.p[firstMatchKey]; ok && len(l.Alerts) > 0 {
+		return l.Alerts[0], l.Count, nil
+	}
 	return nil, l.Count, fmt.Errorf("There is more then one result for Alert UUID: %s!", id)
 }
 
+// AlertExists returns true if a Alert with the given ID exists. When no match is found
+// it returns false, while any other error is returned as is.
+func (s *AlertService) AlertExists(id string, opts ...OptionFunc) (bool, error) {
+	_, count, err := s.GetAlertByID(id, opts...)
+	if errors.Is(err, ErrNotFound) {
+		return false, nil
+	}
+	if count > 0 {
+		return true, nil
+	}
+	return false, err
+}
+
 // Lists all alerts.
 func (s *AlertService) ListAlerts(p *ListAlertsParams) (*ListAlertsResponse, error) {
-	resp, err := s.cs.newRequest("listAlerts", p.toURLValues())
+	return s.ListAlertsWithContext(context.Background(), p)
+}
+
+// ListAlertsWithContext is the same as ListAlerts, but the request is cancelled when the context is done
+func (s *AlertService) ListAlertsWithContext(ctx context.Context, p *ListAlertsParams) (*ListAlertsResponse, error) {
+	return s.ListAlertsRawWithContext(ctx, p.toURLValues())
+}
+
+// ListAlertsRaw is the same as ListAlerts, but takes the params as url.Values instead of typed params
+func (s *AlertService) ListAlertsRaw(v url.Values) (*ListAlertsResponse, error) {
+	return s.ListAlertsRawWithContext(context.Background(), v)
+}
+
+// ListAlertsRawWithContext is the same as ListAlertsRaw, but the request is cancelled when the context is done
+func (s *AlertService) ListAlertsRawWithContext(ctx context.Context, v url.Values) (*ListAlertsResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "listAlerts", "listalertsresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -533,6 +877,93 @@ func (s *AlertService) ListAlerts(p *ListAlertsParams) (*ListAlertsResponse, err
 	return &r, nil
 }
 
+// StreamAlerts fetches the results of ListAlerts page by page in the background and sends them on the
+// returned channel. Both returned channels are closed when done. If fetching a page fails, or the context
+// is done before all results are sent, the error is sent on the error channel and fetching stops. A
+// request for a page in flight is cancelled when the context is done. The page and pagesize set in the
+// params are ignored.
+func (s *AlertService) StreamAlerts(ctx context.Context, p *ListAlertsParams) (<-chan *Alert, <-chan error) {
+	ch := make(chan *Alert)
+	errs := make(chan error, 1)
+
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesize(500)
+
+	go func() {
+		defer close(errs)
+		defer close(ch)
+
+		for page, count := 1, 0; ; page++ {
+			pp.SetPage(page)
+
+			l, err := s.ListAlertsWithContext(ctx, pp)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			for _, v := range l.Alerts {
+				select {
+				case ch <- v:
+				case <-ctx.Done():
+					errs <- ctx.Err()
+					return
+				}
+			}
+
+			count += len(l.Alerts)
+			if len(l.Alerts) < 500 || count >= l.Count {
+				return
+			}
+		}
+	}()
+
+	return ch, errs
+}
+
+// ListAlertsWhere returns all results of ListAlerts for which pred returns true. Note that the results are
+// filtered client-side, so all results matching the params are fetched first.
+// All pages are fetched, so the page and pagesize set in the params are ignored.
+func (s *AlertService) ListAlertsWhere(p *ListAlertsParams, pred func(*Alert) bool) ([]*Alert, error) {
+	var r []*Alert
+	ch, errs := s.StreamAlerts(context.Background(), p)
+	for v := range ch {
+		if pred(v) {
+			r = append(r, v)
+		}
+	}
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ListAllAlerts returns the results of ListAlerts across all pages, by calling ListAlerts page by page until all
+// results are fetched. The pagesize set in the params is used for every page (500 if not set), and the
+// page set in the params is ignored.
+func (s *AlertService) ListAllAlerts(p *ListAlertsParams) ([]*Alert, error) {
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesizeIfUnset(500)
+	pagesize := pp.p["pagesize"].(int)
+
+	var r []*Alert
+	for page := 1; ; page++ {
+		pp.SetPage(page)
+
+		l, err := s.ListAlerts(pp)
+		if err != nil {
+			return nil, err
+		}
+		r = append(r, l.Alerts...)
+
+		if len(l.Alerts) < pagesize || len(r) >= l.Count {
+			return r, nil
+		}
+	}
+}
+
 type ListAlertsResponse struct {
 	Count  int      `json:"count"`
 	Alerts []*Alert `json:"alert"`
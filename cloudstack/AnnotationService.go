@@ -0,0 +1,104 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstack
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// Annotation represents a note attached to a resource
+type Annotation struct {
+	Annotation string `json:"annotation"`
+	Created    string `json:"created"`
+	Entityid   string `json:"entityid"`
+	Entitytype string `json:"entitytype"`
+	Id         string `json:"id"`
+	Removed    string `json:"removed"`
+	Userid     string `json:"userid"`
+}
+
+// AddNote attaches a note to the entity with the given type (e.g. HOST or VM) and ID. The
+// annotations API is only available on CloudStack 4.11 and later.
+func (s *AnnotationService) AddNote(entityType, entityId, note string) (*Annotation, error) {
+	p := url.Values{}
+	p.Set("entitytype", entityType)
+	p.Set("entityid", entityId)
+	p.Set("annotation", note)
+
+	resp, err := s.cs.newRequest("addAnnotation", p)
+	if err != nil {
+		return nil, annotationError(err)
+	}
+
+	if resp, err = getRawValue(resp); err != nil {
+		return nil, err
+	}
+
+	var r Annotation
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+// ListNotes returns all notes attached to the entity with the given type and ID. The
+// annotations API is only available on CloudStack 4.11 and later.
+func (s *AnnotationService) ListNotes(entityType, entityId string) ([]*Annotation, error) {
+	p := url.Values{}
+	p.Set("entitytype", entityType)
+	p.Set("entityid", entityId)
+
+	resp, err := s.cs.newRequest("listAnnotations", p)
+	if err != nil {
+		return nil, annotationError(err)
+	}
+
+	var r struct {
+		Count       int           `json:"count"`
+		Annotations []*Annotation `json:"annotation"`
+	}
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return r.Annotations, nil
+}
+
+// RemoveNote removes the note with the given ID. The annotations API is only available
+// on CloudStack 4.11 and later.
+func (s *AnnotationService) RemoveNote(id string) error {
+	p := url.Values{}
+	p.Set("id", id)
+
+	if _, err := s.cs.newRequest("removeAnnotation", p); err != nil {
+		return annotationError(err)
+	}
+
+	return nil
+}
+
+// Returns a clear error when the annotations API is not available on the server
+func annotationError(err error) error {
+	if strings.Contains(err.Error(), "CloudStack API error 432") {
+		return fmt.Errorf("The annotations API is not available on this server, it requires CloudStack 4.11 or later: %v", err)
+	}
+	return err
+}
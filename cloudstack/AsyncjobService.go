@@ -17,16 +17,135 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"net/url"
 	"strconv"
 	"time"
 )
 
+// ResultText returns the job result and true if the result type of the job is text, which
+// is for example the case for the error message of a failed job.
+func (r *QueryAsyncJobResultResponse) ResultText() (string, bool) {
+	if r.Jobresulttype != "text" {
+		return "", false
+	}
+
+	var text string
+	if err := json.Unmarshal(r.Jobresult, &text); err != nil {
+		return string(r.Jobresult), true
+	}
+	return text, true
+}
+
+// ResultAs decodes the job result into out. If the result type of the job is text instead
+// of an object, the text is returned as an error.
+func (r *QueryAsyncJobResultResponse) ResultAs(out interface{}) error {
+	if text, ok := r.ResultText(); ok {
+		return fmt.Errorf("Unable to decode text job result: %s", text)
+	}
+	return json.Unmarshal(r.Jobresult, out)
+}
+
+// InstanceRef returns the type and ID of the resource the job acted on, for example
+// "VirtualMachine" and the ID of the deployed virtual machine. Both are empty if the
+// job is not related to a specific resource.
+func (r *QueryAsyncJobResultResponse) InstanceRef() (resourceType, id string) {
+	return r.Jobinstancetype, r.Jobinstanceid
+}
+
+// The number of jobs from which on QueryJobs lists the jobs using a single call, instead of querying them
+// one by one, as listing all jobs is expensive when only a few of them are needed
+const minJobsToList = 10
+
+// QueryJobs returns the current state of the async jobs with the given IDs, mapped by job ID. When
+// querying many jobs, it uses a single listAsyncJobs call to get the state of all jobs at once, and only
+// falls back to querying jobs one by one for the jobs which are not listed, or if listAsyncJobs is not
+// available to the caller. Fewer jobs are queried one by one, to avoid listing all jobs of the account.
+func (cs *CloudStackClient) QueryJobs(jobids []string) (map[string]*AsyncJob, error) {
+	jobs := make(map[string]*AsyncJob, len(jobids))
+
+	if len(jobids) >= minJobsToList {
+		if err := cs.listJobs(jobids, jobs); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, id := range jobids {
+		if _, found := jobs[id]; found {
+			continue
+		}
+
+		resp, err := cs.newRequest("queryAsyncJobResult", cs.Asyncjob.NewQueryAsyncJobResultParams(id).toURLValues())
+		if err != nil {
+			return nil, err
+		}
+
+		var j AsyncJob
+		if err := json.Unmarshal(resp, &j); err != nil {
+			return nil, err
+		}
+		jobs[id] = &j
+	}
+
+	return jobs, nil
+}
+
+// Adds the listed jobs with the given IDs to the jobs. When listAsyncJobs is not available to the caller
+// (error code 432) no jobs are added, so all jobs are queried one by one instead.
+func (cs *CloudStackClient) listJobs(jobids []string, jobs map[string]*AsyncJob) error {
+	p := cs.Asyncjob.NewListAsyncJobsParams()
+	p.SetListall(true)
+
+	// The listApis response doesn't include the jobid field of the listed jobs,
+	// so decode it next to the generated AsyncJob type
+	var l struct {
+		AsyncJobs []struct {
+			JobID string `json:"jobid"`
+			AsyncJob
+		} `json:"asyncjobs"`
+	}
+
+	resp, err := cs.newRequest("listAsyncJobs", p.toURLValues())
+	if err != nil {
+		var ae *APIError
+		if errors.As(err, &ae) && ae.ErrorCode == 432 {
+			return nil
+		}
+		return err
+	}
+	if err := json.Unmarshal(resp, &l); err != nil {
+		return err
+	}
+
+	wanted := make(map[string]bool, len(jobids))
+	for _, id := range jobids {
+		wanted[id] = true
+	}
+	for i := range l.AsyncJobs {
+		if j := &l.AsyncJobs[i]; wanted[j.JobID] {
+			jobs[j.JobID] = &j.AsyncJob
+		}
+	}
+	return nil
+}
+
 type ListAsyncJobsParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ListAsyncJobsParams) Clone() *ListAsyncJobsParams {
+	c := &ListAsyncJobsParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListAsyncJobsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -71,6 +190,13 @@ func (p *ListAsyncJobsParams) SetAccount(v string) {
 	return
 }
 
+// SetAccountIfUnset sets account to v, unless it is already set
+func (p *ListAsyncJobsParams) SetAccountIfUnset(v string) {
+	if _, found := p.p["account"]; !found {
+		p.SetAccount(v)
+	}
+}
+
 func (p *ListAsyncJobsParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -79,6 +205,13 @@ func (p *ListAsyncJobsParams) SetDomainid(v string) {
 	return
 }
 
+// SetDomainidIfUnset sets domainid to v, unless it is already set
+func (p *ListAsyncJobsParams) SetDomainidIfUnset(v string) {
+	if _, found := p.p["domainid"]; !found {
+		p.SetDomainid(v)
+	}
+}
+
 func (p *ListAsyncJobsParams) SetIsrecursive(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -87,6 +220,13 @@ func (p *ListAsyncJobsParams) SetIsrecursive(v bool) {
 	return
 }
 
+// SetIsrecursiveIfUnset sets isrecursive to v, unless it is already set
+func (p *ListAsyncJobsParams) SetIsrecursiveIfUnset(v bool) {
+	if _, found := p.p["isrecursive"]; !found {
+		p.SetIsrecursive(v)
+	}
+}
+
 func (p *ListAsyncJobsParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -95,6 +235,13 @@ func (p *ListAsyncJobsParams) SetKeyword(v string) {
 	return
 }
 
+// SetKeywordIfUnset sets keyword to v, unless it is already set
+func (p *ListAsyncJobsParams) SetKeywordIfUnset(v string) {
+	if _, found := p.p["keyword"]; !found {
+		p.SetKeyword(v)
+	}
+}
+
 func (p *ListAsyncJobsParams) SetListall(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -103,6 +250,13 @@ func (p *ListAsyncJobsParams) SetListall(v bool) {
 	return
 }
 
+// SetListallIfUnset sets listall to v, unless it is already set
+func (p *ListAsyncJobsParams) SetListallIfUnset(v bool) {
+	if _, found := p.p["listall"]; !found {
+		p.SetListall(v)
+	}
+}
+
 func (p *ListAsyncJobsParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -111,6 +265,13 @@ func (p *ListAsyncJobsParams) SetPage(v int) {
 	return
 }
 
+// SetPageIfUnset sets page to v, unless it is already set
+func (p *ListAsyncJobsParams) SetPageIfUnset(v int) {
+	if _, found := p.p["page"]; !found {
+		p.SetPage(v)
+	}
+}
+
 func (p *ListAsyncJobsParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -119,6 +280,13 @@ func (p *ListAsyncJobsParams) SetPagesize(v int) {
 	return
 }
 
+// SetPagesizeIfUnset sets pagesize to v, unless it is already set
+func (p *ListAsyncJobsParams) SetPagesizeIfUnset(v int) {
+	if _, found := p.p["pagesize"]; !found {
+		p.SetPagesize(v)
+	}
+}
+
 func (p *ListAsyncJobsParams) SetStartdate(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -127,17 +295,45 @@ func (p *ListAsyncJobsParams) SetStartdate(v string) {
 	return
 }
 
+// SetStartdateIfUnset sets startdate to v, unless it is already set
+func (p *ListAsyncJobsParams) SetStartdateIfUnset(v string) {
+	if _, found := p.p["startdate"]; !found {
+		p.SetStartdate(v)
+	}
+}
+
+var _ ListAllSetter = (*ListAsyncJobsParams)(nil)
+
 // You should always use this function to get a new ListAsyncJobsParams instance,
-// as then you are sure you have configured all required params
-func (s *AsyncjobService) NewListAsyncJobsParams() *ListAsyncJobsParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *AsyncjobService) NewListAsyncJobsParams(opts ...func(*ListAsyncJobsParams)) *ListAsyncJobsParams {
 	p := &ListAsyncJobsParams{}
 	p.p = make(map[string]interface{})
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Lists all pending asynchronous jobs for the account.
 func (s *AsyncjobService) ListAsyncJobs(p *ListAsyncJobsParams) (*ListAsyncJobsResponse, error) {
-	resp, err := s.cs.newRequest("listAsyncJobs", p.toURLValues())
+	return s.ListAsyncJobsWithContext(context.Background(), p)
+}
+
+// ListAsyncJobsWithContext is the same as ListAsyncJobs, but the request is cancelled when the context is done
+func (s *AsyncjobService) ListAsyncJobsWithContext(ctx context.Context, p *ListAsyncJobsParams) (*ListAsyncJobsResponse, error) {
+	return s.ListAsyncJobsRawWithContext(ctx, p.toURLValues())
+}
+
+// ListAsyncJobsRaw is the same as ListAsyncJobs, but takes the params as url.Values instead of typed params
+func (s *AsyncjobService) ListAsyncJobsRaw(v url.Values) (*ListAsyncJobsResponse, error) {
+	return s.ListAsyncJobsRawWithContext(context.Background(), v)
+}
+
+// ListAsyncJobsRawWithContext is the same as ListAsyncJobsRaw, but the request is cancelled when the context is done
+func (s *AsyncjobService) ListAsyncJobsRawWithContext(ctx context.Context, v url.Values) (*ListAsyncJobsResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "listAsyncJobs", "listasyncjobsresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -150,6 +346,93 @@ func (s *AsyncjobService) ListAsyncJobs(p *ListAsyncJobsParams) (*ListAsyncJobsR
 	return &r, nil
 }
 
+// StreamAsyncJobs fetches the results of ListAsyncJobs page by page in the background and sends them on the
+// returned channel. Both returned channels are closed when done. If fetching a page fails, or the context
+// is done before all results are sent, the error is sent on the error channel and fetching stops. A
+// request for a page in flight is cancelled when the context is done. The page and pagesize set in the
+// params are ignored.
+func (s *AsyncjobService) StreamAsyncJobs(ctx context.Context, p *ListAsyncJobsParams) (<-chan *AsyncJob, <-chan error) {
+	ch := make(chan *AsyncJob)
+	errs := make(chan error, 1)
+
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesize(500)
+
+	go func() {
+		defer close(errs)
+		defer close(ch)
+
+		for page, count := 1, 0; ; page++ {
+			pp.SetPage(page)
+
+			l, err := s.ListAsyncJobsWithContext(ctx, pp)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			for _, v := range l.AsyncJobs {
+				select {
+				case ch <- v:
+				case <-ctx.Done():
+					errs <- ctx.Err()
+					return
+				}
+			}
+
+			count += len(l.AsyncJobs)
+			if len(l.AsyncJobs) < 500 || count >= l.Count {
+				return
+			}
+		}
+	}()
+
+	return ch, errs
+}
+
+// ListAsyncJobsWhere returns all results of ListAsyncJobs for which pred returns true. Note that the results are
+// filtered client-side, so all results matching the params are fetched first.
+// All pages are fetched, so the page and pagesize set in the params are ignored.
+func (s *AsyncjobService) ListAsyncJobsWhere(p *ListAsyncJobsParams, pred func(*AsyncJob) bool) ([]*AsyncJob, error) {
+	var r []*AsyncJob
+	ch, errs := s.StreamAsyncJobs(context.Background(), p)
+	for v := range ch {
+		if pred(v) {
+			r = append(r, v)
+		}
+	}
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ListAllAsyncJobs returns the results of ListAsyncJobs across all pages, by calling ListAsyncJobs page by page until all
+// results are fetched. The pagesize set in the params is used for every page (500 if not set), and the
+// page set in the params is ignored.
+func (s *AsyncjobService) ListAllAsyncJobs(p *ListAsyncJobsParams) ([]*AsyncJob, error) {
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesizeIfUnset(500)
+	pagesize := pp.p["pagesize"].(int)
+
+	var r []*AsyncJob
+	for page := 1; ; page++ {
+		pp.SetPage(page)
+
+		l, err := s.ListAsyncJobs(pp)
+		if err != nil {
+			return nil, err
+		}
+		r = append(r, l.AsyncJobs...)
+
+		if len(l.AsyncJobs) < pagesize || len(r) >= l.Count {
+			return r, nil
+		}
+	}
+}
+
 type ListAsyncJobsResponse struct {
 	Count     int         `json:"count"`
 	AsyncJobs []*AsyncJob `json:"asyncjobs"`
@@ -173,6 +456,16 @@ type QueryAsyncJobResultParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *QueryAsyncJobResultParams) Clone() *QueryAsyncJobResultParams {
+	c := &QueryAsyncJobResultParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *QueryAsyncJobResultParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -184,6 +477,16 @@ func (p *QueryAsyncJobResultParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *QueryAsyncJobResultParams) validate() error {
+	for _, k := range []string{"jobid"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of queryAsyncJobResult", k)
+		}
+	}
+	return nil
+}
+
 func (p *QueryAsyncJobResultParams) SetJobid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -192,27 +495,66 @@ func (p *QueryAsyncJobResultParams) SetJobid(v string) {
 	return
 }
 
+// SetJobidIfUnset sets jobid to v, unless it is already set
+func (p *QueryAsyncJobResultParams) SetJobidIfUnset(v string) {
+	if _, found := p.p["jobid"]; !found {
+		p.SetJobid(v)
+	}
+}
+
 // You should always use this function to get a new QueryAsyncJobResultParams instance,
-// as then you are sure you have configured all required params
-func (s *AsyncjobService) NewQueryAsyncJobResultParams(jobid string) *QueryAsyncJobResultParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *AsyncjobService) NewQueryAsyncJobResultParams(jobid string, opts ...func(*QueryAsyncJobResultParams)) *QueryAsyncJobResultParams {
 	p := &QueryAsyncJobResultParams{}
 	p.p = make(map[string]interface{})
 	p.p["jobid"] = jobid
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Retrieves the current status of asynchronous job.
-func (s *AsyncjobService) QueryAsyncJobResult(p *QueryAsyncJobResultParams) (*QueryAsyncJobResultResponse, error) {
+func (s *AsyncjobService) QueryAsyncJobResult(p *QueryAsyncJobResultParams, opts ...OptionFunc) (*QueryAsyncJobResultResponse, error) {
+	return s.QueryAsyncJobResultWithContext(context.Background(), p, opts...)
+}
+
+// QueryAsyncJobResultWithContext is the same as QueryAsyncJobResult, but the request is cancelled when the context is done
+func (s *AsyncjobService) QueryAsyncJobResultWithContext(ctx context.Context, p *QueryAsyncJobResultParams, opts ...OptionFunc) (*QueryAsyncJobResultResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.QueryAsyncJobResultRawWithContext(ctx, p.toURLValues())
+}
+
+// QueryAsyncJobResultRaw is the same as QueryAsyncJobResult, but takes the params as url.Values instead of typed params
+func (s *AsyncjobService) QueryAsyncJobResultRaw(v url.Values) (*QueryAsyncJobResultResponse, error) {
+	return s.QueryAsyncJobResultRawWithContext(context.Background(), v)
+}
+
+// QueryAsyncJobResultRawWithContext is the same as QueryAsyncJobResultRaw, but the request is cancelled when the context is done
+func (s *AsyncjobService) QueryAsyncJobResultRawWithContext(ctx context.Context, v url.Values) (*QueryAsyncJobResultResponse, error) {
 	var resp json.RawMessage
 	var err error
 
 	// We should be able to retry on failure as this call is idempotent
 	for i := 0; i < 3; i++ {
-		resp, err = s.cs.newRequest("queryAsyncJobResult", p.toURLValues())
-		if err == nil {
+		resp, err = s.cs.newRequestWithContext(ctx, "queryAsyncJobResult", "queryasyncjobresultresponse", v)
+		if err == nil || ctx.Err() != nil {
 			break
 		}
-		time.Sleep(500 * time.Millisecond)
+		select {
+		case <-ctx.Done():
+		case <-time.After(500 * time.Millisecond):
+		}
 	}
 	if err != nil {
 		return nil, err
@@ -17,7 +17,9 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
 	"net/url"
 	"strconv"
 )
@@ -26,6 +28,16 @@ type LoginParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *LoginParams) Clone() *LoginParams {
+	c := &LoginParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *LoginParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -47,6 +59,16 @@ func (p *LoginParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *LoginParams) validate() error {
+	for _, k := range []string{"password", "username"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of login", k)
+		}
+	}
+	return nil
+}
+
 func (p *LoginParams) SetDomain(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -55,6 +77,13 @@ func (p *LoginParams) SetDomain(v string) {
 	return
 }
 
+// SetDomainIfUnset sets domain to v, unless it is already set
+func (p *LoginParams) SetDomainIfUnset(v string) {
+	if _, found := p.p["domain"]; !found {
+		p.SetDomain(v)
+	}
+}
+
 func (p *LoginParams) SetDomainId(v int64) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -63,6 +92,13 @@ func (p *LoginParams) SetDomainId(v int64) {
 	return
 }
 
+// SetDomainIdIfUnset sets domainId to v, unless it is already set
+func (p *LoginParams) SetDomainIdIfUnset(v int64) {
+	if _, found := p.p["domainId"]; !found {
+		p.SetDomainId(v)
+	}
+}
+
 func (p *LoginParams) SetPassword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -71,6 +107,13 @@ func (p *LoginParams) SetPassword(v string) {
 	return
 }
 
+// SetPasswordIfUnset sets password to v, unless it is already set
+func (p *LoginParams) SetPasswordIfUnset(v string) {
+	if _, found := p.p["password"]; !found {
+		p.SetPassword(v)
+	}
+}
+
 func (p *LoginParams) SetUsername(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -79,19 +122,55 @@ func (p *LoginParams) SetUsername(v string) {
 	return
 }
 
+// SetUsernameIfUnset sets username to v, unless it is already set
+func (p *LoginParams) SetUsernameIfUnset(v string) {
+	if _, found := p.p["username"]; !found {
+		p.SetUsername(v)
+	}
+}
+
 // You should always use this function to get a new LoginParams instance,
-// as then you are sure you have configured all required params
-func (s *AuthenticationService) NewLoginParams(password string, username string) *LoginParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *AuthenticationService) NewLoginParams(password string, username string, opts ...func(*LoginParams)) *LoginParams {
 	p := &LoginParams{}
 	p.p = make(map[string]interface{})
 	p.p["password"] = password
 	p.p["username"] = username
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Logs a user into the CloudStack. A successful login attempt will generate a JSESSIONID cookie value that can be passed in subsequent Query command calls until the "logout" command has been issued or the session has expired.
-func (s *AuthenticationService) Login(p *LoginParams) (*LoginResponse, error) {
-	resp, err := s.cs.newRequest("login", p.toURLValues())
+func (s *AuthenticationService) Login(p *LoginParams, opts ...OptionFunc) (*LoginResponse, error) {
+	return s.LoginWithContext(context.Background(), p, opts...)
+}
+
+// LoginWithContext is the same as Login, but the request is cancelled when the context is done
+func (s *AuthenticationService) LoginWithContext(ctx context.Context, p *LoginParams, opts ...OptionFunc) (*LoginResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.LoginRawWithContext(ctx, p.toURLValues())
+}
+
+// LoginRaw is the same as Login, but takes the params as url.Values instead of typed params
+func (s *AuthenticationService) LoginRaw(v url.Values) (*LoginResponse, error) {
+	return s.LoginRawWithContext(context.Background(), v)
+}
+
+// LoginRawWithContext is the same as LoginRaw, but the request is cancelled when the context is done
+func (s *AuthenticationService) LoginRawWithContext(ctx context.Context, v url.Values) (*LoginResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "login", "loginresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -122,6 +201,16 @@ type LogoutParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *LogoutParams) Clone() *LogoutParams {
+	c := &LogoutParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *LogoutParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -131,16 +220,41 @@ func (p *LogoutParams) toURLValues() url.Values {
 }
 
 // You should always use this function to get a new LogoutParams instance,
-// as then you are sure you have configured all required params
-func (s *AuthenticationService) NewLogoutParams() *LogoutParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *AuthenticationService) NewLogoutParams(opts ...func(*LogoutParams)) *LogoutParams {
 	p := &LogoutParams{}
 	p.p = make(map[string]interface{})
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Logs out the user
-func (s *AuthenticationService) Logout(p *LogoutParams) (*LogoutResponse, error) {
-	resp, err := s.cs.newRequest("logout", p.toURLValues())
+func (s *AuthenticationService) Logout(p *LogoutParams, opts ...OptionFunc) (*LogoutResponse, error) {
+	return s.LogoutWithContext(context.Background(), p, opts...)
+}
+
+// LogoutWithContext is the same as Logout, but the request is cancelled when the context is done
+func (s *AuthenticationService) LogoutWithContext(ctx context.Context, p *LogoutParams, opts ...OptionFunc) (*LogoutResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	return s.LogoutRawWithContext(ctx, p.toURLValues())
+}
+
+// LogoutRaw is the same as Logout, but takes the params as url.Values instead of typed params
+func (s *AuthenticationService) LogoutRaw(v url.Values) (*LogoutResponse, error) {
+	return s.LogoutRawWithContext(context.Background(), v)
+}
+
+// LogoutRawWithContext is the same as LogoutRaw, but the request is cancelled when the context is done
+func (s *AuthenticationService) LogoutRawWithContext(ctx context.Context, v url.Values) (*LogoutResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "logout", "logoutresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -17,17 +17,30 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/url"
 	"strconv"
 	"strings"
+	"time"
 )
 
 type CreateAutoScalePolicyParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *CreateAutoScalePolicyParams) Clone() *CreateAutoScalePolicyParams {
+	c := &CreateAutoScalePolicyParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *CreateAutoScalePolicyParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -51,6 +64,16 @@ func (p *CreateAutoScalePolicyParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *CreateAutoScalePolicyParams) validate() error {
+	for _, k := range []string{"action", "conditionids", "duration"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of createAutoScalePolicy", k)
+		}
+	}
+	return nil
+}
+
 func (p *CreateAutoScalePolicyParams) SetAction(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -59,6 +82,13 @@ func (p *CreateAutoScalePolicyParams) SetAction(v string) {
 	return
 }
 
+// SetActionIfUnset sets action to v, unless it is already set
+func (p *CreateAutoScalePolicyParams) SetActionIfUnset(v string) {
+	if _, found := p.p["action"]; !found {
+		p.SetAction(v)
+	}
+}
+
 func (p *CreateAutoScalePolicyParams) SetConditionids(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -67,6 +97,18 @@ func (p *CreateAutoScalePolicyParams) SetConditionids(v []string) {
 	return
 }
 
+// SetConditionidsIfUnset sets conditionids to v, unless it is already set
+func (p *CreateAutoScalePolicyParams) SetConditionidsIfUnset(v []string) {
+	if _, found := p.p["conditionids"]; !found {
+		p.SetConditionids(v)
+	}
+}
+
+// SetConditionidsCSV splits v on commas and sets the resulting values
+func (p *CreateAutoScalePolicyParams) SetConditionidsCSV(v string) {
+	p.SetConditionids(splitCSV(v))
+}
+
 func (p *CreateAutoScalePolicyParams) SetDuration(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -75,6 +117,13 @@ func (p *CreateAutoScalePolicyParams) SetDuration(v int) {
 	return
 }
 
+// SetDurationIfUnset sets duration to v, unless it is already set
+func (p *CreateAutoScalePolicyParams) SetDurationIfUnset(v int) {
+	if _, found := p.p["duration"]; !found {
+		p.SetDuration(v)
+	}
+}
+
 func (p *CreateAutoScalePolicyParams) SetQuiettime(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -83,20 +132,75 @@ func (p *CreateAutoScalePolicyParams) SetQuiettime(v int) {
 	return
 }
 
+// SetQuiettimeIfUnset sets quiettime to v, unless it is already set
+func (p *CreateAutoScalePolicyParams) SetQuiettimeIfUnset(v int) {
+	if _, found := p.p["quiettime"]; !found {
+		p.SetQuiettime(v)
+	}
+}
+
 // You should always use this function to get a new CreateAutoScalePolicyParams instance,
-// as then you are sure you have configured all required params
-func (s *AutoScaleService) NewCreateAutoScalePolicyParams(action string, conditionids []string, duration int) *CreateAutoScalePolicyParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *AutoScaleService) NewCreateAutoScalePolicyParams(action string, conditionids []string, duration int, opts ...func(*CreateAutoScalePolicyParams)) *CreateAutoScalePolicyParams {
 	p := &CreateAutoScalePolicyParams{}
 	p.p = make(map[string]interface{})
 	p.p["action"] = action
 	p.p["conditionids"] = conditionids
 	p.p["duration"] = duration
+	for _, fn := range opts {
+		fn(p)
+	}
+	return p
+}
+
+// CreateAutoScalePolicyOpts contains all params that can be set on a CreateAutoScalePolicyParams.
+// Optional params are pointers (or slices and maps) which are only set when not nil.
+type CreateAutoScalePolicyOpts struct {
+	Action       string
+	Conditionids []string
+	Duration     int
+	Quiettime    *int
+}
+
+// NewCreateAutoScalePolicyParamsFromOpts is an alternative for NewCreateAutoScalePolicyParams which takes all params using
+// an options struct, as that is easier to read for APIs with many required params
+func (s *AutoScaleService) NewCreateAutoScalePolicyParamsFromOpts(o CreateAutoScalePolicyOpts) *CreateAutoScalePolicyParams {
+	p := s.NewCreateAutoScalePolicyParams(o.Action, o.Conditionids, o.Duration)
+	if o.Quiettime != nil {
+		p.p["quiettime"] = *o.Quiettime
+	}
 	return p
 }
 
 // Creates an autoscale policy for a provision or deprovision action, the action is taken when the all the conditions evaluates to true for the specified duration. The policy is in effect once it is attached to a autscale vm group.
-func (s *AutoScaleService) CreateAutoScalePolicy(p *CreateAutoScalePolicyParams) (*CreateAutoScalePolicyResponse, error) {
-	resp, err := s.cs.newRequest("createAutoScalePolicy", p.toURLValues())
+func (s *AutoScaleService) CreateAutoScalePolicy(p *CreateAutoScalePolicyParams, opts ...OptionFunc) (*CreateAutoScalePolicyResponse, error) {
+	return s.CreateAutoScalePolicyWithContext(context.Background(), p, opts...)
+}
+
+// CreateAutoScalePolicyWithContext is the same as CreateAutoScalePolicy, but the request is cancelled when the context is done
+func (s *AutoScaleService) CreateAutoScalePolicyWithContext(ctx context.Context, p *CreateAutoScalePolicyParams, opts ...OptionFunc) (*CreateAutoScalePolicyResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.CreateAutoScalePolicyRawWithContext(ctx, p.toURLValues())
+}
+
+// CreateAutoScalePolicyRaw is the same as CreateAutoScalePolicy, but takes the params as url.Values instead of typed params
+func (s *AutoScaleService) CreateAutoScalePolicyRaw(v url.Values) (*CreateAutoScalePolicyResponse, error) {
+	return s.CreateAutoScalePolicyRawWithContext(context.Background(), v)
+}
+
+// CreateAutoScalePolicyRawWithContext is the same as CreateAutoScalePolicyRaw, but the request is cancelled when the context is done
+func (s *AutoScaleService) CreateAutoScalePolicyRawWithContext(ctx context.Context, v url.Values) (*CreateAutoScalePolicyResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "createAutoScalePolicy", "createautoscalepolicyresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -108,9 +212,9 @@ func (s *AutoScaleService) CreateAutoScalePolicy(p *CreateAutoScalePolicyParams)
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -147,6 +251,16 @@ type CreateAutoScaleVmGroupParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *CreateAutoScaleVmGroupParams) Clone() *CreateAutoScaleVmGroupParams {
+	c := &CreateAutoScaleVmGroupParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *CreateAutoScaleVmGroupParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -185,6 +299,16 @@ func (p *CreateAutoScaleVmGroupParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *CreateAutoScaleVmGroupParams) validate() error {
+	for _, k := range []string{"lbruleid", "maxmembers", "minmembers", "scaledownpolicyids", "scaleuppolicyids", "vmprofileid"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of createAutoScaleVmGroup", k)
+		}
+	}
+	return nil
+}
+
 func (p *CreateAutoScaleVmGroupParams) SetFordisplay(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -193,6 +317,13 @@ func (p *CreateAutoScaleVmGroupParams) SetFordisplay(v bool) {
 	return
 }
 
+// SetFordisplayIfUnset sets fordisplay to v, unless it is already set
+func (p *CreateAutoScaleVmGroupParams) SetFordisplayIfUnset(v bool) {
+	if _, found := p.p["fordisplay"]; !found {
+		p.SetFordisplay(v)
+	}
+}
+
 func (p *CreateAutoScaleVmGroupParams) SetInterval(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -201,6 +332,13 @@ func (p *CreateAutoScaleVmGroupParams) SetInterval(v int) {
 	return
 }
 
+// SetIntervalIfUnset sets interval to v, unless it is already set
+func (p *CreateAutoScaleVmGroupParams) SetIntervalIfUnset(v int) {
+	if _, found := p.p["interval"]; !found {
+		p.SetInterval(v)
+	}
+}
+
 func (p *CreateAutoScaleVmGroupParams) SetLbruleid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -209,6 +347,13 @@ func (p *CreateAutoScaleVmGroupParams) SetLbruleid(v string) {
 	return
 }
 
+// SetLbruleidIfUnset sets lbruleid to v, unless it is already set
+func (p *CreateAutoScaleVmGroupParams) SetLbruleidIfUnset(v string) {
+	if _, found := p.p["lbruleid"]; !found {
+		p.SetLbruleid(v)
+	}
+}
+
 func (p *CreateAutoScaleVmGroupParams) SetMaxmembers(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -217,6 +362,13 @@ func (p *CreateAutoScaleVmGroupParams) SetMaxmembers(v int) {
 	return
 }
 
+// SetMaxmembersIfUnset sets maxmembers to v, unless it is already set
+func (p *CreateAutoScaleVmGroupParams) SetMaxmembersIfUnset(v int) {
+	if _, found := p.p["maxmembers"]; !found {
+		p.SetMaxmembers(v)
+	}
+}
+
 func (p *CreateAutoScaleVmGroupParams) SetMinmembers(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -225,6 +377,13 @@ func (p *CreateAutoScaleVmGroupParams) SetMinmembers(v int) {
 	return
 }
 
+// SetMinmembersIfUnset sets minmembers to v, unless it is already set
+func (p *CreateAutoScaleVmGroupParams) SetMinmembersIfUnset(v int) {
+	if _, found := p.p["minmembers"]; !found {
+		p.SetMinmembers(v)
+	}
+}
+
 func (p *CreateAutoScaleVmGroupParams) SetScaledownpolicyids(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -233,6 +392,18 @@ func (p *CreateAutoScaleVmGroupParams) SetScaledownpolicyids(v []string) {
 	return
 }
 
+// SetScaledownpolicyidsIfUnset sets scaledownpolicyids to v, unless it is already set
+func (p *CreateAutoScaleVmGroupParams) SetScaledownpolicyidsIfUnset(v []string) {
+	if _, found := p.p["scaledownpolicyids"]; !found {
+		p.SetScaledownpolicyids(v)
+	}
+}
+
+// SetScaledownpolicyidsCSV splits v on commas and sets the resulting values
+func (p *CreateAutoScaleVmGroupParams) SetScaledownpolicyidsCSV(v string) {
+	p.SetScaledownpolicyids(splitCSV(v))
+}
+
 func (p *CreateAutoScaleVmGroupParams) SetScaleuppolicyids(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -241,6 +412,18 @@ func (p *CreateAutoScaleVmGroupParams) SetScaleuppolicyids(v []string) {
 	return
 }
 
+// SetScaleuppolicyidsIfUnset sets scaleuppolicyids to v, unless it is already set
+func (p *CreateAutoScaleVmGroupParams) SetScaleuppolicyidsIfUnset(v []string) {
+	if _, found := p.p["scaleuppolicyids"]; !found {
+		p.SetScaleuppolicyids(v)
+	}
+}
+
+// SetScaleuppolicyidsCSV splits v on commas and sets the resulting values
+func (p *CreateAutoScaleVmGroupParams) SetScaleuppolicyidsCSV(v string) {
+	p.SetScaleuppolicyids(splitCSV(v))
+}
+
 func (p *CreateAutoScaleVmGroupParams) SetVmprofileid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -249,9 +432,17 @@ func (p *CreateAutoScaleVmGroupParams) SetVmprofileid(v string) {
 	return
 }
 
+// SetVmprofileidIfUnset sets vmprofileid to v, unless it is already set
+func (p *CreateAutoScaleVmGroupParams) SetVmprofileidIfUnset(v string) {
+	if _, found := p.p["vmprofileid"]; !found {
+		p.SetVmprofileid(v)
+	}
+}
+
 // You should always use this function to get a new CreateAutoScaleVmGroupParams instance,
-// as then you are sure you have configured all required params
-func (s *AutoScaleService) NewCreateAutoScaleVmGroupParams(lbruleid string, maxmembers int, minmembers int, scaledownpolicyids []string, scaleuppolicyids []string, vmprofileid string) *CreateAutoScaleVmGroupParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *AutoScaleService) NewCreateAutoScaleVmGroupParams(lbruleid string, maxmembers int, minmembers int, scaledownpolicyids []string, scaleuppolicyids []string, vmprofileid string, opts ...func(*CreateAutoScaleVmGroupParams)) *CreateAutoScaleVmGroupParams {
 	p := &CreateAutoScaleVmGroupParams{}
 	p.p = make(map[string]interface{})
 	p.p["lbruleid"] = lbruleid
@@ -260,12 +451,66 @@ func (s *AutoScaleService) NewCreateAutoScaleVmGroupParams(lbruleid string, maxm
 	p.p["scaledownpolicyids"] = scaledownpolicyids
 	p.p["scaleuppolicyids"] = scaleuppolicyids
 	p.p["vmprofileid"] = vmprofileid
+	for _, fn := range opts {
+		fn(p)
+	}
+	return p
+}
+
+// CreateAutoScaleVmGroupOpts contains all params that can be set on a CreateAutoScaleVmGroupParams.
+// Optional params are pointers (or slices and maps) which are only set when not nil.
+type CreateAutoScaleVmGroupOpts struct {
+	Fordisplay         *bool
+	Interval           *int
+	Lbruleid           string
+	Maxmembers         int
+	Minmembers         int
+	Scaledownpolicyids []string
+	Scaleuppolicyids   []string
+	Vmprofileid        string
+}
+
+// NewCreateAutoScaleVmGroupParamsFromOpts is an alternative for NewCreateAutoScaleVmGroupParams which takes all params using
+// an options struct, as that is easier to read for APIs with many required params
+func (s *AutoScaleService) NewCreateAutoScaleVmGroupParamsFromOpts(o CreateAutoScaleVmGroupOpts) *CreateAutoScaleVmGroupParams {
+	p := s.NewCreateAutoScaleVmGroupParams(o.Lbruleid, o.Maxmembers, o.Minmembers, o.Scaledownpolicyids, o.Scaleuppolicyids, o.Vmprofileid)
+	if o.Fordisplay != nil {
+		p.p["fordisplay"] = *o.Fordisplay
+	}
+	if o.Interval != nil {
+		p.p["interval"] = *o.Interval
+	}
 	return p
 }
 
 // Creates and automatically starts a virtual machine based on a service offering, disk offering, and template.
-func (s *AutoScaleService) CreateAutoScaleVmGroup(p *CreateAutoScaleVmGroupParams) (*CreateAutoScaleVmGroupResponse, error) {
-	resp, err := s.cs.newRequest("createAutoScaleVmGroup", p.toURLValues())
+func (s *AutoScaleService) CreateAutoScaleVmGroup(p *CreateAutoScaleVmGroupParams, opts ...OptionFunc) (*CreateAutoScaleVmGroupResponse, error) {
+	return s.CreateAutoScaleVmGroupWithContext(context.Background(), p, opts...)
+}
+
+// CreateAutoScaleVmGroupWithContext is the same as CreateAutoScaleVmGroup, but the request is cancelled when the context is done
+func (s *AutoScaleService) CreateAutoScaleVmGroupWithContext(ctx context.Context, p *CreateAutoScaleVmGroupParams, opts ...OptionFunc) (*CreateAutoScaleVmGroupResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.CreateAutoScaleVmGroupRawWithContext(ctx, p.toURLValues())
+}
+
+// CreateAutoScaleVmGroupRaw is the same as CreateAutoScaleVmGroup, but takes the params as url.Values instead of typed params
+func (s *AutoScaleService) CreateAutoScaleVmGroupRaw(v url.Values) (*CreateAutoScaleVmGroupResponse, error) {
+	return s.CreateAutoScaleVmGroupRawWithContext(context.Background(), v)
+}
+
+// CreateAutoScaleVmGroupRawWithContext is the same as CreateAutoScaleVmGroupRaw, but the request is cancelled when the context is done
+func (s *AutoScaleService) CreateAutoScaleVmGroupRawWithContext(ctx context.Context, v url.Values) (*CreateAutoScaleVmGroupResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "createAutoScaleVmGroup", "createautoscalevmgroupresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -277,9 +522,9 @@ func (s *AutoScaleService) CreateAutoScaleVmGroup(p *CreateAutoScaleVmGroupParam
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -321,6 +566,16 @@ type CreateAutoScaleVmProfileParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *CreateAutoScaleVmProfileParams) Clone() *CreateAutoScaleVmProfileParams {
+	c := &CreateAutoScaleVmProfileParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *CreateAutoScaleVmProfileParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -330,11 +585,10 @@ func (p *CreateAutoScaleVmProfileParams) toURLValues() url.Values {
 		u.Set("autoscaleuserid", v.(string))
 	}
 	if v, found := p.p["counterparam"]; found {
-		i := 0
-		for k, vv := range v.(map[string]string) {
+		m := v.(map[string]string)
+		for i, k := range getSortedKeysFromMap(m) {
 			u.Set(fmt.Sprintf("counterparam[%d].key", i), k)
-			u.Set(fmt.Sprintf("counterparam[%d].value", i), vv)
-			i++
+			u.Set(fmt.Sprintf("counterparam[%d].value", i), m[k])
 		}
 	}
 	if v, found := p.p["destroyvmgraceperiod"]; found {
@@ -360,6 +614,16 @@ func (p *CreateAutoScaleVmProfileParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *CreateAutoScaleVmProfileParams) validate() error {
+	for _, k := range []string{"serviceofferingid", "templateid", "zoneid"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of createAutoScaleVmProfile", k)
+		}
+	}
+	return nil
+}
+
 func (p *CreateAutoScaleVmProfileParams) SetAutoscaleuserid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -368,6 +632,13 @@ func (p *CreateAutoScaleVmProfileParams) SetAutoscaleuserid(v string) {
 	return
 }
 
+// SetAutoscaleuseridIfUnset sets autoscaleuserid to v, unless it is already set
+func (p *CreateAutoScaleVmProfileParams) SetAutoscaleuseridIfUnset(v string) {
+	if _, found := p.p["autoscaleuserid"]; !found {
+		p.SetAutoscaleuserid(v)
+	}
+}
+
 func (p *CreateAutoScaleVmProfileParams) SetCounterparam(v map[string]string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -376,6 +647,13 @@ func (p *CreateAutoScaleVmProfileParams) SetCounterparam(v map[string]string) {
 	return
 }
 
+// SetCounterparamIfUnset sets counterparam to v, unless it is already set
+func (p *CreateAutoScaleVmProfileParams) SetCounterparamIfUnset(v map[string]string) {
+	if _, found := p.p["counterparam"]; !found {
+		p.SetCounterparam(v)
+	}
+}
+
 func (p *CreateAutoScaleVmProfileParams) SetDestroyvmgraceperiod(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -384,6 +662,13 @@ func (p *CreateAutoScaleVmProfileParams) SetDestroyvmgraceperiod(v int) {
 	return
 }
 
+// SetDestroyvmgraceperiodIfUnset sets destroyvmgraceperiod to v, unless it is already set
+func (p *CreateAutoScaleVmProfileParams) SetDestroyvmgraceperiodIfUnset(v int) {
+	if _, found := p.p["destroyvmgraceperiod"]; !found {
+		p.SetDestroyvmgraceperiod(v)
+	}
+}
+
 func (p *CreateAutoScaleVmProfileParams) SetFordisplay(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -392,6 +677,13 @@ func (p *CreateAutoScaleVmProfileParams) SetFordisplay(v bool) {
 	return
 }
 
+// SetFordisplayIfUnset sets fordisplay to v, unless it is already set
+func (p *CreateAutoScaleVmProfileParams) SetFordisplayIfUnset(v bool) {
+	if _, found := p.p["fordisplay"]; !found {
+		p.SetFordisplay(v)
+	}
+}
+
 func (p *CreateAutoScaleVmProfileParams) SetOtherdeployparams(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -400,6 +692,13 @@ func (p *CreateAutoScaleVmProfileParams) SetOtherdeployparams(v string) {
 	return
 }
 
+// SetOtherdeployparamsIfUnset sets otherdeployparams to v, unless it is already set
+func (p *CreateAutoScaleVmProfileParams) SetOtherdeployparamsIfUnset(v string) {
+	if _, found := p.p["otherdeployparams"]; !found {
+		p.SetOtherdeployparams(v)
+	}
+}
+
 func (p *CreateAutoScaleVmProfileParams) SetServiceofferingid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -408,6 +707,13 @@ func (p *CreateAutoScaleVmProfileParams) SetServiceofferingid(v string) {
 	return
 }
 
+// SetServiceofferingidIfUnset sets serviceofferingid to v, unless it is already set
+func (p *CreateAutoScaleVmProfileParams) SetServiceofferingidIfUnset(v string) {
+	if _, found := p.p["serviceofferingid"]; !found {
+		p.SetServiceofferingid(v)
+	}
+}
+
 func (p *CreateAutoScaleVmProfileParams) SetTemplateid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -416,6 +722,13 @@ func (p *CreateAutoScaleVmProfileParams) SetTemplateid(v string) {
 	return
 }
 
+// SetTemplateidIfUnset sets templateid to v, unless it is already set
+func (p *CreateAutoScaleVmProfileParams) SetTemplateidIfUnset(v string) {
+	if _, found := p.p["templateid"]; !found {
+		p.SetTemplateid(v)
+	}
+}
+
 func (p *CreateAutoScaleVmProfileParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -424,20 +737,91 @@ func (p *CreateAutoScaleVmProfileParams) SetZoneid(v string) {
 	return
 }
 
+// SetZoneidIfUnset sets zoneid to v, unless it is already set
+func (p *CreateAutoScaleVmProfileParams) SetZoneidIfUnset(v string) {
+	if _, found := p.p["zoneid"]; !found {
+		p.SetZoneid(v)
+	}
+}
+
 // You should always use this function to get a new CreateAutoScaleVmProfileParams instance,
-// as then you are sure you have configured all required params
-func (s *AutoScaleService) NewCreateAutoScaleVmProfileParams(serviceofferingid string, templateid string, zoneid string) *CreateAutoScaleVmProfileParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *AutoScaleService) NewCreateAutoScaleVmProfileParams(serviceofferingid string, templateid string, zoneid string, opts ...func(*CreateAutoScaleVmProfileParams)) *CreateAutoScaleVmProfileParams {
 	p := &CreateAutoScaleVmProfileParams{}
 	p.p = make(map[string]interface{})
 	p.p["serviceofferingid"] = serviceofferingid
 	p.p["templateid"] = templateid
 	p.p["zoneid"] = zoneid
+	for _, fn := range opts {
+		fn(p)
+	}
+	return p
+}
+
+// CreateAutoScaleVmProfileOpts contains all params that can be set on a CreateAutoScaleVmProfileParams.
+// Optional params are pointers (or slices and maps) which are only set when not nil.
+type CreateAutoScaleVmProfileOpts struct {
+	Autoscaleuserid      *string
+	Counterparam         map[string]string
+	Destroyvmgraceperiod *int
+	Fordisplay           *bool
+	Otherdeployparams    *string
+	Serviceofferingid    string
+	Templateid           string
+	Zoneid               string
+}
+
+// NewCreateAutoScaleVmProfileParamsFromOpts is an alternative for NewCreateAutoScaleVmProfileParams which takes all params using
+// an options struct, as that is easier to read for APIs with many required params
+func (s *AutoScaleService) NewCreateAutoScaleVmProfileParamsFromOpts(o CreateAutoScaleVmProfileOpts) *CreateAutoScaleVmProfileParams {
+	p := s.NewCreateAutoScaleVmProfileParams(o.Serviceofferingid, o.Templateid, o.Zoneid)
+	if o.Autoscaleuserid != nil {
+		p.p["autoscaleuserid"] = *o.Autoscaleuserid
+	}
+	if o.Counterparam != nil {
+		p.p["counterparam"] = o.Counterparam
+	}
+	if o.Destroyvmgraceperiod != nil {
+		p.p["destroyvmgraceperiod"] = *o.Destroyvmgraceperiod
+	}
+	if o.Fordisplay != nil {
+		p.p["fordisplay"] = *o.Fordisplay
+	}
+	if o.Otherdeployparams != nil {
+		p.p["otherdeployparams"] = *o.Otherdeployparams
+	}
 	return p
 }
 
 // Creates a profile that contains information about the virtual machine which will be provisioned automatically by autoscale feature.
-func (s *AutoScaleService) CreateAutoScaleVmProfile(p *CreateAutoScaleVmProfileParams) (*CreateAutoScaleVmProfileResponse, error) {
-	resp, err := s.cs.newRequest("createAutoScaleVmProfile", p.toURLValues())
+func (s *AutoScaleService) CreateAutoScaleVmProfile(p *CreateAutoScaleVmProfileParams, opts ...OptionFunc) (*CreateAutoScaleVmProfileResponse, error) {
+	return s.CreateAutoScaleVmProfileWithContext(context.Background(), p, opts...)
+}
+
+// CreateAutoScaleVmProfileWithContext is the same as CreateAutoScaleVmProfile, but the request is cancelled when the context is done
+func (s *AutoScaleService) CreateAutoScaleVmProfileWithContext(ctx context.Context, p *CreateAutoScaleVmProfileParams, opts ...OptionFunc) (*CreateAutoScaleVmProfileResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.CreateAutoScaleVmProfileRawWithContext(ctx, p.toURLValues())
+}
+
+// CreateAutoScaleVmProfileRaw is the same as CreateAutoScaleVmProfile, but takes the params as url.Values instead of typed params
+func (s *AutoScaleService) CreateAutoScaleVmProfileRaw(v url.Values) (*CreateAutoScaleVmProfileResponse, error) {
+	return s.CreateAutoScaleVmProfileRawWithContext(context.Background(), v)
+}
+
+// CreateAutoScaleVmProfileRawWithContext is the same as CreateAutoScaleVmProfileRaw, but the request is cancelled when the context is done
+func (s *AutoScaleService) CreateAutoScaleVmProfileRawWithContext(ctx context.Context, v url.Values) (*CreateAutoScaleVmProfileResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "createAutoScaleVmProfile", "createautoscalevmprofileresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -449,9 +833,9 @@ func (s *AutoScaleService) CreateAutoScaleVmProfile(p *CreateAutoScaleVmProfileP
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -491,6 +875,16 @@ type CreateConditionParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *CreateConditionParams) Clone() *CreateConditionParams {
+	c := &CreateConditionParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *CreateConditionParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -515,6 +909,16 @@ func (p *CreateConditionParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *CreateConditionParams) validate() error {
+	for _, k := range []string{"counterid", "relationaloperator", "threshold"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of createCondition", k)
+		}
+	}
+	return nil
+}
+
 func (p *CreateConditionParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -523,6 +927,13 @@ func (p *CreateConditionParams) SetAccount(v string) {
 	return
 }
 
+// SetAccountIfUnset sets account to v, unless it is already set
+func (p *CreateConditionParams) SetAccountIfUnset(v string) {
+	if _, found := p.p["account"]; !found {
+		p.SetAccount(v)
+	}
+}
+
 func (p *CreateConditionParams) SetCounterid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -531,6 +942,13 @@ func (p *CreateConditionParams) SetCounterid(v string) {
 	return
 }
 
+// SetCounteridIfUnset sets counterid to v, unless it is already set
+func (p *CreateConditionParams) SetCounteridIfUnset(v string) {
+	if _, found := p.p["counterid"]; !found {
+		p.SetCounterid(v)
+	}
+}
+
 func (p *CreateConditionParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -539,6 +957,13 @@ func (p *CreateConditionParams) SetDomainid(v string) {
 	return
 }
 
+// SetDomainidIfUnset sets domainid to v, unless it is already set
+func (p *CreateConditionParams) SetDomainidIfUnset(v string) {
+	if _, found := p.p["domainid"]; !found {
+		p.SetDomainid(v)
+	}
+}
+
 func (p *CreateConditionParams) SetRelationaloperator(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -547,6 +972,13 @@ func (p *CreateConditionParams) SetRelationaloperator(v string) {
 	return
 }
 
+// SetRelationaloperatorIfUnset sets relationaloperator to v, unless it is already set
+func (p *CreateConditionParams) SetRelationaloperatorIfUnset(v string) {
+	if _, found := p.p["relationaloperator"]; !found {
+		p.SetRelationaloperator(v)
+	}
+}
+
 func (p *CreateConditionParams) SetThreshold(v int64) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -555,20 +987,79 @@ func (p *CreateConditionParams) SetThreshold(v int64) {
 	return
 }
 
+// SetThresholdIfUnset sets threshold to v, unless it is already set
+func (p *CreateConditionParams) SetThresholdIfUnset(v int64) {
+	if _, found := p.p["threshold"]; !found {
+		p.SetThreshold(v)
+	}
+}
+
 // You should always use this function to get a new CreateConditionParams instance,
-// as then you are sure you have configured all required params
-func (s *AutoScaleService) NewCreateConditionParams(counterid string, relationaloperator string, threshold int64) *CreateConditionParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *AutoScaleService) NewCreateConditionParams(counterid string, relationaloperator string, threshold int64, opts ...func(*CreateConditionParams)) *CreateConditionParams {
 	p := &CreateConditionParams{}
 	p.p = make(map[string]interface{})
 	p.p["counterid"] = counterid
 	p.p["relationaloperator"] = relationaloperator
 	p.p["threshold"] = threshold
+	for _, fn := range opts {
+		fn(p)
+	}
+	return p
+}
+
+// CreateConditionOpts contains all params that can be set on a CreateConditionParams.
+// Optional params are pointers (or slices and maps) which are only set when not nil.
+type CreateConditionOpts struct {
+	Account            *string
+	Counterid          string
+	Domainid           *string
+	Relationaloperator string
+	Threshold          int64
+}
+
+// NewCreateConditionParamsFromOpts is an alternative for NewCreateConditionParams which takes all params using
+// an options struct, as that is easier to read for APIs with many required params
+func (s *AutoScaleService) NewCreateConditionParamsFromOpts(o CreateConditionOpts) *CreateConditionParams {
+	p := s.NewCreateConditionParams(o.Counterid, o.Relationaloperator, o.Threshold)
+	if o.Account != nil {
+		p.p["account"] = *o.Account
+	}
+	if o.Domainid != nil {
+		p.p["domainid"] = *o.Domainid
+	}
 	return p
 }
 
 // Creates a condition
-func (s *AutoScaleService) CreateCondition(p *CreateConditionParams) (*CreateConditionResponse, error) {
-	resp, err := s.cs.newRequest("createCondition", p.toURLValues())
+func (s *AutoScaleService) CreateCondition(p *CreateConditionParams, opts ...OptionFunc) (*CreateConditionResponse, error) {
+	return s.CreateConditionWithContext(context.Background(), p, opts...)
+}
+
+// CreateConditionWithContext is the same as CreateCondition, but the request is cancelled when the context is done
+func (s *AutoScaleService) CreateConditionWithContext(ctx context.Context, p *CreateConditionParams, opts ...OptionFunc) (*CreateConditionResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.CreateConditionRawWithContext(ctx, p.toURLValues())
+}
+
+// CreateConditionRaw is the same as CreateCondition, but takes the params as url.Values instead of typed params
+func (s *AutoScaleService) CreateConditionRaw(v url.Values) (*CreateConditionResponse, error) {
+	return s.CreateConditionRawWithContext(context.Background(), v)
+}
+
+// CreateConditionRawWithContext is the same as CreateConditionRaw, but the request is cancelled when the context is done
+func (s *AutoScaleService) CreateConditionRawWithContext(ctx context.Context, v url.Values) (*CreateConditionResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "createCondition", "createconditionresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -580,9 +1071,9 @@ func (s *AutoScaleService) CreateCondition(p *CreateConditionParams) (*CreateCon
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -619,6 +1110,16 @@ type CreateCounterParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *CreateCounterParams) Clone() *CreateCounterParams {
+	c := &CreateCounterParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *CreateCounterParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -636,6 +1137,16 @@ func (p *CreateCounterParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *CreateCounterParams) validate() error {
+	for _, k := range []string{"name", "source", "value"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of createCounter", k)
+		}
+	}
+	return nil
+}
+
 func (p *CreateCounterParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -644,6 +1155,13 @@ func (p *CreateCounterParams) SetName(v string) {
 	return
 }
 
+// SetNameIfUnset sets name to v, unless it is already set
+func (p *CreateCounterParams) SetNameIfUnset(v string) {
+	if _, found := p.p["name"]; !found {
+		p.SetName(v)
+	}
+}
+
 func (p *CreateCounterParams) SetSource(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -652,6 +1170,13 @@ func (p *CreateCounterParams) SetSource(v string) {
 	return
 }
 
+// SetSourceIfUnset sets source to v, unless it is already set
+func (p *CreateCounterParams) SetSourceIfUnset(v string) {
+	if _, found := p.p["source"]; !found {
+		p.SetSource(v)
+	}
+}
+
 func (p *CreateCounterParams) SetValue(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -660,20 +1185,71 @@ func (p *CreateCounterParams) SetValue(v string) {
 	return
 }
 
+// SetValueIfUnset sets value to v, unless it is already set
+func (p *CreateCounterParams) SetValueIfUnset(v string) {
+	if _, found := p.p["value"]; !found {
+		p.SetValue(v)
+	}
+}
+
 // You should always use this function to get a new CreateCounterParams instance,
-// as then you are sure you have configured all required params
-func (s *AutoScaleService) NewCreateCounterParams(name string, source string, value string) *CreateCounterParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *AutoScaleService) NewCreateCounterParams(name string, source string, value string, opts ...func(*CreateCounterParams)) *CreateCounterParams {
 	p := &CreateCounterParams{}
 	p.p = make(map[string]interface{})
 	p.p["name"] = name
 	p.p["source"] = source
 	p.p["value"] = value
+	for _, fn := range opts {
+		fn(p)
+	}
+	return p
+}
+
+// CreateCounterOpts contains all params that can be set on a CreateCounterParams.
+// Optional params are pointers (or slices and maps) which are only set when not nil.
+type CreateCounterOpts struct {
+	Name   string
+	Source string
+	Value  string
+}
+
+// NewCreateCounterParamsFromOpts is an alternative for NewCreateCounterParams which takes all params using
+// an options struct, as that is easier to read for APIs with many required params
+func (s *AutoScaleService) NewCreateCounterParamsFromOpts(o CreateCounterOpts) *CreateCounterParams {
+	p := s.NewCreateCounterParams(o.Name, o.Source, o.Value)
 	return p
 }
 
 // Adds metric counter
-func (s *AutoScaleService) CreateCounter(p *CreateCounterParams) (*CreateCounterResponse, error) {
-	resp, err := s.cs.newRequest("createCounter", p.toURLValues())
+func (s *AutoScaleService) CreateCounter(p *CreateCounterParams, opts ...OptionFunc) (*CreateCounterResponse, error) {
+	return s.CreateCounterWithContext(context.Background(), p, opts...)
+}
+
+// CreateCounterWithContext is the same as CreateCounter, but the request is cancelled when the context is done
+func (s *AutoScaleService) CreateCounterWithContext(ctx context.Context, p *CreateCounterParams, opts ...OptionFunc) (*CreateCounterResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.CreateCounterRawWithContext(ctx, p.toURLValues())
+}
+
+// CreateCounterRaw is the same as CreateCounter, but takes the params as url.Values instead of typed params
+func (s *AutoScaleService) CreateCounterRaw(v url.Values) (*CreateCounterResponse, error) {
+	return s.CreateCounterRawWithContext(context.Background(), v)
+}
+
+// CreateCounterRawWithContext is the same as CreateCounterRaw, but the request is cancelled when the context is done
+func (s *AutoScaleService) CreateCounterRawWithContext(ctx context.Context, v url.Values) (*CreateCounterResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "createCounter", "createcounterresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -685,9 +1261,9 @@ func (s *AutoScaleService) CreateCounter(p *CreateCounterParams) (*CreateCounter
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -719,6 +1295,16 @@ type DeleteAutoScalePolicyParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *DeleteAutoScalePolicyParams) Clone() *DeleteAutoScalePolicyParams {
+	c := &DeleteAutoScalePolicyParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeleteAutoScalePolicyParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -730,6 +1316,16 @@ func (p *DeleteAutoScalePolicyParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *DeleteAutoScalePolicyParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of deleteAutoScalePolicy", k)
+		}
+	}
+	return nil
+}
+
 func (p *DeleteAutoScalePolicyParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -738,32 +1334,68 @@ func (p *DeleteAutoScalePolicyParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *DeleteAutoScalePolicyParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 // You should always use this function to get a new DeleteAutoScalePolicyParams instance,
-// as then you are sure you have configured all required params
-func (s *AutoScaleService) NewDeleteAutoScalePolicyParams(id string) *DeleteAutoScalePolicyParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *AutoScaleService) NewDeleteAutoScalePolicyParams(id string, opts ...func(*DeleteAutoScalePolicyParams)) *DeleteAutoScalePolicyParams {
 	p := &DeleteAutoScalePolicyParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Deletes a autoscale policy.
-func (s *AutoScaleService) DeleteAutoScalePolicy(p *DeleteAutoScalePolicyParams) (*DeleteAutoScalePolicyResponse, error) {
-	resp, err := s.cs.newRequest("deleteAutoScalePolicy", p.toURLValues())
-	if err != nil {
-		return nil, err
+func (s *AutoScaleService) DeleteAutoScalePolicy(p *DeleteAutoScalePolicyParams, opts ...OptionFunc) (*DeleteAutoScalePolicyResponse, error) {
+	return s.DeleteAutoScalePolicyWithContext(context.Background(), p, opts...)
+}
+
+// DeleteAutoScalePolicyWithContext is the same as DeleteAutoScalePolicy, but the request is cancelled when the context is done
+func (s *AutoScaleService) DeleteAutoScalePolicyWithContext(ctx context.Context, p *DeleteAutoScalePolicyParams, opts ...OptionFunc) (*DeleteAutoScalePolicyResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
 	}
 
-	var r DeleteAutoScalePolicyResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := p.validate(); err != nil {
 		return nil, err
 	}
 
-	// If we have a async client, we need to wait for the async result
+	return s.DeleteAutoScalePolicyRawWithContext(ctx, p.toURLValues())
+}
+
+// DeleteAutoScalePolicyRaw is the same as DeleteAutoScalePolicy, but takes the params as url.Values instead of typed params
+func (s *AutoScaleService) DeleteAutoScalePolicyRaw(v url.Values) (*DeleteAutoScalePolicyResponse, error) {
+	return s.DeleteAutoScalePolicyRawWithContext(context.Background(), v)
+}
+
+// DeleteAutoScalePolicyRawWithContext is the same as DeleteAutoScalePolicyRaw, but the request is cancelled when the context is done
+func (s *AutoScaleService) DeleteAutoScalePolicyRawWithContext(ctx context.Context, v url.Values) (*DeleteAutoScalePolicyResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteAutoScalePolicy", "deleteautoscalepolicyresponse", v)
+	if err != nil {
+		return nil, err
+	}
+
+	var r DeleteAutoScalePolicyResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -777,6 +1409,34 @@ func (s *AutoScaleService) DeleteAutoScalePolicy(p *DeleteAutoScalePolicyParams)
 	return &r, nil
 }
 
+// WaitForAutoScalePolicyDeleted polls until the AutoScalePolicy with the given ID no longer exists, the timeout
+// expires or the context is cancelled. Deleted resources may still be listed for a short while
+// after the delete command finished, so this can be used to make sure they are really gone. The
+// options are passed to GetAutoScalePolicyByID, so a resource outside of the default scope can be found.
+func (s *AutoScaleService) WaitForAutoScalePolicyDeleted(ctx context.Context, id string, timeout time.Duration, opts ...OptionFunc) error {
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	for {
+		_, count, err := s.GetAutoScalePolicyByID(id, opts...)
+		if count == 0 {
+			return nil
+		}
+		if count < 0 {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(2 * time.Second):
+		}
+	}
+}
+
 type DeleteAutoScalePolicyResponse struct {
 	JobID       string `json:"jobid"`
 	Displaytext string `json:"displaytext"`
@@ -787,6 +1447,16 @@ type DeleteAutoScaleVmGroupParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *DeleteAutoScaleVmGroupParams) Clone() *DeleteAutoScaleVmGroupParams {
+	c := &DeleteAutoScaleVmGroupParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeleteAutoScaleVmGroupParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -798,6 +1468,16 @@ func (p *DeleteAutoScaleVmGroupParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *DeleteAutoScaleVmGroupParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of deleteAutoScaleVmGroup", k)
+		}
+	}
+	return nil
+}
+
 func (p *DeleteAutoScaleVmGroupParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -806,18 +1486,54 @@ func (p *DeleteAutoScaleVmGroupParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *DeleteAutoScaleVmGroupParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 // You should always use this function to get a new DeleteAutoScaleVmGroupParams instance,
-// as then you are sure you have configured all required params
-func (s *AutoScaleService) NewDeleteAutoScaleVmGroupParams(id string) *DeleteAutoScaleVmGroupParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *AutoScaleService) NewDeleteAutoScaleVmGroupParams(id string, opts ...func(*DeleteAutoScaleVmGroupParams)) *DeleteAutoScaleVmGroupParams {
 	p := &DeleteAutoScaleVmGroupParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Deletes a autoscale vm group.
-func (s *AutoScaleService) DeleteAutoScaleVmGroup(p *DeleteAutoScaleVmGroupParams) (*DeleteAutoScaleVmGroupResponse, error) {
-	resp, err := s.cs.newRequest("deleteAutoScaleVmGroup", p.toURLValues())
+func (s *AutoScaleService) DeleteAutoScaleVmGroup(p *DeleteAutoScaleVmGroupParams, opts ...OptionFunc) (*DeleteAutoScaleVmGroupResponse, error) {
+	return s.DeleteAutoScaleVmGroupWithContext(context.Background(), p, opts...)
+}
+
+// DeleteAutoScaleVmGroupWithContext is the same as DeleteAutoScaleVmGroup, but the request is cancelled when the context is done
+func (s *AutoScaleService) DeleteAutoScaleVmGroupWithContext(ctx context.Context, p *DeleteAutoScaleVmGroupParams, opts ...OptionFunc) (*DeleteAutoScaleVmGroupResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.DeleteAutoScaleVmGroupRawWithContext(ctx, p.toURLValues())
+}
+
+// DeleteAutoScaleVmGroupRaw is the same as DeleteAutoScaleVmGroup, but takes the params as url.Values instead of typed params
+func (s *AutoScaleService) DeleteAutoScaleVmGroupRaw(v url.Values) (*DeleteAutoScaleVmGroupResponse, error) {
+	return s.DeleteAutoScaleVmGroupRawWithContext(context.Background(), v)
+}
+
+// DeleteAutoScaleVmGroupRawWithContext is the same as DeleteAutoScaleVmGroupRaw, but the request is cancelled when the context is done
+func (s *AutoScaleService) DeleteAutoScaleVmGroupRawWithContext(ctx context.Context, v url.Values) (*DeleteAutoScaleVmGroupResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteAutoScaleVmGroup", "deleteautoscalevmgroupresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -829,9 +1545,9 @@ func (s *AutoScaleService) DeleteAutoScaleVmGroup(p *DeleteAutoScaleVmGroupParam
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -845,6 +1561,34 @@ func (s *AutoScaleService) DeleteAutoScaleVmGroup(p *DeleteAutoScaleVmGroupParam
 	return &r, nil
 }
 
+// WaitForAutoScaleVmGroupDeleted polls until the AutoScaleVmGroup with the given ID no longer exists, the timeout
+// expires or the context is cancelled. Deleted resources may still be listed for a short while
+// after the delete command finished, so this can be used to make sure they are really gone. The
+// options are passed to GetAutoScaleVmGroupByID, so a resource outside of the default scope can be found.
+func (s *AutoScaleService) WaitForAutoScaleVmGroupDeleted(ctx context.Context, id string, timeout time.Duration, opts ...OptionFunc) error {
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	for {
+		_, count, err := s.GetAutoScaleVmGroupByID(id, opts...)
+		if count == 0 {
+			return nil
+		}
+		if count < 0 {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(2 * time.Second):
+		}
+	}
+}
+
 type DeleteAutoScaleVmGroupResponse struct {
 	JobID       string `json:"jobid"`
 	Displaytext string `json:"displaytext"`
@@ -855,6 +1599,16 @@ type DeleteAutoScaleVmProfileParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *DeleteAutoScaleVmProfileParams) Clone() *DeleteAutoScaleVmProfileParams {
+	c := &DeleteAutoScaleVmProfileParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeleteAutoScaleVmProfileParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -866,6 +1620,16 @@ func (p *DeleteAutoScaleVmProfileParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *DeleteAutoScaleVmProfileParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of deleteAutoScaleVmProfile", k)
+		}
+	}
+	return nil
+}
+
 func (p *DeleteAutoScaleVmProfileParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -874,18 +1638,54 @@ func (p *DeleteAutoScaleVmProfileParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *DeleteAutoScaleVmProfileParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 // You should always use this function to get a new DeleteAutoScaleVmProfileParams instance,
-// as then you are sure you have configured all required params
-func (s *AutoScaleService) NewDeleteAutoScaleVmProfileParams(id string) *DeleteAutoScaleVmProfileParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *AutoScaleService) NewDeleteAutoScaleVmProfileParams(id string, opts ...func(*DeleteAutoScaleVmProfileParams)) *DeleteAutoScaleVmProfileParams {
 	p := &DeleteAutoScaleVmProfileParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Deletes a autoscale vm profile.
-func (s *AutoScaleService) DeleteAutoScaleVmProfile(p *DeleteAutoScaleVmProfileParams) (*DeleteAutoScaleVmProfileResponse, error) {
-	resp, err := s.cs.newRequest("deleteAutoScaleVmProfile", p.toURLValues())
+func (s *AutoScaleService) DeleteAutoScaleVmProfile(p *DeleteAutoScaleVmProfileParams, opts ...OptionFunc) (*DeleteAutoScaleVmProfileResponse, error) {
+	return s.DeleteAutoScaleVmProfileWithContext(context.Background(), p, opts...)
+}
+
+// DeleteAutoScaleVmProfileWithContext is the same as DeleteAutoScaleVmProfile, but the request is cancelled when the context is done
+func (s *AutoScaleService) DeleteAutoScaleVmProfileWithContext(ctx context.Context, p *DeleteAutoScaleVmProfileParams, opts ...OptionFunc) (*DeleteAutoScaleVmProfileResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.DeleteAutoScaleVmProfileRawWithContext(ctx, p.toURLValues())
+}
+
+// DeleteAutoScaleVmProfileRaw is the same as DeleteAutoScaleVmProfile, but takes the params as url.Values instead of typed params
+func (s *AutoScaleService) DeleteAutoScaleVmProfileRaw(v url.Values) (*DeleteAutoScaleVmProfileResponse, error) {
+	return s.DeleteAutoScaleVmProfileRawWithContext(context.Background(), v)
+}
+
+// DeleteAutoScaleVmProfileRawWithContext is the same as DeleteAutoScaleVmProfileRaw, but the request is cancelled when the context is done
+func (s *AutoScaleService) DeleteAutoScaleVmProfileRawWithContext(ctx context.Context, v url.Values) (*DeleteAutoScaleVmProfileResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteAutoScaleVmProfile", "deleteautoscalevmprofileresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -897,9 +1697,9 @@ func (s *AutoScaleService) DeleteAutoScaleVmProfile(p *DeleteAutoScaleVmProfileP
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -913,6 +1713,34 @@ func (s *AutoScaleService) DeleteAutoScaleVmProfile(p *DeleteAutoScaleVmProfileP
 	return &r, nil
 }
 
+// WaitForAutoScaleVmProfileDeleted polls until the AutoScaleVmProfile with the given ID no longer exists, the timeout
+// expires or the context is cancelled. Deleted resources may still be listed for a short while
+// after the delete command finished, so this can be used to make sure they are really gone. The
+// options are passed to GetAutoScaleVmProfileByID, so a resource outside of the default scope can be found.
+func (s *AutoScaleService) WaitForAutoScaleVmProfileDeleted(ctx context.Context, id string, timeout time.Duration, opts ...OptionFunc) error {
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	for {
+		_, count, err := s.GetAutoScaleVmProfileByID(id, opts...)
+		if count == 0 {
+			return nil
+		}
+		if count < 0 {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(2 * time.Second):
+		}
+	}
+}
+
 type DeleteAutoScaleVmProfileResponse struct {
 	JobID       string `json:"jobid"`
 	Displaytext string `json:"displaytext"`
@@ -923,6 +1751,16 @@ type DeleteConditionParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *DeleteConditionParams) Clone() *DeleteConditionParams {
+	c := &DeleteConditionParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeleteConditionParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -934,6 +1772,16 @@ func (p *DeleteConditionParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *DeleteConditionParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of deleteCondition", k)
+		}
+	}
+	return nil
+}
+
 func (p *DeleteConditionParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -942,18 +1790,54 @@ func (p *DeleteConditionParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *DeleteConditionParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 // You should always use this function to get a new DeleteConditionParams instance,
-// as then you are sure you have configured all required params
-func (s *AutoScaleService) NewDeleteConditionParams(id string) *DeleteConditionParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *AutoScaleService) NewDeleteConditionParams(id string, opts ...func(*DeleteConditionParams)) *DeleteConditionParams {
 	p := &DeleteConditionParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Removes a condition
-func (s *AutoScaleService) DeleteCondition(p *DeleteConditionParams) (*DeleteConditionResponse, error) {
-	resp, err := s.cs.newRequest("deleteCondition", p.toURLValues())
+func (s *AutoScaleService) DeleteCondition(p *DeleteConditionParams, opts ...OptionFunc) (*DeleteConditionResponse, error) {
+	return s.DeleteConditionWithContext(context.Background(), p, opts...)
+}
+
+// DeleteConditionWithContext is the same as DeleteCondition, but the request is cancelled when the context is done
+func (s *AutoScaleService) DeleteConditionWithContext(ctx context.Context, p *DeleteConditionParams, opts ...OptionFunc) (*DeleteConditionResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.DeleteConditionRawWithContext(ctx, p.toURLValues())
+}
+
+// DeleteConditionRaw is the same as DeleteCondition, but takes the params as url.Values instead of typed params
+func (s *AutoScaleService) DeleteConditionRaw(v url.Values) (*DeleteConditionResponse, error) {
+	return s.DeleteConditionRawWithContext(context.Background(), v)
+}
+
+// DeleteConditionRawWithContext is the same as DeleteConditionRaw, but the request is cancelled when the context is done
+func (s *AutoScaleService) DeleteConditionRawWithContext(ctx context.Context, v url.Values) (*DeleteConditionResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteCondition", "deleteconditionresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -965,9 +1849,9 @@ func (s *AutoScaleService) DeleteCondition(p *DeleteConditionParams) (*DeleteCon
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -981,6 +1865,34 @@ func (s *AutoScaleService) DeleteCondition(p *DeleteConditionParams) (*DeleteCon
 	return &r, nil
 }
 
+// WaitForConditionDeleted polls until the Condition with the given ID no longer exists, the timeout
+// expires or the context is cancelled. Deleted resources may still be listed for a short while
+// after the delete command finished, so this can be used to make sure they are really gone. The
+// options are passed to GetConditionByID, so a resource outside of the default scope can be found.
+func (s *AutoScaleService) WaitForConditionDeleted(ctx context.Context, id string, timeout time.Duration, opts ...OptionFunc) error {
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	for {
+		_, count, err := s.GetConditionByID(id, opts...)
+		if count == 0 {
+			return nil
+		}
+		if count < 0 {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(2 * time.Second):
+		}
+	}
+}
+
 type DeleteConditionResponse struct {
 	JobID       string `json:"jobid"`
 	Displaytext string `json:"displaytext"`
@@ -991,6 +1903,16 @@ type DeleteCounterParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *DeleteCounterParams) Clone() *DeleteCounterParams {
+	c := &DeleteCounterParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeleteCounterParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1002,6 +1924,16 @@ func (p *DeleteCounterParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *DeleteCounterParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of deleteCounter", k)
+		}
+	}
+	return nil
+}
+
 func (p *DeleteCounterParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1010,18 +1942,54 @@ func (p *DeleteCounterParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *DeleteCounterParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 // You should always use this function to get a new DeleteCounterParams instance,
-// as then you are sure you have configured all required params
-func (s *AutoScaleService) NewDeleteCounterParams(id string) *DeleteCounterParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *AutoScaleService) NewDeleteCounterParams(id string, opts ...func(*DeleteCounterParams)) *DeleteCounterParams {
 	p := &DeleteCounterParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Deletes a counter
-func (s *AutoScaleService) DeleteCounter(p *DeleteCounterParams) (*DeleteCounterResponse, error) {
-	resp, err := s.cs.newRequest("deleteCounter", p.toURLValues())
+func (s *AutoScaleService) DeleteCounter(p *DeleteCounterParams, opts ...OptionFunc) (*DeleteCounterResponse, error) {
+	return s.DeleteCounterWithContext(context.Background(), p, opts...)
+}
+
+// DeleteCounterWithContext is the same as DeleteCounter, but the request is cancelled when the context is done
+func (s *AutoScaleService) DeleteCounterWithContext(ctx context.Context, p *DeleteCounterParams, opts ...OptionFunc) (*DeleteCounterResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.DeleteCounterRawWithContext(ctx, p.toURLValues())
+}
+
+// DeleteCounterRaw is the same as DeleteCounter, but takes the params as url.Values instead of typed params
+func (s *AutoScaleService) DeleteCounterRaw(v url.Values) (*DeleteCounterResponse, error) {
+	return s.DeleteCounterRawWithContext(context.Background(), v)
+}
+
+// DeleteCounterRawWithContext is the same as DeleteCounterRaw, but the request is cancelled when the context is done
+func (s *AutoScaleService) DeleteCounterRawWithContext(ctx context.Context, v url.Values) (*DeleteCounterResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteCounter", "deletecounterresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -1033,9 +2001,9 @@ func (s *AutoScaleService) DeleteCounter(p *DeleteCounterParams) (*DeleteCounter
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -1049,6 +2017,34 @@ func (s *AutoScaleService) DeleteCounter(p *DeleteCounterParams) (*DeleteCounter
 	return &r, nil
 }
 
+// WaitForCounterDeleted polls until the Counter with the given ID no longer exists, the timeout
+// expires or the context is cancelled. Deleted resources may still be listed for a short while
+// after the delete command finished, so this can be used to make sure they are really gone. The
+// options are passed to GetCounterByID, so a resource outside of the default scope can be found.
+func (s *AutoScaleService) WaitForCounterDeleted(ctx context.Context, id string, timeout time.Duration, opts ...OptionFunc) error {
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	for {
+		_, count, err := s.GetCounterByID(id, opts...)
+		if count == 0 {
+			return nil
+		}
+		if count < 0 {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(2 * time.Second):
+		}
+	}
+}
+
 type DeleteCounterResponse struct {
 	JobID       string `json:"jobid"`
 	Displaytext string `json:"displaytext"`
@@ -1059,6 +2055,16 @@ type DisableAutoScaleVmGroupParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *DisableAutoScaleVmGroupParams) Clone() *DisableAutoScaleVmGroupParams {
+	c := &DisableAutoScaleVmGroupParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DisableAutoScaleVmGroupParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1070,6 +2076,16 @@ func (p *DisableAutoScaleVmGroupParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *DisableAutoScaleVmGroupParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of disableAutoScaleVmGroup", k)
+		}
+	}
+	return nil
+}
+
 func (p *DisableAutoScaleVmGroupParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1078,18 +2094,54 @@ func (p *DisableAutoScaleVmGroupParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *DisableAutoScaleVmGroupParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 // You should always use this function to get a new DisableAutoScaleVmGroupParams instance,
-// as then you are sure you have configured all required params
-func (s *AutoScaleService) NewDisableAutoScaleVmGroupParams(id string) *DisableAutoScaleVmGroupParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *AutoScaleService) NewDisableAutoScaleVmGroupParams(id string, opts ...func(*DisableAutoScaleVmGroupParams)) *DisableAutoScaleVmGroupParams {
 	p := &DisableAutoScaleVmGroupParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Disables an AutoScale Vm Group
-func (s *AutoScaleService) DisableAutoScaleVmGroup(p *DisableAutoScaleVmGroupParams) (*DisableAutoScaleVmGroupResponse, error) {
-	resp, err := s.cs.newRequest("disableAutoScaleVmGroup", p.toURLValues())
+func (s *AutoScaleService) DisableAutoScaleVmGroup(p *DisableAutoScaleVmGroupParams, opts ...OptionFunc) (*DisableAutoScaleVmGroupResponse, error) {
+	return s.DisableAutoScaleVmGroupWithContext(context.Background(), p, opts...)
+}
+
+// DisableAutoScaleVmGroupWithContext is the same as DisableAutoScaleVmGroup, but the request is cancelled when the context is done
+func (s *AutoScaleService) DisableAutoScaleVmGroupWithContext(ctx context.Context, p *DisableAutoScaleVmGroupParams, opts ...OptionFunc) (*DisableAutoScaleVmGroupResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.DisableAutoScaleVmGroupRawWithContext(ctx, p.toURLValues())
+}
+
+// DisableAutoScaleVmGroupRaw is the same as DisableAutoScaleVmGroup, but takes the params as url.Values instead of typed params
+func (s *AutoScaleService) DisableAutoScaleVmGroupRaw(v url.Values) (*DisableAutoScaleVmGroupResponse, error) {
+	return s.DisableAutoScaleVmGroupRawWithContext(context.Background(), v)
+}
+
+// DisableAutoScaleVmGroupRawWithContext is the same as DisableAutoScaleVmGroupRaw, but the request is cancelled when the context is done
+func (s *AutoScaleService) DisableAutoScaleVmGroupRawWithContext(ctx context.Context, v url.Values) (*DisableAutoScaleVmGroupResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "disableAutoScaleVmGroup", "disableautoscalevmgroupresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -1101,9 +2153,9 @@ func (s *AutoScaleService) DisableAutoScaleVmGroup(p *DisableAutoScaleVmGroupPar
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -1145,6 +2197,16 @@ type EnableAutoScaleVmGroupParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *EnableAutoScaleVmGroupParams) Clone() *EnableAutoScaleVmGroupParams {
+	c := &EnableAutoScaleVmGroupParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *EnableAutoScaleVmGroupParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1156,6 +2218,16 @@ func (p *EnableAutoScaleVmGroupParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *EnableAutoScaleVmGroupParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of enableAutoScaleVmGroup", k)
+		}
+	}
+	return nil
+}
+
 func (p *EnableAutoScaleVmGroupParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1164,18 +2236,54 @@ func (p *EnableAutoScaleVmGroupParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *EnableAutoScaleVmGroupParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 // You should always use this function to get a new EnableAutoScaleVmGroupParams instance,
-// as then you are sure you have configured all required params
-func (s *AutoScaleService) NewEnableAutoScaleVmGroupParams(id string) *EnableAutoScaleVmGroupParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *AutoScaleService) NewEnableAutoScaleVmGroupParams(id string, opts ...func(*EnableAutoScaleVmGroupParams)) *EnableAutoScaleVmGroupParams {
 	p := &EnableAutoScaleVmGroupParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Enables an AutoScale Vm Group
-func (s *AutoScaleService) EnableAutoScaleVmGroup(p *EnableAutoScaleVmGroupParams) (*EnableAutoScaleVmGroupResponse, error) {
-	resp, err := s.cs.newRequest("enableAutoScaleVmGroup", p.toURLValues())
+func (s *AutoScaleService) EnableAutoScaleVmGroup(p *EnableAutoScaleVmGroupParams, opts ...OptionFunc) (*EnableAutoScaleVmGroupResponse, error) {
+	return s.EnableAutoScaleVmGroupWithContext(context.Background(), p, opts...)
+}
+
+// EnableAutoScaleVmGroupWithContext is the same as EnableAutoScaleVmGroup, but the request is cancelled when the context is done
+func (s *AutoScaleService) EnableAutoScaleVmGroupWithContext(ctx context.Context, p *EnableAutoScaleVmGroupParams, opts ...OptionFunc) (*EnableAutoScaleVmGroupResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.EnableAutoScaleVmGroupRawWithContext(ctx, p.toURLValues())
+}
+
+// EnableAutoScaleVmGroupRaw is the same as EnableAutoScaleVmGroup, but takes the params as url.Values instead of typed params
+func (s *AutoScaleService) EnableAutoScaleVmGroupRaw(v url.Values) (*EnableAutoScaleVmGroupResponse, error) {
+	return s.EnableAutoScaleVmGroupRawWithContext(context.Background(), v)
+}
+
+// EnableAutoScaleVmGroupRawWithContext is the same as EnableAutoScaleVmGroupRaw, but the request is cancelled when the context is done
+func (s *AutoScaleService) EnableAutoScaleVmGroupRawWithContext(ctx context.Context, v url.Values) (*EnableAutoScaleVmGroupResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "enableAutoScaleVmGroup", "enableautoscalevmgroupresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -1187,9 +2295,9 @@ func (s *AutoScaleService) EnableAutoScaleVmGroup(p *EnableAutoScaleVmGroupParam
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -1231,6 +2339,16 @@ type ListAutoScalePoliciesParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ListAutoScalePoliciesParams) Clone() *ListAutoScalePoliciesParams {
+	c := &ListAutoScalePoliciesParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListAutoScalePoliciesParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1284,6 +2402,13 @@ func (p *ListAutoScalePoliciesParams) SetAccount(v string) {
 	return
 }
 
+// SetAccountIfUnset sets account to v, unless it is already set
+func (p *ListAutoScalePoliciesParams) SetAccountIfUnset(v string) {
+	if _, found := p.p["account"]; !found {
+		p.SetAccount(v)
+	}
+}
+
 func (p *ListAutoScalePoliciesParams) SetAction(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1292,6 +2417,13 @@ func (p *ListAutoScalePoliciesParams) SetAction(v string) {
 	return
 }
 
+// SetActionIfUnset sets action to v, unless it is already set
+func (p *ListAutoScalePoliciesParams) SetActionIfUnset(v string) {
+	if _, found := p.p["action"]; !found {
+		p.SetAction(v)
+	}
+}
+
 func (p *ListAutoScalePoliciesParams) SetConditionid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1300,6 +2432,13 @@ func (p *ListAutoScalePoliciesParams) SetConditionid(v string) {
 	return
 }
 
+// SetConditionidIfUnset sets conditionid to v, unless it is already set
+func (p *ListAutoScalePoliciesParams) SetConditionidIfUnset(v string) {
+	if _, found := p.p["conditionid"]; !found {
+		p.SetConditionid(v)
+	}
+}
+
 func (p *ListAutoScalePoliciesParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1308,6 +2447,13 @@ func (p *ListAutoScalePoliciesParams) SetDomainid(v string) {
 	return
 }
 
+// SetDomainidIfUnset sets domainid to v, unless it is already set
+func (p *ListAutoScalePoliciesParams) SetDomainidIfUnset(v string) {
+	if _, found := p.p["domainid"]; !found {
+		p.SetDomainid(v)
+	}
+}
+
 func (p *ListAutoScalePoliciesParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1316,6 +2462,13 @@ func (p *ListAutoScalePoliciesParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *ListAutoScalePoliciesParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 func (p *ListAutoScalePoliciesParams) SetIsrecursive(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1324,6 +2477,13 @@ func (p *ListAutoScalePoliciesParams) SetIsrecursive(v bool) {
 	return
 }
 
+// SetIsrecursiveIfUnset sets isrecursive to v, unless it is already set
+func (p *ListAutoScalePoliciesParams) SetIsrecursiveIfUnset(v bool) {
+	if _, found := p.p["isrecursive"]; !found {
+		p.SetIsrecursive(v)
+	}
+}
+
 func (p *ListAutoScalePoliciesParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1332,6 +2492,13 @@ func (p *ListAutoScalePoliciesParams) SetKeyword(v string) {
 	return
 }
 
+// SetKeywordIfUnset sets keyword to v, unless it is already set
+func (p *ListAutoScalePoliciesParams) SetKeywordIfUnset(v string) {
+	if _, found := p.p["keyword"]; !found {
+		p.SetKeyword(v)
+	}
+}
+
 func (p *ListAutoScalePoliciesParams) SetListall(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1340,6 +2507,13 @@ func (p *ListAutoScalePoliciesParams) SetListall(v bool) {
 	return
 }
 
+// SetListallIfUnset sets listall to v, unless it is already set
+func (p *ListAutoScalePoliciesParams) SetListallIfUnset(v bool) {
+	if _, found := p.p["listall"]; !found {
+		p.SetListall(v)
+	}
+}
+
 func (p *ListAutoScalePoliciesParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1348,6 +2522,13 @@ func (p *ListAutoScalePoliciesParams) SetPage(v int) {
 	return
 }
 
+// SetPageIfUnset sets page to v, unless it is already set
+func (p *ListAutoScalePoliciesParams) SetPageIfUnset(v int) {
+	if _, found := p.p["page"]; !found {
+		p.SetPage(v)
+	}
+}
+
 func (p *ListAutoScalePoliciesParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1356,6 +2537,13 @@ func (p *ListAutoScalePoliciesParams) SetPagesize(v int) {
 	return
 }
 
+// SetPagesizeIfUnset sets pagesize to v, unless it is already set
+func (p *ListAutoScalePoliciesParams) SetPagesizeIfUnset(v int) {
+	if _, found := p.p["pagesize"]; !found {
+		p.SetPagesize(v)
+	}
+}
+
 func (p *ListAutoScalePoliciesParams) SetVmgroupid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1364,14 +2552,34 @@ func (p *ListAutoScalePoliciesParams) SetVmgroupid(v string) {
 	return
 }
 
+// SetVmgroupidIfUnset sets vmgroupid to v, unless it is already set
+func (p *ListAutoScalePoliciesParams) SetVmgroupidIfUnset(v string) {
+	if _, found := p.p["vmgroupid"]; !found {
+		p.SetVmgroupid(v)
+	}
+}
+
+var _ ListAllSetter = (*ListAutoScalePoliciesParams)(nil)
+
 // You should always use this function to get a new ListAutoScalePoliciesParams instance,
-// as then you are sure you have configured all required params
-func (s *AutoScaleService) NewListAutoScalePoliciesParams() *ListAutoScalePoliciesParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *AutoScaleService) NewListAutoScalePoliciesParams(opts ...func(*ListAutoScalePoliciesParams)) *ListAutoScalePoliciesParams {
 	p := &ListAutoScalePoliciesParams{}
 	p.p = make(map[string]interface{})
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
+func (p *ListAutoScalePoliciesParams) setFirstMatch() {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p[firstMatchKey] = true
+}
+
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *AutoScaleService) GetAutoScalePolicyByID(id string, opts ...OptionFunc) (*AutoScalePolicy, int, error) {
 	p := &ListAutoScalePoliciesParams{}
@@ -1379,7 +2587,7 @@ func (s *AutoScaleService) GetAutoScalePolicyByID(id string, opts ...OptionFunc)
 
 	p.p["id"] = id
 
-	for _, fn := range append(s.cs.options, opts...) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
 		if err := fn(s.cs, p); err != nil {
 			return nil, -1, err
 		}
@@ -1390,24 +2598,56 @@ func (s *AutoScaleService) GetAutoScalePolicyByID(id string, opts ...OptionFunc)
 		if strings.Contains(err.Error(), fmt.Sprintf(
 			"Invalid parameter id value=%s due to incorrect long value format, "+
 				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+			return nil, 0, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
 	}
 
 	if l.Count == 1 {
 		return l.AutoScalePolicies[0], l.Count, nil
 	}
+
+	if _, ok := p.p[firstMatchKey]; ok && len(l.AutoScalePolicies) > 0 {
+		return l.AutoScalePolicies[0], l.Count, nil
+	}
 	return nil, l.Count, fmt.Errorf("There is more then one result for AutoScalePolicy UUID: %s!", id)
 }
 
+// AutoScalePolicyExists returns true if a AutoScalePolicy with the given ID exists. When no match is found
+// it returns false, while any other error is returned as is.
+func (s *AutoScaleService) AutoScalePolicyExists(id string, opts ...OptionFunc) (bool, error) {
+	_, count, err := s.GetAutoScalePolicyByID(id, opts...)
+	if errors.Is(err, ErrNotFound) {
+		return false, nil
+	}
+	if count > 0 {
+		return true, nil
+	}
+	return false, err
+}
+
 // Lists autoscale policies.
 func (s *AutoScaleService) ListAutoScalePolicies(p *ListAutoScalePoliciesParams) (*ListAutoScalePoliciesResponse, error) {
-	resp, err := s.cs.newRequest("listAutoScalePolicies", p.toURLValues())
+	return s.ListAutoScalePoliciesWithContext(context.Background(), p)
+}
+
+// ListAutoScalePoliciesWithContext is the same as ListAutoScalePolicies, but the request is cancelled when the context is done
+func (s *AutoScaleService) ListAutoScalePoliciesWithContext(ctx context.Context, p *ListAutoScalePoliciesParams) (*ListAutoScalePoliciesResponse, error) {
+	return s.ListAutoScalePoliciesRawWithContext(ctx, p.toURLValues())
+}
+
+// ListAutoScalePoliciesRaw is the same as ListAutoScalePolicies, but takes the params as url.Values instead of typed params
+func (s *AutoScaleService) ListAutoScalePoliciesRaw(v url.Values) (*ListAutoScalePoliciesResponse, error) {
+	return s.ListAutoScalePoliciesRawWithContext(context.Background(), v)
+}
+
+// ListAutoScalePoliciesRawWithContext is the same as ListAutoScalePoliciesRaw, but the request is cancelled when the context is done
+func (s *AutoScaleService) ListAutoScalePoliciesRawWithContext(ctx context.Context, v url.Values) (*ListAutoScalePoliciesResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "listAutoScalePolicies", "listautoscalepoliciesresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -1420,6 +2660,93 @@ func (s *AutoScaleService) ListAutoScalePolicies(p *ListAutoScalePoliciesParams)
 	return &r, nil
 }
 
+// StreamAutoScalePolicies fetches the results of ListAutoScalePolicies page by page in the background and sends them on the
+// returned channel. Both returned channels are closed when done. If fetching a page fails, or the context
+// is done before all results are sent, the error is sent on the error channel and fetching stops. A
+// request for a page in flight is cancelled when the context is done. The page and pagesize set in the
+// params are ignored.
+func (s *AutoScaleService) StreamAutoScalePolicies(ctx context.Context, p *ListAutoScalePoliciesParams) (<-chan *AutoScalePolicy, <-chan error) {
+	ch := make(chan *AutoScalePolicy)
+	errs := make(chan error, 1)
+
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesize(500)
+
+	go func() {
+		defer close(errs)
+		defer close(ch)
+
+		for page, count := 1, 0; ; page++ {
+			pp.SetPage(page)
+
+			l, err := s.ListAutoScalePoliciesWithContext(ctx, pp)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			for _, v := range l.AutoScalePolicies {
+				select {
+				case ch <- v:
+				case <-ctx.Done():
+					errs <- ctx.Err()
+					return
+				}
+			}
+
+			count += len(l.AutoScalePolicies)
+			if len(l.AutoScalePolicies) < 500 || count >= l.Count {
+				return
+			}
+		}
+	}()
+
+	return ch, errs
+}
+
+// ListAutoScalePoliciesWhere returns all results of ListAutoScalePolicies for which pred returns true. Note that the results are
+// filtered client-side, so all results matching the params are fetched first.
+// All pages are fetched, so the page and pagesize set in the params are ignored.
+func (s *AutoScaleService) ListAutoScalePoliciesWhere(p *ListAutoScalePoliciesParams, pred func(*AutoScalePolicy) bool) ([]*AutoScalePolicy, error) {
+	var r []*AutoScalePolicy
+	ch, errs := s.StreamAutoScalePolicies(context.Background(), p)
+	for v := range ch {
+		if pred(v) {
+			r = append(r, v)
+		}
+	}
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ListAllAutoScalePolicies returns the results of ListAutoScalePolicies across all pages, by calling ListAutoScalePolicies page by page until all
+// results are fetched. The pagesize set in the params is used for every page (500 if not set), and the
+// page set in the params is ignored.
+func (s *AutoScaleService) ListAllAutoScalePolicies(p *ListAutoScalePoliciesParams) ([]*AutoScalePolicy, error) {
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesizeIfUnset(500)
+	pagesize := pp.p["pagesize"].(int)
+
+	var r []*AutoScalePolicy
+	for page := 1; ; page++ {
+		pp.SetPage(page)
+
+		l, err := s.ListAutoScalePolicies(pp)
+		if err != nil {
+			return nil, err
+		}
+		r = append(r, l.AutoScalePolicies...)
+
+		if len(l.AutoScalePolicies) < pagesize || len(r) >= l.Count {
+			return r, nil
+		}
+	}
+}
+
 type ListAutoScalePoliciesResponse struct {
 	Count             int                `json:"count"`
 	AutoScalePolicies []*AutoScalePolicy `json:"autoscalepolicy"`
@@ -1442,6 +2769,16 @@ type ListAutoScaleVmGroupsParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ListAutoScaleVmGroupsParams) Clone() *ListAutoScaleVmGroupsParams {
+	c := &ListAutoScaleVmGroupsParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListAutoScaleVmGroupsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1505,6 +2842,13 @@ func (p *ListAutoScaleVmGroupsParams) SetAccount(v string) {
 	return
 }
 
+// SetAccountIfUnset sets account to v, unless it is already set
+func (p *ListAutoScaleVmGroupsParams) SetAccountIfUnset(v string) {
+	if _, found := p.p["account"]; !found {
+		p.SetAccount(v)
+	}
+}
+
 func (p *ListAutoScaleVmGroupsParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1513,6 +2857,13 @@ func (p *ListAutoScaleVmGroupsParams) SetDomainid(v string) {
 	return
 }
 
+// SetDomainidIfUnset sets domainid to v, unless it is already set
+func (p *ListAutoScaleVmGroupsParams) SetDomainidIfUnset(v string) {
+	if _, found := p.p["domainid"]; !found {
+		p.SetDomainid(v)
+	}
+}
+
 func (p *ListAutoScaleVmGroupsParams) SetFordisplay(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1521,6 +2872,13 @@ func (p *ListAutoScaleVmGroupsParams) SetFordisplay(v bool) {
 	return
 }
 
+// SetFordisplayIfUnset sets fordisplay to v, unless it is already set
+func (p *ListAutoScaleVmGroupsParams) SetFordisplayIfUnset(v bool) {
+	if _, found := p.p["fordisplay"]; !found {
+		p.SetFordisplay(v)
+	}
+}
+
 func (p *ListAutoScaleVmGroupsParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1529,6 +2887,13 @@ func (p *ListAutoScaleVmGroupsParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *ListAutoScaleVmGroupsParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 func (p *ListAutoScaleVmGroupsParams) SetIsrecursive(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1537,6 +2902,13 @@ func (p *ListAutoScaleVmGroupsParams) SetIsrecursive(v bool) {
 	return
 }
 
+// SetIsrecursiveIfUnset sets isrecursive to v, unless it is already set
+func (p *ListAutoScaleVmGroupsParams) SetIsrecursiveIfUnset(v bool) {
+	if _, found := p.p["isrecursive"]; !found {
+		p.SetIsrecursive(v)
+	}
+}
+
 func (p *ListAutoScaleVmGroupsParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1545,6 +2917,13 @@ func (p *ListAutoScaleVmGroupsParams) SetKeyword(v string) {
 	return
 }
 
+// SetKeywordIfUnset sets keyword to v, unless it is already set
+func (p *ListAutoScaleVmGroupsParams) SetKeywordIfUnset(v string) {
+	if _, found := p.p["keyword"]; !found {
+		p.SetKeyword(v)
+	}
+}
+
 func (p *ListAutoScaleVmGroupsParams) SetLbruleid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1553,6 +2932,13 @@ func (p *ListAutoScaleVmGroupsParams) SetLbruleid(v string) {
 	return
 }
 
+// SetLbruleidIfUnset sets lbruleid to v, unless it is already set
+func (p *ListAutoScaleVmGroupsParams) SetLbruleidIfUnset(v string) {
+	if _, found := p.p["lbruleid"]; !found {
+		p.SetLbruleid(v)
+	}
+}
+
 func (p *ListAutoScaleVmGroupsParams) SetListall(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1561,6 +2947,13 @@ func (p *ListAutoScaleVmGroupsParams) SetListall(v bool) {
 	return
 }
 
+// SetListallIfUnset sets listall to v, unless it is already set
+func (p *ListAutoScaleVmGroupsParams) SetListallIfUnset(v bool) {
+	if _, found := p.p["listall"]; !found {
+		p.SetListall(v)
+	}
+}
+
 func (p *ListAutoScaleVmGroupsParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1569,6 +2962,13 @@ func (p *ListAutoScaleVmGroupsParams) SetPage(v int) {
 	return
 }
 
+// SetPageIfUnset sets page to v, unless it is already set
+func (p *ListAutoScaleVmGroupsParams) SetPageIfUnset(v int) {
+	if _, found := p.p["page"]; !found {
+		p.SetPage(v)
+	}
+}
+
 func (p *ListAutoScaleVmGroupsParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1577,6 +2977,13 @@ func (p *ListAutoScaleVmGroupsParams) SetPagesize(v int) {
 	return
 }
 
+// SetPagesizeIfUnset sets pagesize to v, unless it is already set
+func (p *ListAutoScaleVmGroupsParams) SetPagesizeIfUnset(v int) {
+	if _, found := p.p["pagesize"]; !found {
+		p.SetPagesize(v)
+	}
+}
+
 func (p *ListAutoScaleVmGroupsParams) SetPolicyid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1585,6 +2992,13 @@ func (p *ListAutoScaleVmGroupsParams) SetPolicyid(v string) {
 	return
 }
 
+// SetPolicyidIfUnset sets policyid to v, unless it is already set
+func (p *ListAutoScaleVmGroupsParams) SetPolicyidIfUnset(v string) {
+	if _, found := p.p["policyid"]; !found {
+		p.SetPolicyid(v)
+	}
+}
+
 func (p *ListAutoScaleVmGroupsParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1593,6 +3007,13 @@ func (p *ListAutoScaleVmGroupsParams) SetProjectid(v string) {
 	return
 }
 
+// SetProjectidIfUnset sets projectid to v, unless it is already set
+func (p *ListAutoScaleVmGroupsParams) SetProjectidIfUnset(v string) {
+	if _, found := p.p["projectid"]; !found {
+		p.SetProjectid(v)
+	}
+}
+
 func (p *ListAutoScaleVmGroupsParams) SetVmprofileid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1601,6 +3022,13 @@ func (p *ListAutoScaleVmGroupsParams) SetVmprofileid(v string) {
 	return
 }
 
+// SetVmprofileidIfUnset sets vmprofileid to v, unless it is already set
+func (p *ListAutoScaleVmGroupsParams) SetVmprofileidIfUnset(v string) {
+	if _, found := p.p["vmprofileid"]; !found {
+		p.SetVmprofileid(v)
+	}
+}
+
 func (p *ListAutoScaleVmGroupsParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1609,14 +3037,34 @@ func (p *ListAutoScaleVmGroupsParams) SetZoneid(v string) {
 	return
 }
 
+// SetZoneidIfUnset sets zoneid to v, unless it is already set
+func (p *ListAutoScaleVmGroupsParams) SetZoneidIfUnset(v string) {
+	if _, found := p.p["zoneid"]; !found {
+		p.SetZoneid(v)
+	}
+}
+
+var _ ListAllSetter = (*ListAutoScaleVmGroupsParams)(nil)
+
 // You should always use this function to get a new ListAutoScaleVmGroupsParams instance,
-// as then you are sure you have configured all required params
-func (s *AutoScaleService) NewListAutoScaleVmGroupsParams() *ListAutoScaleVmGroupsParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *AutoScaleService) NewListAutoScaleVmGroupsParams(opts ...func(*ListAutoScaleVmGroupsParams)) *ListAutoScaleVmGroupsParams {
 	p := &ListAutoScaleVmGroupsParams{}
 	p.p = make(map[string]interface{})
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
+func (p *ListAutoScaleVmGroupsParams) setFirstMatch() {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p[firstMatchKey] = true
+}
+
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *AutoScaleService) GetAutoScaleVmGroupByID(id string, opts ...OptionFunc) (*AutoScaleVmGroup, int, error) {
 	p := &ListAutoScaleVmGroupsParams{}
@@ -1624,7 +3072,7 @@ func (s *AutoScaleService) GetAutoScaleVmGroupByID(id string, opts ...OptionFunc
 
 	p.p["id"] = id
 
-	for _, fn := range append(s.cs.options, opts...) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
 		if err := fn(s.cs, p); err != nil {
 			return nil, -1, err
 		}
@@ -1635,24 +3083,56 @@ func (s *AutoScaleService) GetAutoScaleVmGroupByID(id string, opts ...OptionFunc
 		if strings.Contains(err.Error(), fmt.Sprintf(
 			"Invalid parameter id value=%s due to incorrect long value format, "+
 				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+			return nil, 0, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
 	}
 
 	if l.Count == 1 {
 		return l.AutoScaleVmGroups[0], l.Count, nil
 	}
+
+	if _, ok := p.p[firstMatchKey]; ok && len(l.AutoScaleVmGroups) > 0 {
+		return l.AutoScaleVmGroups[0], l.Count, nil
+	}
 	return nil, l.Count, fmt.Errorf("There is more then one result for AutoScaleVmGroup UUID: %s!", id)
 }
 
+// AutoScaleVmGroupExists returns true if a AutoScaleVmGroup with the given ID exists. When no match is found
+// it returns false, while any other error is returned as is.
+func (s *AutoScaleService) AutoScaleVmGroupExists(id string, opts ...OptionFunc) (bool, error) {
+	_, count, err := s.GetAutoScaleVmGroupByID(id, opts...)
+	if errors.Is(err, ErrNotFound) {
+		return false, nil
+	}
+	if count > 0 {
+		return true, nil
+	}
+	return false, err
+}
+
 // Lists autoscale vm groups.
 func (s *AutoScaleService) ListAutoScaleVmGroups(p *ListAutoScaleVmGroupsParams) (*ListAutoScaleVmGroupsResponse, error) {
-	resp, err := s.cs.newRequest("listAutoScaleVmGroups", p.toURLValues())
+	return s.ListAutoScaleVmGroupsWithContext(context.Background(), p)
+}
+
+// ListAutoScaleVmGroupsWithContext is the same as ListAutoScaleVmGroups, but the request is cancelled when the context is done
+func (s *AutoScaleService) ListAutoScaleVmGroupsWithContext(ctx context.Context, p *ListAutoScaleVmGroupsParams) (*ListAutoScaleVmGroupsResponse, error) {
+	return s.ListAutoScaleVmGroupsRawWithContext(ctx, p.toURLValues())
+}
+
+// ListAutoScaleVmGroupsRaw is the same as ListAutoScaleVmGroups, but takes the params as url.Values instead of typed params
+func (s *AutoScaleService) ListAutoScaleVmGroupsRaw(v url.Values) (*ListAutoScaleVmGroupsResponse, error) {
+	return s.ListAutoScaleVmGroupsRawWithContext(context.Background(), v)
+}
+
+// ListAutoScaleVmGroupsRawWithContext is the same as ListAutoScaleVmGroupsRaw, but the request is cancelled when the context is done
+func (s *AutoScaleService) ListAutoScaleVmGroupsRawWithContext(ctx context.Context, v url.Values) (*ListAutoScaleVmGroupsResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "listAutoScaleVmGroups", "listautoscalevmgroupsresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -1665,6 +3145,93 @@ func (s *AutoScaleService) ListAutoScaleVmGroups(p *ListAutoScaleVmGroupsParams)
 	return &r, nil
 }
 
+// StreamAutoScaleVmGroups fetches the results of ListAutoScaleVmGroups page by page in the background and sends them on the
+// returned channel. Both returned channels are closed when done. If fetching a page fails, or the context
+// is done before all results are sent, the error is sent on the error channel and fetching stops. A
+// request for a page in flight is cancelled when the context is done. The page and pagesize set in the
+// params are ignored.
+func (s *AutoScaleService) StreamAutoScaleVmGroups(ctx context.Context, p *ListAutoScaleVmGroupsParams) (<-chan *AutoScaleVmGroup, <-chan error) {
+	ch := make(chan *AutoScaleVmGroup)
+	errs := make(chan error, 1)
+
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesize(500)
+
+	go func() {
+		defer close(errs)
+		defer close(ch)
+
+		for page, count := 1, 0; ; page++ {
+			pp.SetPage(page)
+
+			l, err := s.ListAutoScaleVmGroupsWithContext(ctx, pp)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			for _, v := range l.AutoScaleVmGroups {
+				select {
+				case ch <- v:
+				case <-ctx.Done():
+					errs <- ctx.Err()
+					return
+				}
+			}
+
+			count += len(l.AutoScaleVmGroups)
+			if len(l.AutoScaleVmGroups) < 500 || count >= l.Count {
+				return
+			}
+		}
+	}()
+
+	return ch, errs
+}
+
+// ListAutoScaleVmGroupsWhere returns all results of ListAutoScaleVmGroups for which pred returns true. Note that the results are
+// filtered client-side, so all results matching the params are fetched first.
+// All pages are fetched, so the page and pagesize set in the params are ignored.
+func (s *AutoScaleService) ListAutoScaleVmGroupsWhere(p *ListAutoScaleVmGroupsParams, pred func(*AutoScaleVmGroup) bool) ([]*AutoScaleVmGroup, error) {
+	var r []*AutoScaleVmGroup
+	ch, errs := s.StreamAutoScaleVmGroups(context.Background(), p)
+	for v := range ch {
+		if pred(v) {
+			r = append(r, v)
+		}
+	}
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ListAllAutoScaleVmGroups returns the results of ListAutoScaleVmGroups across all pages, by calling ListAutoScaleVmGroups page by page until all
+// results are fetched. The pagesize set in the params is used for every page (500 if not set), and the
+// page set in the params is ignored.
+func (s *AutoScaleService) ListAllAutoScaleVmGroups(p *ListAutoScaleVmGroupsParams) ([]*AutoScaleVmGroup, error) {
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesizeIfUnset(500)
+	pagesize := pp.p["pagesize"].(int)
+
+	var r []*AutoScaleVmGroup
+	for page := 1; ; page++ {
+		pp.SetPage(page)
+
+		l, err := s.ListAutoScaleVmGroups(pp)
+		if err != nil {
+			return nil, err
+		}
+		r = append(r, l.AutoScaleVmGroups...)
+
+		if len(l.AutoScaleVmGroups) < pagesize || len(r) >= l.Count {
+			return r, nil
+		}
+	}
+}
+
 type ListAutoScaleVmGroupsResponse struct {
 	Count             int                 `json:"count"`
 	AutoScaleVmGroups []*AutoScaleVmGroup `json:"autoscalevmgroup"`
@@ -1692,6 +3259,16 @@ type ListAutoScaleVmProfilesParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ListAutoScaleVmProfilesParams) Clone() *ListAutoScaleVmProfilesParams {
+	c := &ListAutoScaleVmProfilesParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListAutoScaleVmProfilesParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1755,6 +3332,13 @@ func (p *ListAutoScaleVmProfilesParams) SetAccount(v string) {
 	return
 }
 
+// SetAccountIfUnset sets account to v, unless it is already set
+func (p *ListAutoScaleVmProfilesParams) SetAccountIfUnset(v string) {
+	if _, found := p.p["account"]; !found {
+		p.SetAccount(v)
+	}
+}
+
 func (p *ListAutoScaleVmProfilesParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1763,6 +3347,13 @@ func (p *ListAutoScaleVmProfilesParams) SetDomainid(v string) {
 	return
 }
 
+// SetDomainidIfUnset sets domainid to v, unless it is already set
+func (p *ListAutoScaleVmProfilesParams) SetDomainidIfUnset(v string) {
+	if _, found := p.p["domainid"]; !found {
+		p.SetDomainid(v)
+	}
+}
+
 func (p *ListAutoScaleVmProfilesParams) SetFordisplay(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1771,6 +3362,13 @@ func (p *ListAutoScaleVmProfilesParams) SetFordisplay(v bool) {
 	return
 }
 
+// SetFordisplayIfUnset sets fordisplay to v, unless it is already set
+func (p *ListAutoScaleVmProfilesParams) SetFordisplayIfUnset(v bool) {
+	if _, found := p.p["fordisplay"]; !found {
+		p.SetFordisplay(v)
+	}
+}
+
 func (p *ListAutoScaleVmProfilesParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1779,6 +3377,13 @@ func (p *ListAutoScaleVmProfilesParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *ListAutoScaleVmProfilesParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 func (p *ListAutoScaleVmProfilesParams) SetIsrecursive(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1787,6 +3392,13 @@ func (p *ListAutoScaleVmProfilesParams) SetIsrecursive(v bool) {
 	return
 }
 
+// SetIsrecursiveIfUnset sets isrecursive to v, unless it is already set
+func (p *ListAutoScaleVmProfilesParams) SetIsrecursiveIfUnset(v bool) {
+	if _, found := p.p["isrecursive"]; !found {
+		p.SetIsrecursive(v)
+	}
+}
+
 func (p *ListAutoScaleVmProfilesParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1795,6 +3407,13 @@ func (p *ListAutoScaleVmProfilesParams) SetKeyword(v string) {
 	return
 }
 
+// SetKeywordIfUnset sets keyword to v, unless it is already set
+func (p *ListAutoScaleVmProfilesParams) SetKeywordIfUnset(v string) {
+	if _, found := p.p["keyword"]; !found {
+		p.SetKeyword(v)
+	}
+}
+
 func (p *ListAutoScaleVmProfilesParams) SetListall(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1803,6 +3422,13 @@ func (p *ListAutoScaleVmProfilesParams) SetListall(v bool) {
 	return
 }
 
+// SetListallIfUnset sets listall to v, unless it is already set
+func (p *ListAutoScaleVmProfilesParams) SetListallIfUnset(v bool) {
+	if _, found := p.p["listall"]; !found {
+		p.SetListall(v)
+	}
+}
+
 func (p *ListAutoScaleVmProfilesParams) SetOtherdeployparams(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1811,6 +3437,13 @@ func (p *ListAutoScaleVmProfilesParams) SetOtherdeployparams(v string) {
 	return
 }
 
+// SetOtherdeployparamsIfUnset sets otherdeployparams to v, unless it is already set
+func (p *ListAutoScaleVmProfilesParams) SetOtherdeployparamsIfUnset(v string) {
+	if _, found := p.p["otherdeployparams"]; !found {
+		p.SetOtherdeployparams(v)
+	}
+}
+
 func (p *ListAutoScaleVmProfilesParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1819,6 +3452,13 @@ func (p *ListAutoScaleVmProfilesParams) SetPage(v int) {
 	return
 }
 
+// SetPageIfUnset sets page to v, unless it is already set
+func (p *ListAutoScaleVmProfilesParams) SetPageIfUnset(v int) {
+	if _, found := p.p["page"]; !found {
+		p.SetPage(v)
+	}
+}
+
 func (p *ListAutoScaleVmProfilesParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1827,6 +3467,13 @@ func (p *ListAutoScaleVmProfilesParams) SetPagesize(v int) {
 	return
 }
 
+// SetPagesizeIfUnset sets pagesize to v, unless it is already set
+func (p *ListAutoScaleVmProfilesParams) SetPagesizeIfUnset(v int) {
+	if _, found := p.p["pagesize"]; !found {
+		p.SetPagesize(v)
+	}
+}
+
 func (p *ListAutoScaleVmProfilesParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1835,6 +3482,13 @@ func (p *ListAutoScaleVmProfilesParams) SetProjectid(v string) {
 	return
 }
 
+// SetProjectidIfUnset sets projectid to v, unless it is already set
+func (p *ListAutoScaleVmProfilesParams) SetProjectidIfUnset(v string) {
+	if _, found := p.p["projectid"]; !found {
+		p.SetProjectid(v)
+	}
+}
+
 func (p *ListAutoScaleVmProfilesParams) SetServiceofferingid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1843,6 +3497,13 @@ func (p *ListAutoScaleVmProfilesParams) SetServiceofferingid(v string) {
 	return
 }
 
+// SetServiceofferingidIfUnset sets serviceofferingid to v, unless it is already set
+func (p *ListAutoScaleVmProfilesParams) SetServiceofferingidIfUnset(v string) {
+	if _, found := p.p["serviceofferingid"]; !found {
+		p.SetServiceofferingid(v)
+	}
+}
+
 func (p *ListAutoScaleVmProfilesParams) SetTemplateid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1851,6 +3512,13 @@ func (p *ListAutoScaleVmProfilesParams) SetTemplateid(v string) {
 	return
 }
 
+// SetTemplateidIfUnset sets templateid to v, unless it is already set
+func (p *ListAutoScaleVmProfilesParams) SetTemplateidIfUnset(v string) {
+	if _, found := p.p["templateid"]; !found {
+		p.SetTemplateid(v)
+	}
+}
+
 func (p *ListAutoScaleVmProfilesParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1859,14 +3527,34 @@ func (p *ListAutoScaleVmProfilesParams) SetZoneid(v string) {
 	return
 }
 
+// SetZoneidIfUnset sets zoneid to v, unless it is already set
+func (p *ListAutoScaleVmProfilesParams) SetZoneidIfUnset(v string) {
+	if _, found := p.p["zoneid"]; !found {
+		p.SetZoneid(v)
+	}
+}
+
+var _ ListAllSetter = (*ListAutoScaleVmProfilesParams)(nil)
+
 // You should always use this function to get a new ListAutoScaleVmProfilesParams instance,
-// as then you are sure you have configured all required params
-func (s *AutoScaleService) NewListAutoScaleVmProfilesParams() *ListAutoScaleVmProfilesParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *AutoScaleService) NewListAutoScaleVmProfilesParams(opts ...func(*ListAutoScaleVmProfilesParams)) *ListAutoScaleVmProfilesParams {
 	p := &ListAutoScaleVmProfilesParams{}
 	p.p = make(map[string]interface{})
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
+func (p *ListAutoScaleVmProfilesParams) setFirstMatch() {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p[firstMatchKey] = true
+}
+
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *AutoScaleService) GetAutoScaleVmProfileByID(id string, opts ...OptionFunc) (*AutoScaleVmProfile, int, error) {
 	p := &ListAutoScaleVmProfilesParams{}
@@ -1874,7 +3562,7 @@ func (s *AutoScaleService) GetAutoScaleVmProfileByID(id string, opts ...OptionFu
 
 	p.p["id"] = id
 
-	for _, fn := range append(s.cs.options, opts...) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
 		if err := fn(s.cs, p); err != nil {
 			return nil, -1, err
 		}
@@ -1885,24 +3573,56 @@ func (s *AutoScaleService) GetAutoScaleVmProfileByID(id string, opts ...OptionFu
 		if strings.Contains(err.Error(), fmt.Sprintf(
 			"Invalid parameter id value=%s due to incorrect long value format, "+
 				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+			return nil, 0, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
 	}
 
 	if l.Count == 1 {
 		return l.AutoScaleVmProfiles[0], l.Count, nil
 	}
+
+	if _, ok := p.p[firstMatchKey]; ok && len(l.AutoScaleVmProfiles) > 0 {
+		return l.AutoScaleVmProfiles[0], l.Count, nil
+	}
 	return nil, l.Count, fmt.Errorf("There is more then one result for AutoScaleVmProfile UUID: %s!", id)
 }
 
+// AutoScaleVmProfileExists returns true if a AutoScaleVmProfile with the given ID exists. When no match is found
+// it returns false, while any other error is returned as is.
+func (s *AutoScaleService) AutoScaleVmProfileExists(id string, opts ...OptionFunc) (bool, error) {
+	_, count, err := s.GetAutoScaleVmProfileByID(id, opts...)
+	if errors.Is(err, ErrNotFound) {
+		return false, nil
+	}
+	if count > 0 {
+		return true, nil
+	}
+	return false, err
+}
+
 // Lists autoscale vm profiles.
 func (s *AutoScaleService) ListAutoScaleVmProfiles(p *ListAutoScaleVmProfilesParams) (*ListAutoScaleVmProfilesResponse, error) {
-	resp, err := s.cs.newRequest("listAutoScaleVmProfiles", p.toURLValues())
+	return s.ListAutoScaleVmProfilesWithContext(context.Background(), p)
+}
+
+// ListAutoScaleVmProfilesWithContext is the same as ListAutoScaleVmProfiles, but the request is cancelled when the context is done
+func (s *AutoScaleService) ListAutoScaleVmProfilesWithContext(ctx context.Context, p *ListAutoScaleVmProfilesParams) (*ListAutoScaleVmProfilesResponse, error) {
+	return s.ListAutoScaleVmProfilesRawWithContext(ctx, p.toURLValues())
+}
+
+// ListAutoScaleVmProfilesRaw is the same as ListAutoScaleVmProfiles, but takes the params as url.Values instead of typed params
+func (s *AutoScaleService) ListAutoScaleVmProfilesRaw(v url.Values) (*ListAutoScaleVmProfilesResponse, error) {
+	return s.ListAutoScaleVmProfilesRawWithContext(context.Background(), v)
+}
+
+// ListAutoScaleVmProfilesRawWithContext is the same as ListAutoScaleVmProfilesRaw, but the request is cancelled when the context is done
+func (s *AutoScaleService) ListAutoScaleVmProfilesRawWithContext(ctx context.Context, v url.Values) (*ListAutoScaleVmProfilesResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "listAutoScaleVmProfiles", "listautoscalevmprofilesresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -1915,6 +3635,93 @@ func (s *AutoScaleService) ListAutoScaleVmProfiles(p *ListAutoScaleVmProfilesPar
 	return &r, nil
 }
 
+// StreamAutoScaleVmProfiles fetches the results of ListAutoScaleVmProfiles page by page in the background and sends them on the
+// returned channel. Both returned channels are closed when done. If fetching a page fails, or the context
+// is done before all results are sent, the error is sent on the error channel and fetching stops. A
+// request for a page in flight is cancelled when the context is done. The page and pagesize set in the
+// params are ignored.
+func (s *AutoScaleService) StreamAutoScaleVmProfiles(ctx context.Context, p *ListAutoScaleVmProfilesParams) (<-chan *AutoScaleVmProfile, <-chan error) {
+	ch := make(chan *AutoScaleVmProfile)
+	errs := make(chan error, 1)
+
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesize(500)
+
+	go func() {
+		defer close(errs)
+		defer close(ch)
+
+		for page, count := 1, 0; ; page++ {
+			pp.SetPage(page)
+
+			l, err := s.ListAutoScaleVmProfilesWithContext(ctx, pp)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			for _, v := range l.AutoScaleVmProfiles {
+				select {
+				case ch <- v:
+				case <-ctx.Done():
+					errs <- ctx.Err()
+					return
+				}
+			}
+
+			count += len(l.AutoScaleVmProfiles)
+			if len(l.AutoScaleVmProfiles) < 500 || count >= l.Count {
+				return
+			}
+		}
+	}()
+
+	return ch, errs
+}
+
+// ListAutoScaleVmProfilesWhere returns all results of ListAutoScaleVmProfiles for which pred returns true. Note that the results are
+// filtered client-side, so all results matching the params are fetched first.
+// All pages are fetched, so the page and pagesize set in the params are ignored.
+func (s *AutoScaleService) ListAutoScaleVmProfilesWhere(p *ListAutoScaleVmProfilesParams, pred func(*AutoScaleVmProfile) bool) ([]*AutoScaleVmProfile, error) {
+	var r []*AutoScaleVmProfile
+	ch, errs := s.StreamAutoScaleVmProfiles(context.Background(), p)
+	for v := range ch {
+		if pred(v) {
+			r = append(r, v)
+		}
+	}
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ListAllAutoScaleVmProfiles returns the results of ListAutoScaleVmProfiles across all pages, by calling ListAutoScaleVmProfiles page by page until all
+// results are fetched. The pagesize set in the params is used for every page (500 if not set), and the
+// page set in the params is ignored.
+func (s *AutoScaleService) ListAllAutoScaleVmProfiles(p *ListAutoScaleVmProfilesParams) ([]*AutoScaleVmProfile, error) {
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesizeIfUnset(500)
+	pagesize := pp.p["pagesize"].(int)
+
+	var r []*AutoScaleVmProfile
+	for page := 1; ; page++ {
+		pp.SetPage(page)
+
+		l, err := s.ListAutoScaleVmProfiles(pp)
+		if err != nil {
+			return nil, err
+		}
+		r = append(r, l.AutoScaleVmProfiles...)
+
+		if len(l.AutoScaleVmProfiles) < pagesize || len(r) >= l.Count {
+			return r, nil
+		}
+	}
+}
+
 type ListAutoScaleVmProfilesResponse struct {
 	Count               int                   `json:"count"`
 	AutoScaleVmProfiles []*AutoScaleVmProfile `json:"autoscalevmprofile"`
@@ -1940,6 +3747,16 @@ type ListConditionsParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ListConditionsParams) Clone() *ListConditionsParams {
+	c := &ListConditionsParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListConditionsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1990,6 +3807,13 @@ func (p *ListConditionsParams) SetAccount(v string) {
 	return
 }
 
+// SetAccountIfUnset sets account to v, unless it is already set
+func (p *ListConditionsParams) SetAccountIfUnset(v string) {
+	if _, found := p.p["account"]; !found {
+		p.SetAccount(v)
+	}
+}
+
 func (p *ListConditionsParams) SetCounterid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1998,6 +3822,13 @@ func (p *ListConditionsParams) SetCounterid(v string) {
 	return
 }
 
+// SetCounteridIfUnset sets counterid to v, unless it is already set
+func (p *ListConditionsParams) SetCounteridIfUnset(v string) {
+	if _, found := p.p["counterid"]; !found {
+		p.SetCounterid(v)
+	}
+}
+
 func (p *ListConditionsParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2006,6 +3837,13 @@ func (p *ListConditionsParams) SetDomainid(v string) {
 	return
 }
 
+// SetDomainidIfUnset sets domainid to v, unless it is already set
+func (p *ListConditionsParams) SetDomainidIfUnset(v string) {
+	if _, found := p.p["domainid"]; !found {
+		p.SetDomainid(v)
+	}
+}
+
 func (p *ListConditionsParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2014,6 +3852,13 @@ func (p *ListConditionsParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *ListConditionsParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 func (p *ListConditionsParams) SetIsrecursive(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2022,6 +3867,13 @@ func (p *ListConditionsParams) SetIsrecursive(v bool) {
 	return
 }
 
+// SetIsrecursiveIfUnset sets isrecursive to v, unless it is already set
+func (p *ListConditionsParams) SetIsrecursiveIfUnset(v bool) {
+	if _, found := p.p["isrecursive"]; !found {
+		p.SetIsrecursive(v)
+	}
+}
+
 func (p *ListConditionsParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2030,6 +3882,13 @@ func (p *ListConditionsParams) SetKeyword(v string) {
 	return
 }
 
+// SetKeywordIfUnset sets keyword to v, unless it is already set
+func (p *ListConditionsParams) SetKeywordIfUnset(v string) {
+	if _, found := p.p["keyword"]; !found {
+		p.SetKeyword(v)
+	}
+}
+
 func (p *ListConditionsParams) SetListall(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2038,6 +3897,13 @@ func (p *ListConditionsParams) SetListall(v bool) {
 	return
 }
 
+// SetListallIfUnset sets listall to v, unless it is already set
+func (p *ListConditionsParams) SetListallIfUnset(v bool) {
+	if _, found := p.p["listall"]; !found {
+		p.SetListall(v)
+	}
+}
+
 func (p *ListConditionsParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2046,6 +3912,13 @@ func (p *ListConditionsParams) SetPage(v int) {
 	return
 }
 
+// SetPageIfUnset sets page to v, unless it is already set
+func (p *ListConditionsParams) SetPageIfUnset(v int) {
+	if _, found := p.p["page"]; !found {
+		p.SetPage(v)
+	}
+}
+
 func (p *ListConditionsParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2054,6 +3927,13 @@ func (p *ListConditionsParams) SetPagesize(v int) {
 	return
 }
 
+// SetPagesizeIfUnset sets pagesize to v, unless it is already set
+func (p *ListConditionsParams) SetPagesizeIfUnset(v int) {
+	if _, found := p.p["pagesize"]; !found {
+		p.SetPagesize(v)
+	}
+}
+
 func (p *ListConditionsParams) SetPolicyid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2062,14 +3942,34 @@ func (p *ListConditionsParams) SetPolicyid(v string) {
 	return
 }
 
+// SetPolicyidIfUnset sets policyid to v, unless it is already set
+func (p *ListConditionsParams) SetPolicyidIfUnset(v string) {
+	if _, found := p.p["policyid"]; !found {
+		p.SetPolicyid(v)
+	}
+}
+
+var _ ListAllSetter = (*ListConditionsParams)(nil)
+
 // You should always use this function to get a new ListConditionsParams instance,
-// as then you are sure you have configured all required params
-func (s *AutoScaleService) NewListConditionsParams() *ListConditionsParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *AutoScaleService) NewListConditionsParams(opts ...func(*ListConditionsParams)) *ListConditionsParams {
 	p := &ListConditionsParams{}
 	p.p = make(map[string]interface{})
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
+func (p *ListConditionsParams) setFirstMatch() {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p[firstMatchKey] = true
+}
+
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *AutoScaleService) GetConditionByID(id string, opts ...OptionFunc) (*Condition, int, error) {
 	p := &ListConditionsParams{}
@@ -2077,7 +3977,7 @@ func (s *AutoScaleService) GetConditionByID(id string, opts ...OptionFunc) (*Con
 
 	p.p["id"] = id
 
-	for _, fn := range append(s.cs.options, opts...) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
 		if err := fn(s.cs, p); err != nil {
 			return nil, -1, err
 		}
@@ -2088,24 +3988,56 @@ func (s *AutoScaleService) GetConditionByID(id string, opts ...OptionFunc) (*Con
 		if strings.Contains(err.Error(), fmt.Sprintf(
 			"Invalid parameter id value=%s due to incorrect long value format, "+
 				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+			return nil, 0, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
 	}
 
 	if l.Count == 1 {
 		return l.Conditions[0], l.Count, nil
 	}
+
+	if _, ok := p.p[firstMatchKey]; ok && len(l.Conditions) > 0 {
+		return l.Conditions[0], l.Count, nil
+	}
 	return nil, l.Count, fmt.Errorf("There is more then one result for Condition UUID: %s!", id)
 }
 
+// ConditionExists returns true if a Condition with the given ID exists. When no match is found
+// it returns false, while any other error is returned as is.
+func (s *AutoScaleService) ConditionExists(id string, opts ...OptionFunc) (bool, error) {
+	_, count, err := s.GetConditionByID(id, opts...)
+	if errors.Is(err, ErrNotFound) {
+		return false, nil
+	}
+	if count > 0 {
+		return true, nil
+	}
+	return false, err
+}
+
 // List Conditions for the specific user
 func (s *AutoScaleService) ListConditions(p *ListConditionsParams) (*ListConditionsResponse, error) {
-	resp, err := s.cs.newRequest("listConditions", p.toURLValues())
+	return s.ListConditionsWithContext(context.Background(), p)
+}
+
+// ListConditionsWithContext is the same as ListConditions, but the request is cancelled when the context is done
+func (s *AutoScaleService) ListConditionsWithContext(ctx context.Context, p *ListConditionsParams) (*ListConditionsResponse, error) {
+	return s.ListConditionsRawWithContext(ctx, p.toURLValues())
+}
+
+// ListConditionsRaw is the same as ListConditions, but takes the params as url.Values instead of typed params
+func (s *AutoScaleService) ListConditionsRaw(v url.Values) (*ListConditionsResponse, error) {
+	return s.ListConditionsRawWithContext(context.Background(), v)
+}
+
+// ListConditionsRawWithContext is the same as ListConditionsRaw, but the request is cancelled when the context is done
+func (s *AutoScaleService) ListConditionsRawWithContext(ctx context.Context, v url.Values) (*ListConditionsResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "listConditions", "listconditionsresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -2118,6 +4050,93 @@ func (s *AutoScaleService) ListConditions(p *ListConditionsParams) (*ListConditi
 	return &r, nil
 }
 
+// StreamConditions fetches the results of ListConditions page by page in the background and sends them on the
+// returned channel. Both returned channels are closed when done. If fetching a page fails, or the context
+// is done before all results are sent, the error is sent on the error channel and fetching stops. A
+// request for a page in flight is cancelled when the context is done. The page and pagesize set in the
+// params are ignored.
+func (s *AutoScaleService) StreamConditions(ctx context.Context, p *ListConditionsParams) (<-chan *Condition, <-chan error) {
+	ch := make(chan *Condition)
+	errs := make(chan error, 1)
+
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesize(500)
+
+	go func() {
+		defer close(errs)
+		defer close(ch)
+
+		for page, count := 1, 0; ; page++ {
+			pp.SetPage(page)
+
+			l, err := s.ListConditionsWithContext(ctx, pp)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			for _, v := range l.Conditions {
+				select {
+				case ch <- v:
+				case <-ctx.Done():
+					errs <- ctx.Err()
+					return
+				}
+			}
+
+			count += len(l.Conditions)
+			if len(l.Conditions) < 500 || count >= l.Count {
+				return
+			}
+		}
+	}()
+
+	return ch, errs
+}
+
+// ListConditionsWhere returns all results of ListConditions for which pred returns true. Note that the results are
+// filtered client-side, so all results matching the params are fetched first.
+// All pages are fetched, so the page and pagesize set in the params are ignored.
+func (s *AutoScaleService) ListConditionsWhere(p *ListConditionsParams, pred func(*Condition) bool) ([]*Condition, error) {
+	var r []*Condition
+	ch, errs := s.StreamConditions(context.Background(), p)
+	for v := range ch {
+		if pred(v) {
+			r = append(r, v)
+		}
+	}
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ListAllConditions returns the results of ListConditions across all pages, by calling ListConditions page by page until all
+// results are fetched. The pagesize set in the params is used for every page (500 if not set), and the
+// page set in the params is ignored.
+func (s *AutoScaleService) ListAllConditions(p *ListConditionsParams) ([]*Condition, error) {
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesizeIfUnset(500)
+	pagesize := pp.p["pagesize"].(int)
+
+	var r []*Condition
+	for page := 1; ; page++ {
+		pp.SetPage(page)
+
+		l, err := s.ListConditions(pp)
+		if err != nil {
+			return nil, err
+		}
+		r = append(r, l.Conditions...)
+
+		if len(l.Conditions) < pagesize || len(r) >= l.Count {
+			return r, nil
+		}
+	}
+}
+
 type ListConditionsResponse struct {
 	Count      int          `json:"count"`
 	Conditions []*Condition `json:"condition"`
@@ -2140,6 +4159,16 @@ type ListCountersParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ListCountersParams) Clone() *ListCountersParams {
+	c := &ListCountersParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListCountersParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -2176,6 +4205,13 @@ func (p *ListCountersParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *ListCountersParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 func (p *ListCountersParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2184,6 +4220,13 @@ func (p *ListCountersParams) SetKeyword(v string) {
 	return
 }
 
+// SetKeywordIfUnset sets keyword to v, unless it is already set
+func (p *ListCountersParams) SetKeywordIfUnset(v string) {
+	if _, found := p.p["keyword"]; !found {
+		p.SetKeyword(v)
+	}
+}
+
 func (p *ListCountersParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2192,6 +4235,13 @@ func (p *ListCountersParams) SetName(v string) {
 	return
 }
 
+// SetNameIfUnset sets name to v, unless it is already set
+func (p *ListCountersParams) SetNameIfUnset(v string) {
+	if _, found := p.p["name"]; !found {
+		p.SetName(v)
+	}
+}
+
 func (p *ListCountersParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2200,6 +4250,13 @@ func (p *ListCountersParams) SetPage(v int) {
 	return
 }
 
+// SetPageIfUnset sets page to v, unless it is already set
+func (p *ListCountersParams) SetPageIfUnset(v int) {
+	if _, found := p.p["page"]; !found {
+		p.SetPage(v)
+	}
+}
+
 func (p *ListCountersParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2208,6 +4265,13 @@ func (p *ListCountersParams) SetPagesize(v int) {
 	return
 }
 
+// SetPagesizeIfUnset sets pagesize to v, unless it is already set
+func (p *ListCountersParams) SetPagesizeIfUnset(v int) {
+	if _, found := p.p["pagesize"]; !found {
+		p.SetPagesize(v)
+	}
+}
+
 func (p *ListCountersParams) SetSource(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2216,14 +4280,32 @@ func (p *ListCountersParams) SetSource(v string) {
 	return
 }
 
+// SetSourceIfUnset sets source to v, unless it is already set
+func (p *ListCountersParams) SetSourceIfUnset(v string) {
+	if _, found := p.p["source"]; !found {
+		p.SetSource(v)
+	}
+}
+
 // You should always use this function to get a new ListCountersParams instance,
-// as then you are sure you have configured all required params
-func (s *AutoScaleService) NewListCountersParams() *ListCountersParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *AutoScaleService) NewListCountersParams(opts ...func(*ListCountersParams)) *ListCountersParams {
 	p := &ListCountersParams{}
 	p.p = make(map[string]interface{})
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
+func (p *ListCountersParams) setFirstMatch() {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p[firstMatchKey] = true
+}
+
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *AutoScaleService) GetCounterID(name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListCountersParams{}
@@ -2231,7 +4313,7 @@ func (s *AutoScaleService) GetCounterID(name string, opts ...OptionFunc) (string
 
 	p.p["name"] = name
 
-	for _, fn := range append(s.cs.options, opts...) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
 		if err := fn(s.cs, p); err != nil {
 			return "", -1, err
 		}
@@ -2243,7 +4325,7 @@ func (s *AutoScaleService) GetCounterID(name string, opts ...OptionFunc) (string
 	}
 
 	if l.Count == 0 {
-		return "", l.Count, fmt.Errorf("No match found for %s: %+v", name, l)
+		return "", l.Count, fmt.Errorf("%w for %s: %+v", ErrNotFound, name, l)
 	}
 
 	if l.Count == 1 {
@@ -2256,6 +4338,10 @@ func (s *AutoScaleService) GetCounterID(name string, opts ...OptionFunc) (string
 				return v.Id, l.Count, nil
 			}
 		}
+
+		if _, ok := p.p[firstMatchKey]; ok && len(l.Counters) > 0 {
+			return l.Counters[0].Id, l.Count, nil
+		}
 	}
 	return "", l.Count, fmt.Errorf("Could not find an exact match for %s: %+v", name, l)
 }
@@ -2281,7 +4367,7 @@ func (s *AutoScaleService) GetCounterByID(id string, opts ...OptionFunc) (*Count
 
 	p.p["id"] = id
 
-	for _, fn := range append(s.cs.options, opts...) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
 		if err := fn(s.cs, p); err != nil {
 			return nil, -1, err
 		}
@@ -2292,24 +4378,56 @@ func (s *AutoScaleService) GetCounterByID(id string, opts ...OptionFunc) (*Count
 		if strings.Contains(err.Error(), fmt.Sprintf(
 			"Invalid parameter id value=%s due to incorrect long value format, "+
 				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+			return nil, 0, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
 	}
 
 	if l.Count == 1 {
 		return l.Counters[0], l.Count, nil
 	}
+
+	if _, ok := p.p[firstMatchKey]; ok && len(l.Counters) > 0 {
+		return l.Counters[0], l.Count, nil
+	}
 	return nil, l.Count, fmt.Errorf("There is more then one result for Counter UUID: %s!", id)
 }
 
+// CounterExists returns true if a Counter with the given ID exists. When no match is found
+// it returns false, while any other error is returned as is.
+func (s *AutoScaleService) CounterExists(id string, opts ...OptionFunc) (bool, error) {
+	_, count, err := s.GetCounterByID(id, opts...)
+	if errors.Is(err, ErrNotFound) {
+		return false, nil
+	}
+	if count > 0 {
+		return true, nil
+	}
+	return false, err
+}
+
 // List the counters
 func (s *AutoScaleService) ListCounters(p *ListCountersParams) (*ListCountersResponse, error) {
-	resp, err := s.cs.newRequest("listCounters", p.toURLValues())
+	return s.ListCountersWithContext(context.Background(), p)
+}
+
+// ListCountersWithContext is the same as ListCounters, but the request is cancelled when the context is done
+func (s *AutoScaleService) ListCountersWithContext(ctx context.Context, p *ListCountersParams) (*ListCountersResponse, error) {
+	return s.ListCountersRawWithContext(ctx, p.toURLValues())
+}
+
+// ListCountersRaw is the same as ListCounters, but takes the params as url.Values instead of typed params
+func (s *AutoScaleService) ListCountersRaw(v url.Values) (*ListCountersResponse, error) {
+	return s.ListCountersRawWithContext(context.Background(), v)
+}
+
+// ListCountersRawWithContext is the same as ListCountersRaw, but the request is cancelled when the context is done
+func (s *AutoScaleService) ListCountersRawWithContext(ctx context.Context, v url.Values) (*ListCountersResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "listCounters", "listcountersresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -2322,6 +4440,93 @@ func (s *AutoScaleService) ListCounters(p *ListCountersParams) (*ListCountersRes
 	return &r, nil
 }
 
+// StreamCounters fetches the results of ListCounters page by page in the background and sends them on the
+// returned channel. Both returned channels are closed when done. If fetching a page fails, or the context
+// is done before all results are sent, the error is sent on the error channel and fetching stops. A
+// request for a page in flight is cancelled when the context is done. The page and pagesize set in the
+// params are ignored.
+func (s *AutoScaleService) StreamCounters(ctx context.Context, p *ListCountersParams) (<-chan *Counter, <-chan error) {
+	ch := make(chan *Counter)
+	errs := make(chan error, 1)
+
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesize(500)
+
+	go func() {
+		defer close(errs)
+		defer close(ch)
+
+		for page, count := 1, 0; ; page++ {
+			pp.SetPage(page)
+
+			l, err := s.ListCountersWithContext(ctx, pp)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			for _, v := range l.Counters {
+				select {
+				case ch <- v:
+				case <-ctx.Done():
+					errs <- ctx.Err()
+					return
+				}
+			}
+
+			count += len(l.Counters)
+			if len(l.Counters) < 500 || count >= l.Count {
+				return
+			}
+		}
+	}()
+
+	return ch, errs
+}
+
+// ListCountersWhere returns all results of ListCounters for which pred returns true. Note that the results are
+// filtered client-side, so all results matching the params are fetched first.
+// All pages are fetched, so the page and pagesize set in the params are ignored.
+func (s *AutoScaleService) ListCountersWhere(p *ListCountersParams, pred func(*Counter) bool) ([]*Counter, error) {
+	var r []*Counter
+	ch, errs := s.StreamCounters(context.Background(), p)
+	for v := range ch {
+		if pred(v) {
+			r = append(r, v)
+		}
+	}
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ListAllCounters returns the results of ListCounters across all pages, by calling ListCounters page by page until all
+// results are fetched. The pagesize set in the params is used for every page (500 if not set), and the
+// page set in the params is ignored.
+func (s *AutoScaleService) ListAllCounters(p *ListCountersParams) ([]*Counter, error) {
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesizeIfUnset(500)
+	pagesize := pp.p["pagesize"].(int)
+
+	var r []*Counter
+	for page := 1; ; page++ {
+		pp.SetPage(page)
+
+		l, err := s.ListCounters(pp)
+		if err != nil {
+			return nil, err
+		}
+		r = append(r, l.Counters...)
+
+		if len(l.Counters) < pagesize || len(r) >= l.Count {
+			return r, nil
+		}
+	}
+}
+
 type ListCountersResponse struct {
 	Count    int        `json:"count"`
 	Counters []*Counter `json:"counter"`
@@ -2339,6 +4544,16 @@ type UpdateAutoScalePolicyParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *UpdateAutoScalePolicyParams) Clone() *UpdateAutoScalePolicyParams {
+	c := &UpdateAutoScalePolicyParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *UpdateAutoScalePolicyParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -2362,6 +4577,16 @@ func (p *UpdateAutoScalePolicyParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *UpdateAutoScalePolicyParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of updateAutoScalePolicy", k)
+		}
+	}
+	return nil
+}
+
 func (p *UpdateAutoScalePolicyParams) SetConditionids(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2370,6 +4595,18 @@ func (p *UpdateAutoScalePolicyParams) SetConditionids(v []string) {
 	return
 }
 
+// SetConditionidsIfUnset sets conditionids to v, unless it is already set
+func (p *UpdateAutoScalePolicyParams) SetConditionidsIfUnset(v []string) {
+	if _, found := p.p["conditionids"]; !found {
+		p.SetConditionids(v)
+	}
+}
+
+// SetConditionidsCSV splits v on commas and sets the resulting values
+func (p *UpdateAutoScalePolicyParams) SetConditionidsCSV(v string) {
+	p.SetConditionids(splitCSV(v))
+}
+
 func (p *UpdateAutoScalePolicyParams) SetDuration(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2378,6 +4615,13 @@ func (p *UpdateAutoScalePolicyParams) SetDuration(v int) {
 	return
 }
 
+// SetDurationIfUnset sets duration to v, unless it is already set
+func (p *UpdateAutoScalePolicyParams) SetDurationIfUnset(v int) {
+	if _, found := p.p["duration"]; !found {
+		p.SetDuration(v)
+	}
+}
+
 func (p *UpdateAutoScalePolicyParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2386,6 +4630,13 @@ func (p *UpdateAutoScalePolicyParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *UpdateAutoScalePolicyParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 func (p *UpdateAutoScalePolicyParams) SetQuiettime(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2394,18 +4645,54 @@ func (p *UpdateAutoScalePolicyParams) SetQuiettime(v int) {
 	return
 }
 
+// SetQuiettimeIfUnset sets quiettime to v, unless it is already set
+func (p *UpdateAutoScalePolicyParams) SetQuiettimeIfUnset(v int) {
+	if _, found := p.p["quiettime"]; !found {
+		p.SetQuiettime(v)
+	}
+}
+
 // You should always use this function to get a new UpdateAutoScalePolicyParams instance,
-// as then you are sure you have configured all required params
-func (s *AutoScaleService) NewUpdateAutoScalePolicyParams(id string) *UpdateAutoScalePolicyParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *AutoScaleService) NewUpdateAutoScalePolicyParams(id string, opts ...func(*UpdateAutoScalePolicyParams)) *UpdateAutoScalePolicyParams {
 	p := &UpdateAutoScalePolicyParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Updates an existing autoscale policy.
-func (s *AutoScaleService) UpdateAutoScalePolicy(p *UpdateAutoScalePolicyParams) (*UpdateAutoScalePolicyResponse, error) {
-	resp, err := s.cs.newRequest("updateAutoScalePolicy", p.toURLValues())
+func (s *AutoScaleService) UpdateAutoScalePolicy(p *UpdateAutoScalePolicyParams, opts ...OptionFunc) (*UpdateAutoScalePolicyResponse, error) {
+	return s.UpdateAutoScalePolicyWithContext(context.Background(), p, opts...)
+}
+
+// UpdateAutoScalePolicyWithContext is the same as UpdateAutoScalePolicy, but the request is cancelled when the context is done
+func (s *AutoScaleService) UpdateAutoScalePolicyWithContext(ctx context.Context, p *UpdateAutoScalePolicyParams, opts ...OptionFunc) (*UpdateAutoScalePolicyResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.UpdateAutoScalePolicyRawWithContext(ctx, p.toURLValues())
+}
+
+// UpdateAutoScalePolicyRaw is the same as UpdateAutoScalePolicy, but takes the params as url.Values instead of typed params
+func (s *AutoScaleService) UpdateAutoScalePolicyRaw(v url.Values) (*UpdateAutoScalePolicyResponse, error) {
+	return s.UpdateAutoScalePolicyRawWithContext(context.Background(), v)
+}
+
+// UpdateAutoScalePolicyRawWithContext is the same as UpdateAutoScalePolicyRaw, but the request is cancelled when the context is done
+func (s *AutoScaleService) UpdateAutoScalePolicyRawWithContext(ctx context.Context, v url.Values) (*UpdateAutoScalePolicyResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "updateAutoScalePolicy", "updateautoscalepolicyresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -2417,9 +4704,9 @@ func (s *AutoScaleService) UpdateAutoScalePolicy(p *UpdateAutoScalePolicyParams)
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -2456,6 +4743,16 @@ type UpdateAutoScaleVmGroupParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *UpdateAutoScaleVmGroupParams) Clone() *UpdateAutoScaleVmGroupParams {
+	c := &UpdateAutoScaleVmGroupParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *UpdateAutoScaleVmGroupParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -2494,6 +4791,16 @@ func (p *UpdateAutoScaleVmGroupParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *UpdateAutoScaleVmGroupParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of updateAutoScaleVmGroup", k)
+		}
+	}
+	return nil
+}
+
 func (p *UpdateAutoScaleVmGroupParams) SetCustomid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2502,6 +4809,13 @@ func (p *UpdateAutoScaleVmGroupParams) SetCustomid(v string) {
 	return
 }
 
+// SetCustomidIfUnset sets customid to v, unless it is already set
+func (p *UpdateAutoScaleVmGroupParams) SetCustomidIfUnset(v string) {
+	if _, found := p.p["customid"]; !found {
+		p.SetCustomid(v)
+	}
+}
+
 func (p *UpdateAutoScaleVmGroupParams) SetFordisplay(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2510,6 +4824,13 @@ func (p *UpdateAutoScaleVmGroupParams) SetFordisplay(v bool) {
 	return
 }
 
+// SetFordisplayIfUnset sets fordisplay to v, unless it is already set
+func (p *UpdateAutoScaleVmGroupParams) SetFordisplayIfUnset(v bool) {
+	if _, found := p.p["fordisplay"]; !found {
+		p.SetFordisplay(v)
+	}
+}
+
 func (p *UpdateAutoScaleVmGroupParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2518,6 +4839,13 @@ func (p *UpdateAutoScaleVmGroupParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *UpdateAutoScaleVmGroupParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 func (p *UpdateAutoScaleVmGroupParams) SetInterval(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2526,6 +4854,13 @@ func (p *UpdateAutoScaleVmGroupParams) SetInterval(v int) {
 	return
 }
 
+// SetIntervalIfUnset sets interval to v, unless it is already set
+func (p *UpdateAutoScaleVmGroupParams) SetIntervalIfUnset(v int) {
+	if _, found := p.p["interval"]; !found {
+		p.SetInterval(v)
+	}
+}
+
 func (p *UpdateAutoScaleVmGroupParams) SetMaxmembers(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2534,6 +4869,13 @@ func (p *UpdateAutoScaleVmGroupParams) SetMaxmembers(v int) {
 	return
 }
 
+// SetMaxmembersIfUnset sets maxmembers to v, unless it is already set
+func (p *UpdateAutoScaleVmGroupParams) SetMaxmembersIfUnset(v int) {
+	if _, found := p.p["maxmembers"]; !found {
+		p.SetMaxmembers(v)
+	}
+}
+
 func (p *UpdateAutoScaleVmGroupParams) SetMinmembers(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2542,6 +4884,13 @@ func (p *UpdateAutoScaleVmGroupParams) SetMinmembers(v int) {
 	return
 }
 
+// SetMinmembersIfUnset sets minmembers to v, unless it is already set
+func (p *UpdateAutoScaleVmGroupParams) SetMinmembersIfUnset(v int) {
+	if _, found := p.p["minmembers"]; !found {
+		p.SetMinmembers(v)
+	}
+}
+
 func (p *UpdateAutoScaleVmGroupParams) SetScaledownpolicyids(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2550,6 +4899,18 @@ func (p *UpdateAutoScaleVmGroupParams) SetScaledownpolicyids(v []string) {
 	return
 }
 
+// SetScaledownpolicyidsIfUnset sets scaledownpolicyids to v, unless it is already set
+func (p *UpdateAutoScaleVmGroupParams) SetScaledownpolicyidsIfUnset(v []string) {
+	if _, found := p.p["scaledownpolicyids"]; !found {
+		p.SetScaledownpolicyids(v)
+	}
+}
+
+// SetScaledownpolicyidsCSV splits v on commas and sets the resulting values
+func (p *UpdateAutoScaleVmGroupParams) SetScaledownpolicyidsCSV(v string) {
+	p.SetScaledownpolicyids(splitCSV(v))
+}
+
 func (p *UpdateAutoScaleVmGroupParams) SetScaleuppolicyids(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2558,18 +4919,59 @@ func (p *UpdateAutoScaleVmGroupParams) SetScaleuppolicyids(v []string) {
 	return
 }
 
+// SetScaleuppolicyidsIfUnset sets scaleuppolicyids to v, unless it is already set
+func (p *UpdateAutoScaleVmGroupParams) SetScaleuppolicyidsIfUnset(v []string) {
+	if _, found := p.p["scaleuppolicyids"]; !found {
+		p.SetScaleuppolicyids(v)
+	}
+}
+
+// SetScaleuppolicyidsCSV splits v on commas and sets the resulting values
+func (p *UpdateAutoScaleVmGroupParams) SetScaleuppolicyidsCSV(v string) {
+	p.SetScaleuppolicyids(splitCSV(v))
+}
+
 // You should always use this function to get a new UpdateAutoScaleVmGroupParams instance,
-// as then you are sure you have configured all required params
-func (s *AutoScaleService) NewUpdateAutoScaleVmGroupParams(id string) *UpdateAutoScaleVmGroupParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *AutoScaleService) NewUpdateAutoScaleVmGroupParams(id string, opts ...func(*UpdateAutoScaleVmGroupParams)) *UpdateAutoScaleVmGroupParams {
 	p := &UpdateAutoScaleVmGroupParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Updates an existing autoscale vm group.
-func (s *AutoScaleService) UpdateAutoScaleVmGroup(p *UpdateAutoScaleVmGroupParams) (*UpdateAutoScaleVmGroupResponse, error) {
-	resp, err := s.cs.newRequest("updateAutoScaleVmGroup", p.toURLValues())
+func (s *AutoScaleService) UpdateAutoScaleVmGroup(p *UpdateAutoScaleVmGroupParams, opts ...OptionFunc) (*UpdateAutoScaleVmGroupResponse, error) {
+	return s.UpdateAutoScaleVmGroupWithContext(context.Background(), p, opts...)
+}
+
+// UpdateAutoScaleVmGroupWithContext is the same as UpdateAutoScaleVmGroup, but the request is cancelled when the context is done
+func (s *AutoScaleService) UpdateAutoScaleVmGroupWithContext(ctx context.Context, p *UpdateAutoScaleVmGroupParams, opts ...OptionFunc) (*UpdateAutoScaleVmGroupResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.UpdateAutoScaleVmGroupRawWithContext(ctx, p.toURLValues())
+}
+
+// UpdateAutoScaleVmGroupRaw is the same as UpdateAutoScaleVmGroup, but takes the params as url.Values instead of typed params
+func (s *AutoScaleService) UpdateAutoScaleVmGroupRaw(v url.Values) (*UpdateAutoScaleVmGroupResponse, error) {
+	return s.UpdateAutoScaleVmGroupRawWithContext(context.Background(), v)
+}
+
+// UpdateAutoScaleVmGroupRawWithContext is the same as UpdateAutoScaleVmGroupRaw, but the request is cancelled when the context is done
+func (s *AutoScaleService) UpdateAutoScaleVmGroupRawWithContext(ctx context.Context, v url.Values) (*UpdateAutoScaleVmGroupResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "updateAutoScaleVmGroup", "updateautoscalevmgroupresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -2581,9 +4983,9 @@ func (s *AutoScaleService) UpdateAutoScaleVmGroup(p *UpdateAutoScaleVmGroupParam
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -2625,6 +5027,16 @@ type UpdateAutoScaleVmProfileParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *UpdateAutoScaleVmProfileParams) Clone() *UpdateAutoScaleVmProfileParams {
+	c := &UpdateAutoScaleVmProfileParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *UpdateAutoScaleVmProfileParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -2634,11 +5046,10 @@ func (p *UpdateAutoScaleVmProfileParams) toURLValues() url.Values {
 		u.Set("autoscaleuserid", v.(string))
 	}
 	if v, found := p.p["counterparam"]; found {
-		i := 0
-		for k, vv := range v.(map[string]string) {
+		m := v.(map[string]string)
+		for i, k := range getSortedKeysFromMap(m) {
 			u.Set(fmt.Sprintf("counterparam[%d].key", i), k)
-			u.Set(fmt.Sprintf("counterparam[%d].value", i), vv)
-			i++
+			u.Set(fmt.Sprintf("counterparam[%d].value", i), m[k])
 		}
 	}
 	if v, found := p.p["customid"]; found {
@@ -2661,6 +5072,16 @@ func (p *UpdateAutoScaleVmProfileParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *UpdateAutoScaleVmProfileParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of updateAutoScaleVmProfile", k)
+		}
+	}
+	return nil
+}
+
 func (p *UpdateAutoScaleVmProfileParams) SetAutoscaleuserid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2669,6 +5090,13 @@ func (p *UpdateAutoScaleVmProfileParams) SetAutoscaleuserid(v string) {
 	return
 }
 
+// SetAutoscaleuseridIfUnset sets autoscaleuserid to v, unless it is already set
+func (p *UpdateAutoScaleVmProfileParams) SetAutoscaleuseridIfUnset(v string) {
+	if _, found := p.p["autoscaleuserid"]; !found {
+		p.SetAutoscaleuserid(v)
+	}
+}
+
 func (p *UpdateAutoScaleVmProfileParams) SetCounterparam(v map[string]string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2677,6 +5105,13 @@ func (p *UpdateAutoScaleVmProfileParams) SetCounterparam(v map[string]string) {
 	return
 }
 
+// SetCounterparamIfUnset sets counterparam to v, unless it is already set
+func (p *UpdateAutoScaleVmProfileParams) SetCounterparamIfUnset(v map[string]string) {
+	if _, found := p.p["counterparam"]; !found {
+		p.SetCounterparam(v)
+	}
+}
+
 func (p *UpdateAutoScaleVmProfileParams) SetCustomid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2685,6 +5120,13 @@ func (p *UpdateAutoScaleVmProfileParams) SetCustomid(v string) {
 	return
 }
 
+// SetCustomidIfUnset sets customid to v, unless it is already set
+func (p *UpdateAutoScaleVmProfileParams) SetCustomidIfUnset(v string) {
+	if _, found := p.p["customid"]; !found {
+		p.SetCustomid(v)
+	}
+}
+
 func (p *UpdateAutoScaleVmProfileParams) SetDestroyvmgraceperiod(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2693,6 +5135,13 @@ func (p *UpdateAutoScaleVmProfileParams) SetDestroyvmgraceperiod(v int) {
 	return
 }
 
+// SetDestroyvmgraceperiodIfUnset sets destroyvmgraceperiod to v, unless it is already set
+func (p *UpdateAutoScaleVmProfileParams) SetDestroyvmgraceperiodIfUnset(v int) {
+	if _, found := p.p["destroyvmgraceperiod"]; !found {
+		p.SetDestroyvmgraceperiod(v)
+	}
+}
+
 func (p *UpdateAutoScaleVmProfileParams) SetFordisplay(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2701,6 +5150,13 @@ func (p *UpdateAutoScaleVmProfileParams) SetFordisplay(v bool) {
 	return
 }
 
+// SetFordisplayIfUnset sets fordisplay to v, unless it is already set
+func (p *UpdateAutoScaleVmProfileParams) SetFordisplayIfUnset(v bool) {
+	if _, found := p.p["fordisplay"]; !found {
+		p.SetFordisplay(v)
+	}
+}
+
 func (p *UpdateAutoScaleVmProfileParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2709,6 +5165,13 @@ func (p *UpdateAutoScaleVmProfileParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *UpdateAutoScaleVmProfileParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 func (p *UpdateAutoScaleVmProfileParams) SetTemplateid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2717,18 +5180,54 @@ func (p *UpdateAutoScaleVmProfileParams) SetTemplateid(v string) {
 	return
 }
 
+// SetTemplateidIfUnset sets templateid to v, unless it is already set
+func (p *UpdateAutoScaleVmProfileParams) SetTemplateidIfUnset(v string) {
+	if _, found := p.p["templateid"]; !found {
+		p.SetTemplateid(v)
+	}
+}
+
 // You should always use this function to get a new UpdateAutoScaleVmProfileParams instance,
-// as then you are sure you have configured all required params
-func (s *AutoScaleService) NewUpdateAutoScaleVmProfileParams(id string) *UpdateAutoScaleVmProfileParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *AutoScaleService) NewUpdateAutoScaleVmProfileParams(id string, opts ...func(*UpdateAutoScaleVmProfileParams)) *UpdateAutoScaleVmProfileParams {
 	p := &UpdateAutoScaleVmProfileParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Updates an existing autoscale vm profile.
-func (s *AutoScaleService) UpdateAutoScaleVmProfile(p *UpdateAutoScaleVmProfileParams) (*UpdateAutoScaleVmProfileResponse, error) {
-	resp, err := s.cs.newRequest("updateAutoScaleVmProfile", p.toURLValues())
+func (s *AutoScaleService) UpdateAutoScaleVmProfile(p *UpdateAutoScaleVmProfileParams, opts ...OptionFunc) (*UpdateAutoScaleVmProfileResponse, error) {
+	return s.UpdateAutoScaleVmProfileWithContext(context.Background(), p, opts...)
+}
+
+// UpdateAutoScaleVmProfileWithContext is the same as UpdateAutoScaleVmProfile, but the request is cancelled when the context is done
+func (s *AutoScaleService) UpdateAutoScaleVmProfileWithContext(ctx context.Context, p *UpdateAutoScaleVmProfileParams, opts ...OptionFunc) (*UpdateAutoScaleVmProfileResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.UpdateAutoScaleVmProfileRawWithContext(ctx, p.toURLValues())
+}
+
+// UpdateAutoScaleVmProfileRaw is the same as UpdateAutoScaleVmProfile, but takes the params as url.Values instead of typed params
+func (s *AutoScaleService) UpdateAutoScaleVmProfileRaw(v url.Values) (*UpdateAutoScaleVmProfileResponse, error) {
+	return s.UpdateAutoScaleVmProfileRawWithContext(context.Background(), v)
+}
+
+// UpdateAutoScaleVmProfileRawWithContext is the same as UpdateAutoScaleVmProfileRaw, but the request is cancelled when the context is done
+func (s *AutoScaleService) UpdateAutoScaleVmProfileRawWithContext(ctx context.Context, v url.Values) (*UpdateAutoScaleVmProfileResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "updateAutoScaleVmProfile", "updateautoscalevmprofileresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -2740,9 +5239,9 @@ func (s *AutoScaleService) UpdateAutoScaleVmProfile(p *UpdateAutoScaleVmProfileP
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
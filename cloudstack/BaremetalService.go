@@ -17,7 +17,9 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
 	"net/url"
 	"strconv"
 )
@@ -26,6 +28,16 @@ type AddBaremetalDhcpParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *AddBaremetalDhcpParams) Clone() *AddBaremetalDhcpParams {
+	c := &AddBaremetalDhcpParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *AddBaremetalDhcpParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -49,6 +61,16 @@ func (p *AddBaremetalDhcpParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *AddBaremetalDhcpParams) validate() error {
+	for _, k := range []string{"dhcpservertype", "password", "physicalnetworkid", "url", "username"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of addBaremetalDhcp", k)
+		}
+	}
+	return nil
+}
+
 func (p *AddBaremetalDhcpParams) SetDhcpservertype(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -57,6 +79,13 @@ func (p *AddBaremetalDhcpParams) SetDhcpservertype(v string) {
 	return
 }
 
+// SetDhcpservertypeIfUnset sets dhcpservertype to v, unless it is already set
+func (p *AddBaremetalDhcpParams) SetDhcpservertypeIfUnset(v string) {
+	if _, found := p.p["dhcpservertype"]; !found {
+		p.SetDhcpservertype(v)
+	}
+}
+
 func (p *AddBaremetalDhcpParams) SetPassword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -65,6 +94,13 @@ func (p *AddBaremetalDhcpParams) SetPassword(v string) {
 	return
 }
 
+// SetPasswordIfUnset sets password to v, unless it is already set
+func (p *AddBaremetalDhcpParams) SetPasswordIfUnset(v string) {
+	if _, found := p.p["password"]; !found {
+		p.SetPassword(v)
+	}
+}
+
 func (p *AddBaremetalDhcpParams) SetPhysicalnetworkid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -73,6 +109,13 @@ func (p *AddBaremetalDhcpParams) SetPhysicalnetworkid(v string) {
 	return
 }
 
+// SetPhysicalnetworkidIfUnset sets physicalnetworkid to v, unless it is already set
+func (p *AddBaremetalDhcpParams) SetPhysicalnetworkidIfUnset(v string) {
+	if _, found := p.p["physicalnetworkid"]; !found {
+		p.SetPhysicalnetworkid(v)
+	}
+}
+
 func (p *AddBaremetalDhcpParams) SetUrl(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -81,6 +124,13 @@ func (p *AddBaremetalDhcpParams) SetUrl(v string) {
 	return
 }
 
+// SetUrlIfUnset sets url to v, unless it is already set
+func (p *AddBaremetalDhcpParams) SetUrlIfUnset(v string) {
+	if _, found := p.p["url"]; !found {
+		p.SetUrl(v)
+	}
+}
+
 func (p *AddBaremetalDhcpParams) SetUsername(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -89,9 +139,17 @@ func (p *AddBaremetalDhcpParams) SetUsername(v string) {
 	return
 }
 
+// SetUsernameIfUnset sets username to v, unless it is already set
+func (p *AddBaremetalDhcpParams) SetUsernameIfUnset(v string) {
+	if _, found := p.p["username"]; !found {
+		p.SetUsername(v)
+	}
+}
+
 // You should always use this function to get a new AddBaremetalDhcpParams instance,
-// as then you are sure you have configured all required params
-func (s *BaremetalService) NewAddBaremetalDhcpParams(dhcpservertype string, password string, physicalnetworkid string, url string, username string) *AddBaremetalDhcpParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *BaremetalService) NewAddBaremetalDhcpParams(dhcpservertype string, password string, physicalnetworkid string, url string, username string, opts ...func(*AddBaremetalDhcpParams)) *AddBaremetalDhcpParams {
 	p := &AddBaremetalDhcpParams{}
 	p.p = make(map[string]interface{})
 	p.p["dhcpservertype"] = dhcpservertype
@@ -99,12 +157,57 @@ func (s *BaremetalService) NewAddBaremetalDhcpParams(dhcpservertype string, pass
 	p.p["physicalnetworkid"] = physicalnetworkid
 	p.p["url"] = url
 	p.p["username"] = username
+	for _, fn := range opts {
+		fn(p)
+	}
+	return p
+}
+
+// AddBaremetalDhcpOpts contains all params that can be set on a AddBaremetalDhcpParams.
+// Optional params are pointers (or slices and maps) which are only set when not nil.
+type AddBaremetalDhcpOpts struct {
+	Dhcpservertype    string
+	Password          string
+	Physicalnetworkid string
+	Url               string
+	Username          string
+}
+
+// NewAddBaremetalDhcpParamsFromOpts is an alternative for NewAddBaremetalDhcpParams which takes all params using
+// an options struct, as that is easier to read for APIs with many required params
+func (s *BaremetalService) NewAddBaremetalDhcpParamsFromOpts(o AddBaremetalDhcpOpts) *AddBaremetalDhcpParams {
+	p := s.NewAddBaremetalDhcpParams(o.Dhcpservertype, o.Password, o.Physicalnetworkid, o.Url, o.Username)
 	return p
 }
 
 // adds a baremetal dhcp server
-func (s *BaremetalService) AddBaremetalDhcp(p *AddBaremetalDhcpParams) (*AddBaremetalDhcpResponse, error) {
-	resp, err := s.cs.newRequest("addBaremetalDhcp", p.toURLValues())
+func (s *BaremetalService) AddBaremetalDhcp(p *AddBaremetalDhcpParams, opts ...OptionFunc) (*AddBaremetalDhcpResponse, error) {
+	return s.AddBaremetalDhcpWithContext(context.Background(), p, opts...)
+}
+
+// AddBaremetalDhcpWithContext is the same as AddBaremetalDhcp, but the request is cancelled when the context is done
+func (s *BaremetalService) AddBaremetalDhcpWithContext(ctx context.Context, p *AddBaremetalDhcpParams, opts ...OptionFunc) (*AddBaremetalDhcpResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.AddBaremetalDhcpRawWithContext(ctx, p.toURLValues())
+}
+
+// AddBaremetalDhcpRaw is the same as AddBaremetalDhcp, but takes the params as url.Values instead of typed params
+func (s *BaremetalService) AddBaremetalDhcpRaw(v url.Values) (*AddBaremetalDhcpResponse, error) {
+	return s.AddBaremetalDhcpRawWithContext(context.Background(), v)
+}
+
+// AddBaremetalDhcpRawWithContext is the same as AddBaremetalDhcpRaw, but the request is cancelled when the context is done
+func (s *BaremetalService) AddBaremetalDhcpRawWithContext(ctx context.Context, v url.Values) (*AddBaremetalDhcpResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "addBaremetalDhcp", "addbaremetaldhcpresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -116,9 +219,9 @@ func (s *BaremetalService) AddBaremetalDhcp(p *AddBaremetalDhcpParams) (*AddBare
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -150,6 +253,16 @@ type AddBaremetalPxeKickStartServerParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *AddBaremetalPxeKickStartServerParams) Clone() *AddBaremetalPxeKickStartServerParams {
+	c := &AddBaremetalPxeKickStartServerParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *AddBaremetalPxeKickStartServerParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -179,6 +292,16 @@ func (p *AddBaremetalPxeKickStartServerParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *AddBaremetalPxeKickStartServerParams) validate() error {
+	for _, k := range []string{"password", "physicalnetworkid", "pxeservertype", "tftpdir", "url", "username"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of addBaremetalPxeKickStartServer", k)
+		}
+	}
+	return nil
+}
+
 func (p *AddBaremetalPxeKickStartServerParams) SetPassword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -187,6 +310,13 @@ func (p *AddBaremetalPxeKickStartServerParams) SetPassword(v string) {
 	return
 }
 
+// SetPasswordIfUnset sets password to v, unless it is already set
+func (p *AddBaremetalPxeKickStartServerParams) SetPasswordIfUnset(v string) {
+	if _, found := p.p["password"]; !found {
+		p.SetPassword(v)
+	}
+}
+
 func (p *AddBaremetalPxeKickStartServerParams) SetPhysicalnetworkid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -195,6 +325,13 @@ func (p *AddBaremetalPxeKickStartServerParams) SetPhysicalnetworkid(v string) {
 	return
 }
 
+// SetPhysicalnetworkidIfUnset sets physicalnetworkid to v, unless it is already set
+func (p *AddBaremetalPxeKickStartServerParams) SetPhysicalnetworkidIfUnset(v string) {
+	if _, found := p.p["physicalnetworkid"]; !found {
+		p.SetPhysicalnetworkid(v)
+	}
+}
+
 func (p *AddBaremetalPxeKickStartServerParams) SetPodid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -203,6 +340,13 @@ func (p *AddBaremetalPxeKickStartServerParams) SetPodid(v string) {
 	return
 }
 
+// SetPodidIfUnset sets podid to v, unless it is already set
+func (p *AddBaremetalPxeKickStartServerParams) SetPodidIfUnset(v string) {
+	if _, found := p.p["podid"]; !found {
+		p.SetPodid(v)
+	}
+}
+
 func (p *AddBaremetalPxeKickStartServerParams) SetPxeservertype(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -211,6 +355,13 @@ func (p *AddBaremetalPxeKickStartServerParams) SetPxeservertype(v string) {
 	return
 }
 
+// SetPxeservertypeIfUnset sets pxeservertype to v, unless it is already set
+func (p *AddBaremetalPxeKickStartServerParams) SetPxeservertypeIfUnset(v string) {
+	if _, found := p.p["pxeservertype"]; !found {
+		p.SetPxeservertype(v)
+	}
+}
+
 func (p *AddBaremetalPxeKickStartServerParams) SetTftpdir(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -219,6 +370,13 @@ func (p *AddBaremetalPxeKickStartServerParams) SetTftpdir(v string) {
 	return
 }
 
+// SetTftpdirIfUnset sets tftpdir to v, unless it is already set
+func (p *AddBaremetalPxeKickStartServerParams) SetTftpdirIfUnset(v string) {
+	if _, found := p.p["tftpdir"]; !found {
+		p.SetTftpdir(v)
+	}
+}
+
 func (p *AddBaremetalPxeKickStartServerParams) SetUrl(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -227,6 +385,13 @@ func (p *AddBaremetalPxeKickStartServerParams) SetUrl(v string) {
 	return
 }
 
+// SetUrlIfUnset sets url to v, unless it is already set
+func (p *AddBaremetalPxeKickStartServerParams) SetUrlIfUnset(v string) {
+	if _, found := p.p["url"]; !found {
+		p.SetUrl(v)
+	}
+}
+
 func (p *AddBaremetalPxeKickStartServerParams) SetUsername(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -235,9 +400,17 @@ func (p *AddBaremetalPxeKickStartServerParams) SetUsername(v string) {
 	return
 }
 
+// SetUsernameIfUnset sets username to v, unless it is already set
+func (p *AddBaremetalPxeKickStartServerParams) SetUsernameIfUnset(v string) {
+	if _, found := p.p["username"]; !found {
+		p.SetUsername(v)
+	}
+}
+
 // You should always use this function to get a new AddBaremetalPxeKickStartServerParams instance,
-// as then you are sure you have configured all required params
-func (s *BaremetalService) NewAddBaremetalPxeKickStartServerParams(password string, physicalnetworkid string, pxeservertype string, tftpdir string, url string, username string) *AddBaremetalPxeKickStartServerParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *BaremetalService) NewAddBaremetalPxeKickStartServerParams(password string, physicalnetworkid string, pxeservertype string, tftpdir string, url string, username string, opts ...func(*AddBaremetalPxeKickStartServerParams)) *AddBaremetalPxeKickStartServerParams {
 	p := &AddBaremetalPxeKickStartServerParams{}
 	p.p = make(map[string]interface{})
 	p.p["password"] = password
@@ -246,12 +419,62 @@ func (s *BaremetalService) NewAddBaremetalPxeKickStartServerParams(password stri
 	p.p["tftpdir"] = tftpdir
 	p.p["url"] = url
 	p.p["username"] = username
+	for _, fn := range opts {
+		fn(p)
+	}
+	return p
+}
+
+// AddBaremetalPxeKickStartServerOpts contains all params that can be set on a AddBaremetalPxeKickStartServerParams.
+// Optional params are pointers (or slices and maps) which are only set when not nil.
+type AddBaremetalPxeKickStartServerOpts struct {
+	Password          string
+	Physicalnetworkid string
+	Podid             *string
+	Pxeservertype     string
+	Tftpdir           string
+	Url               string
+	Username          string
+}
+
+// NewAddBaremetalPxeKickStartServerParamsFromOpts is an alternative for NewAddBaremetalPxeKickStartServerParams which takes all params using
+// an options struct, as that is easier to read for APIs with many required params
+func (s *BaremetalService) NewAddBaremetalPxeKickStartServerParamsFromOpts(o AddBaremetalPxeKickStartServerOpts) *AddBaremetalPxeKickStartServerParams {
+	p := s.NewAddBaremetalPxeKickStartServerParams(o.Password, o.Physicalnetworkid, o.Pxeservertype, o.Tftpdir, o.Url, o.Username)
+	if o.Podid != nil {
+		p.p["podid"] = *o.Podid
+	}
 	return p
 }
 
 // add a baremetal pxe server
-func (s *BaremetalService) AddBaremetalPxeKickStartServer(p *AddBaremetalPxeKickStartServerParams) (*AddBaremetalPxeKickStartServerResponse, error) {
-	resp, err := s.cs.newRequest("addBaremetalPxeKickStartServer", p.toURLValues())
+func (s *BaremetalService) AddBaremetalPxeKickStartServer(p *AddBaremetalPxeKickStartServerParams, opts ...OptionFunc) (*AddBaremetalPxeKickStartServerResponse, error) {
+	return s.AddBaremetalPxeKickStartServerWithContext(context.Background(), p, opts...)
+}
+
+// AddBaremetalPxeKickStartServerWithContext is the same as AddBaremetalPxeKickStartServer, but the request is cancelled when the context is done
+func (s *BaremetalService) AddBaremetalPxeKickStartServerWithContext(ctx context.Context, p *AddBaremetalPxeKickStartServerParams, opts ...OptionFunc) (*AddBaremetalPxeKickStartServerResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.AddBaremetalPxeKickStartServerRawWithContext(ctx, p.toURLValues())
+}
+
+// AddBaremetalPxeKickStartServerRaw is the same as AddBaremetalPxeKickStartServer, but takes the params as url.Values instead of typed params
+func (s *BaremetalService) AddBaremetalPxeKickStartServerRaw(v url.Values) (*AddBaremetalPxeKickStartServerResponse, error) {
+	return s.AddBaremetalPxeKickStartServerRawWithContext(context.Background(), v)
+}
+
+// AddBaremetalPxeKickStartServerRawWithContext is the same as AddBaremetalPxeKickStartServerRaw, but the request is cancelled when the context is done
+func (s *BaremetalService) AddBaremetalPxeKickStartServerRawWithContext(ctx context.Context, v url.Values) (*AddBaremetalPxeKickStartServerResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "addBaremetalPxeKickStartServer", "addbaremetalpxekickstartserverresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -263,15 +486,15 @@ func (s *BaremetalService) AddBaremetalPxeKickStartServer(p *AddBaremetalPxeKick
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
+		b, err = getRawValueUnlessHas(b, "tftpdir")
 		if err != nil {
 			return nil, err
 		}
@@ -293,6 +516,16 @@ type AddBaremetalPxePingServerParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *AddBaremetalPxePingServerParams) Clone() *AddBaremetalPxePingServerParams {
+	c := &AddBaremetalPxePingServerParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *AddBaremetalPxePingServerParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -334,6 +567,16 @@ func (p *AddBaremetalPxePingServerParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *AddBaremetalPxePingServerParams) validate() error {
+	for _, k := range []string{"password", "physicalnetworkid", "pingdir", "pingstorageserverip", "pxeservertype", "tftpdir", "url", "username"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of addBaremetalPxePingServer", k)
+		}
+	}
+	return nil
+}
+
 func (p *AddBaremetalPxePingServerParams) SetPassword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -342,6 +585,13 @@ func (p *AddBaremetalPxePingServerParams) SetPassword(v string) {
 	return
 }
 
+// SetPasswordIfUnset sets password to v, unless it is already set
+func (p *AddBaremetalPxePingServerParams) SetPasswordIfUnset(v string) {
+	if _, found := p.p["password"]; !found {
+		p.SetPassword(v)
+	}
+}
+
 func (p *AddBaremetalPxePingServerParams) SetPhysicalnetworkid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -350,6 +600,13 @@ func (p *AddBaremetalPxePingServerParams) SetPhysicalnetworkid(v string) {
 	return
 }
 
+// SetPhysicalnetworkidIfUnset sets physicalnetworkid to v, unless it is already set
+func (p *AddBaremetalPxePingServerParams) SetPhysicalnetworkidIfUnset(v string) {
+	if _, found := p.p["physicalnetworkid"]; !found {
+		p.SetPhysicalnetworkid(v)
+	}
+}
+
 func (p *AddBaremetalPxePingServerParams) SetPingcifspassword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -358,6 +615,13 @@ func (p *AddBaremetalPxePingServerParams) SetPingcifspassword(v string) {
 	return
 }
 
+// SetPingcifspasswordIfUnset sets pingcifspassword to v, unless it is already set
+func (p *AddBaremetalPxePingServerParams) SetPingcifspasswordIfUnset(v string) {
+	if _, found := p.p["pingcifspassword"]; !found {
+		p.SetPingcifspassword(v)
+	}
+}
+
 func (p *AddBaremetalPxePingServerParams) SetPingcifsusername(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -366,6 +630,13 @@ func (p *AddBaremetalPxePingServerParams) SetPingcifsusername(v string) {
 	return
 }
 
+// SetPingcifsusernameIfUnset sets pingcifsusername to v, unless it is already set
+func (p *AddBaremetalPxePingServerParams) SetPingcifsusernameIfUnset(v string) {
+	if _, found := p.p["pingcifsusername"]; !found {
+		p.SetPingcifsusername(v)
+	}
+}
+
 func (p *AddBaremetalPxePingServerParams) SetPingdir(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -374,6 +645,13 @@ func (p *AddBaremetalPxePingServerParams) SetPingdir(v string) {
 	return
 }
 
+// SetPingdirIfUnset sets pingdir to v, unless it is already set
+func (p *AddBaremetalPxePingServerParams) SetPingdirIfUnset(v string) {
+	if _, found := p.p["pingdir"]; !found {
+		p.SetPingdir(v)
+	}
+}
+
 func (p *AddBaremetalPxePingServerParams) SetPingstorageserverip(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -382,6 +660,13 @@ func (p *AddBaremetalPxePingServerParams) SetPingstorageserverip(v string) {
 	return
 }
 
+// SetPingstorageserveripIfUnset sets pingstorageserverip to v, unless it is already set
+func (p *AddBaremetalPxePingServerParams) SetPingstorageserveripIfUnset(v string) {
+	if _, found := p.p["pingstorageserverip"]; !found {
+		p.SetPingstorageserverip(v)
+	}
+}
+
 func (p *AddBaremetalPxePingServerParams) SetPodid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -390,6 +675,13 @@ func (p *AddBaremetalPxePingServerParams) SetPodid(v string) {
 	return
 }
 
+// SetPodidIfUnset sets podid to v, unless it is already set
+func (p *AddBaremetalPxePingServerParams) SetPodidIfUnset(v string) {
+	if _, found := p.p["podid"]; !found {
+		p.SetPodid(v)
+	}
+}
+
 func (p *AddBaremetalPxePingServerParams) SetPxeservertype(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -398,6 +690,13 @@ func (p *AddBaremetalPxePingServerParams) SetPxeservertype(v string) {
 	return
 }
 
+// SetPxeservertypeIfUnset sets pxeservertype to v, unless it is already set
+func (p *AddBaremetalPxePingServerParams) SetPxeservertypeIfUnset(v string) {
+	if _, found := p.p["pxeservertype"]; !found {
+		p.SetPxeservertype(v)
+	}
+}
+
 func (p *AddBaremetalPxePingServerParams) SetTftpdir(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -406,6 +705,13 @@ func (p *AddBaremetalPxePingServerParams) SetTftpdir(v string) {
 	return
 }
 
+// SetTftpdirIfUnset sets tftpdir to v, unless it is already set
+func (p *AddBaremetalPxePingServerParams) SetTftpdirIfUnset(v string) {
+	if _, found := p.p["tftpdir"]; !found {
+		p.SetTftpdir(v)
+	}
+}
+
 func (p *AddBaremetalPxePingServerParams) SetUrl(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -414,6 +720,13 @@ func (p *AddBaremetalPxePingServerParams) SetUrl(v string) {
 	return
 }
 
+// SetUrlIfUnset sets url to v, unless it is already set
+func (p *AddBaremetalPxePingServerParams) SetUrlIfUnset(v string) {
+	if _, found := p.p["url"]; !found {
+		p.SetUrl(v)
+	}
+}
+
 func (p *AddBaremetalPxePingServerParams) SetUsername(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -422,9 +735,17 @@ func (p *AddBaremetalPxePingServerParams) SetUsername(v string) {
 	return
 }
 
+// SetUsernameIfUnset sets username to v, unless it is already set
+func (p *AddBaremetalPxePingServerParams) SetUsernameIfUnset(v string) {
+	if _, found := p.p["username"]; !found {
+		p.SetUsername(v)
+	}
+}
+
 // You should always use this function to get a new AddBaremetalPxePingServerParams instance,
-// as then you are sure you have configured all required params
-func (s *BaremetalService) NewAddBaremetalPxePingServerParams(password string, physicalnetworkid string, pingdir string, pingstorageserverip string, pxeservertype string, tftpdir string, url string, username string) *AddBaremetalPxePingServerParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *BaremetalService) NewAddBaremetalPxePingServerParams(password string, physicalnetworkid string, pingdir string, pingstorageserverip string, pxeservertype string, tftpdir string, url string, username string, opts ...func(*AddBaremetalPxePingServerParams)) *AddBaremetalPxePingServerParams {
 	p := &AddBaremetalPxePingServerParams{}
 	p.p = make(map[string]interface{})
 	p.p["password"] = password
@@ -435,12 +756,72 @@ func (s *BaremetalService) NewAddBaremetalPxePingServerParams(password string, p
 	p.p["tftpdir"] = tftpdir
 	p.p["url"] = url
 	p.p["username"] = username
+	for _, fn := range opts {
+		fn(p)
+	}
+	return p
+}
+
+// AddBaremetalPxePingServerOpts contains all params that can be set on a AddBaremetalPxePingServerParams.
+// Optional params are pointers (or slices and maps) which are only set when not nil.
+type AddBaremetalPxePingServerOpts struct {
+	Password            string
+	Physicalnetworkid   string
+	Pingcifspassword    *string
+	Pingcifsusername    *string
+	Pingdir             string
+	Pingstorageserverip string
+	Podid               *string
+	Pxeservertype       string
+	Tftpdir             string
+	Url                 string
+	Username            string
+}
+
+// NewAddBaremetalPxePingServerParamsFromOpts is an alternative for NewAddBaremetalPxePingServerParams which takes all params using
+// an options struct, as that is easier to read for APIs with many required params
+func (s *BaremetalService) NewAddBaremetalPxePingServerParamsFromOpts(o AddBaremetalPxePingServerOpts) *AddBaremetalPxePingServerParams {
+	p := s.NewAddBaremetalPxePingServerParams(o.Password, o.Physicalnetworkid, o.Pingdir, o.Pingstorageserverip, o.Pxeservertype, o.Tftpdir, o.Url, o.Username)
+	if o.Pingcifspassword != nil {
+		p.p["pingcifspassword"] = *o.Pingcifspassword
+	}
+	if o.Pingcifsusername != nil {
+		p.p["pingcifsusername"] = *o.Pingcifsusername
+	}
+	if o.Podid != nil {
+		p.p["podid"] = *o.Podid
+	}
 	return p
 }
 
 // add a baremetal ping pxe server
-func (s *BaremetalService) AddBaremetalPxePingServer(p *AddBaremetalPxePingServerParams) (*AddBaremetalPxePingServerResponse, error) {
-	resp, err := s.cs.newRequest("addBaremetalPxePingServer", p.toURLValues())
+func (s *BaremetalService) AddBaremetalPxePingServer(p *AddBaremetalPxePingServerParams, opts ...OptionFunc) (*AddBaremetalPxePingServerResponse, error) {
+	return s.AddBaremetalPxePingServerWithContext(context.Background(), p, opts...)
+}
+
+// AddBaremetalPxePingServerWithContext is the same as AddBaremetalPxePingServer, but the request is cancelled when the context is done
+func (s *BaremetalService) AddBaremetalPxePingServerWithContext(ctx context.Context, p *AddBaremetalPxePingServerParams, opts ...OptionFunc) (*AddBaremetalPxePingServerResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.AddBaremetalPxePingServerRawWithContext(ctx, p.toURLValues())
+}
+
+// AddBaremetalPxePingServerRaw is the same as AddBaremetalPxePingServer, but takes the params as url.Values instead of typed params
+func (s *BaremetalService) AddBaremetalPxePingServerRaw(v url.Values) (*AddBaremetalPxePingServerResponse, error) {
+	return s.AddBaremetalPxePingServerRawWithContext(context.Background(), v)
+}
+
+// AddBaremetalPxePingServerRawWithContext is the same as AddBaremetalPxePingServerRaw, but the request is cancelled when the context is done
+func (s *BaremetalService) AddBaremetalPxePingServerRawWithContext(ctx context.Context, v url.Values) (*AddBaremetalPxePingServerResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "addBaremetalPxePingServer", "addbaremetalpxepingserverresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -452,9 +833,9 @@ func (s *BaremetalService) AddBaremetalPxePingServer(p *AddBaremetalPxePingServe
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -484,6 +865,16 @@ type AddBaremetalRctParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *AddBaremetalRctParams) Clone() *AddBaremetalRctParams {
+	c := &AddBaremetalRctParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *AddBaremetalRctParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -495,6 +886,16 @@ func (p *AddBaremetalRctParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *AddBaremetalRctParams) validate() error {
+	for _, k := range []string{"baremetalrcturl"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of addBaremetalRct", k)
+		}
+	}
+	return nil
+}
+
 func (p *AddBaremetalRctParams) SetBaremetalrcturl(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -503,18 +904,54 @@ func (p *AddBaremetalRctParams) SetBaremetalrcturl(v string) {
 	return
 }
 
+// SetBaremetalrcturlIfUnset sets baremetalrcturl to v, unless it is already set
+func (p *AddBaremetalRctParams) SetBaremetalrcturlIfUnset(v string) {
+	if _, found := p.p["baremetalrcturl"]; !found {
+		p.SetBaremetalrcturl(v)
+	}
+}
+
 // You should always use this function to get a new AddBaremetalRctParams instance,
-// as then you are sure you have configured all required params
-func (s *BaremetalService) NewAddBaremetalRctParams(baremetalrcturl string) *AddBaremetalRctParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *BaremetalService) NewAddBaremetalRctParams(baremetalrcturl string, opts ...func(*AddBaremetalRctParams)) *AddBaremetalRctParams {
 	p := &AddBaremetalRctParams{}
 	p.p = make(map[string]interface{})
 	p.p["baremetalrcturl"] = baremetalrcturl
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // adds baremetal rack configuration text
-func (s *BaremetalService) AddBaremetalRct(p *AddBaremetalRctParams) (*AddBaremetalRctResponse, error) {
-	resp, err := s.cs.newRequest("addBaremetalRct", p.toURLValues())
+func (s *BaremetalService) AddBaremetalRct(p *AddBaremetalRctParams, opts ...OptionFunc) (*AddBaremetalRctResponse, error) {
+	return s.AddBaremetalRctWithContext(context.Background(), p, opts...)
+}
+
+// AddBaremetalRctWithContext is the same as AddBaremetalRct, but the request is cancelled when the context is done
+func (s *BaremetalService) AddBaremetalRctWithContext(ctx context.Context, p *AddBaremetalRctParams, opts ...OptionFunc) (*AddBaremetalRctResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.AddBaremetalRctRawWithContext(ctx, p.toURLValues())
+}
+
+// AddBaremetalRctRaw is the same as AddBaremetalRct, but takes the params as url.Values instead of typed params
+func (s *BaremetalService) AddBaremetalRctRaw(v url.Values) (*AddBaremetalRctResponse, error) {
+	return s.AddBaremetalRctRawWithContext(context.Background(), v)
+}
+
+// AddBaremetalRctRawWithContext is the same as AddBaremetalRctRaw, but the request is cancelled when the context is done
+func (s *BaremetalService) AddBaremetalRctRawWithContext(ctx context.Context, v url.Values) (*AddBaremetalRctResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "addBaremetalRct", "addbaremetalrctresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -526,9 +963,9 @@ func (s *BaremetalService) AddBaremetalRct(p *AddBaremetalRctParams) (*AddBareme
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -557,6 +994,16 @@ type DeleteBaremetalRctParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *DeleteBaremetalRctParams) Clone() *DeleteBaremetalRctParams {
+	c := &DeleteBaremetalRctParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeleteBaremetalRctParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -568,6 +1015,16 @@ func (p *DeleteBaremetalRctParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *DeleteBaremetalRctParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of deleteBaremetalRct", k)
+		}
+	}
+	return nil
+}
+
 func (p *DeleteBaremetalRctParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -576,18 +1033,54 @@ func (p *DeleteBaremetalRctParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *DeleteBaremetalRctParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 // You should always use this function to get a new DeleteBaremetalRctParams instance,
-// as then you are sure you have configured all required params
-func (s *BaremetalService) NewDeleteBaremetalRctParams(id string) *DeleteBaremetalRctParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *BaremetalService) NewDeleteBaremetalRctParams(id string, opts ...func(*DeleteBaremetalRctParams)) *DeleteBaremetalRctParams {
 	p := &DeleteBaremetalRctParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // deletes baremetal rack configuration text
-func (s *BaremetalService) DeleteBaremetalRct(p *DeleteBaremetalRctParams) (*DeleteBaremetalRctResponse, error) {
-	resp, err := s.cs.newRequest("deleteBaremetalRct", p.toURLValues())
+func (s *BaremetalService) DeleteBaremetalRct(p *DeleteBaremetalRctParams, opts ...OptionFunc) (*DeleteBaremetalRctResponse, error) {
+	return s.DeleteBaremetalRctWithContext(context.Background(), p, opts...)
+}
+
+// DeleteBaremetalRctWithContext is the same as DeleteBaremetalRct, but the request is cancelled when the context is done
+func (s *BaremetalService) DeleteBaremetalRctWithContext(ctx context.Context, p *DeleteBaremetalRctParams, opts ...OptionFunc) (*DeleteBaremetalRctResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.DeleteBaremetalRctRawWithContext(ctx, p.toURLValues())
+}
+
+// DeleteBaremetalRctRaw is the same as DeleteBaremetalRct, but takes the params as url.Values instead of typed params
+func (s *BaremetalService) DeleteBaremetalRctRaw(v url.Values) (*DeleteBaremetalRctResponse, error) {
+	return s.DeleteBaremetalRctRawWithContext(context.Background(), v)
+}
+
+// DeleteBaremetalRctRawWithContext is the same as DeleteBaremetalRctRaw, but the request is cancelled when the context is done
+func (s *BaremetalService) DeleteBaremetalRctRawWithContext(ctx context.Context, v url.Values) (*DeleteBaremetalRctResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteBaremetalRct", "deletebaremetalrctresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -599,9 +1092,9 @@ func (s *BaremetalService) DeleteBaremetalRct(p *DeleteBaremetalRctParams) (*Del
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -625,6 +1118,16 @@ type ListBaremetalDhcpParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ListBaremetalDhcpParams) Clone() *ListBaremetalDhcpParams {
+	c := &ListBaremetalDhcpParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListBaremetalDhcpParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -654,6 +1157,16 @@ func (p *ListBaremetalDhcpParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *ListBaremetalDhcpParams) validate() error {
+	for _, k := range []string{"physicalnetworkid"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of listBaremetalDhcp", k)
+		}
+	}
+	return nil
+}
+
 func (p *ListBaremetalDhcpParams) SetDhcpservertype(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -662,6 +1175,13 @@ func (p *ListBaremetalDhcpParams) SetDhcpservertype(v string) {
 	return
 }
 
+// SetDhcpservertypeIfUnset sets dhcpservertype to v, unless it is already set
+func (p *ListBaremetalDhcpParams) SetDhcpservertypeIfUnset(v string) {
+	if _, found := p.p["dhcpservertype"]; !found {
+		p.SetDhcpservertype(v)
+	}
+}
+
 func (p *ListBaremetalDhcpParams) SetId(v int64) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -670,6 +1190,13 @@ func (p *ListBaremetalDhcpParams) SetId(v int64) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *ListBaremetalDhcpParams) SetIdIfUnset(v int64) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 func (p *ListBaremetalDhcpParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -678,6 +1205,13 @@ func (p *ListBaremetalDhcpParams) SetKeyword(v string) {
 	return
 }
 
+// SetKeywordIfUnset sets keyword to v, unless it is already set
+func (p *ListBaremetalDhcpParams) SetKeywordIfUnset(v string) {
+	if _, found := p.p["keyword"]; !found {
+		p.SetKeyword(v)
+	}
+}
+
 func (p *ListBaremetalDhcpParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -686,6 +1220,13 @@ func (p *ListBaremetalDhcpParams) SetPage(v int) {
 	return
 }
 
+// SetPageIfUnset sets page to v, unless it is already set
+func (p *ListBaremetalDhcpParams) SetPageIfUnset(v int) {
+	if _, found := p.p["page"]; !found {
+		p.SetPage(v)
+	}
+}
+
 func (p *ListBaremetalDhcpParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -694,6 +1235,13 @@ func (p *ListBaremetalDhcpParams) SetPagesize(v int) {
 	return
 }
 
+// SetPagesizeIfUnset sets pagesize to v, unless it is already set
+func (p *ListBaremetalDhcpParams) SetPagesizeIfUnset(v int) {
+	if _, found := p.p["pagesize"]; !found {
+		p.SetPagesize(v)
+	}
+}
+
 func (p *ListBaremetalDhcpParams) SetPhysicalnetworkid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -702,18 +1250,48 @@ func (p *ListBaremetalDhcpParams) SetPhysicalnetworkid(v string) {
 	return
 }
 
+// SetPhysicalnetworkidIfUnset sets physicalnetworkid to v, unless it is already set
+func (p *ListBaremetalDhcpParams) SetPhysicalnetworkidIfUnset(v string) {
+	if _, found := p.p["physicalnetworkid"]; !found {
+		p.SetPhysicalnetworkid(v)
+	}
+}
+
 // You should always use this function to get a new ListBaremetalDhcpParams instance,
-// as then you are sure you have configured all required params
-func (s *BaremetalService) NewListBaremetalDhcpParams(physicalnetworkid string) *ListBaremetalDhcpParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *BaremetalService) NewListBaremetalDhcpParams(physicalnetworkid string, opts ...func(*ListBaremetalDhcpParams)) *ListBaremetalDhcpParams {
 	p := &ListBaremetalDhcpParams{}
 	p.p = make(map[string]interface{})
 	p.p["physicalnetworkid"] = physicalnetworkid
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // list baremetal dhcp servers
 func (s *BaremetalService) ListBaremetalDhcp(p *ListBaremetalDhcpParams) (*ListBaremetalDhcpResponse, error) {
-	resp, err := s.cs.newRequest("listBaremetalDhcp", p.toURLValues())
+	return s.ListBaremetalDhcpWithContext(context.Background(), p)
+}
+
+// ListBaremetalDhcpWithContext is the same as ListBaremetalDhcp, but the request is cancelled when the context is done
+func (s *BaremetalService) ListBaremetalDhcpWithContext(ctx context.Context, p *ListBaremetalDhcpParams) (*ListBaremetalDhcpResponse, error) {
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.ListBaremetalDhcpRawWithContext(ctx, p.toURLValues())
+}
+
+// ListBaremetalDhcpRaw is the same as ListBaremetalDhcp, but takes the params as url.Values instead of typed params
+func (s *BaremetalService) ListBaremetalDhcpRaw(v url.Values) (*ListBaremetalDhcpResponse, error) {
+	return s.ListBaremetalDhcpRawWithContext(context.Background(), v)
+}
+
+// ListBaremetalDhcpRawWithContext is the same as ListBaremetalDhcpRaw, but the request is cancelled when the context is done
+func (s *BaremetalService) ListBaremetalDhcpRawWithContext(ctx context.Context, v url.Values) (*ListBaremetalDhcpResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "listBaremetalDhcp", "listbaremetaldhcpresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -726,6 +1304,93 @@ func (s *BaremetalService) ListBaremetalDhcp(p *ListBaremetalDhcpParams) (*ListB
 	return &r, nil
 }
 
+// StreamBaremetalDhcp fetches the results of ListBaremetalDhcp page by page in the background and sends them on the
+// returned channel. Both returned channels are closed when done. If fetching a page fails, or the context
+// is done before all results are sent, the error is sent on the error channel and fetching stops. A
+// request for a page in flight is cancelled when the context is done. The page and pagesize set in the
+// params are ignored.
+func (s *BaremetalService) StreamBaremetalDhcp(ctx context.Context, p *ListBaremetalDhcpParams) (<-chan *BaremetalDhcp, <-chan error) {
+	ch := make(chan *BaremetalDhcp)
+	errs := make(chan error, 1)
+
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesize(500)
+
+	go func() {
+		defer close(errs)
+		defer close(ch)
+
+		for page, count := 1, 0; ; page++ {
+			pp.SetPage(page)
+
+			l, err := s.ListBaremetalDhcpWithContext(ctx, pp)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			for _, v := range l.BaremetalDhcp {
+				select {
+				case ch <- v:
+				case <-ctx.Done():
+					errs <- ctx.Err()
+					return
+				}
+			}
+
+			count += len(l.BaremetalDhcp)
+			if len(l.BaremetalDhcp) < 500 || count >= l.Count {
+				return
+			}
+		}
+	}()
+
+	return ch, errs
+}
+
+// ListBaremetalDhcpWhere returns all results of ListBaremetalDhcp for which pred returns true. Note that the results are
+// filtered client-side, so all results matching the params are fetched first.
+// All pages are fetched, so the page and pagesize set in the params are ignored.
+func (s *BaremetalService) ListBaremetalDhcpWhere(p *ListBaremetalDhcpParams, pred func(*BaremetalDhcp) bool) ([]*BaremetalDhcp, error) {
+	var r []*BaremetalDhcp
+	ch, errs := s.StreamBaremetalDhcp(context.Background(), p)
+	for v := range ch {
+		if pred(v) {
+			r = append(r, v)
+		}
+	}
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ListAllBaremetalDhcp returns the results of ListBaremetalDhcp across all pages, by calling ListBaremetalDhcp page by page until all
+// results are fetched. The pagesize set in the params is used for every page (500 if not set), and the
+// page set in the params is ignored.
+func (s *BaremetalService) ListAllBaremetalDhcp(p *ListBaremetalDhcpParams) ([]*BaremetalDhcp, error) {
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesizeIfUnset(500)
+	pagesize := pp.p["pagesize"].(int)
+
+	var r []*BaremetalDhcp
+	for page := 1; ; page++ {
+		pp.SetPage(page)
+
+		l, err := s.ListBaremetalDhcp(pp)
+		if err != nil {
+			return nil, err
+		}
+		r = append(r, l.BaremetalDhcp...)
+
+		if len(l.BaremetalDhcp) < pagesize || len(r) >= l.Count {
+			return r, nil
+		}
+	}
+}
+
 type ListBaremetalDhcpResponse struct {
 	Count         int              `json:"count"`
 	BaremetalDhcp []*BaremetalDhcp `json:"baremetaldhcp"`
@@ -743,6 +1408,16 @@ type ListBaremetalPxeServersParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ListBaremetalPxeServersParams) Clone() *ListBaremetalPxeServersParams {
+	c := &ListBaremetalPxeServersParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListBaremetalPxeServersParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -769,6 +1444,16 @@ func (p *ListBaremetalPxeServersParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *ListBaremetalPxeServersParams) validate() error {
+	for _, k := range []string{"physicalnetworkid"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of listBaremetalPxeServers", k)
+		}
+	}
+	return nil
+}
+
 func (p *ListBaremetalPxeServersParams) SetId(v int64) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -777,6 +1462,13 @@ func (p *ListBaremetalPxeServersParams) SetId(v int64) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *ListBaremetalPxeServersParams) SetIdIfUnset(v int64) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 func (p *ListBaremetalPxeServersParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -785,6 +1477,13 @@ func (p *ListBaremetalPxeServersParams) SetKeyword(v string) {
 	return
 }
 
+// SetKeywordIfUnset sets keyword to v, unless it is already set
+func (p *ListBaremetalPxeServersParams) SetKeywordIfUnset(v string) {
+	if _, found := p.p["keyword"]; !found {
+		p.SetKeyword(v)
+	}
+}
+
 func (p *ListBaremetalPxeServersParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -793,6 +1492,13 @@ func (p *ListBaremetalPxeServersParams) SetPage(v int) {
 	return
 }
 
+// SetPageIfUnset sets page to v, unless it is already set
+func (p *ListBaremetalPxeServersParams) SetPageIfUnset(v int) {
+	if _, found := p.p["page"]; !found {
+		p.SetPage(v)
+	}
+}
+
 func (p *ListBaremetalPxeServersParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -801,6 +1507,13 @@ func (p *ListBaremetalPxeServersParams) SetPagesize(v int) {
 	return
 }
 
+// SetPagesizeIfUnset sets pagesize to v, unless it is already set
+func (p *ListBaremetalPxeServersParams) SetPagesizeIfUnset(v int) {
+	if _, found := p.p["pagesize"]; !found {
+		p.SetPagesize(v)
+	}
+}
+
 func (p *ListBaremetalPxeServersParams) SetPhysicalnetworkid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -809,18 +1522,48 @@ func (p *ListBaremetalPxeServersParams) SetPhysicalnetworkid(v string) {
 	return
 }
 
+// SetPhysicalnetworkidIfUnset sets physicalnetworkid to v, unless it is already set
+func (p *ListBaremetalPxeServersParams) SetPhysicalnetworkidIfUnset(v string) {
+	if _, found := p.p["physicalnetworkid"]; !found {
+		p.SetPhysicalnetworkid(v)
+	}
+}
+
 // You should always use this function to get a new ListBaremetalPxeServersParams instance,
-// as then you are sure you have configured all required params
-func (s *BaremetalService) NewListBaremetalPxeServersParams(physicalnetworkid string) *ListBaremetalPxeServersParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *BaremetalService) NewListBaremetalPxeServersParams(physicalnetworkid string, opts ...func(*ListBaremetalPxeServersParams)) *ListBaremetalPxeServersParams {
 	p := &ListBaremetalPxeServersParams{}
 	p.p = make(map[string]interface{})
 	p.p["physicalnetworkid"] = physicalnetworkid
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // list baremetal pxe server
 func (s *BaremetalService) ListBaremetalPxeServers(p *ListBaremetalPxeServersParams) (*ListBaremetalPxeServersResponse, error) {
-	resp, err := s.cs.newRequest("listBaremetalPxeServers", p.toURLValues())
+	return s.ListBaremetalPxeServersWithContext(context.Background(), p)
+}
+
+// ListBaremetalPxeServersWithContext is the same as ListBaremetalPxeServers, but the request is cancelled when the context is done
+func (s *BaremetalService) ListBaremetalPxeServersWithContext(ctx context.Context, p *ListBaremetalPxeServersParams) (*ListBaremetalPxeServersResponse, error) {
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.ListBaremetalPxeServersRawWithContext(ctx, p.toURLValues())
+}
+
+// ListBaremetalPxeServersRaw is the same as ListBaremetalPxeServers, but takes the params as url.Values instead of typed params
+func (s *BaremetalService) ListBaremetalPxeServersRaw(v url.Values) (*ListBaremetalPxeServersResponse, error) {
+	return s.ListBaremetalPxeServersRawWithContext(context.Background(), v)
+}
+
+// ListBaremetalPxeServersRawWithContext is the same as ListBaremetalPxeServersRaw, but the request is cancelled when the context is done
+func (s *BaremetalService) ListBaremetalPxeServersRawWithContext(ctx context.Context, v url.Values) (*ListBaremetalPxeServersResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "listBaremetalPxeServers", "listbaremetalpxeserversresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -833,6 +1576,93 @@ func (s *BaremetalService) ListBaremetalPxeServers(p *ListBaremetalPxeServersPar
 	return &r, nil
 }
 
+// StreamBaremetalPxeServers fetches the results of ListBaremetalPxeServers page by page in the background and sends them on the
+// returned channel. Both returned channels are closed when done. If fetching a page fails, or the context
+// is done before all results are sent, the error is sent on the error channel and fetching stops. A
+// request for a page in flight is cancelled when the context is done. The page and pagesize set in the
+// params are ignored.
+func (s *BaremetalService) StreamBaremetalPxeServers(ctx context.Context, p *ListBaremetalPxeServersParams) (<-chan *BaremetalPxeServer, <-chan error) {
+	ch := make(chan *BaremetalPxeServer)
+	errs := make(chan error, 1)
+
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesize(500)
+
+	go func() {
+		defer close(errs)
+		defer close(ch)
+
+		for page, count := 1, 0; ; page++ {
+			pp.SetPage(page)
+
+			l, err := s.ListBaremetalPxeServersWithContext(ctx, pp)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			for _, v := range l.BaremetalPxeServers {
+				select {
+				case ch <- v:
+				case <-ctx.Done():
+					errs <- ctx.Err()
+					return
+				}
+			}
+
+			count += len(l.BaremetalPxeServers)
+			if len(l.BaremetalPxeServers) < 500 || count >= l.Count {
+				return
+			}
+		}
+	}()
+
+	return ch, errs
+}
+
+// ListBaremetalPxeServersWhere returns all results of ListBaremetalPxeServers for which pred returns true. Note that the results are
+// filtered client-side, so all results matching the params are fetched first.
+// All pages are fetched, so the page and pagesize set in the params are ignored.
+func (s *BaremetalService) ListBaremetalPxeServersWhere(p *ListBaremetalPxeServersParams, pred func(*BaremetalPxeServer) bool) ([]*BaremetalPxeServer, error) {
+	var r []*BaremetalPxeServer
+	ch, errs := s.StreamBaremetalPxeServers(context.Background(), p)
+	for v := range ch {
+		if pred(v) {
+			r = append(r, v)
+		}
+	}
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ListAllBaremetalPxeServers returns the results of ListBaremetalPxeServers across all pages, by calling ListBaremetalPxeServers page by page until all
+// results are fetched. The pagesize set in the params is used for every page (500 if not set), and the
+// page set in the params is ignored.
+func (s *BaremetalService) ListAllBaremetalPxeServers(p *ListBaremetalPxeServersParams) ([]*BaremetalPxeServer, error) {
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesizeIfUnset(500)
+	pagesize := pp.p["pagesize"].(int)
+
+	var r []*BaremetalPxeServer
+	for page := 1; ; page++ {
+		pp.SetPage(page)
+
+		l, err := s.ListBaremetalPxeServers(pp)
+		if err != nil {
+			return nil, err
+		}
+		r = append(r, l.BaremetalPxeServers...)
+
+		if len(l.BaremetalPxeServers) < pagesize || len(r) >= l.Count {
+			return r, nil
+		}
+	}
+}
+
 type ListBaremetalPxeServersResponse struct {
 	Count               int                   `json:"count"`
 	BaremetalPxeServers []*BaremetalPxeServer `json:"baremetalpxeserver"`
@@ -849,6 +1679,16 @@ type ListBaremetalRctParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ListBaremetalRctParams) Clone() *ListBaremetalRctParams {
+	c := &ListBaremetalRctParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListBaremetalRctParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -876,6 +1716,13 @@ func (p *ListBaremetalRctParams) SetKeyword(v string) {
 	return
 }
 
+// SetKeywordIfUnset sets keyword to v, unless it is already set
+func (p *ListBaremetalRctParams) SetKeywordIfUnset(v string) {
+	if _, found := p.p["keyword"]; !found {
+		p.SetKeyword(v)
+	}
+}
+
 func (p *ListBaremetalRctParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -884,6 +1731,13 @@ func (p *ListBaremetalRctParams) SetPage(v int) {
 	return
 }
 
+// SetPageIfUnset sets page to v, unless it is already set
+func (p *ListBaremetalRctParams) SetPageIfUnset(v int) {
+	if _, found := p.p["page"]; !found {
+		p.SetPage(v)
+	}
+}
+
 func (p *ListBaremetalRctParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -892,17 +1746,43 @@ func (p *ListBaremetalRctParams) SetPagesize(v int) {
 	return
 }
 
+// SetPagesizeIfUnset sets pagesize to v, unless it is already set
+func (p *ListBaremetalRctParams) SetPagesizeIfUnset(v int) {
+	if _, found := p.p["pagesize"]; !found {
+		p.SetPagesize(v)
+	}
+}
+
 // You should always use this function to get a new ListBaremetalRctParams instance,
-// as then you are sure you have configured all required params
-func (s *BaremetalService) NewListBaremetalRctParams() *ListBaremetalRctParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *BaremetalService) NewListBaremetalRctParams(opts ...func(*ListBaremetalRctParams)) *ListBaremetalRctParams {
 	p := &ListBaremetalRctParams{}
 	p.p = make(map[string]interface{})
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // list baremetal rack configuration
 func (s *BaremetalService) ListBaremetalRct(p *ListBaremetalRctParams) (*ListBaremetalRctResponse, error) {
-	resp, err := s.cs.newRequest("listBaremetalRct", p.toURLValues())
+	return s.ListBaremetalRctWithContext(context.Background(), p)
+}
+
+// ListBaremetalRctWithContext is the same as ListBaremetalRct, but the request is cancelled when the context is done
+func (s *BaremetalService) ListBaremetalRctWithContext(ctx context.Context, p *ListBaremetalRctParams) (*ListBaremetalRctResponse, error) {
+	return s.ListBaremetalRctRawWithContext(ctx, p.toURLValues())
+}
+
+// ListBaremetalRctRaw is the same as ListBaremetalRct, but takes the params as url.Values instead of typed params
+func (s *BaremetalService) ListBaremetalRctRaw(v url.Values) (*ListBaremetalRctResponse, error) {
+	return s.ListBaremetalRctRawWithContext(context.Background(), v)
+}
+
+// ListBaremetalRctRawWithContext is the same as ListBaremetalRctRaw, but the request is cancelled when the context is done
+func (s *BaremetalService) ListBaremetalRctRawWithContext(ctx context.Context, v url.Values) (*ListBaremetalRctResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "listBaremetalRct", "listbaremetalrctresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -915,6 +1795,93 @@ func (s *BaremetalService) ListBaremetalRct(p *ListBaremetalRctParams) (*ListBar
 	return &r, nil
 }
 
+// StreamBaremetalRct fetches the results of ListBaremetalRct page by page in the background and sends them on the
+// returned channel. Both returned channels are closed when done. If fetching a page fails, or the context
+// is done before all results are sent, the error is sent on the error channel and fetching stops. A
+// request for a page in flight is cancelled when the context is done. The page and pagesize set in the
+// params are ignored.
+func (s *BaremetalService) StreamBaremetalRct(ctx context.Context, p *ListBaremetalRctParams) (<-chan *BaremetalRct, <-chan error) {
+	ch := make(chan *BaremetalRct)
+	errs := make(chan error, 1)
+
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesize(500)
+
+	go func() {
+		defer close(errs)
+		defer close(ch)
+
+		for page, count := 1, 0; ; page++ {
+			pp.SetPage(page)
+
+			l, err := s.ListBaremetalRctWithContext(ctx, pp)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			for _, v := range l.BaremetalRct {
+				select {
+				case ch <- v:
+				case <-ctx.Done():
+					errs <- ctx.Err()
+					return
+				}
+			}
+
+			count += len(l.BaremetalRct)
+			if len(l.BaremetalRct) < 500 || count >= l.Count {
+				return
+			}
+		}
+	}()
+
+	return ch, errs
+}
+
+// ListBaremetalRctWhere returns all results of ListBaremetalRct for which pred returns true. Note that the results are
+// filtered client-side, so all results matching the params are fetched first.
+// All pages are fetched, so the page and pagesize set in the params are ignored.
+func (s *BaremetalService) ListBaremetalRctWhere(p *ListBaremetalRctParams, pred func(*BaremetalRct) bool) ([]*BaremetalRct, error) {
+	var r []*BaremetalRct
+	ch, errs := s.StreamBaremetalRct(context.Background(), p)
+	for v := range ch {
+		if pred(v) {
+			r = append(r, v)
+		}
+	}
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ListAllBaremetalRct returns the results of ListBaremetalRct across all pages, by calling ListBaremetalRct page by page until all
+// results are fetched. The pagesize set in the params is used for every page (500 if not set), and the
+// page set in the params is ignored.
+func (s *BaremetalService) ListAllBaremetalRct(p *ListBaremetalRctParams) ([]*BaremetalRct, error) {
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesizeIfUnset(500)
+	pagesize := pp.p["pagesize"].(int)
+
+	var r []*BaremetalRct
+	for page := 1; ; page++ {
+		pp.SetPage(page)
+
+		l, err := s.ListBaremetalRct(pp)
+		if err != nil {
+			return nil, err
+		}
+		r = append(r, l.BaremetalRct...)
+
+		if len(l.BaremetalRct) < pagesize || len(r) >= l.Count {
+			return r, nil
+		}
+	}
+}
+
 type ListBaremetalRctResponse struct {
 	Count        int             `json:"count"`
 	BaremetalRct []*BaremetalRct `json:"baremetalrct"`
@@ -929,6 +1896,16 @@ type NotifyBaremetalProvisionDoneParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *NotifyBaremetalProvisionDoneParams) Clone() *NotifyBaremetalProvisionDoneParams {
+	c := &NotifyBaremetalProvisionDoneParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *NotifyBaremetalProvisionDoneParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -940,6 +1917,16 @@ func (p *NotifyBaremetalProvisionDoneParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *NotifyBaremetalProvisionDoneParams) validate() error {
+	for _, k := range []string{"mac"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of notifyBaremetalProvisionDone", k)
+		}
+	}
+	return nil
+}
+
 func (p *NotifyBaremetalProvisionDoneParams) SetMac(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -948,18 +1935,54 @@ func (p *NotifyBaremetalProvisionDoneParams) SetMac(v string) {
 	return
 }
 
+// SetMacIfUnset sets mac to v, unless it is already set
+func (p *NotifyBaremetalProvisionDoneParams) SetMacIfUnset(v string) {
+	if _, found := p.p["mac"]; !found {
+		p.SetMac(v)
+	}
+}
+
 // You should always use this function to get a new NotifyBaremetalProvisionDoneParams instance,
-// as then you are sure you have configured all required params
-func (s *BaremetalService) NewNotifyBaremetalProvisionDoneParams(mac string) *NotifyBaremetalProvisionDoneParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *BaremetalService) NewNotifyBaremetalProvisionDoneParams(mac string, opts ...func(*NotifyBaremetalProvisionDoneParams)) *NotifyBaremetalProvisionDoneParams {
 	p := &NotifyBaremetalProvisionDoneParams{}
 	p.p = make(map[string]interface{})
 	p.p["mac"] = mac
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Notify provision has been done on a host. This api is for baremetal virtual router service, not for end user
-func (s *BaremetalService) NotifyBaremetalProvisionDone(p *NotifyBaremetalProvisionDoneParams) (*NotifyBaremetalProvisionDoneResponse, error) {
-	resp, err := s.cs.newRequest("notifyBaremetalProvisionDone", p.toURLValues())
+func (s *BaremetalService) NotifyBaremetalProvisionDone(p *NotifyBaremetalProvisionDoneParams, opts ...OptionFunc) (*NotifyBaremetalProvisionDoneResponse, error) {
+	return s.NotifyBaremetalProvisionDoneWithContext(context.Background(), p, opts...)
+}
+
+// NotifyBaremetalProvisionDoneWithContext is the same as NotifyBaremetalProvisionDone, but the request is cancelled when the context is done
+func (s *BaremetalService) NotifyBaremetalProvisionDoneWithContext(ctx context.Context, p *NotifyBaremetalProvisionDoneParams, opts ...OptionFunc) (*NotifyBaremetalProvisionDoneResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.NotifyBaremetalProvisionDoneRawWithContext(ctx, p.toURLValues())
+}
+
+// NotifyBaremetalProvisionDoneRaw is the same as NotifyBaremetalProvisionDone, but takes the params as url.Values instead of typed params
+func (s *BaremetalService) NotifyBaremetalProvisionDoneRaw(v url.Values) (*NotifyBaremetalProvisionDoneResponse, error) {
+	return s.NotifyBaremetalProvisionDoneRawWithContext(context.Background(), v)
+}
+
+// NotifyBaremetalProvisionDoneRawWithContext is the same as NotifyBaremetalProvisionDoneRaw, but the request is cancelled when the context is done
+func (s *BaremetalService) NotifyBaremetalProvisionDoneRawWithContext(ctx context.Context, v url.Values) (*NotifyBaremetalProvisionDoneResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "notifyBaremetalProvisionDone", "notifybaremetalprovisiondoneresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -971,9 +1994,9 @@ func (s *BaremetalService) NotifyBaremetalProvisionDone(p *NotifyBaremetalProvis
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
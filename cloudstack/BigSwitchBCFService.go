@@ -17,7 +17,9 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
 	"net/url"
 	"strconv"
 )
@@ -26,6 +28,16 @@ type AddBigSwitchBcfDeviceParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *AddBigSwitchBcfDeviceParams) Clone() *AddBigSwitchBcfDeviceParams {
+	c := &AddBigSwitchBcfDeviceParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *AddBigSwitchBcfDeviceParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -50,6 +62,16 @@ func (p *AddBigSwitchBcfDeviceParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *AddBigSwitchBcfDeviceParams) validate() error {
+	for _, k := range []string{"hostname", "nat", "password", "physicalnetworkid", "username"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of addBigSwitchBcfDevice", k)
+		}
+	}
+	return nil
+}
+
 func (p *AddBigSwitchBcfDeviceParams) SetHostname(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -58,6 +80,13 @@ func (p *AddBigSwitchBcfDeviceParams) SetHostname(v string) {
 	return
 }
 
+// SetHostnameIfUnset sets hostname to v, unless it is already set
+func (p *AddBigSwitchBcfDeviceParams) SetHostnameIfUnset(v string) {
+	if _, found := p.p["hostname"]; !found {
+		p.SetHostname(v)
+	}
+}
+
 func (p *AddBigSwitchBcfDeviceParams) SetNat(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -66,6 +95,13 @@ func (p *AddBigSwitchBcfDeviceParams) SetNat(v bool) {
 	return
 }
 
+// SetNatIfUnset sets nat to v, unless it is already set
+func (p *AddBigSwitchBcfDeviceParams) SetNatIfUnset(v bool) {
+	if _, found := p.p["nat"]; !found {
+		p.SetNat(v)
+	}
+}
+
 func (p *AddBigSwitchBcfDeviceParams) SetPassword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -74,6 +110,13 @@ func (p *AddBigSwitchBcfDeviceParams) SetPassword(v string) {
 	return
 }
 
+// SetPasswordIfUnset sets password to v, unless it is already set
+func (p *AddBigSwitchBcfDeviceParams) SetPasswordIfUnset(v string) {
+	if _, found := p.p["password"]; !found {
+		p.SetPassword(v)
+	}
+}
+
 func (p *AddBigSwitchBcfDeviceParams) SetPhysicalnetworkid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -82,6 +125,13 @@ func (p *AddBigSwitchBcfDeviceParams) SetPhysicalnetworkid(v string) {
 	return
 }
 
+// SetPhysicalnetworkidIfUnset sets physicalnetworkid to v, unless it is already set
+func (p *AddBigSwitchBcfDeviceParams) SetPhysicalnetworkidIfUnset(v string) {
+	if _, found := p.p["physicalnetworkid"]; !found {
+		p.SetPhysicalnetworkid(v)
+	}
+}
+
 func (p *AddBigSwitchBcfDeviceParams) SetUsername(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -90,9 +140,17 @@ func (p *AddBigSwitchBcfDeviceParams) SetUsername(v string) {
 	return
 }
 
+// SetUsernameIfUnset sets username to v, unless it is already set
+func (p *AddBigSwitchBcfDeviceParams) SetUsernameIfUnset(v string) {
+	if _, found := p.p["username"]; !found {
+		p.SetUsername(v)
+	}
+}
+
 // You should always use this function to get a new AddBigSwitchBcfDeviceParams instance,
-// as then you are sure you have configured all required params
-func (s *BigSwitchBCFService) NewAddBigSwitchBcfDeviceParams(hostname string, nat bool, password string, physicalnetworkid string, username string) *AddBigSwitchBcfDeviceParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *BigSwitchBCFService) NewAddBigSwitchBcfDeviceParams(hostname string, nat bool, password string, physicalnetworkid string, username string, opts ...func(*AddBigSwitchBcfDeviceParams)) *AddBigSwitchBcfDeviceParams {
 	p := &AddBigSwitchBcfDeviceParams{}
 	p.p = make(map[string]interface{})
 	p.p["hostname"] = hostname
@@ -100,12 +158,57 @@ func (s *BigSwitchBCFService) NewAddBigSwitchBcfDeviceParams(hostname string, na
 	p.p["password"] = password
 	p.p["physicalnetworkid"] = physicalnetworkid
 	p.p["username"] = username
+	for _, fn := range opts {
+		fn(p)
+	}
+	return p
+}
+
+// AddBigSwitchBcfDeviceOpts contains all params that can be set on a AddBigSwitchBcfDeviceParams.
+// Optional params are pointers (or slices and maps) which are only set when not nil.
+type AddBigSwitchBcfDeviceOpts struct {
+	Hostname          string
+	Nat               bool
+	Password          string
+	Physicalnetworkid string
+	Username          string
+}
+
+// NewAddBigSwitchBcfDeviceParamsFromOpts is an alternative for NewAddBigSwitchBcfDeviceParams which takes all params using
+// an options struct, as that is easier to read for APIs with many required params
+func (s *BigSwitchBCFService) NewAddBigSwitchBcfDeviceParamsFromOpts(o AddBigSwitchBcfDeviceOpts) *AddBigSwitchBcfDeviceParams {
+	p := s.NewAddBigSwitchBcfDeviceParams(o.Hostname, o.Nat, o.Password, o.Physicalnetworkid, o.Username)
 	return p
 }
 
 // Adds a BigSwitch BCF Controller device
-func (s *BigSwitchBCFService) AddBigSwitchBcfDevice(p *AddBigSwitchBcfDeviceParams) (*AddBigSwitchBcfDeviceResponse, error) {
-	resp, err := s.cs.newRequest("addBigSwitchBcfDevice", p.toURLValues())
+func (s *BigSwitchBCFService) AddBigSwitchBcfDevice(p *AddBigSwitchBcfDeviceParams, opts ...OptionFunc) (*AddBigSwitchBcfDeviceResponse, error) {
+	return s.AddBigSwitchBcfDeviceWithContext(context.Background(), p, opts...)
+}
+
+// AddBigSwitchBcfDeviceWithContext is the same as AddBigSwitchBcfDevice, but the request is cancelled when the context is done
+func (s *BigSwitchBCFService) AddBigSwitchBcfDeviceWithContext(ctx context.Context, p *AddBigSwitchBcfDeviceParams, opts ...OptionFunc) (*AddBigSwitchBcfDeviceResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.AddBigSwitchBcfDeviceRawWithContext(ctx, p.toURLValues())
+}
+
+// AddBigSwitchBcfDeviceRaw is the same as AddBigSwitchBcfDevice, but takes the params as url.Values instead of typed params
+func (s *BigSwitchBCFService) AddBigSwitchBcfDeviceRaw(v url.Values) (*AddBigSwitchBcfDeviceResponse, error) {
+	return s.AddBigSwitchBcfDeviceRawWithContext(context.Background(), v)
+}
+
+// AddBigSwitchBcfDeviceRawWithContext is the same as AddBigSwitchBcfDeviceRaw, but the request is cancelled when the context is done
+func (s *BigSwitchBCFService) AddBigSwitchBcfDeviceRawWithContext(ctx context.Context, v url.Values) (*AddBigSwitchBcfDeviceResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "addBigSwitchBcfDevice", "addbigswitchbcfdeviceresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -117,9 +220,9 @@ func (s *BigSwitchBCFService) AddBigSwitchBcfDevice(p *AddBigSwitchBcfDevicePara
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -154,6 +257,16 @@ type DeleteBigSwitchBcfDeviceParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *DeleteBigSwitchBcfDeviceParams) Clone() *DeleteBigSwitchBcfDeviceParams {
+	c := &DeleteBigSwitchBcfDeviceParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeleteBigSwitchBcfDeviceParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -165,6 +278,16 @@ func (p *DeleteBigSwitchBcfDeviceParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *DeleteBigSwitchBcfDeviceParams) validate() error {
+	for _, k := range []string{"bcfdeviceid"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of deleteBigSwitchBcfDevice", k)
+		}
+	}
+	return nil
+}
+
 func (p *DeleteBigSwitchBcfDeviceParams) SetBcfdeviceid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -173,18 +296,54 @@ func (p *DeleteBigSwitchBcfDeviceParams) SetBcfdeviceid(v string) {
 	return
 }
 
+// SetBcfdeviceidIfUnset sets bcfdeviceid to v, unless it is already set
+func (p *DeleteBigSwitchBcfDeviceParams) SetBcfdeviceidIfUnset(v string) {
+	if _, found := p.p["bcfdeviceid"]; !found {
+		p.SetBcfdeviceid(v)
+	}
+}
+
 // You should always use this function to get a new DeleteBigSwitchBcfDeviceParams instance,
-// as then you are sure you have configured all required params
-func (s *BigSwitchBCFService) NewDeleteBigSwitchBcfDeviceParams(bcfdeviceid string) *DeleteBigSwitchBcfDeviceParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *BigSwitchBCFService) NewDeleteBigSwitchBcfDeviceParams(bcfdeviceid string, opts ...func(*DeleteBigSwitchBcfDeviceParams)) *DeleteBigSwitchBcfDeviceParams {
 	p := &DeleteBigSwitchBcfDeviceParams{}
 	p.p = make(map[string]interface{})
 	p.p["bcfdeviceid"] = bcfdeviceid
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
-//  delete a BigSwitch BCF Controller device
-func (s *BigSwitchBCFService) DeleteBigSwitchBcfDevice(p *DeleteBigSwitchBcfDeviceParams) (*DeleteBigSwitchBcfDeviceResponse, error) {
-	resp, err := s.cs.newRequest("deleteBigSwitchBcfDevice", p.toURLValues())
+// delete a BigSwitch BCF Controller device
+func (s *BigSwitchBCFService) DeleteBigSwitchBcfDevice(p *DeleteBigSwitchBcfDeviceParams, opts ...OptionFunc) (*DeleteBigSwitchBcfDeviceResponse, error) {
+	return s.DeleteBigSwitchBcfDeviceWithContext(context.Background(), p, opts...)
+}
+
+// DeleteBigSwitchBcfDeviceWithContext is the same as DeleteBigSwitchBcfDevice, but the request is cancelled when the context is done
+func (s *BigSwitchBCFService) DeleteBigSwitchBcfDeviceWithContext(ctx context.Context, p *DeleteBigSwitchBcfDeviceParams, opts ...OptionFunc) (*DeleteBigSwitchBcfDeviceResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.DeleteBigSwitchBcfDeviceRawWithContext(ctx, p.toURLValues())
+}
+
+// DeleteBigSwitchBcfDeviceRaw is the same as DeleteBigSwitchBcfDevice, but takes the params as url.Values instead of typed params
+func (s *BigSwitchBCFService) DeleteBigSwitchBcfDeviceRaw(v url.Values) (*DeleteBigSwitchBcfDeviceResponse, error) {
+	return s.DeleteBigSwitchBcfDeviceRawWithContext(context.Background(), v)
+}
+
+// DeleteBigSwitchBcfDeviceRawWithContext is the same as DeleteBigSwitchBcfDeviceRaw, but the request is cancelled when the context is done
+func (s *BigSwitchBCFService) DeleteBigSwitchBcfDeviceRawWithContext(ctx context.Context, v url.Values) (*DeleteBigSwitchBcfDeviceResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteBigSwitchBcfDevice", "deletebigswitchbcfdeviceresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -196,9 +355,9 @@ func (s *BigSwitchBCFService) DeleteBigSwitchBcfDevice(p *DeleteBigSwitchBcfDevi
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -222,6 +381,16 @@ type ListBigSwitchBcfDevicesParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ListBigSwitchBcfDevicesParams) Clone() *ListBigSwitchBcfDevicesParams {
+	c := &ListBigSwitchBcfDevicesParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListBigSwitchBcfDevicesParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -255,6 +424,13 @@ func (p *ListBigSwitchBcfDevicesParams) SetBcfdeviceid(v string) {
 	return
 }
 
+// SetBcfdeviceidIfUnset sets bcfdeviceid to v, unless it is already set
+func (p *ListBigSwitchBcfDevicesParams) SetBcfdeviceidIfUnset(v string) {
+	if _, found := p.p["bcfdeviceid"]; !found {
+		p.SetBcfdeviceid(v)
+	}
+}
+
 func (p *ListBigSwitchBcfDevicesParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -263,6 +439,13 @@ func (p *ListBigSwitchBcfDevicesParams) SetKeyword(v string) {
 	return
 }
 
+// SetKeywordIfUnset sets keyword to v, unless it is already set
+func (p *ListBigSwitchBcfDevicesParams) SetKeywordIfUnset(v string) {
+	if _, found := p.p["keyword"]; !found {
+		p.SetKeyword(v)
+	}
+}
+
 func (p *ListBigSwitchBcfDevicesParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -271,6 +454,13 @@ func (p *ListBigSwitchBcfDevicesParams) SetPage(v int) {
 	return
 }
 
+// SetPageIfUnset sets page to v, unless it is already set
+func (p *ListBigSwitchBcfDevicesParams) SetPageIfUnset(v int) {
+	if _, found := p.p["page"]; !found {
+		p.SetPage(v)
+	}
+}
+
 func (p *ListBigSwitchBcfDevicesParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -279,6 +469,13 @@ func (p *ListBigSwitchBcfDevicesParams) SetPagesize(v int) {
 	return
 }
 
+// SetPagesizeIfUnset sets pagesize to v, unless it is already set
+func (p *ListBigSwitchBcfDevicesParams) SetPagesizeIfUnset(v int) {
+	if _, found := p.p["pagesize"]; !found {
+		p.SetPagesize(v)
+	}
+}
+
 func (p *ListBigSwitchBcfDevicesParams) SetPhysicalnetworkid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -287,17 +484,43 @@ func (p *ListBigSwitchBcfDevicesParams) SetPhysicalnetworkid(v string) {
 	return
 }
 
+// SetPhysicalnetworkidIfUnset sets physicalnetworkid to v, unless it is already set
+func (p *ListBigSwitchBcfDevicesParams) SetPhysicalnetworkidIfUnset(v string) {
+	if _, found := p.p["physicalnetworkid"]; !found {
+		p.SetPhysicalnetworkid(v)
+	}
+}
+
 // You should always use this function to get a new ListBigSwitchBcfDevicesParams instance,
-// as then you are sure you have configured all required params
-func (s *BigSwitchBCFService) NewListBigSwitchBcfDevicesParams() *ListBigSwitchBcfDevicesParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *BigSwitchBCFService) NewListBigSwitchBcfDevicesParams(opts ...func(*ListBigSwitchBcfDevicesParams)) *ListBigSwitchBcfDevicesParams {
 	p := &ListBigSwitchBcfDevicesParams{}
 	p.p = make(map[string]interface{})
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Lists BigSwitch BCF Controller devices
 func (s *BigSwitchBCFService) ListBigSwitchBcfDevices(p *ListBigSwitchBcfDevicesParams) (*ListBigSwitchBcfDevicesResponse, error) {
-	resp, err := s.cs.newRequest("listBigSwitchBcfDevices", p.toURLValues())
+	return s.ListBigSwitchBcfDevicesWithContext(context.Background(), p)
+}
+
+// ListBigSwitchBcfDevicesWithContext is the same as ListBigSwitchBcfDevices, but the request is cancelled when the context is done
+func (s *BigSwitchBCFService) ListBigSwitchBcfDevicesWithContext(ctx context.Context, p *ListBigSwitchBcfDevicesParams) (*ListBigSwitchBcfDevicesResponse, error) {
+	return s.ListBigSwitchBcfDevicesRawWithContext(ctx, p.toURLValues())
+}
+
+// ListBigSwitchBcfDevicesRaw is the same as ListBigSwitchBcfDevices, but takes the params as url.Values instead of typed params
+func (s *BigSwitchBCFService) ListBigSwitchBcfDevicesRaw(v url.Values) (*ListBigSwitchBcfDevicesResponse, error) {
+	return s.ListBigSwitchBcfDevicesRawWithContext(context.Background(), v)
+}
+
+// ListBigSwitchBcfDevicesRawWithContext is the same as ListBigSwitchBcfDevicesRaw, but the request is cancelled when the context is done
+func (s *BigSwitchBCFService) ListBigSwitchBcfDevicesRawWithContext(ctx context.Context, v url.Values) (*ListBigSwitchBcfDevicesResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "listBigSwitchBcfDevices", "listbigswitchbcfdevicesresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -310,6 +533,93 @@ func (s *BigSwitchBCFService) ListBigSwitchBcfDevices(p *ListBigSwitchBcfDevices
 	return &r, nil
 }
 
+// StreamBigSwitchBcfDevices fetches the results of ListBigSwitchBcfDevices page by page in the background and sends them on the
+// returned channel. Both returned channels are closed when done. If fetching a page fails, or the context
+// is done before all results are sent, the error is sent on the error channel and fetching stops. A
+// request for a page in flight is cancelled when the context is done. The page and pagesize set in the
+// params are ignored.
+func (s *BigSwitchBCFService) StreamBigSwitchBcfDevices(ctx context.Context, p *ListBigSwitchBcfDevicesParams) (<-chan *BigSwitchBcfDevice, <-chan error) {
+	ch := make(chan *BigSwitchBcfDevice)
+	errs := make(chan error, 1)
+
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesize(500)
+
+	go func() {
+		defer close(errs)
+		defer close(ch)
+
+		for page, count := 1, 0; ; page++ {
+			pp.SetPage(page)
+
+			l, err := s.ListBigSwitchBcfDevicesWithContext(ctx, pp)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			for _, v := range l.BigSwitchBcfDevices {
+				select {
+				case ch <- v:
+				case <-ctx.Done():
+					errs <- ctx.Err()
+					return
+				}
+			}
+
+			count += len(l.BigSwitchBcfDevices)
+			if len(l.BigSwitchBcfDevices) < 500 || count >= l.Count {
+				return
+			}
+		}
+	}()
+
+	return ch, errs
+}
+
+// ListBigSwitchBcfDevicesWhere returns all results of ListBigSwitchBcfDevices for which pred returns true. Note that the results are
+// filtered client-side, so all results matching the params are fetched first.
+// All pages are fetched, so the page and pagesize set in the params are ignored.
+func (s *BigSwitchBCFService) ListBigSwitchBcfDevicesWhere(p *ListBigSwitchBcfDevicesParams, pred func(*BigSwitchBcfDevice) bool) ([]*BigSwitchBcfDevice, error) {
+	var r []*BigSwitchBcfDevice
+	ch, errs := s.StreamBigSwitchBcfDevices(context.Background(), p)
+	for v := range ch {
+		if pred(v) {
+			r = append(r, v)
+		}
+	}
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ListAllBigSwitchBcfDevices returns the results of ListBigSwitchBcfDevices across all pages, by calling ListBigSwitchBcfDevices page by page until all
+// results are fetched. The pagesize set in the params is used for every page (500 if not set), and the
+// page set in the params is ignored.
+func (s *BigSwitchBCFService) ListAllBigSwitchBcfDevices(p *ListBigSwitchBcfDevicesParams) ([]*BigSwitchBcfDevice, error) {
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesizeIfUnset(500)
+	pagesize := pp.p["pagesize"].(int)
+
+	var r []*BigSwitchBcfDevice
+	for page := 1; ; page++ {
+		pp.SetPage(page)
+
+		l, err := s.ListBigSwitchBcfDevices(pp)
+		if err != nil {
+			return nil, err
+		}
+		r = append(r, l.BigSwitchBcfDevices...)
+
+		if len(l.BigSwitchBcfDevices) < pagesize || len(r) >= l.Count {
+			return r, nil
+		}
+	}
+}
+
 type ListBigSwitchBcfDevicesResponse struct {
 	Count               int                   `json:"count"`
 	BigSwitchBcfDevices []*BigSwitchBcfDevice `json:"bigswitchbcfdevice"`
@@ -17,6 +17,7 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
@@ -27,6 +28,16 @@ type AddBrocadeVcsDeviceParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *AddBrocadeVcsDeviceParams) Clone() *AddBrocadeVcsDeviceParams {
+	c := &AddBrocadeVcsDeviceParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *AddBrocadeVcsDeviceParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -47,6 +58,16 @@ func (p *AddBrocadeVcsDeviceParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *AddBrocadeVcsDeviceParams) validate() error {
+	for _, k := range []string{"hostname", "password", "physicalnetworkid", "username"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of addBrocadeVcsDevice", k)
+		}
+	}
+	return nil
+}
+
 func (p *AddBrocadeVcsDeviceParams) SetHostname(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -55,6 +76,13 @@ func (p *AddBrocadeVcsDeviceParams) SetHostname(v string) {
 	return
 }
 
+// SetHostnameIfUnset sets hostname to v, unless it is already set
+func (p *AddBrocadeVcsDeviceParams) SetHostnameIfUnset(v string) {
+	if _, found := p.p["hostname"]; !found {
+		p.SetHostname(v)
+	}
+}
+
 func (p *AddBrocadeVcsDeviceParams) SetPassword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -63,6 +91,13 @@ func (p *AddBrocadeVcsDeviceParams) SetPassword(v string) {
 	return
 }
 
+// SetPasswordIfUnset sets password to v, unless it is already set
+func (p *AddBrocadeVcsDeviceParams) SetPasswordIfUnset(v string) {
+	if _, found := p.p["password"]; !found {
+		p.SetPassword(v)
+	}
+}
+
 func (p *AddBrocadeVcsDeviceParams) SetPhysicalnetworkid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -71,6 +106,13 @@ func (p *AddBrocadeVcsDeviceParams) SetPhysicalnetworkid(v string) {
 	return
 }
 
+// SetPhysicalnetworkidIfUnset sets physicalnetworkid to v, unless it is already set
+func (p *AddBrocadeVcsDeviceParams) SetPhysicalnetworkidIfUnset(v string) {
+	if _, found := p.p["physicalnetworkid"]; !found {
+		p.SetPhysicalnetworkid(v)
+	}
+}
+
 func (p *AddBrocadeVcsDeviceParams) SetUsername(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -79,21 +121,73 @@ func (p *AddBrocadeVcsDeviceParams) SetUsername(v string) {
 	return
 }
 
+// SetUsernameIfUnset sets username to v, unless it is already set
+func (p *AddBrocadeVcsDeviceParams) SetUsernameIfUnset(v string) {
+	if _, found := p.p["username"]; !found {
+		p.SetUsername(v)
+	}
+}
+
 // You should always use this function to get a new AddBrocadeVcsDeviceParams instance,
-// as then you are sure you have configured all required params
-func (s *BrocadeVCSService) NewAddBrocadeVcsDeviceParams(hostname string, password string, physicalnetworkid string, username string) *AddBrocadeVcsDeviceParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *BrocadeVCSService) NewAddBrocadeVcsDeviceParams(hostname string, password string, physicalnetworkid string, username string, opts ...func(*AddBrocadeVcsDeviceParams)) *AddBrocadeVcsDeviceParams {
 	p := &AddBrocadeVcsDeviceParams{}
 	p.p = make(map[string]interface{})
 	p.p["hostname"] = hostname
 	p.p["password"] = password
 	p.p["physicalnetworkid"] = physicalnetworkid
 	p.p["username"] = username
+	for _, fn := range opts {
+		fn(p)
+	}
+	return p
+}
+
+// AddBrocadeVcsDeviceOpts contains all params that can be set on a AddBrocadeVcsDeviceParams.
+// Optional params are pointers (or slices and maps) which are only set when not nil.
+type AddBrocadeVcsDeviceOpts struct {
+	Hostname          string
+	Password          string
+	Physicalnetworkid string
+	Username          string
+}
+
+// NewAddBrocadeVcsDeviceParamsFromOpts is an alternative for NewAddBrocadeVcsDeviceParams which takes all params using
+// an options struct, as that is easier to read for APIs with many required params
+func (s *BrocadeVCSService) NewAddBrocadeVcsDeviceParamsFromOpts(o AddBrocadeVcsDeviceOpts) *AddBrocadeVcsDeviceParams {
+	p := s.NewAddBrocadeVcsDeviceParams(o.Hostname, o.Password, o.Physicalnetworkid, o.Username)
 	return p
 }
 
 // Adds a Brocade VCS Switch
-func (s *BrocadeVCSService) AddBrocadeVcsDevice(p *AddBrocadeVcsDeviceParams) (*AddBrocadeVcsDeviceResponse, error) {
-	resp, err := s.cs.newRequest("addBrocadeVcsDevice", p.toURLValues())
+func (s *BrocadeVCSService) AddBrocadeVcsDevice(p *AddBrocadeVcsDeviceParams, opts ...OptionFunc) (*AddBrocadeVcsDeviceResponse, error) {
+	return s.AddBrocadeVcsDeviceWithContext(context.Background(), p, opts...)
+}
+
+// AddBrocadeVcsDeviceWithContext is the same as AddBrocadeVcsDevice, but the request is cancelled when the context is done
+func (s *BrocadeVCSService) AddBrocadeVcsDeviceWithContext(ctx context.Context, p *AddBrocadeVcsDeviceParams, opts ...OptionFunc) (*AddBrocadeVcsDeviceResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.AddBrocadeVcsDeviceRawWithContext(ctx, p.toURLValues())
+}
+
+// AddBrocadeVcsDeviceRaw is the same as AddBrocadeVcsDevice, but takes the params as url.Values instead of typed params
+func (s *BrocadeVCSService) AddBrocadeVcsDeviceRaw(v url.Values) (*AddBrocadeVcsDeviceResponse, error) {
+	return s.AddBrocadeVcsDeviceRawWithContext(context.Background(), v)
+}
+
+// AddBrocadeVcsDeviceRawWithContext is the same as AddBrocadeVcsDeviceRaw, but the request is cancelled when the context is done
+func (s *BrocadeVCSService) AddBrocadeVcsDeviceRawWithContext(ctx context.Context, v url.Values) (*AddBrocadeVcsDeviceResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "addBrocadeVcsDevice", "addbrocadevcsdeviceresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -105,9 +199,9 @@ func (s *BrocadeVCSService) AddBrocadeVcsDevice(p *AddBrocadeVcsDeviceParams) (*
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -139,6 +233,16 @@ type DeleteBrocadeVcsDeviceParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *DeleteBrocadeVcsDeviceParams) Clone() *DeleteBrocadeVcsDeviceParams {
+	c := &DeleteBrocadeVcsDeviceParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeleteBrocadeVcsDeviceParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -150,6 +254,16 @@ func (p *DeleteBrocadeVcsDeviceParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *DeleteBrocadeVcsDeviceParams) validate() error {
+	for _, k := range []string{"vcsdeviceid"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of deleteBrocadeVcsDevice", k)
+		}
+	}
+	return nil
+}
+
 func (p *DeleteBrocadeVcsDeviceParams) SetVcsdeviceid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -158,18 +272,54 @@ func (p *DeleteBrocadeVcsDeviceParams) SetVcsdeviceid(v string) {
 	return
 }
 
+// SetVcsdeviceidIfUnset sets vcsdeviceid to v, unless it is already set
+func (p *DeleteBrocadeVcsDeviceParams) SetVcsdeviceidIfUnset(v string) {
+	if _, found := p.p["vcsdeviceid"]; !found {
+		p.SetVcsdeviceid(v)
+	}
+}
+
 // You should always use this function to get a new DeleteBrocadeVcsDeviceParams instance,
-// as then you are sure you have configured all required params
-func (s *BrocadeVCSService) NewDeleteBrocadeVcsDeviceParams(vcsdeviceid string) *DeleteBrocadeVcsDeviceParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *BrocadeVCSService) NewDeleteBrocadeVcsDeviceParams(vcsdeviceid string, opts ...func(*DeleteBrocadeVcsDeviceParams)) *DeleteBrocadeVcsDeviceParams {
 	p := &DeleteBrocadeVcsDeviceParams{}
 	p.p = make(map[string]interface{})
 	p.p["vcsdeviceid"] = vcsdeviceid
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
-//  delete a Brocade VCS Switch
-func (s *BrocadeVCSService) DeleteBrocadeVcsDevice(p *DeleteBrocadeVcsDeviceParams) (*DeleteBrocadeVcsDeviceResponse, error) {
-	resp, err := s.cs.newRequest("deleteBrocadeVcsDevice", p.toURLValues())
+// delete a Brocade VCS Switch
+func (s *BrocadeVCSService) DeleteBrocadeVcsDevice(p *DeleteBrocadeVcsDeviceParams, opts ...OptionFunc) (*DeleteBrocadeVcsDeviceResponse, error) {
+	return s.DeleteBrocadeVcsDeviceWithContext(context.Background(), p, opts...)
+}
+
+// DeleteBrocadeVcsDeviceWithContext is the same as DeleteBrocadeVcsDevice, but the request is cancelled when the context is done
+func (s *BrocadeVCSService) DeleteBrocadeVcsDeviceWithContext(ctx context.Context, p *DeleteBrocadeVcsDeviceParams, opts ...OptionFunc) (*DeleteBrocadeVcsDeviceResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.DeleteBrocadeVcsDeviceRawWithContext(ctx, p.toURLValues())
+}
+
+// DeleteBrocadeVcsDeviceRaw is the same as DeleteBrocadeVcsDevice, but takes the params as url.Values instead of typed params
+func (s *BrocadeVCSService) DeleteBrocadeVcsDeviceRaw(v url.Values) (*DeleteBrocadeVcsDeviceResponse, error) {
+	return s.DeleteBrocadeVcsDeviceRawWithContext(context.Background(), v)
+}
+
+// DeleteBrocadeVcsDeviceRawWithContext is the same as DeleteBrocadeVcsDeviceRaw, but the request is cancelled when the context is done
+func (s *BrocadeVCSService) DeleteBrocadeVcsDeviceRawWithContext(ctx context.Context, v url.Values) (*DeleteBrocadeVcsDeviceResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteBrocadeVcsDevice", "deletebrocadevcsdeviceresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -181,9 +331,9 @@ func (s *BrocadeVCSService) DeleteBrocadeVcsDevice(p *DeleteBrocadeVcsDevicePara
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -207,6 +357,16 @@ type ListBrocadeVcsDeviceNetworksParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ListBrocadeVcsDeviceNetworksParams) Clone() *ListBrocadeVcsDeviceNetworksParams {
+	c := &ListBrocadeVcsDeviceNetworksParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListBrocadeVcsDeviceNetworksParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -229,6 +389,16 @@ func (p *ListBrocadeVcsDeviceNetworksParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *ListBrocadeVcsDeviceNetworksParams) validate() error {
+	for _, k := range []string{"vcsdeviceid"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of listBrocadeVcsDeviceNetworks", k)
+		}
+	}
+	return nil
+}
+
 func (p *ListBrocadeVcsDeviceNetworksParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -237,6 +407,13 @@ func (p *ListBrocadeVcsDeviceNetworksParams) SetKeyword(v string) {
 	return
 }
 
+// SetKeywordIfUnset sets keyword to v, unless it is already set
+func (p *ListBrocadeVcsDeviceNetworksParams) SetKeywordIfUnset(v string) {
+	if _, found := p.p["keyword"]; !found {
+		p.SetKeyword(v)
+	}
+}
+
 func (p *ListBrocadeVcsDeviceNetworksParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -245,6 +422,13 @@ func (p *ListBrocadeVcsDeviceNetworksParams) SetPage(v int) {
 	return
 }
 
+// SetPageIfUnset sets page to v, unless it is already set
+func (p *ListBrocadeVcsDeviceNetworksParams) SetPageIfUnset(v int) {
+	if _, found := p.p["page"]; !found {
+		p.SetPage(v)
+	}
+}
+
 func (p *ListBrocadeVcsDeviceNetworksParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -253,6 +437,13 @@ func (p *ListBrocadeVcsDeviceNetworksParams) SetPagesize(v int) {
 	return
 }
 
+// SetPagesizeIfUnset sets pagesize to v, unless it is already set
+func (p *ListBrocadeVcsDeviceNetworksParams) SetPagesizeIfUnset(v int) {
+	if _, found := p.p["pagesize"]; !found {
+		p.SetPagesize(v)
+	}
+}
+
 func (p *ListBrocadeVcsDeviceNetworksParams) SetVcsdeviceid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -261,15 +452,33 @@ func (p *ListBrocadeVcsDeviceNetworksParams) SetVcsdeviceid(v string) {
 	return
 }
 
+// SetVcsdeviceidIfUnset sets vcsdeviceid to v, unless it is already set
+func (p *ListBrocadeVcsDeviceNetworksParams) SetVcsdeviceidIfUnset(v string) {
+	if _, found := p.p["vcsdeviceid"]; !found {
+		p.SetVcsdeviceid(v)
+	}
+}
+
 // You should always use this function to get a new ListBrocadeVcsDeviceNetworksParams instance,
-// as then you are sure you have configured all required params
-func (s *BrocadeVCSService) NewListBrocadeVcsDeviceNetworksParams(vcsdeviceid string) *ListBrocadeVcsDeviceNetworksParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *BrocadeVCSService) NewListBrocadeVcsDeviceNetworksParams(vcsdeviceid string, opts ...func(*ListBrocadeVcsDeviceNetworksParams)) *ListBrocadeVcsDeviceNetworksParams {
 	p := &ListBrocadeVcsDeviceNetworksParams{}
 	p.p = make(map[string]interface{})
 	p.p["vcsdeviceid"] = vcsdeviceid
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
+func (p *ListBrocadeVcsDeviceNetworksParams) setFirstMatch() {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p[firstMatchKey] = true
+}
+
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *BrocadeVCSService) GetBrocadeVcsDeviceNetworkID(keyword string, vcsdeviceid string, opts ...OptionFunc) (string, int, error) {
 	p := &ListBrocadeVcsDeviceNetworksParams{}
@@ -278,7 +487,7 @@ func (s *BrocadeVCSService) GetBrocadeVcsDeviceNetworkID(keyword string, vcsdevi
 	p.p["keyword"] = keyword
 	p.p["vcsdeviceid"] = vcsdeviceid
 
-	for _, fn := range append(s.cs.options, opts...) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
 		if err := fn(s.cs, p); err != nil {
 			return "", -1, err
 		}
@@ -290,7 +499,7 @@ func (s *BrocadeVCSService) GetBrocadeVcsDeviceNetworkID(keyword string, vcsdevi
 	}
 
 	if l.Count == 0 {
-		return "", l.Count, fmt.Errorf("No match found for %s: %+v", keyword, l)
+		return "", l.Count, fmt.Errorf("%w for %s: %+v", ErrNotFound, keyword, l)
 	}
 
 	if l.Count == 1 {
@@ -303,13 +512,36 @@ func (s *BrocadeVCSService) GetBrocadeVcsDeviceNetworkID(keyword string, vcsdevi
 				return v.Id, l.Count, nil
 			}
 		}
+
+		if _, ok := p.p[firstMatchKey]; ok && len(l.BrocadeVcsDeviceNetworks) > 0 {
+			return l.BrocadeVcsDeviceNetworks[0].Id, l.Count, nil
+		}
 	}
 	return "", l.Count, fmt.Errorf("Could not find an exact match for %s: %+v", keyword, l)
 }
 
 // lists network that are using a brocade vcs switch
 func (s *BrocadeVCSService) ListBrocadeVcsDeviceNetworks(p *ListBrocadeVcsDeviceNetworksParams) (*ListBrocadeVcsDeviceNetworksResponse, error) {
-	resp, err := s.cs.newRequest("listBrocadeVcsDeviceNetworks", p.toURLValues())
+	return s.ListBrocadeVcsDeviceNetworksWithContext(context.Background(), p)
+}
+
+// ListBrocadeVcsDeviceNetworksWithContext is the same as ListBrocadeVcsDeviceNetworks, but the request is cancelled when the context is done
+func (s *BrocadeVCSService) ListBrocadeVcsDeviceNetworksWithContext(ctx context.Context, p *ListBrocadeVcsDeviceNetworksParams) (*ListBrocadeVcsDeviceNetworksResponse, error) {
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.ListBrocadeVcsDeviceNetworksRawWithContext(ctx, p.toURLValues())
+}
+
+// ListBrocadeVcsDeviceNetworksRaw is the same as ListBrocadeVcsDeviceNetworks, but takes the params as url.Values instead of typed params
+func (s *BrocadeVCSService) ListBrocadeVcsDeviceNetworksRaw(v url.Values) (*ListBrocadeVcsDeviceNetworksResponse, error) {
+	return s.ListBrocadeVcsDeviceNetworksRawWithContext(context.Background(), v)
+}
+
+// ListBrocadeVcsDeviceNetworksRawWithContext is the same as ListBrocadeVcsDeviceNetworksRaw, but the request is cancelled when the context is done
+func (s *BrocadeVCSService) ListBrocadeVcsDeviceNetworksRawWithContext(ctx context.Context, v url.Values) (*ListBrocadeVcsDeviceNetworksResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "listBrocadeVcsDeviceNetworks", "listbrocadevcsdevicenetworksresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -322,6 +554,93 @@ func (s *BrocadeVCSService) ListBrocadeVcsDeviceNetworks(p *ListBrocadeVcsDevice
 	return &r, nil
 }
 
+// StreamBrocadeVcsDeviceNetworks fetches the results of ListBrocadeVcsDeviceNetworks page by page in the background and sends them on the
+// returned channel. Both returned channels are closed when done. If fetching a page fails, or the context
+// is done before all results are sent, the error is sent on the error channel and fetching stops. A
+// request for a page in flight is cancelled when the context is done. The page and pagesize set in the
+// params are ignored.
+func (s *BrocadeVCSService) StreamBrocadeVcsDeviceNetworks(ctx context.Context, p *ListBrocadeVcsDeviceNetworksParams) (<-chan *BrocadeVcsDeviceNetwork, <-chan error) {
+	ch := make(chan *BrocadeVcsDeviceNetwork)
+	errs := make(chan error, 1)
+
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesize(500)
+
+	go func() {
+		defer close(errs)
+		defer close(ch)
+
+		for page, count := 1, 0; ; page++ {
+			pp.SetPage(page)
+
+			l, err := s.ListBrocadeVcsDeviceNetworksWithContext(ctx, pp)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			for _, v := range l.BrocadeVcsDeviceNetworks {
+				select {
+				case ch <- v:
+				case <-ctx.Done():
+					errs <- ctx.Err()
+					return
+				}
+			}
+
+			count += len(l.BrocadeVcsDeviceNetworks)
+			if len(l.BrocadeVcsDeviceNetworks) < 500 || count >= l.Count {
+				return
+			}
+		}
+	}()
+
+	return ch, errs
+}
+
+// ListBrocadeVcsDeviceNetworksWhere returns all results of ListBrocadeVcsDeviceNetworks for which pred returns true. Note that the results are
+// filtered client-side, so all results matching the params are fetched first.
+// All pages are fetched, so the page and pagesize set in the params are ignored.
+func (s *BrocadeVCSService) ListBrocadeVcsDeviceNetworksWhere(p *ListBrocadeVcsDeviceNetworksParams, pred func(*BrocadeVcsDeviceNetwork) bool) ([]*BrocadeVcsDeviceNetwork, error) {
+	var r []*BrocadeVcsDeviceNetwork
+	ch, errs := s.StreamBrocadeVcsDeviceNetworks(context.Background(), p)
+	for v := range ch {
+		if pred(v) {
+			r = append(r, v)
+		}
+	}
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ListAllBrocadeVcsDeviceNetworks returns the results of ListBrocadeVcsDeviceNetworks across all pages, by calling ListBrocadeVcsDeviceNetworks page by page until all
+// results are fetched. The pagesize set in the params is used for every page (500 if not set), and the
+// page set in the params is ignored.
+func (s *BrocadeVCSService) ListAllBrocadeVcsDeviceNetworks(p *ListBrocadeVcsDeviceNetworksParams) ([]*BrocadeVcsDeviceNetwork, error) {
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesizeIfUnset(500)
+	pagesize := pp.p["pagesize"].(int)
+
+	var r []*BrocadeVcsDeviceNetwork
+	for page := 1; ; page++ {
+		pp.SetPage(page)
+
+		l, err := s.ListBrocadeVcsDeviceNetworks(pp)
+		if err != nil {
+			return nil, err
+		}
+		r = append(r, l.BrocadeVcsDeviceNetworks...)
+
+		if len(l.BrocadeVcsDeviceNetworks) < pagesize || len(r) >= l.Count {
+			return r, nil
+		}
+	}
+}
+
 type ListBrocadeVcsDeviceNetworksResponse struct {
 	Count                    int                        `json:"count"`
 	BrocadeVcsDeviceNetworks []*BrocadeVcsDeviceNetwork `json:"brocadevcsdevicenetwork"`
@@ -409,6 +728,16 @@ type ListBrocadeVcsDevicesParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ListBrocadeVcsDevicesParams) Clone() *ListBrocadeVcsDevicesParams {
+	c := &ListBrocadeVcsDevicesParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListBrocadeVcsDevicesParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -442,6 +771,13 @@ func (p *ListBrocadeVcsDevicesParams) SetKeyword(v string) {
 	return
 }
 
+// SetKeywordIfUnset sets keyword to v, unless it is already set
+func (p *ListBrocadeVcsDevicesParams) SetKeywordIfUnset(v string) {
+	if _, found := p.p["keyword"]; !found {
+		p.SetKeyword(v)
+	}
+}
+
 func (p *ListBrocadeVcsDevicesParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -450,6 +786,13 @@ func (p *ListBrocadeVcsDevicesParams) SetPage(v int) {
 	return
 }
 
+// SetPageIfUnset sets page to v, unless it is already set
+func (p *ListBrocadeVcsDevicesParams) SetPageIfUnset(v int) {
+	if _, found := p.p["page"]; !found {
+		p.SetPage(v)
+	}
+}
+
 func (p *ListBrocadeVcsDevicesParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -458,6 +801,13 @@ func (p *ListBrocadeVcsDevicesParams) SetPagesize(v int) {
 	return
 }
 
+// SetPagesizeIfUnset sets pagesize to v, unless it is already set
+func (p *ListBrocadeVcsDevicesParams) SetPagesizeIfUnset(v int) {
+	if _, found := p.p["pagesize"]; !found {
+		p.SetPagesize(v)
+	}
+}
+
 func (p *ListBrocadeVcsDevicesParams) SetPhysicalnetworkid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -466,6 +816,13 @@ func (p *ListBrocadeVcsDevicesParams) SetPhysicalnetworkid(v string) {
 	return
 }
 
+// SetPhysicalnetworkidIfUnset sets physicalnetworkid to v, unless it is already set
+func (p *ListBrocadeVcsDevicesParams) SetPhysicalnetworkidIfUnset(v string) {
+	if _, found := p.p["physicalnetworkid"]; !found {
+		p.SetPhysicalnetworkid(v)
+	}
+}
+
 func (p *ListBrocadeVcsDevicesParams) SetVcsdeviceid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -474,17 +831,43 @@ func (p *ListBrocadeVcsDevicesParams) SetVcsdeviceid(v string) {
 	return
 }
 
+// SetVcsdeviceidIfUnset sets vcsdeviceid to v, unless it is already set
+func (p *ListBrocadeVcsDevicesParams) SetVcsdeviceidIfUnset(v string) {
+	if _, found := p.p["vcsdeviceid"]; !found {
+		p.SetVcsdeviceid(v)
+	}
+}
+
 // You should always use this function to get a new ListBrocadeVcsDevicesParams instance,
-// as then you are sure you have configured all required params
-func (s *BrocadeVCSService) NewListBrocadeVcsDevicesParams() *ListBrocadeVcsDevicesParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *BrocadeVCSService) NewListBrocadeVcsDevicesParams(opts ...func(*ListBrocadeVcsDevicesParams)) *ListBrocadeVcsDevicesParams {
 	p := &ListBrocadeVcsDevicesParams{}
 	p.p = make(map[string]interface{})
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Lists Brocade VCS Switches
 func (s *BrocadeVCSService) ListBrocadeVcsDevices(p *ListBrocadeVcsDevicesParams) (*ListBrocadeVcsDevicesResponse, error) {
-	resp, err := s.cs.newRequest("listBrocadeVcsDevices", p.toURLValues())
+	return s.ListBrocadeVcsDevicesWithContext(context.Background(), p)
+}
+
+// ListBrocadeVcsDevicesWithContext is the same as ListBrocadeVcsDevices, but the request is cancelled when the context is done
+func (s *BrocadeVCSService) ListBrocadeVcsDevicesWithContext(ctx context.Context, p *ListBrocadeVcsDevicesParams) (*ListBrocadeVcsDevicesResponse, error) {
+	return s.ListBrocadeVcsDevicesRawWithContext(ctx, p.toURLValues())
+}
+
+// ListBrocadeVcsDevicesRaw is the same as ListBrocadeVcsDevices, but takes the params as url.Values instead of typed params
+func (s *BrocadeVCSService) ListBrocadeVcsDevicesRaw(v url.Values) (*ListBrocadeVcsDevicesResponse, error) {
+	return s.ListBrocadeVcsDevicesRawWithContext(context.Background(), v)
+}
+
+// ListBrocadeVcsDevicesRawWithContext is the same as ListBrocadeVcsDevicesRaw, but the request is cancelled when the context is done
+func (s *BrocadeVCSService) ListBrocadeVcsDevicesRawWithContext(ctx context.Context, v url.Values) (*ListBrocadeVcsDevicesResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "listBrocadeVcsDevices", "listbrocadevcsdevicesresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -497,6 +880,93 @@ func (s *BrocadeVCSService) ListBrocadeVcsDevices(p *ListBrocadeVcsDevicesParams
 	return &r, nil
 }
 
+// StreamBrocadeVcsDevices fetches the results of ListBrocadeVcsDevices page by page in the background and sends them on the
+// returned channel. Both returned channels are closed when done. If fetching a page fails, or the context
+// is done before all results are sent, the error is sent on the error channel and fetching stops. A
+// request for a page in flight is cancelled when the context is done. The page and pagesize set in the
+// params are ignored.
+func (s *BrocadeVCSService) StreamBrocadeVcsDevices(ctx context.Context, p *ListBrocadeVcsDevicesParams) (<-chan *BrocadeVcsDevice, <-chan error) {
+	ch := make(chan *BrocadeVcsDevice)
+	errs := make(chan error, 1)
+
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesize(500)
+
+	go func() {
+		defer close(errs)
+		defer close(ch)
+
+		for page, count := 1, 0; ; page++ {
+			pp.SetPage(page)
+
+			l, err := s.ListBrocadeVcsDevicesWithContext(ctx, pp)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			for _, v := range l.BrocadeVcsDevices {
+				select {
+				case ch <- v:
+				case <-ctx.Done():
+					errs <- ctx.Err()
+					return
+				}
+			}
+
+			count += len(l.BrocadeVcsDevices)
+			if len(l.BrocadeVcsDevices) < 500 || count >= l.Count {
+				return
+			}
+		}
+	}()
+
+	return ch, errs
+}
+
+// ListBrocadeVcsDevicesWhere returns all results of ListBrocadeVcsDevices for which pred returns true. Note that the results are
+// filtered client-side, so all results matching the params are fetched first.
+// All pages are fetched, so the page and pagesize set in the params are ignored.
+func (s *BrocadeVCSService) ListBrocadeVcsDevicesWhere(p *ListBrocadeVcsDevicesParams, pred func(*BrocadeVcsDevice) bool) ([]*BrocadeVcsDevice, error) {
+	var r []*BrocadeVcsDevice
+	ch, errs := s.StreamBrocadeVcsDevices(context.Background(), p)
+	for v := range ch {
+		if pred(v) {
+			r = append(r, v)
+		}
+	}
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ListAllBrocadeVcsDevices returns the results of ListBrocadeVcsDevices across all pages, by calling ListBrocadeVcsDevices page by page until all
+// results are fetched. The pagesize set in the params is used for every page (500 if not set), and the
+// page set in the params is ignored.
+func (s *BrocadeVCSService) ListAllBrocadeVcsDevices(p *ListBrocadeVcsDevicesParams) ([]*BrocadeVcsDevice, error) {
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesizeIfUnset(500)
+	pagesize := pp.p["pagesize"].(int)
+
+	var r []*BrocadeVcsDevice
+	for page := 1; ; page++ {
+		pp.SetPage(page)
+
+		l, err := s.ListBrocadeVcsDevices(pp)
+		if err != nil {
+			return nil, err
+		}
+		r = append(r, l.BrocadeVcsDevices...)
+
+		if len(l.BrocadeVcsDevices) < pagesize || len(r) >= l.Count {
+			return r, nil
+		}
+	}
+}
+
 type ListBrocadeVcsDevicesResponse struct {
 	Count             int                 `json:"count"`
 	BrocadeVcsDevices []*BrocadeVcsDevice `json:"brocadevcsdevice"`
@@ -17,7 +17,9 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
 	"net/url"
 	"strconv"
 )
@@ -26,6 +28,16 @@ type UploadCustomCertificateParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *UploadCustomCertificateParams) Clone() *UploadCustomCertificateParams {
+	c := &UploadCustomCertificateParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *UploadCustomCertificateParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -50,6 +62,16 @@ func (p *UploadCustomCertificateParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *UploadCustomCertificateParams) validate() error {
+	for _, k := range []string{"certificate", "domainsuffix"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of uploadCustomCertificate", k)
+		}
+	}
+	return nil
+}
+
 func (p *UploadCustomCertificateParams) SetCertificate(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -58,6 +80,13 @@ func (p *UploadCustomCertificateParams) SetCertificate(v string) {
 	return
 }
 
+// SetCertificateIfUnset sets certificate to v, unless it is already set
+func (p *UploadCustomCertificateParams) SetCertificateIfUnset(v string) {
+	if _, found := p.p["certificate"]; !found {
+		p.SetCertificate(v)
+	}
+}
+
 func (p *UploadCustomCertificateParams) SetDomainsuffix(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -66,6 +95,13 @@ func (p *UploadCustomCertificateParams) SetDomainsuffix(v string) {
 	return
 }
 
+// SetDomainsuffixIfUnset sets domainsuffix to v, unless it is already set
+func (p *UploadCustomCertificateParams) SetDomainsuffixIfUnset(v string) {
+	if _, found := p.p["domainsuffix"]; !found {
+		p.SetDomainsuffix(v)
+	}
+}
+
 func (p *UploadCustomCertificateParams) SetId(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -74,6 +110,13 @@ func (p *UploadCustomCertificateParams) SetId(v int) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *UploadCustomCertificateParams) SetIdIfUnset(v int) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 func (p *UploadCustomCertificateParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -82,6 +125,13 @@ func (p *UploadCustomCertificateParams) SetName(v string) {
 	return
 }
 
+// SetNameIfUnset sets name to v, unless it is already set
+func (p *UploadCustomCertificateParams) SetNameIfUnset(v string) {
+	if _, found := p.p["name"]; !found {
+		p.SetName(v)
+	}
+}
+
 func (p *UploadCustomCertificateParams) SetPrivatekey(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -90,19 +140,55 @@ func (p *UploadCustomCertificateParams) SetPrivatekey(v string) {
 	return
 }
 
+// SetPrivatekeyIfUnset sets privatekey to v, unless it is already set
+func (p *UploadCustomCertificateParams) SetPrivatekeyIfUnset(v string) {
+	if _, found := p.p["privatekey"]; !found {
+		p.SetPrivatekey(v)
+	}
+}
+
 // You should always use this function to get a new UploadCustomCertificateParams instance,
-// as then you are sure you have configured all required params
-func (s *CertificateService) NewUploadCustomCertificateParams(certificate string, domainsuffix string) *UploadCustomCertificateParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *CertificateService) NewUploadCustomCertificateParams(certificate string, domainsuffix string, opts ...func(*UploadCustomCertificateParams)) *UploadCustomCertificateParams {
 	p := &UploadCustomCertificateParams{}
 	p.p = make(map[string]interface{})
 	p.p["certificate"] = certificate
 	p.p["domainsuffix"] = domainsuffix
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Uploads a custom certificate for the console proxy VMs to use for SSL. Can be used to upload a single certificate signed by a known CA. Can also be used, through multiple calls, to upload a chain of certificates from CA to the custom certificate itself.
-func (s *CertificateService) UploadCustomCertificate(p *UploadCustomCertificateParams) (*UploadCustomCertificateResponse, error) {
-	resp, err := s.cs.newRequest("uploadCustomCertificate", p.toURLValues())
+func (s *CertificateService) UploadCustomCertificate(p *UploadCustomCertificateParams, opts ...OptionFunc) (*UploadCustomCertificateResponse, error) {
+	return s.UploadCustomCertificateWithContext(context.Background(), p, opts...)
+}
+
+// UploadCustomCertificateWithContext is the same as UploadCustomCertificate, but the request is cancelled when the context is done
+func (s *CertificateService) UploadCustomCertificateWithContext(ctx context.Context, p *UploadCustomCertificateParams, opts ...OptionFunc) (*UploadCustomCertificateResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.UploadCustomCertificateRawWithContext(ctx, p.toURLValues())
+}
+
+// UploadCustomCertificateRaw is the same as UploadCustomCertificate, but takes the params as url.Values instead of typed params
+func (s *CertificateService) UploadCustomCertificateRaw(v url.Values) (*UploadCustomCertificateResponse, error) {
+	return s.UploadCustomCertificateRawWithContext(context.Background(), v)
+}
+
+// UploadCustomCertificateRawWithContext is the same as UploadCustomCertificateRaw, but the request is cancelled when the context is done
+func (s *CertificateService) UploadCustomCertificateRawWithContext(ctx context.Context, v url.Values) (*UploadCustomCertificateResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "uploadCustomCertificate", "uploadcustomcertificateresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -114,15 +200,15 @@ func (s *CertificateService) UploadCustomCertificate(p *UploadCustomCertificateP
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
+		b, err = getRawValueUnlessHas(b, "message")
 		if err != nil {
 			return nil, err
 		}
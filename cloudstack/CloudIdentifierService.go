@@ -17,7 +17,9 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
 	"net/url"
 )
 
@@ -25,6 +27,16 @@ type GetCloudIdentifierParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *GetCloudIdentifierParams) Clone() *GetCloudIdentifierParams {
+	c := &GetCloudIdentifierParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *GetCloudIdentifierParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -36,6 +48,16 @@ func (p *GetCloudIdentifierParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *GetCloudIdentifierParams) validate() error {
+	for _, k := range []string{"userid"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of getCloudIdentifier", k)
+		}
+	}
+	return nil
+}
+
 func (p *GetCloudIdentifierParams) SetUserid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -44,18 +66,54 @@ func (p *GetCloudIdentifierParams) SetUserid(v string) {
 	return
 }
 
+// SetUseridIfUnset sets userid to v, unless it is already set
+func (p *GetCloudIdentifierParams) SetUseridIfUnset(v string) {
+	if _, found := p.p["userid"]; !found {
+		p.SetUserid(v)
+	}
+}
+
 // You should always use this function to get a new GetCloudIdentifierParams instance,
-// as then you are sure you have configured all required params
-func (s *CloudIdentifierService) NewGetCloudIdentifierParams(userid string) *GetCloudIdentifierParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *CloudIdentifierService) NewGetCloudIdentifierParams(userid string, opts ...func(*GetCloudIdentifierParams)) *GetCloudIdentifierParams {
 	p := &GetCloudIdentifierParams{}
 	p.p = make(map[string]interface{})
 	p.p["userid"] = userid
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Retrieves a cloud identifier.
-func (s *CloudIdentifierService) GetCloudIdentifier(p *GetCloudIdentifierParams) (*GetCloudIdentifierResponse, error) {
-	resp, err := s.cs.newRequest("getCloudIdentifier", p.toURLValues())
+func (s *CloudIdentifierService) GetCloudIdentifier(p *GetCloudIdentifierParams, opts ...OptionFunc) (*GetCloudIdentifierResponse, error) {
+	return s.GetCloudIdentifierWithContext(context.Background(), p, opts...)
+}
+
+// GetCloudIdentifierWithContext is the same as GetCloudIdentifier, but the request is cancelled when the context is done
+func (s *CloudIdentifierService) GetCloudIdentifierWithContext(ctx context.Context, p *GetCloudIdentifierParams, opts ...OptionFunc) (*GetCloudIdentifierResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.GetCloudIdentifierRawWithContext(ctx, p.toURLValues())
+}
+
+// GetCloudIdentifierRaw is the same as GetCloudIdentifier, but takes the params as url.Values instead of typed params
+func (s *CloudIdentifierService) GetCloudIdentifierRaw(v url.Values) (*GetCloudIdentifierResponse, error) {
+	return s.GetCloudIdentifierRawWithContext(context.Background(), v)
+}
+
+// GetCloudIdentifierRawWithContext is the same as GetCloudIdentifierRaw, but the request is cancelled when the context is done
+func (s *CloudIdentifierService) GetCloudIdentifierRawWithContext(ctx context.Context, v url.Values) (*GetCloudIdentifierResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "getCloudIdentifier", "getcloudidentifierresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -17,17 +17,67 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/url"
 	"strconv"
 	"strings"
+	"time"
 )
 
+// UpdateMany runs UpdateCluster for all given IDs in parallel, using at most concurrency concurrent requests.
+// For each ID the params are populated with the ID and then passed to mutate, which should set the
+// changes to apply. The result contains the updated resources (in the order of the IDs) and the error
+// of every update that failed by ID. The returned error contains all failures, or is nil if none failed.
+// The updated resources are returned as the UpdateClusterResponse of every update, which contains the same
+// fields as the resource itself, so no additional request is needed per resource to get them. The
+// options are used for every update.
+func (s *ClusterService) UpdateMany(ids []string, mutate func(*UpdateClusterParams), concurrency int, opts ...OptionFunc) (BulkResult[*UpdateClusterResponse], error) {
+	rs := make([]*UpdateClusterResponse, len(ids))
+	errs := make([]error, len(ids))
+
+	runConcurrently(len(ids), concurrency, func(i int) {
+		p := &UpdateClusterParams{p: map[string]interface{}{"id": ids[i]}}
+		if mutate != nil {
+			mutate(p)
+		}
+
+		r, err := s.UpdateCluster(p, opts...)
+		if err != nil {
+			errs[i] = err
+			return
+		}
+		rs[i] = r
+	})
+
+	var r BulkResult[*UpdateClusterResponse]
+	for i, id := range ids {
+		if errs[i] != nil {
+			r.fail(id, errs[i])
+			continue
+		}
+		r.Succeeded = append(r.Succeeded, rs[i])
+	}
+
+	return r, r.Err()
+}
+
 type AddClusterParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *AddClusterParams) Clone() *AddClusterParams {
+	c := &AddClusterParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *AddClusterParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -93,6 +143,16 @@ func (p *AddClusterParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *AddClusterParams) validate() error {
+	for _, k := range []string{"clustername", "clustertype", "hypervisor", "podid", "zoneid"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of addCluster", k)
+		}
+	}
+	return nil
+}
+
 func (p *AddClusterParams) SetAllocationstate(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -101,6 +161,13 @@ func (p *AddClusterParams) SetAllocationstate(v string) {
 	return
 }
 
+// SetAllocationstateIfUnset sets allocationstate to v, unless it is already set
+func (p *AddClusterParams) SetAllocationstateIfUnset(v string) {
+	if _, found := p.p["allocationstate"]; !found {
+		p.SetAllocationstate(v)
+	}
+}
+
 func (p *AddClusterParams) SetClustername(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -109,6 +176,13 @@ func (p *AddClusterParams) SetClustername(v string) {
 	return
 }
 
+// SetClusternameIfUnset sets clustername to v, unless it is already set
+func (p *AddClusterParams) SetClusternameIfUnset(v string) {
+	if _, found := p.p["clustername"]; !found {
+		p.SetClustername(v)
+	}
+}
+
 func (p *AddClusterParams) SetClustertype(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -117,6 +191,13 @@ func (p *AddClusterParams) SetClustertype(v string) {
 	return
 }
 
+// SetClustertypeIfUnset sets clustertype to v, unless it is already set
+func (p *AddClusterParams) SetClustertypeIfUnset(v string) {
+	if _, found := p.p["clustertype"]; !found {
+		p.SetClustertype(v)
+	}
+}
+
 func (p *AddClusterParams) SetGuestvswitchname(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -125,6 +206,13 @@ func (p *AddClusterParams) SetGuestvswitchname(v string) {
 	return
 }
 
+// SetGuestvswitchnameIfUnset sets guestvswitchname to v, unless it is already set
+func (p *AddClusterParams) SetGuestvswitchnameIfUnset(v string) {
+	if _, found := p.p["guestvswitchname"]; !found {
+		p.SetGuestvswitchname(v)
+	}
+}
+
 func (p *AddClusterParams) SetGuestvswitchtype(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -133,14 +221,28 @@ func (p *AddClusterParams) SetGuestvswitchtype(v string) {
 	return
 }
 
-func (p *AddClusterParams) SetHypervisor(v string) {
+// SetGuestvswitchtypeIfUnset sets guestvswitchtype to v, unless it is already set
+func (p *AddClusterParams) SetGuestvswitchtypeIfUnset(v string) {
+	if _, found := p.p["guestvswitchtype"]; !found {
+		p.SetGuestvswitchtype(v)
+	}
+}
+
+func (p *AddClusterParams) SetHypervisor(v HypervisorType) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	p.p["hypervisor"] = v
+	p.p["hypervisor"] = string(v)
 	return
 }
 
+// SetHypervisorIfUnset sets hypervisor to v, unless it is already set
+func (p *AddClusterParams) SetHypervisorIfUnset(v HypervisorType) {
+	if _, found := p.p["hypervisor"]; !found {
+		p.SetHypervisor(v)
+	}
+}
+
 func (p *AddClusterParams) SetOvm3cluster(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -149,6 +251,13 @@ func (p *AddClusterParams) SetOvm3cluster(v string) {
 	return
 }
 
+// SetOvm3clusterIfUnset sets ovm3cluster to v, unless it is already set
+func (p *AddClusterParams) SetOvm3clusterIfUnset(v string) {
+	if _, found := p.p["ovm3cluster"]; !found {
+		p.SetOvm3cluster(v)
+	}
+}
+
 func (p *AddClusterParams) SetOvm3pool(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -157,6 +266,13 @@ func (p *AddClusterParams) SetOvm3pool(v string) {
 	return
 }
 
+// SetOvm3poolIfUnset sets ovm3pool to v, unless it is already set
+func (p *AddClusterParams) SetOvm3poolIfUnset(v string) {
+	if _, found := p.p["ovm3pool"]; !found {
+		p.SetOvm3pool(v)
+	}
+}
+
 func (p *AddClusterParams) SetOvm3vip(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -165,6 +281,13 @@ func (p *AddClusterParams) SetOvm3vip(v string) {
 	return
 }
 
+// SetOvm3vipIfUnset sets ovm3vip to v, unless it is already set
+func (p *AddClusterParams) SetOvm3vipIfUnset(v string) {
+	if _, found := p.p["ovm3vip"]; !found {
+		p.SetOvm3vip(v)
+	}
+}
+
 func (p *AddClusterParams) SetPassword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -173,6 +296,13 @@ func (p *AddClusterParams) SetPassword(v string) {
 	return
 }
 
+// SetPasswordIfUnset sets password to v, unless it is already set
+func (p *AddClusterParams) SetPasswordIfUnset(v string) {
+	if _, found := p.p["password"]; !found {
+		p.SetPassword(v)
+	}
+}
+
 func (p *AddClusterParams) SetPodid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -181,6 +311,13 @@ func (p *AddClusterParams) SetPodid(v string) {
 	return
 }
 
+// SetPodidIfUnset sets podid to v, unless it is already set
+func (p *AddClusterParams) SetPodidIfUnset(v string) {
+	if _, found := p.p["podid"]; !found {
+		p.SetPodid(v)
+	}
+}
+
 func (p *AddClusterParams) SetPublicvswitchname(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -189,6 +326,13 @@ func (p *AddClusterParams) SetPublicvswitchname(v string) {
 	return
 }
 
+// SetPublicvswitchnameIfUnset sets publicvswitchname to v, unless it is already set
+func (p *AddClusterParams) SetPublicvswitchnameIfUnset(v string) {
+	if _, found := p.p["publicvswitchname"]; !found {
+		p.SetPublicvswitchname(v)
+	}
+}
+
 func (p *AddClusterParams) SetPublicvswitchtype(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -197,6 +341,13 @@ func (p *AddClusterParams) SetPublicvswitchtype(v string) {
 	return
 }
 
+// SetPublicvswitchtypeIfUnset sets publicvswitchtype to v, unless it is already set
+func (p *AddClusterParams) SetPublicvswitchtypeIfUnset(v string) {
+	if _, found := p.p["publicvswitchtype"]; !found {
+		p.SetPublicvswitchtype(v)
+	}
+}
+
 func (p *AddClusterParams) SetUrl(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -205,6 +356,13 @@ func (p *AddClusterParams) SetUrl(v string) {
 	return
 }
 
+// SetUrlIfUnset sets url to v, unless it is already set
+func (p *AddClusterParams) SetUrlIfUnset(v string) {
+	if _, found := p.p["url"]; !found {
+		p.SetUrl(v)
+	}
+}
+
 func (p *AddClusterParams) SetUsername(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -213,6 +371,13 @@ func (p *AddClusterParams) SetUsername(v string) {
 	return
 }
 
+// SetUsernameIfUnset sets username to v, unless it is already set
+func (p *AddClusterParams) SetUsernameIfUnset(v string) {
+	if _, found := p.p["username"]; !found {
+		p.SetUsername(v)
+	}
+}
+
 func (p *AddClusterParams) SetVsmipaddress(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -221,6 +386,13 @@ func (p *AddClusterParams) SetVsmipaddress(v string) {
 	return
 }
 
+// SetVsmipaddressIfUnset sets vsmipaddress to v, unless it is already set
+func (p *AddClusterParams) SetVsmipaddressIfUnset(v string) {
+	if _, found := p.p["vsmipaddress"]; !found {
+		p.SetVsmipaddress(v)
+	}
+}
+
 func (p *AddClusterParams) SetVsmpassword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -229,6 +401,13 @@ func (p *AddClusterParams) SetVsmpassword(v string) {
 	return
 }
 
+// SetVsmpasswordIfUnset sets vsmpassword to v, unless it is already set
+func (p *AddClusterParams) SetVsmpasswordIfUnset(v string) {
+	if _, found := p.p["vsmpassword"]; !found {
+		p.SetVsmpassword(v)
+	}
+}
+
 func (p *AddClusterParams) SetVsmusername(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -237,6 +416,13 @@ func (p *AddClusterParams) SetVsmusername(v string) {
 	return
 }
 
+// SetVsmusernameIfUnset sets vsmusername to v, unless it is already set
+func (p *AddClusterParams) SetVsmusernameIfUnset(v string) {
+	if _, found := p.p["vsmusername"]; !found {
+		p.SetVsmusername(v)
+	}
+}
+
 func (p *AddClusterParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -245,9 +431,17 @@ func (p *AddClusterParams) SetZoneid(v string) {
 	return
 }
 
+// SetZoneidIfUnset sets zoneid to v, unless it is already set
+func (p *AddClusterParams) SetZoneidIfUnset(v string) {
+	if _, found := p.p["zoneid"]; !found {
+		p.SetZoneid(v)
+	}
+}
+
 // You should always use this function to get a new AddClusterParams instance,
-// as then you are sure you have configured all required params
-func (s *ClusterService) NewAddClusterParams(clustername string, clustertype string, hypervisor string, podid string, zoneid string) *AddClusterParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *ClusterService) NewAddClusterParams(clustername string, clustertype string, hypervisor string, podid string, zoneid string, opts ...func(*AddClusterParams)) *AddClusterParams {
 	p := &AddClusterParams{}
 	p.p = make(map[string]interface{})
 	p.p["clustername"] = clustername
@@ -255,12 +449,113 @@ func (s *ClusterService) NewAddClusterParams(clustername string, clustertype str
 	p.p["hypervisor"] = hypervisor
 	p.p["podid"] = podid
 	p.p["zoneid"] = zoneid
+	for _, fn := range opts {
+		fn(p)
+	}
+	return p
+}
+
+// AddClusterOpts contains all params that can be set on a AddClusterParams.
+// Optional params are pointers (or slices and maps) which are only set when not nil.
+type AddClusterOpts struct {
+	Allocationstate   *string
+	Clustername       string
+	Clustertype       string
+	Guestvswitchname  *string
+	Guestvswitchtype  *string
+	Hypervisor        HypervisorType
+	Ovm3cluster       *string
+	Ovm3pool          *string
+	Ovm3vip           *string
+	Password          *string
+	Podid             string
+	Publicvswitchname *string
+	Publicvswitchtype *string
+	Url               *string
+	Username          *string
+	Vsmipaddress      *string
+	Vsmpassword       *string
+	Vsmusername       *string
+	Zoneid            string
+}
+
+// NewAddClusterParamsFromOpts is an alternative for NewAddClusterParams which takes all params using
+// an options struct, as that is easier to read for APIs with many required params
+func (s *ClusterService) NewAddClusterParamsFromOpts(o AddClusterOpts) *AddClusterParams {
+	p := s.NewAddClusterParams(o.Clustername, o.Clustertype, string(o.Hypervisor), o.Podid, o.Zoneid)
+	if o.Allocationstate != nil {
+		p.p["allocationstate"] = *o.Allocationstate
+	}
+	if o.Guestvswitchname != nil {
+		p.p["guestvswitchname"] = *o.Guestvswitchname
+	}
+	if o.Guestvswitchtype != nil {
+		p.p["guestvswitchtype"] = *o.Guestvswitchtype
+	}
+	if o.Ovm3cluster != nil {
+		p.p["ovm3cluster"] = *o.Ovm3cluster
+	}
+	if o.Ovm3pool != nil {
+		p.p["ovm3pool"] = *o.Ovm3pool
+	}
+	if o.Ovm3vip != nil {
+		p.p["ovm3vip"] = *o.Ovm3vip
+	}
+	if o.Password != nil {
+		p.p["password"] = *o.Password
+	}
+	if o.Publicvswitchname != nil {
+		p.p["publicvswitchname"] = *o.Publicvswitchname
+	}
+	if o.Publicvswitchtype != nil {
+		p.p["publicvswitchtype"] = *o.Publicvswitchtype
+	}
+	if o.Url != nil {
+		p.p["url"] = *o.Url
+	}
+	if o.Username != nil {
+		p.p["username"] = *o.Username
+	}
+	if o.Vsmipaddress != nil {
+		p.p["vsmipaddress"] = *o.Vsmipaddress
+	}
+	if o.Vsmpassword != nil {
+		p.p["vsmpassword"] = *o.Vsmpassword
+	}
+	if o.Vsmusername != nil {
+		p.p["vsmusername"] = *o.Vsmusername
+	}
 	return p
 }
 
 // Adds a new cluster
-func (s *ClusterService) AddCluster(p *AddClusterParams) (*AddClusterResponse, error) {
-	resp, err := s.cs.newRequest("addCluster", p.toURLValues())
+func (s *ClusterService) AddCluster(p *AddClusterParams, opts ...OptionFunc) (*AddClusterResponse, error) {
+	return s.AddClusterWithContext(context.Background(), p, opts...)
+}
+
+// AddClusterWithContext is the same as AddCluster, but the request is cancelled when the context is done
+func (s *ClusterService) AddClusterWithContext(ctx context.Context, p *AddClusterParams, opts ...OptionFunc) (*AddClusterResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.AddClusterRawWithContext(ctx, p.toURLValues())
+}
+
+// AddClusterRaw is the same as AddCluster, but takes the params as url.Values instead of typed params
+func (s *ClusterService) AddClusterRaw(v url.Values) (*AddClusterResponse, error) {
+	return s.AddClusterRawWithContext(context.Background(), v)
+}
+
+// AddClusterRawWithContext is the same as AddClusterRaw, but the request is cancelled when the context is done
+func (s *ClusterService) AddClusterRawWithContext(ctx context.Context, v url.Values) (*AddClusterResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "addCluster", "addclusterresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -287,25 +582,35 @@ type AddClusterResponse struct {
 		Zoneid        string `json:"zoneid"`
 		Zonename      string `json:"zonename"`
 	} `json:"capacity"`
-	Clustertype           string            `json:"clustertype"`
-	Cpuovercommitratio    string            `json:"cpuovercommitratio"`
-	Hypervisortype        string            `json:"hypervisortype"`
-	Id                    string            `json:"id"`
-	Managedstate          string            `json:"managedstate"`
-	Memoryovercommitratio string            `json:"memoryovercommitratio"`
-	Name                  string            `json:"name"`
-	Ovm3vip               string            `json:"ovm3vip"`
-	Podid                 string            `json:"podid"`
-	Podname               string            `json:"podname"`
-	Resourcedetails       map[string]string `json:"resourcedetails"`
-	Zoneid                string            `json:"zoneid"`
-	Zonename              string            `json:"zonename"`
+	Clustertype           string    `json:"clustertype"`
+	Cpuovercommitratio    string    `json:"cpuovercommitratio"`
+	Hypervisortype        string    `json:"hypervisortype"`
+	Id                    string    `json:"id"`
+	Managedstate          string    `json:"managedstate"`
+	Memoryovercommitratio string    `json:"memoryovercommitratio"`
+	Name                  string    `json:"name"`
+	Ovm3vip               string    `json:"ovm3vip"`
+	Podid                 string    `json:"podid"`
+	Podname               string    `json:"podname"`
+	Resourcedetails       StringMap `json:"resourcedetails"`
+	Zoneid                string    `json:"zoneid"`
+	Zonename              string    `json:"zonename"`
 }
 
 type DedicateClusterParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *DedicateClusterParams) Clone() *DedicateClusterParams {
+	c := &DedicateClusterParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DedicateClusterParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -323,6 +628,16 @@ func (p *DedicateClusterParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *DedicateClusterParams) validate() error {
+	for _, k := range []string{"clusterid", "domainid"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of dedicateCluster", k)
+		}
+	}
+	return nil
+}
+
 func (p *DedicateClusterParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -331,6 +646,13 @@ func (p *DedicateClusterParams) SetAccount(v string) {
 	return
 }
 
+// SetAccountIfUnset sets account to v, unless it is already set
+func (p *DedicateClusterParams) SetAccountIfUnset(v string) {
+	if _, found := p.p["account"]; !found {
+		p.SetAccount(v)
+	}
+}
+
 func (p *DedicateClusterParams) SetClusterid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -339,6 +661,13 @@ func (p *DedicateClusterParams) SetClusterid(v string) {
 	return
 }
 
+// SetClusteridIfUnset sets clusterid to v, unless it is already set
+func (p *DedicateClusterParams) SetClusteridIfUnset(v string) {
+	if _, found := p.p["clusterid"]; !found {
+		p.SetClusterid(v)
+	}
+}
+
 func (p *DedicateClusterParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -347,19 +676,55 @@ func (p *DedicateClusterParams) SetDomainid(v string) {
 	return
 }
 
+// SetDomainidIfUnset sets domainid to v, unless it is already set
+func (p *DedicateClusterParams) SetDomainidIfUnset(v string) {
+	if _, found := p.p["domainid"]; !found {
+		p.SetDomainid(v)
+	}
+}
+
 // You should always use this function to get a new DedicateClusterParams instance,
-// as then you are sure you have configured all required params
-func (s *ClusterService) NewDedicateClusterParams(clusterid string, domainid string) *DedicateClusterParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *ClusterService) NewDedicateClusterParams(clusterid string, domainid string, opts ...func(*DedicateClusterParams)) *DedicateClusterParams {
 	p := &DedicateClusterParams{}
 	p.p = make(map[string]interface{})
 	p.p["clusterid"] = clusterid
 	p.p["domainid"] = domainid
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Dedicate an existing cluster
-func (s *ClusterService) DedicateCluster(p *DedicateClusterParams) (*DedicateClusterResponse, error) {
-	resp, err := s.cs.newRequest("dedicateCluster", p.toURLValues())
+func (s *ClusterService) DedicateCluster(p *DedicateClusterParams, opts ...OptionFunc) (*DedicateClusterResponse, error) {
+	return s.DedicateClusterWithContext(context.Background(), p, opts...)
+}
+
+// DedicateClusterWithContext is the same as DedicateCluster, but the request is cancelled when the context is done
+func (s *ClusterService) DedicateClusterWithContext(ctx context.Context, p *DedicateClusterParams, opts ...OptionFunc) (*DedicateClusterResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.DedicateClusterRawWithContext(ctx, p.toURLValues())
+}
+
+// DedicateClusterRaw is the same as DedicateCluster, but takes the params as url.Values instead of typed params
+func (s *ClusterService) DedicateClusterRaw(v url.Values) (*DedicateClusterResponse, error) {
+	return s.DedicateClusterRawWithContext(context.Background(), v)
+}
+
+// DedicateClusterRawWithContext is the same as DedicateClusterRaw, but the request is cancelled when the context is done
+func (s *ClusterService) DedicateClusterRawWithContext(ctx context.Context, v url.Values) (*DedicateClusterResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "dedicateCluster", "dedicateclusterresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -371,9 +736,9 @@ func (s *ClusterService) DedicateCluster(p *DedicateClusterParams) (*DedicateClu
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -406,6 +771,16 @@ type DeleteClusterParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *DeleteClusterParams) Clone() *DeleteClusterParams {
+	c := &DeleteClusterParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeleteClusterParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -417,6 +792,16 @@ func (p *DeleteClusterParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *DeleteClusterParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of deleteCluster", k)
+		}
+	}
+	return nil
+}
+
 func (p *DeleteClusterParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -425,18 +810,54 @@ func (p *DeleteClusterParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *DeleteClusterParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 // You should always use this function to get a new DeleteClusterParams instance,
-// as then you are sure you have configured all required params
-func (s *ClusterService) NewDeleteClusterParams(id string) *DeleteClusterParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *ClusterService) NewDeleteClusterParams(id string, opts ...func(*DeleteClusterParams)) *DeleteClusterParams {
 	p := &DeleteClusterParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Deletes a cluster.
-func (s *ClusterService) DeleteCluster(p *DeleteClusterParams) (*DeleteClusterResponse, error) {
-	resp, err := s.cs.newRequest("deleteCluster", p.toURLValues())
+func (s *ClusterService) DeleteCluster(p *DeleteClusterParams, opts ...OptionFunc) (*DeleteClusterResponse, error) {
+	return s.DeleteClusterWithContext(context.Background(), p, opts...)
+}
+
+// DeleteClusterWithContext is the same as DeleteCluster, but the request is cancelled when the context is done
+func (s *ClusterService) DeleteClusterWithContext(ctx context.Context, p *DeleteClusterParams, opts ...OptionFunc) (*DeleteClusterResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.DeleteClusterRawWithContext(ctx, p.toURLValues())
+}
+
+// DeleteClusterRaw is the same as DeleteCluster, but takes the params as url.Values instead of typed params
+func (s *ClusterService) DeleteClusterRaw(v url.Values) (*DeleteClusterResponse, error) {
+	return s.DeleteClusterRawWithContext(context.Background(), v)
+}
+
+// DeleteClusterRawWithContext is the same as DeleteClusterRaw, but the request is cancelled when the context is done
+func (s *ClusterService) DeleteClusterRawWithContext(ctx context.Context, v url.Values) (*DeleteClusterResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteCluster", "deleteclusterresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -449,6 +870,34 @@ func (s *ClusterService) DeleteCluster(p *DeleteClusterParams) (*DeleteClusterRe
 	return &r, nil
 }
 
+// WaitForClusterDeleted polls until the Cluster with the given ID no longer exists, the timeout
+// expires or the context is cancelled. Deleted resources may still be listed for a short while
+// after the delete command finished, so this can be used to make sure they are really gone. The
+// options are passed to GetClusterByID, so a resource outside of the default scope can be found.
+func (s *ClusterService) WaitForClusterDeleted(ctx context.Context, id string, timeout time.Duration, opts ...OptionFunc) error {
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	for {
+		_, count, err := s.GetClusterByID(id, opts...)
+		if count == 0 {
+			return nil
+		}
+		if count < 0 {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(2 * time.Second):
+		}
+	}
+}
+
 type DeleteClusterResponse struct {
 	Displaytext string `json:"displaytext"`
 	Success     bool   `json:"success"`
@@ -477,6 +926,16 @@ type DisableOutOfBandManagementForClusterParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *DisableOutOfBandManagementForClusterParams) Clone() *DisableOutOfBandManagementForClusterParams {
+	c := &DisableOutOfBandManagementForClusterParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DisableOutOfBandManagementForClusterParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -488,6 +947,16 @@ func (p *DisableOutOfBandManagementForClusterParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *DisableOutOfBandManagementForClusterParams) validate() error {
+	for _, k := range []string{"clusterid"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of disableOutOfBandManagementForCluster", k)
+		}
+	}
+	return nil
+}
+
 func (p *DisableOutOfBandManagementForClusterParams) SetClusterid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -496,18 +965,54 @@ func (p *DisableOutOfBandManagementForClusterParams) SetClusterid(v string) {
 	return
 }
 
+// SetClusteridIfUnset sets clusterid to v, unless it is already set
+func (p *DisableOutOfBandManagementForClusterParams) SetClusteridIfUnset(v string) {
+	if _, found := p.p["clusterid"]; !found {
+		p.SetClusterid(v)
+	}
+}
+
 // You should always use this function to get a new DisableOutOfBandManagementForClusterParams instance,
-// as then you are sure you have configured all required params
-func (s *ClusterService) NewDisableOutOfBandManagementForClusterParams(clusterid string) *DisableOutOfBandManagementForClusterParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *ClusterService) NewDisableOutOfBandManagementForClusterParams(clusterid string, opts ...func(*DisableOutOfBandManagementForClusterParams)) *DisableOutOfBandManagementForClusterParams {
 	p := &DisableOutOfBandManagementForClusterParams{}
 	p.p = make(map[string]interface{})
 	p.p["clusterid"] = clusterid
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Disables out-of-band management for a cluster
-func (s *ClusterService) DisableOutOfBandManagementForCluster(p *DisableOutOfBandManagementForClusterParams) (*DisableOutOfBandManagementForClusterResponse, error) {
-	resp, err := s.cs.newRequest("disableOutOfBandManagementForCluster", p.toURLValues())
+func (s *ClusterService) DisableOutOfBandManagementForCluster(p *DisableOutOfBandManagementForClusterParams, opts ...OptionFunc) (*DisableOutOfBandManagementForClusterResponse, error) {
+	return s.DisableOutOfBandManagementForClusterWithContext(context.Background(), p, opts...)
+}
+
+// DisableOutOfBandManagementForClusterWithContext is the same as DisableOutOfBandManagementForCluster, but the request is cancelled when the context is done
+func (s *ClusterService) DisableOutOfBandManagementForClusterWithContext(ctx context.Context, p *DisableOutOfBandManagementForClusterParams, opts ...OptionFunc) (*DisableOutOfBandManagementForClusterResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.DisableOutOfBandManagementForClusterRawWithContext(ctx, p.toURLValues())
+}
+
+// DisableOutOfBandManagementForClusterRaw is the same as DisableOutOfBandManagementForCluster, but takes the params as url.Values instead of typed params
+func (s *ClusterService) DisableOutOfBandManagementForClusterRaw(v url.Values) (*DisableOutOfBandManagementForClusterResponse, error) {
+	return s.DisableOutOfBandManagementForClusterRawWithContext(context.Background(), v)
+}
+
+// DisableOutOfBandManagementForClusterRawWithContext is the same as DisableOutOfBandManagementForClusterRaw, but the request is cancelled when the context is done
+func (s *ClusterService) DisableOutOfBandManagementForClusterRawWithContext(ctx context.Context, v url.Values) (*DisableOutOfBandManagementForClusterResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "disableOutOfBandManagementForCluster", "disableoutofbandmanagementforclusterresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -519,9 +1024,9 @@ func (s *ClusterService) DisableOutOfBandManagementForCluster(p *DisableOutOfBan
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -559,6 +1064,16 @@ type EnableOutOfBandManagementForClusterParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *EnableOutOfBandManagementForClusterParams) Clone() *EnableOutOfBandManagementForClusterParams {
+	c := &EnableOutOfBandManagementForClusterParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *EnableOutOfBandManagementForClusterParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -570,6 +1085,16 @@ func (p *EnableOutOfBandManagementForClusterParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *EnableOutOfBandManagementForClusterParams) validate() error {
+	for _, k := range []string{"clusterid"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of enableOutOfBandManagementForCluster", k)
+		}
+	}
+	return nil
+}
+
 func (p *EnableOutOfBandManagementForClusterParams) SetClusterid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -578,18 +1103,54 @@ func (p *EnableOutOfBandManagementForClusterParams) SetClusterid(v string) {
 	return
 }
 
+// SetClusteridIfUnset sets clusterid to v, unless it is already set
+func (p *EnableOutOfBandManagementForClusterParams) SetClusteridIfUnset(v string) {
+	if _, found := p.p["clusterid"]; !found {
+		p.SetClusterid(v)
+	}
+}
+
 // You should always use this function to get a new EnableOutOfBandManagementForClusterParams instance,
-// as then you are sure you have configured all required params
-func (s *ClusterService) NewEnableOutOfBandManagementForClusterParams(clusterid string) *EnableOutOfBandManagementForClusterParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *ClusterService) NewEnableOutOfBandManagementForClusterParams(clusterid string, opts ...func(*EnableOutOfBandManagementForClusterParams)) *EnableOutOfBandManagementForClusterParams {
 	p := &EnableOutOfBandManagementForClusterParams{}
 	p.p = make(map[string]interface{})
 	p.p["clusterid"] = clusterid
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Enables out-of-band management for a cluster
-func (s *ClusterService) EnableOutOfBandManagementForCluster(p *EnableOutOfBandManagementForClusterParams) (*EnableOutOfBandManagementForClusterResponse, error) {
-	resp, err := s.cs.newRequest("enableOutOfBandManagementForCluster", p.toURLValues())
+func (s *ClusterService) EnableOutOfBandManagementForCluster(p *EnableOutOfBandManagementForClusterParams, opts ...OptionFunc) (*EnableOutOfBandManagementForClusterResponse, error) {
+	return s.EnableOutOfBandManagementForClusterWithContext(context.Background(), p, opts...)
+}
+
+// EnableOutOfBandManagementForClusterWithContext is the same as EnableOutOfBandManagementForCluster, but the request is cancelled when the context is done
+func (s *ClusterService) EnableOutOfBandManagementForClusterWithContext(ctx context.Context, p *EnableOutOfBandManagementForClusterParams, opts ...OptionFunc) (*EnableOutOfBandManagementForClusterResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.EnableOutOfBandManagementForClusterRawWithContext(ctx, p.toURLValues())
+}
+
+// EnableOutOfBandManagementForClusterRaw is the same as EnableOutOfBandManagementForCluster, but takes the params as url.Values instead of typed params
+func (s *ClusterService) EnableOutOfBandManagementForClusterRaw(v url.Values) (*EnableOutOfBandManagementForClusterResponse, error) {
+	return s.EnableOutOfBandManagementForClusterRawWithContext(context.Background(), v)
+}
+
+// EnableOutOfBandManagementForClusterRawWithContext is the same as EnableOutOfBandManagementForClusterRaw, but the request is cancelled when the context is done
+func (s *ClusterService) EnableOutOfBandManagementForClusterRawWithContext(ctx context.Context, v url.Values) (*EnableOutOfBandManagementForClusterResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "enableOutOfBandManagementForCluster", "enableoutofbandmanagementforclusterresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -601,9 +1162,9 @@ func (s *ClusterService) EnableOutOfBandManagementForCluster(p *EnableOutOfBandM
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -641,6 +1202,16 @@ type ListClustersParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ListClustersParams) Clone() *ListClustersParams {
+	c := &ListClustersParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListClustersParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -696,6 +1267,13 @@ func (p *ListClustersParams) SetAllocationstate(v string) {
 	return
 }
 
+// SetAllocationstateIfUnset sets allocationstate to v, unless it is already set
+func (p *ListClustersParams) SetAllocationstateIfUnset(v string) {
+	if _, found := p.p["allocationstate"]; !found {
+		p.SetAllocationstate(v)
+	}
+}
+
 func (p *ListClustersParams) SetClustertype(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -704,14 +1282,28 @@ func (p *ListClustersParams) SetClustertype(v string) {
 	return
 }
 
-func (p *ListClustersParams) SetHypervisor(v string) {
+// SetClustertypeIfUnset sets clustertype to v, unless it is already set
+func (p *ListClustersParams) SetClustertypeIfUnset(v string) {
+	if _, found := p.p["clustertype"]; !found {
+		p.SetClustertype(v)
+	}
+}
+
+func (p *ListClustersParams) SetHypervisor(v HypervisorType) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	p.p["hypervisor"] = v
+	p.p["hypervisor"] = string(v)
 	return
 }
 
+// SetHypervisorIfUnset sets hypervisor to v, unless it is already set
+func (p *ListClustersParams) SetHypervisorIfUnset(v HypervisorType) {
+	if _, found := p.p["hypervisor"]; !found {
+		p.SetHypervisor(v)
+	}
+}
+
 func (p *ListClustersParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -720,6 +1312,13 @@ func (p *ListClustersParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *ListClustersParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 func (p *ListClustersParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -728,6 +1327,13 @@ func (p *ListClustersParams) SetKeyword(v string) {
 	return
 }
 
+// SetKeywordIfUnset sets keyword to v, unless it is already set
+func (p *ListClustersParams) SetKeywordIfUnset(v string) {
+	if _, found := p.p["keyword"]; !found {
+		p.SetKeyword(v)
+	}
+}
+
 func (p *ListClustersParams) SetManagedstate(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -736,6 +1342,13 @@ func (p *ListClustersParams) SetManagedstate(v string) {
 	return
 }
 
+// SetManagedstateIfUnset sets managedstate to v, unless it is already set
+func (p *ListClustersParams) SetManagedstateIfUnset(v string) {
+	if _, found := p.p["managedstate"]; !found {
+		p.SetManagedstate(v)
+	}
+}
+
 func (p *ListClustersParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -744,6 +1357,13 @@ func (p *ListClustersParams) SetName(v string) {
 	return
 }
 
+// SetNameIfUnset sets name to v, unless it is already set
+func (p *ListClustersParams) SetNameIfUnset(v string) {
+	if _, found := p.p["name"]; !found {
+		p.SetName(v)
+	}
+}
+
 func (p *ListClustersParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -752,6 +1372,13 @@ func (p *ListClustersParams) SetPage(v int) {
 	return
 }
 
+// SetPageIfUnset sets page to v, unless it is already set
+func (p *ListClustersParams) SetPageIfUnset(v int) {
+	if _, found := p.p["page"]; !found {
+		p.SetPage(v)
+	}
+}
+
 func (p *ListClustersParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -760,6 +1387,13 @@ func (p *ListClustersParams) SetPagesize(v int) {
 	return
 }
 
+// SetPagesizeIfUnset sets pagesize to v, unless it is already set
+func (p *ListClustersParams) SetPagesizeIfUnset(v int) {
+	if _, found := p.p["pagesize"]; !found {
+		p.SetPagesize(v)
+	}
+}
+
 func (p *ListClustersParams) SetPodid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -768,6 +1402,13 @@ func (p *ListClustersParams) SetPodid(v string) {
 	return
 }
 
+// SetPodidIfUnset sets podid to v, unless it is already set
+func (p *ListClustersParams) SetPodidIfUnset(v string) {
+	if _, found := p.p["podid"]; !found {
+		p.SetPodid(v)
+	}
+}
+
 func (p *ListClustersParams) SetShowcapacities(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -776,6 +1417,13 @@ func (p *ListClustersParams) SetShowcapacities(v bool) {
 	return
 }
 
+// SetShowcapacitiesIfUnset sets showcapacities to v, unless it is already set
+func (p *ListClustersParams) SetShowcapacitiesIfUnset(v bool) {
+	if _, found := p.p["showcapacities"]; !found {
+		p.SetShowcapacities(v)
+	}
+}
+
 func (p *ListClustersParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -784,14 +1432,32 @@ func (p *ListClustersParams) SetZoneid(v string) {
 	return
 }
 
+// SetZoneidIfUnset sets zoneid to v, unless it is already set
+func (p *ListClustersParams) SetZoneidIfUnset(v string) {
+	if _, found := p.p["zoneid"]; !found {
+		p.SetZoneid(v)
+	}
+}
+
 // You should always use this function to get a new ListClustersParams instance,
-// as then you are sure you have configured all required params
-func (s *ClusterService) NewListClustersParams() *ListClustersParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *ClusterService) NewListClustersParams(opts ...func(*ListClustersParams)) *ListClustersParams {
 	p := &ListClustersParams{}
 	p.p = make(map[string]interface{})
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
+func (p *ListClustersParams) setFirstMatch() {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p[firstMatchKey] = true
+}
+
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *ClusterService) GetClusterID(name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListClustersParams{}
@@ -799,7 +1465,7 @@ func (s *ClusterService) GetClusterID(name string, opts ...OptionFunc) (string,
 
 	p.p["name"] = name
 
-	for _, fn := range append(s.cs.options, opts...) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
 		if err := fn(s.cs, p); err != nil {
 			return "", -1, err
 		}
@@ -811,7 +1477,7 @@ func (s *ClusterService) GetClusterID(name string, opts ...OptionFunc) (string,
 	}
 
 	if l.Count == 0 {
-		return "", l.Count, fmt.Errorf("No match found for %s: %+v", name, l)
+		return "", l.Count, fmt.Errorf("%w for %s: %+v", ErrNotFound, name, l)
 	}
 
 	if l.Count == 1 {
@@ -824,6 +1490,10 @@ func (s *ClusterService) GetClusterID(name string, opts ...OptionFunc) (string,
 				return v.Id, l.Count, nil
 			}
 		}
+
+		if _, ok := p.p[firstMatchKey]; ok && len(l.Clusters) > 0 {
+			return l.Clusters[0].Id, l.Count, nil
+		}
 	}
 	return "", l.Count, fmt.Errorf("Could not find an exact match for %s: %+v", name, l)
 }
@@ -849,7 +1519,7 @@ func (s *ClusterService) GetClusterByID(id string, opts ...OptionFunc) (*Cluster
 
 	p.p["id"] = id
 
-	for _, fn := range append(s.cs.options, opts...) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
 		if err := fn(s.cs, p); err != nil {
 			return nil, -1, err
 		}
@@ -860,24 +1530,56 @@ func (s *ClusterService) GetClusterByID(id string, opts ...OptionFunc) (*Cluster
 		if strings.Contains(err.Error(), fmt.Sprintf(
 			"Invalid parameter id value=%s due to incorrect long value format, "+
 				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+			return nil, 0, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
 	}
 
 	if l.Count == 1 {
 		return l.Clusters[0], l.Count, nil
 	}
+
+	if _, ok := p.p[firstMatchKey]; ok && len(l.Clusters) > 0 {
+		return l.Clusters[0], l.Count, nil
+	}
 	return nil, l.Count, fmt.Errorf("There is more then one result for Cluster UUID: %s!", id)
 }
 
+// ClusterExists returns true if a Cluster with the given ID exists. When no match is found
+// it returns false, while any other error is returned as is.
+func (s *ClusterService) ClusterExists(id string, opts ...OptionFunc) (bool, error) {
+	_, count, err := s.GetClusterByID(id, opts...)
+	if errors.Is(err, ErrNotFound) {
+		return false, nil
+	}
+	if count > 0 {
+		return true, nil
+	}
+	return false, err
+}
+
 // Lists clusters.
 func (s *ClusterService) ListClusters(p *ListClustersParams) (*ListClustersResponse, error) {
-	resp, err := s.cs.newRequest("listClusters", p.toURLValues())
+	return s.ListClustersWithContext(context.Background(), p)
+}
+
+// ListClustersWithContext is the same as ListClusters, but the request is cancelled when the context is done
+func (s *ClusterService) ListClustersWithContext(ctx context.Context, p *ListClustersParams) (*ListClustersResponse, error) {
+	return s.ListClustersRawWithContext(ctx, p.toURLValues())
+}
+
+// ListClustersRaw is the same as ListClusters, but takes the params as url.Values instead of typed params
+func (s *ClusterService) ListClustersRaw(v url.Values) (*ListClustersResponse, error) {
+	return s.ListClustersRawWithContext(context.Background(), v)
+}
+
+// ListClustersRawWithContext is the same as ListClustersRaw, but the request is cancelled when the context is done
+func (s *ClusterService) ListClustersRawWithContext(ctx context.Context, v url.Values) (*ListClustersResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "listClusters", "listclustersresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -890,6 +1592,93 @@ func (s *ClusterService) ListClusters(p *ListClustersParams) (*ListClustersRespo
 	return &r, nil
 }
 
+// StreamClusters fetches the results of ListClusters page by page in the background and sends them on the
+// returned channel. Both returned channels are closed when done. If fetching a page fails, or the context
+// is done before all results are sent, the error is sent on the error channel and fetching stops. A
+// request for a page in flight is cancelled when the context is done. The page and pagesize set in the
+// params are ignored.
+func (s *ClusterService) StreamClusters(ctx context.Context, p *ListClustersParams) (<-chan *Cluster, <-chan error) {
+	ch := make(chan *Cluster)
+	errs := make(chan error, 1)
+
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesize(500)
+
+	go func() {
+		defer close(errs)
+		defer close(ch)
+
+		for page, count := 1, 0; ; page++ {
+			pp.SetPage(page)
+
+			l, err := s.ListClustersWithContext(ctx, pp)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			for _, v := range l.Clusters {
+				select {
+				case ch <- v:
+				case <-ctx.Done():
+					errs <- ctx.Err()
+					return
+				}
+			}
+
+			count += len(l.Clusters)
+			if len(l.Clusters) < 500 || count >= l.Count {
+				return
+			}
+		}
+	}()
+
+	return ch, errs
+}
+
+// ListClustersWhere returns all results of ListClusters for which pred returns true. Note that the results are
+// filtered client-side, so all results matching the params are fetched first.
+// All pages are fetched, so the page and pagesize set in the params are ignored.
+func (s *ClusterService) ListClustersWhere(p *ListClustersParams, pred func(*Cluster) bool) ([]*Cluster, error) {
+	var r []*Cluster
+	ch, errs := s.StreamClusters(context.Background(), p)
+	for v := range ch {
+		if pred(v) {
+			r = append(r, v)
+		}
+	}
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ListAllClusters returns the results of ListClusters across all pages, by calling ListClusters page by page until all
+// results are fetched. The pagesize set in the params is used for every page (500 if not set), and the
+// page set in the params is ignored.
+func (s *ClusterService) ListAllClusters(p *ListClustersParams) ([]*Cluster, error) {
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesizeIfUnset(500)
+	pagesize := pp.p["pagesize"].(int)
+
+	var r []*Cluster
+	for page := 1; ; page++ {
+		pp.SetPage(page)
+
+		l, err := s.ListClusters(pp)
+		if err != nil {
+			return nil, err
+		}
+		r = append(r, l.Clusters...)
+
+		if len(l.Clusters) < pagesize || len(r) >= l.Count {
+			return r, nil
+		}
+	}
+}
+
 type ListClustersResponse struct {
 	Count    int        `json:"count"`
 	Clusters []*Cluster `json:"cluster"`
@@ -909,25 +1698,35 @@ type Cluster struct {
 		Zoneid        string `json:"zoneid"`
 		Zonename      string `json:"zonename"`
 	} `json:"capacity"`
-	Clustertype           string            `json:"clustertype"`
-	Cpuovercommitratio    string            `json:"cpuovercommitratio"`
-	Hypervisortype        string            `json:"hypervisortype"`
-	Id                    string            `json:"id"`
-	Managedstate          string            `json:"managedstate"`
-	Memoryovercommitratio string            `json:"memoryovercommitratio"`
-	Name                  string            `json:"name"`
-	Ovm3vip               string            `json:"ovm3vip"`
-	Podid                 string            `json:"podid"`
-	Podname               string            `json:"podname"`
-	Resourcedetails       map[string]string `json:"resourcedetails"`
-	Zoneid                string            `json:"zoneid"`
-	Zonename              string            `json:"zonename"`
+	Clustertype           string    `json:"clustertype"`
+	Cpuovercommitratio    string    `json:"cpuovercommitratio"`
+	Hypervisortype        string    `json:"hypervisortype"`
+	Id                    string    `json:"id"`
+	Managedstate          string    `json:"managedstate"`
+	Memoryovercommitratio string    `json:"memoryovercommitratio"`
+	Name                  string    `json:"name"`
+	Ovm3vip               string    `json:"ovm3vip"`
+	Podid                 string    `json:"podid"`
+	Podname               string    `json:"podname"`
+	Resourcedetails       StringMap `json:"resourcedetails"`
+	Zoneid                string    `json:"zoneid"`
+	Zonename              string    `json:"zonename"`
 }
 
 type ListDedicatedClustersParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ListDedicatedClustersParams) Clone() *ListDedicatedClustersParams {
+	c := &ListDedicatedClustersParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListDedicatedClustersParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -967,6 +1766,13 @@ func (p *ListDedicatedClustersParams) SetAccount(v string) {
 	return
 }
 
+// SetAccountIfUnset sets account to v, unless it is already set
+func (p *ListDedicatedClustersParams) SetAccountIfUnset(v string) {
+	if _, found := p.p["account"]; !found {
+		p.SetAccount(v)
+	}
+}
+
 func (p *ListDedicatedClustersParams) SetAffinitygroupid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -975,6 +1781,13 @@ func (p *ListDedicatedClustersParams) SetAffinitygroupid(v string) {
 	return
 }
 
+// SetAffinitygroupidIfUnset sets affinitygroupid to v, unless it is already set
+func (p *ListDedicatedClustersParams) SetAffinitygroupidIfUnset(v string) {
+	if _, found := p.p["affinitygroupid"]; !found {
+		p.SetAffinitygroupid(v)
+	}
+}
+
 func (p *ListDedicatedClustersParams) SetClusterid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -983,6 +1796,13 @@ func (p *ListDedicatedClustersParams) SetClusterid(v string) {
 	return
 }
 
+// SetClusteridIfUnset sets clusterid to v, unless it is already set
+func (p *ListDedicatedClustersParams) SetClusteridIfUnset(v string) {
+	if _, found := p.p["clusterid"]; !found {
+		p.SetClusterid(v)
+	}
+}
+
 func (p *ListDedicatedClustersParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -991,6 +1811,13 @@ func (p *ListDedicatedClustersParams) SetDomainid(v string) {
 	return
 }
 
+// SetDomainidIfUnset sets domainid to v, unless it is already set
+func (p *ListDedicatedClustersParams) SetDomainidIfUnset(v string) {
+	if _, found := p.p["domainid"]; !found {
+		p.SetDomainid(v)
+	}
+}
+
 func (p *ListDedicatedClustersParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -999,6 +1826,13 @@ func (p *ListDedicatedClustersParams) SetKeyword(v string) {
 	return
 }
 
+// SetKeywordIfUnset sets keyword to v, unless it is already set
+func (p *ListDedicatedClustersParams) SetKeywordIfUnset(v string) {
+	if _, found := p.p["keyword"]; !found {
+		p.SetKeyword(v)
+	}
+}
+
 func (p *ListDedicatedClustersParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1007,6 +1841,13 @@ func (p *ListDedicatedClustersParams) SetPage(v int) {
 	return
 }
 
+// SetPageIfUnset sets page to v, unless it is already set
+func (p *ListDedicatedClustersParams) SetPageIfUnset(v int) {
+	if _, found := p.p["page"]; !found {
+		p.SetPage(v)
+	}
+}
+
 func (p *ListDedicatedClustersParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1015,17 +1856,43 @@ func (p *ListDedicatedClustersParams) SetPagesize(v int) {
 	return
 }
 
+// SetPagesizeIfUnset sets pagesize to v, unless it is already set
+func (p *ListDedicatedClustersParams) SetPagesizeIfUnset(v int) {
+	if _, found := p.p["pagesize"]; !found {
+		p.SetPagesize(v)
+	}
+}
+
 // You should always use this function to get a new ListDedicatedClustersParams instance,
-// as then you are sure you have configured all required params
-func (s *ClusterService) NewListDedicatedClustersParams() *ListDedicatedClustersParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *ClusterService) NewListDedicatedClustersParams(opts ...func(*ListDedicatedClustersParams)) *ListDedicatedClustersParams {
 	p := &ListDedicatedClustersParams{}
 	p.p = make(map[string]interface{})
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Lists dedicated clusters.
 func (s *ClusterService) ListDedicatedClusters(p *ListDedicatedClustersParams) (*ListDedicatedClustersResponse, error) {
-	resp, err := s.cs.newRequest("listDedicatedClusters", p.toURLValues())
+	return s.ListDedicatedClustersWithContext(context.Background(), p)
+}
+
+// ListDedicatedClustersWithContext is the same as ListDedicatedClusters, but the request is cancelled when the context is done
+func (s *ClusterService) ListDedicatedClustersWithContext(ctx context.Context, p *ListDedicatedClustersParams) (*ListDedicatedClustersResponse, error) {
+	return s.ListDedicatedClustersRawWithContext(ctx, p.toURLValues())
+}
+
+// ListDedicatedClustersRaw is the same as ListDedicatedClusters, but takes the params as url.Values instead of typed params
+func (s *ClusterService) ListDedicatedClustersRaw(v url.Values) (*ListDedicatedClustersResponse, error) {
+	return s.ListDedicatedClustersRawWithContext(context.Background(), v)
+}
+
+// ListDedicatedClustersRawWithContext is the same as ListDedicatedClustersRaw, but the request is cancelled when the context is done
+func (s *ClusterService) ListDedicatedClustersRawWithContext(ctx context.Context, v url.Values) (*ListDedicatedClustersResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "listDedicatedClusters", "listdedicatedclustersresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -1038,6 +1905,93 @@ func (s *ClusterService) ListDedicatedClusters(p *ListDedicatedClustersParams) (
 	return &r, nil
 }
 
+// StreamDedicatedClusters fetches the results of ListDedicatedClusters page by page in the background and sends them on the
+// returned channel. Both returned channels are closed when done. If fetching a page fails, or the context
+// is done before all results are sent, the error is sent on the error channel and fetching stops. A
+// request for a page in flight is cancelled when the context is done. The page and pagesize set in the
+// params are ignored.
+func (s *ClusterService) StreamDedicatedClusters(ctx context.Context, p *ListDedicatedClustersParams) (<-chan *DedicatedCluster, <-chan error) {
+	ch := make(chan *DedicatedCluster)
+	errs := make(chan error, 1)
+
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesize(500)
+
+	go func() {
+		defer close(errs)
+		defer close(ch)
+
+		for page, count := 1, 0; ; page++ {
+			pp.SetPage(page)
+
+			l, err := s.ListDedicatedClustersWithContext(ctx, pp)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			for _, v := range l.DedicatedClusters {
+				select {
+				case ch <- v:
+				case <-ctx.Done():
+					errs <- ctx.Err()
+					return
+				}
+			}
+
+			count += len(l.DedicatedClusters)
+			if len(l.DedicatedClusters) < 500 || count >= l.Count {
+				return
+			}
+		}
+	}()
+
+	return ch, errs
+}
+
+// ListDedicatedClustersWhere returns all results of ListDedicatedClusters for which pred returns true. Note that the results are
+// filtered client-side, so all results matching the params are fetched first.
+// All pages are fetched, so the page and pagesize set in the params are ignored.
+func (s *ClusterService) ListDedicatedClustersWhere(p *ListDedicatedClustersParams, pred func(*DedicatedCluster) bool) ([]*DedicatedCluster, error) {
+	var r []*DedicatedCluster
+	ch, errs := s.StreamDedicatedClusters(context.Background(), p)
+	for v := range ch {
+		if pred(v) {
+			r = append(r, v)
+		}
+	}
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ListAllDedicatedClusters returns the results of ListDedicatedClusters across all pages, by calling ListDedicatedClusters page by page until all
+// results are fetched. The pagesize set in the params is used for every page (500 if not set), and the
+// page set in the params is ignored.
+func (s *ClusterService) ListAllDedicatedClusters(p *ListDedicatedClustersParams) ([]*DedicatedCluster, error) {
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesizeIfUnset(500)
+	pagesize := pp.p["pagesize"].(int)
+
+	var r []*DedicatedCluster
+	for page := 1; ; page++ {
+		pp.SetPage(page)
+
+		l, err := s.ListDedicatedClusters(pp)
+		if err != nil {
+			return nil, err
+		}
+		r = append(r, l.DedicatedClusters...)
+
+		if len(l.DedicatedClusters) < pagesize || len(r) >= l.Count {
+			return r, nil
+		}
+	}
+}
+
 type ListDedicatedClustersResponse struct {
 	Count             int                 `json:"count"`
 	DedicatedClusters []*DedicatedCluster `json:"dedicatedcluster"`
@@ -1056,6 +2010,16 @@ type ReleaseDedicatedClusterParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ReleaseDedicatedClusterParams) Clone() *ReleaseDedicatedClusterParams {
+	c := &ReleaseDedicatedClusterParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ReleaseDedicatedClusterParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1067,6 +2031,16 @@ func (p *ReleaseDedicatedClusterParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *ReleaseDedicatedClusterParams) validate() error {
+	for _, k := range []string{"clusterid"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of releaseDedicatedCluster", k)
+		}
+	}
+	return nil
+}
+
 func (p *ReleaseDedicatedClusterParams) SetClusterid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1075,18 +2049,54 @@ func (p *ReleaseDedicatedClusterParams) SetClusterid(v string) {
 	return
 }
 
+// SetClusteridIfUnset sets clusterid to v, unless it is already set
+func (p *ReleaseDedicatedClusterParams) SetClusteridIfUnset(v string) {
+	if _, found := p.p["clusterid"]; !found {
+		p.SetClusterid(v)
+	}
+}
+
 // You should always use this function to get a new ReleaseDedicatedClusterParams instance,
-// as then you are sure you have configured all required params
-func (s *ClusterService) NewReleaseDedicatedClusterParams(clusterid string) *ReleaseDedicatedClusterParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *ClusterService) NewReleaseDedicatedClusterParams(clusterid string, opts ...func(*ReleaseDedicatedClusterParams)) *ReleaseDedicatedClusterParams {
 	p := &ReleaseDedicatedClusterParams{}
 	p.p = make(map[string]interface{})
 	p.p["clusterid"] = clusterid
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Release the dedication for cluster
-func (s *ClusterService) ReleaseDedicatedCluster(p *ReleaseDedicatedClusterParams) (*ReleaseDedicatedClusterResponse, error) {
-	resp, err := s.cs.newRequest("releaseDedicatedCluster", p.toURLValues())
+func (s *ClusterService) ReleaseDedicatedCluster(p *ReleaseDedicatedClusterParams, opts ...OptionFunc) (*ReleaseDedicatedClusterResponse, error) {
+	return s.ReleaseDedicatedClusterWithContext(context.Background(), p, opts...)
+}
+
+// ReleaseDedicatedClusterWithContext is the same as ReleaseDedicatedCluster, but the request is cancelled when the context is done
+func (s *ClusterService) ReleaseDedicatedClusterWithContext(ctx context.Context, p *ReleaseDedicatedClusterParams, opts ...OptionFunc) (*ReleaseDedicatedClusterResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.ReleaseDedicatedClusterRawWithContext(ctx, p.toURLValues())
+}
+
+// ReleaseDedicatedClusterRaw is the same as ReleaseDedicatedCluster, but takes the params as url.Values instead of typed params
+func (s *ClusterService) ReleaseDedicatedClusterRaw(v url.Values) (*ReleaseDedicatedClusterResponse, error) {
+	return s.ReleaseDedicatedClusterRawWithContext(context.Background(), v)
+}
+
+// ReleaseDedicatedClusterRawWithContext is the same as ReleaseDedicatedClusterRaw, but the request is cancelled when the context is done
+func (s *ClusterService) ReleaseDedicatedClusterRawWithContext(ctx context.Context, v url.Values) (*ReleaseDedicatedClusterResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "releaseDedicatedCluster", "releasededicatedclusterresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -1098,9 +2108,9 @@ func (s *ClusterService) ReleaseDedicatedCluster(p *ReleaseDedicatedClusterParam
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -1124,6 +2134,16 @@ type UpdateClusterParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *UpdateClusterParams) Clone() *UpdateClusterParams {
+	c := &UpdateClusterParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *UpdateClusterParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1150,6 +2170,16 @@ func (p *UpdateClusterParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *UpdateClusterParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of updateCluster", k)
+		}
+	}
+	return nil
+}
+
 func (p *UpdateClusterParams) SetAllocationstate(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1158,6 +2188,13 @@ func (p *UpdateClusterParams) SetAllocationstate(v string) {
 	return
 }
 
+// SetAllocationstateIfUnset sets allocationstate to v, unless it is already set
+func (p *UpdateClusterParams) SetAllocationstateIfUnset(v string) {
+	if _, found := p.p["allocationstate"]; !found {
+		p.SetAllocationstate(v)
+	}
+}
+
 func (p *UpdateClusterParams) SetClustername(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1166,6 +2203,13 @@ func (p *UpdateClusterParams) SetClustername(v string) {
 	return
 }
 
+// SetClusternameIfUnset sets clustername to v, unless it is already set
+func (p *UpdateClusterParams) SetClusternameIfUnset(v string) {
+	if _, found := p.p["clustername"]; !found {
+		p.SetClustername(v)
+	}
+}
+
 func (p *UpdateClusterParams) SetClustertype(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1174,14 +2218,28 @@ func (p *UpdateClusterParams) SetClustertype(v string) {
 	return
 }
 
-func (p *UpdateClusterParams) SetHypervisor(v string) {
+// SetClustertypeIfUnset sets clustertype to v, unless it is already set
+func (p *UpdateClusterParams) SetClustertypeIfUnset(v string) {
+	if _, found := p.p["clustertype"]; !found {
+		p.SetClustertype(v)
+	}
+}
+
+func (p *UpdateClusterParams) SetHypervisor(v HypervisorType) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	p.p["hypervisor"] = v
+	p.p["hypervisor"] = string(v)
 	return
 }
 
+// SetHypervisorIfUnset sets hypervisor to v, unless it is already set
+func (p *UpdateClusterParams) SetHypervisorIfUnset(v HypervisorType) {
+	if _, found := p.p["hypervisor"]; !found {
+		p.SetHypervisor(v)
+	}
+}
+
 func (p *UpdateClusterParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1190,6 +2248,13 @@ func (p *UpdateClusterParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *UpdateClusterParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 func (p *UpdateClusterParams) SetManagedstate(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1198,18 +2263,54 @@ func (p *UpdateClusterParams) SetManagedstate(v string) {
 	return
 }
 
+// SetManagedstateIfUnset sets managedstate to v, unless it is already set
+func (p *UpdateClusterParams) SetManagedstateIfUnset(v string) {
+	if _, found := p.p["managedstate"]; !found {
+		p.SetManagedstate(v)
+	}
+}
+
 // You should always use this function to get a new UpdateClusterParams instance,
-// as then you are sure you have configured all required params
-func (s *ClusterService) NewUpdateClusterParams(id string) *UpdateClusterParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *ClusterService) NewUpdateClusterParams(id string, opts ...func(*UpdateClusterParams)) *UpdateClusterParams {
 	p := &UpdateClusterParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Updates an existing cluster
-func (s *ClusterService) UpdateCluster(p *UpdateClusterParams) (*UpdateClusterResponse, error) {
-	resp, err := s.cs.newRequest("updateCluster", p.toURLValues())
+func (s *ClusterService) UpdateCluster(p *UpdateClusterParams, opts ...OptionFunc) (*UpdateClusterResponse, error) {
+	return s.UpdateClusterWithContext(context.Background(), p, opts...)
+}
+
+// UpdateClusterWithContext is the same as UpdateCluster, but the request is cancelled when the context is done
+func (s *ClusterService) UpdateClusterWithContext(ctx context.Context, p *UpdateClusterParams, opts ...OptionFunc) (*UpdateClusterResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.UpdateClusterRawWithContext(ctx, p.toURLValues())
+}
+
+// UpdateClusterRaw is the same as UpdateCluster, but takes the params as url.Values instead of typed params
+func (s *ClusterService) UpdateClusterRaw(v url.Values) (*UpdateClusterResponse, error) {
+	return s.UpdateClusterRawWithContext(context.Background(), v)
+}
+
+// UpdateClusterRawWithContext is the same as UpdateClusterRaw, but the request is cancelled when the context is done
+func (s *ClusterService) UpdateClusterRawWithContext(ctx context.Context, v url.Values) (*UpdateClusterResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "updateCluster", "updateclusterresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -1236,17 +2337,17 @@ type UpdateClusterResponse struct {
 		Zoneid        string `json:"zoneid"`
 		Zonename      string `json:"zonename"`
 	} `json:"capacity"`
-	Clustertype           string            `json:"clustertype"`
-	Cpuovercommitratio    string            `json:"cpuovercommitratio"`
-	Hypervisortype        string            `json:"hypervisortype"`
-	Id                    string            `json:"id"`
-	Managedstate          string            `json:"managedstate"`
-	Memoryovercommitratio string            `json:"memoryovercommitratio"`
-	Name                  string            `json:"name"`
-	Ovm3vip               string            `json:"ovm3vip"`
-	Podid                 string            `json:"podid"`
-	Podname               string            `json:"podname"`
-	Resourcedetails       map[string]string `json:"resourcedetails"`
-	Zoneid                string            `json:"zoneid"`
-	Zonename              string            `json:"zonename"`
+	Clustertype           string    `json:"clustertype"`
+	Cpuovercommitratio    string    `json:"cpuovercommitratio"`
+	Hypervisortype        string    `json:"hypervisortype"`
+	Id                    string    `json:"id"`
+	Managedstate          string    `json:"managedstate"`
+	Memoryovercommitratio string    `json:"memoryovercommitratio"`
+	Name                  string    `json:"name"`
+	Ovm3vip               string    `json:"ovm3vip"`
+	Podid                 string    `json:"podid"`
+	Podname               string    `json:"podname"`
+	Resourcedetails       StringMap `json:"resourcedetails"`
+	Zoneid                string    `json:"zoneid"`
+	Zonename              string    `json:"zonename"`
 }
@@ -17,15 +17,88 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
 	"net/url"
 	"strconv"
 )
 
+// ConfigScope is the scope of the configuration settings compared by DetectDrift. At most one of
+// the IDs should be set; when none is set, the global settings are compared.
+type ConfigScope struct {
+	AccountID string
+	ClusterID string
+	StorageID string
+	ZoneID    string
+}
+
+// ConfigDiff is the difference between the current and the desired value of a configuration
+// setting. Missing is true when the setting does not exist (in the scope) on the server.
+type ConfigDiff struct {
+	Current string
+	Desired string
+	Missing bool
+}
+
+// DetectDrift compares the configuration settings in the scope with the desired values, and returns
+// the difference for every setting of which the current value differs from the desired value, or
+// which does not exist on the server, keyed by the name of the setting. Settings that are not in
+// desired are ignored.
+func (s *ConfigurationService) DetectDrift(desired map[string]string, scope ConfigScope) (map[string]ConfigDiff, error) {
+	p := s.NewListConfigurationsParams()
+	if scope.AccountID != "" {
+		p.SetAccountid(scope.AccountID)
+	}
+	if scope.ClusterID != "" {
+		p.SetClusterid(scope.ClusterID)
+	}
+	if scope.StorageID != "" {
+		p.SetStorageid(scope.StorageID)
+	}
+	if scope.ZoneID != "" {
+		p.SetZoneid(scope.ZoneID)
+	}
+
+	l, err := s.ListConfigurationsWhere(p, func(c *Configuration) bool {
+		_, ok := desired[c.Name]
+		return ok
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	current := make(map[string]string, len(l))
+	for _, c := range l {
+		current[c.Name] = c.Value
+	}
+
+	drift := make(map[string]ConfigDiff)
+	for name, want := range desired {
+		have, ok := current[name]
+		if !ok {
+			drift[name] = ConfigDiff{Desired: want, Missing: true}
+		} else if have != want {
+			drift[name] = ConfigDiff{Current: have, Desired: want}
+		}
+	}
+	return drift, nil
+}
+
 type ListCapabilitiesParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ListCapabilitiesParams) Clone() *ListCapabilitiesParams {
+	c := &ListCapabilitiesParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListCapabilitiesParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -35,16 +108,35 @@ func (p *ListCapabilitiesParams) toURLValues() url.Values {
 }
 
 // You should always use this function to get a new ListCapabilitiesParams instance,
-// as then you are sure you have configured all required params
-func (s *ConfigurationService) NewListCapabilitiesParams() *ListCapabilitiesParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *ConfigurationService) NewListCapabilitiesParams(opts ...func(*ListCapabilitiesParams)) *ListCapabilitiesParams {
 	p := &ListCapabilitiesParams{}
 	p.p = make(map[string]interface{})
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Lists capabilities
 func (s *ConfigurationService) ListCapabilities(p *ListCapabilitiesParams) (*ListCapabilitiesResponse, error) {
-	resp, err := s.cs.newRequest("listCapabilities", p.toURLValues())
+	return s.ListCapabilitiesWithContext(context.Background(), p)
+}
+
+// ListCapabilitiesWithContext is the same as ListCapabilities, but the request is cancelled when the context is done
+func (s *ConfigurationService) ListCapabilitiesWithContext(ctx context.Context, p *ListCapabilitiesParams) (*ListCapabilitiesResponse, error) {
+	return s.ListCapabilitiesRawWithContext(ctx, p.toURLValues())
+}
+
+// ListCapabilitiesRaw is the same as ListCapabilities, but takes the params as url.Values instead of typed params
+func (s *ConfigurationService) ListCapabilitiesRaw(v url.Values) (*ListCapabilitiesResponse, error) {
+	return s.ListCapabilitiesRawWithContext(context.Background(), v)
+}
+
+// ListCapabilitiesRawWithContext is the same as ListCapabilitiesRaw, but the request is cancelled when the context is done
+func (s *ConfigurationService) ListCapabilitiesRawWithContext(ctx context.Context, v url.Values) (*ListCapabilitiesResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "listCapabilities", "listcapabilitiesresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -57,6 +149,22 @@ func (s *ConfigurationService) ListCapabilities(p *ListCapabilitiesParams) (*Lis
 	return &r, nil
 }
 
+// ListCapabilitiesWhere returns all results of ListCapabilities for which pred returns true. Note that the results are
+// filtered client-side, so all results matching the params are fetched first.
+func (s *ConfigurationService) ListCapabilitiesWhere(p *ListCapabilitiesParams, pred func(*Capability) bool) ([]*Capability, error) {
+	var r []*Capability
+	l, err := s.ListCapabilities(p)
+	if err != nil {
+		return nil, err
+	}
+	for _, v := range l.Capabilities {
+		if pred(v) {
+			r = append(r, v)
+		}
+	}
+	return r, nil
+}
+
 type ListCapabilitiesResponse struct {
 	Count        int           `json:"count"`
 	Capabilities []*Capability `json:"capability"`
@@ -84,6 +192,16 @@ type ListConfigurationsParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ListConfigurationsParams) Clone() *ListConfigurationsParams {
+	c := &ListConfigurationsParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListConfigurationsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -129,6 +247,13 @@ func (p *ListConfigurationsParams) SetAccountid(v string) {
 	return
 }
 
+// SetAccountidIfUnset sets accountid to v, unless it is already set
+func (p *ListConfigurationsParams) SetAccountidIfUnset(v string) {
+	if _, found := p.p["accountid"]; !found {
+		p.SetAccountid(v)
+	}
+}
+
 func (p *ListConfigurationsParams) SetCategory(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -137,6 +262,13 @@ func (p *ListConfigurationsParams) SetCategory(v string) {
 	return
 }
 
+// SetCategoryIfUnset sets category to v, unless it is already set
+func (p *ListConfigurationsParams) SetCategoryIfUnset(v string) {
+	if _, found := p.p["category"]; !found {
+		p.SetCategory(v)
+	}
+}
+
 func (p *ListConfigurationsParams) SetClusterid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -145,6 +277,13 @@ func (p *ListConfigurationsParams) SetClusterid(v string) {
 	return
 }
 
+// SetClusteridIfUnset sets clusterid to v, unless it is already set
+func (p *ListConfigurationsParams) SetClusteridIfUnset(v string) {
+	if _, found := p.p["clusterid"]; !found {
+		p.SetClusterid(v)
+	}
+}
+
 func (p *ListConfigurationsParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -153,6 +292,13 @@ func (p *ListConfigurationsParams) SetKeyword(v string) {
 	return
 }
 
+// SetKeywordIfUnset sets keyword to v, unless it is already set
+func (p *ListConfigurationsParams) SetKeywordIfUnset(v string) {
+	if _, found := p.p["keyword"]; !found {
+		p.SetKeyword(v)
+	}
+}
+
 func (p *ListConfigurationsParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -161,6 +307,13 @@ func (p *ListConfigurationsParams) SetName(v string) {
 	return
 }
 
+// SetNameIfUnset sets name to v, unless it is already set
+func (p *ListConfigurationsParams) SetNameIfUnset(v string) {
+	if _, found := p.p["name"]; !found {
+		p.SetName(v)
+	}
+}
+
 func (p *ListConfigurationsParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -169,6 +322,13 @@ func (p *ListConfigurationsParams) SetPage(v int) {
 	return
 }
 
+// SetPageIfUnset sets page to v, unless it is already set
+func (p *ListConfigurationsParams) SetPageIfUnset(v int) {
+	if _, found := p.p["page"]; !found {
+		p.SetPage(v)
+	}
+}
+
 func (p *ListConfigurationsParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -177,6 +337,13 @@ func (p *ListConfigurationsParams) SetPagesize(v int) {
 	return
 }
 
+// SetPagesizeIfUnset sets pagesize to v, unless it is already set
+func (p *ListConfigurationsParams) SetPagesizeIfUnset(v int) {
+	if _, found := p.p["pagesize"]; !found {
+		p.SetPagesize(v)
+	}
+}
+
 func (p *ListConfigurationsParams) SetStorageid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -185,6 +352,13 @@ func (p *ListConfigurationsParams) SetStorageid(v string) {
 	return
 }
 
+// SetStorageidIfUnset sets storageid to v, unless it is already set
+func (p *ListConfigurationsParams) SetStorageidIfUnset(v string) {
+	if _, found := p.p["storageid"]; !found {
+		p.SetStorageid(v)
+	}
+}
+
 func (p *ListConfigurationsParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -193,17 +367,43 @@ func (p *ListConfigurationsParams) SetZoneid(v string) {
 	return
 }
 
+// SetZoneidIfUnset sets zoneid to v, unless it is already set
+func (p *ListConfigurationsParams) SetZoneidIfUnset(v string) {
+	if _, found := p.p["zoneid"]; !found {
+		p.SetZoneid(v)
+	}
+}
+
 // You should always use this function to get a new ListConfigurationsParams instance,
-// as then you are sure you have configured all required params
-func (s *ConfigurationService) NewListConfigurationsParams() *ListConfigurationsParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *ConfigurationService) NewListConfigurationsParams(opts ...func(*ListConfigurationsParams)) *ListConfigurationsParams {
 	p := &ListConfigurationsParams{}
 	p.p = make(map[string]interface{})
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Lists all configurations.
 func (s *ConfigurationService) ListConfigurations(p *ListConfigurationsParams) (*ListConfigurationsResponse, error) {
-	resp, err := s.cs.newRequest("listConfigurations", p.toURLValues())
+	return s.ListConfigurationsWithContext(context.Background(), p)
+}
+
+// ListConfigurationsWithContext is the same as ListConfigurations, but the request is cancelled when the context is done
+func (s *ConfigurationService) ListConfigurationsWithContext(ctx context.Context, p *ListConfigurationsParams) (*ListConfigurationsResponse, error) {
+	return s.ListConfigurationsRawWithContext(ctx, p.toURLValues())
+}
+
+// ListConfigurationsRaw is the same as ListConfigurations, but takes the params as url.Values instead of typed params
+func (s *ConfigurationService) ListConfigurationsRaw(v url.Values) (*ListConfigurationsResponse, error) {
+	return s.ListConfigurationsRawWithContext(context.Background(), v)
+}
+
+// ListConfigurationsRawWithContext is the same as ListConfigurationsRaw, but the request is cancelled when the context is done
+func (s *ConfigurationService) ListConfigurationsRawWithContext(ctx context.Context, v url.Values) (*ListConfigurationsResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "listConfigurations", "listconfigurationsresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -216,6 +416,93 @@ func (s *ConfigurationService) ListConfigurations(p *ListConfigurationsParams) (
 	return &r, nil
 }
 
+// StreamConfigurations fetches the results of ListConfigurations page by page in the background and sends them on the
+// returned channel. Both returned channels are closed when done. If fetching a page fails, or the context
+// is done before all results are sent, the error is sent on the error channel and fetching stops. A
+// request for a page in flight is cancelled when the context is done. The page and pagesize set in the
+// params are ignored.
+func (s *ConfigurationService) StreamConfigurations(ctx context.Context, p *ListConfigurationsParams) (<-chan *Configuration, <-chan error) {
+	ch := make(chan *Configuration)
+	errs := make(chan error, 1)
+
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesize(500)
+
+	go func() {
+		defer close(errs)
+		defer close(ch)
+
+		for page, count := 1, 0; ; page++ {
+			pp.SetPage(page)
+
+			l, err := s.ListConfigurationsWithContext(ctx, pp)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			for _, v := range l.Configurations {
+				select {
+				case ch <- v:
+				case <-ctx.Done():
+					errs <- ctx.Err()
+					return
+				}
+			}
+
+			count += len(l.Configurations)
+			if len(l.Configurations) < 500 || count >= l.Count {
+				return
+			}
+		}
+	}()
+
+	return ch, errs
+}
+
+// ListConfigurationsWhere returns all results of ListConfigurations for which pred returns true. Note that the results are
+// filtered client-side, so all results matching the params are fetched first.
+// All pages are fetched, so the page and pagesize set in the params are ignored.
+func (s *ConfigurationService) ListConfigurationsWhere(p *ListConfigurationsParams, pred func(*Configuration) bool) ([]*Configuration, error) {
+	var r []*Configuration
+	ch, errs := s.StreamConfigurations(context.Background(), p)
+	for v := range ch {
+		if pred(v) {
+			r = append(r, v)
+		}
+	}
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ListAllConfigurations returns the results of ListConfigurations across all pages, by calling ListConfigurations page by page until all
+// results are fetched. The pagesize set in the params is used for every page (500 if not set), and the
+// page set in the params is ignored.
+func (s *ConfigurationService) ListAllConfigurations(p *ListConfigurationsParams) ([]*Configuration, error) {
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesizeIfUnset(500)
+	pagesize := pp.p["pagesize"].(int)
+
+	var r []*Configuration
+	for page := 1; ; page++ {
+		pp.SetPage(page)
+
+		l, err := s.ListConfigurations(pp)
+		if err != nil {
+			return nil, err
+		}
+		r = append(r, l.Configurations...)
+
+		if len(l.Configurations) < pagesize || len(r) >= l.Count {
+			return r, nil
+		}
+	}
+}
+
 type ListConfigurationsResponse struct {
 	Count          int              `json:"count"`
 	Configurations []*Configuration `json:"configuration"`
@@ -234,6 +521,16 @@ type ListDeploymentPlannersParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ListDeploymentPlannersParams) Clone() *ListDeploymentPlannersParams {
+	c := &ListDeploymentPlannersParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListDeploymentPlannersParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -261,6 +558,13 @@ func (p *ListDeploymentPlannersParams) SetKeyword(v string) {
 	return
 }
 
+// SetKeywordIfUnset sets keyword to v, unless it is already set
+func (p *ListDeploymentPlannersParams) SetKeywordIfUnset(v string) {
+	if _, found := p.p["keyword"]; !found {
+		p.SetKeyword(v)
+	}
+}
+
 func (p *ListDeploymentPlannersParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -269,6 +573,13 @@ func (p *ListDeploymentPlannersParams) SetPage(v int) {
 	return
 }
 
+// SetPageIfUnset sets page to v, unless it is already set
+func (p *ListDeploymentPlannersParams) SetPageIfUnset(v int) {
+	if _, found := p.p["page"]; !found {
+		p.SetPage(v)
+	}
+}
+
 func (p *ListDeploymentPlannersParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -277,17 +588,43 @@ func (p *ListDeploymentPlannersParams) SetPagesize(v int) {
 	return
 }
 
+// SetPagesizeIfUnset sets pagesize to v, unless it is already set
+func (p *ListDeploymentPlannersParams) SetPagesizeIfUnset(v int) {
+	if _, found := p.p["pagesize"]; !found {
+		p.SetPagesize(v)
+	}
+}
+
 // You should always use this function to get a new ListDeploymentPlannersParams instance,
-// as then you are sure you have configured all required params
-func (s *ConfigurationService) NewListDeploymentPlannersParams() *ListDeploymentPlannersParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *ConfigurationService) NewListDeploymentPlannersParams(opts ...func(*ListDeploymentPlannersParams)) *ListDeploymentPlannersParams {
 	p := &ListDeploymentPlannersParams{}
 	p.p = make(map[string]interface{})
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Lists all DeploymentPlanners available.
 func (s *ConfigurationService) ListDeploymentPlanners(p *ListDeploymentPlannersParams) (*ListDeploymentPlannersResponse, error) {
-	resp, err := s.cs.newRequest("listDeploymentPlanners", p.toURLValues())
+	return s.ListDeploymentPlannersWithContext(context.Background(), p)
+}
+
+// ListDeploymentPlannersWithContext is the same as ListDeploymentPlanners, but the request is cancelled when the context is done
+func (s *ConfigurationService) ListDeploymentPlannersWithContext(ctx context.Context, p *ListDeploymentPlannersParams) (*ListDeploymentPlannersResponse, error) {
+	return s.ListDeploymentPlannersRawWithContext(ctx, p.toURLValues())
+}
+
+// ListDeploymentPlannersRaw is the same as ListDeploymentPlanners, but takes the params as url.Values instead of typed params
+func (s *ConfigurationService) ListDeploymentPlannersRaw(v url.Values) (*ListDeploymentPlannersResponse, error) {
+	return s.ListDeploymentPlannersRawWithContext(context.Background(), v)
+}
+
+// ListDeploymentPlannersRawWithContext is the same as ListDeploymentPlannersRaw, but the request is cancelled when the context is done
+func (s *ConfigurationService) ListDeploymentPlannersRawWithContext(ctx context.Context, v url.Values) (*ListDeploymentPlannersResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "listDeploymentPlanners", "listdeploymentplannersresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -300,6 +637,93 @@ func (s *ConfigurationService) ListDeploymentPlanners(p *ListDeploymentPlannersP
 	return &r, nil
 }
 
+// StreamDeploymentPlanners fetches the results of ListDeploymentPlanners page by page in the background and sends them on the
+// returned channel. Both returned channels are closed when done. If fetching a page fails, or the context
+// is done before all results are sent, the error is sent on the error channel and fetching stops. A
+// request for a page in flight is cancelled when the context is done. The page and pagesize set in the
+// params are ignored.
+func (s *ConfigurationService) StreamDeploymentPlanners(ctx context.Context, p *ListDeploymentPlannersParams) (<-chan *DeploymentPlanner, <-chan error) {
+	ch := make(chan *DeploymentPlanner)
+	errs := make(chan error, 1)
+
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesize(500)
+
+	go func() {
+		defer close(errs)
+		defer close(ch)
+
+		for page, count := 1, 0; ; page++ {
+			pp.SetPage(page)
+
+			l, err := s.ListDeploymentPlannersWithContext(ctx, pp)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			for _, v := range l.DeploymentPlanners {
+				select {
+				case ch <- v:
+				case <-ctx.Done():
+					errs <- ctx.Err()
+					return
+				}
+			}
+
+			count += len(l.DeploymentPlanners)
+			if len(l.DeploymentPlanners) < 500 || count >= l.Count {
+				return
+			}
+		}
+	}()
+
+	return ch, errs
+}
+
+// ListDeploymentPlannersWhere returns all results of ListDeploymentPlanners for which pred returns true. Note that the results are
+// filtered client-side, so all results matching the params are fetched first.
+// All pages are fetched, so the page and pagesize set in the params are ignored.
+func (s *ConfigurationService) ListDeploymentPlannersWhere(p *ListDeploymentPlannersParams, pred func(*DeploymentPlanner) bool) ([]*DeploymentPlanner, error) {
+	var r []*DeploymentPlanner
+	ch, errs := s.StreamDeploymentPlanners(context.Background(), p)
+	for v := range ch {
+		if pred(v) {
+			r = append(r, v)
+		}
+	}
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ListAllDeploymentPlanners returns the results of ListDeploymentPlanners across all pages, by calling ListDeploymentPlanners page by page until all
+// results are fetched. The pagesize set in the params is used for every page (500 if not set), and the
+// page set in the params is ignored.
+func (s *ConfigurationService) ListAllDeploymentPlanners(p *ListDeploymentPlannersParams) ([]*DeploymentPlanner, error) {
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesizeIfUnset(500)
+	pagesize := pp.p["pagesize"].(int)
+
+	var r []*DeploymentPlanner
+	for page := 1; ; page++ {
+		pp.SetPage(page)
+
+		l, err := s.ListDeploymentPlanners(pp)
+		if err != nil {
+			return nil, err
+		}
+		r = append(r, l.DeploymentPlanners...)
+
+		if len(l.DeploymentPlanners) < pagesize || len(r) >= l.Count {
+			return r, nil
+		}
+	}
+}
+
 type ListDeploymentPlannersResponse struct {
 	Count              int                  `json:"count"`
 	DeploymentPlanners []*DeploymentPlanner `json:"deploymentplanner"`
@@ -313,6 +737,16 @@ type UpdateConfigurationParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *UpdateConfigurationParams) Clone() *UpdateConfigurationParams {
+	c := &UpdateConfigurationParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *UpdateConfigurationParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -339,6 +773,16 @@ func (p *UpdateConfigurationParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *UpdateConfigurationParams) validate() error {
+	for _, k := range []string{"name"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of updateConfiguration", k)
+		}
+	}
+	return nil
+}
+
 func (p *UpdateConfigurationParams) SetAccountid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -347,6 +791,13 @@ func (p *UpdateConfigurationParams) SetAccountid(v string) {
 	return
 }
 
+// SetAccountidIfUnset sets accountid to v, unless it is already set
+func (p *UpdateConfigurationParams) SetAccountidIfUnset(v string) {
+	if _, found := p.p["accountid"]; !found {
+		p.SetAccountid(v)
+	}
+}
+
 func (p *UpdateConfigurationParams) SetClusterid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -355,6 +806,13 @@ func (p *UpdateConfigurationParams) SetClusterid(v string) {
 	return
 }
 
+// SetClusteridIfUnset sets clusterid to v, unless it is already set
+func (p *UpdateConfigurationParams) SetClusteridIfUnset(v string) {
+	if _, found := p.p["clusterid"]; !found {
+		p.SetClusterid(v)
+	}
+}
+
 func (p *UpdateConfigurationParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -363,6 +821,13 @@ func (p *UpdateConfigurationParams) SetName(v string) {
 	return
 }
 
+// SetNameIfUnset sets name to v, unless it is already set
+func (p *UpdateConfigurationParams) SetNameIfUnset(v string) {
+	if _, found := p.p["name"]; !found {
+		p.SetName(v)
+	}
+}
+
 func (p *UpdateConfigurationParams) SetStorageid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -371,6 +836,13 @@ func (p *UpdateConfigurationParams) SetStorageid(v string) {
 	return
 }
 
+// SetStorageidIfUnset sets storageid to v, unless it is already set
+func (p *UpdateConfigurationParams) SetStorageidIfUnset(v string) {
+	if _, found := p.p["storageid"]; !found {
+		p.SetStorageid(v)
+	}
+}
+
 func (p *UpdateConfigurationParams) SetValue(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -379,6 +851,13 @@ func (p *UpdateConfigurationParams) SetValue(v string) {
 	return
 }
 
+// SetValueIfUnset sets value to v, unless it is already set
+func (p *UpdateConfigurationParams) SetValueIfUnset(v string) {
+	if _, found := p.p["value"]; !found {
+		p.SetValue(v)
+	}
+}
+
 func (p *UpdateConfigurationParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -387,18 +866,54 @@ func (p *UpdateConfigurationParams) SetZoneid(v string) {
 	return
 }
 
+// SetZoneidIfUnset sets zoneid to v, unless it is already set
+func (p *UpdateConfigurationParams) SetZoneidIfUnset(v string) {
+	if _, found := p.p["zoneid"]; !found {
+		p.SetZoneid(v)
+	}
+}
+
 // You should always use this function to get a new UpdateConfigurationParams instance,
-// as then you are sure you have configured all required params
-func (s *ConfigurationService) NewUpdateConfigurationParams(name string) *UpdateConfigurationParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *ConfigurationService) NewUpdateConfigurationParams(name string, opts ...func(*UpdateConfigurationParams)) *UpdateConfigurationParams {
 	p := &UpdateConfigurationParams{}
 	p.p = make(map[string]interface{})
 	p.p["name"] = name
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Updates a configuration.
-func (s *ConfigurationService) UpdateConfiguration(p *UpdateConfigurationParams) (*UpdateConfigurationResponse, error) {
-	resp, err := s.cs.newRequest("updateConfiguration", p.toURLValues())
+func (s *ConfigurationService) UpdateConfiguration(p *UpdateConfigurationParams, opts ...OptionFunc) (*UpdateConfigurationResponse, error) {
+	return s.UpdateConfigurationWithContext(context.Background(), p, opts...)
+}
+
+// UpdateConfigurationWithContext is the same as UpdateConfiguration, but the request is cancelled when the context is done
+func (s *ConfigurationService) UpdateConfigurationWithContext(ctx context.Context, p *UpdateConfigurationParams, opts ...OptionFunc) (*UpdateConfigurationResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.UpdateConfigurationRawWithContext(ctx, p.toURLValues())
+}
+
+// UpdateConfigurationRaw is the same as UpdateConfiguration, but takes the params as url.Values instead of typed params
+func (s *ConfigurationService) UpdateConfigurationRaw(v url.Values) (*UpdateConfigurationResponse, error) {
+	return s.UpdateConfigurationRawWithContext(context.Background(), v)
+}
+
+// UpdateConfigurationRawWithContext is the same as UpdateConfigurationRaw, but the request is cancelled when the context is done
+func (s *ConfigurationService) UpdateConfigurationRawWithContext(ctx context.Context, v url.Values) (*UpdateConfigurationResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "updateConfiguration", "updateconfigurationresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -17,6 +17,7 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
@@ -48,10 +49,8 @@ func (p *CustomServiceParams) toURLValues() url.Values {
 		case []string:
 			u.Set(k, strings.Join(t, ", "))
 		case map[string]string:
-			i := 0
-			for kk, vv := range t {
-				u.Set(fmt.Sprintf("%s[%d].%s", k, i, kk), vv)
-				i++
+			for i, kk := range getSortedKeysFromMap(t) {
+				u.Set(fmt.Sprintf("%s[%d].%s", k, i, kk), t[kk])
 			}
 		}
 	}
@@ -68,7 +67,12 @@ func (p *CustomServiceParams) SetParam(param string, v interface{}) {
 }
 
 func (s *CustomService) CustomRequest(api string, p *CustomServiceParams, result interface{}) error {
-	resp, err := s.cs.newRequest(api, p.toURLValues())
+	return s.CustomRequestWithContext(context.Background(), api, p, result)
+}
+
+// CustomRequestWithContext is the same as CustomRequest, but the request is cancelled when the context is done
+func (s *CustomService) CustomRequestWithContext(ctx context.Context, api string, p *CustomServiceParams, result interface{}) error {
+	resp, err := s.cs.newRequestWithContext(ctx, api, responseKeys[api], p.toURLValues())
 	if err != nil {
 		return err
 	}
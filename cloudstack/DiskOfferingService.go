@@ -17,17 +17,95 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/url"
 	"strconv"
 	"strings"
+	"time"
 )
 
+// ResolveOffering returns the DiskOffering with the given name. Results are cached on the service for
+// the configured TTL (5 minutes by default), so repeated lookups don't result in additional list calls.
+func (s *DiskOfferingService) ResolveOffering(name string) (*DiskOffering, error) {
+	if v, found := s.cache.get(name); found {
+		return v.(*DiskOffering), nil
+	}
+
+	o, _, err := s.GetDiskOfferingByName(name)
+	if err != nil {
+		return nil, err
+	}
+
+	s.cache.set(name, o)
+
+	return o, nil
+}
+
+// SetResolveTTL sets the TTL of the offerings cached by ResolveOffering. A TTL of 0 caches
+// the offerings until InvalidateResolveCache is called.
+func (s *DiskOfferingService) SetResolveTTL(ttl time.Duration) {
+	s.cache.setTTL(ttl)
+}
+
+// InvalidateResolveCache removes all offerings cached by ResolveOffering
+func (s *DiskOfferingService) InvalidateResolveCache() {
+	s.cache.invalidate()
+}
+
+// UpdateMany runs UpdateDiskOffering for all given IDs in parallel, using at most concurrency concurrent requests.
+// For each ID the params are populated with the ID and then passed to mutate, which should set the
+// changes to apply. The result contains the updated resources (in the order of the IDs) and the error
+// of every update that failed by ID. The returned error contains all failures, or is nil if none failed.
+// The updated resources are returned as the UpdateDiskOfferingResponse of every update, which contains the same
+// fields as the resource itself, so no additional request is needed per resource to get them. The
+// options are used for every update.
+func (s *DiskOfferingService) UpdateMany(ids []string, mutate func(*UpdateDiskOfferingParams), concurrency int, opts ...OptionFunc) (BulkResult[*UpdateDiskOfferingResponse], error) {
+	rs := make([]*UpdateDiskOfferingResponse, len(ids))
+	errs := make([]error, len(ids))
+
+	runConcurrently(len(ids), concurrency, func(i int) {
+		p := &UpdateDiskOfferingParams{p: map[string]interface{}{"id": ids[i]}}
+		if mutate != nil {
+			mutate(p)
+		}
+
+		r, err := s.UpdateDiskOffering(p, opts...)
+		if err != nil {
+			errs[i] = err
+			return
+		}
+		rs[i] = r
+	})
+
+	var r BulkResult[*UpdateDiskOfferingResponse]
+	for i, id := range ids {
+		if errs[i] != nil {
+			r.fail(id, errs[i])
+			continue
+		}
+		r.Succeeded = append(r.Succeeded, rs[i])
+	}
+
+	return r, r.Err()
+}
+
 type CreateDiskOfferingParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *CreateDiskOfferingParams) Clone() *CreateDiskOfferingParams {
+	c := &CreateDiskOfferingParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *CreateDiskOfferingParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -98,6 +176,16 @@ func (p *CreateDiskOfferingParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *CreateDiskOfferingParams) validate() error {
+	for _, k := range []string{"displaytext", "name"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of createDiskOffering", k)
+		}
+	}
+	return nil
+}
+
 func (p *CreateDiskOfferingParams) SetBytesreadrate(v int64) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -106,6 +194,13 @@ func (p *CreateDiskOfferingParams) SetBytesreadrate(v int64) {
 	return
 }
 
+// SetBytesreadrateIfUnset sets bytesreadrate to v, unless it is already set
+func (p *CreateDiskOfferingParams) SetBytesreadrateIfUnset(v int64) {
+	if _, found := p.p["bytesreadrate"]; !found {
+		p.SetBytesreadrate(v)
+	}
+}
+
 func (p *CreateDiskOfferingParams) SetByteswriterate(v int64) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -114,6 +209,13 @@ func (p *CreateDiskOfferingParams) SetByteswriterate(v int64) {
 	return
 }
 
+// SetByteswriterateIfUnset sets byteswriterate to v, unless it is already set
+func (p *CreateDiskOfferingParams) SetByteswriterateIfUnset(v int64) {
+	if _, found := p.p["byteswriterate"]; !found {
+		p.SetByteswriterate(v)
+	}
+}
+
 func (p *CreateDiskOfferingParams) SetCustomized(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -122,6 +224,13 @@ func (p *CreateDiskOfferingParams) SetCustomized(v bool) {
 	return
 }
 
+// SetCustomizedIfUnset sets customized to v, unless it is already set
+func (p *CreateDiskOfferingParams) SetCustomizedIfUnset(v bool) {
+	if _, found := p.p["customized"]; !found {
+		p.SetCustomized(v)
+	}
+}
+
 func (p *CreateDiskOfferingParams) SetCustomizediops(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -130,6 +239,13 @@ func (p *CreateDiskOfferingParams) SetCustomizediops(v bool) {
 	return
 }
 
+// SetCustomizediopsIfUnset sets customizediops to v, unless it is already set
+func (p *CreateDiskOfferingParams) SetCustomizediopsIfUnset(v bool) {
+	if _, found := p.p["customizediops"]; !found {
+		p.SetCustomizediops(v)
+	}
+}
+
 func (p *CreateDiskOfferingParams) SetDisksize(v int64) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -138,6 +254,13 @@ func (p *CreateDiskOfferingParams) SetDisksize(v int64) {
 	return
 }
 
+// SetDisksizeIfUnset sets disksize to v, unless it is already set
+func (p *CreateDiskOfferingParams) SetDisksizeIfUnset(v int64) {
+	if _, found := p.p["disksize"]; !found {
+		p.SetDisksize(v)
+	}
+}
+
 func (p *CreateDiskOfferingParams) SetDisplayoffering(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -146,6 +269,13 @@ func (p *CreateDiskOfferingParams) SetDisplayoffering(v bool) {
 	return
 }
 
+// SetDisplayofferingIfUnset sets displayoffering to v, unless it is already set
+func (p *CreateDiskOfferingParams) SetDisplayofferingIfUnset(v bool) {
+	if _, found := p.p["displayoffering"]; !found {
+		p.SetDisplayoffering(v)
+	}
+}
+
 func (p *CreateDiskOfferingParams) SetDisplaytext(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -154,6 +284,13 @@ func (p *CreateDiskOfferingParams) SetDisplaytext(v string) {
 	return
 }
 
+// SetDisplaytextIfUnset sets displaytext to v, unless it is already set
+func (p *CreateDiskOfferingParams) SetDisplaytextIfUnset(v string) {
+	if _, found := p.p["displaytext"]; !found {
+		p.SetDisplaytext(v)
+	}
+}
+
 func (p *CreateDiskOfferingParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -162,6 +299,13 @@ func (p *CreateDiskOfferingParams) SetDomainid(v string) {
 	return
 }
 
+// SetDomainidIfUnset sets domainid to v, unless it is already set
+func (p *CreateDiskOfferingParams) SetDomainidIfUnset(v string) {
+	if _, found := p.p["domainid"]; !found {
+		p.SetDomainid(v)
+	}
+}
+
 func (p *CreateDiskOfferingParams) SetHypervisorsnapshotreserve(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -170,6 +314,13 @@ func (p *CreateDiskOfferingParams) SetHypervisorsnapshotreserve(v int) {
 	return
 }
 
+// SetHypervisorsnapshotreserveIfUnset sets hypervisorsnapshotreserve to v, unless it is already set
+func (p *CreateDiskOfferingParams) SetHypervisorsnapshotreserveIfUnset(v int) {
+	if _, found := p.p["hypervisorsnapshotreserve"]; !found {
+		p.SetHypervisorsnapshotreserve(v)
+	}
+}
+
 func (p *CreateDiskOfferingParams) SetIopsreadrate(v int64) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -178,6 +329,13 @@ func (p *CreateDiskOfferingParams) SetIopsreadrate(v int64) {
 	return
 }
 
+// SetIopsreadrateIfUnset sets iopsreadrate to v, unless it is already set
+func (p *CreateDiskOfferingParams) SetIopsreadrateIfUnset(v int64) {
+	if _, found := p.p["iopsreadrate"]; !found {
+		p.SetIopsreadrate(v)
+	}
+}
+
 func (p *CreateDiskOfferingParams) SetIopswriterate(v int64) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -186,6 +344,13 @@ func (p *CreateDiskOfferingParams) SetIopswriterate(v int64) {
 	return
 }
 
+// SetIopswriterateIfUnset sets iopswriterate to v, unless it is already set
+func (p *CreateDiskOfferingParams) SetIopswriterateIfUnset(v int64) {
+	if _, found := p.p["iopswriterate"]; !found {
+		p.SetIopswriterate(v)
+	}
+}
+
 func (p *CreateDiskOfferingParams) SetMaxiops(v int64) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -194,6 +359,13 @@ func (p *CreateDiskOfferingParams) SetMaxiops(v int64) {
 	return
 }
 
+// SetMaxiopsIfUnset sets maxiops to v, unless it is already set
+func (p *CreateDiskOfferingParams) SetMaxiopsIfUnset(v int64) {
+	if _, found := p.p["maxiops"]; !found {
+		p.SetMaxiops(v)
+	}
+}
+
 func (p *CreateDiskOfferingParams) SetMiniops(v int64) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -202,6 +374,13 @@ func (p *CreateDiskOfferingParams) SetMiniops(v int64) {
 	return
 }
 
+// SetMiniopsIfUnset sets miniops to v, unless it is already set
+func (p *CreateDiskOfferingParams) SetMiniopsIfUnset(v int64) {
+	if _, found := p.p["miniops"]; !found {
+		p.SetMiniops(v)
+	}
+}
+
 func (p *CreateDiskOfferingParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -210,22 +389,43 @@ func (p *CreateDiskOfferingParams) SetName(v string) {
 	return
 }
 
-func (p *CreateDiskOfferingParams) SetProvisioningtype(v string) {
+// SetNameIfUnset sets name to v, unless it is already set
+func (p *CreateDiskOfferingParams) SetNameIfUnset(v string) {
+	if _, found := p.p["name"]; !found {
+		p.SetName(v)
+	}
+}
+
+func (p *CreateDiskOfferingParams) SetProvisioningtype(v ProvisioningType) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	p.p["provisioningtype"] = v
+	p.p["provisioningtype"] = string(v)
 	return
 }
 
-func (p *CreateDiskOfferingParams) SetStoragetype(v string) {
+// SetProvisioningtypeIfUnset sets provisioningtype to v, unless it is already set
+func (p *CreateDiskOfferingParams) SetProvisioningtypeIfUnset(v ProvisioningType) {
+	if _, found := p.p["provisioningtype"]; !found {
+		p.SetProvisioningtype(v)
+	}
+}
+
+func (p *CreateDiskOfferingParams) SetStoragetype(v StorageType) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	p.p["storagetype"] = v
+	p.p["storagetype"] = string(v)
 	return
 }
 
+// SetStoragetypeIfUnset sets storagetype to v, unless it is already set
+func (p *CreateDiskOfferingParams) SetStoragetypeIfUnset(v StorageType) {
+	if _, found := p.p["storagetype"]; !found {
+		p.SetStoragetype(v)
+	}
+}
+
 func (p *CreateDiskOfferingParams) SetTags(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -234,19 +434,55 @@ func (p *CreateDiskOfferingParams) SetTags(v string) {
 	return
 }
 
+// SetTagsIfUnset sets tags to v, unless it is already set
+func (p *CreateDiskOfferingParams) SetTagsIfUnset(v string) {
+	if _, found := p.p["tags"]; !found {
+		p.SetTags(v)
+	}
+}
+
 // You should always use this function to get a new CreateDiskOfferingParams instance,
-// as then you are sure you have configured all required params
-func (s *DiskOfferingService) NewCreateDiskOfferingParams(displaytext string, name string) *CreateDiskOfferingParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *DiskOfferingService) NewCreateDiskOfferingParams(displaytext string, name string, opts ...func(*CreateDiskOfferingParams)) *CreateDiskOfferingParams {
 	p := &CreateDiskOfferingParams{}
 	p.p = make(map[string]interface{})
 	p.p["displaytext"] = displaytext
 	p.p["name"] = name
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Creates a disk offering.
-func (s *DiskOfferingService) CreateDiskOffering(p *CreateDiskOfferingParams) (*CreateDiskOfferingResponse, error) {
-	resp, err := s.cs.newRequest("createDiskOffering", p.toURLValues())
+func (s *DiskOfferingService) CreateDiskOffering(p *CreateDiskOfferingParams, opts ...OptionFunc) (*CreateDiskOfferingResponse, error) {
+	return s.CreateDiskOfferingWithContext(context.Background(), p, opts...)
+}
+
+// CreateDiskOfferingWithContext is the same as CreateDiskOffering, but the request is cancelled when the context is done
+func (s *DiskOfferingService) CreateDiskOfferingWithContext(ctx context.Context, p *CreateDiskOfferingParams, opts ...OptionFunc) (*CreateDiskOfferingResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.CreateDiskOfferingRawWithContext(ctx, p.toURLValues())
+}
+
+// CreateDiskOfferingRaw is the same as CreateDiskOffering, but takes the params as url.Values instead of typed params
+func (s *DiskOfferingService) CreateDiskOfferingRaw(v url.Values) (*CreateDiskOfferingResponse, error) {
+	return s.CreateDiskOfferingRawWithContext(context.Background(), v)
+}
+
+// CreateDiskOfferingRawWithContext is the same as CreateDiskOfferingRaw, but the request is cancelled when the context is done
+func (s *DiskOfferingService) CreateDiskOfferingRawWithContext(ctx context.Context, v url.Values) (*CreateDiskOfferingResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "createDiskOffering", "creatediskofferingresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -287,6 +523,16 @@ type DeleteDiskOfferingParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *DeleteDiskOfferingParams) Clone() *DeleteDiskOfferingParams {
+	c := &DeleteDiskOfferingParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeleteDiskOfferingParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -298,6 +544,16 @@ func (p *DeleteDiskOfferingParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *DeleteDiskOfferingParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of deleteDiskOffering", k)
+		}
+	}
+	return nil
+}
+
 func (p *DeleteDiskOfferingParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -306,18 +562,54 @@ func (p *DeleteDiskOfferingParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *DeleteDiskOfferingParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 // You should always use this function to get a new DeleteDiskOfferingParams instance,
-// as then you are sure you have configured all required params
-func (s *DiskOfferingService) NewDeleteDiskOfferingParams(id string) *DeleteDiskOfferingParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *DiskOfferingService) NewDeleteDiskOfferingParams(id string, opts ...func(*DeleteDiskOfferingParams)) *DeleteDiskOfferingParams {
 	p := &DeleteDiskOfferingParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Updates a disk offering.
-func (s *DiskOfferingService) DeleteDiskOffering(p *DeleteDiskOfferingParams) (*DeleteDiskOfferingResponse, error) {
-	resp, err := s.cs.newRequest("deleteDiskOffering", p.toURLValues())
+func (s *DiskOfferingService) DeleteDiskOffering(p *DeleteDiskOfferingParams, opts ...OptionFunc) (*DeleteDiskOfferingResponse, error) {
+	return s.DeleteDiskOfferingWithContext(context.Background(), p, opts...)
+}
+
+// DeleteDiskOfferingWithContext is the same as DeleteDiskOffering, but the request is cancelled when the context is done
+func (s *DiskOfferingService) DeleteDiskOfferingWithContext(ctx context.Context, p *DeleteDiskOfferingParams, opts ...OptionFunc) (*DeleteDiskOfferingResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.DeleteDiskOfferingRawWithContext(ctx, p.toURLValues())
+}
+
+// DeleteDiskOfferingRaw is the same as DeleteDiskOffering, but takes the params as url.Values instead of typed params
+func (s *DiskOfferingService) DeleteDiskOfferingRaw(v url.Values) (*DeleteDiskOfferingResponse, error) {
+	return s.DeleteDiskOfferingRawWithContext(context.Background(), v)
+}
+
+// DeleteDiskOfferingRawWithContext is the same as DeleteDiskOfferingRaw, but the request is cancelled when the context is done
+func (s *DiskOfferingService) DeleteDiskOfferingRawWithContext(ctx context.Context, v url.Values) (*DeleteDiskOfferingResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteDiskOffering", "deletediskofferingresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -330,6 +622,34 @@ func (s *DiskOfferingService) DeleteDiskOffering(p *DeleteDiskOfferingParams) (*
 	return &r, nil
 }
 
+// WaitForDiskOfferingDeleted polls until the DiskOffering with the given ID no longer exists, the timeout
+// expires or the context is cancelled. Deleted resources may still be listed for a short while
+// after the delete command finished, so this can be used to make sure they are really gone. The
+// options are passed to GetDiskOfferingByID, so a resource outside of the default scope can be found.
+func (s *DiskOfferingService) WaitForDiskOfferingDeleted(ctx context.Context, id string, timeout time.Duration, opts ...OptionFunc) error {
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	for {
+		_, count, err := s.GetDiskOfferingByID(id, opts...)
+		if count == 0 {
+			return nil
+		}
+		if count < 0 {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(2 * time.Second):
+		}
+	}
+}
+
 type DeleteDiskOfferingResponse struct {
 	Displaytext string `json:"displaytext"`
 	Success     bool   `json:"success"`
@@ -358,6 +678,16 @@ type ListDiskOfferingsParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ListDiskOfferingsParams) Clone() *ListDiskOfferingsParams {
+	c := &ListDiskOfferingsParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListDiskOfferingsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -402,6 +732,13 @@ func (p *ListDiskOfferingsParams) SetDomainid(v string) {
 	return
 }
 
+// SetDomainidIfUnset sets domainid to v, unless it is already set
+func (p *ListDiskOfferingsParams) SetDomainidIfUnset(v string) {
+	if _, found := p.p["domainid"]; !found {
+		p.SetDomainid(v)
+	}
+}
+
 func (p *ListDiskOfferingsParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -410,6 +747,13 @@ func (p *ListDiskOfferingsParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *ListDiskOfferingsParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 func (p *ListDiskOfferingsParams) SetIsrecursive(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -418,6 +762,13 @@ func (p *ListDiskOfferingsParams) SetIsrecursive(v bool) {
 	return
 }
 
+// SetIsrecursiveIfUnset sets isrecursive to v, unless it is already set
+func (p *ListDiskOfferingsParams) SetIsrecursiveIfUnset(v bool) {
+	if _, found := p.p["isrecursive"]; !found {
+		p.SetIsrecursive(v)
+	}
+}
+
 func (p *ListDiskOfferingsParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -426,6 +777,13 @@ func (p *ListDiskOfferingsParams) SetKeyword(v string) {
 	return
 }
 
+// SetKeywordIfUnset sets keyword to v, unless it is already set
+func (p *ListDiskOfferingsParams) SetKeywordIfUnset(v string) {
+	if _, found := p.p["keyword"]; !found {
+		p.SetKeyword(v)
+	}
+}
+
 func (p *ListDiskOfferingsParams) SetListall(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -434,6 +792,13 @@ func (p *ListDiskOfferingsParams) SetListall(v bool) {
 	return
 }
 
+// SetListallIfUnset sets listall to v, unless it is already set
+func (p *ListDiskOfferingsParams) SetListallIfUnset(v bool) {
+	if _, found := p.p["listall"]; !found {
+		p.SetListall(v)
+	}
+}
+
 func (p *ListDiskOfferingsParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -442,6 +807,13 @@ func (p *ListDiskOfferingsParams) SetName(v string) {
 	return
 }
 
+// SetNameIfUnset sets name to v, unless it is already set
+func (p *ListDiskOfferingsParams) SetNameIfUnset(v string) {
+	if _, found := p.p["name"]; !found {
+		p.SetName(v)
+	}
+}
+
 func (p *ListDiskOfferingsParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -450,6 +822,13 @@ func (p *ListDiskOfferingsParams) SetPage(v int) {
 	return
 }
 
+// SetPageIfUnset sets page to v, unless it is already set
+func (p *ListDiskOfferingsParams) SetPageIfUnset(v int) {
+	if _, found := p.p["page"]; !found {
+		p.SetPage(v)
+	}
+}
+
 func (p *ListDiskOfferingsParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -458,14 +837,34 @@ func (p *ListDiskOfferingsParams) SetPagesize(v int) {
 	return
 }
 
+// SetPagesizeIfUnset sets pagesize to v, unless it is already set
+func (p *ListDiskOfferingsParams) SetPagesizeIfUnset(v int) {
+	if _, found := p.p["pagesize"]; !found {
+		p.SetPagesize(v)
+	}
+}
+
+var _ ListAllSetter = (*ListDiskOfferingsParams)(nil)
+
 // You should always use this function to get a new ListDiskOfferingsParams instance,
-// as then you are sure you have configured all required params
-func (s *DiskOfferingService) NewListDiskOfferingsParams() *ListDiskOfferingsParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *DiskOfferingService) NewListDiskOfferingsParams(opts ...func(*ListDiskOfferingsParams)) *ListDiskOfferingsParams {
 	p := &ListDiskOfferingsParams{}
 	p.p = make(map[string]interface{})
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
+func (p *ListDiskOfferingsParams) setFirstMatch() {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p[firstMatchKey] = true
+}
+
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *DiskOfferingService) GetDiskOfferingID(name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListDiskOfferingsParams{}
@@ -473,7 +872,7 @@ func (s *DiskOfferingService) GetDiskOfferingID(name string, opts ...OptionFunc)
 
 	p.p["name"] = name
 
-	for _, fn := range append(s.cs.options, opts...) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
 		if err := fn(s.cs, p); err != nil {
 			return "", -1, err
 		}
@@ -485,7 +884,7 @@ func (s *DiskOfferingService) GetDiskOfferingID(name string, opts ...OptionFunc)
 	}
 
 	if l.Count == 0 {
-		return "", l.Count, fmt.Errorf("No match found for %s: %+v", name, l)
+		return "", l.Count, fmt.Errorf("%w for %s: %+v", ErrNotFound, name, l)
 	}
 
 	if l.Count == 1 {
@@ -498,6 +897,10 @@ func (s *DiskOfferingService) GetDiskOfferingID(name string, opts ...OptionFunc)
 				return v.Id, l.Count, nil
 			}
 		}
+
+		if _, ok := p.p[firstMatchKey]; ok && len(l.DiskOfferings) > 0 {
+			return l.DiskOfferings[0].Id, l.Count, nil
+		}
 	}
 	return "", l.Count, fmt.Errorf("Could not find an exact match for %s: %+v", name, l)
 }
@@ -523,7 +926,7 @@ func (s *DiskOfferingService) GetDiskOfferingByID(id string, opts ...OptionFunc)
 
 	p.p["id"] = id
 
-	for _, fn := range append(s.cs.options, opts...) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
 		if err := fn(s.cs, p); err != nil {
 			return nil, -1, err
 		}
@@ -534,24 +937,56 @@ func (s *DiskOfferingService) GetDiskOfferingByID(id string, opts ...OptionFunc)
 		if strings.Contains(err.Error(), fmt.Sprintf(
 			"Invalid parameter id value=%s due to incorrect long value format, "+
 				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+			return nil, 0, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
 	}
 
 	if l.Count == 1 {
 		return l.DiskOfferings[0], l.Count, nil
 	}
+
+	if _, ok := p.p[firstMatchKey]; ok && len(l.DiskOfferings) > 0 {
+		return l.DiskOfferings[0], l.Count, nil
+	}
 	return nil, l.Count, fmt.Errorf("There is more then one result for DiskOffering UUID: %s!", id)
 }
 
+// DiskOfferingExists returns true if a DiskOffering with the given ID exists. When no match is found
+// it returns false, while any other error is returned as is.
+func (s *DiskOfferingService) DiskOfferingExists(id string, opts ...OptionFunc) (bool, error) {
+	_, count, err := s.GetDiskOfferingByID(id, opts...)
+	if errors.Is(err, ErrNotFound) {
+		return false, nil
+	}
+	if count > 0 {
+		return true, nil
+	}
+	return false, err
+}
+
 // Lists all available disk offerings.
 func (s *DiskOfferingService) ListDiskOfferings(p *ListDiskOfferingsParams) (*ListDiskOfferingsResponse, error) {
-	resp, err := s.cs.newRequest("listDiskOfferings", p.toURLValues())
+	return s.ListDiskOfferingsWithContext(context.Background(), p)
+}
+
+// ListDiskOfferingsWithContext is the same as ListDiskOfferings, but the request is cancelled when the context is done
+func (s *DiskOfferingService) ListDiskOfferingsWithContext(ctx context.Context, p *ListDiskOfferingsParams) (*ListDiskOfferingsResponse, error) {
+	return s.ListDiskOfferingsRawWithContext(ctx, p.toURLValues())
+}
+
+// ListDiskOfferingsRaw is the same as ListDiskOfferings, but takes the params as url.Values instead of typed params
+func (s *DiskOfferingService) ListDiskOfferingsRaw(v url.Values) (*ListDiskOfferingsResponse, error) {
+	return s.ListDiskOfferingsRawWithContext(context.Background(), v)
+}
+
+// ListDiskOfferingsRawWithContext is the same as ListDiskOfferingsRaw, but the request is cancelled when the context is done
+func (s *DiskOfferingService) ListDiskOfferingsRawWithContext(ctx context.Context, v url.Values) (*ListDiskOfferingsResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "listDiskOfferings", "listdiskofferingsresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -564,6 +999,93 @@ func (s *DiskOfferingService) ListDiskOfferings(p *ListDiskOfferingsParams) (*Li
 	return &r, nil
 }
 
+// StreamDiskOfferings fetches the results of ListDiskOfferings page by page in the background and sends them on the
+// returned channel. Both returned channels are closed when done. If fetching a page fails, or the context
+// is done before all results are sent, the error is sent on the error channel and fetching stops. A
+// request for a page in flight is cancelled when the context is done. The page and pagesize set in the
+// params are ignored.
+func (s *DiskOfferingService) StreamDiskOfferings(ctx context.Context, p *ListDiskOfferingsParams) (<-chan *DiskOffering, <-chan error) {
+	ch := make(chan *DiskOffering)
+	errs := make(chan error, 1)
+
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesize(500)
+
+	go func() {
+		defer close(errs)
+		defer close(ch)
+
+		for page, count := 1, 0; ; page++ {
+			pp.SetPage(page)
+
+			l, err := s.ListDiskOfferingsWithContext(ctx, pp)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			for _, v := range l.DiskOfferings {
+				select {
+				case ch <- v:
+				case <-ctx.Done():
+					errs <- ctx.Err()
+					return
+				}
+			}
+
+			count += len(l.DiskOfferings)
+			if len(l.DiskOfferings) < 500 || count >= l.Count {
+				return
+			}
+		}
+	}()
+
+	return ch, errs
+}
+
+// ListDiskOfferingsWhere returns all results of ListDiskOfferings for which pred returns true. Note that the results are
+// filtered client-side, so all results matching the params are fetched first.
+// All pages are fetched, so the page and pagesize set in the params are ignored.
+func (s *DiskOfferingService) ListDiskOfferingsWhere(p *ListDiskOfferingsParams, pred func(*DiskOffering) bool) ([]*DiskOffering, error) {
+	var r []*DiskOffering
+	ch, errs := s.StreamDiskOfferings(context.Background(), p)
+	for v := range ch {
+		if pred(v) {
+			r = append(r, v)
+		}
+	}
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ListAllDiskOfferings returns the results of ListDiskOfferings across all pages, by calling ListDiskOfferings page by page until all
+// results are fetched. The pagesize set in the params is used for every page (500 if not set), and the
+// page set in the params is ignored.
+func (s *DiskOfferingService) ListAllDiskOfferings(p *ListDiskOfferingsParams) ([]*DiskOffering, error) {
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesizeIfUnset(500)
+	pagesize := pp.p["pagesize"].(int)
+
+	var r []*DiskOffering
+	for page := 1; ; page++ {
+		pp.SetPage(page)
+
+		l, err := s.ListDiskOfferings(pp)
+		if err != nil {
+			return nil, err
+		}
+		r = append(r, l.DiskOfferings...)
+
+		if len(l.DiskOfferings) < pagesize || len(r) >= l.Count {
+			return r, nil
+		}
+	}
+}
+
 type ListDiskOfferingsResponse struct {
 	Count         int             `json:"count"`
 	DiskOfferings []*DiskOffering `json:"diskoffering"`
@@ -597,6 +1119,16 @@ type UpdateDiskOfferingParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *UpdateDiskOfferingParams) Clone() *UpdateDiskOfferingParams {
+	c := &UpdateDiskOfferingParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *UpdateDiskOfferingParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -622,6 +1154,16 @@ func (p *UpdateDiskOfferingParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *UpdateDiskOfferingParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of updateDiskOffering", k)
+		}
+	}
+	return nil
+}
+
 func (p *UpdateDiskOfferingParams) SetDisplayoffering(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -630,6 +1172,13 @@ func (p *UpdateDiskOfferingParams) SetDisplayoffering(v bool) {
 	return
 }
 
+// SetDisplayofferingIfUnset sets displayoffering to v, unless it is already set
+func (p *UpdateDiskOfferingParams) SetDisplayofferingIfUnset(v bool) {
+	if _, found := p.p["displayoffering"]; !found {
+		p.SetDisplayoffering(v)
+	}
+}
+
 func (p *UpdateDiskOfferingParams) SetDisplaytext(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -638,6 +1187,13 @@ func (p *UpdateDiskOfferingParams) SetDisplaytext(v string) {
 	return
 }
 
+// SetDisplaytextIfUnset sets displaytext to v, unless it is already set
+func (p *UpdateDiskOfferingParams) SetDisplaytextIfUnset(v string) {
+	if _, found := p.p["displaytext"]; !found {
+		p.SetDisplaytext(v)
+	}
+}
+
 func (p *UpdateDiskOfferingParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -646,6 +1202,13 @@ func (p *UpdateDiskOfferingParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *UpdateDiskOfferingParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 func (p *UpdateDiskOfferingParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -654,6 +1217,13 @@ func (p *UpdateDiskOfferingParams) SetName(v string) {
 	return
 }
 
+// SetNameIfUnset sets name to v, unless it is already set
+func (p *UpdateDiskOfferingParams) SetNameIfUnset(v string) {
+	if _, found := p.p["name"]; !found {
+		p.SetName(v)
+	}
+}
+
 func (p *UpdateDiskOfferingParams) SetSortkey(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -662,18 +1232,54 @@ func (p *UpdateDiskOfferingParams) SetSortkey(v int) {
 	return
 }
 
+// SetSortkeyIfUnset sets sortkey to v, unless it is already set
+func (p *UpdateDiskOfferingParams) SetSortkeyIfUnset(v int) {
+	if _, found := p.p["sortkey"]; !found {
+		p.SetSortkey(v)
+	}
+}
+
 // You should always use this function to get a new UpdateDiskOfferingParams instance,
-// as then you are sure you have configured all required params
-func (s *DiskOfferingService) NewUpdateDiskOfferingParams(id string) *UpdateDiskOfferingParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *DiskOfferingService) NewUpdateDiskOfferingParams(id string, opts ...func(*UpdateDiskOfferingParams)) *UpdateDiskOfferingParams {
 	p := &UpdateDiskOfferingParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Updates a disk offering.
-func (s *DiskOfferingService) UpdateDiskOffering(p *UpdateDiskOfferingParams) (*UpdateDiskOfferingResponse, error) {
-	resp, err := s.cs.newRequest("updateDiskOffering", p.toURLValues())
+func (s *DiskOfferingService) UpdateDiskOffering(p *UpdateDiskOfferingParams, opts ...OptionFunc) (*UpdateDiskOfferingResponse, error) {
+	return s.UpdateDiskOfferingWithContext(context.Background(), p, opts...)
+}
+
+// UpdateDiskOfferingWithContext is the same as UpdateDiskOffering, but the request is cancelled when the context is done
+func (s *DiskOfferingService) UpdateDiskOfferingWithContext(ctx context.Context, p *UpdateDiskOfferingParams, opts ...OptionFunc) (*UpdateDiskOfferingResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.UpdateDiskOfferingRawWithContext(ctx, p.toURLValues())
+}
+
+// UpdateDiskOfferingRaw is the same as UpdateDiskOffering, but takes the params as url.Values instead of typed params
+func (s *DiskOfferingService) UpdateDiskOfferingRaw(v url.Values) (*UpdateDiskOfferingResponse, error) {
+	return s.UpdateDiskOfferingRawWithContext(context.Background(), v)
+}
+
+// UpdateDiskOfferingRawWithContext is the same as UpdateDiskOfferingRaw, but the request is cancelled when the context is done
+func (s *DiskOfferingService) UpdateDiskOfferingRawWithContext(ctx context.Context, v url.Values) (*UpdateDiskOfferingResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "updateDiskOffering", "updatediskofferingresponse", v)
 	if err != nil {
 		return nil, err
 	}
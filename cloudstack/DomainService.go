@@ -17,17 +17,67 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/url"
 	"strconv"
 	"strings"
+	"time"
 )
 
+// UpdateMany runs UpdateDomain for all given IDs in parallel, using at most concurrency concurrent requests.
+// For each ID the params are populated with the ID and then passed to mutate, which should set the
+// changes to apply. The result contains the updated resources (in the order of the IDs) and the error
+// of every update that failed by ID. The returned error contains all failures, or is nil if none failed.
+// The updated resources are returned as the UpdateDomainResponse of every update, which contains the same
+// fields as the resource itself, so no additional request is needed per resource to get them. The
+// options are used for every update.
+func (s *DomainService) UpdateMany(ids []string, mutate func(*UpdateDomainParams), concurrency int, opts ...OptionFunc) (BulkResult[*UpdateDomainResponse], error) {
+	rs := make([]*UpdateDomainResponse, len(ids))
+	errs := make([]error, len(ids))
+
+	runConcurrently(len(ids), concurrency, func(i int) {
+		p := &UpdateDomainParams{p: map[string]interface{}{"id": ids[i]}}
+		if mutate != nil {
+			mutate(p)
+		}
+
+		r, err := s.UpdateDomain(p, opts...)
+		if err != nil {
+			errs[i] = err
+			return
+		}
+		rs[i] = r
+	})
+
+	var r BulkResult[*UpdateDomainResponse]
+	for i, id := range ids {
+		if errs[i] != nil {
+			r.fail(id, errs[i])
+			continue
+		}
+		r.Succeeded = append(r.Succeeded, rs[i])
+	}
+
+	return r, r.Err()
+}
+
 type CreateDomainParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *CreateDomainParams) Clone() *CreateDomainParams {
+	c := &CreateDomainParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *CreateDomainParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -48,6 +98,16 @@ func (p *CreateDomainParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *CreateDomainParams) validate() error {
+	for _, k := range []string{"name"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of createDomain", k)
+		}
+	}
+	return nil
+}
+
 func (p *CreateDomainParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -56,6 +116,13 @@ func (p *CreateDomainParams) SetDomainid(v string) {
 	return
 }
 
+// SetDomainidIfUnset sets domainid to v, unless it is already set
+func (p *CreateDomainParams) SetDomainidIfUnset(v string) {
+	if _, found := p.p["domainid"]; !found {
+		p.SetDomainid(v)
+	}
+}
+
 func (p *CreateDomainParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -64,6 +131,13 @@ func (p *CreateDomainParams) SetName(v string) {
 	return
 }
 
+// SetNameIfUnset sets name to v, unless it is already set
+func (p *CreateDomainParams) SetNameIfUnset(v string) {
+	if _, found := p.p["name"]; !found {
+		p.SetName(v)
+	}
+}
+
 func (p *CreateDomainParams) SetNetworkdomain(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -72,6 +146,13 @@ func (p *CreateDomainParams) SetNetworkdomain(v string) {
 	return
 }
 
+// SetNetworkdomainIfUnset sets networkdomain to v, unless it is already set
+func (p *CreateDomainParams) SetNetworkdomainIfUnset(v string) {
+	if _, found := p.p["networkdomain"]; !found {
+		p.SetNetworkdomain(v)
+	}
+}
+
 func (p *CreateDomainParams) SetParentdomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -80,18 +161,54 @@ func (p *CreateDomainParams) SetParentdomainid(v string) {
 	return
 }
 
+// SetParentdomainidIfUnset sets parentdomainid to v, unless it is already set
+func (p *CreateDomainParams) SetParentdomainidIfUnset(v string) {
+	if _, found := p.p["parentdomainid"]; !found {
+		p.SetParentdomainid(v)
+	}
+}
+
 // You should always use this function to get a new CreateDomainParams instance,
-// as then you are sure you have configured all required params
-func (s *DomainService) NewCreateDomainParams(name string) *CreateDomainParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *DomainService) NewCreateDomainParams(name string, opts ...func(*CreateDomainParams)) *CreateDomainParams {
 	p := &CreateDomainParams{}
 	p.p = make(map[string]interface{})
 	p.p["name"] = name
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Creates a domain
-func (s *DomainService) CreateDomain(p *CreateDomainParams) (*CreateDomainResponse, error) {
-	resp, err := s.cs.newRequest("createDomain", p.toURLValues())
+func (s *DomainService) CreateDomain(p *CreateDomainParams, opts ...OptionFunc) (*CreateDomainResponse, error) {
+	return s.CreateDomainWithContext(context.Background(), p, opts...)
+}
+
+// CreateDomainWithContext is the same as CreateDomain, but the request is cancelled when the context is done
+func (s *DomainService) CreateDomainWithContext(ctx context.Context, p *CreateDomainParams, opts ...OptionFunc) (*CreateDomainResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.CreateDomainRawWithContext(ctx, p.toURLValues())
+}
+
+// CreateDomainRaw is the same as CreateDomain, but takes the params as url.Values instead of typed params
+func (s *DomainService) CreateDomainRaw(v url.Values) (*CreateDomainResponse, error) {
+	return s.CreateDomainRawWithContext(context.Background(), v)
+}
+
+// CreateDomainRawWithContext is the same as CreateDomainRaw, but the request is cancelled when the context is done
+func (s *DomainService) CreateDomainRawWithContext(ctx context.Context, v url.Values) (*CreateDomainResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "createDomain", "createdomainresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -156,6 +273,16 @@ type DeleteDomainParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *DeleteDomainParams) Clone() *DeleteDomainParams {
+	c := &DeleteDomainParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeleteDomainParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -171,6 +298,16 @@ func (p *DeleteDomainParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *DeleteDomainParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of deleteDomain", k)
+		}
+	}
+	return nil
+}
+
 func (p *DeleteDomainParams) SetCleanup(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -179,6 +316,13 @@ func (p *DeleteDomainParams) SetCleanup(v bool) {
 	return
 }
 
+// SetCleanupIfUnset sets cleanup to v, unless it is already set
+func (p *DeleteDomainParams) SetCleanupIfUnset(v bool) {
+	if _, found := p.p["cleanup"]; !found {
+		p.SetCleanup(v)
+	}
+}
+
 func (p *DeleteDomainParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -187,18 +331,54 @@ func (p *DeleteDomainParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *DeleteDomainParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 // You should always use this function to get a new DeleteDomainParams instance,
-// as then you are sure you have configured all required params
-func (s *DomainService) NewDeleteDomainParams(id string) *DeleteDomainParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *DomainService) NewDeleteDomainParams(id string, opts ...func(*DeleteDomainParams)) *DeleteDomainParams {
 	p := &DeleteDomainParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Deletes a specified domain
-func (s *DomainService) DeleteDomain(p *DeleteDomainParams) (*DeleteDomainResponse, error) {
-	resp, err := s.cs.newRequest("deleteDomain", p.toURLValues())
+func (s *DomainService) DeleteDomain(p *DeleteDomainParams, opts ...OptionFunc) (*DeleteDomainResponse, error) {
+	return s.DeleteDomainWithContext(context.Background(), p, opts...)
+}
+
+// DeleteDomainWithContext is the same as DeleteDomain, but the request is cancelled when the context is done
+func (s *DomainService) DeleteDomainWithContext(ctx context.Context, p *DeleteDomainParams, opts ...OptionFunc) (*DeleteDomainResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.DeleteDomainRawWithContext(ctx, p.toURLValues())
+}
+
+// DeleteDomainRaw is the same as DeleteDomain, but takes the params as url.Values instead of typed params
+func (s *DomainService) DeleteDomainRaw(v url.Values) (*DeleteDomainResponse, error) {
+	return s.DeleteDomainRawWithContext(context.Background(), v)
+}
+
+// DeleteDomainRawWithContext is the same as DeleteDomainRaw, but the request is cancelled when the context is done
+func (s *DomainService) DeleteDomainRawWithContext(ctx context.Context, v url.Values) (*DeleteDomainResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteDomain", "deletedomainresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -210,9 +390,9 @@ func (s *DomainService) DeleteDomain(p *DeleteDomainParams) (*DeleteDomainRespon
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -226,6 +406,34 @@ func (s *DomainService) DeleteDomain(p *DeleteDomainParams) (*DeleteDomainRespon
 	return &r, nil
 }
 
+// WaitForDomainDeleted polls until the Domain with the given ID no longer exists, the timeout
+// expires or the context is cancelled. Deleted resources may still be listed for a short while
+// after the delete command finished, so this can be used to make sure they are really gone. The
+// options are passed to GetDomainByID, so a resource outside of the default scope can be found.
+func (s *DomainService) WaitForDomainDeleted(ctx context.Context, id string, timeout time.Duration, opts ...OptionFunc) error {
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	for {
+		_, count, err := s.GetDomainByID(id, opts...)
+		if count == 0 {
+			return nil
+		}
+		if count < 0 {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(2 * time.Second):
+		}
+	}
+}
+
 type DeleteDomainResponse struct {
 	JobID       string `json:"jobid"`
 	Displaytext string `json:"displaytext"`
@@ -236,6 +444,16 @@ type ListDomainChildrenParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ListDomainChildrenParams) Clone() *ListDomainChildrenParams {
+	c := &ListDomainChildrenParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListDomainChildrenParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -277,6 +495,13 @@ func (p *ListDomainChildrenParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *ListDomainChildrenParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 func (p *ListDomainChildrenParams) SetIsrecursive(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -285,6 +510,13 @@ func (p *ListDomainChildrenParams) SetIsrecursive(v bool) {
 	return
 }
 
+// SetIsrecursiveIfUnset sets isrecursive to v, unless it is already set
+func (p *ListDomainChildrenParams) SetIsrecursiveIfUnset(v bool) {
+	if _, found := p.p["isrecursive"]; !found {
+		p.SetIsrecursive(v)
+	}
+}
+
 func (p *ListDomainChildrenParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -293,6 +525,13 @@ func (p *ListDomainChildrenParams) SetKeyword(v string) {
 	return
 }
 
+// SetKeywordIfUnset sets keyword to v, unless it is already set
+func (p *ListDomainChildrenParams) SetKeywordIfUnset(v string) {
+	if _, found := p.p["keyword"]; !found {
+		p.SetKeyword(v)
+	}
+}
+
 func (p *ListDomainChildrenParams) SetListall(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -301,6 +540,13 @@ func (p *ListDomainChildrenParams) SetListall(v bool) {
 	return
 }
 
+// SetListallIfUnset sets listall to v, unless it is already set
+func (p *ListDomainChildrenParams) SetListallIfUnset(v bool) {
+	if _, found := p.p["listall"]; !found {
+		p.SetListall(v)
+	}
+}
+
 func (p *ListDomainChildrenParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -309,6 +555,13 @@ func (p *ListDomainChildrenParams) SetName(v string) {
 	return
 }
 
+// SetNameIfUnset sets name to v, unless it is already set
+func (p *ListDomainChildrenParams) SetNameIfUnset(v string) {
+	if _, found := p.p["name"]; !found {
+		p.SetName(v)
+	}
+}
+
 func (p *ListDomainChildrenParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -317,6 +570,13 @@ func (p *ListDomainChildrenParams) SetPage(v int) {
 	return
 }
 
+// SetPageIfUnset sets page to v, unless it is already set
+func (p *ListDomainChildrenParams) SetPageIfUnset(v int) {
+	if _, found := p.p["page"]; !found {
+		p.SetPage(v)
+	}
+}
+
 func (p *ListDomainChildrenParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -325,14 +585,34 @@ func (p *ListDomainChildrenParams) SetPagesize(v int) {
 	return
 }
 
+// SetPagesizeIfUnset sets pagesize to v, unless it is already set
+func (p *ListDomainChildrenParams) SetPagesizeIfUnset(v int) {
+	if _, found := p.p["pagesize"]; !found {
+		p.SetPagesize(v)
+	}
+}
+
+var _ ListAllSetter = (*ListDomainChildrenParams)(nil)
+
 // You should always use this function to get a new ListDomainChildrenParams instance,
-// as then you are sure you have configured all required params
-func (s *DomainService) NewListDomainChildrenParams() *ListDomainChildrenParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *DomainService) NewListDomainChildrenParams(opts ...func(*ListDomainChildrenParams)) *ListDomainChildrenParams {
 	p := &ListDomainChildrenParams{}
 	p.p = make(map[string]interface{})
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
+func (p *ListDomainChildrenParams) setFirstMatch() {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p[firstMatchKey] = true
+}
+
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *DomainService) GetDomainChildrenID(name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListDomainChildrenParams{}
@@ -340,7 +620,7 @@ func (s *DomainService) GetDomainChildrenID(name string, opts ...OptionFunc) (st
 
 	p.p["name"] = name
 
-	for _, fn := range append(s.cs.options, opts...) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
 		if err := fn(s.cs, p); err != nil {
 			return "", -1, err
 		}
@@ -352,7 +632,7 @@ func (s *DomainService) GetDomainChildrenID(name string, opts ...OptionFunc) (st
 	}
 
 	if l.Count == 0 {
-		return "", l.Count, fmt.Errorf("No match found for %s: %+v", name, l)
+		return "", l.Count, fmt.Errorf("%w for %s: %+v", ErrNotFound, name, l)
 	}
 
 	if l.Count == 1 {
@@ -365,6 +645,10 @@ func (s *DomainService) GetDomainChildrenID(name string, opts ...OptionFunc) (st
 				return v.Id, l.Count, nil
 			}
 		}
+
+		if _, ok := p.p[firstMatchKey]; ok && len(l.DomainChildren) > 0 {
+			return l.DomainChildren[0].Id, l.Count, nil
+		}
 	}
 	return "", l.Count, fmt.Errorf("Could not find an exact match for %s: %+v", name, l)
 }
@@ -390,7 +674,7 @@ func (s *DomainService) GetDomainChildrenByID(id string, opts ...OptionFunc) (*D
 
 	p.p["id"] = id
 
-	for _, fn := range append(s.cs.options, opts...) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
 		if err := fn(s.cs, p); err != nil {
 			return nil, -1, err
 		}
@@ -401,24 +685,56 @@ func (s *DomainService) GetDomainChildrenByID(id string, opts ...OptionFunc) (*D
 		if strings.Contains(err.Error(), fmt.Sprintf(
 			"Invalid parameter id value=%s due to incorrect long value format, "+
 				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+			return nil, 0, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
 	}
 
 	if l.Count == 1 {
 		return l.DomainChildren[0], l.Count, nil
 	}
+
+	if _, ok := p.p[firstMatchKey]; ok && len(l.DomainChildren) > 0 {
+		return l.DomainChildren[0], l.Count, nil
+	}
 	return nil, l.Count, fmt.Errorf("There is more then one result for DomainChildren UUID: %s!", id)
 }
 
+// DomainChildrenExists returns true if a DomainChildren with the given ID exists. When no match is found
+// it returns false, while any other error is returned as is.
+func (s *DomainService) DomainChildrenExists(id string, opts ...OptionFunc) (bool, error) {
+	_, count, err := s.GetDomainChildrenByID(id, opts...)
+	if errors.Is(err, ErrNotFound) {
+		return false, nil
+	}
+	if count > 0 {
+		return true, nil
+	}
+	return false, err
+}
+
 // Lists all children domains belonging to a specified domain
 func (s *DomainService) ListDomainChildren(p *ListDomainChildrenParams) (*ListDomainChildrenResponse, error) {
-	resp, err := s.cs.newRequest("listDomainChildren", p.toURLValues())
+	return s.ListDomainChildrenWithContext(context.Background(), p)
+}
+
+// ListDomainChildrenWithContext is the same as ListDomainChildren, but the request is cancelled when the context is done
+func (s *DomainService) ListDomainChildrenWithContext(ctx context.Context, p *ListDomainChildrenParams) (*ListDomainChildrenResponse, error) {
+	return s.ListDomainChildrenRawWithContext(ctx, p.toURLValues())
+}
+
+// ListDomainChildrenRaw is the same as ListDomainChildren, but takes the params as url.Values instead of typed params
+func (s *DomainService) ListDomainChildrenRaw(v url.Values) (*ListDomainChildrenResponse, error) {
+	return s.ListDomainChildrenRawWithContext(context.Background(), v)
+}
+
+// ListDomainChildrenRawWithContext is the same as ListDomainChildrenRaw, but the request is cancelled when the context is done
+func (s *DomainService) ListDomainChildrenRawWithContext(ctx context.Context, v url.Values) (*ListDomainChildrenResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "listDomainChildren", "listdomainchildrenresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -431,6 +747,93 @@ func (s *DomainService) ListDomainChildren(p *ListDomainChildrenParams) (*ListDo
 	return &r, nil
 }
 
+// StreamDomainChildren fetches the results of ListDomainChildren page by page in the background and sends them on the
+// returned channel. Both returned channels are closed when done. If fetching a page fails, or the context
+// is done before all results are sent, the error is sent on the error channel and fetching stops. A
+// request for a page in flight is cancelled when the context is done. The page and pagesize set in the
+// params are ignored.
+func (s *DomainService) StreamDomainChildren(ctx context.Context, p *ListDomainChildrenParams) (<-chan *DomainChildren, <-chan error) {
+	ch := make(chan *DomainChildren)
+	errs := make(chan error, 1)
+
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesize(500)
+
+	go func() {
+		defer close(errs)
+		defer close(ch)
+
+		for page, count := 1, 0; ; page++ {
+			pp.SetPage(page)
+
+			l, err := s.ListDomainChildrenWithContext(ctx, pp)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			for _, v := range l.DomainChildren {
+				select {
+				case ch <- v:
+				case <-ctx.Done():
+					errs <- ctx.Err()
+					return
+				}
+			}
+
+			count += len(l.DomainChildren)
+			if len(l.DomainChildren) < 500 || count >= l.Count {
+				return
+			}
+		}
+	}()
+
+	return ch, errs
+}
+
+// ListDomainChildrenWhere returns all results of ListDomainChildren for which pred returns true. Note that the results are
+// filtered client-side, so all results matching the params are fetched first.
+// All pages are fetched, so the page and pagesize set in the params are ignored.
+func (s *DomainService) ListDomainChildrenWhere(p *ListDomainChildrenParams, pred func(*DomainChildren) bool) ([]*DomainChildren, error) {
+	var r []*DomainChildren
+	ch, errs := s.StreamDomainChildren(context.Background(), p)
+	for v := range ch {
+		if pred(v) {
+			r = append(r, v)
+		}
+	}
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ListAllDomainChildren returns the results of ListDomainChildren across all pages, by calling ListDomainChildren page by page until all
+// results are fetched. The pagesize set in the params is used for every page (500 if not set), and the
+// page set in the params is ignored.
+func (s *DomainService) ListAllDomainChildren(p *ListDomainChildrenParams) ([]*DomainChildren, error) {
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesizeIfUnset(500)
+	pagesize := pp.p["pagesize"].(int)
+
+	var r []*DomainChildren
+	for page := 1; ; page++ {
+		pp.SetPage(page)
+
+		l, err := s.ListDomainChildren(pp)
+		if err != nil {
+			return nil, err
+		}
+		r = append(r, l.DomainChildren...)
+
+		if len(l.DomainChildren) < pagesize || len(r) >= l.Count {
+			return r, nil
+		}
+	}
+}
+
 type ListDomainChildrenResponse struct {
 	Count          int               `json:"count"`
 	DomainChildren []*DomainChildren `json:"domainchildren"`
@@ -488,6 +891,16 @@ type ListDomainsParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ListDomainsParams) Clone() *ListDomainsParams {
+	c := &ListDomainsParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListDomainsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -529,6 +942,13 @@ func (p *ListDomainsParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *ListDomainsParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 func (p *ListDomainsParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -537,6 +957,13 @@ func (p *ListDomainsParams) SetKeyword(v string) {
 	return
 }
 
+// SetKeywordIfUnset sets keyword to v, unless it is already set
+func (p *ListDomainsParams) SetKeywordIfUnset(v string) {
+	if _, found := p.p["keyword"]; !found {
+		p.SetKeyword(v)
+	}
+}
+
 func (p *ListDomainsParams) SetLevel(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -545,6 +972,13 @@ func (p *ListDomainsParams) SetLevel(v int) {
 	return
 }
 
+// SetLevelIfUnset sets level to v, unless it is already set
+func (p *ListDomainsParams) SetLevelIfUnset(v int) {
+	if _, found := p.p["level"]; !found {
+		p.SetLevel(v)
+	}
+}
+
 func (p *ListDomainsParams) SetListall(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -553,6 +987,13 @@ func (p *ListDomainsParams) SetListall(v bool) {
 	return
 }
 
+// SetListallIfUnset sets listall to v, unless it is already set
+func (p *ListDomainsParams) SetListallIfUnset(v bool) {
+	if _, found := p.p["listall"]; !found {
+		p.SetListall(v)
+	}
+}
+
 func (p *ListDomainsParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -561,6 +1002,13 @@ func (p *ListDomainsParams) SetName(v string) {
 	return
 }
 
+// SetNameIfUnset sets name to v, unless it is already set
+func (p *ListDomainsParams) SetNameIfUnset(v string) {
+	if _, found := p.p["name"]; !found {
+		p.SetName(v)
+	}
+}
+
 func (p *ListDomainsParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -569,6 +1017,13 @@ func (p *ListDomainsParams) SetPage(v int) {
 	return
 }
 
+// SetPageIfUnset sets page to v, unless it is already set
+func (p *ListDomainsParams) SetPageIfUnset(v int) {
+	if _, found := p.p["page"]; !found {
+		p.SetPage(v)
+	}
+}
+
 func (p *ListDomainsParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -577,14 +1032,34 @@ func (p *ListDomainsParams) SetPagesize(v int) {
 	return
 }
 
+// SetPagesizeIfUnset sets pagesize to v, unless it is already set
+func (p *ListDomainsParams) SetPagesizeIfUnset(v int) {
+	if _, found := p.p["pagesize"]; !found {
+		p.SetPagesize(v)
+	}
+}
+
+var _ ListAllSetter = (*ListDomainsParams)(nil)
+
 // You should always use this function to get a new ListDomainsParams instance,
-// as then you are sure you have configured all required params
-func (s *DomainService) NewListDomainsParams() *ListDomainsParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *DomainService) NewListDomainsParams(opts ...func(*ListDomainsParams)) *ListDomainsParams {
 	p := &ListDomainsParams{}
 	p.p = make(map[string]interface{})
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
+func (p *ListDomainsParams) setFirstMatch() {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p[firstMatchKey] = true
+}
+
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *DomainService) GetDomainID(name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListDomainsParams{}
@@ -592,7 +1067,7 @@ func (s *DomainService) GetDomainID(name string, opts ...OptionFunc) (string, in
 
 	p.p["name"] = name
 
-	for _, fn := range append(s.cs.options, opts...) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
 		if err := fn(s.cs, p); err != nil {
 			return "", -1, err
 		}
@@ -604,7 +1079,7 @@ func (s *DomainService) GetDomainID(name string, opts ...OptionFunc) (string, in
 	}
 
 	if l.Count == 0 {
-		return "", l.Count, fmt.Errorf("No match found for %s: %+v", name, l)
+		return "", l.Count, fmt.Errorf("%w for %s: %+v", ErrNotFound, name, l)
 	}
 
 	if l.Count == 1 {
@@ -617,6 +1092,10 @@ func (s *DomainService) GetDomainID(name string, opts ...OptionFunc) (string, in
 				return v.Id, l.Count, nil
 			}
 		}
+
+		if _, ok := p.p[firstMatchKey]; ok && len(l.Domains) > 0 {
+			return l.Domains[0].Id, l.Count, nil
+		}
 	}
 	return "", l.Count, fmt.Errorf("Could not find an exact match for %s: %+v", name, l)
 }
@@ -642,7 +1121,7 @@ func (s *DomainService) GetDomainByID(id string, opts ...OptionFunc) (*Domain, i
 
 	p.p["id"] = id
 
-	for _, fn := range append(s.cs.options, opts...) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
 		if err := fn(s.cs, p); err != nil {
 			return nil, -1, err
 		}
@@ -653,24 +1132,56 @@ func (s *DomainService) GetDomainByID(id string, opts ...OptionFunc) (*Domain, i
 		if strings.Contains(err.Error(), fmt.Sprintf(
 			"Invalid parameter id value=%s due to incorrect long value format, "+
 				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+			return nil, 0, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
 	}
 
 	if l.Count == 1 {
 		return l.Domains[0], l.Count, nil
 	}
+
+	if _, ok := p.p[firstMatchKey]; ok && len(l.Domains) > 0 {
+		return l.Domains[0], l.Count, nil
+	}
 	return nil, l.Count, fmt.Errorf("There is more then one result for Domain UUID: %s!", id)
 }
 
+// DomainExists returns true if a Domain with the given ID exists. When no match is found
+// it returns false, while any other error is returned as is.
+func (s *DomainService) DomainExists(id string, opts ...OptionFunc) (bool, error) {
+	_, count, err := s.GetDomainByID(id, opts...)
+	if errors.Is(err, ErrNotFound) {
+		return false, nil
+	}
+	if count > 0 {
+		return true, nil
+	}
+	return false, err
+}
+
 // Lists domains and provides detailed information for listed domains
 func (s *DomainService) ListDomains(p *ListDomainsParams) (*ListDomainsResponse, error) {
-	resp, err := s.cs.newRequest("listDomains", p.toURLValues())
+	return s.ListDomainsWithContext(context.Background(), p)
+}
+
+// ListDomainsWithContext is the same as ListDomains, but the request is cancelled when the context is done
+func (s *DomainService) ListDomainsWithContext(ctx context.Context, p *ListDomainsParams) (*ListDomainsResponse, error) {
+	return s.ListDomainsRawWithContext(ctx, p.toURLValues())
+}
+
+// ListDomainsRaw is the same as ListDomains, but takes the params as url.Values instead of typed params
+func (s *DomainService) ListDomainsRaw(v url.Values) (*ListDomainsResponse, error) {
+	return s.ListDomainsRawWithContext(context.Background(), v)
+}
+
+// ListDomainsRawWithContext is the same as ListDomainsRaw, but the request is cancelled when the context is done
+func (s *DomainService) ListDomainsRawWithContext(ctx context.Context, v url.Values) (*ListDomainsResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "listDomains", "listdomainsresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -683,6 +1194,93 @@ func (s *DomainService) ListDomains(p *ListDomainsParams) (*ListDomainsResponse,
 	return &r, nil
 }
 
+// StreamDomains fetches the results of ListDomains page by page in the background and sends them on the
+// returned channel. Both returned channels are closed when done. If fetching a page fails, or the context
+// is done before all results are sent, the error is sent on the error channel and fetching stops. A
+// request for a page in flight is cancelled when the context is done. The page and pagesize set in the
+// params are ignored.
+func (s *DomainService) StreamDomains(ctx context.Context, p *ListDomainsParams) (<-chan *Domain, <-chan error) {
+	ch := make(chan *Domain)
+	errs := make(chan error, 1)
+
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesize(500)
+
+	go func() {
+		defer close(errs)
+		defer close(ch)
+
+		for page, count := 1, 0; ; page++ {
+			pp.SetPage(page)
+
+			l, err := s.ListDomainsWithContext(ctx, pp)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			for _, v := range l.Domains {
+				select {
+				case ch <- v:
+				case <-ctx.Done():
+					errs <- ctx.Err()
+					return
+				}
+			}
+
+			count += len(l.Domains)
+			if len(l.Domains) < 500 || count >= l.Count {
+				return
+			}
+		}
+	}()
+
+	return ch, errs
+}
+
+// ListDomainsWhere returns all results of ListDomains for which pred returns true. Note that the results are
+// filtered client-side, so all results matching the params are fetched first.
+// All pages are fetched, so the page and pagesize set in the params are ignored.
+func (s *DomainService) ListDomainsWhere(p *ListDomainsParams, pred func(*Domain) bool) ([]*Domain, error) {
+	var r []*Domain
+	ch, errs := s.StreamDomains(context.Background(), p)
+	for v := range ch {
+		if pred(v) {
+			r = append(r, v)
+		}
+	}
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ListAllDomains returns the results of ListDomains across all pages, by calling ListDomains page by page until all
+// results are fetched. The pagesize set in the params is used for every page (500 if not set), and the
+// page set in the params is ignored.
+func (s *DomainService) ListAllDomains(p *ListDomainsParams) ([]*Domain, error) {
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesizeIfUnset(500)
+	pagesize := pp.p["pagesize"].(int)
+
+	var r []*Domain
+	for page := 1; ; page++ {
+		pp.SetPage(page)
+
+		l, err := s.ListDomains(pp)
+		if err != nil {
+			return nil, err
+		}
+		r = append(r, l.Domains...)
+
+		if len(l.Domains) < pagesize || len(r) >= l.Count {
+			return r, nil
+		}
+	}
+}
+
 type ListDomainsResponse struct {
 	Count   int       `json:"count"`
 	Domains []*Domain `json:"domain"`
@@ -740,6 +1338,16 @@ type UpdateDomainParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *UpdateDomainParams) Clone() *UpdateDomainParams {
+	c := &UpdateDomainParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *UpdateDomainParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -757,6 +1365,16 @@ func (p *UpdateDomainParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *UpdateDomainParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of updateDomain", k)
+		}
+	}
+	return nil
+}
+
 func (p *UpdateDomainParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -765,6 +1383,13 @@ func (p *UpdateDomainParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *UpdateDomainParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 func (p *UpdateDomainParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -773,6 +1398,13 @@ func (p *UpdateDomainParams) SetName(v string) {
 	return
 }
 
+// SetNameIfUnset sets name to v, unless it is already set
+func (p *UpdateDomainParams) SetNameIfUnset(v string) {
+	if _, found := p.p["name"]; !found {
+		p.SetName(v)
+	}
+}
+
 func (p *UpdateDomainParams) SetNetworkdomain(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -781,18 +1413,54 @@ func (p *UpdateDomainParams) SetNetworkdomain(v string) {
 	return
 }
 
+// SetNetworkdomainIfUnset sets networkdomain to v, unless it is already set
+func (p *UpdateDomainParams) SetNetworkdomainIfUnset(v string) {
+	if _, found := p.p["networkdomain"]; !found {
+		p.SetNetworkdomain(v)
+	}
+}
+
 // You should always use this function to get a new UpdateDomainParams instance,
-// as then you are sure you have configured all required params
-func (s *DomainService) NewUpdateDomainParams(id string) *UpdateDomainParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *DomainService) NewUpdateDomainParams(id string, opts ...func(*UpdateDomainParams)) *UpdateDomainParams {
 	p := &UpdateDomainParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Updates a domain with a new name
-func (s *DomainService) UpdateDomain(p *UpdateDomainParams) (*UpdateDomainResponse, error) {
-	resp, err := s.cs.newRequest("updateDomain", p.toURLValues())
+func (s *DomainService) UpdateDomain(p *UpdateDomainParams, opts ...OptionFunc) (*UpdateDomainResponse, error) {
+	return s.UpdateDomainWithContext(context.Background(), p, opts...)
+}
+
+// UpdateDomainWithContext is the same as UpdateDomain, but the request is cancelled when the context is done
+func (s *DomainService) UpdateDomainWithContext(ctx context.Context, p *UpdateDomainParams, opts ...OptionFunc) (*UpdateDomainResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.UpdateDomainRawWithContext(ctx, p.toURLValues())
+}
+
+// UpdateDomainRaw is the same as UpdateDomain, but takes the params as url.Values instead of typed params
+func (s *DomainService) UpdateDomainRaw(v url.Values) (*UpdateDomainResponse, error) {
+	return s.UpdateDomainRawWithContext(context.Background(), v)
+}
+
+// UpdateDomainRawWithContext is the same as UpdateDomainRaw, but the request is cancelled when the context is done
+func (s *DomainService) UpdateDomainRawWithContext(ctx context.Context, v url.Values) (*UpdateDomainResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "updateDomain", "updatedomainresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -17,17 +17,125 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/url"
+	"sort"
 	"strconv"
 	"strings"
+	"time"
 )
 
+// WatchEvents polls for new events every interval, starting with the events created since the given time,
+// and emits every event once on the returned channel in the order they were created. Pagination is handled
+// internally and failed polls are retried on the next interval. The channel is closed when the context is
+// done. The since time is sent as is, so it should be in the timezone of the management server.
+func (s *EventService) WatchEvents(ctx context.Context, since time.Time, interval time.Duration, opts ...OptionFunc) <-chan *Event {
+	ch := make(chan *Event)
+
+	go func() {
+		defer close(ch)
+
+		cursor := since
+
+		// The events seen at or after the cursor, used to dedupe events
+		seen := make(map[string]time.Time)
+
+		for {
+			events, err := s.listEventsSince(cursor, opts...)
+			if err == nil {
+				for _, e := range events {
+					created, err := time.Parse("2006-01-02T15:04:05-0700", e.Created)
+					if err != nil {
+						created = cursor
+					}
+					if created.Before(cursor) {
+						continue
+					}
+					if _, found := seen[e.Id]; found {
+						continue
+					}
+					seen[e.Id] = created
+
+					select {
+					case ch <- e:
+					case <-ctx.Done():
+						return
+					}
+
+					if created.After(cursor) {
+						cursor = created
+					}
+				}
+
+				for id, created := range seen {
+					if created.Before(cursor) {
+						delete(seen, id)
+					}
+				}
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(interval):
+			}
+		}
+	}()
+
+	return ch
+}
+
+// Lists all events created since the given time, sorted by the time they were created
+func (s *EventService) listEventsSince(since time.Time, opts ...OptionFunc) ([]*Event, error) {
+	var events []*Event
+
+	for page := 1; ; page++ {
+		p := s.NewListEventsParams()
+		p.SetStartdate(since.Format("2006-01-02 15:04:05"))
+		p.SetPage(page)
+		p.SetPagesize(500)
+
+		for _, fn := range s.cs.withDefaultOptions(opts) {
+			if err := fn(s.cs, p); err != nil {
+				return nil, err
+			}
+		}
+
+		l, err := s.ListEvents(p)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, l.Events...)
+
+		if len(l.Events) < 500 || len(events) >= l.Count {
+			break
+		}
+	}
+
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].Created < events[j].Created
+	})
+
+	return events, nil
+}
+
 type ArchiveEventsParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ArchiveEventsParams) Clone() *ArchiveEventsParams {
+	c := &ArchiveEventsParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ArchiveEventsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -57,6 +165,13 @@ func (p *ArchiveEventsParams) SetEnddate(v string) {
 	return
 }
 
+// SetEnddateIfUnset sets enddate to v, unless it is already set
+func (p *ArchiveEventsParams) SetEnddateIfUnset(v string) {
+	if _, found := p.p["enddate"]; !found {
+		p.SetEnddate(v)
+	}
+}
+
 func (p *ArchiveEventsParams) SetIds(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -65,6 +180,18 @@ func (p *ArchiveEventsParams) SetIds(v []string) {
 	return
 }
 
+// SetIdsIfUnset sets ids to v, unless it is already set
+func (p *ArchiveEventsParams) SetIdsIfUnset(v []string) {
+	if _, found := p.p["ids"]; !found {
+		p.SetIds(v)
+	}
+}
+
+// SetIdsCSV splits v on commas and sets the resulting values
+func (p *ArchiveEventsParams) SetIdsCSV(v string) {
+	p.SetIds(splitCSV(v))
+}
+
 func (p *ArchiveEventsParams) SetStartdate(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -73,6 +200,13 @@ func (p *ArchiveEventsParams) SetStartdate(v string) {
 	return
 }
 
+// SetStartdateIfUnset sets startdate to v, unless it is already set
+func (p *ArchiveEventsParams) SetStartdateIfUnset(v string) {
+	if _, found := p.p["startdate"]; !found {
+		p.SetStartdate(v)
+	}
+}
+
 func (p *ArchiveEventsParams) SetType(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -81,17 +215,49 @@ func (p *ArchiveEventsParams) SetType(v string) {
 	return
 }
 
+// SetTypeIfUnset sets type to v, unless it is already set
+func (p *ArchiveEventsParams) SetTypeIfUnset(v string) {
+	if _, found := p.p["type"]; !found {
+		p.SetType(v)
+	}
+}
+
 // You should always use this function to get a new ArchiveEventsParams instance,
-// as then you are sure you have configured all required params
-func (s *EventService) NewArchiveEventsParams() *ArchiveEventsParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *EventService) NewArchiveEventsParams(opts ...func(*ArchiveEventsParams)) *ArchiveEventsParams {
 	p := &ArchiveEventsParams{}
 	p.p = make(map[string]interface{})
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Archive one or more events.
-func (s *EventService) ArchiveEvents(p *ArchiveEventsParams) (*ArchiveEventsResponse, error) {
-	resp, err := s.cs.newRequest("archiveEvents", p.toURLValues())
+func (s *EventService) ArchiveEvents(p *ArchiveEventsParams, opts ...OptionFunc) (*ArchiveEventsResponse, error) {
+	return s.ArchiveEventsWithContext(context.Background(), p, opts...)
+}
+
+// ArchiveEventsWithContext is the same as ArchiveEvents, but the request is cancelled when the context is done
+func (s *EventService) ArchiveEventsWithContext(ctx context.Context, p *ArchiveEventsParams, opts ...OptionFunc) (*ArchiveEventsResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	return s.ArchiveEventsRawWithContext(ctx, p.toURLValues())
+}
+
+// ArchiveEventsRaw is the same as ArchiveEvents, but takes the params as url.Values instead of typed params
+func (s *EventService) ArchiveEventsRaw(v url.Values) (*ArchiveEventsResponse, error) {
+	return s.ArchiveEventsRawWithContext(context.Background(), v)
+}
+
+// ArchiveEventsRawWithContext is the same as ArchiveEventsRaw, but the request is cancelled when the context is done
+func (s *EventService) ArchiveEventsRawWithContext(ctx context.Context, v url.Values) (*ArchiveEventsResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "archiveEvents", "archiveeventsresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -132,6 +298,16 @@ type DeleteEventsParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *DeleteEventsParams) Clone() *DeleteEventsParams {
+	c := &DeleteEventsParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeleteEventsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -161,6 +337,13 @@ func (p *DeleteEventsParams) SetEnddate(v string) {
 	return
 }
 
+// SetEnddateIfUnset sets enddate to v, unless it is already set
+func (p *DeleteEventsParams) SetEnddateIfUnset(v string) {
+	if _, found := p.p["enddate"]; !found {
+		p.SetEnddate(v)
+	}
+}
+
 func (p *DeleteEventsParams) SetIds(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -169,6 +352,18 @@ func (p *DeleteEventsParams) SetIds(v []string) {
 	return
 }
 
+// SetIdsIfUnset sets ids to v, unless it is already set
+func (p *DeleteEventsParams) SetIdsIfUnset(v []string) {
+	if _, found := p.p["ids"]; !found {
+		p.SetIds(v)
+	}
+}
+
+// SetIdsCSV splits v on commas and sets the resulting values
+func (p *DeleteEventsParams) SetIdsCSV(v string) {
+	p.SetIds(splitCSV(v))
+}
+
 func (p *DeleteEventsParams) SetStartdate(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -177,6 +372,13 @@ func (p *DeleteEventsParams) SetStartdate(v string) {
 	return
 }
 
+// SetStartdateIfUnset sets startdate to v, unless it is already set
+func (p *DeleteEventsParams) SetStartdateIfUnset(v string) {
+	if _, found := p.p["startdate"]; !found {
+		p.SetStartdate(v)
+	}
+}
+
 func (p *DeleteEventsParams) SetType(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -185,17 +387,49 @@ func (p *DeleteEventsParams) SetType(v string) {
 	return
 }
 
+// SetTypeIfUnset sets type to v, unless it is already set
+func (p *DeleteEventsParams) SetTypeIfUnset(v string) {
+	if _, found := p.p["type"]; !found {
+		p.SetType(v)
+	}
+}
+
 // You should always use this function to get a new DeleteEventsParams instance,
-// as then you are sure you have configured all required params
-func (s *EventService) NewDeleteEventsParams() *DeleteEventsParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *EventService) NewDeleteEventsParams(opts ...func(*DeleteEventsParams)) *DeleteEventsParams {
 	p := &DeleteEventsParams{}
 	p.p = make(map[string]interface{})
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Delete one or more events.
-func (s *EventService) DeleteEvents(p *DeleteEventsParams) (*DeleteEventsResponse, error) {
-	resp, err := s.cs.newRequest("deleteEvents", p.toURLValues())
+func (s *EventService) DeleteEvents(p *DeleteEventsParams, opts ...OptionFunc) (*DeleteEventsResponse, error) {
+	return s.DeleteEventsWithContext(context.Background(), p, opts...)
+}
+
+// DeleteEventsWithContext is the same as DeleteEvents, but the request is cancelled when the context is done
+func (s *EventService) DeleteEventsWithContext(ctx context.Context, p *DeleteEventsParams, opts ...OptionFunc) (*DeleteEventsResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	return s.DeleteEventsRawWithContext(ctx, p.toURLValues())
+}
+
+// DeleteEventsRaw is the same as DeleteEvents, but takes the params as url.Values instead of typed params
+func (s *EventService) DeleteEventsRaw(v url.Values) (*DeleteEventsResponse, error) {
+	return s.DeleteEventsRawWithContext(context.Background(), v)
+}
+
+// DeleteEventsRawWithContext is the same as DeleteEventsRaw, but the request is cancelled when the context is done
+func (s *EventService) DeleteEventsRawWithContext(ctx context.Context, v url.Values) (*DeleteEventsResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteEvents", "deleteeventsresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -236,6 +470,16 @@ type ListEventTypesParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ListEventTypesParams) Clone() *ListEventTypesParams {
+	c := &ListEventTypesParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListEventTypesParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -245,16 +489,35 @@ func (p *ListEventTypesParams) toURLValues() url.Values {
 }
 
 // You should always use this function to get a new ListEventTypesParams instance,
-// as then you are sure you have configured all required params
-func (s *EventService) NewListEventTypesParams() *ListEventTypesParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *EventService) NewListEventTypesParams(opts ...func(*ListEventTypesParams)) *ListEventTypesParams {
 	p := &ListEventTypesParams{}
 	p.p = make(map[string]interface{})
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // List Event Types
 func (s *EventService) ListEventTypes(p *ListEventTypesParams) (*ListEventTypesResponse, error) {
-	resp, err := s.cs.newRequest("listEventTypes", p.toURLValues())
+	return s.ListEventTypesWithContext(context.Background(), p)
+}
+
+// ListEventTypesWithContext is the same as ListEventTypes, but the request is cancelled when the context is done
+func (s *EventService) ListEventTypesWithContext(ctx context.Context, p *ListEventTypesParams) (*ListEventTypesResponse, error) {
+	return s.ListEventTypesRawWithContext(ctx, p.toURLValues())
+}
+
+// ListEventTypesRaw is the same as ListEventTypes, but takes the params as url.Values instead of typed params
+func (s *EventService) ListEventTypesRaw(v url.Values) (*ListEventTypesResponse, error) {
+	return s.ListEventTypesRawWithContext(context.Background(), v)
+}
+
+// ListEventTypesRawWithContext is the same as ListEventTypesRaw, but the request is cancelled when the context is done
+func (s *EventService) ListEventTypesRawWithContext(ctx context.Context, v url.Values) (*ListEventTypesResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "listEventTypes", "listeventtypesresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -267,6 +530,22 @@ func (s *EventService) ListEventTypes(p *ListEventTypesParams) (*ListEventTypesR
 	return &r, nil
 }
 
+// ListEventTypesWhere returns all results of ListEventTypes for which pred returns true. Note that the results are
+// filtered client-side, so all results matching the params are fetched first.
+func (s *EventService) ListEventTypesWhere(p *ListEventTypesParams, pred func(*EventType) bool) ([]*EventType, error) {
+	var r []*EventType
+	l, err := s.ListEventTypes(p)
+	if err != nil {
+		return nil, err
+	}
+	for _, v := range l.EventTypes {
+		if pred(v) {
+			r = append(r, v)
+		}
+	}
+	return r, nil
+}
+
 type ListEventTypesResponse struct {
 	Count      int          `json:"count"`
 	EventTypes []*EventType `json:"eventtype"`
@@ -280,6 +559,16 @@ type ListEventsParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ListEventsParams) Clone() *ListEventsParams {
+	c := &ListEventsParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListEventsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -347,6 +636,13 @@ func (p *ListEventsParams) SetAccount(v string) {
 	return
 }
 
+// SetAccountIfUnset sets account to v, unless it is already set
+func (p *ListEventsParams) SetAccountIfUnset(v string) {
+	if _, found := p.p["account"]; !found {
+		p.SetAccount(v)
+	}
+}
+
 func (p *ListEventsParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -355,6 +651,13 @@ func (p *ListEventsParams) SetDomainid(v string) {
 	return
 }
 
+// SetDomainidIfUnset sets domainid to v, unless it is already set
+func (p *ListEventsParams) SetDomainidIfUnset(v string) {
+	if _, found := p.p["domainid"]; !found {
+		p.SetDomainid(v)
+	}
+}
+
 func (p *ListEventsParams) SetDuration(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -363,6 +666,13 @@ func (p *ListEventsParams) SetDuration(v int) {
 	return
 }
 
+// SetDurationIfUnset sets duration to v, unless it is already set
+func (p *ListEventsParams) SetDurationIfUnset(v int) {
+	if _, found := p.p["duration"]; !found {
+		p.SetDuration(v)
+	}
+}
+
 func (p *ListEventsParams) SetEnddate(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -371,6 +681,13 @@ func (p *ListEventsParams) SetEnddate(v string) {
 	return
 }
 
+// SetEnddateIfUnset sets enddate to v, unless it is already set
+func (p *ListEventsParams) SetEnddateIfUnset(v string) {
+	if _, found := p.p["enddate"]; !found {
+		p.SetEnddate(v)
+	}
+}
+
 func (p *ListEventsParams) SetEntrytime(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -379,6 +696,13 @@ func (p *ListEventsParams) SetEntrytime(v int) {
 	return
 }
 
+// SetEntrytimeIfUnset sets entrytime to v, unless it is already set
+func (p *ListEventsParams) SetEntrytimeIfUnset(v int) {
+	if _, found := p.p["entrytime"]; !found {
+		p.SetEntrytime(v)
+	}
+}
+
 func (p *ListEventsParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -387,6 +711,13 @@ func (p *ListEventsParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *ListEventsParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 func (p *ListEventsParams) SetIsrecursive(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -395,6 +726,13 @@ func (p *ListEventsParams) SetIsrecursive(v bool) {
 	return
 }
 
+// SetIsrecursiveIfUnset sets isrecursive to v, unless it is already set
+func (p *ListEventsParams) SetIsrecursiveIfUnset(v bool) {
+	if _, found := p.p["isrecursive"]; !found {
+		p.SetIsrecursive(v)
+	}
+}
+
 func (p *ListEventsParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -403,6 +741,13 @@ func (p *ListEventsParams) SetKeyword(v string) {
 	return
 }
 
+// SetKeywordIfUnset sets keyword to v, unless it is already set
+func (p *ListEventsParams) SetKeywordIfUnset(v string) {
+	if _, found := p.p["keyword"]; !found {
+		p.SetKeyword(v)
+	}
+}
+
 func (p *ListEventsParams) SetLevel(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -411,6 +756,13 @@ func (p *ListEventsParams) SetLevel(v string) {
 	return
 }
 
+// SetLevelIfUnset sets level to v, unless it is already set
+func (p *ListEventsParams) SetLevelIfUnset(v string) {
+	if _, found := p.p["level"]; !found {
+		p.SetLevel(v)
+	}
+}
+
 func (p *ListEventsParams) SetListall(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -419,6 +771,13 @@ func (p *ListEventsParams) SetListall(v bool) {
 	return
 }
 
+// SetListallIfUnset sets listall to v, unless it is already set
+func (p *ListEventsParams) SetListallIfUnset(v bool) {
+	if _, found := p.p["listall"]; !found {
+		p.SetListall(v)
+	}
+}
+
 func (p *ListEventsParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -427,6 +786,13 @@ func (p *ListEventsParams) SetPage(v int) {
 	return
 }
 
+// SetPageIfUnset sets page to v, unless it is already set
+func (p *ListEventsParams) SetPageIfUnset(v int) {
+	if _, found := p.p["page"]; !found {
+		p.SetPage(v)
+	}
+}
+
 func (p *ListEventsParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -435,6 +801,13 @@ func (p *ListEventsParams) SetPagesize(v int) {
 	return
 }
 
+// SetPagesizeIfUnset sets pagesize to v, unless it is already set
+func (p *ListEventsParams) SetPagesizeIfUnset(v int) {
+	if _, found := p.p["pagesize"]; !found {
+		p.SetPagesize(v)
+	}
+}
+
 func (p *ListEventsParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -443,6 +816,13 @@ func (p *ListEventsParams) SetProjectid(v string) {
 	return
 }
 
+// SetProjectidIfUnset sets projectid to v, unless it is already set
+func (p *ListEventsParams) SetProjectidIfUnset(v string) {
+	if _, found := p.p["projectid"]; !found {
+		p.SetProjectid(v)
+	}
+}
+
 func (p *ListEventsParams) SetStartdate(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -451,6 +831,13 @@ func (p *ListEventsParams) SetStartdate(v string) {
 	return
 }
 
+// SetStartdateIfUnset sets startdate to v, unless it is already set
+func (p *ListEventsParams) SetStartdateIfUnset(v string) {
+	if _, found := p.p["startdate"]; !found {
+		p.SetStartdate(v)
+	}
+}
+
 func (p *ListEventsParams) SetType(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -459,14 +846,34 @@ func (p *ListEventsParams) SetType(v string) {
 	return
 }
 
+// SetTypeIfUnset sets type to v, unless it is already set
+func (p *ListEventsParams) SetTypeIfUnset(v string) {
+	if _, found := p.p["type"]; !found {
+		p.SetType(v)
+	}
+}
+
+var _ ListAllSetter = (*ListEventsParams)(nil)
+
 // You should always use this function to get a new ListEventsParams instance,
-// as then you are sure you have configured all required params
-func (s *EventService) NewListEventsParams() *ListEventsParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *EventService) NewListEventsParams(opts ...func(*ListEventsParams)) *ListEventsParams {
 	p := &ListEventsParams{}
 	p.p = make(map[string]interface{})
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
+func (p *ListEventsParams) setFirstMatch() {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p[firstMatchKey] = true
+}
+
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *EventService) GetEventByID(id string, opts ...OptionFunc) (*Event, int, error) {
 	p := &ListEventsParams{}
@@ -474,7 +881,7 @@ func (s *EventService) GetEventByID(id string, opts ...OptionFunc) (*Event, int,
 
 	p.p["id"] = id
 
-	for _, fn := range append(s.cs.options, opts...) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
 		if err := fn(s.cs, p); err != nil {
 			return nil, -1, err
 		}
@@ -485,24 +892,56 @@ func (s *EventService) GetEventByID(id string, opts ...OptionFunc) (*Event, int,
 		if strings.Contains(err.Error(), fmt.Sprintf(
 			"Invalid parameter id value=%s due to incorrect long value format, "+
 				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+			return nil, 0, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
 	}
 
 	if l.Count == 1 {
 		return l.Events[0], l.Count, nil
 	}
+
+	if _, ok := p.p[firstMatchKey]; ok && len(l.Events) > 0 {
+		return l.Events[0], l.Count, nil
+	}
 	return nil, l.Count, fmt.Errorf("There is more then one result for Event UUID: %s!", id)
 }
 
+// EventExists returns true if a Event with the given ID exists. When no match is found
+// it returns false, while any other error is returned as is.
+func (s *EventService) EventExists(id string, opts ...OptionFunc) (bool, error) {
+	_, count, err := s.GetEventByID(id, opts...)
+	if errors.Is(err, ErrNotFound) {
+		return false, nil
+	}
+	if count > 0 {
+		return true, nil
+	}
+	return false, err
+}
+
 // A command to list events.
 func (s *EventService) ListEvents(p *ListEventsParams) (*ListEventsResponse, error) {
-	resp, err := s.cs.newRequest("listEvents", p.toURLValues())
+	return s.ListEventsWithContext(context.Background(), p)
+}
+
+// ListEventsWithContext is the same as ListEvents, but the request is cancelled when the context is done
+func (s *EventService) ListEventsWithContext(ctx context.Context, p *ListEventsParams) (*ListEventsResponse, error) {
+	return s.ListEventsRawWithContext(ctx, p.toURLValues())
+}
+
+// ListEventsRaw is the same as ListEvents, but takes the params as url.Values instead of typed params
+func (s *EventService) ListEventsRaw(v url.Values) (*ListEventsResponse, error) {
+	return s.ListEventsRawWithContext(context.Background(), v)
+}
+
+// ListEventsRawWithContext is the same as ListEventsRaw, but the request is cancelled when the context is done
+func (s *EventService) ListEventsRawWithContext(ctx context.Context, v url.Values) (*ListEventsResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "listEvents", "listeventsresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -515,6 +954,93 @@ func (s *EventService) ListEvents(p *ListEventsParams) (*ListEventsResponse, err
 	return &r, nil
 }
 
+// StreamEvents fetches the results of ListEvents page by page in the background and sends them on the
+// returned channel. Both returned channels are closed when done. If fetching a page fails, or the context
+// is done before all results are sent, the error is sent on the error channel and fetching stops. A
+// request for a page in flight is cancelled when the context is done. The page and pagesize set in the
+// params are ignored.
+func (s *EventService) StreamEvents(ctx context.Context, p *ListEventsParams) (<-chan *Event, <-chan error) {
+	ch := make(chan *Event)
+	errs := make(chan error, 1)
+
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesize(500)
+
+	go func() {
+		defer close(errs)
+		defer close(ch)
+
+		for page, count := 1, 0; ; page++ {
+			pp.SetPage(page)
+
+			l, err := s.ListEventsWithContext(ctx, pp)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			for _, v := range l.Events {
+				select {
+				case ch <- v:
+				case <-ctx.Done():
+					errs <- ctx.Err()
+					return
+				}
+			}
+
+			count += len(l.Events)
+			if len(l.Events) < 500 || count >= l.Count {
+				return
+			}
+		}
+	}()
+
+	return ch, errs
+}
+
+// ListEventsWhere returns all results of ListEvents for which pred returns true. Note that the results are
+// filtered client-side, so all results matching the params are fetched first.
+// All pages are fetched, so the page and pagesize set in the params are ignored.
+func (s *EventService) ListEventsWhere(p *ListEventsParams, pred func(*Event) bool) ([]*Event, error) {
+	var r []*Event
+	ch, errs := s.StreamEvents(context.Background(), p)
+	for v := range ch {
+		if pred(v) {
+			r = append(r, v)
+		}
+	}
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ListAllEvents returns the results of ListEvents across all pages, by calling ListEvents page by page until all
+// results are fetched. The pagesize set in the params is used for every page (500 if not set), and the
+// page set in the params is ignored.
+func (s *EventService) ListAllEvents(p *ListEventsParams) ([]*Event, error) {
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesizeIfUnset(500)
+	pagesize := pp.p["pagesize"].(int)
+
+	var r []*Event
+	for page := 1; ; page++ {
+		pp.SetPage(page)
+
+		l, err := s.ListEvents(pp)
+		if err != nil {
+			return nil, err
+		}
+		r = append(r, l.Events...)
+
+		if len(l.Events) < pagesize || len(r) >= l.Count {
+			return r, nil
+		}
+	}
+}
+
 type ListEventsResponse struct {
 	Count  int      `json:"count"`
 	Events []*Event `json:"event"`
@@ -17,7 +17,9 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
 	"net/url"
 	"strconv"
 )
@@ -26,6 +28,16 @@ type AddExternalFirewallParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *AddExternalFirewallParams) Clone() *AddExternalFirewallParams {
+	c := &AddExternalFirewallParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *AddExternalFirewallParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -46,6 +58,16 @@ func (p *AddExternalFirewallParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *AddExternalFirewallParams) validate() error {
+	for _, k := range []string{"password", "url", "username", "zoneid"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of addExternalFirewall", k)
+		}
+	}
+	return nil
+}
+
 func (p *AddExternalFirewallParams) SetPassword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -54,6 +76,13 @@ func (p *AddExternalFirewallParams) SetPassword(v string) {
 	return
 }
 
+// SetPasswordIfUnset sets password to v, unless it is already set
+func (p *AddExternalFirewallParams) SetPasswordIfUnset(v string) {
+	if _, found := p.p["password"]; !found {
+		p.SetPassword(v)
+	}
+}
+
 func (p *AddExternalFirewallParams) SetUrl(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -62,6 +91,13 @@ func (p *AddExternalFirewallParams) SetUrl(v string) {
 	return
 }
 
+// SetUrlIfUnset sets url to v, unless it is already set
+func (p *AddExternalFirewallParams) SetUrlIfUnset(v string) {
+	if _, found := p.p["url"]; !found {
+		p.SetUrl(v)
+	}
+}
+
 func (p *AddExternalFirewallParams) SetUsername(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -70,6 +106,13 @@ func (p *AddExternalFirewallParams) SetUsername(v string) {
 	return
 }
 
+// SetUsernameIfUnset sets username to v, unless it is already set
+func (p *AddExternalFirewallParams) SetUsernameIfUnset(v string) {
+	if _, found := p.p["username"]; !found {
+		p.SetUsername(v)
+	}
+}
+
 func (p *AddExternalFirewallParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -78,21 +121,73 @@ func (p *AddExternalFirewallParams) SetZoneid(v string) {
 	return
 }
 
+// SetZoneidIfUnset sets zoneid to v, unless it is already set
+func (p *AddExternalFirewallParams) SetZoneidIfUnset(v string) {
+	if _, found := p.p["zoneid"]; !found {
+		p.SetZoneid(v)
+	}
+}
+
 // You should always use this function to get a new AddExternalFirewallParams instance,
-// as then you are sure you have configured all required params
-func (s *ExtFirewallService) NewAddExternalFirewallParams(password string, url string, username string, zoneid string) *AddExternalFirewallParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *ExtFirewallService) NewAddExternalFirewallParams(password string, url string, username string, zoneid string, opts ...func(*AddExternalFirewallParams)) *AddExternalFirewallParams {
 	p := &AddExternalFirewallParams{}
 	p.p = make(map[string]interface{})
 	p.p["password"] = password
 	p.p["url"] = url
 	p.p["username"] = username
 	p.p["zoneid"] = zoneid
+	for _, fn := range opts {
+		fn(p)
+	}
+	return p
+}
+
+// AddExternalFirewallOpts contains all params that can be set on a AddExternalFirewallParams.
+// Optional params are pointers (or slices and maps) which are only set when not nil.
+type AddExternalFirewallOpts struct {
+	Password string
+	Url      string
+	Username string
+	Zoneid   string
+}
+
+// NewAddExternalFirewallParamsFromOpts is an alternative for NewAddExternalFirewallParams which takes all params using
+// an options struct, as that is easier to read for APIs with many required params
+func (s *ExtFirewallService) NewAddExternalFirewallParamsFromOpts(o AddExternalFirewallOpts) *AddExternalFirewallParams {
+	p := s.NewAddExternalFirewallParams(o.Password, o.Url, o.Username, o.Zoneid)
 	return p
 }
 
 // Adds an external firewall appliance
-func (s *ExtFirewallService) AddExternalFirewall(p *AddExternalFirewallParams) (*AddExternalFirewallResponse, error) {
-	resp, err := s.cs.newRequest("addExternalFirewall", p.toURLValues())
+func (s *ExtFirewallService) AddExternalFirewall(p *AddExternalFirewallParams, opts ...OptionFunc) (*AddExternalFirewallResponse, error) {
+	return s.AddExternalFirewallWithContext(context.Background(), p, opts...)
+}
+
+// AddExternalFirewallWithContext is the same as AddExternalFirewall, but the request is cancelled when the context is done
+func (s *ExtFirewallService) AddExternalFirewallWithContext(ctx context.Context, p *AddExternalFirewallParams, opts ...OptionFunc) (*AddExternalFirewallResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.AddExternalFirewallRawWithContext(ctx, p.toURLValues())
+}
+
+// AddExternalFirewallRaw is the same as AddExternalFirewall, but takes the params as url.Values instead of typed params
+func (s *ExtFirewallService) AddExternalFirewallRaw(v url.Values) (*AddExternalFirewallResponse, error) {
+	return s.AddExternalFirewallRawWithContext(context.Background(), v)
+}
+
+// AddExternalFirewallRawWithContext is the same as AddExternalFirewallRaw, but the request is cancelled when the context is done
+func (s *ExtFirewallService) AddExternalFirewallRawWithContext(ctx context.Context, v url.Values) (*AddExternalFirewallResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "addExternalFirewall", "addexternalfirewallresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -123,6 +218,16 @@ type DeleteExternalFirewallParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *DeleteExternalFirewallParams) Clone() *DeleteExternalFirewallParams {
+	c := &DeleteExternalFirewallParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeleteExternalFirewallParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -134,6 +239,16 @@ func (p *DeleteExternalFirewallParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *DeleteExternalFirewallParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of deleteExternalFirewall", k)
+		}
+	}
+	return nil
+}
+
 func (p *DeleteExternalFirewallParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -142,18 +257,54 @@ func (p *DeleteExternalFirewallParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *DeleteExternalFirewallParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 // You should always use this function to get a new DeleteExternalFirewallParams instance,
-// as then you are sure you have configured all required params
-func (s *ExtFirewallService) NewDeleteExternalFirewallParams(id string) *DeleteExternalFirewallParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *ExtFirewallService) NewDeleteExternalFirewallParams(id string, opts ...func(*DeleteExternalFirewallParams)) *DeleteExternalFirewallParams {
 	p := &DeleteExternalFirewallParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Deletes an external firewall appliance.
-func (s *ExtFirewallService) DeleteExternalFirewall(p *DeleteExternalFirewallParams) (*DeleteExternalFirewallResponse, error) {
-	resp, err := s.cs.newRequest("deleteExternalFirewall", p.toURLValues())
+func (s *ExtFirewallService) DeleteExternalFirewall(p *DeleteExternalFirewallParams, opts ...OptionFunc) (*DeleteExternalFirewallResponse, error) {
+	return s.DeleteExternalFirewallWithContext(context.Background(), p, opts...)
+}
+
+// DeleteExternalFirewallWithContext is the same as DeleteExternalFirewall, but the request is cancelled when the context is done
+func (s *ExtFirewallService) DeleteExternalFirewallWithContext(ctx context.Context, p *DeleteExternalFirewallParams, opts ...OptionFunc) (*DeleteExternalFirewallResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.DeleteExternalFirewallRawWithContext(ctx, p.toURLValues())
+}
+
+// DeleteExternalFirewallRaw is the same as DeleteExternalFirewall, but takes the params as url.Values instead of typed params
+func (s *ExtFirewallService) DeleteExternalFirewallRaw(v url.Values) (*DeleteExternalFirewallResponse, error) {
+	return s.DeleteExternalFirewallRawWithContext(context.Background(), v)
+}
+
+// DeleteExternalFirewallRawWithContext is the same as DeleteExternalFirewallRaw, but the request is cancelled when the context is done
+func (s *ExtFirewallService) DeleteExternalFirewallRawWithContext(ctx context.Context, v url.Values) (*DeleteExternalFirewallResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteExternalFirewall", "deleteexternalfirewallresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -194,6 +345,16 @@ type ListExternalFirewallsParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ListExternalFirewallsParams) Clone() *ListExternalFirewallsParams {
+	c := &ListExternalFirewallsParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListExternalFirewallsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -216,6 +377,16 @@ func (p *ListExternalFirewallsParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *ListExternalFirewallsParams) validate() error {
+	for _, k := range []string{"zoneid"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of listExternalFirewalls", k)
+		}
+	}
+	return nil
+}
+
 func (p *ListExternalFirewallsParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -224,6 +395,13 @@ func (p *ListExternalFirewallsParams) SetKeyword(v string) {
 	return
 }
 
+// SetKeywordIfUnset sets keyword to v, unless it is already set
+func (p *ListExternalFirewallsParams) SetKeywordIfUnset(v string) {
+	if _, found := p.p["keyword"]; !found {
+		p.SetKeyword(v)
+	}
+}
+
 func (p *ListExternalFirewallsParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -232,6 +410,13 @@ func (p *ListExternalFirewallsParams) SetPage(v int) {
 	return
 }
 
+// SetPageIfUnset sets page to v, unless it is already set
+func (p *ListExternalFirewallsParams) SetPageIfUnset(v int) {
+	if _, found := p.p["page"]; !found {
+		p.SetPage(v)
+	}
+}
+
 func (p *ListExternalFirewallsParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -240,6 +425,13 @@ func (p *ListExternalFirewallsParams) SetPagesize(v int) {
 	return
 }
 
+// SetPagesizeIfUnset sets pagesize to v, unless it is already set
+func (p *ListExternalFirewallsParams) SetPagesizeIfUnset(v int) {
+	if _, found := p.p["pagesize"]; !found {
+		p.SetPagesize(v)
+	}
+}
+
 func (p *ListExternalFirewallsParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -248,18 +440,48 @@ func (p *ListExternalFirewallsParams) SetZoneid(v string) {
 	return
 }
 
+// SetZoneidIfUnset sets zoneid to v, unless it is already set
+func (p *ListExternalFirewallsParams) SetZoneidIfUnset(v string) {
+	if _, found := p.p["zoneid"]; !found {
+		p.SetZoneid(v)
+	}
+}
+
 // You should always use this function to get a new ListExternalFirewallsParams instance,
-// as then you are sure you have configured all required params
-func (s *ExtFirewallService) NewListExternalFirewallsParams(zoneid string) *ListExternalFirewallsParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *ExtFirewallService) NewListExternalFirewallsParams(zoneid string, opts ...func(*ListExternalFirewallsParams)) *ListExternalFirewallsParams {
 	p := &ListExternalFirewallsParams{}
 	p.p = make(map[string]interface{})
 	p.p["zoneid"] = zoneid
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // List external firewall appliances.
 func (s *ExtFirewallService) ListExternalFirewalls(p *ListExternalFirewallsParams) (*ListExternalFirewallsResponse, error) {
-	resp, err := s.cs.newRequest("listExternalFirewalls", p.toURLValues())
+	return s.ListExternalFirewallsWithContext(context.Background(), p)
+}
+
+// ListExternalFirewallsWithContext is the same as ListExternalFirewalls, but the request is cancelled when the context is done
+func (s *ExtFirewallService) ListExternalFirewallsWithContext(ctx context.Context, p *ListExternalFirewallsParams) (*ListExternalFirewallsResponse, error) {
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.ListExternalFirewallsRawWithContext(ctx, p.toURLValues())
+}
+
+// ListExternalFirewallsRaw is the same as ListExternalFirewalls, but takes the params as url.Values instead of typed params
+func (s *ExtFirewallService) ListExternalFirewallsRaw(v url.Values) (*ListExternalFirewallsResponse, error) {
+	return s.ListExternalFirewallsRawWithContext(context.Background(), v)
+}
+
+// ListExternalFirewallsRawWithContext is the same as ListExternalFirewallsRaw, but the request is cancelled when the context is done
+func (s *ExtFirewallService) ListExternalFirewallsRawWithContext(ctx context.Context, v url.Values) (*ListExternalFirewallsResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "listExternalFirewalls", "listexternalfirewallsresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -272,6 +494,93 @@ func (s *ExtFirewallService) ListExternalFirewalls(p *ListExternalFirewallsParam
 	return &r, nil
 }
 
+// StreamExternalFirewalls fetches the results of ListExternalFirewalls page by page in the background and sends them on the
+// returned channel. Both returned channels are closed when done. If fetching a page fails, or the context
+// is done before all results are sent, the error is sent on the error channel and fetching stops. A
+// request for a page in flight is cancelled when the context is done. The page and pagesize set in the
+// params are ignored.
+func (s *ExtFirewallService) StreamExternalFirewalls(ctx context.Context, p *ListExternalFirewallsParams) (<-chan *ExternalFirewall, <-chan error) {
+	ch := make(chan *ExternalFirewall)
+	errs := make(chan error, 1)
+
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesize(500)
+
+	go func() {
+		defer close(errs)
+		defer close(ch)
+
+		for page, count := 1, 0; ; page++ {
+			pp.SetPage(page)
+
+			l, err := s.ListExternalFirewallsWithContext(ctx, pp)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			for _, v := range l.ExternalFirewalls {
+				select {
+				case ch <- v:
+				case <-ctx.Done():
+					errs <- ctx.Err()
+					return
+				}
+			}
+
+			count += len(l.ExternalFirewalls)
+			if len(l.ExternalFirewalls) < 500 || count >= l.Count {
+				return
+			}
+		}
+	}()
+
+	return ch, errs
+}
+
+// ListExternalFirewallsWhere returns all results of ListExternalFirewalls for which pred returns true. Note that the results are
+// filtered client-side, so all results matching the params are fetched first.
+// All pages are fetched, so the page and pagesize set in the params are ignored.
+func (s *ExtFirewallService) ListExternalFirewallsWhere(p *ListExternalFirewallsParams, pred func(*ExternalFirewall) bool) ([]*ExternalFirewall, error) {
+	var r []*ExternalFirewall
+	ch, errs := s.StreamExternalFirewalls(context.Background(), p)
+	for v := range ch {
+		if pred(v) {
+			r = append(r, v)
+		}
+	}
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ListAllExternalFirewalls returns the results of ListExternalFirewalls across all pages, by calling ListExternalFirewalls page by page until all
+// results are fetched. The pagesize set in the params is used for every page (500 if not set), and the
+// page set in the params is ignored.
+func (s *ExtFirewallService) ListAllExternalFirewalls(p *ListExternalFirewallsParams) ([]*ExternalFirewall, error) {
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesizeIfUnset(500)
+	pagesize := pp.p["pagesize"].(int)
+
+	var r []*ExternalFirewall
+	for page := 1; ; page++ {
+		pp.SetPage(page)
+
+		l, err := s.ListExternalFirewalls(pp)
+		if err != nil {
+			return nil, err
+		}
+		r = append(r, l.ExternalFirewalls...)
+
+		if len(l.ExternalFirewalls) < pagesize || len(r) >= l.Count {
+			return r, nil
+		}
+	}
+}
+
 type ListExternalFirewallsResponse struct {
 	Count             int                 `json:"count"`
 	ExternalFirewalls []*ExternalFirewall `json:"externalfirewall"`
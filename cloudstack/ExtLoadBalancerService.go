@@ -17,6 +17,7 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
@@ -27,6 +28,16 @@ type AddExternalLoadBalancerParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *AddExternalLoadBalancerParams) Clone() *AddExternalLoadBalancerParams {
+	c := &AddExternalLoadBalancerParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *AddExternalLoadBalancerParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -47,6 +58,16 @@ func (p *AddExternalLoadBalancerParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *AddExternalLoadBalancerParams) validate() error {
+	for _, k := range []string{"password", "url", "username", "zoneid"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of addExternalLoadBalancer", k)
+		}
+	}
+	return nil
+}
+
 func (p *AddExternalLoadBalancerParams) SetPassword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -55,6 +76,13 @@ func (p *AddExternalLoadBalancerParams) SetPassword(v string) {
 	return
 }
 
+// SetPasswordIfUnset sets password to v, unless it is already set
+func (p *AddExternalLoadBalancerParams) SetPasswordIfUnset(v string) {
+	if _, found := p.p["password"]; !found {
+		p.SetPassword(v)
+	}
+}
+
 func (p *AddExternalLoadBalancerParams) SetUrl(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -63,6 +91,13 @@ func (p *AddExternalLoadBalancerParams) SetUrl(v string) {
 	return
 }
 
+// SetUrlIfUnset sets url to v, unless it is already set
+func (p *AddExternalLoadBalancerParams) SetUrlIfUnset(v string) {
+	if _, found := p.p["url"]; !found {
+		p.SetUrl(v)
+	}
+}
+
 func (p *AddExternalLoadBalancerParams) SetUsername(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -71,6 +106,13 @@ func (p *AddExternalLoadBalancerParams) SetUsername(v string) {
 	return
 }
 
+// SetUsernameIfUnset sets username to v, unless it is already set
+func (p *AddExternalLoadBalancerParams) SetUsernameIfUnset(v string) {
+	if _, found := p.p["username"]; !found {
+		p.SetUsername(v)
+	}
+}
+
 func (p *AddExternalLoadBalancerParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -79,21 +121,73 @@ func (p *AddExternalLoadBalancerParams) SetZoneid(v string) {
 	return
 }
 
+// SetZoneidIfUnset sets zoneid to v, unless it is already set
+func (p *AddExternalLoadBalancerParams) SetZoneidIfUnset(v string) {
+	if _, found := p.p["zoneid"]; !found {
+		p.SetZoneid(v)
+	}
+}
+
 // You should always use this function to get a new AddExternalLoadBalancerParams instance,
-// as then you are sure you have configured all required params
-func (s *ExtLoadBalancerService) NewAddExternalLoadBalancerParams(password string, url string, username string, zoneid string) *AddExternalLoadBalancerParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *ExtLoadBalancerService) NewAddExternalLoadBalancerParams(password string, url string, username string, zoneid string, opts ...func(*AddExternalLoadBalancerParams)) *AddExternalLoadBalancerParams {
 	p := &AddExternalLoadBalancerParams{}
 	p.p = make(map[string]interface{})
 	p.p["password"] = password
 	p.p["url"] = url
 	p.p["username"] = username
 	p.p["zoneid"] = zoneid
+	for _, fn := range opts {
+		fn(p)
+	}
+	return p
+}
+
+// AddExternalLoadBalancerOpts contains all params that can be set on a AddExternalLoadBalancerParams.
+// Optional params are pointers (or slices and maps) which are only set when not nil.
+type AddExternalLoadBalancerOpts struct {
+	Password string
+	Url      string
+	Username string
+	Zoneid   string
+}
+
+// NewAddExternalLoadBalancerParamsFromOpts is an alternative for NewAddExternalLoadBalancerParams which takes all params using
+// an options struct, as that is easier to read for APIs with many required params
+func (s *ExtLoadBalancerService) NewAddExternalLoadBalancerParamsFromOpts(o AddExternalLoadBalancerOpts) *AddExternalLoadBalancerParams {
+	p := s.NewAddExternalLoadBalancerParams(o.Password, o.Url, o.Username, o.Zoneid)
 	return p
 }
 
 // Adds F5 external load balancer appliance.
-func (s *ExtLoadBalancerService) AddExternalLoadBalancer(p *AddExternalLoadBalancerParams) (*AddExternalLoadBalancerResponse, error) {
-	resp, err := s.cs.newRequest("addExternalLoadBalancer", p.toURLValues())
+func (s *ExtLoadBalancerService) AddExternalLoadBalancer(p *AddExternalLoadBalancerParams, opts ...OptionFunc) (*AddExternalLoadBalancerResponse, error) {
+	return s.AddExternalLoadBalancerWithContext(context.Background(), p, opts...)
+}
+
+// AddExternalLoadBalancerWithContext is the same as AddExternalLoadBalancer, but the request is cancelled when the context is done
+func (s *ExtLoadBalancerService) AddExternalLoadBalancerWithContext(ctx context.Context, p *AddExternalLoadBalancerParams, opts ...OptionFunc) (*AddExternalLoadBalancerResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.AddExternalLoadBalancerRawWithContext(ctx, p.toURLValues())
+}
+
+// AddExternalLoadBalancerRaw is the same as AddExternalLoadBalancer, but takes the params as url.Values instead of typed params
+func (s *ExtLoadBalancerService) AddExternalLoadBalancerRaw(v url.Values) (*AddExternalLoadBalancerResponse, error) {
+	return s.AddExternalLoadBalancerRawWithContext(context.Background(), v)
+}
+
+// AddExternalLoadBalancerRawWithContext is the same as AddExternalLoadBalancerRaw, but the request is cancelled when the context is done
+func (s *ExtLoadBalancerService) AddExternalLoadBalancerRawWithContext(ctx context.Context, v url.Values) (*AddExternalLoadBalancerResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "addExternalLoadBalancer", "addexternalloadbalancerresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -120,6 +214,16 @@ type DeleteExternalLoadBalancerParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *DeleteExternalLoadBalancerParams) Clone() *DeleteExternalLoadBalancerParams {
+	c := &DeleteExternalLoadBalancerParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeleteExternalLoadBalancerParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -131,6 +235,16 @@ func (p *DeleteExternalLoadBalancerParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *DeleteExternalLoadBalancerParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of deleteExternalLoadBalancer", k)
+		}
+	}
+	return nil
+}
+
 func (p *DeleteExternalLoadBalancerParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -139,18 +253,54 @@ func (p *DeleteExternalLoadBalancerParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *DeleteExternalLoadBalancerParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 // You should always use this function to get a new DeleteExternalLoadBalancerParams instance,
-// as then you are sure you have configured all required params
-func (s *ExtLoadBalancerService) NewDeleteExternalLoadBalancerParams(id string) *DeleteExternalLoadBalancerParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *ExtLoadBalancerService) NewDeleteExternalLoadBalancerParams(id string, opts ...func(*DeleteExternalLoadBalancerParams)) *DeleteExternalLoadBalancerParams {
 	p := &DeleteExternalLoadBalancerParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Deletes a F5 external load balancer appliance added in a zone.
-func (s *ExtLoadBalancerService) DeleteExternalLoadBalancer(p *DeleteExternalLoadBalancerParams) (*DeleteExternalLoadBalancerResponse, error) {
-	resp, err := s.cs.newRequest("deleteExternalLoadBalancer", p.toURLValues())
+func (s *ExtLoadBalancerService) DeleteExternalLoadBalancer(p *DeleteExternalLoadBalancerParams, opts ...OptionFunc) (*DeleteExternalLoadBalancerResponse, error) {
+	return s.DeleteExternalLoadBalancerWithContext(context.Background(), p, opts...)
+}
+
+// DeleteExternalLoadBalancerWithContext is the same as DeleteExternalLoadBalancer, but the request is cancelled when the context is done
+func (s *ExtLoadBalancerService) DeleteExternalLoadBalancerWithContext(ctx context.Context, p *DeleteExternalLoadBalancerParams, opts ...OptionFunc) (*DeleteExternalLoadBalancerResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.DeleteExternalLoadBalancerRawWithContext(ctx, p.toURLValues())
+}
+
+// DeleteExternalLoadBalancerRaw is the same as DeleteExternalLoadBalancer, but takes the params as url.Values instead of typed params
+func (s *ExtLoadBalancerService) DeleteExternalLoadBalancerRaw(v url.Values) (*DeleteExternalLoadBalancerResponse, error) {
+	return s.DeleteExternalLoadBalancerRawWithContext(context.Background(), v)
+}
+
+// DeleteExternalLoadBalancerRawWithContext is the same as DeleteExternalLoadBalancerRaw, but the request is cancelled when the context is done
+func (s *ExtLoadBalancerService) DeleteExternalLoadBalancerRawWithContext(ctx context.Context, v url.Values) (*DeleteExternalLoadBalancerResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteExternalLoadBalancer", "deleteexternalloadbalancerresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -191,6 +341,16 @@ type ListExternalLoadBalancersParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ListExternalLoadBalancersParams) Clone() *ListExternalLoadBalancersParams {
+	c := &ListExternalLoadBalancersParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListExternalLoadBalancersParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -221,6 +381,13 @@ func (p *ListExternalLoadBalancersParams) SetKeyword(v string) {
 	return
 }
 
+// SetKeywordIfUnset sets keyword to v, unless it is already set
+func (p *ListExternalLoadBalancersParams) SetKeywordIfUnset(v string) {
+	if _, found := p.p["keyword"]; !found {
+		p.SetKeyword(v)
+	}
+}
+
 func (p *ListExternalLoadBalancersParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -229,6 +396,13 @@ func (p *ListExternalLoadBalancersParams) SetPage(v int) {
 	return
 }
 
+// SetPageIfUnset sets page to v, unless it is already set
+func (p *ListExternalLoadBalancersParams) SetPageIfUnset(v int) {
+	if _, found := p.p["page"]; !found {
+		p.SetPage(v)
+	}
+}
+
 func (p *ListExternalLoadBalancersParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -237,6 +411,13 @@ func (p *ListExternalLoadBalancersParams) SetPagesize(v int) {
 	return
 }
 
+// SetPagesizeIfUnset sets pagesize to v, unless it is already set
+func (p *ListExternalLoadBalancersParams) SetPagesizeIfUnset(v int) {
+	if _, found := p.p["pagesize"]; !found {
+		p.SetPagesize(v)
+	}
+}
+
 func (p *ListExternalLoadBalancersParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -245,14 +426,32 @@ func (p *ListExternalLoadBalancersParams) SetZoneid(v string) {
 	return
 }
 
+// SetZoneidIfUnset sets zoneid to v, unless it is already set
+func (p *ListExternalLoadBalancersParams) SetZoneidIfUnset(v string) {
+	if _, found := p.p["zoneid"]; !found {
+		p.SetZoneid(v)
+	}
+}
+
 // You should always use this function to get a new ListExternalLoadBalancersParams instance,
-// as then you are sure you have configured all required params
-func (s *ExtLoadBalancerService) NewListExternalLoadBalancersParams() *ListExternalLoadBalancersParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *ExtLoadBalancerService) NewListExternalLoadBalancersParams(opts ...func(*ListExternalLoadBalancersParams)) *ListExternalLoadBalancersParams {
 	p := &ListExternalLoadBalancersParams{}
 	p.p = make(map[string]interface{})
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
+func (p *ListExternalLoadBalancersParams) setFirstMatch() {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p[firstMatchKey] = true
+}
+
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *ExtLoadBalancerService) GetExternalLoadBalancerID(keyword string, opts ...OptionFunc) (string, int, error) {
 	p := &ListExternalLoadBalancersParams{}
@@ -260,7 +459,7 @@ func (s *ExtLoadBalancerService) GetExternalLoadBalancerID(keyword string, opts
 
 	p.p["keyword"] = keyword
 
-	for _, fn := range append(s.cs.options, opts...) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
 		if err := fn(s.cs, p); err != nil {
 			return "", -1, err
 		}
@@ -272,7 +471,7 @@ func (s *ExtLoadBalancerService) GetExternalLoadBalancerID(keyword string, opts
 	}
 
 	if l.Count == 0 {
-		return "", l.Count, fmt.Errorf("No match found for %s: %+v", keyword, l)
+		return "", l.Count, fmt.Errorf("%w for %s: %+v", ErrNotFound, keyword, l)
 	}
 
 	if l.Count == 1 {
@@ -285,13 +484,32 @@ func (s *ExtLoadBalancerService) GetExternalLoadBalancerID(keyword string, opts
 				return v.Id, l.Count, nil
 			}
 		}
+
+		if _, ok := p.p[firstMatchKey]; ok && len(l.ExternalLoadBalancers) > 0 {
+			return l.ExternalLoadBalancers[0].Id, l.Count, nil
+		}
 	}
 	return "", l.Count, fmt.Errorf("Could not find an exact match for %s: %+v", keyword, l)
 }
 
 // Lists F5 external load balancer appliances added in a zone.
 func (s *ExtLoadBalancerService) ListExternalLoadBalancers(p *ListExternalLoadBalancersParams) (*ListExternalLoadBalancersResponse, error) {
-	resp, err := s.cs.newRequest("listExternalLoadBalancers", p.toURLValues())
+	return s.ListExternalLoadBalancersWithContext(context.Background(), p)
+}
+
+// ListExternalLoadBalancersWithContext is the same as ListExternalLoadBalancers, but the request is cancelled when the context is done
+func (s *ExtLoadBalancerService) ListExternalLoadBalancersWithContext(ctx context.Context, p *ListExternalLoadBalancersParams) (*ListExternalLoadBalancersResponse, error) {
+	return s.ListExternalLoadBalancersRawWithContext(ctx, p.toURLValues())
+}
+
+// ListExternalLoadBalancersRaw is the same as ListExternalLoadBalancers, but takes the params as url.Values instead of typed params
+func (s *ExtLoadBalancerService) ListExternalLoadBalancersRaw(v url.Values) (*ListExternalLoadBalancersResponse, error) {
+	return s.ListExternalLoadBalancersRawWithContext(context.Background(), v)
+}
+
+// ListExternalLoadBalancersRawWithContext is the same as ListExternalLoadBalancersRaw, but the request is cancelled when the context is done
+func (s *ExtLoadBalancerService) ListExternalLoadBalancersRawWithContext(ctx context.Context, v url.Values) (*ListExternalLoadBalancersResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "listExternalLoadBalancers", "listexternalloadbalancersresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -304,29 +522,116 @@ func (s *ExtLoadBalancerService) ListExternalLoadBalancers(p *ListExternalLoadBa
 	return &r, nil
 }
 
+// StreamExternalLoadBalancers fetches the results of ListExternalLoadBalancers page by page in the background and sends them on the
+// returned channel. Both returned channels are closed when done. If fetching a page fails, or the context
+// is done before all results are sent, the error is sent on the error channel and fetching stops. A
+// request for a page in flight is cancelled when the context is done. The page and pagesize set in the
+// params are ignored.
+func (s *ExtLoadBalancerService) StreamExternalLoadBalancers(ctx context.Context, p *ListExternalLoadBalancersParams) (<-chan *ExternalLoadBalancer, <-chan error) {
+	ch := make(chan *ExternalLoadBalancer)
+	errs := make(chan error, 1)
+
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesize(500)
+
+	go func() {
+		defer close(errs)
+		defer close(ch)
+
+		for page, count := 1, 0; ; page++ {
+			pp.SetPage(page)
+
+			l, err := s.ListExternalLoadBalancersWithContext(ctx, pp)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			for _, v := range l.ExternalLoadBalancers {
+				select {
+				case ch <- v:
+				case <-ctx.Done():
+					errs <- ctx.Err()
+					return
+				}
+			}
+
+			count += len(l.ExternalLoadBalancers)
+			if len(l.ExternalLoadBalancers) < 500 || count >= l.Count {
+				return
+			}
+		}
+	}()
+
+	return ch, errs
+}
+
+// ListExternalLoadBalancersWhere returns all results of ListExternalLoadBalancers for which pred returns true. Note that the results are
+// filtered client-side, so all results matching the params are fetched first.
+// All pages are fetched, so the page and pagesize set in the params are ignored.
+func (s *ExtLoadBalancerService) ListExternalLoadBalancersWhere(p *ListExternalLoadBalancersParams, pred func(*ExternalLoadBalancer) bool) ([]*ExternalLoadBalancer, error) {
+	var r []*ExternalLoadBalancer
+	ch, errs := s.StreamExternalLoadBalancers(context.Background(), p)
+	for v := range ch {
+		if pred(v) {
+			r = append(r, v)
+		}
+	}
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ListAllExternalLoadBalancers returns the results of ListExternalLoadBalancers across all pages, by calling ListExternalLoadBalancers page by page until all
+// results are fetched. The pagesize set in the params is used for every page (500 if not set), and the
+// page set in the params is ignored.
+func (s *ExtLoadBalancerService) ListAllExternalLoadBalancers(p *ListExternalLoadBalancersParams) ([]*ExternalLoadBalancer, error) {
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesizeIfUnset(500)
+	pagesize := pp.p["pagesize"].(int)
+
+	var r []*ExternalLoadBalancer
+	for page := 1; ; page++ {
+		pp.SetPage(page)
+
+		l, err := s.ListExternalLoadBalancers(pp)
+		if err != nil {
+			return nil, err
+		}
+		r = append(r, l.ExternalLoadBalancers...)
+
+		if len(l.ExternalLoadBalancers) < pagesize || len(r) >= l.Count {
+			return r, nil
+		}
+	}
+}
+
 type ListExternalLoadBalancersResponse struct {
 	Count                 int                     `json:"count"`
 	ExternalLoadBalancers []*ExternalLoadBalancer `json:"externalloadbalancer"`
 }
 
 type ExternalLoadBalancer struct {
-	Averageload             int64             `json:"averageload"`
-	Capabilities            string            `json:"capabilities"`
-	Clusterid               string            `json:"clusterid"`
-	Clustername             string            `json:"clustername"`
-	Clustertype             string            `json:"clustertype"`
-	Cpuallocated            string            `json:"cpuallocated"`
-	Cpunumber               int               `json:"cpunumber"`
-	Cpusockets              int               `json:"cpusockets"`
-	Cpuspeed                int64             `json:"cpuspeed"`
-	Cpuused                 string            `json:"cpuused"`
-	Cpuwithoverprovisioning string            `json:"cpuwithoverprovisioning"`
-	Created                 string            `json:"created"`
-	Details                 map[string]string `json:"details"`
-	Disconnected            string            `json:"disconnected"`
-	Disksizeallocated       int64             `json:"disksizeallocated"`
-	Disksizetotal           int64             `json:"disksizetotal"`
-	Events                  string            `json:"events"`
+	Averageload             int64     `json:"averageload"`
+	Capabilities            string    `json:"capabilities"`
+	Clusterid               string    `json:"clusterid"`
+	Clustername             string    `json:"clustername"`
+	Clustertype             string    `json:"clustertype"`
+	Cpuallocated            string    `json:"cpuallocated"`
+	Cpunumber               int       `json:"cpunumber"`
+	Cpusockets              int       `json:"cpusockets"`
+	Cpuspeed                int64     `json:"cpuspeed"`
+	Cpuused                 string    `json:"cpuused"`
+	Cpuwithoverprovisioning string    `json:"cpuwithoverprovisioning"`
+	Created                 string    `json:"created"`
+	Details                 StringMap `json:"details"`
+	Disconnected            string    `json:"disconnected"`
+	Disksizeallocated       int64     `json:"disksizeallocated"`
+	Disksizetotal           int64     `json:"disksizetotal"`
+	Events                  string    `json:"events"`
 	Gpugroup                []struct {
 		Gpugroupname string `json:"gpugroupname"`
 		Vgpu         []struct {
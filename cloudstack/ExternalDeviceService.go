@@ -17,7 +17,9 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
 	"net/url"
 	"strconv"
 )
@@ -26,6 +28,16 @@ type AddCiscoAsa1000vResourceParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *AddCiscoAsa1000vResourceParams) Clone() *AddCiscoAsa1000vResourceParams {
+	c := &AddCiscoAsa1000vResourceParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *AddCiscoAsa1000vResourceParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -46,6 +58,16 @@ func (p *AddCiscoAsa1000vResourceParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *AddCiscoAsa1000vResourceParams) validate() error {
+	for _, k := range []string{"clusterid", "hostname", "insideportprofile", "physicalnetworkid"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of addCiscoAsa1000vResource", k)
+		}
+	}
+	return nil
+}
+
 func (p *AddCiscoAsa1000vResourceParams) SetClusterid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -54,6 +76,13 @@ func (p *AddCiscoAsa1000vResourceParams) SetClusterid(v string) {
 	return
 }
 
+// SetClusteridIfUnset sets clusterid to v, unless it is already set
+func (p *AddCiscoAsa1000vResourceParams) SetClusteridIfUnset(v string) {
+	if _, found := p.p["clusterid"]; !found {
+		p.SetClusterid(v)
+	}
+}
+
 func (p *AddCiscoAsa1000vResourceParams) SetHostname(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -62,6 +91,13 @@ func (p *AddCiscoAsa1000vResourceParams) SetHostname(v string) {
 	return
 }
 
+// SetHostnameIfUnset sets hostname to v, unless it is already set
+func (p *AddCiscoAsa1000vResourceParams) SetHostnameIfUnset(v string) {
+	if _, found := p.p["hostname"]; !found {
+		p.SetHostname(v)
+	}
+}
+
 func (p *AddCiscoAsa1000vResourceParams) SetInsideportprofile(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -70,6 +106,13 @@ func (p *AddCiscoAsa1000vResourceParams) SetInsideportprofile(v string) {
 	return
 }
 
+// SetInsideportprofileIfUnset sets insideportprofile to v, unless it is already set
+func (p *AddCiscoAsa1000vResourceParams) SetInsideportprofileIfUnset(v string) {
+	if _, found := p.p["insideportprofile"]; !found {
+		p.SetInsideportprofile(v)
+	}
+}
+
 func (p *AddCiscoAsa1000vResourceParams) SetPhysicalnetworkid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -78,21 +121,73 @@ func (p *AddCiscoAsa1000vResourceParams) SetPhysicalnetworkid(v string) {
 	return
 }
 
+// SetPhysicalnetworkidIfUnset sets physicalnetworkid to v, unless it is already set
+func (p *AddCiscoAsa1000vResourceParams) SetPhysicalnetworkidIfUnset(v string) {
+	if _, found := p.p["physicalnetworkid"]; !found {
+		p.SetPhysicalnetworkid(v)
+	}
+}
+
 // You should always use this function to get a new AddCiscoAsa1000vResourceParams instance,
-// as then you are sure you have configured all required params
-func (s *ExternalDeviceService) NewAddCiscoAsa1000vResourceParams(clusterid string, hostname string, insideportprofile string, physicalnetworkid string) *AddCiscoAsa1000vResourceParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *ExternalDeviceService) NewAddCiscoAsa1000vResourceParams(clusterid string, hostname string, insideportprofile string, physicalnetworkid string, opts ...func(*AddCiscoAsa1000vResourceParams)) *AddCiscoAsa1000vResourceParams {
 	p := &AddCiscoAsa1000vResourceParams{}
 	p.p = make(map[string]interface{})
 	p.p["clusterid"] = clusterid
 	p.p["hostname"] = hostname
 	p.p["insideportprofile"] = insideportprofile
 	p.p["physicalnetworkid"] = physicalnetworkid
+	for _, fn := range opts {
+		fn(p)
+	}
+	return p
+}
+
+// AddCiscoAsa1000vResourceOpts contains all params that can be set on a AddCiscoAsa1000vResourceParams.
+// Optional params are pointers (or slices and maps) which are only set when not nil.
+type AddCiscoAsa1000vResourceOpts struct {
+	Clusterid         string
+	Hostname          string
+	Insideportprofile string
+	Physicalnetworkid string
+}
+
+// NewAddCiscoAsa1000vResourceParamsFromOpts is an alternative for NewAddCiscoAsa1000vResourceParams which takes all params using
+// an options struct, as that is easier to read for APIs with many required params
+func (s *ExternalDeviceService) NewAddCiscoAsa1000vResourceParamsFromOpts(o AddCiscoAsa1000vResourceOpts) *AddCiscoAsa1000vResourceParams {
+	p := s.NewAddCiscoAsa1000vResourceParams(o.Clusterid, o.Hostname, o.Insideportprofile, o.Physicalnetworkid)
 	return p
 }
 
 // Adds a Cisco Asa 1000v appliance
-func (s *ExternalDeviceService) AddCiscoAsa1000vResource(p *AddCiscoAsa1000vResourceParams) (*AddCiscoAsa1000vResourceResponse, error) {
-	resp, err := s.cs.newRequest("addCiscoAsa1000vResource", p.toURLValues())
+func (s *ExternalDeviceService) AddCiscoAsa1000vResource(p *AddCiscoAsa1000vResourceParams, opts ...OptionFunc) (*AddCiscoAsa1000vResourceResponse, error) {
+	return s.AddCiscoAsa1000vResourceWithContext(context.Background(), p, opts...)
+}
+
+// AddCiscoAsa1000vResourceWithContext is the same as AddCiscoAsa1000vResource, but the request is cancelled when the context is done
+func (s *ExternalDeviceService) AddCiscoAsa1000vResourceWithContext(ctx context.Context, p *AddCiscoAsa1000vResourceParams, opts ...OptionFunc) (*AddCiscoAsa1000vResourceResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.AddCiscoAsa1000vResourceRawWithContext(ctx, p.toURLValues())
+}
+
+// AddCiscoAsa1000vResourceRaw is the same as AddCiscoAsa1000vResource, but takes the params as url.Values instead of typed params
+func (s *ExternalDeviceService) AddCiscoAsa1000vResourceRaw(v url.Values) (*AddCiscoAsa1000vResourceResponse, error) {
+	return s.AddCiscoAsa1000vResourceRawWithContext(context.Background(), v)
+}
+
+// AddCiscoAsa1000vResourceRawWithContext is the same as AddCiscoAsa1000vResourceRaw, but the request is cancelled when the context is done
+func (s *ExternalDeviceService) AddCiscoAsa1000vResourceRawWithContext(ctx context.Context, v url.Values) (*AddCiscoAsa1000vResourceResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "addCiscoAsa1000vResource", "addciscoasa1000vresourceresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -112,6 +207,16 @@ type AddCiscoVnmcResourceParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *AddCiscoVnmcResourceParams) Clone() *AddCiscoVnmcResourceParams {
+	c := &AddCiscoVnmcResourceParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *AddCiscoVnmcResourceParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -132,6 +237,16 @@ func (p *AddCiscoVnmcResourceParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *AddCiscoVnmcResourceParams) validate() error {
+	for _, k := range []string{"hostname", "password", "physicalnetworkid", "username"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of addCiscoVnmcResource", k)
+		}
+	}
+	return nil
+}
+
 func (p *AddCiscoVnmcResourceParams) SetHostname(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -140,6 +255,13 @@ func (p *AddCiscoVnmcResourceParams) SetHostname(v string) {
 	return
 }
 
+// SetHostnameIfUnset sets hostname to v, unless it is already set
+func (p *AddCiscoVnmcResourceParams) SetHostnameIfUnset(v string) {
+	if _, found := p.p["hostname"]; !found {
+		p.SetHostname(v)
+	}
+}
+
 func (p *AddCiscoVnmcResourceParams) SetPassword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -148,6 +270,13 @@ func (p *AddCiscoVnmcResourceParams) SetPassword(v string) {
 	return
 }
 
+// SetPasswordIfUnset sets password to v, unless it is already set
+func (p *AddCiscoVnmcResourceParams) SetPasswordIfUnset(v string) {
+	if _, found := p.p["password"]; !found {
+		p.SetPassword(v)
+	}
+}
+
 func (p *AddCiscoVnmcResourceParams) SetPhysicalnetworkid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -156,6 +285,13 @@ func (p *AddCiscoVnmcResourceParams) SetPhysicalnetworkid(v string) {
 	return
 }
 
+// SetPhysicalnetworkidIfUnset sets physicalnetworkid to v, unless it is already set
+func (p *AddCiscoVnmcResourceParams) SetPhysicalnetworkidIfUnset(v string) {
+	if _, found := p.p["physicalnetworkid"]; !found {
+		p.SetPhysicalnetworkid(v)
+	}
+}
+
 func (p *AddCiscoVnmcResourceParams) SetUsername(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -164,21 +300,73 @@ func (p *AddCiscoVnmcResourceParams) SetUsername(v string) {
 	return
 }
 
+// SetUsernameIfUnset sets username to v, unless it is already set
+func (p *AddCiscoVnmcResourceParams) SetUsernameIfUnset(v string) {
+	if _, found := p.p["username"]; !found {
+		p.SetUsername(v)
+	}
+}
+
 // You should always use this function to get a new AddCiscoVnmcResourceParams instance,
-// as then you are sure you have configured all required params
-func (s *ExternalDeviceService) NewAddCiscoVnmcResourceParams(hostname string, password string, physicalnetworkid string, username string) *AddCiscoVnmcResourceParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *ExternalDeviceService) NewAddCiscoVnmcResourceParams(hostname string, password string, physicalnetworkid string, username string, opts ...func(*AddCiscoVnmcResourceParams)) *AddCiscoVnmcResourceParams {
 	p := &AddCiscoVnmcResourceParams{}
 	p.p = make(map[string]interface{})
 	p.p["hostname"] = hostname
 	p.p["password"] = password
 	p.p["physicalnetworkid"] = physicalnetworkid
 	p.p["username"] = username
+	for _, fn := range opts {
+		fn(p)
+	}
+	return p
+}
+
+// AddCiscoVnmcResourceOpts contains all params that can be set on a AddCiscoVnmcResourceParams.
+// Optional params are pointers (or slices and maps) which are only set when not nil.
+type AddCiscoVnmcResourceOpts struct {
+	Hostname          string
+	Password          string
+	Physicalnetworkid string
+	Username          string
+}
+
+// NewAddCiscoVnmcResourceParamsFromOpts is an alternative for NewAddCiscoVnmcResourceParams which takes all params using
+// an options struct, as that is easier to read for APIs with many required params
+func (s *ExternalDeviceService) NewAddCiscoVnmcResourceParamsFromOpts(o AddCiscoVnmcResourceOpts) *AddCiscoVnmcResourceParams {
+	p := s.NewAddCiscoVnmcResourceParams(o.Hostname, o.Password, o.Physicalnetworkid, o.Username)
 	return p
 }
 
 // Adds a Cisco Vnmc Controller
-func (s *ExternalDeviceService) AddCiscoVnmcResource(p *AddCiscoVnmcResourceParams) (*AddCiscoVnmcResourceResponse, error) {
-	resp, err := s.cs.newRequest("addCiscoVnmcResource", p.toURLValues())
+func (s *ExternalDeviceService) AddCiscoVnmcResource(p *AddCiscoVnmcResourceParams, opts ...OptionFunc) (*AddCiscoVnmcResourceResponse, error) {
+	return s.AddCiscoVnmcResourceWithContext(context.Background(), p, opts...)
+}
+
+// AddCiscoVnmcResourceWithContext is the same as AddCiscoVnmcResource, but the request is cancelled when the context is done
+func (s *ExternalDeviceService) AddCiscoVnmcResourceWithContext(ctx context.Context, p *AddCiscoVnmcResourceParams, opts ...OptionFunc) (*AddCiscoVnmcResourceResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.AddCiscoVnmcResourceRawWithContext(ctx, p.toURLValues())
+}
+
+// AddCiscoVnmcResourceRaw is the same as AddCiscoVnmcResource, but takes the params as url.Values instead of typed params
+func (s *ExternalDeviceService) AddCiscoVnmcResourceRaw(v url.Values) (*AddCiscoVnmcResourceResponse, error) {
+	return s.AddCiscoVnmcResourceRawWithContext(context.Background(), v)
+}
+
+// AddCiscoVnmcResourceRawWithContext is the same as AddCiscoVnmcResourceRaw, but the request is cancelled when the context is done
+func (s *ExternalDeviceService) AddCiscoVnmcResourceRawWithContext(ctx context.Context, v url.Values) (*AddCiscoVnmcResourceResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "addCiscoVnmcResource", "addciscovnmcresourceresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -198,6 +386,16 @@ type DeleteCiscoAsa1000vResourceParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *DeleteCiscoAsa1000vResourceParams) Clone() *DeleteCiscoAsa1000vResourceParams {
+	c := &DeleteCiscoAsa1000vResourceParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeleteCiscoAsa1000vResourceParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -209,6 +407,16 @@ func (p *DeleteCiscoAsa1000vResourceParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *DeleteCiscoAsa1000vResourceParams) validate() error {
+	for _, k := range []string{"resourceid"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of deleteCiscoAsa1000vResource", k)
+		}
+	}
+	return nil
+}
+
 func (p *DeleteCiscoAsa1000vResourceParams) SetResourceid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -217,18 +425,54 @@ func (p *DeleteCiscoAsa1000vResourceParams) SetResourceid(v string) {
 	return
 }
 
+// SetResourceidIfUnset sets resourceid to v, unless it is already set
+func (p *DeleteCiscoAsa1000vResourceParams) SetResourceidIfUnset(v string) {
+	if _, found := p.p["resourceid"]; !found {
+		p.SetResourceid(v)
+	}
+}
+
 // You should always use this function to get a new DeleteCiscoAsa1000vResourceParams instance,
-// as then you are sure you have configured all required params
-func (s *ExternalDeviceService) NewDeleteCiscoAsa1000vResourceParams(resourceid string) *DeleteCiscoAsa1000vResourceParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *ExternalDeviceService) NewDeleteCiscoAsa1000vResourceParams(resourceid string, opts ...func(*DeleteCiscoAsa1000vResourceParams)) *DeleteCiscoAsa1000vResourceParams {
 	p := &DeleteCiscoAsa1000vResourceParams{}
 	p.p = make(map[string]interface{})
 	p.p["resourceid"] = resourceid
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Deletes a Cisco ASA 1000v appliance
-func (s *ExternalDeviceService) DeleteCiscoAsa1000vResource(p *DeleteCiscoAsa1000vResourceParams) (*DeleteCiscoAsa1000vResourceResponse, error) {
-	resp, err := s.cs.newRequest("deleteCiscoAsa1000vResource", p.toURLValues())
+func (s *ExternalDeviceService) DeleteCiscoAsa1000vResource(p *DeleteCiscoAsa1000vResourceParams, opts ...OptionFunc) (*DeleteCiscoAsa1000vResourceResponse, error) {
+	return s.DeleteCiscoAsa1000vResourceWithContext(context.Background(), p, opts...)
+}
+
+// DeleteCiscoAsa1000vResourceWithContext is the same as DeleteCiscoAsa1000vResource, but the request is cancelled when the context is done
+func (s *ExternalDeviceService) DeleteCiscoAsa1000vResourceWithContext(ctx context.Context, p *DeleteCiscoAsa1000vResourceParams, opts ...OptionFunc) (*DeleteCiscoAsa1000vResourceResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.DeleteCiscoAsa1000vResourceRawWithContext(ctx, p.toURLValues())
+}
+
+// DeleteCiscoAsa1000vResourceRaw is the same as DeleteCiscoAsa1000vResource, but takes the params as url.Values instead of typed params
+func (s *ExternalDeviceService) DeleteCiscoAsa1000vResourceRaw(v url.Values) (*DeleteCiscoAsa1000vResourceResponse, error) {
+	return s.DeleteCiscoAsa1000vResourceRawWithContext(context.Background(), v)
+}
+
+// DeleteCiscoAsa1000vResourceRawWithContext is the same as DeleteCiscoAsa1000vResourceRaw, but the request is cancelled when the context is done
+func (s *ExternalDeviceService) DeleteCiscoAsa1000vResourceRawWithContext(ctx context.Context, v url.Values) (*DeleteCiscoAsa1000vResourceResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteCiscoAsa1000vResource", "deleteciscoasa1000vresourceresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -269,6 +513,16 @@ type DeleteCiscoNexusVSMParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *DeleteCiscoNexusVSMParams) Clone() *DeleteCiscoNexusVSMParams {
+	c := &DeleteCiscoNexusVSMParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeleteCiscoNexusVSMParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -280,6 +534,16 @@ func (p *DeleteCiscoNexusVSMParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *DeleteCiscoNexusVSMParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of deleteCiscoNexusVSM", k)
+		}
+	}
+	return nil
+}
+
 func (p *DeleteCiscoNexusVSMParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -288,18 +552,54 @@ func (p *DeleteCiscoNexusVSMParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *DeleteCiscoNexusVSMParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 // You should always use this function to get a new DeleteCiscoNexusVSMParams instance,
-// as then you are sure you have configured all required params
-func (s *ExternalDeviceService) NewDeleteCiscoNexusVSMParams(id string) *DeleteCiscoNexusVSMParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *ExternalDeviceService) NewDeleteCiscoNexusVSMParams(id string, opts ...func(*DeleteCiscoNexusVSMParams)) *DeleteCiscoNexusVSMParams {
 	p := &DeleteCiscoNexusVSMParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
-//  delete a Cisco Nexus VSM device
-func (s *ExternalDeviceService) DeleteCiscoNexusVSM(p *DeleteCiscoNexusVSMParams) (*DeleteCiscoNexusVSMResponse, error) {
-	resp, err := s.cs.newRequest("deleteCiscoNexusVSM", p.toURLValues())
+// delete a Cisco Nexus VSM device
+func (s *ExternalDeviceService) DeleteCiscoNexusVSM(p *DeleteCiscoNexusVSMParams, opts ...OptionFunc) (*DeleteCiscoNexusVSMResponse, error) {
+	return s.DeleteCiscoNexusVSMWithContext(context.Background(), p, opts...)
+}
+
+// DeleteCiscoNexusVSMWithContext is the same as DeleteCiscoNexusVSM, but the request is cancelled when the context is done
+func (s *ExternalDeviceService) DeleteCiscoNexusVSMWithContext(ctx context.Context, p *DeleteCiscoNexusVSMParams, opts ...OptionFunc) (*DeleteCiscoNexusVSMResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.DeleteCiscoNexusVSMRawWithContext(ctx, p.toURLValues())
+}
+
+// DeleteCiscoNexusVSMRaw is the same as DeleteCiscoNexusVSM, but takes the params as url.Values instead of typed params
+func (s *ExternalDeviceService) DeleteCiscoNexusVSMRaw(v url.Values) (*DeleteCiscoNexusVSMResponse, error) {
+	return s.DeleteCiscoNexusVSMRawWithContext(context.Background(), v)
+}
+
+// DeleteCiscoNexusVSMRawWithContext is the same as DeleteCiscoNexusVSMRaw, but the request is cancelled when the context is done
+func (s *ExternalDeviceService) DeleteCiscoNexusVSMRawWithContext(ctx context.Context, v url.Values) (*DeleteCiscoNexusVSMResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteCiscoNexusVSM", "deletecisconexusvsmresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -311,9 +611,9 @@ func (s *ExternalDeviceService) DeleteCiscoNexusVSM(p *DeleteCiscoNexusVSMParams
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -337,6 +637,16 @@ type DeleteCiscoVnmcResourceParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *DeleteCiscoVnmcResourceParams) Clone() *DeleteCiscoVnmcResourceParams {
+	c := &DeleteCiscoVnmcResourceParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeleteCiscoVnmcResourceParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -348,6 +658,16 @@ func (p *DeleteCiscoVnmcResourceParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *DeleteCiscoVnmcResourceParams) validate() error {
+	for _, k := range []string{"resourceid"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of deleteCiscoVnmcResource", k)
+		}
+	}
+	return nil
+}
+
 func (p *DeleteCiscoVnmcResourceParams) SetResourceid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -356,18 +676,54 @@ func (p *DeleteCiscoVnmcResourceParams) SetResourceid(v string) {
 	return
 }
 
+// SetResourceidIfUnset sets resourceid to v, unless it is already set
+func (p *DeleteCiscoVnmcResourceParams) SetResourceidIfUnset(v string) {
+	if _, found := p.p["resourceid"]; !found {
+		p.SetResourceid(v)
+	}
+}
+
 // You should always use this function to get a new DeleteCiscoVnmcResourceParams instance,
-// as then you are sure you have configured all required params
-func (s *ExternalDeviceService) NewDeleteCiscoVnmcResourceParams(resourceid string) *DeleteCiscoVnmcResourceParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *ExternalDeviceService) NewDeleteCiscoVnmcResourceParams(resourceid string, opts ...func(*DeleteCiscoVnmcResourceParams)) *DeleteCiscoVnmcResourceParams {
 	p := &DeleteCiscoVnmcResourceParams{}
 	p.p = make(map[string]interface{})
 	p.p["resourceid"] = resourceid
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Deletes a Cisco Vnmc controller
-func (s *ExternalDeviceService) DeleteCiscoVnmcResource(p *DeleteCiscoVnmcResourceParams) (*DeleteCiscoVnmcResourceResponse, error) {
-	resp, err := s.cs.newRequest("deleteCiscoVnmcResource", p.toURLValues())
+func (s *ExternalDeviceService) DeleteCiscoVnmcResource(p *DeleteCiscoVnmcResourceParams, opts ...OptionFunc) (*DeleteCiscoVnmcResourceResponse, error) {
+	return s.DeleteCiscoVnmcResourceWithContext(context.Background(), p, opts...)
+}
+
+// DeleteCiscoVnmcResourceWithContext is the same as DeleteCiscoVnmcResource, but the request is cancelled when the context is done
+func (s *ExternalDeviceService) DeleteCiscoVnmcResourceWithContext(ctx context.Context, p *DeleteCiscoVnmcResourceParams, opts ...OptionFunc) (*DeleteCiscoVnmcResourceResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.DeleteCiscoVnmcResourceRawWithContext(ctx, p.toURLValues())
+}
+
+// DeleteCiscoVnmcResourceRaw is the same as DeleteCiscoVnmcResource, but takes the params as url.Values instead of typed params
+func (s *ExternalDeviceService) DeleteCiscoVnmcResourceRaw(v url.Values) (*DeleteCiscoVnmcResourceResponse, error) {
+	return s.DeleteCiscoVnmcResourceRawWithContext(context.Background(), v)
+}
+
+// DeleteCiscoVnmcResourceRawWithContext is the same as DeleteCiscoVnmcResourceRaw, but the request is cancelled when the context is done
+func (s *ExternalDeviceService) DeleteCiscoVnmcResourceRawWithContext(ctx context.Context, v url.Values) (*DeleteCiscoVnmcResourceResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteCiscoVnmcResource", "deleteciscovnmcresourceresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -408,6 +764,16 @@ type DisableCiscoNexusVSMParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *DisableCiscoNexusVSMParams) Clone() *DisableCiscoNexusVSMParams {
+	c := &DisableCiscoNexusVSMParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DisableCiscoNexusVSMParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -419,6 +785,16 @@ func (p *DisableCiscoNexusVSMParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *DisableCiscoNexusVSMParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of disableCiscoNexusVSM", k)
+		}
+	}
+	return nil
+}
+
 func (p *DisableCiscoNexusVSMParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -427,18 +803,54 @@ func (p *DisableCiscoNexusVSMParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *DisableCiscoNexusVSMParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 // You should always use this function to get a new DisableCiscoNexusVSMParams instance,
-// as then you are sure you have configured all required params
-func (s *ExternalDeviceService) NewDisableCiscoNexusVSMParams(id string) *DisableCiscoNexusVSMParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *ExternalDeviceService) NewDisableCiscoNexusVSMParams(id string, opts ...func(*DisableCiscoNexusVSMParams)) *DisableCiscoNexusVSMParams {
 	p := &DisableCiscoNexusVSMParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // disable a Cisco Nexus VSM device
-func (s *ExternalDeviceService) DisableCiscoNexusVSM(p *DisableCiscoNexusVSMParams) (*DisableCiscoNexusVSMResponse, error) {
-	resp, err := s.cs.newRequest("disableCiscoNexusVSM", p.toURLValues())
+func (s *ExternalDeviceService) DisableCiscoNexusVSM(p *DisableCiscoNexusVSMParams, opts ...OptionFunc) (*DisableCiscoNexusVSMResponse, error) {
+	return s.DisableCiscoNexusVSMWithContext(context.Background(), p, opts...)
+}
+
+// DisableCiscoNexusVSMWithContext is the same as DisableCiscoNexusVSM, but the request is cancelled when the context is done
+func (s *ExternalDeviceService) DisableCiscoNexusVSMWithContext(ctx context.Context, p *DisableCiscoNexusVSMParams, opts ...OptionFunc) (*DisableCiscoNexusVSMResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.DisableCiscoNexusVSMRawWithContext(ctx, p.toURLValues())
+}
+
+// DisableCiscoNexusVSMRaw is the same as DisableCiscoNexusVSM, but takes the params as url.Values instead of typed params
+func (s *ExternalDeviceService) DisableCiscoNexusVSMRaw(v url.Values) (*DisableCiscoNexusVSMResponse, error) {
+	return s.DisableCiscoNexusVSMRawWithContext(context.Background(), v)
+}
+
+// DisableCiscoNexusVSMRawWithContext is the same as DisableCiscoNexusVSMRaw, but the request is cancelled when the context is done
+func (s *ExternalDeviceService) DisableCiscoNexusVSMRawWithContext(ctx context.Context, v url.Values) (*DisableCiscoNexusVSMResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "disableCiscoNexusVSM", "disablecisconexusvsmresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -450,9 +862,9 @@ func (s *ExternalDeviceService) DisableCiscoNexusVSM(p *DisableCiscoNexusVSMPara
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -490,6 +902,16 @@ type EnableCiscoNexusVSMParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *EnableCiscoNexusVSMParams) Clone() *EnableCiscoNexusVSMParams {
+	c := &EnableCiscoNexusVSMParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *EnableCiscoNexusVSMParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -501,6 +923,16 @@ func (p *EnableCiscoNexusVSMParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *EnableCiscoNexusVSMParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of enableCiscoNexusVSM", k)
+		}
+	}
+	return nil
+}
+
 func (p *EnableCiscoNexusVSMParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -509,18 +941,54 @@ func (p *EnableCiscoNexusVSMParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *EnableCiscoNexusVSMParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 // You should always use this function to get a new EnableCiscoNexusVSMParams instance,
-// as then you are sure you have configured all required params
-func (s *ExternalDeviceService) NewEnableCiscoNexusVSMParams(id string) *EnableCiscoNexusVSMParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *ExternalDeviceService) NewEnableCiscoNexusVSMParams(id string, opts ...func(*EnableCiscoNexusVSMParams)) *EnableCiscoNexusVSMParams {
 	p := &EnableCiscoNexusVSMParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Enable a Cisco Nexus VSM device
-func (s *ExternalDeviceService) EnableCiscoNexusVSM(p *EnableCiscoNexusVSMParams) (*EnableCiscoNexusVSMResponse, error) {
-	resp, err := s.cs.newRequest("enableCiscoNexusVSM", p.toURLValues())
+func (s *ExternalDeviceService) EnableCiscoNexusVSM(p *EnableCiscoNexusVSMParams, opts ...OptionFunc) (*EnableCiscoNexusVSMResponse, error) {
+	return s.EnableCiscoNexusVSMWithContext(context.Background(), p, opts...)
+}
+
+// EnableCiscoNexusVSMWithContext is the same as EnableCiscoNexusVSM, but the request is cancelled when the context is done
+func (s *ExternalDeviceService) EnableCiscoNexusVSMWithContext(ctx context.Context, p *EnableCiscoNexusVSMParams, opts ...OptionFunc) (*EnableCiscoNexusVSMResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.EnableCiscoNexusVSMRawWithContext(ctx, p.toURLValues())
+}
+
+// EnableCiscoNexusVSMRaw is the same as EnableCiscoNexusVSM, but takes the params as url.Values instead of typed params
+func (s *ExternalDeviceService) EnableCiscoNexusVSMRaw(v url.Values) (*EnableCiscoNexusVSMResponse, error) {
+	return s.EnableCiscoNexusVSMRawWithContext(context.Background(), v)
+}
+
+// EnableCiscoNexusVSMRawWithContext is the same as EnableCiscoNexusVSMRaw, but the request is cancelled when the context is done
+func (s *ExternalDeviceService) EnableCiscoNexusVSMRawWithContext(ctx context.Context, v url.Values) (*EnableCiscoNexusVSMResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "enableCiscoNexusVSM", "enablecisconexusvsmresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -532,9 +1000,9 @@ func (s *ExternalDeviceService) EnableCiscoNexusVSM(p *EnableCiscoNexusVSMParams
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -572,6 +1040,16 @@ type ListCiscoAsa1000vResourcesParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ListCiscoAsa1000vResourcesParams) Clone() *ListCiscoAsa1000vResourcesParams {
+	c := &ListCiscoAsa1000vResourcesParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListCiscoAsa1000vResourcesParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -608,6 +1086,13 @@ func (p *ListCiscoAsa1000vResourcesParams) SetHostname(v string) {
 	return
 }
 
+// SetHostnameIfUnset sets hostname to v, unless it is already set
+func (p *ListCiscoAsa1000vResourcesParams) SetHostnameIfUnset(v string) {
+	if _, found := p.p["hostname"]; !found {
+		p.SetHostname(v)
+	}
+}
+
 func (p *ListCiscoAsa1000vResourcesParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -616,6 +1101,13 @@ func (p *ListCiscoAsa1000vResourcesParams) SetKeyword(v string) {
 	return
 }
 
+// SetKeywordIfUnset sets keyword to v, unless it is already set
+func (p *ListCiscoAsa1000vResourcesParams) SetKeywordIfUnset(v string) {
+	if _, found := p.p["keyword"]; !found {
+		p.SetKeyword(v)
+	}
+}
+
 func (p *ListCiscoAsa1000vResourcesParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -624,6 +1116,13 @@ func (p *ListCiscoAsa1000vResourcesParams) SetPage(v int) {
 	return
 }
 
+// SetPageIfUnset sets page to v, unless it is already set
+func (p *ListCiscoAsa1000vResourcesParams) SetPageIfUnset(v int) {
+	if _, found := p.p["page"]; !found {
+		p.SetPage(v)
+	}
+}
+
 func (p *ListCiscoAsa1000vResourcesParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -632,6 +1131,13 @@ func (p *ListCiscoAsa1000vResourcesParams) SetPagesize(v int) {
 	return
 }
 
+// SetPagesizeIfUnset sets pagesize to v, unless it is already set
+func (p *ListCiscoAsa1000vResourcesParams) SetPagesizeIfUnset(v int) {
+	if _, found := p.p["pagesize"]; !found {
+		p.SetPagesize(v)
+	}
+}
+
 func (p *ListCiscoAsa1000vResourcesParams) SetPhysicalnetworkid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -640,6 +1146,13 @@ func (p *ListCiscoAsa1000vResourcesParams) SetPhysicalnetworkid(v string) {
 	return
 }
 
+// SetPhysicalnetworkidIfUnset sets physicalnetworkid to v, unless it is already set
+func (p *ListCiscoAsa1000vResourcesParams) SetPhysicalnetworkidIfUnset(v string) {
+	if _, found := p.p["physicalnetworkid"]; !found {
+		p.SetPhysicalnetworkid(v)
+	}
+}
+
 func (p *ListCiscoAsa1000vResourcesParams) SetResourceid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -648,17 +1161,43 @@ func (p *ListCiscoAsa1000vResourcesParams) SetResourceid(v string) {
 	return
 }
 
+// SetResourceidIfUnset sets resourceid to v, unless it is already set
+func (p *ListCiscoAsa1000vResourcesParams) SetResourceidIfUnset(v string) {
+	if _, found := p.p["resourceid"]; !found {
+		p.SetResourceid(v)
+	}
+}
+
 // You should always use this function to get a new ListCiscoAsa1000vResourcesParams instance,
-// as then you are sure you have configured all required params
-func (s *ExternalDeviceService) NewListCiscoAsa1000vResourcesParams() *ListCiscoAsa1000vResourcesParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *ExternalDeviceService) NewListCiscoAsa1000vResourcesParams(opts ...func(*ListCiscoAsa1000vResourcesParams)) *ListCiscoAsa1000vResourcesParams {
 	p := &ListCiscoAsa1000vResourcesParams{}
 	p.p = make(map[string]interface{})
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Lists Cisco ASA 1000v appliances
 func (s *ExternalDeviceService) ListCiscoAsa1000vResources(p *ListCiscoAsa1000vResourcesParams) (*ListCiscoAsa1000vResourcesResponse, error) {
-	resp, err := s.cs.newRequest("listCiscoAsa1000vResources", p.toURLValues())
+	return s.ListCiscoAsa1000vResourcesWithContext(context.Background(), p)
+}
+
+// ListCiscoAsa1000vResourcesWithContext is the same as ListCiscoAsa1000vResources, but the request is cancelled when the context is done
+func (s *ExternalDeviceService) ListCiscoAsa1000vResourcesWithContext(ctx context.Context, p *ListCiscoAsa1000vResourcesParams) (*ListCiscoAsa1000vResourcesResponse, error) {
+	return s.ListCiscoAsa1000vResourcesRawWithContext(ctx, p.toURLValues())
+}
+
+// ListCiscoAsa1000vResourcesRaw is the same as ListCiscoAsa1000vResources, but takes the params as url.Values instead of typed params
+func (s *ExternalDeviceService) ListCiscoAsa1000vResourcesRaw(v url.Values) (*ListCiscoAsa1000vResourcesResponse, error) {
+	return s.ListCiscoAsa1000vResourcesRawWithContext(context.Background(), v)
+}
+
+// ListCiscoAsa1000vResourcesRawWithContext is the same as ListCiscoAsa1000vResourcesRaw, but the request is cancelled when the context is done
+func (s *ExternalDeviceService) ListCiscoAsa1000vResourcesRawWithContext(ctx context.Context, v url.Values) (*ListCiscoAsa1000vResourcesResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "listCiscoAsa1000vResources", "listciscoasa1000vresourcesresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -671,6 +1210,93 @@ func (s *ExternalDeviceService) ListCiscoAsa1000vResources(p *ListCiscoAsa1000vR
 	return &r, nil
 }
 
+// StreamCiscoAsa1000vResources fetches the results of ListCiscoAsa1000vResources page by page in the background and sends them on the
+// returned channel. Both returned channels are closed when done. If fetching a page fails, or the context
+// is done before all results are sent, the error is sent on the error channel and fetching stops. A
+// request for a page in flight is cancelled when the context is done. The page and pagesize set in the
+// params are ignored.
+func (s *ExternalDeviceService) StreamCiscoAsa1000vResources(ctx context.Context, p *ListCiscoAsa1000vResourcesParams) (<-chan *CiscoAsa1000vResource, <-chan error) {
+	ch := make(chan *CiscoAsa1000vResource)
+	errs := make(chan error, 1)
+
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesize(500)
+
+	go func() {
+		defer close(errs)
+		defer close(ch)
+
+		for page, count := 1, 0; ; page++ {
+			pp.SetPage(page)
+
+			l, err := s.ListCiscoAsa1000vResourcesWithContext(ctx, pp)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			for _, v := range l.CiscoAsa1000vResources {
+				select {
+				case ch <- v:
+				case <-ctx.Done():
+					errs <- ctx.Err()
+					return
+				}
+			}
+
+			count += len(l.CiscoAsa1000vResources)
+			if len(l.CiscoAsa1000vResources) < 500 || count >= l.Count {
+				return
+			}
+		}
+	}()
+
+	return ch, errs
+}
+
+// ListCiscoAsa1000vResourcesWhere returns all results of ListCiscoAsa1000vResources for which pred returns true. Note that the results are
+// filtered client-side, so all results matching the params are fetched first.
+// All pages are fetched, so the page and pagesize set in the params are ignored.
+func (s *ExternalDeviceService) ListCiscoAsa1000vResourcesWhere(p *ListCiscoAsa1000vResourcesParams, pred func(*CiscoAsa1000vResource) bool) ([]*CiscoAsa1000vResource, error) {
+	var r []*CiscoAsa1000vResource
+	ch, errs := s.StreamCiscoAsa1000vResources(context.Background(), p)
+	for v := range ch {
+		if pred(v) {
+			r = append(r, v)
+		}
+	}
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ListAllCiscoAsa1000vResources returns the results of ListCiscoAsa1000vResources across all pages, by calling ListCiscoAsa1000vResources page by page until all
+// results are fetched. The pagesize set in the params is used for every page (500 if not set), and the
+// page set in the params is ignored.
+func (s *ExternalDeviceService) ListAllCiscoAsa1000vResources(p *ListCiscoAsa1000vResourcesParams) ([]*CiscoAsa1000vResource, error) {
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesizeIfUnset(500)
+	pagesize := pp.p["pagesize"].(int)
+
+	var r []*CiscoAsa1000vResource
+	for page := 1; ; page++ {
+		pp.SetPage(page)
+
+		l, err := s.ListCiscoAsa1000vResources(pp)
+		if err != nil {
+			return nil, err
+		}
+		r = append(r, l.CiscoAsa1000vResources...)
+
+		if len(l.CiscoAsa1000vResources) < pagesize || len(r) >= l.Count {
+			return r, nil
+		}
+	}
+}
+
 type ListCiscoAsa1000vResourcesResponse struct {
 	Count                  int                      `json:"count"`
 	CiscoAsa1000vResources []*CiscoAsa1000vResource `json:"ciscoasa1000vresource"`
@@ -683,6 +1309,16 @@ type ListCiscoNexusVSMsParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ListCiscoNexusVSMsParams) Clone() *ListCiscoNexusVSMsParams {
+	c := &ListCiscoNexusVSMsParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListCiscoNexusVSMsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -716,6 +1352,13 @@ func (p *ListCiscoNexusVSMsParams) SetClusterid(v string) {
 	return
 }
 
+// SetClusteridIfUnset sets clusterid to v, unless it is already set
+func (p *ListCiscoNexusVSMsParams) SetClusteridIfUnset(v string) {
+	if _, found := p.p["clusterid"]; !found {
+		p.SetClusterid(v)
+	}
+}
+
 func (p *ListCiscoNexusVSMsParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -724,6 +1367,13 @@ func (p *ListCiscoNexusVSMsParams) SetKeyword(v string) {
 	return
 }
 
+// SetKeywordIfUnset sets keyword to v, unless it is already set
+func (p *ListCiscoNexusVSMsParams) SetKeywordIfUnset(v string) {
+	if _, found := p.p["keyword"]; !found {
+		p.SetKeyword(v)
+	}
+}
+
 func (p *ListCiscoNexusVSMsParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -732,6 +1382,13 @@ func (p *ListCiscoNexusVSMsParams) SetPage(v int) {
 	return
 }
 
+// SetPageIfUnset sets page to v, unless it is already set
+func (p *ListCiscoNexusVSMsParams) SetPageIfUnset(v int) {
+	if _, found := p.p["page"]; !found {
+		p.SetPage(v)
+	}
+}
+
 func (p *ListCiscoNexusVSMsParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -740,6 +1397,13 @@ func (p *ListCiscoNexusVSMsParams) SetPagesize(v int) {
 	return
 }
 
+// SetPagesizeIfUnset sets pagesize to v, unless it is already set
+func (p *ListCiscoNexusVSMsParams) SetPagesizeIfUnset(v int) {
+	if _, found := p.p["pagesize"]; !found {
+		p.SetPagesize(v)
+	}
+}
+
 func (p *ListCiscoNexusVSMsParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -748,17 +1412,43 @@ func (p *ListCiscoNexusVSMsParams) SetZoneid(v string) {
 	return
 }
 
+// SetZoneidIfUnset sets zoneid to v, unless it is already set
+func (p *ListCiscoNexusVSMsParams) SetZoneidIfUnset(v string) {
+	if _, found := p.p["zoneid"]; !found {
+		p.SetZoneid(v)
+	}
+}
+
 // You should always use this function to get a new ListCiscoNexusVSMsParams instance,
-// as then you are sure you have configured all required params
-func (s *ExternalDeviceService) NewListCiscoNexusVSMsParams() *ListCiscoNexusVSMsParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *ExternalDeviceService) NewListCiscoNexusVSMsParams(opts ...func(*ListCiscoNexusVSMsParams)) *ListCiscoNexusVSMsParams {
 	p := &ListCiscoNexusVSMsParams{}
 	p.p = make(map[string]interface{})
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Retrieves a Cisco Nexus 1000v Virtual Switch Manager device associated with a Cluster
 func (s *ExternalDeviceService) ListCiscoNexusVSMs(p *ListCiscoNexusVSMsParams) (*ListCiscoNexusVSMsResponse, error) {
-	resp, err := s.cs.newRequest("listCiscoNexusVSMs", p.toURLValues())
+	return s.ListCiscoNexusVSMsWithContext(context.Background(), p)
+}
+
+// ListCiscoNexusVSMsWithContext is the same as ListCiscoNexusVSMs, but the request is cancelled when the context is done
+func (s *ExternalDeviceService) ListCiscoNexusVSMsWithContext(ctx context.Context, p *ListCiscoNexusVSMsParams) (*ListCiscoNexusVSMsResponse, error) {
+	return s.ListCiscoNexusVSMsRawWithContext(ctx, p.toURLValues())
+}
+
+// ListCiscoNexusVSMsRaw is the same as ListCiscoNexusVSMs, but takes the params as url.Values instead of typed params
+func (s *ExternalDeviceService) ListCiscoNexusVSMsRaw(v url.Values) (*ListCiscoNexusVSMsResponse, error) {
+	return s.ListCiscoNexusVSMsRawWithContext(context.Background(), v)
+}
+
+// ListCiscoNexusVSMsRawWithContext is the same as ListCiscoNexusVSMsRaw, but the request is cancelled when the context is done
+func (s *ExternalDeviceService) ListCiscoNexusVSMsRawWithContext(ctx context.Context, v url.Values) (*ListCiscoNexusVSMsResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "listCiscoNexusVSMs", "listcisconexusvsmsresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -771,6 +1461,93 @@ func (s *ExternalDeviceService) ListCiscoNexusVSMs(p *ListCiscoNexusVSMsParams)
 	return &r, nil
 }
 
+// StreamCiscoNexusVSMs fetches the results of ListCiscoNexusVSMs page by page in the background and sends them on the
+// returned channel. Both returned channels are closed when done. If fetching a page fails, or the context
+// is done before all results are sent, the error is sent on the error channel and fetching stops. A
+// request for a page in flight is cancelled when the context is done. The page and pagesize set in the
+// params are ignored.
+func (s *ExternalDeviceService) StreamCiscoNexusVSMs(ctx context.Context, p *ListCiscoNexusVSMsParams) (<-chan *CiscoNexusVSM, <-chan error) {
+	ch := make(chan *CiscoNexusVSM)
+	errs := make(chan error, 1)
+
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesize(500)
+
+	go func() {
+		defer close(errs)
+		defer close(ch)
+
+		for page, count := 1, 0; ; page++ {
+			pp.SetPage(page)
+
+			l, err := s.ListCiscoNexusVSMsWithContext(ctx, pp)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			for _, v := range l.CiscoNexusVSMs {
+				select {
+				case ch <- v:
+				case <-ctx.Done():
+					errs <- ctx.Err()
+					return
+				}
+			}
+
+			count += len(l.CiscoNexusVSMs)
+			if len(l.CiscoNexusVSMs) < 500 || count >= l.Count {
+				return
+			}
+		}
+	}()
+
+	return ch, errs
+}
+
+// ListCiscoNexusVSMsWhere returns all results of ListCiscoNexusVSMs for which pred returns true. Note that the results are
+// filtered client-side, so all results matching the params are fetched first.
+// All pages are fetched, so the page and pagesize set in the params are ignored.
+func (s *ExternalDeviceService) ListCiscoNexusVSMsWhere(p *ListCiscoNexusVSMsParams, pred func(*CiscoNexusVSM) bool) ([]*CiscoNexusVSM, error) {
+	var r []*CiscoNexusVSM
+	ch, errs := s.StreamCiscoNexusVSMs(context.Background(), p)
+	for v := range ch {
+		if pred(v) {
+			r = append(r, v)
+		}
+	}
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ListAllCiscoNexusVSMs returns the results of ListCiscoNexusVSMs across all pages, by calling ListCiscoNexusVSMs page by page until all
+// results are fetched. The pagesize set in the params is used for every page (500 if not set), and the
+// page set in the params is ignored.
+func (s *ExternalDeviceService) ListAllCiscoNexusVSMs(p *ListCiscoNexusVSMsParams) ([]*CiscoNexusVSM, error) {
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesizeIfUnset(500)
+	pagesize := pp.p["pagesize"].(int)
+
+	var r []*CiscoNexusVSM
+	for page := 1; ; page++ {
+		pp.SetPage(page)
+
+		l, err := s.ListCiscoNexusVSMs(pp)
+		if err != nil {
+			return nil, err
+		}
+		r = append(r, l.CiscoNexusVSMs...)
+
+		if len(l.CiscoNexusVSMs) < pagesize || len(r) >= l.Count {
+			return r, nil
+		}
+	}
+}
+
 type ListCiscoNexusVSMsResponse struct {
 	Count          int              `json:"count"`
 	CiscoNexusVSMs []*CiscoNexusVSM `json:"cisconexusvsm"`
@@ -794,6 +1571,16 @@ type ListCiscoVnmcResourcesParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ListCiscoVnmcResourcesParams) Clone() *ListCiscoVnmcResourcesParams {
+	c := &ListCiscoVnmcResourcesParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListCiscoVnmcResourcesParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -827,6 +1614,13 @@ func (p *ListCiscoVnmcResourcesParams) SetKeyword(v string) {
 	return
 }
 
+// SetKeywordIfUnset sets keyword to v, unless it is already set
+func (p *ListCiscoVnmcResourcesParams) SetKeywordIfUnset(v string) {
+	if _, found := p.p["keyword"]; !found {
+		p.SetKeyword(v)
+	}
+}
+
 func (p *ListCiscoVnmcResourcesParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -835,6 +1629,13 @@ func (p *ListCiscoVnmcResourcesParams) SetPage(v int) {
 	return
 }
 
+// SetPageIfUnset sets page to v, unless it is already set
+func (p *ListCiscoVnmcResourcesParams) SetPageIfUnset(v int) {
+	if _, found := p.p["page"]; !found {
+		p.SetPage(v)
+	}
+}
+
 func (p *ListCiscoVnmcResourcesParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -843,6 +1644,13 @@ func (p *ListCiscoVnmcResourcesParams) SetPagesize(v int) {
 	return
 }
 
+// SetPagesizeIfUnset sets pagesize to v, unless it is already set
+func (p *ListCiscoVnmcResourcesParams) SetPagesizeIfUnset(v int) {
+	if _, found := p.p["pagesize"]; !found {
+		p.SetPagesize(v)
+	}
+}
+
 func (p *ListCiscoVnmcResourcesParams) SetPhysicalnetworkid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -851,6 +1659,13 @@ func (p *ListCiscoVnmcResourcesParams) SetPhysicalnetworkid(v string) {
 	return
 }
 
+// SetPhysicalnetworkidIfUnset sets physicalnetworkid to v, unless it is already set
+func (p *ListCiscoVnmcResourcesParams) SetPhysicalnetworkidIfUnset(v string) {
+	if _, found := p.p["physicalnetworkid"]; !found {
+		p.SetPhysicalnetworkid(v)
+	}
+}
+
 func (p *ListCiscoVnmcResourcesParams) SetResourceid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -859,17 +1674,43 @@ func (p *ListCiscoVnmcResourcesParams) SetResourceid(v string) {
 	return
 }
 
+// SetResourceidIfUnset sets resourceid to v, unless it is already set
+func (p *ListCiscoVnmcResourcesParams) SetResourceidIfUnset(v string) {
+	if _, found := p.p["resourceid"]; !found {
+		p.SetResourceid(v)
+	}
+}
+
 // You should always use this function to get a new ListCiscoVnmcResourcesParams instance,
-// as then you are sure you have configured all required params
-func (s *ExternalDeviceService) NewListCiscoVnmcResourcesParams() *ListCiscoVnmcResourcesParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *ExternalDeviceService) NewListCiscoVnmcResourcesParams(opts ...func(*ListCiscoVnmcResourcesParams)) *ListCiscoVnmcResourcesParams {
 	p := &ListCiscoVnmcResourcesParams{}
 	p.p = make(map[string]interface{})
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Lists Cisco VNMC controllers
 func (s *ExternalDeviceService) ListCiscoVnmcResources(p *ListCiscoVnmcResourcesParams) (*ListCiscoVnmcResourcesResponse, error) {
-	resp, err := s.cs.newRequest("listCiscoVnmcResources", p.toURLValues())
+	return s.ListCiscoVnmcResourcesWithContext(context.Background(), p)
+}
+
+// ListCiscoVnmcResourcesWithContext is the same as ListCiscoVnmcResources, but the request is cancelled when the context is done
+func (s *ExternalDeviceService) ListCiscoVnmcResourcesWithContext(ctx context.Context, p *ListCiscoVnmcResourcesParams) (*ListCiscoVnmcResourcesResponse, error) {
+	return s.ListCiscoVnmcResourcesRawWithContext(ctx, p.toURLValues())
+}
+
+// ListCiscoVnmcResourcesRaw is the same as ListCiscoVnmcResources, but takes the params as url.Values instead of typed params
+func (s *ExternalDeviceService) ListCiscoVnmcResourcesRaw(v url.Values) (*ListCiscoVnmcResourcesResponse, error) {
+	return s.ListCiscoVnmcResourcesRawWithContext(context.Background(), v)
+}
+
+// ListCiscoVnmcResourcesRawWithContext is the same as ListCiscoVnmcResourcesRaw, but the request is cancelled when the context is done
+func (s *ExternalDeviceService) ListCiscoVnmcResourcesRawWithContext(ctx context.Context, v url.Values) (*ListCiscoVnmcResourcesResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "listCiscoVnmcResources", "listciscovnmcresourcesresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -882,6 +1723,93 @@ func (s *ExternalDeviceService) ListCiscoVnmcResources(p *ListCiscoVnmcResources
 	return &r, nil
 }
 
+// StreamCiscoVnmcResources fetches the results of ListCiscoVnmcResources page by page in the background and sends them on the
+// returned channel. Both returned channels are closed when done. If fetching a page fails, or the context
+// is done before all results are sent, the error is sent on the error channel and fetching stops. A
+// request for a page in flight is cancelled when the context is done. The page and pagesize set in the
+// params are ignored.
+func (s *ExternalDeviceService) StreamCiscoVnmcResources(ctx context.Context, p *ListCiscoVnmcResourcesParams) (<-chan *CiscoVnmcResource, <-chan error) {
+	ch := make(chan *CiscoVnmcResource)
+	errs := make(chan error, 1)
+
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesize(500)
+
+	go func() {
+		defer close(errs)
+		defer close(ch)
+
+		for page, count := 1, 0; ; page++ {
+			pp.SetPage(page)
+
+			l, err := s.ListCiscoVnmcResourcesWithContext(ctx, pp)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			for _, v := range l.CiscoVnmcResources {
+				select {
+				case ch <- v:
+				case <-ctx.Done():
+					errs <- ctx.Err()
+					return
+				}
+			}
+
+			count += len(l.CiscoVnmcResources)
+			if len(l.CiscoVnmcResources) < 500 || count >= l.Count {
+				return
+			}
+		}
+	}()
+
+	return ch, errs
+}
+
+// ListCiscoVnmcResourcesWhere returns all results of ListCiscoVnmcResources for which pred returns true. Note that the results are
+// filtered client-side, so all results matching the params are fetched first.
+// All pages are fetched, so the page and pagesize set in the params are ignored.
+func (s *ExternalDeviceService) ListCiscoVnmcResourcesWhere(p *ListCiscoVnmcResourcesParams, pred func(*CiscoVnmcResource) bool) ([]*CiscoVnmcResource, error) {
+	var r []*CiscoVnmcResource
+	ch, errs := s.StreamCiscoVnmcResources(context.Background(), p)
+	for v := range ch {
+		if pred(v) {
+			r = append(r, v)
+		}
+	}
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ListAllCiscoVnmcResources returns the results of ListCiscoVnmcResources across all pages, by calling ListCiscoVnmcResources page by page until all
+// results are fetched. The pagesize set in the params is used for every page (500 if not set), and the
+// page set in the params is ignored.
+func (s *ExternalDeviceService) ListAllCiscoVnmcResources(p *ListCiscoVnmcResourcesParams) ([]*CiscoVnmcResource, error) {
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesizeIfUnset(500)
+	pagesize := pp.p["pagesize"].(int)
+
+	var r []*CiscoVnmcResource
+	for page := 1; ; page++ {
+		pp.SetPage(page)
+
+		l, err := s.ListCiscoVnmcResources(pp)
+		if err != nil {
+			return nil, err
+		}
+		r = append(r, l.CiscoVnmcResources...)
+
+		if len(l.CiscoVnmcResources) < pagesize || len(r) >= l.Count {
+			return r, nil
+		}
+	}
+}
+
 type ListCiscoVnmcResourcesResponse struct {
 	Count              int                  `json:"count"`
 	CiscoVnmcResources []*CiscoVnmcResource `json:"ciscovnmcresource"`
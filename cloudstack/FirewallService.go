@@ -17,11 +17,14 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/url"
 	"strconv"
 	"strings"
+	"time"
 )
 
 // Helper function for maintaining backwards compatibility
@@ -78,6 +81,16 @@ type AddPaloAltoFirewallParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *AddPaloAltoFirewallParams) Clone() *AddPaloAltoFirewallParams {
+	c := &AddPaloAltoFirewallParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *AddPaloAltoFirewallParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -101,6 +114,16 @@ func (p *AddPaloAltoFirewallParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *AddPaloAltoFirewallParams) validate() error {
+	for _, k := range []string{"networkdevicetype", "password", "physicalnetworkid", "url", "username"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of addPaloAltoFirewall", k)
+		}
+	}
+	return nil
+}
+
 func (p *AddPaloAltoFirewallParams) SetNetworkdevicetype(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -109,6 +132,13 @@ func (p *AddPaloAltoFirewallParams) SetNetworkdevicetype(v string) {
 	return
 }
 
+// SetNetworkdevicetypeIfUnset sets networkdevicetype to v, unless it is already set
+func (p *AddPaloAltoFirewallParams) SetNetworkdevicetypeIfUnset(v string) {
+	if _, found := p.p["networkdevicetype"]; !found {
+		p.SetNetworkdevicetype(v)
+	}
+}
+
 func (p *AddPaloAltoFirewallParams) SetPassword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -117,6 +147,13 @@ func (p *AddPaloAltoFirewallParams) SetPassword(v string) {
 	return
 }
 
+// SetPasswordIfUnset sets password to v, unless it is already set
+func (p *AddPaloAltoFirewallParams) SetPasswordIfUnset(v string) {
+	if _, found := p.p["password"]; !found {
+		p.SetPassword(v)
+	}
+}
+
 func (p *AddPaloAltoFirewallParams) SetPhysicalnetworkid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -125,6 +162,13 @@ func (p *AddPaloAltoFirewallParams) SetPhysicalnetworkid(v string) {
 	return
 }
 
+// SetPhysicalnetworkidIfUnset sets physicalnetworkid to v, unless it is already set
+func (p *AddPaloAltoFirewallParams) SetPhysicalnetworkidIfUnset(v string) {
+	if _, found := p.p["physicalnetworkid"]; !found {
+		p.SetPhysicalnetworkid(v)
+	}
+}
+
 func (p *AddPaloAltoFirewallParams) SetUrl(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -133,6 +177,13 @@ func (p *AddPaloAltoFirewallParams) SetUrl(v string) {
 	return
 }
 
+// SetUrlIfUnset sets url to v, unless it is already set
+func (p *AddPaloAltoFirewallParams) SetUrlIfUnset(v string) {
+	if _, found := p.p["url"]; !found {
+		p.SetUrl(v)
+	}
+}
+
 func (p *AddPaloAltoFirewallParams) SetUsername(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -141,9 +192,17 @@ func (p *AddPaloAltoFirewallParams) SetUsername(v string) {
 	return
 }
 
+// SetUsernameIfUnset sets username to v, unless it is already set
+func (p *AddPaloAltoFirewallParams) SetUsernameIfUnset(v string) {
+	if _, found := p.p["username"]; !found {
+		p.SetUsername(v)
+	}
+}
+
 // You should always use this function to get a new AddPaloAltoFirewallParams instance,
-// as then you are sure you have configured all required params
-func (s *FirewallService) NewAddPaloAltoFirewallParams(networkdevicetype string, password string, physicalnetworkid string, url string, username string) *AddPaloAltoFirewallParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *FirewallService) NewAddPaloAltoFirewallParams(networkdevicetype string, password string, physicalnetworkid string, url string, username string, opts ...func(*AddPaloAltoFirewallParams)) *AddPaloAltoFirewallParams {
 	p := &AddPaloAltoFirewallParams{}
 	p.p = make(map[string]interface{})
 	p.p["networkdevicetype"] = networkdevicetype
@@ -151,12 +210,57 @@ func (s *FirewallService) NewAddPaloAltoFirewallParams(networkdevicetype string,
 	p.p["physicalnetworkid"] = physicalnetworkid
 	p.p["url"] = url
 	p.p["username"] = username
+	for _, fn := range opts {
+		fn(p)
+	}
+	return p
+}
+
+// AddPaloAltoFirewallOpts contains all params that can be set on a AddPaloAltoFirewallParams.
+// Optional params are pointers (or slices and maps) which are only set when not nil.
+type AddPaloAltoFirewallOpts struct {
+	Networkdevicetype string
+	Password          string
+	Physicalnetworkid string
+	Url               string
+	Username          string
+}
+
+// NewAddPaloAltoFirewallParamsFromOpts is an alternative for NewAddPaloAltoFirewallParams which takes all params using
+// an options struct, as that is easier to read for APIs with many required params
+func (s *FirewallService) NewAddPaloAltoFirewallParamsFromOpts(o AddPaloAltoFirewallOpts) *AddPaloAltoFirewallParams {
+	p := s.NewAddPaloAltoFirewallParams(o.Networkdevicetype, o.Password, o.Physicalnetworkid, o.Url, o.Username)
 	return p
 }
 
 // Adds a Palo Alto firewall device
-func (s *FirewallService) AddPaloAltoFirewall(p *AddPaloAltoFirewallParams) (*AddPaloAltoFirewallResponse, error) {
-	resp, err := s.cs.newRequest("addPaloAltoFirewall", p.toURLValues())
+func (s *FirewallService) AddPaloAltoFirewall(p *AddPaloAltoFirewallParams, opts ...OptionFunc) (*AddPaloAltoFirewallResponse, error) {
+	return s.AddPaloAltoFirewallWithContext(context.Background(), p, opts...)
+}
+
+// AddPaloAltoFirewallWithContext is the same as AddPaloAltoFirewall, but the request is cancelled when the context is done
+func (s *FirewallService) AddPaloAltoFirewallWithContext(ctx context.Context, p *AddPaloAltoFirewallParams, opts ...OptionFunc) (*AddPaloAltoFirewallResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.AddPaloAltoFirewallRawWithContext(ctx, p.toURLValues())
+}
+
+// AddPaloAltoFirewallRaw is the same as AddPaloAltoFirewall, but takes the params as url.Values instead of typed params
+func (s *FirewallService) AddPaloAltoFirewallRaw(v url.Values) (*AddPaloAltoFirewallResponse, error) {
+	return s.AddPaloAltoFirewallRawWithContext(context.Background(), v)
+}
+
+// AddPaloAltoFirewallRawWithContext is the same as AddPaloAltoFirewallRaw, but the request is cancelled when the context is done
+func (s *FirewallService) AddPaloAltoFirewallRawWithContext(ctx context.Context, v url.Values) (*AddPaloAltoFirewallResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "addPaloAltoFirewall", "addpaloaltofirewallresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -168,9 +272,9 @@ func (s *FirewallService) AddPaloAltoFirewall(p *AddPaloAltoFirewallParams) (*Ad
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -218,6 +322,16 @@ type AddSrxFirewallParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *AddSrxFirewallParams) Clone() *AddSrxFirewallParams {
+	c := &AddSrxFirewallParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *AddSrxFirewallParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -241,6 +355,16 @@ func (p *AddSrxFirewallParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *AddSrxFirewallParams) validate() error {
+	for _, k := range []string{"networkdevicetype", "password", "physicalnetworkid", "url", "username"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of addSrxFirewall", k)
+		}
+	}
+	return nil
+}
+
 func (p *AddSrxFirewallParams) SetNetworkdevicetype(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -249,6 +373,13 @@ func (p *AddSrxFirewallParams) SetNetworkdevicetype(v string) {
 	return
 }
 
+// SetNetworkdevicetypeIfUnset sets networkdevicetype to v, unless it is already set
+func (p *AddSrxFirewallParams) SetNetworkdevicetypeIfUnset(v string) {
+	if _, found := p.p["networkdevicetype"]; !found {
+		p.SetNetworkdevicetype(v)
+	}
+}
+
 func (p *AddSrxFirewallParams) SetPassword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -257,6 +388,13 @@ func (p *AddSrxFirewallParams) SetPassword(v string) {
 	return
 }
 
+// SetPasswordIfUnset sets password to v, unless it is already set
+func (p *AddSrxFirewallParams) SetPasswordIfUnset(v string) {
+	if _, found := p.p["password"]; !found {
+		p.SetPassword(v)
+	}
+}
+
 func (p *AddSrxFirewallParams) SetPhysicalnetworkid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -265,6 +403,13 @@ func (p *AddSrxFirewallParams) SetPhysicalnetworkid(v string) {
 	return
 }
 
+// SetPhysicalnetworkidIfUnset sets physicalnetworkid to v, unless it is already set
+func (p *AddSrxFirewallParams) SetPhysicalnetworkidIfUnset(v string) {
+	if _, found := p.p["physicalnetworkid"]; !found {
+		p.SetPhysicalnetworkid(v)
+	}
+}
+
 func (p *AddSrxFirewallParams) SetUrl(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -273,6 +418,13 @@ func (p *AddSrxFirewallParams) SetUrl(v string) {
 	return
 }
 
+// SetUrlIfUnset sets url to v, unless it is already set
+func (p *AddSrxFirewallParams) SetUrlIfUnset(v string) {
+	if _, found := p.p["url"]; !found {
+		p.SetUrl(v)
+	}
+}
+
 func (p *AddSrxFirewallParams) SetUsername(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -281,9 +433,17 @@ func (p *AddSrxFirewallParams) SetUsername(v string) {
 	return
 }
 
+// SetUsernameIfUnset sets username to v, unless it is already set
+func (p *AddSrxFirewallParams) SetUsernameIfUnset(v string) {
+	if _, found := p.p["username"]; !found {
+		p.SetUsername(v)
+	}
+}
+
 // You should always use this function to get a new AddSrxFirewallParams instance,
-// as then you are sure you have configured all required params
-func (s *FirewallService) NewAddSrxFirewallParams(networkdevicetype string, password string, physicalnetworkid string, url string, username string) *AddSrxFirewallParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *FirewallService) NewAddSrxFirewallParams(networkdevicetype string, password string, physicalnetworkid string, url string, username string, opts ...func(*AddSrxFirewallParams)) *AddSrxFirewallParams {
 	p := &AddSrxFirewallParams{}
 	p.p = make(map[string]interface{})
 	p.p["networkdevicetype"] = networkdevicetype
@@ -291,12 +451,57 @@ func (s *FirewallService) NewAddSrxFirewallParams(networkdevicetype string, pass
 	p.p["physicalnetworkid"] = physicalnetworkid
 	p.p["url"] = url
 	p.p["username"] = username
+	for _, fn := range opts {
+		fn(p)
+	}
+	return p
+}
+
+// AddSrxFirewallOpts contains all params that can be set on a AddSrxFirewallParams.
+// Optional params are pointers (or slices and maps) which are only set when not nil.
+type AddSrxFirewallOpts struct {
+	Networkdevicetype string
+	Password          string
+	Physicalnetworkid string
+	Url               string
+	Username          string
+}
+
+// NewAddSrxFirewallParamsFromOpts is an alternative for NewAddSrxFirewallParams which takes all params using
+// an options struct, as that is easier to read for APIs with many required params
+func (s *FirewallService) NewAddSrxFirewallParamsFromOpts(o AddSrxFirewallOpts) *AddSrxFirewallParams {
+	p := s.NewAddSrxFirewallParams(o.Networkdevicetype, o.Password, o.Physicalnetworkid, o.Url, o.Username)
 	return p
 }
 
 // Adds a SRX firewall device
-func (s *FirewallService) AddSrxFirewall(p *AddSrxFirewallParams) (*AddSrxFirewallResponse, error) {
-	resp, err := s.cs.newRequest("addSrxFirewall", p.toURLValues())
+func (s *FirewallService) AddSrxFirewall(p *AddSrxFirewallParams, opts ...OptionFunc) (*AddSrxFirewallResponse, error) {
+	return s.AddSrxFirewallWithContext(context.Background(), p, opts...)
+}
+
+// AddSrxFirewallWithContext is the same as AddSrxFirewall, but the request is cancelled when the context is done
+func (s *FirewallService) AddSrxFirewallWithContext(ctx context.Context, p *AddSrxFirewallParams, opts ...OptionFunc) (*AddSrxFirewallResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.AddSrxFirewallRawWithContext(ctx, p.toURLValues())
+}
+
+// AddSrxFirewallRaw is the same as AddSrxFirewall, but takes the params as url.Values instead of typed params
+func (s *FirewallService) AddSrxFirewallRaw(v url.Values) (*AddSrxFirewallResponse, error) {
+	return s.AddSrxFirewallRawWithContext(context.Background(), v)
+}
+
+// AddSrxFirewallRawWithContext is the same as AddSrxFirewallRaw, but the request is cancelled when the context is done
+func (s *FirewallService) AddSrxFirewallRawWithContext(ctx context.Context, v url.Values) (*AddSrxFirewallResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "addSrxFirewall", "addsrxfirewallresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -308,9 +513,9 @@ func (s *FirewallService) AddSrxFirewall(p *AddSrxFirewallParams) (*AddSrxFirewa
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -358,6 +563,16 @@ type ConfigurePaloAltoFirewallParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ConfigurePaloAltoFirewallParams) Clone() *ConfigurePaloAltoFirewallParams {
+	c := &ConfigurePaloAltoFirewallParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ConfigurePaloAltoFirewallParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -373,6 +588,16 @@ func (p *ConfigurePaloAltoFirewallParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *ConfigurePaloAltoFirewallParams) validate() error {
+	for _, k := range []string{"fwdeviceid"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of configurePaloAltoFirewall", k)
+		}
+	}
+	return nil
+}
+
 func (p *ConfigurePaloAltoFirewallParams) SetFwdevicecapacity(v int64) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -381,6 +606,13 @@ func (p *ConfigurePaloAltoFirewallParams) SetFwdevicecapacity(v int64) {
 	return
 }
 
+// SetFwdevicecapacityIfUnset sets fwdevicecapacity to v, unless it is already set
+func (p *ConfigurePaloAltoFirewallParams) SetFwdevicecapacityIfUnset(v int64) {
+	if _, found := p.p["fwdevicecapacity"]; !found {
+		p.SetFwdevicecapacity(v)
+	}
+}
+
 func (p *ConfigurePaloAltoFirewallParams) SetFwdeviceid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -389,18 +621,54 @@ func (p *ConfigurePaloAltoFirewallParams) SetFwdeviceid(v string) {
 	return
 }
 
+// SetFwdeviceidIfUnset sets fwdeviceid to v, unless it is already set
+func (p *ConfigurePaloAltoFirewallParams) SetFwdeviceidIfUnset(v string) {
+	if _, found := p.p["fwdeviceid"]; !found {
+		p.SetFwdeviceid(v)
+	}
+}
+
 // You should always use this function to get a new ConfigurePaloAltoFirewallParams instance,
-// as then you are sure you have configured all required params
-func (s *FirewallService) NewConfigurePaloAltoFirewallParams(fwdeviceid string) *ConfigurePaloAltoFirewallParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *FirewallService) NewConfigurePaloAltoFirewallParams(fwdeviceid string, opts ...func(*ConfigurePaloAltoFirewallParams)) *ConfigurePaloAltoFirewallParams {
 	p := &ConfigurePaloAltoFirewallParams{}
 	p.p = make(map[string]interface{})
 	p.p["fwdeviceid"] = fwdeviceid
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Configures a Palo Alto firewall device
-func (s *FirewallService) ConfigurePaloAltoFirewall(p *ConfigurePaloAltoFirewallParams) (*PaloAltoFirewallResponse, error) {
-	resp, err := s.cs.newRequest("configurePaloAltoFirewall", p.toURLValues())
+func (s *FirewallService) ConfigurePaloAltoFirewall(p *ConfigurePaloAltoFirewallParams, opts ...OptionFunc) (*PaloAltoFirewallResponse, error) {
+	return s.ConfigurePaloAltoFirewallWithContext(context.Background(), p, opts...)
+}
+
+// ConfigurePaloAltoFirewallWithContext is the same as ConfigurePaloAltoFirewall, but the request is cancelled when the context is done
+func (s *FirewallService) ConfigurePaloAltoFirewallWithContext(ctx context.Context, p *ConfigurePaloAltoFirewallParams, opts ...OptionFunc) (*PaloAltoFirewallResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.ConfigurePaloAltoFirewallRawWithContext(ctx, p.toURLValues())
+}
+
+// ConfigurePaloAltoFirewallRaw is the same as ConfigurePaloAltoFirewall, but takes the params as url.Values instead of typed params
+func (s *FirewallService) ConfigurePaloAltoFirewallRaw(v url.Values) (*PaloAltoFirewallResponse, error) {
+	return s.ConfigurePaloAltoFirewallRawWithContext(context.Background(), v)
+}
+
+// ConfigurePaloAltoFirewallRawWithContext is the same as ConfigurePaloAltoFirewallRaw, but the request is cancelled when the context is done
+func (s *FirewallService) ConfigurePaloAltoFirewallRawWithContext(ctx context.Context, v url.Values) (*PaloAltoFirewallResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "configurePaloAltoFirewall", "configurepaloaltofirewallresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -412,9 +680,9 @@ func (s *FirewallService) ConfigurePaloAltoFirewall(p *ConfigurePaloAltoFirewall
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -462,6 +730,16 @@ type ConfigureSrxFirewallParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ConfigureSrxFirewallParams) Clone() *ConfigureSrxFirewallParams {
+	c := &ConfigureSrxFirewallParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ConfigureSrxFirewallParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -477,6 +755,16 @@ func (p *ConfigureSrxFirewallParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *ConfigureSrxFirewallParams) validate() error {
+	for _, k := range []string{"fwdeviceid"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of configureSrxFirewall", k)
+		}
+	}
+	return nil
+}
+
 func (p *ConfigureSrxFirewallParams) SetFwdevicecapacity(v int64) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -485,6 +773,13 @@ func (p *ConfigureSrxFirewallParams) SetFwdevicecapacity(v int64) {
 	return
 }
 
+// SetFwdevicecapacityIfUnset sets fwdevicecapacity to v, unless it is already set
+func (p *ConfigureSrxFirewallParams) SetFwdevicecapacityIfUnset(v int64) {
+	if _, found := p.p["fwdevicecapacity"]; !found {
+		p.SetFwdevicecapacity(v)
+	}
+}
+
 func (p *ConfigureSrxFirewallParams) SetFwdeviceid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -493,18 +788,54 @@ func (p *ConfigureSrxFirewallParams) SetFwdeviceid(v string) {
 	return
 }
 
+// SetFwdeviceidIfUnset sets fwdeviceid to v, unless it is already set
+func (p *ConfigureSrxFirewallParams) SetFwdeviceidIfUnset(v string) {
+	if _, found := p.p["fwdeviceid"]; !found {
+		p.SetFwdeviceid(v)
+	}
+}
+
 // You should always use this function to get a new ConfigureSrxFirewallParams instance,
-// as then you are sure you have configured all required params
-func (s *FirewallService) NewConfigureSrxFirewallParams(fwdeviceid string) *ConfigureSrxFirewallParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *FirewallService) NewConfigureSrxFirewallParams(fwdeviceid string, opts ...func(*ConfigureSrxFirewallParams)) *ConfigureSrxFirewallParams {
 	p := &ConfigureSrxFirewallParams{}
 	p.p = make(map[string]interface{})
 	p.p["fwdeviceid"] = fwdeviceid
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Configures a SRX firewall device
-func (s *FirewallService) ConfigureSrxFirewall(p *ConfigureSrxFirewallParams) (*SrxFirewallResponse, error) {
-	resp, err := s.cs.newRequest("configureSrxFirewall", p.toURLValues())
+func (s *FirewallService) ConfigureSrxFirewall(p *ConfigureSrxFirewallParams, opts ...OptionFunc) (*SrxFirewallResponse, error) {
+	return s.ConfigureSrxFirewallWithContext(context.Background(), p, opts...)
+}
+
+// ConfigureSrxFirewallWithContext is the same as ConfigureSrxFirewall, but the request is cancelled when the context is done
+func (s *FirewallService) ConfigureSrxFirewallWithContext(ctx context.Context, p *ConfigureSrxFirewallParams, opts ...OptionFunc) (*SrxFirewallResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.ConfigureSrxFirewallRawWithContext(ctx, p.toURLValues())
+}
+
+// ConfigureSrxFirewallRaw is the same as ConfigureSrxFirewall, but takes the params as url.Values instead of typed params
+func (s *FirewallService) ConfigureSrxFirewallRaw(v url.Values) (*SrxFirewallResponse, error) {
+	return s.ConfigureSrxFirewallRawWithContext(context.Background(), v)
+}
+
+// ConfigureSrxFirewallRawWithContext is the same as ConfigureSrxFirewallRaw, but the request is cancelled when the context is done
+func (s *FirewallService) ConfigureSrxFirewallRawWithContext(ctx context.Context, v url.Values) (*SrxFirewallResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "configureSrxFirewall", "configuresrxfirewallresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -516,9 +847,9 @@ func (s *FirewallService) ConfigureSrxFirewall(p *ConfigureSrxFirewallParams) (*
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -566,6 +897,16 @@ type CreateEgressFirewallRuleParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *CreateEgressFirewallRuleParams) Clone() *CreateEgressFirewallRuleParams {
+	c := &CreateEgressFirewallRuleParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *CreateEgressFirewallRuleParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -607,6 +948,16 @@ func (p *CreateEgressFirewallRuleParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *CreateEgressFirewallRuleParams) validate() error {
+	for _, k := range []string{"networkid", "protocol"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of createEgressFirewallRule", k)
+		}
+	}
+	return nil
+}
+
 func (p *CreateEgressFirewallRuleParams) SetCidrlist(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -615,6 +966,18 @@ func (p *CreateEgressFirewallRuleParams) SetCidrlist(v []string) {
 	return
 }
 
+// SetCidrlistIfUnset sets cidrlist to v, unless it is already set
+func (p *CreateEgressFirewallRuleParams) SetCidrlistIfUnset(v []string) {
+	if _, found := p.p["cidrlist"]; !found {
+		p.SetCidrlist(v)
+	}
+}
+
+// SetCidrlistCSV splits v on commas and sets the resulting values
+func (p *CreateEgressFirewallRuleParams) SetCidrlistCSV(v string) {
+	p.SetCidrlist(splitCSV(v))
+}
+
 func (p *CreateEgressFirewallRuleParams) SetEndport(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -623,6 +986,13 @@ func (p *CreateEgressFirewallRuleParams) SetEndport(v int) {
 	return
 }
 
+// SetEndportIfUnset sets endport to v, unless it is already set
+func (p *CreateEgressFirewallRuleParams) SetEndportIfUnset(v int) {
+	if _, found := p.p["endport"]; !found {
+		p.SetEndport(v)
+	}
+}
+
 func (p *CreateEgressFirewallRuleParams) SetFordisplay(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -631,6 +1001,13 @@ func (p *CreateEgressFirewallRuleParams) SetFordisplay(v bool) {
 	return
 }
 
+// SetFordisplayIfUnset sets fordisplay to v, unless it is already set
+func (p *CreateEgressFirewallRuleParams) SetFordisplayIfUnset(v bool) {
+	if _, found := p.p["fordisplay"]; !found {
+		p.SetFordisplay(v)
+	}
+}
+
 func (p *CreateEgressFirewallRuleParams) SetIcmpcode(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -639,6 +1016,13 @@ func (p *CreateEgressFirewallRuleParams) SetIcmpcode(v int) {
 	return
 }
 
+// SetIcmpcodeIfUnset sets icmpcode to v, unless it is already set
+func (p *CreateEgressFirewallRuleParams) SetIcmpcodeIfUnset(v int) {
+	if _, found := p.p["icmpcode"]; !found {
+		p.SetIcmpcode(v)
+	}
+}
+
 func (p *CreateEgressFirewallRuleParams) SetIcmptype(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -647,6 +1031,13 @@ func (p *CreateEgressFirewallRuleParams) SetIcmptype(v int) {
 	return
 }
 
+// SetIcmptypeIfUnset sets icmptype to v, unless it is already set
+func (p *CreateEgressFirewallRuleParams) SetIcmptypeIfUnset(v int) {
+	if _, found := p.p["icmptype"]; !found {
+		p.SetIcmptype(v)
+	}
+}
+
 func (p *CreateEgressFirewallRuleParams) SetNetworkid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -655,14 +1046,28 @@ func (p *CreateEgressFirewallRuleParams) SetNetworkid(v string) {
 	return
 }
 
-func (p *CreateEgressFirewallRuleParams) SetProtocol(v string) {
+// SetNetworkidIfUnset sets networkid to v, unless it is already set
+func (p *CreateEgressFirewallRuleParams) SetNetworkidIfUnset(v string) {
+	if _, found := p.p["networkid"]; !found {
+		p.SetNetworkid(v)
+	}
+}
+
+func (p *CreateEgressFirewallRuleParams) SetProtocol(v Protocol) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	p.p["protocol"] = v
+	p.p["protocol"] = string(v)
 	return
 }
 
+// SetProtocolIfUnset sets protocol to v, unless it is already set
+func (p *CreateEgressFirewallRuleParams) SetProtocolIfUnset(v Protocol) {
+	if _, found := p.p["protocol"]; !found {
+		p.SetProtocol(v)
+	}
+}
+
 func (p *CreateEgressFirewallRuleParams) SetStartport(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -671,6 +1076,13 @@ func (p *CreateEgressFirewallRuleParams) SetStartport(v int) {
 	return
 }
 
+// SetStartportIfUnset sets startport to v, unless it is already set
+func (p *CreateEgressFirewallRuleParams) SetStartportIfUnset(v int) {
+	if _, found := p.p["startport"]; !found {
+		p.SetStartport(v)
+	}
+}
+
 func (p *CreateEgressFirewallRuleParams) SetType(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -679,19 +1091,55 @@ func (p *CreateEgressFirewallRuleParams) SetType(v string) {
 	return
 }
 
+// SetTypeIfUnset sets type to v, unless it is already set
+func (p *CreateEgressFirewallRuleParams) SetTypeIfUnset(v string) {
+	if _, found := p.p["type"]; !found {
+		p.SetType(v)
+	}
+}
+
 // You should always use this function to get a new CreateEgressFirewallRuleParams instance,
-// as then you are sure you have configured all required params
-func (s *FirewallService) NewCreateEgressFirewallRuleParams(networkid string, protocol string) *CreateEgressFirewallRuleParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *FirewallService) NewCreateEgressFirewallRuleParams(networkid string, protocol string, opts ...func(*CreateEgressFirewallRuleParams)) *CreateEgressFirewallRuleParams {
 	p := &CreateEgressFirewallRuleParams{}
 	p.p = make(map[string]interface{})
 	p.p["networkid"] = networkid
 	p.p["protocol"] = protocol
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Creates a egress firewall rule for a given network
-func (s *FirewallService) CreateEgressFirewallRule(p *CreateEgressFirewallRuleParams) (*CreateEgressFirewallRuleResponse, error) {
-	resp, err := s.cs.newRequest("createEgressFirewallRule", p.toURLValues())
+func (s *FirewallService) CreateEgressFirewallRule(p *CreateEgressFirewallRuleParams, opts ...OptionFunc) (*CreateEgressFirewallRuleResponse, error) {
+	return s.CreateEgressFirewallRuleWithContext(context.Background(), p, opts...)
+}
+
+// CreateEgressFirewallRuleWithContext is the same as CreateEgressFirewallRule, but the request is cancelled when the context is done
+func (s *FirewallService) CreateEgressFirewallRuleWithContext(ctx context.Context, p *CreateEgressFirewallRuleParams, opts ...OptionFunc) (*CreateEgressFirewallRuleResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.CreateEgressFirewallRuleRawWithContext(ctx, p.toURLValues())
+}
+
+// CreateEgressFirewallRuleRaw is the same as CreateEgressFirewallRule, but takes the params as url.Values instead of typed params
+func (s *FirewallService) CreateEgressFirewallRuleRaw(v url.Values) (*CreateEgressFirewallRuleResponse, error) {
+	return s.CreateEgressFirewallRuleRawWithContext(context.Background(), v)
+}
+
+// CreateEgressFirewallRuleRawWithContext is the same as CreateEgressFirewallRuleRaw, but the request is cancelled when the context is done
+func (s *FirewallService) CreateEgressFirewallRuleRawWithContext(ctx context.Context, v url.Values) (*CreateEgressFirewallRuleResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "createEgressFirewallRule", "createegressfirewallruleresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -703,9 +1151,9 @@ func (s *FirewallService) CreateEgressFirewallRule(p *CreateEgressFirewallRulePa
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -761,6 +1209,16 @@ type CreateFirewallRuleParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *CreateFirewallRuleParams) Clone() *CreateFirewallRuleParams {
+	c := &CreateFirewallRuleParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *CreateFirewallRuleParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -802,6 +1260,16 @@ func (p *CreateFirewallRuleParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *CreateFirewallRuleParams) validate() error {
+	for _, k := range []string{"ipaddressid", "protocol"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of createFirewallRule", k)
+		}
+	}
+	return nil
+}
+
 func (p *CreateFirewallRuleParams) SetCidrlist(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -810,6 +1278,18 @@ func (p *CreateFirewallRuleParams) SetCidrlist(v []string) {
 	return
 }
 
+// SetCidrlistIfUnset sets cidrlist to v, unless it is already set
+func (p *CreateFirewallRuleParams) SetCidrlistIfUnset(v []string) {
+	if _, found := p.p["cidrlist"]; !found {
+		p.SetCidrlist(v)
+	}
+}
+
+// SetCidrlistCSV splits v on commas and sets the resulting values
+func (p *CreateFirewallRuleParams) SetCidrlistCSV(v string) {
+	p.SetCidrlist(splitCSV(v))
+}
+
 func (p *CreateFirewallRuleParams) SetEndport(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -818,6 +1298,13 @@ func (p *CreateFirewallRuleParams) SetEndport(v int) {
 	return
 }
 
+// SetEndportIfUnset sets endport to v, unless it is already set
+func (p *CreateFirewallRuleParams) SetEndportIfUnset(v int) {
+	if _, found := p.p["endport"]; !found {
+		p.SetEndport(v)
+	}
+}
+
 func (p *CreateFirewallRuleParams) SetFordisplay(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -826,6 +1313,13 @@ func (p *CreateFirewallRuleParams) SetFordisplay(v bool) {
 	return
 }
 
+// SetFordisplayIfUnset sets fordisplay to v, unless it is already set
+func (p *CreateFirewallRuleParams) SetFordisplayIfUnset(v bool) {
+	if _, found := p.p["fordisplay"]; !found {
+		p.SetFordisplay(v)
+	}
+}
+
 func (p *CreateFirewallRuleParams) SetIcmpcode(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -834,6 +1328,13 @@ func (p *CreateFirewallRuleParams) SetIcmpcode(v int) {
 	return
 }
 
+// SetIcmpcodeIfUnset sets icmpcode to v, unless it is already set
+func (p *CreateFirewallRuleParams) SetIcmpcodeIfUnset(v int) {
+	if _, found := p.p["icmpcode"]; !found {
+		p.SetIcmpcode(v)
+	}
+}
+
 func (p *CreateFirewallRuleParams) SetIcmptype(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -842,6 +1343,13 @@ func (p *CreateFirewallRuleParams) SetIcmptype(v int) {
 	return
 }
 
+// SetIcmptypeIfUnset sets icmptype to v, unless it is already set
+func (p *CreateFirewallRuleParams) SetIcmptypeIfUnset(v int) {
+	if _, found := p.p["icmptype"]; !found {
+		p.SetIcmptype(v)
+	}
+}
+
 func (p *CreateFirewallRuleParams) SetIpaddressid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -850,14 +1358,28 @@ func (p *CreateFirewallRuleParams) SetIpaddressid(v string) {
 	return
 }
 
-func (p *CreateFirewallRuleParams) SetProtocol(v string) {
+// SetIpaddressidIfUnset sets ipaddressid to v, unless it is already set
+func (p *CreateFirewallRuleParams) SetIpaddressidIfUnset(v string) {
+	if _, found := p.p["ipaddressid"]; !found {
+		p.SetIpaddressid(v)
+	}
+}
+
+func (p *CreateFirewallRuleParams) SetProtocol(v Protocol) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	p.p["protocol"] = v
+	p.p["protocol"] = string(v)
 	return
 }
 
+// SetProtocolIfUnset sets protocol to v, unless it is already set
+func (p *CreateFirewallRuleParams) SetProtocolIfUnset(v Protocol) {
+	if _, found := p.p["protocol"]; !found {
+		p.SetProtocol(v)
+	}
+}
+
 func (p *CreateFirewallRuleParams) SetStartport(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -866,6 +1388,13 @@ func (p *CreateFirewallRuleParams) SetStartport(v int) {
 	return
 }
 
+// SetStartportIfUnset sets startport to v, unless it is already set
+func (p *CreateFirewallRuleParams) SetStartportIfUnset(v int) {
+	if _, found := p.p["startport"]; !found {
+		p.SetStartport(v)
+	}
+}
+
 func (p *CreateFirewallRuleParams) SetType(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -874,19 +1403,55 @@ func (p *CreateFirewallRuleParams) SetType(v string) {
 	return
 }
 
+// SetTypeIfUnset sets type to v, unless it is already set
+func (p *CreateFirewallRuleParams) SetTypeIfUnset(v string) {
+	if _, found := p.p["type"]; !found {
+		p.SetType(v)
+	}
+}
+
 // You should always use this function to get a new CreateFirewallRuleParams instance,
-// as then you are sure you have configured all required params
-func (s *FirewallService) NewCreateFirewallRuleParams(ipaddressid string, protocol string) *CreateFirewallRuleParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *FirewallService) NewCreateFirewallRuleParams(ipaddressid string, protocol string, opts ...func(*CreateFirewallRuleParams)) *CreateFirewallRuleParams {
 	p := &CreateFirewallRuleParams{}
 	p.p = make(map[string]interface{})
 	p.p["ipaddressid"] = ipaddressid
 	p.p["protocol"] = protocol
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Creates a firewall rule for a given IP address
-func (s *FirewallService) CreateFirewallRule(p *CreateFirewallRuleParams) (*CreateFirewallRuleResponse, error) {
-	resp, err := s.cs.newRequest("createFirewallRule", p.toURLValues())
+func (s *FirewallService) CreateFirewallRule(p *CreateFirewallRuleParams, opts ...OptionFunc) (*CreateFirewallRuleResponse, error) {
+	return s.CreateFirewallRuleWithContext(context.Background(), p, opts...)
+}
+
+// CreateFirewallRuleWithContext is the same as CreateFirewallRule, but the request is cancelled when the context is done
+func (s *FirewallService) CreateFirewallRuleWithContext(ctx context.Context, p *CreateFirewallRuleParams, opts ...OptionFunc) (*CreateFirewallRuleResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.CreateFirewallRuleRawWithContext(ctx, p.toURLValues())
+}
+
+// CreateFirewallRuleRaw is the same as CreateFirewallRule, but takes the params as url.Values instead of typed params
+func (s *FirewallService) CreateFirewallRuleRaw(v url.Values) (*CreateFirewallRuleResponse, error) {
+	return s.CreateFirewallRuleRawWithContext(context.Background(), v)
+}
+
+// CreateFirewallRuleRawWithContext is the same as CreateFirewallRuleRaw, but the request is cancelled when the context is done
+func (s *FirewallService) CreateFirewallRuleRawWithContext(ctx context.Context, v url.Values) (*CreateFirewallRuleResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "createFirewallRule", "createfirewallruleresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -898,9 +1463,9 @@ func (s *FirewallService) CreateFirewallRule(p *CreateFirewallRuleParams) (*Crea
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -956,6 +1521,16 @@ type CreatePortForwardingRuleParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *CreatePortForwardingRuleParams) Clone() *CreatePortForwardingRuleParams {
+	c := &CreatePortForwardingRuleParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *CreatePortForwardingRuleParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1007,6 +1582,16 @@ func (p *CreatePortForwardingRuleParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *CreatePortForwardingRuleParams) validate() error {
+	for _, k := range []string{"ipaddressid", "privateport", "protocol", "publicport", "virtualmachineid"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of createPortForwardingRule", k)
+		}
+	}
+	return nil
+}
+
 func (p *CreatePortForwardingRuleParams) SetCidrlist(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1015,6 +1600,18 @@ func (p *CreatePortForwardingRuleParams) SetCidrlist(v []string) {
 	return
 }
 
+// SetCidrlistIfUnset sets cidrlist to v, unless it is already set
+func (p *CreatePortForwardingRuleParams) SetCidrlistIfUnset(v []string) {
+	if _, found := p.p["cidrlist"]; !found {
+		p.SetCidrlist(v)
+	}
+}
+
+// SetCidrlistCSV splits v on commas and sets the resulting values
+func (p *CreatePortForwardingRuleParams) SetCidrlistCSV(v string) {
+	p.SetCidrlist(splitCSV(v))
+}
+
 func (p *CreatePortForwardingRuleParams) SetFordisplay(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1023,14 +1620,28 @@ func (p *CreatePortForwardingRuleParams) SetFordisplay(v bool) {
 	return
 }
 
-func (p *CreatePortForwardingRuleParams) SetIpaddressid(v string) {
-	if p.p == nil {
+// SetFordisplayIfUnset sets fordisplay to v, unless it is already set
+func (p *CreatePortForwardingRuleParams) SetFordisplayIfUnset(v bool) {
+	if _, found := p.p["fordisplay"]; !found {
+		p.SetFordisplay(v)
+	}
+}
+
+func (p *CreatePortForwardingRuleParams) SetIpaddressid(v string) {
+	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
 	p.p["ipaddressid"] = v
 	return
 }
 
+// SetIpaddressidIfUnset sets ipaddressid to v, unless it is already set
+func (p *CreatePortForwardingRuleParams) SetIpaddressidIfUnset(v string) {
+	if _, found := p.p["ipaddressid"]; !found {
+		p.SetIpaddressid(v)
+	}
+}
+
 func (p *CreatePortForwardingRuleParams) SetNetworkid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1039,6 +1650,13 @@ func (p *CreatePortForwardingRuleParams) SetNetworkid(v string) {
 	return
 }
 
+// SetNetworkidIfUnset sets networkid to v, unless it is already set
+func (p *CreatePortForwardingRuleParams) SetNetworkidIfUnset(v string) {
+	if _, found := p.p["networkid"]; !found {
+		p.SetNetworkid(v)
+	}
+}
+
 func (p *CreatePortForwardingRuleParams) SetOpenfirewall(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1047,6 +1665,13 @@ func (p *CreatePortForwardingRuleParams) SetOpenfirewall(v bool) {
 	return
 }
 
+// SetOpenfirewallIfUnset sets openfirewall to v, unless it is already set
+func (p *CreatePortForwardingRuleParams) SetOpenfirewallIfUnset(v bool) {
+	if _, found := p.p["openfirewall"]; !found {
+		p.SetOpenfirewall(v)
+	}
+}
+
 func (p *CreatePortForwardingRuleParams) SetPrivateendport(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1055,6 +1680,13 @@ func (p *CreatePortForwardingRuleParams) SetPrivateendport(v int) {
 	return
 }
 
+// SetPrivateendportIfUnset sets privateendport to v, unless it is already set
+func (p *CreatePortForwardingRuleParams) SetPrivateendportIfUnset(v int) {
+	if _, found := p.p["privateendport"]; !found {
+		p.SetPrivateendport(v)
+	}
+}
+
 func (p *CreatePortForwardingRuleParams) SetPrivateport(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1063,14 +1695,28 @@ func (p *CreatePortForwardingRuleParams) SetPrivateport(v int) {
 	return
 }
 
-func (p *CreatePortForwardingRuleParams) SetProtocol(v string) {
+// SetPrivateportIfUnset sets privateport to v, unless it is already set
+func (p *CreatePortForwardingRuleParams) SetPrivateportIfUnset(v int) {
+	if _, found := p.p["privateport"]; !found {
+		p.SetPrivateport(v)
+	}
+}
+
+func (p *CreatePortForwardingRuleParams) SetProtocol(v Protocol) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	p.p["protocol"] = v
+	p.p["protocol"] = string(v)
 	return
 }
 
+// SetProtocolIfUnset sets protocol to v, unless it is already set
+func (p *CreatePortForwardingRuleParams) SetProtocolIfUnset(v Protocol) {
+	if _, found := p.p["protocol"]; !found {
+		p.SetProtocol(v)
+	}
+}
+
 func (p *CreatePortForwardingRuleParams) SetPublicendport(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1079,6 +1725,13 @@ func (p *CreatePortForwardingRuleParams) SetPublicendport(v int) {
 	return
 }
 
+// SetPublicendportIfUnset sets publicendport to v, unless it is already set
+func (p *CreatePortForwardingRuleParams) SetPublicendportIfUnset(v int) {
+	if _, found := p.p["publicendport"]; !found {
+		p.SetPublicendport(v)
+	}
+}
+
 func (p *CreatePortForwardingRuleParams) SetPublicport(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1087,6 +1740,13 @@ func (p *CreatePortForwardingRuleParams) SetPublicport(v int) {
 	return
 }
 
+// SetPublicportIfUnset sets publicport to v, unless it is already set
+func (p *CreatePortForwardingRuleParams) SetPublicportIfUnset(v int) {
+	if _, found := p.p["publicport"]; !found {
+		p.SetPublicport(v)
+	}
+}
+
 func (p *CreatePortForwardingRuleParams) SetVirtualmachineid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1095,6 +1755,13 @@ func (p *CreatePortForwardingRuleParams) SetVirtualmachineid(v string) {
 	return
 }
 
+// SetVirtualmachineidIfUnset sets virtualmachineid to v, unless it is already set
+func (p *CreatePortForwardingRuleParams) SetVirtualmachineidIfUnset(v string) {
+	if _, found := p.p["virtualmachineid"]; !found {
+		p.SetVirtualmachineid(v)
+	}
+}
+
 func (p *CreatePortForwardingRuleParams) SetVmguestip(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1103,9 +1770,17 @@ func (p *CreatePortForwardingRuleParams) SetVmguestip(v string) {
 	return
 }
 
+// SetVmguestipIfUnset sets vmguestip to v, unless it is already set
+func (p *CreatePortForwardingRuleParams) SetVmguestipIfUnset(v string) {
+	if _, found := p.p["vmguestip"]; !found {
+		p.SetVmguestip(v)
+	}
+}
+
 // You should always use this function to get a new CreatePortForwardingRuleParams instance,
-// as then you are sure you have configured all required params
-func (s *FirewallService) NewCreatePortForwardingRuleParams(ipaddressid string, privateport int, protocol string, publicport int, virtualmachineid string) *CreatePortForwardingRuleParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *FirewallService) NewCreatePortForwardingRuleParams(ipaddressid string, privateport int, protocol string, publicport int, virtualmachineid string, opts ...func(*CreatePortForwardingRuleParams)) *CreatePortForwardingRuleParams {
 	p := &CreatePortForwardingRuleParams{}
 	p.p = make(map[string]interface{})
 	p.p["ipaddressid"] = ipaddressid
@@ -1113,12 +1788,85 @@ func (s *FirewallService) NewCreatePortForwardingRuleParams(ipaddressid string,
 	p.p["protocol"] = protocol
 	p.p["publicport"] = publicport
 	p.p["virtualmachineid"] = virtualmachineid
+	for _, fn := range opts {
+		fn(p)
+	}
+	return p
+}
+
+// CreatePortForwardingRuleOpts contains all params that can be set on a CreatePortForwardingRuleParams.
+// Optional params are pointers (or slices and maps) which are only set when not nil.
+type CreatePortForwardingRuleOpts struct {
+	Cidrlist         []string
+	Fordisplay       *bool
+	Ipaddressid      string
+	Networkid        *string
+	Openfirewall     *bool
+	Privateendport   *int
+	Privateport      int
+	Protocol         Protocol
+	Publicendport    *int
+	Publicport       int
+	Virtualmachineid string
+	Vmguestip        *string
+}
+
+// NewCreatePortForwardingRuleParamsFromOpts is an alternative for NewCreatePortForwardingRuleParams which takes all params using
+// an options struct, as that is easier to read for APIs with many required params
+func (s *FirewallService) NewCreatePortForwardingRuleParamsFromOpts(o CreatePortForwardingRuleOpts) *CreatePortForwardingRuleParams {
+	p := s.NewCreatePortForwardingRuleParams(o.Ipaddressid, o.Privateport, string(o.Protocol), o.Publicport, o.Virtualmachineid)
+	if o.Cidrlist != nil {
+		p.p["cidrlist"] = o.Cidrlist
+	}
+	if o.Fordisplay != nil {
+		p.p["fordisplay"] = *o.Fordisplay
+	}
+	if o.Networkid != nil {
+		p.p["networkid"] = *o.Networkid
+	}
+	if o.Openfirewall != nil {
+		p.p["openfirewall"] = *o.Openfirewall
+	}
+	if o.Privateendport != nil {
+		p.p["privateendport"] = *o.Privateendport
+	}
+	if o.Publicendport != nil {
+		p.p["publicendport"] = *o.Publicendport
+	}
+	if o.Vmguestip != nil {
+		p.p["vmguestip"] = *o.Vmguestip
+	}
 	return p
 }
 
 // Creates a port forwarding rule
-func (s *FirewallService) CreatePortForwardingRule(p *CreatePortForwardingRuleParams) (*CreatePortForwardingRuleResponse, error) {
-	resp, err := s.cs.newRequest("createPortForwardingRule", p.toURLValues())
+func (s *FirewallService) CreatePortForwardingRule(p *CreatePortForwardingRuleParams, opts ...OptionFunc) (*CreatePortForwardingRuleResponse, error) {
+	return s.CreatePortForwardingRuleWithContext(context.Background(), p, opts...)
+}
+
+// CreatePortForwardingRuleWithContext is the same as CreatePortForwardingRule, but the request is cancelled when the context is done
+func (s *FirewallService) CreatePortForwardingRuleWithContext(ctx context.Context, p *CreatePortForwardingRuleParams, opts ...OptionFunc) (*CreatePortForwardingRuleResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.CreatePortForwardingRuleRawWithContext(ctx, p.toURLValues())
+}
+
+// CreatePortForwardingRuleRaw is the same as CreatePortForwardingRule, but takes the params as url.Values instead of typed params
+func (s *FirewallService) CreatePortForwardingRuleRaw(v url.Values) (*CreatePortForwardingRuleResponse, error) {
+	return s.CreatePortForwardingRuleRawWithContext(context.Background(), v)
+}
+
+// CreatePortForwardingRuleRawWithContext is the same as CreatePortForwardingRuleRaw, but the request is cancelled when the context is done
+func (s *FirewallService) CreatePortForwardingRuleRawWithContext(ctx context.Context, v url.Values) (*CreatePortForwardingRuleResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "createPortForwardingRule", "createportforwardingruleresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -1130,9 +1878,9 @@ func (s *FirewallService) CreatePortForwardingRule(p *CreatePortForwardingRulePa
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -1192,6 +1940,16 @@ type DeleteEgressFirewallRuleParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *DeleteEgressFirewallRuleParams) Clone() *DeleteEgressFirewallRuleParams {
+	c := &DeleteEgressFirewallRuleParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeleteEgressFirewallRuleParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1203,6 +1961,16 @@ func (p *DeleteEgressFirewallRuleParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *DeleteEgressFirewallRuleParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of deleteEgressFirewallRule", k)
+		}
+	}
+	return nil
+}
+
 func (p *DeleteEgressFirewallRuleParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1211,18 +1979,54 @@ func (p *DeleteEgressFirewallRuleParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *DeleteEgressFirewallRuleParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 // You should always use this function to get a new DeleteEgressFirewallRuleParams instance,
-// as then you are sure you have configured all required params
-func (s *FirewallService) NewDeleteEgressFirewallRuleParams(id string) *DeleteEgressFirewallRuleParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *FirewallService) NewDeleteEgressFirewallRuleParams(id string, opts ...func(*DeleteEgressFirewallRuleParams)) *DeleteEgressFirewallRuleParams {
 	p := &DeleteEgressFirewallRuleParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Deletes an egress firewall rule
-func (s *FirewallService) DeleteEgressFirewallRule(p *DeleteEgressFirewallRuleParams) (*DeleteEgressFirewallRuleResponse, error) {
-	resp, err := s.cs.newRequest("deleteEgressFirewallRule", p.toURLValues())
+func (s *FirewallService) DeleteEgressFirewallRule(p *DeleteEgressFirewallRuleParams, opts ...OptionFunc) (*DeleteEgressFirewallRuleResponse, error) {
+	return s.DeleteEgressFirewallRuleWithContext(context.Background(), p, opts...)
+}
+
+// DeleteEgressFirewallRuleWithContext is the same as DeleteEgressFirewallRule, but the request is cancelled when the context is done
+func (s *FirewallService) DeleteEgressFirewallRuleWithContext(ctx context.Context, p *DeleteEgressFirewallRuleParams, opts ...OptionFunc) (*DeleteEgressFirewallRuleResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.DeleteEgressFirewallRuleRawWithContext(ctx, p.toURLValues())
+}
+
+// DeleteEgressFirewallRuleRaw is the same as DeleteEgressFirewallRule, but takes the params as url.Values instead of typed params
+func (s *FirewallService) DeleteEgressFirewallRuleRaw(v url.Values) (*DeleteEgressFirewallRuleResponse, error) {
+	return s.DeleteEgressFirewallRuleRawWithContext(context.Background(), v)
+}
+
+// DeleteEgressFirewallRuleRawWithContext is the same as DeleteEgressFirewallRuleRaw, but the request is cancelled when the context is done
+func (s *FirewallService) DeleteEgressFirewallRuleRawWithContext(ctx context.Context, v url.Values) (*DeleteEgressFirewallRuleResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteEgressFirewallRule", "deleteegressfirewallruleresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -1234,9 +2038,9 @@ func (s *FirewallService) DeleteEgressFirewallRule(p *DeleteEgressFirewallRulePa
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -1255,6 +2059,34 @@ func (s *FirewallService) DeleteEgressFirewallRule(p *DeleteEgressFirewallRulePa
 	return &r, nil
 }
 
+// WaitForEgressFirewallRuleDeleted polls until the EgressFirewallRule with the given ID no longer exists, the timeout
+// expires or the context is cancelled. Deleted resources may still be listed for a short while
+// after the delete command finished, so this can be used to make sure they are really gone. The
+// options are passed to GetEgressFirewallRuleByID, so a resource outside of the default scope can be found.
+func (s *FirewallService) WaitForEgressFirewallRuleDeleted(ctx context.Context, id string, timeout time.Duration, opts ...OptionFunc) error {
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	for {
+		_, count, err := s.GetEgressFirewallRuleByID(id, opts...)
+		if count == 0 {
+			return nil
+		}
+		if count < 0 {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(2 * time.Second):
+		}
+	}
+}
+
 type DeleteEgressFirewallRuleResponse struct {
 	JobID       string `json:"jobid"`
 	Displaytext string `json:"displaytext"`
@@ -1265,6 +2097,16 @@ type DeleteFirewallRuleParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *DeleteFirewallRuleParams) Clone() *DeleteFirewallRuleParams {
+	c := &DeleteFirewallRuleParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeleteFirewallRuleParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1276,6 +2118,16 @@ func (p *DeleteFirewallRuleParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *DeleteFirewallRuleParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of deleteFirewallRule", k)
+		}
+	}
+	return nil
+}
+
 func (p *DeleteFirewallRuleParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1284,18 +2136,54 @@ func (p *DeleteFirewallRuleParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *DeleteFirewallRuleParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 // You should always use this function to get a new DeleteFirewallRuleParams instance,
-// as then you are sure you have configured all required params
-func (s *FirewallService) NewDeleteFirewallRuleParams(id string) *DeleteFirewallRuleParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *FirewallService) NewDeleteFirewallRuleParams(id string, opts ...func(*DeleteFirewallRuleParams)) *DeleteFirewallRuleParams {
 	p := &DeleteFirewallRuleParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Deletes a firewall rule
-func (s *FirewallService) DeleteFirewallRule(p *DeleteFirewallRuleParams) (*DeleteFirewallRuleResponse, error) {
-	resp, err := s.cs.newRequest("deleteFirewallRule", p.toURLValues())
+func (s *FirewallService) DeleteFirewallRule(p *DeleteFirewallRuleParams, opts ...OptionFunc) (*DeleteFirewallRuleResponse, error) {
+	return s.DeleteFirewallRuleWithContext(context.Background(), p, opts...)
+}
+
+// DeleteFirewallRuleWithContext is the same as DeleteFirewallRule, but the request is cancelled when the context is done
+func (s *FirewallService) DeleteFirewallRuleWithContext(ctx context.Context, p *DeleteFirewallRuleParams, opts ...OptionFunc) (*DeleteFirewallRuleResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.DeleteFirewallRuleRawWithContext(ctx, p.toURLValues())
+}
+
+// DeleteFirewallRuleRaw is the same as DeleteFirewallRule, but takes the params as url.Values instead of typed params
+func (s *FirewallService) DeleteFirewallRuleRaw(v url.Values) (*DeleteFirewallRuleResponse, error) {
+	return s.DeleteFirewallRuleRawWithContext(context.Background(), v)
+}
+
+// DeleteFirewallRuleRawWithContext is the same as DeleteFirewallRuleRaw, but the request is cancelled when the context is done
+func (s *FirewallService) DeleteFirewallRuleRawWithContext(ctx context.Context, v url.Values) (*DeleteFirewallRuleResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteFirewallRule", "deletefirewallruleresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -1307,9 +2195,9 @@ func (s *FirewallService) DeleteFirewallRule(p *DeleteFirewallRuleParams) (*Dele
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -1328,6 +2216,34 @@ func (s *FirewallService) DeleteFirewallRule(p *DeleteFirewallRuleParams) (*Dele
 	return &r, nil
 }
 
+// WaitForFirewallRuleDeleted polls until the FirewallRule with the given ID no longer exists, the timeout
+// expires or the context is cancelled. Deleted resources may still be listed for a short while
+// after the delete command finished, so this can be used to make sure they are really gone. The
+// options are passed to GetFirewallRuleByID, so a resource outside of the default scope can be found.
+func (s *FirewallService) WaitForFirewallRuleDeleted(ctx context.Context, id string, timeout time.Duration, opts ...OptionFunc) error {
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	for {
+		_, count, err := s.GetFirewallRuleByID(id, opts...)
+		if count == 0 {
+			return nil
+		}
+		if count < 0 {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(2 * time.Second):
+		}
+	}
+}
+
 type DeleteFirewallRuleResponse struct {
 	JobID       string `json:"jobid"`
 	Displaytext string `json:"displaytext"`
@@ -1338,6 +2254,16 @@ type DeletePaloAltoFirewallParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *DeletePaloAltoFirewallParams) Clone() *DeletePaloAltoFirewallParams {
+	c := &DeletePaloAltoFirewallParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeletePaloAltoFirewallParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1349,6 +2275,16 @@ func (p *DeletePaloAltoFirewallParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *DeletePaloAltoFirewallParams) validate() error {
+	for _, k := range []string{"fwdeviceid"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of deletePaloAltoFirewall", k)
+		}
+	}
+	return nil
+}
+
 func (p *DeletePaloAltoFirewallParams) SetFwdeviceid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1357,18 +2293,54 @@ func (p *DeletePaloAltoFirewallParams) SetFwdeviceid(v string) {
 	return
 }
 
+// SetFwdeviceidIfUnset sets fwdeviceid to v, unless it is already set
+func (p *DeletePaloAltoFirewallParams) SetFwdeviceidIfUnset(v string) {
+	if _, found := p.p["fwdeviceid"]; !found {
+		p.SetFwdeviceid(v)
+	}
+}
+
 // You should always use this function to get a new DeletePaloAltoFirewallParams instance,
-// as then you are sure you have configured all required params
-func (s *FirewallService) NewDeletePaloAltoFirewallParams(fwdeviceid string) *DeletePaloAltoFirewallParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *FirewallService) NewDeletePaloAltoFirewallParams(fwdeviceid string, opts ...func(*DeletePaloAltoFirewallParams)) *DeletePaloAltoFirewallParams {
 	p := &DeletePaloAltoFirewallParams{}
 	p.p = make(map[string]interface{})
 	p.p["fwdeviceid"] = fwdeviceid
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
-//  delete a Palo Alto firewall device
-func (s *FirewallService) DeletePaloAltoFirewall(p *DeletePaloAltoFirewallParams) (*DeletePaloAltoFirewallResponse, error) {
-	resp, err := s.cs.newRequest("deletePaloAltoFirewall", p.toURLValues())
+// delete a Palo Alto firewall device
+func (s *FirewallService) DeletePaloAltoFirewall(p *DeletePaloAltoFirewallParams, opts ...OptionFunc) (*DeletePaloAltoFirewallResponse, error) {
+	return s.DeletePaloAltoFirewallWithContext(context.Background(), p, opts...)
+}
+
+// DeletePaloAltoFirewallWithContext is the same as DeletePaloAltoFirewall, but the request is cancelled when the context is done
+func (s *FirewallService) DeletePaloAltoFirewallWithContext(ctx context.Context, p *DeletePaloAltoFirewallParams, opts ...OptionFunc) (*DeletePaloAltoFirewallResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.DeletePaloAltoFirewallRawWithContext(ctx, p.toURLValues())
+}
+
+// DeletePaloAltoFirewallRaw is the same as DeletePaloAltoFirewall, but takes the params as url.Values instead of typed params
+func (s *FirewallService) DeletePaloAltoFirewallRaw(v url.Values) (*DeletePaloAltoFirewallResponse, error) {
+	return s.DeletePaloAltoFirewallRawWithContext(context.Background(), v)
+}
+
+// DeletePaloAltoFirewallRawWithContext is the same as DeletePaloAltoFirewallRaw, but the request is cancelled when the context is done
+func (s *FirewallService) DeletePaloAltoFirewallRawWithContext(ctx context.Context, v url.Values) (*DeletePaloAltoFirewallResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "deletePaloAltoFirewall", "deletepaloaltofirewallresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -1380,9 +2352,9 @@ func (s *FirewallService) DeletePaloAltoFirewall(p *DeletePaloAltoFirewallParams
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -1411,6 +2383,16 @@ type DeletePortForwardingRuleParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *DeletePortForwardingRuleParams) Clone() *DeletePortForwardingRuleParams {
+	c := &DeletePortForwardingRuleParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeletePortForwardingRuleParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1422,6 +2404,16 @@ func (p *DeletePortForwardingRuleParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *DeletePortForwardingRuleParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of deletePortForwardingRule", k)
+		}
+	}
+	return nil
+}
+
 func (p *DeletePortForwardingRuleParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1430,18 +2422,54 @@ func (p *DeletePortForwardingRuleParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *DeletePortForwardingRuleParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 // You should always use this function to get a new DeletePortForwardingRuleParams instance,
-// as then you are sure you have configured all required params
-func (s *FirewallService) NewDeletePortForwardingRuleParams(id string) *DeletePortForwardingRuleParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *FirewallService) NewDeletePortForwardingRuleParams(id string, opts ...func(*DeletePortForwardingRuleParams)) *DeletePortForwardingRuleParams {
 	p := &DeletePortForwardingRuleParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Deletes a port forwarding rule
-func (s *FirewallService) DeletePortForwardingRule(p *DeletePortForwardingRuleParams) (*DeletePortForwardingRuleResponse, error) {
-	resp, err := s.cs.newRequest("deletePortForwardingRule", p.toURLValues())
+func (s *FirewallService) DeletePortForwardingRule(p *DeletePortForwardingRuleParams, opts ...OptionFunc) (*DeletePortForwardingRuleResponse, error) {
+	return s.DeletePortForwardingRuleWithContext(context.Background(), p, opts...)
+}
+
+// DeletePortForwardingRuleWithContext is the same as DeletePortForwardingRule, but the request is cancelled when the context is done
+func (s *FirewallService) DeletePortForwardingRuleWithContext(ctx context.Context, p *DeletePortForwardingRuleParams, opts ...OptionFunc) (*DeletePortForwardingRuleResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.DeletePortForwardingRuleRawWithContext(ctx, p.toURLValues())
+}
+
+// DeletePortForwardingRuleRaw is the same as DeletePortForwardingRule, but takes the params as url.Values instead of typed params
+func (s *FirewallService) DeletePortForwardingRuleRaw(v url.Values) (*DeletePortForwardingRuleResponse, error) {
+	return s.DeletePortForwardingRuleRawWithContext(context.Background(), v)
+}
+
+// DeletePortForwardingRuleRawWithContext is the same as DeletePortForwardingRuleRaw, but the request is cancelled when the context is done
+func (s *FirewallService) DeletePortForwardingRuleRawWithContext(ctx context.Context, v url.Values) (*DeletePortForwardingRuleResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "deletePortForwardingRule", "deleteportforwardingruleresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -1453,9 +2481,9 @@ func (s *FirewallService) DeletePortForwardingRule(p *DeletePortForwardingRulePa
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -1474,6 +2502,34 @@ func (s *FirewallService) DeletePortForwardingRule(p *DeletePortForwardingRulePa
 	return &r, nil
 }
 
+// WaitForPortForwardingRuleDeleted polls until the PortForwardingRule with the given ID no longer exists, the timeout
+// expires or the context is cancelled. Deleted resources may still be listed for a short while
+// after the delete command finished, so this can be used to make sure they are really gone. The
+// options are passed to GetPortForwardingRuleByID, so a resource outside of the default scope can be found.
+func (s *FirewallService) WaitForPortForwardingRuleDeleted(ctx context.Context, id string, timeout time.Duration, opts ...OptionFunc) error {
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	for {
+		_, count, err := s.GetPortForwardingRuleByID(id, opts...)
+		if count == 0 {
+			return nil
+		}
+		if count < 0 {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(2 * time.Second):
+		}
+	}
+}
+
 type DeletePortForwardingRuleResponse struct {
 	JobID       string `json:"jobid"`
 	Displaytext string `json:"displaytext"`
@@ -1484,6 +2540,16 @@ type DeleteSrxFirewallParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *DeleteSrxFirewallParams) Clone() *DeleteSrxFirewallParams {
+	c := &DeleteSrxFirewallParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeleteSrxFirewallParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1495,6 +2561,16 @@ func (p *DeleteSrxFirewallParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *DeleteSrxFirewallParams) validate() error {
+	for _, k := range []string{"fwdeviceid"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of deleteSrxFirewall", k)
+		}
+	}
+	return nil
+}
+
 func (p *DeleteSrxFirewallParams) SetFwdeviceid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1503,18 +2579,54 @@ func (p *DeleteSrxFirewallParams) SetFwdeviceid(v string) {
 	return
 }
 
+// SetFwdeviceidIfUnset sets fwdeviceid to v, unless it is already set
+func (p *DeleteSrxFirewallParams) SetFwdeviceidIfUnset(v string) {
+	if _, found := p.p["fwdeviceid"]; !found {
+		p.SetFwdeviceid(v)
+	}
+}
+
 // You should always use this function to get a new DeleteSrxFirewallParams instance,
-// as then you are sure you have configured all required params
-func (s *FirewallService) NewDeleteSrxFirewallParams(fwdeviceid string) *DeleteSrxFirewallParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *FirewallService) NewDeleteSrxFirewallParams(fwdeviceid string, opts ...func(*DeleteSrxFirewallParams)) *DeleteSrxFirewallParams {
 	p := &DeleteSrxFirewallParams{}
 	p.p = make(map[string]interface{})
 	p.p["fwdeviceid"] = fwdeviceid
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
-//  delete a SRX firewall device
-func (s *FirewallService) DeleteSrxFirewall(p *DeleteSrxFirewallParams) (*DeleteSrxFirewallResponse, error) {
-	resp, err := s.cs.newRequest("deleteSrxFirewall", p.toURLValues())
+// delete a SRX firewall device
+func (s *FirewallService) DeleteSrxFirewall(p *DeleteSrxFirewallParams, opts ...OptionFunc) (*DeleteSrxFirewallResponse, error) {
+	return s.DeleteSrxFirewallWithContext(context.Background(), p, opts...)
+}
+
+// DeleteSrxFirewallWithContext is the same as DeleteSrxFirewall, but the request is cancelled when the context is done
+func (s *FirewallService) DeleteSrxFirewallWithContext(ctx context.Context, p *DeleteSrxFirewallParams, opts ...OptionFunc) (*DeleteSrxFirewallResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.DeleteSrxFirewallRawWithContext(ctx, p.toURLValues())
+}
+
+// DeleteSrxFirewallRaw is the same as DeleteSrxFirewall, but takes the params as url.Values instead of typed params
+func (s *FirewallService) DeleteSrxFirewallRaw(v url.Values) (*DeleteSrxFirewallResponse, error) {
+	return s.DeleteSrxFirewallRawWithContext(context.Background(), v)
+}
+
+// DeleteSrxFirewallRawWithContext is the same as DeleteSrxFirewallRaw, but the request is cancelled when the context is done
+func (s *FirewallService) DeleteSrxFirewallRawWithContext(ctx context.Context, v url.Values) (*DeleteSrxFirewallResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteSrxFirewall", "deletesrxfirewallresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -1526,9 +2638,9 @@ func (s *FirewallService) DeleteSrxFirewall(p *DeleteSrxFirewallParams) (*Delete
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -1557,6 +2669,16 @@ type ListEgressFirewallRulesParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ListEgressFirewallRulesParams) Clone() *ListEgressFirewallRulesParams {
+	c := &ListEgressFirewallRulesParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListEgressFirewallRulesParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1604,11 +2726,10 @@ func (p *ListEgressFirewallRulesParams) toURLValues() url.Values {
 		u.Set("projectid", v.(string))
 	}
 	if v, found := p.p["tags"]; found {
-		i := 0
-		for k, vv := range v.(map[string]string) {
+		m := v.(map[string]string)
+		for i, k := range getSortedKeysFromMap(m) {
 			u.Set(fmt.Sprintf("tags[%d].key", i), k)
-			u.Set(fmt.Sprintf("tags[%d].value", i), vv)
-			i++
+			u.Set(fmt.Sprintf("tags[%d].value", i), m[k])
 		}
 	}
 	return u
@@ -1622,6 +2743,13 @@ func (p *ListEgressFirewallRulesParams) SetAccount(v string) {
 	return
 }
 
+// SetAccountIfUnset sets account to v, unless it is already set
+func (p *ListEgressFirewallRulesParams) SetAccountIfUnset(v string) {
+	if _, found := p.p["account"]; !found {
+		p.SetAccount(v)
+	}
+}
+
 func (p *ListEgressFirewallRulesParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1630,6 +2758,13 @@ func (p *ListEgressFirewallRulesParams) SetDomainid(v string) {
 	return
 }
 
+// SetDomainidIfUnset sets domainid to v, unless it is already set
+func (p *ListEgressFirewallRulesParams) SetDomainidIfUnset(v string) {
+	if _, found := p.p["domainid"]; !found {
+		p.SetDomainid(v)
+	}
+}
+
 func (p *ListEgressFirewallRulesParams) SetFordisplay(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1638,6 +2773,13 @@ func (p *ListEgressFirewallRulesParams) SetFordisplay(v bool) {
 	return
 }
 
+// SetFordisplayIfUnset sets fordisplay to v, unless it is already set
+func (p *ListEgressFirewallRulesParams) SetFordisplayIfUnset(v bool) {
+	if _, found := p.p["fordisplay"]; !found {
+		p.SetFordisplay(v)
+	}
+}
+
 func (p *ListEgressFirewallRulesParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1646,6 +2788,13 @@ func (p *ListEgressFirewallRulesParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *ListEgressFirewallRulesParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 func (p *ListEgressFirewallRulesParams) SetIpaddressid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1654,6 +2803,13 @@ func (p *ListEgressFirewallRulesParams) SetIpaddressid(v string) {
 	return
 }
 
+// SetIpaddressidIfUnset sets ipaddressid to v, unless it is already set
+func (p *ListEgressFirewallRulesParams) SetIpaddressidIfUnset(v string) {
+	if _, found := p.p["ipaddressid"]; !found {
+		p.SetIpaddressid(v)
+	}
+}
+
 func (p *ListEgressFirewallRulesParams) SetIsrecursive(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1662,6 +2818,13 @@ func (p *ListEgressFirewallRulesParams) SetIsrecursive(v bool) {
 	return
 }
 
+// SetIsrecursiveIfUnset sets isrecursive to v, unless it is already set
+func (p *ListEgressFirewallRulesParams) SetIsrecursiveIfUnset(v bool) {
+	if _, found := p.p["isrecursive"]; !found {
+		p.SetIsrecursive(v)
+	}
+}
+
 func (p *ListEgressFirewallRulesParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1670,6 +2833,13 @@ func (p *ListEgressFirewallRulesParams) SetKeyword(v string) {
 	return
 }
 
+// SetKeywordIfUnset sets keyword to v, unless it is already set
+func (p *ListEgressFirewallRulesParams) SetKeywordIfUnset(v string) {
+	if _, found := p.p["keyword"]; !found {
+		p.SetKeyword(v)
+	}
+}
+
 func (p *ListEgressFirewallRulesParams) SetListall(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1678,6 +2848,13 @@ func (p *ListEgressFirewallRulesParams) SetListall(v bool) {
 	return
 }
 
+// SetListallIfUnset sets listall to v, unless it is already set
+func (p *ListEgressFirewallRulesParams) SetListallIfUnset(v bool) {
+	if _, found := p.p["listall"]; !found {
+		p.SetListall(v)
+	}
+}
+
 func (p *ListEgressFirewallRulesParams) SetNetworkid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1686,6 +2863,13 @@ func (p *ListEgressFirewallRulesParams) SetNetworkid(v string) {
 	return
 }
 
+// SetNetworkidIfUnset sets networkid to v, unless it is already set
+func (p *ListEgressFirewallRulesParams) SetNetworkidIfUnset(v string) {
+	if _, found := p.p["networkid"]; !found {
+		p.SetNetworkid(v)
+	}
+}
+
 func (p *ListEgressFirewallRulesParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1694,6 +2878,13 @@ func (p *ListEgressFirewallRulesParams) SetPage(v int) {
 	return
 }
 
+// SetPageIfUnset sets page to v, unless it is already set
+func (p *ListEgressFirewallRulesParams) SetPageIfUnset(v int) {
+	if _, found := p.p["page"]; !found {
+		p.SetPage(v)
+	}
+}
+
 func (p *ListEgressFirewallRulesParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1702,6 +2893,13 @@ func (p *ListEgressFirewallRulesParams) SetPagesize(v int) {
 	return
 }
 
+// SetPagesizeIfUnset sets pagesize to v, unless it is already set
+func (p *ListEgressFirewallRulesParams) SetPagesizeIfUnset(v int) {
+	if _, found := p.p["pagesize"]; !found {
+		p.SetPagesize(v)
+	}
+}
+
 func (p *ListEgressFirewallRulesParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1710,6 +2908,13 @@ func (p *ListEgressFirewallRulesParams) SetProjectid(v string) {
 	return
 }
 
+// SetProjectidIfUnset sets projectid to v, unless it is already set
+func (p *ListEgressFirewallRulesParams) SetProjectidIfUnset(v string) {
+	if _, found := p.p["projectid"]; !found {
+		p.SetProjectid(v)
+	}
+}
+
 func (p *ListEgressFirewallRulesParams) SetTags(v map[string]string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1718,14 +2923,34 @@ func (p *ListEgressFirewallRulesParams) SetTags(v map[string]string) {
 	return
 }
 
+// SetTagsIfUnset sets tags to v, unless it is already set
+func (p *ListEgressFirewallRulesParams) SetTagsIfUnset(v map[string]string) {
+	if _, found := p.p["tags"]; !found {
+		p.SetTags(v)
+	}
+}
+
+var _ ListAllSetter = (*ListEgressFirewallRulesParams)(nil)
+
 // You should always use this function to get a new ListEgressFirewallRulesParams instance,
-// as then you are sure you have configured all required params
-func (s *FirewallService) NewListEgressFirewallRulesParams() *ListEgressFirewallRulesParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *FirewallService) NewListEgressFirewallRulesParams(opts ...func(*ListEgressFirewallRulesParams)) *ListEgressFirewallRulesParams {
 	p := &ListEgressFirewallRulesParams{}
 	p.p = make(map[string]interface{})
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
+func (p *ListEgressFirewallRulesParams) setFirstMatch() {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p[firstMatchKey] = true
+}
+
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *FirewallService) GetEgressFirewallRuleByID(id string, opts ...OptionFunc) (*EgressFirewallRule, int, error) {
 	p := &ListEgressFirewallRulesParams{}
@@ -1733,7 +2958,7 @@ func (s *FirewallService) GetEgressFirewallRuleByID(id string, opts ...OptionFun
 
 	p.p["id"] = id
 
-	for _, fn := range append(s.cs.options, opts...) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
 		if err := fn(s.cs, p); err != nil {
 			return nil, -1, err
 		}
@@ -1744,24 +2969,56 @@ func (s *FirewallService) GetEgressFirewallRuleByID(id string, opts ...OptionFun
 		if strings.Contains(err.Error(), fmt.Sprintf(
 			"Invalid parameter id value=%s due to incorrect long value format, "+
 				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+			return nil, 0, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
 	}
 
 	if l.Count == 1 {
 		return l.EgressFirewallRules[0], l.Count, nil
 	}
+
+	if _, ok := p.p[firstMatchKey]; ok && len(l.EgressFirewallRules) > 0 {
+		return l.EgressFirewallRules[0], l.Count, nil
+	}
 	return nil, l.Count, fmt.Errorf("There is more then one result for EgressFirewallRule UUID: %s!", id)
 }
 
+// EgressFirewallRuleExists returns true if a EgressFirewallRule with the given ID exists. When no match is found
+// it returns false, while any other error is returned as is.
+func (s *FirewallService) EgressFirewallRuleExists(id string, opts ...OptionFunc) (bool, error) {
+	_, count, err := s.GetEgressFirewallRuleByID(id, opts...)
+	if errors.Is(err, ErrNotFound) {
+		return false, nil
+	}
+	if count > 0 {
+		return true, nil
+	}
+	return false, err
+}
+
 // Lists all egress firewall rules for network ID.
 func (s *FirewallService) ListEgressFirewallRules(p *ListEgressFirewallRulesParams) (*ListEgressFirewallRulesResponse, error) {
-	resp, err := s.cs.newRequest("listEgressFirewallRules", p.toURLValues())
+	return s.ListEgressFirewallRulesWithContext(context.Background(), p)
+}
+
+// ListEgressFirewallRulesWithContext is the same as ListEgressFirewallRules, but the request is cancelled when the context is done
+func (s *FirewallService) ListEgressFirewallRulesWithContext(ctx context.Context, p *ListEgressFirewallRulesParams) (*ListEgressFirewallRulesResponse, error) {
+	return s.ListEgressFirewallRulesRawWithContext(ctx, p.toURLValues())
+}
+
+// ListEgressFirewallRulesRaw is the same as ListEgressFirewallRules, but takes the params as url.Values instead of typed params
+func (s *FirewallService) ListEgressFirewallRulesRaw(v url.Values) (*ListEgressFirewallRulesResponse, error) {
+	return s.ListEgressFirewallRulesRawWithContext(context.Background(), v)
+}
+
+// ListEgressFirewallRulesRawWithContext is the same as ListEgressFirewallRulesRaw, but the request is cancelled when the context is done
+func (s *FirewallService) ListEgressFirewallRulesRawWithContext(ctx context.Context, v url.Values) (*ListEgressFirewallRulesResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "listEgressFirewallRules", "listegressfirewallrulesresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -1779,6 +3036,93 @@ func (s *FirewallService) ListEgressFirewallRules(p *ListEgressFirewallRulesPara
 	return &r, nil
 }
 
+// StreamEgressFirewallRules fetches the results of ListEgressFirewallRules page by page in the background and sends them on the
+// returned channel. Both returned channels are closed when done. If fetching a page fails, or the context
+// is done before all results are sent, the error is sent on the error channel and fetching stops. A
+// request for a page in flight is cancelled when the context is done. The page and pagesize set in the
+// params are ignored.
+func (s *FirewallService) StreamEgressFirewallRules(ctx context.Context, p *ListEgressFirewallRulesParams) (<-chan *EgressFirewallRule, <-chan error) {
+	ch := make(chan *EgressFirewallRule)
+	errs := make(chan error, 1)
+
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesize(500)
+
+	go func() {
+		defer close(errs)
+		defer close(ch)
+
+		for page, count := 1, 0; ; page++ {
+			pp.SetPage(page)
+
+			l, err := s.ListEgressFirewallRulesWithContext(ctx, pp)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			for _, v := range l.EgressFirewallRules {
+				select {
+				case ch <- v:
+				case <-ctx.Done():
+					errs <- ctx.Err()
+					return
+				}
+			}
+
+			count += len(l.EgressFirewallRules)
+			if len(l.EgressFirewallRules) < 500 || count >= l.Count {
+				return
+			}
+		}
+	}()
+
+	return ch, errs
+}
+
+// ListEgressFirewallRulesWhere returns all results of ListEgressFirewallRules for which pred returns true. Note that the results are
+// filtered client-side, so all results matching the params are fetched first.
+// All pages are fetched, so the page and pagesize set in the params are ignored.
+func (s *FirewallService) ListEgressFirewallRulesWhere(p *ListEgressFirewallRulesParams, pred func(*EgressFirewallRule) bool) ([]*EgressFirewallRule, error) {
+	var r []*EgressFirewallRule
+	ch, errs := s.StreamEgressFirewallRules(context.Background(), p)
+	for v := range ch {
+		if pred(v) {
+			r = append(r, v)
+		}
+	}
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ListAllEgressFirewallRules returns the results of ListEgressFirewallRules across all pages, by calling ListEgressFirewallRules page by page until all
+// results are fetched. The pagesize set in the params is used for every page (500 if not set), and the
+// page set in the params is ignored.
+func (s *FirewallService) ListAllEgressFirewallRules(p *ListEgressFirewallRulesParams) ([]*EgressFirewallRule, error) {
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesizeIfUnset(500)
+	pagesize := pp.p["pagesize"].(int)
+
+	var r []*EgressFirewallRule
+	for page := 1; ; page++ {
+		pp.SetPage(page)
+
+		l, err := s.ListEgressFirewallRules(pp)
+		if err != nil {
+			return nil, err
+		}
+		r = append(r, l.EgressFirewallRules...)
+
+		if len(l.EgressFirewallRules) < pagesize || len(r) >= l.Count {
+			return r, nil
+		}
+	}
+}
+
 type ListEgressFirewallRulesResponse struct {
 	Count               int                   `json:"count"`
 	EgressFirewallRules []*EgressFirewallRule `json:"firewallrule"`
@@ -1815,6 +3159,16 @@ type ListFirewallRulesParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ListFirewallRulesParams) Clone() *ListFirewallRulesParams {
+	c := &ListFirewallRulesParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListFirewallRulesParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1862,11 +3216,10 @@ func (p *ListFirewallRulesParams) toURLValues() url.Values {
 		u.Set("projectid", v.(string))
 	}
 	if v, found := p.p["tags"]; found {
-		i := 0
-		for k, vv := range v.(map[string]string) {
+		m := v.(map[string]string)
+		for i, k := range getSortedKeysFromMap(m) {
 			u.Set(fmt.Sprintf("tags[%d].key", i), k)
-			u.Set(fmt.Sprintf("tags[%d].value", i), vv)
-			i++
+			u.Set(fmt.Sprintf("tags[%d].value", i), m[k])
 		}
 	}
 	return u
@@ -1880,6 +3233,13 @@ func (p *ListFirewallRulesParams) SetAccount(v string) {
 	return
 }
 
+// SetAccountIfUnset sets account to v, unless it is already set
+func (p *ListFirewallRulesParams) SetAccountIfUnset(v string) {
+	if _, found := p.p["account"]; !found {
+		p.SetAccount(v)
+	}
+}
+
 func (p *ListFirewallRulesParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1888,6 +3248,13 @@ func (p *ListFirewallRulesParams) SetDomainid(v string) {
 	return
 }
 
+// SetDomainidIfUnset sets domainid to v, unless it is already set
+func (p *ListFirewallRulesParams) SetDomainidIfUnset(v string) {
+	if _, found := p.p["domainid"]; !found {
+		p.SetDomainid(v)
+	}
+}
+
 func (p *ListFirewallRulesParams) SetFordisplay(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1896,6 +3263,13 @@ func (p *ListFirewallRulesParams) SetFordisplay(v bool) {
 	return
 }
 
+// SetFordisplayIfUnset sets fordisplay to v, unless it is already set
+func (p *ListFirewallRulesParams) SetFordisplayIfUnset(v bool) {
+	if _, found := p.p["fordisplay"]; !found {
+		p.SetFordisplay(v)
+	}
+}
+
 func (p *ListFirewallRulesParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1904,6 +3278,13 @@ func (p *ListFirewallRulesParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *ListFirewallRulesParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 func (p *ListFirewallRulesParams) SetIpaddressid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1912,6 +3293,13 @@ func (p *ListFirewallRulesParams) SetIpaddressid(v string) {
 	return
 }
 
+// SetIpaddressidIfUnset sets ipaddressid to v, unless it is already set
+func (p *ListFirewallRulesParams) SetIpaddressidIfUnset(v string) {
+	if _, found := p.p["ipaddressid"]; !found {
+		p.SetIpaddressid(v)
+	}
+}
+
 func (p *ListFirewallRulesParams) SetIsrecursive(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1920,6 +3308,13 @@ func (p *ListFirewallRulesParams) SetIsrecursive(v bool) {
 	return
 }
 
+// SetIsrecursiveIfUnset sets isrecursive to v, unless it is already set
+func (p *ListFirewallRulesParams) SetIsrecursiveIfUnset(v bool) {
+	if _, found := p.p["isrecursive"]; !found {
+		p.SetIsrecursive(v)
+	}
+}
+
 func (p *ListFirewallRulesParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1928,6 +3323,13 @@ func (p *ListFirewallRulesParams) SetKeyword(v string) {
 	return
 }
 
+// SetKeywordIfUnset sets keyword to v, unless it is already set
+func (p *ListFirewallRulesParams) SetKeywordIfUnset(v string) {
+	if _, found := p.p["keyword"]; !found {
+		p.SetKeyword(v)
+	}
+}
+
 func (p *ListFirewallRulesParams) SetListall(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1936,6 +3338,13 @@ func (p *ListFirewallRulesParams) SetListall(v bool) {
 	return
 }
 
+// SetListallIfUnset sets listall to v, unless it is already set
+func (p *ListFirewallRulesParams) SetListallIfUnset(v bool) {
+	if _, found := p.p["listall"]; !found {
+		p.SetListall(v)
+	}
+}
+
 func (p *ListFirewallRulesParams) SetNetworkid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1944,6 +3353,13 @@ func (p *ListFirewallRulesParams) SetNetworkid(v string) {
 	return
 }
 
+// SetNetworkidIfUnset sets networkid to v, unless it is already set
+func (p *ListFirewallRulesParams) SetNetworkidIfUnset(v string) {
+	if _, found := p.p["networkid"]; !found {
+		p.SetNetworkid(v)
+	}
+}
+
 func (p *ListFirewallRulesParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1952,6 +3368,13 @@ func (p *ListFirewallRulesParams) SetPage(v int) {
 	return
 }
 
+// SetPageIfUnset sets page to v, unless it is already set
+func (p *ListFirewallRulesParams) SetPageIfUnset(v int) {
+	if _, found := p.p["page"]; !found {
+		p.SetPage(v)
+	}
+}
+
 func (p *ListFirewallRulesParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1960,6 +3383,13 @@ func (p *ListFirewallRulesParams) SetPagesize(v int) {
 	return
 }
 
+// SetPagesizeIfUnset sets pagesize to v, unless it is already set
+func (p *ListFirewallRulesParams) SetPagesizeIfUnset(v int) {
+	if _, found := p.p["pagesize"]; !found {
+		p.SetPagesize(v)
+	}
+}
+
 func (p *ListFirewallRulesParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1968,6 +3398,13 @@ func (p *ListFirewallRulesParams) SetProjectid(v string) {
 	return
 }
 
+// SetProjectidIfUnset sets projectid to v, unless it is already set
+func (p *ListFirewallRulesParams) SetProjectidIfUnset(v string) {
+	if _, found := p.p["projectid"]; !found {
+		p.SetProjectid(v)
+	}
+}
+
 func (p *ListFirewallRulesParams) SetTags(v map[string]string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1976,14 +3413,34 @@ func (p *ListFirewallRulesParams) SetTags(v map[string]string) {
 	return
 }
 
+// SetTagsIfUnset sets tags to v, unless it is already set
+func (p *ListFirewallRulesParams) SetTagsIfUnset(v map[string]string) {
+	if _, found := p.p["tags"]; !found {
+		p.SetTags(v)
+	}
+}
+
+var _ ListAllSetter = (*ListFirewallRulesParams)(nil)
+
 // You should always use this function to get a new ListFirewallRulesParams instance,
-// as then you are sure you have configured all required params
-func (s *FirewallService) NewListFirewallRulesParams() *ListFirewallRulesParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *FirewallService) NewListFirewallRulesParams(opts ...func(*ListFirewallRulesParams)) *ListFirewallRulesParams {
 	p := &ListFirewallRulesParams{}
 	p.p = make(map[string]interface{})
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
+func (p *ListFirewallRulesParams) setFirstMatch() {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p[firstMatchKey] = true
+}
+
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *FirewallService) GetFirewallRuleByID(id string, opts ...OptionFunc) (*FirewallRule, int, error) {
 	p := &ListFirewallRulesParams{}
@@ -1991,7 +3448,7 @@ func (s *FirewallService) GetFirewallRuleByID(id string, opts ...OptionFunc) (*F
 
 	p.p["id"] = id
 
-	for _, fn := range append(s.cs.options, opts...) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
 		if err := fn(s.cs, p); err != nil {
 			return nil, -1, err
 		}
@@ -2002,24 +3459,56 @@ func (s *FirewallService) GetFirewallRuleByID(id string, opts ...OptionFunc) (*F
 		if strings.Contains(err.Error(), fmt.Sprintf(
 			"Invalid parameter id value=%s due to incorrect long value format, "+
 				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+			return nil, 0, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
 	}
 
 	if l.Count == 1 {
 		return l.FirewallRules[0], l.Count, nil
 	}
+
+	if _, ok := p.p[firstMatchKey]; ok && len(l.FirewallRules) > 0 {
+		return l.FirewallRules[0], l.Count, nil
+	}
 	return nil, l.Count, fmt.Errorf("There is more then one result for FirewallRule UUID: %s!", id)
 }
 
+// FirewallRuleExists returns true if a FirewallRule with the given ID exists. When no match is found
+// it returns false, while any other error is returned as is.
+func (s *FirewallService) FirewallRuleExists(id string, opts ...OptionFunc) (bool, error) {
+	_, count, err := s.GetFirewallRuleByID(id, opts...)
+	if errors.Is(err, ErrNotFound) {
+		return false, nil
+	}
+	if count > 0 {
+		return true, nil
+	}
+	return false, err
+}
+
 // Lists all firewall rules for an IP address.
 func (s *FirewallService) ListFirewallRules(p *ListFirewallRulesParams) (*ListFirewallRulesResponse, error) {
-	resp, err := s.cs.newRequest("listFirewallRules", p.toURLValues())
+	return s.ListFirewallRulesWithContext(context.Background(), p)
+}
+
+// ListFirewallRulesWithContext is the same as ListFirewallRules, but the request is cancelled when the context is done
+func (s *FirewallService) ListFirewallRulesWithContext(ctx context.Context, p *ListFirewallRulesParams) (*ListFirewallRulesResponse, error) {
+	return s.ListFirewallRulesRawWithContext(ctx, p.toURLValues())
+}
+
+// ListFirewallRulesRaw is the same as ListFirewallRules, but takes the params as url.Values instead of typed params
+func (s *FirewallService) ListFirewallRulesRaw(v url.Values) (*ListFirewallRulesResponse, error) {
+	return s.ListFirewallRulesRawWithContext(context.Background(), v)
+}
+
+// ListFirewallRulesRawWithContext is the same as ListFirewallRulesRaw, but the request is cancelled when the context is done
+func (s *FirewallService) ListFirewallRulesRawWithContext(ctx context.Context, v url.Values) (*ListFirewallRulesResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "listFirewallRules", "listfirewallrulesresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -2037,6 +3526,93 @@ func (s *FirewallService) ListFirewallRules(p *ListFirewallRulesParams) (*ListFi
 	return &r, nil
 }
 
+// StreamFirewallRules fetches the results of ListFirewallRules page by page in the background and sends them on the
+// returned channel. Both returned channels are closed when done. If fetching a page fails, or the context
+// is done before all results are sent, the error is sent on the error channel and fetching stops. A
+// request for a page in flight is cancelled when the context is done. The page and pagesize set in the
+// params are ignored.
+func (s *FirewallService) StreamFirewallRules(ctx context.Context, p *ListFirewallRulesParams) (<-chan *FirewallRule, <-chan error) {
+	ch := make(chan *FirewallRule)
+	errs := make(chan error, 1)
+
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesize(500)
+
+	go func() {
+		defer close(errs)
+		defer close(ch)
+
+		for page, count := 1, 0; ; page++ {
+			pp.SetPage(page)
+
+			l, err := s.ListFirewallRulesWithContext(ctx, pp)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			for _, v := range l.FirewallRules {
+				select {
+				case ch <- v:
+				case <-ctx.Done():
+					errs <- ctx.Err()
+					return
+				}
+			}
+
+			count += len(l.FirewallRules)
+			if len(l.FirewallRules) < 500 || count >= l.Count {
+				return
+			}
+		}
+	}()
+
+	return ch, errs
+}
+
+// ListFirewallRulesWhere returns all results of ListFirewallRules for which pred returns true. Note that the results are
+// filtered client-side, so all results matching the params are fetched first.
+// All pages are fetched, so the page and pagesize set in the params are ignored.
+func (s *FirewallService) ListFirewallRulesWhere(p *ListFirewallRulesParams, pred func(*FirewallRule) bool) ([]*FirewallRule, error) {
+	var r []*FirewallRule
+	ch, errs := s.StreamFirewallRules(context.Background(), p)
+	for v := range ch {
+		if pred(v) {
+			r = append(r, v)
+		}
+	}
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ListAllFirewallRules returns the results of ListFirewallRules across all pages, by calling ListFirewallRules page by page until all
+// results are fetched. The pagesize set in the params is used for every page (500 if not set), and the
+// page set in the params is ignored.
+func (s *FirewallService) ListAllFirewallRules(p *ListFirewallRulesParams) ([]*FirewallRule, error) {
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesizeIfUnset(500)
+	pagesize := pp.p["pagesize"].(int)
+
+	var r []*FirewallRule
+	for page := 1; ; page++ {
+		pp.SetPage(page)
+
+		l, err := s.ListFirewallRules(pp)
+		if err != nil {
+			return nil, err
+		}
+		r = append(r, l.FirewallRules...)
+
+		if len(l.FirewallRules) < pagesize || len(r) >= l.Count {
+			return r, nil
+		}
+	}
+}
+
 type ListFirewallRulesResponse struct {
 	Count         int             `json:"count"`
 	FirewallRules []*FirewallRule `json:"firewallrule"`
@@ -2073,6 +3649,16 @@ type ListPaloAltoFirewallsParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ListPaloAltoFirewallsParams) Clone() *ListPaloAltoFirewallsParams {
+	c := &ListPaloAltoFirewallsParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListPaloAltoFirewallsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -2106,6 +3692,13 @@ func (p *ListPaloAltoFirewallsParams) SetFwdeviceid(v string) {
 	return
 }
 
+// SetFwdeviceidIfUnset sets fwdeviceid to v, unless it is already set
+func (p *ListPaloAltoFirewallsParams) SetFwdeviceidIfUnset(v string) {
+	if _, found := p.p["fwdeviceid"]; !found {
+		p.SetFwdeviceid(v)
+	}
+}
+
 func (p *ListPaloAltoFirewallsParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2114,6 +3707,13 @@ func (p *ListPaloAltoFirewallsParams) SetKeyword(v string) {
 	return
 }
 
+// SetKeywordIfUnset sets keyword to v, unless it is already set
+func (p *ListPaloAltoFirewallsParams) SetKeywordIfUnset(v string) {
+	if _, found := p.p["keyword"]; !found {
+		p.SetKeyword(v)
+	}
+}
+
 func (p *ListPaloAltoFirewallsParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2122,6 +3722,13 @@ func (p *ListPaloAltoFirewallsParams) SetPage(v int) {
 	return
 }
 
+// SetPageIfUnset sets page to v, unless it is already set
+func (p *ListPaloAltoFirewallsParams) SetPageIfUnset(v int) {
+	if _, found := p.p["page"]; !found {
+		p.SetPage(v)
+	}
+}
+
 func (p *ListPaloAltoFirewallsParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2130,6 +3737,13 @@ func (p *ListPaloAltoFirewallsParams) SetPagesize(v int) {
 	return
 }
 
+// SetPagesizeIfUnset sets pagesize to v, unless it is already set
+func (p *ListPaloAltoFirewallsParams) SetPagesizeIfUnset(v int) {
+	if _, found := p.p["pagesize"]; !found {
+		p.SetPagesize(v)
+	}
+}
+
 func (p *ListPaloAltoFirewallsParams) SetPhysicalnetworkid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2138,17 +3752,43 @@ func (p *ListPaloAltoFirewallsParams) SetPhysicalnetworkid(v string) {
 	return
 }
 
+// SetPhysicalnetworkidIfUnset sets physicalnetworkid to v, unless it is already set
+func (p *ListPaloAltoFirewallsParams) SetPhysicalnetworkidIfUnset(v string) {
+	if _, found := p.p["physicalnetworkid"]; !found {
+		p.SetPhysicalnetworkid(v)
+	}
+}
+
 // You should always use this function to get a new ListPaloAltoFirewallsParams instance,
-// as then you are sure you have configured all required params
-func (s *FirewallService) NewListPaloAltoFirewallsParams() *ListPaloAltoFirewallsParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *FirewallService) NewListPaloAltoFirewallsParams(opts ...func(*ListPaloAltoFirewallsParams)) *ListPaloAltoFirewallsParams {
 	p := &ListPaloAltoFirewallsParams{}
 	p.p = make(map[string]interface{})
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // lists Palo Alto firewall devices in a physical network
 func (s *FirewallService) ListPaloAltoFirewalls(p *ListPaloAltoFirewallsParams) (*ListPaloAltoFirewallsResponse, error) {
-	resp, err := s.cs.newRequest("listPaloAltoFirewalls", p.toURLValues())
+	return s.ListPaloAltoFirewallsWithContext(context.Background(), p)
+}
+
+// ListPaloAltoFirewallsWithContext is the same as ListPaloAltoFirewalls, but the request is cancelled when the context is done
+func (s *FirewallService) ListPaloAltoFirewallsWithContext(ctx context.Context, p *ListPaloAltoFirewallsParams) (*ListPaloAltoFirewallsResponse, error) {
+	return s.ListPaloAltoFirewallsRawWithContext(ctx, p.toURLValues())
+}
+
+// ListPaloAltoFirewallsRaw is the same as ListPaloAltoFirewalls, but takes the params as url.Values instead of typed params
+func (s *FirewallService) ListPaloAltoFirewallsRaw(v url.Values) (*ListPaloAltoFirewallsResponse, error) {
+	return s.ListPaloAltoFirewallsRawWithContext(context.Background(), v)
+}
+
+// ListPaloAltoFirewallsRawWithContext is the same as ListPaloAltoFirewallsRaw, but the request is cancelled when the context is done
+func (s *FirewallService) ListPaloAltoFirewallsRawWithContext(ctx context.Context, v url.Values) (*ListPaloAltoFirewallsResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "listPaloAltoFirewalls", "listpaloaltofirewallsresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -2163,7 +3803,94 @@ func (s *FirewallService) ListPaloAltoFirewalls(p *ListPaloAltoFirewallsParams)
 		return nil, err
 	}
 
-	return &r, nil
+	return &r, nil
+}
+
+// StreamPaloAltoFirewalls fetches the results of ListPaloAltoFirewalls page by page in the background and sends them on the
+// returned channel. Both returned channels are closed when done. If fetching a page fails, or the context
+// is done before all results are sent, the error is sent on the error channel and fetching stops. A
+// request for a page in flight is cancelled when the context is done. The page and pagesize set in the
+// params are ignored.
+func (s *FirewallService) StreamPaloAltoFirewalls(ctx context.Context, p *ListPaloAltoFirewallsParams) (<-chan *PaloAltoFirewall, <-chan error) {
+	ch := make(chan *PaloAltoFirewall)
+	errs := make(chan error, 1)
+
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesize(500)
+
+	go func() {
+		defer close(errs)
+		defer close(ch)
+
+		for page, count := 1, 0; ; page++ {
+			pp.SetPage(page)
+
+			l, err := s.ListPaloAltoFirewallsWithContext(ctx, pp)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			for _, v := range l.PaloAltoFirewalls {
+				select {
+				case ch <- v:
+				case <-ctx.Done():
+					errs <- ctx.Err()
+					return
+				}
+			}
+
+			count += len(l.PaloAltoFirewalls)
+			if len(l.PaloAltoFirewalls) < 500 || count >= l.Count {
+				return
+			}
+		}
+	}()
+
+	return ch, errs
+}
+
+// ListPaloAltoFirewallsWhere returns all results of ListPaloAltoFirewalls for which pred returns true. Note that the results are
+// filtered client-side, so all results matching the params are fetched first.
+// All pages are fetched, so the page and pagesize set in the params are ignored.
+func (s *FirewallService) ListPaloAltoFirewallsWhere(p *ListPaloAltoFirewallsParams, pred func(*PaloAltoFirewall) bool) ([]*PaloAltoFirewall, error) {
+	var r []*PaloAltoFirewall
+	ch, errs := s.StreamPaloAltoFirewalls(context.Background(), p)
+	for v := range ch {
+		if pred(v) {
+			r = append(r, v)
+		}
+	}
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ListAllPaloAltoFirewalls returns the results of ListPaloAltoFirewalls across all pages, by calling ListPaloAltoFirewalls page by page until all
+// results are fetched. The pagesize set in the params is used for every page (500 if not set), and the
+// page set in the params is ignored.
+func (s *FirewallService) ListAllPaloAltoFirewalls(p *ListPaloAltoFirewallsParams) ([]*PaloAltoFirewall, error) {
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesizeIfUnset(500)
+	pagesize := pp.p["pagesize"].(int)
+
+	var r []*PaloAltoFirewall
+	for page := 1; ; page++ {
+		pp.SetPage(page)
+
+		l, err := s.ListPaloAltoFirewalls(pp)
+		if err != nil {
+			return nil, err
+		}
+		r = append(r, l.PaloAltoFirewalls...)
+
+		if len(l.PaloAltoFirewalls) < pagesize || len(r) >= l.Count {
+			return r, nil
+		}
+	}
 }
 
 type ListPaloAltoFirewallsResponse struct {
@@ -2194,6 +3921,16 @@ type ListPortForwardingRulesParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ListPortForwardingRulesParams) Clone() *ListPortForwardingRulesParams {
+	c := &ListPortForwardingRulesParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListPortForwardingRulesParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -2241,11 +3978,10 @@ func (p *ListPortForwardingRulesParams) toURLValues() url.Values {
 		u.Set("projectid", v.(string))
 	}
 	if v, found := p.p["tags"]; found {
-		i := 0
-		for k, vv := range v.(map[string]string) {
+		m := v.(map[string]string)
+		for i, k := range getSortedKeysFromMap(m) {
 			u.Set(fmt.Sprintf("tags[%d].key", i), k)
-			u.Set(fmt.Sprintf("tags[%d].value", i), vv)
-			i++
+			u.Set(fmt.Sprintf("tags[%d].value", i), m[k])
 		}
 	}
 	return u
@@ -2259,6 +3995,13 @@ func (p *ListPortForwardingRulesParams) SetAccount(v string) {
 	return
 }
 
+// SetAccountIfUnset sets account to v, unless it is already set
+func (p *ListPortForwardingRulesParams) SetAccountIfUnset(v string) {
+	if _, found := p.p["account"]; !found {
+		p.SetAccount(v)
+	}
+}
+
 func (p *ListPortForwardingRulesParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2267,6 +4010,13 @@ func (p *ListPortForwardingRulesParams) SetDomainid(v string) {
 	return
 }
 
+// SetDomainidIfUnset sets domainid to v, unless it is already set
+func (p *ListPortForwardingRulesParams) SetDomainidIfUnset(v string) {
+	if _, found := p.p["domainid"]; !found {
+		p.SetDomainid(v)
+	}
+}
+
 func (p *ListPortForwardingRulesParams) SetFordisplay(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2275,6 +4025,13 @@ func (p *ListPortForwardingRulesParams) SetFordisplay(v bool) {
 	return
 }
 
+// SetFordisplayIfUnset sets fordisplay to v, unless it is already set
+func (p *ListPortForwardingRulesParams) SetFordisplayIfUnset(v bool) {
+	if _, found := p.p["fordisplay"]; !found {
+		p.SetFordisplay(v)
+	}
+}
+
 func (p *ListPortForwardingRulesParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2283,6 +4040,13 @@ func (p *ListPortForwardingRulesParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *ListPortForwardingRulesParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 func (p *ListPortForwardingRulesParams) SetIpaddressid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2291,6 +4055,13 @@ func (p *ListPortForwardingRulesParams) SetIpaddressid(v string) {
 	return
 }
 
+// SetIpaddressidIfUnset sets ipaddressid to v, unless it is already set
+func (p *ListPortForwardingRulesParams) SetIpaddressidIfUnset(v string) {
+	if _, found := p.p["ipaddressid"]; !found {
+		p.SetIpaddressid(v)
+	}
+}
+
 func (p *ListPortForwardingRulesParams) SetIsrecursive(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2299,6 +4070,13 @@ func (p *ListPortForwardingRulesParams) SetIsrecursive(v bool) {
 	return
 }
 
+// SetIsrecursiveIfUnset sets isrecursive to v, unless it is already set
+func (p *ListPortForwardingRulesParams) SetIsrecursiveIfUnset(v bool) {
+	if _, found := p.p["isrecursive"]; !found {
+		p.SetIsrecursive(v)
+	}
+}
+
 func (p *ListPortForwardingRulesParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2307,6 +4085,13 @@ func (p *ListPortForwardingRulesParams) SetKeyword(v string) {
 	return
 }
 
+// SetKeywordIfUnset sets keyword to v, unless it is already set
+func (p *ListPortForwardingRulesParams) SetKeywordIfUnset(v string) {
+	if _, found := p.p["keyword"]; !found {
+		p.SetKeyword(v)
+	}
+}
+
 func (p *ListPortForwardingRulesParams) SetListall(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2315,6 +4100,13 @@ func (p *ListPortForwardingRulesParams) SetListall(v bool) {
 	return
 }
 
+// SetListallIfUnset sets listall to v, unless it is already set
+func (p *ListPortForwardingRulesParams) SetListallIfUnset(v bool) {
+	if _, found := p.p["listall"]; !found {
+		p.SetListall(v)
+	}
+}
+
 func (p *ListPortForwardingRulesParams) SetNetworkid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2323,6 +4115,13 @@ func (p *ListPortForwardingRulesParams) SetNetworkid(v string) {
 	return
 }
 
+// SetNetworkidIfUnset sets networkid to v, unless it is already set
+func (p *ListPortForwardingRulesParams) SetNetworkidIfUnset(v string) {
+	if _, found := p.p["networkid"]; !found {
+		p.SetNetworkid(v)
+	}
+}
+
 func (p *ListPortForwardingRulesParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2331,6 +4130,13 @@ func (p *ListPortForwardingRulesParams) SetPage(v int) {
 	return
 }
 
+// SetPageIfUnset sets page to v, unless it is already set
+func (p *ListPortForwardingRulesParams) SetPageIfUnset(v int) {
+	if _, found := p.p["page"]; !found {
+		p.SetPage(v)
+	}
+}
+
 func (p *ListPortForwardingRulesParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2339,6 +4145,13 @@ func (p *ListPortForwardingRulesParams) SetPagesize(v int) {
 	return
 }
 
+// SetPagesizeIfUnset sets pagesize to v, unless it is already set
+func (p *ListPortForwardingRulesParams) SetPagesizeIfUnset(v int) {
+	if _, found := p.p["pagesize"]; !found {
+		p.SetPagesize(v)
+	}
+}
+
 func (p *ListPortForwardingRulesParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2347,6 +4160,13 @@ func (p *ListPortForwardingRulesParams) SetProjectid(v string) {
 	return
 }
 
+// SetProjectidIfUnset sets projectid to v, unless it is already set
+func (p *ListPortForwardingRulesParams) SetProjectidIfUnset(v string) {
+	if _, found := p.p["projectid"]; !found {
+		p.SetProjectid(v)
+	}
+}
+
 func (p *ListPortForwardingRulesParams) SetTags(v map[string]string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2355,14 +4175,34 @@ func (p *ListPortForwardingRulesParams) SetTags(v map[string]string) {
 	return
 }
 
+// SetTagsIfUnset sets tags to v, unless it is already set
+func (p *ListPortForwardingRulesParams) SetTagsIfUnset(v map[string]string) {
+	if _, found := p.p["tags"]; !found {
+		p.SetTags(v)
+	}
+}
+
+var _ ListAllSetter = (*ListPortForwardingRulesParams)(nil)
+
 // You should always use this function to get a new ListPortForwardingRulesParams instance,
-// as then you are sure you have configured all required params
-func (s *FirewallService) NewListPortForwardingRulesParams() *ListPortForwardingRulesParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *FirewallService) NewListPortForwardingRulesParams(opts ...func(*ListPortForwardingRulesParams)) *ListPortForwardingRulesParams {
 	p := &ListPortForwardingRulesParams{}
 	p.p = make(map[string]interface{})
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
+func (p *ListPortForwardingRulesParams) setFirstMatch() {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p[firstMatchKey] = true
+}
+
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *FirewallService) GetPortForwardingRuleByID(id string, opts ...OptionFunc) (*PortForwardingRule, int, error) {
 	p := &ListPortForwardingRulesParams{}
@@ -2370,7 +4210,7 @@ func (s *FirewallService) GetPortForwardingRuleByID(id string, opts ...OptionFun
 
 	p.p["id"] = id
 
-	for _, fn := range append(s.cs.options, opts...) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
 		if err := fn(s.cs, p); err != nil {
 			return nil, -1, err
 		}
@@ -2381,24 +4221,56 @@ func (s *FirewallService) GetPortForwardingRuleByID(id string, opts ...OptionFun
 		if strings.Contains(err.Error(), fmt.Sprintf(
 			"Invalid parameter id value=%s due to incorrect long value format, "+
 				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+			return nil, 0, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
 	}
 
 	if l.Count == 1 {
 		return l.PortForwardingRules[0], l.Count, nil
 	}
+
+	if _, ok := p.p[firstMatchKey]; ok && len(l.PortForwardingRules) > 0 {
+		return l.PortForwardingRules[0], l.Count, nil
+	}
 	return nil, l.Count, fmt.Errorf("There is more then one result for PortForwardingRule UUID: %s!", id)
 }
 
+// PortForwardingRuleExists returns true if a PortForwardingRule with the given ID exists. When no match is found
+// it returns false, while any other error is returned as is.
+func (s *FirewallService) PortForwardingRuleExists(id string, opts ...OptionFunc) (bool, error) {
+	_, count, err := s.GetPortForwardingRuleByID(id, opts...)
+	if errors.Is(err, ErrNotFound) {
+		return false, nil
+	}
+	if count > 0 {
+		return true, nil
+	}
+	return false, err
+}
+
 // Lists all port forwarding rules for an IP address.
 func (s *FirewallService) ListPortForwardingRules(p *ListPortForwardingRulesParams) (*ListPortForwardingRulesResponse, error) {
-	resp, err := s.cs.newRequest("listPortForwardingRules", p.toURLValues())
+	return s.ListPortForwardingRulesWithContext(context.Background(), p)
+}
+
+// ListPortForwardingRulesWithContext is the same as ListPortForwardingRules, but the request is cancelled when the context is done
+func (s *FirewallService) ListPortForwardingRulesWithContext(ctx context.Context, p *ListPortForwardingRulesParams) (*ListPortForwardingRulesResponse, error) {
+	return s.ListPortForwardingRulesRawWithContext(ctx, p.toURLValues())
+}
+
+// ListPortForwardingRulesRaw is the same as ListPortForwardingRules, but takes the params as url.Values instead of typed params
+func (s *FirewallService) ListPortForwardingRulesRaw(v url.Values) (*ListPortForwardingRulesResponse, error) {
+	return s.ListPortForwardingRulesRawWithContext(context.Background(), v)
+}
+
+// ListPortForwardingRulesRawWithContext is the same as ListPortForwardingRulesRaw, but the request is cancelled when the context is done
+func (s *FirewallService) ListPortForwardingRulesRawWithContext(ctx context.Context, v url.Values) (*ListPortForwardingRulesResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "listPortForwardingRules", "listportforwardingrulesresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -2416,6 +4288,93 @@ func (s *FirewallService) ListPortForwardingRules(p *ListPortForwardingRulesPara
 	return &r, nil
 }
 
+// StreamPortForwardingRules fetches the results of ListPortForwardingRules page by page in the background and sends them on the
+// returned channel. Both returned channels are closed when done. If fetching a page fails, or the context
+// is done before all results are sent, the error is sent on the error channel and fetching stops. A
+// request for a page in flight is cancelled when the context is done. The page and pagesize set in the
+// params are ignored.
+func (s *FirewallService) StreamPortForwardingRules(ctx context.Context, p *ListPortForwardingRulesParams) (<-chan *PortForwardingRule, <-chan error) {
+	ch := make(chan *PortForwardingRule)
+	errs := make(chan error, 1)
+
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesize(500)
+
+	go func() {
+		defer close(errs)
+		defer close(ch)
+
+		for page, count := 1, 0; ; page++ {
+			pp.SetPage(page)
+
+			l, err := s.ListPortForwardingRulesWithContext(ctx, pp)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			for _, v := range l.PortForwardingRules {
+				select {
+				case ch <- v:
+				case <-ctx.Done():
+					errs <- ctx.Err()
+					return
+				}
+			}
+
+			count += len(l.PortForwardingRules)
+			if len(l.PortForwardingRules) < 500 || count >= l.Count {
+				return
+			}
+		}
+	}()
+
+	return ch, errs
+}
+
+// ListPortForwardingRulesWhere returns all results of ListPortForwardingRules for which pred returns true. Note that the results are
+// filtered client-side, so all results matching the params are fetched first.
+// All pages are fetched, so the page and pagesize set in the params are ignored.
+func (s *FirewallService) ListPortForwardingRulesWhere(p *ListPortForwardingRulesParams, pred func(*PortForwardingRule) bool) ([]*PortForwardingRule, error) {
+	var r []*PortForwardingRule
+	ch, errs := s.StreamPortForwardingRules(context.Background(), p)
+	for v := range ch {
+		if pred(v) {
+			r = append(r, v)
+		}
+	}
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ListAllPortForwardingRules returns the results of ListPortForwardingRules across all pages, by calling ListPortForwardingRules page by page until all
+// results are fetched. The pagesize set in the params is used for every page (500 if not set), and the
+// page set in the params is ignored.
+func (s *FirewallService) ListAllPortForwardingRules(p *ListPortForwardingRulesParams) ([]*PortForwardingRule, error) {
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesizeIfUnset(500)
+	pagesize := pp.p["pagesize"].(int)
+
+	var r []*PortForwardingRule
+	for page := 1; ; page++ {
+		pp.SetPage(page)
+
+		l, err := s.ListPortForwardingRules(pp)
+		if err != nil {
+			return nil, err
+		}
+		r = append(r, l.PortForwardingRules...)
+
+		if len(l.PortForwardingRules) < pagesize || len(r) >= l.Count {
+			return r, nil
+		}
+	}
+}
+
 type ListPortForwardingRulesResponse struct {
 	Count               int                   `json:"count"`
 	PortForwardingRules []*PortForwardingRule `json:"portforwardingrule"`
@@ -2456,6 +4415,16 @@ type ListSrxFirewallsParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ListSrxFirewallsParams) Clone() *ListSrxFirewallsParams {
+	c := &ListSrxFirewallsParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListSrxFirewallsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -2489,6 +4458,13 @@ func (p *ListSrxFirewallsParams) SetFwdeviceid(v string) {
 	return
 }
 
+// SetFwdeviceidIfUnset sets fwdeviceid to v, unless it is already set
+func (p *ListSrxFirewallsParams) SetFwdeviceidIfUnset(v string) {
+	if _, found := p.p["fwdeviceid"]; !found {
+		p.SetFwdeviceid(v)
+	}
+}
+
 func (p *ListSrxFirewallsParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2497,6 +4473,13 @@ func (p *ListSrxFirewallsParams) SetKeyword(v string) {
 	return
 }
 
+// SetKeywordIfUnset sets keyword to v, unless it is already set
+func (p *ListSrxFirewallsParams) SetKeywordIfUnset(v string) {
+	if _, found := p.p["keyword"]; !found {
+		p.SetKeyword(v)
+	}
+}
+
 func (p *ListSrxFirewallsParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2505,6 +4488,13 @@ func (p *ListSrxFirewallsParams) SetPage(v int) {
 	return
 }
 
+// SetPageIfUnset sets page to v, unless it is already set
+func (p *ListSrxFirewallsParams) SetPageIfUnset(v int) {
+	if _, found := p.p["page"]; !found {
+		p.SetPage(v)
+	}
+}
+
 func (p *ListSrxFirewallsParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2513,6 +4503,13 @@ func (p *ListSrxFirewallsParams) SetPagesize(v int) {
 	return
 }
 
+// SetPagesizeIfUnset sets pagesize to v, unless it is already set
+func (p *ListSrxFirewallsParams) SetPagesizeIfUnset(v int) {
+	if _, found := p.p["pagesize"]; !found {
+		p.SetPagesize(v)
+	}
+}
+
 func (p *ListSrxFirewallsParams) SetPhysicalnetworkid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2521,17 +4518,43 @@ func (p *ListSrxFirewallsParams) SetPhysicalnetworkid(v string) {
 	return
 }
 
+// SetPhysicalnetworkidIfUnset sets physicalnetworkid to v, unless it is already set
+func (p *ListSrxFirewallsParams) SetPhysicalnetworkidIfUnset(v string) {
+	if _, found := p.p["physicalnetworkid"]; !found {
+		p.SetPhysicalnetworkid(v)
+	}
+}
+
 // You should always use this function to get a new ListSrxFirewallsParams instance,
-// as then you are sure you have configured all required params
-func (s *FirewallService) NewListSrxFirewallsParams() *ListSrxFirewallsParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *FirewallService) NewListSrxFirewallsParams(opts ...func(*ListSrxFirewallsParams)) *ListSrxFirewallsParams {
 	p := &ListSrxFirewallsParams{}
 	p.p = make(map[string]interface{})
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // lists SRX firewall devices in a physical network
 func (s *FirewallService) ListSrxFirewalls(p *ListSrxFirewallsParams) (*ListSrxFirewallsResponse, error) {
-	resp, err := s.cs.newRequest("listSrxFirewalls", p.toURLValues())
+	return s.ListSrxFirewallsWithContext(context.Background(), p)
+}
+
+// ListSrxFirewallsWithContext is the same as ListSrxFirewalls, but the request is cancelled when the context is done
+func (s *FirewallService) ListSrxFirewallsWithContext(ctx context.Context, p *ListSrxFirewallsParams) (*ListSrxFirewallsResponse, error) {
+	return s.ListSrxFirewallsRawWithContext(ctx, p.toURLValues())
+}
+
+// ListSrxFirewallsRaw is the same as ListSrxFirewalls, but takes the params as url.Values instead of typed params
+func (s *FirewallService) ListSrxFirewallsRaw(v url.Values) (*ListSrxFirewallsResponse, error) {
+	return s.ListSrxFirewallsRawWithContext(context.Background(), v)
+}
+
+// ListSrxFirewallsRawWithContext is the same as ListSrxFirewallsRaw, but the request is cancelled when the context is done
+func (s *FirewallService) ListSrxFirewallsRawWithContext(ctx context.Context, v url.Values) (*ListSrxFirewallsResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "listSrxFirewalls", "listsrxfirewallsresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -2549,6 +4572,93 @@ func (s *FirewallService) ListSrxFirewalls(p *ListSrxFirewallsParams) (*ListSrxF
 	return &r, nil
 }
 
+// StreamSrxFirewalls fetches the results of ListSrxFirewalls page by page in the background and sends them on the
+// returned channel. Both returned channels are closed when done. If fetching a page fails, or the context
+// is done before all results are sent, the error is sent on the error channel and fetching stops. A
+// request for a page in flight is cancelled when the context is done. The page and pagesize set in the
+// params are ignored.
+func (s *FirewallService) StreamSrxFirewalls(ctx context.Context, p *ListSrxFirewallsParams) (<-chan *SrxFirewall, <-chan error) {
+	ch := make(chan *SrxFirewall)
+	errs := make(chan error, 1)
+
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesize(500)
+
+	go func() {
+		defer close(errs)
+		defer close(ch)
+
+		for page, count := 1, 0; ; page++ {
+			pp.SetPage(page)
+
+			l, err := s.ListSrxFirewallsWithContext(ctx, pp)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			for _, v := range l.SrxFirewalls {
+				select {
+				case ch <- v:
+				case <-ctx.Done():
+					errs <- ctx.Err()
+					return
+				}
+			}
+
+			count += len(l.SrxFirewalls)
+			if len(l.SrxFirewalls) < 500 || count >= l.Count {
+				return
+			}
+		}
+	}()
+
+	return ch, errs
+}
+
+// ListSrxFirewallsWhere returns all results of ListSrxFirewalls for which pred returns true. Note that the results are
+// filtered client-side, so all results matching the params are fetched first.
+// All pages are fetched, so the page and pagesize set in the params are ignored.
+func (s *FirewallService) ListSrxFirewallsWhere(p *ListSrxFirewallsParams, pred func(*SrxFirewall) bool) ([]*SrxFirewall, error) {
+	var r []*SrxFirewall
+	ch, errs := s.StreamSrxFirewalls(context.Background(), p)
+	for v := range ch {
+		if pred(v) {
+			r = append(r, v)
+		}
+	}
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ListAllSrxFirewalls returns the results of ListSrxFirewalls across all pages, by calling ListSrxFirewalls page by page until all
+// results are fetched. The pagesize set in the params is used for every page (500 if not set), and the
+// page set in the params is ignored.
+func (s *FirewallService) ListAllSrxFirewalls(p *ListSrxFirewallsParams) ([]*SrxFirewall, error) {
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesizeIfUnset(500)
+	pagesize := pp.p["pagesize"].(int)
+
+	var r []*SrxFirewall
+	for page := 1; ; page++ {
+		pp.SetPage(page)
+
+		l, err := s.ListSrxFirewalls(pp)
+		if err != nil {
+			return nil, err
+		}
+		r = append(r, l.SrxFirewalls...)
+
+		if len(l.SrxFirewalls) < pagesize || len(r) >= l.Count {
+			return r, nil
+		}
+	}
+}
+
 type ListSrxFirewallsResponse struct {
 	Count        int            `json:"count"`
 	SrxFirewalls []*SrxFirewall `json:"srxfirewall"`
@@ -2577,6 +4687,16 @@ type UpdateEgressFirewallRuleParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *UpdateEgressFirewallRuleParams) Clone() *UpdateEgressFirewallRuleParams {
+	c := &UpdateEgressFirewallRuleParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *UpdateEgressFirewallRuleParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -2595,6 +4715,16 @@ func (p *UpdateEgressFirewallRuleParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *UpdateEgressFirewallRuleParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of updateEgressFirewallRule", k)
+		}
+	}
+	return nil
+}
+
 func (p *UpdateEgressFirewallRuleParams) SetCustomid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2603,6 +4733,13 @@ func (p *UpdateEgressFirewallRuleParams) SetCustomid(v string) {
 	return
 }
 
+// SetCustomidIfUnset sets customid to v, unless it is already set
+func (p *UpdateEgressFirewallRuleParams) SetCustomidIfUnset(v string) {
+	if _, found := p.p["customid"]; !found {
+		p.SetCustomid(v)
+	}
+}
+
 func (p *UpdateEgressFirewallRuleParams) SetFordisplay(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2611,6 +4748,13 @@ func (p *UpdateEgressFirewallRuleParams) SetFordisplay(v bool) {
 	return
 }
 
+// SetFordisplayIfUnset sets fordisplay to v, unless it is already set
+func (p *UpdateEgressFirewallRuleParams) SetFordisplayIfUnset(v bool) {
+	if _, found := p.p["fordisplay"]; !found {
+		p.SetFordisplay(v)
+	}
+}
+
 func (p *UpdateEgressFirewallRuleParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2619,18 +4763,54 @@ func (p *UpdateEgressFirewallRuleParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *UpdateEgressFirewallRuleParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 // You should always use this function to get a new UpdateEgressFirewallRuleParams instance,
-// as then you are sure you have configured all required params
-func (s *FirewallService) NewUpdateEgressFirewallRuleParams(id string) *UpdateEgressFirewallRuleParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *FirewallService) NewUpdateEgressFirewallRuleParams(id string, opts ...func(*UpdateEgressFirewallRuleParams)) *UpdateEgressFirewallRuleParams {
 	p := &UpdateEgressFirewallRuleParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Updates egress firewall rule
-func (s *FirewallService) UpdateEgressFirewallRule(p *UpdateEgressFirewallRuleParams) (*UpdateEgressFirewallRuleResponse, error) {
-	resp, err := s.cs.newRequest("updateEgressFirewallRule", p.toURLValues())
+func (s *FirewallService) UpdateEgressFirewallRule(p *UpdateEgressFirewallRuleParams, opts ...OptionFunc) (*UpdateEgressFirewallRuleResponse, error) {
+	return s.UpdateEgressFirewallRuleWithContext(context.Background(), p, opts...)
+}
+
+// UpdateEgressFirewallRuleWithContext is the same as UpdateEgressFirewallRule, but the request is cancelled when the context is done
+func (s *FirewallService) UpdateEgressFirewallRuleWithContext(ctx context.Context, p *UpdateEgressFirewallRuleParams, opts ...OptionFunc) (*UpdateEgressFirewallRuleResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.UpdateEgressFirewallRuleRawWithContext(ctx, p.toURLValues())
+}
+
+// UpdateEgressFirewallRuleRaw is the same as UpdateEgressFirewallRule, but takes the params as url.Values instead of typed params
+func (s *FirewallService) UpdateEgressFirewallRuleRaw(v url.Values) (*UpdateEgressFirewallRuleResponse, error) {
+	return s.UpdateEgressFirewallRuleRawWithContext(context.Background(), v)
+}
+
+// UpdateEgressFirewallRuleRawWithContext is the same as UpdateEgressFirewallRuleRaw, but the request is cancelled when the context is done
+func (s *FirewallService) UpdateEgressFirewallRuleRawWithContext(ctx context.Context, v url.Values) (*UpdateEgressFirewallRuleResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "updateEgressFirewallRule", "updateegressfirewallruleresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -2642,9 +4822,9 @@ func (s *FirewallService) UpdateEgressFirewallRule(p *UpdateEgressFirewallRulePa
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -2700,6 +4880,16 @@ type UpdateFirewallRuleParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *UpdateFirewallRuleParams) Clone() *UpdateFirewallRuleParams {
+	c := &UpdateFirewallRuleParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *UpdateFirewallRuleParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -2718,6 +4908,16 @@ func (p *UpdateFirewallRuleParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *UpdateFirewallRuleParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of updateFirewallRule", k)
+		}
+	}
+	return nil
+}
+
 func (p *UpdateFirewallRuleParams) SetCustomid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2726,6 +4926,13 @@ func (p *UpdateFirewallRuleParams) SetCustomid(v string) {
 	return
 }
 
+// SetCustomidIfUnset sets customid to v, unless it is already set
+func (p *UpdateFirewallRuleParams) SetCustomidIfUnset(v string) {
+	if _, found := p.p["customid"]; !found {
+		p.SetCustomid(v)
+	}
+}
+
 func (p *UpdateFirewallRuleParams) SetFordisplay(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2734,6 +4941,13 @@ func (p *UpdateFirewallRuleParams) SetFordisplay(v bool) {
 	return
 }
 
+// SetFordisplayIfUnset sets fordisplay to v, unless it is already set
+func (p *UpdateFirewallRuleParams) SetFordisplayIfUnset(v bool) {
+	if _, found := p.p["fordisplay"]; !found {
+		p.SetFordisplay(v)
+	}
+}
+
 func (p *UpdateFirewallRuleParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2742,18 +4956,54 @@ func (p *UpdateFirewallRuleParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *UpdateFirewallRuleParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 // You should always use this function to get a new UpdateFirewallRuleParams instance,
-// as then you are sure you have configured all required params
-func (s *FirewallService) NewUpdateFirewallRuleParams(id string) *UpdateFirewallRuleParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *FirewallService) NewUpdateFirewallRuleParams(id string, opts ...func(*UpdateFirewallRuleParams)) *UpdateFirewallRuleParams {
 	p := &UpdateFirewallRuleParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Updates firewall rule
-func (s *FirewallService) UpdateFirewallRule(p *UpdateFirewallRuleParams) (*UpdateFirewallRuleResponse, error) {
-	resp, err := s.cs.newRequest("updateFirewallRule", p.toURLValues())
+func (s *FirewallService) UpdateFirewallRule(p *UpdateFirewallRuleParams, opts ...OptionFunc) (*UpdateFirewallRuleResponse, error) {
+	return s.UpdateFirewallRuleWithContext(context.Background(), p, opts...)
+}
+
+// UpdateFirewallRuleWithContext is the same as UpdateFirewallRule, but the request is cancelled when the context is done
+func (s *FirewallService) UpdateFirewallRuleWithContext(ctx context.Context, p *UpdateFirewallRuleParams, opts ...OptionFunc) (*UpdateFirewallRuleResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.UpdateFirewallRuleRawWithContext(ctx, p.toURLValues())
+}
+
+// UpdateFirewallRuleRaw is the same as UpdateFirewallRule, but takes the params as url.Values instead of typed params
+func (s *FirewallService) UpdateFirewallRuleRaw(v url.Values) (*UpdateFirewallRuleResponse, error) {
+	return s.UpdateFirewallRuleRawWithContext(context.Background(), v)
+}
+
+// UpdateFirewallRuleRawWithContext is the same as UpdateFirewallRuleRaw, but the request is cancelled when the context is done
+func (s *FirewallService) UpdateFirewallRuleRawWithContext(ctx context.Context, v url.Values) (*UpdateFirewallRuleResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "updateFirewallRule", "updatefirewallruleresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -2765,9 +5015,9 @@ func (s *FirewallService) UpdateFirewallRule(p *UpdateFirewallRuleParams) (*Upda
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -2823,6 +5073,16 @@ type UpdatePortForwardingRuleParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *UpdatePortForwardingRuleParams) Clone() *UpdatePortForwardingRuleParams {
+	c := &UpdatePortForwardingRuleParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *UpdatePortForwardingRuleParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -2851,6 +5111,16 @@ func (p *UpdatePortForwardingRuleParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *UpdatePortForwardingRuleParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of updatePortForwardingRule", k)
+		}
+	}
+	return nil
+}
+
 func (p *UpdatePortForwardingRuleParams) SetCustomid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2859,6 +5129,13 @@ func (p *UpdatePortForwardingRuleParams) SetCustomid(v string) {
 	return
 }
 
+// SetCustomidIfUnset sets customid to v, unless it is already set
+func (p *UpdatePortForwardingRuleParams) SetCustomidIfUnset(v string) {
+	if _, found := p.p["customid"]; !found {
+		p.SetCustomid(v)
+	}
+}
+
 func (p *UpdatePortForwardingRuleParams) SetFordisplay(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2867,6 +5144,13 @@ func (p *UpdatePortForwardingRuleParams) SetFordisplay(v bool) {
 	return
 }
 
+// SetFordisplayIfUnset sets fordisplay to v, unless it is already set
+func (p *UpdatePortForwardingRuleParams) SetFordisplayIfUnset(v bool) {
+	if _, found := p.p["fordisplay"]; !found {
+		p.SetFordisplay(v)
+	}
+}
+
 func (p *UpdatePortForwardingRuleParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2875,6 +5159,13 @@ func (p *UpdatePortForwardingRuleParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *UpdatePortForwardingRuleParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 func (p *UpdatePortForwardingRuleParams) SetPrivateport(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2883,6 +5174,13 @@ func (p *UpdatePortForwardingRuleParams) SetPrivateport(v int) {
 	return
 }
 
+// SetPrivateportIfUnset sets privateport to v, unless it is already set
+func (p *UpdatePortForwardingRuleParams) SetPrivateportIfUnset(v int) {
+	if _, found := p.p["privateport"]; !found {
+		p.SetPrivateport(v)
+	}
+}
+
 func (p *UpdatePortForwardingRuleParams) SetVirtualmachineid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2891,6 +5189,13 @@ func (p *UpdatePortForwardingRuleParams) SetVirtualmachineid(v string) {
 	return
 }
 
+// SetVirtualmachineidIfUnset sets virtualmachineid to v, unless it is already set
+func (p *UpdatePortForwardingRuleParams) SetVirtualmachineidIfUnset(v string) {
+	if _, found := p.p["virtualmachineid"]; !found {
+		p.SetVirtualmachineid(v)
+	}
+}
+
 func (p *UpdatePortForwardingRuleParams) SetVmguestip(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2899,18 +5204,54 @@ func (p *UpdatePortForwardingRuleParams) SetVmguestip(v string) {
 	return
 }
 
+// SetVmguestipIfUnset sets vmguestip to v, unless it is already set
+func (p *UpdatePortForwardingRuleParams) SetVmguestipIfUnset(v string) {
+	if _, found := p.p["vmguestip"]; !found {
+		p.SetVmguestip(v)
+	}
+}
+
 // You should always use this function to get a new UpdatePortForwardingRuleParams instance,
-// as then you are sure you have configured all required params
-func (s *FirewallService) NewUpdatePortForwardingRuleParams(id string) *UpdatePortForwardingRuleParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *FirewallService) NewUpdatePortForwardingRuleParams(id string, opts ...func(*UpdatePortForwardingRuleParams)) *UpdatePortForwardingRuleParams {
 	p := &UpdatePortForwardingRuleParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Updates a port forwarding rule. Only the private port and the virtual machine can be updated.
-func (s *FirewallService) UpdatePortForwardingRule(p *UpdatePortForwardingRuleParams) (*UpdatePortForwardingRuleResponse, error) {
-	resp, err := s.cs.newRequest("updatePortForwardingRule", p.toURLValues())
+func (s *FirewallService) UpdatePortForwardingRule(p *UpdatePortForwardingRuleParams, opts ...OptionFunc) (*UpdatePortForwardingRuleResponse, error) {
+	return s.UpdatePortForwardingRuleWithContext(context.Background(), p, opts...)
+}
+
+// UpdatePortForwardingRuleWithContext is the same as UpdatePortForwardingRule, but the request is cancelled when the context is done
+func (s *FirewallService) UpdatePortForwardingRuleWithContext(ctx context.Context, p *UpdatePortForwardingRuleParams, opts ...OptionFunc) (*UpdatePortForwardingRuleResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.UpdatePortForwardingRuleRawWithContext(ctx, p.toURLValues())
+}
+
+// UpdatePortForwardingRuleRaw is the same as UpdatePortForwardingRule, but takes the params as url.Values instead of typed params
+func (s *FirewallService) UpdatePortForwardingRuleRaw(v url.Values) (*UpdatePortForwardingRuleResponse, error) {
+	return s.UpdatePortForwardingRuleRawWithContext(context.Background(), v)
+}
+
+// UpdatePortForwardingRuleRawWithContext is the same as UpdatePortForwardingRuleRaw, but the request is cancelled when the context is done
+func (s *FirewallService) UpdatePortForwardingRuleRawWithContext(ctx context.Context, v url.Values) (*UpdatePortForwardingRuleResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "updatePortForwardingRule", "updateportforwardingruleresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -2922,9 +5263,9 @@ func (s *FirewallService) UpdatePortForwardingRule(p *UpdatePortForwardingRulePa
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -17,17 +17,83 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/url"
 	"strconv"
 	"strings"
 )
 
+// OSTypeName returns the description of the OS type with the given ID. All OS types are listed
+// once and cached on the service, use RefreshOSTypes to list them again.
+func (s *GuestOSService) OSTypeName(id string) (string, error) {
+	osTypes, err := s.osTypes()
+	if err != nil {
+		return "", err
+	}
+
+	for _, o := range osTypes {
+		if o.Id == id {
+			return o.Description, nil
+		}
+	}
+	return "", fmt.Errorf("%w for OS type ID %s", ErrNotFound, id)
+}
+
+// OSTypeID returns the ID of the OS type with the given description, ignoring case. All OS types
+// are listed once and cached on the service, use RefreshOSTypes to list them again.
+func (s *GuestOSService) OSTypeID(description string) (string, error) {
+	osTypes, err := s.osTypes()
+	if err != nil {
+		return "", err
+	}
+
+	for _, o := range osTypes {
+		if strings.EqualFold(o.Description, description) {
+			return o.Id, nil
+		}
+	}
+	return "", fmt.Errorf("%w for OS type %s", ErrNotFound, description)
+}
+
+// RefreshOSTypes lists all OS types again and replaces the cached OS types
+func (s *GuestOSService) RefreshOSTypes() error {
+	s.cache.invalidate()
+	_, err := s.osTypes()
+	return err
+}
+
+func (s *GuestOSService) osTypes() ([]*OsType, error) {
+	if v, found := s.cache.get("ostypes"); found {
+		return v.([]*OsType), nil
+	}
+
+	l, err := s.ListOsTypes(s.NewListOsTypesParams())
+	if err != nil {
+		return nil, err
+	}
+
+	s.cache.set("ostypes", l.OsTypes)
+
+	return l.OsTypes, nil
+}
+
 type AddGuestOsParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *AddGuestOsParams) Clone() *AddGuestOsParams {
+	c := &AddGuestOsParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *AddGuestOsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -45,6 +111,16 @@ func (p *AddGuestOsParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *AddGuestOsParams) validate() error {
+	for _, k := range []string{"oscategoryid", "osdisplayname"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of addGuestOs", k)
+		}
+	}
+	return nil
+}
+
 func (p *AddGuestOsParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -53,6 +129,13 @@ func (p *AddGuestOsParams) SetName(v string) {
 	return
 }
 
+// SetNameIfUnset sets name to v, unless it is already set
+func (p *AddGuestOsParams) SetNameIfUnset(v string) {
+	if _, found := p.p["name"]; !found {
+		p.SetName(v)
+	}
+}
+
 func (p *AddGuestOsParams) SetOscategoryid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -61,6 +144,13 @@ func (p *AddGuestOsParams) SetOscategoryid(v string) {
 	return
 }
 
+// SetOscategoryidIfUnset sets oscategoryid to v, unless it is already set
+func (p *AddGuestOsParams) SetOscategoryidIfUnset(v string) {
+	if _, found := p.p["oscategoryid"]; !found {
+		p.SetOscategoryid(v)
+	}
+}
+
 func (p *AddGuestOsParams) SetOsdisplayname(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -69,19 +159,55 @@ func (p *AddGuestOsParams) SetOsdisplayname(v string) {
 	return
 }
 
+// SetOsdisplaynameIfUnset sets osdisplayname to v, unless it is already set
+func (p *AddGuestOsParams) SetOsdisplaynameIfUnset(v string) {
+	if _, found := p.p["osdisplayname"]; !found {
+		p.SetOsdisplayname(v)
+	}
+}
+
 // You should always use this function to get a new AddGuestOsParams instance,
-// as then you are sure you have configured all required params
-func (s *GuestOSService) NewAddGuestOsParams(oscategoryid string, osdisplayname string) *AddGuestOsParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *GuestOSService) NewAddGuestOsParams(oscategoryid string, osdisplayname string, opts ...func(*AddGuestOsParams)) *AddGuestOsParams {
 	p := &AddGuestOsParams{}
 	p.p = make(map[string]interface{})
 	p.p["oscategoryid"] = oscategoryid
 	p.p["osdisplayname"] = osdisplayname
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Add a new guest OS type
-func (s *GuestOSService) AddGuestOs(p *AddGuestOsParams) (*AddGuestOsResponse, error) {
-	resp, err := s.cs.newRequest("addGuestOs", p.toURLValues())
+func (s *GuestOSService) AddGuestOs(p *AddGuestOsParams, opts ...OptionFunc) (*AddGuestOsResponse, error) {
+	return s.AddGuestOsWithContext(context.Background(), p, opts...)
+}
+
+// AddGuestOsWithContext is the same as AddGuestOs, but the request is cancelled when the context is done
+func (s *GuestOSService) AddGuestOsWithContext(ctx context.Context, p *AddGuestOsParams, opts ...OptionFunc) (*AddGuestOsResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.AddGuestOsRawWithContext(ctx, p.toURLValues())
+}
+
+// AddGuestOsRaw is the same as AddGuestOs, but takes the params as url.Values instead of typed params
+func (s *GuestOSService) AddGuestOsRaw(v url.Values) (*AddGuestOsResponse, error) {
+	return s.AddGuestOsRawWithContext(context.Background(), v)
+}
+
+// AddGuestOsRawWithContext is the same as AddGuestOsRaw, but the request is cancelled when the context is done
+func (s *GuestOSService) AddGuestOsRawWithContext(ctx context.Context, v url.Values) (*AddGuestOsResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "addGuestOs", "addguestosresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -93,9 +219,9 @@ func (s *GuestOSService) AddGuestOs(p *AddGuestOsParams) (*AddGuestOsResponse, e
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -126,6 +252,16 @@ type AddGuestOsMappingParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *AddGuestOsMappingParams) Clone() *AddGuestOsMappingParams {
+	c := &AddGuestOsMappingParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *AddGuestOsMappingParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -149,14 +285,31 @@ func (p *AddGuestOsMappingParams) toURLValues() url.Values {
 	return u
 }
 
-func (p *AddGuestOsMappingParams) SetHypervisor(v string) {
+// Returns an error naming the first required param which is not set
+func (p *AddGuestOsMappingParams) validate() error {
+	for _, k := range []string{"hypervisor", "hypervisorversion", "osnameforhypervisor"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of addGuestOsMapping", k)
+		}
+	}
+	return nil
+}
+
+func (p *AddGuestOsMappingParams) SetHypervisor(v HypervisorType) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	p.p["hypervisor"] = v
+	p.p["hypervisor"] = string(v)
 	return
 }
 
+// SetHypervisorIfUnset sets hypervisor to v, unless it is already set
+func (p *AddGuestOsMappingParams) SetHypervisorIfUnset(v HypervisorType) {
+	if _, found := p.p["hypervisor"]; !found {
+		p.SetHypervisor(v)
+	}
+}
+
 func (p *AddGuestOsMappingParams) SetHypervisorversion(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -165,6 +318,13 @@ func (p *AddGuestOsMappingParams) SetHypervisorversion(v string) {
 	return
 }
 
+// SetHypervisorversionIfUnset sets hypervisorversion to v, unless it is already set
+func (p *AddGuestOsMappingParams) SetHypervisorversionIfUnset(v string) {
+	if _, found := p.p["hypervisorversion"]; !found {
+		p.SetHypervisorversion(v)
+	}
+}
+
 func (p *AddGuestOsMappingParams) SetOsdisplayname(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -173,6 +333,13 @@ func (p *AddGuestOsMappingParams) SetOsdisplayname(v string) {
 	return
 }
 
+// SetOsdisplaynameIfUnset sets osdisplayname to v, unless it is already set
+func (p *AddGuestOsMappingParams) SetOsdisplaynameIfUnset(v string) {
+	if _, found := p.p["osdisplayname"]; !found {
+		p.SetOsdisplayname(v)
+	}
+}
+
 func (p *AddGuestOsMappingParams) SetOsnameforhypervisor(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -181,6 +348,13 @@ func (p *AddGuestOsMappingParams) SetOsnameforhypervisor(v string) {
 	return
 }
 
+// SetOsnameforhypervisorIfUnset sets osnameforhypervisor to v, unless it is already set
+func (p *AddGuestOsMappingParams) SetOsnameforhypervisorIfUnset(v string) {
+	if _, found := p.p["osnameforhypervisor"]; !found {
+		p.SetOsnameforhypervisor(v)
+	}
+}
+
 func (p *AddGuestOsMappingParams) SetOstypeid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -189,20 +363,79 @@ func (p *AddGuestOsMappingParams) SetOstypeid(v string) {
 	return
 }
 
+// SetOstypeidIfUnset sets ostypeid to v, unless it is already set
+func (p *AddGuestOsMappingParams) SetOstypeidIfUnset(v string) {
+	if _, found := p.p["ostypeid"]; !found {
+		p.SetOstypeid(v)
+	}
+}
+
 // You should always use this function to get a new AddGuestOsMappingParams instance,
-// as then you are sure you have configured all required params
-func (s *GuestOSService) NewAddGuestOsMappingParams(hypervisor string, hypervisorversion string, osnameforhypervisor string) *AddGuestOsMappingParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *GuestOSService) NewAddGuestOsMappingParams(hypervisor string, hypervisorversion string, osnameforhypervisor string, opts ...func(*AddGuestOsMappingParams)) *AddGuestOsMappingParams {
 	p := &AddGuestOsMappingParams{}
 	p.p = make(map[string]interface{})
 	p.p["hypervisor"] = hypervisor
 	p.p["hypervisorversion"] = hypervisorversion
 	p.p["osnameforhypervisor"] = osnameforhypervisor
+	for _, fn := range opts {
+		fn(p)
+	}
+	return p
+}
+
+// AddGuestOsMappingOpts contains all params that can be set on a AddGuestOsMappingParams.
+// Optional params are pointers (or slices and maps) which are only set when not nil.
+type AddGuestOsMappingOpts struct {
+	Hypervisor          HypervisorType
+	Hypervisorversion   string
+	Osdisplayname       *string
+	Osnameforhypervisor string
+	Ostypeid            *string
+}
+
+// NewAddGuestOsMappingParamsFromOpts is an alternative for NewAddGuestOsMappingParams which takes all params using
+// an options struct, as that is easier to read for APIs with many required params
+func (s *GuestOSService) NewAddGuestOsMappingParamsFromOpts(o AddGuestOsMappingOpts) *AddGuestOsMappingParams {
+	p := s.NewAddGuestOsMappingParams(string(o.Hypervisor), o.Hypervisorversion, o.Osnameforhypervisor)
+	if o.Osdisplayname != nil {
+		p.p["osdisplayname"] = *o.Osdisplayname
+	}
+	if o.Ostypeid != nil {
+		p.p["ostypeid"] = *o.Ostypeid
+	}
 	return p
 }
 
 // Adds a guest OS name to hypervisor OS name mapping
-func (s *GuestOSService) AddGuestOsMapping(p *AddGuestOsMappingParams) (*AddGuestOsMappingResponse, error) {
-	resp, err := s.cs.newRequest("addGuestOsMapping", p.toURLValues())
+func (s *GuestOSService) AddGuestOsMapping(p *AddGuestOsMappingParams, opts ...OptionFunc) (*AddGuestOsMappingResponse, error) {
+	return s.AddGuestOsMappingWithContext(context.Background(), p, opts...)
+}
+
+// AddGuestOsMappingWithContext is the same as AddGuestOsMapping, but the request is cancelled when the context is done
+func (s *GuestOSService) AddGuestOsMappingWithContext(ctx context.Context, p *AddGuestOsMappingParams, opts ...OptionFunc) (*AddGuestOsMappingResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.AddGuestOsMappingRawWithContext(ctx, p.toURLValues())
+}
+
+// AddGuestOsMappingRaw is the same as AddGuestOsMapping, but takes the params as url.Values instead of typed params
+func (s *GuestOSService) AddGuestOsMappingRaw(v url.Values) (*AddGuestOsMappingResponse, error) {
+	return s.AddGuestOsMappingRawWithContext(context.Background(), v)
+}
+
+// AddGuestOsMappingRawWithContext is the same as AddGuestOsMappingRaw, but the request is cancelled when the context is done
+func (s *GuestOSService) AddGuestOsMappingRawWithContext(ctx context.Context, v url.Values) (*AddGuestOsMappingResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "addGuestOsMapping", "addguestosmappingresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -214,9 +447,9 @@ func (s *GuestOSService) AddGuestOsMapping(p *AddGuestOsMappingParams) (*AddGues
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -250,6 +483,16 @@ type ListGuestOsMappingParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ListGuestOsMappingParams) Clone() *ListGuestOsMappingParams {
+	c := &ListGuestOsMappingParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListGuestOsMappingParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -281,14 +524,21 @@ func (p *ListGuestOsMappingParams) toURLValues() url.Values {
 	return u
 }
 
-func (p *ListGuestOsMappingParams) SetHypervisor(v string) {
+func (p *ListGuestOsMappingParams) SetHypervisor(v HypervisorType) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	p.p["hypervisor"] = v
+	p.p["hypervisor"] = string(v)
 	return
 }
 
+// SetHypervisorIfUnset sets hypervisor to v, unless it is already set
+func (p *ListGuestOsMappingParams) SetHypervisorIfUnset(v HypervisorType) {
+	if _, found := p.p["hypervisor"]; !found {
+		p.SetHypervisor(v)
+	}
+}
+
 func (p *ListGuestOsMappingParams) SetHypervisorversion(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -297,6 +547,13 @@ func (p *ListGuestOsMappingParams) SetHypervisorversion(v string) {
 	return
 }
 
+// SetHypervisorversionIfUnset sets hypervisorversion to v, unless it is already set
+func (p *ListGuestOsMappingParams) SetHypervisorversionIfUnset(v string) {
+	if _, found := p.p["hypervisorversion"]; !found {
+		p.SetHypervisorversion(v)
+	}
+}
+
 func (p *ListGuestOsMappingParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -305,6 +562,13 @@ func (p *ListGuestOsMappingParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *ListGuestOsMappingParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 func (p *ListGuestOsMappingParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -313,6 +577,13 @@ func (p *ListGuestOsMappingParams) SetKeyword(v string) {
 	return
 }
 
+// SetKeywordIfUnset sets keyword to v, unless it is already set
+func (p *ListGuestOsMappingParams) SetKeywordIfUnset(v string) {
+	if _, found := p.p["keyword"]; !found {
+		p.SetKeyword(v)
+	}
+}
+
 func (p *ListGuestOsMappingParams) SetOstypeid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -321,6 +592,13 @@ func (p *ListGuestOsMappingParams) SetOstypeid(v string) {
 	return
 }
 
+// SetOstypeidIfUnset sets ostypeid to v, unless it is already set
+func (p *ListGuestOsMappingParams) SetOstypeidIfUnset(v string) {
+	if _, found := p.p["ostypeid"]; !found {
+		p.SetOstypeid(v)
+	}
+}
+
 func (p *ListGuestOsMappingParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -329,6 +607,13 @@ func (p *ListGuestOsMappingParams) SetPage(v int) {
 	return
 }
 
+// SetPageIfUnset sets page to v, unless it is already set
+func (p *ListGuestOsMappingParams) SetPageIfUnset(v int) {
+	if _, found := p.p["page"]; !found {
+		p.SetPage(v)
+	}
+}
+
 func (p *ListGuestOsMappingParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -337,14 +622,32 @@ func (p *ListGuestOsMappingParams) SetPagesize(v int) {
 	return
 }
 
+// SetPagesizeIfUnset sets pagesize to v, unless it is already set
+func (p *ListGuestOsMappingParams) SetPagesizeIfUnset(v int) {
+	if _, found := p.p["pagesize"]; !found {
+		p.SetPagesize(v)
+	}
+}
+
 // You should always use this function to get a new ListGuestOsMappingParams instance,
-// as then you are sure you have configured all required params
-func (s *GuestOSService) NewListGuestOsMappingParams() *ListGuestOsMappingParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *GuestOSService) NewListGuestOsMappingParams(opts ...func(*ListGuestOsMappingParams)) *ListGuestOsMappingParams {
 	p := &ListGuestOsMappingParams{}
 	p.p = make(map[string]interface{})
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
+func (p *ListGuestOsMappingParams) setFirstMatch() {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p[firstMatchKey] = true
+}
+
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *GuestOSService) GetGuestOsMappingByID(id string, opts ...OptionFunc) (*GuestOsMapping, int, error) {
 	p := &ListGuestOsMappingParams{}
@@ -352,7 +655,7 @@ func (s *GuestOSService) GetGuestOsMappingByID(id string, opts ...OptionFunc) (*
 
 	p.p["id"] = id
 
-	for _, fn := range append(s.cs.options, opts...) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
 		if err := fn(s.cs, p); err != nil {
 			return nil, -1, err
 		}
@@ -363,24 +666,56 @@ func (s *GuestOSService) GetGuestOsMappingByID(id string, opts ...OptionFunc) (*
 		if strings.Contains(err.Error(), fmt.Sprintf(
 			"Invalid parameter id value=%s due to incorrect long value format, "+
 				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+			return nil, 0, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
 	}
 
 	if l.Count == 1 {
 		return l.GuestOsMapping[0], l.Count, nil
 	}
+
+	if _, ok := p.p[firstMatchKey]; ok && len(l.GuestOsMapping) > 0 {
+		return l.GuestOsMapping[0], l.Count, nil
+	}
 	return nil, l.Count, fmt.Errorf("There is more then one result for GuestOsMapping UUID: %s!", id)
 }
 
+// GuestOsMappingExists returns true if a GuestOsMapping with the given ID exists. When no match is found
+// it returns false, while any other error is returned as is.
+func (s *GuestOSService) GuestOsMappingExists(id string, opts ...OptionFunc) (bool, error) {
+	_, count, err := s.GetGuestOsMappingByID(id, opts...)
+	if errors.Is(err, ErrNotFound) {
+		return false, nil
+	}
+	if count > 0 {
+		return true, nil
+	}
+	return false, err
+}
+
 // Lists all available OS mappings for given hypervisor
 func (s *GuestOSService) ListGuestOsMapping(p *ListGuestOsMappingParams) (*ListGuestOsMappingResponse, error) {
-	resp, err := s.cs.newRequest("listGuestOsMapping", p.toURLValues())
+	return s.ListGuestOsMappingWithContext(context.Background(), p)
+}
+
+// ListGuestOsMappingWithContext is the same as ListGuestOsMapping, but the request is cancelled when the context is done
+func (s *GuestOSService) ListGuestOsMappingWithContext(ctx context.Context, p *ListGuestOsMappingParams) (*ListGuestOsMappingResponse, error) {
+	return s.ListGuestOsMappingRawWithContext(ctx, p.toURLValues())
+}
+
+// ListGuestOsMappingRaw is the same as ListGuestOsMapping, but takes the params as url.Values instead of typed params
+func (s *GuestOSService) ListGuestOsMappingRaw(v url.Values) (*ListGuestOsMappingResponse, error) {
+	return s.ListGuestOsMappingRawWithContext(context.Background(), v)
+}
+
+// ListGuestOsMappingRawWithContext is the same as ListGuestOsMappingRaw, but the request is cancelled when the context is done
+func (s *GuestOSService) ListGuestOsMappingRawWithContext(ctx context.Context, v url.Values) (*ListGuestOsMappingResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "listGuestOsMapping", "listguestosmappingresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -393,6 +728,93 @@ func (s *GuestOSService) ListGuestOsMapping(p *ListGuestOsMappingParams) (*ListG
 	return &r, nil
 }
 
+// StreamGuestOsMapping fetches the results of ListGuestOsMapping page by page in the background and sends them on the
+// returned channel. Both returned channels are closed when done. If fetching a page fails, or the context
+// is done before all results are sent, the error is sent on the error channel and fetching stops. A
+// request for a page in flight is cancelled when the context is done. The page and pagesize set in the
+// params are ignored.
+func (s *GuestOSService) StreamGuestOsMapping(ctx context.Context, p *ListGuestOsMappingParams) (<-chan *GuestOsMapping, <-chan error) {
+	ch := make(chan *GuestOsMapping)
+	errs := make(chan error, 1)
+
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesize(500)
+
+	go func() {
+		defer close(errs)
+		defer close(ch)
+
+		for page, count := 1, 0; ; page++ {
+			pp.SetPage(page)
+
+			l, err := s.ListGuestOsMappingWithContext(ctx, pp)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			for _, v := range l.GuestOsMapping {
+				select {
+				case ch <- v:
+				case <-ctx.Done():
+					errs <- ctx.Err()
+					return
+				}
+			}
+
+			count += len(l.GuestOsMapping)
+			if len(l.GuestOsMapping) < 500 || count >= l.Count {
+				return
+			}
+		}
+	}()
+
+	return ch, errs
+}
+
+// ListGuestOsMappingWhere returns all results of ListGuestOsMapping for which pred returns true. Note that the results are
+// filtered client-side, so all results matching the params are fetched first.
+// All pages are fetched, so the page and pagesize set in the params are ignored.
+func (s *GuestOSService) ListGuestOsMappingWhere(p *ListGuestOsMappingParams, pred func(*GuestOsMapping) bool) ([]*GuestOsMapping, error) {
+	var r []*GuestOsMapping
+	ch, errs := s.StreamGuestOsMapping(context.Background(), p)
+	for v := range ch {
+		if pred(v) {
+			r = append(r, v)
+		}
+	}
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ListAllGuestOsMapping returns the results of ListGuestOsMapping across all pages, by calling ListGuestOsMapping page by page until all
+// results are fetched. The pagesize set in the params is used for every page (500 if not set), and the
+// page set in the params is ignored.
+func (s *GuestOSService) ListAllGuestOsMapping(p *ListGuestOsMappingParams) ([]*GuestOsMapping, error) {
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesizeIfUnset(500)
+	pagesize := pp.p["pagesize"].(int)
+
+	var r []*GuestOsMapping
+	for page := 1; ; page++ {
+		pp.SetPage(page)
+
+		l, err := s.ListGuestOsMapping(pp)
+		if err != nil {
+			return nil, err
+		}
+		r = append(r, l.GuestOsMapping...)
+
+		if len(l.GuestOsMapping) < pagesize || len(r) >= l.Count {
+			return r, nil
+		}
+	}
+}
+
 type ListGuestOsMappingResponse struct {
 	Count          int               `json:"count"`
 	GuestOsMapping []*GuestOsMapping `json:"guestosmapping"`
@@ -412,6 +834,16 @@ type ListOsCategoriesParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ListOsCategoriesParams) Clone() *ListOsCategoriesParams {
+	c := &ListOsCategoriesParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListOsCategoriesParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -445,6 +877,13 @@ func (p *ListOsCategoriesParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *ListOsCategoriesParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 func (p *ListOsCategoriesParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -453,6 +892,13 @@ func (p *ListOsCategoriesParams) SetKeyword(v string) {
 	return
 }
 
+// SetKeywordIfUnset sets keyword to v, unless it is already set
+func (p *ListOsCategoriesParams) SetKeywordIfUnset(v string) {
+	if _, found := p.p["keyword"]; !found {
+		p.SetKeyword(v)
+	}
+}
+
 func (p *ListOsCategoriesParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -461,6 +907,13 @@ func (p *ListOsCategoriesParams) SetName(v string) {
 	return
 }
 
+// SetNameIfUnset sets name to v, unless it is already set
+func (p *ListOsCategoriesParams) SetNameIfUnset(v string) {
+	if _, found := p.p["name"]; !found {
+		p.SetName(v)
+	}
+}
+
 func (p *ListOsCategoriesParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -469,6 +922,13 @@ func (p *ListOsCategoriesParams) SetPage(v int) {
 	return
 }
 
+// SetPageIfUnset sets page to v, unless it is already set
+func (p *ListOsCategoriesParams) SetPageIfUnset(v int) {
+	if _, found := p.p["page"]; !found {
+		p.SetPage(v)
+	}
+}
+
 func (p *ListOsCategoriesParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -477,14 +937,32 @@ func (p *ListOsCategoriesParams) SetPagesize(v int) {
 	return
 }
 
+// SetPagesizeIfUnset sets pagesize to v, unless it is already set
+func (p *ListOsCategoriesParams) SetPagesizeIfUnset(v int) {
+	if _, found := p.p["pagesize"]; !found {
+		p.SetPagesize(v)
+	}
+}
+
 // You should always use this function to get a new ListOsCategoriesParams instance,
-// as then you are sure you have configured all required params
-func (s *GuestOSService) NewListOsCategoriesParams() *ListOsCategoriesParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *GuestOSService) NewListOsCategoriesParams(opts ...func(*ListOsCategoriesParams)) *ListOsCategoriesParams {
 	p := &ListOsCategoriesParams{}
 	p.p = make(map[string]interface{})
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
+func (p *ListOsCategoriesParams) setFirstMatch() {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p[firstMatchKey] = true
+}
+
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *GuestOSService) GetOsCategoryID(name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListOsCategoriesParams{}
@@ -492,7 +970,7 @@ func (s *GuestOSService) GetOsCategoryID(name string, opts ...OptionFunc) (strin
 
 	p.p["name"] = name
 
-	for _, fn := range append(s.cs.options, opts...) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
 		if err := fn(s.cs, p); err != nil {
 			return "", -1, err
 		}
@@ -504,7 +982,7 @@ func (s *GuestOSService) GetOsCategoryID(name string, opts ...OptionFunc) (strin
 	}
 
 	if l.Count == 0 {
-		return "", l.Count, fmt.Errorf("No match found for %s: %+v", name, l)
+		return "", l.Count, fmt.Errorf("%w for %s: %+v", ErrNotFound, name, l)
 	}
 
 	if l.Count == 1 {
@@ -517,6 +995,10 @@ func (s *GuestOSService) GetOsCategoryID(name string, opts ...OptionFunc) (strin
 				return v.Id, l.Count, nil
 			}
 		}
+
+		if _, ok := p.p[firstMatchKey]; ok && len(l.OsCategories) > 0 {
+			return l.OsCategories[0].Id, l.Count, nil
+		}
 	}
 	return "", l.Count, fmt.Errorf("Could not find an exact match for %s: %+v", name, l)
 }
@@ -542,7 +1024,7 @@ func (s *GuestOSService) GetOsCategoryByID(id string, opts ...OptionFunc) (*OsCa
 
 	p.p["id"] = id
 
-	for _, fn := range append(s.cs.options, opts...) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
 		if err := fn(s.cs, p); err != nil {
 			return nil, -1, err
 		}
@@ -553,24 +1035,56 @@ func (s *GuestOSService) GetOsCategoryByID(id string, opts ...OptionFunc) (*OsCa
 		if strings.Contains(err.Error(), fmt.Sprintf(
 			"Invalid parameter id value=%s due to incorrect long value format, "+
 				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+			return nil, 0, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
 	}
 
 	if l.Count == 1 {
 		return l.OsCategories[0], l.Count, nil
 	}
+
+	if _, ok := p.p[firstMatchKey]; ok && len(l.OsCategories) > 0 {
+		return l.OsCategories[0], l.Count, nil
+	}
 	return nil, l.Count, fmt.Errorf("There is more then one result for OsCategory UUID: %s!", id)
 }
 
+// OsCategoryExists returns true if a OsCategory with the given ID exists. When no match is found
+// it returns false, while any other error is returned as is.
+func (s *GuestOSService) OsCategoryExists(id string, opts ...OptionFunc) (bool, error) {
+	_, count, err := s.GetOsCategoryByID(id, opts...)
+	if errors.Is(err, ErrNotFound) {
+		return false, nil
+	}
+	if count > 0 {
+		return true, nil
+	}
+	return false, err
+}
+
 // Lists all supported OS categories for this cloud.
 func (s *GuestOSService) ListOsCategories(p *ListOsCategoriesParams) (*ListOsCategoriesResponse, error) {
-	resp, err := s.cs.newRequest("listOsCategories", p.toURLValues())
+	return s.ListOsCategoriesWithContext(context.Background(), p)
+}
+
+// ListOsCategoriesWithContext is the same as ListOsCategories, but the request is cancelled when the context is done
+func (s *GuestOSService) ListOsCategoriesWithContext(ctx context.Context, p *ListOsCategoriesParams) (*ListOsCategoriesResponse, error) {
+	return s.ListOsCategoriesRawWithContext(ctx, p.toURLValues())
+}
+
+// ListOsCategoriesRaw is the same as ListOsCategories, but takes the params as url.Values instead of typed params
+func (s *GuestOSService) ListOsCategoriesRaw(v url.Values) (*ListOsCategoriesResponse, error) {
+	return s.ListOsCategoriesRawWithContext(context.Background(), v)
+}
+
+// ListOsCategoriesRawWithContext is the same as ListOsCategoriesRaw, but the request is cancelled when the context is done
+func (s *GuestOSService) ListOsCategoriesRawWithContext(ctx context.Context, v url.Values) (*ListOsCategoriesResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "listOsCategories", "listoscategoriesresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -583,6 +1097,93 @@ func (s *GuestOSService) ListOsCategories(p *ListOsCategoriesParams) (*ListOsCat
 	return &r, nil
 }
 
+// StreamOsCategories fetches the results of ListOsCategories page by page in the background and sends them on the
+// returned channel. Both returned channels are closed when done. If fetching a page fails, or the context
+// is done before all results are sent, the error is sent on the error channel and fetching stops. A
+// request for a page in flight is cancelled when the context is done. The page and pagesize set in the
+// params are ignored.
+func (s *GuestOSService) StreamOsCategories(ctx context.Context, p *ListOsCategoriesParams) (<-chan *OsCategory, <-chan error) {
+	ch := make(chan *OsCategory)
+	errs := make(chan error, 1)
+
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesize(500)
+
+	go func() {
+		defer close(errs)
+		defer close(ch)
+
+		for page, count := 1, 0; ; page++ {
+			pp.SetPage(page)
+
+			l, err := s.ListOsCategoriesWithContext(ctx, pp)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			for _, v := range l.OsCategories {
+				select {
+				case ch <- v:
+				case <-ctx.Done():
+					errs <- ctx.Err()
+					return
+				}
+			}
+
+			count += len(l.OsCategories)
+			if len(l.OsCategories) < 500 || count >= l.Count {
+				return
+			}
+		}
+	}()
+
+	return ch, errs
+}
+
+// ListOsCategoriesWhere returns all results of ListOsCategories for which pred returns true. Note that the results are
+// filtered client-side, so all results matching the params are fetched first.
+// All pages are fetched, so the page and pagesize set in the params are ignored.
+func (s *GuestOSService) ListOsCategoriesWhere(p *ListOsCategoriesParams, pred func(*OsCategory) bool) ([]*OsCategory, error) {
+	var r []*OsCategory
+	ch, errs := s.StreamOsCategories(context.Background(), p)
+	for v := range ch {
+		if pred(v) {
+			r = append(r, v)
+		}
+	}
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ListAllOsCategories returns the results of ListOsCategories across all pages, by calling ListOsCategories page by page until all
+// results are fetched. The pagesize set in the params is used for every page (500 if not set), and the
+// page set in the params is ignored.
+func (s *GuestOSService) ListAllOsCategories(p *ListOsCategoriesParams) ([]*OsCategory, error) {
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesizeIfUnset(500)
+	pagesize := pp.p["pagesize"].(int)
+
+	var r []*OsCategory
+	for page := 1; ; page++ {
+		pp.SetPage(page)
+
+		l, err := s.ListOsCategories(pp)
+		if err != nil {
+			return nil, err
+		}
+		r = append(r, l.OsCategories...)
+
+		if len(l.OsCategories) < pagesize || len(r) >= l.Count {
+			return r, nil
+		}
+	}
+}
+
 type ListOsCategoriesResponse struct {
 	Count        int           `json:"count"`
 	OsCategories []*OsCategory `json:"oscategory"`
@@ -597,6 +1198,16 @@ type ListOsTypesParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ListOsTypesParams) Clone() *ListOsTypesParams {
+	c := &ListOsTypesParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListOsTypesParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -633,6 +1244,13 @@ func (p *ListOsTypesParams) SetDescription(v string) {
 	return
 }
 
+// SetDescriptionIfUnset sets description to v, unless it is already set
+func (p *ListOsTypesParams) SetDescriptionIfUnset(v string) {
+	if _, found := p.p["description"]; !found {
+		p.SetDescription(v)
+	}
+}
+
 func (p *ListOsTypesParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -641,6 +1259,13 @@ func (p *ListOsTypesParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *ListOsTypesParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 func (p *ListOsTypesParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -649,6 +1274,13 @@ func (p *ListOsTypesParams) SetKeyword(v string) {
 	return
 }
 
+// SetKeywordIfUnset sets keyword to v, unless it is already set
+func (p *ListOsTypesParams) SetKeywordIfUnset(v string) {
+	if _, found := p.p["keyword"]; !found {
+		p.SetKeyword(v)
+	}
+}
+
 func (p *ListOsTypesParams) SetOscategoryid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -657,6 +1289,13 @@ func (p *ListOsTypesParams) SetOscategoryid(v string) {
 	return
 }
 
+// SetOscategoryidIfUnset sets oscategoryid to v, unless it is already set
+func (p *ListOsTypesParams) SetOscategoryidIfUnset(v string) {
+	if _, found := p.p["oscategoryid"]; !found {
+		p.SetOscategoryid(v)
+	}
+}
+
 func (p *ListOsTypesParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -665,6 +1304,13 @@ func (p *ListOsTypesParams) SetPage(v int) {
 	return
 }
 
+// SetPageIfUnset sets page to v, unless it is already set
+func (p *ListOsTypesParams) SetPageIfUnset(v int) {
+	if _, found := p.p["page"]; !found {
+		p.SetPage(v)
+	}
+}
+
 func (p *ListOsTypesParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -673,14 +1319,32 @@ func (p *ListOsTypesParams) SetPagesize(v int) {
 	return
 }
 
+// SetPagesizeIfUnset sets pagesize to v, unless it is already set
+func (p *ListOsTypesParams) SetPagesizeIfUnset(v int) {
+	if _, found := p.p["pagesize"]; !found {
+		p.SetPagesize(v)
+	}
+}
+
 // You should always use this function to get a new ListOsTypesParams instance,
-// as then you are sure you have configured all required params
-func (s *GuestOSService) NewListOsTypesParams() *ListOsTypesParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *GuestOSService) NewListOsTypesParams(opts ...func(*ListOsTypesParams)) *ListOsTypesParams {
 	p := &ListOsTypesParams{}
 	p.p = make(map[string]interface{})
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
+func (p *ListOsTypesParams) setFirstMatch() {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p[firstMatchKey] = true
+}
+
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *GuestOSService) GetOsTypeByID(id string, opts ...OptionFunc) (*OsType, int, error) {
 	p := &ListOsTypesParams{}
@@ -688,7 +1352,7 @@ func (s *GuestOSService) GetOsTypeByID(id string, opts ...OptionFunc) (*OsType,
 
 	p.p["id"] = id
 
-	for _, fn := range append(s.cs.options, opts...) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
 		if err := fn(s.cs, p); err != nil {
 			return nil, -1, err
 		}
@@ -699,24 +1363,56 @@ func (s *GuestOSService) GetOsTypeByID(id string, opts ...OptionFunc) (*OsType,
 		if strings.Contains(err.Error(), fmt.Sprintf(
 			"Invalid parameter id value=%s due to incorrect long value format, "+
 				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+			return nil, 0, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
 	}
 
 	if l.Count == 1 {
 		return l.OsTypes[0], l.Count, nil
 	}
+
+	if _, ok := p.p[firstMatchKey]; ok && len(l.OsTypes) > 0 {
+		return l.OsTypes[0], l.Count, nil
+	}
 	return nil, l.Count, fmt.Errorf("There is more then one result for OsType UUID: %s!", id)
 }
 
+// OsTypeExists returns true if a OsType with the given ID exists. When no match is found
+// it returns false, while any other error is returned as is.
+func (s *GuestOSService) OsTypeExists(id string, opts ...OptionFunc) (bool, error) {
+	_, count, err := s.GetOsTypeByID(id, opts...)
+	if errors.Is(err, ErrNotFound) {
+		return false, nil
+	}
+	if count > 0 {
+		return true, nil
+	}
+	return false, err
+}
+
 // Lists all supported OS types for this cloud.
 func (s *GuestOSService) ListOsTypes(p *ListOsTypesParams) (*ListOsTypesResponse, error) {
-	resp, err := s.cs.newRequest("listOsTypes", p.toURLValues())
+	return s.ListOsTypesWithContext(context.Background(), p)
+}
+
+// ListOsTypesWithContext is the same as ListOsTypes, but the request is cancelled when the context is done
+func (s *GuestOSService) ListOsTypesWithContext(ctx context.Context, p *ListOsTypesParams) (*ListOsTypesResponse, error) {
+	return s.ListOsTypesRawWithContext(ctx, p.toURLValues())
+}
+
+// ListOsTypesRaw is the same as ListOsTypes, but takes the params as url.Values instead of typed params
+func (s *GuestOSService) ListOsTypesRaw(v url.Values) (*ListOsTypesResponse, error) {
+	return s.ListOsTypesRawWithContext(context.Background(), v)
+}
+
+// ListOsTypesRawWithContext is the same as ListOsTypesRaw, but the request is cancelled when the context is done
+func (s *GuestOSService) ListOsTypesRawWithContext(ctx context.Context, v url.Values) (*ListOsTypesResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "listOsTypes", "listostypesresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -729,6 +1425,93 @@ func (s *GuestOSService) ListOsTypes(p *ListOsTypesParams) (*ListOsTypesResponse
 	return &r, nil
 }
 
+// StreamOsTypes fetches the results of ListOsTypes page by page in the background and sends them on the
+// returned channel. Both returned channels are closed when done. If fetching a page fails, or the context
+// is done before all results are sent, the error is sent on the error channel and fetching stops. A
+// request for a page in flight is cancelled when the context is done. The page and pagesize set in the
+// params are ignored.
+func (s *GuestOSService) StreamOsTypes(ctx context.Context, p *ListOsTypesParams) (<-chan *OsType, <-chan error) {
+	ch := make(chan *OsType)
+	errs := make(chan error, 1)
+
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesize(500)
+
+	go func() {
+		defer close(errs)
+		defer close(ch)
+
+		for page, count := 1, 0; ; page++ {
+			pp.SetPage(page)
+
+			l, err := s.ListOsTypesWithContext(ctx, pp)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			for _, v := range l.OsTypes {
+				select {
+				case ch <- v:
+				case <-ctx.Done():
+					errs <- ctx.Err()
+					return
+				}
+			}
+
+			count += len(l.OsTypes)
+			if len(l.OsTypes) < 500 || count >= l.Count {
+				return
+			}
+		}
+	}()
+
+	return ch, errs
+}
+
+// ListOsTypesWhere returns all results of ListOsTypes for which pred returns true. Note that the results are
+// filtered client-side, so all results matching the params are fetched first.
+// All pages are fetched, so the page and pagesize set in the params are ignored.
+func (s *GuestOSService) ListOsTypesWhere(p *ListOsTypesParams, pred func(*OsType) bool) ([]*OsType, error) {
+	var r []*OsType
+	ch, errs := s.StreamOsTypes(context.Background(), p)
+	for v := range ch {
+		if pred(v) {
+			r = append(r, v)
+		}
+	}
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ListAllOsTypes returns the results of ListOsTypes across all pages, by calling ListOsTypes page by page until all
+// results are fetched. The pagesize set in the params is used for every page (500 if not set), and the
+// page set in the params is ignored.
+func (s *GuestOSService) ListAllOsTypes(p *ListOsTypesParams) ([]*OsType, error) {
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesizeIfUnset(500)
+	pagesize := pp.p["pagesize"].(int)
+
+	var r []*OsType
+	for page := 1; ; page++ {
+		pp.SetPage(page)
+
+		l, err := s.ListOsTypes(pp)
+		if err != nil {
+			return nil, err
+		}
+		r = append(r, l.OsTypes...)
+
+		if len(l.OsTypes) < pagesize || len(r) >= l.Count {
+			return r, nil
+		}
+	}
+}
+
 type ListOsTypesResponse struct {
 	Count   int       `json:"count"`
 	OsTypes []*OsType `json:"ostype"`
@@ -745,6 +1528,16 @@ type RemoveGuestOsParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *RemoveGuestOsParams) Clone() *RemoveGuestOsParams {
+	c := &RemoveGuestOsParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *RemoveGuestOsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -756,6 +1549,16 @@ func (p *RemoveGuestOsParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *RemoveGuestOsParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of removeGuestOs", k)
+		}
+	}
+	return nil
+}
+
 func (p *RemoveGuestOsParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -764,18 +1567,54 @@ func (p *RemoveGuestOsParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *RemoveGuestOsParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 // You should always use this function to get a new RemoveGuestOsParams instance,
-// as then you are sure you have configured all required params
-func (s *GuestOSService) NewRemoveGuestOsParams(id string) *RemoveGuestOsParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *GuestOSService) NewRemoveGuestOsParams(id string, opts ...func(*RemoveGuestOsParams)) *RemoveGuestOsParams {
 	p := &RemoveGuestOsParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Removes a Guest OS from listing.
-func (s *GuestOSService) RemoveGuestOs(p *RemoveGuestOsParams) (*RemoveGuestOsResponse, error) {
-	resp, err := s.cs.newRequest("removeGuestOs", p.toURLValues())
+func (s *GuestOSService) RemoveGuestOs(p *RemoveGuestOsParams, opts ...OptionFunc) (*RemoveGuestOsResponse, error) {
+	return s.RemoveGuestOsWithContext(context.Background(), p, opts...)
+}
+
+// RemoveGuestOsWithContext is the same as RemoveGuestOs, but the request is cancelled when the context is done
+func (s *GuestOSService) RemoveGuestOsWithContext(ctx context.Context, p *RemoveGuestOsParams, opts ...OptionFunc) (*RemoveGuestOsResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.RemoveGuestOsRawWithContext(ctx, p.toURLValues())
+}
+
+// RemoveGuestOsRaw is the same as RemoveGuestOs, but takes the params as url.Values instead of typed params
+func (s *GuestOSService) RemoveGuestOsRaw(v url.Values) (*RemoveGuestOsResponse, error) {
+	return s.RemoveGuestOsRawWithContext(context.Background(), v)
+}
+
+// RemoveGuestOsRawWithContext is the same as RemoveGuestOsRaw, but the request is cancelled when the context is done
+func (s *GuestOSService) RemoveGuestOsRawWithContext(ctx context.Context, v url.Values) (*RemoveGuestOsResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "removeGuestOs", "removeguestosresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -787,9 +1626,9 @@ func (s *GuestOSService) RemoveGuestOs(p *RemoveGuestOsParams) (*RemoveGuestOsRe
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -813,6 +1652,16 @@ type RemoveGuestOsMappingParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *RemoveGuestOsMappingParams) Clone() *RemoveGuestOsMappingParams {
+	c := &RemoveGuestOsMappingParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *RemoveGuestOsMappingParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -824,6 +1673,16 @@ func (p *RemoveGuestOsMappingParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *RemoveGuestOsMappingParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of removeGuestOsMapping", k)
+		}
+	}
+	return nil
+}
+
 func (p *RemoveGuestOsMappingParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -832,18 +1691,54 @@ func (p *RemoveGuestOsMappingParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *RemoveGuestOsMappingParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 // You should always use this function to get a new RemoveGuestOsMappingParams instance,
-// as then you are sure you have configured all required params
-func (s *GuestOSService) NewRemoveGuestOsMappingParams(id string) *RemoveGuestOsMappingParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *GuestOSService) NewRemoveGuestOsMappingParams(id string, opts ...func(*RemoveGuestOsMappingParams)) *RemoveGuestOsMappingParams {
 	p := &RemoveGuestOsMappingParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Removes a Guest OS Mapping.
-func (s *GuestOSService) RemoveGuestOsMapping(p *RemoveGuestOsMappingParams) (*RemoveGuestOsMappingResponse, error) {
-	resp, err := s.cs.newRequest("removeGuestOsMapping", p.toURLValues())
+func (s *GuestOSService) RemoveGuestOsMapping(p *RemoveGuestOsMappingParams, opts ...OptionFunc) (*RemoveGuestOsMappingResponse, error) {
+	return s.RemoveGuestOsMappingWithContext(context.Background(), p, opts...)
+}
+
+// RemoveGuestOsMappingWithContext is the same as RemoveGuestOsMapping, but the request is cancelled when the context is done
+func (s *GuestOSService) RemoveGuestOsMappingWithContext(ctx context.Context, p *RemoveGuestOsMappingParams, opts ...OptionFunc) (*RemoveGuestOsMappingResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.RemoveGuestOsMappingRawWithContext(ctx, p.toURLValues())
+}
+
+// RemoveGuestOsMappingRaw is the same as RemoveGuestOsMapping, but takes the params as url.Values instead of typed params
+func (s *GuestOSService) RemoveGuestOsMappingRaw(v url.Values) (*RemoveGuestOsMappingResponse, error) {
+	return s.RemoveGuestOsMappingRawWithContext(context.Background(), v)
+}
+
+// RemoveGuestOsMappingRawWithContext is the same as RemoveGuestOsMappingRaw, but the request is cancelled when the context is done
+func (s *GuestOSService) RemoveGuestOsMappingRawWithContext(ctx context.Context, v url.Values) (*RemoveGuestOsMappingResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "removeGuestOsMapping", "removeguestosmappingresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -855,9 +1750,9 @@ func (s *GuestOSService) RemoveGuestOsMapping(p *RemoveGuestOsMappingParams) (*R
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -881,6 +1776,16 @@ type UpdateGuestOsParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *UpdateGuestOsParams) Clone() *UpdateGuestOsParams {
+	c := &UpdateGuestOsParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *UpdateGuestOsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -895,6 +1800,16 @@ func (p *UpdateGuestOsParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *UpdateGuestOsParams) validate() error {
+	for _, k := range []string{"id", "osdisplayname"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of updateGuestOs", k)
+		}
+	}
+	return nil
+}
+
 func (p *UpdateGuestOsParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -903,6 +1818,13 @@ func (p *UpdateGuestOsParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *UpdateGuestOsParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 func (p *UpdateGuestOsParams) SetOsdisplayname(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -911,19 +1833,55 @@ func (p *UpdateGuestOsParams) SetOsdisplayname(v string) {
 	return
 }
 
+// SetOsdisplaynameIfUnset sets osdisplayname to v, unless it is already set
+func (p *UpdateGuestOsParams) SetOsdisplaynameIfUnset(v string) {
+	if _, found := p.p["osdisplayname"]; !found {
+		p.SetOsdisplayname(v)
+	}
+}
+
 // You should always use this function to get a new UpdateGuestOsParams instance,
-// as then you are sure you have configured all required params
-func (s *GuestOSService) NewUpdateGuestOsParams(id string, osdisplayname string) *UpdateGuestOsParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *GuestOSService) NewUpdateGuestOsParams(id string, osdisplayname string, opts ...func(*UpdateGuestOsParams)) *UpdateGuestOsParams {
 	p := &UpdateGuestOsParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
 	p.p["osdisplayname"] = osdisplayname
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Updates the information about Guest OS
-func (s *GuestOSService) UpdateGuestOs(p *UpdateGuestOsParams) (*UpdateGuestOsResponse, error) {
-	resp, err := s.cs.newRequest("updateGuestOs", p.toURLValues())
+func (s *GuestOSService) UpdateGuestOs(p *UpdateGuestOsParams, opts ...OptionFunc) (*UpdateGuestOsResponse, error) {
+	return s.UpdateGuestOsWithContext(context.Background(), p, opts...)
+}
+
+// UpdateGuestOsWithContext is the same as UpdateGuestOs, but the request is cancelled when the context is done
+func (s *GuestOSService) UpdateGuestOsWithContext(ctx context.Context, p *UpdateGuestOsParams, opts ...OptionFunc) (*UpdateGuestOsResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.UpdateGuestOsRawWithContext(ctx, p.toURLValues())
+}
+
+// UpdateGuestOsRaw is the same as UpdateGuestOs, but takes the params as url.Values instead of typed params
+func (s *GuestOSService) UpdateGuestOsRaw(v url.Values) (*UpdateGuestOsResponse, error) {
+	return s.UpdateGuestOsRawWithContext(context.Background(), v)
+}
+
+// UpdateGuestOsRawWithContext is the same as UpdateGuestOsRaw, but the request is cancelled when the context is done
+func (s *GuestOSService) UpdateGuestOsRawWithContext(ctx context.Context, v url.Values) (*UpdateGuestOsResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "updateGuestOs", "updateguestosresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -935,9 +1893,9 @@ func (s *GuestOSService) UpdateGuestOs(p *UpdateGuestOsParams) (*UpdateGuestOsRe
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -968,6 +1926,16 @@ type UpdateGuestOsMappingParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *UpdateGuestOsMappingParams) Clone() *UpdateGuestOsMappingParams {
+	c := &UpdateGuestOsMappingParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *UpdateGuestOsMappingParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -982,6 +1950,16 @@ func (p *UpdateGuestOsMappingParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *UpdateGuestOsMappingParams) validate() error {
+	for _, k := range []string{"id", "osnameforhypervisor"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of updateGuestOsMapping", k)
+		}
+	}
+	return nil
+}
+
 func (p *UpdateGuestOsMappingParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -990,6 +1968,13 @@ func (p *UpdateGuestOsMappingParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *UpdateGuestOsMappingParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 func (p *UpdateGuestOsMappingParams) SetOsnameforhypervisor(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -998,19 +1983,55 @@ func (p *UpdateGuestOsMappingParams) SetOsnameforhypervisor(v string) {
 	return
 }
 
+// SetOsnameforhypervisorIfUnset sets osnameforhypervisor to v, unless it is already set
+func (p *UpdateGuestOsMappingParams) SetOsnameforhypervisorIfUnset(v string) {
+	if _, found := p.p["osnameforhypervisor"]; !found {
+		p.SetOsnameforhypervisor(v)
+	}
+}
+
 // You should always use this function to get a new UpdateGuestOsMappingParams instance,
-// as then you are sure you have configured all required params
-func (s *GuestOSService) NewUpdateGuestOsMappingParams(id string, osnameforhypervisor string) *UpdateGuestOsMappingParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *GuestOSService) NewUpdateGuestOsMappingParams(id string, osnameforhypervisor string, opts ...func(*UpdateGuestOsMappingParams)) *UpdateGuestOsMappingParams {
 	p := &UpdateGuestOsMappingParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
 	p.p["osnameforhypervisor"] = osnameforhypervisor
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Updates the information about Guest OS to Hypervisor specific name mapping
-func (s *GuestOSService) UpdateGuestOsMapping(p *UpdateGuestOsMappingParams) (*UpdateGuestOsMappingResponse, error) {
-	resp, err := s.cs.newRequest("updateGuestOsMapping", p.toURLValues())
+func (s *GuestOSService) UpdateGuestOsMapping(p *UpdateGuestOsMappingParams, opts ...OptionFunc) (*UpdateGuestOsMappingResponse, error) {
+	return s.UpdateGuestOsMappingWithContext(context.Background(), p, opts...)
+}
+
+// UpdateGuestOsMappingWithContext is the same as UpdateGuestOsMapping, but the request is cancelled when the context is done
+func (s *GuestOSService) UpdateGuestOsMappingWithContext(ctx context.Context, p *UpdateGuestOsMappingParams, opts ...OptionFunc) (*UpdateGuestOsMappingResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.UpdateGuestOsMappingRawWithContext(ctx, p.toURLValues())
+}
+
+// UpdateGuestOsMappingRaw is the same as UpdateGuestOsMapping, but takes the params as url.Values instead of typed params
+func (s *GuestOSService) UpdateGuestOsMappingRaw(v url.Values) (*UpdateGuestOsMappingResponse, error) {
+	return s.UpdateGuestOsMappingRawWithContext(context.Background(), v)
+}
+
+// UpdateGuestOsMappingRawWithContext is the same as UpdateGuestOsMappingRaw, but the request is cancelled when the context is done
+func (s *GuestOSService) UpdateGuestOsMappingRawWithContext(ctx context.Context, v url.Values) (*UpdateGuestOsMappingResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "updateGuestOsMapping", "updateguestosmappingresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -1022,9 +2043,9 @@ func (s *GuestOSService) UpdateGuestOsMapping(p *UpdateGuestOsMappingParams) (*U
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
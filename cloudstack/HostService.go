@@ -17,17 +17,130 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/url"
 	"strconv"
 	"strings"
+	"time"
 )
 
+// ErrHostMaintenanceFailed is returned (wrapped) by EnterMaintenanceAndWait and CancelMaintenanceAndWait
+// when the host ends up in an error state, for example because not all virtual machines running on the
+// host could be migrated to other hosts
+var ErrHostMaintenanceFailed = errors.New("Host maintenance failed")
+
+// EnterMaintenanceAndWait prepares the host for maintenance and polls until its resource state is
+// Maintenance or the timeout expires (a timeout of 0 means no timeout). When the host ends up in an
+// error state instead, the host is returned together with an ErrHostMaintenanceFailed error.
+func (s *HostService) EnterMaintenanceAndWait(hostid string, timeout time.Duration, opts ...OptionFunc) (*Host, error) {
+	r, err := s.PrepareHostForMaintenance(s.NewPrepareHostForMaintenanceParams(hostid))
+	if err != nil {
+		return nil, err
+	}
+	return s.waitForResourceState(context.Background(), r.JobID, hostid, "Maintenance", timeout, opts...)
+}
+
+// CancelMaintenanceAndWait cancels the maintenance of the host and polls until its resource state is
+// Enabled or the timeout expires (a timeout of 0 means no timeout). When the host ends up in an error
+// state instead, the host is returned together with an ErrHostMaintenanceFailed error.
+func (s *HostService) CancelMaintenanceAndWait(hostid string, timeout time.Duration, opts ...OptionFunc) (*Host, error) {
+	r, err := s.CancelHostMaintenance(s.NewCancelHostMaintenanceParams(hostid))
+	if err != nil {
+		return nil, err
+	}
+	return s.waitForResourceState(context.Background(), r.JobID, hostid, "Enabled", timeout, opts...)
+}
+
+// Waits for the job to finish and then polls until the host reaches the given resource state
+func (s *HostService) waitForResourceState(ctx context.Context, jobid, hostid, state string, timeout time.Duration, opts ...OptionFunc) (*Host, error) {
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	// An async client already waited for the job to finish
+	if !s.cs.async {
+		if _, err := s.cs.GetAsyncJobResult(jobid, s.cs.timeout); err != nil {
+			return nil, err
+		}
+	}
+
+	for {
+		h, _, err := s.GetHostByID(hostid, opts...)
+		if err != nil {
+			return nil, err
+		}
+		if h.Resourcestate == state {
+			return h, nil
+		}
+		// E.g. ErrorInMaintenance or ErrorInPrepareForMaintenance
+		if strings.HasPrefix(h.Resourcestate, "Error") {
+			return h, fmt.Errorf("%w: host %s is in resource state %s", ErrHostMaintenanceFailed, hostid, h.Resourcestate)
+		}
+
+		select {
+		case <-ctx.Done():
+			return h, fmt.Errorf("Timeout waiting for host %s to reach resource state %s, last state was %s: %w", hostid, state, h.Resourcestate, ctx.Err())
+		case <-time.After(2 * time.Second):
+		}
+	}
+}
+
+// UpdateMany runs UpdateHost for all given IDs in parallel, using at most concurrency concurrent requests.
+// For each ID the params are populated with the ID and then passed to mutate, which should set the
+// changes to apply. The result contains the updated resources (in the order of the IDs) and the error
+// of every update that failed by ID. The returned error contains all failures, or is nil if none failed.
+// The updated resources are returned as the UpdateHostResponse of every update, which contains the same
+// fields as the resource itself, so no additional request is needed per resource to get them. The
+// options are used for every update.
+func (s *HostService) UpdateMany(ids []string, mutate func(*UpdateHostParams), concurrency int, opts ...OptionFunc) (BulkResult[*UpdateHostResponse], error) {
+	rs := make([]*UpdateHostResponse, len(ids))
+	errs := make([]error, len(ids))
+
+	runConcurrently(len(ids), concurrency, func(i int) {
+		p := &UpdateHostParams{p: map[string]interface{}{"id": ids[i]}}
+		if mutate != nil {
+			mutate(p)
+		}
+
+		r, err := s.UpdateHost(p, opts...)
+		if err != nil {
+			errs[i] = err
+			return
+		}
+		rs[i] = r
+	})
+
+	var r BulkResult[*UpdateHostResponse]
+	for i, id := range ids {
+		if errs[i] != nil {
+			r.fail(id, errs[i])
+			continue
+		}
+		r.Succeeded = append(r.Succeeded, rs[i])
+	}
+
+	return r, r.Err()
+}
+
 type AddBaremetalHostParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *AddBaremetalHostParams) Clone() *AddBaremetalHostParams {
+	c := &AddBaremetalHostParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *AddBaremetalHostParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -70,6 +183,16 @@ func (p *AddBaremetalHostParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *AddBaremetalHostParams) validate() error {
+	for _, k := range []string{"hypervisor", "password", "podid", "url", "username", "zoneid"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of addBaremetalHost", k)
+		}
+	}
+	return nil
+}
+
 func (p *AddBaremetalHostParams) SetAllocationstate(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -78,6 +201,13 @@ func (p *AddBaremetalHostParams) SetAllocationstate(v string) {
 	return
 }
 
+// SetAllocationstateIfUnset sets allocationstate to v, unless it is already set
+func (p *AddBaremetalHostParams) SetAllocationstateIfUnset(v string) {
+	if _, found := p.p["allocationstate"]; !found {
+		p.SetAllocationstate(v)
+	}
+}
+
 func (p *AddBaremetalHostParams) SetClusterid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -86,6 +216,13 @@ func (p *AddBaremetalHostParams) SetClusterid(v string) {
 	return
 }
 
+// SetClusteridIfUnset sets clusterid to v, unless it is already set
+func (p *AddBaremetalHostParams) SetClusteridIfUnset(v string) {
+	if _, found := p.p["clusterid"]; !found {
+		p.SetClusterid(v)
+	}
+}
+
 func (p *AddBaremetalHostParams) SetClustername(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -94,6 +231,13 @@ func (p *AddBaremetalHostParams) SetClustername(v string) {
 	return
 }
 
+// SetClusternameIfUnset sets clustername to v, unless it is already set
+func (p *AddBaremetalHostParams) SetClusternameIfUnset(v string) {
+	if _, found := p.p["clustername"]; !found {
+		p.SetClustername(v)
+	}
+}
+
 func (p *AddBaremetalHostParams) SetHosttags(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -102,14 +246,33 @@ func (p *AddBaremetalHostParams) SetHosttags(v []string) {
 	return
 }
 
-func (p *AddBaremetalHostParams) SetHypervisor(v string) {
+// SetHosttagsIfUnset sets hosttags to v, unless it is already set
+func (p *AddBaremetalHostParams) SetHosttagsIfUnset(v []string) {
+	if _, found := p.p["hosttags"]; !found {
+		p.SetHosttags(v)
+	}
+}
+
+// SetHosttagsCSV splits v on commas and sets the resulting values
+func (p *AddBaremetalHostParams) SetHosttagsCSV(v string) {
+	p.SetHosttags(splitCSV(v))
+}
+
+func (p *AddBaremetalHostParams) SetHypervisor(v HypervisorType) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	p.p["hypervisor"] = v
+	p.p["hypervisor"] = string(v)
 	return
 }
 
+// SetHypervisorIfUnset sets hypervisor to v, unless it is already set
+func (p *AddBaremetalHostParams) SetHypervisorIfUnset(v HypervisorType) {
+	if _, found := p.p["hypervisor"]; !found {
+		p.SetHypervisor(v)
+	}
+}
+
 func (p *AddBaremetalHostParams) SetIpaddress(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -118,6 +281,13 @@ func (p *AddBaremetalHostParams) SetIpaddress(v string) {
 	return
 }
 
+// SetIpaddressIfUnset sets ipaddress to v, unless it is already set
+func (p *AddBaremetalHostParams) SetIpaddressIfUnset(v string) {
+	if _, found := p.p["ipaddress"]; !found {
+		p.SetIpaddress(v)
+	}
+}
+
 func (p *AddBaremetalHostParams) SetPassword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -126,6 +296,13 @@ func (p *AddBaremetalHostParams) SetPassword(v string) {
 	return
 }
 
+// SetPasswordIfUnset sets password to v, unless it is already set
+func (p *AddBaremetalHostParams) SetPasswordIfUnset(v string) {
+	if _, found := p.p["password"]; !found {
+		p.SetPassword(v)
+	}
+}
+
 func (p *AddBaremetalHostParams) SetPodid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -134,6 +311,13 @@ func (p *AddBaremetalHostParams) SetPodid(v string) {
 	return
 }
 
+// SetPodidIfUnset sets podid to v, unless it is already set
+func (p *AddBaremetalHostParams) SetPodidIfUnset(v string) {
+	if _, found := p.p["podid"]; !found {
+		p.SetPodid(v)
+	}
+}
+
 func (p *AddBaremetalHostParams) SetUrl(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -142,6 +326,13 @@ func (p *AddBaremetalHostParams) SetUrl(v string) {
 	return
 }
 
+// SetUrlIfUnset sets url to v, unless it is already set
+func (p *AddBaremetalHostParams) SetUrlIfUnset(v string) {
+	if _, found := p.p["url"]; !found {
+		p.SetUrl(v)
+	}
+}
+
 func (p *AddBaremetalHostParams) SetUsername(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -150,6 +341,13 @@ func (p *AddBaremetalHostParams) SetUsername(v string) {
 	return
 }
 
+// SetUsernameIfUnset sets username to v, unless it is already set
+func (p *AddBaremetalHostParams) SetUsernameIfUnset(v string) {
+	if _, found := p.p["username"]; !found {
+		p.SetUsername(v)
+	}
+}
+
 func (p *AddBaremetalHostParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -158,9 +356,17 @@ func (p *AddBaremetalHostParams) SetZoneid(v string) {
 	return
 }
 
+// SetZoneidIfUnset sets zoneid to v, unless it is already set
+func (p *AddBaremetalHostParams) SetZoneidIfUnset(v string) {
+	if _, found := p.p["zoneid"]; !found {
+		p.SetZoneid(v)
+	}
+}
+
 // You should always use this function to get a new AddBaremetalHostParams instance,
-// as then you are sure you have configured all required params
-func (s *HostService) NewAddBaremetalHostParams(hypervisor string, password string, podid string, url string, username string, zoneid string) *AddBaremetalHostParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *HostService) NewAddBaremetalHostParams(hypervisor string, password string, podid string, url string, username string, zoneid string, opts ...func(*AddBaremetalHostParams)) *AddBaremetalHostParams {
 	p := &AddBaremetalHostParams{}
 	p.p = make(map[string]interface{})
 	p.p["hypervisor"] = hypervisor
@@ -169,12 +375,78 @@ func (s *HostService) NewAddBaremetalHostParams(hypervisor string, password stri
 	p.p["url"] = url
 	p.p["username"] = username
 	p.p["zoneid"] = zoneid
+	for _, fn := range opts {
+		fn(p)
+	}
+	return p
+}
+
+// AddBaremetalHostOpts contains all params that can be set on a AddBaremetalHostParams.
+// Optional params are pointers (or slices and maps) which are only set when not nil.
+type AddBaremetalHostOpts struct {
+	Allocationstate *string
+	Clusterid       *string
+	Clustername     *string
+	Hosttags        []string
+	Hypervisor      HypervisorType
+	Ipaddress       *string
+	Password        string
+	Podid           string
+	Url             string
+	Username        string
+	Zoneid          string
+}
+
+// NewAddBaremetalHostParamsFromOpts is an alternative for NewAddBaremetalHostParams which takes all params using
+// an options struct, as that is easier to read for APIs with many required params
+func (s *HostService) NewAddBaremetalHostParamsFromOpts(o AddBaremetalHostOpts) *AddBaremetalHostParams {
+	p := s.NewAddBaremetalHostParams(string(o.Hypervisor), o.Password, o.Podid, o.Url, o.Username, o.Zoneid)
+	if o.Allocationstate != nil {
+		p.p["allocationstate"] = *o.Allocationstate
+	}
+	if o.Clusterid != nil {
+		p.p["clusterid"] = *o.Clusterid
+	}
+	if o.Clustername != nil {
+		p.p["clustername"] = *o.Clustername
+	}
+	if o.Hosttags != nil {
+		p.p["hosttags"] = o.Hosttags
+	}
+	if o.Ipaddress != nil {
+		p.p["ipaddress"] = *o.Ipaddress
+	}
 	return p
 }
 
 // add a baremetal host
-func (s *HostService) AddBaremetalHost(p *AddBaremetalHostParams) (*AddBaremetalHostResponse, error) {
-	resp, err := s.cs.newRequest("addBaremetalHost", p.toURLValues())
+func (s *HostService) AddBaremetalHost(p *AddBaremetalHostParams, opts ...OptionFunc) (*AddBaremetalHostResponse, error) {
+	return s.AddBaremetalHostWithContext(context.Background(), p, opts...)
+}
+
+// AddBaremetalHostWithContext is the same as AddBaremetalHost, but the request is cancelled when the context is done
+func (s *HostService) AddBaremetalHostWithContext(ctx context.Context, p *AddBaremetalHostParams, opts ...OptionFunc) (*AddBaremetalHostResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.AddBaremetalHostRawWithContext(ctx, p.toURLValues())
+}
+
+// AddBaremetalHostRaw is the same as AddBaremetalHost, but takes the params as url.Values instead of typed params
+func (s *HostService) AddBaremetalHostRaw(v url.Values) (*AddBaremetalHostResponse, error) {
+	return s.AddBaremetalHostRawWithContext(context.Background(), v)
+}
+
+// AddBaremetalHostRawWithContext is the same as AddBaremetalHostRaw, but the request is cancelled when the context is done
+func (s *HostService) AddBaremetalHostRawWithContext(ctx context.Context, v url.Values) (*AddBaremetalHostResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "addBaremetalHost", "addbaremetalhostresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -188,23 +460,23 @@ func (s *HostService) AddBaremetalHost(p *AddBaremetalHostParams) (*AddBaremetal
 }
 
 type AddBaremetalHostResponse struct {
-	Averageload             int64             `json:"averageload"`
-	Capabilities            string            `json:"capabilities"`
-	Clusterid               string            `json:"clusterid"`
-	Clustername             string            `json:"clustername"`
-	Clustertype             string            `json:"clustertype"`
-	Cpuallocated            string            `json:"cpuallocated"`
-	Cpunumber               int               `json:"cpunumber"`
-	Cpusockets              int               `json:"cpusockets"`
-	Cpuspeed                int64             `json:"cpuspeed"`
-	Cpuused                 string            `json:"cpuused"`
-	Cpuwithoverprovisioning string            `json:"cpuwithoverprovisioning"`
-	Created                 string            `json:"created"`
-	Details                 map[string]string `json:"details"`
-	Disconnected            string            `json:"disconnected"`
-	Disksizeallocated       int64             `json:"disksizeallocated"`
-	Disksizetotal           int64             `json:"disksizetotal"`
-	Events                  string            `json:"events"`
+	Averageload             int64     `json:"averageload"`
+	Capabilities            string    `json:"capabilities"`
+	Clusterid               string    `json:"clusterid"`
+	Clustername             string    `json:"clustername"`
+	Clustertype             string    `json:"clustertype"`
+	Cpuallocated            string    `json:"cpuallocated"`
+	Cpunumber               int       `json:"cpunumber"`
+	Cpusockets              int       `json:"cpusockets"`
+	Cpuspeed                int64     `json:"cpuspeed"`
+	Cpuused                 string    `json:"cpuused"`
+	Cpuwithoverprovisioning string    `json:"cpuwithoverprovisioning"`
+	Created                 string    `json:"created"`
+	Details                 StringMap `json:"details"`
+	Disconnected            string    `json:"disconnected"`
+	Disksizeallocated       int64     `json:"disksizeallocated"`
+	Disksizetotal           int64     `json:"disksizetotal"`
+	Events                  string    `json:"events"`
 	Gpugroup                []struct {
 		Gpugroupname string `json:"gpugroupname"`
 		Vgpu         []struct {
@@ -253,6 +525,16 @@ type AddGloboDnsHostParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *AddGloboDnsHostParams) Clone() *AddGloboDnsHostParams {
+	c := &AddGloboDnsHostParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *AddGloboDnsHostParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -273,6 +555,16 @@ func (p *AddGloboDnsHostParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *AddGloboDnsHostParams) validate() error {
+	for _, k := range []string{"password", "physicalnetworkid", "url", "username"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of addGloboDnsHost", k)
+		}
+	}
+	return nil
+}
+
 func (p *AddGloboDnsHostParams) SetPassword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -281,6 +573,13 @@ func (p *AddGloboDnsHostParams) SetPassword(v string) {
 	return
 }
 
+// SetPasswordIfUnset sets password to v, unless it is already set
+func (p *AddGloboDnsHostParams) SetPasswordIfUnset(v string) {
+	if _, found := p.p["password"]; !found {
+		p.SetPassword(v)
+	}
+}
+
 func (p *AddGloboDnsHostParams) SetPhysicalnetworkid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -289,6 +588,13 @@ func (p *AddGloboDnsHostParams) SetPhysicalnetworkid(v string) {
 	return
 }
 
+// SetPhysicalnetworkidIfUnset sets physicalnetworkid to v, unless it is already set
+func (p *AddGloboDnsHostParams) SetPhysicalnetworkidIfUnset(v string) {
+	if _, found := p.p["physicalnetworkid"]; !found {
+		p.SetPhysicalnetworkid(v)
+	}
+}
+
 func (p *AddGloboDnsHostParams) SetUrl(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -297,6 +603,13 @@ func (p *AddGloboDnsHostParams) SetUrl(v string) {
 	return
 }
 
+// SetUrlIfUnset sets url to v, unless it is already set
+func (p *AddGloboDnsHostParams) SetUrlIfUnset(v string) {
+	if _, found := p.p["url"]; !found {
+		p.SetUrl(v)
+	}
+}
+
 func (p *AddGloboDnsHostParams) SetUsername(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -305,21 +618,73 @@ func (p *AddGloboDnsHostParams) SetUsername(v string) {
 	return
 }
 
+// SetUsernameIfUnset sets username to v, unless it is already set
+func (p *AddGloboDnsHostParams) SetUsernameIfUnset(v string) {
+	if _, found := p.p["username"]; !found {
+		p.SetUsername(v)
+	}
+}
+
 // You should always use this function to get a new AddGloboDnsHostParams instance,
-// as then you are sure you have configured all required params
-func (s *HostService) NewAddGloboDnsHostParams(password string, physicalnetworkid string, url string, username string) *AddGloboDnsHostParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *HostService) NewAddGloboDnsHostParams(password string, physicalnetworkid string, url string, username string, opts ...func(*AddGloboDnsHostParams)) *AddGloboDnsHostParams {
 	p := &AddGloboDnsHostParams{}
 	p.p = make(map[string]interface{})
 	p.p["password"] = password
 	p.p["physicalnetworkid"] = physicalnetworkid
 	p.p["url"] = url
 	p.p["username"] = username
+	for _, fn := range opts {
+		fn(p)
+	}
+	return p
+}
+
+// AddGloboDnsHostOpts contains all params that can be set on a AddGloboDnsHostParams.
+// Optional params are pointers (or slices and maps) which are only set when not nil.
+type AddGloboDnsHostOpts struct {
+	Password          string
+	Physicalnetworkid string
+	Url               string
+	Username          string
+}
+
+// NewAddGloboDnsHostParamsFromOpts is an alternative for NewAddGloboDnsHostParams which takes all params using
+// an options struct, as that is easier to read for APIs with many required params
+func (s *HostService) NewAddGloboDnsHostParamsFromOpts(o AddGloboDnsHostOpts) *AddGloboDnsHostParams {
+	p := s.NewAddGloboDnsHostParams(o.Password, o.Physicalnetworkid, o.Url, o.Username)
 	return p
 }
 
 // Adds the GloboDNS external host
-func (s *HostService) AddGloboDnsHost(p *AddGloboDnsHostParams) (*AddGloboDnsHostResponse, error) {
-	resp, err := s.cs.newRequest("addGloboDnsHost", p.toURLValues())
+func (s *HostService) AddGloboDnsHost(p *AddGloboDnsHostParams, opts ...OptionFunc) (*AddGloboDnsHostResponse, error) {
+	return s.AddGloboDnsHostWithContext(context.Background(), p, opts...)
+}
+
+// AddGloboDnsHostWithContext is the same as AddGloboDnsHost, but the request is cancelled when the context is done
+func (s *HostService) AddGloboDnsHostWithContext(ctx context.Context, p *AddGloboDnsHostParams, opts ...OptionFunc) (*AddGloboDnsHostResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.AddGloboDnsHostRawWithContext(ctx, p.toURLValues())
+}
+
+// AddGloboDnsHostRaw is the same as AddGloboDnsHost, but takes the params as url.Values instead of typed params
+func (s *HostService) AddGloboDnsHostRaw(v url.Values) (*AddGloboDnsHostResponse, error) {
+	return s.AddGloboDnsHostRawWithContext(context.Background(), v)
+}
+
+// AddGloboDnsHostRawWithContext is the same as AddGloboDnsHostRaw, but the request is cancelled when the context is done
+func (s *HostService) AddGloboDnsHostRawWithContext(ctx context.Context, v url.Values) (*AddGloboDnsHostResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "addGloboDnsHost", "addglobodnshostresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -331,9 +696,9 @@ func (s *HostService) AddGloboDnsHost(p *AddGloboDnsHostParams) (*AddGloboDnsHos
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -357,6 +722,16 @@ type AddHostParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *AddHostParams) Clone() *AddHostParams {
+	c := &AddHostParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *AddHostParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -396,6 +771,16 @@ func (p *AddHostParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *AddHostParams) validate() error {
+	for _, k := range []string{"hypervisor", "password", "podid", "url", "username", "zoneid"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of addHost", k)
+		}
+	}
+	return nil
+}
+
 func (p *AddHostParams) SetAllocationstate(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -404,6 +789,13 @@ func (p *AddHostParams) SetAllocationstate(v string) {
 	return
 }
 
+// SetAllocationstateIfUnset sets allocationstate to v, unless it is already set
+func (p *AddHostParams) SetAllocationstateIfUnset(v string) {
+	if _, found := p.p["allocationstate"]; !found {
+		p.SetAllocationstate(v)
+	}
+}
+
 func (p *AddHostParams) SetClusterid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -412,6 +804,13 @@ func (p *AddHostParams) SetClusterid(v string) {
 	return
 }
 
+// SetClusteridIfUnset sets clusterid to v, unless it is already set
+func (p *AddHostParams) SetClusteridIfUnset(v string) {
+	if _, found := p.p["clusterid"]; !found {
+		p.SetClusterid(v)
+	}
+}
+
 func (p *AddHostParams) SetClustername(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -420,6 +819,13 @@ func (p *AddHostParams) SetClustername(v string) {
 	return
 }
 
+// SetClusternameIfUnset sets clustername to v, unless it is already set
+func (p *AddHostParams) SetClusternameIfUnset(v string) {
+	if _, found := p.p["clustername"]; !found {
+		p.SetClustername(v)
+	}
+}
+
 func (p *AddHostParams) SetHosttags(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -428,14 +834,33 @@ func (p *AddHostParams) SetHosttags(v []string) {
 	return
 }
 
-func (p *AddHostParams) SetHypervisor(v string) {
+// SetHosttagsIfUnset sets hosttags to v, unless it is already set
+func (p *AddHostParams) SetHosttagsIfUnset(v []string) {
+	if _, found := p.p["hosttags"]; !found {
+		p.SetHosttags(v)
+	}
+}
+
+// SetHosttagsCSV splits v on commas and sets the resulting values
+func (p *AddHostParams) SetHosttagsCSV(v string) {
+	p.SetHosttags(splitCSV(v))
+}
+
+func (p *AddHostParams) SetHypervisor(v HypervisorType) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	p.p["hypervisor"] = v
+	p.p["hypervisor"] = string(v)
 	return
 }
 
+// SetHypervisorIfUnset sets hypervisor to v, unless it is already set
+func (p *AddHostParams) SetHypervisorIfUnset(v HypervisorType) {
+	if _, found := p.p["hypervisor"]; !found {
+		p.SetHypervisor(v)
+	}
+}
+
 func (p *AddHostParams) SetPassword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -444,6 +869,13 @@ func (p *AddHostParams) SetPassword(v string) {
 	return
 }
 
+// SetPasswordIfUnset sets password to v, unless it is already set
+func (p *AddHostParams) SetPasswordIfUnset(v string) {
+	if _, found := p.p["password"]; !found {
+		p.SetPassword(v)
+	}
+}
+
 func (p *AddHostParams) SetPodid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -452,6 +884,13 @@ func (p *AddHostParams) SetPodid(v string) {
 	return
 }
 
+// SetPodidIfUnset sets podid to v, unless it is already set
+func (p *AddHostParams) SetPodidIfUnset(v string) {
+	if _, found := p.p["podid"]; !found {
+		p.SetPodid(v)
+	}
+}
+
 func (p *AddHostParams) SetUrl(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -460,6 +899,13 @@ func (p *AddHostParams) SetUrl(v string) {
 	return
 }
 
+// SetUrlIfUnset sets url to v, unless it is already set
+func (p *AddHostParams) SetUrlIfUnset(v string) {
+	if _, found := p.p["url"]; !found {
+		p.SetUrl(v)
+	}
+}
+
 func (p *AddHostParams) SetUsername(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -468,6 +914,13 @@ func (p *AddHostParams) SetUsername(v string) {
 	return
 }
 
+// SetUsernameIfUnset sets username to v, unless it is already set
+func (p *AddHostParams) SetUsernameIfUnset(v string) {
+	if _, found := p.p["username"]; !found {
+		p.SetUsername(v)
+	}
+}
+
 func (p *AddHostParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -476,9 +929,17 @@ func (p *AddHostParams) SetZoneid(v string) {
 	return
 }
 
+// SetZoneidIfUnset sets zoneid to v, unless it is already set
+func (p *AddHostParams) SetZoneidIfUnset(v string) {
+	if _, found := p.p["zoneid"]; !found {
+		p.SetZoneid(v)
+	}
+}
+
 // You should always use this function to get a new AddHostParams instance,
-// as then you are sure you have configured all required params
-func (s *HostService) NewAddHostParams(hypervisor string, password string, podid string, url string, username string, zoneid string) *AddHostParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *HostService) NewAddHostParams(hypervisor string, password string, podid string, url string, username string, zoneid string, opts ...func(*AddHostParams)) *AddHostParams {
 	p := &AddHostParams{}
 	p.p = make(map[string]interface{})
 	p.p["hypervisor"] = hypervisor
@@ -487,12 +948,74 @@ func (s *HostService) NewAddHostParams(hypervisor string, password string, podid
 	p.p["url"] = url
 	p.p["username"] = username
 	p.p["zoneid"] = zoneid
+	for _, fn := range opts {
+		fn(p)
+	}
+	return p
+}
+
+// AddHostOpts contains all params that can be set on a AddHostParams.
+// Optional params are pointers (or slices and maps) which are only set when not nil.
+type AddHostOpts struct {
+	Allocationstate *string
+	Clusterid       *string
+	Clustername     *string
+	Hosttags        []string
+	Hypervisor      HypervisorType
+	Password        string
+	Podid           string
+	Url             string
+	Username        string
+	Zoneid          string
+}
+
+// NewAddHostParamsFromOpts is an alternative for NewAddHostParams which takes all params using
+// an options struct, as that is easier to read for APIs with many required params
+func (s *HostService) NewAddHostParamsFromOpts(o AddHostOpts) *AddHostParams {
+	p := s.NewAddHostParams(string(o.Hypervisor), o.Password, o.Podid, o.Url, o.Username, o.Zoneid)
+	if o.Allocationstate != nil {
+		p.p["allocationstate"] = *o.Allocationstate
+	}
+	if o.Clusterid != nil {
+		p.p["clusterid"] = *o.Clusterid
+	}
+	if o.Clustername != nil {
+		p.p["clustername"] = *o.Clustername
+	}
+	if o.Hosttags != nil {
+		p.p["hosttags"] = o.Hosttags
+	}
 	return p
 }
 
 // Adds a new host.
-func (s *HostService) AddHost(p *AddHostParams) (*AddHostResponse, error) {
-	resp, err := s.cs.newRequest("addHost", p.toURLValues())
+func (s *HostService) AddHost(p *AddHostParams, opts ...OptionFunc) (*AddHostResponse, error) {
+	return s.AddHostWithContext(context.Background(), p, opts...)
+}
+
+// AddHostWithContext is the same as AddHost, but the request is cancelled when the context is done
+func (s *HostService) AddHostWithContext(ctx context.Context, p *AddHostParams, opts ...OptionFunc) (*AddHostResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.AddHostRawWithContext(ctx, p.toURLValues())
+}
+
+// AddHostRaw is the same as AddHost, but takes the params as url.Values instead of typed params
+func (s *HostService) AddHostRaw(v url.Values) (*AddHostResponse, error) {
+	return s.AddHostRawWithContext(context.Background(), v)
+}
+
+// AddHostRawWithContext is the same as AddHostRaw, but the request is cancelled when the context is done
+func (s *HostService) AddHostRawWithContext(ctx context.Context, v url.Values) (*AddHostResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "addHost", "addhostresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -506,23 +1029,23 @@ func (s *HostService) AddHost(p *AddHostParams) (*AddHostResponse, error) {
 }
 
 type AddHostResponse struct {
-	Averageload             int64             `json:"averageload"`
-	Capabilities            string            `json:"capabilities"`
-	Clusterid               string            `json:"clusterid"`
-	Clustername             string            `json:"clustername"`
-	Clustertype             string            `json:"clustertype"`
-	Cpuallocated            string            `json:"cpuallocated"`
-	Cpunumber               int               `json:"cpunumber"`
-	Cpusockets              int               `json:"cpusockets"`
-	Cpuspeed                int64             `json:"cpuspeed"`
-	Cpuused                 string            `json:"cpuused"`
-	Cpuwithoverprovisioning string            `json:"cpuwithoverprovisioning"`
-	Created                 string            `json:"created"`
-	Details                 map[string]string `json:"details"`
-	Disconnected            string            `json:"disconnected"`
-	Disksizeallocated       int64             `json:"disksizeallocated"`
-	Disksizetotal           int64             `json:"disksizetotal"`
-	Events                  string            `json:"events"`
+	Averageload             int64     `json:"averageload"`
+	Capabilities            string    `json:"capabilities"`
+	Clusterid               string    `json:"clusterid"`
+	Clustername             string    `json:"clustername"`
+	Clustertype             string    `json:"clustertype"`
+	Cpuallocated            string    `json:"cpuallocated"`
+	Cpunumber               int       `json:"cpunumber"`
+	Cpusockets              int       `json:"cpusockets"`
+	Cpuspeed                int64     `json:"cpuspeed"`
+	Cpuused                 string    `json:"cpuused"`
+	Cpuwithoverprovisioning string    `json:"cpuwithoverprovisioning"`
+	Created                 string    `json:"created"`
+	Details                 StringMap `json:"details"`
+	Disconnected            string    `json:"disconnected"`
+	Disksizeallocated       int64     `json:"disksizeallocated"`
+	Disksizetotal           int64     `json:"disksizetotal"`
+	Events                  string    `json:"events"`
 	Gpugroup                []struct {
 		Gpugroupname string `json:"gpugroupname"`
 		Vgpu         []struct {
@@ -571,6 +1094,16 @@ type AddSecondaryStorageParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *AddSecondaryStorageParams) Clone() *AddSecondaryStorageParams {
+	c := &AddSecondaryStorageParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *AddSecondaryStorageParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -585,6 +1118,16 @@ func (p *AddSecondaryStorageParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *AddSecondaryStorageParams) validate() error {
+	for _, k := range []string{"url"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of addSecondaryStorage", k)
+		}
+	}
+	return nil
+}
+
 func (p *AddSecondaryStorageParams) SetUrl(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -593,6 +1136,13 @@ func (p *AddSecondaryStorageParams) SetUrl(v string) {
 	return
 }
 
+// SetUrlIfUnset sets url to v, unless it is already set
+func (p *AddSecondaryStorageParams) SetUrlIfUnset(v string) {
+	if _, found := p.p["url"]; !found {
+		p.SetUrl(v)
+	}
+}
+
 func (p *AddSecondaryStorageParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -601,18 +1151,54 @@ func (p *AddSecondaryStorageParams) SetZoneid(v string) {
 	return
 }
 
+// SetZoneidIfUnset sets zoneid to v, unless it is already set
+func (p *AddSecondaryStorageParams) SetZoneidIfUnset(v string) {
+	if _, found := p.p["zoneid"]; !found {
+		p.SetZoneid(v)
+	}
+}
+
 // You should always use this function to get a new AddSecondaryStorageParams instance,
-// as then you are sure you have configured all required params
-func (s *HostService) NewAddSecondaryStorageParams(url string) *AddSecondaryStorageParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *HostService) NewAddSecondaryStorageParams(url string, opts ...func(*AddSecondaryStorageParams)) *AddSecondaryStorageParams {
 	p := &AddSecondaryStorageParams{}
 	p.p = make(map[string]interface{})
 	p.p["url"] = url
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Adds secondary storage.
-func (s *HostService) AddSecondaryStorage(p *AddSecondaryStorageParams) (*AddSecondaryStorageResponse, error) {
-	resp, err := s.cs.newRequest("addSecondaryStorage", p.toURLValues())
+func (s *HostService) AddSecondaryStorage(p *AddSecondaryStorageParams, opts ...OptionFunc) (*AddSecondaryStorageResponse, error) {
+	return s.AddSecondaryStorageWithContext(context.Background(), p, opts...)
+}
+
+// AddSecondaryStorageWithContext is the same as AddSecondaryStorage, but the request is cancelled when the context is done
+func (s *HostService) AddSecondaryStorageWithContext(ctx context.Context, p *AddSecondaryStorageParams, opts ...OptionFunc) (*AddSecondaryStorageResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.AddSecondaryStorageRawWithContext(ctx, p.toURLValues())
+}
+
+// AddSecondaryStorageRaw is the same as AddSecondaryStorage, but takes the params as url.Values instead of typed params
+func (s *HostService) AddSecondaryStorageRaw(v url.Values) (*AddSecondaryStorageResponse, error) {
+	return s.AddSecondaryStorageRawWithContext(context.Background(), v)
+}
+
+// AddSecondaryStorageRawWithContext is the same as AddSecondaryStorageRaw, but the request is cancelled when the context is done
+func (s *HostService) AddSecondaryStorageRawWithContext(ctx context.Context, v url.Values) (*AddSecondaryStorageResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "addSecondaryStorage", "addsecondarystorageresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -641,6 +1227,16 @@ type CancelHostMaintenanceParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *CancelHostMaintenanceParams) Clone() *CancelHostMaintenanceParams {
+	c := &CancelHostMaintenanceParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *CancelHostMaintenanceParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -652,6 +1248,16 @@ func (p *CancelHostMaintenanceParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *CancelHostMaintenanceParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of cancelHostMaintenance", k)
+		}
+	}
+	return nil
+}
+
 func (p *CancelHostMaintenanceParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -660,18 +1266,54 @@ func (p *CancelHostMaintenanceParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *CancelHostMaintenanceParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 // You should always use this function to get a new CancelHostMaintenanceParams instance,
-// as then you are sure you have configured all required params
-func (s *HostService) NewCancelHostMaintenanceParams(id string) *CancelHostMaintenanceParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *HostService) NewCancelHostMaintenanceParams(id string, opts ...func(*CancelHostMaintenanceParams)) *CancelHostMaintenanceParams {
 	p := &CancelHostMaintenanceParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Cancels host maintenance.
-func (s *HostService) CancelHostMaintenance(p *CancelHostMaintenanceParams) (*CancelHostMaintenanceResponse, error) {
-	resp, err := s.cs.newRequest("cancelHostMaintenance", p.toURLValues())
+func (s *HostService) CancelHostMaintenance(p *CancelHostMaintenanceParams, opts ...OptionFunc) (*CancelHostMaintenanceResponse, error) {
+	return s.CancelHostMaintenanceWithContext(context.Background(), p, opts...)
+}
+
+// CancelHostMaintenanceWithContext is the same as CancelHostMaintenance, but the request is cancelled when the context is done
+func (s *HostService) CancelHostMaintenanceWithContext(ctx context.Context, p *CancelHostMaintenanceParams, opts ...OptionFunc) (*CancelHostMaintenanceResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.CancelHostMaintenanceRawWithContext(ctx, p.toURLValues())
+}
+
+// CancelHostMaintenanceRaw is the same as CancelHostMaintenance, but takes the params as url.Values instead of typed params
+func (s *HostService) CancelHostMaintenanceRaw(v url.Values) (*CancelHostMaintenanceResponse, error) {
+	return s.CancelHostMaintenanceRawWithContext(context.Background(), v)
+}
+
+// CancelHostMaintenanceRawWithContext is the same as CancelHostMaintenanceRaw, but the request is cancelled when the context is done
+func (s *HostService) CancelHostMaintenanceRawWithContext(ctx context.Context, v url.Values) (*CancelHostMaintenanceResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "cancelHostMaintenance", "cancelhostmaintenanceresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -683,9 +1325,9 @@ func (s *HostService) CancelHostMaintenance(p *CancelHostMaintenanceParams) (*Ca
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -705,24 +1347,24 @@ func (s *HostService) CancelHostMaintenance(p *CancelHostMaintenanceParams) (*Ca
 }
 
 type CancelHostMaintenanceResponse struct {
-	JobID                   string            `json:"jobid"`
-	Averageload             int64             `json:"averageload"`
-	Capabilities            string            `json:"capabilities"`
-	Clusterid               string            `json:"clusterid"`
-	Clustername             string            `json:"clustername"`
-	Clustertype             string            `json:"clustertype"`
-	Cpuallocated            string            `json:"cpuallocated"`
-	Cpunumber               int               `json:"cpunumber"`
-	Cpusockets              int               `json:"cpusockets"`
-	Cpuspeed                int64             `json:"cpuspeed"`
-	Cpuused                 string            `json:"cpuused"`
-	Cpuwithoverprovisioning string            `json:"cpuwithoverprovisioning"`
-	Created                 string            `json:"created"`
-	Details                 map[string]string `json:"details"`
-	Disconnected            string            `json:"disconnected"`
-	Disksizeallocated       int64             `json:"disksizeallocated"`
-	Disksizetotal           int64             `json:"disksizetotal"`
-	Events                  string            `json:"events"`
+	JobID                   string    `json:"jobid"`
+	Averageload             int64     `json:"averageload"`
+	Capabilities            string    `json:"capabilities"`
+	Clusterid               string    `json:"clusterid"`
+	Clustername             string    `json:"clustername"`
+	Clustertype             string    `json:"clustertype"`
+	Cpuallocated            string    `json:"cpuallocated"`
+	Cpunumber               int       `json:"cpunumber"`
+	Cpusockets              int       `json:"cpusockets"`
+	Cpuspeed                int64     `json:"cpuspeed"`
+	Cpuused                 string    `json:"cpuused"`
+	Cpuwithoverprovisioning string    `json:"cpuwithoverprovisioning"`
+	Created                 string    `json:"created"`
+	Details                 StringMap `json:"details"`
+	Disconnected            string    `json:"disconnected"`
+	Disksizeallocated       int64     `json:"disksizeallocated"`
+	Disksizetotal           int64     `json:"disksizetotal"`
+	Events                  string    `json:"events"`
 	Gpugroup                []struct {
 		Gpugroupname string `json:"gpugroupname"`
 		Vgpu         []struct {
@@ -771,6 +1413,16 @@ type DedicateHostParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *DedicateHostParams) Clone() *DedicateHostParams {
+	c := &DedicateHostParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DedicateHostParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -788,6 +1440,16 @@ func (p *DedicateHostParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *DedicateHostParams) validate() error {
+	for _, k := range []string{"domainid", "hostid"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of dedicateHost", k)
+		}
+	}
+	return nil
+}
+
 func (p *DedicateHostParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -796,6 +1458,13 @@ func (p *DedicateHostParams) SetAccount(v string) {
 	return
 }
 
+// SetAccountIfUnset sets account to v, unless it is already set
+func (p *DedicateHostParams) SetAccountIfUnset(v string) {
+	if _, found := p.p["account"]; !found {
+		p.SetAccount(v)
+	}
+}
+
 func (p *DedicateHostParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -804,6 +1473,13 @@ func (p *DedicateHostParams) SetDomainid(v string) {
 	return
 }
 
+// SetDomainidIfUnset sets domainid to v, unless it is already set
+func (p *DedicateHostParams) SetDomainidIfUnset(v string) {
+	if _, found := p.p["domainid"]; !found {
+		p.SetDomainid(v)
+	}
+}
+
 func (p *DedicateHostParams) SetHostid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -812,19 +1488,55 @@ func (p *DedicateHostParams) SetHostid(v string) {
 	return
 }
 
+// SetHostidIfUnset sets hostid to v, unless it is already set
+func (p *DedicateHostParams) SetHostidIfUnset(v string) {
+	if _, found := p.p["hostid"]; !found {
+		p.SetHostid(v)
+	}
+}
+
 // You should always use this function to get a new DedicateHostParams instance,
-// as then you are sure you have configured all required params
-func (s *HostService) NewDedicateHostParams(domainid string, hostid string) *DedicateHostParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *HostService) NewDedicateHostParams(domainid string, hostid string, opts ...func(*DedicateHostParams)) *DedicateHostParams {
 	p := &DedicateHostParams{}
 	p.p = make(map[string]interface{})
 	p.p["domainid"] = domainid
 	p.p["hostid"] = hostid
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Dedicates a host.
-func (s *HostService) DedicateHost(p *DedicateHostParams) (*DedicateHostResponse, error) {
-	resp, err := s.cs.newRequest("dedicateHost", p.toURLValues())
+func (s *HostService) DedicateHost(p *DedicateHostParams, opts ...OptionFunc) (*DedicateHostResponse, error) {
+	return s.DedicateHostWithContext(context.Background(), p, opts...)
+}
+
+// DedicateHostWithContext is the same as DedicateHost, but the request is cancelled when the context is done
+func (s *HostService) DedicateHostWithContext(ctx context.Context, p *DedicateHostParams, opts ...OptionFunc) (*DedicateHostResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.DedicateHostRawWithContext(ctx, p.toURLValues())
+}
+
+// DedicateHostRaw is the same as DedicateHost, but takes the params as url.Values instead of typed params
+func (s *HostService) DedicateHostRaw(v url.Values) (*DedicateHostResponse, error) {
+	return s.DedicateHostRawWithContext(context.Background(), v)
+}
+
+// DedicateHostRawWithContext is the same as DedicateHostRaw, but the request is cancelled when the context is done
+func (s *HostService) DedicateHostRawWithContext(ctx context.Context, v url.Values) (*DedicateHostResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "dedicateHost", "dedicatehostresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -836,9 +1548,9 @@ func (s *HostService) DedicateHost(p *DedicateHostParams) (*DedicateHostResponse
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -871,6 +1583,16 @@ type DeleteHostParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *DeleteHostParams) Clone() *DeleteHostParams {
+	c := &DeleteHostParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeleteHostParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -890,6 +1612,16 @@ func (p *DeleteHostParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *DeleteHostParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of deleteHost", k)
+		}
+	}
+	return nil
+}
+
 func (p *DeleteHostParams) SetForced(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -898,6 +1630,13 @@ func (p *DeleteHostParams) SetForced(v bool) {
 	return
 }
 
+// SetForcedIfUnset sets forced to v, unless it is already set
+func (p *DeleteHostParams) SetForcedIfUnset(v bool) {
+	if _, found := p.p["forced"]; !found {
+		p.SetForced(v)
+	}
+}
+
 func (p *DeleteHostParams) SetForcedestroylocalstorage(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -906,6 +1645,13 @@ func (p *DeleteHostParams) SetForcedestroylocalstorage(v bool) {
 	return
 }
 
+// SetForcedestroylocalstorageIfUnset sets forcedestroylocalstorage to v, unless it is already set
+func (p *DeleteHostParams) SetForcedestroylocalstorageIfUnset(v bool) {
+	if _, found := p.p["forcedestroylocalstorage"]; !found {
+		p.SetForcedestroylocalstorage(v)
+	}
+}
+
 func (p *DeleteHostParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -914,18 +1660,54 @@ func (p *DeleteHostParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *DeleteHostParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 // You should always use this function to get a new DeleteHostParams instance,
-// as then you are sure you have configured all required params
-func (s *HostService) NewDeleteHostParams(id string) *DeleteHostParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *HostService) NewDeleteHostParams(id string, opts ...func(*DeleteHostParams)) *DeleteHostParams {
 	p := &DeleteHostParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Deletes a host.
-func (s *HostService) DeleteHost(p *DeleteHostParams) (*DeleteHostResponse, error) {
-	resp, err := s.cs.newRequest("deleteHost", p.toURLValues())
+func (s *HostService) DeleteHost(p *DeleteHostParams, opts ...OptionFunc) (*DeleteHostResponse, error) {
+	return s.DeleteHostWithContext(context.Background(), p, opts...)
+}
+
+// DeleteHostWithContext is the same as DeleteHost, but the request is cancelled when the context is done
+func (s *HostService) DeleteHostWithContext(ctx context.Context, p *DeleteHostParams, opts ...OptionFunc) (*DeleteHostResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.DeleteHostRawWithContext(ctx, p.toURLValues())
+}
+
+// DeleteHostRaw is the same as DeleteHost, but takes the params as url.Values instead of typed params
+func (s *HostService) DeleteHostRaw(v url.Values) (*DeleteHostResponse, error) {
+	return s.DeleteHostRawWithContext(context.Background(), v)
+}
+
+// DeleteHostRawWithContext is the same as DeleteHostRaw, but the request is cancelled when the context is done
+func (s *HostService) DeleteHostRawWithContext(ctx context.Context, v url.Values) (*DeleteHostResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteHost", "deletehostresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -938,6 +1720,34 @@ func (s *HostService) DeleteHost(p *DeleteHostParams) (*DeleteHostResponse, erro
 	return &r, nil
 }
 
+// WaitForHostDeleted polls until the Host with the given ID no longer exists, the timeout
+// expires or the context is cancelled. Deleted resources may still be listed for a short while
+// after the delete command finished, so this can be used to make sure they are really gone. The
+// options are passed to GetHostByID, so a resource outside of the default scope can be found.
+func (s *HostService) WaitForHostDeleted(ctx context.Context, id string, timeout time.Duration, opts ...OptionFunc) error {
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	for {
+		_, count, err := s.GetHostByID(id, opts...)
+		if count == 0 {
+			return nil
+		}
+		if count < 0 {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(2 * time.Second):
+		}
+	}
+}
+
 type DeleteHostResponse struct {
 	Displaytext string `json:"displaytext"`
 	Success     bool   `json:"success"`
@@ -966,6 +1776,16 @@ type DisableOutOfBandManagementForHostParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *DisableOutOfBandManagementForHostParams) Clone() *DisableOutOfBandManagementForHostParams {
+	c := &DisableOutOfBandManagementForHostParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DisableOutOfBandManagementForHostParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -977,6 +1797,16 @@ func (p *DisableOutOfBandManagementForHostParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *DisableOutOfBandManagementForHostParams) validate() error {
+	for _, k := range []string{"hostid"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of disableOutOfBandManagementForHost", k)
+		}
+	}
+	return nil
+}
+
 func (p *DisableOutOfBandManagementForHostParams) SetHostid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -985,18 +1815,54 @@ func (p *DisableOutOfBandManagementForHostParams) SetHostid(v string) {
 	return
 }
 
+// SetHostidIfUnset sets hostid to v, unless it is already set
+func (p *DisableOutOfBandManagementForHostParams) SetHostidIfUnset(v string) {
+	if _, found := p.p["hostid"]; !found {
+		p.SetHostid(v)
+	}
+}
+
 // You should always use this function to get a new DisableOutOfBandManagementForHostParams instance,
-// as then you are sure you have configured all required params
-func (s *HostService) NewDisableOutOfBandManagementForHostParams(hostid string) *DisableOutOfBandManagementForHostParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *HostService) NewDisableOutOfBandManagementForHostParams(hostid string, opts ...func(*DisableOutOfBandManagementForHostParams)) *DisableOutOfBandManagementForHostParams {
 	p := &DisableOutOfBandManagementForHostParams{}
 	p.p = make(map[string]interface{})
 	p.p["hostid"] = hostid
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Disables out-of-band management for a host
-func (s *HostService) DisableOutOfBandManagementForHost(p *DisableOutOfBandManagementForHostParams) (*DisableOutOfBandManagementForHostResponse, error) {
-	resp, err := s.cs.newRequest("disableOutOfBandManagementForHost", p.toURLValues())
+func (s *HostService) DisableOutOfBandManagementForHost(p *DisableOutOfBandManagementForHostParams, opts ...OptionFunc) (*DisableOutOfBandManagementForHostResponse, error) {
+	return s.DisableOutOfBandManagementForHostWithContext(context.Background(), p, opts...)
+}
+
+// DisableOutOfBandManagementForHostWithContext is the same as DisableOutOfBandManagementForHost, but the request is cancelled when the context is done
+func (s *HostService) DisableOutOfBandManagementForHostWithContext(ctx context.Context, p *DisableOutOfBandManagementForHostParams, opts ...OptionFunc) (*DisableOutOfBandManagementForHostResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.DisableOutOfBandManagementForHostRawWithContext(ctx, p.toURLValues())
+}
+
+// DisableOutOfBandManagementForHostRaw is the same as DisableOutOfBandManagementForHost, but takes the params as url.Values instead of typed params
+func (s *HostService) DisableOutOfBandManagementForHostRaw(v url.Values) (*DisableOutOfBandManagementForHostResponse, error) {
+	return s.DisableOutOfBandManagementForHostRawWithContext(context.Background(), v)
+}
+
+// DisableOutOfBandManagementForHostRawWithContext is the same as DisableOutOfBandManagementForHostRaw, but the request is cancelled when the context is done
+func (s *HostService) DisableOutOfBandManagementForHostRawWithContext(ctx context.Context, v url.Values) (*DisableOutOfBandManagementForHostResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "disableOutOfBandManagementForHost", "disableoutofbandmanagementforhostresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -1008,9 +1874,9 @@ func (s *HostService) DisableOutOfBandManagementForHost(p *DisableOutOfBandManag
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -1048,6 +1914,16 @@ type EnableOutOfBandManagementForHostParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *EnableOutOfBandManagementForHostParams) Clone() *EnableOutOfBandManagementForHostParams {
+	c := &EnableOutOfBandManagementForHostParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *EnableOutOfBandManagementForHostParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1059,6 +1935,16 @@ func (p *EnableOutOfBandManagementForHostParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *EnableOutOfBandManagementForHostParams) validate() error {
+	for _, k := range []string{"hostid"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of enableOutOfBandManagementForHost", k)
+		}
+	}
+	return nil
+}
+
 func (p *EnableOutOfBandManagementForHostParams) SetHostid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1067,18 +1953,54 @@ func (p *EnableOutOfBandManagementForHostParams) SetHostid(v string) {
 	return
 }
 
+// SetHostidIfUnset sets hostid to v, unless it is already set
+func (p *EnableOutOfBandManagementForHostParams) SetHostidIfUnset(v string) {
+	if _, found := p.p["hostid"]; !found {
+		p.SetHostid(v)
+	}
+}
+
 // You should always use this function to get a new EnableOutOfBandManagementForHostParams instance,
-// as then you are sure you have configured all required params
-func (s *HostService) NewEnableOutOfBandManagementForHostParams(hostid string) *EnableOutOfBandManagementForHostParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *HostService) NewEnableOutOfBandManagementForHostParams(hostid string, opts ...func(*EnableOutOfBandManagementForHostParams)) *EnableOutOfBandManagementForHostParams {
 	p := &EnableOutOfBandManagementForHostParams{}
 	p.p = make(map[string]interface{})
 	p.p["hostid"] = hostid
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Enables out-of-band management for a host
-func (s *HostService) EnableOutOfBandManagementForHost(p *EnableOutOfBandManagementForHostParams) (*EnableOutOfBandManagementForHostResponse, error) {
-	resp, err := s.cs.newRequest("enableOutOfBandManagementForHost", p.toURLValues())
+func (s *HostService) EnableOutOfBandManagementForHost(p *EnableOutOfBandManagementForHostParams, opts ...OptionFunc) (*EnableOutOfBandManagementForHostResponse, error) {
+	return s.EnableOutOfBandManagementForHostWithContext(context.Background(), p, opts...)
+}
+
+// EnableOutOfBandManagementForHostWithContext is the same as EnableOutOfBandManagementForHost, but the request is cancelled when the context is done
+func (s *HostService) EnableOutOfBandManagementForHostWithContext(ctx context.Context, p *EnableOutOfBandManagementForHostParams, opts ...OptionFunc) (*EnableOutOfBandManagementForHostResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.EnableOutOfBandManagementForHostRawWithContext(ctx, p.toURLValues())
+}
+
+// EnableOutOfBandManagementForHostRaw is the same as EnableOutOfBandManagementForHost, but takes the params as url.Values instead of typed params
+func (s *HostService) EnableOutOfBandManagementForHostRaw(v url.Values) (*EnableOutOfBandManagementForHostResponse, error) {
+	return s.EnableOutOfBandManagementForHostRawWithContext(context.Background(), v)
+}
+
+// EnableOutOfBandManagementForHostRawWithContext is the same as EnableOutOfBandManagementForHostRaw, but the request is cancelled when the context is done
+func (s *HostService) EnableOutOfBandManagementForHostRawWithContext(ctx context.Context, v url.Values) (*EnableOutOfBandManagementForHostResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "enableOutOfBandManagementForHost", "enableoutofbandmanagementforhostresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -1090,9 +2012,9 @@ func (s *HostService) EnableOutOfBandManagementForHost(p *EnableOutOfBandManagem
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -1130,6 +2052,16 @@ type FindHostsForMigrationParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *FindHostsForMigrationParams) Clone() *FindHostsForMigrationParams {
+	c := &FindHostsForMigrationParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *FindHostsForMigrationParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1152,6 +2084,16 @@ func (p *FindHostsForMigrationParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *FindHostsForMigrationParams) validate() error {
+	for _, k := range []string{"virtualmachineid"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of findHostsForMigration", k)
+		}
+	}
+	return nil
+}
+
 func (p *FindHostsForMigrationParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1160,6 +2102,13 @@ func (p *FindHostsForMigrationParams) SetKeyword(v string) {
 	return
 }
 
+// SetKeywordIfUnset sets keyword to v, unless it is already set
+func (p *FindHostsForMigrationParams) SetKeywordIfUnset(v string) {
+	if _, found := p.p["keyword"]; !found {
+		p.SetKeyword(v)
+	}
+}
+
 func (p *FindHostsForMigrationParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1168,6 +2117,13 @@ func (p *FindHostsForMigrationParams) SetPage(v int) {
 	return
 }
 
+// SetPageIfUnset sets page to v, unless it is already set
+func (p *FindHostsForMigrationParams) SetPageIfUnset(v int) {
+	if _, found := p.p["page"]; !found {
+		p.SetPage(v)
+	}
+}
+
 func (p *FindHostsForMigrationParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1176,6 +2132,13 @@ func (p *FindHostsForMigrationParams) SetPagesize(v int) {
 	return
 }
 
+// SetPagesizeIfUnset sets pagesize to v, unless it is already set
+func (p *FindHostsForMigrationParams) SetPagesizeIfUnset(v int) {
+	if _, found := p.p["pagesize"]; !found {
+		p.SetPagesize(v)
+	}
+}
+
 func (p *FindHostsForMigrationParams) SetVirtualmachineid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1184,18 +2147,54 @@ func (p *FindHostsForMigrationParams) SetVirtualmachineid(v string) {
 	return
 }
 
+// SetVirtualmachineidIfUnset sets virtualmachineid to v, unless it is already set
+func (p *FindHostsForMigrationParams) SetVirtualmachineidIfUnset(v string) {
+	if _, found := p.p["virtualmachineid"]; !found {
+		p.SetVirtualmachineid(v)
+	}
+}
+
 // You should always use this function to get a new FindHostsForMigrationParams instance,
-// as then you are sure you have configured all required params
-func (s *HostService) NewFindHostsForMigrationParams(virtualmachineid string) *FindHostsForMigrationParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *HostService) NewFindHostsForMigrationParams(virtualmachineid string, opts ...func(*FindHostsForMigrationParams)) *FindHostsForMigrationParams {
 	p := &FindHostsForMigrationParams{}
 	p.p = make(map[string]interface{})
 	p.p["virtualmachineid"] = virtualmachineid
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Find hosts suitable for migrating a virtual machine.
-func (s *HostService) FindHostsForMigration(p *FindHostsForMigrationParams) (*FindHostsForMigrationResponse, error) {
-	resp, err := s.cs.newRequest("findHostsForMigration", p.toURLValues())
+func (s *HostService) FindHostsForMigration(p *FindHostsForMigrationParams, opts ...OptionFunc) (*FindHostsForMigrationResponse, error) {
+	return s.FindHostsForMigrationWithContext(context.Background(), p, opts...)
+}
+
+// FindHostsForMigrationWithContext is the same as FindHostsForMigration, but the request is cancelled when the context is done
+func (s *HostService) FindHostsForMigrationWithContext(ctx context.Context, p *FindHostsForMigrationParams, opts ...OptionFunc) (*FindHostsForMigrationResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.FindHostsForMigrationRawWithContext(ctx, p.toURLValues())
+}
+
+// FindHostsForMigrationRaw is the same as FindHostsForMigration, but takes the params as url.Values instead of typed params
+func (s *HostService) FindHostsForMigrationRaw(v url.Values) (*FindHostsForMigrationResponse, error) {
+	return s.FindHostsForMigrationRawWithContext(context.Background(), v)
+}
+
+// FindHostsForMigrationRawWithContext is the same as FindHostsForMigrationRaw, but the request is cancelled when the context is done
+func (s *HostService) FindHostsForMigrationRawWithContext(ctx context.Context, v url.Values) (*FindHostsForMigrationResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "findHostsForMigration", "findhostsformigrationresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -1259,6 +2258,16 @@ type ListDedicatedHostsParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ListDedicatedHostsParams) Clone() *ListDedicatedHostsParams {
+	c := &ListDedicatedHostsParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListDedicatedHostsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1298,6 +2307,13 @@ func (p *ListDedicatedHostsParams) SetAccount(v string) {
 	return
 }
 
+// SetAccountIfUnset sets account to v, unless it is already set
+func (p *ListDedicatedHostsParams) SetAccountIfUnset(v string) {
+	if _, found := p.p["account"]; !found {
+		p.SetAccount(v)
+	}
+}
+
 func (p *ListDedicatedHostsParams) SetAffinitygroupid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1306,6 +2322,13 @@ func (p *ListDedicatedHostsParams) SetAffinitygroupid(v string) {
 	return
 }
 
+// SetAffinitygroupidIfUnset sets affinitygroupid to v, unless it is already set
+func (p *ListDedicatedHostsParams) SetAffinitygroupidIfUnset(v string) {
+	if _, found := p.p["affinitygroupid"]; !found {
+		p.SetAffinitygroupid(v)
+	}
+}
+
 func (p *ListDedicatedHostsParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1314,6 +2337,13 @@ func (p *ListDedicatedHostsParams) SetDomainid(v string) {
 	return
 }
 
+// SetDomainidIfUnset sets domainid to v, unless it is already set
+func (p *ListDedicatedHostsParams) SetDomainidIfUnset(v string) {
+	if _, found := p.p["domainid"]; !found {
+		p.SetDomainid(v)
+	}
+}
+
 func (p *ListDedicatedHostsParams) SetHostid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1322,6 +2352,13 @@ func (p *ListDedicatedHostsParams) SetHostid(v string) {
 	return
 }
 
+// SetHostidIfUnset sets hostid to v, unless it is already set
+func (p *ListDedicatedHostsParams) SetHostidIfUnset(v string) {
+	if _, found := p.p["hostid"]; !found {
+		p.SetHostid(v)
+	}
+}
+
 func (p *ListDedicatedHostsParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1330,6 +2367,13 @@ func (p *ListDedicatedHostsParams) SetKeyword(v string) {
 	return
 }
 
+// SetKeywordIfUnset sets keyword to v, unless it is already set
+func (p *ListDedicatedHostsParams) SetKeywordIfUnset(v string) {
+	if _, found := p.p["keyword"]; !found {
+		p.SetKeyword(v)
+	}
+}
+
 func (p *ListDedicatedHostsParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1338,6 +2382,13 @@ func (p *ListDedicatedHostsParams) SetPage(v int) {
 	return
 }
 
+// SetPageIfUnset sets page to v, unless it is already set
+func (p *ListDedicatedHostsParams) SetPageIfUnset(v int) {
+	if _, found := p.p["page"]; !found {
+		p.SetPage(v)
+	}
+}
+
 func (p *ListDedicatedHostsParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1346,17 +2397,43 @@ func (p *ListDedicatedHostsParams) SetPagesize(v int) {
 	return
 }
 
+// SetPagesizeIfUnset sets pagesize to v, unless it is already set
+func (p *ListDedicatedHostsParams) SetPagesizeIfUnset(v int) {
+	if _, found := p.p["pagesize"]; !found {
+		p.SetPagesize(v)
+	}
+}
+
 // You should always use this function to get a new ListDedicatedHostsParams instance,
-// as then you are sure you have configured all required params
-func (s *HostService) NewListDedicatedHostsParams() *ListDedicatedHostsParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *HostService) NewListDedicatedHostsParams(opts ...func(*ListDedicatedHostsParams)) *ListDedicatedHostsParams {
 	p := &ListDedicatedHostsParams{}
 	p.p = make(map[string]interface{})
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Lists dedicated hosts.
 func (s *HostService) ListDedicatedHosts(p *ListDedicatedHostsParams) (*ListDedicatedHostsResponse, error) {
-	resp, err := s.cs.newRequest("listDedicatedHosts", p.toURLValues())
+	return s.ListDedicatedHostsWithContext(context.Background(), p)
+}
+
+// ListDedicatedHostsWithContext is the same as ListDedicatedHosts, but the request is cancelled when the context is done
+func (s *HostService) ListDedicatedHostsWithContext(ctx context.Context, p *ListDedicatedHostsParams) (*ListDedicatedHostsResponse, error) {
+	return s.ListDedicatedHostsRawWithContext(ctx, p.toURLValues())
+}
+
+// ListDedicatedHostsRaw is the same as ListDedicatedHosts, but takes the params as url.Values instead of typed params
+func (s *HostService) ListDedicatedHostsRaw(v url.Values) (*ListDedicatedHostsResponse, error) {
+	return s.ListDedicatedHostsRawWithContext(context.Background(), v)
+}
+
+// ListDedicatedHostsRawWithContext is the same as ListDedicatedHostsRaw, but the request is cancelled when the context is done
+func (s *HostService) ListDedicatedHostsRawWithContext(ctx context.Context, v url.Values) (*ListDedicatedHostsResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "listDedicatedHosts", "listdedicatedhostsresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -1369,6 +2446,93 @@ func (s *HostService) ListDedicatedHosts(p *ListDedicatedHostsParams) (*ListDedi
 	return &r, nil
 }
 
+// StreamDedicatedHosts fetches the results of ListDedicatedHosts page by page in the background and sends them on the
+// returned channel. Both returned channels are closed when done. If fetching a page fails, or the context
+// is done before all results are sent, the error is sent on the error channel and fetching stops. A
+// request for a page in flight is cancelled when the context is done. The page and pagesize set in the
+// params are ignored.
+func (s *HostService) StreamDedicatedHosts(ctx context.Context, p *ListDedicatedHostsParams) (<-chan *DedicatedHost, <-chan error) {
+	ch := make(chan *DedicatedHost)
+	errs := make(chan error, 1)
+
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesize(500)
+
+	go func() {
+		defer close(errs)
+		defer close(ch)
+
+		for page, count := 1, 0; ; page++ {
+			pp.SetPage(page)
+
+			l, err := s.ListDedicatedHostsWithContext(ctx, pp)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			for _, v := range l.DedicatedHosts {
+				select {
+				case ch <- v:
+				case <-ctx.Done():
+					errs <- ctx.Err()
+					return
+				}
+			}
+
+			count += len(l.DedicatedHosts)
+			if len(l.DedicatedHosts) < 500 || count >= l.Count {
+				return
+			}
+		}
+	}()
+
+	return ch, errs
+}
+
+// ListDedicatedHostsWhere returns all results of ListDedicatedHosts for which pred returns true. Note that the results are
+// filtered client-side, so all results matching the params are fetched first.
+// All pages are fetched, so the page and pagesize set in the params are ignored.
+func (s *HostService) ListDedicatedHostsWhere(p *ListDedicatedHostsParams, pred func(*DedicatedHost) bool) ([]*DedicatedHost, error) {
+	var r []*DedicatedHost
+	ch, errs := s.StreamDedicatedHosts(context.Background(), p)
+	for v := range ch {
+		if pred(v) {
+			r = append(r, v)
+		}
+	}
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ListAllDedicatedHosts returns the results of ListDedicatedHosts across all pages, by calling ListDedicatedHosts page by page until all
+// results are fetched. The pagesize set in the params is used for every page (500 if not set), and the
+// page set in the params is ignored.
+func (s *HostService) ListAllDedicatedHosts(p *ListDedicatedHostsParams) ([]*DedicatedHost, error) {
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesizeIfUnset(500)
+	pagesize := pp.p["pagesize"].(int)
+
+	var r []*DedicatedHost
+	for page := 1; ; page++ {
+		pp.SetPage(page)
+
+		l, err := s.ListDedicatedHosts(pp)
+		if err != nil {
+			return nil, err
+		}
+		r = append(r, l.DedicatedHosts...)
+
+		if len(l.DedicatedHosts) < pagesize || len(r) >= l.Count {
+			return r, nil
+		}
+	}
+}
+
 type ListDedicatedHostsResponse struct {
 	Count          int              `json:"count"`
 	DedicatedHosts []*DedicatedHost `json:"dedicatedhost"`
@@ -1387,6 +2551,16 @@ type ListHostTagsParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ListHostTagsParams) Clone() *ListHostTagsParams {
+	c := &ListHostTagsParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListHostTagsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1414,6 +2588,13 @@ func (p *ListHostTagsParams) SetKeyword(v string) {
 	return
 }
 
+// SetKeywordIfUnset sets keyword to v, unless it is already set
+func (p *ListHostTagsParams) SetKeywordIfUnset(v string) {
+	if _, found := p.p["keyword"]; !found {
+		p.SetKeyword(v)
+	}
+}
+
 func (p *ListHostTagsParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1422,6 +2603,13 @@ func (p *ListHostTagsParams) SetPage(v int) {
 	return
 }
 
+// SetPageIfUnset sets page to v, unless it is already set
+func (p *ListHostTagsParams) SetPageIfUnset(v int) {
+	if _, found := p.p["page"]; !found {
+		p.SetPage(v)
+	}
+}
+
 func (p *ListHostTagsParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1430,14 +2618,32 @@ func (p *ListHostTagsParams) SetPagesize(v int) {
 	return
 }
 
+// SetPagesizeIfUnset sets pagesize to v, unless it is already set
+func (p *ListHostTagsParams) SetPagesizeIfUnset(v int) {
+	if _, found := p.p["pagesize"]; !found {
+		p.SetPagesize(v)
+	}
+}
+
 // You should always use this function to get a new ListHostTagsParams instance,
-// as then you are sure you have configured all required params
-func (s *HostService) NewListHostTagsParams() *ListHostTagsParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *HostService) NewListHostTagsParams(opts ...func(*ListHostTagsParams)) *ListHostTagsParams {
 	p := &ListHostTagsParams{}
 	p.p = make(map[string]interface{})
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
+func (p *ListHostTagsParams) setFirstMatch() {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p[firstMatchKey] = true
+}
+
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *HostService) GetHostTagID(keyword string, opts ...OptionFunc) (string, int, error) {
 	p := &ListHostTagsParams{}
@@ -1445,7 +2651,7 @@ func (s *HostService) GetHostTagID(keyword string, opts ...OptionFunc) (string,
 
 	p.p["keyword"] = keyword
 
-	for _, fn := range append(s.cs.options, opts...) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
 		if err := fn(s.cs, p); err != nil {
 			return "", -1, err
 		}
@@ -1457,7 +2663,7 @@ func (s *HostService) GetHostTagID(keyword string, opts ...OptionFunc) (string,
 	}
 
 	if l.Count == 0 {
-		return "", l.Count, fmt.Errorf("No match found for %s: %+v", keyword, l)
+		return "", l.Count, fmt.Errorf("%w for %s: %+v", ErrNotFound, keyword, l)
 	}
 
 	if l.Count == 1 {
@@ -1470,13 +2676,32 @@ func (s *HostService) GetHostTagID(keyword string, opts ...OptionFunc) (string,
 				return v.Id, l.Count, nil
 			}
 		}
+
+		if _, ok := p.p[firstMatchKey]; ok && len(l.HostTags) > 0 {
+			return l.HostTags[0].Id, l.Count, nil
+		}
 	}
 	return "", l.Count, fmt.Errorf("Could not find an exact match for %s: %+v", keyword, l)
 }
 
 // Lists host tags
 func (s *HostService) ListHostTags(p *ListHostTagsParams) (*ListHostTagsResponse, error) {
-	resp, err := s.cs.newRequest("listHostTags", p.toURLValues())
+	return s.ListHostTagsWithContext(context.Background(), p)
+}
+
+// ListHostTagsWithContext is the same as ListHostTags, but the request is cancelled when the context is done
+func (s *HostService) ListHostTagsWithContext(ctx context.Context, p *ListHostTagsParams) (*ListHostTagsResponse, error) {
+	return s.ListHostTagsRawWithContext(ctx, p.toURLValues())
+}
+
+// ListHostTagsRaw is the same as ListHostTags, but takes the params as url.Values instead of typed params
+func (s *HostService) ListHostTagsRaw(v url.Values) (*ListHostTagsResponse, error) {
+	return s.ListHostTagsRawWithContext(context.Background(), v)
+}
+
+// ListHostTagsRawWithContext is the same as ListHostTagsRaw, but the request is cancelled when the context is done
+func (s *HostService) ListHostTagsRawWithContext(ctx context.Context, v url.Values) (*ListHostTagsResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "listHostTags", "listhosttagsresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -1489,6 +2714,93 @@ func (s *HostService) ListHostTags(p *ListHostTagsParams) (*ListHostTagsResponse
 	return &r, nil
 }
 
+// StreamHostTags fetches the results of ListHostTags page by page in the background and sends them on the
+// returned channel. Both returned channels are closed when done. If fetching a page fails, or the context
+// is done before all results are sent, the error is sent on the error channel and fetching stops. A
+// request for a page in flight is cancelled when the context is done. The page and pagesize set in the
+// params are ignored.
+func (s *HostService) StreamHostTags(ctx context.Context, p *ListHostTagsParams) (<-chan *HostTag, <-chan error) {
+	ch := make(chan *HostTag)
+	errs := make(chan error, 1)
+
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesize(500)
+
+	go func() {
+		defer close(errs)
+		defer close(ch)
+
+		for page, count := 1, 0; ; page++ {
+			pp.SetPage(page)
+
+			l, err := s.ListHostTagsWithContext(ctx, pp)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			for _, v := range l.HostTags {
+				select {
+				case ch <- v:
+				case <-ctx.Done():
+					errs <- ctx.Err()
+					return
+				}
+			}
+
+			count += len(l.HostTags)
+			if len(l.HostTags) < 500 || count >= l.Count {
+				return
+			}
+		}
+	}()
+
+	return ch, errs
+}
+
+// ListHostTagsWhere returns all results of ListHostTags for which pred returns true. Note that the results are
+// filtered client-side, so all results matching the params are fetched first.
+// All pages are fetched, so the page and pagesize set in the params are ignored.
+func (s *HostService) ListHostTagsWhere(p *ListHostTagsParams, pred func(*HostTag) bool) ([]*HostTag, error) {
+	var r []*HostTag
+	ch, errs := s.StreamHostTags(context.Background(), p)
+	for v := range ch {
+		if pred(v) {
+			r = append(r, v)
+		}
+	}
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ListAllHostTags returns the results of ListHostTags across all pages, by calling ListHostTags page by page until all
+// results are fetched. The pagesize set in the params is used for every page (500 if not set), and the
+// page set in the params is ignored.
+func (s *HostService) ListAllHostTags(p *ListHostTagsParams) ([]*HostTag, error) {
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesizeIfUnset(500)
+	pagesize := pp.p["pagesize"].(int)
+
+	var r []*HostTag
+	for page := 1; ; page++ {
+		pp.SetPage(page)
+
+		l, err := s.ListHostTags(pp)
+		if err != nil {
+			return nil, err
+		}
+		r = append(r, l.HostTags...)
+
+		if len(l.HostTags) < pagesize || len(r) >= l.Count {
+			return r, nil
+		}
+	}
+}
+
 type ListHostTagsResponse struct {
 	Count    int        `json:"count"`
 	HostTags []*HostTag `json:"hosttag"`
@@ -1504,6 +2816,16 @@ type ListHostsParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ListHostsParams) Clone() *ListHostsParams {
+	c := &ListHostsParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListHostsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1576,6 +2898,13 @@ func (p *ListHostsParams) SetClusterid(v string) {
 	return
 }
 
+// SetClusteridIfUnset sets clusterid to v, unless it is already set
+func (p *ListHostsParams) SetClusteridIfUnset(v string) {
+	if _, found := p.p["clusterid"]; !found {
+		p.SetClusterid(v)
+	}
+}
+
 func (p *ListHostsParams) SetDetails(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1584,6 +2913,18 @@ func (p *ListHostsParams) SetDetails(v []string) {
 	return
 }
 
+// SetDetailsIfUnset sets details to v, unless it is already set
+func (p *ListHostsParams) SetDetailsIfUnset(v []string) {
+	if _, found := p.p["details"]; !found {
+		p.SetDetails(v)
+	}
+}
+
+// SetDetailsCSV splits v on commas and sets the resulting values
+func (p *ListHostsParams) SetDetailsCSV(v string) {
+	p.SetDetails(splitCSV(v))
+}
+
 func (p *ListHostsParams) SetHahost(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1592,14 +2933,28 @@ func (p *ListHostsParams) SetHahost(v bool) {
 	return
 }
 
-func (p *ListHostsParams) SetHypervisor(v string) {
+// SetHahostIfUnset sets hahost to v, unless it is already set
+func (p *ListHostsParams) SetHahostIfUnset(v bool) {
+	if _, found := p.p["hahost"]; !found {
+		p.SetHahost(v)
+	}
+}
+
+func (p *ListHostsParams) SetHypervisor(v HypervisorType) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	p.p["hypervisor"] = v
+	p.p["hypervisor"] = string(v)
 	return
 }
 
+// SetHypervisorIfUnset sets hypervisor to v, unless it is already set
+func (p *ListHostsParams) SetHypervisorIfUnset(v HypervisorType) {
+	if _, found := p.p["hypervisor"]; !found {
+		p.SetHypervisor(v)
+	}
+}
+
 func (p *ListHostsParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1608,6 +2963,13 @@ func (p *ListHostsParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *ListHostsParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 func (p *ListHostsParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1616,6 +2978,13 @@ func (p *ListHostsParams) SetKeyword(v string) {
 	return
 }
 
+// SetKeywordIfUnset sets keyword to v, unless it is already set
+func (p *ListHostsParams) SetKeywordIfUnset(v string) {
+	if _, found := p.p["keyword"]; !found {
+		p.SetKeyword(v)
+	}
+}
+
 func (p *ListHostsParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1624,6 +2993,13 @@ func (p *ListHostsParams) SetName(v string) {
 	return
 }
 
+// SetNameIfUnset sets name to v, unless it is already set
+func (p *ListHostsParams) SetNameIfUnset(v string) {
+	if _, found := p.p["name"]; !found {
+		p.SetName(v)
+	}
+}
+
 func (p *ListHostsParams) SetOutofbandmanagementenabled(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1632,6 +3008,13 @@ func (p *ListHostsParams) SetOutofbandmanagementenabled(v bool) {
 	return
 }
 
+// SetOutofbandmanagementenabledIfUnset sets outofbandmanagementenabled to v, unless it is already set
+func (p *ListHostsParams) SetOutofbandmanagementenabledIfUnset(v bool) {
+	if _, found := p.p["outofbandmanagementenabled"]; !found {
+		p.SetOutofbandmanagementenabled(v)
+	}
+}
+
 func (p *ListHostsParams) SetOutofbandmanagementpowerstate(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1640,6 +3023,13 @@ func (p *ListHostsParams) SetOutofbandmanagementpowerstate(v string) {
 	return
 }
 
+// SetOutofbandmanagementpowerstateIfUnset sets outofbandmanagementpowerstate to v, unless it is already set
+func (p *ListHostsParams) SetOutofbandmanagementpowerstateIfUnset(v string) {
+	if _, found := p.p["outofbandmanagementpowerstate"]; !found {
+		p.SetOutofbandmanagementpowerstate(v)
+	}
+}
+
 func (p *ListHostsParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1648,6 +3038,13 @@ func (p *ListHostsParams) SetPage(v int) {
 	return
 }
 
+// SetPageIfUnset sets page to v, unless it is already set
+func (p *ListHostsParams) SetPageIfUnset(v int) {
+	if _, found := p.p["page"]; !found {
+		p.SetPage(v)
+	}
+}
+
 func (p *ListHostsParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1656,6 +3053,13 @@ func (p *ListHostsParams) SetPagesize(v int) {
 	return
 }
 
+// SetPagesizeIfUnset sets pagesize to v, unless it is already set
+func (p *ListHostsParams) SetPagesizeIfUnset(v int) {
+	if _, found := p.p["pagesize"]; !found {
+		p.SetPagesize(v)
+	}
+}
+
 func (p *ListHostsParams) SetPodid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1664,6 +3068,13 @@ func (p *ListHostsParams) SetPodid(v string) {
 	return
 }
 
+// SetPodidIfUnset sets podid to v, unless it is already set
+func (p *ListHostsParams) SetPodidIfUnset(v string) {
+	if _, found := p.p["podid"]; !found {
+		p.SetPodid(v)
+	}
+}
+
 func (p *ListHostsParams) SetResourcestate(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1672,6 +3083,13 @@ func (p *ListHostsParams) SetResourcestate(v string) {
 	return
 }
 
+// SetResourcestateIfUnset sets resourcestate to v, unless it is already set
+func (p *ListHostsParams) SetResourcestateIfUnset(v string) {
+	if _, found := p.p["resourcestate"]; !found {
+		p.SetResourcestate(v)
+	}
+}
+
 func (p *ListHostsParams) SetState(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1680,6 +3098,13 @@ func (p *ListHostsParams) SetState(v string) {
 	return
 }
 
+// SetStateIfUnset sets state to v, unless it is already set
+func (p *ListHostsParams) SetStateIfUnset(v string) {
+	if _, found := p.p["state"]; !found {
+		p.SetState(v)
+	}
+}
+
 func (p *ListHostsParams) SetType(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1688,6 +3113,13 @@ func (p *ListHostsParams) SetType(v string) {
 	return
 }
 
+// SetTypeIfUnset sets type to v, unless it is already set
+func (p *ListHostsParams) SetTypeIfUnset(v string) {
+	if _, found := p.p["type"]; !found {
+		p.SetType(v)
+	}
+}
+
 func (p *ListHostsParams) SetVirtualmachineid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1696,6 +3128,13 @@ func (p *ListHostsParams) SetVirtualmachineid(v string) {
 	return
 }
 
+// SetVirtualmachineidIfUnset sets virtualmachineid to v, unless it is already set
+func (p *ListHostsParams) SetVirtualmachineidIfUnset(v string) {
+	if _, found := p.p["virtualmachineid"]; !found {
+		p.SetVirtualmachineid(v)
+	}
+}
+
 func (p *ListHostsParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1704,14 +3143,32 @@ func (p *ListHostsParams) SetZoneid(v string) {
 	return
 }
 
+// SetZoneidIfUnset sets zoneid to v, unless it is already set
+func (p *ListHostsParams) SetZoneidIfUnset(v string) {
+	if _, found := p.p["zoneid"]; !found {
+		p.SetZoneid(v)
+	}
+}
+
 // You should always use this function to get a new ListHostsParams instance,
-// as then you are sure you have configured all required params
-func (s *HostService) NewListHostsParams() *ListHostsParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *HostService) NewListHostsParams(opts ...func(*ListHostsParams)) *ListHostsParams {
 	p := &ListHostsParams{}
 	p.p = make(map[string]interface{})
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
+func (p *ListHostsParams) setFirstMatch() {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p[firstMatchKey] = true
+}
+
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *HostService) GetHostID(name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListHostsParams{}
@@ -1719,7 +3176,7 @@ func (s *HostService) GetHostID(name string, opts ...OptionFunc) (string, int, e
 
 	p.p["name"] = name
 
-	for _, fn := range append(s.cs.options, opts...) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
 		if err := fn(s.cs, p); err != nil {
 			return "", -1, err
 		}
@@ -1731,7 +3188,7 @@ func (s *HostService) GetHostID(name string, opts ...OptionFunc) (string, int, e
 	}
 
 	if l.Count == 0 {
-		return "", l.Count, fmt.Errorf("No match found for %s: %+v", name, l)
+		return "", l.Count, fmt.Errorf("%w for %s: %+v", ErrNotFound, name, l)
 	}
 
 	if l.Count == 1 {
@@ -1744,6 +3201,10 @@ func (s *HostService) GetHostID(name string, opts ...OptionFunc) (string, int, e
 				return v.Id, l.Count, nil
 			}
 		}
+
+		if _, ok := p.p[firstMatchKey]; ok && len(l.Hosts) > 0 {
+			return l.Hosts[0].Id, l.Count, nil
+		}
 	}
 	return "", l.Count, fmt.Errorf("Could not find an exact match for %s: %+v", name, l)
 }
@@ -1769,7 +3230,7 @@ func (s *HostService) GetHostByID(id string, opts ...OptionFunc) (*Host, int, er
 
 	p.p["id"] = id
 
-	for _, fn := range append(s.cs.options, opts...) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
 		if err := fn(s.cs, p); err != nil {
 			return nil, -1, err
 		}
@@ -1780,24 +3241,56 @@ func (s *HostService) GetHostByID(id string, opts ...OptionFunc) (*Host, int, er
 		if strings.Contains(err.Error(), fmt.Sprintf(
 			"Invalid parameter id value=%s due to incorrect long value format, "+
 				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+			return nil, 0, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
 	}
 
 	if l.Count == 1 {
 		return l.Hosts[0], l.Count, nil
 	}
+
+	if _, ok := p.p[firstMatchKey]; ok && len(l.Hosts) > 0 {
+		return l.Hosts[0], l.Count, nil
+	}
 	return nil, l.Count, fmt.Errorf("There is more then one result for Host UUID: %s!", id)
 }
 
+// HostExists returns true if a Host with the given ID exists. When no match is found
+// it returns false, while any other error is returned as is.
+func (s *HostService) HostExists(id string, opts ...OptionFunc) (bool, error) {
+	_, count, err := s.GetHostByID(id, opts...)
+	if errors.Is(err, ErrNotFound) {
+		return false, nil
+	}
+	if count > 0 {
+		return true, nil
+	}
+	return false, err
+}
+
 // Lists hosts.
 func (s *HostService) ListHosts(p *ListHostsParams) (*ListHostsResponse, error) {
-	resp, err := s.cs.newRequest("listHosts", p.toURLValues())
+	return s.ListHostsWithContext(context.Background(), p)
+}
+
+// ListHostsWithContext is the same as ListHosts, but the request is cancelled when the context is done
+func (s *HostService) ListHostsWithContext(ctx context.Context, p *ListHostsParams) (*ListHostsResponse, error) {
+	return s.ListHostsRawWithContext(ctx, p.toURLValues())
+}
+
+// ListHostsRaw is the same as ListHosts, but takes the params as url.Values instead of typed params
+func (s *HostService) ListHostsRaw(v url.Values) (*ListHostsResponse, error) {
+	return s.ListHostsRawWithContext(context.Background(), v)
+}
+
+// ListHostsRawWithContext is the same as ListHostsRaw, but the request is cancelled when the context is done
+func (s *HostService) ListHostsRawWithContext(ctx context.Context, v url.Values) (*ListHostsResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "listHosts", "listhostsresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -1810,29 +3303,116 @@ func (s *HostService) ListHosts(p *ListHostsParams) (*ListHostsResponse, error)
 	return &r, nil
 }
 
+// StreamHosts fetches the results of ListHosts page by page in the background and sends them on the
+// returned channel. Both returned channels are closed when done. If fetching a page fails, or the context
+// is done before all results are sent, the error is sent on the error channel and fetching stops. A
+// request for a page in flight is cancelled when the context is done. The page and pagesize set in the
+// params are ignored.
+func (s *HostService) StreamHosts(ctx context.Context, p *ListHostsParams) (<-chan *Host, <-chan error) {
+	ch := make(chan *Host)
+	errs := make(chan error, 1)
+
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesize(500)
+
+	go func() {
+		defer close(errs)
+		defer close(ch)
+
+		for page, count := 1, 0; ; page++ {
+			pp.SetPage(page)
+
+			l, err := s.ListHostsWithContext(ctx, pp)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			for _, v := range l.Hosts {
+				select {
+				case ch <- v:
+				case <-ctx.Done():
+					errs <- ctx.Err()
+					return
+				}
+			}
+
+			count += len(l.Hosts)
+			if len(l.Hosts) < 500 || count >= l.Count {
+				return
+			}
+		}
+	}()
+
+	return ch, errs
+}
+
+// ListHostsWhere returns all results of ListHosts for which pred returns true. Note that the results are
+// filtered client-side, so all results matching the params are fetched first.
+// All pages are fetched, so the page and pagesize set in the params are ignored.
+func (s *HostService) ListHostsWhere(p *ListHostsParams, pred func(*Host) bool) ([]*Host, error) {
+	var r []*Host
+	ch, errs := s.StreamHosts(context.Background(), p)
+	for v := range ch {
+		if pred(v) {
+			r = append(r, v)
+		}
+	}
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ListAllHosts returns the results of ListHosts across all pages, by calling ListHosts page by page until all
+// results are fetched. The pagesize set in the params is used for every page (500 if not set), and the
+// page set in the params is ignored.
+func (s *HostService) ListAllHosts(p *ListHostsParams) ([]*Host, error) {
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesizeIfUnset(500)
+	pagesize := pp.p["pagesize"].(int)
+
+	var r []*Host
+	for page := 1; ; page++ {
+		pp.SetPage(page)
+
+		l, err := s.ListHosts(pp)
+		if err != nil {
+			return nil, err
+		}
+		r = append(r, l.Hosts...)
+
+		if len(l.Hosts) < pagesize || len(r) >= l.Count {
+			return r, nil
+		}
+	}
+}
+
 type ListHostsResponse struct {
 	Count int     `json:"count"`
 	Hosts []*Host `json:"host"`
 }
 
 type Host struct {
-	Averageload             int64             `json:"averageload"`
-	Capabilities            string            `json:"capabilities"`
-	Clusterid               string            `json:"clusterid"`
-	Clustername             string            `json:"clustername"`
-	Clustertype             string            `json:"clustertype"`
-	Cpuallocated            string            `json:"cpuallocated"`
-	Cpunumber               int               `json:"cpunumber"`
-	Cpusockets              int               `json:"cpusockets"`
-	Cpuspeed                int64             `json:"cpuspeed"`
-	Cpuused                 string            `json:"cpuused"`
-	Cpuwithoverprovisioning string            `json:"cpuwithoverprovisioning"`
-	Created                 string            `json:"created"`
-	Details                 map[string]string `json:"details"`
-	Disconnected            string            `json:"disconnected"`
-	Disksizeallocated       int64             `json:"disksizeallocated"`
-	Disksizetotal           int64             `json:"disksizetotal"`
-	Events                  string            `json:"events"`
+	Averageload             int64     `json:"averageload"`
+	Capabilities            string    `json:"capabilities"`
+	Clusterid               string    `json:"clusterid"`
+	Clustername             string    `json:"clustername"`
+	Clustertype             string    `json:"clustertype"`
+	Cpuallocated            string    `json:"cpuallocated"`
+	Cpunumber               int       `json:"cpunumber"`
+	Cpusockets              int       `json:"cpusockets"`
+	Cpuspeed                int64     `json:"cpuspeed"`
+	Cpuused                 string    `json:"cpuused"`
+	Cpuwithoverprovisioning string    `json:"cpuwithoverprovisioning"`
+	Created                 string    `json:"created"`
+	Details                 StringMap `json:"details"`
+	Disconnected            string    `json:"disconnected"`
+	Disksizeallocated       int64     `json:"disksizeallocated"`
+	Disksizetotal           int64     `json:"disksizetotal"`
+	Events                  string    `json:"events"`
 	Gpugroup                []struct {
 		Gpugroupname string `json:"gpugroupname"`
 		Vgpu         []struct {
@@ -1881,6 +3461,16 @@ type PrepareHostForMaintenanceParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *PrepareHostForMaintenanceParams) Clone() *PrepareHostForMaintenanceParams {
+	c := &PrepareHostForMaintenanceParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *PrepareHostForMaintenanceParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1892,6 +3482,16 @@ func (p *PrepareHostForMaintenanceParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *PrepareHostForMaintenanceParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of prepareHostForMaintenance", k)
+		}
+	}
+	return nil
+}
+
 func (p *PrepareHostForMaintenanceParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1900,18 +3500,54 @@ func (p *PrepareHostForMaintenanceParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *PrepareHostForMaintenanceParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 // You should always use this function to get a new PrepareHostForMaintenanceParams instance,
-// as then you are sure you have configured all required params
-func (s *HostService) NewPrepareHostForMaintenanceParams(id string) *PrepareHostForMaintenanceParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *HostService) NewPrepareHostForMaintenanceParams(id string, opts ...func(*PrepareHostForMaintenanceParams)) *PrepareHostForMaintenanceParams {
 	p := &PrepareHostForMaintenanceParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Prepares a host for maintenance.
-func (s *HostService) PrepareHostForMaintenance(p *PrepareHostForMaintenanceParams) (*PrepareHostForMaintenanceResponse, error) {
-	resp, err := s.cs.newRequest("prepareHostForMaintenance", p.toURLValues())
+func (s *HostService) PrepareHostForMaintenance(p *PrepareHostForMaintenanceParams, opts ...OptionFunc) (*PrepareHostForMaintenanceResponse, error) {
+	return s.PrepareHostForMaintenanceWithContext(context.Background(), p, opts...)
+}
+
+// PrepareHostForMaintenanceWithContext is the same as PrepareHostForMaintenance, but the request is cancelled when the context is done
+func (s *HostService) PrepareHostForMaintenanceWithContext(ctx context.Context, p *PrepareHostForMaintenanceParams, opts ...OptionFunc) (*PrepareHostForMaintenanceResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.PrepareHostForMaintenanceRawWithContext(ctx, p.toURLValues())
+}
+
+// PrepareHostForMaintenanceRaw is the same as PrepareHostForMaintenance, but takes the params as url.Values instead of typed params
+func (s *HostService) PrepareHostForMaintenanceRaw(v url.Values) (*PrepareHostForMaintenanceResponse, error) {
+	return s.PrepareHostForMaintenanceRawWithContext(context.Background(), v)
+}
+
+// PrepareHostForMaintenanceRawWithContext is the same as PrepareHostForMaintenanceRaw, but the request is cancelled when the context is done
+func (s *HostService) PrepareHostForMaintenanceRawWithContext(ctx context.Context, v url.Values) (*PrepareHostForMaintenanceResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "prepareHostForMaintenance", "preparehostformaintenanceresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -1923,9 +3559,9 @@ func (s *HostService) PrepareHostForMaintenance(p *PrepareHostForMaintenancePara
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -1945,24 +3581,24 @@ func (s *HostService) PrepareHostForMaintenance(p *PrepareHostForMaintenancePara
 }
 
 type PrepareHostForMaintenanceResponse struct {
-	JobID                   string            `json:"jobid"`
-	Averageload             int64             `json:"averageload"`
-	Capabilities            string            `json:"capabilities"`
-	Clusterid               string            `json:"clusterid"`
-	Clustername             string            `json:"clustername"`
-	Clustertype             string            `json:"clustertype"`
-	Cpuallocated            string            `json:"cpuallocated"`
-	Cpunumber               int               `json:"cpunumber"`
-	Cpusockets              int               `json:"cpusockets"`
-	Cpuspeed                int64             `json:"cpuspeed"`
-	Cpuused                 string            `json:"cpuused"`
-	Cpuwithoverprovisioning string            `json:"cpuwithoverprovisioning"`
-	Created                 string            `json:"created"`
-	Details                 map[string]string `json:"details"`
-	Disconnected            string            `json:"disconnected"`
-	Disksizeallocated       int64             `json:"disksizeallocated"`
-	Disksizetotal           int64             `json:"disksizetotal"`
-	Events                  string            `json:"events"`
+	JobID                   string    `json:"jobid"`
+	Averageload             int64     `json:"averageload"`
+	Capabilities            string    `json:"capabilities"`
+	Clusterid               string    `json:"clusterid"`
+	Clustername             string    `json:"clustername"`
+	Clustertype             string    `json:"clustertype"`
+	Cpuallocated            string    `json:"cpuallocated"`
+	Cpunumber               int       `json:"cpunumber"`
+	Cpusockets              int       `json:"cpusockets"`
+	Cpuspeed                int64     `json:"cpuspeed"`
+	Cpuused                 string    `json:"cpuused"`
+	Cpuwithoverprovisioning string    `json:"cpuwithoverprovisioning"`
+	Created                 string    `json:"created"`
+	Details                 StringMap `json:"details"`
+	Disconnected            string    `json:"disconnected"`
+	Disksizeallocated       int64     `json:"disksizeallocated"`
+	Disksizetotal           int64     `json:"disksizetotal"`
+	Events                  string    `json:"events"`
 	Gpugroup                []struct {
 		Gpugroupname string `json:"gpugroupname"`
 		Vgpu         []struct {
@@ -2011,6 +3647,16 @@ type ReconnectHostParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ReconnectHostParams) Clone() *ReconnectHostParams {
+	c := &ReconnectHostParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ReconnectHostParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -2022,6 +3668,16 @@ func (p *ReconnectHostParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *ReconnectHostParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of reconnectHost", k)
+		}
+	}
+	return nil
+}
+
 func (p *ReconnectHostParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2030,18 +3686,54 @@ func (p *ReconnectHostParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *ReconnectHostParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 // You should always use this function to get a new ReconnectHostParams instance,
-// as then you are sure you have configured all required params
-func (s *HostService) NewReconnectHostParams(id string) *ReconnectHostParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *HostService) NewReconnectHostParams(id string, opts ...func(*ReconnectHostParams)) *ReconnectHostParams {
 	p := &ReconnectHostParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Reconnects a host.
-func (s *HostService) ReconnectHost(p *ReconnectHostParams) (*ReconnectHostResponse, error) {
-	resp, err := s.cs.newRequest("reconnectHost", p.toURLValues())
+func (s *HostService) ReconnectHost(p *ReconnectHostParams, opts ...OptionFunc) (*ReconnectHostResponse, error) {
+	return s.ReconnectHostWithContext(context.Background(), p, opts...)
+}
+
+// ReconnectHostWithContext is the same as ReconnectHost, but the request is cancelled when the context is done
+func (s *HostService) ReconnectHostWithContext(ctx context.Context, p *ReconnectHostParams, opts ...OptionFunc) (*ReconnectHostResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.ReconnectHostRawWithContext(ctx, p.toURLValues())
+}
+
+// ReconnectHostRaw is the same as ReconnectHost, but takes the params as url.Values instead of typed params
+func (s *HostService) ReconnectHostRaw(v url.Values) (*ReconnectHostResponse, error) {
+	return s.ReconnectHostRawWithContext(context.Background(), v)
+}
+
+// ReconnectHostRawWithContext is the same as ReconnectHostRaw, but the request is cancelled when the context is done
+func (s *HostService) ReconnectHostRawWithContext(ctx context.Context, v url.Values) (*ReconnectHostResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "reconnectHost", "reconnecthostresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -2053,9 +3745,9 @@ func (s *HostService) ReconnectHost(p *ReconnectHostParams) (*ReconnectHostRespo
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -2075,24 +3767,24 @@ func (s *HostService) ReconnectHost(p *ReconnectHostParams) (*ReconnectHostRespo
 }
 
 type ReconnectHostResponse struct {
-	JobID                   string            `json:"jobid"`
-	Averageload             int64             `json:"averageload"`
-	Capabilities            string            `json:"capabilities"`
-	Clusterid               string            `json:"clusterid"`
-	Clustername             string            `json:"clustername"`
-	Clustertype             string            `json:"clustertype"`
-	Cpuallocated            string            `json:"cpuallocated"`
-	Cpunumber               int               `json:"cpunumber"`
-	Cpusockets              int               `json:"cpusockets"`
-	Cpuspeed                int64             `json:"cpuspeed"`
-	Cpuused                 string            `json:"cpuused"`
-	Cpuwithoverprovisioning string            `json:"cpuwithoverprovisioning"`
-	Created                 string            `json:"created"`
-	Details                 map[string]string `json:"details"`
-	Disconnected            string            `json:"disconnected"`
-	Disksizeallocated       int64             `json:"disksizeallocated"`
-	Disksizetotal           int64             `json:"disksizetotal"`
-	Events                  string            `json:"events"`
+	JobID                   string    `json:"jobid"`
+	Averageload             int64     `json:"averageload"`
+	Capabilities            string    `json:"capabilities"`
+	Clusterid               string    `json:"clusterid"`
+	Clustername             string    `json:"clustername"`
+	Clustertype             string    `json:"clustertype"`
+	Cpuallocated            string    `json:"cpuallocated"`
+	Cpunumber               int       `json:"cpunumber"`
+	Cpusockets              int       `json:"cpusockets"`
+	Cpuspeed                int64     `json:"cpuspeed"`
+	Cpuused                 string    `json:"cpuused"`
+	Cpuwithoverprovisioning string    `json:"cpuwithoverprovisioning"`
+	Created                 string    `json:"created"`
+	Details                 StringMap `json:"details"`
+	Disconnected            string    `json:"disconnected"`
+	Disksizeallocated       int64     `json:"disksizeallocated"`
+	Disksizetotal           int64     `json:"disksizetotal"`
+	Events                  string    `json:"events"`
 	Gpugroup                []struct {
 		Gpugroupname string `json:"gpugroupname"`
 		Vgpu         []struct {
@@ -2141,6 +3833,16 @@ type ReleaseDedicatedHostParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ReleaseDedicatedHostParams) Clone() *ReleaseDedicatedHostParams {
+	c := &ReleaseDedicatedHostParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ReleaseDedicatedHostParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -2152,6 +3854,16 @@ func (p *ReleaseDedicatedHostParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *ReleaseDedicatedHostParams) validate() error {
+	for _, k := range []string{"hostid"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of releaseDedicatedHost", k)
+		}
+	}
+	return nil
+}
+
 func (p *ReleaseDedicatedHostParams) SetHostid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2160,18 +3872,54 @@ func (p *ReleaseDedicatedHostParams) SetHostid(v string) {
 	return
 }
 
+// SetHostidIfUnset sets hostid to v, unless it is already set
+func (p *ReleaseDedicatedHostParams) SetHostidIfUnset(v string) {
+	if _, found := p.p["hostid"]; !found {
+		p.SetHostid(v)
+	}
+}
+
 // You should always use this function to get a new ReleaseDedicatedHostParams instance,
-// as then you are sure you have configured all required params
-func (s *HostService) NewReleaseDedicatedHostParams(hostid string) *ReleaseDedicatedHostParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *HostService) NewReleaseDedicatedHostParams(hostid string, opts ...func(*ReleaseDedicatedHostParams)) *ReleaseDedicatedHostParams {
 	p := &ReleaseDedicatedHostParams{}
 	p.p = make(map[string]interface{})
 	p.p["hostid"] = hostid
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Release the dedication for host
-func (s *HostService) ReleaseDedicatedHost(p *ReleaseDedicatedHostParams) (*ReleaseDedicatedHostResponse, error) {
-	resp, err := s.cs.newRequest("releaseDedicatedHost", p.toURLValues())
+func (s *HostService) ReleaseDedicatedHost(p *ReleaseDedicatedHostParams, opts ...OptionFunc) (*ReleaseDedicatedHostResponse, error) {
+	return s.ReleaseDedicatedHostWithContext(context.Background(), p, opts...)
+}
+
+// ReleaseDedicatedHostWithContext is the same as ReleaseDedicatedHost, but the request is cancelled when the context is done
+func (s *HostService) ReleaseDedicatedHostWithContext(ctx context.Context, p *ReleaseDedicatedHostParams, opts ...OptionFunc) (*ReleaseDedicatedHostResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.ReleaseDedicatedHostRawWithContext(ctx, p.toURLValues())
+}
+
+// ReleaseDedicatedHostRaw is the same as ReleaseDedicatedHost, but takes the params as url.Values instead of typed params
+func (s *HostService) ReleaseDedicatedHostRaw(v url.Values) (*ReleaseDedicatedHostResponse, error) {
+	return s.ReleaseDedicatedHostRawWithContext(context.Background(), v)
+}
+
+// ReleaseDedicatedHostRawWithContext is the same as ReleaseDedicatedHostRaw, but the request is cancelled when the context is done
+func (s *HostService) ReleaseDedicatedHostRawWithContext(ctx context.Context, v url.Values) (*ReleaseDedicatedHostResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "releaseDedicatedHost", "releasededicatedhostresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -2183,9 +3931,9 @@ func (s *HostService) ReleaseDedicatedHost(p *ReleaseDedicatedHostParams) (*Rele
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -2209,6 +3957,16 @@ type ReleaseHostReservationParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ReleaseHostReservationParams) Clone() *ReleaseHostReservationParams {
+	c := &ReleaseHostReservationParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ReleaseHostReservationParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -2220,6 +3978,16 @@ func (p *ReleaseHostReservationParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *ReleaseHostReservationParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of releaseHostReservation", k)
+		}
+	}
+	return nil
+}
+
 func (p *ReleaseHostReservationParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2228,18 +3996,54 @@ func (p *ReleaseHostReservationParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *ReleaseHostReservationParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 // You should always use this function to get a new ReleaseHostReservationParams instance,
-// as then you are sure you have configured all required params
-func (s *HostService) NewReleaseHostReservationParams(id string) *ReleaseHostReservationParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *HostService) NewReleaseHostReservationParams(id string, opts ...func(*ReleaseHostReservationParams)) *ReleaseHostReservationParams {
 	p := &ReleaseHostReservationParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Releases host reservation.
-func (s *HostService) ReleaseHostReservation(p *ReleaseHostReservationParams) (*ReleaseHostReservationResponse, error) {
-	resp, err := s.cs.newRequest("releaseHostReservation", p.toURLValues())
+func (s *HostService) ReleaseHostReservation(p *ReleaseHostReservationParams, opts ...OptionFunc) (*ReleaseHostReservationResponse, error) {
+	return s.ReleaseHostReservationWithContext(context.Background(), p, opts...)
+}
+
+// ReleaseHostReservationWithContext is the same as ReleaseHostReservation, but the request is cancelled when the context is done
+func (s *HostService) ReleaseHostReservationWithContext(ctx context.Context, p *ReleaseHostReservationParams, opts ...OptionFunc) (*ReleaseHostReservationResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.ReleaseHostReservationRawWithContext(ctx, p.toURLValues())
+}
+
+// ReleaseHostReservationRaw is the same as ReleaseHostReservation, but takes the params as url.Values instead of typed params
+func (s *HostService) ReleaseHostReservationRaw(v url.Values) (*ReleaseHostReservationResponse, error) {
+	return s.ReleaseHostReservationRawWithContext(context.Background(), v)
+}
+
+// ReleaseHostReservationRawWithContext is the same as ReleaseHostReservationRaw, but the request is cancelled when the context is done
+func (s *HostService) ReleaseHostReservationRawWithContext(ctx context.Context, v url.Values) (*ReleaseHostReservationResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "releaseHostReservation", "releasehostreservationresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -2251,9 +4055,9 @@ func (s *HostService) ReleaseHostReservation(p *ReleaseHostReservationParams) (*
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -2277,6 +4081,16 @@ type UpdateHostParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *UpdateHostParams) Clone() *UpdateHostParams {
+	c := &UpdateHostParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *UpdateHostParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -2301,6 +4115,16 @@ func (p *UpdateHostParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *UpdateHostParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of updateHost", k)
+		}
+	}
+	return nil
+}
+
 func (p *UpdateHostParams) SetAllocationstate(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2309,6 +4133,13 @@ func (p *UpdateHostParams) SetAllocationstate(v string) {
 	return
 }
 
+// SetAllocationstateIfUnset sets allocationstate to v, unless it is already set
+func (p *UpdateHostParams) SetAllocationstateIfUnset(v string) {
+	if _, found := p.p["allocationstate"]; !found {
+		p.SetAllocationstate(v)
+	}
+}
+
 func (p *UpdateHostParams) SetHosttags(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2317,6 +4148,18 @@ func (p *UpdateHostParams) SetHosttags(v []string) {
 	return
 }
 
+// SetHosttagsIfUnset sets hosttags to v, unless it is already set
+func (p *UpdateHostParams) SetHosttagsIfUnset(v []string) {
+	if _, found := p.p["hosttags"]; !found {
+		p.SetHosttags(v)
+	}
+}
+
+// SetHosttagsCSV splits v on commas and sets the resulting values
+func (p *UpdateHostParams) SetHosttagsCSV(v string) {
+	p.SetHosttags(splitCSV(v))
+}
+
 func (p *UpdateHostParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2325,6 +4168,13 @@ func (p *UpdateHostParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *UpdateHostParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 func (p *UpdateHostParams) SetOscategoryid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2333,6 +4183,13 @@ func (p *UpdateHostParams) SetOscategoryid(v string) {
 	return
 }
 
+// SetOscategoryidIfUnset sets oscategoryid to v, unless it is already set
+func (p *UpdateHostParams) SetOscategoryidIfUnset(v string) {
+	if _, found := p.p["oscategoryid"]; !found {
+		p.SetOscategoryid(v)
+	}
+}
+
 func (p *UpdateHostParams) SetUrl(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2341,18 +4198,54 @@ func (p *UpdateHostParams) SetUrl(v string) {
 	return
 }
 
+// SetUrlIfUnset sets url to v, unless it is already set
+func (p *UpdateHostParams) SetUrlIfUnset(v string) {
+	if _, found := p.p["url"]; !found {
+		p.SetUrl(v)
+	}
+}
+
 // You should always use this function to get a new UpdateHostParams instance,
-// as then you are sure you have configured all required params
-func (s *HostService) NewUpdateHostParams(id string) *UpdateHostParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *HostService) NewUpdateHostParams(id string, opts ...func(*UpdateHostParams)) *UpdateHostParams {
 	p := &UpdateHostParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Updates a host.
-func (s *HostService) UpdateHost(p *UpdateHostParams) (*UpdateHostResponse, error) {
-	resp, err := s.cs.newRequest("updateHost", p.toURLValues())
+func (s *HostService) UpdateHost(p *UpdateHostParams, opts ...OptionFunc) (*UpdateHostResponse, error) {
+	return s.UpdateHostWithContext(context.Background(), p, opts...)
+}
+
+// UpdateHostWithContext is the same as UpdateHost, but the request is cancelled when the context is done
+func (s *HostService) UpdateHostWithContext(ctx context.Context, p *UpdateHostParams, opts ...OptionFunc) (*UpdateHostResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.UpdateHostRawWithContext(ctx, p.toURLValues())
+}
+
+// UpdateHostRaw is the same as UpdateHost, but takes the params as url.Values instead of typed params
+func (s *HostService) UpdateHostRaw(v url.Values) (*UpdateHostResponse, error) {
+	return s.UpdateHostRawWithContext(context.Background(), v)
+}
+
+// UpdateHostRawWithContext is the same as UpdateHostRaw, but the request is cancelled when the context is done
+func (s *HostService) UpdateHostRawWithContext(ctx context.Context, v url.Values) (*UpdateHostResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "updateHost", "updatehostresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -2366,23 +4259,23 @@ func (s *HostService) UpdateHost(p *UpdateHostParams) (*UpdateHostResponse, erro
 }
 
 type UpdateHostResponse struct {
-	Averageload             int64             `json:"averageload"`
-	Capabilities            string            `json:"capabilities"`
-	Clusterid               string            `json:"clusterid"`
-	Clustername             string            `json:"clustername"`
-	Clustertype             string            `json:"clustertype"`
-	Cpuallocated            string            `json:"cpuallocated"`
-	Cpunumber               int               `json:"cpunumber"`
-	Cpusockets              int               `json:"cpusockets"`
-	Cpuspeed                int64             `json:"cpuspeed"`
-	Cpuused                 string            `json:"cpuused"`
-	Cpuwithoverprovisioning string            `json:"cpuwithoverprovisioning"`
-	Created                 string            `json:"created"`
-	Details                 map[string]string `json:"details"`
-	Disconnected            string            `json:"disconnected"`
-	Disksizeallocated       int64             `json:"disksizeallocated"`
-	Disksizetotal           int64             `json:"disksizetotal"`
-	Events                  string            `json:"events"`
+	Averageload             int64     `json:"averageload"`
+	Capabilities            string    `json:"capabilities"`
+	Clusterid               string    `json:"clusterid"`
+	Clustername             string    `json:"clustername"`
+	Clustertype             string    `json:"clustertype"`
+	Cpuallocated            string    `json:"cpuallocated"`
+	Cpunumber               int       `json:"cpunumber"`
+	Cpusockets              int       `json:"cpusockets"`
+	Cpuspeed                int64     `json:"cpuspeed"`
+	Cpuused                 string    `json:"cpuused"`
+	Cpuwithoverprovisioning string    `json:"cpuwithoverprovisioning"`
+	Created                 string    `json:"created"`
+	Details                 StringMap `json:"details"`
+	Disconnected            string    `json:"disconnected"`
+	Disksizeallocated       int64     `json:"disksizeallocated"`
+	Disksizetotal           int64     `json:"disksizetotal"`
+	Events                  string    `json:"events"`
 	Gpugroup                []struct {
 		Gpugroupname string `json:"gpugroupname"`
 		Vgpu         []struct {
@@ -2431,6 +4324,16 @@ type UpdateHostPasswordParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *UpdateHostPasswordParams) Clone() *UpdateHostPasswordParams {
+	c := &UpdateHostPasswordParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *UpdateHostPasswordParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -2455,6 +4358,16 @@ func (p *UpdateHostPasswordParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *UpdateHostPasswordParams) validate() error {
+	for _, k := range []string{"password", "username"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of updateHostPassword", k)
+		}
+	}
+	return nil
+}
+
 func (p *UpdateHostPasswordParams) SetClusterid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2463,6 +4376,13 @@ func (p *UpdateHostPasswordParams) SetClusterid(v string) {
 	return
 }
 
+// SetClusteridIfUnset sets clusterid to v, unless it is already set
+func (p *UpdateHostPasswordParams) SetClusteridIfUnset(v string) {
+	if _, found := p.p["clusterid"]; !found {
+		p.SetClusterid(v)
+	}
+}
+
 func (p *UpdateHostPasswordParams) SetHostid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2471,6 +4391,13 @@ func (p *UpdateHostPasswordParams) SetHostid(v string) {
 	return
 }
 
+// SetHostidIfUnset sets hostid to v, unless it is already set
+func (p *UpdateHostPasswordParams) SetHostidIfUnset(v string) {
+	if _, found := p.p["hostid"]; !found {
+		p.SetHostid(v)
+	}
+}
+
 func (p *UpdateHostPasswordParams) SetPassword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2479,6 +4406,13 @@ func (p *UpdateHostPasswordParams) SetPassword(v string) {
 	return
 }
 
+// SetPasswordIfUnset sets password to v, unless it is already set
+func (p *UpdateHostPasswordParams) SetPasswordIfUnset(v string) {
+	if _, found := p.p["password"]; !found {
+		p.SetPassword(v)
+	}
+}
+
 func (p *UpdateHostPasswordParams) SetUpdate_passwd_on_host(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2487,6 +4421,13 @@ func (p *UpdateHostPasswordParams) SetUpdate_passwd_on_host(v bool) {
 	return
 }
 
+// SetUpdate_passwd_on_hostIfUnset sets update_passwd_on_host to v, unless it is already set
+func (p *UpdateHostPasswordParams) SetUpdate_passwd_on_hostIfUnset(v bool) {
+	if _, found := p.p["update_passwd_on_host"]; !found {
+		p.SetUpdate_passwd_on_host(v)
+	}
+}
+
 func (p *UpdateHostPasswordParams) SetUsername(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2495,19 +4436,55 @@ func (p *UpdateHostPasswordParams) SetUsername(v string) {
 	return
 }
 
+// SetUsernameIfUnset sets username to v, unless it is already set
+func (p *UpdateHostPasswordParams) SetUsernameIfUnset(v string) {
+	if _, found := p.p["username"]; !found {
+		p.SetUsername(v)
+	}
+}
+
 // You should always use this function to get a new UpdateHostPasswordParams instance,
-// as then you are sure you have configured all required params
-func (s *HostService) NewUpdateHostPasswordParams(password string, username string) *UpdateHostPasswordParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *HostService) NewUpdateHostPasswordParams(password string, username string, opts ...func(*UpdateHostPasswordParams)) *UpdateHostPasswordParams {
 	p := &UpdateHostPasswordParams{}
 	p.p = make(map[string]interface{})
 	p.p["password"] = password
 	p.p["username"] = username
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Update password of a host/pool on management server.
-func (s *HostService) UpdateHostPassword(p *UpdateHostPasswordParams) (*UpdateHostPasswordResponse, error) {
-	resp, err := s.cs.newRequest("updateHostPassword", p.toURLValues())
+func (s *HostService) UpdateHostPassword(p *UpdateHostPasswordParams, opts ...OptionFunc) (*UpdateHostPasswordResponse, error) {
+	return s.UpdateHostPasswordWithContext(context.Background(), p, opts...)
+}
+
+// UpdateHostPasswordWithContext is the same as UpdateHostPassword, but the request is cancelled when the context is done
+func (s *HostService) UpdateHostPasswordWithContext(ctx context.Context, p *UpdateHostPasswordParams, opts ...OptionFunc) (*UpdateHostPasswordResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.UpdateHostPasswordRawWithContext(ctx, p.toURLValues())
+}
+
+// UpdateHostPasswordRaw is the same as UpdateHostPassword, but takes the params as url.Values instead of typed params
+func (s *HostService) UpdateHostPasswordRaw(v url.Values) (*UpdateHostPasswordResponse, error) {
+	return s.UpdateHostPasswordRawWithContext(context.Background(), v)
+}
+
+// UpdateHostPasswordRawWithContext is the same as UpdateHostPasswordRaw, but the request is cancelled when the context is done
+func (s *HostService) UpdateHostPasswordRawWithContext(ctx context.Context, v url.Values) (*UpdateHostPasswordResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "updateHostPassword", "updatehostpasswordresponse", v)
 	if err != nil {
 		return nil, err
 	}
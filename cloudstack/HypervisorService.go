@@ -17,7 +17,9 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/url"
 	"strconv"
@@ -28,6 +30,16 @@ type ListHypervisorCapabilitiesParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ListHypervisorCapabilitiesParams) Clone() *ListHypervisorCapabilitiesParams {
+	c := &ListHypervisorCapabilitiesParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListHypervisorCapabilitiesParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -53,14 +65,21 @@ func (p *ListHypervisorCapabilitiesParams) toURLValues() url.Values {
 	return u
 }
 
-func (p *ListHypervisorCapabilitiesParams) SetHypervisor(v string) {
+func (p *ListHypervisorCapabilitiesParams) SetHypervisor(v HypervisorType) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	p.p["hypervisor"] = v
+	p.p["hypervisor"] = string(v)
 	return
 }
 
+// SetHypervisorIfUnset sets hypervisor to v, unless it is already set
+func (p *ListHypervisorCapabilitiesParams) SetHypervisorIfUnset(v HypervisorType) {
+	if _, found := p.p["hypervisor"]; !found {
+		p.SetHypervisor(v)
+	}
+}
+
 func (p *ListHypervisorCapabilitiesParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -69,6 +88,13 @@ func (p *ListHypervisorCapabilitiesParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *ListHypervisorCapabilitiesParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 func (p *ListHypervisorCapabilitiesParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -77,6 +103,13 @@ func (p *ListHypervisorCapabilitiesParams) SetKeyword(v string) {
 	return
 }
 
+// SetKeywordIfUnset sets keyword to v, unless it is already set
+func (p *ListHypervisorCapabilitiesParams) SetKeywordIfUnset(v string) {
+	if _, found := p.p["keyword"]; !found {
+		p.SetKeyword(v)
+	}
+}
+
 func (p *ListHypervisorCapabilitiesParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -85,6 +118,13 @@ func (p *ListHypervisorCapabilitiesParams) SetPage(v int) {
 	return
 }
 
+// SetPageIfUnset sets page to v, unless it is already set
+func (p *ListHypervisorCapabilitiesParams) SetPageIfUnset(v int) {
+	if _, found := p.p["page"]; !found {
+		p.SetPage(v)
+	}
+}
+
 func (p *ListHypervisorCapabilitiesParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -93,14 +133,32 @@ func (p *ListHypervisorCapabilitiesParams) SetPagesize(v int) {
 	return
 }
 
+// SetPagesizeIfUnset sets pagesize to v, unless it is already set
+func (p *ListHypervisorCapabilitiesParams) SetPagesizeIfUnset(v int) {
+	if _, found := p.p["pagesize"]; !found {
+		p.SetPagesize(v)
+	}
+}
+
 // You should always use this function to get a new ListHypervisorCapabilitiesParams instance,
-// as then you are sure you have configured all required params
-func (s *HypervisorService) NewListHypervisorCapabilitiesParams() *ListHypervisorCapabilitiesParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *HypervisorService) NewListHypervisorCapabilitiesParams(opts ...func(*ListHypervisorCapabilitiesParams)) *ListHypervisorCapabilitiesParams {
 	p := &ListHypervisorCapabilitiesParams{}
 	p.p = make(map[string]interface{})
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
+func (p *ListHypervisorCapabilitiesParams) setFirstMatch() {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p[firstMatchKey] = true
+}
+
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *HypervisorService) GetHypervisorCapabilityByID(id string, opts ...OptionFunc) (*HypervisorCapability, int, error) {
 	p := &ListHypervisorCapabilitiesParams{}
@@ -108,7 +166,7 @@ func (s *HypervisorService) GetHypervisorCapabilityByID(id string, opts ...Optio
 
 	p.p["id"] = id
 
-	for _, fn := range append(s.cs.options, opts...) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
 		if err := fn(s.cs, p); err != nil {
 			return nil, -1, err
 		}
@@ -119,24 +177,56 @@ func (s *HypervisorService) GetHypervisorCapabilityByID(id string, opts ...Optio
 		if strings.Contains(err.Error(), fmt.Sprintf(
 			"Invalid parameter id value=%s due to incorrect long value format, "+
 				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+			return nil, 0, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
 	}
 
 	if l.Count == 1 {
 		return l.HypervisorCapabilities[0], l.Count, nil
 	}
+
+	if _, ok := p.p[firstMatchKey]; ok && len(l.HypervisorCapabilities) > 0 {
+		return l.HypervisorCapabilities[0], l.Count, nil
+	}
 	return nil, l.Count, fmt.Errorf("There is more then one result for HypervisorCapability UUID: %s!", id)
 }
 
+// HypervisorCapabilityExists returns true if a HypervisorCapability with the given ID exists. When no match is found
+// it returns false, while any other error is returned as is.
+func (s *HypervisorService) HypervisorCapabilityExists(id string, opts ...OptionFunc) (bool, error) {
+	_, count, err := s.GetHypervisorCapabilityByID(id, opts...)
+	if errors.Is(err, ErrNotFound) {
+		return false, nil
+	}
+	if count > 0 {
+		return true, nil
+	}
+	return false, err
+}
+
 // Lists all hypervisor capabilities.
 func (s *HypervisorService) ListHypervisorCapabilities(p *ListHypervisorCapabilitiesParams) (*ListHypervisorCapabilitiesResponse, error) {
-	resp, err := s.cs.newRequest("listHypervisorCapabilities", p.toURLValues())
+	return s.ListHypervisorCapabilitiesWithContext(context.Background(), p)
+}
+
+// ListHypervisorCapabilitiesWithContext is the same as ListHypervisorCapabilities, but the request is cancelled when the context is done
+func (s *HypervisorService) ListHypervisorCapabilitiesWithContext(ctx context.Context, p *ListHypervisorCapabilitiesParams) (*ListHypervisorCapabilitiesResponse, error) {
+	return s.ListHypervisorCapabilitiesRawWithContext(ctx, p.toURLValues())
+}
+
+// ListHypervisorCapabilitiesRaw is the same as ListHypervisorCapabilities, but takes the params as url.Values instead of typed params
+func (s *HypervisorService) ListHypervisorCapabilitiesRaw(v url.Values) (*ListHypervisorCapabilitiesResponse, error) {
+	return s.ListHypervisorCapabilitiesRawWithContext(context.Background(), v)
+}
+
+// ListHypervisorCapabilitiesRawWithContext is the same as ListHypervisorCapabilitiesRaw, but the request is cancelled when the context is done
+func (s *HypervisorService) ListHypervisorCapabilitiesRawWithContext(ctx context.Context, v url.Values) (*ListHypervisorCapabilitiesResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "listHypervisorCapabilities", "listhypervisorcapabilitiesresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -149,6 +239,93 @@ func (s *HypervisorService) ListHypervisorCapabilities(p *ListHypervisorCapabili
 	return &r, nil
 }
 
+// StreamHypervisorCapabilities fetches the results of ListHypervisorCapabilities page by page in the background and sends them on the
+// returned channel. Both returned channels are closed when done. If fetching a page fails, or the context
+// is done before all results are sent, the error is sent on the error channel and fetching stops. A
+// request for a page in flight is cancelled when the context is done. The page and pagesize set in the
+// params are ignored.
+func (s *HypervisorService) StreamHypervisorCapabilities(ctx context.Context, p *ListHypervisorCapabilitiesParams) (<-chan *HypervisorCapability, <-chan error) {
+	ch := make(chan *HypervisorCapability)
+	errs := make(chan error, 1)
+
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesize(500)
+
+	go func() {
+		defer close(errs)
+		defer close(ch)
+
+		for page, count := 1, 0; ; page++ {
+			pp.SetPage(page)
+
+			l, err := s.ListHypervisorCapabilitiesWithContext(ctx, pp)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			for _, v := range l.HypervisorCapabilities {
+				select {
+				case ch <- v:
+				case <-ctx.Done():
+					errs <- ctx.Err()
+					return
+				}
+			}
+
+			count += len(l.HypervisorCapabilities)
+			if len(l.HypervisorCapabilities) < 500 || count >= l.Count {
+				return
+			}
+		}
+	}()
+
+	return ch, errs
+}
+
+// ListHypervisorCapabilitiesWhere returns all results of ListHypervisorCapabilities for which pred returns true. Note that the results are
+// filtered client-side, so all results matching the params are fetched first.
+// All pages are fetched, so the page and pagesize set in the params are ignored.
+func (s *HypervisorService) ListHypervisorCapabilitiesWhere(p *ListHypervisorCapabilitiesParams, pred func(*HypervisorCapability) bool) ([]*HypervisorCapability, error) {
+	var r []*HypervisorCapability
+	ch, errs := s.StreamHypervisorCapabilities(context.Background(), p)
+	for v := range ch {
+		if pred(v) {
+			r = append(r, v)
+		}
+	}
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ListAllHypervisorCapabilities returns the results of ListHypervisorCapabilities across all pages, by calling ListHypervisorCapabilities page by page until all
+// results are fetched. The pagesize set in the params is used for every page (500 if not set), and the
+// page set in the params is ignored.
+func (s *HypervisorService) ListAllHypervisorCapabilities(p *ListHypervisorCapabilitiesParams) ([]*HypervisorCapability, error) {
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesizeIfUnset(500)
+	pagesize := pp.p["pagesize"].(int)
+
+	var r []*HypervisorCapability
+	for page := 1; ; page++ {
+		pp.SetPage(page)
+
+		l, err := s.ListHypervisorCapabilities(pp)
+		if err != nil {
+			return nil, err
+		}
+		r = append(r, l.HypervisorCapabilities...)
+
+		if len(l.HypervisorCapabilities) < pagesize || len(r) >= l.Count {
+			return r, nil
+		}
+	}
+}
+
 type ListHypervisorCapabilitiesResponse struct {
 	Count                  int                     `json:"count"`
 	HypervisorCapabilities []*HypervisorCapability `json:"hypervisorcapability"`
@@ -169,6 +346,16 @@ type ListHypervisorsParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ListHypervisorsParams) Clone() *ListHypervisorsParams {
+	c := &ListHypervisorsParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListHypervisorsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -188,17 +375,43 @@ func (p *ListHypervisorsParams) SetZoneid(v string) {
 	return
 }
 
+// SetZoneidIfUnset sets zoneid to v, unless it is already set
+func (p *ListHypervisorsParams) SetZoneidIfUnset(v string) {
+	if _, found := p.p["zoneid"]; !found {
+		p.SetZoneid(v)
+	}
+}
+
 // You should always use this function to get a new ListHypervisorsParams instance,
-// as then you are sure you have configured all required params
-func (s *HypervisorService) NewListHypervisorsParams() *ListHypervisorsParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *HypervisorService) NewListHypervisorsParams(opts ...func(*ListHypervisorsParams)) *ListHypervisorsParams {
 	p := &ListHypervisorsParams{}
 	p.p = make(map[string]interface{})
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // List hypervisors
 func (s *HypervisorService) ListHypervisors(p *ListHypervisorsParams) (*ListHypervisorsResponse, error) {
-	resp, err := s.cs.newRequest("listHypervisors", p.toURLValues())
+	return s.ListHypervisorsWithContext(context.Background(), p)
+}
+
+// ListHypervisorsWithContext is the same as ListHypervisors, but the request is cancelled when the context is done
+func (s *HypervisorService) ListHypervisorsWithContext(ctx context.Context, p *ListHypervisorsParams) (*ListHypervisorsResponse, error) {
+	return s.ListHypervisorsRawWithContext(ctx, p.toURLValues())
+}
+
+// ListHypervisorsRaw is the same as ListHypervisors, but takes the params as url.Values instead of typed params
+func (s *HypervisorService) ListHypervisorsRaw(v url.Values) (*ListHypervisorsResponse, error) {
+	return s.ListHypervisorsRawWithContext(context.Background(), v)
+}
+
+// ListHypervisorsRawWithContext is the same as ListHypervisorsRaw, but the request is cancelled when the context is done
+func (s *HypervisorService) ListHypervisorsRawWithContext(ctx context.Context, v url.Values) (*ListHypervisorsResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "listHypervisors", "listhypervisorsresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -211,6 +424,22 @@ func (s *HypervisorService) ListHypervisors(p *ListHypervisorsParams) (*ListHype
 	return &r, nil
 }
 
+// ListHypervisorsWhere returns all results of ListHypervisors for which pred returns true. Note that the results are
+// filtered client-side, so all results matching the params are fetched first.
+func (s *HypervisorService) ListHypervisorsWhere(p *ListHypervisorsParams, pred func(*Hypervisor) bool) ([]*Hypervisor, error) {
+	var r []*Hypervisor
+	l, err := s.ListHypervisors(p)
+	if err != nil {
+		return nil, err
+	}
+	for _, v := range l.Hypervisors {
+		if pred(v) {
+			r = append(r, v)
+		}
+	}
+	return r, nil
+}
+
 type ListHypervisorsResponse struct {
 	Count       int           `json:"count"`
 	Hypervisors []*Hypervisor `json:"hypervisor"`
@@ -224,6 +453,16 @@ type UpdateHypervisorCapabilitiesParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *UpdateHypervisorCapabilitiesParams) Clone() *UpdateHypervisorCapabilitiesParams {
+	c := &UpdateHypervisorCapabilitiesParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *UpdateHypervisorCapabilitiesParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -251,6 +490,13 @@ func (p *UpdateHypervisorCapabilitiesParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *UpdateHypervisorCapabilitiesParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 func (p *UpdateHypervisorCapabilitiesParams) SetMaxguestslimit(v int64) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -259,6 +505,13 @@ func (p *UpdateHypervisorCapabilitiesParams) SetMaxguestslimit(v int64) {
 	return
 }
 
+// SetMaxguestslimitIfUnset sets maxguestslimit to v, unless it is already set
+func (p *UpdateHypervisorCapabilitiesParams) SetMaxguestslimitIfUnset(v int64) {
+	if _, found := p.p["maxguestslimit"]; !found {
+		p.SetMaxguestslimit(v)
+	}
+}
+
 func (p *UpdateHypervisorCapabilitiesParams) SetSecuritygroupenabled(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -267,17 +520,49 @@ func (p *UpdateHypervisorCapabilitiesParams) SetSecuritygroupenabled(v bool) {
 	return
 }
 
+// SetSecuritygroupenabledIfUnset sets securitygroupenabled to v, unless it is already set
+func (p *UpdateHypervisorCapabilitiesParams) SetSecuritygroupenabledIfUnset(v bool) {
+	if _, found := p.p["securitygroupenabled"]; !found {
+		p.SetSecuritygroupenabled(v)
+	}
+}
+
 // You should always use this function to get a new UpdateHypervisorCapabilitiesParams instance,
-// as then you are sure you have configured all required params
-func (s *HypervisorService) NewUpdateHypervisorCapabilitiesParams() *UpdateHypervisorCapabilitiesParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *HypervisorService) NewUpdateHypervisorCapabilitiesParams(opts ...func(*UpdateHypervisorCapabilitiesParams)) *UpdateHypervisorCapabilitiesParams {
 	p := &UpdateHypervisorCapabilitiesParams{}
 	p.p = make(map[string]interface{})
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Updates a hypervisor capabilities.
-func (s *HypervisorService) UpdateHypervisorCapabilities(p *UpdateHypervisorCapabilitiesParams) (*UpdateHypervisorCapabilitiesResponse, error) {
-	resp, err := s.cs.newRequest("updateHypervisorCapabilities", p.toURLValues())
+func (s *HypervisorService) UpdateHypervisorCapabilities(p *UpdateHypervisorCapabilitiesParams, opts ...OptionFunc) (*UpdateHypervisorCapabilitiesResponse, error) {
+	return s.UpdateHypervisorCapabilitiesWithContext(context.Background(), p, opts...)
+}
+
+// UpdateHypervisorCapabilitiesWithContext is the same as UpdateHypervisorCapabilities, but the request is cancelled when the context is done
+func (s *HypervisorService) UpdateHypervisorCapabilitiesWithContext(ctx context.Context, p *UpdateHypervisorCapabilitiesParams, opts ...OptionFunc) (*UpdateHypervisorCapabilitiesResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	return s.UpdateHypervisorCapabilitiesRawWithContext(ctx, p.toURLValues())
+}
+
+// UpdateHypervisorCapabilitiesRaw is the same as UpdateHypervisorCapabilities, but takes the params as url.Values instead of typed params
+func (s *HypervisorService) UpdateHypervisorCapabilitiesRaw(v url.Values) (*UpdateHypervisorCapabilitiesResponse, error) {
+	return s.UpdateHypervisorCapabilitiesRawWithContext(context.Background(), v)
+}
+
+// UpdateHypervisorCapabilitiesRawWithContext is the same as UpdateHypervisorCapabilitiesRaw, but the request is cancelled when the context is done
+func (s *HypervisorService) UpdateHypervisorCapabilitiesRawWithContext(ctx context.Context, v url.Values) (*UpdateHypervisorCapabilitiesResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "updateHypervisorCapabilities", "updatehypervisorcapabilitiesresponse", v)
 	if err != nil {
 		return nil, err
 	}
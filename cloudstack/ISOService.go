@@ -17,17 +17,67 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/url"
 	"strconv"
 	"strings"
+	"time"
 )
 
+// UpdateMany runs UpdateIso for all given IDs in parallel, using at most concurrency concurrent requests.
+// For each ID the params are populated with the ID and then passed to mutate, which should set the
+// changes to apply. The result contains the updated resources (in the order of the IDs) and the error
+// of every update that failed by ID. The returned error contains all failures, or is nil if none failed.
+// The updated resources are returned as the UpdateIsoResponse of every update, which contains the same
+// fields as the resource itself, so no additional request is needed per resource to get them. The
+// options are used for every update.
+func (s *ISOService) UpdateMany(ids []string, mutate func(*UpdateIsoParams), concurrency int, opts ...OptionFunc) (BulkResult[*UpdateIsoResponse], error) {
+	rs := make([]*UpdateIsoResponse, len(ids))
+	errs := make([]error, len(ids))
+
+	runConcurrently(len(ids), concurrency, func(i int) {
+		p := &UpdateIsoParams{p: map[string]interface{}{"id": ids[i]}}
+		if mutate != nil {
+			mutate(p)
+		}
+
+		r, err := s.UpdateIso(p, opts...)
+		if err != nil {
+			errs[i] = err
+			return
+		}
+		rs[i] = r
+	})
+
+	var r BulkResult[*UpdateIsoResponse]
+	for i, id := range ids {
+		if errs[i] != nil {
+			r.fail(id, errs[i])
+			continue
+		}
+		r.Succeeded = append(r.Succeeded, rs[i])
+	}
+
+	return r, r.Err()
+}
+
 type AttachIsoParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *AttachIsoParams) Clone() *AttachIsoParams {
+	c := &AttachIsoParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *AttachIsoParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -42,6 +92,16 @@ func (p *AttachIsoParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *AttachIsoParams) validate() error {
+	for _, k := range []string{"id", "virtualmachineid"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of attachIso", k)
+		}
+	}
+	return nil
+}
+
 func (p *AttachIsoParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -50,6 +110,13 @@ func (p *AttachIsoParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *AttachIsoParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 func (p *AttachIsoParams) SetVirtualmachineid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -58,19 +125,55 @@ func (p *AttachIsoParams) SetVirtualmachineid(v string) {
 	return
 }
 
+// SetVirtualmachineidIfUnset sets virtualmachineid to v, unless it is already set
+func (p *AttachIsoParams) SetVirtualmachineidIfUnset(v string) {
+	if _, found := p.p["virtualmachineid"]; !found {
+		p.SetVirtualmachineid(v)
+	}
+}
+
 // You should always use this function to get a new AttachIsoParams instance,
-// as then you are sure you have configured all required params
-func (s *ISOService) NewAttachIsoParams(id string, virtualmachineid string) *AttachIsoParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *ISOService) NewAttachIsoParams(id string, virtualmachineid string, opts ...func(*AttachIsoParams)) *AttachIsoParams {
 	p := &AttachIsoParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
 	p.p["virtualmachineid"] = virtualmachineid
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Attaches an ISO to a virtual machine.
-func (s *ISOService) AttachIso(p *AttachIsoParams) (*AttachIsoResponse, error) {
-	resp, err := s.cs.newRequest("attachIso", p.toURLValues())
+func (s *ISOService) AttachIso(p *AttachIsoParams, opts ...OptionFunc) (*AttachIsoResponse, error) {
+	return s.AttachIsoWithContext(context.Background(), p, opts...)
+}
+
+// AttachIsoWithContext is the same as AttachIso, but the request is cancelled when the context is done
+func (s *ISOService) AttachIsoWithContext(ctx context.Context, p *AttachIsoParams, opts ...OptionFunc) (*AttachIsoResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.AttachIsoRawWithContext(ctx, p.toURLValues())
+}
+
+// AttachIsoRaw is the same as AttachIso, but takes the params as url.Values instead of typed params
+func (s *ISOService) AttachIsoRaw(v url.Values) (*AttachIsoResponse, error) {
+	return s.AttachIsoRawWithContext(context.Background(), v)
+}
+
+// AttachIsoRawWithContext is the same as AttachIsoRaw, but the request is cancelled when the context is done
+func (s *ISOService) AttachIsoRawWithContext(ctx context.Context, v url.Values) (*AttachIsoResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "attachIso", "attachisoresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -82,9 +185,9 @@ func (s *ISOService) AttachIso(p *AttachIsoParams) (*AttachIsoResponse, error) {
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -118,43 +221,43 @@ type AttachIsoResponse struct {
 		Type              string   `json:"type"`
 		VirtualmachineIds []string `json:"virtualmachineIds"`
 	} `json:"affinitygroup"`
-	Cpunumber             int               `json:"cpunumber"`
-	Cpuspeed              int               `json:"cpuspeed"`
-	Cpuused               string            `json:"cpuused"`
-	Created               string            `json:"created"`
-	Details               map[string]string `json:"details"`
-	Diskioread            int64             `json:"diskioread"`
-	Diskiowrite           int64             `json:"diskiowrite"`
-	Diskkbsread           int64             `json:"diskkbsread"`
-	Diskkbswrite          int64             `json:"diskkbswrite"`
-	Diskofferingid        string            `json:"diskofferingid"`
-	Diskofferingname      string            `json:"diskofferingname"`
-	Displayname           string            `json:"displayname"`
-	Displayvm             bool              `json:"displayvm"`
-	Domain                string            `json:"domain"`
-	Domainid              string            `json:"domainid"`
-	Forvirtualnetwork     bool              `json:"forvirtualnetwork"`
-	Group                 string            `json:"group"`
-	Groupid               string            `json:"groupid"`
-	Guestosid             string            `json:"guestosid"`
-	Haenable              bool              `json:"haenable"`
-	Hostid                string            `json:"hostid"`
-	Hostname              string            `json:"hostname"`
-	Hypervisor            string            `json:"hypervisor"`
-	Id                    string            `json:"id"`
-	Instancename          string            `json:"instancename"`
-	Isdynamicallyscalable bool              `json:"isdynamicallyscalable"`
-	Isodisplaytext        string            `json:"isodisplaytext"`
-	Isoid                 string            `json:"isoid"`
-	Isoname               string            `json:"isoname"`
-	Keypair               string            `json:"keypair"`
-	Memory                int               `json:"memory"`
-	Memoryintfreekbs      int64             `json:"memoryintfreekbs"`
-	Memorykbs             int64             `json:"memorykbs"`
-	Memorytargetkbs       int64             `json:"memorytargetkbs"`
-	Name                  string            `json:"name"`
-	Networkkbsread        int64             `json:"networkkbsread"`
-	Networkkbswrite       int64             `json:"networkkbswrite"`
+	Cpunumber             int       `json:"cpunumber"`
+	Cpuspeed              int       `json:"cpuspeed"`
+	Cpuused               string    `json:"cpuused"`
+	Created               string    `json:"created"`
+	Details               StringMap `json:"details"`
+	Diskioread            int64     `json:"diskioread"`
+	Diskiowrite           int64     `json:"diskiowrite"`
+	Diskkbsread           int64     `json:"diskkbsread"`
+	Diskkbswrite          int64     `json:"diskkbswrite"`
+	Diskofferingid        string    `json:"diskofferingid"`
+	Diskofferingname      string    `json:"diskofferingname"`
+	Displayname           string    `json:"displayname"`
+	Displayvm             bool      `json:"displayvm"`
+	Domain                string    `json:"domain"`
+	Domainid              string    `json:"domainid"`
+	Forvirtualnetwork     bool      `json:"forvirtualnetwork"`
+	Group                 string    `json:"group"`
+	Groupid               string    `json:"groupid"`
+	Guestosid             string    `json:"guestosid"`
+	Haenable              bool      `json:"haenable"`
+	Hostid                string    `json:"hostid"`
+	Hostname              string    `json:"hostname"`
+	Hypervisor            string    `json:"hypervisor"`
+	Id                    string    `json:"id"`
+	Instancename          string    `json:"instancename"`
+	Isdynamicallyscalable bool      `json:"isdynamicallyscalable"`
+	Isodisplaytext        string    `json:"isodisplaytext"`
+	Isoid                 string    `json:"isoid"`
+	Isoname               string    `json:"isoname"`
+	Keypair               string    `json:"keypair"`
+	Memory                int       `json:"memory"`
+	Memoryintfreekbs      int64     `json:"memoryintfreekbs"`
+	Memorykbs             int64     `json:"memorykbs"`
+	Memorytargetkbs       int64     `json:"memorytargetkbs"`
+	Name                  string    `json:"name"`
+	Networkkbsread        int64     `json:"networkkbsread"`
+	Networkkbswrite       int64     `json:"networkkbswrite"`
 	Nic                   []struct {
 		Broadcasturi         string `json:"broadcasturi"`
 		Deviceid             string `json:"deviceid"`
@@ -277,6 +380,16 @@ type CopyIsoParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *CopyIsoParams) Clone() *CopyIsoParams {
+	c := &CopyIsoParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *CopyIsoParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -294,6 +407,16 @@ func (p *CopyIsoParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *CopyIsoParams) validate() error {
+	for _, k := range []string{"destzoneid", "id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of copyIso", k)
+		}
+	}
+	return nil
+}
+
 func (p *CopyIsoParams) SetDestzoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -302,6 +425,13 @@ func (p *CopyIsoParams) SetDestzoneid(v string) {
 	return
 }
 
+// SetDestzoneidIfUnset sets destzoneid to v, unless it is already set
+func (p *CopyIsoParams) SetDestzoneidIfUnset(v string) {
+	if _, found := p.p["destzoneid"]; !found {
+		p.SetDestzoneid(v)
+	}
+}
+
 func (p *CopyIsoParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -310,6 +440,13 @@ func (p *CopyIsoParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *CopyIsoParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 func (p *CopyIsoParams) SetSourcezoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -318,19 +455,55 @@ func (p *CopyIsoParams) SetSourcezoneid(v string) {
 	return
 }
 
+// SetSourcezoneidIfUnset sets sourcezoneid to v, unless it is already set
+func (p *CopyIsoParams) SetSourcezoneidIfUnset(v string) {
+	if _, found := p.p["sourcezoneid"]; !found {
+		p.SetSourcezoneid(v)
+	}
+}
+
 // You should always use this function to get a new CopyIsoParams instance,
-// as then you are sure you have configured all required params
-func (s *ISOService) NewCopyIsoParams(destzoneid string, id string) *CopyIsoParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *ISOService) NewCopyIsoParams(destzoneid string, id string, opts ...func(*CopyIsoParams)) *CopyIsoParams {
 	p := &CopyIsoParams{}
 	p.p = make(map[string]interface{})
 	p.p["destzoneid"] = destzoneid
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Copies an iso from one zone to another.
-func (s *ISOService) CopyIso(p *CopyIsoParams) (*CopyIsoResponse, error) {
-	resp, err := s.cs.newRequest("copyIso", p.toURLValues())
+func (s *ISOService) CopyIso(p *CopyIsoParams, opts ...OptionFunc) (*CopyIsoResponse, error) {
+	return s.CopyIsoWithContext(context.Background(), p, opts...)
+}
+
+// CopyIsoWithContext is the same as CopyIso, but the request is cancelled when the context is done
+func (s *ISOService) CopyIsoWithContext(ctx context.Context, p *CopyIsoParams, opts ...OptionFunc) (*CopyIsoResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.CopyIsoRawWithContext(ctx, p.toURLValues())
+}
+
+// CopyIsoRaw is the same as CopyIso, but takes the params as url.Values instead of typed params
+func (s *ISOService) CopyIsoRaw(v url.Values) (*CopyIsoResponse, error) {
+	return s.CopyIsoRawWithContext(context.Background(), v)
+}
+
+// CopyIsoRawWithContext is the same as CopyIsoRaw, but the request is cancelled when the context is done
+func (s *ISOService) CopyIsoRawWithContext(ctx context.Context, v url.Values) (*CopyIsoResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "copyIso", "copyisoresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -342,9 +515,9 @@ func (s *ISOService) CopyIso(p *CopyIsoParams) (*CopyIsoResponse, error) {
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -364,48 +537,58 @@ func (s *ISOService) CopyIso(p *CopyIsoParams) (*CopyIsoResponse, error) {
 }
 
 type CopyIsoResponse struct {
-	JobID                 string            `json:"jobid"`
-	Account               string            `json:"account"`
-	Accountid             string            `json:"accountid"`
-	Bootable              bool              `json:"bootable"`
-	Checksum              string            `json:"checksum"`
-	Created               string            `json:"created"`
-	CrossZones            bool              `json:"crossZones"`
-	Details               map[string]string `json:"details"`
-	Displaytext           string            `json:"displaytext"`
-	Domain                string            `json:"domain"`
-	Domainid              string            `json:"domainid"`
-	Format                string            `json:"format"`
-	Hostid                string            `json:"hostid"`
-	Hostname              string            `json:"hostname"`
-	Hypervisor            string            `json:"hypervisor"`
-	Id                    string            `json:"id"`
-	Isdynamicallyscalable bool              `json:"isdynamicallyscalable"`
-	Isextractable         bool              `json:"isextractable"`
-	Isfeatured            bool              `json:"isfeatured"`
-	Ispublic              bool              `json:"ispublic"`
-	Isready               bool              `json:"isready"`
-	Name                  string            `json:"name"`
-	Ostypeid              string            `json:"ostypeid"`
-	Ostypename            string            `json:"ostypename"`
-	Passwordenabled       bool              `json:"passwordenabled"`
-	Project               string            `json:"project"`
-	Projectid             string            `json:"projectid"`
-	Removed               string            `json:"removed"`
-	Size                  int64             `json:"size"`
-	Sourcetemplateid      string            `json:"sourcetemplateid"`
-	Sshkeyenabled         bool              `json:"sshkeyenabled"`
-	Status                string            `json:"status"`
-	Templatetag           string            `json:"templatetag"`
-	Templatetype          string            `json:"templatetype"`
-	Zoneid                string            `json:"zoneid"`
-	Zonename              string            `json:"zonename"`
+	JobID                 string    `json:"jobid"`
+	Account               string    `json:"account"`
+	Accountid             string    `json:"accountid"`
+	Bootable              bool      `json:"bootable"`
+	Checksum              string    `json:"checksum"`
+	Created               string    `json:"created"`
+	CrossZones            bool      `json:"crossZones"`
+	Details               StringMap `json:"details"`
+	Displaytext           string    `json:"displaytext"`
+	Domain                string    `json:"domain"`
+	Domainid              string    `json:"domainid"`
+	Format                string    `json:"format"`
+	Hostid                string    `json:"hostid"`
+	Hostname              string    `json:"hostname"`
+	Hypervisor            string    `json:"hypervisor"`
+	Id                    string    `json:"id"`
+	Isdynamicallyscalable bool      `json:"isdynamicallyscalable"`
+	Isextractable         bool      `json:"isextractable"`
+	Isfeatured            bool      `json:"isfeatured"`
+	Ispublic              bool      `json:"ispublic"`
+	Isready               bool      `json:"isready"`
+	Name                  string    `json:"name"`
+	Ostypeid              string    `json:"ostypeid"`
+	Ostypename            string    `json:"ostypename"`
+	Passwordenabled       bool      `json:"passwordenabled"`
+	Project               string    `json:"project"`
+	Projectid             string    `json:"projectid"`
+	Removed               string    `json:"removed"`
+	Size                  int64     `json:"size"`
+	Sourcetemplateid      string    `json:"sourcetemplateid"`
+	Sshkeyenabled         bool      `json:"sshkeyenabled"`
+	Status                string    `json:"status"`
+	Templatetag           string    `json:"templatetag"`
+	Templatetype          string    `json:"templatetype"`
+	Zoneid                string    `json:"zoneid"`
+	Zonename              string    `json:"zonename"`
 }
 
 type DeleteIsoParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *DeleteIsoParams) Clone() *DeleteIsoParams {
+	c := &DeleteIsoParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeleteIsoParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -420,6 +603,16 @@ func (p *DeleteIsoParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *DeleteIsoParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of deleteIso", k)
+		}
+	}
+	return nil
+}
+
 func (p *DeleteIsoParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -428,6 +621,13 @@ func (p *DeleteIsoParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *DeleteIsoParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 func (p *DeleteIsoParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -436,18 +636,54 @@ func (p *DeleteIsoParams) SetZoneid(v string) {
 	return
 }
 
+// SetZoneidIfUnset sets zoneid to v, unless it is already set
+func (p *DeleteIsoParams) SetZoneidIfUnset(v string) {
+	if _, found := p.p["zoneid"]; !found {
+		p.SetZoneid(v)
+	}
+}
+
 // You should always use this function to get a new DeleteIsoParams instance,
-// as then you are sure you have configured all required params
-func (s *ISOService) NewDeleteIsoParams(id string) *DeleteIsoParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *ISOService) NewDeleteIsoParams(id string, opts ...func(*DeleteIsoParams)) *DeleteIsoParams {
 	p := &DeleteIsoParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Deletes an ISO file.
-func (s *ISOService) DeleteIso(p *DeleteIsoParams) (*DeleteIsoResponse, error) {
-	resp, err := s.cs.newRequest("deleteIso", p.toURLValues())
+func (s *ISOService) DeleteIso(p *DeleteIsoParams, opts ...OptionFunc) (*DeleteIsoResponse, error) {
+	return s.DeleteIsoWithContext(context.Background(), p, opts...)
+}
+
+// DeleteIsoWithContext is the same as DeleteIso, but the request is cancelled when the context is done
+func (s *ISOService) DeleteIsoWithContext(ctx context.Context, p *DeleteIsoParams, opts ...OptionFunc) (*DeleteIsoResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.DeleteIsoRawWithContext(ctx, p.toURLValues())
+}
+
+// DeleteIsoRaw is the same as DeleteIso, but takes the params as url.Values instead of typed params
+func (s *ISOService) DeleteIsoRaw(v url.Values) (*DeleteIsoResponse, error) {
+	return s.DeleteIsoRawWithContext(context.Background(), v)
+}
+
+// DeleteIsoRawWithContext is the same as DeleteIsoRaw, but the request is cancelled when the context is done
+func (s *ISOService) DeleteIsoRawWithContext(ctx context.Context, v url.Values) (*DeleteIsoResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteIso", "deleteisoresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -459,9 +695,9 @@ func (s *ISOService) DeleteIso(p *DeleteIsoParams) (*DeleteIsoResponse, error) {
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -475,6 +711,34 @@ func (s *ISOService) DeleteIso(p *DeleteIsoParams) (*DeleteIsoResponse, error) {
 	return &r, nil
 }
 
+// WaitForIsoDeleted polls until the Iso with the given ID no longer exists, the timeout
+// expires or the context is cancelled. Deleted resources may still be listed for a short while
+// after the delete command finished, so this can be used to make sure they are really gone. The
+// options are passed to GetIsoByID, so a resource outside of the default scope can be found.
+func (s *ISOService) WaitForIsoDeleted(ctx context.Context, id string, timeout time.Duration, opts ...OptionFunc) error {
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	for {
+		_, count, err := s.GetIsoByID(id, opts...)
+		if count == 0 {
+			return nil
+		}
+		if count < 0 {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(2 * time.Second):
+		}
+	}
+}
+
 type DeleteIsoResponse struct {
 	JobID       string `json:"jobid"`
 	Displaytext string `json:"displaytext"`
@@ -485,6 +749,16 @@ type DetachIsoParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *DetachIsoParams) Clone() *DetachIsoParams {
+	c := &DetachIsoParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DetachIsoParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -496,6 +770,16 @@ func (p *DetachIsoParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *DetachIsoParams) validate() error {
+	for _, k := range []string{"virtualmachineid"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of detachIso", k)
+		}
+	}
+	return nil
+}
+
 func (p *DetachIsoParams) SetVirtualmachineid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -504,18 +788,54 @@ func (p *DetachIsoParams) SetVirtualmachineid(v string) {
 	return
 }
 
+// SetVirtualmachineidIfUnset sets virtualmachineid to v, unless it is already set
+func (p *DetachIsoParams) SetVirtualmachineidIfUnset(v string) {
+	if _, found := p.p["virtualmachineid"]; !found {
+		p.SetVirtualmachineid(v)
+	}
+}
+
 // You should always use this function to get a new DetachIsoParams instance,
-// as then you are sure you have configured all required params
-func (s *ISOService) NewDetachIsoParams(virtualmachineid string) *DetachIsoParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *ISOService) NewDetachIsoParams(virtualmachineid string, opts ...func(*DetachIsoParams)) *DetachIsoParams {
 	p := &DetachIsoParams{}
 	p.p = make(map[string]interface{})
 	p.p["virtualmachineid"] = virtualmachineid
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Detaches any ISO file (if any) currently attached to a virtual machine.
-func (s *ISOService) DetachIso(p *DetachIsoParams) (*DetachIsoResponse, error) {
-	resp, err := s.cs.newRequest("detachIso", p.toURLValues())
+func (s *ISOService) DetachIso(p *DetachIsoParams, opts ...OptionFunc) (*DetachIsoResponse, error) {
+	return s.DetachIsoWithContext(context.Background(), p, opts...)
+}
+
+// DetachIsoWithContext is the same as DetachIso, but the request is cancelled when the context is done
+func (s *ISOService) DetachIsoWithContext(ctx context.Context, p *DetachIsoParams, opts ...OptionFunc) (*DetachIsoResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.DetachIsoRawWithContext(ctx, p.toURLValues())
+}
+
+// DetachIsoRaw is the same as DetachIso, but takes the params as url.Values instead of typed params
+func (s *ISOService) DetachIsoRaw(v url.Values) (*DetachIsoResponse, error) {
+	return s.DetachIsoRawWithContext(context.Background(), v)
+}
+
+// DetachIsoRawWithContext is the same as DetachIsoRaw, but the request is cancelled when the context is done
+func (s *ISOService) DetachIsoRawWithContext(ctx context.Context, v url.Values) (*DetachIsoResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "detachIso", "detachisoresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -527,9 +847,9 @@ func (s *ISOService) DetachIso(p *DetachIsoParams) (*DetachIsoResponse, error) {
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -563,43 +883,43 @@ type DetachIsoResponse struct {
 		Type              string   `json:"type"`
 		VirtualmachineIds []string `json:"virtualmachineIds"`
 	} `json:"affinitygroup"`
-	Cpunumber             int               `json:"cpunumber"`
-	Cpuspeed              int               `json:"cpuspeed"`
-	Cpuused               string            `json:"cpuused"`
-	Created               string            `json:"created"`
-	Details               map[string]string `json:"details"`
-	Diskioread            int64             `json:"diskioread"`
-	Diskiowrite           int64             `json:"diskiowrite"`
-	Diskkbsread           int64             `json:"diskkbsread"`
-	Diskkbswrite          int64             `json:"diskkbswrite"`
-	Diskofferingid        string            `json:"diskofferingid"`
-	Diskofferingname      string            `json:"diskofferingname"`
-	Displayname           string            `json:"displayname"`
-	Displayvm             bool              `json:"displayvm"`
-	Domain                string            `json:"domain"`
-	Domainid              string            `json:"domainid"`
-	Forvirtualnetwork     bool              `json:"forvirtualnetwork"`
-	Group                 string            `json:"group"`
-	Groupid               string            `json:"groupid"`
-	Guestosid             string            `json:"guestosid"`
-	Haenable              bool              `json:"haenable"`
-	Hostid                string            `json:"hostid"`
-	Hostname              string            `json:"hostname"`
-	Hypervisor            string            `json:"hypervisor"`
-	Id                    string            `json:"id"`
-	Instancename          string            `json:"instancename"`
-	Isdynamicallyscalable bool              `json:"isdynamicallyscalable"`
-	Isodisplaytext        string            `json:"isodisplaytext"`
-	Isoid                 string            `json:"isoid"`
-	Isoname               string            `json:"isoname"`
-	Keypair               string            `json:"keypair"`
-	Memory                int               `json:"memory"`
-	Memoryintfreekbs      int64             `json:"memoryintfreekbs"`
-	Memorykbs             int64             `json:"memorykbs"`
-	Memorytargetkbs       int64             `json:"memorytargetkbs"`
-	Name                  string            `json:"name"`
-	Networkkbsread        int64             `json:"networkkbsread"`
-	Networkkbswrite       int64             `json:"networkkbswrite"`
+	Cpunumber             int       `json:"cpunumber"`
+	Cpuspeed              int       `json:"cpuspeed"`
+	Cpuused               string    `json:"cpuused"`
+	Created               string    `json:"created"`
+	Details               StringMap `json:"details"`
+	Diskioread            int64     `json:"diskioread"`
+	Diskiowrite           int64     `json:"diskiowrite"`
+	Diskkbsread           int64     `json:"diskkbsread"`
+	Diskkbswrite          int64     `json:"diskkbswrite"`
+	Diskofferingid        string    `json:"diskofferingid"`
+	Diskofferingname      string    `json:"diskofferingname"`
+	Displayname           string    `json:"displayname"`
+	Displayvm             bool      `json:"displayvm"`
+	Domain                string    `json:"domain"`
+	Domainid              string    `json:"domainid"`
+	Forvirtualnetwork     bool      `json:"forvirtualnetwork"`
+	Group                 string    `json:"group"`
+	Groupid               string    `json:"groupid"`
+	Guestosid             string    `json:"guestosid"`
+	Haenable              bool      `json:"haenable"`
+	Hostid                string    `json:"hostid"`
+	Hostname              string    `json:"hostname"`
+	Hypervisor            string    `json:"hypervisor"`
+	Id                    string    `json:"id"`
+	Instancename          string    `json:"instancename"`
+	Isdynamicallyscalable bool      `json:"isdynamicallyscalable"`
+	Isodisplaytext        string    `json:"isodisplaytext"`
+	Isoid                 string    `json:"isoid"`
+	Isoname               string    `json:"isoname"`
+	Keypair               string    `json:"keypair"`
+	Memory                int       `json:"memory"`
+	Memoryintfreekbs      int64     `json:"memoryintfreekbs"`
+	Memorykbs             int64     `json:"memorykbs"`
+	Memorytargetkbs       int64     `json:"memorytargetkbs"`
+	Name                  string    `json:"name"`
+	Networkkbsread        int64     `json:"networkkbsread"`
+	Networkkbswrite       int64     `json:"networkkbswrite"`
 	Nic                   []struct {
 		Broadcasturi         string `json:"broadcasturi"`
 		Deviceid             string `json:"deviceid"`
@@ -722,6 +1042,16 @@ type ExtractIsoParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ExtractIsoParams) Clone() *ExtractIsoParams {
+	c := &ExtractIsoParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ExtractIsoParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -742,6 +1072,16 @@ func (p *ExtractIsoParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *ExtractIsoParams) validate() error {
+	for _, k := range []string{"id", "mode"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of extractIso", k)
+		}
+	}
+	return nil
+}
+
 func (p *ExtractIsoParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -750,6 +1090,13 @@ func (p *ExtractIsoParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *ExtractIsoParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 func (p *ExtractIsoParams) SetMode(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -758,6 +1105,13 @@ func (p *ExtractIsoParams) SetMode(v string) {
 	return
 }
 
+// SetModeIfUnset sets mode to v, unless it is already set
+func (p *ExtractIsoParams) SetModeIfUnset(v string) {
+	if _, found := p.p["mode"]; !found {
+		p.SetMode(v)
+	}
+}
+
 func (p *ExtractIsoParams) SetUrl(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -766,6 +1120,13 @@ func (p *ExtractIsoParams) SetUrl(v string) {
 	return
 }
 
+// SetUrlIfUnset sets url to v, unless it is already set
+func (p *ExtractIsoParams) SetUrlIfUnset(v string) {
+	if _, found := p.p["url"]; !found {
+		p.SetUrl(v)
+	}
+}
+
 func (p *ExtractIsoParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -774,19 +1135,55 @@ func (p *ExtractIsoParams) SetZoneid(v string) {
 	return
 }
 
+// SetZoneidIfUnset sets zoneid to v, unless it is already set
+func (p *ExtractIsoParams) SetZoneidIfUnset(v string) {
+	if _, found := p.p["zoneid"]; !found {
+		p.SetZoneid(v)
+	}
+}
+
 // You should always use this function to get a new ExtractIsoParams instance,
-// as then you are sure you have configured all required params
-func (s *ISOService) NewExtractIsoParams(id string, mode string) *ExtractIsoParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *ISOService) NewExtractIsoParams(id string, mode string, opts ...func(*ExtractIsoParams)) *ExtractIsoParams {
 	p := &ExtractIsoParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
 	p.p["mode"] = mode
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Extracts an ISO
-func (s *ISOService) ExtractIso(p *ExtractIsoParams) (*ExtractIsoResponse, error) {
-	resp, err := s.cs.newRequest("extractIso", p.toURLValues())
+func (s *ISOService) ExtractIso(p *ExtractIsoParams, opts ...OptionFunc) (*ExtractIsoResponse, error) {
+	return s.ExtractIsoWithContext(context.Background(), p, opts...)
+}
+
+// ExtractIsoWithContext is the same as ExtractIso, but the request is cancelled when the context is done
+func (s *ISOService) ExtractIsoWithContext(ctx context.Context, p *ExtractIsoParams, opts ...OptionFunc) (*ExtractIsoResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.ExtractIsoRawWithContext(ctx, p.toURLValues())
+}
+
+// ExtractIsoRaw is the same as ExtractIso, but takes the params as url.Values instead of typed params
+func (s *ISOService) ExtractIsoRaw(v url.Values) (*ExtractIsoResponse, error) {
+	return s.ExtractIsoRawWithContext(context.Background(), v)
+}
+
+// ExtractIsoRawWithContext is the same as ExtractIsoRaw, but the request is cancelled when the context is done
+func (s *ISOService) ExtractIsoRawWithContext(ctx context.Context, v url.Values) (*ExtractIsoResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "extractIso", "extractisoresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -798,9 +1195,9 @@ func (s *ISOService) ExtractIso(p *ExtractIsoParams) (*ExtractIsoResponse, error
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -841,6 +1238,16 @@ type ListIsoPermissionsParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ListIsoPermissionsParams) Clone() *ListIsoPermissionsParams {
+	c := &ListIsoPermissionsParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListIsoPermissionsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -852,6 +1259,16 @@ func (p *ListIsoPermissionsParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *ListIsoPermissionsParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of listIsoPermissions", k)
+		}
+	}
+	return nil
+}
+
 func (p *ListIsoPermissionsParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -860,15 +1277,33 @@ func (p *ListIsoPermissionsParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *ListIsoPermissionsParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 // You should always use this function to get a new ListIsoPermissionsParams instance,
-// as then you are sure you have configured all required params
-func (s *ISOService) NewListIsoPermissionsParams(id string) *ListIsoPermissionsParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *ISOService) NewListIsoPermissionsParams(id string, opts ...func(*ListIsoPermissionsParams)) *ListIsoPermissionsParams {
 	p := &ListIsoPermissionsParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
+func (p *ListIsoPermissionsParams) setFirstMatch() {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p[firstMatchKey] = true
+}
+
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *ISOService) GetIsoPermissionByID(id string, opts ...OptionFunc) (*IsoPermission, int, error) {
 	p := &ListIsoPermissionsParams{}
@@ -876,7 +1311,7 @@ func (s *ISOService) GetIsoPermissionByID(id string, opts ...OptionFunc) (*IsoPe
 
 	p.p["id"] = id
 
-	for _, fn := range append(s.cs.options, opts...) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
 		if err := fn(s.cs, p); err != nil {
 			return nil, -1, err
 		}
@@ -887,24 +1322,60 @@ func (s *ISOService) GetIsoPermissionByID(id string, opts ...OptionFunc) (*IsoPe
 		if strings.Contains(err.Error(), fmt.Sprintf(
 			"Invalid parameter id value=%s due to incorrect long value format, "+
 				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+			return nil, 0, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
 	}
 
 	if l.Count == 1 {
 		return l.IsoPermissions[0], l.Count, nil
 	}
+
+	if _, ok := p.p[firstMatchKey]; ok && len(l.IsoPermissions) > 0 {
+		return l.IsoPermissions[0], l.Count, nil
+	}
 	return nil, l.Count, fmt.Errorf("There is more then one result for IsoPermission UUID: %s!", id)
 }
 
+// IsoPermissionExists returns true if a IsoPermission with the given ID exists. When no match is found
+// it returns false, while any other error is returned as is.
+func (s *ISOService) IsoPermissionExists(id string, opts ...OptionFunc) (bool, error) {
+	_, count, err := s.GetIsoPermissionByID(id, opts...)
+	if errors.Is(err, ErrNotFound) {
+		return false, nil
+	}
+	if count > 0 {
+		return true, nil
+	}
+	return false, err
+}
+
 // List ISO visibility and all accounts that have permissions to view this ISO.
 func (s *ISOService) ListIsoPermissions(p *ListIsoPermissionsParams) (*ListIsoPermissionsResponse, error) {
-	resp, err := s.cs.newRequest("listIsoPermissions", p.toURLValues())
+	return s.ListIsoPermissionsWithContext(context.Background(), p)
+}
+
+// ListIsoPermissionsWithContext is the same as ListIsoPermissions, but the request is cancelled when the context is done
+func (s *ISOService) ListIsoPermissionsWithContext(ctx context.Context, p *ListIsoPermissionsParams) (*ListIsoPermissionsResponse, error) {
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.ListIsoPermissionsRawWithContext(ctx, p.toURLValues())
+}
+
+// ListIsoPermissionsRaw is the same as ListIsoPermissions, but takes the params as url.Values instead of typed params
+func (s *ISOService) ListIsoPermissionsRaw(v url.Values) (*ListIsoPermissionsResponse, error) {
+	return s.ListIsoPermissionsRawWithContext(context.Background(), v)
+}
+
+// ListIsoPermissionsRawWithContext is the same as ListIsoPermissionsRaw, but the request is cancelled when the context is done
+func (s *ISOService) ListIsoPermissionsRawWithContext(ctx context.Context, v url.Values) (*ListIsoPermissionsResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "listIsoPermissions", "listisopermissionsresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -917,6 +1388,22 @@ func (s *ISOService) ListIsoPermissions(p *ListIsoPermissionsParams) (*ListIsoPe
 	return &r, nil
 }
 
+// ListIsoPermissionsWhere returns all results of ListIsoPermissions for which pred returns true. Note that the results are
+// filtered client-side, so all results matching the params are fetched first.
+func (s *ISOService) ListIsoPermissionsWhere(p *ListIsoPermissionsParams, pred func(*IsoPermission) bool) ([]*IsoPermission, error) {
+	var r []*IsoPermission
+	l, err := s.ListIsoPermissions(p)
+	if err != nil {
+		return nil, err
+	}
+	for _, v := range l.IsoPermissions {
+		if pred(v) {
+			r = append(r, v)
+		}
+	}
+	return r, nil
+}
+
 type ListIsoPermissionsResponse struct {
 	Count          int              `json:"count"`
 	IsoPermissions []*IsoPermission `json:"isopermission"`
@@ -934,6 +1421,16 @@ type ListIsosParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ListIsosParams) Clone() *ListIsosParams {
+	c := &ListIsosParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListIsosParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -996,11 +1493,10 @@ func (p *ListIsosParams) toURLValues() url.Values {
 		u.Set("showremoved", vv)
 	}
 	if v, found := p.p["tags"]; found {
-		i := 0
-		for k, vv := range v.(map[string]string) {
+		m := v.(map[string]string)
+		for i, k := range getSortedKeysFromMap(m) {
 			u.Set(fmt.Sprintf("tags[%d].key", i), k)
-			u.Set(fmt.Sprintf("tags[%d].value", i), vv)
-			i++
+			u.Set(fmt.Sprintf("tags[%d].value", i), m[k])
 		}
 	}
 	if v, found := p.p["zoneid"]; found {
@@ -1017,6 +1513,13 @@ func (p *ListIsosParams) SetAccount(v string) {
 	return
 }
 
+// SetAccountIfUnset sets account to v, unless it is already set
+func (p *ListIsosParams) SetAccountIfUnset(v string) {
+	if _, found := p.p["account"]; !found {
+		p.SetAccount(v)
+	}
+}
+
 func (p *ListIsosParams) SetBootable(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1025,6 +1528,13 @@ func (p *ListIsosParams) SetBootable(v bool) {
 	return
 }
 
+// SetBootableIfUnset sets bootable to v, unless it is already set
+func (p *ListIsosParams) SetBootableIfUnset(v bool) {
+	if _, found := p.p["bootable"]; !found {
+		p.SetBootable(v)
+	}
+}
+
 func (p *ListIsosParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1033,14 +1543,28 @@ func (p *ListIsosParams) SetDomainid(v string) {
 	return
 }
 
-func (p *ListIsosParams) SetHypervisor(v string) {
+// SetDomainidIfUnset sets domainid to v, unless it is already set
+func (p *ListIsosParams) SetDomainidIfUnset(v string) {
+	if _, found := p.p["domainid"]; !found {
+		p.SetDomainid(v)
+	}
+}
+
+func (p *ListIsosParams) SetHypervisor(v HypervisorType) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	p.p["hypervisor"] = v
+	p.p["hypervisor"] = string(v)
 	return
 }
 
+// SetHypervisorIfUnset sets hypervisor to v, unless it is already set
+func (p *ListIsosParams) SetHypervisorIfUnset(v HypervisorType) {
+	if _, found := p.p["hypervisor"]; !found {
+		p.SetHypervisor(v)
+	}
+}
+
 func (p *ListIsosParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1049,14 +1573,28 @@ func (p *ListIsosParams) SetId(v string) {
 	return
 }
 
-func (p *ListIsosParams) SetIsofilter(v string) {
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *ListIsosParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
+func (p *ListIsosParams) SetIsofilter(v TemplateFilter) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	p.p["isofilter"] = v
+	p.p["isofilter"] = string(v)
 	return
 }
 
+// SetIsofilterIfUnset sets isofilter to v, unless it is already set
+func (p *ListIsosParams) SetIsofilterIfUnset(v TemplateFilter) {
+	if _, found := p.p["isofilter"]; !found {
+		p.SetIsofilter(v)
+	}
+}
+
 func (p *ListIsosParams) SetIspublic(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1065,6 +1603,13 @@ func (p *ListIsosParams) SetIspublic(v bool) {
 	return
 }
 
+// SetIspublicIfUnset sets ispublic to v, unless it is already set
+func (p *ListIsosParams) SetIspublicIfUnset(v bool) {
+	if _, found := p.p["ispublic"]; !found {
+		p.SetIspublic(v)
+	}
+}
+
 func (p *ListIsosParams) SetIsready(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1073,6 +1618,13 @@ func (p *ListIsosParams) SetIsready(v bool) {
 	return
 }
 
+// SetIsreadyIfUnset sets isready to v, unless it is already set
+func (p *ListIsosParams) SetIsreadyIfUnset(v bool) {
+	if _, found := p.p["isready"]; !found {
+		p.SetIsready(v)
+	}
+}
+
 func (p *ListIsosParams) SetIsrecursive(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1081,6 +1633,13 @@ func (p *ListIsosParams) SetIsrecursive(v bool) {
 	return
 }
 
+// SetIsrecursiveIfUnset sets isrecursive to v, unless it is already set
+func (p *ListIsosParams) SetIsrecursiveIfUnset(v bool) {
+	if _, found := p.p["isrecursive"]; !found {
+		p.SetIsrecursive(v)
+	}
+}
+
 func (p *ListIsosParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1089,6 +1648,13 @@ func (p *ListIsosParams) SetKeyword(v string) {
 	return
 }
 
+// SetKeywordIfUnset sets keyword to v, unless it is already set
+func (p *ListIsosParams) SetKeywordIfUnset(v string) {
+	if _, found := p.p["keyword"]; !found {
+		p.SetKeyword(v)
+	}
+}
+
 func (p *ListIsosParams) SetListall(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1097,6 +1663,13 @@ func (p *ListIsosParams) SetListall(v bool) {
 	return
 }
 
+// SetListallIfUnset sets listall to v, unless it is already set
+func (p *ListIsosParams) SetListallIfUnset(v bool) {
+	if _, found := p.p["listall"]; !found {
+		p.SetListall(v)
+	}
+}
+
 func (p *ListIsosParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1105,6 +1678,13 @@ func (p *ListIsosParams) SetName(v string) {
 	return
 }
 
+// SetNameIfUnset sets name to v, unless it is already set
+func (p *ListIsosParams) SetNameIfUnset(v string) {
+	if _, found := p.p["name"]; !found {
+		p.SetName(v)
+	}
+}
+
 func (p *ListIsosParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1113,6 +1693,13 @@ func (p *ListIsosParams) SetPage(v int) {
 	return
 }
 
+// SetPageIfUnset sets page to v, unless it is already set
+func (p *ListIsosParams) SetPageIfUnset(v int) {
+	if _, found := p.p["page"]; !found {
+		p.SetPage(v)
+	}
+}
+
 func (p *ListIsosParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1121,6 +1708,13 @@ func (p *ListIsosParams) SetPagesize(v int) {
 	return
 }
 
+// SetPagesizeIfUnset sets pagesize to v, unless it is already set
+func (p *ListIsosParams) SetPagesizeIfUnset(v int) {
+	if _, found := p.p["pagesize"]; !found {
+		p.SetPagesize(v)
+	}
+}
+
 func (p *ListIsosParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1129,6 +1723,13 @@ func (p *ListIsosParams) SetProjectid(v string) {
 	return
 }
 
+// SetProjectidIfUnset sets projectid to v, unless it is already set
+func (p *ListIsosParams) SetProjectidIfUnset(v string) {
+	if _, found := p.p["projectid"]; !found {
+		p.SetProjectid(v)
+	}
+}
+
 func (p *ListIsosParams) SetShowremoved(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1137,6 +1738,13 @@ func (p *ListIsosParams) SetShowremoved(v bool) {
 	return
 }
 
+// SetShowremovedIfUnset sets showremoved to v, unless it is already set
+func (p *ListIsosParams) SetShowremovedIfUnset(v bool) {
+	if _, found := p.p["showremoved"]; !found {
+		p.SetShowremoved(v)
+	}
+}
+
 func (p *ListIsosParams) SetTags(v map[string]string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1145,6 +1753,13 @@ func (p *ListIsosParams) SetTags(v map[string]string) {
 	return
 }
 
+// SetTagsIfUnset sets tags to v, unless it is already set
+func (p *ListIsosParams) SetTagsIfUnset(v map[string]string) {
+	if _, found := p.p["tags"]; !found {
+		p.SetTags(v)
+	}
+}
+
 func (p *ListIsosParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1153,14 +1768,34 @@ func (p *ListIsosParams) SetZoneid(v string) {
 	return
 }
 
+// SetZoneidIfUnset sets zoneid to v, unless it is already set
+func (p *ListIsosParams) SetZoneidIfUnset(v string) {
+	if _, found := p.p["zoneid"]; !found {
+		p.SetZoneid(v)
+	}
+}
+
+var _ ListAllSetter = (*ListIsosParams)(nil)
+
 // You should always use this function to get a new ListIsosParams instance,
-// as then you are sure you have configured all required params
-func (s *ISOService) NewListIsosParams() *ListIsosParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *ISOService) NewListIsosParams(opts ...func(*ListIsosParams)) *ListIsosParams {
 	p := &ListIsosParams{}
 	p.p = make(map[string]interface{})
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
+func (p *ListIsosParams) setFirstMatch() {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p[firstMatchKey] = true
+}
+
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *ISOService) GetIsoID(name string, isofilter string, zoneid string, opts ...OptionFunc) (string, int, error) {
 	p := &ListIsosParams{}
@@ -1170,7 +1805,7 @@ func (s *ISOService) GetIsoID(name string, isofilter string, zoneid string, opts
 	p.p["isofilter"] = isofilter
 	p.p["zoneid"] = zoneid
 
-	for _, fn := range append(s.cs.options, opts...) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
 		if err := fn(s.cs, p); err != nil {
 			return "", -1, err
 		}
@@ -1182,7 +1817,7 @@ func (s *ISOService) GetIsoID(name string, isofilter string, zoneid string, opts
 	}
 
 	if l.Count == 0 {
-		return "", l.Count, fmt.Errorf("No match found for %s: %+v", name, l)
+		return "", l.Count, fmt.Errorf("%w for %s: %+v", ErrNotFound, name, l)
 	}
 
 	if l.Count == 1 {
@@ -1195,6 +1830,10 @@ func (s *ISOService) GetIsoID(name string, isofilter string, zoneid string, opts
 				return v.Id, l.Count, nil
 			}
 		}
+
+		if _, ok := p.p[firstMatchKey]; ok && len(l.Isos) > 0 {
+			return l.Isos[0].Id, l.Count, nil
+		}
 	}
 	return "", l.Count, fmt.Errorf("Could not find an exact match for %s: %+v", name, l)
 }
@@ -1220,7 +1859,7 @@ func (s *ISOService) GetIsoByID(id string, opts ...OptionFunc) (*Iso, int, error
 
 	p.p["id"] = id
 
-	for _, fn := range append(s.cs.options, opts...) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
 		if err := fn(s.cs, p); err != nil {
 			return nil, -1, err
 		}
@@ -1231,24 +1870,56 @@ func (s *ISOService) GetIsoByID(id string, opts ...OptionFunc) (*Iso, int, error
 		if strings.Contains(err.Error(), fmt.Sprintf(
 			"Invalid parameter id value=%s due to incorrect long value format, "+
 				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+			return nil, 0, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
 	}
 
 	if l.Count == 1 {
 		return l.Isos[0], l.Count, nil
 	}
+
+	if _, ok := p.p[firstMatchKey]; ok && len(l.Isos) > 0 {
+		return l.Isos[0], l.Count, nil
+	}
 	return nil, l.Count, fmt.Errorf("There is more then one result for Iso UUID: %s!", id)
 }
 
+// IsoExists returns true if a Iso with the given ID exists. When no match is found
+// it returns false, while any other error is returned as is.
+func (s *ISOService) IsoExists(id string, opts ...OptionFunc) (bool, error) {
+	_, count, err := s.GetIsoByID(id, opts...)
+	if errors.Is(err, ErrNotFound) {
+		return false, nil
+	}
+	if count > 0 {
+		return true, nil
+	}
+	return false, err
+}
+
 // Lists all available ISO files.
 func (s *ISOService) ListIsos(p *ListIsosParams) (*ListIsosResponse, error) {
-	resp, err := s.cs.newRequest("listIsos", p.toURLValues())
+	return s.ListIsosWithContext(context.Background(), p)
+}
+
+// ListIsosWithContext is the same as ListIsos, but the request is cancelled when the context is done
+func (s *ISOService) ListIsosWithContext(ctx context.Context, p *ListIsosParams) (*ListIsosResponse, error) {
+	return s.ListIsosRawWithContext(ctx, p.toURLValues())
+}
+
+// ListIsosRaw is the same as ListIsos, but takes the params as url.Values instead of typed params
+func (s *ISOService) ListIsosRaw(v url.Values) (*ListIsosResponse, error) {
+	return s.ListIsosRawWithContext(context.Background(), v)
+}
+
+// ListIsosRawWithContext is the same as ListIsosRaw, but the request is cancelled when the context is done
+func (s *ISOService) ListIsosRawWithContext(ctx context.Context, v url.Values) (*ListIsosResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "listIsos", "listisosresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -1261,53 +1932,150 @@ func (s *ISOService) ListIsos(p *ListIsosParams) (*ListIsosResponse, error) {
 	return &r, nil
 }
 
+// StreamIsos fetches the results of ListIsos page by page in the background and sends them on the
+// returned channel. Both returned channels are closed when done. If fetching a page fails, or the context
+// is done before all results are sent, the error is sent on the error channel and fetching stops. A
+// request for a page in flight is cancelled when the context is done. The page and pagesize set in the
+// params are ignored.
+func (s *ISOService) StreamIsos(ctx context.Context, p *ListIsosParams) (<-chan *Iso, <-chan error) {
+	ch := make(chan *Iso)
+	errs := make(chan error, 1)
+
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesize(500)
+
+	go func() {
+		defer close(errs)
+		defer close(ch)
+
+		for page, count := 1, 0; ; page++ {
+			pp.SetPage(page)
+
+			l, err := s.ListIsosWithContext(ctx, pp)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			for _, v := range l.Isos {
+				select {
+				case ch <- v:
+				case <-ctx.Done():
+					errs <- ctx.Err()
+					return
+				}
+			}
+
+			count += len(l.Isos)
+			if len(l.Isos) < 500 || count >= l.Count {
+				return
+			}
+		}
+	}()
+
+	return ch, errs
+}
+
+// ListIsosWhere returns all results of ListIsos for which pred returns true. Note that the results are
+// filtered client-side, so all results matching the params are fetched first.
+// All pages are fetched, so the page and pagesize set in the params are ignored.
+func (s *ISOService) ListIsosWhere(p *ListIsosParams, pred func(*Iso) bool) ([]*Iso, error) {
+	var r []*Iso
+	ch, errs := s.StreamIsos(context.Background(), p)
+	for v := range ch {
+		if pred(v) {
+			r = append(r, v)
+		}
+	}
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ListAllIsos returns the results of ListIsos across all pages, by calling ListIsos page by page until all
+// results are fetched. The pagesize set in the params is used for every page (500 if not set), and the
+// page set in the params is ignored.
+func (s *ISOService) ListAllIsos(p *ListIsosParams) ([]*Iso, error) {
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesizeIfUnset(500)
+	pagesize := pp.p["pagesize"].(int)
+
+	var r []*Iso
+	for page := 1; ; page++ {
+		pp.SetPage(page)
+
+		l, err := s.ListIsos(pp)
+		if err != nil {
+			return nil, err
+		}
+		r = append(r, l.Isos...)
+
+		if len(l.Isos) < pagesize || len(r) >= l.Count {
+			return r, nil
+		}
+	}
+}
+
 type ListIsosResponse struct {
 	Count int    `json:"count"`
 	Isos  []*Iso `json:"iso"`
 }
 
 type Iso struct {
-	Account               string            `json:"account"`
-	Accountid             string            `json:"accountid"`
-	Bootable              bool              `json:"bootable"`
-	Checksum              string            `json:"checksum"`
-	Created               string            `json:"created"`
-	CrossZones            bool              `json:"crossZones"`
-	Details               map[string]string `json:"details"`
-	Displaytext           string            `json:"displaytext"`
-	Domain                string            `json:"domain"`
-	Domainid              string            `json:"domainid"`
-	Format                string            `json:"format"`
-	Hostid                string            `json:"hostid"`
-	Hostname              string            `json:"hostname"`
-	Hypervisor            string            `json:"hypervisor"`
-	Id                    string            `json:"id"`
-	Isdynamicallyscalable bool              `json:"isdynamicallyscalable"`
-	Isextractable         bool              `json:"isextractable"`
-	Isfeatured            bool              `json:"isfeatured"`
-	Ispublic              bool              `json:"ispublic"`
-	Isready               bool              `json:"isready"`
-	Name                  string            `json:"name"`
-	Ostypeid              string            `json:"ostypeid"`
-	Ostypename            string            `json:"ostypename"`
-	Passwordenabled       bool              `json:"passwordenabled"`
-	Project               string            `json:"project"`
-	Projectid             string            `json:"projectid"`
-	Removed               string            `json:"removed"`
-	Size                  int64             `json:"size"`
-	Sourcetemplateid      string            `json:"sourcetemplateid"`
-	Sshkeyenabled         bool              `json:"sshkeyenabled"`
-	Status                string            `json:"status"`
-	Templatetag           string            `json:"templatetag"`
-	Templatetype          string            `json:"templatetype"`
-	Zoneid                string            `json:"zoneid"`
-	Zonename              string            `json:"zonename"`
+	Account               string    `json:"account"`
+	Accountid             string    `json:"accountid"`
+	Bootable              bool      `json:"bootable"`
+	Checksum              string    `json:"checksum"`
+	Created               string    `json:"created"`
+	CrossZones            bool      `json:"crossZones"`
+	Details               StringMap `json:"details"`
+	Displaytext           string    `json:"displaytext"`
+	Domain                string    `json:"domain"`
+	Domainid              string    `json:"domainid"`
+	Format                string    `json:"format"`
+	Hostid                string    `json:"hostid"`
+	Hostname              string    `json:"hostname"`
+	Hypervisor            string    `json:"hypervisor"`
+	Id                    string    `json:"id"`
+	Isdynamicallyscalable bool      `json:"isdynamicallyscalable"`
+	Isextractable         bool      `json:"isextractable"`
+	Isfeatured            bool      `json:"isfeatured"`
+	Ispublic              bool      `json:"ispublic"`
+	Isready               bool      `json:"isready"`
+	Name                  string    `json:"name"`
+	Ostypeid              string    `json:"ostypeid"`
+	Ostypename            string    `json:"ostypename"`
+	Passwordenabled       bool      `json:"passwordenabled"`
+	Project               string    `json:"project"`
+	Projectid             string    `json:"projectid"`
+	Removed               string    `json:"removed"`
+	Size                  int64     `json:"size"`
+	Sourcetemplateid      string    `json:"sourcetemplateid"`
+	Sshkeyenabled         bool      `json:"sshkeyenabled"`
+	Status                string    `json:"status"`
+	Templatetag           string    `json:"templatetag"`
+	Templatetype          string    `json:"templatetype"`
+	Zoneid                string    `json:"zoneid"`
+	Zonename              string    `json:"zonename"`
 }
 
 type RegisterIsoParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *RegisterIsoParams) Clone() *RegisterIsoParams {
+	c := &RegisterIsoParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *RegisterIsoParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1366,6 +2134,16 @@ func (p *RegisterIsoParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *RegisterIsoParams) validate() error {
+	for _, k := range []string{"displaytext", "name", "url", "zoneid"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of registerIso", k)
+		}
+	}
+	return nil
+}
+
 func (p *RegisterIsoParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1374,6 +2152,13 @@ func (p *RegisterIsoParams) SetAccount(v string) {
 	return
 }
 
+// SetAccountIfUnset sets account to v, unless it is already set
+func (p *RegisterIsoParams) SetAccountIfUnset(v string) {
+	if _, found := p.p["account"]; !found {
+		p.SetAccount(v)
+	}
+}
+
 func (p *RegisterIsoParams) SetBootable(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1382,6 +2167,13 @@ func (p *RegisterIsoParams) SetBootable(v bool) {
 	return
 }
 
+// SetBootableIfUnset sets bootable to v, unless it is already set
+func (p *RegisterIsoParams) SetBootableIfUnset(v bool) {
+	if _, found := p.p["bootable"]; !found {
+		p.SetBootable(v)
+	}
+}
+
 func (p *RegisterIsoParams) SetChecksum(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1390,6 +2182,13 @@ func (p *RegisterIsoParams) SetChecksum(v string) {
 	return
 }
 
+// SetChecksumIfUnset sets checksum to v, unless it is already set
+func (p *RegisterIsoParams) SetChecksumIfUnset(v string) {
+	if _, found := p.p["checksum"]; !found {
+		p.SetChecksum(v)
+	}
+}
+
 func (p *RegisterIsoParams) SetDisplaytext(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1398,6 +2197,13 @@ func (p *RegisterIsoParams) SetDisplaytext(v string) {
 	return
 }
 
+// SetDisplaytextIfUnset sets displaytext to v, unless it is already set
+func (p *RegisterIsoParams) SetDisplaytextIfUnset(v string) {
+	if _, found := p.p["displaytext"]; !found {
+		p.SetDisplaytext(v)
+	}
+}
+
 func (p *RegisterIsoParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1406,6 +2212,13 @@ func (p *RegisterIsoParams) SetDomainid(v string) {
 	return
 }
 
+// SetDomainidIfUnset sets domainid to v, unless it is already set
+func (p *RegisterIsoParams) SetDomainidIfUnset(v string) {
+	if _, found := p.p["domainid"]; !found {
+		p.SetDomainid(v)
+	}
+}
+
 func (p *RegisterIsoParams) SetImagestoreuuid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1414,6 +2227,13 @@ func (p *RegisterIsoParams) SetImagestoreuuid(v string) {
 	return
 }
 
+// SetImagestoreuuidIfUnset sets imagestoreuuid to v, unless it is already set
+func (p *RegisterIsoParams) SetImagestoreuuidIfUnset(v string) {
+	if _, found := p.p["imagestoreuuid"]; !found {
+		p.SetImagestoreuuid(v)
+	}
+}
+
 func (p *RegisterIsoParams) SetIsdynamicallyscalable(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1422,6 +2242,13 @@ func (p *RegisterIsoParams) SetIsdynamicallyscalable(v bool) {
 	return
 }
 
+// SetIsdynamicallyscalableIfUnset sets isdynamicallyscalable to v, unless it is already set
+func (p *RegisterIsoParams) SetIsdynamicallyscalableIfUnset(v bool) {
+	if _, found := p.p["isdynamicallyscalable"]; !found {
+		p.SetIsdynamicallyscalable(v)
+	}
+}
+
 func (p *RegisterIsoParams) SetIsextractable(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1430,6 +2257,13 @@ func (p *RegisterIsoParams) SetIsextractable(v bool) {
 	return
 }
 
+// SetIsextractableIfUnset sets isextractable to v, unless it is already set
+func (p *RegisterIsoParams) SetIsextractableIfUnset(v bool) {
+	if _, found := p.p["isextractable"]; !found {
+		p.SetIsextractable(v)
+	}
+}
+
 func (p *RegisterIsoParams) SetIsfeatured(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1438,6 +2272,13 @@ func (p *RegisterIsoParams) SetIsfeatured(v bool) {
 	return
 }
 
+// SetIsfeaturedIfUnset sets isfeatured to v, unless it is already set
+func (p *RegisterIsoParams) SetIsfeaturedIfUnset(v bool) {
+	if _, found := p.p["isfeatured"]; !found {
+		p.SetIsfeatured(v)
+	}
+}
+
 func (p *RegisterIsoParams) SetIspublic(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1446,6 +2287,13 @@ func (p *RegisterIsoParams) SetIspublic(v bool) {
 	return
 }
 
+// SetIspublicIfUnset sets ispublic to v, unless it is already set
+func (p *RegisterIsoParams) SetIspublicIfUnset(v bool) {
+	if _, found := p.p["ispublic"]; !found {
+		p.SetIspublic(v)
+	}
+}
+
 func (p *RegisterIsoParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1454,6 +2302,13 @@ func (p *RegisterIsoParams) SetName(v string) {
 	return
 }
 
+// SetNameIfUnset sets name to v, unless it is already set
+func (p *RegisterIsoParams) SetNameIfUnset(v string) {
+	if _, found := p.p["name"]; !found {
+		p.SetName(v)
+	}
+}
+
 func (p *RegisterIsoParams) SetOstypeid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1462,6 +2317,13 @@ func (p *RegisterIsoParams) SetOstypeid(v string) {
 	return
 }
 
+// SetOstypeidIfUnset sets ostypeid to v, unless it is already set
+func (p *RegisterIsoParams) SetOstypeidIfUnset(v string) {
+	if _, found := p.p["ostypeid"]; !found {
+		p.SetOstypeid(v)
+	}
+}
+
 func (p *RegisterIsoParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1470,6 +2332,13 @@ func (p *RegisterIsoParams) SetProjectid(v string) {
 	return
 }
 
+// SetProjectidIfUnset sets projectid to v, unless it is already set
+func (p *RegisterIsoParams) SetProjectidIfUnset(v string) {
+	if _, found := p.p["projectid"]; !found {
+		p.SetProjectid(v)
+	}
+}
+
 func (p *RegisterIsoParams) SetUrl(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1478,6 +2347,13 @@ func (p *RegisterIsoParams) SetUrl(v string) {
 	return
 }
 
+// SetUrlIfUnset sets url to v, unless it is already set
+func (p *RegisterIsoParams) SetUrlIfUnset(v string) {
+	if _, found := p.p["url"]; !found {
+		p.SetUrl(v)
+	}
+}
+
 func (p *RegisterIsoParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1486,21 +2362,117 @@ func (p *RegisterIsoParams) SetZoneid(v string) {
 	return
 }
 
+// SetZoneidIfUnset sets zoneid to v, unless it is already set
+func (p *RegisterIsoParams) SetZoneidIfUnset(v string) {
+	if _, found := p.p["zoneid"]; !found {
+		p.SetZoneid(v)
+	}
+}
+
 // You should always use this function to get a new RegisterIsoParams instance,
-// as then you are sure you have configured all required params
-func (s *ISOService) NewRegisterIsoParams(displaytext string, name string, url string, zoneid string) *RegisterIsoParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *ISOService) NewRegisterIsoParams(displaytext string, name string, url string, zoneid string, opts ...func(*RegisterIsoParams)) *RegisterIsoParams {
 	p := &RegisterIsoParams{}
 	p.p = make(map[string]interface{})
 	p.p["displaytext"] = displaytext
 	p.p["name"] = name
 	p.p["url"] = url
 	p.p["zoneid"] = zoneid
+	for _, fn := range opts {
+		fn(p)
+	}
+	return p
+}
+
+// RegisterIsoOpts contains all params that can be set on a RegisterIsoParams.
+// Optional params are pointers (or slices and maps) which are only set when not nil.
+type RegisterIsoOpts struct {
+	Account               *string
+	Bootable              *bool
+	Checksum              *string
+	Displaytext           string
+	Domainid              *string
+	Imagestoreuuid        *string
+	Isdynamicallyscalable *bool
+	Isextractable         *bool
+	Isfeatured            *bool
+	Ispublic              *bool
+	Name                  string
+	Ostypeid              *string
+	Projectid             *string
+	Url                   string
+	Zoneid                string
+}
+
+// NewRegisterIsoParamsFromOpts is an alternative for NewRegisterIsoParams which takes all params using
+// an options struct, as that is easier to read for APIs with many required params
+func (s *ISOService) NewRegisterIsoParamsFromOpts(o RegisterIsoOpts) *RegisterIsoParams {
+	p := s.NewRegisterIsoParams(o.Displaytext, o.Name, o.Url, o.Zoneid)
+	if o.Account != nil {
+		p.p["account"] = *o.Account
+	}
+	if o.Bootable != nil {
+		p.p["bootable"] = *o.Bootable
+	}
+	if o.Checksum != nil {
+		p.p["checksum"] = *o.Checksum
+	}
+	if o.Domainid != nil {
+		p.p["domainid"] = *o.Domainid
+	}
+	if o.Imagestoreuuid != nil {
+		p.p["imagestoreuuid"] = *o.Imagestoreuuid
+	}
+	if o.Isdynamicallyscalable != nil {
+		p.p["isdynamicallyscalable"] = *o.Isdynamicallyscalable
+	}
+	if o.Isextractable != nil {
+		p.p["isextractable"] = *o.Isextractable
+	}
+	if o.Isfeatured != nil {
+		p.p["isfeatured"] = *o.Isfeatured
+	}
+	if o.Ispublic != nil {
+		p.p["ispublic"] = *o.Ispublic
+	}
+	if o.Ostypeid != nil {
+		p.p["ostypeid"] = *o.Ostypeid
+	}
+	if o.Projectid != nil {
+		p.p["projectid"] = *o.Projectid
+	}
 	return p
 }
 
 // Registers an existing ISO into the CloudStack Cloud.
-func (s *ISOService) RegisterIso(p *RegisterIsoParams) (*RegisterIsoResponse, error) {
-	resp, err := s.cs.newRequest("registerIso", p.toURLValues())
+func (s *ISOService) RegisterIso(p *RegisterIsoParams, opts ...OptionFunc) (*RegisterIsoResponse, error) {
+	return s.RegisterIsoWithContext(context.Background(), p, opts...)
+}
+
+// RegisterIsoWithContext is the same as RegisterIso, but the request is cancelled when the context is done
+func (s *ISOService) RegisterIsoWithContext(ctx context.Context, p *RegisterIsoParams, opts ...OptionFunc) (*RegisterIsoResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.RegisterIsoRawWithContext(ctx, p.toURLValues())
+}
+
+// RegisterIsoRaw is the same as RegisterIso, but takes the params as url.Values instead of typed params
+func (s *ISOService) RegisterIsoRaw(v url.Values) (*RegisterIsoResponse, error) {
+	return s.RegisterIsoRawWithContext(context.Background(), v)
+}
+
+// RegisterIsoRawWithContext is the same as RegisterIsoRaw, but the request is cancelled when the context is done
+func (s *ISOService) RegisterIsoRawWithContext(ctx context.Context, v url.Values) (*RegisterIsoResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "registerIso", "registerisoresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -1514,47 +2486,57 @@ func (s *ISOService) RegisterIso(p *RegisterIsoParams) (*RegisterIsoResponse, er
 }
 
 type RegisterIsoResponse struct {
-	Account               string            `json:"account"`
-	Accountid             string            `json:"accountid"`
-	Bootable              bool              `json:"bootable"`
-	Checksum              string            `json:"checksum"`
-	Created               string            `json:"created"`
-	CrossZones            bool              `json:"crossZones"`
-	Details               map[string]string `json:"details"`
-	Displaytext           string            `json:"displaytext"`
-	Domain                string            `json:"domain"`
-	Domainid              string            `json:"domainid"`
-	Format                string            `json:"format"`
-	Hostid                string            `json:"hostid"`
-	Hostname              string            `json:"hostname"`
-	Hypervisor            string            `json:"hypervisor"`
-	Id                    string            `json:"id"`
-	Isdynamicallyscalable bool              `json:"isdynamicallyscalable"`
-	Isextractable         bool              `json:"isextractable"`
-	Isfeatured            bool              `json:"isfeatured"`
-	Ispublic              bool              `json:"ispublic"`
-	Isready               bool              `json:"isready"`
-	Name                  string            `json:"name"`
-	Ostypeid              string            `json:"ostypeid"`
-	Ostypename            string            `json:"ostypename"`
-	Passwordenabled       bool              `json:"passwordenabled"`
-	Project               string            `json:"project"`
-	Projectid             string            `json:"projectid"`
-	Removed               string            `json:"removed"`
-	Size                  int64             `json:"size"`
-	Sourcetemplateid      string            `json:"sourcetemplateid"`
-	Sshkeyenabled         bool              `json:"sshkeyenabled"`
-	Status                string            `json:"status"`
-	Templatetag           string            `json:"templatetag"`
-	Templatetype          string            `json:"templatetype"`
-	Zoneid                string            `json:"zoneid"`
-	Zonename              string            `json:"zonename"`
+	Account               string    `json:"account"`
+	Accountid             string    `json:"accountid"`
+	Bootable              bool      `json:"bootable"`
+	Checksum              string    `json:"checksum"`
+	Created               string    `json:"created"`
+	CrossZones            bool      `json:"crossZones"`
+	Details               StringMap `json:"details"`
+	Displaytext           string    `json:"displaytext"`
+	Domain                string    `json:"domain"`
+	Domainid              string    `json:"domainid"`
+	Format                string    `json:"format"`
+	Hostid                string    `json:"hostid"`
+	Hostname              string    `json:"hostname"`
+	Hypervisor            string    `json:"hypervisor"`
+	Id                    string    `json:"id"`
+	Isdynamicallyscalable bool      `json:"isdynamicallyscalable"`
+	Isextractable         bool      `json:"isextractable"`
+	Isfeatured            bool      `json:"isfeatured"`
+	Ispublic              bool      `json:"ispublic"`
+	Isready               bool      `json:"isready"`
+	Name                  string    `json:"name"`
+	Ostypeid              string    `json:"ostypeid"`
+	Ostypename            string    `json:"ostypename"`
+	Passwordenabled       bool      `json:"passwordenabled"`
+	Project               string    `json:"project"`
+	Projectid             string    `json:"projectid"`
+	Removed               string    `json:"removed"`
+	Size                  int64     `json:"size"`
+	Sourcetemplateid      string    `json:"sourcetemplateid"`
+	Sshkeyenabled         bool      `json:"sshkeyenabled"`
+	Status                string    `json:"status"`
+	Templatetag           string    `json:"templatetag"`
+	Templatetype          string    `json:"templatetype"`
+	Zoneid                string    `json:"zoneid"`
+	Zonename              string    `json:"zonename"`
 }
 
 type UpdateIsoParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *UpdateIsoParams) Clone() *UpdateIsoParams {
+	c := &UpdateIsoParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *UpdateIsoParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1565,10 +2547,9 @@ func (p *UpdateIsoParams) toURLValues() url.Values {
 		u.Set("bootable", vv)
 	}
 	if v, found := p.p["details"]; found {
-		i := 0
-		for k, vv := range v.(map[string]string) {
-			u.Set(fmt.Sprintf("details[%d].%s", i, k), vv)
-			i++
+		m := v.(map[string]string)
+		for i, k := range getSortedKeysFromMap(m) {
+			u.Set(fmt.Sprintf("details[%d].%s", i, k), m[k])
 		}
 	}
 	if v, found := p.p["displaytext"]; found {
@@ -1609,6 +2590,16 @@ func (p *UpdateIsoParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *UpdateIsoParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of updateIso", k)
+		}
+	}
+	return nil
+}
+
 func (p *UpdateIsoParams) SetBootable(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1617,6 +2608,13 @@ func (p *UpdateIsoParams) SetBootable(v bool) {
 	return
 }
 
+// SetBootableIfUnset sets bootable to v, unless it is already set
+func (p *UpdateIsoParams) SetBootableIfUnset(v bool) {
+	if _, found := p.p["bootable"]; !found {
+		p.SetBootable(v)
+	}
+}
+
 func (p *UpdateIsoParams) SetDetails(v map[string]string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1625,6 +2623,13 @@ func (p *UpdateIsoParams) SetDetails(v map[string]string) {
 	return
 }
 
+// SetDetailsIfUnset sets details to v, unless it is already set
+func (p *UpdateIsoParams) SetDetailsIfUnset(v map[string]string) {
+	if _, found := p.p["details"]; !found {
+		p.SetDetails(v)
+	}
+}
+
 func (p *UpdateIsoParams) SetDisplaytext(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1633,6 +2638,13 @@ func (p *UpdateIsoParams) SetDisplaytext(v string) {
 	return
 }
 
+// SetDisplaytextIfUnset sets displaytext to v, unless it is already set
+func (p *UpdateIsoParams) SetDisplaytextIfUnset(v string) {
+	if _, found := p.p["displaytext"]; !found {
+		p.SetDisplaytext(v)
+	}
+}
+
 func (p *UpdateIsoParams) SetFormat(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1641,6 +2653,13 @@ func (p *UpdateIsoParams) SetFormat(v string) {
 	return
 }
 
+// SetFormatIfUnset sets format to v, unless it is already set
+func (p *UpdateIsoParams) SetFormatIfUnset(v string) {
+	if _, found := p.p["format"]; !found {
+		p.SetFormat(v)
+	}
+}
+
 func (p *UpdateIsoParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1649,6 +2668,13 @@ func (p *UpdateIsoParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *UpdateIsoParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 func (p *UpdateIsoParams) SetIsdynamicallyscalable(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1657,6 +2683,13 @@ func (p *UpdateIsoParams) SetIsdynamicallyscalable(v bool) {
 	return
 }
 
+// SetIsdynamicallyscalableIfUnset sets isdynamicallyscalable to v, unless it is already set
+func (p *UpdateIsoParams) SetIsdynamicallyscalableIfUnset(v bool) {
+	if _, found := p.p["isdynamicallyscalable"]; !found {
+		p.SetIsdynamicallyscalable(v)
+	}
+}
+
 func (p *UpdateIsoParams) SetIsrouting(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1665,6 +2698,13 @@ func (p *UpdateIsoParams) SetIsrouting(v bool) {
 	return
 }
 
+// SetIsroutingIfUnset sets isrouting to v, unless it is already set
+func (p *UpdateIsoParams) SetIsroutingIfUnset(v bool) {
+	if _, found := p.p["isrouting"]; !found {
+		p.SetIsrouting(v)
+	}
+}
+
 func (p *UpdateIsoParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1673,6 +2713,13 @@ func (p *UpdateIsoParams) SetName(v string) {
 	return
 }
 
+// SetNameIfUnset sets name to v, unless it is already set
+func (p *UpdateIsoParams) SetNameIfUnset(v string) {
+	if _, found := p.p["name"]; !found {
+		p.SetName(v)
+	}
+}
+
 func (p *UpdateIsoParams) SetOstypeid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1681,6 +2728,13 @@ func (p *UpdateIsoParams) SetOstypeid(v string) {
 	return
 }
 
+// SetOstypeidIfUnset sets ostypeid to v, unless it is already set
+func (p *UpdateIsoParams) SetOstypeidIfUnset(v string) {
+	if _, found := p.p["ostypeid"]; !found {
+		p.SetOstypeid(v)
+	}
+}
+
 func (p *UpdateIsoParams) SetPasswordenabled(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1689,6 +2743,13 @@ func (p *UpdateIsoParams) SetPasswordenabled(v bool) {
 	return
 }
 
+// SetPasswordenabledIfUnset sets passwordenabled to v, unless it is already set
+func (p *UpdateIsoParams) SetPasswordenabledIfUnset(v bool) {
+	if _, found := p.p["passwordenabled"]; !found {
+		p.SetPasswordenabled(v)
+	}
+}
+
 func (p *UpdateIsoParams) SetRequireshvm(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1697,6 +2758,13 @@ func (p *UpdateIsoParams) SetRequireshvm(v bool) {
 	return
 }
 
+// SetRequireshvmIfUnset sets requireshvm to v, unless it is already set
+func (p *UpdateIsoParams) SetRequireshvmIfUnset(v bool) {
+	if _, found := p.p["requireshvm"]; !found {
+		p.SetRequireshvm(v)
+	}
+}
+
 func (p *UpdateIsoParams) SetSortkey(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1705,18 +2773,54 @@ func (p *UpdateIsoParams) SetSortkey(v int) {
 	return
 }
 
+// SetSortkeyIfUnset sets sortkey to v, unless it is already set
+func (p *UpdateIsoParams) SetSortkeyIfUnset(v int) {
+	if _, found := p.p["sortkey"]; !found {
+		p.SetSortkey(v)
+	}
+}
+
 // You should always use this function to get a new UpdateIsoParams instance,
-// as then you are sure you have configured all required params
-func (s *ISOService) NewUpdateIsoParams(id string) *UpdateIsoParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *ISOService) NewUpdateIsoParams(id string, opts ...func(*UpdateIsoParams)) *UpdateIsoParams {
 	p := &UpdateIsoParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Updates an ISO file.
-func (s *ISOService) UpdateIso(p *UpdateIsoParams) (*UpdateIsoResponse, error) {
-	resp, err := s.cs.newRequest("updateIso", p.toURLValues())
+func (s *ISOService) UpdateIso(p *UpdateIsoParams, opts ...OptionFunc) (*UpdateIsoResponse, error) {
+	return s.UpdateIsoWithContext(context.Background(), p, opts...)
+}
+
+// UpdateIsoWithContext is the same as UpdateIso, but the request is cancelled when the context is done
+func (s *ISOService) UpdateIsoWithContext(ctx context.Context, p *UpdateIsoParams, opts ...OptionFunc) (*UpdateIsoResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.UpdateIsoRawWithContext(ctx, p.toURLValues())
+}
+
+// UpdateIsoRaw is the same as UpdateIso, but takes the params as url.Values instead of typed params
+func (s *ISOService) UpdateIsoRaw(v url.Values) (*UpdateIsoResponse, error) {
+	return s.UpdateIsoRawWithContext(context.Background(), v)
+}
+
+// UpdateIsoRawWithContext is the same as UpdateIsoRaw, but the request is cancelled when the context is done
+func (s *ISOService) UpdateIsoRawWithContext(ctx context.Context, v url.Values) (*UpdateIsoResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "updateIso", "updateisoresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -1730,47 +2834,57 @@ func (s *ISOService) UpdateIso(p *UpdateIsoParams) (*UpdateIsoResponse, error) {
 }
 
 type UpdateIsoResponse struct {
-	Account               string            `json:"account"`
-	Accountid             string            `json:"accountid"`
-	Bootable              bool              `json:"bootable"`
-	Checksum              string            `json:"checksum"`
-	Created               string            `json:"created"`
-	CrossZones            bool              `json:"crossZones"`
-	Details               map[string]string `json:"details"`
-	Displaytext           string            `json:"displaytext"`
-	Domain                string            `json:"domain"`
-	Domainid              string            `json:"domainid"`
-	Format                string            `json:"format"`
-	Hostid                string            `json:"hostid"`
-	Hostname              string            `json:"hostname"`
-	Hypervisor            string            `json:"hypervisor"`
-	Id                    string            `json:"id"`
-	Isdynamicallyscalable bool              `json:"isdynamicallyscalable"`
-	Isextractable         bool              `json:"isextractable"`
-	Isfeatured            bool              `json:"isfeatured"`
-	Ispublic              bool              `json:"ispublic"`
-	Isready               bool              `json:"isready"`
-	Name                  string            `json:"name"`
-	Ostypeid              string            `json:"ostypeid"`
-	Ostypename            string            `json:"ostypename"`
-	Passwordenabled       bool              `json:"passwordenabled"`
-	Project               string            `json:"project"`
-	Projectid             string            `json:"projectid"`
-	Removed               string            `json:"removed"`
-	Size                  int64             `json:"size"`
-	Sourcetemplateid      string            `json:"sourcetemplateid"`
-	Sshkeyenabled         bool              `json:"sshkeyenabled"`
-	Status                string            `json:"status"`
-	Templatetag           string            `json:"templatetag"`
-	Templatetype          string            `json:"templatetype"`
-	Zoneid                string            `json:"zoneid"`
-	Zonename              string            `json:"zonename"`
+	Account               string    `json:"account"`
+	Accountid             string    `json:"accountid"`
+	Bootable              bool      `json:"bootable"`
+	Checksum              string    `json:"checksum"`
+	Created               string    `json:"created"`
+	CrossZones            bool      `json:"crossZones"`
+	Details               StringMap `json:"details"`
+	Displaytext           string    `json:"displaytext"`
+	Domain                string    `json:"domain"`
+	Domainid              string    `json:"domainid"`
+	Format                string    `json:"format"`
+	Hostid                string    `json:"hostid"`
+	Hostname              string    `json:"hostname"`
+	Hypervisor            string    `json:"hypervisor"`
+	Id                    string    `json:"id"`
+	Isdynamicallyscalable bool      `json:"isdynamicallyscalable"`
+	Isextractable         bool      `json:"isextractable"`
+	Isfeatured            bool      `json:"isfeatured"`
+	Ispublic              bool      `json:"ispublic"`
+	Isready               bool      `json:"isready"`
+	Name                  string    `json:"name"`
+	Ostypeid              string    `json:"ostypeid"`
+	Ostypename            string    `json:"ostypename"`
+	Passwordenabled       bool      `json:"passwordenabled"`
+	Project               string    `json:"project"`
+	Projectid             string    `json:"projectid"`
+	Removed               string    `json:"removed"`
+	Size                  int64     `json:"size"`
+	Sourcetemplateid      string    `json:"sourcetemplateid"`
+	Sshkeyenabled         bool      `json:"sshkeyenabled"`
+	Status                string    `json:"status"`
+	Templatetag           string    `json:"templatetag"`
+	Templatetype          string    `json:"templatetype"`
+	Zoneid                string    `json:"zoneid"`
+	Zonename              string    `json:"zonename"`
 }
 
 type UpdateIsoPermissionsParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *UpdateIsoPermissionsParams) Clone() *UpdateIsoPermissionsParams {
+	c := &UpdateIsoPermissionsParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *UpdateIsoPermissionsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1805,6 +2919,16 @@ func (p *UpdateIsoPermissionsParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *UpdateIsoPermissionsParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of updateIsoPermissions", k)
+		}
+	}
+	return nil
+}
+
 func (p *UpdateIsoPermissionsParams) SetAccounts(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1813,6 +2937,18 @@ func (p *UpdateIsoPermissionsParams) SetAccounts(v []string) {
 	return
 }
 
+// SetAccountsIfUnset sets accounts to v, unless it is already set
+func (p *UpdateIsoPermissionsParams) SetAccountsIfUnset(v []string) {
+	if _, found := p.p["accounts"]; !found {
+		p.SetAccounts(v)
+	}
+}
+
+// SetAccountsCSV splits v on commas and sets the resulting values
+func (p *UpdateIsoPermissionsParams) SetAccountsCSV(v string) {
+	p.SetAccounts(splitCSV(v))
+}
+
 func (p *UpdateIsoPermissionsParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1821,6 +2957,13 @@ func (p *UpdateIsoPermissionsParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *UpdateIsoPermissionsParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 func (p *UpdateIsoPermissionsParams) SetIsextractable(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1829,6 +2972,13 @@ func (p *UpdateIsoPermissionsParams) SetIsextractable(v bool) {
 	return
 }
 
+// SetIsextractableIfUnset sets isextractable to v, unless it is already set
+func (p *UpdateIsoPermissionsParams) SetIsextractableIfUnset(v bool) {
+	if _, found := p.p["isextractable"]; !found {
+		p.SetIsextractable(v)
+	}
+}
+
 func (p *UpdateIsoPermissionsParams) SetIsfeatured(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1837,6 +2987,13 @@ func (p *UpdateIsoPermissionsParams) SetIsfeatured(v bool) {
 	return
 }
 
+// SetIsfeaturedIfUnset sets isfeatured to v, unless it is already set
+func (p *UpdateIsoPermissionsParams) SetIsfeaturedIfUnset(v bool) {
+	if _, found := p.p["isfeatured"]; !found {
+		p.SetIsfeatured(v)
+	}
+}
+
 func (p *UpdateIsoPermissionsParams) SetIspublic(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1845,6 +3002,13 @@ func (p *UpdateIsoPermissionsParams) SetIspublic(v bool) {
 	return
 }
 
+// SetIspublicIfUnset sets ispublic to v, unless it is already set
+func (p *UpdateIsoPermissionsParams) SetIspublicIfUnset(v bool) {
+	if _, found := p.p["ispublic"]; !found {
+		p.SetIspublic(v)
+	}
+}
+
 func (p *UpdateIsoPermissionsParams) SetOp(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1853,6 +3017,13 @@ func (p *UpdateIsoPermissionsParams) SetOp(v string) {
 	return
 }
 
+// SetOpIfUnset sets op to v, unless it is already set
+func (p *UpdateIsoPermissionsParams) SetOpIfUnset(v string) {
+	if _, found := p.p["op"]; !found {
+		p.SetOp(v)
+	}
+}
+
 func (p *UpdateIsoPermissionsParams) SetProjectids(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1861,18 +3032,59 @@ func (p *UpdateIsoPermissionsParams) SetProjectids(v []string) {
 	return
 }
 
+// SetProjectidsIfUnset sets projectids to v, unless it is already set
+func (p *UpdateIsoPermissionsParams) SetProjectidsIfUnset(v []string) {
+	if _, found := p.p["projectids"]; !found {
+		p.SetProjectids(v)
+	}
+}
+
+// SetProjectidsCSV splits v on commas and sets the resulting values
+func (p *UpdateIsoPermissionsParams) SetProjectidsCSV(v string) {
+	p.SetProjectids(splitCSV(v))
+}
+
 // You should always use this function to get a new UpdateIsoPermissionsParams instance,
-// as then you are sure you have configured all required params
-func (s *ISOService) NewUpdateIsoPermissionsParams(id string) *UpdateIsoPermissionsParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *ISOService) NewUpdateIsoPermissionsParams(id string, opts ...func(*UpdateIsoPermissionsParams)) *UpdateIsoPermissionsParams {
 	p := &UpdateIsoPermissionsParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Updates ISO permissions
-func (s *ISOService) UpdateIsoPermissions(p *UpdateIsoPermissionsParams) (*UpdateIsoPermissionsResponse, error) {
-	resp, err := s.cs.newRequest("updateIsoPermissions", p.toURLValues())
+func (s *ISOService) UpdateIsoPermissions(p *UpdateIsoPermissionsParams, opts ...OptionFunc) (*UpdateIsoPermissionsResponse, error) {
+	return s.UpdateIsoPermissionsWithContext(context.Background(), p, opts...)
+}
+
+// UpdateIsoPermissionsWithContext is the same as UpdateIsoPermissions, but the request is cancelled when the context is done
+func (s *ISOService) UpdateIsoPermissionsWithContext(ctx context.Context, p *UpdateIsoPermissionsParams, opts ...OptionFunc) (*UpdateIsoPermissionsResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.UpdateIsoPermissionsRawWithContext(ctx, p.toURLValues())
+}
+
+// UpdateIsoPermissionsRaw is the same as UpdateIsoPermissions, but takes the params as url.Values instead of typed params
+func (s *ISOService) UpdateIsoPermissionsRaw(v url.Values) (*UpdateIsoPermissionsResponse, error) {
+	return s.UpdateIsoPermissionsRawWithContext(context.Background(), v)
+}
+
+// UpdateIsoPermissionsRawWithContext is the same as UpdateIsoPermissionsRaw, but the request is cancelled when the context is done
+func (s *ISOService) UpdateIsoPermissionsRawWithContext(ctx context.Context, v url.Values) (*UpdateIsoPermissionsResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "updateIsoPermissions", "updateisopermissionsresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -17,27 +17,47 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/url"
 	"strconv"
 	"strings"
+	"time"
 )
 
 type AddImageStoreParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *AddImageStoreParams) Clone() *AddImageStoreParams {
+	c := &AddImageStoreParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *AddImageStoreParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
 	}
 	if v, found := p.p["details"]; found {
-		i := 0
-		for k, vv := range v.(map[string]string) {
-			u.Set(fmt.Sprintf("details[%d].%s", i, k), vv)
-			i++
+		switch m := v.(type) {
+		case []KeyValuePair:
+			for i, kv := range m {
+				u.Set(fmt.Sprintf("details[%d].key", i), kv.Key)
+				u.Set(fmt.Sprintf("details[%d].value", i), kv.Value)
+			}
+		case map[string]string:
+			for i, k := range getSortedKeysFromMap(m) {
+				u.Set(fmt.Sprintf("details[%d].key", i), k)
+				u.Set(fmt.Sprintf("details[%d].value", i), m[k])
+			}
 		}
 	}
 	if v, found := p.p["name"]; found {
@@ -55,6 +75,16 @@ func (p *AddImageStoreParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *AddImageStoreParams) validate() error {
+	for _, k := range []string{"provider"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of addImageStore", k)
+		}
+	}
+	return nil
+}
+
 func (p *AddImageStoreParams) SetDetails(v map[string]string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -63,6 +93,21 @@ func (p *AddImageStoreParams) SetDetails(v map[string]string) {
 	return
 }
 
+// SetDetailsIfUnset sets details to v, unless it is already set
+func (p *AddImageStoreParams) SetDetailsIfUnset(v map[string]string) {
+	if _, found := p.p["details"]; !found {
+		p.SetDetails(v)
+	}
+}
+
+// SetDetailsKV sets the details as key/value pairs, which are encoded in the given order
+func (p *AddImageStoreParams) SetDetailsKV(v []KeyValuePair) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["details"] = v
+}
+
 func (p *AddImageStoreParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -71,6 +116,13 @@ func (p *AddImageStoreParams) SetName(v string) {
 	return
 }
 
+// SetNameIfUnset sets name to v, unless it is already set
+func (p *AddImageStoreParams) SetNameIfUnset(v string) {
+	if _, found := p.p["name"]; !found {
+		p.SetName(v)
+	}
+}
+
 func (p *AddImageStoreParams) SetProvider(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -79,6 +131,13 @@ func (p *AddImageStoreParams) SetProvider(v string) {
 	return
 }
 
+// SetProviderIfUnset sets provider to v, unless it is already set
+func (p *AddImageStoreParams) SetProviderIfUnset(v string) {
+	if _, found := p.p["provider"]; !found {
+		p.SetProvider(v)
+	}
+}
+
 func (p *AddImageStoreParams) SetUrl(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -87,6 +146,13 @@ func (p *AddImageStoreParams) SetUrl(v string) {
 	return
 }
 
+// SetUrlIfUnset sets url to v, unless it is already set
+func (p *AddImageStoreParams) SetUrlIfUnset(v string) {
+	if _, found := p.p["url"]; !found {
+		p.SetUrl(v)
+	}
+}
+
 func (p *AddImageStoreParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -95,18 +161,54 @@ func (p *AddImageStoreParams) SetZoneid(v string) {
 	return
 }
 
+// SetZoneidIfUnset sets zoneid to v, unless it is already set
+func (p *AddImageStoreParams) SetZoneidIfUnset(v string) {
+	if _, found := p.p["zoneid"]; !found {
+		p.SetZoneid(v)
+	}
+}
+
 // You should always use this function to get a new AddImageStoreParams instance,
-// as then you are sure you have configured all required params
-func (s *ImageStoreService) NewAddImageStoreParams(provider string) *AddImageStoreParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *ImageStoreService) NewAddImageStoreParams(provider string, opts ...func(*AddImageStoreParams)) *AddImageStoreParams {
 	p := &AddImageStoreParams{}
 	p.p = make(map[string]interface{})
 	p.p["provider"] = provider
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Adds backup image store.
-func (s *ImageStoreService) AddImageStore(p *AddImageStoreParams) (*AddImageStoreResponse, error) {
-	resp, err := s.cs.newRequest("addImageStore", p.toURLValues())
+func (s *ImageStoreService) AddImageStore(p *AddImageStoreParams, opts ...OptionFunc) (*AddImageStoreResponse, error) {
+	return s.AddImageStoreWithContext(context.Background(), p, opts...)
+}
+
+// AddImageStoreWithContext is the same as AddImageStore, but the request is cancelled when the context is done
+func (s *ImageStoreService) AddImageStoreWithContext(ctx context.Context, p *AddImageStoreParams, opts ...OptionFunc) (*AddImageStoreResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.AddImageStoreRawWithContext(ctx, p.toURLValues())
+}
+
+// AddImageStoreRaw is the same as AddImageStore, but takes the params as url.Values instead of typed params
+func (s *ImageStoreService) AddImageStoreRaw(v url.Values) (*AddImageStoreResponse, error) {
+	return s.AddImageStoreRawWithContext(context.Background(), v)
+}
+
+// AddImageStoreRawWithContext is the same as AddImageStoreRaw, but the request is cancelled when the context is done
+func (s *ImageStoreService) AddImageStoreRawWithContext(ctx context.Context, v url.Values) (*AddImageStoreResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "addImageStore", "addimagestoreresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -135,6 +237,16 @@ type AddImageStoreS3Params struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *AddImageStoreS3Params) Clone() *AddImageStoreS3Params {
+	c := &AddImageStoreS3Params{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *AddImageStoreS3Params) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -182,6 +294,16 @@ func (p *AddImageStoreS3Params) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *AddImageStoreS3Params) validate() error {
+	for _, k := range []string{"accesskey", "bucket", "endpoint", "secretkey"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of addImageStoreS3", k)
+		}
+	}
+	return nil
+}
+
 func (p *AddImageStoreS3Params) SetAccesskey(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -190,6 +312,13 @@ func (p *AddImageStoreS3Params) SetAccesskey(v string) {
 	return
 }
 
+// SetAccesskeyIfUnset sets accesskey to v, unless it is already set
+func (p *AddImageStoreS3Params) SetAccesskeyIfUnset(v string) {
+	if _, found := p.p["accesskey"]; !found {
+		p.SetAccesskey(v)
+	}
+}
+
 func (p *AddImageStoreS3Params) SetBucket(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -198,6 +327,13 @@ func (p *AddImageStoreS3Params) SetBucket(v string) {
 	return
 }
 
+// SetBucketIfUnset sets bucket to v, unless it is already set
+func (p *AddImageStoreS3Params) SetBucketIfUnset(v string) {
+	if _, found := p.p["bucket"]; !found {
+		p.SetBucket(v)
+	}
+}
+
 func (p *AddImageStoreS3Params) SetConnectiontimeout(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -206,6 +342,13 @@ func (p *AddImageStoreS3Params) SetConnectiontimeout(v int) {
 	return
 }
 
+// SetConnectiontimeoutIfUnset sets connectiontimeout to v, unless it is already set
+func (p *AddImageStoreS3Params) SetConnectiontimeoutIfUnset(v int) {
+	if _, found := p.p["connectiontimeout"]; !found {
+		p.SetConnectiontimeout(v)
+	}
+}
+
 func (p *AddImageStoreS3Params) SetConnectionttl(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -214,6 +357,13 @@ func (p *AddImageStoreS3Params) SetConnectionttl(v int) {
 	return
 }
 
+// SetConnectionttlIfUnset sets connectionttl to v, unless it is already set
+func (p *AddImageStoreS3Params) SetConnectionttlIfUnset(v int) {
+	if _, found := p.p["connectionttl"]; !found {
+		p.SetConnectionttl(v)
+	}
+}
+
 func (p *AddImageStoreS3Params) SetEndpoint(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -222,6 +372,13 @@ func (p *AddImageStoreS3Params) SetEndpoint(v string) {
 	return
 }
 
+// SetEndpointIfUnset sets endpoint to v, unless it is already set
+func (p *AddImageStoreS3Params) SetEndpointIfUnset(v string) {
+	if _, found := p.p["endpoint"]; !found {
+		p.SetEndpoint(v)
+	}
+}
+
 func (p *AddImageStoreS3Params) SetMaxerrorretry(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -230,6 +387,13 @@ func (p *AddImageStoreS3Params) SetMaxerrorretry(v int) {
 	return
 }
 
+// SetMaxerrorretryIfUnset sets maxerrorretry to v, unless it is already set
+func (p *AddImageStoreS3Params) SetMaxerrorretryIfUnset(v int) {
+	if _, found := p.p["maxerrorretry"]; !found {
+		p.SetMaxerrorretry(v)
+	}
+}
+
 func (p *AddImageStoreS3Params) SetS3signer(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -238,6 +402,13 @@ func (p *AddImageStoreS3Params) SetS3signer(v string) {
 	return
 }
 
+// SetS3signerIfUnset sets s3signer to v, unless it is already set
+func (p *AddImageStoreS3Params) SetS3signerIfUnset(v string) {
+	if _, found := p.p["s3signer"]; !found {
+		p.SetS3signer(v)
+	}
+}
+
 func (p *AddImageStoreS3Params) SetSecretkey(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -246,6 +417,13 @@ func (p *AddImageStoreS3Params) SetSecretkey(v string) {
 	return
 }
 
+// SetSecretkeyIfUnset sets secretkey to v, unless it is already set
+func (p *AddImageStoreS3Params) SetSecretkeyIfUnset(v string) {
+	if _, found := p.p["secretkey"]; !found {
+		p.SetSecretkey(v)
+	}
+}
+
 func (p *AddImageStoreS3Params) SetSockettimeout(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -254,6 +432,13 @@ func (p *AddImageStoreS3Params) SetSockettimeout(v int) {
 	return
 }
 
+// SetSockettimeoutIfUnset sets sockettimeout to v, unless it is already set
+func (p *AddImageStoreS3Params) SetSockettimeoutIfUnset(v int) {
+	if _, found := p.p["sockettimeout"]; !found {
+		p.SetSockettimeout(v)
+	}
+}
+
 func (p *AddImageStoreS3Params) SetUsehttps(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -262,6 +447,13 @@ func (p *AddImageStoreS3Params) SetUsehttps(v bool) {
 	return
 }
 
+// SetUsehttpsIfUnset sets usehttps to v, unless it is already set
+func (p *AddImageStoreS3Params) SetUsehttpsIfUnset(v bool) {
+	if _, found := p.p["usehttps"]; !found {
+		p.SetUsehttps(v)
+	}
+}
+
 func (p *AddImageStoreS3Params) SetUsetcpkeepalive(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -270,21 +462,101 @@ func (p *AddImageStoreS3Params) SetUsetcpkeepalive(v bool) {
 	return
 }
 
+// SetUsetcpkeepaliveIfUnset sets usetcpkeepalive to v, unless it is already set
+func (p *AddImageStoreS3Params) SetUsetcpkeepaliveIfUnset(v bool) {
+	if _, found := p.p["usetcpkeepalive"]; !found {
+		p.SetUsetcpkeepalive(v)
+	}
+}
+
 // You should always use this function to get a new AddImageStoreS3Params instance,
-// as then you are sure you have configured all required params
-func (s *ImageStoreService) NewAddImageStoreS3Params(accesskey string, bucket string, endpoint string, secretkey string) *AddImageStoreS3Params {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *ImageStoreService) NewAddImageStoreS3Params(accesskey string, bucket string, endpoint string, secretkey string, opts ...func(*AddImageStoreS3Params)) *AddImageStoreS3Params {
 	p := &AddImageStoreS3Params{}
 	p.p = make(map[string]interface{})
 	p.p["accesskey"] = accesskey
 	p.p["bucket"] = bucket
 	p.p["endpoint"] = endpoint
 	p.p["secretkey"] = secretkey
+	for _, fn := range opts {
+		fn(p)
+	}
+	return p
+}
+
+// AddImageStoreS3Opts contains all params that can be set on a AddImageStoreS3Params.
+// Optional params are pointers (or slices and maps) which are only set when not nil.
+type AddImageStoreS3Opts struct {
+	Accesskey         string
+	Bucket            string
+	Connectiontimeout *int
+	Connectionttl     *int
+	Endpoint          string
+	Maxerrorretry     *int
+	S3signer          *string
+	Secretkey         string
+	Sockettimeout     *int
+	Usehttps          *bool
+	Usetcpkeepalive   *bool
+}
+
+// NewAddImageStoreS3ParamsFromOpts is an alternative for NewAddImageStoreS3Params which takes all params using
+// an options struct, as that is easier to read for APIs with many required params
+func (s *ImageStoreService) NewAddImageStoreS3ParamsFromOpts(o AddImageStoreS3Opts) *AddImageStoreS3Params {
+	p := s.NewAddImageStoreS3Params(o.Accesskey, o.Bucket, o.Endpoint, o.Secretkey)
+	if o.Connectiontimeout != nil {
+		p.p["connectiontimeout"] = *o.Connectiontimeout
+	}
+	if o.Connectionttl != nil {
+		p.p["connectionttl"] = *o.Connectionttl
+	}
+	if o.Maxerrorretry != nil {
+		p.p["maxerrorretry"] = *o.Maxerrorretry
+	}
+	if o.S3signer != nil {
+		p.p["s3signer"] = *o.S3signer
+	}
+	if o.Sockettimeout != nil {
+		p.p["sockettimeout"] = *o.Sockettimeout
+	}
+	if o.Usehttps != nil {
+		p.p["usehttps"] = *o.Usehttps
+	}
+	if o.Usetcpkeepalive != nil {
+		p.p["usetcpkeepalive"] = *o.Usetcpkeepalive
+	}
 	return p
 }
 
 // Adds S3 Image Store
-func (s *ImageStoreService) AddImageStoreS3(p *AddImageStoreS3Params) (*AddImageStoreS3Response, error) {
-	resp, err := s.cs.newRequest("addImageStoreS3", p.toURLValues())
+func (s *ImageStoreService) AddImageStoreS3(p *AddImageStoreS3Params, opts ...OptionFunc) (*AddImageStoreS3Response, error) {
+	return s.AddImageStoreS3WithContext(context.Background(), p, opts...)
+}
+
+// AddImageStoreS3WithContext is the same as AddImageStoreS3, but the request is cancelled when the context is done
+func (s *ImageStoreService) AddImageStoreS3WithContext(ctx context.Context, p *AddImageStoreS3Params, opts ...OptionFunc) (*AddImageStoreS3Response, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.AddImageStoreS3RawWithContext(ctx, p.toURLValues())
+}
+
+// AddImageStoreS3Raw is the same as AddImageStoreS3, but takes the params as url.Values instead of typed params
+func (s *ImageStoreService) AddImageStoreS3Raw(v url.Values) (*AddImageStoreS3Response, error) {
+	return s.AddImageStoreS3RawWithContext(context.Background(), v)
+}
+
+// AddImageStoreS3RawWithContext is the same as AddImageStoreS3Raw, but the request is cancelled when the context is done
+func (s *ImageStoreService) AddImageStoreS3RawWithContext(ctx context.Context, v url.Values) (*AddImageStoreS3Response, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "addImageStoreS3", "addimagestores3response", v)
 	if err != nil {
 		return nil, err
 	}
@@ -313,16 +585,33 @@ type CreateSecondaryStagingStoreParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *CreateSecondaryStagingStoreParams) Clone() *CreateSecondaryStagingStoreParams {
+	c := &CreateSecondaryStagingStoreParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *CreateSecondaryStagingStoreParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
 	}
 	if v, found := p.p["details"]; found {
-		i := 0
-		for k, vv := range v.(map[string]string) {
-			u.Set(fmt.Sprintf("details[%d].%s", i, k), vv)
-			i++
+		switch m := v.(type) {
+		case []KeyValuePair:
+			for i, kv := range m {
+				u.Set(fmt.Sprintf("details[%d].key", i), kv.Key)
+				u.Set(fmt.Sprintf("details[%d].value", i), kv.Value)
+			}
+		case map[string]string:
+			for i, k := range getSortedKeysFromMap(m) {
+				u.Set(fmt.Sprintf("details[%d].key", i), k)
+				u.Set(fmt.Sprintf("details[%d].value", i), m[k])
+			}
 		}
 	}
 	if v, found := p.p["provider"]; found {
@@ -340,6 +629,16 @@ func (p *CreateSecondaryStagingStoreParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *CreateSecondaryStagingStoreParams) validate() error {
+	for _, k := range []string{"url"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of createSecondaryStagingStore", k)
+		}
+	}
+	return nil
+}
+
 func (p *CreateSecondaryStagingStoreParams) SetDetails(v map[string]string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -348,6 +647,21 @@ func (p *CreateSecondaryStagingStoreParams) SetDetails(v map[string]string) {
 	return
 }
 
+// SetDetailsIfUnset sets details to v, unless it is already set
+func (p *CreateSecondaryStagingStoreParams) SetDetailsIfUnset(v map[string]string) {
+	if _, found := p.p["details"]; !found {
+		p.SetDetails(v)
+	}
+}
+
+// SetDetailsKV sets the details as key/value pairs, which are encoded in the given order
+func (p *CreateSecondaryStagingStoreParams) SetDetailsKV(v []KeyValuePair) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["details"] = v
+}
+
 func (p *CreateSecondaryStagingStoreParams) SetProvider(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -356,6 +670,13 @@ func (p *CreateSecondaryStagingStoreParams) SetProvider(v string) {
 	return
 }
 
+// SetProviderIfUnset sets provider to v, unless it is already set
+func (p *CreateSecondaryStagingStoreParams) SetProviderIfUnset(v string) {
+	if _, found := p.p["provider"]; !found {
+		p.SetProvider(v)
+	}
+}
+
 func (p *CreateSecondaryStagingStoreParams) SetScope(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -364,6 +685,13 @@ func (p *CreateSecondaryStagingStoreParams) SetScope(v string) {
 	return
 }
 
+// SetScopeIfUnset sets scope to v, unless it is already set
+func (p *CreateSecondaryStagingStoreParams) SetScopeIfUnset(v string) {
+	if _, found := p.p["scope"]; !found {
+		p.SetScope(v)
+	}
+}
+
 func (p *CreateSecondaryStagingStoreParams) SetUrl(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -372,6 +700,13 @@ func (p *CreateSecondaryStagingStoreParams) SetUrl(v string) {
 	return
 }
 
+// SetUrlIfUnset sets url to v, unless it is already set
+func (p *CreateSecondaryStagingStoreParams) SetUrlIfUnset(v string) {
+	if _, found := p.p["url"]; !found {
+		p.SetUrl(v)
+	}
+}
+
 func (p *CreateSecondaryStagingStoreParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -380,18 +715,54 @@ func (p *CreateSecondaryStagingStoreParams) SetZoneid(v string) {
 	return
 }
 
+// SetZoneidIfUnset sets zoneid to v, unless it is already set
+func (p *CreateSecondaryStagingStoreParams) SetZoneidIfUnset(v string) {
+	if _, found := p.p["zoneid"]; !found {
+		p.SetZoneid(v)
+	}
+}
+
 // You should always use this function to get a new CreateSecondaryStagingStoreParams instance,
-// as then you are sure you have configured all required params
-func (s *ImageStoreService) NewCreateSecondaryStagingStoreParams(url string) *CreateSecondaryStagingStoreParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *ImageStoreService) NewCreateSecondaryStagingStoreParams(url string, opts ...func(*CreateSecondaryStagingStoreParams)) *CreateSecondaryStagingStoreParams {
 	p := &CreateSecondaryStagingStoreParams{}
 	p.p = make(map[string]interface{})
 	p.p["url"] = url
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // create secondary staging store.
-func (s *ImageStoreService) CreateSecondaryStagingStore(p *CreateSecondaryStagingStoreParams) (*CreateSecondaryStagingStoreResponse, error) {
-	resp, err := s.cs.newRequest("createSecondaryStagingStore", p.toURLValues())
+func (s *ImageStoreService) CreateSecondaryStagingStore(p *CreateSecondaryStagingStoreParams, opts ...OptionFunc) (*CreateSecondaryStagingStoreResponse, error) {
+	return s.CreateSecondaryStagingStoreWithContext(context.Background(), p, opts...)
+}
+
+// CreateSecondaryStagingStoreWithContext is the same as CreateSecondaryStagingStore, but the request is cancelled when the context is done
+func (s *ImageStoreService) CreateSecondaryStagingStoreWithContext(ctx context.Context, p *CreateSecondaryStagingStoreParams, opts ...OptionFunc) (*CreateSecondaryStagingStoreResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.CreateSecondaryStagingStoreRawWithContext(ctx, p.toURLValues())
+}
+
+// CreateSecondaryStagingStoreRaw is the same as CreateSecondaryStagingStore, but takes the params as url.Values instead of typed params
+func (s *ImageStoreService) CreateSecondaryStagingStoreRaw(v url.Values) (*CreateSecondaryStagingStoreResponse, error) {
+	return s.CreateSecondaryStagingStoreRawWithContext(context.Background(), v)
+}
+
+// CreateSecondaryStagingStoreRawWithContext is the same as CreateSecondaryStagingStoreRaw, but the request is cancelled when the context is done
+func (s *ImageStoreService) CreateSecondaryStagingStoreRawWithContext(ctx context.Context, v url.Values) (*CreateSecondaryStagingStoreResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "createSecondaryStagingStore", "createsecondarystagingstoreresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -420,6 +791,16 @@ type DeleteImageStoreParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *DeleteImageStoreParams) Clone() *DeleteImageStoreParams {
+	c := &DeleteImageStoreParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeleteImageStoreParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -431,6 +812,16 @@ func (p *DeleteImageStoreParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *DeleteImageStoreParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of deleteImageStore", k)
+		}
+	}
+	return nil
+}
+
 func (p *DeleteImageStoreParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -439,18 +830,54 @@ func (p *DeleteImageStoreParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *DeleteImageStoreParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 // You should always use this function to get a new DeleteImageStoreParams instance,
-// as then you are sure you have configured all required params
-func (s *ImageStoreService) NewDeleteImageStoreParams(id string) *DeleteImageStoreParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *ImageStoreService) NewDeleteImageStoreParams(id string, opts ...func(*DeleteImageStoreParams)) *DeleteImageStoreParams {
 	p := &DeleteImageStoreParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Deletes an image store or Secondary Storage.
-func (s *ImageStoreService) DeleteImageStore(p *DeleteImageStoreParams) (*DeleteImageStoreResponse, error) {
-	resp, err := s.cs.newRequest("deleteImageStore", p.toURLValues())
+func (s *ImageStoreService) DeleteImageStore(p *DeleteImageStoreParams, opts ...OptionFunc) (*DeleteImageStoreResponse, error) {
+	return s.DeleteImageStoreWithContext(context.Background(), p, opts...)
+}
+
+// DeleteImageStoreWithContext is the same as DeleteImageStore, but the request is cancelled when the context is done
+func (s *ImageStoreService) DeleteImageStoreWithContext(ctx context.Context, p *DeleteImageStoreParams, opts ...OptionFunc) (*DeleteImageStoreResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.DeleteImageStoreRawWithContext(ctx, p.toURLValues())
+}
+
+// DeleteImageStoreRaw is the same as DeleteImageStore, but takes the params as url.Values instead of typed params
+func (s *ImageStoreService) DeleteImageStoreRaw(v url.Values) (*DeleteImageStoreResponse, error) {
+	return s.DeleteImageStoreRawWithContext(context.Background(), v)
+}
+
+// DeleteImageStoreRawWithContext is the same as DeleteImageStoreRaw, but the request is cancelled when the context is done
+func (s *ImageStoreService) DeleteImageStoreRawWithContext(ctx context.Context, v url.Values) (*DeleteImageStoreResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteImageStore", "deleteimagestoreresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -463,6 +890,34 @@ func (s *ImageStoreService) DeleteImageStore(p *DeleteImageStoreParams) (*Delete
 	return &r, nil
 }
 
+// WaitForImageStoreDeleted polls until the ImageStore with the given ID no longer exists, the timeout
+// expires or the context is cancelled. Deleted resources may still be listed for a short while
+// after the delete command finished, so this can be used to make sure they are really gone. The
+// options are passed to GetImageStoreByID, so a resource outside of the default scope can be found.
+func (s *ImageStoreService) WaitForImageStoreDeleted(ctx context.Context, id string, timeout time.Duration, opts ...OptionFunc) error {
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	for {
+		_, count, err := s.GetImageStoreByID(id, opts...)
+		if count == 0 {
+			return nil
+		}
+		if count < 0 {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(2 * time.Second):
+		}
+	}
+}
+
 type DeleteImageStoreResponse struct {
 	Displaytext string `json:"displaytext"`
 	Success     bool   `json:"success"`
@@ -491,6 +946,16 @@ type DeleteSecondaryStagingStoreParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *DeleteSecondaryStagingStoreParams) Clone() *DeleteSecondaryStagingStoreParams {
+	c := &DeleteSecondaryStagingStoreParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeleteSecondaryStagingStoreParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -502,6 +967,16 @@ func (p *DeleteSecondaryStagingStoreParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *DeleteSecondaryStagingStoreParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of deleteSecondaryStagingStore", k)
+		}
+	}
+	return nil
+}
+
 func (p *DeleteSecondaryStagingStoreParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -510,18 +985,54 @@ func (p *DeleteSecondaryStagingStoreParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *DeleteSecondaryStagingStoreParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 // You should always use this function to get a new DeleteSecondaryStagingStoreParams instance,
-// as then you are sure you have configured all required params
-func (s *ImageStoreService) NewDeleteSecondaryStagingStoreParams(id string) *DeleteSecondaryStagingStoreParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *ImageStoreService) NewDeleteSecondaryStagingStoreParams(id string, opts ...func(*DeleteSecondaryStagingStoreParams)) *DeleteSecondaryStagingStoreParams {
 	p := &DeleteSecondaryStagingStoreParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Deletes a secondary staging store .
-func (s *ImageStoreService) DeleteSecondaryStagingStore(p *DeleteSecondaryStagingStoreParams) (*DeleteSecondaryStagingStoreResponse, error) {
-	resp, err := s.cs.newRequest("deleteSecondaryStagingStore", p.toURLValues())
+func (s *ImageStoreService) DeleteSecondaryStagingStore(p *DeleteSecondaryStagingStoreParams, opts ...OptionFunc) (*DeleteSecondaryStagingStoreResponse, error) {
+	return s.DeleteSecondaryStagingStoreWithContext(context.Background(), p, opts...)
+}
+
+// DeleteSecondaryStagingStoreWithContext is the same as DeleteSecondaryStagingStore, but the request is cancelled when the context is done
+func (s *ImageStoreService) DeleteSecondaryStagingStoreWithContext(ctx context.Context, p *DeleteSecondaryStagingStoreParams, opts ...OptionFunc) (*DeleteSecondaryStagingStoreResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.DeleteSecondaryStagingStoreRawWithContext(ctx, p.toURLValues())
+}
+
+// DeleteSecondaryStagingStoreRaw is the same as DeleteSecondaryStagingStore, but takes the params as url.Values instead of typed params
+func (s *ImageStoreService) DeleteSecondaryStagingStoreRaw(v url.Values) (*DeleteSecondaryStagingStoreResponse, error) {
+	return s.DeleteSecondaryStagingStoreRawWithContext(context.Background(), v)
+}
+
+// DeleteSecondaryStagingStoreRawWithContext is the same as DeleteSecondaryStagingStoreRaw, but the request is cancelled when the context is done
+func (s *ImageStoreService) DeleteSecondaryStagingStoreRawWithContext(ctx context.Context, v url.Values) (*DeleteSecondaryStagingStoreResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteSecondaryStagingStore", "deletesecondarystagingstoreresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -534,6 +1045,34 @@ func (s *ImageStoreService) DeleteSecondaryStagingStore(p *DeleteSecondaryStagin
 	return &r, nil
 }
 
+// WaitForSecondaryStagingStoreDeleted polls until the SecondaryStagingStore with the given ID no longer exists, the timeout
+// expires or the context is cancelled. Deleted resources may still be listed for a short while
+// after the delete command finished, so this can be used to make sure they are really gone. The
+// options are passed to GetSecondaryStagingStoreByID, so a resource outside of the default scope can be found.
+func (s *ImageStoreService) WaitForSecondaryStagingStoreDeleted(ctx context.Context, id string, timeout time.Duration, opts ...OptionFunc) error {
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	for {
+		_, count, err := s.GetSecondaryStagingStoreByID(id, opts...)
+		if count == 0 {
+			return nil
+		}
+		if count < 0 {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(2 * time.Second):
+		}
+	}
+}
+
 type DeleteSecondaryStagingStoreResponse struct {
 	Displaytext string `json:"displaytext"`
 	Success     bool   `json:"success"`
@@ -562,6 +1101,16 @@ type ListImageStoresParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ListImageStoresParams) Clone() *ListImageStoresParams {
+	c := &ListImageStoresParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListImageStoresParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -604,6 +1153,13 @@ func (p *ListImageStoresParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *ListImageStoresParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 func (p *ListImageStoresParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -612,6 +1168,13 @@ func (p *ListImageStoresParams) SetKeyword(v string) {
 	return
 }
 
+// SetKeywordIfUnset sets keyword to v, unless it is already set
+func (p *ListImageStoresParams) SetKeywordIfUnset(v string) {
+	if _, found := p.p["keyword"]; !found {
+		p.SetKeyword(v)
+	}
+}
+
 func (p *ListImageStoresParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -620,6 +1183,13 @@ func (p *ListImageStoresParams) SetName(v string) {
 	return
 }
 
+// SetNameIfUnset sets name to v, unless it is already set
+func (p *ListImageStoresParams) SetNameIfUnset(v string) {
+	if _, found := p.p["name"]; !found {
+		p.SetName(v)
+	}
+}
+
 func (p *ListImageStoresParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -628,6 +1198,13 @@ func (p *ListImageStoresParams) SetPage(v int) {
 	return
 }
 
+// SetPageIfUnset sets page to v, unless it is already set
+func (p *ListImageStoresParams) SetPageIfUnset(v int) {
+	if _, found := p.p["page"]; !found {
+		p.SetPage(v)
+	}
+}
+
 func (p *ListImageStoresParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -636,6 +1213,13 @@ func (p *ListImageStoresParams) SetPagesize(v int) {
 	return
 }
 
+// SetPagesizeIfUnset sets pagesize to v, unless it is already set
+func (p *ListImageStoresParams) SetPagesizeIfUnset(v int) {
+	if _, found := p.p["pagesize"]; !found {
+		p.SetPagesize(v)
+	}
+}
+
 func (p *ListImageStoresParams) SetProtocol(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -644,6 +1228,13 @@ func (p *ListImageStoresParams) SetProtocol(v string) {
 	return
 }
 
+// SetProtocolIfUnset sets protocol to v, unless it is already set
+func (p *ListImageStoresParams) SetProtocolIfUnset(v string) {
+	if _, found := p.p["protocol"]; !found {
+		p.SetProtocol(v)
+	}
+}
+
 func (p *ListImageStoresParams) SetProvider(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -652,6 +1243,13 @@ func (p *ListImageStoresParams) SetProvider(v string) {
 	return
 }
 
+// SetProviderIfUnset sets provider to v, unless it is already set
+func (p *ListImageStoresParams) SetProviderIfUnset(v string) {
+	if _, found := p.p["provider"]; !found {
+		p.SetProvider(v)
+	}
+}
+
 func (p *ListImageStoresParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -660,14 +1258,32 @@ func (p *ListImageStoresParams) SetZoneid(v string) {
 	return
 }
 
+// SetZoneidIfUnset sets zoneid to v, unless it is already set
+func (p *ListImageStoresParams) SetZoneidIfUnset(v string) {
+	if _, found := p.p["zoneid"]; !found {
+		p.SetZoneid(v)
+	}
+}
+
 // You should always use this function to get a new ListImageStoresParams instance,
-// as then you are sure you have configured all required params
-func (s *ImageStoreService) NewListImageStoresParams() *ListImageStoresParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *ImageStoreService) NewListImageStoresParams(opts ...func(*ListImageStoresParams)) *ListImageStoresParams {
 	p := &ListImageStoresParams{}
 	p.p = make(map[string]interface{})
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
+func (p *ListImageStoresParams) setFirstMatch() {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p[firstMatchKey] = true
+}
+
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *ImageStoreService) GetImageStoreID(name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListImageStoresParams{}
@@ -675,7 +1291,7 @@ func (s *ImageStoreService) GetImageStoreID(name string, opts ...OptionFunc) (st
 
 	p.p["name"] = name
 
-	for _, fn := range append(s.cs.options, opts...) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
 		if err := fn(s.cs, p); err != nil {
 			return "", -1, err
 		}
@@ -687,7 +1303,7 @@ func (s *ImageStoreService) GetImageStoreID(name string, opts ...OptionFunc) (st
 	}
 
 	if l.Count == 0 {
-		return "", l.Count, fmt.Errorf("No match found for %s: %+v", name, l)
+		return "", l.Count, fmt.Errorf("%w for %s: %+v", ErrNotFound, name, l)
 	}
 
 	if l.Count == 1 {
@@ -700,6 +1316,10 @@ func (s *ImageStoreService) GetImageStoreID(name string, opts ...OptionFunc) (st
 				return v.Id, l.Count, nil
 			}
 		}
+
+		if _, ok := p.p[firstMatchKey]; ok && len(l.ImageStores) > 0 {
+			return l.ImageStores[0].Id, l.Count, nil
+		}
 	}
 	return "", l.Count, fmt.Errorf("Could not find an exact match for %s: %+v", name, l)
 }
@@ -725,7 +1345,7 @@ func (s *ImageStoreService) GetImageStoreByID(id string, opts ...OptionFunc) (*I
 
 	p.p["id"] = id
 
-	for _, fn := range append(s.cs.options, opts...) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
 		if err := fn(s.cs, p); err != nil {
 			return nil, -1, err
 		}
@@ -736,24 +1356,56 @@ func (s *ImageStoreService) GetImageStoreByID(id string, opts ...OptionFunc) (*I
 		if strings.Contains(err.Error(), fmt.Sprintf(
 			"Invalid parameter id value=%s due to incorrect long value format, "+
 				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+			return nil, 0, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
 	}
 
 	if l.Count == 1 {
 		return l.ImageStores[0], l.Count, nil
 	}
+
+	if _, ok := p.p[firstMatchKey]; ok && len(l.ImageStores) > 0 {
+		return l.ImageStores[0], l.Count, nil
+	}
 	return nil, l.Count, fmt.Errorf("There is more then one result for ImageStore UUID: %s!", id)
 }
 
+// ImageStoreExists returns true if a ImageStore with the given ID exists. When no match is found
+// it returns false, while any other error is returned as is.
+func (s *ImageStoreService) ImageStoreExists(id string, opts ...OptionFunc) (bool, error) {
+	_, count, err := s.GetImageStoreByID(id, opts...)
+	if errors.Is(err, ErrNotFound) {
+		return false, nil
+	}
+	if count > 0 {
+		return true, nil
+	}
+	return false, err
+}
+
 // Lists image stores.
 func (s *ImageStoreService) ListImageStores(p *ListImageStoresParams) (*ListImageStoresResponse, error) {
-	resp, err := s.cs.newRequest("listImageStores", p.toURLValues())
+	return s.ListImageStoresWithContext(context.Background(), p)
+}
+
+// ListImageStoresWithContext is the same as ListImageStores, but the request is cancelled when the context is done
+func (s *ImageStoreService) ListImageStoresWithContext(ctx context.Context, p *ListImageStoresParams) (*ListImageStoresResponse, error) {
+	return s.ListImageStoresRawWithContext(ctx, p.toURLValues())
+}
+
+// ListImageStoresRaw is the same as ListImageStores, but takes the params as url.Values instead of typed params
+func (s *ImageStoreService) ListImageStoresRaw(v url.Values) (*ListImageStoresResponse, error) {
+	return s.ListImageStoresRawWithContext(context.Background(), v)
+}
+
+// ListImageStoresRawWithContext is the same as ListImageStoresRaw, but the request is cancelled when the context is done
+func (s *ImageStoreService) ListImageStoresRawWithContext(ctx context.Context, v url.Values) (*ListImageStoresResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "listImageStores", "listimagestoresresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -766,6 +1418,93 @@ func (s *ImageStoreService) ListImageStores(p *ListImageStoresParams) (*ListImag
 	return &r, nil
 }
 
+// StreamImageStores fetches the results of ListImageStores page by page in the background and sends them on the
+// returned channel. Both returned channels are closed when done. If fetching a page fails, or the context
+// is done before all results are sent, the error is sent on the error channel and fetching stops. A
+// request for a page in flight is cancelled when the context is done. The page and pagesize set in the
+// params are ignored.
+func (s *ImageStoreService) StreamImageStores(ctx context.Context, p *ListImageStoresParams) (<-chan *ImageStore, <-chan error) {
+	ch := make(chan *ImageStore)
+	errs := make(chan error, 1)
+
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesize(500)
+
+	go func() {
+		defer close(errs)
+		defer close(ch)
+
+		for page, count := 1, 0; ; page++ {
+			pp.SetPage(page)
+
+			l, err := s.ListImageStoresWithContext(ctx, pp)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			for _, v := range l.ImageStores {
+				select {
+				case ch <- v:
+				case <-ctx.Done():
+					errs <- ctx.Err()
+					return
+				}
+			}
+
+			count += len(l.ImageStores)
+			if len(l.ImageStores) < 500 || count >= l.Count {
+				return
+			}
+		}
+	}()
+
+	return ch, errs
+}
+
+// ListImageStoresWhere returns all results of ListImageStores for which pred returns true. Note that the results are
+// filtered client-side, so all results matching the params are fetched first.
+// All pages are fetched, so the page and pagesize set in the params are ignored.
+func (s *ImageStoreService) ListImageStoresWhere(p *ListImageStoresParams, pred func(*ImageStore) bool) ([]*ImageStore, error) {
+	var r []*ImageStore
+	ch, errs := s.StreamImageStores(context.Background(), p)
+	for v := range ch {
+		if pred(v) {
+			r = append(r, v)
+		}
+	}
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ListAllImageStores returns the results of ListImageStores across all pages, by calling ListImageStores page by page until all
+// results are fetched. The pagesize set in the params is used for every page (500 if not set), and the
+// page set in the params is ignored.
+func (s *ImageStoreService) ListAllImageStores(p *ListImageStoresParams) ([]*ImageStore, error) {
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesizeIfUnset(500)
+	pagesize := pp.p["pagesize"].(int)
+
+	var r []*ImageStore
+	for page := 1; ; page++ {
+		pp.SetPage(page)
+
+		l, err := s.ListImageStores(pp)
+		if err != nil {
+			return nil, err
+		}
+		r = append(r, l.ImageStores...)
+
+		if len(l.ImageStores) < pagesize || len(r) >= l.Count {
+			return r, nil
+		}
+	}
+}
+
 type ListImageStoresResponse struct {
 	Count       int           `json:"count"`
 	ImageStores []*ImageStore `json:"imagestore"`
@@ -787,6 +1526,16 @@ type ListSecondaryStagingStoresParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ListSecondaryStagingStoresParams) Clone() *ListSecondaryStagingStoresParams {
+	c := &ListSecondaryStagingStoresParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListSecondaryStagingStoresParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -829,6 +1578,13 @@ func (p *ListSecondaryStagingStoresParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *ListSecondaryStagingStoresParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 func (p *ListSecondaryStagingStoresParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -837,6 +1593,13 @@ func (p *ListSecondaryStagingStoresParams) SetKeyword(v string) {
 	return
 }
 
+// SetKeywordIfUnset sets keyword to v, unless it is already set
+func (p *ListSecondaryStagingStoresParams) SetKeywordIfUnset(v string) {
+	if _, found := p.p["keyword"]; !found {
+		p.SetKeyword(v)
+	}
+}
+
 func (p *ListSecondaryStagingStoresParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -845,6 +1608,13 @@ func (p *ListSecondaryStagingStoresParams) SetName(v string) {
 	return
 }
 
+// SetNameIfUnset sets name to v, unless it is already set
+func (p *ListSecondaryStagingStoresParams) SetNameIfUnset(v string) {
+	if _, found := p.p["name"]; !found {
+		p.SetName(v)
+	}
+}
+
 func (p *ListSecondaryStagingStoresParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -853,6 +1623,13 @@ func (p *ListSecondaryStagingStoresParams) SetPage(v int) {
 	return
 }
 
+// SetPageIfUnset sets page to v, unless it is already set
+func (p *ListSecondaryStagingStoresParams) SetPageIfUnset(v int) {
+	if _, found := p.p["page"]; !found {
+		p.SetPage(v)
+	}
+}
+
 func (p *ListSecondaryStagingStoresParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -861,6 +1638,13 @@ func (p *ListSecondaryStagingStoresParams) SetPagesize(v int) {
 	return
 }
 
+// SetPagesizeIfUnset sets pagesize to v, unless it is already set
+func (p *ListSecondaryStagingStoresParams) SetPagesizeIfUnset(v int) {
+	if _, found := p.p["pagesize"]; !found {
+		p.SetPagesize(v)
+	}
+}
+
 func (p *ListSecondaryStagingStoresParams) SetProtocol(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -869,6 +1653,13 @@ func (p *ListSecondaryStagingStoresParams) SetProtocol(v string) {
 	return
 }
 
+// SetProtocolIfUnset sets protocol to v, unless it is already set
+func (p *ListSecondaryStagingStoresParams) SetProtocolIfUnset(v string) {
+	if _, found := p.p["protocol"]; !found {
+		p.SetProtocol(v)
+	}
+}
+
 func (p *ListSecondaryStagingStoresParams) SetProvider(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -877,6 +1668,13 @@ func (p *ListSecondaryStagingStoresParams) SetProvider(v string) {
 	return
 }
 
+// SetProviderIfUnset sets provider to v, unless it is already set
+func (p *ListSecondaryStagingStoresParams) SetProviderIfUnset(v string) {
+	if _, found := p.p["provider"]; !found {
+		p.SetProvider(v)
+	}
+}
+
 func (p *ListSecondaryStagingStoresParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -885,14 +1683,32 @@ func (p *ListSecondaryStagingStoresParams) SetZoneid(v string) {
 	return
 }
 
+// SetZoneidIfUnset sets zoneid to v, unless it is already set
+func (p *ListSecondaryStagingStoresParams) SetZoneidIfUnset(v string) {
+	if _, found := p.p["zoneid"]; !found {
+		p.SetZoneid(v)
+	}
+}
+
 // You should always use this function to get a new ListSecondaryStagingStoresParams instance,
-// as then you are sure you have configured all required params
-func (s *ImageStoreService) NewListSecondaryStagingStoresParams() *ListSecondaryStagingStoresParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *ImageStoreService) NewListSecondaryStagingStoresParams(opts ...func(*ListSecondaryStagingStoresParams)) *ListSecondaryStagingStoresParams {
 	p := &ListSecondaryStagingStoresParams{}
 	p.p = make(map[string]interface{})
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
+func (p *ListSecondaryStagingStoresParams) setFirstMatch() {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p[firstMatchKey] = true
+}
+
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *ImageStoreService) GetSecondaryStagingStoreID(name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListSecondaryStagingStoresParams{}
@@ -900,7 +1716,7 @@ func (s *ImageStoreService) GetSecondaryStagingStoreID(name string, opts ...Opti
 
 	p.p["name"] = name
 
-	for _, fn := range append(s.cs.options, opts...) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
 		if err := fn(s.cs, p); err != nil {
 			return "", -1, err
 		}
@@ -912,7 +1728,7 @@ func (s *ImageStoreService) GetSecondaryStagingStoreID(name string, opts ...Opti
 	}
 
 	if l.Count == 0 {
-		return "", l.Count, fmt.Errorf("No match found for %s: %+v", name, l)
+		return "", l.Count, fmt.Errorf("%w for %s: %+v", ErrNotFound, name, l)
 	}
 
 	if l.Count == 1 {
@@ -925,6 +1741,10 @@ func (s *ImageStoreService) GetSecondaryStagingStoreID(name string, opts ...Opti
 				return v.Id, l.Count, nil
 			}
 		}
+
+		if _, ok := p.p[firstMatchKey]; ok && len(l.SecondaryStagingStores) > 0 {
+			return l.SecondaryStagingStores[0].Id, l.Count, nil
+		}
 	}
 	return "", l.Count, fmt.Errorf("Could not find an exact match for %s: %+v", name, l)
 }
@@ -950,7 +1770,7 @@ func (s *ImageStoreService) GetSecondaryStagingStoreByID(id string, opts ...Opti
 
 	p.p["id"] = id
 
-	for _, fn := range append(s.cs.options, opts...) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
 		if err := fn(s.cs, p); err != nil {
 			return nil, -1, err
 		}
@@ -961,24 +1781,56 @@ func (s *ImageStoreService) GetSecondaryStagingStoreByID(id string, opts ...Opti
 		if strings.Contains(err.Error(), fmt.Sprintf(
 			"Invalid parameter id value=%s due to incorrect long value format, "+
 				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+			return nil, 0, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
 	}
 
 	if l.Count == 1 {
 		return l.SecondaryStagingStores[0], l.Count, nil
 	}
+
+	if _, ok := p.p[firstMatchKey]; ok && len(l.SecondaryStagingStores) > 0 {
+		return l.SecondaryStagingStores[0], l.Count, nil
+	}
 	return nil, l.Count, fmt.Errorf("There is more then one result for SecondaryStagingStore UUID: %s!", id)
 }
 
+// SecondaryStagingStoreExists returns true if a SecondaryStagingStore with the given ID exists. When no match is found
+// it returns false, while any other error is returned as is.
+func (s *ImageStoreService) SecondaryStagingStoreExists(id string, opts ...OptionFunc) (bool, error) {
+	_, count, err := s.GetSecondaryStagingStoreByID(id, opts...)
+	if errors.Is(err, ErrNotFound) {
+		return false, nil
+	}
+	if count > 0 {
+		return true, nil
+	}
+	return false, err
+}
+
 // Lists secondary staging stores.
 func (s *ImageStoreService) ListSecondaryStagingStores(p *ListSecondaryStagingStoresParams) (*ListSecondaryStagingStoresResponse, error) {
-	resp, err := s.cs.newRequest("listSecondaryStagingStores", p.toURLValues())
+	return s.ListSecondaryStagingStoresWithContext(context.Background(), p)
+}
+
+// ListSecondaryStagingStoresWithContext is the same as ListSecondaryStagingStores, but the request is cancelled when the context is done
+func (s *ImageStoreService) ListSecondaryStagingStoresWithContext(ctx context.Context, p *ListSecondaryStagingStoresParams) (*ListSecondaryStagingStoresResponse, error) {
+	return s.ListSecondaryStagingStoresRawWithContext(ctx, p.toURLValues())
+}
+
+// ListSecondaryStagingStoresRaw is the same as ListSecondaryStagingStores, but takes the params as url.Values instead of typed params
+func (s *ImageStoreService) ListSecondaryStagingStoresRaw(v url.Values) (*ListSecondaryStagingStoresResponse, error) {
+	return s.ListSecondaryStagingStoresRawWithContext(context.Background(), v)
+}
+
+// ListSecondaryStagingStoresRawWithContext is the same as ListSecondaryStagingStoresRaw, but the request is cancelled when the context is done
+func (s *ImageStoreService) ListSecondaryStagingStoresRawWithContext(ctx context.Context, v url.Values) (*ListSecondaryStagingStoresResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "listSecondaryStagingStores", "listsecondarystagingstoresresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -991,6 +1843,93 @@ func (s *ImageStoreService) ListSecondaryStagingStores(p *ListSecondaryStagingSt
 	return &r, nil
 }
 
+// StreamSecondaryStagingStores fetches the results of ListSecondaryStagingStores page by page in the background and sends them on the
+// returned channel. Both returned channels are closed when done. If fetching a page fails, or the context
+// is done before all results are sent, the error is sent on the error channel and fetching stops. A
+// request for a page in flight is cancelled when the context is done. The page and pagesize set in the
+// params are ignored.
+func (s *ImageStoreService) StreamSecondaryStagingStores(ctx context.Context, p *ListSecondaryStagingStoresParams) (<-chan *SecondaryStagingStore, <-chan error) {
+	ch := make(chan *SecondaryStagingStore)
+	errs := make(chan error, 1)
+
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesize(500)
+
+	go func() {
+		defer close(errs)
+		defer close(ch)
+
+		for page, count := 1, 0; ; page++ {
+			pp.SetPage(page)
+
+			l, err := s.ListSecondaryStagingStoresWithContext(ctx, pp)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			for _, v := range l.SecondaryStagingStores {
+				select {
+				case ch <- v:
+				case <-ctx.Done():
+					errs <- ctx.Err()
+					return
+				}
+			}
+
+			count += len(l.SecondaryStagingStores)
+			if len(l.SecondaryStagingStores) < 500 || count >= l.Count {
+				return
+			}
+		}
+	}()
+
+	return ch, errs
+}
+
+// ListSecondaryStagingStoresWhere returns all results of ListSecondaryStagingStores for which pred returns true. Note that the results are
+// filtered client-side, so all results matching the params are fetched first.
+// All pages are fetched, so the page and pagesize set in the params are ignored.
+func (s *ImageStoreService) ListSecondaryStagingStoresWhere(p *ListSecondaryStagingStoresParams, pred func(*SecondaryStagingStore) bool) ([]*SecondaryStagingStore, error) {
+	var r []*SecondaryStagingStore
+	ch, errs := s.StreamSecondaryStagingStores(context.Background(), p)
+	for v := range ch {
+		if pred(v) {
+			r = append(r, v)
+		}
+	}
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ListAllSecondaryStagingStores returns the results of ListSecondaryStagingStores across all pages, by calling ListSecondaryStagingStores page by page until all
+// results are fetched. The pagesize set in the params is used for every page (500 if not set), and the
+// page set in the params is ignored.
+func (s *ImageStoreService) ListAllSecondaryStagingStores(p *ListSecondaryStagingStoresParams) ([]*SecondaryStagingStore, error) {
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesizeIfUnset(500)
+	pagesize := pp.p["pagesize"].(int)
+
+	var r []*SecondaryStagingStore
+	for page := 1; ; page++ {
+		pp.SetPage(page)
+
+		l, err := s.ListSecondaryStagingStores(pp)
+		if err != nil {
+			return nil, err
+		}
+		r = append(r, l.SecondaryStagingStores...)
+
+		if len(l.SecondaryStagingStores) < pagesize || len(r) >= l.Count {
+			return r, nil
+		}
+	}
+}
+
 type ListSecondaryStagingStoresResponse struct {
 	Count                  int                      `json:"count"`
 	SecondaryStagingStores []*SecondaryStagingStore `json:"secondarystagingstore"`
@@ -1012,16 +1951,33 @@ type UpdateCloudToUseObjectStoreParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *UpdateCloudToUseObjectStoreParams) Clone() *UpdateCloudToUseObjectStoreParams {
+	c := &UpdateCloudToUseObjectStoreParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *UpdateCloudToUseObjectStoreParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
 	}
 	if v, found := p.p["details"]; found {
-		i := 0
-		for k, vv := range v.(map[string]string) {
-			u.Set(fmt.Sprintf("details[%d].%s", i, k), vv)
-			i++
+		switch m := v.(type) {
+		case []KeyValuePair:
+			for i, kv := range m {
+				u.Set(fmt.Sprintf("details[%d].key", i), kv.Key)
+				u.Set(fmt.Sprintf("details[%d].value", i), kv.Value)
+			}
+		case map[string]string:
+			for i, k := range getSortedKeysFromMap(m) {
+				u.Set(fmt.Sprintf("details[%d].key", i), k)
+				u.Set(fmt.Sprintf("details[%d].value", i), m[k])
+			}
 		}
 	}
 	if v, found := p.p["name"]; found {
@@ -1036,6 +1992,16 @@ func (p *UpdateCloudToUseObjectStoreParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *UpdateCloudToUseObjectStoreParams) validate() error {
+	for _, k := range []string{"provider"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of updateCloudToUseObjectStore", k)
+		}
+	}
+	return nil
+}
+
 func (p *UpdateCloudToUseObjectStoreParams) SetDetails(v map[string]string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1044,6 +2010,21 @@ func (p *UpdateCloudToUseObjectStoreParams) SetDetails(v map[string]string) {
 	return
 }
 
+// SetDetailsIfUnset sets details to v, unless it is already set
+func (p *UpdateCloudToUseObjectStoreParams) SetDetailsIfUnset(v map[string]string) {
+	if _, found := p.p["details"]; !found {
+		p.SetDetails(v)
+	}
+}
+
+// SetDetailsKV sets the details as key/value pairs, which are encoded in the given order
+func (p *UpdateCloudToUseObjectStoreParams) SetDetailsKV(v []KeyValuePair) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["details"] = v
+}
+
 func (p *UpdateCloudToUseObjectStoreParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1052,6 +2033,13 @@ func (p *UpdateCloudToUseObjectStoreParams) SetName(v string) {
 	return
 }
 
+// SetNameIfUnset sets name to v, unless it is already set
+func (p *UpdateCloudToUseObjectStoreParams) SetNameIfUnset(v string) {
+	if _, found := p.p["name"]; !found {
+		p.SetName(v)
+	}
+}
+
 func (p *UpdateCloudToUseObjectStoreParams) SetProvider(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1060,6 +2048,13 @@ func (p *UpdateCloudToUseObjectStoreParams) SetProvider(v string) {
 	return
 }
 
+// SetProviderIfUnset sets provider to v, unless it is already set
+func (p *UpdateCloudToUseObjectStoreParams) SetProviderIfUnset(v string) {
+	if _, found := p.p["provider"]; !found {
+		p.SetProvider(v)
+	}
+}
+
 func (p *UpdateCloudToUseObjectStoreParams) SetUrl(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1068,18 +2063,54 @@ func (p *UpdateCloudToUseObjectStoreParams) SetUrl(v string) {
 	return
 }
 
+// SetUrlIfUnset sets url to v, unless it is already set
+func (p *UpdateCloudToUseObjectStoreParams) SetUrlIfUnset(v string) {
+	if _, found := p.p["url"]; !found {
+		p.SetUrl(v)
+	}
+}
+
 // You should always use this function to get a new UpdateCloudToUseObjectStoreParams instance,
-// as then you are sure you have configured all required params
-func (s *ImageStoreService) NewUpdateCloudToUseObjectStoreParams(provider string) *UpdateCloudToUseObjectStoreParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *ImageStoreService) NewUpdateCloudToUseObjectStoreParams(provider string, opts ...func(*UpdateCloudToUseObjectStoreParams)) *UpdateCloudToUseObjectStoreParams {
 	p := &UpdateCloudToUseObjectStoreParams{}
 	p.p = make(map[string]interface{})
 	p.p["provider"] = provider
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Migrate current NFS secondary storages to use object store.
-func (s *ImageStoreService) UpdateCloudToUseObjectStore(p *UpdateCloudToUseObjectStoreParams) (*UpdateCloudToUseObjectStoreResponse, error) {
-	resp, err := s.cs.newRequest("updateCloudToUseObjectStore", p.toURLValues())
+func (s *ImageStoreService) UpdateCloudToUseObjectStore(p *UpdateCloudToUseObjectStoreParams, opts ...OptionFunc) (*UpdateCloudToUseObjectStoreResponse, error) {
+	return s.UpdateCloudToUseObjectStoreWithContext(context.Background(), p, opts...)
+}
+
+// UpdateCloudToUseObjectStoreWithContext is the same as UpdateCloudToUseObjectStore, but the request is cancelled when the context is done
+func (s *ImageStoreService) UpdateCloudToUseObjectStoreWithContext(ctx context.Context, p *UpdateCloudToUseObjectStoreParams, opts ...OptionFunc) (*UpdateCloudToUseObjectStoreResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.UpdateCloudToUseObjectStoreRawWithContext(ctx, p.toURLValues())
+}
+
+// UpdateCloudToUseObjectStoreRaw is the same as UpdateCloudToUseObjectStore, but takes the params as url.Values instead of typed params
+func (s *ImageStoreService) UpdateCloudToUseObjectStoreRaw(v url.Values) (*UpdateCloudToUseObjectStoreResponse, error) {
+	return s.UpdateCloudToUseObjectStoreRawWithContext(context.Background(), v)
+}
+
+// UpdateCloudToUseObjectStoreRawWithContext is the same as UpdateCloudToUseObjectStoreRaw, but the request is cancelled when the context is done
+func (s *ImageStoreService) UpdateCloudToUseObjectStoreRawWithContext(ctx context.Context, v url.Values) (*UpdateCloudToUseObjectStoreResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "updateCloudToUseObjectStore", "updatecloudtouseobjectstoreresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -17,7 +17,9 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/url"
 	"strconv"
@@ -28,6 +30,16 @@ type ConfigureInternalLoadBalancerElementParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ConfigureInternalLoadBalancerElementParams) Clone() *ConfigureInternalLoadBalancerElementParams {
+	c := &ConfigureInternalLoadBalancerElementParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ConfigureInternalLoadBalancerElementParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -43,6 +55,16 @@ func (p *ConfigureInternalLoadBalancerElementParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *ConfigureInternalLoadBalancerElementParams) validate() error {
+	for _, k := range []string{"enabled", "id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of configureInternalLoadBalancerElement", k)
+		}
+	}
+	return nil
+}
+
 func (p *ConfigureInternalLoadBalancerElementParams) SetEnabled(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -51,6 +73,13 @@ func (p *ConfigureInternalLoadBalancerElementParams) SetEnabled(v bool) {
 	return
 }
 
+// SetEnabledIfUnset sets enabled to v, unless it is already set
+func (p *ConfigureInternalLoadBalancerElementParams) SetEnabledIfUnset(v bool) {
+	if _, found := p.p["enabled"]; !found {
+		p.SetEnabled(v)
+	}
+}
+
 func (p *ConfigureInternalLoadBalancerElementParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -59,19 +88,55 @@ func (p *ConfigureInternalLoadBalancerElementParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *ConfigureInternalLoadBalancerElementParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 // You should always use this function to get a new ConfigureInternalLoadBalancerElementParams instance,
-// as then you are sure you have configured all required params
-func (s *InternalLBService) NewConfigureInternalLoadBalancerElementParams(enabled bool, id string) *ConfigureInternalLoadBalancerElementParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *InternalLBService) NewConfigureInternalLoadBalancerElementParams(enabled bool, id string, opts ...func(*ConfigureInternalLoadBalancerElementParams)) *ConfigureInternalLoadBalancerElementParams {
 	p := &ConfigureInternalLoadBalancerElementParams{}
 	p.p = make(map[string]interface{})
 	p.p["enabled"] = enabled
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Configures an Internal Load Balancer element.
-func (s *InternalLBService) ConfigureInternalLoadBalancerElement(p *ConfigureInternalLoadBalancerElementParams) (*InternalLoadBalancerElementResponse, error) {
-	resp, err := s.cs.newRequest("configureInternalLoadBalancerElement", p.toURLValues())
+func (s *InternalLBService) ConfigureInternalLoadBalancerElement(p *ConfigureInternalLoadBalancerElementParams, opts ...OptionFunc) (*InternalLoadBalancerElementResponse, error) {
+	return s.ConfigureInternalLoadBalancerElementWithContext(context.Background(), p, opts...)
+}
+
+// ConfigureInternalLoadBalancerElementWithContext is the same as ConfigureInternalLoadBalancerElement, but the request is cancelled when the context is done
+func (s *InternalLBService) ConfigureInternalLoadBalancerElementWithContext(ctx context.Context, p *ConfigureInternalLoadBalancerElementParams, opts ...OptionFunc) (*InternalLoadBalancerElementResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.ConfigureInternalLoadBalancerElementRawWithContext(ctx, p.toURLValues())
+}
+
+// ConfigureInternalLoadBalancerElementRaw is the same as ConfigureInternalLoadBalancerElement, but takes the params as url.Values instead of typed params
+func (s *InternalLBService) ConfigureInternalLoadBalancerElementRaw(v url.Values) (*InternalLoadBalancerElementResponse, error) {
+	return s.ConfigureInternalLoadBalancerElementRawWithContext(context.Background(), v)
+}
+
+// ConfigureInternalLoadBalancerElementRawWithContext is the same as ConfigureInternalLoadBalancerElementRaw, but the request is cancelled when the context is done
+func (s *InternalLBService) ConfigureInternalLoadBalancerElementRawWithContext(ctx context.Context, v url.Values) (*InternalLoadBalancerElementResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "configureInternalLoadBalancerElement", "configureinternalloadbalancerelementresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -83,9 +148,9 @@ func (s *InternalLBService) ConfigureInternalLoadBalancerElement(p *ConfigureInt
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -115,6 +180,16 @@ type CreateInternalLoadBalancerElementParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *CreateInternalLoadBalancerElementParams) Clone() *CreateInternalLoadBalancerElementParams {
+	c := &CreateInternalLoadBalancerElementParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *CreateInternalLoadBalancerElementParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -126,6 +201,16 @@ func (p *CreateInternalLoadBalancerElementParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *CreateInternalLoadBalancerElementParams) validate() error {
+	for _, k := range []string{"nspid"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of createInternalLoadBalancerElement", k)
+		}
+	}
+	return nil
+}
+
 func (p *CreateInternalLoadBalancerElementParams) SetNspid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -134,18 +219,54 @@ func (p *CreateInternalLoadBalancerElementParams) SetNspid(v string) {
 	return
 }
 
+// SetNspidIfUnset sets nspid to v, unless it is already set
+func (p *CreateInternalLoadBalancerElementParams) SetNspidIfUnset(v string) {
+	if _, found := p.p["nspid"]; !found {
+		p.SetNspid(v)
+	}
+}
+
 // You should always use this function to get a new CreateInternalLoadBalancerElementParams instance,
-// as then you are sure you have configured all required params
-func (s *InternalLBService) NewCreateInternalLoadBalancerElementParams(nspid string) *CreateInternalLoadBalancerElementParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *InternalLBService) NewCreateInternalLoadBalancerElementParams(nspid string, opts ...func(*CreateInternalLoadBalancerElementParams)) *CreateInternalLoadBalancerElementParams {
 	p := &CreateInternalLoadBalancerElementParams{}
 	p.p = make(map[string]interface{})
 	p.p["nspid"] = nspid
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Create an Internal Load Balancer element.
-func (s *InternalLBService) CreateInternalLoadBalancerElement(p *CreateInternalLoadBalancerElementParams) (*CreateInternalLoadBalancerElementResponse, error) {
-	resp, err := s.cs.newRequest("createInternalLoadBalancerElement", p.toURLValues())
+func (s *InternalLBService) CreateInternalLoadBalancerElement(p *CreateInternalLoadBalancerElementParams, opts ...OptionFunc) (*CreateInternalLoadBalancerElementResponse, error) {
+	return s.CreateInternalLoadBalancerElementWithContext(context.Background(), p, opts...)
+}
+
+// CreateInternalLoadBalancerElementWithContext is the same as CreateInternalLoadBalancerElement, but the request is cancelled when the context is done
+func (s *InternalLBService) CreateInternalLoadBalancerElementWithContext(ctx context.Context, p *CreateInternalLoadBalancerElementParams, opts ...OptionFunc) (*CreateInternalLoadBalancerElementResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.CreateInternalLoadBalancerElementRawWithContext(ctx, p.toURLValues())
+}
+
+// CreateInternalLoadBalancerElementRaw is the same as CreateInternalLoadBalancerElement, but takes the params as url.Values instead of typed params
+func (s *InternalLBService) CreateInternalLoadBalancerElementRaw(v url.Values) (*CreateInternalLoadBalancerElementResponse, error) {
+	return s.CreateInternalLoadBalancerElementRawWithContext(context.Background(), v)
+}
+
+// CreateInternalLoadBalancerElementRawWithContext is the same as CreateInternalLoadBalancerElementRaw, but the request is cancelled when the context is done
+func (s *InternalLBService) CreateInternalLoadBalancerElementRawWithContext(ctx context.Context, v url.Values) (*CreateInternalLoadBalancerElementResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "createInternalLoadBalancerElement", "createinternalloadbalancerelementresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -157,9 +278,9 @@ func (s *InternalLBService) CreateInternalLoadBalancerElement(p *CreateInternalL
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -189,6 +310,16 @@ type ListInternalLoadBalancerElementsParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ListInternalLoadBalancerElementsParams) Clone() *ListInternalLoadBalancerElementsParams {
+	c := &ListInternalLoadBalancerElementsParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListInternalLoadBalancerElementsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -226,6 +357,13 @@ func (p *ListInternalLoadBalancerElementsParams) SetEnabled(v bool) {
 	return
 }
 
+// SetEnabledIfUnset sets enabled to v, unless it is already set
+func (p *ListInternalLoadBalancerElementsParams) SetEnabledIfUnset(v bool) {
+	if _, found := p.p["enabled"]; !found {
+		p.SetEnabled(v)
+	}
+}
+
 func (p *ListInternalLoadBalancerElementsParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -234,6 +372,13 @@ func (p *ListInternalLoadBalancerElementsParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *ListInternalLoadBalancerElementsParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 func (p *ListInternalLoadBalancerElementsParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -242,6 +387,13 @@ func (p *ListInternalLoadBalancerElementsParams) SetKeyword(v string) {
 	return
 }
 
+// SetKeywordIfUnset sets keyword to v, unless it is already set
+func (p *ListInternalLoadBalancerElementsParams) SetKeywordIfUnset(v string) {
+	if _, found := p.p["keyword"]; !found {
+		p.SetKeyword(v)
+	}
+}
+
 func (p *ListInternalLoadBalancerElementsParams) SetNspid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -250,6 +402,13 @@ func (p *ListInternalLoadBalancerElementsParams) SetNspid(v string) {
 	return
 }
 
+// SetNspidIfUnset sets nspid to v, unless it is already set
+func (p *ListInternalLoadBalancerElementsParams) SetNspidIfUnset(v string) {
+	if _, found := p.p["nspid"]; !found {
+		p.SetNspid(v)
+	}
+}
+
 func (p *ListInternalLoadBalancerElementsParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -258,6 +417,13 @@ func (p *ListInternalLoadBalancerElementsParams) SetPage(v int) {
 	return
 }
 
+// SetPageIfUnset sets page to v, unless it is already set
+func (p *ListInternalLoadBalancerElementsParams) SetPageIfUnset(v int) {
+	if _, found := p.p["page"]; !found {
+		p.SetPage(v)
+	}
+}
+
 func (p *ListInternalLoadBalancerElementsParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -266,14 +432,32 @@ func (p *ListInternalLoadBalancerElementsParams) SetPagesize(v int) {
 	return
 }
 
+// SetPagesizeIfUnset sets pagesize to v, unless it is already set
+func (p *ListInternalLoadBalancerElementsParams) SetPagesizeIfUnset(v int) {
+	if _, found := p.p["pagesize"]; !found {
+		p.SetPagesize(v)
+	}
+}
+
 // You should always use this function to get a new ListInternalLoadBalancerElementsParams instance,
-// as then you are sure you have configured all required params
-func (s *InternalLBService) NewListInternalLoadBalancerElementsParams() *ListInternalLoadBalancerElementsParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *InternalLBService) NewListInternalLoadBalancerElementsParams(opts ...func(*ListInternalLoadBalancerElementsParams)) *ListInternalLoadBalancerElementsParams {
 	p := &ListInternalLoadBalancerElementsParams{}
 	p.p = make(map[string]interface{})
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
+func (p *ListInternalLoadBalancerElementsParams) setFirstMatch() {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p[firstMatchKey] = true
+}
+
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *InternalLBService) GetInternalLoadBalancerElementByID(id string, opts ...OptionFunc) (*InternalLoadBalancerElement, int, error) {
 	p := &ListInternalLoadBalancerElementsParams{}
@@ -281,7 +465,7 @@ func (s *InternalLBService) GetInternalLoadBalancerElementByID(id string, opts .
 
 	p.p["id"] = id
 
-	for _, fn := range append(s.cs.options, opts...) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
 		if err := fn(s.cs, p); err != nil {
 			return nil, -1, err
 		}
@@ -292,24 +476,56 @@ func (s *InternalLBService) GetInternalLoadBalancerElementByID(id string, opts .
 		if strings.Contains(err.Error(), fmt.Sprintf(
 			"Invalid parameter id value=%s due to incorrect long value format, "+
 				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+			return nil, 0, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
 	}
 
 	if l.Count == 1 {
 		return l.InternalLoadBalancerElements[0], l.Count, nil
 	}
+
+	if _, ok := p.p[firstMatchKey]; ok && len(l.InternalLoadBalancerElements) > 0 {
+		return l.InternalLoadBalancerElements[0], l.Count, nil
+	}
 	return nil, l.Count, fmt.Errorf("There is more then one result for InternalLoadBalancerElement UUID: %s!", id)
 }
 
+// InternalLoadBalancerElementExists returns true if a InternalLoadBalancerElement with the given ID exists. When no match is found
+// it returns false, while any other error is returned as is.
+func (s *InternalLBService) InternalLoadBalancerElementExists(id string, opts ...OptionFunc) (bool, error) {
+	_, count, err := s.GetInternalLoadBalancerElementByID(id, opts...)
+	if errors.Is(err, ErrNotFound) {
+		return false, nil
+	}
+	if count > 0 {
+		return true, nil
+	}
+	return false, err
+}
+
 // Lists all available Internal Load Balancer elements.
 func (s *InternalLBService) ListInternalLoadBalancerElements(p *ListInternalLoadBalancerElementsParams) (*ListInternalLoadBalancerElementsResponse, error) {
-	resp, err := s.cs.newRequest("listInternalLoadBalancerElements", p.toURLValues())
+	return s.ListInternalLoadBalancerElementsWithContext(context.Background(), p)
+}
+
+// ListInternalLoadBalancerElementsWithContext is the same as ListInternalLoadBalancerElements, but the request is cancelled when the context is done
+func (s *InternalLBService) ListInternalLoadBalancerElementsWithContext(ctx context.Context, p *ListInternalLoadBalancerElementsParams) (*ListInternalLoadBalancerElementsResponse, error) {
+	return s.ListInternalLoadBalancerElementsRawWithContext(ctx, p.toURLValues())
+}
+
+// ListInternalLoadBalancerElementsRaw is the same as ListInternalLoadBalancerElements, but takes the params as url.Values instead of typed params
+func (s *InternalLBService) ListInternalLoadBalancerElementsRaw(v url.Values) (*ListInternalLoadBalancerElementsResponse, error) {
+	return s.ListInternalLoadBalancerElementsRawWithContext(context.Background(), v)
+}
+
+// ListInternalLoadBalancerElementsRawWithContext is the same as ListInternalLoadBalancerElementsRaw, but the request is cancelled when the context is done
+func (s *InternalLBService) ListInternalLoadBalancerElementsRawWithContext(ctx context.Context, v url.Values) (*ListInternalLoadBalancerElementsResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "listInternalLoadBalancerElements", "listinternalloadbalancerelementsresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -322,6 +538,93 @@ func (s *InternalLBService) ListInternalLoadBalancerElements(p *ListInternalLoad
 	return &r, nil
 }
 
+// StreamInternalLoadBalancerElements fetches the results of ListInternalLoadBalancerElements page by page in the background and sends them on the
+// returned channel. Both returned channels are closed when done. If fetching a page fails, or the context
+// is done before all results are sent, the error is sent on the error channel and fetching stops. A
+// request for a page in flight is cancelled when the context is done. The page and pagesize set in the
+// params are ignored.
+func (s *InternalLBService) StreamInternalLoadBalancerElements(ctx context.Context, p *ListInternalLoadBalancerElementsParams) (<-chan *InternalLoadBalancerElement, <-chan error) {
+	ch := make(chan *InternalLoadBalancerElement)
+	errs := make(chan error, 1)
+
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesize(500)
+
+	go func() {
+		defer close(errs)
+		defer close(ch)
+
+		for page, count := 1, 0; ; page++ {
+			pp.SetPage(page)
+
+			l, err := s.ListInternalLoadBalancerElementsWithContext(ctx, pp)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			for _, v := range l.InternalLoadBalancerElements {
+				select {
+				case ch <- v:
+				case <-ctx.Done():
+					errs <- ctx.Err()
+					return
+				}
+			}
+
+			count += len(l.InternalLoadBalancerElements)
+			if len(l.InternalLoadBalancerElements) < 500 || count >= l.Count {
+				return
+			}
+		}
+	}()
+
+	return ch, errs
+}
+
+// ListInternalLoadBalancerElementsWhere returns all results of ListInternalLoadBalancerElements for which pred returns true. Note that the results are
+// filtered client-side, so all results matching the params are fetched first.
+// All pages are fetched, so the page and pagesize set in the params are ignored.
+func (s *InternalLBService) ListInternalLoadBalancerElementsWhere(p *ListInternalLoadBalancerElementsParams, pred func(*InternalLoadBalancerElement) bool) ([]*InternalLoadBalancerElement, error) {
+	var r []*InternalLoadBalancerElement
+	ch, errs := s.StreamInternalLoadBalancerElements(context.Background(), p)
+	for v := range ch {
+		if pred(v) {
+			r = append(r, v)
+		}
+	}
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ListAllInternalLoadBalancerElements returns the results of ListInternalLoadBalancerElements across all pages, by calling ListInternalLoadBalancerElements page by page until all
+// results are fetched. The pagesize set in the params is used for every page (500 if not set), and the
+// page set in the params is ignored.
+func (s *InternalLBService) ListAllInternalLoadBalancerElements(p *ListInternalLoadBalancerElementsParams) ([]*InternalLoadBalancerElement, error) {
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesizeIfUnset(500)
+	pagesize := pp.p["pagesize"].(int)
+
+	var r []*InternalLoadBalancerElement
+	for page := 1; ; page++ {
+		pp.SetPage(page)
+
+		l, err := s.ListInternalLoadBalancerElements(pp)
+		if err != nil {
+			return nil, err
+		}
+		r = append(r, l.InternalLoadBalancerElements...)
+
+		if len(l.InternalLoadBalancerElements) < pagesize || len(r) >= l.Count {
+			return r, nil
+		}
+	}
+}
+
 type ListInternalLoadBalancerElementsResponse struct {
 	Count                        int                            `json:"count"`
 	InternalLoadBalancerElements []*InternalLoadBalancerElement `json:"internalloadbalancerelement"`
@@ -337,6 +640,16 @@ type ListInternalLoadBalancerVMsParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ListInternalLoadBalancerVMsParams) Clone() *ListInternalLoadBalancerVMsParams {
+	c := &ListInternalLoadBalancerVMsParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListInternalLoadBalancerVMsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -409,6 +722,13 @@ func (p *ListInternalLoadBalancerVMsParams) SetAccount(v string) {
 	return
 }
 
+// SetAccountIfUnset sets account to v, unless it is already set
+func (p *ListInternalLoadBalancerVMsParams) SetAccountIfUnset(v string) {
+	if _, found := p.p["account"]; !found {
+		p.SetAccount(v)
+	}
+}
+
 func (p *ListInternalLoadBalancerVMsParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -417,6 +737,13 @@ func (p *ListInternalLoadBalancerVMsParams) SetDomainid(v string) {
 	return
 }
 
+// SetDomainidIfUnset sets domainid to v, unless it is already set
+func (p *ListInternalLoadBalancerVMsParams) SetDomainidIfUnset(v string) {
+	if _, found := p.p["domainid"]; !found {
+		p.SetDomainid(v)
+	}
+}
+
 func (p *ListInternalLoadBalancerVMsParams) SetForvpc(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -425,6 +752,13 @@ func (p *ListInternalLoadBalancerVMsParams) SetForvpc(v bool) {
 	return
 }
 
+// SetForvpcIfUnset sets forvpc to v, unless it is already set
+func (p *ListInternalLoadBalancerVMsParams) SetForvpcIfUnset(v bool) {
+	if _, found := p.p["forvpc"]; !found {
+		p.SetForvpc(v)
+	}
+}
+
 func (p *ListInternalLoadBalancerVMsParams) SetHostid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -433,6 +767,13 @@ func (p *ListInternalLoadBalancerVMsParams) SetHostid(v string) {
 	return
 }
 
+// SetHostidIfUnset sets hostid to v, unless it is already set
+func (p *ListInternalLoadBalancerVMsParams) SetHostidIfUnset(v string) {
+	if _, found := p.p["hostid"]; !found {
+		p.SetHostid(v)
+	}
+}
+
 func (p *ListInternalLoadBalancerVMsParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -441,6 +782,13 @@ func (p *ListInternalLoadBalancerVMsParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *ListInternalLoadBalancerVMsParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 func (p *ListInternalLoadBalancerVMsParams) SetIsrecursive(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -449,6 +797,13 @@ func (p *ListInternalLoadBalancerVMsParams) SetIsrecursive(v bool) {
 	return
 }
 
+// SetIsrecursiveIfUnset sets isrecursive to v, unless it is already set
+func (p *ListInternalLoadBalancerVMsParams) SetIsrecursiveIfUnset(v bool) {
+	if _, found := p.p["isrecursive"]; !found {
+		p.SetIsrecursive(v)
+	}
+}
+
 func (p *ListInternalLoadBalancerVMsParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -457,6 +812,13 @@ func (p *ListInternalLoadBalancerVMsParams) SetKeyword(v string) {
 	return
 }
 
+// SetKeywordIfUnset sets keyword to v, unless it is already set
+func (p *ListInternalLoadBalancerVMsParams) SetKeywordIfUnset(v string) {
+	if _, found := p.p["keyword"]; !found {
+		p.SetKeyword(v)
+	}
+}
+
 func (p *ListInternalLoadBalancerVMsParams) SetListall(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -465,6 +827,13 @@ func (p *ListInternalLoadBalancerVMsParams) SetListall(v bool) {
 	return
 }
 
+// SetListallIfUnset sets listall to v, unless it is already set
+func (p *ListInternalLoadBalancerVMsParams) SetListallIfUnset(v bool) {
+	if _, found := p.p["listall"]; !found {
+		p.SetListall(v)
+	}
+}
+
 func (p *ListInternalLoadBalancerVMsParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -473,6 +842,13 @@ func (p *ListInternalLoadBalancerVMsParams) SetName(v string) {
 	return
 }
 
+// SetNameIfUnset sets name to v, unless it is already set
+func (p *ListInternalLoadBalancerVMsParams) SetNameIfUnset(v string) {
+	if _, found := p.p["name"]; !found {
+		p.SetName(v)
+	}
+}
+
 func (p *ListInternalLoadBalancerVMsParams) SetNetworkid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -481,6 +857,13 @@ func (p *ListInternalLoadBalancerVMsParams) SetNetworkid(v string) {
 	return
 }
 
+// SetNetworkidIfUnset sets networkid to v, unless it is already set
+func (p *ListInternalLoadBalancerVMsParams) SetNetworkidIfUnset(v string) {
+	if _, found := p.p["networkid"]; !found {
+		p.SetNetworkid(v)
+	}
+}
+
 func (p *ListInternalLoadBalancerVMsParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -489,6 +872,13 @@ func (p *ListInternalLoadBalancerVMsParams) SetPage(v int) {
 	return
 }
 
+// SetPageIfUnset sets page to v, unless it is already set
+func (p *ListInternalLoadBalancerVMsParams) SetPageIfUnset(v int) {
+	if _, found := p.p["page"]; !found {
+		p.SetPage(v)
+	}
+}
+
 func (p *ListInternalLoadBalancerVMsParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -497,6 +887,13 @@ func (p *ListInternalLoadBalancerVMsParams) SetPagesize(v int) {
 	return
 }
 
+// SetPagesizeIfUnset sets pagesize to v, unless it is already set
+func (p *ListInternalLoadBalancerVMsParams) SetPagesizeIfUnset(v int) {
+	if _, found := p.p["pagesize"]; !found {
+		p.SetPagesize(v)
+	}
+}
+
 func (p *ListInternalLoadBalancerVMsParams) SetPodid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -505,6 +902,13 @@ func (p *ListInternalLoadBalancerVMsParams) SetPodid(v string) {
 	return
 }
 
+// SetPodidIfUnset sets podid to v, unless it is already set
+func (p *ListInternalLoadBalancerVMsParams) SetPodidIfUnset(v string) {
+	if _, found := p.p["podid"]; !found {
+		p.SetPodid(v)
+	}
+}
+
 func (p *ListInternalLoadBalancerVMsParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -513,6 +917,13 @@ func (p *ListInternalLoadBalancerVMsParams) SetProjectid(v string) {
 	return
 }
 
+// SetProjectidIfUnset sets projectid to v, unless it is already set
+func (p *ListInternalLoadBalancerVMsParams) SetProjectidIfUnset(v string) {
+	if _, found := p.p["projectid"]; !found {
+		p.SetProjectid(v)
+	}
+}
+
 func (p *ListInternalLoadBalancerVMsParams) SetState(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -521,6 +932,13 @@ func (p *ListInternalLoadBalancerVMsParams) SetState(v string) {
 	return
 }
 
+// SetStateIfUnset sets state to v, unless it is already set
+func (p *ListInternalLoadBalancerVMsParams) SetStateIfUnset(v string) {
+	if _, found := p.p["state"]; !found {
+		p.SetState(v)
+	}
+}
+
 func (p *ListInternalLoadBalancerVMsParams) SetVpcid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -529,6 +947,13 @@ func (p *ListInternalLoadBalancerVMsParams) SetVpcid(v string) {
 	return
 }
 
+// SetVpcidIfUnset sets vpcid to v, unless it is already set
+func (p *ListInternalLoadBalancerVMsParams) SetVpcidIfUnset(v string) {
+	if _, found := p.p["vpcid"]; !found {
+		p.SetVpcid(v)
+	}
+}
+
 func (p *ListInternalLoadBalancerVMsParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -537,14 +962,34 @@ func (p *ListInternalLoadBalancerVMsParams) SetZoneid(v string) {
 	return
 }
 
+// SetZoneidIfUnset sets zoneid to v, unless it is already set
+func (p *ListInternalLoadBalancerVMsParams) SetZoneidIfUnset(v string) {
+	if _, found := p.p["zoneid"]; !found {
+		p.SetZoneid(v)
+	}
+}
+
+var _ ListAllSetter = (*ListInternalLoadBalancerVMsParams)(nil)
+
 // You should always use this function to get a new ListInternalLoadBalancerVMsParams instance,
-// as then you are sure you have configured all required params
-func (s *InternalLBService) NewListInternalLoadBalancerVMsParams() *ListInternalLoadBalancerVMsParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *InternalLBService) NewListInternalLoadBalancerVMsParams(opts ...func(*ListInternalLoadBalancerVMsParams)) *ListInternalLoadBalancerVMsParams {
 	p := &ListInternalLoadBalancerVMsParams{}
 	p.p = make(map[string]interface{})
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
+func (p *ListInternalLoadBalancerVMsParams) setFirstMatch() {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p[firstMatchKey] = true
+}
+
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *InternalLBService) GetInternalLoadBalancerVMID(name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListInternalLoadBalancerVMsParams{}
@@ -552,7 +997,7 @@ func (s *InternalLBService) GetInternalLoadBalancerVMID(name string, opts ...Opt
 
 	p.p["name"] = name
 
-	for _, fn := range append(s.cs.options, opts...) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
 		if err := fn(s.cs, p); err != nil {
 			return "", -1, err
 		}
@@ -564,7 +1009,7 @@ func (s *InternalLBService) GetInternalLoadBalancerVMID(name string, opts ...Opt
 	}
 
 	if l.Count == 0 {
-		return "", l.Count, fmt.Errorf("No match found for %s: %+v", name, l)
+		return "", l.Count, fmt.Errorf("%w for %s: %+v", ErrNotFound, name, l)
 	}
 
 	if l.Count == 1 {
@@ -577,6 +1022,10 @@ func (s *InternalLBService) GetInternalLoadBalancerVMID(name string, opts ...Opt
 				return v.Id, l.Count, nil
 			}
 		}
+
+		if _, ok := p.p[firstMatchKey]; ok && len(l.InternalLoadBalancerVMs) > 0 {
+			return l.InternalLoadBalancerVMs[0].Id, l.Count, nil
+		}
 	}
 	return "", l.Count, fmt.Errorf("Could not find an exact match for %s: %+v", name, l)
 }
@@ -602,7 +1051,7 @@ func (s *InternalLBService) GetInternalLoadBalancerVMByID(id string, opts ...Opt
 
 	p.p["id"] = id
 
-	for _, fn := range append(s.cs.options, opts...) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
 		if err := fn(s.cs, p); err != nil {
 			return nil, -1, err
 		}
@@ -613,24 +1062,56 @@ func (s *InternalLBService) GetInternalLoadBalancerVMByID(id string, opts ...Opt
 		if strings.Contains(err.Error(), fmt.Sprintf(
 			"Invalid parameter id value=%s due to incorrect long value format, "+
 				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+			return nil, 0, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
 	}
 
 	if l.Count == 1 {
 		return l.InternalLoadBalancerVMs[0], l.Count, nil
 	}
+
+	if _, ok := p.p[firstMatchKey]; ok && len(l.InternalLoadBalancerVMs) > 0 {
+		return l.InternalLoadBalancerVMs[0], l.Count, nil
+	}
 	return nil, l.Count, fmt.Errorf("There is more then one result for InternalLoadBalancerVM UUID: %s!", id)
 }
 
+// InternalLoadBalancerVMExists returns true if a InternalLoadBalancerVM with the given ID exists. When no match is found
+// it returns false, while any other error is returned as is.
+func (s *InternalLBService) InternalLoadBalancerVMExists(id string, opts ...OptionFunc) (bool, error) {
+	_, count, err := s.GetInternalLoadBalancerVMByID(id, opts...)
+	if errors.Is(err, ErrNotFound) {
+		return false, nil
+	}
+	if count > 0 {
+		return true, nil
+	}
+	return false, err
+}
+
 // List internal LB VMs.
 func (s *InternalLBService) ListInternalLoadBalancerVMs(p *ListInternalLoadBalancerVMsParams) (*ListInternalLoadBalancerVMsResponse, error) {
-	resp, err := s.cs.newRequest("listInternalLoadBalancerVMs", p.toURLValues())
+	return s.ListInternalLoadBalancerVMsWithContext(context.Background(), p)
+}
+
+// ListInternalLoadBalancerVMsWithContext is the same as ListInternalLoadBalancerVMs, but the request is cancelled when the context is done
+func (s *InternalLBService) ListInternalLoadBalancerVMsWithContext(ctx context.Context, p *ListInternalLoadBalancerVMsParams) (*ListInternalLoadBalancerVMsResponse, error) {
+	return s.ListInternalLoadBalancerVMsRawWithContext(ctx, p.toURLValues())
+}
+
+// ListInternalLoadBalancerVMsRaw is the same as ListInternalLoadBalancerVMs, but takes the params as url.Values instead of typed params
+func (s *InternalLBService) ListInternalLoadBalancerVMsRaw(v url.Values) (*ListInternalLoadBalancerVMsResponse, error) {
+	return s.ListInternalLoadBalancerVMsRawWithContext(context.Background(), v)
+}
+
+// ListInternalLoadBalancerVMsRawWithContext is the same as ListInternalLoadBalancerVMsRaw, but the request is cancelled when the context is done
+func (s *InternalLBService) ListInternalLoadBalancerVMsRawWithContext(ctx context.Context, v url.Values) (*ListInternalLoadBalancerVMsResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "listInternalLoadBalancerVMs", "listinternalloadbalancervmsresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -643,6 +1124,93 @@ func (s *InternalLBService) ListInternalLoadBalancerVMs(p *ListInternalLoadBalan
 	return &r, nil
 }
 
+// StreamInternalLoadBalancerVMs fetches the results of ListInternalLoadBalancerVMs page by page in the background and sends them on the
+// returned channel. Both returned channels are closed when done. If fetching a page fails, or the context
+// is done before all results are sent, the error is sent on the error channel and fetching stops. A
+// request for a page in flight is cancelled when the context is done. The page and pagesize set in the
+// params are ignored.
+func (s *InternalLBService) StreamInternalLoadBalancerVMs(ctx context.Context, p *ListInternalLoadBalancerVMsParams) (<-chan *InternalLoadBalancerVM, <-chan error) {
+	ch := make(chan *InternalLoadBalancerVM)
+	errs := make(chan error, 1)
+
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesize(500)
+
+	go func() {
+		defer close(errs)
+		defer close(ch)
+
+		for page, count := 1, 0; ; page++ {
+			pp.SetPage(page)
+
+			l, err := s.ListInternalLoadBalancerVMsWithContext(ctx, pp)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			for _, v := range l.InternalLoadBalancerVMs {
+				select {
+				case ch <- v:
+				case <-ctx.Done():
+					errs <- ctx.Err()
+					return
+				}
+			}
+
+			count += len(l.InternalLoadBalancerVMs)
+			if len(l.InternalLoadBalancerVMs) < 500 || count >= l.Count {
+				return
+			}
+		}
+	}()
+
+	return ch, errs
+}
+
+// ListInternalLoadBalancerVMsWhere returns all results of ListInternalLoadBalancerVMs for which pred returns true. Note that the results are
+// filtered client-side, so all results matching the params are fetched first.
+// All pages are fetched, so the page and pagesize set in the params are ignored.
+func (s *InternalLBService) ListInternalLoadBalancerVMsWhere(p *ListInternalLoadBalancerVMsParams, pred func(*InternalLoadBalancerVM) bool) ([]*InternalLoadBalancerVM, error) {
+	var r []*InternalLoadBalancerVM
+	ch, errs := s.StreamInternalLoadBalancerVMs(context.Background(), p)
+	for v := range ch {
+		if pred(v) {
+			r = append(r, v)
+		}
+	}
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ListAllInternalLoadBalancerVMs returns the results of ListInternalLoadBalancerVMs across all pages, by calling ListInternalLoadBalancerVMs page by page until all
+// results are fetched. The pagesize set in the params is used for every page (500 if not set), and the
+// page set in the params is ignored.
+func (s *InternalLBService) ListAllInternalLoadBalancerVMs(p *ListInternalLoadBalancerVMsParams) ([]*InternalLoadBalancerVM, error) {
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesizeIfUnset(500)
+	pagesize := pp.p["pagesize"].(int)
+
+	var r []*InternalLoadBalancerVM
+	for page := 1; ; page++ {
+		pp.SetPage(page)
+
+		l, err := s.ListInternalLoadBalancerVMs(pp)
+		if err != nil {
+			return nil, err
+		}
+		r = append(r, l.InternalLoadBalancerVMs...)
+
+		if len(l.InternalLoadBalancerVMs) < pagesize || len(r) >= l.Count {
+			return r, nil
+		}
+	}
+}
+
 type ListInternalLoadBalancerVMsResponse struct {
 	Count                   int                       `json:"count"`
 	InternalLoadBalancerVMs []*InternalLoadBalancerVM `json:"internalloadbalancervm"`
@@ -725,6 +1293,16 @@ type StartInternalLoadBalancerVMParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *StartInternalLoadBalancerVMParams) Clone() *StartInternalLoadBalancerVMParams {
+	c := &StartInternalLoadBalancerVMParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *StartInternalLoadBalancerVMParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -736,6 +1314,16 @@ func (p *StartInternalLoadBalancerVMParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *StartInternalLoadBalancerVMParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of startInternalLoadBalancerVM", k)
+		}
+	}
+	return nil
+}
+
 func (p *StartInternalLoadBalancerVMParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -744,18 +1332,54 @@ func (p *StartInternalLoadBalancerVMParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *StartInternalLoadBalancerVMParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 // You should always use this function to get a new StartInternalLoadBalancerVMParams instance,
-// as then you are sure you have configured all required params
-func (s *InternalLBService) NewStartInternalLoadBalancerVMParams(id string) *StartInternalLoadBalancerVMParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *InternalLBService) NewStartInternalLoadBalancerVMParams(id string, opts ...func(*StartInternalLoadBalancerVMParams)) *StartInternalLoadBalancerVMParams {
 	p := &StartInternalLoadBalancerVMParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Starts an existing internal lb vm.
-func (s *InternalLBService) StartInternalLoadBalancerVM(p *StartInternalLoadBalancerVMParams) (*StartInternalLoadBalancerVMResponse, error) {
-	resp, err := s.cs.newRequest("startInternalLoadBalancerVM", p.toURLValues())
+func (s *InternalLBService) StartInternalLoadBalancerVM(p *StartInternalLoadBalancerVMParams, opts ...OptionFunc) (*StartInternalLoadBalancerVMResponse, error) {
+	return s.StartInternalLoadBalancerVMWithContext(context.Background(), p, opts...)
+}
+
+// StartInternalLoadBalancerVMWithContext is the same as StartInternalLoadBalancerVM, but the request is cancelled when the context is done
+func (s *InternalLBService) StartInternalLoadBalancerVMWithContext(ctx context.Context, p *StartInternalLoadBalancerVMParams, opts ...OptionFunc) (*StartInternalLoadBalancerVMResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.StartInternalLoadBalancerVMRawWithContext(ctx, p.toURLValues())
+}
+
+// StartInternalLoadBalancerVMRaw is the same as StartInternalLoadBalancerVM, but takes the params as url.Values instead of typed params
+func (s *InternalLBService) StartInternalLoadBalancerVMRaw(v url.Values) (*StartInternalLoadBalancerVMResponse, error) {
+	return s.StartInternalLoadBalancerVMRawWithContext(context.Background(), v)
+}
+
+// StartInternalLoadBalancerVMRawWithContext is the same as StartInternalLoadBalancerVMRaw, but the request is cancelled when the context is done
+func (s *InternalLBService) StartInternalLoadBalancerVMRawWithContext(ctx context.Context, v url.Values) (*StartInternalLoadBalancerVMResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "startInternalLoadBalancerVM", "startinternalloadbalancervmresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -767,9 +1391,9 @@ func (s *InternalLBService) StartInternalLoadBalancerVM(p *StartInternalLoadBala
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -866,6 +1490,16 @@ type StopInternalLoadBalancerVMParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *StopInternalLoadBalancerVMParams) Clone() *StopInternalLoadBalancerVMParams {
+	c := &StopInternalLoadBalancerVMParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *StopInternalLoadBalancerVMParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -881,6 +1515,16 @@ func (p *StopInternalLoadBalancerVMParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *StopInternalLoadBalancerVMParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of stopInternalLoadBalancerVM", k)
+		}
+	}
+	return nil
+}
+
 func (p *StopInternalLoadBalancerVMParams) SetForced(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -889,6 +1533,13 @@ func (p *StopInternalLoadBalancerVMParams) SetForced(v bool) {
 	return
 }
 
+// SetForcedIfUnset sets forced to v, unless it is already set
+func (p *StopInternalLoadBalancerVMParams) SetForcedIfUnset(v bool) {
+	if _, found := p.p["forced"]; !found {
+		p.SetForced(v)
+	}
+}
+
 func (p *StopInternalLoadBalancerVMParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -897,18 +1548,54 @@ func (p *StopInternalLoadBalancerVMParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *StopInternalLoadBalancerVMParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 // You should always use this function to get a new StopInternalLoadBalancerVMParams instance,
-// as then you are sure you have configured all required params
-func (s *InternalLBService) NewStopInternalLoadBalancerVMParams(id string) *StopInternalLoadBalancerVMParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *InternalLBService) NewStopInternalLoadBalancerVMParams(id string, opts ...func(*StopInternalLoadBalancerVMParams)) *StopInternalLoadBalancerVMParams {
 	p := &StopInternalLoadBalancerVMParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Stops an Internal LB vm.
-func (s *InternalLBService) StopInternalLoadBalancerVM(p *StopInternalLoadBalancerVMParams) (*StopInternalLoadBalancerVMResponse, error) {
-	resp, err := s.cs.newRequest("stopInternalLoadBalancerVM", p.toURLValues())
+func (s *InternalLBService) StopInternalLoadBalancerVM(p *StopInternalLoadBalancerVMParams, opts ...OptionFunc) (*StopInternalLoadBalancerVMResponse, error) {
+	return s.StopInternalLoadBalancerVMWithContext(context.Background(), p, opts...)
+}
+
+// StopInternalLoadBalancerVMWithContext is the same as StopInternalLoadBalancerVM, but the request is cancelled when the context is done
+func (s *InternalLBService) StopInternalLoadBalancerVMWithContext(ctx context.Context, p *StopInternalLoadBalancerVMParams, opts ...OptionFunc) (*StopInternalLoadBalancerVMResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.StopInternalLoadBalancerVMRawWithContext(ctx, p.toURLValues())
+}
+
+// StopInternalLoadBalancerVMRaw is the same as StopInternalLoadBalancerVM, but takes the params as url.Values instead of typed params
+func (s *InternalLBService) StopInternalLoadBalancerVMRaw(v url.Values) (*StopInternalLoadBalancerVMResponse, error) {
+	return s.StopInternalLoadBalancerVMRawWithContext(context.Background(), v)
+}
+
+// StopInternalLoadBalancerVMRawWithContext is the same as StopInternalLoadBalancerVMRaw, but the request is cancelled when the context is done
+func (s *InternalLBService) StopInternalLoadBalancerVMRawWithContext(ctx context.Context, v url.Values) (*StopInternalLoadBalancerVMResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "stopInternalLoadBalancerVM", "stopinternalloadbalancervmresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -920,9 +1607,9 @@ func (s *InternalLBService) StopInternalLoadBalancerVM(p *StopInternalLoadBalanc
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -17,6 +17,7 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
@@ -27,6 +28,16 @@ type AddLdapConfigurationParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *AddLdapConfigurationParams) Clone() *AddLdapConfigurationParams {
+	c := &AddLdapConfigurationParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *AddLdapConfigurationParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -42,6 +53,16 @@ func (p *AddLdapConfigurationParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *AddLdapConfigurationParams) validate() error {
+	for _, k := range []string{"hostname", "port"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of addLdapConfiguration", k)
+		}
+	}
+	return nil
+}
+
 func (p *AddLdapConfigurationParams) SetHostname(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -50,6 +71,13 @@ func (p *AddLdapConfigurationParams) SetHostname(v string) {
 	return
 }
 
+// SetHostnameIfUnset sets hostname to v, unless it is already set
+func (p *AddLdapConfigurationParams) SetHostnameIfUnset(v string) {
+	if _, found := p.p["hostname"]; !found {
+		p.SetHostname(v)
+	}
+}
+
 func (p *AddLdapConfigurationParams) SetPort(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -58,19 +86,55 @@ func (p *AddLdapConfigurationParams) SetPort(v int) {
 	return
 }
 
+// SetPortIfUnset sets port to v, unless it is already set
+func (p *AddLdapConfigurationParams) SetPortIfUnset(v int) {
+	if _, found := p.p["port"]; !found {
+		p.SetPort(v)
+	}
+}
+
 // You should always use this function to get a new AddLdapConfigurationParams instance,
-// as then you are sure you have configured all required params
-func (s *LDAPService) NewAddLdapConfigurationParams(hostname string, port int) *AddLdapConfigurationParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *LDAPService) NewAddLdapConfigurationParams(hostname string, port int, opts ...func(*AddLdapConfigurationParams)) *AddLdapConfigurationParams {
 	p := &AddLdapConfigurationParams{}
 	p.p = make(map[string]interface{})
 	p.p["hostname"] = hostname
 	p.p["port"] = port
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Add a new Ldap Configuration
-func (s *LDAPService) AddLdapConfiguration(p *AddLdapConfigurationParams) (*AddLdapConfigurationResponse, error) {
-	resp, err := s.cs.newRequest("addLdapConfiguration", p.toURLValues())
+func (s *LDAPService) AddLdapConfiguration(p *AddLdapConfigurationParams, opts ...OptionFunc) (*AddLdapConfigurationResponse, error) {
+	return s.AddLdapConfigurationWithContext(context.Background(), p, opts...)
+}
+
+// AddLdapConfigurationWithContext is the same as AddLdapConfiguration, but the request is cancelled when the context is done
+func (s *LDAPService) AddLdapConfigurationWithContext(ctx context.Context, p *AddLdapConfigurationParams, opts ...OptionFunc) (*AddLdapConfigurationResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.AddLdapConfigurationRawWithContext(ctx, p.toURLValues())
+}
+
+// AddLdapConfigurationRaw is the same as AddLdapConfiguration, but takes the params as url.Values instead of typed params
+func (s *LDAPService) AddLdapConfigurationRaw(v url.Values) (*AddLdapConfigurationResponse, error) {
+	return s.AddLdapConfigurationRawWithContext(context.Background(), v)
+}
+
+// AddLdapConfigurationRawWithContext is the same as AddLdapConfigurationRaw, but the request is cancelled when the context is done
+func (s *LDAPService) AddLdapConfigurationRawWithContext(ctx context.Context, v url.Values) (*AddLdapConfigurationResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "addLdapConfiguration", "ldapconfigurationresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -92,6 +156,16 @@ type DeleteLdapConfigurationParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *DeleteLdapConfigurationParams) Clone() *DeleteLdapConfigurationParams {
+	c := &DeleteLdapConfigurationParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeleteLdapConfigurationParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -103,6 +177,16 @@ func (p *DeleteLdapConfigurationParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *DeleteLdapConfigurationParams) validate() error {
+	for _, k := range []string{"hostname"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of deleteLdapConfiguration", k)
+		}
+	}
+	return nil
+}
+
 func (p *DeleteLdapConfigurationParams) SetHostname(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -111,18 +195,54 @@ func (p *DeleteLdapConfigurationParams) SetHostname(v string) {
 	return
 }
 
+// SetHostnameIfUnset sets hostname to v, unless it is already set
+func (p *DeleteLdapConfigurationParams) SetHostnameIfUnset(v string) {
+	if _, found := p.p["hostname"]; !found {
+		p.SetHostname(v)
+	}
+}
+
 // You should always use this function to get a new DeleteLdapConfigurationParams instance,
-// as then you are sure you have configured all required params
-func (s *LDAPService) NewDeleteLdapConfigurationParams(hostname string) *DeleteLdapConfigurationParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *LDAPService) NewDeleteLdapConfigurationParams(hostname string, opts ...func(*DeleteLdapConfigurationParams)) *DeleteLdapConfigurationParams {
 	p := &DeleteLdapConfigurationParams{}
 	p.p = make(map[string]interface{})
 	p.p["hostname"] = hostname
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Remove an Ldap Configuration
-func (s *LDAPService) DeleteLdapConfiguration(p *DeleteLdapConfigurationParams) (*DeleteLdapConfigurationResponse, error) {
-	resp, err := s.cs.newRequest("deleteLdapConfiguration", p.toURLValues())
+func (s *LDAPService) DeleteLdapConfiguration(p *DeleteLdapConfigurationParams, opts ...OptionFunc) (*DeleteLdapConfigurationResponse, error) {
+	return s.DeleteLdapConfigurationWithContext(context.Background(), p, opts...)
+}
+
+// DeleteLdapConfigurationWithContext is the same as DeleteLdapConfiguration, but the request is cancelled when the context is done
+func (s *LDAPService) DeleteLdapConfigurationWithContext(ctx context.Context, p *DeleteLdapConfigurationParams, opts ...OptionFunc) (*DeleteLdapConfigurationResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.DeleteLdapConfigurationRawWithContext(ctx, p.toURLValues())
+}
+
+// DeleteLdapConfigurationRaw is the same as DeleteLdapConfiguration, but takes the params as url.Values instead of typed params
+func (s *LDAPService) DeleteLdapConfigurationRaw(v url.Values) (*DeleteLdapConfigurationResponse, error) {
+	return s.DeleteLdapConfigurationRawWithContext(context.Background(), v)
+}
+
+// DeleteLdapConfigurationRawWithContext is the same as DeleteLdapConfigurationRaw, but the request is cancelled when the context is done
+func (s *LDAPService) DeleteLdapConfigurationRawWithContext(ctx context.Context, v url.Values) (*DeleteLdapConfigurationResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteLdapConfiguration", "ldapconfigurationresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -144,6 +264,16 @@ type ImportLdapUsersParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ImportLdapUsersParams) Clone() *ImportLdapUsersParams {
+	c := &ImportLdapUsersParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ImportLdapUsersParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -153,11 +283,10 @@ func (p *ImportLdapUsersParams) toURLValues() url.Values {
 		u.Set("account", v.(string))
 	}
 	if v, found := p.p["accountdetails"]; found {
-		i := 0
-		for k, vv := range v.(map[string]string) {
+		m := v.(map[string]string)
+		for i, k := range getSortedKeysFromMap(m) {
 			u.Set(fmt.Sprintf("accountdetails[%d].key", i), k)
-			u.Set(fmt.Sprintf("accountdetails[%d].value", i), vv)
-			i++
+			u.Set(fmt.Sprintf("accountdetails[%d].value", i), m[k])
 		}
 	}
 	if v, found := p.p["accounttype"]; found {
@@ -198,6 +327,13 @@ func (p *ImportLdapUsersParams) SetAccount(v string) {
 	return
 }
 
+// SetAccountIfUnset sets account to v, unless it is already set
+func (p *ImportLdapUsersParams) SetAccountIfUnset(v string) {
+	if _, found := p.p["account"]; !found {
+		p.SetAccount(v)
+	}
+}
+
 func (p *ImportLdapUsersParams) SetAccountdetails(v map[string]string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -206,6 +342,13 @@ func (p *ImportLdapUsersParams) SetAccountdetails(v map[string]string) {
 	return
 }
 
+// SetAccountdetailsIfUnset sets accountdetails to v, unless it is already set
+func (p *ImportLdapUsersParams) SetAccountdetailsIfUnset(v map[string]string) {
+	if _, found := p.p["accountdetails"]; !found {
+		p.SetAccountdetails(v)
+	}
+}
+
 func (p *ImportLdapUsersParams) SetAccounttype(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -214,6 +357,13 @@ func (p *ImportLdapUsersParams) SetAccounttype(v int) {
 	return
 }
 
+// SetAccounttypeIfUnset sets accounttype to v, unless it is already set
+func (p *ImportLdapUsersParams) SetAccounttypeIfUnset(v int) {
+	if _, found := p.p["accounttype"]; !found {
+		p.SetAccounttype(v)
+	}
+}
+
 func (p *ImportLdapUsersParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -222,6 +372,13 @@ func (p *ImportLdapUsersParams) SetDomainid(v string) {
 	return
 }
 
+// SetDomainidIfUnset sets domainid to v, unless it is already set
+func (p *ImportLdapUsersParams) SetDomainidIfUnset(v string) {
+	if _, found := p.p["domainid"]; !found {
+		p.SetDomainid(v)
+	}
+}
+
 func (p *ImportLdapUsersParams) SetGroup(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -230,6 +387,13 @@ func (p *ImportLdapUsersParams) SetGroup(v string) {
 	return
 }
 
+// SetGroupIfUnset sets group to v, unless it is already set
+func (p *ImportLdapUsersParams) SetGroupIfUnset(v string) {
+	if _, found := p.p["group"]; !found {
+		p.SetGroup(v)
+	}
+}
+
 func (p *ImportLdapUsersParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -238,6 +402,13 @@ func (p *ImportLdapUsersParams) SetKeyword(v string) {
 	return
 }
 
+// SetKeywordIfUnset sets keyword to v, unless it is already set
+func (p *ImportLdapUsersParams) SetKeywordIfUnset(v string) {
+	if _, found := p.p["keyword"]; !found {
+		p.SetKeyword(v)
+	}
+}
+
 func (p *ImportLdapUsersParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -246,6 +417,13 @@ func (p *ImportLdapUsersParams) SetPage(v int) {
 	return
 }
 
+// SetPageIfUnset sets page to v, unless it is already set
+func (p *ImportLdapUsersParams) SetPageIfUnset(v int) {
+	if _, found := p.p["page"]; !found {
+		p.SetPage(v)
+	}
+}
+
 func (p *ImportLdapUsersParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -254,6 +432,13 @@ func (p *ImportLdapUsersParams) SetPagesize(v int) {
 	return
 }
 
+// SetPagesizeIfUnset sets pagesize to v, unless it is already set
+func (p *ImportLdapUsersParams) SetPagesizeIfUnset(v int) {
+	if _, found := p.p["pagesize"]; !found {
+		p.SetPagesize(v)
+	}
+}
+
 func (p *ImportLdapUsersParams) SetRoleid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -262,6 +447,13 @@ func (p *ImportLdapUsersParams) SetRoleid(v string) {
 	return
 }
 
+// SetRoleidIfUnset sets roleid to v, unless it is already set
+func (p *ImportLdapUsersParams) SetRoleidIfUnset(v string) {
+	if _, found := p.p["roleid"]; !found {
+		p.SetRoleid(v)
+	}
+}
+
 func (p *ImportLdapUsersParams) SetTimezone(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -270,17 +462,49 @@ func (p *ImportLdapUsersParams) SetTimezone(v string) {
 	return
 }
 
+// SetTimezoneIfUnset sets timezone to v, unless it is already set
+func (p *ImportLdapUsersParams) SetTimezoneIfUnset(v string) {
+	if _, found := p.p["timezone"]; !found {
+		p.SetTimezone(v)
+	}
+}
+
 // You should always use this function to get a new ImportLdapUsersParams instance,
-// as then you are sure you have configured all required params
-func (s *LDAPService) NewImportLdapUsersParams() *ImportLdapUsersParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *LDAPService) NewImportLdapUsersParams(opts ...func(*ImportLdapUsersParams)) *ImportLdapUsersParams {
 	p := &ImportLdapUsersParams{}
 	p.p = make(map[string]interface{})
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Import LDAP users
-func (s *LDAPService) ImportLdapUsers(p *ImportLdapUsersParams) (*ImportLdapUsersResponse, error) {
-	resp, err := s.cs.newRequest("importLdapUsers", p.toURLValues())
+func (s *LDAPService) ImportLdapUsers(p *ImportLdapUsersParams, opts ...OptionFunc) (*ImportLdapUsersResponse, error) {
+	return s.ImportLdapUsersWithContext(context.Background(), p, opts...)
+}
+
+// ImportLdapUsersWithContext is the same as ImportLdapUsers, but the request is cancelled when the context is done
+func (s *LDAPService) ImportLdapUsersWithContext(ctx context.Context, p *ImportLdapUsersParams, opts ...OptionFunc) (*ImportLdapUsersResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	return s.ImportLdapUsersRawWithContext(ctx, p.toURLValues())
+}
+
+// ImportLdapUsersRaw is the same as ImportLdapUsers, but takes the params as url.Values instead of typed params
+func (s *LDAPService) ImportLdapUsersRaw(v url.Values) (*ImportLdapUsersResponse, error) {
+	return s.ImportLdapUsersRawWithContext(context.Background(), v)
+}
+
+// ImportLdapUsersRawWithContext is the same as ImportLdapUsersRaw, but the request is cancelled when the context is done
+func (s *LDAPService) ImportLdapUsersRawWithContext(ctx context.Context, v url.Values) (*ImportLdapUsersResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "importLdapUsers", "ldapuserresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -306,6 +530,16 @@ type LdapConfigParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *LdapConfigParams) Clone() *LdapConfigParams {
+	c := &LdapConfigParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *LdapConfigParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -355,6 +589,13 @@ func (p *LdapConfigParams) SetBinddn(v string) {
 	return
 }
 
+// SetBinddnIfUnset sets binddn to v, unless it is already set
+func (p *LdapConfigParams) SetBinddnIfUnset(v string) {
+	if _, found := p.p["binddn"]; !found {
+		p.SetBinddn(v)
+	}
+}
+
 func (p *LdapConfigParams) SetBindpass(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -363,6 +604,13 @@ func (p *LdapConfigParams) SetBindpass(v string) {
 	return
 }
 
+// SetBindpassIfUnset sets bindpass to v, unless it is already set
+func (p *LdapConfigParams) SetBindpassIfUnset(v string) {
+	if _, found := p.p["bindpass"]; !found {
+		p.SetBindpass(v)
+	}
+}
+
 func (p *LdapConfigParams) SetHostname(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -371,6 +619,13 @@ func (p *LdapConfigParams) SetHostname(v string) {
 	return
 }
 
+// SetHostnameIfUnset sets hostname to v, unless it is already set
+func (p *LdapConfigParams) SetHostnameIfUnset(v string) {
+	if _, found := p.p["hostname"]; !found {
+		p.SetHostname(v)
+	}
+}
+
 func (p *LdapConfigParams) SetListall(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -379,6 +634,13 @@ func (p *LdapConfigParams) SetListall(v bool) {
 	return
 }
 
+// SetListallIfUnset sets listall to v, unless it is already set
+func (p *LdapConfigParams) SetListallIfUnset(v bool) {
+	if _, found := p.p["listall"]; !found {
+		p.SetListall(v)
+	}
+}
+
 func (p *LdapConfigParams) SetPort(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -387,6 +649,13 @@ func (p *LdapConfigParams) SetPort(v int) {
 	return
 }
 
+// SetPortIfUnset sets port to v, unless it is already set
+func (p *LdapConfigParams) SetPortIfUnset(v int) {
+	if _, found := p.p["port"]; !found {
+		p.SetPort(v)
+	}
+}
+
 func (p *LdapConfigParams) SetQueryfilter(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -395,6 +664,13 @@ func (p *LdapConfigParams) SetQueryfilter(v string) {
 	return
 }
 
+// SetQueryfilterIfUnset sets queryfilter to v, unless it is already set
+func (p *LdapConfigParams) SetQueryfilterIfUnset(v string) {
+	if _, found := p.p["queryfilter"]; !found {
+		p.SetQueryfilter(v)
+	}
+}
+
 func (p *LdapConfigParams) SetSearchbase(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -403,6 +679,13 @@ func (p *LdapConfigParams) SetSearchbase(v string) {
 	return
 }
 
+// SetSearchbaseIfUnset sets searchbase to v, unless it is already set
+func (p *LdapConfigParams) SetSearchbaseIfUnset(v string) {
+	if _, found := p.p["searchbase"]; !found {
+		p.SetSearchbase(v)
+	}
+}
+
 func (p *LdapConfigParams) SetSsl(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -411,6 +694,13 @@ func (p *LdapConfigParams) SetSsl(v bool) {
 	return
 }
 
+// SetSslIfUnset sets ssl to v, unless it is already set
+func (p *LdapConfigParams) SetSslIfUnset(v bool) {
+	if _, found := p.p["ssl"]; !found {
+		p.SetSsl(v)
+	}
+}
+
 func (p *LdapConfigParams) SetTruststore(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -419,6 +709,13 @@ func (p *LdapConfigParams) SetTruststore(v string) {
 	return
 }
 
+// SetTruststoreIfUnset sets truststore to v, unless it is already set
+func (p *LdapConfigParams) SetTruststoreIfUnset(v string) {
+	if _, found := p.p["truststore"]; !found {
+		p.SetTruststore(v)
+	}
+}
+
 func (p *LdapConfigParams) SetTruststorepass(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -427,17 +724,51 @@ func (p *LdapConfigParams) SetTruststorepass(v string) {
 	return
 }
 
+// SetTruststorepassIfUnset sets truststorepass to v, unless it is already set
+func (p *LdapConfigParams) SetTruststorepassIfUnset(v string) {
+	if _, found := p.p["truststorepass"]; !found {
+		p.SetTruststorepass(v)
+	}
+}
+
+var _ ListAllSetter = (*LdapConfigParams)(nil)
+
 // You should always use this function to get a new LdapConfigParams instance,
-// as then you are sure you have configured all required params
-func (s *LDAPService) NewLdapConfigParams() *LdapConfigParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *LDAPService) NewLdapConfigParams(opts ...func(*LdapConfigParams)) *LdapConfigParams {
 	p := &LdapConfigParams{}
 	p.p = make(map[string]interface{})
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Configure the LDAP context for this site.
-func (s *LDAPService) LdapConfig(p *LdapConfigParams) (*LdapConfigResponse, error) {
-	resp, err := s.cs.newRequest("ldapConfig", p.toURLValues())
+func (s *LDAPService) LdapConfig(p *LdapConfigParams, opts ...OptionFunc) (*LdapConfigResponse, error) {
+	return s.LdapConfigWithContext(context.Background(), p, opts...)
+}
+
+// LdapConfigWithContext is the same as LdapConfig, but the request is cancelled when the context is done
+func (s *LDAPService) LdapConfigWithContext(ctx context.Context, p *LdapConfigParams, opts ...OptionFunc) (*LdapConfigResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	return s.LdapConfigRawWithContext(ctx, p.toURLValues())
+}
+
+// LdapConfigRaw is the same as LdapConfig, but takes the params as url.Values instead of typed params
+func (s *LDAPService) LdapConfigRaw(v url.Values) (*LdapConfigResponse, error) {
+	return s.LdapConfigRawWithContext(context.Background(), v)
+}
+
+// LdapConfigRawWithContext is the same as LdapConfigRaw, but the request is cancelled when the context is done
+func (s *LDAPService) LdapConfigRawWithContext(ctx context.Context, v url.Values) (*LdapConfigResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "ldapConfig", "ldapconfigresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -464,6 +795,16 @@ type LdapCreateAccountParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *LdapCreateAccountParams) Clone() *LdapCreateAccountParams {
+	c := &LdapCreateAccountParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *LdapCreateAccountParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -473,11 +814,10 @@ func (p *LdapCreateAccountParams) toURLValues() url.Values {
 		u.Set("account", v.(string))
 	}
 	if v, found := p.p["accountdetails"]; found {
-		i := 0
-		for k, vv := range v.(map[string]string) {
+		m := v.(map[string]string)
+		for i, k := range getSortedKeysFromMap(m) {
 			u.Set(fmt.Sprintf("accountdetails[%d].key", i), k)
-			u.Set(fmt.Sprintf("accountdetails[%d].value", i), vv)
-			i++
+			u.Set(fmt.Sprintf("accountdetails[%d].value", i), m[k])
 		}
 	}
 	if v, found := p.p["accountid"]; found {
@@ -508,6 +848,16 @@ func (p *LdapCreateAccountParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *LdapCreateAccountParams) validate() error {
+	for _, k := range []string{"username"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of ldapCreateAccount", k)
+		}
+	}
+	return nil
+}
+
 func (p *LdapCreateAccountParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -516,6 +866,13 @@ func (p *LdapCreateAccountParams) SetAccount(v string) {
 	return
 }
 
+// SetAccountIfUnset sets account to v, unless it is already set
+func (p *LdapCreateAccountParams) SetAccountIfUnset(v string) {
+	if _, found := p.p["account"]; !found {
+		p.SetAccount(v)
+	}
+}
+
 func (p *LdapCreateAccountParams) SetAccountdetails(v map[string]string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -524,6 +881,13 @@ func (p *LdapCreateAccountParams) SetAccountdetails(v map[string]string) {
 	return
 }
 
+// SetAccountdetailsIfUnset sets accountdetails to v, unless it is already set
+func (p *LdapCreateAccountParams) SetAccountdetailsIfUnset(v map[string]string) {
+	if _, found := p.p["accountdetails"]; !found {
+		p.SetAccountdetails(v)
+	}
+}
+
 func (p *LdapCreateAccountParams) SetAccountid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -532,6 +896,13 @@ func (p *LdapCreateAccountParams) SetAccountid(v string) {
 	return
 }
 
+// SetAccountidIfUnset sets accountid to v, unless it is already set
+func (p *LdapCreateAccountParams) SetAccountidIfUnset(v string) {
+	if _, found := p.p["accountid"]; !found {
+		p.SetAccountid(v)
+	}
+}
+
 func (p *LdapCreateAccountParams) SetAccounttype(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -540,6 +911,13 @@ func (p *LdapCreateAccountParams) SetAccounttype(v int) {
 	return
 }
 
+// SetAccounttypeIfUnset sets accounttype to v, unless it is already set
+func (p *LdapCreateAccountParams) SetAccounttypeIfUnset(v int) {
+	if _, found := p.p["accounttype"]; !found {
+		p.SetAccounttype(v)
+	}
+}
+
 func (p *LdapCreateAccountParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -548,6 +926,13 @@ func (p *LdapCreateAccountParams) SetDomainid(v string) {
 	return
 }
 
+// SetDomainidIfUnset sets domainid to v, unless it is already set
+func (p *LdapCreateAccountParams) SetDomainidIfUnset(v string) {
+	if _, found := p.p["domainid"]; !found {
+		p.SetDomainid(v)
+	}
+}
+
 func (p *LdapCreateAccountParams) SetNetworkdomain(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -556,6 +941,13 @@ func (p *LdapCreateAccountParams) SetNetworkdomain(v string) {
 	return
 }
 
+// SetNetworkdomainIfUnset sets networkdomain to v, unless it is already set
+func (p *LdapCreateAccountParams) SetNetworkdomainIfUnset(v string) {
+	if _, found := p.p["networkdomain"]; !found {
+		p.SetNetworkdomain(v)
+	}
+}
+
 func (p *LdapCreateAccountParams) SetRoleid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -564,6 +956,13 @@ func (p *LdapCreateAccountParams) SetRoleid(v string) {
 	return
 }
 
+// SetRoleidIfUnset sets roleid to v, unless it is already set
+func (p *LdapCreateAccountParams) SetRoleidIfUnset(v string) {
+	if _, found := p.p["roleid"]; !found {
+		p.SetRoleid(v)
+	}
+}
+
 func (p *LdapCreateAccountParams) SetTimezone(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -572,6 +971,13 @@ func (p *LdapCreateAccountParams) SetTimezone(v string) {
 	return
 }
 
+// SetTimezoneIfUnset sets timezone to v, unless it is already set
+func (p *LdapCreateAccountParams) SetTimezoneIfUnset(v string) {
+	if _, found := p.p["timezone"]; !found {
+		p.SetTimezone(v)
+	}
+}
+
 func (p *LdapCreateAccountParams) SetUserid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -580,6 +986,13 @@ func (p *LdapCreateAccountParams) SetUserid(v string) {
 	return
 }
 
+// SetUseridIfUnset sets userid to v, unless it is already set
+func (p *LdapCreateAccountParams) SetUseridIfUnset(v string) {
+	if _, found := p.p["userid"]; !found {
+		p.SetUserid(v)
+	}
+}
+
 func (p *LdapCreateAccountParams) SetUsername(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -588,18 +1001,54 @@ func (p *LdapCreateAccountParams) SetUsername(v string) {
 	return
 }
 
+// SetUsernameIfUnset sets username to v, unless it is already set
+func (p *LdapCreateAccountParams) SetUsernameIfUnset(v string) {
+	if _, found := p.p["username"]; !found {
+		p.SetUsername(v)
+	}
+}
+
 // You should always use this function to get a new LdapCreateAccountParams instance,
-// as then you are sure you have configured all required params
-func (s *LDAPService) NewLdapCreateAccountParams(username string) *LdapCreateAccountParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *LDAPService) NewLdapCreateAccountParams(username string, opts ...func(*LdapCreateAccountParams)) *LdapCreateAccountParams {
 	p := &LdapCreateAccountParams{}
 	p.p = make(map[string]interface{})
 	p.p["username"] = username
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Creates an account from an LDAP user
-func (s *LDAPService) LdapCreateAccount(p *LdapCreateAccountParams) (*LdapCreateAccountResponse, error) {
-	resp, err := s.cs.newRequest("ldapCreateAccount", p.toURLValues())
+func (s *LDAPService) LdapCreateAccount(p *LdapCreateAccountParams, opts ...OptionFunc) (*LdapCreateAccountResponse, error) {
+	return s.LdapCreateAccountWithContext(context.Background(), p, opts...)
+}
+
+// LdapCreateAccountWithContext is the same as LdapCreateAccount, but the request is cancelled when the context is done
+func (s *LDAPService) LdapCreateAccountWithContext(ctx context.Context, p *LdapCreateAccountParams, opts ...OptionFunc) (*LdapCreateAccountResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.LdapCreateAccountRawWithContext(ctx, p.toURLValues())
+}
+
+// LdapCreateAccountRaw is the same as LdapCreateAccount, but takes the params as url.Values instead of typed params
+func (s *LDAPService) LdapCreateAccountRaw(v url.Values) (*LdapCreateAccountResponse, error) {
+	return s.LdapCreateAccountRawWithContext(context.Background(), v)
+}
+
+// LdapCreateAccountRawWithContext is the same as LdapCreateAccountRaw, but the request is cancelled when the context is done
+func (s *LDAPService) LdapCreateAccountRawWithContext(ctx context.Context, v url.Values) (*LdapCreateAccountResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "ldapCreateAccount", "createaccountresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -613,50 +1062,50 @@ func (s *LDAPService) LdapCreateAccount(p *LdapCreateAccountParams) (*LdapCreate
 }
 
 type LdapCreateAccountResponse struct {
-	Accountdetails            map[string]string `json:"accountdetails"`
-	Accounttype               int               `json:"accounttype"`
-	Cpuavailable              string            `json:"cpuavailable"`
-	Cpulimit                  string            `json:"cpulimit"`
-	Cputotal                  int64             `json:"cputotal"`
-	Defaultzoneid             string            `json:"defaultzoneid"`
-	Domain                    string            `json:"domain"`
-	Domainid                  string            `json:"domainid"`
-	Groups                    []string          `json:"groups"`
-	Id                        string            `json:"id"`
-	Ipavailable               string            `json:"ipavailable"`
-	Iplimit                   string            `json:"iplimit"`
-	Iptotal                   int64             `json:"iptotal"`
-	Iscleanuprequired         bool              `json:"iscleanuprequired"`
-	Isdefault                 bool              `json:"isdefault"`
-	Memoryavailable           string            `json:"memoryavailable"`
-	Memorylimit               string            `json:"memorylimit"`
-	Memorytotal               int64             `json:"memorytotal"`
-	Name                      string            `json:"name"`
-	Networkavailable          string            `json:"networkavailable"`
-	Networkdomain             string            `json:"networkdomain"`
-	Networklimit              string            `json:"networklimit"`
-	Networktotal              int64             `json:"networktotal"`
-	Primarystorageavailable   string            `json:"primarystorageavailable"`
-	Primarystoragelimit       string            `json:"primarystoragelimit"`
-	Primarystoragetotal       int64             `json:"primarystoragetotal"`
-	Projectavailable          string            `json:"projectavailable"`
-	Projectlimit              string            `json:"projectlimit"`
-	Projecttotal              int64             `json:"projecttotal"`
-	Receivedbytes             int64             `json:"receivedbytes"`
-	Roleid                    string            `json:"roleid"`
-	Rolename                  string            `json:"rolename"`
-	Roletype                  string            `json:"roletype"`
-	Secondarystorageavailable string            `json:"secondarystorageavailable"`
-	Secondarystoragelimit     string            `json:"secondarystoragelimit"`
-	Secondarystoragetotal     int64             `json:"secondarystoragetotal"`
-	Sentbytes                 int64             `json:"sentbytes"`
-	Snapshotavailable         string            `json:"snapshotavailable"`
-	Snapshotlimit             string            `json:"snapshotlimit"`
-	Snapshottotal             int64             `json:"snapshottotal"`
-	State                     string            `json:"state"`
-	Templateavailable         string            `json:"templateavailable"`
-	Templatelimit             string            `json:"templatelimit"`
-	Templatetotal             int64             `json:"templatetotal"`
+	Accountdetails            StringMap `json:"accountdetails"`
+	Accounttype               int       `json:"accounttype"`
+	Cpuavailable              string    `json:"cpuavailable"`
+	Cpulimit                  string    `json:"cpulimit"`
+	Cputotal                  int64     `json:"cputotal"`
+	Defaultzoneid             string    `json:"defaultzoneid"`
+	Domain                    string    `json:"domain"`
+	Domainid                  string    `json:"domainid"`
+	Groups                    []string  `json:"groups"`
+	Id                        string    `json:"id"`
+	Ipavailable               string    `json:"ipavailable"`
+	Iplimit                   string    `json:"iplimit"`
+	Iptotal                   int64     `json:"iptotal"`
+	Iscleanuprequired         bool      `json:"iscleanuprequired"`
+	Isdefault                 bool      `json:"isdefault"`
+	Memoryavailable           string    `json:"memoryavailable"`
+	Memorylimit               string    `json:"memorylimit"`
+	Memorytotal               int64     `json:"memorytotal"`
+	Name                      string    `json:"name"`
+	Networkavailable          string    `json:"networkavailable"`
+	Networkdomain             string    `json:"networkdomain"`
+	Networklimit              string    `json:"networklimit"`
+	Networktotal              int64     `json:"networktotal"`
+	Primarystorageavailable   string    `json:"primarystorageavailable"`
+	Primarystoragelimit       string    `json:"primarystoragelimit"`
+	Primarystoragetotal       int64     `json:"primarystoragetotal"`
+	Projectavailable          string    `json:"projectavailable"`
+	Projectlimit              string    `json:"projectlimit"`
+	Projecttotal              int64     `json:"projecttotal"`
+	Receivedbytes             int64     `json:"receivedbytes"`
+	Roleid                    string    `json:"roleid"`
+	Rolename                  string    `json:"rolename"`
+	Roletype                  string    `json:"roletype"`
+	Secondarystorageavailable string    `json:"secondarystorageavailable"`
+	Secondarystoragelimit     string    `json:"secondarystoragelimit"`
+	Secondarystoragetotal     int64     `json:"secondarystoragetotal"`
+	Sentbytes                 int64     `json:"sentbytes"`
+	Snapshotavailable         string    `json:"snapshotavailable"`
+	Snapshotlimit             string    `json:"snapshotlimit"`
+	Snapshottotal             int64     `json:"snapshottotal"`
+	State                     string    `json:"state"`
+	Templateavailable         string    `json:"templateavailable"`
+	Templatelimit             string    `json:"templatelimit"`
+	Templatetotal             int64     `json:"templatetotal"`
 	User                      []struct {
 		Account             string `json:"account"`
 		Accountid           string `json:"accountid"`
@@ -696,6 +1145,16 @@ type LdapRemoveParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *LdapRemoveParams) Clone() *LdapRemoveParams {
+	c := &LdapRemoveParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *LdapRemoveParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -705,16 +1164,41 @@ func (p *LdapRemoveParams) toURLValues() url.Values {
 }
 
 // You should always use this function to get a new LdapRemoveParams instance,
-// as then you are sure you have configured all required params
-func (s *LDAPService) NewLdapRemoveParams() *LdapRemoveParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *LDAPService) NewLdapRemoveParams(opts ...func(*LdapRemoveParams)) *LdapRemoveParams {
 	p := &LdapRemoveParams{}
 	p.p = make(map[string]interface{})
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Remove the LDAP context for this site.
-func (s *LDAPService) LdapRemove(p *LdapRemoveParams) (*LdapRemoveResponse, error) {
-	resp, err := s.cs.newRequest("ldapRemove", p.toURLValues())
+func (s *LDAPService) LdapRemove(p *LdapRemoveParams, opts ...OptionFunc) (*LdapRemoveResponse, error) {
+	return s.LdapRemoveWithContext(context.Background(), p, opts...)
+}
+
+// LdapRemoveWithContext is the same as LdapRemove, but the request is cancelled when the context is done
+func (s *LDAPService) LdapRemoveWithContext(ctx context.Context, p *LdapRemoveParams, opts ...OptionFunc) (*LdapRemoveResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	return s.LdapRemoveRawWithContext(ctx, p.toURLValues())
+}
+
+// LdapRemoveRaw is the same as LdapRemove, but takes the params as url.Values instead of typed params
+func (s *LDAPService) LdapRemoveRaw(v url.Values) (*LdapRemoveResponse, error) {
+	return s.LdapRemoveRawWithContext(context.Background(), v)
+}
+
+// LdapRemoveRawWithContext is the same as LdapRemoveRaw, but the request is cancelled when the context is done
+func (s *LDAPService) LdapRemoveRawWithContext(ctx context.Context, v url.Values) (*LdapRemoveResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "ldapRemove", "ldapremoveresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -741,6 +1225,16 @@ type LinkDomainToLdapParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *LinkDomainToLdapParams) Clone() *LinkDomainToLdapParams {
+	c := &LinkDomainToLdapParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *LinkDomainToLdapParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -765,6 +1259,16 @@ func (p *LinkDomainToLdapParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *LinkDomainToLdapParams) validate() error {
+	for _, k := range []string{"accounttype", "domainid", "name", "type"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of linkDomainToLdap", k)
+		}
+	}
+	return nil
+}
+
 func (p *LinkDomainToLdapParams) SetAccounttype(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -773,6 +1277,13 @@ func (p *LinkDomainToLdapParams) SetAccounttype(v int) {
 	return
 }
 
+// SetAccounttypeIfUnset sets accounttype to v, unless it is already set
+func (p *LinkDomainToLdapParams) SetAccounttypeIfUnset(v int) {
+	if _, found := p.p["accounttype"]; !found {
+		p.SetAccounttype(v)
+	}
+}
+
 func (p *LinkDomainToLdapParams) SetAdmin(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -781,6 +1292,13 @@ func (p *LinkDomainToLdapParams) SetAdmin(v string) {
 	return
 }
 
+// SetAdminIfUnset sets admin to v, unless it is already set
+func (p *LinkDomainToLdapParams) SetAdminIfUnset(v string) {
+	if _, found := p.p["admin"]; !found {
+		p.SetAdmin(v)
+	}
+}
+
 func (p *LinkDomainToLdapParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -789,6 +1307,13 @@ func (p *LinkDomainToLdapParams) SetDomainid(v string) {
 	return
 }
 
+// SetDomainidIfUnset sets domainid to v, unless it is already set
+func (p *LinkDomainToLdapParams) SetDomainidIfUnset(v string) {
+	if _, found := p.p["domainid"]; !found {
+		p.SetDomainid(v)
+	}
+}
+
 func (p *LinkDomainToLdapParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -797,6 +1322,13 @@ func (p *LinkDomainToLdapParams) SetName(v string) {
 	return
 }
 
+// SetNameIfUnset sets name to v, unless it is already set
+func (p *LinkDomainToLdapParams) SetNameIfUnset(v string) {
+	if _, found := p.p["name"]; !found {
+		p.SetName(v)
+	}
+}
+
 func (p *LinkDomainToLdapParams) SetType(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -805,21 +1337,77 @@ func (p *LinkDomainToLdapParams) SetType(v string) {
 	return
 }
 
+// SetTypeIfUnset sets type to v, unless it is already set
+func (p *LinkDomainToLdapParams) SetTypeIfUnset(v string) {
+	if _, found := p.p["type"]; !found {
+		p.SetType(v)
+	}
+}
+
 // You should always use this function to get a new LinkDomainToLdapParams instance,
-// as then you are sure you have configured all required params
-func (s *LDAPService) NewLinkDomainToLdapParams(accounttype int, domainid string, name string, lDAPType string) *LinkDomainToLdapParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *LDAPService) NewLinkDomainToLdapParams(accounttype int, domainid string, name string, lDAPType string, opts ...func(*LinkDomainToLdapParams)) *LinkDomainToLdapParams {
 	p := &LinkDomainToLdapParams{}
 	p.p = make(map[string]interface{})
 	p.p["accounttype"] = accounttype
 	p.p["domainid"] = domainid
 	p.p["name"] = name
 	p.p["type"] = lDAPType
+	for _, fn := range opts {
+		fn(p)
+	}
+	return p
+}
+
+// LinkDomainToLdapOpts contains all params that can be set on a LinkDomainToLdapParams.
+// Optional params are pointers (or slices and maps) which are only set when not nil.
+type LinkDomainToLdapOpts struct {
+	Accounttype int
+	Admin       *string
+	Domainid    string
+	Name        string
+	Type        string
+}
+
+// NewLinkDomainToLdapParamsFromOpts is an alternative for NewLinkDomainToLdapParams which takes all params using
+// an options struct, as that is easier to read for APIs with many required params
+func (s *LDAPService) NewLinkDomainToLdapParamsFromOpts(o LinkDomainToLdapOpts) *LinkDomainToLdapParams {
+	p := s.NewLinkDomainToLdapParams(o.Accounttype, o.Domainid, o.Name, o.Type)
+	if o.Admin != nil {
+		p.p["admin"] = *o.Admin
+	}
 	return p
 }
 
 // link an existing cloudstack domain to group or OU in ldap
-func (s *LDAPService) LinkDomainToLdap(p *LinkDomainToLdapParams) (*LinkDomainToLdapResponse, error) {
-	resp, err := s.cs.newRequest("linkDomainToLdap", p.toURLValues())
+func (s *LDAPService) LinkDomainToLdap(p *LinkDomainToLdapParams, opts ...OptionFunc) (*LinkDomainToLdapResponse, error) {
+	return s.LinkDomainToLdapWithContext(context.Background(), p, opts...)
+}
+
+// LinkDomainToLdapWithContext is the same as LinkDomainToLdap, but the request is cancelled when the context is done
+func (s *LDAPService) LinkDomainToLdapWithContext(ctx context.Context, p *LinkDomainToLdapParams, opts ...OptionFunc) (*LinkDomainToLdapResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.LinkDomainToLdapRawWithContext(ctx, p.toURLValues())
+}
+
+// LinkDomainToLdapRaw is the same as LinkDomainToLdap, but takes the params as url.Values instead of typed params
+func (s *LDAPService) LinkDomainToLdapRaw(v url.Values) (*LinkDomainToLdapResponse, error) {
+	return s.LinkDomainToLdapRawWithContext(context.Background(), v)
+}
+
+// LinkDomainToLdapRawWithContext is the same as LinkDomainToLdapRaw, but the request is cancelled when the context is done
+func (s *LDAPService) LinkDomainToLdapRawWithContext(ctx context.Context, v url.Values) (*LinkDomainToLdapResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "linkDomainToLdap", "linkdomaintoldapresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -844,6 +1432,16 @@ type ListLdapConfigurationsParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ListLdapConfigurationsParams) Clone() *ListLdapConfigurationsParams {
+	c := &ListLdapConfigurationsParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListLdapConfigurationsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -878,6 +1476,13 @@ func (p *ListLdapConfigurationsParams) SetHostname(v string) {
 	return
 }
 
+// SetHostnameIfUnset sets hostname to v, unless it is already set
+func (p *ListLdapConfigurationsParams) SetHostnameIfUnset(v string) {
+	if _, found := p.p["hostname"]; !found {
+		p.SetHostname(v)
+	}
+}
+
 func (p *ListLdapConfigurationsParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -886,6 +1491,13 @@ func (p *ListLdapConfigurationsParams) SetKeyword(v string) {
 	return
 }
 
+// SetKeywordIfUnset sets keyword to v, unless it is already set
+func (p *ListLdapConfigurationsParams) SetKeywordIfUnset(v string) {
+	if _, found := p.p["keyword"]; !found {
+		p.SetKeyword(v)
+	}
+}
+
 func (p *ListLdapConfigurationsParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -894,6 +1506,13 @@ func (p *ListLdapConfigurationsParams) SetPage(v int) {
 	return
 }
 
+// SetPageIfUnset sets page to v, unless it is already set
+func (p *ListLdapConfigurationsParams) SetPageIfUnset(v int) {
+	if _, found := p.p["page"]; !found {
+		p.SetPage(v)
+	}
+}
+
 func (p *ListLdapConfigurationsParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -902,6 +1521,13 @@ func (p *ListLdapConfigurationsParams) SetPagesize(v int) {
 	return
 }
 
+// SetPagesizeIfUnset sets pagesize to v, unless it is already set
+func (p *ListLdapConfigurationsParams) SetPagesizeIfUnset(v int) {
+	if _, found := p.p["pagesize"]; !found {
+		p.SetPagesize(v)
+	}
+}
+
 func (p *ListLdapConfigurationsParams) SetPort(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -910,17 +1536,43 @@ func (p *ListLdapConfigurationsParams) SetPort(v int) {
 	return
 }
 
+// SetPortIfUnset sets port to v, unless it is already set
+func (p *ListLdapConfigurationsParams) SetPortIfUnset(v int) {
+	if _, found := p.p["port"]; !found {
+		p.SetPort(v)
+	}
+}
+
 // You should always use this function to get a new ListLdapConfigurationsParams instance,
-// as then you are sure you have configured all required params
-func (s *LDAPService) NewListLdapConfigurationsParams() *ListLdapConfigurationsParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *LDAPService) NewListLdapConfigurationsParams(opts ...func(*ListLdapConfigurationsParams)) *ListLdapConfigurationsParams {
 	p := &ListLdapConfigurationsParams{}
 	p.p = make(map[string]interface{})
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Lists all LDAP configurations
 func (s *LDAPService) ListLdapConfigurations(p *ListLdapConfigurationsParams) (*ListLdapConfigurationsResponse, error) {
-	resp, err := s.cs.newRequest("listLdapConfigurations", p.toURLValues())
+	return s.ListLdapConfigurationsWithContext(context.Background(), p)
+}
+
+// ListLdapConfigurationsWithContext is the same as ListLdapConfigurations, but the request is cancelled when the context is done
+func (s *LDAPService) ListLdapConfigurationsWithContext(ctx context.Context, p *ListLdapConfigurationsParams) (*ListLdapConfigurationsResponse, error) {
+	return s.ListLdapConfigurationsRawWithContext(ctx, p.toURLValues())
+}
+
+// ListLdapConfigurationsRaw is the same as ListLdapConfigurations, but takes the params as url.Values instead of typed params
+func (s *LDAPService) ListLdapConfigurationsRaw(v url.Values) (*ListLdapConfigurationsResponse, error) {
+	return s.ListLdapConfigurationsRawWithContext(context.Background(), v)
+}
+
+// ListLdapConfigurationsRawWithContext is the same as ListLdapConfigurationsRaw, but the request is cancelled when the context is done
+func (s *LDAPService) ListLdapConfigurationsRawWithContext(ctx context.Context, v url.Values) (*ListLdapConfigurationsResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "listLdapConfigurations", "ldapconfigurationresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -933,6 +1585,93 @@ func (s *LDAPService) ListLdapConfigurations(p *ListLdapConfigurationsParams) (*
 	return &r, nil
 }
 
+// StreamLdapConfigurations fetches the results of ListLdapConfigurations page by page in the background and sends them on the
+// returned channel. Both returned channels are closed when done. If fetching a page fails, or the context
+// is done before all results are sent, the error is sent on the error channel and fetching stops. A
+// request for a page in flight is cancelled when the context is done. The page and pagesize set in the
+// params are ignored.
+func (s *LDAPService) StreamLdapConfigurations(ctx context.Context, p *ListLdapConfigurationsParams) (<-chan *LdapConfiguration, <-chan error) {
+	ch := make(chan *LdapConfiguration)
+	errs := make(chan error, 1)
+
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesize(500)
+
+	go func() {
+		defer close(errs)
+		defer close(ch)
+
+		for page, count := 1, 0; ; page++ {
+			pp.SetPage(page)
+
+			l, err := s.ListLdapConfigurationsWithContext(ctx, pp)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			for _, v := range l.LdapConfigurations {
+				select {
+				case ch <- v:
+				case <-ctx.Done():
+					errs <- ctx.Err()
+					return
+				}
+			}
+
+			count += len(l.LdapConfigurations)
+			if len(l.LdapConfigurations) < 500 || count >= l.Count {
+				return
+			}
+		}
+	}()
+
+	return ch, errs
+}
+
+// ListLdapConfigurationsWhere returns all results of ListLdapConfigurations for which pred returns true. Note that the results are
+// filtered client-side, so all results matching the params are fetched first.
+// All pages are fetched, so the page and pagesize set in the params are ignored.
+func (s *LDAPService) ListLdapConfigurationsWhere(p *ListLdapConfigurationsParams, pred func(*LdapConfiguration) bool) ([]*LdapConfiguration, error) {
+	var r []*LdapConfiguration
+	ch, errs := s.StreamLdapConfigurations(context.Background(), p)
+	for v := range ch {
+		if pred(v) {
+			r = append(r, v)
+		}
+	}
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ListAllLdapConfigurations returns the results of ListLdapConfigurations across all pages, by calling ListLdapConfigurations page by page until all
+// results are fetched. The pagesize set in the params is used for every page (500 if not set), and the
+// page set in the params is ignored.
+func (s *LDAPService) ListAllLdapConfigurations(p *ListLdapConfigurationsParams) ([]*LdapConfiguration, error) {
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesizeIfUnset(500)
+	pagesize := pp.p["pagesize"].(int)
+
+	var r []*LdapConfiguration
+	for page := 1; ; page++ {
+		pp.SetPage(page)
+
+		l, err := s.ListLdapConfigurations(pp)
+		if err != nil {
+			return nil, err
+		}
+		r = append(r, l.LdapConfigurations...)
+
+		if len(l.LdapConfigurations) < pagesize || len(r) >= l.Count {
+			return r, nil
+		}
+	}
+}
+
 type ListLdapConfigurationsResponse struct {
 	Count              int                  `json:"count"`
 	LdapConfigurations []*LdapConfiguration `json:"ldapconfiguration"`
@@ -947,6 +1686,16 @@ type ListLdapUsersParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ListLdapUsersParams) Clone() *ListLdapUsersParams {
+	c := &ListLdapUsersParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListLdapUsersParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -977,6 +1726,13 @@ func (p *ListLdapUsersParams) SetKeyword(v string) {
 	return
 }
 
+// SetKeywordIfUnset sets keyword to v, unless it is already set
+func (p *ListLdapUsersParams) SetKeywordIfUnset(v string) {
+	if _, found := p.p["keyword"]; !found {
+		p.SetKeyword(v)
+	}
+}
+
 func (p *ListLdapUsersParams) SetListtype(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -985,6 +1741,13 @@ func (p *ListLdapUsersParams) SetListtype(v string) {
 	return
 }
 
+// SetListtypeIfUnset sets listtype to v, unless it is already set
+func (p *ListLdapUsersParams) SetListtypeIfUnset(v string) {
+	if _, found := p.p["listtype"]; !found {
+		p.SetListtype(v)
+	}
+}
+
 func (p *ListLdapUsersParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -993,6 +1756,13 @@ func (p *ListLdapUsersParams) SetPage(v int) {
 	return
 }
 
+// SetPageIfUnset sets page to v, unless it is already set
+func (p *ListLdapUsersParams) SetPageIfUnset(v int) {
+	if _, found := p.p["page"]; !found {
+		p.SetPage(v)
+	}
+}
+
 func (p *ListLdapUsersParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1001,17 +1771,43 @@ func (p *ListLdapUsersParams) SetPagesize(v int) {
 	return
 }
 
+// SetPagesizeIfUnset sets pagesize to v, unless it is already set
+func (p *ListLdapUsersParams) SetPagesizeIfUnset(v int) {
+	if _, found := p.p["pagesize"]; !found {
+		p.SetPagesize(v)
+	}
+}
+
 // You should always use this function to get a new ListLdapUsersParams instance,
-// as then you are sure you have configured all required params
-func (s *LDAPService) NewListLdapUsersParams() *ListLdapUsersParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *LDAPService) NewListLdapUsersParams(opts ...func(*ListLdapUsersParams)) *ListLdapUsersParams {
 	p := &ListLdapUsersParams{}
 	p.p = make(map[string]interface{})
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Lists all LDAP Users
 func (s *LDAPService) ListLdapUsers(p *ListLdapUsersParams) (*ListLdapUsersResponse, error) {
-	resp, err := s.cs.newRequest("listLdapUsers", p.toURLValues())
+	return s.ListLdapUsersWithContext(context.Background(), p)
+}
+
+// ListLdapUsersWithContext is the same as ListLdapUsers, but the request is cancelled when the context is done
+func (s *LDAPService) ListLdapUsersWithContext(ctx context.Context, p *ListLdapUsersParams) (*ListLdapUsersResponse, error) {
+	return s.ListLdapUsersRawWithContext(ctx, p.toURLValues())
+}
+
+// ListLdapUsersRaw is the same as ListLdapUsers, but takes the params as url.Values instead of typed params
+func (s *LDAPService) ListLdapUsersRaw(v url.Values) (*ListLdapUsersResponse, error) {
+	return s.ListLdapUsersRawWithContext(context.Background(), v)
+}
+
+// ListLdapUsersRawWithContext is the same as ListLdapUsersRaw, but the request is cancelled when the context is done
+func (s *LDAPService) ListLdapUsersRawWithContext(ctx context.Context, v url.Values) (*ListLdapUsersResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "listLdapUsers", "ldapuserresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -1024,6 +1820,93 @@ func (s *LDAPService) ListLdapUsers(p *ListLdapUsersParams) (*ListLdapUsersRespo
 	return &r, nil
 }
 
+// StreamLdapUsers fetches the results of ListLdapUsers page by page in the background and sends them on the
+// returned channel. Both returned channels are closed when done. If fetching a page fails, or the context
+// is done before all results are sent, the error is sent on the error channel and fetching stops. A
+// request for a page in flight is cancelled when the context is done. The page and pagesize set in the
+// params are ignored.
+func (s *LDAPService) StreamLdapUsers(ctx context.Context, p *ListLdapUsersParams) (<-chan *LdapUser, <-chan error) {
+	ch := make(chan *LdapUser)
+	errs := make(chan error, 1)
+
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesize(500)
+
+	go func() {
+		defer close(errs)
+		defer close(ch)
+
+		for page, count := 1, 0; ; page++ {
+			pp.SetPage(page)
+
+			l, err := s.ListLdapUsersWithContext(ctx, pp)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			for _, v := range l.LdapUsers {
+				select {
+				case ch <- v:
+				case <-ctx.Done():
+					errs <- ctx.Err()
+					return
+				}
+			}
+
+			count += len(l.LdapUsers)
+			if len(l.LdapUsers) < 500 || count >= l.Count {
+				return
+			}
+		}
+	}()
+
+	return ch, errs
+}
+
+// ListLdapUsersWhere returns all results of ListLdapUsers for which pred returns true. Note that the results are
+// filtered client-side, so all results matching the params are fetched first.
+// All pages are fetched, so the page and pagesize set in the params are ignored.
+func (s *LDAPService) ListLdapUsersWhere(p *ListLdapUsersParams, pred func(*LdapUser) bool) ([]*LdapUser, error) {
+	var r []*LdapUser
+	ch, errs := s.StreamLdapUsers(context.Background(), p)
+	for v := range ch {
+		if pred(v) {
+			r = append(r, v)
+		}
+	}
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ListAllLdapUsers returns the results of ListLdapUsers across all pages, by calling ListLdapUsers page by page until all
+// results are fetched. The pagesize set in the params is used for every page (500 if not set), and the
+// page set in the params is ignored.
+func (s *LDAPService) ListAllLdapUsers(p *ListLdapUsersParams) ([]*LdapUser, error) {
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesizeIfUnset(500)
+	pagesize := pp.p["pagesize"].(int)
+
+	var r []*LdapUser
+	for page := 1; ; page++ {
+		pp.SetPage(page)
+
+		l, err := s.ListLdapUsers(pp)
+		if err != nil {
+			return nil, err
+		}
+		r = append(r, l.LdapUsers...)
+
+		if len(l.LdapUsers) < pagesize || len(r) >= l.Count {
+			return r, nil
+		}
+	}
+}
+
 type ListLdapUsersResponse struct {
 	Count     int         `json:"count"`
 	LdapUsers []*LdapUser `json:"ldapuser"`
@@ -1042,6 +1925,16 @@ type SearchLdapParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *SearchLdapParams) Clone() *SearchLdapParams {
+	c := &SearchLdapParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *SearchLdapParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1064,6 +1957,16 @@ func (p *SearchLdapParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *SearchLdapParams) validate() error {
+	for _, k := range []string{"query"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of searchLdap", k)
+		}
+	}
+	return nil
+}
+
 func (p *SearchLdapParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1072,6 +1975,13 @@ func (p *SearchLdapParams) SetKeyword(v string) {
 	return
 }
 
+// SetKeywordIfUnset sets keyword to v, unless it is already set
+func (p *SearchLdapParams) SetKeywordIfUnset(v string) {
+	if _, found := p.p["keyword"]; !found {
+		p.SetKeyword(v)
+	}
+}
+
 func (p *SearchLdapParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1080,6 +1990,13 @@ func (p *SearchLdapParams) SetPage(v int) {
 	return
 }
 
+// SetPageIfUnset sets page to v, unless it is already set
+func (p *SearchLdapParams) SetPageIfUnset(v int) {
+	if _, found := p.p["page"]; !found {
+		p.SetPage(v)
+	}
+}
+
 func (p *SearchLdapParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1088,6 +2005,13 @@ func (p *SearchLdapParams) SetPagesize(v int) {
 	return
 }
 
+// SetPagesizeIfUnset sets pagesize to v, unless it is already set
+func (p *SearchLdapParams) SetPagesizeIfUnset(v int) {
+	if _, found := p.p["pagesize"]; !found {
+		p.SetPagesize(v)
+	}
+}
+
 func (p *SearchLdapParams) SetQuery(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1096,18 +2020,54 @@ func (p *SearchLdapParams) SetQuery(v string) {
 	return
 }
 
+// SetQueryIfUnset sets query to v, unless it is already set
+func (p *SearchLdapParams) SetQueryIfUnset(v string) {
+	if _, found := p.p["query"]; !found {
+		p.SetQuery(v)
+	}
+}
+
 // You should always use this function to get a new SearchLdapParams instance,
-// as then you are sure you have configured all required params
-func (s *LDAPService) NewSearchLdapParams(query string) *SearchLdapParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *LDAPService) NewSearchLdapParams(query string, opts ...func(*SearchLdapParams)) *SearchLdapParams {
 	p := &SearchLdapParams{}
 	p.p = make(map[string]interface{})
 	p.p["query"] = query
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Searches LDAP based on the username attribute
-func (s *LDAPService) SearchLdap(p *SearchLdapParams) (*SearchLdapResponse, error) {
-	resp, err := s.cs.newRequest("searchLdap", p.toURLValues())
+func (s *LDAPService) SearchLdap(p *SearchLdapParams, opts ...OptionFunc) (*SearchLdapResponse, error) {
+	return s.SearchLdapWithContext(context.Background(), p, opts...)
+}
+
+// SearchLdapWithContext is the same as SearchLdap, but the request is cancelled when the context is done
+func (s *LDAPService) SearchLdapWithContext(ctx context.Context, p *SearchLdapParams, opts ...OptionFunc) (*SearchLdapResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.SearchLdapRawWithContext(ctx, p.toURLValues())
+}
+
+// SearchLdapRaw is the same as SearchLdap, but takes the params as url.Values instead of typed params
+func (s *LDAPService) SearchLdapRaw(v url.Values) (*SearchLdapResponse, error) {
+	return s.SearchLdapRawWithContext(context.Background(), v)
+}
+
+// SearchLdapRawWithContext is the same as SearchLdapRaw, but the request is cancelled when the context is done
+func (s *LDAPService) SearchLdapRawWithContext(ctx context.Context, v url.Values) (*SearchLdapResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "searchLdap", "ldapuserresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -17,15 +17,68 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
 	"net/url"
 	"strconv"
 )
 
+// IsUnlimited returns true if the resource limit has no maximum
+func (r *ResourceLimit) IsUnlimited() bool {
+	return isUnlimitedMax(r.Max)
+}
+
+// RemainingPercent returns the percentage of the resource limit which is still available given the
+// used amount, between 0 and 100. An unlimited resource always has 100 percent remaining.
+func (r *ResourceLimit) RemainingPercent(used int64) float64 {
+	return remainingPercent(r.Max, used)
+}
+
+// IsUnlimited returns true if the updated resource limit has no maximum
+func (r *UpdateResourceLimitResponse) IsUnlimited() bool {
+	return isUnlimitedMax(r.Max)
+}
+
+// RemainingPercent returns the percentage of the updated resource limit which is still available
+// given the used amount, between 0 and 100. An unlimited resource always has 100 percent remaining.
+func (r *UpdateResourceLimitResponse) RemainingPercent(used int64) float64 {
+	return remainingPercent(r.Max, used)
+}
+
+// Returns true if the max of a resource limit is the unlimited sentinel
+func isUnlimitedMax(max int64) bool {
+	return strconv.FormatInt(max, 10) == UnlimitedResourceID
+}
+
+// Returns the percentage of max which is still available given the used amount
+func remainingPercent(max int64, used int64) float64 {
+	if isUnlimitedMax(max) {
+		return 100
+	}
+	if max <= 0 || used >= max {
+		return 0
+	}
+	if used <= 0 {
+		return 100
+	}
+	return float64(max-used) / float64(max) * 100
+}
+
 type GetApiLimitParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *GetApiLimitParams) Clone() *GetApiLimitParams {
+	c := &GetApiLimitParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *GetApiLimitParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -35,16 +88,41 @@ func (p *GetApiLimitParams) toURLValues() url.Values {
 }
 
 // You should always use this function to get a new GetApiLimitParams instance,
-// as then you are sure you have configured all required params
-func (s *LimitService) NewGetApiLimitParams() *GetApiLimitParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *LimitService) NewGetApiLimitParams(opts ...func(*GetApiLimitParams)) *GetApiLimitParams {
 	p := &GetApiLimitParams{}
 	p.p = make(map[string]interface{})
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Get API limit count for the caller
-func (s *LimitService) GetApiLimit(p *GetApiLimitParams) (*GetApiLimitResponse, error) {
-	resp, err := s.cs.newRequest("getApiLimit", p.toURLValues())
+func (s *LimitService) GetApiLimit(p *GetApiLimitParams, opts ...OptionFunc) (*GetApiLimitResponse, error) {
+	return s.GetApiLimitWithContext(context.Background(), p, opts...)
+}
+
+// GetApiLimitWithContext is the same as GetApiLimit, but the request is cancelled when the context is done
+func (s *LimitService) GetApiLimitWithContext(ctx context.Context, p *GetApiLimitParams, opts ...OptionFunc) (*GetApiLimitResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	return s.GetApiLimitRawWithContext(ctx, p.toURLValues())
+}
+
+// GetApiLimitRaw is the same as GetApiLimit, but takes the params as url.Values instead of typed params
+func (s *LimitService) GetApiLimitRaw(v url.Values) (*GetApiLimitResponse, error) {
+	return s.GetApiLimitRawWithContext(context.Background(), v)
+}
+
+// GetApiLimitRawWithContext is the same as GetApiLimitRaw, but the request is cancelled when the context is done
+func (s *LimitService) GetApiLimitRawWithContext(ctx context.Context, v url.Values) (*GetApiLimitResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "getApiLimit", "getapilimitresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -69,6 +147,16 @@ type ListResourceLimitsParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ListResourceLimitsParams) Clone() *ListResourceLimitsParams {
+	c := &ListResourceLimitsParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListResourceLimitsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -121,6 +209,13 @@ func (p *ListResourceLimitsParams) SetAccount(v string) {
 	return
 }
 
+// SetAccountIfUnset sets account to v, unless it is already set
+func (p *ListResourceLimitsParams) SetAccountIfUnset(v string) {
+	if _, found := p.p["account"]; !found {
+		p.SetAccount(v)
+	}
+}
+
 func (p *ListResourceLimitsParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -129,6 +224,13 @@ func (p *ListResourceLimitsParams) SetDomainid(v string) {
 	return
 }
 
+// SetDomainidIfUnset sets domainid to v, unless it is already set
+func (p *ListResourceLimitsParams) SetDomainidIfUnset(v string) {
+	if _, found := p.p["domainid"]; !found {
+		p.SetDomainid(v)
+	}
+}
+
 func (p *ListResourceLimitsParams) SetId(v int64) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -137,6 +239,13 @@ func (p *ListResourceLimitsParams) SetId(v int64) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *ListResourceLimitsParams) SetIdIfUnset(v int64) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 func (p *ListResourceLimitsParams) SetIsrecursive(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -145,6 +254,13 @@ func (p *ListResourceLimitsParams) SetIsrecursive(v bool) {
 	return
 }
 
+// SetIsrecursiveIfUnset sets isrecursive to v, unless it is already set
+func (p *ListResourceLimitsParams) SetIsrecursiveIfUnset(v bool) {
+	if _, found := p.p["isrecursive"]; !found {
+		p.SetIsrecursive(v)
+	}
+}
+
 func (p *ListResourceLimitsParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -153,6 +269,13 @@ func (p *ListResourceLimitsParams) SetKeyword(v string) {
 	return
 }
 
+// SetKeywordIfUnset sets keyword to v, unless it is already set
+func (p *ListResourceLimitsParams) SetKeywordIfUnset(v string) {
+	if _, found := p.p["keyword"]; !found {
+		p.SetKeyword(v)
+	}
+}
+
 func (p *ListResourceLimitsParams) SetListall(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -161,6 +284,13 @@ func (p *ListResourceLimitsParams) SetListall(v bool) {
 	return
 }
 
+// SetListallIfUnset sets listall to v, unless it is already set
+func (p *ListResourceLimitsParams) SetListallIfUnset(v bool) {
+	if _, found := p.p["listall"]; !found {
+		p.SetListall(v)
+	}
+}
+
 func (p *ListResourceLimitsParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -169,6 +299,13 @@ func (p *ListResourceLimitsParams) SetPage(v int) {
 	return
 }
 
+// SetPageIfUnset sets page to v, unless it is already set
+func (p *ListResourceLimitsParams) SetPageIfUnset(v int) {
+	if _, found := p.p["page"]; !found {
+		p.SetPage(v)
+	}
+}
+
 func (p *ListResourceLimitsParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -177,6 +314,13 @@ func (p *ListResourceLimitsParams) SetPagesize(v int) {
 	return
 }
 
+// SetPagesizeIfUnset sets pagesize to v, unless it is already set
+func (p *ListResourceLimitsParams) SetPagesizeIfUnset(v int) {
+	if _, found := p.p["pagesize"]; !found {
+		p.SetPagesize(v)
+	}
+}
+
 func (p *ListResourceLimitsParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -185,6 +329,13 @@ func (p *ListResourceLimitsParams) SetProjectid(v string) {
 	return
 }
 
+// SetProjectidIfUnset sets projectid to v, unless it is already set
+func (p *ListResourceLimitsParams) SetProjectidIfUnset(v string) {
+	if _, found := p.p["projectid"]; !found {
+		p.SetProjectid(v)
+	}
+}
+
 func (p *ListResourceLimitsParams) SetResourcetype(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -193,17 +344,45 @@ func (p *ListResourceLimitsParams) SetResourcetype(v int) {
 	return
 }
 
+// SetResourcetypeIfUnset sets resourcetype to v, unless it is already set
+func (p *ListResourceLimitsParams) SetResourcetypeIfUnset(v int) {
+	if _, found := p.p["resourcetype"]; !found {
+		p.SetResourcetype(v)
+	}
+}
+
+var _ ListAllSetter = (*ListResourceLimitsParams)(nil)
+
 // You should always use this function to get a new ListResourceLimitsParams instance,
-// as then you are sure you have configured all required params
-func (s *LimitService) NewListResourceLimitsParams() *ListResourceLimitsParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *LimitService) NewListResourceLimitsParams(opts ...func(*ListResourceLimitsParams)) *ListResourceLimitsParams {
 	p := &ListResourceLimitsParams{}
 	p.p = make(map[string]interface{})
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Lists resource limits.
 func (s *LimitService) ListResourceLimits(p *ListResourceLimitsParams) (*ListResourceLimitsResponse, error) {
-	resp, err := s.cs.newRequest("listResourceLimits", p.toURLValues())
+	return s.ListResourceLimitsWithContext(context.Background(), p)
+}
+
+// ListResourceLimitsWithContext is the same as ListResourceLimits, but the request is cancelled when the context is done
+func (s *LimitService) ListResourceLimitsWithContext(ctx context.Context, p *ListResourceLimitsParams) (*ListResourceLimitsResponse, error) {
+	return s.ListResourceLimitsRawWithContext(ctx, p.toURLValues())
+}
+
+// ListResourceLimitsRaw is the same as ListResourceLimits, but takes the params as url.Values instead of typed params
+func (s *LimitService) ListResourceLimitsRaw(v url.Values) (*ListResourceLimitsResponse, error) {
+	return s.ListResourceLimitsRawWithContext(context.Background(), v)
+}
+
+// ListResourceLimitsRawWithContext is the same as ListResourceLimitsRaw, but the request is cancelled when the context is done
+func (s *LimitService) ListResourceLimitsRawWithContext(ctx context.Context, v url.Values) (*ListResourceLimitsResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "listResourceLimits", "listresourcelimitsresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -216,6 +395,93 @@ func (s *LimitService) ListResourceLimits(p *ListResourceLimitsParams) (*ListRes
 	return &r, nil
 }
 
+// StreamResourceLimits fetches the results of ListResourceLimits page by page in the background and sends them on the
+// returned channel. Both returned channels are closed when done. If fetching a page fails, or the context
+// is done before all results are sent, the error is sent on the error channel and fetching stops. A
+// request for a page in flight is cancelled when the context is done. The page and pagesize set in the
+// params are ignored.
+func (s *LimitService) StreamResourceLimits(ctx context.Context, p *ListResourceLimitsParams) (<-chan *ResourceLimit, <-chan error) {
+	ch := make(chan *ResourceLimit)
+	errs := make(chan error, 1)
+
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesize(500)
+
+	go func() {
+		defer close(errs)
+		defer close(ch)
+
+		for page, count := 1, 0; ; page++ {
+			pp.SetPage(page)
+
+			l, err := s.ListResourceLimitsWithContext(ctx, pp)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			for _, v := range l.ResourceLimits {
+				select {
+				case ch <- v:
+				case <-ctx.Done():
+					errs <- ctx.Err()
+					return
+				}
+			}
+
+			count += len(l.ResourceLimits)
+			if len(l.ResourceLimits) < 500 || count >= l.Count {
+				return
+			}
+		}
+	}()
+
+	return ch, errs
+}
+
+// ListResourceLimitsWhere returns all results of ListResourceLimits for which pred returns true. Note that the results are
+// filtered client-side, so all results matching the params are fetched first.
+// All pages are fetched, so the page and pagesize set in the params are ignored.
+func (s *LimitService) ListResourceLimitsWhere(p *ListResourceLimitsParams, pred func(*ResourceLimit) bool) ([]*ResourceLimit, error) {
+	var r []*ResourceLimit
+	ch, errs := s.StreamResourceLimits(context.Background(), p)
+	for v := range ch {
+		if pred(v) {
+			r = append(r, v)
+		}
+	}
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ListAllResourceLimits returns the results of ListResourceLimits across all pages, by calling ListResourceLimits page by page until all
+// results are fetched. The pagesize set in the params is used for every page (500 if not set), and the
+// page set in the params is ignored.
+func (s *LimitService) ListAllResourceLimits(p *ListResourceLimitsParams) ([]*ResourceLimit, error) {
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesizeIfUnset(500)
+	pagesize := pp.p["pagesize"].(int)
+
+	var r []*ResourceLimit
+	for page := 1; ; page++ {
+		pp.SetPage(page)
+
+		l, err := s.ListResourceLimits(pp)
+		if err != nil {
+			return nil, err
+		}
+		r = append(r, l.ResourceLimits...)
+
+		if len(l.ResourceLimits) < pagesize || len(r) >= l.Count {
+			return r, nil
+		}
+	}
+}
+
 type ListResourceLimitsResponse struct {
 	Count          int              `json:"count"`
 	ResourceLimits []*ResourceLimit `json:"resourcelimit"`
@@ -235,6 +501,16 @@ type ResetApiLimitParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ResetApiLimitParams) Clone() *ResetApiLimitParams {
+	c := &ResetApiLimitParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ResetApiLimitParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -254,17 +530,49 @@ func (p *ResetApiLimitParams) SetAccount(v string) {
 	return
 }
 
+// SetAccountIfUnset sets account to v, unless it is already set
+func (p *ResetApiLimitParams) SetAccountIfUnset(v string) {
+	if _, found := p.p["account"]; !found {
+		p.SetAccount(v)
+	}
+}
+
 // You should always use this function to get a new ResetApiLimitParams instance,
-// as then you are sure you have configured all required params
-func (s *LimitService) NewResetApiLimitParams() *ResetApiLimitParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *LimitService) NewResetApiLimitParams(opts ...func(*ResetApiLimitParams)) *ResetApiLimitParams {
 	p := &ResetApiLimitParams{}
 	p.p = make(map[string]interface{})
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Reset api count
-func (s *LimitService) ResetApiLimit(p *ResetApiLimitParams) (*ResetApiLimitResponse, error) {
-	resp, err := s.cs.newRequest("resetApiLimit", p.toURLValues())
+func (s *LimitService) ResetApiLimit(p *ResetApiLimitParams, opts ...OptionFunc) (*ResetApiLimitResponse, error) {
+	return s.ResetApiLimitWithContext(context.Background(), p, opts...)
+}
+
+// ResetApiLimitWithContext is the same as ResetApiLimit, but the request is cancelled when the context is done
+func (s *LimitService) ResetApiLimitWithContext(ctx context.Context, p *ResetApiLimitParams, opts ...OptionFunc) (*ResetApiLimitResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	return s.ResetApiLimitRawWithContext(ctx, p.toURLValues())
+}
+
+// ResetApiLimitRaw is the same as ResetApiLimit, but takes the params as url.Values instead of typed params
+func (s *LimitService) ResetApiLimitRaw(v url.Values) (*ResetApiLimitResponse, error) {
+	return s.ResetApiLimitRawWithContext(context.Background(), v)
+}
+
+// ResetApiLimitRawWithContext is the same as ResetApiLimitRaw, but the request is cancelled when the context is done
+func (s *LimitService) ResetApiLimitRawWithContext(ctx context.Context, v url.Values) (*ResetApiLimitResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "resetApiLimit", "resetapilimitresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -289,6 +597,16 @@ type UpdateResourceCountParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *UpdateResourceCountParams) Clone() *UpdateResourceCountParams {
+	c := &UpdateResourceCountParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *UpdateResourceCountParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -310,6 +628,16 @@ func (p *UpdateResourceCountParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *UpdateResourceCountParams) validate() error {
+	for _, k := range []string{"domainid"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of updateResourceCount", k)
+		}
+	}
+	return nil
+}
+
 func (p *UpdateResourceCountParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -318,6 +646,13 @@ func (p *UpdateResourceCountParams) SetAccount(v string) {
 	return
 }
 
+// SetAccountIfUnset sets account to v, unless it is already set
+func (p *UpdateResourceCountParams) SetAccountIfUnset(v string) {
+	if _, found := p.p["account"]; !found {
+		p.SetAccount(v)
+	}
+}
+
 func (p *UpdateResourceCountParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -326,6 +661,13 @@ func (p *UpdateResourceCountParams) SetDomainid(v string) {
 	return
 }
 
+// SetDomainidIfUnset sets domainid to v, unless it is already set
+func (p *UpdateResourceCountParams) SetDomainidIfUnset(v string) {
+	if _, found := p.p["domainid"]; !found {
+		p.SetDomainid(v)
+	}
+}
+
 func (p *UpdateResourceCountParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -334,6 +676,13 @@ func (p *UpdateResourceCountParams) SetProjectid(v string) {
 	return
 }
 
+// SetProjectidIfUnset sets projectid to v, unless it is already set
+func (p *UpdateResourceCountParams) SetProjectidIfUnset(v string) {
+	if _, found := p.p["projectid"]; !found {
+		p.SetProjectid(v)
+	}
+}
+
 func (p *UpdateResourceCountParams) SetResourcetype(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -342,18 +691,54 @@ func (p *UpdateResourceCountParams) SetResourcetype(v int) {
 	return
 }
 
+// SetResourcetypeIfUnset sets resourcetype to v, unless it is already set
+func (p *UpdateResourceCountParams) SetResourcetypeIfUnset(v int) {
+	if _, found := p.p["resourcetype"]; !found {
+		p.SetResourcetype(v)
+	}
+}
+
 // You should always use this function to get a new UpdateResourceCountParams instance,
-// as then you are sure you have configured all required params
-func (s *LimitService) NewUpdateResourceCountParams(domainid string) *UpdateResourceCountParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *LimitService) NewUpdateResourceCountParams(domainid string, opts ...func(*UpdateResourceCountParams)) *UpdateResourceCountParams {
 	p := &UpdateResourceCountParams{}
 	p.p = make(map[string]interface{})
 	p.p["domainid"] = domainid
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Recalculate and update resource count for an account or domain.
-func (s *LimitService) UpdateResourceCount(p *UpdateResourceCountParams) (*UpdateResourceCountResponse, error) {
-	resp, err := s.cs.newRequest("updateResourceCount", p.toURLValues())
+func (s *LimitService) UpdateResourceCount(p *UpdateResourceCountParams, opts ...OptionFunc) (*UpdateResourceCountResponse, error) {
+	return s.UpdateResourceCountWithContext(context.Background(), p, opts...)
+}
+
+// UpdateResourceCountWithContext is the same as UpdateResourceCount, but the request is cancelled when the context is done
+func (s *LimitService) UpdateResourceCountWithContext(ctx context.Context, p *UpdateResourceCountParams, opts ...OptionFunc) (*UpdateResourceCountResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.UpdateResourceCountRawWithContext(ctx, p.toURLValues())
+}
+
+// UpdateResourceCountRaw is the same as UpdateResourceCount, but takes the params as url.Values instead of typed params
+func (s *LimitService) UpdateResourceCountRaw(v url.Values) (*UpdateResourceCountResponse, error) {
+	return s.UpdateResourceCountRawWithContext(context.Background(), v)
+}
+
+// UpdateResourceCountRawWithContext is the same as UpdateResourceCountRaw, but the request is cancelled when the context is done
+func (s *LimitService) UpdateResourceCountRawWithContext(ctx context.Context, v url.Values) (*UpdateResourceCountResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "updateResourceCount", "updateresourcecountresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -380,6 +765,16 @@ type UpdateResourceLimitParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *UpdateResourceLimitParams) Clone() *UpdateResourceLimitParams {
+	c := &UpdateResourceLimitParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *UpdateResourceLimitParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -405,6 +800,16 @@ func (p *UpdateResourceLimitParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *UpdateResourceLimitParams) validate() error {
+	for _, k := range []string{"resourcetype"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of updateResourceLimit", k)
+		}
+	}
+	return nil
+}
+
 func (p *UpdateResourceLimitParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -413,6 +818,13 @@ func (p *UpdateResourceLimitParams) SetAccount(v string) {
 	return
 }
 
+// SetAccountIfUnset sets account to v, unless it is already set
+func (p *UpdateResourceLimitParams) SetAccountIfUnset(v string) {
+	if _, found := p.p["account"]; !found {
+		p.SetAccount(v)
+	}
+}
+
 func (p *UpdateResourceLimitParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -421,6 +833,13 @@ func (p *UpdateResourceLimitParams) SetDomainid(v string) {
 	return
 }
 
+// SetDomainidIfUnset sets domainid to v, unless it is already set
+func (p *UpdateResourceLimitParams) SetDomainidIfUnset(v string) {
+	if _, found := p.p["domainid"]; !found {
+		p.SetDomainid(v)
+	}
+}
+
 func (p *UpdateResourceLimitParams) SetMax(v int64) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -429,6 +848,13 @@ func (p *UpdateResourceLimitParams) SetMax(v int64) {
 	return
 }
 
+// SetMaxIfUnset sets max to v, unless it is already set
+func (p *UpdateResourceLimitParams) SetMaxIfUnset(v int64) {
+	if _, found := p.p["max"]; !found {
+		p.SetMax(v)
+	}
+}
+
 func (p *UpdateResourceLimitParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -437,6 +863,13 @@ func (p *UpdateResourceLimitParams) SetProjectid(v string) {
 	return
 }
 
+// SetProjectidIfUnset sets projectid to v, unless it is already set
+func (p *UpdateResourceLimitParams) SetProjectidIfUnset(v string) {
+	if _, found := p.p["projectid"]; !found {
+		p.SetProjectid(v)
+	}
+}
+
 func (p *UpdateResourceLimitParams) SetResourcetype(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -445,18 +878,54 @@ func (p *UpdateResourceLimitParams) SetResourcetype(v int) {
 	return
 }
 
+// SetResourcetypeIfUnset sets resourcetype to v, unless it is already set
+func (p *UpdateResourceLimitParams) SetResourcetypeIfUnset(v int) {
+	if _, found := p.p["resourcetype"]; !found {
+		p.SetResourcetype(v)
+	}
+}
+
 // You should always use this function to get a new UpdateResourceLimitParams instance,
-// as then you are sure you have configured all required params
-func (s *LimitService) NewUpdateResourceLimitParams(resourcetype int) *UpdateResourceLimitParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *LimitService) NewUpdateResourceLimitParams(resourcetype int, opts ...func(*UpdateResourceLimitParams)) *UpdateResourceLimitParams {
 	p := &UpdateResourceLimitParams{}
 	p.p = make(map[string]interface{})
 	p.p["resourcetype"] = resourcetype
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Updates resource limits for an account or domain.
-func (s *LimitService) UpdateResourceLimit(p *UpdateResourceLimitParams) (*UpdateResourceLimitResponse, error) {
-	resp, err := s.cs.newRequest("updateResourceLimit", p.toURLValues())
+func (s *LimitService) UpdateResourceLimit(p *UpdateResourceLimitParams, opts ...OptionFunc) (*UpdateResourceLimitResponse, error) {
+	return s.UpdateResourceLimitWithContext(context.Background(), p, opts...)
+}
+
+// UpdateResourceLimitWithContext is the same as UpdateResourceLimit, but the request is cancelled when the context is done
+func (s *LimitService) UpdateResourceLimitWithContext(ctx context.Context, p *UpdateResourceLimitParams, opts ...OptionFunc) (*UpdateResourceLimitResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.UpdateResourceLimitRawWithContext(ctx, p.toURLValues())
+}
+
+// UpdateResourceLimitRaw is the same as UpdateResourceLimit, but takes the params as url.Values instead of typed params
+func (s *LimitService) UpdateResourceLimitRaw(v url.Values) (*UpdateResourceLimitResponse, error) {
+	return s.UpdateResourceLimitRawWithContext(context.Background(), v)
+}
+
+// UpdateResourceLimitRawWithContext is the same as UpdateResourceLimitRaw, but the request is cancelled when the context is done
+func (s *LimitService) UpdateResourceLimitRawWithContext(ctx context.Context, v url.Values) (*UpdateResourceLimitResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "updateResourceLimit", "updateresourcelimitresponse", v)
 	if err != nil {
 		return nil, err
 	}
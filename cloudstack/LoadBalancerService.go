@@ -17,17 +17,82 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/url"
 	"strconv"
 	"strings"
+	"time"
 )
 
+// UpdateMany runs UpdateLoadBalancer for all given IDs in parallel, using at most concurrency concurrent requests.
+// For each ID the params are populated with the ID and then passed to mutate, which should set the
+// changes to apply. The result contains the updated resources (in the order of the IDs) and the error
+// of every update that failed by ID. The returned error contains all failures, or is nil if none failed.
+// The updated resources are returned as the UpdateLoadBalancerResponse of every update, which contains the same
+// fields as the resource itself, so no additional request is needed per resource to get them. The
+// options are used for every update.
+func (s *LoadBalancerService) UpdateMany(ids []string, mutate func(*UpdateLoadBalancerParams), concurrency int, opts ...OptionFunc) (BulkResult[*UpdateLoadBalancerResponse], error) {
+	rs := make([]*UpdateLoadBalancerResponse, len(ids))
+	errs := make([]error, len(ids))
+
+	runConcurrently(len(ids), concurrency, func(i int) {
+		p := &UpdateLoadBalancerParams{p: map[string]interface{}{"id": ids[i]}}
+		if mutate != nil {
+			mutate(p)
+		}
+
+		r, err := s.UpdateLoadBalancer(p, opts...)
+		if err != nil {
+			errs[i] = err
+			return
+		}
+
+		// An async client already waited for the job to finish
+		if !s.cs.async {
+			b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+			if err == nil {
+				b, err = getRawValue(b)
+			}
+			if err == nil {
+				err = json.Unmarshal(b, r)
+			}
+			if err != nil {
+				errs[i] = err
+				return
+			}
+		}
+		rs[i] = r
+	})
+
+	var r BulkResult[*UpdateLoadBalancerResponse]
+	for i, id := range ids {
+		if errs[i] != nil {
+			r.fail(id, errs[i])
+			continue
+		}
+		r.Succeeded = append(r.Succeeded, rs[i])
+	}
+
+	return r, r.Err()
+}
+
 type AddF5LoadBalancerParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *AddF5LoadBalancerParams) Clone() *AddF5LoadBalancerParams {
+	c := &AddF5LoadBalancerParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *AddF5LoadBalancerParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -51,6 +116,16 @@ func (p *AddF5LoadBalancerParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *AddF5LoadBalancerParams) validate() error {
+	for _, k := range []string{"networkdevicetype", "password", "physicalnetworkid", "url", "username"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of addF5LoadBalancer", k)
+		}
+	}
+	return nil
+}
+
 func (p *AddF5LoadBalancerParams) SetNetworkdevicetype(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -59,6 +134,13 @@ func (p *AddF5LoadBalancerParams) SetNetworkdevicetype(v string) {
 	return
 }
 
+// SetNetworkdevicetypeIfUnset sets networkdevicetype to v, unless it is already set
+func (p *AddF5LoadBalancerParams) SetNetworkdevicetypeIfUnset(v string) {
+	if _, found := p.p["networkdevicetype"]; !found {
+		p.SetNetworkdevicetype(v)
+	}
+}
+
 func (p *AddF5LoadBalancerParams) SetPassword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -67,6 +149,13 @@ func (p *AddF5LoadBalancerParams) SetPassword(v string) {
 	return
 }
 
+// SetPasswordIfUnset sets password to v, unless it is already set
+func (p *AddF5LoadBalancerParams) SetPasswordIfUnset(v string) {
+	if _, found := p.p["password"]; !found {
+		p.SetPassword(v)
+	}
+}
+
 func (p *AddF5LoadBalancerParams) SetPhysicalnetworkid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -75,6 +164,13 @@ func (p *AddF5LoadBalancerParams) SetPhysicalnetworkid(v string) {
 	return
 }
 
+// SetPhysicalnetworkidIfUnset sets physicalnetworkid to v, unless it is already set
+func (p *AddF5LoadBalancerParams) SetPhysicalnetworkidIfUnset(v string) {
+	if _, found := p.p["physicalnetworkid"]; !found {
+		p.SetPhysicalnetworkid(v)
+	}
+}
+
 func (p *AddF5LoadBalancerParams) SetUrl(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -83,6 +179,13 @@ func (p *AddF5LoadBalancerParams) SetUrl(v string) {
 	return
 }
 
+// SetUrlIfUnset sets url to v, unless it is already set
+func (p *AddF5LoadBalancerParams) SetUrlIfUnset(v string) {
+	if _, found := p.p["url"]; !found {
+		p.SetUrl(v)
+	}
+}
+
 func (p *AddF5LoadBalancerParams) SetUsername(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -91,9 +194,17 @@ func (p *AddF5LoadBalancerParams) SetUsername(v string) {
 	return
 }
 
+// SetUsernameIfUnset sets username to v, unless it is already set
+func (p *AddF5LoadBalancerParams) SetUsernameIfUnset(v string) {
+	if _, found := p.p["username"]; !found {
+		p.SetUsername(v)
+	}
+}
+
 // You should always use this function to get a new AddF5LoadBalancerParams instance,
-// as then you are sure you have configured all required params
-func (s *LoadBalancerService) NewAddF5LoadBalancerParams(networkdevicetype string, password string, physicalnetworkid string, url string, username string) *AddF5LoadBalancerParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *LoadBalancerService) NewAddF5LoadBalancerParams(networkdevicetype string, password string, physicalnetworkid string, url string, username string, opts ...func(*AddF5LoadBalancerParams)) *AddF5LoadBalancerParams {
 	p := &AddF5LoadBalancerParams{}
 	p.p = make(map[string]interface{})
 	p.p["networkdevicetype"] = networkdevicetype
@@ -101,12 +212,57 @@ func (s *LoadBalancerService) NewAddF5LoadBalancerParams(networkdevicetype strin
 	p.p["physicalnetworkid"] = physicalnetworkid
 	p.p["url"] = url
 	p.p["username"] = username
+	for _, fn := range opts {
+		fn(p)
+	}
+	return p
+}
+
+// AddF5LoadBalancerOpts contains all params that can be set on a AddF5LoadBalancerParams.
+// Optional params are pointers (or slices and maps) which are only set when not nil.
+type AddF5LoadBalancerOpts struct {
+	Networkdevicetype string
+	Password          string
+	Physicalnetworkid string
+	Url               string
+	Username          string
+}
+
+// NewAddF5LoadBalancerParamsFromOpts is an alternative for NewAddF5LoadBalancerParams which takes all params using
+// an options struct, as that is easier to read for APIs with many required params
+func (s *LoadBalancerService) NewAddF5LoadBalancerParamsFromOpts(o AddF5LoadBalancerOpts) *AddF5LoadBalancerParams {
+	p := s.NewAddF5LoadBalancerParams(o.Networkdevicetype, o.Password, o.Physicalnetworkid, o.Url, o.Username)
 	return p
 }
 
 // Adds a F5 BigIP load balancer device
-func (s *LoadBalancerService) AddF5LoadBalancer(p *AddF5LoadBalancerParams) (*AddF5LoadBalancerResponse, error) {
-	resp, err := s.cs.newRequest("addF5LoadBalancer", p.toURLValues())
+func (s *LoadBalancerService) AddF5LoadBalancer(p *AddF5LoadBalancerParams, opts ...OptionFunc) (*AddF5LoadBalancerResponse, error) {
+	return s.AddF5LoadBalancerWithContext(context.Background(), p, opts...)
+}
+
+// AddF5LoadBalancerWithContext is the same as AddF5LoadBalancer, but the request is cancelled when the context is done
+func (s *LoadBalancerService) AddF5LoadBalancerWithContext(ctx context.Context, p *AddF5LoadBalancerParams, opts ...OptionFunc) (*AddF5LoadBalancerResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.AddF5LoadBalancerRawWithContext(ctx, p.toURLValues())
+}
+
+// AddF5LoadBalancerRaw is the same as AddF5LoadBalancer, but takes the params as url.Values instead of typed params
+func (s *LoadBalancerService) AddF5LoadBalancerRaw(v url.Values) (*AddF5LoadBalancerResponse, error) {
+	return s.AddF5LoadBalancerRawWithContext(context.Background(), v)
+}
+
+// AddF5LoadBalancerRawWithContext is the same as AddF5LoadBalancerRaw, but the request is cancelled when the context is done
+func (s *LoadBalancerService) AddF5LoadBalancerRawWithContext(ctx context.Context, v url.Values) (*AddF5LoadBalancerResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "addF5LoadBalancer", "addf5loadbalancerresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -118,9 +274,9 @@ func (s *LoadBalancerService) AddF5LoadBalancer(p *AddF5LoadBalancerParams) (*Ad
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -157,6 +313,16 @@ type AddNetscalerLoadBalancerParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *AddNetscalerLoadBalancerParams) Clone() *AddNetscalerLoadBalancerParams {
+	c := &AddNetscalerLoadBalancerParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *AddNetscalerLoadBalancerParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -194,6 +360,16 @@ func (p *AddNetscalerLoadBalancerParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *AddNetscalerLoadBalancerParams) validate() error {
+	for _, k := range []string{"networkdevicetype", "password", "physicalnetworkid", "url", "username"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of addNetscalerLoadBalancer", k)
+		}
+	}
+	return nil
+}
+
 func (p *AddNetscalerLoadBalancerParams) SetGslbprovider(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -202,6 +378,13 @@ func (p *AddNetscalerLoadBalancerParams) SetGslbprovider(v bool) {
 	return
 }
 
+// SetGslbproviderIfUnset sets gslbprovider to v, unless it is already set
+func (p *AddNetscalerLoadBalancerParams) SetGslbproviderIfUnset(v bool) {
+	if _, found := p.p["gslbprovider"]; !found {
+		p.SetGslbprovider(v)
+	}
+}
+
 func (p *AddNetscalerLoadBalancerParams) SetGslbproviderprivateip(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -210,6 +393,13 @@ func (p *AddNetscalerLoadBalancerParams) SetGslbproviderprivateip(v string) {
 	return
 }
 
+// SetGslbproviderprivateipIfUnset sets gslbproviderprivateip to v, unless it is already set
+func (p *AddNetscalerLoadBalancerParams) SetGslbproviderprivateipIfUnset(v string) {
+	if _, found := p.p["gslbproviderprivateip"]; !found {
+		p.SetGslbproviderprivateip(v)
+	}
+}
+
 func (p *AddNetscalerLoadBalancerParams) SetGslbproviderpublicip(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -218,6 +408,13 @@ func (p *AddNetscalerLoadBalancerParams) SetGslbproviderpublicip(v string) {
 	return
 }
 
+// SetGslbproviderpublicipIfUnset sets gslbproviderpublicip to v, unless it is already set
+func (p *AddNetscalerLoadBalancerParams) SetGslbproviderpublicipIfUnset(v string) {
+	if _, found := p.p["gslbproviderpublicip"]; !found {
+		p.SetGslbproviderpublicip(v)
+	}
+}
+
 func (p *AddNetscalerLoadBalancerParams) SetIsexclusivegslbprovider(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -226,6 +423,13 @@ func (p *AddNetscalerLoadBalancerParams) SetIsexclusivegslbprovider(v bool) {
 	return
 }
 
+// SetIsexclusivegslbproviderIfUnset sets isexclusivegslbprovider to v, unless it is already set
+func (p *AddNetscalerLoadBalancerParams) SetIsexclusivegslbproviderIfUnset(v bool) {
+	if _, found := p.p["isexclusivegslbprovider"]; !found {
+		p.SetIsexclusivegslbprovider(v)
+	}
+}
+
 func (p *AddNetscalerLoadBalancerParams) SetNetworkdevicetype(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -234,6 +438,13 @@ func (p *AddNetscalerLoadBalancerParams) SetNetworkdevicetype(v string) {
 	return
 }
 
+// SetNetworkdevicetypeIfUnset sets networkdevicetype to v, unless it is already set
+func (p *AddNetscalerLoadBalancerParams) SetNetworkdevicetypeIfUnset(v string) {
+	if _, found := p.p["networkdevicetype"]; !found {
+		p.SetNetworkdevicetype(v)
+	}
+}
+
 func (p *AddNetscalerLoadBalancerParams) SetPassword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -242,6 +453,13 @@ func (p *AddNetscalerLoadBalancerParams) SetPassword(v string) {
 	return
 }
 
+// SetPasswordIfUnset sets password to v, unless it is already set
+func (p *AddNetscalerLoadBalancerParams) SetPasswordIfUnset(v string) {
+	if _, found := p.p["password"]; !found {
+		p.SetPassword(v)
+	}
+}
+
 func (p *AddNetscalerLoadBalancerParams) SetPhysicalnetworkid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -250,6 +468,13 @@ func (p *AddNetscalerLoadBalancerParams) SetPhysicalnetworkid(v string) {
 	return
 }
 
+// SetPhysicalnetworkidIfUnset sets physicalnetworkid to v, unless it is already set
+func (p *AddNetscalerLoadBalancerParams) SetPhysicalnetworkidIfUnset(v string) {
+	if _, found := p.p["physicalnetworkid"]; !found {
+		p.SetPhysicalnetworkid(v)
+	}
+}
+
 func (p *AddNetscalerLoadBalancerParams) SetUrl(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -258,6 +483,13 @@ func (p *AddNetscalerLoadBalancerParams) SetUrl(v string) {
 	return
 }
 
+// SetUrlIfUnset sets url to v, unless it is already set
+func (p *AddNetscalerLoadBalancerParams) SetUrlIfUnset(v string) {
+	if _, found := p.p["url"]; !found {
+		p.SetUrl(v)
+	}
+}
+
 func (p *AddNetscalerLoadBalancerParams) SetUsername(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -266,9 +498,17 @@ func (p *AddNetscalerLoadBalancerParams) SetUsername(v string) {
 	return
 }
 
+// SetUsernameIfUnset sets username to v, unless it is already set
+func (p *AddNetscalerLoadBalancerParams) SetUsernameIfUnset(v string) {
+	if _, found := p.p["username"]; !found {
+		p.SetUsername(v)
+	}
+}
+
 // You should always use this function to get a new AddNetscalerLoadBalancerParams instance,
-// as then you are sure you have configured all required params
-func (s *LoadBalancerService) NewAddNetscalerLoadBalancerParams(networkdevicetype string, password string, physicalnetworkid string, url string, username string) *AddNetscalerLoadBalancerParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *LoadBalancerService) NewAddNetscalerLoadBalancerParams(networkdevicetype string, password string, physicalnetworkid string, url string, username string, opts ...func(*AddNetscalerLoadBalancerParams)) *AddNetscalerLoadBalancerParams {
 	p := &AddNetscalerLoadBalancerParams{}
 	p.p = make(map[string]interface{})
 	p.p["networkdevicetype"] = networkdevicetype
@@ -276,12 +516,73 @@ func (s *LoadBalancerService) NewAddNetscalerLoadBalancerParams(networkdevicetyp
 	p.p["physicalnetworkid"] = physicalnetworkid
 	p.p["url"] = url
 	p.p["username"] = username
+	for _, fn := range opts {
+		fn(p)
+	}
+	return p
+}
+
+// AddNetscalerLoadBalancerOpts contains all params that can be set on a AddNetscalerLoadBalancerParams.
+// Optional params are pointers (or slices and maps) which are only set when not nil.
+type AddNetscalerLoadBalancerOpts struct {
+	Gslbprovider            *bool
+	Gslbproviderprivateip   *string
+	Gslbproviderpublicip    *string
+	Isexclusivegslbprovider *bool
+	Networkdevicetype       string
+	Password                string
+	Physicalnetworkid       string
+	Url                     string
+	Username                string
+}
+
+// NewAddNetscalerLoadBalancerParamsFromOpts is an alternative for NewAddNetscalerLoadBalancerParams which takes all params using
+// an options struct, as that is easier to read for APIs with many required params
+func (s *LoadBalancerService) NewAddNetscalerLoadBalancerParamsFromOpts(o AddNetscalerLoadBalancerOpts) *AddNetscalerLoadBalancerParams {
+	p := s.NewAddNetscalerLoadBalancerParams(o.Networkdevicetype, o.Password, o.Physicalnetworkid, o.Url, o.Username)
+	if o.Gslbprovider != nil {
+		p.p["gslbprovider"] = *o.Gslbprovider
+	}
+	if o.Gslbproviderprivateip != nil {
+		p.p["gslbproviderprivateip"] = *o.Gslbproviderprivateip
+	}
+	if o.Gslbproviderpublicip != nil {
+		p.p["gslbproviderpublicip"] = *o.Gslbproviderpublicip
+	}
+	if o.Isexclusivegslbprovider != nil {
+		p.p["isexclusivegslbprovider"] = *o.Isexclusivegslbprovider
+	}
 	return p
 }
 
 // Adds a netscaler load balancer device
-func (s *LoadBalancerService) AddNetscalerLoadBalancer(p *AddNetscalerLoadBalancerParams) (*AddNetscalerLoadBalancerResponse, error) {
-	resp, err := s.cs.newRequest("addNetscalerLoadBalancer", p.toURLValues())
+func (s *LoadBalancerService) AddNetscalerLoadBalancer(p *AddNetscalerLoadBalancerParams, opts ...OptionFunc) (*AddNetscalerLoadBalancerResponse, error) {
+	return s.AddNetscalerLoadBalancerWithContext(context.Background(), p, opts...)
+}
+
+// AddNetscalerLoadBalancerWithContext is the same as AddNetscalerLoadBalancer, but the request is cancelled when the context is done
+func (s *LoadBalancerService) AddNetscalerLoadBalancerWithContext(ctx context.Context, p *AddNetscalerLoadBalancerParams, opts ...OptionFunc) (*AddNetscalerLoadBalancerResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.AddNetscalerLoadBalancerRawWithContext(ctx, p.toURLValues())
+}
+
+// AddNetscalerLoadBalancerRaw is the same as AddNetscalerLoadBalancer, but takes the params as url.Values instead of typed params
+func (s *LoadBalancerService) AddNetscalerLoadBalancerRaw(v url.Values) (*AddNetscalerLoadBalancerResponse, error) {
+	return s.AddNetscalerLoadBalancerRawWithContext(context.Background(), v)
+}
+
+// AddNetscalerLoadBalancerRawWithContext is the same as AddNetscalerLoadBalancerRaw, but the request is cancelled when the context is done
+func (s *LoadBalancerService) AddNetscalerLoadBalancerRawWithContext(ctx context.Context, v url.Values) (*AddNetscalerLoadBalancerResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "addNetscalerLoadBalancer", "addnetscalerloadbalancerresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -293,9 +594,9 @@ func (s *LoadBalancerService) AddNetscalerLoadBalancer(p *AddNetscalerLoadBalanc
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -337,6 +638,16 @@ type AssignCertToLoadBalancerParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *AssignCertToLoadBalancerParams) Clone() *AssignCertToLoadBalancerParams {
+	c := &AssignCertToLoadBalancerParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *AssignCertToLoadBalancerParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -351,6 +662,16 @@ func (p *AssignCertToLoadBalancerParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *AssignCertToLoadBalancerParams) validate() error {
+	for _, k := range []string{"certid", "lbruleid"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of assignCertToLoadBalancer", k)
+		}
+	}
+	return nil
+}
+
 func (p *AssignCertToLoadBalancerParams) SetCertid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -359,6 +680,13 @@ func (p *AssignCertToLoadBalancerParams) SetCertid(v string) {
 	return
 }
 
+// SetCertidIfUnset sets certid to v, unless it is already set
+func (p *AssignCertToLoadBalancerParams) SetCertidIfUnset(v string) {
+	if _, found := p.p["certid"]; !found {
+		p.SetCertid(v)
+	}
+}
+
 func (p *AssignCertToLoadBalancerParams) SetLbruleid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -367,19 +695,55 @@ func (p *AssignCertToLoadBalancerParams) SetLbruleid(v string) {
 	return
 }
 
+// SetLbruleidIfUnset sets lbruleid to v, unless it is already set
+func (p *AssignCertToLoadBalancerParams) SetLbruleidIfUnset(v string) {
+	if _, found := p.p["lbruleid"]; !found {
+		p.SetLbruleid(v)
+	}
+}
+
 // You should always use this function to get a new AssignCertToLoadBalancerParams instance,
-// as then you are sure you have configured all required params
-func (s *LoadBalancerService) NewAssignCertToLoadBalancerParams(certid string, lbruleid string) *AssignCertToLoadBalancerParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *LoadBalancerService) NewAssignCertToLoadBalancerParams(certid string, lbruleid string, opts ...func(*AssignCertToLoadBalancerParams)) *AssignCertToLoadBalancerParams {
 	p := &AssignCertToLoadBalancerParams{}
 	p.p = make(map[string]interface{})
 	p.p["certid"] = certid
 	p.p["lbruleid"] = lbruleid
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Assigns a certificate to a load balancer rule
-func (s *LoadBalancerService) AssignCertToLoadBalancer(p *AssignCertToLoadBalancerParams) (*AssignCertToLoadBalancerResponse, error) {
-	resp, err := s.cs.newRequest("assignCertToLoadBalancer", p.toURLValues())
+func (s *LoadBalancerService) AssignCertToLoadBalancer(p *AssignCertToLoadBalancerParams, opts ...OptionFunc) (*AssignCertToLoadBalancerResponse, error) {
+	return s.AssignCertToLoadBalancerWithContext(context.Background(), p, opts...)
+}
+
+// AssignCertToLoadBalancerWithContext is the same as AssignCertToLoadBalancer, but the request is cancelled when the context is done
+func (s *LoadBalancerService) AssignCertToLoadBalancerWithContext(ctx context.Context, p *AssignCertToLoadBalancerParams, opts ...OptionFunc) (*AssignCertToLoadBalancerResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.AssignCertToLoadBalancerRawWithContext(ctx, p.toURLValues())
+}
+
+// AssignCertToLoadBalancerRaw is the same as AssignCertToLoadBalancer, but takes the params as url.Values instead of typed params
+func (s *LoadBalancerService) AssignCertToLoadBalancerRaw(v url.Values) (*AssignCertToLoadBalancerResponse, error) {
+	return s.AssignCertToLoadBalancerRawWithContext(context.Background(), v)
+}
+
+// AssignCertToLoadBalancerRawWithContext is the same as AssignCertToLoadBalancerRaw, but the request is cancelled when the context is done
+func (s *LoadBalancerService) AssignCertToLoadBalancerRawWithContext(ctx context.Context, v url.Values) (*AssignCertToLoadBalancerResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "assignCertToLoadBalancer", "assigncerttoloadbalancerresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -391,9 +755,9 @@ func (s *LoadBalancerService) AssignCertToLoadBalancer(p *AssignCertToLoadBalanc
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -417,17 +781,26 @@ type AssignToGlobalLoadBalancerRuleParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *AssignToGlobalLoadBalancerRuleParams) Clone() *AssignToGlobalLoadBalancerRuleParams {
+	c := &AssignToGlobalLoadBalancerRuleParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *AssignToGlobalLoadBalancerRuleParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
 	}
 	if v, found := p.p["gslblbruleweightsmap"]; found {
-		i := 0
-		for k, vv := range v.(map[string]string) {
+		m := v.(map[string]string)
+		for i, k := range getSortedKeysFromMap(m) {
 			u.Set(fmt.Sprintf("gslblbruleweightsmap[%d].key", i), k)
-			u.Set(fmt.Sprintf("gslblbruleweightsmap[%d].value", i), vv)
-			i++
+			u.Set(fmt.Sprintf("gslblbruleweightsmap[%d].value", i), m[k])
 		}
 	}
 	if v, found := p.p["id"]; found {
@@ -440,6 +813,16 @@ func (p *AssignToGlobalLoadBalancerRuleParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *AssignToGlobalLoadBalancerRuleParams) validate() error {
+	for _, k := range []string{"id", "loadbalancerrulelist"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of assignToGlobalLoadBalancerRule", k)
+		}
+	}
+	return nil
+}
+
 func (p *AssignToGlobalLoadBalancerRuleParams) SetGslblbruleweightsmap(v map[string]string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -448,6 +831,13 @@ func (p *AssignToGlobalLoadBalancerRuleParams) SetGslblbruleweightsmap(v map[str
 	return
 }
 
+// SetGslblbruleweightsmapIfUnset sets gslblbruleweightsmap to v, unless it is already set
+func (p *AssignToGlobalLoadBalancerRuleParams) SetGslblbruleweightsmapIfUnset(v map[string]string) {
+	if _, found := p.p["gslblbruleweightsmap"]; !found {
+		p.SetGslblbruleweightsmap(v)
+	}
+}
+
 func (p *AssignToGlobalLoadBalancerRuleParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -456,6 +846,13 @@ func (p *AssignToGlobalLoadBalancerRuleParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *AssignToGlobalLoadBalancerRuleParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 func (p *AssignToGlobalLoadBalancerRuleParams) SetLoadbalancerrulelist(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -464,19 +861,60 @@ func (p *AssignToGlobalLoadBalancerRuleParams) SetLoadbalancerrulelist(v []strin
 	return
 }
 
+// SetLoadbalancerrulelistIfUnset sets loadbalancerrulelist to v, unless it is already set
+func (p *AssignToGlobalLoadBalancerRuleParams) SetLoadbalancerrulelistIfUnset(v []string) {
+	if _, found := p.p["loadbalancerrulelist"]; !found {
+		p.SetLoadbalancerrulelist(v)
+	}
+}
+
+// SetLoadbalancerrulelistCSV splits v on commas and sets the resulting values
+func (p *AssignToGlobalLoadBalancerRuleParams) SetLoadbalancerrulelistCSV(v string) {
+	p.SetLoadbalancerrulelist(splitCSV(v))
+}
+
 // You should always use this function to get a new AssignToGlobalLoadBalancerRuleParams instance,
-// as then you are sure you have configured all required params
-func (s *LoadBalancerService) NewAssignToGlobalLoadBalancerRuleParams(id string, loadbalancerrulelist []string) *AssignToGlobalLoadBalancerRuleParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *LoadBalancerService) NewAssignToGlobalLoadBalancerRuleParams(id string, loadbalancerrulelist []string, opts ...func(*AssignToGlobalLoadBalancerRuleParams)) *AssignToGlobalLoadBalancerRuleParams {
 	p := &AssignToGlobalLoadBalancerRuleParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
 	p.p["loadbalancerrulelist"] = loadbalancerrulelist
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Assign load balancer rule or list of load balancer rules to a global load balancer rules.
-func (s *LoadBalancerService) AssignToGlobalLoadBalancerRule(p *AssignToGlobalLoadBalancerRuleParams) (*AssignToGlobalLoadBalancerRuleResponse, error) {
-	resp, err := s.cs.newRequest("assignToGlobalLoadBalancerRule", p.toURLValues())
+func (s *LoadBalancerService) AssignToGlobalLoadBalancerRule(p *AssignToGlobalLoadBalancerRuleParams, opts ...OptionFunc) (*AssignToGlobalLoadBalancerRuleResponse, error) {
+	return s.AssignToGlobalLoadBalancerRuleWithContext(context.Background(), p, opts...)
+}
+
+// AssignToGlobalLoadBalancerRuleWithContext is the same as AssignToGlobalLoadBalancerRule, but the request is cancelled when the context is done
+func (s *LoadBalancerService) AssignToGlobalLoadBalancerRuleWithContext(ctx context.Context, p *AssignToGlobalLoadBalancerRuleParams, opts ...OptionFunc) (*AssignToGlobalLoadBalancerRuleResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.AssignToGlobalLoadBalancerRuleRawWithContext(ctx, p.toURLValues())
+}
+
+// AssignToGlobalLoadBalancerRuleRaw is the same as AssignToGlobalLoadBalancerRule, but takes the params as url.Values instead of typed params
+func (s *LoadBalancerService) AssignToGlobalLoadBalancerRuleRaw(v url.Values) (*AssignToGlobalLoadBalancerRuleResponse, error) {
+	return s.AssignToGlobalLoadBalancerRuleRawWithContext(context.Background(), v)
+}
+
+// AssignToGlobalLoadBalancerRuleRawWithContext is the same as AssignToGlobalLoadBalancerRuleRaw, but the request is cancelled when the context is done
+func (s *LoadBalancerService) AssignToGlobalLoadBalancerRuleRawWithContext(ctx context.Context, v url.Values) (*AssignToGlobalLoadBalancerRuleResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "assignToGlobalLoadBalancerRule", "assigntogloballoadbalancerruleresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -488,9 +926,9 @@ func (s *LoadBalancerService) AssignToGlobalLoadBalancerRule(p *AssignToGlobalLo
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -514,6 +952,16 @@ type AssignToLoadBalancerRuleParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *AssignToLoadBalancerRuleParams) Clone() *AssignToLoadBalancerRuleParams {
+	c := &AssignToLoadBalancerRuleParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *AssignToLoadBalancerRuleParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -527,16 +975,25 @@ func (p *AssignToLoadBalancerRuleParams) toURLValues() url.Values {
 		u.Set("virtualmachineids", vv)
 	}
 	if v, found := p.p["vmidipmap"]; found {
-		i := 0
-		for k, vv := range v.(map[string]string) {
+		m := v.(map[string]string)
+		for i, k := range getSortedKeysFromMap(m) {
 			u.Set(fmt.Sprintf("vmidipmap[%d].key", i), k)
-			u.Set(fmt.Sprintf("vmidipmap[%d].value", i), vv)
-			i++
+			u.Set(fmt.Sprintf("vmidipmap[%d].value", i), m[k])
 		}
 	}
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *AssignToLoadBalancerRuleParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of assignToLoadBalancerRule", k)
+		}
+	}
+	return nil
+}
+
 func (p *AssignToLoadBalancerRuleParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -545,6 +1002,13 @@ func (p *AssignToLoadBalancerRuleParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *AssignToLoadBalancerRuleParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 func (p *AssignToLoadBalancerRuleParams) SetVirtualmachineids(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -553,6 +1017,18 @@ func (p *AssignToLoadBalancerRuleParams) SetVirtualmachineids(v []string) {
 	return
 }
 
+// SetVirtualmachineidsIfUnset sets virtualmachineids to v, unless it is already set
+func (p *AssignToLoadBalancerRuleParams) SetVirtualmachineidsIfUnset(v []string) {
+	if _, found := p.p["virtualmachineids"]; !found {
+		p.SetVirtualmachineids(v)
+	}
+}
+
+// SetVirtualmachineidsCSV splits v on commas and sets the resulting values
+func (p *AssignToLoadBalancerRuleParams) SetVirtualmachineidsCSV(v string) {
+	p.SetVirtualmachineids(splitCSV(v))
+}
+
 func (p *AssignToLoadBalancerRuleParams) SetVmidipmap(v map[string]string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -561,18 +1037,54 @@ func (p *AssignToLoadBalancerRuleParams) SetVmidipmap(v map[string]string) {
 	return
 }
 
+// SetVmidipmapIfUnset sets vmidipmap to v, unless it is already set
+func (p *AssignToLoadBalancerRuleParams) SetVmidipmapIfUnset(v map[string]string) {
+	if _, found := p.p["vmidipmap"]; !found {
+		p.SetVmidipmap(v)
+	}
+}
+
 // You should always use this function to get a new AssignToLoadBalancerRuleParams instance,
-// as then you are sure you have configured all required params
-func (s *LoadBalancerService) NewAssignToLoadBalancerRuleParams(id string) *AssignToLoadBalancerRuleParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *LoadBalancerService) NewAssignToLoadBalancerRuleParams(id string, opts ...func(*AssignToLoadBalancerRuleParams)) *AssignToLoadBalancerRuleParams {
 	p := &AssignToLoadBalancerRuleParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Assigns virtual machine or a list of virtual machines to a load balancer rule.
-func (s *LoadBalancerService) AssignToLoadBalancerRule(p *AssignToLoadBalancerRuleParams) (*AssignToLoadBalancerRuleResponse, error) {
-	resp, err := s.cs.newRequest("assignToLoadBalancerRule", p.toURLValues())
+func (s *LoadBalancerService) AssignToLoadBalancerRule(p *AssignToLoadBalancerRuleParams, opts ...OptionFunc) (*AssignToLoadBalancerRuleResponse, error) {
+	return s.AssignToLoadBalancerRuleWithContext(context.Background(), p, opts...)
+}
+
+// AssignToLoadBalancerRuleWithContext is the same as AssignToLoadBalancerRule, but the request is cancelled when the context is done
+func (s *LoadBalancerService) AssignToLoadBalancerRuleWithContext(ctx context.Context, p *AssignToLoadBalancerRuleParams, opts ...OptionFunc) (*AssignToLoadBalancerRuleResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.AssignToLoadBalancerRuleRawWithContext(ctx, p.toURLValues())
+}
+
+// AssignToLoadBalancerRuleRaw is the same as AssignToLoadBalancerRule, but takes the params as url.Values instead of typed params
+func (s *LoadBalancerService) AssignToLoadBalancerRuleRaw(v url.Values) (*AssignToLoadBalancerRuleResponse, error) {
+	return s.AssignToLoadBalancerRuleRawWithContext(context.Background(), v)
+}
+
+// AssignToLoadBalancerRuleRawWithContext is the same as AssignToLoadBalancerRuleRaw, but the request is cancelled when the context is done
+func (s *LoadBalancerService) AssignToLoadBalancerRuleRawWithContext(ctx context.Context, v url.Values) (*AssignToLoadBalancerRuleResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "assignToLoadBalancerRule", "assigntoloadbalancerruleresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -584,9 +1096,9 @@ func (s *LoadBalancerService) AssignToLoadBalancerRule(p *AssignToLoadBalancerRu
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -610,6 +1122,16 @@ type ConfigureF5LoadBalancerParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ConfigureF5LoadBalancerParams) Clone() *ConfigureF5LoadBalancerParams {
+	c := &ConfigureF5LoadBalancerParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ConfigureF5LoadBalancerParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -625,6 +1147,16 @@ func (p *ConfigureF5LoadBalancerParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *ConfigureF5LoadBalancerParams) validate() error {
+	for _, k := range []string{"lbdeviceid"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of configureF5LoadBalancer", k)
+		}
+	}
+	return nil
+}
+
 func (p *ConfigureF5LoadBalancerParams) SetLbdevicecapacity(v int64) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -633,6 +1165,13 @@ func (p *ConfigureF5LoadBalancerParams) SetLbdevicecapacity(v int64) {
 	return
 }
 
+// SetLbdevicecapacityIfUnset sets lbdevicecapacity to v, unless it is already set
+func (p *ConfigureF5LoadBalancerParams) SetLbdevicecapacityIfUnset(v int64) {
+	if _, found := p.p["lbdevicecapacity"]; !found {
+		p.SetLbdevicecapacity(v)
+	}
+}
+
 func (p *ConfigureF5LoadBalancerParams) SetLbdeviceid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -641,18 +1180,54 @@ func (p *ConfigureF5LoadBalancerParams) SetLbdeviceid(v string) {
 	return
 }
 
+// SetLbdeviceidIfUnset sets lbdeviceid to v, unless it is already set
+func (p *ConfigureF5LoadBalancerParams) SetLbdeviceidIfUnset(v string) {
+	if _, found := p.p["lbdeviceid"]; !found {
+		p.SetLbdeviceid(v)
+	}
+}
+
 // You should always use this function to get a new ConfigureF5LoadBalancerParams instance,
-// as then you are sure you have configured all required params
-func (s *LoadBalancerService) NewConfigureF5LoadBalancerParams(lbdeviceid string) *ConfigureF5LoadBalancerParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *LoadBalancerService) NewConfigureF5LoadBalancerParams(lbdeviceid string, opts ...func(*ConfigureF5LoadBalancerParams)) *ConfigureF5LoadBalancerParams {
 	p := &ConfigureF5LoadBalancerParams{}
 	p.p = make(map[string]interface{})
 	p.p["lbdeviceid"] = lbdeviceid
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // configures a F5 load balancer device
-func (s *LoadBalancerService) ConfigureF5LoadBalancer(p *ConfigureF5LoadBalancerParams) (*F5LoadBalancerResponse, error) {
-	resp, err := s.cs.newRequest("configureF5LoadBalancer", p.toURLValues())
+func (s *LoadBalancerService) ConfigureF5LoadBalancer(p *ConfigureF5LoadBalancerParams, opts ...OptionFunc) (*F5LoadBalancerResponse, error) {
+	return s.ConfigureF5LoadBalancerWithContext(context.Background(), p, opts...)
+}
+
+// ConfigureF5LoadBalancerWithContext is the same as ConfigureF5LoadBalancer, but the request is cancelled when the context is done
+func (s *LoadBalancerService) ConfigureF5LoadBalancerWithContext(ctx context.Context, p *ConfigureF5LoadBalancerParams, opts ...OptionFunc) (*F5LoadBalancerResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.ConfigureF5LoadBalancerRawWithContext(ctx, p.toURLValues())
+}
+
+// ConfigureF5LoadBalancerRaw is the same as ConfigureF5LoadBalancer, but takes the params as url.Values instead of typed params
+func (s *LoadBalancerService) ConfigureF5LoadBalancerRaw(v url.Values) (*F5LoadBalancerResponse, error) {
+	return s.ConfigureF5LoadBalancerRawWithContext(context.Background(), v)
+}
+
+// ConfigureF5LoadBalancerRawWithContext is the same as ConfigureF5LoadBalancerRaw, but the request is cancelled when the context is done
+func (s *LoadBalancerService) ConfigureF5LoadBalancerRawWithContext(ctx context.Context, v url.Values) (*F5LoadBalancerResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "configureF5LoadBalancer", "configuref5loadbalancerresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -664,9 +1239,9 @@ func (s *LoadBalancerService) ConfigureF5LoadBalancer(p *ConfigureF5LoadBalancer
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -703,6 +1278,16 @@ type ConfigureNetscalerLoadBalancerParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ConfigureNetscalerLoadBalancerParams) Clone() *ConfigureNetscalerLoadBalancerParams {
+	c := &ConfigureNetscalerLoadBalancerParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ConfigureNetscalerLoadBalancerParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -730,6 +1315,16 @@ func (p *ConfigureNetscalerLoadBalancerParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *ConfigureNetscalerLoadBalancerParams) validate() error {
+	for _, k := range []string{"lbdeviceid"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of configureNetscalerLoadBalancer", k)
+		}
+	}
+	return nil
+}
+
 func (p *ConfigureNetscalerLoadBalancerParams) SetInline(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -738,6 +1333,13 @@ func (p *ConfigureNetscalerLoadBalancerParams) SetInline(v bool) {
 	return
 }
 
+// SetInlineIfUnset sets inline to v, unless it is already set
+func (p *ConfigureNetscalerLoadBalancerParams) SetInlineIfUnset(v bool) {
+	if _, found := p.p["inline"]; !found {
+		p.SetInline(v)
+	}
+}
+
 func (p *ConfigureNetscalerLoadBalancerParams) SetLbdevicecapacity(v int64) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -746,6 +1348,13 @@ func (p *ConfigureNetscalerLoadBalancerParams) SetLbdevicecapacity(v int64) {
 	return
 }
 
+// SetLbdevicecapacityIfUnset sets lbdevicecapacity to v, unless it is already set
+func (p *ConfigureNetscalerLoadBalancerParams) SetLbdevicecapacityIfUnset(v int64) {
+	if _, found := p.p["lbdevicecapacity"]; !found {
+		p.SetLbdevicecapacity(v)
+	}
+}
+
 func (p *ConfigureNetscalerLoadBalancerParams) SetLbdevicededicated(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -754,6 +1363,13 @@ func (p *ConfigureNetscalerLoadBalancerParams) SetLbdevicededicated(v bool) {
 	return
 }
 
+// SetLbdevicededicatedIfUnset sets lbdevicededicated to v, unless it is already set
+func (p *ConfigureNetscalerLoadBalancerParams) SetLbdevicededicatedIfUnset(v bool) {
+	if _, found := p.p["lbdevicededicated"]; !found {
+		p.SetLbdevicededicated(v)
+	}
+}
+
 func (p *ConfigureNetscalerLoadBalancerParams) SetLbdeviceid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -762,6 +1378,13 @@ func (p *ConfigureNetscalerLoadBalancerParams) SetLbdeviceid(v string) {
 	return
 }
 
+// SetLbdeviceidIfUnset sets lbdeviceid to v, unless it is already set
+func (p *ConfigureNetscalerLoadBalancerParams) SetLbdeviceidIfUnset(v string) {
+	if _, found := p.p["lbdeviceid"]; !found {
+		p.SetLbdeviceid(v)
+	}
+}
+
 func (p *ConfigureNetscalerLoadBalancerParams) SetPodids(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -770,18 +1393,59 @@ func (p *ConfigureNetscalerLoadBalancerParams) SetPodids(v []string) {
 	return
 }
 
-// You should always use this function to get a new ConfigureNetscalerLoadBalancerParams instance,
-// as then you are sure you have configured all required params
-func (s *LoadBalancerService) NewConfigureNetscalerLoadBalancerParams(lbdeviceid string) *ConfigureNetscalerLoadBalancerParams {
+// SetPodidsIfUnset sets podids to v, unless it is already set
+func (p *ConfigureNetscalerLoadBalancerParams) SetPodidsIfUnset(v []string) {
+	if _, found := p.p["podids"]; !found {
+		p.SetPodids(v)
+	}
+}
+
+// SetPodidsCSV splits v on commas and sets the resulting values
+func (p *ConfigureNetscalerLoadBalancerParams) SetPodidsCSV(v string) {
+	p.SetPodids(splitCSV(v))
+}
+
+// You should always use this function to get a new ConfigureNetscalerLoadBalancerParams instance,
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *LoadBalancerService) NewConfigureNetscalerLoadBalancerParams(lbdeviceid string, opts ...func(*ConfigureNetscalerLoadBalancerParams)) *ConfigureNetscalerLoadBalancerParams {
 	p := &ConfigureNetscalerLoadBalancerParams{}
 	p.p = make(map[string]interface{})
 	p.p["lbdeviceid"] = lbdeviceid
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // configures a netscaler load balancer device
-func (s *LoadBalancerService) ConfigureNetscalerLoadBalancer(p *ConfigureNetscalerLoadBalancerParams) (*NetscalerLoadBalancerResponse, error) {
-	resp, err := s.cs.newRequest("configureNetscalerLoadBalancer", p.toURLValues())
+func (s *LoadBalancerService) ConfigureNetscalerLoadBalancer(p *ConfigureNetscalerLoadBalancerParams, opts ...OptionFunc) (*NetscalerLoadBalancerResponse, error) {
+	return s.ConfigureNetscalerLoadBalancerWithContext(context.Background(), p, opts...)
+}
+
+// ConfigureNetscalerLoadBalancerWithContext is the same as ConfigureNetscalerLoadBalancer, but the request is cancelled when the context is done
+func (s *LoadBalancerService) ConfigureNetscalerLoadBalancerWithContext(ctx context.Context, p *ConfigureNetscalerLoadBalancerParams, opts ...OptionFunc) (*NetscalerLoadBalancerResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.ConfigureNetscalerLoadBalancerRawWithContext(ctx, p.toURLValues())
+}
+
+// ConfigureNetscalerLoadBalancerRaw is the same as ConfigureNetscalerLoadBalancer, but takes the params as url.Values instead of typed params
+func (s *LoadBalancerService) ConfigureNetscalerLoadBalancerRaw(v url.Values) (*NetscalerLoadBalancerResponse, error) {
+	return s.ConfigureNetscalerLoadBalancerRawWithContext(context.Background(), v)
+}
+
+// ConfigureNetscalerLoadBalancerRawWithContext is the same as ConfigureNetscalerLoadBalancerRaw, but the request is cancelled when the context is done
+func (s *LoadBalancerService) ConfigureNetscalerLoadBalancerRawWithContext(ctx context.Context, v url.Values) (*NetscalerLoadBalancerResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "configureNetscalerLoadBalancer", "configurenetscalerloadbalancerresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -793,9 +1457,9 @@ func (s *LoadBalancerService) ConfigureNetscalerLoadBalancer(p *ConfigureNetscal
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -837,6 +1501,16 @@ type CreateGlobalLoadBalancerRuleParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *CreateGlobalLoadBalancerRuleParams) Clone() *CreateGlobalLoadBalancerRuleParams {
+	c := &CreateGlobalLoadBalancerRuleParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *CreateGlobalLoadBalancerRuleParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -873,6 +1547,16 @@ func (p *CreateGlobalLoadBalancerRuleParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *CreateGlobalLoadBalancerRuleParams) validate() error {
+	for _, k := range []string{"gslbdomainname", "gslbservicetype", "name", "regionid"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of createGlobalLoadBalancerRule", k)
+		}
+	}
+	return nil
+}
+
 func (p *CreateGlobalLoadBalancerRuleParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -881,6 +1565,13 @@ func (p *CreateGlobalLoadBalancerRuleParams) SetAccount(v string) {
 	return
 }
 
+// SetAccountIfUnset sets account to v, unless it is already set
+func (p *CreateGlobalLoadBalancerRuleParams) SetAccountIfUnset(v string) {
+	if _, found := p.p["account"]; !found {
+		p.SetAccount(v)
+	}
+}
+
 func (p *CreateGlobalLoadBalancerRuleParams) SetDescription(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -889,6 +1580,13 @@ func (p *CreateGlobalLoadBalancerRuleParams) SetDescription(v string) {
 	return
 }
 
+// SetDescriptionIfUnset sets description to v, unless it is already set
+func (p *CreateGlobalLoadBalancerRuleParams) SetDescriptionIfUnset(v string) {
+	if _, found := p.p["description"]; !found {
+		p.SetDescription(v)
+	}
+}
+
 func (p *CreateGlobalLoadBalancerRuleParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -897,6 +1595,13 @@ func (p *CreateGlobalLoadBalancerRuleParams) SetDomainid(v string) {
 	return
 }
 
+// SetDomainidIfUnset sets domainid to v, unless it is already set
+func (p *CreateGlobalLoadBalancerRuleParams) SetDomainidIfUnset(v string) {
+	if _, found := p.p["domainid"]; !found {
+		p.SetDomainid(v)
+	}
+}
+
 func (p *CreateGlobalLoadBalancerRuleParams) SetGslbdomainname(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -905,6 +1610,13 @@ func (p *CreateGlobalLoadBalancerRuleParams) SetGslbdomainname(v string) {
 	return
 }
 
+// SetGslbdomainnameIfUnset sets gslbdomainname to v, unless it is already set
+func (p *CreateGlobalLoadBalancerRuleParams) SetGslbdomainnameIfUnset(v string) {
+	if _, found := p.p["gslbdomainname"]; !found {
+		p.SetGslbdomainname(v)
+	}
+}
+
 func (p *CreateGlobalLoadBalancerRuleParams) SetGslblbmethod(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -913,6 +1625,13 @@ func (p *CreateGlobalLoadBalancerRuleParams) SetGslblbmethod(v string) {
 	return
 }
 
+// SetGslblbmethodIfUnset sets gslblbmethod to v, unless it is already set
+func (p *CreateGlobalLoadBalancerRuleParams) SetGslblbmethodIfUnset(v string) {
+	if _, found := p.p["gslblbmethod"]; !found {
+		p.SetGslblbmethod(v)
+	}
+}
+
 func (p *CreateGlobalLoadBalancerRuleParams) SetGslbservicetype(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -921,6 +1640,13 @@ func (p *CreateGlobalLoadBalancerRuleParams) SetGslbservicetype(v string) {
 	return
 }
 
+// SetGslbservicetypeIfUnset sets gslbservicetype to v, unless it is already set
+func (p *CreateGlobalLoadBalancerRuleParams) SetGslbservicetypeIfUnset(v string) {
+	if _, found := p.p["gslbservicetype"]; !found {
+		p.SetGslbservicetype(v)
+	}
+}
+
 func (p *CreateGlobalLoadBalancerRuleParams) SetGslbstickysessionmethodname(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -929,6 +1655,13 @@ func (p *CreateGlobalLoadBalancerRuleParams) SetGslbstickysessionmethodname(v st
 	return
 }
 
+// SetGslbstickysessionmethodnameIfUnset sets gslbstickysessionmethodname to v, unless it is already set
+func (p *CreateGlobalLoadBalancerRuleParams) SetGslbstickysessionmethodnameIfUnset(v string) {
+	if _, found := p.p["gslbstickysessionmethodname"]; !found {
+		p.SetGslbstickysessionmethodname(v)
+	}
+}
+
 func (p *CreateGlobalLoadBalancerRuleParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -937,6 +1670,13 @@ func (p *CreateGlobalLoadBalancerRuleParams) SetName(v string) {
 	return
 }
 
+// SetNameIfUnset sets name to v, unless it is already set
+func (p *CreateGlobalLoadBalancerRuleParams) SetNameIfUnset(v string) {
+	if _, found := p.p["name"]; !found {
+		p.SetName(v)
+	}
+}
+
 func (p *CreateGlobalLoadBalancerRuleParams) SetRegionid(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -945,21 +1685,93 @@ func (p *CreateGlobalLoadBalancerRuleParams) SetRegionid(v int) {
 	return
 }
 
+// SetRegionidIfUnset sets regionid to v, unless it is already set
+func (p *CreateGlobalLoadBalancerRuleParams) SetRegionidIfUnset(v int) {
+	if _, found := p.p["regionid"]; !found {
+		p.SetRegionid(v)
+	}
+}
+
 // You should always use this function to get a new CreateGlobalLoadBalancerRuleParams instance,
-// as then you are sure you have configured all required params
-func (s *LoadBalancerService) NewCreateGlobalLoadBalancerRuleParams(gslbdomainname string, gslbservicetype string, name string, regionid int) *CreateGlobalLoadBalancerRuleParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *LoadBalancerService) NewCreateGlobalLoadBalancerRuleParams(gslbdomainname string, gslbservicetype string, name string, regionid int, opts ...func(*CreateGlobalLoadBalancerRuleParams)) *CreateGlobalLoadBalancerRuleParams {
 	p := &CreateGlobalLoadBalancerRuleParams{}
 	p.p = make(map[string]interface{})
 	p.p["gslbdomainname"] = gslbdomainname
 	p.p["gslbservicetype"] = gslbservicetype
 	p.p["name"] = name
 	p.p["regionid"] = regionid
+	for _, fn := range opts {
+		fn(p)
+	}
+	return p
+}
+
+// CreateGlobalLoadBalancerRuleOpts contains all params that can be set on a CreateGlobalLoadBalancerRuleParams.
+// Optional params are pointers (or slices and maps) which are only set when not nil.
+type CreateGlobalLoadBalancerRuleOpts struct {
+	Account                     *string
+	Description                 *string
+	Domainid                    *string
+	Gslbdomainname              string
+	Gslblbmethod                *string
+	Gslbservicetype             string
+	Gslbstickysessionmethodname *string
+	Name                        string
+	Regionid                    int
+}
+
+// NewCreateGlobalLoadBalancerRuleParamsFromOpts is an alternative for NewCreateGlobalLoadBalancerRuleParams which takes all params using
+// an options struct, as that is easier to read for APIs with many required params
+func (s *LoadBalancerService) NewCreateGlobalLoadBalancerRuleParamsFromOpts(o CreateGlobalLoadBalancerRuleOpts) *CreateGlobalLoadBalancerRuleParams {
+	p := s.NewCreateGlobalLoadBalancerRuleParams(o.Gslbdomainname, o.Gslbservicetype, o.Name, o.Regionid)
+	if o.Account != nil {
+		p.p["account"] = *o.Account
+	}
+	if o.Description != nil {
+		p.p["description"] = *o.Description
+	}
+	if o.Domainid != nil {
+		p.p["domainid"] = *o.Domainid
+	}
+	if o.Gslblbmethod != nil {
+		p.p["gslblbmethod"] = *o.Gslblbmethod
+	}
+	if o.Gslbstickysessionmethodname != nil {
+		p.p["gslbstickysessionmethodname"] = *o.Gslbstickysessionmethodname
+	}
 	return p
 }
 
 // Creates a global load balancer rule
-func (s *LoadBalancerService) CreateGlobalLoadBalancerRule(p *CreateGlobalLoadBalancerRuleParams) (*CreateGlobalLoadBalancerRuleResponse, error) {
-	resp, err := s.cs.newRequest("createGlobalLoadBalancerRule", p.toURLValues())
+func (s *LoadBalancerService) CreateGlobalLoadBalancerRule(p *CreateGlobalLoadBalancerRuleParams, opts ...OptionFunc) (*CreateGlobalLoadBalancerRuleResponse, error) {
+	return s.CreateGlobalLoadBalancerRuleWithContext(context.Background(), p, opts...)
+}
+
+// CreateGlobalLoadBalancerRuleWithContext is the same as CreateGlobalLoadBalancerRule, but the request is cancelled when the context is done
+func (s *LoadBalancerService) CreateGlobalLoadBalancerRuleWithContext(ctx context.Context, p *CreateGlobalLoadBalancerRuleParams, opts ...OptionFunc) (*CreateGlobalLoadBalancerRuleResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.CreateGlobalLoadBalancerRuleRawWithContext(ctx, p.toURLValues())
+}
+
+// CreateGlobalLoadBalancerRuleRaw is the same as CreateGlobalLoadBalancerRule, but takes the params as url.Values instead of typed params
+func (s *LoadBalancerService) CreateGlobalLoadBalancerRuleRaw(v url.Values) (*CreateGlobalLoadBalancerRuleResponse, error) {
+	return s.CreateGlobalLoadBalancerRuleRawWithContext(context.Background(), v)
+}
+
+// CreateGlobalLoadBalancerRuleRawWithContext is the same as CreateGlobalLoadBalancerRuleRaw, but the request is cancelled when the context is done
+func (s *LoadBalancerService) CreateGlobalLoadBalancerRuleRawWithContext(ctx context.Context, v url.Values) (*CreateGlobalLoadBalancerRuleResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "createGlobalLoadBalancerRule", "creategloballoadbalancerruleresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -971,9 +1783,9 @@ func (s *LoadBalancerService) CreateGlobalLoadBalancerRule(p *CreateGlobalLoadBa
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -1046,6 +1858,16 @@ type CreateLBHealthCheckPolicyParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *CreateLBHealthCheckPolicyParams) Clone() *CreateLBHealthCheckPolicyParams {
+	c := &CreateLBHealthCheckPolicyParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *CreateLBHealthCheckPolicyParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1083,6 +1905,16 @@ func (p *CreateLBHealthCheckPolicyParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *CreateLBHealthCheckPolicyParams) validate() error {
+	for _, k := range []string{"lbruleid"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of createLBHealthCheckPolicy", k)
+		}
+	}
+	return nil
+}
+
 func (p *CreateLBHealthCheckPolicyParams) SetDescription(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1091,6 +1923,13 @@ func (p *CreateLBHealthCheckPolicyParams) SetDescription(v string) {
 	return
 }
 
+// SetDescriptionIfUnset sets description to v, unless it is already set
+func (p *CreateLBHealthCheckPolicyParams) SetDescriptionIfUnset(v string) {
+	if _, found := p.p["description"]; !found {
+		p.SetDescription(v)
+	}
+}
+
 func (p *CreateLBHealthCheckPolicyParams) SetFordisplay(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1099,6 +1938,13 @@ func (p *CreateLBHealthCheckPolicyParams) SetFordisplay(v bool) {
 	return
 }
 
+// SetFordisplayIfUnset sets fordisplay to v, unless it is already set
+func (p *CreateLBHealthCheckPolicyParams) SetFordisplayIfUnset(v bool) {
+	if _, found := p.p["fordisplay"]; !found {
+		p.SetFordisplay(v)
+	}
+}
+
 func (p *CreateLBHealthCheckPolicyParams) SetHealthythreshold(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1107,6 +1953,13 @@ func (p *CreateLBHealthCheckPolicyParams) SetHealthythreshold(v int) {
 	return
 }
 
+// SetHealthythresholdIfUnset sets healthythreshold to v, unless it is already set
+func (p *CreateLBHealthCheckPolicyParams) SetHealthythresholdIfUnset(v int) {
+	if _, found := p.p["healthythreshold"]; !found {
+		p.SetHealthythreshold(v)
+	}
+}
+
 func (p *CreateLBHealthCheckPolicyParams) SetIntervaltime(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1115,6 +1968,13 @@ func (p *CreateLBHealthCheckPolicyParams) SetIntervaltime(v int) {
 	return
 }
 
+// SetIntervaltimeIfUnset sets intervaltime to v, unless it is already set
+func (p *CreateLBHealthCheckPolicyParams) SetIntervaltimeIfUnset(v int) {
+	if _, found := p.p["intervaltime"]; !found {
+		p.SetIntervaltime(v)
+	}
+}
+
 func (p *CreateLBHealthCheckPolicyParams) SetLbruleid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1123,6 +1983,13 @@ func (p *CreateLBHealthCheckPolicyParams) SetLbruleid(v string) {
 	return
 }
 
+// SetLbruleidIfUnset sets lbruleid to v, unless it is already set
+func (p *CreateLBHealthCheckPolicyParams) SetLbruleidIfUnset(v string) {
+	if _, found := p.p["lbruleid"]; !found {
+		p.SetLbruleid(v)
+	}
+}
+
 func (p *CreateLBHealthCheckPolicyParams) SetPingpath(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1131,6 +1998,13 @@ func (p *CreateLBHealthCheckPolicyParams) SetPingpath(v string) {
 	return
 }
 
+// SetPingpathIfUnset sets pingpath to v, unless it is already set
+func (p *CreateLBHealthCheckPolicyParams) SetPingpathIfUnset(v string) {
+	if _, found := p.p["pingpath"]; !found {
+		p.SetPingpath(v)
+	}
+}
+
 func (p *CreateLBHealthCheckPolicyParams) SetResponsetimeout(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1139,6 +2013,13 @@ func (p *CreateLBHealthCheckPolicyParams) SetResponsetimeout(v int) {
 	return
 }
 
+// SetResponsetimeoutIfUnset sets responsetimeout to v, unless it is already set
+func (p *CreateLBHealthCheckPolicyParams) SetResponsetimeoutIfUnset(v int) {
+	if _, found := p.p["responsetimeout"]; !found {
+		p.SetResponsetimeout(v)
+	}
+}
+
 func (p *CreateLBHealthCheckPolicyParams) SetUnhealthythreshold(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1147,18 +2028,54 @@ func (p *CreateLBHealthCheckPolicyParams) SetUnhealthythreshold(v int) {
 	return
 }
 
+// SetUnhealthythresholdIfUnset sets unhealthythreshold to v, unless it is already set
+func (p *CreateLBHealthCheckPolicyParams) SetUnhealthythresholdIfUnset(v int) {
+	if _, found := p.p["unhealthythreshold"]; !found {
+		p.SetUnhealthythreshold(v)
+	}
+}
+
 // You should always use this function to get a new CreateLBHealthCheckPolicyParams instance,
-// as then you are sure you have configured all required params
-func (s *LoadBalancerService) NewCreateLBHealthCheckPolicyParams(lbruleid string) *CreateLBHealthCheckPolicyParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *LoadBalancerService) NewCreateLBHealthCheckPolicyParams(lbruleid string, opts ...func(*CreateLBHealthCheckPolicyParams)) *CreateLBHealthCheckPolicyParams {
 	p := &CreateLBHealthCheckPolicyParams{}
 	p.p = make(map[string]interface{})
 	p.p["lbruleid"] = lbruleid
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Creates a load balancer health check policy
-func (s *LoadBalancerService) CreateLBHealthCheckPolicy(p *CreateLBHealthCheckPolicyParams) (*CreateLBHealthCheckPolicyResponse, error) {
-	resp, err := s.cs.newRequest("createLBHealthCheckPolicy", p.toURLValues())
+func (s *LoadBalancerService) CreateLBHealthCheckPolicy(p *CreateLBHealthCheckPolicyParams, opts ...OptionFunc) (*CreateLBHealthCheckPolicyResponse, error) {
+	return s.CreateLBHealthCheckPolicyWithContext(context.Background(), p, opts...)
+}
+
+// CreateLBHealthCheckPolicyWithContext is the same as CreateLBHealthCheckPolicy, but the request is cancelled when the context is done
+func (s *LoadBalancerService) CreateLBHealthCheckPolicyWithContext(ctx context.Context, p *CreateLBHealthCheckPolicyParams, opts ...OptionFunc) (*CreateLBHealthCheckPolicyResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.CreateLBHealthCheckPolicyRawWithContext(ctx, p.toURLValues())
+}
+
+// CreateLBHealthCheckPolicyRaw is the same as CreateLBHealthCheckPolicy, but takes the params as url.Values instead of typed params
+func (s *LoadBalancerService) CreateLBHealthCheckPolicyRaw(v url.Values) (*CreateLBHealthCheckPolicyResponse, error) {
+	return s.CreateLBHealthCheckPolicyRawWithContext(context.Background(), v)
+}
+
+// CreateLBHealthCheckPolicyRawWithContext is the same as CreateLBHealthCheckPolicyRaw, but the request is cancelled when the context is done
+func (s *LoadBalancerService) CreateLBHealthCheckPolicyRawWithContext(ctx context.Context, v url.Values) (*CreateLBHealthCheckPolicyResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "createLBHealthCheckPolicy", "createlbhealthcheckpolicyresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -1170,9 +2087,9 @@ func (s *LoadBalancerService) CreateLBHealthCheckPolicy(p *CreateLBHealthCheckPo
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -1215,6 +2132,16 @@ type CreateLBStickinessPolicyParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *CreateLBStickinessPolicyParams) Clone() *CreateLBStickinessPolicyParams {
+	c := &CreateLBStickinessPolicyParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *CreateLBStickinessPolicyParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1237,16 +2164,25 @@ func (p *CreateLBStickinessPolicyParams) toURLValues() url.Values {
 		u.Set("name", v.(string))
 	}
 	if v, found := p.p["param"]; found {
-		i := 0
-		for k, vv := range v.(map[string]string) {
+		m := v.(map[string]string)
+		for i, k := range getSortedKeysFromMap(m) {
 			u.Set(fmt.Sprintf("param[%d].key", i), k)
-			u.Set(fmt.Sprintf("param[%d].value", i), vv)
-			i++
+			u.Set(fmt.Sprintf("param[%d].value", i), m[k])
 		}
 	}
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *CreateLBStickinessPolicyParams) validate() error {
+	for _, k := range []string{"lbruleid", "methodname", "name"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of createLBStickinessPolicy", k)
+		}
+	}
+	return nil
+}
+
 func (p *CreateLBStickinessPolicyParams) SetDescription(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1255,6 +2191,13 @@ func (p *CreateLBStickinessPolicyParams) SetDescription(v string) {
 	return
 }
 
+// SetDescriptionIfUnset sets description to v, unless it is already set
+func (p *CreateLBStickinessPolicyParams) SetDescriptionIfUnset(v string) {
+	if _, found := p.p["description"]; !found {
+		p.SetDescription(v)
+	}
+}
+
 func (p *CreateLBStickinessPolicyParams) SetFordisplay(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1263,6 +2206,13 @@ func (p *CreateLBStickinessPolicyParams) SetFordisplay(v bool) {
 	return
 }
 
+// SetFordisplayIfUnset sets fordisplay to v, unless it is already set
+func (p *CreateLBStickinessPolicyParams) SetFordisplayIfUnset(v bool) {
+	if _, found := p.p["fordisplay"]; !found {
+		p.SetFordisplay(v)
+	}
+}
+
 func (p *CreateLBStickinessPolicyParams) SetLbruleid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1271,6 +2221,13 @@ func (p *CreateLBStickinessPolicyParams) SetLbruleid(v string) {
 	return
 }
 
+// SetLbruleidIfUnset sets lbruleid to v, unless it is already set
+func (p *CreateLBStickinessPolicyParams) SetLbruleidIfUnset(v string) {
+	if _, found := p.p["lbruleid"]; !found {
+		p.SetLbruleid(v)
+	}
+}
+
 func (p *CreateLBStickinessPolicyParams) SetMethodname(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1279,6 +2236,13 @@ func (p *CreateLBStickinessPolicyParams) SetMethodname(v string) {
 	return
 }
 
+// SetMethodnameIfUnset sets methodname to v, unless it is already set
+func (p *CreateLBStickinessPolicyParams) SetMethodnameIfUnset(v string) {
+	if _, found := p.p["methodname"]; !found {
+		p.SetMethodname(v)
+	}
+}
+
 func (p *CreateLBStickinessPolicyParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1287,6 +2251,13 @@ func (p *CreateLBStickinessPolicyParams) SetName(v string) {
 	return
 }
 
+// SetNameIfUnset sets name to v, unless it is already set
+func (p *CreateLBStickinessPolicyParams) SetNameIfUnset(v string) {
+	if _, found := p.p["name"]; !found {
+		p.SetName(v)
+	}
+}
+
 func (p *CreateLBStickinessPolicyParams) SetParam(v map[string]string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1295,20 +2266,83 @@ func (p *CreateLBStickinessPolicyParams) SetParam(v map[string]string) {
 	return
 }
 
+// SetParamIfUnset sets param to v, unless it is already set
+func (p *CreateLBStickinessPolicyParams) SetParamIfUnset(v map[string]string) {
+	if _, found := p.p["param"]; !found {
+		p.SetParam(v)
+	}
+}
+
 // You should always use this function to get a new CreateLBStickinessPolicyParams instance,
-// as then you are sure you have configured all required params
-func (s *LoadBalancerService) NewCreateLBStickinessPolicyParams(lbruleid string, methodname string, name string) *CreateLBStickinessPolicyParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *LoadBalancerService) NewCreateLBStickinessPolicyParams(lbruleid string, methodname string, name string, opts ...func(*CreateLBStickinessPolicyParams)) *CreateLBStickinessPolicyParams {
 	p := &CreateLBStickinessPolicyParams{}
 	p.p = make(map[string]interface{})
 	p.p["lbruleid"] = lbruleid
 	p.p["methodname"] = methodname
 	p.p["name"] = name
+	for _, fn := range opts {
+		fn(p)
+	}
+	return p
+}
+
+// CreateLBStickinessPolicyOpts contains all params that can be set on a CreateLBStickinessPolicyParams.
+// Optional params are pointers (or slices and maps) which are only set when not nil.
+type CreateLBStickinessPolicyOpts struct {
+	Description *string
+	Fordisplay  *bool
+	Lbruleid    string
+	Methodname  string
+	Name        string
+	Param       map[string]string
+}
+
+// NewCreateLBStickinessPolicyParamsFromOpts is an alternative for NewCreateLBStickinessPolicyParams which takes all params using
+// an options struct, as that is easier to read for APIs with many required params
+func (s *LoadBalancerService) NewCreateLBStickinessPolicyParamsFromOpts(o CreateLBStickinessPolicyOpts) *CreateLBStickinessPolicyParams {
+	p := s.NewCreateLBStickinessPolicyParams(o.Lbruleid, o.Methodname, o.Name)
+	if o.Description != nil {
+		p.p["description"] = *o.Description
+	}
+	if o.Fordisplay != nil {
+		p.p["fordisplay"] = *o.Fordisplay
+	}
+	if o.Param != nil {
+		p.p["param"] = o.Param
+	}
 	return p
 }
 
 // Creates a load balancer stickiness policy
-func (s *LoadBalancerService) CreateLBStickinessPolicy(p *CreateLBStickinessPolicyParams) (*CreateLBStickinessPolicyResponse, error) {
-	resp, err := s.cs.newRequest("createLBStickinessPolicy", p.toURLValues())
+func (s *LoadBalancerService) CreateLBStickinessPolicy(p *CreateLBStickinessPolicyParams, opts ...OptionFunc) (*CreateLBStickinessPolicyResponse, error) {
+	return s.CreateLBStickinessPolicyWithContext(context.Background(), p, opts...)
+}
+
+// CreateLBStickinessPolicyWithContext is the same as CreateLBStickinessPolicy, but the request is cancelled when the context is done
+func (s *LoadBalancerService) CreateLBStickinessPolicyWithContext(ctx context.Context, p *CreateLBStickinessPolicyParams, opts ...OptionFunc) (*CreateLBStickinessPolicyResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.CreateLBStickinessPolicyRawWithContext(ctx, p.toURLValues())
+}
+
+// CreateLBStickinessPolicyRaw is the same as CreateLBStickinessPolicy, but takes the params as url.Values instead of typed params
+func (s *LoadBalancerService) CreateLBStickinessPolicyRaw(v url.Values) (*CreateLBStickinessPolicyResponse, error) {
+	return s.CreateLBStickinessPolicyRawWithContext(context.Background(), v)
+}
+
+// CreateLBStickinessPolicyRawWithContext is the same as CreateLBStickinessPolicyRaw, but the request is cancelled when the context is done
+func (s *LoadBalancerService) CreateLBStickinessPolicyRawWithContext(ctx context.Context, v url.Values) (*CreateLBStickinessPolicyResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "createLBStickinessPolicy", "createlbstickinesspolicyresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -1320,9 +2354,9 @@ func (s *LoadBalancerService) CreateLBStickinessPolicy(p *CreateLBStickinessPoli
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -1351,13 +2385,13 @@ type CreateLBStickinessPolicyResponse struct {
 	Name             string `json:"name"`
 	State            string `json:"state"`
 	Stickinesspolicy []struct {
-		Description string            `json:"description"`
-		Fordisplay  bool              `json:"fordisplay"`
-		Id          string            `json:"id"`
-		Methodname  string            `json:"methodname"`
-		Name        string            `json:"name"`
-		Params      map[string]string `json:"params"`
-		State       string            `json:"state"`
+		Description string    `json:"description"`
+		Fordisplay  bool      `json:"fordisplay"`
+		Id          string    `json:"id"`
+		Methodname  string    `json:"methodname"`
+		Name        string    `json:"name"`
+		Params      StringMap `json:"params"`
+		State       string    `json:"state"`
 	} `json:"stickinesspolicy"`
 	Zoneid string `json:"zoneid"`
 }
@@ -1366,6 +2400,16 @@ type CreateLoadBalancerParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *CreateLoadBalancerParams) Clone() *CreateLoadBalancerParams {
+	c := &CreateLoadBalancerParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *CreateLoadBalancerParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1407,6 +2451,16 @@ func (p *CreateLoadBalancerParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *CreateLoadBalancerParams) validate() error {
+	for _, k := range []string{"algorithm", "instanceport", "name", "networkid", "scheme", "sourceipaddressnetworkid", "sourceport"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of createLoadBalancer", k)
+		}
+	}
+	return nil
+}
+
 func (p *CreateLoadBalancerParams) SetAlgorithm(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1415,6 +2469,13 @@ func (p *CreateLoadBalancerParams) SetAlgorithm(v string) {
 	return
 }
 
+// SetAlgorithmIfUnset sets algorithm to v, unless it is already set
+func (p *CreateLoadBalancerParams) SetAlgorithmIfUnset(v string) {
+	if _, found := p.p["algorithm"]; !found {
+		p.SetAlgorithm(v)
+	}
+}
+
 func (p *CreateLoadBalancerParams) SetDescription(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1423,6 +2484,13 @@ func (p *CreateLoadBalancerParams) SetDescription(v string) {
 	return
 }
 
+// SetDescriptionIfUnset sets description to v, unless it is already set
+func (p *CreateLoadBalancerParams) SetDescriptionIfUnset(v string) {
+	if _, found := p.p["description"]; !found {
+		p.SetDescription(v)
+	}
+}
+
 func (p *CreateLoadBalancerParams) SetFordisplay(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1431,6 +2499,13 @@ func (p *CreateLoadBalancerParams) SetFordisplay(v bool) {
 	return
 }
 
+// SetFordisplayIfUnset sets fordisplay to v, unless it is already set
+func (p *CreateLoadBalancerParams) SetFordisplayIfUnset(v bool) {
+	if _, found := p.p["fordisplay"]; !found {
+		p.SetFordisplay(v)
+	}
+}
+
 func (p *CreateLoadBalancerParams) SetInstanceport(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1439,6 +2514,13 @@ func (p *CreateLoadBalancerParams) SetInstanceport(v int) {
 	return
 }
 
+// SetInstanceportIfUnset sets instanceport to v, unless it is already set
+func (p *CreateLoadBalancerParams) SetInstanceportIfUnset(v int) {
+	if _, found := p.p["instanceport"]; !found {
+		p.SetInstanceport(v)
+	}
+}
+
 func (p *CreateLoadBalancerParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1447,6 +2529,13 @@ func (p *CreateLoadBalancerParams) SetName(v string) {
 	return
 }
 
+// SetNameIfUnset sets name to v, unless it is already set
+func (p *CreateLoadBalancerParams) SetNameIfUnset(v string) {
+	if _, found := p.p["name"]; !found {
+		p.SetName(v)
+	}
+}
+
 func (p *CreateLoadBalancerParams) SetNetworkid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1455,6 +2544,13 @@ func (p *CreateLoadBalancerParams) SetNetworkid(v string) {
 	return
 }
 
+// SetNetworkidIfUnset sets networkid to v, unless it is already set
+func (p *CreateLoadBalancerParams) SetNetworkidIfUnset(v string) {
+	if _, found := p.p["networkid"]; !found {
+		p.SetNetworkid(v)
+	}
+}
+
 func (p *CreateLoadBalancerParams) SetScheme(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1463,6 +2559,13 @@ func (p *CreateLoadBalancerParams) SetScheme(v string) {
 	return
 }
 
+// SetSchemeIfUnset sets scheme to v, unless it is already set
+func (p *CreateLoadBalancerParams) SetSchemeIfUnset(v string) {
+	if _, found := p.p["scheme"]; !found {
+		p.SetScheme(v)
+	}
+}
+
 func (p *CreateLoadBalancerParams) SetSourceipaddress(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1471,6 +2574,13 @@ func (p *CreateLoadBalancerParams) SetSourceipaddress(v string) {
 	return
 }
 
+// SetSourceipaddressIfUnset sets sourceipaddress to v, unless it is already set
+func (p *CreateLoadBalancerParams) SetSourceipaddressIfUnset(v string) {
+	if _, found := p.p["sourceipaddress"]; !found {
+		p.SetSourceipaddress(v)
+	}
+}
+
 func (p *CreateLoadBalancerParams) SetSourceipaddressnetworkid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1479,6 +2589,13 @@ func (p *CreateLoadBalancerParams) SetSourceipaddressnetworkid(v string) {
 	return
 }
 
+// SetSourceipaddressnetworkidIfUnset sets sourceipaddressnetworkid to v, unless it is already set
+func (p *CreateLoadBalancerParams) SetSourceipaddressnetworkidIfUnset(v string) {
+	if _, found := p.p["sourceipaddressnetworkid"]; !found {
+		p.SetSourceipaddressnetworkid(v)
+	}
+}
+
 func (p *CreateLoadBalancerParams) SetSourceport(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1487,9 +2604,17 @@ func (p *CreateLoadBalancerParams) SetSourceport(v int) {
 	return
 }
 
+// SetSourceportIfUnset sets sourceport to v, unless it is already set
+func (p *CreateLoadBalancerParams) SetSourceportIfUnset(v int) {
+	if _, found := p.p["sourceport"]; !found {
+		p.SetSourceport(v)
+	}
+}
+
 // You should always use this function to get a new CreateLoadBalancerParams instance,
-// as then you are sure you have configured all required params
-func (s *LoadBalancerService) NewCreateLoadBalancerParams(algorithm string, instanceport int, name string, networkid string, scheme string, sourceipaddressnetworkid string, sourceport int) *CreateLoadBalancerParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *LoadBalancerService) NewCreateLoadBalancerParams(algorithm string, instanceport int, name string, networkid string, scheme string, sourceipaddressnetworkid string, sourceport int, opts ...func(*CreateLoadBalancerParams)) *CreateLoadBalancerParams {
 	p := &CreateLoadBalancerParams{}
 	p.p = make(map[string]interface{})
 	p.p["algorithm"] = algorithm
@@ -1499,12 +2624,71 @@ func (s *LoadBalancerService) NewCreateLoadBalancerParams(algorithm string, inst
 	p.p["scheme"] = scheme
 	p.p["sourceipaddressnetworkid"] = sourceipaddressnetworkid
 	p.p["sourceport"] = sourceport
+	for _, fn := range opts {
+		fn(p)
+	}
+	return p
+}
+
+// CreateLoadBalancerOpts contains all params that can be set on a CreateLoadBalancerParams.
+// Optional params are pointers (or slices and maps) which are only set when not nil.
+type CreateLoadBalancerOpts struct {
+	Algorithm                string
+	Description              *string
+	Fordisplay               *bool
+	Instanceport             int
+	Name                     string
+	Networkid                string
+	Scheme                   string
+	Sourceipaddress          *string
+	Sourceipaddressnetworkid string
+	Sourceport               int
+}
+
+// NewCreateLoadBalancerParamsFromOpts is an alternative for NewCreateLoadBalancerParams which takes all params using
+// an options struct, as that is easier to read for APIs with many required params
+func (s *LoadBalancerService) NewCreateLoadBalancerParamsFromOpts(o CreateLoadBalancerOpts) *CreateLoadBalancerParams {
+	p := s.NewCreateLoadBalancerParams(o.Algorithm, o.Instanceport, o.Name, o.Networkid, o.Scheme, o.Sourceipaddressnetworkid, o.Sourceport)
+	if o.Description != nil {
+		p.p["description"] = *o.Description
+	}
+	if o.Fordisplay != nil {
+		p.p["fordisplay"] = *o.Fordisplay
+	}
+	if o.Sourceipaddress != nil {
+		p.p["sourceipaddress"] = *o.Sourceipaddress
+	}
 	return p
 }
 
 // Creates a load balancer
-func (s *LoadBalancerService) CreateLoadBalancer(p *CreateLoadBalancerParams) (*CreateLoadBalancerResponse, error) {
-	resp, err := s.cs.newRequest("createLoadBalancer", p.toURLValues())
+func (s *LoadBalancerService) CreateLoadBalancer(p *CreateLoadBalancerParams, opts ...OptionFunc) (*CreateLoadBalancerResponse, error) {
+	return s.CreateLoadBalancerWithContext(context.Background(), p, opts...)
+}
+
+// CreateLoadBalancerWithContext is the same as CreateLoadBalancer, but the request is cancelled when the context is done
+func (s *LoadBalancerService) CreateLoadBalancerWithContext(ctx context.Context, p *CreateLoadBalancerParams, opts ...OptionFunc) (*CreateLoadBalancerResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.CreateLoadBalancerRawWithContext(ctx, p.toURLValues())
+}
+
+// CreateLoadBalancerRaw is the same as CreateLoadBalancer, but takes the params as url.Values instead of typed params
+func (s *LoadBalancerService) CreateLoadBalancerRaw(v url.Values) (*CreateLoadBalancerResponse, error) {
+	return s.CreateLoadBalancerRawWithContext(context.Background(), v)
+}
+
+// CreateLoadBalancerRawWithContext is the same as CreateLoadBalancerRaw, but the request is cancelled when the context is done
+func (s *LoadBalancerService) CreateLoadBalancerRawWithContext(ctx context.Context, v url.Values) (*CreateLoadBalancerResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "createLoadBalancer", "createloadbalancerresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -1516,9 +2700,9 @@ func (s *LoadBalancerService) CreateLoadBalancer(p *CreateLoadBalancerParams) (*
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -1581,6 +2765,16 @@ type CreateLoadBalancerRuleParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *CreateLoadBalancerRuleParams) Clone() *CreateLoadBalancerRuleParams {
+	c := &CreateLoadBalancerRuleParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *CreateLoadBalancerRuleParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1636,6 +2830,16 @@ func (p *CreateLoadBalancerRuleParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *CreateLoadBalancerRuleParams) validate() error {
+	for _, k := range []string{"algorithm", "name", "privateport", "publicport"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of createLoadBalancerRule", k)
+		}
+	}
+	return nil
+}
+
 func (p *CreateLoadBalancerRuleParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1644,6 +2848,13 @@ func (p *CreateLoadBalancerRuleParams) SetAccount(v string) {
 	return
 }
 
+// SetAccountIfUnset sets account to v, unless it is already set
+func (p *CreateLoadBalancerRuleParams) SetAccountIfUnset(v string) {
+	if _, found := p.p["account"]; !found {
+		p.SetAccount(v)
+	}
+}
+
 func (p *CreateLoadBalancerRuleParams) SetAlgorithm(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1652,6 +2863,13 @@ func (p *CreateLoadBalancerRuleParams) SetAlgorithm(v string) {
 	return
 }
 
+// SetAlgorithmIfUnset sets algorithm to v, unless it is already set
+func (p *CreateLoadBalancerRuleParams) SetAlgorithmIfUnset(v string) {
+	if _, found := p.p["algorithm"]; !found {
+		p.SetAlgorithm(v)
+	}
+}
+
 func (p *CreateLoadBalancerRuleParams) SetCidrlist(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1660,6 +2878,18 @@ func (p *CreateLoadBalancerRuleParams) SetCidrlist(v []string) {
 	return
 }
 
+// SetCidrlistIfUnset sets cidrlist to v, unless it is already set
+func (p *CreateLoadBalancerRuleParams) SetCidrlistIfUnset(v []string) {
+	if _, found := p.p["cidrlist"]; !found {
+		p.SetCidrlist(v)
+	}
+}
+
+// SetCidrlistCSV splits v on commas and sets the resulting values
+func (p *CreateLoadBalancerRuleParams) SetCidrlistCSV(v string) {
+	p.SetCidrlist(splitCSV(v))
+}
+
 func (p *CreateLoadBalancerRuleParams) SetDescription(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1668,6 +2898,13 @@ func (p *CreateLoadBalancerRuleParams) SetDescription(v string) {
 	return
 }
 
+// SetDescriptionIfUnset sets description to v, unless it is already set
+func (p *CreateLoadBalancerRuleParams) SetDescriptionIfUnset(v string) {
+	if _, found := p.p["description"]; !found {
+		p.SetDescription(v)
+	}
+}
+
 func (p *CreateLoadBalancerRuleParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1676,7 +2913,14 @@ func (p *CreateLoadBalancerRuleParams) SetDomainid(v string) {
 	return
 }
 
-func (p *CreateLoadBalancerRuleParams) SetFordisplay(v bool) {
+// SetDomainidIfUnset sets domainid to v, unless it is already set
+func (p *CreateLoadBalancerRuleParams) SetDomainidIfUnset(v string) {
+	if _, found := p.p["domainid"]; !found {
+		p.SetDomainid(v)
+	}
+}
+
+func (p *CreateLoadBalancerRuleParams) SetFordisplay(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
@@ -1684,6 +2928,13 @@ func (p *CreateLoadBalancerRuleParams) SetFordisplay(v bool) {
 	return
 }
 
+// SetFordisplayIfUnset sets fordisplay to v, unless it is already set
+func (p *CreateLoadBalancerRuleParams) SetFordisplayIfUnset(v bool) {
+	if _, found := p.p["fordisplay"]; !found {
+		p.SetFordisplay(v)
+	}
+}
+
 func (p *CreateLoadBalancerRuleParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1692,6 +2943,13 @@ func (p *CreateLoadBalancerRuleParams) SetName(v string) {
 	return
 }
 
+// SetNameIfUnset sets name to v, unless it is already set
+func (p *CreateLoadBalancerRuleParams) SetNameIfUnset(v string) {
+	if _, found := p.p["name"]; !found {
+		p.SetName(v)
+	}
+}
+
 func (p *CreateLoadBalancerRuleParams) SetNetworkid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1700,6 +2958,13 @@ func (p *CreateLoadBalancerRuleParams) SetNetworkid(v string) {
 	return
 }
 
+// SetNetworkidIfUnset sets networkid to v, unless it is already set
+func (p *CreateLoadBalancerRuleParams) SetNetworkidIfUnset(v string) {
+	if _, found := p.p["networkid"]; !found {
+		p.SetNetworkid(v)
+	}
+}
+
 func (p *CreateLoadBalancerRuleParams) SetOpenfirewall(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1708,6 +2973,13 @@ func (p *CreateLoadBalancerRuleParams) SetOpenfirewall(v bool) {
 	return
 }
 
+// SetOpenfirewallIfUnset sets openfirewall to v, unless it is already set
+func (p *CreateLoadBalancerRuleParams) SetOpenfirewallIfUnset(v bool) {
+	if _, found := p.p["openfirewall"]; !found {
+		p.SetOpenfirewall(v)
+	}
+}
+
 func (p *CreateLoadBalancerRuleParams) SetPrivateport(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1716,6 +2988,13 @@ func (p *CreateLoadBalancerRuleParams) SetPrivateport(v int) {
 	return
 }
 
+// SetPrivateportIfUnset sets privateport to v, unless it is already set
+func (p *CreateLoadBalancerRuleParams) SetPrivateportIfUnset(v int) {
+	if _, found := p.p["privateport"]; !found {
+		p.SetPrivateport(v)
+	}
+}
+
 func (p *CreateLoadBalancerRuleParams) SetProtocol(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1724,6 +3003,13 @@ func (p *CreateLoadBalancerRuleParams) SetProtocol(v string) {
 	return
 }
 
+// SetProtocolIfUnset sets protocol to v, unless it is already set
+func (p *CreateLoadBalancerRuleParams) SetProtocolIfUnset(v string) {
+	if _, found := p.p["protocol"]; !found {
+		p.SetProtocol(v)
+	}
+}
+
 func (p *CreateLoadBalancerRuleParams) SetPublicipid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1732,6 +3018,13 @@ func (p *CreateLoadBalancerRuleParams) SetPublicipid(v string) {
 	return
 }
 
+// SetPublicipidIfUnset sets publicipid to v, unless it is already set
+func (p *CreateLoadBalancerRuleParams) SetPublicipidIfUnset(v string) {
+	if _, found := p.p["publicipid"]; !found {
+		p.SetPublicipid(v)
+	}
+}
+
 func (p *CreateLoadBalancerRuleParams) SetPublicport(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1740,6 +3033,13 @@ func (p *CreateLoadBalancerRuleParams) SetPublicport(v int) {
 	return
 }
 
+// SetPublicportIfUnset sets publicport to v, unless it is already set
+func (p *CreateLoadBalancerRuleParams) SetPublicportIfUnset(v int) {
+	if _, found := p.p["publicport"]; !found {
+		p.SetPublicport(v)
+	}
+}
+
 func (p *CreateLoadBalancerRuleParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1748,21 +3048,113 @@ func (p *CreateLoadBalancerRuleParams) SetZoneid(v string) {
 	return
 }
 
+// SetZoneidIfUnset sets zoneid to v, unless it is already set
+func (p *CreateLoadBalancerRuleParams) SetZoneidIfUnset(v string) {
+	if _, found := p.p["zoneid"]; !found {
+		p.SetZoneid(v)
+	}
+}
+
 // You should always use this function to get a new CreateLoadBalancerRuleParams instance,
-// as then you are sure you have configured all required params
-func (s *LoadBalancerService) NewCreateLoadBalancerRuleParams(algorithm string, name string, privateport int, publicport int) *CreateLoadBalancerRuleParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *LoadBalancerService) NewCreateLoadBalancerRuleParams(algorithm string, name string, privateport int, publicport int, opts ...func(*CreateLoadBalancerRuleParams)) *CreateLoadBalancerRuleParams {
 	p := &CreateLoadBalancerRuleParams{}
 	p.p = make(map[string]interface{})
 	p.p["algorithm"] = algorithm
 	p.p["name"] = name
 	p.p["privateport"] = privateport
 	p.p["publicport"] = publicport
+	for _, fn := range opts {
+		fn(p)
+	}
+	return p
+}
+
+// CreateLoadBalancerRuleOpts contains all params that can be set on a CreateLoadBalancerRuleParams.
+// Optional params are pointers (or slices and maps) which are only set when not nil.
+type CreateLoadBalancerRuleOpts struct {
+	Account      *string
+	Algorithm    string
+	Cidrlist     []string
+	Description  *string
+	Domainid     *string
+	Fordisplay   *bool
+	Name         string
+	Networkid    *string
+	Openfirewall *bool
+	Privateport  int
+	Protocol     *string
+	Publicipid   *string
+	Publicport   int
+	Zoneid       *string
+}
+
+// NewCreateLoadBalancerRuleParamsFromOpts is an alternative for NewCreateLoadBalancerRuleParams which takes all params using
+// an options struct, as that is easier to read for APIs with many required params
+func (s *LoadBalancerService) NewCreateLoadBalancerRuleParamsFromOpts(o CreateLoadBalancerRuleOpts) *CreateLoadBalancerRuleParams {
+	p := s.NewCreateLoadBalancerRuleParams(o.Algorithm, o.Name, o.Privateport, o.Publicport)
+	if o.Account != nil {
+		p.p["account"] = *o.Account
+	}
+	if o.Cidrlist != nil {
+		p.p["cidrlist"] = o.Cidrlist
+	}
+	if o.Description != nil {
+		p.p["description"] = *o.Description
+	}
+	if o.Domainid != nil {
+		p.p["domainid"] = *o.Domainid
+	}
+	if o.Fordisplay != nil {
+		p.p["fordisplay"] = *o.Fordisplay
+	}
+	if o.Networkid != nil {
+		p.p["networkid"] = *o.Networkid
+	}
+	if o.Openfirewall != nil {
+		p.p["openfirewall"] = *o.Openfirewall
+	}
+	if o.Protocol != nil {
+		p.p["protocol"] = *o.Protocol
+	}
+	if o.Publicipid != nil {
+		p.p["publicipid"] = *o.Publicipid
+	}
+	if o.Zoneid != nil {
+		p.p["zoneid"] = *o.Zoneid
+	}
 	return p
 }
 
 // Creates a load balancer rule
-func (s *LoadBalancerService) CreateLoadBalancerRule(p *CreateLoadBalancerRuleParams) (*CreateLoadBalancerRuleResponse, error) {
-	resp, err := s.cs.newRequest("createLoadBalancerRule", p.toURLValues())
+func (s *LoadBalancerService) CreateLoadBalancerRule(p *CreateLoadBalancerRuleParams, opts ...OptionFunc) (*CreateLoadBalancerRuleResponse, error) {
+	return s.CreateLoadBalancerRuleWithContext(context.Background(), p, opts...)
+}
+
+// CreateLoadBalancerRuleWithContext is the same as CreateLoadBalancerRule, but the request is cancelled when the context is done
+func (s *LoadBalancerService) CreateLoadBalancerRuleWithContext(ctx context.Context, p *CreateLoadBalancerRuleParams, opts ...OptionFunc) (*CreateLoadBalancerRuleResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.CreateLoadBalancerRuleRawWithContext(ctx, p.toURLValues())
+}
+
+// CreateLoadBalancerRuleRaw is the same as CreateLoadBalancerRule, but takes the params as url.Values instead of typed params
+func (s *LoadBalancerService) CreateLoadBalancerRuleRaw(v url.Values) (*CreateLoadBalancerRuleResponse, error) {
+	return s.CreateLoadBalancerRuleRawWithContext(context.Background(), v)
+}
+
+// CreateLoadBalancerRuleRawWithContext is the same as CreateLoadBalancerRuleRaw, but the request is cancelled when the context is done
+func (s *LoadBalancerService) CreateLoadBalancerRuleRawWithContext(ctx context.Context, v url.Values) (*CreateLoadBalancerRuleResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "createLoadBalancerRule", "createloadbalancerruleresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -1774,9 +3166,9 @@ func (s *LoadBalancerService) CreateLoadBalancerRule(p *CreateLoadBalancerRulePa
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -1834,6 +3226,16 @@ type DeleteF5LoadBalancerParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *DeleteF5LoadBalancerParams) Clone() *DeleteF5LoadBalancerParams {
+	c := &DeleteF5LoadBalancerParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeleteF5LoadBalancerParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1845,6 +3247,16 @@ func (p *DeleteF5LoadBalancerParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *DeleteF5LoadBalancerParams) validate() error {
+	for _, k := range []string{"lbdeviceid"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of deleteF5LoadBalancer", k)
+		}
+	}
+	return nil
+}
+
 func (p *DeleteF5LoadBalancerParams) SetLbdeviceid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1853,18 +3265,54 @@ func (p *DeleteF5LoadBalancerParams) SetLbdeviceid(v string) {
 	return
 }
 
+// SetLbdeviceidIfUnset sets lbdeviceid to v, unless it is already set
+func (p *DeleteF5LoadBalancerParams) SetLbdeviceidIfUnset(v string) {
+	if _, found := p.p["lbdeviceid"]; !found {
+		p.SetLbdeviceid(v)
+	}
+}
+
 // You should always use this function to get a new DeleteF5LoadBalancerParams instance,
-// as then you are sure you have configured all required params
-func (s *LoadBalancerService) NewDeleteF5LoadBalancerParams(lbdeviceid string) *DeleteF5LoadBalancerParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *LoadBalancerService) NewDeleteF5LoadBalancerParams(lbdeviceid string, opts ...func(*DeleteF5LoadBalancerParams)) *DeleteF5LoadBalancerParams {
 	p := &DeleteF5LoadBalancerParams{}
 	p.p = make(map[string]interface{})
 	p.p["lbdeviceid"] = lbdeviceid
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
-//  delete a F5 load balancer device
-func (s *LoadBalancerService) DeleteF5LoadBalancer(p *DeleteF5LoadBalancerParams) (*DeleteF5LoadBalancerResponse, error) {
-	resp, err := s.cs.newRequest("deleteF5LoadBalancer", p.toURLValues())
+// delete a F5 load balancer device
+func (s *LoadBalancerService) DeleteF5LoadBalancer(p *DeleteF5LoadBalancerParams, opts ...OptionFunc) (*DeleteF5LoadBalancerResponse, error) {
+	return s.DeleteF5LoadBalancerWithContext(context.Background(), p, opts...)
+}
+
+// DeleteF5LoadBalancerWithContext is the same as DeleteF5LoadBalancer, but the request is cancelled when the context is done
+func (s *LoadBalancerService) DeleteF5LoadBalancerWithContext(ctx context.Context, p *DeleteF5LoadBalancerParams, opts ...OptionFunc) (*DeleteF5LoadBalancerResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.DeleteF5LoadBalancerRawWithContext(ctx, p.toURLValues())
+}
+
+// DeleteF5LoadBalancerRaw is the same as DeleteF5LoadBalancer, but takes the params as url.Values instead of typed params
+func (s *LoadBalancerService) DeleteF5LoadBalancerRaw(v url.Values) (*DeleteF5LoadBalancerResponse, error) {
+	return s.DeleteF5LoadBalancerRawWithContext(context.Background(), v)
+}
+
+// DeleteF5LoadBalancerRawWithContext is the same as DeleteF5LoadBalancerRaw, but the request is cancelled when the context is done
+func (s *LoadBalancerService) DeleteF5LoadBalancerRawWithContext(ctx context.Context, v url.Values) (*DeleteF5LoadBalancerResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteF5LoadBalancer", "deletef5loadbalancerresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -1876,9 +3324,9 @@ func (s *LoadBalancerService) DeleteF5LoadBalancer(p *DeleteF5LoadBalancerParams
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -1902,6 +3350,16 @@ type DeleteGlobalLoadBalancerRuleParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *DeleteGlobalLoadBalancerRuleParams) Clone() *DeleteGlobalLoadBalancerRuleParams {
+	c := &DeleteGlobalLoadBalancerRuleParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeleteGlobalLoadBalancerRuleParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1913,6 +3371,16 @@ func (p *DeleteGlobalLoadBalancerRuleParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *DeleteGlobalLoadBalancerRuleParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of deleteGlobalLoadBalancerRule", k)
+		}
+	}
+	return nil
+}
+
 func (p *DeleteGlobalLoadBalancerRuleParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1921,18 +3389,54 @@ func (p *DeleteGlobalLoadBalancerRuleParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *DeleteGlobalLoadBalancerRuleParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 // You should always use this function to get a new DeleteGlobalLoadBalancerRuleParams instance,
-// as then you are sure you have configured all required params
-func (s *LoadBalancerService) NewDeleteGlobalLoadBalancerRuleParams(id string) *DeleteGlobalLoadBalancerRuleParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *LoadBalancerService) NewDeleteGlobalLoadBalancerRuleParams(id string, opts ...func(*DeleteGlobalLoadBalancerRuleParams)) *DeleteGlobalLoadBalancerRuleParams {
 	p := &DeleteGlobalLoadBalancerRuleParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Deletes a global load balancer rule.
-func (s *LoadBalancerService) DeleteGlobalLoadBalancerRule(p *DeleteGlobalLoadBalancerRuleParams) (*DeleteGlobalLoadBalancerRuleResponse, error) {
-	resp, err := s.cs.newRequest("deleteGlobalLoadBalancerRule", p.toURLValues())
+func (s *LoadBalancerService) DeleteGlobalLoadBalancerRule(p *DeleteGlobalLoadBalancerRuleParams, opts ...OptionFunc) (*DeleteGlobalLoadBalancerRuleResponse, error) {
+	return s.DeleteGlobalLoadBalancerRuleWithContext(context.Background(), p, opts...)
+}
+
+// DeleteGlobalLoadBalancerRuleWithContext is the same as DeleteGlobalLoadBalancerRule, but the request is cancelled when the context is done
+func (s *LoadBalancerService) DeleteGlobalLoadBalancerRuleWithContext(ctx context.Context, p *DeleteGlobalLoadBalancerRuleParams, opts ...OptionFunc) (*DeleteGlobalLoadBalancerRuleResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.DeleteGlobalLoadBalancerRuleRawWithContext(ctx, p.toURLValues())
+}
+
+// DeleteGlobalLoadBalancerRuleRaw is the same as DeleteGlobalLoadBalancerRule, but takes the params as url.Values instead of typed params
+func (s *LoadBalancerService) DeleteGlobalLoadBalancerRuleRaw(v url.Values) (*DeleteGlobalLoadBalancerRuleResponse, error) {
+	return s.DeleteGlobalLoadBalancerRuleRawWithContext(context.Background(), v)
+}
+
+// DeleteGlobalLoadBalancerRuleRawWithContext is the same as DeleteGlobalLoadBalancerRuleRaw, but the request is cancelled when the context is done
+func (s *LoadBalancerService) DeleteGlobalLoadBalancerRuleRawWithContext(ctx context.Context, v url.Values) (*DeleteGlobalLoadBalancerRuleResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteGlobalLoadBalancerRule", "deletegloballoadbalancerruleresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -1944,9 +3448,9 @@ func (s *LoadBalancerService) DeleteGlobalLoadBalancerRule(p *DeleteGlobalLoadBa
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -1960,6 +3464,34 @@ func (s *LoadBalancerService) DeleteGlobalLoadBalancerRule(p *DeleteGlobalLoadBa
 	return &r, nil
 }
 
+// WaitForGlobalLoadBalancerRuleDeleted polls until the GlobalLoadBalancerRule with the given ID no longer exists, the timeout
+// expires or the context is cancelled. Deleted resources may still be listed for a short while
+// after the delete command finished, so this can be used to make sure they are really gone. The
+// options are passed to GetGlobalLoadBalancerRuleByID, so a resource outside of the default scope can be found.
+func (s *LoadBalancerService) WaitForGlobalLoadBalancerRuleDeleted(ctx context.Context, id string, timeout time.Duration, opts ...OptionFunc) error {
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	for {
+		_, count, err := s.GetGlobalLoadBalancerRuleByID(id, opts...)
+		if count == 0 {
+			return nil
+		}
+		if count < 0 {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(2 * time.Second):
+		}
+	}
+}
+
 type DeleteGlobalLoadBalancerRuleResponse struct {
 	JobID       string `json:"jobid"`
 	Displaytext string `json:"displaytext"`
@@ -1970,6 +3502,16 @@ type DeleteLBHealthCheckPolicyParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *DeleteLBHealthCheckPolicyParams) Clone() *DeleteLBHealthCheckPolicyParams {
+	c := &DeleteLBHealthCheckPolicyParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeleteLBHealthCheckPolicyParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1981,6 +3523,16 @@ func (p *DeleteLBHealthCheckPolicyParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *DeleteLBHealthCheckPolicyParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of deleteLBHealthCheckPolicy", k)
+		}
+	}
+	return nil
+}
+
 func (p *DeleteLBHealthCheckPolicyParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1989,18 +3541,54 @@ func (p *DeleteLBHealthCheckPolicyParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *DeleteLBHealthCheckPolicyParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 // You should always use this function to get a new DeleteLBHealthCheckPolicyParams instance,
-// as then you are sure you have configured all required params
-func (s *LoadBalancerService) NewDeleteLBHealthCheckPolicyParams(id string) *DeleteLBHealthCheckPolicyParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *LoadBalancerService) NewDeleteLBHealthCheckPolicyParams(id string, opts ...func(*DeleteLBHealthCheckPolicyParams)) *DeleteLBHealthCheckPolicyParams {
 	p := &DeleteLBHealthCheckPolicyParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Deletes a load balancer health check policy.
-func (s *LoadBalancerService) DeleteLBHealthCheckPolicy(p *DeleteLBHealthCheckPolicyParams) (*DeleteLBHealthCheckPolicyResponse, error) {
-	resp, err := s.cs.newRequest("deleteLBHealthCheckPolicy", p.toURLValues())
+func (s *LoadBalancerService) DeleteLBHealthCheckPolicy(p *DeleteLBHealthCheckPolicyParams, opts ...OptionFunc) (*DeleteLBHealthCheckPolicyResponse, error) {
+	return s.DeleteLBHealthCheckPolicyWithContext(context.Background(), p, opts...)
+}
+
+// DeleteLBHealthCheckPolicyWithContext is the same as DeleteLBHealthCheckPolicy, but the request is cancelled when the context is done
+func (s *LoadBalancerService) DeleteLBHealthCheckPolicyWithContext(ctx context.Context, p *DeleteLBHealthCheckPolicyParams, opts ...OptionFunc) (*DeleteLBHealthCheckPolicyResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.DeleteLBHealthCheckPolicyRawWithContext(ctx, p.toURLValues())
+}
+
+// DeleteLBHealthCheckPolicyRaw is the same as DeleteLBHealthCheckPolicy, but takes the params as url.Values instead of typed params
+func (s *LoadBalancerService) DeleteLBHealthCheckPolicyRaw(v url.Values) (*DeleteLBHealthCheckPolicyResponse, error) {
+	return s.DeleteLBHealthCheckPolicyRawWithContext(context.Background(), v)
+}
+
+// DeleteLBHealthCheckPolicyRawWithContext is the same as DeleteLBHealthCheckPolicyRaw, but the request is cancelled when the context is done
+func (s *LoadBalancerService) DeleteLBHealthCheckPolicyRawWithContext(ctx context.Context, v url.Values) (*DeleteLBHealthCheckPolicyResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteLBHealthCheckPolicy", "deletelbhealthcheckpolicyresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -2012,9 +3600,9 @@ func (s *LoadBalancerService) DeleteLBHealthCheckPolicy(p *DeleteLBHealthCheckPo
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -2028,6 +3616,34 @@ func (s *LoadBalancerService) DeleteLBHealthCheckPolicy(p *DeleteLBHealthCheckPo
 	return &r, nil
 }
 
+// WaitForLBHealthCheckPolicyDeleted polls until the LBHealthCheckPolicy with the given ID no longer exists, the timeout
+// expires or the context is cancelled. Deleted resources may still be listed for a short while
+// after the delete command finished, so this can be used to make sure they are really gone. The
+// options are passed to GetLBHealthCheckPolicyByID, so a resource outside of the default scope can be found.
+func (s *LoadBalancerService) WaitForLBHealthCheckPolicyDeleted(ctx context.Context, id string, timeout time.Duration, opts ...OptionFunc) error {
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	for {
+		_, count, err := s.GetLBHealthCheckPolicyByID(id, opts...)
+		if count == 0 {
+			return nil
+		}
+		if count < 0 {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(2 * time.Second):
+		}
+	}
+}
+
 type DeleteLBHealthCheckPolicyResponse struct {
 	JobID       string `json:"jobid"`
 	Displaytext string `json:"displaytext"`
@@ -2038,6 +3654,16 @@ type DeleteLBStickinessPolicyParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *DeleteLBStickinessPolicyParams) Clone() *DeleteLBStickinessPolicyParams {
+	c := &DeleteLBStickinessPolicyParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeleteLBStickinessPolicyParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -2049,6 +3675,16 @@ func (p *DeleteLBStickinessPolicyParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *DeleteLBStickinessPolicyParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of deleteLBStickinessPolicy", k)
+		}
+	}
+	return nil
+}
+
 func (p *DeleteLBStickinessPolicyParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2057,18 +3693,54 @@ func (p *DeleteLBStickinessPolicyParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *DeleteLBStickinessPolicyParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 // You should always use this function to get a new DeleteLBStickinessPolicyParams instance,
-// as then you are sure you have configured all required params
-func (s *LoadBalancerService) NewDeleteLBStickinessPolicyParams(id string) *DeleteLBStickinessPolicyParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *LoadBalancerService) NewDeleteLBStickinessPolicyParams(id string, opts ...func(*DeleteLBStickinessPolicyParams)) *DeleteLBStickinessPolicyParams {
 	p := &DeleteLBStickinessPolicyParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Deletes a load balancer stickiness policy.
-func (s *LoadBalancerService) DeleteLBStickinessPolicy(p *DeleteLBStickinessPolicyParams) (*DeleteLBStickinessPolicyResponse, error) {
-	resp, err := s.cs.newRequest("deleteLBStickinessPolicy", p.toURLValues())
+func (s *LoadBalancerService) DeleteLBStickinessPolicy(p *DeleteLBStickinessPolicyParams, opts ...OptionFunc) (*DeleteLBStickinessPolicyResponse, error) {
+	return s.DeleteLBStickinessPolicyWithContext(context.Background(), p, opts...)
+}
+
+// DeleteLBStickinessPolicyWithContext is the same as DeleteLBStickinessPolicy, but the request is cancelled when the context is done
+func (s *LoadBalancerService) DeleteLBStickinessPolicyWithContext(ctx context.Context, p *DeleteLBStickinessPolicyParams, opts ...OptionFunc) (*DeleteLBStickinessPolicyResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.DeleteLBStickinessPolicyRawWithContext(ctx, p.toURLValues())
+}
+
+// DeleteLBStickinessPolicyRaw is the same as DeleteLBStickinessPolicy, but takes the params as url.Values instead of typed params
+func (s *LoadBalancerService) DeleteLBStickinessPolicyRaw(v url.Values) (*DeleteLBStickinessPolicyResponse, error) {
+	return s.DeleteLBStickinessPolicyRawWithContext(context.Background(), v)
+}
+
+// DeleteLBStickinessPolicyRawWithContext is the same as DeleteLBStickinessPolicyRaw, but the request is cancelled when the context is done
+func (s *LoadBalancerService) DeleteLBStickinessPolicyRawWithContext(ctx context.Context, v url.Values) (*DeleteLBStickinessPolicyResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteLBStickinessPolicy", "deletelbstickinesspolicyresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -2080,9 +3752,9 @@ func (s *LoadBalancerService) DeleteLBStickinessPolicy(p *DeleteLBStickinessPoli
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -2096,6 +3768,34 @@ func (s *LoadBalancerService) DeleteLBStickinessPolicy(p *DeleteLBStickinessPoli
 	return &r, nil
 }
 
+// WaitForLBStickinessPolicyDeleted polls until the LBStickinessPolicy with the given ID no longer exists, the timeout
+// expires or the context is cancelled. Deleted resources may still be listed for a short while
+// after the delete command finished, so this can be used to make sure they are really gone. The
+// options are passed to GetLBStickinessPolicyByID, so a resource outside of the default scope can be found.
+func (s *LoadBalancerService) WaitForLBStickinessPolicyDeleted(ctx context.Context, id string, timeout time.Duration, opts ...OptionFunc) error {
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	for {
+		_, count, err := s.GetLBStickinessPolicyByID(id, opts...)
+		if count == 0 {
+			return nil
+		}
+		if count < 0 {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(2 * time.Second):
+		}
+	}
+}
+
 type DeleteLBStickinessPolicyResponse struct {
 	JobID       string `json:"jobid"`
 	Displaytext string `json:"displaytext"`
@@ -2106,6 +3806,16 @@ type DeleteLoadBalancerParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *DeleteLoadBalancerParams) Clone() *DeleteLoadBalancerParams {
+	c := &DeleteLoadBalancerParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeleteLoadBalancerParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -2117,6 +3827,16 @@ func (p *DeleteLoadBalancerParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *DeleteLoadBalancerParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of deleteLoadBalancer", k)
+		}
+	}
+	return nil
+}
+
 func (p *DeleteLoadBalancerParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2125,18 +3845,54 @@ func (p *DeleteLoadBalancerParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *DeleteLoadBalancerParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 // You should always use this function to get a new DeleteLoadBalancerParams instance,
-// as then you are sure you have configured all required params
-func (s *LoadBalancerService) NewDeleteLoadBalancerParams(id string) *DeleteLoadBalancerParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *LoadBalancerService) NewDeleteLoadBalancerParams(id string, opts ...func(*DeleteLoadBalancerParams)) *DeleteLoadBalancerParams {
 	p := &DeleteLoadBalancerParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Deletes a load balancer
-func (s *LoadBalancerService) DeleteLoadBalancer(p *DeleteLoadBalancerParams) (*DeleteLoadBalancerResponse, error) {
-	resp, err := s.cs.newRequest("deleteLoadBalancer", p.toURLValues())
+func (s *LoadBalancerService) DeleteLoadBalancer(p *DeleteLoadBalancerParams, opts ...OptionFunc) (*DeleteLoadBalancerResponse, error) {
+	return s.DeleteLoadBalancerWithContext(context.Background(), p, opts...)
+}
+
+// DeleteLoadBalancerWithContext is the same as DeleteLoadBalancer, but the request is cancelled when the context is done
+func (s *LoadBalancerService) DeleteLoadBalancerWithContext(ctx context.Context, p *DeleteLoadBalancerParams, opts ...OptionFunc) (*DeleteLoadBalancerResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.DeleteLoadBalancerRawWithContext(ctx, p.toURLValues())
+}
+
+// DeleteLoadBalancerRaw is the same as DeleteLoadBalancer, but takes the params as url.Values instead of typed params
+func (s *LoadBalancerService) DeleteLoadBalancerRaw(v url.Values) (*DeleteLoadBalancerResponse, error) {
+	return s.DeleteLoadBalancerRawWithContext(context.Background(), v)
+}
+
+// DeleteLoadBalancerRawWithContext is the same as DeleteLoadBalancerRaw, but the request is cancelled when the context is done
+func (s *LoadBalancerService) DeleteLoadBalancerRawWithContext(ctx context.Context, v url.Values) (*DeleteLoadBalancerResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteLoadBalancer", "deleteloadbalancerresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -2148,9 +3904,9 @@ func (s *LoadBalancerService) DeleteLoadBalancer(p *DeleteLoadBalancerParams) (*
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -2164,6 +3920,34 @@ func (s *LoadBalancerService) DeleteLoadBalancer(p *DeleteLoadBalancerParams) (*
 	return &r, nil
 }
 
+// WaitForLoadBalancerDeleted polls until the LoadBalancer with the given ID no longer exists, the timeout
+// expires or the context is cancelled. Deleted resources may still be listed for a short while
+// after the delete command finished, so this can be used to make sure they are really gone. The
+// options are passed to GetLoadBalancerByID, so a resource outside of the default scope can be found.
+func (s *LoadBalancerService) WaitForLoadBalancerDeleted(ctx context.Context, id string, timeout time.Duration, opts ...OptionFunc) error {
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	for {
+		_, count, err := s.GetLoadBalancerByID(id, opts...)
+		if count == 0 {
+			return nil
+		}
+		if count < 0 {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(2 * time.Second):
+		}
+	}
+}
+
 type DeleteLoadBalancerResponse struct {
 	JobID       string `json:"jobid"`
 	Displaytext string `json:"displaytext"`
@@ -2174,6 +3958,16 @@ type DeleteLoadBalancerRuleParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *DeleteLoadBalancerRuleParams) Clone() *DeleteLoadBalancerRuleParams {
+	c := &DeleteLoadBalancerRuleParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeleteLoadBalancerRuleParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -2185,6 +3979,16 @@ func (p *DeleteLoadBalancerRuleParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *DeleteLoadBalancerRuleParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of deleteLoadBalancerRule", k)
+		}
+	}
+	return nil
+}
+
 func (p *DeleteLoadBalancerRuleParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2193,18 +3997,54 @@ func (p *DeleteLoadBalancerRuleParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *DeleteLoadBalancerRuleParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 // You should always use this function to get a new DeleteLoadBalancerRuleParams instance,
-// as then you are sure you have configured all required params
-func (s *LoadBalancerService) NewDeleteLoadBalancerRuleParams(id string) *DeleteLoadBalancerRuleParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *LoadBalancerService) NewDeleteLoadBalancerRuleParams(id string, opts ...func(*DeleteLoadBalancerRuleParams)) *DeleteLoadBalancerRuleParams {
 	p := &DeleteLoadBalancerRuleParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Deletes a load balancer rule.
-func (s *LoadBalancerService) DeleteLoadBalancerRule(p *DeleteLoadBalancerRuleParams) (*DeleteLoadBalancerRuleResponse, error) {
-	resp, err := s.cs.newRequest("deleteLoadBalancerRule", p.toURLValues())
+func (s *LoadBalancerService) DeleteLoadBalancerRule(p *DeleteLoadBalancerRuleParams, opts ...OptionFunc) (*DeleteLoadBalancerRuleResponse, error) {
+	return s.DeleteLoadBalancerRuleWithContext(context.Background(), p, opts...)
+}
+
+// DeleteLoadBalancerRuleWithContext is the same as DeleteLoadBalancerRule, but the request is cancelled when the context is done
+func (s *LoadBalancerService) DeleteLoadBalancerRuleWithContext(ctx context.Context, p *DeleteLoadBalancerRuleParams, opts ...OptionFunc) (*DeleteLoadBalancerRuleResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.DeleteLoadBalancerRuleRawWithContext(ctx, p.toURLValues())
+}
+
+// DeleteLoadBalancerRuleRaw is the same as DeleteLoadBalancerRule, but takes the params as url.Values instead of typed params
+func (s *LoadBalancerService) DeleteLoadBalancerRuleRaw(v url.Values) (*DeleteLoadBalancerRuleResponse, error) {
+	return s.DeleteLoadBalancerRuleRawWithContext(context.Background(), v)
+}
+
+// DeleteLoadBalancerRuleRawWithContext is the same as DeleteLoadBalancerRuleRaw, but the request is cancelled when the context is done
+func (s *LoadBalancerService) DeleteLoadBalancerRuleRawWithContext(ctx context.Context, v url.Values) (*DeleteLoadBalancerRuleResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteLoadBalancerRule", "deleteloadbalancerruleresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -2216,9 +4056,9 @@ func (s *LoadBalancerService) DeleteLoadBalancerRule(p *DeleteLoadBalancerRulePa
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -2232,6 +4072,34 @@ func (s *LoadBalancerService) DeleteLoadBalancerRule(p *DeleteLoadBalancerRulePa
 	return &r, nil
 }
 
+// WaitForLoadBalancerRuleDeleted polls until the LoadBalancerRule with the given ID no longer exists, the timeout
+// expires or the context is cancelled. Deleted resources may still be listed for a short while
+// after the delete command finished, so this can be used to make sure they are really gone. The
+// options are passed to GetLoadBalancerRuleByID, so a resource outside of the default scope can be found.
+func (s *LoadBalancerService) WaitForLoadBalancerRuleDeleted(ctx context.Context, id string, timeout time.Duration, opts ...OptionFunc) error {
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	for {
+		_, count, err := s.GetLoadBalancerRuleByID(id, opts...)
+		if count == 0 {
+			return nil
+		}
+		if count < 0 {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(2 * time.Second):
+		}
+	}
+}
+
 type DeleteLoadBalancerRuleResponse struct {
 	JobID       string `json:"jobid"`
 	Displaytext string `json:"displaytext"`
@@ -2242,6 +4110,16 @@ type DeleteNetscalerLoadBalancerParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *DeleteNetscalerLoadBalancerParams) Clone() *DeleteNetscalerLoadBalancerParams {
+	c := &DeleteNetscalerLoadBalancerParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeleteNetscalerLoadBalancerParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -2253,6 +4131,16 @@ func (p *DeleteNetscalerLoadBalancerParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *DeleteNetscalerLoadBalancerParams) validate() error {
+	for _, k := range []string{"lbdeviceid"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of deleteNetscalerLoadBalancer", k)
+		}
+	}
+	return nil
+}
+
 func (p *DeleteNetscalerLoadBalancerParams) SetLbdeviceid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2261,18 +4149,54 @@ func (p *DeleteNetscalerLoadBalancerParams) SetLbdeviceid(v string) {
 	return
 }
 
+// SetLbdeviceidIfUnset sets lbdeviceid to v, unless it is already set
+func (p *DeleteNetscalerLoadBalancerParams) SetLbdeviceidIfUnset(v string) {
+	if _, found := p.p["lbdeviceid"]; !found {
+		p.SetLbdeviceid(v)
+	}
+}
+
 // You should always use this function to get a new DeleteNetscalerLoadBalancerParams instance,
-// as then you are sure you have configured all required params
-func (s *LoadBalancerService) NewDeleteNetscalerLoadBalancerParams(lbdeviceid string) *DeleteNetscalerLoadBalancerParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *LoadBalancerService) NewDeleteNetscalerLoadBalancerParams(lbdeviceid string, opts ...func(*DeleteNetscalerLoadBalancerParams)) *DeleteNetscalerLoadBalancerParams {
 	p := &DeleteNetscalerLoadBalancerParams{}
 	p.p = make(map[string]interface{})
 	p.p["lbdeviceid"] = lbdeviceid
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
-//  delete a netscaler load balancer device
-func (s *LoadBalancerService) DeleteNetscalerLoadBalancer(p *DeleteNetscalerLoadBalancerParams) (*DeleteNetscalerLoadBalancerResponse, error) {
-	resp, err := s.cs.newRequest("deleteNetscalerLoadBalancer", p.toURLValues())
+// delete a netscaler load balancer device
+func (s *LoadBalancerService) DeleteNetscalerLoadBalancer(p *DeleteNetscalerLoadBalancerParams, opts ...OptionFunc) (*DeleteNetscalerLoadBalancerResponse, error) {
+	return s.DeleteNetscalerLoadBalancerWithContext(context.Background(), p, opts...)
+}
+
+// DeleteNetscalerLoadBalancerWithContext is the same as DeleteNetscalerLoadBalancer, but the request is cancelled when the context is done
+func (s *LoadBalancerService) DeleteNetscalerLoadBalancerWithContext(ctx context.Context, p *DeleteNetscalerLoadBalancerParams, opts ...OptionFunc) (*DeleteNetscalerLoadBalancerResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.DeleteNetscalerLoadBalancerRawWithContext(ctx, p.toURLValues())
+}
+
+// DeleteNetscalerLoadBalancerRaw is the same as DeleteNetscalerLoadBalancer, but takes the params as url.Values instead of typed params
+func (s *LoadBalancerService) DeleteNetscalerLoadBalancerRaw(v url.Values) (*DeleteNetscalerLoadBalancerResponse, error) {
+	return s.DeleteNetscalerLoadBalancerRawWithContext(context.Background(), v)
+}
+
+// DeleteNetscalerLoadBalancerRawWithContext is the same as DeleteNetscalerLoadBalancerRaw, but the request is cancelled when the context is done
+func (s *LoadBalancerService) DeleteNetscalerLoadBalancerRawWithContext(ctx context.Context, v url.Values) (*DeleteNetscalerLoadBalancerResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteNetscalerLoadBalancer", "deletenetscalerloadbalancerresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -2284,9 +4208,9 @@ func (s *LoadBalancerService) DeleteNetscalerLoadBalancer(p *DeleteNetscalerLoad
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -2310,6 +4234,16 @@ type DeleteSslCertParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *DeleteSslCertParams) Clone() *DeleteSslCertParams {
+	c := &DeleteSslCertParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeleteSslCertParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -2321,6 +4255,16 @@ func (p *DeleteSslCertParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *DeleteSslCertParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of deleteSslCert", k)
+		}
+	}
+	return nil
+}
+
 func (p *DeleteSslCertParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2329,18 +4273,54 @@ func (p *DeleteSslCertParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *DeleteSslCertParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 // You should always use this function to get a new DeleteSslCertParams instance,
-// as then you are sure you have configured all required params
-func (s *LoadBalancerService) NewDeleteSslCertParams(id string) *DeleteSslCertParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *LoadBalancerService) NewDeleteSslCertParams(id string, opts ...func(*DeleteSslCertParams)) *DeleteSslCertParams {
 	p := &DeleteSslCertParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Delete a certificate to CloudStack
-func (s *LoadBalancerService) DeleteSslCert(p *DeleteSslCertParams) (*DeleteSslCertResponse, error) {
-	resp, err := s.cs.newRequest("deleteSslCert", p.toURLValues())
+func (s *LoadBalancerService) DeleteSslCert(p *DeleteSslCertParams, opts ...OptionFunc) (*DeleteSslCertResponse, error) {
+	return s.DeleteSslCertWithContext(context.Background(), p, opts...)
+}
+
+// DeleteSslCertWithContext is the same as DeleteSslCert, but the request is cancelled when the context is done
+func (s *LoadBalancerService) DeleteSslCertWithContext(ctx context.Context, p *DeleteSslCertParams, opts ...OptionFunc) (*DeleteSslCertResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.DeleteSslCertRawWithContext(ctx, p.toURLValues())
+}
+
+// DeleteSslCertRaw is the same as DeleteSslCert, but takes the params as url.Values instead of typed params
+func (s *LoadBalancerService) DeleteSslCertRaw(v url.Values) (*DeleteSslCertResponse, error) {
+	return s.DeleteSslCertRawWithContext(context.Background(), v)
+}
+
+// DeleteSslCertRawWithContext is the same as DeleteSslCertRaw, but the request is cancelled when the context is done
+func (s *LoadBalancerService) DeleteSslCertRawWithContext(ctx context.Context, v url.Values) (*DeleteSslCertResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteSslCert", "deletesslcertresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -2381,6 +4361,16 @@ type ListF5LoadBalancersParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ListF5LoadBalancersParams) Clone() *ListF5LoadBalancersParams {
+	c := &ListF5LoadBalancersParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListF5LoadBalancersParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -2414,6 +4404,13 @@ func (p *ListF5LoadBalancersParams) SetKeyword(v string) {
 	return
 }
 
+// SetKeywordIfUnset sets keyword to v, unless it is already set
+func (p *ListF5LoadBalancersParams) SetKeywordIfUnset(v string) {
+	if _, found := p.p["keyword"]; !found {
+		p.SetKeyword(v)
+	}
+}
+
 func (p *ListF5LoadBalancersParams) SetLbdeviceid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2422,6 +4419,13 @@ func (p *ListF5LoadBalancersParams) SetLbdeviceid(v string) {
 	return
 }
 
+// SetLbdeviceidIfUnset sets lbdeviceid to v, unless it is already set
+func (p *ListF5LoadBalancersParams) SetLbdeviceidIfUnset(v string) {
+	if _, found := p.p["lbdeviceid"]; !found {
+		p.SetLbdeviceid(v)
+	}
+}
+
 func (p *ListF5LoadBalancersParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2430,6 +4434,13 @@ func (p *ListF5LoadBalancersParams) SetPage(v int) {
 	return
 }
 
+// SetPageIfUnset sets page to v, unless it is already set
+func (p *ListF5LoadBalancersParams) SetPageIfUnset(v int) {
+	if _, found := p.p["page"]; !found {
+		p.SetPage(v)
+	}
+}
+
 func (p *ListF5LoadBalancersParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2438,6 +4449,13 @@ func (p *ListF5LoadBalancersParams) SetPagesize(v int) {
 	return
 }
 
+// SetPagesizeIfUnset sets pagesize to v, unless it is already set
+func (p *ListF5LoadBalancersParams) SetPagesizeIfUnset(v int) {
+	if _, found := p.p["pagesize"]; !found {
+		p.SetPagesize(v)
+	}
+}
+
 func (p *ListF5LoadBalancersParams) SetPhysicalnetworkid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2446,17 +4464,43 @@ func (p *ListF5LoadBalancersParams) SetPhysicalnetworkid(v string) {
 	return
 }
 
+// SetPhysicalnetworkidIfUnset sets physicalnetworkid to v, unless it is already set
+func (p *ListF5LoadBalancersParams) SetPhysicalnetworkidIfUnset(v string) {
+	if _, found := p.p["physicalnetworkid"]; !found {
+		p.SetPhysicalnetworkid(v)
+	}
+}
+
 // You should always use this function to get a new ListF5LoadBalancersParams instance,
-// as then you are sure you have configured all required params
-func (s *LoadBalancerService) NewListF5LoadBalancersParams() *ListF5LoadBalancersParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *LoadBalancerService) NewListF5LoadBalancersParams(opts ...func(*ListF5LoadBalancersParams)) *ListF5LoadBalancersParams {
 	p := &ListF5LoadBalancersParams{}
 	p.p = make(map[string]interface{})
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // lists F5 load balancer devices
 func (s *LoadBalancerService) ListF5LoadBalancers(p *ListF5LoadBalancersParams) (*ListF5LoadBalancersResponse, error) {
-	resp, err := s.cs.newRequest("listF5LoadBalancers", p.toURLValues())
+	return s.ListF5LoadBalancersWithContext(context.Background(), p)
+}
+
+// ListF5LoadBalancersWithContext is the same as ListF5LoadBalancers, but the request is cancelled when the context is done
+func (s *LoadBalancerService) ListF5LoadBalancersWithContext(ctx context.Context, p *ListF5LoadBalancersParams) (*ListF5LoadBalancersResponse, error) {
+	return s.ListF5LoadBalancersRawWithContext(ctx, p.toURLValues())
+}
+
+// ListF5LoadBalancersRaw is the same as ListF5LoadBalancers, but takes the params as url.Values instead of typed params
+func (s *LoadBalancerService) ListF5LoadBalancersRaw(v url.Values) (*ListF5LoadBalancersResponse, error) {
+	return s.ListF5LoadBalancersRawWithContext(context.Background(), v)
+}
+
+// ListF5LoadBalancersRawWithContext is the same as ListF5LoadBalancersRaw, but the request is cancelled when the context is done
+func (s *LoadBalancerService) ListF5LoadBalancersRawWithContext(ctx context.Context, v url.Values) (*ListF5LoadBalancersResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "listF5LoadBalancers", "listf5loadbalancersresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -2469,6 +4513,93 @@ func (s *LoadBalancerService) ListF5LoadBalancers(p *ListF5LoadBalancersParams)
 	return &r, nil
 }
 
+// StreamF5LoadBalancers fetches the results of ListF5LoadBalancers page by page in the background and sends them on the
+// returned channel. Both returned channels are closed when done. If fetching a page fails, or the context
+// is done before all results are sent, the error is sent on the error channel and fetching stops. A
+// request for a page in flight is cancelled when the context is done. The page and pagesize set in the
+// params are ignored.
+func (s *LoadBalancerService) StreamF5LoadBalancers(ctx context.Context, p *ListF5LoadBalancersParams) (<-chan *F5LoadBalancer, <-chan error) {
+	ch := make(chan *F5LoadBalancer)
+	errs := make(chan error, 1)
+
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesize(500)
+
+	go func() {
+		defer close(errs)
+		defer close(ch)
+
+		for page, count := 1, 0; ; page++ {
+			pp.SetPage(page)
+
+			l, err := s.ListF5LoadBalancersWithContext(ctx, pp)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			for _, v := range l.F5LoadBalancers {
+				select {
+				case ch <- v:
+				case <-ctx.Done():
+					errs <- ctx.Err()
+					return
+				}
+			}
+
+			count += len(l.F5LoadBalancers)
+			if len(l.F5LoadBalancers) < 500 || count >= l.Count {
+				return
+			}
+		}
+	}()
+
+	return ch, errs
+}
+
+// ListF5LoadBalancersWhere returns all results of ListF5LoadBalancers for which pred returns true. Note that the results are
+// filtered client-side, so all results matching the params are fetched first.
+// All pages are fetched, so the page and pagesize set in the params are ignored.
+func (s *LoadBalancerService) ListF5LoadBalancersWhere(p *ListF5LoadBalancersParams, pred func(*F5LoadBalancer) bool) ([]*F5LoadBalancer, error) {
+	var r []*F5LoadBalancer
+	ch, errs := s.StreamF5LoadBalancers(context.Background(), p)
+	for v := range ch {
+		if pred(v) {
+			r = append(r, v)
+		}
+	}
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ListAllF5LoadBalancers returns the results of ListF5LoadBalancers across all pages, by calling ListF5LoadBalancers page by page until all
+// results are fetched. The pagesize set in the params is used for every page (500 if not set), and the
+// page set in the params is ignored.
+func (s *LoadBalancerService) ListAllF5LoadBalancers(p *ListF5LoadBalancersParams) ([]*F5LoadBalancer, error) {
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesizeIfUnset(500)
+	pagesize := pp.p["pagesize"].(int)
+
+	var r []*F5LoadBalancer
+	for page := 1; ; page++ {
+		pp.SetPage(page)
+
+		l, err := s.ListF5LoadBalancers(pp)
+		if err != nil {
+			return nil, err
+		}
+		r = append(r, l.F5LoadBalancers...)
+
+		if len(l.F5LoadBalancers) < pagesize || len(r) >= l.Count {
+			return r, nil
+		}
+	}
+}
+
 type ListF5LoadBalancersResponse struct {
 	Count           int               `json:"count"`
 	F5LoadBalancers []*F5LoadBalancer `json:"f5loadbalancer"`
@@ -2491,6 +4622,16 @@ type ListGlobalLoadBalancerRulesParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ListGlobalLoadBalancerRulesParams) Clone() *ListGlobalLoadBalancerRulesParams {
+	c := &ListGlobalLoadBalancerRulesParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListGlobalLoadBalancerRulesParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -2532,11 +4673,10 @@ func (p *ListGlobalLoadBalancerRulesParams) toURLValues() url.Values {
 		u.Set("regionid", vv)
 	}
 	if v, found := p.p["tags"]; found {
-		i := 0
-		for k, vv := range v.(map[string]string) {
+		m := v.(map[string]string)
+		for i, k := range getSortedKeysFromMap(m) {
 			u.Set(fmt.Sprintf("tags[%d].key", i), k)
-			u.Set(fmt.Sprintf("tags[%d].value", i), vv)
-			i++
+			u.Set(fmt.Sprintf("tags[%d].value", i), m[k])
 		}
 	}
 	return u
@@ -2550,6 +4690,13 @@ func (p *ListGlobalLoadBalancerRulesParams) SetAccount(v string) {
 	return
 }
 
+// SetAccountIfUnset sets account to v, unless it is already set
+func (p *ListGlobalLoadBalancerRulesParams) SetAccountIfUnset(v string) {
+	if _, found := p.p["account"]; !found {
+		p.SetAccount(v)
+	}
+}
+
 func (p *ListGlobalLoadBalancerRulesParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2558,6 +4705,13 @@ func (p *ListGlobalLoadBalancerRulesParams) SetDomainid(v string) {
 	return
 }
 
+// SetDomainidIfUnset sets domainid to v, unless it is already set
+func (p *ListGlobalLoadBalancerRulesParams) SetDomainidIfUnset(v string) {
+	if _, found := p.p["domainid"]; !found {
+		p.SetDomainid(v)
+	}
+}
+
 func (p *ListGlobalLoadBalancerRulesParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2566,6 +4720,13 @@ func (p *ListGlobalLoadBalancerRulesParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *ListGlobalLoadBalancerRulesParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 func (p *ListGlobalLoadBalancerRulesParams) SetIsrecursive(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2574,6 +4735,13 @@ func (p *ListGlobalLoadBalancerRulesParams) SetIsrecursive(v bool) {
 	return
 }
 
+// SetIsrecursiveIfUnset sets isrecursive to v, unless it is already set
+func (p *ListGlobalLoadBalancerRulesParams) SetIsrecursiveIfUnset(v bool) {
+	if _, found := p.p["isrecursive"]; !found {
+		p.SetIsrecursive(v)
+	}
+}
+
 func (p *ListGlobalLoadBalancerRulesParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2582,6 +4750,13 @@ func (p *ListGlobalLoadBalancerRulesParams) SetKeyword(v string) {
 	return
 }
 
+// SetKeywordIfUnset sets keyword to v, unless it is already set
+func (p *ListGlobalLoadBalancerRulesParams) SetKeywordIfUnset(v string) {
+	if _, found := p.p["keyword"]; !found {
+		p.SetKeyword(v)
+	}
+}
+
 func (p *ListGlobalLoadBalancerRulesParams) SetListall(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2590,6 +4765,13 @@ func (p *ListGlobalLoadBalancerRulesParams) SetListall(v bool) {
 	return
 }
 
+// SetListallIfUnset sets listall to v, unless it is already set
+func (p *ListGlobalLoadBalancerRulesParams) SetListallIfUnset(v bool) {
+	if _, found := p.p["listall"]; !found {
+		p.SetListall(v)
+	}
+}
+
 func (p *ListGlobalLoadBalancerRulesParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2598,6 +4780,13 @@ func (p *ListGlobalLoadBalancerRulesParams) SetPage(v int) {
 	return
 }
 
+// SetPageIfUnset sets page to v, unless it is already set
+func (p *ListGlobalLoadBalancerRulesParams) SetPageIfUnset(v int) {
+	if _, found := p.p["page"]; !found {
+		p.SetPage(v)
+	}
+}
+
 func (p *ListGlobalLoadBalancerRulesParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2606,6 +4795,13 @@ func (p *ListGlobalLoadBalancerRulesParams) SetPagesize(v int) {
 	return
 }
 
+// SetPagesizeIfUnset sets pagesize to v, unless it is already set
+func (p *ListGlobalLoadBalancerRulesParams) SetPagesizeIfUnset(v int) {
+	if _, found := p.p["pagesize"]; !found {
+		p.SetPagesize(v)
+	}
+}
+
 func (p *ListGlobalLoadBalancerRulesParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2614,6 +4810,13 @@ func (p *ListGlobalLoadBalancerRulesParams) SetProjectid(v string) {
 	return
 }
 
+// SetProjectidIfUnset sets projectid to v, unless it is already set
+func (p *ListGlobalLoadBalancerRulesParams) SetProjectidIfUnset(v string) {
+	if _, found := p.p["projectid"]; !found {
+		p.SetProjectid(v)
+	}
+}
+
 func (p *ListGlobalLoadBalancerRulesParams) SetRegionid(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2622,6 +4825,13 @@ func (p *ListGlobalLoadBalancerRulesParams) SetRegionid(v int) {
 	return
 }
 
+// SetRegionidIfUnset sets regionid to v, unless it is already set
+func (p *ListGlobalLoadBalancerRulesParams) SetRegionidIfUnset(v int) {
+	if _, found := p.p["regionid"]; !found {
+		p.SetRegionid(v)
+	}
+}
+
 func (p *ListGlobalLoadBalancerRulesParams) SetTags(v map[string]string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2630,14 +4840,34 @@ func (p *ListGlobalLoadBalancerRulesParams) SetTags(v map[string]string) {
 	return
 }
 
+// SetTagsIfUnset sets tags to v, unless it is already set
+func (p *ListGlobalLoadBalancerRulesParams) SetTagsIfUnset(v map[string]string) {
+	if _, found := p.p["tags"]; !found {
+		p.SetTags(v)
+	}
+}
+
+var _ ListAllSetter = (*ListGlobalLoadBalancerRulesParams)(nil)
+
 // You should always use this function to get a new ListGlobalLoadBalancerRulesParams instance,
-// as then you are sure you have configured all required params
-func (s *LoadBalancerService) NewListGlobalLoadBalancerRulesParams() *ListGlobalLoadBalancerRulesParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *LoadBalancerService) NewListGlobalLoadBalancerRulesParams(opts ...func(*ListGlobalLoadBalancerRulesParams)) *ListGlobalLoadBalancerRulesParams {
 	p := &ListGlobalLoadBalancerRulesParams{}
 	p.p = make(map[string]interface{})
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
+func (p *ListGlobalLoadBalancerRulesParams) setFirstMatch() {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p[firstMatchKey] = true
+}
+
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *LoadBalancerService) GetGlobalLoadBalancerRuleID(keyword string, opts ...OptionFunc) (string, int, error) {
 	p := &ListGlobalLoadBalancerRulesParams{}
@@ -2645,7 +4875,7 @@ func (s *LoadBalancerService) GetGlobalLoadBalancerRuleID(keyword string, opts .
 
 	p.p["keyword"] = keyword
 
-	for _, fn := range append(s.cs.options, opts...) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
 		if err := fn(s.cs, p); err != nil {
 			return "", -1, err
 		}
@@ -2657,7 +4887,7 @@ func (s *LoadBalancerService) GetGlobalLoadBalancerRuleID(keyword string, opts .
 	}
 
 	if l.Count == 0 {
-		return "", l.Count, fmt.Errorf("No match found for %s: %+v", keyword, l)
+		return "", l.Count, fmt.Errorf("%w for %s: %+v", ErrNotFound, keyword, l)
 	}
 
 	if l.Count == 1 {
@@ -2670,6 +4900,10 @@ func (s *LoadBalancerService) GetGlobalLoadBalancerRuleID(keyword string, opts .
 				return v.Id, l.Count, nil
 			}
 		}
+
+		if _, ok := p.p[firstMatchKey]; ok && len(l.GlobalLoadBalancerRules) > 0 {
+			return l.GlobalLoadBalancerRules[0].Id, l.Count, nil
+		}
 	}
 	return "", l.Count, fmt.Errorf("Could not find an exact match for %s: %+v", keyword, l)
 }
@@ -2695,7 +4929,7 @@ func (s *LoadBalancerService) GetGlobalLoadBalancerRuleByID(id string, opts ...O
 
 	p.p["id"] = id
 
-	for _, fn := range append(s.cs.options, opts...) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
 		if err := fn(s.cs, p); err != nil {
 			return nil, -1, err
 		}
@@ -2706,24 +4940,56 @@ func (s *LoadBalancerService) GetGlobalLoadBalancerRuleByID(id string, opts ...O
 		if strings.Contains(err.Error(), fmt.Sprintf(
 			"Invalid parameter id value=%s due to incorrect long value format, "+
 				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+			return nil, 0, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
 	}
 
 	if l.Count == 1 {
 		return l.GlobalLoadBalancerRules[0], l.Count, nil
 	}
+
+	if _, ok := p.p[firstMatchKey]; ok && len(l.GlobalLoadBalancerRules) > 0 {
+		return l.GlobalLoadBalancerRules[0], l.Count, nil
+	}
 	return nil, l.Count, fmt.Errorf("There is more then one result for GlobalLoadBalancerRule UUID: %s!", id)
 }
 
+// GlobalLoadBalancerRuleExists returns true if a GlobalLoadBalancerRule with the given ID exists. When no match is found
+// it returns false, while any other error is returned as is.
+func (s *LoadBalancerService) GlobalLoadBalancerRuleExists(id string, opts ...OptionFunc) (bool, error) {
+	_, count, err := s.GetGlobalLoadBalancerRuleByID(id, opts...)
+	if errors.Is(err, ErrNotFound) {
+		return false, nil
+	}
+	if count > 0 {
+		return true, nil
+	}
+	return false, err
+}
+
 // Lists load balancer rules.
 func (s *LoadBalancerService) ListGlobalLoadBalancerRules(p *ListGlobalLoadBalancerRulesParams) (*ListGlobalLoadBalancerRulesResponse, error) {
-	resp, err := s.cs.newRequest("listGlobalLoadBalancerRules", p.toURLValues())
+	return s.ListGlobalLoadBalancerRulesWithContext(context.Background(), p)
+}
+
+// ListGlobalLoadBalancerRulesWithContext is the same as ListGlobalLoadBalancerRules, but the request is cancelled when the context is done
+func (s *LoadBalancerService) ListGlobalLoadBalancerRulesWithContext(ctx context.Context, p *ListGlobalLoadBalancerRulesParams) (*ListGlobalLoadBalancerRulesResponse, error) {
+	return s.ListGlobalLoadBalancerRulesRawWithContext(ctx, p.toURLValues())
+}
+
+// ListGlobalLoadBalancerRulesRaw is the same as ListGlobalLoadBalancerRules, but takes the params as url.Values instead of typed params
+func (s *LoadBalancerService) ListGlobalLoadBalancerRulesRaw(v url.Values) (*ListGlobalLoadBalancerRulesResponse, error) {
+	return s.ListGlobalLoadBalancerRulesRawWithContext(context.Background(), v)
+}
+
+// ListGlobalLoadBalancerRulesRawWithContext is the same as ListGlobalLoadBalancerRulesRaw, but the request is cancelled when the context is done
+func (s *LoadBalancerService) ListGlobalLoadBalancerRulesRawWithContext(ctx context.Context, v url.Values) (*ListGlobalLoadBalancerRulesResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "listGlobalLoadBalancerRules", "listgloballoadbalancerrulesresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -2736,6 +5002,93 @@ func (s *LoadBalancerService) ListGlobalLoadBalancerRules(p *ListGlobalLoadBalan
 	return &r, nil
 }
 
+// StreamGlobalLoadBalancerRules fetches the results of ListGlobalLoadBalancerRules page by page in the background and sends them on the
+// returned channel. Both returned channels are closed when done. If fetching a page fails, or the context
+// is done before all results are sent, the error is sent on the error channel and fetching stops. A
+// request for a page in flight is cancelled when the context is done. The page and pagesize set in the
+// params are ignored.
+func (s *LoadBalancerService) StreamGlobalLoadBalancerRules(ctx context.Context, p *ListGlobalLoadBalancerRulesParams) (<-chan *GlobalLoadBalancerRule, <-chan error) {
+	ch := make(chan *GlobalLoadBalancerRule)
+	errs := make(chan error, 1)
+
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesize(500)
+
+	go func() {
+		defer close(errs)
+		defer close(ch)
+
+		for page, count := 1, 0; ; page++ {
+			pp.SetPage(page)
+
+			l, err := s.ListGlobalLoadBalancerRulesWithContext(ctx, pp)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			for _, v := range l.GlobalLoadBalancerRules {
+				select {
+				case ch <- v:
+				case <-ctx.Done():
+					errs <- ctx.Err()
+					return
+				}
+			}
+
+			count += len(l.GlobalLoadBalancerRules)
+			if len(l.GlobalLoadBalancerRules) < 500 || count >= l.Count {
+				return
+			}
+		}
+	}()
+
+	return ch, errs
+}
+
+// ListGlobalLoadBalancerRulesWhere returns all results of ListGlobalLoadBalancerRules for which pred returns true. Note that the results are
+// filtered client-side, so all results matching the params are fetched first.
+// All pages are fetched, so the page and pagesize set in the params are ignored.
+func (s *LoadBalancerService) ListGlobalLoadBalancerRulesWhere(p *ListGlobalLoadBalancerRulesParams, pred func(*GlobalLoadBalancerRule) bool) ([]*GlobalLoadBalancerRule, error) {
+	var r []*GlobalLoadBalancerRule
+	ch, errs := s.StreamGlobalLoadBalancerRules(context.Background(), p)
+	for v := range ch {
+		if pred(v) {
+			r = append(r, v)
+		}
+	}
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ListAllGlobalLoadBalancerRules returns the results of ListGlobalLoadBalancerRules across all pages, by calling ListGlobalLoadBalancerRules page by page until all
+// results are fetched. The pagesize set in the params is used for every page (500 if not set), and the
+// page set in the params is ignored.
+func (s *LoadBalancerService) ListAllGlobalLoadBalancerRules(p *ListGlobalLoadBalancerRulesParams) ([]*GlobalLoadBalancerRule, error) {
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesizeIfUnset(500)
+	pagesize := pp.p["pagesize"].(int)
+
+	var r []*GlobalLoadBalancerRule
+	for page := 1; ; page++ {
+		pp.SetPage(page)
+
+		l, err := s.ListGlobalLoadBalancerRules(pp)
+		if err != nil {
+			return nil, err
+		}
+		r = append(r, l.GlobalLoadBalancerRules...)
+
+		if len(l.GlobalLoadBalancerRules) < pagesize || len(r) >= l.Count {
+			return r, nil
+		}
+	}
+}
+
 type ListGlobalLoadBalancerRulesResponse struct {
 	Count                   int                       `json:"count"`
 	GlobalLoadBalancerRules []*GlobalLoadBalancerRule `json:"globalloadbalancerrule"`
@@ -2794,6 +5147,16 @@ type ListLBHealthCheckPoliciesParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ListLBHealthCheckPoliciesParams) Clone() *ListLBHealthCheckPoliciesParams {
+	c := &ListLBHealthCheckPoliciesParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListLBHealthCheckPoliciesParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -2831,6 +5194,13 @@ func (p *ListLBHealthCheckPoliciesParams) SetFordisplay(v bool) {
 	return
 }
 
+// SetFordisplayIfUnset sets fordisplay to v, unless it is already set
+func (p *ListLBHealthCheckPoliciesParams) SetFordisplayIfUnset(v bool) {
+	if _, found := p.p["fordisplay"]; !found {
+		p.SetFordisplay(v)
+	}
+}
+
 func (p *ListLBHealthCheckPoliciesParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2839,6 +5209,13 @@ func (p *ListLBHealthCheckPoliciesParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *ListLBHealthCheckPoliciesParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 func (p *ListLBHealthCheckPoliciesParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2847,6 +5224,13 @@ func (p *ListLBHealthCheckPoliciesParams) SetKeyword(v string) {
 	return
 }
 
+// SetKeywordIfUnset sets keyword to v, unless it is already set
+func (p *ListLBHealthCheckPoliciesParams) SetKeywordIfUnset(v string) {
+	if _, found := p.p["keyword"]; !found {
+		p.SetKeyword(v)
+	}
+}
+
 func (p *ListLBHealthCheckPoliciesParams) SetLbruleid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2855,6 +5239,13 @@ func (p *ListLBHealthCheckPoliciesParams) SetLbruleid(v string) {
 	return
 }
 
+// SetLbruleidIfUnset sets lbruleid to v, unless it is already set
+func (p *ListLBHealthCheckPoliciesParams) SetLbruleidIfUnset(v string) {
+	if _, found := p.p["lbruleid"]; !found {
+		p.SetLbruleid(v)
+	}
+}
+
 func (p *ListLBHealthCheckPoliciesParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2863,6 +5254,13 @@ func (p *ListLBHealthCheckPoliciesParams) SetPage(v int) {
 	return
 }
 
+// SetPageIfUnset sets page to v, unless it is already set
+func (p *ListLBHealthCheckPoliciesParams) SetPageIfUnset(v int) {
+	if _, found := p.p["page"]; !found {
+		p.SetPage(v)
+	}
+}
+
 func (p *ListLBHealthCheckPoliciesParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2871,14 +5269,32 @@ func (p *ListLBHealthCheckPoliciesParams) SetPagesize(v int) {
 	return
 }
 
+// SetPagesizeIfUnset sets pagesize to v, unless it is already set
+func (p *ListLBHealthCheckPoliciesParams) SetPagesizeIfUnset(v int) {
+	if _, found := p.p["pagesize"]; !found {
+		p.SetPagesize(v)
+	}
+}
+
 // You should always use this function to get a new ListLBHealthCheckPoliciesParams instance,
-// as then you are sure you have configured all required params
-func (s *LoadBalancerService) NewListLBHealthCheckPoliciesParams() *ListLBHealthCheckPoliciesParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *LoadBalancerService) NewListLBHealthCheckPoliciesParams(opts ...func(*ListLBHealthCheckPoliciesParams)) *ListLBHealthCheckPoliciesParams {
 	p := &ListLBHealthCheckPoliciesParams{}
 	p.p = make(map[string]interface{})
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
+func (p *ListLBHealthCheckPoliciesParams) setFirstMatch() {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p[firstMatchKey] = true
+}
+
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *LoadBalancerService) GetLBHealthCheckPolicyByID(id string, opts ...OptionFunc) (*LBHealthCheckPolicy, int, error) {
 	p := &ListLBHealthCheckPoliciesParams{}
@@ -2886,7 +5302,7 @@ func (s *LoadBalancerService) GetLBHealthCheckPolicyByID(id string, opts ...Opti
 
 	p.p["id"] = id
 
-	for _, fn := range append(s.cs.options, opts...) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
 		if err := fn(s.cs, p); err != nil {
 			return nil, -1, err
 		}
@@ -2897,24 +5313,56 @@ func (s *LoadBalancerService) GetLBHealthCheckPolicyByID(id string, opts ...Opti
 		if strings.Contains(err.Error(), fmt.Sprintf(
 			"Invalid parameter id value=%s due to incorrect long value format, "+
 				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+			return nil, 0, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
 	}
 
 	if l.Count == 1 {
 		return l.LBHealthCheckPolicies[0], l.Count, nil
 	}
+
+	if _, ok := p.p[firstMatchKey]; ok && len(l.LBHealthCheckPolicies) > 0 {
+		return l.LBHealthCheckPolicies[0], l.Count, nil
+	}
 	return nil, l.Count, fmt.Errorf("There is more then one result for LBHealthCheckPolicy UUID: %s!", id)
 }
 
+// LBHealthCheckPolicyExists returns true if a LBHealthCheckPolicy with the given ID exists. When no match is found
+// it returns false, while any other error is returned as is.
+func (s *LoadBalancerService) LBHealthCheckPolicyExists(id string, opts ...OptionFunc) (bool, error) {
+	_, count, err := s.GetLBHealthCheckPolicyByID(id, opts...)
+	if errors.Is(err, ErrNotFound) {
+		return false, nil
+	}
+	if count > 0 {
+		return true, nil
+	}
+	return false, err
+}
+
 // Lists load balancer health check policies.
 func (s *LoadBalancerService) ListLBHealthCheckPolicies(p *ListLBHealthCheckPoliciesParams) (*ListLBHealthCheckPoliciesResponse, error) {
-	resp, err := s.cs.newRequest("listLBHealthCheckPolicies", p.toURLValues())
+	return s.ListLBHealthCheckPoliciesWithContext(context.Background(), p)
+}
+
+// ListLBHealthCheckPoliciesWithContext is the same as ListLBHealthCheckPolicies, but the request is cancelled when the context is done
+func (s *LoadBalancerService) ListLBHealthCheckPoliciesWithContext(ctx context.Context, p *ListLBHealthCheckPoliciesParams) (*ListLBHealthCheckPoliciesResponse, error) {
+	return s.ListLBHealthCheckPoliciesRawWithContext(ctx, p.toURLValues())
+}
+
+// ListLBHealthCheckPoliciesRaw is the same as ListLBHealthCheckPolicies, but takes the params as url.Values instead of typed params
+func (s *LoadBalancerService) ListLBHealthCheckPoliciesRaw(v url.Values) (*ListLBHealthCheckPoliciesResponse, error) {
+	return s.ListLBHealthCheckPoliciesRawWithContext(context.Background(), v)
+}
+
+// ListLBHealthCheckPoliciesRawWithContext is the same as ListLBHealthCheckPoliciesRaw, but the request is cancelled when the context is done
+func (s *LoadBalancerService) ListLBHealthCheckPoliciesRawWithContext(ctx context.Context, v url.Values) (*ListLBHealthCheckPoliciesResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "listLBHealthCheckPolicies", "listlbhealthcheckpoliciesresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -2927,6 +5375,93 @@ func (s *LoadBalancerService) ListLBHealthCheckPolicies(p *ListLBHealthCheckPoli
 	return &r, nil
 }
 
+// StreamLBHealthCheckPolicies fetches the results of ListLBHealthCheckPolicies page by page in the background and sends them on the
+// returned channel. Both returned channels are closed when done. If fetching a page fails, or the context
+// is done before all results are sent, the error is sent on the error channel and fetching stops. A
+// request for a page in flight is cancelled when the context is done. The page and pagesize set in the
+// params are ignored.
+func (s *LoadBalancerService) StreamLBHealthCheckPolicies(ctx context.Context, p *ListLBHealthCheckPoliciesParams) (<-chan *LBHealthCheckPolicy, <-chan error) {
+	ch := make(chan *LBHealthCheckPolicy)
+	errs := make(chan error, 1)
+
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesize(500)
+
+	go func() {
+		defer close(errs)
+		defer close(ch)
+
+		for page, count := 1, 0; ; page++ {
+			pp.SetPage(page)
+
+			l, err := s.ListLBHealthCheckPoliciesWithContext(ctx, pp)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			for _, v := range l.LBHealthCheckPolicies {
+				select {
+				case ch <- v:
+				case <-ctx.Done():
+					errs <- ctx.Err()
+					return
+				}
+			}
+
+			count += len(l.LBHealthCheckPolicies)
+			if len(l.LBHealthCheckPolicies) < 500 || count >= l.Count {
+				return
+			}
+		}
+	}()
+
+	return ch, errs
+}
+
+// ListLBHealthCheckPoliciesWhere returns all results of ListLBHealthCheckPolicies for which pred returns true. Note that the results are
+// filtered client-side, so all results matching the params are fetched first.
+// All pages are fetched, so the page and pagesize set in the params are ignored.
+func (s *LoadBalancerService) ListLBHealthCheckPoliciesWhere(p *ListLBHealthCheckPoliciesParams, pred func(*LBHealthCheckPolicy) bool) ([]*LBHealthCheckPolicy, error) {
+	var r []*LBHealthCheckPolicy
+	ch, errs := s.StreamLBHealthCheckPolicies(context.Background(), p)
+	for v := range ch {
+		if pred(v) {
+			r = append(r, v)
+		}
+	}
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ListAllLBHealthCheckPolicies returns the results of ListLBHealthCheckPolicies across all pages, by calling ListLBHealthCheckPolicies page by page until all
+// results are fetched. The pagesize set in the params is used for every page (500 if not set), and the
+// page set in the params is ignored.
+func (s *LoadBalancerService) ListAllLBHealthCheckPolicies(p *ListLBHealthCheckPoliciesParams) ([]*LBHealthCheckPolicy, error) {
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesizeIfUnset(500)
+	pagesize := pp.p["pagesize"].(int)
+
+	var r []*LBHealthCheckPolicy
+	for page := 1; ; page++ {
+		pp.SetPage(page)
+
+		l, err := s.ListLBHealthCheckPolicies(pp)
+		if err != nil {
+			return nil, err
+		}
+		r = append(r, l.LBHealthCheckPolicies...)
+
+		if len(l.LBHealthCheckPolicies) < pagesize || len(r) >= l.Count {
+			return r, nil
+		}
+	}
+}
+
 type ListLBHealthCheckPoliciesResponse struct {
 	Count                 int                    `json:"count"`
 	LBHealthCheckPolicies []*LBHealthCheckPolicy `json:"lbhealthcheckpolicy"`
@@ -2955,6 +5490,16 @@ type ListLBStickinessPoliciesParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ListLBStickinessPoliciesParams) Clone() *ListLBStickinessPoliciesParams {
+	c := &ListLBStickinessPoliciesParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListLBStickinessPoliciesParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -2992,6 +5537,13 @@ func (p *ListLBStickinessPoliciesParams) SetFordisplay(v bool) {
 	return
 }
 
+// SetFordisplayIfUnset sets fordisplay to v, unless it is already set
+func (p *ListLBStickinessPoliciesParams) SetFordisplayIfUnset(v bool) {
+	if _, found := p.p["fordisplay"]; !found {
+		p.SetFordisplay(v)
+	}
+}
+
 func (p *ListLBStickinessPoliciesParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3000,6 +5552,13 @@ func (p *ListLBStickinessPoliciesParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *ListLBStickinessPoliciesParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 func (p *ListLBStickinessPoliciesParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3008,6 +5567,13 @@ func (p *ListLBStickinessPoliciesParams) SetKeyword(v string) {
 	return
 }
 
+// SetKeywordIfUnset sets keyword to v, unless it is already set
+func (p *ListLBStickinessPoliciesParams) SetKeywordIfUnset(v string) {
+	if _, found := p.p["keyword"]; !found {
+		p.SetKeyword(v)
+	}
+}
+
 func (p *ListLBStickinessPoliciesParams) SetLbruleid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3016,6 +5582,13 @@ func (p *ListLBStickinessPoliciesParams) SetLbruleid(v string) {
 	return
 }
 
+// SetLbruleidIfUnset sets lbruleid to v, unless it is already set
+func (p *ListLBStickinessPoliciesParams) SetLbruleidIfUnset(v string) {
+	if _, found := p.p["lbruleid"]; !found {
+		p.SetLbruleid(v)
+	}
+}
+
 func (p *ListLBStickinessPoliciesParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3024,6 +5597,13 @@ func (p *ListLBStickinessPoliciesParams) SetPage(v int) {
 	return
 }
 
+// SetPageIfUnset sets page to v, unless it is already set
+func (p *ListLBStickinessPoliciesParams) SetPageIfUnset(v int) {
+	if _, found := p.p["page"]; !found {
+		p.SetPage(v)
+	}
+}
+
 func (p *ListLBStickinessPoliciesParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3032,14 +5612,32 @@ func (p *ListLBStickinessPoliciesParams) SetPagesize(v int) {
 	return
 }
 
+// SetPagesizeIfUnset sets pagesize to v, unless it is already set
+func (p *ListLBStickinessPoliciesParams) SetPagesizeIfUnset(v int) {
+	if _, found := p.p["pagesize"]; !found {
+		p.SetPagesize(v)
+	}
+}
+
 // You should always use this function to get a new ListLBStickinessPoliciesParams instance,
-// as then you are sure you have configured all required params
-func (s *LoadBalancerService) NewListLBStickinessPoliciesParams() *ListLBStickinessPoliciesParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *LoadBalancerService) NewListLBStickinessPoliciesParams(opts ...func(*ListLBStickinessPoliciesParams)) *ListLBStickinessPoliciesParams {
 	p := &ListLBStickinessPoliciesParams{}
 	p.p = make(map[string]interface{})
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
+func (p *ListLBStickinessPoliciesParams) setFirstMatch() {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p[firstMatchKey] = true
+}
+
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *LoadBalancerService) GetLBStickinessPolicyByID(id string, opts ...OptionFunc) (*LBStickinessPolicy, int, error) {
 	p := &ListLBStickinessPoliciesParams{}
@@ -3047,7 +5645,7 @@ func (s *LoadBalancerService) GetLBStickinessPolicyByID(id string, opts ...Optio
 
 	p.p["id"] = id
 
-	for _, fn := range append(s.cs.options, opts...) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
 		if err := fn(s.cs, p); err != nil {
 			return nil, -1, err
 		}
@@ -3058,24 +5656,56 @@ func (s *LoadBalancerService) GetLBStickinessPolicyByID(id string, opts ...Optio
 		if strings.Contains(err.Error(), fmt.Sprintf(
 			"Invalid parameter id value=%s due to incorrect long value format, "+
 				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+			return nil, 0, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
 	}
 
 	if l.Count == 1 {
 		return l.LBStickinessPolicies[0], l.Count, nil
 	}
+
+	if _, ok := p.p[firstMatchKey]; ok && len(l.LBStickinessPolicies) > 0 {
+		return l.LBStickinessPolicies[0], l.Count, nil
+	}
 	return nil, l.Count, fmt.Errorf("There is more then one result for LBStickinessPolicy UUID: %s!", id)
 }
 
+// LBStickinessPolicyExists returns true if a LBStickinessPolicy with the given ID exists. When no match is found
+// it returns false, while any other error is returned as is.
+func (s *LoadBalancerService) LBStickinessPolicyExists(id string, opts ...OptionFunc) (bool, error) {
+	_, count, err := s.GetLBStickinessPolicyByID(id, opts...)
+	if errors.Is(err, ErrNotFound) {
+		return false, nil
+	}
+	if count > 0 {
+		return true, nil
+	}
+	return false, err
+}
+
 // Lists load balancer stickiness policies.
 func (s *LoadBalancerService) ListLBStickinessPolicies(p *ListLBStickinessPoliciesParams) (*ListLBStickinessPoliciesResponse, error) {
-	resp, err := s.cs.newRequest("listLBStickinessPolicies", p.toURLValues())
+	return s.ListLBStickinessPoliciesWithContext(context.Background(), p)
+}
+
+// ListLBStickinessPoliciesWithContext is the same as ListLBStickinessPolicies, but the request is cancelled when the context is done
+func (s *LoadBalancerService) ListLBStickinessPoliciesWithContext(ctx context.Context, p *ListLBStickinessPoliciesParams) (*ListLBStickinessPoliciesResponse, error) {
+	return s.ListLBStickinessPoliciesRawWithContext(ctx, p.toURLValues())
+}
+
+// ListLBStickinessPoliciesRaw is the same as ListLBStickinessPolicies, but takes the params as url.Values instead of typed params
+func (s *LoadBalancerService) ListLBStickinessPoliciesRaw(v url.Values) (*ListLBStickinessPoliciesResponse, error) {
+	return s.ListLBStickinessPoliciesRawWithContext(context.Background(), v)
+}
+
+// ListLBStickinessPoliciesRawWithContext is the same as ListLBStickinessPoliciesRaw, but the request is cancelled when the context is done
+func (s *LoadBalancerService) ListLBStickinessPoliciesRawWithContext(ctx context.Context, v url.Values) (*ListLBStickinessPoliciesResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "listLBStickinessPolicies", "listlbstickinesspoliciesresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -3088,6 +5718,93 @@ func (s *LoadBalancerService) ListLBStickinessPolicies(p *ListLBStickinessPolici
 	return &r, nil
 }
 
+// StreamLBStickinessPolicies fetches the results of ListLBStickinessPolicies page by page in the background and sends them on the
+// returned channel. Both returned channels are closed when done. If fetching a page fails, or the context
+// is done before all results are sent, the error is sent on the error channel and fetching stops. A
+// request for a page in flight is cancelled when the context is done. The page and pagesize set in the
+// params are ignored.
+func (s *LoadBalancerService) StreamLBStickinessPolicies(ctx context.Context, p *ListLBStickinessPoliciesParams) (<-chan *LBStickinessPolicy, <-chan error) {
+	ch := make(chan *LBStickinessPolicy)
+	errs := make(chan error, 1)
+
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesize(500)
+
+	go func() {
+		defer close(errs)
+		defer close(ch)
+
+		for page, count := 1, 0; ; page++ {
+			pp.SetPage(page)
+
+			l, err := s.ListLBStickinessPoliciesWithContext(ctx, pp)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			for _, v := range l.LBStickinessPolicies {
+				select {
+				case ch <- v:
+				case <-ctx.Done():
+					errs <- ctx.Err()
+					return
+				}
+			}
+
+			count += len(l.LBStickinessPolicies)
+			if len(l.LBStickinessPolicies) < 500 || count >= l.Count {
+				return
+			}
+		}
+	}()
+
+	return ch, errs
+}
+
+// ListLBStickinessPoliciesWhere returns all results of ListLBStickinessPolicies for which pred returns true. Note that the results are
+// filtered client-side, so all results matching the params are fetched first.
+// All pages are fetched, so the page and pagesize set in the params are ignored.
+func (s *LoadBalancerService) ListLBStickinessPoliciesWhere(p *ListLBStickinessPoliciesParams, pred func(*LBStickinessPolicy) bool) ([]*LBStickinessPolicy, error) {
+	var r []*LBStickinessPolicy
+	ch, errs := s.StreamLBStickinessPolicies(context.Background(), p)
+	for v := range ch {
+		if pred(v) {
+			r = append(r, v)
+		}
+	}
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ListAllLBStickinessPolicies returns the results of ListLBStickinessPolicies across all pages, by calling ListLBStickinessPolicies page by page until all
+// results are fetched. The pagesize set in the params is used for every page (500 if not set), and the
+// page set in the params is ignored.
+func (s *LoadBalancerService) ListAllLBStickinessPolicies(p *ListLBStickinessPoliciesParams) ([]*LBStickinessPolicy, error) {
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesizeIfUnset(500)
+	pagesize := pp.p["pagesize"].(int)
+
+	var r []*LBStickinessPolicy
+	for page := 1; ; page++ {
+		pp.SetPage(page)
+
+		l, err := s.ListLBStickinessPolicies(pp)
+		if err != nil {
+			return nil, err
+		}
+		r = append(r, l.LBStickinessPolicies...)
+
+		if len(l.LBStickinessPolicies) < pagesize || len(r) >= l.Count {
+			return r, nil
+		}
+	}
+}
+
 type ListLBStickinessPoliciesResponse struct {
 	Count                int                   `json:"count"`
 	LBStickinessPolicies []*LBStickinessPolicy `json:"lbstickinesspolicy"`
@@ -3102,13 +5819,13 @@ type LBStickinessPolicy struct {
 	Name             string `json:"name"`
 	State            string `json:"state"`
 	Stickinesspolicy []struct {
-		Description string            `json:"description"`
-		Fordisplay  bool              `json:"fordisplay"`
-		Id          string            `json:"id"`
-		Methodname  string            `json:"methodname"`
-		Name        string            `json:"name"`
-		Params      map[string]string `json:"params"`
-		State       string            `json:"state"`
+		Description string    `json:"description"`
+		Fordisplay  bool      `json:"fordisplay"`
+		Id          string    `json:"id"`
+		Methodname  string    `json:"methodname"`
+		Name        string    `json:"name"`
+		Params      StringMap `json:"params"`
+		State       string    `json:"state"`
 	} `json:"stickinesspolicy"`
 	Zoneid string `json:"zoneid"`
 }
@@ -3117,6 +5834,16 @@ type ListLoadBalancerRuleInstancesParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ListLoadBalancerRuleInstancesParams) Clone() *ListLoadBalancerRuleInstancesParams {
+	c := &ListLoadBalancerRuleInstancesParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListLoadBalancerRuleInstancesParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -3147,6 +5874,16 @@ func (p *ListLoadBalancerRuleInstancesParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *ListLoadBalancerRuleInstancesParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of listLoadBalancerRuleInstances", k)
+		}
+	}
+	return nil
+}
+
 func (p *ListLoadBalancerRuleInstancesParams) SetApplied(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3155,6 +5892,13 @@ func (p *ListLoadBalancerRuleInstancesParams) SetApplied(v bool) {
 	return
 }
 
+// SetAppliedIfUnset sets applied to v, unless it is already set
+func (p *ListLoadBalancerRuleInstancesParams) SetAppliedIfUnset(v bool) {
+	if _, found := p.p["applied"]; !found {
+		p.SetApplied(v)
+	}
+}
+
 func (p *ListLoadBalancerRuleInstancesParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3163,6 +5907,13 @@ func (p *ListLoadBalancerRuleInstancesParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *ListLoadBalancerRuleInstancesParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 func (p *ListLoadBalancerRuleInstancesParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3171,6 +5922,13 @@ func (p *ListLoadBalancerRuleInstancesParams) SetKeyword(v string) {
 	return
 }
 
+// SetKeywordIfUnset sets keyword to v, unless it is already set
+func (p *ListLoadBalancerRuleInstancesParams) SetKeywordIfUnset(v string) {
+	if _, found := p.p["keyword"]; !found {
+		p.SetKeyword(v)
+	}
+}
+
 func (p *ListLoadBalancerRuleInstancesParams) SetLbvmips(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3179,6 +5937,13 @@ func (p *ListLoadBalancerRuleInstancesParams) SetLbvmips(v bool) {
 	return
 }
 
+// SetLbvmipsIfUnset sets lbvmips to v, unless it is already set
+func (p *ListLoadBalancerRuleInstancesParams) SetLbvmipsIfUnset(v bool) {
+	if _, found := p.p["lbvmips"]; !found {
+		p.SetLbvmips(v)
+	}
+}
+
 func (p *ListLoadBalancerRuleInstancesParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3187,6 +5952,13 @@ func (p *ListLoadBalancerRuleInstancesParams) SetPage(v int) {
 	return
 }
 
+// SetPageIfUnset sets page to v, unless it is already set
+func (p *ListLoadBalancerRuleInstancesParams) SetPageIfUnset(v int) {
+	if _, found := p.p["page"]; !found {
+		p.SetPage(v)
+	}
+}
+
 func (p *ListLoadBalancerRuleInstancesParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3195,15 +5967,33 @@ func (p *ListLoadBalancerRuleInstancesParams) SetPagesize(v int) {
 	return
 }
 
+// SetPagesizeIfUnset sets pagesize to v, unless it is already set
+func (p *ListLoadBalancerRuleInstancesParams) SetPagesizeIfUnset(v int) {
+	if _, found := p.p["pagesize"]; !found {
+		p.SetPagesize(v)
+	}
+}
+
 // You should always use this function to get a new ListLoadBalancerRuleInstancesParams instance,
-// as then you are sure you have configured all required params
-func (s *LoadBalancerService) NewListLoadBalancerRuleInstancesParams(id string) *ListLoadBalancerRuleInstancesParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *LoadBalancerService) NewListLoadBalancerRuleInstancesParams(id string, opts ...func(*ListLoadBalancerRuleInstancesParams)) *ListLoadBalancerRuleInstancesParams {
 	p := &ListLoadBalancerRuleInstancesParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
+func (p *ListLoadBalancerRuleInstancesParams) setFirstMatch() {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p[firstMatchKey] = true
+}
+
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *LoadBalancerService) GetLoadBalancerRuleInstanceByID(id string, opts ...OptionFunc) (*VirtualMachine, int, error) {
 	p := &ListLoadBalancerRuleInstancesParams{}
@@ -3211,7 +6001,7 @@ func (s *LoadBalancerService) GetLoadBalancerRuleInstanceByID(id string, opts ..
 
 	p.p["id"] = id
 
-	for _, fn := range append(s.cs.options, opts...) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
 		if err := fn(s.cs, p); err != nil {
 			return nil, -1, err
 		}
@@ -3222,24 +6012,60 @@ func (s *LoadBalancerService) GetLoadBalancerRuleInstanceByID(id string, opts ..
 		if strings.Contains(err.Error(), fmt.Sprintf(
 			"Invalid parameter id value=%s due to incorrect long value format, "+
 				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+			return nil, 0, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
 	}
 
 	if l.Count == 1 {
 		return l.LoadBalancerRuleInstances[0], l.Count, nil
 	}
+
+	if _, ok := p.p[firstMatchKey]; ok && len(l.LoadBalancerRuleInstances) > 0 {
+		return l.LoadBalancerRuleInstances[0], l.Count, nil
+	}
 	return nil, l.Count, fmt.Errorf("There is more then one result for LoadBalancerRuleInstance UUID: %s!", id)
 }
 
+// LoadBalancerRuleInstanceExists returns true if a LoadBalancerRuleInstance with the given ID exists. When no match is found
+// it returns false, while any other error is returned as is.
+func (s *LoadBalancerService) LoadBalancerRuleInstanceExists(id string, opts ...OptionFunc) (bool, error) {
+	_, count, err := s.GetLoadBalancerRuleInstanceByID(id, opts...)
+	if errors.Is(err, ErrNotFound) {
+		return false, nil
+	}
+	if count > 0 {
+		return true, nil
+	}
+	return false, err
+}
+
 // List all virtual machine instances that are assigned to a load balancer rule.
 func (s *LoadBalancerService) ListLoadBalancerRuleInstances(p *ListLoadBalancerRuleInstancesParams) (*ListLoadBalancerRuleInstancesResponse, error) {
-	resp, err := s.cs.newRequest("listLoadBalancerRuleInstances", p.toURLValues())
+	return s.ListLoadBalancerRuleInstancesWithContext(context.Background(), p)
+}
+
+// ListLoadBalancerRuleInstancesWithContext is the same as ListLoadBalancerRuleInstances, but the request is cancelled when the context is done
+func (s *LoadBalancerService) ListLoadBalancerRuleInstancesWithContext(ctx context.Context, p *ListLoadBalancerRuleInstancesParams) (*ListLoadBalancerRuleInstancesResponse, error) {
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.ListLoadBalancerRuleInstancesRawWithContext(ctx, p.toURLValues())
+}
+
+// ListLoadBalancerRuleInstancesRaw is the same as ListLoadBalancerRuleInstances, but takes the params as url.Values instead of typed params
+func (s *LoadBalancerService) ListLoadBalancerRuleInstancesRaw(v url.Values) (*ListLoadBalancerRuleInstancesResponse, error) {
+	return s.ListLoadBalancerRuleInstancesRawWithContext(context.Background(), v)
+}
+
+// ListLoadBalancerRuleInstancesRawWithContext is the same as ListLoadBalancerRuleInstancesRaw, but the request is cancelled when the context is done
+func (s *LoadBalancerService) ListLoadBalancerRuleInstancesRawWithContext(ctx context.Context, v url.Values) (*ListLoadBalancerRuleInstancesResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "listLoadBalancerRuleInstances", "listloadbalancerruleinstancesresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -3267,6 +6093,16 @@ type ListLoadBalancerRulesParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ListLoadBalancerRulesParams) Clone() *ListLoadBalancerRulesParams {
+	c := &ListLoadBalancerRulesParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListLoadBalancerRulesParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -3317,11 +6153,10 @@ func (p *ListLoadBalancerRulesParams) toURLValues() url.Values {
 		u.Set("publicipid", v.(string))
 	}
 	if v, found := p.p["tags"]; found {
-		i := 0
-		for k, vv := range v.(map[string]string) {
+		m := v.(map[string]string)
+		for i, k := range getSortedKeysFromMap(m) {
 			u.Set(fmt.Sprintf("tags[%d].key", i), k)
-			u.Set(fmt.Sprintf("tags[%d].value", i), vv)
-			i++
+			u.Set(fmt.Sprintf("tags[%d].value", i), m[k])
 		}
 	}
 	if v, found := p.p["virtualmachineid"]; found {
@@ -3341,6 +6176,13 @@ func (p *ListLoadBalancerRulesParams) SetAccount(v string) {
 	return
 }
 
+// SetAccountIfUnset sets account to v, unless it is already set
+func (p *ListLoadBalancerRulesParams) SetAccountIfUnset(v string) {
+	if _, found := p.p["account"]; !found {
+		p.SetAccount(v)
+	}
+}
+
 func (p *ListLoadBalancerRulesParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3349,6 +6191,13 @@ func (p *ListLoadBalancerRulesParams) SetDomainid(v string) {
 	return
 }
 
+// SetDomainidIfUnset sets domainid to v, unless it is already set
+func (p *ListLoadBalancerRulesParams) SetDomainidIfUnset(v string) {
+	if _, found := p.p["domainid"]; !found {
+		p.SetDomainid(v)
+	}
+}
+
 func (p *ListLoadBalancerRulesParams) SetFordisplay(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3357,6 +6206,13 @@ func (p *ListLoadBalancerRulesParams) SetFordisplay(v bool) {
 	return
 }
 
+// SetFordisplayIfUnset sets fordisplay to v, unless it is already set
+func (p *ListLoadBalancerRulesParams) SetFordisplayIfUnset(v bool) {
+	if _, found := p.p["fordisplay"]; !found {
+		p.SetFordisplay(v)
+	}
+}
+
 func (p *ListLoadBalancerRulesParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3365,6 +6221,13 @@ func (p *ListLoadBalancerRulesParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *ListLoadBalancerRulesParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 func (p *ListLoadBalancerRulesParams) SetIsrecursive(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3373,6 +6236,13 @@ func (p *ListLoadBalancerRulesParams) SetIsrecursive(v bool) {
 	return
 }
 
+// SetIsrecursiveIfUnset sets isrecursive to v, unless it is already set
+func (p *ListLoadBalancerRulesParams) SetIsrecursiveIfUnset(v bool) {
+	if _, found := p.p["isrecursive"]; !found {
+		p.SetIsrecursive(v)
+	}
+}
+
 func (p *ListLoadBalancerRulesParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3381,6 +6251,13 @@ func (p *ListLoadBalancerRulesParams) SetKeyword(v string) {
 	return
 }
 
+// SetKeywordIfUnset sets keyword to v, unless it is already set
+func (p *ListLoadBalancerRulesParams) SetKeywordIfUnset(v string) {
+	if _, found := p.p["keyword"]; !found {
+		p.SetKeyword(v)
+	}
+}
+
 func (p *ListLoadBalancerRulesParams) SetListall(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3389,6 +6266,13 @@ func (p *ListLoadBalancerRulesParams) SetListall(v bool) {
 	return
 }
 
+// SetListallIfUnset sets listall to v, unless it is already set
+func (p *ListLoadBalancerRulesParams) SetListallIfUnset(v bool) {
+	if _, found := p.p["listall"]; !found {
+		p.SetListall(v)
+	}
+}
+
 func (p *ListLoadBalancerRulesParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3397,6 +6281,13 @@ func (p *ListLoadBalancerRulesParams) SetName(v string) {
 	return
 }
 
+// SetNameIfUnset sets name to v, unless it is already set
+func (p *ListLoadBalancerRulesParams) SetNameIfUnset(v string) {
+	if _, found := p.p["name"]; !found {
+		p.SetName(v)
+	}
+}
+
 func (p *ListLoadBalancerRulesParams) SetNetworkid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3405,6 +6296,13 @@ func (p *ListLoadBalancerRulesParams) SetNetworkid(v string) {
 	return
 }
 
+// SetNetworkidIfUnset sets networkid to v, unless it is already set
+func (p *ListLoadBalancerRulesParams) SetNetworkidIfUnset(v string) {
+	if _, found := p.p["networkid"]; !found {
+		p.SetNetworkid(v)
+	}
+}
+
 func (p *ListLoadBalancerRulesParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3413,6 +6311,13 @@ func (p *ListLoadBalancerRulesParams) SetPage(v int) {
 	return
 }
 
+// SetPageIfUnset sets page to v, unless it is already set
+func (p *ListLoadBalancerRulesParams) SetPageIfUnset(v int) {
+	if _, found := p.p["page"]; !found {
+		p.SetPage(v)
+	}
+}
+
 func (p *ListLoadBalancerRulesParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3421,6 +6326,13 @@ func (p *ListLoadBalancerRulesParams) SetPagesize(v int) {
 	return
 }
 
+// SetPagesizeIfUnset sets pagesize to v, unless it is already set
+func (p *ListLoadBalancerRulesParams) SetPagesizeIfUnset(v int) {
+	if _, found := p.p["pagesize"]; !found {
+		p.SetPagesize(v)
+	}
+}
+
 func (p *ListLoadBalancerRulesParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3429,6 +6341,13 @@ func (p *ListLoadBalancerRulesParams) SetProjectid(v string) {
 	return
 }
 
+// SetProjectidIfUnset sets projectid to v, unless it is already set
+func (p *ListLoadBalancerRulesParams) SetProjectidIfUnset(v string) {
+	if _, found := p.p["projectid"]; !found {
+		p.SetProjectid(v)
+	}
+}
+
 func (p *ListLoadBalancerRulesParams) SetPublicipid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3437,6 +6356,13 @@ func (p *ListLoadBalancerRulesParams) SetPublicipid(v string) {
 	return
 }
 
+// SetPublicipidIfUnset sets publicipid to v, unless it is already set
+func (p *ListLoadBalancerRulesParams) SetPublicipidIfUnset(v string) {
+	if _, found := p.p["publicipid"]; !found {
+		p.SetPublicipid(v)
+	}
+}
+
 func (p *ListLoadBalancerRulesParams) SetTags(v map[string]string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3445,6 +6371,13 @@ func (p *ListLoadBalancerRulesParams) SetTags(v map[string]string) {
 	return
 }
 
+// SetTagsIfUnset sets tags to v, unless it is already set
+func (p *ListLoadBalancerRulesParams) SetTagsIfUnset(v map[string]string) {
+	if _, found := p.p["tags"]; !found {
+		p.SetTags(v)
+	}
+}
+
 func (p *ListLoadBalancerRulesParams) SetVirtualmachineid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3453,6 +6386,13 @@ func (p *ListLoadBalancerRulesParams) SetVirtualmachineid(v string) {
 	return
 }
 
+// SetVirtualmachineidIfUnset sets virtualmachineid to v, unless it is already set
+func (p *ListLoadBalancerRulesParams) SetVirtualmachineidIfUnset(v string) {
+	if _, found := p.p["virtualmachineid"]; !found {
+		p.SetVirtualmachineid(v)
+	}
+}
+
 func (p *ListLoadBalancerRulesParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3461,14 +6401,34 @@ func (p *ListLoadBalancerRulesParams) SetZoneid(v string) {
 	return
 }
 
+// SetZoneidIfUnset sets zoneid to v, unless it is already set
+func (p *ListLoadBalancerRulesParams) SetZoneidIfUnset(v string) {
+	if _, found := p.p["zoneid"]; !found {
+		p.SetZoneid(v)
+	}
+}
+
+var _ ListAllSetter = (*ListLoadBalancerRulesParams)(nil)
+
 // You should always use this function to get a new ListLoadBalancerRulesParams instance,
-// as then you are sure you have configured all required params
-func (s *LoadBalancerService) NewListLoadBalancerRulesParams() *ListLoadBalancerRulesParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *LoadBalancerService) NewListLoadBalancerRulesParams(opts ...func(*ListLoadBalancerRulesParams)) *ListLoadBalancerRulesParams {
 	p := &ListLoadBalancerRulesParams{}
 	p.p = make(map[string]interface{})
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
+func (p *ListLoadBalancerRulesParams) setFirstMatch() {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p[firstMatchKey] = true
+}
+
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *LoadBalancerService) GetLoadBalancerRuleID(name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListLoadBalancerRulesParams{}
@@ -3476,7 +6436,7 @@ func (s *LoadBalancerService) GetLoadBalancerRuleID(name string, opts ...OptionF
 
 	p.p["name"] = name
 
-	for _, fn := range append(s.cs.options, opts...) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
 		if err := fn(s.cs, p); err != nil {
 			return "", -1, err
 		}
@@ -3488,7 +6448,7 @@ func (s *LoadBalancerService) GetLoadBalancerRuleID(name string, opts ...OptionF
 	}
 
 	if l.Count == 0 {
-		return "", l.Count, fmt.Errorf("No match found for %s: %+v", name, l)
+		return "", l.Count, fmt.Errorf("%w for %s: %+v", ErrNotFound, name, l)
 	}
 
 	if l.Count == 1 {
@@ -3501,6 +6461,10 @@ func (s *LoadBalancerService) GetLoadBalancerRuleID(name string, opts ...OptionF
 				return v.Id, l.Count, nil
 			}
 		}
+
+		if _, ok := p.p[firstMatchKey]; ok && len(l.LoadBalancerRules) > 0 {
+			return l.LoadBalancerRules[0].Id, l.Count, nil
+		}
 	}
 	return "", l.Count, fmt.Errorf("Could not find an exact match for %s: %+v", name, l)
 }
@@ -3526,7 +6490,7 @@ func (s *LoadBalancerService) GetLoadBalancerRuleByID(id string, opts ...OptionF
 
 	p.p["id"] = id
 
-	for _, fn := range append(s.cs.options, opts...) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
 		if err := fn(s.cs, p); err != nil {
 			return nil, -1, err
 		}
@@ -3537,24 +6501,56 @@ func (s *LoadBalancerService) GetLoadBalancerRuleByID(id string, opts ...OptionF
 		if strings.Contains(err.Error(), fmt.Sprintf(
 			"Invalid parameter id value=%s due to incorrect long value format, "+
 				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+			return nil, 0, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
 	}
 
 	if l.Count == 1 {
 		return l.LoadBalancerRules[0], l.Count, nil
 	}
+
+	if _, ok := p.p[firstMatchKey]; ok && len(l.LoadBalancerRules) > 0 {
+		return l.LoadBalancerRules[0], l.Count, nil
+	}
 	return nil, l.Count, fmt.Errorf("There is more then one result for LoadBalancerRule UUID: %s!", id)
 }
 
+// LoadBalancerRuleExists returns true if a LoadBalancerRule with the given ID exists. When no match is found
+// it returns false, while any other error is returned as is.
+func (s *LoadBalancerService) LoadBalancerRuleExists(id string, opts ...OptionFunc) (bool, error) {
+	_, count, err := s.GetLoadBalancerRuleByID(id, opts...)
+	if errors.Is(err, ErrNotFound) {
+		return false, nil
+	}
+	if count > 0 {
+		return true, nil
+	}
+	return false, err
+}
+
 // Lists load balancer rules.
 func (s *LoadBalancerService) ListLoadBalancerRules(p *ListLoadBalancerRulesParams) (*ListLoadBalancerRulesResponse, error) {
-	resp, err := s.cs.newRequest("listLoadBalancerRules", p.toURLValues())
+	return s.ListLoadBalancerRulesWithContext(context.Background(), p)
+}
+
+// ListLoadBalancerRulesWithContext is the same as ListLoadBalancerRules, but the request is cancelled when the context is done
+func (s *LoadBalancerService) ListLoadBalancerRulesWithContext(ctx context.Context, p *ListLoadBalancerRulesParams) (*ListLoadBalancerRulesResponse, error) {
+	return s.ListLoadBalancerRulesRawWithContext(ctx, p.toURLValues())
+}
+
+// ListLoadBalancerRulesRaw is the same as ListLoadBalancerRules, but takes the params as url.Values instead of typed params
+func (s *LoadBalancerService) ListLoadBalancerRulesRaw(v url.Values) (*ListLoadBalancerRulesResponse, error) {
+	return s.ListLoadBalancerRulesRawWithContext(context.Background(), v)
+}
+
+// ListLoadBalancerRulesRawWithContext is the same as ListLoadBalancerRulesRaw, but the request is cancelled when the context is done
+func (s *LoadBalancerService) ListLoadBalancerRulesRawWithContext(ctx context.Context, v url.Values) (*ListLoadBalancerRulesResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "listLoadBalancerRules", "listloadbalancerrulesresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -3564,7 +6560,94 @@ func (s *LoadBalancerService) ListLoadBalancerRules(p *ListLoadBalancerRulesPara
 		return nil, err
 	}
 
-	return &r, nil
+	return &r, nil
+}
+
+// StreamLoadBalancerRules fetches the results of ListLoadBalancerRules page by page in the background and sends them on the
+// returned channel. Both returned channels are closed when done. If fetching a page fails, or the context
+// is done before all results are sent, the error is sent on the error channel and fetching stops. A
+// request for a page in flight is cancelled when the context is done. The page and pagesize set in the
+// params are ignored.
+func (s *LoadBalancerService) StreamLoadBalancerRules(ctx context.Context, p *ListLoadBalancerRulesParams) (<-chan *LoadBalancerRule, <-chan error) {
+	ch := make(chan *LoadBalancerRule)
+	errs := make(chan error, 1)
+
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesize(500)
+
+	go func() {
+		defer close(errs)
+		defer close(ch)
+
+		for page, count := 1, 0; ; page++ {
+			pp.SetPage(page)
+
+			l, err := s.ListLoadBalancerRulesWithContext(ctx, pp)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			for _, v := range l.LoadBalancerRules {
+				select {
+				case ch <- v:
+				case <-ctx.Done():
+					errs <- ctx.Err()
+					return
+				}
+			}
+
+			count += len(l.LoadBalancerRules)
+			if len(l.LoadBalancerRules) < 500 || count >= l.Count {
+				return
+			}
+		}
+	}()
+
+	return ch, errs
+}
+
+// ListLoadBalancerRulesWhere returns all results of ListLoadBalancerRules for which pred returns true. Note that the results are
+// filtered client-side, so all results matching the params are fetched first.
+// All pages are fetched, so the page and pagesize set in the params are ignored.
+func (s *LoadBalancerService) ListLoadBalancerRulesWhere(p *ListLoadBalancerRulesParams, pred func(*LoadBalancerRule) bool) ([]*LoadBalancerRule, error) {
+	var r []*LoadBalancerRule
+	ch, errs := s.StreamLoadBalancerRules(context.Background(), p)
+	for v := range ch {
+		if pred(v) {
+			r = append(r, v)
+		}
+	}
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ListAllLoadBalancerRules returns the results of ListLoadBalancerRules across all pages, by calling ListLoadBalancerRules page by page until all
+// results are fetched. The pagesize set in the params is used for every page (500 if not set), and the
+// page set in the params is ignored.
+func (s *LoadBalancerService) ListAllLoadBalancerRules(p *ListLoadBalancerRulesParams) ([]*LoadBalancerRule, error) {
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesizeIfUnset(500)
+	pagesize := pp.p["pagesize"].(int)
+
+	var r []*LoadBalancerRule
+	for page := 1; ; page++ {
+		pp.SetPage(page)
+
+		l, err := s.ListLoadBalancerRules(pp)
+		if err != nil {
+			return nil, err
+		}
+		r = append(r, l.LoadBalancerRules...)
+
+		if len(l.LoadBalancerRules) < pagesize || len(r) >= l.Count {
+			return r, nil
+		}
+	}
 }
 
 type ListLoadBalancerRulesResponse struct {
@@ -3610,6 +6693,16 @@ type ListLoadBalancersParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ListLoadBalancersParams) Clone() *ListLoadBalancersParams {
+	c := &ListLoadBalancersParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListLoadBalancersParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -3666,11 +6759,10 @@ func (p *ListLoadBalancersParams) toURLValues() url.Values {
 		u.Set("sourceipaddressnetworkid", v.(string))
 	}
 	if v, found := p.p["tags"]; found {
-		i := 0
-		for k, vv := range v.(map[string]string) {
+		m := v.(map[string]string)
+		for i, k := range getSortedKeysFromMap(m) {
 			u.Set(fmt.Sprintf("tags[%d].key", i), k)
-			u.Set(fmt.Sprintf("tags[%d].value", i), vv)
-			i++
+			u.Set(fmt.Sprintf("tags[%d].value", i), m[k])
 		}
 	}
 	return u
@@ -3684,6 +6776,13 @@ func (p *ListLoadBalancersParams) SetAccount(v string) {
 	return
 }
 
+// SetAccountIfUnset sets account to v, unless it is already set
+func (p *ListLoadBalancersParams) SetAccountIfUnset(v string) {
+	if _, found := p.p["account"]; !found {
+		p.SetAccount(v)
+	}
+}
+
 func (p *ListLoadBalancersParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3692,6 +6791,13 @@ func (p *ListLoadBalancersParams) SetDomainid(v string) {
 	return
 }
 
+// SetDomainidIfUnset sets domainid to v, unless it is already set
+func (p *ListLoadBalancersParams) SetDomainidIfUnset(v string) {
+	if _, found := p.p["domainid"]; !found {
+		p.SetDomainid(v)
+	}
+}
+
 func (p *ListLoadBalancersParams) SetFordisplay(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3700,6 +6806,13 @@ func (p *ListLoadBalancersParams) SetFordisplay(v bool) {
 	return
 }
 
+// SetFordisplayIfUnset sets fordisplay to v, unless it is already set
+func (p *ListLoadBalancersParams) SetFordisplayIfUnset(v bool) {
+	if _, found := p.p["fordisplay"]; !found {
+		p.SetFordisplay(v)
+	}
+}
+
 func (p *ListLoadBalancersParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3708,6 +6821,13 @@ func (p *ListLoadBalancersParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *ListLoadBalancersParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 func (p *ListLoadBalancersParams) SetIsrecursive(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3716,6 +6836,13 @@ func (p *ListLoadBalancersParams) SetIsrecursive(v bool) {
 	return
 }
 
+// SetIsrecursiveIfUnset sets isrecursive to v, unless it is already set
+func (p *ListLoadBalancersParams) SetIsrecursiveIfUnset(v bool) {
+	if _, found := p.p["isrecursive"]; !found {
+		p.SetIsrecursive(v)
+	}
+}
+
 func (p *ListLoadBalancersParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3724,6 +6851,13 @@ func (p *ListLoadBalancersParams) SetKeyword(v string) {
 	return
 }
 
+// SetKeywordIfUnset sets keyword to v, unless it is already set
+func (p *ListLoadBalancersParams) SetKeywordIfUnset(v string) {
+	if _, found := p.p["keyword"]; !found {
+		p.SetKeyword(v)
+	}
+}
+
 func (p *ListLoadBalancersParams) SetListall(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3732,6 +6866,13 @@ func (p *ListLoadBalancersParams) SetListall(v bool) {
 	return
 }
 
+// SetListallIfUnset sets listall to v, unless it is already set
+func (p *ListLoadBalancersParams) SetListallIfUnset(v bool) {
+	if _, found := p.p["listall"]; !found {
+		p.SetListall(v)
+	}
+}
+
 func (p *ListLoadBalancersParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3740,6 +6881,13 @@ func (p *ListLoadBalancersParams) SetName(v string) {
 	return
 }
 
+// SetNameIfUnset sets name to v, unless it is already set
+func (p *ListLoadBalancersParams) SetNameIfUnset(v string) {
+	if _, found := p.p["name"]; !found {
+		p.SetName(v)
+	}
+}
+
 func (p *ListLoadBalancersParams) SetNetworkid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3748,6 +6896,13 @@ func (p *ListLoadBalancersParams) SetNetworkid(v string) {
 	return
 }
 
+// SetNetworkidIfUnset sets networkid to v, unless it is already set
+func (p *ListLoadBalancersParams) SetNetworkidIfUnset(v string) {
+	if _, found := p.p["networkid"]; !found {
+		p.SetNetworkid(v)
+	}
+}
+
 func (p *ListLoadBalancersParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3756,6 +6911,13 @@ func (p *ListLoadBalancersParams) SetPage(v int) {
 	return
 }
 
+// SetPageIfUnset sets page to v, unless it is already set
+func (p *ListLoadBalancersParams) SetPageIfUnset(v int) {
+	if _, found := p.p["page"]; !found {
+		p.SetPage(v)
+	}
+}
+
 func (p *ListLoadBalancersParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3764,6 +6926,13 @@ func (p *ListLoadBalancersParams) SetPagesize(v int) {
 	return
 }
 
+// SetPagesizeIfUnset sets pagesize to v, unless it is already set
+func (p *ListLoadBalancersParams) SetPagesizeIfUnset(v int) {
+	if _, found := p.p["pagesize"]; !found {
+		p.SetPagesize(v)
+	}
+}
+
 func (p *ListLoadBalancersParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3772,6 +6941,13 @@ func (p *ListLoadBalancersParams) SetProjectid(v string) {
 	return
 }
 
+// SetProjectidIfUnset sets projectid to v, unless it is already set
+func (p *ListLoadBalancersParams) SetProjectidIfUnset(v string) {
+	if _, found := p.p["projectid"]; !found {
+		p.SetProjectid(v)
+	}
+}
+
 func (p *ListLoadBalancersParams) SetScheme(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3780,6 +6956,13 @@ func (p *ListLoadBalancersParams) SetScheme(v string) {
 	return
 }
 
+// SetSchemeIfUnset sets scheme to v, unless it is already set
+func (p *ListLoadBalancersParams) SetSchemeIfUnset(v string) {
+	if _, found := p.p["scheme"]; !found {
+		p.SetScheme(v)
+	}
+}
+
 func (p *ListLoadBalancersParams) SetSourceipaddress(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3788,6 +6971,13 @@ func (p *ListLoadBalancersParams) SetSourceipaddress(v string) {
 	return
 }
 
+// SetSourceipaddressIfUnset sets sourceipaddress to v, unless it is already set
+func (p *ListLoadBalancersParams) SetSourceipaddressIfUnset(v string) {
+	if _, found := p.p["sourceipaddress"]; !found {
+		p.SetSourceipaddress(v)
+	}
+}
+
 func (p *ListLoadBalancersParams) SetSourceipaddressnetworkid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3796,6 +6986,13 @@ func (p *ListLoadBalancersParams) SetSourceipaddressnetworkid(v string) {
 	return
 }
 
+// SetSourceipaddressnetworkidIfUnset sets sourceipaddressnetworkid to v, unless it is already set
+func (p *ListLoadBalancersParams) SetSourceipaddressnetworkidIfUnset(v string) {
+	if _, found := p.p["sourceipaddressnetworkid"]; !found {
+		p.SetSourceipaddressnetworkid(v)
+	}
+}
+
 func (p *ListLoadBalancersParams) SetTags(v map[string]string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3804,14 +7001,34 @@ func (p *ListLoadBalancersParams) SetTags(v map[string]string) {
 	return
 }
 
+// SetTagsIfUnset sets tags to v, unless it is already set
+func (p *ListLoadBalancersParams) SetTagsIfUnset(v map[string]string) {
+	if _, found := p.p["tags"]; !found {
+		p.SetTags(v)
+	}
+}
+
+var _ ListAllSetter = (*ListLoadBalancersParams)(nil)
+
 // You should always use this function to get a new ListLoadBalancersParams instance,
-// as then you are sure you have configured all required params
-func (s *LoadBalancerService) NewListLoadBalancersParams() *ListLoadBalancersParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *LoadBalancerService) NewListLoadBalancersParams(opts ...func(*ListLoadBalancersParams)) *ListLoadBalancersParams {
 	p := &ListLoadBalancersParams{}
 	p.p = make(map[string]interface{})
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
+func (p *ListLoadBalancersParams) setFirstMatch() {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p[firstMatchKey] = true
+}
+
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *LoadBalancerService) GetLoadBalancerID(name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListLoadBalancersParams{}
@@ -3819,7 +7036,7 @@ func (s *LoadBalancerService) GetLoadBalancerID(name string, opts ...OptionFunc)
 
 	p.p["name"] = name
 
-	for _, fn := range append(s.cs.options, opts...) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
 		if err := fn(s.cs, p); err != nil {
 			return "", -1, err
 		}
@@ -3831,7 +7048,7 @@ func (s *LoadBalancerService) GetLoadBalancerID(name string, opts ...OptionFunc)
 	}
 
 	if l.Count == 0 {
-		return "", l.Count, fmt.Errorf("No match found for %s: %+v", name, l)
+		return "", l.Count, fmt.Errorf("%w for %s: %+v", ErrNotFound, name, l)
 	}
 
 	if l.Count == 1 {
@@ -3844,6 +7061,10 @@ func (s *LoadBalancerService) GetLoadBalancerID(name string, opts ...OptionFunc)
 				return v.Id, l.Count, nil
 			}
 		}
+
+		if _, ok := p.p[firstMatchKey]; ok && len(l.LoadBalancers) > 0 {
+			return l.LoadBalancers[0].Id, l.Count, nil
+		}
 	}
 	return "", l.Count, fmt.Errorf("Could not find an exact match for %s: %+v", name, l)
 }
@@ -3869,7 +7090,7 @@ func (s *LoadBalancerService) GetLoadBalancerByID(id string, opts ...OptionFunc)
 
 	p.p["id"] = id
 
-	for _, fn := range append(s.cs.options, opts...) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
 		if err := fn(s.cs, p); err != nil {
 			return nil, -1, err
 		}
@@ -3880,24 +7101,56 @@ func (s *LoadBalancerService) GetLoadBalancerByID(id string, opts ...OptionFunc)
 		if strings.Contains(err.Error(), fmt.Sprintf(
 			"Invalid parameter id value=%s due to incorrect long value format, "+
 				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+			return nil, 0, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
 	}
 
 	if l.Count == 1 {
 		return l.LoadBalancers[0], l.Count, nil
 	}
+
+	if _, ok := p.p[firstMatchKey]; ok && len(l.LoadBalancers) > 0 {
+		return l.LoadBalancers[0], l.Count, nil
+	}
 	return nil, l.Count, fmt.Errorf("There is more then one result for LoadBalancer UUID: %s!", id)
 }
 
+// LoadBalancerExists returns true if a LoadBalancer with the given ID exists. When no match is found
+// it returns false, while any other error is returned as is.
+func (s *LoadBalancerService) LoadBalancerExists(id string, opts ...OptionFunc) (bool, error) {
+	_, count, err := s.GetLoadBalancerByID(id, opts...)
+	if errors.Is(err, ErrNotFound) {
+		return false, nil
+	}
+	if count > 0 {
+		return true, nil
+	}
+	return false, err
+}
+
 // Lists load balancers
 func (s *LoadBalancerService) ListLoadBalancers(p *ListLoadBalancersParams) (*ListLoadBalancersResponse, error) {
-	resp, err := s.cs.newRequest("listLoadBalancers", p.toURLValues())
+	return s.ListLoadBalancersWithContext(context.Background(), p)
+}
+
+// ListLoadBalancersWithContext is the same as ListLoadBalancers, but the request is cancelled when the context is done
+func (s *LoadBalancerService) ListLoadBalancersWithContext(ctx context.Context, p *ListLoadBalancersParams) (*ListLoadBalancersResponse, error) {
+	return s.ListLoadBalancersRawWithContext(ctx, p.toURLValues())
+}
+
+// ListLoadBalancersRaw is the same as ListLoadBalancers, but takes the params as url.Values instead of typed params
+func (s *LoadBalancerService) ListLoadBalancersRaw(v url.Values) (*ListLoadBalancersResponse, error) {
+	return s.ListLoadBalancersRawWithContext(context.Background(), v)
+}
+
+// ListLoadBalancersRawWithContext is the same as ListLoadBalancersRaw, but the request is cancelled when the context is done
+func (s *LoadBalancerService) ListLoadBalancersRawWithContext(ctx context.Context, v url.Values) (*ListLoadBalancersResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "listLoadBalancers", "listloadbalancersresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -3910,6 +7163,93 @@ func (s *LoadBalancerService) ListLoadBalancers(p *ListLoadBalancersParams) (*Li
 	return &r, nil
 }
 
+// StreamLoadBalancers fetches the results of ListLoadBalancers page by page in the background and sends them on the
+// returned channel. Both returned channels are closed when done. If fetching a page fails, or the context
+// is done before all results are sent, the error is sent on the error channel and fetching stops. A
+// request for a page in flight is cancelled when the context is done. The page and pagesize set in the
+// params are ignored.
+func (s *LoadBalancerService) StreamLoadBalancers(ctx context.Context, p *ListLoadBalancersParams) (<-chan *LoadBalancer, <-chan error) {
+	ch := make(chan *LoadBalancer)
+	errs := make(chan error, 1)
+
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesize(500)
+
+	go func() {
+		defer close(errs)
+		defer close(ch)
+
+		for page, count := 1, 0; ; page++ {
+			pp.SetPage(page)
+
+			l, err := s.ListLoadBalancersWithContext(ctx, pp)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			for _, v := range l.LoadBalancers {
+				select {
+				case ch <- v:
+				case <-ctx.Done():
+					errs <- ctx.Err()
+					return
+				}
+			}
+
+			count += len(l.LoadBalancers)
+			if len(l.LoadBalancers) < 500 || count >= l.Count {
+				return
+			}
+		}
+	}()
+
+	return ch, errs
+}
+
+// ListLoadBalancersWhere returns all results of ListLoadBalancers for which pred returns true. Note that the results are
+// filtered client-side, so all results matching the params are fetched first.
+// All pages are fetched, so the page and pagesize set in the params are ignored.
+func (s *LoadBalancerService) ListLoadBalancersWhere(p *ListLoadBalancersParams, pred func(*LoadBalancer) bool) ([]*LoadBalancer, error) {
+	var r []*LoadBalancer
+	ch, errs := s.StreamLoadBalancers(context.Background(), p)
+	for v := range ch {
+		if pred(v) {
+			r = append(r, v)
+		}
+	}
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ListAllLoadBalancers returns the results of ListLoadBalancers across all pages, by calling ListLoadBalancers page by page until all
+// results are fetched. The pagesize set in the params is used for every page (500 if not set), and the
+// page set in the params is ignored.
+func (s *LoadBalancerService) ListAllLoadBalancers(p *ListLoadBalancersParams) ([]*LoadBalancer, error) {
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesizeIfUnset(500)
+	pagesize := pp.p["pagesize"].(int)
+
+	var r []*LoadBalancer
+	for page := 1; ; page++ {
+		pp.SetPage(page)
+
+		l, err := s.ListLoadBalancers(pp)
+		if err != nil {
+			return nil, err
+		}
+		r = append(r, l.LoadBalancers...)
+
+		if len(l.LoadBalancers) < pagesize || len(r) >= l.Count {
+			return r, nil
+		}
+	}
+}
+
 type ListLoadBalancersResponse struct {
 	Count         int             `json:"count"`
 	LoadBalancers []*LoadBalancer `json:"loadbalancer"`
@@ -3958,6 +7298,16 @@ type ListNetscalerLoadBalancersParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ListNetscalerLoadBalancersParams) Clone() *ListNetscalerLoadBalancersParams {
+	c := &ListNetscalerLoadBalancersParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListNetscalerLoadBalancersParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -3991,6 +7341,13 @@ func (p *ListNetscalerLoadBalancersParams) SetKeyword(v string) {
 	return
 }
 
+// SetKeywordIfUnset sets keyword to v, unless it is already set
+func (p *ListNetscalerLoadBalancersParams) SetKeywordIfUnset(v string) {
+	if _, found := p.p["keyword"]; !found {
+		p.SetKeyword(v)
+	}
+}
+
 func (p *ListNetscalerLoadBalancersParams) SetLbdeviceid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3999,6 +7356,13 @@ func (p *ListNetscalerLoadBalancersParams) SetLbdeviceid(v string) {
 	return
 }
 
+// SetLbdeviceidIfUnset sets lbdeviceid to v, unless it is already set
+func (p *ListNetscalerLoadBalancersParams) SetLbdeviceidIfUnset(v string) {
+	if _, found := p.p["lbdeviceid"]; !found {
+		p.SetLbdeviceid(v)
+	}
+}
+
 func (p *ListNetscalerLoadBalancersParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -4007,6 +7371,13 @@ func (p *ListNetscalerLoadBalancersParams) SetPage(v int) {
 	return
 }
 
+// SetPageIfUnset sets page to v, unless it is already set
+func (p *ListNetscalerLoadBalancersParams) SetPageIfUnset(v int) {
+	if _, found := p.p["page"]; !found {
+		p.SetPage(v)
+	}
+}
+
 func (p *ListNetscalerLoadBalancersParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -4015,6 +7386,13 @@ func (p *ListNetscalerLoadBalancersParams) SetPagesize(v int) {
 	return
 }
 
+// SetPagesizeIfUnset sets pagesize to v, unless it is already set
+func (p *ListNetscalerLoadBalancersParams) SetPagesizeIfUnset(v int) {
+	if _, found := p.p["pagesize"]; !found {
+		p.SetPagesize(v)
+	}
+}
+
 func (p *ListNetscalerLoadBalancersParams) SetPhysicalnetworkid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -4023,17 +7401,43 @@ func (p *ListNetscalerLoadBalancersParams) SetPhysicalnetworkid(v string) {
 	return
 }
 
+// SetPhysicalnetworkidIfUnset sets physicalnetworkid to v, unless it is already set
+func (p *ListNetscalerLoadBalancersParams) SetPhysicalnetworkidIfUnset(v string) {
+	if _, found := p.p["physicalnetworkid"]; !found {
+		p.SetPhysicalnetworkid(v)
+	}
+}
+
 // You should always use this function to get a new ListNetscalerLoadBalancersParams instance,
-// as then you are sure you have configured all required params
-func (s *LoadBalancerService) NewListNetscalerLoadBalancersParams() *ListNetscalerLoadBalancersParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *LoadBalancerService) NewListNetscalerLoadBalancersParams(opts ...func(*ListNetscalerLoadBalancersParams)) *ListNetscalerLoadBalancersParams {
 	p := &ListNetscalerLoadBalancersParams{}
 	p.p = make(map[string]interface{})
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // lists netscaler load balancer devices
 func (s *LoadBalancerService) ListNetscalerLoadBalancers(p *ListNetscalerLoadBalancersParams) (*ListNetscalerLoadBalancersResponse, error) {
-	resp, err := s.cs.newRequest("listNetscalerLoadBalancers", p.toURLValues())
+	return s.ListNetscalerLoadBalancersWithContext(context.Background(), p)
+}
+
+// ListNetscalerLoadBalancersWithContext is the same as ListNetscalerLoadBalancers, but the request is cancelled when the context is done
+func (s *LoadBalancerService) ListNetscalerLoadBalancersWithContext(ctx context.Context, p *ListNetscalerLoadBalancersParams) (*ListNetscalerLoadBalancersResponse, error) {
+	return s.ListNetscalerLoadBalancersRawWithContext(ctx, p.toURLValues())
+}
+
+// ListNetscalerLoadBalancersRaw is the same as ListNetscalerLoadBalancers, but takes the params as url.Values instead of typed params
+func (s *LoadBalancerService) ListNetscalerLoadBalancersRaw(v url.Values) (*ListNetscalerLoadBalancersResponse, error) {
+	return s.ListNetscalerLoadBalancersRawWithContext(context.Background(), v)
+}
+
+// ListNetscalerLoadBalancersRawWithContext is the same as ListNetscalerLoadBalancersRaw, but the request is cancelled when the context is done
+func (s *LoadBalancerService) ListNetscalerLoadBalancersRawWithContext(ctx context.Context, v url.Values) (*ListNetscalerLoadBalancersResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "listNetscalerLoadBalancers", "listnetscalerloadbalancersresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -4046,6 +7450,93 @@ func (s *LoadBalancerService) ListNetscalerLoadBalancers(p *ListNetscalerLoadBal
 	return &r, nil
 }
 
+// StreamNetscalerLoadBalancers fetches the results of ListNetscalerLoadBalancers page by page in the background and sends them on the
+// returned channel. Both returned channels are closed when done. If fetching a page fails, or the context
+// is done before all results are sent, the error is sent on the error channel and fetching stops. A
+// request for a page in flight is cancelled when the context is done. The page and pagesize set in the
+// params are ignored.
+func (s *LoadBalancerService) StreamNetscalerLoadBalancers(ctx context.Context, p *ListNetscalerLoadBalancersParams) (<-chan *NetscalerLoadBalancer, <-chan error) {
+	ch := make(chan *NetscalerLoadBalancer)
+	errs := make(chan error, 1)
+
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesize(500)
+
+	go func() {
+		defer close(errs)
+		defer close(ch)
+
+		for page, count := 1, 0; ; page++ {
+			pp.SetPage(page)
+
+			l, err := s.ListNetscalerLoadBalancersWithContext(ctx, pp)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			for _, v := range l.NetscalerLoadBalancers {
+				select {
+				case ch <- v:
+				case <-ctx.Done():
+					errs <- ctx.Err()
+					return
+				}
+			}
+
+			count += len(l.NetscalerLoadBalancers)
+			if len(l.NetscalerLoadBalancers) < 500 || count >= l.Count {
+				return
+			}
+		}
+	}()
+
+	return ch, errs
+}
+
+// ListNetscalerLoadBalancersWhere returns all results of ListNetscalerLoadBalancers for which pred returns true. Note that the results are
+// filtered client-side, so all results matching the params are fetched first.
+// All pages are fetched, so the page and pagesize set in the params are ignored.
+func (s *LoadBalancerService) ListNetscalerLoadBalancersWhere(p *ListNetscalerLoadBalancersParams, pred func(*NetscalerLoadBalancer) bool) ([]*NetscalerLoadBalancer, error) {
+	var r []*NetscalerLoadBalancer
+	ch, errs := s.StreamNetscalerLoadBalancers(context.Background(), p)
+	for v := range ch {
+		if pred(v) {
+			r = append(r, v)
+		}
+	}
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ListAllNetscalerLoadBalancers returns the results of ListNetscalerLoadBalancers across all pages, by calling ListNetscalerLoadBalancers page by page until all
+// results are fetched. The pagesize set in the params is used for every page (500 if not set), and the
+// page set in the params is ignored.
+func (s *LoadBalancerService) ListAllNetscalerLoadBalancers(p *ListNetscalerLoadBalancersParams) ([]*NetscalerLoadBalancer, error) {
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesizeIfUnset(500)
+	pagesize := pp.p["pagesize"].(int)
+
+	var r []*NetscalerLoadBalancer
+	for page := 1; ; page++ {
+		pp.SetPage(page)
+
+		l, err := s.ListNetscalerLoadBalancers(pp)
+		if err != nil {
+			return nil, err
+		}
+		r = append(r, l.NetscalerLoadBalancers...)
+
+		if len(l.NetscalerLoadBalancers) < pagesize || len(r) >= l.Count {
+			return r, nil
+		}
+	}
+}
+
 type ListNetscalerLoadBalancersResponse struct {
 	Count                  int                      `json:"count"`
 	NetscalerLoadBalancers []*NetscalerLoadBalancer `json:"netscalerloadbalancer"`
@@ -4073,6 +7564,16 @@ type ListSslCertsParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ListSslCertsParams) Clone() *ListSslCertsParams {
+	c := &ListSslCertsParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListSslCertsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -4101,6 +7602,13 @@ func (p *ListSslCertsParams) SetAccountid(v string) {
 	return
 }
 
+// SetAccountidIfUnset sets accountid to v, unless it is already set
+func (p *ListSslCertsParams) SetAccountidIfUnset(v string) {
+	if _, found := p.p["accountid"]; !found {
+		p.SetAccountid(v)
+	}
+}
+
 func (p *ListSslCertsParams) SetCertid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -4109,6 +7617,13 @@ func (p *ListSslCertsParams) SetCertid(v string) {
 	return
 }
 
+// SetCertidIfUnset sets certid to v, unless it is already set
+func (p *ListSslCertsParams) SetCertidIfUnset(v string) {
+	if _, found := p.p["certid"]; !found {
+		p.SetCertid(v)
+	}
+}
+
 func (p *ListSslCertsParams) SetLbruleid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -4117,6 +7632,13 @@ func (p *ListSslCertsParams) SetLbruleid(v string) {
 	return
 }
 
+// SetLbruleidIfUnset sets lbruleid to v, unless it is already set
+func (p *ListSslCertsParams) SetLbruleidIfUnset(v string) {
+	if _, found := p.p["lbruleid"]; !found {
+		p.SetLbruleid(v)
+	}
+}
+
 func (p *ListSslCertsParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -4125,17 +7647,43 @@ func (p *ListSslCertsParams) SetProjectid(v string) {
 	return
 }
 
+// SetProjectidIfUnset sets projectid to v, unless it is already set
+func (p *ListSslCertsParams) SetProjectidIfUnset(v string) {
+	if _, found := p.p["projectid"]; !found {
+		p.SetProjectid(v)
+	}
+}
+
 // You should always use this function to get a new ListSslCertsParams instance,
-// as then you are sure you have configured all required params
-func (s *LoadBalancerService) NewListSslCertsParams() *ListSslCertsParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *LoadBalancerService) NewListSslCertsParams(opts ...func(*ListSslCertsParams)) *ListSslCertsParams {
 	p := &ListSslCertsParams{}
 	p.p = make(map[string]interface{})
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Lists SSL certificates
 func (s *LoadBalancerService) ListSslCerts(p *ListSslCertsParams) (*ListSslCertsResponse, error) {
-	resp, err := s.cs.newRequest("listSslCerts", p.toURLValues())
+	return s.ListSslCertsWithContext(context.Background(), p)
+}
+
+// ListSslCertsWithContext is the same as ListSslCerts, but the request is cancelled when the context is done
+func (s *LoadBalancerService) ListSslCertsWithContext(ctx context.Context, p *ListSslCertsParams) (*ListSslCertsResponse, error) {
+	return s.ListSslCertsRawWithContext(ctx, p.toURLValues())
+}
+
+// ListSslCertsRaw is the same as ListSslCerts, but takes the params as url.Values instead of typed params
+func (s *LoadBalancerService) ListSslCertsRaw(v url.Values) (*ListSslCertsResponse, error) {
+	return s.ListSslCertsRawWithContext(context.Background(), v)
+}
+
+// ListSslCertsRawWithContext is the same as ListSslCertsRaw, but the request is cancelled when the context is done
+func (s *LoadBalancerService) ListSslCertsRawWithContext(ctx context.Context, v url.Values) (*ListSslCertsResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "listSslCerts", "listsslcertsresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -4148,6 +7696,22 @@ func (s *LoadBalancerService) ListSslCerts(p *ListSslCertsParams) (*ListSslCerts
 	return &r, nil
 }
 
+// ListSslCertsWhere returns all results of ListSslCerts for which pred returns true. Note that the results are
+// filtered client-side, so all results matching the params are fetched first.
+func (s *LoadBalancerService) ListSslCertsWhere(p *ListSslCertsParams, pred func(*SslCert) bool) ([]*SslCert, error) {
+	var r []*SslCert
+	l, err := s.ListSslCerts(p)
+	if err != nil {
+		return nil, err
+	}
+	for _, v := range l.SslCerts {
+		if pred(v) {
+			r = append(r, v)
+		}
+	}
+	return r, nil
+}
+
 type ListSslCertsResponse struct {
 	Count    int        `json:"count"`
 	SslCerts []*SslCert `json:"sslcert"`
@@ -4170,6 +7734,16 @@ type RemoveCertFromLoadBalancerParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *RemoveCertFromLoadBalancerParams) Clone() *RemoveCertFromLoadBalancerParams {
+	c := &RemoveCertFromLoadBalancerParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *RemoveCertFromLoadBalancerParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -4181,6 +7755,16 @@ func (p *RemoveCertFromLoadBalancerParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *RemoveCertFromLoadBalancerParams) validate() error {
+	for _, k := range []string{"lbruleid"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of removeCertFromLoadBalancer", k)
+		}
+	}
+	return nil
+}
+
 func (p *RemoveCertFromLoadBalancerParams) SetLbruleid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -4189,18 +7773,54 @@ func (p *RemoveCertFromLoadBalancerParams) SetLbruleid(v string) {
 	return
 }
 
+// SetLbruleidIfUnset sets lbruleid to v, unless it is already set
+func (p *RemoveCertFromLoadBalancerParams) SetLbruleidIfUnset(v string) {
+	if _, found := p.p["lbruleid"]; !found {
+		p.SetLbruleid(v)
+	}
+}
+
 // You should always use this function to get a new RemoveCertFromLoadBalancerParams instance,
-// as then you are sure you have configured all required params
-func (s *LoadBalancerService) NewRemoveCertFromLoadBalancerParams(lbruleid string) *RemoveCertFromLoadBalancerParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *LoadBalancerService) NewRemoveCertFromLoadBalancerParams(lbruleid string, opts ...func(*RemoveCertFromLoadBalancerParams)) *RemoveCertFromLoadBalancerParams {
 	p := &RemoveCertFromLoadBalancerParams{}
 	p.p = make(map[string]interface{})
 	p.p["lbruleid"] = lbruleid
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Removes a certificate from a load balancer rule
-func (s *LoadBalancerService) RemoveCertFromLoadBalancer(p *RemoveCertFromLoadBalancerParams) (*RemoveCertFromLoadBalancerResponse, error) {
-	resp, err := s.cs.newRequest("removeCertFromLoadBalancer", p.toURLValues())
+func (s *LoadBalancerService) RemoveCertFromLoadBalancer(p *RemoveCertFromLoadBalancerParams, opts ...OptionFunc) (*RemoveCertFromLoadBalancerResponse, error) {
+	return s.RemoveCertFromLoadBalancerWithContext(context.Background(), p, opts...)
+}
+
+// RemoveCertFromLoadBalancerWithContext is the same as RemoveCertFromLoadBalancer, but the request is cancelled when the context is done
+func (s *LoadBalancerService) RemoveCertFromLoadBalancerWithContext(ctx context.Context, p *RemoveCertFromLoadBalancerParams, opts ...OptionFunc) (*RemoveCertFromLoadBalancerResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.RemoveCertFromLoadBalancerRawWithContext(ctx, p.toURLValues())
+}
+
+// RemoveCertFromLoadBalancerRaw is the same as RemoveCertFromLoadBalancer, but takes the params as url.Values instead of typed params
+func (s *LoadBalancerService) RemoveCertFromLoadBalancerRaw(v url.Values) (*RemoveCertFromLoadBalancerResponse, error) {
+	return s.RemoveCertFromLoadBalancerRawWithContext(context.Background(), v)
+}
+
+// RemoveCertFromLoadBalancerRawWithContext is the same as RemoveCertFromLoadBalancerRaw, but the request is cancelled when the context is done
+func (s *LoadBalancerService) RemoveCertFromLoadBalancerRawWithContext(ctx context.Context, v url.Values) (*RemoveCertFromLoadBalancerResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "removeCertFromLoadBalancer", "removecertfromloadbalancerresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -4212,9 +7832,9 @@ func (s *LoadBalancerService) RemoveCertFromLoadBalancer(p *RemoveCertFromLoadBa
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -4238,6 +7858,16 @@ type RemoveFromGlobalLoadBalancerRuleParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *RemoveFromGlobalLoadBalancerRuleParams) Clone() *RemoveFromGlobalLoadBalancerRuleParams {
+	c := &RemoveFromGlobalLoadBalancerRuleParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *RemoveFromGlobalLoadBalancerRuleParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -4253,6 +7883,16 @@ func (p *RemoveFromGlobalLoadBalancerRuleParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *RemoveFromGlobalLoadBalancerRuleParams) validate() error {
+	for _, k := range []string{"id", "loadbalancerrulelist"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of removeFromGlobalLoadBalancerRule", k)
+		}
+	}
+	return nil
+}
+
 func (p *RemoveFromGlobalLoadBalancerRuleParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -4261,27 +7901,75 @@ func (p *RemoveFromGlobalLoadBalancerRuleParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *RemoveFromGlobalLoadBalancerRuleParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 func (p *RemoveFromGlobalLoadBalancerRuleParams) SetLoadbalancerrulelist(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	p.p["loadbalancerrulelist"] = v
-	return
+	p.p["loadbalancerrulelist"] = v
+	return
+}
+
+// SetLoadbalancerrulelistIfUnset sets loadbalancerrulelist to v, unless it is already set
+func (p *RemoveFromGlobalLoadBalancerRuleParams) SetLoadbalancerrulelistIfUnset(v []string) {
+	if _, found := p.p["loadbalancerrulelist"]; !found {
+		p.SetLoadbalancerrulelist(v)
+	}
+}
+
+// SetLoadbalancerrulelistCSV splits v on commas and sets the resulting values
+func (p *RemoveFromGlobalLoadBalancerRuleParams) SetLoadbalancerrulelistCSV(v string) {
+	p.SetLoadbalancerrulelist(splitCSV(v))
+}
+
+// You should always use this function to get a new RemoveFromGlobalLoadBalancerRuleParams instance,
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *LoadBalancerService) NewRemoveFromGlobalLoadBalancerRuleParams(id string, loadbalancerrulelist []string, opts ...func(*RemoveFromGlobalLoadBalancerRuleParams)) *RemoveFromGlobalLoadBalancerRuleParams {
+	p := &RemoveFromGlobalLoadBalancerRuleParams{}
+	p.p = make(map[string]interface{})
+	p.p["id"] = id
+	p.p["loadbalancerrulelist"] = loadbalancerrulelist
+	for _, fn := range opts {
+		fn(p)
+	}
+	return p
+}
+
+// Removes a load balancer rule association with global load balancer rule
+func (s *LoadBalancerService) RemoveFromGlobalLoadBalancerRule(p *RemoveFromGlobalLoadBalancerRuleParams, opts ...OptionFunc) (*RemoveFromGlobalLoadBalancerRuleResponse, error) {
+	return s.RemoveFromGlobalLoadBalancerRuleWithContext(context.Background(), p, opts...)
+}
+
+// RemoveFromGlobalLoadBalancerRuleWithContext is the same as RemoveFromGlobalLoadBalancerRule, but the request is cancelled when the context is done
+func (s *LoadBalancerService) RemoveFromGlobalLoadBalancerRuleWithContext(ctx context.Context, p *RemoveFromGlobalLoadBalancerRuleParams, opts ...OptionFunc) (*RemoveFromGlobalLoadBalancerRuleResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.RemoveFromGlobalLoadBalancerRuleRawWithContext(ctx, p.toURLValues())
 }
 
-// You should always use this function to get a new RemoveFromGlobalLoadBalancerRuleParams instance,
-// as then you are sure you have configured all required params
-func (s *LoadBalancerService) NewRemoveFromGlobalLoadBalancerRuleParams(id string, loadbalancerrulelist []string) *RemoveFromGlobalLoadBalancerRuleParams {
-	p := &RemoveFromGlobalLoadBalancerRuleParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
-	p.p["loadbalancerrulelist"] = loadbalancerrulelist
-	return p
+// RemoveFromGlobalLoadBalancerRuleRaw is the same as RemoveFromGlobalLoadBalancerRule, but takes the params as url.Values instead of typed params
+func (s *LoadBalancerService) RemoveFromGlobalLoadBalancerRuleRaw(v url.Values) (*RemoveFromGlobalLoadBalancerRuleResponse, error) {
+	return s.RemoveFromGlobalLoadBalancerRuleRawWithContext(context.Background(), v)
 }
 
-// Removes a load balancer rule association with global load balancer rule
-func (s *LoadBalancerService) RemoveFromGlobalLoadBalancerRule(p *RemoveFromGlobalLoadBalancerRuleParams) (*RemoveFromGlobalLoadBalancerRuleResponse, error) {
-	resp, err := s.cs.newRequest("removeFromGlobalLoadBalancerRule", p.toURLValues())
+// RemoveFromGlobalLoadBalancerRuleRawWithContext is the same as RemoveFromGlobalLoadBalancerRuleRaw, but the request is cancelled when the context is done
+func (s *LoadBalancerService) RemoveFromGlobalLoadBalancerRuleRawWithContext(ctx context.Context, v url.Values) (*RemoveFromGlobalLoadBalancerRuleResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "removeFromGlobalLoadBalancerRule", "removefromgloballoadbalancerruleresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -4293,9 +7981,9 @@ func (s *LoadBalancerService) RemoveFromGlobalLoadBalancerRule(p *RemoveFromGlob
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -4319,6 +8007,16 @@ type RemoveFromLoadBalancerRuleParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *RemoveFromLoadBalancerRuleParams) Clone() *RemoveFromLoadBalancerRuleParams {
+	c := &RemoveFromLoadBalancerRuleParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *RemoveFromLoadBalancerRuleParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -4332,16 +8030,25 @@ func (p *RemoveFromLoadBalancerRuleParams) toURLValues() url.Values {
 		u.Set("virtualmachineids", vv)
 	}
 	if v, found := p.p["vmidipmap"]; found {
-		i := 0
-		for k, vv := range v.(map[string]string) {
+		m := v.(map[string]string)
+		for i, k := range getSortedKeysFromMap(m) {
 			u.Set(fmt.Sprintf("vmidipmap[%d].key", i), k)
-			u.Set(fmt.Sprintf("vmidipmap[%d].value", i), vv)
-			i++
+			u.Set(fmt.Sprintf("vmidipmap[%d].value", i), m[k])
 		}
 	}
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *RemoveFromLoadBalancerRuleParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of removeFromLoadBalancerRule", k)
+		}
+	}
+	return nil
+}
+
 func (p *RemoveFromLoadBalancerRuleParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -4350,6 +8057,13 @@ func (p *RemoveFromLoadBalancerRuleParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *RemoveFromLoadBalancerRuleParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 func (p *RemoveFromLoadBalancerRuleParams) SetVirtualmachineids(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -4358,6 +8072,18 @@ func (p *RemoveFromLoadBalancerRuleParams) SetVirtualmachineids(v []string) {
 	return
 }
 
+// SetVirtualmachineidsIfUnset sets virtualmachineids to v, unless it is already set
+func (p *RemoveFromLoadBalancerRuleParams) SetVirtualmachineidsIfUnset(v []string) {
+	if _, found := p.p["virtualmachineids"]; !found {
+		p.SetVirtualmachineids(v)
+	}
+}
+
+// SetVirtualmachineidsCSV splits v on commas and sets the resulting values
+func (p *RemoveFromLoadBalancerRuleParams) SetVirtualmachineidsCSV(v string) {
+	p.SetVirtualmachineids(splitCSV(v))
+}
+
 func (p *RemoveFromLoadBalancerRuleParams) SetVmidipmap(v map[string]string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -4366,18 +8092,54 @@ func (p *RemoveFromLoadBalancerRuleParams) SetVmidipmap(v map[string]string) {
 	return
 }
 
+// SetVmidipmapIfUnset sets vmidipmap to v, unless it is already set
+func (p *RemoveFromLoadBalancerRuleParams) SetVmidipmapIfUnset(v map[string]string) {
+	if _, found := p.p["vmidipmap"]; !found {
+		p.SetVmidipmap(v)
+	}
+}
+
 // You should always use this function to get a new RemoveFromLoadBalancerRuleParams instance,
-// as then you are sure you have configured all required params
-func (s *LoadBalancerService) NewRemoveFromLoadBalancerRuleParams(id string) *RemoveFromLoadBalancerRuleParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *LoadBalancerService) NewRemoveFromLoadBalancerRuleParams(id string, opts ...func(*RemoveFromLoadBalancerRuleParams)) *RemoveFromLoadBalancerRuleParams {
 	p := &RemoveFromLoadBalancerRuleParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Removes a virtual machine or a list of virtual machines from a load balancer rule.
-func (s *LoadBalancerService) RemoveFromLoadBalancerRule(p *RemoveFromLoadBalancerRuleParams) (*RemoveFromLoadBalancerRuleResponse, error) {
-	resp, err := s.cs.newRequest("removeFromLoadBalancerRule", p.toURLValues())
+func (s *LoadBalancerService) RemoveFromLoadBalancerRule(p *RemoveFromLoadBalancerRuleParams, opts ...OptionFunc) (*RemoveFromLoadBalancerRuleResponse, error) {
+	return s.RemoveFromLoadBalancerRuleWithContext(context.Background(), p, opts...)
+}
+
+// RemoveFromLoadBalancerRuleWithContext is the same as RemoveFromLoadBalancerRule, but the request is cancelled when the context is done
+func (s *LoadBalancerService) RemoveFromLoadBalancerRuleWithContext(ctx context.Context, p *RemoveFromLoadBalancerRuleParams, opts ...OptionFunc) (*RemoveFromLoadBalancerRuleResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.RemoveFromLoadBalancerRuleRawWithContext(ctx, p.toURLValues())
+}
+
+// RemoveFromLoadBalancerRuleRaw is the same as RemoveFromLoadBalancerRule, but takes the params as url.Values instead of typed params
+func (s *LoadBalancerService) RemoveFromLoadBalancerRuleRaw(v url.Values) (*RemoveFromLoadBalancerRuleResponse, error) {
+	return s.RemoveFromLoadBalancerRuleRawWithContext(context.Background(), v)
+}
+
+// RemoveFromLoadBalancerRuleRawWithContext is the same as RemoveFromLoadBalancerRuleRaw, but the request is cancelled when the context is done
+func (s *LoadBalancerService) RemoveFromLoadBalancerRuleRawWithContext(ctx context.Context, v url.Values) (*RemoveFromLoadBalancerRuleResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "removeFromLoadBalancerRule", "removefromloadbalancerruleresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -4389,9 +8151,9 @@ func (s *LoadBalancerService) RemoveFromLoadBalancerRule(p *RemoveFromLoadBalanc
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -4415,6 +8177,16 @@ type UpdateGlobalLoadBalancerRuleParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *UpdateGlobalLoadBalancerRuleParams) Clone() *UpdateGlobalLoadBalancerRuleParams {
+	c := &UpdateGlobalLoadBalancerRuleParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *UpdateGlobalLoadBalancerRuleParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -4435,6 +8207,16 @@ func (p *UpdateGlobalLoadBalancerRuleParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *UpdateGlobalLoadBalancerRuleParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of updateGlobalLoadBalancerRule", k)
+		}
+	}
+	return nil
+}
+
 func (p *UpdateGlobalLoadBalancerRuleParams) SetDescription(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -4443,6 +8225,13 @@ func (p *UpdateGlobalLoadBalancerRuleParams) SetDescription(v string) {
 	return
 }
 
+// SetDescriptionIfUnset sets description to v, unless it is already set
+func (p *UpdateGlobalLoadBalancerRuleParams) SetDescriptionIfUnset(v string) {
+	if _, found := p.p["description"]; !found {
+		p.SetDescription(v)
+	}
+}
+
 func (p *UpdateGlobalLoadBalancerRuleParams) SetGslblbmethod(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -4451,6 +8240,13 @@ func (p *UpdateGlobalLoadBalancerRuleParams) SetGslblbmethod(v string) {
 	return
 }
 
+// SetGslblbmethodIfUnset sets gslblbmethod to v, unless it is already set
+func (p *UpdateGlobalLoadBalancerRuleParams) SetGslblbmethodIfUnset(v string) {
+	if _, found := p.p["gslblbmethod"]; !found {
+		p.SetGslblbmethod(v)
+	}
+}
+
 func (p *UpdateGlobalLoadBalancerRuleParams) SetGslbstickysessionmethodname(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -4459,6 +8255,13 @@ func (p *UpdateGlobalLoadBalancerRuleParams) SetGslbstickysessionmethodname(v st
 	return
 }
 
+// SetGslbstickysessionmethodnameIfUnset sets gslbstickysessionmethodname to v, unless it is already set
+func (p *UpdateGlobalLoadBalancerRuleParams) SetGslbstickysessionmethodnameIfUnset(v string) {
+	if _, found := p.p["gslbstickysessionmethodname"]; !found {
+		p.SetGslbstickysessionmethodname(v)
+	}
+}
+
 func (p *UpdateGlobalLoadBalancerRuleParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -4467,18 +8270,54 @@ func (p *UpdateGlobalLoadBalancerRuleParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *UpdateGlobalLoadBalancerRuleParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 // You should always use this function to get a new UpdateGlobalLoadBalancerRuleParams instance,
-// as then you are sure you have configured all required params
-func (s *LoadBalancerService) NewUpdateGlobalLoadBalancerRuleParams(id string) *UpdateGlobalLoadBalancerRuleParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *LoadBalancerService) NewUpdateGlobalLoadBalancerRuleParams(id string, opts ...func(*UpdateGlobalLoadBalancerRuleParams)) *UpdateGlobalLoadBalancerRuleParams {
 	p := &UpdateGlobalLoadBalancerRuleParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // update global load balancer rules.
-func (s *LoadBalancerService) UpdateGlobalLoadBalancerRule(p *UpdateGlobalLoadBalancerRuleParams) (*UpdateGlobalLoadBalancerRuleResponse, error) {
-	resp, err := s.cs.newRequest("updateGlobalLoadBalancerRule", p.toURLValues())
+func (s *LoadBalancerService) UpdateGlobalLoadBalancerRule(p *UpdateGlobalLoadBalancerRuleParams, opts ...OptionFunc) (*UpdateGlobalLoadBalancerRuleResponse, error) {
+	return s.UpdateGlobalLoadBalancerRuleWithContext(context.Background(), p, opts...)
+}
+
+// UpdateGlobalLoadBalancerRuleWithContext is the same as UpdateGlobalLoadBalancerRule, but the request is cancelled when the context is done
+func (s *LoadBalancerService) UpdateGlobalLoadBalancerRuleWithContext(ctx context.Context, p *UpdateGlobalLoadBalancerRuleParams, opts ...OptionFunc) (*UpdateGlobalLoadBalancerRuleResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.UpdateGlobalLoadBalancerRuleRawWithContext(ctx, p.toURLValues())
+}
+
+// UpdateGlobalLoadBalancerRuleRaw is the same as UpdateGlobalLoadBalancerRule, but takes the params as url.Values instead of typed params
+func (s *LoadBalancerService) UpdateGlobalLoadBalancerRuleRaw(v url.Values) (*UpdateGlobalLoadBalancerRuleResponse, error) {
+	return s.UpdateGlobalLoadBalancerRuleRawWithContext(context.Background(), v)
+}
+
+// UpdateGlobalLoadBalancerRuleRawWithContext is the same as UpdateGlobalLoadBalancerRuleRaw, but the request is cancelled when the context is done
+func (s *LoadBalancerService) UpdateGlobalLoadBalancerRuleRawWithContext(ctx context.Context, v url.Values) (*UpdateGlobalLoadBalancerRuleResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "updateGlobalLoadBalancerRule", "updategloballoadbalancerruleresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -4490,9 +8329,9 @@ func (s *LoadBalancerService) UpdateGlobalLoadBalancerRule(p *UpdateGlobalLoadBa
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -4565,6 +8404,16 @@ type UpdateLBHealthCheckPolicyParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *UpdateLBHealthCheckPolicyParams) Clone() *UpdateLBHealthCheckPolicyParams {
+	c := &UpdateLBHealthCheckPolicyParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *UpdateLBHealthCheckPolicyParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -4583,6 +8432,16 @@ func (p *UpdateLBHealthCheckPolicyParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *UpdateLBHealthCheckPolicyParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of updateLBHealthCheckPolicy", k)
+		}
+	}
+	return nil
+}
+
 func (p *UpdateLBHealthCheckPolicyParams) SetCustomid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -4591,6 +8450,13 @@ func (p *UpdateLBHealthCheckPolicyParams) SetCustomid(v string) {
 	return
 }
 
+// SetCustomidIfUnset sets customid to v, unless it is already set
+func (p *UpdateLBHealthCheckPolicyParams) SetCustomidIfUnset(v string) {
+	if _, found := p.p["customid"]; !found {
+		p.SetCustomid(v)
+	}
+}
+
 func (p *UpdateLBHealthCheckPolicyParams) SetFordisplay(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -4599,6 +8465,13 @@ func (p *UpdateLBHealthCheckPolicyParams) SetFordisplay(v bool) {
 	return
 }
 
+// SetFordisplayIfUnset sets fordisplay to v, unless it is already set
+func (p *UpdateLBHealthCheckPolicyParams) SetFordisplayIfUnset(v bool) {
+	if _, found := p.p["fordisplay"]; !found {
+		p.SetFordisplay(v)
+	}
+}
+
 func (p *UpdateLBHealthCheckPolicyParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -4607,18 +8480,54 @@ func (p *UpdateLBHealthCheckPolicyParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *UpdateLBHealthCheckPolicyParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 // You should always use this function to get a new UpdateLBHealthCheckPolicyParams instance,
-// as then you are sure you have configured all required params
-func (s *LoadBalancerService) NewUpdateLBHealthCheckPolicyParams(id string) *UpdateLBHealthCheckPolicyParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *LoadBalancerService) NewUpdateLBHealthCheckPolicyParams(id string, opts ...func(*UpdateLBHealthCheckPolicyParams)) *UpdateLBHealthCheckPolicyParams {
 	p := &UpdateLBHealthCheckPolicyParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Updates load balancer health check policy
-func (s *LoadBalancerService) UpdateLBHealthCheckPolicy(p *UpdateLBHealthCheckPolicyParams) (*UpdateLBHealthCheckPolicyResponse, error) {
-	resp, err := s.cs.newRequest("updateLBHealthCheckPolicy", p.toURLValues())
+func (s *LoadBalancerService) UpdateLBHealthCheckPolicy(p *UpdateLBHealthCheckPolicyParams, opts ...OptionFunc) (*UpdateLBHealthCheckPolicyResponse, error) {
+	return s.UpdateLBHealthCheckPolicyWithContext(context.Background(), p, opts...)
+}
+
+// UpdateLBHealthCheckPolicyWithContext is the same as UpdateLBHealthCheckPolicy, but the request is cancelled when the context is done
+func (s *LoadBalancerService) UpdateLBHealthCheckPolicyWithContext(ctx context.Context, p *UpdateLBHealthCheckPolicyParams, opts ...OptionFunc) (*UpdateLBHealthCheckPolicyResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.UpdateLBHealthCheckPolicyRawWithContext(ctx, p.toURLValues())
+}
+
+// UpdateLBHealthCheckPolicyRaw is the same as UpdateLBHealthCheckPolicy, but takes the params as url.Values instead of typed params
+func (s *LoadBalancerService) UpdateLBHealthCheckPolicyRaw(v url.Values) (*UpdateLBHealthCheckPolicyResponse, error) {
+	return s.UpdateLBHealthCheckPolicyRawWithContext(context.Background(), v)
+}
+
+// UpdateLBHealthCheckPolicyRawWithContext is the same as UpdateLBHealthCheckPolicyRaw, but the request is cancelled when the context is done
+func (s *LoadBalancerService) UpdateLBHealthCheckPolicyRawWithContext(ctx context.Context, v url.Values) (*UpdateLBHealthCheckPolicyResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "updateLBHealthCheckPolicy", "updatelbhealthcheckpolicyresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -4630,9 +8539,9 @@ func (s *LoadBalancerService) UpdateLBHealthCheckPolicy(p *UpdateLBHealthCheckPo
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -4675,6 +8584,16 @@ type UpdateLBStickinessPolicyParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *UpdateLBStickinessPolicyParams) Clone() *UpdateLBStickinessPolicyParams {
+	c := &UpdateLBStickinessPolicyParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *UpdateLBStickinessPolicyParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -4693,6 +8612,16 @@ func (p *UpdateLBStickinessPolicyParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *UpdateLBStickinessPolicyParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of updateLBStickinessPolicy", k)
+		}
+	}
+	return nil
+}
+
 func (p *UpdateLBStickinessPolicyParams) SetCustomid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -4701,6 +8630,13 @@ func (p *UpdateLBStickinessPolicyParams) SetCustomid(v string) {
 	return
 }
 
+// SetCustomidIfUnset sets customid to v, unless it is already set
+func (p *UpdateLBStickinessPolicyParams) SetCustomidIfUnset(v string) {
+	if _, found := p.p["customid"]; !found {
+		p.SetCustomid(v)
+	}
+}
+
 func (p *UpdateLBStickinessPolicyParams) SetFordisplay(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -4709,6 +8645,13 @@ func (p *UpdateLBStickinessPolicyParams) SetFordisplay(v bool) {
 	return
 }
 
+// SetFordisplayIfUnset sets fordisplay to v, unless it is already set
+func (p *UpdateLBStickinessPolicyParams) SetFordisplayIfUnset(v bool) {
+	if _, found := p.p["fordisplay"]; !found {
+		p.SetFordisplay(v)
+	}
+}
+
 func (p *UpdateLBStickinessPolicyParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -4717,18 +8660,54 @@ func (p *UpdateLBStickinessPolicyParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *UpdateLBStickinessPolicyParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 // You should always use this function to get a new UpdateLBStickinessPolicyParams instance,
-// as then you are sure you have configured all required params
-func (s *LoadBalancerService) NewUpdateLBStickinessPolicyParams(id string) *UpdateLBStickinessPolicyParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *LoadBalancerService) NewUpdateLBStickinessPolicyParams(id string, opts ...func(*UpdateLBStickinessPolicyParams)) *UpdateLBStickinessPolicyParams {
 	p := &UpdateLBStickinessPolicyParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Updates load balancer stickiness policy
-func (s *LoadBalancerService) UpdateLBStickinessPolicy(p *UpdateLBStickinessPolicyParams) (*UpdateLBStickinessPolicyResponse, error) {
-	resp, err := s.cs.newRequest("updateLBStickinessPolicy", p.toURLValues())
+func (s *LoadBalancerService) UpdateLBStickinessPolicy(p *UpdateLBStickinessPolicyParams, opts ...OptionFunc) (*UpdateLBStickinessPolicyResponse, error) {
+	return s.UpdateLBStickinessPolicyWithContext(context.Background(), p, opts...)
+}
+
+// UpdateLBStickinessPolicyWithContext is the same as UpdateLBStickinessPolicy, but the request is cancelled when the context is done
+func (s *LoadBalancerService) UpdateLBStickinessPolicyWithContext(ctx context.Context, p *UpdateLBStickinessPolicyParams, opts ...OptionFunc) (*UpdateLBStickinessPolicyResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.UpdateLBStickinessPolicyRawWithContext(ctx, p.toURLValues())
+}
+
+// UpdateLBStickinessPolicyRaw is the same as UpdateLBStickinessPolicy, but takes the params as url.Values instead of typed params
+func (s *LoadBalancerService) UpdateLBStickinessPolicyRaw(v url.Values) (*UpdateLBStickinessPolicyResponse, error) {
+	return s.UpdateLBStickinessPolicyRawWithContext(context.Background(), v)
+}
+
+// UpdateLBStickinessPolicyRawWithContext is the same as UpdateLBStickinessPolicyRaw, but the request is cancelled when the context is done
+func (s *LoadBalancerService) UpdateLBStickinessPolicyRawWithContext(ctx context.Context, v url.Values) (*UpdateLBStickinessPolicyResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "updateLBStickinessPolicy", "updatelbstickinesspolicyresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -4740,9 +8719,9 @@ func (s *LoadBalancerService) UpdateLBStickinessPolicy(p *UpdateLBStickinessPoli
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -4771,13 +8750,13 @@ type UpdateLBStickinessPolicyResponse struct {
 	Name             string `json:"name"`
 	State            string `json:"state"`
 	Stickinesspolicy []struct {
-		Description string            `json:"description"`
-		Fordisplay  bool              `json:"fordisplay"`
-		Id          string            `json:"id"`
-		Methodname  string            `json:"methodname"`
-		Name        string            `json:"name"`
-		Params      map[string]string `json:"params"`
-		State       string            `json:"state"`
+		Description string    `json:"description"`
+		Fordisplay  bool      `json:"fordisplay"`
+		Id          string    `json:"id"`
+		Methodname  string    `json:"methodname"`
+		Name        string    `json:"name"`
+		Params      StringMap `json:"params"`
+		State       string    `json:"state"`
 	} `json:"stickinesspolicy"`
 	Zoneid string `json:"zoneid"`
 }
@@ -4786,6 +8765,16 @@ type UpdateLoadBalancerParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *UpdateLoadBalancerParams) Clone() *UpdateLoadBalancerParams {
+	c := &UpdateLoadBalancerParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *UpdateLoadBalancerParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -4804,6 +8793,16 @@ func (p *UpdateLoadBalancerParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *UpdateLoadBalancerParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of updateLoadBalancer", k)
+		}
+	}
+	return nil
+}
+
 func (p *UpdateLoadBalancerParams) SetCustomid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -4812,6 +8811,13 @@ func (p *UpdateLoadBalancerParams) SetCustomid(v string) {
 	return
 }
 
+// SetCustomidIfUnset sets customid to v, unless it is already set
+func (p *UpdateLoadBalancerParams) SetCustomidIfUnset(v string) {
+	if _, found := p.p["customid"]; !found {
+		p.SetCustomid(v)
+	}
+}
+
 func (p *UpdateLoadBalancerParams) SetFordisplay(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -4820,6 +8826,13 @@ func (p *UpdateLoadBalancerParams) SetFordisplay(v bool) {
 	return
 }
 
+// SetFordisplayIfUnset sets fordisplay to v, unless it is already set
+func (p *UpdateLoadBalancerParams) SetFordisplayIfUnset(v bool) {
+	if _, found := p.p["fordisplay"]; !found {
+		p.SetFordisplay(v)
+	}
+}
+
 func (p *UpdateLoadBalancerParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -4828,18 +8841,54 @@ func (p *UpdateLoadBalancerParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *UpdateLoadBalancerParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 // You should always use this function to get a new UpdateLoadBalancerParams instance,
-// as then you are sure you have configured all required params
-func (s *LoadBalancerService) NewUpdateLoadBalancerParams(id string) *UpdateLoadBalancerParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *LoadBalancerService) NewUpdateLoadBalancerParams(id string, opts ...func(*UpdateLoadBalancerParams)) *UpdateLoadBalancerParams {
 	p := &UpdateLoadBalancerParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Updates a load balancer
-func (s *LoadBalancerService) UpdateLoadBalancer(p *UpdateLoadBalancerParams) (*UpdateLoadBalancerResponse, error) {
-	resp, err := s.cs.newRequest("updateLoadBalancer", p.toURLValues())
+func (s *LoadBalancerService) UpdateLoadBalancer(p *UpdateLoadBalancerParams, opts ...OptionFunc) (*UpdateLoadBalancerResponse, error) {
+	return s.UpdateLoadBalancerWithContext(context.Background(), p, opts...)
+}
+
+// UpdateLoadBalancerWithContext is the same as UpdateLoadBalancer, but the request is cancelled when the context is done
+func (s *LoadBalancerService) UpdateLoadBalancerWithContext(ctx context.Context, p *UpdateLoadBalancerParams, opts ...OptionFunc) (*UpdateLoadBalancerResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.UpdateLoadBalancerRawWithContext(ctx, p.toURLValues())
+}
+
+// UpdateLoadBalancerRaw is the same as UpdateLoadBalancer, but takes the params as url.Values instead of typed params
+func (s *LoadBalancerService) UpdateLoadBalancerRaw(v url.Values) (*UpdateLoadBalancerResponse, error) {
+	return s.UpdateLoadBalancerRawWithContext(context.Background(), v)
+}
+
+// UpdateLoadBalancerRawWithContext is the same as UpdateLoadBalancerRaw, but the request is cancelled when the context is done
+func (s *LoadBalancerService) UpdateLoadBalancerRawWithContext(ctx context.Context, v url.Values) (*UpdateLoadBalancerResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "updateLoadBalancer", "updateloadbalancerresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -4851,9 +8900,9 @@ func (s *LoadBalancerService) UpdateLoadBalancer(p *UpdateLoadBalancerParams) (*
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -4916,6 +8965,16 @@ type UpdateLoadBalancerRuleParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *UpdateLoadBalancerRuleParams) Clone() *UpdateLoadBalancerRuleParams {
+	c := &UpdateLoadBalancerRuleParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *UpdateLoadBalancerRuleParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -4943,6 +9002,16 @@ func (p *UpdateLoadBalancerRuleParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *UpdateLoadBalancerRuleParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of updateLoadBalancerRule", k)
+		}
+	}
+	return nil
+}
+
 func (p *UpdateLoadBalancerRuleParams) SetAlgorithm(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -4951,6 +9020,13 @@ func (p *UpdateLoadBalancerRuleParams) SetAlgorithm(v string) {
 	return
 }
 
+// SetAlgorithmIfUnset sets algorithm to v, unless it is already set
+func (p *UpdateLoadBalancerRuleParams) SetAlgorithmIfUnset(v string) {
+	if _, found := p.p["algorithm"]; !found {
+		p.SetAlgorithm(v)
+	}
+}
+
 func (p *UpdateLoadBalancerRuleParams) SetCustomid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -4959,6 +9035,13 @@ func (p *UpdateLoadBalancerRuleParams) SetCustomid(v string) {
 	return
 }
 
+// SetCustomidIfUnset sets customid to v, unless it is already set
+func (p *UpdateLoadBalancerRuleParams) SetCustomidIfUnset(v string) {
+	if _, found := p.p["customid"]; !found {
+		p.SetCustomid(v)
+	}
+}
+
 func (p *UpdateLoadBalancerRuleParams) SetDescription(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -4967,6 +9050,13 @@ func (p *UpdateLoadBalancerRuleParams) SetDescription(v string) {
 	return
 }
 
+// SetDescriptionIfUnset sets description to v, unless it is already set
+func (p *UpdateLoadBalancerRuleParams) SetDescriptionIfUnset(v string) {
+	if _, found := p.p["description"]; !found {
+		p.SetDescription(v)
+	}
+}
+
 func (p *UpdateLoadBalancerRuleParams) SetFordisplay(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -4975,6 +9065,13 @@ func (p *UpdateLoadBalancerRuleParams) SetFordisplay(v bool) {
 	return
 }
 
+// SetFordisplayIfUnset sets fordisplay to v, unless it is already set
+func (p *UpdateLoadBalancerRuleParams) SetFordisplayIfUnset(v bool) {
+	if _, found := p.p["fordisplay"]; !found {
+		p.SetFordisplay(v)
+	}
+}
+
 func (p *UpdateLoadBalancerRuleParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -4983,6 +9080,13 @@ func (p *UpdateLoadBalancerRuleParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *UpdateLoadBalancerRuleParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 func (p *UpdateLoadBalancerRuleParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -4991,18 +9095,54 @@ func (p *UpdateLoadBalancerRuleParams) SetName(v string) {
 	return
 }
 
+// SetNameIfUnset sets name to v, unless it is already set
+func (p *UpdateLoadBalancerRuleParams) SetNameIfUnset(v string) {
+	if _, found := p.p["name"]; !found {
+		p.SetName(v)
+	}
+}
+
 // You should always use this function to get a new UpdateLoadBalancerRuleParams instance,
-// as then you are sure you have configured all required params
-func (s *LoadBalancerService) NewUpdateLoadBalancerRuleParams(id string) *UpdateLoadBalancerRuleParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *LoadBalancerService) NewUpdateLoadBalancerRuleParams(id string, opts ...func(*UpdateLoadBalancerRuleParams)) *UpdateLoadBalancerRuleParams {
 	p := &UpdateLoadBalancerRuleParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Updates load balancer
-func (s *LoadBalancerService) UpdateLoadBalancerRule(p *UpdateLoadBalancerRuleParams) (*UpdateLoadBalancerRuleResponse, error) {
-	resp, err := s.cs.newRequest("updateLoadBalancerRule", p.toURLValues())
+func (s *LoadBalancerService) UpdateLoadBalancerRule(p *UpdateLoadBalancerRuleParams, opts ...OptionFunc) (*UpdateLoadBalancerRuleResponse, error) {
+	return s.UpdateLoadBalancerRuleWithContext(context.Background(), p, opts...)
+}
+
+// UpdateLoadBalancerRuleWithContext is the same as UpdateLoadBalancerRule, but the request is cancelled when the context is done
+func (s *LoadBalancerService) UpdateLoadBalancerRuleWithContext(ctx context.Context, p *UpdateLoadBalancerRuleParams, opts ...OptionFunc) (*UpdateLoadBalancerRuleResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.UpdateLoadBalancerRuleRawWithContext(ctx, p.toURLValues())
+}
+
+// UpdateLoadBalancerRuleRaw is the same as UpdateLoadBalancerRule, but takes the params as url.Values instead of typed params
+func (s *LoadBalancerService) UpdateLoadBalancerRuleRaw(v url.Values) (*UpdateLoadBalancerRuleResponse, error) {
+	return s.UpdateLoadBalancerRuleRawWithContext(context.Background(), v)
+}
+
+// UpdateLoadBalancerRuleRawWithContext is the same as UpdateLoadBalancerRuleRaw, but the request is cancelled when the context is done
+func (s *LoadBalancerService) UpdateLoadBalancerRuleRawWithContext(ctx context.Context, v url.Values) (*UpdateLoadBalancerRuleResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "updateLoadBalancerRule", "updateloadbalancerruleresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -5014,9 +9154,9 @@ func (s *LoadBalancerService) UpdateLoadBalancerRule(p *UpdateLoadBalancerRulePa
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -5074,6 +9214,16 @@ type UploadSslCertParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *UploadSslCertParams) Clone() *UploadSslCertParams {
+	c := &UploadSslCertParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *UploadSslCertParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -5103,6 +9253,16 @@ func (p *UploadSslCertParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *UploadSslCertParams) validate() error {
+	for _, k := range []string{"certificate", "privatekey"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of uploadSslCert", k)
+		}
+	}
+	return nil
+}
+
 func (p *UploadSslCertParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -5111,6 +9271,13 @@ func (p *UploadSslCertParams) SetAccount(v string) {
 	return
 }
 
+// SetAccountIfUnset sets account to v, unless it is already set
+func (p *UploadSslCertParams) SetAccountIfUnset(v string) {
+	if _, found := p.p["account"]; !found {
+		p.SetAccount(v)
+	}
+}
+
 func (p *UploadSslCertParams) SetCertchain(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -5119,6 +9286,13 @@ func (p *UploadSslCertParams) SetCertchain(v string) {
 	return
 }
 
+// SetCertchainIfUnset sets certchain to v, unless it is already set
+func (p *UploadSslCertParams) SetCertchainIfUnset(v string) {
+	if _, found := p.p["certchain"]; !found {
+		p.SetCertchain(v)
+	}
+}
+
 func (p *UploadSslCertParams) SetCertificate(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -5127,6 +9301,13 @@ func (p *UploadSslCertParams) SetCertificate(v string) {
 	return
 }
 
+// SetCertificateIfUnset sets certificate to v, unless it is already set
+func (p *UploadSslCertParams) SetCertificateIfUnset(v string) {
+	if _, found := p.p["certificate"]; !found {
+		p.SetCertificate(v)
+	}
+}
+
 func (p *UploadSslCertParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -5135,6 +9316,13 @@ func (p *UploadSslCertParams) SetDomainid(v string) {
 	return
 }
 
+// SetDomainidIfUnset sets domainid to v, unless it is already set
+func (p *UploadSslCertParams) SetDomainidIfUnset(v string) {
+	if _, found := p.p["domainid"]; !found {
+		p.SetDomainid(v)
+	}
+}
+
 func (p *UploadSslCertParams) SetPassword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -5143,6 +9331,13 @@ func (p *UploadSslCertParams) SetPassword(v string) {
 	return
 }
 
+// SetPasswordIfUnset sets password to v, unless it is already set
+func (p *UploadSslCertParams) SetPasswordIfUnset(v string) {
+	if _, found := p.p["password"]; !found {
+		p.SetPassword(v)
+	}
+}
+
 func (p *UploadSslCertParams) SetPrivatekey(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -5151,6 +9346,13 @@ func (p *UploadSslCertParams) SetPrivatekey(v string) {
 	return
 }
 
+// SetPrivatekeyIfUnset sets privatekey to v, unless it is already set
+func (p *UploadSslCertParams) SetPrivatekeyIfUnset(v string) {
+	if _, found := p.p["privatekey"]; !found {
+		p.SetPrivatekey(v)
+	}
+}
+
 func (p *UploadSslCertParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -5159,19 +9361,55 @@ func (p *UploadSslCertParams) SetProjectid(v string) {
 	return
 }
 
+// SetProjectidIfUnset sets projectid to v, unless it is already set
+func (p *UploadSslCertParams) SetProjectidIfUnset(v string) {
+	if _, found := p.p["projectid"]; !found {
+		p.SetProjectid(v)
+	}
+}
+
 // You should always use this function to get a new UploadSslCertParams instance,
-// as then you are sure you have configured all required params
-func (s *LoadBalancerService) NewUploadSslCertParams(certificate string, privatekey string) *UploadSslCertParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *LoadBalancerService) NewUploadSslCertParams(certificate string, privatekey string, opts ...func(*UploadSslCertParams)) *UploadSslCertParams {
 	p := &UploadSslCertParams{}
 	p.p = make(map[string]interface{})
 	p.p["certificate"] = certificate
 	p.p["privatekey"] = privatekey
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Upload a certificate to CloudStack
-func (s *LoadBalancerService) UploadSslCert(p *UploadSslCertParams) (*UploadSslCertResponse, error) {
-	resp, err := s.cs.newRequest("uploadSslCert", p.toURLValues())
+func (s *LoadBalancerService) UploadSslCert(p *UploadSslCertParams, opts ...OptionFunc) (*UploadSslCertResponse, error) {
+	return s.UploadSslCertWithContext(context.Background(), p, opts...)
+}
+
+// UploadSslCertWithContext is the same as UploadSslCert, but the request is cancelled when the context is done
+func (s *LoadBalancerService) UploadSslCertWithContext(ctx context.Context, p *UploadSslCertParams, opts ...OptionFunc) (*UploadSslCertResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.UploadSslCertRawWithContext(ctx, p.toURLValues())
+}
+
+// UploadSslCertRaw is the same as UploadSslCert, but takes the params as url.Values instead of typed params
+func (s *LoadBalancerService) UploadSslCertRaw(v url.Values) (*UploadSslCertResponse, error) {
+	return s.UploadSslCertRawWithContext(context.Background(), v)
+}
+
+// UploadSslCertRawWithContext is the same as UploadSslCertRaw, but the request is cancelled when the context is done
+func (s *LoadBalancerService) UploadSslCertRawWithContext(ctx context.Context, v url.Values) (*UploadSslCertResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "uploadSslCert", "uploadsslcertresponse", v)
 	if err != nil {
 		return nil, err
 	}
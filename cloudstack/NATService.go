@@ -17,17 +17,30 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/url"
 	"strconv"
 	"strings"
+	"time"
 )
 
 type CreateIpForwardingRuleParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *CreateIpForwardingRuleParams) Clone() *CreateIpForwardingRuleParams {
+	c := &CreateIpForwardingRuleParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *CreateIpForwardingRuleParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -58,6 +71,16 @@ func (p *CreateIpForwardingRuleParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *CreateIpForwardingRuleParams) validate() error {
+	for _, k := range []string{"ipaddressid", "protocol", "startport"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of createIpForwardingRule", k)
+		}
+	}
+	return nil
+}
+
 func (p *CreateIpForwardingRuleParams) SetCidrlist(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -66,6 +89,18 @@ func (p *CreateIpForwardingRuleParams) SetCidrlist(v []string) {
 	return
 }
 
+// SetCidrlistIfUnset sets cidrlist to v, unless it is already set
+func (p *CreateIpForwardingRuleParams) SetCidrlistIfUnset(v []string) {
+	if _, found := p.p["cidrlist"]; !found {
+		p.SetCidrlist(v)
+	}
+}
+
+// SetCidrlistCSV splits v on commas and sets the resulting values
+func (p *CreateIpForwardingRuleParams) SetCidrlistCSV(v string) {
+	p.SetCidrlist(splitCSV(v))
+}
+
 func (p *CreateIpForwardingRuleParams) SetEndport(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -74,6 +109,13 @@ func (p *CreateIpForwardingRuleParams) SetEndport(v int) {
 	return
 }
 
+// SetEndportIfUnset sets endport to v, unless it is already set
+func (p *CreateIpForwardingRuleParams) SetEndportIfUnset(v int) {
+	if _, found := p.p["endport"]; !found {
+		p.SetEndport(v)
+	}
+}
+
 func (p *CreateIpForwardingRuleParams) SetIpaddressid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -82,6 +124,13 @@ func (p *CreateIpForwardingRuleParams) SetIpaddressid(v string) {
 	return
 }
 
+// SetIpaddressidIfUnset sets ipaddressid to v, unless it is already set
+func (p *CreateIpForwardingRuleParams) SetIpaddressidIfUnset(v string) {
+	if _, found := p.p["ipaddressid"]; !found {
+		p.SetIpaddressid(v)
+	}
+}
+
 func (p *CreateIpForwardingRuleParams) SetOpenfirewall(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -90,14 +139,28 @@ func (p *CreateIpForwardingRuleParams) SetOpenfirewall(v bool) {
 	return
 }
 
-func (p *CreateIpForwardingRuleParams) SetProtocol(v string) {
+// SetOpenfirewallIfUnset sets openfirewall to v, unless it is already set
+func (p *CreateIpForwardingRuleParams) SetOpenfirewallIfUnset(v bool) {
+	if _, found := p.p["openfirewall"]; !found {
+		p.SetOpenfirewall(v)
+	}
+}
+
+func (p *CreateIpForwardingRuleParams) SetProtocol(v Protocol) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	p.p["protocol"] = v
+	p.p["protocol"] = string(v)
 	return
 }
 
+// SetProtocolIfUnset sets protocol to v, unless it is already set
+func (p *CreateIpForwardingRuleParams) SetProtocolIfUnset(v Protocol) {
+	if _, found := p.p["protocol"]; !found {
+		p.SetProtocol(v)
+	}
+}
+
 func (p *CreateIpForwardingRuleParams) SetStartport(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -106,20 +169,83 @@ func (p *CreateIpForwardingRuleParams) SetStartport(v int) {
 	return
 }
 
+// SetStartportIfUnset sets startport to v, unless it is already set
+func (p *CreateIpForwardingRuleParams) SetStartportIfUnset(v int) {
+	if _, found := p.p["startport"]; !found {
+		p.SetStartport(v)
+	}
+}
+
 // You should always use this function to get a new CreateIpForwardingRuleParams instance,
-// as then you are sure you have configured all required params
-func (s *NATService) NewCreateIpForwardingRuleParams(ipaddressid string, protocol string, startport int) *CreateIpForwardingRuleParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *NATService) NewCreateIpForwardingRuleParams(ipaddressid string, protocol string, startport int, opts ...func(*CreateIpForwardingRuleParams)) *CreateIpForwardingRuleParams {
 	p := &CreateIpForwardingRuleParams{}
 	p.p = make(map[string]interface{})
 	p.p["ipaddressid"] = ipaddressid
 	p.p["protocol"] = protocol
 	p.p["startport"] = startport
+	for _, fn := range opts {
+		fn(p)
+	}
+	return p
+}
+
+// CreateIpForwardingRuleOpts contains all params that can be set on a CreateIpForwardingRuleParams.
+// Optional params are pointers (or slices and maps) which are only set when not nil.
+type CreateIpForwardingRuleOpts struct {
+	Cidrlist     []string
+	Endport      *int
+	Ipaddressid  string
+	Openfirewall *bool
+	Protocol     Protocol
+	Startport    int
+}
+
+// NewCreateIpForwardingRuleParamsFromOpts is an alternative for NewCreateIpForwardingRuleParams which takes all params using
+// an options struct, as that is easier to read for APIs with many required params
+func (s *NATService) NewCreateIpForwardingRuleParamsFromOpts(o CreateIpForwardingRuleOpts) *CreateIpForwardingRuleParams {
+	p := s.NewCreateIpForwardingRuleParams(o.Ipaddressid, string(o.Protocol), o.Startport)
+	if o.Cidrlist != nil {
+		p.p["cidrlist"] = o.Cidrlist
+	}
+	if o.Endport != nil {
+		p.p["endport"] = *o.Endport
+	}
+	if o.Openfirewall != nil {
+		p.p["openfirewall"] = *o.Openfirewall
+	}
 	return p
 }
 
 // Creates an IP forwarding rule
-func (s *NATService) CreateIpForwardingRule(p *CreateIpForwardingRuleParams) (*CreateIpForwardingRuleResponse, error) {
-	resp, err := s.cs.newRequest("createIpForwardingRule", p.toURLValues())
+func (s *NATService) CreateIpForwardingRule(p *CreateIpForwardingRuleParams, opts ...OptionFunc) (*CreateIpForwardingRuleResponse, error) {
+	return s.CreateIpForwardingRuleWithContext(context.Background(), p, opts...)
+}
+
+// CreateIpForwardingRuleWithContext is the same as CreateIpForwardingRule, but the request is cancelled when the context is done
+func (s *NATService) CreateIpForwardingRuleWithContext(ctx context.Context, p *CreateIpForwardingRuleParams, opts ...OptionFunc) (*CreateIpForwardingRuleResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.CreateIpForwardingRuleRawWithContext(ctx, p.toURLValues())
+}
+
+// CreateIpForwardingRuleRaw is the same as CreateIpForwardingRule, but takes the params as url.Values instead of typed params
+func (s *NATService) CreateIpForwardingRuleRaw(v url.Values) (*CreateIpForwardingRuleResponse, error) {
+	return s.CreateIpForwardingRuleRawWithContext(context.Background(), v)
+}
+
+// CreateIpForwardingRuleRawWithContext is the same as CreateIpForwardingRuleRaw, but the request is cancelled when the context is done
+func (s *NATService) CreateIpForwardingRuleRawWithContext(ctx context.Context, v url.Values) (*CreateIpForwardingRuleResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "createIpForwardingRule", "createipforwardingruleresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -131,9 +257,9 @@ func (s *NATService) CreateIpForwardingRule(p *CreateIpForwardingRuleParams) (*C
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -188,6 +314,16 @@ type DeleteIpForwardingRuleParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *DeleteIpForwardingRuleParams) Clone() *DeleteIpForwardingRuleParams {
+	c := &DeleteIpForwardingRuleParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeleteIpForwardingRuleParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -199,6 +335,16 @@ func (p *DeleteIpForwardingRuleParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *DeleteIpForwardingRuleParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of deleteIpForwardingRule", k)
+		}
+	}
+	return nil
+}
+
 func (p *DeleteIpForwardingRuleParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -207,18 +353,54 @@ func (p *DeleteIpForwardingRuleParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *DeleteIpForwardingRuleParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 // You should always use this function to get a new DeleteIpForwardingRuleParams instance,
-// as then you are sure you have configured all required params
-func (s *NATService) NewDeleteIpForwardingRuleParams(id string) *DeleteIpForwardingRuleParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *NATService) NewDeleteIpForwardingRuleParams(id string, opts ...func(*DeleteIpForwardingRuleParams)) *DeleteIpForwardingRuleParams {
 	p := &DeleteIpForwardingRuleParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Deletes an IP forwarding rule
-func (s *NATService) DeleteIpForwardingRule(p *DeleteIpForwardingRuleParams) (*DeleteIpForwardingRuleResponse, error) {
-	resp, err := s.cs.newRequest("deleteIpForwardingRule", p.toURLValues())
+func (s *NATService) DeleteIpForwardingRule(p *DeleteIpForwardingRuleParams, opts ...OptionFunc) (*DeleteIpForwardingRuleResponse, error) {
+	return s.DeleteIpForwardingRuleWithContext(context.Background(), p, opts...)
+}
+
+// DeleteIpForwardingRuleWithContext is the same as DeleteIpForwardingRule, but the request is cancelled when the context is done
+func (s *NATService) DeleteIpForwardingRuleWithContext(ctx context.Context, p *DeleteIpForwardingRuleParams, opts ...OptionFunc) (*DeleteIpForwardingRuleResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.DeleteIpForwardingRuleRawWithContext(ctx, p.toURLValues())
+}
+
+// DeleteIpForwardingRuleRaw is the same as DeleteIpForwardingRule, but takes the params as url.Values instead of typed params
+func (s *NATService) DeleteIpForwardingRuleRaw(v url.Values) (*DeleteIpForwardingRuleResponse, error) {
+	return s.DeleteIpForwardingRuleRawWithContext(context.Background(), v)
+}
+
+// DeleteIpForwardingRuleRawWithContext is the same as DeleteIpForwardingRuleRaw, but the request is cancelled when the context is done
+func (s *NATService) DeleteIpForwardingRuleRawWithContext(ctx context.Context, v url.Values) (*DeleteIpForwardingRuleResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteIpForwardingRule", "deleteipforwardingruleresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -230,9 +412,9 @@ func (s *NATService) DeleteIpForwardingRule(p *DeleteIpForwardingRuleParams) (*D
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -246,6 +428,34 @@ func (s *NATService) DeleteIpForwardingRule(p *DeleteIpForwardingRuleParams) (*D
 	return &r, nil
 }
 
+// WaitForIpForwardingRuleDeleted polls until the IpForwardingRule with the given ID no longer exists, the timeout
+// expires or the context is cancelled. Deleted resources may still be listed for a short while
+// after the delete command finished, so this can be used to make sure they are really gone. The
+// options are passed to GetIpForwardingRuleByID, so a resource outside of the default scope can be found.
+func (s *NATService) WaitForIpForwardingRuleDeleted(ctx context.Context, id string, timeout time.Duration, opts ...OptionFunc) error {
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	for {
+		_, count, err := s.GetIpForwardingRuleByID(id, opts...)
+		if count == 0 {
+			return nil
+		}
+		if count < 0 {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(2 * time.Second):
+		}
+	}
+}
+
 type DeleteIpForwardingRuleResponse struct {
 	JobID       string `json:"jobid"`
 	Displaytext string `json:"displaytext"`
@@ -256,6 +466,16 @@ type DisableStaticNatParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *DisableStaticNatParams) Clone() *DisableStaticNatParams {
+	c := &DisableStaticNatParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DisableStaticNatParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -267,6 +487,16 @@ func (p *DisableStaticNatParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *DisableStaticNatParams) validate() error {
+	for _, k := range []string{"ipaddressid"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of disableStaticNat", k)
+		}
+	}
+	return nil
+}
+
 func (p *DisableStaticNatParams) SetIpaddressid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -275,18 +505,54 @@ func (p *DisableStaticNatParams) SetIpaddressid(v string) {
 	return
 }
 
+// SetIpaddressidIfUnset sets ipaddressid to v, unless it is already set
+func (p *DisableStaticNatParams) SetIpaddressidIfUnset(v string) {
+	if _, found := p.p["ipaddressid"]; !found {
+		p.SetIpaddressid(v)
+	}
+}
+
 // You should always use this function to get a new DisableStaticNatParams instance,
-// as then you are sure you have configured all required params
-func (s *NATService) NewDisableStaticNatParams(ipaddressid string) *DisableStaticNatParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *NATService) NewDisableStaticNatParams(ipaddressid string, opts ...func(*DisableStaticNatParams)) *DisableStaticNatParams {
 	p := &DisableStaticNatParams{}
 	p.p = make(map[string]interface{})
 	p.p["ipaddressid"] = ipaddressid
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Disables static rule for given IP address
-func (s *NATService) DisableStaticNat(p *DisableStaticNatParams) (*DisableStaticNatResponse, error) {
-	resp, err := s.cs.newRequest("disableStaticNat", p.toURLValues())
+func (s *NATService) DisableStaticNat(p *DisableStaticNatParams, opts ...OptionFunc) (*DisableStaticNatResponse, error) {
+	return s.DisableStaticNatWithContext(context.Background(), p, opts...)
+}
+
+// DisableStaticNatWithContext is the same as DisableStaticNat, but the request is cancelled when the context is done
+func (s *NATService) DisableStaticNatWithContext(ctx context.Context, p *DisableStaticNatParams, opts ...OptionFunc) (*DisableStaticNatResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.DisableStaticNatRawWithContext(ctx, p.toURLValues())
+}
+
+// DisableStaticNatRaw is the same as DisableStaticNat, but takes the params as url.Values instead of typed params
+func (s *NATService) DisableStaticNatRaw(v url.Values) (*DisableStaticNatResponse, error) {
+	return s.DisableStaticNatRawWithContext(context.Background(), v)
+}
+
+// DisableStaticNatRawWithContext is the same as DisableStaticNatRaw, but the request is cancelled when the context is done
+func (s *NATService) DisableStaticNatRawWithContext(ctx context.Context, v url.Values) (*DisableStaticNatResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "disableStaticNat", "disablestaticnatresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -298,9 +564,9 @@ func (s *NATService) DisableStaticNat(p *DisableStaticNatParams) (*DisableStatic
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -324,6 +590,16 @@ type EnableStaticNatParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *EnableStaticNatParams) Clone() *EnableStaticNatParams {
+	c := &EnableStaticNatParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *EnableStaticNatParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -344,6 +620,16 @@ func (p *EnableStaticNatParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *EnableStaticNatParams) validate() error {
+	for _, k := range []string{"ipaddressid", "virtualmachineid"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of enableStaticNat", k)
+		}
+	}
+	return nil
+}
+
 func (p *EnableStaticNatParams) SetIpaddressid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -352,6 +638,13 @@ func (p *EnableStaticNatParams) SetIpaddressid(v string) {
 	return
 }
 
+// SetIpaddressidIfUnset sets ipaddressid to v, unless it is already set
+func (p *EnableStaticNatParams) SetIpaddressidIfUnset(v string) {
+	if _, found := p.p["ipaddressid"]; !found {
+		p.SetIpaddressid(v)
+	}
+}
+
 func (p *EnableStaticNatParams) SetNetworkid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -360,6 +653,13 @@ func (p *EnableStaticNatParams) SetNetworkid(v string) {
 	return
 }
 
+// SetNetworkidIfUnset sets networkid to v, unless it is already set
+func (p *EnableStaticNatParams) SetNetworkidIfUnset(v string) {
+	if _, found := p.p["networkid"]; !found {
+		p.SetNetworkid(v)
+	}
+}
+
 func (p *EnableStaticNatParams) SetVirtualmachineid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -368,6 +668,13 @@ func (p *EnableStaticNatParams) SetVirtualmachineid(v string) {
 	return
 }
 
+// SetVirtualmachineidIfUnset sets virtualmachineid to v, unless it is already set
+func (p *EnableStaticNatParams) SetVirtualmachineidIfUnset(v string) {
+	if _, found := p.p["virtualmachineid"]; !found {
+		p.SetVirtualmachineid(v)
+	}
+}
+
 func (p *EnableStaticNatParams) SetVmguestip(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -376,19 +683,55 @@ func (p *EnableStaticNatParams) SetVmguestip(v string) {
 	return
 }
 
+// SetVmguestipIfUnset sets vmguestip to v, unless it is already set
+func (p *EnableStaticNatParams) SetVmguestipIfUnset(v string) {
+	if _, found := p.p["vmguestip"]; !found {
+		p.SetVmguestip(v)
+	}
+}
+
 // You should always use this function to get a new EnableStaticNatParams instance,
-// as then you are sure you have configured all required params
-func (s *NATService) NewEnableStaticNatParams(ipaddressid string, virtualmachineid string) *EnableStaticNatParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *NATService) NewEnableStaticNatParams(ipaddressid string, virtualmachineid string, opts ...func(*EnableStaticNatParams)) *EnableStaticNatParams {
 	p := &EnableStaticNatParams{}
 	p.p = make(map[string]interface{})
 	p.p["ipaddressid"] = ipaddressid
 	p.p["virtualmachineid"] = virtualmachineid
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Enables static NAT for given IP address
-func (s *NATService) EnableStaticNat(p *EnableStaticNatParams) (*EnableStaticNatResponse, error) {
-	resp, err := s.cs.newRequest("enableStaticNat", p.toURLValues())
+func (s *NATService) EnableStaticNat(p *EnableStaticNatParams, opts ...OptionFunc) (*EnableStaticNatResponse, error) {
+	return s.EnableStaticNatWithContext(context.Background(), p, opts...)
+}
+
+// EnableStaticNatWithContext is the same as EnableStaticNat, but the request is cancelled when the context is done
+func (s *NATService) EnableStaticNatWithContext(ctx context.Context, p *EnableStaticNatParams, opts ...OptionFunc) (*EnableStaticNatResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.EnableStaticNatRawWithContext(ctx, p.toURLValues())
+}
+
+// EnableStaticNatRaw is the same as EnableStaticNat, but takes the params as url.Values instead of typed params
+func (s *NATService) EnableStaticNatRaw(v url.Values) (*EnableStaticNatResponse, error) {
+	return s.EnableStaticNatRawWithContext(context.Background(), v)
+}
+
+// EnableStaticNatRawWithContext is the same as EnableStaticNatRaw, but the request is cancelled when the context is done
+func (s *NATService) EnableStaticNatRawWithContext(ctx context.Context, v url.Values) (*EnableStaticNatResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "enableStaticNat", "enablestaticnatresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -429,6 +772,16 @@ type ListIpForwardingRulesParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ListIpForwardingRulesParams) Clone() *ListIpForwardingRulesParams {
+	c := &ListIpForwardingRulesParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListIpForwardingRulesParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -482,6 +835,13 @@ func (p *ListIpForwardingRulesParams) SetAccount(v string) {
 	return
 }
 
+// SetAccountIfUnset sets account to v, unless it is already set
+func (p *ListIpForwardingRulesParams) SetAccountIfUnset(v string) {
+	if _, found := p.p["account"]; !found {
+		p.SetAccount(v)
+	}
+}
+
 func (p *ListIpForwardingRulesParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -490,6 +850,13 @@ func (p *ListIpForwardingRulesParams) SetDomainid(v string) {
 	return
 }
 
+// SetDomainidIfUnset sets domainid to v, unless it is already set
+func (p *ListIpForwardingRulesParams) SetDomainidIfUnset(v string) {
+	if _, found := p.p["domainid"]; !found {
+		p.SetDomainid(v)
+	}
+}
+
 func (p *ListIpForwardingRulesParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -498,6 +865,13 @@ func (p *ListIpForwardingRulesParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *ListIpForwardingRulesParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 func (p *ListIpForwardingRulesParams) SetIpaddressid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -506,6 +880,13 @@ func (p *ListIpForwardingRulesParams) SetIpaddressid(v string) {
 	return
 }
 
+// SetIpaddressidIfUnset sets ipaddressid to v, unless it is already set
+func (p *ListIpForwardingRulesParams) SetIpaddressidIfUnset(v string) {
+	if _, found := p.p["ipaddressid"]; !found {
+		p.SetIpaddressid(v)
+	}
+}
+
 func (p *ListIpForwardingRulesParams) SetIsrecursive(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -514,6 +895,13 @@ func (p *ListIpForwardingRulesParams) SetIsrecursive(v bool) {
 	return
 }
 
+// SetIsrecursiveIfUnset sets isrecursive to v, unless it is already set
+func (p *ListIpForwardingRulesParams) SetIsrecursiveIfUnset(v bool) {
+	if _, found := p.p["isrecursive"]; !found {
+		p.SetIsrecursive(v)
+	}
+}
+
 func (p *ListIpForwardingRulesParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -522,6 +910,13 @@ func (p *ListIpForwardingRulesParams) SetKeyword(v string) {
 	return
 }
 
+// SetKeywordIfUnset sets keyword to v, unless it is already set
+func (p *ListIpForwardingRulesParams) SetKeywordIfUnset(v string) {
+	if _, found := p.p["keyword"]; !found {
+		p.SetKeyword(v)
+	}
+}
+
 func (p *ListIpForwardingRulesParams) SetListall(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -530,6 +925,13 @@ func (p *ListIpForwardingRulesParams) SetListall(v bool) {
 	return
 }
 
+// SetListallIfUnset sets listall to v, unless it is already set
+func (p *ListIpForwardingRulesParams) SetListallIfUnset(v bool) {
+	if _, found := p.p["listall"]; !found {
+		p.SetListall(v)
+	}
+}
+
 func (p *ListIpForwardingRulesParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -538,6 +940,13 @@ func (p *ListIpForwardingRulesParams) SetPage(v int) {
 	return
 }
 
+// SetPageIfUnset sets page to v, unless it is already set
+func (p *ListIpForwardingRulesParams) SetPageIfUnset(v int) {
+	if _, found := p.p["page"]; !found {
+		p.SetPage(v)
+	}
+}
+
 func (p *ListIpForwardingRulesParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -546,6 +955,13 @@ func (p *ListIpForwardingRulesParams) SetPagesize(v int) {
 	return
 }
 
+// SetPagesizeIfUnset sets pagesize to v, unless it is already set
+func (p *ListIpForwardingRulesParams) SetPagesizeIfUnset(v int) {
+	if _, found := p.p["pagesize"]; !found {
+		p.SetPagesize(v)
+	}
+}
+
 func (p *ListIpForwardingRulesParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -554,6 +970,13 @@ func (p *ListIpForwardingRulesParams) SetProjectid(v string) {
 	return
 }
 
+// SetProjectidIfUnset sets projectid to v, unless it is already set
+func (p *ListIpForwardingRulesParams) SetProjectidIfUnset(v string) {
+	if _, found := p.p["projectid"]; !found {
+		p.SetProjectid(v)
+	}
+}
+
 func (p *ListIpForwardingRulesParams) SetVirtualmachineid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -562,14 +985,34 @@ func (p *ListIpForwardingRulesParams) SetVirtualmachineid(v string) {
 	return
 }
 
+// SetVirtualmachineidIfUnset sets virtualmachineid to v, unless it is already set
+func (p *ListIpForwardingRulesParams) SetVirtualmachineidIfUnset(v string) {
+	if _, found := p.p["virtualmachineid"]; !found {
+		p.SetVirtualmachineid(v)
+	}
+}
+
+var _ ListAllSetter = (*ListIpForwardingRulesParams)(nil)
+
 // You should always use this function to get a new ListIpForwardingRulesParams instance,
-// as then you are sure you have configured all required params
-func (s *NATService) NewListIpForwardingRulesParams() *ListIpForwardingRulesParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *NATService) NewListIpForwardingRulesParams(opts ...func(*ListIpForwardingRulesParams)) *ListIpForwardingRulesParams {
 	p := &ListIpForwardingRulesParams{}
 	p.p = make(map[string]interface{})
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
+func (p *ListIpForwardingRulesParams) setFirstMatch() {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p[firstMatchKey] = true
+}
+
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *NATService) GetIpForwardingRuleByID(id string, opts ...OptionFunc) (*IpForwardingRule, int, error) {
 	p := &ListIpForwardingRulesParams{}
@@ -577,7 +1020,7 @@ func (s *NATService) GetIpForwardingRuleByID(id string, opts ...OptionFunc) (*Ip
 
 	p.p["id"] = id
 
-	for _, fn := range append(s.cs.options, opts...) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
 		if err := fn(s.cs, p); err != nil {
 			return nil, -1, err
 		}
@@ -588,24 +1031,56 @@ func (s *NATService) GetIpForwardingRuleByID(id string, opts ...OptionFunc) (*Ip
 		if strings.Contains(err.Error(), fmt.Sprintf(
 			"Invalid parameter id value=%s due to incorrect long value format, "+
 				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+			return nil, 0, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
 	}
 
 	if l.Count == 1 {
 		return l.IpForwardingRules[0], l.Count, nil
 	}
+
+	if _, ok := p.p[firstMatchKey]; ok && len(l.IpForwardingRules) > 0 {
+		return l.IpForwardingRules[0], l.Count, nil
+	}
 	return nil, l.Count, fmt.Errorf("There is more then one result for IpForwardingRule UUID: %s!", id)
 }
 
+// IpForwardingRuleExists returns true if a IpForwardingRule with the given ID exists. When no match is found
+// it returns false, while any other error is returned as is.
+func (s *NATService) IpForwardingRuleExists(id string, opts ...OptionFunc) (bool, error) {
+	_, count, err := s.GetIpForwardingRuleByID(id, opts...)
+	if errors.Is(err, ErrNotFound) {
+		return false, nil
+	}
+	if count > 0 {
+		return true, nil
+	}
+	return false, err
+}
+
 // List the IP forwarding rules
 func (s *NATService) ListIpForwardingRules(p *ListIpForwardingRulesParams) (*ListIpForwardingRulesResponse, error) {
-	resp, err := s.cs.newRequest("listIpForwardingRules", p.toURLValues())
+	return s.ListIpForwardingRulesWithContext(context.Background(), p)
+}
+
+// ListIpForwardingRulesWithContext is the same as ListIpForwardingRules, but the request is cancelled when the context is done
+func (s *NATService) ListIpForwardingRulesWithContext(ctx context.Context, p *ListIpForwardingRulesParams) (*ListIpForwardingRulesResponse, error) {
+	return s.ListIpForwardingRulesRawWithContext(ctx, p.toURLValues())
+}
+
+// ListIpForwardingRulesRaw is the same as ListIpForwardingRules, but takes the params as url.Values instead of typed params
+func (s *NATService) ListIpForwardingRulesRaw(v url.Values) (*ListIpForwardingRulesResponse, error) {
+	return s.ListIpForwardingRulesRawWithContext(context.Background(), v)
+}
+
+// ListIpForwardingRulesRawWithContext is the same as ListIpForwardingRulesRaw, but the request is cancelled when the context is done
+func (s *NATService) ListIpForwardingRulesRawWithContext(ctx context.Context, v url.Values) (*ListIpForwardingRulesResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "listIpForwardingRules", "listipforwardingrulesresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -618,6 +1093,93 @@ func (s *NATService) ListIpForwardingRules(p *ListIpForwardingRulesParams) (*Lis
 	return &r, nil
 }
 
+// StreamIpForwardingRules fetches the results of ListIpForwardingRules page by page in the background and sends them on the
+// returned channel. Both returned channels are closed when done. If fetching a page fails, or the context
+// is done before all results are sent, the error is sent on the error channel and fetching stops. A
+// request for a page in flight is cancelled when the context is done. The page and pagesize set in the
+// params are ignored.
+func (s *NATService) StreamIpForwardingRules(ctx context.Context, p *ListIpForwardingRulesParams) (<-chan *IpForwardingRule, <-chan error) {
+	ch := make(chan *IpForwardingRule)
+	errs := make(chan error, 1)
+
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesize(500)
+
+	go func() {
+		defer close(errs)
+		defer close(ch)
+
+		for page, count := 1, 0; ; page++ {
+			pp.SetPage(page)
+
+			l, err := s.ListIpForwardingRulesWithContext(ctx, pp)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			for _, v := range l.IpForwardingRules {
+				select {
+				case ch <- v:
+				case <-ctx.Done():
+					errs <- ctx.Err()
+					return
+				}
+			}
+
+			count += len(l.IpForwardingRules)
+			if len(l.IpForwardingRules) < 500 || count >= l.Count {
+				return
+			}
+		}
+	}()
+
+	return ch, errs
+}
+
+// ListIpForwardingRulesWhere returns all results of ListIpForwardingRules for which pred returns true. Note that the results are
+// filtered client-side, so all results matching the params are fetched first.
+// All pages are fetched, so the page and pagesize set in the params are ignored.
+func (s *NATService) ListIpForwardingRulesWhere(p *ListIpForwardingRulesParams, pred func(*IpForwardingRule) bool) ([]*IpForwardingRule, error) {
+	var r []*IpForwardingRule
+	ch, errs := s.StreamIpForwardingRules(context.Background(), p)
+	for v := range ch {
+		if pred(v) {
+			r = append(r, v)
+		}
+	}
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ListAllIpForwardingRules returns the results of ListIpForwardingRules across all pages, by calling ListIpForwardingRules page by page until all
+// results are fetched. The pagesize set in the params is used for every page (500 if not set), and the
+// page set in the params is ignored.
+func (s *NATService) ListAllIpForwardingRules(p *ListIpForwardingRulesParams) ([]*IpForwardingRule, error) {
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesizeIfUnset(500)
+	pagesize := pp.p["pagesize"].(int)
+
+	var r []*IpForwardingRule
+	for page := 1; ; page++ {
+		pp.SetPage(page)
+
+		l, err := s.ListIpForwardingRules(pp)
+		if err != nil {
+			return nil, err
+		}
+		r = append(r, l.IpForwardingRules...)
+
+		if len(l.IpForwardingRules) < pagesize || len(r) >= l.Count {
+			return r, nil
+		}
+	}
+}
+
 type ListIpForwardingRulesResponse struct {
 	Count             int                 `json:"count"`
 	IpForwardingRules []*IpForwardingRule `json:"ipforwardingrule"`
@@ -17,17 +17,30 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/url"
 	"strconv"
 	"strings"
+	"time"
 )
 
 type CreateNetworkACLParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *CreateNetworkACLParams) Clone() *CreateNetworkACLParams {
+	c := &CreateNetworkACLParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *CreateNetworkACLParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -79,6 +92,16 @@ func (p *CreateNetworkACLParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *CreateNetworkACLParams) validate() error {
+	for _, k := range []string{"protocol"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of createNetworkACL", k)
+		}
+	}
+	return nil
+}
+
 func (p *CreateNetworkACLParams) SetAclid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -87,6 +110,13 @@ func (p *CreateNetworkACLParams) SetAclid(v string) {
 	return
 }
 
+// SetAclidIfUnset sets aclid to v, unless it is already set
+func (p *CreateNetworkACLParams) SetAclidIfUnset(v string) {
+	if _, found := p.p["aclid"]; !found {
+		p.SetAclid(v)
+	}
+}
+
 func (p *CreateNetworkACLParams) SetAction(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -95,6 +125,13 @@ func (p *CreateNetworkACLParams) SetAction(v string) {
 	return
 }
 
+// SetActionIfUnset sets action to v, unless it is already set
+func (p *CreateNetworkACLParams) SetActionIfUnset(v string) {
+	if _, found := p.p["action"]; !found {
+		p.SetAction(v)
+	}
+}
+
 func (p *CreateNetworkACLParams) SetCidrlist(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -103,6 +140,18 @@ func (p *CreateNetworkACLParams) SetCidrlist(v []string) {
 	return
 }
 
+// SetCidrlistIfUnset sets cidrlist to v, unless it is already set
+func (p *CreateNetworkACLParams) SetCidrlistIfUnset(v []string) {
+	if _, found := p.p["cidrlist"]; !found {
+		p.SetCidrlist(v)
+	}
+}
+
+// SetCidrlistCSV splits v on commas and sets the resulting values
+func (p *CreateNetworkACLParams) SetCidrlistCSV(v string) {
+	p.SetCidrlist(splitCSV(v))
+}
+
 func (p *CreateNetworkACLParams) SetEndport(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -111,6 +160,13 @@ func (p *CreateNetworkACLParams) SetEndport(v int) {
 	return
 }
 
+// SetEndportIfUnset sets endport to v, unless it is already set
+func (p *CreateNetworkACLParams) SetEndportIfUnset(v int) {
+	if _, found := p.p["endport"]; !found {
+		p.SetEndport(v)
+	}
+}
+
 func (p *CreateNetworkACLParams) SetFordisplay(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -119,6 +175,13 @@ func (p *CreateNetworkACLParams) SetFordisplay(v bool) {
 	return
 }
 
+// SetFordisplayIfUnset sets fordisplay to v, unless it is already set
+func (p *CreateNetworkACLParams) SetFordisplayIfUnset(v bool) {
+	if _, found := p.p["fordisplay"]; !found {
+		p.SetFordisplay(v)
+	}
+}
+
 func (p *CreateNetworkACLParams) SetIcmpcode(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -127,6 +190,13 @@ func (p *CreateNetworkACLParams) SetIcmpcode(v int) {
 	return
 }
 
+// SetIcmpcodeIfUnset sets icmpcode to v, unless it is already set
+func (p *CreateNetworkACLParams) SetIcmpcodeIfUnset(v int) {
+	if _, found := p.p["icmpcode"]; !found {
+		p.SetIcmpcode(v)
+	}
+}
+
 func (p *CreateNetworkACLParams) SetIcmptype(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -135,6 +205,13 @@ func (p *CreateNetworkACLParams) SetIcmptype(v int) {
 	return
 }
 
+// SetIcmptypeIfUnset sets icmptype to v, unless it is already set
+func (p *CreateNetworkACLParams) SetIcmptypeIfUnset(v int) {
+	if _, found := p.p["icmptype"]; !found {
+		p.SetIcmptype(v)
+	}
+}
+
 func (p *CreateNetworkACLParams) SetNetworkid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -143,6 +220,13 @@ func (p *CreateNetworkACLParams) SetNetworkid(v string) {
 	return
 }
 
+// SetNetworkidIfUnset sets networkid to v, unless it is already set
+func (p *CreateNetworkACLParams) SetNetworkidIfUnset(v string) {
+	if _, found := p.p["networkid"]; !found {
+		p.SetNetworkid(v)
+	}
+}
+
 func (p *CreateNetworkACLParams) SetNumber(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -151,14 +235,28 @@ func (p *CreateNetworkACLParams) SetNumber(v int) {
 	return
 }
 
-func (p *CreateNetworkACLParams) SetProtocol(v string) {
+// SetNumberIfUnset sets number to v, unless it is already set
+func (p *CreateNetworkACLParams) SetNumberIfUnset(v int) {
+	if _, found := p.p["number"]; !found {
+		p.SetNumber(v)
+	}
+}
+
+func (p *CreateNetworkACLParams) SetProtocol(v Protocol) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	p.p["protocol"] = v
+	p.p["protocol"] = string(v)
 	return
 }
 
+// SetProtocolIfUnset sets protocol to v, unless it is already set
+func (p *CreateNetworkACLParams) SetProtocolIfUnset(v Protocol) {
+	if _, found := p.p["protocol"]; !found {
+		p.SetProtocol(v)
+	}
+}
+
 func (p *CreateNetworkACLParams) SetStartport(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -167,6 +265,13 @@ func (p *CreateNetworkACLParams) SetStartport(v int) {
 	return
 }
 
+// SetStartportIfUnset sets startport to v, unless it is already set
+func (p *CreateNetworkACLParams) SetStartportIfUnset(v int) {
+	if _, found := p.p["startport"]; !found {
+		p.SetStartport(v)
+	}
+}
+
 func (p *CreateNetworkACLParams) SetTraffictype(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -175,18 +280,54 @@ func (p *CreateNetworkACLParams) SetTraffictype(v string) {
 	return
 }
 
+// SetTraffictypeIfUnset sets traffictype to v, unless it is already set
+func (p *CreateNetworkACLParams) SetTraffictypeIfUnset(v string) {
+	if _, found := p.p["traffictype"]; !found {
+		p.SetTraffictype(v)
+	}
+}
+
 // You should always use this function to get a new CreateNetworkACLParams instance,
-// as then you are sure you have configured all required params
-func (s *NetworkACLService) NewCreateNetworkACLParams(protocol string) *CreateNetworkACLParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *NetworkACLService) NewCreateNetworkACLParams(protocol string, opts ...func(*CreateNetworkACLParams)) *CreateNetworkACLParams {
 	p := &CreateNetworkACLParams{}
 	p.p = make(map[string]interface{})
 	p.p["protocol"] = protocol
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Creates a ACL rule in the given network (the network has to belong to VPC)
-func (s *NetworkACLService) CreateNetworkACL(p *CreateNetworkACLParams) (*CreateNetworkACLResponse, error) {
-	resp, err := s.cs.newRequest("createNetworkACL", p.toURLValues())
+func (s *NetworkACLService) CreateNetworkACL(p *CreateNetworkACLParams, opts ...OptionFunc) (*CreateNetworkACLResponse, error) {
+	return s.CreateNetworkACLWithContext(context.Background(), p, opts...)
+}
+
+// CreateNetworkACLWithContext is the same as CreateNetworkACL, but the request is cancelled when the context is done
+func (s *NetworkACLService) CreateNetworkACLWithContext(ctx context.Context, p *CreateNetworkACLParams, opts ...OptionFunc) (*CreateNetworkACLResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.CreateNetworkACLRawWithContext(ctx, p.toURLValues())
+}
+
+// CreateNetworkACLRaw is the same as CreateNetworkACL, but takes the params as url.Values instead of typed params
+func (s *NetworkACLService) CreateNetworkACLRaw(v url.Values) (*CreateNetworkACLResponse, error) {
+	return s.CreateNetworkACLRawWithContext(context.Background(), v)
+}
+
+// CreateNetworkACLRawWithContext is the same as CreateNetworkACLRaw, but the request is cancelled when the context is done
+func (s *NetworkACLService) CreateNetworkACLRawWithContext(ctx context.Context, v url.Values) (*CreateNetworkACLResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "createNetworkACL", "createnetworkaclresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -198,9 +339,9 @@ func (s *NetworkACLService) CreateNetworkACL(p *CreateNetworkACLParams) (*Create
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -252,6 +393,16 @@ type CreateNetworkACLListParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *CreateNetworkACLListParams) Clone() *CreateNetworkACLListParams {
+	c := &CreateNetworkACLListParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *CreateNetworkACLListParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -273,6 +424,16 @@ func (p *CreateNetworkACLListParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *CreateNetworkACLListParams) validate() error {
+	for _, k := range []string{"name", "vpcid"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of createNetworkACLList", k)
+		}
+	}
+	return nil
+}
+
 func (p *CreateNetworkACLListParams) SetDescription(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -281,6 +442,13 @@ func (p *CreateNetworkACLListParams) SetDescription(v string) {
 	return
 }
 
+// SetDescriptionIfUnset sets description to v, unless it is already set
+func (p *CreateNetworkACLListParams) SetDescriptionIfUnset(v string) {
+	if _, found := p.p["description"]; !found {
+		p.SetDescription(v)
+	}
+}
+
 func (p *CreateNetworkACLListParams) SetFordisplay(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -289,6 +457,13 @@ func (p *CreateNetworkACLListParams) SetFordisplay(v bool) {
 	return
 }
 
+// SetFordisplayIfUnset sets fordisplay to v, unless it is already set
+func (p *CreateNetworkACLListParams) SetFordisplayIfUnset(v bool) {
+	if _, found := p.p["fordisplay"]; !found {
+		p.SetFordisplay(v)
+	}
+}
+
 func (p *CreateNetworkACLListParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -297,6 +472,13 @@ func (p *CreateNetworkACLListParams) SetName(v string) {
 	return
 }
 
+// SetNameIfUnset sets name to v, unless it is already set
+func (p *CreateNetworkACLListParams) SetNameIfUnset(v string) {
+	if _, found := p.p["name"]; !found {
+		p.SetName(v)
+	}
+}
+
 func (p *CreateNetworkACLListParams) SetVpcid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -305,19 +487,55 @@ func (p *CreateNetworkACLListParams) SetVpcid(v string) {
 	return
 }
 
+// SetVpcidIfUnset sets vpcid to v, unless it is already set
+func (p *CreateNetworkACLListParams) SetVpcidIfUnset(v string) {
+	if _, found := p.p["vpcid"]; !found {
+		p.SetVpcid(v)
+	}
+}
+
 // You should always use this function to get a new CreateNetworkACLListParams instance,
-// as then you are sure you have configured all required params
-func (s *NetworkACLService) NewCreateNetworkACLListParams(name string, vpcid string) *CreateNetworkACLListParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *NetworkACLService) NewCreateNetworkACLListParams(name string, vpcid string, opts ...func(*CreateNetworkACLListParams)) *CreateNetworkACLListParams {
 	p := &CreateNetworkACLListParams{}
 	p.p = make(map[string]interface{})
 	p.p["name"] = name
 	p.p["vpcid"] = vpcid
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Creates a network ACL for the given VPC
-func (s *NetworkACLService) CreateNetworkACLList(p *CreateNetworkACLListParams) (*CreateNetworkACLListResponse, error) {
-	resp, err := s.cs.newRequest("createNetworkACLList", p.toURLValues())
+func (s *NetworkACLService) CreateNetworkACLList(p *CreateNetworkACLListParams, opts ...OptionFunc) (*CreateNetworkACLListResponse, error) {
+	return s.CreateNetworkACLListWithContext(context.Background(), p, opts...)
+}
+
+// CreateNetworkACLListWithContext is the same as CreateNetworkACLList, but the request is cancelled when the context is done
+func (s *NetworkACLService) CreateNetworkACLListWithContext(ctx context.Context, p *CreateNetworkACLListParams, opts ...OptionFunc) (*CreateNetworkACLListResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.CreateNetworkACLListRawWithContext(ctx, p.toURLValues())
+}
+
+// CreateNetworkACLListRaw is the same as CreateNetworkACLList, but takes the params as url.Values instead of typed params
+func (s *NetworkACLService) CreateNetworkACLListRaw(v url.Values) (*CreateNetworkACLListResponse, error) {
+	return s.CreateNetworkACLListRawWithContext(context.Background(), v)
+}
+
+// CreateNetworkACLListRawWithContext is the same as CreateNetworkACLListRaw, but the request is cancelled when the context is done
+func (s *NetworkACLService) CreateNetworkACLListRawWithContext(ctx context.Context, v url.Values) (*CreateNetworkACLListResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "createNetworkACLList", "createnetworkacllistresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -329,9 +547,9 @@ func (s *NetworkACLService) CreateNetworkACLList(p *CreateNetworkACLListParams)
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -363,6 +581,16 @@ type DeleteNetworkACLParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *DeleteNetworkACLParams) Clone() *DeleteNetworkACLParams {
+	c := &DeleteNetworkACLParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeleteNetworkACLParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -374,6 +602,16 @@ func (p *DeleteNetworkACLParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *DeleteNetworkACLParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of deleteNetworkACL", k)
+		}
+	}
+	return nil
+}
+
 func (p *DeleteNetworkACLParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -382,18 +620,54 @@ func (p *DeleteNetworkACLParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *DeleteNetworkACLParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 // You should always use this function to get a new DeleteNetworkACLParams instance,
-// as then you are sure you have configured all required params
-func (s *NetworkACLService) NewDeleteNetworkACLParams(id string) *DeleteNetworkACLParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *NetworkACLService) NewDeleteNetworkACLParams(id string, opts ...func(*DeleteNetworkACLParams)) *DeleteNetworkACLParams {
 	p := &DeleteNetworkACLParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Deletes a network ACL
-func (s *NetworkACLService) DeleteNetworkACL(p *DeleteNetworkACLParams) (*DeleteNetworkACLResponse, error) {
-	resp, err := s.cs.newRequest("deleteNetworkACL", p.toURLValues())
+func (s *NetworkACLService) DeleteNetworkACL(p *DeleteNetworkACLParams, opts ...OptionFunc) (*DeleteNetworkACLResponse, error) {
+	return s.DeleteNetworkACLWithContext(context.Background(), p, opts...)
+}
+
+// DeleteNetworkACLWithContext is the same as DeleteNetworkACL, but the request is cancelled when the context is done
+func (s *NetworkACLService) DeleteNetworkACLWithContext(ctx context.Context, p *DeleteNetworkACLParams, opts ...OptionFunc) (*DeleteNetworkACLResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.DeleteNetworkACLRawWithContext(ctx, p.toURLValues())
+}
+
+// DeleteNetworkACLRaw is the same as DeleteNetworkACL, but takes the params as url.Values instead of typed params
+func (s *NetworkACLService) DeleteNetworkACLRaw(v url.Values) (*DeleteNetworkACLResponse, error) {
+	return s.DeleteNetworkACLRawWithContext(context.Background(), v)
+}
+
+// DeleteNetworkACLRawWithContext is the same as DeleteNetworkACLRaw, but the request is cancelled when the context is done
+func (s *NetworkACLService) DeleteNetworkACLRawWithContext(ctx context.Context, v url.Values) (*DeleteNetworkACLResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteNetworkACL", "deletenetworkaclresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -405,9 +679,9 @@ func (s *NetworkACLService) DeleteNetworkACL(p *DeleteNetworkACLParams) (*Delete
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -421,6 +695,34 @@ func (s *NetworkACLService) DeleteNetworkACL(p *DeleteNetworkACLParams) (*Delete
 	return &r, nil
 }
 
+// WaitForNetworkACLDeleted polls until the NetworkACL with the given ID no longer exists, the timeout
+// expires or the context is cancelled. Deleted resources may still be listed for a short while
+// after the delete command finished, so this can be used to make sure they are really gone. The
+// options are passed to GetNetworkACLByID, so a resource outside of the default scope can be found.
+func (s *NetworkACLService) WaitForNetworkACLDeleted(ctx context.Context, id string, timeout time.Duration, opts ...OptionFunc) error {
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	for {
+		_, count, err := s.GetNetworkACLByID(id, opts...)
+		if count == 0 {
+			return nil
+		}
+		if count < 0 {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(2 * time.Second):
+		}
+	}
+}
+
 type DeleteNetworkACLResponse struct {
 	JobID       string `json:"jobid"`
 	Displaytext string `json:"displaytext"`
@@ -431,6 +733,16 @@ type DeleteNetworkACLListParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *DeleteNetworkACLListParams) Clone() *DeleteNetworkACLListParams {
+	c := &DeleteNetworkACLListParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeleteNetworkACLListParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -442,6 +754,16 @@ func (p *DeleteNetworkACLListParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *DeleteNetworkACLListParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of deleteNetworkACLList", k)
+		}
+	}
+	return nil
+}
+
 func (p *DeleteNetworkACLListParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -450,18 +772,54 @@ func (p *DeleteNetworkACLListParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *DeleteNetworkACLListParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 // You should always use this function to get a new DeleteNetworkACLListParams instance,
-// as then you are sure you have configured all required params
-func (s *NetworkACLService) NewDeleteNetworkACLListParams(id string) *DeleteNetworkACLListParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *NetworkACLService) NewDeleteNetworkACLListParams(id string, opts ...func(*DeleteNetworkACLListParams)) *DeleteNetworkACLListParams {
 	p := &DeleteNetworkACLListParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Deletes a network ACL
-func (s *NetworkACLService) DeleteNetworkACLList(p *DeleteNetworkACLListParams) (*DeleteNetworkACLListResponse, error) {
-	resp, err := s.cs.newRequest("deleteNetworkACLList", p.toURLValues())
+func (s *NetworkACLService) DeleteNetworkACLList(p *DeleteNetworkACLListParams, opts ...OptionFunc) (*DeleteNetworkACLListResponse, error) {
+	return s.DeleteNetworkACLListWithContext(context.Background(), p, opts...)
+}
+
+// DeleteNetworkACLListWithContext is the same as DeleteNetworkACLList, but the request is cancelled when the context is done
+func (s *NetworkACLService) DeleteNetworkACLListWithContext(ctx context.Context, p *DeleteNetworkACLListParams, opts ...OptionFunc) (*DeleteNetworkACLListResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.DeleteNetworkACLListRawWithContext(ctx, p.toURLValues())
+}
+
+// DeleteNetworkACLListRaw is the same as DeleteNetworkACLList, but takes the params as url.Values instead of typed params
+func (s *NetworkACLService) DeleteNetworkACLListRaw(v url.Values) (*DeleteNetworkACLListResponse, error) {
+	return s.DeleteNetworkACLListRawWithContext(context.Background(), v)
+}
+
+// DeleteNetworkACLListRawWithContext is the same as DeleteNetworkACLListRaw, but the request is cancelled when the context is done
+func (s *NetworkACLService) DeleteNetworkACLListRawWithContext(ctx context.Context, v url.Values) (*DeleteNetworkACLListResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteNetworkACLList", "deletenetworkacllistresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -473,9 +831,9 @@ func (s *NetworkACLService) DeleteNetworkACLList(p *DeleteNetworkACLListParams)
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -489,6 +847,34 @@ func (s *NetworkACLService) DeleteNetworkACLList(p *DeleteNetworkACLListParams)
 	return &r, nil
 }
 
+// WaitForNetworkACLListDeleted polls until the NetworkACLList with the given ID no longer exists, the timeout
+// expires or the context is cancelled. Deleted resources may still be listed for a short while
+// after the delete command finished, so this can be used to make sure they are really gone. The
+// options are passed to GetNetworkACLListByID, so a resource outside of the default scope can be found.
+func (s *NetworkACLService) WaitForNetworkACLListDeleted(ctx context.Context, id string, timeout time.Duration, opts ...OptionFunc) error {
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	for {
+		_, count, err := s.GetNetworkACLListByID(id, opts...)
+		if count == 0 {
+			return nil
+		}
+		if count < 0 {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(2 * time.Second):
+		}
+	}
+}
+
 type DeleteNetworkACLListResponse struct {
 	JobID       string `json:"jobid"`
 	Displaytext string `json:"displaytext"`
@@ -499,6 +885,16 @@ type ListNetworkACLListsParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ListNetworkACLListsParams) Clone() *ListNetworkACLListsParams {
+	c := &ListNetworkACLListsParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListNetworkACLListsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -559,6 +955,13 @@ func (p *ListNetworkACLListsParams) SetAccount(v string) {
 	return
 }
 
+// SetAccountIfUnset sets account to v, unless it is already set
+func (p *ListNetworkACLListsParams) SetAccountIfUnset(v string) {
+	if _, found := p.p["account"]; !found {
+		p.SetAccount(v)
+	}
+}
+
 func (p *ListNetworkACLListsParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -567,6 +970,13 @@ func (p *ListNetworkACLListsParams) SetDomainid(v string) {
 	return
 }
 
+// SetDomainidIfUnset sets domainid to v, unless it is already set
+func (p *ListNetworkACLListsParams) SetDomainidIfUnset(v string) {
+	if _, found := p.p["domainid"]; !found {
+		p.SetDomainid(v)
+	}
+}
+
 func (p *ListNetworkACLListsParams) SetFordisplay(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -575,6 +985,13 @@ func (p *ListNetworkACLListsParams) SetFordisplay(v bool) {
 	return
 }
 
+// SetFordisplayIfUnset sets fordisplay to v, unless it is already set
+func (p *ListNetworkACLListsParams) SetFordisplayIfUnset(v bool) {
+	if _, found := p.p["fordisplay"]; !found {
+		p.SetFordisplay(v)
+	}
+}
+
 func (p *ListNetworkACLListsParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -583,6 +1000,13 @@ func (p *ListNetworkACLListsParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *ListNetworkACLListsParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 func (p *ListNetworkACLListsParams) SetIsrecursive(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -591,6 +1015,13 @@ func (p *ListNetworkACLListsParams) SetIsrecursive(v bool) {
 	return
 }
 
+// SetIsrecursiveIfUnset sets isrecursive to v, unless it is already set
+func (p *ListNetworkACLListsParams) SetIsrecursiveIfUnset(v bool) {
+	if _, found := p.p["isrecursive"]; !found {
+		p.SetIsrecursive(v)
+	}
+}
+
 func (p *ListNetworkACLListsParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -599,6 +1030,13 @@ func (p *ListNetworkACLListsParams) SetKeyword(v string) {
 	return
 }
 
+// SetKeywordIfUnset sets keyword to v, unless it is already set
+func (p *ListNetworkACLListsParams) SetKeywordIfUnset(v string) {
+	if _, found := p.p["keyword"]; !found {
+		p.SetKeyword(v)
+	}
+}
+
 func (p *ListNetworkACLListsParams) SetListall(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -607,6 +1045,13 @@ func (p *ListNetworkACLListsParams) SetListall(v bool) {
 	return
 }
 
+// SetListallIfUnset sets listall to v, unless it is already set
+func (p *ListNetworkACLListsParams) SetListallIfUnset(v bool) {
+	if _, found := p.p["listall"]; !found {
+		p.SetListall(v)
+	}
+}
+
 func (p *ListNetworkACLListsParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -615,6 +1060,13 @@ func (p *ListNetworkACLListsParams) SetName(v string) {
 	return
 }
 
+// SetNameIfUnset sets name to v, unless it is already set
+func (p *ListNetworkACLListsParams) SetNameIfUnset(v string) {
+	if _, found := p.p["name"]; !found {
+		p.SetName(v)
+	}
+}
+
 func (p *ListNetworkACLListsParams) SetNetworkid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -623,6 +1075,13 @@ func (p *ListNetworkACLListsParams) SetNetworkid(v string) {
 	return
 }
 
+// SetNetworkidIfUnset sets networkid to v, unless it is already set
+func (p *ListNetworkACLListsParams) SetNetworkidIfUnset(v string) {
+	if _, found := p.p["networkid"]; !found {
+		p.SetNetworkid(v)
+	}
+}
+
 func (p *ListNetworkACLListsParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -631,6 +1090,13 @@ func (p *ListNetworkACLListsParams) SetPage(v int) {
 	return
 }
 
+// SetPageIfUnset sets page to v, unless it is already set
+func (p *ListNetworkACLListsParams) SetPageIfUnset(v int) {
+	if _, found := p.p["page"]; !found {
+		p.SetPage(v)
+	}
+}
+
 func (p *ListNetworkACLListsParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -639,6 +1105,13 @@ func (p *ListNetworkACLListsParams) SetPagesize(v int) {
 	return
 }
 
+// SetPagesizeIfUnset sets pagesize to v, unless it is already set
+func (p *ListNetworkACLListsParams) SetPagesizeIfUnset(v int) {
+	if _, found := p.p["pagesize"]; !found {
+		p.SetPagesize(v)
+	}
+}
+
 func (p *ListNetworkACLListsParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -647,6 +1120,13 @@ func (p *ListNetworkACLListsParams) SetProjectid(v string) {
 	return
 }
 
+// SetProjectidIfUnset sets projectid to v, unless it is already set
+func (p *ListNetworkACLListsParams) SetProjectidIfUnset(v string) {
+	if _, found := p.p["projectid"]; !found {
+		p.SetProjectid(v)
+	}
+}
+
 func (p *ListNetworkACLListsParams) SetVpcid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -655,14 +1135,34 @@ func (p *ListNetworkACLListsParams) SetVpcid(v string) {
 	return
 }
 
+// SetVpcidIfUnset sets vpcid to v, unless it is already set
+func (p *ListNetworkACLListsParams) SetVpcidIfUnset(v string) {
+	if _, found := p.p["vpcid"]; !found {
+		p.SetVpcid(v)
+	}
+}
+
+var _ ListAllSetter = (*ListNetworkACLListsParams)(nil)
+
 // You should always use this function to get a new ListNetworkACLListsParams instance,
-// as then you are sure you have configured all required params
-func (s *NetworkACLService) NewListNetworkACLListsParams() *ListNetworkACLListsParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *NetworkACLService) NewListNetworkACLListsParams(opts ...func(*ListNetworkACLListsParams)) *ListNetworkACLListsParams {
 	p := &ListNetworkACLListsParams{}
 	p.p = make(map[string]interface{})
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
+func (p *ListNetworkACLListsParams) setFirstMatch() {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p[firstMatchKey] = true
+}
+
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *NetworkACLService) GetNetworkACLListID(name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListNetworkACLListsParams{}
@@ -670,7 +1170,7 @@ func (s *NetworkACLService) GetNetworkACLListID(name string, opts ...OptionFunc)
 
 	p.p["name"] = name
 
-	for _, fn := range append(s.cs.options, opts...) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
 		if err := fn(s.cs, p); err != nil {
 			return "", -1, err
 		}
@@ -682,7 +1182,7 @@ func (s *NetworkACLService) GetNetworkACLListID(name string, opts ...OptionFunc)
 	}
 
 	if l.Count == 0 {
-		return "", l.Count, fmt.Errorf("No match found for %s: %+v", name, l)
+		return "", l.Count, fmt.Errorf("%w for %s: %+v", ErrNotFound, name, l)
 	}
 
 	if l.Count == 1 {
@@ -695,6 +1195,10 @@ func (s *NetworkACLService) GetNetworkACLListID(name string, opts ...OptionFunc)
 				return v.Id, l.Count, nil
 			}
 		}
+
+		if _, ok := p.p[firstMatchKey]; ok && len(l.NetworkACLLists) > 0 {
+			return l.NetworkACLLists[0].Id, l.Count, nil
+		}
 	}
 	return "", l.Count, fmt.Errorf("Could not find an exact match for %s: %+v", name, l)
 }
@@ -720,7 +1224,7 @@ func (s *NetworkACLService) GetNetworkACLListByID(id string, opts ...OptionFunc)
 
 	p.p["id"] = id
 
-	for _, fn := range append(s.cs.options, opts...) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
 		if err := fn(s.cs, p); err != nil {
 			return nil, -1, err
 		}
@@ -731,24 +1235,56 @@ func (s *NetworkACLService) GetNetworkACLListByID(id string, opts ...OptionFunc)
 		if strings.Contains(err.Error(), fmt.Sprintf(
 			"Invalid parameter id value=%s due to incorrect long value format, "+
 				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+			return nil, 0, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
 	}
 
 	if l.Count == 1 {
 		return l.NetworkACLLists[0], l.Count, nil
 	}
+
+	if _, ok := p.p[firstMatchKey]; ok && len(l.NetworkACLLists) > 0 {
+		return l.NetworkACLLists[0], l.Count, nil
+	}
 	return nil, l.Count, fmt.Errorf("There is more then one result for NetworkACLList UUID: %s!", id)
 }
 
+// NetworkACLListExists returns true if a NetworkACLList with the given ID exists. When no match is found
+// it returns false, while any other error is returned as is.
+func (s *NetworkACLService) NetworkACLListExists(id string, opts ...OptionFunc) (bool, error) {
+	_, count, err := s.GetNetworkACLListByID(id, opts...)
+	if errors.Is(err, ErrNotFound) {
+		return false, nil
+	}
+	if count > 0 {
+		return true, nil
+	}
+	return false, err
+}
+
 // Lists all network ACLs
 func (s *NetworkACLService) ListNetworkACLLists(p *ListNetworkACLListsParams) (*ListNetworkACLListsResponse, error) {
-	resp, err := s.cs.newRequest("listNetworkACLLists", p.toURLValues())
+	return s.ListNetworkACLListsWithContext(context.Background(), p)
+}
+
+// ListNetworkACLListsWithContext is the same as ListNetworkACLLists, but the request is cancelled when the context is done
+func (s *NetworkACLService) ListNetworkACLListsWithContext(ctx context.Context, p *ListNetworkACLListsParams) (*ListNetworkACLListsResponse, error) {
+	return s.ListNetworkACLListsRawWithContext(ctx, p.toURLValues())
+}
+
+// ListNetworkACLListsRaw is the same as ListNetworkACLLists, but takes the params as url.Values instead of typed params
+func (s *NetworkACLService) ListNetworkACLListsRaw(v url.Values) (*ListNetworkACLListsResponse, error) {
+	return s.ListNetworkACLListsRawWithContext(context.Background(), v)
+}
+
+// ListNetworkACLListsRawWithContext is the same as ListNetworkACLListsRaw, but the request is cancelled when the context is done
+func (s *NetworkACLService) ListNetworkACLListsRawWithContext(ctx context.Context, v url.Values) (*ListNetworkACLListsResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "listNetworkACLLists", "listnetworkacllistsresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -761,6 +1297,93 @@ func (s *NetworkACLService) ListNetworkACLLists(p *ListNetworkACLListsParams) (*
 	return &r, nil
 }
 
+// StreamNetworkACLLists fetches the results of ListNetworkACLLists page by page in the background and sends them on the
+// returned channel. Both returned channels are closed when done. If fetching a page fails, or the context
+// is done before all results are sent, the error is sent on the error channel and fetching stops. A
+// request for a page in flight is cancelled when the context is done. The page and pagesize set in the
+// params are ignored.
+func (s *NetworkACLService) StreamNetworkACLLists(ctx context.Context, p *ListNetworkACLListsParams) (<-chan *NetworkACLList, <-chan error) {
+	ch := make(chan *NetworkACLList)
+	errs := make(chan error, 1)
+
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesize(500)
+
+	go func() {
+		defer close(errs)
+		defer close(ch)
+
+		for page, count := 1, 0; ; page++ {
+			pp.SetPage(page)
+
+			l, err := s.ListNetworkACLListsWithContext(ctx, pp)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			for _, v := range l.NetworkACLLists {
+				select {
+				case ch <- v:
+				case <-ctx.Done():
+					errs <- ctx.Err()
+					return
+				}
+			}
+
+			count += len(l.NetworkACLLists)
+			if len(l.NetworkACLLists) < 500 || count >= l.Count {
+				return
+			}
+		}
+	}()
+
+	return ch, errs
+}
+
+// ListNetworkACLListsWhere returns all results of ListNetworkACLLists for which pred returns true. Note that the results are
+// filtered client-side, so all results matching the params are fetched first.
+// All pages are fetched, so the page and pagesize set in the params are ignored.
+func (s *NetworkACLService) ListNetworkACLListsWhere(p *ListNetworkACLListsParams, pred func(*NetworkACLList) bool) ([]*NetworkACLList, error) {
+	var r []*NetworkACLList
+	ch, errs := s.StreamNetworkACLLists(context.Background(), p)
+	for v := range ch {
+		if pred(v) {
+			r = append(r, v)
+		}
+	}
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ListAllNetworkACLLists returns the results of ListNetworkACLLists across all pages, by calling ListNetworkACLLists page by page until all
+// results are fetched. The pagesize set in the params is used for every page (500 if not set), and the
+// page set in the params is ignored.
+func (s *NetworkACLService) ListAllNetworkACLLists(p *ListNetworkACLListsParams) ([]*NetworkACLList, error) {
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesizeIfUnset(500)
+	pagesize := pp.p["pagesize"].(int)
+
+	var r []*NetworkACLList
+	for page := 1; ; page++ {
+		pp.SetPage(page)
+
+		l, err := s.ListNetworkACLLists(pp)
+		if err != nil {
+			return nil, err
+		}
+		r = append(r, l.NetworkACLLists...)
+
+		if len(l.NetworkACLLists) < pagesize || len(r) >= l.Count {
+			return r, nil
+		}
+	}
+}
+
 type ListNetworkACLListsResponse struct {
 	Count           int               `json:"count"`
 	NetworkACLLists []*NetworkACLList `json:"networkacllist"`
@@ -778,6 +1401,16 @@ type ListNetworkACLsParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ListNetworkACLsParams) Clone() *ListNetworkACLsParams {
+	c := &ListNetworkACLsParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListNetworkACLsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -831,11 +1464,10 @@ func (p *ListNetworkACLsParams) toURLValues() url.Values {
 		u.Set("protocol", v.(string))
 	}
 	if v, found := p.p["tags"]; found {
-		i := 0
-		for k, vv := range v.(map[string]string) {
+		m := v.(map[string]string)
+		for i, k := range getSortedKeysFromMap(m) {
 			u.Set(fmt.Sprintf("tags[%d].key", i), k)
-			u.Set(fmt.Sprintf("tags[%d].value", i), vv)
-			i++
+			u.Set(fmt.Sprintf("tags[%d].value", i), m[k])
 		}
 	}
 	if v, found := p.p["traffictype"]; found {
@@ -852,6 +1484,13 @@ func (p *ListNetworkACLsParams) SetAccount(v string) {
 	return
 }
 
+// SetAccountIfUnset sets account to v, unless it is already set
+func (p *ListNetworkACLsParams) SetAccountIfUnset(v string) {
+	if _, found := p.p["account"]; !found {
+		p.SetAccount(v)
+	}
+}
+
 func (p *ListNetworkACLsParams) SetAclid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -860,6 +1499,13 @@ func (p *ListNetworkACLsParams) SetAclid(v string) {
 	return
 }
 
+// SetAclidIfUnset sets aclid to v, unless it is already set
+func (p *ListNetworkACLsParams) SetAclidIfUnset(v string) {
+	if _, found := p.p["aclid"]; !found {
+		p.SetAclid(v)
+	}
+}
+
 func (p *ListNetworkACLsParams) SetAction(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -868,6 +1514,13 @@ func (p *ListNetworkACLsParams) SetAction(v string) {
 	return
 }
 
+// SetActionIfUnset sets action to v, unless it is already set
+func (p *ListNetworkACLsParams) SetActionIfUnset(v string) {
+	if _, found := p.p["action"]; !found {
+		p.SetAction(v)
+	}
+}
+
 func (p *ListNetworkACLsParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -876,6 +1529,13 @@ func (p *ListNetworkACLsParams) SetDomainid(v string) {
 	return
 }
 
+// SetDomainidIfUnset sets domainid to v, unless it is already set
+func (p *ListNetworkACLsParams) SetDomainidIfUnset(v string) {
+	if _, found := p.p["domainid"]; !found {
+		p.SetDomainid(v)
+	}
+}
+
 func (p *ListNetworkACLsParams) SetFordisplay(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -884,6 +1544,13 @@ func (p *ListNetworkACLsParams) SetFordisplay(v bool) {
 	return
 }
 
+// SetFordisplayIfUnset sets fordisplay to v, unless it is already set
+func (p *ListNetworkACLsParams) SetFordisplayIfUnset(v bool) {
+	if _, found := p.p["fordisplay"]; !found {
+		p.SetFordisplay(v)
+	}
+}
+
 func (p *ListNetworkACLsParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -892,6 +1559,13 @@ func (p *ListNetworkACLsParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *ListNetworkACLsParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 func (p *ListNetworkACLsParams) SetIsrecursive(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -900,6 +1574,13 @@ func (p *ListNetworkACLsParams) SetIsrecursive(v bool) {
 	return
 }
 
+// SetIsrecursiveIfUnset sets isrecursive to v, unless it is already set
+func (p *ListNetworkACLsParams) SetIsrecursiveIfUnset(v bool) {
+	if _, found := p.p["isrecursive"]; !found {
+		p.SetIsrecursive(v)
+	}
+}
+
 func (p *ListNetworkACLsParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -908,6 +1589,13 @@ func (p *ListNetworkACLsParams) SetKeyword(v string) {
 	return
 }
 
+// SetKeywordIfUnset sets keyword to v, unless it is already set
+func (p *ListNetworkACLsParams) SetKeywordIfUnset(v string) {
+	if _, found := p.p["keyword"]; !found {
+		p.SetKeyword(v)
+	}
+}
+
 func (p *ListNetworkACLsParams) SetListall(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -916,6 +1604,13 @@ func (p *ListNetworkACLsParams) SetListall(v bool) {
 	return
 }
 
+// SetListallIfUnset sets listall to v, unless it is already set
+func (p *ListNetworkACLsParams) SetListallIfUnset(v bool) {
+	if _, found := p.p["listall"]; !found {
+		p.SetListall(v)
+	}
+}
+
 func (p *ListNetworkACLsParams) SetNetworkid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -924,6 +1619,13 @@ func (p *ListNetworkACLsParams) SetNetworkid(v string) {
 	return
 }
 
+// SetNetworkidIfUnset sets networkid to v, unless it is already set
+func (p *ListNetworkACLsParams) SetNetworkidIfUnset(v string) {
+	if _, found := p.p["networkid"]; !found {
+		p.SetNetworkid(v)
+	}
+}
+
 func (p *ListNetworkACLsParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -932,6 +1634,13 @@ func (p *ListNetworkACLsParams) SetPage(v int) {
 	return
 }
 
+// SetPageIfUnset sets page to v, unless it is already set
+func (p *ListNetworkACLsParams) SetPageIfUnset(v int) {
+	if _, found := p.p["page"]; !found {
+		p.SetPage(v)
+	}
+}
+
 func (p *ListNetworkACLsParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -940,6 +1649,13 @@ func (p *ListNetworkACLsParams) SetPagesize(v int) {
 	return
 }
 
+// SetPagesizeIfUnset sets pagesize to v, unless it is already set
+func (p *ListNetworkACLsParams) SetPagesizeIfUnset(v int) {
+	if _, found := p.p["pagesize"]; !found {
+		p.SetPagesize(v)
+	}
+}
+
 func (p *ListNetworkACLsParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -948,14 +1664,28 @@ func (p *ListNetworkACLsParams) SetProjectid(v string) {
 	return
 }
 
-func (p *ListNetworkACLsParams) SetProtocol(v string) {
+// SetProjectidIfUnset sets projectid to v, unless it is already set
+func (p *ListNetworkACLsParams) SetProjectidIfUnset(v string) {
+	if _, found := p.p["projectid"]; !found {
+		p.SetProjectid(v)
+	}
+}
+
+func (p *ListNetworkACLsParams) SetProtocol(v Protocol) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	p.p["protocol"] = v
+	p.p["protocol"] = string(v)
 	return
 }
 
+// SetProtocolIfUnset sets protocol to v, unless it is already set
+func (p *ListNetworkACLsParams) SetProtocolIfUnset(v Protocol) {
+	if _, found := p.p["protocol"]; !found {
+		p.SetProtocol(v)
+	}
+}
+
 func (p *ListNetworkACLsParams) SetTags(v map[string]string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -964,6 +1694,13 @@ func (p *ListNetworkACLsParams) SetTags(v map[string]string) {
 	return
 }
 
+// SetTagsIfUnset sets tags to v, unless it is already set
+func (p *ListNetworkACLsParams) SetTagsIfUnset(v map[string]string) {
+	if _, found := p.p["tags"]; !found {
+		p.SetTags(v)
+	}
+}
+
 func (p *ListNetworkACLsParams) SetTraffictype(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -972,14 +1709,34 @@ func (p *ListNetworkACLsParams) SetTraffictype(v string) {
 	return
 }
 
+// SetTraffictypeIfUnset sets traffictype to v, unless it is already set
+func (p *ListNetworkACLsParams) SetTraffictypeIfUnset(v string) {
+	if _, found := p.p["traffictype"]; !found {
+		p.SetTraffictype(v)
+	}
+}
+
+var _ ListAllSetter = (*ListNetworkACLsParams)(nil)
+
 // You should always use this function to get a new ListNetworkACLsParams instance,
-// as then you are sure you have configured all required params
-func (s *NetworkACLService) NewListNetworkACLsParams() *ListNetworkACLsParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *NetworkACLService) NewListNetworkACLsParams(opts ...func(*ListNetworkACLsParams)) *ListNetworkACLsParams {
 	p := &ListNetworkACLsParams{}
 	p.p = make(map[string]interface{})
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
+func (p *ListNetworkACLsParams) setFirstMatch() {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p[firstMatchKey] = true
+}
+
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *NetworkACLService) GetNetworkACLByID(id string, opts ...OptionFunc) (*NetworkACL, int, error) {
 	p := &ListNetworkACLsParams{}
@@ -987,7 +1744,7 @@ func (s *NetworkACLService) GetNetworkACLByID(id string, opts ...OptionFunc) (*N
 
 	p.p["id"] = id
 
-	for _, fn := range append(s.cs.options, opts...) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
 		if err := fn(s.cs, p); err != nil {
 			return nil, -1, err
 		}
@@ -998,24 +1755,56 @@ func (s *NetworkACLService) GetNetworkACLByID(id string, opts ...OptionFunc) (*N
 		if strings.Contains(err.Error(), fmt.Sprintf(
 			"Invalid parameter id value=%s due to incorrect long value format, "+
 				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+			return nil, 0, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
 	}
 
 	if l.Count == 1 {
 		return l.NetworkACLs[0], l.Count, nil
 	}
+
+	if _, ok := p.p[firstMatchKey]; ok && len(l.NetworkACLs) > 0 {
+		return l.NetworkACLs[0], l.Count, nil
+	}
 	return nil, l.Count, fmt.Errorf("There is more then one result for NetworkACL UUID: %s!", id)
 }
 
+// NetworkACLExists returns true if a NetworkACL with the given ID exists. When no match is found
+// it returns false, while any other error is returned as is.
+func (s *NetworkACLService) NetworkACLExists(id string, opts ...OptionFunc) (bool, error) {
+	_, count, err := s.GetNetworkACLByID(id, opts...)
+	if errors.Is(err, ErrNotFound) {
+		return false, nil
+	}
+	if count > 0 {
+		return true, nil
+	}
+	return false, err
+}
+
 // Lists all network ACL items
 func (s *NetworkACLService) ListNetworkACLs(p *ListNetworkACLsParams) (*ListNetworkACLsResponse, error) {
-	resp, err := s.cs.newRequest("listNetworkACLs", p.toURLValues())
+	return s.ListNetworkACLsWithContext(context.Background(), p)
+}
+
+// ListNetworkACLsWithContext is the same as ListNetworkACLs, but the request is cancelled when the context is done
+func (s *NetworkACLService) ListNetworkACLsWithContext(ctx context.Context, p *ListNetworkACLsParams) (*ListNetworkACLsResponse, error) {
+	return s.ListNetworkACLsRawWithContext(ctx, p.toURLValues())
+}
+
+// ListNetworkACLsRaw is the same as ListNetworkACLs, but takes the params as url.Values instead of typed params
+func (s *NetworkACLService) ListNetworkACLsRaw(v url.Values) (*ListNetworkACLsResponse, error) {
+	return s.ListNetworkACLsRawWithContext(context.Background(), v)
+}
+
+// ListNetworkACLsRawWithContext is the same as ListNetworkACLsRaw, but the request is cancelled when the context is done
+func (s *NetworkACLService) ListNetworkACLsRawWithContext(ctx context.Context, v url.Values) (*ListNetworkACLsResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "listNetworkACLs", "listnetworkaclsresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -1028,6 +1817,93 @@ func (s *NetworkACLService) ListNetworkACLs(p *ListNetworkACLsParams) (*ListNetw
 	return &r, nil
 }
 
+// StreamNetworkACLs fetches the results of ListNetworkACLs page by page in the background and sends them on the
+// returned channel. Both returned channels are closed when done. If fetching a page fails, or the context
+// is done before all results are sent, the error is sent on the error channel and fetching stops. A
+// request for a page in flight is cancelled when the context is done. The page and pagesize set in the
+// params are ignored.
+func (s *NetworkACLService) StreamNetworkACLs(ctx context.Context, p *ListNetworkACLsParams) (<-chan *NetworkACL, <-chan error) {
+	ch := make(chan *NetworkACL)
+	errs := make(chan error, 1)
+
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesize(500)
+
+	go func() {
+		defer close(errs)
+		defer close(ch)
+
+		for page, count := 1, 0; ; page++ {
+			pp.SetPage(page)
+
+			l, err := s.ListNetworkACLsWithContext(ctx, pp)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			for _, v := range l.NetworkACLs {
+				select {
+				case ch <- v:
+				case <-ctx.Done():
+					errs <- ctx.Err()
+					return
+				}
+			}
+
+			count += len(l.NetworkACLs)
+			if len(l.NetworkACLs) < 500 || count >= l.Count {
+				return
+			}
+		}
+	}()
+
+	return ch, errs
+}
+
+// ListNetworkACLsWhere returns all results of ListNetworkACLs for which pred returns true. Note that the results are
+// filtered client-side, so all results matching the params are fetched first.
+// All pages are fetched, so the page and pagesize set in the params are ignored.
+func (s *NetworkACLService) ListNetworkACLsWhere(p *ListNetworkACLsParams, pred func(*NetworkACL) bool) ([]*NetworkACL, error) {
+	var r []*NetworkACL
+	ch, errs := s.StreamNetworkACLs(context.Background(), p)
+	for v := range ch {
+		if pred(v) {
+			r = append(r, v)
+		}
+	}
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ListAllNetworkACLs returns the results of ListNetworkACLs across all pages, by calling ListNetworkACLs page by page until all
+// results are fetched. The pagesize set in the params is used for every page (500 if not set), and the
+// page set in the params is ignored.
+func (s *NetworkACLService) ListAllNetworkACLs(p *ListNetworkACLsParams) ([]*NetworkACL, error) {
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesizeIfUnset(500)
+	pagesize := pp.p["pagesize"].(int)
+
+	var r []*NetworkACL
+	for page := 1; ; page++ {
+		pp.SetPage(page)
+
+		l, err := s.ListNetworkACLs(pp)
+		if err != nil {
+			return nil, err
+		}
+		r = append(r, l.NetworkACLs...)
+
+		if len(l.NetworkACLs) < pagesize || len(r) >= l.Count {
+			return r, nil
+		}
+	}
+}
+
 type ListNetworkACLsResponse struct {
 	Count       int           `json:"count"`
 	NetworkACLs []*NetworkACL `json:"networkacl"`
@@ -1065,6 +1941,16 @@ type ReplaceNetworkACLListParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ReplaceNetworkACLListParams) Clone() *ReplaceNetworkACLListParams {
+	c := &ReplaceNetworkACLListParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ReplaceNetworkACLListParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1082,6 +1968,16 @@ func (p *ReplaceNetworkACLListParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *ReplaceNetworkACLListParams) validate() error {
+	for _, k := range []string{"aclid"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of replaceNetworkACLList", k)
+		}
+	}
+	return nil
+}
+
 func (p *ReplaceNetworkACLListParams) SetAclid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1090,6 +1986,13 @@ func (p *ReplaceNetworkACLListParams) SetAclid(v string) {
 	return
 }
 
+// SetAclidIfUnset sets aclid to v, unless it is already set
+func (p *ReplaceNetworkACLListParams) SetAclidIfUnset(v string) {
+	if _, found := p.p["aclid"]; !found {
+		p.SetAclid(v)
+	}
+}
+
 func (p *ReplaceNetworkACLListParams) SetGatewayid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1098,6 +2001,13 @@ func (p *ReplaceNetworkACLListParams) SetGatewayid(v string) {
 	return
 }
 
+// SetGatewayidIfUnset sets gatewayid to v, unless it is already set
+func (p *ReplaceNetworkACLListParams) SetGatewayidIfUnset(v string) {
+	if _, found := p.p["gatewayid"]; !found {
+		p.SetGatewayid(v)
+	}
+}
+
 func (p *ReplaceNetworkACLListParams) SetNetworkid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1106,18 +2016,54 @@ func (p *ReplaceNetworkACLListParams) SetNetworkid(v string) {
 	return
 }
 
+// SetNetworkidIfUnset sets networkid to v, unless it is already set
+func (p *ReplaceNetworkACLListParams) SetNetworkidIfUnset(v string) {
+	if _, found := p.p["networkid"]; !found {
+		p.SetNetworkid(v)
+	}
+}
+
 // You should always use this function to get a new ReplaceNetworkACLListParams instance,
-// as then you are sure you have configured all required params
-func (s *NetworkACLService) NewReplaceNetworkACLListParams(aclid string) *ReplaceNetworkACLListParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *NetworkACLService) NewReplaceNetworkACLListParams(aclid string, opts ...func(*ReplaceNetworkACLListParams)) *ReplaceNetworkACLListParams {
 	p := &ReplaceNetworkACLListParams{}
 	p.p = make(map[string]interface{})
 	p.p["aclid"] = aclid
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Replaces ACL associated with a network or private gateway
-func (s *NetworkACLService) ReplaceNetworkACLList(p *ReplaceNetworkACLListParams) (*ReplaceNetworkACLListResponse, error) {
-	resp, err := s.cs.newRequest("replaceNetworkACLList", p.toURLValues())
+func (s *NetworkACLService) ReplaceNetworkACLList(p *ReplaceNetworkACLListParams, opts ...OptionFunc) (*ReplaceNetworkACLListResponse, error) {
+	return s.ReplaceNetworkACLListWithContext(context.Background(), p, opts...)
+}
+
+// ReplaceNetworkACLListWithContext is the same as ReplaceNetworkACLList, but the request is cancelled when the context is done
+func (s *NetworkACLService) ReplaceNetworkACLListWithContext(ctx context.Context, p *ReplaceNetworkACLListParams, opts ...OptionFunc) (*ReplaceNetworkACLListResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.ReplaceNetworkACLListRawWithContext(ctx, p.toURLValues())
+}
+
+// ReplaceNetworkACLListRaw is the same as ReplaceNetworkACLList, but takes the params as url.Values instead of typed params
+func (s *NetworkACLService) ReplaceNetworkACLListRaw(v url.Values) (*ReplaceNetworkACLListResponse, error) {
+	return s.ReplaceNetworkACLListRawWithContext(context.Background(), v)
+}
+
+// ReplaceNetworkACLListRawWithContext is the same as ReplaceNetworkACLListRaw, but the request is cancelled when the context is done
+func (s *NetworkACLService) ReplaceNetworkACLListRawWithContext(ctx context.Context, v url.Values) (*ReplaceNetworkACLListResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "replaceNetworkACLList", "replacenetworkacllistresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -1129,9 +2075,9 @@ func (s *NetworkACLService) ReplaceNetworkACLList(p *ReplaceNetworkACLListParams
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -1155,6 +2101,16 @@ type UpdateNetworkACLItemParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *UpdateNetworkACLItemParams) Clone() *UpdateNetworkACLItemParams {
+	c := &UpdateNetworkACLItemParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *UpdateNetworkACLItemParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1206,6 +2162,16 @@ func (p *UpdateNetworkACLItemParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *UpdateNetworkACLItemParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of updateNetworkACLItem", k)
+		}
+	}
+	return nil
+}
+
 func (p *UpdateNetworkACLItemParams) SetAction(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1214,6 +2180,13 @@ func (p *UpdateNetworkACLItemParams) SetAction(v string) {
 	return
 }
 
+// SetActionIfUnset sets action to v, unless it is already set
+func (p *UpdateNetworkACLItemParams) SetActionIfUnset(v string) {
+	if _, found := p.p["action"]; !found {
+		p.SetAction(v)
+	}
+}
+
 func (p *UpdateNetworkACLItemParams) SetCidrlist(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1222,6 +2195,18 @@ func (p *UpdateNetworkACLItemParams) SetCidrlist(v []string) {
 	return
 }
 
+// SetCidrlistIfUnset sets cidrlist to v, unless it is already set
+func (p *UpdateNetworkACLItemParams) SetCidrlistIfUnset(v []string) {
+	if _, found := p.p["cidrlist"]; !found {
+		p.SetCidrlist(v)
+	}
+}
+
+// SetCidrlistCSV splits v on commas and sets the resulting values
+func (p *UpdateNetworkACLItemParams) SetCidrlistCSV(v string) {
+	p.SetCidrlist(splitCSV(v))
+}
+
 func (p *UpdateNetworkACLItemParams) SetCustomid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1230,6 +2215,13 @@ func (p *UpdateNetworkACLItemParams) SetCustomid(v string) {
 	return
 }
 
+// SetCustomidIfUnset sets customid to v, unless it is already set
+func (p *UpdateNetworkACLItemParams) SetCustomidIfUnset(v string) {
+	if _, found := p.p["customid"]; !found {
+		p.SetCustomid(v)
+	}
+}
+
 func (p *UpdateNetworkACLItemParams) SetEndport(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1238,6 +2230,13 @@ func (p *UpdateNetworkACLItemParams) SetEndport(v int) {
 	return
 }
 
+// SetEndportIfUnset sets endport to v, unless it is already set
+func (p *UpdateNetworkACLItemParams) SetEndportIfUnset(v int) {
+	if _, found := p.p["endport"]; !found {
+		p.SetEndport(v)
+	}
+}
+
 func (p *UpdateNetworkACLItemParams) SetFordisplay(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1246,6 +2245,13 @@ func (p *UpdateNetworkACLItemParams) SetFordisplay(v bool) {
 	return
 }
 
+// SetFordisplayIfUnset sets fordisplay to v, unless it is already set
+func (p *UpdateNetworkACLItemParams) SetFordisplayIfUnset(v bool) {
+	if _, found := p.p["fordisplay"]; !found {
+		p.SetFordisplay(v)
+	}
+}
+
 func (p *UpdateNetworkACLItemParams) SetIcmpcode(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1254,6 +2260,13 @@ func (p *UpdateNetworkACLItemParams) SetIcmpcode(v int) {
 	return
 }
 
+// SetIcmpcodeIfUnset sets icmpcode to v, unless it is already set
+func (p *UpdateNetworkACLItemParams) SetIcmpcodeIfUnset(v int) {
+	if _, found := p.p["icmpcode"]; !found {
+		p.SetIcmpcode(v)
+	}
+}
+
 func (p *UpdateNetworkACLItemParams) SetIcmptype(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1262,6 +2275,13 @@ func (p *UpdateNetworkACLItemParams) SetIcmptype(v int) {
 	return
 }
 
+// SetIcmptypeIfUnset sets icmptype to v, unless it is already set
+func (p *UpdateNetworkACLItemParams) SetIcmptypeIfUnset(v int) {
+	if _, found := p.p["icmptype"]; !found {
+		p.SetIcmptype(v)
+	}
+}
+
 func (p *UpdateNetworkACLItemParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1270,6 +2290,13 @@ func (p *UpdateNetworkACLItemParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *UpdateNetworkACLItemParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 func (p *UpdateNetworkACLItemParams) SetNumber(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1278,14 +2305,28 @@ func (p *UpdateNetworkACLItemParams) SetNumber(v int) {
 	return
 }
 
-func (p *UpdateNetworkACLItemParams) SetProtocol(v string) {
+// SetNumberIfUnset sets number to v, unless it is already set
+func (p *UpdateNetworkACLItemParams) SetNumberIfUnset(v int) {
+	if _, found := p.p["number"]; !found {
+		p.SetNumber(v)
+	}
+}
+
+func (p *UpdateNetworkACLItemParams) SetProtocol(v Protocol) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	p.p["protocol"] = v
+	p.p["protocol"] = string(v)
 	return
 }
 
+// SetProtocolIfUnset sets protocol to v, unless it is already set
+func (p *UpdateNetworkACLItemParams) SetProtocolIfUnset(v Protocol) {
+	if _, found := p.p["protocol"]; !found {
+		p.SetProtocol(v)
+	}
+}
+
 func (p *UpdateNetworkACLItemParams) SetStartport(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1294,6 +2335,13 @@ func (p *UpdateNetworkACLItemParams) SetStartport(v int) {
 	return
 }
 
+// SetStartportIfUnset sets startport to v, unless it is already set
+func (p *UpdateNetworkACLItemParams) SetStartportIfUnset(v int) {
+	if _, found := p.p["startport"]; !found {
+		p.SetStartport(v)
+	}
+}
+
 func (p *UpdateNetworkACLItemParams) SetTraffictype(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1302,18 +2350,54 @@ func (p *UpdateNetworkACLItemParams) SetTraffictype(v string) {
 	return
 }
 
+// SetTraffictypeIfUnset sets traffictype to v, unless it is already set
+func (p *UpdateNetworkACLItemParams) SetTraffictypeIfUnset(v string) {
+	if _, found := p.p["traffictype"]; !found {
+		p.SetTraffictype(v)
+	}
+}
+
 // You should always use this function to get a new UpdateNetworkACLItemParams instance,
-// as then you are sure you have configured all required params
-func (s *NetworkACLService) NewUpdateNetworkACLItemParams(id string) *UpdateNetworkACLItemParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *NetworkACLService) NewUpdateNetworkACLItemParams(id string, opts ...func(*UpdateNetworkACLItemParams)) *UpdateNetworkACLItemParams {
 	p := &UpdateNetworkACLItemParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Updates ACL item with specified ID
-func (s *NetworkACLService) UpdateNetworkACLItem(p *UpdateNetworkACLItemParams) (*UpdateNetworkACLItemResponse, error) {
-	resp, err := s.cs.newRequest("updateNetworkACLItem", p.toURLValues())
+func (s *NetworkACLService) UpdateNetworkACLItem(p *UpdateNetworkACLItemParams, opts ...OptionFunc) (*UpdateNetworkACLItemResponse, error) {
+	return s.UpdateNetworkACLItemWithContext(context.Background(), p, opts...)
+}
+
+// UpdateNetworkACLItemWithContext is the same as UpdateNetworkACLItem, but the request is cancelled when the context is done
+func (s *NetworkACLService) UpdateNetworkACLItemWithContext(ctx context.Context, p *UpdateNetworkACLItemParams, opts ...OptionFunc) (*UpdateNetworkACLItemResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.UpdateNetworkACLItemRawWithContext(ctx, p.toURLValues())
+}
+
+// UpdateNetworkACLItemRaw is the same as UpdateNetworkACLItem, but takes the params as url.Values instead of typed params
+func (s *NetworkACLService) UpdateNetworkACLItemRaw(v url.Values) (*UpdateNetworkACLItemResponse, error) {
+	return s.UpdateNetworkACLItemRawWithContext(context.Background(), v)
+}
+
+// UpdateNetworkACLItemRawWithContext is the same as UpdateNetworkACLItemRaw, but the request is cancelled when the context is done
+func (s *NetworkACLService) UpdateNetworkACLItemRawWithContext(ctx context.Context, v url.Values) (*UpdateNetworkACLItemResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "updateNetworkACLItem", "updatenetworkaclitemresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -1325,9 +2409,9 @@ func (s *NetworkACLService) UpdateNetworkACLItem(p *UpdateNetworkACLItemParams)
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -1379,6 +2463,16 @@ type UpdateNetworkACLListParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *UpdateNetworkACLListParams) Clone() *UpdateNetworkACLListParams {
+	c := &UpdateNetworkACLListParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *UpdateNetworkACLListParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1397,6 +2491,16 @@ func (p *UpdateNetworkACLListParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *UpdateNetworkACLListParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of updateNetworkACLList", k)
+		}
+	}
+	return nil
+}
+
 func (p *UpdateNetworkACLListParams) SetCustomid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1405,6 +2509,13 @@ func (p *UpdateNetworkACLListParams) SetCustomid(v string) {
 	return
 }
 
+// SetCustomidIfUnset sets customid to v, unless it is already set
+func (p *UpdateNetworkACLListParams) SetCustomidIfUnset(v string) {
+	if _, found := p.p["customid"]; !found {
+		p.SetCustomid(v)
+	}
+}
+
 func (p *UpdateNetworkACLListParams) SetFordisplay(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1413,6 +2524,13 @@ func (p *UpdateNetworkACLListParams) SetFordisplay(v bool) {
 	return
 }
 
+// SetFordisplayIfUnset sets fordisplay to v, unless it is already set
+func (p *UpdateNetworkACLListParams) SetFordisplayIfUnset(v bool) {
+	if _, found := p.p["fordisplay"]; !found {
+		p.SetFordisplay(v)
+	}
+}
+
 func (p *UpdateNetworkACLListParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1421,18 +2539,54 @@ func (p *UpdateNetworkACLListParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *UpdateNetworkACLListParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 // You should always use this function to get a new UpdateNetworkACLListParams instance,
-// as then you are sure you have configured all required params
-func (s *NetworkACLService) NewUpdateNetworkACLListParams(id string) *UpdateNetworkACLListParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *NetworkACLService) NewUpdateNetworkACLListParams(id string, opts ...func(*UpdateNetworkACLListParams)) *UpdateNetworkACLListParams {
 	p := &UpdateNetworkACLListParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Updates network ACL list
-func (s *NetworkACLService) UpdateNetworkACLList(p *UpdateNetworkACLListParams) (*UpdateNetworkACLListResponse, error) {
-	resp, err := s.cs.newRequest("updateNetworkACLList", p.toURLValues())
+func (s *NetworkACLService) UpdateNetworkACLList(p *UpdateNetworkACLListParams, opts ...OptionFunc) (*UpdateNetworkACLListResponse, error) {
+	return s.UpdateNetworkACLListWithContext(context.Background(), p, opts...)
+}
+
+// UpdateNetworkACLListWithContext is the same as UpdateNetworkACLList, but the request is cancelled when the context is done
+func (s *NetworkACLService) UpdateNetworkACLListWithContext(ctx context.Context, p *UpdateNetworkACLListParams, opts ...OptionFunc) (*UpdateNetworkACLListResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.UpdateNetworkACLListRawWithContext(ctx, p.toURLValues())
+}
+
+// UpdateNetworkACLListRaw is the same as UpdateNetworkACLList, but takes the params as url.Values instead of typed params
+func (s *NetworkACLService) UpdateNetworkACLListRaw(v url.Values) (*UpdateNetworkACLListResponse, error) {
+	return s.UpdateNetworkACLListRawWithContext(context.Background(), v)
+}
+
+// UpdateNetworkACLListRawWithContext is the same as UpdateNetworkACLListRaw, but the request is cancelled when the context is done
+func (s *NetworkACLService) UpdateNetworkACLListRawWithContext(ctx context.Context, v url.Values) (*UpdateNetworkACLListResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "updateNetworkACLList", "updatenetworkacllistresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -1444,9 +2598,9 @@ func (s *NetworkACLService) UpdateNetworkACLList(p *UpdateNetworkACLListParams)
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
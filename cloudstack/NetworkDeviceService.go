@@ -17,6 +17,7 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
@@ -27,17 +28,26 @@ type AddNetworkDeviceParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *AddNetworkDeviceParams) Clone() *AddNetworkDeviceParams {
+	c := &AddNetworkDeviceParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *AddNetworkDeviceParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
 	}
 	if v, found := p.p["networkdeviceparameterlist"]; found {
-		i := 0
-		for k, vv := range v.(map[string]string) {
+		m := v.(map[string]string)
+		for i, k := range getSortedKeysFromMap(m) {
 			u.Set(fmt.Sprintf("networkdeviceparameterlist[%d].key", i), k)
-			u.Set(fmt.Sprintf("networkdeviceparameterlist[%d].value", i), vv)
-			i++
+			u.Set(fmt.Sprintf("networkdeviceparameterlist[%d].value", i), m[k])
 		}
 	}
 	if v, found := p.p["networkdevicetype"]; found {
@@ -54,6 +64,13 @@ func (p *AddNetworkDeviceParams) SetNetworkdeviceparameterlist(v map[string]stri
 	return
 }
 
+// SetNetworkdeviceparameterlistIfUnset sets networkdeviceparameterlist to v, unless it is already set
+func (p *AddNetworkDeviceParams) SetNetworkdeviceparameterlistIfUnset(v map[string]string) {
+	if _, found := p.p["networkdeviceparameterlist"]; !found {
+		p.SetNetworkdeviceparameterlist(v)
+	}
+}
+
 func (p *AddNetworkDeviceParams) SetNetworkdevicetype(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -62,17 +79,49 @@ func (p *AddNetworkDeviceParams) SetNetworkdevicetype(v string) {
 	return
 }
 
+// SetNetworkdevicetypeIfUnset sets networkdevicetype to v, unless it is already set
+func (p *AddNetworkDeviceParams) SetNetworkdevicetypeIfUnset(v string) {
+	if _, found := p.p["networkdevicetype"]; !found {
+		p.SetNetworkdevicetype(v)
+	}
+}
+
 // You should always use this function to get a new AddNetworkDeviceParams instance,
-// as then you are sure you have configured all required params
-func (s *NetworkDeviceService) NewAddNetworkDeviceParams() *AddNetworkDeviceParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *NetworkDeviceService) NewAddNetworkDeviceParams(opts ...func(*AddNetworkDeviceParams)) *AddNetworkDeviceParams {
 	p := &AddNetworkDeviceParams{}
 	p.p = make(map[string]interface{})
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Adds a network device of one of the following types: ExternalDhcp, ExternalFirewall, ExternalLoadBalancer, PxeServer
-func (s *NetworkDeviceService) AddNetworkDevice(p *AddNetworkDeviceParams) (*AddNetworkDeviceResponse, error) {
-	resp, err := s.cs.newRequest("addNetworkDevice", p.toURLValues())
+func (s *NetworkDeviceService) AddNetworkDevice(p *AddNetworkDeviceParams, opts ...OptionFunc) (*AddNetworkDeviceResponse, error) {
+	return s.AddNetworkDeviceWithContext(context.Background(), p, opts...)
+}
+
+// AddNetworkDeviceWithContext is the same as AddNetworkDevice, but the request is cancelled when the context is done
+func (s *NetworkDeviceService) AddNetworkDeviceWithContext(ctx context.Context, p *AddNetworkDeviceParams, opts ...OptionFunc) (*AddNetworkDeviceResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	return s.AddNetworkDeviceRawWithContext(ctx, p.toURLValues())
+}
+
+// AddNetworkDeviceRaw is the same as AddNetworkDevice, but takes the params as url.Values instead of typed params
+func (s *NetworkDeviceService) AddNetworkDeviceRaw(v url.Values) (*AddNetworkDeviceResponse, error) {
+	return s.AddNetworkDeviceRawWithContext(context.Background(), v)
+}
+
+// AddNetworkDeviceRawWithContext is the same as AddNetworkDeviceRaw, but the request is cancelled when the context is done
+func (s *NetworkDeviceService) AddNetworkDeviceRawWithContext(ctx context.Context, v url.Values) (*AddNetworkDeviceResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "addNetworkDevice", "addnetworkdeviceresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -93,6 +142,16 @@ type DeleteNetworkDeviceParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *DeleteNetworkDeviceParams) Clone() *DeleteNetworkDeviceParams {
+	c := &DeleteNetworkDeviceParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeleteNetworkDeviceParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -104,6 +163,16 @@ func (p *DeleteNetworkDeviceParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *DeleteNetworkDeviceParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of deleteNetworkDevice", k)
+		}
+	}
+	return nil
+}
+
 func (p *DeleteNetworkDeviceParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -112,18 +181,54 @@ func (p *DeleteNetworkDeviceParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *DeleteNetworkDeviceParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 // You should always use this function to get a new DeleteNetworkDeviceParams instance,
-// as then you are sure you have configured all required params
-func (s *NetworkDeviceService) NewDeleteNetworkDeviceParams(id string) *DeleteNetworkDeviceParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *NetworkDeviceService) NewDeleteNetworkDeviceParams(id string, opts ...func(*DeleteNetworkDeviceParams)) *DeleteNetworkDeviceParams {
 	p := &DeleteNetworkDeviceParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Deletes network device.
-func (s *NetworkDeviceService) DeleteNetworkDevice(p *DeleteNetworkDeviceParams) (*DeleteNetworkDeviceResponse, error) {
-	resp, err := s.cs.newRequest("deleteNetworkDevice", p.toURLValues())
+func (s *NetworkDeviceService) DeleteNetworkDevice(p *DeleteNetworkDeviceParams, opts ...OptionFunc) (*DeleteNetworkDeviceResponse, error) {
+	return s.DeleteNetworkDeviceWithContext(context.Background(), p, opts...)
+}
+
+// DeleteNetworkDeviceWithContext is the same as DeleteNetworkDevice, but the request is cancelled when the context is done
+func (s *NetworkDeviceService) DeleteNetworkDeviceWithContext(ctx context.Context, p *DeleteNetworkDeviceParams, opts ...OptionFunc) (*DeleteNetworkDeviceResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.DeleteNetworkDeviceRawWithContext(ctx, p.toURLValues())
+}
+
+// DeleteNetworkDeviceRaw is the same as DeleteNetworkDevice, but takes the params as url.Values instead of typed params
+func (s *NetworkDeviceService) DeleteNetworkDeviceRaw(v url.Values) (*DeleteNetworkDeviceResponse, error) {
+	return s.DeleteNetworkDeviceRawWithContext(context.Background(), v)
+}
+
+// DeleteNetworkDeviceRawWithContext is the same as DeleteNetworkDeviceRaw, but the request is cancelled when the context is done
+func (s *NetworkDeviceService) DeleteNetworkDeviceRawWithContext(ctx context.Context, v url.Values) (*DeleteNetworkDeviceResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteNetworkDevice", "deletenetworkdeviceresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -164,6 +269,16 @@ type ListNetworkDeviceParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ListNetworkDeviceParams) Clone() *ListNetworkDeviceParams {
+	c := &ListNetworkDeviceParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListNetworkDeviceParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -173,11 +288,10 @@ func (p *ListNetworkDeviceParams) toURLValues() url.Values {
 		u.Set("keyword", v.(string))
 	}
 	if v, found := p.p["networkdeviceparameterlist"]; found {
-		i := 0
-		for k, vv := range v.(map[string]string) {
+		m := v.(map[string]string)
+		for i, k := range getSortedKeysFromMap(m) {
 			u.Set(fmt.Sprintf("networkdeviceparameterlist[%d].key", i), k)
-			u.Set(fmt.Sprintf("networkdeviceparameterlist[%d].value", i), vv)
-			i++
+			u.Set(fmt.Sprintf("networkdeviceparameterlist[%d].value", i), m[k])
 		}
 	}
 	if v, found := p.p["networkdevicetype"]; found {
@@ -202,6 +316,13 @@ func (p *ListNetworkDeviceParams) SetKeyword(v string) {
 	return
 }
 
+// SetKeywordIfUnset sets keyword to v, unless it is already set
+func (p *ListNetworkDeviceParams) SetKeywordIfUnset(v string) {
+	if _, found := p.p["keyword"]; !found {
+		p.SetKeyword(v)
+	}
+}
+
 func (p *ListNetworkDeviceParams) SetNetworkdeviceparameterlist(v map[string]string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -210,6 +331,13 @@ func (p *ListNetworkDeviceParams) SetNetworkdeviceparameterlist(v map[string]str
 	return
 }
 
+// SetNetworkdeviceparameterlistIfUnset sets networkdeviceparameterlist to v, unless it is already set
+func (p *ListNetworkDeviceParams) SetNetworkdeviceparameterlistIfUnset(v map[string]string) {
+	if _, found := p.p["networkdeviceparameterlist"]; !found {
+		p.SetNetworkdeviceparameterlist(v)
+	}
+}
+
 func (p *ListNetworkDeviceParams) SetNetworkdevicetype(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -218,6 +346,13 @@ func (p *ListNetworkDeviceParams) SetNetworkdevicetype(v string) {
 	return
 }
 
+// SetNetworkdevicetypeIfUnset sets networkdevicetype to v, unless it is already set
+func (p *ListNetworkDeviceParams) SetNetworkdevicetypeIfUnset(v string) {
+	if _, found := p.p["networkdevicetype"]; !found {
+		p.SetNetworkdevicetype(v)
+	}
+}
+
 func (p *ListNetworkDeviceParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -226,6 +361,13 @@ func (p *ListNetworkDeviceParams) SetPage(v int) {
 	return
 }
 
+// SetPageIfUnset sets page to v, unless it is already set
+func (p *ListNetworkDeviceParams) SetPageIfUnset(v int) {
+	if _, found := p.p["page"]; !found {
+		p.SetPage(v)
+	}
+}
+
 func (p *ListNetworkDeviceParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -234,17 +376,43 @@ func (p *ListNetworkDeviceParams) SetPagesize(v int) {
 	return
 }
 
+// SetPagesizeIfUnset sets pagesize to v, unless it is already set
+func (p *ListNetworkDeviceParams) SetPagesizeIfUnset(v int) {
+	if _, found := p.p["pagesize"]; !found {
+		p.SetPagesize(v)
+	}
+}
+
 // You should always use this function to get a new ListNetworkDeviceParams instance,
-// as then you are sure you have configured all required params
-func (s *NetworkDeviceService) NewListNetworkDeviceParams() *ListNetworkDeviceParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *NetworkDeviceService) NewListNetworkDeviceParams(opts ...func(*ListNetworkDeviceParams)) *ListNetworkDeviceParams {
 	p := &ListNetworkDeviceParams{}
 	p.p = make(map[string]interface{})
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // List network devices
 func (s *NetworkDeviceService) ListNetworkDevice(p *ListNetworkDeviceParams) (*ListNetworkDeviceResponse, error) {
-	resp, err := s.cs.newRequest("listNetworkDevice", p.toURLValues())
+	return s.ListNetworkDeviceWithContext(context.Background(), p)
+}
+
+// ListNetworkDeviceWithContext is the same as ListNetworkDevice, but the request is cancelled when the context is done
+func (s *NetworkDeviceService) ListNetworkDeviceWithContext(ctx context.Context, p *ListNetworkDeviceParams) (*ListNetworkDeviceResponse, error) {
+	return s.ListNetworkDeviceRawWithContext(ctx, p.toURLValues())
+}
+
+// ListNetworkDeviceRaw is the same as ListNetworkDevice, but takes the params as url.Values instead of typed params
+func (s *NetworkDeviceService) ListNetworkDeviceRaw(v url.Values) (*ListNetworkDeviceResponse, error) {
+	return s.ListNetworkDeviceRawWithContext(context.Background(), v)
+}
+
+// ListNetworkDeviceRawWithContext is the same as ListNetworkDeviceRaw, but the request is cancelled when the context is done
+func (s *NetworkDeviceService) ListNetworkDeviceRawWithContext(ctx context.Context, v url.Values) (*ListNetworkDeviceResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "listNetworkDevice", "listnetworkdeviceresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -257,6 +425,93 @@ func (s *NetworkDeviceService) ListNetworkDevice(p *ListNetworkDeviceParams) (*L
 	return &r, nil
 }
 
+// StreamNetworkDevice fetches the results of ListNetworkDevice page by page in the background and sends them on the
+// returned channel. Both returned channels are closed when done. If fetching a page fails, or the context
+// is done before all results are sent, the error is sent on the error channel and fetching stops. A
+// request for a page in flight is cancelled when the context is done. The page and pagesize set in the
+// params are ignored.
+func (s *NetworkDeviceService) StreamNetworkDevice(ctx context.Context, p *ListNetworkDeviceParams) (<-chan *NetworkDevice, <-chan error) {
+	ch := make(chan *NetworkDevice)
+	errs := make(chan error, 1)
+
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesize(500)
+
+	go func() {
+		defer close(errs)
+		defer close(ch)
+
+		for page, count := 1, 0; ; page++ {
+			pp.SetPage(page)
+
+			l, err := s.ListNetworkDeviceWithContext(ctx, pp)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			for _, v := range l.NetworkDevice {
+				select {
+				case ch <- v:
+				case <-ctx.Done():
+					errs <- ctx.Err()
+					return
+				}
+			}
+
+			count += len(l.NetworkDevice)
+			if len(l.NetworkDevice) < 500 || count >= l.Count {
+				return
+			}
+		}
+	}()
+
+	return ch, errs
+}
+
+// ListNetworkDeviceWhere returns all results of ListNetworkDevice for which pred returns true. Note that the results are
+// filtered client-side, so all results matching the params are fetched first.
+// All pages are fetched, so the page and pagesize set in the params are ignored.
+func (s *NetworkDeviceService) ListNetworkDeviceWhere(p *ListNetworkDeviceParams, pred func(*NetworkDevice) bool) ([]*NetworkDevice, error) {
+	var r []*NetworkDevice
+	ch, errs := s.StreamNetworkDevice(context.Background(), p)
+	for v := range ch {
+		if pred(v) {
+			r = append(r, v)
+		}
+	}
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ListAllNetworkDevice returns the results of ListNetworkDevice across all pages, by calling ListNetworkDevice page by page until all
+// results are fetched. The pagesize set in the params is used for every page (500 if not set), and the
+// page set in the params is ignored.
+func (s *NetworkDeviceService) ListAllNetworkDevice(p *ListNetworkDeviceParams) ([]*NetworkDevice, error) {
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesizeIfUnset(500)
+	pagesize := pp.p["pagesize"].(int)
+
+	var r []*NetworkDevice
+	for page := 1; ; page++ {
+		pp.SetPage(page)
+
+		l, err := s.ListNetworkDevice(pp)
+		if err != nil {
+			return nil, err
+		}
+		r = append(r, l.NetworkDevice...)
+
+		if len(l.NetworkDevice) < pagesize || len(r) >= l.Count {
+			return r, nil
+		}
+	}
+}
+
 type ListNetworkDeviceResponse struct {
 	Count         int              `json:"count"`
 	NetworkDevice []*NetworkDevice `json:"networkdevice"`
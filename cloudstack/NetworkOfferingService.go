@@ -17,17 +17,67 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/url"
 	"strconv"
 	"strings"
+	"time"
 )
 
+// UpdateMany runs UpdateNetworkOffering for all given IDs in parallel, using at most concurrency concurrent requests.
+// For each ID the params are populated with the ID and then passed to mutate, which should set the
+// changes to apply. The result contains the updated resources (in the order of the IDs) and the error
+// of every update that failed by ID. The returned error contains all failures, or is nil if none failed.
+// The updated resources are returned as the UpdateNetworkOfferingResponse of every update, which contains the same
+// fields as the resource itself, so no additional request is needed per resource to get them. The
+// options are used for every update.
+func (s *NetworkOfferingService) UpdateMany(ids []string, mutate func(*UpdateNetworkOfferingParams), concurrency int, opts ...OptionFunc) (BulkResult[*UpdateNetworkOfferingResponse], error) {
+	rs := make([]*UpdateNetworkOfferingResponse, len(ids))
+	errs := make([]error, len(ids))
+
+	runConcurrently(len(ids), concurrency, func(i int) {
+		p := &UpdateNetworkOfferingParams{p: map[string]interface{}{"id": ids[i]}}
+		if mutate != nil {
+			mutate(p)
+		}
+
+		r, err := s.UpdateNetworkOffering(p, opts...)
+		if err != nil {
+			errs[i] = err
+			return
+		}
+		rs[i] = r
+	})
+
+	var r BulkResult[*UpdateNetworkOfferingResponse]
+	for i, id := range ids {
+		if errs[i] != nil {
+			r.fail(id, errs[i])
+			continue
+		}
+		r.Succeeded = append(r.Succeeded, rs[i])
+	}
+
+	return r, r.Err()
+}
+
 type CreateNetworkOfferingParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *CreateNetworkOfferingParams) Clone() *CreateNetworkOfferingParams {
+	c := &CreateNetworkOfferingParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *CreateNetworkOfferingParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -41,10 +91,9 @@ func (p *CreateNetworkOfferingParams) toURLValues() url.Values {
 		u.Set("conservemode", vv)
 	}
 	if v, found := p.p["details"]; found {
-		i := 0
-		for k, vv := range v.(map[string]string) {
-			u.Set(fmt.Sprintf("details[%d].%s", i, k), vv)
-			i++
+		m := v.(map[string]string)
+		for i, k := range getSortedKeysFromMap(m) {
+			u.Set(fmt.Sprintf("details[%d].%s", i, k), m[k])
 		}
 	}
 	if v, found := p.p["displaytext"]; found {
@@ -77,22 +126,20 @@ func (p *CreateNetworkOfferingParams) toURLValues() url.Values {
 		u.Set("networkrate", vv)
 	}
 	if v, found := p.p["servicecapabilitylist"]; found {
-		i := 0
-		for k, vv := range v.(map[string]string) {
+		m := v.(map[string]string)
+		for i, k := range getSortedKeysFromMap(m) {
 			u.Set(fmt.Sprintf("servicecapabilitylist[%d].key", i), k)
-			u.Set(fmt.Sprintf("servicecapabilitylist[%d].value", i), vv)
-			i++
+			u.Set(fmt.Sprintf("servicecapabilitylist[%d].value", i), m[k])
 		}
 	}
 	if v, found := p.p["serviceofferingid"]; found {
 		u.Set("serviceofferingid", v.(string))
 	}
 	if v, found := p.p["serviceproviderlist"]; found {
-		i := 0
-		for k, vv := range v.(map[string]string) {
+		m := v.(map[string]string)
+		for i, k := range getSortedKeysFromMap(m) {
 			u.Set(fmt.Sprintf("serviceproviderlist[%d].service", i), k)
-			u.Set(fmt.Sprintf("serviceproviderlist[%d].provider", i), vv)
-			i++
+			u.Set(fmt.Sprintf("serviceproviderlist[%d].provider", i), m[k])
 		}
 	}
 	if v, found := p.p["specifyipranges"]; found {
@@ -116,6 +163,16 @@ func (p *CreateNetworkOfferingParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *CreateNetworkOfferingParams) validate() error {
+	for _, k := range []string{"displaytext", "guestiptype", "name", "supportedservices", "traffictype"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of createNetworkOffering", k)
+		}
+	}
+	return nil
+}
+
 func (p *CreateNetworkOfferingParams) SetAvailability(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -124,6 +181,13 @@ func (p *CreateNetworkOfferingParams) SetAvailability(v string) {
 	return
 }
 
+// SetAvailabilityIfUnset sets availability to v, unless it is already set
+func (p *CreateNetworkOfferingParams) SetAvailabilityIfUnset(v string) {
+	if _, found := p.p["availability"]; !found {
+		p.SetAvailability(v)
+	}
+}
+
 func (p *CreateNetworkOfferingParams) SetConservemode(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -132,6 +196,13 @@ func (p *CreateNetworkOfferingParams) SetConservemode(v bool) {
 	return
 }
 
+// SetConservemodeIfUnset sets conservemode to v, unless it is already set
+func (p *CreateNetworkOfferingParams) SetConservemodeIfUnset(v bool) {
+	if _, found := p.p["conservemode"]; !found {
+		p.SetConservemode(v)
+	}
+}
+
 func (p *CreateNetworkOfferingParams) SetDetails(v map[string]string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -140,6 +211,13 @@ func (p *CreateNetworkOfferingParams) SetDetails(v map[string]string) {
 	return
 }
 
+// SetDetailsIfUnset sets details to v, unless it is already set
+func (p *CreateNetworkOfferingParams) SetDetailsIfUnset(v map[string]string) {
+	if _, found := p.p["details"]; !found {
+		p.SetDetails(v)
+	}
+}
+
 func (p *CreateNetworkOfferingParams) SetDisplaytext(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -148,6 +226,13 @@ func (p *CreateNetworkOfferingParams) SetDisplaytext(v string) {
 	return
 }
 
+// SetDisplaytextIfUnset sets displaytext to v, unless it is already set
+func (p *CreateNetworkOfferingParams) SetDisplaytextIfUnset(v string) {
+	if _, found := p.p["displaytext"]; !found {
+		p.SetDisplaytext(v)
+	}
+}
+
 func (p *CreateNetworkOfferingParams) SetEgressdefaultpolicy(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -156,6 +241,13 @@ func (p *CreateNetworkOfferingParams) SetEgressdefaultpolicy(v bool) {
 	return
 }
 
+// SetEgressdefaultpolicyIfUnset sets egressdefaultpolicy to v, unless it is already set
+func (p *CreateNetworkOfferingParams) SetEgressdefaultpolicyIfUnset(v bool) {
+	if _, found := p.p["egressdefaultpolicy"]; !found {
+		p.SetEgressdefaultpolicy(v)
+	}
+}
+
 func (p *CreateNetworkOfferingParams) SetGuestiptype(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -164,6 +256,13 @@ func (p *CreateNetworkOfferingParams) SetGuestiptype(v string) {
 	return
 }
 
+// SetGuestiptypeIfUnset sets guestiptype to v, unless it is already set
+func (p *CreateNetworkOfferingParams) SetGuestiptypeIfUnset(v string) {
+	if _, found := p.p["guestiptype"]; !found {
+		p.SetGuestiptype(v)
+	}
+}
+
 func (p *CreateNetworkOfferingParams) SetIspersistent(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -172,6 +271,13 @@ func (p *CreateNetworkOfferingParams) SetIspersistent(v bool) {
 	return
 }
 
+// SetIspersistentIfUnset sets ispersistent to v, unless it is already set
+func (p *CreateNetworkOfferingParams) SetIspersistentIfUnset(v bool) {
+	if _, found := p.p["ispersistent"]; !found {
+		p.SetIspersistent(v)
+	}
+}
+
 func (p *CreateNetworkOfferingParams) SetKeepaliveenabled(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -180,6 +286,13 @@ func (p *CreateNetworkOfferingParams) SetKeepaliveenabled(v bool) {
 	return
 }
 
+// SetKeepaliveenabledIfUnset sets keepaliveenabled to v, unless it is already set
+func (p *CreateNetworkOfferingParams) SetKeepaliveenabledIfUnset(v bool) {
+	if _, found := p.p["keepaliveenabled"]; !found {
+		p.SetKeepaliveenabled(v)
+	}
+}
+
 func (p *CreateNetworkOfferingParams) SetMaxconnections(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -188,6 +301,13 @@ func (p *CreateNetworkOfferingParams) SetMaxconnections(v int) {
 	return
 }
 
+// SetMaxconnectionsIfUnset sets maxconnections to v, unless it is already set
+func (p *CreateNetworkOfferingParams) SetMaxconnectionsIfUnset(v int) {
+	if _, found := p.p["maxconnections"]; !found {
+		p.SetMaxconnections(v)
+	}
+}
+
 func (p *CreateNetworkOfferingParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -196,6 +316,13 @@ func (p *CreateNetworkOfferingParams) SetName(v string) {
 	return
 }
 
+// SetNameIfUnset sets name to v, unless it is already set
+func (p *CreateNetworkOfferingParams) SetNameIfUnset(v string) {
+	if _, found := p.p["name"]; !found {
+		p.SetName(v)
+	}
+}
+
 func (p *CreateNetworkOfferingParams) SetNetworkrate(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -204,6 +331,13 @@ func (p *CreateNetworkOfferingParams) SetNetworkrate(v int) {
 	return
 }
 
+// SetNetworkrateIfUnset sets networkrate to v, unless it is already set
+func (p *CreateNetworkOfferingParams) SetNetworkrateIfUnset(v int) {
+	if _, found := p.p["networkrate"]; !found {
+		p.SetNetworkrate(v)
+	}
+}
+
 func (p *CreateNetworkOfferingParams) SetServicecapabilitylist(v map[string]string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -212,6 +346,13 @@ func (p *CreateNetworkOfferingParams) SetServicecapabilitylist(v map[string]stri
 	return
 }
 
+// SetServicecapabilitylistIfUnset sets servicecapabilitylist to v, unless it is already set
+func (p *CreateNetworkOfferingParams) SetServicecapabilitylistIfUnset(v map[string]string) {
+	if _, found := p.p["servicecapabilitylist"]; !found {
+		p.SetServicecapabilitylist(v)
+	}
+}
+
 func (p *CreateNetworkOfferingParams) SetServiceofferingid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -220,6 +361,13 @@ func (p *CreateNetworkOfferingParams) SetServiceofferingid(v string) {
 	return
 }
 
+// SetServiceofferingidIfUnset sets serviceofferingid to v, unless it is already set
+func (p *CreateNetworkOfferingParams) SetServiceofferingidIfUnset(v string) {
+	if _, found := p.p["serviceofferingid"]; !found {
+		p.SetServiceofferingid(v)
+	}
+}
+
 func (p *CreateNetworkOfferingParams) SetServiceproviderlist(v map[string]string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -228,6 +376,13 @@ func (p *CreateNetworkOfferingParams) SetServiceproviderlist(v map[string]string
 	return
 }
 
+// SetServiceproviderlistIfUnset sets serviceproviderlist to v, unless it is already set
+func (p *CreateNetworkOfferingParams) SetServiceproviderlistIfUnset(v map[string]string) {
+	if _, found := p.p["serviceproviderlist"]; !found {
+		p.SetServiceproviderlist(v)
+	}
+}
+
 func (p *CreateNetworkOfferingParams) SetSpecifyipranges(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -236,6 +391,13 @@ func (p *CreateNetworkOfferingParams) SetSpecifyipranges(v bool) {
 	return
 }
 
+// SetSpecifyiprangesIfUnset sets specifyipranges to v, unless it is already set
+func (p *CreateNetworkOfferingParams) SetSpecifyiprangesIfUnset(v bool) {
+	if _, found := p.p["specifyipranges"]; !found {
+		p.SetSpecifyipranges(v)
+	}
+}
+
 func (p *CreateNetworkOfferingParams) SetSpecifyvlan(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -244,6 +406,13 @@ func (p *CreateNetworkOfferingParams) SetSpecifyvlan(v bool) {
 	return
 }
 
+// SetSpecifyvlanIfUnset sets specifyvlan to v, unless it is already set
+func (p *CreateNetworkOfferingParams) SetSpecifyvlanIfUnset(v bool) {
+	if _, found := p.p["specifyvlan"]; !found {
+		p.SetSpecifyvlan(v)
+	}
+}
+
 func (p *CreateNetworkOfferingParams) SetSupportedservices(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -252,6 +421,18 @@ func (p *CreateNetworkOfferingParams) SetSupportedservices(v []string) {
 	return
 }
 
+// SetSupportedservicesIfUnset sets supportedservices to v, unless it is already set
+func (p *CreateNetworkOfferingParams) SetSupportedservicesIfUnset(v []string) {
+	if _, found := p.p["supportedservices"]; !found {
+		p.SetSupportedservices(v)
+	}
+}
+
+// SetSupportedservicesCSV splits v on commas and sets the resulting values
+func (p *CreateNetworkOfferingParams) SetSupportedservicesCSV(v string) {
+	p.SetSupportedservices(splitCSV(v))
+}
+
 func (p *CreateNetworkOfferingParams) SetTags(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -260,6 +441,13 @@ func (p *CreateNetworkOfferingParams) SetTags(v string) {
 	return
 }
 
+// SetTagsIfUnset sets tags to v, unless it is already set
+func (p *CreateNetworkOfferingParams) SetTagsIfUnset(v string) {
+	if _, found := p.p["tags"]; !found {
+		p.SetTags(v)
+	}
+}
+
 func (p *CreateNetworkOfferingParams) SetTraffictype(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -268,9 +456,17 @@ func (p *CreateNetworkOfferingParams) SetTraffictype(v string) {
 	return
 }
 
+// SetTraffictypeIfUnset sets traffictype to v, unless it is already set
+func (p *CreateNetworkOfferingParams) SetTraffictypeIfUnset(v string) {
+	if _, found := p.p["traffictype"]; !found {
+		p.SetTraffictype(v)
+	}
+}
+
 // You should always use this function to get a new CreateNetworkOfferingParams instance,
-// as then you are sure you have configured all required params
-func (s *NetworkOfferingService) NewCreateNetworkOfferingParams(displaytext string, guestiptype string, name string, supportedservices []string, traffictype string) *CreateNetworkOfferingParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *NetworkOfferingService) NewCreateNetworkOfferingParams(displaytext string, guestiptype string, name string, supportedservices []string, traffictype string, opts ...func(*CreateNetworkOfferingParams)) *CreateNetworkOfferingParams {
 	p := &CreateNetworkOfferingParams{}
 	p.p = make(map[string]interface{})
 	p.p["displaytext"] = displaytext
@@ -278,17 +474,118 @@ func (s *NetworkOfferingService) NewCreateNetworkOfferingParams(displaytext stri
 	p.p["name"] = name
 	p.p["supportedservices"] = supportedservices
 	p.p["traffictype"] = traffictype
+	for _, fn := range opts {
+		fn(p)
+	}
+	return p
+}
+
+// CreateNetworkOfferingOpts contains all params that can be set on a CreateNetworkOfferingParams.
+// Optional params are pointers (or slices and maps) which are only set when not nil.
+type CreateNetworkOfferingOpts struct {
+	Availability          *string
+	Conservemode          *bool
+	Details               map[string]string
+	Displaytext           string
+	Egressdefaultpolicy   *bool
+	Guestiptype           string
+	Ispersistent          *bool
+	Keepaliveenabled      *bool
+	Maxconnections        *int
+	Name                  string
+	Networkrate           *int
+	Servicecapabilitylist map[string]string
+	Serviceofferingid     *string
+	Serviceproviderlist   map[string]string
+	Specifyipranges       *bool
+	Specifyvlan           *bool
+	Supportedservices     []string
+	Tags                  *string
+	Traffictype           string
+}
+
+// NewCreateNetworkOfferingParamsFromOpts is an alternative for NewCreateNetworkOfferingParams which takes all params using
+// an options struct, as that is easier to read for APIs with many required params
+func (s *NetworkOfferingService) NewCreateNetworkOfferingParamsFromOpts(o CreateNetworkOfferingOpts) *CreateNetworkOfferingParams {
+	p := s.NewCreateNetworkOfferingParams(o.Displaytext, o.Guestiptype, o.Name, o.Supportedservices, o.Traffictype)
+	if o.Availability != nil {
+		p.p["availability"] = *o.Availability
+	}
+	if o.Conservemode != nil {
+		p.p["conservemode"] = *o.Conservemode
+	}
+	if o.Details != nil {
+		p.p["details"] = o.Details
+	}
+	if o.Egressdefaultpolicy != nil {
+		p.p["egressdefaultpolicy"] = *o.Egressdefaultpolicy
+	}
+	if o.Ispersistent != nil {
+		p.p["ispersistent"] = *o.Ispersistent
+	}
+	if o.Keepaliveenabled != nil {
+		p.p["keepaliveenabled"] = *o.Keepaliveenabled
+	}
+	if o.Maxconnections != nil {
+		p.p["maxconnections"] = *o.Maxconnections
+	}
+	if o.Networkrate != nil {
+		p.p["networkrate"] = *o.Networkrate
+	}
+	if o.Servicecapabilitylist != nil {
+		p.p["servicecapabilitylist"] = o.Servicecapabilitylist
+	}
+	if o.Serviceofferingid != nil {
+		p.p["serviceofferingid"] = *o.Serviceofferingid
+	}
+	if o.Serviceproviderlist != nil {
+		p.p["serviceproviderlist"] = o.Serviceproviderlist
+	}
+	if o.Specifyipranges != nil {
+		p.p["specifyipranges"] = *o.Specifyipranges
+	}
+	if o.Specifyvlan != nil {
+		p.p["specifyvlan"] = *o.Specifyvlan
+	}
+	if o.Tags != nil {
+		p.p["tags"] = *o.Tags
+	}
 	return p
 }
 
 // Creates a network offering.
-func (s *NetworkOfferingService) CreateNetworkOffering(p *CreateNetworkOfferingParams) (*CreateNetworkOfferingResponse, error) {
-	resp, err := s.cs.newRequest("createNetworkOffering", p.toURLValues())
+func (s *NetworkOfferingService) CreateNetworkOffering(p *CreateNetworkOfferingParams, opts ...OptionFunc) (*CreateNetworkOfferingResponse, error) {
+	return s.CreateNetworkOfferingWithContext(context.Background(), p, opts...)
+}
+
+// CreateNetworkOfferingWithContext is the same as CreateNetworkOffering, but the request is cancelled when the context is done
+func (s *NetworkOfferingService) CreateNetworkOfferingWithContext(ctx context.Context, p *CreateNetworkOfferingParams, opts ...OptionFunc) (*CreateNetworkOfferingResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.CreateNetworkOfferingRawWithContext(ctx, p.toURLValues())
+}
+
+// CreateNetworkOfferingRaw is the same as CreateNetworkOffering, but takes the params as url.Values instead of typed params
+func (s *NetworkOfferingService) CreateNetworkOfferingRaw(v url.Values) (*CreateNetworkOfferingResponse, error) {
+	return s.CreateNetworkOfferingRawWithContext(context.Background(), v)
+}
+
+// CreateNetworkOfferingRawWithContext is the same as CreateNetworkOfferingRaw, but the request is cancelled when the context is done
+func (s *NetworkOfferingService) CreateNetworkOfferingRawWithContext(ctx context.Context, v url.Values) (*CreateNetworkOfferingResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "createNetworkOffering", "createnetworkofferingresponse", v)
 	if err != nil {
 		return nil, err
 	}
 
-	if resp, err = getRawValue(resp); err != nil {
+	if resp, err = getRawValueByKey(resp, "networkoffering"); err != nil {
 		return nil, err
 	}
 
@@ -301,20 +598,20 @@ func (s *NetworkOfferingService) CreateNetworkOffering(p *CreateNetworkOfferingP
 }
 
 type CreateNetworkOfferingResponse struct {
-	Availability        string            `json:"availability"`
-	Conservemode        bool              `json:"conservemode"`
-	Created             string            `json:"created"`
-	Details             map[string]string `json:"details"`
-	Displaytext         string            `json:"displaytext"`
-	Egressdefaultpolicy bool              `json:"egressdefaultpolicy"`
-	Forvpc              bool              `json:"forvpc"`
-	Guestiptype         string            `json:"guestiptype"`
-	Id                  string            `json:"id"`
-	Isdefault           bool              `json:"isdefault"`
-	Ispersistent        bool              `json:"ispersistent"`
-	Maxconnections      int               `json:"maxconnections"`
-	Name                string            `json:"name"`
-	Networkrate         int               `json:"networkrate"`
+	Availability        string    `json:"availability"`
+	Conservemode        bool      `json:"conservemode"`
+	Created             string    `json:"created"`
+	Details             StringMap `json:"details"`
+	Displaytext         string    `json:"displaytext"`
+	Egressdefaultpolicy bool      `json:"egressdefaultpolicy"`
+	Forvpc              bool      `json:"forvpc"`
+	Guestiptype         string    `json:"guestiptype"`
+	Id                  string    `json:"id"`
+	Isdefault           bool      `json:"isdefault"`
+	Ispersistent        bool      `json:"ispersistent"`
+	Maxconnections      int       `json:"maxconnections"`
+	Name                string    `json:"name"`
+	Networkrate         int       `json:"networkrate"`
 	Service             []struct {
 		Capability []struct {
 			Canchooseservicecapability bool   `json:"canchooseservicecapability"`
@@ -345,6 +642,16 @@ type DeleteNetworkOfferingParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *DeleteNetworkOfferingParams) Clone() *DeleteNetworkOfferingParams {
+	c := &DeleteNetworkOfferingParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeleteNetworkOfferingParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -356,6 +663,16 @@ func (p *DeleteNetworkOfferingParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *DeleteNetworkOfferingParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of deleteNetworkOffering", k)
+		}
+	}
+	return nil
+}
+
 func (p *DeleteNetworkOfferingParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -364,18 +681,54 @@ func (p *DeleteNetworkOfferingParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *DeleteNetworkOfferingParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 // You should always use this function to get a new DeleteNetworkOfferingParams instance,
-// as then you are sure you have configured all required params
-func (s *NetworkOfferingService) NewDeleteNetworkOfferingParams(id string) *DeleteNetworkOfferingParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *NetworkOfferingService) NewDeleteNetworkOfferingParams(id string, opts ...func(*DeleteNetworkOfferingParams)) *DeleteNetworkOfferingParams {
 	p := &DeleteNetworkOfferingParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Deletes a network offering.
-func (s *NetworkOfferingService) DeleteNetworkOffering(p *DeleteNetworkOfferingParams) (*DeleteNetworkOfferingResponse, error) {
-	resp, err := s.cs.newRequest("deleteNetworkOffering", p.toURLValues())
+func (s *NetworkOfferingService) DeleteNetworkOffering(p *DeleteNetworkOfferingParams, opts ...OptionFunc) (*DeleteNetworkOfferingResponse, error) {
+	return s.DeleteNetworkOfferingWithContext(context.Background(), p, opts...)
+}
+
+// DeleteNetworkOfferingWithContext is the same as DeleteNetworkOffering, but the request is cancelled when the context is done
+func (s *NetworkOfferingService) DeleteNetworkOfferingWithContext(ctx context.Context, p *DeleteNetworkOfferingParams, opts ...OptionFunc) (*DeleteNetworkOfferingResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.DeleteNetworkOfferingRawWithContext(ctx, p.toURLValues())
+}
+
+// DeleteNetworkOfferingRaw is the same as DeleteNetworkOffering, but takes the params as url.Values instead of typed params
+func (s *NetworkOfferingService) DeleteNetworkOfferingRaw(v url.Values) (*DeleteNetworkOfferingResponse, error) {
+	return s.DeleteNetworkOfferingRawWithContext(context.Background(), v)
+}
+
+// DeleteNetworkOfferingRawWithContext is the same as DeleteNetworkOfferingRaw, but the request is cancelled when the context is done
+func (s *NetworkOfferingService) DeleteNetworkOfferingRawWithContext(ctx context.Context, v url.Values) (*DeleteNetworkOfferingResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteNetworkOffering", "deletenetworkofferingresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -388,6 +741,34 @@ func (s *NetworkOfferingService) DeleteNetworkOffering(p *DeleteNetworkOfferingP
 	return &r, nil
 }
 
+// WaitForNetworkOfferingDeleted polls until the NetworkOffering with the given ID no longer exists, the timeout
+// expires or the context is cancelled. Deleted resources may still be listed for a short while
+// after the delete command finished, so this can be used to make sure they are really gone. The
+// options are passed to GetNetworkOfferingByID, so a resource outside of the default scope can be found.
+func (s *NetworkOfferingService) WaitForNetworkOfferingDeleted(ctx context.Context, id string, timeout time.Duration, opts ...OptionFunc) error {
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	for {
+		_, count, err := s.GetNetworkOfferingByID(id, opts...)
+		if count == 0 {
+			return nil
+		}
+		if count < 0 {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(2 * time.Second):
+		}
+	}
+}
+
 type DeleteNetworkOfferingResponse struct {
 	Displaytext string `json:"displaytext"`
 	Success     bool   `json:"success"`
@@ -416,6 +797,16 @@ type ListNetworkOfferingsParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ListNetworkOfferingsParams) Clone() *ListNetworkOfferingsParams {
+	c := &ListNetworkOfferingsParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListNetworkOfferingsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -501,6 +892,13 @@ func (p *ListNetworkOfferingsParams) SetAvailability(v string) {
 	return
 }
 
+// SetAvailabilityIfUnset sets availability to v, unless it is already set
+func (p *ListNetworkOfferingsParams) SetAvailabilityIfUnset(v string) {
+	if _, found := p.p["availability"]; !found {
+		p.SetAvailability(v)
+	}
+}
+
 func (p *ListNetworkOfferingsParams) SetDisplaytext(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -509,6 +907,13 @@ func (p *ListNetworkOfferingsParams) SetDisplaytext(v string) {
 	return
 }
 
+// SetDisplaytextIfUnset sets displaytext to v, unless it is already set
+func (p *ListNetworkOfferingsParams) SetDisplaytextIfUnset(v string) {
+	if _, found := p.p["displaytext"]; !found {
+		p.SetDisplaytext(v)
+	}
+}
+
 func (p *ListNetworkOfferingsParams) SetForvpc(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -517,6 +922,13 @@ func (p *ListNetworkOfferingsParams) SetForvpc(v bool) {
 	return
 }
 
+// SetForvpcIfUnset sets forvpc to v, unless it is already set
+func (p *ListNetworkOfferingsParams) SetForvpcIfUnset(v bool) {
+	if _, found := p.p["forvpc"]; !found {
+		p.SetForvpc(v)
+	}
+}
+
 func (p *ListNetworkOfferingsParams) SetGuestiptype(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -525,6 +937,13 @@ func (p *ListNetworkOfferingsParams) SetGuestiptype(v string) {
 	return
 }
 
+// SetGuestiptypeIfUnset sets guestiptype to v, unless it is already set
+func (p *ListNetworkOfferingsParams) SetGuestiptypeIfUnset(v string) {
+	if _, found := p.p["guestiptype"]; !found {
+		p.SetGuestiptype(v)
+	}
+}
+
 func (p *ListNetworkOfferingsParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -533,6 +952,13 @@ func (p *ListNetworkOfferingsParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *ListNetworkOfferingsParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 func (p *ListNetworkOfferingsParams) SetIsdefault(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -541,6 +967,13 @@ func (p *ListNetworkOfferingsParams) SetIsdefault(v bool) {
 	return
 }
 
+// SetIsdefaultIfUnset sets isdefault to v, unless it is already set
+func (p *ListNetworkOfferingsParams) SetIsdefaultIfUnset(v bool) {
+	if _, found := p.p["isdefault"]; !found {
+		p.SetIsdefault(v)
+	}
+}
+
 func (p *ListNetworkOfferingsParams) SetIstagged(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -549,6 +982,13 @@ func (p *ListNetworkOfferingsParams) SetIstagged(v bool) {
 	return
 }
 
+// SetIstaggedIfUnset sets istagged to v, unless it is already set
+func (p *ListNetworkOfferingsParams) SetIstaggedIfUnset(v bool) {
+	if _, found := p.p["istagged"]; !found {
+		p.SetIstagged(v)
+	}
+}
+
 func (p *ListNetworkOfferingsParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -557,6 +997,13 @@ func (p *ListNetworkOfferingsParams) SetKeyword(v string) {
 	return
 }
 
+// SetKeywordIfUnset sets keyword to v, unless it is already set
+func (p *ListNetworkOfferingsParams) SetKeywordIfUnset(v string) {
+	if _, found := p.p["keyword"]; !found {
+		p.SetKeyword(v)
+	}
+}
+
 func (p *ListNetworkOfferingsParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -565,6 +1012,13 @@ func (p *ListNetworkOfferingsParams) SetName(v string) {
 	return
 }
 
+// SetNameIfUnset sets name to v, unless it is already set
+func (p *ListNetworkOfferingsParams) SetNameIfUnset(v string) {
+	if _, found := p.p["name"]; !found {
+		p.SetName(v)
+	}
+}
+
 func (p *ListNetworkOfferingsParams) SetNetworkid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -573,6 +1027,13 @@ func (p *ListNetworkOfferingsParams) SetNetworkid(v string) {
 	return
 }
 
+// SetNetworkidIfUnset sets networkid to v, unless it is already set
+func (p *ListNetworkOfferingsParams) SetNetworkidIfUnset(v string) {
+	if _, found := p.p["networkid"]; !found {
+		p.SetNetworkid(v)
+	}
+}
+
 func (p *ListNetworkOfferingsParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -581,6 +1042,13 @@ func (p *ListNetworkOfferingsParams) SetPage(v int) {
 	return
 }
 
+// SetPageIfUnset sets page to v, unless it is already set
+func (p *ListNetworkOfferingsParams) SetPageIfUnset(v int) {
+	if _, found := p.p["page"]; !found {
+		p.SetPage(v)
+	}
+}
+
 func (p *ListNetworkOfferingsParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -589,6 +1057,13 @@ func (p *ListNetworkOfferingsParams) SetPagesize(v int) {
 	return
 }
 
+// SetPagesizeIfUnset sets pagesize to v, unless it is already set
+func (p *ListNetworkOfferingsParams) SetPagesizeIfUnset(v int) {
+	if _, found := p.p["pagesize"]; !found {
+		p.SetPagesize(v)
+	}
+}
+
 func (p *ListNetworkOfferingsParams) SetSourcenatsupported(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -597,6 +1072,13 @@ func (p *ListNetworkOfferingsParams) SetSourcenatsupported(v bool) {
 	return
 }
 
+// SetSourcenatsupportedIfUnset sets sourcenatsupported to v, unless it is already set
+func (p *ListNetworkOfferingsParams) SetSourcenatsupportedIfUnset(v bool) {
+	if _, found := p.p["sourcenatsupported"]; !found {
+		p.SetSourcenatsupported(v)
+	}
+}
+
 func (p *ListNetworkOfferingsParams) SetSpecifyipranges(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -605,6 +1087,13 @@ func (p *ListNetworkOfferingsParams) SetSpecifyipranges(v bool) {
 	return
 }
 
+// SetSpecifyiprangesIfUnset sets specifyipranges to v, unless it is already set
+func (p *ListNetworkOfferingsParams) SetSpecifyiprangesIfUnset(v bool) {
+	if _, found := p.p["specifyipranges"]; !found {
+		p.SetSpecifyipranges(v)
+	}
+}
+
 func (p *ListNetworkOfferingsParams) SetSpecifyvlan(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -613,6 +1102,13 @@ func (p *ListNetworkOfferingsParams) SetSpecifyvlan(v bool) {
 	return
 }
 
+// SetSpecifyvlanIfUnset sets specifyvlan to v, unless it is already set
+func (p *ListNetworkOfferingsParams) SetSpecifyvlanIfUnset(v bool) {
+	if _, found := p.p["specifyvlan"]; !found {
+		p.SetSpecifyvlan(v)
+	}
+}
+
 func (p *ListNetworkOfferingsParams) SetState(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -621,6 +1117,13 @@ func (p *ListNetworkOfferingsParams) SetState(v string) {
 	return
 }
 
+// SetStateIfUnset sets state to v, unless it is already set
+func (p *ListNetworkOfferingsParams) SetStateIfUnset(v string) {
+	if _, found := p.p["state"]; !found {
+		p.SetState(v)
+	}
+}
+
 func (p *ListNetworkOfferingsParams) SetSupportedservices(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -629,6 +1132,18 @@ func (p *ListNetworkOfferingsParams) SetSupportedservices(v []string) {
 	return
 }
 
+// SetSupportedservicesIfUnset sets supportedservices to v, unless it is already set
+func (p *ListNetworkOfferingsParams) SetSupportedservicesIfUnset(v []string) {
+	if _, found := p.p["supportedservices"]; !found {
+		p.SetSupportedservices(v)
+	}
+}
+
+// SetSupportedservicesCSV splits v on commas and sets the resulting values
+func (p *ListNetworkOfferingsParams) SetSupportedservicesCSV(v string) {
+	p.SetSupportedservices(splitCSV(v))
+}
+
 func (p *ListNetworkOfferingsParams) SetTags(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -637,6 +1152,13 @@ func (p *ListNetworkOfferingsParams) SetTags(v string) {
 	return
 }
 
+// SetTagsIfUnset sets tags to v, unless it is already set
+func (p *ListNetworkOfferingsParams) SetTagsIfUnset(v string) {
+	if _, found := p.p["tags"]; !found {
+		p.SetTags(v)
+	}
+}
+
 func (p *ListNetworkOfferingsParams) SetTraffictype(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -645,6 +1167,13 @@ func (p *ListNetworkOfferingsParams) SetTraffictype(v string) {
 	return
 }
 
+// SetTraffictypeIfUnset sets traffictype to v, unless it is already set
+func (p *ListNetworkOfferingsParams) SetTraffictypeIfUnset(v string) {
+	if _, found := p.p["traffictype"]; !found {
+		p.SetTraffictype(v)
+	}
+}
+
 func (p *ListNetworkOfferingsParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -653,14 +1182,32 @@ func (p *ListNetworkOfferingsParams) SetZoneid(v string) {
 	return
 }
 
+// SetZoneidIfUnset sets zoneid to v, unless it is already set
+func (p *ListNetworkOfferingsParams) SetZoneidIfUnset(v string) {
+	if _, found := p.p["zoneid"]; !found {
+		p.SetZoneid(v)
+	}
+}
+
 // You should always use this function to get a new ListNetworkOfferingsParams instance,
-// as then you are sure you have configured all required params
-func (s *NetworkOfferingService) NewListNetworkOfferingsParams() *ListNetworkOfferingsParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *NetworkOfferingService) NewListNetworkOfferingsParams(opts ...func(*ListNetworkOfferingsParams)) *ListNetworkOfferingsParams {
 	p := &ListNetworkOfferingsParams{}
 	p.p = make(map[string]interface{})
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
+func (p *ListNetworkOfferingsParams) setFirstMatch() {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p[firstMatchKey] = true
+}
+
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *NetworkOfferingService) GetNetworkOfferingID(name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListNetworkOfferingsParams{}
@@ -668,7 +1215,7 @@ func (s *NetworkOfferingService) GetNetworkOfferingID(name string, opts ...Optio
 
 	p.p["name"] = name
 
-	for _, fn := range append(s.cs.options, opts...) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
 		if err := fn(s.cs, p); err != nil {
 			return "", -1, err
 		}
@@ -680,7 +1227,7 @@ func (s *NetworkOfferingService) GetNetworkOfferingID(name string, opts ...Optio
 	}
 
 	if l.Count == 0 {
-		return "", l.Count, fmt.Errorf("No match found for %s: %+v", name, l)
+		return "", l.Count, fmt.Errorf("%w for %s: %+v", ErrNotFound, name, l)
 	}
 
 	if l.Count == 1 {
@@ -693,6 +1240,10 @@ func (s *NetworkOfferingService) GetNetworkOfferingID(name string, opts ...Optio
 				return v.Id, l.Count, nil
 			}
 		}
+
+		if _, ok := p.p[firstMatchKey]; ok && len(l.NetworkOfferings) > 0 {
+			return l.NetworkOfferings[0].Id, l.Count, nil
+		}
 	}
 	return "", l.Count, fmt.Errorf("Could not find an exact match for %s: %+v", name, l)
 }
@@ -718,7 +1269,7 @@ func (s *NetworkOfferingService) GetNetworkOfferingByID(id string, opts ...Optio
 
 	p.p["id"] = id
 
-	for _, fn := range append(s.cs.options, opts...) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
 		if err := fn(s.cs, p); err != nil {
 			return nil, -1, err
 		}
@@ -729,24 +1280,56 @@ func (s *NetworkOfferingService) GetNetworkOfferingByID(id string, opts ...Optio
 		if strings.Contains(err.Error(), fmt.Sprintf(
 			"Invalid parameter id value=%s due to incorrect long value format, "+
 				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+			return nil, 0, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
 	}
 
 	if l.Count == 1 {
 		return l.NetworkOfferings[0], l.Count, nil
 	}
+
+	if _, ok := p.p[firstMatchKey]; ok && len(l.NetworkOfferings) > 0 {
+		return l.NetworkOfferings[0], l.Count, nil
+	}
 	return nil, l.Count, fmt.Errorf("There is more then one result for NetworkOffering UUID: %s!", id)
 }
 
+// NetworkOfferingExists returns true if a NetworkOffering with the given ID exists. When no match is found
+// it returns false, while any other error is returned as is.
+func (s *NetworkOfferingService) NetworkOfferingExists(id string, opts ...OptionFunc) (bool, error) {
+	_, count, err := s.GetNetworkOfferingByID(id, opts...)
+	if errors.Is(err, ErrNotFound) {
+		return false, nil
+	}
+	if count > 0 {
+		return true, nil
+	}
+	return false, err
+}
+
 // Lists all available network offerings.
 func (s *NetworkOfferingService) ListNetworkOfferings(p *ListNetworkOfferingsParams) (*ListNetworkOfferingsResponse, error) {
-	resp, err := s.cs.newRequest("listNetworkOfferings", p.toURLValues())
+	return s.ListNetworkOfferingsWithContext(context.Background(), p)
+}
+
+// ListNetworkOfferingsWithContext is the same as ListNetworkOfferings, but the request is cancelled when the context is done
+func (s *NetworkOfferingService) ListNetworkOfferingsWithContext(ctx context.Context, p *ListNetworkOfferingsParams) (*ListNetworkOfferingsResponse, error) {
+	return s.ListNetworkOfferingsRawWithContext(ctx, p.toURLValues())
+}
+
+// ListNetworkOfferingsRaw is the same as ListNetworkOfferings, but takes the params as url.Values instead of typed params
+func (s *NetworkOfferingService) ListNetworkOfferingsRaw(v url.Values) (*ListNetworkOfferingsResponse, error) {
+	return s.ListNetworkOfferingsRawWithContext(context.Background(), v)
+}
+
+// ListNetworkOfferingsRawWithContext is the same as ListNetworkOfferingsRaw, but the request is cancelled when the context is done
+func (s *NetworkOfferingService) ListNetworkOfferingsRawWithContext(ctx context.Context, v url.Values) (*ListNetworkOfferingsResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "listNetworkOfferings", "listnetworkofferingsresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -759,26 +1342,113 @@ func (s *NetworkOfferingService) ListNetworkOfferings(p *ListNetworkOfferingsPar
 	return &r, nil
 }
 
+// StreamNetworkOfferings fetches the results of ListNetworkOfferings page by page in the background and sends them on the
+// returned channel. Both returned channels are closed when done. If fetching a page fails, or the context
+// is done before all results are sent, the error is sent on the error channel and fetching stops. A
+// request for a page in flight is cancelled when the context is done. The page and pagesize set in the
+// params are ignored.
+func (s *NetworkOfferingService) StreamNetworkOfferings(ctx context.Context, p *ListNetworkOfferingsParams) (<-chan *NetworkOffering, <-chan error) {
+	ch := make(chan *NetworkOffering)
+	errs := make(chan error, 1)
+
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesize(500)
+
+	go func() {
+		defer close(errs)
+		defer close(ch)
+
+		for page, count := 1, 0; ; page++ {
+			pp.SetPage(page)
+
+			l, err := s.ListNetworkOfferingsWithContext(ctx, pp)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			for _, v := range l.NetworkOfferings {
+				select {
+				case ch <- v:
+				case <-ctx.Done():
+					errs <- ctx.Err()
+					return
+				}
+			}
+
+			count += len(l.NetworkOfferings)
+			if len(l.NetworkOfferings) < 500 || count >= l.Count {
+				return
+			}
+		}
+	}()
+
+	return ch, errs
+}
+
+// ListNetworkOfferingsWhere returns all results of ListNetworkOfferings for which pred returns true. Note that the results are
+// filtered client-side, so all results matching the params are fetched first.
+// All pages are fetched, so the page and pagesize set in the params are ignored.
+func (s *NetworkOfferingService) ListNetworkOfferingsWhere(p *ListNetworkOfferingsParams, pred func(*NetworkOffering) bool) ([]*NetworkOffering, error) {
+	var r []*NetworkOffering
+	ch, errs := s.StreamNetworkOfferings(context.Background(), p)
+	for v := range ch {
+		if pred(v) {
+			r = append(r, v)
+		}
+	}
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ListAllNetworkOfferings returns the results of ListNetworkOfferings across all pages, by calling ListNetworkOfferings page by page until all
+// results are fetched. The pagesize set in the params is used for every page (500 if not set), and the
+// page set in the params is ignored.
+func (s *NetworkOfferingService) ListAllNetworkOfferings(p *ListNetworkOfferingsParams) ([]*NetworkOffering, error) {
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesizeIfUnset(500)
+	pagesize := pp.p["pagesize"].(int)
+
+	var r []*NetworkOffering
+	for page := 1; ; page++ {
+		pp.SetPage(page)
+
+		l, err := s.ListNetworkOfferings(pp)
+		if err != nil {
+			return nil, err
+		}
+		r = append(r, l.NetworkOfferings...)
+
+		if len(l.NetworkOfferings) < pagesize || len(r) >= l.Count {
+			return r, nil
+		}
+	}
+}
+
 type ListNetworkOfferingsResponse struct {
 	Count            int                `json:"count"`
 	NetworkOfferings []*NetworkOffering `json:"networkoffering"`
 }
 
 type NetworkOffering struct {
-	Availability        string            `json:"availability"`
-	Conservemode        bool              `json:"conservemode"`
-	Created             string            `json:"created"`
-	Details             map[string]string `json:"details"`
-	Displaytext         string            `json:"displaytext"`
-	Egressdefaultpolicy bool              `json:"egressdefaultpolicy"`
-	Forvpc              bool              `json:"forvpc"`
-	Guestiptype         string            `json:"guestiptype"`
-	Id                  string            `json:"id"`
-	Isdefault           bool              `json:"isdefault"`
-	Ispersistent        bool              `json:"ispersistent"`
-	Maxconnections      int               `json:"maxconnections"`
-	Name                string            `json:"name"`
-	Networkrate         int               `json:"networkrate"`
+	Availability        string    `json:"availability"`
+	Conservemode        bool      `json:"conservemode"`
+	Created             string    `json:"created"`
+	Details             StringMap `json:"details"`
+	Displaytext         string    `json:"displaytext"`
+	Egressdefaultpolicy bool      `json:"egressdefaultpolicy"`
+	Forvpc              bool      `json:"forvpc"`
+	Guestiptype         string    `json:"guestiptype"`
+	Id                  string    `json:"id"`
+	Isdefault           bool      `json:"isdefault"`
+	Ispersistent        bool      `json:"ispersistent"`
+	Maxconnections      int       `json:"maxconnections"`
+	Name                string    `json:"name"`
+	Networkrate         int       `json:"networkrate"`
 	Service             []struct {
 		Capability []struct {
 			Canchooseservicecapability bool   `json:"canchooseservicecapability"`
@@ -809,6 +1479,16 @@ type UpdateNetworkOfferingParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *UpdateNetworkOfferingParams) Clone() *UpdateNetworkOfferingParams {
+	c := &UpdateNetworkOfferingParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *UpdateNetworkOfferingParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -852,6 +1532,13 @@ func (p *UpdateNetworkOfferingParams) SetAvailability(v string) {
 	return
 }
 
+// SetAvailabilityIfUnset sets availability to v, unless it is already set
+func (p *UpdateNetworkOfferingParams) SetAvailabilityIfUnset(v string) {
+	if _, found := p.p["availability"]; !found {
+		p.SetAvailability(v)
+	}
+}
+
 func (p *UpdateNetworkOfferingParams) SetDisplaytext(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -860,6 +1547,13 @@ func (p *UpdateNetworkOfferingParams) SetDisplaytext(v string) {
 	return
 }
 
+// SetDisplaytextIfUnset sets displaytext to v, unless it is already set
+func (p *UpdateNetworkOfferingParams) SetDisplaytextIfUnset(v string) {
+	if _, found := p.p["displaytext"]; !found {
+		p.SetDisplaytext(v)
+	}
+}
+
 func (p *UpdateNetworkOfferingParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -868,6 +1562,13 @@ func (p *UpdateNetworkOfferingParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *UpdateNetworkOfferingParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 func (p *UpdateNetworkOfferingParams) SetKeepaliveenabled(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -876,6 +1577,13 @@ func (p *UpdateNetworkOfferingParams) SetKeepaliveenabled(v bool) {
 	return
 }
 
+// SetKeepaliveenabledIfUnset sets keepaliveenabled to v, unless it is already set
+func (p *UpdateNetworkOfferingParams) SetKeepaliveenabledIfUnset(v bool) {
+	if _, found := p.p["keepaliveenabled"]; !found {
+		p.SetKeepaliveenabled(v)
+	}
+}
+
 func (p *UpdateNetworkOfferingParams) SetMaxconnections(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -884,6 +1592,13 @@ func (p *UpdateNetworkOfferingParams) SetMaxconnections(v int) {
 	return
 }
 
+// SetMaxconnectionsIfUnset sets maxconnections to v, unless it is already set
+func (p *UpdateNetworkOfferingParams) SetMaxconnectionsIfUnset(v int) {
+	if _, found := p.p["maxconnections"]; !found {
+		p.SetMaxconnections(v)
+	}
+}
+
 func (p *UpdateNetworkOfferingParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -892,6 +1607,13 @@ func (p *UpdateNetworkOfferingParams) SetName(v string) {
 	return
 }
 
+// SetNameIfUnset sets name to v, unless it is already set
+func (p *UpdateNetworkOfferingParams) SetNameIfUnset(v string) {
+	if _, found := p.p["name"]; !found {
+		p.SetName(v)
+	}
+}
+
 func (p *UpdateNetworkOfferingParams) SetSortkey(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -900,6 +1622,13 @@ func (p *UpdateNetworkOfferingParams) SetSortkey(v int) {
 	return
 }
 
+// SetSortkeyIfUnset sets sortkey to v, unless it is already set
+func (p *UpdateNetworkOfferingParams) SetSortkeyIfUnset(v int) {
+	if _, found := p.p["sortkey"]; !found {
+		p.SetSortkey(v)
+	}
+}
+
 func (p *UpdateNetworkOfferingParams) SetState(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -908,17 +1637,49 @@ func (p *UpdateNetworkOfferingParams) SetState(v string) {
 	return
 }
 
+// SetStateIfUnset sets state to v, unless it is already set
+func (p *UpdateNetworkOfferingParams) SetStateIfUnset(v string) {
+	if _, found := p.p["state"]; !found {
+		p.SetState(v)
+	}
+}
+
 // You should always use this function to get a new UpdateNetworkOfferingParams instance,
-// as then you are sure you have configured all required params
-func (s *NetworkOfferingService) NewUpdateNetworkOfferingParams() *UpdateNetworkOfferingParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *NetworkOfferingService) NewUpdateNetworkOfferingParams(opts ...func(*UpdateNetworkOfferingParams)) *UpdateNetworkOfferingParams {
 	p := &UpdateNetworkOfferingParams{}
 	p.p = make(map[string]interface{})
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Updates a network offering.
-func (s *NetworkOfferingService) UpdateNetworkOffering(p *UpdateNetworkOfferingParams) (*UpdateNetworkOfferingResponse, error) {
-	resp, err := s.cs.newRequest("updateNetworkOffering", p.toURLValues())
+func (s *NetworkOfferingService) UpdateNetworkOffering(p *UpdateNetworkOfferingParams, opts ...OptionFunc) (*UpdateNetworkOfferingResponse, error) {
+	return s.UpdateNetworkOfferingWithContext(context.Background(), p, opts...)
+}
+
+// UpdateNetworkOfferingWithContext is the same as UpdateNetworkOffering, but the request is cancelled when the context is done
+func (s *NetworkOfferingService) UpdateNetworkOfferingWithContext(ctx context.Context, p *UpdateNetworkOfferingParams, opts ...OptionFunc) (*UpdateNetworkOfferingResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	return s.UpdateNetworkOfferingRawWithContext(ctx, p.toURLValues())
+}
+
+// UpdateNetworkOfferingRaw is the same as UpdateNetworkOffering, but takes the params as url.Values instead of typed params
+func (s *NetworkOfferingService) UpdateNetworkOfferingRaw(v url.Values) (*UpdateNetworkOfferingResponse, error) {
+	return s.UpdateNetworkOfferingRawWithContext(context.Background(), v)
+}
+
+// UpdateNetworkOfferingRawWithContext is the same as UpdateNetworkOfferingRaw, but the request is cancelled when the context is done
+func (s *NetworkOfferingService) UpdateNetworkOfferingRawWithContext(ctx context.Context, v url.Values) (*UpdateNetworkOfferingResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "updateNetworkOffering", "updatenetworkofferingresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -932,20 +1693,20 @@ func (s *NetworkOfferingService) UpdateNetworkOffering(p *UpdateNetworkOfferingP
 }
 
 type UpdateNetworkOfferingResponse struct {
-	Availability        string            `json:"availability"`
-	Conservemode        bool              `json:"conservemode"`
-	Created             string            `json:"created"`
-	Details             map[string]string `json:"details"`
-	Displaytext         string            `json:"displaytext"`
-	Egressdefaultpolicy bool              `json:"egressdefaultpolicy"`
-	Forvpc              bool              `json:"forvpc"`
-	Guestiptype         string            `json:"guestiptype"`
-	Id                  string            `json:"id"`
-	Isdefault           bool              `json:"isdefault"`
-	Ispersistent        bool              `json:"ispersistent"`
-	Maxconnections      int               `json:"maxconnections"`
-	Name                string            `json:"name"`
-	Networkrate         int               `json:"networkrate"`
+	Availability        string    `json:"availability"`
+	Conservemode        bool      `json:"conservemode"`
+	Created             string    `json:"created"`
+	Details             StringMap `json:"details"`
+	Displaytext         string    `json:"displaytext"`
+	Egressdefaultpolicy bool      `json:"egressdefaultpolicy"`
+	Forvpc              bool      `json:"forvpc"`
+	Guestiptype         string    `json:"guestiptype"`
+	Id                  string    `json:"id"`
+	Isdefault           bool      `json:"isdefault"`
+	Ispersistent        bool      `json:"ispersistent"`
+	Maxconnections      int       `json:"maxconnections"`
+	Name                string    `json:"name"`
+	Networkrate         int       `json:"networkrate"`
 	Service             []struct {
 		Capability []struct {
 			Canchooseservicecapability bool   `json:"canchooseservicecapability"`
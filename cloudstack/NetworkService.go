@@ -17,17 +17,140 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/url"
 	"strconv"
 	"strings"
+	"time"
 )
 
+// ErrRouterFailed is returned (wrapped) by CreateNetworkAndWaitImplemented when a virtual router of the
+// network failed to deploy
+var ErrRouterFailed = errors.New("Virtual router failed")
+
+// CreateNetworkAndWaitImplemented creates the network and polls until its state is Implemented (or Setup,
+// the final state of networks without a virtual router) or the timeout expires (a timeout of 0 means no
+// timeout), so VMs can be deployed into it right away. When a virtual router of the network ends up in the
+// Error state, the network is returned together with an ErrRouterFailed error. Note that a non-persistent
+// isolated network is only implemented when the first VM is deployed into it, so the network offering
+// should be persistent. The options are used when getting the network and listing its routers.
+func (s *NetworkService) CreateNetworkAndWaitImplemented(p *CreateNetworkParams, timeout time.Duration, opts ...OptionFunc) (*Network, error) {
+	ctx := context.Background()
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	r, err := s.CreateNetwork(p)
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		network, _, err := s.GetNetworkByID(r.Id, opts...)
+		if err != nil {
+			return nil, err
+		}
+		switch network.State {
+		case "Implemented", "Setup":
+			return network, nil
+		}
+
+		rp := s.cs.Router.NewListRoutersParams()
+		rp.SetNetworkid(r.Id)
+		for _, fn := range s.cs.withDefaultOptions(opts) {
+			if err := fn(s.cs, rp); err != nil {
+				return nil, err
+			}
+		}
+		l, err := s.cs.Router.ListRouters(rp)
+		if err != nil {
+			return nil, err
+		}
+		for _, router := range l.Routers {
+			if router.State == "Error" {
+				return network, fmt.Errorf("%w: router %s of network %s is in state %s", ErrRouterFailed, router.Id, r.Id, router.State)
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return network, fmt.Errorf("Timeout waiting for network %s to be implemented, last state was %s: %w", r.Id, network.State, ctx.Err())
+		case <-time.After(2 * time.Second):
+		}
+	}
+}
+
+// UpdateMany runs UpdateNetwork for all given IDs in parallel, using at most concurrency concurrent requests.
+// For each ID the params are populated with the ID and then passed to mutate, which should set the
+// changes to apply. The result contains the updated resources (in the order of the IDs) and the error
+// of every update that failed by ID. The returned error contains all failures, or is nil if none failed.
+// The updated resources are returned as the UpdateNetworkResponse of every update, which contains the same
+// fields as the resource itself, so no additional request is needed per resource to get them. The
+// options are used for every update.
+func (s *NetworkService) UpdateMany(ids []string, mutate func(*UpdateNetworkParams), concurrency int, opts ...OptionFunc) (BulkResult[*UpdateNetworkResponse], error) {
+	rs := make([]*UpdateNetworkResponse, len(ids))
+	errs := make([]error, len(ids))
+
+	runConcurrently(len(ids), concurrency, func(i int) {
+		p := &UpdateNetworkParams{p: map[string]interface{}{"id": ids[i]}}
+		if mutate != nil {
+			mutate(p)
+		}
+
+		r, err := s.UpdateNetwork(p, opts...)
+		if err != nil {
+			errs[i] = err
+			return
+		}
+
+		// An async client already waited for the job to finish
+		if !s.cs.async {
+			b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+			if err == nil {
+				b, err = getRawValue(b)
+			}
+			if err == nil {
+				err = json.Unmarshal(b, r)
+			}
+			if err != nil {
+				errs[i] = err
+				return
+			}
+		}
+		rs[i] = r
+	})
+
+	var r BulkResult[*UpdateNetworkResponse]
+	for i, id := range ids {
+		if errs[i] != nil {
+			r.fail(id, errs[i])
+			continue
+		}
+		r.Succeeded = append(r.Succeeded, rs[i])
+	}
+
+	return r, r.Err()
+}
+
 type AddNetworkServiceProviderParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *AddNetworkServiceProviderParams) Clone() *AddNetworkServiceProviderParams {
+	c := &AddNetworkServiceProviderParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *AddNetworkServiceProviderParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -49,6 +172,16 @@ func (p *AddNetworkServiceProviderParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *AddNetworkServiceProviderParams) validate() error {
+	for _, k := range []string{"name", "physicalnetworkid"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of addNetworkServiceProvider", k)
+		}
+	}
+	return nil
+}
+
 func (p *AddNetworkServiceProviderParams) SetDestinationphysicalnetworkid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -57,6 +190,13 @@ func (p *AddNetworkServiceProviderParams) SetDestinationphysicalnetworkid(v stri
 	return
 }
 
+// SetDestinationphysicalnetworkidIfUnset sets destinationphysicalnetworkid to v, unless it is already set
+func (p *AddNetworkServiceProviderParams) SetDestinationphysicalnetworkidIfUnset(v string) {
+	if _, found := p.p["destinationphysicalnetworkid"]; !found {
+		p.SetDestinationphysicalnetworkid(v)
+	}
+}
+
 func (p *AddNetworkServiceProviderParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -65,6 +205,13 @@ func (p *AddNetworkServiceProviderParams) SetName(v string) {
 	return
 }
 
+// SetNameIfUnset sets name to v, unless it is already set
+func (p *AddNetworkServiceProviderParams) SetNameIfUnset(v string) {
+	if _, found := p.p["name"]; !found {
+		p.SetName(v)
+	}
+}
+
 func (p *AddNetworkServiceProviderParams) SetPhysicalnetworkid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -73,6 +220,13 @@ func (p *AddNetworkServiceProviderParams) SetPhysicalnetworkid(v string) {
 	return
 }
 
+// SetPhysicalnetworkidIfUnset sets physicalnetworkid to v, unless it is already set
+func (p *AddNetworkServiceProviderParams) SetPhysicalnetworkidIfUnset(v string) {
+	if _, found := p.p["physicalnetworkid"]; !found {
+		p.SetPhysicalnetworkid(v)
+	}
+}
+
 func (p *AddNetworkServiceProviderParams) SetServicelist(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -81,19 +235,60 @@ func (p *AddNetworkServiceProviderParams) SetServicelist(v []string) {
 	return
 }
 
+// SetServicelistIfUnset sets servicelist to v, unless it is already set
+func (p *AddNetworkServiceProviderParams) SetServicelistIfUnset(v []string) {
+	if _, found := p.p["servicelist"]; !found {
+		p.SetServicelist(v)
+	}
+}
+
+// SetServicelistCSV splits v on commas and sets the resulting values
+func (p *AddNetworkServiceProviderParams) SetServicelistCSV(v string) {
+	p.SetServicelist(splitCSV(v))
+}
+
 // You should always use this function to get a new AddNetworkServiceProviderParams instance,
-// as then you are sure you have configured all required params
-func (s *NetworkService) NewAddNetworkServiceProviderParams(name string, physicalnetworkid string) *AddNetworkServiceProviderParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *NetworkService) NewAddNetworkServiceProviderParams(name string, physicalnetworkid string, opts ...func(*AddNetworkServiceProviderParams)) *AddNetworkServiceProviderParams {
 	p := &AddNetworkServiceProviderParams{}
 	p.p = make(map[string]interface{})
 	p.p["name"] = name
 	p.p["physicalnetworkid"] = physicalnetworkid
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Adds a network serviceProvider to a physical network
-func (s *NetworkService) AddNetworkServiceProvider(p *AddNetworkServiceProviderParams) (*AddNetworkServiceProviderResponse, error) {
-	resp, err := s.cs.newRequest("addNetworkServiceProvider", p.toURLValues())
+func (s *NetworkService) AddNetworkServiceProvider(p *AddNetworkServiceProviderParams, opts ...OptionFunc) (*AddNetworkServiceProviderResponse, error) {
+	return s.AddNetworkServiceProviderWithContext(context.Background(), p, opts...)
+}
+
+// AddNetworkServiceProviderWithContext is the same as AddNetworkServiceProvider, but the request is cancelled when the context is done
+func (s *NetworkService) AddNetworkServiceProviderWithContext(ctx context.Context, p *AddNetworkServiceProviderParams, opts ...OptionFunc) (*AddNetworkServiceProviderResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.AddNetworkServiceProviderRawWithContext(ctx, p.toURLValues())
+}
+
+// AddNetworkServiceProviderRaw is the same as AddNetworkServiceProvider, but takes the params as url.Values instead of typed params
+func (s *NetworkService) AddNetworkServiceProviderRaw(v url.Values) (*AddNetworkServiceProviderResponse, error) {
+	return s.AddNetworkServiceProviderRawWithContext(context.Background(), v)
+}
+
+// AddNetworkServiceProviderRawWithContext is the same as AddNetworkServiceProviderRaw, but the request is cancelled when the context is done
+func (s *NetworkService) AddNetworkServiceProviderRawWithContext(ctx context.Context, v url.Values) (*AddNetworkServiceProviderResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "addNetworkServiceProvider", "addnetworkserviceproviderresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -105,9 +300,9 @@ func (s *NetworkService) AddNetworkServiceProvider(p *AddNetworkServiceProviderP
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -141,6 +336,16 @@ type AddOpenDaylightControllerParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *AddOpenDaylightControllerParams) Clone() *AddOpenDaylightControllerParams {
+	c := &AddOpenDaylightControllerParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *AddOpenDaylightControllerParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -161,6 +366,16 @@ func (p *AddOpenDaylightControllerParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *AddOpenDaylightControllerParams) validate() error {
+	for _, k := range []string{"password", "physicalnetworkid", "url", "username"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of addOpenDaylightController", k)
+		}
+	}
+	return nil
+}
+
 func (p *AddOpenDaylightControllerParams) SetPassword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -169,6 +384,13 @@ func (p *AddOpenDaylightControllerParams) SetPassword(v string) {
 	return
 }
 
+// SetPasswordIfUnset sets password to v, unless it is already set
+func (p *AddOpenDaylightControllerParams) SetPasswordIfUnset(v string) {
+	if _, found := p.p["password"]; !found {
+		p.SetPassword(v)
+	}
+}
+
 func (p *AddOpenDaylightControllerParams) SetPhysicalnetworkid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -177,6 +399,13 @@ func (p *AddOpenDaylightControllerParams) SetPhysicalnetworkid(v string) {
 	return
 }
 
+// SetPhysicalnetworkidIfUnset sets physicalnetworkid to v, unless it is already set
+func (p *AddOpenDaylightControllerParams) SetPhysicalnetworkidIfUnset(v string) {
+	if _, found := p.p["physicalnetworkid"]; !found {
+		p.SetPhysicalnetworkid(v)
+	}
+}
+
 func (p *AddOpenDaylightControllerParams) SetUrl(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -185,6 +414,13 @@ func (p *AddOpenDaylightControllerParams) SetUrl(v string) {
 	return
 }
 
+// SetUrlIfUnset sets url to v, unless it is already set
+func (p *AddOpenDaylightControllerParams) SetUrlIfUnset(v string) {
+	if _, found := p.p["url"]; !found {
+		p.SetUrl(v)
+	}
+}
+
 func (p *AddOpenDaylightControllerParams) SetUsername(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -193,21 +429,73 @@ func (p *AddOpenDaylightControllerParams) SetUsername(v string) {
 	return
 }
 
+// SetUsernameIfUnset sets username to v, unless it is already set
+func (p *AddOpenDaylightControllerParams) SetUsernameIfUnset(v string) {
+	if _, found := p.p["username"]; !found {
+		p.SetUsername(v)
+	}
+}
+
 // You should always use this function to get a new AddOpenDaylightControllerParams instance,
-// as then you are sure you have configured all required params
-func (s *NetworkService) NewAddOpenDaylightControllerParams(password string, physicalnetworkid string, url string, username string) *AddOpenDaylightControllerParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *NetworkService) NewAddOpenDaylightControllerParams(password string, physicalnetworkid string, url string, username string, opts ...func(*AddOpenDaylightControllerParams)) *AddOpenDaylightControllerParams {
 	p := &AddOpenDaylightControllerParams{}
 	p.p = make(map[string]interface{})
 	p.p["password"] = password
 	p.p["physicalnetworkid"] = physicalnetworkid
 	p.p["url"] = url
 	p.p["username"] = username
+	for _, fn := range opts {
+		fn(p)
+	}
+	return p
+}
+
+// AddOpenDaylightControllerOpts contains all params that can be set on a AddOpenDaylightControllerParams.
+// Optional params are pointers (or slices and maps) which are only set when not nil.
+type AddOpenDaylightControllerOpts struct {
+	Password          string
+	Physicalnetworkid string
+	Url               string
+	Username          string
+}
+
+// NewAddOpenDaylightControllerParamsFromOpts is an alternative for NewAddOpenDaylightControllerParams which takes all params using
+// an options struct, as that is easier to read for APIs with many required params
+func (s *NetworkService) NewAddOpenDaylightControllerParamsFromOpts(o AddOpenDaylightControllerOpts) *AddOpenDaylightControllerParams {
+	p := s.NewAddOpenDaylightControllerParams(o.Password, o.Physicalnetworkid, o.Url, o.Username)
 	return p
 }
 
 // Adds an OpenDyalight controler
-func (s *NetworkService) AddOpenDaylightController(p *AddOpenDaylightControllerParams) (*AddOpenDaylightControllerResponse, error) {
-	resp, err := s.cs.newRequest("addOpenDaylightController", p.toURLValues())
+func (s *NetworkService) AddOpenDaylightController(p *AddOpenDaylightControllerParams, opts ...OptionFunc) (*AddOpenDaylightControllerResponse, error) {
+	return s.AddOpenDaylightControllerWithContext(context.Background(), p, opts...)
+}
+
+// AddOpenDaylightControllerWithContext is the same as AddOpenDaylightController, but the request is cancelled when the context is done
+func (s *NetworkService) AddOpenDaylightControllerWithContext(ctx context.Context, p *AddOpenDaylightControllerParams, opts ...OptionFunc) (*AddOpenDaylightControllerResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.AddOpenDaylightControllerRawWithContext(ctx, p.toURLValues())
+}
+
+// AddOpenDaylightControllerRaw is the same as AddOpenDaylightController, but takes the params as url.Values instead of typed params
+func (s *NetworkService) AddOpenDaylightControllerRaw(v url.Values) (*AddOpenDaylightControllerResponse, error) {
+	return s.AddOpenDaylightControllerRawWithContext(context.Background(), v)
+}
+
+// AddOpenDaylightControllerRawWithContext is the same as AddOpenDaylightControllerRaw, but the request is cancelled when the context is done
+func (s *NetworkService) AddOpenDaylightControllerRawWithContext(ctx context.Context, v url.Values) (*AddOpenDaylightControllerResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "addOpenDaylightController", "addopendaylightcontrollerresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -219,9 +507,9 @@ func (s *NetworkService) AddOpenDaylightController(p *AddOpenDaylightControllerP
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -253,6 +541,16 @@ type CreateNetworkParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *CreateNetworkParams) Clone() *CreateNetworkParams {
+	c := &CreateNetworkParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *CreateNetworkParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -335,6 +633,16 @@ func (p *CreateNetworkParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *CreateNetworkParams) validate() error {
+	for _, k := range []string{"displaytext", "name", "networkofferingid", "zoneid"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of createNetwork", k)
+		}
+	}
+	return nil
+}
+
 func (p *CreateNetworkParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -343,6 +651,13 @@ func (p *CreateNetworkParams) SetAccount(v string) {
 	return
 }
 
+// SetAccountIfUnset sets account to v, unless it is already set
+func (p *CreateNetworkParams) SetAccountIfUnset(v string) {
+	if _, found := p.p["account"]; !found {
+		p.SetAccount(v)
+	}
+}
+
 func (p *CreateNetworkParams) SetAclid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -351,14 +666,28 @@ func (p *CreateNetworkParams) SetAclid(v string) {
 	return
 }
 
-func (p *CreateNetworkParams) SetAcltype(v string) {
+// SetAclidIfUnset sets aclid to v, unless it is already set
+func (p *CreateNetworkParams) SetAclidIfUnset(v string) {
+	if _, found := p.p["aclid"]; !found {
+		p.SetAclid(v)
+	}
+}
+
+func (p *CreateNetworkParams) SetAcltype(v ACLType) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	p.p["acltype"] = v
+	p.p["acltype"] = string(v)
 	return
 }
 
+// SetAcltypeIfUnset sets acltype to v, unless it is already set
+func (p *CreateNetworkParams) SetAcltypeIfUnset(v ACLType) {
+	if _, found := p.p["acltype"]; !found {
+		p.SetAcltype(v)
+	}
+}
+
 func (p *CreateNetworkParams) SetDisplaynetwork(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -367,6 +696,13 @@ func (p *CreateNetworkParams) SetDisplaynetwork(v bool) {
 	return
 }
 
+// SetDisplaynetworkIfUnset sets displaynetwork to v, unless it is already set
+func (p *CreateNetworkParams) SetDisplaynetworkIfUnset(v bool) {
+	if _, found := p.p["displaynetwork"]; !found {
+		p.SetDisplaynetwork(v)
+	}
+}
+
 func (p *CreateNetworkParams) SetDisplaytext(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -375,6 +711,13 @@ func (p *CreateNetworkParams) SetDisplaytext(v string) {
 	return
 }
 
+// SetDisplaytextIfUnset sets displaytext to v, unless it is already set
+func (p *CreateNetworkParams) SetDisplaytextIfUnset(v string) {
+	if _, found := p.p["displaytext"]; !found {
+		p.SetDisplaytext(v)
+	}
+}
+
 func (p *CreateNetworkParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -383,6 +726,13 @@ func (p *CreateNetworkParams) SetDomainid(v string) {
 	return
 }
 
+// SetDomainidIfUnset sets domainid to v, unless it is already set
+func (p *CreateNetworkParams) SetDomainidIfUnset(v string) {
+	if _, found := p.p["domainid"]; !found {
+		p.SetDomainid(v)
+	}
+}
+
 func (p *CreateNetworkParams) SetEndip(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -391,6 +741,13 @@ func (p *CreateNetworkParams) SetEndip(v string) {
 	return
 }
 
+// SetEndipIfUnset sets endip to v, unless it is already set
+func (p *CreateNetworkParams) SetEndipIfUnset(v string) {
+	if _, found := p.p["endip"]; !found {
+		p.SetEndip(v)
+	}
+}
+
 func (p *CreateNetworkParams) SetEndipv6(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -399,6 +756,13 @@ func (p *CreateNetworkParams) SetEndipv6(v string) {
 	return
 }
 
+// SetEndipv6IfUnset sets endipv6 to v, unless it is already set
+func (p *CreateNetworkParams) SetEndipv6IfUnset(v string) {
+	if _, found := p.p["endipv6"]; !found {
+		p.SetEndipv6(v)
+	}
+}
+
 func (p *CreateNetworkParams) SetGateway(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -407,6 +771,13 @@ func (p *CreateNetworkParams) SetGateway(v string) {
 	return
 }
 
+// SetGatewayIfUnset sets gateway to v, unless it is already set
+func (p *CreateNetworkParams) SetGatewayIfUnset(v string) {
+	if _, found := p.p["gateway"]; !found {
+		p.SetGateway(v)
+	}
+}
+
 func (p *CreateNetworkParams) SetIp6cidr(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -415,6 +786,13 @@ func (p *CreateNetworkParams) SetIp6cidr(v string) {
 	return
 }
 
+// SetIp6cidrIfUnset sets ip6cidr to v, unless it is already set
+func (p *CreateNetworkParams) SetIp6cidrIfUnset(v string) {
+	if _, found := p.p["ip6cidr"]; !found {
+		p.SetIp6cidr(v)
+	}
+}
+
 func (p *CreateNetworkParams) SetIp6gateway(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -423,6 +801,13 @@ func (p *CreateNetworkParams) SetIp6gateway(v string) {
 	return
 }
 
+// SetIp6gatewayIfUnset sets ip6gateway to v, unless it is already set
+func (p *CreateNetworkParams) SetIp6gatewayIfUnset(v string) {
+	if _, found := p.p["ip6gateway"]; !found {
+		p.SetIp6gateway(v)
+	}
+}
+
 func (p *CreateNetworkParams) SetIsolatedpvlan(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -431,6 +816,13 @@ func (p *CreateNetworkParams) SetIsolatedpvlan(v string) {
 	return
 }
 
+// SetIsolatedpvlanIfUnset sets isolatedpvlan to v, unless it is already set
+func (p *CreateNetworkParams) SetIsolatedpvlanIfUnset(v string) {
+	if _, found := p.p["isolatedpvlan"]; !found {
+		p.SetIsolatedpvlan(v)
+	}
+}
+
 func (p *CreateNetworkParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -439,6 +831,13 @@ func (p *CreateNetworkParams) SetName(v string) {
 	return
 }
 
+// SetNameIfUnset sets name to v, unless it is already set
+func (p *CreateNetworkParams) SetNameIfUnset(v string) {
+	if _, found := p.p["name"]; !found {
+		p.SetName(v)
+	}
+}
+
 func (p *CreateNetworkParams) SetNetmask(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -447,6 +846,13 @@ func (p *CreateNetworkParams) SetNetmask(v string) {
 	return
 }
 
+// SetNetmaskIfUnset sets netmask to v, unless it is already set
+func (p *CreateNetworkParams) SetNetmaskIfUnset(v string) {
+	if _, found := p.p["netmask"]; !found {
+		p.SetNetmask(v)
+	}
+}
+
 func (p *CreateNetworkParams) SetNetworkdomain(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -455,6 +861,13 @@ func (p *CreateNetworkParams) SetNetworkdomain(v string) {
 	return
 }
 
+// SetNetworkdomainIfUnset sets networkdomain to v, unless it is already set
+func (p *CreateNetworkParams) SetNetworkdomainIfUnset(v string) {
+	if _, found := p.p["networkdomain"]; !found {
+		p.SetNetworkdomain(v)
+	}
+}
+
 func (p *CreateNetworkParams) SetNetworkofferingid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -463,6 +876,13 @@ func (p *CreateNetworkParams) SetNetworkofferingid(v string) {
 	return
 }
 
+// SetNetworkofferingidIfUnset sets networkofferingid to v, unless it is already set
+func (p *CreateNetworkParams) SetNetworkofferingidIfUnset(v string) {
+	if _, found := p.p["networkofferingid"]; !found {
+		p.SetNetworkofferingid(v)
+	}
+}
+
 func (p *CreateNetworkParams) SetPhysicalnetworkid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -471,6 +891,13 @@ func (p *CreateNetworkParams) SetPhysicalnetworkid(v string) {
 	return
 }
 
+// SetPhysicalnetworkidIfUnset sets physicalnetworkid to v, unless it is already set
+func (p *CreateNetworkParams) SetPhysicalnetworkidIfUnset(v string) {
+	if _, found := p.p["physicalnetworkid"]; !found {
+		p.SetPhysicalnetworkid(v)
+	}
+}
+
 func (p *CreateNetworkParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -479,6 +906,13 @@ func (p *CreateNetworkParams) SetProjectid(v string) {
 	return
 }
 
+// SetProjectidIfUnset sets projectid to v, unless it is already set
+func (p *CreateNetworkParams) SetProjectidIfUnset(v string) {
+	if _, found := p.p["projectid"]; !found {
+		p.SetProjectid(v)
+	}
+}
+
 func (p *CreateNetworkParams) SetStartip(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -487,6 +921,13 @@ func (p *CreateNetworkParams) SetStartip(v string) {
 	return
 }
 
+// SetStartipIfUnset sets startip to v, unless it is already set
+func (p *CreateNetworkParams) SetStartipIfUnset(v string) {
+	if _, found := p.p["startip"]; !found {
+		p.SetStartip(v)
+	}
+}
+
 func (p *CreateNetworkParams) SetStartipv6(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -495,6 +936,13 @@ func (p *CreateNetworkParams) SetStartipv6(v string) {
 	return
 }
 
+// SetStartipv6IfUnset sets startipv6 to v, unless it is already set
+func (p *CreateNetworkParams) SetStartipv6IfUnset(v string) {
+	if _, found := p.p["startipv6"]; !found {
+		p.SetStartipv6(v)
+	}
+}
+
 func (p *CreateNetworkParams) SetSubdomainaccess(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -503,6 +951,13 @@ func (p *CreateNetworkParams) SetSubdomainaccess(v bool) {
 	return
 }
 
+// SetSubdomainaccessIfUnset sets subdomainaccess to v, unless it is already set
+func (p *CreateNetworkParams) SetSubdomainaccessIfUnset(v bool) {
+	if _, found := p.p["subdomainaccess"]; !found {
+		p.SetSubdomainaccess(v)
+	}
+}
+
 func (p *CreateNetworkParams) SetVlan(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -511,6 +966,13 @@ func (p *CreateNetworkParams) SetVlan(v string) {
 	return
 }
 
+// SetVlanIfUnset sets vlan to v, unless it is already set
+func (p *CreateNetworkParams) SetVlanIfUnset(v string) {
+	if _, found := p.p["vlan"]; !found {
+		p.SetVlan(v)
+	}
+}
+
 func (p *CreateNetworkParams) SetVpcid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -519,6 +981,13 @@ func (p *CreateNetworkParams) SetVpcid(v string) {
 	return
 }
 
+// SetVpcidIfUnset sets vpcid to v, unless it is already set
+func (p *CreateNetworkParams) SetVpcidIfUnset(v string) {
+	if _, found := p.p["vpcid"]; !found {
+		p.SetVpcid(v)
+	}
+}
+
 func (p *CreateNetworkParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -527,26 +996,158 @@ func (p *CreateNetworkParams) SetZoneid(v string) {
 	return
 }
 
+// SetZoneidIfUnset sets zoneid to v, unless it is already set
+func (p *CreateNetworkParams) SetZoneidIfUnset(v string) {
+	if _, found := p.p["zoneid"]; !found {
+		p.SetZoneid(v)
+	}
+}
+
 // You should always use this function to get a new CreateNetworkParams instance,
-// as then you are sure you have configured all required params
-func (s *NetworkService) NewCreateNetworkParams(displaytext string, name string, networkofferingid string, zoneid string) *CreateNetworkParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *NetworkService) NewCreateNetworkParams(displaytext string, name string, networkofferingid string, zoneid string, opts ...func(*CreateNetworkParams)) *CreateNetworkParams {
 	p := &CreateNetworkParams{}
 	p.p = make(map[string]interface{})
 	p.p["displaytext"] = displaytext
 	p.p["name"] = name
 	p.p["networkofferingid"] = networkofferingid
 	p.p["zoneid"] = zoneid
+	for _, fn := range opts {
+		fn(p)
+	}
+	return p
+}
+
+// CreateNetworkOpts contains all params that can be set on a CreateNetworkParams.
+// Optional params are pointers (or slices and maps) which are only set when not nil.
+type CreateNetworkOpts struct {
+	Account           *string
+	Aclid             *string
+	Acltype           *ACLType
+	Displaynetwork    *bool
+	Displaytext       string
+	Domainid          *string
+	Endip             *string
+	Endipv6           *string
+	Gateway           *string
+	Ip6cidr           *string
+	Ip6gateway        *string
+	Isolatedpvlan     *string
+	Name              string
+	Netmask           *string
+	Networkdomain     *string
+	Networkofferingid string
+	Physicalnetworkid *string
+	Projectid         *string
+	Startip           *string
+	Startipv6         *string
+	Subdomainaccess   *bool
+	Vlan              *string
+	Vpcid             *string
+	Zoneid            string
+}
+
+// NewCreateNetworkParamsFromOpts is an alternative for NewCreateNetworkParams which takes all params using
+// an options struct, as that is easier to read for APIs with many required params
+func (s *NetworkService) NewCreateNetworkParamsFromOpts(o CreateNetworkOpts) *CreateNetworkParams {
+	p := s.NewCreateNetworkParams(o.Displaytext, o.Name, o.Networkofferingid, o.Zoneid)
+	if o.Account != nil {
+		p.p["account"] = *o.Account
+	}
+	if o.Aclid != nil {
+		p.p["aclid"] = *o.Aclid
+	}
+	if o.Acltype != nil {
+		p.p["acltype"] = string(*o.Acltype)
+	}
+	if o.Displaynetwork != nil {
+		p.p["displaynetwork"] = *o.Displaynetwork
+	}
+	if o.Domainid != nil {
+		p.p["domainid"] = *o.Domainid
+	}
+	if o.Endip != nil {
+		p.p["endip"] = *o.Endip
+	}
+	if o.Endipv6 != nil {
+		p.p["endipv6"] = *o.Endipv6
+	}
+	if o.Gateway != nil {
+		p.p["gateway"] = *o.Gateway
+	}
+	if o.Ip6cidr != nil {
+		p.p["ip6cidr"] = *o.Ip6cidr
+	}
+	if o.Ip6gateway != nil {
+		p.p["ip6gateway"] = *o.Ip6gateway
+	}
+	if o.Isolatedpvlan != nil {
+		p.p["isolatedpvlan"] = *o.Isolatedpvlan
+	}
+	if o.Netmask != nil {
+		p.p["netmask"] = *o.Netmask
+	}
+	if o.Networkdomain != nil {
+		p.p["networkdomain"] = *o.Networkdomain
+	}
+	if o.Physicalnetworkid != nil {
+		p.p["physicalnetworkid"] = *o.Physicalnetworkid
+	}
+	if o.Projectid != nil {
+		p.p["projectid"] = *o.Projectid
+	}
+	if o.Startip != nil {
+		p.p["startip"] = *o.Startip
+	}
+	if o.Startipv6 != nil {
+		p.p["startipv6"] = *o.Startipv6
+	}
+	if o.Subdomainaccess != nil {
+		p.p["subdomainaccess"] = *o.Subdomainaccess
+	}
+	if o.Vlan != nil {
+		p.p["vlan"] = *o.Vlan
+	}
+	if o.Vpcid != nil {
+		p.p["vpcid"] = *o.Vpcid
+	}
 	return p
 }
 
 // Creates a network
-func (s *NetworkService) CreateNetwork(p *CreateNetworkParams) (*CreateNetworkResponse, error) {
-	resp, err := s.cs.newRequest("createNetwork", p.toURLValues())
+func (s *NetworkService) CreateNetwork(p *CreateNetworkParams, opts ...OptionFunc) (*CreateNetworkResponse, error) {
+	return s.CreateNetworkWithContext(context.Background(), p, opts...)
+}
+
+// CreateNetworkWithContext is the same as CreateNetwork, but the request is cancelled when the context is done
+func (s *NetworkService) CreateNetworkWithContext(ctx context.Context, p *CreateNetworkParams, opts ...OptionFunc) (*CreateNetworkResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.CreateNetworkRawWithContext(ctx, p.toURLValues())
+}
+
+// CreateNetworkRaw is the same as CreateNetwork, but takes the params as url.Values instead of typed params
+func (s *NetworkService) CreateNetworkRaw(v url.Values) (*CreateNetworkResponse, error) {
+	return s.CreateNetworkRawWithContext(context.Background(), v)
+}
+
+// CreateNetworkRawWithContext is the same as CreateNetworkRaw, but the request is cancelled when the context is done
+func (s *NetworkService) CreateNetworkRawWithContext(ctx context.Context, v url.Values) (*CreateNetworkResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "createNetwork", "createnetworkresponse", v)
 	if err != nil {
 		return nil, err
 	}
 
-	if resp, err = getRawValue(resp); err != nil {
+	if resp, err = getRawValueByKey(resp, "network"); err != nil {
 		return nil, err
 	}
 
@@ -640,6 +1241,16 @@ type CreatePhysicalNetworkParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *CreatePhysicalNetworkParams) Clone() *CreatePhysicalNetworkParams {
+	c := &CreatePhysicalNetworkParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *CreatePhysicalNetworkParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -674,6 +1285,16 @@ func (p *CreatePhysicalNetworkParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *CreatePhysicalNetworkParams) validate() error {
+	for _, k := range []string{"name", "zoneid"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of createPhysicalNetwork", k)
+		}
+	}
+	return nil
+}
+
 func (p *CreatePhysicalNetworkParams) SetBroadcastdomainrange(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -682,6 +1303,13 @@ func (p *CreatePhysicalNetworkParams) SetBroadcastdomainrange(v string) {
 	return
 }
 
+// SetBroadcastdomainrangeIfUnset sets broadcastdomainrange to v, unless it is already set
+func (p *CreatePhysicalNetworkParams) SetBroadcastdomainrangeIfUnset(v string) {
+	if _, found := p.p["broadcastdomainrange"]; !found {
+		p.SetBroadcastdomainrange(v)
+	}
+}
+
 func (p *CreatePhysicalNetworkParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -690,6 +1318,13 @@ func (p *CreatePhysicalNetworkParams) SetDomainid(v string) {
 	return
 }
 
+// SetDomainidIfUnset sets domainid to v, unless it is already set
+func (p *CreatePhysicalNetworkParams) SetDomainidIfUnset(v string) {
+	if _, found := p.p["domainid"]; !found {
+		p.SetDomainid(v)
+	}
+}
+
 func (p *CreatePhysicalNetworkParams) SetIsolationmethods(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -698,6 +1333,18 @@ func (p *CreatePhysicalNetworkParams) SetIsolationmethods(v []string) {
 	return
 }
 
+// SetIsolationmethodsIfUnset sets isolationmethods to v, unless it is already set
+func (p *CreatePhysicalNetworkParams) SetIsolationmethodsIfUnset(v []string) {
+	if _, found := p.p["isolationmethods"]; !found {
+		p.SetIsolationmethods(v)
+	}
+}
+
+// SetIsolationmethodsCSV splits v on commas and sets the resulting values
+func (p *CreatePhysicalNetworkParams) SetIsolationmethodsCSV(v string) {
+	p.SetIsolationmethods(splitCSV(v))
+}
+
 func (p *CreatePhysicalNetworkParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -706,6 +1353,13 @@ func (p *CreatePhysicalNetworkParams) SetName(v string) {
 	return
 }
 
+// SetNameIfUnset sets name to v, unless it is already set
+func (p *CreatePhysicalNetworkParams) SetNameIfUnset(v string) {
+	if _, found := p.p["name"]; !found {
+		p.SetName(v)
+	}
+}
+
 func (p *CreatePhysicalNetworkParams) SetNetworkspeed(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -714,6 +1368,13 @@ func (p *CreatePhysicalNetworkParams) SetNetworkspeed(v string) {
 	return
 }
 
+// SetNetworkspeedIfUnset sets networkspeed to v, unless it is already set
+func (p *CreatePhysicalNetworkParams) SetNetworkspeedIfUnset(v string) {
+	if _, found := p.p["networkspeed"]; !found {
+		p.SetNetworkspeed(v)
+	}
+}
+
 func (p *CreatePhysicalNetworkParams) SetTags(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -722,14 +1383,33 @@ func (p *CreatePhysicalNetworkParams) SetTags(v []string) {
 	return
 }
 
-func (p *CreatePhysicalNetworkParams) SetVlan(v string) {
-	if p.p == nil {
+// SetTagsIfUnset sets tags to v, unless it is already set
+func (p *CreatePhysicalNetworkParams) SetTagsIfUnset(v []string) {
+	if _, found := p.p["tags"]; !found {
+		p.SetTags(v)
+	}
+}
+
+// SetTagsCSV splits v on commas and sets the resulting values
+func (p *CreatePhysicalNetworkParams) SetTagsCSV(v string) {
+	p.SetTags(splitCSV(v))
+}
+
+func (p *CreatePhysicalNetworkParams) SetVlan(v string) {
+	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
 	p.p["vlan"] = v
 	return
 }
 
+// SetVlanIfUnset sets vlan to v, unless it is already set
+func (p *CreatePhysicalNetworkParams) SetVlanIfUnset(v string) {
+	if _, found := p.p["vlan"]; !found {
+		p.SetVlan(v)
+	}
+}
+
 func (p *CreatePhysicalNetworkParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -738,19 +1418,55 @@ func (p *CreatePhysicalNetworkParams) SetZoneid(v string) {
 	return
 }
 
+// SetZoneidIfUnset sets zoneid to v, unless it is already set
+func (p *CreatePhysicalNetworkParams) SetZoneidIfUnset(v string) {
+	if _, found := p.p["zoneid"]; !found {
+		p.SetZoneid(v)
+	}
+}
+
 // You should always use this function to get a new CreatePhysicalNetworkParams instance,
-// as then you are sure you have configured all required params
-func (s *NetworkService) NewCreatePhysicalNetworkParams(name string, zoneid string) *CreatePhysicalNetworkParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *NetworkService) NewCreatePhysicalNetworkParams(name string, zoneid string, opts ...func(*CreatePhysicalNetworkParams)) *CreatePhysicalNetworkParams {
 	p := &CreatePhysicalNetworkParams{}
 	p.p = make(map[string]interface{})
 	p.p["name"] = name
 	p.p["zoneid"] = zoneid
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Creates a physical network
-func (s *NetworkService) CreatePhysicalNetwork(p *CreatePhysicalNetworkParams) (*CreatePhysicalNetworkResponse, error) {
-	resp, err := s.cs.newRequest("createPhysicalNetwork", p.toURLValues())
+func (s *NetworkService) CreatePhysicalNetwork(p *CreatePhysicalNetworkParams, opts ...OptionFunc) (*CreatePhysicalNetworkResponse, error) {
+	return s.CreatePhysicalNetworkWithContext(context.Background(), p, opts...)
+}
+
+// CreatePhysicalNetworkWithContext is the same as CreatePhysicalNetwork, but the request is cancelled when the context is done
+func (s *NetworkService) CreatePhysicalNetworkWithContext(ctx context.Context, p *CreatePhysicalNetworkParams, opts ...OptionFunc) (*CreatePhysicalNetworkResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.CreatePhysicalNetworkRawWithContext(ctx, p.toURLValues())
+}
+
+// CreatePhysicalNetworkRaw is the same as CreatePhysicalNetwork, but takes the params as url.Values instead of typed params
+func (s *NetworkService) CreatePhysicalNetworkRaw(v url.Values) (*CreatePhysicalNetworkResponse, error) {
+	return s.CreatePhysicalNetworkRawWithContext(context.Background(), v)
+}
+
+// CreatePhysicalNetworkRawWithContext is the same as CreatePhysicalNetworkRaw, but the request is cancelled when the context is done
+func (s *NetworkService) CreatePhysicalNetworkRawWithContext(ctx context.Context, v url.Values) (*CreatePhysicalNetworkResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "createPhysicalNetwork", "createphysicalnetworkresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -762,9 +1478,9 @@ func (s *NetworkService) CreatePhysicalNetwork(p *CreatePhysicalNetworkParams) (
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -801,6 +1517,16 @@ type CreateServiceInstanceParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *CreateServiceInstanceParams) Clone() *CreateServiceInstanceParams {
+	c := &CreateServiceInstanceParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *CreateServiceInstanceParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -836,6 +1562,16 @@ func (p *CreateServiceInstanceParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *CreateServiceInstanceParams) validate() error {
+	for _, k := range []string{"leftnetworkid", "name", "rightnetworkid", "serviceofferingid", "templateid", "zoneid"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of createServiceInstance", k)
+		}
+	}
+	return nil
+}
+
 func (p *CreateServiceInstanceParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -844,6 +1580,13 @@ func (p *CreateServiceInstanceParams) SetAccount(v string) {
 	return
 }
 
+// SetAccountIfUnset sets account to v, unless it is already set
+func (p *CreateServiceInstanceParams) SetAccountIfUnset(v string) {
+	if _, found := p.p["account"]; !found {
+		p.SetAccount(v)
+	}
+}
+
 func (p *CreateServiceInstanceParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -852,6 +1595,13 @@ func (p *CreateServiceInstanceParams) SetDomainid(v string) {
 	return
 }
 
+// SetDomainidIfUnset sets domainid to v, unless it is already set
+func (p *CreateServiceInstanceParams) SetDomainidIfUnset(v string) {
+	if _, found := p.p["domainid"]; !found {
+		p.SetDomainid(v)
+	}
+}
+
 func (p *CreateServiceInstanceParams) SetLeftnetworkid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -860,6 +1610,13 @@ func (p *CreateServiceInstanceParams) SetLeftnetworkid(v string) {
 	return
 }
 
+// SetLeftnetworkidIfUnset sets leftnetworkid to v, unless it is already set
+func (p *CreateServiceInstanceParams) SetLeftnetworkidIfUnset(v string) {
+	if _, found := p.p["leftnetworkid"]; !found {
+		p.SetLeftnetworkid(v)
+	}
+}
+
 func (p *CreateServiceInstanceParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -868,6 +1625,13 @@ func (p *CreateServiceInstanceParams) SetName(v string) {
 	return
 }
 
+// SetNameIfUnset sets name to v, unless it is already set
+func (p *CreateServiceInstanceParams) SetNameIfUnset(v string) {
+	if _, found := p.p["name"]; !found {
+		p.SetName(v)
+	}
+}
+
 func (p *CreateServiceInstanceParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -876,6 +1640,13 @@ func (p *CreateServiceInstanceParams) SetProjectid(v string) {
 	return
 }
 
+// SetProjectidIfUnset sets projectid to v, unless it is already set
+func (p *CreateServiceInstanceParams) SetProjectidIfUnset(v string) {
+	if _, found := p.p["projectid"]; !found {
+		p.SetProjectid(v)
+	}
+}
+
 func (p *CreateServiceInstanceParams) SetRightnetworkid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -884,6 +1655,13 @@ func (p *CreateServiceInstanceParams) SetRightnetworkid(v string) {
 	return
 }
 
+// SetRightnetworkidIfUnset sets rightnetworkid to v, unless it is already set
+func (p *CreateServiceInstanceParams) SetRightnetworkidIfUnset(v string) {
+	if _, found := p.p["rightnetworkid"]; !found {
+		p.SetRightnetworkid(v)
+	}
+}
+
 func (p *CreateServiceInstanceParams) SetServiceofferingid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -892,6 +1670,13 @@ func (p *CreateServiceInstanceParams) SetServiceofferingid(v string) {
 	return
 }
 
+// SetServiceofferingidIfUnset sets serviceofferingid to v, unless it is already set
+func (p *CreateServiceInstanceParams) SetServiceofferingidIfUnset(v string) {
+	if _, found := p.p["serviceofferingid"]; !found {
+		p.SetServiceofferingid(v)
+	}
+}
+
 func (p *CreateServiceInstanceParams) SetTemplateid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -900,6 +1685,13 @@ func (p *CreateServiceInstanceParams) SetTemplateid(v string) {
 	return
 }
 
+// SetTemplateidIfUnset sets templateid to v, unless it is already set
+func (p *CreateServiceInstanceParams) SetTemplateidIfUnset(v string) {
+	if _, found := p.p["templateid"]; !found {
+		p.SetTemplateid(v)
+	}
+}
+
 func (p *CreateServiceInstanceParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -908,9 +1700,17 @@ func (p *CreateServiceInstanceParams) SetZoneid(v string) {
 	return
 }
 
+// SetZoneidIfUnset sets zoneid to v, unless it is already set
+func (p *CreateServiceInstanceParams) SetZoneidIfUnset(v string) {
+	if _, found := p.p["zoneid"]; !found {
+		p.SetZoneid(v)
+	}
+}
+
 // You should always use this function to get a new CreateServiceInstanceParams instance,
-// as then you are sure you have configured all required params
-func (s *NetworkService) NewCreateServiceInstanceParams(leftnetworkid string, name string, rightnetworkid string, serviceofferingid string, templateid string, zoneid string) *CreateServiceInstanceParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *NetworkService) NewCreateServiceInstanceParams(leftnetworkid string, name string, rightnetworkid string, serviceofferingid string, templateid string, zoneid string, opts ...func(*CreateServiceInstanceParams)) *CreateServiceInstanceParams {
 	p := &CreateServiceInstanceParams{}
 	p.p = make(map[string]interface{})
 	p.p["leftnetworkid"] = leftnetworkid
@@ -919,12 +1719,70 @@ func (s *NetworkService) NewCreateServiceInstanceParams(leftnetworkid string, na
 	p.p["serviceofferingid"] = serviceofferingid
 	p.p["templateid"] = templateid
 	p.p["zoneid"] = zoneid
+	for _, fn := range opts {
+		fn(p)
+	}
+	return p
+}
+
+// CreateServiceInstanceOpts contains all params that can be set on a CreateServiceInstanceParams.
+// Optional params are pointers (or slices and maps) which are only set when not nil.
+type CreateServiceInstanceOpts struct {
+	Account           *string
+	Domainid          *string
+	Leftnetworkid     string
+	Name              string
+	Projectid         *string
+	Rightnetworkid    string
+	Serviceofferingid string
+	Templateid        string
+	Zoneid            string
+}
+
+// NewCreateServiceInstanceParamsFromOpts is an alternative for NewCreateServiceInstanceParams which takes all params using
+// an options struct, as that is easier to read for APIs with many required params
+func (s *NetworkService) NewCreateServiceInstanceParamsFromOpts(o CreateServiceInstanceOpts) *CreateServiceInstanceParams {
+	p := s.NewCreateServiceInstanceParams(o.Leftnetworkid, o.Name, o.Rightnetworkid, o.Serviceofferingid, o.Templateid, o.Zoneid)
+	if o.Account != nil {
+		p.p["account"] = *o.Account
+	}
+	if o.Domainid != nil {
+		p.p["domainid"] = *o.Domainid
+	}
+	if o.Projectid != nil {
+		p.p["projectid"] = *o.Projectid
+	}
 	return p
 }
 
 // Creates a system virtual-machine that implements network services
-func (s *NetworkService) CreateServiceInstance(p *CreateServiceInstanceParams) (*CreateServiceInstanceResponse, error) {
-	resp, err := s.cs.newRequest("createServiceInstance", p.toURLValues())
+func (s *NetworkService) CreateServiceInstance(p *CreateServiceInstanceParams, opts ...OptionFunc) (*CreateServiceInstanceResponse, error) {
+	return s.CreateServiceInstanceWithContext(context.Background(), p, opts...)
+}
+
+// CreateServiceInstanceWithContext is the same as CreateServiceInstance, but the request is cancelled when the context is done
+func (s *NetworkService) CreateServiceInstanceWithContext(ctx context.Context, p *CreateServiceInstanceParams, opts ...OptionFunc) (*CreateServiceInstanceResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.CreateServiceInstanceRawWithContext(ctx, p.toURLValues())
+}
+
+// CreateServiceInstanceRaw is the same as CreateServiceInstance, but takes the params as url.Values instead of typed params
+func (s *NetworkService) CreateServiceInstanceRaw(v url.Values) (*CreateServiceInstanceResponse, error) {
+	return s.CreateServiceInstanceRawWithContext(context.Background(), v)
+}
+
+// CreateServiceInstanceRawWithContext is the same as CreateServiceInstanceRaw, but the request is cancelled when the context is done
+func (s *NetworkService) CreateServiceInstanceRawWithContext(ctx context.Context, v url.Values) (*CreateServiceInstanceResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "createServiceInstance", "createserviceinstanceresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -936,9 +1794,9 @@ func (s *NetworkService) CreateServiceInstance(p *CreateServiceInstanceParams) (
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -973,6 +1831,16 @@ type CreateStorageNetworkIpRangeParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *CreateStorageNetworkIpRangeParams) Clone() *CreateStorageNetworkIpRangeParams {
+	c := &CreateStorageNetworkIpRangeParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *CreateStorageNetworkIpRangeParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1000,6 +1868,16 @@ func (p *CreateStorageNetworkIpRangeParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *CreateStorageNetworkIpRangeParams) validate() error {
+	for _, k := range []string{"gateway", "netmask", "podid", "startip"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of createStorageNetworkIpRange", k)
+		}
+	}
+	return nil
+}
+
 func (p *CreateStorageNetworkIpRangeParams) SetEndip(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1008,6 +1886,13 @@ func (p *CreateStorageNetworkIpRangeParams) SetEndip(v string) {
 	return
 }
 
+// SetEndipIfUnset sets endip to v, unless it is already set
+func (p *CreateStorageNetworkIpRangeParams) SetEndipIfUnset(v string) {
+	if _, found := p.p["endip"]; !found {
+		p.SetEndip(v)
+	}
+}
+
 func (p *CreateStorageNetworkIpRangeParams) SetGateway(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1016,6 +1901,13 @@ func (p *CreateStorageNetworkIpRangeParams) SetGateway(v string) {
 	return
 }
 
+// SetGatewayIfUnset sets gateway to v, unless it is already set
+func (p *CreateStorageNetworkIpRangeParams) SetGatewayIfUnset(v string) {
+	if _, found := p.p["gateway"]; !found {
+		p.SetGateway(v)
+	}
+}
+
 func (p *CreateStorageNetworkIpRangeParams) SetNetmask(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1024,6 +1916,13 @@ func (p *CreateStorageNetworkIpRangeParams) SetNetmask(v string) {
 	return
 }
 
+// SetNetmaskIfUnset sets netmask to v, unless it is already set
+func (p *CreateStorageNetworkIpRangeParams) SetNetmaskIfUnset(v string) {
+	if _, found := p.p["netmask"]; !found {
+		p.SetNetmask(v)
+	}
+}
+
 func (p *CreateStorageNetworkIpRangeParams) SetPodid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1032,6 +1931,13 @@ func (p *CreateStorageNetworkIpRangeParams) SetPodid(v string) {
 	return
 }
 
+// SetPodidIfUnset sets podid to v, unless it is already set
+func (p *CreateStorageNetworkIpRangeParams) SetPodidIfUnset(v string) {
+	if _, found := p.p["podid"]; !found {
+		p.SetPodid(v)
+	}
+}
+
 func (p *CreateStorageNetworkIpRangeParams) SetStartip(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1040,6 +1946,13 @@ func (p *CreateStorageNetworkIpRangeParams) SetStartip(v string) {
 	return
 }
 
+// SetStartipIfUnset sets startip to v, unless it is already set
+func (p *CreateStorageNetworkIpRangeParams) SetStartipIfUnset(v string) {
+	if _, found := p.p["startip"]; !found {
+		p.SetStartip(v)
+	}
+}
+
 func (p *CreateStorageNetworkIpRangeParams) SetVlan(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1048,21 +1961,81 @@ func (p *CreateStorageNetworkIpRangeParams) SetVlan(v int) {
 	return
 }
 
+// SetVlanIfUnset sets vlan to v, unless it is already set
+func (p *CreateStorageNetworkIpRangeParams) SetVlanIfUnset(v int) {
+	if _, found := p.p["vlan"]; !found {
+		p.SetVlan(v)
+	}
+}
+
 // You should always use this function to get a new CreateStorageNetworkIpRangeParams instance,
-// as then you are sure you have configured all required params
-func (s *NetworkService) NewCreateStorageNetworkIpRangeParams(gateway string, netmask string, podid string, startip string) *CreateStorageNetworkIpRangeParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *NetworkService) NewCreateStorageNetworkIpRangeParams(gateway string, netmask string, podid string, startip string, opts ...func(*CreateStorageNetworkIpRangeParams)) *CreateStorageNetworkIpRangeParams {
 	p := &CreateStorageNetworkIpRangeParams{}
 	p.p = make(map[string]interface{})
 	p.p["gateway"] = gateway
 	p.p["netmask"] = netmask
 	p.p["podid"] = podid
 	p.p["startip"] = startip
+	for _, fn := range opts {
+		fn(p)
+	}
+	return p
+}
+
+// CreateStorageNetworkIpRangeOpts contains all params that can be set on a CreateStorageNetworkIpRangeParams.
+// Optional params are pointers (or slices and maps) which are only set when not nil.
+type CreateStorageNetworkIpRangeOpts struct {
+	Endip   *string
+	Gateway string
+	Netmask string
+	Podid   string
+	Startip string
+	Vlan    *int
+}
+
+// NewCreateStorageNetworkIpRangeParamsFromOpts is an alternative for NewCreateStorageNetworkIpRangeParams which takes all params using
+// an options struct, as that is easier to read for APIs with many required params
+func (s *NetworkService) NewCreateStorageNetworkIpRangeParamsFromOpts(o CreateStorageNetworkIpRangeOpts) *CreateStorageNetworkIpRangeParams {
+	p := s.NewCreateStorageNetworkIpRangeParams(o.Gateway, o.Netmask, o.Podid, o.Startip)
+	if o.Endip != nil {
+		p.p["endip"] = *o.Endip
+	}
+	if o.Vlan != nil {
+		p.p["vlan"] = *o.Vlan
+	}
 	return p
 }
 
 // Creates a Storage network IP range.
-func (s *NetworkService) CreateStorageNetworkIpRange(p *CreateStorageNetworkIpRangeParams) (*CreateStorageNetworkIpRangeResponse, error) {
-	resp, err := s.cs.newRequest("createStorageNetworkIpRange", p.toURLValues())
+func (s *NetworkService) CreateStorageNetworkIpRange(p *CreateStorageNetworkIpRangeParams, opts ...OptionFunc) (*CreateStorageNetworkIpRangeResponse, error) {
+	return s.CreateStorageNetworkIpRangeWithContext(context.Background(), p, opts...)
+}
+
+// CreateStorageNetworkIpRangeWithContext is the same as CreateStorageNetworkIpRange, but the request is cancelled when the context is done
+func (s *NetworkService) CreateStorageNetworkIpRangeWithContext(ctx context.Context, p *CreateStorageNetworkIpRangeParams, opts ...OptionFunc) (*CreateStorageNetworkIpRangeResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.CreateStorageNetworkIpRangeRawWithContext(ctx, p.toURLValues())
+}
+
+// CreateStorageNetworkIpRangeRaw is the same as CreateStorageNetworkIpRange, but takes the params as url.Values instead of typed params
+func (s *NetworkService) CreateStorageNetworkIpRangeRaw(v url.Values) (*CreateStorageNetworkIpRangeResponse, error) {
+	return s.CreateStorageNetworkIpRangeRawWithContext(context.Background(), v)
+}
+
+// CreateStorageNetworkIpRangeRawWithContext is the same as CreateStorageNetworkIpRangeRaw, but the request is cancelled when the context is done
+func (s *NetworkService) CreateStorageNetworkIpRangeRawWithContext(ctx context.Context, v url.Values) (*CreateStorageNetworkIpRangeResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "createStorageNetworkIpRange", "createstoragenetworkiprangeresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -1074,9 +2047,9 @@ func (s *NetworkService) CreateStorageNetworkIpRange(p *CreateStorageNetworkIpRa
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -1112,6 +2085,16 @@ type DedicatePublicIpRangeParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *DedicatePublicIpRangeParams) Clone() *DedicatePublicIpRangeParams {
+	c := &DedicatePublicIpRangeParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DedicatePublicIpRangeParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1132,6 +2115,16 @@ func (p *DedicatePublicIpRangeParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *DedicatePublicIpRangeParams) validate() error {
+	for _, k := range []string{"domainid", "id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of dedicatePublicIpRange", k)
+		}
+	}
+	return nil
+}
+
 func (p *DedicatePublicIpRangeParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1140,6 +2133,13 @@ func (p *DedicatePublicIpRangeParams) SetAccount(v string) {
 	return
 }
 
+// SetAccountIfUnset sets account to v, unless it is already set
+func (p *DedicatePublicIpRangeParams) SetAccountIfUnset(v string) {
+	if _, found := p.p["account"]; !found {
+		p.SetAccount(v)
+	}
+}
+
 func (p *DedicatePublicIpRangeParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1148,6 +2148,13 @@ func (p *DedicatePublicIpRangeParams) SetDomainid(v string) {
 	return
 }
 
+// SetDomainidIfUnset sets domainid to v, unless it is already set
+func (p *DedicatePublicIpRangeParams) SetDomainidIfUnset(v string) {
+	if _, found := p.p["domainid"]; !found {
+		p.SetDomainid(v)
+	}
+}
+
 func (p *DedicatePublicIpRangeParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1156,6 +2163,13 @@ func (p *DedicatePublicIpRangeParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *DedicatePublicIpRangeParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 func (p *DedicatePublicIpRangeParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1164,19 +2178,55 @@ func (p *DedicatePublicIpRangeParams) SetProjectid(v string) {
 	return
 }
 
+// SetProjectidIfUnset sets projectid to v, unless it is already set
+func (p *DedicatePublicIpRangeParams) SetProjectidIfUnset(v string) {
+	if _, found := p.p["projectid"]; !found {
+		p.SetProjectid(v)
+	}
+}
+
 // You should always use this function to get a new DedicatePublicIpRangeParams instance,
-// as then you are sure you have configured all required params
-func (s *NetworkService) NewDedicatePublicIpRangeParams(domainid string, id string) *DedicatePublicIpRangeParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *NetworkService) NewDedicatePublicIpRangeParams(domainid string, id string, opts ...func(*DedicatePublicIpRangeParams)) *DedicatePublicIpRangeParams {
 	p := &DedicatePublicIpRangeParams{}
 	p.p = make(map[string]interface{})
 	p.p["domainid"] = domainid
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Dedicates a Public IP range to an account
-func (s *NetworkService) DedicatePublicIpRange(p *DedicatePublicIpRangeParams) (*DedicatePublicIpRangeResponse, error) {
-	resp, err := s.cs.newRequest("dedicatePublicIpRange", p.toURLValues())
+func (s *NetworkService) DedicatePublicIpRange(p *DedicatePublicIpRangeParams, opts ...OptionFunc) (*DedicatePublicIpRangeResponse, error) {
+	return s.DedicatePublicIpRangeWithContext(context.Background(), p, opts...)
+}
+
+// DedicatePublicIpRangeWithContext is the same as DedicatePublicIpRange, but the request is cancelled when the context is done
+func (s *NetworkService) DedicatePublicIpRangeWithContext(ctx context.Context, p *DedicatePublicIpRangeParams, opts ...OptionFunc) (*DedicatePublicIpRangeResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.DedicatePublicIpRangeRawWithContext(ctx, p.toURLValues())
+}
+
+// DedicatePublicIpRangeRaw is the same as DedicatePublicIpRange, but takes the params as url.Values instead of typed params
+func (s *NetworkService) DedicatePublicIpRangeRaw(v url.Values) (*DedicatePublicIpRangeResponse, error) {
+	return s.DedicatePublicIpRangeRawWithContext(context.Background(), v)
+}
+
+// DedicatePublicIpRangeRawWithContext is the same as DedicatePublicIpRangeRaw, but the request is cancelled when the context is done
+func (s *NetworkService) DedicatePublicIpRangeRawWithContext(ctx context.Context, v url.Values) (*DedicatePublicIpRangeResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "dedicatePublicIpRange", "dedicatepubliciprangeresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -1218,6 +2268,16 @@ type DeleteNetworkParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *DeleteNetworkParams) Clone() *DeleteNetworkParams {
+	c := &DeleteNetworkParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeleteNetworkParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1233,6 +2293,16 @@ func (p *DeleteNetworkParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *DeleteNetworkParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of deleteNetwork", k)
+		}
+	}
+	return nil
+}
+
 func (p *DeleteNetworkParams) SetForced(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1241,6 +2311,13 @@ func (p *DeleteNetworkParams) SetForced(v bool) {
 	return
 }
 
+// SetForcedIfUnset sets forced to v, unless it is already set
+func (p *DeleteNetworkParams) SetForcedIfUnset(v bool) {
+	if _, found := p.p["forced"]; !found {
+		p.SetForced(v)
+	}
+}
+
 func (p *DeleteNetworkParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1249,18 +2326,54 @@ func (p *DeleteNetworkParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *DeleteNetworkParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 // You should always use this function to get a new DeleteNetworkParams instance,
-// as then you are sure you have configured all required params
-func (s *NetworkService) NewDeleteNetworkParams(id string) *DeleteNetworkParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *NetworkService) NewDeleteNetworkParams(id string, opts ...func(*DeleteNetworkParams)) *DeleteNetworkParams {
 	p := &DeleteNetworkParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Deletes a network
-func (s *NetworkService) DeleteNetwork(p *DeleteNetworkParams) (*DeleteNetworkResponse, error) {
-	resp, err := s.cs.newRequest("deleteNetwork", p.toURLValues())
+func (s *NetworkService) DeleteNetwork(p *DeleteNetworkParams, opts ...OptionFunc) (*DeleteNetworkResponse, error) {
+	return s.DeleteNetworkWithContext(context.Background(), p, opts...)
+}
+
+// DeleteNetworkWithContext is the same as DeleteNetwork, but the request is cancelled when the context is done
+func (s *NetworkService) DeleteNetworkWithContext(ctx context.Context, p *DeleteNetworkParams, opts ...OptionFunc) (*DeleteNetworkResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.DeleteNetworkRawWithContext(ctx, p.toURLValues())
+}
+
+// DeleteNetworkRaw is the same as DeleteNetwork, but takes the params as url.Values instead of typed params
+func (s *NetworkService) DeleteNetworkRaw(v url.Values) (*DeleteNetworkResponse, error) {
+	return s.DeleteNetworkRawWithContext(context.Background(), v)
+}
+
+// DeleteNetworkRawWithContext is the same as DeleteNetworkRaw, but the request is cancelled when the context is done
+func (s *NetworkService) DeleteNetworkRawWithContext(ctx context.Context, v url.Values) (*DeleteNetworkResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteNetwork", "deletenetworkresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -1272,9 +2385,9 @@ func (s *NetworkService) DeleteNetwork(p *DeleteNetworkParams) (*DeleteNetworkRe
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -1288,6 +2401,34 @@ func (s *NetworkService) DeleteNetwork(p *DeleteNetworkParams) (*DeleteNetworkRe
 	return &r, nil
 }
 
+// WaitForNetworkDeleted polls until the Network with the given ID no longer exists, the timeout
+// expires or the context is cancelled. Deleted resources may still be listed for a short while
+// after the delete command finished, so this can be used to make sure they are really gone. The
+// options are passed to GetNetworkByID, so a resource outside of the default scope can be found.
+func (s *NetworkService) WaitForNetworkDeleted(ctx context.Context, id string, timeout time.Duration, opts ...OptionFunc) error {
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	for {
+		_, count, err := s.GetNetworkByID(id, opts...)
+		if count == 0 {
+			return nil
+		}
+		if count < 0 {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(2 * time.Second):
+		}
+	}
+}
+
 type DeleteNetworkResponse struct {
 	JobID       string `json:"jobid"`
 	Displaytext string `json:"displaytext"`
@@ -1298,6 +2439,16 @@ type DeleteNetworkServiceProviderParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *DeleteNetworkServiceProviderParams) Clone() *DeleteNetworkServiceProviderParams {
+	c := &DeleteNetworkServiceProviderParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeleteNetworkServiceProviderParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1309,6 +2460,16 @@ func (p *DeleteNetworkServiceProviderParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *DeleteNetworkServiceProviderParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of deleteNetworkServiceProvider", k)
+		}
+	}
+	return nil
+}
+
 func (p *DeleteNetworkServiceProviderParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1317,18 +2478,54 @@ func (p *DeleteNetworkServiceProviderParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *DeleteNetworkServiceProviderParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 // You should always use this function to get a new DeleteNetworkServiceProviderParams instance,
-// as then you are sure you have configured all required params
-func (s *NetworkService) NewDeleteNetworkServiceProviderParams(id string) *DeleteNetworkServiceProviderParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *NetworkService) NewDeleteNetworkServiceProviderParams(id string, opts ...func(*DeleteNetworkServiceProviderParams)) *DeleteNetworkServiceProviderParams {
 	p := &DeleteNetworkServiceProviderParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Deletes a Network Service Provider.
-func (s *NetworkService) DeleteNetworkServiceProvider(p *DeleteNetworkServiceProviderParams) (*DeleteNetworkServiceProviderResponse, error) {
-	resp, err := s.cs.newRequest("deleteNetworkServiceProvider", p.toURLValues())
+func (s *NetworkService) DeleteNetworkServiceProvider(p *DeleteNetworkServiceProviderParams, opts ...OptionFunc) (*DeleteNetworkServiceProviderResponse, error) {
+	return s.DeleteNetworkServiceProviderWithContext(context.Background(), p, opts...)
+}
+
+// DeleteNetworkServiceProviderWithContext is the same as DeleteNetworkServiceProvider, but the request is cancelled when the context is done
+func (s *NetworkService) DeleteNetworkServiceProviderWithContext(ctx context.Context, p *DeleteNetworkServiceProviderParams, opts ...OptionFunc) (*DeleteNetworkServiceProviderResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.DeleteNetworkServiceProviderRawWithContext(ctx, p.toURLValues())
+}
+
+// DeleteNetworkServiceProviderRaw is the same as DeleteNetworkServiceProvider, but takes the params as url.Values instead of typed params
+func (s *NetworkService) DeleteNetworkServiceProviderRaw(v url.Values) (*DeleteNetworkServiceProviderResponse, error) {
+	return s.DeleteNetworkServiceProviderRawWithContext(context.Background(), v)
+}
+
+// DeleteNetworkServiceProviderRawWithContext is the same as DeleteNetworkServiceProviderRaw, but the request is cancelled when the context is done
+func (s *NetworkService) DeleteNetworkServiceProviderRawWithContext(ctx context.Context, v url.Values) (*DeleteNetworkServiceProviderResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteNetworkServiceProvider", "deletenetworkserviceproviderresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -1340,9 +2537,9 @@ func (s *NetworkService) DeleteNetworkServiceProvider(p *DeleteNetworkServicePro
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -1366,6 +2563,16 @@ type DeleteOpenDaylightControllerParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *DeleteOpenDaylightControllerParams) Clone() *DeleteOpenDaylightControllerParams {
+	c := &DeleteOpenDaylightControllerParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeleteOpenDaylightControllerParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1377,6 +2584,16 @@ func (p *DeleteOpenDaylightControllerParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *DeleteOpenDaylightControllerParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of deleteOpenDaylightController", k)
+		}
+	}
+	return nil
+}
+
 func (p *DeleteOpenDaylightControllerParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1385,18 +2602,54 @@ func (p *DeleteOpenDaylightControllerParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *DeleteOpenDaylightControllerParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 // You should always use this function to get a new DeleteOpenDaylightControllerParams instance,
-// as then you are sure you have configured all required params
-func (s *NetworkService) NewDeleteOpenDaylightControllerParams(id string) *DeleteOpenDaylightControllerParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *NetworkService) NewDeleteOpenDaylightControllerParams(id string, opts ...func(*DeleteOpenDaylightControllerParams)) *DeleteOpenDaylightControllerParams {
 	p := &DeleteOpenDaylightControllerParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Removes an OpenDyalight controler
-func (s *NetworkService) DeleteOpenDaylightController(p *DeleteOpenDaylightControllerParams) (*DeleteOpenDaylightControllerResponse, error) {
-	resp, err := s.cs.newRequest("deleteOpenDaylightController", p.toURLValues())
+func (s *NetworkService) DeleteOpenDaylightController(p *DeleteOpenDaylightControllerParams, opts ...OptionFunc) (*DeleteOpenDaylightControllerResponse, error) {
+	return s.DeleteOpenDaylightControllerWithContext(context.Background(), p, opts...)
+}
+
+// DeleteOpenDaylightControllerWithContext is the same as DeleteOpenDaylightController, but the request is cancelled when the context is done
+func (s *NetworkService) DeleteOpenDaylightControllerWithContext(ctx context.Context, p *DeleteOpenDaylightControllerParams, opts ...OptionFunc) (*DeleteOpenDaylightControllerResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.DeleteOpenDaylightControllerRawWithContext(ctx, p.toURLValues())
+}
+
+// DeleteOpenDaylightControllerRaw is the same as DeleteOpenDaylightController, but takes the params as url.Values instead of typed params
+func (s *NetworkService) DeleteOpenDaylightControllerRaw(v url.Values) (*DeleteOpenDaylightControllerResponse, error) {
+	return s.DeleteOpenDaylightControllerRawWithContext(context.Background(), v)
+}
+
+// DeleteOpenDaylightControllerRawWithContext is the same as DeleteOpenDaylightControllerRaw, but the request is cancelled when the context is done
+func (s *NetworkService) DeleteOpenDaylightControllerRawWithContext(ctx context.Context, v url.Values) (*DeleteOpenDaylightControllerResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteOpenDaylightController", "deleteopendaylightcontrollerresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -1408,9 +2661,9 @@ func (s *NetworkService) DeleteOpenDaylightController(p *DeleteOpenDaylightContr
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -1429,8 +2682,36 @@ func (s *NetworkService) DeleteOpenDaylightController(p *DeleteOpenDaylightContr
 	return &r, nil
 }
 
-type DeleteOpenDaylightControllerResponse struct {
-	JobID             string `json:"jobid"`
+// WaitForOpenDaylightControllerDeleted polls until the OpenDaylightController with the given ID no longer exists, the timeout
+// expires or the context is cancelled. Deleted resources may still be listed for a short while
+// after the delete command finished, so this can be used to make sure they are really gone. The
+// options are passed to GetOpenDaylightControllerByID, so a resource outside of the default scope can be found.
+func (s *NetworkService) WaitForOpenDaylightControllerDeleted(ctx context.Context, id string, timeout time.Duration, opts ...OptionFunc) error {
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	for {
+		_, count, err := s.GetOpenDaylightControllerByID(id, opts...)
+		if count == 0 {
+			return nil
+		}
+		if count < 0 {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(2 * time.Second):
+		}
+	}
+}
+
+type DeleteOpenDaylightControllerResponse struct {
+	JobID             string `json:"jobid"`
 	Id                string `json:"id"`
 	Name              string `json:"name"`
 	Physicalnetworkid string `json:"physicalnetworkid"`
@@ -1442,6 +2723,16 @@ type DeletePhysicalNetworkParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *DeletePhysicalNetworkParams) Clone() *DeletePhysicalNetworkParams {
+	c := &DeletePhysicalNetworkParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeletePhysicalNetworkParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1453,6 +2744,16 @@ func (p *DeletePhysicalNetworkParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *DeletePhysicalNetworkParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of deletePhysicalNetwork", k)
+		}
+	}
+	return nil
+}
+
 func (p *DeletePhysicalNetworkParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1461,18 +2762,54 @@ func (p *DeletePhysicalNetworkParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *DeletePhysicalNetworkParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 // You should always use this function to get a new DeletePhysicalNetworkParams instance,
-// as then you are sure you have configured all required params
-func (s *NetworkService) NewDeletePhysicalNetworkParams(id string) *DeletePhysicalNetworkParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *NetworkService) NewDeletePhysicalNetworkParams(id string, opts ...func(*DeletePhysicalNetworkParams)) *DeletePhysicalNetworkParams {
 	p := &DeletePhysicalNetworkParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Deletes a Physical Network.
-func (s *NetworkService) DeletePhysicalNetwork(p *DeletePhysicalNetworkParams) (*DeletePhysicalNetworkResponse, error) {
-	resp, err := s.cs.newRequest("deletePhysicalNetwork", p.toURLValues())
+func (s *NetworkService) DeletePhysicalNetwork(p *DeletePhysicalNetworkParams, opts ...OptionFunc) (*DeletePhysicalNetworkResponse, error) {
+	return s.DeletePhysicalNetworkWithContext(context.Background(), p, opts...)
+}
+
+// DeletePhysicalNetworkWithContext is the same as DeletePhysicalNetwork, but the request is cancelled when the context is done
+func (s *NetworkService) DeletePhysicalNetworkWithContext(ctx context.Context, p *DeletePhysicalNetworkParams, opts ...OptionFunc) (*DeletePhysicalNetworkResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.DeletePhysicalNetworkRawWithContext(ctx, p.toURLValues())
+}
+
+// DeletePhysicalNetworkRaw is the same as DeletePhysicalNetwork, but takes the params as url.Values instead of typed params
+func (s *NetworkService) DeletePhysicalNetworkRaw(v url.Values) (*DeletePhysicalNetworkResponse, error) {
+	return s.DeletePhysicalNetworkRawWithContext(context.Background(), v)
+}
+
+// DeletePhysicalNetworkRawWithContext is the same as DeletePhysicalNetworkRaw, but the request is cancelled when the context is done
+func (s *NetworkService) DeletePhysicalNetworkRawWithContext(ctx context.Context, v url.Values) (*DeletePhysicalNetworkResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "deletePhysicalNetwork", "deletephysicalnetworkresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -1484,9 +2821,9 @@ func (s *NetworkService) DeletePhysicalNetwork(p *DeletePhysicalNetworkParams) (
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -1500,6 +2837,34 @@ func (s *NetworkService) DeletePhysicalNetwork(p *DeletePhysicalNetworkParams) (
 	return &r, nil
 }
 
+// WaitForPhysicalNetworkDeleted polls until the PhysicalNetwork with the given ID no longer exists, the timeout
+// expires or the context is cancelled. Deleted resources may still be listed for a short while
+// after the delete command finished, so this can be used to make sure they are really gone. The
+// options are passed to GetPhysicalNetworkByID, so a resource outside of the default scope can be found.
+func (s *NetworkService) WaitForPhysicalNetworkDeleted(ctx context.Context, id string, timeout time.Duration, opts ...OptionFunc) error {
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	for {
+		_, count, err := s.GetPhysicalNetworkByID(id, opts...)
+		if count == 0 {
+			return nil
+		}
+		if count < 0 {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(2 * time.Second):
+		}
+	}
+}
+
 type DeletePhysicalNetworkResponse struct {
 	JobID       string `json:"jobid"`
 	Displaytext string `json:"displaytext"`
@@ -1510,6 +2875,16 @@ type DeleteStorageNetworkIpRangeParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *DeleteStorageNetworkIpRangeParams) Clone() *DeleteStorageNetworkIpRangeParams {
+	c := &DeleteStorageNetworkIpRangeParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeleteStorageNetworkIpRangeParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1521,6 +2896,16 @@ func (p *DeleteStorageNetworkIpRangeParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *DeleteStorageNetworkIpRangeParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of deleteStorageNetworkIpRange", k)
+		}
+	}
+	return nil
+}
+
 func (p *DeleteStorageNetworkIpRangeParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1529,18 +2914,54 @@ func (p *DeleteStorageNetworkIpRangeParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *DeleteStorageNetworkIpRangeParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 // You should always use this function to get a new DeleteStorageNetworkIpRangeParams instance,
-// as then you are sure you have configured all required params
-func (s *NetworkService) NewDeleteStorageNetworkIpRangeParams(id string) *DeleteStorageNetworkIpRangeParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *NetworkService) NewDeleteStorageNetworkIpRangeParams(id string, opts ...func(*DeleteStorageNetworkIpRangeParams)) *DeleteStorageNetworkIpRangeParams {
 	p := &DeleteStorageNetworkIpRangeParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Deletes a storage network IP Range.
-func (s *NetworkService) DeleteStorageNetworkIpRange(p *DeleteStorageNetworkIpRangeParams) (*DeleteStorageNetworkIpRangeResponse, error) {
-	resp, err := s.cs.newRequest("deleteStorageNetworkIpRange", p.toURLValues())
+func (s *NetworkService) DeleteStorageNetworkIpRange(p *DeleteStorageNetworkIpRangeParams, opts ...OptionFunc) (*DeleteStorageNetworkIpRangeResponse, error) {
+	return s.DeleteStorageNetworkIpRangeWithContext(context.Background(), p, opts...)
+}
+
+// DeleteStorageNetworkIpRangeWithContext is the same as DeleteStorageNetworkIpRange, but the request is cancelled when the context is done
+func (s *NetworkService) DeleteStorageNetworkIpRangeWithContext(ctx context.Context, p *DeleteStorageNetworkIpRangeParams, opts ...OptionFunc) (*DeleteStorageNetworkIpRangeResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.DeleteStorageNetworkIpRangeRawWithContext(ctx, p.toURLValues())
+}
+
+// DeleteStorageNetworkIpRangeRaw is the same as DeleteStorageNetworkIpRange, but takes the params as url.Values instead of typed params
+func (s *NetworkService) DeleteStorageNetworkIpRangeRaw(v url.Values) (*DeleteStorageNetworkIpRangeResponse, error) {
+	return s.DeleteStorageNetworkIpRangeRawWithContext(context.Background(), v)
+}
+
+// DeleteStorageNetworkIpRangeRawWithContext is the same as DeleteStorageNetworkIpRangeRaw, but the request is cancelled when the context is done
+func (s *NetworkService) DeleteStorageNetworkIpRangeRawWithContext(ctx context.Context, v url.Values) (*DeleteStorageNetworkIpRangeResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteStorageNetworkIpRange", "deletestoragenetworkiprangeresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -1552,9 +2973,9 @@ func (s *NetworkService) DeleteStorageNetworkIpRange(p *DeleteStorageNetworkIpRa
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -1568,6 +2989,34 @@ func (s *NetworkService) DeleteStorageNetworkIpRange(p *DeleteStorageNetworkIpRa
 	return &r, nil
 }
 
+// WaitForStorageNetworkIpRangeDeleted polls until the StorageNetworkIpRange with the given ID no longer exists, the timeout
+// expires or the context is cancelled. Deleted resources may still be listed for a short while
+// after the delete command finished, so this can be used to make sure they are really gone. The
+// options are passed to GetStorageNetworkIpRangeByID, so a resource outside of the default scope can be found.
+func (s *NetworkService) WaitForStorageNetworkIpRangeDeleted(ctx context.Context, id string, timeout time.Duration, opts ...OptionFunc) error {
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	for {
+		_, count, err := s.GetStorageNetworkIpRangeByID(id, opts...)
+		if count == 0 {
+			return nil
+		}
+		if count < 0 {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(2 * time.Second):
+		}
+	}
+}
+
 type DeleteStorageNetworkIpRangeResponse struct {
 	JobID       string `json:"jobid"`
 	Displaytext string `json:"displaytext"`
@@ -1578,6 +3027,16 @@ type ListF5LoadBalancerNetworksParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ListF5LoadBalancerNetworksParams) Clone() *ListF5LoadBalancerNetworksParams {
+	c := &ListF5LoadBalancerNetworksParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListF5LoadBalancerNetworksParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1600,6 +3059,16 @@ func (p *ListF5LoadBalancerNetworksParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *ListF5LoadBalancerNetworksParams) validate() error {
+	for _, k := range []string{"lbdeviceid"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of listF5LoadBalancerNetworks", k)
+		}
+	}
+	return nil
+}
+
 func (p *ListF5LoadBalancerNetworksParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1608,6 +3077,13 @@ func (p *ListF5LoadBalancerNetworksParams) SetKeyword(v string) {
 	return
 }
 
+// SetKeywordIfUnset sets keyword to v, unless it is already set
+func (p *ListF5LoadBalancerNetworksParams) SetKeywordIfUnset(v string) {
+	if _, found := p.p["keyword"]; !found {
+		p.SetKeyword(v)
+	}
+}
+
 func (p *ListF5LoadBalancerNetworksParams) SetLbdeviceid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1616,6 +3092,13 @@ func (p *ListF5LoadBalancerNetworksParams) SetLbdeviceid(v string) {
 	return
 }
 
+// SetLbdeviceidIfUnset sets lbdeviceid to v, unless it is already set
+func (p *ListF5LoadBalancerNetworksParams) SetLbdeviceidIfUnset(v string) {
+	if _, found := p.p["lbdeviceid"]; !found {
+		p.SetLbdeviceid(v)
+	}
+}
+
 func (p *ListF5LoadBalancerNetworksParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1624,6 +3107,13 @@ func (p *ListF5LoadBalancerNetworksParams) SetPage(v int) {
 	return
 }
 
+// SetPageIfUnset sets page to v, unless it is already set
+func (p *ListF5LoadBalancerNetworksParams) SetPageIfUnset(v int) {
+	if _, found := p.p["page"]; !found {
+		p.SetPage(v)
+	}
+}
+
 func (p *ListF5LoadBalancerNetworksParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1632,15 +3122,33 @@ func (p *ListF5LoadBalancerNetworksParams) SetPagesize(v int) {
 	return
 }
 
+// SetPagesizeIfUnset sets pagesize to v, unless it is already set
+func (p *ListF5LoadBalancerNetworksParams) SetPagesizeIfUnset(v int) {
+	if _, found := p.p["pagesize"]; !found {
+		p.SetPagesize(v)
+	}
+}
+
 // You should always use this function to get a new ListF5LoadBalancerNetworksParams instance,
-// as then you are sure you have configured all required params
-func (s *NetworkService) NewListF5LoadBalancerNetworksParams(lbdeviceid string) *ListF5LoadBalancerNetworksParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *NetworkService) NewListF5LoadBalancerNetworksParams(lbdeviceid string, opts ...func(*ListF5LoadBalancerNetworksParams)) *ListF5LoadBalancerNetworksParams {
 	p := &ListF5LoadBalancerNetworksParams{}
 	p.p = make(map[string]interface{})
 	p.p["lbdeviceid"] = lbdeviceid
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
+func (p *ListF5LoadBalancerNetworksParams) setFirstMatch() {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p[firstMatchKey] = true
+}
+
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *NetworkService) GetF5LoadBalancerNetworkID(keyword string, lbdeviceid string, opts ...OptionFunc) (string, int, error) {
 	p := &ListF5LoadBalancerNetworksParams{}
@@ -1649,7 +3157,7 @@ func (s *NetworkService) GetF5LoadBalancerNetworkID(keyword string, lbdeviceid s
 	p.p["keyword"] = keyword
 	p.p["lbdeviceid"] = lbdeviceid
 
-	for _, fn := range append(s.cs.options, opts...) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
 		if err := fn(s.cs, p); err != nil {
 			return "", -1, err
 		}
@@ -1661,7 +3169,7 @@ func (s *NetworkService) GetF5LoadBalancerNetworkID(keyword string, lbdeviceid s
 	}
 
 	if l.Count == 0 {
-		return "", l.Count, fmt.Errorf("No match found for %s: %+v", keyword, l)
+		return "", l.Count, fmt.Errorf("%w for %s: %+v", ErrNotFound, keyword, l)
 	}
 
 	if l.Count == 1 {
@@ -1674,13 +3182,36 @@ func (s *NetworkService) GetF5LoadBalancerNetworkID(keyword string, lbdeviceid s
 				return v.Id, l.Count, nil
 			}
 		}
+
+		if _, ok := p.p[firstMatchKey]; ok && len(l.F5LoadBalancerNetworks) > 0 {
+			return l.F5LoadBalancerNetworks[0].Id, l.Count, nil
+		}
 	}
 	return "", l.Count, fmt.Errorf("Could not find an exact match for %s: %+v", keyword, l)
 }
 
 // lists network that are using a F5 load balancer device
 func (s *NetworkService) ListF5LoadBalancerNetworks(p *ListF5LoadBalancerNetworksParams) (*ListF5LoadBalancerNetworksResponse, error) {
-	resp, err := s.cs.newRequest("listF5LoadBalancerNetworks", p.toURLValues())
+	return s.ListF5LoadBalancerNetworksWithContext(context.Background(), p)
+}
+
+// ListF5LoadBalancerNetworksWithContext is the same as ListF5LoadBalancerNetworks, but the request is cancelled when the context is done
+func (s *NetworkService) ListF5LoadBalancerNetworksWithContext(ctx context.Context, p *ListF5LoadBalancerNetworksParams) (*ListF5LoadBalancerNetworksResponse, error) {
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.ListF5LoadBalancerNetworksRawWithContext(ctx, p.toURLValues())
+}
+
+// ListF5LoadBalancerNetworksRaw is the same as ListF5LoadBalancerNetworks, but takes the params as url.Values instead of typed params
+func (s *NetworkService) ListF5LoadBalancerNetworksRaw(v url.Values) (*ListF5LoadBalancerNetworksResponse, error) {
+	return s.ListF5LoadBalancerNetworksRawWithContext(context.Background(), v)
+}
+
+// ListF5LoadBalancerNetworksRawWithContext is the same as ListF5LoadBalancerNetworksRaw, but the request is cancelled when the context is done
+func (s *NetworkService) ListF5LoadBalancerNetworksRawWithContext(ctx context.Context, v url.Values) (*ListF5LoadBalancerNetworksResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "listF5LoadBalancerNetworks", "listf5loadbalancernetworksresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -1693,6 +3224,93 @@ func (s *NetworkService) ListF5LoadBalancerNetworks(p *ListF5LoadBalancerNetwork
 	return &r, nil
 }
 
+// StreamF5LoadBalancerNetworks fetches the results of ListF5LoadBalancerNetworks page by page in the background and sends them on the
+// returned channel. Both returned channels are closed when done. If fetching a page fails, or the context
+// is done before all results are sent, the error is sent on the error channel and fetching stops. A
+// request for a page in flight is cancelled when the context is done. The page and pagesize set in the
+// params are ignored.
+func (s *NetworkService) StreamF5LoadBalancerNetworks(ctx context.Context, p *ListF5LoadBalancerNetworksParams) (<-chan *F5LoadBalancerNetwork, <-chan error) {
+	ch := make(chan *F5LoadBalancerNetwork)
+	errs := make(chan error, 1)
+
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesize(500)
+
+	go func() {
+		defer close(errs)
+		defer close(ch)
+
+		for page, count := 1, 0; ; page++ {
+			pp.SetPage(page)
+
+			l, err := s.ListF5LoadBalancerNetworksWithContext(ctx, pp)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			for _, v := range l.F5LoadBalancerNetworks {
+				select {
+				case ch <- v:
+				case <-ctx.Done():
+					errs <- ctx.Err()
+					return
+				}
+			}
+
+			count += len(l.F5LoadBalancerNetworks)
+			if len(l.F5LoadBalancerNetworks) < 500 || count >= l.Count {
+				return
+			}
+		}
+	}()
+
+	return ch, errs
+}
+
+// ListF5LoadBalancerNetworksWhere returns all results of ListF5LoadBalancerNetworks for which pred returns true. Note that the results are
+// filtered client-side, so all results matching the params are fetched first.
+// All pages are fetched, so the page and pagesize set in the params are ignored.
+func (s *NetworkService) ListF5LoadBalancerNetworksWhere(p *ListF5LoadBalancerNetworksParams, pred func(*F5LoadBalancerNetwork) bool) ([]*F5LoadBalancerNetwork, error) {
+	var r []*F5LoadBalancerNetwork
+	ch, errs := s.StreamF5LoadBalancerNetworks(context.Background(), p)
+	for v := range ch {
+		if pred(v) {
+			r = append(r, v)
+		}
+	}
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ListAllF5LoadBalancerNetworks returns the results of ListF5LoadBalancerNetworks across all pages, by calling ListF5LoadBalancerNetworks page by page until all
+// results are fetched. The pagesize set in the params is used for every page (500 if not set), and the
+// page set in the params is ignored.
+func (s *NetworkService) ListAllF5LoadBalancerNetworks(p *ListF5LoadBalancerNetworksParams) ([]*F5LoadBalancerNetwork, error) {
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesizeIfUnset(500)
+	pagesize := pp.p["pagesize"].(int)
+
+	var r []*F5LoadBalancerNetwork
+	for page := 1; ; page++ {
+		pp.SetPage(page)
+
+		l, err := s.ListF5LoadBalancerNetworks(pp)
+		if err != nil {
+			return nil, err
+		}
+		r = append(r, l.F5LoadBalancerNetworks...)
+
+		if len(l.F5LoadBalancerNetworks) < pagesize || len(r) >= l.Count {
+			return r, nil
+		}
+	}
+}
+
 type ListF5LoadBalancerNetworksResponse struct {
 	Count                  int                      `json:"count"`
 	F5LoadBalancerNetworks []*F5LoadBalancerNetwork `json:"f5loadbalancernetwork"`
@@ -1780,6 +3398,16 @@ type ListNetscalerLoadBalancerNetworksParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ListNetscalerLoadBalancerNetworksParams) Clone() *ListNetscalerLoadBalancerNetworksParams {
+	c := &ListNetscalerLoadBalancerNetworksParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListNetscalerLoadBalancerNetworksParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1802,6 +3430,16 @@ func (p *ListNetscalerLoadBalancerNetworksParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *ListNetscalerLoadBalancerNetworksParams) validate() error {
+	for _, k := range []string{"lbdeviceid"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of listNetscalerLoadBalancerNetworks", k)
+		}
+	}
+	return nil
+}
+
 func (p *ListNetscalerLoadBalancerNetworksParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1810,6 +3448,13 @@ func (p *ListNetscalerLoadBalancerNetworksParams) SetKeyword(v string) {
 	return
 }
 
+// SetKeywordIfUnset sets keyword to v, unless it is already set
+func (p *ListNetscalerLoadBalancerNetworksParams) SetKeywordIfUnset(v string) {
+	if _, found := p.p["keyword"]; !found {
+		p.SetKeyword(v)
+	}
+}
+
 func (p *ListNetscalerLoadBalancerNetworksParams) SetLbdeviceid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1818,6 +3463,13 @@ func (p *ListNetscalerLoadBalancerNetworksParams) SetLbdeviceid(v string) {
 	return
 }
 
+// SetLbdeviceidIfUnset sets lbdeviceid to v, unless it is already set
+func (p *ListNetscalerLoadBalancerNetworksParams) SetLbdeviceidIfUnset(v string) {
+	if _, found := p.p["lbdeviceid"]; !found {
+		p.SetLbdeviceid(v)
+	}
+}
+
 func (p *ListNetscalerLoadBalancerNetworksParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1826,6 +3478,13 @@ func (p *ListNetscalerLoadBalancerNetworksParams) SetPage(v int) {
 	return
 }
 
+// SetPageIfUnset sets page to v, unless it is already set
+func (p *ListNetscalerLoadBalancerNetworksParams) SetPageIfUnset(v int) {
+	if _, found := p.p["page"]; !found {
+		p.SetPage(v)
+	}
+}
+
 func (p *ListNetscalerLoadBalancerNetworksParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1834,15 +3493,33 @@ func (p *ListNetscalerLoadBalancerNetworksParams) SetPagesize(v int) {
 	return
 }
 
+// SetPagesizeIfUnset sets pagesize to v, unless it is already set
+func (p *ListNetscalerLoadBalancerNetworksParams) SetPagesizeIfUnset(v int) {
+	if _, found := p.p["pagesize"]; !found {
+		p.SetPagesize(v)
+	}
+}
+
 // You should always use this function to get a new ListNetscalerLoadBalancerNetworksParams instance,
-// as then you are sure you have configured all required params
-func (s *NetworkService) NewListNetscalerLoadBalancerNetworksParams(lbdeviceid string) *ListNetscalerLoadBalancerNetworksParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *NetworkService) NewListNetscalerLoadBalancerNetworksParams(lbdeviceid string, opts ...func(*ListNetscalerLoadBalancerNetworksParams)) *ListNetscalerLoadBalancerNetworksParams {
 	p := &ListNetscalerLoadBalancerNetworksParams{}
 	p.p = make(map[string]interface{})
 	p.p["lbdeviceid"] = lbdeviceid
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
+func (p *ListNetscalerLoadBalancerNetworksParams) setFirstMatch() {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p[firstMatchKey] = true
+}
+
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *NetworkService) GetNetscalerLoadBalancerNetworkID(keyword string, lbdeviceid string, opts ...OptionFunc) (string, int, error) {
 	p := &ListNetscalerLoadBalancerNetworksParams{}
@@ -1851,7 +3528,7 @@ func (s *NetworkService) GetNetscalerLoadBalancerNetworkID(keyword string, lbdev
 	p.p["keyword"] = keyword
 	p.p["lbdeviceid"] = lbdeviceid
 
-	for _, fn := range append(s.cs.options, opts...) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
 		if err := fn(s.cs, p); err != nil {
 			return "", -1, err
 		}
@@ -1863,7 +3540,7 @@ func (s *NetworkService) GetNetscalerLoadBalancerNetworkID(keyword string, lbdev
 	}
 
 	if l.Count == 0 {
-		return "", l.Count, fmt.Errorf("No match found for %s: %+v", keyword, l)
+		return "", l.Count, fmt.Errorf("%w for %s: %+v", ErrNotFound, keyword, l)
 	}
 
 	if l.Count == 1 {
@@ -1876,13 +3553,36 @@ func (s *NetworkService) GetNetscalerLoadBalancerNetworkID(keyword string, lbdev
 				return v.Id, l.Count, nil
 			}
 		}
+
+		if _, ok := p.p[firstMatchKey]; ok && len(l.NetscalerLoadBalancerNetworks) > 0 {
+			return l.NetscalerLoadBalancerNetworks[0].Id, l.Count, nil
+		}
 	}
 	return "", l.Count, fmt.Errorf("Could not find an exact match for %s: %+v", keyword, l)
 }
 
 // lists network that are using a netscaler load balancer device
 func (s *NetworkService) ListNetscalerLoadBalancerNetworks(p *ListNetscalerLoadBalancerNetworksParams) (*ListNetscalerLoadBalancerNetworksResponse, error) {
-	resp, err := s.cs.newRequest("listNetscalerLoadBalancerNetworks", p.toURLValues())
+	return s.ListNetscalerLoadBalancerNetworksWithContext(context.Background(), p)
+}
+
+// ListNetscalerLoadBalancerNetworksWithContext is the same as ListNetscalerLoadBalancerNetworks, but the request is cancelled when the context is done
+func (s *NetworkService) ListNetscalerLoadBalancerNetworksWithContext(ctx context.Context, p *ListNetscalerLoadBalancerNetworksParams) (*ListNetscalerLoadBalancerNetworksResponse, error) {
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.ListNetscalerLoadBalancerNetworksRawWithContext(ctx, p.toURLValues())
+}
+
+// ListNetscalerLoadBalancerNetworksRaw is the same as ListNetscalerLoadBalancerNetworks, but takes the params as url.Values instead of typed params
+func (s *NetworkService) ListNetscalerLoadBalancerNetworksRaw(v url.Values) (*ListNetscalerLoadBalancerNetworksResponse, error) {
+	return s.ListNetscalerLoadBalancerNetworksRawWithContext(context.Background(), v)
+}
+
+// ListNetscalerLoadBalancerNetworksRawWithContext is the same as ListNetscalerLoadBalancerNetworksRaw, but the request is cancelled when the context is done
+func (s *NetworkService) ListNetscalerLoadBalancerNetworksRawWithContext(ctx context.Context, v url.Values) (*ListNetscalerLoadBalancerNetworksResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "listNetscalerLoadBalancerNetworks", "listnetscalerloadbalancernetworksresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -1895,6 +3595,93 @@ func (s *NetworkService) ListNetscalerLoadBalancerNetworks(p *ListNetscalerLoadB
 	return &r, nil
 }
 
+// StreamNetscalerLoadBalancerNetworks fetches the results of ListNetscalerLoadBalancerNetworks page by page in the background and sends them on the
+// returned channel. Both returned channels are closed when done. If fetching a page fails, or the context
+// is done before all results are sent, the error is sent on the error channel and fetching stops. A
+// request for a page in flight is cancelled when the context is done. The page and pagesize set in the
+// params are ignored.
+func (s *NetworkService) StreamNetscalerLoadBalancerNetworks(ctx context.Context, p *ListNetscalerLoadBalancerNetworksParams) (<-chan *NetscalerLoadBalancerNetwork, <-chan error) {
+	ch := make(chan *NetscalerLoadBalancerNetwork)
+	errs := make(chan error, 1)
+
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesize(500)
+
+	go func() {
+		defer close(errs)
+		defer close(ch)
+
+		for page, count := 1, 0; ; page++ {
+			pp.SetPage(page)
+
+			l, err := s.ListNetscalerLoadBalancerNetworksWithContext(ctx, pp)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			for _, v := range l.NetscalerLoadBalancerNetworks {
+				select {
+				case ch <- v:
+				case <-ctx.Done():
+					errs <- ctx.Err()
+					return
+				}
+			}
+
+			count += len(l.NetscalerLoadBalancerNetworks)
+			if len(l.NetscalerLoadBalancerNetworks) < 500 || count >= l.Count {
+				return
+			}
+		}
+	}()
+
+	return ch, errs
+}
+
+// ListNetscalerLoadBalancerNetworksWhere returns all results of ListNetscalerLoadBalancerNetworks for which pred returns true. Note that the results are
+// filtered client-side, so all results matching the params are fetched first.
+// All pages are fetched, so the page and pagesize set in the params are ignored.
+func (s *NetworkService) ListNetscalerLoadBalancerNetworksWhere(p *ListNetscalerLoadBalancerNetworksParams, pred func(*NetscalerLoadBalancerNetwork) bool) ([]*NetscalerLoadBalancerNetwork, error) {
+	var r []*NetscalerLoadBalancerNetwork
+	ch, errs := s.StreamNetscalerLoadBalancerNetworks(context.Background(), p)
+	for v := range ch {
+		if pred(v) {
+			r = append(r, v)
+		}
+	}
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ListAllNetscalerLoadBalancerNetworks returns the results of ListNetscalerLoadBalancerNetworks across all pages, by calling ListNetscalerLoadBalancerNetworks page by page until all
+// results are fetched. The pagesize set in the params is used for every page (500 if not set), and the
+// page set in the params is ignored.
+func (s *NetworkService) ListAllNetscalerLoadBalancerNetworks(p *ListNetscalerLoadBalancerNetworksParams) ([]*NetscalerLoadBalancerNetwork, error) {
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesizeIfUnset(500)
+	pagesize := pp.p["pagesize"].(int)
+
+	var r []*NetscalerLoadBalancerNetwork
+	for page := 1; ; page++ {
+		pp.SetPage(page)
+
+		l, err := s.ListNetscalerLoadBalancerNetworks(pp)
+		if err != nil {
+			return nil, err
+		}
+		r = append(r, l.NetscalerLoadBalancerNetworks...)
+
+		if len(l.NetscalerLoadBalancerNetworks) < pagesize || len(r) >= l.Count {
+			return r, nil
+		}
+	}
+}
+
 type ListNetscalerLoadBalancerNetworksResponse struct {
 	Count                         int                             `json:"count"`
 	NetscalerLoadBalancerNetworks []*NetscalerLoadBalancerNetwork `json:"netscalerloadbalancernetwork"`
@@ -1982,6 +3769,16 @@ type ListNetworkIsolationMethodsParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ListNetworkIsolationMethodsParams) Clone() *ListNetworkIsolationMethodsParams {
+	c := &ListNetworkIsolationMethodsParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListNetworkIsolationMethodsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -2009,6 +3806,13 @@ func (p *ListNetworkIsolationMethodsParams) SetKeyword(v string) {
 	return
 }
 
+// SetKeywordIfUnset sets keyword to v, unless it is already set
+func (p *ListNetworkIsolationMethodsParams) SetKeywordIfUnset(v string) {
+	if _, found := p.p["keyword"]; !found {
+		p.SetKeyword(v)
+	}
+}
+
 func (p *ListNetworkIsolationMethodsParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2017,6 +3821,13 @@ func (p *ListNetworkIsolationMethodsParams) SetPage(v int) {
 	return
 }
 
+// SetPageIfUnset sets page to v, unless it is already set
+func (p *ListNetworkIsolationMethodsParams) SetPageIfUnset(v int) {
+	if _, found := p.p["page"]; !found {
+		p.SetPage(v)
+	}
+}
+
 func (p *ListNetworkIsolationMethodsParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2025,17 +3836,43 @@ func (p *ListNetworkIsolationMethodsParams) SetPagesize(v int) {
 	return
 }
 
+// SetPagesizeIfUnset sets pagesize to v, unless it is already set
+func (p *ListNetworkIsolationMethodsParams) SetPagesizeIfUnset(v int) {
+	if _, found := p.p["pagesize"]; !found {
+		p.SetPagesize(v)
+	}
+}
+
 // You should always use this function to get a new ListNetworkIsolationMethodsParams instance,
-// as then you are sure you have configured all required params
-func (s *NetworkService) NewListNetworkIsolationMethodsParams() *ListNetworkIsolationMethodsParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *NetworkService) NewListNetworkIsolationMethodsParams(opts ...func(*ListNetworkIsolationMethodsParams)) *ListNetworkIsolationMethodsParams {
 	p := &ListNetworkIsolationMethodsParams{}
 	p.p = make(map[string]interface{})
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Lists supported methods of network isolation
 func (s *NetworkService) ListNetworkIsolationMethods(p *ListNetworkIsolationMethodsParams) (*ListNetworkIsolationMethodsResponse, error) {
-	resp, err := s.cs.newRequest("listNetworkIsolationMethods", p.toURLValues())
+	return s.ListNetworkIsolationMethodsWithContext(context.Background(), p)
+}
+
+// ListNetworkIsolationMethodsWithContext is the same as ListNetworkIsolationMethods, but the request is cancelled when the context is done
+func (s *NetworkService) ListNetworkIsolationMethodsWithContext(ctx context.Context, p *ListNetworkIsolationMethodsParams) (*ListNetworkIsolationMethodsResponse, error) {
+	return s.ListNetworkIsolationMethodsRawWithContext(ctx, p.toURLValues())
+}
+
+// ListNetworkIsolationMethodsRaw is the same as ListNetworkIsolationMethods, but takes the params as url.Values instead of typed params
+func (s *NetworkService) ListNetworkIsolationMethodsRaw(v url.Values) (*ListNetworkIsolationMethodsResponse, error) {
+	return s.ListNetworkIsolationMethodsRawWithContext(context.Background(), v)
+}
+
+// ListNetworkIsolationMethodsRawWithContext is the same as ListNetworkIsolationMethodsRaw, but the request is cancelled when the context is done
+func (s *NetworkService) ListNetworkIsolationMethodsRawWithContext(ctx context.Context, v url.Values) (*ListNetworkIsolationMethodsResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "listNetworkIsolationMethods", "listnetworkisolationmethodsresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -2048,6 +3885,93 @@ func (s *NetworkService) ListNetworkIsolationMethods(p *ListNetworkIsolationMeth
 	return &r, nil
 }
 
+// StreamNetworkIsolationMethods fetches the results of ListNetworkIsolationMethods page by page in the background and sends them on the
+// returned channel. Both returned channels are closed when done. If fetching a page fails, or the context
+// is done before all results are sent, the error is sent on the error channel and fetching stops. A
+// request for a page in flight is cancelled when the context is done. The page and pagesize set in the
+// params are ignored.
+func (s *NetworkService) StreamNetworkIsolationMethods(ctx context.Context, p *ListNetworkIsolationMethodsParams) (<-chan *NetworkIsolationMethod, <-chan error) {
+	ch := make(chan *NetworkIsolationMethod)
+	errs := make(chan error, 1)
+
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesize(500)
+
+	go func() {
+		defer close(errs)
+		defer close(ch)
+
+		for page, count := 1, 0; ; page++ {
+			pp.SetPage(page)
+
+			l, err := s.ListNetworkIsolationMethodsWithContext(ctx, pp)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			for _, v := range l.NetworkIsolationMethods {
+				select {
+				case ch <- v:
+				case <-ctx.Done():
+					errs <- ctx.Err()
+					return
+				}
+			}
+
+			count += len(l.NetworkIsolationMethods)
+			if len(l.NetworkIsolationMethods) < 500 || count >= l.Count {
+				return
+			}
+		}
+	}()
+
+	return ch, errs
+}
+
+// ListNetworkIsolationMethodsWhere returns all results of ListNetworkIsolationMethods for which pred returns true. Note that the results are
+// filtered client-side, so all results matching the params are fetched first.
+// All pages are fetched, so the page and pagesize set in the params are ignored.
+func (s *NetworkService) ListNetworkIsolationMethodsWhere(p *ListNetworkIsolationMethodsParams, pred func(*NetworkIsolationMethod) bool) ([]*NetworkIsolationMethod, error) {
+	var r []*NetworkIsolationMethod
+	ch, errs := s.StreamNetworkIsolationMethods(context.Background(), p)
+	for v := range ch {
+		if pred(v) {
+			r = append(r, v)
+		}
+	}
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ListAllNetworkIsolationMethods returns the results of ListNetworkIsolationMethods across all pages, by calling ListNetworkIsolationMethods page by page until all
+// results are fetched. The pagesize set in the params is used for every page (500 if not set), and the
+// page set in the params is ignored.
+func (s *NetworkService) ListAllNetworkIsolationMethods(p *ListNetworkIsolationMethodsParams) ([]*NetworkIsolationMethod, error) {
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesizeIfUnset(500)
+	pagesize := pp.p["pagesize"].(int)
+
+	var r []*NetworkIsolationMethod
+	for page := 1; ; page++ {
+		pp.SetPage(page)
+
+		l, err := s.ListNetworkIsolationMethods(pp)
+		if err != nil {
+			return nil, err
+		}
+		r = append(r, l.NetworkIsolationMethods...)
+
+		if len(l.NetworkIsolationMethods) < pagesize || len(r) >= l.Count {
+			return r, nil
+		}
+	}
+}
+
 type ListNetworkIsolationMethodsResponse struct {
 	Count                   int                       `json:"count"`
 	NetworkIsolationMethods []*NetworkIsolationMethod `json:"networkisolationmethod"`
@@ -2061,6 +3985,16 @@ type ListNetworkServiceProvidersParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ListNetworkServiceProvidersParams) Clone() *ListNetworkServiceProvidersParams {
+	c := &ListNetworkServiceProvidersParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListNetworkServiceProvidersParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -2097,6 +4031,13 @@ func (p *ListNetworkServiceProvidersParams) SetKeyword(v string) {
 	return
 }
 
+// SetKeywordIfUnset sets keyword to v, unless it is already set
+func (p *ListNetworkServiceProvidersParams) SetKeywordIfUnset(v string) {
+	if _, found := p.p["keyword"]; !found {
+		p.SetKeyword(v)
+	}
+}
+
 func (p *ListNetworkServiceProvidersParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2105,6 +4046,13 @@ func (p *ListNetworkServiceProvidersParams) SetName(v string) {
 	return
 }
 
+// SetNameIfUnset sets name to v, unless it is already set
+func (p *ListNetworkServiceProvidersParams) SetNameIfUnset(v string) {
+	if _, found := p.p["name"]; !found {
+		p.SetName(v)
+	}
+}
+
 func (p *ListNetworkServiceProvidersParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2113,6 +4061,13 @@ func (p *ListNetworkServiceProvidersParams) SetPage(v int) {
 	return
 }
 
+// SetPageIfUnset sets page to v, unless it is already set
+func (p *ListNetworkServiceProvidersParams) SetPageIfUnset(v int) {
+	if _, found := p.p["page"]; !found {
+		p.SetPage(v)
+	}
+}
+
 func (p *ListNetworkServiceProvidersParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2121,6 +4076,13 @@ func (p *ListNetworkServiceProvidersParams) SetPagesize(v int) {
 	return
 }
 
+// SetPagesizeIfUnset sets pagesize to v, unless it is already set
+func (p *ListNetworkServiceProvidersParams) SetPagesizeIfUnset(v int) {
+	if _, found := p.p["pagesize"]; !found {
+		p.SetPagesize(v)
+	}
+}
+
 func (p *ListNetworkServiceProvidersParams) SetPhysicalnetworkid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2129,6 +4091,13 @@ func (p *ListNetworkServiceProvidersParams) SetPhysicalnetworkid(v string) {
 	return
 }
 
+// SetPhysicalnetworkidIfUnset sets physicalnetworkid to v, unless it is already set
+func (p *ListNetworkServiceProvidersParams) SetPhysicalnetworkidIfUnset(v string) {
+	if _, found := p.p["physicalnetworkid"]; !found {
+		p.SetPhysicalnetworkid(v)
+	}
+}
+
 func (p *ListNetworkServiceProvidersParams) SetState(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2137,14 +4106,32 @@ func (p *ListNetworkServiceProvidersParams) SetState(v string) {
 	return
 }
 
+// SetStateIfUnset sets state to v, unless it is already set
+func (p *ListNetworkServiceProvidersParams) SetStateIfUnset(v string) {
+	if _, found := p.p["state"]; !found {
+		p.SetState(v)
+	}
+}
+
 // You should always use this function to get a new ListNetworkServiceProvidersParams instance,
-// as then you are sure you have configured all required params
-func (s *NetworkService) NewListNetworkServiceProvidersParams() *ListNetworkServiceProvidersParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *NetworkService) NewListNetworkServiceProvidersParams(opts ...func(*ListNetworkServiceProvidersParams)) *ListNetworkServiceProvidersParams {
 	p := &ListNetworkServiceProvidersParams{}
 	p.p = make(map[string]interface{})
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
+func (p *ListNetworkServiceProvidersParams) setFirstMatch() {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p[firstMatchKey] = true
+}
+
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *NetworkService) GetNetworkServiceProviderID(name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListNetworkServiceProvidersParams{}
@@ -2152,7 +4139,7 @@ func (s *NetworkService) GetNetworkServiceProviderID(name string, opts ...Option
 
 	p.p["name"] = name
 
-	for _, fn := range append(s.cs.options, opts...) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
 		if err := fn(s.cs, p); err != nil {
 			return "", -1, err
 		}
@@ -2164,7 +4151,7 @@ func (s *NetworkService) GetNetworkServiceProviderID(name string, opts ...Option
 	}
 
 	if l.Count == 0 {
-		return "", l.Count, fmt.Errorf("No match found for %s: %+v", name, l)
+		return "", l.Count, fmt.Errorf("%w for %s: %+v", ErrNotFound, name, l)
 	}
 
 	if l.Count == 1 {
@@ -2177,13 +4164,32 @@ func (s *NetworkService) GetNetworkServiceProviderID(name string, opts ...Option
 				return v.Id, l.Count, nil
 			}
 		}
+
+		if _, ok := p.p[firstMatchKey]; ok && len(l.NetworkServiceProviders) > 0 {
+			return l.NetworkServiceProviders[0].Id, l.Count, nil
+		}
 	}
 	return "", l.Count, fmt.Errorf("Could not find an exact match for %s: %+v", name, l)
 }
 
 // Lists network serviceproviders for a given physical network.
 func (s *NetworkService) ListNetworkServiceProviders(p *ListNetworkServiceProvidersParams) (*ListNetworkServiceProvidersResponse, error) {
-	resp, err := s.cs.newRequest("listNetworkServiceProviders", p.toURLValues())
+	return s.ListNetworkServiceProvidersWithContext(context.Background(), p)
+}
+
+// ListNetworkServiceProvidersWithContext is the same as ListNetworkServiceProviders, but the request is cancelled when the context is done
+func (s *NetworkService) ListNetworkServiceProvidersWithContext(ctx context.Context, p *ListNetworkServiceProvidersParams) (*ListNetworkServiceProvidersResponse, error) {
+	return s.ListNetworkServiceProvidersRawWithContext(ctx, p.toURLValues())
+}
+
+// ListNetworkServiceProvidersRaw is the same as ListNetworkServiceProviders, but takes the params as url.Values instead of typed params
+func (s *NetworkService) ListNetworkServiceProvidersRaw(v url.Values) (*ListNetworkServiceProvidersResponse, error) {
+	return s.ListNetworkServiceProvidersRawWithContext(context.Background(), v)
+}
+
+// ListNetworkServiceProvidersRawWithContext is the same as ListNetworkServiceProvidersRaw, but the request is cancelled when the context is done
+func (s *NetworkService) ListNetworkServiceProvidersRawWithContext(ctx context.Context, v url.Values) (*ListNetworkServiceProvidersResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "listNetworkServiceProviders", "listnetworkserviceprovidersresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -2193,7 +4199,94 @@ func (s *NetworkService) ListNetworkServiceProviders(p *ListNetworkServiceProvid
 		return nil, err
 	}
 
-	return &r, nil
+	return &r, nil
+}
+
+// StreamNetworkServiceProviders fetches the results of ListNetworkServiceProviders page by page in the background and sends them on the
+// returned channel. Both returned channels are closed when done. If fetching a page fails, or the context
+// is done before all results are sent, the error is sent on the error channel and fetching stops. A
+// request for a page in flight is cancelled when the context is done. The page and pagesize set in the
+// params are ignored.
+func (s *NetworkService) StreamNetworkServiceProviders(ctx context.Context, p *ListNetworkServiceProvidersParams) (<-chan *NetworkServiceProvider, <-chan error) {
+	ch := make(chan *NetworkServiceProvider)
+	errs := make(chan error, 1)
+
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesize(500)
+
+	go func() {
+		defer close(errs)
+		defer close(ch)
+
+		for page, count := 1, 0; ; page++ {
+			pp.SetPage(page)
+
+			l, err := s.ListNetworkServiceProvidersWithContext(ctx, pp)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			for _, v := range l.NetworkServiceProviders {
+				select {
+				case ch <- v:
+				case <-ctx.Done():
+					errs <- ctx.Err()
+					return
+				}
+			}
+
+			count += len(l.NetworkServiceProviders)
+			if len(l.NetworkServiceProviders) < 500 || count >= l.Count {
+				return
+			}
+		}
+	}()
+
+	return ch, errs
+}
+
+// ListNetworkServiceProvidersWhere returns all results of ListNetworkServiceProviders for which pred returns true. Note that the results are
+// filtered client-side, so all results matching the params are fetched first.
+// All pages are fetched, so the page and pagesize set in the params are ignored.
+func (s *NetworkService) ListNetworkServiceProvidersWhere(p *ListNetworkServiceProvidersParams, pred func(*NetworkServiceProvider) bool) ([]*NetworkServiceProvider, error) {
+	var r []*NetworkServiceProvider
+	ch, errs := s.StreamNetworkServiceProviders(context.Background(), p)
+	for v := range ch {
+		if pred(v) {
+			r = append(r, v)
+		}
+	}
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ListAllNetworkServiceProviders returns the results of ListNetworkServiceProviders across all pages, by calling ListNetworkServiceProviders page by page until all
+// results are fetched. The pagesize set in the params is used for every page (500 if not set), and the
+// page set in the params is ignored.
+func (s *NetworkService) ListAllNetworkServiceProviders(p *ListNetworkServiceProvidersParams) ([]*NetworkServiceProvider, error) {
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesizeIfUnset(500)
+	pagesize := pp.p["pagesize"].(int)
+
+	var r []*NetworkServiceProvider
+	for page := 1; ; page++ {
+		pp.SetPage(page)
+
+		l, err := s.ListNetworkServiceProviders(pp)
+		if err != nil {
+			return nil, err
+		}
+		r = append(r, l.NetworkServiceProviders...)
+
+		if len(l.NetworkServiceProviders) < pagesize || len(r) >= l.Count {
+			return r, nil
+		}
+	}
 }
 
 type ListNetworkServiceProvidersResponse struct {
@@ -2215,6 +4308,16 @@ type ListNetworksParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ListNetworksParams) Clone() *ListNetworksParams {
+	c := &ListNetworksParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListNetworksParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -2286,11 +4389,10 @@ func (p *ListNetworksParams) toURLValues() url.Values {
 		u.Set("supportedservices", vv)
 	}
 	if v, found := p.p["tags"]; found {
-		i := 0
-		for k, vv := range v.(map[string]string) {
+		m := v.(map[string]string)
+		for i, k := range getSortedKeysFromMap(m) {
 			u.Set(fmt.Sprintf("tags[%d].key", i), k)
-			u.Set(fmt.Sprintf("tags[%d].value", i), vv)
-			i++
+			u.Set(fmt.Sprintf("tags[%d].value", i), m[k])
 		}
 	}
 	if v, found := p.p["traffictype"]; found {
@@ -2316,14 +4418,28 @@ func (p *ListNetworksParams) SetAccount(v string) {
 	return
 }
 
-func (p *ListNetworksParams) SetAcltype(v string) {
+// SetAccountIfUnset sets account to v, unless it is already set
+func (p *ListNetworksParams) SetAccountIfUnset(v string) {
+	if _, found := p.p["account"]; !found {
+		p.SetAccount(v)
+	}
+}
+
+func (p *ListNetworksParams) SetAcltype(v ACLType) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	p.p["acltype"] = v
+	p.p["acltype"] = string(v)
 	return
 }
 
+// SetAcltypeIfUnset sets acltype to v, unless it is already set
+func (p *ListNetworksParams) SetAcltypeIfUnset(v ACLType) {
+	if _, found := p.p["acltype"]; !found {
+		p.SetAcltype(v)
+	}
+}
+
 func (p *ListNetworksParams) SetCanusefordeploy(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2332,6 +4448,13 @@ func (p *ListNetworksParams) SetCanusefordeploy(v bool) {
 	return
 }
 
+// SetCanusefordeployIfUnset sets canusefordeploy to v, unless it is already set
+func (p *ListNetworksParams) SetCanusefordeployIfUnset(v bool) {
+	if _, found := p.p["canusefordeploy"]; !found {
+		p.SetCanusefordeploy(v)
+	}
+}
+
 func (p *ListNetworksParams) SetDisplaynetwork(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2340,6 +4463,13 @@ func (p *ListNetworksParams) SetDisplaynetwork(v bool) {
 	return
 }
 
+// SetDisplaynetworkIfUnset sets displaynetwork to v, unless it is already set
+func (p *ListNetworksParams) SetDisplaynetworkIfUnset(v bool) {
+	if _, found := p.p["displaynetwork"]; !found {
+		p.SetDisplaynetwork(v)
+	}
+}
+
 func (p *ListNetworksParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2348,6 +4478,13 @@ func (p *ListNetworksParams) SetDomainid(v string) {
 	return
 }
 
+// SetDomainidIfUnset sets domainid to v, unless it is already set
+func (p *ListNetworksParams) SetDomainidIfUnset(v string) {
+	if _, found := p.p["domainid"]; !found {
+		p.SetDomainid(v)
+	}
+}
+
 func (p *ListNetworksParams) SetForvpc(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2356,6 +4493,13 @@ func (p *ListNetworksParams) SetForvpc(v bool) {
 	return
 }
 
+// SetForvpcIfUnset sets forvpc to v, unless it is already set
+func (p *ListNetworksParams) SetForvpcIfUnset(v bool) {
+	if _, found := p.p["forvpc"]; !found {
+		p.SetForvpc(v)
+	}
+}
+
 func (p *ListNetworksParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2364,6 +4508,13 @@ func (p *ListNetworksParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *ListNetworksParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 func (p *ListNetworksParams) SetIsrecursive(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2372,6 +4523,13 @@ func (p *ListNetworksParams) SetIsrecursive(v bool) {
 	return
 }
 
+// SetIsrecursiveIfUnset sets isrecursive to v, unless it is already set
+func (p *ListNetworksParams) SetIsrecursiveIfUnset(v bool) {
+	if _, found := p.p["isrecursive"]; !found {
+		p.SetIsrecursive(v)
+	}
+}
+
 func (p *ListNetworksParams) SetIssystem(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2380,6 +4538,13 @@ func (p *ListNetworksParams) SetIssystem(v bool) {
 	return
 }
 
+// SetIssystemIfUnset sets issystem to v, unless it is already set
+func (p *ListNetworksParams) SetIssystemIfUnset(v bool) {
+	if _, found := p.p["issystem"]; !found {
+		p.SetIssystem(v)
+	}
+}
+
 func (p *ListNetworksParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2388,6 +4553,13 @@ func (p *ListNetworksParams) SetKeyword(v string) {
 	return
 }
 
+// SetKeywordIfUnset sets keyword to v, unless it is already set
+func (p *ListNetworksParams) SetKeywordIfUnset(v string) {
+	if _, found := p.p["keyword"]; !found {
+		p.SetKeyword(v)
+	}
+}
+
 func (p *ListNetworksParams) SetListall(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2396,6 +4568,13 @@ func (p *ListNetworksParams) SetListall(v bool) {
 	return
 }
 
+// SetListallIfUnset sets listall to v, unless it is already set
+func (p *ListNetworksParams) SetListallIfUnset(v bool) {
+	if _, found := p.p["listall"]; !found {
+		p.SetListall(v)
+	}
+}
+
 func (p *ListNetworksParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2404,6 +4583,13 @@ func (p *ListNetworksParams) SetPage(v int) {
 	return
 }
 
+// SetPageIfUnset sets page to v, unless it is already set
+func (p *ListNetworksParams) SetPageIfUnset(v int) {
+	if _, found := p.p["page"]; !found {
+		p.SetPage(v)
+	}
+}
+
 func (p *ListNetworksParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2412,6 +4598,13 @@ func (p *ListNetworksParams) SetPagesize(v int) {
 	return
 }
 
+// SetPagesizeIfUnset sets pagesize to v, unless it is already set
+func (p *ListNetworksParams) SetPagesizeIfUnset(v int) {
+	if _, found := p.p["pagesize"]; !found {
+		p.SetPagesize(v)
+	}
+}
+
 func (p *ListNetworksParams) SetPhysicalnetworkid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2420,6 +4613,13 @@ func (p *ListNetworksParams) SetPhysicalnetworkid(v string) {
 	return
 }
 
+// SetPhysicalnetworkidIfUnset sets physicalnetworkid to v, unless it is already set
+func (p *ListNetworksParams) SetPhysicalnetworkidIfUnset(v string) {
+	if _, found := p.p["physicalnetworkid"]; !found {
+		p.SetPhysicalnetworkid(v)
+	}
+}
+
 func (p *ListNetworksParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2428,6 +4628,13 @@ func (p *ListNetworksParams) SetProjectid(v string) {
 	return
 }
 
+// SetProjectidIfUnset sets projectid to v, unless it is already set
+func (p *ListNetworksParams) SetProjectidIfUnset(v string) {
+	if _, found := p.p["projectid"]; !found {
+		p.SetProjectid(v)
+	}
+}
+
 func (p *ListNetworksParams) SetRestartrequired(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2436,6 +4643,13 @@ func (p *ListNetworksParams) SetRestartrequired(v bool) {
 	return
 }
 
+// SetRestartrequiredIfUnset sets restartrequired to v, unless it is already set
+func (p *ListNetworksParams) SetRestartrequiredIfUnset(v bool) {
+	if _, found := p.p["restartrequired"]; !found {
+		p.SetRestartrequired(v)
+	}
+}
+
 func (p *ListNetworksParams) SetSpecifyipranges(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2444,6 +4658,13 @@ func (p *ListNetworksParams) SetSpecifyipranges(v bool) {
 	return
 }
 
+// SetSpecifyiprangesIfUnset sets specifyipranges to v, unless it is already set
+func (p *ListNetworksParams) SetSpecifyiprangesIfUnset(v bool) {
+	if _, found := p.p["specifyipranges"]; !found {
+		p.SetSpecifyipranges(v)
+	}
+}
+
 func (p *ListNetworksParams) SetSupportedservices(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2452,6 +4673,18 @@ func (p *ListNetworksParams) SetSupportedservices(v []string) {
 	return
 }
 
+// SetSupportedservicesIfUnset sets supportedservices to v, unless it is already set
+func (p *ListNetworksParams) SetSupportedservicesIfUnset(v []string) {
+	if _, found := p.p["supportedservices"]; !found {
+		p.SetSupportedservices(v)
+	}
+}
+
+// SetSupportedservicesCSV splits v on commas and sets the resulting values
+func (p *ListNetworksParams) SetSupportedservicesCSV(v string) {
+	p.SetSupportedservices(splitCSV(v))
+}
+
 func (p *ListNetworksParams) SetTags(v map[string]string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2460,6 +4693,13 @@ func (p *ListNetworksParams) SetTags(v map[string]string) {
 	return
 }
 
+// SetTagsIfUnset sets tags to v, unless it is already set
+func (p *ListNetworksParams) SetTagsIfUnset(v map[string]string) {
+	if _, found := p.p["tags"]; !found {
+		p.SetTags(v)
+	}
+}
+
 func (p *ListNetworksParams) SetTraffictype(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2468,6 +4708,13 @@ func (p *ListNetworksParams) SetTraffictype(v string) {
 	return
 }
 
+// SetTraffictypeIfUnset sets traffictype to v, unless it is already set
+func (p *ListNetworksParams) SetTraffictypeIfUnset(v string) {
+	if _, found := p.p["traffictype"]; !found {
+		p.SetTraffictype(v)
+	}
+}
+
 func (p *ListNetworksParams) SetType(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2476,6 +4723,13 @@ func (p *ListNetworksParams) SetType(v string) {
 	return
 }
 
+// SetTypeIfUnset sets type to v, unless it is already set
+func (p *ListNetworksParams) SetTypeIfUnset(v string) {
+	if _, found := p.p["type"]; !found {
+		p.SetType(v)
+	}
+}
+
 func (p *ListNetworksParams) SetVpcid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2484,6 +4738,13 @@ func (p *ListNetworksParams) SetVpcid(v string) {
 	return
 }
 
+// SetVpcidIfUnset sets vpcid to v, unless it is already set
+func (p *ListNetworksParams) SetVpcidIfUnset(v string) {
+	if _, found := p.p["vpcid"]; !found {
+		p.SetVpcid(v)
+	}
+}
+
 func (p *ListNetworksParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2492,14 +4753,34 @@ func (p *ListNetworksParams) SetZoneid(v string) {
 	return
 }
 
+// SetZoneidIfUnset sets zoneid to v, unless it is already set
+func (p *ListNetworksParams) SetZoneidIfUnset(v string) {
+	if _, found := p.p["zoneid"]; !found {
+		p.SetZoneid(v)
+	}
+}
+
+var _ ListAllSetter = (*ListNetworksParams)(nil)
+
 // You should always use this function to get a new ListNetworksParams instance,
-// as then you are sure you have configured all required params
-func (s *NetworkService) NewListNetworksParams() *ListNetworksParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *NetworkService) NewListNetworksParams(opts ...func(*ListNetworksParams)) *ListNetworksParams {
 	p := &ListNetworksParams{}
 	p.p = make(map[string]interface{})
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
+func (p *ListNetworksParams) setFirstMatch() {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p[firstMatchKey] = true
+}
+
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *NetworkService) GetNetworkID(keyword string, opts ...OptionFunc) (string, int, error) {
 	p := &ListNetworksParams{}
@@ -2507,7 +4788,7 @@ func (s *NetworkService) GetNetworkID(keyword string, opts ...OptionFunc) (strin
 
 	p.p["keyword"] = keyword
 
-	for _, fn := range append(s.cs.options, opts...) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
 		if err := fn(s.cs, p); err != nil {
 			return "", -1, err
 		}
@@ -2519,7 +4800,7 @@ func (s *NetworkService) GetNetworkID(keyword string, opts ...OptionFunc) (strin
 	}
 
 	if l.Count == 0 {
-		return "", l.Count, fmt.Errorf("No match found for %s: %+v", keyword, l)
+		return "", l.Count, fmt.Errorf("%w for %s: %+v", ErrNotFound, keyword, l)
 	}
 
 	if l.Count == 1 {
@@ -2532,6 +4813,10 @@ func (s *NetworkService) GetNetworkID(keyword string, opts ...OptionFunc) (strin
 				return v.Id, l.Count, nil
 			}
 		}
+
+		if _, ok := p.p[firstMatchKey]; ok && len(l.Networks) > 0 {
+			return l.Networks[0].Id, l.Count, nil
+		}
 	}
 	return "", l.Count, fmt.Errorf("Could not find an exact match for %s: %+v", keyword, l)
 }
@@ -2557,7 +4842,7 @@ func (s *NetworkService) GetNetworkByID(id string, opts ...OptionFunc) (*Network
 
 	p.p["id"] = id
 
-	for _, fn := range append(s.cs.options, opts...) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
 		if err := fn(s.cs, p); err != nil {
 			return nil, -1, err
 		}
@@ -2568,24 +4853,60 @@ func (s *NetworkService) GetNetworkByID(id string, opts ...OptionFunc) (*Network
 		if strings.Contains(err.Error(), fmt.Sprintf(
 			"Invalid parameter id value=%s due to incorrect long value format, "+
 				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+			return nil, 0, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
 	}
 
 	if l.Count == 1 {
 		return l.Networks[0], l.Count, nil
 	}
+
+	if _, ok := p.p[firstMatchKey]; ok && len(l.Networks) > 0 {
+		return l.Networks[0], l.Count, nil
+	}
 	return nil, l.Count, fmt.Errorf("There is more then one result for Network UUID: %s!", id)
 }
 
+// NetworkExists returns true if a Network with the given ID exists. When no match is found
+// it returns false, while any other error is returned as is.
+func (s *NetworkService) NetworkExists(id string, opts ...OptionFunc) (bool, error) {
+	_, count, err := s.GetNetworkByID(id, opts...)
+	if errors.Is(err, ErrNotFound) {
+		return false, nil
+	}
+	if count > 0 {
+		return true, nil
+	}
+	return false, err
+}
+
 // Lists all available networks.
 func (s *NetworkService) ListNetworks(p *ListNetworksParams) (*ListNetworksResponse, error) {
-	resp, err := s.cs.newRequest("listNetworks", p.toURLValues())
+	return s.ListNetworksWithContext(context.Background(), p)
+}
+
+// ListNetworksWithContext is the same as ListNetworks, but the request is cancelled when the context is done
+func (s *NetworkService) ListNetworksWithContext(ctx context.Context, p *ListNetworksParams) (*ListNetworksResponse, error) {
+	if err := checkMutuallyExclusive(p.p, "account", "projectid"); err != nil {
+		return nil, err
+	}
+
+	return s.ListNetworksRawWithContext(ctx, p.toURLValues())
+}
+
+// ListNetworksRaw is the same as ListNetworks, but takes the params as url.Values instead of typed params
+func (s *NetworkService) ListNetworksRaw(v url.Values) (*ListNetworksResponse, error) {
+	return s.ListNetworksRawWithContext(context.Background(), v)
+}
+
+// ListNetworksRawWithContext is the same as ListNetworksRaw, but the request is cancelled when the context is done
+func (s *NetworkService) ListNetworksRawWithContext(ctx context.Context, v url.Values) (*ListNetworksResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "listNetworks", "listnetworksresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -2598,6 +4919,93 @@ func (s *NetworkService) ListNetworks(p *ListNetworksParams) (*ListNetworksRespo
 	return &r, nil
 }
 
+// StreamNetworks fetches the results of ListNetworks page by page in the background and sends them on the
+// returned channel. Both returned channels are closed when done. If fetching a page fails, or the context
+// is done before all results are sent, the error is sent on the error channel and fetching stops. A
+// request for a page in flight is cancelled when the context is done. The page and pagesize set in the
+// params are ignored.
+func (s *NetworkService) StreamNetworks(ctx context.Context, p *ListNetworksParams) (<-chan *Network, <-chan error) {
+	ch := make(chan *Network)
+	errs := make(chan error, 1)
+
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesize(500)
+
+	go func() {
+		defer close(errs)
+		defer close(ch)
+
+		for page, count := 1, 0; ; page++ {
+			pp.SetPage(page)
+
+			l, err := s.ListNetworksWithContext(ctx, pp)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			for _, v := range l.Networks {
+				select {
+				case ch <- v:
+				case <-ctx.Done():
+					errs <- ctx.Err()
+					return
+				}
+			}
+
+			count += len(l.Networks)
+			if len(l.Networks) < 500 || count >= l.Count {
+				return
+			}
+		}
+	}()
+
+	return ch, errs
+}
+
+// ListNetworksWhere returns all results of ListNetworks for which pred returns true. Note that the results are
+// filtered client-side, so all results matching the params are fetched first.
+// All pages are fetched, so the page and pagesize set in the params are ignored.
+func (s *NetworkService) ListNetworksWhere(p *ListNetworksParams, pred func(*Network) bool) ([]*Network, error) {
+	var r []*Network
+	ch, errs := s.StreamNetworks(context.Background(), p)
+	for v := range ch {
+		if pred(v) {
+			r = append(r, v)
+		}
+	}
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ListAllNetworks returns the results of ListNetworks across all pages, by calling ListNetworks page by page until all
+// results are fetched. The pagesize set in the params is used for every page (500 if not set), and the
+// page set in the params is ignored.
+func (s *NetworkService) ListAllNetworks(p *ListNetworksParams) ([]*Network, error) {
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesizeIfUnset(500)
+	pagesize := pp.p["pagesize"].(int)
+
+	var r []*Network
+	for page := 1; ; page++ {
+		pp.SetPage(page)
+
+		l, err := s.ListNetworks(pp)
+		if err != nil {
+			return nil, err
+		}
+		r = append(r, l.Networks...)
+
+		if len(l.Networks) < pagesize || len(r) >= l.Count {
+			return r, nil
+		}
+	}
+}
+
 type ListNetworksResponse struct {
 	Count    int        `json:"count"`
 	Networks []*Network `json:"network"`
@@ -2685,6 +5093,16 @@ type ListNiciraNvpDeviceNetworksParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ListNiciraNvpDeviceNetworksParams) Clone() *ListNiciraNvpDeviceNetworksParams {
+	c := &ListNiciraNvpDeviceNetworksParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListNiciraNvpDeviceNetworksParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -2707,6 +5125,16 @@ func (p *ListNiciraNvpDeviceNetworksParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *ListNiciraNvpDeviceNetworksParams) validate() error {
+	for _, k := range []string{"nvpdeviceid"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of listNiciraNvpDeviceNetworks", k)
+		}
+	}
+	return nil
+}
+
 func (p *ListNiciraNvpDeviceNetworksParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2715,6 +5143,13 @@ func (p *ListNiciraNvpDeviceNetworksParams) SetKeyword(v string) {
 	return
 }
 
+// SetKeywordIfUnset sets keyword to v, unless it is already set
+func (p *ListNiciraNvpDeviceNetworksParams) SetKeywordIfUnset(v string) {
+	if _, found := p.p["keyword"]; !found {
+		p.SetKeyword(v)
+	}
+}
+
 func (p *ListNiciraNvpDeviceNetworksParams) SetNvpdeviceid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2723,6 +5158,13 @@ func (p *ListNiciraNvpDeviceNetworksParams) SetNvpdeviceid(v string) {
 	return
 }
 
+// SetNvpdeviceidIfUnset sets nvpdeviceid to v, unless it is already set
+func (p *ListNiciraNvpDeviceNetworksParams) SetNvpdeviceidIfUnset(v string) {
+	if _, found := p.p["nvpdeviceid"]; !found {
+		p.SetNvpdeviceid(v)
+	}
+}
+
 func (p *ListNiciraNvpDeviceNetworksParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2731,6 +5173,13 @@ func (p *ListNiciraNvpDeviceNetworksParams) SetPage(v int) {
 	return
 }
 
+// SetPageIfUnset sets page to v, unless it is already set
+func (p *ListNiciraNvpDeviceNetworksParams) SetPageIfUnset(v int) {
+	if _, found := p.p["page"]; !found {
+		p.SetPage(v)
+	}
+}
+
 func (p *ListNiciraNvpDeviceNetworksParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2739,15 +5188,33 @@ func (p *ListNiciraNvpDeviceNetworksParams) SetPagesize(v int) {
 	return
 }
 
+// SetPagesizeIfUnset sets pagesize to v, unless it is already set
+func (p *ListNiciraNvpDeviceNetworksParams) SetPagesizeIfUnset(v int) {
+	if _, found := p.p["pagesize"]; !found {
+		p.SetPagesize(v)
+	}
+}
+
 // You should always use this function to get a new ListNiciraNvpDeviceNetworksParams instance,
-// as then you are sure you have configured all required params
-func (s *NetworkService) NewListNiciraNvpDeviceNetworksParams(nvpdeviceid string) *ListNiciraNvpDeviceNetworksParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *NetworkService) NewListNiciraNvpDeviceNetworksParams(nvpdeviceid string, opts ...func(*ListNiciraNvpDeviceNetworksParams)) *ListNiciraNvpDeviceNetworksParams {
 	p := &ListNiciraNvpDeviceNetworksParams{}
 	p.p = make(map[string]interface{})
 	p.p["nvpdeviceid"] = nvpdeviceid
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
+func (p *ListNiciraNvpDeviceNetworksParams) setFirstMatch() {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p[firstMatchKey] = true
+}
+
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *NetworkService) GetNiciraNvpDeviceNetworkID(keyword string, nvpdeviceid string, opts ...OptionFunc) (string, int, error) {
 	p := &ListNiciraNvpDeviceNetworksParams{}
@@ -2756,7 +5223,7 @@ func (s *NetworkService) GetNiciraNvpDeviceNetworkID(keyword string, nvpdeviceid
 	p.p["keyword"] = keyword
 	p.p["nvpdeviceid"] = nvpdeviceid
 
-	for _, fn := range append(s.cs.options, opts...) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
 		if err := fn(s.cs, p); err != nil {
 			return "", -1, err
 		}
@@ -2768,7 +5235,7 @@ func (s *NetworkService) GetNiciraNvpDeviceNetworkID(keyword string, nvpdeviceid
 	}
 
 	if l.Count == 0 {
-		return "", l.Count, fmt.Errorf("No match found for %s: %+v", keyword, l)
+		return "", l.Count, fmt.Errorf("%w for %s: %+v", ErrNotFound, keyword, l)
 	}
 
 	if l.Count == 1 {
@@ -2781,13 +5248,36 @@ func (s *NetworkService) GetNiciraNvpDeviceNetworkID(keyword string, nvpdeviceid
 				return v.Id, l.Count, nil
 			}
 		}
+
+		if _, ok := p.p[firstMatchKey]; ok && len(l.NiciraNvpDeviceNetworks) > 0 {
+			return l.NiciraNvpDeviceNetworks[0].Id, l.Count, nil
+		}
 	}
 	return "", l.Count, fmt.Errorf("Could not find an exact match for %s: %+v", keyword, l)
 }
 
 // lists network that are using a nicira nvp device
 func (s *NetworkService) ListNiciraNvpDeviceNetworks(p *ListNiciraNvpDeviceNetworksParams) (*ListNiciraNvpDeviceNetworksResponse, error) {
-	resp, err := s.cs.newRequest("listNiciraNvpDeviceNetworks", p.toURLValues())
+	return s.ListNiciraNvpDeviceNetworksWithContext(context.Background(), p)
+}
+
+// ListNiciraNvpDeviceNetworksWithContext is the same as ListNiciraNvpDeviceNetworks, but the request is cancelled when the context is done
+func (s *NetworkService) ListNiciraNvpDeviceNetworksWithContext(ctx context.Context, p *ListNiciraNvpDeviceNetworksParams) (*ListNiciraNvpDeviceNetworksResponse, error) {
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.ListNiciraNvpDeviceNetworksRawWithContext(ctx, p.toURLValues())
+}
+
+// ListNiciraNvpDeviceNetworksRaw is the same as ListNiciraNvpDeviceNetworks, but takes the params as url.Values instead of typed params
+func (s *NetworkService) ListNiciraNvpDeviceNetworksRaw(v url.Values) (*ListNiciraNvpDeviceNetworksResponse, error) {
+	return s.ListNiciraNvpDeviceNetworksRawWithContext(context.Background(), v)
+}
+
+// ListNiciraNvpDeviceNetworksRawWithContext is the same as ListNiciraNvpDeviceNetworksRaw, but the request is cancelled when the context is done
+func (s *NetworkService) ListNiciraNvpDeviceNetworksRawWithContext(ctx context.Context, v url.Values) (*ListNiciraNvpDeviceNetworksResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "listNiciraNvpDeviceNetworks", "listniciranvpdevicenetworksresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -2800,6 +5290,93 @@ func (s *NetworkService) ListNiciraNvpDeviceNetworks(p *ListNiciraNvpDeviceNetwo
 	return &r, nil
 }
 
+// StreamNiciraNvpDeviceNetworks fetches the results of ListNiciraNvpDeviceNetworks page by page in the background and sends them on the
+// returned channel. Both returned channels are closed when done. If fetching a page fails, or the context
+// is done before all results are sent, the error is sent on the error channel and fetching stops. A
+// request for a page in flight is cancelled when the context is done. The page and pagesize set in the
+// params are ignored.
+func (s *NetworkService) StreamNiciraNvpDeviceNetworks(ctx context.Context, p *ListNiciraNvpDeviceNetworksParams) (<-chan *NiciraNvpDeviceNetwork, <-chan error) {
+	ch := make(chan *NiciraNvpDeviceNetwork)
+	errs := make(chan error, 1)
+
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesize(500)
+
+	go func() {
+		defer close(errs)
+		defer close(ch)
+
+		for page, count := 1, 0; ; page++ {
+			pp.SetPage(page)
+
+			l, err := s.ListNiciraNvpDeviceNetworksWithContext(ctx, pp)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			for _, v := range l.NiciraNvpDeviceNetworks {
+				select {
+				case ch <- v:
+				case <-ctx.Done():
+					errs <- ctx.Err()
+					return
+				}
+			}
+
+			count += len(l.NiciraNvpDeviceNetworks)
+			if len(l.NiciraNvpDeviceNetworks) < 500 || count >= l.Count {
+				return
+			}
+		}
+	}()
+
+	return ch, errs
+}
+
+// ListNiciraNvpDeviceNetworksWhere returns all results of ListNiciraNvpDeviceNetworks for which pred returns true. Note that the results are
+// filtered client-side, so all results matching the params are fetched first.
+// All pages are fetched, so the page and pagesize set in the params are ignored.
+func (s *NetworkService) ListNiciraNvpDeviceNetworksWhere(p *ListNiciraNvpDeviceNetworksParams, pred func(*NiciraNvpDeviceNetwork) bool) ([]*NiciraNvpDeviceNetwork, error) {
+	var r []*NiciraNvpDeviceNetwork
+	ch, errs := s.StreamNiciraNvpDeviceNetworks(context.Background(), p)
+	for v := range ch {
+		if pred(v) {
+			r = append(r, v)
+		}
+	}
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ListAllNiciraNvpDeviceNetworks returns the results of ListNiciraNvpDeviceNetworks across all pages, by calling ListNiciraNvpDeviceNetworks page by page until all
+// results are fetched. The pagesize set in the params is used for every page (500 if not set), and the
+// page set in the params is ignored.
+func (s *NetworkService) ListAllNiciraNvpDeviceNetworks(p *ListNiciraNvpDeviceNetworksParams) ([]*NiciraNvpDeviceNetwork, error) {
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesizeIfUnset(500)
+	pagesize := pp.p["pagesize"].(int)
+
+	var r []*NiciraNvpDeviceNetwork
+	for page := 1; ; page++ {
+		pp.SetPage(page)
+
+		l, err := s.ListNiciraNvpDeviceNetworks(pp)
+		if err != nil {
+			return nil, err
+		}
+		r = append(r, l.NiciraNvpDeviceNetworks...)
+
+		if len(l.NiciraNvpDeviceNetworks) < pagesize || len(r) >= l.Count {
+			return r, nil
+		}
+	}
+}
+
 type ListNiciraNvpDeviceNetworksResponse struct {
 	Count                   int                       `json:"count"`
 	NiciraNvpDeviceNetworks []*NiciraNvpDeviceNetwork `json:"niciranvpdevicenetwork"`
@@ -2887,6 +5464,16 @@ type ListOpenDaylightControllersParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ListOpenDaylightControllersParams) Clone() *ListOpenDaylightControllersParams {
+	c := &ListOpenDaylightControllersParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListOpenDaylightControllersParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -2909,6 +5496,13 @@ func (p *ListOpenDaylightControllersParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *ListOpenDaylightControllersParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 func (p *ListOpenDaylightControllersParams) SetPhysicalnetworkid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2917,14 +5511,32 @@ func (p *ListOpenDaylightControllersParams) SetPhysicalnetworkid(v string) {
 	return
 }
 
+// SetPhysicalnetworkidIfUnset sets physicalnetworkid to v, unless it is already set
+func (p *ListOpenDaylightControllersParams) SetPhysicalnetworkidIfUnset(v string) {
+	if _, found := p.p["physicalnetworkid"]; !found {
+		p.SetPhysicalnetworkid(v)
+	}
+}
+
 // You should always use this function to get a new ListOpenDaylightControllersParams instance,
-// as then you are sure you have configured all required params
-func (s *NetworkService) NewListOpenDaylightControllersParams() *ListOpenDaylightControllersParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *NetworkService) NewListOpenDaylightControllersParams(opts ...func(*ListOpenDaylightControllersParams)) *ListOpenDaylightControllersParams {
 	p := &ListOpenDaylightControllersParams{}
 	p.p = make(map[string]interface{})
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
+func (p *ListOpenDaylightControllersParams) setFirstMatch() {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p[firstMatchKey] = true
+}
+
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *NetworkService) GetOpenDaylightControllerByID(id string, opts ...OptionFunc) (*OpenDaylightController, int, error) {
 	p := &ListOpenDaylightControllersParams{}
@@ -2932,7 +5544,7 @@ func (s *NetworkService) GetOpenDaylightControllerByID(id string, opts ...Option
 
 	p.p["id"] = id
 
-	for _, fn := range append(s.cs.options, opts...) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
 		if err := fn(s.cs, p); err != nil {
 			return nil, -1, err
 		}
@@ -2943,24 +5555,56 @@ func (s *NetworkService) GetOpenDaylightControllerByID(id string, opts ...Option
 		if strings.Contains(err.Error(), fmt.Sprintf(
 			"Invalid parameter id value=%s due to incorrect long value format, "+
 				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+			return nil, 0, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
 	}
 
 	if l.Count == 1 {
 		return l.OpenDaylightControllers[0], l.Count, nil
 	}
+
+	if _, ok := p.p[firstMatchKey]; ok && len(l.OpenDaylightControllers) > 0 {
+		return l.OpenDaylightControllers[0], l.Count, nil
+	}
 	return nil, l.Count, fmt.Errorf("There is more then one result for OpenDaylightController UUID: %s!", id)
 }
 
+// OpenDaylightControllerExists returns true if a OpenDaylightController with the given ID exists. When no match is found
+// it returns false, while any other error is returned as is.
+func (s *NetworkService) OpenDaylightControllerExists(id string, opts ...OptionFunc) (bool, error) {
+	_, count, err := s.GetOpenDaylightControllerByID(id, opts...)
+	if errors.Is(err, ErrNotFound) {
+		return false, nil
+	}
+	if count > 0 {
+		return true, nil
+	}
+	return false, err
+}
+
 // Lists OpenDyalight controllers
 func (s *NetworkService) ListOpenDaylightControllers(p *ListOpenDaylightControllersParams) (*ListOpenDaylightControllersResponse, error) {
-	resp, err := s.cs.newRequest("listOpenDaylightControllers", p.toURLValues())
+	return s.ListOpenDaylightControllersWithContext(context.Background(), p)
+}
+
+// ListOpenDaylightControllersWithContext is the same as ListOpenDaylightControllers, but the request is cancelled when the context is done
+func (s *NetworkService) ListOpenDaylightControllersWithContext(ctx context.Context, p *ListOpenDaylightControllersParams) (*ListOpenDaylightControllersResponse, error) {
+	return s.ListOpenDaylightControllersRawWithContext(ctx, p.toURLValues())
+}
+
+// ListOpenDaylightControllersRaw is the same as ListOpenDaylightControllers, but takes the params as url.Values instead of typed params
+func (s *NetworkService) ListOpenDaylightControllersRaw(v url.Values) (*ListOpenDaylightControllersResponse, error) {
+	return s.ListOpenDaylightControllersRawWithContext(context.Background(), v)
+}
+
+// ListOpenDaylightControllersRawWithContext is the same as ListOpenDaylightControllersRaw, but the request is cancelled when the context is done
+func (s *NetworkService) ListOpenDaylightControllersRawWithContext(ctx context.Context, v url.Values) (*ListOpenDaylightControllersResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "listOpenDaylightControllers", "listopendaylightcontrollersresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -2973,6 +5617,22 @@ func (s *NetworkService) ListOpenDaylightControllers(p *ListOpenDaylightControll
 	return &r, nil
 }
 
+// ListOpenDaylightControllersWhere returns all results of ListOpenDaylightControllers for which pred returns true. Note that the results are
+// filtered client-side, so all results matching the params are fetched first.
+func (s *NetworkService) ListOpenDaylightControllersWhere(p *ListOpenDaylightControllersParams, pred func(*OpenDaylightController) bool) ([]*OpenDaylightController, error) {
+	var r []*OpenDaylightController
+	l, err := s.ListOpenDaylightControllers(p)
+	if err != nil {
+		return nil, err
+	}
+	for _, v := range l.OpenDaylightControllers {
+		if pred(v) {
+			r = append(r, v)
+		}
+	}
+	return r, nil
+}
+
 type ListOpenDaylightControllersResponse struct {
 	Count                   int                       `json:"count"`
 	OpenDaylightControllers []*OpenDaylightController `json:"opendaylightcontroller"`
@@ -2990,6 +5650,16 @@ type ListPaloAltoFirewallNetworksParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ListPaloAltoFirewallNetworksParams) Clone() *ListPaloAltoFirewallNetworksParams {
+	c := &ListPaloAltoFirewallNetworksParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListPaloAltoFirewallNetworksParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -3012,6 +5682,16 @@ func (p *ListPaloAltoFirewallNetworksParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *ListPaloAltoFirewallNetworksParams) validate() error {
+	for _, k := range []string{"lbdeviceid"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of listPaloAltoFirewallNetworks", k)
+		}
+	}
+	return nil
+}
+
 func (p *ListPaloAltoFirewallNetworksParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3020,6 +5700,13 @@ func (p *ListPaloAltoFirewallNetworksParams) SetKeyword(v string) {
 	return
 }
 
+// SetKeywordIfUnset sets keyword to v, unless it is already set
+func (p *ListPaloAltoFirewallNetworksParams) SetKeywordIfUnset(v string) {
+	if _, found := p.p["keyword"]; !found {
+		p.SetKeyword(v)
+	}
+}
+
 func (p *ListPaloAltoFirewallNetworksParams) SetLbdeviceid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3028,6 +5715,13 @@ func (p *ListPaloAltoFirewallNetworksParams) SetLbdeviceid(v string) {
 	return
 }
 
+// SetLbdeviceidIfUnset sets lbdeviceid to v, unless it is already set
+func (p *ListPaloAltoFirewallNetworksParams) SetLbdeviceidIfUnset(v string) {
+	if _, found := p.p["lbdeviceid"]; !found {
+		p.SetLbdeviceid(v)
+	}
+}
+
 func (p *ListPaloAltoFirewallNetworksParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3036,6 +5730,13 @@ func (p *ListPaloAltoFirewallNetworksParams) SetPage(v int) {
 	return
 }
 
+// SetPageIfUnset sets page to v, unless it is already set
+func (p *ListPaloAltoFirewallNetworksParams) SetPageIfUnset(v int) {
+	if _, found := p.p["page"]; !found {
+		p.SetPage(v)
+	}
+}
+
 func (p *ListPaloAltoFirewallNetworksParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3044,15 +5745,33 @@ func (p *ListPaloAltoFirewallNetworksParams) SetPagesize(v int) {
 	return
 }
 
+// SetPagesizeIfUnset sets pagesize to v, unless it is already set
+func (p *ListPaloAltoFirewallNetworksParams) SetPagesizeIfUnset(v int) {
+	if _, found := p.p["pagesize"]; !found {
+		p.SetPagesize(v)
+	}
+}
+
 // You should always use this function to get a new ListPaloAltoFirewallNetworksParams instance,
-// as then you are sure you have configured all required params
-func (s *NetworkService) NewListPaloAltoFirewallNetworksParams(lbdeviceid string) *ListPaloAltoFirewallNetworksParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *NetworkService) NewListPaloAltoFirewallNetworksParams(lbdeviceid string, opts ...func(*ListPaloAltoFirewallNetworksParams)) *ListPaloAltoFirewallNetworksParams {
 	p := &ListPaloAltoFirewallNetworksParams{}
 	p.p = make(map[string]interface{})
 	p.p["lbdeviceid"] = lbdeviceid
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
+func (p *ListPaloAltoFirewallNetworksParams) setFirstMatch() {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p[firstMatchKey] = true
+}
+
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *NetworkService) GetPaloAltoFirewallNetworkID(keyword string, lbdeviceid string, opts ...OptionFunc) (string, int, error) {
 	p := &ListPaloAltoFirewallNetworksParams{}
@@ -3061,7 +5780,7 @@ func (s *NetworkService) GetPaloAltoFirewallNetworkID(keyword string, lbdeviceid
 	p.p["keyword"] = keyword
 	p.p["lbdeviceid"] = lbdeviceid
 
-	for _, fn := range append(s.cs.options, opts...) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
 		if err := fn(s.cs, p); err != nil {
 			return "", -1, err
 		}
@@ -3073,7 +5792,7 @@ func (s *NetworkService) GetPaloAltoFirewallNetworkID(keyword string, lbdeviceid
 	}
 
 	if l.Count == 0 {
-		return "", l.Count, fmt.Errorf("No match found for %s: %+v", keyword, l)
+		return "", l.Count, fmt.Errorf("%w for %s: %+v", ErrNotFound, keyword, l)
 	}
 
 	if l.Count == 1 {
@@ -3086,13 +5805,36 @@ func (s *NetworkService) GetPaloAltoFirewallNetworkID(keyword string, lbdeviceid
 				return v.Id, l.Count, nil
 			}
 		}
+
+		if _, ok := p.p[firstMatchKey]; ok && len(l.PaloAltoFirewallNetworks) > 0 {
+			return l.PaloAltoFirewallNetworks[0].Id, l.Count, nil
+		}
 	}
 	return "", l.Count, fmt.Errorf("Could not find an exact match for %s: %+v", keyword, l)
 }
 
 // lists network that are using Palo Alto firewall device
 func (s *NetworkService) ListPaloAltoFirewallNetworks(p *ListPaloAltoFirewallNetworksParams) (*ListPaloAltoFirewallNetworksResponse, error) {
-	resp, err := s.cs.newRequest("listPaloAltoFirewallNetworks", p.toURLValues())
+	return s.ListPaloAltoFirewallNetworksWithContext(context.Background(), p)
+}
+
+// ListPaloAltoFirewallNetworksWithContext is the same as ListPaloAltoFirewallNetworks, but the request is cancelled when the context is done
+func (s *NetworkService) ListPaloAltoFirewallNetworksWithContext(ctx context.Context, p *ListPaloAltoFirewallNetworksParams) (*ListPaloAltoFirewallNetworksResponse, error) {
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.ListPaloAltoFirewallNetworksRawWithContext(ctx, p.toURLValues())
+}
+
+// ListPaloAltoFirewallNetworksRaw is the same as ListPaloAltoFirewallNetworks, but takes the params as url.Values instead of typed params
+func (s *NetworkService) ListPaloAltoFirewallNetworksRaw(v url.Values) (*ListPaloAltoFirewallNetworksResponse, error) {
+	return s.ListPaloAltoFirewallNetworksRawWithContext(context.Background(), v)
+}
+
+// ListPaloAltoFirewallNetworksRawWithContext is the same as ListPaloAltoFirewallNetworksRaw, but the request is cancelled when the context is done
+func (s *NetworkService) ListPaloAltoFirewallNetworksRawWithContext(ctx context.Context, v url.Values) (*ListPaloAltoFirewallNetworksResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "listPaloAltoFirewallNetworks", "listpaloaltofirewallnetworksresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -3105,6 +5847,93 @@ func (s *NetworkService) ListPaloAltoFirewallNetworks(p *ListPaloAltoFirewallNet
 	return &r, nil
 }
 
+// StreamPaloAltoFirewallNetworks fetches the results of ListPaloAltoFirewallNetworks page by page in the background and sends them on the
+// returned channel. Both returned channels are closed when done. If fetching a page fails, or the context
+// is done before all results are sent, the error is sent on the error channel and fetching stops. A
+// request for a page in flight is cancelled when the context is done. The page and pagesize set in the
+// params are ignored.
+func (s *NetworkService) StreamPaloAltoFirewallNetworks(ctx context.Context, p *ListPaloAltoFirewallNetworksParams) (<-chan *PaloAltoFirewallNetwork, <-chan error) {
+	ch := make(chan *PaloAltoFirewallNetwork)
+	errs := make(chan error, 1)
+
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesize(500)
+
+	go func() {
+		defer close(errs)
+		defer close(ch)
+
+		for page, count := 1, 0; ; page++ {
+			pp.SetPage(page)
+
+			l, err := s.ListPaloAltoFirewallNetworksWithContext(ctx, pp)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			for _, v := range l.PaloAltoFirewallNetworks {
+				select {
+				case ch <- v:
+				case <-ctx.Done():
+					errs <- ctx.Err()
+					return
+				}
+			}
+
+			count += len(l.PaloAltoFirewallNetworks)
+			if len(l.PaloAltoFirewallNetworks) < 500 || count >= l.Count {
+				return
+			}
+		}
+	}()
+
+	return ch, errs
+}
+
+// ListPaloAltoFirewallNetworksWhere returns all results of ListPaloAltoFirewallNetworks for which pred returns true. Note that the results are
+// filtered client-side, so all results matching the params are fetched first.
+// All pages are fetched, so the page and pagesize set in the params are ignored.
+func (s *NetworkService) ListPaloAltoFirewallNetworksWhere(p *ListPaloAltoFirewallNetworksParams, pred func(*PaloAltoFirewallNetwork) bool) ([]*PaloAltoFirewallNetwork, error) {
+	var r []*PaloAltoFirewallNetwork
+	ch, errs := s.StreamPaloAltoFirewallNetworks(context.Background(), p)
+	for v := range ch {
+		if pred(v) {
+			r = append(r, v)
+		}
+	}
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ListAllPaloAltoFirewallNetworks returns the results of ListPaloAltoFirewallNetworks across all pages, by calling ListPaloAltoFirewallNetworks page by page until all
+// results are fetched. The pagesize set in the params is used for every page (500 if not set), and the
+// page set in the params is ignored.
+func (s *NetworkService) ListAllPaloAltoFirewallNetworks(p *ListPaloAltoFirewallNetworksParams) ([]*PaloAltoFirewallNetwork, error) {
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesizeIfUnset(500)
+	pagesize := pp.p["pagesize"].(int)
+
+	var r []*PaloAltoFirewallNetwork
+	for page := 1; ; page++ {
+		pp.SetPage(page)
+
+		l, err := s.ListPaloAltoFirewallNetworks(pp)
+		if err != nil {
+			return nil, err
+		}
+		r = append(r, l.PaloAltoFirewallNetworks...)
+
+		if len(l.PaloAltoFirewallNetworks) < pagesize || len(r) >= l.Count {
+			return r, nil
+		}
+	}
+}
+
 type ListPaloAltoFirewallNetworksResponse struct {
 	Count                    int                        `json:"count"`
 	PaloAltoFirewallNetworks []*PaloAltoFirewallNetwork `json:"paloaltofirewallnetwork"`
@@ -3192,6 +6021,16 @@ type ListPhysicalNetworksParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ListPhysicalNetworksParams) Clone() *ListPhysicalNetworksParams {
+	c := &ListPhysicalNetworksParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListPhysicalNetworksParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -3228,6 +6067,13 @@ func (p *ListPhysicalNetworksParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *ListPhysicalNetworksParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 func (p *ListPhysicalNetworksParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3236,6 +6082,13 @@ func (p *ListPhysicalNetworksParams) SetKeyword(v string) {
 	return
 }
 
+// SetKeywordIfUnset sets keyword to v, unless it is already set
+func (p *ListPhysicalNetworksParams) SetKeywordIfUnset(v string) {
+	if _, found := p.p["keyword"]; !found {
+		p.SetKeyword(v)
+	}
+}
+
 func (p *ListPhysicalNetworksParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3244,6 +6097,13 @@ func (p *ListPhysicalNetworksParams) SetName(v string) {
 	return
 }
 
+// SetNameIfUnset sets name to v, unless it is already set
+func (p *ListPhysicalNetworksParams) SetNameIfUnset(v string) {
+	if _, found := p.p["name"]; !found {
+		p.SetName(v)
+	}
+}
+
 func (p *ListPhysicalNetworksParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3252,6 +6112,13 @@ func (p *ListPhysicalNetworksParams) SetPage(v int) {
 	return
 }
 
+// SetPageIfUnset sets page to v, unless it is already set
+func (p *ListPhysicalNetworksParams) SetPageIfUnset(v int) {
+	if _, found := p.p["page"]; !found {
+		p.SetPage(v)
+	}
+}
+
 func (p *ListPhysicalNetworksParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3260,6 +6127,13 @@ func (p *ListPhysicalNetworksParams) SetPagesize(v int) {
 	return
 }
 
+// SetPagesizeIfUnset sets pagesize to v, unless it is already set
+func (p *ListPhysicalNetworksParams) SetPagesizeIfUnset(v int) {
+	if _, found := p.p["pagesize"]; !found {
+		p.SetPagesize(v)
+	}
+}
+
 func (p *ListPhysicalNetworksParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3268,14 +6142,32 @@ func (p *ListPhysicalNetworksParams) SetZoneid(v string) {
 	return
 }
 
+// SetZoneidIfUnset sets zoneid to v, unless it is already set
+func (p *ListPhysicalNetworksParams) SetZoneidIfUnset(v string) {
+	if _, found := p.p["zoneid"]; !found {
+		p.SetZoneid(v)
+	}
+}
+
 // You should always use this function to get a new ListPhysicalNetworksParams instance,
-// as then you are sure you have configured all required params
-func (s *NetworkService) NewListPhysicalNetworksParams() *ListPhysicalNetworksParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *NetworkService) NewListPhysicalNetworksParams(opts ...func(*ListPhysicalNetworksParams)) *ListPhysicalNetworksParams {
 	p := &ListPhysicalNetworksParams{}
 	p.p = make(map[string]interface{})
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
+func (p *ListPhysicalNetworksParams) setFirstMatch() {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p[firstMatchKey] = true
+}
+
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *NetworkService) GetPhysicalNetworkID(name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListPhysicalNetworksParams{}
@@ -3283,7 +6175,7 @@ func (s *NetworkService) GetPhysicalNetworkID(name string, opts ...OptionFunc) (
 
 	p.p["name"] = name
 
-	for _, fn := range append(s.cs.options, opts...) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
 		if err := fn(s.cs, p); err != nil {
 			return "", -1, err
 		}
@@ -3295,7 +6187,7 @@ func (s *NetworkService) GetPhysicalNetworkID(name string, opts ...OptionFunc) (
 	}
 
 	if l.Count == 0 {
-		return "", l.Count, fmt.Errorf("No match found for %s: %+v", name, l)
+		return "", l.Count, fmt.Errorf("%w for %s: %+v", ErrNotFound, name, l)
 	}
 
 	if l.Count == 1 {
@@ -3308,6 +6200,10 @@ func (s *NetworkService) GetPhysicalNetworkID(name string, opts ...OptionFunc) (
 				return v.Id, l.Count, nil
 			}
 		}
+
+		if _, ok := p.p[firstMatchKey]; ok && len(l.PhysicalNetworks) > 0 {
+			return l.PhysicalNetworks[0].Id, l.Count, nil
+		}
 	}
 	return "", l.Count, fmt.Errorf("Could not find an exact match for %s: %+v", name, l)
 }
@@ -3333,7 +6229,7 @@ func (s *NetworkService) GetPhysicalNetworkByID(id string, opts ...OptionFunc) (
 
 	p.p["id"] = id
 
-	for _, fn := range append(s.cs.options, opts...) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
 		if err := fn(s.cs, p); err != nil {
 			return nil, -1, err
 		}
@@ -3344,24 +6240,56 @@ func (s *NetworkService) GetPhysicalNetworkByID(id string, opts ...OptionFunc) (
 		if strings.Contains(err.Error(), fmt.Sprintf(
 			"Invalid parameter id value=%s due to incorrect long value format, "+
 				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+			return nil, 0, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
 	}
 
 	if l.Count == 1 {
 		return l.PhysicalNetworks[0], l.Count, nil
 	}
+
+	if _, ok := p.p[firstMatchKey]; ok && len(l.PhysicalNetworks) > 0 {
+		return l.PhysicalNetworks[0], l.Count, nil
+	}
 	return nil, l.Count, fmt.Errorf("There is more then one result for PhysicalNetwork UUID: %s!", id)
 }
 
+// PhysicalNetworkExists returns true if a PhysicalNetwork with the given ID exists. When no match is found
+// it returns false, while any other error is returned as is.
+func (s *NetworkService) PhysicalNetworkExists(id string, opts ...OptionFunc) (bool, error) {
+	_, count, err := s.GetPhysicalNetworkByID(id, opts...)
+	if errors.Is(err, ErrNotFound) {
+		return false, nil
+	}
+	if count > 0 {
+		return true, nil
+	}
+	return false, err
+}
+
 // Lists physical networks
 func (s *NetworkService) ListPhysicalNetworks(p *ListPhysicalNetworksParams) (*ListPhysicalNetworksResponse, error) {
-	resp, err := s.cs.newRequest("listPhysicalNetworks", p.toURLValues())
+	return s.ListPhysicalNetworksWithContext(context.Background(), p)
+}
+
+// ListPhysicalNetworksWithContext is the same as ListPhysicalNetworks, but the request is cancelled when the context is done
+func (s *NetworkService) ListPhysicalNetworksWithContext(ctx context.Context, p *ListPhysicalNetworksParams) (*ListPhysicalNetworksResponse, error) {
+	return s.ListPhysicalNetworksRawWithContext(ctx, p.toURLValues())
+}
+
+// ListPhysicalNetworksRaw is the same as ListPhysicalNetworks, but takes the params as url.Values instead of typed params
+func (s *NetworkService) ListPhysicalNetworksRaw(v url.Values) (*ListPhysicalNetworksResponse, error) {
+	return s.ListPhysicalNetworksRawWithContext(context.Background(), v)
+}
+
+// ListPhysicalNetworksRawWithContext is the same as ListPhysicalNetworksRaw, but the request is cancelled when the context is done
+func (s *NetworkService) ListPhysicalNetworksRawWithContext(ctx context.Context, v url.Values) (*ListPhysicalNetworksResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "listPhysicalNetworks", "listphysicalnetworksresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -3374,6 +6302,93 @@ func (s *NetworkService) ListPhysicalNetworks(p *ListPhysicalNetworksParams) (*L
 	return &r, nil
 }
 
+// StreamPhysicalNetworks fetches the results of ListPhysicalNetworks page by page in the background and sends them on the
+// returned channel. Both returned channels are closed when done. If fetching a page fails, or the context
+// is done before all results are sent, the error is sent on the error channel and fetching stops. A
+// request for a page in flight is cancelled when the context is done. The page and pagesize set in the
+// params are ignored.
+func (s *NetworkService) StreamPhysicalNetworks(ctx context.Context, p *ListPhysicalNetworksParams) (<-chan *PhysicalNetwork, <-chan error) {
+	ch := make(chan *PhysicalNetwork)
+	errs := make(chan error, 1)
+
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesize(500)
+
+	go func() {
+		defer close(errs)
+		defer close(ch)
+
+		for page, count := 1, 0; ; page++ {
+			pp.SetPage(page)
+
+			l, err := s.ListPhysicalNetworksWithContext(ctx, pp)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			for _, v := range l.PhysicalNetworks {
+				select {
+				case ch <- v:
+				case <-ctx.Done():
+					errs <- ctx.Err()
+					return
+				}
+			}
+
+			count += len(l.PhysicalNetworks)
+			if len(l.PhysicalNetworks) < 500 || count >= l.Count {
+				return
+			}
+		}
+	}()
+
+	return ch, errs
+}
+
+// ListPhysicalNetworksWhere returns all results of ListPhysicalNetworks for which pred returns true. Note that the results are
+// filtered client-side, so all results matching the params are fetched first.
+// All pages are fetched, so the page and pagesize set in the params are ignored.
+func (s *NetworkService) ListPhysicalNetworksWhere(p *ListPhysicalNetworksParams, pred func(*PhysicalNetwork) bool) ([]*PhysicalNetwork, error) {
+	var r []*PhysicalNetwork
+	ch, errs := s.StreamPhysicalNetworks(context.Background(), p)
+	for v := range ch {
+		if pred(v) {
+			r = append(r, v)
+		}
+	}
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ListAllPhysicalNetworks returns the results of ListPhysicalNetworks across all pages, by calling ListPhysicalNetworks page by page until all
+// results are fetched. The pagesize set in the params is used for every page (500 if not set), and the
+// page set in the params is ignored.
+func (s *NetworkService) ListAllPhysicalNetworks(p *ListPhysicalNetworksParams) ([]*PhysicalNetwork, error) {
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesizeIfUnset(500)
+	pagesize := pp.p["pagesize"].(int)
+
+	var r []*PhysicalNetwork
+	for page := 1; ; page++ {
+		pp.SetPage(page)
+
+		l, err := s.ListPhysicalNetworks(pp)
+		if err != nil {
+			return nil, err
+		}
+		r = append(r, l.PhysicalNetworks...)
+
+		if len(l.PhysicalNetworks) < pagesize || len(r) >= l.Count {
+			return r, nil
+		}
+	}
+}
+
 type ListPhysicalNetworksResponse struct {
 	Count            int                `json:"count"`
 	PhysicalNetworks []*PhysicalNetwork `json:"physicalnetwork"`
@@ -3396,6 +6411,16 @@ type ListSrxFirewallNetworksParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ListSrxFirewallNetworksParams) Clone() *ListSrxFirewallNetworksParams {
+	c := &ListSrxFirewallNetworksParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListSrxFirewallNetworksParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -3418,6 +6443,16 @@ func (p *ListSrxFirewallNetworksParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *ListSrxFirewallNetworksParams) validate() error {
+	for _, k := range []string{"lbdeviceid"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of listSrxFirewallNetworks", k)
+		}
+	}
+	return nil
+}
+
 func (p *ListSrxFirewallNetworksParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3426,6 +6461,13 @@ func (p *ListSrxFirewallNetworksParams) SetKeyword(v string) {
 	return
 }
 
+// SetKeywordIfUnset sets keyword to v, unless it is already set
+func (p *ListSrxFirewallNetworksParams) SetKeywordIfUnset(v string) {
+	if _, found := p.p["keyword"]; !found {
+		p.SetKeyword(v)
+	}
+}
+
 func (p *ListSrxFirewallNetworksParams) SetLbdeviceid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3434,6 +6476,13 @@ func (p *ListSrxFirewallNetworksParams) SetLbdeviceid(v string) {
 	return
 }
 
+// SetLbdeviceidIfUnset sets lbdeviceid to v, unless it is already set
+func (p *ListSrxFirewallNetworksParams) SetLbdeviceidIfUnset(v string) {
+	if _, found := p.p["lbdeviceid"]; !found {
+		p.SetLbdeviceid(v)
+	}
+}
+
 func (p *ListSrxFirewallNetworksParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3442,6 +6491,13 @@ func (p *ListSrxFirewallNetworksParams) SetPage(v int) {
 	return
 }
 
+// SetPageIfUnset sets page to v, unless it is already set
+func (p *ListSrxFirewallNetworksParams) SetPageIfUnset(v int) {
+	if _, found := p.p["page"]; !found {
+		p.SetPage(v)
+	}
+}
+
 func (p *ListSrxFirewallNetworksParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3450,15 +6506,33 @@ func (p *ListSrxFirewallNetworksParams) SetPagesize(v int) {
 	return
 }
 
+// SetPagesizeIfUnset sets pagesize to v, unless it is already set
+func (p *ListSrxFirewallNetworksParams) SetPagesizeIfUnset(v int) {
+	if _, found := p.p["pagesize"]; !found {
+		p.SetPagesize(v)
+	}
+}
+
 // You should always use this function to get a new ListSrxFirewallNetworksParams instance,
-// as then you are sure you have configured all required params
-func (s *NetworkService) NewListSrxFirewallNetworksParams(lbdeviceid string) *ListSrxFirewallNetworksParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *NetworkService) NewListSrxFirewallNetworksParams(lbdeviceid string, opts ...func(*ListSrxFirewallNetworksParams)) *ListSrxFirewallNetworksParams {
 	p := &ListSrxFirewallNetworksParams{}
 	p.p = make(map[string]interface{})
 	p.p["lbdeviceid"] = lbdeviceid
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
+func (p *ListSrxFirewallNetworksParams) setFirstMatch() {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p[firstMatchKey] = true
+}
+
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *NetworkService) GetSrxFirewallNetworkID(keyword string, lbdeviceid string, opts ...OptionFunc) (string, int, error) {
 	p := &ListSrxFirewallNetworksParams{}
@@ -3467,7 +6541,7 @@ func (s *NetworkService) GetSrxFirewallNetworkID(keyword string, lbdeviceid stri
 	p.p["keyword"] = keyword
 	p.p["lbdeviceid"] = lbdeviceid
 
-	for _, fn := range append(s.cs.options, opts...) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
 		if err := fn(s.cs, p); err != nil {
 			return "", -1, err
 		}
@@ -3479,7 +6553,7 @@ func (s *NetworkService) GetSrxFirewallNetworkID(keyword string, lbdeviceid stri
 	}
 
 	if l.Count == 0 {
-		return "", l.Count, fmt.Errorf("No match found for %s: %+v", keyword, l)
+		return "", l.Count, fmt.Errorf("%w for %s: %+v", ErrNotFound, keyword, l)
 	}
 
 	if l.Count == 1 {
@@ -3492,13 +6566,36 @@ func (s *NetworkService) GetSrxFirewallNetworkID(keyword string, lbdeviceid stri
 				return v.Id, l.Count, nil
 			}
 		}
+
+		if _, ok := p.p[firstMatchKey]; ok && len(l.SrxFirewallNetworks) > 0 {
+			return l.SrxFirewallNetworks[0].Id, l.Count, nil
+		}
 	}
 	return "", l.Count, fmt.Errorf("Could not find an exact match for %s: %+v", keyword, l)
 }
 
 // lists network that are using SRX firewall device
 func (s *NetworkService) ListSrxFirewallNetworks(p *ListSrxFirewallNetworksParams) (*ListSrxFirewallNetworksResponse, error) {
-	resp, err := s.cs.newRequest("listSrxFirewallNetworks", p.toURLValues())
+	return s.ListSrxFirewallNetworksWithContext(context.Background(), p)
+}
+
+// ListSrxFirewallNetworksWithContext is the same as ListSrxFirewallNetworks, but the request is cancelled when the context is done
+func (s *NetworkService) ListSrxFirewallNetworksWithContext(ctx context.Context, p *ListSrxFirewallNetworksParams) (*ListSrxFirewallNetworksResponse, error) {
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.ListSrxFirewallNetworksRawWithContext(ctx, p.toURLValues())
+}
+
+// ListSrxFirewallNetworksRaw is the same as ListSrxFirewallNetworks, but takes the params as url.Values instead of typed params
+func (s *NetworkService) ListSrxFirewallNetworksRaw(v url.Values) (*ListSrxFirewallNetworksResponse, error) {
+	return s.ListSrxFirewallNetworksRawWithContext(context.Background(), v)
+}
+
+// ListSrxFirewallNetworksRawWithContext is the same as ListSrxFirewallNetworksRaw, but the request is cancelled when the context is done
+func (s *NetworkService) ListSrxFirewallNetworksRawWithContext(ctx context.Context, v url.Values) (*ListSrxFirewallNetworksResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "listSrxFirewallNetworks", "listsrxfirewallnetworksresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -3511,6 +6608,93 @@ func (s *NetworkService) ListSrxFirewallNetworks(p *ListSrxFirewallNetworksParam
 	return &r, nil
 }
 
+// StreamSrxFirewallNetworks fetches the results of ListSrxFirewallNetworks page by page in the background and sends them on the
+// returned channel. Both returned channels are closed when done. If fetching a page fails, or the context
+// is done before all results are sent, the error is sent on the error channel and fetching stops. A
+// request for a page in flight is cancelled when the context is done. The page and pagesize set in the
+// params are ignored.
+func (s *NetworkService) StreamSrxFirewallNetworks(ctx context.Context, p *ListSrxFirewallNetworksParams) (<-chan *SrxFirewallNetwork, <-chan error) {
+	ch := make(chan *SrxFirewallNetwork)
+	errs := make(chan error, 1)
+
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesize(500)
+
+	go func() {
+		defer close(errs)
+		defer close(ch)
+
+		for page, count := 1, 0; ; page++ {
+			pp.SetPage(page)
+
+			l, err := s.ListSrxFirewallNetworksWithContext(ctx, pp)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			for _, v := range l.SrxFirewallNetworks {
+				select {
+				case ch <- v:
+				case <-ctx.Done():
+					errs <- ctx.Err()
+					return
+				}
+			}
+
+			count += len(l.SrxFirewallNetworks)
+			if len(l.SrxFirewallNetworks) < 500 || count >= l.Count {
+				return
+			}
+		}
+	}()
+
+	return ch, errs
+}
+
+// ListSrxFirewallNetworksWhere returns all results of ListSrxFirewallNetworks for which pred returns true. Note that the results are
+// filtered client-side, so all results matching the params are fetched first.
+// All pages are fetched, so the page and pagesize set in the params are ignored.
+func (s *NetworkService) ListSrxFirewallNetworksWhere(p *ListSrxFirewallNetworksParams, pred func(*SrxFirewallNetwork) bool) ([]*SrxFirewallNetwork, error) {
+	var r []*SrxFirewallNetwork
+	ch, errs := s.StreamSrxFirewallNetworks(context.Background(), p)
+	for v := range ch {
+		if pred(v) {
+			r = append(r, v)
+		}
+	}
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ListAllSrxFirewallNetworks returns the results of ListSrxFirewallNetworks across all pages, by calling ListSrxFirewallNetworks page by page until all
+// results are fetched. The pagesize set in the params is used for every page (500 if not set), and the
+// page set in the params is ignored.
+func (s *NetworkService) ListAllSrxFirewallNetworks(p *ListSrxFirewallNetworksParams) ([]*SrxFirewallNetwork, error) {
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesizeIfUnset(500)
+	pagesize := pp.p["pagesize"].(int)
+
+	var r []*SrxFirewallNetwork
+	for page := 1; ; page++ {
+		pp.SetPage(page)
+
+		l, err := s.ListSrxFirewallNetworks(pp)
+		if err != nil {
+			return nil, err
+		}
+		r = append(r, l.SrxFirewallNetworks...)
+
+		if len(l.SrxFirewallNetworks) < pagesize || len(r) >= l.Count {
+			return r, nil
+		}
+	}
+}
+
 type ListSrxFirewallNetworksResponse struct {
 	Count               int                   `json:"count"`
 	SrxFirewallNetworks []*SrxFirewallNetwork `json:"srxfirewallnetwork"`
@@ -3598,6 +6782,16 @@ type ListStorageNetworkIpRangeParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ListStorageNetworkIpRangeParams) Clone() *ListStorageNetworkIpRangeParams {
+	c := &ListStorageNetworkIpRangeParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListStorageNetworkIpRangeParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -3634,6 +6828,13 @@ func (p *ListStorageNetworkIpRangeParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *ListStorageNetworkIpRangeParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 func (p *ListStorageNetworkIpRangeParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3642,6 +6843,13 @@ func (p *ListStorageNetworkIpRangeParams) SetKeyword(v string) {
 	return
 }
 
+// SetKeywordIfUnset sets keyword to v, unless it is already set
+func (p *ListStorageNetworkIpRangeParams) SetKeywordIfUnset(v string) {
+	if _, found := p.p["keyword"]; !found {
+		p.SetKeyword(v)
+	}
+}
+
 func (p *ListStorageNetworkIpRangeParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3650,6 +6858,13 @@ func (p *ListStorageNetworkIpRangeParams) SetPage(v int) {
 	return
 }
 
+// SetPageIfUnset sets page to v, unless it is already set
+func (p *ListStorageNetworkIpRangeParams) SetPageIfUnset(v int) {
+	if _, found := p.p["page"]; !found {
+		p.SetPage(v)
+	}
+}
+
 func (p *ListStorageNetworkIpRangeParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3658,6 +6873,13 @@ func (p *ListStorageNetworkIpRangeParams) SetPagesize(v int) {
 	return
 }
 
+// SetPagesizeIfUnset sets pagesize to v, unless it is already set
+func (p *ListStorageNetworkIpRangeParams) SetPagesizeIfUnset(v int) {
+	if _, found := p.p["pagesize"]; !found {
+		p.SetPagesize(v)
+	}
+}
+
 func (p *ListStorageNetworkIpRangeParams) SetPodid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3666,6 +6888,13 @@ func (p *ListStorageNetworkIpRangeParams) SetPodid(v string) {
 	return
 }
 
+// SetPodidIfUnset sets podid to v, unless it is already set
+func (p *ListStorageNetworkIpRangeParams) SetPodidIfUnset(v string) {
+	if _, found := p.p["podid"]; !found {
+		p.SetPodid(v)
+	}
+}
+
 func (p *ListStorageNetworkIpRangeParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3674,14 +6903,32 @@ func (p *ListStorageNetworkIpRangeParams) SetZoneid(v string) {
 	return
 }
 
+// SetZoneidIfUnset sets zoneid to v, unless it is already set
+func (p *ListStorageNetworkIpRangeParams) SetZoneidIfUnset(v string) {
+	if _, found := p.p["zoneid"]; !found {
+		p.SetZoneid(v)
+	}
+}
+
 // You should always use this function to get a new ListStorageNetworkIpRangeParams instance,
-// as then you are sure you have configured all required params
-func (s *NetworkService) NewListStorageNetworkIpRangeParams() *ListStorageNetworkIpRangeParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *NetworkService) NewListStorageNetworkIpRangeParams(opts ...func(*ListStorageNetworkIpRangeParams)) *ListStorageNetworkIpRangeParams {
 	p := &ListStorageNetworkIpRangeParams{}
 	p.p = make(map[string]interface{})
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
+func (p *ListStorageNetworkIpRangeParams) setFirstMatch() {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p[firstMatchKey] = true
+}
+
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *NetworkService) GetStorageNetworkIpRangeByID(id string, opts ...OptionFunc) (*StorageNetworkIpRange, int, error) {
 	p := &ListStorageNetworkIpRangeParams{}
@@ -3689,7 +6936,7 @@ func (s *NetworkService) GetStorageNetworkIpRangeByID(id string, opts ...OptionF
 
 	p.p["id"] = id
 
-	for _, fn := range append(s.cs.options, opts...) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
 		if err := fn(s.cs, p); err != nil {
 			return nil, -1, err
 		}
@@ -3700,24 +6947,56 @@ func (s *NetworkService) GetStorageNetworkIpRangeByID(id string, opts ...OptionF
 		if strings.Contains(err.Error(), fmt.Sprintf(
 			"Invalid parameter id value=%s due to incorrect long value format, "+
 				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+			return nil, 0, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
 	}
 
 	if l.Count == 1 {
 		return l.StorageNetworkIpRange[0], l.Count, nil
 	}
+
+	if _, ok := p.p[firstMatchKey]; ok && len(l.StorageNetworkIpRange) > 0 {
+		return l.StorageNetworkIpRange[0], l.Count, nil
+	}
 	return nil, l.Count, fmt.Errorf("There is more then one result for StorageNetworkIpRange UUID: %s!", id)
 }
 
+// StorageNetworkIpRangeExists returns true if a StorageNetworkIpRange with the given ID exists. When no match is found
+// it returns false, while any other error is returned as is.
+func (s *NetworkService) StorageNetworkIpRangeExists(id string, opts ...OptionFunc) (bool, error) {
+	_, count, err := s.GetStorageNetworkIpRangeByID(id, opts...)
+	if errors.Is(err, ErrNotFound) {
+		return false, nil
+	}
+	if count > 0 {
+		return true, nil
+	}
+	return false, err
+}
+
 // List a storage network IP range.
 func (s *NetworkService) ListStorageNetworkIpRange(p *ListStorageNetworkIpRangeParams) (*ListStorageNetworkIpRangeResponse, error) {
-	resp, err := s.cs.newRequest("listStorageNetworkIpRange", p.toURLValues())
+	return s.ListStorageNetworkIpRangeWithContext(context.Background(), p)
+}
+
+// ListStorageNetworkIpRangeWithContext is the same as ListStorageNetworkIpRange, but the request is cancelled when the context is done
+func (s *NetworkService) ListStorageNetworkIpRangeWithContext(ctx context.Context, p *ListStorageNetworkIpRangeParams) (*ListStorageNetworkIpRangeResponse, error) {
+	return s.ListStorageNetworkIpRangeRawWithContext(ctx, p.toURLValues())
+}
+
+// ListStorageNetworkIpRangeRaw is the same as ListStorageNetworkIpRange, but takes the params as url.Values instead of typed params
+func (s *NetworkService) ListStorageNetworkIpRangeRaw(v url.Values) (*ListStorageNetworkIpRangeResponse, error) {
+	return s.ListStorageNetworkIpRangeRawWithContext(context.Background(), v)
+}
+
+// ListStorageNetworkIpRangeRawWithContext is the same as ListStorageNetworkIpRangeRaw, but the request is cancelled when the context is done
+func (s *NetworkService) ListStorageNetworkIpRangeRawWithContext(ctx context.Context, v url.Values) (*ListStorageNetworkIpRangeResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "listStorageNetworkIpRange", "liststoragenetworkiprangeresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -3730,6 +7009,93 @@ func (s *NetworkService) ListStorageNetworkIpRange(p *ListStorageNetworkIpRangeP
 	return &r, nil
 }
 
+// StreamStorageNetworkIpRange fetches the results of ListStorageNetworkIpRange page by page in the background and sends them on the
+// returned channel. Both returned channels are closed when done. If fetching a page fails, or the context
+// is done before all results are sent, the error is sent on the error channel and fetching stops. A
+// request for a page in flight is cancelled when the context is done. The page and pagesize set in the
+// params are ignored.
+func (s *NetworkService) StreamStorageNetworkIpRange(ctx context.Context, p *ListStorageNetworkIpRangeParams) (<-chan *StorageNetworkIpRange, <-chan error) {
+	ch := make(chan *StorageNetworkIpRange)
+	errs := make(chan error, 1)
+
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesize(500)
+
+	go func() {
+		defer close(errs)
+		defer close(ch)
+
+		for page, count := 1, 0; ; page++ {
+			pp.SetPage(page)
+
+			l, err := s.ListStorageNetworkIpRangeWithContext(ctx, pp)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			for _, v := range l.StorageNetworkIpRange {
+				select {
+				case ch <- v:
+				case <-ctx.Done():
+					errs <- ctx.Err()
+					return
+				}
+			}
+
+			count += len(l.StorageNetworkIpRange)
+			if len(l.StorageNetworkIpRange) < 500 || count >= l.Count {
+				return
+			}
+		}
+	}()
+
+	return ch, errs
+}
+
+// ListStorageNetworkIpRangeWhere returns all results of ListStorageNetworkIpRange for which pred returns true. Note that the results are
+// filtered client-side, so all results matching the params are fetched first.
+// All pages are fetched, so the page and pagesize set in the params are ignored.
+func (s *NetworkService) ListStorageNetworkIpRangeWhere(p *ListStorageNetworkIpRangeParams, pred func(*StorageNetworkIpRange) bool) ([]*StorageNetworkIpRange, error) {
+	var r []*StorageNetworkIpRange
+	ch, errs := s.StreamStorageNetworkIpRange(context.Background(), p)
+	for v := range ch {
+		if pred(v) {
+			r = append(r, v)
+		}
+	}
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ListAllStorageNetworkIpRange returns the results of ListStorageNetworkIpRange across all pages, by calling ListStorageNetworkIpRange page by page until all
+// results are fetched. The pagesize set in the params is used for every page (500 if not set), and the
+// page set in the params is ignored.
+func (s *NetworkService) ListAllStorageNetworkIpRange(p *ListStorageNetworkIpRangeParams) ([]*StorageNetworkIpRange, error) {
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesizeIfUnset(500)
+	pagesize := pp.p["pagesize"].(int)
+
+	var r []*StorageNetworkIpRange
+	for page := 1; ; page++ {
+		pp.SetPage(page)
+
+		l, err := s.ListStorageNetworkIpRange(pp)
+		if err != nil {
+			return nil, err
+		}
+		r = append(r, l.StorageNetworkIpRange...)
+
+		if len(l.StorageNetworkIpRange) < pagesize || len(r) >= l.Count {
+			return r, nil
+		}
+	}
+}
+
 type ListStorageNetworkIpRangeResponse struct {
 	Count                 int                      `json:"count"`
 	StorageNetworkIpRange []*StorageNetworkIpRange `json:"storagenetworkiprange"`
@@ -3751,6 +7117,16 @@ type ListSupportedNetworkServicesParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ListSupportedNetworkServicesParams) Clone() *ListSupportedNetworkServicesParams {
+	c := &ListSupportedNetworkServicesParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListSupportedNetworkServicesParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -3784,6 +7160,13 @@ func (p *ListSupportedNetworkServicesParams) SetKeyword(v string) {
 	return
 }
 
+// SetKeywordIfUnset sets keyword to v, unless it is already set
+func (p *ListSupportedNetworkServicesParams) SetKeywordIfUnset(v string) {
+	if _, found := p.p["keyword"]; !found {
+		p.SetKeyword(v)
+	}
+}
+
 func (p *ListSupportedNetworkServicesParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3792,6 +7175,13 @@ func (p *ListSupportedNetworkServicesParams) SetPage(v int) {
 	return
 }
 
+// SetPageIfUnset sets page to v, unless it is already set
+func (p *ListSupportedNetworkServicesParams) SetPageIfUnset(v int) {
+	if _, found := p.p["page"]; !found {
+		p.SetPage(v)
+	}
+}
+
 func (p *ListSupportedNetworkServicesParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3800,6 +7190,13 @@ func (p *ListSupportedNetworkServicesParams) SetPagesize(v int) {
 	return
 }
 
+// SetPagesizeIfUnset sets pagesize to v, unless it is already set
+func (p *ListSupportedNetworkServicesParams) SetPagesizeIfUnset(v int) {
+	if _, found := p.p["pagesize"]; !found {
+		p.SetPagesize(v)
+	}
+}
+
 func (p *ListSupportedNetworkServicesParams) SetProvider(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3808,6 +7205,13 @@ func (p *ListSupportedNetworkServicesParams) SetProvider(v string) {
 	return
 }
 
+// SetProviderIfUnset sets provider to v, unless it is already set
+func (p *ListSupportedNetworkServicesParams) SetProviderIfUnset(v string) {
+	if _, found := p.p["provider"]; !found {
+		p.SetProvider(v)
+	}
+}
+
 func (p *ListSupportedNetworkServicesParams) SetService(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3816,17 +7220,43 @@ func (p *ListSupportedNetworkServicesParams) SetService(v string) {
 	return
 }
 
+// SetServiceIfUnset sets service to v, unless it is already set
+func (p *ListSupportedNetworkServicesParams) SetServiceIfUnset(v string) {
+	if _, found := p.p["service"]; !found {
+		p.SetService(v)
+	}
+}
+
 // You should always use this function to get a new ListSupportedNetworkServicesParams instance,
-// as then you are sure you have configured all required params
-func (s *NetworkService) NewListSupportedNetworkServicesParams() *ListSupportedNetworkServicesParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *NetworkService) NewListSupportedNetworkServicesParams(opts ...func(*ListSupportedNetworkServicesParams)) *ListSupportedNetworkServicesParams {
 	p := &ListSupportedNetworkServicesParams{}
 	p.p = make(map[string]interface{})
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Lists all network services provided by CloudStack or for the given Provider.
 func (s *NetworkService) ListSupportedNetworkServices(p *ListSupportedNetworkServicesParams) (*ListSupportedNetworkServicesResponse, error) {
-	resp, err := s.cs.newRequest("listSupportedNetworkServices", p.toURLValues())
+	return s.ListSupportedNetworkServicesWithContext(context.Background(), p)
+}
+
+// ListSupportedNetworkServicesWithContext is the same as ListSupportedNetworkServices, but the request is cancelled when the context is done
+func (s *NetworkService) ListSupportedNetworkServicesWithContext(ctx context.Context, p *ListSupportedNetworkServicesParams) (*ListSupportedNetworkServicesResponse, error) {
+	return s.ListSupportedNetworkServicesRawWithContext(ctx, p.toURLValues())
+}
+
+// ListSupportedNetworkServicesRaw is the same as ListSupportedNetworkServices, but takes the params as url.Values instead of typed params
+func (s *NetworkService) ListSupportedNetworkServicesRaw(v url.Values) (*ListSupportedNetworkServicesResponse, error) {
+	return s.ListSupportedNetworkServicesRawWithContext(context.Background(), v)
+}
+
+// ListSupportedNetworkServicesRawWithContext is the same as ListSupportedNetworkServicesRaw, but the request is cancelled when the context is done
+func (s *NetworkService) ListSupportedNetworkServicesRawWithContext(ctx context.Context, v url.Values) (*ListSupportedNetworkServicesResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "listSupportedNetworkServices", "listsupportednetworkservicesresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -3839,6 +7269,93 @@ func (s *NetworkService) ListSupportedNetworkServices(p *ListSupportedNetworkSer
 	return &r, nil
 }
 
+// StreamSupportedNetworkServices fetches the results of ListSupportedNetworkServices page by page in the background and sends them on the
+// returned channel. Both returned channels are closed when done. If fetching a page fails, or the context
+// is done before all results are sent, the error is sent on the error channel and fetching stops. A
+// request for a page in flight is cancelled when the context is done. The page and pagesize set in the
+// params are ignored.
+func (s *NetworkService) StreamSupportedNetworkServices(ctx context.Context, p *ListSupportedNetworkServicesParams) (<-chan *SupportedNetworkService, <-chan error) {
+	ch := make(chan *SupportedNetworkService)
+	errs := make(chan error, 1)
+
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesize(500)
+
+	go func() {
+		defer close(errs)
+		defer close(ch)
+
+		for page, count := 1, 0; ; page++ {
+			pp.SetPage(page)
+
+			l, err := s.ListSupportedNetworkServicesWithContext(ctx, pp)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			for _, v := range l.SupportedNetworkServices {
+				select {
+				case ch <- v:
+				case <-ctx.Done():
+					errs <- ctx.Err()
+					return
+				}
+			}
+
+			count += len(l.SupportedNetworkServices)
+			if len(l.SupportedNetworkServices) < 500 || count >= l.Count {
+				return
+			}
+		}
+	}()
+
+	return ch, errs
+}
+
+// ListSupportedNetworkServicesWhere returns all results of ListSupportedNetworkServices for which pred returns true. Note that the results are
+// filtered client-side, so all results matching the params are fetched first.
+// All pages are fetched, so the page and pagesize set in the params are ignored.
+func (s *NetworkService) ListSupportedNetworkServicesWhere(p *ListSupportedNetworkServicesParams, pred func(*SupportedNetworkService) bool) ([]*SupportedNetworkService, error) {
+	var r []*SupportedNetworkService
+	ch, errs := s.StreamSupportedNetworkServices(context.Background(), p)
+	for v := range ch {
+		if pred(v) {
+			r = append(r, v)
+		}
+	}
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ListAllSupportedNetworkServices returns the results of ListSupportedNetworkServices across all pages, by calling ListSupportedNetworkServices page by page until all
+// results are fetched. The pagesize set in the params is used for every page (500 if not set), and the
+// page set in the params is ignored.
+func (s *NetworkService) ListAllSupportedNetworkServices(p *ListSupportedNetworkServicesParams) ([]*SupportedNetworkService, error) {
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesizeIfUnset(500)
+	pagesize := pp.p["pagesize"].(int)
+
+	var r []*SupportedNetworkService
+	for page := 1; ; page++ {
+		pp.SetPage(page)
+
+		l, err := s.ListSupportedNetworkServices(pp)
+		if err != nil {
+			return nil, err
+		}
+		r = append(r, l.SupportedNetworkServices...)
+
+		if len(l.SupportedNetworkServices) < pagesize || len(r) >= l.Count {
+			return r, nil
+		}
+	}
+}
+
 type ListSupportedNetworkServicesResponse struct {
 	Count                    int                        `json:"count"`
 	SupportedNetworkServices []*SupportedNetworkService `json:"supportednetworkservice"`
@@ -3866,6 +7383,16 @@ type ReleasePublicIpRangeParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ReleasePublicIpRangeParams) Clone() *ReleasePublicIpRangeParams {
+	c := &ReleasePublicIpRangeParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ReleasePublicIpRangeParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -3877,6 +7404,16 @@ func (p *ReleasePublicIpRangeParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *ReleasePublicIpRangeParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of releasePublicIpRange", k)
+		}
+	}
+	return nil
+}
+
 func (p *ReleasePublicIpRangeParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3885,18 +7422,54 @@ func (p *ReleasePublicIpRangeParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *ReleasePublicIpRangeParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 // You should always use this function to get a new ReleasePublicIpRangeParams instance,
-// as then you are sure you have configured all required params
-func (s *NetworkService) NewReleasePublicIpRangeParams(id string) *ReleasePublicIpRangeParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *NetworkService) NewReleasePublicIpRangeParams(id string, opts ...func(*ReleasePublicIpRangeParams)) *ReleasePublicIpRangeParams {
 	p := &ReleasePublicIpRangeParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Releases a Public IP range back to the system pool
-func (s *NetworkService) ReleasePublicIpRange(p *ReleasePublicIpRangeParams) (*ReleasePublicIpRangeResponse, error) {
-	resp, err := s.cs.newRequest("releasePublicIpRange", p.toURLValues())
+func (s *NetworkService) ReleasePublicIpRange(p *ReleasePublicIpRangeParams, opts ...OptionFunc) (*ReleasePublicIpRangeResponse, error) {
+	return s.ReleasePublicIpRangeWithContext(context.Background(), p, opts...)
+}
+
+// ReleasePublicIpRangeWithContext is the same as ReleasePublicIpRange, but the request is cancelled when the context is done
+func (s *NetworkService) ReleasePublicIpRangeWithContext(ctx context.Context, p *ReleasePublicIpRangeParams, opts ...OptionFunc) (*ReleasePublicIpRangeResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.ReleasePublicIpRangeRawWithContext(ctx, p.toURLValues())
+}
+
+// ReleasePublicIpRangeRaw is the same as ReleasePublicIpRange, but takes the params as url.Values instead of typed params
+func (s *NetworkService) ReleasePublicIpRangeRaw(v url.Values) (*ReleasePublicIpRangeResponse, error) {
+	return s.ReleasePublicIpRangeRawWithContext(context.Background(), v)
+}
+
+// ReleasePublicIpRangeRawWithContext is the same as ReleasePublicIpRangeRaw, but the request is cancelled when the context is done
+func (s *NetworkService) ReleasePublicIpRangeRawWithContext(ctx context.Context, v url.Values) (*ReleasePublicIpRangeResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "releasePublicIpRange", "releasepubliciprangeresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -3937,6 +7510,16 @@ type RestartNetworkParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *RestartNetworkParams) Clone() *RestartNetworkParams {
+	c := &RestartNetworkParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *RestartNetworkParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -3952,6 +7535,16 @@ func (p *RestartNetworkParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *RestartNetworkParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of restartNetwork", k)
+		}
+	}
+	return nil
+}
+
 func (p *RestartNetworkParams) SetCleanup(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3960,6 +7553,13 @@ func (p *RestartNetworkParams) SetCleanup(v bool) {
 	return
 }
 
+// SetCleanupIfUnset sets cleanup to v, unless it is already set
+func (p *RestartNetworkParams) SetCleanupIfUnset(v bool) {
+	if _, found := p.p["cleanup"]; !found {
+		p.SetCleanup(v)
+	}
+}
+
 func (p *RestartNetworkParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3968,18 +7568,54 @@ func (p *RestartNetworkParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *RestartNetworkParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 // You should always use this function to get a new RestartNetworkParams instance,
-// as then you are sure you have configured all required params
-func (s *NetworkService) NewRestartNetworkParams(id string) *RestartNetworkParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *NetworkService) NewRestartNetworkParams(id string, opts ...func(*RestartNetworkParams)) *RestartNetworkParams {
 	p := &RestartNetworkParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Restarts the network; includes 1) restarting network elements - virtual routers, DHCP servers 2) reapplying all public IPs 3) reapplying loadBalancing/portForwarding rules
-func (s *NetworkService) RestartNetwork(p *RestartNetworkParams) (*RestartNetworkResponse, error) {
-	resp, err := s.cs.newRequest("restartNetwork", p.toURLValues())
+func (s *NetworkService) RestartNetwork(p *RestartNetworkParams, opts ...OptionFunc) (*RestartNetworkResponse, error) {
+	return s.RestartNetworkWithContext(context.Background(), p, opts...)
+}
+
+// RestartNetworkWithContext is the same as RestartNetwork, but the request is cancelled when the context is done
+func (s *NetworkService) RestartNetworkWithContext(ctx context.Context, p *RestartNetworkParams, opts ...OptionFunc) (*RestartNetworkResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.RestartNetworkRawWithContext(ctx, p.toURLValues())
+}
+
+// RestartNetworkRaw is the same as RestartNetwork, but takes the params as url.Values instead of typed params
+func (s *NetworkService) RestartNetworkRaw(v url.Values) (*RestartNetworkResponse, error) {
+	return s.RestartNetworkRawWithContext(context.Background(), v)
+}
+
+// RestartNetworkRawWithContext is the same as RestartNetworkRaw, but the request is cancelled when the context is done
+func (s *NetworkService) RestartNetworkRawWithContext(ctx context.Context, v url.Values) (*RestartNetworkResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "restartNetwork", "restartnetworkresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -3991,9 +7627,9 @@ func (s *NetworkService) RestartNetwork(p *RestartNetworkParams) (*RestartNetwor
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -4061,6 +7697,16 @@ type UpdateNetworkParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *UpdateNetworkParams) Clone() *UpdateNetworkParams {
+	c := &UpdateNetworkParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *UpdateNetworkParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -4098,6 +7744,16 @@ func (p *UpdateNetworkParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *UpdateNetworkParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of updateNetwork", k)
+		}
+	}
+	return nil
+}
+
 func (p *UpdateNetworkParams) SetChangecidr(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -4106,6 +7762,13 @@ func (p *UpdateNetworkParams) SetChangecidr(v bool) {
 	return
 }
 
+// SetChangecidrIfUnset sets changecidr to v, unless it is already set
+func (p *UpdateNetworkParams) SetChangecidrIfUnset(v bool) {
+	if _, found := p.p["changecidr"]; !found {
+		p.SetChangecidr(v)
+	}
+}
+
 func (p *UpdateNetworkParams) SetCustomid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -4114,6 +7777,13 @@ func (p *UpdateNetworkParams) SetCustomid(v string) {
 	return
 }
 
+// SetCustomidIfUnset sets customid to v, unless it is already set
+func (p *UpdateNetworkParams) SetCustomidIfUnset(v string) {
+	if _, found := p.p["customid"]; !found {
+		p.SetCustomid(v)
+	}
+}
+
 func (p *UpdateNetworkParams) SetDisplaynetwork(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -4122,6 +7792,13 @@ func (p *UpdateNetworkParams) SetDisplaynetwork(v bool) {
 	return
 }
 
+// SetDisplaynetworkIfUnset sets displaynetwork to v, unless it is already set
+func (p *UpdateNetworkParams) SetDisplaynetworkIfUnset(v bool) {
+	if _, found := p.p["displaynetwork"]; !found {
+		p.SetDisplaynetwork(v)
+	}
+}
+
 func (p *UpdateNetworkParams) SetDisplaytext(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -4130,6 +7807,13 @@ func (p *UpdateNetworkParams) SetDisplaytext(v string) {
 	return
 }
 
+// SetDisplaytextIfUnset sets displaytext to v, unless it is already set
+func (p *UpdateNetworkParams) SetDisplaytextIfUnset(v string) {
+	if _, found := p.p["displaytext"]; !found {
+		p.SetDisplaytext(v)
+	}
+}
+
 func (p *UpdateNetworkParams) SetGuestvmcidr(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -4138,6 +7822,13 @@ func (p *UpdateNetworkParams) SetGuestvmcidr(v string) {
 	return
 }
 
+// SetGuestvmcidrIfUnset sets guestvmcidr to v, unless it is already set
+func (p *UpdateNetworkParams) SetGuestvmcidrIfUnset(v string) {
+	if _, found := p.p["guestvmcidr"]; !found {
+		p.SetGuestvmcidr(v)
+	}
+}
+
 func (p *UpdateNetworkParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -4146,6 +7837,13 @@ func (p *UpdateNetworkParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *UpdateNetworkParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 func (p *UpdateNetworkParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -4154,6 +7852,13 @@ func (p *UpdateNetworkParams) SetName(v string) {
 	return
 }
 
+// SetNameIfUnset sets name to v, unless it is already set
+func (p *UpdateNetworkParams) SetNameIfUnset(v string) {
+	if _, found := p.p["name"]; !found {
+		p.SetName(v)
+	}
+}
+
 func (p *UpdateNetworkParams) SetNetworkdomain(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -4162,6 +7867,13 @@ func (p *UpdateNetworkParams) SetNetworkdomain(v string) {
 	return
 }
 
+// SetNetworkdomainIfUnset sets networkdomain to v, unless it is already set
+func (p *UpdateNetworkParams) SetNetworkdomainIfUnset(v string) {
+	if _, found := p.p["networkdomain"]; !found {
+		p.SetNetworkdomain(v)
+	}
+}
+
 func (p *UpdateNetworkParams) SetNetworkofferingid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -4170,18 +7882,54 @@ func (p *UpdateNetworkParams) SetNetworkofferingid(v string) {
 	return
 }
 
+// SetNetworkofferingidIfUnset sets networkofferingid to v, unless it is already set
+func (p *UpdateNetworkParams) SetNetworkofferingidIfUnset(v string) {
+	if _, found := p.p["networkofferingid"]; !found {
+		p.SetNetworkofferingid(v)
+	}
+}
+
 // You should always use this function to get a new UpdateNetworkParams instance,
-// as then you are sure you have configured all required params
-func (s *NetworkService) NewUpdateNetworkParams(id string) *UpdateNetworkParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *NetworkService) NewUpdateNetworkParams(id string, opts ...func(*UpdateNetworkParams)) *UpdateNetworkParams {
 	p := &UpdateNetworkParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Updates a network
-func (s *NetworkService) UpdateNetwork(p *UpdateNetworkParams) (*UpdateNetworkResponse, error) {
-	resp, err := s.cs.newRequest("updateNetwork", p.toURLValues())
+func (s *NetworkService) UpdateNetwork(p *UpdateNetworkParams, opts ...OptionFunc) (*UpdateNetworkResponse, error) {
+	return s.UpdateNetworkWithContext(context.Background(), p, opts...)
+}
+
+// UpdateNetworkWithContext is the same as UpdateNetwork, but the request is cancelled when the context is done
+func (s *NetworkService) UpdateNetworkWithContext(ctx context.Context, p *UpdateNetworkParams, opts ...OptionFunc) (*UpdateNetworkResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.UpdateNetworkRawWithContext(ctx, p.toURLValues())
+}
+
+// UpdateNetworkRaw is the same as UpdateNetwork, but takes the params as url.Values instead of typed params
+func (s *NetworkService) UpdateNetworkRaw(v url.Values) (*UpdateNetworkResponse, error) {
+	return s.UpdateNetworkRawWithContext(context.Background(), v)
+}
+
+// UpdateNetworkRawWithContext is the same as UpdateNetworkRaw, but the request is cancelled when the context is done
+func (s *NetworkService) UpdateNetworkRawWithContext(ctx context.Context, v url.Values) (*UpdateNetworkResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "updateNetwork", "updatenetworkresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -4193,9 +7941,9 @@ func (s *NetworkService) UpdateNetwork(p *UpdateNetworkParams) (*UpdateNetworkRe
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -4297,6 +8045,16 @@ type UpdateNetworkServiceProviderParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *UpdateNetworkServiceProviderParams) Clone() *UpdateNetworkServiceProviderParams {
+	c := &UpdateNetworkServiceProviderParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *UpdateNetworkServiceProviderParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -4315,6 +8073,16 @@ func (p *UpdateNetworkServiceProviderParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *UpdateNetworkServiceProviderParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of updateNetworkServiceProvider", k)
+		}
+	}
+	return nil
+}
+
 func (p *UpdateNetworkServiceProviderParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -4323,6 +8091,13 @@ func (p *UpdateNetworkServiceProviderParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *UpdateNetworkServiceProviderParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 func (p *UpdateNetworkServiceProviderParams) SetServicelist(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -4331,6 +8106,18 @@ func (p *UpdateNetworkServiceProviderParams) SetServicelist(v []string) {
 	return
 }
 
+// SetServicelistIfUnset sets servicelist to v, unless it is already set
+func (p *UpdateNetworkServiceProviderParams) SetServicelistIfUnset(v []string) {
+	if _, found := p.p["servicelist"]; !found {
+		p.SetServicelist(v)
+	}
+}
+
+// SetServicelistCSV splits v on commas and sets the resulting values
+func (p *UpdateNetworkServiceProviderParams) SetServicelistCSV(v string) {
+	p.SetServicelist(splitCSV(v))
+}
+
 func (p *UpdateNetworkServiceProviderParams) SetState(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -4339,18 +8126,54 @@ func (p *UpdateNetworkServiceProviderParams) SetState(v string) {
 	return
 }
 
+// SetStateIfUnset sets state to v, unless it is already set
+func (p *UpdateNetworkServiceProviderParams) SetStateIfUnset(v string) {
+	if _, found := p.p["state"]; !found {
+		p.SetState(v)
+	}
+}
+
 // You should always use this function to get a new UpdateNetworkServiceProviderParams instance,
-// as then you are sure you have configured all required params
-func (s *NetworkService) NewUpdateNetworkServiceProviderParams(id string) *UpdateNetworkServiceProviderParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *NetworkService) NewUpdateNetworkServiceProviderParams(id string, opts ...func(*UpdateNetworkServiceProviderParams)) *UpdateNetworkServiceProviderParams {
 	p := &UpdateNetworkServiceProviderParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Updates a network serviceProvider of a physical network
-func (s *NetworkService) UpdateNetworkServiceProvider(p *UpdateNetworkServiceProviderParams) (*UpdateNetworkServiceProviderResponse, error) {
-	resp, err := s.cs.newRequest("updateNetworkServiceProvider", p.toURLValues())
+func (s *NetworkService) UpdateNetworkServiceProvider(p *UpdateNetworkServiceProviderParams, opts ...OptionFunc) (*UpdateNetworkServiceProviderResponse, error) {
+	return s.UpdateNetworkServiceProviderWithContext(context.Background(), p, opts...)
+}
+
+// UpdateNetworkServiceProviderWithContext is the same as UpdateNetworkServiceProvider, but the request is cancelled when the context is done
+func (s *NetworkService) UpdateNetworkServiceProviderWithContext(ctx context.Context, p *UpdateNetworkServiceProviderParams, opts ...OptionFunc) (*UpdateNetworkServiceProviderResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.UpdateNetworkServiceProviderRawWithContext(ctx, p.toURLValues())
+}
+
+// UpdateNetworkServiceProviderRaw is the same as UpdateNetworkServiceProvider, but takes the params as url.Values instead of typed params
+func (s *NetworkService) UpdateNetworkServiceProviderRaw(v url.Values) (*UpdateNetworkServiceProviderResponse, error) {
+	return s.UpdateNetworkServiceProviderRawWithContext(context.Background(), v)
+}
+
+// UpdateNetworkServiceProviderRawWithContext is the same as UpdateNetworkServiceProviderRaw, but the request is cancelled when the context is done
+func (s *NetworkService) UpdateNetworkServiceProviderRawWithContext(ctx context.Context, v url.Values) (*UpdateNetworkServiceProviderResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "updateNetworkServiceProvider", "updatenetworkserviceproviderresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -4362,9 +8185,9 @@ func (s *NetworkService) UpdateNetworkServiceProvider(p *UpdateNetworkServicePro
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -4398,6 +8221,16 @@ type UpdatePhysicalNetworkParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *UpdatePhysicalNetworkParams) Clone() *UpdatePhysicalNetworkParams {
+	c := &UpdatePhysicalNetworkParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *UpdatePhysicalNetworkParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -4422,6 +8255,16 @@ func (p *UpdatePhysicalNetworkParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *UpdatePhysicalNetworkParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of updatePhysicalNetwork", k)
+		}
+	}
+	return nil
+}
+
 func (p *UpdatePhysicalNetworkParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -4430,6 +8273,13 @@ func (p *UpdatePhysicalNetworkParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *UpdatePhysicalNetworkParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 func (p *UpdatePhysicalNetworkParams) SetNetworkspeed(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -4438,6 +8288,13 @@ func (p *UpdatePhysicalNetworkParams) SetNetworkspeed(v string) {
 	return
 }
 
+// SetNetworkspeedIfUnset sets networkspeed to v, unless it is already set
+func (p *UpdatePhysicalNetworkParams) SetNetworkspeedIfUnset(v string) {
+	if _, found := p.p["networkspeed"]; !found {
+		p.SetNetworkspeed(v)
+	}
+}
+
 func (p *UpdatePhysicalNetworkParams) SetState(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -4446,6 +8303,13 @@ func (p *UpdatePhysicalNetworkParams) SetState(v string) {
 	return
 }
 
+// SetStateIfUnset sets state to v, unless it is already set
+func (p *UpdatePhysicalNetworkParams) SetStateIfUnset(v string) {
+	if _, found := p.p["state"]; !found {
+		p.SetState(v)
+	}
+}
+
 func (p *UpdatePhysicalNetworkParams) SetTags(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -4454,6 +8318,18 @@ func (p *UpdatePhysicalNetworkParams) SetTags(v []string) {
 	return
 }
 
+// SetTagsIfUnset sets tags to v, unless it is already set
+func (p *UpdatePhysicalNetworkParams) SetTagsIfUnset(v []string) {
+	if _, found := p.p["tags"]; !found {
+		p.SetTags(v)
+	}
+}
+
+// SetTagsCSV splits v on commas and sets the resulting values
+func (p *UpdatePhysicalNetworkParams) SetTagsCSV(v string) {
+	p.SetTags(splitCSV(v))
+}
+
 func (p *UpdatePhysicalNetworkParams) SetVlan(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -4462,18 +8338,54 @@ func (p *UpdatePhysicalNetworkParams) SetVlan(v string) {
 	return
 }
 
+// SetVlanIfUnset sets vlan to v, unless it is already set
+func (p *UpdatePhysicalNetworkParams) SetVlanIfUnset(v string) {
+	if _, found := p.p["vlan"]; !found {
+		p.SetVlan(v)
+	}
+}
+
 // You should always use this function to get a new UpdatePhysicalNetworkParams instance,
-// as then you are sure you have configured all required params
-func (s *NetworkService) NewUpdatePhysicalNetworkParams(id string) *UpdatePhysicalNetworkParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *NetworkService) NewUpdatePhysicalNetworkParams(id string, opts ...func(*UpdatePhysicalNetworkParams)) *UpdatePhysicalNetworkParams {
 	p := &UpdatePhysicalNetworkParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Updates a physical network
-func (s *NetworkService) UpdatePhysicalNetwork(p *UpdatePhysicalNetworkParams) (*UpdatePhysicalNetworkResponse, error) {
-	resp, err := s.cs.newRequest("updatePhysicalNetwork", p.toURLValues())
+func (s *NetworkService) UpdatePhysicalNetwork(p *UpdatePhysicalNetworkParams, opts ...OptionFunc) (*UpdatePhysicalNetworkResponse, error) {
+	return s.UpdatePhysicalNetworkWithContext(context.Background(), p, opts...)
+}
+
+// UpdatePhysicalNetworkWithContext is the same as UpdatePhysicalNetwork, but the request is cancelled when the context is done
+func (s *NetworkService) UpdatePhysicalNetworkWithContext(ctx context.Context, p *UpdatePhysicalNetworkParams, opts ...OptionFunc) (*UpdatePhysicalNetworkResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.UpdatePhysicalNetworkRawWithContext(ctx, p.toURLValues())
+}
+
+// UpdatePhysicalNetworkRaw is the same as UpdatePhysicalNetwork, but takes the params as url.Values instead of typed params
+func (s *NetworkService) UpdatePhysicalNetworkRaw(v url.Values) (*UpdatePhysicalNetworkResponse, error) {
+	return s.UpdatePhysicalNetworkRawWithContext(context.Background(), v)
+}
+
+// UpdatePhysicalNetworkRawWithContext is the same as UpdatePhysicalNetworkRaw, but the request is cancelled when the context is done
+func (s *NetworkService) UpdatePhysicalNetworkRawWithContext(ctx context.Context, v url.Values) (*UpdatePhysicalNetworkResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "updatePhysicalNetwork", "updatephysicalnetworkresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -4485,9 +8397,9 @@ func (s *NetworkService) UpdatePhysicalNetwork(p *UpdatePhysicalNetworkParams) (
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -4524,6 +8436,16 @@ type UpdateStorageNetworkIpRangeParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *UpdateStorageNetworkIpRangeParams) Clone() *UpdateStorageNetworkIpRangeParams {
+	c := &UpdateStorageNetworkIpRangeParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *UpdateStorageNetworkIpRangeParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -4548,6 +8470,16 @@ func (p *UpdateStorageNetworkIpRangeParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *UpdateStorageNetworkIpRangeParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of updateStorageNetworkIpRange", k)
+		}
+	}
+	return nil
+}
+
 func (p *UpdateStorageNetworkIpRangeParams) SetEndip(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -4556,6 +8488,13 @@ func (p *UpdateStorageNetworkIpRangeParams) SetEndip(v string) {
 	return
 }
 
+// SetEndipIfUnset sets endip to v, unless it is already set
+func (p *UpdateStorageNetworkIpRangeParams) SetEndipIfUnset(v string) {
+	if _, found := p.p["endip"]; !found {
+		p.SetEndip(v)
+	}
+}
+
 func (p *UpdateStorageNetworkIpRangeParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -4564,6 +8503,13 @@ func (p *UpdateStorageNetworkIpRangeParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *UpdateStorageNetworkIpRangeParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 func (p *UpdateStorageNetworkIpRangeParams) SetNetmask(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -4572,6 +8518,13 @@ func (p *UpdateStorageNetworkIpRangeParams) SetNetmask(v string) {
 	return
 }
 
+// SetNetmaskIfUnset sets netmask to v, unless it is already set
+func (p *UpdateStorageNetworkIpRangeParams) SetNetmaskIfUnset(v string) {
+	if _, found := p.p["netmask"]; !found {
+		p.SetNetmask(v)
+	}
+}
+
 func (p *UpdateStorageNetworkIpRangeParams) SetStartip(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -4580,6 +8533,13 @@ func (p *UpdateStorageNetworkIpRangeParams) SetStartip(v string) {
 	return
 }
 
+// SetStartipIfUnset sets startip to v, unless it is already set
+func (p *UpdateStorageNetworkIpRangeParams) SetStartipIfUnset(v string) {
+	if _, found := p.p["startip"]; !found {
+		p.SetStartip(v)
+	}
+}
+
 func (p *UpdateStorageNetworkIpRangeParams) SetVlan(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -4588,18 +8548,54 @@ func (p *UpdateStorageNetworkIpRangeParams) SetVlan(v int) {
 	return
 }
 
+// SetVlanIfUnset sets vlan to v, unless it is already set
+func (p *UpdateStorageNetworkIpRangeParams) SetVlanIfUnset(v int) {
+	if _, found := p.p["vlan"]; !found {
+		p.SetVlan(v)
+	}
+}
+
 // You should always use this function to get a new UpdateStorageNetworkIpRangeParams instance,
-// as then you are sure you have configured all required params
-func (s *NetworkService) NewUpdateStorageNetworkIpRangeParams(id string) *UpdateStorageNetworkIpRangeParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *NetworkService) NewUpdateStorageNetworkIpRangeParams(id string, opts ...func(*UpdateStorageNetworkIpRangeParams)) *UpdateStorageNetworkIpRangeParams {
 	p := &UpdateStorageNetworkIpRangeParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Update a Storage network IP range, only allowed when no IPs in this range have been allocated.
-func (s *NetworkService) UpdateStorageNetworkIpRange(p *UpdateStorageNetworkIpRangeParams) (*UpdateStorageNetworkIpRangeResponse, error) {
-	resp, err := s.cs.newRequest("updateStorageNetworkIpRange", p.toURLValues())
+func (s *NetworkService) UpdateStorageNetworkIpRange(p *UpdateStorageNetworkIpRangeParams, opts ...OptionFunc) (*UpdateStorageNetworkIpRangeResponse, error) {
+	return s.UpdateStorageNetworkIpRangeWithContext(context.Background(), p, opts...)
+}
+
+// UpdateStorageNetworkIpRangeWithContext is the same as UpdateStorageNetworkIpRange, but the request is cancelled when the context is done
+func (s *NetworkService) UpdateStorageNetworkIpRangeWithContext(ctx context.Context, p *UpdateStorageNetworkIpRangeParams, opts ...OptionFunc) (*UpdateStorageNetworkIpRangeResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.UpdateStorageNetworkIpRangeRawWithContext(ctx, p.toURLValues())
+}
+
+// UpdateStorageNetworkIpRangeRaw is the same as UpdateStorageNetworkIpRange, but takes the params as url.Values instead of typed params
+func (s *NetworkService) UpdateStorageNetworkIpRangeRaw(v url.Values) (*UpdateStorageNetworkIpRangeResponse, error) {
+	return s.UpdateStorageNetworkIpRangeRawWithContext(context.Background(), v)
+}
+
+// UpdateStorageNetworkIpRangeRawWithContext is the same as UpdateStorageNetworkIpRangeRaw, but the request is cancelled when the context is done
+func (s *NetworkService) UpdateStorageNetworkIpRangeRawWithContext(ctx context.Context, v url.Values) (*UpdateStorageNetworkIpRangeResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "updateStorageNetworkIpRange", "updatestoragenetworkiprangeresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -4611,9 +8607,9 @@ func (s *NetworkService) UpdateStorageNetworkIpRange(p *UpdateStorageNetworkIpRa
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -17,15 +17,72 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
 	"net/url"
 	"strconv"
 )
 
+// AttachNetwork adds a NIC for the given network to the virtual machine, waits for the async job to
+// finish and returns the updated virtual machine. The options are applied to the add NIC params and
+// used when getting the updated virtual machine.
+func (s *NicService) AttachNetwork(vmid, networkid string, opts ...OptionFunc) (*VirtualMachine, error) {
+	p := s.cs.VirtualMachine.NewAddNicToVirtualMachineParams(networkid, vmid)
+
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	r, err := s.cs.VirtualMachine.AddNicToVirtualMachine(p)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.waitForNicJob(r.JobID, vmid, opts...)
+}
+
+// DetachNetwork removes the given NIC from the virtual machine, waits for the async job to finish
+// and returns the updated virtual machine. The options are used when getting the updated virtual machine.
+func (s *NicService) DetachNetwork(vmid, nicid string, opts ...OptionFunc) (*VirtualMachine, error) {
+	p := s.cs.VirtualMachine.NewRemoveNicFromVirtualMachineParams(nicid, vmid)
+
+	r, err := s.cs.VirtualMachine.RemoveNicFromVirtualMachine(p)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.waitForNicJob(r.JobID, vmid, opts...)
+}
+
+func (s *NicService) waitForNicJob(jobid, vmid string, opts ...OptionFunc) (*VirtualMachine, error) {
+	// An async client already waited for the job to finish
+	if !s.cs.async {
+		if _, err := s.cs.GetAsyncJobResult(jobid, s.cs.timeout); err != nil {
+			return nil, err
+		}
+	}
+
+	vm, _, err := s.cs.VirtualMachine.GetVirtualMachineByID(vmid, opts...)
+	return vm, err
+}
+
 type AddIpToNicParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *AddIpToNicParams) Clone() *AddIpToNicParams {
+	c := &AddIpToNicParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *AddIpToNicParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -40,6 +97,16 @@ func (p *AddIpToNicParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *AddIpToNicParams) validate() error {
+	for _, k := range []string{"nicid"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of addIpToNic", k)
+		}
+	}
+	return nil
+}
+
 func (p *AddIpToNicParams) SetIpaddress(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -48,6 +115,13 @@ func (p *AddIpToNicParams) SetIpaddress(v string) {
 	return
 }
 
+// SetIpaddressIfUnset sets ipaddress to v, unless it is already set
+func (p *AddIpToNicParams) SetIpaddressIfUnset(v string) {
+	if _, found := p.p["ipaddress"]; !found {
+		p.SetIpaddress(v)
+	}
+}
+
 func (p *AddIpToNicParams) SetNicid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -56,18 +130,54 @@ func (p *AddIpToNicParams) SetNicid(v string) {
 	return
 }
 
+// SetNicidIfUnset sets nicid to v, unless it is already set
+func (p *AddIpToNicParams) SetNicidIfUnset(v string) {
+	if _, found := p.p["nicid"]; !found {
+		p.SetNicid(v)
+	}
+}
+
 // You should always use this function to get a new AddIpToNicParams instance,
-// as then you are sure you have configured all required params
-func (s *NicService) NewAddIpToNicParams(nicid string) *AddIpToNicParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *NicService) NewAddIpToNicParams(nicid string, opts ...func(*AddIpToNicParams)) *AddIpToNicParams {
 	p := &AddIpToNicParams{}
 	p.p = make(map[string]interface{})
 	p.p["nicid"] = nicid
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Assigns secondary IP to NIC
-func (s *NicService) AddIpToNic(p *AddIpToNicParams) (*AddIpToNicResponse, error) {
-	resp, err := s.cs.newRequest("addIpToNic", p.toURLValues())
+func (s *NicService) AddIpToNic(p *AddIpToNicParams, opts ...OptionFunc) (*AddIpToNicResponse, error) {
+	return s.AddIpToNicWithContext(context.Background(), p, opts...)
+}
+
+// AddIpToNicWithContext is the same as AddIpToNic, but the request is cancelled when the context is done
+func (s *NicService) AddIpToNicWithContext(ctx context.Context, p *AddIpToNicParams, opts ...OptionFunc) (*AddIpToNicResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.AddIpToNicRawWithContext(ctx, p.toURLValues())
+}
+
+// AddIpToNicRaw is the same as AddIpToNic, but takes the params as url.Values instead of typed params
+func (s *NicService) AddIpToNicRaw(v url.Values) (*AddIpToNicResponse, error) {
+	return s.AddIpToNicRawWithContext(context.Background(), v)
+}
+
+// AddIpToNicRawWithContext is the same as AddIpToNicRaw, but the request is cancelled when the context is done
+func (s *NicService) AddIpToNicRawWithContext(ctx context.Context, v url.Values) (*AddIpToNicResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "addIpToNic", "addiptovmnicresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -79,9 +189,9 @@ func (s *NicService) AddIpToNic(p *AddIpToNicParams) (*AddIpToNicResponse, error
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -113,6 +223,16 @@ type ListNicsParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ListNicsParams) Clone() *ListNicsParams {
+	c := &ListNicsParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListNicsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -145,6 +265,16 @@ func (p *ListNicsParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *ListNicsParams) validate() error {
+	for _, k := range []string{"virtualmachineid"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of listNics", k)
+		}
+	}
+	return nil
+}
+
 func (p *ListNicsParams) SetFordisplay(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -153,6 +283,13 @@ func (p *ListNicsParams) SetFordisplay(v bool) {
 	return
 }
 
+// SetFordisplayIfUnset sets fordisplay to v, unless it is already set
+func (p *ListNicsParams) SetFordisplayIfUnset(v bool) {
+	if _, found := p.p["fordisplay"]; !found {
+		p.SetFordisplay(v)
+	}
+}
+
 func (p *ListNicsParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -161,6 +298,13 @@ func (p *ListNicsParams) SetKeyword(v string) {
 	return
 }
 
+// SetKeywordIfUnset sets keyword to v, unless it is already set
+func (p *ListNicsParams) SetKeywordIfUnset(v string) {
+	if _, found := p.p["keyword"]; !found {
+		p.SetKeyword(v)
+	}
+}
+
 func (p *ListNicsParams) SetNetworkid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -169,6 +313,13 @@ func (p *ListNicsParams) SetNetworkid(v string) {
 	return
 }
 
+// SetNetworkidIfUnset sets networkid to v, unless it is already set
+func (p *ListNicsParams) SetNetworkidIfUnset(v string) {
+	if _, found := p.p["networkid"]; !found {
+		p.SetNetworkid(v)
+	}
+}
+
 func (p *ListNicsParams) SetNicid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -177,6 +328,13 @@ func (p *ListNicsParams) SetNicid(v string) {
 	return
 }
 
+// SetNicidIfUnset sets nicid to v, unless it is already set
+func (p *ListNicsParams) SetNicidIfUnset(v string) {
+	if _, found := p.p["nicid"]; !found {
+		p.SetNicid(v)
+	}
+}
+
 func (p *ListNicsParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -185,6 +343,13 @@ func (p *ListNicsParams) SetPage(v int) {
 	return
 }
 
+// SetPageIfUnset sets page to v, unless it is already set
+func (p *ListNicsParams) SetPageIfUnset(v int) {
+	if _, found := p.p["page"]; !found {
+		p.SetPage(v)
+	}
+}
+
 func (p *ListNicsParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -193,6 +358,13 @@ func (p *ListNicsParams) SetPagesize(v int) {
 	return
 }
 
+// SetPagesizeIfUnset sets pagesize to v, unless it is already set
+func (p *ListNicsParams) SetPagesizeIfUnset(v int) {
+	if _, found := p.p["pagesize"]; !found {
+		p.SetPagesize(v)
+	}
+}
+
 func (p *ListNicsParams) SetVirtualmachineid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -201,18 +373,48 @@ func (p *ListNicsParams) SetVirtualmachineid(v string) {
 	return
 }
 
+// SetVirtualmachineidIfUnset sets virtualmachineid to v, unless it is already set
+func (p *ListNicsParams) SetVirtualmachineidIfUnset(v string) {
+	if _, found := p.p["virtualmachineid"]; !found {
+		p.SetVirtualmachineid(v)
+	}
+}
+
 // You should always use this function to get a new ListNicsParams instance,
-// as then you are sure you have configured all required params
-func (s *NicService) NewListNicsParams(virtualmachineid string) *ListNicsParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *NicService) NewListNicsParams(virtualmachineid string, opts ...func(*ListNicsParams)) *ListNicsParams {
 	p := &ListNicsParams{}
 	p.p = make(map[string]interface{})
 	p.p["virtualmachineid"] = virtualmachineid
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // list the vm nics  IP to NIC
 func (s *NicService) ListNics(p *ListNicsParams) (*ListNicsResponse, error) {
-	resp, err := s.cs.newRequest("listNics", p.toURLValues())
+	return s.ListNicsWithContext(context.Background(), p)
+}
+
+// ListNicsWithContext is the same as ListNics, but the request is cancelled when the context is done
+func (s *NicService) ListNicsWithContext(ctx context.Context, p *ListNicsParams) (*ListNicsResponse, error) {
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.ListNicsRawWithContext(ctx, p.toURLValues())
+}
+
+// ListNicsRaw is the same as ListNics, but takes the params as url.Values instead of typed params
+func (s *NicService) ListNicsRaw(v url.Values) (*ListNicsResponse, error) {
+	return s.ListNicsRawWithContext(context.Background(), v)
+}
+
+// ListNicsRawWithContext is the same as ListNicsRaw, but the request is cancelled when the context is done
+func (s *NicService) ListNicsRawWithContext(ctx context.Context, v url.Values) (*ListNicsResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "listNics", "listnicsresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -225,6 +427,93 @@ func (s *NicService) ListNics(p *ListNicsParams) (*ListNicsResponse, error) {
 	return &r, nil
 }
 
+// StreamNics fetches the results of ListNics page by page in the background and sends them on the
+// returned channel. Both returned channels are closed when done. If fetching a page fails, or the context
+// is done before all results are sent, the error is sent on the error channel and fetching stops. A
+// request for a page in flight is cancelled when the context is done. The page and pagesize set in the
+// params are ignored.
+func (s *NicService) StreamNics(ctx context.Context, p *ListNicsParams) (<-chan *Nic, <-chan error) {
+	ch := make(chan *Nic)
+	errs := make(chan error, 1)
+
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesize(500)
+
+	go func() {
+		defer close(errs)
+		defer close(ch)
+
+		for page, count := 1, 0; ; page++ {
+			pp.SetPage(page)
+
+			l, err := s.ListNicsWithContext(ctx, pp)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			for _, v := range l.Nics {
+				select {
+				case ch <- v:
+				case <-ctx.Done():
+					errs <- ctx.Err()
+					return
+				}
+			}
+
+			count += len(l.Nics)
+			if len(l.Nics) < 500 || count >= l.Count {
+				return
+			}
+		}
+	}()
+
+	return ch, errs
+}
+
+// ListNicsWhere returns all results of ListNics for which pred returns true. Note that the results are
+// filtered client-side, so all results matching the params are fetched first.
+// All pages are fetched, so the page and pagesize set in the params are ignored.
+func (s *NicService) ListNicsWhere(p *ListNicsParams, pred func(*Nic) bool) ([]*Nic, error) {
+	var r []*Nic
+	ch, errs := s.StreamNics(context.Background(), p)
+	for v := range ch {
+		if pred(v) {
+			r = append(r, v)
+		}
+	}
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ListAllNics returns the results of ListNics across all pages, by calling ListNics page by page until all
+// results are fetched. The pagesize set in the params is used for every page (500 if not set), and the
+// page set in the params is ignored.
+func (s *NicService) ListAllNics(p *ListNicsParams) ([]*Nic, error) {
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesizeIfUnset(500)
+	pagesize := pp.p["pagesize"].(int)
+
+	var r []*Nic
+	for page := 1; ; page++ {
+		pp.SetPage(page)
+
+		l, err := s.ListNics(pp)
+		if err != nil {
+			return nil, err
+		}
+		r = append(r, l.Nics...)
+
+		if len(l.Nics) < pagesize || len(r) >= l.Count {
+			return r, nil
+		}
+	}
+}
+
 type ListNicsResponse struct {
 	Count int    `json:"count"`
 	Nics  []*Nic `json:"nic"`
@@ -260,6 +549,16 @@ type RemoveIpFromNicParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *RemoveIpFromNicParams) Clone() *RemoveIpFromNicParams {
+	c := &RemoveIpFromNicParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *RemoveIpFromNicParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -271,6 +570,16 @@ func (p *RemoveIpFromNicParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *RemoveIpFromNicParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of removeIpFromNic", k)
+		}
+	}
+	return nil
+}
+
 func (p *RemoveIpFromNicParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -279,18 +588,54 @@ func (p *RemoveIpFromNicParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *RemoveIpFromNicParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 // You should always use this function to get a new RemoveIpFromNicParams instance,
-// as then you are sure you have configured all required params
-func (s *NicService) NewRemoveIpFromNicParams(id string) *RemoveIpFromNicParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *NicService) NewRemoveIpFromNicParams(id string, opts ...func(*RemoveIpFromNicParams)) *RemoveIpFromNicParams {
 	p := &RemoveIpFromNicParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Removes secondary IP from the NIC.
-func (s *NicService) RemoveIpFromNic(p *RemoveIpFromNicParams) (*RemoveIpFromNicResponse, error) {
-	resp, err := s.cs.newRequest("removeIpFromNic", p.toURLValues())
+func (s *NicService) RemoveIpFromNic(p *RemoveIpFromNicParams, opts ...OptionFunc) (*RemoveIpFromNicResponse, error) {
+	return s.RemoveIpFromNicWithContext(context.Background(), p, opts...)
+}
+
+// RemoveIpFromNicWithContext is the same as RemoveIpFromNic, but the request is cancelled when the context is done
+func (s *NicService) RemoveIpFromNicWithContext(ctx context.Context, p *RemoveIpFromNicParams, opts ...OptionFunc) (*RemoveIpFromNicResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.RemoveIpFromNicRawWithContext(ctx, p.toURLValues())
+}
+
+// RemoveIpFromNicRaw is the same as RemoveIpFromNic, but takes the params as url.Values instead of typed params
+func (s *NicService) RemoveIpFromNicRaw(v url.Values) (*RemoveIpFromNicResponse, error) {
+	return s.RemoveIpFromNicRawWithContext(context.Background(), v)
+}
+
+// RemoveIpFromNicRawWithContext is the same as RemoveIpFromNicRaw, but the request is cancelled when the context is done
+func (s *NicService) RemoveIpFromNicRawWithContext(ctx context.Context, v url.Values) (*RemoveIpFromNicResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "removeIpFromNic", "removeipfromvmnicresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -302,9 +647,9 @@ func (s *NicService) RemoveIpFromNic(p *RemoveIpFromNicParams) (*RemoveIpFromNic
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -328,6 +673,16 @@ type UpdateVmNicIpParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *UpdateVmNicIpParams) Clone() *UpdateVmNicIpParams {
+	c := &UpdateVmNicIpParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *UpdateVmNicIpParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -342,6 +697,16 @@ func (p *UpdateVmNicIpParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *UpdateVmNicIpParams) validate() error {
+	for _, k := range []string{"nicid"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of updateVmNicIp", k)
+		}
+	}
+	return nil
+}
+
 func (p *UpdateVmNicIpParams) SetIpaddress(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -350,6 +715,13 @@ func (p *UpdateVmNicIpParams) SetIpaddress(v string) {
 	return
 }
 
+// SetIpaddressIfUnset sets ipaddress to v, unless it is already set
+func (p *UpdateVmNicIpParams) SetIpaddressIfUnset(v string) {
+	if _, found := p.p["ipaddress"]; !found {
+		p.SetIpaddress(v)
+	}
+}
+
 func (p *UpdateVmNicIpParams) SetNicid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -358,18 +730,54 @@ func (p *UpdateVmNicIpParams) SetNicid(v string) {
 	return
 }
 
+// SetNicidIfUnset sets nicid to v, unless it is already set
+func (p *UpdateVmNicIpParams) SetNicidIfUnset(v string) {
+	if _, found := p.p["nicid"]; !found {
+		p.SetNicid(v)
+	}
+}
+
 // You should always use this function to get a new UpdateVmNicIpParams instance,
-// as then you are sure you have configured all required params
-func (s *NicService) NewUpdateVmNicIpParams(nicid string) *UpdateVmNicIpParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *NicService) NewUpdateVmNicIpParams(nicid string, opts ...func(*UpdateVmNicIpParams)) *UpdateVmNicIpParams {
 	p := &UpdateVmNicIpParams{}
 	p.p = make(map[string]interface{})
 	p.p["nicid"] = nicid
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Update the default Ip of a VM Nic
-func (s *NicService) UpdateVmNicIp(p *UpdateVmNicIpParams) (*UpdateVmNicIpResponse, error) {
-	resp, err := s.cs.newRequest("updateVmNicIp", p.toURLValues())
+func (s *NicService) UpdateVmNicIp(p *UpdateVmNicIpParams, opts ...OptionFunc) (*UpdateVmNicIpResponse, error) {
+	return s.UpdateVmNicIpWithContext(context.Background(), p, opts...)
+}
+
+// UpdateVmNicIpWithContext is the same as UpdateVmNicIp, but the request is cancelled when the context is done
+func (s *NicService) UpdateVmNicIpWithContext(ctx context.Context, p *UpdateVmNicIpParams, opts ...OptionFunc) (*UpdateVmNicIpResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.UpdateVmNicIpRawWithContext(ctx, p.toURLValues())
+}
+
+// UpdateVmNicIpRaw is the same as UpdateVmNicIp, but takes the params as url.Values instead of typed params
+func (s *NicService) UpdateVmNicIpRaw(v url.Values) (*UpdateVmNicIpResponse, error) {
+	return s.UpdateVmNicIpRawWithContext(context.Background(), v)
+}
+
+// UpdateVmNicIpRawWithContext is the same as UpdateVmNicIpRaw, but the request is cancelled when the context is done
+func (s *NicService) UpdateVmNicIpRawWithContext(ctx context.Context, v url.Values) (*UpdateVmNicIpResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "updateVmNicIp", "updatevmnicipresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -381,9 +789,9 @@ func (s *NicService) UpdateVmNicIp(p *UpdateVmNicIpParams) (*UpdateVmNicIpRespon
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -417,43 +825,43 @@ type UpdateVmNicIpResponse struct {
 		Type              string   `json:"type"`
 		VirtualmachineIds []string `json:"virtualmachineIds"`
 	} `json:"affinitygroup"`
-	Cpunumber             int               `json:"cpunumber"`
-	Cpuspeed              int               `json:"cpuspeed"`
-	Cpuused               string            `json:"cpuused"`
-	Created               string            `json:"created"`
-	Details               map[string]string `json:"details"`
-	Diskioread            int64             `json:"diskioread"`
-	Diskiowrite           int64             `json:"diskiowrite"`
-	Diskkbsread           int64             `json:"diskkbsread"`
-	Diskkbswrite          int64             `json:"diskkbswrite"`
-	Diskofferingid        string            `json:"diskofferingid"`
-	Diskofferingname      string            `json:"diskofferingname"`
-	Displayname           string            `json:"displayname"`
-	Displayvm             bool              `json:"displayvm"`
-	Domain                string            `json:"domain"`
-	Domainid              string            `json:"domainid"`
-	Forvirtualnetwork     bool              `json:"forvirtualnetwork"`
-	Group                 string            `json:"group"`
-	Groupid               string            `json:"groupid"`
-	Guestosid             string            `json:"guestosid"`
-	Haenable              bool              `json:"haenable"`
-	Hostid                string            `json:"hostid"`
-	Hostname              string            `json:"hostname"`
-	Hypervisor            string            `json:"hypervisor"`
-	Id                    string            `json:"id"`
-	Instancename          string            `json:"instancename"`
-	Isdynamicallyscalable bool              `json:"isdynamicallyscalable"`
-	Isodisplaytext        string            `json:"isodisplaytext"`
-	Isoid                 string            `json:"isoid"`
-	Isoname               string            `json:"isoname"`
-	Keypair               string            `json:"keypair"`
-	Memory                int               `json:"memory"`
-	Memoryintfreekbs      int64             `json:"memoryintfreekbs"`
-	Memorykbs             int64             `json:"memorykbs"`
-	Memorytargetkbs       int64             `json:"memorytargetkbs"`
-	Name                  string            `json:"name"`
-	Networkkbsread        int64             `json:"networkkbsread"`
-	Networkkbswrite       int64             `json:"networkkbswrite"`
+	Cpunumber             int       `json:"cpunumber"`
+	Cpuspeed              int       `json:"cpuspeed"`
+	Cpuused               string    `json:"cpuused"`
+	Created               string    `json:"created"`
+	Details               StringMap `json:"details"`
+	Diskioread            int64     `json:"diskioread"`
+	Diskiowrite           int64     `json:"diskiowrite"`
+	Diskkbsread           int64     `json:"diskkbsread"`
+	Diskkbswrite          int64     `json:"diskkbswrite"`
+	Diskofferingid        string    `json:"diskofferingid"`
+	Diskofferingname      string    `json:"diskofferingname"`
+	Displayname           string    `json:"displayname"`
+	Displayvm             bool      `json:"displayvm"`
+	Domain                string    `json:"domain"`
+	Domainid              string    `json:"domainid"`
+	Forvirtualnetwork     bool      `json:"forvirtualnetwork"`
+	Group                 string    `json:"group"`
+	Groupid               string    `json:"groupid"`
+	Guestosid             string    `json:"guestosid"`
+	Haenable              bool      `json:"haenable"`
+	Hostid                string    `json:"hostid"`
+	Hostname              string    `json:"hostname"`
+	Hypervisor            string    `json:"hypervisor"`
+	Id                    string    `json:"id"`
+	Instancename          string    `json:"instancename"`
+	Isdynamicallyscalable bool      `json:"isdynamicallyscalable"`
+	Isodisplaytext        string    `json:"isodisplaytext"`
+	Isoid                 string    `json:"isoid"`
+	Isoname               string    `json:"isoname"`
+	Keypair               string    `json:"keypair"`
+	Memory                int       `json:"memory"`
+	Memoryintfreekbs      int64     `json:"memoryintfreekbs"`
+	Memorykbs             int64     `json:"memorykbs"`
+	Memorytargetkbs       int64     `json:"memorytargetkbs"`
+	Name                  string    `json:"name"`
+	Networkkbsread        int64     `json:"networkkbsread"`
+	Networkkbswrite       int64     `json:"networkkbswrite"`
 	Nic                   []struct {
 		Broadcasturi         string `json:"broadcasturi"`
 		Deviceid             string `json:"deviceid"`
@@ -17,7 +17,9 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
 	"net/url"
 	"strconv"
 )
@@ -26,6 +28,16 @@ type AddNiciraNvpDeviceParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *AddNiciraNvpDeviceParams) Clone() *AddNiciraNvpDeviceParams {
+	c := &AddNiciraNvpDeviceParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *AddNiciraNvpDeviceParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -55,6 +67,16 @@ func (p *AddNiciraNvpDeviceParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *AddNiciraNvpDeviceParams) validate() error {
+	for _, k := range []string{"hostname", "password", "physicalnetworkid", "transportzoneuuid", "username"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of addNiciraNvpDevice", k)
+		}
+	}
+	return nil
+}
+
 func (p *AddNiciraNvpDeviceParams) SetHostname(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -63,6 +85,13 @@ func (p *AddNiciraNvpDeviceParams) SetHostname(v string) {
 	return
 }
 
+// SetHostnameIfUnset sets hostname to v, unless it is already set
+func (p *AddNiciraNvpDeviceParams) SetHostnameIfUnset(v string) {
+	if _, found := p.p["hostname"]; !found {
+		p.SetHostname(v)
+	}
+}
+
 func (p *AddNiciraNvpDeviceParams) SetL2gatewayserviceuuid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -71,6 +100,13 @@ func (p *AddNiciraNvpDeviceParams) SetL2gatewayserviceuuid(v string) {
 	return
 }
 
+// SetL2gatewayserviceuuidIfUnset sets l2gatewayserviceuuid to v, unless it is already set
+func (p *AddNiciraNvpDeviceParams) SetL2gatewayserviceuuidIfUnset(v string) {
+	if _, found := p.p["l2gatewayserviceuuid"]; !found {
+		p.SetL2gatewayserviceuuid(v)
+	}
+}
+
 func (p *AddNiciraNvpDeviceParams) SetL3gatewayserviceuuid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -79,6 +115,13 @@ func (p *AddNiciraNvpDeviceParams) SetL3gatewayserviceuuid(v string) {
 	return
 }
 
+// SetL3gatewayserviceuuidIfUnset sets l3gatewayserviceuuid to v, unless it is already set
+func (p *AddNiciraNvpDeviceParams) SetL3gatewayserviceuuidIfUnset(v string) {
+	if _, found := p.p["l3gatewayserviceuuid"]; !found {
+		p.SetL3gatewayserviceuuid(v)
+	}
+}
+
 func (p *AddNiciraNvpDeviceParams) SetPassword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -87,6 +130,13 @@ func (p *AddNiciraNvpDeviceParams) SetPassword(v string) {
 	return
 }
 
+// SetPasswordIfUnset sets password to v, unless it is already set
+func (p *AddNiciraNvpDeviceParams) SetPasswordIfUnset(v string) {
+	if _, found := p.p["password"]; !found {
+		p.SetPassword(v)
+	}
+}
+
 func (p *AddNiciraNvpDeviceParams) SetPhysicalnetworkid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -95,6 +145,13 @@ func (p *AddNiciraNvpDeviceParams) SetPhysicalnetworkid(v string) {
 	return
 }
 
+// SetPhysicalnetworkidIfUnset sets physicalnetworkid to v, unless it is already set
+func (p *AddNiciraNvpDeviceParams) SetPhysicalnetworkidIfUnset(v string) {
+	if _, found := p.p["physicalnetworkid"]; !found {
+		p.SetPhysicalnetworkid(v)
+	}
+}
+
 func (p *AddNiciraNvpDeviceParams) SetTransportzoneuuid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -103,6 +160,13 @@ func (p *AddNiciraNvpDeviceParams) SetTransportzoneuuid(v string) {
 	return
 }
 
+// SetTransportzoneuuidIfUnset sets transportzoneuuid to v, unless it is already set
+func (p *AddNiciraNvpDeviceParams) SetTransportzoneuuidIfUnset(v string) {
+	if _, found := p.p["transportzoneuuid"]; !found {
+		p.SetTransportzoneuuid(v)
+	}
+}
+
 func (p *AddNiciraNvpDeviceParams) SetUsername(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -111,9 +175,17 @@ func (p *AddNiciraNvpDeviceParams) SetUsername(v string) {
 	return
 }
 
+// SetUsernameIfUnset sets username to v, unless it is already set
+func (p *AddNiciraNvpDeviceParams) SetUsernameIfUnset(v string) {
+	if _, found := p.p["username"]; !found {
+		p.SetUsername(v)
+	}
+}
+
 // You should always use this function to get a new AddNiciraNvpDeviceParams instance,
-// as then you are sure you have configured all required params
-func (s *NiciraNVPService) NewAddNiciraNvpDeviceParams(hostname string, password string, physicalnetworkid string, transportzoneuuid string, username string) *AddNiciraNvpDeviceParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *NiciraNVPService) NewAddNiciraNvpDeviceParams(hostname string, password string, physicalnetworkid string, transportzoneuuid string, username string, opts ...func(*AddNiciraNvpDeviceParams)) *AddNiciraNvpDeviceParams {
 	p := &AddNiciraNvpDeviceParams{}
 	p.p = make(map[string]interface{})
 	p.p["hostname"] = hostname
@@ -121,12 +193,65 @@ func (s *NiciraNVPService) NewAddNiciraNvpDeviceParams(hostname string, password
 	p.p["physicalnetworkid"] = physicalnetworkid
 	p.p["transportzoneuuid"] = transportzoneuuid
 	p.p["username"] = username
+	for _, fn := range opts {
+		fn(p)
+	}
+	return p
+}
+
+// AddNiciraNvpDeviceOpts contains all params that can be set on a AddNiciraNvpDeviceParams.
+// Optional params are pointers (or slices and maps) which are only set when not nil.
+type AddNiciraNvpDeviceOpts struct {
+	Hostname             string
+	L2gatewayserviceuuid *string
+	L3gatewayserviceuuid *string
+	Password             string
+	Physicalnetworkid    string
+	Transportzoneuuid    string
+	Username             string
+}
+
+// NewAddNiciraNvpDeviceParamsFromOpts is an alternative for NewAddNiciraNvpDeviceParams which takes all params using
+// an options struct, as that is easier to read for APIs with many required params
+func (s *NiciraNVPService) NewAddNiciraNvpDeviceParamsFromOpts(o AddNiciraNvpDeviceOpts) *AddNiciraNvpDeviceParams {
+	p := s.NewAddNiciraNvpDeviceParams(o.Hostname, o.Password, o.Physicalnetworkid, o.Transportzoneuuid, o.Username)
+	if o.L2gatewayserviceuuid != nil {
+		p.p["l2gatewayserviceuuid"] = *o.L2gatewayserviceuuid
+	}
+	if o.L3gatewayserviceuuid != nil {
+		p.p["l3gatewayserviceuuid"] = *o.L3gatewayserviceuuid
+	}
 	return p
 }
 
 // Adds a Nicira NVP device
-func (s *NiciraNVPService) AddNiciraNvpDevice(p *AddNiciraNvpDeviceParams) (*AddNiciraNvpDeviceResponse, error) {
-	resp, err := s.cs.newRequest("addNiciraNvpDevice", p.toURLValues())
+func (s *NiciraNVPService) AddNiciraNvpDevice(p *AddNiciraNvpDeviceParams, opts ...OptionFunc) (*AddNiciraNvpDeviceResponse, error) {
+	return s.AddNiciraNvpDeviceWithContext(context.Background(), p, opts...)
+}
+
+// AddNiciraNvpDeviceWithContext is the same as AddNiciraNvpDevice, but the request is cancelled when the context is done
+func (s *NiciraNVPService) AddNiciraNvpDeviceWithContext(ctx context.Context, p *AddNiciraNvpDeviceParams, opts ...OptionFunc) (*AddNiciraNvpDeviceResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.AddNiciraNvpDeviceRawWithContext(ctx, p.toURLValues())
+}
+
+// AddNiciraNvpDeviceRaw is the same as AddNiciraNvpDevice, but takes the params as url.Values instead of typed params
+func (s *NiciraNVPService) AddNiciraNvpDeviceRaw(v url.Values) (*AddNiciraNvpDeviceResponse, error) {
+	return s.AddNiciraNvpDeviceRawWithContext(context.Background(), v)
+}
+
+// AddNiciraNvpDeviceRawWithContext is the same as AddNiciraNvpDeviceRaw, but the request is cancelled when the context is done
+func (s *NiciraNVPService) AddNiciraNvpDeviceRawWithContext(ctx context.Context, v url.Values) (*AddNiciraNvpDeviceResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "addNiciraNvpDevice", "addniciranvpdeviceresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -138,9 +263,9 @@ func (s *NiciraNVPService) AddNiciraNvpDevice(p *AddNiciraNvpDeviceParams) (*Add
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -175,6 +300,16 @@ type DeleteNiciraNvpDeviceParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *DeleteNiciraNvpDeviceParams) Clone() *DeleteNiciraNvpDeviceParams {
+	c := &DeleteNiciraNvpDeviceParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeleteNiciraNvpDeviceParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -186,6 +321,16 @@ func (p *DeleteNiciraNvpDeviceParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *DeleteNiciraNvpDeviceParams) validate() error {
+	for _, k := range []string{"nvpdeviceid"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of deleteNiciraNvpDevice", k)
+		}
+	}
+	return nil
+}
+
 func (p *DeleteNiciraNvpDeviceParams) SetNvpdeviceid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -194,18 +339,54 @@ func (p *DeleteNiciraNvpDeviceParams) SetNvpdeviceid(v string) {
 	return
 }
 
+// SetNvpdeviceidIfUnset sets nvpdeviceid to v, unless it is already set
+func (p *DeleteNiciraNvpDeviceParams) SetNvpdeviceidIfUnset(v string) {
+	if _, found := p.p["nvpdeviceid"]; !found {
+		p.SetNvpdeviceid(v)
+	}
+}
+
 // You should always use this function to get a new DeleteNiciraNvpDeviceParams instance,
-// as then you are sure you have configured all required params
-func (s *NiciraNVPService) NewDeleteNiciraNvpDeviceParams(nvpdeviceid string) *DeleteNiciraNvpDeviceParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *NiciraNVPService) NewDeleteNiciraNvpDeviceParams(nvpdeviceid string, opts ...func(*DeleteNiciraNvpDeviceParams)) *DeleteNiciraNvpDeviceParams {
 	p := &DeleteNiciraNvpDeviceParams{}
 	p.p = make(map[string]interface{})
 	p.p["nvpdeviceid"] = nvpdeviceid
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
-//  delete a nicira nvp device
-func (s *NiciraNVPService) DeleteNiciraNvpDevice(p *DeleteNiciraNvpDeviceParams) (*DeleteNiciraNvpDeviceResponse, error) {
-	resp, err := s.cs.newRequest("deleteNiciraNvpDevice", p.toURLValues())
+// delete a nicira nvp device
+func (s *NiciraNVPService) DeleteNiciraNvpDevice(p *DeleteNiciraNvpDeviceParams, opts ...OptionFunc) (*DeleteNiciraNvpDeviceResponse, error) {
+	return s.DeleteNiciraNvpDeviceWithContext(context.Background(), p, opts...)
+}
+
+// DeleteNiciraNvpDeviceWithContext is the same as DeleteNiciraNvpDevice, but the request is cancelled when the context is done
+func (s *NiciraNVPService) DeleteNiciraNvpDeviceWithContext(ctx context.Context, p *DeleteNiciraNvpDeviceParams, opts ...OptionFunc) (*DeleteNiciraNvpDeviceResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.DeleteNiciraNvpDeviceRawWithContext(ctx, p.toURLValues())
+}
+
+// DeleteNiciraNvpDeviceRaw is the same as DeleteNiciraNvpDevice, but takes the params as url.Values instead of typed params
+func (s *NiciraNVPService) DeleteNiciraNvpDeviceRaw(v url.Values) (*DeleteNiciraNvpDeviceResponse, error) {
+	return s.DeleteNiciraNvpDeviceRawWithContext(context.Background(), v)
+}
+
+// DeleteNiciraNvpDeviceRawWithContext is the same as DeleteNiciraNvpDeviceRaw, but the request is cancelled when the context is done
+func (s *NiciraNVPService) DeleteNiciraNvpDeviceRawWithContext(ctx context.Context, v url.Values) (*DeleteNiciraNvpDeviceResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteNiciraNvpDevice", "deleteniciranvpdeviceresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -217,9 +398,9 @@ func (s *NiciraNVPService) DeleteNiciraNvpDevice(p *DeleteNiciraNvpDeviceParams)
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -243,6 +424,16 @@ type ListNiciraNvpDevicesParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ListNiciraNvpDevicesParams) Clone() *ListNiciraNvpDevicesParams {
+	c := &ListNiciraNvpDevicesParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListNiciraNvpDevicesParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -276,6 +467,13 @@ func (p *ListNiciraNvpDevicesParams) SetKeyword(v string) {
 	return
 }
 
+// SetKeywordIfUnset sets keyword to v, unless it is already set
+func (p *ListNiciraNvpDevicesParams) SetKeywordIfUnset(v string) {
+	if _, found := p.p["keyword"]; !found {
+		p.SetKeyword(v)
+	}
+}
+
 func (p *ListNiciraNvpDevicesParams) SetNvpdeviceid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -284,6 +482,13 @@ func (p *ListNiciraNvpDevicesParams) SetNvpdeviceid(v string) {
 	return
 }
 
+// SetNvpdeviceidIfUnset sets nvpdeviceid to v, unless it is already set
+func (p *ListNiciraNvpDevicesParams) SetNvpdeviceidIfUnset(v string) {
+	if _, found := p.p["nvpdeviceid"]; !found {
+		p.SetNvpdeviceid(v)
+	}
+}
+
 func (p *ListNiciraNvpDevicesParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -292,6 +497,13 @@ func (p *ListNiciraNvpDevicesParams) SetPage(v int) {
 	return
 }
 
+// SetPageIfUnset sets page to v, unless it is already set
+func (p *ListNiciraNvpDevicesParams) SetPageIfUnset(v int) {
+	if _, found := p.p["page"]; !found {
+		p.SetPage(v)
+	}
+}
+
 func (p *ListNiciraNvpDevicesParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -300,6 +512,13 @@ func (p *ListNiciraNvpDevicesParams) SetPagesize(v int) {
 	return
 }
 
+// SetPagesizeIfUnset sets pagesize to v, unless it is already set
+func (p *ListNiciraNvpDevicesParams) SetPagesizeIfUnset(v int) {
+	if _, found := p.p["pagesize"]; !found {
+		p.SetPagesize(v)
+	}
+}
+
 func (p *ListNiciraNvpDevicesParams) SetPhysicalnetworkid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -308,17 +527,43 @@ func (p *ListNiciraNvpDevicesParams) SetPhysicalnetworkid(v string) {
 	return
 }
 
+// SetPhysicalnetworkidIfUnset sets physicalnetworkid to v, unless it is already set
+func (p *ListNiciraNvpDevicesParams) SetPhysicalnetworkidIfUnset(v string) {
+	if _, found := p.p["physicalnetworkid"]; !found {
+		p.SetPhysicalnetworkid(v)
+	}
+}
+
 // You should always use this function to get a new ListNiciraNvpDevicesParams instance,
-// as then you are sure you have configured all required params
-func (s *NiciraNVPService) NewListNiciraNvpDevicesParams() *ListNiciraNvpDevicesParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *NiciraNVPService) NewListNiciraNvpDevicesParams(opts ...func(*ListNiciraNvpDevicesParams)) *ListNiciraNvpDevicesParams {
 	p := &ListNiciraNvpDevicesParams{}
 	p.p = make(map[string]interface{})
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Lists Nicira NVP devices
 func (s *NiciraNVPService) ListNiciraNvpDevices(p *ListNiciraNvpDevicesParams) (*ListNiciraNvpDevicesResponse, error) {
-	resp, err := s.cs.newRequest("listNiciraNvpDevices", p.toURLValues())
+	return s.ListNiciraNvpDevicesWithContext(context.Background(), p)
+}
+
+// ListNiciraNvpDevicesWithContext is the same as ListNiciraNvpDevices, but the request is cancelled when the context is done
+func (s *NiciraNVPService) ListNiciraNvpDevicesWithContext(ctx context.Context, p *ListNiciraNvpDevicesParams) (*ListNiciraNvpDevicesResponse, error) {
+	return s.ListNiciraNvpDevicesRawWithContext(ctx, p.toURLValues())
+}
+
+// ListNiciraNvpDevicesRaw is the same as ListNiciraNvpDevices, but takes the params as url.Values instead of typed params
+func (s *NiciraNVPService) ListNiciraNvpDevicesRaw(v url.Values) (*ListNiciraNvpDevicesResponse, error) {
+	return s.ListNiciraNvpDevicesRawWithContext(context.Background(), v)
+}
+
+// ListNiciraNvpDevicesRawWithContext is the same as ListNiciraNvpDevicesRaw, but the request is cancelled when the context is done
+func (s *NiciraNVPService) ListNiciraNvpDevicesRawWithContext(ctx context.Context, v url.Values) (*ListNiciraNvpDevicesResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "listNiciraNvpDevices", "listniciranvpdevicesresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -331,6 +576,93 @@ func (s *NiciraNVPService) ListNiciraNvpDevices(p *ListNiciraNvpDevicesParams) (
 	return &r, nil
 }
 
+// StreamNiciraNvpDevices fetches the results of ListNiciraNvpDevices page by page in the background and sends them on the
+// returned channel. Both returned channels are closed when done. If fetching a page fails, or the context
+// is done before all results are sent, the error is sent on the error channel and fetching stops. A
+// request for a page in flight is cancelled when the context is done. The page and pagesize set in the
+// params are ignored.
+func (s *NiciraNVPService) StreamNiciraNvpDevices(ctx context.Context, p *ListNiciraNvpDevicesParams) (<-chan *NiciraNvpDevice, <-chan error) {
+	ch := make(chan *NiciraNvpDevice)
+	errs := make(chan error, 1)
+
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesize(500)
+
+	go func() {
+		defer close(errs)
+		defer close(ch)
+
+		for page, count := 1, 0; ; page++ {
+			pp.SetPage(page)
+
+			l, err := s.ListNiciraNvpDevicesWithContext(ctx, pp)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			for _, v := range l.NiciraNvpDevices {
+				select {
+				case ch <- v:
+				case <-ctx.Done():
+					errs <- ctx.Err()
+					return
+				}
+			}
+
+			count += len(l.NiciraNvpDevices)
+			if len(l.NiciraNvpDevices) < 500 || count >= l.Count {
+				return
+			}
+		}
+	}()
+
+	return ch, errs
+}
+
+// ListNiciraNvpDevicesWhere returns all results of ListNiciraNvpDevices for which pred returns true. Note that the results are
+// filtered client-side, so all results matching the params are fetched first.
+// All pages are fetched, so the page and pagesize set in the params are ignored.
+func (s *NiciraNVPService) ListNiciraNvpDevicesWhere(p *ListNiciraNvpDevicesParams, pred func(*NiciraNvpDevice) bool) ([]*NiciraNvpDevice, error) {
+	var r []*NiciraNvpDevice
+	ch, errs := s.StreamNiciraNvpDevices(context.Background(), p)
+	for v := range ch {
+		if pred(v) {
+			r = append(r, v)
+		}
+	}
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ListAllNiciraNvpDevices returns the results of ListNiciraNvpDevices across all pages, by calling ListNiciraNvpDevices page by page until all
+// results are fetched. The pagesize set in the params is used for every page (500 if not set), and the
+// page set in the params is ignored.
+func (s *NiciraNVPService) ListAllNiciraNvpDevices(p *ListNiciraNvpDevicesParams) ([]*NiciraNvpDevice, error) {
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesizeIfUnset(500)
+	pagesize := pp.p["pagesize"].(int)
+
+	var r []*NiciraNvpDevice
+	for page := 1; ; page++ {
+		pp.SetPage(page)
+
+		l, err := s.ListNiciraNvpDevices(pp)
+		if err != nil {
+			return nil, err
+		}
+		r = append(r, l.NiciraNvpDevices...)
+
+		if len(l.NiciraNvpDevices) < pagesize || len(r) >= l.Count {
+			return r, nil
+		}
+	}
+}
+
 type ListNiciraNvpDevicesResponse struct {
 	Count            int                `json:"count"`
 	NiciraNvpDevices []*NiciraNvpDevice `json:"niciranvpdevice"`
@@ -17,7 +17,9 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
 	"net/url"
 	"strconv"
 )
@@ -26,6 +28,16 @@ type AddNuageVspDeviceParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *AddNuageVspDeviceParams) Clone() *AddNuageVspDeviceParams {
+	c := &AddNuageVspDeviceParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *AddNuageVspDeviceParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -61,6 +73,16 @@ func (p *AddNuageVspDeviceParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *AddNuageVspDeviceParams) validate() error {
+	for _, k := range []string{"hostname", "password", "physicalnetworkid", "port", "username"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of addNuageVspDevice", k)
+		}
+	}
+	return nil
+}
+
 func (p *AddNuageVspDeviceParams) SetApiversion(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -69,6 +91,13 @@ func (p *AddNuageVspDeviceParams) SetApiversion(v string) {
 	return
 }
 
+// SetApiversionIfUnset sets apiversion to v, unless it is already set
+func (p *AddNuageVspDeviceParams) SetApiversionIfUnset(v string) {
+	if _, found := p.p["apiversion"]; !found {
+		p.SetApiversion(v)
+	}
+}
+
 func (p *AddNuageVspDeviceParams) SetHostname(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -77,6 +106,13 @@ func (p *AddNuageVspDeviceParams) SetHostname(v string) {
 	return
 }
 
+// SetHostnameIfUnset sets hostname to v, unless it is already set
+func (p *AddNuageVspDeviceParams) SetHostnameIfUnset(v string) {
+	if _, found := p.p["hostname"]; !found {
+		p.SetHostname(v)
+	}
+}
+
 func (p *AddNuageVspDeviceParams) SetPassword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -85,6 +121,13 @@ func (p *AddNuageVspDeviceParams) SetPassword(v string) {
 	return
 }
 
+// SetPasswordIfUnset sets password to v, unless it is already set
+func (p *AddNuageVspDeviceParams) SetPasswordIfUnset(v string) {
+	if _, found := p.p["password"]; !found {
+		p.SetPassword(v)
+	}
+}
+
 func (p *AddNuageVspDeviceParams) SetPhysicalnetworkid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -93,6 +136,13 @@ func (p *AddNuageVspDeviceParams) SetPhysicalnetworkid(v string) {
 	return
 }
 
+// SetPhysicalnetworkidIfUnset sets physicalnetworkid to v, unless it is already set
+func (p *AddNuageVspDeviceParams) SetPhysicalnetworkidIfUnset(v string) {
+	if _, found := p.p["physicalnetworkid"]; !found {
+		p.SetPhysicalnetworkid(v)
+	}
+}
+
 func (p *AddNuageVspDeviceParams) SetPort(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -101,6 +151,13 @@ func (p *AddNuageVspDeviceParams) SetPort(v int) {
 	return
 }
 
+// SetPortIfUnset sets port to v, unless it is already set
+func (p *AddNuageVspDeviceParams) SetPortIfUnset(v int) {
+	if _, found := p.p["port"]; !found {
+		p.SetPort(v)
+	}
+}
+
 func (p *AddNuageVspDeviceParams) SetRetrycount(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -109,6 +166,13 @@ func (p *AddNuageVspDeviceParams) SetRetrycount(v int) {
 	return
 }
 
+// SetRetrycountIfUnset sets retrycount to v, unless it is already set
+func (p *AddNuageVspDeviceParams) SetRetrycountIfUnset(v int) {
+	if _, found := p.p["retrycount"]; !found {
+		p.SetRetrycount(v)
+	}
+}
+
 func (p *AddNuageVspDeviceParams) SetRetryinterval(v int64) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -117,6 +181,13 @@ func (p *AddNuageVspDeviceParams) SetRetryinterval(v int64) {
 	return
 }
 
+// SetRetryintervalIfUnset sets retryinterval to v, unless it is already set
+func (p *AddNuageVspDeviceParams) SetRetryintervalIfUnset(v int64) {
+	if _, found := p.p["retryinterval"]; !found {
+		p.SetRetryinterval(v)
+	}
+}
+
 func (p *AddNuageVspDeviceParams) SetUsername(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -125,9 +196,17 @@ func (p *AddNuageVspDeviceParams) SetUsername(v string) {
 	return
 }
 
+// SetUsernameIfUnset sets username to v, unless it is already set
+func (p *AddNuageVspDeviceParams) SetUsernameIfUnset(v string) {
+	if _, found := p.p["username"]; !found {
+		p.SetUsername(v)
+	}
+}
+
 // You should always use this function to get a new AddNuageVspDeviceParams instance,
-// as then you are sure you have configured all required params
-func (s *NuageVSPService) NewAddNuageVspDeviceParams(hostname string, password string, physicalnetworkid string, port int, username string) *AddNuageVspDeviceParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *NuageVSPService) NewAddNuageVspDeviceParams(hostname string, password string, physicalnetworkid string, port int, username string, opts ...func(*AddNuageVspDeviceParams)) *AddNuageVspDeviceParams {
 	p := &AddNuageVspDeviceParams{}
 	p.p = make(map[string]interface{})
 	p.p["hostname"] = hostname
@@ -135,12 +214,69 @@ func (s *NuageVSPService) NewAddNuageVspDeviceParams(hostname string, password s
 	p.p["physicalnetworkid"] = physicalnetworkid
 	p.p["port"] = port
 	p.p["username"] = username
+	for _, fn := range opts {
+		fn(p)
+	}
+	return p
+}
+
+// AddNuageVspDeviceOpts contains all params that can be set on a AddNuageVspDeviceParams.
+// Optional params are pointers (or slices and maps) which are only set when not nil.
+type AddNuageVspDeviceOpts struct {
+	Apiversion        *string
+	Hostname          string
+	Password          string
+	Physicalnetworkid string
+	Port              int
+	Retrycount        *int
+	Retryinterval     *int64
+	Username          string
+}
+
+// NewAddNuageVspDeviceParamsFromOpts is an alternative for NewAddNuageVspDeviceParams which takes all params using
+// an options struct, as that is easier to read for APIs with many required params
+func (s *NuageVSPService) NewAddNuageVspDeviceParamsFromOpts(o AddNuageVspDeviceOpts) *AddNuageVspDeviceParams {
+	p := s.NewAddNuageVspDeviceParams(o.Hostname, o.Password, o.Physicalnetworkid, o.Port, o.Username)
+	if o.Apiversion != nil {
+		p.p["apiversion"] = *o.Apiversion
+	}
+	if o.Retrycount != nil {
+		p.p["retrycount"] = *o.Retrycount
+	}
+	if o.Retryinterval != nil {
+		p.p["retryinterval"] = *o.Retryinterval
+	}
 	return p
 }
 
 // Adds a Nuage VSP device
-func (s *NuageVSPService) AddNuageVspDevice(p *AddNuageVspDeviceParams) (*AddNuageVspDeviceResponse, error) {
-	resp, err := s.cs.newRequest("addNuageVspDevice", p.toURLValues())
+func (s *NuageVSPService) AddNuageVspDevice(p *AddNuageVspDeviceParams, opts ...OptionFunc) (*AddNuageVspDeviceResponse, error) {
+	return s.AddNuageVspDeviceWithContext(context.Background(), p, opts...)
+}
+
+// AddNuageVspDeviceWithContext is the same as AddNuageVspDevice, but the request is cancelled when the context is done
+func (s *NuageVSPService) AddNuageVspDeviceWithContext(ctx context.Context, p *AddNuageVspDeviceParams, opts ...OptionFunc) (*AddNuageVspDeviceResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.AddNuageVspDeviceRawWithContext(ctx, p.toURLValues())
+}
+
+// AddNuageVspDeviceRaw is the same as AddNuageVspDevice, but takes the params as url.Values instead of typed params
+func (s *NuageVSPService) AddNuageVspDeviceRaw(v url.Values) (*AddNuageVspDeviceResponse, error) {
+	return s.AddNuageVspDeviceRawWithContext(context.Background(), v)
+}
+
+// AddNuageVspDeviceRawWithContext is the same as AddNuageVspDeviceRaw, but the request is cancelled when the context is done
+func (s *NuageVSPService) AddNuageVspDeviceRawWithContext(ctx context.Context, v url.Values) (*AddNuageVspDeviceResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "addNuageVspDevice", "addnuagevspdeviceresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -152,9 +288,9 @@ func (s *NuageVSPService) AddNuageVspDevice(p *AddNuageVspDeviceParams) (*AddNua
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -191,6 +327,16 @@ type DeleteNuageVspDeviceParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *DeleteNuageVspDeviceParams) Clone() *DeleteNuageVspDeviceParams {
+	c := &DeleteNuageVspDeviceParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeleteNuageVspDeviceParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -202,6 +348,16 @@ func (p *DeleteNuageVspDeviceParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *DeleteNuageVspDeviceParams) validate() error {
+	for _, k := range []string{"vspdeviceid"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of deleteNuageVspDevice", k)
+		}
+	}
+	return nil
+}
+
 func (p *DeleteNuageVspDeviceParams) SetVspdeviceid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -210,18 +366,54 @@ func (p *DeleteNuageVspDeviceParams) SetVspdeviceid(v string) {
 	return
 }
 
+// SetVspdeviceidIfUnset sets vspdeviceid to v, unless it is already set
+func (p *DeleteNuageVspDeviceParams) SetVspdeviceidIfUnset(v string) {
+	if _, found := p.p["vspdeviceid"]; !found {
+		p.SetVspdeviceid(v)
+	}
+}
+
 // You should always use this function to get a new DeleteNuageVspDeviceParams instance,
-// as then you are sure you have configured all required params
-func (s *NuageVSPService) NewDeleteNuageVspDeviceParams(vspdeviceid string) *DeleteNuageVspDeviceParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *NuageVSPService) NewDeleteNuageVspDeviceParams(vspdeviceid string, opts ...func(*DeleteNuageVspDeviceParams)) *DeleteNuageVspDeviceParams {
 	p := &DeleteNuageVspDeviceParams{}
 	p.p = make(map[string]interface{})
 	p.p["vspdeviceid"] = vspdeviceid
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // delete a nuage vsp device
-func (s *NuageVSPService) DeleteNuageVspDevice(p *DeleteNuageVspDeviceParams) (*DeleteNuageVspDeviceResponse, error) {
-	resp, err := s.cs.newRequest("deleteNuageVspDevice", p.toURLValues())
+func (s *NuageVSPService) DeleteNuageVspDevice(p *DeleteNuageVspDeviceParams, opts ...OptionFunc) (*DeleteNuageVspDeviceResponse, error) {
+	return s.DeleteNuageVspDeviceWithContext(context.Background(), p, opts...)
+}
+
+// DeleteNuageVspDeviceWithContext is the same as DeleteNuageVspDevice, but the request is cancelled when the context is done
+func (s *NuageVSPService) DeleteNuageVspDeviceWithContext(ctx context.Context, p *DeleteNuageVspDeviceParams, opts ...OptionFunc) (*DeleteNuageVspDeviceResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.DeleteNuageVspDeviceRawWithContext(ctx, p.toURLValues())
+}
+
+// DeleteNuageVspDeviceRaw is the same as DeleteNuageVspDevice, but takes the params as url.Values instead of typed params
+func (s *NuageVSPService) DeleteNuageVspDeviceRaw(v url.Values) (*DeleteNuageVspDeviceResponse, error) {
+	return s.DeleteNuageVspDeviceRawWithContext(context.Background(), v)
+}
+
+// DeleteNuageVspDeviceRawWithContext is the same as DeleteNuageVspDeviceRaw, but the request is cancelled when the context is done
+func (s *NuageVSPService) DeleteNuageVspDeviceRawWithContext(ctx context.Context, v url.Values) (*DeleteNuageVspDeviceResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteNuageVspDevice", "deletenuagevspdeviceresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -233,9 +425,9 @@ func (s *NuageVSPService) DeleteNuageVspDevice(p *DeleteNuageVspDeviceParams) (*
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -259,6 +451,16 @@ type ListNuageVspDevicesParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ListNuageVspDevicesParams) Clone() *ListNuageVspDevicesParams {
+	c := &ListNuageVspDevicesParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListNuageVspDevicesParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -292,6 +494,13 @@ func (p *ListNuageVspDevicesParams) SetKeyword(v string) {
 	return
 }
 
+// SetKeywordIfUnset sets keyword to v, unless it is already set
+func (p *ListNuageVspDevicesParams) SetKeywordIfUnset(v string) {
+	if _, found := p.p["keyword"]; !found {
+		p.SetKeyword(v)
+	}
+}
+
 func (p *ListNuageVspDevicesParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -300,6 +509,13 @@ func (p *ListNuageVspDevicesParams) SetPage(v int) {
 	return
 }
 
+// SetPageIfUnset sets page to v, unless it is already set
+func (p *ListNuageVspDevicesParams) SetPageIfUnset(v int) {
+	if _, found := p.p["page"]; !found {
+		p.SetPage(v)
+	}
+}
+
 func (p *ListNuageVspDevicesParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -308,6 +524,13 @@ func (p *ListNuageVspDevicesParams) SetPagesize(v int) {
 	return
 }
 
+// SetPagesizeIfUnset sets pagesize to v, unless it is already set
+func (p *ListNuageVspDevicesParams) SetPagesizeIfUnset(v int) {
+	if _, found := p.p["pagesize"]; !found {
+		p.SetPagesize(v)
+	}
+}
+
 func (p *ListNuageVspDevicesParams) SetPhysicalnetworkid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -316,6 +539,13 @@ func (p *ListNuageVspDevicesParams) SetPhysicalnetworkid(v string) {
 	return
 }
 
+// SetPhysicalnetworkidIfUnset sets physicalnetworkid to v, unless it is already set
+func (p *ListNuageVspDevicesParams) SetPhysicalnetworkidIfUnset(v string) {
+	if _, found := p.p["physicalnetworkid"]; !found {
+		p.SetPhysicalnetworkid(v)
+	}
+}
+
 func (p *ListNuageVspDevicesParams) SetVspdeviceid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -324,17 +554,43 @@ func (p *ListNuageVspDevicesParams) SetVspdeviceid(v string) {
 	return
 }
 
+// SetVspdeviceidIfUnset sets vspdeviceid to v, unless it is already set
+func (p *ListNuageVspDevicesParams) SetVspdeviceidIfUnset(v string) {
+	if _, found := p.p["vspdeviceid"]; !found {
+		p.SetVspdeviceid(v)
+	}
+}
+
 // You should always use this function to get a new ListNuageVspDevicesParams instance,
-// as then you are sure you have configured all required params
-func (s *NuageVSPService) NewListNuageVspDevicesParams() *ListNuageVspDevicesParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *NuageVSPService) NewListNuageVspDevicesParams(opts ...func(*ListNuageVspDevicesParams)) *ListNuageVspDevicesParams {
 	p := &ListNuageVspDevicesParams{}
 	p.p = make(map[string]interface{})
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Lists Nuage VSP devices
 func (s *NuageVSPService) ListNuageVspDevices(p *ListNuageVspDevicesParams) (*ListNuageVspDevicesResponse, error) {
-	resp, err := s.cs.newRequest("listNuageVspDevices", p.toURLValues())
+	return s.ListNuageVspDevicesWithContext(context.Background(), p)
+}
+
+// ListNuageVspDevicesWithContext is the same as ListNuageVspDevices, but the request is cancelled when the context is done
+func (s *NuageVSPService) ListNuageVspDevicesWithContext(ctx context.Context, p *ListNuageVspDevicesParams) (*ListNuageVspDevicesResponse, error) {
+	return s.ListNuageVspDevicesRawWithContext(ctx, p.toURLValues())
+}
+
+// ListNuageVspDevicesRaw is the same as ListNuageVspDevices, but takes the params as url.Values instead of typed params
+func (s *NuageVSPService) ListNuageVspDevicesRaw(v url.Values) (*ListNuageVspDevicesResponse, error) {
+	return s.ListNuageVspDevicesRawWithContext(context.Background(), v)
+}
+
+// ListNuageVspDevicesRawWithContext is the same as ListNuageVspDevicesRaw, but the request is cancelled when the context is done
+func (s *NuageVSPService) ListNuageVspDevicesRawWithContext(ctx context.Context, v url.Values) (*ListNuageVspDevicesResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "listNuageVspDevices", "listnuagevspdevicesresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -347,6 +603,93 @@ func (s *NuageVSPService) ListNuageVspDevices(p *ListNuageVspDevicesParams) (*Li
 	return &r, nil
 }
 
+// StreamNuageVspDevices fetches the results of ListNuageVspDevices page by page in the background and sends them on the
+// returned channel. Both returned channels are closed when done. If fetching a page fails, or the context
+// is done before all results are sent, the error is sent on the error channel and fetching stops. A
+// request for a page in flight is cancelled when the context is done. The page and pagesize set in the
+// params are ignored.
+func (s *NuageVSPService) StreamNuageVspDevices(ctx context.Context, p *ListNuageVspDevicesParams) (<-chan *NuageVspDevice, <-chan error) {
+	ch := make(chan *NuageVspDevice)
+	errs := make(chan error, 1)
+
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesize(500)
+
+	go func() {
+		defer close(errs)
+		defer close(ch)
+
+		for page, count := 1, 0; ; page++ {
+			pp.SetPage(page)
+
+			l, err := s.ListNuageVspDevicesWithContext(ctx, pp)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			for _, v := range l.NuageVspDevices {
+				select {
+				case ch <- v:
+				case <-ctx.Done():
+					errs <- ctx.Err()
+					return
+				}
+			}
+
+			count += len(l.NuageVspDevices)
+			if len(l.NuageVspDevices) < 500 || count >= l.Count {
+				return
+			}
+		}
+	}()
+
+	return ch, errs
+}
+
+// ListNuageVspDevicesWhere returns all results of ListNuageVspDevices for which pred returns true. Note that the results are
+// filtered client-side, so all results matching the params are fetched first.
+// All pages are fetched, so the page and pagesize set in the params are ignored.
+func (s *NuageVSPService) ListNuageVspDevicesWhere(p *ListNuageVspDevicesParams, pred func(*NuageVspDevice) bool) ([]*NuageVspDevice, error) {
+	var r []*NuageVspDevice
+	ch, errs := s.StreamNuageVspDevices(context.Background(), p)
+	for v := range ch {
+		if pred(v) {
+			r = append(r, v)
+		}
+	}
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ListAllNuageVspDevices returns the results of ListNuageVspDevices across all pages, by calling ListNuageVspDevices page by page until all
+// results are fetched. The pagesize set in the params is used for every page (500 if not set), and the
+// page set in the params is ignored.
+func (s *NuageVSPService) ListAllNuageVspDevices(p *ListNuageVspDevicesParams) ([]*NuageVspDevice, error) {
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesizeIfUnset(500)
+	pagesize := pp.p["pagesize"].(int)
+
+	var r []*NuageVspDevice
+	for page := 1; ; page++ {
+		pp.SetPage(page)
+
+		l, err := s.ListNuageVspDevices(pp)
+		if err != nil {
+			return nil, err
+		}
+		r = append(r, l.NuageVspDevices...)
+
+		if len(l.NuageVspDevices) < pagesize || len(r) >= l.Count {
+			return r, nil
+		}
+	}
+}
+
 type ListNuageVspDevicesResponse struct {
 	Count           int               `json:"count"`
 	NuageVspDevices []*NuageVspDevice `json:"nuagevspdevice"`
@@ -369,6 +712,16 @@ type UpdateNuageVspDeviceParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *UpdateNuageVspDeviceParams) Clone() *UpdateNuageVspDeviceParams {
+	c := &UpdateNuageVspDeviceParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *UpdateNuageVspDeviceParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -404,6 +757,16 @@ func (p *UpdateNuageVspDeviceParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *UpdateNuageVspDeviceParams) validate() error {
+	for _, k := range []string{"physicalnetworkid"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of updateNuageVspDevice", k)
+		}
+	}
+	return nil
+}
+
 func (p *UpdateNuageVspDeviceParams) SetApiversion(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -412,6 +775,13 @@ func (p *UpdateNuageVspDeviceParams) SetApiversion(v string) {
 	return
 }
 
+// SetApiversionIfUnset sets apiversion to v, unless it is already set
+func (p *UpdateNuageVspDeviceParams) SetApiversionIfUnset(v string) {
+	if _, found := p.p["apiversion"]; !found {
+		p.SetApiversion(v)
+	}
+}
+
 func (p *UpdateNuageVspDeviceParams) SetHostname(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -420,6 +790,13 @@ func (p *UpdateNuageVspDeviceParams) SetHostname(v string) {
 	return
 }
 
+// SetHostnameIfUnset sets hostname to v, unless it is already set
+func (p *UpdateNuageVspDeviceParams) SetHostnameIfUnset(v string) {
+	if _, found := p.p["hostname"]; !found {
+		p.SetHostname(v)
+	}
+}
+
 func (p *UpdateNuageVspDeviceParams) SetPassword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -428,6 +805,13 @@ func (p *UpdateNuageVspDeviceParams) SetPassword(v string) {
 	return
 }
 
+// SetPasswordIfUnset sets password to v, unless it is already set
+func (p *UpdateNuageVspDeviceParams) SetPasswordIfUnset(v string) {
+	if _, found := p.p["password"]; !found {
+		p.SetPassword(v)
+	}
+}
+
 func (p *UpdateNuageVspDeviceParams) SetPhysicalnetworkid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -436,6 +820,13 @@ func (p *UpdateNuageVspDeviceParams) SetPhysicalnetworkid(v string) {
 	return
 }
 
+// SetPhysicalnetworkidIfUnset sets physicalnetworkid to v, unless it is already set
+func (p *UpdateNuageVspDeviceParams) SetPhysicalnetworkidIfUnset(v string) {
+	if _, found := p.p["physicalnetworkid"]; !found {
+		p.SetPhysicalnetworkid(v)
+	}
+}
+
 func (p *UpdateNuageVspDeviceParams) SetPort(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -444,6 +835,13 @@ func (p *UpdateNuageVspDeviceParams) SetPort(v int) {
 	return
 }
 
+// SetPortIfUnset sets port to v, unless it is already set
+func (p *UpdateNuageVspDeviceParams) SetPortIfUnset(v int) {
+	if _, found := p.p["port"]; !found {
+		p.SetPort(v)
+	}
+}
+
 func (p *UpdateNuageVspDeviceParams) SetRetrycount(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -452,6 +850,13 @@ func (p *UpdateNuageVspDeviceParams) SetRetrycount(v int) {
 	return
 }
 
+// SetRetrycountIfUnset sets retrycount to v, unless it is already set
+func (p *UpdateNuageVspDeviceParams) SetRetrycountIfUnset(v int) {
+	if _, found := p.p["retrycount"]; !found {
+		p.SetRetrycount(v)
+	}
+}
+
 func (p *UpdateNuageVspDeviceParams) SetRetryinterval(v int64) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -460,6 +865,13 @@ func (p *UpdateNuageVspDeviceParams) SetRetryinterval(v int64) {
 	return
 }
 
+// SetRetryintervalIfUnset sets retryinterval to v, unless it is already set
+func (p *UpdateNuageVspDeviceParams) SetRetryintervalIfUnset(v int64) {
+	if _, found := p.p["retryinterval"]; !found {
+		p.SetRetryinterval(v)
+	}
+}
+
 func (p *UpdateNuageVspDeviceParams) SetUsername(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -468,18 +880,54 @@ func (p *UpdateNuageVspDeviceParams) SetUsername(v string) {
 	return
 }
 
+// SetUsernameIfUnset sets username to v, unless it is already set
+func (p *UpdateNuageVspDeviceParams) SetUsernameIfUnset(v string) {
+	if _, found := p.p["username"]; !found {
+		p.SetUsername(v)
+	}
+}
+
 // You should always use this function to get a new UpdateNuageVspDeviceParams instance,
-// as then you are sure you have configured all required params
-func (s *NuageVSPService) NewUpdateNuageVspDeviceParams(physicalnetworkid string) *UpdateNuageVspDeviceParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *NuageVSPService) NewUpdateNuageVspDeviceParams(physicalnetworkid string, opts ...func(*UpdateNuageVspDeviceParams)) *UpdateNuageVspDeviceParams {
 	p := &UpdateNuageVspDeviceParams{}
 	p.p = make(map[string]interface{})
 	p.p["physicalnetworkid"] = physicalnetworkid
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Update a Nuage VSP device
-func (s *NuageVSPService) UpdateNuageVspDevice(p *UpdateNuageVspDeviceParams) (*UpdateNuageVspDeviceResponse, error) {
-	resp, err := s.cs.newRequest("updateNuageVspDevice", p.toURLValues())
+func (s *NuageVSPService) UpdateNuageVspDevice(p *UpdateNuageVspDeviceParams, opts ...OptionFunc) (*UpdateNuageVspDeviceResponse, error) {
+	return s.UpdateNuageVspDeviceWithContext(context.Background(), p, opts...)
+}
+
+// UpdateNuageVspDeviceWithContext is the same as UpdateNuageVspDevice, but the request is cancelled when the context is done
+func (s *NuageVSPService) UpdateNuageVspDeviceWithContext(ctx context.Context, p *UpdateNuageVspDeviceParams, opts ...OptionFunc) (*UpdateNuageVspDeviceResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.UpdateNuageVspDeviceRawWithContext(ctx, p.toURLValues())
+}
+
+// UpdateNuageVspDeviceRaw is the same as UpdateNuageVspDevice, but takes the params as url.Values instead of typed params
+func (s *NuageVSPService) UpdateNuageVspDeviceRaw(v url.Values) (*UpdateNuageVspDeviceResponse, error) {
+	return s.UpdateNuageVspDeviceRawWithContext(context.Background(), v)
+}
+
+// UpdateNuageVspDeviceRawWithContext is the same as UpdateNuageVspDeviceRaw, but the request is cancelled when the context is done
+func (s *NuageVSPService) UpdateNuageVspDeviceRawWithContext(ctx context.Context, v url.Values) (*UpdateNuageVspDeviceResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "updateNuageVspDevice", "updatenuagevspdeviceresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -491,9 +939,9 @@ func (s *NuageVSPService) UpdateNuageVspDevice(p *UpdateNuageVspDeviceParams) (*
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
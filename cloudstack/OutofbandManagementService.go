@@ -17,7 +17,9 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
 	"net/url"
 	"strconv"
 )
@@ -26,6 +28,16 @@ type ChangeOutOfBandManagementPasswordParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ChangeOutOfBandManagementPasswordParams) Clone() *ChangeOutOfBandManagementPasswordParams {
+	c := &ChangeOutOfBandManagementPasswordParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ChangeOutOfBandManagementPasswordParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -40,6 +52,16 @@ func (p *ChangeOutOfBandManagementPasswordParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *ChangeOutOfBandManagementPasswordParams) validate() error {
+	for _, k := range []string{"hostid"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of changeOutOfBandManagementPassword", k)
+		}
+	}
+	return nil
+}
+
 func (p *ChangeOutOfBandManagementPasswordParams) SetHostid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -48,6 +70,13 @@ func (p *ChangeOutOfBandManagementPasswordParams) SetHostid(v string) {
 	return
 }
 
+// SetHostidIfUnset sets hostid to v, unless it is already set
+func (p *ChangeOutOfBandManagementPasswordParams) SetHostidIfUnset(v string) {
+	if _, found := p.p["hostid"]; !found {
+		p.SetHostid(v)
+	}
+}
+
 func (p *ChangeOutOfBandManagementPasswordParams) SetPassword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -56,18 +85,54 @@ func (p *ChangeOutOfBandManagementPasswordParams) SetPassword(v string) {
 	return
 }
 
+// SetPasswordIfUnset sets password to v, unless it is already set
+func (p *ChangeOutOfBandManagementPasswordParams) SetPasswordIfUnset(v string) {
+	if _, found := p.p["password"]; !found {
+		p.SetPassword(v)
+	}
+}
+
 // You should always use this function to get a new ChangeOutOfBandManagementPasswordParams instance,
-// as then you are sure you have configured all required params
-func (s *OutofbandManagementService) NewChangeOutOfBandManagementPasswordParams(hostid string) *ChangeOutOfBandManagementPasswordParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *OutofbandManagementService) NewChangeOutOfBandManagementPasswordParams(hostid string, opts ...func(*ChangeOutOfBandManagementPasswordParams)) *ChangeOutOfBandManagementPasswordParams {
 	p := &ChangeOutOfBandManagementPasswordParams{}
 	p.p = make(map[string]interface{})
 	p.p["hostid"] = hostid
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Changes out-of-band management interface password on the host and updates the interface configuration in CloudStack if the operation succeeds, else reverts the old password
-func (s *OutofbandManagementService) ChangeOutOfBandManagementPassword(p *ChangeOutOfBandManagementPasswordParams) (*ChangeOutOfBandManagementPasswordResponse, error) {
-	resp, err := s.cs.newRequest("changeOutOfBandManagementPassword", p.toURLValues())
+func (s *OutofbandManagementService) ChangeOutOfBandManagementPassword(p *ChangeOutOfBandManagementPasswordParams, opts ...OptionFunc) (*ChangeOutOfBandManagementPasswordResponse, error) {
+	return s.ChangeOutOfBandManagementPasswordWithContext(context.Background(), p, opts...)
+}
+
+// ChangeOutOfBandManagementPasswordWithContext is the same as ChangeOutOfBandManagementPassword, but the request is cancelled when the context is done
+func (s *OutofbandManagementService) ChangeOutOfBandManagementPasswordWithContext(ctx context.Context, p *ChangeOutOfBandManagementPasswordParams, opts ...OptionFunc) (*ChangeOutOfBandManagementPasswordResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.ChangeOutOfBandManagementPasswordRawWithContext(ctx, p.toURLValues())
+}
+
+// ChangeOutOfBandManagementPasswordRaw is the same as ChangeOutOfBandManagementPassword, but takes the params as url.Values instead of typed params
+func (s *OutofbandManagementService) ChangeOutOfBandManagementPasswordRaw(v url.Values) (*ChangeOutOfBandManagementPasswordResponse, error) {
+	return s.ChangeOutOfBandManagementPasswordRawWithContext(context.Background(), v)
+}
+
+// ChangeOutOfBandManagementPasswordRawWithContext is the same as ChangeOutOfBandManagementPasswordRaw, but the request is cancelled when the context is done
+func (s *OutofbandManagementService) ChangeOutOfBandManagementPasswordRawWithContext(ctx context.Context, v url.Values) (*ChangeOutOfBandManagementPasswordResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "changeOutOfBandManagementPassword", "changeoutofbandmanagementpasswordresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -79,9 +144,9 @@ func (s *OutofbandManagementService) ChangeOutOfBandManagementPassword(p *Change
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -119,6 +184,16 @@ type ConfigureOutOfBandManagementParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ConfigureOutOfBandManagementParams) Clone() *ConfigureOutOfBandManagementParams {
+	c := &ConfigureOutOfBandManagementParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ConfigureOutOfBandManagementParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -145,6 +220,16 @@ func (p *ConfigureOutOfBandManagementParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *ConfigureOutOfBandManagementParams) validate() error {
+	for _, k := range []string{"address", "driver", "hostid", "password", "port", "username"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of configureOutOfBandManagement", k)
+		}
+	}
+	return nil
+}
+
 func (p *ConfigureOutOfBandManagementParams) SetAddress(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -153,6 +238,13 @@ func (p *ConfigureOutOfBandManagementParams) SetAddress(v string) {
 	return
 }
 
+// SetAddressIfUnset sets address to v, unless it is already set
+func (p *ConfigureOutOfBandManagementParams) SetAddressIfUnset(v string) {
+	if _, found := p.p["address"]; !found {
+		p.SetAddress(v)
+	}
+}
+
 func (p *ConfigureOutOfBandManagementParams) SetDriver(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -161,6 +253,13 @@ func (p *ConfigureOutOfBandManagementParams) SetDriver(v string) {
 	return
 }
 
+// SetDriverIfUnset sets driver to v, unless it is already set
+func (p *ConfigureOutOfBandManagementParams) SetDriverIfUnset(v string) {
+	if _, found := p.p["driver"]; !found {
+		p.SetDriver(v)
+	}
+}
+
 func (p *ConfigureOutOfBandManagementParams) SetHostid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -169,6 +268,13 @@ func (p *ConfigureOutOfBandManagementParams) SetHostid(v string) {
 	return
 }
 
+// SetHostidIfUnset sets hostid to v, unless it is already set
+func (p *ConfigureOutOfBandManagementParams) SetHostidIfUnset(v string) {
+	if _, found := p.p["hostid"]; !found {
+		p.SetHostid(v)
+	}
+}
+
 func (p *ConfigureOutOfBandManagementParams) SetPassword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -177,6 +283,13 @@ func (p *ConfigureOutOfBandManagementParams) SetPassword(v string) {
 	return
 }
 
+// SetPasswordIfUnset sets password to v, unless it is already set
+func (p *ConfigureOutOfBandManagementParams) SetPasswordIfUnset(v string) {
+	if _, found := p.p["password"]; !found {
+		p.SetPassword(v)
+	}
+}
+
 func (p *ConfigureOutOfBandManagementParams) SetPort(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -185,6 +298,13 @@ func (p *ConfigureOutOfBandManagementParams) SetPort(v string) {
 	return
 }
 
+// SetPortIfUnset sets port to v, unless it is already set
+func (p *ConfigureOutOfBandManagementParams) SetPortIfUnset(v string) {
+	if _, found := p.p["port"]; !found {
+		p.SetPort(v)
+	}
+}
+
 func (p *ConfigureOutOfBandManagementParams) SetUsername(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -193,9 +313,17 @@ func (p *ConfigureOutOfBandManagementParams) SetUsername(v string) {
 	return
 }
 
+// SetUsernameIfUnset sets username to v, unless it is already set
+func (p *ConfigureOutOfBandManagementParams) SetUsernameIfUnset(v string) {
+	if _, found := p.p["username"]; !found {
+		p.SetUsername(v)
+	}
+}
+
 // You should always use this function to get a new ConfigureOutOfBandManagementParams instance,
-// as then you are sure you have configured all required params
-func (s *OutofbandManagementService) NewConfigureOutOfBandManagementParams(address string, driver string, hostid string, password string, port string, username string) *ConfigureOutOfBandManagementParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *OutofbandManagementService) NewConfigureOutOfBandManagementParams(address string, driver string, hostid string, password string, port string, username string, opts ...func(*ConfigureOutOfBandManagementParams)) *ConfigureOutOfBandManagementParams {
 	p := &ConfigureOutOfBandManagementParams{}
 	p.p = make(map[string]interface{})
 	p.p["address"] = address
@@ -204,12 +332,58 @@ func (s *OutofbandManagementService) NewConfigureOutOfBandManagementParams(addre
 	p.p["password"] = password
 	p.p["port"] = port
 	p.p["username"] = username
+	for _, fn := range opts {
+		fn(p)
+	}
+	return p
+}
+
+// ConfigureOutOfBandManagementOpts contains all params that can be set on a ConfigureOutOfBandManagementParams.
+// Optional params are pointers (or slices and maps) which are only set when not nil.
+type ConfigureOutOfBandManagementOpts struct {
+	Address  string
+	Driver   string
+	Hostid   string
+	Password string
+	Port     string
+	Username string
+}
+
+// NewConfigureOutOfBandManagementParamsFromOpts is an alternative for NewConfigureOutOfBandManagementParams which takes all params using
+// an options struct, as that is easier to read for APIs with many required params
+func (s *OutofbandManagementService) NewConfigureOutOfBandManagementParamsFromOpts(o ConfigureOutOfBandManagementOpts) *ConfigureOutOfBandManagementParams {
+	p := s.NewConfigureOutOfBandManagementParams(o.Address, o.Driver, o.Hostid, o.Password, o.Port, o.Username)
 	return p
 }
 
 // Configures a host's out-of-band management interface
-func (s *OutofbandManagementService) ConfigureOutOfBandManagement(p *ConfigureOutOfBandManagementParams) (*OutOfBandManagementResponse, error) {
-	resp, err := s.cs.newRequest("configureOutOfBandManagement", p.toURLValues())
+func (s *OutofbandManagementService) ConfigureOutOfBandManagement(p *ConfigureOutOfBandManagementParams, opts ...OptionFunc) (*OutOfBandManagementResponse, error) {
+	return s.ConfigureOutOfBandManagementWithContext(context.Background(), p, opts...)
+}
+
+// ConfigureOutOfBandManagementWithContext is the same as ConfigureOutOfBandManagement, but the request is cancelled when the context is done
+func (s *OutofbandManagementService) ConfigureOutOfBandManagementWithContext(ctx context.Context, p *ConfigureOutOfBandManagementParams, opts ...OptionFunc) (*OutOfBandManagementResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.ConfigureOutOfBandManagementRawWithContext(ctx, p.toURLValues())
+}
+
+// ConfigureOutOfBandManagementRaw is the same as ConfigureOutOfBandManagement, but takes the params as url.Values instead of typed params
+func (s *OutofbandManagementService) ConfigureOutOfBandManagementRaw(v url.Values) (*OutOfBandManagementResponse, error) {
+	return s.ConfigureOutOfBandManagementRawWithContext(context.Background(), v)
+}
+
+// ConfigureOutOfBandManagementRawWithContext is the same as ConfigureOutOfBandManagementRaw, but the request is cancelled when the context is done
+func (s *OutofbandManagementService) ConfigureOutOfBandManagementRawWithContext(ctx context.Context, v url.Values) (*OutOfBandManagementResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "configureOutOfBandManagement", "configureoutofbandmanagementresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -240,6 +414,16 @@ type IssueOutOfBandManagementPowerActionParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *IssueOutOfBandManagementPowerActionParams) Clone() *IssueOutOfBandManagementPowerActionParams {
+	c := &IssueOutOfBandManagementPowerActionParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *IssueOutOfBandManagementPowerActionParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -258,6 +442,16 @@ func (p *IssueOutOfBandManagementPowerActionParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *IssueOutOfBandManagementPowerActionParams) validate() error {
+	for _, k := range []string{"action", "hostid"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of issueOutOfBandManagementPowerAction", k)
+		}
+	}
+	return nil
+}
+
 func (p *IssueOutOfBandManagementPowerActionParams) SetAction(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -266,6 +460,13 @@ func (p *IssueOutOfBandManagementPowerActionParams) SetAction(v string) {
 	return
 }
 
+// SetActionIfUnset sets action to v, unless it is already set
+func (p *IssueOutOfBandManagementPowerActionParams) SetActionIfUnset(v string) {
+	if _, found := p.p["action"]; !found {
+		p.SetAction(v)
+	}
+}
+
 func (p *IssueOutOfBandManagementPowerActionParams) SetHostid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -274,6 +475,13 @@ func (p *IssueOutOfBandManagementPowerActionParams) SetHostid(v string) {
 	return
 }
 
+// SetHostidIfUnset sets hostid to v, unless it is already set
+func (p *IssueOutOfBandManagementPowerActionParams) SetHostidIfUnset(v string) {
+	if _, found := p.p["hostid"]; !found {
+		p.SetHostid(v)
+	}
+}
+
 func (p *IssueOutOfBandManagementPowerActionParams) SetTimeout(v int64) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -282,19 +490,55 @@ func (p *IssueOutOfBandManagementPowerActionParams) SetTimeout(v int64) {
 	return
 }
 
+// SetTimeoutIfUnset sets timeout to v, unless it is already set
+func (p *IssueOutOfBandManagementPowerActionParams) SetTimeoutIfUnset(v int64) {
+	if _, found := p.p["timeout"]; !found {
+		p.SetTimeout(v)
+	}
+}
+
 // You should always use this function to get a new IssueOutOfBandManagementPowerActionParams instance,
-// as then you are sure you have configured all required params
-func (s *OutofbandManagementService) NewIssueOutOfBandManagementPowerActionParams(action string, hostid string) *IssueOutOfBandManagementPowerActionParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *OutofbandManagementService) NewIssueOutOfBandManagementPowerActionParams(action string, hostid string, opts ...func(*IssueOutOfBandManagementPowerActionParams)) *IssueOutOfBandManagementPowerActionParams {
 	p := &IssueOutOfBandManagementPowerActionParams{}
 	p.p = make(map[string]interface{})
 	p.p["action"] = action
 	p.p["hostid"] = hostid
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Initiates the specified power action to the host's out-of-band management interface
-func (s *OutofbandManagementService) IssueOutOfBandManagementPowerAction(p *IssueOutOfBandManagementPowerActionParams) (*IssueOutOfBandManagementPowerActionResponse, error) {
-	resp, err := s.cs.newRequest("issueOutOfBandManagementPowerAction", p.toURLValues())
+func (s *OutofbandManagementService) IssueOutOfBandManagementPowerAction(p *IssueOutOfBandManagementPowerActionParams, opts ...OptionFunc) (*IssueOutOfBandManagementPowerActionResponse, error) {
+	return s.IssueOutOfBandManagementPowerActionWithContext(context.Background(), p, opts...)
+}
+
+// IssueOutOfBandManagementPowerActionWithContext is the same as IssueOutOfBandManagementPowerAction, but the request is cancelled when the context is done
+func (s *OutofbandManagementService) IssueOutOfBandManagementPowerActionWithContext(ctx context.Context, p *IssueOutOfBandManagementPowerActionParams, opts ...OptionFunc) (*IssueOutOfBandManagementPowerActionResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.IssueOutOfBandManagementPowerActionRawWithContext(ctx, p.toURLValues())
+}
+
+// IssueOutOfBandManagementPowerActionRaw is the same as IssueOutOfBandManagementPowerAction, but takes the params as url.Values instead of typed params
+func (s *OutofbandManagementService) IssueOutOfBandManagementPowerActionRaw(v url.Values) (*IssueOutOfBandManagementPowerActionResponse, error) {
+	return s.IssueOutOfBandManagementPowerActionRawWithContext(context.Background(), v)
+}
+
+// IssueOutOfBandManagementPowerActionRawWithContext is the same as IssueOutOfBandManagementPowerActionRaw, but the request is cancelled when the context is done
+func (s *OutofbandManagementService) IssueOutOfBandManagementPowerActionRawWithContext(ctx context.Context, v url.Values) (*IssueOutOfBandManagementPowerActionResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "issueOutOfBandManagementPowerAction", "issueoutofbandmanagementpoweractionresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -306,9 +550,9 @@ func (s *OutofbandManagementService) IssueOutOfBandManagementPowerAction(p *Issu
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -17,7 +17,9 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/url"
 	"strconv"
@@ -28,6 +30,16 @@ type ConfigureOvsElementParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ConfigureOvsElementParams) Clone() *ConfigureOvsElementParams {
+	c := &ConfigureOvsElementParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ConfigureOvsElementParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -43,6 +55,16 @@ func (p *ConfigureOvsElementParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *ConfigureOvsElementParams) validate() error {
+	for _, k := range []string{"enabled", "id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of configureOvsElement", k)
+		}
+	}
+	return nil
+}
+
 func (p *ConfigureOvsElementParams) SetEnabled(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -51,6 +73,13 @@ func (p *ConfigureOvsElementParams) SetEnabled(v bool) {
 	return
 }
 
+// SetEnabledIfUnset sets enabled to v, unless it is already set
+func (p *ConfigureOvsElementParams) SetEnabledIfUnset(v bool) {
+	if _, found := p.p["enabled"]; !found {
+		p.SetEnabled(v)
+	}
+}
+
 func (p *ConfigureOvsElementParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -59,19 +88,55 @@ func (p *ConfigureOvsElementParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *ConfigureOvsElementParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 // You should always use this function to get a new ConfigureOvsElementParams instance,
-// as then you are sure you have configured all required params
-func (s *OvsElementService) NewConfigureOvsElementParams(enabled bool, id string) *ConfigureOvsElementParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *OvsElementService) NewConfigureOvsElementParams(enabled bool, id string, opts ...func(*ConfigureOvsElementParams)) *ConfigureOvsElementParams {
 	p := &ConfigureOvsElementParams{}
 	p.p = make(map[string]interface{})
 	p.p["enabled"] = enabled
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Configures an ovs element.
-func (s *OvsElementService) ConfigureOvsElement(p *ConfigureOvsElementParams) (*OvsElementResponse, error) {
-	resp, err := s.cs.newRequest("configureOvsElement", p.toURLValues())
+func (s *OvsElementService) ConfigureOvsElement(p *ConfigureOvsElementParams, opts ...OptionFunc) (*OvsElementResponse, error) {
+	return s.ConfigureOvsElementWithContext(context.Background(), p, opts...)
+}
+
+// ConfigureOvsElementWithContext is the same as ConfigureOvsElement, but the request is cancelled when the context is done
+func (s *OvsElementService) ConfigureOvsElementWithContext(ctx context.Context, p *ConfigureOvsElementParams, opts ...OptionFunc) (*OvsElementResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.ConfigureOvsElementRawWithContext(ctx, p.toURLValues())
+}
+
+// ConfigureOvsElementRaw is the same as ConfigureOvsElement, but takes the params as url.Values instead of typed params
+func (s *OvsElementService) ConfigureOvsElementRaw(v url.Values) (*OvsElementResponse, error) {
+	return s.ConfigureOvsElementRawWithContext(context.Background(), v)
+}
+
+// ConfigureOvsElementRawWithContext is the same as ConfigureOvsElementRaw, but the request is cancelled when the context is done
+func (s *OvsElementService) ConfigureOvsElementRawWithContext(ctx context.Context, v url.Values) (*OvsElementResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "configureOvsElement", "configureovselementresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -83,9 +148,9 @@ func (s *OvsElementService) ConfigureOvsElement(p *ConfigureOvsElementParams) (*
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -120,6 +185,16 @@ type ListOvsElementsParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ListOvsElementsParams) Clone() *ListOvsElementsParams {
+	c := &ListOvsElementsParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListOvsElementsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -157,6 +232,13 @@ func (p *ListOvsElementsParams) SetEnabled(v bool) {
 	return
 }
 
+// SetEnabledIfUnset sets enabled to v, unless it is already set
+func (p *ListOvsElementsParams) SetEnabledIfUnset(v bool) {
+	if _, found := p.p["enabled"]; !found {
+		p.SetEnabled(v)
+	}
+}
+
 func (p *ListOvsElementsParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -165,6 +247,13 @@ func (p *ListOvsElementsParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *ListOvsElementsParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 func (p *ListOvsElementsParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -173,6 +262,13 @@ func (p *ListOvsElementsParams) SetKeyword(v string) {
 	return
 }
 
+// SetKeywordIfUnset sets keyword to v, unless it is already set
+func (p *ListOvsElementsParams) SetKeywordIfUnset(v string) {
+	if _, found := p.p["keyword"]; !found {
+		p.SetKeyword(v)
+	}
+}
+
 func (p *ListOvsElementsParams) SetNspid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -181,6 +277,13 @@ func (p *ListOvsElementsParams) SetNspid(v string) {
 	return
 }
 
+// SetNspidIfUnset sets nspid to v, unless it is already set
+func (p *ListOvsElementsParams) SetNspidIfUnset(v string) {
+	if _, found := p.p["nspid"]; !found {
+		p.SetNspid(v)
+	}
+}
+
 func (p *ListOvsElementsParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -189,6 +292,13 @@ func (p *ListOvsElementsParams) SetPage(v int) {
 	return
 }
 
+// SetPageIfUnset sets page to v, unless it is already set
+func (p *ListOvsElementsParams) SetPageIfUnset(v int) {
+	if _, found := p.p["page"]; !found {
+		p.SetPage(v)
+	}
+}
+
 func (p *ListOvsElementsParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -197,14 +307,32 @@ func (p *ListOvsElementsParams) SetPagesize(v int) {
 	return
 }
 
+// SetPagesizeIfUnset sets pagesize to v, unless it is already set
+func (p *ListOvsElementsParams) SetPagesizeIfUnset(v int) {
+	if _, found := p.p["pagesize"]; !found {
+		p.SetPagesize(v)
+	}
+}
+
 // You should always use this function to get a new ListOvsElementsParams instance,
-// as then you are sure you have configured all required params
-func (s *OvsElementService) NewListOvsElementsParams() *ListOvsElementsParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *OvsElementService) NewListOvsElementsParams(opts ...func(*ListOvsElementsParams)) *ListOvsElementsParams {
 	p := &ListOvsElementsParams{}
 	p.p = make(map[string]interface{})
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
+func (p *ListOvsElementsParams) setFirstMatch() {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p[firstMatchKey] = true
+}
+
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *OvsElementService) GetOvsElementByID(id string, opts ...OptionFunc) (*OvsElement, int, error) {
 	p := &ListOvsElementsParams{}
@@ -212,7 +340,7 @@ func (s *OvsElementService) GetOvsElementByID(id string, opts ...OptionFunc) (*O
 
 	p.p["id"] = id
 
-	for _, fn := range append(s.cs.options, opts...) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
 		if err := fn(s.cs, p); err != nil {
 			return nil, -1, err
 		}
@@ -223,24 +351,56 @@ func (s *OvsElementService) GetOvsElementByID(id string, opts ...OptionFunc) (*O
 		if strings.Contains(err.Error(), fmt.Sprintf(
 			"Invalid parameter id value=%s due to incorrect long value format, "+
 				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+			return nil, 0, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
 	}
 
 	if l.Count == 1 {
 		return l.OvsElements[0], l.Count, nil
 	}
+
+	if _, ok := p.p[firstMatchKey]; ok && len(l.OvsElements) > 0 {
+		return l.OvsElements[0], l.Count, nil
+	}
 	return nil, l.Count, fmt.Errorf("There is more then one result for OvsElement UUID: %s!", id)
 }
 
+// OvsElementExists returns true if a OvsElement with the given ID exists. When no match is found
+// it returns false, while any other error is returned as is.
+func (s *OvsElementService) OvsElementExists(id string, opts ...OptionFunc) (bool, error) {
+	_, count, err := s.GetOvsElementByID(id, opts...)
+	if errors.Is(err, ErrNotFound) {
+		return false, nil
+	}
+	if count > 0 {
+		return true, nil
+	}
+	return false, err
+}
+
 // Lists all available ovs elements.
 func (s *OvsElementService) ListOvsElements(p *ListOvsElementsParams) (*ListOvsElementsResponse, error) {
-	resp, err := s.cs.newRequest("listOvsElements", p.toURLValues())
+	return s.ListOvsElementsWithContext(context.Background(), p)
+}
+
+// ListOvsElementsWithContext is the same as ListOvsElements, but the request is cancelled when the context is done
+func (s *OvsElementService) ListOvsElementsWithContext(ctx context.Context, p *ListOvsElementsParams) (*ListOvsElementsResponse, error) {
+	return s.ListOvsElementsRawWithContext(ctx, p.toURLValues())
+}
+
+// ListOvsElementsRaw is the same as ListOvsElements, but takes the params as url.Values instead of typed params
+func (s *OvsElementService) ListOvsElementsRaw(v url.Values) (*ListOvsElementsResponse, error) {
+	return s.ListOvsElementsRawWithContext(context.Background(), v)
+}
+
+// ListOvsElementsRawWithContext is the same as ListOvsElementsRaw, but the request is cancelled when the context is done
+func (s *OvsElementService) ListOvsElementsRawWithContext(ctx context.Context, v url.Values) (*ListOvsElementsResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "listOvsElements", "listovselementsresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -253,6 +413,93 @@ func (s *OvsElementService) ListOvsElements(p *ListOvsElementsParams) (*ListOvsE
 	return &r, nil
 }
 
+// StreamOvsElements fetches the results of ListOvsElements page by page in the background and sends them on the
+// returned channel. Both returned channels are closed when done. If fetching a page fails, or the context
+// is done before all results are sent, the error is sent on the error channel and fetching stops. A
+// request for a page in flight is cancelled when the context is done. The page and pagesize set in the
+// params are ignored.
+func (s *OvsElementService) StreamOvsElements(ctx context.Context, p *ListOvsElementsParams) (<-chan *OvsElement, <-chan error) {
+	ch := make(chan *OvsElement)
+	errs := make(chan error, 1)
+
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesize(500)
+
+	go func() {
+		defer close(errs)
+		defer close(ch)
+
+		for page, count := 1, 0; ; page++ {
+			pp.SetPage(page)
+
+			l, err := s.ListOvsElementsWithContext(ctx, pp)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			for _, v := range l.OvsElements {
+				select {
+				case ch <- v:
+				case <-ctx.Done():
+					errs <- ctx.Err()
+					return
+				}
+			}
+
+			count += len(l.OvsElements)
+			if len(l.OvsElements) < 500 || count >= l.Count {
+				return
+			}
+		}
+	}()
+
+	return ch, errs
+}
+
+// ListOvsElementsWhere returns all results of ListOvsElements for which pred returns true. Note that the results are
+// filtered client-side, so all results matching the params are fetched first.
+// All pages are fetched, so the page and pagesize set in the params are ignored.
+func (s *OvsElementService) ListOvsElementsWhere(p *ListOvsElementsParams, pred func(*OvsElement) bool) ([]*OvsElement, error) {
+	var r []*OvsElement
+	ch, errs := s.StreamOvsElements(context.Background(), p)
+	for v := range ch {
+		if pred(v) {
+			r = append(r, v)
+		}
+	}
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ListAllOvsElements returns the results of ListOvsElements across all pages, by calling ListOvsElements page by page until all
+// results are fetched. The pagesize set in the params is used for every page (500 if not set), and the
+// page set in the params is ignored.
+func (s *OvsElementService) ListAllOvsElements(p *ListOvsElementsParams) ([]*OvsElement, error) {
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesizeIfUnset(500)
+	pagesize := pp.p["pagesize"].(int)
+
+	var r []*OvsElement
+	for page := 1; ; page++ {
+		pp.SetPage(page)
+
+		l, err := s.ListOvsElements(pp)
+		if err != nil {
+			return nil, err
+		}
+		r = append(r, l.OvsElements...)
+
+		if len(l.OvsElements) < pagesize || len(r) >= l.Count {
+			return r, nil
+		}
+	}
+}
+
 type ListOvsElementsResponse struct {
 	Count       int           `json:"count"`
 	OvsElements []*OvsElement `json:"ovselement"`
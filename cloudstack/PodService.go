@@ -17,17 +17,67 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/url"
 	"strconv"
 	"strings"
+	"time"
 )
 
+// UpdateMany runs UpdatePod for all given IDs in parallel, using at most concurrency concurrent requests.
+// For each ID the params are populated with the ID and then passed to mutate, which should set the
+// changes to apply. The result contains the updated resources (in the order of the IDs) and the error
+// of every update that failed by ID. The returned error contains all failures, or is nil if none failed.
+// The updated resources are returned as the UpdatePodResponse of every update, which contains the same
+// fields as the resource itself, so no additional request is needed per resource to get them. The
+// options are used for every update.
+func (s *PodService) UpdateMany(ids []string, mutate func(*UpdatePodParams), concurrency int, opts ...OptionFunc) (BulkResult[*UpdatePodResponse], error) {
+	rs := make([]*UpdatePodResponse, len(ids))
+	errs := make([]error, len(ids))
+
+	runConcurrently(len(ids), concurrency, func(i int) {
+		p := &UpdatePodParams{p: map[string]interface{}{"id": ids[i]}}
+		if mutate != nil {
+			mutate(p)
+		}
+
+		r, err := s.UpdatePod(p, opts...)
+		if err != nil {
+			errs[i] = err
+			return
+		}
+		rs[i] = r
+	})
+
+	var r BulkResult[*UpdatePodResponse]
+	for i, id := range ids {
+		if errs[i] != nil {
+			r.fail(id, errs[i])
+			continue
+		}
+		r.Succeeded = append(r.Succeeded, rs[i])
+	}
+
+	return r, r.Err()
+}
+
 type CreatePodParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *CreatePodParams) Clone() *CreatePodParams {
+	c := &CreatePodParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *CreatePodParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -57,6 +107,16 @@ func (p *CreatePodParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *CreatePodParams) validate() error {
+	for _, k := range []string{"gateway", "name", "netmask", "startip", "zoneid"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of createPod", k)
+		}
+	}
+	return nil
+}
+
 func (p *CreatePodParams) SetAllocationstate(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -65,6 +125,13 @@ func (p *CreatePodParams) SetAllocationstate(v string) {
 	return
 }
 
+// SetAllocationstateIfUnset sets allocationstate to v, unless it is already set
+func (p *CreatePodParams) SetAllocationstateIfUnset(v string) {
+	if _, found := p.p["allocationstate"]; !found {
+		p.SetAllocationstate(v)
+	}
+}
+
 func (p *CreatePodParams) SetEndip(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -73,6 +140,13 @@ func (p *CreatePodParams) SetEndip(v string) {
 	return
 }
 
+// SetEndipIfUnset sets endip to v, unless it is already set
+func (p *CreatePodParams) SetEndipIfUnset(v string) {
+	if _, found := p.p["endip"]; !found {
+		p.SetEndip(v)
+	}
+}
+
 func (p *CreatePodParams) SetGateway(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -81,6 +155,13 @@ func (p *CreatePodParams) SetGateway(v string) {
 	return
 }
 
+// SetGatewayIfUnset sets gateway to v, unless it is already set
+func (p *CreatePodParams) SetGatewayIfUnset(v string) {
+	if _, found := p.p["gateway"]; !found {
+		p.SetGateway(v)
+	}
+}
+
 func (p *CreatePodParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -89,6 +170,13 @@ func (p *CreatePodParams) SetName(v string) {
 	return
 }
 
+// SetNameIfUnset sets name to v, unless it is already set
+func (p *CreatePodParams) SetNameIfUnset(v string) {
+	if _, found := p.p["name"]; !found {
+		p.SetName(v)
+	}
+}
+
 func (p *CreatePodParams) SetNetmask(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -97,6 +185,13 @@ func (p *CreatePodParams) SetNetmask(v string) {
 	return
 }
 
+// SetNetmaskIfUnset sets netmask to v, unless it is already set
+func (p *CreatePodParams) SetNetmaskIfUnset(v string) {
+	if _, found := p.p["netmask"]; !found {
+		p.SetNetmask(v)
+	}
+}
+
 func (p *CreatePodParams) SetStartip(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -105,6 +200,13 @@ func (p *CreatePodParams) SetStartip(v string) {
 	return
 }
 
+// SetStartipIfUnset sets startip to v, unless it is already set
+func (p *CreatePodParams) SetStartipIfUnset(v string) {
+	if _, found := p.p["startip"]; !found {
+		p.SetStartip(v)
+	}
+}
+
 func (p *CreatePodParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -113,9 +215,17 @@ func (p *CreatePodParams) SetZoneid(v string) {
 	return
 }
 
+// SetZoneidIfUnset sets zoneid to v, unless it is already set
+func (p *CreatePodParams) SetZoneidIfUnset(v string) {
+	if _, found := p.p["zoneid"]; !found {
+		p.SetZoneid(v)
+	}
+}
+
 // You should always use this function to get a new CreatePodParams instance,
-// as then you are sure you have configured all required params
-func (s *PodService) NewCreatePodParams(gateway string, name string, netmask string, startip string, zoneid string) *CreatePodParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *PodService) NewCreatePodParams(gateway string, name string, netmask string, startip string, zoneid string, opts ...func(*CreatePodParams)) *CreatePodParams {
 	p := &CreatePodParams{}
 	p.p = make(map[string]interface{})
 	p.p["gateway"] = gateway
@@ -123,12 +233,65 @@ func (s *PodService) NewCreatePodParams(gateway string, name string, netmask str
 	p.p["netmask"] = netmask
 	p.p["startip"] = startip
 	p.p["zoneid"] = zoneid
+	for _, fn := range opts {
+		fn(p)
+	}
+	return p
+}
+
+// CreatePodOpts contains all params that can be set on a CreatePodParams.
+// Optional params are pointers (or slices and maps) which are only set when not nil.
+type CreatePodOpts struct {
+	Allocationstate *string
+	Endip           *string
+	Gateway         string
+	Name            string
+	Netmask         string
+	Startip         string
+	Zoneid          string
+}
+
+// NewCreatePodParamsFromOpts is an alternative for NewCreatePodParams which takes all params using
+// an options struct, as that is easier to read for APIs with many required params
+func (s *PodService) NewCreatePodParamsFromOpts(o CreatePodOpts) *CreatePodParams {
+	p := s.NewCreatePodParams(o.Gateway, o.Name, o.Netmask, o.Startip, o.Zoneid)
+	if o.Allocationstate != nil {
+		p.p["allocationstate"] = *o.Allocationstate
+	}
+	if o.Endip != nil {
+		p.p["endip"] = *o.Endip
+	}
 	return p
 }
 
 // Creates a new Pod.
-func (s *PodService) CreatePod(p *CreatePodParams) (*CreatePodResponse, error) {
-	resp, err := s.cs.newRequest("createPod", p.toURLValues())
+func (s *PodService) CreatePod(p *CreatePodParams, opts ...OptionFunc) (*CreatePodResponse, error) {
+	return s.CreatePodWithContext(context.Background(), p, opts...)
+}
+
+// CreatePodWithContext is the same as CreatePod, but the request is cancelled when the context is done
+func (s *PodService) CreatePodWithContext(ctx context.Context, p *CreatePodParams, opts ...OptionFunc) (*CreatePodResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.CreatePodRawWithContext(ctx, p.toURLValues())
+}
+
+// CreatePodRaw is the same as CreatePod, but takes the params as url.Values instead of typed params
+func (s *PodService) CreatePodRaw(v url.Values) (*CreatePodResponse, error) {
+	return s.CreatePodRawWithContext(context.Background(), v)
+}
+
+// CreatePodRawWithContext is the same as CreatePodRaw, but the request is cancelled when the context is done
+func (s *PodService) CreatePodRawWithContext(ctx context.Context, v url.Values) (*CreatePodResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "createPod", "createpodresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -169,6 +332,16 @@ type DedicatePodParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *DedicatePodParams) Clone() *DedicatePodParams {
+	c := &DedicatePodParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DedicatePodParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -186,6 +359,16 @@ func (p *DedicatePodParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *DedicatePodParams) validate() error {
+	for _, k := range []string{"domainid", "podid"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of dedicatePod", k)
+		}
+	}
+	return nil
+}
+
 func (p *DedicatePodParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -194,6 +377,13 @@ func (p *DedicatePodParams) SetAccount(v string) {
 	return
 }
 
+// SetAccountIfUnset sets account to v, unless it is already set
+func (p *DedicatePodParams) SetAccountIfUnset(v string) {
+	if _, found := p.p["account"]; !found {
+		p.SetAccount(v)
+	}
+}
+
 func (p *DedicatePodParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -202,6 +392,13 @@ func (p *DedicatePodParams) SetDomainid(v string) {
 	return
 }
 
+// SetDomainidIfUnset sets domainid to v, unless it is already set
+func (p *DedicatePodParams) SetDomainidIfUnset(v string) {
+	if _, found := p.p["domainid"]; !found {
+		p.SetDomainid(v)
+	}
+}
+
 func (p *DedicatePodParams) SetPodid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -210,19 +407,55 @@ func (p *DedicatePodParams) SetPodid(v string) {
 	return
 }
 
+// SetPodidIfUnset sets podid to v, unless it is already set
+func (p *DedicatePodParams) SetPodidIfUnset(v string) {
+	if _, found := p.p["podid"]; !found {
+		p.SetPodid(v)
+	}
+}
+
 // You should always use this function to get a new DedicatePodParams instance,
-// as then you are sure you have configured all required params
-func (s *PodService) NewDedicatePodParams(domainid string, podid string) *DedicatePodParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *PodService) NewDedicatePodParams(domainid string, podid string, opts ...func(*DedicatePodParams)) *DedicatePodParams {
 	p := &DedicatePodParams{}
 	p.p = make(map[string]interface{})
 	p.p["domainid"] = domainid
 	p.p["podid"] = podid
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Dedicates a Pod.
-func (s *PodService) DedicatePod(p *DedicatePodParams) (*DedicatePodResponse, error) {
-	resp, err := s.cs.newRequest("dedicatePod", p.toURLValues())
+func (s *PodService) DedicatePod(p *DedicatePodParams, opts ...OptionFunc) (*DedicatePodResponse, error) {
+	return s.DedicatePodWithContext(context.Background(), p, opts...)
+}
+
+// DedicatePodWithContext is the same as DedicatePod, but the request is cancelled when the context is done
+func (s *PodService) DedicatePodWithContext(ctx context.Context, p *DedicatePodParams, opts ...OptionFunc) (*DedicatePodResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.DedicatePodRawWithContext(ctx, p.toURLValues())
+}
+
+// DedicatePodRaw is the same as DedicatePod, but takes the params as url.Values instead of typed params
+func (s *PodService) DedicatePodRaw(v url.Values) (*DedicatePodResponse, error) {
+	return s.DedicatePodRawWithContext(context.Background(), v)
+}
+
+// DedicatePodRawWithContext is the same as DedicatePodRaw, but the request is cancelled when the context is done
+func (s *PodService) DedicatePodRawWithContext(ctx context.Context, v url.Values) (*DedicatePodResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "dedicatePod", "dedicatepodresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -234,9 +467,9 @@ func (s *PodService) DedicatePod(p *DedicatePodParams) (*DedicatePodResponse, er
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -269,6 +502,16 @@ type DeletePodParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *DeletePodParams) Clone() *DeletePodParams {
+	c := &DeletePodParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeletePodParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -280,6 +523,16 @@ func (p *DeletePodParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *DeletePodParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of deletePod", k)
+		}
+	}
+	return nil
+}
+
 func (p *DeletePodParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -288,18 +541,54 @@ func (p *DeletePodParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *DeletePodParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 // You should always use this function to get a new DeletePodParams instance,
-// as then you are sure you have configured all required params
-func (s *PodService) NewDeletePodParams(id string) *DeletePodParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *PodService) NewDeletePodParams(id string, opts ...func(*DeletePodParams)) *DeletePodParams {
 	p := &DeletePodParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Deletes a Pod.
-func (s *PodService) DeletePod(p *DeletePodParams) (*DeletePodResponse, error) {
-	resp, err := s.cs.newRequest("deletePod", p.toURLValues())
+func (s *PodService) DeletePod(p *DeletePodParams, opts ...OptionFunc) (*DeletePodResponse, error) {
+	return s.DeletePodWithContext(context.Background(), p, opts...)
+}
+
+// DeletePodWithContext is the same as DeletePod, but the request is cancelled when the context is done
+func (s *PodService) DeletePodWithContext(ctx context.Context, p *DeletePodParams, opts ...OptionFunc) (*DeletePodResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.DeletePodRawWithContext(ctx, p.toURLValues())
+}
+
+// DeletePodRaw is the same as DeletePod, but takes the params as url.Values instead of typed params
+func (s *PodService) DeletePodRaw(v url.Values) (*DeletePodResponse, error) {
+	return s.DeletePodRawWithContext(context.Background(), v)
+}
+
+// DeletePodRawWithContext is the same as DeletePodRaw, but the request is cancelled when the context is done
+func (s *PodService) DeletePodRawWithContext(ctx context.Context, v url.Values) (*DeletePodResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "deletePod", "deletepodresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -312,6 +601,34 @@ func (s *PodService) DeletePod(p *DeletePodParams) (*DeletePodResponse, error) {
 	return &r, nil
 }
 
+// WaitForPodDeleted polls until the Pod with the given ID no longer exists, the timeout
+// expires or the context is cancelled. Deleted resources may still be listed for a short while
+// after the delete command finished, so this can be used to make sure they are really gone. The
+// options are passed to GetPodByID, so a resource outside of the default scope can be found.
+func (s *PodService) WaitForPodDeleted(ctx context.Context, id string, timeout time.Duration, opts ...OptionFunc) error {
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	for {
+		_, count, err := s.GetPodByID(id, opts...)
+		if count == 0 {
+			return nil
+		}
+		if count < 0 {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(2 * time.Second):
+		}
+	}
+}
+
 type DeletePodResponse struct {
 	Displaytext string `json:"displaytext"`
 	Success     bool   `json:"success"`
@@ -340,6 +657,16 @@ type ListDedicatedPodsParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ListDedicatedPodsParams) Clone() *ListDedicatedPodsParams {
+	c := &ListDedicatedPodsParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListDedicatedPodsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -379,6 +706,13 @@ func (p *ListDedicatedPodsParams) SetAccount(v string) {
 	return
 }
 
+// SetAccountIfUnset sets account to v, unless it is already set
+func (p *ListDedicatedPodsParams) SetAccountIfUnset(v string) {
+	if _, found := p.p["account"]; !found {
+		p.SetAccount(v)
+	}
+}
+
 func (p *ListDedicatedPodsParams) SetAffinitygroupid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -387,6 +721,13 @@ func (p *ListDedicatedPodsParams) SetAffinitygroupid(v string) {
 	return
 }
 
+// SetAffinitygroupidIfUnset sets affinitygroupid to v, unless it is already set
+func (p *ListDedicatedPodsParams) SetAffinitygroupidIfUnset(v string) {
+	if _, found := p.p["affinitygroupid"]; !found {
+		p.SetAffinitygroupid(v)
+	}
+}
+
 func (p *ListDedicatedPodsParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -395,6 +736,13 @@ func (p *ListDedicatedPodsParams) SetDomainid(v string) {
 	return
 }
 
+// SetDomainidIfUnset sets domainid to v, unless it is already set
+func (p *ListDedicatedPodsParams) SetDomainidIfUnset(v string) {
+	if _, found := p.p["domainid"]; !found {
+		p.SetDomainid(v)
+	}
+}
+
 func (p *ListDedicatedPodsParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -403,6 +751,13 @@ func (p *ListDedicatedPodsParams) SetKeyword(v string) {
 	return
 }
 
+// SetKeywordIfUnset sets keyword to v, unless it is already set
+func (p *ListDedicatedPodsParams) SetKeywordIfUnset(v string) {
+	if _, found := p.p["keyword"]; !found {
+		p.SetKeyword(v)
+	}
+}
+
 func (p *ListDedicatedPodsParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -411,6 +766,13 @@ func (p *ListDedicatedPodsParams) SetPage(v int) {
 	return
 }
 
+// SetPageIfUnset sets page to v, unless it is already set
+func (p *ListDedicatedPodsParams) SetPageIfUnset(v int) {
+	if _, found := p.p["page"]; !found {
+		p.SetPage(v)
+	}
+}
+
 func (p *ListDedicatedPodsParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -419,6 +781,13 @@ func (p *ListDedicatedPodsParams) SetPagesize(v int) {
 	return
 }
 
+// SetPagesizeIfUnset sets pagesize to v, unless it is already set
+func (p *ListDedicatedPodsParams) SetPagesizeIfUnset(v int) {
+	if _, found := p.p["pagesize"]; !found {
+		p.SetPagesize(v)
+	}
+}
+
 func (p *ListDedicatedPodsParams) SetPodid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -427,17 +796,43 @@ func (p *ListDedicatedPodsParams) SetPodid(v string) {
 	return
 }
 
+// SetPodidIfUnset sets podid to v, unless it is already set
+func (p *ListDedicatedPodsParams) SetPodidIfUnset(v string) {
+	if _, found := p.p["podid"]; !found {
+		p.SetPodid(v)
+	}
+}
+
 // You should always use this function to get a new ListDedicatedPodsParams instance,
-// as then you are sure you have configured all required params
-func (s *PodService) NewListDedicatedPodsParams() *ListDedicatedPodsParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *PodService) NewListDedicatedPodsParams(opts ...func(*ListDedicatedPodsParams)) *ListDedicatedPodsParams {
 	p := &ListDedicatedPodsParams{}
 	p.p = make(map[string]interface{})
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Lists dedicated pods.
 func (s *PodService) ListDedicatedPods(p *ListDedicatedPodsParams) (*ListDedicatedPodsResponse, error) {
-	resp, err := s.cs.newRequest("listDedicatedPods", p.toURLValues())
+	return s.ListDedicatedPodsWithContext(context.Background(), p)
+}
+
+// ListDedicatedPodsWithContext is the same as ListDedicatedPods, but the request is cancelled when the context is done
+func (s *PodService) ListDedicatedPodsWithContext(ctx context.Context, p *ListDedicatedPodsParams) (*ListDedicatedPodsResponse, error) {
+	return s.ListDedicatedPodsRawWithContext(ctx, p.toURLValues())
+}
+
+// ListDedicatedPodsRaw is the same as ListDedicatedPods, but takes the params as url.Values instead of typed params
+func (s *PodService) ListDedicatedPodsRaw(v url.Values) (*ListDedicatedPodsResponse, error) {
+	return s.ListDedicatedPodsRawWithContext(context.Background(), v)
+}
+
+// ListDedicatedPodsRawWithContext is the same as ListDedicatedPodsRaw, but the request is cancelled when the context is done
+func (s *PodService) ListDedicatedPodsRawWithContext(ctx context.Context, v url.Values) (*ListDedicatedPodsResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "listDedicatedPods", "listdedicatedpodsresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -450,6 +845,93 @@ func (s *PodService) ListDedicatedPods(p *ListDedicatedPodsParams) (*ListDedicat
 	return &r, nil
 }
 
+// StreamDedicatedPods fetches the results of ListDedicatedPods page by page in the background and sends them on the
+// returned channel. Both returned channels are closed when done. If fetching a page fails, or the context
+// is done before all results are sent, the error is sent on the error channel and fetching stops. A
+// request for a page in flight is cancelled when the context is done. The page and pagesize set in the
+// params are ignored.
+func (s *PodService) StreamDedicatedPods(ctx context.Context, p *ListDedicatedPodsParams) (<-chan *DedicatedPod, <-chan error) {
+	ch := make(chan *DedicatedPod)
+	errs := make(chan error, 1)
+
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesize(500)
+
+	go func() {
+		defer close(errs)
+		defer close(ch)
+
+		for page, count := 1, 0; ; page++ {
+			pp.SetPage(page)
+
+			l, err := s.ListDedicatedPodsWithContext(ctx, pp)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			for _, v := range l.DedicatedPods {
+				select {
+				case ch <- v:
+				case <-ctx.Done():
+					errs <- ctx.Err()
+					return
+				}
+			}
+
+			count += len(l.DedicatedPods)
+			if len(l.DedicatedPods) < 500 || count >= l.Count {
+				return
+			}
+		}
+	}()
+
+	return ch, errs
+}
+
+// ListDedicatedPodsWhere returns all results of ListDedicatedPods for which pred returns true. Note that the results are
+// filtered client-side, so all results matching the params are fetched first.
+// All pages are fetched, so the page and pagesize set in the params are ignored.
+func (s *PodService) ListDedicatedPodsWhere(p *ListDedicatedPodsParams, pred func(*DedicatedPod) bool) ([]*DedicatedPod, error) {
+	var r []*DedicatedPod
+	ch, errs := s.StreamDedicatedPods(context.Background(), p)
+	for v := range ch {
+		if pred(v) {
+			r = append(r, v)
+		}
+	}
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ListAllDedicatedPods returns the results of ListDedicatedPods across all pages, by calling ListDedicatedPods page by page until all
+// results are fetched. The pagesize set in the params is used for every page (500 if not set), and the
+// page set in the params is ignored.
+func (s *PodService) ListAllDedicatedPods(p *ListDedicatedPodsParams) ([]*DedicatedPod, error) {
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesizeIfUnset(500)
+	pagesize := pp.p["pagesize"].(int)
+
+	var r []*DedicatedPod
+	for page := 1; ; page++ {
+		pp.SetPage(page)
+
+		l, err := s.ListDedicatedPods(pp)
+		if err != nil {
+			return nil, err
+		}
+		r = append(r, l.DedicatedPods...)
+
+		if len(l.DedicatedPods) < pagesize || len(r) >= l.Count {
+			return r, nil
+		}
+	}
+}
+
 type ListDedicatedPodsResponse struct {
 	Count         int             `json:"count"`
 	DedicatedPods []*DedicatedPod `json:"dedicatedpod"`
@@ -468,6 +950,16 @@ type ListPodsParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ListPodsParams) Clone() *ListPodsParams {
+	c := &ListPodsParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListPodsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -511,6 +1003,13 @@ func (p *ListPodsParams) SetAllocationstate(v string) {
 	return
 }
 
+// SetAllocationstateIfUnset sets allocationstate to v, unless it is already set
+func (p *ListPodsParams) SetAllocationstateIfUnset(v string) {
+	if _, found := p.p["allocationstate"]; !found {
+		p.SetAllocationstate(v)
+	}
+}
+
 func (p *ListPodsParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -519,6 +1018,13 @@ func (p *ListPodsParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *ListPodsParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 func (p *ListPodsParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -527,6 +1033,13 @@ func (p *ListPodsParams) SetKeyword(v string) {
 	return
 }
 
+// SetKeywordIfUnset sets keyword to v, unless it is already set
+func (p *ListPodsParams) SetKeywordIfUnset(v string) {
+	if _, found := p.p["keyword"]; !found {
+		p.SetKeyword(v)
+	}
+}
+
 func (p *ListPodsParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -535,6 +1048,13 @@ func (p *ListPodsParams) SetName(v string) {
 	return
 }
 
+// SetNameIfUnset sets name to v, unless it is already set
+func (p *ListPodsParams) SetNameIfUnset(v string) {
+	if _, found := p.p["name"]; !found {
+		p.SetName(v)
+	}
+}
+
 func (p *ListPodsParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -543,6 +1063,13 @@ func (p *ListPodsParams) SetPage(v int) {
 	return
 }
 
+// SetPageIfUnset sets page to v, unless it is already set
+func (p *ListPodsParams) SetPageIfUnset(v int) {
+	if _, found := p.p["page"]; !found {
+		p.SetPage(v)
+	}
+}
+
 func (p *ListPodsParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -551,6 +1078,13 @@ func (p *ListPodsParams) SetPagesize(v int) {
 	return
 }
 
+// SetPagesizeIfUnset sets pagesize to v, unless it is already set
+func (p *ListPodsParams) SetPagesizeIfUnset(v int) {
+	if _, found := p.p["pagesize"]; !found {
+		p.SetPagesize(v)
+	}
+}
+
 func (p *ListPodsParams) SetShowcapacities(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -559,6 +1093,13 @@ func (p *ListPodsParams) SetShowcapacities(v bool) {
 	return
 }
 
+// SetShowcapacitiesIfUnset sets showcapacities to v, unless it is already set
+func (p *ListPodsParams) SetShowcapacitiesIfUnset(v bool) {
+	if _, found := p.p["showcapacities"]; !found {
+		p.SetShowcapacities(v)
+	}
+}
+
 func (p *ListPodsParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -567,14 +1108,32 @@ func (p *ListPodsParams) SetZoneid(v string) {
 	return
 }
 
+// SetZoneidIfUnset sets zoneid to v, unless it is already set
+func (p *ListPodsParams) SetZoneidIfUnset(v string) {
+	if _, found := p.p["zoneid"]; !found {
+		p.SetZoneid(v)
+	}
+}
+
 // You should always use this function to get a new ListPodsParams instance,
-// as then you are sure you have configured all required params
-func (s *PodService) NewListPodsParams() *ListPodsParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *PodService) NewListPodsParams(opts ...func(*ListPodsParams)) *ListPodsParams {
 	p := &ListPodsParams{}
 	p.p = make(map[string]interface{})
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
+func (p *ListPodsParams) setFirstMatch() {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p[firstMatchKey] = true
+}
+
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *PodService) GetPodID(name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListPodsParams{}
@@ -582,7 +1141,7 @@ func (s *PodService) GetPodID(name string, opts ...OptionFunc) (string, int, err
 
 	p.p["name"] = name
 
-	for _, fn := range append(s.cs.options, opts...) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
 		if err := fn(s.cs, p); err != nil {
 			return "", -1, err
 		}
@@ -594,7 +1153,7 @@ func (s *PodService) GetPodID(name string, opts ...OptionFunc) (string, int, err
 	}
 
 	if l.Count == 0 {
-		return "", l.Count, fmt.Errorf("No match found for %s: %+v", name, l)
+		return "", l.Count, fmt.Errorf("%w for %s: %+v", ErrNotFound, name, l)
 	}
 
 	if l.Count == 1 {
@@ -607,6 +1166,10 @@ func (s *PodService) GetPodID(name string, opts ...OptionFunc) (string, int, err
 				return v.Id, l.Count, nil
 			}
 		}
+
+		if _, ok := p.p[firstMatchKey]; ok && len(l.Pods) > 0 {
+			return l.Pods[0].Id, l.Count, nil
+		}
 	}
 	return "", l.Count, fmt.Errorf("Could not find an exact match for %s: %+v", name, l)
 }
@@ -632,7 +1195,7 @@ func (s *PodService) GetPodByID(id string, opts ...OptionFunc) (*Pod, int, error
 
 	p.p["id"] = id
 
-	for _, fn := range append(s.cs.options, opts...) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
 		if err := fn(s.cs, p); err != nil {
 			return nil, -1, err
 		}
@@ -643,24 +1206,56 @@ func (s *PodService) GetPodByID(id string, opts ...OptionFunc) (*Pod, int, error
 		if strings.Contains(err.Error(), fmt.Sprintf(
 			"Invalid parameter id value=%s due to incorrect long value format, "+
 				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+			return nil, 0, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
 	}
 
 	if l.Count == 1 {
 		return l.Pods[0], l.Count, nil
 	}
+
+	if _, ok := p.p[firstMatchKey]; ok && len(l.Pods) > 0 {
+		return l.Pods[0], l.Count, nil
+	}
 	return nil, l.Count, fmt.Errorf("There is more then one result for Pod UUID: %s!", id)
 }
 
+// PodExists returns true if a Pod with the given ID exists. When no match is found
+// it returns false, while any other error is returned as is.
+func (s *PodService) PodExists(id string, opts ...OptionFunc) (bool, error) {
+	_, count, err := s.GetPodByID(id, opts...)
+	if errors.Is(err, ErrNotFound) {
+		return false, nil
+	}
+	if count > 0 {
+		return true, nil
+	}
+	return false, err
+}
+
 // Lists all Pods.
 func (s *PodService) ListPods(p *ListPodsParams) (*ListPodsResponse, error) {
-	resp, err := s.cs.newRequest("listPods", p.toURLValues())
+	return s.ListPodsWithContext(context.Background(), p)
+}
+
+// ListPodsWithContext is the same as ListPods, but the request is cancelled when the context is done
+func (s *PodService) ListPodsWithContext(ctx context.Context, p *ListPodsParams) (*ListPodsResponse, error) {
+	return s.ListPodsRawWithContext(ctx, p.toURLValues())
+}
+
+// ListPodsRaw is the same as ListPods, but takes the params as url.Values instead of typed params
+func (s *PodService) ListPodsRaw(v url.Values) (*ListPodsResponse, error) {
+	return s.ListPodsRawWithContext(context.Background(), v)
+}
+
+// ListPodsRawWithContext is the same as ListPodsRaw, but the request is cancelled when the context is done
+func (s *PodService) ListPodsRawWithContext(ctx context.Context, v url.Values) (*ListPodsResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "listPods", "listpodsresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -673,6 +1268,93 @@ func (s *PodService) ListPods(p *ListPodsParams) (*ListPodsResponse, error) {
 	return &r, nil
 }
 
+// StreamPods fetches the results of ListPods page by page in the background and sends them on the
+// returned channel. Both returned channels are closed when done. If fetching a page fails, or the context
+// is done before all results are sent, the error is sent on the error channel and fetching stops. A
+// request for a page in flight is cancelled when the context is done. The page and pagesize set in the
+// params are ignored.
+func (s *PodService) StreamPods(ctx context.Context, p *ListPodsParams) (<-chan *Pod, <-chan error) {
+	ch := make(chan *Pod)
+	errs := make(chan error, 1)
+
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesize(500)
+
+	go func() {
+		defer close(errs)
+		defer close(ch)
+
+		for page, count := 1, 0; ; page++ {
+			pp.SetPage(page)
+
+			l, err := s.ListPodsWithContext(ctx, pp)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			for _, v := range l.Pods {
+				select {
+				case ch <- v:
+				case <-ctx.Done():
+					errs <- ctx.Err()
+					return
+				}
+			}
+
+			count += len(l.Pods)
+			if len(l.Pods) < 500 || count >= l.Count {
+				return
+			}
+		}
+	}()
+
+	return ch, errs
+}
+
+// ListPodsWhere returns all results of ListPods for which pred returns true. Note that the results are
+// filtered client-side, so all results matching the params are fetched first.
+// All pages are fetched, so the page and pagesize set in the params are ignored.
+func (s *PodService) ListPodsWhere(p *ListPodsParams, pred func(*Pod) bool) ([]*Pod, error) {
+	var r []*Pod
+	ch, errs := s.StreamPods(context.Background(), p)
+	for v := range ch {
+		if pred(v) {
+			r = append(r, v)
+		}
+	}
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ListAllPods returns the results of ListPods across all pages, by calling ListPods page by page until all
+// results are fetched. The pagesize set in the params is used for every page (500 if not set), and the
+// page set in the params is ignored.
+func (s *PodService) ListAllPods(p *ListPodsParams) ([]*Pod, error) {
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesizeIfUnset(500)
+	pagesize := pp.p["pagesize"].(int)
+
+	var r []*Pod
+	for page := 1; ; page++ {
+		pp.SetPage(page)
+
+		l, err := s.ListPods(pp)
+		if err != nil {
+			return nil, err
+		}
+		r = append(r, l.Pods...)
+
+		if len(l.Pods) < pagesize || len(r) >= l.Count {
+			return r, nil
+		}
+	}
+}
+
 type ListPodsResponse struct {
 	Count int    `json:"count"`
 	Pods  []*Pod `json:"pod"`
@@ -706,6 +1388,16 @@ type ReleaseDedicatedPodParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ReleaseDedicatedPodParams) Clone() *ReleaseDedicatedPodParams {
+	c := &ReleaseDedicatedPodParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ReleaseDedicatedPodParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -717,6 +1409,16 @@ func (p *ReleaseDedicatedPodParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *ReleaseDedicatedPodParams) validate() error {
+	for _, k := range []string{"podid"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of releaseDedicatedPod", k)
+		}
+	}
+	return nil
+}
+
 func (p *ReleaseDedicatedPodParams) SetPodid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -725,18 +1427,54 @@ func (p *ReleaseDedicatedPodParams) SetPodid(v string) {
 	return
 }
 
+// SetPodidIfUnset sets podid to v, unless it is already set
+func (p *ReleaseDedicatedPodParams) SetPodidIfUnset(v string) {
+	if _, found := p.p["podid"]; !found {
+		p.SetPodid(v)
+	}
+}
+
 // You should always use this function to get a new ReleaseDedicatedPodParams instance,
-// as then you are sure you have configured all required params
-func (s *PodService) NewReleaseDedicatedPodParams(podid string) *ReleaseDedicatedPodParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *PodService) NewReleaseDedicatedPodParams(podid string, opts ...func(*ReleaseDedicatedPodParams)) *ReleaseDedicatedPodParams {
 	p := &ReleaseDedicatedPodParams{}
 	p.p = make(map[string]interface{})
 	p.p["podid"] = podid
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Release the dedication for the pod
-func (s *PodService) ReleaseDedicatedPod(p *ReleaseDedicatedPodParams) (*ReleaseDedicatedPodResponse, error) {
-	resp, err := s.cs.newRequest("releaseDedicatedPod", p.toURLValues())
+func (s *PodService) ReleaseDedicatedPod(p *ReleaseDedicatedPodParams, opts ...OptionFunc) (*ReleaseDedicatedPodResponse, error) {
+	return s.ReleaseDedicatedPodWithContext(context.Background(), p, opts...)
+}
+
+// ReleaseDedicatedPodWithContext is the same as ReleaseDedicatedPod, but the request is cancelled when the context is done
+func (s *PodService) ReleaseDedicatedPodWithContext(ctx context.Context, p *ReleaseDedicatedPodParams, opts ...OptionFunc) (*ReleaseDedicatedPodResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.ReleaseDedicatedPodRawWithContext(ctx, p.toURLValues())
+}
+
+// ReleaseDedicatedPodRaw is the same as ReleaseDedicatedPod, but takes the params as url.Values instead of typed params
+func (s *PodService) ReleaseDedicatedPodRaw(v url.Values) (*ReleaseDedicatedPodResponse, error) {
+	return s.ReleaseDedicatedPodRawWithContext(context.Background(), v)
+}
+
+// ReleaseDedicatedPodRawWithContext is the same as ReleaseDedicatedPodRaw, but the request is cancelled when the context is done
+func (s *PodService) ReleaseDedicatedPodRawWithContext(ctx context.Context, v url.Values) (*ReleaseDedicatedPodResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "releaseDedicatedPod", "releasededicatedpodresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -748,9 +1486,9 @@ func (s *PodService) ReleaseDedicatedPod(p *ReleaseDedicatedPodParams) (*Release
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -774,6 +1512,16 @@ type UpdatePodParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *UpdatePodParams) Clone() *UpdatePodParams {
+	c := &UpdatePodParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *UpdatePodParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -803,6 +1551,16 @@ func (p *UpdatePodParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *UpdatePodParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of updatePod", k)
+		}
+	}
+	return nil
+}
+
 func (p *UpdatePodParams) SetAllocationstate(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -811,6 +1569,13 @@ func (p *UpdatePodParams) SetAllocationstate(v string) {
 	return
 }
 
+// SetAllocationstateIfUnset sets allocationstate to v, unless it is already set
+func (p *UpdatePodParams) SetAllocationstateIfUnset(v string) {
+	if _, found := p.p["allocationstate"]; !found {
+		p.SetAllocationstate(v)
+	}
+}
+
 func (p *UpdatePodParams) SetEndip(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -819,6 +1584,13 @@ func (p *UpdatePodParams) SetEndip(v string) {
 	return
 }
 
+// SetEndipIfUnset sets endip to v, unless it is already set
+func (p *UpdatePodParams) SetEndipIfUnset(v string) {
+	if _, found := p.p["endip"]; !found {
+		p.SetEndip(v)
+	}
+}
+
 func (p *UpdatePodParams) SetGateway(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -827,6 +1599,13 @@ func (p *UpdatePodParams) SetGateway(v string) {
 	return
 }
 
+// SetGatewayIfUnset sets gateway to v, unless it is already set
+func (p *UpdatePodParams) SetGatewayIfUnset(v string) {
+	if _, found := p.p["gateway"]; !found {
+		p.SetGateway(v)
+	}
+}
+
 func (p *UpdatePodParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -835,6 +1614,13 @@ func (p *UpdatePodParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *UpdatePodParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 func (p *UpdatePodParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -843,6 +1629,13 @@ func (p *UpdatePodParams) SetName(v string) {
 	return
 }
 
+// SetNameIfUnset sets name to v, unless it is already set
+func (p *UpdatePodParams) SetNameIfUnset(v string) {
+	if _, found := p.p["name"]; !found {
+		p.SetName(v)
+	}
+}
+
 func (p *UpdatePodParams) SetNetmask(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -851,6 +1644,13 @@ func (p *UpdatePodParams) SetNetmask(v string) {
 	return
 }
 
+// SetNetmaskIfUnset sets netmask to v, unless it is already set
+func (p *UpdatePodParams) SetNetmaskIfUnset(v string) {
+	if _, found := p.p["netmask"]; !found {
+		p.SetNetmask(v)
+	}
+}
+
 func (p *UpdatePodParams) SetStartip(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -859,18 +1659,54 @@ func (p *UpdatePodParams) SetStartip(v string) {
 	return
 }
 
+// SetStartipIfUnset sets startip to v, unless it is already set
+func (p *UpdatePodParams) SetStartipIfUnset(v string) {
+	if _, found := p.p["startip"]; !found {
+		p.SetStartip(v)
+	}
+}
+
 // You should always use this function to get a new UpdatePodParams instance,
-// as then you are sure you have configured all required params
-func (s *PodService) NewUpdatePodParams(id string) *UpdatePodParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *PodService) NewUpdatePodParams(id string, opts ...func(*UpdatePodParams)) *UpdatePodParams {
 	p := &UpdatePodParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Updates a Pod.
-func (s *PodService) UpdatePod(p *UpdatePodParams) (*UpdatePodResponse, error) {
-	resp, err := s.cs.newRequest("updatePod", p.toURLValues())
+func (s *PodService) UpdatePod(p *UpdatePodParams, opts ...OptionFunc) (*UpdatePodResponse, error) {
+	return s.UpdatePodWithContext(context.Background(), p, opts...)
+}
+
+// UpdatePodWithContext is the same as UpdatePod, but the request is cancelled when the context is done
+func (s *PodService) UpdatePodWithContext(ctx context.Context, p *UpdatePodParams, opts ...OptionFunc) (*UpdatePodResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.UpdatePodRawWithContext(ctx, p.toURLValues())
+}
+
+// UpdatePodRaw is the same as UpdatePod, but takes the params as url.Values instead of typed params
+func (s *PodService) UpdatePodRaw(v url.Values) (*UpdatePodResponse, error) {
+	return s.UpdatePodRawWithContext(context.Background(), v)
+}
+
+// UpdatePodRawWithContext is the same as UpdatePodRaw, but the request is cancelled when the context is done
+func (s *PodService) UpdatePodRawWithContext(ctx context.Context, v url.Values) (*UpdatePodResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "updatePod", "updatepodresponse", v)
 	if err != nil {
 		return nil, err
 	}
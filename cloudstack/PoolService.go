@@ -17,17 +17,30 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/url"
 	"strconv"
 	"strings"
+	"time"
 )
 
 type CreateStoragePoolParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *CreateStoragePoolParams) Clone() *CreateStoragePoolParams {
+	c := &CreateStoragePoolParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *CreateStoragePoolParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -45,10 +58,9 @@ func (p *CreateStoragePoolParams) toURLValues() url.Values {
 		u.Set("clusterid", v.(string))
 	}
 	if v, found := p.p["details"]; found {
-		i := 0
-		for k, vv := range v.(map[string]string) {
-			u.Set(fmt.Sprintf("details[%d].%s", i, k), vv)
-			i++
+		m := v.(map[string]string)
+		for i, k := range getSortedKeysFromMap(m) {
+			u.Set(fmt.Sprintf("details[%d].%s", i, k), m[k])
 		}
 	}
 	if v, found := p.p["hypervisor"]; found {
@@ -82,6 +94,16 @@ func (p *CreateStoragePoolParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *CreateStoragePoolParams) validate() error {
+	for _, k := range []string{"name", "url", "zoneid"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of createStoragePool", k)
+		}
+	}
+	return nil
+}
+
 func (p *CreateStoragePoolParams) SetCapacitybytes(v int64) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -90,6 +112,13 @@ func (p *CreateStoragePoolParams) SetCapacitybytes(v int64) {
 	return
 }
 
+// SetCapacitybytesIfUnset sets capacitybytes to v, unless it is already set
+func (p *CreateStoragePoolParams) SetCapacitybytesIfUnset(v int64) {
+	if _, found := p.p["capacitybytes"]; !found {
+		p.SetCapacitybytes(v)
+	}
+}
+
 func (p *CreateStoragePoolParams) SetCapacityiops(v int64) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -98,6 +127,13 @@ func (p *CreateStoragePoolParams) SetCapacityiops(v int64) {
 	return
 }
 
+// SetCapacityiopsIfUnset sets capacityiops to v, unless it is already set
+func (p *CreateStoragePoolParams) SetCapacityiopsIfUnset(v int64) {
+	if _, found := p.p["capacityiops"]; !found {
+		p.SetCapacityiops(v)
+	}
+}
+
 func (p *CreateStoragePoolParams) SetClusterid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -106,6 +142,13 @@ func (p *CreateStoragePoolParams) SetClusterid(v string) {
 	return
 }
 
+// SetClusteridIfUnset sets clusterid to v, unless it is already set
+func (p *CreateStoragePoolParams) SetClusteridIfUnset(v string) {
+	if _, found := p.p["clusterid"]; !found {
+		p.SetClusterid(v)
+	}
+}
+
 func (p *CreateStoragePoolParams) SetDetails(v map[string]string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -114,14 +157,28 @@ func (p *CreateStoragePoolParams) SetDetails(v map[string]string) {
 	return
 }
 
-func (p *CreateStoragePoolParams) SetHypervisor(v string) {
+// SetDetailsIfUnset sets details to v, unless it is already set
+func (p *CreateStoragePoolParams) SetDetailsIfUnset(v map[string]string) {
+	if _, found := p.p["details"]; !found {
+		p.SetDetails(v)
+	}
+}
+
+func (p *CreateStoragePoolParams) SetHypervisor(v HypervisorType) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	p.p["hypervisor"] = v
+	p.p["hypervisor"] = string(v)
 	return
 }
 
+// SetHypervisorIfUnset sets hypervisor to v, unless it is already set
+func (p *CreateStoragePoolParams) SetHypervisorIfUnset(v HypervisorType) {
+	if _, found := p.p["hypervisor"]; !found {
+		p.SetHypervisor(v)
+	}
+}
+
 func (p *CreateStoragePoolParams) SetManaged(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -130,6 +187,13 @@ func (p *CreateStoragePoolParams) SetManaged(v bool) {
 	return
 }
 
+// SetManagedIfUnset sets managed to v, unless it is already set
+func (p *CreateStoragePoolParams) SetManagedIfUnset(v bool) {
+	if _, found := p.p["managed"]; !found {
+		p.SetManaged(v)
+	}
+}
+
 func (p *CreateStoragePoolParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -138,6 +202,13 @@ func (p *CreateStoragePoolParams) SetName(v string) {
 	return
 }
 
+// SetNameIfUnset sets name to v, unless it is already set
+func (p *CreateStoragePoolParams) SetNameIfUnset(v string) {
+	if _, found := p.p["name"]; !found {
+		p.SetName(v)
+	}
+}
+
 func (p *CreateStoragePoolParams) SetPodid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -146,6 +217,13 @@ func (p *CreateStoragePoolParams) SetPodid(v string) {
 	return
 }
 
+// SetPodidIfUnset sets podid to v, unless it is already set
+func (p *CreateStoragePoolParams) SetPodidIfUnset(v string) {
+	if _, found := p.p["podid"]; !found {
+		p.SetPodid(v)
+	}
+}
+
 func (p *CreateStoragePoolParams) SetProvider(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -154,6 +232,13 @@ func (p *CreateStoragePoolParams) SetProvider(v string) {
 	return
 }
 
+// SetProviderIfUnset sets provider to v, unless it is already set
+func (p *CreateStoragePoolParams) SetProviderIfUnset(v string) {
+	if _, found := p.p["provider"]; !found {
+		p.SetProvider(v)
+	}
+}
+
 func (p *CreateStoragePoolParams) SetScope(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -162,6 +247,13 @@ func (p *CreateStoragePoolParams) SetScope(v string) {
 	return
 }
 
+// SetScopeIfUnset sets scope to v, unless it is already set
+func (p *CreateStoragePoolParams) SetScopeIfUnset(v string) {
+	if _, found := p.p["scope"]; !found {
+		p.SetScope(v)
+	}
+}
+
 func (p *CreateStoragePoolParams) SetTags(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -170,6 +262,13 @@ func (p *CreateStoragePoolParams) SetTags(v string) {
 	return
 }
 
+// SetTagsIfUnset sets tags to v, unless it is already set
+func (p *CreateStoragePoolParams) SetTagsIfUnset(v string) {
+	if _, found := p.p["tags"]; !found {
+		p.SetTags(v)
+	}
+}
+
 func (p *CreateStoragePoolParams) SetUrl(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -178,6 +277,13 @@ func (p *CreateStoragePoolParams) SetUrl(v string) {
 	return
 }
 
+// SetUrlIfUnset sets url to v, unless it is already set
+func (p *CreateStoragePoolParams) SetUrlIfUnset(v string) {
+	if _, found := p.p["url"]; !found {
+		p.SetUrl(v)
+	}
+}
+
 func (p *CreateStoragePoolParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -186,20 +292,111 @@ func (p *CreateStoragePoolParams) SetZoneid(v string) {
 	return
 }
 
+// SetZoneidIfUnset sets zoneid to v, unless it is already set
+func (p *CreateStoragePoolParams) SetZoneidIfUnset(v string) {
+	if _, found := p.p["zoneid"]; !found {
+		p.SetZoneid(v)
+	}
+}
+
 // You should always use this function to get a new CreateStoragePoolParams instance,
-// as then you are sure you have configured all required params
-func (s *PoolService) NewCreateStoragePoolParams(name string, url string, zoneid string) *CreateStoragePoolParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *PoolService) NewCreateStoragePoolParams(name string, url string, zoneid string, opts ...func(*CreateStoragePoolParams)) *CreateStoragePoolParams {
 	p := &CreateStoragePoolParams{}
 	p.p = make(map[string]interface{})
 	p.p["name"] = name
 	p.p["url"] = url
 	p.p["zoneid"] = zoneid
+	for _, fn := range opts {
+		fn(p)
+	}
+	return p
+}
+
+// CreateStoragePoolOpts contains all params that can be set on a CreateStoragePoolParams.
+// Optional params are pointers (or slices and maps) which are only set when not nil.
+type CreateStoragePoolOpts struct {
+	Capacitybytes *int64
+	Capacityiops  *int64
+	Clusterid     *string
+	Details       map[string]string
+	Hypervisor    *HypervisorType
+	Managed       *bool
+	Name          string
+	Podid         *string
+	Provider      *string
+	Scope         *string
+	Tags          *string
+	Url           string
+	Zoneid        string
+}
+
+// NewCreateStoragePoolParamsFromOpts is an alternative for NewCreateStoragePoolParams which takes all params using
+// an options struct, as that is easier to read for APIs with many required params
+func (s *PoolService) NewCreateStoragePoolParamsFromOpts(o CreateStoragePoolOpts) *CreateStoragePoolParams {
+	p := s.NewCreateStoragePoolParams(o.Name, o.Url, o.Zoneid)
+	if o.Capacitybytes != nil {
+		p.p["capacitybytes"] = *o.Capacitybytes
+	}
+	if o.Capacityiops != nil {
+		p.p["capacityiops"] = *o.Capacityiops
+	}
+	if o.Clusterid != nil {
+		p.p["clusterid"] = *o.Clusterid
+	}
+	if o.Details != nil {
+		p.p["details"] = o.Details
+	}
+	if o.Hypervisor != nil {
+		p.p["hypervisor"] = string(*o.Hypervisor)
+	}
+	if o.Managed != nil {
+		p.p["managed"] = *o.Managed
+	}
+	if o.Podid != nil {
+		p.p["podid"] = *o.Podid
+	}
+	if o.Provider != nil {
+		p.p["provider"] = *o.Provider
+	}
+	if o.Scope != nil {
+		p.p["scope"] = *o.Scope
+	}
+	if o.Tags != nil {
+		p.p["tags"] = *o.Tags
+	}
 	return p
 }
 
 // Creates a storage pool.
-func (s *PoolService) CreateStoragePool(p *CreateStoragePoolParams) (*CreateStoragePoolResponse, error) {
-	resp, err := s.cs.newRequest("createStoragePool", p.toURLValues())
+func (s *PoolService) CreateStoragePool(p *CreateStoragePoolParams, opts ...OptionFunc) (*CreateStoragePoolResponse, error) {
+	return s.CreateStoragePoolWithContext(context.Background(), p, opts...)
+}
+
+// CreateStoragePoolWithContext is the same as CreateStoragePool, but the request is cancelled when the context is done
+func (s *PoolService) CreateStoragePoolWithContext(ctx context.Context, p *CreateStoragePoolParams, opts ...OptionFunc) (*CreateStoragePoolResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.CreateStoragePoolRawWithContext(ctx, p.toURLValues())
+}
+
+// CreateStoragePoolRaw is the same as CreateStoragePool, but takes the params as url.Values instead of typed params
+func (s *PoolService) CreateStoragePoolRaw(v url.Values) (*CreateStoragePoolResponse, error) {
+	return s.CreateStoragePoolRawWithContext(context.Background(), v)
+}
+
+// CreateStoragePoolRawWithContext is the same as CreateStoragePoolRaw, but the request is cancelled when the context is done
+func (s *PoolService) CreateStoragePoolRawWithContext(ctx context.Context, v url.Values) (*CreateStoragePoolResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "createStoragePool", "createstoragepoolresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -213,35 +410,45 @@ func (s *PoolService) CreateStoragePool(p *CreateStoragePoolParams) (*CreateStor
 }
 
 type CreateStoragePoolResponse struct {
-	Capacityiops         int64             `json:"capacityiops"`
-	Clusterid            string            `json:"clusterid"`
-	Clustername          string            `json:"clustername"`
-	Created              string            `json:"created"`
-	Disksizeallocated    int64             `json:"disksizeallocated"`
-	Disksizetotal        int64             `json:"disksizetotal"`
-	Disksizeused         int64             `json:"disksizeused"`
-	Hypervisor           string            `json:"hypervisor"`
-	Id                   string            `json:"id"`
-	Ipaddress            string            `json:"ipaddress"`
-	Name                 string            `json:"name"`
-	Overprovisionfactor  string            `json:"overprovisionfactor"`
-	Path                 string            `json:"path"`
-	Podid                string            `json:"podid"`
-	Podname              string            `json:"podname"`
-	Scope                string            `json:"scope"`
-	State                string            `json:"state"`
-	Storagecapabilities  map[string]string `json:"storagecapabilities"`
-	Suitableformigration bool              `json:"suitableformigration"`
-	Tags                 string            `json:"tags"`
-	Type                 string            `json:"type"`
-	Zoneid               string            `json:"zoneid"`
-	Zonename             string            `json:"zonename"`
+	Capacityiops         int64     `json:"capacityiops"`
+	Clusterid            string    `json:"clusterid"`
+	Clustername          string    `json:"clustername"`
+	Created              string    `json:"created"`
+	Disksizeallocated    int64     `json:"disksizeallocated"`
+	Disksizetotal        int64     `json:"disksizetotal"`
+	Disksizeused         int64     `json:"disksizeused"`
+	Hypervisor           string    `json:"hypervisor"`
+	Id                   string    `json:"id"`
+	Ipaddress            string    `json:"ipaddress"`
+	Name                 string    `json:"name"`
+	Overprovisionfactor  string    `json:"overprovisionfactor"`
+	Path                 string    `json:"path"`
+	Podid                string    `json:"podid"`
+	Podname              string    `json:"podname"`
+	Scope                string    `json:"scope"`
+	State                string    `json:"state"`
+	Storagecapabilities  StringMap `json:"storagecapabilities"`
+	Suitableformigration bool      `json:"suitableformigration"`
+	Tags                 string    `json:"tags"`
+	Type                 string    `json:"type"`
+	Zoneid               string    `json:"zoneid"`
+	Zonename             string    `json:"zonename"`
 }
 
 type DeleteStoragePoolParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *DeleteStoragePoolParams) Clone() *DeleteStoragePoolParams {
+	c := &DeleteStoragePoolParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeleteStoragePoolParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -257,6 +464,16 @@ func (p *DeleteStoragePoolParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *DeleteStoragePoolParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of deleteStoragePool", k)
+		}
+	}
+	return nil
+}
+
 func (p *DeleteStoragePoolParams) SetForced(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -265,6 +482,13 @@ func (p *DeleteStoragePoolParams) SetForced(v bool) {
 	return
 }
 
+// SetForcedIfUnset sets forced to v, unless it is already set
+func (p *DeleteStoragePoolParams) SetForcedIfUnset(v bool) {
+	if _, found := p.p["forced"]; !found {
+		p.SetForced(v)
+	}
+}
+
 func (p *DeleteStoragePoolParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -273,18 +497,54 @@ func (p *DeleteStoragePoolParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *DeleteStoragePoolParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 // You should always use this function to get a new DeleteStoragePoolParams instance,
-// as then you are sure you have configured all required params
-func (s *PoolService) NewDeleteStoragePoolParams(id string) *DeleteStoragePoolParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *PoolService) NewDeleteStoragePoolParams(id string, opts ...func(*DeleteStoragePoolParams)) *DeleteStoragePoolParams {
 	p := &DeleteStoragePoolParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Deletes a storage pool.
-func (s *PoolService) DeleteStoragePool(p *DeleteStoragePoolParams) (*DeleteStoragePoolResponse, error) {
-	resp, err := s.cs.newRequest("deleteStoragePool", p.toURLValues())
+func (s *PoolService) DeleteStoragePool(p *DeleteStoragePoolParams, opts ...OptionFunc) (*DeleteStoragePoolResponse, error) {
+	return s.DeleteStoragePoolWithContext(context.Background(), p, opts...)
+}
+
+// DeleteStoragePoolWithContext is the same as DeleteStoragePool, but the request is cancelled when the context is done
+func (s *PoolService) DeleteStoragePoolWithContext(ctx context.Context, p *DeleteStoragePoolParams, opts ...OptionFunc) (*DeleteStoragePoolResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.DeleteStoragePoolRawWithContext(ctx, p.toURLValues())
+}
+
+// DeleteStoragePoolRaw is the same as DeleteStoragePool, but takes the params as url.Values instead of typed params
+func (s *PoolService) DeleteStoragePoolRaw(v url.Values) (*DeleteStoragePoolResponse, error) {
+	return s.DeleteStoragePoolRawWithContext(context.Background(), v)
+}
+
+// DeleteStoragePoolRawWithContext is the same as DeleteStoragePoolRaw, but the request is cancelled when the context is done
+func (s *PoolService) DeleteStoragePoolRawWithContext(ctx context.Context, v url.Values) (*DeleteStoragePoolResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteStoragePool", "deletestoragepoolresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -297,6 +557,34 @@ func (s *PoolService) DeleteStoragePool(p *DeleteStoragePoolParams) (*DeleteStor
 	return &r, nil
 }
 
+// WaitForStoragePoolDeleted polls until the StoragePool with the given ID no longer exists, the timeout
+// expires or the context is cancelled. Deleted resources may still be listed for a short while
+// after the delete command finished, so this can be used to make sure they are really gone. The
+// options are passed to GetStoragePoolByID, so a resource outside of the default scope can be found.
+func (s *PoolService) WaitForStoragePoolDeleted(ctx context.Context, id string, timeout time.Duration, opts ...OptionFunc) error {
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	for {
+		_, count, err := s.GetStoragePoolByID(id, opts...)
+		if count == 0 {
+			return nil
+		}
+		if count < 0 {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(2 * time.Second):
+		}
+	}
+}
+
 type DeleteStoragePoolResponse struct {
 	Displaytext string `json:"displaytext"`
 	Success     bool   `json:"success"`
@@ -325,6 +613,16 @@ type FindStoragePoolsForMigrationParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *FindStoragePoolsForMigrationParams) Clone() *FindStoragePoolsForMigrationParams {
+	c := &FindStoragePoolsForMigrationParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *FindStoragePoolsForMigrationParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -347,6 +645,16 @@ func (p *FindStoragePoolsForMigrationParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *FindStoragePoolsForMigrationParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of findStoragePoolsForMigration", k)
+		}
+	}
+	return nil
+}
+
 func (p *FindStoragePoolsForMigrationParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -355,6 +663,13 @@ func (p *FindStoragePoolsForMigrationParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *FindStoragePoolsForMigrationParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 func (p *FindStoragePoolsForMigrationParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -363,6 +678,13 @@ func (p *FindStoragePoolsForMigrationParams) SetKeyword(v string) {
 	return
 }
 
+// SetKeywordIfUnset sets keyword to v, unless it is already set
+func (p *FindStoragePoolsForMigrationParams) SetKeywordIfUnset(v string) {
+	if _, found := p.p["keyword"]; !found {
+		p.SetKeyword(v)
+	}
+}
+
 func (p *FindStoragePoolsForMigrationParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -371,6 +693,13 @@ func (p *FindStoragePoolsForMigrationParams) SetPage(v int) {
 	return
 }
 
+// SetPageIfUnset sets page to v, unless it is already set
+func (p *FindStoragePoolsForMigrationParams) SetPageIfUnset(v int) {
+	if _, found := p.p["page"]; !found {
+		p.SetPage(v)
+	}
+}
+
 func (p *FindStoragePoolsForMigrationParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -379,18 +708,54 @@ func (p *FindStoragePoolsForMigrationParams) SetPagesize(v int) {
 	return
 }
 
+// SetPagesizeIfUnset sets pagesize to v, unless it is already set
+func (p *FindStoragePoolsForMigrationParams) SetPagesizeIfUnset(v int) {
+	if _, found := p.p["pagesize"]; !found {
+		p.SetPagesize(v)
+	}
+}
+
 // You should always use this function to get a new FindStoragePoolsForMigrationParams instance,
-// as then you are sure you have configured all required params
-func (s *PoolService) NewFindStoragePoolsForMigrationParams(id string) *FindStoragePoolsForMigrationParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *PoolService) NewFindStoragePoolsForMigrationParams(id string, opts ...func(*FindStoragePoolsForMigrationParams)) *FindStoragePoolsForMigrationParams {
 	p := &FindStoragePoolsForMigrationParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Lists storage pools available for migration of a volume.
-func (s *PoolService) FindStoragePoolsForMigration(p *FindStoragePoolsForMigrationParams) (*FindStoragePoolsForMigrationResponse, error) {
-	resp, err := s.cs.newRequest("findStoragePoolsForMigration", p.toURLValues())
+func (s *PoolService) FindStoragePoolsForMigration(p *FindStoragePoolsForMigrationParams, opts ...OptionFunc) (*FindStoragePoolsForMigrationResponse, error) {
+	return s.FindStoragePoolsForMigrationWithContext(context.Background(), p, opts...)
+}
+
+// FindStoragePoolsForMigrationWithContext is the same as FindStoragePoolsForMigration, but the request is cancelled when the context is done
+func (s *PoolService) FindStoragePoolsForMigrationWithContext(ctx context.Context, p *FindStoragePoolsForMigrationParams, opts ...OptionFunc) (*FindStoragePoolsForMigrationResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.FindStoragePoolsForMigrationRawWithContext(ctx, p.toURLValues())
+}
+
+// FindStoragePoolsForMigrationRaw is the same as FindStoragePoolsForMigration, but takes the params as url.Values instead of typed params
+func (s *PoolService) FindStoragePoolsForMigrationRaw(v url.Values) (*FindStoragePoolsForMigrationResponse, error) {
+	return s.FindStoragePoolsForMigrationRawWithContext(context.Background(), v)
+}
+
+// FindStoragePoolsForMigrationRawWithContext is the same as FindStoragePoolsForMigrationRaw, but the request is cancelled when the context is done
+func (s *PoolService) FindStoragePoolsForMigrationRawWithContext(ctx context.Context, v url.Values) (*FindStoragePoolsForMigrationResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "findStoragePoolsForMigration", "findstoragepoolsformigrationresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -404,35 +769,45 @@ func (s *PoolService) FindStoragePoolsForMigration(p *FindStoragePoolsForMigrati
 }
 
 type FindStoragePoolsForMigrationResponse struct {
-	Capacityiops         int64             `json:"capacityiops"`
-	Clusterid            string            `json:"clusterid"`
-	Clustername          string            `json:"clustername"`
-	Created              string            `json:"created"`
-	Disksizeallocated    int64             `json:"disksizeallocated"`
-	Disksizetotal        int64             `json:"disksizetotal"`
-	Disksizeused         int64             `json:"disksizeused"`
-	Hypervisor           string            `json:"hypervisor"`
-	Id                   string            `json:"id"`
-	Ipaddress            string            `json:"ipaddress"`
-	Name                 string            `json:"name"`
-	Overprovisionfactor  string            `json:"overprovisionfactor"`
-	Path                 string            `json:"path"`
-	Podid                string            `json:"podid"`
-	Podname              string            `json:"podname"`
-	Scope                string            `json:"scope"`
-	State                string            `json:"state"`
-	Storagecapabilities  map[string]string `json:"storagecapabilities"`
-	Suitableformigration bool              `json:"suitableformigration"`
-	Tags                 string            `json:"tags"`
-	Type                 string            `json:"type"`
-	Zoneid               string            `json:"zoneid"`
-	Zonename             string            `json:"zonename"`
+	Capacityiops         int64     `json:"capacityiops"`
+	Clusterid            string    `json:"clusterid"`
+	Clustername          string    `json:"clustername"`
+	Created              string    `json:"created"`
+	Disksizeallocated    int64     `json:"disksizeallocated"`
+	Disksizetotal        int64     `json:"disksizetotal"`
+	Disksizeused         int64     `json:"disksizeused"`
+	Hypervisor           string    `json:"hypervisor"`
+	Id                   string    `json:"id"`
+	Ipaddress            string    `json:"ipaddress"`
+	Name                 string    `json:"name"`
+	Overprovisionfactor  string    `json:"overprovisionfactor"`
+	Path                 string    `json:"path"`
+	Podid                string    `json:"podid"`
+	Podname              string    `json:"podname"`
+	Scope                string    `json:"scope"`
+	State                string    `json:"state"`
+	Storagecapabilities  StringMap `json:"storagecapabilities"`
+	Suitableformigration bool      `json:"suitableformigration"`
+	Tags                 string    `json:"tags"`
+	Type                 string    `json:"type"`
+	Zoneid               string    `json:"zoneid"`
+	Zonename             string    `json:"zonename"`
 }
 
 type ListStoragePoolsParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ListStoragePoolsParams) Clone() *ListStoragePoolsParams {
+	c := &ListStoragePoolsParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListStoragePoolsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -484,6 +859,13 @@ func (p *ListStoragePoolsParams) SetClusterid(v string) {
 	return
 }
 
+// SetClusteridIfUnset sets clusterid to v, unless it is already set
+func (p *ListStoragePoolsParams) SetClusteridIfUnset(v string) {
+	if _, found := p.p["clusterid"]; !found {
+		p.SetClusterid(v)
+	}
+}
+
 func (p *ListStoragePoolsParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -492,6 +874,13 @@ func (p *ListStoragePoolsParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *ListStoragePoolsParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 func (p *ListStoragePoolsParams) SetIpaddress(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -500,6 +889,13 @@ func (p *ListStoragePoolsParams) SetIpaddress(v string) {
 	return
 }
 
+// SetIpaddressIfUnset sets ipaddress to v, unless it is already set
+func (p *ListStoragePoolsParams) SetIpaddressIfUnset(v string) {
+	if _, found := p.p["ipaddress"]; !found {
+		p.SetIpaddress(v)
+	}
+}
+
 func (p *ListStoragePoolsParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -508,6 +904,13 @@ func (p *ListStoragePoolsParams) SetKeyword(v string) {
 	return
 }
 
+// SetKeywordIfUnset sets keyword to v, unless it is already set
+func (p *ListStoragePoolsParams) SetKeywordIfUnset(v string) {
+	if _, found := p.p["keyword"]; !found {
+		p.SetKeyword(v)
+	}
+}
+
 func (p *ListStoragePoolsParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -516,6 +919,13 @@ func (p *ListStoragePoolsParams) SetName(v string) {
 	return
 }
 
+// SetNameIfUnset sets name to v, unless it is already set
+func (p *ListStoragePoolsParams) SetNameIfUnset(v string) {
+	if _, found := p.p["name"]; !found {
+		p.SetName(v)
+	}
+}
+
 func (p *ListStoragePoolsParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -524,6 +934,13 @@ func (p *ListStoragePoolsParams) SetPage(v int) {
 	return
 }
 
+// SetPageIfUnset sets page to v, unless it is already set
+func (p *ListStoragePoolsParams) SetPageIfUnset(v int) {
+	if _, found := p.p["page"]; !found {
+		p.SetPage(v)
+	}
+}
+
 func (p *ListStoragePoolsParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -532,6 +949,13 @@ func (p *ListStoragePoolsParams) SetPagesize(v int) {
 	return
 }
 
+// SetPagesizeIfUnset sets pagesize to v, unless it is already set
+func (p *ListStoragePoolsParams) SetPagesizeIfUnset(v int) {
+	if _, found := p.p["pagesize"]; !found {
+		p.SetPagesize(v)
+	}
+}
+
 func (p *ListStoragePoolsParams) SetPath(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -540,6 +964,13 @@ func (p *ListStoragePoolsParams) SetPath(v string) {
 	return
 }
 
+// SetPathIfUnset sets path to v, unless it is already set
+func (p *ListStoragePoolsParams) SetPathIfUnset(v string) {
+	if _, found := p.p["path"]; !found {
+		p.SetPath(v)
+	}
+}
+
 func (p *ListStoragePoolsParams) SetPodid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -548,6 +979,13 @@ func (p *ListStoragePoolsParams) SetPodid(v string) {
 	return
 }
 
+// SetPodidIfUnset sets podid to v, unless it is already set
+func (p *ListStoragePoolsParams) SetPodidIfUnset(v string) {
+	if _, found := p.p["podid"]; !found {
+		p.SetPodid(v)
+	}
+}
+
 func (p *ListStoragePoolsParams) SetScope(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -556,6 +994,13 @@ func (p *ListStoragePoolsParams) SetScope(v string) {
 	return
 }
 
+// SetScopeIfUnset sets scope to v, unless it is already set
+func (p *ListStoragePoolsParams) SetScopeIfUnset(v string) {
+	if _, found := p.p["scope"]; !found {
+		p.SetScope(v)
+	}
+}
+
 func (p *ListStoragePoolsParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -564,14 +1009,32 @@ func (p *ListStoragePoolsParams) SetZoneid(v string) {
 	return
 }
 
+// SetZoneidIfUnset sets zoneid to v, unless it is already set
+func (p *ListStoragePoolsParams) SetZoneidIfUnset(v string) {
+	if _, found := p.p["zoneid"]; !found {
+		p.SetZoneid(v)
+	}
+}
+
 // You should always use this function to get a new ListStoragePoolsParams instance,
-// as then you are sure you have configured all required params
-func (s *PoolService) NewListStoragePoolsParams() *ListStoragePoolsParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *PoolService) NewListStoragePoolsParams(opts ...func(*ListStoragePoolsParams)) *ListStoragePoolsParams {
 	p := &ListStoragePoolsParams{}
 	p.p = make(map[string]interface{})
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
+func (p *ListStoragePoolsParams) setFirstMatch() {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p[firstMatchKey] = true
+}
+
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *PoolService) GetStoragePoolID(name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListStoragePoolsParams{}
@@ -579,7 +1042,7 @@ func (s *PoolService) GetStoragePoolID(name string, opts ...OptionFunc) (string,
 
 	p.p["name"] = name
 
-	for _, fn := range append(s.cs.options, opts...) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
 		if err := fn(s.cs, p); err != nil {
 			return "", -1, err
 		}
@@ -591,7 +1054,7 @@ func (s *PoolService) GetStoragePoolID(name string, opts ...OptionFunc) (string,
 	}
 
 	if l.Count == 0 {
-		return "", l.Count, fmt.Errorf("No match found for %s: %+v", name, l)
+		return "", l.Count, fmt.Errorf("%w for %s: %+v", ErrNotFound, name, l)
 	}
 
 	if l.Count == 1 {
@@ -604,6 +1067,10 @@ func (s *PoolService) GetStoragePoolID(name string, opts ...OptionFunc) (string,
 				return v.Id, l.Count, nil
 			}
 		}
+
+		if _, ok := p.p[firstMatchKey]; ok && len(l.StoragePools) > 0 {
+			return l.StoragePools[0].Id, l.Count, nil
+		}
 	}
 	return "", l.Count, fmt.Errorf("Could not find an exact match for %s: %+v", name, l)
 }
@@ -629,7 +1096,7 @@ func (s *PoolService) GetStoragePoolByID(id string, opts ...OptionFunc) (*Storag
 
 	p.p["id"] = id
 
-	for _, fn := range append(s.cs.options, opts...) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
 		if err := fn(s.cs, p); err != nil {
 			return nil, -1, err
 		}
@@ -640,24 +1107,56 @@ func (s *PoolService) GetStoragePoolByID(id string, opts ...OptionFunc) (*Storag
 		if strings.Contains(err.Error(), fmt.Sprintf(
 			"Invalid parameter id value=%s due to incorrect long value format, "+
 				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+			return nil, 0, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
 	}
 
 	if l.Count == 1 {
 		return l.StoragePools[0], l.Count, nil
 	}
+
+	if _, ok := p.p[firstMatchKey]; ok && len(l.StoragePools) > 0 {
+		return l.StoragePools[0], l.Count, nil
+	}
 	return nil, l.Count, fmt.Errorf("There is more then one result for StoragePool UUID: %s!", id)
 }
 
+// StoragePoolExists returns true if a StoragePool with the given ID exists. When no match is found
+// it returns false, while any other error is returned as is.
+func (s *PoolService) StoragePoolExists(id string, opts ...OptionFunc) (bool, error) {
+	_, count, err := s.GetStoragePoolByID(id, opts...)
+	if errors.Is(err, ErrNotFound) {
+		return false, nil
+	}
+	if count > 0 {
+		return true, nil
+	}
+	return false, err
+}
+
 // Lists storage pools.
 func (s *PoolService) ListStoragePools(p *ListStoragePoolsParams) (*ListStoragePoolsResponse, error) {
-	resp, err := s.cs.newRequest("listStoragePools", p.toURLValues())
+	return s.ListStoragePoolsWithContext(context.Background(), p)
+}
+
+// ListStoragePoolsWithContext is the same as ListStoragePools, but the request is cancelled when the context is done
+func (s *PoolService) ListStoragePoolsWithContext(ctx context.Context, p *ListStoragePoolsParams) (*ListStoragePoolsResponse, error) {
+	return s.ListStoragePoolsRawWithContext(ctx, p.toURLValues())
+}
+
+// ListStoragePoolsRaw is the same as ListStoragePools, but takes the params as url.Values instead of typed params
+func (s *PoolService) ListStoragePoolsRaw(v url.Values) (*ListStoragePoolsResponse, error) {
+	return s.ListStoragePoolsRawWithContext(context.Background(), v)
+}
+
+// ListStoragePoolsRawWithContext is the same as ListStoragePoolsRaw, but the request is cancelled when the context is done
+func (s *PoolService) ListStoragePoolsRawWithContext(ctx context.Context, v url.Values) (*ListStoragePoolsResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "listStoragePools", "liststoragepoolsresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -670,41 +1169,138 @@ func (s *PoolService) ListStoragePools(p *ListStoragePoolsParams) (*ListStorageP
 	return &r, nil
 }
 
+// StreamStoragePools fetches the results of ListStoragePools page by page in the background and sends them on the
+// returned channel. Both returned channels are closed when done. If fetching a page fails, or the context
+// is done before all results are sent, the error is sent on the error channel and fetching stops. A
+// request for a page in flight is cancelled when the context is done. The page and pagesize set in the
+// params are ignored.
+func (s *PoolService) StreamStoragePools(ctx context.Context, p *ListStoragePoolsParams) (<-chan *StoragePool, <-chan error) {
+	ch := make(chan *StoragePool)
+	errs := make(chan error, 1)
+
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesize(500)
+
+	go func() {
+		defer close(errs)
+		defer close(ch)
+
+		for page, count := 1, 0; ; page++ {
+			pp.SetPage(page)
+
+			l, err := s.ListStoragePoolsWithContext(ctx, pp)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			for _, v := range l.StoragePools {
+				select {
+				case ch <- v:
+				case <-ctx.Done():
+					errs <- ctx.Err()
+					return
+				}
+			}
+
+			count += len(l.StoragePools)
+			if len(l.StoragePools) < 500 || count >= l.Count {
+				return
+			}
+		}
+	}()
+
+	return ch, errs
+}
+
+// ListStoragePoolsWhere returns all results of ListStoragePools for which pred returns true. Note that the results are
+// filtered client-side, so all results matching the params are fetched first.
+// All pages are fetched, so the page and pagesize set in the params are ignored.
+func (s *PoolService) ListStoragePoolsWhere(p *ListStoragePoolsParams, pred func(*StoragePool) bool) ([]*StoragePool, error) {
+	var r []*StoragePool
+	ch, errs := s.StreamStoragePools(context.Background(), p)
+	for v := range ch {
+		if pred(v) {
+			r = append(r, v)
+		}
+	}
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ListAllStoragePools returns the results of ListStoragePools across all pages, by calling ListStoragePools page by page until all
+// results are fetched. The pagesize set in the params is used for every page (500 if not set), and the
+// page set in the params is ignored.
+func (s *PoolService) ListAllStoragePools(p *ListStoragePoolsParams) ([]*StoragePool, error) {
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesizeIfUnset(500)
+	pagesize := pp.p["pagesize"].(int)
+
+	var r []*StoragePool
+	for page := 1; ; page++ {
+		pp.SetPage(page)
+
+		l, err := s.ListStoragePools(pp)
+		if err != nil {
+			return nil, err
+		}
+		r = append(r, l.StoragePools...)
+
+		if len(l.StoragePools) < pagesize || len(r) >= l.Count {
+			return r, nil
+		}
+	}
+}
+
 type ListStoragePoolsResponse struct {
 	Count        int            `json:"count"`
 	StoragePools []*StoragePool `json:"storagepool"`
 }
 
 type StoragePool struct {
-	Capacityiops         int64             `json:"capacityiops"`
-	Clusterid            string            `json:"clusterid"`
-	Clustername          string            `json:"clustername"`
-	Created              string            `json:"created"`
-	Disksizeallocated    int64             `json:"disksizeallocated"`
-	Disksizetotal        int64             `json:"disksizetotal"`
-	Disksizeused         int64             `json:"disksizeused"`
-	Hypervisor           string            `json:"hypervisor"`
-	Id                   string            `json:"id"`
-	Ipaddress            string            `json:"ipaddress"`
-	Name                 string            `json:"name"`
-	Overprovisionfactor  string            `json:"overprovisionfactor"`
-	Path                 string            `json:"path"`
-	Podid                string            `json:"podid"`
-	Podname              string            `json:"podname"`
-	Scope                string            `json:"scope"`
-	State                string            `json:"state"`
-	Storagecapabilities  map[string]string `json:"storagecapabilities"`
-	Suitableformigration bool              `json:"suitableformigration"`
-	Tags                 string            `json:"tags"`
-	Type                 string            `json:"type"`
-	Zoneid               string            `json:"zoneid"`
-	Zonename             string            `json:"zonename"`
+	Capacityiops         int64     `json:"capacityiops"`
+	Clusterid            string    `json:"clusterid"`
+	Clustername          string    `json:"clustername"`
+	Created              string    `json:"created"`
+	Disksizeallocated    int64     `json:"disksizeallocated"`
+	Disksizetotal        int64     `json:"disksizetotal"`
+	Disksizeused         int64     `json:"disksizeused"`
+	Hypervisor           string    `json:"hypervisor"`
+	Id                   string    `json:"id"`
+	Ipaddress            string    `json:"ipaddress"`
+	Name                 string    `json:"name"`
+	Overprovisionfactor  string    `json:"overprovisionfactor"`
+	Path                 string    `json:"path"`
+	Podid                string    `json:"podid"`
+	Podname              string    `json:"podname"`
+	Scope                string    `json:"scope"`
+	State                string    `json:"state"`
+	Storagecapabilities  StringMap `json:"storagecapabilities"`
+	Suitableformigration bool      `json:"suitableformigration"`
+	Tags                 string    `json:"tags"`
+	Type                 string    `json:"type"`
+	Zoneid               string    `json:"zoneid"`
+	Zonename             string    `json:"zonename"`
 }
 
 type UpdateStoragePoolParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *UpdateStoragePoolParams) Clone() *UpdateStoragePoolParams {
+	c := &UpdateStoragePoolParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *UpdateStoragePoolParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -732,6 +1328,16 @@ func (p *UpdateStoragePoolParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *UpdateStoragePoolParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of updateStoragePool", k)
+		}
+	}
+	return nil
+}
+
 func (p *UpdateStoragePoolParams) SetCapacitybytes(v int64) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -740,6 +1346,13 @@ func (p *UpdateStoragePoolParams) SetCapacitybytes(v int64) {
 	return
 }
 
+// SetCapacitybytesIfUnset sets capacitybytes to v, unless it is already set
+func (p *UpdateStoragePoolParams) SetCapacitybytesIfUnset(v int64) {
+	if _, found := p.p["capacitybytes"]; !found {
+		p.SetCapacitybytes(v)
+	}
+}
+
 func (p *UpdateStoragePoolParams) SetCapacityiops(v int64) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -748,6 +1361,13 @@ func (p *UpdateStoragePoolParams) SetCapacityiops(v int64) {
 	return
 }
 
+// SetCapacityiopsIfUnset sets capacityiops to v, unless it is already set
+func (p *UpdateStoragePoolParams) SetCapacityiopsIfUnset(v int64) {
+	if _, found := p.p["capacityiops"]; !found {
+		p.SetCapacityiops(v)
+	}
+}
+
 func (p *UpdateStoragePoolParams) SetEnabled(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -756,6 +1376,13 @@ func (p *UpdateStoragePoolParams) SetEnabled(v bool) {
 	return
 }
 
+// SetEnabledIfUnset sets enabled to v, unless it is already set
+func (p *UpdateStoragePoolParams) SetEnabledIfUnset(v bool) {
+	if _, found := p.p["enabled"]; !found {
+		p.SetEnabled(v)
+	}
+}
+
 func (p *UpdateStoragePoolParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -764,6 +1391,13 @@ func (p *UpdateStoragePoolParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *UpdateStoragePoolParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 func (p *UpdateStoragePoolParams) SetTags(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -772,18 +1406,59 @@ func (p *UpdateStoragePoolParams) SetTags(v []string) {
 	return
 }
 
+// SetTagsIfUnset sets tags to v, unless it is already set
+func (p *UpdateStoragePoolParams) SetTagsIfUnset(v []string) {
+	if _, found := p.p["tags"]; !found {
+		p.SetTags(v)
+	}
+}
+
+// SetTagsCSV splits v on commas and sets the resulting values
+func (p *UpdateStoragePoolParams) SetTagsCSV(v string) {
+	p.SetTags(splitCSV(v))
+}
+
 // You should always use this function to get a new UpdateStoragePoolParams instance,
-// as then you are sure you have configured all required params
-func (s *PoolService) NewUpdateStoragePoolParams(id string) *UpdateStoragePoolParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *PoolService) NewUpdateStoragePoolParams(id string, opts ...func(*UpdateStoragePoolParams)) *UpdateStoragePoolParams {
 	p := &UpdateStoragePoolParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Updates a storage pool.
-func (s *PoolService) UpdateStoragePool(p *UpdateStoragePoolParams) (*UpdateStoragePoolResponse, error) {
-	resp, err := s.cs.newRequest("updateStoragePool", p.toURLValues())
+func (s *PoolService) UpdateStoragePool(p *UpdateStoragePoolParams, opts ...OptionFunc) (*UpdateStoragePoolResponse, error) {
+	return s.UpdateStoragePoolWithContext(context.Background(), p, opts...)
+}
+
+// UpdateStoragePoolWithContext is the same as UpdateStoragePool, but the request is cancelled when the context is done
+func (s *PoolService) UpdateStoragePoolWithContext(ctx context.Context, p *UpdateStoragePoolParams, opts ...OptionFunc) (*UpdateStoragePoolResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.UpdateStoragePoolRawWithContext(ctx, p.toURLValues())
+}
+
+// UpdateStoragePoolRaw is the same as UpdateStoragePool, but takes the params as url.Values instead of typed params
+func (s *PoolService) UpdateStoragePoolRaw(v url.Values) (*UpdateStoragePoolResponse, error) {
+	return s.UpdateStoragePoolRawWithContext(context.Background(), v)
+}
+
+// UpdateStoragePoolRawWithContext is the same as UpdateStoragePoolRaw, but the request is cancelled when the context is done
+func (s *PoolService) UpdateStoragePoolRawWithContext(ctx context.Context, v url.Values) (*UpdateStoragePoolResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "updateStoragePool", "updatestoragepoolresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -797,27 +1472,27 @@ func (s *PoolService) UpdateStoragePool(p *UpdateStoragePoolParams) (*UpdateStor
 }
 
 type UpdateStoragePoolResponse struct {
-	Capacityiops         int64             `json:"capacityiops"`
-	Clusterid            string            `json:"clusterid"`
-	Clustername          string            `json:"clustername"`
-	Created              string            `json:"created"`
-	Disksizeallocated    int64             `json:"disksizeallocated"`
-	Disksizetotal        int64             `json:"disksizetotal"`
-	Disksizeused         int64             `json:"disksizeused"`
-	Hypervisor           string            `json:"hypervisor"`
-	Id                   string            `json:"id"`
-	Ipaddress            string            `json:"ipaddress"`
-	Name                 string            `json:"name"`
-	Overprovisionfactor  string            `json:"overprovisionfactor"`
-	Path                 string            `json:"path"`
-	Podid                string            `json:"podid"`
-	Podname              string            `json:"podname"`
-	Scope                string            `json:"scope"`
-	State                string            `json:"state"`
-	Storagecapabilities  map[string]string `json:"storagecapabilities"`
-	Suitableformigration bool              `json:"suitableformigration"`
-	Tags                 string            `json:"tags"`
-	Type                 string            `json:"type"`
-	Zoneid               string            `json:"zoneid"`
-	Zonename             string            `json:"zonename"`
+	Capacityiops         int64     `json:"capacityiops"`
+	Clusterid            string    `json:"clusterid"`
+	Clustername          string    `json:"clustername"`
+	Created              string    `json:"created"`
+	Disksizeallocated    int64     `json:"disksizeallocated"`
+	Disksizetotal        int64     `json:"disksizetotal"`
+	Disksizeused         int64     `json:"disksizeused"`
+	Hypervisor           string    `json:"hypervisor"`
+	Id                   string    `json:"id"`
+	Ipaddress            string    `json:"ipaddress"`
+	Name                 string    `json:"name"`
+	Overprovisionfactor  string    `json:"overprovisionfactor"`
+	Path                 string    `json:"path"`
+	Podid                string    `json:"podid"`
+	Podname              string    `json:"podname"`
+	Scope                string    `json:"scope"`
+	State                string    `json:"state"`
+	Storagecapabilities  StringMap `json:"storagecapabilities"`
+	Suitableformigration bool      `json:"suitableformigration"`
+	Tags                 string    `json:"tags"`
+	Type                 string    `json:"type"`
+	Zoneid               string    `json:"zoneid"`
+	Zonename             string    `json:"zonename"`
 }
@@ -17,17 +17,30 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/url"
 	"strconv"
 	"strings"
+	"time"
 )
 
 type CreatePortableIpRangeParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *CreatePortableIpRangeParams) Clone() *CreatePortableIpRangeParams {
+	c := &CreatePortableIpRangeParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *CreatePortableIpRangeParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -55,6 +68,16 @@ func (p *CreatePortableIpRangeParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *CreatePortableIpRangeParams) validate() error {
+	for _, k := range []string{"endip", "gateway", "netmask", "regionid", "startip"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of createPortableIpRange", k)
+		}
+	}
+	return nil
+}
+
 func (p *CreatePortableIpRangeParams) SetEndip(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -63,6 +86,13 @@ func (p *CreatePortableIpRangeParams) SetEndip(v string) {
 	return
 }
 
+// SetEndipIfUnset sets endip to v, unless it is already set
+func (p *CreatePortableIpRangeParams) SetEndipIfUnset(v string) {
+	if _, found := p.p["endip"]; !found {
+		p.SetEndip(v)
+	}
+}
+
 func (p *CreatePortableIpRangeParams) SetGateway(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -71,6 +101,13 @@ func (p *CreatePortableIpRangeParams) SetGateway(v string) {
 	return
 }
 
+// SetGatewayIfUnset sets gateway to v, unless it is already set
+func (p *CreatePortableIpRangeParams) SetGatewayIfUnset(v string) {
+	if _, found := p.p["gateway"]; !found {
+		p.SetGateway(v)
+	}
+}
+
 func (p *CreatePortableIpRangeParams) SetNetmask(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -79,6 +116,13 @@ func (p *CreatePortableIpRangeParams) SetNetmask(v string) {
 	return
 }
 
+// SetNetmaskIfUnset sets netmask to v, unless it is already set
+func (p *CreatePortableIpRangeParams) SetNetmaskIfUnset(v string) {
+	if _, found := p.p["netmask"]; !found {
+		p.SetNetmask(v)
+	}
+}
+
 func (p *CreatePortableIpRangeParams) SetRegionid(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -87,6 +131,13 @@ func (p *CreatePortableIpRangeParams) SetRegionid(v int) {
 	return
 }
 
+// SetRegionidIfUnset sets regionid to v, unless it is already set
+func (p *CreatePortableIpRangeParams) SetRegionidIfUnset(v int) {
+	if _, found := p.p["regionid"]; !found {
+		p.SetRegionid(v)
+	}
+}
+
 func (p *CreatePortableIpRangeParams) SetStartip(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -95,6 +146,13 @@ func (p *CreatePortableIpRangeParams) SetStartip(v string) {
 	return
 }
 
+// SetStartipIfUnset sets startip to v, unless it is already set
+func (p *CreatePortableIpRangeParams) SetStartipIfUnset(v string) {
+	if _, found := p.p["startip"]; !found {
+		p.SetStartip(v)
+	}
+}
+
 func (p *CreatePortableIpRangeParams) SetVlan(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -103,9 +161,17 @@ func (p *CreatePortableIpRangeParams) SetVlan(v string) {
 	return
 }
 
+// SetVlanIfUnset sets vlan to v, unless it is already set
+func (p *CreatePortableIpRangeParams) SetVlanIfUnset(v string) {
+	if _, found := p.p["vlan"]; !found {
+		p.SetVlan(v)
+	}
+}
+
 // You should always use this function to get a new CreatePortableIpRangeParams instance,
-// as then you are sure you have configured all required params
-func (s *PortableIPService) NewCreatePortableIpRangeParams(endip string, gateway string, netmask string, regionid int, startip string) *CreatePortableIpRangeParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *PortableIPService) NewCreatePortableIpRangeParams(endip string, gateway string, netmask string, regionid int, startip string, opts ...func(*CreatePortableIpRangeParams)) *CreatePortableIpRangeParams {
 	p := &CreatePortableIpRangeParams{}
 	p.p = make(map[string]interface{})
 	p.p["endip"] = endip
@@ -113,12 +179,61 @@ func (s *PortableIPService) NewCreatePortableIpRangeParams(endip string, gateway
 	p.p["netmask"] = netmask
 	p.p["regionid"] = regionid
 	p.p["startip"] = startip
+	for _, fn := range opts {
+		fn(p)
+	}
+	return p
+}
+
+// CreatePortableIpRangeOpts contains all params that can be set on a CreatePortableIpRangeParams.
+// Optional params are pointers (or slices and maps) which are only set when not nil.
+type CreatePortableIpRangeOpts struct {
+	Endip    string
+	Gateway  string
+	Netmask  string
+	Regionid int
+	Startip  string
+	Vlan     *string
+}
+
+// NewCreatePortableIpRangeParamsFromOpts is an alternative for NewCreatePortableIpRangeParams which takes all params using
+// an options struct, as that is easier to read for APIs with many required params
+func (s *PortableIPService) NewCreatePortableIpRangeParamsFromOpts(o CreatePortableIpRangeOpts) *CreatePortableIpRangeParams {
+	p := s.NewCreatePortableIpRangeParams(o.Endip, o.Gateway, o.Netmask, o.Regionid, o.Startip)
+	if o.Vlan != nil {
+		p.p["vlan"] = *o.Vlan
+	}
 	return p
 }
 
 // adds a range of portable public IP's to a region
-func (s *PortableIPService) CreatePortableIpRange(p *CreatePortableIpRangeParams) (*CreatePortableIpRangeResponse, error) {
-	resp, err := s.cs.newRequest("createPortableIpRange", p.toURLValues())
+func (s *PortableIPService) CreatePortableIpRange(p *CreatePortableIpRangeParams, opts ...OptionFunc) (*CreatePortableIpRangeResponse, error) {
+	return s.CreatePortableIpRangeWithContext(context.Background(), p, opts...)
+}
+
+// CreatePortableIpRangeWithContext is the same as CreatePortableIpRange, but the request is cancelled when the context is done
+func (s *PortableIPService) CreatePortableIpRangeWithContext(ctx context.Context, p *CreatePortableIpRangeParams, opts ...OptionFunc) (*CreatePortableIpRangeResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.CreatePortableIpRangeRawWithContext(ctx, p.toURLValues())
+}
+
+// CreatePortableIpRangeRaw is the same as CreatePortableIpRange, but takes the params as url.Values instead of typed params
+func (s *PortableIPService) CreatePortableIpRangeRaw(v url.Values) (*CreatePortableIpRangeResponse, error) {
+	return s.CreatePortableIpRangeRawWithContext(context.Background(), v)
+}
+
+// CreatePortableIpRangeRawWithContext is the same as CreatePortableIpRangeRaw, but the request is cancelled when the context is done
+func (s *PortableIPService) CreatePortableIpRangeRawWithContext(ctx context.Context, v url.Values) (*CreatePortableIpRangeResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "createPortableIpRange", "createportableiprangeresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -130,9 +245,9 @@ func (s *PortableIPService) CreatePortableIpRange(p *CreatePortableIpRangeParams
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -178,6 +293,16 @@ type DeletePortableIpRangeParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *DeletePortableIpRangeParams) Clone() *DeletePortableIpRangeParams {
+	c := &DeletePortableIpRangeParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeletePortableIpRangeParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -189,6 +314,16 @@ func (p *DeletePortableIpRangeParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *DeletePortableIpRangeParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of deletePortableIpRange", k)
+		}
+	}
+	return nil
+}
+
 func (p *DeletePortableIpRangeParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -197,18 +332,54 @@ func (p *DeletePortableIpRangeParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *DeletePortableIpRangeParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 // You should always use this function to get a new DeletePortableIpRangeParams instance,
-// as then you are sure you have configured all required params
-func (s *PortableIPService) NewDeletePortableIpRangeParams(id string) *DeletePortableIpRangeParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *PortableIPService) NewDeletePortableIpRangeParams(id string, opts ...func(*DeletePortableIpRangeParams)) *DeletePortableIpRangeParams {
 	p := &DeletePortableIpRangeParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // deletes a range of portable public IP's associated with a region
-func (s *PortableIPService) DeletePortableIpRange(p *DeletePortableIpRangeParams) (*DeletePortableIpRangeResponse, error) {
-	resp, err := s.cs.newRequest("deletePortableIpRange", p.toURLValues())
+func (s *PortableIPService) DeletePortableIpRange(p *DeletePortableIpRangeParams, opts ...OptionFunc) (*DeletePortableIpRangeResponse, error) {
+	return s.DeletePortableIpRangeWithContext(context.Background(), p, opts...)
+}
+
+// DeletePortableIpRangeWithContext is the same as DeletePortableIpRange, but the request is cancelled when the context is done
+func (s *PortableIPService) DeletePortableIpRangeWithContext(ctx context.Context, p *DeletePortableIpRangeParams, opts ...OptionFunc) (*DeletePortableIpRangeResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.DeletePortableIpRangeRawWithContext(ctx, p.toURLValues())
+}
+
+// DeletePortableIpRangeRaw is the same as DeletePortableIpRange, but takes the params as url.Values instead of typed params
+func (s *PortableIPService) DeletePortableIpRangeRaw(v url.Values) (*DeletePortableIpRangeResponse, error) {
+	return s.DeletePortableIpRangeRawWithContext(context.Background(), v)
+}
+
+// DeletePortableIpRangeRawWithContext is the same as DeletePortableIpRangeRaw, but the request is cancelled when the context is done
+func (s *PortableIPService) DeletePortableIpRangeRawWithContext(ctx context.Context, v url.Values) (*DeletePortableIpRangeResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "deletePortableIpRange", "deleteportableiprangeresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -220,9 +391,9 @@ func (s *PortableIPService) DeletePortableIpRange(p *DeletePortableIpRangeParams
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -236,6 +407,34 @@ func (s *PortableIPService) DeletePortableIpRange(p *DeletePortableIpRangeParams
 	return &r, nil
 }
 
+// WaitForPortableIpRangeDeleted polls until the PortableIpRange with the given ID no longer exists, the timeout
+// expires or the context is cancelled. Deleted resources may still be listed for a short while
+// after the delete command finished, so this can be used to make sure they are really gone. The
+// options are passed to GetPortableIpRangeByID, so a resource outside of the default scope can be found.
+func (s *PortableIPService) WaitForPortableIpRangeDeleted(ctx context.Context, id string, timeout time.Duration, opts ...OptionFunc) error {
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	for {
+		_, count, err := s.GetPortableIpRangeByID(id, opts...)
+		if count == 0 {
+			return nil
+		}
+		if count < 0 {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(2 * time.Second):
+		}
+	}
+}
+
 type DeletePortableIpRangeResponse struct {
 	JobID       string `json:"jobid"`
 	Displaytext string `json:"displaytext"`
@@ -246,6 +445,16 @@ type ListPortableIpRangesParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ListPortableIpRangesParams) Clone() *ListPortableIpRangesParams {
+	c := &ListPortableIpRangesParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListPortableIpRangesParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -280,6 +489,13 @@ func (p *ListPortableIpRangesParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *ListPortableIpRangesParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 func (p *ListPortableIpRangesParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -288,6 +504,13 @@ func (p *ListPortableIpRangesParams) SetKeyword(v string) {
 	return
 }
 
+// SetKeywordIfUnset sets keyword to v, unless it is already set
+func (p *ListPortableIpRangesParams) SetKeywordIfUnset(v string) {
+	if _, found := p.p["keyword"]; !found {
+		p.SetKeyword(v)
+	}
+}
+
 func (p *ListPortableIpRangesParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -296,6 +519,13 @@ func (p *ListPortableIpRangesParams) SetPage(v int) {
 	return
 }
 
+// SetPageIfUnset sets page to v, unless it is already set
+func (p *ListPortableIpRangesParams) SetPageIfUnset(v int) {
+	if _, found := p.p["page"]; !found {
+		p.SetPage(v)
+	}
+}
+
 func (p *ListPortableIpRangesParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -304,6 +534,13 @@ func (p *ListPortableIpRangesParams) SetPagesize(v int) {
 	return
 }
 
+// SetPagesizeIfUnset sets pagesize to v, unless it is already set
+func (p *ListPortableIpRangesParams) SetPagesizeIfUnset(v int) {
+	if _, found := p.p["pagesize"]; !found {
+		p.SetPagesize(v)
+	}
+}
+
 func (p *ListPortableIpRangesParams) SetRegionid(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -312,14 +549,32 @@ func (p *ListPortableIpRangesParams) SetRegionid(v int) {
 	return
 }
 
+// SetRegionidIfUnset sets regionid to v, unless it is already set
+func (p *ListPortableIpRangesParams) SetRegionidIfUnset(v int) {
+	if _, found := p.p["regionid"]; !found {
+		p.SetRegionid(v)
+	}
+}
+
 // You should always use this function to get a new ListPortableIpRangesParams instance,
-// as then you are sure you have configured all required params
-func (s *PortableIPService) NewListPortableIpRangesParams() *ListPortableIpRangesParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *PortableIPService) NewListPortableIpRangesParams(opts ...func(*ListPortableIpRangesParams)) *ListPortableIpRangesParams {
 	p := &ListPortableIpRangesParams{}
 	p.p = make(map[string]interface{})
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
+func (p *ListPortableIpRangesParams) setFirstMatch() {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p[firstMatchKey] = true
+}
+
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *PortableIPService) GetPortableIpRangeByID(id string, opts ...OptionFunc) (*PortableIpRange, int, error) {
 	p := &ListPortableIpRangesParams{}
@@ -327,7 +582,7 @@ func (s *PortableIPService) GetPortableIpRangeByID(id string, opts ...OptionFunc
 
 	p.p["id"] = id
 
-	for _, fn := range append(s.cs.options, opts...) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
 		if err := fn(s.cs, p); err != nil {
 			return nil, -1, err
 		}
@@ -338,24 +593,56 @@ func (s *PortableIPService) GetPortableIpRangeByID(id string, opts ...OptionFunc
 		if strings.Contains(err.Error(), fmt.Sprintf(
 			"Invalid parameter id value=%s due to incorrect long value format, "+
 				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+			return nil, 0, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
 	}
 
 	if l.Count == 1 {
 		return l.PortableIpRanges[0], l.Count, nil
 	}
+
+	if _, ok := p.p[firstMatchKey]; ok && len(l.PortableIpRanges) > 0 {
+		return l.PortableIpRanges[0], l.Count, nil
+	}
 	return nil, l.Count, fmt.Errorf("There is more then one result for PortableIpRange UUID: %s!", id)
 }
 
+// PortableIpRangeExists returns true if a PortableIpRange with the given ID exists. When no match is found
+// it returns false, while any other error is returned as is.
+func (s *PortableIPService) PortableIpRangeExists(id string, opts ...OptionFunc) (bool, error) {
+	_, count, err := s.GetPortableIpRangeByID(id, opts...)
+	if errors.Is(err, ErrNotFound) {
+		return false, nil
+	}
+	if count > 0 {
+		return true, nil
+	}
+	return false, err
+}
+
 // list portable IP ranges
 func (s *PortableIPService) ListPortableIpRanges(p *ListPortableIpRangesParams) (*ListPortableIpRangesResponse, error) {
-	resp, err := s.cs.newRequest("listPortableIpRanges", p.toURLValues())
+	return s.ListPortableIpRangesWithContext(context.Background(), p)
+}
+
+// ListPortableIpRangesWithContext is the same as ListPortableIpRanges, but the request is cancelled when the context is done
+func (s *PortableIPService) ListPortableIpRangesWithContext(ctx context.Context, p *ListPortableIpRangesParams) (*ListPortableIpRangesResponse, error) {
+	return s.ListPortableIpRangesRawWithContext(ctx, p.toURLValues())
+}
+
+// ListPortableIpRangesRaw is the same as ListPortableIpRanges, but takes the params as url.Values instead of typed params
+func (s *PortableIPService) ListPortableIpRangesRaw(v url.Values) (*ListPortableIpRangesResponse, error) {
+	return s.ListPortableIpRangesRawWithContext(context.Background(), v)
+}
+
+// ListPortableIpRangesRawWithContext is the same as ListPortableIpRangesRaw, but the request is cancelled when the context is done
+func (s *PortableIPService) ListPortableIpRangesRawWithContext(ctx context.Context, v url.Values) (*ListPortableIpRangesResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "listPortableIpRanges", "listportableiprangesresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -368,6 +655,93 @@ func (s *PortableIPService) ListPortableIpRanges(p *ListPortableIpRangesParams)
 	return &r, nil
 }
 
+// StreamPortableIpRanges fetches the results of ListPortableIpRanges page by page in the background and sends them on the
+// returned channel. Both returned channels are closed when done. If fetching a page fails, or the context
+// is done before all results are sent, the error is sent on the error channel and fetching stops. A
+// request for a page in flight is cancelled when the context is done. The page and pagesize set in the
+// params are ignored.
+func (s *PortableIPService) StreamPortableIpRanges(ctx context.Context, p *ListPortableIpRangesParams) (<-chan *PortableIpRange, <-chan error) {
+	ch := make(chan *PortableIpRange)
+	errs := make(chan error, 1)
+
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesize(500)
+
+	go func() {
+		defer close(errs)
+		defer close(ch)
+
+		for page, count := 1, 0; ; page++ {
+			pp.SetPage(page)
+
+			l, err := s.ListPortableIpRangesWithContext(ctx, pp)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			for _, v := range l.PortableIpRanges {
+				select {
+				case ch <- v:
+				case <-ctx.Done():
+					errs <- ctx.Err()
+					return
+				}
+			}
+
+			count += len(l.PortableIpRanges)
+			if len(l.PortableIpRanges) < 500 || count >= l.Count {
+				return
+			}
+		}
+	}()
+
+	return ch, errs
+}
+
+// ListPortableIpRangesWhere returns all results of ListPortableIpRanges for which pred returns true. Note that the results are
+// filtered client-side, so all results matching the params are fetched first.
+// All pages are fetched, so the page and pagesize set in the params are ignored.
+func (s *PortableIPService) ListPortableIpRangesWhere(p *ListPortableIpRangesParams, pred func(*PortableIpRange) bool) ([]*PortableIpRange, error) {
+	var r []*PortableIpRange
+	ch, errs := s.StreamPortableIpRanges(context.Background(), p)
+	for v := range ch {
+		if pred(v) {
+			r = append(r, v)
+		}
+	}
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ListAllPortableIpRanges returns the results of ListPortableIpRanges across all pages, by calling ListPortableIpRanges page by page until all
+// results are fetched. The pagesize set in the params is used for every page (500 if not set), and the
+// page set in the params is ignored.
+func (s *PortableIPService) ListAllPortableIpRanges(p *ListPortableIpRangesParams) ([]*PortableIpRange, error) {
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesizeIfUnset(500)
+	pagesize := pp.p["pagesize"].(int)
+
+	var r []*PortableIpRange
+	for page := 1; ; page++ {
+		pp.SetPage(page)
+
+		l, err := s.ListPortableIpRanges(pp)
+		if err != nil {
+			return nil, err
+		}
+		r = append(r, l.PortableIpRanges...)
+
+		if len(l.PortableIpRanges) < pagesize || len(r) >= l.Count {
+			return r, nil
+		}
+	}
+}
+
 type ListPortableIpRangesResponse struct {
 	Count            int                `json:"count"`
 	PortableIpRanges []*PortableIpRange `json:"portableiprange"`
@@ -17,17 +17,82 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/url"
 	"strconv"
 	"strings"
+	"time"
 )
 
+// UpdateMany runs UpdateProject for all given IDs in parallel, using at most concurrency concurrent requests.
+// For each ID the params are populated with the ID and then passed to mutate, which should set the
+// changes to apply. The result contains the updated resources (in the order of the IDs) and the error
+// of every update that failed by ID. The returned error contains all failures, or is nil if none failed.
+// The updated resources are returned as the UpdateProjectResponse of every update, which contains the same
+// fields as the resource itself, so no additional request is needed per resource to get them. The
+// options are used for every update.
+func (s *ProjectService) UpdateMany(ids []string, mutate func(*UpdateProjectParams), concurrency int, opts ...OptionFunc) (BulkResult[*UpdateProjectResponse], error) {
+	rs := make([]*UpdateProjectResponse, len(ids))
+	errs := make([]error, len(ids))
+
+	runConcurrently(len(ids), concurrency, func(i int) {
+		p := &UpdateProjectParams{p: map[string]interface{}{"id": ids[i]}}
+		if mutate != nil {
+			mutate(p)
+		}
+
+		r, err := s.UpdateProject(p, opts...)
+		if err != nil {
+			errs[i] = err
+			return
+		}
+
+		// An async client already waited for the job to finish
+		if !s.cs.async {
+			b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+			if err == nil {
+				b, err = getRawValue(b)
+			}
+			if err == nil {
+				err = json.Unmarshal(b, r)
+			}
+			if err != nil {
+				errs[i] = err
+				return
+			}
+		}
+		rs[i] = r
+	})
+
+	var r BulkResult[*UpdateProjectResponse]
+	for i, id := range ids {
+		if errs[i] != nil {
+			r.fail(id, errs[i])
+			continue
+		}
+		r.Succeeded = append(r.Succeeded, rs[i])
+	}
+
+	return r, r.Err()
+}
+
 type ActivateProjectParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ActivateProjectParams) Clone() *ActivateProjectParams {
+	c := &ActivateProjectParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ActivateProjectParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -39,6 +104,16 @@ func (p *ActivateProjectParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *ActivateProjectParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of activateProject", k)
+		}
+	}
+	return nil
+}
+
 func (p *ActivateProjectParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -47,18 +122,54 @@ func (p *ActivateProjectParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *ActivateProjectParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 // You should always use this function to get a new ActivateProjectParams instance,
-// as then you are sure you have configured all required params
-func (s *ProjectService) NewActivateProjectParams(id string) *ActivateProjectParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *ProjectService) NewActivateProjectParams(id string, opts ...func(*ActivateProjectParams)) *ActivateProjectParams {
 	p := &ActivateProjectParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Activates a project
-func (s *ProjectService) ActivateProject(p *ActivateProjectParams) (*ActivateProjectResponse, error) {
-	resp, err := s.cs.newRequest("activateProject", p.toURLValues())
+func (s *ProjectService) ActivateProject(p *ActivateProjectParams, opts ...OptionFunc) (*ActivateProjectResponse, error) {
+	return s.ActivateProjectWithContext(context.Background(), p, opts...)
+}
+
+// ActivateProjectWithContext is the same as ActivateProject, but the request is cancelled when the context is done
+func (s *ProjectService) ActivateProjectWithContext(ctx context.Context, p *ActivateProjectParams, opts ...OptionFunc) (*ActivateProjectResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.ActivateProjectRawWithContext(ctx, p.toURLValues())
+}
+
+// ActivateProjectRaw is the same as ActivateProject, but takes the params as url.Values instead of typed params
+func (s *ProjectService) ActivateProjectRaw(v url.Values) (*ActivateProjectResponse, error) {
+	return s.ActivateProjectRawWithContext(context.Background(), v)
+}
+
+// ActivateProjectRawWithContext is the same as ActivateProjectRaw, but the request is cancelled when the context is done
+func (s *ProjectService) ActivateProjectRawWithContext(ctx context.Context, v url.Values) (*ActivateProjectResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "activateProject", "activateprojectresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -70,9 +181,9 @@ func (s *ProjectService) ActivateProject(p *ActivateProjectParams) (*ActivatePro
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -153,6 +264,16 @@ type CreateProjectParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *CreateProjectParams) Clone() *CreateProjectParams {
+	c := &CreateProjectParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *CreateProjectParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -173,6 +294,16 @@ func (p *CreateProjectParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *CreateProjectParams) validate() error {
+	for _, k := range []string{"displaytext", "name"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of createProject", k)
+		}
+	}
+	return nil
+}
+
 func (p *CreateProjectParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -181,6 +312,13 @@ func (p *CreateProjectParams) SetAccount(v string) {
 	return
 }
 
+// SetAccountIfUnset sets account to v, unless it is already set
+func (p *CreateProjectParams) SetAccountIfUnset(v string) {
+	if _, found := p.p["account"]; !found {
+		p.SetAccount(v)
+	}
+}
+
 func (p *CreateProjectParams) SetDisplaytext(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -189,6 +327,13 @@ func (p *CreateProjectParams) SetDisplaytext(v string) {
 	return
 }
 
+// SetDisplaytextIfUnset sets displaytext to v, unless it is already set
+func (p *CreateProjectParams) SetDisplaytextIfUnset(v string) {
+	if _, found := p.p["displaytext"]; !found {
+		p.SetDisplaytext(v)
+	}
+}
+
 func (p *CreateProjectParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -197,6 +342,13 @@ func (p *CreateProjectParams) SetDomainid(v string) {
 	return
 }
 
+// SetDomainidIfUnset sets domainid to v, unless it is already set
+func (p *CreateProjectParams) SetDomainidIfUnset(v string) {
+	if _, found := p.p["domainid"]; !found {
+		p.SetDomainid(v)
+	}
+}
+
 func (p *CreateProjectParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -205,19 +357,55 @@ func (p *CreateProjectParams) SetName(v string) {
 	return
 }
 
+// SetNameIfUnset sets name to v, unless it is already set
+func (p *CreateProjectParams) SetNameIfUnset(v string) {
+	if _, found := p.p["name"]; !found {
+		p.SetName(v)
+	}
+}
+
 // You should always use this function to get a new CreateProjectParams instance,
-// as then you are sure you have configured all required params
-func (s *ProjectService) NewCreateProjectParams(displaytext string, name string) *CreateProjectParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *ProjectService) NewCreateProjectParams(displaytext string, name string, opts ...func(*CreateProjectParams)) *CreateProjectParams {
 	p := &CreateProjectParams{}
 	p.p = make(map[string]interface{})
 	p.p["displaytext"] = displaytext
 	p.p["name"] = name
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Creates a project
-func (s *ProjectService) CreateProject(p *CreateProjectParams) (*CreateProjectResponse, error) {
-	resp, err := s.cs.newRequest("createProject", p.toURLValues())
+func (s *ProjectService) CreateProject(p *CreateProjectParams, opts ...OptionFunc) (*CreateProjectResponse, error) {
+	return s.CreateProjectWithContext(context.Background(), p, opts...)
+}
+
+// CreateProjectWithContext is the same as CreateProject, but the request is cancelled when the context is done
+func (s *ProjectService) CreateProjectWithContext(ctx context.Context, p *CreateProjectParams, opts ...OptionFunc) (*CreateProjectResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.CreateProjectRawWithContext(ctx, p.toURLValues())
+}
+
+// CreateProjectRaw is the same as CreateProject, but takes the params as url.Values instead of typed params
+func (s *ProjectService) CreateProjectRaw(v url.Values) (*CreateProjectResponse, error) {
+	return s.CreateProjectRawWithContext(context.Background(), v)
+}
+
+// CreateProjectRawWithContext is the same as CreateProjectRaw, but the request is cancelled when the context is done
+func (s *ProjectService) CreateProjectRawWithContext(ctx context.Context, v url.Values) (*CreateProjectResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "createProject", "createprojectresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -229,9 +417,9 @@ func (s *ProjectService) CreateProject(p *CreateProjectParams) (*CreateProjectRe
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -312,6 +500,16 @@ type DeleteProjectParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *DeleteProjectParams) Clone() *DeleteProjectParams {
+	c := &DeleteProjectParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeleteProjectParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -323,6 +521,16 @@ func (p *DeleteProjectParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *DeleteProjectParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of deleteProject", k)
+		}
+	}
+	return nil
+}
+
 func (p *DeleteProjectParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -331,18 +539,54 @@ func (p *DeleteProjectParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *DeleteProjectParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 // You should always use this function to get a new DeleteProjectParams instance,
-// as then you are sure you have configured all required params
-func (s *ProjectService) NewDeleteProjectParams(id string) *DeleteProjectParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *ProjectService) NewDeleteProjectParams(id string, opts ...func(*DeleteProjectParams)) *DeleteProjectParams {
 	p := &DeleteProjectParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Deletes a project
-func (s *ProjectService) DeleteProject(p *DeleteProjectParams) (*DeleteProjectResponse, error) {
-	resp, err := s.cs.newRequest("deleteProject", p.toURLValues())
+func (s *ProjectService) DeleteProject(p *DeleteProjectParams, opts ...OptionFunc) (*DeleteProjectResponse, error) {
+	return s.DeleteProjectWithContext(context.Background(), p, opts...)
+}
+
+// DeleteProjectWithContext is the same as DeleteProject, but the request is cancelled when the context is done
+func (s *ProjectService) DeleteProjectWithContext(ctx context.Context, p *DeleteProjectParams, opts ...OptionFunc) (*DeleteProjectResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.DeleteProjectRawWithContext(ctx, p.toURLValues())
+}
+
+// DeleteProjectRaw is the same as DeleteProject, but takes the params as url.Values instead of typed params
+func (s *ProjectService) DeleteProjectRaw(v url.Values) (*DeleteProjectResponse, error) {
+	return s.DeleteProjectRawWithContext(context.Background(), v)
+}
+
+// DeleteProjectRawWithContext is the same as DeleteProjectRaw, but the request is cancelled when the context is done
+func (s *ProjectService) DeleteProjectRawWithContext(ctx context.Context, v url.Values) (*DeleteProjectResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteProject", "deleteprojectresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -354,9 +598,9 @@ func (s *ProjectService) DeleteProject(p *DeleteProjectParams) (*DeleteProjectRe
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -370,6 +614,34 @@ func (s *ProjectService) DeleteProject(p *DeleteProjectParams) (*DeleteProjectRe
 	return &r, nil
 }
 
+// WaitForProjectDeleted polls until the Project with the given ID no longer exists, the timeout
+// expires or the context is cancelled. Deleted resources may still be listed for a short while
+// after the delete command finished, so this can be used to make sure they are really gone. The
+// options are passed to GetProjectByID, so a resource outside of the default scope can be found.
+func (s *ProjectService) WaitForProjectDeleted(ctx context.Context, id string, timeout time.Duration, opts ...OptionFunc) error {
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	for {
+		_, count, err := s.GetProjectByID(id, opts...)
+		if count == 0 {
+			return nil
+		}
+		if count < 0 {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(2 * time.Second):
+		}
+	}
+}
+
 type DeleteProjectResponse struct {
 	JobID       string `json:"jobid"`
 	Displaytext string `json:"displaytext"`
@@ -380,6 +652,16 @@ type DeleteProjectInvitationParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *DeleteProjectInvitationParams) Clone() *DeleteProjectInvitationParams {
+	c := &DeleteProjectInvitationParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeleteProjectInvitationParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -391,6 +673,16 @@ func (p *DeleteProjectInvitationParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *DeleteProjectInvitationParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of deleteProjectInvitation", k)
+		}
+	}
+	return nil
+}
+
 func (p *DeleteProjectInvitationParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -399,18 +691,54 @@ func (p *DeleteProjectInvitationParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *DeleteProjectInvitationParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 // You should always use this function to get a new DeleteProjectInvitationParams instance,
-// as then you are sure you have configured all required params
-func (s *ProjectService) NewDeleteProjectInvitationParams(id string) *DeleteProjectInvitationParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *ProjectService) NewDeleteProjectInvitationParams(id string, opts ...func(*DeleteProjectInvitationParams)) *DeleteProjectInvitationParams {
 	p := &DeleteProjectInvitationParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Deletes project invitation
-func (s *ProjectService) DeleteProjectInvitation(p *DeleteProjectInvitationParams) (*DeleteProjectInvitationResponse, error) {
-	resp, err := s.cs.newRequest("deleteProjectInvitation", p.toURLValues())
+func (s *ProjectService) DeleteProjectInvitation(p *DeleteProjectInvitationParams, opts ...OptionFunc) (*DeleteProjectInvitationResponse, error) {
+	return s.DeleteProjectInvitationWithContext(context.Background(), p, opts...)
+}
+
+// DeleteProjectInvitationWithContext is the same as DeleteProjectInvitation, but the request is cancelled when the context is done
+func (s *ProjectService) DeleteProjectInvitationWithContext(ctx context.Context, p *DeleteProjectInvitationParams, opts ...OptionFunc) (*DeleteProjectInvitationResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.DeleteProjectInvitationRawWithContext(ctx, p.toURLValues())
+}
+
+// DeleteProjectInvitationRaw is the same as DeleteProjectInvitation, but takes the params as url.Values instead of typed params
+func (s *ProjectService) DeleteProjectInvitationRaw(v url.Values) (*DeleteProjectInvitationResponse, error) {
+	return s.DeleteProjectInvitationRawWithContext(context.Background(), v)
+}
+
+// DeleteProjectInvitationRawWithContext is the same as DeleteProjectInvitationRaw, but the request is cancelled when the context is done
+func (s *ProjectService) DeleteProjectInvitationRawWithContext(ctx context.Context, v url.Values) (*DeleteProjectInvitationResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteProjectInvitation", "deleteprojectinvitationresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -422,9 +750,9 @@ func (s *ProjectService) DeleteProjectInvitation(p *DeleteProjectInvitationParam
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -438,6 +766,34 @@ func (s *ProjectService) DeleteProjectInvitation(p *DeleteProjectInvitationParam
 	return &r, nil
 }
 
+// WaitForProjectInvitationDeleted polls until the ProjectInvitation with the given ID no longer exists, the timeout
+// expires or the context is cancelled. Deleted resources may still be listed for a short while
+// after the delete command finished, so this can be used to make sure they are really gone. The
+// options are passed to GetProjectInvitationByID, so a resource outside of the default scope can be found.
+func (s *ProjectService) WaitForProjectInvitationDeleted(ctx context.Context, id string, timeout time.Duration, opts ...OptionFunc) error {
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	for {
+		_, count, err := s.GetProjectInvitationByID(id, opts...)
+		if count == 0 {
+			return nil
+		}
+		if count < 0 {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(2 * time.Second):
+		}
+	}
+}
+
 type DeleteProjectInvitationResponse struct {
 	JobID       string `json:"jobid"`
 	Displaytext string `json:"displaytext"`
@@ -448,6 +804,16 @@ type ListProjectInvitationsParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ListProjectInvitationsParams) Clone() *ListProjectInvitationsParams {
+	c := &ListProjectInvitationsParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListProjectInvitationsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -502,6 +868,13 @@ func (p *ListProjectInvitationsParams) SetAccount(v string) {
 	return
 }
 
+// SetAccountIfUnset sets account to v, unless it is already set
+func (p *ListProjectInvitationsParams) SetAccountIfUnset(v string) {
+	if _, found := p.p["account"]; !found {
+		p.SetAccount(v)
+	}
+}
+
 func (p *ListProjectInvitationsParams) SetActiveonly(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -510,6 +883,13 @@ func (p *ListProjectInvitationsParams) SetActiveonly(v bool) {
 	return
 }
 
+// SetActiveonlyIfUnset sets activeonly to v, unless it is already set
+func (p *ListProjectInvitationsParams) SetActiveonlyIfUnset(v bool) {
+	if _, found := p.p["activeonly"]; !found {
+		p.SetActiveonly(v)
+	}
+}
+
 func (p *ListProjectInvitationsParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -518,6 +898,13 @@ func (p *ListProjectInvitationsParams) SetDomainid(v string) {
 	return
 }
 
+// SetDomainidIfUnset sets domainid to v, unless it is already set
+func (p *ListProjectInvitationsParams) SetDomainidIfUnset(v string) {
+	if _, found := p.p["domainid"]; !found {
+		p.SetDomainid(v)
+	}
+}
+
 func (p *ListProjectInvitationsParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -526,6 +913,13 @@ func (p *ListProjectInvitationsParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *ListProjectInvitationsParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 func (p *ListProjectInvitationsParams) SetIsrecursive(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -534,6 +928,13 @@ func (p *ListProjectInvitationsParams) SetIsrecursive(v bool) {
 	return
 }
 
+// SetIsrecursiveIfUnset sets isrecursive to v, unless it is already set
+func (p *ListProjectInvitationsParams) SetIsrecursiveIfUnset(v bool) {
+	if _, found := p.p["isrecursive"]; !found {
+		p.SetIsrecursive(v)
+	}
+}
+
 func (p *ListProjectInvitationsParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -542,6 +943,13 @@ func (p *ListProjectInvitationsParams) SetKeyword(v string) {
 	return
 }
 
+// SetKeywordIfUnset sets keyword to v, unless it is already set
+func (p *ListProjectInvitationsParams) SetKeywordIfUnset(v string) {
+	if _, found := p.p["keyword"]; !found {
+		p.SetKeyword(v)
+	}
+}
+
 func (p *ListProjectInvitationsParams) SetListall(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -550,6 +958,13 @@ func (p *ListProjectInvitationsParams) SetListall(v bool) {
 	return
 }
 
+// SetListallIfUnset sets listall to v, unless it is already set
+func (p *ListProjectInvitationsParams) SetListallIfUnset(v bool) {
+	if _, found := p.p["listall"]; !found {
+		p.SetListall(v)
+	}
+}
+
 func (p *ListProjectInvitationsParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -558,6 +973,13 @@ func (p *ListProjectInvitationsParams) SetPage(v int) {
 	return
 }
 
+// SetPageIfUnset sets page to v, unless it is already set
+func (p *ListProjectInvitationsParams) SetPageIfUnset(v int) {
+	if _, found := p.p["page"]; !found {
+		p.SetPage(v)
+	}
+}
+
 func (p *ListProjectInvitationsParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -566,6 +988,13 @@ func (p *ListProjectInvitationsParams) SetPagesize(v int) {
 	return
 }
 
+// SetPagesizeIfUnset sets pagesize to v, unless it is already set
+func (p *ListProjectInvitationsParams) SetPagesizeIfUnset(v int) {
+	if _, found := p.p["pagesize"]; !found {
+		p.SetPagesize(v)
+	}
+}
+
 func (p *ListProjectInvitationsParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -574,6 +1003,13 @@ func (p *ListProjectInvitationsParams) SetProjectid(v string) {
 	return
 }
 
+// SetProjectidIfUnset sets projectid to v, unless it is already set
+func (p *ListProjectInvitationsParams) SetProjectidIfUnset(v string) {
+	if _, found := p.p["projectid"]; !found {
+		p.SetProjectid(v)
+	}
+}
+
 func (p *ListProjectInvitationsParams) SetState(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -582,14 +1018,34 @@ func (p *ListProjectInvitationsParams) SetState(v string) {
 	return
 }
 
+// SetStateIfUnset sets state to v, unless it is already set
+func (p *ListProjectInvitationsParams) SetStateIfUnset(v string) {
+	if _, found := p.p["state"]; !found {
+		p.SetState(v)
+	}
+}
+
+var _ ListAllSetter = (*ListProjectInvitationsParams)(nil)
+
 // You should always use this function to get a new ListProjectInvitationsParams instance,
-// as then you are sure you have configured all required params
-func (s *ProjectService) NewListProjectInvitationsParams() *ListProjectInvitationsParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *ProjectService) NewListProjectInvitationsParams(opts ...func(*ListProjectInvitationsParams)) *ListProjectInvitationsParams {
 	p := &ListProjectInvitationsParams{}
 	p.p = make(map[string]interface{})
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
+func (p *ListProjectInvitationsParams) setFirstMatch() {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p[firstMatchKey] = true
+}
+
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *ProjectService) GetProjectInvitationByID(id string, opts ...OptionFunc) (*ProjectInvitation, int, error) {
 	p := &ListProjectInvitationsParams{}
@@ -597,7 +1053,7 @@ func (s *ProjectService) GetProjectInvitationByID(id string, opts ...OptionFunc)
 
 	p.p["id"] = id
 
-	for _, fn := range append(s.cs.options, opts...) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
 		if err := fn(s.cs, p); err != nil {
 			return nil, -1, err
 		}
@@ -608,24 +1064,56 @@ func (s *ProjectService) GetProjectInvitationByID(id string, opts ...OptionFunc)
 		if strings.Contains(err.Error(), fmt.Sprintf(
 			"Invalid parameter id value=%s due to incorrect long value format, "+
 				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+			return nil, 0, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
 	}
 
 	if l.Count == 1 {
 		return l.ProjectInvitations[0], l.Count, nil
 	}
+
+	if _, ok := p.p[firstMatchKey]; ok && len(l.ProjectInvitations) > 0 {
+		return l.ProjectInvitations[0], l.Count, nil
+	}
 	return nil, l.Count, fmt.Errorf("There is more then one result for ProjectInvitation UUID: %s!", id)
 }
 
+// ProjectInvitationExists returns true if a ProjectInvitation with the given ID exists. When no match is found
+// it returns false, while any other error is returned as is.
+func (s *ProjectService) ProjectInvitationExists(id string, opts ...OptionFunc) (bool, error) {
+	_, count, err := s.GetProjectInvitationByID(id, opts...)
+	if errors.Is(err, ErrNotFound) {
+		return false, nil
+	}
+	if count > 0 {
+		return true, nil
+	}
+	return false, err
+}
+
 // Lists project invitations and provides detailed information for listed invitations
 func (s *ProjectService) ListProjectInvitations(p *ListProjectInvitationsParams) (*ListProjectInvitationsResponse, error) {
-	resp, err := s.cs.newRequest("listProjectInvitations", p.toURLValues())
+	return s.ListProjectInvitationsWithContext(context.Background(), p)
+}
+
+// ListProjectInvitationsWithContext is the same as ListProjectInvitations, but the request is cancelled when the context is done
+func (s *ProjectService) ListProjectInvitationsWithContext(ctx context.Context, p *ListProjectInvitationsParams) (*ListProjectInvitationsResponse, error) {
+	return s.ListProjectInvitationsRawWithContext(ctx, p.toURLValues())
+}
+
+// ListProjectInvitationsRaw is the same as ListProjectInvitations, but takes the params as url.Values instead of typed params
+func (s *ProjectService) ListProjectInvitationsRaw(v url.Values) (*ListProjectInvitationsResponse, error) {
+	return s.ListProjectInvitationsRawWithContext(context.Background(), v)
+}
+
+// ListProjectInvitationsRawWithContext is the same as ListProjectInvitationsRaw, but the request is cancelled when the context is done
+func (s *ProjectService) ListProjectInvitationsRawWithContext(ctx context.Context, v url.Values) (*ListProjectInvitationsResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "listProjectInvitations", "listprojectinvitationsresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -638,6 +1126,93 @@ func (s *ProjectService) ListProjectInvitations(p *ListProjectInvitationsParams)
 	return &r, nil
 }
 
+// StreamProjectInvitations fetches the results of ListProjectInvitations page by page in the background and sends them on the
+// returned channel. Both returned channels are closed when done. If fetching a page fails, or the context
+// is done before all results are sent, the error is sent on the error channel and fetching stops. A
+// request for a page in flight is cancelled when the context is done. The page and pagesize set in the
+// params are ignored.
+func (s *ProjectService) StreamProjectInvitations(ctx context.Context, p *ListProjectInvitationsParams) (<-chan *ProjectInvitation, <-chan error) {
+	ch := make(chan *ProjectInvitation)
+	errs := make(chan error, 1)
+
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesize(500)
+
+	go func() {
+		defer close(errs)
+		defer close(ch)
+
+		for page, count := 1, 0; ; page++ {
+			pp.SetPage(page)
+
+			l, err := s.ListProjectInvitationsWithContext(ctx, pp)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			for _, v := range l.ProjectInvitations {
+				select {
+				case ch <- v:
+				case <-ctx.Done():
+					errs <- ctx.Err()
+					return
+				}
+			}
+
+			count += len(l.ProjectInvitations)
+			if len(l.ProjectInvitations) < 500 || count >= l.Count {
+				return
+			}
+		}
+	}()
+
+	return ch, errs
+}
+
+// ListProjectInvitationsWhere returns all results of ListProjectInvitations for which pred returns true. Note that the results are
+// filtered client-side, so all results matching the params are fetched first.
+// All pages are fetched, so the page and pagesize set in the params are ignored.
+func (s *ProjectService) ListProjectInvitationsWhere(p *ListProjectInvitationsParams, pred func(*ProjectInvitation) bool) ([]*ProjectInvitation, error) {
+	var r []*ProjectInvitation
+	ch, errs := s.StreamProjectInvitations(context.Background(), p)
+	for v := range ch {
+		if pred(v) {
+			r = append(r, v)
+		}
+	}
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ListAllProjectInvitations returns the results of ListProjectInvitations across all pages, by calling ListProjectInvitations page by page until all
+// results are fetched. The pagesize set in the params is used for every page (500 if not set), and the
+// page set in the params is ignored.
+func (s *ProjectService) ListAllProjectInvitations(p *ListProjectInvitationsParams) ([]*ProjectInvitation, error) {
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesizeIfUnset(500)
+	pagesize := pp.p["pagesize"].(int)
+
+	var r []*ProjectInvitation
+	for page := 1; ; page++ {
+		pp.SetPage(page)
+
+		l, err := s.ListProjectInvitations(pp)
+		if err != nil {
+			return nil, err
+		}
+		r = append(r, l.ProjectInvitations...)
+
+		if len(l.ProjectInvitations) < pagesize || len(r) >= l.Count {
+			return r, nil
+		}
+	}
+}
+
 type ListProjectInvitationsResponse struct {
 	Count              int                  `json:"count"`
 	ProjectInvitations []*ProjectInvitation `json:"projectinvitation"`
@@ -658,6 +1233,16 @@ type ListProjectsParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ListProjectsParams) Clone() *ListProjectsParams {
+	c := &ListProjectsParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListProjectsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -701,11 +1286,10 @@ func (p *ListProjectsParams) toURLValues() url.Values {
 		u.Set("state", v.(string))
 	}
 	if v, found := p.p["tags"]; found {
-		i := 0
-		for k, vv := range v.(map[string]string) {
+		m := v.(map[string]string)
+		for i, k := range getSortedKeysFromMap(m) {
 			u.Set(fmt.Sprintf("tags[%d].key", i), k)
-			u.Set(fmt.Sprintf("tags[%d].value", i), vv)
-			i++
+			u.Set(fmt.Sprintf("tags[%d].value", i), m[k])
 		}
 	}
 	return u
@@ -719,6 +1303,13 @@ func (p *ListProjectsParams) SetAccount(v string) {
 	return
 }
 
+// SetAccountIfUnset sets account to v, unless it is already set
+func (p *ListProjectsParams) SetAccountIfUnset(v string) {
+	if _, found := p.p["account"]; !found {
+		p.SetAccount(v)
+	}
+}
+
 func (p *ListProjectsParams) SetDisplaytext(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -727,6 +1318,13 @@ func (p *ListProjectsParams) SetDisplaytext(v string) {
 	return
 }
 
+// SetDisplaytextIfUnset sets displaytext to v, unless it is already set
+func (p *ListProjectsParams) SetDisplaytextIfUnset(v string) {
+	if _, found := p.p["displaytext"]; !found {
+		p.SetDisplaytext(v)
+	}
+}
+
 func (p *ListProjectsParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -735,6 +1333,13 @@ func (p *ListProjectsParams) SetDomainid(v string) {
 	return
 }
 
+// SetDomainidIfUnset sets domainid to v, unless it is already set
+func (p *ListProjectsParams) SetDomainidIfUnset(v string) {
+	if _, found := p.p["domainid"]; !found {
+		p.SetDomainid(v)
+	}
+}
+
 func (p *ListProjectsParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -743,6 +1348,13 @@ func (p *ListProjectsParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *ListProjectsParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 func (p *ListProjectsParams) SetIsrecursive(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -751,6 +1363,13 @@ func (p *ListProjectsParams) SetIsrecursive(v bool) {
 	return
 }
 
+// SetIsrecursiveIfUnset sets isrecursive to v, unless it is already set
+func (p *ListProjectsParams) SetIsrecursiveIfUnset(v bool) {
+	if _, found := p.p["isrecursive"]; !found {
+		p.SetIsrecursive(v)
+	}
+}
+
 func (p *ListProjectsParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -759,6 +1378,13 @@ func (p *ListProjectsParams) SetKeyword(v string) {
 	return
 }
 
+// SetKeywordIfUnset sets keyword to v, unless it is already set
+func (p *ListProjectsParams) SetKeywordIfUnset(v string) {
+	if _, found := p.p["keyword"]; !found {
+		p.SetKeyword(v)
+	}
+}
+
 func (p *ListProjectsParams) SetListall(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -767,6 +1393,13 @@ func (p *ListProjectsParams) SetListall(v bool) {
 	return
 }
 
+// SetListallIfUnset sets listall to v, unless it is already set
+func (p *ListProjectsParams) SetListallIfUnset(v bool) {
+	if _, found := p.p["listall"]; !found {
+		p.SetListall(v)
+	}
+}
+
 func (p *ListProjectsParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -775,6 +1408,13 @@ func (p *ListProjectsParams) SetName(v string) {
 	return
 }
 
+// SetNameIfUnset sets name to v, unless it is already set
+func (p *ListProjectsParams) SetNameIfUnset(v string) {
+	if _, found := p.p["name"]; !found {
+		p.SetName(v)
+	}
+}
+
 func (p *ListProjectsParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -783,6 +1423,13 @@ func (p *ListProjectsParams) SetPage(v int) {
 	return
 }
 
+// SetPageIfUnset sets page to v, unless it is already set
+func (p *ListProjectsParams) SetPageIfUnset(v int) {
+	if _, found := p.p["page"]; !found {
+		p.SetPage(v)
+	}
+}
+
 func (p *ListProjectsParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -791,6 +1438,13 @@ func (p *ListProjectsParams) SetPagesize(v int) {
 	return
 }
 
+// SetPagesizeIfUnset sets pagesize to v, unless it is already set
+func (p *ListProjectsParams) SetPagesizeIfUnset(v int) {
+	if _, found := p.p["pagesize"]; !found {
+		p.SetPagesize(v)
+	}
+}
+
 func (p *ListProjectsParams) SetState(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -799,6 +1453,13 @@ func (p *ListProjectsParams) SetState(v string) {
 	return
 }
 
+// SetStateIfUnset sets state to v, unless it is already set
+func (p *ListProjectsParams) SetStateIfUnset(v string) {
+	if _, found := p.p["state"]; !found {
+		p.SetState(v)
+	}
+}
+
 func (p *ListProjectsParams) SetTags(v map[string]string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -807,14 +1468,34 @@ func (p *ListProjectsParams) SetTags(v map[string]string) {
 	return
 }
 
+// SetTagsIfUnset sets tags to v, unless it is already set
+func (p *ListProjectsParams) SetTagsIfUnset(v map[string]string) {
+	if _, found := p.p["tags"]; !found {
+		p.SetTags(v)
+	}
+}
+
+var _ ListAllSetter = (*ListProjectsParams)(nil)
+
 // You should always use this function to get a new ListProjectsParams instance,
-// as then you are sure you have configured all required params
-func (s *ProjectService) NewListProjectsParams() *ListProjectsParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *ProjectService) NewListProjectsParams(opts ...func(*ListProjectsParams)) *ListProjectsParams {
 	p := &ListProjectsParams{}
 	p.p = make(map[string]interface{})
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
+func (p *ListProjectsParams) setFirstMatch() {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p[firstMatchKey] = true
+}
+
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *ProjectService) GetProjectID(name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListProjectsParams{}
@@ -822,7 +1503,7 @@ func (s *ProjectService) GetProjectID(name string, opts ...OptionFunc) (string,
 
 	p.p["name"] = name
 
-	for _, fn := range append(s.cs.options, opts...) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
 		if err := fn(s.cs, p); err != nil {
 			return "", -1, err
 		}
@@ -834,7 +1515,7 @@ func (s *ProjectService) GetProjectID(name string, opts ...OptionFunc) (string,
 	}
 
 	if l.Count == 0 {
-		return "", l.Count, fmt.Errorf("No match found for %s: %+v", name, l)
+		return "", l.Count, fmt.Errorf("%w for %s: %+v", ErrNotFound, name, l)
 	}
 
 	if l.Count == 1 {
@@ -847,6 +1528,10 @@ func (s *ProjectService) GetProjectID(name string, opts ...OptionFunc) (string,
 				return v.Id, l.Count, nil
 			}
 		}
+
+		if _, ok := p.p[firstMatchKey]; ok && len(l.Projects) > 0 {
+			return l.Projects[0].Id, l.Count, nil
+		}
 	}
 	return "", l.Count, fmt.Errorf("Could not find an exact match for %s: %+v", name, l)
 }
@@ -872,7 +1557,7 @@ func (s *ProjectService) GetProjectByID(id string, opts ...OptionFunc) (*Project
 
 	p.p["id"] = id
 
-	for _, fn := range append(s.cs.options, opts...) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
 		if err := fn(s.cs, p); err != nil {
 			return nil, -1, err
 		}
@@ -883,24 +1568,56 @@ func (s *ProjectService) GetProjectByID(id string, opts ...OptionFunc) (*Project
 		if strings.Contains(err.Error(), fmt.Sprintf(
 			"Invalid parameter id value=%s due to incorrect long value format, "+
 				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+			return nil, 0, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
 	}
 
 	if l.Count == 1 {
 		return l.Projects[0], l.Count, nil
 	}
+
+	if _, ok := p.p[firstMatchKey]; ok && len(l.Projects) > 0 {
+		return l.Projects[0], l.Count, nil
+	}
 	return nil, l.Count, fmt.Errorf("There is more then one result for Project UUID: %s!", id)
 }
 
+// ProjectExists returns true if a Project with the given ID exists. When no match is found
+// it returns false, while any other error is returned as is.
+func (s *ProjectService) ProjectExists(id string, opts ...OptionFunc) (bool, error) {
+	_, count, err := s.GetProjectByID(id, opts...)
+	if errors.Is(err, ErrNotFound) {
+		return false, nil
+	}
+	if count > 0 {
+		return true, nil
+	}
+	return false, err
+}
+
 // Lists projects and provides detailed information for listed projects
 func (s *ProjectService) ListProjects(p *ListProjectsParams) (*ListProjectsResponse, error) {
-	resp, err := s.cs.newRequest("listProjects", p.toURLValues())
+	return s.ListProjectsWithContext(context.Background(), p)
+}
+
+// ListProjectsWithContext is the same as ListProjects, but the request is cancelled when the context is done
+func (s *ProjectService) ListProjectsWithContext(ctx context.Context, p *ListProjectsParams) (*ListProjectsResponse, error) {
+	return s.ListProjectsRawWithContext(ctx, p.toURLValues())
+}
+
+// ListProjectsRaw is the same as ListProjects, but takes the params as url.Values instead of typed params
+func (s *ProjectService) ListProjectsRaw(v url.Values) (*ListProjectsResponse, error) {
+	return s.ListProjectsRawWithContext(context.Background(), v)
+}
+
+// ListProjectsRawWithContext is the same as ListProjectsRaw, but the request is cancelled when the context is done
+func (s *ProjectService) ListProjectsRawWithContext(ctx context.Context, v url.Values) (*ListProjectsResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "listProjects", "listprojectsresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -913,6 +1630,93 @@ func (s *ProjectService) ListProjects(p *ListProjectsParams) (*ListProjectsRespo
 	return &r, nil
 }
 
+// StreamProjects fetches the results of ListProjects page by page in the background and sends them on the
+// returned channel. Both returned channels are closed when done. If fetching a page fails, or the context
+// is done before all results are sent, the error is sent on the error channel and fetching stops. A
+// request for a page in flight is cancelled when the context is done. The page and pagesize set in the
+// params are ignored.
+func (s *ProjectService) StreamProjects(ctx context.Context, p *ListProjectsParams) (<-chan *Project, <-chan error) {
+	ch := make(chan *Project)
+	errs := make(chan error, 1)
+
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesize(500)
+
+	go func() {
+		defer close(errs)
+		defer close(ch)
+
+		for page, count := 1, 0; ; page++ {
+			pp.SetPage(page)
+
+			l, err := s.ListProjectsWithContext(ctx, pp)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			for _, v := range l.Projects {
+				select {
+				case ch <- v:
+				case <-ctx.Done():
+					errs <- ctx.Err()
+					return
+				}
+			}
+
+			count += len(l.Projects)
+			if len(l.Projects) < 500 || count >= l.Count {
+				return
+			}
+		}
+	}()
+
+	return ch, errs
+}
+
+// ListProjectsWhere returns all results of ListProjects for which pred returns true. Note that the results are
+// filtered client-side, so all results matching the params are fetched first.
+// All pages are fetched, so the page and pagesize set in the params are ignored.
+func (s *ProjectService) ListProjectsWhere(p *ListProjectsParams, pred func(*Project) bool) ([]*Project, error) {
+	var r []*Project
+	ch, errs := s.StreamProjects(context.Background(), p)
+	for v := range ch {
+		if pred(v) {
+			r = append(r, v)
+		}
+	}
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ListAllProjects returns the results of ListProjects across all pages, by calling ListProjects page by page until all
+// results are fetched. The pagesize set in the params is used for every page (500 if not set), and the
+// page set in the params is ignored.
+func (s *ProjectService) ListAllProjects(p *ListProjectsParams) ([]*Project, error) {
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesizeIfUnset(500)
+	pagesize := pp.p["pagesize"].(int)
+
+	var r []*Project
+	for page := 1; ; page++ {
+		pp.SetPage(page)
+
+		l, err := s.ListProjects(pp)
+		if err != nil {
+			return nil, err
+		}
+		r = append(r, l.Projects...)
+
+		if len(l.Projects) < pagesize || len(r) >= l.Count {
+			return r, nil
+		}
+	}
+}
+
 type ListProjectsResponse struct {
 	Count    int        `json:"count"`
 	Projects []*Project `json:"project"`
@@ -979,6 +1783,16 @@ type SuspendProjectParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *SuspendProjectParams) Clone() *SuspendProjectParams {
+	c := &SuspendProjectParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *SuspendProjectParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -990,6 +1804,16 @@ func (p *SuspendProjectParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *SuspendProjectParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of suspendProject", k)
+		}
+	}
+	return nil
+}
+
 func (p *SuspendProjectParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -998,18 +1822,54 @@ func (p *SuspendProjectParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *SuspendProjectParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 // You should always use this function to get a new SuspendProjectParams instance,
-// as then you are sure you have configured all required params
-func (s *ProjectService) NewSuspendProjectParams(id string) *SuspendProjectParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *ProjectService) NewSuspendProjectParams(id string, opts ...func(*SuspendProjectParams)) *SuspendProjectParams {
 	p := &SuspendProjectParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Suspends a project
-func (s *ProjectService) SuspendProject(p *SuspendProjectParams) (*SuspendProjectResponse, error) {
-	resp, err := s.cs.newRequest("suspendProject", p.toURLValues())
+func (s *ProjectService) SuspendProject(p *SuspendProjectParams, opts ...OptionFunc) (*SuspendProjectResponse, error) {
+	return s.SuspendProjectWithContext(context.Background(), p, opts...)
+}
+
+// SuspendProjectWithContext is the same as SuspendProject, but the request is cancelled when the context is done
+func (s *ProjectService) SuspendProjectWithContext(ctx context.Context, p *SuspendProjectParams, opts ...OptionFunc) (*SuspendProjectResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.SuspendProjectRawWithContext(ctx, p.toURLValues())
+}
+
+// SuspendProjectRaw is the same as SuspendProject, but takes the params as url.Values instead of typed params
+func (s *ProjectService) SuspendProjectRaw(v url.Values) (*SuspendProjectResponse, error) {
+	return s.SuspendProjectRawWithContext(context.Background(), v)
+}
+
+// SuspendProjectRawWithContext is the same as SuspendProjectRaw, but the request is cancelled when the context is done
+func (s *ProjectService) SuspendProjectRawWithContext(ctx context.Context, v url.Values) (*SuspendProjectResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "suspendProject", "suspendprojectresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -1021,9 +1881,9 @@ func (s *ProjectService) SuspendProject(p *SuspendProjectParams) (*SuspendProjec
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -1104,6 +1964,16 @@ type UpdateProjectParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *UpdateProjectParams) Clone() *UpdateProjectParams {
+	c := &UpdateProjectParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *UpdateProjectParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1121,6 +1991,16 @@ func (p *UpdateProjectParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *UpdateProjectParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of updateProject", k)
+		}
+	}
+	return nil
+}
+
 func (p *UpdateProjectParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1129,6 +2009,13 @@ func (p *UpdateProjectParams) SetAccount(v string) {
 	return
 }
 
+// SetAccountIfUnset sets account to v, unless it is already set
+func (p *UpdateProjectParams) SetAccountIfUnset(v string) {
+	if _, found := p.p["account"]; !found {
+		p.SetAccount(v)
+	}
+}
+
 func (p *UpdateProjectParams) SetDisplaytext(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1137,6 +2024,13 @@ func (p *UpdateProjectParams) SetDisplaytext(v string) {
 	return
 }
 
+// SetDisplaytextIfUnset sets displaytext to v, unless it is already set
+func (p *UpdateProjectParams) SetDisplaytextIfUnset(v string) {
+	if _, found := p.p["displaytext"]; !found {
+		p.SetDisplaytext(v)
+	}
+}
+
 func (p *UpdateProjectParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1145,18 +2039,54 @@ func (p *UpdateProjectParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *UpdateProjectParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 // You should always use this function to get a new UpdateProjectParams instance,
-// as then you are sure you have configured all required params
-func (s *ProjectService) NewUpdateProjectParams(id string) *UpdateProjectParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *ProjectService) NewUpdateProjectParams(id string, opts ...func(*UpdateProjectParams)) *UpdateProjectParams {
 	p := &UpdateProjectParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Updates a project
-func (s *ProjectService) UpdateProject(p *UpdateProjectParams) (*UpdateProjectResponse, error) {
-	resp, err := s.cs.newRequest("updateProject", p.toURLValues())
+func (s *ProjectService) UpdateProject(p *UpdateProjectParams, opts ...OptionFunc) (*UpdateProjectResponse, error) {
+	return s.UpdateProjectWithContext(context.Background(), p, opts...)
+}
+
+// UpdateProjectWithContext is the same as UpdateProject, but the request is cancelled when the context is done
+func (s *ProjectService) UpdateProjectWithContext(ctx context.Context, p *UpdateProjectParams, opts ...OptionFunc) (*UpdateProjectResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.UpdateProjectRawWithContext(ctx, p.toURLValues())
+}
+
+// UpdateProjectRaw is the same as UpdateProject, but takes the params as url.Values instead of typed params
+func (s *ProjectService) UpdateProjectRaw(v url.Values) (*UpdateProjectResponse, error) {
+	return s.UpdateProjectRawWithContext(context.Background(), v)
+}
+
+// UpdateProjectRawWithContext is the same as UpdateProjectRaw, but the request is cancelled when the context is done
+func (s *ProjectService) UpdateProjectRawWithContext(ctx context.Context, v url.Values) (*UpdateProjectResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "updateProject", "updateprojectresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -1168,9 +2098,9 @@ func (s *ProjectService) UpdateProject(p *UpdateProjectParams) (*UpdateProjectRe
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -1251,6 +2181,16 @@ type UpdateProjectInvitationParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *UpdateProjectInvitationParams) Clone() *UpdateProjectInvitationParams {
+	c := &UpdateProjectInvitationParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *UpdateProjectInvitationParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1272,6 +2212,16 @@ func (p *UpdateProjectInvitationParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *UpdateProjectInvitationParams) validate() error {
+	for _, k := range []string{"projectid"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of updateProjectInvitation", k)
+		}
+	}
+	return nil
+}
+
 func (p *UpdateProjectInvitationParams) SetAccept(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1280,6 +2230,13 @@ func (p *UpdateProjectInvitationParams) SetAccept(v bool) {
 	return
 }
 
+// SetAcceptIfUnset sets accept to v, unless it is already set
+func (p *UpdateProjectInvitationParams) SetAcceptIfUnset(v bool) {
+	if _, found := p.p["accept"]; !found {
+		p.SetAccept(v)
+	}
+}
+
 func (p *UpdateProjectInvitationParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1288,6 +2245,13 @@ func (p *UpdateProjectInvitationParams) SetAccount(v string) {
 	return
 }
 
+// SetAccountIfUnset sets account to v, unless it is already set
+func (p *UpdateProjectInvitationParams) SetAccountIfUnset(v string) {
+	if _, found := p.p["account"]; !found {
+		p.SetAccount(v)
+	}
+}
+
 func (p *UpdateProjectInvitationParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1296,6 +2260,13 @@ func (p *UpdateProjectInvitationParams) SetProjectid(v string) {
 	return
 }
 
+// SetProjectidIfUnset sets projectid to v, unless it is already set
+func (p *UpdateProjectInvitationParams) SetProjectidIfUnset(v string) {
+	if _, found := p.p["projectid"]; !found {
+		p.SetProjectid(v)
+	}
+}
+
 func (p *UpdateProjectInvitationParams) SetToken(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1304,18 +2275,54 @@ func (p *UpdateProjectInvitationParams) SetToken(v string) {
 	return
 }
 
+// SetTokenIfUnset sets token to v, unless it is already set
+func (p *UpdateProjectInvitationParams) SetTokenIfUnset(v string) {
+	if _, found := p.p["token"]; !found {
+		p.SetToken(v)
+	}
+}
+
 // You should always use this function to get a new UpdateProjectInvitationParams instance,
-// as then you are sure you have configured all required params
-func (s *ProjectService) NewUpdateProjectInvitationParams(projectid string) *UpdateProjectInvitationParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *ProjectService) NewUpdateProjectInvitationParams(projectid string, opts ...func(*UpdateProjectInvitationParams)) *UpdateProjectInvitationParams {
 	p := &UpdateProjectInvitationParams{}
 	p.p = make(map[string]interface{})
 	p.p["projectid"] = projectid
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Accepts or declines project invitation
-func (s *ProjectService) UpdateProjectInvitation(p *UpdateProjectInvitationParams) (*UpdateProjectInvitationResponse, error) {
-	resp, err := s.cs.newRequest("updateProjectInvitation", p.toURLValues())
+func (s *ProjectService) UpdateProjectInvitation(p *UpdateProjectInvitationParams, opts ...OptionFunc) (*UpdateProjectInvitationResponse, error) {
+	return s.UpdateProjectInvitationWithContext(context.Background(), p, opts...)
+}
+
+// UpdateProjectInvitationWithContext is the same as UpdateProjectInvitation, but the request is cancelled when the context is done
+func (s *ProjectService) UpdateProjectInvitationWithContext(ctx context.Context, p *UpdateProjectInvitationParams, opts ...OptionFunc) (*UpdateProjectInvitationResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.UpdateProjectInvitationRawWithContext(ctx, p.toURLValues())
+}
+
+// UpdateProjectInvitationRaw is the same as UpdateProjectInvitation, but takes the params as url.Values instead of typed params
+func (s *ProjectService) UpdateProjectInvitationRaw(v url.Values) (*UpdateProjectInvitationResponse, error) {
+	return s.UpdateProjectInvitationRawWithContext(context.Background(), v)
+}
+
+// UpdateProjectInvitationRawWithContext is the same as UpdateProjectInvitationRaw, but the request is cancelled when the context is done
+func (s *ProjectService) UpdateProjectInvitationRawWithContext(ctx context.Context, v url.Values) (*UpdateProjectInvitationResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "updateProjectInvitation", "updateprojectinvitationresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -1327,9 +2334,9 @@ func (s *ProjectService) UpdateProjectInvitation(p *UpdateProjectInvitationParam
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -17,6 +17,7 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"net/url"
 )
@@ -25,6 +26,16 @@ type QuotaIsEnabledParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *QuotaIsEnabledParams) Clone() *QuotaIsEnabledParams {
+	c := &QuotaIsEnabledParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *QuotaIsEnabledParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -34,16 +45,41 @@ func (p *QuotaIsEnabledParams) toURLValues() url.Values {
 }
 
 // You should always use this function to get a new QuotaIsEnabledParams instance,
-// as then you are sure you have configured all required params
-func (s *QuotaService) NewQuotaIsEnabledParams() *QuotaIsEnabledParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *QuotaService) NewQuotaIsEnabledParams(opts ...func(*QuotaIsEnabledParams)) *QuotaIsEnabledParams {
 	p := &QuotaIsEnabledParams{}
 	p.p = make(map[string]interface{})
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Return true if the plugin is enabled
-func (s *QuotaService) QuotaIsEnabled(p *QuotaIsEnabledParams) (*QuotaIsEnabledResponse, error) {
-	resp, err := s.cs.newRequest("quotaIsEnabled", p.toURLValues())
+func (s *QuotaService) QuotaIsEnabled(p *QuotaIsEnabledParams, opts ...OptionFunc) (*QuotaIsEnabledResponse, error) {
+	return s.QuotaIsEnabledWithContext(context.Background(), p, opts...)
+}
+
+// QuotaIsEnabledWithContext is the same as QuotaIsEnabled, but the request is cancelled when the context is done
+func (s *QuotaService) QuotaIsEnabledWithContext(ctx context.Context, p *QuotaIsEnabledParams, opts ...OptionFunc) (*QuotaIsEnabledResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	return s.QuotaIsEnabledRawWithContext(ctx, p.toURLValues())
+}
+
+// QuotaIsEnabledRaw is the same as QuotaIsEnabled, but takes the params as url.Values instead of typed params
+func (s *QuotaService) QuotaIsEnabledRaw(v url.Values) (*QuotaIsEnabledResponse, error) {
+	return s.QuotaIsEnabledRawWithContext(context.Background(), v)
+}
+
+// QuotaIsEnabledRawWithContext is the same as QuotaIsEnabledRaw, but the request is cancelled when the context is done
+func (s *QuotaService) QuotaIsEnabledRawWithContext(ctx context.Context, v url.Values) (*QuotaIsEnabledResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "quotaIsEnabled", "quotaisenabledresponse", v)
 	if err != nil {
 		return nil, err
 	}
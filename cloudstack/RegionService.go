@@ -17,7 +17,9 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
 	"net/url"
 	"strconv"
 )
@@ -26,6 +28,16 @@ type AddRegionParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *AddRegionParams) Clone() *AddRegionParams {
+	c := &AddRegionParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *AddRegionParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -44,6 +56,16 @@ func (p *AddRegionParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *AddRegionParams) validate() error {
+	for _, k := range []string{"endpoint", "id", "name"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of addRegion", k)
+		}
+	}
+	return nil
+}
+
 func (p *AddRegionParams) SetEndpoint(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -52,6 +74,13 @@ func (p *AddRegionParams) SetEndpoint(v string) {
 	return
 }
 
+// SetEndpointIfUnset sets endpoint to v, unless it is already set
+func (p *AddRegionParams) SetEndpointIfUnset(v string) {
+	if _, found := p.p["endpoint"]; !found {
+		p.SetEndpoint(v)
+	}
+}
+
 func (p *AddRegionParams) SetId(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -60,6 +89,13 @@ func (p *AddRegionParams) SetId(v int) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *AddRegionParams) SetIdIfUnset(v int) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 func (p *AddRegionParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -68,20 +104,71 @@ func (p *AddRegionParams) SetName(v string) {
 	return
 }
 
+// SetNameIfUnset sets name to v, unless it is already set
+func (p *AddRegionParams) SetNameIfUnset(v string) {
+	if _, found := p.p["name"]; !found {
+		p.SetName(v)
+	}
+}
+
 // You should always use this function to get a new AddRegionParams instance,
-// as then you are sure you have configured all required params
-func (s *RegionService) NewAddRegionParams(endpoint string, id int, name string) *AddRegionParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *RegionService) NewAddRegionParams(endpoint string, id int, name string, opts ...func(*AddRegionParams)) *AddRegionParams {
 	p := &AddRegionParams{}
 	p.p = make(map[string]interface{})
 	p.p["endpoint"] = endpoint
 	p.p["id"] = id
 	p.p["name"] = name
+	for _, fn := range opts {
+		fn(p)
+	}
+	return p
+}
+
+// AddRegionOpts contains all params that can be set on a AddRegionParams.
+// Optional params are pointers (or slices and maps) which are only set when not nil.
+type AddRegionOpts struct {
+	Endpoint string
+	Id       int
+	Name     string
+}
+
+// NewAddRegionParamsFromOpts is an alternative for NewAddRegionParams which takes all params using
+// an options struct, as that is easier to read for APIs with many required params
+func (s *RegionService) NewAddRegionParamsFromOpts(o AddRegionOpts) *AddRegionParams {
+	p := s.NewAddRegionParams(o.Endpoint, o.Id, o.Name)
 	return p
 }
 
 // Adds a Region
-func (s *RegionService) AddRegion(p *AddRegionParams) (*AddRegionResponse, error) {
-	resp, err := s.cs.newRequest("addRegion", p.toURLValues())
+func (s *RegionService) AddRegion(p *AddRegionParams, opts ...OptionFunc) (*AddRegionResponse, error) {
+	return s.AddRegionWithContext(context.Background(), p, opts...)
+}
+
+// AddRegionWithContext is the same as AddRegion, but the request is cancelled when the context is done
+func (s *RegionService) AddRegionWithContext(ctx context.Context, p *AddRegionParams, opts ...OptionFunc) (*AddRegionResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.AddRegionRawWithContext(ctx, p.toURLValues())
+}
+
+// AddRegionRaw is the same as AddRegion, but takes the params as url.Values instead of typed params
+func (s *RegionService) AddRegionRaw(v url.Values) (*AddRegionResponse, error) {
+	return s.AddRegionRawWithContext(context.Background(), v)
+}
+
+// AddRegionRawWithContext is the same as AddRegionRaw, but the request is cancelled when the context is done
+func (s *RegionService) AddRegionRawWithContext(ctx context.Context, v url.Values) (*AddRegionResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "addRegion", "addregionresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -106,6 +193,16 @@ type ListRegionsParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ListRegionsParams) Clone() *ListRegionsParams {
+	c := &ListRegionsParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListRegionsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -140,6 +237,13 @@ func (p *ListRegionsParams) SetId(v int) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *ListRegionsParams) SetIdIfUnset(v int) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 func (p *ListRegionsParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -148,6 +252,13 @@ func (p *ListRegionsParams) SetKeyword(v string) {
 	return
 }
 
+// SetKeywordIfUnset sets keyword to v, unless it is already set
+func (p *ListRegionsParams) SetKeywordIfUnset(v string) {
+	if _, found := p.p["keyword"]; !found {
+		p.SetKeyword(v)
+	}
+}
+
 func (p *ListRegionsParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -156,6 +267,13 @@ func (p *ListRegionsParams) SetName(v string) {
 	return
 }
 
+// SetNameIfUnset sets name to v, unless it is already set
+func (p *ListRegionsParams) SetNameIfUnset(v string) {
+	if _, found := p.p["name"]; !found {
+		p.SetName(v)
+	}
+}
+
 func (p *ListRegionsParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -164,6 +282,13 @@ func (p *ListRegionsParams) SetPage(v int) {
 	return
 }
 
+// SetPageIfUnset sets page to v, unless it is already set
+func (p *ListRegionsParams) SetPageIfUnset(v int) {
+	if _, found := p.p["page"]; !found {
+		p.SetPage(v)
+	}
+}
+
 func (p *ListRegionsParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -172,17 +297,43 @@ func (p *ListRegionsParams) SetPagesize(v int) {
 	return
 }
 
+// SetPagesizeIfUnset sets pagesize to v, unless it is already set
+func (p *ListRegionsParams) SetPagesizeIfUnset(v int) {
+	if _, found := p.p["pagesize"]; !found {
+		p.SetPagesize(v)
+	}
+}
+
 // You should always use this function to get a new ListRegionsParams instance,
-// as then you are sure you have configured all required params
-func (s *RegionService) NewListRegionsParams() *ListRegionsParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *RegionService) NewListRegionsParams(opts ...func(*ListRegionsParams)) *ListRegionsParams {
 	p := &ListRegionsParams{}
 	p.p = make(map[string]interface{})
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Lists Regions
 func (s *RegionService) ListRegions(p *ListRegionsParams) (*ListRegionsResponse, error) {
-	resp, err := s.cs.newRequest("listRegions", p.toURLValues())
+	return s.ListRegionsWithContext(context.Background(), p)
+}
+
+// ListRegionsWithContext is the same as ListRegions, but the request is cancelled when the context is done
+func (s *RegionService) ListRegionsWithContext(ctx context.Context, p *ListRegionsParams) (*ListRegionsResponse, error) {
+	return s.ListRegionsRawWithContext(ctx, p.toURLValues())
+}
+
+// ListRegionsRaw is the same as ListRegions, but takes the params as url.Values instead of typed params
+func (s *RegionService) ListRegionsRaw(v url.Values) (*ListRegionsResponse, error) {
+	return s.ListRegionsRawWithContext(context.Background(), v)
+}
+
+// ListRegionsRawWithContext is the same as ListRegionsRaw, but the request is cancelled when the context is done
+func (s *RegionService) ListRegionsRawWithContext(ctx context.Context, v url.Values) (*ListRegionsResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "listRegions", "listregionsresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -195,6 +346,93 @@ func (s *RegionService) ListRegions(p *ListRegionsParams) (*ListRegionsResponse,
 	return &r, nil
 }
 
+// StreamRegions fetches the results of ListRegions page by page in the background and sends them on the
+// returned channel. Both returned channels are closed when done. If fetching a page fails, or the context
+// is done before all results are sent, the error is sent on the error channel and fetching stops. A
+// request for a page in flight is cancelled when the context is done. The page and pagesize set in the
+// params are ignored.
+func (s *RegionService) StreamRegions(ctx context.Context, p *ListRegionsParams) (<-chan *Region, <-chan error) {
+	ch := make(chan *Region)
+	errs := make(chan error, 1)
+
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesize(500)
+
+	go func() {
+		defer close(errs)
+		defer close(ch)
+
+		for page, count := 1, 0; ; page++ {
+			pp.SetPage(page)
+
+			l, err := s.ListRegionsWithContext(ctx, pp)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			for _, v := range l.Regions {
+				select {
+				case ch <- v:
+				case <-ctx.Done():
+					errs <- ctx.Err()
+					return
+				}
+			}
+
+			count += len(l.Regions)
+			if len(l.Regions) < 500 || count >= l.Count {
+				return
+			}
+		}
+	}()
+
+	return ch, errs
+}
+
+// ListRegionsWhere returns all results of ListRegions for which pred returns true. Note that the results are
+// filtered client-side, so all results matching the params are fetched first.
+// All pages are fetched, so the page and pagesize set in the params are ignored.
+func (s *RegionService) ListRegionsWhere(p *ListRegionsParams, pred func(*Region) bool) ([]*Region, error) {
+	var r []*Region
+	ch, errs := s.StreamRegions(context.Background(), p)
+	for v := range ch {
+		if pred(v) {
+			r = append(r, v)
+		}
+	}
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ListAllRegions returns the results of ListRegions across all pages, by calling ListRegions page by page until all
+// results are fetched. The pagesize set in the params is used for every page (500 if not set), and the
+// page set in the params is ignored.
+func (s *RegionService) ListAllRegions(p *ListRegionsParams) ([]*Region, error) {
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesizeIfUnset(500)
+	pagesize := pp.p["pagesize"].(int)
+
+	var r []*Region
+	for page := 1; ; page++ {
+		pp.SetPage(page)
+
+		l, err := s.ListRegions(pp)
+		if err != nil {
+			return nil, err
+		}
+		r = append(r, l.Regions...)
+
+		if len(l.Regions) < pagesize || len(r) >= l.Count {
+			return r, nil
+		}
+	}
+}
+
 type ListRegionsResponse struct {
 	Count   int       `json:"count"`
 	Regions []*Region `json:"region"`
@@ -212,6 +450,16 @@ type RemoveRegionParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *RemoveRegionParams) Clone() *RemoveRegionParams {
+	c := &RemoveRegionParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *RemoveRegionParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -224,6 +472,16 @@ func (p *RemoveRegionParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *RemoveRegionParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of removeRegion", k)
+		}
+	}
+	return nil
+}
+
 func (p *RemoveRegionParams) SetId(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -232,18 +490,54 @@ func (p *RemoveRegionParams) SetId(v int) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *RemoveRegionParams) SetIdIfUnset(v int) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 // You should always use this function to get a new RemoveRegionParams instance,
-// as then you are sure you have configured all required params
-func (s *RegionService) NewRemoveRegionParams(id int) *RemoveRegionParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *RegionService) NewRemoveRegionParams(id int, opts ...func(*RemoveRegionParams)) *RemoveRegionParams {
 	p := &RemoveRegionParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Removes specified region
-func (s *RegionService) RemoveRegion(p *RemoveRegionParams) (*RemoveRegionResponse, error) {
-	resp, err := s.cs.newRequest("removeRegion", p.toURLValues())
+func (s *RegionService) RemoveRegion(p *RemoveRegionParams, opts ...OptionFunc) (*RemoveRegionResponse, error) {
+	return s.RemoveRegionWithContext(context.Background(), p, opts...)
+}
+
+// RemoveRegionWithContext is the same as RemoveRegion, but the request is cancelled when the context is done
+func (s *RegionService) RemoveRegionWithContext(ctx context.Context, p *RemoveRegionParams, opts ...OptionFunc) (*RemoveRegionResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.RemoveRegionRawWithContext(ctx, p.toURLValues())
+}
+
+// RemoveRegionRaw is the same as RemoveRegion, but takes the params as url.Values instead of typed params
+func (s *RegionService) RemoveRegionRaw(v url.Values) (*RemoveRegionResponse, error) {
+	return s.RemoveRegionRawWithContext(context.Background(), v)
+}
+
+// RemoveRegionRawWithContext is the same as RemoveRegionRaw, but the request is cancelled when the context is done
+func (s *RegionService) RemoveRegionRawWithContext(ctx context.Context, v url.Values) (*RemoveRegionResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "removeRegion", "removeregionresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -284,6 +578,16 @@ type UpdateRegionParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *UpdateRegionParams) Clone() *UpdateRegionParams {
+	c := &UpdateRegionParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *UpdateRegionParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -302,6 +606,16 @@ func (p *UpdateRegionParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *UpdateRegionParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of updateRegion", k)
+		}
+	}
+	return nil
+}
+
 func (p *UpdateRegionParams) SetEndpoint(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -310,6 +624,13 @@ func (p *UpdateRegionParams) SetEndpoint(v string) {
 	return
 }
 
+// SetEndpointIfUnset sets endpoint to v, unless it is already set
+func (p *UpdateRegionParams) SetEndpointIfUnset(v string) {
+	if _, found := p.p["endpoint"]; !found {
+		p.SetEndpoint(v)
+	}
+}
+
 func (p *UpdateRegionParams) SetId(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -318,6 +639,13 @@ func (p *UpdateRegionParams) SetId(v int) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *UpdateRegionParams) SetIdIfUnset(v int) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 func (p *UpdateRegionParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -326,18 +654,54 @@ func (p *UpdateRegionParams) SetName(v string) {
 	return
 }
 
+// SetNameIfUnset sets name to v, unless it is already set
+func (p *UpdateRegionParams) SetNameIfUnset(v string) {
+	if _, found := p.p["name"]; !found {
+		p.SetName(v)
+	}
+}
+
 // You should always use this function to get a new UpdateRegionParams instance,
-// as then you are sure you have configured all required params
-func (s *RegionService) NewUpdateRegionParams(id int) *UpdateRegionParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *RegionService) NewUpdateRegionParams(id int, opts ...func(*UpdateRegionParams)) *UpdateRegionParams {
 	p := &UpdateRegionParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Updates a region
-func (s *RegionService) UpdateRegion(p *UpdateRegionParams) (*UpdateRegionResponse, error) {
-	resp, err := s.cs.newRequest("updateRegion", p.toURLValues())
+func (s *RegionService) UpdateRegion(p *UpdateRegionParams, opts ...OptionFunc) (*UpdateRegionResponse, error) {
+	return s.UpdateRegionWithContext(context.Background(), p, opts...)
+}
+
+// UpdateRegionWithContext is the same as UpdateRegion, but the request is cancelled when the context is done
+func (s *RegionService) UpdateRegionWithContext(ctx context.Context, p *UpdateRegionParams, opts ...OptionFunc) (*UpdateRegionResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.UpdateRegionRawWithContext(ctx, p.toURLValues())
+}
+
+// UpdateRegionRaw is the same as UpdateRegion, but takes the params as url.Values instead of typed params
+func (s *RegionService) UpdateRegionRaw(v url.Values) (*UpdateRegionResponse, error) {
+	return s.UpdateRegionRawWithContext(context.Background(), v)
+}
+
+// UpdateRegionRawWithContext is the same as UpdateRegionRaw, but the request is cancelled when the context is done
+func (s *RegionService) UpdateRegionRawWithContext(ctx context.Context, v url.Values) (*UpdateRegionResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "updateRegion", "updateregionresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -17,6 +17,7 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
@@ -27,16 +28,33 @@ type AddResourceDetailParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *AddResourceDetailParams) Clone() *AddResourceDetailParams {
+	c := &AddResourceDetailParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *AddResourceDetailParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
 	}
 	if v, found := p.p["details"]; found {
-		i := 0
-		for k, vv := range v.(map[string]string) {
-			u.Set(fmt.Sprintf("details[%d].%s", i, k), vv)
-			i++
+		switch m := v.(type) {
+		case []KeyValuePair:
+			for i, kv := range m {
+				u.Set(fmt.Sprintf("details[%d].key", i), kv.Key)
+				u.Set(fmt.Sprintf("details[%d].value", i), kv.Value)
+			}
+		case map[string]string:
+			for i, k := range getSortedKeysFromMap(m) {
+				u.Set(fmt.Sprintf("details[%d].key", i), k)
+				u.Set(fmt.Sprintf("details[%d].value", i), m[k])
+			}
 		}
 	}
 	if v, found := p.p["fordisplay"]; found {
@@ -52,6 +70,16 @@ func (p *AddResourceDetailParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *AddResourceDetailParams) validate() error {
+	for _, k := range []string{"details", "resourceid", "resourcetype"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of addResourceDetail", k)
+		}
+	}
+	return nil
+}
+
 func (p *AddResourceDetailParams) SetDetails(v map[string]string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -60,6 +88,21 @@ func (p *AddResourceDetailParams) SetDetails(v map[string]string) {
 	return
 }
 
+// SetDetailsIfUnset sets details to v, unless it is already set
+func (p *AddResourceDetailParams) SetDetailsIfUnset(v map[string]string) {
+	if _, found := p.p["details"]; !found {
+		p.SetDetails(v)
+	}
+}
+
+// SetDetailsKV sets the details as key/value pairs, which are encoded in the given order
+func (p *AddResourceDetailParams) SetDetailsKV(v []KeyValuePair) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["details"] = v
+}
+
 func (p *AddResourceDetailParams) SetFordisplay(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -68,6 +111,13 @@ func (p *AddResourceDetailParams) SetFordisplay(v bool) {
 	return
 }
 
+// SetFordisplayIfUnset sets fordisplay to v, unless it is already set
+func (p *AddResourceDetailParams) SetFordisplayIfUnset(v bool) {
+	if _, found := p.p["fordisplay"]; !found {
+		p.SetFordisplay(v)
+	}
+}
+
 func (p *AddResourceDetailParams) SetResourceid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -76,6 +126,13 @@ func (p *AddResourceDetailParams) SetResourceid(v string) {
 	return
 }
 
+// SetResourceidIfUnset sets resourceid to v, unless it is already set
+func (p *AddResourceDetailParams) SetResourceidIfUnset(v string) {
+	if _, found := p.p["resourceid"]; !found {
+		p.SetResourceid(v)
+	}
+}
+
 func (p *AddResourceDetailParams) SetResourcetype(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -84,20 +141,75 @@ func (p *AddResourceDetailParams) SetResourcetype(v string) {
 	return
 }
 
+// SetResourcetypeIfUnset sets resourcetype to v, unless it is already set
+func (p *AddResourceDetailParams) SetResourcetypeIfUnset(v string) {
+	if _, found := p.p["resourcetype"]; !found {
+		p.SetResourcetype(v)
+	}
+}
+
 // You should always use this function to get a new AddResourceDetailParams instance,
-// as then you are sure you have configured all required params
-func (s *ResourcemetadataService) NewAddResourceDetailParams(details map[string]string, resourceid string, resourcetype string) *AddResourceDetailParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *ResourcemetadataService) NewAddResourceDetailParams(details map[string]string, resourceid string, resourcetype string, opts ...func(*AddResourceDetailParams)) *AddResourceDetailParams {
 	p := &AddResourceDetailParams{}
 	p.p = make(map[string]interface{})
 	p.p["details"] = details
 	p.p["resourceid"] = resourceid
 	p.p["resourcetype"] = resourcetype
+	for _, fn := range opts {
+		fn(p)
+	}
+	return p
+}
+
+// AddResourceDetailOpts contains all params that can be set on a AddResourceDetailParams.
+// Optional params are pointers (or slices and maps) which are only set when not nil.
+type AddResourceDetailOpts struct {
+	Details      map[string]string
+	Fordisplay   *bool
+	Resourceid   string
+	Resourcetype string
+}
+
+// NewAddResourceDetailParamsFromOpts is an alternative for NewAddResourceDetailParams which takes all params using
+// an options struct, as that is easier to read for APIs with many required params
+func (s *ResourcemetadataService) NewAddResourceDetailParamsFromOpts(o AddResourceDetailOpts) *AddResourceDetailParams {
+	p := s.NewAddResourceDetailParams(o.Details, o.Resourceid, o.Resourcetype)
+	if o.Fordisplay != nil {
+		p.p["fordisplay"] = *o.Fordisplay
+	}
 	return p
 }
 
 // Adds detail for the Resource.
-func (s *ResourcemetadataService) AddResourceDetail(p *AddResourceDetailParams) (*AddResourceDetailResponse, error) {
-	resp, err := s.cs.newRequest("addResourceDetail", p.toURLValues())
+func (s *ResourcemetadataService) AddResourceDetail(p *AddResourceDetailParams, opts ...OptionFunc) (*AddResourceDetailResponse, error) {
+	return s.AddResourceDetailWithContext(context.Background(), p, opts...)
+}
+
+// AddResourceDetailWithContext is the same as AddResourceDetail, but the request is cancelled when the context is done
+func (s *ResourcemetadataService) AddResourceDetailWithContext(ctx context.Context, p *AddResourceDetailParams, opts ...OptionFunc) (*AddResourceDetailResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.AddResourceDetailRawWithContext(ctx, p.toURLValues())
+}
+
+// AddResourceDetailRaw is the same as AddResourceDetail, but takes the params as url.Values instead of typed params
+func (s *ResourcemetadataService) AddResourceDetailRaw(v url.Values) (*AddResourceDetailResponse, error) {
+	return s.AddResourceDetailRawWithContext(context.Background(), v)
+}
+
+// AddResourceDetailRawWithContext is the same as AddResourceDetailRaw, but the request is cancelled when the context is done
+func (s *ResourcemetadataService) AddResourceDetailRawWithContext(ctx context.Context, v url.Values) (*AddResourceDetailResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "addResourceDetail", "addresourcedetailresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -109,9 +221,9 @@ func (s *ResourcemetadataService) AddResourceDetail(p *AddResourceDetailParams)
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -135,6 +247,16 @@ type GetVolumeSnapshotDetailsParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *GetVolumeSnapshotDetailsParams) Clone() *GetVolumeSnapshotDetailsParams {
+	c := &GetVolumeSnapshotDetailsParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *GetVolumeSnapshotDetailsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -146,6 +268,16 @@ func (p *GetVolumeSnapshotDetailsParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *GetVolumeSnapshotDetailsParams) validate() error {
+	for _, k := range []string{"snapshotid"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of getVolumeSnapshotDetails", k)
+		}
+	}
+	return nil
+}
+
 func (p *GetVolumeSnapshotDetailsParams) SetSnapshotid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -154,18 +286,54 @@ func (p *GetVolumeSnapshotDetailsParams) SetSnapshotid(v string) {
 	return
 }
 
+// SetSnapshotidIfUnset sets snapshotid to v, unless it is already set
+func (p *GetVolumeSnapshotDetailsParams) SetSnapshotidIfUnset(v string) {
+	if _, found := p.p["snapshotid"]; !found {
+		p.SetSnapshotid(v)
+	}
+}
+
 // You should always use this function to get a new GetVolumeSnapshotDetailsParams instance,
-// as then you are sure you have configured all required params
-func (s *ResourcemetadataService) NewGetVolumeSnapshotDetailsParams(snapshotid string) *GetVolumeSnapshotDetailsParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *ResourcemetadataService) NewGetVolumeSnapshotDetailsParams(snapshotid string, opts ...func(*GetVolumeSnapshotDetailsParams)) *GetVolumeSnapshotDetailsParams {
 	p := &GetVolumeSnapshotDetailsParams{}
 	p.p = make(map[string]interface{})
 	p.p["snapshotid"] = snapshotid
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Get Volume Snapshot Details
-func (s *ResourcemetadataService) GetVolumeSnapshotDetails(p *GetVolumeSnapshotDetailsParams) (*GetVolumeSnapshotDetailsResponse, error) {
-	resp, err := s.cs.newRequest("getVolumeSnapshotDetails", p.toURLValues())
+func (s *ResourcemetadataService) GetVolumeSnapshotDetails(p *GetVolumeSnapshotDetailsParams, opts ...OptionFunc) (*GetVolumeSnapshotDetailsResponse, error) {
+	return s.GetVolumeSnapshotDetailsWithContext(context.Background(), p, opts...)
+}
+
+// GetVolumeSnapshotDetailsWithContext is the same as GetVolumeSnapshotDetails, but the request is cancelled when the context is done
+func (s *ResourcemetadataService) GetVolumeSnapshotDetailsWithContext(ctx context.Context, p *GetVolumeSnapshotDetailsParams, opts ...OptionFunc) (*GetVolumeSnapshotDetailsResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.GetVolumeSnapshotDetailsRawWithContext(ctx, p.toURLValues())
+}
+
+// GetVolumeSnapshotDetailsRaw is the same as GetVolumeSnapshotDetails, but takes the params as url.Values instead of typed params
+func (s *ResourcemetadataService) GetVolumeSnapshotDetailsRaw(v url.Values) (*GetVolumeSnapshotDetailsResponse, error) {
+	return s.GetVolumeSnapshotDetailsRawWithContext(context.Background(), v)
+}
+
+// GetVolumeSnapshotDetailsRawWithContext is the same as GetVolumeSnapshotDetailsRaw, but the request is cancelled when the context is done
+func (s *ResourcemetadataService) GetVolumeSnapshotDetailsRawWithContext(ctx context.Context, v url.Values) (*GetVolumeSnapshotDetailsResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "getVolumeSnapshotDetails", "getvolumesnapshotdetailsresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -186,6 +354,16 @@ type ListResourceDetailsParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ListResourceDetailsParams) Clone() *ListResourceDetailsParams {
+	c := &ListResourceDetailsParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListResourceDetailsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -238,6 +416,16 @@ func (p *ListResourceDetailsParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *ListResourceDetailsParams) validate() error {
+	for _, k := range []string{"resourcetype"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of listResourceDetails", k)
+		}
+	}
+	return nil
+}
+
 func (p *ListResourceDetailsParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -246,6 +434,13 @@ func (p *ListResourceDetailsParams) SetAccount(v string) {
 	return
 }
 
+// SetAccountIfUnset sets account to v, unless it is already set
+func (p *ListResourceDetailsParams) SetAccountIfUnset(v string) {
+	if _, found := p.p["account"]; !found {
+		p.SetAccount(v)
+	}
+}
+
 func (p *ListResourceDetailsParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -254,6 +449,13 @@ func (p *ListResourceDetailsParams) SetDomainid(v string) {
 	return
 }
 
+// SetDomainidIfUnset sets domainid to v, unless it is already set
+func (p *ListResourceDetailsParams) SetDomainidIfUnset(v string) {
+	if _, found := p.p["domainid"]; !found {
+		p.SetDomainid(v)
+	}
+}
+
 func (p *ListResourceDetailsParams) SetFordisplay(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -262,6 +464,13 @@ func (p *ListResourceDetailsParams) SetFordisplay(v bool) {
 	return
 }
 
+// SetFordisplayIfUnset sets fordisplay to v, unless it is already set
+func (p *ListResourceDetailsParams) SetFordisplayIfUnset(v bool) {
+	if _, found := p.p["fordisplay"]; !found {
+		p.SetFordisplay(v)
+	}
+}
+
 func (p *ListResourceDetailsParams) SetIsrecursive(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -270,6 +479,13 @@ func (p *ListResourceDetailsParams) SetIsrecursive(v bool) {
 	return
 }
 
+// SetIsrecursiveIfUnset sets isrecursive to v, unless it is already set
+func (p *ListResourceDetailsParams) SetIsrecursiveIfUnset(v bool) {
+	if _, found := p.p["isrecursive"]; !found {
+		p.SetIsrecursive(v)
+	}
+}
+
 func (p *ListResourceDetailsParams) SetKey(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -278,6 +494,13 @@ func (p *ListResourceDetailsParams) SetKey(v string) {
 	return
 }
 
+// SetKeyIfUnset sets key to v, unless it is already set
+func (p *ListResourceDetailsParams) SetKeyIfUnset(v string) {
+	if _, found := p.p["key"]; !found {
+		p.SetKey(v)
+	}
+}
+
 func (p *ListResourceDetailsParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -286,6 +509,13 @@ func (p *ListResourceDetailsParams) SetKeyword(v string) {
 	return
 }
 
+// SetKeywordIfUnset sets keyword to v, unless it is already set
+func (p *ListResourceDetailsParams) SetKeywordIfUnset(v string) {
+	if _, found := p.p["keyword"]; !found {
+		p.SetKeyword(v)
+	}
+}
+
 func (p *ListResourceDetailsParams) SetListall(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -294,6 +524,13 @@ func (p *ListResourceDetailsParams) SetListall(v bool) {
 	return
 }
 
+// SetListallIfUnset sets listall to v, unless it is already set
+func (p *ListResourceDetailsParams) SetListallIfUnset(v bool) {
+	if _, found := p.p["listall"]; !found {
+		p.SetListall(v)
+	}
+}
+
 func (p *ListResourceDetailsParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -302,6 +539,13 @@ func (p *ListResourceDetailsParams) SetPage(v int) {
 	return
 }
 
+// SetPageIfUnset sets page to v, unless it is already set
+func (p *ListResourceDetailsParams) SetPageIfUnset(v int) {
+	if _, found := p.p["page"]; !found {
+		p.SetPage(v)
+	}
+}
+
 func (p *ListResourceDetailsParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -310,6 +554,13 @@ func (p *ListResourceDetailsParams) SetPagesize(v int) {
 	return
 }
 
+// SetPagesizeIfUnset sets pagesize to v, unless it is already set
+func (p *ListResourceDetailsParams) SetPagesizeIfUnset(v int) {
+	if _, found := p.p["pagesize"]; !found {
+		p.SetPagesize(v)
+	}
+}
+
 func (p *ListResourceDetailsParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -318,6 +569,13 @@ func (p *ListResourceDetailsParams) SetProjectid(v string) {
 	return
 }
 
+// SetProjectidIfUnset sets projectid to v, unless it is already set
+func (p *ListResourceDetailsParams) SetProjectidIfUnset(v string) {
+	if _, found := p.p["projectid"]; !found {
+		p.SetProjectid(v)
+	}
+}
+
 func (p *ListResourceDetailsParams) SetResourceid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -326,6 +584,13 @@ func (p *ListResourceDetailsParams) SetResourceid(v string) {
 	return
 }
 
+// SetResourceidIfUnset sets resourceid to v, unless it is already set
+func (p *ListResourceDetailsParams) SetResourceidIfUnset(v string) {
+	if _, found := p.p["resourceid"]; !found {
+		p.SetResourceid(v)
+	}
+}
+
 func (p *ListResourceDetailsParams) SetResourcetype(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -334,6 +599,13 @@ func (p *ListResourceDetailsParams) SetResourcetype(v string) {
 	return
 }
 
+// SetResourcetypeIfUnset sets resourcetype to v, unless it is already set
+func (p *ListResourceDetailsParams) SetResourcetypeIfUnset(v string) {
+	if _, found := p.p["resourcetype"]; !found {
+		p.SetResourcetype(v)
+	}
+}
+
 func (p *ListResourceDetailsParams) SetValue(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -342,18 +614,50 @@ func (p *ListResourceDetailsParams) SetValue(v string) {
 	return
 }
 
+// SetValueIfUnset sets value to v, unless it is already set
+func (p *ListResourceDetailsParams) SetValueIfUnset(v string) {
+	if _, found := p.p["value"]; !found {
+		p.SetValue(v)
+	}
+}
+
+var _ ListAllSetter = (*ListResourceDetailsParams)(nil)
+
 // You should always use this function to get a new ListResourceDetailsParams instance,
-// as then you are sure you have configured all required params
-func (s *ResourcemetadataService) NewListResourceDetailsParams(resourcetype string) *ListResourceDetailsParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *ResourcemetadataService) NewListResourceDetailsParams(resourcetype string, opts ...func(*ListResourceDetailsParams)) *ListResourceDetailsParams {
 	p := &ListResourceDetailsParams{}
 	p.p = make(map[string]interface{})
 	p.p["resourcetype"] = resourcetype
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // List resource detail(s)
 func (s *ResourcemetadataService) ListResourceDetails(p *ListResourceDetailsParams) (*ListResourceDetailsResponse, error) {
-	resp, err := s.cs.newRequest("listResourceDetails", p.toURLValues())
+	return s.ListResourceDetailsWithContext(context.Background(), p)
+}
+
+// ListResourceDetailsWithContext is the same as ListResourceDetails, but the request is cancelled when the context is done
+func (s *ResourcemetadataService) ListResourceDetailsWithContext(ctx context.Context, p *ListResourceDetailsParams) (*ListResourceDetailsResponse, error) {
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.ListResourceDetailsRawWithContext(ctx, p.toURLValues())
+}
+
+// ListResourceDetailsRaw is the same as ListResourceDetails, but takes the params as url.Values instead of typed params
+func (s *ResourcemetadataService) ListResourceDetailsRaw(v url.Values) (*ListResourceDetailsResponse, error) {
+	return s.ListResourceDetailsRawWithContext(context.Background(), v)
+}
+
+// ListResourceDetailsRawWithContext is the same as ListResourceDetailsRaw, but the request is cancelled when the context is done
+func (s *ResourcemetadataService) ListResourceDetailsRawWithContext(ctx context.Context, v url.Values) (*ListResourceDetailsResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "listResourceDetails", "listresourcedetailsresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -366,6 +670,93 @@ func (s *ResourcemetadataService) ListResourceDetails(p *ListResourceDetailsPara
 	return &r, nil
 }
 
+// StreamResourceDetails fetches the results of ListResourceDetails page by page in the background and sends them on the
+// returned channel. Both returned channels are closed when done. If fetching a page fails, or the context
+// is done before all results are sent, the error is sent on the error channel and fetching stops. A
+// request for a page in flight is cancelled when the context is done. The page and pagesize set in the
+// params are ignored.
+func (s *ResourcemetadataService) StreamResourceDetails(ctx context.Context, p *ListResourceDetailsParams) (<-chan *ResourceDetail, <-chan error) {
+	ch := make(chan *ResourceDetail)
+	errs := make(chan error, 1)
+
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesize(500)
+
+	go func() {
+		defer close(errs)
+		defer close(ch)
+
+		for page, count := 1, 0; ; page++ {
+			pp.SetPage(page)
+
+			l, err := s.ListResourceDetailsWithContext(ctx, pp)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			for _, v := range l.ResourceDetails {
+				select {
+				case ch <- v:
+				case <-ctx.Done():
+					errs <- ctx.Err()
+					return
+				}
+			}
+
+			count += len(l.ResourceDetails)
+			if len(l.ResourceDetails) < 500 || count >= l.Count {
+				return
+			}
+		}
+	}()
+
+	return ch, errs
+}
+
+// ListResourceDetailsWhere returns all results of ListResourceDetails for which pred returns true. Note that the results are
+// filtered client-side, so all results matching the params are fetched first.
+// All pages are fetched, so the page and pagesize set in the params are ignored.
+func (s *ResourcemetadataService) ListResourceDetailsWhere(p *ListResourceDetailsParams, pred func(*ResourceDetail) bool) ([]*ResourceDetail, error) {
+	var r []*ResourceDetail
+	ch, errs := s.StreamResourceDetails(context.Background(), p)
+	for v := range ch {
+		if pred(v) {
+			r = append(r, v)
+		}
+	}
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ListAllResourceDetails returns the results of ListResourceDetails across all pages, by calling ListResourceDetails page by page until all
+// results are fetched. The pagesize set in the params is used for every page (500 if not set), and the
+// page set in the params is ignored.
+func (s *ResourcemetadataService) ListAllResourceDetails(p *ListResourceDetailsParams) ([]*ResourceDetail, error) {
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesizeIfUnset(500)
+	pagesize := pp.p["pagesize"].(int)
+
+	var r []*ResourceDetail
+	for page := 1; ; page++ {
+		pp.SetPage(page)
+
+		l, err := s.ListResourceDetails(pp)
+		if err != nil {
+			return nil, err
+		}
+		r = append(r, l.ResourceDetails...)
+
+		if len(l.ResourceDetails) < pagesize || len(r) >= l.Count {
+			return r, nil
+		}
+	}
+}
+
 type ListResourceDetailsResponse struct {
 	Count           int               `json:"count"`
 	ResourceDetails []*ResourceDetail `json:"resourcedetail"`
@@ -388,6 +779,16 @@ type RemoveResourceDetailParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *RemoveResourceDetailParams) Clone() *RemoveResourceDetailParams {
+	c := &RemoveResourceDetailParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *RemoveResourceDetailParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -405,6 +806,16 @@ func (p *RemoveResourceDetailParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *RemoveResourceDetailParams) validate() error {
+	for _, k := range []string{"resourceid", "resourcetype"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of removeResourceDetail", k)
+		}
+	}
+	return nil
+}
+
 func (p *RemoveResourceDetailParams) SetKey(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -413,6 +824,13 @@ func (p *RemoveResourceDetailParams) SetKey(v string) {
 	return
 }
 
+// SetKeyIfUnset sets key to v, unless it is already set
+func (p *RemoveResourceDetailParams) SetKeyIfUnset(v string) {
+	if _, found := p.p["key"]; !found {
+		p.SetKey(v)
+	}
+}
+
 func (p *RemoveResourceDetailParams) SetResourceid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -421,6 +839,13 @@ func (p *RemoveResourceDetailParams) SetResourceid(v string) {
 	return
 }
 
+// SetResourceidIfUnset sets resourceid to v, unless it is already set
+func (p *RemoveResourceDetailParams) SetResourceidIfUnset(v string) {
+	if _, found := p.p["resourceid"]; !found {
+		p.SetResourceid(v)
+	}
+}
+
 func (p *RemoveResourceDetailParams) SetResourcetype(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -429,19 +854,55 @@ func (p *RemoveResourceDetailParams) SetResourcetype(v string) {
 	return
 }
 
+// SetResourcetypeIfUnset sets resourcetype to v, unless it is already set
+func (p *RemoveResourceDetailParams) SetResourcetypeIfUnset(v string) {
+	if _, found := p.p["resourcetype"]; !found {
+		p.SetResourcetype(v)
+	}
+}
+
 // You should always use this function to get a new RemoveResourceDetailParams instance,
-// as then you are sure you have configured all required params
-func (s *ResourcemetadataService) NewRemoveResourceDetailParams(resourceid string, resourcetype string) *RemoveResourceDetailParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *ResourcemetadataService) NewRemoveResourceDetailParams(resourceid string, resourcetype string, opts ...func(*RemoveResourceDetailParams)) *RemoveResourceDetailParams {
 	p := &RemoveResourceDetailParams{}
 	p.p = make(map[string]interface{})
 	p.p["resourceid"] = resourceid
 	p.p["resourcetype"] = resourcetype
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Removes detail for the Resource.
-func (s *ResourcemetadataService) RemoveResourceDetail(p *RemoveResourceDetailParams) (*RemoveResourceDetailResponse, error) {
-	resp, err := s.cs.newRequest("removeResourceDetail", p.toURLValues())
+func (s *ResourcemetadataService) RemoveResourceDetail(p *RemoveResourceDetailParams, opts ...OptionFunc) (*RemoveResourceDetailResponse, error) {
+	return s.RemoveResourceDetailWithContext(context.Background(), p, opts...)
+}
+
+// RemoveResourceDetailWithContext is the same as RemoveResourceDetail, but the request is cancelled when the context is done
+func (s *ResourcemetadataService) RemoveResourceDetailWithContext(ctx context.Context, p *RemoveResourceDetailParams, opts ...OptionFunc) (*RemoveResourceDetailResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.RemoveResourceDetailRawWithContext(ctx, p.toURLValues())
+}
+
+// RemoveResourceDetailRaw is the same as RemoveResourceDetail, but takes the params as url.Values instead of typed params
+func (s *ResourcemetadataService) RemoveResourceDetailRaw(v url.Values) (*RemoveResourceDetailResponse, error) {
+	return s.RemoveResourceDetailRawWithContext(context.Background(), v)
+}
+
+// RemoveResourceDetailRawWithContext is the same as RemoveResourceDetailRaw, but the request is cancelled when the context is done
+func (s *ResourcemetadataService) RemoveResourceDetailRawWithContext(ctx context.Context, v url.Values) (*RemoveResourceDetailResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "removeResourceDetail", "removeresourcedetailresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -453,9 +914,9 @@ func (s *ResourcemetadataService) RemoveResourceDetail(p *RemoveResourceDetailPa
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
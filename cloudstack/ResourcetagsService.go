@@ -17,6 +17,7 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
@@ -28,6 +29,16 @@ type CreateTagsParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *CreateTagsParams) Clone() *CreateTagsParams {
+	c := &CreateTagsParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *CreateTagsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -44,16 +55,25 @@ func (p *CreateTagsParams) toURLValues() url.Values {
 		u.Set("resourcetype", v.(string))
 	}
 	if v, found := p.p["tags"]; found {
-		i := 0
-		for k, vv := range v.(map[string]string) {
+		m := v.(map[string]string)
+		for i, k := range getSortedKeysFromMap(m) {
 			u.Set(fmt.Sprintf("tags[%d].key", i), k)
-			u.Set(fmt.Sprintf("tags[%d].value", i), vv)
-			i++
+			u.Set(fmt.Sprintf("tags[%d].value", i), m[k])
 		}
 	}
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *CreateTagsParams) validate() error {
+	for _, k := range []string{"resourceids", "resourcetype", "tags"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of createTags", k)
+		}
+	}
+	return nil
+}
+
 func (p *CreateTagsParams) SetCustomer(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -62,6 +82,13 @@ func (p *CreateTagsParams) SetCustomer(v string) {
 	return
 }
 
+// SetCustomerIfUnset sets customer to v, unless it is already set
+func (p *CreateTagsParams) SetCustomerIfUnset(v string) {
+	if _, found := p.p["customer"]; !found {
+		p.SetCustomer(v)
+	}
+}
+
 func (p *CreateTagsParams) SetResourceids(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -70,6 +97,18 @@ func (p *CreateTagsParams) SetResourceids(v []string) {
 	return
 }
 
+// SetResourceidsIfUnset sets resourceids to v, unless it is already set
+func (p *CreateTagsParams) SetResourceidsIfUnset(v []string) {
+	if _, found := p.p["resourceids"]; !found {
+		p.SetResourceids(v)
+	}
+}
+
+// SetResourceidsCSV splits v on commas and sets the resulting values
+func (p *CreateTagsParams) SetResourceidsCSV(v string) {
+	p.SetResourceids(splitCSV(v))
+}
+
 func (p *CreateTagsParams) SetResourcetype(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -78,6 +117,13 @@ func (p *CreateTagsParams) SetResourcetype(v string) {
 	return
 }
 
+// SetResourcetypeIfUnset sets resourcetype to v, unless it is already set
+func (p *CreateTagsParams) SetResourcetypeIfUnset(v string) {
+	if _, found := p.p["resourcetype"]; !found {
+		p.SetResourcetype(v)
+	}
+}
+
 func (p *CreateTagsParams) SetTags(v map[string]string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -86,20 +132,75 @@ func (p *CreateTagsParams) SetTags(v map[string]string) {
 	return
 }
 
+// SetTagsIfUnset sets tags to v, unless it is already set
+func (p *CreateTagsParams) SetTagsIfUnset(v map[string]string) {
+	if _, found := p.p["tags"]; !found {
+		p.SetTags(v)
+	}
+}
+
 // You should always use this function to get a new CreateTagsParams instance,
-// as then you are sure you have configured all required params
-func (s *ResourcetagsService) NewCreateTagsParams(resourceids []string, resourcetype string, tags map[string]string) *CreateTagsParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *ResourcetagsService) NewCreateTagsParams(resourceids []string, resourcetype string, tags map[string]string, opts ...func(*CreateTagsParams)) *CreateTagsParams {
 	p := &CreateTagsParams{}
 	p.p = make(map[string]interface{})
 	p.p["resourceids"] = resourceids
 	p.p["resourcetype"] = resourcetype
 	p.p["tags"] = tags
+	for _, fn := range opts {
+		fn(p)
+	}
+	return p
+}
+
+// CreateTagsOpts contains all params that can be set on a CreateTagsParams.
+// Optional params are pointers (or slices and maps) which are only set when not nil.
+type CreateTagsOpts struct {
+	Customer     *string
+	Resourceids  []string
+	Resourcetype string
+	Tags         map[string]string
+}
+
+// NewCreateTagsParamsFromOpts is an alternative for NewCreateTagsParams which takes all params using
+// an options struct, as that is easier to read for APIs with many required params
+func (s *ResourcetagsService) NewCreateTagsParamsFromOpts(o CreateTagsOpts) *CreateTagsParams {
+	p := s.NewCreateTagsParams(o.Resourceids, o.Resourcetype, o.Tags)
+	if o.Customer != nil {
+		p.p["customer"] = *o.Customer
+	}
 	return p
 }
 
 // Creates resource tag(s)
-func (s *ResourcetagsService) CreateTags(p *CreateTagsParams) (*CreateTagsResponse, error) {
-	resp, err := s.cs.newRequest("createTags", p.toURLValues())
+func (s *ResourcetagsService) CreateTags(p *CreateTagsParams, opts ...OptionFunc) (*CreateTagsResponse, error) {
+	return s.CreateTagsWithContext(context.Background(), p, opts...)
+}
+
+// CreateTagsWithContext is the same as CreateTags, but the request is cancelled when the context is done
+func (s *ResourcetagsService) CreateTagsWithContext(ctx context.Context, p *CreateTagsParams, opts ...OptionFunc) (*CreateTagsResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.CreateTagsRawWithContext(ctx, p.toURLValues())
+}
+
+// CreateTagsRaw is the same as CreateTags, but takes the params as url.Values instead of typed params
+func (s *ResourcetagsService) CreateTagsRaw(v url.Values) (*CreateTagsResponse, error) {
+	return s.CreateTagsRawWithContext(context.Background(), v)
+}
+
+// CreateTagsRawWithContext is the same as CreateTagsRaw, but the request is cancelled when the context is done
+func (s *ResourcetagsService) CreateTagsRawWithContext(ctx context.Context, v url.Values) (*CreateTagsResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "createTags", "createtagsresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -111,9 +212,9 @@ func (s *ResourcetagsService) CreateTags(p *CreateTagsParams) (*CreateTagsRespon
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -137,6 +238,16 @@ type DeleteTagsParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *DeleteTagsParams) Clone() *DeleteTagsParams {
+	c := &DeleteTagsParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeleteTagsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -150,16 +261,25 @@ func (p *DeleteTagsParams) toURLValues() url.Values {
 		u.Set("resourcetype", v.(string))
 	}
 	if v, found := p.p["tags"]; found {
-		i := 0
-		for k, vv := range v.(map[string]string) {
+		m := v.(map[string]string)
+		for i, k := range getSortedKeysFromMap(m) {
 			u.Set(fmt.Sprintf("tags[%d].key", i), k)
-			u.Set(fmt.Sprintf("tags[%d].value", i), vv)
-			i++
+			u.Set(fmt.Sprintf("tags[%d].value", i), m[k])
 		}
 	}
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *DeleteTagsParams) validate() error {
+	for _, k := range []string{"resourceids", "resourcetype"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of deleteTags", k)
+		}
+	}
+	return nil
+}
+
 func (p *DeleteTagsParams) SetResourceids(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -168,6 +288,18 @@ func (p *DeleteTagsParams) SetResourceids(v []string) {
 	return
 }
 
+// SetResourceidsIfUnset sets resourceids to v, unless it is already set
+func (p *DeleteTagsParams) SetResourceidsIfUnset(v []string) {
+	if _, found := p.p["resourceids"]; !found {
+		p.SetResourceids(v)
+	}
+}
+
+// SetResourceidsCSV splits v on commas and sets the resulting values
+func (p *DeleteTagsParams) SetResourceidsCSV(v string) {
+	p.SetResourceids(splitCSV(v))
+}
+
 func (p *DeleteTagsParams) SetResourcetype(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -176,6 +308,13 @@ func (p *DeleteTagsParams) SetResourcetype(v string) {
 	return
 }
 
+// SetResourcetypeIfUnset sets resourcetype to v, unless it is already set
+func (p *DeleteTagsParams) SetResourcetypeIfUnset(v string) {
+	if _, found := p.p["resourcetype"]; !found {
+		p.SetResourcetype(v)
+	}
+}
+
 func (p *DeleteTagsParams) SetTags(v map[string]string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -184,19 +323,55 @@ func (p *DeleteTagsParams) SetTags(v map[string]string) {
 	return
 }
 
+// SetTagsIfUnset sets tags to v, unless it is already set
+func (p *DeleteTagsParams) SetTagsIfUnset(v map[string]string) {
+	if _, found := p.p["tags"]; !found {
+		p.SetTags(v)
+	}
+}
+
 // You should always use this function to get a new DeleteTagsParams instance,
-// as then you are sure you have configured all required params
-func (s *ResourcetagsService) NewDeleteTagsParams(resourceids []string, resourcetype string) *DeleteTagsParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *ResourcetagsService) NewDeleteTagsParams(resourceids []string, resourcetype string, opts ...func(*DeleteTagsParams)) *DeleteTagsParams {
 	p := &DeleteTagsParams{}
 	p.p = make(map[string]interface{})
 	p.p["resourceids"] = resourceids
 	p.p["resourcetype"] = resourcetype
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Deleting resource tag(s)
-func (s *ResourcetagsService) DeleteTags(p *DeleteTagsParams) (*DeleteTagsResponse, error) {
-	resp, err := s.cs.newRequest("deleteTags", p.toURLValues())
+func (s *ResourcetagsService) DeleteTags(p *DeleteTagsParams, opts ...OptionFunc) (*DeleteTagsResponse, error) {
+	return s.DeleteTagsWithContext(context.Background(), p, opts...)
+}
+
+// DeleteTagsWithContext is the same as DeleteTags, but the request is cancelled when the context is done
+func (s *ResourcetagsService) DeleteTagsWithContext(ctx context.Context, p *DeleteTagsParams, opts ...OptionFunc) (*DeleteTagsResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.DeleteTagsRawWithContext(ctx, p.toURLValues())
+}
+
+// DeleteTagsRaw is the same as DeleteTags, but takes the params as url.Values instead of typed params
+func (s *ResourcetagsService) DeleteTagsRaw(v url.Values) (*DeleteTagsResponse, error) {
+	return s.DeleteTagsRawWithContext(context.Background(), v)
+}
+
+// DeleteTagsRawWithContext is the same as DeleteTagsRaw, but the request is cancelled when the context is done
+func (s *ResourcetagsService) DeleteTagsRawWithContext(ctx context.Context, v url.Values) (*DeleteTagsResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteTags", "deletetagsresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -208,9 +383,9 @@ func (s *ResourcetagsService) DeleteTags(p *DeleteTagsParams) (*DeleteTagsRespon
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -234,6 +409,16 @@ type ListStorageTagsParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ListStorageTagsParams) Clone() *ListStorageTagsParams {
+	c := &ListStorageTagsParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListStorageTagsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -261,6 +446,13 @@ func (p *ListStorageTagsParams) SetKeyword(v string) {
 	return
 }
 
+// SetKeywordIfUnset sets keyword to v, unless it is already set
+func (p *ListStorageTagsParams) SetKeywordIfUnset(v string) {
+	if _, found := p.p["keyword"]; !found {
+		p.SetKeyword(v)
+	}
+}
+
 func (p *ListStorageTagsParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -269,6 +461,13 @@ func (p *ListStorageTagsParams) SetPage(v int) {
 	return
 }
 
+// SetPageIfUnset sets page to v, unless it is already set
+func (p *ListStorageTagsParams) SetPageIfUnset(v int) {
+	if _, found := p.p["page"]; !found {
+		p.SetPage(v)
+	}
+}
+
 func (p *ListStorageTagsParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -277,14 +476,32 @@ func (p *ListStorageTagsParams) SetPagesize(v int) {
 	return
 }
 
+// SetPagesizeIfUnset sets pagesize to v, unless it is already set
+func (p *ListStorageTagsParams) SetPagesizeIfUnset(v int) {
+	if _, found := p.p["pagesize"]; !found {
+		p.SetPagesize(v)
+	}
+}
+
 // You should always use this function to get a new ListStorageTagsParams instance,
-// as then you are sure you have configured all required params
-func (s *ResourcetagsService) NewListStorageTagsParams() *ListStorageTagsParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *ResourcetagsService) NewListStorageTagsParams(opts ...func(*ListStorageTagsParams)) *ListStorageTagsParams {
 	p := &ListStorageTagsParams{}
 	p.p = make(map[string]interface{})
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
+func (p *ListStorageTagsParams) setFirstMatch() {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p[firstMatchKey] = true
+}
+
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *ResourcetagsService) GetStorageTagID(keyword string, opts ...OptionFunc) (string, int, error) {
 	p := &ListStorageTagsParams{}
@@ -292,7 +509,7 @@ func (s *ResourcetagsService) GetStorageTagID(keyword string, opts ...OptionFunc
 
 	p.p["keyword"] = keyword
 
-	for _, fn := range append(s.cs.options, opts...) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
 		if err := fn(s.cs, p); err != nil {
 			return "", -1, err
 		}
@@ -304,7 +521,7 @@ func (s *ResourcetagsService) GetStorageTagID(keyword string, opts ...OptionFunc
 	}
 
 	if l.Count == 0 {
-		return "", l.Count, fmt.Errorf("No match found for %s: %+v", keyword, l)
+		return "", l.Count, fmt.Errorf("%w for %s: %+v", ErrNotFound, keyword, l)
 	}
 
 	if l.Count == 1 {
@@ -317,13 +534,32 @@ func (s *ResourcetagsService) GetStorageTagID(keyword string, opts ...OptionFunc
 				return v.Id, l.Count, nil
 			}
 		}
+
+		if _, ok := p.p[firstMatchKey]; ok && len(l.StorageTags) > 0 {
+			return l.StorageTags[0].Id, l.Count, nil
+		}
 	}
 	return "", l.Count, fmt.Errorf("Could not find an exact match for %s: %+v", keyword, l)
 }
 
 // Lists storage tags
 func (s *ResourcetagsService) ListStorageTags(p *ListStorageTagsParams) (*ListStorageTagsResponse, error) {
-	resp, err := s.cs.newRequest("listStorageTags", p.toURLValues())
+	return s.ListStorageTagsWithContext(context.Background(), p)
+}
+
+// ListStorageTagsWithContext is the same as ListStorageTags, but the request is cancelled when the context is done
+func (s *ResourcetagsService) ListStorageTagsWithContext(ctx context.Context, p *ListStorageTagsParams) (*ListStorageTagsResponse, error) {
+	return s.ListStorageTagsRawWithContext(ctx, p.toURLValues())
+}
+
+// ListStorageTagsRaw is the same as ListStorageTags, but takes the params as url.Values instead of typed params
+func (s *ResourcetagsService) ListStorageTagsRaw(v url.Values) (*ListStorageTagsResponse, error) {
+	return s.ListStorageTagsRawWithContext(context.Background(), v)
+}
+
+// ListStorageTagsRawWithContext is the same as ListStorageTagsRaw, but the request is cancelled when the context is done
+func (s *ResourcetagsService) ListStorageTagsRawWithContext(ctx context.Context, v url.Values) (*ListStorageTagsResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "listStorageTags", "liststoragetagsresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -336,6 +572,93 @@ func (s *ResourcetagsService) ListStorageTags(p *ListStorageTagsParams) (*ListSt
 	return &r, nil
 }
 
+// StreamStorageTags fetches the results of ListStorageTags page by page in the background and sends them on the
+// returned channel. Both returned channels are closed when done. If fetching a page fails, or the context
+// is done before all results are sent, the error is sent on the error channel and fetching stops. A
+// request for a page in flight is cancelled when the context is done. The page and pagesize set in the
+// params are ignored.
+func (s *ResourcetagsService) StreamStorageTags(ctx context.Context, p *ListStorageTagsParams) (<-chan *StorageTag, <-chan error) {
+	ch := make(chan *StorageTag)
+	errs := make(chan error, 1)
+
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesize(500)
+
+	go func() {
+		defer close(errs)
+		defer close(ch)
+
+		for page, count := 1, 0; ; page++ {
+			pp.SetPage(page)
+
+			l, err := s.ListStorageTagsWithContext(ctx, pp)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			for _, v := range l.StorageTags {
+				select {
+				case ch <- v:
+				case <-ctx.Done():
+					errs <- ctx.Err()
+					return
+				}
+			}
+
+			count += len(l.StorageTags)
+			if len(l.StorageTags) < 500 || count >= l.Count {
+				return
+			}
+		}
+	}()
+
+	return ch, errs
+}
+
+// ListStorageTagsWhere returns all results of ListStorageTags for which pred returns true. Note that the results are
+// filtered client-side, so all results matching the params are fetched first.
+// All pages are fetched, so the page and pagesize set in the params are ignored.
+func (s *ResourcetagsService) ListStorageTagsWhere(p *ListStorageTagsParams, pred func(*StorageTag) bool) ([]*StorageTag, error) {
+	var r []*StorageTag
+	ch, errs := s.StreamStorageTags(context.Background(), p)
+	for v := range ch {
+		if pred(v) {
+			r = append(r, v)
+		}
+	}
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ListAllStorageTags returns the results of ListStorageTags across all pages, by calling ListStorageTags page by page until all
+// results are fetched. The pagesize set in the params is used for every page (500 if not set), and the
+// page set in the params is ignored.
+func (s *ResourcetagsService) ListAllStorageTags(p *ListStorageTagsParams) ([]*StorageTag, error) {
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesizeIfUnset(500)
+	pagesize := pp.p["pagesize"].(int)
+
+	var r []*StorageTag
+	for page := 1; ; page++ {
+		pp.SetPage(page)
+
+		l, err := s.ListStorageTags(pp)
+		if err != nil {
+			return nil, err
+		}
+		r = append(r, l.StorageTags...)
+
+		if len(l.StorageTags) < pagesize || len(r) >= l.Count {
+			return r, nil
+		}
+	}
+}
+
 type ListStorageTagsResponse struct {
 	Count       int           `json:"count"`
 	StorageTags []*StorageTag `json:"storagetag"`
@@ -351,6 +674,16 @@ type ListTagsParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ListTagsParams) Clone() *ListTagsParams {
+	c := &ListTagsParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListTagsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -410,6 +743,13 @@ func (p *ListTagsParams) SetAccount(v string) {
 	return
 }
 
+// SetAccountIfUnset sets account to v, unless it is already set
+func (p *ListTagsParams) SetAccountIfUnset(v string) {
+	if _, found := p.p["account"]; !found {
+		p.SetAccount(v)
+	}
+}
+
 func (p *ListTagsParams) SetCustomer(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -418,6 +758,13 @@ func (p *ListTagsParams) SetCustomer(v string) {
 	return
 }
 
+// SetCustomerIfUnset sets customer to v, unless it is already set
+func (p *ListTagsParams) SetCustomerIfUnset(v string) {
+	if _, found := p.p["customer"]; !found {
+		p.SetCustomer(v)
+	}
+}
+
 func (p *ListTagsParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -426,6 +773,13 @@ func (p *ListTagsParams) SetDomainid(v string) {
 	return
 }
 
+// SetDomainidIfUnset sets domainid to v, unless it is already set
+func (p *ListTagsParams) SetDomainidIfUnset(v string) {
+	if _, found := p.p["domainid"]; !found {
+		p.SetDomainid(v)
+	}
+}
+
 func (p *ListTagsParams) SetIsrecursive(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -434,6 +788,13 @@ func (p *ListTagsParams) SetIsrecursive(v bool) {
 	return
 }
 
+// SetIsrecursiveIfUnset sets isrecursive to v, unless it is already set
+func (p *ListTagsParams) SetIsrecursiveIfUnset(v bool) {
+	if _, found := p.p["isrecursive"]; !found {
+		p.SetIsrecursive(v)
+	}
+}
+
 func (p *ListTagsParams) SetKey(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -442,6 +803,13 @@ func (p *ListTagsParams) SetKey(v string) {
 	return
 }
 
+// SetKeyIfUnset sets key to v, unless it is already set
+func (p *ListTagsParams) SetKeyIfUnset(v string) {
+	if _, found := p.p["key"]; !found {
+		p.SetKey(v)
+	}
+}
+
 func (p *ListTagsParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -450,6 +818,13 @@ func (p *ListTagsParams) SetKeyword(v string) {
 	return
 }
 
+// SetKeywordIfUnset sets keyword to v, unless it is already set
+func (p *ListTagsParams) SetKeywordIfUnset(v string) {
+	if _, found := p.p["keyword"]; !found {
+		p.SetKeyword(v)
+	}
+}
+
 func (p *ListTagsParams) SetListall(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -458,6 +833,13 @@ func (p *ListTagsParams) SetListall(v bool) {
 	return
 }
 
+// SetListallIfUnset sets listall to v, unless it is already set
+func (p *ListTagsParams) SetListallIfUnset(v bool) {
+	if _, found := p.p["listall"]; !found {
+		p.SetListall(v)
+	}
+}
+
 func (p *ListTagsParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -466,6 +848,13 @@ func (p *ListTagsParams) SetPage(v int) {
 	return
 }
 
+// SetPageIfUnset sets page to v, unless it is already set
+func (p *ListTagsParams) SetPageIfUnset(v int) {
+	if _, found := p.p["page"]; !found {
+		p.SetPage(v)
+	}
+}
+
 func (p *ListTagsParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -474,6 +863,13 @@ func (p *ListTagsParams) SetPagesize(v int) {
 	return
 }
 
+// SetPagesizeIfUnset sets pagesize to v, unless it is already set
+func (p *ListTagsParams) SetPagesizeIfUnset(v int) {
+	if _, found := p.p["pagesize"]; !found {
+		p.SetPagesize(v)
+	}
+}
+
 func (p *ListTagsParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -482,6 +878,13 @@ func (p *ListTagsParams) SetProjectid(v string) {
 	return
 }
 
+// SetProjectidIfUnset sets projectid to v, unless it is already set
+func (p *ListTagsParams) SetProjectidIfUnset(v string) {
+	if _, found := p.p["projectid"]; !found {
+		p.SetProjectid(v)
+	}
+}
+
 func (p *ListTagsParams) SetResourceid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -490,6 +893,13 @@ func (p *ListTagsParams) SetResourceid(v string) {
 	return
 }
 
+// SetResourceidIfUnset sets resourceid to v, unless it is already set
+func (p *ListTagsParams) SetResourceidIfUnset(v string) {
+	if _, found := p.p["resourceid"]; !found {
+		p.SetResourceid(v)
+	}
+}
+
 func (p *ListTagsParams) SetResourcetype(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -498,6 +908,13 @@ func (p *ListTagsParams) SetResourcetype(v string) {
 	return
 }
 
+// SetResourcetypeIfUnset sets resourcetype to v, unless it is already set
+func (p *ListTagsParams) SetResourcetypeIfUnset(v string) {
+	if _, found := p.p["resourcetype"]; !found {
+		p.SetResourcetype(v)
+	}
+}
+
 func (p *ListTagsParams) SetValue(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -506,17 +923,45 @@ func (p *ListTagsParams) SetValue(v string) {
 	return
 }
 
+// SetValueIfUnset sets value to v, unless it is already set
+func (p *ListTagsParams) SetValueIfUnset(v string) {
+	if _, found := p.p["value"]; !found {
+		p.SetValue(v)
+	}
+}
+
+var _ ListAllSetter = (*ListTagsParams)(nil)
+
 // You should always use this function to get a new ListTagsParams instance,
-// as then you are sure you have configured all required params
-func (s *ResourcetagsService) NewListTagsParams() *ListTagsParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *ResourcetagsService) NewListTagsParams(opts ...func(*ListTagsParams)) *ListTagsParams {
 	p := &ListTagsParams{}
 	p.p = make(map[string]interface{})
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // List resource tag(s)
 func (s *ResourcetagsService) ListTags(p *ListTagsParams) (*ListTagsResponse, error) {
-	resp, err := s.cs.newRequest("listTags", p.toURLValues())
+	return s.ListTagsWithContext(context.Background(), p)
+}
+
+// ListTagsWithContext is the same as ListTags, but the request is cancelled when the context is done
+func (s *ResourcetagsService) ListTagsWithContext(ctx context.Context, p *ListTagsParams) (*ListTagsResponse, error) {
+	return s.ListTagsRawWithContext(ctx, p.toURLValues())
+}
+
+// ListTagsRaw is the same as ListTags, but takes the params as url.Values instead of typed params
+func (s *ResourcetagsService) ListTagsRaw(v url.Values) (*ListTagsResponse, error) {
+	return s.ListTagsRawWithContext(context.Background(), v)
+}
+
+// ListTagsRawWithContext is the same as ListTagsRaw, but the request is cancelled when the context is done
+func (s *ResourcetagsService) ListTagsRawWithContext(ctx context.Context, v url.Values) (*ListTagsResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "listTags", "listtagsresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -529,6 +974,93 @@ func (s *ResourcetagsService) ListTags(p *ListTagsParams) (*ListTagsResponse, er
 	return &r, nil
 }
 
+// StreamTags fetches the results of ListTags page by page in the background and sends them on the
+// returned channel. Both returned channels are closed when done. If fetching a page fails, or the context
+// is done before all results are sent, the error is sent on the error channel and fetching stops. A
+// request for a page in flight is cancelled when the context is done. The page and pagesize set in the
+// params are ignored.
+func (s *ResourcetagsService) StreamTags(ctx context.Context, p *ListTagsParams) (<-chan *Tag, <-chan error) {
+	ch := make(chan *Tag)
+	errs := make(chan error, 1)
+
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesize(500)
+
+	go func() {
+		defer close(errs)
+		defer close(ch)
+
+		for page, count := 1, 0; ; page++ {
+			pp.SetPage(page)
+
+			l, err := s.ListTagsWithContext(ctx, pp)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			for _, v := range l.Tags {
+				select {
+				case ch <- v:
+				case <-ctx.Done():
+					errs <- ctx.Err()
+					return
+				}
+			}
+
+			count += len(l.Tags)
+			if len(l.Tags) < 500 || count >= l.Count {
+				return
+			}
+		}
+	}()
+
+	return ch, errs
+}
+
+// ListTagsWhere returns all results of ListTags for which pred returns true. Note that the results are
+// filtered client-side, so all results matching the params are fetched first.
+// All pages are fetched, so the page and pagesize set in the params are ignored.
+func (s *ResourcetagsService) ListTagsWhere(p *ListTagsParams, pred func(*Tag) bool) ([]*Tag, error) {
+	var r []*Tag
+	ch, errs := s.StreamTags(context.Background(), p)
+	for v := range ch {
+		if pred(v) {
+			r = append(r, v)
+		}
+	}
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ListAllTags returns the results of ListTags across all pages, by calling ListTags page by page until all
+// results are fetched. The pagesize set in the params is used for every page (500 if not set), and the
+// page set in the params is ignored.
+func (s *ResourcetagsService) ListAllTags(p *ListTagsParams) ([]*Tag, error) {
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesizeIfUnset(500)
+	pagesize := pp.p["pagesize"].(int)
+
+	var r []*Tag
+	for page := 1; ; page++ {
+		pp.SetPage(page)
+
+		l, err := s.ListTags(pp)
+		if err != nil {
+			return nil, err
+		}
+		r = append(r, l.Tags...)
+
+		if len(l.Tags) < pagesize || len(r) >= l.Count {
+			return r, nil
+		}
+	}
+}
+
 type ListTagsResponse struct {
 	Count int    `json:"count"`
 	Tags  []*Tag `json:"tag"`
@@ -17,16 +17,66 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/url"
 	"strings"
+	"time"
 )
 
+// UpdateMany runs UpdateRole for all given IDs in parallel, using at most concurrency concurrent requests.
+// For each ID the params are populated with the ID and then passed to mutate, which should set the
+// changes to apply. The result contains the updated resources (in the order of the IDs) and the error
+// of every update that failed by ID. The returned error contains all failures, or is nil if none failed.
+// The updated resources are returned as the UpdateRoleResponse of every update, which contains the same
+// fields as the resource itself, so no additional request is needed per resource to get them. The
+// options are used for every update.
+func (s *RoleService) UpdateMany(ids []string, mutate func(*UpdateRoleParams), concurrency int, opts ...OptionFunc) (BulkResult[*UpdateRoleResponse], error) {
+	rs := make([]*UpdateRoleResponse, len(ids))
+	errs := make([]error, len(ids))
+
+	runConcurrently(len(ids), concurrency, func(i int) {
+		p := &UpdateRoleParams{p: map[string]interface{}{"id": ids[i]}}
+		if mutate != nil {
+			mutate(p)
+		}
+
+		r, err := s.UpdateRole(p, opts...)
+		if err != nil {
+			errs[i] = err
+			return
+		}
+		rs[i] = r
+	})
+
+	var r BulkResult[*UpdateRoleResponse]
+	for i, id := range ids {
+		if errs[i] != nil {
+			r.fail(id, errs[i])
+			continue
+		}
+		r.Succeeded = append(r.Succeeded, rs[i])
+	}
+
+	return r, r.Err()
+}
+
 type CreateRoleParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *CreateRoleParams) Clone() *CreateRoleParams {
+	c := &CreateRoleParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *CreateRoleParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -44,6 +94,16 @@ func (p *CreateRoleParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *CreateRoleParams) validate() error {
+	for _, k := range []string{"name", "type"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of createRole", k)
+		}
+	}
+	return nil
+}
+
 func (p *CreateRoleParams) SetDescription(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -52,6 +112,13 @@ func (p *CreateRoleParams) SetDescription(v string) {
 	return
 }
 
+// SetDescriptionIfUnset sets description to v, unless it is already set
+func (p *CreateRoleParams) SetDescriptionIfUnset(v string) {
+	if _, found := p.p["description"]; !found {
+		p.SetDescription(v)
+	}
+}
+
 func (p *CreateRoleParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -60,6 +127,13 @@ func (p *CreateRoleParams) SetName(v string) {
 	return
 }
 
+// SetNameIfUnset sets name to v, unless it is already set
+func (p *CreateRoleParams) SetNameIfUnset(v string) {
+	if _, found := p.p["name"]; !found {
+		p.SetName(v)
+	}
+}
+
 func (p *CreateRoleParams) SetType(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -68,19 +142,55 @@ func (p *CreateRoleParams) SetType(v string) {
 	return
 }
 
+// SetTypeIfUnset sets type to v, unless it is already set
+func (p *CreateRoleParams) SetTypeIfUnset(v string) {
+	if _, found := p.p["type"]; !found {
+		p.SetType(v)
+	}
+}
+
 // You should always use this function to get a new CreateRoleParams instance,
-// as then you are sure you have configured all required params
-func (s *RoleService) NewCreateRoleParams(name string, roleType string) *CreateRoleParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *RoleService) NewCreateRoleParams(name string, roleType string, opts ...func(*CreateRoleParams)) *CreateRoleParams {
 	p := &CreateRoleParams{}
 	p.p = make(map[string]interface{})
 	p.p["name"] = name
 	p.p["type"] = roleType
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Creates a role
-func (s *RoleService) CreateRole(p *CreateRoleParams) (*CreateRoleResponse, error) {
-	resp, err := s.cs.newRequest("createRole", p.toURLValues())
+func (s *RoleService) CreateRole(p *CreateRoleParams, opts ...OptionFunc) (*CreateRoleResponse, error) {
+	return s.CreateRoleWithContext(context.Background(), p, opts...)
+}
+
+// CreateRoleWithContext is the same as CreateRole, but the request is cancelled when the context is done
+func (s *RoleService) CreateRoleWithContext(ctx context.Context, p *CreateRoleParams, opts ...OptionFunc) (*CreateRoleResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.CreateRoleRawWithContext(ctx, p.toURLValues())
+}
+
+// CreateRoleRaw is the same as CreateRole, but takes the params as url.Values instead of typed params
+func (s *RoleService) CreateRoleRaw(v url.Values) (*CreateRoleResponse, error) {
+	return s.CreateRoleRawWithContext(context.Background(), v)
+}
+
+// CreateRoleRawWithContext is the same as CreateRoleRaw, but the request is cancelled when the context is done
+func (s *RoleService) CreateRoleRawWithContext(ctx context.Context, v url.Values) (*CreateRoleResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "createRole", "createroleresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -104,6 +214,16 @@ type CreateRolePermissionParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *CreateRolePermissionParams) Clone() *CreateRolePermissionParams {
+	c := &CreateRolePermissionParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *CreateRolePermissionParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -124,6 +244,16 @@ func (p *CreateRolePermissionParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *CreateRolePermissionParams) validate() error {
+	for _, k := range []string{"permission", "roleid", "rule"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of createRolePermission", k)
+		}
+	}
+	return nil
+}
+
 func (p *CreateRolePermissionParams) SetDescription(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -132,6 +262,13 @@ func (p *CreateRolePermissionParams) SetDescription(v string) {
 	return
 }
 
+// SetDescriptionIfUnset sets description to v, unless it is already set
+func (p *CreateRolePermissionParams) SetDescriptionIfUnset(v string) {
+	if _, found := p.p["description"]; !found {
+		p.SetDescription(v)
+	}
+}
+
 func (p *CreateRolePermissionParams) SetPermission(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -140,6 +277,13 @@ func (p *CreateRolePermissionParams) SetPermission(v string) {
 	return
 }
 
+// SetPermissionIfUnset sets permission to v, unless it is already set
+func (p *CreateRolePermissionParams) SetPermissionIfUnset(v string) {
+	if _, found := p.p["permission"]; !found {
+		p.SetPermission(v)
+	}
+}
+
 func (p *CreateRolePermissionParams) SetRoleid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -148,6 +292,13 @@ func (p *CreateRolePermissionParams) SetRoleid(v string) {
 	return
 }
 
+// SetRoleidIfUnset sets roleid to v, unless it is already set
+func (p *CreateRolePermissionParams) SetRoleidIfUnset(v string) {
+	if _, found := p.p["roleid"]; !found {
+		p.SetRoleid(v)
+	}
+}
+
 func (p *CreateRolePermissionParams) SetRule(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -156,20 +307,75 @@ func (p *CreateRolePermissionParams) SetRule(v string) {
 	return
 }
 
+// SetRuleIfUnset sets rule to v, unless it is already set
+func (p *CreateRolePermissionParams) SetRuleIfUnset(v string) {
+	if _, found := p.p["rule"]; !found {
+		p.SetRule(v)
+	}
+}
+
 // You should always use this function to get a new CreateRolePermissionParams instance,
-// as then you are sure you have configured all required params
-func (s *RoleService) NewCreateRolePermissionParams(permission string, roleid string, rule string) *CreateRolePermissionParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *RoleService) NewCreateRolePermissionParams(permission string, roleid string, rule string, opts ...func(*CreateRolePermissionParams)) *CreateRolePermissionParams {
 	p := &CreateRolePermissionParams{}
 	p.p = make(map[string]interface{})
 	p.p["permission"] = permission
 	p.p["roleid"] = roleid
 	p.p["rule"] = rule
+	for _, fn := range opts {
+		fn(p)
+	}
+	return p
+}
+
+// CreateRolePermissionOpts contains all params that can be set on a CreateRolePermissionParams.
+// Optional params are pointers (or slices and maps) which are only set when not nil.
+type CreateRolePermissionOpts struct {
+	Description *string
+	Permission  string
+	Roleid      string
+	Rule        string
+}
+
+// NewCreateRolePermissionParamsFromOpts is an alternative for NewCreateRolePermissionParams which takes all params using
+// an options struct, as that is easier to read for APIs with many required params
+func (s *RoleService) NewCreateRolePermissionParamsFromOpts(o CreateRolePermissionOpts) *CreateRolePermissionParams {
+	p := s.NewCreateRolePermissionParams(o.Permission, o.Roleid, o.Rule)
+	if o.Description != nil {
+		p.p["description"] = *o.Description
+	}
 	return p
 }
 
 // Adds a API permission to a role
-func (s *RoleService) CreateRolePermission(p *CreateRolePermissionParams) (*CreateRolePermissionResponse, error) {
-	resp, err := s.cs.newRequest("createRolePermission", p.toURLValues())
+func (s *RoleService) CreateRolePermission(p *CreateRolePermissionParams, opts ...OptionFunc) (*CreateRolePermissionResponse, error) {
+	return s.CreateRolePermissionWithContext(context.Background(), p, opts...)
+}
+
+// CreateRolePermissionWithContext is the same as CreateRolePermission, but the request is cancelled when the context is done
+func (s *RoleService) CreateRolePermissionWithContext(ctx context.Context, p *CreateRolePermissionParams, opts ...OptionFunc) (*CreateRolePermissionResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.CreateRolePermissionRawWithContext(ctx, p.toURLValues())
+}
+
+// CreateRolePermissionRaw is the same as CreateRolePermission, but takes the params as url.Values instead of typed params
+func (s *RoleService) CreateRolePermissionRaw(v url.Values) (*CreateRolePermissionResponse, error) {
+	return s.CreateRolePermissionRawWithContext(context.Background(), v)
+}
+
+// CreateRolePermissionRawWithContext is the same as CreateRolePermissionRaw, but the request is cancelled when the context is done
+func (s *RoleService) CreateRolePermissionRawWithContext(ctx context.Context, v url.Values) (*CreateRolePermissionResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "createRolePermission", "createrolepermissionresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -195,6 +401,16 @@ type DeleteRoleParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *DeleteRoleParams) Clone() *DeleteRoleParams {
+	c := &DeleteRoleParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeleteRoleParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -206,6 +422,16 @@ func (p *DeleteRoleParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *DeleteRoleParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of deleteRole", k)
+		}
+	}
+	return nil
+}
+
 func (p *DeleteRoleParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -214,18 +440,54 @@ func (p *DeleteRoleParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *DeleteRoleParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 // You should always use this function to get a new DeleteRoleParams instance,
-// as then you are sure you have configured all required params
-func (s *RoleService) NewDeleteRoleParams(id string) *DeleteRoleParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *RoleService) NewDeleteRoleParams(id string, opts ...func(*DeleteRoleParams)) *DeleteRoleParams {
 	p := &DeleteRoleParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Deletes a role
-func (s *RoleService) DeleteRole(p *DeleteRoleParams) (*DeleteRoleResponse, error) {
-	resp, err := s.cs.newRequest("deleteRole", p.toURLValues())
+func (s *RoleService) DeleteRole(p *DeleteRoleParams, opts ...OptionFunc) (*DeleteRoleResponse, error) {
+	return s.DeleteRoleWithContext(context.Background(), p, opts...)
+}
+
+// DeleteRoleWithContext is the same as DeleteRole, but the request is cancelled when the context is done
+func (s *RoleService) DeleteRoleWithContext(ctx context.Context, p *DeleteRoleParams, opts ...OptionFunc) (*DeleteRoleResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.DeleteRoleRawWithContext(ctx, p.toURLValues())
+}
+
+// DeleteRoleRaw is the same as DeleteRole, but takes the params as url.Values instead of typed params
+func (s *RoleService) DeleteRoleRaw(v url.Values) (*DeleteRoleResponse, error) {
+	return s.DeleteRoleRawWithContext(context.Background(), v)
+}
+
+// DeleteRoleRawWithContext is the same as DeleteRoleRaw, but the request is cancelled when the context is done
+func (s *RoleService) DeleteRoleRawWithContext(ctx context.Context, v url.Values) (*DeleteRoleResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteRole", "deleteroleresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -238,6 +500,34 @@ func (s *RoleService) DeleteRole(p *DeleteRoleParams) (*DeleteRoleResponse, erro
 	return &r, nil
 }
 
+// WaitForRoleDeleted polls until the Role with the given ID no longer exists, the timeout
+// expires or the context is cancelled. Deleted resources may still be listed for a short while
+// after the delete command finished, so this can be used to make sure they are really gone. The
+// options are passed to GetRoleByID, so a resource outside of the default scope can be found.
+func (s *RoleService) WaitForRoleDeleted(ctx context.Context, id string, timeout time.Duration, opts ...OptionFunc) error {
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	for {
+		_, count, err := s.GetRoleByID(id, opts...)
+		if count == 0 {
+			return nil
+		}
+		if count < 0 {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(2 * time.Second):
+		}
+	}
+}
+
 type DeleteRoleResponse struct {
 	Displaytext string `json:"displaytext"`
 	Success     bool   `json:"success"`
@@ -266,6 +556,16 @@ type DeleteRolePermissionParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *DeleteRolePermissionParams) Clone() *DeleteRolePermissionParams {
+	c := &DeleteRolePermissionParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeleteRolePermissionParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -277,6 +577,16 @@ func (p *DeleteRolePermissionParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *DeleteRolePermissionParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of deleteRolePermission", k)
+		}
+	}
+	return nil
+}
+
 func (p *DeleteRolePermissionParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -285,18 +595,54 @@ func (p *DeleteRolePermissionParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *DeleteRolePermissionParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 // You should always use this function to get a new DeleteRolePermissionParams instance,
-// as then you are sure you have configured all required params
-func (s *RoleService) NewDeleteRolePermissionParams(id string) *DeleteRolePermissionParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *RoleService) NewDeleteRolePermissionParams(id string, opts ...func(*DeleteRolePermissionParams)) *DeleteRolePermissionParams {
 	p := &DeleteRolePermissionParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Deletes a role permission
-func (s *RoleService) DeleteRolePermission(p *DeleteRolePermissionParams) (*DeleteRolePermissionResponse, error) {
-	resp, err := s.cs.newRequest("deleteRolePermission", p.toURLValues())
+func (s *RoleService) DeleteRolePermission(p *DeleteRolePermissionParams, opts ...OptionFunc) (*DeleteRolePermissionResponse, error) {
+	return s.DeleteRolePermissionWithContext(context.Background(), p, opts...)
+}
+
+// DeleteRolePermissionWithContext is the same as DeleteRolePermission, but the request is cancelled when the context is done
+func (s *RoleService) DeleteRolePermissionWithContext(ctx context.Context, p *DeleteRolePermissionParams, opts ...OptionFunc) (*DeleteRolePermissionResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.DeleteRolePermissionRawWithContext(ctx, p.toURLValues())
+}
+
+// DeleteRolePermissionRaw is the same as DeleteRolePermission, but takes the params as url.Values instead of typed params
+func (s *RoleService) DeleteRolePermissionRaw(v url.Values) (*DeleteRolePermissionResponse, error) {
+	return s.DeleteRolePermissionRawWithContext(context.Background(), v)
+}
+
+// DeleteRolePermissionRawWithContext is the same as DeleteRolePermissionRaw, but the request is cancelled when the context is done
+func (s *RoleService) DeleteRolePermissionRawWithContext(ctx context.Context, v url.Values) (*DeleteRolePermissionResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteRolePermission", "deleterolepermissionresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -337,6 +683,16 @@ type ListRolePermissionsParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ListRolePermissionsParams) Clone() *ListRolePermissionsParams {
+	c := &ListRolePermissionsParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListRolePermissionsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -356,17 +712,43 @@ func (p *ListRolePermissionsParams) SetRoleid(v string) {
 	return
 }
 
+// SetRoleidIfUnset sets roleid to v, unless it is already set
+func (p *ListRolePermissionsParams) SetRoleidIfUnset(v string) {
+	if _, found := p.p["roleid"]; !found {
+		p.SetRoleid(v)
+	}
+}
+
 // You should always use this function to get a new ListRolePermissionsParams instance,
-// as then you are sure you have configured all required params
-func (s *RoleService) NewListRolePermissionsParams() *ListRolePermissionsParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *RoleService) NewListRolePermissionsParams(opts ...func(*ListRolePermissionsParams)) *ListRolePermissionsParams {
 	p := &ListRolePermissionsParams{}
 	p.p = make(map[string]interface{})
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Lists role permissions
 func (s *RoleService) ListRolePermissions(p *ListRolePermissionsParams) (*ListRolePermissionsResponse, error) {
-	resp, err := s.cs.newRequest("listRolePermissions", p.toURLValues())
+	return s.ListRolePermissionsWithContext(context.Background(), p)
+}
+
+// ListRolePermissionsWithContext is the same as ListRolePermissions, but the request is cancelled when the context is done
+func (s *RoleService) ListRolePermissionsWithContext(ctx context.Context, p *ListRolePermissionsParams) (*ListRolePermissionsResponse, error) {
+	return s.ListRolePermissionsRawWithContext(ctx, p.toURLValues())
+}
+
+// ListRolePermissionsRaw is the same as ListRolePermissions, but takes the params as url.Values instead of typed params
+func (s *RoleService) ListRolePermissionsRaw(v url.Values) (*ListRolePermissionsResponse, error) {
+	return s.ListRolePermissionsRawWithContext(context.Background(), v)
+}
+
+// ListRolePermissionsRawWithContext is the same as ListRolePermissionsRaw, but the request is cancelled when the context is done
+func (s *RoleService) ListRolePermissionsRawWithContext(ctx context.Context, v url.Values) (*ListRolePermissionsResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "listRolePermissions", "listrolepermissionsresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -379,6 +761,22 @@ func (s *RoleService) ListRolePermissions(p *ListRolePermissionsParams) (*ListRo
 	return &r, nil
 }
 
+// ListRolePermissionsWhere returns all results of ListRolePermissions for which pred returns true. Note that the results are
+// filtered client-side, so all results matching the params are fetched first.
+func (s *RoleService) ListRolePermissionsWhere(p *ListRolePermissionsParams, pred func(*RolePermission) bool) ([]*RolePermission, error) {
+	var r []*RolePermission
+	l, err := s.ListRolePermissions(p)
+	if err != nil {
+		return nil, err
+	}
+	for _, v := range l.RolePermissions {
+		if pred(v) {
+			r = append(r, v)
+		}
+	}
+	return r, nil
+}
+
 type ListRolePermissionsResponse struct {
 	Count           int               `json:"count"`
 	RolePermissions []*RolePermission `json:"rolepermission"`
@@ -397,6 +795,16 @@ type ListRolesParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ListRolesParams) Clone() *ListRolesParams {
+	c := &ListRolesParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListRolesParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -422,6 +830,13 @@ func (p *ListRolesParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *ListRolesParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 func (p *ListRolesParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -430,6 +845,13 @@ func (p *ListRolesParams) SetName(v string) {
 	return
 }
 
+// SetNameIfUnset sets name to v, unless it is already set
+func (p *ListRolesParams) SetNameIfUnset(v string) {
+	if _, found := p.p["name"]; !found {
+		p.SetName(v)
+	}
+}
+
 func (p *ListRolesParams) SetType(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -438,14 +860,32 @@ func (p *ListRolesParams) SetType(v string) {
 	return
 }
 
+// SetTypeIfUnset sets type to v, unless it is already set
+func (p *ListRolesParams) SetTypeIfUnset(v string) {
+	if _, found := p.p["type"]; !found {
+		p.SetType(v)
+	}
+}
+
 // You should always use this function to get a new ListRolesParams instance,
-// as then you are sure you have configured all required params
-func (s *RoleService) NewListRolesParams() *ListRolesParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *RoleService) NewListRolesParams(opts ...func(*ListRolesParams)) *ListRolesParams {
 	p := &ListRolesParams{}
 	p.p = make(map[string]interface{})
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
+func (p *ListRolesParams) setFirstMatch() {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p[firstMatchKey] = true
+}
+
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *RoleService) GetRoleID(name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListRolesParams{}
@@ -453,7 +893,7 @@ func (s *RoleService) GetRoleID(name string, opts ...OptionFunc) (string, int, e
 
 	p.p["name"] = name
 
-	for _, fn := range append(s.cs.options, opts...) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
 		if err := fn(s.cs, p); err != nil {
 			return "", -1, err
 		}
@@ -465,7 +905,7 @@ func (s *RoleService) GetRoleID(name string, opts ...OptionFunc) (string, int, e
 	}
 
 	if l.Count == 0 {
-		return "", l.Count, fmt.Errorf("No match found for %s: %+v", name, l)
+		return "", l.Count, fmt.Errorf("%w for %s: %+v", ErrNotFound, name, l)
 	}
 
 	if l.Count == 1 {
@@ -478,6 +918,10 @@ func (s *RoleService) GetRoleID(name string, opts ...OptionFunc) (string, int, e
 				return v.Id, l.Count, nil
 			}
 		}
+
+		if _, ok := p.p[firstMatchKey]; ok && len(l.Roles) > 0 {
+			return l.Roles[0].Id, l.Count, nil
+		}
 	}
 	return "", l.Count, fmt.Errorf("Could not find an exact match for %s: %+v", name, l)
 }
@@ -503,7 +947,7 @@ func (s *RoleService) GetRoleByID(id string, opts ...OptionFunc) (*Role, int, er
 
 	p.p["id"] = id
 
-	for _, fn := range append(s.cs.options, opts...) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
 		if err := fn(s.cs, p); err != nil {
 			return nil, -1, err
 		}
@@ -514,24 +958,56 @@ func (s *RoleService) GetRoleByID(id string, opts ...OptionFunc) (*Role, int, er
 		if strings.Contains(err.Error(), fmt.Sprintf(
 			"Invalid parameter id value=%s due to incorrect long value format, "+
 				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+			return nil, 0, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
 	}
 
 	if l.Count == 1 {
 		return l.Roles[0], l.Count, nil
 	}
+
+	if _, ok := p.p[firstMatchKey]; ok && len(l.Roles) > 0 {
+		return l.Roles[0], l.Count, nil
+	}
 	return nil, l.Count, fmt.Errorf("There is more then one result for Role UUID: %s!", id)
 }
 
+// RoleExists returns true if a Role with the given ID exists. When no match is found
+// it returns false, while any other error is returned as is.
+func (s *RoleService) RoleExists(id string, opts ...OptionFunc) (bool, error) {
+	_, count, err := s.GetRoleByID(id, opts...)
+	if errors.Is(err, ErrNotFound) {
+		return false, nil
+	}
+	if count > 0 {
+		return true, nil
+	}
+	return false, err
+}
+
 // Lists dynamic roles in CloudStack
 func (s *RoleService) ListRoles(p *ListRolesParams) (*ListRolesResponse, error) {
-	resp, err := s.cs.newRequest("listRoles", p.toURLValues())
+	return s.ListRolesWithContext(context.Background(), p)
+}
+
+// ListRolesWithContext is the same as ListRoles, but the request is cancelled when the context is done
+func (s *RoleService) ListRolesWithContext(ctx context.Context, p *ListRolesParams) (*ListRolesResponse, error) {
+	return s.ListRolesRawWithContext(ctx, p.toURLValues())
+}
+
+// ListRolesRaw is the same as ListRoles, but takes the params as url.Values instead of typed params
+func (s *RoleService) ListRolesRaw(v url.Values) (*ListRolesResponse, error) {
+	return s.ListRolesRawWithContext(context.Background(), v)
+}
+
+// ListRolesRawWithContext is the same as ListRolesRaw, but the request is cancelled when the context is done
+func (s *RoleService) ListRolesRawWithContext(ctx context.Context, v url.Values) (*ListRolesResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "listRoles", "listrolesresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -544,6 +1020,22 @@ func (s *RoleService) ListRoles(p *ListRolesParams) (*ListRolesResponse, error)
 	return &r, nil
 }
 
+// ListRolesWhere returns all results of ListRoles for which pred returns true. Note that the results are
+// filtered client-side, so all results matching the params are fetched first.
+func (s *RoleService) ListRolesWhere(p *ListRolesParams, pred func(*Role) bool) ([]*Role, error) {
+	var r []*Role
+	l, err := s.ListRoles(p)
+	if err != nil {
+		return nil, err
+	}
+	for _, v := range l.Roles {
+		if pred(v) {
+			r = append(r, v)
+		}
+	}
+	return r, nil
+}
+
 type ListRolesResponse struct {
 	Count int     `json:"count"`
 	Roles []*Role `json:"role"`
@@ -560,6 +1052,16 @@ type UpdateRoleParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *UpdateRoleParams) Clone() *UpdateRoleParams {
+	c := &UpdateRoleParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *UpdateRoleParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -580,6 +1082,16 @@ func (p *UpdateRoleParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *UpdateRoleParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of updateRole", k)
+		}
+	}
+	return nil
+}
+
 func (p *UpdateRoleParams) SetDescription(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -588,6 +1100,13 @@ func (p *UpdateRoleParams) SetDescription(v string) {
 	return
 }
 
+// SetDescriptionIfUnset sets description to v, unless it is already set
+func (p *UpdateRoleParams) SetDescriptionIfUnset(v string) {
+	if _, found := p.p["description"]; !found {
+		p.SetDescription(v)
+	}
+}
+
 func (p *UpdateRoleParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -596,6 +1115,13 @@ func (p *UpdateRoleParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *UpdateRoleParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 func (p *UpdateRoleParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -604,6 +1130,13 @@ func (p *UpdateRoleParams) SetName(v string) {
 	return
 }
 
+// SetNameIfUnset sets name to v, unless it is already set
+func (p *UpdateRoleParams) SetNameIfUnset(v string) {
+	if _, found := p.p["name"]; !found {
+		p.SetName(v)
+	}
+}
+
 func (p *UpdateRoleParams) SetType(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -612,18 +1145,54 @@ func (p *UpdateRoleParams) SetType(v string) {
 	return
 }
 
+// SetTypeIfUnset sets type to v, unless it is already set
+func (p *UpdateRoleParams) SetTypeIfUnset(v string) {
+	if _, found := p.p["type"]; !found {
+		p.SetType(v)
+	}
+}
+
 // You should always use this function to get a new UpdateRoleParams instance,
-// as then you are sure you have configured all required params
-func (s *RoleService) NewUpdateRoleParams(id string) *UpdateRoleParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *RoleService) NewUpdateRoleParams(id string, opts ...func(*UpdateRoleParams)) *UpdateRoleParams {
 	p := &UpdateRoleParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Updates a role
-func (s *RoleService) UpdateRole(p *UpdateRoleParams) (*UpdateRoleResponse, error) {
-	resp, err := s.cs.newRequest("updateRole", p.toURLValues())
+func (s *RoleService) UpdateRole(p *UpdateRoleParams, opts ...OptionFunc) (*UpdateRoleResponse, error) {
+	return s.UpdateRoleWithContext(context.Background(), p, opts...)
+}
+
+// UpdateRoleWithContext is the same as UpdateRole, but the request is cancelled when the context is done
+func (s *RoleService) UpdateRoleWithContext(ctx context.Context, p *UpdateRoleParams, opts ...OptionFunc) (*UpdateRoleResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.UpdateRoleRawWithContext(ctx, p.toURLValues())
+}
+
+// UpdateRoleRaw is the same as UpdateRole, but takes the params as url.Values instead of typed params
+func (s *RoleService) UpdateRoleRaw(v url.Values) (*UpdateRoleResponse, error) {
+	return s.UpdateRoleRawWithContext(context.Background(), v)
+}
+
+// UpdateRoleRawWithContext is the same as UpdateRoleRaw, but the request is cancelled when the context is done
+func (s *RoleService) UpdateRoleRawWithContext(ctx context.Context, v url.Values) (*UpdateRoleResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "updateRole", "updateroleresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -664,6 +1233,16 @@ type UpdateRolePermissionParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *UpdateRolePermissionParams) Clone() *UpdateRolePermissionParams {
+	c := &UpdateRolePermissionParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *UpdateRolePermissionParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -679,6 +1258,16 @@ func (p *UpdateRolePermissionParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *UpdateRolePermissionParams) validate() error {
+	for _, k := range []string{"roleid", "ruleorder"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of updateRolePermission", k)
+		}
+	}
+	return nil
+}
+
 func (p *UpdateRolePermissionParams) SetRoleid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -687,6 +1276,13 @@ func (p *UpdateRolePermissionParams) SetRoleid(v string) {
 	return
 }
 
+// SetRoleidIfUnset sets roleid to v, unless it is already set
+func (p *UpdateRolePermissionParams) SetRoleidIfUnset(v string) {
+	if _, found := p.p["roleid"]; !found {
+		p.SetRoleid(v)
+	}
+}
+
 func (p *UpdateRolePermissionParams) SetRuleorder(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -695,19 +1291,60 @@ func (p *UpdateRolePermissionParams) SetRuleorder(v []string) {
 	return
 }
 
+// SetRuleorderIfUnset sets ruleorder to v, unless it is already set
+func (p *UpdateRolePermissionParams) SetRuleorderIfUnset(v []string) {
+	if _, found := p.p["ruleorder"]; !found {
+		p.SetRuleorder(v)
+	}
+}
+
+// SetRuleorderCSV splits v on commas and sets the resulting values
+func (p *UpdateRolePermissionParams) SetRuleorderCSV(v string) {
+	p.SetRuleorder(splitCSV(v))
+}
+
 // You should always use this function to get a new UpdateRolePermissionParams instance,
-// as then you are sure you have configured all required params
-func (s *RoleService) NewUpdateRolePermissionParams(roleid string, ruleorder []string) *UpdateRolePermissionParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *RoleService) NewUpdateRolePermissionParams(roleid string, ruleorder []string, opts ...func(*UpdateRolePermissionParams)) *UpdateRolePermissionParams {
 	p := &UpdateRolePermissionParams{}
 	p.p = make(map[string]interface{})
 	p.p["roleid"] = roleid
 	p.p["ruleorder"] = ruleorder
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Updates a role permission order
-func (s *RoleService) UpdateRolePermission(p *UpdateRolePermissionParams) (*UpdateRolePermissionResponse, error) {
-	resp, err := s.cs.newRequest("updateRolePermission", p.toURLValues())
+func (s *RoleService) UpdateRolePermission(p *UpdateRolePermissionParams, opts ...OptionFunc) (*UpdateRolePermissionResponse, error) {
+	return s.UpdateRolePermissionWithContext(context.Background(), p, opts...)
+}
+
+// UpdateRolePermissionWithContext is the same as UpdateRolePermission, but the request is cancelled when the context is done
+func (s *RoleService) UpdateRolePermissionWithContext(ctx context.Context, p *UpdateRolePermissionParams, opts ...OptionFunc) (*UpdateRolePermissionResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.UpdateRolePermissionRawWithContext(ctx, p.toURLValues())
+}
+
+// UpdateRolePermissionRaw is the same as UpdateRolePermission, but takes the params as url.Values instead of typed params
+func (s *RoleService) UpdateRolePermissionRaw(v url.Values) (*UpdateRolePermissionResponse, error) {
+	return s.UpdateRolePermissionRawWithContext(context.Background(), v)
+}
+
+// UpdateRolePermissionRawWithContext is the same as UpdateRolePermissionRaw, but the request is cancelled when the context is done
+func (s *RoleService) UpdateRolePermissionRawWithContext(ctx context.Context, v url.Values) (*UpdateRolePermissionResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "updateRolePermission", "updaterolepermissionresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -17,17 +17,41 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/url"
 	"strconv"
 	"strings"
 )
 
+// MigrateRouterAndWait migrates the virtual router to the given host, waits for the async job to finish
+// and returns the updated router. The options are used when getting the updated router.
+func (s *RouterService) MigrateRouterAndWait(routerid, hostid string, opts ...OptionFunc) (*Router, error) {
+	// Virtual routers are system VMs, so they are migrated using migrateSystemVm
+	if err := s.cs.SystemVM.migrateAndWait(routerid, hostid); err != nil {
+		return nil, err
+	}
+
+	r, _, err := s.GetRouterByID(routerid, opts...)
+	return r, err
+}
+
 type ChangeServiceForRouterParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ChangeServiceForRouterParams) Clone() *ChangeServiceForRouterParams {
+	c := &ChangeServiceForRouterParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ChangeServiceForRouterParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -42,6 +66,16 @@ func (p *ChangeServiceForRouterParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *ChangeServiceForRouterParams) validate() error {
+	for _, k := range []string{"id", "serviceofferingid"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of changeServiceForRouter", k)
+		}
+	}
+	return nil
+}
+
 func (p *ChangeServiceForRouterParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -50,6 +84,13 @@ func (p *ChangeServiceForRouterParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *ChangeServiceForRouterParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 func (p *ChangeServiceForRouterParams) SetServiceofferingid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -58,19 +99,55 @@ func (p *ChangeServiceForRouterParams) SetServiceofferingid(v string) {
 	return
 }
 
+// SetServiceofferingidIfUnset sets serviceofferingid to v, unless it is already set
+func (p *ChangeServiceForRouterParams) SetServiceofferingidIfUnset(v string) {
+	if _, found := p.p["serviceofferingid"]; !found {
+		p.SetServiceofferingid(v)
+	}
+}
+
 // You should always use this function to get a new ChangeServiceForRouterParams instance,
-// as then you are sure you have configured all required params
-func (s *RouterService) NewChangeServiceForRouterParams(id string, serviceofferingid string) *ChangeServiceForRouterParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *RouterService) NewChangeServiceForRouterParams(id string, serviceofferingid string, opts ...func(*ChangeServiceForRouterParams)) *ChangeServiceForRouterParams {
 	p := &ChangeServiceForRouterParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
 	p.p["serviceofferingid"] = serviceofferingid
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Upgrades domain router to a new service offering
-func (s *RouterService) ChangeServiceForRouter(p *ChangeServiceForRouterParams) (*ChangeServiceForRouterResponse, error) {
-	resp, err := s.cs.newRequest("changeServiceForRouter", p.toURLValues())
+func (s *RouterService) ChangeServiceForRouter(p *ChangeServiceForRouterParams, opts ...OptionFunc) (*ChangeServiceForRouterResponse, error) {
+	return s.ChangeServiceForRouterWithContext(context.Background(), p, opts...)
+}
+
+// ChangeServiceForRouterWithContext is the same as ChangeServiceForRouter, but the request is cancelled when the context is done
+func (s *RouterService) ChangeServiceForRouterWithContext(ctx context.Context, p *ChangeServiceForRouterParams, opts ...OptionFunc) (*ChangeServiceForRouterResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.ChangeServiceForRouterRawWithContext(ctx, p.toURLValues())
+}
+
+// ChangeServiceForRouterRaw is the same as ChangeServiceForRouter, but takes the params as url.Values instead of typed params
+func (s *RouterService) ChangeServiceForRouterRaw(v url.Values) (*ChangeServiceForRouterResponse, error) {
+	return s.ChangeServiceForRouterRawWithContext(context.Background(), v)
+}
+
+// ChangeServiceForRouterRawWithContext is the same as ChangeServiceForRouterRaw, but the request is cancelled when the context is done
+func (s *RouterService) ChangeServiceForRouterRawWithContext(ctx context.Context, v url.Values) (*ChangeServiceForRouterResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "changeServiceForRouter", "changeserviceforrouterresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -160,6 +237,16 @@ type ConfigureVirtualRouterElementParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ConfigureVirtualRouterElementParams) Clone() *ConfigureVirtualRouterElementParams {
+	c := &ConfigureVirtualRouterElementParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ConfigureVirtualRouterElementParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -175,6 +262,16 @@ func (p *ConfigureVirtualRouterElementParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *ConfigureVirtualRouterElementParams) validate() error {
+	for _, k := range []string{"enabled", "id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of configureVirtualRouterElement", k)
+		}
+	}
+	return nil
+}
+
 func (p *ConfigureVirtualRouterElementParams) SetEnabled(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -183,6 +280,13 @@ func (p *ConfigureVirtualRouterElementParams) SetEnabled(v bool) {
 	return
 }
 
+// SetEnabledIfUnset sets enabled to v, unless it is already set
+func (p *ConfigureVirtualRouterElementParams) SetEnabledIfUnset(v bool) {
+	if _, found := p.p["enabled"]; !found {
+		p.SetEnabled(v)
+	}
+}
+
 func (p *ConfigureVirtualRouterElementParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -191,19 +295,55 @@ func (p *ConfigureVirtualRouterElementParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *ConfigureVirtualRouterElementParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 // You should always use this function to get a new ConfigureVirtualRouterElementParams instance,
-// as then you are sure you have configured all required params
-func (s *RouterService) NewConfigureVirtualRouterElementParams(enabled bool, id string) *ConfigureVirtualRouterElementParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *RouterService) NewConfigureVirtualRouterElementParams(enabled bool, id string, opts ...func(*ConfigureVirtualRouterElementParams)) *ConfigureVirtualRouterElementParams {
 	p := &ConfigureVirtualRouterElementParams{}
 	p.p = make(map[string]interface{})
 	p.p["enabled"] = enabled
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Configures a virtual router element.
-func (s *RouterService) ConfigureVirtualRouterElement(p *ConfigureVirtualRouterElementParams) (*VirtualRouterElementResponse, error) {
-	resp, err := s.cs.newRequest("configureVirtualRouterElement", p.toURLValues())
+func (s *RouterService) ConfigureVirtualRouterElement(p *ConfigureVirtualRouterElementParams, opts ...OptionFunc) (*VirtualRouterElementResponse, error) {
+	return s.ConfigureVirtualRouterElementWithContext(context.Background(), p, opts...)
+}
+
+// ConfigureVirtualRouterElementWithContext is the same as ConfigureVirtualRouterElement, but the request is cancelled when the context is done
+func (s *RouterService) ConfigureVirtualRouterElementWithContext(ctx context.Context, p *ConfigureVirtualRouterElementParams, opts ...OptionFunc) (*VirtualRouterElementResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.ConfigureVirtualRouterElementRawWithContext(ctx, p.toURLValues())
+}
+
+// ConfigureVirtualRouterElementRaw is the same as ConfigureVirtualRouterElement, but takes the params as url.Values instead of typed params
+func (s *RouterService) ConfigureVirtualRouterElementRaw(v url.Values) (*VirtualRouterElementResponse, error) {
+	return s.ConfigureVirtualRouterElementRawWithContext(context.Background(), v)
+}
+
+// ConfigureVirtualRouterElementRawWithContext is the same as ConfigureVirtualRouterElementRaw, but the request is cancelled when the context is done
+func (s *RouterService) ConfigureVirtualRouterElementRawWithContext(ctx context.Context, v url.Values) (*VirtualRouterElementResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "configureVirtualRouterElement", "configurevirtualrouterelementresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -215,9 +355,9 @@ func (s *RouterService) ConfigureVirtualRouterElement(p *ConfigureVirtualRouterE
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -252,6 +392,16 @@ type CreateVirtualRouterElementParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *CreateVirtualRouterElementParams) Clone() *CreateVirtualRouterElementParams {
+	c := &CreateVirtualRouterElementParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *CreateVirtualRouterElementParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -266,6 +416,16 @@ func (p *CreateVirtualRouterElementParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *CreateVirtualRouterElementParams) validate() error {
+	for _, k := range []string{"nspid"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of createVirtualRouterElement", k)
+		}
+	}
+	return nil
+}
+
 func (p *CreateVirtualRouterElementParams) SetNspid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -274,6 +434,13 @@ func (p *CreateVirtualRouterElementParams) SetNspid(v string) {
 	return
 }
 
+// SetNspidIfUnset sets nspid to v, unless it is already set
+func (p *CreateVirtualRouterElementParams) SetNspidIfUnset(v string) {
+	if _, found := p.p["nspid"]; !found {
+		p.SetNspid(v)
+	}
+}
+
 func (p *CreateVirtualRouterElementParams) SetProvidertype(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -282,18 +449,54 @@ func (p *CreateVirtualRouterElementParams) SetProvidertype(v string) {
 	return
 }
 
+// SetProvidertypeIfUnset sets providertype to v, unless it is already set
+func (p *CreateVirtualRouterElementParams) SetProvidertypeIfUnset(v string) {
+	if _, found := p.p["providertype"]; !found {
+		p.SetProvidertype(v)
+	}
+}
+
 // You should always use this function to get a new CreateVirtualRouterElementParams instance,
-// as then you are sure you have configured all required params
-func (s *RouterService) NewCreateVirtualRouterElementParams(nspid string) *CreateVirtualRouterElementParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *RouterService) NewCreateVirtualRouterElementParams(nspid string, opts ...func(*CreateVirtualRouterElementParams)) *CreateVirtualRouterElementParams {
 	p := &CreateVirtualRouterElementParams{}
 	p.p = make(map[string]interface{})
 	p.p["nspid"] = nspid
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Create a virtual router element.
-func (s *RouterService) CreateVirtualRouterElement(p *CreateVirtualRouterElementParams) (*CreateVirtualRouterElementResponse, error) {
-	resp, err := s.cs.newRequest("createVirtualRouterElement", p.toURLValues())
+func (s *RouterService) CreateVirtualRouterElement(p *CreateVirtualRouterElementParams, opts ...OptionFunc) (*CreateVirtualRouterElementResponse, error) {
+	return s.CreateVirtualRouterElementWithContext(context.Background(), p, opts...)
+}
+
+// CreateVirtualRouterElementWithContext is the same as CreateVirtualRouterElement, but the request is cancelled when the context is done
+func (s *RouterService) CreateVirtualRouterElementWithContext(ctx context.Context, p *CreateVirtualRouterElementParams, opts ...OptionFunc) (*CreateVirtualRouterElementResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.CreateVirtualRouterElementRawWithContext(ctx, p.toURLValues())
+}
+
+// CreateVirtualRouterElementRaw is the same as CreateVirtualRouterElement, but takes the params as url.Values instead of typed params
+func (s *RouterService) CreateVirtualRouterElementRaw(v url.Values) (*CreateVirtualRouterElementResponse, error) {
+	return s.CreateVirtualRouterElementRawWithContext(context.Background(), v)
+}
+
+// CreateVirtualRouterElementRawWithContext is the same as CreateVirtualRouterElementRaw, but the request is cancelled when the context is done
+func (s *RouterService) CreateVirtualRouterElementRawWithContext(ctx context.Context, v url.Values) (*CreateVirtualRouterElementResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "createVirtualRouterElement", "createvirtualrouterelementresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -305,9 +508,9 @@ func (s *RouterService) CreateVirtualRouterElement(p *CreateVirtualRouterElement
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -342,6 +545,16 @@ type DestroyRouterParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *DestroyRouterParams) Clone() *DestroyRouterParams {
+	c := &DestroyRouterParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DestroyRouterParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -353,6 +566,16 @@ func (p *DestroyRouterParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *DestroyRouterParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of destroyRouter", k)
+		}
+	}
+	return nil
+}
+
 func (p *DestroyRouterParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -361,18 +584,54 @@ func (p *DestroyRouterParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *DestroyRouterParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 // You should always use this function to get a new DestroyRouterParams instance,
-// as then you are sure you have configured all required params
-func (s *RouterService) NewDestroyRouterParams(id string) *DestroyRouterParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *RouterService) NewDestroyRouterParams(id string, opts ...func(*DestroyRouterParams)) *DestroyRouterParams {
 	p := &DestroyRouterParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Destroys a router.
-func (s *RouterService) DestroyRouter(p *DestroyRouterParams) (*DestroyRouterResponse, error) {
-	resp, err := s.cs.newRequest("destroyRouter", p.toURLValues())
+func (s *RouterService) DestroyRouter(p *DestroyRouterParams, opts ...OptionFunc) (*DestroyRouterResponse, error) {
+	return s.DestroyRouterWithContext(context.Background(), p, opts...)
+}
+
+// DestroyRouterWithContext is the same as DestroyRouter, but the request is cancelled when the context is done
+func (s *RouterService) DestroyRouterWithContext(ctx context.Context, p *DestroyRouterParams, opts ...OptionFunc) (*DestroyRouterResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.DestroyRouterRawWithContext(ctx, p.toURLValues())
+}
+
+// DestroyRouterRaw is the same as DestroyRouter, but takes the params as url.Values instead of typed params
+func (s *RouterService) DestroyRouterRaw(v url.Values) (*DestroyRouterResponse, error) {
+	return s.DestroyRouterRawWithContext(context.Background(), v)
+}
+
+// DestroyRouterRawWithContext is the same as DestroyRouterRaw, but the request is cancelled when the context is done
+func (s *RouterService) DestroyRouterRawWithContext(ctx context.Context, v url.Values) (*DestroyRouterResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "destroyRouter", "destroyrouterresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -384,9 +643,9 @@ func (s *RouterService) DestroyRouter(p *DestroyRouterParams) (*DestroyRouterRes
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -483,6 +742,16 @@ type ListRoutersParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ListRoutersParams) Clone() *ListRoutersParams {
+	c := &ListRoutersParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListRoutersParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -561,6 +830,13 @@ func (p *ListRoutersParams) SetAccount(v string) {
 	return
 }
 
+// SetAccountIfUnset sets account to v, unless it is already set
+func (p *ListRoutersParams) SetAccountIfUnset(v string) {
+	if _, found := p.p["account"]; !found {
+		p.SetAccount(v)
+	}
+}
+
 func (p *ListRoutersParams) SetClusterid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -569,6 +845,13 @@ func (p *ListRoutersParams) SetClusterid(v string) {
 	return
 }
 
+// SetClusteridIfUnset sets clusterid to v, unless it is already set
+func (p *ListRoutersParams) SetClusteridIfUnset(v string) {
+	if _, found := p.p["clusterid"]; !found {
+		p.SetClusterid(v)
+	}
+}
+
 func (p *ListRoutersParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -577,6 +860,13 @@ func (p *ListRoutersParams) SetDomainid(v string) {
 	return
 }
 
+// SetDomainidIfUnset sets domainid to v, unless it is already set
+func (p *ListRoutersParams) SetDomainidIfUnset(v string) {
+	if _, found := p.p["domainid"]; !found {
+		p.SetDomainid(v)
+	}
+}
+
 func (p *ListRoutersParams) SetForvpc(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -585,6 +875,13 @@ func (p *ListRoutersParams) SetForvpc(v bool) {
 	return
 }
 
+// SetForvpcIfUnset sets forvpc to v, unless it is already set
+func (p *ListRoutersParams) SetForvpcIfUnset(v bool) {
+	if _, found := p.p["forvpc"]; !found {
+		p.SetForvpc(v)
+	}
+}
+
 func (p *ListRoutersParams) SetHostid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -593,6 +890,13 @@ func (p *ListRoutersParams) SetHostid(v string) {
 	return
 }
 
+// SetHostidIfUnset sets hostid to v, unless it is already set
+func (p *ListRoutersParams) SetHostidIfUnset(v string) {
+	if _, found := p.p["hostid"]; !found {
+		p.SetHostid(v)
+	}
+}
+
 func (p *ListRoutersParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -601,6 +905,13 @@ func (p *ListRoutersParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *ListRoutersParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 func (p *ListRoutersParams) SetIsrecursive(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -609,6 +920,13 @@ func (p *ListRoutersParams) SetIsrecursive(v bool) {
 	return
 }
 
+// SetIsrecursiveIfUnset sets isrecursive to v, unless it is already set
+func (p *ListRoutersParams) SetIsrecursiveIfUnset(v bool) {
+	if _, found := p.p["isrecursive"]; !found {
+		p.SetIsrecursive(v)
+	}
+}
+
 func (p *ListRoutersParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -617,6 +935,13 @@ func (p *ListRoutersParams) SetKeyword(v string) {
 	return
 }
 
+// SetKeywordIfUnset sets keyword to v, unless it is already set
+func (p *ListRoutersParams) SetKeywordIfUnset(v string) {
+	if _, found := p.p["keyword"]; !found {
+		p.SetKeyword(v)
+	}
+}
+
 func (p *ListRoutersParams) SetListall(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -625,6 +950,13 @@ func (p *ListRoutersParams) SetListall(v bool) {
 	return
 }
 
+// SetListallIfUnset sets listall to v, unless it is already set
+func (p *ListRoutersParams) SetListallIfUnset(v bool) {
+	if _, found := p.p["listall"]; !found {
+		p.SetListall(v)
+	}
+}
+
 func (p *ListRoutersParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -633,6 +965,13 @@ func (p *ListRoutersParams) SetName(v string) {
 	return
 }
 
+// SetNameIfUnset sets name to v, unless it is already set
+func (p *ListRoutersParams) SetNameIfUnset(v string) {
+	if _, found := p.p["name"]; !found {
+		p.SetName(v)
+	}
+}
+
 func (p *ListRoutersParams) SetNetworkid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -641,6 +980,13 @@ func (p *ListRoutersParams) SetNetworkid(v string) {
 	return
 }
 
+// SetNetworkidIfUnset sets networkid to v, unless it is already set
+func (p *ListRoutersParams) SetNetworkidIfUnset(v string) {
+	if _, found := p.p["networkid"]; !found {
+		p.SetNetworkid(v)
+	}
+}
+
 func (p *ListRoutersParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -649,6 +995,13 @@ func (p *ListRoutersParams) SetPage(v int) {
 	return
 }
 
+// SetPageIfUnset sets page to v, unless it is already set
+func (p *ListRoutersParams) SetPageIfUnset(v int) {
+	if _, found := p.p["page"]; !found {
+		p.SetPage(v)
+	}
+}
+
 func (p *ListRoutersParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -657,6 +1010,13 @@ func (p *ListRoutersParams) SetPagesize(v int) {
 	return
 }
 
+// SetPagesizeIfUnset sets pagesize to v, unless it is already set
+func (p *ListRoutersParams) SetPagesizeIfUnset(v int) {
+	if _, found := p.p["pagesize"]; !found {
+		p.SetPagesize(v)
+	}
+}
+
 func (p *ListRoutersParams) SetPodid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -665,6 +1025,13 @@ func (p *ListRoutersParams) SetPodid(v string) {
 	return
 }
 
+// SetPodidIfUnset sets podid to v, unless it is already set
+func (p *ListRoutersParams) SetPodidIfUnset(v string) {
+	if _, found := p.p["podid"]; !found {
+		p.SetPodid(v)
+	}
+}
+
 func (p *ListRoutersParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -673,6 +1040,13 @@ func (p *ListRoutersParams) SetProjectid(v string) {
 	return
 }
 
+// SetProjectidIfUnset sets projectid to v, unless it is already set
+func (p *ListRoutersParams) SetProjectidIfUnset(v string) {
+	if _, found := p.p["projectid"]; !found {
+		p.SetProjectid(v)
+	}
+}
+
 func (p *ListRoutersParams) SetState(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -681,6 +1055,13 @@ func (p *ListRoutersParams) SetState(v string) {
 	return
 }
 
+// SetStateIfUnset sets state to v, unless it is already set
+func (p *ListRoutersParams) SetStateIfUnset(v string) {
+	if _, found := p.p["state"]; !found {
+		p.SetState(v)
+	}
+}
+
 func (p *ListRoutersParams) SetVersion(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -689,6 +1070,13 @@ func (p *ListRoutersParams) SetVersion(v string) {
 	return
 }
 
+// SetVersionIfUnset sets version to v, unless it is already set
+func (p *ListRoutersParams) SetVersionIfUnset(v string) {
+	if _, found := p.p["version"]; !found {
+		p.SetVersion(v)
+	}
+}
+
 func (p *ListRoutersParams) SetVpcid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -697,6 +1085,13 @@ func (p *ListRoutersParams) SetVpcid(v string) {
 	return
 }
 
+// SetVpcidIfUnset sets vpcid to v, unless it is already set
+func (p *ListRoutersParams) SetVpcidIfUnset(v string) {
+	if _, found := p.p["vpcid"]; !found {
+		p.SetVpcid(v)
+	}
+}
+
 func (p *ListRoutersParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -705,14 +1100,34 @@ func (p *ListRoutersParams) SetZoneid(v string) {
 	return
 }
 
+// SetZoneidIfUnset sets zoneid to v, unless it is already set
+func (p *ListRoutersParams) SetZoneidIfUnset(v string) {
+	if _, found := p.p["zoneid"]; !found {
+		p.SetZoneid(v)
+	}
+}
+
+var _ ListAllSetter = (*ListRoutersParams)(nil)
+
 // You should always use this function to get a new ListRoutersParams instance,
-// as then you are sure you have configured all required params
-func (s *RouterService) NewListRoutersParams() *ListRoutersParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *RouterService) NewListRoutersParams(opts ...func(*ListRoutersParams)) *ListRoutersParams {
 	p := &ListRoutersParams{}
 	p.p = make(map[string]interface{})
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
+func (p *ListRoutersParams) setFirstMatch() {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p[firstMatchKey] = true
+}
+
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *RouterService) GetRouterID(name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListRoutersParams{}
@@ -720,7 +1135,7 @@ func (s *RouterService) GetRouterID(name string, opts ...OptionFunc) (string, in
 
 	p.p["name"] = name
 
-	for _, fn := range append(s.cs.options, opts...) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
 		if err := fn(s.cs, p); err != nil {
 			return "", -1, err
 		}
@@ -732,7 +1147,7 @@ func (s *RouterService) GetRouterID(name string, opts ...OptionFunc) (string, in
 	}
 
 	if l.Count == 0 {
-		return "", l.Count, fmt.Errorf("No match found for %s: %+v", name, l)
+		return "", l.Count, fmt.Errorf("%w for %s: %+v", ErrNotFound, name, l)
 	}
 
 	if l.Count == 1 {
@@ -745,6 +1160,10 @@ func (s *RouterService) GetRouterID(name string, opts ...OptionFunc) (string, in
 				return v.Id, l.Count, nil
 			}
 		}
+
+		if _, ok := p.p[firstMatchKey]; ok && len(l.Routers) > 0 {
+			return l.Routers[0].Id, l.Count, nil
+		}
 	}
 	return "", l.Count, fmt.Errorf("Could not find an exact match for %s: %+v", name, l)
 }
@@ -770,7 +1189,7 @@ func (s *RouterService) GetRouterByID(id string, opts ...OptionFunc) (*Router, i
 
 	p.p["id"] = id
 
-	for _, fn := range append(s.cs.options, opts...) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
 		if err := fn(s.cs, p); err != nil {
 			return nil, -1, err
 		}
@@ -781,24 +1200,56 @@ func (s *RouterService) GetRouterByID(id string, opts ...OptionFunc) (*Router, i
 		if strings.Contains(err.Error(), fmt.Sprintf(
 			"Invalid parameter id value=%s due to incorrect long value format, "+
 				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+			return nil, 0, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
 	}
 
 	if l.Count == 1 {
 		return l.Routers[0], l.Count, nil
 	}
+
+	if _, ok := p.p[firstMatchKey]; ok && len(l.Routers) > 0 {
+		return l.Routers[0], l.Count, nil
+	}
 	return nil, l.Count, fmt.Errorf("There is more then one result for Router UUID: %s!", id)
 }
 
+// RouterExists returns true if a Router with the given ID exists. When no match is found
+// it returns false, while any other error is returned as is.
+func (s *RouterService) RouterExists(id string, opts ...OptionFunc) (bool, error) {
+	_, count, err := s.GetRouterByID(id, opts...)
+	if errors.Is(err, ErrNotFound) {
+		return false, nil
+	}
+	if count > 0 {
+		return true, nil
+	}
+	return false, err
+}
+
 // List routers.
 func (s *RouterService) ListRouters(p *ListRoutersParams) (*ListRoutersResponse, error) {
-	resp, err := s.cs.newRequest("listRouters", p.toURLValues())
+	return s.ListRoutersWithContext(context.Background(), p)
+}
+
+// ListRoutersWithContext is the same as ListRouters, but the request is cancelled when the context is done
+func (s *RouterService) ListRoutersWithContext(ctx context.Context, p *ListRoutersParams) (*ListRoutersResponse, error) {
+	return s.ListRoutersRawWithContext(ctx, p.toURLValues())
+}
+
+// ListRoutersRaw is the same as ListRouters, but takes the params as url.Values instead of typed params
+func (s *RouterService) ListRoutersRaw(v url.Values) (*ListRoutersResponse, error) {
+	return s.ListRoutersRawWithContext(context.Background(), v)
+}
+
+// ListRoutersRawWithContext is the same as ListRoutersRaw, but the request is cancelled when the context is done
+func (s *RouterService) ListRoutersRawWithContext(ctx context.Context, v url.Values) (*ListRoutersResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "listRouters", "listroutersresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -811,6 +1262,93 @@ func (s *RouterService) ListRouters(p *ListRoutersParams) (*ListRoutersResponse,
 	return &r, nil
 }
 
+// StreamRouters fetches the results of ListRouters page by page in the background and sends them on the
+// returned channel. Both returned channels are closed when done. If fetching a page fails, or the context
+// is done before all results are sent, the error is sent on the error channel and fetching stops. A
+// request for a page in flight is cancelled when the context is done. The page and pagesize set in the
+// params are ignored.
+func (s *RouterService) StreamRouters(ctx context.Context, p *ListRoutersParams) (<-chan *Router, <-chan error) {
+	ch := make(chan *Router)
+	errs := make(chan error, 1)
+
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesize(500)
+
+	go func() {
+		defer close(errs)
+		defer close(ch)
+
+		for page, count := 1, 0; ; page++ {
+			pp.SetPage(page)
+
+			l, err := s.ListRoutersWithContext(ctx, pp)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			for _, v := range l.Routers {
+				select {
+				case ch <- v:
+				case <-ctx.Done():
+					errs <- ctx.Err()
+					return
+				}
+			}
+
+			count += len(l.Routers)
+			if len(l.Routers) < 500 || count >= l.Count {
+				return
+			}
+		}
+	}()
+
+	return ch, errs
+}
+
+// ListRoutersWhere returns all results of ListRouters for which pred returns true. Note that the results are
+// filtered client-side, so all results matching the params are fetched first.
+// All pages are fetched, so the page and pagesize set in the params are ignored.
+func (s *RouterService) ListRoutersWhere(p *ListRoutersParams, pred func(*Router) bool) ([]*Router, error) {
+	var r []*Router
+	ch, errs := s.StreamRouters(context.Background(), p)
+	for v := range ch {
+		if pred(v) {
+			r = append(r, v)
+		}
+	}
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ListAllRouters returns the results of ListRouters across all pages, by calling ListRouters page by page until all
+// results are fetched. The pagesize set in the params is used for every page (500 if not set), and the
+// page set in the params is ignored.
+func (s *RouterService) ListAllRouters(p *ListRoutersParams) ([]*Router, error) {
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesizeIfUnset(500)
+	pagesize := pp.p["pagesize"].(int)
+
+	var r []*Router
+	for page := 1; ; page++ {
+		pp.SetPage(page)
+
+		l, err := s.ListRouters(pp)
+		if err != nil {
+			return nil, err
+		}
+		r = append(r, l.Routers...)
+
+		if len(l.Routers) < pagesize || len(r) >= l.Count {
+			return r, nil
+		}
+	}
+}
+
 type ListRoutersResponse struct {
 	Count   int       `json:"count"`
 	Routers []*Router `json:"router"`
@@ -893,6 +1431,16 @@ type ListVirtualRouterElementsParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ListVirtualRouterElementsParams) Clone() *ListVirtualRouterElementsParams {
+	c := &ListVirtualRouterElementsParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListVirtualRouterElementsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -930,6 +1478,13 @@ func (p *ListVirtualRouterElementsParams) SetEnabled(v bool) {
 	return
 }
 
+// SetEnabledIfUnset sets enabled to v, unless it is already set
+func (p *ListVirtualRouterElementsParams) SetEnabledIfUnset(v bool) {
+	if _, found := p.p["enabled"]; !found {
+		p.SetEnabled(v)
+	}
+}
+
 func (p *ListVirtualRouterElementsParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -938,6 +1493,13 @@ func (p *ListVirtualRouterElementsParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *ListVirtualRouterElementsParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 func (p *ListVirtualRouterElementsParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -946,6 +1508,13 @@ func (p *ListVirtualRouterElementsParams) SetKeyword(v string) {
 	return
 }
 
+// SetKeywordIfUnset sets keyword to v, unless it is already set
+func (p *ListVirtualRouterElementsParams) SetKeywordIfUnset(v string) {
+	if _, found := p.p["keyword"]; !found {
+		p.SetKeyword(v)
+	}
+}
+
 func (p *ListVirtualRouterElementsParams) SetNspid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -954,6 +1523,13 @@ func (p *ListVirtualRouterElementsParams) SetNspid(v string) {
 	return
 }
 
+// SetNspidIfUnset sets nspid to v, unless it is already set
+func (p *ListVirtualRouterElementsParams) SetNspidIfUnset(v string) {
+	if _, found := p.p["nspid"]; !found {
+		p.SetNspid(v)
+	}
+}
+
 func (p *ListVirtualRouterElementsParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -962,6 +1538,13 @@ func (p *ListVirtualRouterElementsParams) SetPage(v int) {
 	return
 }
 
+// SetPageIfUnset sets page to v, unless it is already set
+func (p *ListVirtualRouterElementsParams) SetPageIfUnset(v int) {
+	if _, found := p.p["page"]; !found {
+		p.SetPage(v)
+	}
+}
+
 func (p *ListVirtualRouterElementsParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -970,14 +1553,32 @@ func (p *ListVirtualRouterElementsParams) SetPagesize(v int) {
 	return
 }
 
+// SetPagesizeIfUnset sets pagesize to v, unless it is already set
+func (p *ListVirtualRouterElementsParams) SetPagesizeIfUnset(v int) {
+	if _, found := p.p["pagesize"]; !found {
+		p.SetPagesize(v)
+	}
+}
+
 // You should always use this function to get a new ListVirtualRouterElementsParams instance,
-// as then you are sure you have configured all required params
-func (s *RouterService) NewListVirtualRouterElementsParams() *ListVirtualRouterElementsParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *RouterService) NewListVirtualRouterElementsParams(opts ...func(*ListVirtualRouterElementsParams)) *ListVirtualRouterElementsParams {
 	p := &ListVirtualRouterElementsParams{}
 	p.p = make(map[string]interface{})
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
+func (p *ListVirtualRouterElementsParams) setFirstMatch() {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p[firstMatchKey] = true
+}
+
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *RouterService) GetVirtualRouterElementByID(id string, opts ...OptionFunc) (*VirtualRouterElement, int, error) {
 	p := &ListVirtualRouterElementsParams{}
@@ -985,7 +1586,7 @@ func (s *RouterService) GetVirtualRouterElementByID(id string, opts ...OptionFun
 
 	p.p["id"] = id
 
-	for _, fn := range append(s.cs.options, opts...) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
 		if err := fn(s.cs, p); err != nil {
 			return nil, -1, err
 		}
@@ -996,24 +1597,56 @@ func (s *RouterService) GetVirtualRouterElementByID(id string, opts ...OptionFun
 		if strings.Contains(err.Error(), fmt.Sprintf(
 			"Invalid parameter id value=%s due to incorrect long value format, "+
 				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+			return nil, 0, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
 	}
 
 	if l.Count == 1 {
 		return l.VirtualRouterElements[0], l.Count, nil
 	}
+
+	if _, ok := p.p[firstMatchKey]; ok && len(l.VirtualRouterElements) > 0 {
+		return l.VirtualRouterElements[0], l.Count, nil
+	}
 	return nil, l.Count, fmt.Errorf("There is more then one result for VirtualRouterElement UUID: %s!", id)
 }
 
+// VirtualRouterElementExists returns true if a VirtualRouterElement with the given ID exists. When no match is found
+// it returns false, while any other error is returned as is.
+func (s *RouterService) VirtualRouterElementExists(id string, opts ...OptionFunc) (bool, error) {
+	_, count, err := s.GetVirtualRouterElementByID(id, opts...)
+	if errors.Is(err, ErrNotFound) {
+		return false, nil
+	}
+	if count > 0 {
+		return true, nil
+	}
+	return false, err
+}
+
 // Lists all available virtual router elements.
 func (s *RouterService) ListVirtualRouterElements(p *ListVirtualRouterElementsParams) (*ListVirtualRouterElementsResponse, error) {
-	resp, err := s.cs.newRequest("listVirtualRouterElements", p.toURLValues())
+	return s.ListVirtualRouterElementsWithContext(context.Background(), p)
+}
+
+// ListVirtualRouterElementsWithContext is the same as ListVirtualRouterElements, but the request is cancelled when the context is done
+func (s *RouterService) ListVirtualRouterElementsWithContext(ctx context.Context, p *ListVirtualRouterElementsParams) (*ListVirtualRouterElementsResponse, error) {
+	return s.ListVirtualRouterElementsRawWithContext(ctx, p.toURLValues())
+}
+
+// ListVirtualRouterElementsRaw is the same as ListVirtualRouterElements, but takes the params as url.Values instead of typed params
+func (s *RouterService) ListVirtualRouterElementsRaw(v url.Values) (*ListVirtualRouterElementsResponse, error) {
+	return s.ListVirtualRouterElementsRawWithContext(context.Background(), v)
+}
+
+// ListVirtualRouterElementsRawWithContext is the same as ListVirtualRouterElementsRaw, but the request is cancelled when the context is done
+func (s *RouterService) ListVirtualRouterElementsRawWithContext(ctx context.Context, v url.Values) (*ListVirtualRouterElementsResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "listVirtualRouterElements", "listvirtualrouterelementsresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -1026,6 +1659,93 @@ func (s *RouterService) ListVirtualRouterElements(p *ListVirtualRouterElementsPa
 	return &r, nil
 }
 
+// StreamVirtualRouterElements fetches the results of ListVirtualRouterElements page by page in the background and sends them on the
+// returned channel. Both returned channels are closed when done. If fetching a page fails, or the context
+// is done before all results are sent, the error is sent on the error channel and fetching stops. A
+// request for a page in flight is cancelled when the context is done. The page and pagesize set in the
+// params are ignored.
+func (s *RouterService) StreamVirtualRouterElements(ctx context.Context, p *ListVirtualRouterElementsParams) (<-chan *VirtualRouterElement, <-chan error) {
+	ch := make(chan *VirtualRouterElement)
+	errs := make(chan error, 1)
+
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesize(500)
+
+	go func() {
+		defer close(errs)
+		defer close(ch)
+
+		for page, count := 1, 0; ; page++ {
+			pp.SetPage(page)
+
+			l, err := s.ListVirtualRouterElementsWithContext(ctx, pp)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			for _, v := range l.VirtualRouterElements {
+				select {
+				case ch <- v:
+				case <-ctx.Done():
+					errs <- ctx.Err()
+					return
+				}
+			}
+
+			count += len(l.VirtualRouterElements)
+			if len(l.VirtualRouterElements) < 500 || count >= l.Count {
+				return
+			}
+		}
+	}()
+
+	return ch, errs
+}
+
+// ListVirtualRouterElementsWhere returns all results of ListVirtualRouterElements for which pred returns true. Note that the results are
+// filtered client-side, so all results matching the params are fetched first.
+// All pages are fetched, so the page and pagesize set in the params are ignored.
+func (s *RouterService) ListVirtualRouterElementsWhere(p *ListVirtualRouterElementsParams, pred func(*VirtualRouterElement) bool) ([]*VirtualRouterElement, error) {
+	var r []*VirtualRouterElement
+	ch, errs := s.StreamVirtualRouterElements(context.Background(), p)
+	for v := range ch {
+		if pred(v) {
+			r = append(r, v)
+		}
+	}
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ListAllVirtualRouterElements returns the results of ListVirtualRouterElements across all pages, by calling ListVirtualRouterElements page by page until all
+// results are fetched. The pagesize set in the params is used for every page (500 if not set), and the
+// page set in the params is ignored.
+func (s *RouterService) ListAllVirtualRouterElements(p *ListVirtualRouterElementsParams) ([]*VirtualRouterElement, error) {
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesizeIfUnset(500)
+	pagesize := pp.p["pagesize"].(int)
+
+	var r []*VirtualRouterElement
+	for page := 1; ; page++ {
+		pp.SetPage(page)
+
+		l, err := s.ListVirtualRouterElements(pp)
+		if err != nil {
+			return nil, err
+		}
+		r = append(r, l.VirtualRouterElements...)
+
+		if len(l.VirtualRouterElements) < pagesize || len(r) >= l.Count {
+			return r, nil
+		}
+	}
+}
+
 type ListVirtualRouterElementsResponse struct {
 	Count                 int                     `json:"count"`
 	VirtualRouterElements []*VirtualRouterElement `json:"virtualrouterelement"`
@@ -1046,6 +1766,16 @@ type RebootRouterParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *RebootRouterParams) Clone() *RebootRouterParams {
+	c := &RebootRouterParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *RebootRouterParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1057,6 +1787,16 @@ func (p *RebootRouterParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *RebootRouterParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of rebootRouter", k)
+		}
+	}
+	return nil
+}
+
 func (p *RebootRouterParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1065,18 +1805,54 @@ func (p *RebootRouterParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *RebootRouterParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 // You should always use this function to get a new RebootRouterParams instance,
-// as then you are sure you have configured all required params
-func (s *RouterService) NewRebootRouterParams(id string) *RebootRouterParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *RouterService) NewRebootRouterParams(id string, opts ...func(*RebootRouterParams)) *RebootRouterParams {
 	p := &RebootRouterParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Starts a router.
-func (s *RouterService) RebootRouter(p *RebootRouterParams) (*RebootRouterResponse, error) {
-	resp, err := s.cs.newRequest("rebootRouter", p.toURLValues())
+func (s *RouterService) RebootRouter(p *RebootRouterParams, opts ...OptionFunc) (*RebootRouterResponse, error) {
+	return s.RebootRouterWithContext(context.Background(), p, opts...)
+}
+
+// RebootRouterWithContext is the same as RebootRouter, but the request is cancelled when the context is done
+func (s *RouterService) RebootRouterWithContext(ctx context.Context, p *RebootRouterParams, opts ...OptionFunc) (*RebootRouterResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.RebootRouterRawWithContext(ctx, p.toURLValues())
+}
+
+// RebootRouterRaw is the same as RebootRouter, but takes the params as url.Values instead of typed params
+func (s *RouterService) RebootRouterRaw(v url.Values) (*RebootRouterResponse, error) {
+	return s.RebootRouterRawWithContext(context.Background(), v)
+}
+
+// RebootRouterRawWithContext is the same as RebootRouterRaw, but the request is cancelled when the context is done
+func (s *RouterService) RebootRouterRawWithContext(ctx context.Context, v url.Values) (*RebootRouterResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "rebootRouter", "rebootrouterresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -1088,9 +1864,9 @@ func (s *RouterService) RebootRouter(p *RebootRouterParams) (*RebootRouterRespon
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -1187,6 +1963,16 @@ type StartRouterParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *StartRouterParams) Clone() *StartRouterParams {
+	c := &StartRouterParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *StartRouterParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1198,6 +1984,16 @@ func (p *StartRouterParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *StartRouterParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of startRouter", k)
+		}
+	}
+	return nil
+}
+
 func (p *StartRouterParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1206,18 +2002,54 @@ func (p *StartRouterParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *StartRouterParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 // You should always use this function to get a new StartRouterParams instance,
-// as then you are sure you have configured all required params
-func (s *RouterService) NewStartRouterParams(id string) *StartRouterParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *RouterService) NewStartRouterParams(id string, opts ...func(*StartRouterParams)) *StartRouterParams {
 	p := &StartRouterParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Starts a router.
-func (s *RouterService) StartRouter(p *StartRouterParams) (*StartRouterResponse, error) {
-	resp, err := s.cs.newRequest("startRouter", p.toURLValues())
+func (s *RouterService) StartRouter(p *StartRouterParams, opts ...OptionFunc) (*StartRouterResponse, error) {
+	return s.StartRouterWithContext(context.Background(), p, opts...)
+}
+
+// StartRouterWithContext is the same as StartRouter, but the request is cancelled when the context is done
+func (s *RouterService) StartRouterWithContext(ctx context.Context, p *StartRouterParams, opts ...OptionFunc) (*StartRouterResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.StartRouterRawWithContext(ctx, p.toURLValues())
+}
+
+// StartRouterRaw is the same as StartRouter, but takes the params as url.Values instead of typed params
+func (s *RouterService) StartRouterRaw(v url.Values) (*StartRouterResponse, error) {
+	return s.StartRouterRawWithContext(context.Background(), v)
+}
+
+// StartRouterRawWithContext is the same as StartRouterRaw, but the request is cancelled when the context is done
+func (s *RouterService) StartRouterRawWithContext(ctx context.Context, v url.Values) (*StartRouterResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "startRouter", "startrouterresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -1229,9 +2061,9 @@ func (s *RouterService) StartRouter(p *StartRouterParams) (*StartRouterResponse,
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -1328,6 +2160,16 @@ type StopRouterParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *StopRouterParams) Clone() *StopRouterParams {
+	c := &StopRouterParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *StopRouterParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1343,6 +2185,16 @@ func (p *StopRouterParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *StopRouterParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of stopRouter", k)
+		}
+	}
+	return nil
+}
+
 func (p *StopRouterParams) SetForced(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1351,6 +2203,13 @@ func (p *StopRouterParams) SetForced(v bool) {
 	return
 }
 
+// SetForcedIfUnset sets forced to v, unless it is already set
+func (p *StopRouterParams) SetForcedIfUnset(v bool) {
+	if _, found := p.p["forced"]; !found {
+		p.SetForced(v)
+	}
+}
+
 func (p *StopRouterParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1359,18 +2218,54 @@ func (p *StopRouterParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *StopRouterParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 // You should always use this function to get a new StopRouterParams instance,
-// as then you are sure you have configured all required params
-func (s *RouterService) NewStopRouterParams(id string) *StopRouterParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *RouterService) NewStopRouterParams(id string, opts ...func(*StopRouterParams)) *StopRouterParams {
 	p := &StopRouterParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Stops a router.
-func (s *RouterService) StopRouter(p *StopRouterParams) (*StopRouterResponse, error) {
-	resp, err := s.cs.newRequest("stopRouter", p.toURLValues())
+func (s *RouterService) StopRouter(p *StopRouterParams, opts ...OptionFunc) (*StopRouterResponse, error) {
+	return s.StopRouterWithContext(context.Background(), p, opts...)
+}
+
+// StopRouterWithContext is the same as StopRouter, but the request is cancelled when the context is done
+func (s *RouterService) StopRouterWithContext(ctx context.Context, p *StopRouterParams, opts ...OptionFunc) (*StopRouterResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.StopRouterRawWithContext(ctx, p.toURLValues())
+}
+
+// StopRouterRaw is the same as StopRouter, but takes the params as url.Values instead of typed params
+func (s *RouterService) StopRouterRaw(v url.Values) (*StopRouterResponse, error) {
+	return s.StopRouterRawWithContext(context.Background(), v)
+}
+
+// StopRouterRawWithContext is the same as StopRouterRaw, but the request is cancelled when the context is done
+func (s *RouterService) StopRouterRawWithContext(ctx context.Context, v url.Values) (*StopRouterResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "stopRouter", "stoprouterresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -1382,9 +2277,9 @@ func (s *RouterService) StopRouter(p *StopRouterParams) (*StopRouterResponse, er
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -17,15 +17,87 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
 	"net/url"
 	"strconv"
 )
 
+// ResetVMKeyAndWait resets the SSH key of the virtual machine to the given key pair, waits for the async
+// job to finish and returns the updated virtual machine. The API requires the virtual machine to be stopped,
+// so if it is not stopped an error is returned, unless stopAndStart is true. In that case the virtual
+// machine is stopped before the reset and started again afterwards (also when the reset failed). The
+// options are used when getting the virtual machine.
+func (s *SSHService) ResetVMKeyAndWait(vmid, keypairName string, stopAndStart bool, opts ...OptionFunc) (*VirtualMachine, error) {
+	vm, _, err := s.cs.VirtualMachine.GetVirtualMachineByID(vmid, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	restart := false
+	if vm.State != "Stopped" {
+		if !stopAndStart {
+			return nil, fmt.Errorf("Virtual machine %s must be stopped to reset its SSH key, but is %s", vmid, vm.State)
+		}
+		r, err := s.cs.VirtualMachine.StopVirtualMachine(s.cs.VirtualMachine.NewStopVirtualMachineParams(vmid))
+		if err == nil {
+			err = s.waitForJob(r.JobID)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("Failed to stop virtual machine %s: %w", vmid, err)
+		}
+		restart = true
+	}
+
+	r, err := s.ResetSSHKeyForVirtualMachine(s.NewResetSSHKeyForVirtualMachineParams(vmid, keypairName))
+	if err == nil {
+		err = s.waitForJob(r.JobID)
+	}
+	if err != nil {
+		err = fmt.Errorf("Failed to reset the SSH key of virtual machine %s: %w", vmid, err)
+	}
+
+	if restart {
+		r, serr := s.cs.VirtualMachine.StartVirtualMachine(s.cs.VirtualMachine.NewStartVirtualMachineParams(vmid))
+		if serr == nil {
+			serr = s.waitForJob(r.JobID)
+		}
+		if serr != nil && err == nil {
+			err = fmt.Errorf("Failed to start virtual machine %s: %w", vmid, serr)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	vm, _, err = s.cs.VirtualMachine.GetVirtualMachineByID(vmid, opts...)
+	return vm, err
+}
+
+func (s *SSHService) waitForJob(jobid string) error {
+	// An async client already waited for the job to finish
+	if s.cs.async {
+		return nil
+	}
+	_, err := s.cs.GetAsyncJobResult(jobid, s.cs.timeout)
+	return err
+}
+
 type CreateSSHKeyPairParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *CreateSSHKeyPairParams) Clone() *CreateSSHKeyPairParams {
+	c := &CreateSSHKeyPairParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *CreateSSHKeyPairParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -46,6 +118,16 @@ func (p *CreateSSHKeyPairParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *CreateSSHKeyPairParams) validate() error {
+	for _, k := range []string{"name"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of createSSHKeyPair", k)
+		}
+	}
+	return nil
+}
+
 func (p *CreateSSHKeyPairParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -54,6 +136,13 @@ func (p *CreateSSHKeyPairParams) SetAccount(v string) {
 	return
 }
 
+// SetAccountIfUnset sets account to v, unless it is already set
+func (p *CreateSSHKeyPairParams) SetAccountIfUnset(v string) {
+	if _, found := p.p["account"]; !found {
+		p.SetAccount(v)
+	}
+}
+
 func (p *CreateSSHKeyPairParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -62,6 +151,13 @@ func (p *CreateSSHKeyPairParams) SetDomainid(v string) {
 	return
 }
 
+// SetDomainidIfUnset sets domainid to v, unless it is already set
+func (p *CreateSSHKeyPairParams) SetDomainidIfUnset(v string) {
+	if _, found := p.p["domainid"]; !found {
+		p.SetDomainid(v)
+	}
+}
+
 func (p *CreateSSHKeyPairParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -70,6 +166,13 @@ func (p *CreateSSHKeyPairParams) SetName(v string) {
 	return
 }
 
+// SetNameIfUnset sets name to v, unless it is already set
+func (p *CreateSSHKeyPairParams) SetNameIfUnset(v string) {
+	if _, found := p.p["name"]; !found {
+		p.SetName(v)
+	}
+}
+
 func (p *CreateSSHKeyPairParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -78,23 +181,59 @@ func (p *CreateSSHKeyPairParams) SetProjectid(v string) {
 	return
 }
 
+// SetProjectidIfUnset sets projectid to v, unless it is already set
+func (p *CreateSSHKeyPairParams) SetProjectidIfUnset(v string) {
+	if _, found := p.p["projectid"]; !found {
+		p.SetProjectid(v)
+	}
+}
+
 // You should always use this function to get a new CreateSSHKeyPairParams instance,
-// as then you are sure you have configured all required params
-func (s *SSHService) NewCreateSSHKeyPairParams(name string) *CreateSSHKeyPairParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *SSHService) NewCreateSSHKeyPairParams(name string, opts ...func(*CreateSSHKeyPairParams)) *CreateSSHKeyPairParams {
 	p := &CreateSSHKeyPairParams{}
 	p.p = make(map[string]interface{})
 	p.p["name"] = name
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Create a new keypair and returns the private key
-func (s *SSHService) CreateSSHKeyPair(p *CreateSSHKeyPairParams) (*CreateSSHKeyPairResponse, error) {
-	resp, err := s.cs.newRequest("createSSHKeyPair", p.toURLValues())
+func (s *SSHService) CreateSSHKeyPair(p *CreateSSHKeyPairParams, opts ...OptionFunc) (*CreateSSHKeyPairResponse, error) {
+	return s.CreateSSHKeyPairWithContext(context.Background(), p, opts...)
+}
+
+// CreateSSHKeyPairWithContext is the same as CreateSSHKeyPair, but the request is cancelled when the context is done
+func (s *SSHService) CreateSSHKeyPairWithContext(ctx context.Context, p *CreateSSHKeyPairParams, opts ...OptionFunc) (*CreateSSHKeyPairResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.CreateSSHKeyPairRawWithContext(ctx, p.toURLValues())
+}
+
+// CreateSSHKeyPairRaw is the same as CreateSSHKeyPair, but takes the params as url.Values instead of typed params
+func (s *SSHService) CreateSSHKeyPairRaw(v url.Values) (*CreateSSHKeyPairResponse, error) {
+	return s.CreateSSHKeyPairRawWithContext(context.Background(), v)
+}
+
+// CreateSSHKeyPairRawWithContext is the same as CreateSSHKeyPairRaw, but the request is cancelled when the context is done
+func (s *SSHService) CreateSSHKeyPairRawWithContext(ctx context.Context, v url.Values) (*CreateSSHKeyPairResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "createSSHKeyPair", "createsshkeypairresponse", v)
 	if err != nil {
 		return nil, err
 	}
 
-	if resp, err = getRawValue(resp); err != nil {
+	if resp, err = getRawValueByKey(resp, "keypair"); err != nil {
 		return nil, err
 	}
 
@@ -114,6 +253,16 @@ type DeleteSSHKeyPairParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *DeleteSSHKeyPairParams) Clone() *DeleteSSHKeyPairParams {
+	c := &DeleteSSHKeyPairParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeleteSSHKeyPairParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -134,6 +283,16 @@ func (p *DeleteSSHKeyPairParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *DeleteSSHKeyPairParams) validate() error {
+	for _, k := range []string{"name"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of deleteSSHKeyPair", k)
+		}
+	}
+	return nil
+}
+
 func (p *DeleteSSHKeyPairParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -142,6 +301,13 @@ func (p *DeleteSSHKeyPairParams) SetAccount(v string) {
 	return
 }
 
+// SetAccountIfUnset sets account to v, unless it is already set
+func (p *DeleteSSHKeyPairParams) SetAccountIfUnset(v string) {
+	if _, found := p.p["account"]; !found {
+		p.SetAccount(v)
+	}
+}
+
 func (p *DeleteSSHKeyPairParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -150,6 +316,13 @@ func (p *DeleteSSHKeyPairParams) SetDomainid(v string) {
 	return
 }
 
+// SetDomainidIfUnset sets domainid to v, unless it is already set
+func (p *DeleteSSHKeyPairParams) SetDomainidIfUnset(v string) {
+	if _, found := p.p["domainid"]; !found {
+		p.SetDomainid(v)
+	}
+}
+
 func (p *DeleteSSHKeyPairParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -158,6 +331,13 @@ func (p *DeleteSSHKeyPairParams) SetName(v string) {
 	return
 }
 
+// SetNameIfUnset sets name to v, unless it is already set
+func (p *DeleteSSHKeyPairParams) SetNameIfUnset(v string) {
+	if _, found := p.p["name"]; !found {
+		p.SetName(v)
+	}
+}
+
 func (p *DeleteSSHKeyPairParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -166,18 +346,54 @@ func (p *DeleteSSHKeyPairParams) SetProjectid(v string) {
 	return
 }
 
+// SetProjectidIfUnset sets projectid to v, unless it is already set
+func (p *DeleteSSHKeyPairParams) SetProjectidIfUnset(v string) {
+	if _, found := p.p["projectid"]; !found {
+		p.SetProjectid(v)
+	}
+}
+
 // You should always use this function to get a new DeleteSSHKeyPairParams instance,
-// as then you are sure you have configured all required params
-func (s *SSHService) NewDeleteSSHKeyPairParams(name string) *DeleteSSHKeyPairParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *SSHService) NewDeleteSSHKeyPairParams(name string, opts ...func(*DeleteSSHKeyPairParams)) *DeleteSSHKeyPairParams {
 	p := &DeleteSSHKeyPairParams{}
 	p.p = make(map[string]interface{})
 	p.p["name"] = name
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Deletes a keypair by name
-func (s *SSHService) DeleteSSHKeyPair(p *DeleteSSHKeyPairParams) (*DeleteSSHKeyPairResponse, error) {
-	resp, err := s.cs.newRequest("deleteSSHKeyPair", p.toURLValues())
+func (s *SSHService) DeleteSSHKeyPair(p *DeleteSSHKeyPairParams, opts ...OptionFunc) (*DeleteSSHKeyPairResponse, error) {
+	return s.DeleteSSHKeyPairWithContext(context.Background(), p, opts...)
+}
+
+// DeleteSSHKeyPairWithContext is the same as DeleteSSHKeyPair, but the request is cancelled when the context is done
+func (s *SSHService) DeleteSSHKeyPairWithContext(ctx context.Context, p *DeleteSSHKeyPairParams, opts ...OptionFunc) (*DeleteSSHKeyPairResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.DeleteSSHKeyPairRawWithContext(ctx, p.toURLValues())
+}
+
+// DeleteSSHKeyPairRaw is the same as DeleteSSHKeyPair, but takes the params as url.Values instead of typed params
+func (s *SSHService) DeleteSSHKeyPairRaw(v url.Values) (*DeleteSSHKeyPairResponse, error) {
+	return s.DeleteSSHKeyPairRawWithContext(context.Background(), v)
+}
+
+// DeleteSSHKeyPairRawWithContext is the same as DeleteSSHKeyPairRaw, but the request is cancelled when the context is done
+func (s *SSHService) DeleteSSHKeyPairRawWithContext(ctx context.Context, v url.Values) (*DeleteSSHKeyPairResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteSSHKeyPair", "deletesshkeypairresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -218,6 +434,16 @@ type ListSSHKeyPairsParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ListSSHKeyPairsParams) Clone() *ListSSHKeyPairsParams {
+	c := &ListSSHKeyPairsParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListSSHKeyPairsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -268,6 +494,13 @@ func (p *ListSSHKeyPairsParams) SetAccount(v string) {
 	return
 }
 
+// SetAccountIfUnset sets account to v, unless it is already set
+func (p *ListSSHKeyPairsParams) SetAccountIfUnset(v string) {
+	if _, found := p.p["account"]; !found {
+		p.SetAccount(v)
+	}
+}
+
 func (p *ListSSHKeyPairsParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -276,6 +509,13 @@ func (p *ListSSHKeyPairsParams) SetDomainid(v string) {
 	return
 }
 
+// SetDomainidIfUnset sets domainid to v, unless it is already set
+func (p *ListSSHKeyPairsParams) SetDomainidIfUnset(v string) {
+	if _, found := p.p["domainid"]; !found {
+		p.SetDomainid(v)
+	}
+}
+
 func (p *ListSSHKeyPairsParams) SetFingerprint(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -284,6 +524,13 @@ func (p *ListSSHKeyPairsParams) SetFingerprint(v string) {
 	return
 }
 
+// SetFingerprintIfUnset sets fingerprint to v, unless it is already set
+func (p *ListSSHKeyPairsParams) SetFingerprintIfUnset(v string) {
+	if _, found := p.p["fingerprint"]; !found {
+		p.SetFingerprint(v)
+	}
+}
+
 func (p *ListSSHKeyPairsParams) SetIsrecursive(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -292,6 +539,13 @@ func (p *ListSSHKeyPairsParams) SetIsrecursive(v bool) {
 	return
 }
 
+// SetIsrecursiveIfUnset sets isrecursive to v, unless it is already set
+func (p *ListSSHKeyPairsParams) SetIsrecursiveIfUnset(v bool) {
+	if _, found := p.p["isrecursive"]; !found {
+		p.SetIsrecursive(v)
+	}
+}
+
 func (p *ListSSHKeyPairsParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -300,6 +554,13 @@ func (p *ListSSHKeyPairsParams) SetKeyword(v string) {
 	return
 }
 
+// SetKeywordIfUnset sets keyword to v, unless it is already set
+func (p *ListSSHKeyPairsParams) SetKeywordIfUnset(v string) {
+	if _, found := p.p["keyword"]; !found {
+		p.SetKeyword(v)
+	}
+}
+
 func (p *ListSSHKeyPairsParams) SetListall(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -308,6 +569,13 @@ func (p *ListSSHKeyPairsParams) SetListall(v bool) {
 	return
 }
 
+// SetListallIfUnset sets listall to v, unless it is already set
+func (p *ListSSHKeyPairsParams) SetListallIfUnset(v bool) {
+	if _, found := p.p["listall"]; !found {
+		p.SetListall(v)
+	}
+}
+
 func (p *ListSSHKeyPairsParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -316,6 +584,13 @@ func (p *ListSSHKeyPairsParams) SetName(v string) {
 	return
 }
 
+// SetNameIfUnset sets name to v, unless it is already set
+func (p *ListSSHKeyPairsParams) SetNameIfUnset(v string) {
+	if _, found := p.p["name"]; !found {
+		p.SetName(v)
+	}
+}
+
 func (p *ListSSHKeyPairsParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -324,6 +599,13 @@ func (p *ListSSHKeyPairsParams) SetPage(v int) {
 	return
 }
 
+// SetPageIfUnset sets page to v, unless it is already set
+func (p *ListSSHKeyPairsParams) SetPageIfUnset(v int) {
+	if _, found := p.p["page"]; !found {
+		p.SetPage(v)
+	}
+}
+
 func (p *ListSSHKeyPairsParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -332,6 +614,13 @@ func (p *ListSSHKeyPairsParams) SetPagesize(v int) {
 	return
 }
 
+// SetPagesizeIfUnset sets pagesize to v, unless it is already set
+func (p *ListSSHKeyPairsParams) SetPagesizeIfUnset(v int) {
+	if _, found := p.p["pagesize"]; !found {
+		p.SetPagesize(v)
+	}
+}
+
 func (p *ListSSHKeyPairsParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -340,17 +629,45 @@ func (p *ListSSHKeyPairsParams) SetProjectid(v string) {
 	return
 }
 
+// SetProjectidIfUnset sets projectid to v, unless it is already set
+func (p *ListSSHKeyPairsParams) SetProjectidIfUnset(v string) {
+	if _, found := p.p["projectid"]; !found {
+		p.SetProjectid(v)
+	}
+}
+
+var _ ListAllSetter = (*ListSSHKeyPairsParams)(nil)
+
 // You should always use this function to get a new ListSSHKeyPairsParams instance,
-// as then you are sure you have configured all required params
-func (s *SSHService) NewListSSHKeyPairsParams() *ListSSHKeyPairsParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *SSHService) NewListSSHKeyPairsParams(opts ...func(*ListSSHKeyPairsParams)) *ListSSHKeyPairsParams {
 	p := &ListSSHKeyPairsParams{}
 	p.p = make(map[string]interface{})
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // List registered keypairs
 func (s *SSHService) ListSSHKeyPairs(p *ListSSHKeyPairsParams) (*ListSSHKeyPairsResponse, error) {
-	resp, err := s.cs.newRequest("listSSHKeyPairs", p.toURLValues())
+	return s.ListSSHKeyPairsWithContext(context.Background(), p)
+}
+
+// ListSSHKeyPairsWithContext is the same as ListSSHKeyPairs, but the request is cancelled when the context is done
+func (s *SSHService) ListSSHKeyPairsWithContext(ctx context.Context, p *ListSSHKeyPairsParams) (*ListSSHKeyPairsResponse, error) {
+	return s.ListSSHKeyPairsRawWithContext(ctx, p.toURLValues())
+}
+
+// ListSSHKeyPairsRaw is the same as ListSSHKeyPairs, but takes the params as url.Values instead of typed params
+func (s *SSHService) ListSSHKeyPairsRaw(v url.Values) (*ListSSHKeyPairsResponse, error) {
+	return s.ListSSHKeyPairsRawWithContext(context.Background(), v)
+}
+
+// ListSSHKeyPairsRawWithContext is the same as ListSSHKeyPairsRaw, but the request is cancelled when the context is done
+func (s *SSHService) ListSSHKeyPairsRawWithContext(ctx context.Context, v url.Values) (*ListSSHKeyPairsResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "listSSHKeyPairs", "listsshkeypairsresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -363,6 +680,93 @@ func (s *SSHService) ListSSHKeyPairs(p *ListSSHKeyPairsParams) (*ListSSHKeyPairs
 	return &r, nil
 }
 
+// StreamSSHKeyPairs fetches the results of ListSSHKeyPairs page by page in the background and sends them on the
+// returned channel. Both returned channels are closed when done. If fetching a page fails, or the context
+// is done before all results are sent, the error is sent on the error channel and fetching stops. A
+// request for a page in flight is cancelled when the context is done. The page and pagesize set in the
+// params are ignored.
+func (s *SSHService) StreamSSHKeyPairs(ctx context.Context, p *ListSSHKeyPairsParams) (<-chan *SSHKeyPair, <-chan error) {
+	ch := make(chan *SSHKeyPair)
+	errs := make(chan error, 1)
+
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesize(500)
+
+	go func() {
+		defer close(errs)
+		defer close(ch)
+
+		for page, count := 1, 0; ; page++ {
+			pp.SetPage(page)
+
+			l, err := s.ListSSHKeyPairsWithContext(ctx, pp)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			for _, v := range l.SSHKeyPairs {
+				select {
+				case ch <- v:
+				case <-ctx.Done():
+					errs <- ctx.Err()
+					return
+				}
+			}
+
+			count += len(l.SSHKeyPairs)
+			if len(l.SSHKeyPairs) < 500 || count >= l.Count {
+				return
+			}
+		}
+	}()
+
+	return ch, errs
+}
+
+// ListSSHKeyPairsWhere returns all results of ListSSHKeyPairs for which pred returns true. Note that the results are
+// filtered client-side, so all results matching the params are fetched first.
+// All pages are fetched, so the page and pagesize set in the params are ignored.
+func (s *SSHService) ListSSHKeyPairsWhere(p *ListSSHKeyPairsParams, pred func(*SSHKeyPair) bool) ([]*SSHKeyPair, error) {
+	var r []*SSHKeyPair
+	ch, errs := s.StreamSSHKeyPairs(context.Background(), p)
+	for v := range ch {
+		if pred(v) {
+			r = append(r, v)
+		}
+	}
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ListAllSSHKeyPairs returns the results of ListSSHKeyPairs across all pages, by calling ListSSHKeyPairs page by page until all
+// results are fetched. The pagesize set in the params is used for every page (500 if not set), and the
+// page set in the params is ignored.
+func (s *SSHService) ListAllSSHKeyPairs(p *ListSSHKeyPairsParams) ([]*SSHKeyPair, error) {
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesizeIfUnset(500)
+	pagesize := pp.p["pagesize"].(int)
+
+	var r []*SSHKeyPair
+	for page := 1; ; page++ {
+		pp.SetPage(page)
+
+		l, err := s.ListSSHKeyPairs(pp)
+		if err != nil {
+			return nil, err
+		}
+		r = append(r, l.SSHKeyPairs...)
+
+		if len(l.SSHKeyPairs) < pagesize || len(r) >= l.Count {
+			return r, nil
+		}
+	}
+}
+
 type ListSSHKeyPairsResponse struct {
 	Count       int           `json:"count"`
 	SSHKeyPairs []*SSHKeyPair `json:"sshkeypair"`
@@ -380,6 +784,16 @@ type RegisterSSHKeyPairParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *RegisterSSHKeyPairParams) Clone() *RegisterSSHKeyPairParams {
+	c := &RegisterSSHKeyPairParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *RegisterSSHKeyPairParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -403,6 +817,16 @@ func (p *RegisterSSHKeyPairParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *RegisterSSHKeyPairParams) validate() error {
+	for _, k := range []string{"name", "publickey"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of registerSSHKeyPair", k)
+		}
+	}
+	return nil
+}
+
 func (p *RegisterSSHKeyPairParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -411,6 +835,13 @@ func (p *RegisterSSHKeyPairParams) SetAccount(v string) {
 	return
 }
 
+// SetAccountIfUnset sets account to v, unless it is already set
+func (p *RegisterSSHKeyPairParams) SetAccountIfUnset(v string) {
+	if _, found := p.p["account"]; !found {
+		p.SetAccount(v)
+	}
+}
+
 func (p *RegisterSSHKeyPairParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -419,6 +850,13 @@ func (p *RegisterSSHKeyPairParams) SetDomainid(v string) {
 	return
 }
 
+// SetDomainidIfUnset sets domainid to v, unless it is already set
+func (p *RegisterSSHKeyPairParams) SetDomainidIfUnset(v string) {
+	if _, found := p.p["domainid"]; !found {
+		p.SetDomainid(v)
+	}
+}
+
 func (p *RegisterSSHKeyPairParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -427,6 +865,13 @@ func (p *RegisterSSHKeyPairParams) SetName(v string) {
 	return
 }
 
+// SetNameIfUnset sets name to v, unless it is already set
+func (p *RegisterSSHKeyPairParams) SetNameIfUnset(v string) {
+	if _, found := p.p["name"]; !found {
+		p.SetName(v)
+	}
+}
+
 func (p *RegisterSSHKeyPairParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -435,6 +880,13 @@ func (p *RegisterSSHKeyPairParams) SetProjectid(v string) {
 	return
 }
 
+// SetProjectidIfUnset sets projectid to v, unless it is already set
+func (p *RegisterSSHKeyPairParams) SetProjectidIfUnset(v string) {
+	if _, found := p.p["projectid"]; !found {
+		p.SetProjectid(v)
+	}
+}
+
 func (p *RegisterSSHKeyPairParams) SetPublickey(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -443,24 +895,60 @@ func (p *RegisterSSHKeyPairParams) SetPublickey(v string) {
 	return
 }
 
+// SetPublickeyIfUnset sets publickey to v, unless it is already set
+func (p *RegisterSSHKeyPairParams) SetPublickeyIfUnset(v string) {
+	if _, found := p.p["publickey"]; !found {
+		p.SetPublickey(v)
+	}
+}
+
 // You should always use this function to get a new RegisterSSHKeyPairParams instance,
-// as then you are sure you have configured all required params
-func (s *SSHService) NewRegisterSSHKeyPairParams(name string, publickey string) *RegisterSSHKeyPairParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *SSHService) NewRegisterSSHKeyPairParams(name string, publickey string, opts ...func(*RegisterSSHKeyPairParams)) *RegisterSSHKeyPairParams {
 	p := &RegisterSSHKeyPairParams{}
 	p.p = make(map[string]interface{})
 	p.p["name"] = name
 	p.p["publickey"] = publickey
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Register a public key in a keypair under a certain name
-func (s *SSHService) RegisterSSHKeyPair(p *RegisterSSHKeyPairParams) (*RegisterSSHKeyPairResponse, error) {
-	resp, err := s.cs.newRequest("registerSSHKeyPair", p.toURLValues())
+func (s *SSHService) RegisterSSHKeyPair(p *RegisterSSHKeyPairParams, opts ...OptionFunc) (*RegisterSSHKeyPairResponse, error) {
+	return s.RegisterSSHKeyPairWithContext(context.Background(), p, opts...)
+}
+
+// RegisterSSHKeyPairWithContext is the same as RegisterSSHKeyPair, but the request is cancelled when the context is done
+func (s *SSHService) RegisterSSHKeyPairWithContext(ctx context.Context, p *RegisterSSHKeyPairParams, opts ...OptionFunc) (*RegisterSSHKeyPairResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.RegisterSSHKeyPairRawWithContext(ctx, p.toURLValues())
+}
+
+// RegisterSSHKeyPairRaw is the same as RegisterSSHKeyPair, but takes the params as url.Values instead of typed params
+func (s *SSHService) RegisterSSHKeyPairRaw(v url.Values) (*RegisterSSHKeyPairResponse, error) {
+	return s.RegisterSSHKeyPairRawWithContext(context.Background(), v)
+}
+
+// RegisterSSHKeyPairRawWithContext is the same as RegisterSSHKeyPairRaw, but the request is cancelled when the context is done
+func (s *SSHService) RegisterSSHKeyPairRawWithContext(ctx context.Context, v url.Values) (*RegisterSSHKeyPairResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "registerSSHKeyPair", "registersshkeypairresponse", v)
 	if err != nil {
 		return nil, err
 	}
 
-	if resp, err = getRawValue(resp); err != nil {
+	if resp, err = getRawValueByKey(resp, "keypair"); err != nil {
 		return nil, err
 	}
 
@@ -484,6 +972,16 @@ type ResetSSHKeyForVirtualMachineParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ResetSSHKeyForVirtualMachineParams) Clone() *ResetSSHKeyForVirtualMachineParams {
+	c := &ResetSSHKeyForVirtualMachineParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ResetSSHKeyForVirtualMachineParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -507,6 +1005,16 @@ func (p *ResetSSHKeyForVirtualMachineParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *ResetSSHKeyForVirtualMachineParams) validate() error {
+	for _, k := range []string{"id", "keypair"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of resetSSHKeyForVirtualMachine", k)
+		}
+	}
+	return nil
+}
+
 func (p *ResetSSHKeyForVirtualMachineParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -515,6 +1023,13 @@ func (p *ResetSSHKeyForVirtualMachineParams) SetAccount(v string) {
 	return
 }
 
+// SetAccountIfUnset sets account to v, unless it is already set
+func (p *ResetSSHKeyForVirtualMachineParams) SetAccountIfUnset(v string) {
+	if _, found := p.p["account"]; !found {
+		p.SetAccount(v)
+	}
+}
+
 func (p *ResetSSHKeyForVirtualMachineParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -523,6 +1038,13 @@ func (p *ResetSSHKeyForVirtualMachineParams) SetDomainid(v string) {
 	return
 }
 
+// SetDomainidIfUnset sets domainid to v, unless it is already set
+func (p *ResetSSHKeyForVirtualMachineParams) SetDomainidIfUnset(v string) {
+	if _, found := p.p["domainid"]; !found {
+		p.SetDomainid(v)
+	}
+}
+
 func (p *ResetSSHKeyForVirtualMachineParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -531,6 +1053,13 @@ func (p *ResetSSHKeyForVirtualMachineParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *ResetSSHKeyForVirtualMachineParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 func (p *ResetSSHKeyForVirtualMachineParams) SetKeypair(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -539,6 +1068,13 @@ func (p *ResetSSHKeyForVirtualMachineParams) SetKeypair(v string) {
 	return
 }
 
+// SetKeypairIfUnset sets keypair to v, unless it is already set
+func (p *ResetSSHKeyForVirtualMachineParams) SetKeypairIfUnset(v string) {
+	if _, found := p.p["keypair"]; !found {
+		p.SetKeypair(v)
+	}
+}
+
 func (p *ResetSSHKeyForVirtualMachineParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -547,19 +1083,55 @@ func (p *ResetSSHKeyForVirtualMachineParams) SetProjectid(v string) {
 	return
 }
 
+// SetProjectidIfUnset sets projectid to v, unless it is already set
+func (p *ResetSSHKeyForVirtualMachineParams) SetProjectidIfUnset(v string) {
+	if _, found := p.p["projectid"]; !found {
+		p.SetProjectid(v)
+	}
+}
+
 // You should always use this function to get a new ResetSSHKeyForVirtualMachineParams instance,
-// as then you are sure you have configured all required params
-func (s *SSHService) NewResetSSHKeyForVirtualMachineParams(id string, keypair string) *ResetSSHKeyForVirtualMachineParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *SSHService) NewResetSSHKeyForVirtualMachineParams(id string, keypair string, opts ...func(*ResetSSHKeyForVirtualMachineParams)) *ResetSSHKeyForVirtualMachineParams {
 	p := &ResetSSHKeyForVirtualMachineParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
 	p.p["keypair"] = keypair
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Resets the SSH Key for virtual machine. The virtual machine must be in a "Stopped" state. [async]
-func (s *SSHService) ResetSSHKeyForVirtualMachine(p *ResetSSHKeyForVirtualMachineParams) (*ResetSSHKeyForVirtualMachineResponse, error) {
-	resp, err := s.cs.newRequest("resetSSHKeyForVirtualMachine", p.toURLValues())
+func (s *SSHService) ResetSSHKeyForVirtualMachine(p *ResetSSHKeyForVirtualMachineParams, opts ...OptionFunc) (*ResetSSHKeyForVirtualMachineResponse, error) {
+	return s.ResetSSHKeyForVirtualMachineWithContext(context.Background(), p, opts...)
+}
+
+// ResetSSHKeyForVirtualMachineWithContext is the same as ResetSSHKeyForVirtualMachine, but the request is cancelled when the context is done
+func (s *SSHService) ResetSSHKeyForVirtualMachineWithContext(ctx context.Context, p *ResetSSHKeyForVirtualMachineParams, opts ...OptionFunc) (*ResetSSHKeyForVirtualMachineResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.ResetSSHKeyForVirtualMachineRawWithContext(ctx, p.toURLValues())
+}
+
+// ResetSSHKeyForVirtualMachineRaw is the same as ResetSSHKeyForVirtualMachine, but takes the params as url.Values instead of typed params
+func (s *SSHService) ResetSSHKeyForVirtualMachineRaw(v url.Values) (*ResetSSHKeyForVirtualMachineResponse, error) {
+	return s.ResetSSHKeyForVirtualMachineRawWithContext(context.Background(), v)
+}
+
+// ResetSSHKeyForVirtualMachineRawWithContext is the same as ResetSSHKeyForVirtualMachineRaw, but the request is cancelled when the context is done
+func (s *SSHService) ResetSSHKeyForVirtualMachineRawWithContext(ctx context.Context, v url.Values) (*ResetSSHKeyForVirtualMachineResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "resetSSHKeyForVirtualMachine", "resetsshkeyforvirtualmachineresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -571,9 +1143,9 @@ func (s *SSHService) ResetSSHKeyForVirtualMachine(p *ResetSSHKeyForVirtualMachin
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -607,43 +1179,43 @@ type ResetSSHKeyForVirtualMachineResponse struct {
 		Type              string   `json:"type"`
 		VirtualmachineIds []string `json:"virtualmachineIds"`
 	} `json:"affinitygroup"`
-	Cpunumber             int               `json:"cpunumber"`
-	Cpuspeed              int               `json:"cpuspeed"`
-	Cpuused               string            `json:"cpuused"`
-	Created               string            `json:"created"`
-	Details               map[string]string `json:"details"`
-	Diskioread            int64             `json:"diskioread"`
-	Diskiowrite           int64             `json:"diskiowrite"`
-	Diskkbsread           int64             `json:"diskkbsread"`
-	Diskkbswrite          int64             `json:"diskkbswrite"`
-	Diskofferingid        string            `json:"diskofferingid"`
-	Diskofferingname      string            `json:"diskofferingname"`
-	Displayname           string            `json:"displayname"`
-	Displayvm             bool              `json:"displayvm"`
-	Domain                string            `json:"domain"`
-	Domainid              string            `json:"domainid"`
-	Forvirtualnetwork     bool              `json:"forvirtualnetwork"`
-	Group                 string            `json:"group"`
-	Groupid               string            `json:"groupid"`
-	Guestosid             string            `json:"guestosid"`
-	Haenable              bool              `json:"haenable"`
-	Hostid                string            `json:"hostid"`
-	Hostname              string            `json:"hostname"`
-	Hypervisor            string            `json:"hypervisor"`
-	Id                    string            `json:"id"`
-	Instancename          string            `json:"instancename"`
-	Isdynamicallyscalable bool              `json:"isdynamicallyscalable"`
-	Isodisplaytext        string            `json:"isodisplaytext"`
-	Isoid                 string            `json:"isoid"`
-	Isoname               string            `json:"isoname"`
-	Keypair               string            `json:"keypair"`
-	Memory                int               `json:"memory"`
-	Memoryintfreekbs      int64             `json:"memoryintfreekbs"`
-	Memorykbs             int64             `json:"memorykbs"`
-	Memorytargetkbs       int64             `json:"memorytargetkbs"`
-	Name                  string            `json:"name"`
-	Networkkbsread        int64             `json:"networkkbsread"`
-	Networkkbswrite       int64             `json:"networkkbswrite"`
+	Cpunumber             int       `json:"cpunumber"`
+	Cpuspeed              int       `json:"cpuspeed"`
+	Cpuused               string    `json:"cpuused"`
+	Created               string    `json:"created"`
+	Details               StringMap `json:"details"`
+	Diskioread            int64     `json:"diskioread"`
+	Diskiowrite           int64     `json:"diskiowrite"`
+	Diskkbsread           int64     `json:"diskkbsread"`
+	Diskkbswrite          int64     `json:"diskkbswrite"`
+	Diskofferingid        string    `json:"diskofferingid"`
+	Diskofferingname      string    `json:"diskofferingname"`
+	Displayname           string    `json:"displayname"`
+	Displayvm             bool      `json:"displayvm"`
+	Domain                string    `json:"domain"`
+	Domainid              string    `json:"domainid"`
+	Forvirtualnetwork     bool      `json:"forvirtualnetwork"`
+	Group                 string    `json:"group"`
+	Groupid               string    `json:"groupid"`
+	Guestosid             string    `json:"guestosid"`
+	Haenable              bool      `json:"haenable"`
+	Hostid                string    `json:"hostid"`
+	Hostname              string    `json:"hostname"`
+	Hypervisor            string    `json:"hypervisor"`
+	Id                    string    `json:"id"`
+	Instancename          string    `json:"instancename"`
+	Isdynamicallyscalable bool      `json:"isdynamicallyscalable"`
+	Isodisplaytext        string    `json:"isodisplaytext"`
+	Isoid                 string    `json:"isoid"`
+	Isoname               string    `json:"isoname"`
+	Keypair               string    `json:"keypair"`
+	Memory                int       `json:"memory"`
+	Memoryintfreekbs      int64     `json:"memoryintfreekbs"`
+	Memorykbs             int64     `json:"memorykbs"`
+	Memorytargetkbs       int64     `json:"memorytargetkbs"`
+	Name                  string    `json:"name"`
+	Networkkbsread        int64     `json:"networkkbsread"`
+	Networkkbswrite       int64     `json:"networkkbswrite"`
 	Nic                   []struct {
 		Broadcasturi         string `json:"broadcasturi"`
 		Deviceid             string `json:"deviceid"`
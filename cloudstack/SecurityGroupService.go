@@ -17,49 +17,198 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/url"
+	"sort"
 	"strconv"
 	"strings"
+	"time"
 )
 
 // Helper function for maintaining backwards compatibility
-func convertAuthorizeSecurityGroupIngressResponse(b []byte) ([]byte, error) {
+func convertAuthorizeSecurityGroupEgressResponse(b []byte) ([]byte, error) {
 	var raw struct {
-		Ingressrule []interface{} `json:"ingressrule"`
+		Egressrule []interface{} `json:"egressrule"`
 	}
 	if err := json.Unmarshal(b, &raw); err != nil {
 		return nil, err
 	}
 
-	if len(raw.Ingressrule) != 1 {
+	if len(raw.Egressrule) != 1 {
 		return b, nil
 	}
 
-	return json.Marshal(raw.Ingressrule[0])
+	return json.Marshal(raw.Egressrule[0])
 }
 
 // Helper function for maintaining backwards compatibility
-func convertAuthorizeSecurityGroupEgressResponse(b []byte) ([]byte, error) {
+func convertAuthorizeSecurityGroupIngressResponse(b []byte) ([]byte, error) {
 	var raw struct {
-		Egressrule []interface{} `json:"egressrule"`
+		Ingressrule []interface{} `json:"ingressrule"`
 	}
 	if err := json.Unmarshal(b, &raw); err != nil {
 		return nil, err
 	}
 
-	if len(raw.Egressrule) != 1 {
+	if len(raw.Ingressrule) != 1 {
 		return b, nil
 	}
 
-	return json.Marshal(raw.Egressrule[0])
+	return json.Marshal(raw.Ingressrule[0])
+}
+
+// IngressRuleSpec describes an ingress rule of a security group, as used by ReconcileIngress. The
+// source of the traffic is either the CIDR, or the security group with the given name owned by the
+// given account. The ports are only used for the tcp and udp protocols, and the ICMP type and code
+// only for the icmp protocol. When EndPort is 0 it is the same as StartPort.
+type IngressRuleSpec struct {
+	Protocol          Protocol
+	CIDR              string
+	SecurityGroupName string
+	Account           string
+	StartPort         int
+	EndPort           int
+	ICMPType          int
+	ICMPCode          int
+}
+
+// Returns the spec with the fields that do not apply to its protocol or source cleared, so it can be
+// compared with other specs
+func (r IngressRuleSpec) normalize() IngressRuleSpec {
+	r.Protocol = Protocol(strings.ToLower(string(r.Protocol)))
+	if r.CIDR != "" {
+		r.SecurityGroupName, r.Account = "", ""
+	}
+	if r.Protocol == ProtocolTCP || r.Protocol == ProtocolUDP {
+		if r.EndPort == 0 {
+			r.EndPort = r.StartPort
+		}
+	} else {
+		r.StartPort, r.EndPort = 0, 0
+	}
+	if r.Protocol != ProtocolICMP {
+		r.ICMPType, r.ICMPCode = 0, 0
+	}
+	return r
+}
+
+// ReconcileIngress makes the ingress rules of the security group match the desired rules. It first
+// authorizes the desired rules the group does not have yet and then revokes the rules of the group
+// that are not desired, waiting for every command to finish. Rules that already match are left
+// untouched. All changes are attempted even when some of them fail, in which case the failures are
+// returned joined into a single error. The options are used when getting the security group.
+func (s *SecurityGroupService) ReconcileIngress(groupid string, desired []IngressRuleSpec, opts ...OptionFunc) error {
+	sg, _, err := s.GetSecurityGroupByID(groupid, opts...)
+	if err != nil {
+		return err
+	}
+
+	// The IDs of the current rules by their spec
+	current := make(map[IngressRuleSpec]string, len(sg.Ingressrule))
+	for _, r := range sg.Ingressrule {
+		spec := IngressRuleSpec{
+			Protocol:          Protocol(r.Protocol),
+			CIDR:              r.Cidr,
+			SecurityGroupName: r.Securitygroupname,
+			Account:           r.Account,
+			StartPort:         r.Startport,
+			EndPort:           r.Endport,
+			ICMPType:          r.Icmptype,
+			ICMPCode:          r.Icmpcode,
+		}
+		current[spec.normalize()] = r.Ruleid
+	}
+
+	var errs []error
+	wanted := make(map[IngressRuleSpec]bool, len(desired))
+	for _, r := range desired {
+		r = r.normalize()
+		if wanted[r] {
+			continue
+		}
+		wanted[r] = true
+
+		if _, ok := current[r]; ok {
+			continue
+		}
+		if err := s.authorizeIngress(groupid, r); err != nil {
+			errs = append(errs, fmt.Errorf("Failed to authorize ingress rule %+v: %w", r, err))
+		}
+	}
+
+	var revoke []string
+	for r, id := range current {
+		if !wanted[r] {
+			revoke = append(revoke, id)
+		}
+	}
+	sort.Strings(revoke)
+
+	for _, id := range revoke {
+		r, err := s.RevokeSecurityGroupIngress(s.NewRevokeSecurityGroupIngressParams(id))
+		if err == nil {
+			err = s.waitForJob(r.JobID)
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("Failed to revoke ingress rule %s: %w", id, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// Authorizes the ingress rule for the security group and waits for the async job to finish
+func (s *SecurityGroupService) authorizeIngress(groupid string, r IngressRuleSpec) error {
+	p := s.NewAuthorizeSecurityGroupIngressParams()
+	p.SetSecuritygroupid(groupid)
+	p.SetProtocol(r.Protocol)
+	if r.CIDR != "" {
+		p.SetCidrlist([]string{r.CIDR})
+	} else {
+		p.SetUsersecuritygrouplist(map[string]string{r.Account: r.SecurityGroupName})
+	}
+	switch r.Protocol {
+	case ProtocolTCP, ProtocolUDP:
+		p.SetStartport(r.StartPort)
+		p.SetEndport(r.EndPort)
+	case ProtocolICMP:
+		p.SetIcmptype(r.ICMPType)
+		p.SetIcmpcode(r.ICMPCode)
+	}
+
+	resp, err := s.AuthorizeSecurityGroupIngress(p)
+	if err != nil {
+		return err
+	}
+	return s.waitForJob(resp.JobID)
+}
+
+func (s *SecurityGroupService) waitForJob(jobid string) error {
+	// An async client already waited for the job to finish
+	if s.cs.async {
+		return nil
+	}
+	_, err := s.cs.GetAsyncJobResult(jobid, s.cs.timeout)
+	return err
 }
 
 type AuthorizeSecurityGroupEgressParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *AuthorizeSecurityGroupEgressParams) Clone() *AuthorizeSecurityGroupEgressParams {
+	c := &AuthorizeSecurityGroupEgressParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *AuthorizeSecurityGroupEgressParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -104,11 +253,10 @@ func (p *AuthorizeSecurityGroupEgressParams) toURLValues() url.Values {
 		u.Set("startport", vv)
 	}
 	if v, found := p.p["usersecuritygrouplist"]; found {
-		i := 0
-		for k, vv := range v.(map[string]string) {
+		m := v.(map[string]string)
+		for i, k := range getSortedKeysFromMap(m) {
 			u.Set(fmt.Sprintf("usersecuritygrouplist[%d].account", i), k)
-			u.Set(fmt.Sprintf("usersecuritygrouplist[%d].group", i), vv)
-			i++
+			u.Set(fmt.Sprintf("usersecuritygrouplist[%d].group", i), m[k])
 		}
 	}
 	return u
@@ -122,6 +270,13 @@ func (p *AuthorizeSecurityGroupEgressParams) SetAccount(v string) {
 	return
 }
 
+// SetAccountIfUnset sets account to v, unless it is already set
+func (p *AuthorizeSecurityGroupEgressParams) SetAccountIfUnset(v string) {
+	if _, found := p.p["account"]; !found {
+		p.SetAccount(v)
+	}
+}
+
 func (p *AuthorizeSecurityGroupEgressParams) SetCidrlist(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -130,6 +285,18 @@ func (p *AuthorizeSecurityGroupEgressParams) SetCidrlist(v []string) {
 	return
 }
 
+// SetCidrlistIfUnset sets cidrlist to v, unless it is already set
+func (p *AuthorizeSecurityGroupEgressParams) SetCidrlistIfUnset(v []string) {
+	if _, found := p.p["cidrlist"]; !found {
+		p.SetCidrlist(v)
+	}
+}
+
+// SetCidrlistCSV splits v on commas and sets the resulting values
+func (p *AuthorizeSecurityGroupEgressParams) SetCidrlistCSV(v string) {
+	p.SetCidrlist(splitCSV(v))
+}
+
 func (p *AuthorizeSecurityGroupEgressParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -138,6 +305,13 @@ func (p *AuthorizeSecurityGroupEgressParams) SetDomainid(v string) {
 	return
 }
 
+// SetDomainidIfUnset sets domainid to v, unless it is already set
+func (p *AuthorizeSecurityGroupEgressParams) SetDomainidIfUnset(v string) {
+	if _, found := p.p["domainid"]; !found {
+		p.SetDomainid(v)
+	}
+}
+
 func (p *AuthorizeSecurityGroupEgressParams) SetEndport(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -146,6 +320,13 @@ func (p *AuthorizeSecurityGroupEgressParams) SetEndport(v int) {
 	return
 }
 
+// SetEndportIfUnset sets endport to v, unless it is already set
+func (p *AuthorizeSecurityGroupEgressParams) SetEndportIfUnset(v int) {
+	if _, found := p.p["endport"]; !found {
+		p.SetEndport(v)
+	}
+}
+
 func (p *AuthorizeSecurityGroupEgressParams) SetIcmpcode(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -154,6 +335,13 @@ func (p *AuthorizeSecurityGroupEgressParams) SetIcmpcode(v int) {
 	return
 }
 
+// SetIcmpcodeIfUnset sets icmpcode to v, unless it is already set
+func (p *AuthorizeSecurityGroupEgressParams) SetIcmpcodeIfUnset(v int) {
+	if _, found := p.p["icmpcode"]; !found {
+		p.SetIcmpcode(v)
+	}
+}
+
 func (p *AuthorizeSecurityGroupEgressParams) SetIcmptype(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -162,6 +350,13 @@ func (p *AuthorizeSecurityGroupEgressParams) SetIcmptype(v int) {
 	return
 }
 
+// SetIcmptypeIfUnset sets icmptype to v, unless it is already set
+func (p *AuthorizeSecurityGroupEgressParams) SetIcmptypeIfUnset(v int) {
+	if _, found := p.p["icmptype"]; !found {
+		p.SetIcmptype(v)
+	}
+}
+
 func (p *AuthorizeSecurityGroupEgressParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -170,14 +365,28 @@ func (p *AuthorizeSecurityGroupEgressParams) SetProjectid(v string) {
 	return
 }
 
-func (p *AuthorizeSecurityGroupEgressParams) SetProtocol(v string) {
+// SetProjectidIfUnset sets projectid to v, unless it is already set
+func (p *AuthorizeSecurityGroupEgressParams) SetProjectidIfUnset(v string) {
+	if _, found := p.p["projectid"]; !found {
+		p.SetProjectid(v)
+	}
+}
+
+func (p *AuthorizeSecurityGroupEgressParams) SetProtocol(v Protocol) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	p.p["protocol"] = v
+	p.p["protocol"] = string(v)
 	return
 }
 
+// SetProtocolIfUnset sets protocol to v, unless it is already set
+func (p *AuthorizeSecurityGroupEgressParams) SetProtocolIfUnset(v Protocol) {
+	if _, found := p.p["protocol"]; !found {
+		p.SetProtocol(v)
+	}
+}
+
 func (p *AuthorizeSecurityGroupEgressParams) SetSecuritygroupid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -186,6 +395,13 @@ func (p *AuthorizeSecurityGroupEgressParams) SetSecuritygroupid(v string) {
 	return
 }
 
+// SetSecuritygroupidIfUnset sets securitygroupid to v, unless it is already set
+func (p *AuthorizeSecurityGroupEgressParams) SetSecuritygroupidIfUnset(v string) {
+	if _, found := p.p["securitygroupid"]; !found {
+		p.SetSecuritygroupid(v)
+	}
+}
+
 func (p *AuthorizeSecurityGroupEgressParams) SetSecuritygroupname(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -194,6 +410,13 @@ func (p *AuthorizeSecurityGroupEgressParams) SetSecuritygroupname(v string) {
 	return
 }
 
+// SetSecuritygroupnameIfUnset sets securitygroupname to v, unless it is already set
+func (p *AuthorizeSecurityGroupEgressParams) SetSecuritygroupnameIfUnset(v string) {
+	if _, found := p.p["securitygroupname"]; !found {
+		p.SetSecuritygroupname(v)
+	}
+}
+
 func (p *AuthorizeSecurityGroupEgressParams) SetStartport(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -202,6 +425,13 @@ func (p *AuthorizeSecurityGroupEgressParams) SetStartport(v int) {
 	return
 }
 
+// SetStartportIfUnset sets startport to v, unless it is already set
+func (p *AuthorizeSecurityGroupEgressParams) SetStartportIfUnset(v int) {
+	if _, found := p.p["startport"]; !found {
+		p.SetStartport(v)
+	}
+}
+
 func (p *AuthorizeSecurityGroupEgressParams) SetUsersecuritygrouplist(v map[string]string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -210,17 +440,49 @@ func (p *AuthorizeSecurityGroupEgressParams) SetUsersecuritygrouplist(v map[stri
 	return
 }
 
+// SetUsersecuritygrouplistIfUnset sets usersecuritygrouplist to v, unless it is already set
+func (p *AuthorizeSecurityGroupEgressParams) SetUsersecuritygrouplistIfUnset(v map[string]string) {
+	if _, found := p.p["usersecuritygrouplist"]; !found {
+		p.SetUsersecuritygrouplist(v)
+	}
+}
+
 // You should always use this function to get a new AuthorizeSecurityGroupEgressParams instance,
-// as then you are sure you have configured all required params
-func (s *SecurityGroupService) NewAuthorizeSecurityGroupEgressParams() *AuthorizeSecurityGroupEgressParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *SecurityGroupService) NewAuthorizeSecurityGroupEgressParams(opts ...func(*AuthorizeSecurityGroupEgressParams)) *AuthorizeSecurityGroupEgressParams {
 	p := &AuthorizeSecurityGroupEgressParams{}
 	p.p = make(map[string]interface{})
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Authorizes a particular egress rule for this security group
-func (s *SecurityGroupService) AuthorizeSecurityGroupEgress(p *AuthorizeSecurityGroupEgressParams) (*AuthorizeSecurityGroupEgressResponse, error) {
-	resp, err := s.cs.newRequest("authorizeSecurityGroupEgress", p.toURLValues())
+func (s *SecurityGroupService) AuthorizeSecurityGroupEgress(p *AuthorizeSecurityGroupEgressParams, opts ...OptionFunc) (*AuthorizeSecurityGroupEgressResponse, error) {
+	return s.AuthorizeSecurityGroupEgressWithContext(context.Background(), p, opts...)
+}
+
+// AuthorizeSecurityGroupEgressWithContext is the same as AuthorizeSecurityGroupEgress, but the request is cancelled when the context is done
+func (s *SecurityGroupService) AuthorizeSecurityGroupEgressWithContext(ctx context.Context, p *AuthorizeSecurityGroupEgressParams, opts ...OptionFunc) (*AuthorizeSecurityGroupEgressResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	return s.AuthorizeSecurityGroupEgressRawWithContext(ctx, p.toURLValues())
+}
+
+// AuthorizeSecurityGroupEgressRaw is the same as AuthorizeSecurityGroupEgress, but takes the params as url.Values instead of typed params
+func (s *SecurityGroupService) AuthorizeSecurityGroupEgressRaw(v url.Values) (*AuthorizeSecurityGroupEgressResponse, error) {
+	return s.AuthorizeSecurityGroupEgressRawWithContext(context.Background(), v)
+}
+
+// AuthorizeSecurityGroupEgressRawWithContext is the same as AuthorizeSecurityGroupEgressRaw, but the request is cancelled when the context is done
+func (s *SecurityGroupService) AuthorizeSecurityGroupEgressRawWithContext(ctx context.Context, v url.Values) (*AuthorizeSecurityGroupEgressResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "authorizeSecurityGroupEgress", "authorizesecuritygroupegressresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -232,9 +494,9 @@ func (s *SecurityGroupService) AuthorizeSecurityGroupEgress(p *AuthorizeSecurity
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -287,6 +549,16 @@ type AuthorizeSecurityGroupIngressParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *AuthorizeSecurityGroupIngressParams) Clone() *AuthorizeSecurityGroupIngressParams {
+	c := &AuthorizeSecurityGroupIngressParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *AuthorizeSecurityGroupIngressParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -331,11 +603,10 @@ func (p *AuthorizeSecurityGroupIngressParams) toURLValues() url.Values {
 		u.Set("startport", vv)
 	}
 	if v, found := p.p["usersecuritygrouplist"]; found {
-		i := 0
-		for k, vv := range v.(map[string]string) {
+		m := v.(map[string]string)
+		for i, k := range getSortedKeysFromMap(m) {
 			u.Set(fmt.Sprintf("usersecuritygrouplist[%d].account", i), k)
-			u.Set(fmt.Sprintf("usersecuritygrouplist[%d].group", i), vv)
-			i++
+			u.Set(fmt.Sprintf("usersecuritygrouplist[%d].group", i), m[k])
 		}
 	}
 	return u
@@ -349,6 +620,13 @@ func (p *AuthorizeSecurityGroupIngressParams) SetAccount(v string) {
 	return
 }
 
+// SetAccountIfUnset sets account to v, unless it is already set
+func (p *AuthorizeSecurityGroupIngressParams) SetAccountIfUnset(v string) {
+	if _, found := p.p["account"]; !found {
+		p.SetAccount(v)
+	}
+}
+
 func (p *AuthorizeSecurityGroupIngressParams) SetCidrlist(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -357,6 +635,18 @@ func (p *AuthorizeSecurityGroupIngressParams) SetCidrlist(v []string) {
 	return
 }
 
+// SetCidrlistIfUnset sets cidrlist to v, unless it is already set
+func (p *AuthorizeSecurityGroupIngressParams) SetCidrlistIfUnset(v []string) {
+	if _, found := p.p["cidrlist"]; !found {
+		p.SetCidrlist(v)
+	}
+}
+
+// SetCidrlistCSV splits v on commas and sets the resulting values
+func (p *AuthorizeSecurityGroupIngressParams) SetCidrlistCSV(v string) {
+	p.SetCidrlist(splitCSV(v))
+}
+
 func (p *AuthorizeSecurityGroupIngressParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -365,6 +655,13 @@ func (p *AuthorizeSecurityGroupIngressParams) SetDomainid(v string) {
 	return
 }
 
+// SetDomainidIfUnset sets domainid to v, unless it is already set
+func (p *AuthorizeSecurityGroupIngressParams) SetDomainidIfUnset(v string) {
+	if _, found := p.p["domainid"]; !found {
+		p.SetDomainid(v)
+	}
+}
+
 func (p *AuthorizeSecurityGroupIngressParams) SetEndport(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -373,6 +670,13 @@ func (p *AuthorizeSecurityGroupIngressParams) SetEndport(v int) {
 	return
 }
 
+// SetEndportIfUnset sets endport to v, unless it is already set
+func (p *AuthorizeSecurityGroupIngressParams) SetEndportIfUnset(v int) {
+	if _, found := p.p["endport"]; !found {
+		p.SetEndport(v)
+	}
+}
+
 func (p *AuthorizeSecurityGroupIngressParams) SetIcmpcode(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -381,6 +685,13 @@ func (p *AuthorizeSecurityGroupIngressParams) SetIcmpcode(v int) {
 	return
 }
 
+// SetIcmpcodeIfUnset sets icmpcode to v, unless it is already set
+func (p *AuthorizeSecurityGroupIngressParams) SetIcmpcodeIfUnset(v int) {
+	if _, found := p.p["icmpcode"]; !found {
+		p.SetIcmpcode(v)
+	}
+}
+
 func (p *AuthorizeSecurityGroupIngressParams) SetIcmptype(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -389,6 +700,13 @@ func (p *AuthorizeSecurityGroupIngressParams) SetIcmptype(v int) {
 	return
 }
 
+// SetIcmptypeIfUnset sets icmptype to v, unless it is already set
+func (p *AuthorizeSecurityGroupIngressParams) SetIcmptypeIfUnset(v int) {
+	if _, found := p.p["icmptype"]; !found {
+		p.SetIcmptype(v)
+	}
+}
+
 func (p *AuthorizeSecurityGroupIngressParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -397,14 +715,28 @@ func (p *AuthorizeSecurityGroupIngressParams) SetProjectid(v string) {
 	return
 }
 
-func (p *AuthorizeSecurityGroupIngressParams) SetProtocol(v string) {
+// SetProjectidIfUnset sets projectid to v, unless it is already set
+func (p *AuthorizeSecurityGroupIngressParams) SetProjectidIfUnset(v string) {
+	if _, found := p.p["projectid"]; !found {
+		p.SetProjectid(v)
+	}
+}
+
+func (p *AuthorizeSecurityGroupIngressParams) SetProtocol(v Protocol) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	p.p["protocol"] = v
+	p.p["protocol"] = string(v)
 	return
 }
 
+// SetProtocolIfUnset sets protocol to v, unless it is already set
+func (p *AuthorizeSecurityGroupIngressParams) SetProtocolIfUnset(v Protocol) {
+	if _, found := p.p["protocol"]; !found {
+		p.SetProtocol(v)
+	}
+}
+
 func (p *AuthorizeSecurityGroupIngressParams) SetSecuritygroupid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -413,6 +745,13 @@ func (p *AuthorizeSecurityGroupIngressParams) SetSecuritygroupid(v string) {
 	return
 }
 
+// SetSecuritygroupidIfUnset sets securitygroupid to v, unless it is already set
+func (p *AuthorizeSecurityGroupIngressParams) SetSecuritygroupidIfUnset(v string) {
+	if _, found := p.p["securitygroupid"]; !found {
+		p.SetSecuritygroupid(v)
+	}
+}
+
 func (p *AuthorizeSecurityGroupIngressParams) SetSecuritygroupname(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -421,6 +760,13 @@ func (p *AuthorizeSecurityGroupIngressParams) SetSecuritygroupname(v string) {
 	return
 }
 
+// SetSecuritygroupnameIfUnset sets securitygroupname to v, unless it is already set
+func (p *AuthorizeSecurityGroupIngressParams) SetSecuritygroupnameIfUnset(v string) {
+	if _, found := p.p["securitygroupname"]; !found {
+		p.SetSecuritygroupname(v)
+	}
+}
+
 func (p *AuthorizeSecurityGroupIngressParams) SetStartport(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -429,6 +775,13 @@ func (p *AuthorizeSecurityGroupIngressParams) SetStartport(v int) {
 	return
 }
 
+// SetStartportIfUnset sets startport to v, unless it is already set
+func (p *AuthorizeSecurityGroupIngressParams) SetStartportIfUnset(v int) {
+	if _, found := p.p["startport"]; !found {
+		p.SetStartport(v)
+	}
+}
+
 func (p *AuthorizeSecurityGroupIngressParams) SetUsersecuritygrouplist(v map[string]string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -437,17 +790,49 @@ func (p *AuthorizeSecurityGroupIngressParams) SetUsersecuritygrouplist(v map[str
 	return
 }
 
+// SetUsersecuritygrouplistIfUnset sets usersecuritygrouplist to v, unless it is already set
+func (p *AuthorizeSecurityGroupIngressParams) SetUsersecuritygrouplistIfUnset(v map[string]string) {
+	if _, found := p.p["usersecuritygrouplist"]; !found {
+		p.SetUsersecuritygrouplist(v)
+	}
+}
+
 // You should always use this function to get a new AuthorizeSecurityGroupIngressParams instance,
-// as then you are sure you have configured all required params
-func (s *SecurityGroupService) NewAuthorizeSecurityGroupIngressParams() *AuthorizeSecurityGroupIngressParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *SecurityGroupService) NewAuthorizeSecurityGroupIngressParams(opts ...func(*AuthorizeSecurityGroupIngressParams)) *AuthorizeSecurityGroupIngressParams {
 	p := &AuthorizeSecurityGroupIngressParams{}
 	p.p = make(map[string]interface{})
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Authorizes a particular ingress rule for this security group
-func (s *SecurityGroupService) AuthorizeSecurityGroupIngress(p *AuthorizeSecurityGroupIngressParams) (*AuthorizeSecurityGroupIngressResponse, error) {
-	resp, err := s.cs.newRequest("authorizeSecurityGroupIngress", p.toURLValues())
+func (s *SecurityGroupService) AuthorizeSecurityGroupIngress(p *AuthorizeSecurityGroupIngressParams, opts ...OptionFunc) (*AuthorizeSecurityGroupIngressResponse, error) {
+	return s.AuthorizeSecurityGroupIngressWithContext(context.Background(), p, opts...)
+}
+
+// AuthorizeSecurityGroupIngressWithContext is the same as AuthorizeSecurityGroupIngress, but the request is cancelled when the context is done
+func (s *SecurityGroupService) AuthorizeSecurityGroupIngressWithContext(ctx context.Context, p *AuthorizeSecurityGroupIngressParams, opts ...OptionFunc) (*AuthorizeSecurityGroupIngressResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	return s.AuthorizeSecurityGroupIngressRawWithContext(ctx, p.toURLValues())
+}
+
+// AuthorizeSecurityGroupIngressRaw is the same as AuthorizeSecurityGroupIngress, but takes the params as url.Values instead of typed params
+func (s *SecurityGroupService) AuthorizeSecurityGroupIngressRaw(v url.Values) (*AuthorizeSecurityGroupIngressResponse, error) {
+	return s.AuthorizeSecurityGroupIngressRawWithContext(context.Background(), v)
+}
+
+// AuthorizeSecurityGroupIngressRawWithContext is the same as AuthorizeSecurityGroupIngressRaw, but the request is cancelled when the context is done
+func (s *SecurityGroupService) AuthorizeSecurityGroupIngressRawWithContext(ctx context.Context, v url.Values) (*AuthorizeSecurityGroupIngressResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "authorizeSecurityGroupIngress", "authorizesecuritygroupingressresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -459,9 +844,9 @@ func (s *SecurityGroupService) AuthorizeSecurityGroupIngress(p *AuthorizeSecurit
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -514,6 +899,16 @@ type CreateSecurityGroupParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *CreateSecurityGroupParams) Clone() *CreateSecurityGroupParams {
+	c := &CreateSecurityGroupParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *CreateSecurityGroupParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -537,6 +932,16 @@ func (p *CreateSecurityGroupParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *CreateSecurityGroupParams) validate() error {
+	for _, k := range []string{"name"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of createSecurityGroup", k)
+		}
+	}
+	return nil
+}
+
 func (p *CreateSecurityGroupParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -545,6 +950,13 @@ func (p *CreateSecurityGroupParams) SetAccount(v string) {
 	return
 }
 
+// SetAccountIfUnset sets account to v, unless it is already set
+func (p *CreateSecurityGroupParams) SetAccountIfUnset(v string) {
+	if _, found := p.p["account"]; !found {
+		p.SetAccount(v)
+	}
+}
+
 func (p *CreateSecurityGroupParams) SetDescription(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -553,6 +965,13 @@ func (p *CreateSecurityGroupParams) SetDescription(v string) {
 	return
 }
 
+// SetDescriptionIfUnset sets description to v, unless it is already set
+func (p *CreateSecurityGroupParams) SetDescriptionIfUnset(v string) {
+	if _, found := p.p["description"]; !found {
+		p.SetDescription(v)
+	}
+}
+
 func (p *CreateSecurityGroupParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -561,6 +980,13 @@ func (p *CreateSecurityGroupParams) SetDomainid(v string) {
 	return
 }
 
+// SetDomainidIfUnset sets domainid to v, unless it is already set
+func (p *CreateSecurityGroupParams) SetDomainidIfUnset(v string) {
+	if _, found := p.p["domainid"]; !found {
+		p.SetDomainid(v)
+	}
+}
+
 func (p *CreateSecurityGroupParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -569,6 +995,13 @@ func (p *CreateSecurityGroupParams) SetName(v string) {
 	return
 }
 
+// SetNameIfUnset sets name to v, unless it is already set
+func (p *CreateSecurityGroupParams) SetNameIfUnset(v string) {
+	if _, found := p.p["name"]; !found {
+		p.SetName(v)
+	}
+}
+
 func (p *CreateSecurityGroupParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -577,23 +1010,59 @@ func (p *CreateSecurityGroupParams) SetProjectid(v string) {
 	return
 }
 
+// SetProjectidIfUnset sets projectid to v, unless it is already set
+func (p *CreateSecurityGroupParams) SetProjectidIfUnset(v string) {
+	if _, found := p.p["projectid"]; !found {
+		p.SetProjectid(v)
+	}
+}
+
 // You should always use this function to get a new CreateSecurityGroupParams instance,
-// as then you are sure you have configured all required params
-func (s *SecurityGroupService) NewCreateSecurityGroupParams(name string) *CreateSecurityGroupParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *SecurityGroupService) NewCreateSecurityGroupParams(name string, opts ...func(*CreateSecurityGroupParams)) *CreateSecurityGroupParams {
 	p := &CreateSecurityGroupParams{}
 	p.p = make(map[string]interface{})
 	p.p["name"] = name
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Creates a security group
-func (s *SecurityGroupService) CreateSecurityGroup(p *CreateSecurityGroupParams) (*CreateSecurityGroupResponse, error) {
-	resp, err := s.cs.newRequest("createSecurityGroup", p.toURLValues())
+func (s *SecurityGroupService) CreateSecurityGroup(p *CreateSecurityGroupParams, opts ...OptionFunc) (*CreateSecurityGroupResponse, error) {
+	return s.CreateSecurityGroupWithContext(context.Background(), p, opts...)
+}
+
+// CreateSecurityGroupWithContext is the same as CreateSecurityGroup, but the request is cancelled when the context is done
+func (s *SecurityGroupService) CreateSecurityGroupWithContext(ctx context.Context, p *CreateSecurityGroupParams, opts ...OptionFunc) (*CreateSecurityGroupResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.CreateSecurityGroupRawWithContext(ctx, p.toURLValues())
+}
+
+// CreateSecurityGroupRaw is the same as CreateSecurityGroup, but takes the params as url.Values instead of typed params
+func (s *SecurityGroupService) CreateSecurityGroupRaw(v url.Values) (*CreateSecurityGroupResponse, error) {
+	return s.CreateSecurityGroupRawWithContext(context.Background(), v)
+}
+
+// CreateSecurityGroupRawWithContext is the same as CreateSecurityGroupRaw, but the request is cancelled when the context is done
+func (s *SecurityGroupService) CreateSecurityGroupRawWithContext(ctx context.Context, v url.Values) (*CreateSecurityGroupResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "createSecurityGroup", "createsecuritygroupresponse", v)
 	if err != nil {
 		return nil, err
 	}
 
-	if resp, err = getRawValue(resp); err != nil {
+	if resp, err = getRawValueByKey(resp, "securitygroup"); err != nil {
 		return nil, err
 	}
 
@@ -680,6 +1149,16 @@ type DeleteSecurityGroupParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *DeleteSecurityGroupParams) Clone() *DeleteSecurityGroupParams {
+	c := &DeleteSecurityGroupParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeleteSecurityGroupParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -711,6 +1190,13 @@ func (p *DeleteSecurityGroupParams) SetAccount(v string) {
 	return
 }
 
+// SetAccountIfUnset sets account to v, unless it is already set
+func (p *DeleteSecurityGroupParams) SetAccountIfUnset(v string) {
+	if _, found := p.p["account"]; !found {
+		p.SetAccount(v)
+	}
+}
+
 func (p *DeleteSecurityGroupParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -719,6 +1205,13 @@ func (p *DeleteSecurityGroupParams) SetDomainid(v string) {
 	return
 }
 
+// SetDomainidIfUnset sets domainid to v, unless it is already set
+func (p *DeleteSecurityGroupParams) SetDomainidIfUnset(v string) {
+	if _, found := p.p["domainid"]; !found {
+		p.SetDomainid(v)
+	}
+}
+
 func (p *DeleteSecurityGroupParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -727,6 +1220,13 @@ func (p *DeleteSecurityGroupParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *DeleteSecurityGroupParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 func (p *DeleteSecurityGroupParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -735,6 +1235,13 @@ func (p *DeleteSecurityGroupParams) SetName(v string) {
 	return
 }
 
+// SetNameIfUnset sets name to v, unless it is already set
+func (p *DeleteSecurityGroupParams) SetNameIfUnset(v string) {
+	if _, found := p.p["name"]; !found {
+		p.SetName(v)
+	}
+}
+
 func (p *DeleteSecurityGroupParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -743,17 +1250,49 @@ func (p *DeleteSecurityGroupParams) SetProjectid(v string) {
 	return
 }
 
+// SetProjectidIfUnset sets projectid to v, unless it is already set
+func (p *DeleteSecurityGroupParams) SetProjectidIfUnset(v string) {
+	if _, found := p.p["projectid"]; !found {
+		p.SetProjectid(v)
+	}
+}
+
 // You should always use this function to get a new DeleteSecurityGroupParams instance,
-// as then you are sure you have configured all required params
-func (s *SecurityGroupService) NewDeleteSecurityGroupParams() *DeleteSecurityGroupParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *SecurityGroupService) NewDeleteSecurityGroupParams(opts ...func(*DeleteSecurityGroupParams)) *DeleteSecurityGroupParams {
 	p := &DeleteSecurityGroupParams{}
 	p.p = make(map[string]interface{})
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Deletes security group
-func (s *SecurityGroupService) DeleteSecurityGroup(p *DeleteSecurityGroupParams) (*DeleteSecurityGroupResponse, error) {
-	resp, err := s.cs.newRequest("deleteSecurityGroup", p.toURLValues())
+func (s *SecurityGroupService) DeleteSecurityGroup(p *DeleteSecurityGroupParams, opts ...OptionFunc) (*DeleteSecurityGroupResponse, error) {
+	return s.DeleteSecurityGroupWithContext(context.Background(), p, opts...)
+}
+
+// DeleteSecurityGroupWithContext is the same as DeleteSecurityGroup, but the request is cancelled when the context is done
+func (s *SecurityGroupService) DeleteSecurityGroupWithContext(ctx context.Context, p *DeleteSecurityGroupParams, opts ...OptionFunc) (*DeleteSecurityGroupResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	return s.DeleteSecurityGroupRawWithContext(ctx, p.toURLValues())
+}
+
+// DeleteSecurityGroupRaw is the same as DeleteSecurityGroup, but takes the params as url.Values instead of typed params
+func (s *SecurityGroupService) DeleteSecurityGroupRaw(v url.Values) (*DeleteSecurityGroupResponse, error) {
+	return s.DeleteSecurityGroupRawWithContext(context.Background(), v)
+}
+
+// DeleteSecurityGroupRawWithContext is the same as DeleteSecurityGroupRaw, but the request is cancelled when the context is done
+func (s *SecurityGroupService) DeleteSecurityGroupRawWithContext(ctx context.Context, v url.Values) (*DeleteSecurityGroupResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteSecurityGroup", "deletesecuritygroupresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -766,6 +1305,34 @@ func (s *SecurityGroupService) DeleteSecurityGroup(p *DeleteSecurityGroupParams)
 	return &r, nil
 }
 
+// WaitForSecurityGroupDeleted polls until the SecurityGroup with the given ID no longer exists, the timeout
+// expires or the context is cancelled. Deleted resources may still be listed for a short while
+// after the delete command finished, so this can be used to make sure they are really gone. The
+// options are passed to GetSecurityGroupByID, so a resource outside of the default scope can be found.
+func (s *SecurityGroupService) WaitForSecurityGroupDeleted(ctx context.Context, id string, timeout time.Duration, opts ...OptionFunc) error {
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	for {
+		_, count, err := s.GetSecurityGroupByID(id, opts...)
+		if count == 0 {
+			return nil
+		}
+		if count < 0 {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(2 * time.Second):
+		}
+	}
+}
+
 type DeleteSecurityGroupResponse struct {
 	Displaytext string `json:"displaytext"`
 	Success     bool   `json:"success"`
@@ -794,6 +1361,16 @@ type ListSecurityGroupsParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ListSecurityGroupsParams) Clone() *ListSecurityGroupsParams {
+	c := &ListSecurityGroupsParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListSecurityGroupsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -834,11 +1411,10 @@ func (p *ListSecurityGroupsParams) toURLValues() url.Values {
 		u.Set("securitygroupname", v.(string))
 	}
 	if v, found := p.p["tags"]; found {
-		i := 0
-		for k, vv := range v.(map[string]string) {
+		m := v.(map[string]string)
+		for i, k := range getSortedKeysFromMap(m) {
 			u.Set(fmt.Sprintf("tags[%d].key", i), k)
-			u.Set(fmt.Sprintf("tags[%d].value", i), vv)
-			i++
+			u.Set(fmt.Sprintf("tags[%d].value", i), m[k])
 		}
 	}
 	if v, found := p.p["virtualmachineid"]; found {
@@ -855,6 +1431,13 @@ func (p *ListSecurityGroupsParams) SetAccount(v string) {
 	return
 }
 
+// SetAccountIfUnset sets account to v, unless it is already set
+func (p *ListSecurityGroupsParams) SetAccountIfUnset(v string) {
+	if _, found := p.p["account"]; !found {
+		p.SetAccount(v)
+	}
+}
+
 func (p *ListSecurityGroupsParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -863,6 +1446,13 @@ func (p *ListSecurityGroupsParams) SetDomainid(v string) {
 	return
 }
 
+// SetDomainidIfUnset sets domainid to v, unless it is already set
+func (p *ListSecurityGroupsParams) SetDomainidIfUnset(v string) {
+	if _, found := p.p["domainid"]; !found {
+		p.SetDomainid(v)
+	}
+}
+
 func (p *ListSecurityGroupsParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -871,6 +1461,13 @@ func (p *ListSecurityGroupsParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *ListSecurityGroupsParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 func (p *ListSecurityGroupsParams) SetIsrecursive(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -879,6 +1476,13 @@ func (p *ListSecurityGroupsParams) SetIsrecursive(v bool) {
 	return
 }
 
+// SetIsrecursiveIfUnset sets isrecursive to v, unless it is already set
+func (p *ListSecurityGroupsParams) SetIsrecursiveIfUnset(v bool) {
+	if _, found := p.p["isrecursive"]; !found {
+		p.SetIsrecursive(v)
+	}
+}
+
 func (p *ListSecurityGroupsParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -887,6 +1491,13 @@ func (p *ListSecurityGroupsParams) SetKeyword(v string) {
 	return
 }
 
+// SetKeywordIfUnset sets keyword to v, unless it is already set
+func (p *ListSecurityGroupsParams) SetKeywordIfUnset(v string) {
+	if _, found := p.p["keyword"]; !found {
+		p.SetKeyword(v)
+	}
+}
+
 func (p *ListSecurityGroupsParams) SetListall(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -895,6 +1506,13 @@ func (p *ListSecurityGroupsParams) SetListall(v bool) {
 	return
 }
 
+// SetListallIfUnset sets listall to v, unless it is already set
+func (p *ListSecurityGroupsParams) SetListallIfUnset(v bool) {
+	if _, found := p.p["listall"]; !found {
+		p.SetListall(v)
+	}
+}
+
 func (p *ListSecurityGroupsParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -903,6 +1521,13 @@ func (p *ListSecurityGroupsParams) SetPage(v int) {
 	return
 }
 
+// SetPageIfUnset sets page to v, unless it is already set
+func (p *ListSecurityGroupsParams) SetPageIfUnset(v int) {
+	if _, found := p.p["page"]; !found {
+		p.SetPage(v)
+	}
+}
+
 func (p *ListSecurityGroupsParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -911,6 +1536,13 @@ func (p *ListSecurityGroupsParams) SetPagesize(v int) {
 	return
 }
 
+// SetPagesizeIfUnset sets pagesize to v, unless it is already set
+func (p *ListSecurityGroupsParams) SetPagesizeIfUnset(v int) {
+	if _, found := p.p["pagesize"]; !found {
+		p.SetPagesize(v)
+	}
+}
+
 func (p *ListSecurityGroupsParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -919,6 +1551,13 @@ func (p *ListSecurityGroupsParams) SetProjectid(v string) {
 	return
 }
 
+// SetProjectidIfUnset sets projectid to v, unless it is already set
+func (p *ListSecurityGroupsParams) SetProjectidIfUnset(v string) {
+	if _, found := p.p["projectid"]; !found {
+		p.SetProjectid(v)
+	}
+}
+
 func (p *ListSecurityGroupsParams) SetSecuritygroupname(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -927,6 +1566,13 @@ func (p *ListSecurityGroupsParams) SetSecuritygroupname(v string) {
 	return
 }
 
+// SetSecuritygroupnameIfUnset sets securitygroupname to v, unless it is already set
+func (p *ListSecurityGroupsParams) SetSecuritygroupnameIfUnset(v string) {
+	if _, found := p.p["securitygroupname"]; !found {
+		p.SetSecuritygroupname(v)
+	}
+}
+
 func (p *ListSecurityGroupsParams) SetTags(v map[string]string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -935,6 +1581,13 @@ func (p *ListSecurityGroupsParams) SetTags(v map[string]string) {
 	return
 }
 
+// SetTagsIfUnset sets tags to v, unless it is already set
+func (p *ListSecurityGroupsParams) SetTagsIfUnset(v map[string]string) {
+	if _, found := p.p["tags"]; !found {
+		p.SetTags(v)
+	}
+}
+
 func (p *ListSecurityGroupsParams) SetVirtualmachineid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -943,14 +1596,34 @@ func (p *ListSecurityGroupsParams) SetVirtualmachineid(v string) {
 	return
 }
 
+// SetVirtualmachineidIfUnset sets virtualmachineid to v, unless it is already set
+func (p *ListSecurityGroupsParams) SetVirtualmachineidIfUnset(v string) {
+	if _, found := p.p["virtualmachineid"]; !found {
+		p.SetVirtualmachineid(v)
+	}
+}
+
+var _ ListAllSetter = (*ListSecurityGroupsParams)(nil)
+
 // You should always use this function to get a new ListSecurityGroupsParams instance,
-// as then you are sure you have configured all required params
-func (s *SecurityGroupService) NewListSecurityGroupsParams() *ListSecurityGroupsParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *SecurityGroupService) NewListSecurityGroupsParams(opts ...func(*ListSecurityGroupsParams)) *ListSecurityGroupsParams {
 	p := &ListSecurityGroupsParams{}
 	p.p = make(map[string]interface{})
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
+func (p *ListSecurityGroupsParams) setFirstMatch() {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p[firstMatchKey] = true
+}
+
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *SecurityGroupService) GetSecurityGroupID(keyword string, opts ...OptionFunc) (string, int, error) {
 	p := &ListSecurityGroupsParams{}
@@ -958,7 +1631,7 @@ func (s *SecurityGroupService) GetSecurityGroupID(keyword string, opts ...Option
 
 	p.p["keyword"] = keyword
 
-	for _, fn := range append(s.cs.options, opts...) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
 		if err := fn(s.cs, p); err != nil {
 			return "", -1, err
 		}
@@ -970,7 +1643,7 @@ func (s *SecurityGroupService) GetSecurityGroupID(keyword string, opts ...Option
 	}
 
 	if l.Count == 0 {
-		return "", l.Count, fmt.Errorf("No match found for %s: %+v", keyword, l)
+		return "", l.Count, fmt.Errorf("%w for %s: %+v", ErrNotFound, keyword, l)
 	}
 
 	if l.Count == 1 {
@@ -983,6 +1656,10 @@ func (s *SecurityGroupService) GetSecurityGroupID(keyword string, opts ...Option
 				return v.Id, l.Count, nil
 			}
 		}
+
+		if _, ok := p.p[firstMatchKey]; ok && len(l.SecurityGroups) > 0 {
+			return l.SecurityGroups[0].Id, l.Count, nil
+		}
 	}
 	return "", l.Count, fmt.Errorf("Could not find an exact match for %s: %+v", keyword, l)
 }
@@ -1008,7 +1685,7 @@ func (s *SecurityGroupService) GetSecurityGroupByID(id string, opts ...OptionFun
 
 	p.p["id"] = id
 
-	for _, fn := range append(s.cs.options, opts...) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
 		if err := fn(s.cs, p); err != nil {
 			return nil, -1, err
 		}
@@ -1019,24 +1696,56 @@ func (s *SecurityGroupService) GetSecurityGroupByID(id string, opts ...OptionFun
 		if strings.Contains(err.Error(), fmt.Sprintf(
 			"Invalid parameter id value=%s due to incorrect long value format, "+
 				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+			return nil, 0, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
 	}
 
 	if l.Count == 1 {
 		return l.SecurityGroups[0], l.Count, nil
 	}
+
+	if _, ok := p.p[firstMatchKey]; ok && len(l.SecurityGroups) > 0 {
+		return l.SecurityGroups[0], l.Count, nil
+	}
 	return nil, l.Count, fmt.Errorf("There is more then one result for SecurityGroup UUID: %s!", id)
 }
 
+// SecurityGroupExists returns true if a SecurityGroup with the given ID exists. When no match is found
+// it returns false, while any other error is returned as is.
+func (s *SecurityGroupService) SecurityGroupExists(id string, opts ...OptionFunc) (bool, error) {
+	_, count, err := s.GetSecurityGroupByID(id, opts...)
+	if errors.Is(err, ErrNotFound) {
+		return false, nil
+	}
+	if count > 0 {
+		return true, nil
+	}
+	return false, err
+}
+
 // Lists security groups
 func (s *SecurityGroupService) ListSecurityGroups(p *ListSecurityGroupsParams) (*ListSecurityGroupsResponse, error) {
-	resp, err := s.cs.newRequest("listSecurityGroups", p.toURLValues())
+	return s.ListSecurityGroupsWithContext(context.Background(), p)
+}
+
+// ListSecurityGroupsWithContext is the same as ListSecurityGroups, but the request is cancelled when the context is done
+func (s *SecurityGroupService) ListSecurityGroupsWithContext(ctx context.Context, p *ListSecurityGroupsParams) (*ListSecurityGroupsResponse, error) {
+	return s.ListSecurityGroupsRawWithContext(ctx, p.toURLValues())
+}
+
+// ListSecurityGroupsRaw is the same as ListSecurityGroups, but takes the params as url.Values instead of typed params
+func (s *SecurityGroupService) ListSecurityGroupsRaw(v url.Values) (*ListSecurityGroupsResponse, error) {
+	return s.ListSecurityGroupsRawWithContext(context.Background(), v)
+}
+
+// ListSecurityGroupsRawWithContext is the same as ListSecurityGroupsRaw, but the request is cancelled when the context is done
+func (s *SecurityGroupService) ListSecurityGroupsRawWithContext(ctx context.Context, v url.Values) (*ListSecurityGroupsResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "listSecurityGroups", "listsecuritygroupsresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -1049,6 +1758,93 @@ func (s *SecurityGroupService) ListSecurityGroups(p *ListSecurityGroupsParams) (
 	return &r, nil
 }
 
+// StreamSecurityGroups fetches the results of ListSecurityGroups page by page in the background and sends them on the
+// returned channel. Both returned channels are closed when done. If fetching a page fails, or the context
+// is done before all results are sent, the error is sent on the error channel and fetching stops. A
+// request for a page in flight is cancelled when the context is done. The page and pagesize set in the
+// params are ignored.
+func (s *SecurityGroupService) StreamSecurityGroups(ctx context.Context, p *ListSecurityGroupsParams) (<-chan *SecurityGroup, <-chan error) {
+	ch := make(chan *SecurityGroup)
+	errs := make(chan error, 1)
+
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesize(500)
+
+	go func() {
+		defer close(errs)
+		defer close(ch)
+
+		for page, count := 1, 0; ; page++ {
+			pp.SetPage(page)
+
+			l, err := s.ListSecurityGroupsWithContext(ctx, pp)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			for _, v := range l.SecurityGroups {
+				select {
+				case ch <- v:
+				case <-ctx.Done():
+					errs <- ctx.Err()
+					return
+				}
+			}
+
+			count += len(l.SecurityGroups)
+			if len(l.SecurityGroups) < 500 || count >= l.Count {
+				return
+			}
+		}
+	}()
+
+	return ch, errs
+}
+
+// ListSecurityGroupsWhere returns all results of ListSecurityGroups for which pred returns true. Note that the results are
+// filtered client-side, so all results matching the params are fetched first.
+// All pages are fetched, so the page and pagesize set in the params are ignored.
+func (s *SecurityGroupService) ListSecurityGroupsWhere(p *ListSecurityGroupsParams, pred func(*SecurityGroup) bool) ([]*SecurityGroup, error) {
+	var r []*SecurityGroup
+	ch, errs := s.StreamSecurityGroups(context.Background(), p)
+	for v := range ch {
+		if pred(v) {
+			r = append(r, v)
+		}
+	}
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ListAllSecurityGroups returns the results of ListSecurityGroups across all pages, by calling ListSecurityGroups page by page until all
+// results are fetched. The pagesize set in the params is used for every page (500 if not set), and the
+// page set in the params is ignored.
+func (s *SecurityGroupService) ListAllSecurityGroups(p *ListSecurityGroupsParams) ([]*SecurityGroup, error) {
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesizeIfUnset(500)
+	pagesize := pp.p["pagesize"].(int)
+
+	var r []*SecurityGroup
+	for page := 1; ; page++ {
+		pp.SetPage(page)
+
+		l, err := s.ListSecurityGroups(pp)
+		if err != nil {
+			return nil, err
+		}
+		r = append(r, l.SecurityGroups...)
+
+		if len(l.SecurityGroups) < pagesize || len(r) >= l.Count {
+			return r, nil
+		}
+	}
+}
+
 type ListSecurityGroupsResponse struct {
 	Count          int              `json:"count"`
 	SecurityGroups []*SecurityGroup `json:"securitygroup"`
@@ -1129,6 +1925,16 @@ type RevokeSecurityGroupEgressParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *RevokeSecurityGroupEgressParams) Clone() *RevokeSecurityGroupEgressParams {
+	c := &RevokeSecurityGroupEgressParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *RevokeSecurityGroupEgressParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1140,6 +1946,16 @@ func (p *RevokeSecurityGroupEgressParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *RevokeSecurityGroupEgressParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of revokeSecurityGroupEgress", k)
+		}
+	}
+	return nil
+}
+
 func (p *RevokeSecurityGroupEgressParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1148,18 +1964,54 @@ func (p *RevokeSecurityGroupEgressParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *RevokeSecurityGroupEgressParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 // You should always use this function to get a new RevokeSecurityGroupEgressParams instance,
-// as then you are sure you have configured all required params
-func (s *SecurityGroupService) NewRevokeSecurityGroupEgressParams(id string) *RevokeSecurityGroupEgressParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *SecurityGroupService) NewRevokeSecurityGroupEgressParams(id string, opts ...func(*RevokeSecurityGroupEgressParams)) *RevokeSecurityGroupEgressParams {
 	p := &RevokeSecurityGroupEgressParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Deletes a particular egress rule from this security group
-func (s *SecurityGroupService) RevokeSecurityGroupEgress(p *RevokeSecurityGroupEgressParams) (*RevokeSecurityGroupEgressResponse, error) {
-	resp, err := s.cs.newRequest("revokeSecurityGroupEgress", p.toURLValues())
+func (s *SecurityGroupService) RevokeSecurityGroupEgress(p *RevokeSecurityGroupEgressParams, opts ...OptionFunc) (*RevokeSecurityGroupEgressResponse, error) {
+	return s.RevokeSecurityGroupEgressWithContext(context.Background(), p, opts...)
+}
+
+// RevokeSecurityGroupEgressWithContext is the same as RevokeSecurityGroupEgress, but the request is cancelled when the context is done
+func (s *SecurityGroupService) RevokeSecurityGroupEgressWithContext(ctx context.Context, p *RevokeSecurityGroupEgressParams, opts ...OptionFunc) (*RevokeSecurityGroupEgressResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.RevokeSecurityGroupEgressRawWithContext(ctx, p.toURLValues())
+}
+
+// RevokeSecurityGroupEgressRaw is the same as RevokeSecurityGroupEgress, but takes the params as url.Values instead of typed params
+func (s *SecurityGroupService) RevokeSecurityGroupEgressRaw(v url.Values) (*RevokeSecurityGroupEgressResponse, error) {
+	return s.RevokeSecurityGroupEgressRawWithContext(context.Background(), v)
+}
+
+// RevokeSecurityGroupEgressRawWithContext is the same as RevokeSecurityGroupEgressRaw, but the request is cancelled when the context is done
+func (s *SecurityGroupService) RevokeSecurityGroupEgressRawWithContext(ctx context.Context, v url.Values) (*RevokeSecurityGroupEgressResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "revokeSecurityGroupEgress", "revokesecuritygroupegressresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -1171,9 +2023,9 @@ func (s *SecurityGroupService) RevokeSecurityGroupEgress(p *RevokeSecurityGroupE
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -1197,6 +2049,16 @@ type RevokeSecurityGroupIngressParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *RevokeSecurityGroupIngressParams) Clone() *RevokeSecurityGroupIngressParams {
+	c := &RevokeSecurityGroupIngressParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *RevokeSecurityGroupIngressParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1208,6 +2070,16 @@ func (p *RevokeSecurityGroupIngressParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *RevokeSecurityGroupIngressParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of revokeSecurityGroupIngress", k)
+		}
+	}
+	return nil
+}
+
 func (p *RevokeSecurityGroupIngressParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1216,18 +2088,54 @@ func (p *RevokeSecurityGroupIngressParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *RevokeSecurityGroupIngressParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 // You should always use this function to get a new RevokeSecurityGroupIngressParams instance,
-// as then you are sure you have configured all required params
-func (s *SecurityGroupService) NewRevokeSecurityGroupIngressParams(id string) *RevokeSecurityGroupIngressParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *SecurityGroupService) NewRevokeSecurityGroupIngressParams(id string, opts ...func(*RevokeSecurityGroupIngressParams)) *RevokeSecurityGroupIngressParams {
 	p := &RevokeSecurityGroupIngressParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Deletes a particular ingress rule from this security group
-func (s *SecurityGroupService) RevokeSecurityGroupIngress(p *RevokeSecurityGroupIngressParams) (*RevokeSecurityGroupIngressResponse, error) {
-	resp, err := s.cs.newRequest("revokeSecurityGroupIngress", p.toURLValues())
+func (s *SecurityGroupService) RevokeSecurityGroupIngress(p *RevokeSecurityGroupIngressParams, opts ...OptionFunc) (*RevokeSecurityGroupIngressResponse, error) {
+	return s.RevokeSecurityGroupIngressWithContext(context.Background(), p, opts...)
+}
+
+// RevokeSecurityGroupIngressWithContext is the same as RevokeSecurityGroupIngress, but the request is cancelled when the context is done
+func (s *SecurityGroupService) RevokeSecurityGroupIngressWithContext(ctx context.Context, p *RevokeSecurityGroupIngressParams, opts ...OptionFunc) (*RevokeSecurityGroupIngressResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.RevokeSecurityGroupIngressRawWithContext(ctx, p.toURLValues())
+}
+
+// RevokeSecurityGroupIngressRaw is the same as RevokeSecurityGroupIngress, but takes the params as url.Values instead of typed params
+func (s *SecurityGroupService) RevokeSecurityGroupIngressRaw(v url.Values) (*RevokeSecurityGroupIngressResponse, error) {
+	return s.RevokeSecurityGroupIngressRawWithContext(context.Background(), v)
+}
+
+// RevokeSecurityGroupIngressRawWithContext is the same as RevokeSecurityGroupIngressRaw, but the request is cancelled when the context is done
+func (s *SecurityGroupService) RevokeSecurityGroupIngressRawWithContext(ctx context.Context, v url.Values) (*RevokeSecurityGroupIngressResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "revokeSecurityGroupIngress", "revokesecuritygroupingressresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -1239,9 +2147,9 @@ func (s *SecurityGroupService) RevokeSecurityGroupIngress(p *RevokeSecurityGroup
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
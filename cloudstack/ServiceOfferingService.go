@@ -17,17 +17,95 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/url"
 	"strconv"
 	"strings"
+	"time"
 )
 
+// ResolveOffering returns the ServiceOffering with the given name. Results are cached on the service for
+// the configured TTL (5 minutes by default), so repeated lookups don't result in additional list calls.
+func (s *ServiceOfferingService) ResolveOffering(name string) (*ServiceOffering, error) {
+	if v, found := s.cache.get(name); found {
+		return v.(*ServiceOffering), nil
+	}
+
+	o, _, err := s.GetServiceOfferingByName(name)
+	if err != nil {
+		return nil, err
+	}
+
+	s.cache.set(name, o)
+
+	return o, nil
+}
+
+// SetResolveTTL sets the TTL of the offerings cached by ResolveOffering. A TTL of 0 caches
+// the offerings until InvalidateResolveCache is called.
+func (s *ServiceOfferingService) SetResolveTTL(ttl time.Duration) {
+	s.cache.setTTL(ttl)
+}
+
+// InvalidateResolveCache removes all offerings cached by ResolveOffering
+func (s *ServiceOfferingService) InvalidateResolveCache() {
+	s.cache.invalidate()
+}
+
+// UpdateMany runs UpdateServiceOffering for all given IDs in parallel, using at most concurrency concurrent requests.
+// For each ID the params are populated with the ID and then passed to mutate, which should set the
+// changes to apply. The result contains the updated resources (in the order of the IDs) and the error
+// of every update that failed by ID. The returned error contains all failures, or is nil if none failed.
+// The updated resources are returned as the UpdateServiceOfferingResponse of every update, which contains the same
+// fields as the resource itself, so no additional request is needed per resource to get them. The
+// options are used for every update.
+func (s *ServiceOfferingService) UpdateMany(ids []string, mutate func(*UpdateServiceOfferingParams), concurrency int, opts ...OptionFunc) (BulkResult[*UpdateServiceOfferingResponse], error) {
+	rs := make([]*UpdateServiceOfferingResponse, len(ids))
+	errs := make([]error, len(ids))
+
+	runConcurrently(len(ids), concurrency, func(i int) {
+		p := &UpdateServiceOfferingParams{p: map[string]interface{}{"id": ids[i]}}
+		if mutate != nil {
+			mutate(p)
+		}
+
+		r, err := s.UpdateServiceOffering(p, opts...)
+		if err != nil {
+			errs[i] = err
+			return
+		}
+		rs[i] = r
+	})
+
+	var r BulkResult[*UpdateServiceOfferingResponse]
+	for i, id := range ids {
+		if errs[i] != nil {
+			r.fail(id, errs[i])
+			continue
+		}
+		r.Succeeded = append(r.Succeeded, rs[i])
+	}
+
+	return r, r.Err()
+}
+
 type CreateServiceOfferingParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *CreateServiceOfferingParams) Clone() *CreateServiceOfferingParams {
+	c := &CreateServiceOfferingParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *CreateServiceOfferingParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -116,11 +194,10 @@ func (p *CreateServiceOfferingParams) toURLValues() url.Values {
 		u.Set("provisioningtype", v.(string))
 	}
 	if v, found := p.p["serviceofferingdetails"]; found {
-		i := 0
-		for k, vv := range v.(map[string]string) {
+		m := v.(map[string]string)
+		for i, k := range getSortedKeysFromMap(m) {
 			u.Set(fmt.Sprintf("serviceofferingdetails[%d].key", i), k)
-			u.Set(fmt.Sprintf("serviceofferingdetails[%d].value", i), vv)
-			i++
+			u.Set(fmt.Sprintf("serviceofferingdetails[%d].value", i), m[k])
 		}
 	}
 	if v, found := p.p["storagetype"]; found {
@@ -135,6 +212,16 @@ func (p *CreateServiceOfferingParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *CreateServiceOfferingParams) validate() error {
+	for _, k := range []string{"displaytext", "name"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of createServiceOffering", k)
+		}
+	}
+	return nil
+}
+
 func (p *CreateServiceOfferingParams) SetBytesreadrate(v int64) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -143,6 +230,13 @@ func (p *CreateServiceOfferingParams) SetBytesreadrate(v int64) {
 	return
 }
 
+// SetBytesreadrateIfUnset sets bytesreadrate to v, unless it is already set
+func (p *CreateServiceOfferingParams) SetBytesreadrateIfUnset(v int64) {
+	if _, found := p.p["bytesreadrate"]; !found {
+		p.SetBytesreadrate(v)
+	}
+}
+
 func (p *CreateServiceOfferingParams) SetByteswriterate(v int64) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -151,6 +245,13 @@ func (p *CreateServiceOfferingParams) SetByteswriterate(v int64) {
 	return
 }
 
+// SetByteswriterateIfUnset sets byteswriterate to v, unless it is already set
+func (p *CreateServiceOfferingParams) SetByteswriterateIfUnset(v int64) {
+	if _, found := p.p["byteswriterate"]; !found {
+		p.SetByteswriterate(v)
+	}
+}
+
 func (p *CreateServiceOfferingParams) SetCpunumber(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -159,6 +260,13 @@ func (p *CreateServiceOfferingParams) SetCpunumber(v int) {
 	return
 }
 
+// SetCpunumberIfUnset sets cpunumber to v, unless it is already set
+func (p *CreateServiceOfferingParams) SetCpunumberIfUnset(v int) {
+	if _, found := p.p["cpunumber"]; !found {
+		p.SetCpunumber(v)
+	}
+}
+
 func (p *CreateServiceOfferingParams) SetCpuspeed(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -167,6 +275,13 @@ func (p *CreateServiceOfferingParams) SetCpuspeed(v int) {
 	return
 }
 
+// SetCpuspeedIfUnset sets cpuspeed to v, unless it is already set
+func (p *CreateServiceOfferingParams) SetCpuspeedIfUnset(v int) {
+	if _, found := p.p["cpuspeed"]; !found {
+		p.SetCpuspeed(v)
+	}
+}
+
 func (p *CreateServiceOfferingParams) SetCustomizediops(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -175,6 +290,13 @@ func (p *CreateServiceOfferingParams) SetCustomizediops(v bool) {
 	return
 }
 
+// SetCustomizediopsIfUnset sets customizediops to v, unless it is already set
+func (p *CreateServiceOfferingParams) SetCustomizediopsIfUnset(v bool) {
+	if _, found := p.p["customizediops"]; !found {
+		p.SetCustomizediops(v)
+	}
+}
+
 func (p *CreateServiceOfferingParams) SetDeploymentplanner(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -183,6 +305,13 @@ func (p *CreateServiceOfferingParams) SetDeploymentplanner(v string) {
 	return
 }
 
+// SetDeploymentplannerIfUnset sets deploymentplanner to v, unless it is already set
+func (p *CreateServiceOfferingParams) SetDeploymentplannerIfUnset(v string) {
+	if _, found := p.p["deploymentplanner"]; !found {
+		p.SetDeploymentplanner(v)
+	}
+}
+
 func (p *CreateServiceOfferingParams) SetDisplaytext(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -191,6 +320,13 @@ func (p *CreateServiceOfferingParams) SetDisplaytext(v string) {
 	return
 }
 
+// SetDisplaytextIfUnset sets displaytext to v, unless it is already set
+func (p *CreateServiceOfferingParams) SetDisplaytextIfUnset(v string) {
+	if _, found := p.p["displaytext"]; !found {
+		p.SetDisplaytext(v)
+	}
+}
+
 func (p *CreateServiceOfferingParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -199,6 +335,13 @@ func (p *CreateServiceOfferingParams) SetDomainid(v string) {
 	return
 }
 
+// SetDomainidIfUnset sets domainid to v, unless it is already set
+func (p *CreateServiceOfferingParams) SetDomainidIfUnset(v string) {
+	if _, found := p.p["domainid"]; !found {
+		p.SetDomainid(v)
+	}
+}
+
 func (p *CreateServiceOfferingParams) SetHosttags(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -207,6 +350,13 @@ func (p *CreateServiceOfferingParams) SetHosttags(v string) {
 	return
 }
 
+// SetHosttagsIfUnset sets hosttags to v, unless it is already set
+func (p *CreateServiceOfferingParams) SetHosttagsIfUnset(v string) {
+	if _, found := p.p["hosttags"]; !found {
+		p.SetHosttags(v)
+	}
+}
+
 func (p *CreateServiceOfferingParams) SetHypervisorsnapshotreserve(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -215,6 +365,13 @@ func (p *CreateServiceOfferingParams) SetHypervisorsnapshotreserve(v int) {
 	return
 }
 
+// SetHypervisorsnapshotreserveIfUnset sets hypervisorsnapshotreserve to v, unless it is already set
+func (p *CreateServiceOfferingParams) SetHypervisorsnapshotreserveIfUnset(v int) {
+	if _, found := p.p["hypervisorsnapshotreserve"]; !found {
+		p.SetHypervisorsnapshotreserve(v)
+	}
+}
+
 func (p *CreateServiceOfferingParams) SetIopsreadrate(v int64) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -223,6 +380,13 @@ func (p *CreateServiceOfferingParams) SetIopsreadrate(v int64) {
 	return
 }
 
+// SetIopsreadrateIfUnset sets iopsreadrate to v, unless it is already set
+func (p *CreateServiceOfferingParams) SetIopsreadrateIfUnset(v int64) {
+	if _, found := p.p["iopsreadrate"]; !found {
+		p.SetIopsreadrate(v)
+	}
+}
+
 func (p *CreateServiceOfferingParams) SetIopswriterate(v int64) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -231,6 +395,13 @@ func (p *CreateServiceOfferingParams) SetIopswriterate(v int64) {
 	return
 }
 
+// SetIopswriterateIfUnset sets iopswriterate to v, unless it is already set
+func (p *CreateServiceOfferingParams) SetIopswriterateIfUnset(v int64) {
+	if _, found := p.p["iopswriterate"]; !found {
+		p.SetIopswriterate(v)
+	}
+}
+
 func (p *CreateServiceOfferingParams) SetIssystem(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -239,6 +410,13 @@ func (p *CreateServiceOfferingParams) SetIssystem(v bool) {
 	return
 }
 
+// SetIssystemIfUnset sets issystem to v, unless it is already set
+func (p *CreateServiceOfferingParams) SetIssystemIfUnset(v bool) {
+	if _, found := p.p["issystem"]; !found {
+		p.SetIssystem(v)
+	}
+}
+
 func (p *CreateServiceOfferingParams) SetIsvolatile(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -247,6 +425,13 @@ func (p *CreateServiceOfferingParams) SetIsvolatile(v bool) {
 	return
 }
 
+// SetIsvolatileIfUnset sets isvolatile to v, unless it is already set
+func (p *CreateServiceOfferingParams) SetIsvolatileIfUnset(v bool) {
+	if _, found := p.p["isvolatile"]; !found {
+		p.SetIsvolatile(v)
+	}
+}
+
 func (p *CreateServiceOfferingParams) SetLimitcpuuse(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -255,6 +440,13 @@ func (p *CreateServiceOfferingParams) SetLimitcpuuse(v bool) {
 	return
 }
 
+// SetLimitcpuuseIfUnset sets limitcpuuse to v, unless it is already set
+func (p *CreateServiceOfferingParams) SetLimitcpuuseIfUnset(v bool) {
+	if _, found := p.p["limitcpuuse"]; !found {
+		p.SetLimitcpuuse(v)
+	}
+}
+
 func (p *CreateServiceOfferingParams) SetMaxiops(v int64) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -263,6 +455,13 @@ func (p *CreateServiceOfferingParams) SetMaxiops(v int64) {
 	return
 }
 
+// SetMaxiopsIfUnset sets maxiops to v, unless it is already set
+func (p *CreateServiceOfferingParams) SetMaxiopsIfUnset(v int64) {
+	if _, found := p.p["maxiops"]; !found {
+		p.SetMaxiops(v)
+	}
+}
+
 func (p *CreateServiceOfferingParams) SetMemory(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -271,6 +470,13 @@ func (p *CreateServiceOfferingParams) SetMemory(v int) {
 	return
 }
 
+// SetMemoryIfUnset sets memory to v, unless it is already set
+func (p *CreateServiceOfferingParams) SetMemoryIfUnset(v int) {
+	if _, found := p.p["memory"]; !found {
+		p.SetMemory(v)
+	}
+}
+
 func (p *CreateServiceOfferingParams) SetMiniops(v int64) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -279,6 +485,13 @@ func (p *CreateServiceOfferingParams) SetMiniops(v int64) {
 	return
 }
 
+// SetMiniopsIfUnset sets miniops to v, unless it is already set
+func (p *CreateServiceOfferingParams) SetMiniopsIfUnset(v int64) {
+	if _, found := p.p["miniops"]; !found {
+		p.SetMiniops(v)
+	}
+}
+
 func (p *CreateServiceOfferingParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -287,6 +500,13 @@ func (p *CreateServiceOfferingParams) SetName(v string) {
 	return
 }
 
+// SetNameIfUnset sets name to v, unless it is already set
+func (p *CreateServiceOfferingParams) SetNameIfUnset(v string) {
+	if _, found := p.p["name"]; !found {
+		p.SetName(v)
+	}
+}
+
 func (p *CreateServiceOfferingParams) SetNetworkrate(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -295,6 +515,13 @@ func (p *CreateServiceOfferingParams) SetNetworkrate(v int) {
 	return
 }
 
+// SetNetworkrateIfUnset sets networkrate to v, unless it is already set
+func (p *CreateServiceOfferingParams) SetNetworkrateIfUnset(v int) {
+	if _, found := p.p["networkrate"]; !found {
+		p.SetNetworkrate(v)
+	}
+}
+
 func (p *CreateServiceOfferingParams) SetOfferha(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -303,14 +530,28 @@ func (p *CreateServiceOfferingParams) SetOfferha(v bool) {
 	return
 }
 
-func (p *CreateServiceOfferingParams) SetProvisioningtype(v string) {
+// SetOfferhaIfUnset sets offerha to v, unless it is already set
+func (p *CreateServiceOfferingParams) SetOfferhaIfUnset(v bool) {
+	if _, found := p.p["offerha"]; !found {
+		p.SetOfferha(v)
+	}
+}
+
+func (p *CreateServiceOfferingParams) SetProvisioningtype(v ProvisioningType) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	p.p["provisioningtype"] = v
+	p.p["provisioningtype"] = string(v)
 	return
 }
 
+// SetProvisioningtypeIfUnset sets provisioningtype to v, unless it is already set
+func (p *CreateServiceOfferingParams) SetProvisioningtypeIfUnset(v ProvisioningType) {
+	if _, found := p.p["provisioningtype"]; !found {
+		p.SetProvisioningtype(v)
+	}
+}
+
 func (p *CreateServiceOfferingParams) SetServiceofferingdetails(v map[string]string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -319,14 +560,28 @@ func (p *CreateServiceOfferingParams) SetServiceofferingdetails(v map[string]str
 	return
 }
 
-func (p *CreateServiceOfferingParams) SetStoragetype(v string) {
+// SetServiceofferingdetailsIfUnset sets serviceofferingdetails to v, unless it is already set
+func (p *CreateServiceOfferingParams) SetServiceofferingdetailsIfUnset(v map[string]string) {
+	if _, found := p.p["serviceofferingdetails"]; !found {
+		p.SetServiceofferingdetails(v)
+	}
+}
+
+func (p *CreateServiceOfferingParams) SetStoragetype(v StorageType) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	p.p["storagetype"] = v
+	p.p["storagetype"] = string(v)
 	return
 }
 
+// SetStoragetypeIfUnset sets storagetype to v, unless it is already set
+func (p *CreateServiceOfferingParams) SetStoragetypeIfUnset(v StorageType) {
+	if _, found := p.p["storagetype"]; !found {
+		p.SetStoragetype(v)
+	}
+}
+
 func (p *CreateServiceOfferingParams) SetSystemvmtype(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -335,6 +590,13 @@ func (p *CreateServiceOfferingParams) SetSystemvmtype(v string) {
 	return
 }
 
+// SetSystemvmtypeIfUnset sets systemvmtype to v, unless it is already set
+func (p *CreateServiceOfferingParams) SetSystemvmtypeIfUnset(v string) {
+	if _, found := p.p["systemvmtype"]; !found {
+		p.SetSystemvmtype(v)
+	}
+}
+
 func (p *CreateServiceOfferingParams) SetTags(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -343,24 +605,60 @@ func (p *CreateServiceOfferingParams) SetTags(v string) {
 	return
 }
 
+// SetTagsIfUnset sets tags to v, unless it is already set
+func (p *CreateServiceOfferingParams) SetTagsIfUnset(v string) {
+	if _, found := p.p["tags"]; !found {
+		p.SetTags(v)
+	}
+}
+
 // You should always use this function to get a new CreateServiceOfferingParams instance,
-// as then you are sure you have configured all required params
-func (s *ServiceOfferingService) NewCreateServiceOfferingParams(displaytext string, name string) *CreateServiceOfferingParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *ServiceOfferingService) NewCreateServiceOfferingParams(displaytext string, name string, opts ...func(*CreateServiceOfferingParams)) *CreateServiceOfferingParams {
 	p := &CreateServiceOfferingParams{}
 	p.p = make(map[string]interface{})
 	p.p["displaytext"] = displaytext
 	p.p["name"] = name
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Creates a service offering.
-func (s *ServiceOfferingService) CreateServiceOffering(p *CreateServiceOfferingParams) (*CreateServiceOfferingResponse, error) {
-	resp, err := s.cs.newRequest("createServiceOffering", p.toURLValues())
+func (s *ServiceOfferingService) CreateServiceOffering(p *CreateServiceOfferingParams, opts ...OptionFunc) (*CreateServiceOfferingResponse, error) {
+	return s.CreateServiceOfferingWithContext(context.Background(), p, opts...)
+}
+
+// CreateServiceOfferingWithContext is the same as CreateServiceOffering, but the request is cancelled when the context is done
+func (s *ServiceOfferingService) CreateServiceOfferingWithContext(ctx context.Context, p *CreateServiceOfferingParams, opts ...OptionFunc) (*CreateServiceOfferingResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.CreateServiceOfferingRawWithContext(ctx, p.toURLValues())
+}
+
+// CreateServiceOfferingRaw is the same as CreateServiceOffering, but takes the params as url.Values instead of typed params
+func (s *ServiceOfferingService) CreateServiceOfferingRaw(v url.Values) (*CreateServiceOfferingResponse, error) {
+	return s.CreateServiceOfferingRawWithContext(context.Background(), v)
+}
+
+// CreateServiceOfferingRawWithContext is the same as CreateServiceOfferingRaw, but the request is cancelled when the context is done
+func (s *ServiceOfferingService) CreateServiceOfferingRawWithContext(ctx context.Context, v url.Values) (*CreateServiceOfferingResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "createServiceOffering", "createserviceofferingresponse", v)
 	if err != nil {
 		return nil, err
 	}
 
-	if resp, err = getRawValue(resp); err != nil {
+	if resp, err = getRawValueByKey(resp, "serviceoffering"); err != nil {
 		return nil, err
 	}
 
@@ -373,43 +671,53 @@ func (s *ServiceOfferingService) CreateServiceOffering(p *CreateServiceOfferingP
 }
 
 type CreateServiceOfferingResponse struct {
-	Cpunumber                 int               `json:"cpunumber"`
-	Cpuspeed                  int               `json:"cpuspeed"`
-	Created                   string            `json:"created"`
-	Defaultuse                bool              `json:"defaultuse"`
-	Deploymentplanner         string            `json:"deploymentplanner"`
-	DiskBytesReadRate         int64             `json:"diskBytesReadRate"`
-	DiskBytesWriteRate        int64             `json:"diskBytesWriteRate"`
-	DiskIopsReadRate          int64             `json:"diskIopsReadRate"`
-	DiskIopsWriteRate         int64             `json:"diskIopsWriteRate"`
-	Displaytext               string            `json:"displaytext"`
-	Domain                    string            `json:"domain"`
-	Domainid                  string            `json:"domainid"`
-	Hosttags                  string            `json:"hosttags"`
-	Hypervisorsnapshotreserve int               `json:"hypervisorsnapshotreserve"`
-	Id                        string            `json:"id"`
-	Iscustomized              bool              `json:"iscustomized"`
-	Iscustomizediops          bool              `json:"iscustomizediops"`
-	Issystem                  bool              `json:"issystem"`
-	Isvolatile                bool              `json:"isvolatile"`
-	Limitcpuuse               bool              `json:"limitcpuuse"`
-	Maxiops                   int64             `json:"maxiops"`
-	Memory                    int               `json:"memory"`
-	Miniops                   int64             `json:"miniops"`
-	Name                      string            `json:"name"`
-	Networkrate               int               `json:"networkrate"`
-	Offerha                   bool              `json:"offerha"`
-	Provisioningtype          string            `json:"provisioningtype"`
-	Serviceofferingdetails    map[string]string `json:"serviceofferingdetails"`
-	Storagetype               string            `json:"storagetype"`
-	Systemvmtype              string            `json:"systemvmtype"`
-	Tags                      string            `json:"tags"`
+	Cpunumber                 int       `json:"cpunumber"`
+	Cpuspeed                  int       `json:"cpuspeed"`
+	Created                   string    `json:"created"`
+	Defaultuse                bool      `json:"defaultuse"`
+	Deploymentplanner         string    `json:"deploymentplanner"`
+	DiskBytesReadRate         int64     `json:"diskBytesReadRate"`
+	DiskBytesWriteRate        int64     `json:"diskBytesWriteRate"`
+	DiskIopsReadRate          int64     `json:"diskIopsReadRate"`
+	DiskIopsWriteRate         int64     `json:"diskIopsWriteRate"`
+	Displaytext               string    `json:"displaytext"`
+	Domain                    string    `json:"domain"`
+	Domainid                  string    `json:"domainid"`
+	Hosttags                  string    `json:"hosttags"`
+	Hypervisorsnapshotreserve int       `json:"hypervisorsnapshotreserve"`
+	Id                        string    `json:"id"`
+	Iscustomized              bool      `json:"iscustomized"`
+	Iscustomizediops          bool      `json:"iscustomizediops"`
+	Issystem                  bool      `json:"issystem"`
+	Isvolatile                bool      `json:"isvolatile"`
+	Limitcpuuse               bool      `json:"limitcpuuse"`
+	Maxiops                   int64     `json:"maxiops"`
+	Memory                    int       `json:"memory"`
+	Miniops                   int64     `json:"miniops"`
+	Name                      string    `json:"name"`
+	Networkrate               int       `json:"networkrate"`
+	Offerha                   bool      `json:"offerha"`
+	Provisioningtype          string    `json:"provisioningtype"`
+	Serviceofferingdetails    StringMap `json:"serviceofferingdetails"`
+	Storagetype               string    `json:"storagetype"`
+	Systemvmtype              string    `json:"systemvmtype"`
+	Tags                      string    `json:"tags"`
 }
 
 type DeleteServiceOfferingParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *DeleteServiceOfferingParams) Clone() *DeleteServiceOfferingParams {
+	c := &DeleteServiceOfferingParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeleteServiceOfferingParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -421,6 +729,16 @@ func (p *DeleteServiceOfferingParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *DeleteServiceOfferingParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of deleteServiceOffering", k)
+		}
+	}
+	return nil
+}
+
 func (p *DeleteServiceOfferingParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -429,18 +747,54 @@ func (p *DeleteServiceOfferingParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *DeleteServiceOfferingParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 // You should always use this function to get a new DeleteServiceOfferingParams instance,
-// as then you are sure you have configured all required params
-func (s *ServiceOfferingService) NewDeleteServiceOfferingParams(id string) *DeleteServiceOfferingParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *ServiceOfferingService) NewDeleteServiceOfferingParams(id string, opts ...func(*DeleteServiceOfferingParams)) *DeleteServiceOfferingParams {
 	p := &DeleteServiceOfferingParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Deletes a service offering.
-func (s *ServiceOfferingService) DeleteServiceOffering(p *DeleteServiceOfferingParams) (*DeleteServiceOfferingResponse, error) {
-	resp, err := s.cs.newRequest("deleteServiceOffering", p.toURLValues())
+func (s *ServiceOfferingService) DeleteServiceOffering(p *DeleteServiceOfferingParams, opts ...OptionFunc) (*DeleteServiceOfferingResponse, error) {
+	return s.DeleteServiceOfferingWithContext(context.Background(), p, opts...)
+}
+
+// DeleteServiceOfferingWithContext is the same as DeleteServiceOffering, but the request is cancelled when the context is done
+func (s *ServiceOfferingService) DeleteServiceOfferingWithContext(ctx context.Context, p *DeleteServiceOfferingParams, opts ...OptionFunc) (*DeleteServiceOfferingResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.DeleteServiceOfferingRawWithContext(ctx, p.toURLValues())
+}
+
+// DeleteServiceOfferingRaw is the same as DeleteServiceOffering, but takes the params as url.Values instead of typed params
+func (s *ServiceOfferingService) DeleteServiceOfferingRaw(v url.Values) (*DeleteServiceOfferingResponse, error) {
+	return s.DeleteServiceOfferingRawWithContext(context.Background(), v)
+}
+
+// DeleteServiceOfferingRawWithContext is the same as DeleteServiceOfferingRaw, but the request is cancelled when the context is done
+func (s *ServiceOfferingService) DeleteServiceOfferingRawWithContext(ctx context.Context, v url.Values) (*DeleteServiceOfferingResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteServiceOffering", "deleteserviceofferingresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -453,6 +807,34 @@ func (s *ServiceOfferingService) DeleteServiceOffering(p *DeleteServiceOfferingP
 	return &r, nil
 }
 
+// WaitForServiceOfferingDeleted polls until the ServiceOffering with the given ID no longer exists, the timeout
+// expires or the context is cancelled. Deleted resources may still be listed for a short while
+// after the delete command finished, so this can be used to make sure they are really gone. The
+// options are passed to GetServiceOfferingByID, so a resource outside of the default scope can be found.
+func (s *ServiceOfferingService) WaitForServiceOfferingDeleted(ctx context.Context, id string, timeout time.Duration, opts ...OptionFunc) error {
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	for {
+		_, count, err := s.GetServiceOfferingByID(id, opts...)
+		if count == 0 {
+			return nil
+		}
+		if count < 0 {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(2 * time.Second):
+		}
+	}
+}
+
 type DeleteServiceOfferingResponse struct {
 	Displaytext string `json:"displaytext"`
 	Success     bool   `json:"success"`
@@ -481,6 +863,16 @@ type ListServiceOfferingsParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ListServiceOfferingsParams) Clone() *ListServiceOfferingsParams {
+	c := &ListServiceOfferingsParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListServiceOfferingsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -535,6 +927,13 @@ func (p *ListServiceOfferingsParams) SetDomainid(v string) {
 	return
 }
 
+// SetDomainidIfUnset sets domainid to v, unless it is already set
+func (p *ListServiceOfferingsParams) SetDomainidIfUnset(v string) {
+	if _, found := p.p["domainid"]; !found {
+		p.SetDomainid(v)
+	}
+}
+
 func (p *ListServiceOfferingsParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -543,6 +942,13 @@ func (p *ListServiceOfferingsParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *ListServiceOfferingsParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 func (p *ListServiceOfferingsParams) SetIsrecursive(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -551,6 +957,13 @@ func (p *ListServiceOfferingsParams) SetIsrecursive(v bool) {
 	return
 }
 
+// SetIsrecursiveIfUnset sets isrecursive to v, unless it is already set
+func (p *ListServiceOfferingsParams) SetIsrecursiveIfUnset(v bool) {
+	if _, found := p.p["isrecursive"]; !found {
+		p.SetIsrecursive(v)
+	}
+}
+
 func (p *ListServiceOfferingsParams) SetIssystem(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -559,6 +972,13 @@ func (p *ListServiceOfferingsParams) SetIssystem(v bool) {
 	return
 }
 
+// SetIssystemIfUnset sets issystem to v, unless it is already set
+func (p *ListServiceOfferingsParams) SetIssystemIfUnset(v bool) {
+	if _, found := p.p["issystem"]; !found {
+		p.SetIssystem(v)
+	}
+}
+
 func (p *ListServiceOfferingsParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -567,6 +987,13 @@ func (p *ListServiceOfferingsParams) SetKeyword(v string) {
 	return
 }
 
+// SetKeywordIfUnset sets keyword to v, unless it is already set
+func (p *ListServiceOfferingsParams) SetKeywordIfUnset(v string) {
+	if _, found := p.p["keyword"]; !found {
+		p.SetKeyword(v)
+	}
+}
+
 func (p *ListServiceOfferingsParams) SetListall(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -575,6 +1002,13 @@ func (p *ListServiceOfferingsParams) SetListall(v bool) {
 	return
 }
 
+// SetListallIfUnset sets listall to v, unless it is already set
+func (p *ListServiceOfferingsParams) SetListallIfUnset(v bool) {
+	if _, found := p.p["listall"]; !found {
+		p.SetListall(v)
+	}
+}
+
 func (p *ListServiceOfferingsParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -583,6 +1017,13 @@ func (p *ListServiceOfferingsParams) SetName(v string) {
 	return
 }
 
+// SetNameIfUnset sets name to v, unless it is already set
+func (p *ListServiceOfferingsParams) SetNameIfUnset(v string) {
+	if _, found := p.p["name"]; !found {
+		p.SetName(v)
+	}
+}
+
 func (p *ListServiceOfferingsParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -591,6 +1032,13 @@ func (p *ListServiceOfferingsParams) SetPage(v int) {
 	return
 }
 
+// SetPageIfUnset sets page to v, unless it is already set
+func (p *ListServiceOfferingsParams) SetPageIfUnset(v int) {
+	if _, found := p.p["page"]; !found {
+		p.SetPage(v)
+	}
+}
+
 func (p *ListServiceOfferingsParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -599,6 +1047,13 @@ func (p *ListServiceOfferingsParams) SetPagesize(v int) {
 	return
 }
 
+// SetPagesizeIfUnset sets pagesize to v, unless it is already set
+func (p *ListServiceOfferingsParams) SetPagesizeIfUnset(v int) {
+	if _, found := p.p["pagesize"]; !found {
+		p.SetPagesize(v)
+	}
+}
+
 func (p *ListServiceOfferingsParams) SetSystemvmtype(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -607,6 +1062,13 @@ func (p *ListServiceOfferingsParams) SetSystemvmtype(v string) {
 	return
 }
 
+// SetSystemvmtypeIfUnset sets systemvmtype to v, unless it is already set
+func (p *ListServiceOfferingsParams) SetSystemvmtypeIfUnset(v string) {
+	if _, found := p.p["systemvmtype"]; !found {
+		p.SetSystemvmtype(v)
+	}
+}
+
 func (p *ListServiceOfferingsParams) SetVirtualmachineid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -615,14 +1077,34 @@ func (p *ListServiceOfferingsParams) SetVirtualmachineid(v string) {
 	return
 }
 
+// SetVirtualmachineidIfUnset sets virtualmachineid to v, unless it is already set
+func (p *ListServiceOfferingsParams) SetVirtualmachineidIfUnset(v string) {
+	if _, found := p.p["virtualmachineid"]; !found {
+		p.SetVirtualmachineid(v)
+	}
+}
+
+var _ ListAllSetter = (*ListServiceOfferingsParams)(nil)
+
 // You should always use this function to get a new ListServiceOfferingsParams instance,
-// as then you are sure you have configured all required params
-func (s *ServiceOfferingService) NewListServiceOfferingsParams() *ListServiceOfferingsParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *ServiceOfferingService) NewListServiceOfferingsParams(opts ...func(*ListServiceOfferingsParams)) *ListServiceOfferingsParams {
 	p := &ListServiceOfferingsParams{}
 	p.p = make(map[string]interface{})
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
+func (p *ListServiceOfferingsParams) setFirstMatch() {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p[firstMatchKey] = true
+}
+
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *ServiceOfferingService) GetServiceOfferingID(name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListServiceOfferingsParams{}
@@ -630,7 +1112,7 @@ func (s *ServiceOfferingService) GetServiceOfferingID(name string, opts ...Optio
 
 	p.p["name"] = name
 
-	for _, fn := range append(s.cs.options, opts...) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
 		if err := fn(s.cs, p); err != nil {
 			return "", -1, err
 		}
@@ -642,7 +1124,7 @@ func (s *ServiceOfferingService) GetServiceOfferingID(name string, opts ...Optio
 	}
 
 	if l.Count == 0 {
-		return "", l.Count, fmt.Errorf("No match found for %s: %+v", name, l)
+		return "", l.Count, fmt.Errorf("%w for %s: %+v", ErrNotFound, name, l)
 	}
 
 	if l.Count == 1 {
@@ -655,6 +1137,10 @@ func (s *ServiceOfferingService) GetServiceOfferingID(name string, opts ...Optio
 				return v.Id, l.Count, nil
 			}
 		}
+
+		if _, ok := p.p[firstMatchKey]; ok && len(l.ServiceOfferings) > 0 {
+			return l.ServiceOfferings[0].Id, l.Count, nil
+		}
 	}
 	return "", l.Count, fmt.Errorf("Could not find an exact match for %s: %+v", name, l)
 }
@@ -680,7 +1166,7 @@ func (s *ServiceOfferingService) GetServiceOfferingByID(id string, opts ...Optio
 
 	p.p["id"] = id
 
-	for _, fn := range append(s.cs.options, opts...) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
 		if err := fn(s.cs, p); err != nil {
 			return nil, -1, err
 		}
@@ -691,24 +1177,56 @@ func (s *ServiceOfferingService) GetServiceOfferingByID(id string, opts ...Optio
 		if strings.Contains(err.Error(), fmt.Sprintf(
 			"Invalid parameter id value=%s due to incorrect long value format, "+
 				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+			return nil, 0, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
 	}
 
 	if l.Count == 1 {
 		return l.ServiceOfferings[0], l.Count, nil
 	}
+
+	if _, ok := p.p[firstMatchKey]; ok && len(l.ServiceOfferings) > 0 {
+		return l.ServiceOfferings[0], l.Count, nil
+	}
 	return nil, l.Count, fmt.Errorf("There is more then one result for ServiceOffering UUID: %s!", id)
 }
 
+// ServiceOfferingExists returns true if a ServiceOffering with the given ID exists. When no match is found
+// it returns false, while any other error is returned as is.
+func (s *ServiceOfferingService) ServiceOfferingExists(id string, opts ...OptionFunc) (bool, error) {
+	_, count, err := s.GetServiceOfferingByID(id, opts...)
+	if errors.Is(err, ErrNotFound) {
+		return false, nil
+	}
+	if count > 0 {
+		return true, nil
+	}
+	return false, err
+}
+
 // Lists all available service offerings.
 func (s *ServiceOfferingService) ListServiceOfferings(p *ListServiceOfferingsParams) (*ListServiceOfferingsResponse, error) {
-	resp, err := s.cs.newRequest("listServiceOfferings", p.toURLValues())
+	return s.ListServiceOfferingsWithContext(context.Background(), p)
+}
+
+// ListServiceOfferingsWithContext is the same as ListServiceOfferings, but the request is cancelled when the context is done
+func (s *ServiceOfferingService) ListServiceOfferingsWithContext(ctx context.Context, p *ListServiceOfferingsParams) (*ListServiceOfferingsResponse, error) {
+	return s.ListServiceOfferingsRawWithContext(ctx, p.toURLValues())
+}
+
+// ListServiceOfferingsRaw is the same as ListServiceOfferings, but takes the params as url.Values instead of typed params
+func (s *ServiceOfferingService) ListServiceOfferingsRaw(v url.Values) (*ListServiceOfferingsResponse, error) {
+	return s.ListServiceOfferingsRawWithContext(context.Background(), v)
+}
+
+// ListServiceOfferingsRawWithContext is the same as ListServiceOfferingsRaw, but the request is cancelled when the context is done
+func (s *ServiceOfferingService) ListServiceOfferingsRawWithContext(ctx context.Context, v url.Values) (*ListServiceOfferingsResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "listServiceOfferings", "listserviceofferingsresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -721,49 +1239,146 @@ func (s *ServiceOfferingService) ListServiceOfferings(p *ListServiceOfferingsPar
 	return &r, nil
 }
 
+// StreamServiceOfferings fetches the results of ListServiceOfferings page by page in the background and sends them on the
+// returned channel. Both returned channels are closed when done. If fetching a page fails, or the context
+// is done before all results are sent, the error is sent on the error channel and fetching stops. A
+// request for a page in flight is cancelled when the context is done. The page and pagesize set in the
+// params are ignored.
+func (s *ServiceOfferingService) StreamServiceOfferings(ctx context.Context, p *ListServiceOfferingsParams) (<-chan *ServiceOffering, <-chan error) {
+	ch := make(chan *ServiceOffering)
+	errs := make(chan error, 1)
+
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesize(500)
+
+	go func() {
+		defer close(errs)
+		defer close(ch)
+
+		for page, count := 1, 0; ; page++ {
+			pp.SetPage(page)
+
+			l, err := s.ListServiceOfferingsWithContext(ctx, pp)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			for _, v := range l.ServiceOfferings {
+				select {
+				case ch <- v:
+				case <-ctx.Done():
+					errs <- ctx.Err()
+					return
+				}
+			}
+
+			count += len(l.ServiceOfferings)
+			if len(l.ServiceOfferings) < 500 || count >= l.Count {
+				return
+			}
+		}
+	}()
+
+	return ch, errs
+}
+
+// ListServiceOfferingsWhere returns all results of ListServiceOfferings for which pred returns true. Note that the results are
+// filtered client-side, so all results matching the params are fetched first.
+// All pages are fetched, so the page and pagesize set in the params are ignored.
+func (s *ServiceOfferingService) ListServiceOfferingsWhere(p *ListServiceOfferingsParams, pred func(*ServiceOffering) bool) ([]*ServiceOffering, error) {
+	var r []*ServiceOffering
+	ch, errs := s.StreamServiceOfferings(context.Background(), p)
+	for v := range ch {
+		if pred(v) {
+			r = append(r, v)
+		}
+	}
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ListAllServiceOfferings returns the results of ListServiceOfferings across all pages, by calling ListServiceOfferings page by page until all
+// results are fetched. The pagesize set in the params is used for every page (500 if not set), and the
+// page set in the params is ignored.
+func (s *ServiceOfferingService) ListAllServiceOfferings(p *ListServiceOfferingsParams) ([]*ServiceOffering, error) {
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesizeIfUnset(500)
+	pagesize := pp.p["pagesize"].(int)
+
+	var r []*ServiceOffering
+	for page := 1; ; page++ {
+		pp.SetPage(page)
+
+		l, err := s.ListServiceOfferings(pp)
+		if err != nil {
+			return nil, err
+		}
+		r = append(r, l.ServiceOfferings...)
+
+		if len(l.ServiceOfferings) < pagesize || len(r) >= l.Count {
+			return r, nil
+		}
+	}
+}
+
 type ListServiceOfferingsResponse struct {
 	Count            int                `json:"count"`
 	ServiceOfferings []*ServiceOffering `json:"serviceoffering"`
 }
 
 type ServiceOffering struct {
-	Cpunumber                 int               `json:"cpunumber"`
-	Cpuspeed                  int               `json:"cpuspeed"`
-	Created                   string            `json:"created"`
-	Defaultuse                bool              `json:"defaultuse"`
-	Deploymentplanner         string            `json:"deploymentplanner"`
-	DiskBytesReadRate         int64             `json:"diskBytesReadRate"`
-	DiskBytesWriteRate        int64             `json:"diskBytesWriteRate"`
-	DiskIopsReadRate          int64             `json:"diskIopsReadRate"`
-	DiskIopsWriteRate         int64             `json:"diskIopsWriteRate"`
-	Displaytext               string            `json:"displaytext"`
-	Domain                    string            `json:"domain"`
-	Domainid                  string            `json:"domainid"`
-	Hosttags                  string            `json:"hosttags"`
-	Hypervisorsnapshotreserve int               `json:"hypervisorsnapshotreserve"`
-	Id                        string            `json:"id"`
-	Iscustomized              bool              `json:"iscustomized"`
-	Iscustomizediops          bool              `json:"iscustomizediops"`
-	Issystem                  bool              `json:"issystem"`
-	Isvolatile                bool              `json:"isvolatile"`
-	Limitcpuuse               bool              `json:"limitcpuuse"`
-	Maxiops                   int64             `json:"maxiops"`
-	Memory                    int               `json:"memory"`
-	Miniops                   int64             `json:"miniops"`
-	Name                      string            `json:"name"`
-	Networkrate               int               `json:"networkrate"`
-	Offerha                   bool              `json:"offerha"`
-	Provisioningtype          string            `json:"provisioningtype"`
-	Serviceofferingdetails    map[string]string `json:"serviceofferingdetails"`
-	Storagetype               string            `json:"storagetype"`
-	Systemvmtype              string            `json:"systemvmtype"`
-	Tags                      string            `json:"tags"`
+	Cpunumber                 int       `json:"cpunumber"`
+	Cpuspeed                  int       `json:"cpuspeed"`
+	Created                   string    `json:"created"`
+	Defaultuse                bool      `json:"defaultuse"`
+	Deploymentplanner         string    `json:"deploymentplanner"`
+	DiskBytesReadRate         int64     `json:"diskBytesReadRate"`
+	DiskBytesWriteRate        int64     `json:"diskBytesWriteRate"`
+	DiskIopsReadRate          int64     `json:"diskIopsReadRate"`
+	DiskIopsWriteRate         int64     `json:"diskIopsWriteRate"`
+	Displaytext               string    `json:"displaytext"`
+	Domain                    string    `json:"domain"`
+	Domainid                  string    `json:"domainid"`
+	Hosttags                  string    `json:"hosttags"`
+	Hypervisorsnapshotreserve int       `json:"hypervisorsnapshotreserve"`
+	Id                        string    `json:"id"`
+	Iscustomized              bool      `json:"iscustomized"`
+	Iscustomizediops          bool      `json:"iscustomizediops"`
+	Issystem                  bool      `json:"issystem"`
+	Isvolatile                bool      `json:"isvolatile"`
+	Limitcpuuse               bool      `json:"limitcpuuse"`
+	Maxiops                   int64     `json:"maxiops"`
+	Memory                    int       `json:"memory"`
+	Miniops                   int64     `json:"miniops"`
+	Name                      string    `json:"name"`
+	Networkrate               int       `json:"networkrate"`
+	Offerha                   bool      `json:"offerha"`
+	Provisioningtype          string    `json:"provisioningtype"`
+	Serviceofferingdetails    StringMap `json:"serviceofferingdetails"`
+	Storagetype               string    `json:"storagetype"`
+	Systemvmtype              string    `json:"systemvmtype"`
+	Tags                      string    `json:"tags"`
 }
 
 type UpdateServiceOfferingParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *UpdateServiceOfferingParams) Clone() *UpdateServiceOfferingParams {
+	c := &UpdateServiceOfferingParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *UpdateServiceOfferingParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -785,6 +1400,16 @@ func (p *UpdateServiceOfferingParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *UpdateServiceOfferingParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of updateServiceOffering", k)
+		}
+	}
+	return nil
+}
+
 func (p *UpdateServiceOfferingParams) SetDisplaytext(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -793,6 +1418,13 @@ func (p *UpdateServiceOfferingParams) SetDisplaytext(v string) {
 	return
 }
 
+// SetDisplaytextIfUnset sets displaytext to v, unless it is already set
+func (p *UpdateServiceOfferingParams) SetDisplaytextIfUnset(v string) {
+	if _, found := p.p["displaytext"]; !found {
+		p.SetDisplaytext(v)
+	}
+}
+
 func (p *UpdateServiceOfferingParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -801,6 +1433,13 @@ func (p *UpdateServiceOfferingParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *UpdateServiceOfferingParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 func (p *UpdateServiceOfferingParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -809,6 +1448,13 @@ func (p *UpdateServiceOfferingParams) SetName(v string) {
 	return
 }
 
+// SetNameIfUnset sets name to v, unless it is already set
+func (p *UpdateServiceOfferingParams) SetNameIfUnset(v string) {
+	if _, found := p.p["name"]; !found {
+		p.SetName(v)
+	}
+}
+
 func (p *UpdateServiceOfferingParams) SetSortkey(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -817,18 +1463,54 @@ func (p *UpdateServiceOfferingParams) SetSortkey(v int) {
 	return
 }
 
+// SetSortkeyIfUnset sets sortkey to v, unless it is already set
+func (p *UpdateServiceOfferingParams) SetSortkeyIfUnset(v int) {
+	if _, found := p.p["sortkey"]; !found {
+		p.SetSortkey(v)
+	}
+}
+
 // You should always use this function to get a new UpdateServiceOfferingParams instance,
-// as then you are sure you have configured all required params
-func (s *ServiceOfferingService) NewUpdateServiceOfferingParams(id string) *UpdateServiceOfferingParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *ServiceOfferingService) NewUpdateServiceOfferingParams(id string, opts ...func(*UpdateServiceOfferingParams)) *UpdateServiceOfferingParams {
 	p := &UpdateServiceOfferingParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Updates a service offering.
-func (s *ServiceOfferingService) UpdateServiceOffering(p *UpdateServiceOfferingParams) (*UpdateServiceOfferingResponse, error) {
-	resp, err := s.cs.newRequest("updateServiceOffering", p.toURLValues())
+func (s *ServiceOfferingService) UpdateServiceOffering(p *UpdateServiceOfferingParams, opts ...OptionFunc) (*UpdateServiceOfferingResponse, error) {
+	return s.UpdateServiceOfferingWithContext(context.Background(), p, opts...)
+}
+
+// UpdateServiceOfferingWithContext is the same as UpdateServiceOffering, but the request is cancelled when the context is done
+func (s *ServiceOfferingService) UpdateServiceOfferingWithContext(ctx context.Context, p *UpdateServiceOfferingParams, opts ...OptionFunc) (*UpdateServiceOfferingResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.UpdateServiceOfferingRawWithContext(ctx, p.toURLValues())
+}
+
+// UpdateServiceOfferingRaw is the same as UpdateServiceOffering, but takes the params as url.Values instead of typed params
+func (s *ServiceOfferingService) UpdateServiceOfferingRaw(v url.Values) (*UpdateServiceOfferingResponse, error) {
+	return s.UpdateServiceOfferingRawWithContext(context.Background(), v)
+}
+
+// UpdateServiceOfferingRawWithContext is the same as UpdateServiceOfferingRaw, but the request is cancelled when the context is done
+func (s *ServiceOfferingService) UpdateServiceOfferingRawWithContext(ctx context.Context, v url.Values) (*UpdateServiceOfferingResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "updateServiceOffering", "updateserviceofferingresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -842,35 +1524,35 @@ func (s *ServiceOfferingService) UpdateServiceOffering(p *UpdateServiceOfferingP
 }
 
 type UpdateServiceOfferingResponse struct {
-	Cpunumber                 int               `json:"cpunumber"`
-	Cpuspeed                  int               `json:"cpuspeed"`
-	Created                   string            `json:"created"`
-	Defaultuse                bool              `json:"defaultuse"`
-	Deploymentplanner         string            `json:"deploymentplanner"`
-	DiskBytesReadRate         int64             `json:"diskBytesReadRate"`
-	DiskBytesWriteRate        int64             `json:"diskBytesWriteRate"`
-	DiskIopsReadRate          int64             `json:"diskIopsReadRate"`
-	DiskIopsWriteRate         int64             `json:"diskIopsWriteRate"`
-	Displaytext               string            `json:"displaytext"`
-	Domain                    string            `json:"domain"`
-	Domainid                  string            `json:"domainid"`
-	Hosttags                  string            `json:"hosttags"`
-	Hypervisorsnapshotreserve int               `json:"hypervisorsnapshotreserve"`
-	Id                        string            `json:"id"`
-	Iscustomized              bool              `json:"iscustomized"`
-	Iscustomizediops          bool              `json:"iscustomizediops"`
-	Issystem                  bool              `json:"issystem"`
-	Isvolatile                bool              `json:"isvolatile"`
-	Limitcpuuse               bool              `json:"limitcpuuse"`
-	Maxiops                   int64             `json:"maxiops"`
-	Memory                    int               `json:"memory"`
-	Miniops                   int64             `json:"miniops"`
-	Name                      string            `json:"name"`
-	Networkrate               int               `json:"networkrate"`
-	Offerha                   bool              `json:"offerha"`
-	Provisioningtype          string            `json:"provisioningtype"`
-	Serviceofferingdetails    map[string]string `json:"serviceofferingdetails"`
-	Storagetype               string            `json:"storagetype"`
-	Systemvmtype              string            `json:"systemvmtype"`
-	Tags                      string            `json:"tags"`
+	Cpunumber                 int       `json:"cpunumber"`
+	Cpuspeed                  int       `json:"cpuspeed"`
+	Created                   string    `json:"created"`
+	Defaultuse                bool      `json:"defaultuse"`
+	Deploymentplanner         string    `json:"deploymentplanner"`
+	DiskBytesReadRate         int64     `json:"diskBytesReadRate"`
+	DiskBytesWriteRate        int64     `json:"diskBytesWriteRate"`
+	DiskIopsReadRate          int64     `json:"diskIopsReadRate"`
+	DiskIopsWriteRate         int64     `json:"diskIopsWriteRate"`
+	Displaytext               string    `json:"displaytext"`
+	Domain                    string    `json:"domain"`
+	Domainid                  string    `json:"domainid"`
+	Hosttags                  string    `json:"hosttags"`
+	Hypervisorsnapshotreserve int       `json:"hypervisorsnapshotreserve"`
+	Id                        string    `json:"id"`
+	Iscustomized              bool      `json:"iscustomized"`
+	Iscustomizediops          bool      `json:"iscustomizediops"`
+	Issystem                  bool      `json:"issystem"`
+	Isvolatile                bool      `json:"isvolatile"`
+	Limitcpuuse               bool      `json:"limitcpuuse"`
+	Maxiops                   int64     `json:"maxiops"`
+	Memory                    int       `json:"memory"`
+	Miniops                   int64     `json:"miniops"`
+	Name                      string    `json:"name"`
+	Networkrate               int       `json:"networkrate"`
+	Offerha                   bool      `json:"offerha"`
+	Provisioningtype          string    `json:"provisioningtype"`
+	Serviceofferingdetails    StringMap `json:"serviceofferingdetails"`
+	Storagetype               string    `json:"storagetype"`
+	Systemvmtype              string    `json:"systemvmtype"`
+	Tags                      string    `json:"tags"`
 }
@@ -17,17 +17,86 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/url"
 	"strconv"
 	"strings"
+	"time"
 )
 
+// ErrSnapshotFailed is returned (wrapped) by CreateSnapshotAndWaitReady when the snapshot ends up in a
+// state in which it will never be backed up
+var ErrSnapshotFailed = errors.New("Snapshot failed")
+
+// CreateSnapshotAndWaitReady creates a snapshot of the volume and polls until its state is BackedUp or
+// the timeout expires (a timeout of 0 means no timeout), as the snapshot may still be creating or backing
+// up after the async job finished. When the snapshot ends up in the Error or Destroyed state instead, the
+// snapshot is returned together with an ErrSnapshotFailed error. The options are used when getting the
+// snapshot.
+func (s *SnapshotService) CreateSnapshotAndWaitReady(volumeid string, timeout time.Duration, opts ...OptionFunc) (*Snapshot, error) {
+	ctx := context.Background()
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	r, err := s.CreateSnapshot(s.NewCreateSnapshotParams(volumeid))
+	if err != nil {
+		return nil, err
+	}
+
+	// An async client already waited for the job to finish
+	if !s.cs.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		if err != nil {
+			return nil, err
+		}
+		if b, err = getRawValueByKey(b, "snapshot"); err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(b, r); err != nil {
+			return nil, err
+		}
+	}
+
+	for {
+		snapshot, _, err := s.GetSnapshotByID(r.Id, opts...)
+		if err != nil {
+			return nil, err
+		}
+		switch snapshot.State {
+		case "BackedUp":
+			return snapshot, nil
+		case "Error", "Destroying", "Destroyed":
+			return snapshot, fmt.Errorf("%w: snapshot %s of volume %s is in state %s", ErrSnapshotFailed, r.Id, volumeid, snapshot.State)
+		}
+
+		select {
+		case <-ctx.Done():
+			return snapshot, fmt.Errorf("Timeout waiting for snapshot %s to be backed up, last state was %s: %w", r.Id, snapshot.State, ctx.Err())
+		case <-time.After(2 * time.Second):
+		}
+	}
+}
+
 type CreateSnapshotParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *CreateSnapshotParams) Clone() *CreateSnapshotParams {
+	c := &CreateSnapshotParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *CreateSnapshotParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -55,6 +124,16 @@ func (p *CreateSnapshotParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *CreateSnapshotParams) validate() error {
+	for _, k := range []string{"volumeid"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of createSnapshot", k)
+		}
+	}
+	return nil
+}
+
 func (p *CreateSnapshotParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -63,6 +142,13 @@ func (p *CreateSnapshotParams) SetAccount(v string) {
 	return
 }
 
+// SetAccountIfUnset sets account to v, unless it is already set
+func (p *CreateSnapshotParams) SetAccountIfUnset(v string) {
+	if _, found := p.p["account"]; !found {
+		p.SetAccount(v)
+	}
+}
+
 func (p *CreateSnapshotParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -71,6 +157,13 @@ func (p *CreateSnapshotParams) SetDomainid(v string) {
 	return
 }
 
+// SetDomainidIfUnset sets domainid to v, unless it is already set
+func (p *CreateSnapshotParams) SetDomainidIfUnset(v string) {
+	if _, found := p.p["domainid"]; !found {
+		p.SetDomainid(v)
+	}
+}
+
 func (p *CreateSnapshotParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -79,6 +172,13 @@ func (p *CreateSnapshotParams) SetName(v string) {
 	return
 }
 
+// SetNameIfUnset sets name to v, unless it is already set
+func (p *CreateSnapshotParams) SetNameIfUnset(v string) {
+	if _, found := p.p["name"]; !found {
+		p.SetName(v)
+	}
+}
+
 func (p *CreateSnapshotParams) SetPolicyid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -87,6 +187,13 @@ func (p *CreateSnapshotParams) SetPolicyid(v string) {
 	return
 }
 
+// SetPolicyidIfUnset sets policyid to v, unless it is already set
+func (p *CreateSnapshotParams) SetPolicyidIfUnset(v string) {
+	if _, found := p.p["policyid"]; !found {
+		p.SetPolicyid(v)
+	}
+}
+
 func (p *CreateSnapshotParams) SetQuiescevm(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -95,6 +202,13 @@ func (p *CreateSnapshotParams) SetQuiescevm(v bool) {
 	return
 }
 
+// SetQuiescevmIfUnset sets quiescevm to v, unless it is already set
+func (p *CreateSnapshotParams) SetQuiescevmIfUnset(v bool) {
+	if _, found := p.p["quiescevm"]; !found {
+		p.SetQuiescevm(v)
+	}
+}
+
 func (p *CreateSnapshotParams) SetVolumeid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -103,18 +217,54 @@ func (p *CreateSnapshotParams) SetVolumeid(v string) {
 	return
 }
 
+// SetVolumeidIfUnset sets volumeid to v, unless it is already set
+func (p *CreateSnapshotParams) SetVolumeidIfUnset(v string) {
+	if _, found := p.p["volumeid"]; !found {
+		p.SetVolumeid(v)
+	}
+}
+
 // You should always use this function to get a new CreateSnapshotParams instance,
-// as then you are sure you have configured all required params
-func (s *SnapshotService) NewCreateSnapshotParams(volumeid string) *CreateSnapshotParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *SnapshotService) NewCreateSnapshotParams(volumeid string, opts ...func(*CreateSnapshotParams)) *CreateSnapshotParams {
 	p := &CreateSnapshotParams{}
 	p.p = make(map[string]interface{})
 	p.p["volumeid"] = volumeid
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Creates an instant snapshot of a volume.
-func (s *SnapshotService) CreateSnapshot(p *CreateSnapshotParams) (*CreateSnapshotResponse, error) {
-	resp, err := s.cs.newRequest("createSnapshot", p.toURLValues())
+func (s *SnapshotService) CreateSnapshot(p *CreateSnapshotParams, opts ...OptionFunc) (*CreateSnapshotResponse, error) {
+	return s.CreateSnapshotWithContext(context.Background(), p, opts...)
+}
+
+// CreateSnapshotWithContext is the same as CreateSnapshot, but the request is cancelled when the context is done
+func (s *SnapshotService) CreateSnapshotWithContext(ctx context.Context, p *CreateSnapshotParams, opts ...OptionFunc) (*CreateSnapshotResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.CreateSnapshotRawWithContext(ctx, p.toURLValues())
+}
+
+// CreateSnapshotRaw is the same as CreateSnapshot, but takes the params as url.Values instead of typed params
+func (s *SnapshotService) CreateSnapshotRaw(v url.Values) (*CreateSnapshotResponse, error) {
+	return s.CreateSnapshotRawWithContext(context.Background(), v)
+}
+
+// CreateSnapshotRawWithContext is the same as CreateSnapshotRaw, but the request is cancelled when the context is done
+func (s *SnapshotService) CreateSnapshotRawWithContext(ctx context.Context, v url.Values) (*CreateSnapshotResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "createSnapshot", "createsnapshotresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -126,15 +276,15 @@ func (s *SnapshotService) CreateSnapshot(p *CreateSnapshotParams) (*CreateSnapsh
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
+		b, err = getRawValueByKey(b, "snapshot")
 		if err != nil {
 			return nil, err
 		}
@@ -184,6 +334,16 @@ type CreateSnapshotPolicyParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *CreateSnapshotPolicyParams) Clone() *CreateSnapshotPolicyParams {
+	c := &CreateSnapshotPolicyParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *CreateSnapshotPolicyParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -212,6 +372,16 @@ func (p *CreateSnapshotPolicyParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *CreateSnapshotPolicyParams) validate() error {
+	for _, k := range []string{"intervaltype", "maxsnaps", "schedule", "timezone", "volumeid"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of createSnapshotPolicy", k)
+		}
+	}
+	return nil
+}
+
 func (p *CreateSnapshotPolicyParams) SetFordisplay(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -220,14 +390,28 @@ func (p *CreateSnapshotPolicyParams) SetFordisplay(v bool) {
 	return
 }
 
-func (p *CreateSnapshotPolicyParams) SetIntervaltype(v string) {
+// SetFordisplayIfUnset sets fordisplay to v, unless it is already set
+func (p *CreateSnapshotPolicyParams) SetFordisplayIfUnset(v bool) {
+	if _, found := p.p["fordisplay"]; !found {
+		p.SetFordisplay(v)
+	}
+}
+
+func (p *CreateSnapshotPolicyParams) SetIntervaltype(v IntervalType) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	p.p["intervaltype"] = v
+	p.p["intervaltype"] = string(v)
 	return
 }
 
+// SetIntervaltypeIfUnset sets intervaltype to v, unless it is already set
+func (p *CreateSnapshotPolicyParams) SetIntervaltypeIfUnset(v IntervalType) {
+	if _, found := p.p["intervaltype"]; !found {
+		p.SetIntervaltype(v)
+	}
+}
+
 func (p *CreateSnapshotPolicyParams) SetMaxsnaps(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -236,6 +420,13 @@ func (p *CreateSnapshotPolicyParams) SetMaxsnaps(v int) {
 	return
 }
 
+// SetMaxsnapsIfUnset sets maxsnaps to v, unless it is already set
+func (p *CreateSnapshotPolicyParams) SetMaxsnapsIfUnset(v int) {
+	if _, found := p.p["maxsnaps"]; !found {
+		p.SetMaxsnaps(v)
+	}
+}
+
 func (p *CreateSnapshotPolicyParams) SetSchedule(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -244,6 +435,13 @@ func (p *CreateSnapshotPolicyParams) SetSchedule(v string) {
 	return
 }
 
+// SetScheduleIfUnset sets schedule to v, unless it is already set
+func (p *CreateSnapshotPolicyParams) SetScheduleIfUnset(v string) {
+	if _, found := p.p["schedule"]; !found {
+		p.SetSchedule(v)
+	}
+}
+
 func (p *CreateSnapshotPolicyParams) SetTimezone(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -252,6 +450,13 @@ func (p *CreateSnapshotPolicyParams) SetTimezone(v string) {
 	return
 }
 
+// SetTimezoneIfUnset sets timezone to v, unless it is already set
+func (p *CreateSnapshotPolicyParams) SetTimezoneIfUnset(v string) {
+	if _, found := p.p["timezone"]; !found {
+		p.SetTimezone(v)
+	}
+}
+
 func (p *CreateSnapshotPolicyParams) SetVolumeid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -260,9 +465,17 @@ func (p *CreateSnapshotPolicyParams) SetVolumeid(v string) {
 	return
 }
 
+// SetVolumeidIfUnset sets volumeid to v, unless it is already set
+func (p *CreateSnapshotPolicyParams) SetVolumeidIfUnset(v string) {
+	if _, found := p.p["volumeid"]; !found {
+		p.SetVolumeid(v)
+	}
+}
+
 // You should always use this function to get a new CreateSnapshotPolicyParams instance,
-// as then you are sure you have configured all required params
-func (s *SnapshotService) NewCreateSnapshotPolicyParams(intervaltype string, maxsnaps int, schedule string, timezone string, volumeid string) *CreateSnapshotPolicyParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *SnapshotService) NewCreateSnapshotPolicyParams(intervaltype string, maxsnaps int, schedule string, timezone string, volumeid string, opts ...func(*CreateSnapshotPolicyParams)) *CreateSnapshotPolicyParams {
 	p := &CreateSnapshotPolicyParams{}
 	p.p = make(map[string]interface{})
 	p.p["intervaltype"] = intervaltype
@@ -270,12 +483,61 @@ func (s *SnapshotService) NewCreateSnapshotPolicyParams(intervaltype string, max
 	p.p["schedule"] = schedule
 	p.p["timezone"] = timezone
 	p.p["volumeid"] = volumeid
+	for _, fn := range opts {
+		fn(p)
+	}
+	return p
+}
+
+// CreateSnapshotPolicyOpts contains all params that can be set on a CreateSnapshotPolicyParams.
+// Optional params are pointers (or slices and maps) which are only set when not nil.
+type CreateSnapshotPolicyOpts struct {
+	Fordisplay   *bool
+	Intervaltype IntervalType
+	Maxsnaps     int
+	Schedule     string
+	Timezone     string
+	Volumeid     string
+}
+
+// NewCreateSnapshotPolicyParamsFromOpts is an alternative for NewCreateSnapshotPolicyParams which takes all params using
+// an options struct, as that is easier to read for APIs with many required params
+func (s *SnapshotService) NewCreateSnapshotPolicyParamsFromOpts(o CreateSnapshotPolicyOpts) *CreateSnapshotPolicyParams {
+	p := s.NewCreateSnapshotPolicyParams(string(o.Intervaltype), o.Maxsnaps, o.Schedule, o.Timezone, o.Volumeid)
+	if o.Fordisplay != nil {
+		p.p["fordisplay"] = *o.Fordisplay
+	}
 	return p
 }
 
 // Creates a snapshot policy for the account.
-func (s *SnapshotService) CreateSnapshotPolicy(p *CreateSnapshotPolicyParams) (*CreateSnapshotPolicyResponse, error) {
-	resp, err := s.cs.newRequest("createSnapshotPolicy", p.toURLValues())
+func (s *SnapshotService) CreateSnapshotPolicy(p *CreateSnapshotPolicyParams, opts ...OptionFunc) (*CreateSnapshotPolicyResponse, error) {
+	return s.CreateSnapshotPolicyWithContext(context.Background(), p, opts...)
+}
+
+// CreateSnapshotPolicyWithContext is the same as CreateSnapshotPolicy, but the request is cancelled when the context is done
+func (s *SnapshotService) CreateSnapshotPolicyWithContext(ctx context.Context, p *CreateSnapshotPolicyParams, opts ...OptionFunc) (*CreateSnapshotPolicyResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.CreateSnapshotPolicyRawWithContext(ctx, p.toURLValues())
+}
+
+// CreateSnapshotPolicyRaw is the same as CreateSnapshotPolicy, but takes the params as url.Values instead of typed params
+func (s *SnapshotService) CreateSnapshotPolicyRaw(v url.Values) (*CreateSnapshotPolicyResponse, error) {
+	return s.CreateSnapshotPolicyRawWithContext(context.Background(), v)
+}
+
+// CreateSnapshotPolicyRawWithContext is the same as CreateSnapshotPolicyRaw, but the request is cancelled when the context is done
+func (s *SnapshotService) CreateSnapshotPolicyRawWithContext(ctx context.Context, v url.Values) (*CreateSnapshotPolicyResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "createSnapshotPolicy", "createsnapshotpolicyresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -302,6 +564,16 @@ type CreateVMSnapshotParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *CreateVMSnapshotParams) Clone() *CreateVMSnapshotParams {
+	c := &CreateVMSnapshotParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *CreateVMSnapshotParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -327,6 +599,16 @@ func (p *CreateVMSnapshotParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *CreateVMSnapshotParams) validate() error {
+	for _, k := range []string{"virtualmachineid"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of createVMSnapshot", k)
+		}
+	}
+	return nil
+}
+
 func (p *CreateVMSnapshotParams) SetDescription(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -335,6 +617,13 @@ func (p *CreateVMSnapshotParams) SetDescription(v string) {
 	return
 }
 
+// SetDescriptionIfUnset sets description to v, unless it is already set
+func (p *CreateVMSnapshotParams) SetDescriptionIfUnset(v string) {
+	if _, found := p.p["description"]; !found {
+		p.SetDescription(v)
+	}
+}
+
 func (p *CreateVMSnapshotParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -343,6 +632,13 @@ func (p *CreateVMSnapshotParams) SetName(v string) {
 	return
 }
 
+// SetNameIfUnset sets name to v, unless it is already set
+func (p *CreateVMSnapshotParams) SetNameIfUnset(v string) {
+	if _, found := p.p["name"]; !found {
+		p.SetName(v)
+	}
+}
+
 func (p *CreateVMSnapshotParams) SetQuiescevm(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -351,6 +647,13 @@ func (p *CreateVMSnapshotParams) SetQuiescevm(v bool) {
 	return
 }
 
+// SetQuiescevmIfUnset sets quiescevm to v, unless it is already set
+func (p *CreateVMSnapshotParams) SetQuiescevmIfUnset(v bool) {
+	if _, found := p.p["quiescevm"]; !found {
+		p.SetQuiescevm(v)
+	}
+}
+
 func (p *CreateVMSnapshotParams) SetSnapshotmemory(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -359,6 +662,13 @@ func (p *CreateVMSnapshotParams) SetSnapshotmemory(v bool) {
 	return
 }
 
+// SetSnapshotmemoryIfUnset sets snapshotmemory to v, unless it is already set
+func (p *CreateVMSnapshotParams) SetSnapshotmemoryIfUnset(v bool) {
+	if _, found := p.p["snapshotmemory"]; !found {
+		p.SetSnapshotmemory(v)
+	}
+}
+
 func (p *CreateVMSnapshotParams) SetVirtualmachineid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -367,18 +677,54 @@ func (p *CreateVMSnapshotParams) SetVirtualmachineid(v string) {
 	return
 }
 
+// SetVirtualmachineidIfUnset sets virtualmachineid to v, unless it is already set
+func (p *CreateVMSnapshotParams) SetVirtualmachineidIfUnset(v string) {
+	if _, found := p.p["virtualmachineid"]; !found {
+		p.SetVirtualmachineid(v)
+	}
+}
+
 // You should always use this function to get a new CreateVMSnapshotParams instance,
-// as then you are sure you have configured all required params
-func (s *SnapshotService) NewCreateVMSnapshotParams(virtualmachineid string) *CreateVMSnapshotParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *SnapshotService) NewCreateVMSnapshotParams(virtualmachineid string, opts ...func(*CreateVMSnapshotParams)) *CreateVMSnapshotParams {
 	p := &CreateVMSnapshotParams{}
 	p.p = make(map[string]interface{})
 	p.p["virtualmachineid"] = virtualmachineid
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Creates snapshot for a vm.
-func (s *SnapshotService) CreateVMSnapshot(p *CreateVMSnapshotParams) (*CreateVMSnapshotResponse, error) {
-	resp, err := s.cs.newRequest("createVMSnapshot", p.toURLValues())
+func (s *SnapshotService) CreateVMSnapshot(p *CreateVMSnapshotParams, opts ...OptionFunc) (*CreateVMSnapshotResponse, error) {
+	return s.CreateVMSnapshotWithContext(context.Background(), p, opts...)
+}
+
+// CreateVMSnapshotWithContext is the same as CreateVMSnapshot, but the request is cancelled when the context is done
+func (s *SnapshotService) CreateVMSnapshotWithContext(ctx context.Context, p *CreateVMSnapshotParams, opts ...OptionFunc) (*CreateVMSnapshotResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.CreateVMSnapshotRawWithContext(ctx, p.toURLValues())
+}
+
+// CreateVMSnapshotRaw is the same as CreateVMSnapshot, but takes the params as url.Values instead of typed params
+func (s *SnapshotService) CreateVMSnapshotRaw(v url.Values) (*CreateVMSnapshotResponse, error) {
+	return s.CreateVMSnapshotRawWithContext(context.Background(), v)
+}
+
+// CreateVMSnapshotRawWithContext is the same as CreateVMSnapshotRaw, but the request is cancelled when the context is done
+func (s *SnapshotService) CreateVMSnapshotRawWithContext(ctx context.Context, v url.Values) (*CreateVMSnapshotResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "createVMSnapshot", "createvmsnapshotresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -390,9 +736,9 @@ func (s *SnapshotService) CreateVMSnapshot(p *CreateVMSnapshotParams) (*CreateVM
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -436,6 +782,16 @@ type DeleteSnapshotParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *DeleteSnapshotParams) Clone() *DeleteSnapshotParams {
+	c := &DeleteSnapshotParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeleteSnapshotParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -447,6 +803,16 @@ func (p *DeleteSnapshotParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *DeleteSnapshotParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of deleteSnapshot", k)
+		}
+	}
+	return nil
+}
+
 func (p *DeleteSnapshotParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -455,18 +821,54 @@ func (p *DeleteSnapshotParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *DeleteSnapshotParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 // You should always use this function to get a new DeleteSnapshotParams instance,
-// as then you are sure you have configured all required params
-func (s *SnapshotService) NewDeleteSnapshotParams(id string) *DeleteSnapshotParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *SnapshotService) NewDeleteSnapshotParams(id string, opts ...func(*DeleteSnapshotParams)) *DeleteSnapshotParams {
 	p := &DeleteSnapshotParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Deletes a snapshot of a disk volume.
-func (s *SnapshotService) DeleteSnapshot(p *DeleteSnapshotParams) (*DeleteSnapshotResponse, error) {
-	resp, err := s.cs.newRequest("deleteSnapshot", p.toURLValues())
+func (s *SnapshotService) DeleteSnapshot(p *DeleteSnapshotParams, opts ...OptionFunc) (*DeleteSnapshotResponse, error) {
+	return s.DeleteSnapshotWithContext(context.Background(), p, opts...)
+}
+
+// DeleteSnapshotWithContext is the same as DeleteSnapshot, but the request is cancelled when the context is done
+func (s *SnapshotService) DeleteSnapshotWithContext(ctx context.Context, p *DeleteSnapshotParams, opts ...OptionFunc) (*DeleteSnapshotResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.DeleteSnapshotRawWithContext(ctx, p.toURLValues())
+}
+
+// DeleteSnapshotRaw is the same as DeleteSnapshot, but takes the params as url.Values instead of typed params
+func (s *SnapshotService) DeleteSnapshotRaw(v url.Values) (*DeleteSnapshotResponse, error) {
+	return s.DeleteSnapshotRawWithContext(context.Background(), v)
+}
+
+// DeleteSnapshotRawWithContext is the same as DeleteSnapshotRaw, but the request is cancelled when the context is done
+func (s *SnapshotService) DeleteSnapshotRawWithContext(ctx context.Context, v url.Values) (*DeleteSnapshotResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteSnapshot", "deletesnapshotresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -478,9 +880,9 @@ func (s *SnapshotService) DeleteSnapshot(p *DeleteSnapshotParams) (*DeleteSnapsh
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -494,6 +896,34 @@ func (s *SnapshotService) DeleteSnapshot(p *DeleteSnapshotParams) (*DeleteSnapsh
 	return &r, nil
 }
 
+// WaitForSnapshotDeleted polls until the Snapshot with the given ID no longer exists, the timeout
+// expires or the context is cancelled. Deleted resources may still be listed for a short while
+// after the delete command finished, so this can be used to make sure they are really gone. The
+// options are passed to GetSnapshotByID, so a resource outside of the default scope can be found.
+func (s *SnapshotService) WaitForSnapshotDeleted(ctx context.Context, id string, timeout time.Duration, opts ...OptionFunc) error {
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	for {
+		_, count, err := s.GetSnapshotByID(id, opts...)
+		if count == 0 {
+			return nil
+		}
+		if count < 0 {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(2 * time.Second):
+		}
+	}
+}
+
 type DeleteSnapshotResponse struct {
 	JobID       string `json:"jobid"`
 	Displaytext string `json:"displaytext"`
@@ -504,6 +934,16 @@ type DeleteSnapshotPoliciesParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *DeleteSnapshotPoliciesParams) Clone() *DeleteSnapshotPoliciesParams {
+	c := &DeleteSnapshotPoliciesParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeleteSnapshotPoliciesParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -527,6 +967,13 @@ func (p *DeleteSnapshotPoliciesParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *DeleteSnapshotPoliciesParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 func (p *DeleteSnapshotPoliciesParams) SetIds(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -535,17 +982,54 @@ func (p *DeleteSnapshotPoliciesParams) SetIds(v []string) {
 	return
 }
 
+// SetIdsIfUnset sets ids to v, unless it is already set
+func (p *DeleteSnapshotPoliciesParams) SetIdsIfUnset(v []string) {
+	if _, found := p.p["ids"]; !found {
+		p.SetIds(v)
+	}
+}
+
+// SetIdsCSV splits v on commas and sets the resulting values
+func (p *DeleteSnapshotPoliciesParams) SetIdsCSV(v string) {
+	p.SetIds(splitCSV(v))
+}
+
 // You should always use this function to get a new DeleteSnapshotPoliciesParams instance,
-// as then you are sure you have configured all required params
-func (s *SnapshotService) NewDeleteSnapshotPoliciesParams() *DeleteSnapshotPoliciesParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *SnapshotService) NewDeleteSnapshotPoliciesParams(opts ...func(*DeleteSnapshotPoliciesParams)) *DeleteSnapshotPoliciesParams {
 	p := &DeleteSnapshotPoliciesParams{}
 	p.p = make(map[string]interface{})
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Deletes snapshot policies for the account.
-func (s *SnapshotService) DeleteSnapshotPolicies(p *DeleteSnapshotPoliciesParams) (*DeleteSnapshotPoliciesResponse, error) {
-	resp, err := s.cs.newRequest("deleteSnapshotPolicies", p.toURLValues())
+func (s *SnapshotService) DeleteSnapshotPolicies(p *DeleteSnapshotPoliciesParams, opts ...OptionFunc) (*DeleteSnapshotPoliciesResponse, error) {
+	return s.DeleteSnapshotPoliciesWithContext(context.Background(), p, opts...)
+}
+
+// DeleteSnapshotPoliciesWithContext is the same as DeleteSnapshotPolicies, but the request is cancelled when the context is done
+func (s *SnapshotService) DeleteSnapshotPoliciesWithContext(ctx context.Context, p *DeleteSnapshotPoliciesParams, opts ...OptionFunc) (*DeleteSnapshotPoliciesResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	return s.DeleteSnapshotPoliciesRawWithContext(ctx, p.toURLValues())
+}
+
+// DeleteSnapshotPoliciesRaw is the same as DeleteSnapshotPolicies, but takes the params as url.Values instead of typed params
+func (s *SnapshotService) DeleteSnapshotPoliciesRaw(v url.Values) (*DeleteSnapshotPoliciesResponse, error) {
+	return s.DeleteSnapshotPoliciesRawWithContext(context.Background(), v)
+}
+
+// DeleteSnapshotPoliciesRawWithContext is the same as DeleteSnapshotPoliciesRaw, but the request is cancelled when the context is done
+func (s *SnapshotService) DeleteSnapshotPoliciesRawWithContext(ctx context.Context, v url.Values) (*DeleteSnapshotPoliciesResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteSnapshotPolicies", "deletesnapshotpoliciesresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -586,6 +1070,16 @@ type DeleteVMSnapshotParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *DeleteVMSnapshotParams) Clone() *DeleteVMSnapshotParams {
+	c := &DeleteVMSnapshotParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeleteVMSnapshotParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -597,6 +1091,16 @@ func (p *DeleteVMSnapshotParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *DeleteVMSnapshotParams) validate() error {
+	for _, k := range []string{"vmsnapshotid"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of deleteVMSnapshot", k)
+		}
+	}
+	return nil
+}
+
 func (p *DeleteVMSnapshotParams) SetVmsnapshotid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -605,18 +1109,54 @@ func (p *DeleteVMSnapshotParams) SetVmsnapshotid(v string) {
 	return
 }
 
+// SetVmsnapshotidIfUnset sets vmsnapshotid to v, unless it is already set
+func (p *DeleteVMSnapshotParams) SetVmsnapshotidIfUnset(v string) {
+	if _, found := p.p["vmsnapshotid"]; !found {
+		p.SetVmsnapshotid(v)
+	}
+}
+
 // You should always use this function to get a new DeleteVMSnapshotParams instance,
-// as then you are sure you have configured all required params
-func (s *SnapshotService) NewDeleteVMSnapshotParams(vmsnapshotid string) *DeleteVMSnapshotParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *SnapshotService) NewDeleteVMSnapshotParams(vmsnapshotid string, opts ...func(*DeleteVMSnapshotParams)) *DeleteVMSnapshotParams {
 	p := &DeleteVMSnapshotParams{}
 	p.p = make(map[string]interface{})
 	p.p["vmsnapshotid"] = vmsnapshotid
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Deletes a vmsnapshot.
-func (s *SnapshotService) DeleteVMSnapshot(p *DeleteVMSnapshotParams) (*DeleteVMSnapshotResponse, error) {
-	resp, err := s.cs.newRequest("deleteVMSnapshot", p.toURLValues())
+func (s *SnapshotService) DeleteVMSnapshot(p *DeleteVMSnapshotParams, opts ...OptionFunc) (*DeleteVMSnapshotResponse, error) {
+	return s.DeleteVMSnapshotWithContext(context.Background(), p, opts...)
+}
+
+// DeleteVMSnapshotWithContext is the same as DeleteVMSnapshot, but the request is cancelled when the context is done
+func (s *SnapshotService) DeleteVMSnapshotWithContext(ctx context.Context, p *DeleteVMSnapshotParams, opts ...OptionFunc) (*DeleteVMSnapshotResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.DeleteVMSnapshotRawWithContext(ctx, p.toURLValues())
+}
+
+// DeleteVMSnapshotRaw is the same as DeleteVMSnapshot, but takes the params as url.Values instead of typed params
+func (s *SnapshotService) DeleteVMSnapshotRaw(v url.Values) (*DeleteVMSnapshotResponse, error) {
+	return s.DeleteVMSnapshotRawWithContext(context.Background(), v)
+}
+
+// DeleteVMSnapshotRawWithContext is the same as DeleteVMSnapshotRaw, but the request is cancelled when the context is done
+func (s *SnapshotService) DeleteVMSnapshotRawWithContext(ctx context.Context, v url.Values) (*DeleteVMSnapshotResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteVMSnapshot", "deletevmsnapshotresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -628,9 +1168,9 @@ func (s *SnapshotService) DeleteVMSnapshot(p *DeleteVMSnapshotParams) (*DeleteVM
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -654,6 +1194,16 @@ type ListSnapshotPoliciesParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ListSnapshotPoliciesParams) Clone() *ListSnapshotPoliciesParams {
+	c := &ListSnapshotPoliciesParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListSnapshotPoliciesParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -691,6 +1241,13 @@ func (p *ListSnapshotPoliciesParams) SetFordisplay(v bool) {
 	return
 }
 
+// SetFordisplayIfUnset sets fordisplay to v, unless it is already set
+func (p *ListSnapshotPoliciesParams) SetFordisplayIfUnset(v bool) {
+	if _, found := p.p["fordisplay"]; !found {
+		p.SetFordisplay(v)
+	}
+}
+
 func (p *ListSnapshotPoliciesParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -699,6 +1256,13 @@ func (p *ListSnapshotPoliciesParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *ListSnapshotPoliciesParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 func (p *ListSnapshotPoliciesParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -707,6 +1271,13 @@ func (p *ListSnapshotPoliciesParams) SetKeyword(v string) {
 	return
 }
 
+// SetKeywordIfUnset sets keyword to v, unless it is already set
+func (p *ListSnapshotPoliciesParams) SetKeywordIfUnset(v string) {
+	if _, found := p.p["keyword"]; !found {
+		p.SetKeyword(v)
+	}
+}
+
 func (p *ListSnapshotPoliciesParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -715,6 +1286,13 @@ func (p *ListSnapshotPoliciesParams) SetPage(v int) {
 	return
 }
 
+// SetPageIfUnset sets page to v, unless it is already set
+func (p *ListSnapshotPoliciesParams) SetPageIfUnset(v int) {
+	if _, found := p.p["page"]; !found {
+		p.SetPage(v)
+	}
+}
+
 func (p *ListSnapshotPoliciesParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -723,6 +1301,13 @@ func (p *ListSnapshotPoliciesParams) SetPagesize(v int) {
 	return
 }
 
+// SetPagesizeIfUnset sets pagesize to v, unless it is already set
+func (p *ListSnapshotPoliciesParams) SetPagesizeIfUnset(v int) {
+	if _, found := p.p["pagesize"]; !found {
+		p.SetPagesize(v)
+	}
+}
+
 func (p *ListSnapshotPoliciesParams) SetVolumeid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -731,14 +1316,32 @@ func (p *ListSnapshotPoliciesParams) SetVolumeid(v string) {
 	return
 }
 
+// SetVolumeidIfUnset sets volumeid to v, unless it is already set
+func (p *ListSnapshotPoliciesParams) SetVolumeidIfUnset(v string) {
+	if _, found := p.p["volumeid"]; !found {
+		p.SetVolumeid(v)
+	}
+}
+
 // You should always use this function to get a new ListSnapshotPoliciesParams instance,
-// as then you are sure you have configured all required params
-func (s *SnapshotService) NewListSnapshotPoliciesParams() *ListSnapshotPoliciesParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *SnapshotService) NewListSnapshotPoliciesParams(opts ...func(*ListSnapshotPoliciesParams)) *ListSnapshotPoliciesParams {
 	p := &ListSnapshotPoliciesParams{}
 	p.p = make(map[string]interface{})
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
+func (p *ListSnapshotPoliciesParams) setFirstMatch() {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p[firstMatchKey] = true
+}
+
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *SnapshotService) GetSnapshotPolicyByID(id string, opts ...OptionFunc) (*SnapshotPolicy, int, error) {
 	p := &ListSnapshotPoliciesParams{}
@@ -746,7 +1349,7 @@ func (s *SnapshotService) GetSnapshotPolicyByID(id string, opts ...OptionFunc) (
 
 	p.p["id"] = id
 
-	for _, fn := range append(s.cs.options, opts...) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
 		if err := fn(s.cs, p); err != nil {
 			return nil, -1, err
 		}
@@ -757,24 +1360,56 @@ func (s *SnapshotService) GetSnapshotPolicyByID(id string, opts ...OptionFunc) (
 		if strings.Contains(err.Error(), fmt.Sprintf(
 			"Invalid parameter id value=%s due to incorrect long value format, "+
 				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+			return nil, 0, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
 	}
 
 	if l.Count == 1 {
 		return l.SnapshotPolicies[0], l.Count, nil
 	}
+
+	if _, ok := p.p[firstMatchKey]; ok && len(l.SnapshotPolicies) > 0 {
+		return l.SnapshotPolicies[0], l.Count, nil
+	}
 	return nil, l.Count, fmt.Errorf("There is more then one result for SnapshotPolicy UUID: %s!", id)
 }
 
+// SnapshotPolicyExists returns true if a SnapshotPolicy with the given ID exists. When no match is found
+// it returns false, while any other error is returned as is.
+func (s *SnapshotService) SnapshotPolicyExists(id string, opts ...OptionFunc) (bool, error) {
+	_, count, err := s.GetSnapshotPolicyByID(id, opts...)
+	if errors.Is(err, ErrNotFound) {
+		return false, nil
+	}
+	if count > 0 {
+		return true, nil
+	}
+	return false, err
+}
+
 // Lists snapshot policies.
 func (s *SnapshotService) ListSnapshotPolicies(p *ListSnapshotPoliciesParams) (*ListSnapshotPoliciesResponse, error) {
-	resp, err := s.cs.newRequest("listSnapshotPolicies", p.toURLValues())
+	return s.ListSnapshotPoliciesWithContext(context.Background(), p)
+}
+
+// ListSnapshotPoliciesWithContext is the same as ListSnapshotPolicies, but the request is cancelled when the context is done
+func (s *SnapshotService) ListSnapshotPoliciesWithContext(ctx context.Context, p *ListSnapshotPoliciesParams) (*ListSnapshotPoliciesResponse, error) {
+	return s.ListSnapshotPoliciesRawWithContext(ctx, p.toURLValues())
+}
+
+// ListSnapshotPoliciesRaw is the same as ListSnapshotPolicies, but takes the params as url.Values instead of typed params
+func (s *SnapshotService) ListSnapshotPoliciesRaw(v url.Values) (*ListSnapshotPoliciesResponse, error) {
+	return s.ListSnapshotPoliciesRawWithContext(context.Background(), v)
+}
+
+// ListSnapshotPoliciesRawWithContext is the same as ListSnapshotPoliciesRaw, but the request is cancelled when the context is done
+func (s *SnapshotService) ListSnapshotPoliciesRawWithContext(ctx context.Context, v url.Values) (*ListSnapshotPoliciesResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "listSnapshotPolicies", "listsnapshotpoliciesresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -787,6 +1422,93 @@ func (s *SnapshotService) ListSnapshotPolicies(p *ListSnapshotPoliciesParams) (*
 	return &r, nil
 }
 
+// StreamSnapshotPolicies fetches the results of ListSnapshotPolicies page by page in the background and sends them on the
+// returned channel. Both returned channels are closed when done. If fetching a page fails, or the context
+// is done before all results are sent, the error is sent on the error channel and fetching stops. A
+// request for a page in flight is cancelled when the context is done. The page and pagesize set in the
+// params are ignored.
+func (s *SnapshotService) StreamSnapshotPolicies(ctx context.Context, p *ListSnapshotPoliciesParams) (<-chan *SnapshotPolicy, <-chan error) {
+	ch := make(chan *SnapshotPolicy)
+	errs := make(chan error, 1)
+
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesize(500)
+
+	go func() {
+		defer close(errs)
+		defer close(ch)
+
+		for page, count := 1, 0; ; page++ {
+			pp.SetPage(page)
+
+			l, err := s.ListSnapshotPoliciesWithContext(ctx, pp)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			for _, v := range l.SnapshotPolicies {
+				select {
+				case ch <- v:
+				case <-ctx.Done():
+					errs <- ctx.Err()
+					return
+				}
+			}
+
+			count += len(l.SnapshotPolicies)
+			if len(l.SnapshotPolicies) < 500 || count >= l.Count {
+				return
+			}
+		}
+	}()
+
+	return ch, errs
+}
+
+// ListSnapshotPoliciesWhere returns all results of ListSnapshotPolicies for which pred returns true. Note that the results are
+// filtered client-side, so all results matching the params are fetched first.
+// All pages are fetched, so the page and pagesize set in the params are ignored.
+func (s *SnapshotService) ListSnapshotPoliciesWhere(p *ListSnapshotPoliciesParams, pred func(*SnapshotPolicy) bool) ([]*SnapshotPolicy, error) {
+	var r []*SnapshotPolicy
+	ch, errs := s.StreamSnapshotPolicies(context.Background(), p)
+	for v := range ch {
+		if pred(v) {
+			r = append(r, v)
+		}
+	}
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ListAllSnapshotPolicies returns the results of ListSnapshotPolicies across all pages, by calling ListSnapshotPolicies page by page until all
+// results are fetched. The pagesize set in the params is used for every page (500 if not set), and the
+// page set in the params is ignored.
+func (s *SnapshotService) ListAllSnapshotPolicies(p *ListSnapshotPoliciesParams) ([]*SnapshotPolicy, error) {
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesizeIfUnset(500)
+	pagesize := pp.p["pagesize"].(int)
+
+	var r []*SnapshotPolicy
+	for page := 1; ; page++ {
+		pp.SetPage(page)
+
+		l, err := s.ListSnapshotPolicies(pp)
+		if err != nil {
+			return nil, err
+		}
+		r = append(r, l.SnapshotPolicies...)
+
+		if len(l.SnapshotPolicies) < pagesize || len(r) >= l.Count {
+			return r, nil
+		}
+	}
+}
+
 type ListSnapshotPoliciesResponse struct {
 	Count            int               `json:"count"`
 	SnapshotPolicies []*SnapshotPolicy `json:"snapshotpolicy"`
@@ -806,6 +1528,16 @@ type ListSnapshotsParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ListSnapshotsParams) Clone() *ListSnapshotsParams {
+	c := &ListSnapshotsParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListSnapshotsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -856,11 +1588,10 @@ func (p *ListSnapshotsParams) toURLValues() url.Values {
 		u.Set("snapshottype", v.(string))
 	}
 	if v, found := p.p["tags"]; found {
-		i := 0
-		for k, vv := range v.(map[string]string) {
+		m := v.(map[string]string)
+		for i, k := range getSortedKeysFromMap(m) {
 			u.Set(fmt.Sprintf("tags[%d].key", i), k)
-			u.Set(fmt.Sprintf("tags[%d].value", i), vv)
-			i++
+			u.Set(fmt.Sprintf("tags[%d].value", i), m[k])
 		}
 	}
 	if v, found := p.p["volumeid"]; found {
@@ -880,6 +1611,13 @@ func (p *ListSnapshotsParams) SetAccount(v string) {
 	return
 }
 
+// SetAccountIfUnset sets account to v, unless it is already set
+func (p *ListSnapshotsParams) SetAccountIfUnset(v string) {
+	if _, found := p.p["account"]; !found {
+		p.SetAccount(v)
+	}
+}
+
 func (p *ListSnapshotsParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -888,6 +1626,13 @@ func (p *ListSnapshotsParams) SetDomainid(v string) {
 	return
 }
 
+// SetDomainidIfUnset sets domainid to v, unless it is already set
+func (p *ListSnapshotsParams) SetDomainidIfUnset(v string) {
+	if _, found := p.p["domainid"]; !found {
+		p.SetDomainid(v)
+	}
+}
+
 func (p *ListSnapshotsParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -896,6 +1641,13 @@ func (p *ListSnapshotsParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *ListSnapshotsParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 func (p *ListSnapshotsParams) SetIds(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -904,12 +1656,31 @@ func (p *ListSnapshotsParams) SetIds(v []string) {
 	return
 }
 
-func (p *ListSnapshotsParams) SetIntervaltype(v string) {
+// SetIdsIfUnset sets ids to v, unless it is already set
+func (p *ListSnapshotsParams) SetIdsIfUnset(v []string) {
+	if _, found := p.p["ids"]; !found {
+		p.SetIds(v)
+	}
+}
+
+// SetIdsCSV splits v on commas and sets the resulting values
+func (p *ListSnapshotsParams) SetIdsCSV(v string) {
+	p.SetIds(splitCSV(v))
+}
+
+func (p *ListSnapshotsParams) SetIntervaltype(v IntervalType) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	p.p["intervaltype"] = v
-	return
+	p.p["intervaltype"] = string(v)
+	return
+}
+
+// SetIntervaltypeIfUnset sets intervaltype to v, unless it is already set
+func (p *ListSnapshotsParams) SetIntervaltypeIfUnset(v IntervalType) {
+	if _, found := p.p["intervaltype"]; !found {
+		p.SetIntervaltype(v)
+	}
 }
 
 func (p *ListSnapshotsParams) SetIsrecursive(v bool) {
@@ -920,6 +1691,13 @@ func (p *ListSnapshotsParams) SetIsrecursive(v bool) {
 	return
 }
 
+// SetIsrecursiveIfUnset sets isrecursive to v, unless it is already set
+func (p *ListSnapshotsParams) SetIsrecursiveIfUnset(v bool) {
+	if _, found := p.p["isrecursive"]; !found {
+		p.SetIsrecursive(v)
+	}
+}
+
 func (p *ListSnapshotsParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -928,6 +1706,13 @@ func (p *ListSnapshotsParams) SetKeyword(v string) {
 	return
 }
 
+// SetKeywordIfUnset sets keyword to v, unless it is already set
+func (p *ListSnapshotsParams) SetKeywordIfUnset(v string) {
+	if _, found := p.p["keyword"]; !found {
+		p.SetKeyword(v)
+	}
+}
+
 func (p *ListSnapshotsParams) SetListall(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -936,6 +1721,13 @@ func (p *ListSnapshotsParams) SetListall(v bool) {
 	return
 }
 
+// SetListallIfUnset sets listall to v, unless it is already set
+func (p *ListSnapshotsParams) SetListallIfUnset(v bool) {
+	if _, found := p.p["listall"]; !found {
+		p.SetListall(v)
+	}
+}
+
 func (p *ListSnapshotsParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -944,6 +1736,13 @@ func (p *ListSnapshotsParams) SetName(v string) {
 	return
 }
 
+// SetNameIfUnset sets name to v, unless it is already set
+func (p *ListSnapshotsParams) SetNameIfUnset(v string) {
+	if _, found := p.p["name"]; !found {
+		p.SetName(v)
+	}
+}
+
 func (p *ListSnapshotsParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -952,6 +1751,13 @@ func (p *ListSnapshotsParams) SetPage(v int) {
 	return
 }
 
+// SetPageIfUnset sets page to v, unless it is already set
+func (p *ListSnapshotsParams) SetPageIfUnset(v int) {
+	if _, found := p.p["page"]; !found {
+		p.SetPage(v)
+	}
+}
+
 func (p *ListSnapshotsParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -960,6 +1766,13 @@ func (p *ListSnapshotsParams) SetPagesize(v int) {
 	return
 }
 
+// SetPagesizeIfUnset sets pagesize to v, unless it is already set
+func (p *ListSnapshotsParams) SetPagesizeIfUnset(v int) {
+	if _, found := p.p["pagesize"]; !found {
+		p.SetPagesize(v)
+	}
+}
+
 func (p *ListSnapshotsParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -968,6 +1781,13 @@ func (p *ListSnapshotsParams) SetProjectid(v string) {
 	return
 }
 
+// SetProjectidIfUnset sets projectid to v, unless it is already set
+func (p *ListSnapshotsParams) SetProjectidIfUnset(v string) {
+	if _, found := p.p["projectid"]; !found {
+		p.SetProjectid(v)
+	}
+}
+
 func (p *ListSnapshotsParams) SetSnapshottype(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -976,6 +1796,13 @@ func (p *ListSnapshotsParams) SetSnapshottype(v string) {
 	return
 }
 
+// SetSnapshottypeIfUnset sets snapshottype to v, unless it is already set
+func (p *ListSnapshotsParams) SetSnapshottypeIfUnset(v string) {
+	if _, found := p.p["snapshottype"]; !found {
+		p.SetSnapshottype(v)
+	}
+}
+
 func (p *ListSnapshotsParams) SetTags(v map[string]string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -984,6 +1811,13 @@ func (p *ListSnapshotsParams) SetTags(v map[string]string) {
 	return
 }
 
+// SetTagsIfUnset sets tags to v, unless it is already set
+func (p *ListSnapshotsParams) SetTagsIfUnset(v map[string]string) {
+	if _, found := p.p["tags"]; !found {
+		p.SetTags(v)
+	}
+}
+
 func (p *ListSnapshotsParams) SetVolumeid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -992,6 +1826,13 @@ func (p *ListSnapshotsParams) SetVolumeid(v string) {
 	return
 }
 
+// SetVolumeidIfUnset sets volumeid to v, unless it is already set
+func (p *ListSnapshotsParams) SetVolumeidIfUnset(v string) {
+	if _, found := p.p["volumeid"]; !found {
+		p.SetVolumeid(v)
+	}
+}
+
 func (p *ListSnapshotsParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1000,14 +1841,34 @@ func (p *ListSnapshotsParams) SetZoneid(v string) {
 	return
 }
 
+// SetZoneidIfUnset sets zoneid to v, unless it is already set
+func (p *ListSnapshotsParams) SetZoneidIfUnset(v string) {
+	if _, found := p.p["zoneid"]; !found {
+		p.SetZoneid(v)
+	}
+}
+
+var _ ListAllSetter = (*ListSnapshotsParams)(nil)
+
 // You should always use this function to get a new ListSnapshotsParams instance,
-// as then you are sure you have configured all required params
-func (s *SnapshotService) NewListSnapshotsParams() *ListSnapshotsParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *SnapshotService) NewListSnapshotsParams(opts ...func(*ListSnapshotsParams)) *ListSnapshotsParams {
 	p := &ListSnapshotsParams{}
 	p.p = make(map[string]interface{})
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
+func (p *ListSnapshotsParams) setFirstMatch() {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p[firstMatchKey] = true
+}
+
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *SnapshotService) GetSnapshotID(name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListSnapshotsParams{}
@@ -1015,7 +1876,7 @@ func (s *SnapshotService) GetSnapshotID(name string, opts ...OptionFunc) (string
 
 	p.p["name"] = name
 
-	for _, fn := range append(s.cs.options, opts...) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
 		if err := fn(s.cs, p); err != nil {
 			return "", -1, err
 		}
@@ -1027,7 +1888,7 @@ func (s *SnapshotService) GetSnapshotID(name string, opts ...OptionFunc) (string
 	}
 
 	if l.Count == 0 {
-		return "", l.Count, fmt.Errorf("No match found for %s: %+v", name, l)
+		return "", l.Count, fmt.Errorf("%w for %s: %+v", ErrNotFound, name, l)
 	}
 
 	if l.Count == 1 {
@@ -1040,6 +1901,10 @@ func (s *SnapshotService) GetSnapshotID(name string, opts ...OptionFunc) (string
 				return v.Id, l.Count, nil
 			}
 		}
+
+		if _, ok := p.p[firstMatchKey]; ok && len(l.Snapshots) > 0 {
+			return l.Snapshots[0].Id, l.Count, nil
+		}
 	}
 	return "", l.Count, fmt.Errorf("Could not find an exact match for %s: %+v", name, l)
 }
@@ -1065,7 +1930,7 @@ func (s *SnapshotService) GetSnapshotByID(id string, opts ...OptionFunc) (*Snaps
 
 	p.p["id"] = id
 
-	for _, fn := range append(s.cs.options, opts...) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
 		if err := fn(s.cs, p); err != nil {
 			return nil, -1, err
 		}
@@ -1076,24 +1941,60 @@ func (s *SnapshotService) GetSnapshotByID(id string, opts ...OptionFunc) (*Snaps
 		if strings.Contains(err.Error(), fmt.Sprintf(
 			"Invalid parameter id value=%s due to incorrect long value format, "+
 				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+			return nil, 0, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
 	}
 
 	if l.Count == 1 {
 		return l.Snapshots[0], l.Count, nil
 	}
+
+	if _, ok := p.p[firstMatchKey]; ok && len(l.Snapshots) > 0 {
+		return l.Snapshots[0], l.Count, nil
+	}
 	return nil, l.Count, fmt.Errorf("There is more then one result for Snapshot UUID: %s!", id)
 }
 
+// SnapshotExists returns true if a Snapshot with the given ID exists. When no match is found
+// it returns false, while any other error is returned as is.
+func (s *SnapshotService) SnapshotExists(id string, opts ...OptionFunc) (bool, error) {
+	_, count, err := s.GetSnapshotByID(id, opts...)
+	if errors.Is(err, ErrNotFound) {
+		return false, nil
+	}
+	if count > 0 {
+		return true, nil
+	}
+	return false, err
+}
+
 // Lists all available snapshots for the account.
 func (s *SnapshotService) ListSnapshots(p *ListSnapshotsParams) (*ListSnapshotsResponse, error) {
-	resp, err := s.cs.newRequest("listSnapshots", p.toURLValues())
+	return s.ListSnapshotsWithContext(context.Background(), p)
+}
+
+// ListSnapshotsWithContext is the same as ListSnapshots, but the request is cancelled when the context is done
+func (s *SnapshotService) ListSnapshotsWithContext(ctx context.Context, p *ListSnapshotsParams) (*ListSnapshotsResponse, error) {
+	if err := checkMutuallyExclusive(p.p, "account", "projectid"); err != nil {
+		return nil, err
+	}
+
+	return s.ListSnapshotsRawWithContext(ctx, p.toURLValues())
+}
+
+// ListSnapshotsRaw is the same as ListSnapshots, but takes the params as url.Values instead of typed params
+func (s *SnapshotService) ListSnapshotsRaw(v url.Values) (*ListSnapshotsResponse, error) {
+	return s.ListSnapshotsRawWithContext(context.Background(), v)
+}
+
+// ListSnapshotsRawWithContext is the same as ListSnapshotsRaw, but the request is cancelled when the context is done
+func (s *SnapshotService) ListSnapshotsRawWithContext(ctx context.Context, v url.Values) (*ListSnapshotsResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "listSnapshots", "listsnapshotsresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -1106,6 +2007,93 @@ func (s *SnapshotService) ListSnapshots(p *ListSnapshotsParams) (*ListSnapshotsR
 	return &r, nil
 }
 
+// StreamSnapshots fetches the results of ListSnapshots page by page in the background and sends them on the
+// returned channel. Both returned channels are closed when done. If fetching a page fails, or the context
+// is done before all results are sent, the error is sent on the error channel and fetching stops. A
+// request for a page in flight is cancelled when the context is done. The page and pagesize set in the
+// params are ignored.
+func (s *SnapshotService) StreamSnapshots(ctx context.Context, p *ListSnapshotsParams) (<-chan *Snapshot, <-chan error) {
+	ch := make(chan *Snapshot)
+	errs := make(chan error, 1)
+
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesize(500)
+
+	go func() {
+		defer close(errs)
+		defer close(ch)
+
+		for page, count := 1, 0; ; page++ {
+			pp.SetPage(page)
+
+			l, err := s.ListSnapshotsWithContext(ctx, pp)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			for _, v := range l.Snapshots {
+				select {
+				case ch <- v:
+				case <-ctx.Done():
+					errs <- ctx.Err()
+					return
+				}
+			}
+
+			count += len(l.Snapshots)
+			if len(l.Snapshots) < 500 || count >= l.Count {
+				return
+			}
+		}
+	}()
+
+	return ch, errs
+}
+
+// ListSnapshotsWhere returns all results of ListSnapshots for which pred returns true. Note that the results are
+// filtered client-side, so all results matching the params are fetched first.
+// All pages are fetched, so the page and pagesize set in the params are ignored.
+func (s *SnapshotService) ListSnapshotsWhere(p *ListSnapshotsParams, pred func(*Snapshot) bool) ([]*Snapshot, error) {
+	var r []*Snapshot
+	ch, errs := s.StreamSnapshots(context.Background(), p)
+	for v := range ch {
+		if pred(v) {
+			r = append(r, v)
+		}
+	}
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ListAllSnapshots returns the results of ListSnapshots across all pages, by calling ListSnapshots page by page until all
+// results are fetched. The pagesize set in the params is used for every page (500 if not set), and the
+// page set in the params is ignored.
+func (s *SnapshotService) ListAllSnapshots(p *ListSnapshotsParams) ([]*Snapshot, error) {
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesizeIfUnset(500)
+	pagesize := pp.p["pagesize"].(int)
+
+	var r []*Snapshot
+	for page := 1; ; page++ {
+		pp.SetPage(page)
+
+		l, err := s.ListSnapshots(pp)
+		if err != nil {
+			return nil, err
+		}
+		r = append(r, l.Snapshots...)
+
+		if len(l.Snapshots) < pagesize || len(r) >= l.Count {
+			return r, nil
+		}
+	}
+}
+
 type ListSnapshotsResponse struct {
 	Count     int         `json:"count"`
 	Snapshots []*Snapshot `json:"snapshot"`
@@ -1147,6 +2135,16 @@ type ListVMSnapshotParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ListVMSnapshotParams) Clone() *ListVMSnapshotParams {
+	c := &ListVMSnapshotParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListVMSnapshotParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1187,11 +2185,10 @@ func (p *ListVMSnapshotParams) toURLValues() url.Values {
 		u.Set("state", v.(string))
 	}
 	if v, found := p.p["tags"]; found {
-		i := 0
-		for k, vv := range v.(map[string]string) {
+		m := v.(map[string]string)
+		for i, k := range getSortedKeysFromMap(m) {
 			u.Set(fmt.Sprintf("tags[%d].key", i), k)
-			u.Set(fmt.Sprintf("tags[%d].value", i), vv)
-			i++
+			u.Set(fmt.Sprintf("tags[%d].value", i), m[k])
 		}
 	}
 	if v, found := p.p["virtualmachineid"]; found {
@@ -1215,6 +2212,13 @@ func (p *ListVMSnapshotParams) SetAccount(v string) {
 	return
 }
 
+// SetAccountIfUnset sets account to v, unless it is already set
+func (p *ListVMSnapshotParams) SetAccountIfUnset(v string) {
+	if _, found := p.p["account"]; !found {
+		p.SetAccount(v)
+	}
+}
+
 func (p *ListVMSnapshotParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1223,6 +2227,13 @@ func (p *ListVMSnapshotParams) SetDomainid(v string) {
 	return
 }
 
+// SetDomainidIfUnset sets domainid to v, unless it is already set
+func (p *ListVMSnapshotParams) SetDomainidIfUnset(v string) {
+	if _, found := p.p["domainid"]; !found {
+		p.SetDomainid(v)
+	}
+}
+
 func (p *ListVMSnapshotParams) SetIsrecursive(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1231,6 +2242,13 @@ func (p *ListVMSnapshotParams) SetIsrecursive(v bool) {
 	return
 }
 
+// SetIsrecursiveIfUnset sets isrecursive to v, unless it is already set
+func (p *ListVMSnapshotParams) SetIsrecursiveIfUnset(v bool) {
+	if _, found := p.p["isrecursive"]; !found {
+		p.SetIsrecursive(v)
+	}
+}
+
 func (p *ListVMSnapshotParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1239,6 +2257,13 @@ func (p *ListVMSnapshotParams) SetKeyword(v string) {
 	return
 }
 
+// SetKeywordIfUnset sets keyword to v, unless it is already set
+func (p *ListVMSnapshotParams) SetKeywordIfUnset(v string) {
+	if _, found := p.p["keyword"]; !found {
+		p.SetKeyword(v)
+	}
+}
+
 func (p *ListVMSnapshotParams) SetListall(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1247,6 +2272,13 @@ func (p *ListVMSnapshotParams) SetListall(v bool) {
 	return
 }
 
+// SetListallIfUnset sets listall to v, unless it is already set
+func (p *ListVMSnapshotParams) SetListallIfUnset(v bool) {
+	if _, found := p.p["listall"]; !found {
+		p.SetListall(v)
+	}
+}
+
 func (p *ListVMSnapshotParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1255,6 +2287,13 @@ func (p *ListVMSnapshotParams) SetName(v string) {
 	return
 }
 
+// SetNameIfUnset sets name to v, unless it is already set
+func (p *ListVMSnapshotParams) SetNameIfUnset(v string) {
+	if _, found := p.p["name"]; !found {
+		p.SetName(v)
+	}
+}
+
 func (p *ListVMSnapshotParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1263,6 +2302,13 @@ func (p *ListVMSnapshotParams) SetPage(v int) {
 	return
 }
 
+// SetPageIfUnset sets page to v, unless it is already set
+func (p *ListVMSnapshotParams) SetPageIfUnset(v int) {
+	if _, found := p.p["page"]; !found {
+		p.SetPage(v)
+	}
+}
+
 func (p *ListVMSnapshotParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1271,6 +2317,13 @@ func (p *ListVMSnapshotParams) SetPagesize(v int) {
 	return
 }
 
+// SetPagesizeIfUnset sets pagesize to v, unless it is already set
+func (p *ListVMSnapshotParams) SetPagesizeIfUnset(v int) {
+	if _, found := p.p["pagesize"]; !found {
+		p.SetPagesize(v)
+	}
+}
+
 func (p *ListVMSnapshotParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1279,6 +2332,13 @@ func (p *ListVMSnapshotParams) SetProjectid(v string) {
 	return
 }
 
+// SetProjectidIfUnset sets projectid to v, unless it is already set
+func (p *ListVMSnapshotParams) SetProjectidIfUnset(v string) {
+	if _, found := p.p["projectid"]; !found {
+		p.SetProjectid(v)
+	}
+}
+
 func (p *ListVMSnapshotParams) SetState(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1287,6 +2347,13 @@ func (p *ListVMSnapshotParams) SetState(v string) {
 	return
 }
 
+// SetStateIfUnset sets state to v, unless it is already set
+func (p *ListVMSnapshotParams) SetStateIfUnset(v string) {
+	if _, found := p.p["state"]; !found {
+		p.SetState(v)
+	}
+}
+
 func (p *ListVMSnapshotParams) SetTags(v map[string]string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1295,6 +2362,13 @@ func (p *ListVMSnapshotParams) SetTags(v map[string]string) {
 	return
 }
 
+// SetTagsIfUnset sets tags to v, unless it is already set
+func (p *ListVMSnapshotParams) SetTagsIfUnset(v map[string]string) {
+	if _, found := p.p["tags"]; !found {
+		p.SetTags(v)
+	}
+}
+
 func (p *ListVMSnapshotParams) SetVirtualmachineid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1303,6 +2377,13 @@ func (p *ListVMSnapshotParams) SetVirtualmachineid(v string) {
 	return
 }
 
+// SetVirtualmachineidIfUnset sets virtualmachineid to v, unless it is already set
+func (p *ListVMSnapshotParams) SetVirtualmachineidIfUnset(v string) {
+	if _, found := p.p["virtualmachineid"]; !found {
+		p.SetVirtualmachineid(v)
+	}
+}
+
 func (p *ListVMSnapshotParams) SetVmsnapshotid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1311,6 +2392,13 @@ func (p *ListVMSnapshotParams) SetVmsnapshotid(v string) {
 	return
 }
 
+// SetVmsnapshotidIfUnset sets vmsnapshotid to v, unless it is already set
+func (p *ListVMSnapshotParams) SetVmsnapshotidIfUnset(v string) {
+	if _, found := p.p["vmsnapshotid"]; !found {
+		p.SetVmsnapshotid(v)
+	}
+}
+
 func (p *ListVMSnapshotParams) SetVmsnapshotids(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1319,14 +2407,39 @@ func (p *ListVMSnapshotParams) SetVmsnapshotids(v []string) {
 	return
 }
 
+// SetVmsnapshotidsIfUnset sets vmsnapshotids to v, unless it is already set
+func (p *ListVMSnapshotParams) SetVmsnapshotidsIfUnset(v []string) {
+	if _, found := p.p["vmsnapshotids"]; !found {
+		p.SetVmsnapshotids(v)
+	}
+}
+
+// SetVmsnapshotidsCSV splits v on commas and sets the resulting values
+func (p *ListVMSnapshotParams) SetVmsnapshotidsCSV(v string) {
+	p.SetVmsnapshotids(splitCSV(v))
+}
+
+var _ ListAllSetter = (*ListVMSnapshotParams)(nil)
+
 // You should always use this function to get a new ListVMSnapshotParams instance,
-// as then you are sure you have configured all required params
-func (s *SnapshotService) NewListVMSnapshotParams() *ListVMSnapshotParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *SnapshotService) NewListVMSnapshotParams(opts ...func(*ListVMSnapshotParams)) *ListVMSnapshotParams {
 	p := &ListVMSnapshotParams{}
 	p.p = make(map[string]interface{})
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
+func (p *ListVMSnapshotParams) setFirstMatch() {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p[firstMatchKey] = true
+}
+
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *SnapshotService) GetVMSnapshotID(name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListVMSnapshotParams{}
@@ -1334,7 +2447,7 @@ func (s *SnapshotService) GetVMSnapshotID(name string, opts ...OptionFunc) (stri
 
 	p.p["name"] = name
 
-	for _, fn := range append(s.cs.options, opts...) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
 		if err := fn(s.cs, p); err != nil {
 			return "", -1, err
 		}
@@ -1346,7 +2459,7 @@ func (s *SnapshotService) GetVMSnapshotID(name string, opts ...OptionFunc) (stri
 	}
 
 	if l.Count == 0 {
-		return "", l.Count, fmt.Errorf("No match found for %s: %+v", name, l)
+		return "", l.Count, fmt.Errorf("%w for %s: %+v", ErrNotFound, name, l)
 	}
 
 	if l.Count == 1 {
@@ -1359,13 +2472,32 @@ func (s *SnapshotService) GetVMSnapshotID(name string, opts ...OptionFunc) (stri
 				return v.Id, l.Count, nil
 			}
 		}
+
+		if _, ok := p.p[firstMatchKey]; ok && len(l.VMSnapshot) > 0 {
+			return l.VMSnapshot[0].Id, l.Count, nil
+		}
 	}
 	return "", l.Count, fmt.Errorf("Could not find an exact match for %s: %+v", name, l)
 }
 
 // List virtual machine snapshot by conditions
 func (s *SnapshotService) ListVMSnapshot(p *ListVMSnapshotParams) (*ListVMSnapshotResponse, error) {
-	resp, err := s.cs.newRequest("listVMSnapshot", p.toURLValues())
+	return s.ListVMSnapshotWithContext(context.Background(), p)
+}
+
+// ListVMSnapshotWithContext is the same as ListVMSnapshot, but the request is cancelled when the context is done
+func (s *SnapshotService) ListVMSnapshotWithContext(ctx context.Context, p *ListVMSnapshotParams) (*ListVMSnapshotResponse, error) {
+	return s.ListVMSnapshotRawWithContext(ctx, p.toURLValues())
+}
+
+// ListVMSnapshotRaw is the same as ListVMSnapshot, but takes the params as url.Values instead of typed params
+func (s *SnapshotService) ListVMSnapshotRaw(v url.Values) (*ListVMSnapshotResponse, error) {
+	return s.ListVMSnapshotRawWithContext(context.Background(), v)
+}
+
+// ListVMSnapshotRawWithContext is the same as ListVMSnapshotRaw, but the request is cancelled when the context is done
+func (s *SnapshotService) ListVMSnapshotRawWithContext(ctx context.Context, v url.Values) (*ListVMSnapshotResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "listVMSnapshot", "listvmsnapshotresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -1378,6 +2510,93 @@ func (s *SnapshotService) ListVMSnapshot(p *ListVMSnapshotParams) (*ListVMSnapsh
 	return &r, nil
 }
 
+// StreamVMSnapshot fetches the results of ListVMSnapshot page by page in the background and sends them on the
+// returned channel. Both returned channels are closed when done. If fetching a page fails, or the context
+// is done before all results are sent, the error is sent on the error channel and fetching stops. A
+// request for a page in flight is cancelled when the context is done. The page and pagesize set in the
+// params are ignored.
+func (s *SnapshotService) StreamVMSnapshot(ctx context.Context, p *ListVMSnapshotParams) (<-chan *VMSnapshot, <-chan error) {
+	ch := make(chan *VMSnapshot)
+	errs := make(chan error, 1)
+
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesize(500)
+
+	go func() {
+		defer close(errs)
+		defer close(ch)
+
+		for page, count := 1, 0; ; page++ {
+			pp.SetPage(page)
+
+			l, err := s.ListVMSnapshotWithContext(ctx, pp)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			for _, v := range l.VMSnapshot {
+				select {
+				case ch <- v:
+				case <-ctx.Done():
+					errs <- ctx.Err()
+					return
+				}
+			}
+
+			count += len(l.VMSnapshot)
+			if len(l.VMSnapshot) < 500 || count >= l.Count {
+				return
+			}
+		}
+	}()
+
+	return ch, errs
+}
+
+// ListVMSnapshotWhere returns all results of ListVMSnapshot for which pred returns true. Note that the results are
+// filtered client-side, so all results matching the params are fetched first.
+// All pages are fetched, so the page and pagesize set in the params are ignored.
+func (s *SnapshotService) ListVMSnapshotWhere(p *ListVMSnapshotParams, pred func(*VMSnapshot) bool) ([]*VMSnapshot, error) {
+	var r []*VMSnapshot
+	ch, errs := s.StreamVMSnapshot(context.Background(), p)
+	for v := range ch {
+		if pred(v) {
+			r = append(r, v)
+		}
+	}
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ListAllVMSnapshot returns the results of ListVMSnapshot across all pages, by calling ListVMSnapshot page by page until all
+// results are fetched. The pagesize set in the params is used for every page (500 if not set), and the
+// page set in the params is ignored.
+func (s *SnapshotService) ListAllVMSnapshot(p *ListVMSnapshotParams) ([]*VMSnapshot, error) {
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesizeIfUnset(500)
+	pagesize := pp.p["pagesize"].(int)
+
+	var r []*VMSnapshot
+	for page := 1; ; page++ {
+		pp.SetPage(page)
+
+		l, err := s.ListVMSnapshot(pp)
+		if err != nil {
+			return nil, err
+		}
+		r = append(r, l.VMSnapshot...)
+
+		if len(l.VMSnapshot) < pagesize || len(r) >= l.Count {
+			return r, nil
+		}
+	}
+}
+
 type ListVMSnapshotResponse struct {
 	Count      int           `json:"count"`
 	VMSnapshot []*VMSnapshot `json:"vmsnapshot"`
@@ -1407,6 +2626,16 @@ type RevertSnapshotParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *RevertSnapshotParams) Clone() *RevertSnapshotParams {
+	c := &RevertSnapshotParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *RevertSnapshotParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1418,6 +2647,16 @@ func (p *RevertSnapshotParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *RevertSnapshotParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of revertSnapshot", k)
+		}
+	}
+	return nil
+}
+
 func (p *RevertSnapshotParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1426,18 +2665,54 @@ func (p *RevertSnapshotParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *RevertSnapshotParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 // You should always use this function to get a new RevertSnapshotParams instance,
-// as then you are sure you have configured all required params
-func (s *SnapshotService) NewRevertSnapshotParams(id string) *RevertSnapshotParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *SnapshotService) NewRevertSnapshotParams(id string, opts ...func(*RevertSnapshotParams)) *RevertSnapshotParams {
 	p := &RevertSnapshotParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // This is supposed to revert a volume snapshot. This command is only supported with KVM so far
-func (s *SnapshotService) RevertSnapshot(p *RevertSnapshotParams) (*RevertSnapshotResponse, error) {
-	resp, err := s.cs.newRequest("revertSnapshot", p.toURLValues())
+func (s *SnapshotService) RevertSnapshot(p *RevertSnapshotParams, opts ...OptionFunc) (*RevertSnapshotResponse, error) {
+	return s.RevertSnapshotWithContext(context.Background(), p, opts...)
+}
+
+// RevertSnapshotWithContext is the same as RevertSnapshot, but the request is cancelled when the context is done
+func (s *SnapshotService) RevertSnapshotWithContext(ctx context.Context, p *RevertSnapshotParams, opts ...OptionFunc) (*RevertSnapshotResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.RevertSnapshotRawWithContext(ctx, p.toURLValues())
+}
+
+// RevertSnapshotRaw is the same as RevertSnapshot, but takes the params as url.Values instead of typed params
+func (s *SnapshotService) RevertSnapshotRaw(v url.Values) (*RevertSnapshotResponse, error) {
+	return s.RevertSnapshotRawWithContext(context.Background(), v)
+}
+
+// RevertSnapshotRawWithContext is the same as RevertSnapshotRaw, but the request is cancelled when the context is done
+func (s *SnapshotService) RevertSnapshotRawWithContext(ctx context.Context, v url.Values) (*RevertSnapshotResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "revertSnapshot", "revertsnapshotresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -1449,9 +2724,9 @@ func (s *SnapshotService) RevertSnapshot(p *RevertSnapshotParams) (*RevertSnapsh
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -1507,6 +2782,16 @@ type RevertToVMSnapshotParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *RevertToVMSnapshotParams) Clone() *RevertToVMSnapshotParams {
+	c := &RevertToVMSnapshotParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *RevertToVMSnapshotParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1518,6 +2803,16 @@ func (p *RevertToVMSnapshotParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *RevertToVMSnapshotParams) validate() error {
+	for _, k := range []string{"vmsnapshotid"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of revertToVMSnapshot", k)
+		}
+	}
+	return nil
+}
+
 func (p *RevertToVMSnapshotParams) SetVmsnapshotid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1526,18 +2821,54 @@ func (p *RevertToVMSnapshotParams) SetVmsnapshotid(v string) {
 	return
 }
 
+// SetVmsnapshotidIfUnset sets vmsnapshotid to v, unless it is already set
+func (p *RevertToVMSnapshotParams) SetVmsnapshotidIfUnset(v string) {
+	if _, found := p.p["vmsnapshotid"]; !found {
+		p.SetVmsnapshotid(v)
+	}
+}
+
 // You should always use this function to get a new RevertToVMSnapshotParams instance,
-// as then you are sure you have configured all required params
-func (s *SnapshotService) NewRevertToVMSnapshotParams(vmsnapshotid string) *RevertToVMSnapshotParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *SnapshotService) NewRevertToVMSnapshotParams(vmsnapshotid string, opts ...func(*RevertToVMSnapshotParams)) *RevertToVMSnapshotParams {
 	p := &RevertToVMSnapshotParams{}
 	p.p = make(map[string]interface{})
 	p.p["vmsnapshotid"] = vmsnapshotid
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Revert VM from a vmsnapshot.
-func (s *SnapshotService) RevertToVMSnapshot(p *RevertToVMSnapshotParams) (*RevertToVMSnapshotResponse, error) {
-	resp, err := s.cs.newRequest("revertToVMSnapshot", p.toURLValues())
+func (s *SnapshotService) RevertToVMSnapshot(p *RevertToVMSnapshotParams, opts ...OptionFunc) (*RevertToVMSnapshotResponse, error) {
+	return s.RevertToVMSnapshotWithContext(context.Background(), p, opts...)
+}
+
+// RevertToVMSnapshotWithContext is the same as RevertToVMSnapshot, but the request is cancelled when the context is done
+func (s *SnapshotService) RevertToVMSnapshotWithContext(ctx context.Context, p *RevertToVMSnapshotParams, opts ...OptionFunc) (*RevertToVMSnapshotResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.RevertToVMSnapshotRawWithContext(ctx, p.toURLValues())
+}
+
+// RevertToVMSnapshotRaw is the same as RevertToVMSnapshot, but takes the params as url.Values instead of typed params
+func (s *SnapshotService) RevertToVMSnapshotRaw(v url.Values) (*RevertToVMSnapshotResponse, error) {
+	return s.RevertToVMSnapshotRawWithContext(context.Background(), v)
+}
+
+// RevertToVMSnapshotRawWithContext is the same as RevertToVMSnapshotRaw, but the request is cancelled when the context is done
+func (s *SnapshotService) RevertToVMSnapshotRawWithContext(ctx context.Context, v url.Values) (*RevertToVMSnapshotResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "revertToVMSnapshot", "reverttovmsnapshotresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -1549,9 +2880,9 @@ func (s *SnapshotService) RevertToVMSnapshot(p *RevertToVMSnapshotParams) (*Reve
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -1585,43 +2916,43 @@ type RevertToVMSnapshotResponse struct {
 		Type              string   `json:"type"`
 		VirtualmachineIds []string `json:"virtualmachineIds"`
 	} `json:"affinitygroup"`
-	Cpunumber             int               `json:"cpunumber"`
-	Cpuspeed              int               `json:"cpuspeed"`
-	Cpuused               string            `json:"cpuused"`
-	Created               string            `json:"created"`
-	Details               map[string]string `json:"details"`
-	Diskioread            int64             `json:"diskioread"`
-	Diskiowrite           int64             `json:"diskiowrite"`
-	Diskkbsread           int64             `json:"diskkbsread"`
-	Diskkbswrite          int64             `json:"diskkbswrite"`
-	Diskofferingid        string            `json:"diskofferingid"`
-	Diskofferingname      string            `json:"diskofferingname"`
-	Displayname           string            `json:"displayname"`
-	Displayvm             bool              `json:"displayvm"`
-	Domain                string            `json:"domain"`
-	Domainid              string            `json:"domainid"`
-	Forvirtualnetwork     bool              `json:"forvirtualnetwork"`
-	Group                 string            `json:"group"`
-	Groupid               string            `json:"groupid"`
-	Guestosid             string            `json:"guestosid"`
-	Haenable              bool              `json:"haenable"`
-	Hostid                string            `json:"hostid"`
-	Hostname              string            `json:"hostname"`
-	Hypervisor            string            `json:"hypervisor"`
-	Id                    string            `json:"id"`
-	Instancename          string            `json:"instancename"`
-	Isdynamicallyscalable bool              `json:"isdynamicallyscalable"`
-	Isodisplaytext        string            `json:"isodisplaytext"`
-	Isoid                 string            `json:"isoid"`
-	Isoname               string            `json:"isoname"`
-	Keypair               string            `json:"keypair"`
-	Memory                int               `json:"memory"`
-	Memoryintfreekbs      int64             `json:"memoryintfreekbs"`
-	Memorykbs             int64             `json:"memorykbs"`
-	Memorytargetkbs       int64             `json:"memorytargetkbs"`
-	Name                  string            `json:"name"`
-	Networkkbsread        int64             `json:"networkkbsread"`
-	Networkkbswrite       int64             `json:"networkkbswrite"`
+	Cpunumber             int       `json:"cpunumber"`
+	Cpuspeed              int       `json:"cpuspeed"`
+	Cpuused               string    `json:"cpuused"`
+	Created               string    `json:"created"`
+	Details               StringMap `json:"details"`
+	Diskioread            int64     `json:"diskioread"`
+	Diskiowrite           int64     `json:"diskiowrite"`
+	Diskkbsread           int64     `json:"diskkbsread"`
+	Diskkbswrite          int64     `json:"diskkbswrite"`
+	Diskofferingid        string    `json:"diskofferingid"`
+	Diskofferingname      string    `json:"diskofferingname"`
+	Displayname           string    `json:"displayname"`
+	Displayvm             bool      `json:"displayvm"`
+	Domain                string    `json:"domain"`
+	Domainid              string    `json:"domainid"`
+	Forvirtualnetwork     bool      `json:"forvirtualnetwork"`
+	Group                 string    `json:"group"`
+	Groupid               string    `json:"groupid"`
+	Guestosid             string    `json:"guestosid"`
+	Haenable              bool      `json:"haenable"`
+	Hostid                string    `json:"hostid"`
+	Hostname              string    `json:"hostname"`
+	Hypervisor            string    `json:"hypervisor"`
+	Id                    string    `json:"id"`
+	Instancename          string    `json:"instancename"`
+	Isdynamicallyscalable bool      `json:"isdynamicallyscalable"`
+	Isodisplaytext        string    `json:"isodisplaytext"`
+	Isoid                 string    `json:"isoid"`
+	Isoname               string    `json:"isoname"`
+	Keypair               string    `json:"keypair"`
+	Memory                int       `json:"memory"`
+	Memoryintfreekbs      int64     `json:"memoryintfreekbs"`
+	Memorykbs             int64     `json:"memorykbs"`
+	Memorytargetkbs       int64     `json:"memorytargetkbs"`
+	Name                  string    `json:"name"`
+	Networkkbsread        int64     `json:"networkkbsread"`
+	Networkkbswrite       int64     `json:"networkkbswrite"`
 	Nic                   []struct {
 		Broadcasturi         string `json:"broadcasturi"`
 		Deviceid             string `json:"deviceid"`
@@ -1744,6 +3075,16 @@ type UpdateSnapshotPolicyParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *UpdateSnapshotPolicyParams) Clone() *UpdateSnapshotPolicyParams {
+	c := &UpdateSnapshotPolicyParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *UpdateSnapshotPolicyParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1770,6 +3111,13 @@ func (p *UpdateSnapshotPolicyParams) SetCustomid(v string) {
 	return
 }
 
+// SetCustomidIfUnset sets customid to v, unless it is already set
+func (p *UpdateSnapshotPolicyParams) SetCustomidIfUnset(v string) {
+	if _, found := p.p["customid"]; !found {
+		p.SetCustomid(v)
+	}
+}
+
 func (p *UpdateSnapshotPolicyParams) SetFordisplay(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1778,6 +3126,13 @@ func (p *UpdateSnapshotPolicyParams) SetFordisplay(v bool) {
 	return
 }
 
+// SetFordisplayIfUnset sets fordisplay to v, unless it is already set
+func (p *UpdateSnapshotPolicyParams) SetFordisplayIfUnset(v bool) {
+	if _, found := p.p["fordisplay"]; !found {
+		p.SetFordisplay(v)
+	}
+}
+
 func (p *UpdateSnapshotPolicyParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1786,17 +3141,49 @@ func (p *UpdateSnapshotPolicyParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *UpdateSnapshotPolicyParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 // You should always use this function to get a new UpdateSnapshotPolicyParams instance,
-// as then you are sure you have configured all required params
-func (s *SnapshotService) NewUpdateSnapshotPolicyParams() *UpdateSnapshotPolicyParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *SnapshotService) NewUpdateSnapshotPolicyParams(opts ...func(*UpdateSnapshotPolicyParams)) *UpdateSnapshotPolicyParams {
 	p := &UpdateSnapshotPolicyParams{}
 	p.p = make(map[string]interface{})
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Updates the snapshot policy.
-func (s *SnapshotService) UpdateSnapshotPolicy(p *UpdateSnapshotPolicyParams) (*UpdateSnapshotPolicyResponse, error) {
-	resp, err := s.cs.newRequest("updateSnapshotPolicy", p.toURLValues())
+func (s *SnapshotService) UpdateSnapshotPolicy(p *UpdateSnapshotPolicyParams, opts ...OptionFunc) (*UpdateSnapshotPolicyResponse, error) {
+	return s.UpdateSnapshotPolicyWithContext(context.Background(), p, opts...)
+}
+
+// UpdateSnapshotPolicyWithContext is the same as UpdateSnapshotPolicy, but the request is cancelled when the context is done
+func (s *SnapshotService) UpdateSnapshotPolicyWithContext(ctx context.Context, p *UpdateSnapshotPolicyParams, opts ...OptionFunc) (*UpdateSnapshotPolicyResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	return s.UpdateSnapshotPolicyRawWithContext(ctx, p.toURLValues())
+}
+
+// UpdateSnapshotPolicyRaw is the same as UpdateSnapshotPolicy, but takes the params as url.Values instead of typed params
+func (s *SnapshotService) UpdateSnapshotPolicyRaw(v url.Values) (*UpdateSnapshotPolicyResponse, error) {
+	return s.UpdateSnapshotPolicyRawWithContext(context.Background(), v)
+}
+
+// UpdateSnapshotPolicyRawWithContext is the same as UpdateSnapshotPolicyRaw, but the request is cancelled when the context is done
+func (s *SnapshotService) UpdateSnapshotPolicyRawWithContext(ctx context.Context, v url.Values) (*UpdateSnapshotPolicyResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "updateSnapshotPolicy", "updatesnapshotpolicyresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -1808,9 +3195,9 @@ func (s *SnapshotService) UpdateSnapshotPolicy(p *UpdateSnapshotPolicyParams) (*
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
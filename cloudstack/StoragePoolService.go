@@ -17,7 +17,9 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
 	"net/url"
 	"strconv"
 )
@@ -26,6 +28,16 @@ type CancelStorageMaintenanceParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *CancelStorageMaintenanceParams) Clone() *CancelStorageMaintenanceParams {
+	c := &CancelStorageMaintenanceParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *CancelStorageMaintenanceParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -37,6 +49,16 @@ func (p *CancelStorageMaintenanceParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *CancelStorageMaintenanceParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of cancelStorageMaintenance", k)
+		}
+	}
+	return nil
+}
+
 func (p *CancelStorageMaintenanceParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -45,18 +67,54 @@ func (p *CancelStorageMaintenanceParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *CancelStorageMaintenanceParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 // You should always use this function to get a new CancelStorageMaintenanceParams instance,
-// as then you are sure you have configured all required params
-func (s *StoragePoolService) NewCancelStorageMaintenanceParams(id string) *CancelStorageMaintenanceParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *StoragePoolService) NewCancelStorageMaintenanceParams(id string, opts ...func(*CancelStorageMaintenanceParams)) *CancelStorageMaintenanceParams {
 	p := &CancelStorageMaintenanceParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Cancels maintenance for primary storage
-func (s *StoragePoolService) CancelStorageMaintenance(p *CancelStorageMaintenanceParams) (*CancelStorageMaintenanceResponse, error) {
-	resp, err := s.cs.newRequest("cancelStorageMaintenance", p.toURLValues())
+func (s *StoragePoolService) CancelStorageMaintenance(p *CancelStorageMaintenanceParams, opts ...OptionFunc) (*CancelStorageMaintenanceResponse, error) {
+	return s.CancelStorageMaintenanceWithContext(context.Background(), p, opts...)
+}
+
+// CancelStorageMaintenanceWithContext is the same as CancelStorageMaintenance, but the request is cancelled when the context is done
+func (s *StoragePoolService) CancelStorageMaintenanceWithContext(ctx context.Context, p *CancelStorageMaintenanceParams, opts ...OptionFunc) (*CancelStorageMaintenanceResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.CancelStorageMaintenanceRawWithContext(ctx, p.toURLValues())
+}
+
+// CancelStorageMaintenanceRaw is the same as CancelStorageMaintenance, but takes the params as url.Values instead of typed params
+func (s *StoragePoolService) CancelStorageMaintenanceRaw(v url.Values) (*CancelStorageMaintenanceResponse, error) {
+	return s.CancelStorageMaintenanceRawWithContext(context.Background(), v)
+}
+
+// CancelStorageMaintenanceRawWithContext is the same as CancelStorageMaintenanceRaw, but the request is cancelled when the context is done
+func (s *StoragePoolService) CancelStorageMaintenanceRawWithContext(ctx context.Context, v url.Values) (*CancelStorageMaintenanceResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "cancelStorageMaintenance", "cancelstoragemaintenanceresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -68,9 +126,9 @@ func (s *StoragePoolService) CancelStorageMaintenance(p *CancelStorageMaintenanc
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -90,36 +148,46 @@ func (s *StoragePoolService) CancelStorageMaintenance(p *CancelStorageMaintenanc
 }
 
 type CancelStorageMaintenanceResponse struct {
-	JobID                string            `json:"jobid"`
-	Capacityiops         int64             `json:"capacityiops"`
-	Clusterid            string            `json:"clusterid"`
-	Clustername          string            `json:"clustername"`
-	Created              string            `json:"created"`
-	Disksizeallocated    int64             `json:"disksizeallocated"`
-	Disksizetotal        int64             `json:"disksizetotal"`
-	Disksizeused         int64             `json:"disksizeused"`
-	Hypervisor           string            `json:"hypervisor"`
-	Id                   string            `json:"id"`
-	Ipaddress            string            `json:"ipaddress"`
-	Name                 string            `json:"name"`
-	Overprovisionfactor  string            `json:"overprovisionfactor"`
-	Path                 string            `json:"path"`
-	Podid                string            `json:"podid"`
-	Podname              string            `json:"podname"`
-	Scope                string            `json:"scope"`
-	State                string            `json:"state"`
-	Storagecapabilities  map[string]string `json:"storagecapabilities"`
-	Suitableformigration bool              `json:"suitableformigration"`
-	Tags                 string            `json:"tags"`
-	Type                 string            `json:"type"`
-	Zoneid               string            `json:"zoneid"`
-	Zonename             string            `json:"zonename"`
+	JobID                string    `json:"jobid"`
+	Capacityiops         int64     `json:"capacityiops"`
+	Clusterid            string    `json:"clusterid"`
+	Clustername          string    `json:"clustername"`
+	Created              string    `json:"created"`
+	Disksizeallocated    int64     `json:"disksizeallocated"`
+	Disksizetotal        int64     `json:"disksizetotal"`
+	Disksizeused         int64     `json:"disksizeused"`
+	Hypervisor           string    `json:"hypervisor"`
+	Id                   string    `json:"id"`
+	Ipaddress            string    `json:"ipaddress"`
+	Name                 string    `json:"name"`
+	Overprovisionfactor  string    `json:"overprovisionfactor"`
+	Path                 string    `json:"path"`
+	Podid                string    `json:"podid"`
+	Podname              string    `json:"podname"`
+	Scope                string    `json:"scope"`
+	State                string    `json:"state"`
+	Storagecapabilities  StringMap `json:"storagecapabilities"`
+	Suitableformigration bool      `json:"suitableformigration"`
+	Tags                 string    `json:"tags"`
+	Type                 string    `json:"type"`
+	Zoneid               string    `json:"zoneid"`
+	Zonename             string    `json:"zonename"`
 }
 
 type EnableStorageMaintenanceParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *EnableStorageMaintenanceParams) Clone() *EnableStorageMaintenanceParams {
+	c := &EnableStorageMaintenanceParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *EnableStorageMaintenanceParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -131,6 +199,16 @@ func (p *EnableStorageMaintenanceParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *EnableStorageMaintenanceParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of enableStorageMaintenance", k)
+		}
+	}
+	return nil
+}
+
 func (p *EnableStorageMaintenanceParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -139,18 +217,54 @@ func (p *EnableStorageMaintenanceParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *EnableStorageMaintenanceParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 // You should always use this function to get a new EnableStorageMaintenanceParams instance,
-// as then you are sure you have configured all required params
-func (s *StoragePoolService) NewEnableStorageMaintenanceParams(id string) *EnableStorageMaintenanceParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *StoragePoolService) NewEnableStorageMaintenanceParams(id string, opts ...func(*EnableStorageMaintenanceParams)) *EnableStorageMaintenanceParams {
 	p := &EnableStorageMaintenanceParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Puts storage pool into maintenance state
-func (s *StoragePoolService) EnableStorageMaintenance(p *EnableStorageMaintenanceParams) (*EnableStorageMaintenanceResponse, error) {
-	resp, err := s.cs.newRequest("enableStorageMaintenance", p.toURLValues())
+func (s *StoragePoolService) EnableStorageMaintenance(p *EnableStorageMaintenanceParams, opts ...OptionFunc) (*EnableStorageMaintenanceResponse, error) {
+	return s.EnableStorageMaintenanceWithContext(context.Background(), p, opts...)
+}
+
+// EnableStorageMaintenanceWithContext is the same as EnableStorageMaintenance, but the request is cancelled when the context is done
+func (s *StoragePoolService) EnableStorageMaintenanceWithContext(ctx context.Context, p *EnableStorageMaintenanceParams, opts ...OptionFunc) (*EnableStorageMaintenanceResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.EnableStorageMaintenanceRawWithContext(ctx, p.toURLValues())
+}
+
+// EnableStorageMaintenanceRaw is the same as EnableStorageMaintenance, but takes the params as url.Values instead of typed params
+func (s *StoragePoolService) EnableStorageMaintenanceRaw(v url.Values) (*EnableStorageMaintenanceResponse, error) {
+	return s.EnableStorageMaintenanceRawWithContext(context.Background(), v)
+}
+
+// EnableStorageMaintenanceRawWithContext is the same as EnableStorageMaintenanceRaw, but the request is cancelled when the context is done
+func (s *StoragePoolService) EnableStorageMaintenanceRawWithContext(ctx context.Context, v url.Values) (*EnableStorageMaintenanceResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "enableStorageMaintenance", "enablestoragemaintenanceresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -162,9 +276,9 @@ func (s *StoragePoolService) EnableStorageMaintenance(p *EnableStorageMaintenanc
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -184,36 +298,46 @@ func (s *StoragePoolService) EnableStorageMaintenance(p *EnableStorageMaintenanc
 }
 
 type EnableStorageMaintenanceResponse struct {
-	JobID                string            `json:"jobid"`
-	Capacityiops         int64             `json:"capacityiops"`
-	Clusterid            string            `json:"clusterid"`
-	Clustername          string            `json:"clustername"`
-	Created              string            `json:"created"`
-	Disksizeallocated    int64             `json:"disksizeallocated"`
-	Disksizetotal        int64             `json:"disksizetotal"`
-	Disksizeused         int64             `json:"disksizeused"`
-	Hypervisor           string            `json:"hypervisor"`
-	Id                   string            `json:"id"`
-	Ipaddress            string            `json:"ipaddress"`
-	Name                 string            `json:"name"`
-	Overprovisionfactor  string            `json:"overprovisionfactor"`
-	Path                 string            `json:"path"`
-	Podid                string            `json:"podid"`
-	Podname              string            `json:"podname"`
-	Scope                string            `json:"scope"`
-	State                string            `json:"state"`
-	Storagecapabilities  map[string]string `json:"storagecapabilities"`
-	Suitableformigration bool              `json:"suitableformigration"`
-	Tags                 string            `json:"tags"`
-	Type                 string            `json:"type"`
-	Zoneid               string            `json:"zoneid"`
-	Zonename             string            `json:"zonename"`
+	JobID                string    `json:"jobid"`
+	Capacityiops         int64     `json:"capacityiops"`
+	Clusterid            string    `json:"clusterid"`
+	Clustername          string    `json:"clustername"`
+	Created              string    `json:"created"`
+	Disksizeallocated    int64     `json:"disksizeallocated"`
+	Disksizetotal        int64     `json:"disksizetotal"`
+	Disksizeused         int64     `json:"disksizeused"`
+	Hypervisor           string    `json:"hypervisor"`
+	Id                   string    `json:"id"`
+	Ipaddress            string    `json:"ipaddress"`
+	Name                 string    `json:"name"`
+	Overprovisionfactor  string    `json:"overprovisionfactor"`
+	Path                 string    `json:"path"`
+	Podid                string    `json:"podid"`
+	Podname              string    `json:"podname"`
+	Scope                string    `json:"scope"`
+	State                string    `json:"state"`
+	Storagecapabilities  StringMap `json:"storagecapabilities"`
+	Suitableformigration bool      `json:"suitableformigration"`
+	Tags                 string    `json:"tags"`
+	Type                 string    `json:"type"`
+	Zoneid               string    `json:"zoneid"`
+	Zonename             string    `json:"zonename"`
 }
 
 type ListStorageProvidersParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ListStorageProvidersParams) Clone() *ListStorageProvidersParams {
+	c := &ListStorageProvidersParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListStorageProvidersParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -236,6 +360,16 @@ func (p *ListStorageProvidersParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *ListStorageProvidersParams) validate() error {
+	for _, k := range []string{"type"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of listStorageProviders", k)
+		}
+	}
+	return nil
+}
+
 func (p *ListStorageProvidersParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -244,6 +378,13 @@ func (p *ListStorageProvidersParams) SetKeyword(v string) {
 	return
 }
 
+// SetKeywordIfUnset sets keyword to v, unless it is already set
+func (p *ListStorageProvidersParams) SetKeywordIfUnset(v string) {
+	if _, found := p.p["keyword"]; !found {
+		p.SetKeyword(v)
+	}
+}
+
 func (p *ListStorageProvidersParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -252,6 +393,13 @@ func (p *ListStorageProvidersParams) SetPage(v int) {
 	return
 }
 
+// SetPageIfUnset sets page to v, unless it is already set
+func (p *ListStorageProvidersParams) SetPageIfUnset(v int) {
+	if _, found := p.p["page"]; !found {
+		p.SetPage(v)
+	}
+}
+
 func (p *ListStorageProvidersParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -260,6 +408,13 @@ func (p *ListStorageProvidersParams) SetPagesize(v int) {
 	return
 }
 
+// SetPagesizeIfUnset sets pagesize to v, unless it is already set
+func (p *ListStorageProvidersParams) SetPagesizeIfUnset(v int) {
+	if _, found := p.p["pagesize"]; !found {
+		p.SetPagesize(v)
+	}
+}
+
 func (p *ListStorageProvidersParams) SetType(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -268,18 +423,48 @@ func (p *ListStorageProvidersParams) SetType(v string) {
 	return
 }
 
+// SetTypeIfUnset sets type to v, unless it is already set
+func (p *ListStorageProvidersParams) SetTypeIfUnset(v string) {
+	if _, found := p.p["type"]; !found {
+		p.SetType(v)
+	}
+}
+
 // You should always use this function to get a new ListStorageProvidersParams instance,
-// as then you are sure you have configured all required params
-func (s *StoragePoolService) NewListStorageProvidersParams(storagePoolType string) *ListStorageProvidersParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *StoragePoolService) NewListStorageProvidersParams(storagePoolType string, opts ...func(*ListStorageProvidersParams)) *ListStorageProvidersParams {
 	p := &ListStorageProvidersParams{}
 	p.p = make(map[string]interface{})
 	p.p["type"] = storagePoolType
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Lists storage providers.
 func (s *StoragePoolService) ListStorageProviders(p *ListStorageProvidersParams) (*ListStorageProvidersResponse, error) {
-	resp, err := s.cs.newRequest("listStorageProviders", p.toURLValues())
+	return s.ListStorageProvidersWithContext(context.Background(), p)
+}
+
+// ListStorageProvidersWithContext is the same as ListStorageProviders, but the request is cancelled when the context is done
+func (s *StoragePoolService) ListStorageProvidersWithContext(ctx context.Context, p *ListStorageProvidersParams) (*ListStorageProvidersResponse, error) {
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.ListStorageProvidersRawWithContext(ctx, p.toURLValues())
+}
+
+// ListStorageProvidersRaw is the same as ListStorageProviders, but takes the params as url.Values instead of typed params
+func (s *StoragePoolService) ListStorageProvidersRaw(v url.Values) (*ListStorageProvidersResponse, error) {
+	return s.ListStorageProvidersRawWithContext(context.Background(), v)
+}
+
+// ListStorageProvidersRawWithContext is the same as ListStorageProvidersRaw, but the request is cancelled when the context is done
+func (s *StoragePoolService) ListStorageProvidersRawWithContext(ctx context.Context, v url.Values) (*ListStorageProvidersResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "listStorageProviders", "liststorageprovidersresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -292,6 +477,93 @@ func (s *StoragePoolService) ListStorageProviders(p *ListStorageProvidersParams)
 	return &r, nil
 }
 
+// StreamStorageProviders fetches the results of ListStorageProviders page by page in the background and sends them on the
+// returned channel. Both returned channels are closed when done. If fetching a page fails, or the context
+// is done before all results are sent, the error is sent on the error channel and fetching stops. A
+// request for a page in flight is cancelled when the context is done. The page and pagesize set in the
+// params are ignored.
+func (s *StoragePoolService) StreamStorageProviders(ctx context.Context, p *ListStorageProvidersParams) (<-chan *StorageProvider, <-chan error) {
+	ch := make(chan *StorageProvider)
+	errs := make(chan error, 1)
+
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesize(500)
+
+	go func() {
+		defer close(errs)
+		defer close(ch)
+
+		for page, count := 1, 0; ; page++ {
+			pp.SetPage(page)
+
+			l, err := s.ListStorageProvidersWithContext(ctx, pp)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			for _, v := range l.StorageProviders {
+				select {
+				case ch <- v:
+				case <-ctx.Done():
+					errs <- ctx.Err()
+					return
+				}
+			}
+
+			count += len(l.StorageProviders)
+			if len(l.StorageProviders) < 500 || count >= l.Count {
+				return
+			}
+		}
+	}()
+
+	return ch, errs
+}
+
+// ListStorageProvidersWhere returns all results of ListStorageProviders for which pred returns true. Note that the results are
+// filtered client-side, so all results matching the params are fetched first.
+// All pages are fetched, so the page and pagesize set in the params are ignored.
+func (s *StoragePoolService) ListStorageProvidersWhere(p *ListStorageProvidersParams, pred func(*StorageProvider) bool) ([]*StorageProvider, error) {
+	var r []*StorageProvider
+	ch, errs := s.StreamStorageProviders(context.Background(), p)
+	for v := range ch {
+		if pred(v) {
+			r = append(r, v)
+		}
+	}
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ListAllStorageProviders returns the results of ListStorageProviders across all pages, by calling ListStorageProviders page by page until all
+// results are fetched. The pagesize set in the params is used for every page (500 if not set), and the
+// page set in the params is ignored.
+func (s *StoragePoolService) ListAllStorageProviders(p *ListStorageProvidersParams) ([]*StorageProvider, error) {
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesizeIfUnset(500)
+	pagesize := pp.p["pagesize"].(int)
+
+	var r []*StorageProvider
+	for page := 1; ; page++ {
+		pp.SetPage(page)
+
+		l, err := s.ListStorageProviders(pp)
+		if err != nil {
+			return nil, err
+		}
+		r = append(r, l.StorageProviders...)
+
+		if len(l.StorageProviders) < pagesize || len(r) >= l.Count {
+			return r, nil
+		}
+	}
+}
+
 type ListStorageProvidersResponse struct {
 	Count            int                `json:"count"`
 	StorageProviders []*StorageProvider `json:"storageprovider"`
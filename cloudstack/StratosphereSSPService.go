@@ -17,7 +17,9 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
 	"net/url"
 )
 
@@ -25,6 +27,16 @@ type AddStratosphereSspParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *AddStratosphereSspParams) Clone() *AddStratosphereSspParams {
+	c := &AddStratosphereSspParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *AddStratosphereSspParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -51,6 +63,16 @@ func (p *AddStratosphereSspParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *AddStratosphereSspParams) validate() error {
+	for _, k := range []string{"name", "url", "zoneid"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of addStratosphereSsp", k)
+		}
+	}
+	return nil
+}
+
 func (p *AddStratosphereSspParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -59,6 +81,13 @@ func (p *AddStratosphereSspParams) SetName(v string) {
 	return
 }
 
+// SetNameIfUnset sets name to v, unless it is already set
+func (p *AddStratosphereSspParams) SetNameIfUnset(v string) {
+	if _, found := p.p["name"]; !found {
+		p.SetName(v)
+	}
+}
+
 func (p *AddStratosphereSspParams) SetPassword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -67,6 +96,13 @@ func (p *AddStratosphereSspParams) SetPassword(v string) {
 	return
 }
 
+// SetPasswordIfUnset sets password to v, unless it is already set
+func (p *AddStratosphereSspParams) SetPasswordIfUnset(v string) {
+	if _, found := p.p["password"]; !found {
+		p.SetPassword(v)
+	}
+}
+
 func (p *AddStratosphereSspParams) SetTenantuuid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -75,6 +111,13 @@ func (p *AddStratosphereSspParams) SetTenantuuid(v string) {
 	return
 }
 
+// SetTenantuuidIfUnset sets tenantuuid to v, unless it is already set
+func (p *AddStratosphereSspParams) SetTenantuuidIfUnset(v string) {
+	if _, found := p.p["tenantuuid"]; !found {
+		p.SetTenantuuid(v)
+	}
+}
+
 func (p *AddStratosphereSspParams) SetUrl(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -83,6 +126,13 @@ func (p *AddStratosphereSspParams) SetUrl(v string) {
 	return
 }
 
+// SetUrlIfUnset sets url to v, unless it is already set
+func (p *AddStratosphereSspParams) SetUrlIfUnset(v string) {
+	if _, found := p.p["url"]; !found {
+		p.SetUrl(v)
+	}
+}
+
 func (p *AddStratosphereSspParams) SetUsername(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -91,6 +141,13 @@ func (p *AddStratosphereSspParams) SetUsername(v string) {
 	return
 }
 
+// SetUsernameIfUnset sets username to v, unless it is already set
+func (p *AddStratosphereSspParams) SetUsernameIfUnset(v string) {
+	if _, found := p.p["username"]; !found {
+		p.SetUsername(v)
+	}
+}
+
 func (p *AddStratosphereSspParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -99,20 +156,83 @@ func (p *AddStratosphereSspParams) SetZoneid(v string) {
 	return
 }
 
+// SetZoneidIfUnset sets zoneid to v, unless it is already set
+func (p *AddStratosphereSspParams) SetZoneidIfUnset(v string) {
+	if _, found := p.p["zoneid"]; !found {
+		p.SetZoneid(v)
+	}
+}
+
 // You should always use this function to get a new AddStratosphereSspParams instance,
-// as then you are sure you have configured all required params
-func (s *StratosphereSSPService) NewAddStratosphereSspParams(name string, url string, zoneid string) *AddStratosphereSspParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *StratosphereSSPService) NewAddStratosphereSspParams(name string, url string, zoneid string, opts ...func(*AddStratosphereSspParams)) *AddStratosphereSspParams {
 	p := &AddStratosphereSspParams{}
 	p.p = make(map[string]interface{})
 	p.p["name"] = name
 	p.p["url"] = url
 	p.p["zoneid"] = zoneid
+	for _, fn := range opts {
+		fn(p)
+	}
+	return p
+}
+
+// AddStratosphereSspOpts contains all params that can be set on a AddStratosphereSspParams.
+// Optional params are pointers (or slices and maps) which are only set when not nil.
+type AddStratosphereSspOpts struct {
+	Name       string
+	Password   *string
+	Tenantuuid *string
+	Url        string
+	Username   *string
+	Zoneid     string
+}
+
+// NewAddStratosphereSspParamsFromOpts is an alternative for NewAddStratosphereSspParams which takes all params using
+// an options struct, as that is easier to read for APIs with many required params
+func (s *StratosphereSSPService) NewAddStratosphereSspParamsFromOpts(o AddStratosphereSspOpts) *AddStratosphereSspParams {
+	p := s.NewAddStratosphereSspParams(o.Name, o.Url, o.Zoneid)
+	if o.Password != nil {
+		p.p["password"] = *o.Password
+	}
+	if o.Tenantuuid != nil {
+		p.p["tenantuuid"] = *o.Tenantuuid
+	}
+	if o.Username != nil {
+		p.p["username"] = *o.Username
+	}
 	return p
 }
 
 // Adds stratosphere ssp server
-func (s *StratosphereSSPService) AddStratosphereSsp(p *AddStratosphereSspParams) (*AddStratosphereSspResponse, error) {
-	resp, err := s.cs.newRequest("addStratosphereSsp", p.toURLValues())
+func (s *StratosphereSSPService) AddStratosphereSsp(p *AddStratosphereSspParams, opts ...OptionFunc) (*AddStratosphereSspResponse, error) {
+	return s.AddStratosphereSspWithContext(context.Background(), p, opts...)
+}
+
+// AddStratosphereSspWithContext is the same as AddStratosphereSsp, but the request is cancelled when the context is done
+func (s *StratosphereSSPService) AddStratosphereSspWithContext(ctx context.Context, p *AddStratosphereSspParams, opts ...OptionFunc) (*AddStratosphereSspResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.AddStratosphereSspRawWithContext(ctx, p.toURLValues())
+}
+
+// AddStratosphereSspRaw is the same as AddStratosphereSsp, but takes the params as url.Values instead of typed params
+func (s *StratosphereSSPService) AddStratosphereSspRaw(v url.Values) (*AddStratosphereSspResponse, error) {
+	return s.AddStratosphereSspRawWithContext(context.Background(), v)
+}
+
+// AddStratosphereSspRawWithContext is the same as AddStratosphereSspRaw, but the request is cancelled when the context is done
+func (s *StratosphereSSPService) AddStratosphereSspRawWithContext(ctx context.Context, v url.Values) (*AddStratosphereSspResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "addStratosphereSsp", "addstratospheresspresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -136,6 +256,16 @@ type DeleteStratosphereSspParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *DeleteStratosphereSspParams) Clone() *DeleteStratosphereSspParams {
+	c := &DeleteStratosphereSspParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeleteStratosphereSspParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -147,6 +277,16 @@ func (p *DeleteStratosphereSspParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *DeleteStratosphereSspParams) validate() error {
+	for _, k := range []string{"hostid"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of deleteStratosphereSsp", k)
+		}
+	}
+	return nil
+}
+
 func (p *DeleteStratosphereSspParams) SetHostid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -155,18 +295,54 @@ func (p *DeleteStratosphereSspParams) SetHostid(v string) {
 	return
 }
 
+// SetHostidIfUnset sets hostid to v, unless it is already set
+func (p *DeleteStratosphereSspParams) SetHostidIfUnset(v string) {
+	if _, found := p.p["hostid"]; !found {
+		p.SetHostid(v)
+	}
+}
+
 // You should always use this function to get a new DeleteStratosphereSspParams instance,
-// as then you are sure you have configured all required params
-func (s *StratosphereSSPService) NewDeleteStratosphereSspParams(hostid string) *DeleteStratosphereSspParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *StratosphereSSPService) NewDeleteStratosphereSspParams(hostid string, opts ...func(*DeleteStratosphereSspParams)) *DeleteStratosphereSspParams {
 	p := &DeleteStratosphereSspParams{}
 	p.p = make(map[string]interface{})
 	p.p["hostid"] = hostid
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Removes stratosphere ssp server
-func (s *StratosphereSSPService) DeleteStratosphereSsp(p *DeleteStratosphereSspParams) (*DeleteStratosphereSspResponse, error) {
-	resp, err := s.cs.newRequest("deleteStratosphereSsp", p.toURLValues())
+func (s *StratosphereSSPService) DeleteStratosphereSsp(p *DeleteStratosphereSspParams, opts ...OptionFunc) (*DeleteStratosphereSspResponse, error) {
+	return s.DeleteStratosphereSspWithContext(context.Background(), p, opts...)
+}
+
+// DeleteStratosphereSspWithContext is the same as DeleteStratosphereSsp, but the request is cancelled when the context is done
+func (s *StratosphereSSPService) DeleteStratosphereSspWithContext(ctx context.Context, p *DeleteStratosphereSspParams, opts ...OptionFunc) (*DeleteStratosphereSspResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.DeleteStratosphereSspRawWithContext(ctx, p.toURLValues())
+}
+
+// DeleteStratosphereSspRaw is the same as DeleteStratosphereSsp, but takes the params as url.Values instead of typed params
+func (s *StratosphereSSPService) DeleteStratosphereSspRaw(v url.Values) (*DeleteStratosphereSspResponse, error) {
+	return s.DeleteStratosphereSspRawWithContext(context.Background(), v)
+}
+
+// DeleteStratosphereSspRawWithContext is the same as DeleteStratosphereSspRaw, but the request is cancelled when the context is done
+func (s *StratosphereSSPService) DeleteStratosphereSspRawWithContext(ctx context.Context, v url.Values) (*DeleteStratosphereSspResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteStratosphereSsp", "deletestratospheresspresponse", v)
 	if err != nil {
 		return nil, err
 	}
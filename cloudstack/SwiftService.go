@@ -17,6 +17,7 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
@@ -27,6 +28,16 @@ type AddSwiftParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *AddSwiftParams) Clone() *AddSwiftParams {
+	c := &AddSwiftParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *AddSwiftParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -47,6 +58,16 @@ func (p *AddSwiftParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *AddSwiftParams) validate() error {
+	for _, k := range []string{"url"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of addSwift", k)
+		}
+	}
+	return nil
+}
+
 func (p *AddSwiftParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -55,6 +76,13 @@ func (p *AddSwiftParams) SetAccount(v string) {
 	return
 }
 
+// SetAccountIfUnset sets account to v, unless it is already set
+func (p *AddSwiftParams) SetAccountIfUnset(v string) {
+	if _, found := p.p["account"]; !found {
+		p.SetAccount(v)
+	}
+}
+
 func (p *AddSwiftParams) SetKey(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -63,6 +91,13 @@ func (p *AddSwiftParams) SetKey(v string) {
 	return
 }
 
+// SetKeyIfUnset sets key to v, unless it is already set
+func (p *AddSwiftParams) SetKeyIfUnset(v string) {
+	if _, found := p.p["key"]; !found {
+		p.SetKey(v)
+	}
+}
+
 func (p *AddSwiftParams) SetUrl(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -71,6 +106,13 @@ func (p *AddSwiftParams) SetUrl(v string) {
 	return
 }
 
+// SetUrlIfUnset sets url to v, unless it is already set
+func (p *AddSwiftParams) SetUrlIfUnset(v string) {
+	if _, found := p.p["url"]; !found {
+		p.SetUrl(v)
+	}
+}
+
 func (p *AddSwiftParams) SetUsername(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -79,18 +121,54 @@ func (p *AddSwiftParams) SetUsername(v string) {
 	return
 }
 
+// SetUsernameIfUnset sets username to v, unless it is already set
+func (p *AddSwiftParams) SetUsernameIfUnset(v string) {
+	if _, found := p.p["username"]; !found {
+		p.SetUsername(v)
+	}
+}
+
 // You should always use this function to get a new AddSwiftParams instance,
-// as then you are sure you have configured all required params
-func (s *SwiftService) NewAddSwiftParams(url string) *AddSwiftParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *SwiftService) NewAddSwiftParams(url string, opts ...func(*AddSwiftParams)) *AddSwiftParams {
 	p := &AddSwiftParams{}
 	p.p = make(map[string]interface{})
 	p.p["url"] = url
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Adds Swift.
-func (s *SwiftService) AddSwift(p *AddSwiftParams) (*AddSwiftResponse, error) {
-	resp, err := s.cs.newRequest("addSwift", p.toURLValues())
+func (s *SwiftService) AddSwift(p *AddSwiftParams, opts ...OptionFunc) (*AddSwiftResponse, error) {
+	return s.AddSwiftWithContext(context.Background(), p, opts...)
+}
+
+// AddSwiftWithContext is the same as AddSwift, but the request is cancelled when the context is done
+func (s *SwiftService) AddSwiftWithContext(ctx context.Context, p *AddSwiftParams, opts ...OptionFunc) (*AddSwiftResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.AddSwiftRawWithContext(ctx, p.toURLValues())
+}
+
+// AddSwiftRaw is the same as AddSwift, but takes the params as url.Values instead of typed params
+func (s *SwiftService) AddSwiftRaw(v url.Values) (*AddSwiftResponse, error) {
+	return s.AddSwiftRawWithContext(context.Background(), v)
+}
+
+// AddSwiftRawWithContext is the same as AddSwiftRaw, but the request is cancelled when the context is done
+func (s *SwiftService) AddSwiftRawWithContext(ctx context.Context, v url.Values) (*AddSwiftResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "addSwift", "addswiftresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -119,6 +197,16 @@ type ListSwiftsParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ListSwiftsParams) Clone() *ListSwiftsParams {
+	c := &ListSwiftsParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListSwiftsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -150,6 +238,13 @@ func (p *ListSwiftsParams) SetId(v int64) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *ListSwiftsParams) SetIdIfUnset(v int64) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 func (p *ListSwiftsParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -158,6 +253,13 @@ func (p *ListSwiftsParams) SetKeyword(v string) {
 	return
 }
 
+// SetKeywordIfUnset sets keyword to v, unless it is already set
+func (p *ListSwiftsParams) SetKeywordIfUnset(v string) {
+	if _, found := p.p["keyword"]; !found {
+		p.SetKeyword(v)
+	}
+}
+
 func (p *ListSwiftsParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -166,6 +268,13 @@ func (p *ListSwiftsParams) SetPage(v int) {
 	return
 }
 
+// SetPageIfUnset sets page to v, unless it is already set
+func (p *ListSwiftsParams) SetPageIfUnset(v int) {
+	if _, found := p.p["page"]; !found {
+		p.SetPage(v)
+	}
+}
+
 func (p *ListSwiftsParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -174,14 +283,32 @@ func (p *ListSwiftsParams) SetPagesize(v int) {
 	return
 }
 
+// SetPagesizeIfUnset sets pagesize to v, unless it is already set
+func (p *ListSwiftsParams) SetPagesizeIfUnset(v int) {
+	if _, found := p.p["pagesize"]; !found {
+		p.SetPagesize(v)
+	}
+}
+
 // You should always use this function to get a new ListSwiftsParams instance,
-// as then you are sure you have configured all required params
-func (s *SwiftService) NewListSwiftsParams() *ListSwiftsParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *SwiftService) NewListSwiftsParams(opts ...func(*ListSwiftsParams)) *ListSwiftsParams {
 	p := &ListSwiftsParams{}
 	p.p = make(map[string]interface{})
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
+func (p *ListSwiftsParams) setFirstMatch() {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p[firstMatchKey] = true
+}
+
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *SwiftService) GetSwiftID(keyword string, opts ...OptionFunc) (string, int, error) {
 	p := &ListSwiftsParams{}
@@ -189,7 +316,7 @@ func (s *SwiftService) GetSwiftID(keyword string, opts ...OptionFunc) (string, i
 
 	p.p["keyword"] = keyword
 
-	for _, fn := range append(s.cs.options, opts...) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
 		if err := fn(s.cs, p); err != nil {
 			return "", -1, err
 		}
@@ -201,7 +328,7 @@ func (s *SwiftService) GetSwiftID(keyword string, opts ...OptionFunc) (string, i
 	}
 
 	if l.Count == 0 {
-		return "", l.Count, fmt.Errorf("No match found for %s: %+v", keyword, l)
+		return "", l.Count, fmt.Errorf("%w for %s: %+v", ErrNotFound, keyword, l)
 	}
 
 	if l.Count == 1 {
@@ -214,13 +341,32 @@ func (s *SwiftService) GetSwiftID(keyword string, opts ...OptionFunc) (string, i
 				return v.Id, l.Count, nil
 			}
 		}
+
+		if _, ok := p.p[firstMatchKey]; ok && len(l.Swifts) > 0 {
+			return l.Swifts[0].Id, l.Count, nil
+		}
 	}
 	return "", l.Count, fmt.Errorf("Could not find an exact match for %s: %+v", keyword, l)
 }
 
 // List Swift.
 func (s *SwiftService) ListSwifts(p *ListSwiftsParams) (*ListSwiftsResponse, error) {
-	resp, err := s.cs.newRequest("listSwifts", p.toURLValues())
+	return s.ListSwiftsWithContext(context.Background(), p)
+}
+
+// ListSwiftsWithContext is the same as ListSwifts, but the request is cancelled when the context is done
+func (s *SwiftService) ListSwiftsWithContext(ctx context.Context, p *ListSwiftsParams) (*ListSwiftsResponse, error) {
+	return s.ListSwiftsRawWithContext(ctx, p.toURLValues())
+}
+
+// ListSwiftsRaw is the same as ListSwifts, but takes the params as url.Values instead of typed params
+func (s *SwiftService) ListSwiftsRaw(v url.Values) (*ListSwiftsResponse, error) {
+	return s.ListSwiftsRawWithContext(context.Background(), v)
+}
+
+// ListSwiftsRawWithContext is the same as ListSwiftsRaw, but the request is cancelled when the context is done
+func (s *SwiftService) ListSwiftsRawWithContext(ctx context.Context, v url.Values) (*ListSwiftsResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "listSwifts", "listswiftsresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -233,6 +379,93 @@ func (s *SwiftService) ListSwifts(p *ListSwiftsParams) (*ListSwiftsResponse, err
 	return &r, nil
 }
 
+// StreamSwifts fetches the results of ListSwifts page by page in the background and sends them on the
+// returned channel. Both returned channels are closed when done. If fetching a page fails, or the context
+// is done before all results are sent, the error is sent on the error channel and fetching stops. A
+// request for a page in flight is cancelled when the context is done. The page and pagesize set in the
+// params are ignored.
+func (s *SwiftService) StreamSwifts(ctx context.Context, p *ListSwiftsParams) (<-chan *Swift, <-chan error) {
+	ch := make(chan *Swift)
+	errs := make(chan error, 1)
+
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesize(500)
+
+	go func() {
+		defer close(errs)
+		defer close(ch)
+
+		for page, count := 1, 0; ; page++ {
+			pp.SetPage(page)
+
+			l, err := s.ListSwiftsWithContext(ctx, pp)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			for _, v := range l.Swifts {
+				select {
+				case ch <- v:
+				case <-ctx.Done():
+					errs <- ctx.Err()
+					return
+				}
+			}
+
+			count += len(l.Swifts)
+			if len(l.Swifts) < 500 || count >= l.Count {
+				return
+			}
+		}
+	}()
+
+	return ch, errs
+}
+
+// ListSwiftsWhere returns all results of ListSwifts for which pred returns true. Note that the results are
+// filtered client-side, so all results matching the params are fetched first.
+// All pages are fetched, so the page and pagesize set in the params are ignored.
+func (s *SwiftService) ListSwiftsWhere(p *ListSwiftsParams, pred func(*Swift) bool) ([]*Swift, error) {
+	var r []*Swift
+	ch, errs := s.StreamSwifts(context.Background(), p)
+	for v := range ch {
+		if pred(v) {
+			r = append(r, v)
+		}
+	}
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ListAllSwifts returns the results of ListSwifts across all pages, by calling ListSwifts page by page until all
+// results are fetched. The pagesize set in the params is used for every page (500 if not set), and the
+// page set in the params is ignored.
+func (s *SwiftService) ListAllSwifts(p *ListSwiftsParams) ([]*Swift, error) {
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesizeIfUnset(500)
+	pagesize := pp.p["pagesize"].(int)
+
+	var r []*Swift
+	for page := 1; ; page++ {
+		pp.SetPage(page)
+
+		l, err := s.ListSwifts(pp)
+		if err != nil {
+			return nil, err
+		}
+		r = append(r, l.Swifts...)
+
+		if len(l.Swifts) < pagesize || len(r) >= l.Count {
+			return r, nil
+		}
+	}
+}
+
 type ListSwiftsResponse struct {
 	Count  int      `json:"count"`
 	Swifts []*Swift `json:"swift"`
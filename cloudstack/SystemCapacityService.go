@@ -17,15 +17,113 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
 	"net/url"
 	"strconv"
+	"strings"
+	"time"
 )
 
+// CapacityAlert is emitted by WatchCapacity when the used percentage of a capacity crosses the threshold
+type CapacityAlert struct {
+	Capacity    *Capacity
+	PercentUsed float64
+	Threshold   float64
+}
+
+// PercentUsed returns the used percentage of the capacity as a number
+func (c *Capacity) PercentUsed() (float64, error) {
+	return strconv.ParseFloat(strings.TrimSpace(c.Percentused), 64)
+}
+
+// WatchCapacity polls the capacity of the given zone (or of all zones when zoneid is empty) every interval
+// and emits an alert on the returned channel when the used percentage of a capacity reaches the threshold.
+// A capacity (identified by its type, zone, pod and cluster) is only alerted once, until its used percentage
+// drops below the threshold again. Failed polls are retried on the next interval. The channel is closed when
+// the context is done.
+func (s *SystemCapacityService) WatchCapacity(ctx context.Context, zoneid string, interval time.Duration, threshold float64, opts ...OptionFunc) <-chan CapacityAlert {
+	ch := make(chan CapacityAlert)
+
+	go func() {
+		defer close(ch)
+
+		// The capacities which are above the threshold and have been alerted
+		alerted := make(map[string]bool)
+
+		for {
+			capacities, err := s.listCapacityOfZone(zoneid, opts...)
+			if err == nil {
+				for _, c := range capacities {
+					used, err := c.PercentUsed()
+					if err != nil {
+						continue
+					}
+
+					key := fmt.Sprintf("%d/%s/%s/%s", c.Type, c.Zoneid, c.Podid, c.Clusterid)
+					if used < threshold {
+						delete(alerted, key)
+						continue
+					}
+					if alerted[key] {
+						continue
+					}
+					alerted[key] = true
+
+					select {
+					case ch <- CapacityAlert{Capacity: c, PercentUsed: used, Threshold: threshold}:
+					case <-ctx.Done():
+						return
+					}
+				}
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(interval):
+			}
+		}
+	}()
+
+	return ch
+}
+
+// Lists the capacity of the given zone, or of all zones when zoneid is empty
+func (s *SystemCapacityService) listCapacityOfZone(zoneid string, opts ...OptionFunc) ([]*Capacity, error) {
+	p := s.NewListCapacityParams()
+	if zoneid != "" {
+		p.SetZoneid(zoneid)
+	}
+
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	l, err := s.ListCapacity(p)
+	if err != nil {
+		return nil, err
+	}
+	return l.Capacity, nil
+}
+
 type ListCapacityParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ListCapacityParams) Clone() *ListCapacityParams {
+	c := &ListCapacityParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListCapacityParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -73,6 +171,13 @@ func (p *ListCapacityParams) SetClusterid(v string) {
 	return
 }
 
+// SetClusteridIfUnset sets clusterid to v, unless it is already set
+func (p *ListCapacityParams) SetClusteridIfUnset(v string) {
+	if _, found := p.p["clusterid"]; !found {
+		p.SetClusterid(v)
+	}
+}
+
 func (p *ListCapacityParams) SetFetchlatest(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -81,6 +186,13 @@ func (p *ListCapacityParams) SetFetchlatest(v bool) {
 	return
 }
 
+// SetFetchlatestIfUnset sets fetchlatest to v, unless it is already set
+func (p *ListCapacityParams) SetFetchlatestIfUnset(v bool) {
+	if _, found := p.p["fetchlatest"]; !found {
+		p.SetFetchlatest(v)
+	}
+}
+
 func (p *ListCapacityParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -89,6 +201,13 @@ func (p *ListCapacityParams) SetKeyword(v string) {
 	return
 }
 
+// SetKeywordIfUnset sets keyword to v, unless it is already set
+func (p *ListCapacityParams) SetKeywordIfUnset(v string) {
+	if _, found := p.p["keyword"]; !found {
+		p.SetKeyword(v)
+	}
+}
+
 func (p *ListCapacityParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -97,6 +216,13 @@ func (p *ListCapacityParams) SetPage(v int) {
 	return
 }
 
+// SetPageIfUnset sets page to v, unless it is already set
+func (p *ListCapacityParams) SetPageIfUnset(v int) {
+	if _, found := p.p["page"]; !found {
+		p.SetPage(v)
+	}
+}
+
 func (p *ListCapacityParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -105,6 +231,13 @@ func (p *ListCapacityParams) SetPagesize(v int) {
 	return
 }
 
+// SetPagesizeIfUnset sets pagesize to v, unless it is already set
+func (p *ListCapacityParams) SetPagesizeIfUnset(v int) {
+	if _, found := p.p["pagesize"]; !found {
+		p.SetPagesize(v)
+	}
+}
+
 func (p *ListCapacityParams) SetPodid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -113,6 +246,13 @@ func (p *ListCapacityParams) SetPodid(v string) {
 	return
 }
 
+// SetPodidIfUnset sets podid to v, unless it is already set
+func (p *ListCapacityParams) SetPodidIfUnset(v string) {
+	if _, found := p.p["podid"]; !found {
+		p.SetPodid(v)
+	}
+}
+
 func (p *ListCapacityParams) SetSortby(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -121,6 +261,13 @@ func (p *ListCapacityParams) SetSortby(v string) {
 	return
 }
 
+// SetSortbyIfUnset sets sortby to v, unless it is already set
+func (p *ListCapacityParams) SetSortbyIfUnset(v string) {
+	if _, found := p.p["sortby"]; !found {
+		p.SetSortby(v)
+	}
+}
+
 func (p *ListCapacityParams) SetType(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -129,6 +276,13 @@ func (p *ListCapacityParams) SetType(v int) {
 	return
 }
 
+// SetTypeIfUnset sets type to v, unless it is already set
+func (p *ListCapacityParams) SetTypeIfUnset(v int) {
+	if _, found := p.p["type"]; !found {
+		p.SetType(v)
+	}
+}
+
 func (p *ListCapacityParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -137,17 +291,43 @@ func (p *ListCapacityParams) SetZoneid(v string) {
 	return
 }
 
+// SetZoneidIfUnset sets zoneid to v, unless it is already set
+func (p *ListCapacityParams) SetZoneidIfUnset(v string) {
+	if _, found := p.p["zoneid"]; !found {
+		p.SetZoneid(v)
+	}
+}
+
 // You should always use this function to get a new ListCapacityParams instance,
-// as then you are sure you have configured all required params
-func (s *SystemCapacityService) NewListCapacityParams() *ListCapacityParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *SystemCapacityService) NewListCapacityParams(opts ...func(*ListCapacityParams)) *ListCapacityParams {
 	p := &ListCapacityParams{}
 	p.p = make(map[string]interface{})
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Lists all the system wide capacities.
 func (s *SystemCapacityService) ListCapacity(p *ListCapacityParams) (*ListCapacityResponse, error) {
-	resp, err := s.cs.newRequest("listCapacity", p.toURLValues())
+	return s.ListCapacityWithContext(context.Background(), p)
+}
+
+// ListCapacityWithContext is the same as ListCapacity, but the request is cancelled when the context is done
+func (s *SystemCapacityService) ListCapacityWithContext(ctx context.Context, p *ListCapacityParams) (*ListCapacityResponse, error) {
+	return s.ListCapacityRawWithContext(ctx, p.toURLValues())
+}
+
+// ListCapacityRaw is the same as ListCapacity, but takes the params as url.Values instead of typed params
+func (s *SystemCapacityService) ListCapacityRaw(v url.Values) (*ListCapacityResponse, error) {
+	return s.ListCapacityRawWithContext(context.Background(), v)
+}
+
+// ListCapacityRawWithContext is the same as ListCapacityRaw, but the request is cancelled when the context is done
+func (s *SystemCapacityService) ListCapacityRawWithContext(ctx context.Context, v url.Values) (*ListCapacityResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "listCapacity", "listcapacityresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -160,6 +340,93 @@ func (s *SystemCapacityService) ListCapacity(p *ListCapacityParams) (*ListCapaci
 	return &r, nil
 }
 
+// StreamCapacity fetches the results of ListCapacity page by page in the background and sends them on the
+// returned channel. Both returned channels are closed when done. If fetching a page fails, or the context
+// is done before all results are sent, the error is sent on the error channel and fetching stops. A
+// request for a page in flight is cancelled when the context is done. The page and pagesize set in the
+// params are ignored.
+func (s *SystemCapacityService) StreamCapacity(ctx context.Context, p *ListCapacityParams) (<-chan *Capacity, <-chan error) {
+	ch := make(chan *Capacity)
+	errs := make(chan error, 1)
+
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesize(500)
+
+	go func() {
+		defer close(errs)
+		defer close(ch)
+
+		for page, count := 1, 0; ; page++ {
+			pp.SetPage(page)
+
+			l, err := s.ListCapacityWithContext(ctx, pp)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			for _, v := range l.Capacity {
+				select {
+				case ch <- v:
+				case <-ctx.Done():
+					errs <- ctx.Err()
+					return
+				}
+			}
+
+			count += len(l.Capacity)
+			if len(l.Capacity) < 500 || count >= l.Count {
+				return
+			}
+		}
+	}()
+
+	return ch, errs
+}
+
+// ListCapacityWhere returns all results of ListCapacity for which pred returns true. Note that the results are
+// filtered client-side, so all results matching the params are fetched first.
+// All pages are fetched, so the page and pagesize set in the params are ignored.
+func (s *SystemCapacityService) ListCapacityWhere(p *ListCapacityParams, pred func(*Capacity) bool) ([]*Capacity, error) {
+	var r []*Capacity
+	ch, errs := s.StreamCapacity(context.Background(), p)
+	for v := range ch {
+		if pred(v) {
+			r = append(r, v)
+		}
+	}
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ListAllCapacity returns the results of ListCapacity across all pages, by calling ListCapacity page by page until all
+// results are fetched. The pagesize set in the params is used for every page (500 if not set), and the
+// page set in the params is ignored.
+func (s *SystemCapacityService) ListAllCapacity(p *ListCapacityParams) ([]*Capacity, error) {
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesizeIfUnset(500)
+	pagesize := pp.p["pagesize"].(int)
+
+	var r []*Capacity
+	for page := 1; ; page++ {
+		pp.SetPage(page)
+
+		l, err := s.ListCapacity(pp)
+		if err != nil {
+			return nil, err
+		}
+		r = append(r, l.Capacity...)
+
+		if len(l.Capacity) < pagesize || len(r) >= l.Count {
+			return r, nil
+		}
+	}
+}
+
 type ListCapacityResponse struct {
 	Count    int         `json:"count"`
 	Capacity []*Capacity `json:"capacity"`
@@ -17,27 +17,65 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/url"
 	"strconv"
 	"strings"
 )
 
+// MigrateSystemVMAndWait migrates the system VM to the given host, waits for the async job to finish
+// and returns the updated system VM. The options are used when getting the updated system VM.
+func (s *SystemVMService) MigrateSystemVMAndWait(systemvmid, hostid string, opts ...OptionFunc) (*SystemVm, error) {
+	if err := s.migrateAndWait(systemvmid, hostid); err != nil {
+		return nil, err
+	}
+
+	vm, _, err := s.GetSystemVmByID(systemvmid, opts...)
+	return vm, err
+}
+
+// Migrates the system VM or router to the given host and waits for the async job to finish
+func (s *SystemVMService) migrateAndWait(id, hostid string) error {
+	r, err := s.MigrateSystemVm(s.NewMigrateSystemVmParams(hostid, id))
+	if err == nil && !s.cs.async {
+		// An async client already waited for the job to finish
+		_, err = s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	}
+	if err != nil {
+		if IsInsufficientCapacity(err) {
+			return fmt.Errorf("Insufficient capacity to migrate %s to host %s: %w", id, hostid, err)
+		}
+		return fmt.Errorf("Failed to migrate %s to host %s: %w", id, hostid, err)
+	}
+	return nil
+}
+
 type ChangeServiceForSystemVmParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ChangeServiceForSystemVmParams) Clone() *ChangeServiceForSystemVmParams {
+	c := &ChangeServiceForSystemVmParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ChangeServiceForSystemVmParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
 	}
 	if v, found := p.p["details"]; found {
-		i := 0
-		for k, vv := range v.(map[string]string) {
-			u.Set(fmt.Sprintf("details[%d].%s", i, k), vv)
-			i++
+		m := v.(map[string]string)
+		for i, k := range getSortedKeysFromMap(m) {
+			u.Set(fmt.Sprintf("details[%d].%s", i, k), m[k])
 		}
 	}
 	if v, found := p.p["id"]; found {
@@ -49,6 +87,16 @@ func (p *ChangeServiceForSystemVmParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *ChangeServiceForSystemVmParams) validate() error {
+	for _, k := range []string{"id", "serviceofferingid"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of changeServiceForSystemVm", k)
+		}
+	}
+	return nil
+}
+
 func (p *ChangeServiceForSystemVmParams) SetDetails(v map[string]string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -57,6 +105,13 @@ func (p *ChangeServiceForSystemVmParams) SetDetails(v map[string]string) {
 	return
 }
 
+// SetDetailsIfUnset sets details to v, unless it is already set
+func (p *ChangeServiceForSystemVmParams) SetDetailsIfUnset(v map[string]string) {
+	if _, found := p.p["details"]; !found {
+		p.SetDetails(v)
+	}
+}
+
 func (p *ChangeServiceForSystemVmParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -65,6 +120,13 @@ func (p *ChangeServiceForSystemVmParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *ChangeServiceForSystemVmParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 func (p *ChangeServiceForSystemVmParams) SetServiceofferingid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -73,19 +135,55 @@ func (p *ChangeServiceForSystemVmParams) SetServiceofferingid(v string) {
 	return
 }
 
+// SetServiceofferingidIfUnset sets serviceofferingid to v, unless it is already set
+func (p *ChangeServiceForSystemVmParams) SetServiceofferingidIfUnset(v string) {
+	if _, found := p.p["serviceofferingid"]; !found {
+		p.SetServiceofferingid(v)
+	}
+}
+
 // You should always use this function to get a new ChangeServiceForSystemVmParams instance,
-// as then you are sure you have configured all required params
-func (s *SystemVMService) NewChangeServiceForSystemVmParams(id string, serviceofferingid string) *ChangeServiceForSystemVmParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *SystemVMService) NewChangeServiceForSystemVmParams(id string, serviceofferingid string, opts ...func(*ChangeServiceForSystemVmParams)) *ChangeServiceForSystemVmParams {
 	p := &ChangeServiceForSystemVmParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
 	p.p["serviceofferingid"] = serviceofferingid
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Changes the service offering for a system vm (console proxy or secondary storage). The system vm must be in a "Stopped" state for this command to take effect.
-func (s *SystemVMService) ChangeServiceForSystemVm(p *ChangeServiceForSystemVmParams) (*ChangeServiceForSystemVmResponse, error) {
-	resp, err := s.cs.newRequest("changeServiceForSystemVm", p.toURLValues())
+func (s *SystemVMService) ChangeServiceForSystemVm(p *ChangeServiceForSystemVmParams, opts ...OptionFunc) (*ChangeServiceForSystemVmResponse, error) {
+	return s.ChangeServiceForSystemVmWithContext(context.Background(), p, opts...)
+}
+
+// ChangeServiceForSystemVmWithContext is the same as ChangeServiceForSystemVm, but the request is cancelled when the context is done
+func (s *SystemVMService) ChangeServiceForSystemVmWithContext(ctx context.Context, p *ChangeServiceForSystemVmParams, opts ...OptionFunc) (*ChangeServiceForSystemVmResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.ChangeServiceForSystemVmRawWithContext(ctx, p.toURLValues())
+}
+
+// ChangeServiceForSystemVmRaw is the same as ChangeServiceForSystemVm, but takes the params as url.Values instead of typed params
+func (s *SystemVMService) ChangeServiceForSystemVmRaw(v url.Values) (*ChangeServiceForSystemVmResponse, error) {
+	return s.ChangeServiceForSystemVmRawWithContext(context.Background(), v)
+}
+
+// ChangeServiceForSystemVmRawWithContext is the same as ChangeServiceForSystemVmRaw, but the request is cancelled when the context is done
+func (s *SystemVMService) ChangeServiceForSystemVmRawWithContext(ctx context.Context, v url.Values) (*ChangeServiceForSystemVmResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "changeServiceForSystemVm", "changeserviceforsystemvmresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -133,6 +231,16 @@ type DestroySystemVmParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *DestroySystemVmParams) Clone() *DestroySystemVmParams {
+	c := &DestroySystemVmParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DestroySystemVmParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -144,6 +252,16 @@ func (p *DestroySystemVmParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *DestroySystemVmParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of destroySystemVm", k)
+		}
+	}
+	return nil
+}
+
 func (p *DestroySystemVmParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -152,18 +270,54 @@ func (p *DestroySystemVmParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *DestroySystemVmParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 // You should always use this function to get a new DestroySystemVmParams instance,
-// as then you are sure you have configured all required params
-func (s *SystemVMService) NewDestroySystemVmParams(id string) *DestroySystemVmParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *SystemVMService) NewDestroySystemVmParams(id string, opts ...func(*DestroySystemVmParams)) *DestroySystemVmParams {
 	p := &DestroySystemVmParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Destroyes a system virtual machine.
-func (s *SystemVMService) DestroySystemVm(p *DestroySystemVmParams) (*DestroySystemVmResponse, error) {
-	resp, err := s.cs.newRequest("destroySystemVm", p.toURLValues())
+func (s *SystemVMService) DestroySystemVm(p *DestroySystemVmParams, opts ...OptionFunc) (*DestroySystemVmResponse, error) {
+	return s.DestroySystemVmWithContext(context.Background(), p, opts...)
+}
+
+// DestroySystemVmWithContext is the same as DestroySystemVm, but the request is cancelled when the context is done
+func (s *SystemVMService) DestroySystemVmWithContext(ctx context.Context, p *DestroySystemVmParams, opts ...OptionFunc) (*DestroySystemVmResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.DestroySystemVmRawWithContext(ctx, p.toURLValues())
+}
+
+// DestroySystemVmRaw is the same as DestroySystemVm, but takes the params as url.Values instead of typed params
+func (s *SystemVMService) DestroySystemVmRaw(v url.Values) (*DestroySystemVmResponse, error) {
+	return s.DestroySystemVmRawWithContext(context.Background(), v)
+}
+
+// DestroySystemVmRawWithContext is the same as DestroySystemVmRaw, but the request is cancelled when the context is done
+func (s *SystemVMService) DestroySystemVmRawWithContext(ctx context.Context, v url.Values) (*DestroySystemVmResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "destroySystemVm", "destroysystemvmresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -175,9 +329,9 @@ func (s *SystemVMService) DestroySystemVm(p *DestroySystemVmParams) (*DestroySys
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -232,6 +386,16 @@ type ListSystemVmsParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ListSystemVmsParams) Clone() *ListSystemVmsParams {
+	c := &ListSystemVmsParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListSystemVmsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -283,6 +447,13 @@ func (p *ListSystemVmsParams) SetHostid(v string) {
 	return
 }
 
+// SetHostidIfUnset sets hostid to v, unless it is already set
+func (p *ListSystemVmsParams) SetHostidIfUnset(v string) {
+	if _, found := p.p["hostid"]; !found {
+		p.SetHostid(v)
+	}
+}
+
 func (p *ListSystemVmsParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -291,6 +462,13 @@ func (p *ListSystemVmsParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *ListSystemVmsParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 func (p *ListSystemVmsParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -299,6 +477,13 @@ func (p *ListSystemVmsParams) SetKeyword(v string) {
 	return
 }
 
+// SetKeywordIfUnset sets keyword to v, unless it is already set
+func (p *ListSystemVmsParams) SetKeywordIfUnset(v string) {
+	if _, found := p.p["keyword"]; !found {
+		p.SetKeyword(v)
+	}
+}
+
 func (p *ListSystemVmsParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -307,6 +492,13 @@ func (p *ListSystemVmsParams) SetName(v string) {
 	return
 }
 
+// SetNameIfUnset sets name to v, unless it is already set
+func (p *ListSystemVmsParams) SetNameIfUnset(v string) {
+	if _, found := p.p["name"]; !found {
+		p.SetName(v)
+	}
+}
+
 func (p *ListSystemVmsParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -315,6 +507,13 @@ func (p *ListSystemVmsParams) SetPage(v int) {
 	return
 }
 
+// SetPageIfUnset sets page to v, unless it is already set
+func (p *ListSystemVmsParams) SetPageIfUnset(v int) {
+	if _, found := p.p["page"]; !found {
+		p.SetPage(v)
+	}
+}
+
 func (p *ListSystemVmsParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -323,6 +522,13 @@ func (p *ListSystemVmsParams) SetPagesize(v int) {
 	return
 }
 
+// SetPagesizeIfUnset sets pagesize to v, unless it is already set
+func (p *ListSystemVmsParams) SetPagesizeIfUnset(v int) {
+	if _, found := p.p["pagesize"]; !found {
+		p.SetPagesize(v)
+	}
+}
+
 func (p *ListSystemVmsParams) SetPodid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -331,6 +537,13 @@ func (p *ListSystemVmsParams) SetPodid(v string) {
 	return
 }
 
+// SetPodidIfUnset sets podid to v, unless it is already set
+func (p *ListSystemVmsParams) SetPodidIfUnset(v string) {
+	if _, found := p.p["podid"]; !found {
+		p.SetPodid(v)
+	}
+}
+
 func (p *ListSystemVmsParams) SetState(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -339,6 +552,13 @@ func (p *ListSystemVmsParams) SetState(v string) {
 	return
 }
 
+// SetStateIfUnset sets state to v, unless it is already set
+func (p *ListSystemVmsParams) SetStateIfUnset(v string) {
+	if _, found := p.p["state"]; !found {
+		p.SetState(v)
+	}
+}
+
 func (p *ListSystemVmsParams) SetStorageid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -347,6 +567,13 @@ func (p *ListSystemVmsParams) SetStorageid(v string) {
 	return
 }
 
+// SetStorageidIfUnset sets storageid to v, unless it is already set
+func (p *ListSystemVmsParams) SetStorageidIfUnset(v string) {
+	if _, found := p.p["storageid"]; !found {
+		p.SetStorageid(v)
+	}
+}
+
 func (p *ListSystemVmsParams) SetSystemvmtype(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -355,6 +582,13 @@ func (p *ListSystemVmsParams) SetSystemvmtype(v string) {
 	return
 }
 
+// SetSystemvmtypeIfUnset sets systemvmtype to v, unless it is already set
+func (p *ListSystemVmsParams) SetSystemvmtypeIfUnset(v string) {
+	if _, found := p.p["systemvmtype"]; !found {
+		p.SetSystemvmtype(v)
+	}
+}
+
 func (p *ListSystemVmsParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -363,14 +597,32 @@ func (p *ListSystemVmsParams) SetZoneid(v string) {
 	return
 }
 
+// SetZoneidIfUnset sets zoneid to v, unless it is already set
+func (p *ListSystemVmsParams) SetZoneidIfUnset(v string) {
+	if _, found := p.p["zoneid"]; !found {
+		p.SetZoneid(v)
+	}
+}
+
 // You should always use this function to get a new ListSystemVmsParams instance,
-// as then you are sure you have configured all required params
-func (s *SystemVMService) NewListSystemVmsParams() *ListSystemVmsParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *SystemVMService) NewListSystemVmsParams(opts ...func(*ListSystemVmsParams)) *ListSystemVmsParams {
 	p := &ListSystemVmsParams{}
 	p.p = make(map[string]interface{})
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
+func (p *ListSystemVmsParams) setFirstMatch() {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p[firstMatchKey] = true
+}
+
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *SystemVMService) GetSystemVmID(name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListSystemVmsParams{}
@@ -378,7 +630,7 @@ func (s *SystemVMService) GetSystemVmID(name string, opts ...OptionFunc) (string
 
 	p.p["name"] = name
 
-	for _, fn := range append(s.cs.options, opts...) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
 		if err := fn(s.cs, p); err != nil {
 			return "", -1, err
 		}
@@ -390,7 +642,7 @@ func (s *SystemVMService) GetSystemVmID(name string, opts ...OptionFunc) (string
 	}
 
 	if l.Count == 0 {
-		return "", l.Count, fmt.Errorf("No match found for %s: %+v", name, l)
+		return "", l.Count, fmt.Errorf("%w for %s: %+v", ErrNotFound, name, l)
 	}
 
 	if l.Count == 1 {
@@ -403,6 +655,10 @@ func (s *SystemVMService) GetSystemVmID(name string, opts ...OptionFunc) (string
 				return v.Id, l.Count, nil
 			}
 		}
+
+		if _, ok := p.p[firstMatchKey]; ok && len(l.SystemVms) > 0 {
+			return l.SystemVms[0].Id, l.Count, nil
+		}
 	}
 	return "", l.Count, fmt.Errorf("Could not find an exact match for %s: %+v", name, l)
 }
@@ -428,7 +684,7 @@ func (s *SystemVMService) GetSystemVmByID(id string, opts ...OptionFunc) (*Syste
 
 	p.p["id"] = id
 
-	for _, fn := range append(s.cs.options, opts...) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
 		if err := fn(s.cs, p); err != nil {
 			return nil, -1, err
 		}
@@ -439,24 +695,56 @@ func (s *SystemVMService) GetSystemVmByID(id string, opts ...OptionFunc) (*Syste
 		if strings.Contains(err.Error(), fmt.Sprintf(
 			"Invalid parameter id value=%s due to incorrect long value format, "+
 				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+			return nil, 0, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
 	}
 
 	if l.Count == 1 {
 		return l.SystemVms[0], l.Count, nil
 	}
+
+	if _, ok := p.p[firstMatchKey]; ok && len(l.SystemVms) > 0 {
+		return l.SystemVms[0], l.Count, nil
+	}
 	return nil, l.Count, fmt.Errorf("There is more then one result for SystemVm UUID: %s!", id)
 }
 
+// SystemVmExists returns true if a SystemVm with the given ID exists. When no match is found
+// it returns false, while any other error is returned as is.
+func (s *SystemVMService) SystemVmExists(id string, opts ...OptionFunc) (bool, error) {
+	_, count, err := s.GetSystemVmByID(id, opts...)
+	if errors.Is(err, ErrNotFound) {
+		return false, nil
+	}
+	if count > 0 {
+		return true, nil
+	}
+	return false, err
+}
+
 // List system virtual machines.
 func (s *SystemVMService) ListSystemVms(p *ListSystemVmsParams) (*ListSystemVmsResponse, error) {
-	resp, err := s.cs.newRequest("listSystemVms", p.toURLValues())
+	return s.ListSystemVmsWithContext(context.Background(), p)
+}
+
+// ListSystemVmsWithContext is the same as ListSystemVms, but the request is cancelled when the context is done
+func (s *SystemVMService) ListSystemVmsWithContext(ctx context.Context, p *ListSystemVmsParams) (*ListSystemVmsResponse, error) {
+	return s.ListSystemVmsRawWithContext(ctx, p.toURLValues())
+}
+
+// ListSystemVmsRaw is the same as ListSystemVms, but takes the params as url.Values instead of typed params
+func (s *SystemVMService) ListSystemVmsRaw(v url.Values) (*ListSystemVmsResponse, error) {
+	return s.ListSystemVmsRawWithContext(context.Background(), v)
+}
+
+// ListSystemVmsRawWithContext is the same as ListSystemVmsRaw, but the request is cancelled when the context is done
+func (s *SystemVMService) ListSystemVmsRawWithContext(ctx context.Context, v url.Values) (*ListSystemVmsResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "listSystemVms", "listsystemvmsresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -469,6 +757,93 @@ func (s *SystemVMService) ListSystemVms(p *ListSystemVmsParams) (*ListSystemVmsR
 	return &r, nil
 }
 
+// StreamSystemVms fetches the results of ListSystemVms page by page in the background and sends them on the
+// returned channel. Both returned channels are closed when done. If fetching a page fails, or the context
+// is done before all results are sent, the error is sent on the error channel and fetching stops. A
+// request for a page in flight is cancelled when the context is done. The page and pagesize set in the
+// params are ignored.
+func (s *SystemVMService) StreamSystemVms(ctx context.Context, p *ListSystemVmsParams) (<-chan *SystemVm, <-chan error) {
+	ch := make(chan *SystemVm)
+	errs := make(chan error, 1)
+
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesize(500)
+
+	go func() {
+		defer close(errs)
+		defer close(ch)
+
+		for page, count := 1, 0; ; page++ {
+			pp.SetPage(page)
+
+			l, err := s.ListSystemVmsWithContext(ctx, pp)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			for _, v := range l.SystemVms {
+				select {
+				case ch <- v:
+				case <-ctx.Done():
+					errs <- ctx.Err()
+					return
+				}
+			}
+
+			count += len(l.SystemVms)
+			if len(l.SystemVms) < 500 || count >= l.Count {
+				return
+			}
+		}
+	}()
+
+	return ch, errs
+}
+
+// ListSystemVmsWhere returns all results of ListSystemVms for which pred returns true. Note that the results are
+// filtered client-side, so all results matching the params are fetched first.
+// All pages are fetched, so the page and pagesize set in the params are ignored.
+func (s *SystemVMService) ListSystemVmsWhere(p *ListSystemVmsParams, pred func(*SystemVm) bool) ([]*SystemVm, error) {
+	var r []*SystemVm
+	ch, errs := s.StreamSystemVms(context.Background(), p)
+	for v := range ch {
+		if pred(v) {
+			r = append(r, v)
+		}
+	}
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ListAllSystemVms returns the results of ListSystemVms across all pages, by calling ListSystemVms page by page until all
+// results are fetched. The pagesize set in the params is used for every page (500 if not set), and the
+// page set in the params is ignored.
+func (s *SystemVMService) ListAllSystemVms(p *ListSystemVmsParams) ([]*SystemVm, error) {
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesizeIfUnset(500)
+	pagesize := pp.p["pagesize"].(int)
+
+	var r []*SystemVm
+	for page := 1; ; page++ {
+		pp.SetPage(page)
+
+		l, err := s.ListSystemVms(pp)
+		if err != nil {
+			return nil, err
+		}
+		r = append(r, l.SystemVms...)
+
+		if len(l.SystemVms) < pagesize || len(r) >= l.Count {
+			return r, nil
+		}
+	}
+}
+
 type ListSystemVmsResponse struct {
 	Count     int         `json:"count"`
 	SystemVms []*SystemVm `json:"systemvm"`
@@ -509,6 +884,16 @@ type MigrateSystemVmParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *MigrateSystemVmParams) Clone() *MigrateSystemVmParams {
+	c := &MigrateSystemVmParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *MigrateSystemVmParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -523,6 +908,16 @@ func (p *MigrateSystemVmParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *MigrateSystemVmParams) validate() error {
+	for _, k := range []string{"hostid", "virtualmachineid"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of migrateSystemVm", k)
+		}
+	}
+	return nil
+}
+
 func (p *MigrateSystemVmParams) SetHostid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -531,6 +926,13 @@ func (p *MigrateSystemVmParams) SetHostid(v string) {
 	return
 }
 
+// SetHostidIfUnset sets hostid to v, unless it is already set
+func (p *MigrateSystemVmParams) SetHostidIfUnset(v string) {
+	if _, found := p.p["hostid"]; !found {
+		p.SetHostid(v)
+	}
+}
+
 func (p *MigrateSystemVmParams) SetVirtualmachineid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -539,19 +941,55 @@ func (p *MigrateSystemVmParams) SetVirtualmachineid(v string) {
 	return
 }
 
+// SetVirtualmachineidIfUnset sets virtualmachineid to v, unless it is already set
+func (p *MigrateSystemVmParams) SetVirtualmachineidIfUnset(v string) {
+	if _, found := p.p["virtualmachineid"]; !found {
+		p.SetVirtualmachineid(v)
+	}
+}
+
 // You should always use this function to get a new MigrateSystemVmParams instance,
-// as then you are sure you have configured all required params
-func (s *SystemVMService) NewMigrateSystemVmParams(hostid string, virtualmachineid string) *MigrateSystemVmParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *SystemVMService) NewMigrateSystemVmParams(hostid string, virtualmachineid string, opts ...func(*MigrateSystemVmParams)) *MigrateSystemVmParams {
 	p := &MigrateSystemVmParams{}
 	p.p = make(map[string]interface{})
 	p.p["hostid"] = hostid
 	p.p["virtualmachineid"] = virtualmachineid
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Attempts Migration of a system virtual machine to the host specified.
-func (s *SystemVMService) MigrateSystemVm(p *MigrateSystemVmParams) (*MigrateSystemVmResponse, error) {
-	resp, err := s.cs.newRequest("migrateSystemVm", p.toURLValues())
+func (s *SystemVMService) MigrateSystemVm(p *MigrateSystemVmParams, opts ...OptionFunc) (*MigrateSystemVmResponse, error) {
+	return s.MigrateSystemVmWithContext(context.Background(), p, opts...)
+}
+
+// MigrateSystemVmWithContext is the same as MigrateSystemVm, but the request is cancelled when the context is done
+func (s *SystemVMService) MigrateSystemVmWithContext(ctx context.Context, p *MigrateSystemVmParams, opts ...OptionFunc) (*MigrateSystemVmResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.MigrateSystemVmRawWithContext(ctx, p.toURLValues())
+}
+
+// MigrateSystemVmRaw is the same as MigrateSystemVm, but takes the params as url.Values instead of typed params
+func (s *SystemVMService) MigrateSystemVmRaw(v url.Values) (*MigrateSystemVmResponse, error) {
+	return s.MigrateSystemVmRawWithContext(context.Background(), v)
+}
+
+// MigrateSystemVmRawWithContext is the same as MigrateSystemVmRaw, but the request is cancelled when the context is done
+func (s *SystemVMService) MigrateSystemVmRawWithContext(ctx context.Context, v url.Values) (*MigrateSystemVmResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "migrateSystemVm", "migratesystemvmresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -563,9 +1001,9 @@ func (s *SystemVMService) MigrateSystemVm(p *MigrateSystemVmParams) (*MigrateSys
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -620,6 +1058,16 @@ type RebootSystemVmParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *RebootSystemVmParams) Clone() *RebootSystemVmParams {
+	c := &RebootSystemVmParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *RebootSystemVmParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -631,6 +1079,16 @@ func (p *RebootSystemVmParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *RebootSystemVmParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of rebootSystemVm", k)
+		}
+	}
+	return nil
+}
+
 func (p *RebootSystemVmParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -639,18 +1097,54 @@ func (p *RebootSystemVmParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *RebootSystemVmParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 // You should always use this function to get a new RebootSystemVmParams instance,
-// as then you are sure you have configured all required params
-func (s *SystemVMService) NewRebootSystemVmParams(id string) *RebootSystemVmParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *SystemVMService) NewRebootSystemVmParams(id string, opts ...func(*RebootSystemVmParams)) *RebootSystemVmParams {
 	p := &RebootSystemVmParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Reboots a system VM.
-func (s *SystemVMService) RebootSystemVm(p *RebootSystemVmParams) (*RebootSystemVmResponse, error) {
-	resp, err := s.cs.newRequest("rebootSystemVm", p.toURLValues())
+func (s *SystemVMService) RebootSystemVm(p *RebootSystemVmParams, opts ...OptionFunc) (*RebootSystemVmResponse, error) {
+	return s.RebootSystemVmWithContext(context.Background(), p, opts...)
+}
+
+// RebootSystemVmWithContext is the same as RebootSystemVm, but the request is cancelled when the context is done
+func (s *SystemVMService) RebootSystemVmWithContext(ctx context.Context, p *RebootSystemVmParams, opts ...OptionFunc) (*RebootSystemVmResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.RebootSystemVmRawWithContext(ctx, p.toURLValues())
+}
+
+// RebootSystemVmRaw is the same as RebootSystemVm, but takes the params as url.Values instead of typed params
+func (s *SystemVMService) RebootSystemVmRaw(v url.Values) (*RebootSystemVmResponse, error) {
+	return s.RebootSystemVmRawWithContext(context.Background(), v)
+}
+
+// RebootSystemVmRawWithContext is the same as RebootSystemVmRaw, but the request is cancelled when the context is done
+func (s *SystemVMService) RebootSystemVmRawWithContext(ctx context.Context, v url.Values) (*RebootSystemVmResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "rebootSystemVm", "rebootsystemvmresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -662,9 +1156,9 @@ func (s *SystemVMService) RebootSystemVm(p *RebootSystemVmParams) (*RebootSystem
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -719,16 +1213,25 @@ type ScaleSystemVmParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ScaleSystemVmParams) Clone() *ScaleSystemVmParams {
+	c := &ScaleSystemVmParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ScaleSystemVmParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
 	}
 	if v, found := p.p["details"]; found {
-		i := 0
-		for k, vv := range v.(map[string]string) {
-			u.Set(fmt.Sprintf("details[%d].%s", i, k), vv)
-			i++
+		m := v.(map[string]string)
+		for i, k := range getSortedKeysFromMap(m) {
+			u.Set(fmt.Sprintf("details[%d].%s", i, k), m[k])
 		}
 	}
 	if v, found := p.p["id"]; found {
@@ -740,6 +1243,16 @@ func (p *ScaleSystemVmParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *ScaleSystemVmParams) validate() error {
+	for _, k := range []string{"id", "serviceofferingid"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of scaleSystemVm", k)
+		}
+	}
+	return nil
+}
+
 func (p *ScaleSystemVmParams) SetDetails(v map[string]string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -748,6 +1261,13 @@ func (p *ScaleSystemVmParams) SetDetails(v map[string]string) {
 	return
 }
 
+// SetDetailsIfUnset sets details to v, unless it is already set
+func (p *ScaleSystemVmParams) SetDetailsIfUnset(v map[string]string) {
+	if _, found := p.p["details"]; !found {
+		p.SetDetails(v)
+	}
+}
+
 func (p *ScaleSystemVmParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -756,6 +1276,13 @@ func (p *ScaleSystemVmParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *ScaleSystemVmParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 func (p *ScaleSystemVmParams) SetServiceofferingid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -764,19 +1291,55 @@ func (p *ScaleSystemVmParams) SetServiceofferingid(v string) {
 	return
 }
 
+// SetServiceofferingidIfUnset sets serviceofferingid to v, unless it is already set
+func (p *ScaleSystemVmParams) SetServiceofferingidIfUnset(v string) {
+	if _, found := p.p["serviceofferingid"]; !found {
+		p.SetServiceofferingid(v)
+	}
+}
+
 // You should always use this function to get a new ScaleSystemVmParams instance,
-// as then you are sure you have configured all required params
-func (s *SystemVMService) NewScaleSystemVmParams(id string, serviceofferingid string) *ScaleSystemVmParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *SystemVMService) NewScaleSystemVmParams(id string, serviceofferingid string, opts ...func(*ScaleSystemVmParams)) *ScaleSystemVmParams {
 	p := &ScaleSystemVmParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
 	p.p["serviceofferingid"] = serviceofferingid
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Scale the service offering for a system vm (console proxy or secondary storage). The system vm must be in a "Stopped" state for this command to take effect.
-func (s *SystemVMService) ScaleSystemVm(p *ScaleSystemVmParams) (*ScaleSystemVmResponse, error) {
-	resp, err := s.cs.newRequest("scaleSystemVm", p.toURLValues())
+func (s *SystemVMService) ScaleSystemVm(p *ScaleSystemVmParams, opts ...OptionFunc) (*ScaleSystemVmResponse, error) {
+	return s.ScaleSystemVmWithContext(context.Background(), p, opts...)
+}
+
+// ScaleSystemVmWithContext is the same as ScaleSystemVm, but the request is cancelled when the context is done
+func (s *SystemVMService) ScaleSystemVmWithContext(ctx context.Context, p *ScaleSystemVmParams, opts ...OptionFunc) (*ScaleSystemVmResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.ScaleSystemVmRawWithContext(ctx, p.toURLValues())
+}
+
+// ScaleSystemVmRaw is the same as ScaleSystemVm, but takes the params as url.Values instead of typed params
+func (s *SystemVMService) ScaleSystemVmRaw(v url.Values) (*ScaleSystemVmResponse, error) {
+	return s.ScaleSystemVmRawWithContext(context.Background(), v)
+}
+
+// ScaleSystemVmRawWithContext is the same as ScaleSystemVmRaw, but the request is cancelled when the context is done
+func (s *SystemVMService) ScaleSystemVmRawWithContext(ctx context.Context, v url.Values) (*ScaleSystemVmResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "scaleSystemVm", "scalesystemvmresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -788,9 +1351,9 @@ func (s *SystemVMService) ScaleSystemVm(p *ScaleSystemVmParams) (*ScaleSystemVmR
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -845,6 +1408,16 @@ type StartSystemVmParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *StartSystemVmParams) Clone() *StartSystemVmParams {
+	c := &StartSystemVmParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *StartSystemVmParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -856,6 +1429,16 @@ func (p *StartSystemVmParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *StartSystemVmParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of startSystemVm", k)
+		}
+	}
+	return nil
+}
+
 func (p *StartSystemVmParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -864,18 +1447,54 @@ func (p *StartSystemVmParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *StartSystemVmParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 // You should always use this function to get a new StartSystemVmParams instance,
-// as then you are sure you have configured all required params
-func (s *SystemVMService) NewStartSystemVmParams(id string) *StartSystemVmParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *SystemVMService) NewStartSystemVmParams(id string, opts ...func(*StartSystemVmParams)) *StartSystemVmParams {
 	p := &StartSystemVmParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Starts a system virtual machine.
-func (s *SystemVMService) StartSystemVm(p *StartSystemVmParams) (*StartSystemVmResponse, error) {
-	resp, err := s.cs.newRequest("startSystemVm", p.toURLValues())
+func (s *SystemVMService) StartSystemVm(p *StartSystemVmParams, opts ...OptionFunc) (*StartSystemVmResponse, error) {
+	return s.StartSystemVmWithContext(context.Background(), p, opts...)
+}
+
+// StartSystemVmWithContext is the same as StartSystemVm, but the request is cancelled when the context is done
+func (s *SystemVMService) StartSystemVmWithContext(ctx context.Context, p *StartSystemVmParams, opts ...OptionFunc) (*StartSystemVmResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.StartSystemVmRawWithContext(ctx, p.toURLValues())
+}
+
+// StartSystemVmRaw is the same as StartSystemVm, but takes the params as url.Values instead of typed params
+func (s *SystemVMService) StartSystemVmRaw(v url.Values) (*StartSystemVmResponse, error) {
+	return s.StartSystemVmRawWithContext(context.Background(), v)
+}
+
+// StartSystemVmRawWithContext is the same as StartSystemVmRaw, but the request is cancelled when the context is done
+func (s *SystemVMService) StartSystemVmRawWithContext(ctx context.Context, v url.Values) (*StartSystemVmResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "startSystemVm", "startsystemvmresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -887,9 +1506,9 @@ func (s *SystemVMService) StartSystemVm(p *StartSystemVmParams) (*StartSystemVmR
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -944,6 +1563,16 @@ type StopSystemVmParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *StopSystemVmParams) Clone() *StopSystemVmParams {
+	c := &StopSystemVmParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *StopSystemVmParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -959,6 +1588,16 @@ func (p *StopSystemVmParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *StopSystemVmParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of stopSystemVm", k)
+		}
+	}
+	return nil
+}
+
 func (p *StopSystemVmParams) SetForced(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -967,6 +1606,13 @@ func (p *StopSystemVmParams) SetForced(v bool) {
 	return
 }
 
+// SetForcedIfUnset sets forced to v, unless it is already set
+func (p *StopSystemVmParams) SetForcedIfUnset(v bool) {
+	if _, found := p.p["forced"]; !found {
+		p.SetForced(v)
+	}
+}
+
 func (p *StopSystemVmParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -975,18 +1621,54 @@ func (p *StopSystemVmParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *StopSystemVmParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 // You should always use this function to get a new StopSystemVmParams instance,
-// as then you are sure you have configured all required params
-func (s *SystemVMService) NewStopSystemVmParams(id string) *StopSystemVmParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *SystemVMService) NewStopSystemVmParams(id string, opts ...func(*StopSystemVmParams)) *StopSystemVmParams {
 	p := &StopSystemVmParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Stops a system VM.
-func (s *SystemVMService) StopSystemVm(p *StopSystemVmParams) (*StopSystemVmResponse, error) {
-	resp, err := s.cs.newRequest("stopSystemVm", p.toURLValues())
+func (s *SystemVMService) StopSystemVm(p *StopSystemVmParams, opts ...OptionFunc) (*StopSystemVmResponse, error) {
+	return s.StopSystemVmWithContext(context.Background(), p, opts...)
+}
+
+// StopSystemVmWithContext is the same as StopSystemVm, but the request is cancelled when the context is done
+func (s *SystemVMService) StopSystemVmWithContext(ctx context.Context, p *StopSystemVmParams, opts ...OptionFunc) (*StopSystemVmResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.StopSystemVmRawWithContext(ctx, p.toURLValues())
+}
+
+// StopSystemVmRaw is the same as StopSystemVm, but takes the params as url.Values instead of typed params
+func (s *SystemVMService) StopSystemVmRaw(v url.Values) (*StopSystemVmResponse, error) {
+	return s.StopSystemVmRawWithContext(context.Background(), v)
+}
+
+// StopSystemVmRawWithContext is the same as StopSystemVmRaw, but the request is cancelled when the context is done
+func (s *SystemVMService) StopSystemVmRawWithContext(ctx context.Context, v url.Values) (*StopSystemVmResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "stopSystemVm", "stopsystemvmresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -998,9 +1680,9 @@ func (s *SystemVMService) StopSystemVm(p *StopSystemVmParams) (*StopSystemVmResp
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -17,17 +17,296 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+	"io/ioutil"
+	"mime/multipart"
+	"net/http"
 	"net/url"
+	"regexp"
 	"strconv"
 	"strings"
 )
 
+// OVFProperty is a property of a deploy-as-is (OVF) template, which can be set when deploying a
+// virtual machine from the template using SetOVFProperties
+type OVFProperty struct {
+	Key              string `json:"key"`
+	Type             string `json:"type"`       // The OVF type, e.g. string, boolean, int, uint8 or real
+	Value            string `json:"value"`      // The default value
+	Qualifiers       string `json:"qualifiers"` // E.g. ValueMap{"a","b"} or MinLen(1),MaxLen(8)
+	Userconfigurable bool   `json:"userconfigurable"`
+	Label            string `json:"label"`
+	Description      string `json:"description"`
+	Password         bool   `json:"password"`
+}
+
+// ListTemplateOVFProperties lists the OVF properties of the deploy-as-is template. This command is not
+// part of the API this package is generated for, as it was added in CloudStack 4.15.
+func (s *TemplateService) ListTemplateOVFProperties(templateid string) ([]*OVFProperty, error) {
+	resp, err := s.cs.newRequest("listTemplateOVFProperties", url.Values{"id": []string{templateid}})
+	if err != nil {
+		return nil, err
+	}
+
+	var r struct {
+		Count         int            `json:"count"`
+		OVFProperties []*OVFProperty `json:"ovfproperty"`
+	}
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return r.OVFProperties, nil
+}
+
+var ovfValueMapRegex = regexp.MustCompile(`ValueMap\{(.*?)\}`)
+
+// ValidateOVFProperties verifies the values (by key) can be set for the OVF properties of a template.
+// It returns an error for values of unknown properties or properties which are not user configurable,
+// for values which do not match the type of their property, and for values which are not in the value
+// map of their property (if it has one).
+func ValidateOVFProperties(properties []*OVFProperty, values map[string]string) error {
+	byKey := make(map[string]*OVFProperty, len(properties))
+	for _, p := range properties {
+		byKey[p.Key] = p
+	}
+
+	var errs []error
+	for _, k := range getSortedKeysFromMap(values) {
+		p, v := byKey[k], values[k]
+		if p == nil {
+			errs = append(errs, fmt.Errorf("Unknown OVF property %s", k))
+			continue
+		}
+		if !p.Userconfigurable {
+			errs = append(errs, fmt.Errorf("OVF property %s is not user configurable", k))
+			continue
+		}
+
+		var err error
+		switch {
+		case p.Type == "boolean":
+			_, err = strconv.ParseBool(v)
+		case p.Type == "real":
+			_, err = strconv.ParseFloat(v, 64)
+		case strings.HasPrefix(p.Type, "uint"):
+			_, err = strconv.ParseUint(v, 10, 64)
+		case strings.HasPrefix(p.Type, "int") || strings.HasPrefix(p.Type, "sint"):
+			_, err = strconv.ParseInt(v, 10, 64)
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("Invalid value %q for OVF property %s of type %s", v, k, p.Type))
+			continue
+		}
+
+		if m := ovfValueMapRegex.FindStringSubmatch(p.Qualifiers); m != nil {
+			allowed := strings.Split(m[1], ",")
+			found := false
+			for i := range allowed {
+				allowed[i] = strings.Trim(strings.TrimSpace(allowed[i]), `"`)
+				found = found || allowed[i] == v
+			}
+			if !found {
+				errs = append(errs, fmt.Errorf("Invalid value %q for OVF property %s, must be one of: %s", v, k, strings.Join(allowed, ", ")))
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// ExtractToFile extracts the template from the given zone (if not empty) using the given mode (which should
+// be HTTP_DOWNLOAD), waits for the async job to finish and downloads the extracted template to destPath.
+func (s *TemplateService) ExtractToFile(templateid, zoneid, mode, destPath string) error {
+	p := s.NewExtractTemplateParams(templateid, mode)
+	if zoneid != "" {
+		p.SetZoneid(zoneid)
+	}
+
+	r, err := s.ExtractTemplate(p)
+	if err != nil {
+		return err
+	}
+
+	// An async client already waited for the job to finish
+	if !s.cs.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		if err != nil {
+			return err
+		}
+		if b, err = getRawValue(b); err != nil {
+			return err
+		}
+		if err := json.Unmarshal(b, r); err != nil {
+			return err
+		}
+	}
+
+	if r.Url == "" {
+		return fmt.Errorf("No download URL returned when extracting template %s", templateid)
+	}
+	return s.cs.downloadToFile(r.Url, destPath)
+}
+
+// FindTemplate searches for a template by name, trying each of the given template filters
+// in order until a unique match is found. If no filters are given it will try the featured,
+// community and self filters. The zone can be either a zone name or ID and can be left empty
+// to search across all zones.
+func (s *TemplateService) FindTemplate(name, zone string, filters ...string) (*Template, error) {
+	if len(filters) == 0 {
+		filters = []string{"featured", "community", "self"}
+	}
+
+	if zone != "" && !IsID(zone) {
+		id, _, err := s.cs.Zone.GetZoneID(zone)
+		if err != nil {
+			return nil, err
+		}
+		zone = id
+	}
+
+	ambiguous := false
+	for _, filter := range filters {
+		p := s.NewListTemplatesParams(filter)
+		p.SetName(name)
+		if zone != "" {
+			p.SetZoneid(zone)
+		}
+
+		l, err := s.ListTemplates(p)
+		if err != nil {
+			return nil, err
+		}
+
+		// The same template is returned once for every zone it is available
+		// in, so we need to dedup the results by ID before checking them
+		var match *Template
+		ids := make(map[string]bool)
+		for _, t := range l.Templates {
+			if t.Name == name && !ids[t.Id] {
+				ids[t.Id] = true
+				match = t
+			}
+		}
+
+		switch len(ids) {
+		case 0:
+			continue
+		case 1:
+			return match, nil
+		default:
+			ambiguous = true
+		}
+	}
+
+	if ambiguous {
+		return nil, fmt.Errorf("Could not find a unique match for %s using filters: %v", name, filters)
+	}
+	return nil, fmt.Errorf("%w for %s using filters: %v", ErrNotFound, name, filters)
+}
+
+// UploadTemplate uploads the template data read from r to the secondary storage, using the
+// upload params returned by GetUploadParamsForTemplate. The upload is done with a multipart
+// POST call which is authenticated with the signature, metadata and expiry date contained in
+// the upload params, instead of with the API key and secret used for regular API calls.
+func (s *TemplateService) UploadTemplate(up *GetUploadParamsForTemplateResponse, r io.Reader) error {
+	pr, pw := io.Pipe()
+	defer pr.Close()
+
+	// Stream the multipart body so the template doesn't need to be buffered in memory
+	mw := multipart.NewWriter(pw)
+	go func() {
+		part, err := mw.CreateFormFile("file", up.Id)
+		if err == nil {
+			_, err = io.Copy(part, r)
+		}
+		if err == nil {
+			err = mw.Close()
+		}
+		pw.CloseWithError(err)
+	}()
+
+	req, err := http.NewRequest("POST", up.PostURL, pr)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", mw.FormDataContentType())
+	req.Header.Set("X-signature", up.Signature)
+	req.Header.Set("X-metadata", up.Metadata)
+	req.Header.Set("X-expires", up.Expires)
+
+	// Uploading a template will usually take a lot longer than
+	// the timeout configured for regular API calls
+	client := *s.cs.client
+	client.Timeout = 0
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		b, _ := ioutil.ReadAll(resp.Body)
+		return fmt.Errorf("Failed to upload template %s: %s: %s", up.Id, resp.Status, string(b))
+	}
+	return nil
+}
+
+// UpdateMany runs UpdateTemplate for all given IDs in parallel, using at most concurrency concurrent requests.
+// For each ID the params are populated with the ID and then passed to mutate, which should set the
+// changes to apply. The result contains the updated resources (in the order of the IDs) and the error
+// of every update that failed by ID. The returned error contains all failures, or is nil if none failed.
+// The updated resources are returned as the UpdateTemplateResponse of every update, which contains the same
+// fields as the resource itself, so no additional request is needed per resource to get them. The
+// options are used for every update.
+func (s *TemplateService) UpdateMany(ids []string, mutate func(*UpdateTemplateParams), concurrency int, opts ...OptionFunc) (BulkResult[*UpdateTemplateResponse], error) {
+	rs := make([]*UpdateTemplateResponse, len(ids))
+	errs := make([]error, len(ids))
+
+	runConcurrently(len(ids), concurrency, func(i int) {
+		p := &UpdateTemplateParams{p: map[string]interface{}{"id": ids[i]}}
+		if mutate != nil {
+			mutate(p)
+		}
+
+		r, err := s.UpdateTemplate(p, opts...)
+		if err != nil {
+			errs[i] = err
+			return
+		}
+		rs[i] = r
+	})
+
+	var r BulkResult[*UpdateTemplateResponse]
+	for i, id := range ids {
+		if errs[i] != nil {
+			r.fail(id, errs[i])
+			continue
+		}
+		r.Succeeded = append(r.Succeeded, rs[i])
+	}
+
+	return r, r.Err()
+}
+
 type CopyTemplateParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *CopyTemplateParams) Clone() *CopyTemplateParams {
+	c := &CopyTemplateParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *CopyTemplateParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -45,6 +324,16 @@ func (p *CopyTemplateParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *CopyTemplateParams) validate() error {
+	for _, k := range []string{"destzoneid", "id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of copyTemplate", k)
+		}
+	}
+	return nil
+}
+
 func (p *CopyTemplateParams) SetDestzoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -53,6 +342,13 @@ func (p *CopyTemplateParams) SetDestzoneid(v string) {
 	return
 }
 
+// SetDestzoneidIfUnset sets destzoneid to v, unless it is already set
+func (p *CopyTemplateParams) SetDestzoneidIfUnset(v string) {
+	if _, found := p.p["destzoneid"]; !found {
+		p.SetDestzoneid(v)
+	}
+}
+
 func (p *CopyTemplateParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -61,6 +357,13 @@ func (p *CopyTemplateParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *CopyTemplateParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 func (p *CopyTemplateParams) SetSourcezoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -69,19 +372,55 @@ func (p *CopyTemplateParams) SetSourcezoneid(v string) {
 	return
 }
 
+// SetSourcezoneidIfUnset sets sourcezoneid to v, unless it is already set
+func (p *CopyTemplateParams) SetSourcezoneidIfUnset(v string) {
+	if _, found := p.p["sourcezoneid"]; !found {
+		p.SetSourcezoneid(v)
+	}
+}
+
 // You should always use this function to get a new CopyTemplateParams instance,
-// as then you are sure you have configured all required params
-func (s *TemplateService) NewCopyTemplateParams(destzoneid string, id string) *CopyTemplateParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *TemplateService) NewCopyTemplateParams(destzoneid string, id string, opts ...func(*CopyTemplateParams)) *CopyTemplateParams {
 	p := &CopyTemplateParams{}
 	p.p = make(map[string]interface{})
 	p.p["destzoneid"] = destzoneid
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Copies a template from one zone to another.
-func (s *TemplateService) CopyTemplate(p *CopyTemplateParams) (*CopyTemplateResponse, error) {
-	resp, err := s.cs.newRequest("copyTemplate", p.toURLValues())
+func (s *TemplateService) CopyTemplate(p *CopyTemplateParams, opts ...OptionFunc) (*CopyTemplateResponse, error) {
+	return s.CopyTemplateWithContext(context.Background(), p, opts...)
+}
+
+// CopyTemplateWithContext is the same as CopyTemplate, but the request is cancelled when the context is done
+func (s *TemplateService) CopyTemplateWithContext(ctx context.Context, p *CopyTemplateParams, opts ...OptionFunc) (*CopyTemplateResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.CopyTemplateRawWithContext(ctx, p.toURLValues())
+}
+
+// CopyTemplateRaw is the same as CopyTemplate, but takes the params as url.Values instead of typed params
+func (s *TemplateService) CopyTemplateRaw(v url.Values) (*CopyTemplateResponse, error) {
+	return s.CopyTemplateRawWithContext(context.Background(), v)
+}
+
+// CopyTemplateRawWithContext is the same as CopyTemplateRaw, but the request is cancelled when the context is done
+func (s *TemplateService) CopyTemplateRawWithContext(ctx context.Context, v url.Values) (*CopyTemplateResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "copyTemplate", "copytemplateresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -93,9 +432,9 @@ func (s *TemplateService) CopyTemplate(p *CopyTemplateParams) (*CopyTemplateResp
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -115,48 +454,58 @@ func (s *TemplateService) CopyTemplate(p *CopyTemplateParams) (*CopyTemplateResp
 }
 
 type CopyTemplateResponse struct {
-	JobID                 string            `json:"jobid"`
-	Account               string            `json:"account"`
-	Accountid             string            `json:"accountid"`
-	Bootable              bool              `json:"bootable"`
-	Checksum              string            `json:"checksum"`
-	Created               string            `json:"created"`
-	CrossZones            bool              `json:"crossZones"`
-	Details               map[string]string `json:"details"`
-	Displaytext           string            `json:"displaytext"`
-	Domain                string            `json:"domain"`
-	Domainid              string            `json:"domainid"`
-	Format                string            `json:"format"`
-	Hostid                string            `json:"hostid"`
-	Hostname              string            `json:"hostname"`
-	Hypervisor            string            `json:"hypervisor"`
-	Id                    string            `json:"id"`
-	Isdynamicallyscalable bool              `json:"isdynamicallyscalable"`
-	Isextractable         bool              `json:"isextractable"`
-	Isfeatured            bool              `json:"isfeatured"`
-	Ispublic              bool              `json:"ispublic"`
-	Isready               bool              `json:"isready"`
-	Name                  string            `json:"name"`
-	Ostypeid              string            `json:"ostypeid"`
-	Ostypename            string            `json:"ostypename"`
-	Passwordenabled       bool              `json:"passwordenabled"`
-	Project               string            `json:"project"`
-	Projectid             string            `json:"projectid"`
-	Removed               string            `json:"removed"`
-	Size                  int64             `json:"size"`
-	Sourcetemplateid      string            `json:"sourcetemplateid"`
-	Sshkeyenabled         bool              `json:"sshkeyenabled"`
-	Status                string            `json:"status"`
-	Templatetag           string            `json:"templatetag"`
-	Templatetype          string            `json:"templatetype"`
-	Zoneid                string            `json:"zoneid"`
-	Zonename              string            `json:"zonename"`
+	JobID                 string    `json:"jobid"`
+	Account               string    `json:"account"`
+	Accountid             string    `json:"accountid"`
+	Bootable              bool      `json:"bootable"`
+	Checksum              string    `json:"checksum"`
+	Created               string    `json:"created"`
+	CrossZones            bool      `json:"crossZones"`
+	Details               StringMap `json:"details"`
+	Displaytext           string    `json:"displaytext"`
+	Domain                string    `json:"domain"`
+	Domainid              string    `json:"domainid"`
+	Format                string    `json:"format"`
+	Hostid                string    `json:"hostid"`
+	Hostname              string    `json:"hostname"`
+	Hypervisor            string    `json:"hypervisor"`
+	Id                    string    `json:"id"`
+	Isdynamicallyscalable bool      `json:"isdynamicallyscalable"`
+	Isextractable         bool      `json:"isextractable"`
+	Isfeatured            bool      `json:"isfeatured"`
+	Ispublic              bool      `json:"ispublic"`
+	Isready               bool      `json:"isready"`
+	Name                  string    `json:"name"`
+	Ostypeid              string    `json:"ostypeid"`
+	Ostypename            string    `json:"ostypename"`
+	Passwordenabled       bool      `json:"passwordenabled"`
+	Project               string    `json:"project"`
+	Projectid             string    `json:"projectid"`
+	Removed               string    `json:"removed"`
+	Size                  int64     `json:"size"`
+	Sourcetemplateid      string    `json:"sourcetemplateid"`
+	Sshkeyenabled         bool      `json:"sshkeyenabled"`
+	Status                string    `json:"status"`
+	Templatetag           string    `json:"templatetag"`
+	Templatetype          string    `json:"templatetype"`
+	Zoneid                string    `json:"zoneid"`
+	Zonename              string    `json:"zonename"`
 }
 
 type CreateTemplateParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *CreateTemplateParams) Clone() *CreateTemplateParams {
+	c := &CreateTemplateParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *CreateTemplateParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -167,10 +516,9 @@ func (p *CreateTemplateParams) toURLValues() url.Values {
 		u.Set("bits", vv)
 	}
 	if v, found := p.p["details"]; found {
-		i := 0
-		for k, vv := range v.(map[string]string) {
-			u.Set(fmt.Sprintf("details[%d].%s", i, k), vv)
-			i++
+		m := v.(map[string]string)
+		for i, k := range getSortedKeysFromMap(m) {
+			u.Set(fmt.Sprintf("details[%d].%s", i, k), m[k])
 		}
 	}
 	if v, found := p.p["displaytext"]; found {
@@ -223,6 +571,16 @@ func (p *CreateTemplateParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *CreateTemplateParams) validate() error {
+	for _, k := range []string{"displaytext", "name", "ostypeid"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of createTemplate", k)
+		}
+	}
+	return nil
+}
+
 func (p *CreateTemplateParams) SetBits(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -231,6 +589,13 @@ func (p *CreateTemplateParams) SetBits(v int) {
 	return
 }
 
+// SetBitsIfUnset sets bits to v, unless it is already set
+func (p *CreateTemplateParams) SetBitsIfUnset(v int) {
+	if _, found := p.p["bits"]; !found {
+		p.SetBits(v)
+	}
+}
+
 func (p *CreateTemplateParams) SetDetails(v map[string]string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -239,6 +604,13 @@ func (p *CreateTemplateParams) SetDetails(v map[string]string) {
 	return
 }
 
+// SetDetailsIfUnset sets details to v, unless it is already set
+func (p *CreateTemplateParams) SetDetailsIfUnset(v map[string]string) {
+	if _, found := p.p["details"]; !found {
+		p.SetDetails(v)
+	}
+}
+
 func (p *CreateTemplateParams) SetDisplaytext(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -247,6 +619,13 @@ func (p *CreateTemplateParams) SetDisplaytext(v string) {
 	return
 }
 
+// SetDisplaytextIfUnset sets displaytext to v, unless it is already set
+func (p *CreateTemplateParams) SetDisplaytextIfUnset(v string) {
+	if _, found := p.p["displaytext"]; !found {
+		p.SetDisplaytext(v)
+	}
+}
+
 func (p *CreateTemplateParams) SetIsdynamicallyscalable(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -255,6 +634,13 @@ func (p *CreateTemplateParams) SetIsdynamicallyscalable(v bool) {
 	return
 }
 
+// SetIsdynamicallyscalableIfUnset sets isdynamicallyscalable to v, unless it is already set
+func (p *CreateTemplateParams) SetIsdynamicallyscalableIfUnset(v bool) {
+	if _, found := p.p["isdynamicallyscalable"]; !found {
+		p.SetIsdynamicallyscalable(v)
+	}
+}
+
 func (p *CreateTemplateParams) SetIsfeatured(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -263,6 +649,13 @@ func (p *CreateTemplateParams) SetIsfeatured(v bool) {
 	return
 }
 
+// SetIsfeaturedIfUnset sets isfeatured to v, unless it is already set
+func (p *CreateTemplateParams) SetIsfeaturedIfUnset(v bool) {
+	if _, found := p.p["isfeatured"]; !found {
+		p.SetIsfeatured(v)
+	}
+}
+
 func (p *CreateTemplateParams) SetIspublic(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -271,6 +664,13 @@ func (p *CreateTemplateParams) SetIspublic(v bool) {
 	return
 }
 
+// SetIspublicIfUnset sets ispublic to v, unless it is already set
+func (p *CreateTemplateParams) SetIspublicIfUnset(v bool) {
+	if _, found := p.p["ispublic"]; !found {
+		p.SetIspublic(v)
+	}
+}
+
 func (p *CreateTemplateParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -279,6 +679,13 @@ func (p *CreateTemplateParams) SetName(v string) {
 	return
 }
 
+// SetNameIfUnset sets name to v, unless it is already set
+func (p *CreateTemplateParams) SetNameIfUnset(v string) {
+	if _, found := p.p["name"]; !found {
+		p.SetName(v)
+	}
+}
+
 func (p *CreateTemplateParams) SetOstypeid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -287,6 +694,13 @@ func (p *CreateTemplateParams) SetOstypeid(v string) {
 	return
 }
 
+// SetOstypeidIfUnset sets ostypeid to v, unless it is already set
+func (p *CreateTemplateParams) SetOstypeidIfUnset(v string) {
+	if _, found := p.p["ostypeid"]; !found {
+		p.SetOstypeid(v)
+	}
+}
+
 func (p *CreateTemplateParams) SetPasswordenabled(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -295,6 +709,13 @@ func (p *CreateTemplateParams) SetPasswordenabled(v bool) {
 	return
 }
 
+// SetPasswordenabledIfUnset sets passwordenabled to v, unless it is already set
+func (p *CreateTemplateParams) SetPasswordenabledIfUnset(v bool) {
+	if _, found := p.p["passwordenabled"]; !found {
+		p.SetPasswordenabled(v)
+	}
+}
+
 func (p *CreateTemplateParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -303,6 +724,13 @@ func (p *CreateTemplateParams) SetProjectid(v string) {
 	return
 }
 
+// SetProjectidIfUnset sets projectid to v, unless it is already set
+func (p *CreateTemplateParams) SetProjectidIfUnset(v string) {
+	if _, found := p.p["projectid"]; !found {
+		p.SetProjectid(v)
+	}
+}
+
 func (p *CreateTemplateParams) SetRequireshvm(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -311,6 +739,13 @@ func (p *CreateTemplateParams) SetRequireshvm(v bool) {
 	return
 }
 
+// SetRequireshvmIfUnset sets requireshvm to v, unless it is already set
+func (p *CreateTemplateParams) SetRequireshvmIfUnset(v bool) {
+	if _, found := p.p["requireshvm"]; !found {
+		p.SetRequireshvm(v)
+	}
+}
+
 func (p *CreateTemplateParams) SetSnapshotid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -319,6 +754,13 @@ func (p *CreateTemplateParams) SetSnapshotid(v string) {
 	return
 }
 
+// SetSnapshotidIfUnset sets snapshotid to v, unless it is already set
+func (p *CreateTemplateParams) SetSnapshotidIfUnset(v string) {
+	if _, found := p.p["snapshotid"]; !found {
+		p.SetSnapshotid(v)
+	}
+}
+
 func (p *CreateTemplateParams) SetTemplatetag(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -327,6 +769,13 @@ func (p *CreateTemplateParams) SetTemplatetag(v string) {
 	return
 }
 
+// SetTemplatetagIfUnset sets templatetag to v, unless it is already set
+func (p *CreateTemplateParams) SetTemplatetagIfUnset(v string) {
+	if _, found := p.p["templatetag"]; !found {
+		p.SetTemplatetag(v)
+	}
+}
+
 func (p *CreateTemplateParams) SetUrl(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -335,6 +784,13 @@ func (p *CreateTemplateParams) SetUrl(v string) {
 	return
 }
 
+// SetUrlIfUnset sets url to v, unless it is already set
+func (p *CreateTemplateParams) SetUrlIfUnset(v string) {
+	if _, found := p.p["url"]; !found {
+		p.SetUrl(v)
+	}
+}
+
 func (p *CreateTemplateParams) SetVirtualmachineid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -343,6 +799,13 @@ func (p *CreateTemplateParams) SetVirtualmachineid(v string) {
 	return
 }
 
+// SetVirtualmachineidIfUnset sets virtualmachineid to v, unless it is already set
+func (p *CreateTemplateParams) SetVirtualmachineidIfUnset(v string) {
+	if _, found := p.p["virtualmachineid"]; !found {
+		p.SetVirtualmachineid(v)
+	}
+}
+
 func (p *CreateTemplateParams) SetVolumeid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -351,20 +814,127 @@ func (p *CreateTemplateParams) SetVolumeid(v string) {
 	return
 }
 
+// SetVolumeidIfUnset sets volumeid to v, unless it is already set
+func (p *CreateTemplateParams) SetVolumeidIfUnset(v string) {
+	if _, found := p.p["volumeid"]; !found {
+		p.SetVolumeid(v)
+	}
+}
+
 // You should always use this function to get a new CreateTemplateParams instance,
-// as then you are sure you have configured all required params
-func (s *TemplateService) NewCreateTemplateParams(displaytext string, name string, ostypeid string) *CreateTemplateParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *TemplateService) NewCreateTemplateParams(displaytext string, name string, ostypeid string, opts ...func(*CreateTemplateParams)) *CreateTemplateParams {
 	p := &CreateTemplateParams{}
 	p.p = make(map[string]interface{})
 	p.p["displaytext"] = displaytext
 	p.p["name"] = name
 	p.p["ostypeid"] = ostypeid
+	for _, fn := range opts {
+		fn(p)
+	}
+	return p
+}
+
+// CreateTemplateOpts contains all params that can be set on a CreateTemplateParams.
+// Optional params are pointers (or slices and maps) which are only set when not nil.
+type CreateTemplateOpts struct {
+	Bits                  *int
+	Details               map[string]string
+	Displaytext           string
+	Isdynamicallyscalable *bool
+	Isfeatured            *bool
+	Ispublic              *bool
+	Name                  string
+	Ostypeid              string
+	Passwordenabled       *bool
+	Projectid             *string
+	Requireshvm           *bool
+	Snapshotid            *string
+	Templatetag           *string
+	Url                   *string
+	Virtualmachineid      *string
+	Volumeid              *string
+}
+
+// NewCreateTemplateParamsFromOpts is an alternative for NewCreateTemplateParams which takes all params using
+// an options struct, as that is easier to read for APIs with many required params
+func (s *TemplateService) NewCreateTemplateParamsFromOpts(o CreateTemplateOpts) *CreateTemplateParams {
+	p := s.NewCreateTemplateParams(o.Displaytext, o.Name, o.Ostypeid)
+	if o.Bits != nil {
+		p.p["bits"] = *o.Bits
+	}
+	if o.Details != nil {
+		p.p["details"] = o.Details
+	}
+	if o.Isdynamicallyscalable != nil {
+		p.p["isdynamicallyscalable"] = *o.Isdynamicallyscalable
+	}
+	if o.Isfeatured != nil {
+		p.p["isfeatured"] = *o.Isfeatured
+	}
+	if o.Ispublic != nil {
+		p.p["ispublic"] = *o.Ispublic
+	}
+	if o.Passwordenabled != nil {
+		p.p["passwordenabled"] = *o.Passwordenabled
+	}
+	if o.Projectid != nil {
+		p.p["projectid"] = *o.Projectid
+	}
+	if o.Requireshvm != nil {
+		p.p["requireshvm"] = *o.Requireshvm
+	}
+	if o.Snapshotid != nil {
+		p.p["snapshotid"] = *o.Snapshotid
+	}
+	if o.Templatetag != nil {
+		p.p["templatetag"] = *o.Templatetag
+	}
+	if o.Url != nil {
+		p.p["url"] = *o.Url
+	}
+	if o.Virtualmachineid != nil {
+		p.p["virtualmachineid"] = *o.Virtualmachineid
+	}
+	if o.Volumeid != nil {
+		p.p["volumeid"] = *o.Volumeid
+	}
 	return p
 }
 
 // Creates a template of a virtual machine. The virtual machine must be in a STOPPED state. A template created from this command is automatically designated as a private template visible to the account that created it.
-func (s *TemplateService) CreateTemplate(p *CreateTemplateParams) (*CreateTemplateResponse, error) {
-	resp, err := s.cs.newRequest("createTemplate", p.toURLValues())
+func (s *TemplateService) CreateTemplate(p *CreateTemplateParams, opts ...OptionFunc) (*CreateTemplateResponse, error) {
+	return s.CreateTemplateWithContext(context.Background(), p, opts...)
+}
+
+// CreateTemplateWithContext is the same as CreateTemplate, but the request is cancelled when the context is done
+func (s *TemplateService) CreateTemplateWithContext(ctx context.Context, p *CreateTemplateParams, opts ...OptionFunc) (*CreateTemplateResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	if err := checkMutuallyExclusive(p.p, "snapshotid", "volumeid"); err != nil {
+		return nil, err
+	}
+
+	return s.CreateTemplateRawWithContext(ctx, p.toURLValues())
+}
+
+// CreateTemplateRaw is the same as CreateTemplate, but takes the params as url.Values instead of typed params
+func (s *TemplateService) CreateTemplateRaw(v url.Values) (*CreateTemplateResponse, error) {
+	return s.CreateTemplateRawWithContext(context.Background(), v)
+}
+
+// CreateTemplateRawWithContext is the same as CreateTemplateRaw, but the request is cancelled when the context is done
+func (s *TemplateService) CreateTemplateRawWithContext(ctx context.Context, v url.Values) (*CreateTemplateResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "createTemplate", "createtemplateresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -376,9 +946,9 @@ func (s *TemplateService) CreateTemplate(p *CreateTemplateParams) (*CreateTempla
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -398,48 +968,58 @@ func (s *TemplateService) CreateTemplate(p *CreateTemplateParams) (*CreateTempla
 }
 
 type CreateTemplateResponse struct {
-	JobID                 string            `json:"jobid"`
-	Account               string            `json:"account"`
-	Accountid             string            `json:"accountid"`
-	Bootable              bool              `json:"bootable"`
-	Checksum              string            `json:"checksum"`
-	Created               string            `json:"created"`
-	CrossZones            bool              `json:"crossZones"`
-	Details               map[string]string `json:"details"`
-	Displaytext           string            `json:"displaytext"`
-	Domain                string            `json:"domain"`
-	Domainid              string            `json:"domainid"`
-	Format                string            `json:"format"`
-	Hostid                string            `json:"hostid"`
-	Hostname              string            `json:"hostname"`
-	Hypervisor            string            `json:"hypervisor"`
-	Id                    string            `json:"id"`
-	Isdynamicallyscalable bool              `json:"isdynamicallyscalable"`
-	Isextractable         bool              `json:"isextractable"`
-	Isfeatured            bool              `json:"isfeatured"`
-	Ispublic              bool              `json:"ispublic"`
-	Isready               bool              `json:"isready"`
-	Name                  string            `json:"name"`
-	Ostypeid              string            `json:"ostypeid"`
-	Ostypename            string            `json:"ostypename"`
-	Passwordenabled       bool              `json:"passwordenabled"`
-	Project               string            `json:"project"`
-	Projectid             string            `json:"projectid"`
-	Removed               string            `json:"removed"`
-	Size                  int64             `json:"size"`
-	Sourcetemplateid      string            `json:"sourcetemplateid"`
-	Sshkeyenabled         bool              `json:"sshkeyenabled"`
-	Status                string            `json:"status"`
-	Templatetag           string            `json:"templatetag"`
-	Templatetype          string            `json:"templatetype"`
-	Zoneid                string            `json:"zoneid"`
-	Zonename              string            `json:"zonename"`
+	JobID                 string    `json:"jobid"`
+	Account               string    `json:"account"`
+	Accountid             string    `json:"accountid"`
+	Bootable              bool      `json:"bootable"`
+	Checksum              string    `json:"checksum"`
+	Created               string    `json:"created"`
+	CrossZones            bool      `json:"crossZones"`
+	Details               StringMap `json:"details"`
+	Displaytext           string    `json:"displaytext"`
+	Domain                string    `json:"domain"`
+	Domainid              string    `json:"domainid"`
+	Format                string    `json:"format"`
+	Hostid                string    `json:"hostid"`
+	Hostname              string    `json:"hostname"`
+	Hypervisor            string    `json:"hypervisor"`
+	Id                    string    `json:"id"`
+	Isdynamicallyscalable bool      `json:"isdynamicallyscalable"`
+	Isextractable         bool      `json:"isextractable"`
+	Isfeatured            bool      `json:"isfeatured"`
+	Ispublic              bool      `json:"ispublic"`
+	Isready               bool      `json:"isready"`
+	Name                  string    `json:"name"`
+	Ostypeid              string    `json:"ostypeid"`
+	Ostypename            string    `json:"ostypename"`
+	Passwordenabled       bool      `json:"passwordenabled"`
+	Project               string    `json:"project"`
+	Projectid             string    `json:"projectid"`
+	Removed               string    `json:"removed"`
+	Size                  int64     `json:"size"`
+	Sourcetemplateid      string    `json:"sourcetemplateid"`
+	Sshkeyenabled         bool      `json:"sshkeyenabled"`
+	Status                string    `json:"status"`
+	Templatetag           string    `json:"templatetag"`
+	Templatetype          string    `json:"templatetype"`
+	Zoneid                string    `json:"zoneid"`
+	Zonename              string    `json:"zonename"`
 }
 
 type DeleteTemplateParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *DeleteTemplateParams) Clone() *DeleteTemplateParams {
+	c := &DeleteTemplateParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeleteTemplateParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -454,6 +1034,16 @@ func (p *DeleteTemplateParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *DeleteTemplateParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of deleteTemplate", k)
+		}
+	}
+	return nil
+}
+
 func (p *DeleteTemplateParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -462,6 +1052,13 @@ func (p *DeleteTemplateParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *DeleteTemplateParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 func (p *DeleteTemplateParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -470,18 +1067,54 @@ func (p *DeleteTemplateParams) SetZoneid(v string) {
 	return
 }
 
+// SetZoneidIfUnset sets zoneid to v, unless it is already set
+func (p *DeleteTemplateParams) SetZoneidIfUnset(v string) {
+	if _, found := p.p["zoneid"]; !found {
+		p.SetZoneid(v)
+	}
+}
+
 // You should always use this function to get a new DeleteTemplateParams instance,
-// as then you are sure you have configured all required params
-func (s *TemplateService) NewDeleteTemplateParams(id string) *DeleteTemplateParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *TemplateService) NewDeleteTemplateParams(id string, opts ...func(*DeleteTemplateParams)) *DeleteTemplateParams {
 	p := &DeleteTemplateParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Deletes a template from the system. All virtual machines using the deleted template will not be affected.
-func (s *TemplateService) DeleteTemplate(p *DeleteTemplateParams) (*DeleteTemplateResponse, error) {
-	resp, err := s.cs.newRequest("deleteTemplate", p.toURLValues())
+func (s *TemplateService) DeleteTemplate(p *DeleteTemplateParams, opts ...OptionFunc) (*DeleteTemplateResponse, error) {
+	return s.DeleteTemplateWithContext(context.Background(), p, opts...)
+}
+
+// DeleteTemplateWithContext is the same as DeleteTemplate, but the request is cancelled when the context is done
+func (s *TemplateService) DeleteTemplateWithContext(ctx context.Context, p *DeleteTemplateParams, opts ...OptionFunc) (*DeleteTemplateResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.DeleteTemplateRawWithContext(ctx, p.toURLValues())
+}
+
+// DeleteTemplateRaw is the same as DeleteTemplate, but takes the params as url.Values instead of typed params
+func (s *TemplateService) DeleteTemplateRaw(v url.Values) (*DeleteTemplateResponse, error) {
+	return s.DeleteTemplateRawWithContext(context.Background(), v)
+}
+
+// DeleteTemplateRawWithContext is the same as DeleteTemplateRaw, but the request is cancelled when the context is done
+func (s *TemplateService) DeleteTemplateRawWithContext(ctx context.Context, v url.Values) (*DeleteTemplateResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteTemplate", "deletetemplateresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -493,9 +1126,9 @@ func (s *TemplateService) DeleteTemplate(p *DeleteTemplateParams) (*DeleteTempla
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -519,6 +1152,16 @@ type ExtractTemplateParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ExtractTemplateParams) Clone() *ExtractTemplateParams {
+	c := &ExtractTemplateParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ExtractTemplateParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -539,6 +1182,16 @@ func (p *ExtractTemplateParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *ExtractTemplateParams) validate() error {
+	for _, k := range []string{"id", "mode"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of extractTemplate", k)
+		}
+	}
+	return nil
+}
+
 func (p *ExtractTemplateParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -547,6 +1200,13 @@ func (p *ExtractTemplateParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *ExtractTemplateParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 func (p *ExtractTemplateParams) SetMode(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -555,6 +1215,13 @@ func (p *ExtractTemplateParams) SetMode(v string) {
 	return
 }
 
+// SetModeIfUnset sets mode to v, unless it is already set
+func (p *ExtractTemplateParams) SetModeIfUnset(v string) {
+	if _, found := p.p["mode"]; !found {
+		p.SetMode(v)
+	}
+}
+
 func (p *ExtractTemplateParams) SetUrl(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -563,6 +1230,13 @@ func (p *ExtractTemplateParams) SetUrl(v string) {
 	return
 }
 
+// SetUrlIfUnset sets url to v, unless it is already set
+func (p *ExtractTemplateParams) SetUrlIfUnset(v string) {
+	if _, found := p.p["url"]; !found {
+		p.SetUrl(v)
+	}
+}
+
 func (p *ExtractTemplateParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -571,19 +1245,55 @@ func (p *ExtractTemplateParams) SetZoneid(v string) {
 	return
 }
 
+// SetZoneidIfUnset sets zoneid to v, unless it is already set
+func (p *ExtractTemplateParams) SetZoneidIfUnset(v string) {
+	if _, found := p.p["zoneid"]; !found {
+		p.SetZoneid(v)
+	}
+}
+
 // You should always use this function to get a new ExtractTemplateParams instance,
-// as then you are sure you have configured all required params
-func (s *TemplateService) NewExtractTemplateParams(id string, mode string) *ExtractTemplateParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *TemplateService) NewExtractTemplateParams(id string, mode string, opts ...func(*ExtractTemplateParams)) *ExtractTemplateParams {
 	p := &ExtractTemplateParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
 	p.p["mode"] = mode
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Extracts a template
-func (s *TemplateService) ExtractTemplate(p *ExtractTemplateParams) (*ExtractTemplateResponse, error) {
-	resp, err := s.cs.newRequest("extractTemplate", p.toURLValues())
+func (s *TemplateService) ExtractTemplate(p *ExtractTemplateParams, opts ...OptionFunc) (*ExtractTemplateResponse, error) {
+	return s.ExtractTemplateWithContext(context.Background(), p, opts...)
+}
+
+// ExtractTemplateWithContext is the same as ExtractTemplate, but the request is cancelled when the context is done
+func (s *TemplateService) ExtractTemplateWithContext(ctx context.Context, p *ExtractTemplateParams, opts ...OptionFunc) (*ExtractTemplateResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.ExtractTemplateRawWithContext(ctx, p.toURLValues())
+}
+
+// ExtractTemplateRaw is the same as ExtractTemplate, but takes the params as url.Values instead of typed params
+func (s *TemplateService) ExtractTemplateRaw(v url.Values) (*ExtractTemplateResponse, error) {
+	return s.ExtractTemplateRawWithContext(context.Background(), v)
+}
+
+// ExtractTemplateRawWithContext is the same as ExtractTemplateRaw, but the request is cancelled when the context is done
+func (s *TemplateService) ExtractTemplateRawWithContext(ctx context.Context, v url.Values) (*ExtractTemplateResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "extractTemplate", "extracttemplateresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -595,9 +1305,9 @@ func (s *TemplateService) ExtractTemplate(p *ExtractTemplateParams) (*ExtractTem
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -638,6 +1348,16 @@ type GetUploadParamsForTemplateParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *GetUploadParamsForTemplateParams) Clone() *GetUploadParamsForTemplateParams {
+	c := &GetUploadParamsForTemplateParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *GetUploadParamsForTemplateParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -654,10 +1374,9 @@ func (p *GetUploadParamsForTemplateParams) toURLValues() url.Values {
 		u.Set("checksum", v.(string))
 	}
 	if v, found := p.p["details"]; found {
-		i := 0
-		for k, vv := range v.(map[string]string) {
-			u.Set(fmt.Sprintf("details[%d].%s", i, k), vv)
-			i++
+		m := v.(map[string]string)
+		for i, k := range getSortedKeysFromMap(m) {
+			u.Set(fmt.Sprintf("details[%d].%s", i, k), m[k])
 		}
 	}
 	if v, found := p.p["displaytext"]; found {
@@ -722,6 +1441,16 @@ func (p *GetUploadParamsForTemplateParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *GetUploadParamsForTemplateParams) validate() error {
+	for _, k := range []string{"displaytext", "format", "hypervisor", "name", "ostypeid", "zoneid"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of getUploadParamsForTemplate", k)
+		}
+	}
+	return nil
+}
+
 func (p *GetUploadParamsForTemplateParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -730,6 +1459,13 @@ func (p *GetUploadParamsForTemplateParams) SetAccount(v string) {
 	return
 }
 
+// SetAccountIfUnset sets account to v, unless it is already set
+func (p *GetUploadParamsForTemplateParams) SetAccountIfUnset(v string) {
+	if _, found := p.p["account"]; !found {
+		p.SetAccount(v)
+	}
+}
+
 func (p *GetUploadParamsForTemplateParams) SetBits(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -738,6 +1474,13 @@ func (p *GetUploadParamsForTemplateParams) SetBits(v int) {
 	return
 }
 
+// SetBitsIfUnset sets bits to v, unless it is already set
+func (p *GetUploadParamsForTemplateParams) SetBitsIfUnset(v int) {
+	if _, found := p.p["bits"]; !found {
+		p.SetBits(v)
+	}
+}
+
 func (p *GetUploadParamsForTemplateParams) SetChecksum(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -746,6 +1489,13 @@ func (p *GetUploadParamsForTemplateParams) SetChecksum(v string) {
 	return
 }
 
+// SetChecksumIfUnset sets checksum to v, unless it is already set
+func (p *GetUploadParamsForTemplateParams) SetChecksumIfUnset(v string) {
+	if _, found := p.p["checksum"]; !found {
+		p.SetChecksum(v)
+	}
+}
+
 func (p *GetUploadParamsForTemplateParams) SetDetails(v map[string]string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -754,6 +1504,13 @@ func (p *GetUploadParamsForTemplateParams) SetDetails(v map[string]string) {
 	return
 }
 
+// SetDetailsIfUnset sets details to v, unless it is already set
+func (p *GetUploadParamsForTemplateParams) SetDetailsIfUnset(v map[string]string) {
+	if _, found := p.p["details"]; !found {
+		p.SetDetails(v)
+	}
+}
+
 func (p *GetUploadParamsForTemplateParams) SetDisplaytext(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -762,6 +1519,13 @@ func (p *GetUploadParamsForTemplateParams) SetDisplaytext(v string) {
 	return
 }
 
+// SetDisplaytextIfUnset sets displaytext to v, unless it is already set
+func (p *GetUploadParamsForTemplateParams) SetDisplaytextIfUnset(v string) {
+	if _, found := p.p["displaytext"]; !found {
+		p.SetDisplaytext(v)
+	}
+}
+
 func (p *GetUploadParamsForTemplateParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -770,6 +1534,13 @@ func (p *GetUploadParamsForTemplateParams) SetDomainid(v string) {
 	return
 }
 
+// SetDomainidIfUnset sets domainid to v, unless it is already set
+func (p *GetUploadParamsForTemplateParams) SetDomainidIfUnset(v string) {
+	if _, found := p.p["domainid"]; !found {
+		p.SetDomainid(v)
+	}
+}
+
 func (p *GetUploadParamsForTemplateParams) SetFormat(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -778,14 +1549,28 @@ func (p *GetUploadParamsForTemplateParams) SetFormat(v string) {
 	return
 }
 
-func (p *GetUploadParamsForTemplateParams) SetHypervisor(v string) {
+// SetFormatIfUnset sets format to v, unless it is already set
+func (p *GetUploadParamsForTemplateParams) SetFormatIfUnset(v string) {
+	if _, found := p.p["format"]; !found {
+		p.SetFormat(v)
+	}
+}
+
+func (p *GetUploadParamsForTemplateParams) SetHypervisor(v HypervisorType) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	p.p["hypervisor"] = v
+	p.p["hypervisor"] = string(v)
 	return
 }
 
+// SetHypervisorIfUnset sets hypervisor to v, unless it is already set
+func (p *GetUploadParamsForTemplateParams) SetHypervisorIfUnset(v HypervisorType) {
+	if _, found := p.p["hypervisor"]; !found {
+		p.SetHypervisor(v)
+	}
+}
+
 func (p *GetUploadParamsForTemplateParams) SetIsdynamicallyscalable(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -794,6 +1579,13 @@ func (p *GetUploadParamsForTemplateParams) SetIsdynamicallyscalable(v bool) {
 	return
 }
 
+// SetIsdynamicallyscalableIfUnset sets isdynamicallyscalable to v, unless it is already set
+func (p *GetUploadParamsForTemplateParams) SetIsdynamicallyscalableIfUnset(v bool) {
+	if _, found := p.p["isdynamicallyscalable"]; !found {
+		p.SetIsdynamicallyscalable(v)
+	}
+}
+
 func (p *GetUploadParamsForTemplateParams) SetIsextractable(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -802,6 +1594,13 @@ func (p *GetUploadParamsForTemplateParams) SetIsextractable(v bool) {
 	return
 }
 
+// SetIsextractableIfUnset sets isextractable to v, unless it is already set
+func (p *GetUploadParamsForTemplateParams) SetIsextractableIfUnset(v bool) {
+	if _, found := p.p["isextractable"]; !found {
+		p.SetIsextractable(v)
+	}
+}
+
 func (p *GetUploadParamsForTemplateParams) SetIsfeatured(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -810,6 +1609,13 @@ func (p *GetUploadParamsForTemplateParams) SetIsfeatured(v bool) {
 	return
 }
 
+// SetIsfeaturedIfUnset sets isfeatured to v, unless it is already set
+func (p *GetUploadParamsForTemplateParams) SetIsfeaturedIfUnset(v bool) {
+	if _, found := p.p["isfeatured"]; !found {
+		p.SetIsfeatured(v)
+	}
+}
+
 func (p *GetUploadParamsForTemplateParams) SetIspublic(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -818,6 +1624,13 @@ func (p *GetUploadParamsForTemplateParams) SetIspublic(v bool) {
 	return
 }
 
+// SetIspublicIfUnset sets ispublic to v, unless it is already set
+func (p *GetUploadParamsForTemplateParams) SetIspublicIfUnset(v bool) {
+	if _, found := p.p["ispublic"]; !found {
+		p.SetIspublic(v)
+	}
+}
+
 func (p *GetUploadParamsForTemplateParams) SetIsrouting(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -826,6 +1639,13 @@ func (p *GetUploadParamsForTemplateParams) SetIsrouting(v bool) {
 	return
 }
 
+// SetIsroutingIfUnset sets isrouting to v, unless it is already set
+func (p *GetUploadParamsForTemplateParams) SetIsroutingIfUnset(v bool) {
+	if _, found := p.p["isrouting"]; !found {
+		p.SetIsrouting(v)
+	}
+}
+
 func (p *GetUploadParamsForTemplateParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -834,6 +1654,13 @@ func (p *GetUploadParamsForTemplateParams) SetName(v string) {
 	return
 }
 
+// SetNameIfUnset sets name to v, unless it is already set
+func (p *GetUploadParamsForTemplateParams) SetNameIfUnset(v string) {
+	if _, found := p.p["name"]; !found {
+		p.SetName(v)
+	}
+}
+
 func (p *GetUploadParamsForTemplateParams) SetOstypeid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -842,6 +1669,13 @@ func (p *GetUploadParamsForTemplateParams) SetOstypeid(v string) {
 	return
 }
 
+// SetOstypeidIfUnset sets ostypeid to v, unless it is already set
+func (p *GetUploadParamsForTemplateParams) SetOstypeidIfUnset(v string) {
+	if _, found := p.p["ostypeid"]; !found {
+		p.SetOstypeid(v)
+	}
+}
+
 func (p *GetUploadParamsForTemplateParams) SetPasswordenabled(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -850,6 +1684,13 @@ func (p *GetUploadParamsForTemplateParams) SetPasswordenabled(v bool) {
 	return
 }
 
+// SetPasswordenabledIfUnset sets passwordenabled to v, unless it is already set
+func (p *GetUploadParamsForTemplateParams) SetPasswordenabledIfUnset(v bool) {
+	if _, found := p.p["passwordenabled"]; !found {
+		p.SetPasswordenabled(v)
+	}
+}
+
 func (p *GetUploadParamsForTemplateParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -858,6 +1699,13 @@ func (p *GetUploadParamsForTemplateParams) SetProjectid(v string) {
 	return
 }
 
+// SetProjectidIfUnset sets projectid to v, unless it is already set
+func (p *GetUploadParamsForTemplateParams) SetProjectidIfUnset(v string) {
+	if _, found := p.p["projectid"]; !found {
+		p.SetProjectid(v)
+	}
+}
+
 func (p *GetUploadParamsForTemplateParams) SetRequireshvm(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -866,6 +1714,13 @@ func (p *GetUploadParamsForTemplateParams) SetRequireshvm(v bool) {
 	return
 }
 
+// SetRequireshvmIfUnset sets requireshvm to v, unless it is already set
+func (p *GetUploadParamsForTemplateParams) SetRequireshvmIfUnset(v bool) {
+	if _, found := p.p["requireshvm"]; !found {
+		p.SetRequireshvm(v)
+	}
+}
+
 func (p *GetUploadParamsForTemplateParams) SetSshkeyenabled(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -874,6 +1729,13 @@ func (p *GetUploadParamsForTemplateParams) SetSshkeyenabled(v bool) {
 	return
 }
 
+// SetSshkeyenabledIfUnset sets sshkeyenabled to v, unless it is already set
+func (p *GetUploadParamsForTemplateParams) SetSshkeyenabledIfUnset(v bool) {
+	if _, found := p.p["sshkeyenabled"]; !found {
+		p.SetSshkeyenabled(v)
+	}
+}
+
 func (p *GetUploadParamsForTemplateParams) SetTemplatetag(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -882,6 +1744,13 @@ func (p *GetUploadParamsForTemplateParams) SetTemplatetag(v string) {
 	return
 }
 
+// SetTemplatetagIfUnset sets templatetag to v, unless it is already set
+func (p *GetUploadParamsForTemplateParams) SetTemplatetagIfUnset(v string) {
+	if _, found := p.p["templatetag"]; !found {
+		p.SetTemplatetag(v)
+	}
+}
+
 func (p *GetUploadParamsForTemplateParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -890,9 +1759,17 @@ func (p *GetUploadParamsForTemplateParams) SetZoneid(v string) {
 	return
 }
 
+// SetZoneidIfUnset sets zoneid to v, unless it is already set
+func (p *GetUploadParamsForTemplateParams) SetZoneidIfUnset(v string) {
+	if _, found := p.p["zoneid"]; !found {
+		p.SetZoneid(v)
+	}
+}
+
 // You should always use this function to get a new GetUploadParamsForTemplateParams instance,
-// as then you are sure you have configured all required params
-func (s *TemplateService) NewGetUploadParamsForTemplateParams(displaytext string, format string, hypervisor string, name string, ostypeid string, zoneid string) *GetUploadParamsForTemplateParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *TemplateService) NewGetUploadParamsForTemplateParams(displaytext string, format string, hypervisor string, name string, ostypeid string, zoneid string, opts ...func(*GetUploadParamsForTemplateParams)) *GetUploadParamsForTemplateParams {
 	p := &GetUploadParamsForTemplateParams{}
 	p.p = make(map[string]interface{})
 	p.p["displaytext"] = displaytext
@@ -901,12 +1778,118 @@ func (s *TemplateService) NewGetUploadParamsForTemplateParams(displaytext string
 	p.p["name"] = name
 	p.p["ostypeid"] = ostypeid
 	p.p["zoneid"] = zoneid
+	for _, fn := range opts {
+		fn(p)
+	}
+	return p
+}
+
+// GetUploadParamsForTemplateOpts contains all params that can be set on a GetUploadParamsForTemplateParams.
+// Optional params are pointers (or slices and maps) which are only set when not nil.
+type GetUploadParamsForTemplateOpts struct {
+	Account               *string
+	Bits                  *int
+	Checksum              *string
+	Details               map[string]string
+	Displaytext           string
+	Domainid              *string
+	Format                string
+	Hypervisor            HypervisorType
+	Isdynamicallyscalable *bool
+	Isextractable         *bool
+	Isfeatured            *bool
+	Ispublic              *bool
+	Isrouting             *bool
+	Name                  string
+	Ostypeid              string
+	Passwordenabled       *bool
+	Projectid             *string
+	Requireshvm           *bool
+	Sshkeyenabled         *bool
+	Templatetag           *string
+	Zoneid                string
+}
+
+// NewGetUploadParamsForTemplateParamsFromOpts is an alternative for NewGetUploadParamsForTemplateParams which takes all params using
+// an options struct, as that is easier to read for APIs with many required params
+func (s *TemplateService) NewGetUploadParamsForTemplateParamsFromOpts(o GetUploadParamsForTemplateOpts) *GetUploadParamsForTemplateParams {
+	p := s.NewGetUploadParamsForTemplateParams(o.Displaytext, o.Format, string(o.Hypervisor), o.Name, o.Ostypeid, o.Zoneid)
+	if o.Account != nil {
+		p.p["account"] = *o.Account
+	}
+	if o.Bits != nil {
+		p.p["bits"] = *o.Bits
+	}
+	if o.Checksum != nil {
+		p.p["checksum"] = *o.Checksum
+	}
+	if o.Details != nil {
+		p.p["details"] = o.Details
+	}
+	if o.Domainid != nil {
+		p.p["domainid"] = *o.Domainid
+	}
+	if o.Isdynamicallyscalable != nil {
+		p.p["isdynamicallyscalable"] = *o.Isdynamicallyscalable
+	}
+	if o.Isextractable != nil {
+		p.p["isextractable"] = *o.Isextractable
+	}
+	if o.Isfeatured != nil {
+		p.p["isfeatured"] = *o.Isfeatured
+	}
+	if o.Ispublic != nil {
+		p.p["ispublic"] = *o.Ispublic
+	}
+	if o.Isrouting != nil {
+		p.p["isrouting"] = *o.Isrouting
+	}
+	if o.Passwordenabled != nil {
+		p.p["passwordenabled"] = *o.Passwordenabled
+	}
+	if o.Projectid != nil {
+		p.p["projectid"] = *o.Projectid
+	}
+	if o.Requireshvm != nil {
+		p.p["requireshvm"] = *o.Requireshvm
+	}
+	if o.Sshkeyenabled != nil {
+		p.p["sshkeyenabled"] = *o.Sshkeyenabled
+	}
+	if o.Templatetag != nil {
+		p.p["templatetag"] = *o.Templatetag
+	}
 	return p
 }
 
 // upload an existing template into the CloudStack cloud.
-func (s *TemplateService) GetUploadParamsForTemplate(p *GetUploadParamsForTemplateParams) (*GetUploadParamsForTemplateResponse, error) {
-	resp, err := s.cs.newRequest("getUploadParamsForTemplate", p.toURLValues())
+func (s *TemplateService) GetUploadParamsForTemplate(p *GetUploadParamsForTemplateParams, opts ...OptionFunc) (*GetUploadParamsForTemplateResponse, error) {
+	return s.GetUploadParamsForTemplateWithContext(context.Background(), p, opts...)
+}
+
+// GetUploadParamsForTemplateWithContext is the same as GetUploadParamsForTemplate, but the request is cancelled when the context is done
+func (s *TemplateService) GetUploadParamsForTemplateWithContext(ctx context.Context, p *GetUploadParamsForTemplateParams, opts ...OptionFunc) (*GetUploadParamsForTemplateResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.GetUploadParamsForTemplateRawWithContext(ctx, p.toURLValues())
+}
+
+// GetUploadParamsForTemplateRaw is the same as GetUploadParamsForTemplate, but takes the params as url.Values instead of typed params
+func (s *TemplateService) GetUploadParamsForTemplateRaw(v url.Values) (*GetUploadParamsForTemplateResponse, error) {
+	return s.GetUploadParamsForTemplateRawWithContext(context.Background(), v)
+}
+
+// GetUploadParamsForTemplateRawWithContext is the same as GetUploadParamsForTemplateRaw, but the request is cancelled when the context is done
+func (s *TemplateService) GetUploadParamsForTemplateRawWithContext(ctx context.Context, v url.Values) (*GetUploadParamsForTemplateResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "getUploadParamsForTemplate", "postuploadtemplateresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -931,6 +1914,16 @@ type ListTemplatePermissionsParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ListTemplatePermissionsParams) Clone() *ListTemplatePermissionsParams {
+	c := &ListTemplatePermissionsParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListTemplatePermissionsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -942,6 +1935,16 @@ func (p *ListTemplatePermissionsParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *ListTemplatePermissionsParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of listTemplatePermissions", k)
+		}
+	}
+	return nil
+}
+
 func (p *ListTemplatePermissionsParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -950,15 +1953,33 @@ func (p *ListTemplatePermissionsParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *ListTemplatePermissionsParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 // You should always use this function to get a new ListTemplatePermissionsParams instance,
-// as then you are sure you have configured all required params
-func (s *TemplateService) NewListTemplatePermissionsParams(id string) *ListTemplatePermissionsParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *TemplateService) NewListTemplatePermissionsParams(id string, opts ...func(*ListTemplatePermissionsParams)) *ListTemplatePermissionsParams {
 	p := &ListTemplatePermissionsParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
+func (p *ListTemplatePermissionsParams) setFirstMatch() {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p[firstMatchKey] = true
+}
+
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *TemplateService) GetTemplatePermissionByID(id string, opts ...OptionFunc) (*TemplatePermission, int, error) {
 	p := &ListTemplatePermissionsParams{}
@@ -966,7 +1987,7 @@ func (s *TemplateService) GetTemplatePermissionByID(id string, opts ...OptionFun
 
 	p.p["id"] = id
 
-	for _, fn := range append(s.cs.options, opts...) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
 		if err := fn(s.cs, p); err != nil {
 			return nil, -1, err
 		}
@@ -977,24 +1998,60 @@ func (s *TemplateService) GetTemplatePermissionByID(id string, opts ...OptionFun
 		if strings.Contains(err.Error(), fmt.Sprintf(
 			"Invalid parameter id value=%s due to incorrect long value format, "+
 				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+			return nil, 0, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
 	}
 
 	if l.Count == 1 {
 		return l.TemplatePermissions[0], l.Count, nil
 	}
+
+	if _, ok := p.p[firstMatchKey]; ok && len(l.TemplatePermissions) > 0 {
+		return l.TemplatePermissions[0], l.Count, nil
+	}
 	return nil, l.Count, fmt.Errorf("There is more then one result for TemplatePermission UUID: %s!", id)
 }
 
+// TemplatePermissionExists returns true if a TemplatePermission with the given ID exists. When no match is found
+// it returns false, while any other error is returned as is.
+func (s *TemplateService) TemplatePermissionExists(id string, opts ...OptionFunc) (bool, error) {
+	_, count, err := s.GetTemplatePermissionByID(id, opts...)
+	if errors.Is(err, ErrNotFound) {
+		return false, nil
+	}
+	if count > 0 {
+		return true, nil
+	}
+	return false, err
+}
+
 // List template visibility and all accounts that have permissions to view this template.
 func (s *TemplateService) ListTemplatePermissions(p *ListTemplatePermissionsParams) (*ListTemplatePermissionsResponse, error) {
-	resp, err := s.cs.newRequest("listTemplatePermissions", p.toURLValues())
+	return s.ListTemplatePermissionsWithContext(context.Background(), p)
+}
+
+// ListTemplatePermissionsWithContext is the same as ListTemplatePermissions, but the request is cancelled when the context is done
+func (s *TemplateService) ListTemplatePermissionsWithContext(ctx context.Context, p *ListTemplatePermissionsParams) (*ListTemplatePermissionsResponse, error) {
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.ListTemplatePermissionsRawWithContext(ctx, p.toURLValues())
+}
+
+// ListTemplatePermissionsRaw is the same as ListTemplatePermissions, but takes the params as url.Values instead of typed params
+func (s *TemplateService) ListTemplatePermissionsRaw(v url.Values) (*ListTemplatePermissionsResponse, error) {
+	return s.ListTemplatePermissionsRawWithContext(context.Background(), v)
+}
+
+// ListTemplatePermissionsRawWithContext is the same as ListTemplatePermissionsRaw, but the request is cancelled when the context is done
+func (s *TemplateService) ListTemplatePermissionsRawWithContext(ctx context.Context, v url.Values) (*ListTemplatePermissionsResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "listTemplatePermissions", "listtemplatepermissionsresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -1007,6 +2064,22 @@ func (s *TemplateService) ListTemplatePermissions(p *ListTemplatePermissionsPara
 	return &r, nil
 }
 
+// ListTemplatePermissionsWhere returns all results of ListTemplatePermissions for which pred returns true. Note that the results are
+// filtered client-side, so all results matching the params are fetched first.
+func (s *TemplateService) ListTemplatePermissionsWhere(p *ListTemplatePermissionsParams, pred func(*TemplatePermission) bool) ([]*TemplatePermission, error) {
+	var r []*TemplatePermission
+	l, err := s.ListTemplatePermissions(p)
+	if err != nil {
+		return nil, err
+	}
+	for _, v := range l.TemplatePermissions {
+		if pred(v) {
+			r = append(r, v)
+		}
+	}
+	return r, nil
+}
+
 type ListTemplatePermissionsResponse struct {
 	Count               int                   `json:"count"`
 	TemplatePermissions []*TemplatePermission `json:"templatepermission"`
@@ -1024,6 +2097,16 @@ type ListTemplatesParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ListTemplatesParams) Clone() *ListTemplatesParams {
+	c := &ListTemplatesParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListTemplatesParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1075,11 +2158,10 @@ func (p *ListTemplatesParams) toURLValues() url.Values {
 		u.Set("showremoved", vv)
 	}
 	if v, found := p.p["tags"]; found {
-		i := 0
-		for k, vv := range v.(map[string]string) {
+		m := v.(map[string]string)
+		for i, k := range getSortedKeysFromMap(m) {
 			u.Set(fmt.Sprintf("tags[%d].key", i), k)
-			u.Set(fmt.Sprintf("tags[%d].value", i), vv)
-			i++
+			u.Set(fmt.Sprintf("tags[%d].value", i), m[k])
 		}
 	}
 	if v, found := p.p["templatefilter"]; found {
@@ -1091,6 +2173,16 @@ func (p *ListTemplatesParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *ListTemplatesParams) validate() error {
+	for _, k := range []string{"templatefilter"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of listTemplates", k)
+		}
+	}
+	return nil
+}
+
 func (p *ListTemplatesParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1099,6 +2191,13 @@ func (p *ListTemplatesParams) SetAccount(v string) {
 	return
 }
 
+// SetAccountIfUnset sets account to v, unless it is already set
+func (p *ListTemplatesParams) SetAccountIfUnset(v string) {
+	if _, found := p.p["account"]; !found {
+		p.SetAccount(v)
+	}
+}
+
 func (p *ListTemplatesParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1107,14 +2206,28 @@ func (p *ListTemplatesParams) SetDomainid(v string) {
 	return
 }
 
-func (p *ListTemplatesParams) SetHypervisor(v string) {
+// SetDomainidIfUnset sets domainid to v, unless it is already set
+func (p *ListTemplatesParams) SetDomainidIfUnset(v string) {
+	if _, found := p.p["domainid"]; !found {
+		p.SetDomainid(v)
+	}
+}
+
+func (p *ListTemplatesParams) SetHypervisor(v HypervisorType) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	p.p["hypervisor"] = v
+	p.p["hypervisor"] = string(v)
 	return
 }
 
+// SetHypervisorIfUnset sets hypervisor to v, unless it is already set
+func (p *ListTemplatesParams) SetHypervisorIfUnset(v HypervisorType) {
+	if _, found := p.p["hypervisor"]; !found {
+		p.SetHypervisor(v)
+	}
+}
+
 func (p *ListTemplatesParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1123,6 +2236,13 @@ func (p *ListTemplatesParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *ListTemplatesParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 func (p *ListTemplatesParams) SetIds(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1131,6 +2251,18 @@ func (p *ListTemplatesParams) SetIds(v []string) {
 	return
 }
 
+// SetIdsIfUnset sets ids to v, unless it is already set
+func (p *ListTemplatesParams) SetIdsIfUnset(v []string) {
+	if _, found := p.p["ids"]; !found {
+		p.SetIds(v)
+	}
+}
+
+// SetIdsCSV splits v on commas and sets the resulting values
+func (p *ListTemplatesParams) SetIdsCSV(v string) {
+	p.SetIds(splitCSV(v))
+}
+
 func (p *ListTemplatesParams) SetIsrecursive(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1139,6 +2271,13 @@ func (p *ListTemplatesParams) SetIsrecursive(v bool) {
 	return
 }
 
+// SetIsrecursiveIfUnset sets isrecursive to v, unless it is already set
+func (p *ListTemplatesParams) SetIsrecursiveIfUnset(v bool) {
+	if _, found := p.p["isrecursive"]; !found {
+		p.SetIsrecursive(v)
+	}
+}
+
 func (p *ListTemplatesParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1147,6 +2286,13 @@ func (p *ListTemplatesParams) SetKeyword(v string) {
 	return
 }
 
+// SetKeywordIfUnset sets keyword to v, unless it is already set
+func (p *ListTemplatesParams) SetKeywordIfUnset(v string) {
+	if _, found := p.p["keyword"]; !found {
+		p.SetKeyword(v)
+	}
+}
+
 func (p *ListTemplatesParams) SetListall(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1155,6 +2301,13 @@ func (p *ListTemplatesParams) SetListall(v bool) {
 	return
 }
 
+// SetListallIfUnset sets listall to v, unless it is already set
+func (p *ListTemplatesParams) SetListallIfUnset(v bool) {
+	if _, found := p.p["listall"]; !found {
+		p.SetListall(v)
+	}
+}
+
 func (p *ListTemplatesParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1163,6 +2316,13 @@ func (p *ListTemplatesParams) SetName(v string) {
 	return
 }
 
+// SetNameIfUnset sets name to v, unless it is already set
+func (p *ListTemplatesParams) SetNameIfUnset(v string) {
+	if _, found := p.p["name"]; !found {
+		p.SetName(v)
+	}
+}
+
 func (p *ListTemplatesParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1171,6 +2331,13 @@ func (p *ListTemplatesParams) SetPage(v int) {
 	return
 }
 
+// SetPageIfUnset sets page to v, unless it is already set
+func (p *ListTemplatesParams) SetPageIfUnset(v int) {
+	if _, found := p.p["page"]; !found {
+		p.SetPage(v)
+	}
+}
+
 func (p *ListTemplatesParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1179,6 +2346,13 @@ func (p *ListTemplatesParams) SetPagesize(v int) {
 	return
 }
 
+// SetPagesizeIfUnset sets pagesize to v, unless it is already set
+func (p *ListTemplatesParams) SetPagesizeIfUnset(v int) {
+	if _, found := p.p["pagesize"]; !found {
+		p.SetPagesize(v)
+	}
+}
+
 func (p *ListTemplatesParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1187,6 +2361,13 @@ func (p *ListTemplatesParams) SetProjectid(v string) {
 	return
 }
 
+// SetProjectidIfUnset sets projectid to v, unless it is already set
+func (p *ListTemplatesParams) SetProjectidIfUnset(v string) {
+	if _, found := p.p["projectid"]; !found {
+		p.SetProjectid(v)
+	}
+}
+
 func (p *ListTemplatesParams) SetShowremoved(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1195,6 +2376,13 @@ func (p *ListTemplatesParams) SetShowremoved(v bool) {
 	return
 }
 
+// SetShowremovedIfUnset sets showremoved to v, unless it is already set
+func (p *ListTemplatesParams) SetShowremovedIfUnset(v bool) {
+	if _, found := p.p["showremoved"]; !found {
+		p.SetShowremoved(v)
+	}
+}
+
 func (p *ListTemplatesParams) SetTags(v map[string]string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1203,14 +2391,28 @@ func (p *ListTemplatesParams) SetTags(v map[string]string) {
 	return
 }
 
-func (p *ListTemplatesParams) SetTemplatefilter(v string) {
+// SetTagsIfUnset sets tags to v, unless it is already set
+func (p *ListTemplatesParams) SetTagsIfUnset(v map[string]string) {
+	if _, found := p.p["tags"]; !found {
+		p.SetTags(v)
+	}
+}
+
+func (p *ListTemplatesParams) SetTemplatefilter(v TemplateFilter) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	p.p["templatefilter"] = v
+	p.p["templatefilter"] = string(v)
 	return
 }
 
+// SetTemplatefilterIfUnset sets templatefilter to v, unless it is already set
+func (p *ListTemplatesParams) SetTemplatefilterIfUnset(v TemplateFilter) {
+	if _, found := p.p["templatefilter"]; !found {
+		p.SetTemplatefilter(v)
+	}
+}
+
 func (p *ListTemplatesParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1219,15 +2421,35 @@ func (p *ListTemplatesParams) SetZoneid(v string) {
 	return
 }
 
+// SetZoneidIfUnset sets zoneid to v, unless it is already set
+func (p *ListTemplatesParams) SetZoneidIfUnset(v string) {
+	if _, found := p.p["zoneid"]; !found {
+		p.SetZoneid(v)
+	}
+}
+
+var _ ListAllSetter = (*ListTemplatesParams)(nil)
+
 // You should always use this function to get a new ListTemplatesParams instance,
-// as then you are sure you have configured all required params
-func (s *TemplateService) NewListTemplatesParams(templatefilter string) *ListTemplatesParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *TemplateService) NewListTemplatesParams(templatefilter string, opts ...func(*ListTemplatesParams)) *ListTemplatesParams {
 	p := &ListTemplatesParams{}
 	p.p = make(map[string]interface{})
 	p.p["templatefilter"] = templatefilter
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
+func (p *ListTemplatesParams) setFirstMatch() {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p[firstMatchKey] = true
+}
+
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *TemplateService) GetTemplateID(name string, templatefilter string, zoneid string, opts ...OptionFunc) (string, int, error) {
 	p := &ListTemplatesParams{}
@@ -1237,7 +2459,7 @@ func (s *TemplateService) GetTemplateID(name string, templatefilter string, zone
 	p.p["templatefilter"] = templatefilter
 	p.p["zoneid"] = zoneid
 
-	for _, fn := range append(s.cs.options, opts...) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
 		if err := fn(s.cs, p); err != nil {
 			return "", -1, err
 		}
@@ -1249,7 +2471,7 @@ func (s *TemplateService) GetTemplateID(name string, templatefilter string, zone
 	}
 
 	if l.Count == 0 {
-		return "", l.Count, fmt.Errorf("No match found for %s: %+v", name, l)
+		return "", l.Count, fmt.Errorf("%w for %s: %+v", ErrNotFound, name, l)
 	}
 
 	if l.Count == 1 {
@@ -1262,6 +2484,10 @@ func (s *TemplateService) GetTemplateID(name string, templatefilter string, zone
 				return v.Id, l.Count, nil
 			}
 		}
+
+		if _, ok := p.p[firstMatchKey]; ok && len(l.Templates) > 0 {
+			return l.Templates[0].Id, l.Count, nil
+		}
 	}
 	return "", l.Count, fmt.Errorf("Could not find an exact match for %s: %+v", name, l)
 }
@@ -1288,7 +2514,7 @@ func (s *TemplateService) GetTemplateByID(id string, templatefilter string, opts
 	p.p["id"] = id
 	p.p["templatefilter"] = templatefilter
 
-	for _, fn := range append(s.cs.options, opts...) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
 		if err := fn(s.cs, p); err != nil {
 			return nil, -1, err
 		}
@@ -1299,24 +2525,60 @@ func (s *TemplateService) GetTemplateByID(id string, templatefilter string, opts
 		if strings.Contains(err.Error(), fmt.Sprintf(
 			"Invalid parameter id value=%s due to incorrect long value format, "+
 				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+			return nil, 0, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
 	}
 
 	if l.Count == 1 {
 		return l.Templates[0], l.Count, nil
 	}
+
+	if _, ok := p.p[firstMatchKey]; ok && len(l.Templates) > 0 {
+		return l.Templates[0], l.Count, nil
+	}
 	return nil, l.Count, fmt.Errorf("There is more then one result for Template UUID: %s!", id)
 }
 
+// TemplateExists returns true if a Template with the given ID exists. When no match is found
+// it returns false, while any other error is returned as is.
+func (s *TemplateService) TemplateExists(id string, templatefilter string, opts ...OptionFunc) (bool, error) {
+	_, count, err := s.GetTemplateByID(id, templatefilter, opts...)
+	if errors.Is(err, ErrNotFound) {
+		return false, nil
+	}
+	if count > 0 {
+		return true, nil
+	}
+	return false, err
+}
+
 // List all public, private, and privileged templates.
 func (s *TemplateService) ListTemplates(p *ListTemplatesParams) (*ListTemplatesResponse, error) {
-	resp, err := s.cs.newRequest("listTemplates", p.toURLValues())
+	return s.ListTemplatesWithContext(context.Background(), p)
+}
+
+// ListTemplatesWithContext is the same as ListTemplates, but the request is cancelled when the context is done
+func (s *TemplateService) ListTemplatesWithContext(ctx context.Context, p *ListTemplatesParams) (*ListTemplatesResponse, error) {
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.ListTemplatesRawWithContext(ctx, p.toURLValues())
+}
+
+// ListTemplatesRaw is the same as ListTemplates, but takes the params as url.Values instead of typed params
+func (s *TemplateService) ListTemplatesRaw(v url.Values) (*ListTemplatesResponse, error) {
+	return s.ListTemplatesRawWithContext(context.Background(), v)
+}
+
+// ListTemplatesRawWithContext is the same as ListTemplatesRaw, but the request is cancelled when the context is done
+func (s *TemplateService) ListTemplatesRawWithContext(ctx context.Context, v url.Values) (*ListTemplatesResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "listTemplates", "listtemplatesresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -1329,53 +2591,150 @@ func (s *TemplateService) ListTemplates(p *ListTemplatesParams) (*ListTemplatesR
 	return &r, nil
 }
 
+// StreamTemplates fetches the results of ListTemplates page by page in the background and sends them on the
+// returned channel. Both returned channels are closed when done. If fetching a page fails, or the context
+// is done before all results are sent, the error is sent on the error channel and fetching stops. A
+// request for a page in flight is cancelled when the context is done. The page and pagesize set in the
+// params are ignored.
+func (s *TemplateService) StreamTemplates(ctx context.Context, p *ListTemplatesParams) (<-chan *Template, <-chan error) {
+	ch := make(chan *Template)
+	errs := make(chan error, 1)
+
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesize(500)
+
+	go func() {
+		defer close(errs)
+		defer close(ch)
+
+		for page, count := 1, 0; ; page++ {
+			pp.SetPage(page)
+
+			l, err := s.ListTemplatesWithContext(ctx, pp)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			for _, v := range l.Templates {
+				select {
+				case ch <- v:
+				case <-ctx.Done():
+					errs <- ctx.Err()
+					return
+				}
+			}
+
+			count += len(l.Templates)
+			if len(l.Templates) < 500 || count >= l.Count {
+				return
+			}
+		}
+	}()
+
+	return ch, errs
+}
+
+// ListTemplatesWhere returns all results of ListTemplates for which pred returns true. Note that the results are
+// filtered client-side, so all results matching the params are fetched first.
+// All pages are fetched, so the page and pagesize set in the params are ignored.
+func (s *TemplateService) ListTemplatesWhere(p *ListTemplatesParams, pred func(*Template) bool) ([]*Template, error) {
+	var r []*Template
+	ch, errs := s.StreamTemplates(context.Background(), p)
+	for v := range ch {
+		if pred(v) {
+			r = append(r, v)
+		}
+	}
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ListAllTemplates returns the results of ListTemplates across all pages, by calling ListTemplates page by page until all
+// results are fetched. The pagesize set in the params is used for every page (500 if not set), and the
+// page set in the params is ignored.
+func (s *TemplateService) ListAllTemplates(p *ListTemplatesParams) ([]*Template, error) {
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesizeIfUnset(500)
+	pagesize := pp.p["pagesize"].(int)
+
+	var r []*Template
+	for page := 1; ; page++ {
+		pp.SetPage(page)
+
+		l, err := s.ListTemplates(pp)
+		if err != nil {
+			return nil, err
+		}
+		r = append(r, l.Templates...)
+
+		if len(l.Templates) < pagesize || len(r) >= l.Count {
+			return r, nil
+		}
+	}
+}
+
 type ListTemplatesResponse struct {
 	Count     int         `json:"count"`
 	Templates []*Template `json:"template"`
 }
 
 type Template struct {
-	Account               string            `json:"account"`
-	Accountid             string            `json:"accountid"`
-	Bootable              bool              `json:"bootable"`
-	Checksum              string            `json:"checksum"`
-	Created               string            `json:"created"`
-	CrossZones            bool              `json:"crossZones"`
-	Details               map[string]string `json:"details"`
-	Displaytext           string            `json:"displaytext"`
-	Domain                string            `json:"domain"`
-	Domainid              string            `json:"domainid"`
-	Format                string            `json:"format"`
-	Hostid                string            `json:"hostid"`
-	Hostname              string            `json:"hostname"`
-	Hypervisor            string            `json:"hypervisor"`
-	Id                    string            `json:"id"`
-	Isdynamicallyscalable bool              `json:"isdynamicallyscalable"`
-	Isextractable         bool              `json:"isextractable"`
-	Isfeatured            bool              `json:"isfeatured"`
-	Ispublic              bool              `json:"ispublic"`
-	Isready               bool              `json:"isready"`
-	Name                  string            `json:"name"`
-	Ostypeid              string            `json:"ostypeid"`
-	Ostypename            string            `json:"ostypename"`
-	Passwordenabled       bool              `json:"passwordenabled"`
-	Project               string            `json:"project"`
-	Projectid             string            `json:"projectid"`
-	Removed               string            `json:"removed"`
-	Size                  int64             `json:"size"`
-	Sourcetemplateid      string            `json:"sourcetemplateid"`
-	Sshkeyenabled         bool              `json:"sshkeyenabled"`
-	Status                string            `json:"status"`
-	Templatetag           string            `json:"templatetag"`
-	Templatetype          string            `json:"templatetype"`
-	Zoneid                string            `json:"zoneid"`
-	Zonename              string            `json:"zonename"`
+	Account               string    `json:"account"`
+	Accountid             string    `json:"accountid"`
+	Bootable              bool      `json:"bootable"`
+	Checksum              string    `json:"checksum"`
+	Created               string    `json:"created"`
+	CrossZones            bool      `json:"crossZones"`
+	Details               StringMap `json:"details"`
+	Displaytext           string    `json:"displaytext"`
+	Domain                string    `json:"domain"`
+	Domainid              string    `json:"domainid"`
+	Format                string    `json:"format"`
+	Hostid                string    `json:"hostid"`
+	Hostname              string    `json:"hostname"`
+	Hypervisor            string    `json:"hypervisor"`
+	Id                    string    `json:"id"`
+	Isdynamicallyscalable bool      `json:"isdynamicallyscalable"`
+	Isextractable         bool      `json:"isextractable"`
+	Isfeatured            bool      `json:"isfeatured"`
+	Ispublic              bool      `json:"ispublic"`
+	Isready               bool      `json:"isready"`
+	Name                  string    `json:"name"`
+	Ostypeid              string    `json:"ostypeid"`
+	Ostypename            string    `json:"ostypename"`
+	Passwordenabled       bool      `json:"passwordenabled"`
+	Project               string    `json:"project"`
+	Projectid             string    `json:"projectid"`
+	Removed               string    `json:"removed"`
+	Size                  int64     `json:"size"`
+	Sourcetemplateid      string    `json:"sourcetemplateid"`
+	Sshkeyenabled         bool      `json:"sshkeyenabled"`
+	Status                string    `json:"status"`
+	Templatetag           string    `json:"templatetag"`
+	Templatetype          string    `json:"templatetype"`
+	Zoneid                string    `json:"zoneid"`
+	Zonename              string    `json:"zonename"`
 }
 
 type PrepareTemplateParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *PrepareTemplateParams) Clone() *PrepareTemplateParams {
+	c := &PrepareTemplateParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *PrepareTemplateParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1393,6 +2752,16 @@ func (p *PrepareTemplateParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *PrepareTemplateParams) validate() error {
+	for _, k := range []string{"templateid", "zoneid"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of prepareTemplate", k)
+		}
+	}
+	return nil
+}
+
 func (p *PrepareTemplateParams) SetStorageid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1401,6 +2770,13 @@ func (p *PrepareTemplateParams) SetStorageid(v string) {
 	return
 }
 
+// SetStorageidIfUnset sets storageid to v, unless it is already set
+func (p *PrepareTemplateParams) SetStorageidIfUnset(v string) {
+	if _, found := p.p["storageid"]; !found {
+		p.SetStorageid(v)
+	}
+}
+
 func (p *PrepareTemplateParams) SetTemplateid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1409,27 +2785,70 @@ func (p *PrepareTemplateParams) SetTemplateid(v string) {
 	return
 }
 
+// SetTemplateidIfUnset sets templateid to v, unless it is already set
+func (p *PrepareTemplateParams) SetTemplateidIfUnset(v string) {
+	if _, found := p.p["templateid"]; !found {
+		p.SetTemplateid(v)
+	}
+}
+
 func (p *PrepareTemplateParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	p.p["zoneid"] = v
-	return
+	p.p["zoneid"] = v
+	return
+}
+
+// SetZoneidIfUnset sets zoneid to v, unless it is already set
+func (p *PrepareTemplateParams) SetZoneidIfUnset(v string) {
+	if _, found := p.p["zoneid"]; !found {
+		p.SetZoneid(v)
+	}
+}
+
+// You should always use this function to get a new PrepareTemplateParams instance,
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *TemplateService) NewPrepareTemplateParams(templateid string, zoneid string, opts ...func(*PrepareTemplateParams)) *PrepareTemplateParams {
+	p := &PrepareTemplateParams{}
+	p.p = make(map[string]interface{})
+	p.p["templateid"] = templateid
+	p.p["zoneid"] = zoneid
+	for _, fn := range opts {
+		fn(p)
+	}
+	return p
+}
+
+// load template into primary storage
+func (s *TemplateService) PrepareTemplate(p *PrepareTemplateParams, opts ...OptionFunc) (*PrepareTemplateResponse, error) {
+	return s.PrepareTemplateWithContext(context.Background(), p, opts...)
+}
+
+// PrepareTemplateWithContext is the same as PrepareTemplate, but the request is cancelled when the context is done
+func (s *TemplateService) PrepareTemplateWithContext(ctx context.Context, p *PrepareTemplateParams, opts ...OptionFunc) (*PrepareTemplateResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.PrepareTemplateRawWithContext(ctx, p.toURLValues())
 }
 
-// You should always use this function to get a new PrepareTemplateParams instance,
-// as then you are sure you have configured all required params
-func (s *TemplateService) NewPrepareTemplateParams(templateid string, zoneid string) *PrepareTemplateParams {
-	p := &PrepareTemplateParams{}
-	p.p = make(map[string]interface{})
-	p.p["templateid"] = templateid
-	p.p["zoneid"] = zoneid
-	return p
+// PrepareTemplateRaw is the same as PrepareTemplate, but takes the params as url.Values instead of typed params
+func (s *TemplateService) PrepareTemplateRaw(v url.Values) (*PrepareTemplateResponse, error) {
+	return s.PrepareTemplateRawWithContext(context.Background(), v)
 }
 
-// load template into primary storage
-func (s *TemplateService) PrepareTemplate(p *PrepareTemplateParams) (*PrepareTemplateResponse, error) {
-	resp, err := s.cs.newRequest("prepareTemplate", p.toURLValues())
+// PrepareTemplateRawWithContext is the same as PrepareTemplateRaw, but the request is cancelled when the context is done
+func (s *TemplateService) PrepareTemplateRawWithContext(ctx context.Context, v url.Values) (*PrepareTemplateResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "prepareTemplate", "preparetemplateresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -1443,47 +2862,57 @@ func (s *TemplateService) PrepareTemplate(p *PrepareTemplateParams) (*PrepareTem
 }
 
 type PrepareTemplateResponse struct {
-	Account               string            `json:"account"`
-	Accountid             string            `json:"accountid"`
-	Bootable              bool              `json:"bootable"`
-	Checksum              string            `json:"checksum"`
-	Created               string            `json:"created"`
-	CrossZones            bool              `json:"crossZones"`
-	Details               map[string]string `json:"details"`
-	Displaytext           string            `json:"displaytext"`
-	Domain                string            `json:"domain"`
-	Domainid              string            `json:"domainid"`
-	Format                string            `json:"format"`
-	Hostid                string            `json:"hostid"`
-	Hostname              string            `json:"hostname"`
-	Hypervisor            string            `json:"hypervisor"`
-	Id                    string            `json:"id"`
-	Isdynamicallyscalable bool              `json:"isdynamicallyscalable"`
-	Isextractable         bool              `json:"isextractable"`
-	Isfeatured            bool              `json:"isfeatured"`
-	Ispublic              bool              `json:"ispublic"`
-	Isready               bool              `json:"isready"`
-	Name                  string            `json:"name"`
-	Ostypeid              string            `json:"ostypeid"`
-	Ostypename            string            `json:"ostypename"`
-	Passwordenabled       bool              `json:"passwordenabled"`
-	Project               string            `json:"project"`
-	Projectid             string            `json:"projectid"`
-	Removed               string            `json:"removed"`
-	Size                  int64             `json:"size"`
-	Sourcetemplateid      string            `json:"sourcetemplateid"`
-	Sshkeyenabled         bool              `json:"sshkeyenabled"`
-	Status                string            `json:"status"`
-	Templatetag           string            `json:"templatetag"`
-	Templatetype          string            `json:"templatetype"`
-	Zoneid                string            `json:"zoneid"`
-	Zonename              string            `json:"zonename"`
+	Account               string    `json:"account"`
+	Accountid             string    `json:"accountid"`
+	Bootable              bool      `json:"bootable"`
+	Checksum              string    `json:"checksum"`
+	Created               string    `json:"created"`
+	CrossZones            bool      `json:"crossZones"`
+	Details               StringMap `json:"details"`
+	Displaytext           string    `json:"displaytext"`
+	Domain                string    `json:"domain"`
+	Domainid              string    `json:"domainid"`
+	Format                string    `json:"format"`
+	Hostid                string    `json:"hostid"`
+	Hostname              string    `json:"hostname"`
+	Hypervisor            string    `json:"hypervisor"`
+	Id                    string    `json:"id"`
+	Isdynamicallyscalable bool      `json:"isdynamicallyscalable"`
+	Isextractable         bool      `json:"isextractable"`
+	Isfeatured            bool      `json:"isfeatured"`
+	Ispublic              bool      `json:"ispublic"`
+	Isready               bool      `json:"isready"`
+	Name                  string    `json:"name"`
+	Ostypeid              string    `json:"ostypeid"`
+	Ostypename            string    `json:"ostypename"`
+	Passwordenabled       bool      `json:"passwordenabled"`
+	Project               string    `json:"project"`
+	Projectid             string    `json:"projectid"`
+	Removed               string    `json:"removed"`
+	Size                  int64     `json:"size"`
+	Sourcetemplateid      string    `json:"sourcetemplateid"`
+	Sshkeyenabled         bool      `json:"sshkeyenabled"`
+	Status                string    `json:"status"`
+	Templatetag           string    `json:"templatetag"`
+	Templatetype          string    `json:"templatetype"`
+	Zoneid                string    `json:"zoneid"`
+	Zonename              string    `json:"zonename"`
 }
 
 type RegisterTemplateParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *RegisterTemplateParams) Clone() *RegisterTemplateParams {
+	c := &RegisterTemplateParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *RegisterTemplateParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1500,10 +2929,9 @@ func (p *RegisterTemplateParams) toURLValues() url.Values {
 		u.Set("checksum", v.(string))
 	}
 	if v, found := p.p["details"]; found {
-		i := 0
-		for k, vv := range v.(map[string]string) {
-			u.Set(fmt.Sprintf("details[%d].%s", i, k), vv)
-			i++
+		m := v.(map[string]string)
+		for i, k := range getSortedKeysFromMap(m) {
+			u.Set(fmt.Sprintf("details[%d].%s", i, k), m[k])
 		}
 	}
 	if v, found := p.p["displaytext"]; found {
@@ -1571,6 +2999,16 @@ func (p *RegisterTemplateParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *RegisterTemplateParams) validate() error {
+	for _, k := range []string{"displaytext", "format", "hypervisor", "name", "ostypeid", "url", "zoneid"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of registerTemplate", k)
+		}
+	}
+	return nil
+}
+
 func (p *RegisterTemplateParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1579,6 +3017,13 @@ func (p *RegisterTemplateParams) SetAccount(v string) {
 	return
 }
 
+// SetAccountIfUnset sets account to v, unless it is already set
+func (p *RegisterTemplateParams) SetAccountIfUnset(v string) {
+	if _, found := p.p["account"]; !found {
+		p.SetAccount(v)
+	}
+}
+
 func (p *RegisterTemplateParams) SetBits(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1587,6 +3032,13 @@ func (p *RegisterTemplateParams) SetBits(v int) {
 	return
 }
 
+// SetBitsIfUnset sets bits to v, unless it is already set
+func (p *RegisterTemplateParams) SetBitsIfUnset(v int) {
+	if _, found := p.p["bits"]; !found {
+		p.SetBits(v)
+	}
+}
+
 func (p *RegisterTemplateParams) SetChecksum(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1595,6 +3047,13 @@ func (p *RegisterTemplateParams) SetChecksum(v string) {
 	return
 }
 
+// SetChecksumIfUnset sets checksum to v, unless it is already set
+func (p *RegisterTemplateParams) SetChecksumIfUnset(v string) {
+	if _, found := p.p["checksum"]; !found {
+		p.SetChecksum(v)
+	}
+}
+
 func (p *RegisterTemplateParams) SetDetails(v map[string]string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1603,6 +3062,13 @@ func (p *RegisterTemplateParams) SetDetails(v map[string]string) {
 	return
 }
 
+// SetDetailsIfUnset sets details to v, unless it is already set
+func (p *RegisterTemplateParams) SetDetailsIfUnset(v map[string]string) {
+	if _, found := p.p["details"]; !found {
+		p.SetDetails(v)
+	}
+}
+
 func (p *RegisterTemplateParams) SetDisplaytext(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1611,6 +3077,13 @@ func (p *RegisterTemplateParams) SetDisplaytext(v string) {
 	return
 }
 
+// SetDisplaytextIfUnset sets displaytext to v, unless it is already set
+func (p *RegisterTemplateParams) SetDisplaytextIfUnset(v string) {
+	if _, found := p.p["displaytext"]; !found {
+		p.SetDisplaytext(v)
+	}
+}
+
 func (p *RegisterTemplateParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1619,6 +3092,13 @@ func (p *RegisterTemplateParams) SetDomainid(v string) {
 	return
 }
 
+// SetDomainidIfUnset sets domainid to v, unless it is already set
+func (p *RegisterTemplateParams) SetDomainidIfUnset(v string) {
+	if _, found := p.p["domainid"]; !found {
+		p.SetDomainid(v)
+	}
+}
+
 func (p *RegisterTemplateParams) SetFormat(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1627,14 +3107,28 @@ func (p *RegisterTemplateParams) SetFormat(v string) {
 	return
 }
 
-func (p *RegisterTemplateParams) SetHypervisor(v string) {
+// SetFormatIfUnset sets format to v, unless it is already set
+func (p *RegisterTemplateParams) SetFormatIfUnset(v string) {
+	if _, found := p.p["format"]; !found {
+		p.SetFormat(v)
+	}
+}
+
+func (p *RegisterTemplateParams) SetHypervisor(v HypervisorType) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	p.p["hypervisor"] = v
+	p.p["hypervisor"] = string(v)
 	return
 }
 
+// SetHypervisorIfUnset sets hypervisor to v, unless it is already set
+func (p *RegisterTemplateParams) SetHypervisorIfUnset(v HypervisorType) {
+	if _, found := p.p["hypervisor"]; !found {
+		p.SetHypervisor(v)
+	}
+}
+
 func (p *RegisterTemplateParams) SetIsdynamicallyscalable(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1643,6 +3137,13 @@ func (p *RegisterTemplateParams) SetIsdynamicallyscalable(v bool) {
 	return
 }
 
+// SetIsdynamicallyscalableIfUnset sets isdynamicallyscalable to v, unless it is already set
+func (p *RegisterTemplateParams) SetIsdynamicallyscalableIfUnset(v bool) {
+	if _, found := p.p["isdynamicallyscalable"]; !found {
+		p.SetIsdynamicallyscalable(v)
+	}
+}
+
 func (p *RegisterTemplateParams) SetIsextractable(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1651,6 +3152,13 @@ func (p *RegisterTemplateParams) SetIsextractable(v bool) {
 	return
 }
 
+// SetIsextractableIfUnset sets isextractable to v, unless it is already set
+func (p *RegisterTemplateParams) SetIsextractableIfUnset(v bool) {
+	if _, found := p.p["isextractable"]; !found {
+		p.SetIsextractable(v)
+	}
+}
+
 func (p *RegisterTemplateParams) SetIsfeatured(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1659,6 +3167,13 @@ func (p *RegisterTemplateParams) SetIsfeatured(v bool) {
 	return
 }
 
+// SetIsfeaturedIfUnset sets isfeatured to v, unless it is already set
+func (p *RegisterTemplateParams) SetIsfeaturedIfUnset(v bool) {
+	if _, found := p.p["isfeatured"]; !found {
+		p.SetIsfeatured(v)
+	}
+}
+
 func (p *RegisterTemplateParams) SetIspublic(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1667,6 +3182,13 @@ func (p *RegisterTemplateParams) SetIspublic(v bool) {
 	return
 }
 
+// SetIspublicIfUnset sets ispublic to v, unless it is already set
+func (p *RegisterTemplateParams) SetIspublicIfUnset(v bool) {
+	if _, found := p.p["ispublic"]; !found {
+		p.SetIspublic(v)
+	}
+}
+
 func (p *RegisterTemplateParams) SetIsrouting(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1675,6 +3197,13 @@ func (p *RegisterTemplateParams) SetIsrouting(v bool) {
 	return
 }
 
+// SetIsroutingIfUnset sets isrouting to v, unless it is already set
+func (p *RegisterTemplateParams) SetIsroutingIfUnset(v bool) {
+	if _, found := p.p["isrouting"]; !found {
+		p.SetIsrouting(v)
+	}
+}
+
 func (p *RegisterTemplateParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1683,6 +3212,13 @@ func (p *RegisterTemplateParams) SetName(v string) {
 	return
 }
 
+// SetNameIfUnset sets name to v, unless it is already set
+func (p *RegisterTemplateParams) SetNameIfUnset(v string) {
+	if _, found := p.p["name"]; !found {
+		p.SetName(v)
+	}
+}
+
 func (p *RegisterTemplateParams) SetOstypeid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1691,6 +3227,13 @@ func (p *RegisterTemplateParams) SetOstypeid(v string) {
 	return
 }
 
+// SetOstypeidIfUnset sets ostypeid to v, unless it is already set
+func (p *RegisterTemplateParams) SetOstypeidIfUnset(v string) {
+	if _, found := p.p["ostypeid"]; !found {
+		p.SetOstypeid(v)
+	}
+}
+
 func (p *RegisterTemplateParams) SetPasswordenabled(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1699,6 +3242,13 @@ func (p *RegisterTemplateParams) SetPasswordenabled(v bool) {
 	return
 }
 
+// SetPasswordenabledIfUnset sets passwordenabled to v, unless it is already set
+func (p *RegisterTemplateParams) SetPasswordenabledIfUnset(v bool) {
+	if _, found := p.p["passwordenabled"]; !found {
+		p.SetPasswordenabled(v)
+	}
+}
+
 func (p *RegisterTemplateParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1707,6 +3257,13 @@ func (p *RegisterTemplateParams) SetProjectid(v string) {
 	return
 }
 
+// SetProjectidIfUnset sets projectid to v, unless it is already set
+func (p *RegisterTemplateParams) SetProjectidIfUnset(v string) {
+	if _, found := p.p["projectid"]; !found {
+		p.SetProjectid(v)
+	}
+}
+
 func (p *RegisterTemplateParams) SetRequireshvm(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1715,6 +3272,13 @@ func (p *RegisterTemplateParams) SetRequireshvm(v bool) {
 	return
 }
 
+// SetRequireshvmIfUnset sets requireshvm to v, unless it is already set
+func (p *RegisterTemplateParams) SetRequireshvmIfUnset(v bool) {
+	if _, found := p.p["requireshvm"]; !found {
+		p.SetRequireshvm(v)
+	}
+}
+
 func (p *RegisterTemplateParams) SetSshkeyenabled(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1723,6 +3287,13 @@ func (p *RegisterTemplateParams) SetSshkeyenabled(v bool) {
 	return
 }
 
+// SetSshkeyenabledIfUnset sets sshkeyenabled to v, unless it is already set
+func (p *RegisterTemplateParams) SetSshkeyenabledIfUnset(v bool) {
+	if _, found := p.p["sshkeyenabled"]; !found {
+		p.SetSshkeyenabled(v)
+	}
+}
+
 func (p *RegisterTemplateParams) SetTemplatetag(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1731,6 +3302,13 @@ func (p *RegisterTemplateParams) SetTemplatetag(v string) {
 	return
 }
 
+// SetTemplatetagIfUnset sets templatetag to v, unless it is already set
+func (p *RegisterTemplateParams) SetTemplatetagIfUnset(v string) {
+	if _, found := p.p["templatetag"]; !found {
+		p.SetTemplatetag(v)
+	}
+}
+
 func (p *RegisterTemplateParams) SetUrl(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1739,6 +3317,13 @@ func (p *RegisterTemplateParams) SetUrl(v string) {
 	return
 }
 
+// SetUrlIfUnset sets url to v, unless it is already set
+func (p *RegisterTemplateParams) SetUrlIfUnset(v string) {
+	if _, found := p.p["url"]; !found {
+		p.SetUrl(v)
+	}
+}
+
 func (p *RegisterTemplateParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1747,9 +3332,17 @@ func (p *RegisterTemplateParams) SetZoneid(v string) {
 	return
 }
 
+// SetZoneidIfUnset sets zoneid to v, unless it is already set
+func (p *RegisterTemplateParams) SetZoneidIfUnset(v string) {
+	if _, found := p.p["zoneid"]; !found {
+		p.SetZoneid(v)
+	}
+}
+
 // You should always use this function to get a new RegisterTemplateParams instance,
-// as then you are sure you have configured all required params
-func (s *TemplateService) NewRegisterTemplateParams(displaytext string, format string, hypervisor string, name string, ostypeid string, url string, zoneid string) *RegisterTemplateParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *TemplateService) NewRegisterTemplateParams(displaytext string, format string, hypervisor string, name string, ostypeid string, url string, zoneid string, opts ...func(*RegisterTemplateParams)) *RegisterTemplateParams {
 	p := &RegisterTemplateParams{}
 	p.p = make(map[string]interface{})
 	p.p["displaytext"] = displaytext
@@ -1759,12 +3352,119 @@ func (s *TemplateService) NewRegisterTemplateParams(displaytext string, format s
 	p.p["ostypeid"] = ostypeid
 	p.p["url"] = url
 	p.p["zoneid"] = zoneid
+	for _, fn := range opts {
+		fn(p)
+	}
+	return p
+}
+
+// RegisterTemplateOpts contains all params that can be set on a RegisterTemplateParams.
+// Optional params are pointers (or slices and maps) which are only set when not nil.
+type RegisterTemplateOpts struct {
+	Account               *string
+	Bits                  *int
+	Checksum              *string
+	Details               map[string]string
+	Displaytext           string
+	Domainid              *string
+	Format                string
+	Hypervisor            HypervisorType
+	Isdynamicallyscalable *bool
+	Isextractable         *bool
+	Isfeatured            *bool
+	Ispublic              *bool
+	Isrouting             *bool
+	Name                  string
+	Ostypeid              string
+	Passwordenabled       *bool
+	Projectid             *string
+	Requireshvm           *bool
+	Sshkeyenabled         *bool
+	Templatetag           *string
+	Url                   string
+	Zoneid                string
+}
+
+// NewRegisterTemplateParamsFromOpts is an alternative for NewRegisterTemplateParams which takes all params using
+// an options struct, as that is easier to read for APIs with many required params
+func (s *TemplateService) NewRegisterTemplateParamsFromOpts(o RegisterTemplateOpts) *RegisterTemplateParams {
+	p := s.NewRegisterTemplateParams(o.Displaytext, o.Format, string(o.Hypervisor), o.Name, o.Ostypeid, o.Url, o.Zoneid)
+	if o.Account != nil {
+		p.p["account"] = *o.Account
+	}
+	if o.Bits != nil {
+		p.p["bits"] = *o.Bits
+	}
+	if o.Checksum != nil {
+		p.p["checksum"] = *o.Checksum
+	}
+	if o.Details != nil {
+		p.p["details"] = o.Details
+	}
+	if o.Domainid != nil {
+		p.p["domainid"] = *o.Domainid
+	}
+	if o.Isdynamicallyscalable != nil {
+		p.p["isdynamicallyscalable"] = *o.Isdynamicallyscalable
+	}
+	if o.Isextractable != nil {
+		p.p["isextractable"] = *o.Isextractable
+	}
+	if o.Isfeatured != nil {
+		p.p["isfeatured"] = *o.Isfeatured
+	}
+	if o.Ispublic != nil {
+		p.p["ispublic"] = *o.Ispublic
+	}
+	if o.Isrouting != nil {
+		p.p["isrouting"] = *o.Isrouting
+	}
+	if o.Passwordenabled != nil {
+		p.p["passwordenabled"] = *o.Passwordenabled
+	}
+	if o.Projectid != nil {
+		p.p["projectid"] = *o.Projectid
+	}
+	if o.Requireshvm != nil {
+		p.p["requireshvm"] = *o.Requireshvm
+	}
+	if o.Sshkeyenabled != nil {
+		p.p["sshkeyenabled"] = *o.Sshkeyenabled
+	}
+	if o.Templatetag != nil {
+		p.p["templatetag"] = *o.Templatetag
+	}
 	return p
 }
 
 // Registers an existing template into the CloudStack cloud.
-func (s *TemplateService) RegisterTemplate(p *RegisterTemplateParams) (*RegisterTemplateResponse, error) {
-	resp, err := s.cs.newRequest("registerTemplate", p.toURLValues())
+func (s *TemplateService) RegisterTemplate(p *RegisterTemplateParams, opts ...OptionFunc) (*RegisterTemplateResponse, error) {
+	return s.RegisterTemplateWithContext(context.Background(), p, opts...)
+}
+
+// RegisterTemplateWithContext is the same as RegisterTemplate, but the request is cancelled when the context is done
+func (s *TemplateService) RegisterTemplateWithContext(ctx context.Context, p *RegisterTemplateParams, opts ...OptionFunc) (*RegisterTemplateResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.RegisterTemplateRawWithContext(ctx, p.toURLValues())
+}
+
+// RegisterTemplateRaw is the same as RegisterTemplate, but takes the params as url.Values instead of typed params
+func (s *TemplateService) RegisterTemplateRaw(v url.Values) (*RegisterTemplateResponse, error) {
+	return s.RegisterTemplateRawWithContext(context.Background(), v)
+}
+
+// RegisterTemplateRawWithContext is the same as RegisterTemplateRaw, but the request is cancelled when the context is done
+func (s *TemplateService) RegisterTemplateRawWithContext(ctx context.Context, v url.Values) (*RegisterTemplateResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "registerTemplate", "registertemplateresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -1783,47 +3483,57 @@ type RegisterTemplateResponse struct {
 }
 
 type RegisterTemplate struct {
-	Account               string            `json:"account"`
-	Accountid             string            `json:"accountid"`
-	Bootable              bool              `json:"bootable"`
-	Checksum              string            `json:"checksum"`
-	Created               string            `json:"created"`
-	CrossZones            bool              `json:"crossZones"`
-	Details               map[string]string `json:"details"`
-	Displaytext           string            `json:"displaytext"`
-	Domain                string            `json:"domain"`
-	Domainid              string            `json:"domainid"`
-	Format                string            `json:"format"`
-	Hostid                string            `json:"hostid"`
-	Hostname              string            `json:"hostname"`
-	Hypervisor            string            `json:"hypervisor"`
-	Id                    string            `json:"id"`
-	Isdynamicallyscalable bool              `json:"isdynamicallyscalable"`
-	Isextractable         bool              `json:"isextractable"`
-	Isfeatured            bool              `json:"isfeatured"`
-	Ispublic              bool              `json:"ispublic"`
-	Isready               bool              `json:"isready"`
-	Name                  string            `json:"name"`
-	Ostypeid              string            `json:"ostypeid"`
-	Ostypename            string            `json:"ostypename"`
-	Passwordenabled       bool              `json:"passwordenabled"`
-	Project               string            `json:"project"`
-	Projectid             string            `json:"projectid"`
-	Removed               string            `json:"removed"`
-	Size                  int64             `json:"size"`
-	Sourcetemplateid      string            `json:"sourcetemplateid"`
-	Sshkeyenabled         bool              `json:"sshkeyenabled"`
-	Status                string            `json:"status"`
-	Templatetag           string            `json:"templatetag"`
-	Templatetype          string            `json:"templatetype"`
-	Zoneid                string            `json:"zoneid"`
-	Zonename              string            `json:"zonename"`
+	Account               string    `json:"account"`
+	Accountid             string    `json:"accountid"`
+	Bootable              bool      `json:"bootable"`
+	Checksum              string    `json:"checksum"`
+	Created               string    `json:"created"`
+	CrossZones            bool      `json:"crossZones"`
+	Details               StringMap `json:"details"`
+	Displaytext           string    `json:"displaytext"`
+	Domain                string    `json:"domain"`
+	Domainid              string    `json:"domainid"`
+	Format                string    `json:"format"`
+	Hostid                string    `json:"hostid"`
+	Hostname              string    `json:"hostname"`
+	Hypervisor            string    `json:"hypervisor"`
+	Id                    string    `json:"id"`
+	Isdynamicallyscalable bool      `json:"isdynamicallyscalable"`
+	Isextractable         bool      `json:"isextractable"`
+	Isfeatured            bool      `json:"isfeatured"`
+	Ispublic              bool      `json:"ispublic"`
+	Isready               bool      `json:"isready"`
+	Name                  string    `json:"name"`
+	Ostypeid              string    `json:"ostypeid"`
+	Ostypename            string    `json:"ostypename"`
+	Passwordenabled       bool      `json:"passwordenabled"`
+	Project               string    `json:"project"`
+	Projectid             string    `json:"projectid"`
+	Removed               string    `json:"removed"`
+	Size                  int64     `json:"size"`
+	Sourcetemplateid      string    `json:"sourcetemplateid"`
+	Sshkeyenabled         bool      `json:"sshkeyenabled"`
+	Status                string    `json:"status"`
+	Templatetag           string    `json:"templatetag"`
+	Templatetype          string    `json:"templatetype"`
+	Zoneid                string    `json:"zoneid"`
+	Zonename              string    `json:"zonename"`
 }
 
 type UpdateTemplateParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *UpdateTemplateParams) Clone() *UpdateTemplateParams {
+	c := &UpdateTemplateParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *UpdateTemplateParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1834,10 +3544,9 @@ func (p *UpdateTemplateParams) toURLValues() url.Values {
 		u.Set("bootable", vv)
 	}
 	if v, found := p.p["details"]; found {
-		i := 0
-		for k, vv := range v.(map[string]string) {
-			u.Set(fmt.Sprintf("details[%d].%s", i, k), vv)
-			i++
+		m := v.(map[string]string)
+		for i, k := range getSortedKeysFromMap(m) {
+			u.Set(fmt.Sprintf("details[%d].%s", i, k), m[k])
 		}
 	}
 	if v, found := p.p["displaytext"]; found {
@@ -1878,6 +3587,16 @@ func (p *UpdateTemplateParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *UpdateTemplateParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of updateTemplate", k)
+		}
+	}
+	return nil
+}
+
 func (p *UpdateTemplateParams) SetBootable(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1886,6 +3605,13 @@ func (p *UpdateTemplateParams) SetBootable(v bool) {
 	return
 }
 
+// SetBootableIfUnset sets bootable to v, unless it is already set
+func (p *UpdateTemplateParams) SetBootableIfUnset(v bool) {
+	if _, found := p.p["bootable"]; !found {
+		p.SetBootable(v)
+	}
+}
+
 func (p *UpdateTemplateParams) SetDetails(v map[string]string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1894,6 +3620,13 @@ func (p *UpdateTemplateParams) SetDetails(v map[string]string) {
 	return
 }
 
+// SetDetailsIfUnset sets details to v, unless it is already set
+func (p *UpdateTemplateParams) SetDetailsIfUnset(v map[string]string) {
+	if _, found := p.p["details"]; !found {
+		p.SetDetails(v)
+	}
+}
+
 func (p *UpdateTemplateParams) SetDisplaytext(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1902,6 +3635,13 @@ func (p *UpdateTemplateParams) SetDisplaytext(v string) {
 	return
 }
 
+// SetDisplaytextIfUnset sets displaytext to v, unless it is already set
+func (p *UpdateTemplateParams) SetDisplaytextIfUnset(v string) {
+	if _, found := p.p["displaytext"]; !found {
+		p.SetDisplaytext(v)
+	}
+}
+
 func (p *UpdateTemplateParams) SetFormat(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1910,6 +3650,13 @@ func (p *UpdateTemplateParams) SetFormat(v string) {
 	return
 }
 
+// SetFormatIfUnset sets format to v, unless it is already set
+func (p *UpdateTemplateParams) SetFormatIfUnset(v string) {
+	if _, found := p.p["format"]; !found {
+		p.SetFormat(v)
+	}
+}
+
 func (p *UpdateTemplateParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1918,6 +3665,13 @@ func (p *UpdateTemplateParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *UpdateTemplateParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 func (p *UpdateTemplateParams) SetIsdynamicallyscalable(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1926,6 +3680,13 @@ func (p *UpdateTemplateParams) SetIsdynamicallyscalable(v bool) {
 	return
 }
 
+// SetIsdynamicallyscalableIfUnset sets isdynamicallyscalable to v, unless it is already set
+func (p *UpdateTemplateParams) SetIsdynamicallyscalableIfUnset(v bool) {
+	if _, found := p.p["isdynamicallyscalable"]; !found {
+		p.SetIsdynamicallyscalable(v)
+	}
+}
+
 func (p *UpdateTemplateParams) SetIsrouting(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1934,6 +3695,13 @@ func (p *UpdateTemplateParams) SetIsrouting(v bool) {
 	return
 }
 
+// SetIsroutingIfUnset sets isrouting to v, unless it is already set
+func (p *UpdateTemplateParams) SetIsroutingIfUnset(v bool) {
+	if _, found := p.p["isrouting"]; !found {
+		p.SetIsrouting(v)
+	}
+}
+
 func (p *UpdateTemplateParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1942,6 +3710,13 @@ func (p *UpdateTemplateParams) SetName(v string) {
 	return
 }
 
+// SetNameIfUnset sets name to v, unless it is already set
+func (p *UpdateTemplateParams) SetNameIfUnset(v string) {
+	if _, found := p.p["name"]; !found {
+		p.SetName(v)
+	}
+}
+
 func (p *UpdateTemplateParams) SetOstypeid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1950,6 +3725,13 @@ func (p *UpdateTemplateParams) SetOstypeid(v string) {
 	return
 }
 
+// SetOstypeidIfUnset sets ostypeid to v, unless it is already set
+func (p *UpdateTemplateParams) SetOstypeidIfUnset(v string) {
+	if _, found := p.p["ostypeid"]; !found {
+		p.SetOstypeid(v)
+	}
+}
+
 func (p *UpdateTemplateParams) SetPasswordenabled(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1958,6 +3740,13 @@ func (p *UpdateTemplateParams) SetPasswordenabled(v bool) {
 	return
 }
 
+// SetPasswordenabledIfUnset sets passwordenabled to v, unless it is already set
+func (p *UpdateTemplateParams) SetPasswordenabledIfUnset(v bool) {
+	if _, found := p.p["passwordenabled"]; !found {
+		p.SetPasswordenabled(v)
+	}
+}
+
 func (p *UpdateTemplateParams) SetRequireshvm(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1966,6 +3755,13 @@ func (p *UpdateTemplateParams) SetRequireshvm(v bool) {
 	return
 }
 
+// SetRequireshvmIfUnset sets requireshvm to v, unless it is already set
+func (p *UpdateTemplateParams) SetRequireshvmIfUnset(v bool) {
+	if _, found := p.p["requireshvm"]; !found {
+		p.SetRequireshvm(v)
+	}
+}
+
 func (p *UpdateTemplateParams) SetSortkey(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1974,18 +3770,54 @@ func (p *UpdateTemplateParams) SetSortkey(v int) {
 	return
 }
 
+// SetSortkeyIfUnset sets sortkey to v, unless it is already set
+func (p *UpdateTemplateParams) SetSortkeyIfUnset(v int) {
+	if _, found := p.p["sortkey"]; !found {
+		p.SetSortkey(v)
+	}
+}
+
 // You should always use this function to get a new UpdateTemplateParams instance,
-// as then you are sure you have configured all required params
-func (s *TemplateService) NewUpdateTemplateParams(id string) *UpdateTemplateParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *TemplateService) NewUpdateTemplateParams(id string, opts ...func(*UpdateTemplateParams)) *UpdateTemplateParams {
 	p := &UpdateTemplateParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Updates attributes of a template.
-func (s *TemplateService) UpdateTemplate(p *UpdateTemplateParams) (*UpdateTemplateResponse, error) {
-	resp, err := s.cs.newRequest("updateTemplate", p.toURLValues())
+func (s *TemplateService) UpdateTemplate(p *UpdateTemplateParams, opts ...OptionFunc) (*UpdateTemplateResponse, error) {
+	return s.UpdateTemplateWithContext(context.Background(), p, opts...)
+}
+
+// UpdateTemplateWithContext is the same as UpdateTemplate, but the request is cancelled when the context is done
+func (s *TemplateService) UpdateTemplateWithContext(ctx context.Context, p *UpdateTemplateParams, opts ...OptionFunc) (*UpdateTemplateResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.UpdateTemplateRawWithContext(ctx, p.toURLValues())
+}
+
+// UpdateTemplateRaw is the same as UpdateTemplate, but takes the params as url.Values instead of typed params
+func (s *TemplateService) UpdateTemplateRaw(v url.Values) (*UpdateTemplateResponse, error) {
+	return s.UpdateTemplateRawWithContext(context.Background(), v)
+}
+
+// UpdateTemplateRawWithContext is the same as UpdateTemplateRaw, but the request is cancelled when the context is done
+func (s *TemplateService) UpdateTemplateRawWithContext(ctx context.Context, v url.Values) (*UpdateTemplateResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "updateTemplate", "updatetemplateresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -1999,47 +3831,57 @@ func (s *TemplateService) UpdateTemplate(p *UpdateTemplateParams) (*UpdateTempla
 }
 
 type UpdateTemplateResponse struct {
-	Account               string            `json:"account"`
-	Accountid             string            `json:"accountid"`
-	Bootable              bool              `json:"bootable"`
-	Checksum              string            `json:"checksum"`
-	Created               string            `json:"created"`
-	CrossZones            bool              `json:"crossZones"`
-	Details               map[string]string `json:"details"`
-	Displaytext           string            `json:"displaytext"`
-	Domain                string            `json:"domain"`
-	Domainid              string            `json:"domainid"`
-	Format                string            `json:"format"`
-	Hostid                string            `json:"hostid"`
-	Hostname              string            `json:"hostname"`
-	Hypervisor            string            `json:"hypervisor"`
-	Id                    string            `json:"id"`
-	Isdynamicallyscalable bool              `json:"isdynamicallyscalable"`
-	Isextractable         bool              `json:"isextractable"`
-	Isfeatured            bool              `json:"isfeatured"`
-	Ispublic              bool              `json:"ispublic"`
-	Isready               bool              `json:"isready"`
-	Name                  string            `json:"name"`
-	Ostypeid              string            `json:"ostypeid"`
-	Ostypename            string            `json:"ostypename"`
-	Passwordenabled       bool              `json:"passwordenabled"`
-	Project               string            `json:"project"`
-	Projectid             string            `json:"projectid"`
-	Removed               string            `json:"removed"`
-	Size                  int64             `json:"size"`
-	Sourcetemplateid      string            `json:"sourcetemplateid"`
-	Sshkeyenabled         bool              `json:"sshkeyenabled"`
-	Status                string            `json:"status"`
-	Templatetag           string            `json:"templatetag"`
-	Templatetype          string            `json:"templatetype"`
-	Zoneid                string            `json:"zoneid"`
-	Zonename              string            `json:"zonename"`
+	Account               string    `json:"account"`
+	Accountid             string    `json:"accountid"`
+	Bootable              bool      `json:"bootable"`
+	Checksum              string    `json:"checksum"`
+	Created               string    `json:"created"`
+	CrossZones            bool      `json:"crossZones"`
+	Details               StringMap `json:"details"`
+	Displaytext           string    `json:"displaytext"`
+	Domain                string    `json:"domain"`
+	Domainid              string    `json:"domainid"`
+	Format                string    `json:"format"`
+	Hostid                string    `json:"hostid"`
+	Hostname              string    `json:"hostname"`
+	Hypervisor            string    `json:"hypervisor"`
+	Id                    string    `json:"id"`
+	Isdynamicallyscalable bool      `json:"isdynamicallyscalable"`
+	Isextractable         bool      `json:"isextractable"`
+	Isfeatured            bool      `json:"isfeatured"`
+	Ispublic              bool      `json:"ispublic"`
+	Isready               bool      `json:"isready"`
+	Name                  string    `json:"name"`
+	Ostypeid              string    `json:"ostypeid"`
+	Ostypename            string    `json:"ostypename"`
+	Passwordenabled       bool      `json:"passwordenabled"`
+	Project               string    `json:"project"`
+	Projectid             string    `json:"projectid"`
+	Removed               string    `json:"removed"`
+	Size                  int64     `json:"size"`
+	Sourcetemplateid      string    `json:"sourcetemplateid"`
+	Sshkeyenabled         bool      `json:"sshkeyenabled"`
+	Status                string    `json:"status"`
+	Templatetag           string    `json:"templatetag"`
+	Templatetype          string    `json:"templatetype"`
+	Zoneid                string    `json:"zoneid"`
+	Zonename              string    `json:"zonename"`
 }
 
 type UpdateTemplatePermissionsParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *UpdateTemplatePermissionsParams) Clone() *UpdateTemplatePermissionsParams {
+	c := &UpdateTemplatePermissionsParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *UpdateTemplatePermissionsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -2074,6 +3916,16 @@ func (p *UpdateTemplatePermissionsParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *UpdateTemplatePermissionsParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of updateTemplatePermissions", k)
+		}
+	}
+	return nil
+}
+
 func (p *UpdateTemplatePermissionsParams) SetAccounts(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2082,6 +3934,18 @@ func (p *UpdateTemplatePermissionsParams) SetAccounts(v []string) {
 	return
 }
 
+// SetAccountsIfUnset sets accounts to v, unless it is already set
+func (p *UpdateTemplatePermissionsParams) SetAccountsIfUnset(v []string) {
+	if _, found := p.p["accounts"]; !found {
+		p.SetAccounts(v)
+	}
+}
+
+// SetAccountsCSV splits v on commas and sets the resulting values
+func (p *UpdateTemplatePermissionsParams) SetAccountsCSV(v string) {
+	p.SetAccounts(splitCSV(v))
+}
+
 func (p *UpdateTemplatePermissionsParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2090,6 +3954,13 @@ func (p *UpdateTemplatePermissionsParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *UpdateTemplatePermissionsParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 func (p *UpdateTemplatePermissionsParams) SetIsextractable(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2098,6 +3969,13 @@ func (p *UpdateTemplatePermissionsParams) SetIsextractable(v bool) {
 	return
 }
 
+// SetIsextractableIfUnset sets isextractable to v, unless it is already set
+func (p *UpdateTemplatePermissionsParams) SetIsextractableIfUnset(v bool) {
+	if _, found := p.p["isextractable"]; !found {
+		p.SetIsextractable(v)
+	}
+}
+
 func (p *UpdateTemplatePermissionsParams) SetIsfeatured(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2106,6 +3984,13 @@ func (p *UpdateTemplatePermissionsParams) SetIsfeatured(v bool) {
 	return
 }
 
+// SetIsfeaturedIfUnset sets isfeatured to v, unless it is already set
+func (p *UpdateTemplatePermissionsParams) SetIsfeaturedIfUnset(v bool) {
+	if _, found := p.p["isfeatured"]; !found {
+		p.SetIsfeatured(v)
+	}
+}
+
 func (p *UpdateTemplatePermissionsParams) SetIspublic(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2114,6 +3999,13 @@ func (p *UpdateTemplatePermissionsParams) SetIspublic(v bool) {
 	return
 }
 
+// SetIspublicIfUnset sets ispublic to v, unless it is already set
+func (p *UpdateTemplatePermissionsParams) SetIspublicIfUnset(v bool) {
+	if _, found := p.p["ispublic"]; !found {
+		p.SetIspublic(v)
+	}
+}
+
 func (p *UpdateTemplatePermissionsParams) SetOp(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2122,6 +4014,13 @@ func (p *UpdateTemplatePermissionsParams) SetOp(v string) {
 	return
 }
 
+// SetOpIfUnset sets op to v, unless it is already set
+func (p *UpdateTemplatePermissionsParams) SetOpIfUnset(v string) {
+	if _, found := p.p["op"]; !found {
+		p.SetOp(v)
+	}
+}
+
 func (p *UpdateTemplatePermissionsParams) SetProjectids(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2130,18 +4029,59 @@ func (p *UpdateTemplatePermissionsParams) SetProjectids(v []string) {
 	return
 }
 
+// SetProjectidsIfUnset sets projectids to v, unless it is already set
+func (p *UpdateTemplatePermissionsParams) SetProjectidsIfUnset(v []string) {
+	if _, found := p.p["projectids"]; !found {
+		p.SetProjectids(v)
+	}
+}
+
+// SetProjectidsCSV splits v on commas and sets the resulting values
+func (p *UpdateTemplatePermissionsParams) SetProjectidsCSV(v string) {
+	p.SetProjectids(splitCSV(v))
+}
+
 // You should always use this function to get a new UpdateTemplatePermissionsParams instance,
-// as then you are sure you have configured all required params
-func (s *TemplateService) NewUpdateTemplatePermissionsParams(id string) *UpdateTemplatePermissionsParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *TemplateService) NewUpdateTemplatePermissionsParams(id string, opts ...func(*UpdateTemplatePermissionsParams)) *UpdateTemplatePermissionsParams {
 	p := &UpdateTemplatePermissionsParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Updates a template visibility permissions. A public template is visible to all accounts within the same domain. A private template is visible only to the owner of the template. A priviledged template is a private template with account permissions added. Only accounts specified under the template permissions are visible to them.
-func (s *TemplateService) UpdateTemplatePermissions(p *UpdateTemplatePermissionsParams) (*UpdateTemplatePermissionsResponse, error) {
-	resp, err := s.cs.newRequest("updateTemplatePermissions", p.toURLValues())
+func (s *TemplateService) UpdateTemplatePermissions(p *UpdateTemplatePermissionsParams, opts ...OptionFunc) (*UpdateTemplatePermissionsResponse, error) {
+	return s.UpdateTemplatePermissionsWithContext(context.Background(), p, opts...)
+}
+
+// UpdateTemplatePermissionsWithContext is the same as UpdateTemplatePermissions, but the request is cancelled when the context is done
+func (s *TemplateService) UpdateTemplatePermissionsWithContext(ctx context.Context, p *UpdateTemplatePermissionsParams, opts ...OptionFunc) (*UpdateTemplatePermissionsResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.UpdateTemplatePermissionsRawWithContext(ctx, p.toURLValues())
+}
+
+// UpdateTemplatePermissionsRaw is the same as UpdateTemplatePermissions, but takes the params as url.Values instead of typed params
+func (s *TemplateService) UpdateTemplatePermissionsRaw(v url.Values) (*UpdateTemplatePermissionsResponse, error) {
+	return s.UpdateTemplatePermissionsRawWithContext(context.Background(), v)
+}
+
+// UpdateTemplatePermissionsRawWithContext is the same as UpdateTemplatePermissionsRaw, but the request is cancelled when the context is done
+func (s *TemplateService) UpdateTemplatePermissionsRawWithContext(ctx context.Context, v url.Values) (*UpdateTemplatePermissionsResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "updateTemplatePermissions", "updatetemplatepermissionsresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -2182,6 +4122,16 @@ type UpgradeRouterTemplateParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *UpgradeRouterTemplateParams) Clone() *UpgradeRouterTemplateParams {
+	c := &UpgradeRouterTemplateParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *UpgradeRouterTemplateParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -2216,6 +4166,13 @@ func (p *UpgradeRouterTemplateParams) SetAccount(v string) {
 	return
 }
 
+// SetAccountIfUnset sets account to v, unless it is already set
+func (p *UpgradeRouterTemplateParams) SetAccountIfUnset(v string) {
+	if _, found := p.p["account"]; !found {
+		p.SetAccount(v)
+	}
+}
+
 func (p *UpgradeRouterTemplateParams) SetClusterid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2224,6 +4181,13 @@ func (p *UpgradeRouterTemplateParams) SetClusterid(v string) {
 	return
 }
 
+// SetClusteridIfUnset sets clusterid to v, unless it is already set
+func (p *UpgradeRouterTemplateParams) SetClusteridIfUnset(v string) {
+	if _, found := p.p["clusterid"]; !found {
+		p.SetClusterid(v)
+	}
+}
+
 func (p *UpgradeRouterTemplateParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2232,6 +4196,13 @@ func (p *UpgradeRouterTemplateParams) SetDomainid(v string) {
 	return
 }
 
+// SetDomainidIfUnset sets domainid to v, unless it is already set
+func (p *UpgradeRouterTemplateParams) SetDomainidIfUnset(v string) {
+	if _, found := p.p["domainid"]; !found {
+		p.SetDomainid(v)
+	}
+}
+
 func (p *UpgradeRouterTemplateParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2240,6 +4211,13 @@ func (p *UpgradeRouterTemplateParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *UpgradeRouterTemplateParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 func (p *UpgradeRouterTemplateParams) SetPodid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2248,6 +4226,13 @@ func (p *UpgradeRouterTemplateParams) SetPodid(v string) {
 	return
 }
 
+// SetPodidIfUnset sets podid to v, unless it is already set
+func (p *UpgradeRouterTemplateParams) SetPodidIfUnset(v string) {
+	if _, found := p.p["podid"]; !found {
+		p.SetPodid(v)
+	}
+}
+
 func (p *UpgradeRouterTemplateParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2256,17 +4241,49 @@ func (p *UpgradeRouterTemplateParams) SetZoneid(v string) {
 	return
 }
 
+// SetZoneidIfUnset sets zoneid to v, unless it is already set
+func (p *UpgradeRouterTemplateParams) SetZoneidIfUnset(v string) {
+	if _, found := p.p["zoneid"]; !found {
+		p.SetZoneid(v)
+	}
+}
+
 // You should always use this function to get a new UpgradeRouterTemplateParams instance,
-// as then you are sure you have configured all required params
-func (s *TemplateService) NewUpgradeRouterTemplateParams() *UpgradeRouterTemplateParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *TemplateService) NewUpgradeRouterTemplateParams(opts ...func(*UpgradeRouterTemplateParams)) *UpgradeRouterTemplateParams {
 	p := &UpgradeRouterTemplateParams{}
 	p.p = make(map[string]interface{})
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Upgrades router to use newer template
-func (s *TemplateService) UpgradeRouterTemplate(p *UpgradeRouterTemplateParams) (*UpgradeRouterTemplateResponse, error) {
-	resp, err := s.cs.newRequest("upgradeRouterTemplate", p.toURLValues())
+func (s *TemplateService) UpgradeRouterTemplate(p *UpgradeRouterTemplateParams, opts ...OptionFunc) (*UpgradeRouterTemplateResponse, error) {
+	return s.UpgradeRouterTemplateWithContext(context.Background(), p, opts...)
+}
+
+// UpgradeRouterTemplateWithContext is the same as UpgradeRouterTemplate, but the request is cancelled when the context is done
+func (s *TemplateService) UpgradeRouterTemplateWithContext(ctx context.Context, p *UpgradeRouterTemplateParams, opts ...OptionFunc) (*UpgradeRouterTemplateResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	return s.UpgradeRouterTemplateRawWithContext(ctx, p.toURLValues())
+}
+
+// UpgradeRouterTemplateRaw is the same as UpgradeRouterTemplate, but takes the params as url.Values instead of typed params
+func (s *TemplateService) UpgradeRouterTemplateRaw(v url.Values) (*UpgradeRouterTemplateResponse, error) {
+	return s.UpgradeRouterTemplateRawWithContext(context.Background(), v)
+}
+
+// UpgradeRouterTemplateRawWithContext is the same as UpgradeRouterTemplateRaw, but the request is cancelled when the context is done
+func (s *TemplateService) UpgradeRouterTemplateRawWithContext(ctx context.Context, v url.Values) (*UpgradeRouterTemplateResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "upgradeRouterTemplate", "upgraderoutertemplateresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -17,7 +17,9 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/url"
 	"strconv"
@@ -28,6 +30,16 @@ type AddUcsManagerParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *AddUcsManagerParams) Clone() *AddUcsManagerParams {
+	c := &AddUcsManagerParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *AddUcsManagerParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -51,6 +63,16 @@ func (p *AddUcsManagerParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *AddUcsManagerParams) validate() error {
+	for _, k := range []string{"password", "url", "username", "zoneid"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of addUcsManager", k)
+		}
+	}
+	return nil
+}
+
 func (p *AddUcsManagerParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -59,6 +81,13 @@ func (p *AddUcsManagerParams) SetName(v string) {
 	return
 }
 
+// SetNameIfUnset sets name to v, unless it is already set
+func (p *AddUcsManagerParams) SetNameIfUnset(v string) {
+	if _, found := p.p["name"]; !found {
+		p.SetName(v)
+	}
+}
+
 func (p *AddUcsManagerParams) SetPassword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -67,6 +96,13 @@ func (p *AddUcsManagerParams) SetPassword(v string) {
 	return
 }
 
+// SetPasswordIfUnset sets password to v, unless it is already set
+func (p *AddUcsManagerParams) SetPasswordIfUnset(v string) {
+	if _, found := p.p["password"]; !found {
+		p.SetPassword(v)
+	}
+}
+
 func (p *AddUcsManagerParams) SetUrl(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -75,6 +111,13 @@ func (p *AddUcsManagerParams) SetUrl(v string) {
 	return
 }
 
+// SetUrlIfUnset sets url to v, unless it is already set
+func (p *AddUcsManagerParams) SetUrlIfUnset(v string) {
+	if _, found := p.p["url"]; !found {
+		p.SetUrl(v)
+	}
+}
+
 func (p *AddUcsManagerParams) SetUsername(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -83,6 +126,13 @@ func (p *AddUcsManagerParams) SetUsername(v string) {
 	return
 }
 
+// SetUsernameIfUnset sets username to v, unless it is already set
+func (p *AddUcsManagerParams) SetUsernameIfUnset(v string) {
+	if _, found := p.p["username"]; !found {
+		p.SetUsername(v)
+	}
+}
+
 func (p *AddUcsManagerParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -91,21 +141,77 @@ func (p *AddUcsManagerParams) SetZoneid(v string) {
 	return
 }
 
+// SetZoneidIfUnset sets zoneid to v, unless it is already set
+func (p *AddUcsManagerParams) SetZoneidIfUnset(v string) {
+	if _, found := p.p["zoneid"]; !found {
+		p.SetZoneid(v)
+	}
+}
+
 // You should always use this function to get a new AddUcsManagerParams instance,
-// as then you are sure you have configured all required params
-func (s *UCSService) NewAddUcsManagerParams(password string, url string, username string, zoneid string) *AddUcsManagerParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *UCSService) NewAddUcsManagerParams(password string, url string, username string, zoneid string, opts ...func(*AddUcsManagerParams)) *AddUcsManagerParams {
 	p := &AddUcsManagerParams{}
 	p.p = make(map[string]interface{})
 	p.p["password"] = password
 	p.p["url"] = url
 	p.p["username"] = username
 	p.p["zoneid"] = zoneid
+	for _, fn := range opts {
+		fn(p)
+	}
+	return p
+}
+
+// AddUcsManagerOpts contains all params that can be set on a AddUcsManagerParams.
+// Optional params are pointers (or slices and maps) which are only set when not nil.
+type AddUcsManagerOpts struct {
+	Name     *string
+	Password string
+	Url      string
+	Username string
+	Zoneid   string
+}
+
+// NewAddUcsManagerParamsFromOpts is an alternative for NewAddUcsManagerParams which takes all params using
+// an options struct, as that is easier to read for APIs with many required params
+func (s *UCSService) NewAddUcsManagerParamsFromOpts(o AddUcsManagerOpts) *AddUcsManagerParams {
+	p := s.NewAddUcsManagerParams(o.Password, o.Url, o.Username, o.Zoneid)
+	if o.Name != nil {
+		p.p["name"] = *o.Name
+	}
 	return p
 }
 
 // Adds a Ucs manager
-func (s *UCSService) AddUcsManager(p *AddUcsManagerParams) (*AddUcsManagerResponse, error) {
-	resp, err := s.cs.newRequest("addUcsManager", p.toURLValues())
+func (s *UCSService) AddUcsManager(p *AddUcsManagerParams, opts ...OptionFunc) (*AddUcsManagerResponse, error) {
+	return s.AddUcsManagerWithContext(context.Background(), p, opts...)
+}
+
+// AddUcsManagerWithContext is the same as AddUcsManager, but the request is cancelled when the context is done
+func (s *UCSService) AddUcsManagerWithContext(ctx context.Context, p *AddUcsManagerParams, opts ...OptionFunc) (*AddUcsManagerResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.AddUcsManagerRawWithContext(ctx, p.toURLValues())
+}
+
+// AddUcsManagerRaw is the same as AddUcsManager, but takes the params as url.Values instead of typed params
+func (s *UCSService) AddUcsManagerRaw(v url.Values) (*AddUcsManagerResponse, error) {
+	return s.AddUcsManagerRawWithContext(context.Background(), v)
+}
+
+// AddUcsManagerRawWithContext is the same as AddUcsManagerRaw, but the request is cancelled when the context is done
+func (s *UCSService) AddUcsManagerRawWithContext(ctx context.Context, v url.Values) (*AddUcsManagerResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "addUcsManager", "adducsmanagerresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -129,6 +235,16 @@ type AssociateUcsProfileToBladeParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *AssociateUcsProfileToBladeParams) Clone() *AssociateUcsProfileToBladeParams {
+	c := &AssociateUcsProfileToBladeParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *AssociateUcsProfileToBladeParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -146,6 +262,16 @@ func (p *AssociateUcsProfileToBladeParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *AssociateUcsProfileToBladeParams) validate() error {
+	for _, k := range []string{"bladeid", "profiledn", "ucsmanagerid"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of associateUcsProfileToBlade", k)
+		}
+	}
+	return nil
+}
+
 func (p *AssociateUcsProfileToBladeParams) SetBladeid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -154,6 +280,13 @@ func (p *AssociateUcsProfileToBladeParams) SetBladeid(v string) {
 	return
 }
 
+// SetBladeidIfUnset sets bladeid to v, unless it is already set
+func (p *AssociateUcsProfileToBladeParams) SetBladeidIfUnset(v string) {
+	if _, found := p.p["bladeid"]; !found {
+		p.SetBladeid(v)
+	}
+}
+
 func (p *AssociateUcsProfileToBladeParams) SetProfiledn(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -162,6 +295,13 @@ func (p *AssociateUcsProfileToBladeParams) SetProfiledn(v string) {
 	return
 }
 
+// SetProfilednIfUnset sets profiledn to v, unless it is already set
+func (p *AssociateUcsProfileToBladeParams) SetProfilednIfUnset(v string) {
+	if _, found := p.p["profiledn"]; !found {
+		p.SetProfiledn(v)
+	}
+}
+
 func (p *AssociateUcsProfileToBladeParams) SetUcsmanagerid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -170,20 +310,71 @@ func (p *AssociateUcsProfileToBladeParams) SetUcsmanagerid(v string) {
 	return
 }
 
+// SetUcsmanageridIfUnset sets ucsmanagerid to v, unless it is already set
+func (p *AssociateUcsProfileToBladeParams) SetUcsmanageridIfUnset(v string) {
+	if _, found := p.p["ucsmanagerid"]; !found {
+		p.SetUcsmanagerid(v)
+	}
+}
+
 // You should always use this function to get a new AssociateUcsProfileToBladeParams instance,
-// as then you are sure you have configured all required params
-func (s *UCSService) NewAssociateUcsProfileToBladeParams(bladeid string, profiledn string, ucsmanagerid string) *AssociateUcsProfileToBladeParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *UCSService) NewAssociateUcsProfileToBladeParams(bladeid string, profiledn string, ucsmanagerid string, opts ...func(*AssociateUcsProfileToBladeParams)) *AssociateUcsProfileToBladeParams {
 	p := &AssociateUcsProfileToBladeParams{}
 	p.p = make(map[string]interface{})
 	p.p["bladeid"] = bladeid
 	p.p["profiledn"] = profiledn
 	p.p["ucsmanagerid"] = ucsmanagerid
+	for _, fn := range opts {
+		fn(p)
+	}
+	return p
+}
+
+// AssociateUcsProfileToBladeOpts contains all params that can be set on a AssociateUcsProfileToBladeParams.
+// Optional params are pointers (or slices and maps) which are only set when not nil.
+type AssociateUcsProfileToBladeOpts struct {
+	Bladeid      string
+	Profiledn    string
+	Ucsmanagerid string
+}
+
+// NewAssociateUcsProfileToBladeParamsFromOpts is an alternative for NewAssociateUcsProfileToBladeParams which takes all params using
+// an options struct, as that is easier to read for APIs with many required params
+func (s *UCSService) NewAssociateUcsProfileToBladeParamsFromOpts(o AssociateUcsProfileToBladeOpts) *AssociateUcsProfileToBladeParams {
+	p := s.NewAssociateUcsProfileToBladeParams(o.Bladeid, o.Profiledn, o.Ucsmanagerid)
 	return p
 }
 
 // associate a profile to a blade
-func (s *UCSService) AssociateUcsProfileToBlade(p *AssociateUcsProfileToBladeParams) (*AssociateUcsProfileToBladeResponse, error) {
-	resp, err := s.cs.newRequest("associateUcsProfileToBlade", p.toURLValues())
+func (s *UCSService) AssociateUcsProfileToBlade(p *AssociateUcsProfileToBladeParams, opts ...OptionFunc) (*AssociateUcsProfileToBladeResponse, error) {
+	return s.AssociateUcsProfileToBladeWithContext(context.Background(), p, opts...)
+}
+
+// AssociateUcsProfileToBladeWithContext is the same as AssociateUcsProfileToBlade, but the request is cancelled when the context is done
+func (s *UCSService) AssociateUcsProfileToBladeWithContext(ctx context.Context, p *AssociateUcsProfileToBladeParams, opts ...OptionFunc) (*AssociateUcsProfileToBladeResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.AssociateUcsProfileToBladeRawWithContext(ctx, p.toURLValues())
+}
+
+// AssociateUcsProfileToBladeRaw is the same as AssociateUcsProfileToBlade, but takes the params as url.Values instead of typed params
+func (s *UCSService) AssociateUcsProfileToBladeRaw(v url.Values) (*AssociateUcsProfileToBladeResponse, error) {
+	return s.AssociateUcsProfileToBladeRawWithContext(context.Background(), v)
+}
+
+// AssociateUcsProfileToBladeRawWithContext is the same as AssociateUcsProfileToBladeRaw, but the request is cancelled when the context is done
+func (s *UCSService) AssociateUcsProfileToBladeRawWithContext(ctx context.Context, v url.Values) (*AssociateUcsProfileToBladeResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "associateUcsProfileToBlade", "associateucsprofiletobladeresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -195,9 +386,9 @@ func (s *UCSService) AssociateUcsProfileToBlade(p *AssociateUcsProfileToBladePar
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -229,6 +420,16 @@ type DeleteUcsManagerParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *DeleteUcsManagerParams) Clone() *DeleteUcsManagerParams {
+	c := &DeleteUcsManagerParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeleteUcsManagerParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -240,6 +441,16 @@ func (p *DeleteUcsManagerParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *DeleteUcsManagerParams) validate() error {
+	for _, k := range []string{"ucsmanagerid"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of deleteUcsManager", k)
+		}
+	}
+	return nil
+}
+
 func (p *DeleteUcsManagerParams) SetUcsmanagerid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -248,18 +459,54 @@ func (p *DeleteUcsManagerParams) SetUcsmanagerid(v string) {
 	return
 }
 
+// SetUcsmanageridIfUnset sets ucsmanagerid to v, unless it is already set
+func (p *DeleteUcsManagerParams) SetUcsmanageridIfUnset(v string) {
+	if _, found := p.p["ucsmanagerid"]; !found {
+		p.SetUcsmanagerid(v)
+	}
+}
+
 // You should always use this function to get a new DeleteUcsManagerParams instance,
-// as then you are sure you have configured all required params
-func (s *UCSService) NewDeleteUcsManagerParams(ucsmanagerid string) *DeleteUcsManagerParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *UCSService) NewDeleteUcsManagerParams(ucsmanagerid string, opts ...func(*DeleteUcsManagerParams)) *DeleteUcsManagerParams {
 	p := &DeleteUcsManagerParams{}
 	p.p = make(map[string]interface{})
 	p.p["ucsmanagerid"] = ucsmanagerid
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Delete a Ucs manager
-func (s *UCSService) DeleteUcsManager(p *DeleteUcsManagerParams) (*DeleteUcsManagerResponse, error) {
-	resp, err := s.cs.newRequest("deleteUcsManager", p.toURLValues())
+func (s *UCSService) DeleteUcsManager(p *DeleteUcsManagerParams, opts ...OptionFunc) (*DeleteUcsManagerResponse, error) {
+	return s.DeleteUcsManagerWithContext(context.Background(), p, opts...)
+}
+
+// DeleteUcsManagerWithContext is the same as DeleteUcsManager, but the request is cancelled when the context is done
+func (s *UCSService) DeleteUcsManagerWithContext(ctx context.Context, p *DeleteUcsManagerParams, opts ...OptionFunc) (*DeleteUcsManagerResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.DeleteUcsManagerRawWithContext(ctx, p.toURLValues())
+}
+
+// DeleteUcsManagerRaw is the same as DeleteUcsManager, but takes the params as url.Values instead of typed params
+func (s *UCSService) DeleteUcsManagerRaw(v url.Values) (*DeleteUcsManagerResponse, error) {
+	return s.DeleteUcsManagerRawWithContext(context.Background(), v)
+}
+
+// DeleteUcsManagerRawWithContext is the same as DeleteUcsManagerRaw, but the request is cancelled when the context is done
+func (s *UCSService) DeleteUcsManagerRawWithContext(ctx context.Context, v url.Values) (*DeleteUcsManagerResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteUcsManager", "deleteucsmanagerresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -300,6 +547,16 @@ type ListUcsBladesParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ListUcsBladesParams) Clone() *ListUcsBladesParams {
+	c := &ListUcsBladesParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListUcsBladesParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -322,6 +579,16 @@ func (p *ListUcsBladesParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *ListUcsBladesParams) validate() error {
+	for _, k := range []string{"ucsmanagerid"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of listUcsBlades", k)
+		}
+	}
+	return nil
+}
+
 func (p *ListUcsBladesParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -330,6 +597,13 @@ func (p *ListUcsBladesParams) SetKeyword(v string) {
 	return
 }
 
+// SetKeywordIfUnset sets keyword to v, unless it is already set
+func (p *ListUcsBladesParams) SetKeywordIfUnset(v string) {
+	if _, found := p.p["keyword"]; !found {
+		p.SetKeyword(v)
+	}
+}
+
 func (p *ListUcsBladesParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -338,6 +612,13 @@ func (p *ListUcsBladesParams) SetPage(v int) {
 	return
 }
 
+// SetPageIfUnset sets page to v, unless it is already set
+func (p *ListUcsBladesParams) SetPageIfUnset(v int) {
+	if _, found := p.p["page"]; !found {
+		p.SetPage(v)
+	}
+}
+
 func (p *ListUcsBladesParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -346,6 +627,13 @@ func (p *ListUcsBladesParams) SetPagesize(v int) {
 	return
 }
 
+// SetPagesizeIfUnset sets pagesize to v, unless it is already set
+func (p *ListUcsBladesParams) SetPagesizeIfUnset(v int) {
+	if _, found := p.p["pagesize"]; !found {
+		p.SetPagesize(v)
+	}
+}
+
 func (p *ListUcsBladesParams) SetUcsmanagerid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -354,18 +642,48 @@ func (p *ListUcsBladesParams) SetUcsmanagerid(v string) {
 	return
 }
 
+// SetUcsmanageridIfUnset sets ucsmanagerid to v, unless it is already set
+func (p *ListUcsBladesParams) SetUcsmanageridIfUnset(v string) {
+	if _, found := p.p["ucsmanagerid"]; !found {
+		p.SetUcsmanagerid(v)
+	}
+}
+
 // You should always use this function to get a new ListUcsBladesParams instance,
-// as then you are sure you have configured all required params
-func (s *UCSService) NewListUcsBladesParams(ucsmanagerid string) *ListUcsBladesParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *UCSService) NewListUcsBladesParams(ucsmanagerid string, opts ...func(*ListUcsBladesParams)) *ListUcsBladesParams {
 	p := &ListUcsBladesParams{}
 	p.p = make(map[string]interface{})
 	p.p["ucsmanagerid"] = ucsmanagerid
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // List ucs blades
 func (s *UCSService) ListUcsBlades(p *ListUcsBladesParams) (*ListUcsBladesResponse, error) {
-	resp, err := s.cs.newRequest("listUcsBlades", p.toURLValues())
+	return s.ListUcsBladesWithContext(context.Background(), p)
+}
+
+// ListUcsBladesWithContext is the same as ListUcsBlades, but the request is cancelled when the context is done
+func (s *UCSService) ListUcsBladesWithContext(ctx context.Context, p *ListUcsBladesParams) (*ListUcsBladesResponse, error) {
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.ListUcsBladesRawWithContext(ctx, p.toURLValues())
+}
+
+// ListUcsBladesRaw is the same as ListUcsBlades, but takes the params as url.Values instead of typed params
+func (s *UCSService) ListUcsBladesRaw(v url.Values) (*ListUcsBladesResponse, error) {
+	return s.ListUcsBladesRawWithContext(context.Background(), v)
+}
+
+// ListUcsBladesRawWithContext is the same as ListUcsBladesRaw, but the request is cancelled when the context is done
+func (s *UCSService) ListUcsBladesRawWithContext(ctx context.Context, v url.Values) (*ListUcsBladesResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "listUcsBlades", "listucsbladesresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -378,6 +696,93 @@ func (s *UCSService) ListUcsBlades(p *ListUcsBladesParams) (*ListUcsBladesRespon
 	return &r, nil
 }
 
+// StreamUcsBlades fetches the results of ListUcsBlades page by page in the background and sends them on the
+// returned channel. Both returned channels are closed when done. If fetching a page fails, or the context
+// is done before all results are sent, the error is sent on the error channel and fetching stops. A
+// request for a page in flight is cancelled when the context is done. The page and pagesize set in the
+// params are ignored.
+func (s *UCSService) StreamUcsBlades(ctx context.Context, p *ListUcsBladesParams) (<-chan *UcsBlade, <-chan error) {
+	ch := make(chan *UcsBlade)
+	errs := make(chan error, 1)
+
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesize(500)
+
+	go func() {
+		defer close(errs)
+		defer close(ch)
+
+		for page, count := 1, 0; ; page++ {
+			pp.SetPage(page)
+
+			l, err := s.ListUcsBladesWithContext(ctx, pp)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			for _, v := range l.UcsBlades {
+				select {
+				case ch <- v:
+				case <-ctx.Done():
+					errs <- ctx.Err()
+					return
+				}
+			}
+
+			count += len(l.UcsBlades)
+			if len(l.UcsBlades) < 500 || count >= l.Count {
+				return
+			}
+		}
+	}()
+
+	return ch, errs
+}
+
+// ListUcsBladesWhere returns all results of ListUcsBlades for which pred returns true. Note that the results are
+// filtered client-side, so all results matching the params are fetched first.
+// All pages are fetched, so the page and pagesize set in the params are ignored.
+func (s *UCSService) ListUcsBladesWhere(p *ListUcsBladesParams, pred func(*UcsBlade) bool) ([]*UcsBlade, error) {
+	var r []*UcsBlade
+	ch, errs := s.StreamUcsBlades(context.Background(), p)
+	for v := range ch {
+		if pred(v) {
+			r = append(r, v)
+		}
+	}
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ListAllUcsBlades returns the results of ListUcsBlades across all pages, by calling ListUcsBlades page by page until all
+// results are fetched. The pagesize set in the params is used for every page (500 if not set), and the
+// page set in the params is ignored.
+func (s *UCSService) ListAllUcsBlades(p *ListUcsBladesParams) ([]*UcsBlade, error) {
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesizeIfUnset(500)
+	pagesize := pp.p["pagesize"].(int)
+
+	var r []*UcsBlade
+	for page := 1; ; page++ {
+		pp.SetPage(page)
+
+		l, err := s.ListUcsBlades(pp)
+		if err != nil {
+			return nil, err
+		}
+		r = append(r, l.UcsBlades...)
+
+		if len(l.UcsBlades) < pagesize || len(r) >= l.Count {
+			return r, nil
+		}
+	}
+}
+
 type ListUcsBladesResponse struct {
 	Count     int         `json:"count"`
 	UcsBlades []*UcsBlade `json:"ucsblade"`
@@ -395,6 +800,16 @@ type ListUcsManagersParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ListUcsManagersParams) Clone() *ListUcsManagersParams {
+	c := &ListUcsManagersParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListUcsManagersParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -428,6 +843,13 @@ func (p *ListUcsManagersParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *ListUcsManagersParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 func (p *ListUcsManagersParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -436,6 +858,13 @@ func (p *ListUcsManagersParams) SetKeyword(v string) {
 	return
 }
 
+// SetKeywordIfUnset sets keyword to v, unless it is already set
+func (p *ListUcsManagersParams) SetKeywordIfUnset(v string) {
+	if _, found := p.p["keyword"]; !found {
+		p.SetKeyword(v)
+	}
+}
+
 func (p *ListUcsManagersParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -444,6 +873,13 @@ func (p *ListUcsManagersParams) SetPage(v int) {
 	return
 }
 
+// SetPageIfUnset sets page to v, unless it is already set
+func (p *ListUcsManagersParams) SetPageIfUnset(v int) {
+	if _, found := p.p["page"]; !found {
+		p.SetPage(v)
+	}
+}
+
 func (p *ListUcsManagersParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -452,6 +888,13 @@ func (p *ListUcsManagersParams) SetPagesize(v int) {
 	return
 }
 
+// SetPagesizeIfUnset sets pagesize to v, unless it is already set
+func (p *ListUcsManagersParams) SetPagesizeIfUnset(v int) {
+	if _, found := p.p["pagesize"]; !found {
+		p.SetPagesize(v)
+	}
+}
+
 func (p *ListUcsManagersParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -460,14 +903,32 @@ func (p *ListUcsManagersParams) SetZoneid(v string) {
 	return
 }
 
+// SetZoneidIfUnset sets zoneid to v, unless it is already set
+func (p *ListUcsManagersParams) SetZoneidIfUnset(v string) {
+	if _, found := p.p["zoneid"]; !found {
+		p.SetZoneid(v)
+	}
+}
+
 // You should always use this function to get a new ListUcsManagersParams instance,
-// as then you are sure you have configured all required params
-func (s *UCSService) NewListUcsManagersParams() *ListUcsManagersParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *UCSService) NewListUcsManagersParams(opts ...func(*ListUcsManagersParams)) *ListUcsManagersParams {
 	p := &ListUcsManagersParams{}
 	p.p = make(map[string]interface{})
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
+func (p *ListUcsManagersParams) setFirstMatch() {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p[firstMatchKey] = true
+}
+
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *UCSService) GetUcsManagerID(keyword string, opts ...OptionFunc) (string, int, error) {
 	p := &ListUcsManagersParams{}
@@ -475,7 +936,7 @@ func (s *UCSService) GetUcsManagerID(keyword string, opts ...OptionFunc) (string
 
 	p.p["keyword"] = keyword
 
-	for _, fn := range append(s.cs.options, opts...) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
 		if err := fn(s.cs, p); err != nil {
 			return "", -1, err
 		}
@@ -487,7 +948,7 @@ func (s *UCSService) GetUcsManagerID(keyword string, opts ...OptionFunc) (string
 	}
 
 	if l.Count == 0 {
-		return "", l.Count, fmt.Errorf("No match found for %s: %+v", keyword, l)
+		return "", l.Count, fmt.Errorf("%w for %s: %+v", ErrNotFound, keyword, l)
 	}
 
 	if l.Count == 1 {
@@ -500,6 +961,10 @@ func (s *UCSService) GetUcsManagerID(keyword string, opts ...OptionFunc) (string
 				return v.Id, l.Count, nil
 			}
 		}
+
+		if _, ok := p.p[firstMatchKey]; ok && len(l.UcsManagers) > 0 {
+			return l.UcsManagers[0].Id, l.Count, nil
+		}
 	}
 	return "", l.Count, fmt.Errorf("Could not find an exact match for %s: %+v", keyword, l)
 }
@@ -525,7 +990,7 @@ func (s *UCSService) GetUcsManagerByID(id string, opts ...OptionFunc) (*UcsManag
 
 	p.p["id"] = id
 
-	for _, fn := range append(s.cs.options, opts...) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
 		if err := fn(s.cs, p); err != nil {
 			return nil, -1, err
 		}
@@ -536,24 +1001,56 @@ func (s *UCSService) GetUcsManagerByID(id string, opts ...OptionFunc) (*UcsManag
 		if strings.Contains(err.Error(), fmt.Sprintf(
 			"Invalid parameter id value=%s due to incorrect long value format, "+
 				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+			return nil, 0, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
 	}
 
 	if l.Count == 1 {
 		return l.UcsManagers[0], l.Count, nil
 	}
+
+	if _, ok := p.p[firstMatchKey]; ok && len(l.UcsManagers) > 0 {
+		return l.UcsManagers[0], l.Count, nil
+	}
 	return nil, l.Count, fmt.Errorf("There is more then one result for UcsManager UUID: %s!", id)
 }
 
+// UcsManagerExists returns true if a UcsManager with the given ID exists. When no match is found
+// it returns false, while any other error is returned as is.
+func (s *UCSService) UcsManagerExists(id string, opts ...OptionFunc) (bool, error) {
+	_, count, err := s.GetUcsManagerByID(id, opts...)
+	if errors.Is(err, ErrNotFound) {
+		return false, nil
+	}
+	if count > 0 {
+		return true, nil
+	}
+	return false, err
+}
+
 // List ucs manager
 func (s *UCSService) ListUcsManagers(p *ListUcsManagersParams) (*ListUcsManagersResponse, error) {
-	resp, err := s.cs.newRequest("listUcsManagers", p.toURLValues())
+	return s.ListUcsManagersWithContext(context.Background(), p)
+}
+
+// ListUcsManagersWithContext is the same as ListUcsManagers, but the request is cancelled when the context is done
+func (s *UCSService) ListUcsManagersWithContext(ctx context.Context, p *ListUcsManagersParams) (*ListUcsManagersResponse, error) {
+	return s.ListUcsManagersRawWithContext(ctx, p.toURLValues())
+}
+
+// ListUcsManagersRaw is the same as ListUcsManagers, but takes the params as url.Values instead of typed params
+func (s *UCSService) ListUcsManagersRaw(v url.Values) (*ListUcsManagersResponse, error) {
+	return s.ListUcsManagersRawWithContext(context.Background(), v)
+}
+
+// ListUcsManagersRawWithContext is the same as ListUcsManagersRaw, but the request is cancelled when the context is done
+func (s *UCSService) ListUcsManagersRawWithContext(ctx context.Context, v url.Values) (*ListUcsManagersResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "listUcsManagers", "listucsmanagersresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -566,6 +1063,93 @@ func (s *UCSService) ListUcsManagers(p *ListUcsManagersParams) (*ListUcsManagers
 	return &r, nil
 }
 
+// StreamUcsManagers fetches the results of ListUcsManagers page by page in the background and sends them on the
+// returned channel. Both returned channels are closed when done. If fetching a page fails, or the context
+// is done before all results are sent, the error is sent on the error channel and fetching stops. A
+// request for a page in flight is cancelled when the context is done. The page and pagesize set in the
+// params are ignored.
+func (s *UCSService) StreamUcsManagers(ctx context.Context, p *ListUcsManagersParams) (<-chan *UcsManager, <-chan error) {
+	ch := make(chan *UcsManager)
+	errs := make(chan error, 1)
+
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesize(500)
+
+	go func() {
+		defer close(errs)
+		defer close(ch)
+
+		for page, count := 1, 0; ; page++ {
+			pp.SetPage(page)
+
+			l, err := s.ListUcsManagersWithContext(ctx, pp)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			for _, v := range l.UcsManagers {
+				select {
+				case ch <- v:
+				case <-ctx.Done():
+					errs <- ctx.Err()
+					return
+				}
+			}
+
+			count += len(l.UcsManagers)
+			if len(l.UcsManagers) < 500 || count >= l.Count {
+				return
+			}
+		}
+	}()
+
+	return ch, errs
+}
+
+// ListUcsManagersWhere returns all results of ListUcsManagers for which pred returns true. Note that the results are
+// filtered client-side, so all results matching the params are fetched first.
+// All pages are fetched, so the page and pagesize set in the params are ignored.
+func (s *UCSService) ListUcsManagersWhere(p *ListUcsManagersParams, pred func(*UcsManager) bool) ([]*UcsManager, error) {
+	var r []*UcsManager
+	ch, errs := s.StreamUcsManagers(context.Background(), p)
+	for v := range ch {
+		if pred(v) {
+			r = append(r, v)
+		}
+	}
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ListAllUcsManagers returns the results of ListUcsManagers across all pages, by calling ListUcsManagers page by page until all
+// results are fetched. The pagesize set in the params is used for every page (500 if not set), and the
+// page set in the params is ignored.
+func (s *UCSService) ListAllUcsManagers(p *ListUcsManagersParams) ([]*UcsManager, error) {
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesizeIfUnset(500)
+	pagesize := pp.p["pagesize"].(int)
+
+	var r []*UcsManager
+	for page := 1; ; page++ {
+		pp.SetPage(page)
+
+		l, err := s.ListUcsManagers(pp)
+		if err != nil {
+			return nil, err
+		}
+		r = append(r, l.UcsManagers...)
+
+		if len(l.UcsManagers) < pagesize || len(r) >= l.Count {
+			return r, nil
+		}
+	}
+}
+
 type ListUcsManagersResponse struct {
 	Count       int           `json:"count"`
 	UcsManagers []*UcsManager `json:"ucsmanager"`
@@ -582,6 +1166,16 @@ type ListUcsProfilesParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ListUcsProfilesParams) Clone() *ListUcsProfilesParams {
+	c := &ListUcsProfilesParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListUcsProfilesParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -604,6 +1198,16 @@ func (p *ListUcsProfilesParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *ListUcsProfilesParams) validate() error {
+	for _, k := range []string{"ucsmanagerid"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of listUcsProfiles", k)
+		}
+	}
+	return nil
+}
+
 func (p *ListUcsProfilesParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -612,6 +1216,13 @@ func (p *ListUcsProfilesParams) SetKeyword(v string) {
 	return
 }
 
+// SetKeywordIfUnset sets keyword to v, unless it is already set
+func (p *ListUcsProfilesParams) SetKeywordIfUnset(v string) {
+	if _, found := p.p["keyword"]; !found {
+		p.SetKeyword(v)
+	}
+}
+
 func (p *ListUcsProfilesParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -620,6 +1231,13 @@ func (p *ListUcsProfilesParams) SetPage(v int) {
 	return
 }
 
+// SetPageIfUnset sets page to v, unless it is already set
+func (p *ListUcsProfilesParams) SetPageIfUnset(v int) {
+	if _, found := p.p["page"]; !found {
+		p.SetPage(v)
+	}
+}
+
 func (p *ListUcsProfilesParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -628,6 +1246,13 @@ func (p *ListUcsProfilesParams) SetPagesize(v int) {
 	return
 }
 
+// SetPagesizeIfUnset sets pagesize to v, unless it is already set
+func (p *ListUcsProfilesParams) SetPagesizeIfUnset(v int) {
+	if _, found := p.p["pagesize"]; !found {
+		p.SetPagesize(v)
+	}
+}
+
 func (p *ListUcsProfilesParams) SetUcsmanagerid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -636,18 +1261,48 @@ func (p *ListUcsProfilesParams) SetUcsmanagerid(v string) {
 	return
 }
 
+// SetUcsmanageridIfUnset sets ucsmanagerid to v, unless it is already set
+func (p *ListUcsProfilesParams) SetUcsmanageridIfUnset(v string) {
+	if _, found := p.p["ucsmanagerid"]; !found {
+		p.SetUcsmanagerid(v)
+	}
+}
+
 // You should always use this function to get a new ListUcsProfilesParams instance,
-// as then you are sure you have configured all required params
-func (s *UCSService) NewListUcsProfilesParams(ucsmanagerid string) *ListUcsProfilesParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *UCSService) NewListUcsProfilesParams(ucsmanagerid string, opts ...func(*ListUcsProfilesParams)) *ListUcsProfilesParams {
 	p := &ListUcsProfilesParams{}
 	p.p = make(map[string]interface{})
 	p.p["ucsmanagerid"] = ucsmanagerid
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // List profile in ucs manager
 func (s *UCSService) ListUcsProfiles(p *ListUcsProfilesParams) (*ListUcsProfilesResponse, error) {
-	resp, err := s.cs.newRequest("listUcsProfiles", p.toURLValues())
+	return s.ListUcsProfilesWithContext(context.Background(), p)
+}
+
+// ListUcsProfilesWithContext is the same as ListUcsProfiles, but the request is cancelled when the context is done
+func (s *UCSService) ListUcsProfilesWithContext(ctx context.Context, p *ListUcsProfilesParams) (*ListUcsProfilesResponse, error) {
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.ListUcsProfilesRawWithContext(ctx, p.toURLValues())
+}
+
+// ListUcsProfilesRaw is the same as ListUcsProfiles, but takes the params as url.Values instead of typed params
+func (s *UCSService) ListUcsProfilesRaw(v url.Values) (*ListUcsProfilesResponse, error) {
+	return s.ListUcsProfilesRawWithContext(context.Background(), v)
+}
+
+// ListUcsProfilesRawWithContext is the same as ListUcsProfilesRaw, but the request is cancelled when the context is done
+func (s *UCSService) ListUcsProfilesRawWithContext(ctx context.Context, v url.Values) (*ListUcsProfilesResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "listUcsProfiles", "listucsprofilesresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -660,6 +1315,93 @@ func (s *UCSService) ListUcsProfiles(p *ListUcsProfilesParams) (*ListUcsProfiles
 	return &r, nil
 }
 
+// StreamUcsProfiles fetches the results of ListUcsProfiles page by page in the background and sends them on the
+// returned channel. Both returned channels are closed when done. If fetching a page fails, or the context
+// is done before all results are sent, the error is sent on the error channel and fetching stops. A
+// request for a page in flight is cancelled when the context is done. The page and pagesize set in the
+// params are ignored.
+func (s *UCSService) StreamUcsProfiles(ctx context.Context, p *ListUcsProfilesParams) (<-chan *UcsProfile, <-chan error) {
+	ch := make(chan *UcsProfile)
+	errs := make(chan error, 1)
+
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesize(500)
+
+	go func() {
+		defer close(errs)
+		defer close(ch)
+
+		for page, count := 1, 0; ; page++ {
+			pp.SetPage(page)
+
+			l, err := s.ListUcsProfilesWithContext(ctx, pp)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			for _, v := range l.UcsProfiles {
+				select {
+				case ch <- v:
+				case <-ctx.Done():
+					errs <- ctx.Err()
+					return
+				}
+			}
+
+			count += len(l.UcsProfiles)
+			if len(l.UcsProfiles) < 500 || count >= l.Count {
+				return
+			}
+		}
+	}()
+
+	return ch, errs
+}
+
+// ListUcsProfilesWhere returns all results of ListUcsProfiles for which pred returns true. Note that the results are
+// filtered client-side, so all results matching the params are fetched first.
+// All pages are fetched, so the page and pagesize set in the params are ignored.
+func (s *UCSService) ListUcsProfilesWhere(p *ListUcsProfilesParams, pred func(*UcsProfile) bool) ([]*UcsProfile, error) {
+	var r []*UcsProfile
+	ch, errs := s.StreamUcsProfiles(context.Background(), p)
+	for v := range ch {
+		if pred(v) {
+			r = append(r, v)
+		}
+	}
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ListAllUcsProfiles returns the results of ListUcsProfiles across all pages, by calling ListUcsProfiles page by page until all
+// results are fetched. The pagesize set in the params is used for every page (500 if not set), and the
+// page set in the params is ignored.
+func (s *UCSService) ListAllUcsProfiles(p *ListUcsProfilesParams) ([]*UcsProfile, error) {
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesizeIfUnset(500)
+	pagesize := pp.p["pagesize"].(int)
+
+	var r []*UcsProfile
+	for page := 1; ; page++ {
+		pp.SetPage(page)
+
+		l, err := s.ListUcsProfiles(pp)
+		if err != nil {
+			return nil, err
+		}
+		r = append(r, l.UcsProfiles...)
+
+		if len(l.UcsProfiles) < pagesize || len(r) >= l.Count {
+			return r, nil
+		}
+	}
+}
+
 type ListUcsProfilesResponse struct {
 	Count       int           `json:"count"`
 	UcsProfiles []*UcsProfile `json:"ucsprofile"`
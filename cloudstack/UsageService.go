@@ -17,16 +17,68 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
 	"strconv"
+	"strings"
+	"time"
 )
 
+// UsageDateFormat is the format of the start and end dates of the usage records
+const UsageDateFormat = "2006-01-02"
+
+// RawUsageValue returns the raw usage of the record as a number
+func (r *UsageRecord) RawUsageValue() (float64, error) {
+	return strconv.ParseFloat(strings.TrimSpace(r.Rawusage), 64)
+}
+
+// UsageValue returns the usage of the record as a number together with its unit, for example
+// 24 and "Hrs" for a usage of "24 Hrs"
+func (r *UsageRecord) UsageValue() (float64, string, error) {
+	fields := strings.Fields(r.Usage)
+	if len(fields) == 0 {
+		return 0, "", fmt.Errorf("Unable to parse usage %q", r.Usage)
+	}
+
+	v, err := strconv.ParseFloat(fields[0], 64)
+	if err != nil {
+		return 0, "", fmt.Errorf("Unable to parse usage %q: %v", r.Usage, err)
+	}
+
+	return v, strings.Join(fields[1:], " "), nil
+}
+
+// Checks the start and end date of the params before ListAllUsageRecords lists the usage records page
+// by page, as the dates should be formatted using UsageDateFormat, optionally followed by a time
+// ("2006-01-02 15:04:05")
+func checkUsageDates(p *ListUsageRecordsParams) error {
+	for _, k := range []string{"startdate", "enddate"} {
+		d, _ := p.p[k].(string)
+		if _, err := time.Parse(UsageDateFormat, d); err != nil {
+			if _, err := time.Parse(UsageDateFormat+" 15:04:05", d); err != nil {
+				return fmt.Errorf("Invalid usage %s %q, expected the format %s", k, d, UsageDateFormat)
+			}
+		}
+	}
+	return nil
+}
+
 type AddTrafficMonitorParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *AddTrafficMonitorParams) Clone() *AddTrafficMonitorParams {
+	c := &AddTrafficMonitorParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *AddTrafficMonitorParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -47,6 +99,16 @@ func (p *AddTrafficMonitorParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *AddTrafficMonitorParams) validate() error {
+	for _, k := range []string{"url", "zoneid"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of addTrafficMonitor", k)
+		}
+	}
+	return nil
+}
+
 func (p *AddTrafficMonitorParams) SetExcludezones(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -55,6 +117,13 @@ func (p *AddTrafficMonitorParams) SetExcludezones(v string) {
 	return
 }
 
+// SetExcludezonesIfUnset sets excludezones to v, unless it is already set
+func (p *AddTrafficMonitorParams) SetExcludezonesIfUnset(v string) {
+	if _, found := p.p["excludezones"]; !found {
+		p.SetExcludezones(v)
+	}
+}
+
 func (p *AddTrafficMonitorParams) SetIncludezones(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -63,6 +132,13 @@ func (p *AddTrafficMonitorParams) SetIncludezones(v string) {
 	return
 }
 
+// SetIncludezonesIfUnset sets includezones to v, unless it is already set
+func (p *AddTrafficMonitorParams) SetIncludezonesIfUnset(v string) {
+	if _, found := p.p["includezones"]; !found {
+		p.SetIncludezones(v)
+	}
+}
+
 func (p *AddTrafficMonitorParams) SetUrl(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -71,6 +147,13 @@ func (p *AddTrafficMonitorParams) SetUrl(v string) {
 	return
 }
 
+// SetUrlIfUnset sets url to v, unless it is already set
+func (p *AddTrafficMonitorParams) SetUrlIfUnset(v string) {
+	if _, found := p.p["url"]; !found {
+		p.SetUrl(v)
+	}
+}
+
 func (p *AddTrafficMonitorParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -79,19 +162,55 @@ func (p *AddTrafficMonitorParams) SetZoneid(v string) {
 	return
 }
 
+// SetZoneidIfUnset sets zoneid to v, unless it is already set
+func (p *AddTrafficMonitorParams) SetZoneidIfUnset(v string) {
+	if _, found := p.p["zoneid"]; !found {
+		p.SetZoneid(v)
+	}
+}
+
 // You should always use this function to get a new AddTrafficMonitorParams instance,
-// as then you are sure you have configured all required params
-func (s *UsageService) NewAddTrafficMonitorParams(url string, zoneid string) *AddTrafficMonitorParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *UsageService) NewAddTrafficMonitorParams(url string, zoneid string, opts ...func(*AddTrafficMonitorParams)) *AddTrafficMonitorParams {
 	p := &AddTrafficMonitorParams{}
 	p.p = make(map[string]interface{})
 	p.p["url"] = url
 	p.p["zoneid"] = zoneid
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Adds Traffic Monitor Host for Direct Network Usage
-func (s *UsageService) AddTrafficMonitor(p *AddTrafficMonitorParams) (*AddTrafficMonitorResponse, error) {
-	resp, err := s.cs.newRequest("addTrafficMonitor", p.toURLValues())
+func (s *UsageService) AddTrafficMonitor(p *AddTrafficMonitorParams, opts ...OptionFunc) (*AddTrafficMonitorResponse, error) {
+	return s.AddTrafficMonitorWithContext(context.Background(), p, opts...)
+}
+
+// AddTrafficMonitorWithContext is the same as AddTrafficMonitor, but the request is cancelled when the context is done
+func (s *UsageService) AddTrafficMonitorWithContext(ctx context.Context, p *AddTrafficMonitorParams, opts ...OptionFunc) (*AddTrafficMonitorResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.AddTrafficMonitorRawWithContext(ctx, p.toURLValues())
+}
+
+// AddTrafficMonitorRaw is the same as AddTrafficMonitor, but takes the params as url.Values instead of typed params
+func (s *UsageService) AddTrafficMonitorRaw(v url.Values) (*AddTrafficMonitorResponse, error) {
+	return s.AddTrafficMonitorRawWithContext(context.Background(), v)
+}
+
+// AddTrafficMonitorRawWithContext is the same as AddTrafficMonitorRaw, but the request is cancelled when the context is done
+func (s *UsageService) AddTrafficMonitorRawWithContext(ctx context.Context, v url.Values) (*AddTrafficMonitorResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "addTrafficMonitor", "addtrafficmonitorresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -116,6 +235,16 @@ type AddTrafficTypeParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *AddTrafficTypeParams) Clone() *AddTrafficTypeParams {
+	c := &AddTrafficTypeParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *AddTrafficTypeParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -151,6 +280,16 @@ func (p *AddTrafficTypeParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *AddTrafficTypeParams) validate() error {
+	for _, k := range []string{"physicalnetworkid", "traffictype"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of addTrafficType", k)
+		}
+	}
+	return nil
+}
+
 func (p *AddTrafficTypeParams) SetHypervnetworklabel(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -159,6 +298,13 @@ func (p *AddTrafficTypeParams) SetHypervnetworklabel(v string) {
 	return
 }
 
+// SetHypervnetworklabelIfUnset sets hypervnetworklabel to v, unless it is already set
+func (p *AddTrafficTypeParams) SetHypervnetworklabelIfUnset(v string) {
+	if _, found := p.p["hypervnetworklabel"]; !found {
+		p.SetHypervnetworklabel(v)
+	}
+}
+
 func (p *AddTrafficTypeParams) SetIsolationmethod(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -167,6 +313,13 @@ func (p *AddTrafficTypeParams) SetIsolationmethod(v string) {
 	return
 }
 
+// SetIsolationmethodIfUnset sets isolationmethod to v, unless it is already set
+func (p *AddTrafficTypeParams) SetIsolationmethodIfUnset(v string) {
+	if _, found := p.p["isolationmethod"]; !found {
+		p.SetIsolationmethod(v)
+	}
+}
+
 func (p *AddTrafficTypeParams) SetKvmnetworklabel(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -175,6 +328,13 @@ func (p *AddTrafficTypeParams) SetKvmnetworklabel(v string) {
 	return
 }
 
+// SetKvmnetworklabelIfUnset sets kvmnetworklabel to v, unless it is already set
+func (p *AddTrafficTypeParams) SetKvmnetworklabelIfUnset(v string) {
+	if _, found := p.p["kvmnetworklabel"]; !found {
+		p.SetKvmnetworklabel(v)
+	}
+}
+
 func (p *AddTrafficTypeParams) SetOvm3networklabel(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -183,6 +343,13 @@ func (p *AddTrafficTypeParams) SetOvm3networklabel(v string) {
 	return
 }
 
+// SetOvm3networklabelIfUnset sets ovm3networklabel to v, unless it is already set
+func (p *AddTrafficTypeParams) SetOvm3networklabelIfUnset(v string) {
+	if _, found := p.p["ovm3networklabel"]; !found {
+		p.SetOvm3networklabel(v)
+	}
+}
+
 func (p *AddTrafficTypeParams) SetPhysicalnetworkid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -191,6 +358,13 @@ func (p *AddTrafficTypeParams) SetPhysicalnetworkid(v string) {
 	return
 }
 
+// SetPhysicalnetworkidIfUnset sets physicalnetworkid to v, unless it is already set
+func (p *AddTrafficTypeParams) SetPhysicalnetworkidIfUnset(v string) {
+	if _, found := p.p["physicalnetworkid"]; !found {
+		p.SetPhysicalnetworkid(v)
+	}
+}
+
 func (p *AddTrafficTypeParams) SetTraffictype(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -199,6 +373,13 @@ func (p *AddTrafficTypeParams) SetTraffictype(v string) {
 	return
 }
 
+// SetTraffictypeIfUnset sets traffictype to v, unless it is already set
+func (p *AddTrafficTypeParams) SetTraffictypeIfUnset(v string) {
+	if _, found := p.p["traffictype"]; !found {
+		p.SetTraffictype(v)
+	}
+}
+
 func (p *AddTrafficTypeParams) SetVlan(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -207,6 +388,13 @@ func (p *AddTrafficTypeParams) SetVlan(v string) {
 	return
 }
 
+// SetVlanIfUnset sets vlan to v, unless it is already set
+func (p *AddTrafficTypeParams) SetVlanIfUnset(v string) {
+	if _, found := p.p["vlan"]; !found {
+		p.SetVlan(v)
+	}
+}
+
 func (p *AddTrafficTypeParams) SetVmwarenetworklabel(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -215,6 +403,13 @@ func (p *AddTrafficTypeParams) SetVmwarenetworklabel(v string) {
 	return
 }
 
+// SetVmwarenetworklabelIfUnset sets vmwarenetworklabel to v, unless it is already set
+func (p *AddTrafficTypeParams) SetVmwarenetworklabelIfUnset(v string) {
+	if _, found := p.p["vmwarenetworklabel"]; !found {
+		p.SetVmwarenetworklabel(v)
+	}
+}
+
 func (p *AddTrafficTypeParams) SetXennetworklabel(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -223,19 +418,55 @@ func (p *AddTrafficTypeParams) SetXennetworklabel(v string) {
 	return
 }
 
+// SetXennetworklabelIfUnset sets xennetworklabel to v, unless it is already set
+func (p *AddTrafficTypeParams) SetXennetworklabelIfUnset(v string) {
+	if _, found := p.p["xennetworklabel"]; !found {
+		p.SetXennetworklabel(v)
+	}
+}
+
 // You should always use this function to get a new AddTrafficTypeParams instance,
-// as then you are sure you have configured all required params
-func (s *UsageService) NewAddTrafficTypeParams(physicalnetworkid string, traffictype string) *AddTrafficTypeParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *UsageService) NewAddTrafficTypeParams(physicalnetworkid string, traffictype string, opts ...func(*AddTrafficTypeParams)) *AddTrafficTypeParams {
 	p := &AddTrafficTypeParams{}
 	p.p = make(map[string]interface{})
 	p.p["physicalnetworkid"] = physicalnetworkid
 	p.p["traffictype"] = traffictype
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Adds traffic type to a physical network
-func (s *UsageService) AddTrafficType(p *AddTrafficTypeParams) (*AddTrafficTypeResponse, error) {
-	resp, err := s.cs.newRequest("addTrafficType", p.toURLValues())
+func (s *UsageService) AddTrafficType(p *AddTrafficTypeParams, opts ...OptionFunc) (*AddTrafficTypeResponse, error) {
+	return s.AddTrafficTypeWithContext(context.Background(), p, opts...)
+}
+
+// AddTrafficTypeWithContext is the same as AddTrafficType, but the request is cancelled when the context is done
+func (s *UsageService) AddTrafficTypeWithContext(ctx context.Context, p *AddTrafficTypeParams, opts ...OptionFunc) (*AddTrafficTypeResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.AddTrafficTypeRawWithContext(ctx, p.toURLValues())
+}
+
+// AddTrafficTypeRaw is the same as AddTrafficType, but takes the params as url.Values instead of typed params
+func (s *UsageService) AddTrafficTypeRaw(v url.Values) (*AddTrafficTypeResponse, error) {
+	return s.AddTrafficTypeRawWithContext(context.Background(), v)
+}
+
+// AddTrafficTypeRawWithContext is the same as AddTrafficTypeRaw, but the request is cancelled when the context is done
+func (s *UsageService) AddTrafficTypeRawWithContext(ctx context.Context, v url.Values) (*AddTrafficTypeResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "addTrafficType", "addtraffictyperesponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -247,9 +478,9 @@ func (s *UsageService) AddTrafficType(p *AddTrafficTypeParams) (*AddTrafficTypeR
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -284,6 +515,16 @@ type DeleteTrafficMonitorParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *DeleteTrafficMonitorParams) Clone() *DeleteTrafficMonitorParams {
+	c := &DeleteTrafficMonitorParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeleteTrafficMonitorParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -295,6 +536,16 @@ func (p *DeleteTrafficMonitorParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *DeleteTrafficMonitorParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of deleteTrafficMonitor", k)
+		}
+	}
+	return nil
+}
+
 func (p *DeleteTrafficMonitorParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -303,18 +554,54 @@ func (p *DeleteTrafficMonitorParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *DeleteTrafficMonitorParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 // You should always use this function to get a new DeleteTrafficMonitorParams instance,
-// as then you are sure you have configured all required params
-func (s *UsageService) NewDeleteTrafficMonitorParams(id string) *DeleteTrafficMonitorParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *UsageService) NewDeleteTrafficMonitorParams(id string, opts ...func(*DeleteTrafficMonitorParams)) *DeleteTrafficMonitorParams {
 	p := &DeleteTrafficMonitorParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Deletes an traffic monitor host.
-func (s *UsageService) DeleteTrafficMonitor(p *DeleteTrafficMonitorParams) (*DeleteTrafficMonitorResponse, error) {
-	resp, err := s.cs.newRequest("deleteTrafficMonitor", p.toURLValues())
+func (s *UsageService) DeleteTrafficMonitor(p *DeleteTrafficMonitorParams, opts ...OptionFunc) (*DeleteTrafficMonitorResponse, error) {
+	return s.DeleteTrafficMonitorWithContext(context.Background(), p, opts...)
+}
+
+// DeleteTrafficMonitorWithContext is the same as DeleteTrafficMonitor, but the request is cancelled when the context is done
+func (s *UsageService) DeleteTrafficMonitorWithContext(ctx context.Context, p *DeleteTrafficMonitorParams, opts ...OptionFunc) (*DeleteTrafficMonitorResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.DeleteTrafficMonitorRawWithContext(ctx, p.toURLValues())
+}
+
+// DeleteTrafficMonitorRaw is the same as DeleteTrafficMonitor, but takes the params as url.Values instead of typed params
+func (s *UsageService) DeleteTrafficMonitorRaw(v url.Values) (*DeleteTrafficMonitorResponse, error) {
+	return s.DeleteTrafficMonitorRawWithContext(context.Background(), v)
+}
+
+// DeleteTrafficMonitorRawWithContext is the same as DeleteTrafficMonitorRaw, but the request is cancelled when the context is done
+func (s *UsageService) DeleteTrafficMonitorRawWithContext(ctx context.Context, v url.Values) (*DeleteTrafficMonitorResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteTrafficMonitor", "deletetrafficmonitorresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -355,6 +642,16 @@ type DeleteTrafficTypeParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *DeleteTrafficTypeParams) Clone() *DeleteTrafficTypeParams {
+	c := &DeleteTrafficTypeParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeleteTrafficTypeParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -366,6 +663,16 @@ func (p *DeleteTrafficTypeParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *DeleteTrafficTypeParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of deleteTrafficType", k)
+		}
+	}
+	return nil
+}
+
 func (p *DeleteTrafficTypeParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -374,18 +681,54 @@ func (p *DeleteTrafficTypeParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *DeleteTrafficTypeParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 // You should always use this function to get a new DeleteTrafficTypeParams instance,
-// as then you are sure you have configured all required params
-func (s *UsageService) NewDeleteTrafficTypeParams(id string) *DeleteTrafficTypeParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *UsageService) NewDeleteTrafficTypeParams(id string, opts ...func(*DeleteTrafficTypeParams)) *DeleteTrafficTypeParams {
 	p := &DeleteTrafficTypeParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Deletes traffic type of a physical network
-func (s *UsageService) DeleteTrafficType(p *DeleteTrafficTypeParams) (*DeleteTrafficTypeResponse, error) {
-	resp, err := s.cs.newRequest("deleteTrafficType", p.toURLValues())
+func (s *UsageService) DeleteTrafficType(p *DeleteTrafficTypeParams, opts ...OptionFunc) (*DeleteTrafficTypeResponse, error) {
+	return s.DeleteTrafficTypeWithContext(context.Background(), p, opts...)
+}
+
+// DeleteTrafficTypeWithContext is the same as DeleteTrafficType, but the request is cancelled when the context is done
+func (s *UsageService) DeleteTrafficTypeWithContext(ctx context.Context, p *DeleteTrafficTypeParams, opts ...OptionFunc) (*DeleteTrafficTypeResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.DeleteTrafficTypeRawWithContext(ctx, p.toURLValues())
+}
+
+// DeleteTrafficTypeRaw is the same as DeleteTrafficType, but takes the params as url.Values instead of typed params
+func (s *UsageService) DeleteTrafficTypeRaw(v url.Values) (*DeleteTrafficTypeResponse, error) {
+	return s.DeleteTrafficTypeRawWithContext(context.Background(), v)
+}
+
+// DeleteTrafficTypeRawWithContext is the same as DeleteTrafficTypeRaw, but the request is cancelled when the context is done
+func (s *UsageService) DeleteTrafficTypeRawWithContext(ctx context.Context, v url.Values) (*DeleteTrafficTypeResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteTrafficType", "deletetraffictyperesponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -397,9 +740,9 @@ func (s *UsageService) DeleteTrafficType(p *DeleteTrafficTypeParams) (*DeleteTra
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -423,6 +766,16 @@ type GenerateUsageRecordsParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *GenerateUsageRecordsParams) Clone() *GenerateUsageRecordsParams {
+	c := &GenerateUsageRecordsParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *GenerateUsageRecordsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -440,6 +793,16 @@ func (p *GenerateUsageRecordsParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *GenerateUsageRecordsParams) validate() error {
+	for _, k := range []string{"enddate", "startdate"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of generateUsageRecords", k)
+		}
+	}
+	return nil
+}
+
 func (p *GenerateUsageRecordsParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -448,6 +811,13 @@ func (p *GenerateUsageRecordsParams) SetDomainid(v string) {
 	return
 }
 
+// SetDomainidIfUnset sets domainid to v, unless it is already set
+func (p *GenerateUsageRecordsParams) SetDomainidIfUnset(v string) {
+	if _, found := p.p["domainid"]; !found {
+		p.SetDomainid(v)
+	}
+}
+
 func (p *GenerateUsageRecordsParams) SetEnddate(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -456,6 +826,13 @@ func (p *GenerateUsageRecordsParams) SetEnddate(v string) {
 	return
 }
 
+// SetEnddateIfUnset sets enddate to v, unless it is already set
+func (p *GenerateUsageRecordsParams) SetEnddateIfUnset(v string) {
+	if _, found := p.p["enddate"]; !found {
+		p.SetEnddate(v)
+	}
+}
+
 func (p *GenerateUsageRecordsParams) SetStartdate(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -464,19 +841,55 @@ func (p *GenerateUsageRecordsParams) SetStartdate(v string) {
 	return
 }
 
+// SetStartdateIfUnset sets startdate to v, unless it is already set
+func (p *GenerateUsageRecordsParams) SetStartdateIfUnset(v string) {
+	if _, found := p.p["startdate"]; !found {
+		p.SetStartdate(v)
+	}
+}
+
 // You should always use this function to get a new GenerateUsageRecordsParams instance,
-// as then you are sure you have configured all required params
-func (s *UsageService) NewGenerateUsageRecordsParams(enddate string, startdate string) *GenerateUsageRecordsParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *UsageService) NewGenerateUsageRecordsParams(enddate string, startdate string, opts ...func(*GenerateUsageRecordsParams)) *GenerateUsageRecordsParams {
 	p := &GenerateUsageRecordsParams{}
 	p.p = make(map[string]interface{})
 	p.p["enddate"] = enddate
 	p.p["startdate"] = startdate
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Generates usage records. This will generate records only if there any records to be generated, i.e if the scheduled usage job was not run or failed
-func (s *UsageService) GenerateUsageRecords(p *GenerateUsageRecordsParams) (*GenerateUsageRecordsResponse, error) {
-	resp, err := s.cs.newRequest("generateUsageRecords", p.toURLValues())
+func (s *UsageService) GenerateUsageRecords(p *GenerateUsageRecordsParams, opts ...OptionFunc) (*GenerateUsageRecordsResponse, error) {
+	return s.GenerateUsageRecordsWithContext(context.Background(), p, opts...)
+}
+
+// GenerateUsageRecordsWithContext is the same as GenerateUsageRecords, but the request is cancelled when the context is done
+func (s *UsageService) GenerateUsageRecordsWithContext(ctx context.Context, p *GenerateUsageRecordsParams, opts ...OptionFunc) (*GenerateUsageRecordsResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.GenerateUsageRecordsRawWithContext(ctx, p.toURLValues())
+}
+
+// GenerateUsageRecordsRaw is the same as GenerateUsageRecords, but takes the params as url.Values instead of typed params
+func (s *UsageService) GenerateUsageRecordsRaw(v url.Values) (*GenerateUsageRecordsResponse, error) {
+	return s.GenerateUsageRecordsRawWithContext(context.Background(), v)
+}
+
+// GenerateUsageRecordsRawWithContext is the same as GenerateUsageRecordsRaw, but the request is cancelled when the context is done
+func (s *UsageService) GenerateUsageRecordsRawWithContext(ctx context.Context, v url.Values) (*GenerateUsageRecordsResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "generateUsageRecords", "generateusagerecordsresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -517,6 +930,16 @@ type ListTrafficMonitorsParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ListTrafficMonitorsParams) Clone() *ListTrafficMonitorsParams {
+	c := &ListTrafficMonitorsParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListTrafficMonitorsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -539,6 +962,16 @@ func (p *ListTrafficMonitorsParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *ListTrafficMonitorsParams) validate() error {
+	for _, k := range []string{"zoneid"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of listTrafficMonitors", k)
+		}
+	}
+	return nil
+}
+
 func (p *ListTrafficMonitorsParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -547,6 +980,13 @@ func (p *ListTrafficMonitorsParams) SetKeyword(v string) {
 	return
 }
 
+// SetKeywordIfUnset sets keyword to v, unless it is already set
+func (p *ListTrafficMonitorsParams) SetKeywordIfUnset(v string) {
+	if _, found := p.p["keyword"]; !found {
+		p.SetKeyword(v)
+	}
+}
+
 func (p *ListTrafficMonitorsParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -555,6 +995,13 @@ func (p *ListTrafficMonitorsParams) SetPage(v int) {
 	return
 }
 
+// SetPageIfUnset sets page to v, unless it is already set
+func (p *ListTrafficMonitorsParams) SetPageIfUnset(v int) {
+	if _, found := p.p["page"]; !found {
+		p.SetPage(v)
+	}
+}
+
 func (p *ListTrafficMonitorsParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -563,6 +1010,13 @@ func (p *ListTrafficMonitorsParams) SetPagesize(v int) {
 	return
 }
 
+// SetPagesizeIfUnset sets pagesize to v, unless it is already set
+func (p *ListTrafficMonitorsParams) SetPagesizeIfUnset(v int) {
+	if _, found := p.p["pagesize"]; !found {
+		p.SetPagesize(v)
+	}
+}
+
 func (p *ListTrafficMonitorsParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -571,18 +1025,48 @@ func (p *ListTrafficMonitorsParams) SetZoneid(v string) {
 	return
 }
 
+// SetZoneidIfUnset sets zoneid to v, unless it is already set
+func (p *ListTrafficMonitorsParams) SetZoneidIfUnset(v string) {
+	if _, found := p.p["zoneid"]; !found {
+		p.SetZoneid(v)
+	}
+}
+
 // You should always use this function to get a new ListTrafficMonitorsParams instance,
-// as then you are sure you have configured all required params
-func (s *UsageService) NewListTrafficMonitorsParams(zoneid string) *ListTrafficMonitorsParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *UsageService) NewListTrafficMonitorsParams(zoneid string, opts ...func(*ListTrafficMonitorsParams)) *ListTrafficMonitorsParams {
 	p := &ListTrafficMonitorsParams{}
 	p.p = make(map[string]interface{})
 	p.p["zoneid"] = zoneid
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // List traffic monitor Hosts.
 func (s *UsageService) ListTrafficMonitors(p *ListTrafficMonitorsParams) (*ListTrafficMonitorsResponse, error) {
-	resp, err := s.cs.newRequest("listTrafficMonitors", p.toURLValues())
+	return s.ListTrafficMonitorsWithContext(context.Background(), p)
+}
+
+// ListTrafficMonitorsWithContext is the same as ListTrafficMonitors, but the request is cancelled when the context is done
+func (s *UsageService) ListTrafficMonitorsWithContext(ctx context.Context, p *ListTrafficMonitorsParams) (*ListTrafficMonitorsResponse, error) {
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.ListTrafficMonitorsRawWithContext(ctx, p.toURLValues())
+}
+
+// ListTrafficMonitorsRaw is the same as ListTrafficMonitors, but takes the params as url.Values instead of typed params
+func (s *UsageService) ListTrafficMonitorsRaw(v url.Values) (*ListTrafficMonitorsResponse, error) {
+	return s.ListTrafficMonitorsRawWithContext(context.Background(), v)
+}
+
+// ListTrafficMonitorsRawWithContext is the same as ListTrafficMonitorsRaw, but the request is cancelled when the context is done
+func (s *UsageService) ListTrafficMonitorsRawWithContext(ctx context.Context, v url.Values) (*ListTrafficMonitorsResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "listTrafficMonitors", "listtrafficmonitorsresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -595,6 +1079,93 @@ func (s *UsageService) ListTrafficMonitors(p *ListTrafficMonitorsParams) (*ListT
 	return &r, nil
 }
 
+// StreamTrafficMonitors fetches the results of ListTrafficMonitors page by page in the background and sends them on the
+// returned channel. Both returned channels are closed when done. If fetching a page fails, or the context
+// is done before all results are sent, the error is sent on the error channel and fetching stops. A
+// request for a page in flight is cancelled when the context is done. The page and pagesize set in the
+// params are ignored.
+func (s *UsageService) StreamTrafficMonitors(ctx context.Context, p *ListTrafficMonitorsParams) (<-chan *TrafficMonitor, <-chan error) {
+	ch := make(chan *TrafficMonitor)
+	errs := make(chan error, 1)
+
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesize(500)
+
+	go func() {
+		defer close(errs)
+		defer close(ch)
+
+		for page, count := 1, 0; ; page++ {
+			pp.SetPage(page)
+
+			l, err := s.ListTrafficMonitorsWithContext(ctx, pp)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			for _, v := range l.TrafficMonitors {
+				select {
+				case ch <- v:
+				case <-ctx.Done():
+					errs <- ctx.Err()
+					return
+				}
+			}
+
+			count += len(l.TrafficMonitors)
+			if len(l.TrafficMonitors) < 500 || count >= l.Count {
+				return
+			}
+		}
+	}()
+
+	return ch, errs
+}
+
+// ListTrafficMonitorsWhere returns all results of ListTrafficMonitors for which pred returns true. Note that the results are
+// filtered client-side, so all results matching the params are fetched first.
+// All pages are fetched, so the page and pagesize set in the params are ignored.
+func (s *UsageService) ListTrafficMonitorsWhere(p *ListTrafficMonitorsParams, pred func(*TrafficMonitor) bool) ([]*TrafficMonitor, error) {
+	var r []*TrafficMonitor
+	ch, errs := s.StreamTrafficMonitors(context.Background(), p)
+	for v := range ch {
+		if pred(v) {
+			r = append(r, v)
+		}
+	}
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ListAllTrafficMonitors returns the results of ListTrafficMonitors across all pages, by calling ListTrafficMonitors page by page until all
+// results are fetched. The pagesize set in the params is used for every page (500 if not set), and the
+// page set in the params is ignored.
+func (s *UsageService) ListAllTrafficMonitors(p *ListTrafficMonitorsParams) ([]*TrafficMonitor, error) {
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesizeIfUnset(500)
+	pagesize := pp.p["pagesize"].(int)
+
+	var r []*TrafficMonitor
+	for page := 1; ; page++ {
+		pp.SetPage(page)
+
+		l, err := s.ListTrafficMonitors(pp)
+		if err != nil {
+			return nil, err
+		}
+		r = append(r, l.TrafficMonitors...)
+
+		if len(l.TrafficMonitors) < pagesize || len(r) >= l.Count {
+			return r, nil
+		}
+	}
+}
+
 type ListTrafficMonitorsResponse struct {
 	Count           int               `json:"count"`
 	TrafficMonitors []*TrafficMonitor `json:"trafficmonitor"`
@@ -612,6 +1183,16 @@ type ListTrafficTypeImplementorsParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ListTrafficTypeImplementorsParams) Clone() *ListTrafficTypeImplementorsParams {
+	c := &ListTrafficTypeImplementorsParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListTrafficTypeImplementorsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -642,6 +1223,13 @@ func (p *ListTrafficTypeImplementorsParams) SetKeyword(v string) {
 	return
 }
 
+// SetKeywordIfUnset sets keyword to v, unless it is already set
+func (p *ListTrafficTypeImplementorsParams) SetKeywordIfUnset(v string) {
+	if _, found := p.p["keyword"]; !found {
+		p.SetKeyword(v)
+	}
+}
+
 func (p *ListTrafficTypeImplementorsParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -650,6 +1238,13 @@ func (p *ListTrafficTypeImplementorsParams) SetPage(v int) {
 	return
 }
 
+// SetPageIfUnset sets page to v, unless it is already set
+func (p *ListTrafficTypeImplementorsParams) SetPageIfUnset(v int) {
+	if _, found := p.p["page"]; !found {
+		p.SetPage(v)
+	}
+}
+
 func (p *ListTrafficTypeImplementorsParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -658,6 +1253,13 @@ func (p *ListTrafficTypeImplementorsParams) SetPagesize(v int) {
 	return
 }
 
+// SetPagesizeIfUnset sets pagesize to v, unless it is already set
+func (p *ListTrafficTypeImplementorsParams) SetPagesizeIfUnset(v int) {
+	if _, found := p.p["pagesize"]; !found {
+		p.SetPagesize(v)
+	}
+}
+
 func (p *ListTrafficTypeImplementorsParams) SetTraffictype(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -666,20 +1268,46 @@ func (p *ListTrafficTypeImplementorsParams) SetTraffictype(v string) {
 	return
 }
 
+// SetTraffictypeIfUnset sets traffictype to v, unless it is already set
+func (p *ListTrafficTypeImplementorsParams) SetTraffictypeIfUnset(v string) {
+	if _, found := p.p["traffictype"]; !found {
+		p.SetTraffictype(v)
+	}
+}
+
 // You should always use this function to get a new ListTrafficTypeImplementorsParams instance,
-// as then you are sure you have configured all required params
-func (s *UsageService) NewListTrafficTypeImplementorsParams() *ListTrafficTypeImplementorsParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *UsageService) NewListTrafficTypeImplementorsParams(opts ...func(*ListTrafficTypeImplementorsParams)) *ListTrafficTypeImplementorsParams {
 	p := &ListTrafficTypeImplementorsParams{}
 	p.p = make(map[string]interface{})
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Lists implementors of implementor of a network traffic type or implementors of all network traffic types
 func (s *UsageService) ListTrafficTypeImplementors(p *ListTrafficTypeImplementorsParams) (*ListTrafficTypeImplementorsResponse, error) {
-	resp, err := s.cs.newRequest("listTrafficTypeImplementors", p.toURLValues())
-	if err != nil {
-		return nil, err
-	}
+	return s.ListTrafficTypeImplementorsWithContext(context.Background(), p)
+}
+
+// ListTrafficTypeImplementorsWithContext is the same as ListTrafficTypeImplementors, but the request is cancelled when the context is done
+func (s *UsageService) ListTrafficTypeImplementorsWithContext(ctx context.Context, p *ListTrafficTypeImplementorsParams) (*ListTrafficTypeImplementorsResponse, error) {
+	return s.ListTrafficTypeImplementorsRawWithContext(ctx, p.toURLValues())
+}
+
+// ListTrafficTypeImplementorsRaw is the same as ListTrafficTypeImplementors, but takes the params as url.Values instead of typed params
+func (s *UsageService) ListTrafficTypeImplementorsRaw(v url.Values) (*ListTrafficTypeImplementorsResponse, error) {
+	return s.ListTrafficTypeImplementorsRawWithContext(context.Background(), v)
+}
+
+// ListTrafficTypeImplementorsRawWithContext is the same as ListTrafficTypeImplementorsRaw, but the request is cancelled when the context is done
+func (s *UsageService) ListTrafficTypeImplementorsRawWithContext(ctx context.Context, v url.Values) (*ListTrafficTypeImplementorsResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "listTrafficTypeImplementors", "listtraffictypeimplementorsresponse", v)
+	if err != nil {
+		return nil, err
+	}
 
 	var r ListTrafficTypeImplementorsResponse
 	if err := json.Unmarshal(resp, &r); err != nil {
@@ -689,6 +1317,93 @@ func (s *UsageService) ListTrafficTypeImplementors(p *ListTrafficTypeImplementor
 	return &r, nil
 }
 
+// StreamTrafficTypeImplementors fetches the results of ListTrafficTypeImplementors page by page in the background and sends them on the
+// returned channel. Both returned channels are closed when done. If fetching a page fails, or the context
+// is done before all results are sent, the error is sent on the error channel and fetching stops. A
+// request for a page in flight is cancelled when the context is done. The page and pagesize set in the
+// params are ignored.
+func (s *UsageService) StreamTrafficTypeImplementors(ctx context.Context, p *ListTrafficTypeImplementorsParams) (<-chan *TrafficTypeImplementor, <-chan error) {
+	ch := make(chan *TrafficTypeImplementor)
+	errs := make(chan error, 1)
+
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesize(500)
+
+	go func() {
+		defer close(errs)
+		defer close(ch)
+
+		for page, count := 1, 0; ; page++ {
+			pp.SetPage(page)
+
+			l, err := s.ListTrafficTypeImplementorsWithContext(ctx, pp)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			for _, v := range l.TrafficTypeImplementors {
+				select {
+				case ch <- v:
+				case <-ctx.Done():
+					errs <- ctx.Err()
+					return
+				}
+			}
+
+			count += len(l.TrafficTypeImplementors)
+			if len(l.TrafficTypeImplementors) < 500 || count >= l.Count {
+				return
+			}
+		}
+	}()
+
+	return ch, errs
+}
+
+// ListTrafficTypeImplementorsWhere returns all results of ListTrafficTypeImplementors for which pred returns true. Note that the results are
+// filtered client-side, so all results matching the params are fetched first.
+// All pages are fetched, so the page and pagesize set in the params are ignored.
+func (s *UsageService) ListTrafficTypeImplementorsWhere(p *ListTrafficTypeImplementorsParams, pred func(*TrafficTypeImplementor) bool) ([]*TrafficTypeImplementor, error) {
+	var r []*TrafficTypeImplementor
+	ch, errs := s.StreamTrafficTypeImplementors(context.Background(), p)
+	for v := range ch {
+		if pred(v) {
+			r = append(r, v)
+		}
+	}
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ListAllTrafficTypeImplementors returns the results of ListTrafficTypeImplementors across all pages, by calling ListTrafficTypeImplementors page by page until all
+// results are fetched. The pagesize set in the params is used for every page (500 if not set), and the
+// page set in the params is ignored.
+func (s *UsageService) ListAllTrafficTypeImplementors(p *ListTrafficTypeImplementorsParams) ([]*TrafficTypeImplementor, error) {
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesizeIfUnset(500)
+	pagesize := pp.p["pagesize"].(int)
+
+	var r []*TrafficTypeImplementor
+	for page := 1; ; page++ {
+		pp.SetPage(page)
+
+		l, err := s.ListTrafficTypeImplementors(pp)
+		if err != nil {
+			return nil, err
+		}
+		r = append(r, l.TrafficTypeImplementors...)
+
+		if len(l.TrafficTypeImplementors) < pagesize || len(r) >= l.Count {
+			return r, nil
+		}
+	}
+}
+
 type ListTrafficTypeImplementorsResponse struct {
 	Count                   int                       `json:"count"`
 	TrafficTypeImplementors []*TrafficTypeImplementor `json:"traffictypeimplementor"`
@@ -703,6 +1418,16 @@ type ListTrafficTypesParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ListTrafficTypesParams) Clone() *ListTrafficTypesParams {
+	c := &ListTrafficTypesParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListTrafficTypesParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -725,6 +1450,16 @@ func (p *ListTrafficTypesParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *ListTrafficTypesParams) validate() error {
+	for _, k := range []string{"physicalnetworkid"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of listTrafficTypes", k)
+		}
+	}
+	return nil
+}
+
 func (p *ListTrafficTypesParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -733,6 +1468,13 @@ func (p *ListTrafficTypesParams) SetKeyword(v string) {
 	return
 }
 
+// SetKeywordIfUnset sets keyword to v, unless it is already set
+func (p *ListTrafficTypesParams) SetKeywordIfUnset(v string) {
+	if _, found := p.p["keyword"]; !found {
+		p.SetKeyword(v)
+	}
+}
+
 func (p *ListTrafficTypesParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -741,6 +1483,13 @@ func (p *ListTrafficTypesParams) SetPage(v int) {
 	return
 }
 
+// SetPageIfUnset sets page to v, unless it is already set
+func (p *ListTrafficTypesParams) SetPageIfUnset(v int) {
+	if _, found := p.p["page"]; !found {
+		p.SetPage(v)
+	}
+}
+
 func (p *ListTrafficTypesParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -749,6 +1498,13 @@ func (p *ListTrafficTypesParams) SetPagesize(v int) {
 	return
 }
 
+// SetPagesizeIfUnset sets pagesize to v, unless it is already set
+func (p *ListTrafficTypesParams) SetPagesizeIfUnset(v int) {
+	if _, found := p.p["pagesize"]; !found {
+		p.SetPagesize(v)
+	}
+}
+
 func (p *ListTrafficTypesParams) SetPhysicalnetworkid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -757,15 +1513,33 @@ func (p *ListTrafficTypesParams) SetPhysicalnetworkid(v string) {
 	return
 }
 
+// SetPhysicalnetworkidIfUnset sets physicalnetworkid to v, unless it is already set
+func (p *ListTrafficTypesParams) SetPhysicalnetworkidIfUnset(v string) {
+	if _, found := p.p["physicalnetworkid"]; !found {
+		p.SetPhysicalnetworkid(v)
+	}
+}
+
 // You should always use this function to get a new ListTrafficTypesParams instance,
-// as then you are sure you have configured all required params
-func (s *UsageService) NewListTrafficTypesParams(physicalnetworkid string) *ListTrafficTypesParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *UsageService) NewListTrafficTypesParams(physicalnetworkid string, opts ...func(*ListTrafficTypesParams)) *ListTrafficTypesParams {
 	p := &ListTrafficTypesParams{}
 	p.p = make(map[string]interface{})
 	p.p["physicalnetworkid"] = physicalnetworkid
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
+func (p *ListTrafficTypesParams) setFirstMatch() {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p[firstMatchKey] = true
+}
+
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *UsageService) GetTrafficTypeID(keyword string, physicalnetworkid string, opts ...OptionFunc) (string, int, error) {
 	p := &ListTrafficTypesParams{}
@@ -774,7 +1548,7 @@ func (s *UsageService) GetTrafficTypeID(keyword string, physicalnetworkid string
 	p.p["keyword"] = keyword
 	p.p["physicalnetworkid"] = physicalnetworkid
 
-	for _, fn := range append(s.cs.options, opts...) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
 		if err := fn(s.cs, p); err != nil {
 			return "", -1, err
 		}
@@ -786,7 +1560,7 @@ func (s *UsageService) GetTrafficTypeID(keyword string, physicalnetworkid string
 	}
 
 	if l.Count == 0 {
-		return "", l.Count, fmt.Errorf("No match found for %s: %+v", keyword, l)
+		return "", l.Count, fmt.Errorf("%w for %s: %+v", ErrNotFound, keyword, l)
 	}
 
 	if l.Count == 1 {
@@ -799,13 +1573,36 @@ func (s *UsageService) GetTrafficTypeID(keyword string, physicalnetworkid string
 				return v.Id, l.Count, nil
 			}
 		}
+
+		if _, ok := p.p[firstMatchKey]; ok && len(l.TrafficTypes) > 0 {
+			return l.TrafficTypes[0].Id, l.Count, nil
+		}
 	}
 	return "", l.Count, fmt.Errorf("Could not find an exact match for %s: %+v", keyword, l)
 }
 
 // Lists traffic types of a given physical network.
 func (s *UsageService) ListTrafficTypes(p *ListTrafficTypesParams) (*ListTrafficTypesResponse, error) {
-	resp, err := s.cs.newRequest("listTrafficTypes", p.toURLValues())
+	return s.ListTrafficTypesWithContext(context.Background(), p)
+}
+
+// ListTrafficTypesWithContext is the same as ListTrafficTypes, but the request is cancelled when the context is done
+func (s *UsageService) ListTrafficTypesWithContext(ctx context.Context, p *ListTrafficTypesParams) (*ListTrafficTypesResponse, error) {
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.ListTrafficTypesRawWithContext(ctx, p.toURLValues())
+}
+
+// ListTrafficTypesRaw is the same as ListTrafficTypes, but takes the params as url.Values instead of typed params
+func (s *UsageService) ListTrafficTypesRaw(v url.Values) (*ListTrafficTypesResponse, error) {
+	return s.ListTrafficTypesRawWithContext(context.Background(), v)
+}
+
+// ListTrafficTypesRawWithContext is the same as ListTrafficTypesRaw, but the request is cancelled when the context is done
+func (s *UsageService) ListTrafficTypesRawWithContext(ctx context.Context, v url.Values) (*ListTrafficTypesResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "listTrafficTypes", "listtraffictypesresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -818,6 +1615,93 @@ func (s *UsageService) ListTrafficTypes(p *ListTrafficTypesParams) (*ListTraffic
 	return &r, nil
 }
 
+// StreamTrafficTypes fetches the results of ListTrafficTypes page by page in the background and sends them on the
+// returned channel. Both returned channels are closed when done. If fetching a page fails, or the context
+// is done before all results are sent, the error is sent on the error channel and fetching stops. A
+// request for a page in flight is cancelled when the context is done. The page and pagesize set in the
+// params are ignored.
+func (s *UsageService) StreamTrafficTypes(ctx context.Context, p *ListTrafficTypesParams) (<-chan *TrafficType, <-chan error) {
+	ch := make(chan *TrafficType)
+	errs := make(chan error, 1)
+
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesize(500)
+
+	go func() {
+		defer close(errs)
+		defer close(ch)
+
+		for page, count := 1, 0; ; page++ {
+			pp.SetPage(page)
+
+			l, err := s.ListTrafficTypesWithContext(ctx, pp)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			for _, v := range l.TrafficTypes {
+				select {
+				case ch <- v:
+				case <-ctx.Done():
+					errs <- ctx.Err()
+					return
+				}
+			}
+
+			count += len(l.TrafficTypes)
+			if len(l.TrafficTypes) < 500 || count >= l.Count {
+				return
+			}
+		}
+	}()
+
+	return ch, errs
+}
+
+// ListTrafficTypesWhere returns all results of ListTrafficTypes for which pred returns true. Note that the results are
+// filtered client-side, so all results matching the params are fetched first.
+// All pages are fetched, so the page and pagesize set in the params are ignored.
+func (s *UsageService) ListTrafficTypesWhere(p *ListTrafficTypesParams, pred func(*TrafficType) bool) ([]*TrafficType, error) {
+	var r []*TrafficType
+	ch, errs := s.StreamTrafficTypes(context.Background(), p)
+	for v := range ch {
+		if pred(v) {
+			r = append(r, v)
+		}
+	}
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ListAllTrafficTypes returns the results of ListTrafficTypes across all pages, by calling ListTrafficTypes page by page until all
+// results are fetched. The pagesize set in the params is used for every page (500 if not set), and the
+// page set in the params is ignored.
+func (s *UsageService) ListAllTrafficTypes(p *ListTrafficTypesParams) ([]*TrafficType, error) {
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesizeIfUnset(500)
+	pagesize := pp.p["pagesize"].(int)
+
+	var r []*TrafficType
+	for page := 1; ; page++ {
+		pp.SetPage(page)
+
+		l, err := s.ListTrafficTypes(pp)
+		if err != nil {
+			return nil, err
+		}
+		r = append(r, l.TrafficTypes...)
+
+		if len(l.TrafficTypes) < pagesize || len(r) >= l.Count {
+			return r, nil
+		}
+	}
+}
+
 type ListTrafficTypesResponse struct {
 	Count        int            `json:"count"`
 	TrafficTypes []*TrafficType `json:"traffictype"`
@@ -837,6 +1721,16 @@ type ListUsageRecordsParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ListUsageRecordsParams) Clone() *ListUsageRecordsParams {
+	c := &ListUsageRecordsParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListUsageRecordsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -881,6 +1775,16 @@ func (p *ListUsageRecordsParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *ListUsageRecordsParams) validate() error {
+	for _, k := range []string{"enddate", "startdate"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of listUsageRecords", k)
+		}
+	}
+	return nil
+}
+
 func (p *ListUsageRecordsParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -889,6 +1793,13 @@ func (p *ListUsageRecordsParams) SetAccount(v string) {
 	return
 }
 
+// SetAccountIfUnset sets account to v, unless it is already set
+func (p *ListUsageRecordsParams) SetAccountIfUnset(v string) {
+	if _, found := p.p["account"]; !found {
+		p.SetAccount(v)
+	}
+}
+
 func (p *ListUsageRecordsParams) SetAccountid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -897,6 +1808,13 @@ func (p *ListUsageRecordsParams) SetAccountid(v string) {
 	return
 }
 
+// SetAccountidIfUnset sets accountid to v, unless it is already set
+func (p *ListUsageRecordsParams) SetAccountidIfUnset(v string) {
+	if _, found := p.p["accountid"]; !found {
+		p.SetAccountid(v)
+	}
+}
+
 func (p *ListUsageRecordsParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -905,6 +1823,13 @@ func (p *ListUsageRecordsParams) SetDomainid(v string) {
 	return
 }
 
+// SetDomainidIfUnset sets domainid to v, unless it is already set
+func (p *ListUsageRecordsParams) SetDomainidIfUnset(v string) {
+	if _, found := p.p["domainid"]; !found {
+		p.SetDomainid(v)
+	}
+}
+
 func (p *ListUsageRecordsParams) SetEnddate(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -913,6 +1838,13 @@ func (p *ListUsageRecordsParams) SetEnddate(v string) {
 	return
 }
 
+// SetEnddateIfUnset sets enddate to v, unless it is already set
+func (p *ListUsageRecordsParams) SetEnddateIfUnset(v string) {
+	if _, found := p.p["enddate"]; !found {
+		p.SetEnddate(v)
+	}
+}
+
 func (p *ListUsageRecordsParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -921,6 +1853,13 @@ func (p *ListUsageRecordsParams) SetKeyword(v string) {
 	return
 }
 
+// SetKeywordIfUnset sets keyword to v, unless it is already set
+func (p *ListUsageRecordsParams) SetKeywordIfUnset(v string) {
+	if _, found := p.p["keyword"]; !found {
+		p.SetKeyword(v)
+	}
+}
+
 func (p *ListUsageRecordsParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -929,6 +1868,13 @@ func (p *ListUsageRecordsParams) SetPage(v int) {
 	return
 }
 
+// SetPageIfUnset sets page to v, unless it is already set
+func (p *ListUsageRecordsParams) SetPageIfUnset(v int) {
+	if _, found := p.p["page"]; !found {
+		p.SetPage(v)
+	}
+}
+
 func (p *ListUsageRecordsParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -937,6 +1883,13 @@ func (p *ListUsageRecordsParams) SetPagesize(v int) {
 	return
 }
 
+// SetPagesizeIfUnset sets pagesize to v, unless it is already set
+func (p *ListUsageRecordsParams) SetPagesizeIfUnset(v int) {
+	if _, found := p.p["pagesize"]; !found {
+		p.SetPagesize(v)
+	}
+}
+
 func (p *ListUsageRecordsParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -945,6 +1898,13 @@ func (p *ListUsageRecordsParams) SetProjectid(v string) {
 	return
 }
 
+// SetProjectidIfUnset sets projectid to v, unless it is already set
+func (p *ListUsageRecordsParams) SetProjectidIfUnset(v string) {
+	if _, found := p.p["projectid"]; !found {
+		p.SetProjectid(v)
+	}
+}
+
 func (p *ListUsageRecordsParams) SetStartdate(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -953,6 +1913,13 @@ func (p *ListUsageRecordsParams) SetStartdate(v string) {
 	return
 }
 
+// SetStartdateIfUnset sets startdate to v, unless it is already set
+func (p *ListUsageRecordsParams) SetStartdateIfUnset(v string) {
+	if _, found := p.p["startdate"]; !found {
+		p.SetStartdate(v)
+	}
+}
+
 func (p *ListUsageRecordsParams) SetType(v int64) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -961,6 +1928,13 @@ func (p *ListUsageRecordsParams) SetType(v int64) {
 	return
 }
 
+// SetTypeIfUnset sets type to v, unless it is already set
+func (p *ListUsageRecordsParams) SetTypeIfUnset(v int64) {
+	if _, found := p.p["type"]; !found {
+		p.SetType(v)
+	}
+}
+
 func (p *ListUsageRecordsParams) SetUsageid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -969,19 +1943,49 @@ func (p *ListUsageRecordsParams) SetUsageid(v string) {
 	return
 }
 
+// SetUsageidIfUnset sets usageid to v, unless it is already set
+func (p *ListUsageRecordsParams) SetUsageidIfUnset(v string) {
+	if _, found := p.p["usageid"]; !found {
+		p.SetUsageid(v)
+	}
+}
+
 // You should always use this function to get a new ListUsageRecordsParams instance,
-// as then you are sure you have configured all required params
-func (s *UsageService) NewListUsageRecordsParams(enddate string, startdate string) *ListUsageRecordsParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *UsageService) NewListUsageRecordsParams(enddate string, startdate string, opts ...func(*ListUsageRecordsParams)) *ListUsageRecordsParams {
 	p := &ListUsageRecordsParams{}
 	p.p = make(map[string]interface{})
 	p.p["enddate"] = enddate
 	p.p["startdate"] = startdate
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Lists usage records for accounts
 func (s *UsageService) ListUsageRecords(p *ListUsageRecordsParams) (*ListUsageRecordsResponse, error) {
-	resp, err := s.cs.newRequest("listUsageRecords", p.toURLValues())
+	return s.ListUsageRecordsWithContext(context.Background(), p)
+}
+
+// ListUsageRecordsWithContext is the same as ListUsageRecords, but the request is cancelled when the context is done
+func (s *UsageService) ListUsageRecordsWithContext(ctx context.Context, p *ListUsageRecordsParams) (*ListUsageRecordsResponse, error) {
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.ListUsageRecordsRawWithContext(ctx, p.toURLValues())
+}
+
+// ListUsageRecordsRaw is the same as ListUsageRecords, but takes the params as url.Values instead of typed params
+func (s *UsageService) ListUsageRecordsRaw(v url.Values) (*ListUsageRecordsResponse, error) {
+	return s.ListUsageRecordsRawWithContext(context.Background(), v)
+}
+
+// ListUsageRecordsRawWithContext is the same as ListUsageRecordsRaw, but the request is cancelled when the context is done
+func (s *UsageService) ListUsageRecordsRawWithContext(ctx context.Context, v url.Values) (*ListUsageRecordsResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "listUsageRecords", "listusagerecordsresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -994,6 +1998,97 @@ func (s *UsageService) ListUsageRecords(p *ListUsageRecordsParams) (*ListUsageRe
 	return &r, nil
 }
 
+// StreamUsageRecords fetches the results of ListUsageRecords page by page in the background and sends them on the
+// returned channel. Both returned channels are closed when done. If fetching a page fails, or the context
+// is done before all results are sent, the error is sent on the error channel and fetching stops. A
+// request for a page in flight is cancelled when the context is done. The page and pagesize set in the
+// params are ignored.
+func (s *UsageService) StreamUsageRecords(ctx context.Context, p *ListUsageRecordsParams) (<-chan *UsageRecord, <-chan error) {
+	ch := make(chan *UsageRecord)
+	errs := make(chan error, 1)
+
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesize(500)
+
+	go func() {
+		defer close(errs)
+		defer close(ch)
+
+		for page, count := 1, 0; ; page++ {
+			pp.SetPage(page)
+
+			l, err := s.ListUsageRecordsWithContext(ctx, pp)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			for _, v := range l.UsageRecords {
+				select {
+				case ch <- v:
+				case <-ctx.Done():
+					errs <- ctx.Err()
+					return
+				}
+			}
+
+			count += len(l.UsageRecords)
+			if len(l.UsageRecords) < 500 || count >= l.Count {
+				return
+			}
+		}
+	}()
+
+	return ch, errs
+}
+
+// ListUsageRecordsWhere returns all results of ListUsageRecords for which pred returns true. Note that the results are
+// filtered client-side, so all results matching the params are fetched first.
+// All pages are fetched, so the page and pagesize set in the params are ignored.
+func (s *UsageService) ListUsageRecordsWhere(p *ListUsageRecordsParams, pred func(*UsageRecord) bool) ([]*UsageRecord, error) {
+	var r []*UsageRecord
+	ch, errs := s.StreamUsageRecords(context.Background(), p)
+	for v := range ch {
+		if pred(v) {
+			r = append(r, v)
+		}
+	}
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ListAllUsageRecords returns the results of ListUsageRecords across all pages, by calling ListUsageRecords page by page until all
+// results are fetched. The pagesize set in the params is used for every page (500 if not set), and the
+// page set in the params is ignored.
+func (s *UsageService) ListAllUsageRecords(p *ListUsageRecordsParams) ([]*UsageRecord, error) {
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesizeIfUnset(500)
+	pagesize := pp.p["pagesize"].(int)
+
+	if err := checkUsageDates(pp); err != nil {
+		return nil, err
+	}
+
+	var r []*UsageRecord
+	for page := 1; ; page++ {
+		pp.SetPage(page)
+
+		l, err := s.ListUsageRecords(pp)
+		if err != nil {
+			return nil, err
+		}
+		r = append(r, l.UsageRecords...)
+
+		if len(l.UsageRecords) < pagesize || len(r) >= l.Count {
+			return r, nil
+		}
+	}
+}
+
 type ListUsageRecordsResponse struct {
 	Count        int            `json:"count"`
 	UsageRecords []*UsageRecord `json:"usagerecord"`
@@ -1034,6 +2129,16 @@ type ListUsageTypesParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ListUsageTypesParams) Clone() *ListUsageTypesParams {
+	c := &ListUsageTypesParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListUsageTypesParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1043,16 +2148,35 @@ func (p *ListUsageTypesParams) toURLValues() url.Values {
 }
 
 // You should always use this function to get a new ListUsageTypesParams instance,
-// as then you are sure you have configured all required params
-func (s *UsageService) NewListUsageTypesParams() *ListUsageTypesParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *UsageService) NewListUsageTypesParams(opts ...func(*ListUsageTypesParams)) *ListUsageTypesParams {
 	p := &ListUsageTypesParams{}
 	p.p = make(map[string]interface{})
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // List Usage Types
 func (s *UsageService) ListUsageTypes(p *ListUsageTypesParams) (*ListUsageTypesResponse, error) {
-	resp, err := s.cs.newRequest("listUsageTypes", p.toURLValues())
+	return s.ListUsageTypesWithContext(context.Background(), p)
+}
+
+// ListUsageTypesWithContext is the same as ListUsageTypes, but the request is cancelled when the context is done
+func (s *UsageService) ListUsageTypesWithContext(ctx context.Context, p *ListUsageTypesParams) (*ListUsageTypesResponse, error) {
+	return s.ListUsageTypesRawWithContext(ctx, p.toURLValues())
+}
+
+// ListUsageTypesRaw is the same as ListUsageTypes, but takes the params as url.Values instead of typed params
+func (s *UsageService) ListUsageTypesRaw(v url.Values) (*ListUsageTypesResponse, error) {
+	return s.ListUsageTypesRawWithContext(context.Background(), v)
+}
+
+// ListUsageTypesRawWithContext is the same as ListUsageTypesRaw, but the request is cancelled when the context is done
+func (s *UsageService) ListUsageTypesRawWithContext(ctx context.Context, v url.Values) (*ListUsageTypesResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "listUsageTypes", "listusagetypesresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -1065,6 +2189,22 @@ func (s *UsageService) ListUsageTypes(p *ListUsageTypesParams) (*ListUsageTypesR
 	return &r, nil
 }
 
+// ListUsageTypesWhere returns all results of ListUsageTypes for which pred returns true. Note that the results are
+// filtered client-side, so all results matching the params are fetched first.
+func (s *UsageService) ListUsageTypesWhere(p *ListUsageTypesParams, pred func(*UsageType) bool) ([]*UsageType, error) {
+	var r []*UsageType
+	l, err := s.ListUsageTypes(p)
+	if err != nil {
+		return nil, err
+	}
+	for _, v := range l.UsageTypes {
+		if pred(v) {
+			r = append(r, v)
+		}
+	}
+	return r, nil
+}
+
 type ListUsageTypesResponse struct {
 	Count      int          `json:"count"`
 	UsageTypes []*UsageType `json:"usagetype"`
@@ -1079,6 +2219,16 @@ type RemoveRawUsageRecordsParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *RemoveRawUsageRecordsParams) Clone() *RemoveRawUsageRecordsParams {
+	c := &RemoveRawUsageRecordsParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *RemoveRawUsageRecordsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1091,6 +2241,16 @@ func (p *RemoveRawUsageRecordsParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *RemoveRawUsageRecordsParams) validate() error {
+	for _, k := range []string{"interval"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of removeRawUsageRecords", k)
+		}
+	}
+	return nil
+}
+
 func (p *RemoveRawUsageRecordsParams) SetInterval(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1099,18 +2259,54 @@ func (p *RemoveRawUsageRecordsParams) SetInterval(v int) {
 	return
 }
 
+// SetIntervalIfUnset sets interval to v, unless it is already set
+func (p *RemoveRawUsageRecordsParams) SetIntervalIfUnset(v int) {
+	if _, found := p.p["interval"]; !found {
+		p.SetInterval(v)
+	}
+}
+
 // You should always use this function to get a new RemoveRawUsageRecordsParams instance,
-// as then you are sure you have configured all required params
-func (s *UsageService) NewRemoveRawUsageRecordsParams(interval int) *RemoveRawUsageRecordsParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *UsageService) NewRemoveRawUsageRecordsParams(interval int, opts ...func(*RemoveRawUsageRecordsParams)) *RemoveRawUsageRecordsParams {
 	p := &RemoveRawUsageRecordsParams{}
 	p.p = make(map[string]interface{})
 	p.p["interval"] = interval
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Safely removes raw records from cloud_usage table
-func (s *UsageService) RemoveRawUsageRecords(p *RemoveRawUsageRecordsParams) (*RemoveRawUsageRecordsResponse, error) {
-	resp, err := s.cs.newRequest("removeRawUsageRecords", p.toURLValues())
+func (s *UsageService) RemoveRawUsageRecords(p *RemoveRawUsageRecordsParams, opts ...OptionFunc) (*RemoveRawUsageRecordsResponse, error) {
+	return s.RemoveRawUsageRecordsWithContext(context.Background(), p, opts...)
+}
+
+// RemoveRawUsageRecordsWithContext is the same as RemoveRawUsageRecords, but the request is cancelled when the context is done
+func (s *UsageService) RemoveRawUsageRecordsWithContext(ctx context.Context, p *RemoveRawUsageRecordsParams, opts ...OptionFunc) (*RemoveRawUsageRecordsResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.RemoveRawUsageRecordsRawWithContext(ctx, p.toURLValues())
+}
+
+// RemoveRawUsageRecordsRaw is the same as RemoveRawUsageRecords, but takes the params as url.Values instead of typed params
+func (s *UsageService) RemoveRawUsageRecordsRaw(v url.Values) (*RemoveRawUsageRecordsResponse, error) {
+	return s.RemoveRawUsageRecordsRawWithContext(context.Background(), v)
+}
+
+// RemoveRawUsageRecordsRawWithContext is the same as RemoveRawUsageRecordsRaw, but the request is cancelled when the context is done
+func (s *UsageService) RemoveRawUsageRecordsRawWithContext(ctx context.Context, v url.Values) (*RemoveRawUsageRecordsResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "removeRawUsageRecords", "removerawusagerecordsresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -1151,6 +2347,16 @@ type UpdateTrafficTypeParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *UpdateTrafficTypeParams) Clone() *UpdateTrafficTypeParams {
+	c := &UpdateTrafficTypeParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *UpdateTrafficTypeParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1177,6 +2383,16 @@ func (p *UpdateTrafficTypeParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *UpdateTrafficTypeParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of updateTrafficType", k)
+		}
+	}
+	return nil
+}
+
 func (p *UpdateTrafficTypeParams) SetHypervnetworklabel(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1185,6 +2401,13 @@ func (p *UpdateTrafficTypeParams) SetHypervnetworklabel(v string) {
 	return
 }
 
+// SetHypervnetworklabelIfUnset sets hypervnetworklabel to v, unless it is already set
+func (p *UpdateTrafficTypeParams) SetHypervnetworklabelIfUnset(v string) {
+	if _, found := p.p["hypervnetworklabel"]; !found {
+		p.SetHypervnetworklabel(v)
+	}
+}
+
 func (p *UpdateTrafficTypeParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1193,6 +2416,13 @@ func (p *UpdateTrafficTypeParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *UpdateTrafficTypeParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 func (p *UpdateTrafficTypeParams) SetKvmnetworklabel(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1201,6 +2431,13 @@ func (p *UpdateTrafficTypeParams) SetKvmnetworklabel(v string) {
 	return
 }
 
+// SetKvmnetworklabelIfUnset sets kvmnetworklabel to v, unless it is already set
+func (p *UpdateTrafficTypeParams) SetKvmnetworklabelIfUnset(v string) {
+	if _, found := p.p["kvmnetworklabel"]; !found {
+		p.SetKvmnetworklabel(v)
+	}
+}
+
 func (p *UpdateTrafficTypeParams) SetOvm3networklabel(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1209,6 +2446,13 @@ func (p *UpdateTrafficTypeParams) SetOvm3networklabel(v string) {
 	return
 }
 
+// SetOvm3networklabelIfUnset sets ovm3networklabel to v, unless it is already set
+func (p *UpdateTrafficTypeParams) SetOvm3networklabelIfUnset(v string) {
+	if _, found := p.p["ovm3networklabel"]; !found {
+		p.SetOvm3networklabel(v)
+	}
+}
+
 func (p *UpdateTrafficTypeParams) SetVmwarenetworklabel(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1217,6 +2461,13 @@ func (p *UpdateTrafficTypeParams) SetVmwarenetworklabel(v string) {
 	return
 }
 
+// SetVmwarenetworklabelIfUnset sets vmwarenetworklabel to v, unless it is already set
+func (p *UpdateTrafficTypeParams) SetVmwarenetworklabelIfUnset(v string) {
+	if _, found := p.p["vmwarenetworklabel"]; !found {
+		p.SetVmwarenetworklabel(v)
+	}
+}
+
 func (p *UpdateTrafficTypeParams) SetXennetworklabel(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1225,18 +2476,54 @@ func (p *UpdateTrafficTypeParams) SetXennetworklabel(v string) {
 	return
 }
 
+// SetXennetworklabelIfUnset sets xennetworklabel to v, unless it is already set
+func (p *UpdateTrafficTypeParams) SetXennetworklabelIfUnset(v string) {
+	if _, found := p.p["xennetworklabel"]; !found {
+		p.SetXennetworklabel(v)
+	}
+}
+
 // You should always use this function to get a new UpdateTrafficTypeParams instance,
-// as then you are sure you have configured all required params
-func (s *UsageService) NewUpdateTrafficTypeParams(id string) *UpdateTrafficTypeParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *UsageService) NewUpdateTrafficTypeParams(id string, opts ...func(*UpdateTrafficTypeParams)) *UpdateTrafficTypeParams {
 	p := &UpdateTrafficTypeParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Updates traffic type of a physical network
-func (s *UsageService) UpdateTrafficType(p *UpdateTrafficTypeParams) (*UpdateTrafficTypeResponse, error) {
-	resp, err := s.cs.newRequest("updateTrafficType", p.toURLValues())
+func (s *UsageService) UpdateTrafficType(p *UpdateTrafficTypeParams, opts ...OptionFunc) (*UpdateTrafficTypeResponse, error) {
+	return s.UpdateTrafficTypeWithContext(context.Background(), p, opts...)
+}
+
+// UpdateTrafficTypeWithContext is the same as UpdateTrafficType, but the request is cancelled when the context is done
+func (s *UsageService) UpdateTrafficTypeWithContext(ctx context.Context, p *UpdateTrafficTypeParams, opts ...OptionFunc) (*UpdateTrafficTypeResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.UpdateTrafficTypeRawWithContext(ctx, p.toURLValues())
+}
+
+// UpdateTrafficTypeRaw is the same as UpdateTrafficType, but takes the params as url.Values instead of typed params
+func (s *UsageService) UpdateTrafficTypeRaw(v url.Values) (*UpdateTrafficTypeResponse, error) {
+	return s.UpdateTrafficTypeRawWithContext(context.Background(), v)
+}
+
+// UpdateTrafficTypeRawWithContext is the same as UpdateTrafficTypeRaw, but the request is cancelled when the context is done
+func (s *UsageService) UpdateTrafficTypeRawWithContext(ctx context.Context, v url.Values) (*UpdateTrafficTypeResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "updateTrafficType", "updatetraffictyperesponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -1248,9 +2535,9 @@ func (s *UsageService) UpdateTrafficType(p *UpdateTrafficTypeParams) (*UpdateTra
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -17,17 +17,86 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/url"
 	"strconv"
 	"strings"
+	"time"
 )
 
+// RotateKeys generates a new api and secret key for the given user and returns them. The old keys
+// of the user stop working immediately, so if the client itself uses the keys of this user, pass
+// true for updateClient to make the client sign all following requests with the new keys.
+func (s *UserService) RotateKeys(userid string, updateClient bool) (string, string, error) {
+	r, err := s.RegisterUserKeys(s.NewRegisterUserKeysParams(userid))
+	if err != nil {
+		return "", "", err
+	}
+	if r.Apikey == "" || r.Secretkey == "" {
+		return "", "", fmt.Errorf("No keys returned when registering new keys for user %s", userid)
+	}
+
+	if updateClient {
+		s.cs.setCredentials(r.Apikey, r.Secretkey)
+	}
+
+	return r.Apikey, r.Secretkey, nil
+}
+
+// UpdateMany runs UpdateUser for all given IDs in parallel, using at most concurrency concurrent requests.
+// For each ID the params are populated with the ID and then passed to mutate, which should set the
+// changes to apply. The result contains the updated resources (in the order of the IDs) and the error
+// of every update that failed by ID. The returned error contains all failures, or is nil if none failed.
+// The updated resources are returned as the UpdateUserResponse of every update, which contains the same
+// fields as the resource itself, so no additional request is needed per resource to get them. The
+// options are used for every update.
+func (s *UserService) UpdateMany(ids []string, mutate func(*UpdateUserParams), concurrency int, opts ...OptionFunc) (BulkResult[*UpdateUserResponse], error) {
+	rs := make([]*UpdateUserResponse, len(ids))
+	errs := make([]error, len(ids))
+
+	runConcurrently(len(ids), concurrency, func(i int) {
+		p := &UpdateUserParams{p: map[string]interface{}{"id": ids[i]}}
+		if mutate != nil {
+			mutate(p)
+		}
+
+		r, err := s.UpdateUser(p, opts...)
+		if err != nil {
+			errs[i] = err
+			return
+		}
+		rs[i] = r
+	})
+
+	var r BulkResult[*UpdateUserResponse]
+	for i, id := range ids {
+		if errs[i] != nil {
+			r.fail(id, errs[i])
+			continue
+		}
+		r.Succeeded = append(r.Succeeded, rs[i])
+	}
+
+	return r, r.Err()
+}
+
 type CreateUserParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *CreateUserParams) Clone() *CreateUserParams {
+	c := &CreateUserParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *CreateUserParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -63,6 +132,16 @@ func (p *CreateUserParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *CreateUserParams) validate() error {
+	for _, k := range []string{"account", "email", "firstname", "lastname", "password", "username"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of createUser", k)
+		}
+	}
+	return nil
+}
+
 func (p *CreateUserParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -71,6 +150,13 @@ func (p *CreateUserParams) SetAccount(v string) {
 	return
 }
 
+// SetAccountIfUnset sets account to v, unless it is already set
+func (p *CreateUserParams) SetAccountIfUnset(v string) {
+	if _, found := p.p["account"]; !found {
+		p.SetAccount(v)
+	}
+}
+
 func (p *CreateUserParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -79,6 +165,13 @@ func (p *CreateUserParams) SetDomainid(v string) {
 	return
 }
 
+// SetDomainidIfUnset sets domainid to v, unless it is already set
+func (p *CreateUserParams) SetDomainidIfUnset(v string) {
+	if _, found := p.p["domainid"]; !found {
+		p.SetDomainid(v)
+	}
+}
+
 func (p *CreateUserParams) SetEmail(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -87,6 +180,13 @@ func (p *CreateUserParams) SetEmail(v string) {
 	return
 }
 
+// SetEmailIfUnset sets email to v, unless it is already set
+func (p *CreateUserParams) SetEmailIfUnset(v string) {
+	if _, found := p.p["email"]; !found {
+		p.SetEmail(v)
+	}
+}
+
 func (p *CreateUserParams) SetFirstname(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -95,6 +195,13 @@ func (p *CreateUserParams) SetFirstname(v string) {
 	return
 }
 
+// SetFirstnameIfUnset sets firstname to v, unless it is already set
+func (p *CreateUserParams) SetFirstnameIfUnset(v string) {
+	if _, found := p.p["firstname"]; !found {
+		p.SetFirstname(v)
+	}
+}
+
 func (p *CreateUserParams) SetLastname(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -103,6 +210,13 @@ func (p *CreateUserParams) SetLastname(v string) {
 	return
 }
 
+// SetLastnameIfUnset sets lastname to v, unless it is already set
+func (p *CreateUserParams) SetLastnameIfUnset(v string) {
+	if _, found := p.p["lastname"]; !found {
+		p.SetLastname(v)
+	}
+}
+
 func (p *CreateUserParams) SetPassword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -111,6 +225,13 @@ func (p *CreateUserParams) SetPassword(v string) {
 	return
 }
 
+// SetPasswordIfUnset sets password to v, unless it is already set
+func (p *CreateUserParams) SetPasswordIfUnset(v string) {
+	if _, found := p.p["password"]; !found {
+		p.SetPassword(v)
+	}
+}
+
 func (p *CreateUserParams) SetTimezone(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -119,6 +240,13 @@ func (p *CreateUserParams) SetTimezone(v string) {
 	return
 }
 
+// SetTimezoneIfUnset sets timezone to v, unless it is already set
+func (p *CreateUserParams) SetTimezoneIfUnset(v string) {
+	if _, found := p.p["timezone"]; !found {
+		p.SetTimezone(v)
+	}
+}
+
 func (p *CreateUserParams) SetUserid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -127,6 +255,13 @@ func (p *CreateUserParams) SetUserid(v string) {
 	return
 }
 
+// SetUseridIfUnset sets userid to v, unless it is already set
+func (p *CreateUserParams) SetUseridIfUnset(v string) {
+	if _, found := p.p["userid"]; !found {
+		p.SetUserid(v)
+	}
+}
+
 func (p *CreateUserParams) SetUsername(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -135,9 +270,17 @@ func (p *CreateUserParams) SetUsername(v string) {
 	return
 }
 
+// SetUsernameIfUnset sets username to v, unless it is already set
+func (p *CreateUserParams) SetUsernameIfUnset(v string) {
+	if _, found := p.p["username"]; !found {
+		p.SetUsername(v)
+	}
+}
+
 // You should always use this function to get a new CreateUserParams instance,
-// as then you are sure you have configured all required params
-func (s *UserService) NewCreateUserParams(account string, email string, firstname string, lastname string, password string, username string) *CreateUserParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *UserService) NewCreateUserParams(account string, email string, firstname string, lastname string, password string, username string, opts ...func(*CreateUserParams)) *CreateUserParams {
 	p := &CreateUserParams{}
 	p.p = make(map[string]interface{})
 	p.p["account"] = account
@@ -146,17 +289,75 @@ func (s *UserService) NewCreateUserParams(account string, email string, firstnam
 	p.p["lastname"] = lastname
 	p.p["password"] = password
 	p.p["username"] = username
+	for _, fn := range opts {
+		fn(p)
+	}
+	return p
+}
+
+// CreateUserOpts contains all params that can be set on a CreateUserParams.
+// Optional params are pointers (or slices and maps) which are only set when not nil.
+type CreateUserOpts struct {
+	Account   string
+	Domainid  *string
+	Email     string
+	Firstname string
+	Lastname  string
+	Password  string
+	Timezone  *string
+	Userid    *string
+	Username  string
+}
+
+// NewCreateUserParamsFromOpts is an alternative for NewCreateUserParams which takes all params using
+// an options struct, as that is easier to read for APIs with many required params
+func (s *UserService) NewCreateUserParamsFromOpts(o CreateUserOpts) *CreateUserParams {
+	p := s.NewCreateUserParams(o.Account, o.Email, o.Firstname, o.Lastname, o.Password, o.Username)
+	if o.Domainid != nil {
+		p.p["domainid"] = *o.Domainid
+	}
+	if o.Timezone != nil {
+		p.p["timezone"] = *o.Timezone
+	}
+	if o.Userid != nil {
+		p.p["userid"] = *o.Userid
+	}
 	return p
 }
 
 // Creates a user for an account that already exists
-func (s *UserService) CreateUser(p *CreateUserParams) (*CreateUserResponse, error) {
-	resp, err := s.cs.newRequest("createUser", p.toURLValues())
+func (s *UserService) CreateUser(p *CreateUserParams, opts ...OptionFunc) (*CreateUserResponse, error) {
+	return s.CreateUserWithContext(context.Background(), p, opts...)
+}
+
+// CreateUserWithContext is the same as CreateUser, but the request is cancelled when the context is done
+func (s *UserService) CreateUserWithContext(ctx context.Context, p *CreateUserParams, opts ...OptionFunc) (*CreateUserResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.CreateUserRawWithContext(ctx, p.toURLValues())
+}
+
+// CreateUserRaw is the same as CreateUser, but takes the params as url.Values instead of typed params
+func (s *UserService) CreateUserRaw(v url.Values) (*CreateUserResponse, error) {
+	return s.CreateUserRawWithContext(context.Background(), v)
+}
+
+// CreateUserRawWithContext is the same as CreateUserRaw, but the request is cancelled when the context is done
+func (s *UserService) CreateUserRawWithContext(ctx context.Context, v url.Values) (*CreateUserResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "createUser", "createuserresponse", v)
 	if err != nil {
 		return nil, err
 	}
 
-	if resp, err = getRawValue(resp); err != nil {
+	if resp, err = getRawValueByKey(resp, "user"); err != nil {
 		return nil, err
 	}
 
@@ -195,6 +396,16 @@ type DeleteUserParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *DeleteUserParams) Clone() *DeleteUserParams {
+	c := &DeleteUserParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeleteUserParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -206,6 +417,16 @@ func (p *DeleteUserParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *DeleteUserParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of deleteUser", k)
+		}
+	}
+	return nil
+}
+
 func (p *DeleteUserParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -214,18 +435,54 @@ func (p *DeleteUserParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *DeleteUserParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 // You should always use this function to get a new DeleteUserParams instance,
-// as then you are sure you have configured all required params
-func (s *UserService) NewDeleteUserParams(id string) *DeleteUserParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *UserService) NewDeleteUserParams(id string, opts ...func(*DeleteUserParams)) *DeleteUserParams {
 	p := &DeleteUserParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Deletes a user for an account
-func (s *UserService) DeleteUser(p *DeleteUserParams) (*DeleteUserResponse, error) {
-	resp, err := s.cs.newRequest("deleteUser", p.toURLValues())
+func (s *UserService) DeleteUser(p *DeleteUserParams, opts ...OptionFunc) (*DeleteUserResponse, error) {
+	return s.DeleteUserWithContext(context.Background(), p, opts...)
+}
+
+// DeleteUserWithContext is the same as DeleteUser, but the request is cancelled when the context is done
+func (s *UserService) DeleteUserWithContext(ctx context.Context, p *DeleteUserParams, opts ...OptionFunc) (*DeleteUserResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.DeleteUserRawWithContext(ctx, p.toURLValues())
+}
+
+// DeleteUserRaw is the same as DeleteUser, but takes the params as url.Values instead of typed params
+func (s *UserService) DeleteUserRaw(v url.Values) (*DeleteUserResponse, error) {
+	return s.DeleteUserRawWithContext(context.Background(), v)
+}
+
+// DeleteUserRawWithContext is the same as DeleteUserRaw, but the request is cancelled when the context is done
+func (s *UserService) DeleteUserRawWithContext(ctx context.Context, v url.Values) (*DeleteUserResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteUser", "deleteuserresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -238,6 +495,34 @@ func (s *UserService) DeleteUser(p *DeleteUserParams) (*DeleteUserResponse, erro
 	return &r, nil
 }
 
+// WaitForUserDeleted polls until the User with the given ID no longer exists, the timeout
+// expires or the context is cancelled. Deleted resources may still be listed for a short while
+// after the delete command finished, so this can be used to make sure they are really gone. The
+// options are passed to GetUserByID, so a resource outside of the default scope can be found.
+func (s *UserService) WaitForUserDeleted(ctx context.Context, id string, timeout time.Duration, opts ...OptionFunc) error {
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	for {
+		_, count, err := s.GetUserByID(id, opts...)
+		if count == 0 {
+			return nil
+		}
+		if count < 0 {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(2 * time.Second):
+		}
+	}
+}
+
 type DeleteUserResponse struct {
 	Displaytext string `json:"displaytext"`
 	Success     bool   `json:"success"`
@@ -266,6 +551,16 @@ type DisableUserParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *DisableUserParams) Clone() *DisableUserParams {
+	c := &DisableUserParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DisableUserParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -277,6 +572,16 @@ func (p *DisableUserParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *DisableUserParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of disableUser", k)
+		}
+	}
+	return nil
+}
+
 func (p *DisableUserParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -285,18 +590,54 @@ func (p *DisableUserParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *DisableUserParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 // You should always use this function to get a new DisableUserParams instance,
-// as then you are sure you have configured all required params
-func (s *UserService) NewDisableUserParams(id string) *DisableUserParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *UserService) NewDisableUserParams(id string, opts ...func(*DisableUserParams)) *DisableUserParams {
 	p := &DisableUserParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Disables a user account
-func (s *UserService) DisableUser(p *DisableUserParams) (*DisableUserResponse, error) {
-	resp, err := s.cs.newRequest("disableUser", p.toURLValues())
+func (s *UserService) DisableUser(p *DisableUserParams, opts ...OptionFunc) (*DisableUserResponse, error) {
+	return s.DisableUserWithContext(context.Background(), p, opts...)
+}
+
+// DisableUserWithContext is the same as DisableUser, but the request is cancelled when the context is done
+func (s *UserService) DisableUserWithContext(ctx context.Context, p *DisableUserParams, opts ...OptionFunc) (*DisableUserResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.DisableUserRawWithContext(ctx, p.toURLValues())
+}
+
+// DisableUserRaw is the same as DisableUser, but takes the params as url.Values instead of typed params
+func (s *UserService) DisableUserRaw(v url.Values) (*DisableUserResponse, error) {
+	return s.DisableUserRawWithContext(context.Background(), v)
+}
+
+// DisableUserRawWithContext is the same as DisableUserRaw, but the request is cancelled when the context is done
+func (s *UserService) DisableUserRawWithContext(ctx context.Context, v url.Values) (*DisableUserResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "disableUser", "disableuserresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -308,9 +649,9 @@ func (s *UserService) DisableUser(p *DisableUserParams) (*DisableUserResponse, e
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -357,6 +698,16 @@ type EnableUserParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *EnableUserParams) Clone() *EnableUserParams {
+	c := &EnableUserParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *EnableUserParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -368,6 +719,16 @@ func (p *EnableUserParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *EnableUserParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of enableUser", k)
+		}
+	}
+	return nil
+}
+
 func (p *EnableUserParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -376,18 +737,54 @@ func (p *EnableUserParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *EnableUserParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 // You should always use this function to get a new EnableUserParams instance,
-// as then you are sure you have configured all required params
-func (s *UserService) NewEnableUserParams(id string) *EnableUserParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *UserService) NewEnableUserParams(id string, opts ...func(*EnableUserParams)) *EnableUserParams {
 	p := &EnableUserParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Enables a user account
-func (s *UserService) EnableUser(p *EnableUserParams) (*EnableUserResponse, error) {
-	resp, err := s.cs.newRequest("enableUser", p.toURLValues())
+func (s *UserService) EnableUser(p *EnableUserParams, opts ...OptionFunc) (*EnableUserResponse, error) {
+	return s.EnableUserWithContext(context.Background(), p, opts...)
+}
+
+// EnableUserWithContext is the same as EnableUser, but the request is cancelled when the context is done
+func (s *UserService) EnableUserWithContext(ctx context.Context, p *EnableUserParams, opts ...OptionFunc) (*EnableUserResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.EnableUserRawWithContext(ctx, p.toURLValues())
+}
+
+// EnableUserRaw is the same as EnableUser, but takes the params as url.Values instead of typed params
+func (s *UserService) EnableUserRaw(v url.Values) (*EnableUserResponse, error) {
+	return s.EnableUserRawWithContext(context.Background(), v)
+}
+
+// EnableUserRawWithContext is the same as EnableUserRaw, but the request is cancelled when the context is done
+func (s *UserService) EnableUserRawWithContext(ctx context.Context, v url.Values) (*EnableUserResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "enableUser", "enableuserresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -427,6 +824,16 @@ type GetUserParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *GetUserParams) Clone() *GetUserParams {
+	c := &GetUserParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *GetUserParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -438,6 +845,16 @@ func (p *GetUserParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *GetUserParams) validate() error {
+	for _, k := range []string{"userapikey"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of getUser", k)
+		}
+	}
+	return nil
+}
+
 func (p *GetUserParams) SetUserapikey(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -446,18 +863,54 @@ func (p *GetUserParams) SetUserapikey(v string) {
 	return
 }
 
+// SetUserapikeyIfUnset sets userapikey to v, unless it is already set
+func (p *GetUserParams) SetUserapikeyIfUnset(v string) {
+	if _, found := p.p["userapikey"]; !found {
+		p.SetUserapikey(v)
+	}
+}
+
 // You should always use this function to get a new GetUserParams instance,
-// as then you are sure you have configured all required params
-func (s *UserService) NewGetUserParams(userapikey string) *GetUserParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *UserService) NewGetUserParams(userapikey string, opts ...func(*GetUserParams)) *GetUserParams {
 	p := &GetUserParams{}
 	p.p = make(map[string]interface{})
 	p.p["userapikey"] = userapikey
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Find user account by API key
-func (s *UserService) GetUser(p *GetUserParams) (*GetUserResponse, error) {
-	resp, err := s.cs.newRequest("getUser", p.toURLValues())
+func (s *UserService) GetUser(p *GetUserParams, opts ...OptionFunc) (*GetUserResponse, error) {
+	return s.GetUserWithContext(context.Background(), p, opts...)
+}
+
+// GetUserWithContext is the same as GetUser, but the request is cancelled when the context is done
+func (s *UserService) GetUserWithContext(ctx context.Context, p *GetUserParams, opts ...OptionFunc) (*GetUserResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.GetUserRawWithContext(ctx, p.toURLValues())
+}
+
+// GetUserRaw is the same as GetUser, but takes the params as url.Values instead of typed params
+func (s *UserService) GetUserRaw(v url.Values) (*GetUserResponse, error) {
+	return s.GetUserRawWithContext(context.Background(), v)
+}
+
+// GetUserRawWithContext is the same as GetUserRaw, but the request is cancelled when the context is done
+func (s *UserService) GetUserRawWithContext(ctx context.Context, v url.Values) (*GetUserResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "getUser", "getuserresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -497,6 +950,16 @@ type GetVirtualMachineUserDataParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *GetVirtualMachineUserDataParams) Clone() *GetVirtualMachineUserDataParams {
+	c := &GetVirtualMachineUserDataParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *GetVirtualMachineUserDataParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -508,6 +971,16 @@ func (p *GetVirtualMachineUserDataParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *GetVirtualMachineUserDataParams) validate() error {
+	for _, k := range []string{"virtualmachineid"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of getVirtualMachineUserData", k)
+		}
+	}
+	return nil
+}
+
 func (p *GetVirtualMachineUserDataParams) SetVirtualmachineid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -516,22 +989,62 @@ func (p *GetVirtualMachineUserDataParams) SetVirtualmachineid(v string) {
 	return
 }
 
+// SetVirtualmachineidIfUnset sets virtualmachineid to v, unless it is already set
+func (p *GetVirtualMachineUserDataParams) SetVirtualmachineidIfUnset(v string) {
+	if _, found := p.p["virtualmachineid"]; !found {
+		p.SetVirtualmachineid(v)
+	}
+}
+
 // You should always use this function to get a new GetVirtualMachineUserDataParams instance,
-// as then you are sure you have configured all required params
-func (s *UserService) NewGetVirtualMachineUserDataParams(virtualmachineid string) *GetVirtualMachineUserDataParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *UserService) NewGetVirtualMachineUserDataParams(virtualmachineid string, opts ...func(*GetVirtualMachineUserDataParams)) *GetVirtualMachineUserDataParams {
 	p := &GetVirtualMachineUserDataParams{}
 	p.p = make(map[string]interface{})
 	p.p["virtualmachineid"] = virtualmachineid
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Returns user data associated with the VM
-func (s *UserService) GetVirtualMachineUserData(p *GetVirtualMachineUserDataParams) (*GetVirtualMachineUserDataResponse, error) {
-	resp, err := s.cs.newRequest("getVirtualMachineUserData", p.toURLValues())
+func (s *UserService) GetVirtualMachineUserData(p *GetVirtualMachineUserDataParams, opts ...OptionFunc) (*GetVirtualMachineUserDataResponse, error) {
+	return s.GetVirtualMachineUserDataWithContext(context.Background(), p, opts...)
+}
+
+// GetVirtualMachineUserDataWithContext is the same as GetVirtualMachineUserData, but the request is cancelled when the context is done
+func (s *UserService) GetVirtualMachineUserDataWithContext(ctx context.Context, p *GetVirtualMachineUserDataParams, opts ...OptionFunc) (*GetVirtualMachineUserDataResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.GetVirtualMachineUserDataRawWithContext(ctx, p.toURLValues())
+}
+
+// GetVirtualMachineUserDataRaw is the same as GetVirtualMachineUserData, but takes the params as url.Values instead of typed params
+func (s *UserService) GetVirtualMachineUserDataRaw(v url.Values) (*GetVirtualMachineUserDataResponse, error) {
+	return s.GetVirtualMachineUserDataRawWithContext(context.Background(), v)
+}
+
+// GetVirtualMachineUserDataRawWithContext is the same as GetVirtualMachineUserDataRaw, but the request is cancelled when the context is done
+func (s *UserService) GetVirtualMachineUserDataRawWithContext(ctx context.Context, v url.Values) (*GetVirtualMachineUserDataResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "getVirtualMachineUserData", "getvirtualmachineuserdataresponse", v)
 	if err != nil {
 		return nil, err
 	}
 
+	if resp, err = getRawValueByKey(resp, "virtualmachineuserdata"); err != nil {
+		return nil, err
+	}
+
 	var r GetVirtualMachineUserDataResponse
 	if err := json.Unmarshal(resp, &r); err != nil {
 		return nil, err
@@ -549,6 +1062,16 @@ type ListUsersParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ListUsersParams) Clone() *ListUsersParams {
+	c := &ListUsersParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListUsersParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -603,6 +1126,13 @@ func (p *ListUsersParams) SetAccount(v string) {
 	return
 }
 
+// SetAccountIfUnset sets account to v, unless it is already set
+func (p *ListUsersParams) SetAccountIfUnset(v string) {
+	if _, found := p.p["account"]; !found {
+		p.SetAccount(v)
+	}
+}
+
 func (p *ListUsersParams) SetAccounttype(v int64) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -611,6 +1141,13 @@ func (p *ListUsersParams) SetAccounttype(v int64) {
 	return
 }
 
+// SetAccounttypeIfUnset sets accounttype to v, unless it is already set
+func (p *ListUsersParams) SetAccounttypeIfUnset(v int64) {
+	if _, found := p.p["accounttype"]; !found {
+		p.SetAccounttype(v)
+	}
+}
+
 func (p *ListUsersParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -619,6 +1156,13 @@ func (p *ListUsersParams) SetDomainid(v string) {
 	return
 }
 
+// SetDomainidIfUnset sets domainid to v, unless it is already set
+func (p *ListUsersParams) SetDomainidIfUnset(v string) {
+	if _, found := p.p["domainid"]; !found {
+		p.SetDomainid(v)
+	}
+}
+
 func (p *ListUsersParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -627,6 +1171,13 @@ func (p *ListUsersParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *ListUsersParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 func (p *ListUsersParams) SetIsrecursive(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -635,6 +1186,13 @@ func (p *ListUsersParams) SetIsrecursive(v bool) {
 	return
 }
 
+// SetIsrecursiveIfUnset sets isrecursive to v, unless it is already set
+func (p *ListUsersParams) SetIsrecursiveIfUnset(v bool) {
+	if _, found := p.p["isrecursive"]; !found {
+		p.SetIsrecursive(v)
+	}
+}
+
 func (p *ListUsersParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -643,6 +1201,13 @@ func (p *ListUsersParams) SetKeyword(v string) {
 	return
 }
 
+// SetKeywordIfUnset sets keyword to v, unless it is already set
+func (p *ListUsersParams) SetKeywordIfUnset(v string) {
+	if _, found := p.p["keyword"]; !found {
+		p.SetKeyword(v)
+	}
+}
+
 func (p *ListUsersParams) SetListall(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -651,6 +1216,13 @@ func (p *ListUsersParams) SetListall(v bool) {
 	return
 }
 
+// SetListallIfUnset sets listall to v, unless it is already set
+func (p *ListUsersParams) SetListallIfUnset(v bool) {
+	if _, found := p.p["listall"]; !found {
+		p.SetListall(v)
+	}
+}
+
 func (p *ListUsersParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -659,6 +1231,13 @@ func (p *ListUsersParams) SetPage(v int) {
 	return
 }
 
+// SetPageIfUnset sets page to v, unless it is already set
+func (p *ListUsersParams) SetPageIfUnset(v int) {
+	if _, found := p.p["page"]; !found {
+		p.SetPage(v)
+	}
+}
+
 func (p *ListUsersParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -667,6 +1246,13 @@ func (p *ListUsersParams) SetPagesize(v int) {
 	return
 }
 
+// SetPagesizeIfUnset sets pagesize to v, unless it is already set
+func (p *ListUsersParams) SetPagesizeIfUnset(v int) {
+	if _, found := p.p["pagesize"]; !found {
+		p.SetPagesize(v)
+	}
+}
+
 func (p *ListUsersParams) SetState(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -675,6 +1261,13 @@ func (p *ListUsersParams) SetState(v string) {
 	return
 }
 
+// SetStateIfUnset sets state to v, unless it is already set
+func (p *ListUsersParams) SetStateIfUnset(v string) {
+	if _, found := p.p["state"]; !found {
+		p.SetState(v)
+	}
+}
+
 func (p *ListUsersParams) SetUsername(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -683,14 +1276,34 @@ func (p *ListUsersParams) SetUsername(v string) {
 	return
 }
 
+// SetUsernameIfUnset sets username to v, unless it is already set
+func (p *ListUsersParams) SetUsernameIfUnset(v string) {
+	if _, found := p.p["username"]; !found {
+		p.SetUsername(v)
+	}
+}
+
+var _ ListAllSetter = (*ListUsersParams)(nil)
+
 // You should always use this function to get a new ListUsersParams instance,
-// as then you are sure you have configured all required params
-func (s *UserService) NewListUsersParams() *ListUsersParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *UserService) NewListUsersParams(opts ...func(*ListUsersParams)) *ListUsersParams {
 	p := &ListUsersParams{}
 	p.p = make(map[string]interface{})
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
+func (p *ListUsersParams) setFirstMatch() {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p[firstMatchKey] = true
+}
+
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *UserService) GetUserByID(id string, opts ...OptionFunc) (*User, int, error) {
 	p := &ListUsersParams{}
@@ -698,7 +1311,7 @@ func (s *UserService) GetUserByID(id string, opts ...OptionFunc) (*User, int, er
 
 	p.p["id"] = id
 
-	for _, fn := range append(s.cs.options, opts...) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
 		if err := fn(s.cs, p); err != nil {
 			return nil, -1, err
 		}
@@ -709,24 +1322,56 @@ func (s *UserService) GetUserByID(id string, opts ...OptionFunc) (*User, int, er
 		if strings.Contains(err.Error(), fmt.Sprintf(
 			"Invalid parameter id value=%s due to incorrect long value format, "+
 				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+			return nil, 0, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
 	}
 
 	if l.Count == 1 {
 		return l.Users[0], l.Count, nil
 	}
+
+	if _, ok := p.p[firstMatchKey]; ok && len(l.Users) > 0 {
+		return l.Users[0], l.Count, nil
+	}
 	return nil, l.Count, fmt.Errorf("There is more then one result for User UUID: %s!", id)
 }
 
+// UserExists returns true if a User with the given ID exists. When no match is found
+// it returns false, while any other error is returned as is.
+func (s *UserService) UserExists(id string, opts ...OptionFunc) (bool, error) {
+	_, count, err := s.GetUserByID(id, opts...)
+	if errors.Is(err, ErrNotFound) {
+		return false, nil
+	}
+	if count > 0 {
+		return true, nil
+	}
+	return false, err
+}
+
 // Lists user accounts
 func (s *UserService) ListUsers(p *ListUsersParams) (*ListUsersResponse, error) {
-	resp, err := s.cs.newRequest("listUsers", p.toURLValues())
+	return s.ListUsersWithContext(context.Background(), p)
+}
+
+// ListUsersWithContext is the same as ListUsers, but the request is cancelled when the context is done
+func (s *UserService) ListUsersWithContext(ctx context.Context, p *ListUsersParams) (*ListUsersResponse, error) {
+	return s.ListUsersRawWithContext(ctx, p.toURLValues())
+}
+
+// ListUsersRaw is the same as ListUsers, but takes the params as url.Values instead of typed params
+func (s *UserService) ListUsersRaw(v url.Values) (*ListUsersResponse, error) {
+	return s.ListUsersRawWithContext(context.Background(), v)
+}
+
+// ListUsersRawWithContext is the same as ListUsersRaw, but the request is cancelled when the context is done
+func (s *UserService) ListUsersRawWithContext(ctx context.Context, v url.Values) (*ListUsersResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "listUsers", "listusersresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -739,6 +1384,93 @@ func (s *UserService) ListUsers(p *ListUsersParams) (*ListUsersResponse, error)
 	return &r, nil
 }
 
+// StreamUsers fetches the results of ListUsers page by page in the background and sends them on the
+// returned channel. Both returned channels are closed when done. If fetching a page fails, or the context
+// is done before all results are sent, the error is sent on the error channel and fetching stops. A
+// request for a page in flight is cancelled when the context is done. The page and pagesize set in the
+// params are ignored.
+func (s *UserService) StreamUsers(ctx context.Context, p *ListUsersParams) (<-chan *User, <-chan error) {
+	ch := make(chan *User)
+	errs := make(chan error, 1)
+
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesize(500)
+
+	go func() {
+		defer close(errs)
+		defer close(ch)
+
+		for page, count := 1, 0; ; page++ {
+			pp.SetPage(page)
+
+			l, err := s.ListUsersWithContext(ctx, pp)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			for _, v := range l.Users {
+				select {
+				case ch <- v:
+				case <-ctx.Done():
+					errs <- ctx.Err()
+					return
+				}
+			}
+
+			count += len(l.Users)
+			if len(l.Users) < 500 || count >= l.Count {
+				return
+			}
+		}
+	}()
+
+	return ch, errs
+}
+
+// ListUsersWhere returns all results of ListUsers for which pred returns true. Note that the results are
+// filtered client-side, so all results matching the params are fetched first.
+// All pages are fetched, so the page and pagesize set in the params are ignored.
+func (s *UserService) ListUsersWhere(p *ListUsersParams, pred func(*User) bool) ([]*User, error) {
+	var r []*User
+	ch, errs := s.StreamUsers(context.Background(), p)
+	for v := range ch {
+		if pred(v) {
+			r = append(r, v)
+		}
+	}
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ListAllUsers returns the results of ListUsers across all pages, by calling ListUsers page by page until all
+// results are fetched. The pagesize set in the params is used for every page (500 if not set), and the
+// page set in the params is ignored.
+func (s *UserService) ListAllUsers(p *ListUsersParams) ([]*User, error) {
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesizeIfUnset(500)
+	pagesize := pp.p["pagesize"].(int)
+
+	var r []*User
+	for page := 1; ; page++ {
+		pp.SetPage(page)
+
+		l, err := s.ListUsers(pp)
+		if err != nil {
+			return nil, err
+		}
+		r = append(r, l.Users...)
+
+		if len(l.Users) < pagesize || len(r) >= l.Count {
+			return r, nil
+		}
+	}
+}
+
 type ListUsersResponse struct {
 	Count int     `json:"count"`
 	Users []*User `json:"user"`
@@ -771,6 +1503,16 @@ type LockUserParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *LockUserParams) Clone() *LockUserParams {
+	c := &LockUserParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *LockUserParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -782,6 +1524,16 @@ func (p *LockUserParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *LockUserParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of lockUser", k)
+		}
+	}
+	return nil
+}
+
 func (p *LockUserParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -790,18 +1542,54 @@ func (p *LockUserParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *LockUserParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 // You should always use this function to get a new LockUserParams instance,
-// as then you are sure you have configured all required params
-func (s *UserService) NewLockUserParams(id string) *LockUserParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *UserService) NewLockUserParams(id string, opts ...func(*LockUserParams)) *LockUserParams {
 	p := &LockUserParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Locks a user account
-func (s *UserService) LockUser(p *LockUserParams) (*LockUserResponse, error) {
-	resp, err := s.cs.newRequest("lockUser", p.toURLValues())
+func (s *UserService) LockUser(p *LockUserParams, opts ...OptionFunc) (*LockUserResponse, error) {
+	return s.LockUserWithContext(context.Background(), p, opts...)
+}
+
+// LockUserWithContext is the same as LockUser, but the request is cancelled when the context is done
+func (s *UserService) LockUserWithContext(ctx context.Context, p *LockUserParams, opts ...OptionFunc) (*LockUserResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.LockUserRawWithContext(ctx, p.toURLValues())
+}
+
+// LockUserRaw is the same as LockUser, but takes the params as url.Values instead of typed params
+func (s *UserService) LockUserRaw(v url.Values) (*LockUserResponse, error) {
+	return s.LockUserRawWithContext(context.Background(), v)
+}
+
+// LockUserRawWithContext is the same as LockUserRaw, but the request is cancelled when the context is done
+func (s *UserService) LockUserRawWithContext(ctx context.Context, v url.Values) (*LockUserResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "lockUser", "lockuserresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -841,6 +1629,16 @@ type RegisterUserKeysParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *RegisterUserKeysParams) Clone() *RegisterUserKeysParams {
+	c := &RegisterUserKeysParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *RegisterUserKeysParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -852,6 +1650,16 @@ func (p *RegisterUserKeysParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *RegisterUserKeysParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of registerUserKeys", k)
+		}
+	}
+	return nil
+}
+
 func (p *RegisterUserKeysParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -860,23 +1668,59 @@ func (p *RegisterUserKeysParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *RegisterUserKeysParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 // You should always use this function to get a new RegisterUserKeysParams instance,
-// as then you are sure you have configured all required params
-func (s *UserService) NewRegisterUserKeysParams(id string) *RegisterUserKeysParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *UserService) NewRegisterUserKeysParams(id string, opts ...func(*RegisterUserKeysParams)) *RegisterUserKeysParams {
 	p := &RegisterUserKeysParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // This command allows a user to register for the developer API, returning a secret key and an API key. This request is made through the integration API port, so it is a privileged command and must be made on behalf of a user. It is up to the implementer just how the username and password are entered, and then how that translates to an integration API request. Both secret key and API key should be returned to the user
-func (s *UserService) RegisterUserKeys(p *RegisterUserKeysParams) (*RegisterUserKeysResponse, error) {
-	resp, err := s.cs.newRequest("registerUserKeys", p.toURLValues())
+func (s *UserService) RegisterUserKeys(p *RegisterUserKeysParams, opts ...OptionFunc) (*RegisterUserKeysResponse, error) {
+	return s.RegisterUserKeysWithContext(context.Background(), p, opts...)
+}
+
+// RegisterUserKeysWithContext is the same as RegisterUserKeys, but the request is cancelled when the context is done
+func (s *UserService) RegisterUserKeysWithContext(ctx context.Context, p *RegisterUserKeysParams, opts ...OptionFunc) (*RegisterUserKeysResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.RegisterUserKeysRawWithContext(ctx, p.toURLValues())
+}
+
+// RegisterUserKeysRaw is the same as RegisterUserKeys, but takes the params as url.Values instead of typed params
+func (s *UserService) RegisterUserKeysRaw(v url.Values) (*RegisterUserKeysResponse, error) {
+	return s.RegisterUserKeysRawWithContext(context.Background(), v)
+}
+
+// RegisterUserKeysRawWithContext is the same as RegisterUserKeysRaw, but the request is cancelled when the context is done
+func (s *UserService) RegisterUserKeysRawWithContext(ctx context.Context, v url.Values) (*RegisterUserKeysResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "registerUserKeys", "registeruserkeysresponse", v)
 	if err != nil {
 		return nil, err
 	}
 
-	if resp, err = getRawValue(resp); err != nil {
+	if resp, err = getRawValueByKey(resp, "userkeys"); err != nil {
 		return nil, err
 	}
 
@@ -897,6 +1741,16 @@ type UpdateUserParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *UpdateUserParams) Clone() *UpdateUserParams {
+	c := &UpdateUserParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *UpdateUserParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -932,6 +1786,16 @@ func (p *UpdateUserParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *UpdateUserParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of updateUser", k)
+		}
+	}
+	return nil
+}
+
 func (p *UpdateUserParams) SetEmail(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -940,6 +1804,13 @@ func (p *UpdateUserParams) SetEmail(v string) {
 	return
 }
 
+// SetEmailIfUnset sets email to v, unless it is already set
+func (p *UpdateUserParams) SetEmailIfUnset(v string) {
+	if _, found := p.p["email"]; !found {
+		p.SetEmail(v)
+	}
+}
+
 func (p *UpdateUserParams) SetFirstname(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -948,6 +1819,13 @@ func (p *UpdateUserParams) SetFirstname(v string) {
 	return
 }
 
+// SetFirstnameIfUnset sets firstname to v, unless it is already set
+func (p *UpdateUserParams) SetFirstnameIfUnset(v string) {
+	if _, found := p.p["firstname"]; !found {
+		p.SetFirstname(v)
+	}
+}
+
 func (p *UpdateUserParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -956,6 +1834,13 @@ func (p *UpdateUserParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *UpdateUserParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 func (p *UpdateUserParams) SetLastname(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -964,6 +1849,13 @@ func (p *UpdateUserParams) SetLastname(v string) {
 	return
 }
 
+// SetLastnameIfUnset sets lastname to v, unless it is already set
+func (p *UpdateUserParams) SetLastnameIfUnset(v string) {
+	if _, found := p.p["lastname"]; !found {
+		p.SetLastname(v)
+	}
+}
+
 func (p *UpdateUserParams) SetPassword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -972,6 +1864,13 @@ func (p *UpdateUserParams) SetPassword(v string) {
 	return
 }
 
+// SetPasswordIfUnset sets password to v, unless it is already set
+func (p *UpdateUserParams) SetPasswordIfUnset(v string) {
+	if _, found := p.p["password"]; !found {
+		p.SetPassword(v)
+	}
+}
+
 func (p *UpdateUserParams) SetTimezone(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -980,6 +1879,13 @@ func (p *UpdateUserParams) SetTimezone(v string) {
 	return
 }
 
+// SetTimezoneIfUnset sets timezone to v, unless it is already set
+func (p *UpdateUserParams) SetTimezoneIfUnset(v string) {
+	if _, found := p.p["timezone"]; !found {
+		p.SetTimezone(v)
+	}
+}
+
 func (p *UpdateUserParams) SetUserapikey(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -988,6 +1894,13 @@ func (p *UpdateUserParams) SetUserapikey(v string) {
 	return
 }
 
+// SetUserapikeyIfUnset sets userapikey to v, unless it is already set
+func (p *UpdateUserParams) SetUserapikeyIfUnset(v string) {
+	if _, found := p.p["userapikey"]; !found {
+		p.SetUserapikey(v)
+	}
+}
+
 func (p *UpdateUserParams) SetUsername(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -996,6 +1909,13 @@ func (p *UpdateUserParams) SetUsername(v string) {
 	return
 }
 
+// SetUsernameIfUnset sets username to v, unless it is already set
+func (p *UpdateUserParams) SetUsernameIfUnset(v string) {
+	if _, found := p.p["username"]; !found {
+		p.SetUsername(v)
+	}
+}
+
 func (p *UpdateUserParams) SetUsersecretkey(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1004,18 +1924,54 @@ func (p *UpdateUserParams) SetUsersecretkey(v string) {
 	return
 }
 
+// SetUsersecretkeyIfUnset sets usersecretkey to v, unless it is already set
+func (p *UpdateUserParams) SetUsersecretkeyIfUnset(v string) {
+	if _, found := p.p["usersecretkey"]; !found {
+		p.SetUsersecretkey(v)
+	}
+}
+
 // You should always use this function to get a new UpdateUserParams instance,
-// as then you are sure you have configured all required params
-func (s *UserService) NewUpdateUserParams(id string) *UpdateUserParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *UserService) NewUpdateUserParams(id string, opts ...func(*UpdateUserParams)) *UpdateUserParams {
 	p := &UpdateUserParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Updates a user account
-func (s *UserService) UpdateUser(p *UpdateUserParams) (*UpdateUserResponse, error) {
-	resp, err := s.cs.newRequest("updateUser", p.toURLValues())
+func (s *UserService) UpdateUser(p *UpdateUserParams, opts ...OptionFunc) (*UpdateUserResponse, error) {
+	return s.UpdateUserWithContext(context.Background(), p, opts...)
+}
+
+// UpdateUserWithContext is the same as UpdateUser, but the request is cancelled when the context is done
+func (s *UserService) UpdateUserWithContext(ctx context.Context, p *UpdateUserParams, opts ...OptionFunc) (*UpdateUserResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.UpdateUserRawWithContext(ctx, p.toURLValues())
+}
+
+// UpdateUserRaw is the same as UpdateUser, but takes the params as url.Values instead of typed params
+func (s *UserService) UpdateUserRaw(v url.Values) (*UpdateUserResponse, error) {
+	return s.UpdateUserRawWithContext(context.Background(), v)
+}
+
+// UpdateUserRawWithContext is the same as UpdateUserRaw, but the request is cancelled when the context is done
+func (s *UserService) UpdateUserRawWithContext(ctx context.Context, v url.Values) (*UpdateUserResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "updateUser", "updateuserresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -17,17 +17,120 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/url"
+	"sort"
 	"strconv"
 	"strings"
+	"time"
 )
 
+// The first and last valid VLAN ID
+const (
+	minVlanID = 1
+	maxVlanID = 4094
+)
+
+// FindFreeVlanRange lists the VLAN IP ranges of the given zone and returns the first and last VLAN
+// ID of the first range of desiredSize consecutive VLAN IDs which is not used by any of them. This
+// only looks up a free range, so nothing is created or reserved. VLANs which are used by other means
+// than a VLAN IP range (e.g. by guest networks) are not taken into account.
+func (s *VLANService) FindFreeVlanRange(zoneid string, desiredSize int, opts ...OptionFunc) (string, string, error) {
+	if desiredSize < 1 || desiredSize > maxVlanID-minVlanID+1 {
+		return "", "", fmt.Errorf("Invalid VLAN range size %d, expected a size between 1 and %d", desiredSize, maxVlanID-minVlanID+1)
+	}
+
+	var used [][2]int
+	for page, count := 1, 0; ; page++ {
+		p := s.NewListVlanIpRangesParams()
+		p.SetZoneid(zoneid)
+		p.SetPage(page)
+		p.SetPagesize(500)
+
+		for _, fn := range s.cs.withDefaultOptions(opts) {
+			if err := fn(s.cs, p); err != nil {
+				return "", "", err
+			}
+		}
+
+		l, err := s.ListVlanIpRanges(p)
+		if err != nil {
+			return "", "", err
+		}
+		for _, r := range l.VlanIpRanges {
+			if start, end, ok := parseVlanRange(r.Vlan); ok {
+				used = append(used, [2]int{start, end})
+			}
+		}
+
+		count += len(l.VlanIpRanges)
+		if len(l.VlanIpRanges) < 500 || count >= l.Count {
+			break
+		}
+	}
+
+	sort.Slice(used, func(i, j int) bool {
+		return used[i][0] < used[j][0]
+	})
+
+	// Walk the used ranges in order and stop at the first gap that is big enough
+	next := minVlanID
+	for _, u := range used {
+		if u[0]-next >= desiredSize {
+			break
+		}
+		if u[1] >= next {
+			next = u[1] + 1
+		}
+	}
+
+	if next+desiredSize-1 > maxVlanID {
+		return "", "", fmt.Errorf("No free range of %d VLANs found in zone %s", desiredSize, zoneid)
+	}
+
+	return strconv.Itoa(next), strconv.Itoa(next + desiredSize - 1), nil
+}
+
+// Parses a VLAN as returned by listVlanIpRanges (e.g. "100", "vlan://100" or "vlan://100-200")
+// into its first and last VLAN ID. Returns false for untagged or otherwise unparsable VLANs.
+func parseVlanRange(vlan string) (int, int, bool) {
+	parts := strings.SplitN(strings.TrimPrefix(vlan, "vlan://"), "-", 2)
+
+	start, err := strconv.Atoi(strings.TrimSpace(parts[0]))
+	if err != nil {
+		return 0, 0, false
+	}
+
+	end := start
+	if len(parts) == 2 {
+		if end, err = strconv.Atoi(strings.TrimSpace(parts[1])); err != nil {
+			return 0, 0, false
+		}
+	}
+	if end < start {
+		start, end = end, start
+	}
+
+	return start, end, true
+}
+
 type CreateVlanIpRangeParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *CreateVlanIpRangeParams) Clone() *CreateVlanIpRangeParams {
+	c := &CreateVlanIpRangeParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *CreateVlanIpRangeParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -96,6 +199,13 @@ func (p *CreateVlanIpRangeParams) SetAccount(v string) {
 	return
 }
 
+// SetAccountIfUnset sets account to v, unless it is already set
+func (p *CreateVlanIpRangeParams) SetAccountIfUnset(v string) {
+	if _, found := p.p["account"]; !found {
+		p.SetAccount(v)
+	}
+}
+
 func (p *CreateVlanIpRangeParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -104,6 +214,13 @@ func (p *CreateVlanIpRangeParams) SetDomainid(v string) {
 	return
 }
 
+// SetDomainidIfUnset sets domainid to v, unless it is already set
+func (p *CreateVlanIpRangeParams) SetDomainidIfUnset(v string) {
+	if _, found := p.p["domainid"]; !found {
+		p.SetDomainid(v)
+	}
+}
+
 func (p *CreateVlanIpRangeParams) SetEndip(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -112,6 +229,13 @@ func (p *CreateVlanIpRangeParams) SetEndip(v string) {
 	return
 }
 
+// SetEndipIfUnset sets endip to v, unless it is already set
+func (p *CreateVlanIpRangeParams) SetEndipIfUnset(v string) {
+	if _, found := p.p["endip"]; !found {
+		p.SetEndip(v)
+	}
+}
+
 func (p *CreateVlanIpRangeParams) SetEndipv6(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -120,6 +244,13 @@ func (p *CreateVlanIpRangeParams) SetEndipv6(v string) {
 	return
 }
 
+// SetEndipv6IfUnset sets endipv6 to v, unless it is already set
+func (p *CreateVlanIpRangeParams) SetEndipv6IfUnset(v string) {
+	if _, found := p.p["endipv6"]; !found {
+		p.SetEndipv6(v)
+	}
+}
+
 func (p *CreateVlanIpRangeParams) SetForvirtualnetwork(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -128,6 +259,13 @@ func (p *CreateVlanIpRangeParams) SetForvirtualnetwork(v bool) {
 	return
 }
 
+// SetForvirtualnetworkIfUnset sets forvirtualnetwork to v, unless it is already set
+func (p *CreateVlanIpRangeParams) SetForvirtualnetworkIfUnset(v bool) {
+	if _, found := p.p["forvirtualnetwork"]; !found {
+		p.SetForvirtualnetwork(v)
+	}
+}
+
 func (p *CreateVlanIpRangeParams) SetGateway(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -136,6 +274,13 @@ func (p *CreateVlanIpRangeParams) SetGateway(v string) {
 	return
 }
 
+// SetGatewayIfUnset sets gateway to v, unless it is already set
+func (p *CreateVlanIpRangeParams) SetGatewayIfUnset(v string) {
+	if _, found := p.p["gateway"]; !found {
+		p.SetGateway(v)
+	}
+}
+
 func (p *CreateVlanIpRangeParams) SetIp6cidr(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -144,6 +289,13 @@ func (p *CreateVlanIpRangeParams) SetIp6cidr(v string) {
 	return
 }
 
+// SetIp6cidrIfUnset sets ip6cidr to v, unless it is already set
+func (p *CreateVlanIpRangeParams) SetIp6cidrIfUnset(v string) {
+	if _, found := p.p["ip6cidr"]; !found {
+		p.SetIp6cidr(v)
+	}
+}
+
 func (p *CreateVlanIpRangeParams) SetIp6gateway(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -152,6 +304,13 @@ func (p *CreateVlanIpRangeParams) SetIp6gateway(v string) {
 	return
 }
 
+// SetIp6gatewayIfUnset sets ip6gateway to v, unless it is already set
+func (p *CreateVlanIpRangeParams) SetIp6gatewayIfUnset(v string) {
+	if _, found := p.p["ip6gateway"]; !found {
+		p.SetIp6gateway(v)
+	}
+}
+
 func (p *CreateVlanIpRangeParams) SetNetmask(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -160,6 +319,13 @@ func (p *CreateVlanIpRangeParams) SetNetmask(v string) {
 	return
 }
 
+// SetNetmaskIfUnset sets netmask to v, unless it is already set
+func (p *CreateVlanIpRangeParams) SetNetmaskIfUnset(v string) {
+	if _, found := p.p["netmask"]; !found {
+		p.SetNetmask(v)
+	}
+}
+
 func (p *CreateVlanIpRangeParams) SetNetworkid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -168,6 +334,13 @@ func (p *CreateVlanIpRangeParams) SetNetworkid(v string) {
 	return
 }
 
+// SetNetworkidIfUnset sets networkid to v, unless it is already set
+func (p *CreateVlanIpRangeParams) SetNetworkidIfUnset(v string) {
+	if _, found := p.p["networkid"]; !found {
+		p.SetNetworkid(v)
+	}
+}
+
 func (p *CreateVlanIpRangeParams) SetPhysicalnetworkid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -176,6 +349,13 @@ func (p *CreateVlanIpRangeParams) SetPhysicalnetworkid(v string) {
 	return
 }
 
+// SetPhysicalnetworkidIfUnset sets physicalnetworkid to v, unless it is already set
+func (p *CreateVlanIpRangeParams) SetPhysicalnetworkidIfUnset(v string) {
+	if _, found := p.p["physicalnetworkid"]; !found {
+		p.SetPhysicalnetworkid(v)
+	}
+}
+
 func (p *CreateVlanIpRangeParams) SetPodid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -184,6 +364,13 @@ func (p *CreateVlanIpRangeParams) SetPodid(v string) {
 	return
 }
 
+// SetPodidIfUnset sets podid to v, unless it is already set
+func (p *CreateVlanIpRangeParams) SetPodidIfUnset(v string) {
+	if _, found := p.p["podid"]; !found {
+		p.SetPodid(v)
+	}
+}
+
 func (p *CreateVlanIpRangeParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -192,6 +379,13 @@ func (p *CreateVlanIpRangeParams) SetProjectid(v string) {
 	return
 }
 
+// SetProjectidIfUnset sets projectid to v, unless it is already set
+func (p *CreateVlanIpRangeParams) SetProjectidIfUnset(v string) {
+	if _, found := p.p["projectid"]; !found {
+		p.SetProjectid(v)
+	}
+}
+
 func (p *CreateVlanIpRangeParams) SetStartip(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -200,6 +394,13 @@ func (p *CreateVlanIpRangeParams) SetStartip(v string) {
 	return
 }
 
+// SetStartipIfUnset sets startip to v, unless it is already set
+func (p *CreateVlanIpRangeParams) SetStartipIfUnset(v string) {
+	if _, found := p.p["startip"]; !found {
+		p.SetStartip(v)
+	}
+}
+
 func (p *CreateVlanIpRangeParams) SetStartipv6(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -208,6 +409,13 @@ func (p *CreateVlanIpRangeParams) SetStartipv6(v string) {
 	return
 }
 
+// SetStartipv6IfUnset sets startipv6 to v, unless it is already set
+func (p *CreateVlanIpRangeParams) SetStartipv6IfUnset(v string) {
+	if _, found := p.p["startipv6"]; !found {
+		p.SetStartipv6(v)
+	}
+}
+
 func (p *CreateVlanIpRangeParams) SetVlan(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -216,6 +424,13 @@ func (p *CreateVlanIpRangeParams) SetVlan(v string) {
 	return
 }
 
+// SetVlanIfUnset sets vlan to v, unless it is already set
+func (p *CreateVlanIpRangeParams) SetVlanIfUnset(v string) {
+	if _, found := p.p["vlan"]; !found {
+		p.SetVlan(v)
+	}
+}
+
 func (p *CreateVlanIpRangeParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -224,17 +439,49 @@ func (p *CreateVlanIpRangeParams) SetZoneid(v string) {
 	return
 }
 
+// SetZoneidIfUnset sets zoneid to v, unless it is already set
+func (p *CreateVlanIpRangeParams) SetZoneidIfUnset(v string) {
+	if _, found := p.p["zoneid"]; !found {
+		p.SetZoneid(v)
+	}
+}
+
 // You should always use this function to get a new CreateVlanIpRangeParams instance,
-// as then you are sure you have configured all required params
-func (s *VLANService) NewCreateVlanIpRangeParams() *CreateVlanIpRangeParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *VLANService) NewCreateVlanIpRangeParams(opts ...func(*CreateVlanIpRangeParams)) *CreateVlanIpRangeParams {
 	p := &CreateVlanIpRangeParams{}
 	p.p = make(map[string]interface{})
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Creates a VLAN IP range.
-func (s *VLANService) CreateVlanIpRange(p *CreateVlanIpRangeParams) (*CreateVlanIpRangeResponse, error) {
-	resp, err := s.cs.newRequest("createVlanIpRange", p.toURLValues())
+func (s *VLANService) CreateVlanIpRange(p *CreateVlanIpRangeParams, opts ...OptionFunc) (*CreateVlanIpRangeResponse, error) {
+	return s.CreateVlanIpRangeWithContext(context.Background(), p, opts...)
+}
+
+// CreateVlanIpRangeWithContext is the same as CreateVlanIpRange, but the request is cancelled when the context is done
+func (s *VLANService) CreateVlanIpRangeWithContext(ctx context.Context, p *CreateVlanIpRangeParams, opts ...OptionFunc) (*CreateVlanIpRangeResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	return s.CreateVlanIpRangeRawWithContext(ctx, p.toURLValues())
+}
+
+// CreateVlanIpRangeRaw is the same as CreateVlanIpRange, but takes the params as url.Values instead of typed params
+func (s *VLANService) CreateVlanIpRangeRaw(v url.Values) (*CreateVlanIpRangeResponse, error) {
+	return s.CreateVlanIpRangeRawWithContext(context.Background(), v)
+}
+
+// CreateVlanIpRangeRawWithContext is the same as CreateVlanIpRangeRaw, but the request is cancelled when the context is done
+func (s *VLANService) CreateVlanIpRangeRawWithContext(ctx context.Context, v url.Values) (*CreateVlanIpRangeResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "createVlanIpRange", "createvlaniprangeresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -276,6 +523,16 @@ type DedicateGuestVlanRangeParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *DedicateGuestVlanRangeParams) Clone() *DedicateGuestVlanRangeParams {
+	c := &DedicateGuestVlanRangeParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DedicateGuestVlanRangeParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -299,6 +556,16 @@ func (p *DedicateGuestVlanRangeParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *DedicateGuestVlanRangeParams) validate() error {
+	for _, k := range []string{"physicalnetworkid", "vlanrange"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of dedicateGuestVlanRange", k)
+		}
+	}
+	return nil
+}
+
 func (p *DedicateGuestVlanRangeParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -307,6 +574,13 @@ func (p *DedicateGuestVlanRangeParams) SetAccount(v string) {
 	return
 }
 
+// SetAccountIfUnset sets account to v, unless it is already set
+func (p *DedicateGuestVlanRangeParams) SetAccountIfUnset(v string) {
+	if _, found := p.p["account"]; !found {
+		p.SetAccount(v)
+	}
+}
+
 func (p *DedicateGuestVlanRangeParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -315,6 +589,13 @@ func (p *DedicateGuestVlanRangeParams) SetDomainid(v string) {
 	return
 }
 
+// SetDomainidIfUnset sets domainid to v, unless it is already set
+func (p *DedicateGuestVlanRangeParams) SetDomainidIfUnset(v string) {
+	if _, found := p.p["domainid"]; !found {
+		p.SetDomainid(v)
+	}
+}
+
 func (p *DedicateGuestVlanRangeParams) SetPhysicalnetworkid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -323,6 +604,13 @@ func (p *DedicateGuestVlanRangeParams) SetPhysicalnetworkid(v string) {
 	return
 }
 
+// SetPhysicalnetworkidIfUnset sets physicalnetworkid to v, unless it is already set
+func (p *DedicateGuestVlanRangeParams) SetPhysicalnetworkidIfUnset(v string) {
+	if _, found := p.p["physicalnetworkid"]; !found {
+		p.SetPhysicalnetworkid(v)
+	}
+}
+
 func (p *DedicateGuestVlanRangeParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -331,6 +619,13 @@ func (p *DedicateGuestVlanRangeParams) SetProjectid(v string) {
 	return
 }
 
+// SetProjectidIfUnset sets projectid to v, unless it is already set
+func (p *DedicateGuestVlanRangeParams) SetProjectidIfUnset(v string) {
+	if _, found := p.p["projectid"]; !found {
+		p.SetProjectid(v)
+	}
+}
+
 func (p *DedicateGuestVlanRangeParams) SetVlanrange(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -339,19 +634,55 @@ func (p *DedicateGuestVlanRangeParams) SetVlanrange(v string) {
 	return
 }
 
+// SetVlanrangeIfUnset sets vlanrange to v, unless it is already set
+func (p *DedicateGuestVlanRangeParams) SetVlanrangeIfUnset(v string) {
+	if _, found := p.p["vlanrange"]; !found {
+		p.SetVlanrange(v)
+	}
+}
+
 // You should always use this function to get a new DedicateGuestVlanRangeParams instance,
-// as then you are sure you have configured all required params
-func (s *VLANService) NewDedicateGuestVlanRangeParams(physicalnetworkid string, vlanrange string) *DedicateGuestVlanRangeParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *VLANService) NewDedicateGuestVlanRangeParams(physicalnetworkid string, vlanrange string, opts ...func(*DedicateGuestVlanRangeParams)) *DedicateGuestVlanRangeParams {
 	p := &DedicateGuestVlanRangeParams{}
 	p.p = make(map[string]interface{})
 	p.p["physicalnetworkid"] = physicalnetworkid
 	p.p["vlanrange"] = vlanrange
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Dedicates a guest vlan range to an account
-func (s *VLANService) DedicateGuestVlanRange(p *DedicateGuestVlanRangeParams) (*DedicateGuestVlanRangeResponse, error) {
-	resp, err := s.cs.newRequest("dedicateGuestVlanRange", p.toURLValues())
+func (s *VLANService) DedicateGuestVlanRange(p *DedicateGuestVlanRangeParams, opts ...OptionFunc) (*DedicateGuestVlanRangeResponse, error) {
+	return s.DedicateGuestVlanRangeWithContext(context.Background(), p, opts...)
+}
+
+// DedicateGuestVlanRangeWithContext is the same as DedicateGuestVlanRange, but the request is cancelled when the context is done
+func (s *VLANService) DedicateGuestVlanRangeWithContext(ctx context.Context, p *DedicateGuestVlanRangeParams, opts ...OptionFunc) (*DedicateGuestVlanRangeResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.DedicateGuestVlanRangeRawWithContext(ctx, p.toURLValues())
+}
+
+// DedicateGuestVlanRangeRaw is the same as DedicateGuestVlanRange, but takes the params as url.Values instead of typed params
+func (s *VLANService) DedicateGuestVlanRangeRaw(v url.Values) (*DedicateGuestVlanRangeResponse, error) {
+	return s.DedicateGuestVlanRangeRawWithContext(context.Background(), v)
+}
+
+// DedicateGuestVlanRangeRawWithContext is the same as DedicateGuestVlanRangeRaw, but the request is cancelled when the context is done
+func (s *VLANService) DedicateGuestVlanRangeRawWithContext(ctx context.Context, v url.Values) (*DedicateGuestVlanRangeResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "dedicateGuestVlanRange", "dedicateguestvlanrangeresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -380,6 +711,16 @@ type DeleteVlanIpRangeParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *DeleteVlanIpRangeParams) Clone() *DeleteVlanIpRangeParams {
+	c := &DeleteVlanIpRangeParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeleteVlanIpRangeParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -391,6 +732,16 @@ func (p *DeleteVlanIpRangeParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *DeleteVlanIpRangeParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of deleteVlanIpRange", k)
+		}
+	}
+	return nil
+}
+
 func (p *DeleteVlanIpRangeParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -399,18 +750,54 @@ func (p *DeleteVlanIpRangeParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *DeleteVlanIpRangeParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 // You should always use this function to get a new DeleteVlanIpRangeParams instance,
-// as then you are sure you have configured all required params
-func (s *VLANService) NewDeleteVlanIpRangeParams(id string) *DeleteVlanIpRangeParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *VLANService) NewDeleteVlanIpRangeParams(id string, opts ...func(*DeleteVlanIpRangeParams)) *DeleteVlanIpRangeParams {
 	p := &DeleteVlanIpRangeParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Creates a VLAN IP range.
-func (s *VLANService) DeleteVlanIpRange(p *DeleteVlanIpRangeParams) (*DeleteVlanIpRangeResponse, error) {
-	resp, err := s.cs.newRequest("deleteVlanIpRange", p.toURLValues())
+func (s *VLANService) DeleteVlanIpRange(p *DeleteVlanIpRangeParams, opts ...OptionFunc) (*DeleteVlanIpRangeResponse, error) {
+	return s.DeleteVlanIpRangeWithContext(context.Background(), p, opts...)
+}
+
+// DeleteVlanIpRangeWithContext is the same as DeleteVlanIpRange, but the request is cancelled when the context is done
+func (s *VLANService) DeleteVlanIpRangeWithContext(ctx context.Context, p *DeleteVlanIpRangeParams, opts ...OptionFunc) (*DeleteVlanIpRangeResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.DeleteVlanIpRangeRawWithContext(ctx, p.toURLValues())
+}
+
+// DeleteVlanIpRangeRaw is the same as DeleteVlanIpRange, but takes the params as url.Values instead of typed params
+func (s *VLANService) DeleteVlanIpRangeRaw(v url.Values) (*DeleteVlanIpRangeResponse, error) {
+	return s.DeleteVlanIpRangeRawWithContext(context.Background(), v)
+}
+
+// DeleteVlanIpRangeRawWithContext is the same as DeleteVlanIpRangeRaw, but the request is cancelled when the context is done
+func (s *VLANService) DeleteVlanIpRangeRawWithContext(ctx context.Context, v url.Values) (*DeleteVlanIpRangeResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteVlanIpRange", "deletevlaniprangeresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -423,6 +810,34 @@ func (s *VLANService) DeleteVlanIpRange(p *DeleteVlanIpRangeParams) (*DeleteVlan
 	return &r, nil
 }
 
+// WaitForVlanIpRangeDeleted polls until the VlanIpRange with the given ID no longer exists, the timeout
+// expires or the context is cancelled. Deleted resources may still be listed for a short while
+// after the delete command finished, so this can be used to make sure they are really gone. The
+// options are passed to GetVlanIpRangeByID, so a resource outside of the default scope can be found.
+func (s *VLANService) WaitForVlanIpRangeDeleted(ctx context.Context, id string, timeout time.Duration, opts ...OptionFunc) error {
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	for {
+		_, count, err := s.GetVlanIpRangeByID(id, opts...)
+		if count == 0 {
+			return nil
+		}
+		if count < 0 {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(2 * time.Second):
+		}
+	}
+}
+
 type DeleteVlanIpRangeResponse struct {
 	Displaytext string `json:"displaytext"`
 	Success     bool   `json:"success"`
@@ -451,6 +866,16 @@ type ListDedicatedGuestVlanRangesParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ListDedicatedGuestVlanRangesParams) Clone() *ListDedicatedGuestVlanRangesParams {
+	c := &ListDedicatedGuestVlanRangesParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListDedicatedGuestVlanRangesParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -499,6 +924,13 @@ func (p *ListDedicatedGuestVlanRangesParams) SetAccount(v string) {
 	return
 }
 
+// SetAccountIfUnset sets account to v, unless it is already set
+func (p *ListDedicatedGuestVlanRangesParams) SetAccountIfUnset(v string) {
+	if _, found := p.p["account"]; !found {
+		p.SetAccount(v)
+	}
+}
+
 func (p *ListDedicatedGuestVlanRangesParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -507,6 +939,13 @@ func (p *ListDedicatedGuestVlanRangesParams) SetDomainid(v string) {
 	return
 }
 
+// SetDomainidIfUnset sets domainid to v, unless it is already set
+func (p *ListDedicatedGuestVlanRangesParams) SetDomainidIfUnset(v string) {
+	if _, found := p.p["domainid"]; !found {
+		p.SetDomainid(v)
+	}
+}
+
 func (p *ListDedicatedGuestVlanRangesParams) SetGuestvlanrange(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -515,6 +954,13 @@ func (p *ListDedicatedGuestVlanRangesParams) SetGuestvlanrange(v string) {
 	return
 }
 
+// SetGuestvlanrangeIfUnset sets guestvlanrange to v, unless it is already set
+func (p *ListDedicatedGuestVlanRangesParams) SetGuestvlanrangeIfUnset(v string) {
+	if _, found := p.p["guestvlanrange"]; !found {
+		p.SetGuestvlanrange(v)
+	}
+}
+
 func (p *ListDedicatedGuestVlanRangesParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -523,6 +969,13 @@ func (p *ListDedicatedGuestVlanRangesParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *ListDedicatedGuestVlanRangesParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 func (p *ListDedicatedGuestVlanRangesParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -531,6 +984,13 @@ func (p *ListDedicatedGuestVlanRangesParams) SetKeyword(v string) {
 	return
 }
 
+// SetKeywordIfUnset sets keyword to v, unless it is already set
+func (p *ListDedicatedGuestVlanRangesParams) SetKeywordIfUnset(v string) {
+	if _, found := p.p["keyword"]; !found {
+		p.SetKeyword(v)
+	}
+}
+
 func (p *ListDedicatedGuestVlanRangesParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -539,6 +999,13 @@ func (p *ListDedicatedGuestVlanRangesParams) SetPage(v int) {
 	return
 }
 
+// SetPageIfUnset sets page to v, unless it is already set
+func (p *ListDedicatedGuestVlanRangesParams) SetPageIfUnset(v int) {
+	if _, found := p.p["page"]; !found {
+		p.SetPage(v)
+	}
+}
+
 func (p *ListDedicatedGuestVlanRangesParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -547,6 +1014,13 @@ func (p *ListDedicatedGuestVlanRangesParams) SetPagesize(v int) {
 	return
 }
 
+// SetPagesizeIfUnset sets pagesize to v, unless it is already set
+func (p *ListDedicatedGuestVlanRangesParams) SetPagesizeIfUnset(v int) {
+	if _, found := p.p["pagesize"]; !found {
+		p.SetPagesize(v)
+	}
+}
+
 func (p *ListDedicatedGuestVlanRangesParams) SetPhysicalnetworkid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -555,6 +1029,13 @@ func (p *ListDedicatedGuestVlanRangesParams) SetPhysicalnetworkid(v string) {
 	return
 }
 
+// SetPhysicalnetworkidIfUnset sets physicalnetworkid to v, unless it is already set
+func (p *ListDedicatedGuestVlanRangesParams) SetPhysicalnetworkidIfUnset(v string) {
+	if _, found := p.p["physicalnetworkid"]; !found {
+		p.SetPhysicalnetworkid(v)
+	}
+}
+
 func (p *ListDedicatedGuestVlanRangesParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -563,6 +1044,13 @@ func (p *ListDedicatedGuestVlanRangesParams) SetProjectid(v string) {
 	return
 }
 
+// SetProjectidIfUnset sets projectid to v, unless it is already set
+func (p *ListDedicatedGuestVlanRangesParams) SetProjectidIfUnset(v string) {
+	if _, found := p.p["projectid"]; !found {
+		p.SetProjectid(v)
+	}
+}
+
 func (p *ListDedicatedGuestVlanRangesParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -571,14 +1059,32 @@ func (p *ListDedicatedGuestVlanRangesParams) SetZoneid(v string) {
 	return
 }
 
+// SetZoneidIfUnset sets zoneid to v, unless it is already set
+func (p *ListDedicatedGuestVlanRangesParams) SetZoneidIfUnset(v string) {
+	if _, found := p.p["zoneid"]; !found {
+		p.SetZoneid(v)
+	}
+}
+
 // You should always use this function to get a new ListDedicatedGuestVlanRangesParams instance,
-// as then you are sure you have configured all required params
-func (s *VLANService) NewListDedicatedGuestVlanRangesParams() *ListDedicatedGuestVlanRangesParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *VLANService) NewListDedicatedGuestVlanRangesParams(opts ...func(*ListDedicatedGuestVlanRangesParams)) *ListDedicatedGuestVlanRangesParams {
 	p := &ListDedicatedGuestVlanRangesParams{}
 	p.p = make(map[string]interface{})
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
+func (p *ListDedicatedGuestVlanRangesParams) setFirstMatch() {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p[firstMatchKey] = true
+}
+
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *VLANService) GetDedicatedGuestVlanRangeByID(id string, opts ...OptionFunc) (*DedicatedGuestVlanRange, int, error) {
 	p := &ListDedicatedGuestVlanRangesParams{}
@@ -586,7 +1092,7 @@ func (s *VLANService) GetDedicatedGuestVlanRangeByID(id string, opts ...OptionFu
 
 	p.p["id"] = id
 
-	for _, fn := range append(s.cs.options, opts...) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
 		if err := fn(s.cs, p); err != nil {
 			return nil, -1, err
 		}
@@ -597,24 +1103,56 @@ func (s *VLANService) GetDedicatedGuestVlanRangeByID(id string, opts ...OptionFu
 		if strings.Contains(err.Error(), fmt.Sprintf(
 			"Invalid parameter id value=%s due to incorrect long value format, "+
 				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+			return nil, 0, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
 	}
 
 	if l.Count == 1 {
 		return l.DedicatedGuestVlanRanges[0], l.Count, nil
 	}
+
+	if _, ok := p.p[firstMatchKey]; ok && len(l.DedicatedGuestVlanRanges) > 0 {
+		return l.DedicatedGuestVlanRanges[0], l.Count, nil
+	}
 	return nil, l.Count, fmt.Errorf("There is more then one result for DedicatedGuestVlanRange UUID: %s!", id)
 }
 
+// DedicatedGuestVlanRangeExists returns true if a DedicatedGuestVlanRange with the given ID exists. When no match is found
+// it returns false, while any other error is returned as is.
+func (s *VLANService) DedicatedGuestVlanRangeExists(id string, opts ...OptionFunc) (bool, error) {
+	_, count, err := s.GetDedicatedGuestVlanRangeByID(id, opts...)
+	if errors.Is(err, ErrNotFound) {
+		return false, nil
+	}
+	if count > 0 {
+		return true, nil
+	}
+	return false, err
+}
+
 // Lists dedicated guest vlan ranges
 func (s *VLANService) ListDedicatedGuestVlanRanges(p *ListDedicatedGuestVlanRangesParams) (*ListDedicatedGuestVlanRangesResponse, error) {
-	resp, err := s.cs.newRequest("listDedicatedGuestVlanRanges", p.toURLValues())
+	return s.ListDedicatedGuestVlanRangesWithContext(context.Background(), p)
+}
+
+// ListDedicatedGuestVlanRangesWithContext is the same as ListDedicatedGuestVlanRanges, but the request is cancelled when the context is done
+func (s *VLANService) ListDedicatedGuestVlanRangesWithContext(ctx context.Context, p *ListDedicatedGuestVlanRangesParams) (*ListDedicatedGuestVlanRangesResponse, error) {
+	return s.ListDedicatedGuestVlanRangesRawWithContext(ctx, p.toURLValues())
+}
+
+// ListDedicatedGuestVlanRangesRaw is the same as ListDedicatedGuestVlanRanges, but takes the params as url.Values instead of typed params
+func (s *VLANService) ListDedicatedGuestVlanRangesRaw(v url.Values) (*ListDedicatedGuestVlanRangesResponse, error) {
+	return s.ListDedicatedGuestVlanRangesRawWithContext(context.Background(), v)
+}
+
+// ListDedicatedGuestVlanRangesRawWithContext is the same as ListDedicatedGuestVlanRangesRaw, but the request is cancelled when the context is done
+func (s *VLANService) ListDedicatedGuestVlanRangesRawWithContext(ctx context.Context, v url.Values) (*ListDedicatedGuestVlanRangesResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "listDedicatedGuestVlanRanges", "listdedicatedguestvlanrangesresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -627,6 +1165,93 @@ func (s *VLANService) ListDedicatedGuestVlanRanges(p *ListDedicatedGuestVlanRang
 	return &r, nil
 }
 
+// StreamDedicatedGuestVlanRanges fetches the results of ListDedicatedGuestVlanRanges page by page in the background and sends them on the
+// returned channel. Both returned channels are closed when done. If fetching a page fails, or the context
+// is done before all results are sent, the error is sent on the error channel and fetching stops. A
+// request for a page in flight is cancelled when the context is done. The page and pagesize set in the
+// params are ignored.
+func (s *VLANService) StreamDedicatedGuestVlanRanges(ctx context.Context, p *ListDedicatedGuestVlanRangesParams) (<-chan *DedicatedGuestVlanRange, <-chan error) {
+	ch := make(chan *DedicatedGuestVlanRange)
+	errs := make(chan error, 1)
+
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesize(500)
+
+	go func() {
+		defer close(errs)
+		defer close(ch)
+
+		for page, count := 1, 0; ; page++ {
+			pp.SetPage(page)
+
+			l, err := s.ListDedicatedGuestVlanRangesWithContext(ctx, pp)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			for _, v := range l.DedicatedGuestVlanRanges {
+				select {
+				case ch <- v:
+				case <-ctx.Done():
+					errs <- ctx.Err()
+					return
+				}
+			}
+
+			count += len(l.DedicatedGuestVlanRanges)
+			if len(l.DedicatedGuestVlanRanges) < 500 || count >= l.Count {
+				return
+			}
+		}
+	}()
+
+	return ch, errs
+}
+
+// ListDedicatedGuestVlanRangesWhere returns all results of ListDedicatedGuestVlanRanges for which pred returns true. Note that the results are
+// filtered client-side, so all results matching the params are fetched first.
+// All pages are fetched, so the page and pagesize set in the params are ignored.
+func (s *VLANService) ListDedicatedGuestVlanRangesWhere(p *ListDedicatedGuestVlanRangesParams, pred func(*DedicatedGuestVlanRange) bool) ([]*DedicatedGuestVlanRange, error) {
+	var r []*DedicatedGuestVlanRange
+	ch, errs := s.StreamDedicatedGuestVlanRanges(context.Background(), p)
+	for v := range ch {
+		if pred(v) {
+			r = append(r, v)
+		}
+	}
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ListAllDedicatedGuestVlanRanges returns the results of ListDedicatedGuestVlanRanges across all pages, by calling ListDedicatedGuestVlanRanges page by page until all
+// results are fetched. The pagesize set in the params is used for every page (500 if not set), and the
+// page set in the params is ignored.
+func (s *VLANService) ListAllDedicatedGuestVlanRanges(p *ListDedicatedGuestVlanRangesParams) ([]*DedicatedGuestVlanRange, error) {
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesizeIfUnset(500)
+	pagesize := pp.p["pagesize"].(int)
+
+	var r []*DedicatedGuestVlanRange
+	for page := 1; ; page++ {
+		pp.SetPage(page)
+
+		l, err := s.ListDedicatedGuestVlanRanges(pp)
+		if err != nil {
+			return nil, err
+		}
+		r = append(r, l.DedicatedGuestVlanRanges...)
+
+		if len(l.DedicatedGuestVlanRanges) < pagesize || len(r) >= l.Count {
+			return r, nil
+		}
+	}
+}
+
 type ListDedicatedGuestVlanRangesResponse struct {
 	Count                    int                        `json:"count"`
 	DedicatedGuestVlanRanges []*DedicatedGuestVlanRange `json:"dedicatedguestvlanrange"`
@@ -648,6 +1273,16 @@ type ListVlanIpRangesParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ListVlanIpRangesParams) Clone() *ListVlanIpRangesParams {
+	c := &ListVlanIpRangesParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListVlanIpRangesParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -706,6 +1341,13 @@ func (p *ListVlanIpRangesParams) SetAccount(v string) {
 	return
 }
 
+// SetAccountIfUnset sets account to v, unless it is already set
+func (p *ListVlanIpRangesParams) SetAccountIfUnset(v string) {
+	if _, found := p.p["account"]; !found {
+		p.SetAccount(v)
+	}
+}
+
 func (p *ListVlanIpRangesParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -714,6 +1356,13 @@ func (p *ListVlanIpRangesParams) SetDomainid(v string) {
 	return
 }
 
+// SetDomainidIfUnset sets domainid to v, unless it is already set
+func (p *ListVlanIpRangesParams) SetDomainidIfUnset(v string) {
+	if _, found := p.p["domainid"]; !found {
+		p.SetDomainid(v)
+	}
+}
+
 func (p *ListVlanIpRangesParams) SetForvirtualnetwork(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -722,6 +1371,13 @@ func (p *ListVlanIpRangesParams) SetForvirtualnetwork(v bool) {
 	return
 }
 
+// SetForvirtualnetworkIfUnset sets forvirtualnetwork to v, unless it is already set
+func (p *ListVlanIpRangesParams) SetForvirtualnetworkIfUnset(v bool) {
+	if _, found := p.p["forvirtualnetwork"]; !found {
+		p.SetForvirtualnetwork(v)
+	}
+}
+
 func (p *ListVlanIpRangesParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -730,6 +1386,13 @@ func (p *ListVlanIpRangesParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *ListVlanIpRangesParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 func (p *ListVlanIpRangesParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -738,6 +1401,13 @@ func (p *ListVlanIpRangesParams) SetKeyword(v string) {
 	return
 }
 
+// SetKeywordIfUnset sets keyword to v, unless it is already set
+func (p *ListVlanIpRangesParams) SetKeywordIfUnset(v string) {
+	if _, found := p.p["keyword"]; !found {
+		p.SetKeyword(v)
+	}
+}
+
 func (p *ListVlanIpRangesParams) SetNetworkid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -746,6 +1416,13 @@ func (p *ListVlanIpRangesParams) SetNetworkid(v string) {
 	return
 }
 
+// SetNetworkidIfUnset sets networkid to v, unless it is already set
+func (p *ListVlanIpRangesParams) SetNetworkidIfUnset(v string) {
+	if _, found := p.p["networkid"]; !found {
+		p.SetNetworkid(v)
+	}
+}
+
 func (p *ListVlanIpRangesParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -754,6 +1431,13 @@ func (p *ListVlanIpRangesParams) SetPage(v int) {
 	return
 }
 
+// SetPageIfUnset sets page to v, unless it is already set
+func (p *ListVlanIpRangesParams) SetPageIfUnset(v int) {
+	if _, found := p.p["page"]; !found {
+		p.SetPage(v)
+	}
+}
+
 func (p *ListVlanIpRangesParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -762,6 +1446,13 @@ func (p *ListVlanIpRangesParams) SetPagesize(v int) {
 	return
 }
 
+// SetPagesizeIfUnset sets pagesize to v, unless it is already set
+func (p *ListVlanIpRangesParams) SetPagesizeIfUnset(v int) {
+	if _, found := p.p["pagesize"]; !found {
+		p.SetPagesize(v)
+	}
+}
+
 func (p *ListVlanIpRangesParams) SetPhysicalnetworkid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -770,6 +1461,13 @@ func (p *ListVlanIpRangesParams) SetPhysicalnetworkid(v string) {
 	return
 }
 
+// SetPhysicalnetworkidIfUnset sets physicalnetworkid to v, unless it is already set
+func (p *ListVlanIpRangesParams) SetPhysicalnetworkidIfUnset(v string) {
+	if _, found := p.p["physicalnetworkid"]; !found {
+		p.SetPhysicalnetworkid(v)
+	}
+}
+
 func (p *ListVlanIpRangesParams) SetPodid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -778,6 +1476,13 @@ func (p *ListVlanIpRangesParams) SetPodid(v string) {
 	return
 }
 
+// SetPodidIfUnset sets podid to v, unless it is already set
+func (p *ListVlanIpRangesParams) SetPodidIfUnset(v string) {
+	if _, found := p.p["podid"]; !found {
+		p.SetPodid(v)
+	}
+}
+
 func (p *ListVlanIpRangesParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -786,6 +1491,13 @@ func (p *ListVlanIpRangesParams) SetProjectid(v string) {
 	return
 }
 
+// SetProjectidIfUnset sets projectid to v, unless it is already set
+func (p *ListVlanIpRangesParams) SetProjectidIfUnset(v string) {
+	if _, found := p.p["projectid"]; !found {
+		p.SetProjectid(v)
+	}
+}
+
 func (p *ListVlanIpRangesParams) SetVlan(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -794,6 +1506,13 @@ func (p *ListVlanIpRangesParams) SetVlan(v string) {
 	return
 }
 
+// SetVlanIfUnset sets vlan to v, unless it is already set
+func (p *ListVlanIpRangesParams) SetVlanIfUnset(v string) {
+	if _, found := p.p["vlan"]; !found {
+		p.SetVlan(v)
+	}
+}
+
 func (p *ListVlanIpRangesParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -802,14 +1521,32 @@ func (p *ListVlanIpRangesParams) SetZoneid(v string) {
 	return
 }
 
+// SetZoneidIfUnset sets zoneid to v, unless it is already set
+func (p *ListVlanIpRangesParams) SetZoneidIfUnset(v string) {
+	if _, found := p.p["zoneid"]; !found {
+		p.SetZoneid(v)
+	}
+}
+
 // You should always use this function to get a new ListVlanIpRangesParams instance,
-// as then you are sure you have configured all required params
-func (s *VLANService) NewListVlanIpRangesParams() *ListVlanIpRangesParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *VLANService) NewListVlanIpRangesParams(opts ...func(*ListVlanIpRangesParams)) *ListVlanIpRangesParams {
 	p := &ListVlanIpRangesParams{}
 	p.p = make(map[string]interface{})
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
+func (p *ListVlanIpRangesParams) setFirstMatch() {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p[firstMatchKey] = true
+}
+
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *VLANService) GetVlanIpRangeByID(id string, opts ...OptionFunc) (*VlanIpRange, int, error) {
 	p := &ListVlanIpRangesParams{}
@@ -817,7 +1554,7 @@ func (s *VLANService) GetVlanIpRangeByID(id string, opts ...OptionFunc) (*VlanIp
 
 	p.p["id"] = id
 
-	for _, fn := range append(s.cs.options, opts...) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
 		if err := fn(s.cs, p); err != nil {
 			return nil, -1, err
 		}
@@ -828,24 +1565,56 @@ func (s *VLANService) GetVlanIpRangeByID(id string, opts ...OptionFunc) (*VlanIp
 		if strings.Contains(err.Error(), fmt.Sprintf(
 			"Invalid parameter id value=%s due to incorrect long value format, "+
 				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+			return nil, 0, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
 	}
 
 	if l.Count == 1 {
 		return l.VlanIpRanges[0], l.Count, nil
 	}
+
+	if _, ok := p.p[firstMatchKey]; ok && len(l.VlanIpRanges) > 0 {
+		return l.VlanIpRanges[0], l.Count, nil
+	}
 	return nil, l.Count, fmt.Errorf("There is more then one result for VlanIpRange UUID: %s!", id)
 }
 
+// VlanIpRangeExists returns true if a VlanIpRange with the given ID exists. When no match is found
+// it returns false, while any other error is returned as is.
+func (s *VLANService) VlanIpRangeExists(id string, opts ...OptionFunc) (bool, error) {
+	_, count, err := s.GetVlanIpRangeByID(id, opts...)
+	if errors.Is(err, ErrNotFound) {
+		return false, nil
+	}
+	if count > 0 {
+		return true, nil
+	}
+	return false, err
+}
+
 // Lists all VLAN IP ranges.
 func (s *VLANService) ListVlanIpRanges(p *ListVlanIpRangesParams) (*ListVlanIpRangesResponse, error) {
-	resp, err := s.cs.newRequest("listVlanIpRanges", p.toURLValues())
+	return s.ListVlanIpRangesWithContext(context.Background(), p)
+}
+
+// ListVlanIpRangesWithContext is the same as ListVlanIpRanges, but the request is cancelled when the context is done
+func (s *VLANService) ListVlanIpRangesWithContext(ctx context.Context, p *ListVlanIpRangesParams) (*ListVlanIpRangesResponse, error) {
+	return s.ListVlanIpRangesRawWithContext(ctx, p.toURLValues())
+}
+
+// ListVlanIpRangesRaw is the same as ListVlanIpRanges, but takes the params as url.Values instead of typed params
+func (s *VLANService) ListVlanIpRangesRaw(v url.Values) (*ListVlanIpRangesResponse, error) {
+	return s.ListVlanIpRangesRawWithContext(context.Background(), v)
+}
+
+// ListVlanIpRangesRawWithContext is the same as ListVlanIpRangesRaw, but the request is cancelled when the context is done
+func (s *VLANService) ListVlanIpRangesRawWithContext(ctx context.Context, v url.Values) (*ListVlanIpRangesResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "listVlanIpRanges", "listvlaniprangesresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -858,6 +1627,93 @@ func (s *VLANService) ListVlanIpRanges(p *ListVlanIpRangesParams) (*ListVlanIpRa
 	return &r, nil
 }
 
+// StreamVlanIpRanges fetches the results of ListVlanIpRanges page by page in the background and sends them on the
+// returned channel. Both returned channels are closed when done. If fetching a page fails, or the context
+// is done before all results are sent, the error is sent on the error channel and fetching stops. A
+// request for a page in flight is cancelled when the context is done. The page and pagesize set in the
+// params are ignored.
+func (s *VLANService) StreamVlanIpRanges(ctx context.Context, p *ListVlanIpRangesParams) (<-chan *VlanIpRange, <-chan error) {
+	ch := make(chan *VlanIpRange)
+	errs := make(chan error, 1)
+
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesize(500)
+
+	go func() {
+		defer close(errs)
+		defer close(ch)
+
+		for page, count := 1, 0; ; page++ {
+			pp.SetPage(page)
+
+			l, err := s.ListVlanIpRangesWithContext(ctx, pp)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			for _, v := range l.VlanIpRanges {
+				select {
+				case ch <- v:
+				case <-ctx.Done():
+					errs <- ctx.Err()
+					return
+				}
+			}
+
+			count += len(l.VlanIpRanges)
+			if len(l.VlanIpRanges) < 500 || count >= l.Count {
+				return
+			}
+		}
+	}()
+
+	return ch, errs
+}
+
+// ListVlanIpRangesWhere returns all results of ListVlanIpRanges for which pred returns true. Note that the results are
+// filtered client-side, so all results matching the params are fetched first.
+// All pages are fetched, so the page and pagesize set in the params are ignored.
+func (s *VLANService) ListVlanIpRangesWhere(p *ListVlanIpRangesParams, pred func(*VlanIpRange) bool) ([]*VlanIpRange, error) {
+	var r []*VlanIpRange
+	ch, errs := s.StreamVlanIpRanges(context.Background(), p)
+	for v := range ch {
+		if pred(v) {
+			r = append(r, v)
+		}
+	}
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ListAllVlanIpRanges returns the results of ListVlanIpRanges across all pages, by calling ListVlanIpRanges page by page until all
+// results are fetched. The pagesize set in the params is used for every page (500 if not set), and the
+// page set in the params is ignored.
+func (s *VLANService) ListAllVlanIpRanges(p *ListVlanIpRangesParams) ([]*VlanIpRange, error) {
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesizeIfUnset(500)
+	pagesize := pp.p["pagesize"].(int)
+
+	var r []*VlanIpRange
+	for page := 1; ; page++ {
+		pp.SetPage(page)
+
+		l, err := s.ListVlanIpRanges(pp)
+		if err != nil {
+			return nil, err
+		}
+		r = append(r, l.VlanIpRanges...)
+
+		if len(l.VlanIpRanges) < pagesize || len(r) >= l.Count {
+			return r, nil
+		}
+	}
+}
+
 type ListVlanIpRangesResponse struct {
 	Count        int            `json:"count"`
 	VlanIpRanges []*VlanIpRange `json:"vlaniprange"`
@@ -892,6 +1748,16 @@ type ReleaseDedicatedGuestVlanRangeParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ReleaseDedicatedGuestVlanRangeParams) Clone() *ReleaseDedicatedGuestVlanRangeParams {
+	c := &ReleaseDedicatedGuestVlanRangeParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ReleaseDedicatedGuestVlanRangeParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -903,6 +1769,16 @@ func (p *ReleaseDedicatedGuestVlanRangeParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *ReleaseDedicatedGuestVlanRangeParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of releaseDedicatedGuestVlanRange", k)
+		}
+	}
+	return nil
+}
+
 func (p *ReleaseDedicatedGuestVlanRangeParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -911,18 +1787,54 @@ func (p *ReleaseDedicatedGuestVlanRangeParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *ReleaseDedicatedGuestVlanRangeParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 // You should always use this function to get a new ReleaseDedicatedGuestVlanRangeParams instance,
-// as then you are sure you have configured all required params
-func (s *VLANService) NewReleaseDedicatedGuestVlanRangeParams(id string) *ReleaseDedicatedGuestVlanRangeParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *VLANService) NewReleaseDedicatedGuestVlanRangeParams(id string, opts ...func(*ReleaseDedicatedGuestVlanRangeParams)) *ReleaseDedicatedGuestVlanRangeParams {
 	p := &ReleaseDedicatedGuestVlanRangeParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Releases a dedicated guest vlan range to the system
-func (s *VLANService) ReleaseDedicatedGuestVlanRange(p *ReleaseDedicatedGuestVlanRangeParams) (*ReleaseDedicatedGuestVlanRangeResponse, error) {
-	resp, err := s.cs.newRequest("releaseDedicatedGuestVlanRange", p.toURLValues())
+func (s *VLANService) ReleaseDedicatedGuestVlanRange(p *ReleaseDedicatedGuestVlanRangeParams, opts ...OptionFunc) (*ReleaseDedicatedGuestVlanRangeResponse, error) {
+	return s.ReleaseDedicatedGuestVlanRangeWithContext(context.Background(), p, opts...)
+}
+
+// ReleaseDedicatedGuestVlanRangeWithContext is the same as ReleaseDedicatedGuestVlanRange, but the request is cancelled when the context is done
+func (s *VLANService) ReleaseDedicatedGuestVlanRangeWithContext(ctx context.Context, p *ReleaseDedicatedGuestVlanRangeParams, opts ...OptionFunc) (*ReleaseDedicatedGuestVlanRangeResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.ReleaseDedicatedGuestVlanRangeRawWithContext(ctx, p.toURLValues())
+}
+
+// ReleaseDedicatedGuestVlanRangeRaw is the same as ReleaseDedicatedGuestVlanRange, but takes the params as url.Values instead of typed params
+func (s *VLANService) ReleaseDedicatedGuestVlanRangeRaw(v url.Values) (*ReleaseDedicatedGuestVlanRangeResponse, error) {
+	return s.ReleaseDedicatedGuestVlanRangeRawWithContext(context.Background(), v)
+}
+
+// ReleaseDedicatedGuestVlanRangeRawWithContext is the same as ReleaseDedicatedGuestVlanRangeRaw, but the request is cancelled when the context is done
+func (s *VLANService) ReleaseDedicatedGuestVlanRangeRawWithContext(ctx context.Context, v url.Values) (*ReleaseDedicatedGuestVlanRangeResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "releaseDedicatedGuestVlanRange", "releasededicatedguestvlanrangeresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -934,9 +1846,9 @@ func (s *VLANService) ReleaseDedicatedGuestVlanRange(p *ReleaseDedicatedGuestVla
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -17,17 +17,30 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/url"
 	"strconv"
 	"strings"
+	"time"
 )
 
 type CreateInstanceGroupParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *CreateInstanceGroupParams) Clone() *CreateInstanceGroupParams {
+	c := &CreateInstanceGroupParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *CreateInstanceGroupParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -48,6 +61,16 @@ func (p *CreateInstanceGroupParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *CreateInstanceGroupParams) validate() error {
+	for _, k := range []string{"name"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of createInstanceGroup", k)
+		}
+	}
+	return nil
+}
+
 func (p *CreateInstanceGroupParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -56,6 +79,13 @@ func (p *CreateInstanceGroupParams) SetAccount(v string) {
 	return
 }
 
+// SetAccountIfUnset sets account to v, unless it is already set
+func (p *CreateInstanceGroupParams) SetAccountIfUnset(v string) {
+	if _, found := p.p["account"]; !found {
+		p.SetAccount(v)
+	}
+}
+
 func (p *CreateInstanceGroupParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -64,6 +94,13 @@ func (p *CreateInstanceGroupParams) SetDomainid(v string) {
 	return
 }
 
+// SetDomainidIfUnset sets domainid to v, unless it is already set
+func (p *CreateInstanceGroupParams) SetDomainidIfUnset(v string) {
+	if _, found := p.p["domainid"]; !found {
+		p.SetDomainid(v)
+	}
+}
+
 func (p *CreateInstanceGroupParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -72,6 +109,13 @@ func (p *CreateInstanceGroupParams) SetName(v string) {
 	return
 }
 
+// SetNameIfUnset sets name to v, unless it is already set
+func (p *CreateInstanceGroupParams) SetNameIfUnset(v string) {
+	if _, found := p.p["name"]; !found {
+		p.SetName(v)
+	}
+}
+
 func (p *CreateInstanceGroupParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -80,18 +124,54 @@ func (p *CreateInstanceGroupParams) SetProjectid(v string) {
 	return
 }
 
+// SetProjectidIfUnset sets projectid to v, unless it is already set
+func (p *CreateInstanceGroupParams) SetProjectidIfUnset(v string) {
+	if _, found := p.p["projectid"]; !found {
+		p.SetProjectid(v)
+	}
+}
+
 // You should always use this function to get a new CreateInstanceGroupParams instance,
-// as then you are sure you have configured all required params
-func (s *VMGroupService) NewCreateInstanceGroupParams(name string) *CreateInstanceGroupParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *VMGroupService) NewCreateInstanceGroupParams(name string, opts ...func(*CreateInstanceGroupParams)) *CreateInstanceGroupParams {
 	p := &CreateInstanceGroupParams{}
 	p.p = make(map[string]interface{})
 	p.p["name"] = name
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Creates a vm group
-func (s *VMGroupService) CreateInstanceGroup(p *CreateInstanceGroupParams) (*CreateInstanceGroupResponse, error) {
-	resp, err := s.cs.newRequest("createInstanceGroup", p.toURLValues())
+func (s *VMGroupService) CreateInstanceGroup(p *CreateInstanceGroupParams, opts ...OptionFunc) (*CreateInstanceGroupResponse, error) {
+	return s.CreateInstanceGroupWithContext(context.Background(), p, opts...)
+}
+
+// CreateInstanceGroupWithContext is the same as CreateInstanceGroup, but the request is cancelled when the context is done
+func (s *VMGroupService) CreateInstanceGroupWithContext(ctx context.Context, p *CreateInstanceGroupParams, opts ...OptionFunc) (*CreateInstanceGroupResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.CreateInstanceGroupRawWithContext(ctx, p.toURLValues())
+}
+
+// CreateInstanceGroupRaw is the same as CreateInstanceGroup, but takes the params as url.Values instead of typed params
+func (s *VMGroupService) CreateInstanceGroupRaw(v url.Values) (*CreateInstanceGroupResponse, error) {
+	return s.CreateInstanceGroupRawWithContext(context.Background(), v)
+}
+
+// CreateInstanceGroupRawWithContext is the same as CreateInstanceGroupRaw, but the request is cancelled when the context is done
+func (s *VMGroupService) CreateInstanceGroupRawWithContext(ctx context.Context, v url.Values) (*CreateInstanceGroupResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "createInstanceGroup", "createinstancegroupresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -119,6 +199,16 @@ type DeleteInstanceGroupParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *DeleteInstanceGroupParams) Clone() *DeleteInstanceGroupParams {
+	c := &DeleteInstanceGroupParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeleteInstanceGroupParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -130,6 +220,16 @@ func (p *DeleteInstanceGroupParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *DeleteInstanceGroupParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of deleteInstanceGroup", k)
+		}
+	}
+	return nil
+}
+
 func (p *DeleteInstanceGroupParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -138,18 +238,54 @@ func (p *DeleteInstanceGroupParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *DeleteInstanceGroupParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 // You should always use this function to get a new DeleteInstanceGroupParams instance,
-// as then you are sure you have configured all required params
-func (s *VMGroupService) NewDeleteInstanceGroupParams(id string) *DeleteInstanceGroupParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *VMGroupService) NewDeleteInstanceGroupParams(id string, opts ...func(*DeleteInstanceGroupParams)) *DeleteInstanceGroupParams {
 	p := &DeleteInstanceGroupParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Deletes a vm group
-func (s *VMGroupService) DeleteInstanceGroup(p *DeleteInstanceGroupParams) (*DeleteInstanceGroupResponse, error) {
-	resp, err := s.cs.newRequest("deleteInstanceGroup", p.toURLValues())
+func (s *VMGroupService) DeleteInstanceGroup(p *DeleteInstanceGroupParams, opts ...OptionFunc) (*DeleteInstanceGroupResponse, error) {
+	return s.DeleteInstanceGroupWithContext(context.Background(), p, opts...)
+}
+
+// DeleteInstanceGroupWithContext is the same as DeleteInstanceGroup, but the request is cancelled when the context is done
+func (s *VMGroupService) DeleteInstanceGroupWithContext(ctx context.Context, p *DeleteInstanceGroupParams, opts ...OptionFunc) (*DeleteInstanceGroupResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.DeleteInstanceGroupRawWithContext(ctx, p.toURLValues())
+}
+
+// DeleteInstanceGroupRaw is the same as DeleteInstanceGroup, but takes the params as url.Values instead of typed params
+func (s *VMGroupService) DeleteInstanceGroupRaw(v url.Values) (*DeleteInstanceGroupResponse, error) {
+	return s.DeleteInstanceGroupRawWithContext(context.Background(), v)
+}
+
+// DeleteInstanceGroupRawWithContext is the same as DeleteInstanceGroupRaw, but the request is cancelled when the context is done
+func (s *VMGroupService) DeleteInstanceGroupRawWithContext(ctx context.Context, v url.Values) (*DeleteInstanceGroupResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteInstanceGroup", "deleteinstancegroupresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -162,6 +298,34 @@ func (s *VMGroupService) DeleteInstanceGroup(p *DeleteInstanceGroupParams) (*Del
 	return &r, nil
 }
 
+// WaitForInstanceGroupDeleted polls until the InstanceGroup with the given ID no longer exists, the timeout
+// expires or the context is cancelled. Deleted resources may still be listed for a short while
+// after the delete command finished, so this can be used to make sure they are really gone. The
+// options are passed to GetInstanceGroupByID, so a resource outside of the default scope can be found.
+func (s *VMGroupService) WaitForInstanceGroupDeleted(ctx context.Context, id string, timeout time.Duration, opts ...OptionFunc) error {
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	for {
+		_, count, err := s.GetInstanceGroupByID(id, opts...)
+		if count == 0 {
+			return nil
+		}
+		if count < 0 {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(2 * time.Second):
+		}
+	}
+}
+
 type DeleteInstanceGroupResponse struct {
 	Displaytext string `json:"displaytext"`
 	Success     bool   `json:"success"`
@@ -190,6 +354,16 @@ type ListInstanceGroupsParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ListInstanceGroupsParams) Clone() *ListInstanceGroupsParams {
+	c := &ListInstanceGroupsParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListInstanceGroupsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -240,6 +414,13 @@ func (p *ListInstanceGroupsParams) SetAccount(v string) {
 	return
 }
 
+// SetAccountIfUnset sets account to v, unless it is already set
+func (p *ListInstanceGroupsParams) SetAccountIfUnset(v string) {
+	if _, found := p.p["account"]; !found {
+		p.SetAccount(v)
+	}
+}
+
 func (p *ListInstanceGroupsParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -248,6 +429,13 @@ func (p *ListInstanceGroupsParams) SetDomainid(v string) {
 	return
 }
 
+// SetDomainidIfUnset sets domainid to v, unless it is already set
+func (p *ListInstanceGroupsParams) SetDomainidIfUnset(v string) {
+	if _, found := p.p["domainid"]; !found {
+		p.SetDomainid(v)
+	}
+}
+
 func (p *ListInstanceGroupsParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -256,6 +444,13 @@ func (p *ListInstanceGroupsParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *ListInstanceGroupsParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 func (p *ListInstanceGroupsParams) SetIsrecursive(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -264,6 +459,13 @@ func (p *ListInstanceGroupsParams) SetIsrecursive(v bool) {
 	return
 }
 
+// SetIsrecursiveIfUnset sets isrecursive to v, unless it is already set
+func (p *ListInstanceGroupsParams) SetIsrecursiveIfUnset(v bool) {
+	if _, found := p.p["isrecursive"]; !found {
+		p.SetIsrecursive(v)
+	}
+}
+
 func (p *ListInstanceGroupsParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -272,6 +474,13 @@ func (p *ListInstanceGroupsParams) SetKeyword(v string) {
 	return
 }
 
+// SetKeywordIfUnset sets keyword to v, unless it is already set
+func (p *ListInstanceGroupsParams) SetKeywordIfUnset(v string) {
+	if _, found := p.p["keyword"]; !found {
+		p.SetKeyword(v)
+	}
+}
+
 func (p *ListInstanceGroupsParams) SetListall(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -280,6 +489,13 @@ func (p *ListInstanceGroupsParams) SetListall(v bool) {
 	return
 }
 
+// SetListallIfUnset sets listall to v, unless it is already set
+func (p *ListInstanceGroupsParams) SetListallIfUnset(v bool) {
+	if _, found := p.p["listall"]; !found {
+		p.SetListall(v)
+	}
+}
+
 func (p *ListInstanceGroupsParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -288,6 +504,13 @@ func (p *ListInstanceGroupsParams) SetName(v string) {
 	return
 }
 
+// SetNameIfUnset sets name to v, unless it is already set
+func (p *ListInstanceGroupsParams) SetNameIfUnset(v string) {
+	if _, found := p.p["name"]; !found {
+		p.SetName(v)
+	}
+}
+
 func (p *ListInstanceGroupsParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -296,6 +519,13 @@ func (p *ListInstanceGroupsParams) SetPage(v int) {
 	return
 }
 
+// SetPageIfUnset sets page to v, unless it is already set
+func (p *ListInstanceGroupsParams) SetPageIfUnset(v int) {
+	if _, found := p.p["page"]; !found {
+		p.SetPage(v)
+	}
+}
+
 func (p *ListInstanceGroupsParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -304,6 +534,13 @@ func (p *ListInstanceGroupsParams) SetPagesize(v int) {
 	return
 }
 
+// SetPagesizeIfUnset sets pagesize to v, unless it is already set
+func (p *ListInstanceGroupsParams) SetPagesizeIfUnset(v int) {
+	if _, found := p.p["pagesize"]; !found {
+		p.SetPagesize(v)
+	}
+}
+
 func (p *ListInstanceGroupsParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -312,14 +549,34 @@ func (p *ListInstanceGroupsParams) SetProjectid(v string) {
 	return
 }
 
+// SetProjectidIfUnset sets projectid to v, unless it is already set
+func (p *ListInstanceGroupsParams) SetProjectidIfUnset(v string) {
+	if _, found := p.p["projectid"]; !found {
+		p.SetProjectid(v)
+	}
+}
+
+var _ ListAllSetter = (*ListInstanceGroupsParams)(nil)
+
 // You should always use this function to get a new ListInstanceGroupsParams instance,
-// as then you are sure you have configured all required params
-func (s *VMGroupService) NewListInstanceGroupsParams() *ListInstanceGroupsParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *VMGroupService) NewListInstanceGroupsParams(opts ...func(*ListInstanceGroupsParams)) *ListInstanceGroupsParams {
 	p := &ListInstanceGroupsParams{}
 	p.p = make(map[string]interface{})
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
+func (p *ListInstanceGroupsParams) setFirstMatch() {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p[firstMatchKey] = true
+}
+
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *VMGroupService) GetInstanceGroupID(name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListInstanceGroupsParams{}
@@ -327,7 +584,7 @@ func (s *VMGroupService) GetInstanceGroupID(name string, opts ...OptionFunc) (st
 
 	p.p["name"] = name
 
-	for _, fn := range append(s.cs.options, opts...) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
 		if err := fn(s.cs, p); err != nil {
 			return "", -1, err
 		}
@@ -339,7 +596,7 @@ func (s *VMGroupService) GetInstanceGroupID(name string, opts ...OptionFunc) (st
 	}
 
 	if l.Count == 0 {
-		return "", l.Count, fmt.Errorf("No match found for %s: %+v", name, l)
+		return "", l.Count, fmt.Errorf("%w for %s: %+v", ErrNotFound, name, l)
 	}
 
 	if l.Count == 1 {
@@ -352,6 +609,10 @@ func (s *VMGroupService) GetInstanceGroupID(name string, opts ...OptionFunc) (st
 				return v.Id, l.Count, nil
 			}
 		}
+
+		if _, ok := p.p[firstMatchKey]; ok && len(l.InstanceGroups) > 0 {
+			return l.InstanceGroups[0].Id, l.Count, nil
+		}
 	}
 	return "", l.Count, fmt.Errorf("Could not find an exact match for %s: %+v", name, l)
 }
@@ -377,7 +638,7 @@ func (s *VMGroupService) GetInstanceGroupByID(id string, opts ...OptionFunc) (*I
 
 	p.p["id"] = id
 
-	for _, fn := range append(s.cs.options, opts...) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
 		if err := fn(s.cs, p); err != nil {
 			return nil, -1, err
 		}
@@ -388,24 +649,56 @@ func (s *VMGroupService) GetInstanceGroupByID(id string, opts ...OptionFunc) (*I
 		if strings.Contains(err.Error(), fmt.Sprintf(
 			"Invalid parameter id value=%s due to incorrect long value format, "+
 				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+			return nil, 0, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
 	}
 
 	if l.Count == 1 {
 		return l.InstanceGroups[0], l.Count, nil
 	}
+
+	if _, ok := p.p[firstMatchKey]; ok && len(l.InstanceGroups) > 0 {
+		return l.InstanceGroups[0], l.Count, nil
+	}
 	return nil, l.Count, fmt.Errorf("There is more then one result for InstanceGroup UUID: %s!", id)
 }
 
+// InstanceGroupExists returns true if a InstanceGroup with the given ID exists. When no match is found
+// it returns false, while any other error is returned as is.
+func (s *VMGroupService) InstanceGroupExists(id string, opts ...OptionFunc) (bool, error) {
+	_, count, err := s.GetInstanceGroupByID(id, opts...)
+	if errors.Is(err, ErrNotFound) {
+		return false, nil
+	}
+	if count > 0 {
+		return true, nil
+	}
+	return false, err
+}
+
 // Lists vm groups
 func (s *VMGroupService) ListInstanceGroups(p *ListInstanceGroupsParams) (*ListInstanceGroupsResponse, error) {
-	resp, err := s.cs.newRequest("listInstanceGroups", p.toURLValues())
+	return s.ListInstanceGroupsWithContext(context.Background(), p)
+}
+
+// ListInstanceGroupsWithContext is the same as ListInstanceGroups, but the request is cancelled when the context is done
+func (s *VMGroupService) ListInstanceGroupsWithContext(ctx context.Context, p *ListInstanceGroupsParams) (*ListInstanceGroupsResponse, error) {
+	return s.ListInstanceGroupsRawWithContext(ctx, p.toURLValues())
+}
+
+// ListInstanceGroupsRaw is the same as ListInstanceGroups, but takes the params as url.Values instead of typed params
+func (s *VMGroupService) ListInstanceGroupsRaw(v url.Values) (*ListInstanceGroupsResponse, error) {
+	return s.ListInstanceGroupsRawWithContext(context.Background(), v)
+}
+
+// ListInstanceGroupsRawWithContext is the same as ListInstanceGroupsRaw, but the request is cancelled when the context is done
+func (s *VMGroupService) ListInstanceGroupsRawWithContext(ctx context.Context, v url.Values) (*ListInstanceGroupsResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "listInstanceGroups", "listinstancegroupsresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -418,6 +711,93 @@ func (s *VMGroupService) ListInstanceGroups(p *ListInstanceGroupsParams) (*ListI
 	return &r, nil
 }
 
+// StreamInstanceGroups fetches the results of ListInstanceGroups page by page in the background and sends them on the
+// returned channel. Both returned channels are closed when done. If fetching a page fails, or the context
+// is done before all results are sent, the error is sent on the error channel and fetching stops. A
+// request for a page in flight is cancelled when the context is done. The page and pagesize set in the
+// params are ignored.
+func (s *VMGroupService) StreamInstanceGroups(ctx context.Context, p *ListInstanceGroupsParams) (<-chan *InstanceGroup, <-chan error) {
+	ch := make(chan *InstanceGroup)
+	errs := make(chan error, 1)
+
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesize(500)
+
+	go func() {
+		defer close(errs)
+		defer close(ch)
+
+		for page, count := 1, 0; ; page++ {
+			pp.SetPage(page)
+
+			l, err := s.ListInstanceGroupsWithContext(ctx, pp)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			for _, v := range l.InstanceGroups {
+				select {
+				case ch <- v:
+				case <-ctx.Done():
+					errs <- ctx.Err()
+					return
+				}
+			}
+
+			count += len(l.InstanceGroups)
+			if len(l.InstanceGroups) < 500 || count >= l.Count {
+				return
+			}
+		}
+	}()
+
+	return ch, errs
+}
+
+// ListInstanceGroupsWhere returns all results of ListInstanceGroups for which pred returns true. Note that the results are
+// filtered client-side, so all results matching the params are fetched first.
+// All pages are fetched, so the page and pagesize set in the params are ignored.
+func (s *VMGroupService) ListInstanceGroupsWhere(p *ListInstanceGroupsParams, pred func(*InstanceGroup) bool) ([]*InstanceGroup, error) {
+	var r []*InstanceGroup
+	ch, errs := s.StreamInstanceGroups(context.Background(), p)
+	for v := range ch {
+		if pred(v) {
+			r = append(r, v)
+		}
+	}
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ListAllInstanceGroups returns the results of ListInstanceGroups across all pages, by calling ListInstanceGroups page by page until all
+// results are fetched. The pagesize set in the params is used for every page (500 if not set), and the
+// page set in the params is ignored.
+func (s *VMGroupService) ListAllInstanceGroups(p *ListInstanceGroupsParams) ([]*InstanceGroup, error) {
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesizeIfUnset(500)
+	pagesize := pp.p["pagesize"].(int)
+
+	var r []*InstanceGroup
+	for page := 1; ; page++ {
+		pp.SetPage(page)
+
+		l, err := s.ListInstanceGroups(pp)
+		if err != nil {
+			return nil, err
+		}
+		r = append(r, l.InstanceGroups...)
+
+		if len(l.InstanceGroups) < pagesize || len(r) >= l.Count {
+			return r, nil
+		}
+	}
+}
+
 type ListInstanceGroupsResponse struct {
 	Count          int              `json:"count"`
 	InstanceGroups []*InstanceGroup `json:"instancegroup"`
@@ -438,6 +818,16 @@ type UpdateInstanceGroupParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *UpdateInstanceGroupParams) Clone() *UpdateInstanceGroupParams {
+	c := &UpdateInstanceGroupParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *UpdateInstanceGroupParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -452,6 +842,16 @@ func (p *UpdateInstanceGroupParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *UpdateInstanceGroupParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of updateInstanceGroup", k)
+		}
+	}
+	return nil
+}
+
 func (p *UpdateInstanceGroupParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -460,6 +860,13 @@ func (p *UpdateInstanceGroupParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *UpdateInstanceGroupParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 func (p *UpdateInstanceGroupParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -468,18 +875,54 @@ func (p *UpdateInstanceGroupParams) SetName(v string) {
 	return
 }
 
+// SetNameIfUnset sets name to v, unless it is already set
+func (p *UpdateInstanceGroupParams) SetNameIfUnset(v string) {
+	if _, found := p.p["name"]; !found {
+		p.SetName(v)
+	}
+}
+
 // You should always use this function to get a new UpdateInstanceGroupParams instance,
-// as then you are sure you have configured all required params
-func (s *VMGroupService) NewUpdateInstanceGroupParams(id string) *UpdateInstanceGroupParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *VMGroupService) NewUpdateInstanceGroupParams(id string, opts ...func(*UpdateInstanceGroupParams)) *UpdateInstanceGroupParams {
 	p := &UpdateInstanceGroupParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Updates a vm group
-func (s *VMGroupService) UpdateInstanceGroup(p *UpdateInstanceGroupParams) (*UpdateInstanceGroupResponse, error) {
-	resp, err := s.cs.newRequest("updateInstanceGroup", p.toURLValues())
+func (s *VMGroupService) UpdateInstanceGroup(p *UpdateInstanceGroupParams, opts ...OptionFunc) (*UpdateInstanceGroupResponse, error) {
+	return s.UpdateInstanceGroupWithContext(context.Background(), p, opts...)
+}
+
+// UpdateInstanceGroupWithContext is the same as UpdateInstanceGroup, but the request is cancelled when the context is done
+func (s *VMGroupService) UpdateInstanceGroupWithContext(ctx context.Context, p *UpdateInstanceGroupParams, opts ...OptionFunc) (*UpdateInstanceGroupResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.UpdateInstanceGroupRawWithContext(ctx, p.toURLValues())
+}
+
+// UpdateInstanceGroupRaw is the same as UpdateInstanceGroup, but takes the params as url.Values instead of typed params
+func (s *VMGroupService) UpdateInstanceGroupRaw(v url.Values) (*UpdateInstanceGroupResponse, error) {
+	return s.UpdateInstanceGroupRawWithContext(context.Background(), v)
+}
+
+// UpdateInstanceGroupRawWithContext is the same as UpdateInstanceGroupRaw, but the request is cancelled when the context is done
+func (s *VMGroupService) UpdateInstanceGroupRawWithContext(ctx context.Context, v url.Values) (*UpdateInstanceGroupResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "updateInstanceGroup", "updateinstancegroupresponse", v)
 	if err != nil {
 		return nil, err
 	}
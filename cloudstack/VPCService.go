@@ -17,17 +17,224 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/url"
 	"strconv"
 	"strings"
+	"time"
 )
 
+// VPCTierSpec describes a VPC with a single network tier, as created by CreateVPCWithTier
+type VPCTierSpec struct {
+	ZoneID        string
+	VPCName       string // Used as the name and display text of the VPC
+	VPCCIDR       string
+	VPCOfferingID string
+
+	TierName          string // Used as the name and display text of the tier
+	TierGateway       string
+	TierNetmask       string
+	NetworkOfferingID string
+
+	// When ACL rules are given, a new ACL list named after the tier is created with these rules and
+	// used for the tier. Otherwise the tier uses the default ACL list of the network offering.
+	ACLRules []*CreateNetworkACLParams
+}
+
+// CreateVPCWithTier creates a VPC, an ACL list with the given rules (if any) and a network tier in
+// the VPC using that ACL list, waiting for every async job to finish. The options are applied to
+// the params of every command that supports them.
+//
+// If any step fails, the rollback is best-effort: the already created ACL list and VPC are deleted
+// in reverse order, but when the rollback also fails, the error describes which resources may be
+// left behind and the caller has to clean them up. When everything was created but getting the VPC or
+// network tier fails, nothing is rolled back and the error contains the IDs of both.
+func (s *VPCService) CreateVPCWithTier(spec VPCTierSpec, opts ...OptionFunc) (*VPC, *Network, error) {
+	var rollback []func() error
+	fail := func(err error) (*VPC, *Network, error) {
+		for i := len(rollback) - 1; i >= 0; i-- {
+			if rerr := rollback[i](); rerr != nil {
+				err = fmt.Errorf("%v (rollback failed: %v)", err, rerr)
+			}
+		}
+		return nil, nil, err
+	}
+	apply := func(p interface{}) error {
+		for _, fn := range s.cs.withDefaultOptions(opts) {
+			if err := fn(s.cs, p); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	vp := s.NewCreateVPCParams(spec.VPCCIDR, spec.VPCName, spec.VPCName, spec.VPCOfferingID, spec.ZoneID)
+	if err := apply(vp); err != nil {
+		return nil, nil, err
+	}
+	vr, err := s.CreateVPC(vp)
+	if err == nil {
+		err = s.waitForJob(vr.JobID)
+	}
+	if err != nil {
+		return fail(fmt.Errorf("Failed to create VPC %s: %v", spec.VPCName, err))
+	}
+	rollback = append(rollback, func() error {
+		r, err := s.DeleteVPC(s.NewDeleteVPCParams(vr.Id))
+		if err == nil {
+			err = s.waitForJob(r.JobID)
+		}
+		if err != nil {
+			return fmt.Errorf("VPC %s may be left behind: %v", vr.Id, err)
+		}
+		return nil
+	})
+
+	var aclid string
+	if len(spec.ACLRules) > 0 {
+		ap := s.cs.NetworkACL.NewCreateNetworkACLListParams(spec.TierName, vr.Id)
+		if err := apply(ap); err != nil {
+			return fail(err)
+		}
+		ar, err := s.cs.NetworkACL.CreateNetworkACLList(ap)
+		if err == nil {
+			err = s.waitForJob(ar.JobID)
+		}
+		if err != nil {
+			return fail(fmt.Errorf("Failed to create ACL list %s: %v", spec.TierName, err))
+		}
+		aclid = ar.Id
+		rollback = append(rollback, func() error {
+			r, err := s.cs.NetworkACL.DeleteNetworkACLList(s.cs.NetworkACL.NewDeleteNetworkACLListParams(aclid))
+			if err == nil {
+				err = s.waitForJob(r.JobID)
+			}
+			if err != nil {
+				return fmt.Errorf("ACL list %s may be left behind: %v", aclid, err)
+			}
+			return nil
+		})
+
+		for _, rule := range spec.ACLRules {
+			// Set the ACL list on a copy, so the params of the caller are not changed
+			rp := rule.Clone()
+			rp.SetAclid(aclid)
+			rr, err := s.cs.NetworkACL.CreateNetworkACL(rp)
+			if err == nil {
+				err = s.waitForJob(rr.JobID)
+			}
+			if err != nil {
+				return fail(fmt.Errorf("Failed to create ACL rule in ACL list %s: %v", spec.TierName, err))
+			}
+		}
+	}
+
+	np := s.cs.Network.NewCreateNetworkParams(spec.TierName, spec.TierName, spec.NetworkOfferingID, spec.ZoneID)
+	np.SetVpcid(vr.Id)
+	np.SetGateway(spec.TierGateway)
+	np.SetNetmask(spec.TierNetmask)
+	if aclid != "" {
+		np.SetAclid(aclid)
+	}
+	if err := apply(np); err != nil {
+		return fail(err)
+	}
+	nr, err := s.cs.Network.CreateNetwork(np)
+	if err != nil {
+		return fail(fmt.Errorf("Failed to create network tier %s: %v", spec.TierName, err))
+	}
+
+	// Everything was created, so report the created resources instead of rolling back
+	vpc, _, err := s.GetVPCByID(vr.Id, opts...)
+	if err != nil {
+		return nil, nil, fmt.Errorf("Created VPC %s with network tier %s, but failed to get the VPC: %w", vr.Id, nr.Id, err)
+	}
+	network, _, err := s.cs.Network.GetNetworkByID(nr.Id, opts...)
+	if err != nil {
+		return nil, nil, fmt.Errorf("Created VPC %s with network tier %s, but failed to get the network tier: %w", vr.Id, nr.Id, err)
+	}
+
+	return vpc, network, nil
+}
+
+func (s *VPCService) waitForJob(jobid string) error {
+	// An async client already waited for the job to finish
+	if s.cs.async {
+		return nil
+	}
+	_, err := s.cs.GetAsyncJobResult(jobid, s.cs.timeout)
+	return err
+}
+
+// UpdateMany runs UpdateVPC for all given IDs in parallel, using at most concurrency concurrent requests.
+// For each ID the params are populated with the ID and then passed to mutate, which should set the
+// changes to apply. The result contains the updated resources (in the order of the IDs) and the error
+// of every update that failed by ID. The returned error contains all failures, or is nil if none failed.
+// The updated resources are returned as the UpdateVPCResponse of every update, which contains the same
+// fields as the resource itself, so no additional request is needed per resource to get them. The
+// options are used for every update.
+func (s *VPCService) UpdateMany(ids []string, mutate func(*UpdateVPCParams), concurrency int, opts ...OptionFunc) (BulkResult[*UpdateVPCResponse], error) {
+	rs := make([]*UpdateVPCResponse, len(ids))
+	errs := make([]error, len(ids))
+
+	runConcurrently(len(ids), concurrency, func(i int) {
+		p := &UpdateVPCParams{p: map[string]interface{}{"id": ids[i]}}
+		if mutate != nil {
+			mutate(p)
+		}
+
+		r, err := s.UpdateVPC(p, opts...)
+		if err != nil {
+			errs[i] = err
+			return
+		}
+
+		// An async client already waited for the job to finish
+		if !s.cs.async {
+			b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+			if err == nil {
+				b, err = getRawValue(b)
+			}
+			if err == nil {
+				err = json.Unmarshal(b, r)
+			}
+			if err != nil {
+				errs[i] = err
+				return
+			}
+		}
+		rs[i] = r
+	})
+
+	var r BulkResult[*UpdateVPCResponse]
+	for i, id := range ids {
+		if errs[i] != nil {
+			r.fail(id, errs[i])
+			continue
+		}
+		r.Succeeded = append(r.Succeeded, rs[i])
+	}
+
+	return r, r.Err()
+}
+
 type CreatePrivateGatewayParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *CreatePrivateGatewayParams) Clone() *CreatePrivateGatewayParams {
+	c := &CreatePrivateGatewayParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *CreatePrivateGatewayParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -64,6 +271,16 @@ func (p *CreatePrivateGatewayParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *CreatePrivateGatewayParams) validate() error {
+	for _, k := range []string{"gateway", "ipaddress", "netmask", "vlan", "vpcid"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of createPrivateGateway", k)
+		}
+	}
+	return nil
+}
+
 func (p *CreatePrivateGatewayParams) SetAclid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -72,6 +289,13 @@ func (p *CreatePrivateGatewayParams) SetAclid(v string) {
 	return
 }
 
+// SetAclidIfUnset sets aclid to v, unless it is already set
+func (p *CreatePrivateGatewayParams) SetAclidIfUnset(v string) {
+	if _, found := p.p["aclid"]; !found {
+		p.SetAclid(v)
+	}
+}
+
 func (p *CreatePrivateGatewayParams) SetGateway(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -80,6 +304,13 @@ func (p *CreatePrivateGatewayParams) SetGateway(v string) {
 	return
 }
 
+// SetGatewayIfUnset sets gateway to v, unless it is already set
+func (p *CreatePrivateGatewayParams) SetGatewayIfUnset(v string) {
+	if _, found := p.p["gateway"]; !found {
+		p.SetGateway(v)
+	}
+}
+
 func (p *CreatePrivateGatewayParams) SetIpaddress(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -88,6 +319,13 @@ func (p *CreatePrivateGatewayParams) SetIpaddress(v string) {
 	return
 }
 
+// SetIpaddressIfUnset sets ipaddress to v, unless it is already set
+func (p *CreatePrivateGatewayParams) SetIpaddressIfUnset(v string) {
+	if _, found := p.p["ipaddress"]; !found {
+		p.SetIpaddress(v)
+	}
+}
+
 func (p *CreatePrivateGatewayParams) SetNetmask(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -96,6 +334,13 @@ func (p *CreatePrivateGatewayParams) SetNetmask(v string) {
 	return
 }
 
+// SetNetmaskIfUnset sets netmask to v, unless it is already set
+func (p *CreatePrivateGatewayParams) SetNetmaskIfUnset(v string) {
+	if _, found := p.p["netmask"]; !found {
+		p.SetNetmask(v)
+	}
+}
+
 func (p *CreatePrivateGatewayParams) SetNetworkofferingid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -104,6 +349,13 @@ func (p *CreatePrivateGatewayParams) SetNetworkofferingid(v string) {
 	return
 }
 
+// SetNetworkofferingidIfUnset sets networkofferingid to v, unless it is already set
+func (p *CreatePrivateGatewayParams) SetNetworkofferingidIfUnset(v string) {
+	if _, found := p.p["networkofferingid"]; !found {
+		p.SetNetworkofferingid(v)
+	}
+}
+
 func (p *CreatePrivateGatewayParams) SetPhysicalnetworkid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -112,6 +364,13 @@ func (p *CreatePrivateGatewayParams) SetPhysicalnetworkid(v string) {
 	return
 }
 
+// SetPhysicalnetworkidIfUnset sets physicalnetworkid to v, unless it is already set
+func (p *CreatePrivateGatewayParams) SetPhysicalnetworkidIfUnset(v string) {
+	if _, found := p.p["physicalnetworkid"]; !found {
+		p.SetPhysicalnetworkid(v)
+	}
+}
+
 func (p *CreatePrivateGatewayParams) SetSourcenatsupported(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -120,6 +379,13 @@ func (p *CreatePrivateGatewayParams) SetSourcenatsupported(v bool) {
 	return
 }
 
+// SetSourcenatsupportedIfUnset sets sourcenatsupported to v, unless it is already set
+func (p *CreatePrivateGatewayParams) SetSourcenatsupportedIfUnset(v bool) {
+	if _, found := p.p["sourcenatsupported"]; !found {
+		p.SetSourcenatsupported(v)
+	}
+}
+
 func (p *CreatePrivateGatewayParams) SetVlan(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -128,6 +394,13 @@ func (p *CreatePrivateGatewayParams) SetVlan(v string) {
 	return
 }
 
+// SetVlanIfUnset sets vlan to v, unless it is already set
+func (p *CreatePrivateGatewayParams) SetVlanIfUnset(v string) {
+	if _, found := p.p["vlan"]; !found {
+		p.SetVlan(v)
+	}
+}
+
 func (p *CreatePrivateGatewayParams) SetVpcid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -136,9 +409,17 @@ func (p *CreatePrivateGatewayParams) SetVpcid(v string) {
 	return
 }
 
+// SetVpcidIfUnset sets vpcid to v, unless it is already set
+func (p *CreatePrivateGatewayParams) SetVpcidIfUnset(v string) {
+	if _, found := p.p["vpcid"]; !found {
+		p.SetVpcid(v)
+	}
+}
+
 // You should always use this function to get a new CreatePrivateGatewayParams instance,
-// as then you are sure you have configured all required params
-func (s *VPCService) NewCreatePrivateGatewayParams(gateway string, ipaddress string, netmask string, vlan string, vpcid string) *CreatePrivateGatewayParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *VPCService) NewCreatePrivateGatewayParams(gateway string, ipaddress string, netmask string, vlan string, vpcid string, opts ...func(*CreatePrivateGatewayParams)) *CreatePrivateGatewayParams {
 	p := &CreatePrivateGatewayParams{}
 	p.p = make(map[string]interface{})
 	p.p["gateway"] = gateway
@@ -146,12 +427,73 @@ func (s *VPCService) NewCreatePrivateGatewayParams(gateway string, ipaddress str
 	p.p["netmask"] = netmask
 	p.p["vlan"] = vlan
 	p.p["vpcid"] = vpcid
+	for _, fn := range opts {
+		fn(p)
+	}
+	return p
+}
+
+// CreatePrivateGatewayOpts contains all params that can be set on a CreatePrivateGatewayParams.
+// Optional params are pointers (or slices and maps) which are only set when not nil.
+type CreatePrivateGatewayOpts struct {
+	Aclid              *string
+	Gateway            string
+	Ipaddress          string
+	Netmask            string
+	Networkofferingid  *string
+	Physicalnetworkid  *string
+	Sourcenatsupported *bool
+	Vlan               string
+	Vpcid              string
+}
+
+// NewCreatePrivateGatewayParamsFromOpts is an alternative for NewCreatePrivateGatewayParams which takes all params using
+// an options struct, as that is easier to read for APIs with many required params
+func (s *VPCService) NewCreatePrivateGatewayParamsFromOpts(o CreatePrivateGatewayOpts) *CreatePrivateGatewayParams {
+	p := s.NewCreatePrivateGatewayParams(o.Gateway, o.Ipaddress, o.Netmask, o.Vlan, o.Vpcid)
+	if o.Aclid != nil {
+		p.p["aclid"] = *o.Aclid
+	}
+	if o.Networkofferingid != nil {
+		p.p["networkofferingid"] = *o.Networkofferingid
+	}
+	if o.Physicalnetworkid != nil {
+		p.p["physicalnetworkid"] = *o.Physicalnetworkid
+	}
+	if o.Sourcenatsupported != nil {
+		p.p["sourcenatsupported"] = *o.Sourcenatsupported
+	}
 	return p
 }
 
 // Creates a private gateway
-func (s *VPCService) CreatePrivateGateway(p *CreatePrivateGatewayParams) (*CreatePrivateGatewayResponse, error) {
-	resp, err := s.cs.newRequest("createPrivateGateway", p.toURLValues())
+func (s *VPCService) CreatePrivateGateway(p *CreatePrivateGatewayParams, opts ...OptionFunc) (*CreatePrivateGatewayResponse, error) {
+	return s.CreatePrivateGatewayWithContext(context.Background(), p, opts...)
+}
+
+// CreatePrivateGatewayWithContext is the same as CreatePrivateGateway, but the request is cancelled when the context is done
+func (s *VPCService) CreatePrivateGatewayWithContext(ctx context.Context, p *CreatePrivateGatewayParams, opts ...OptionFunc) (*CreatePrivateGatewayResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.CreatePrivateGatewayRawWithContext(ctx, p.toURLValues())
+}
+
+// CreatePrivateGatewayRaw is the same as CreatePrivateGateway, but takes the params as url.Values instead of typed params
+func (s *VPCService) CreatePrivateGatewayRaw(v url.Values) (*CreatePrivateGatewayResponse, error) {
+	return s.CreatePrivateGatewayRawWithContext(context.Background(), v)
+}
+
+// CreatePrivateGatewayRawWithContext is the same as CreatePrivateGatewayRaw, but the request is cancelled when the context is done
+func (s *VPCService) CreatePrivateGatewayRawWithContext(ctx context.Context, v url.Values) (*CreatePrivateGatewayResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "createPrivateGateway", "createprivategatewayresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -163,9 +505,9 @@ func (s *VPCService) CreatePrivateGateway(p *CreatePrivateGatewayParams) (*Creat
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -209,6 +551,16 @@ type CreateStaticRouteParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *CreateStaticRouteParams) Clone() *CreateStaticRouteParams {
+	c := &CreateStaticRouteParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *CreateStaticRouteParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -223,6 +575,16 @@ func (p *CreateStaticRouteParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *CreateStaticRouteParams) validate() error {
+	for _, k := range []string{"cidr", "gatewayid"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of createStaticRoute", k)
+		}
+	}
+	return nil
+}
+
 func (p *CreateStaticRouteParams) SetCidr(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -231,6 +593,13 @@ func (p *CreateStaticRouteParams) SetCidr(v string) {
 	return
 }
 
+// SetCidrIfUnset sets cidr to v, unless it is already set
+func (p *CreateStaticRouteParams) SetCidrIfUnset(v string) {
+	if _, found := p.p["cidr"]; !found {
+		p.SetCidr(v)
+	}
+}
+
 func (p *CreateStaticRouteParams) SetGatewayid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -239,19 +608,55 @@ func (p *CreateStaticRouteParams) SetGatewayid(v string) {
 	return
 }
 
+// SetGatewayidIfUnset sets gatewayid to v, unless it is already set
+func (p *CreateStaticRouteParams) SetGatewayidIfUnset(v string) {
+	if _, found := p.p["gatewayid"]; !found {
+		p.SetGatewayid(v)
+	}
+}
+
 // You should always use this function to get a new CreateStaticRouteParams instance,
-// as then you are sure you have configured all required params
-func (s *VPCService) NewCreateStaticRouteParams(cidr string, gatewayid string) *CreateStaticRouteParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *VPCService) NewCreateStaticRouteParams(cidr string, gatewayid string, opts ...func(*CreateStaticRouteParams)) *CreateStaticRouteParams {
 	p := &CreateStaticRouteParams{}
 	p.p = make(map[string]interface{})
 	p.p["cidr"] = cidr
 	p.p["gatewayid"] = gatewayid
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Creates a static route
-func (s *VPCService) CreateStaticRoute(p *CreateStaticRouteParams) (*CreateStaticRouteResponse, error) {
-	resp, err := s.cs.newRequest("createStaticRoute", p.toURLValues())
+func (s *VPCService) CreateStaticRoute(p *CreateStaticRouteParams, opts ...OptionFunc) (*CreateStaticRouteResponse, error) {
+	return s.CreateStaticRouteWithContext(context.Background(), p, opts...)
+}
+
+// CreateStaticRouteWithContext is the same as CreateStaticRoute, but the request is cancelled when the context is done
+func (s *VPCService) CreateStaticRouteWithContext(ctx context.Context, p *CreateStaticRouteParams, opts ...OptionFunc) (*CreateStaticRouteResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.CreateStaticRouteRawWithContext(ctx, p.toURLValues())
+}
+
+// CreateStaticRouteRaw is the same as CreateStaticRoute, but takes the params as url.Values instead of typed params
+func (s *VPCService) CreateStaticRouteRaw(v url.Values) (*CreateStaticRouteResponse, error) {
+	return s.CreateStaticRouteRawWithContext(context.Background(), v)
+}
+
+// CreateStaticRouteRawWithContext is the same as CreateStaticRouteRaw, but the request is cancelled when the context is done
+func (s *VPCService) CreateStaticRouteRawWithContext(ctx context.Context, v url.Values) (*CreateStaticRouteResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "createStaticRoute", "createstaticrouteresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -263,9 +668,9 @@ func (s *VPCService) CreateStaticRoute(p *CreateStaticRouteParams) (*CreateStati
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -314,6 +719,16 @@ type CreateVPCParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *CreateVPCParams) Clone() *CreateVPCParams {
+	c := &CreateVPCParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *CreateVPCParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -357,6 +772,16 @@ func (p *CreateVPCParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *CreateVPCParams) validate() error {
+	for _, k := range []string{"cidr", "displaytext", "name", "vpcofferingid", "zoneid"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of createVPC", k)
+		}
+	}
+	return nil
+}
+
 func (p *CreateVPCParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -365,6 +790,13 @@ func (p *CreateVPCParams) SetAccount(v string) {
 	return
 }
 
+// SetAccountIfUnset sets account to v, unless it is already set
+func (p *CreateVPCParams) SetAccountIfUnset(v string) {
+	if _, found := p.p["account"]; !found {
+		p.SetAccount(v)
+	}
+}
+
 func (p *CreateVPCParams) SetCidr(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -373,6 +805,13 @@ func (p *CreateVPCParams) SetCidr(v string) {
 	return
 }
 
+// SetCidrIfUnset sets cidr to v, unless it is already set
+func (p *CreateVPCParams) SetCidrIfUnset(v string) {
+	if _, found := p.p["cidr"]; !found {
+		p.SetCidr(v)
+	}
+}
+
 func (p *CreateVPCParams) SetDisplaytext(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -381,6 +820,13 @@ func (p *CreateVPCParams) SetDisplaytext(v string) {
 	return
 }
 
+// SetDisplaytextIfUnset sets displaytext to v, unless it is already set
+func (p *CreateVPCParams) SetDisplaytextIfUnset(v string) {
+	if _, found := p.p["displaytext"]; !found {
+		p.SetDisplaytext(v)
+	}
+}
+
 func (p *CreateVPCParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -389,6 +835,13 @@ func (p *CreateVPCParams) SetDomainid(v string) {
 	return
 }
 
+// SetDomainidIfUnset sets domainid to v, unless it is already set
+func (p *CreateVPCParams) SetDomainidIfUnset(v string) {
+	if _, found := p.p["domainid"]; !found {
+		p.SetDomainid(v)
+	}
+}
+
 func (p *CreateVPCParams) SetFordisplay(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -397,6 +850,13 @@ func (p *CreateVPCParams) SetFordisplay(v bool) {
 	return
 }
 
+// SetFordisplayIfUnset sets fordisplay to v, unless it is already set
+func (p *CreateVPCParams) SetFordisplayIfUnset(v bool) {
+	if _, found := p.p["fordisplay"]; !found {
+		p.SetFordisplay(v)
+	}
+}
+
 func (p *CreateVPCParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -405,6 +865,13 @@ func (p *CreateVPCParams) SetName(v string) {
 	return
 }
 
+// SetNameIfUnset sets name to v, unless it is already set
+func (p *CreateVPCParams) SetNameIfUnset(v string) {
+	if _, found := p.p["name"]; !found {
+		p.SetName(v)
+	}
+}
+
 func (p *CreateVPCParams) SetNetworkdomain(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -413,6 +880,13 @@ func (p *CreateVPCParams) SetNetworkdomain(v string) {
 	return
 }
 
+// SetNetworkdomainIfUnset sets networkdomain to v, unless it is already set
+func (p *CreateVPCParams) SetNetworkdomainIfUnset(v string) {
+	if _, found := p.p["networkdomain"]; !found {
+		p.SetNetworkdomain(v)
+	}
+}
+
 func (p *CreateVPCParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -421,6 +895,13 @@ func (p *CreateVPCParams) SetProjectid(v string) {
 	return
 }
 
+// SetProjectidIfUnset sets projectid to v, unless it is already set
+func (p *CreateVPCParams) SetProjectidIfUnset(v string) {
+	if _, found := p.p["projectid"]; !found {
+		p.SetProjectid(v)
+	}
+}
+
 func (p *CreateVPCParams) SetStart(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -429,6 +910,13 @@ func (p *CreateVPCParams) SetStart(v bool) {
 	return
 }
 
+// SetStartIfUnset sets start to v, unless it is already set
+func (p *CreateVPCParams) SetStartIfUnset(v bool) {
+	if _, found := p.p["start"]; !found {
+		p.SetStart(v)
+	}
+}
+
 func (p *CreateVPCParams) SetVpcofferingid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -437,6 +925,13 @@ func (p *CreateVPCParams) SetVpcofferingid(v string) {
 	return
 }
 
+// SetVpcofferingidIfUnset sets vpcofferingid to v, unless it is already set
+func (p *CreateVPCParams) SetVpcofferingidIfUnset(v string) {
+	if _, found := p.p["vpcofferingid"]; !found {
+		p.SetVpcofferingid(v)
+	}
+}
+
 func (p *CreateVPCParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -445,9 +940,17 @@ func (p *CreateVPCParams) SetZoneid(v string) {
 	return
 }
 
+// SetZoneidIfUnset sets zoneid to v, unless it is already set
+func (p *CreateVPCParams) SetZoneidIfUnset(v string) {
+	if _, found := p.p["zoneid"]; !found {
+		p.SetZoneid(v)
+	}
+}
+
 // You should always use this function to get a new CreateVPCParams instance,
-// as then you are sure you have configured all required params
-func (s *VPCService) NewCreateVPCParams(cidr string, displaytext string, name string, vpcofferingid string, zoneid string) *CreateVPCParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *VPCService) NewCreateVPCParams(cidr string, displaytext string, name string, vpcofferingid string, zoneid string, opts ...func(*CreateVPCParams)) *CreateVPCParams {
 	p := &CreateVPCParams{}
 	p.p = make(map[string]interface{})
 	p.p["cidr"] = cidr
@@ -455,12 +958,81 @@ func (s *VPCService) NewCreateVPCParams(cidr string, displaytext string, name st
 	p.p["name"] = name
 	p.p["vpcofferingid"] = vpcofferingid
 	p.p["zoneid"] = zoneid
+	for _, fn := range opts {
+		fn(p)
+	}
+	return p
+}
+
+// CreateVPCOpts contains all params that can be set on a CreateVPCParams.
+// Optional params are pointers (or slices and maps) which are only set when not nil.
+type CreateVPCOpts struct {
+	Account       *string
+	Cidr          string
+	Displaytext   string
+	Domainid      *string
+	Fordisplay    *bool
+	Name          string
+	Networkdomain *string
+	Projectid     *string
+	Start         *bool
+	Vpcofferingid string
+	Zoneid        string
+}
+
+// NewCreateVPCParamsFromOpts is an alternative for NewCreateVPCParams which takes all params using
+// an options struct, as that is easier to read for APIs with many required params
+func (s *VPCService) NewCreateVPCParamsFromOpts(o CreateVPCOpts) *CreateVPCParams {
+	p := s.NewCreateVPCParams(o.Cidr, o.Displaytext, o.Name, o.Vpcofferingid, o.Zoneid)
+	if o.Account != nil {
+		p.p["account"] = *o.Account
+	}
+	if o.Domainid != nil {
+		p.p["domainid"] = *o.Domainid
+	}
+	if o.Fordisplay != nil {
+		p.p["fordisplay"] = *o.Fordisplay
+	}
+	if o.Networkdomain != nil {
+		p.p["networkdomain"] = *o.Networkdomain
+	}
+	if o.Projectid != nil {
+		p.p["projectid"] = *o.Projectid
+	}
+	if o.Start != nil {
+		p.p["start"] = *o.Start
+	}
 	return p
 }
 
 // Creates a VPC
-func (s *VPCService) CreateVPC(p *CreateVPCParams) (*CreateVPCResponse, error) {
-	resp, err := s.cs.newRequest("createVPC", p.toURLValues())
+func (s *VPCService) CreateVPC(p *CreateVPCParams, opts ...OptionFunc) (*CreateVPCResponse, error) {
+	return s.CreateVPCWithContext(context.Background(), p, opts...)
+}
+
+// CreateVPCWithContext is the same as CreateVPC, but the request is cancelled when the context is done
+func (s *VPCService) CreateVPCWithContext(ctx context.Context, p *CreateVPCParams, opts ...OptionFunc) (*CreateVPCResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.CreateVPCRawWithContext(ctx, p.toURLValues())
+}
+
+// CreateVPCRaw is the same as CreateVPC, but takes the params as url.Values instead of typed params
+func (s *VPCService) CreateVPCRaw(v url.Values) (*CreateVPCResponse, error) {
+	return s.CreateVPCRawWithContext(context.Background(), v)
+}
+
+// CreateVPCRawWithContext is the same as CreateVPCRaw, but the request is cancelled when the context is done
+func (s *VPCService) CreateVPCRawWithContext(ctx context.Context, v url.Values) (*CreateVPCResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "createVPC", "createvpcresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -472,9 +1044,9 @@ func (s *VPCService) CreateVPC(p *CreateVPCParams) (*CreateVPCResponse, error) {
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -627,6 +1199,16 @@ type CreateVPCOfferingParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *CreateVPCOfferingParams) Clone() *CreateVPCOfferingParams {
+	c := &CreateVPCOfferingParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *CreateVPCOfferingParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -639,22 +1221,20 @@ func (p *CreateVPCOfferingParams) toURLValues() url.Values {
 		u.Set("name", v.(string))
 	}
 	if v, found := p.p["servicecapabilitylist"]; found {
-		i := 0
-		for k, vv := range v.(map[string]string) {
+		m := v.(map[string]string)
+		for i, k := range getSortedKeysFromMap(m) {
 			u.Set(fmt.Sprintf("servicecapabilitylist[%d].key", i), k)
-			u.Set(fmt.Sprintf("servicecapabilitylist[%d].value", i), vv)
-			i++
+			u.Set(fmt.Sprintf("servicecapabilitylist[%d].value", i), m[k])
 		}
 	}
 	if v, found := p.p["serviceofferingid"]; found {
 		u.Set("serviceofferingid", v.(string))
 	}
 	if v, found := p.p["serviceproviderlist"]; found {
-		i := 0
-		for k, vv := range v.(map[string]string) {
+		m := v.(map[string]string)
+		for i, k := range getSortedKeysFromMap(m) {
 			u.Set(fmt.Sprintf("serviceproviderlist[%d].service", i), k)
-			u.Set(fmt.Sprintf("serviceproviderlist[%d].provider", i), vv)
-			i++
+			u.Set(fmt.Sprintf("serviceproviderlist[%d].provider", i), m[k])
 		}
 	}
 	if v, found := p.p["supportedservices"]; found {
@@ -664,6 +1244,16 @@ func (p *CreateVPCOfferingParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *CreateVPCOfferingParams) validate() error {
+	for _, k := range []string{"displaytext", "name", "supportedservices"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of createVPCOffering", k)
+		}
+	}
+	return nil
+}
+
 func (p *CreateVPCOfferingParams) SetDisplaytext(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -672,6 +1262,13 @@ func (p *CreateVPCOfferingParams) SetDisplaytext(v string) {
 	return
 }
 
+// SetDisplaytextIfUnset sets displaytext to v, unless it is already set
+func (p *CreateVPCOfferingParams) SetDisplaytextIfUnset(v string) {
+	if _, found := p.p["displaytext"]; !found {
+		p.SetDisplaytext(v)
+	}
+}
+
 func (p *CreateVPCOfferingParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -680,6 +1277,13 @@ func (p *CreateVPCOfferingParams) SetName(v string) {
 	return
 }
 
+// SetNameIfUnset sets name to v, unless it is already set
+func (p *CreateVPCOfferingParams) SetNameIfUnset(v string) {
+	if _, found := p.p["name"]; !found {
+		p.SetName(v)
+	}
+}
+
 func (p *CreateVPCOfferingParams) SetServicecapabilitylist(v map[string]string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -688,6 +1292,13 @@ func (p *CreateVPCOfferingParams) SetServicecapabilitylist(v map[string]string)
 	return
 }
 
+// SetServicecapabilitylistIfUnset sets servicecapabilitylist to v, unless it is already set
+func (p *CreateVPCOfferingParams) SetServicecapabilitylistIfUnset(v map[string]string) {
+	if _, found := p.p["servicecapabilitylist"]; !found {
+		p.SetServicecapabilitylist(v)
+	}
+}
+
 func (p *CreateVPCOfferingParams) SetServiceofferingid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -696,6 +1307,13 @@ func (p *CreateVPCOfferingParams) SetServiceofferingid(v string) {
 	return
 }
 
+// SetServiceofferingidIfUnset sets serviceofferingid to v, unless it is already set
+func (p *CreateVPCOfferingParams) SetServiceofferingidIfUnset(v string) {
+	if _, found := p.p["serviceofferingid"]; !found {
+		p.SetServiceofferingid(v)
+	}
+}
+
 func (p *CreateVPCOfferingParams) SetServiceproviderlist(v map[string]string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -704,6 +1322,13 @@ func (p *CreateVPCOfferingParams) SetServiceproviderlist(v map[string]string) {
 	return
 }
 
+// SetServiceproviderlistIfUnset sets serviceproviderlist to v, unless it is already set
+func (p *CreateVPCOfferingParams) SetServiceproviderlistIfUnset(v map[string]string) {
+	if _, found := p.p["serviceproviderlist"]; !found {
+		p.SetServiceproviderlist(v)
+	}
+}
+
 func (p *CreateVPCOfferingParams) SetSupportedservices(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -712,20 +1337,88 @@ func (p *CreateVPCOfferingParams) SetSupportedservices(v []string) {
 	return
 }
 
+// SetSupportedservicesIfUnset sets supportedservices to v, unless it is already set
+func (p *CreateVPCOfferingParams) SetSupportedservicesIfUnset(v []string) {
+	if _, found := p.p["supportedservices"]; !found {
+		p.SetSupportedservices(v)
+	}
+}
+
+// SetSupportedservicesCSV splits v on commas and sets the resulting values
+func (p *CreateVPCOfferingParams) SetSupportedservicesCSV(v string) {
+	p.SetSupportedservices(splitCSV(v))
+}
+
 // You should always use this function to get a new CreateVPCOfferingParams instance,
-// as then you are sure you have configured all required params
-func (s *VPCService) NewCreateVPCOfferingParams(displaytext string, name string, supportedservices []string) *CreateVPCOfferingParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *VPCService) NewCreateVPCOfferingParams(displaytext string, name string, supportedservices []string, opts ...func(*CreateVPCOfferingParams)) *CreateVPCOfferingParams {
 	p := &CreateVPCOfferingParams{}
 	p.p = make(map[string]interface{})
 	p.p["displaytext"] = displaytext
 	p.p["name"] = name
 	p.p["supportedservices"] = supportedservices
+	for _, fn := range opts {
+		fn(p)
+	}
+	return p
+}
+
+// CreateVPCOfferingOpts contains all params that can be set on a CreateVPCOfferingParams.
+// Optional params are pointers (or slices and maps) which are only set when not nil.
+type CreateVPCOfferingOpts struct {
+	Displaytext           string
+	Name                  string
+	Servicecapabilitylist map[string]string
+	Serviceofferingid     *string
+	Serviceproviderlist   map[string]string
+	Supportedservices     []string
+}
+
+// NewCreateVPCOfferingParamsFromOpts is an alternative for NewCreateVPCOfferingParams which takes all params using
+// an options struct, as that is easier to read for APIs with many required params
+func (s *VPCService) NewCreateVPCOfferingParamsFromOpts(o CreateVPCOfferingOpts) *CreateVPCOfferingParams {
+	p := s.NewCreateVPCOfferingParams(o.Displaytext, o.Name, o.Supportedservices)
+	if o.Servicecapabilitylist != nil {
+		p.p["servicecapabilitylist"] = o.Servicecapabilitylist
+	}
+	if o.Serviceofferingid != nil {
+		p.p["serviceofferingid"] = *o.Serviceofferingid
+	}
+	if o.Serviceproviderlist != nil {
+		p.p["serviceproviderlist"] = o.Serviceproviderlist
+	}
 	return p
 }
 
 // Creates VPC offering
-func (s *VPCService) CreateVPCOffering(p *CreateVPCOfferingParams) (*CreateVPCOfferingResponse, error) {
-	resp, err := s.cs.newRequest("createVPCOffering", p.toURLValues())
+func (s *VPCService) CreateVPCOffering(p *CreateVPCOfferingParams, opts ...OptionFunc) (*CreateVPCOfferingResponse, error) {
+	return s.CreateVPCOfferingWithContext(context.Background(), p, opts...)
+}
+
+// CreateVPCOfferingWithContext is the same as CreateVPCOffering, but the request is cancelled when the context is done
+func (s *VPCService) CreateVPCOfferingWithContext(ctx context.Context, p *CreateVPCOfferingParams, opts ...OptionFunc) (*CreateVPCOfferingResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.CreateVPCOfferingRawWithContext(ctx, p.toURLValues())
+}
+
+// CreateVPCOfferingRaw is the same as CreateVPCOffering, but takes the params as url.Values instead of typed params
+func (s *VPCService) CreateVPCOfferingRaw(v url.Values) (*CreateVPCOfferingResponse, error) {
+	return s.CreateVPCOfferingRawWithContext(context.Background(), v)
+}
+
+// CreateVPCOfferingRawWithContext is the same as CreateVPCOfferingRaw, but the request is cancelled when the context is done
+func (s *VPCService) CreateVPCOfferingRawWithContext(ctx context.Context, v url.Values) (*CreateVPCOfferingResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "createVPCOffering", "createvpcofferingresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -737,9 +1430,9 @@ func (s *VPCService) CreateVPCOffering(p *CreateVPCOfferingParams) (*CreateVPCOf
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -791,6 +1484,16 @@ type DeletePrivateGatewayParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *DeletePrivateGatewayParams) Clone() *DeletePrivateGatewayParams {
+	c := &DeletePrivateGatewayParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeletePrivateGatewayParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -802,6 +1505,16 @@ func (p *DeletePrivateGatewayParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *DeletePrivateGatewayParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of deletePrivateGateway", k)
+		}
+	}
+	return nil
+}
+
 func (p *DeletePrivateGatewayParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -810,18 +1523,54 @@ func (p *DeletePrivateGatewayParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *DeletePrivateGatewayParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 // You should always use this function to get a new DeletePrivateGatewayParams instance,
-// as then you are sure you have configured all required params
-func (s *VPCService) NewDeletePrivateGatewayParams(id string) *DeletePrivateGatewayParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *VPCService) NewDeletePrivateGatewayParams(id string, opts ...func(*DeletePrivateGatewayParams)) *DeletePrivateGatewayParams {
 	p := &DeletePrivateGatewayParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Deletes a Private gateway
-func (s *VPCService) DeletePrivateGateway(p *DeletePrivateGatewayParams) (*DeletePrivateGatewayResponse, error) {
-	resp, err := s.cs.newRequest("deletePrivateGateway", p.toURLValues())
+func (s *VPCService) DeletePrivateGateway(p *DeletePrivateGatewayParams, opts ...OptionFunc) (*DeletePrivateGatewayResponse, error) {
+	return s.DeletePrivateGatewayWithContext(context.Background(), p, opts...)
+}
+
+// DeletePrivateGatewayWithContext is the same as DeletePrivateGateway, but the request is cancelled when the context is done
+func (s *VPCService) DeletePrivateGatewayWithContext(ctx context.Context, p *DeletePrivateGatewayParams, opts ...OptionFunc) (*DeletePrivateGatewayResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.DeletePrivateGatewayRawWithContext(ctx, p.toURLValues())
+}
+
+// DeletePrivateGatewayRaw is the same as DeletePrivateGateway, but takes the params as url.Values instead of typed params
+func (s *VPCService) DeletePrivateGatewayRaw(v url.Values) (*DeletePrivateGatewayResponse, error) {
+	return s.DeletePrivateGatewayRawWithContext(context.Background(), v)
+}
+
+// DeletePrivateGatewayRawWithContext is the same as DeletePrivateGatewayRaw, but the request is cancelled when the context is done
+func (s *VPCService) DeletePrivateGatewayRawWithContext(ctx context.Context, v url.Values) (*DeletePrivateGatewayResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "deletePrivateGateway", "deleteprivategatewayresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -833,9 +1582,9 @@ func (s *VPCService) DeletePrivateGateway(p *DeletePrivateGatewayParams) (*Delet
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -849,6 +1598,34 @@ func (s *VPCService) DeletePrivateGateway(p *DeletePrivateGatewayParams) (*Delet
 	return &r, nil
 }
 
+// WaitForPrivateGatewayDeleted polls until the PrivateGateway with the given ID no longer exists, the timeout
+// expires or the context is cancelled. Deleted resources may still be listed for a short while
+// after the delete command finished, so this can be used to make sure they are really gone. The
+// options are passed to GetPrivateGatewayByID, so a resource outside of the default scope can be found.
+func (s *VPCService) WaitForPrivateGatewayDeleted(ctx context.Context, id string, timeout time.Duration, opts ...OptionFunc) error {
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	for {
+		_, count, err := s.GetPrivateGatewayByID(id, opts...)
+		if count == 0 {
+			return nil
+		}
+		if count < 0 {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(2 * time.Second):
+		}
+	}
+}
+
 type DeletePrivateGatewayResponse struct {
 	JobID       string `json:"jobid"`
 	Displaytext string `json:"displaytext"`
@@ -859,6 +1636,16 @@ type DeleteStaticRouteParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *DeleteStaticRouteParams) Clone() *DeleteStaticRouteParams {
+	c := &DeleteStaticRouteParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeleteStaticRouteParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -870,6 +1657,16 @@ func (p *DeleteStaticRouteParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *DeleteStaticRouteParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of deleteStaticRoute", k)
+		}
+	}
+	return nil
+}
+
 func (p *DeleteStaticRouteParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -878,18 +1675,54 @@ func (p *DeleteStaticRouteParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *DeleteStaticRouteParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 // You should always use this function to get a new DeleteStaticRouteParams instance,
-// as then you are sure you have configured all required params
-func (s *VPCService) NewDeleteStaticRouteParams(id string) *DeleteStaticRouteParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *VPCService) NewDeleteStaticRouteParams(id string, opts ...func(*DeleteStaticRouteParams)) *DeleteStaticRouteParams {
 	p := &DeleteStaticRouteParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Deletes a static route
-func (s *VPCService) DeleteStaticRoute(p *DeleteStaticRouteParams) (*DeleteStaticRouteResponse, error) {
-	resp, err := s.cs.newRequest("deleteStaticRoute", p.toURLValues())
+func (s *VPCService) DeleteStaticRoute(p *DeleteStaticRouteParams, opts ...OptionFunc) (*DeleteStaticRouteResponse, error) {
+	return s.DeleteStaticRouteWithContext(context.Background(), p, opts...)
+}
+
+// DeleteStaticRouteWithContext is the same as DeleteStaticRoute, but the request is cancelled when the context is done
+func (s *VPCService) DeleteStaticRouteWithContext(ctx context.Context, p *DeleteStaticRouteParams, opts ...OptionFunc) (*DeleteStaticRouteResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.DeleteStaticRouteRawWithContext(ctx, p.toURLValues())
+}
+
+// DeleteStaticRouteRaw is the same as DeleteStaticRoute, but takes the params as url.Values instead of typed params
+func (s *VPCService) DeleteStaticRouteRaw(v url.Values) (*DeleteStaticRouteResponse, error) {
+	return s.DeleteStaticRouteRawWithContext(context.Background(), v)
+}
+
+// DeleteStaticRouteRawWithContext is the same as DeleteStaticRouteRaw, but the request is cancelled when the context is done
+func (s *VPCService) DeleteStaticRouteRawWithContext(ctx context.Context, v url.Values) (*DeleteStaticRouteResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteStaticRoute", "deletestaticrouteresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -901,9 +1734,9 @@ func (s *VPCService) DeleteStaticRoute(p *DeleteStaticRouteParams) (*DeleteStati
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -917,6 +1750,34 @@ func (s *VPCService) DeleteStaticRoute(p *DeleteStaticRouteParams) (*DeleteStati
 	return &r, nil
 }
 
+// WaitForStaticRouteDeleted polls until the StaticRoute with the given ID no longer exists, the timeout
+// expires or the context is cancelled. Deleted resources may still be listed for a short while
+// after the delete command finished, so this can be used to make sure they are really gone. The
+// options are passed to GetStaticRouteByID, so a resource outside of the default scope can be found.
+func (s *VPCService) WaitForStaticRouteDeleted(ctx context.Context, id string, timeout time.Duration, opts ...OptionFunc) error {
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	for {
+		_, count, err := s.GetStaticRouteByID(id, opts...)
+		if count == 0 {
+			return nil
+		}
+		if count < 0 {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(2 * time.Second):
+		}
+	}
+}
+
 type DeleteStaticRouteResponse struct {
 	JobID       string `json:"jobid"`
 	Displaytext string `json:"displaytext"`
@@ -927,6 +1788,16 @@ type DeleteVPCParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *DeleteVPCParams) Clone() *DeleteVPCParams {
+	c := &DeleteVPCParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeleteVPCParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -938,6 +1809,16 @@ func (p *DeleteVPCParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *DeleteVPCParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of deleteVPC", k)
+		}
+	}
+	return nil
+}
+
 func (p *DeleteVPCParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -946,18 +1827,54 @@ func (p *DeleteVPCParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *DeleteVPCParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 // You should always use this function to get a new DeleteVPCParams instance,
-// as then you are sure you have configured all required params
-func (s *VPCService) NewDeleteVPCParams(id string) *DeleteVPCParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *VPCService) NewDeleteVPCParams(id string, opts ...func(*DeleteVPCParams)) *DeleteVPCParams {
 	p := &DeleteVPCParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Deletes a VPC
-func (s *VPCService) DeleteVPC(p *DeleteVPCParams) (*DeleteVPCResponse, error) {
-	resp, err := s.cs.newRequest("deleteVPC", p.toURLValues())
+func (s *VPCService) DeleteVPC(p *DeleteVPCParams, opts ...OptionFunc) (*DeleteVPCResponse, error) {
+	return s.DeleteVPCWithContext(context.Background(), p, opts...)
+}
+
+// DeleteVPCWithContext is the same as DeleteVPC, but the request is cancelled when the context is done
+func (s *VPCService) DeleteVPCWithContext(ctx context.Context, p *DeleteVPCParams, opts ...OptionFunc) (*DeleteVPCResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.DeleteVPCRawWithContext(ctx, p.toURLValues())
+}
+
+// DeleteVPCRaw is the same as DeleteVPC, but takes the params as url.Values instead of typed params
+func (s *VPCService) DeleteVPCRaw(v url.Values) (*DeleteVPCResponse, error) {
+	return s.DeleteVPCRawWithContext(context.Background(), v)
+}
+
+// DeleteVPCRawWithContext is the same as DeleteVPCRaw, but the request is cancelled when the context is done
+func (s *VPCService) DeleteVPCRawWithContext(ctx context.Context, v url.Values) (*DeleteVPCResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteVPC", "deletevpcresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -969,9 +1886,9 @@ func (s *VPCService) DeleteVPC(p *DeleteVPCParams) (*DeleteVPCResponse, error) {
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -985,6 +1902,34 @@ func (s *VPCService) DeleteVPC(p *DeleteVPCParams) (*DeleteVPCResponse, error) {
 	return &r, nil
 }
 
+// WaitForVPCDeleted polls until the VPC with the given ID no longer exists, the timeout
+// expires or the context is cancelled. Deleted resources may still be listed for a short while
+// after the delete command finished, so this can be used to make sure they are really gone. The
+// options are passed to GetVPCByID, so a resource outside of the default scope can be found.
+func (s *VPCService) WaitForVPCDeleted(ctx context.Context, id string, timeout time.Duration, opts ...OptionFunc) error {
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	for {
+		_, count, err := s.GetVPCByID(id, opts...)
+		if count == 0 {
+			return nil
+		}
+		if count < 0 {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(2 * time.Second):
+		}
+	}
+}
+
 type DeleteVPCResponse struct {
 	JobID       string `json:"jobid"`
 	Displaytext string `json:"displaytext"`
@@ -995,6 +1940,16 @@ type DeleteVPCOfferingParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *DeleteVPCOfferingParams) Clone() *DeleteVPCOfferingParams {
+	c := &DeleteVPCOfferingParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeleteVPCOfferingParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1006,6 +1961,16 @@ func (p *DeleteVPCOfferingParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *DeleteVPCOfferingParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of deleteVPCOffering", k)
+		}
+	}
+	return nil
+}
+
 func (p *DeleteVPCOfferingParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1014,18 +1979,54 @@ func (p *DeleteVPCOfferingParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *DeleteVPCOfferingParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 // You should always use this function to get a new DeleteVPCOfferingParams instance,
-// as then you are sure you have configured all required params
-func (s *VPCService) NewDeleteVPCOfferingParams(id string) *DeleteVPCOfferingParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *VPCService) NewDeleteVPCOfferingParams(id string, opts ...func(*DeleteVPCOfferingParams)) *DeleteVPCOfferingParams {
 	p := &DeleteVPCOfferingParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Deletes VPC offering
-func (s *VPCService) DeleteVPCOffering(p *DeleteVPCOfferingParams) (*DeleteVPCOfferingResponse, error) {
-	resp, err := s.cs.newRequest("deleteVPCOffering", p.toURLValues())
+func (s *VPCService) DeleteVPCOffering(p *DeleteVPCOfferingParams, opts ...OptionFunc) (*DeleteVPCOfferingResponse, error) {
+	return s.DeleteVPCOfferingWithContext(context.Background(), p, opts...)
+}
+
+// DeleteVPCOfferingWithContext is the same as DeleteVPCOffering, but the request is cancelled when the context is done
+func (s *VPCService) DeleteVPCOfferingWithContext(ctx context.Context, p *DeleteVPCOfferingParams, opts ...OptionFunc) (*DeleteVPCOfferingResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.DeleteVPCOfferingRawWithContext(ctx, p.toURLValues())
+}
+
+// DeleteVPCOfferingRaw is the same as DeleteVPCOffering, but takes the params as url.Values instead of typed params
+func (s *VPCService) DeleteVPCOfferingRaw(v url.Values) (*DeleteVPCOfferingResponse, error) {
+	return s.DeleteVPCOfferingRawWithContext(context.Background(), v)
+}
+
+// DeleteVPCOfferingRawWithContext is the same as DeleteVPCOfferingRaw, but the request is cancelled when the context is done
+func (s *VPCService) DeleteVPCOfferingRawWithContext(ctx context.Context, v url.Values) (*DeleteVPCOfferingResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteVPCOffering", "deletevpcofferingresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -1037,9 +2038,9 @@ func (s *VPCService) DeleteVPCOffering(p *DeleteVPCOfferingParams) (*DeleteVPCOf
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -1053,6 +2054,34 @@ func (s *VPCService) DeleteVPCOffering(p *DeleteVPCOfferingParams) (*DeleteVPCOf
 	return &r, nil
 }
 
+// WaitForVPCOfferingDeleted polls until the VPCOffering with the given ID no longer exists, the timeout
+// expires or the context is cancelled. Deleted resources may still be listed for a short while
+// after the delete command finished, so this can be used to make sure they are really gone. The
+// options are passed to GetVPCOfferingByID, so a resource outside of the default scope can be found.
+func (s *VPCService) WaitForVPCOfferingDeleted(ctx context.Context, id string, timeout time.Duration, opts ...OptionFunc) error {
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	for {
+		_, count, err := s.GetVPCOfferingByID(id, opts...)
+		if count == 0 {
+			return nil
+		}
+		if count < 0 {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(2 * time.Second):
+		}
+	}
+}
+
 type DeleteVPCOfferingResponse struct {
 	JobID       string `json:"jobid"`
 	Displaytext string `json:"displaytext"`
@@ -1063,6 +2092,16 @@ type ListPrivateGatewaysParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ListPrivateGatewaysParams) Clone() *ListPrivateGatewaysParams {
+	c := &ListPrivateGatewaysParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListPrivateGatewaysParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1122,6 +2161,13 @@ func (p *ListPrivateGatewaysParams) SetAccount(v string) {
 	return
 }
 
+// SetAccountIfUnset sets account to v, unless it is already set
+func (p *ListPrivateGatewaysParams) SetAccountIfUnset(v string) {
+	if _, found := p.p["account"]; !found {
+		p.SetAccount(v)
+	}
+}
+
 func (p *ListPrivateGatewaysParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1130,6 +2176,13 @@ func (p *ListPrivateGatewaysParams) SetDomainid(v string) {
 	return
 }
 
+// SetDomainidIfUnset sets domainid to v, unless it is already set
+func (p *ListPrivateGatewaysParams) SetDomainidIfUnset(v string) {
+	if _, found := p.p["domainid"]; !found {
+		p.SetDomainid(v)
+	}
+}
+
 func (p *ListPrivateGatewaysParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1138,6 +2191,13 @@ func (p *ListPrivateGatewaysParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *ListPrivateGatewaysParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 func (p *ListPrivateGatewaysParams) SetIpaddress(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1146,6 +2206,13 @@ func (p *ListPrivateGatewaysParams) SetIpaddress(v string) {
 	return
 }
 
+// SetIpaddressIfUnset sets ipaddress to v, unless it is already set
+func (p *ListPrivateGatewaysParams) SetIpaddressIfUnset(v string) {
+	if _, found := p.p["ipaddress"]; !found {
+		p.SetIpaddress(v)
+	}
+}
+
 func (p *ListPrivateGatewaysParams) SetIsrecursive(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1154,6 +2221,13 @@ func (p *ListPrivateGatewaysParams) SetIsrecursive(v bool) {
 	return
 }
 
+// SetIsrecursiveIfUnset sets isrecursive to v, unless it is already set
+func (p *ListPrivateGatewaysParams) SetIsrecursiveIfUnset(v bool) {
+	if _, found := p.p["isrecursive"]; !found {
+		p.SetIsrecursive(v)
+	}
+}
+
 func (p *ListPrivateGatewaysParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1162,6 +2236,13 @@ func (p *ListPrivateGatewaysParams) SetKeyword(v string) {
 	return
 }
 
+// SetKeywordIfUnset sets keyword to v, unless it is already set
+func (p *ListPrivateGatewaysParams) SetKeywordIfUnset(v string) {
+	if _, found := p.p["keyword"]; !found {
+		p.SetKeyword(v)
+	}
+}
+
 func (p *ListPrivateGatewaysParams) SetListall(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1170,6 +2251,13 @@ func (p *ListPrivateGatewaysParams) SetListall(v bool) {
 	return
 }
 
+// SetListallIfUnset sets listall to v, unless it is already set
+func (p *ListPrivateGatewaysParams) SetListallIfUnset(v bool) {
+	if _, found := p.p["listall"]; !found {
+		p.SetListall(v)
+	}
+}
+
 func (p *ListPrivateGatewaysParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1178,6 +2266,13 @@ func (p *ListPrivateGatewaysParams) SetPage(v int) {
 	return
 }
 
+// SetPageIfUnset sets page to v, unless it is already set
+func (p *ListPrivateGatewaysParams) SetPageIfUnset(v int) {
+	if _, found := p.p["page"]; !found {
+		p.SetPage(v)
+	}
+}
+
 func (p *ListPrivateGatewaysParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1186,6 +2281,13 @@ func (p *ListPrivateGatewaysParams) SetPagesize(v int) {
 	return
 }
 
+// SetPagesizeIfUnset sets pagesize to v, unless it is already set
+func (p *ListPrivateGatewaysParams) SetPagesizeIfUnset(v int) {
+	if _, found := p.p["pagesize"]; !found {
+		p.SetPagesize(v)
+	}
+}
+
 func (p *ListPrivateGatewaysParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1194,6 +2296,13 @@ func (p *ListPrivateGatewaysParams) SetProjectid(v string) {
 	return
 }
 
+// SetProjectidIfUnset sets projectid to v, unless it is already set
+func (p *ListPrivateGatewaysParams) SetProjectidIfUnset(v string) {
+	if _, found := p.p["projectid"]; !found {
+		p.SetProjectid(v)
+	}
+}
+
 func (p *ListPrivateGatewaysParams) SetState(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1202,6 +2311,13 @@ func (p *ListPrivateGatewaysParams) SetState(v string) {
 	return
 }
 
+// SetStateIfUnset sets state to v, unless it is already set
+func (p *ListPrivateGatewaysParams) SetStateIfUnset(v string) {
+	if _, found := p.p["state"]; !found {
+		p.SetState(v)
+	}
+}
+
 func (p *ListPrivateGatewaysParams) SetVlan(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1210,6 +2326,13 @@ func (p *ListPrivateGatewaysParams) SetVlan(v string) {
 	return
 }
 
+// SetVlanIfUnset sets vlan to v, unless it is already set
+func (p *ListPrivateGatewaysParams) SetVlanIfUnset(v string) {
+	if _, found := p.p["vlan"]; !found {
+		p.SetVlan(v)
+	}
+}
+
 func (p *ListPrivateGatewaysParams) SetVpcid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1218,14 +2341,34 @@ func (p *ListPrivateGatewaysParams) SetVpcid(v string) {
 	return
 }
 
+// SetVpcidIfUnset sets vpcid to v, unless it is already set
+func (p *ListPrivateGatewaysParams) SetVpcidIfUnset(v string) {
+	if _, found := p.p["vpcid"]; !found {
+		p.SetVpcid(v)
+	}
+}
+
+var _ ListAllSetter = (*ListPrivateGatewaysParams)(nil)
+
 // You should always use this function to get a new ListPrivateGatewaysParams instance,
-// as then you are sure you have configured all required params
-func (s *VPCService) NewListPrivateGatewaysParams() *ListPrivateGatewaysParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *VPCService) NewListPrivateGatewaysParams(opts ...func(*ListPrivateGatewaysParams)) *ListPrivateGatewaysParams {
 	p := &ListPrivateGatewaysParams{}
 	p.p = make(map[string]interface{})
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
+func (p *ListPrivateGatewaysParams) setFirstMatch() {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p[firstMatchKey] = true
+}
+
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *VPCService) GetPrivateGatewayByID(id string, opts ...OptionFunc) (*PrivateGateway, int, error) {
 	p := &ListPrivateGatewaysParams{}
@@ -1233,7 +2376,7 @@ func (s *VPCService) GetPrivateGatewayByID(id string, opts ...OptionFunc) (*Priv
 
 	p.p["id"] = id
 
-	for _, fn := range append(s.cs.options, opts...) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
 		if err := fn(s.cs, p); err != nil {
 			return nil, -1, err
 		}
@@ -1244,24 +2387,56 @@ func (s *VPCService) GetPrivateGatewayByID(id string, opts ...OptionFunc) (*Priv
 		if strings.Contains(err.Error(), fmt.Sprintf(
 			"Invalid parameter id value=%s due to incorrect long value format, "+
 				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+			return nil, 0, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
 	}
 
 	if l.Count == 1 {
 		return l.PrivateGateways[0], l.Count, nil
 	}
+
+	if _, ok := p.p[firstMatchKey]; ok && len(l.PrivateGateways) > 0 {
+		return l.PrivateGateways[0], l.Count, nil
+	}
 	return nil, l.Count, fmt.Errorf("There is more then one result for PrivateGateway UUID: %s!", id)
 }
 
+// PrivateGatewayExists returns true if a PrivateGateway with the given ID exists. When no match is found
+// it returns false, while any other error is returned as is.
+func (s *VPCService) PrivateGatewayExists(id string, opts ...OptionFunc) (bool, error) {
+	_, count, err := s.GetPrivateGatewayByID(id, opts...)
+	if errors.Is(err, ErrNotFound) {
+		return false, nil
+	}
+	if count > 0 {
+		return true, nil
+	}
+	return false, err
+}
+
 // List private gateways
 func (s *VPCService) ListPrivateGateways(p *ListPrivateGatewaysParams) (*ListPrivateGatewaysResponse, error) {
-	resp, err := s.cs.newRequest("listPrivateGateways", p.toURLValues())
+	return s.ListPrivateGatewaysWithContext(context.Background(), p)
+}
+
+// ListPrivateGatewaysWithContext is the same as ListPrivateGateways, but the request is cancelled when the context is done
+func (s *VPCService) ListPrivateGatewaysWithContext(ctx context.Context, p *ListPrivateGatewaysParams) (*ListPrivateGatewaysResponse, error) {
+	return s.ListPrivateGatewaysRawWithContext(ctx, p.toURLValues())
+}
+
+// ListPrivateGatewaysRaw is the same as ListPrivateGateways, but takes the params as url.Values instead of typed params
+func (s *VPCService) ListPrivateGatewaysRaw(v url.Values) (*ListPrivateGatewaysResponse, error) {
+	return s.ListPrivateGatewaysRawWithContext(context.Background(), v)
+}
+
+// ListPrivateGatewaysRawWithContext is the same as ListPrivateGatewaysRaw, but the request is cancelled when the context is done
+func (s *VPCService) ListPrivateGatewaysRawWithContext(ctx context.Context, v url.Values) (*ListPrivateGatewaysResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "listPrivateGateways", "listprivategatewaysresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -1274,6 +2449,93 @@ func (s *VPCService) ListPrivateGateways(p *ListPrivateGatewaysParams) (*ListPri
 	return &r, nil
 }
 
+// StreamPrivateGateways fetches the results of ListPrivateGateways page by page in the background and sends them on the
+// returned channel. Both returned channels are closed when done. If fetching a page fails, or the context
+// is done before all results are sent, the error is sent on the error channel and fetching stops. A
+// request for a page in flight is cancelled when the context is done. The page and pagesize set in the
+// params are ignored.
+func (s *VPCService) StreamPrivateGateways(ctx context.Context, p *ListPrivateGatewaysParams) (<-chan *PrivateGateway, <-chan error) {
+	ch := make(chan *PrivateGateway)
+	errs := make(chan error, 1)
+
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesize(500)
+
+	go func() {
+		defer close(errs)
+		defer close(ch)
+
+		for page, count := 1, 0; ; page++ {
+			pp.SetPage(page)
+
+			l, err := s.ListPrivateGatewaysWithContext(ctx, pp)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			for _, v := range l.PrivateGateways {
+				select {
+				case ch <- v:
+				case <-ctx.Done():
+					errs <- ctx.Err()
+					return
+				}
+			}
+
+			count += len(l.PrivateGateways)
+			if len(l.PrivateGateways) < 500 || count >= l.Count {
+				return
+			}
+		}
+	}()
+
+	return ch, errs
+}
+
+// ListPrivateGatewaysWhere returns all results of ListPrivateGateways for which pred returns true. Note that the results are
+// filtered client-side, so all results matching the params are fetched first.
+// All pages are fetched, so the page and pagesize set in the params are ignored.
+func (s *VPCService) ListPrivateGatewaysWhere(p *ListPrivateGatewaysParams, pred func(*PrivateGateway) bool) ([]*PrivateGateway, error) {
+	var r []*PrivateGateway
+	ch, errs := s.StreamPrivateGateways(context.Background(), p)
+	for v := range ch {
+		if pred(v) {
+			r = append(r, v)
+		}
+	}
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ListAllPrivateGateways returns the results of ListPrivateGateways across all pages, by calling ListPrivateGateways page by page until all
+// results are fetched. The pagesize set in the params is used for every page (500 if not set), and the
+// page set in the params is ignored.
+func (s *VPCService) ListAllPrivateGateways(p *ListPrivateGatewaysParams) ([]*PrivateGateway, error) {
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesizeIfUnset(500)
+	pagesize := pp.p["pagesize"].(int)
+
+	var r []*PrivateGateway
+	for page := 1; ; page++ {
+		pp.SetPage(page)
+
+		l, err := s.ListPrivateGateways(pp)
+		if err != nil {
+			return nil, err
+		}
+		r = append(r, l.PrivateGateways...)
+
+		if len(l.PrivateGateways) < pagesize || len(r) >= l.Count {
+			return r, nil
+		}
+	}
+}
+
 type ListPrivateGatewaysResponse struct {
 	Count           int               `json:"count"`
 	PrivateGateways []*PrivateGateway `json:"privategateway"`
@@ -1303,6 +2565,16 @@ type ListStaticRoutesParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ListStaticRoutesParams) Clone() *ListStaticRoutesParams {
+	c := &ListStaticRoutesParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListStaticRoutesParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1343,11 +2615,10 @@ func (p *ListStaticRoutesParams) toURLValues() url.Values {
 		u.Set("projectid", v.(string))
 	}
 	if v, found := p.p["tags"]; found {
-		i := 0
-		for k, vv := range v.(map[string]string) {
+		m := v.(map[string]string)
+		for i, k := range getSortedKeysFromMap(m) {
 			u.Set(fmt.Sprintf("tags[%d].key", i), k)
-			u.Set(fmt.Sprintf("tags[%d].value", i), vv)
-			i++
+			u.Set(fmt.Sprintf("tags[%d].value", i), m[k])
 		}
 	}
 	if v, found := p.p["vpcid"]; found {
@@ -1364,6 +2635,13 @@ func (p *ListStaticRoutesParams) SetAccount(v string) {
 	return
 }
 
+// SetAccountIfUnset sets account to v, unless it is already set
+func (p *ListStaticRoutesParams) SetAccountIfUnset(v string) {
+	if _, found := p.p["account"]; !found {
+		p.SetAccount(v)
+	}
+}
+
 func (p *ListStaticRoutesParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1372,6 +2650,13 @@ func (p *ListStaticRoutesParams) SetDomainid(v string) {
 	return
 }
 
+// SetDomainidIfUnset sets domainid to v, unless it is already set
+func (p *ListStaticRoutesParams) SetDomainidIfUnset(v string) {
+	if _, found := p.p["domainid"]; !found {
+		p.SetDomainid(v)
+	}
+}
+
 func (p *ListStaticRoutesParams) SetGatewayid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1380,6 +2665,13 @@ func (p *ListStaticRoutesParams) SetGatewayid(v string) {
 	return
 }
 
+// SetGatewayidIfUnset sets gatewayid to v, unless it is already set
+func (p *ListStaticRoutesParams) SetGatewayidIfUnset(v string) {
+	if _, found := p.p["gatewayid"]; !found {
+		p.SetGatewayid(v)
+	}
+}
+
 func (p *ListStaticRoutesParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1388,6 +2680,13 @@ func (p *ListStaticRoutesParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *ListStaticRoutesParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 func (p *ListStaticRoutesParams) SetIsrecursive(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1396,6 +2695,13 @@ func (p *ListStaticRoutesParams) SetIsrecursive(v bool) {
 	return
 }
 
+// SetIsrecursiveIfUnset sets isrecursive to v, unless it is already set
+func (p *ListStaticRoutesParams) SetIsrecursiveIfUnset(v bool) {
+	if _, found := p.p["isrecursive"]; !found {
+		p.SetIsrecursive(v)
+	}
+}
+
 func (p *ListStaticRoutesParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1404,6 +2710,13 @@ func (p *ListStaticRoutesParams) SetKeyword(v string) {
 	return
 }
 
+// SetKeywordIfUnset sets keyword to v, unless it is already set
+func (p *ListStaticRoutesParams) SetKeywordIfUnset(v string) {
+	if _, found := p.p["keyword"]; !found {
+		p.SetKeyword(v)
+	}
+}
+
 func (p *ListStaticRoutesParams) SetListall(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1412,6 +2725,13 @@ func (p *ListStaticRoutesParams) SetListall(v bool) {
 	return
 }
 
+// SetListallIfUnset sets listall to v, unless it is already set
+func (p *ListStaticRoutesParams) SetListallIfUnset(v bool) {
+	if _, found := p.p["listall"]; !found {
+		p.SetListall(v)
+	}
+}
+
 func (p *ListStaticRoutesParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1420,6 +2740,13 @@ func (p *ListStaticRoutesParams) SetPage(v int) {
 	return
 }
 
+// SetPageIfUnset sets page to v, unless it is already set
+func (p *ListStaticRoutesParams) SetPageIfUnset(v int) {
+	if _, found := p.p["page"]; !found {
+		p.SetPage(v)
+	}
+}
+
 func (p *ListStaticRoutesParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1428,6 +2755,13 @@ func (p *ListStaticRoutesParams) SetPagesize(v int) {
 	return
 }
 
+// SetPagesizeIfUnset sets pagesize to v, unless it is already set
+func (p *ListStaticRoutesParams) SetPagesizeIfUnset(v int) {
+	if _, found := p.p["pagesize"]; !found {
+		p.SetPagesize(v)
+	}
+}
+
 func (p *ListStaticRoutesParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1436,6 +2770,13 @@ func (p *ListStaticRoutesParams) SetProjectid(v string) {
 	return
 }
 
+// SetProjectidIfUnset sets projectid to v, unless it is already set
+func (p *ListStaticRoutesParams) SetProjectidIfUnset(v string) {
+	if _, found := p.p["projectid"]; !found {
+		p.SetProjectid(v)
+	}
+}
+
 func (p *ListStaticRoutesParams) SetTags(v map[string]string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1444,6 +2785,13 @@ func (p *ListStaticRoutesParams) SetTags(v map[string]string) {
 	return
 }
 
+// SetTagsIfUnset sets tags to v, unless it is already set
+func (p *ListStaticRoutesParams) SetTagsIfUnset(v map[string]string) {
+	if _, found := p.p["tags"]; !found {
+		p.SetTags(v)
+	}
+}
+
 func (p *ListStaticRoutesParams) SetVpcid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1452,14 +2800,34 @@ func (p *ListStaticRoutesParams) SetVpcid(v string) {
 	return
 }
 
+// SetVpcidIfUnset sets vpcid to v, unless it is already set
+func (p *ListStaticRoutesParams) SetVpcidIfUnset(v string) {
+	if _, found := p.p["vpcid"]; !found {
+		p.SetVpcid(v)
+	}
+}
+
+var _ ListAllSetter = (*ListStaticRoutesParams)(nil)
+
 // You should always use this function to get a new ListStaticRoutesParams instance,
-// as then you are sure you have configured all required params
-func (s *VPCService) NewListStaticRoutesParams() *ListStaticRoutesParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *VPCService) NewListStaticRoutesParams(opts ...func(*ListStaticRoutesParams)) *ListStaticRoutesParams {
 	p := &ListStaticRoutesParams{}
 	p.p = make(map[string]interface{})
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
+func (p *ListStaticRoutesParams) setFirstMatch() {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p[firstMatchKey] = true
+}
+
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *VPCService) GetStaticRouteByID(id string, opts ...OptionFunc) (*StaticRoute, int, error) {
 	p := &ListStaticRoutesParams{}
@@ -1467,7 +2835,7 @@ func (s *VPCService) GetStaticRouteByID(id string, opts ...OptionFunc) (*StaticR
 
 	p.p["id"] = id
 
-	for _, fn := range append(s.cs.options, opts...) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
 		if err := fn(s.cs, p); err != nil {
 			return nil, -1, err
 		}
@@ -1478,24 +2846,56 @@ func (s *VPCService) GetStaticRouteByID(id string, opts ...OptionFunc) (*StaticR
 		if strings.Contains(err.Error(), fmt.Sprintf(
 			"Invalid parameter id value=%s due to incorrect long value format, "+
 				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+			return nil, 0, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
 	}
 
 	if l.Count == 1 {
 		return l.StaticRoutes[0], l.Count, nil
 	}
+
+	if _, ok := p.p[firstMatchKey]; ok && len(l.StaticRoutes) > 0 {
+		return l.StaticRoutes[0], l.Count, nil
+	}
 	return nil, l.Count, fmt.Errorf("There is more then one result for StaticRoute UUID: %s!", id)
 }
 
+// StaticRouteExists returns true if a StaticRoute with the given ID exists. When no match is found
+// it returns false, while any other error is returned as is.
+func (s *VPCService) StaticRouteExists(id string, opts ...OptionFunc) (bool, error) {
+	_, count, err := s.GetStaticRouteByID(id, opts...)
+	if errors.Is(err, ErrNotFound) {
+		return false, nil
+	}
+	if count > 0 {
+		return true, nil
+	}
+	return false, err
+}
+
 // Lists all static routes
 func (s *VPCService) ListStaticRoutes(p *ListStaticRoutesParams) (*ListStaticRoutesResponse, error) {
-	resp, err := s.cs.newRequest("listStaticRoutes", p.toURLValues())
+	return s.ListStaticRoutesWithContext(context.Background(), p)
+}
+
+// ListStaticRoutesWithContext is the same as ListStaticRoutes, but the request is cancelled when the context is done
+func (s *VPCService) ListStaticRoutesWithContext(ctx context.Context, p *ListStaticRoutesParams) (*ListStaticRoutesResponse, error) {
+	return s.ListStaticRoutesRawWithContext(ctx, p.toURLValues())
+}
+
+// ListStaticRoutesRaw is the same as ListStaticRoutes, but takes the params as url.Values instead of typed params
+func (s *VPCService) ListStaticRoutesRaw(v url.Values) (*ListStaticRoutesResponse, error) {
+	return s.ListStaticRoutesRawWithContext(context.Background(), v)
+}
+
+// ListStaticRoutesRawWithContext is the same as ListStaticRoutesRaw, but the request is cancelled when the context is done
+func (s *VPCService) ListStaticRoutesRawWithContext(ctx context.Context, v url.Values) (*ListStaticRoutesResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "listStaticRoutes", "liststaticroutesresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -1508,6 +2908,93 @@ func (s *VPCService) ListStaticRoutes(p *ListStaticRoutesParams) (*ListStaticRou
 	return &r, nil
 }
 
+// StreamStaticRoutes fetches the results of ListStaticRoutes page by page in the background and sends them on the
+// returned channel. Both returned channels are closed when done. If fetching a page fails, or the context
+// is done before all results are sent, the error is sent on the error channel and fetching stops. A
+// request for a page in flight is cancelled when the context is done. The page and pagesize set in the
+// params are ignored.
+func (s *VPCService) StreamStaticRoutes(ctx context.Context, p *ListStaticRoutesParams) (<-chan *StaticRoute, <-chan error) {
+	ch := make(chan *StaticRoute)
+	errs := make(chan error, 1)
+
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesize(500)
+
+	go func() {
+		defer close(errs)
+		defer close(ch)
+
+		for page, count := 1, 0; ; page++ {
+			pp.SetPage(page)
+
+			l, err := s.ListStaticRoutesWithContext(ctx, pp)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			for _, v := range l.StaticRoutes {
+				select {
+				case ch <- v:
+				case <-ctx.Done():
+					errs <- ctx.Err()
+					return
+				}
+			}
+
+			count += len(l.StaticRoutes)
+			if len(l.StaticRoutes) < 500 || count >= l.Count {
+				return
+			}
+		}
+	}()
+
+	return ch, errs
+}
+
+// ListStaticRoutesWhere returns all results of ListStaticRoutes for which pred returns true. Note that the results are
+// filtered client-side, so all results matching the params are fetched first.
+// All pages are fetched, so the page and pagesize set in the params are ignored.
+func (s *VPCService) ListStaticRoutesWhere(p *ListStaticRoutesParams, pred func(*StaticRoute) bool) ([]*StaticRoute, error) {
+	var r []*StaticRoute
+	ch, errs := s.StreamStaticRoutes(context.Background(), p)
+	for v := range ch {
+		if pred(v) {
+			r = append(r, v)
+		}
+	}
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ListAllStaticRoutes returns the results of ListStaticRoutes across all pages, by calling ListStaticRoutes page by page until all
+// results are fetched. The pagesize set in the params is used for every page (500 if not set), and the
+// page set in the params is ignored.
+func (s *VPCService) ListAllStaticRoutes(p *ListStaticRoutesParams) ([]*StaticRoute, error) {
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesizeIfUnset(500)
+	pagesize := pp.p["pagesize"].(int)
+
+	var r []*StaticRoute
+	for page := 1; ; page++ {
+		pp.SetPage(page)
+
+		l, err := s.ListStaticRoutes(pp)
+		if err != nil {
+			return nil, err
+		}
+		r = append(r, l.StaticRoutes...)
+
+		if len(l.StaticRoutes) < pagesize || len(r) >= l.Count {
+			return r, nil
+		}
+	}
+}
+
 type ListStaticRoutesResponse struct {
 	Count        int            `json:"count"`
 	StaticRoutes []*StaticRoute `json:"staticroute"`
@@ -1542,6 +3029,16 @@ type ListVPCOfferingsParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ListVPCOfferingsParams) Clone() *ListVPCOfferingsParams {
+	c := &ListVPCOfferingsParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListVPCOfferingsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1589,6 +3086,13 @@ func (p *ListVPCOfferingsParams) SetDisplaytext(v string) {
 	return
 }
 
+// SetDisplaytextIfUnset sets displaytext to v, unless it is already set
+func (p *ListVPCOfferingsParams) SetDisplaytextIfUnset(v string) {
+	if _, found := p.p["displaytext"]; !found {
+		p.SetDisplaytext(v)
+	}
+}
+
 func (p *ListVPCOfferingsParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1597,6 +3101,13 @@ func (p *ListVPCOfferingsParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *ListVPCOfferingsParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 func (p *ListVPCOfferingsParams) SetIsdefault(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1605,6 +3116,13 @@ func (p *ListVPCOfferingsParams) SetIsdefault(v bool) {
 	return
 }
 
+// SetIsdefaultIfUnset sets isdefault to v, unless it is already set
+func (p *ListVPCOfferingsParams) SetIsdefaultIfUnset(v bool) {
+	if _, found := p.p["isdefault"]; !found {
+		p.SetIsdefault(v)
+	}
+}
+
 func (p *ListVPCOfferingsParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1613,6 +3131,13 @@ func (p *ListVPCOfferingsParams) SetKeyword(v string) {
 	return
 }
 
+// SetKeywordIfUnset sets keyword to v, unless it is already set
+func (p *ListVPCOfferingsParams) SetKeywordIfUnset(v string) {
+	if _, found := p.p["keyword"]; !found {
+		p.SetKeyword(v)
+	}
+}
+
 func (p *ListVPCOfferingsParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1621,6 +3146,13 @@ func (p *ListVPCOfferingsParams) SetName(v string) {
 	return
 }
 
+// SetNameIfUnset sets name to v, unless it is already set
+func (p *ListVPCOfferingsParams) SetNameIfUnset(v string) {
+	if _, found := p.p["name"]; !found {
+		p.SetName(v)
+	}
+}
+
 func (p *ListVPCOfferingsParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1629,6 +3161,13 @@ func (p *ListVPCOfferingsParams) SetPage(v int) {
 	return
 }
 
+// SetPageIfUnset sets page to v, unless it is already set
+func (p *ListVPCOfferingsParams) SetPageIfUnset(v int) {
+	if _, found := p.p["page"]; !found {
+		p.SetPage(v)
+	}
+}
+
 func (p *ListVPCOfferingsParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1637,6 +3176,13 @@ func (p *ListVPCOfferingsParams) SetPagesize(v int) {
 	return
 }
 
+// SetPagesizeIfUnset sets pagesize to v, unless it is already set
+func (p *ListVPCOfferingsParams) SetPagesizeIfUnset(v int) {
+	if _, found := p.p["pagesize"]; !found {
+		p.SetPagesize(v)
+	}
+}
+
 func (p *ListVPCOfferingsParams) SetState(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1645,6 +3191,13 @@ func (p *ListVPCOfferingsParams) SetState(v string) {
 	return
 }
 
+// SetStateIfUnset sets state to v, unless it is already set
+func (p *ListVPCOfferingsParams) SetStateIfUnset(v string) {
+	if _, found := p.p["state"]; !found {
+		p.SetState(v)
+	}
+}
+
 func (p *ListVPCOfferingsParams) SetSupportedservices(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1653,14 +3206,37 @@ func (p *ListVPCOfferingsParams) SetSupportedservices(v []string) {
 	return
 }
 
+// SetSupportedservicesIfUnset sets supportedservices to v, unless it is already set
+func (p *ListVPCOfferingsParams) SetSupportedservicesIfUnset(v []string) {
+	if _, found := p.p["supportedservices"]; !found {
+		p.SetSupportedservices(v)
+	}
+}
+
+// SetSupportedservicesCSV splits v on commas and sets the resulting values
+func (p *ListVPCOfferingsParams) SetSupportedservicesCSV(v string) {
+	p.SetSupportedservices(splitCSV(v))
+}
+
 // You should always use this function to get a new ListVPCOfferingsParams instance,
-// as then you are sure you have configured all required params
-func (s *VPCService) NewListVPCOfferingsParams() *ListVPCOfferingsParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *VPCService) NewListVPCOfferingsParams(opts ...func(*ListVPCOfferingsParams)) *ListVPCOfferingsParams {
 	p := &ListVPCOfferingsParams{}
 	p.p = make(map[string]interface{})
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
+func (p *ListVPCOfferingsParams) setFirstMatch() {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p[firstMatchKey] = true
+}
+
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *VPCService) GetVPCOfferingID(name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListVPCOfferingsParams{}
@@ -1668,7 +3244,7 @@ func (s *VPCService) GetVPCOfferingID(name string, opts ...OptionFunc) (string,
 
 	p.p["name"] = name
 
-	for _, fn := range append(s.cs.options, opts...) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
 		if err := fn(s.cs, p); err != nil {
 			return "", -1, err
 		}
@@ -1680,7 +3256,7 @@ func (s *VPCService) GetVPCOfferingID(name string, opts ...OptionFunc) (string,
 	}
 
 	if l.Count == 0 {
-		return "", l.Count, fmt.Errorf("No match found for %s: %+v", name, l)
+		return "", l.Count, fmt.Errorf("%w for %s: %+v", ErrNotFound, name, l)
 	}
 
 	if l.Count == 1 {
@@ -1693,6 +3269,10 @@ func (s *VPCService) GetVPCOfferingID(name string, opts ...OptionFunc) (string,
 				return v.Id, l.Count, nil
 			}
 		}
+
+		if _, ok := p.p[firstMatchKey]; ok && len(l.VPCOfferings) > 0 {
+			return l.VPCOfferings[0].Id, l.Count, nil
+		}
 	}
 	return "", l.Count, fmt.Errorf("Could not find an exact match for %s: %+v", name, l)
 }
@@ -1718,7 +3298,7 @@ func (s *VPCService) GetVPCOfferingByID(id string, opts ...OptionFunc) (*VPCOffe
 
 	p.p["id"] = id
 
-	for _, fn := range append(s.cs.options, opts...) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
 		if err := fn(s.cs, p); err != nil {
 			return nil, -1, err
 		}
@@ -1729,24 +3309,56 @@ func (s *VPCService) GetVPCOfferingByID(id string, opts ...OptionFunc) (*VPCOffe
 		if strings.Contains(err.Error(), fmt.Sprintf(
 			"Invalid parameter id value=%s due to incorrect long value format, "+
 				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+			return nil, 0, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
+	}
+
+	if l.Count == 1 {
+		return l.VPCOfferings[0], l.Count, nil
 	}
 
-	if l.Count == 1 {
+	if _, ok := p.p[firstMatchKey]; ok && len(l.VPCOfferings) > 0 {
 		return l.VPCOfferings[0], l.Count, nil
 	}
 	return nil, l.Count, fmt.Errorf("There is more then one result for VPCOffering UUID: %s!", id)
 }
 
+// VPCOfferingExists returns true if a VPCOffering with the given ID exists. When no match is found
+// it returns false, while any other error is returned as is.
+func (s *VPCService) VPCOfferingExists(id string, opts ...OptionFunc) (bool, error) {
+	_, count, err := s.GetVPCOfferingByID(id, opts...)
+	if errors.Is(err, ErrNotFound) {
+		return false, nil
+	}
+	if count > 0 {
+		return true, nil
+	}
+	return false, err
+}
+
 // Lists VPC offerings
 func (s *VPCService) ListVPCOfferings(p *ListVPCOfferingsParams) (*ListVPCOfferingsResponse, error) {
-	resp, err := s.cs.newRequest("listVPCOfferings", p.toURLValues())
+	return s.ListVPCOfferingsWithContext(context.Background(), p)
+}
+
+// ListVPCOfferingsWithContext is the same as ListVPCOfferings, but the request is cancelled when the context is done
+func (s *VPCService) ListVPCOfferingsWithContext(ctx context.Context, p *ListVPCOfferingsParams) (*ListVPCOfferingsResponse, error) {
+	return s.ListVPCOfferingsRawWithContext(ctx, p.toURLValues())
+}
+
+// ListVPCOfferingsRaw is the same as ListVPCOfferings, but takes the params as url.Values instead of typed params
+func (s *VPCService) ListVPCOfferingsRaw(v url.Values) (*ListVPCOfferingsResponse, error) {
+	return s.ListVPCOfferingsRawWithContext(context.Background(), v)
+}
+
+// ListVPCOfferingsRawWithContext is the same as ListVPCOfferingsRaw, but the request is cancelled when the context is done
+func (s *VPCService) ListVPCOfferingsRawWithContext(ctx context.Context, v url.Values) (*ListVPCOfferingsResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "listVPCOfferings", "listvpcofferingsresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -1759,6 +3371,93 @@ func (s *VPCService) ListVPCOfferings(p *ListVPCOfferingsParams) (*ListVPCOfferi
 	return &r, nil
 }
 
+// StreamVPCOfferings fetches the results of ListVPCOfferings page by page in the background and sends them on the
+// returned channel. Both returned channels are closed when done. If fetching a page fails, or the context
+// is done before all results are sent, the error is sent on the error channel and fetching stops. A
+// request for a page in flight is cancelled when the context is done. The page and pagesize set in the
+// params are ignored.
+func (s *VPCService) StreamVPCOfferings(ctx context.Context, p *ListVPCOfferingsParams) (<-chan *VPCOffering, <-chan error) {
+	ch := make(chan *VPCOffering)
+	errs := make(chan error, 1)
+
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesize(500)
+
+	go func() {
+		defer close(errs)
+		defer close(ch)
+
+		for page, count := 1, 0; ; page++ {
+			pp.SetPage(page)
+
+			l, err := s.ListVPCOfferingsWithContext(ctx, pp)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			for _, v := range l.VPCOfferings {
+				select {
+				case ch <- v:
+				case <-ctx.Done():
+					errs <- ctx.Err()
+					return
+				}
+			}
+
+			count += len(l.VPCOfferings)
+			if len(l.VPCOfferings) < 500 || count >= l.Count {
+				return
+			}
+		}
+	}()
+
+	return ch, errs
+}
+
+// ListVPCOfferingsWhere returns all results of ListVPCOfferings for which pred returns true. Note that the results are
+// filtered client-side, so all results matching the params are fetched first.
+// All pages are fetched, so the page and pagesize set in the params are ignored.
+func (s *VPCService) ListVPCOfferingsWhere(p *ListVPCOfferingsParams, pred func(*VPCOffering) bool) ([]*VPCOffering, error) {
+	var r []*VPCOffering
+	ch, errs := s.StreamVPCOfferings(context.Background(), p)
+	for v := range ch {
+		if pred(v) {
+			r = append(r, v)
+		}
+	}
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ListAllVPCOfferings returns the results of ListVPCOfferings across all pages, by calling ListVPCOfferings page by page until all
+// results are fetched. The pagesize set in the params is used for every page (500 if not set), and the
+// page set in the params is ignored.
+func (s *VPCService) ListAllVPCOfferings(p *ListVPCOfferingsParams) ([]*VPCOffering, error) {
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesizeIfUnset(500)
+	pagesize := pp.p["pagesize"].(int)
+
+	var r []*VPCOffering
+	for page := 1; ; page++ {
+		pp.SetPage(page)
+
+		l, err := s.ListVPCOfferings(pp)
+		if err != nil {
+			return nil, err
+		}
+		r = append(r, l.VPCOfferings...)
+
+		if len(l.VPCOfferings) < pagesize || len(r) >= l.Count {
+			return r, nil
+		}
+	}
+}
+
 type ListVPCOfferingsResponse struct {
 	Count        int            `json:"count"`
 	VPCOfferings []*VPCOffering `json:"vpcoffering"`
@@ -1796,6 +3495,16 @@ type ListVPCsParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ListVPCsParams) Clone() *ListVPCsParams {
+	c := &ListVPCsParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListVPCsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1857,11 +3566,10 @@ func (p *ListVPCsParams) toURLValues() url.Values {
 		u.Set("supportedservices", vv)
 	}
 	if v, found := p.p["tags"]; found {
-		i := 0
-		for k, vv := range v.(map[string]string) {
+		m := v.(map[string]string)
+		for i, k := range getSortedKeysFromMap(m) {
 			u.Set(fmt.Sprintf("tags[%d].key", i), k)
-			u.Set(fmt.Sprintf("tags[%d].value", i), vv)
-			i++
+			u.Set(fmt.Sprintf("tags[%d].value", i), m[k])
 		}
 	}
 	if v, found := p.p["vpcofferingid"]; found {
@@ -1881,6 +3589,13 @@ func (p *ListVPCsParams) SetAccount(v string) {
 	return
 }
 
+// SetAccountIfUnset sets account to v, unless it is already set
+func (p *ListVPCsParams) SetAccountIfUnset(v string) {
+	if _, found := p.p["account"]; !found {
+		p.SetAccount(v)
+	}
+}
+
 func (p *ListVPCsParams) SetCidr(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1889,6 +3604,13 @@ func (p *ListVPCsParams) SetCidr(v string) {
 	return
 }
 
+// SetCidrIfUnset sets cidr to v, unless it is already set
+func (p *ListVPCsParams) SetCidrIfUnset(v string) {
+	if _, found := p.p["cidr"]; !found {
+		p.SetCidr(v)
+	}
+}
+
 func (p *ListVPCsParams) SetDisplaytext(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1897,6 +3619,13 @@ func (p *ListVPCsParams) SetDisplaytext(v string) {
 	return
 }
 
+// SetDisplaytextIfUnset sets displaytext to v, unless it is already set
+func (p *ListVPCsParams) SetDisplaytextIfUnset(v string) {
+	if _, found := p.p["displaytext"]; !found {
+		p.SetDisplaytext(v)
+	}
+}
+
 func (p *ListVPCsParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1905,6 +3634,13 @@ func (p *ListVPCsParams) SetDomainid(v string) {
 	return
 }
 
+// SetDomainidIfUnset sets domainid to v, unless it is already set
+func (p *ListVPCsParams) SetDomainidIfUnset(v string) {
+	if _, found := p.p["domainid"]; !found {
+		p.SetDomainid(v)
+	}
+}
+
 func (p *ListVPCsParams) SetFordisplay(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1913,6 +3649,13 @@ func (p *ListVPCsParams) SetFordisplay(v bool) {
 	return
 }
 
+// SetFordisplayIfUnset sets fordisplay to v, unless it is already set
+func (p *ListVPCsParams) SetFordisplayIfUnset(v bool) {
+	if _, found := p.p["fordisplay"]; !found {
+		p.SetFordisplay(v)
+	}
+}
+
 func (p *ListVPCsParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1921,6 +3664,13 @@ func (p *ListVPCsParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *ListVPCsParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 func (p *ListVPCsParams) SetIsrecursive(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1929,6 +3679,13 @@ func (p *ListVPCsParams) SetIsrecursive(v bool) {
 	return
 }
 
+// SetIsrecursiveIfUnset sets isrecursive to v, unless it is already set
+func (p *ListVPCsParams) SetIsrecursiveIfUnset(v bool) {
+	if _, found := p.p["isrecursive"]; !found {
+		p.SetIsrecursive(v)
+	}
+}
+
 func (p *ListVPCsParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1937,6 +3694,13 @@ func (p *ListVPCsParams) SetKeyword(v string) {
 	return
 }
 
+// SetKeywordIfUnset sets keyword to v, unless it is already set
+func (p *ListVPCsParams) SetKeywordIfUnset(v string) {
+	if _, found := p.p["keyword"]; !found {
+		p.SetKeyword(v)
+	}
+}
+
 func (p *ListVPCsParams) SetListall(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1945,6 +3709,13 @@ func (p *ListVPCsParams) SetListall(v bool) {
 	return
 }
 
+// SetListallIfUnset sets listall to v, unless it is already set
+func (p *ListVPCsParams) SetListallIfUnset(v bool) {
+	if _, found := p.p["listall"]; !found {
+		p.SetListall(v)
+	}
+}
+
 func (p *ListVPCsParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1953,6 +3724,13 @@ func (p *ListVPCsParams) SetName(v string) {
 	return
 }
 
+// SetNameIfUnset sets name to v, unless it is already set
+func (p *ListVPCsParams) SetNameIfUnset(v string) {
+	if _, found := p.p["name"]; !found {
+		p.SetName(v)
+	}
+}
+
 func (p *ListVPCsParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1961,6 +3739,13 @@ func (p *ListVPCsParams) SetPage(v int) {
 	return
 }
 
+// SetPageIfUnset sets page to v, unless it is already set
+func (p *ListVPCsParams) SetPageIfUnset(v int) {
+	if _, found := p.p["page"]; !found {
+		p.SetPage(v)
+	}
+}
+
 func (p *ListVPCsParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1969,6 +3754,13 @@ func (p *ListVPCsParams) SetPagesize(v int) {
 	return
 }
 
+// SetPagesizeIfUnset sets pagesize to v, unless it is already set
+func (p *ListVPCsParams) SetPagesizeIfUnset(v int) {
+	if _, found := p.p["pagesize"]; !found {
+		p.SetPagesize(v)
+	}
+}
+
 func (p *ListVPCsParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1977,6 +3769,13 @@ func (p *ListVPCsParams) SetProjectid(v string) {
 	return
 }
 
+// SetProjectidIfUnset sets projectid to v, unless it is already set
+func (p *ListVPCsParams) SetProjectidIfUnset(v string) {
+	if _, found := p.p["projectid"]; !found {
+		p.SetProjectid(v)
+	}
+}
+
 func (p *ListVPCsParams) SetRestartrequired(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1985,6 +3784,13 @@ func (p *ListVPCsParams) SetRestartrequired(v bool) {
 	return
 }
 
+// SetRestartrequiredIfUnset sets restartrequired to v, unless it is already set
+func (p *ListVPCsParams) SetRestartrequiredIfUnset(v bool) {
+	if _, found := p.p["restartrequired"]; !found {
+		p.SetRestartrequired(v)
+	}
+}
+
 func (p *ListVPCsParams) SetState(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1993,6 +3799,13 @@ func (p *ListVPCsParams) SetState(v string) {
 	return
 }
 
+// SetStateIfUnset sets state to v, unless it is already set
+func (p *ListVPCsParams) SetStateIfUnset(v string) {
+	if _, found := p.p["state"]; !found {
+		p.SetState(v)
+	}
+}
+
 func (p *ListVPCsParams) SetSupportedservices(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2001,6 +3814,18 @@ func (p *ListVPCsParams) SetSupportedservices(v []string) {
 	return
 }
 
+// SetSupportedservicesIfUnset sets supportedservices to v, unless it is already set
+func (p *ListVPCsParams) SetSupportedservicesIfUnset(v []string) {
+	if _, found := p.p["supportedservices"]; !found {
+		p.SetSupportedservices(v)
+	}
+}
+
+// SetSupportedservicesCSV splits v on commas and sets the resulting values
+func (p *ListVPCsParams) SetSupportedservicesCSV(v string) {
+	p.SetSupportedservices(splitCSV(v))
+}
+
 func (p *ListVPCsParams) SetTags(v map[string]string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2009,6 +3834,13 @@ func (p *ListVPCsParams) SetTags(v map[string]string) {
 	return
 }
 
+// SetTagsIfUnset sets tags to v, unless it is already set
+func (p *ListVPCsParams) SetTagsIfUnset(v map[string]string) {
+	if _, found := p.p["tags"]; !found {
+		p.SetTags(v)
+	}
+}
+
 func (p *ListVPCsParams) SetVpcofferingid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2017,6 +3849,13 @@ func (p *ListVPCsParams) SetVpcofferingid(v string) {
 	return
 }
 
+// SetVpcofferingidIfUnset sets vpcofferingid to v, unless it is already set
+func (p *ListVPCsParams) SetVpcofferingidIfUnset(v string) {
+	if _, found := p.p["vpcofferingid"]; !found {
+		p.SetVpcofferingid(v)
+	}
+}
+
 func (p *ListVPCsParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2025,14 +3864,34 @@ func (p *ListVPCsParams) SetZoneid(v string) {
 	return
 }
 
+// SetZoneidIfUnset sets zoneid to v, unless it is already set
+func (p *ListVPCsParams) SetZoneidIfUnset(v string) {
+	if _, found := p.p["zoneid"]; !found {
+		p.SetZoneid(v)
+	}
+}
+
+var _ ListAllSetter = (*ListVPCsParams)(nil)
+
 // You should always use this function to get a new ListVPCsParams instance,
-// as then you are sure you have configured all required params
-func (s *VPCService) NewListVPCsParams() *ListVPCsParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *VPCService) NewListVPCsParams(opts ...func(*ListVPCsParams)) *ListVPCsParams {
 	p := &ListVPCsParams{}
 	p.p = make(map[string]interface{})
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
+func (p *ListVPCsParams) setFirstMatch() {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p[firstMatchKey] = true
+}
+
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *VPCService) GetVPCID(name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListVPCsParams{}
@@ -2040,7 +3899,7 @@ func (s *VPCService) GetVPCID(name string, opts ...OptionFunc) (string, int, err
 
 	p.p["name"] = name
 
-	for _, fn := range append(s.cs.options, opts...) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
 		if err := fn(s.cs, p); err != nil {
 			return "", -1, err
 		}
@@ -2052,7 +3911,7 @@ func (s *VPCService) GetVPCID(name string, opts ...OptionFunc) (string, int, err
 	}
 
 	if l.Count == 0 {
-		return "", l.Count, fmt.Errorf("No match found for %s: %+v", name, l)
+		return "", l.Count, fmt.Errorf("%w for %s: %+v", ErrNotFound, name, l)
 	}
 
 	if l.Count == 1 {
@@ -2065,6 +3924,10 @@ func (s *VPCService) GetVPCID(name string, opts ...OptionFunc) (string, int, err
 				return v.Id, l.Count, nil
 			}
 		}
+
+		if _, ok := p.p[firstMatchKey]; ok && len(l.VPCs) > 0 {
+			return l.VPCs[0].Id, l.Count, nil
+		}
 	}
 	return "", l.Count, fmt.Errorf("Could not find an exact match for %s: %+v", name, l)
 }
@@ -2090,7 +3953,7 @@ func (s *VPCService) GetVPCByID(id string, opts ...OptionFunc) (*VPC, int, error
 
 	p.p["id"] = id
 
-	for _, fn := range append(s.cs.options, opts...) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
 		if err := fn(s.cs, p); err != nil {
 			return nil, -1, err
 		}
@@ -2101,24 +3964,56 @@ func (s *VPCService) GetVPCByID(id string, opts ...OptionFunc) (*VPC, int, error
 		if strings.Contains(err.Error(), fmt.Sprintf(
 			"Invalid parameter id value=%s due to incorrect long value format, "+
 				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+			return nil, 0, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
 	}
 
 	if l.Count == 1 {
 		return l.VPCs[0], l.Count, nil
 	}
+
+	if _, ok := p.p[firstMatchKey]; ok && len(l.VPCs) > 0 {
+		return l.VPCs[0], l.Count, nil
+	}
 	return nil, l.Count, fmt.Errorf("There is more then one result for VPC UUID: %s!", id)
 }
 
+// VPCExists returns true if a VPC with the given ID exists. When no match is found
+// it returns false, while any other error is returned as is.
+func (s *VPCService) VPCExists(id string, opts ...OptionFunc) (bool, error) {
+	_, count, err := s.GetVPCByID(id, opts...)
+	if errors.Is(err, ErrNotFound) {
+		return false, nil
+	}
+	if count > 0 {
+		return true, nil
+	}
+	return false, err
+}
+
 // Lists VPCs
 func (s *VPCService) ListVPCs(p *ListVPCsParams) (*ListVPCsResponse, error) {
-	resp, err := s.cs.newRequest("listVPCs", p.toURLValues())
+	return s.ListVPCsWithContext(context.Background(), p)
+}
+
+// ListVPCsWithContext is the same as ListVPCs, but the request is cancelled when the context is done
+func (s *VPCService) ListVPCsWithContext(ctx context.Context, p *ListVPCsParams) (*ListVPCsResponse, error) {
+	return s.ListVPCsRawWithContext(ctx, p.toURLValues())
+}
+
+// ListVPCsRaw is the same as ListVPCs, but takes the params as url.Values instead of typed params
+func (s *VPCService) ListVPCsRaw(v url.Values) (*ListVPCsResponse, error) {
+	return s.ListVPCsRawWithContext(context.Background(), v)
+}
+
+// ListVPCsRawWithContext is the same as ListVPCsRaw, but the request is cancelled when the context is done
+func (s *VPCService) ListVPCsRawWithContext(ctx context.Context, v url.Values) (*ListVPCsResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "listVPCs", "listvpcsresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -2131,6 +4026,93 @@ func (s *VPCService) ListVPCs(p *ListVPCsParams) (*ListVPCsResponse, error) {
 	return &r, nil
 }
 
+// StreamVPCs fetches the results of ListVPCs page by page in the background and sends them on the
+// returned channel. Both returned channels are closed when done. If fetching a page fails, or the context
+// is done before all results are sent, the error is sent on the error channel and fetching stops. A
+// request for a page in flight is cancelled when the context is done. The page and pagesize set in the
+// params are ignored.
+func (s *VPCService) StreamVPCs(ctx context.Context, p *ListVPCsParams) (<-chan *VPC, <-chan error) {
+	ch := make(chan *VPC)
+	errs := make(chan error, 1)
+
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesize(500)
+
+	go func() {
+		defer close(errs)
+		defer close(ch)
+
+		for page, count := 1, 0; ; page++ {
+			pp.SetPage(page)
+
+			l, err := s.ListVPCsWithContext(ctx, pp)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			for _, v := range l.VPCs {
+				select {
+				case ch <- v:
+				case <-ctx.Done():
+					errs <- ctx.Err()
+					return
+				}
+			}
+
+			count += len(l.VPCs)
+			if len(l.VPCs) < 500 || count >= l.Count {
+				return
+			}
+		}
+	}()
+
+	return ch, errs
+}
+
+// ListVPCsWhere returns all results of ListVPCs for which pred returns true. Note that the results are
+// filtered client-side, so all results matching the params are fetched first.
+// All pages are fetched, so the page and pagesize set in the params are ignored.
+func (s *VPCService) ListVPCsWhere(p *ListVPCsParams, pred func(*VPC) bool) ([]*VPC, error) {
+	var r []*VPC
+	ch, errs := s.StreamVPCs(context.Background(), p)
+	for v := range ch {
+		if pred(v) {
+			r = append(r, v)
+		}
+	}
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ListAllVPCs returns the results of ListVPCs across all pages, by calling ListVPCs page by page until all
+// results are fetched. The pagesize set in the params is used for every page (500 if not set), and the
+// page set in the params is ignored.
+func (s *VPCService) ListAllVPCs(p *ListVPCsParams) ([]*VPC, error) {
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesizeIfUnset(500)
+	pagesize := pp.p["pagesize"].(int)
+
+	var r []*VPC
+	for page := 1; ; page++ {
+		pp.SetPage(page)
+
+		l, err := s.ListVPCs(pp)
+		if err != nil {
+			return nil, err
+		}
+		r = append(r, l.VPCs...)
+
+		if len(l.VPCs) < pagesize || len(r) >= l.Count {
+			return r, nil
+		}
+	}
+}
+
 type ListVPCsResponse struct {
 	Count int    `json:"count"`
 	VPCs  []*VPC `json:"vpc"`
@@ -2269,6 +4251,16 @@ type RestartVPCParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *RestartVPCParams) Clone() *RestartVPCParams {
+	c := &RestartVPCParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *RestartVPCParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -2288,6 +4280,16 @@ func (p *RestartVPCParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *RestartVPCParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of restartVPC", k)
+		}
+	}
+	return nil
+}
+
 func (p *RestartVPCParams) SetCleanup(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2296,6 +4298,13 @@ func (p *RestartVPCParams) SetCleanup(v bool) {
 	return
 }
 
+// SetCleanupIfUnset sets cleanup to v, unless it is already set
+func (p *RestartVPCParams) SetCleanupIfUnset(v bool) {
+	if _, found := p.p["cleanup"]; !found {
+		p.SetCleanup(v)
+	}
+}
+
 func (p *RestartVPCParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2304,6 +4313,13 @@ func (p *RestartVPCParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *RestartVPCParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 func (p *RestartVPCParams) SetMakeredundant(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2312,18 +4328,54 @@ func (p *RestartVPCParams) SetMakeredundant(v bool) {
 	return
 }
 
+// SetMakeredundantIfUnset sets makeredundant to v, unless it is already set
+func (p *RestartVPCParams) SetMakeredundantIfUnset(v bool) {
+	if _, found := p.p["makeredundant"]; !found {
+		p.SetMakeredundant(v)
+	}
+}
+
 // You should always use this function to get a new RestartVPCParams instance,
-// as then you are sure you have configured all required params
-func (s *VPCService) NewRestartVPCParams(id string) *RestartVPCParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *VPCService) NewRestartVPCParams(id string, opts ...func(*RestartVPCParams)) *RestartVPCParams {
 	p := &RestartVPCParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Restarts a VPC
-func (s *VPCService) RestartVPC(p *RestartVPCParams) (*RestartVPCResponse, error) {
-	resp, err := s.cs.newRequest("restartVPC", p.toURLValues())
+func (s *VPCService) RestartVPC(p *RestartVPCParams, opts ...OptionFunc) (*RestartVPCResponse, error) {
+	return s.RestartVPCWithContext(context.Background(), p, opts...)
+}
+
+// RestartVPCWithContext is the same as RestartVPC, but the request is cancelled when the context is done
+func (s *VPCService) RestartVPCWithContext(ctx context.Context, p *RestartVPCParams, opts ...OptionFunc) (*RestartVPCResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.RestartVPCRawWithContext(ctx, p.toURLValues())
+}
+
+// RestartVPCRaw is the same as RestartVPC, but takes the params as url.Values instead of typed params
+func (s *VPCService) RestartVPCRaw(v url.Values) (*RestartVPCResponse, error) {
+	return s.RestartVPCRawWithContext(context.Background(), v)
+}
+
+// RestartVPCRawWithContext is the same as RestartVPCRaw, but the request is cancelled when the context is done
+func (s *VPCService) RestartVPCRawWithContext(ctx context.Context, v url.Values) (*RestartVPCResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "restartVPC", "restartvpcresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -2335,9 +4387,9 @@ func (s *VPCService) RestartVPC(p *RestartVPCParams) (*RestartVPCResponse, error
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -2490,6 +4542,16 @@ type UpdateVPCParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *UpdateVPCParams) Clone() *UpdateVPCParams {
+	c := &UpdateVPCParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *UpdateVPCParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -2514,6 +4576,16 @@ func (p *UpdateVPCParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *UpdateVPCParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of updateVPC", k)
+		}
+	}
+	return nil
+}
+
 func (p *UpdateVPCParams) SetCustomid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2522,6 +4594,13 @@ func (p *UpdateVPCParams) SetCustomid(v string) {
 	return
 }
 
+// SetCustomidIfUnset sets customid to v, unless it is already set
+func (p *UpdateVPCParams) SetCustomidIfUnset(v string) {
+	if _, found := p.p["customid"]; !found {
+		p.SetCustomid(v)
+	}
+}
+
 func (p *UpdateVPCParams) SetDisplaytext(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2530,6 +4609,13 @@ func (p *UpdateVPCParams) SetDisplaytext(v string) {
 	return
 }
 
+// SetDisplaytextIfUnset sets displaytext to v, unless it is already set
+func (p *UpdateVPCParams) SetDisplaytextIfUnset(v string) {
+	if _, found := p.p["displaytext"]; !found {
+		p.SetDisplaytext(v)
+	}
+}
+
 func (p *UpdateVPCParams) SetFordisplay(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2538,6 +4624,13 @@ func (p *UpdateVPCParams) SetFordisplay(v bool) {
 	return
 }
 
+// SetFordisplayIfUnset sets fordisplay to v, unless it is already set
+func (p *UpdateVPCParams) SetFordisplayIfUnset(v bool) {
+	if _, found := p.p["fordisplay"]; !found {
+		p.SetFordisplay(v)
+	}
+}
+
 func (p *UpdateVPCParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2546,6 +4639,13 @@ func (p *UpdateVPCParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *UpdateVPCParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 func (p *UpdateVPCParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2554,18 +4654,54 @@ func (p *UpdateVPCParams) SetName(v string) {
 	return
 }
 
+// SetNameIfUnset sets name to v, unless it is already set
+func (p *UpdateVPCParams) SetNameIfUnset(v string) {
+	if _, found := p.p["name"]; !found {
+		p.SetName(v)
+	}
+}
+
 // You should always use this function to get a new UpdateVPCParams instance,
-// as then you are sure you have configured all required params
-func (s *VPCService) NewUpdateVPCParams(id string) *UpdateVPCParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *VPCService) NewUpdateVPCParams(id string, opts ...func(*UpdateVPCParams)) *UpdateVPCParams {
 	p := &UpdateVPCParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Updates a VPC
-func (s *VPCService) UpdateVPC(p *UpdateVPCParams) (*UpdateVPCResponse, error) {
-	resp, err := s.cs.newRequest("updateVPC", p.toURLValues())
+func (s *VPCService) UpdateVPC(p *UpdateVPCParams, opts ...OptionFunc) (*UpdateVPCResponse, error) {
+	return s.UpdateVPCWithContext(context.Background(), p, opts...)
+}
+
+// UpdateVPCWithContext is the same as UpdateVPC, but the request is cancelled when the context is done
+func (s *VPCService) UpdateVPCWithContext(ctx context.Context, p *UpdateVPCParams, opts ...OptionFunc) (*UpdateVPCResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.UpdateVPCRawWithContext(ctx, p.toURLValues())
+}
+
+// UpdateVPCRaw is the same as UpdateVPC, but takes the params as url.Values instead of typed params
+func (s *VPCService) UpdateVPCRaw(v url.Values) (*UpdateVPCResponse, error) {
+	return s.UpdateVPCRawWithContext(context.Background(), v)
+}
+
+// UpdateVPCRawWithContext is the same as UpdateVPCRaw, but the request is cancelled when the context is done
+func (s *VPCService) UpdateVPCRawWithContext(ctx context.Context, v url.Values) (*UpdateVPCResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "updateVPC", "updatevpcresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -2577,9 +4713,9 @@ func (s *VPCService) UpdateVPC(p *UpdateVPCParams) (*UpdateVPCResponse, error) {
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -2732,6 +4868,16 @@ type UpdateVPCOfferingParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *UpdateVPCOfferingParams) Clone() *UpdateVPCOfferingParams {
+	c := &UpdateVPCOfferingParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *UpdateVPCOfferingParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -2752,6 +4898,16 @@ func (p *UpdateVPCOfferingParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *UpdateVPCOfferingParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of updateVPCOffering", k)
+		}
+	}
+	return nil
+}
+
 func (p *UpdateVPCOfferingParams) SetDisplaytext(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2760,6 +4916,13 @@ func (p *UpdateVPCOfferingParams) SetDisplaytext(v string) {
 	return
 }
 
+// SetDisplaytextIfUnset sets displaytext to v, unless it is already set
+func (p *UpdateVPCOfferingParams) SetDisplaytextIfUnset(v string) {
+	if _, found := p.p["displaytext"]; !found {
+		p.SetDisplaytext(v)
+	}
+}
+
 func (p *UpdateVPCOfferingParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2768,6 +4931,13 @@ func (p *UpdateVPCOfferingParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *UpdateVPCOfferingParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 func (p *UpdateVPCOfferingParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2776,6 +4946,13 @@ func (p *UpdateVPCOfferingParams) SetName(v string) {
 	return
 }
 
+// SetNameIfUnset sets name to v, unless it is already set
+func (p *UpdateVPCOfferingParams) SetNameIfUnset(v string) {
+	if _, found := p.p["name"]; !found {
+		p.SetName(v)
+	}
+}
+
 func (p *UpdateVPCOfferingParams) SetState(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2784,18 +4961,54 @@ func (p *UpdateVPCOfferingParams) SetState(v string) {
 	return
 }
 
+// SetStateIfUnset sets state to v, unless it is already set
+func (p *UpdateVPCOfferingParams) SetStateIfUnset(v string) {
+	if _, found := p.p["state"]; !found {
+		p.SetState(v)
+	}
+}
+
 // You should always use this function to get a new UpdateVPCOfferingParams instance,
-// as then you are sure you have configured all required params
-func (s *VPCService) NewUpdateVPCOfferingParams(id string) *UpdateVPCOfferingParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *VPCService) NewUpdateVPCOfferingParams(id string, opts ...func(*UpdateVPCOfferingParams)) *UpdateVPCOfferingParams {
 	p := &UpdateVPCOfferingParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Updates VPC offering
-func (s *VPCService) UpdateVPCOffering(p *UpdateVPCOfferingParams) (*UpdateVPCOfferingResponse, error) {
-	resp, err := s.cs.newRequest("updateVPCOffering", p.toURLValues())
+func (s *VPCService) UpdateVPCOffering(p *UpdateVPCOfferingParams, opts ...OptionFunc) (*UpdateVPCOfferingResponse, error) {
+	return s.UpdateVPCOfferingWithContext(context.Background(), p, opts...)
+}
+
+// UpdateVPCOfferingWithContext is the same as UpdateVPCOffering, but the request is cancelled when the context is done
+func (s *VPCService) UpdateVPCOfferingWithContext(ctx context.Context, p *UpdateVPCOfferingParams, opts ...OptionFunc) (*UpdateVPCOfferingResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.UpdateVPCOfferingRawWithContext(ctx, p.toURLValues())
+}
+
+// UpdateVPCOfferingRaw is the same as UpdateVPCOffering, but takes the params as url.Values instead of typed params
+func (s *VPCService) UpdateVPCOfferingRaw(v url.Values) (*UpdateVPCOfferingResponse, error) {
+	return s.UpdateVPCOfferingRawWithContext(context.Background(), v)
+}
+
+// UpdateVPCOfferingRawWithContext is the same as UpdateVPCOfferingRaw, but the request is cancelled when the context is done
+func (s *VPCService) UpdateVPCOfferingRawWithContext(ctx context.Context, v url.Values) (*UpdateVPCOfferingResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "updateVPCOffering", "updatevpcofferingresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -2807,9 +5020,9 @@ func (s *VPCService) UpdateVPCOffering(p *UpdateVPCOfferingParams) (*UpdateVPCOf
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
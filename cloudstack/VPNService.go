@@ -17,17 +17,30 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/url"
 	"strconv"
 	"strings"
+	"time"
 )
 
 type AddVpnUserParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *AddVpnUserParams) Clone() *AddVpnUserParams {
+	c := &AddVpnUserParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *AddVpnUserParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -51,6 +64,16 @@ func (p *AddVpnUserParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *AddVpnUserParams) validate() error {
+	for _, k := range []string{"password", "username"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of addVpnUser", k)
+		}
+	}
+	return nil
+}
+
 func (p *AddVpnUserParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -59,6 +82,13 @@ func (p *AddVpnUserParams) SetAccount(v string) {
 	return
 }
 
+// SetAccountIfUnset sets account to v, unless it is already set
+func (p *AddVpnUserParams) SetAccountIfUnset(v string) {
+	if _, found := p.p["account"]; !found {
+		p.SetAccount(v)
+	}
+}
+
 func (p *AddVpnUserParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -67,6 +97,13 @@ func (p *AddVpnUserParams) SetDomainid(v string) {
 	return
 }
 
+// SetDomainidIfUnset sets domainid to v, unless it is already set
+func (p *AddVpnUserParams) SetDomainidIfUnset(v string) {
+	if _, found := p.p["domainid"]; !found {
+		p.SetDomainid(v)
+	}
+}
+
 func (p *AddVpnUserParams) SetPassword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -75,6 +112,13 @@ func (p *AddVpnUserParams) SetPassword(v string) {
 	return
 }
 
+// SetPasswordIfUnset sets password to v, unless it is already set
+func (p *AddVpnUserParams) SetPasswordIfUnset(v string) {
+	if _, found := p.p["password"]; !found {
+		p.SetPassword(v)
+	}
+}
+
 func (p *AddVpnUserParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -83,6 +127,13 @@ func (p *AddVpnUserParams) SetProjectid(v string) {
 	return
 }
 
+// SetProjectidIfUnset sets projectid to v, unless it is already set
+func (p *AddVpnUserParams) SetProjectidIfUnset(v string) {
+	if _, found := p.p["projectid"]; !found {
+		p.SetProjectid(v)
+	}
+}
+
 func (p *AddVpnUserParams) SetUsername(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -91,19 +142,55 @@ func (p *AddVpnUserParams) SetUsername(v string) {
 	return
 }
 
+// SetUsernameIfUnset sets username to v, unless it is already set
+func (p *AddVpnUserParams) SetUsernameIfUnset(v string) {
+	if _, found := p.p["username"]; !found {
+		p.SetUsername(v)
+	}
+}
+
 // You should always use this function to get a new AddVpnUserParams instance,
-// as then you are sure you have configured all required params
-func (s *VPNService) NewAddVpnUserParams(password string, username string) *AddVpnUserParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *VPNService) NewAddVpnUserParams(password string, username string, opts ...func(*AddVpnUserParams)) *AddVpnUserParams {
 	p := &AddVpnUserParams{}
 	p.p = make(map[string]interface{})
 	p.p["password"] = password
 	p.p["username"] = username
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Adds vpn users
-func (s *VPNService) AddVpnUser(p *AddVpnUserParams) (*AddVpnUserResponse, error) {
-	resp, err := s.cs.newRequest("addVpnUser", p.toURLValues())
+func (s *VPNService) AddVpnUser(p *AddVpnUserParams, opts ...OptionFunc) (*AddVpnUserResponse, error) {
+	return s.AddVpnUserWithContext(context.Background(), p, opts...)
+}
+
+// AddVpnUserWithContext is the same as AddVpnUser, but the request is cancelled when the context is done
+func (s *VPNService) AddVpnUserWithContext(ctx context.Context, p *AddVpnUserParams, opts ...OptionFunc) (*AddVpnUserResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.AddVpnUserRawWithContext(ctx, p.toURLValues())
+}
+
+// AddVpnUserRaw is the same as AddVpnUser, but takes the params as url.Values instead of typed params
+func (s *VPNService) AddVpnUserRaw(v url.Values) (*AddVpnUserResponse, error) {
+	return s.AddVpnUserRawWithContext(context.Background(), v)
+}
+
+// AddVpnUserRawWithContext is the same as AddVpnUserRaw, but the request is cancelled when the context is done
+func (s *VPNService) AddVpnUserRawWithContext(ctx context.Context, v url.Values) (*AddVpnUserResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "addVpnUser", "addvpnuserresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -115,9 +202,9 @@ func (s *VPNService) AddVpnUser(p *AddVpnUserParams) (*AddVpnUserResponse, error
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -152,6 +239,16 @@ type CreateRemoteAccessVpnParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *CreateRemoteAccessVpnParams) Clone() *CreateRemoteAccessVpnParams {
+	c := &CreateRemoteAccessVpnParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *CreateRemoteAccessVpnParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -180,6 +277,16 @@ func (p *CreateRemoteAccessVpnParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *CreateRemoteAccessVpnParams) validate() error {
+	for _, k := range []string{"publicipid"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of createRemoteAccessVpn", k)
+		}
+	}
+	return nil
+}
+
 func (p *CreateRemoteAccessVpnParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -188,6 +295,13 @@ func (p *CreateRemoteAccessVpnParams) SetAccount(v string) {
 	return
 }
 
+// SetAccountIfUnset sets account to v, unless it is already set
+func (p *CreateRemoteAccessVpnParams) SetAccountIfUnset(v string) {
+	if _, found := p.p["account"]; !found {
+		p.SetAccount(v)
+	}
+}
+
 func (p *CreateRemoteAccessVpnParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -196,6 +310,13 @@ func (p *CreateRemoteAccessVpnParams) SetDomainid(v string) {
 	return
 }
 
+// SetDomainidIfUnset sets domainid to v, unless it is already set
+func (p *CreateRemoteAccessVpnParams) SetDomainidIfUnset(v string) {
+	if _, found := p.p["domainid"]; !found {
+		p.SetDomainid(v)
+	}
+}
+
 func (p *CreateRemoteAccessVpnParams) SetFordisplay(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -204,6 +325,13 @@ func (p *CreateRemoteAccessVpnParams) SetFordisplay(v bool) {
 	return
 }
 
+// SetFordisplayIfUnset sets fordisplay to v, unless it is already set
+func (p *CreateRemoteAccessVpnParams) SetFordisplayIfUnset(v bool) {
+	if _, found := p.p["fordisplay"]; !found {
+		p.SetFordisplay(v)
+	}
+}
+
 func (p *CreateRemoteAccessVpnParams) SetIprange(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -212,6 +340,13 @@ func (p *CreateRemoteAccessVpnParams) SetIprange(v string) {
 	return
 }
 
+// SetIprangeIfUnset sets iprange to v, unless it is already set
+func (p *CreateRemoteAccessVpnParams) SetIprangeIfUnset(v string) {
+	if _, found := p.p["iprange"]; !found {
+		p.SetIprange(v)
+	}
+}
+
 func (p *CreateRemoteAccessVpnParams) SetOpenfirewall(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -220,6 +355,13 @@ func (p *CreateRemoteAccessVpnParams) SetOpenfirewall(v bool) {
 	return
 }
 
+// SetOpenfirewallIfUnset sets openfirewall to v, unless it is already set
+func (p *CreateRemoteAccessVpnParams) SetOpenfirewallIfUnset(v bool) {
+	if _, found := p.p["openfirewall"]; !found {
+		p.SetOpenfirewall(v)
+	}
+}
+
 func (p *CreateRemoteAccessVpnParams) SetPublicipid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -228,18 +370,54 @@ func (p *CreateRemoteAccessVpnParams) SetPublicipid(v string) {
 	return
 }
 
+// SetPublicipidIfUnset sets publicipid to v, unless it is already set
+func (p *CreateRemoteAccessVpnParams) SetPublicipidIfUnset(v string) {
+	if _, found := p.p["publicipid"]; !found {
+		p.SetPublicipid(v)
+	}
+}
+
 // You should always use this function to get a new CreateRemoteAccessVpnParams instance,
-// as then you are sure you have configured all required params
-func (s *VPNService) NewCreateRemoteAccessVpnParams(publicipid string) *CreateRemoteAccessVpnParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *VPNService) NewCreateRemoteAccessVpnParams(publicipid string, opts ...func(*CreateRemoteAccessVpnParams)) *CreateRemoteAccessVpnParams {
 	p := &CreateRemoteAccessVpnParams{}
 	p.p = make(map[string]interface{})
 	p.p["publicipid"] = publicipid
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Creates a l2tp/ipsec remote access vpn
-func (s *VPNService) CreateRemoteAccessVpn(p *CreateRemoteAccessVpnParams) (*CreateRemoteAccessVpnResponse, error) {
-	resp, err := s.cs.newRequest("createRemoteAccessVpn", p.toURLValues())
+func (s *VPNService) CreateRemoteAccessVpn(p *CreateRemoteAccessVpnParams, opts ...OptionFunc) (*CreateRemoteAccessVpnResponse, error) {
+	return s.CreateRemoteAccessVpnWithContext(context.Background(), p, opts...)
+}
+
+// CreateRemoteAccessVpnWithContext is the same as CreateRemoteAccessVpn, but the request is cancelled when the context is done
+func (s *VPNService) CreateRemoteAccessVpnWithContext(ctx context.Context, p *CreateRemoteAccessVpnParams, opts ...OptionFunc) (*CreateRemoteAccessVpnResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.CreateRemoteAccessVpnRawWithContext(ctx, p.toURLValues())
+}
+
+// CreateRemoteAccessVpnRaw is the same as CreateRemoteAccessVpn, but takes the params as url.Values instead of typed params
+func (s *VPNService) CreateRemoteAccessVpnRaw(v url.Values) (*CreateRemoteAccessVpnResponse, error) {
+	return s.CreateRemoteAccessVpnRawWithContext(context.Background(), v)
+}
+
+// CreateRemoteAccessVpnRawWithContext is the same as CreateRemoteAccessVpnRaw, but the request is cancelled when the context is done
+func (s *VPNService) CreateRemoteAccessVpnRawWithContext(ctx context.Context, v url.Values) (*CreateRemoteAccessVpnResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "createRemoteAccessVpn", "createremoteaccessvpnresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -251,9 +429,9 @@ func (s *VPNService) CreateRemoteAccessVpn(p *CreateRemoteAccessVpnParams) (*Cre
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -292,6 +470,16 @@ type CreateVpnConnectionParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *CreateVpnConnectionParams) Clone() *CreateVpnConnectionParams {
+	c := &CreateVpnConnectionParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *CreateVpnConnectionParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -314,6 +502,16 @@ func (p *CreateVpnConnectionParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *CreateVpnConnectionParams) validate() error {
+	for _, k := range []string{"s2scustomergatewayid", "s2svpngatewayid"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of createVpnConnection", k)
+		}
+	}
+	return nil
+}
+
 func (p *CreateVpnConnectionParams) SetFordisplay(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -322,6 +520,13 @@ func (p *CreateVpnConnectionParams) SetFordisplay(v bool) {
 	return
 }
 
+// SetFordisplayIfUnset sets fordisplay to v, unless it is already set
+func (p *CreateVpnConnectionParams) SetFordisplayIfUnset(v bool) {
+	if _, found := p.p["fordisplay"]; !found {
+		p.SetFordisplay(v)
+	}
+}
+
 func (p *CreateVpnConnectionParams) SetPassive(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -330,6 +535,13 @@ func (p *CreateVpnConnectionParams) SetPassive(v bool) {
 	return
 }
 
+// SetPassiveIfUnset sets passive to v, unless it is already set
+func (p *CreateVpnConnectionParams) SetPassiveIfUnset(v bool) {
+	if _, found := p.p["passive"]; !found {
+		p.SetPassive(v)
+	}
+}
+
 func (p *CreateVpnConnectionParams) SetS2scustomergatewayid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -338,6 +550,13 @@ func (p *CreateVpnConnectionParams) SetS2scustomergatewayid(v string) {
 	return
 }
 
+// SetS2scustomergatewayidIfUnset sets s2scustomergatewayid to v, unless it is already set
+func (p *CreateVpnConnectionParams) SetS2scustomergatewayidIfUnset(v string) {
+	if _, found := p.p["s2scustomergatewayid"]; !found {
+		p.SetS2scustomergatewayid(v)
+	}
+}
+
 func (p *CreateVpnConnectionParams) SetS2svpngatewayid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -346,19 +565,55 @@ func (p *CreateVpnConnectionParams) SetS2svpngatewayid(v string) {
 	return
 }
 
+// SetS2svpngatewayidIfUnset sets s2svpngatewayid to v, unless it is already set
+func (p *CreateVpnConnectionParams) SetS2svpngatewayidIfUnset(v string) {
+	if _, found := p.p["s2svpngatewayid"]; !found {
+		p.SetS2svpngatewayid(v)
+	}
+}
+
 // You should always use this function to get a new CreateVpnConnectionParams instance,
-// as then you are sure you have configured all required params
-func (s *VPNService) NewCreateVpnConnectionParams(s2scustomergatewayid string, s2svpngatewayid string) *CreateVpnConnectionParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *VPNService) NewCreateVpnConnectionParams(s2scustomergatewayid string, s2svpngatewayid string, opts ...func(*CreateVpnConnectionParams)) *CreateVpnConnectionParams {
 	p := &CreateVpnConnectionParams{}
 	p.p = make(map[string]interface{})
 	p.p["s2scustomergatewayid"] = s2scustomergatewayid
 	p.p["s2svpngatewayid"] = s2svpngatewayid
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Create site to site vpn connection
-func (s *VPNService) CreateVpnConnection(p *CreateVpnConnectionParams) (*CreateVpnConnectionResponse, error) {
-	resp, err := s.cs.newRequest("createVpnConnection", p.toURLValues())
+func (s *VPNService) CreateVpnConnection(p *CreateVpnConnectionParams, opts ...OptionFunc) (*CreateVpnConnectionResponse, error) {
+	return s.CreateVpnConnectionWithContext(context.Background(), p, opts...)
+}
+
+// CreateVpnConnectionWithContext is the same as CreateVpnConnection, but the request is cancelled when the context is done
+func (s *VPNService) CreateVpnConnectionWithContext(ctx context.Context, p *CreateVpnConnectionParams, opts ...OptionFunc) (*CreateVpnConnectionResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.CreateVpnConnectionRawWithContext(ctx, p.toURLValues())
+}
+
+// CreateVpnConnectionRaw is the same as CreateVpnConnection, but takes the params as url.Values instead of typed params
+func (s *VPNService) CreateVpnConnectionRaw(v url.Values) (*CreateVpnConnectionResponse, error) {
+	return s.CreateVpnConnectionRawWithContext(context.Background(), v)
+}
+
+// CreateVpnConnectionRawWithContext is the same as CreateVpnConnectionRaw, but the request is cancelled when the context is done
+func (s *VPNService) CreateVpnConnectionRawWithContext(ctx context.Context, v url.Values) (*CreateVpnConnectionResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "createVpnConnection", "createvpnconnectionresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -370,9 +625,9 @@ func (s *VPNService) CreateVpnConnection(p *CreateVpnConnectionParams) (*CreateV
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -422,6 +677,16 @@ type CreateVpnCustomerGatewayParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *CreateVpnCustomerGatewayParams) Clone() *CreateVpnCustomerGatewayParams {
+	c := &CreateVpnCustomerGatewayParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *CreateVpnCustomerGatewayParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -473,6 +738,16 @@ func (p *CreateVpnCustomerGatewayParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *CreateVpnCustomerGatewayParams) validate() error {
+	for _, k := range []string{"cidrlist", "esppolicy", "gateway", "ikepolicy", "ipsecpsk"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of createVpnCustomerGateway", k)
+		}
+	}
+	return nil
+}
+
 func (p *CreateVpnCustomerGatewayParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -481,6 +756,13 @@ func (p *CreateVpnCustomerGatewayParams) SetAccount(v string) {
 	return
 }
 
+// SetAccountIfUnset sets account to v, unless it is already set
+func (p *CreateVpnCustomerGatewayParams) SetAccountIfUnset(v string) {
+	if _, found := p.p["account"]; !found {
+		p.SetAccount(v)
+	}
+}
+
 func (p *CreateVpnCustomerGatewayParams) SetCidrlist(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -489,6 +771,13 @@ func (p *CreateVpnCustomerGatewayParams) SetCidrlist(v string) {
 	return
 }
 
+// SetCidrlistIfUnset sets cidrlist to v, unless it is already set
+func (p *CreateVpnCustomerGatewayParams) SetCidrlistIfUnset(v string) {
+	if _, found := p.p["cidrlist"]; !found {
+		p.SetCidrlist(v)
+	}
+}
+
 func (p *CreateVpnCustomerGatewayParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -497,6 +786,13 @@ func (p *CreateVpnCustomerGatewayParams) SetDomainid(v string) {
 	return
 }
 
+// SetDomainidIfUnset sets domainid to v, unless it is already set
+func (p *CreateVpnCustomerGatewayParams) SetDomainidIfUnset(v string) {
+	if _, found := p.p["domainid"]; !found {
+		p.SetDomainid(v)
+	}
+}
+
 func (p *CreateVpnCustomerGatewayParams) SetDpd(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -505,6 +801,13 @@ func (p *CreateVpnCustomerGatewayParams) SetDpd(v bool) {
 	return
 }
 
+// SetDpdIfUnset sets dpd to v, unless it is already set
+func (p *CreateVpnCustomerGatewayParams) SetDpdIfUnset(v bool) {
+	if _, found := p.p["dpd"]; !found {
+		p.SetDpd(v)
+	}
+}
+
 func (p *CreateVpnCustomerGatewayParams) SetEsplifetime(v int64) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -513,6 +816,13 @@ func (p *CreateVpnCustomerGatewayParams) SetEsplifetime(v int64) {
 	return
 }
 
+// SetEsplifetimeIfUnset sets esplifetime to v, unless it is already set
+func (p *CreateVpnCustomerGatewayParams) SetEsplifetimeIfUnset(v int64) {
+	if _, found := p.p["esplifetime"]; !found {
+		p.SetEsplifetime(v)
+	}
+}
+
 func (p *CreateVpnCustomerGatewayParams) SetEsppolicy(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -521,6 +831,13 @@ func (p *CreateVpnCustomerGatewayParams) SetEsppolicy(v string) {
 	return
 }
 
+// SetEsppolicyIfUnset sets esppolicy to v, unless it is already set
+func (p *CreateVpnCustomerGatewayParams) SetEsppolicyIfUnset(v string) {
+	if _, found := p.p["esppolicy"]; !found {
+		p.SetEsppolicy(v)
+	}
+}
+
 func (p *CreateVpnCustomerGatewayParams) SetForceencap(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -529,6 +846,13 @@ func (p *CreateVpnCustomerGatewayParams) SetForceencap(v bool) {
 	return
 }
 
+// SetForceencapIfUnset sets forceencap to v, unless it is already set
+func (p *CreateVpnCustomerGatewayParams) SetForceencapIfUnset(v bool) {
+	if _, found := p.p["forceencap"]; !found {
+		p.SetForceencap(v)
+	}
+}
+
 func (p *CreateVpnCustomerGatewayParams) SetGateway(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -537,6 +861,13 @@ func (p *CreateVpnCustomerGatewayParams) SetGateway(v string) {
 	return
 }
 
+// SetGatewayIfUnset sets gateway to v, unless it is already set
+func (p *CreateVpnCustomerGatewayParams) SetGatewayIfUnset(v string) {
+	if _, found := p.p["gateway"]; !found {
+		p.SetGateway(v)
+	}
+}
+
 func (p *CreateVpnCustomerGatewayParams) SetIkelifetime(v int64) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -545,6 +876,13 @@ func (p *CreateVpnCustomerGatewayParams) SetIkelifetime(v int64) {
 	return
 }
 
+// SetIkelifetimeIfUnset sets ikelifetime to v, unless it is already set
+func (p *CreateVpnCustomerGatewayParams) SetIkelifetimeIfUnset(v int64) {
+	if _, found := p.p["ikelifetime"]; !found {
+		p.SetIkelifetime(v)
+	}
+}
+
 func (p *CreateVpnCustomerGatewayParams) SetIkepolicy(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -553,6 +891,13 @@ func (p *CreateVpnCustomerGatewayParams) SetIkepolicy(v string) {
 	return
 }
 
+// SetIkepolicyIfUnset sets ikepolicy to v, unless it is already set
+func (p *CreateVpnCustomerGatewayParams) SetIkepolicyIfUnset(v string) {
+	if _, found := p.p["ikepolicy"]; !found {
+		p.SetIkepolicy(v)
+	}
+}
+
 func (p *CreateVpnCustomerGatewayParams) SetIpsecpsk(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -561,6 +906,13 @@ func (p *CreateVpnCustomerGatewayParams) SetIpsecpsk(v string) {
 	return
 }
 
+// SetIpsecpskIfUnset sets ipsecpsk to v, unless it is already set
+func (p *CreateVpnCustomerGatewayParams) SetIpsecpskIfUnset(v string) {
+	if _, found := p.p["ipsecpsk"]; !found {
+		p.SetIpsecpsk(v)
+	}
+}
+
 func (p *CreateVpnCustomerGatewayParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -569,6 +921,13 @@ func (p *CreateVpnCustomerGatewayParams) SetName(v string) {
 	return
 }
 
+// SetNameIfUnset sets name to v, unless it is already set
+func (p *CreateVpnCustomerGatewayParams) SetNameIfUnset(v string) {
+	if _, found := p.p["name"]; !found {
+		p.SetName(v)
+	}
+}
+
 func (p *CreateVpnCustomerGatewayParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -577,9 +936,17 @@ func (p *CreateVpnCustomerGatewayParams) SetProjectid(v string) {
 	return
 }
 
+// SetProjectidIfUnset sets projectid to v, unless it is already set
+func (p *CreateVpnCustomerGatewayParams) SetProjectidIfUnset(v string) {
+	if _, found := p.p["projectid"]; !found {
+		p.SetProjectid(v)
+	}
+}
+
 // You should always use this function to get a new CreateVpnCustomerGatewayParams instance,
-// as then you are sure you have configured all required params
-func (s *VPNService) NewCreateVpnCustomerGatewayParams(cidrlist string, esppolicy string, gateway string, ikepolicy string, ipsecpsk string) *CreateVpnCustomerGatewayParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *VPNService) NewCreateVpnCustomerGatewayParams(cidrlist string, esppolicy string, gateway string, ikepolicy string, ipsecpsk string, opts ...func(*CreateVpnCustomerGatewayParams)) *CreateVpnCustomerGatewayParams {
 	p := &CreateVpnCustomerGatewayParams{}
 	p.p = make(map[string]interface{})
 	p.p["cidrlist"] = cidrlist
@@ -587,12 +954,89 @@ func (s *VPNService) NewCreateVpnCustomerGatewayParams(cidrlist string, esppolic
 	p.p["gateway"] = gateway
 	p.p["ikepolicy"] = ikepolicy
 	p.p["ipsecpsk"] = ipsecpsk
+	for _, fn := range opts {
+		fn(p)
+	}
+	return p
+}
+
+// CreateVpnCustomerGatewayOpts contains all params that can be set on a CreateVpnCustomerGatewayParams.
+// Optional params are pointers (or slices and maps) which are only set when not nil.
+type CreateVpnCustomerGatewayOpts struct {
+	Account     *string
+	Cidrlist    string
+	Domainid    *string
+	Dpd         *bool
+	Esplifetime *int64
+	Esppolicy   string
+	Forceencap  *bool
+	Gateway     string
+	Ikelifetime *int64
+	Ikepolicy   string
+	Ipsecpsk    string
+	Name        *string
+	Projectid   *string
+}
+
+// NewCreateVpnCustomerGatewayParamsFromOpts is an alternative for NewCreateVpnCustomerGatewayParams which takes all params using
+// an options struct, as that is easier to read for APIs with many required params
+func (s *VPNService) NewCreateVpnCustomerGatewayParamsFromOpts(o CreateVpnCustomerGatewayOpts) *CreateVpnCustomerGatewayParams {
+	p := s.NewCreateVpnCustomerGatewayParams(o.Cidrlist, o.Esppolicy, o.Gateway, o.Ikepolicy, o.Ipsecpsk)
+	if o.Account != nil {
+		p.p["account"] = *o.Account
+	}
+	if o.Domainid != nil {
+		p.p["domainid"] = *o.Domainid
+	}
+	if o.Dpd != nil {
+		p.p["dpd"] = *o.Dpd
+	}
+	if o.Esplifetime != nil {
+		p.p["esplifetime"] = *o.Esplifetime
+	}
+	if o.Forceencap != nil {
+		p.p["forceencap"] = *o.Forceencap
+	}
+	if o.Ikelifetime != nil {
+		p.p["ikelifetime"] = *o.Ikelifetime
+	}
+	if o.Name != nil {
+		p.p["name"] = *o.Name
+	}
+	if o.Projectid != nil {
+		p.p["projectid"] = *o.Projectid
+	}
 	return p
 }
 
 // Creates site to site vpn customer gateway
-func (s *VPNService) CreateVpnCustomerGateway(p *CreateVpnCustomerGatewayParams) (*CreateVpnCustomerGatewayResponse, error) {
-	resp, err := s.cs.newRequest("createVpnCustomerGateway", p.toURLValues())
+func (s *VPNService) CreateVpnCustomerGateway(p *CreateVpnCustomerGatewayParams, opts ...OptionFunc) (*CreateVpnCustomerGatewayResponse, error) {
+	return s.CreateVpnCustomerGatewayWithContext(context.Background(), p, opts...)
+}
+
+// CreateVpnCustomerGatewayWithContext is the same as CreateVpnCustomerGateway, but the request is cancelled when the context is done
+func (s *VPNService) CreateVpnCustomerGatewayWithContext(ctx context.Context, p *CreateVpnCustomerGatewayParams, opts ...OptionFunc) (*CreateVpnCustomerGatewayResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.CreateVpnCustomerGatewayRawWithContext(ctx, p.toURLValues())
+}
+
+// CreateVpnCustomerGatewayRaw is the same as CreateVpnCustomerGateway, but takes the params as url.Values instead of typed params
+func (s *VPNService) CreateVpnCustomerGatewayRaw(v url.Values) (*CreateVpnCustomerGatewayResponse, error) {
+	return s.CreateVpnCustomerGatewayRawWithContext(context.Background(), v)
+}
+
+// CreateVpnCustomerGatewayRawWithContext is the same as CreateVpnCustomerGatewayRaw, but the request is cancelled when the context is done
+func (s *VPNService) CreateVpnCustomerGatewayRawWithContext(ctx context.Context, v url.Values) (*CreateVpnCustomerGatewayResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "createVpnCustomerGateway", "createvpncustomergatewayresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -604,9 +1048,9 @@ func (s *VPNService) CreateVpnCustomerGateway(p *CreateVpnCustomerGatewayParams)
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -651,6 +1095,16 @@ type CreateVpnGatewayParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *CreateVpnGatewayParams) Clone() *CreateVpnGatewayParams {
+	c := &CreateVpnGatewayParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *CreateVpnGatewayParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -666,6 +1120,16 @@ func (p *CreateVpnGatewayParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *CreateVpnGatewayParams) validate() error {
+	for _, k := range []string{"vpcid"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of createVpnGateway", k)
+		}
+	}
+	return nil
+}
+
 func (p *CreateVpnGatewayParams) SetFordisplay(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -674,6 +1138,13 @@ func (p *CreateVpnGatewayParams) SetFordisplay(v bool) {
 	return
 }
 
+// SetFordisplayIfUnset sets fordisplay to v, unless it is already set
+func (p *CreateVpnGatewayParams) SetFordisplayIfUnset(v bool) {
+	if _, found := p.p["fordisplay"]; !found {
+		p.SetFordisplay(v)
+	}
+}
+
 func (p *CreateVpnGatewayParams) SetVpcid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -682,18 +1153,54 @@ func (p *CreateVpnGatewayParams) SetVpcid(v string) {
 	return
 }
 
+// SetVpcidIfUnset sets vpcid to v, unless it is already set
+func (p *CreateVpnGatewayParams) SetVpcidIfUnset(v string) {
+	if _, found := p.p["vpcid"]; !found {
+		p.SetVpcid(v)
+	}
+}
+
 // You should always use this function to get a new CreateVpnGatewayParams instance,
-// as then you are sure you have configured all required params
-func (s *VPNService) NewCreateVpnGatewayParams(vpcid string) *CreateVpnGatewayParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *VPNService) NewCreateVpnGatewayParams(vpcid string, opts ...func(*CreateVpnGatewayParams)) *CreateVpnGatewayParams {
 	p := &CreateVpnGatewayParams{}
 	p.p = make(map[string]interface{})
 	p.p["vpcid"] = vpcid
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Creates site to site vpn local gateway
-func (s *VPNService) CreateVpnGateway(p *CreateVpnGatewayParams) (*CreateVpnGatewayResponse, error) {
-	resp, err := s.cs.newRequest("createVpnGateway", p.toURLValues())
+func (s *VPNService) CreateVpnGateway(p *CreateVpnGatewayParams, opts ...OptionFunc) (*CreateVpnGatewayResponse, error) {
+	return s.CreateVpnGatewayWithContext(context.Background(), p, opts...)
+}
+
+// CreateVpnGatewayWithContext is the same as CreateVpnGateway, but the request is cancelled when the context is done
+func (s *VPNService) CreateVpnGatewayWithContext(ctx context.Context, p *CreateVpnGatewayParams, opts ...OptionFunc) (*CreateVpnGatewayResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.CreateVpnGatewayRawWithContext(ctx, p.toURLValues())
+}
+
+// CreateVpnGatewayRaw is the same as CreateVpnGateway, but takes the params as url.Values instead of typed params
+func (s *VPNService) CreateVpnGatewayRaw(v url.Values) (*CreateVpnGatewayResponse, error) {
+	return s.CreateVpnGatewayRawWithContext(context.Background(), v)
+}
+
+// CreateVpnGatewayRawWithContext is the same as CreateVpnGatewayRaw, but the request is cancelled when the context is done
+func (s *VPNService) CreateVpnGatewayRawWithContext(ctx context.Context, v url.Values) (*CreateVpnGatewayResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "createVpnGateway", "createvpngatewayresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -705,9 +1212,9 @@ func (s *VPNService) CreateVpnGateway(p *CreateVpnGatewayParams) (*CreateVpnGate
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -744,6 +1251,16 @@ type DeleteRemoteAccessVpnParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *DeleteRemoteAccessVpnParams) Clone() *DeleteRemoteAccessVpnParams {
+	c := &DeleteRemoteAccessVpnParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeleteRemoteAccessVpnParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -755,6 +1272,16 @@ func (p *DeleteRemoteAccessVpnParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *DeleteRemoteAccessVpnParams) validate() error {
+	for _, k := range []string{"publicipid"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of deleteRemoteAccessVpn", k)
+		}
+	}
+	return nil
+}
+
 func (p *DeleteRemoteAccessVpnParams) SetPublicipid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -763,18 +1290,54 @@ func (p *DeleteRemoteAccessVpnParams) SetPublicipid(v string) {
 	return
 }
 
+// SetPublicipidIfUnset sets publicipid to v, unless it is already set
+func (p *DeleteRemoteAccessVpnParams) SetPublicipidIfUnset(v string) {
+	if _, found := p.p["publicipid"]; !found {
+		p.SetPublicipid(v)
+	}
+}
+
 // You should always use this function to get a new DeleteRemoteAccessVpnParams instance,
-// as then you are sure you have configured all required params
-func (s *VPNService) NewDeleteRemoteAccessVpnParams(publicipid string) *DeleteRemoteAccessVpnParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *VPNService) NewDeleteRemoteAccessVpnParams(publicipid string, opts ...func(*DeleteRemoteAccessVpnParams)) *DeleteRemoteAccessVpnParams {
 	p := &DeleteRemoteAccessVpnParams{}
 	p.p = make(map[string]interface{})
 	p.p["publicipid"] = publicipid
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Destroys a l2tp/ipsec remote access vpn
-func (s *VPNService) DeleteRemoteAccessVpn(p *DeleteRemoteAccessVpnParams) (*DeleteRemoteAccessVpnResponse, error) {
-	resp, err := s.cs.newRequest("deleteRemoteAccessVpn", p.toURLValues())
+func (s *VPNService) DeleteRemoteAccessVpn(p *DeleteRemoteAccessVpnParams, opts ...OptionFunc) (*DeleteRemoteAccessVpnResponse, error) {
+	return s.DeleteRemoteAccessVpnWithContext(context.Background(), p, opts...)
+}
+
+// DeleteRemoteAccessVpnWithContext is the same as DeleteRemoteAccessVpn, but the request is cancelled when the context is done
+func (s *VPNService) DeleteRemoteAccessVpnWithContext(ctx context.Context, p *DeleteRemoteAccessVpnParams, opts ...OptionFunc) (*DeleteRemoteAccessVpnResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.DeleteRemoteAccessVpnRawWithContext(ctx, p.toURLValues())
+}
+
+// DeleteRemoteAccessVpnRaw is the same as DeleteRemoteAccessVpn, but takes the params as url.Values instead of typed params
+func (s *VPNService) DeleteRemoteAccessVpnRaw(v url.Values) (*DeleteRemoteAccessVpnResponse, error) {
+	return s.DeleteRemoteAccessVpnRawWithContext(context.Background(), v)
+}
+
+// DeleteRemoteAccessVpnRawWithContext is the same as DeleteRemoteAccessVpnRaw, but the request is cancelled when the context is done
+func (s *VPNService) DeleteRemoteAccessVpnRawWithContext(ctx context.Context, v url.Values) (*DeleteRemoteAccessVpnResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteRemoteAccessVpn", "deleteremoteaccessvpnresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -786,9 +1349,9 @@ func (s *VPNService) DeleteRemoteAccessVpn(p *DeleteRemoteAccessVpnParams) (*Del
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -812,6 +1375,16 @@ type DeleteVpnConnectionParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *DeleteVpnConnectionParams) Clone() *DeleteVpnConnectionParams {
+	c := &DeleteVpnConnectionParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeleteVpnConnectionParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -823,6 +1396,16 @@ func (p *DeleteVpnConnectionParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *DeleteVpnConnectionParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of deleteVpnConnection", k)
+		}
+	}
+	return nil
+}
+
 func (p *DeleteVpnConnectionParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -831,20 +1414,56 @@ func (p *DeleteVpnConnectionParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *DeleteVpnConnectionParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 // You should always use this function to get a new DeleteVpnConnectionParams instance,
-// as then you are sure you have configured all required params
-func (s *VPNService) NewDeleteVpnConnectionParams(id string) *DeleteVpnConnectionParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *VPNService) NewDeleteVpnConnectionParams(id string, opts ...func(*DeleteVpnConnectionParams)) *DeleteVpnConnectionParams {
 	p := &DeleteVpnConnectionParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Delete site to site vpn connection
-func (s *VPNService) DeleteVpnConnection(p *DeleteVpnConnectionParams) (*DeleteVpnConnectionResponse, error) {
-	resp, err := s.cs.newRequest("deleteVpnConnection", p.toURLValues())
-	if err != nil {
-		return nil, err
+func (s *VPNService) DeleteVpnConnection(p *DeleteVpnConnectionParams, opts ...OptionFunc) (*DeleteVpnConnectionResponse, error) {
+	return s.DeleteVpnConnectionWithContext(context.Background(), p, opts...)
+}
+
+// DeleteVpnConnectionWithContext is the same as DeleteVpnConnection, but the request is cancelled when the context is done
+func (s *VPNService) DeleteVpnConnectionWithContext(ctx context.Context, p *DeleteVpnConnectionParams, opts ...OptionFunc) (*DeleteVpnConnectionResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.DeleteVpnConnectionRawWithContext(ctx, p.toURLValues())
+}
+
+// DeleteVpnConnectionRaw is the same as DeleteVpnConnection, but takes the params as url.Values instead of typed params
+func (s *VPNService) DeleteVpnConnectionRaw(v url.Values) (*DeleteVpnConnectionResponse, error) {
+	return s.DeleteVpnConnectionRawWithContext(context.Background(), v)
+}
+
+// DeleteVpnConnectionRawWithContext is the same as DeleteVpnConnectionRaw, but the request is cancelled when the context is done
+func (s *VPNService) DeleteVpnConnectionRawWithContext(ctx context.Context, v url.Values) (*DeleteVpnConnectionResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteVpnConnection", "deletevpnconnectionresponse", v)
+	if err != nil {
+		return nil, err
 	}
 
 	var r DeleteVpnConnectionResponse
@@ -854,9 +1473,9 @@ func (s *VPNService) DeleteVpnConnection(p *DeleteVpnConnectionParams) (*DeleteV
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -870,6 +1489,34 @@ func (s *VPNService) DeleteVpnConnection(p *DeleteVpnConnectionParams) (*DeleteV
 	return &r, nil
 }
 
+// WaitForVpnConnectionDeleted polls until the VpnConnection with the given ID no longer exists, the timeout
+// expires or the context is cancelled. Deleted resources may still be listed for a short while
+// after the delete command finished, so this can be used to make sure they are really gone. The
+// options are passed to GetVpnConnectionByID, so a resource outside of the default scope can be found.
+func (s *VPNService) WaitForVpnConnectionDeleted(ctx context.Context, id string, timeout time.Duration, opts ...OptionFunc) error {
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	for {
+		_, count, err := s.GetVpnConnectionByID(id, opts...)
+		if count == 0 {
+			return nil
+		}
+		if count < 0 {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(2 * time.Second):
+		}
+	}
+}
+
 type DeleteVpnConnectionResponse struct {
 	JobID       string `json:"jobid"`
 	Displaytext string `json:"displaytext"`
@@ -880,6 +1527,16 @@ type DeleteVpnCustomerGatewayParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *DeleteVpnCustomerGatewayParams) Clone() *DeleteVpnCustomerGatewayParams {
+	c := &DeleteVpnCustomerGatewayParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeleteVpnCustomerGatewayParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -891,6 +1548,16 @@ func (p *DeleteVpnCustomerGatewayParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *DeleteVpnCustomerGatewayParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of deleteVpnCustomerGateway", k)
+		}
+	}
+	return nil
+}
+
 func (p *DeleteVpnCustomerGatewayParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -899,18 +1566,54 @@ func (p *DeleteVpnCustomerGatewayParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *DeleteVpnCustomerGatewayParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 // You should always use this function to get a new DeleteVpnCustomerGatewayParams instance,
-// as then you are sure you have configured all required params
-func (s *VPNService) NewDeleteVpnCustomerGatewayParams(id string) *DeleteVpnCustomerGatewayParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *VPNService) NewDeleteVpnCustomerGatewayParams(id string, opts ...func(*DeleteVpnCustomerGatewayParams)) *DeleteVpnCustomerGatewayParams {
 	p := &DeleteVpnCustomerGatewayParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Delete site to site vpn customer gateway
-func (s *VPNService) DeleteVpnCustomerGateway(p *DeleteVpnCustomerGatewayParams) (*DeleteVpnCustomerGatewayResponse, error) {
-	resp, err := s.cs.newRequest("deleteVpnCustomerGateway", p.toURLValues())
+func (s *VPNService) DeleteVpnCustomerGateway(p *DeleteVpnCustomerGatewayParams, opts ...OptionFunc) (*DeleteVpnCustomerGatewayResponse, error) {
+	return s.DeleteVpnCustomerGatewayWithContext(context.Background(), p, opts...)
+}
+
+// DeleteVpnCustomerGatewayWithContext is the same as DeleteVpnCustomerGateway, but the request is cancelled when the context is done
+func (s *VPNService) DeleteVpnCustomerGatewayWithContext(ctx context.Context, p *DeleteVpnCustomerGatewayParams, opts ...OptionFunc) (*DeleteVpnCustomerGatewayResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.DeleteVpnCustomerGatewayRawWithContext(ctx, p.toURLValues())
+}
+
+// DeleteVpnCustomerGatewayRaw is the same as DeleteVpnCustomerGateway, but takes the params as url.Values instead of typed params
+func (s *VPNService) DeleteVpnCustomerGatewayRaw(v url.Values) (*DeleteVpnCustomerGatewayResponse, error) {
+	return s.DeleteVpnCustomerGatewayRawWithContext(context.Background(), v)
+}
+
+// DeleteVpnCustomerGatewayRawWithContext is the same as DeleteVpnCustomerGatewayRaw, but the request is cancelled when the context is done
+func (s *VPNService) DeleteVpnCustomerGatewayRawWithContext(ctx context.Context, v url.Values) (*DeleteVpnCustomerGatewayResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteVpnCustomerGateway", "deletevpncustomergatewayresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -922,9 +1625,9 @@ func (s *VPNService) DeleteVpnCustomerGateway(p *DeleteVpnCustomerGatewayParams)
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -938,6 +1641,34 @@ func (s *VPNService) DeleteVpnCustomerGateway(p *DeleteVpnCustomerGatewayParams)
 	return &r, nil
 }
 
+// WaitForVpnCustomerGatewayDeleted polls until the VpnCustomerGateway with the given ID no longer exists, the timeout
+// expires or the context is cancelled. Deleted resources may still be listed for a short while
+// after the delete command finished, so this can be used to make sure they are really gone. The
+// options are passed to GetVpnCustomerGatewayByID, so a resource outside of the default scope can be found.
+func (s *VPNService) WaitForVpnCustomerGatewayDeleted(ctx context.Context, id string, timeout time.Duration, opts ...OptionFunc) error {
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	for {
+		_, count, err := s.GetVpnCustomerGatewayByID(id, opts...)
+		if count == 0 {
+			return nil
+		}
+		if count < 0 {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(2 * time.Second):
+		}
+	}
+}
+
 type DeleteVpnCustomerGatewayResponse struct {
 	JobID       string `json:"jobid"`
 	Displaytext string `json:"displaytext"`
@@ -948,6 +1679,16 @@ type DeleteVpnGatewayParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *DeleteVpnGatewayParams) Clone() *DeleteVpnGatewayParams {
+	c := &DeleteVpnGatewayParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeleteVpnGatewayParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -959,6 +1700,16 @@ func (p *DeleteVpnGatewayParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *DeleteVpnGatewayParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of deleteVpnGateway", k)
+		}
+	}
+	return nil
+}
+
 func (p *DeleteVpnGatewayParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -967,18 +1718,54 @@ func (p *DeleteVpnGatewayParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *DeleteVpnGatewayParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 // You should always use this function to get a new DeleteVpnGatewayParams instance,
-// as then you are sure you have configured all required params
-func (s *VPNService) NewDeleteVpnGatewayParams(id string) *DeleteVpnGatewayParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *VPNService) NewDeleteVpnGatewayParams(id string, opts ...func(*DeleteVpnGatewayParams)) *DeleteVpnGatewayParams {
 	p := &DeleteVpnGatewayParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Delete site to site vpn gateway
-func (s *VPNService) DeleteVpnGateway(p *DeleteVpnGatewayParams) (*DeleteVpnGatewayResponse, error) {
-	resp, err := s.cs.newRequest("deleteVpnGateway", p.toURLValues())
+func (s *VPNService) DeleteVpnGateway(p *DeleteVpnGatewayParams, opts ...OptionFunc) (*DeleteVpnGatewayResponse, error) {
+	return s.DeleteVpnGatewayWithContext(context.Background(), p, opts...)
+}
+
+// DeleteVpnGatewayWithContext is the same as DeleteVpnGateway, but the request is cancelled when the context is done
+func (s *VPNService) DeleteVpnGatewayWithContext(ctx context.Context, p *DeleteVpnGatewayParams, opts ...OptionFunc) (*DeleteVpnGatewayResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.DeleteVpnGatewayRawWithContext(ctx, p.toURLValues())
+}
+
+// DeleteVpnGatewayRaw is the same as DeleteVpnGateway, but takes the params as url.Values instead of typed params
+func (s *VPNService) DeleteVpnGatewayRaw(v url.Values) (*DeleteVpnGatewayResponse, error) {
+	return s.DeleteVpnGatewayRawWithContext(context.Background(), v)
+}
+
+// DeleteVpnGatewayRawWithContext is the same as DeleteVpnGatewayRaw, but the request is cancelled when the context is done
+func (s *VPNService) DeleteVpnGatewayRawWithContext(ctx context.Context, v url.Values) (*DeleteVpnGatewayResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteVpnGateway", "deletevpngatewayresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -990,9 +1777,9 @@ func (s *VPNService) DeleteVpnGateway(p *DeleteVpnGatewayParams) (*DeleteVpnGate
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -1006,6 +1793,34 @@ func (s *VPNService) DeleteVpnGateway(p *DeleteVpnGatewayParams) (*DeleteVpnGate
 	return &r, nil
 }
 
+// WaitForVpnGatewayDeleted polls until the VpnGateway with the given ID no longer exists, the timeout
+// expires or the context is cancelled. Deleted resources may still be listed for a short while
+// after the delete command finished, so this can be used to make sure they are really gone. The
+// options are passed to GetVpnGatewayByID, so a resource outside of the default scope can be found.
+func (s *VPNService) WaitForVpnGatewayDeleted(ctx context.Context, id string, timeout time.Duration, opts ...OptionFunc) error {
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	for {
+		_, count, err := s.GetVpnGatewayByID(id, opts...)
+		if count == 0 {
+			return nil
+		}
+		if count < 0 {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(2 * time.Second):
+		}
+	}
+}
+
 type DeleteVpnGatewayResponse struct {
 	JobID       string `json:"jobid"`
 	Displaytext string `json:"displaytext"`
@@ -1016,6 +1831,16 @@ type ListRemoteAccessVpnsParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ListRemoteAccessVpnsParams) Clone() *ListRemoteAccessVpnsParams {
+	c := &ListRemoteAccessVpnsParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListRemoteAccessVpnsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1073,6 +1898,13 @@ func (p *ListRemoteAccessVpnsParams) SetAccount(v string) {
 	return
 }
 
+// SetAccountIfUnset sets account to v, unless it is already set
+func (p *ListRemoteAccessVpnsParams) SetAccountIfUnset(v string) {
+	if _, found := p.p["account"]; !found {
+		p.SetAccount(v)
+	}
+}
+
 func (p *ListRemoteAccessVpnsParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1081,6 +1913,13 @@ func (p *ListRemoteAccessVpnsParams) SetDomainid(v string) {
 	return
 }
 
+// SetDomainidIfUnset sets domainid to v, unless it is already set
+func (p *ListRemoteAccessVpnsParams) SetDomainidIfUnset(v string) {
+	if _, found := p.p["domainid"]; !found {
+		p.SetDomainid(v)
+	}
+}
+
 func (p *ListRemoteAccessVpnsParams) SetFordisplay(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1089,6 +1928,13 @@ func (p *ListRemoteAccessVpnsParams) SetFordisplay(v bool) {
 	return
 }
 
+// SetFordisplayIfUnset sets fordisplay to v, unless it is already set
+func (p *ListRemoteAccessVpnsParams) SetFordisplayIfUnset(v bool) {
+	if _, found := p.p["fordisplay"]; !found {
+		p.SetFordisplay(v)
+	}
+}
+
 func (p *ListRemoteAccessVpnsParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1097,6 +1943,13 @@ func (p *ListRemoteAccessVpnsParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *ListRemoteAccessVpnsParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 func (p *ListRemoteAccessVpnsParams) SetIsrecursive(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1105,6 +1958,13 @@ func (p *ListRemoteAccessVpnsParams) SetIsrecursive(v bool) {
 	return
 }
 
+// SetIsrecursiveIfUnset sets isrecursive to v, unless it is already set
+func (p *ListRemoteAccessVpnsParams) SetIsrecursiveIfUnset(v bool) {
+	if _, found := p.p["isrecursive"]; !found {
+		p.SetIsrecursive(v)
+	}
+}
+
 func (p *ListRemoteAccessVpnsParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1113,6 +1973,13 @@ func (p *ListRemoteAccessVpnsParams) SetKeyword(v string) {
 	return
 }
 
+// SetKeywordIfUnset sets keyword to v, unless it is already set
+func (p *ListRemoteAccessVpnsParams) SetKeywordIfUnset(v string) {
+	if _, found := p.p["keyword"]; !found {
+		p.SetKeyword(v)
+	}
+}
+
 func (p *ListRemoteAccessVpnsParams) SetListall(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1121,6 +1988,13 @@ func (p *ListRemoteAccessVpnsParams) SetListall(v bool) {
 	return
 }
 
+// SetListallIfUnset sets listall to v, unless it is already set
+func (p *ListRemoteAccessVpnsParams) SetListallIfUnset(v bool) {
+	if _, found := p.p["listall"]; !found {
+		p.SetListall(v)
+	}
+}
+
 func (p *ListRemoteAccessVpnsParams) SetNetworkid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1129,6 +2003,13 @@ func (p *ListRemoteAccessVpnsParams) SetNetworkid(v string) {
 	return
 }
 
+// SetNetworkidIfUnset sets networkid to v, unless it is already set
+func (p *ListRemoteAccessVpnsParams) SetNetworkidIfUnset(v string) {
+	if _, found := p.p["networkid"]; !found {
+		p.SetNetworkid(v)
+	}
+}
+
 func (p *ListRemoteAccessVpnsParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1137,6 +2018,13 @@ func (p *ListRemoteAccessVpnsParams) SetPage(v int) {
 	return
 }
 
+// SetPageIfUnset sets page to v, unless it is already set
+func (p *ListRemoteAccessVpnsParams) SetPageIfUnset(v int) {
+	if _, found := p.p["page"]; !found {
+		p.SetPage(v)
+	}
+}
+
 func (p *ListRemoteAccessVpnsParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1145,6 +2033,13 @@ func (p *ListRemoteAccessVpnsParams) SetPagesize(v int) {
 	return
 }
 
+// SetPagesizeIfUnset sets pagesize to v, unless it is already set
+func (p *ListRemoteAccessVpnsParams) SetPagesizeIfUnset(v int) {
+	if _, found := p.p["pagesize"]; !found {
+		p.SetPagesize(v)
+	}
+}
+
 func (p *ListRemoteAccessVpnsParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1153,6 +2048,13 @@ func (p *ListRemoteAccessVpnsParams) SetProjectid(v string) {
 	return
 }
 
+// SetProjectidIfUnset sets projectid to v, unless it is already set
+func (p *ListRemoteAccessVpnsParams) SetProjectidIfUnset(v string) {
+	if _, found := p.p["projectid"]; !found {
+		p.SetProjectid(v)
+	}
+}
+
 func (p *ListRemoteAccessVpnsParams) SetPublicipid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1161,14 +2063,34 @@ func (p *ListRemoteAccessVpnsParams) SetPublicipid(v string) {
 	return
 }
 
+// SetPublicipidIfUnset sets publicipid to v, unless it is already set
+func (p *ListRemoteAccessVpnsParams) SetPublicipidIfUnset(v string) {
+	if _, found := p.p["publicipid"]; !found {
+		p.SetPublicipid(v)
+	}
+}
+
+var _ ListAllSetter = (*ListRemoteAccessVpnsParams)(nil)
+
 // You should always use this function to get a new ListRemoteAccessVpnsParams instance,
-// as then you are sure you have configured all required params
-func (s *VPNService) NewListRemoteAccessVpnsParams() *ListRemoteAccessVpnsParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *VPNService) NewListRemoteAccessVpnsParams(opts ...func(*ListRemoteAccessVpnsParams)) *ListRemoteAccessVpnsParams {
 	p := &ListRemoteAccessVpnsParams{}
 	p.p = make(map[string]interface{})
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
+func (p *ListRemoteAccessVpnsParams) setFirstMatch() {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p[firstMatchKey] = true
+}
+
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *VPNService) GetRemoteAccessVpnByID(id string, opts ...OptionFunc) (*RemoteAccessVpn, int, error) {
 	p := &ListRemoteAccessVpnsParams{}
@@ -1176,7 +2098,7 @@ func (s *VPNService) GetRemoteAccessVpnByID(id string, opts ...OptionFunc) (*Rem
 
 	p.p["id"] = id
 
-	for _, fn := range append(s.cs.options, opts...) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
 		if err := fn(s.cs, p); err != nil {
 			return nil, -1, err
 		}
@@ -1187,24 +2109,56 @@ func (s *VPNService) GetRemoteAccessVpnByID(id string, opts ...OptionFunc) (*Rem
 		if strings.Contains(err.Error(), fmt.Sprintf(
 			"Invalid parameter id value=%s due to incorrect long value format, "+
 				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+			return nil, 0, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
 	}
 
 	if l.Count == 1 {
 		return l.RemoteAccessVpns[0], l.Count, nil
 	}
+
+	if _, ok := p.p[firstMatchKey]; ok && len(l.RemoteAccessVpns) > 0 {
+		return l.RemoteAccessVpns[0], l.Count, nil
+	}
 	return nil, l.Count, fmt.Errorf("There is more then one result for RemoteAccessVpn UUID: %s!", id)
 }
 
+// RemoteAccessVpnExists returns true if a RemoteAccessVpn with the given ID exists. When no match is found
+// it returns false, while any other error is returned as is.
+func (s *VPNService) RemoteAccessVpnExists(id string, opts ...OptionFunc) (bool, error) {
+	_, count, err := s.GetRemoteAccessVpnByID(id, opts...)
+	if errors.Is(err, ErrNotFound) {
+		return false, nil
+	}
+	if count > 0 {
+		return true, nil
+	}
+	return false, err
+}
+
 // Lists remote access vpns
 func (s *VPNService) ListRemoteAccessVpns(p *ListRemoteAccessVpnsParams) (*ListRemoteAccessVpnsResponse, error) {
-	resp, err := s.cs.newRequest("listRemoteAccessVpns", p.toURLValues())
+	return s.ListRemoteAccessVpnsWithContext(context.Background(), p)
+}
+
+// ListRemoteAccessVpnsWithContext is the same as ListRemoteAccessVpns, but the request is cancelled when the context is done
+func (s *VPNService) ListRemoteAccessVpnsWithContext(ctx context.Context, p *ListRemoteAccessVpnsParams) (*ListRemoteAccessVpnsResponse, error) {
+	return s.ListRemoteAccessVpnsRawWithContext(ctx, p.toURLValues())
+}
+
+// ListRemoteAccessVpnsRaw is the same as ListRemoteAccessVpns, but takes the params as url.Values instead of typed params
+func (s *VPNService) ListRemoteAccessVpnsRaw(v url.Values) (*ListRemoteAccessVpnsResponse, error) {
+	return s.ListRemoteAccessVpnsRawWithContext(context.Background(), v)
+}
+
+// ListRemoteAccessVpnsRawWithContext is the same as ListRemoteAccessVpnsRaw, but the request is cancelled when the context is done
+func (s *VPNService) ListRemoteAccessVpnsRawWithContext(ctx context.Context, v url.Values) (*ListRemoteAccessVpnsResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "listRemoteAccessVpns", "listremoteaccessvpnsresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -1217,6 +2171,93 @@ func (s *VPNService) ListRemoteAccessVpns(p *ListRemoteAccessVpnsParams) (*ListR
 	return &r, nil
 }
 
+// StreamRemoteAccessVpns fetches the results of ListRemoteAccessVpns page by page in the background and sends them on the
+// returned channel. Both returned channels are closed when done. If fetching a page fails, or the context
+// is done before all results are sent, the error is sent on the error channel and fetching stops. A
+// request for a page in flight is cancelled when the context is done. The page and pagesize set in the
+// params are ignored.
+func (s *VPNService) StreamRemoteAccessVpns(ctx context.Context, p *ListRemoteAccessVpnsParams) (<-chan *RemoteAccessVpn, <-chan error) {
+	ch := make(chan *RemoteAccessVpn)
+	errs := make(chan error, 1)
+
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesize(500)
+
+	go func() {
+		defer close(errs)
+		defer close(ch)
+
+		for page, count := 1, 0; ; page++ {
+			pp.SetPage(page)
+
+			l, err := s.ListRemoteAccessVpnsWithContext(ctx, pp)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			for _, v := range l.RemoteAccessVpns {
+				select {
+				case ch <- v:
+				case <-ctx.Done():
+					errs <- ctx.Err()
+					return
+				}
+			}
+
+			count += len(l.RemoteAccessVpns)
+			if len(l.RemoteAccessVpns) < 500 || count >= l.Count {
+				return
+			}
+		}
+	}()
+
+	return ch, errs
+}
+
+// ListRemoteAccessVpnsWhere returns all results of ListRemoteAccessVpns for which pred returns true. Note that the results are
+// filtered client-side, so all results matching the params are fetched first.
+// All pages are fetched, so the page and pagesize set in the params are ignored.
+func (s *VPNService) ListRemoteAccessVpnsWhere(p *ListRemoteAccessVpnsParams, pred func(*RemoteAccessVpn) bool) ([]*RemoteAccessVpn, error) {
+	var r []*RemoteAccessVpn
+	ch, errs := s.StreamRemoteAccessVpns(context.Background(), p)
+	for v := range ch {
+		if pred(v) {
+			r = append(r, v)
+		}
+	}
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ListAllRemoteAccessVpns returns the results of ListRemoteAccessVpns across all pages, by calling ListRemoteAccessVpns page by page until all
+// results are fetched. The pagesize set in the params is used for every page (500 if not set), and the
+// page set in the params is ignored.
+func (s *VPNService) ListAllRemoteAccessVpns(p *ListRemoteAccessVpnsParams) ([]*RemoteAccessVpn, error) {
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesizeIfUnset(500)
+	pagesize := pp.p["pagesize"].(int)
+
+	var r []*RemoteAccessVpn
+	for page := 1; ; page++ {
+		pp.SetPage(page)
+
+		l, err := s.ListRemoteAccessVpns(pp)
+		if err != nil {
+			return nil, err
+		}
+		r = append(r, l.RemoteAccessVpns...)
+
+		if len(l.RemoteAccessVpns) < pagesize || len(r) >= l.Count {
+			return r, nil
+		}
+	}
+}
+
 type ListRemoteAccessVpnsResponse struct {
 	Count            int                `json:"count"`
 	RemoteAccessVpns []*RemoteAccessVpn `json:"remoteaccessvpn"`
@@ -1241,6 +2282,16 @@ type ListVpnConnectionsParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ListVpnConnectionsParams) Clone() *ListVpnConnectionsParams {
+	c := &ListVpnConnectionsParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListVpnConnectionsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1295,6 +2346,13 @@ func (p *ListVpnConnectionsParams) SetAccount(v string) {
 	return
 }
 
+// SetAccountIfUnset sets account to v, unless it is already set
+func (p *ListVpnConnectionsParams) SetAccountIfUnset(v string) {
+	if _, found := p.p["account"]; !found {
+		p.SetAccount(v)
+	}
+}
+
 func (p *ListVpnConnectionsParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1303,6 +2361,13 @@ func (p *ListVpnConnectionsParams) SetDomainid(v string) {
 	return
 }
 
+// SetDomainidIfUnset sets domainid to v, unless it is already set
+func (p *ListVpnConnectionsParams) SetDomainidIfUnset(v string) {
+	if _, found := p.p["domainid"]; !found {
+		p.SetDomainid(v)
+	}
+}
+
 func (p *ListVpnConnectionsParams) SetFordisplay(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1311,6 +2376,13 @@ func (p *ListVpnConnectionsParams) SetFordisplay(v bool) {
 	return
 }
 
+// SetFordisplayIfUnset sets fordisplay to v, unless it is already set
+func (p *ListVpnConnectionsParams) SetFordisplayIfUnset(v bool) {
+	if _, found := p.p["fordisplay"]; !found {
+		p.SetFordisplay(v)
+	}
+}
+
 func (p *ListVpnConnectionsParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1319,6 +2391,13 @@ func (p *ListVpnConnectionsParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *ListVpnConnectionsParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 func (p *ListVpnConnectionsParams) SetIsrecursive(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1327,6 +2406,13 @@ func (p *ListVpnConnectionsParams) SetIsrecursive(v bool) {
 	return
 }
 
+// SetIsrecursiveIfUnset sets isrecursive to v, unless it is already set
+func (p *ListVpnConnectionsParams) SetIsrecursiveIfUnset(v bool) {
+	if _, found := p.p["isrecursive"]; !found {
+		p.SetIsrecursive(v)
+	}
+}
+
 func (p *ListVpnConnectionsParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1335,6 +2421,13 @@ func (p *ListVpnConnectionsParams) SetKeyword(v string) {
 	return
 }
 
+// SetKeywordIfUnset sets keyword to v, unless it is already set
+func (p *ListVpnConnectionsParams) SetKeywordIfUnset(v string) {
+	if _, found := p.p["keyword"]; !found {
+		p.SetKeyword(v)
+	}
+}
+
 func (p *ListVpnConnectionsParams) SetListall(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1343,6 +2436,13 @@ func (p *ListVpnConnectionsParams) SetListall(v bool) {
 	return
 }
 
+// SetListallIfUnset sets listall to v, unless it is already set
+func (p *ListVpnConnectionsParams) SetListallIfUnset(v bool) {
+	if _, found := p.p["listall"]; !found {
+		p.SetListall(v)
+	}
+}
+
 func (p *ListVpnConnectionsParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1351,6 +2451,13 @@ func (p *ListVpnConnectionsParams) SetPage(v int) {
 	return
 }
 
+// SetPageIfUnset sets page to v, unless it is already set
+func (p *ListVpnConnectionsParams) SetPageIfUnset(v int) {
+	if _, found := p.p["page"]; !found {
+		p.SetPage(v)
+	}
+}
+
 func (p *ListVpnConnectionsParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1359,6 +2466,13 @@ func (p *ListVpnConnectionsParams) SetPagesize(v int) {
 	return
 }
 
+// SetPagesizeIfUnset sets pagesize to v, unless it is already set
+func (p *ListVpnConnectionsParams) SetPagesizeIfUnset(v int) {
+	if _, found := p.p["pagesize"]; !found {
+		p.SetPagesize(v)
+	}
+}
+
 func (p *ListVpnConnectionsParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1367,6 +2481,13 @@ func (p *ListVpnConnectionsParams) SetProjectid(v string) {
 	return
 }
 
+// SetProjectidIfUnset sets projectid to v, unless it is already set
+func (p *ListVpnConnectionsParams) SetProjectidIfUnset(v string) {
+	if _, found := p.p["projectid"]; !found {
+		p.SetProjectid(v)
+	}
+}
+
 func (p *ListVpnConnectionsParams) SetVpcid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1375,14 +2496,34 @@ func (p *ListVpnConnectionsParams) SetVpcid(v string) {
 	return
 }
 
+// SetVpcidIfUnset sets vpcid to v, unless it is already set
+func (p *ListVpnConnectionsParams) SetVpcidIfUnset(v string) {
+	if _, found := p.p["vpcid"]; !found {
+		p.SetVpcid(v)
+	}
+}
+
+var _ ListAllSetter = (*ListVpnConnectionsParams)(nil)
+
 // You should always use this function to get a new ListVpnConnectionsParams instance,
-// as then you are sure you have configured all required params
-func (s *VPNService) NewListVpnConnectionsParams() *ListVpnConnectionsParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *VPNService) NewListVpnConnectionsParams(opts ...func(*ListVpnConnectionsParams)) *ListVpnConnectionsParams {
 	p := &ListVpnConnectionsParams{}
 	p.p = make(map[string]interface{})
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
+func (p *ListVpnConnectionsParams) setFirstMatch() {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p[firstMatchKey] = true
+}
+
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *VPNService) GetVpnConnectionByID(id string, opts ...OptionFunc) (*VpnConnection, int, error) {
 	p := &ListVpnConnectionsParams{}
@@ -1390,7 +2531,7 @@ func (s *VPNService) GetVpnConnectionByID(id string, opts ...OptionFunc) (*VpnCo
 
 	p.p["id"] = id
 
-	for _, fn := range append(s.cs.options, opts...) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
 		if err := fn(s.cs, p); err != nil {
 			return nil, -1, err
 		}
@@ -1401,24 +2542,56 @@ func (s *VPNService) GetVpnConnectionByID(id string, opts ...OptionFunc) (*VpnCo
 		if strings.Contains(err.Error(), fmt.Sprintf(
 			"Invalid parameter id value=%s due to incorrect long value format, "+
 				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+			return nil, 0, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
 	}
 
 	if l.Count == 1 {
 		return l.VpnConnections[0], l.Count, nil
 	}
+
+	if _, ok := p.p[firstMatchKey]; ok && len(l.VpnConnections) > 0 {
+		return l.VpnConnections[0], l.Count, nil
+	}
 	return nil, l.Count, fmt.Errorf("There is more then one result for VpnConnection UUID: %s!", id)
 }
 
+// VpnConnectionExists returns true if a VpnConnection with the given ID exists. When no match is found
+// it returns false, while any other error is returned as is.
+func (s *VPNService) VpnConnectionExists(id string, opts ...OptionFunc) (bool, error) {
+	_, count, err := s.GetVpnConnectionByID(id, opts...)
+	if errors.Is(err, ErrNotFound) {
+		return false, nil
+	}
+	if count > 0 {
+		return true, nil
+	}
+	return false, err
+}
+
 // Lists site to site vpn connection gateways
 func (s *VPNService) ListVpnConnections(p *ListVpnConnectionsParams) (*ListVpnConnectionsResponse, error) {
-	resp, err := s.cs.newRequest("listVpnConnections", p.toURLValues())
+	return s.ListVpnConnectionsWithContext(context.Background(), p)
+}
+
+// ListVpnConnectionsWithContext is the same as ListVpnConnections, but the request is cancelled when the context is done
+func (s *VPNService) ListVpnConnectionsWithContext(ctx context.Context, p *ListVpnConnectionsParams) (*ListVpnConnectionsResponse, error) {
+	return s.ListVpnConnectionsRawWithContext(ctx, p.toURLValues())
+}
+
+// ListVpnConnectionsRaw is the same as ListVpnConnections, but takes the params as url.Values instead of typed params
+func (s *VPNService) ListVpnConnectionsRaw(v url.Values) (*ListVpnConnectionsResponse, error) {
+	return s.ListVpnConnectionsRawWithContext(context.Background(), v)
+}
+
+// ListVpnConnectionsRawWithContext is the same as ListVpnConnectionsRaw, but the request is cancelled when the context is done
+func (s *VPNService) ListVpnConnectionsRawWithContext(ctx context.Context, v url.Values) (*ListVpnConnectionsResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "listVpnConnections", "listvpnconnectionsresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -1431,6 +2604,93 @@ func (s *VPNService) ListVpnConnections(p *ListVpnConnectionsParams) (*ListVpnCo
 	return &r, nil
 }
 
+// StreamVpnConnections fetches the results of ListVpnConnections page by page in the background and sends them on the
+// returned channel. Both returned channels are closed when done. If fetching a page fails, or the context
+// is done before all results are sent, the error is sent on the error channel and fetching stops. A
+// request for a page in flight is cancelled when the context is done. The page and pagesize set in the
+// params are ignored.
+func (s *VPNService) StreamVpnConnections(ctx context.Context, p *ListVpnConnectionsParams) (<-chan *VpnConnection, <-chan error) {
+	ch := make(chan *VpnConnection)
+	errs := make(chan error, 1)
+
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesize(500)
+
+	go func() {
+		defer close(errs)
+		defer close(ch)
+
+		for page, count := 1, 0; ; page++ {
+			pp.SetPage(page)
+
+			l, err := s.ListVpnConnectionsWithContext(ctx, pp)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			for _, v := range l.VpnConnections {
+				select {
+				case ch <- v:
+				case <-ctx.Done():
+					errs <- ctx.Err()
+					return
+				}
+			}
+
+			count += len(l.VpnConnections)
+			if len(l.VpnConnections) < 500 || count >= l.Count {
+				return
+			}
+		}
+	}()
+
+	return ch, errs
+}
+
+// ListVpnConnectionsWhere returns all results of ListVpnConnections for which pred returns true. Note that the results are
+// filtered client-side, so all results matching the params are fetched first.
+// All pages are fetched, so the page and pagesize set in the params are ignored.
+func (s *VPNService) ListVpnConnectionsWhere(p *ListVpnConnectionsParams, pred func(*VpnConnection) bool) ([]*VpnConnection, error) {
+	var r []*VpnConnection
+	ch, errs := s.StreamVpnConnections(context.Background(), p)
+	for v := range ch {
+		if pred(v) {
+			r = append(r, v)
+		}
+	}
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ListAllVpnConnections returns the results of ListVpnConnections across all pages, by calling ListVpnConnections page by page until all
+// results are fetched. The pagesize set in the params is used for every page (500 if not set), and the
+// page set in the params is ignored.
+func (s *VPNService) ListAllVpnConnections(p *ListVpnConnectionsParams) ([]*VpnConnection, error) {
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesizeIfUnset(500)
+	pagesize := pp.p["pagesize"].(int)
+
+	var r []*VpnConnection
+	for page := 1; ; page++ {
+		pp.SetPage(page)
+
+		l, err := s.ListVpnConnections(pp)
+		if err != nil {
+			return nil, err
+		}
+		r = append(r, l.VpnConnections...)
+
+		if len(l.VpnConnections) < pagesize || len(r) >= l.Count {
+			return r, nil
+		}
+	}
+}
+
 type ListVpnConnectionsResponse struct {
 	Count          int              `json:"count"`
 	VpnConnections []*VpnConnection `json:"vpnconnection"`
@@ -1466,6 +2726,16 @@ type ListVpnCustomerGatewaysParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ListVpnCustomerGatewaysParams) Clone() *ListVpnCustomerGatewaysParams {
+	c := &ListVpnCustomerGatewaysParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListVpnCustomerGatewaysParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1513,6 +2783,13 @@ func (p *ListVpnCustomerGatewaysParams) SetAccount(v string) {
 	return
 }
 
+// SetAccountIfUnset sets account to v, unless it is already set
+func (p *ListVpnCustomerGatewaysParams) SetAccountIfUnset(v string) {
+	if _, found := p.p["account"]; !found {
+		p.SetAccount(v)
+	}
+}
+
 func (p *ListVpnCustomerGatewaysParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1521,6 +2798,13 @@ func (p *ListVpnCustomerGatewaysParams) SetDomainid(v string) {
 	return
 }
 
+// SetDomainidIfUnset sets domainid to v, unless it is already set
+func (p *ListVpnCustomerGatewaysParams) SetDomainidIfUnset(v string) {
+	if _, found := p.p["domainid"]; !found {
+		p.SetDomainid(v)
+	}
+}
+
 func (p *ListVpnCustomerGatewaysParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1529,6 +2813,13 @@ func (p *ListVpnCustomerGatewaysParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *ListVpnCustomerGatewaysParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 func (p *ListVpnCustomerGatewaysParams) SetIsrecursive(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1537,6 +2828,13 @@ func (p *ListVpnCustomerGatewaysParams) SetIsrecursive(v bool) {
 	return
 }
 
+// SetIsrecursiveIfUnset sets isrecursive to v, unless it is already set
+func (p *ListVpnCustomerGatewaysParams) SetIsrecursiveIfUnset(v bool) {
+	if _, found := p.p["isrecursive"]; !found {
+		p.SetIsrecursive(v)
+	}
+}
+
 func (p *ListVpnCustomerGatewaysParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1545,6 +2843,13 @@ func (p *ListVpnCustomerGatewaysParams) SetKeyword(v string) {
 	return
 }
 
+// SetKeywordIfUnset sets keyword to v, unless it is already set
+func (p *ListVpnCustomerGatewaysParams) SetKeywordIfUnset(v string) {
+	if _, found := p.p["keyword"]; !found {
+		p.SetKeyword(v)
+	}
+}
+
 func (p *ListVpnCustomerGatewaysParams) SetListall(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1553,6 +2858,13 @@ func (p *ListVpnCustomerGatewaysParams) SetListall(v bool) {
 	return
 }
 
+// SetListallIfUnset sets listall to v, unless it is already set
+func (p *ListVpnCustomerGatewaysParams) SetListallIfUnset(v bool) {
+	if _, found := p.p["listall"]; !found {
+		p.SetListall(v)
+	}
+}
+
 func (p *ListVpnCustomerGatewaysParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1561,6 +2873,13 @@ func (p *ListVpnCustomerGatewaysParams) SetPage(v int) {
 	return
 }
 
+// SetPageIfUnset sets page to v, unless it is already set
+func (p *ListVpnCustomerGatewaysParams) SetPageIfUnset(v int) {
+	if _, found := p.p["page"]; !found {
+		p.SetPage(v)
+	}
+}
+
 func (p *ListVpnCustomerGatewaysParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1569,6 +2888,13 @@ func (p *ListVpnCustomerGatewaysParams) SetPagesize(v int) {
 	return
 }
 
+// SetPagesizeIfUnset sets pagesize to v, unless it is already set
+func (p *ListVpnCustomerGatewaysParams) SetPagesizeIfUnset(v int) {
+	if _, found := p.p["pagesize"]; !found {
+		p.SetPagesize(v)
+	}
+}
+
 func (p *ListVpnCustomerGatewaysParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1577,14 +2903,34 @@ func (p *ListVpnCustomerGatewaysParams) SetProjectid(v string) {
 	return
 }
 
+// SetProjectidIfUnset sets projectid to v, unless it is already set
+func (p *ListVpnCustomerGatewaysParams) SetProjectidIfUnset(v string) {
+	if _, found := p.p["projectid"]; !found {
+		p.SetProjectid(v)
+	}
+}
+
+var _ ListAllSetter = (*ListVpnCustomerGatewaysParams)(nil)
+
 // You should always use this function to get a new ListVpnCustomerGatewaysParams instance,
-// as then you are sure you have configured all required params
-func (s *VPNService) NewListVpnCustomerGatewaysParams() *ListVpnCustomerGatewaysParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *VPNService) NewListVpnCustomerGatewaysParams(opts ...func(*ListVpnCustomerGatewaysParams)) *ListVpnCustomerGatewaysParams {
 	p := &ListVpnCustomerGatewaysParams{}
 	p.p = make(map[string]interface{})
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
+func (p *ListVpnCustomerGatewaysParams) setFirstMatch() {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p[firstMatchKey] = true
+}
+
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *VPNService) GetVpnCustomerGatewayID(keyword string, opts ...OptionFunc) (string, int, error) {
 	p := &ListVpnCustomerGatewaysParams{}
@@ -1592,7 +2938,7 @@ func (s *VPNService) GetVpnCustomerGatewayID(keyword string, opts ...OptionFunc)
 
 	p.p["keyword"] = keyword
 
-	for _, fn := range append(s.cs.options, opts...) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
 		if err := fn(s.cs, p); err != nil {
 			return "", -1, err
 		}
@@ -1604,7 +2950,7 @@ func (s *VPNService) GetVpnCustomerGatewayID(keyword string, opts ...OptionFunc)
 	}
 
 	if l.Count == 0 {
-		return "", l.Count, fmt.Errorf("No match found for %s: %+v", keyword, l)
+		return "", l.Count, fmt.Errorf("%w for %s: %+v", ErrNotFound, keyword, l)
 	}
 
 	if l.Count == 1 {
@@ -1617,6 +2963,10 @@ func (s *VPNService) GetVpnCustomerGatewayID(keyword string, opts ...OptionFunc)
 				return v.Id, l.Count, nil
 			}
 		}
+
+		if _, ok := p.p[firstMatchKey]; ok && len(l.VpnCustomerGateways) > 0 {
+			return l.VpnCustomerGateways[0].Id, l.Count, nil
+		}
 	}
 	return "", l.Count, fmt.Errorf("Could not find an exact match for %s: %+v", keyword, l)
 }
@@ -1642,7 +2992,7 @@ func (s *VPNService) GetVpnCustomerGatewayByID(id string, opts ...OptionFunc) (*
 
 	p.p["id"] = id
 
-	for _, fn := range append(s.cs.options, opts...) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
 		if err := fn(s.cs, p); err != nil {
 			return nil, -1, err
 		}
@@ -1653,24 +3003,56 @@ func (s *VPNService) GetVpnCustomerGatewayByID(id string, opts ...OptionFunc) (*
 		if strings.Contains(err.Error(), fmt.Sprintf(
 			"Invalid parameter id value=%s due to incorrect long value format, "+
 				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+			return nil, 0, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
 	}
 
 	if l.Count == 1 {
 		return l.VpnCustomerGateways[0], l.Count, nil
 	}
+
+	if _, ok := p.p[firstMatchKey]; ok && len(l.VpnCustomerGateways) > 0 {
+		return l.VpnCustomerGateways[0], l.Count, nil
+	}
 	return nil, l.Count, fmt.Errorf("There is more then one result for VpnCustomerGateway UUID: %s!", id)
 }
 
+// VpnCustomerGatewayExists returns true if a VpnCustomerGateway with the given ID exists. When no match is found
+// it returns false, while any other error is returned as is.
+func (s *VPNService) VpnCustomerGatewayExists(id string, opts ...OptionFunc) (bool, error) {
+	_, count, err := s.GetVpnCustomerGatewayByID(id, opts...)
+	if errors.Is(err, ErrNotFound) {
+		return false, nil
+	}
+	if count > 0 {
+		return true, nil
+	}
+	return false, err
+}
+
 // Lists site to site vpn customer gateways
 func (s *VPNService) ListVpnCustomerGateways(p *ListVpnCustomerGatewaysParams) (*ListVpnCustomerGatewaysResponse, error) {
-	resp, err := s.cs.newRequest("listVpnCustomerGateways", p.toURLValues())
+	return s.ListVpnCustomerGatewaysWithContext(context.Background(), p)
+}
+
+// ListVpnCustomerGatewaysWithContext is the same as ListVpnCustomerGateways, but the request is cancelled when the context is done
+func (s *VPNService) ListVpnCustomerGatewaysWithContext(ctx context.Context, p *ListVpnCustomerGatewaysParams) (*ListVpnCustomerGatewaysResponse, error) {
+	return s.ListVpnCustomerGatewaysRawWithContext(ctx, p.toURLValues())
+}
+
+// ListVpnCustomerGatewaysRaw is the same as ListVpnCustomerGateways, but takes the params as url.Values instead of typed params
+func (s *VPNService) ListVpnCustomerGatewaysRaw(v url.Values) (*ListVpnCustomerGatewaysResponse, error) {
+	return s.ListVpnCustomerGatewaysRawWithContext(context.Background(), v)
+}
+
+// ListVpnCustomerGatewaysRawWithContext is the same as ListVpnCustomerGatewaysRaw, but the request is cancelled when the context is done
+func (s *VPNService) ListVpnCustomerGatewaysRawWithContext(ctx context.Context, v url.Values) (*ListVpnCustomerGatewaysResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "listVpnCustomerGateways", "listvpncustomergatewaysresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -1683,6 +3065,93 @@ func (s *VPNService) ListVpnCustomerGateways(p *ListVpnCustomerGatewaysParams) (
 	return &r, nil
 }
 
+// StreamVpnCustomerGateways fetches the results of ListVpnCustomerGateways page by page in the background and sends them on the
+// returned channel. Both returned channels are closed when done. If fetching a page fails, or the context
+// is done before all results are sent, the error is sent on the error channel and fetching stops. A
+// request for a page in flight is cancelled when the context is done. The page and pagesize set in the
+// params are ignored.
+func (s *VPNService) StreamVpnCustomerGateways(ctx context.Context, p *ListVpnCustomerGatewaysParams) (<-chan *VpnCustomerGateway, <-chan error) {
+	ch := make(chan *VpnCustomerGateway)
+	errs := make(chan error, 1)
+
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesize(500)
+
+	go func() {
+		defer close(errs)
+		defer close(ch)
+
+		for page, count := 1, 0; ; page++ {
+			pp.SetPage(page)
+
+			l, err := s.ListVpnCustomerGatewaysWithContext(ctx, pp)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			for _, v := range l.VpnCustomerGateways {
+				select {
+				case ch <- v:
+				case <-ctx.Done():
+					errs <- ctx.Err()
+					return
+				}
+			}
+
+			count += len(l.VpnCustomerGateways)
+			if len(l.VpnCustomerGateways) < 500 || count >= l.Count {
+				return
+			}
+		}
+	}()
+
+	return ch, errs
+}
+
+// ListVpnCustomerGatewaysWhere returns all results of ListVpnCustomerGateways for which pred returns true. Note that the results are
+// filtered client-side, so all results matching the params are fetched first.
+// All pages are fetched, so the page and pagesize set in the params are ignored.
+func (s *VPNService) ListVpnCustomerGatewaysWhere(p *ListVpnCustomerGatewaysParams, pred func(*VpnCustomerGateway) bool) ([]*VpnCustomerGateway, error) {
+	var r []*VpnCustomerGateway
+	ch, errs := s.StreamVpnCustomerGateways(context.Background(), p)
+	for v := range ch {
+		if pred(v) {
+			r = append(r, v)
+		}
+	}
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ListAllVpnCustomerGateways returns the results of ListVpnCustomerGateways across all pages, by calling ListVpnCustomerGateways page by page until all
+// results are fetched. The pagesize set in the params is used for every page (500 if not set), and the
+// page set in the params is ignored.
+func (s *VPNService) ListAllVpnCustomerGateways(p *ListVpnCustomerGatewaysParams) ([]*VpnCustomerGateway, error) {
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesizeIfUnset(500)
+	pagesize := pp.p["pagesize"].(int)
+
+	var r []*VpnCustomerGateway
+	for page := 1; ; page++ {
+		pp.SetPage(page)
+
+		l, err := s.ListVpnCustomerGateways(pp)
+		if err != nil {
+			return nil, err
+		}
+		r = append(r, l.VpnCustomerGateways...)
+
+		if len(l.VpnCustomerGateways) < pagesize || len(r) >= l.Count {
+			return r, nil
+		}
+	}
+}
+
 type ListVpnCustomerGatewaysResponse struct {
 	Count               int                   `json:"count"`
 	VpnCustomerGateways []*VpnCustomerGateway `json:"vpncustomergateway"`
@@ -1713,6 +3182,16 @@ type ListVpnGatewaysParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ListVpnGatewaysParams) Clone() *ListVpnGatewaysParams {
+	c := &ListVpnGatewaysParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListVpnGatewaysParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1767,6 +3246,13 @@ func (p *ListVpnGatewaysParams) SetAccount(v string) {
 	return
 }
 
+// SetAccountIfUnset sets account to v, unless it is already set
+func (p *ListVpnGatewaysParams) SetAccountIfUnset(v string) {
+	if _, found := p.p["account"]; !found {
+		p.SetAccount(v)
+	}
+}
+
 func (p *ListVpnGatewaysParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1775,6 +3261,13 @@ func (p *ListVpnGatewaysParams) SetDomainid(v string) {
 	return
 }
 
+// SetDomainidIfUnset sets domainid to v, unless it is already set
+func (p *ListVpnGatewaysParams) SetDomainidIfUnset(v string) {
+	if _, found := p.p["domainid"]; !found {
+		p.SetDomainid(v)
+	}
+}
+
 func (p *ListVpnGatewaysParams) SetFordisplay(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1783,6 +3276,13 @@ func (p *ListVpnGatewaysParams) SetFordisplay(v bool) {
 	return
 }
 
+// SetFordisplayIfUnset sets fordisplay to v, unless it is already set
+func (p *ListVpnGatewaysParams) SetFordisplayIfUnset(v bool) {
+	if _, found := p.p["fordisplay"]; !found {
+		p.SetFordisplay(v)
+	}
+}
+
 func (p *ListVpnGatewaysParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1791,6 +3291,13 @@ func (p *ListVpnGatewaysParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *ListVpnGatewaysParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 func (p *ListVpnGatewaysParams) SetIsrecursive(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1799,6 +3306,13 @@ func (p *ListVpnGatewaysParams) SetIsrecursive(v bool) {
 	return
 }
 
+// SetIsrecursiveIfUnset sets isrecursive to v, unless it is already set
+func (p *ListVpnGatewaysParams) SetIsrecursiveIfUnset(v bool) {
+	if _, found := p.p["isrecursive"]; !found {
+		p.SetIsrecursive(v)
+	}
+}
+
 func (p *ListVpnGatewaysParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1807,6 +3321,13 @@ func (p *ListVpnGatewaysParams) SetKeyword(v string) {
 	return
 }
 
+// SetKeywordIfUnset sets keyword to v, unless it is already set
+func (p *ListVpnGatewaysParams) SetKeywordIfUnset(v string) {
+	if _, found := p.p["keyword"]; !found {
+		p.SetKeyword(v)
+	}
+}
+
 func (p *ListVpnGatewaysParams) SetListall(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1815,6 +3336,13 @@ func (p *ListVpnGatewaysParams) SetListall(v bool) {
 	return
 }
 
+// SetListallIfUnset sets listall to v, unless it is already set
+func (p *ListVpnGatewaysParams) SetListallIfUnset(v bool) {
+	if _, found := p.p["listall"]; !found {
+		p.SetListall(v)
+	}
+}
+
 func (p *ListVpnGatewaysParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1823,6 +3351,13 @@ func (p *ListVpnGatewaysParams) SetPage(v int) {
 	return
 }
 
+// SetPageIfUnset sets page to v, unless it is already set
+func (p *ListVpnGatewaysParams) SetPageIfUnset(v int) {
+	if _, found := p.p["page"]; !found {
+		p.SetPage(v)
+	}
+}
+
 func (p *ListVpnGatewaysParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1831,6 +3366,13 @@ func (p *ListVpnGatewaysParams) SetPagesize(v int) {
 	return
 }
 
+// SetPagesizeIfUnset sets pagesize to v, unless it is already set
+func (p *ListVpnGatewaysParams) SetPagesizeIfUnset(v int) {
+	if _, found := p.p["pagesize"]; !found {
+		p.SetPagesize(v)
+	}
+}
+
 func (p *ListVpnGatewaysParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1839,6 +3381,13 @@ func (p *ListVpnGatewaysParams) SetProjectid(v string) {
 	return
 }
 
+// SetProjectidIfUnset sets projectid to v, unless it is already set
+func (p *ListVpnGatewaysParams) SetProjectidIfUnset(v string) {
+	if _, found := p.p["projectid"]; !found {
+		p.SetProjectid(v)
+	}
+}
+
 func (p *ListVpnGatewaysParams) SetVpcid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1847,14 +3396,34 @@ func (p *ListVpnGatewaysParams) SetVpcid(v string) {
 	return
 }
 
+// SetVpcidIfUnset sets vpcid to v, unless it is already set
+func (p *ListVpnGatewaysParams) SetVpcidIfUnset(v string) {
+	if _, found := p.p["vpcid"]; !found {
+		p.SetVpcid(v)
+	}
+}
+
+var _ ListAllSetter = (*ListVpnGatewaysParams)(nil)
+
 // You should always use this function to get a new ListVpnGatewaysParams instance,
-// as then you are sure you have configured all required params
-func (s *VPNService) NewListVpnGatewaysParams() *ListVpnGatewaysParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *VPNService) NewListVpnGatewaysParams(opts ...func(*ListVpnGatewaysParams)) *ListVpnGatewaysParams {
 	p := &ListVpnGatewaysParams{}
 	p.p = make(map[string]interface{})
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
+func (p *ListVpnGatewaysParams) setFirstMatch() {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p[firstMatchKey] = true
+}
+
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *VPNService) GetVpnGatewayByID(id string, opts ...OptionFunc) (*VpnGateway, int, error) {
 	p := &ListVpnGatewaysParams{}
@@ -1862,7 +3431,7 @@ func (s *VPNService) GetVpnGatewayByID(id string, opts ...OptionFunc) (*VpnGatew
 
 	p.p["id"] = id
 
-	for _, fn := range append(s.cs.options, opts...) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
 		if err := fn(s.cs, p); err != nil {
 			return nil, -1, err
 		}
@@ -1873,24 +3442,56 @@ func (s *VPNService) GetVpnGatewayByID(id string, opts ...OptionFunc) (*VpnGatew
 		if strings.Contains(err.Error(), fmt.Sprintf(
 			"Invalid parameter id value=%s due to incorrect long value format, "+
 				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+			return nil, 0, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
 	}
 
 	if l.Count == 1 {
 		return l.VpnGateways[0], l.Count, nil
 	}
+
+	if _, ok := p.p[firstMatchKey]; ok && len(l.VpnGateways) > 0 {
+		return l.VpnGateways[0], l.Count, nil
+	}
 	return nil, l.Count, fmt.Errorf("There is more then one result for VpnGateway UUID: %s!", id)
 }
 
+// VpnGatewayExists returns true if a VpnGateway with the given ID exists. When no match is found
+// it returns false, while any other error is returned as is.
+func (s *VPNService) VpnGatewayExists(id string, opts ...OptionFunc) (bool, error) {
+	_, count, err := s.GetVpnGatewayByID(id, opts...)
+	if errors.Is(err, ErrNotFound) {
+		return false, nil
+	}
+	if count > 0 {
+		return true, nil
+	}
+	return false, err
+}
+
 // Lists site 2 site vpn gateways
 func (s *VPNService) ListVpnGateways(p *ListVpnGatewaysParams) (*ListVpnGatewaysResponse, error) {
-	resp, err := s.cs.newRequest("listVpnGateways", p.toURLValues())
+	return s.ListVpnGatewaysWithContext(context.Background(), p)
+}
+
+// ListVpnGatewaysWithContext is the same as ListVpnGateways, but the request is cancelled when the context is done
+func (s *VPNService) ListVpnGatewaysWithContext(ctx context.Context, p *ListVpnGatewaysParams) (*ListVpnGatewaysResponse, error) {
+	return s.ListVpnGatewaysRawWithContext(ctx, p.toURLValues())
+}
+
+// ListVpnGatewaysRaw is the same as ListVpnGateways, but takes the params as url.Values instead of typed params
+func (s *VPNService) ListVpnGatewaysRaw(v url.Values) (*ListVpnGatewaysResponse, error) {
+	return s.ListVpnGatewaysRawWithContext(context.Background(), v)
+}
+
+// ListVpnGatewaysRawWithContext is the same as ListVpnGatewaysRaw, but the request is cancelled when the context is done
+func (s *VPNService) ListVpnGatewaysRawWithContext(ctx context.Context, v url.Values) (*ListVpnGatewaysResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "listVpnGateways", "listvpngatewaysresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -1903,6 +3504,93 @@ func (s *VPNService) ListVpnGateways(p *ListVpnGatewaysParams) (*ListVpnGateways
 	return &r, nil
 }
 
+// StreamVpnGateways fetches the results of ListVpnGateways page by page in the background and sends them on the
+// returned channel. Both returned channels are closed when done. If fetching a page fails, or the context
+// is done before all results are sent, the error is sent on the error channel and fetching stops. A
+// request for a page in flight is cancelled when the context is done. The page and pagesize set in the
+// params are ignored.
+func (s *VPNService) StreamVpnGateways(ctx context.Context, p *ListVpnGatewaysParams) (<-chan *VpnGateway, <-chan error) {
+	ch := make(chan *VpnGateway)
+	errs := make(chan error, 1)
+
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesize(500)
+
+	go func() {
+		defer close(errs)
+		defer close(ch)
+
+		for page, count := 1, 0; ; page++ {
+			pp.SetPage(page)
+
+			l, err := s.ListVpnGatewaysWithContext(ctx, pp)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			for _, v := range l.VpnGateways {
+				select {
+				case ch <- v:
+				case <-ctx.Done():
+					errs <- ctx.Err()
+					return
+				}
+			}
+
+			count += len(l.VpnGateways)
+			if len(l.VpnGateways) < 500 || count >= l.Count {
+				return
+			}
+		}
+	}()
+
+	return ch, errs
+}
+
+// ListVpnGatewaysWhere returns all results of ListVpnGateways for which pred returns true. Note that the results are
+// filtered client-side, so all results matching the params are fetched first.
+// All pages are fetched, so the page and pagesize set in the params are ignored.
+func (s *VPNService) ListVpnGatewaysWhere(p *ListVpnGatewaysParams, pred func(*VpnGateway) bool) ([]*VpnGateway, error) {
+	var r []*VpnGateway
+	ch, errs := s.StreamVpnGateways(context.Background(), p)
+	for v := range ch {
+		if pred(v) {
+			r = append(r, v)
+		}
+	}
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ListAllVpnGateways returns the results of ListVpnGateways across all pages, by calling ListVpnGateways page by page until all
+// results are fetched. The pagesize set in the params is used for every page (500 if not set), and the
+// page set in the params is ignored.
+func (s *VPNService) ListAllVpnGateways(p *ListVpnGatewaysParams) ([]*VpnGateway, error) {
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesizeIfUnset(500)
+	pagesize := pp.p["pagesize"].(int)
+
+	var r []*VpnGateway
+	for page := 1; ; page++ {
+		pp.SetPage(page)
+
+		l, err := s.ListVpnGateways(pp)
+		if err != nil {
+			return nil, err
+		}
+		r = append(r, l.VpnGateways...)
+
+		if len(l.VpnGateways) < pagesize || len(r) >= l.Count {
+			return r, nil
+		}
+	}
+}
+
 type ListVpnGatewaysResponse struct {
 	Count       int           `json:"count"`
 	VpnGateways []*VpnGateway `json:"vpngateway"`
@@ -1925,6 +3613,16 @@ type ListVpnUsersParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ListVpnUsersParams) Clone() *ListVpnUsersParams {
+	c := &ListVpnUsersParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListVpnUsersParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1975,6 +3673,13 @@ func (p *ListVpnUsersParams) SetAccount(v string) {
 	return
 }
 
+// SetAccountIfUnset sets account to v, unless it is already set
+func (p *ListVpnUsersParams) SetAccountIfUnset(v string) {
+	if _, found := p.p["account"]; !found {
+		p.SetAccount(v)
+	}
+}
+
 func (p *ListVpnUsersParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1983,6 +3688,13 @@ func (p *ListVpnUsersParams) SetDomainid(v string) {
 	return
 }
 
+// SetDomainidIfUnset sets domainid to v, unless it is already set
+func (p *ListVpnUsersParams) SetDomainidIfUnset(v string) {
+	if _, found := p.p["domainid"]; !found {
+		p.SetDomainid(v)
+	}
+}
+
 func (p *ListVpnUsersParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1991,6 +3703,13 @@ func (p *ListVpnUsersParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *ListVpnUsersParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 func (p *ListVpnUsersParams) SetIsrecursive(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1999,6 +3718,13 @@ func (p *ListVpnUsersParams) SetIsrecursive(v bool) {
 	return
 }
 
+// SetIsrecursiveIfUnset sets isrecursive to v, unless it is already set
+func (p *ListVpnUsersParams) SetIsrecursiveIfUnset(v bool) {
+	if _, found := p.p["isrecursive"]; !found {
+		p.SetIsrecursive(v)
+	}
+}
+
 func (p *ListVpnUsersParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2007,6 +3733,13 @@ func (p *ListVpnUsersParams) SetKeyword(v string) {
 	return
 }
 
+// SetKeywordIfUnset sets keyword to v, unless it is already set
+func (p *ListVpnUsersParams) SetKeywordIfUnset(v string) {
+	if _, found := p.p["keyword"]; !found {
+		p.SetKeyword(v)
+	}
+}
+
 func (p *ListVpnUsersParams) SetListall(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2015,6 +3748,13 @@ func (p *ListVpnUsersParams) SetListall(v bool) {
 	return
 }
 
+// SetListallIfUnset sets listall to v, unless it is already set
+func (p *ListVpnUsersParams) SetListallIfUnset(v bool) {
+	if _, found := p.p["listall"]; !found {
+		p.SetListall(v)
+	}
+}
+
 func (p *ListVpnUsersParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2023,6 +3763,13 @@ func (p *ListVpnUsersParams) SetPage(v int) {
 	return
 }
 
+// SetPageIfUnset sets page to v, unless it is already set
+func (p *ListVpnUsersParams) SetPageIfUnset(v int) {
+	if _, found := p.p["page"]; !found {
+		p.SetPage(v)
+	}
+}
+
 func (p *ListVpnUsersParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2031,6 +3778,13 @@ func (p *ListVpnUsersParams) SetPagesize(v int) {
 	return
 }
 
+// SetPagesizeIfUnset sets pagesize to v, unless it is already set
+func (p *ListVpnUsersParams) SetPagesizeIfUnset(v int) {
+	if _, found := p.p["pagesize"]; !found {
+		p.SetPagesize(v)
+	}
+}
+
 func (p *ListVpnUsersParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2039,6 +3793,13 @@ func (p *ListVpnUsersParams) SetProjectid(v string) {
 	return
 }
 
+// SetProjectidIfUnset sets projectid to v, unless it is already set
+func (p *ListVpnUsersParams) SetProjectidIfUnset(v string) {
+	if _, found := p.p["projectid"]; !found {
+		p.SetProjectid(v)
+	}
+}
+
 func (p *ListVpnUsersParams) SetUsername(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2047,14 +3808,34 @@ func (p *ListVpnUsersParams) SetUsername(v string) {
 	return
 }
 
+// SetUsernameIfUnset sets username to v, unless it is already set
+func (p *ListVpnUsersParams) SetUsernameIfUnset(v string) {
+	if _, found := p.p["username"]; !found {
+		p.SetUsername(v)
+	}
+}
+
+var _ ListAllSetter = (*ListVpnUsersParams)(nil)
+
 // You should always use this function to get a new ListVpnUsersParams instance,
-// as then you are sure you have configured all required params
-func (s *VPNService) NewListVpnUsersParams() *ListVpnUsersParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *VPNService) NewListVpnUsersParams(opts ...func(*ListVpnUsersParams)) *ListVpnUsersParams {
 	p := &ListVpnUsersParams{}
 	p.p = make(map[string]interface{})
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
+func (p *ListVpnUsersParams) setFirstMatch() {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p[firstMatchKey] = true
+}
+
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *VPNService) GetVpnUserByID(id string, opts ...OptionFunc) (*VpnUser, int, error) {
 	p := &ListVpnUsersParams{}
@@ -2062,7 +3843,7 @@ func (s *VPNService) GetVpnUserByID(id string, opts ...OptionFunc) (*VpnUser, in
 
 	p.p["id"] = id
 
-	for _, fn := range append(s.cs.options, opts...) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
 		if err := fn(s.cs, p); err != nil {
 			return nil, -1, err
 		}
@@ -2073,34 +3854,153 @@ func (s *VPNService) GetVpnUserByID(id string, opts ...OptionFunc) (*VpnUser, in
 		if strings.Contains(err.Error(), fmt.Sprintf(
 			"Invalid parameter id value=%s due to incorrect long value format, "+
 				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+			return nil, 0, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
+	}
+
+	if l.Count == 1 {
+		return l.VpnUsers[0], l.Count, nil
+	}
+
+	if _, ok := p.p[firstMatchKey]; ok && len(l.VpnUsers) > 0 {
+		return l.VpnUsers[0], l.Count, nil
 	}
+	return nil, l.Count, fmt.Errorf("There is more then one result for VpnUser UUID: %s!", id)
+}
+
+// VpnUserExists returns true if a VpnUser with the given ID exists. When no match is found
+// it returns false, while any other error is returned as is.
+func (s *VPNService) VpnUserExists(id string, opts ...OptionFunc) (bool, error) {
+	_, count, err := s.GetVpnUserByID(id, opts...)
+	if errors.Is(err, ErrNotFound) {
+		return false, nil
+	}
+	if count > 0 {
+		return true, nil
+	}
+	return false, err
+}
+
+// Lists vpn users
+func (s *VPNService) ListVpnUsers(p *ListVpnUsersParams) (*ListVpnUsersResponse, error) {
+	return s.ListVpnUsersWithContext(context.Background(), p)
+}
+
+// ListVpnUsersWithContext is the same as ListVpnUsers, but the request is cancelled when the context is done
+func (s *VPNService) ListVpnUsersWithContext(ctx context.Context, p *ListVpnUsersParams) (*ListVpnUsersResponse, error) {
+	return s.ListVpnUsersRawWithContext(ctx, p.toURLValues())
+}
+
+// ListVpnUsersRaw is the same as ListVpnUsers, but takes the params as url.Values instead of typed params
+func (s *VPNService) ListVpnUsersRaw(v url.Values) (*ListVpnUsersResponse, error) {
+	return s.ListVpnUsersRawWithContext(context.Background(), v)
+}
+
+// ListVpnUsersRawWithContext is the same as ListVpnUsersRaw, but the request is cancelled when the context is done
+func (s *VPNService) ListVpnUsersRawWithContext(ctx context.Context, v url.Values) (*ListVpnUsersResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "listVpnUsers", "listvpnusersresponse", v)
+	if err != nil {
+		return nil, err
+	}
+
+	var r ListVpnUsersResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+// StreamVpnUsers fetches the results of ListVpnUsers page by page in the background and sends them on the
+// returned channel. Both returned channels are closed when done. If fetching a page fails, or the context
+// is done before all results are sent, the error is sent on the error channel and fetching stops. A
+// request for a page in flight is cancelled when the context is done. The page and pagesize set in the
+// params are ignored.
+func (s *VPNService) StreamVpnUsers(ctx context.Context, p *ListVpnUsersParams) (<-chan *VpnUser, <-chan error) {
+	ch := make(chan *VpnUser)
+	errs := make(chan error, 1)
+
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesize(500)
+
+	go func() {
+		defer close(errs)
+		defer close(ch)
+
+		for page, count := 1, 0; ; page++ {
+			pp.SetPage(page)
+
+			l, err := s.ListVpnUsersWithContext(ctx, pp)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			for _, v := range l.VpnUsers {
+				select {
+				case ch <- v:
+				case <-ctx.Done():
+					errs <- ctx.Err()
+					return
+				}
+			}
 
-	if l.Count == 1 {
-		return l.VpnUsers[0], l.Count, nil
-	}
-	return nil, l.Count, fmt.Errorf("There is more then one result for VpnUser UUID: %s!", id)
+			count += len(l.VpnUsers)
+			if len(l.VpnUsers) < 500 || count >= l.Count {
+				return
+			}
+		}
+	}()
+
+	return ch, errs
 }
 
-// Lists vpn users
-func (s *VPNService) ListVpnUsers(p *ListVpnUsersParams) (*ListVpnUsersResponse, error) {
-	resp, err := s.cs.newRequest("listVpnUsers", p.toURLValues())
-	if err != nil {
-		return nil, err
+// ListVpnUsersWhere returns all results of ListVpnUsers for which pred returns true. Note that the results are
+// filtered client-side, so all results matching the params are fetched first.
+// All pages are fetched, so the page and pagesize set in the params are ignored.
+func (s *VPNService) ListVpnUsersWhere(p *ListVpnUsersParams, pred func(*VpnUser) bool) ([]*VpnUser, error) {
+	var r []*VpnUser
+	ch, errs := s.StreamVpnUsers(context.Background(), p)
+	for v := range ch {
+		if pred(v) {
+			r = append(r, v)
+		}
 	}
-
-	var r ListVpnUsersResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := <-errs; err != nil {
 		return nil, err
 	}
+	return r, nil
+}
 
-	return &r, nil
+// ListAllVpnUsers returns the results of ListVpnUsers across all pages, by calling ListVpnUsers page by page until all
+// results are fetched. The pagesize set in the params is used for every page (500 if not set), and the
+// page set in the params is ignored.
+func (s *VPNService) ListAllVpnUsers(p *ListVpnUsersParams) ([]*VpnUser, error) {
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesizeIfUnset(500)
+	pagesize := pp.p["pagesize"].(int)
+
+	var r []*VpnUser
+	for page := 1; ; page++ {
+		pp.SetPage(page)
+
+		l, err := s.ListVpnUsers(pp)
+		if err != nil {
+			return nil, err
+		}
+		r = append(r, l.VpnUsers...)
+
+		if len(l.VpnUsers) < pagesize || len(r) >= l.Count {
+			return r, nil
+		}
+	}
 }
 
 type ListVpnUsersResponse struct {
@@ -2123,6 +4023,16 @@ type RemoveVpnUserParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *RemoveVpnUserParams) Clone() *RemoveVpnUserParams {
+	c := &RemoveVpnUserParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *RemoveVpnUserParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -2143,6 +4053,16 @@ func (p *RemoveVpnUserParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *RemoveVpnUserParams) validate() error {
+	for _, k := range []string{"username"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of removeVpnUser", k)
+		}
+	}
+	return nil
+}
+
 func (p *RemoveVpnUserParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2151,6 +4071,13 @@ func (p *RemoveVpnUserParams) SetAccount(v string) {
 	return
 }
 
+// SetAccountIfUnset sets account to v, unless it is already set
+func (p *RemoveVpnUserParams) SetAccountIfUnset(v string) {
+	if _, found := p.p["account"]; !found {
+		p.SetAccount(v)
+	}
+}
+
 func (p *RemoveVpnUserParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2159,6 +4086,13 @@ func (p *RemoveVpnUserParams) SetDomainid(v string) {
 	return
 }
 
+// SetDomainidIfUnset sets domainid to v, unless it is already set
+func (p *RemoveVpnUserParams) SetDomainidIfUnset(v string) {
+	if _, found := p.p["domainid"]; !found {
+		p.SetDomainid(v)
+	}
+}
+
 func (p *RemoveVpnUserParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2167,6 +4101,13 @@ func (p *RemoveVpnUserParams) SetProjectid(v string) {
 	return
 }
 
+// SetProjectidIfUnset sets projectid to v, unless it is already set
+func (p *RemoveVpnUserParams) SetProjectidIfUnset(v string) {
+	if _, found := p.p["projectid"]; !found {
+		p.SetProjectid(v)
+	}
+}
+
 func (p *RemoveVpnUserParams) SetUsername(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2175,18 +4116,54 @@ func (p *RemoveVpnUserParams) SetUsername(v string) {
 	return
 }
 
+// SetUsernameIfUnset sets username to v, unless it is already set
+func (p *RemoveVpnUserParams) SetUsernameIfUnset(v string) {
+	if _, found := p.p["username"]; !found {
+		p.SetUsername(v)
+	}
+}
+
 // You should always use this function to get a new RemoveVpnUserParams instance,
-// as then you are sure you have configured all required params
-func (s *VPNService) NewRemoveVpnUserParams(username string) *RemoveVpnUserParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *VPNService) NewRemoveVpnUserParams(username string, opts ...func(*RemoveVpnUserParams)) *RemoveVpnUserParams {
 	p := &RemoveVpnUserParams{}
 	p.p = make(map[string]interface{})
 	p.p["username"] = username
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Removes vpn user
-func (s *VPNService) RemoveVpnUser(p *RemoveVpnUserParams) (*RemoveVpnUserResponse, error) {
-	resp, err := s.cs.newRequest("removeVpnUser", p.toURLValues())
+func (s *VPNService) RemoveVpnUser(p *RemoveVpnUserParams, opts ...OptionFunc) (*RemoveVpnUserResponse, error) {
+	return s.RemoveVpnUserWithContext(context.Background(), p, opts...)
+}
+
+// RemoveVpnUserWithContext is the same as RemoveVpnUser, but the request is cancelled when the context is done
+func (s *VPNService) RemoveVpnUserWithContext(ctx context.Context, p *RemoveVpnUserParams, opts ...OptionFunc) (*RemoveVpnUserResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.RemoveVpnUserRawWithContext(ctx, p.toURLValues())
+}
+
+// RemoveVpnUserRaw is the same as RemoveVpnUser, but takes the params as url.Values instead of typed params
+func (s *VPNService) RemoveVpnUserRaw(v url.Values) (*RemoveVpnUserResponse, error) {
+	return s.RemoveVpnUserRawWithContext(context.Background(), v)
+}
+
+// RemoveVpnUserRawWithContext is the same as RemoveVpnUserRaw, but the request is cancelled when the context is done
+func (s *VPNService) RemoveVpnUserRawWithContext(ctx context.Context, v url.Values) (*RemoveVpnUserResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "removeVpnUser", "removevpnuserresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -2198,9 +4175,9 @@ func (s *VPNService) RemoveVpnUser(p *RemoveVpnUserParams) (*RemoveVpnUserRespon
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -2224,6 +4201,16 @@ type ResetVpnConnectionParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ResetVpnConnectionParams) Clone() *ResetVpnConnectionParams {
+	c := &ResetVpnConnectionParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ResetVpnConnectionParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -2241,6 +4228,16 @@ func (p *ResetVpnConnectionParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *ResetVpnConnectionParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of resetVpnConnection", k)
+		}
+	}
+	return nil
+}
+
 func (p *ResetVpnConnectionParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2249,6 +4246,13 @@ func (p *ResetVpnConnectionParams) SetAccount(v string) {
 	return
 }
 
+// SetAccountIfUnset sets account to v, unless it is already set
+func (p *ResetVpnConnectionParams) SetAccountIfUnset(v string) {
+	if _, found := p.p["account"]; !found {
+		p.SetAccount(v)
+	}
+}
+
 func (p *ResetVpnConnectionParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2257,6 +4261,13 @@ func (p *ResetVpnConnectionParams) SetDomainid(v string) {
 	return
 }
 
+// SetDomainidIfUnset sets domainid to v, unless it is already set
+func (p *ResetVpnConnectionParams) SetDomainidIfUnset(v string) {
+	if _, found := p.p["domainid"]; !found {
+		p.SetDomainid(v)
+	}
+}
+
 func (p *ResetVpnConnectionParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2265,18 +4276,54 @@ func (p *ResetVpnConnectionParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *ResetVpnConnectionParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 // You should always use this function to get a new ResetVpnConnectionParams instance,
-// as then you are sure you have configured all required params
-func (s *VPNService) NewResetVpnConnectionParams(id string) *ResetVpnConnectionParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *VPNService) NewResetVpnConnectionParams(id string, opts ...func(*ResetVpnConnectionParams)) *ResetVpnConnectionParams {
 	p := &ResetVpnConnectionParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Reset site to site vpn connection
-func (s *VPNService) ResetVpnConnection(p *ResetVpnConnectionParams) (*ResetVpnConnectionResponse, error) {
-	resp, err := s.cs.newRequest("resetVpnConnection", p.toURLValues())
+func (s *VPNService) ResetVpnConnection(p *ResetVpnConnectionParams, opts ...OptionFunc) (*ResetVpnConnectionResponse, error) {
+	return s.ResetVpnConnectionWithContext(context.Background(), p, opts...)
+}
+
+// ResetVpnConnectionWithContext is the same as ResetVpnConnection, but the request is cancelled when the context is done
+func (s *VPNService) ResetVpnConnectionWithContext(ctx context.Context, p *ResetVpnConnectionParams, opts ...OptionFunc) (*ResetVpnConnectionResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.ResetVpnConnectionRawWithContext(ctx, p.toURLValues())
+}
+
+// ResetVpnConnectionRaw is the same as ResetVpnConnection, but takes the params as url.Values instead of typed params
+func (s *VPNService) ResetVpnConnectionRaw(v url.Values) (*ResetVpnConnectionResponse, error) {
+	return s.ResetVpnConnectionRawWithContext(context.Background(), v)
+}
+
+// ResetVpnConnectionRawWithContext is the same as ResetVpnConnectionRaw, but the request is cancelled when the context is done
+func (s *VPNService) ResetVpnConnectionRawWithContext(ctx context.Context, v url.Values) (*ResetVpnConnectionResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "resetVpnConnection", "resetvpnconnectionresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -2288,9 +4335,9 @@ func (s *VPNService) ResetVpnConnection(p *ResetVpnConnectionParams) (*ResetVpnC
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -2340,6 +4387,16 @@ type UpdateRemoteAccessVpnParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *UpdateRemoteAccessVpnParams) Clone() *UpdateRemoteAccessVpnParams {
+	c := &UpdateRemoteAccessVpnParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *UpdateRemoteAccessVpnParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -2358,6 +4415,16 @@ func (p *UpdateRemoteAccessVpnParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *UpdateRemoteAccessVpnParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of updateRemoteAccessVpn", k)
+		}
+	}
+	return nil
+}
+
 func (p *UpdateRemoteAccessVpnParams) SetCustomid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2366,6 +4433,13 @@ func (p *UpdateRemoteAccessVpnParams) SetCustomid(v string) {
 	return
 }
 
+// SetCustomidIfUnset sets customid to v, unless it is already set
+func (p *UpdateRemoteAccessVpnParams) SetCustomidIfUnset(v string) {
+	if _, found := p.p["customid"]; !found {
+		p.SetCustomid(v)
+	}
+}
+
 func (p *UpdateRemoteAccessVpnParams) SetFordisplay(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2374,6 +4448,13 @@ func (p *UpdateRemoteAccessVpnParams) SetFordisplay(v bool) {
 	return
 }
 
+// SetFordisplayIfUnset sets fordisplay to v, unless it is already set
+func (p *UpdateRemoteAccessVpnParams) SetFordisplayIfUnset(v bool) {
+	if _, found := p.p["fordisplay"]; !found {
+		p.SetFordisplay(v)
+	}
+}
+
 func (p *UpdateRemoteAccessVpnParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2382,18 +4463,54 @@ func (p *UpdateRemoteAccessVpnParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *UpdateRemoteAccessVpnParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 // You should always use this function to get a new UpdateRemoteAccessVpnParams instance,
-// as then you are sure you have configured all required params
-func (s *VPNService) NewUpdateRemoteAccessVpnParams(id string) *UpdateRemoteAccessVpnParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *VPNService) NewUpdateRemoteAccessVpnParams(id string, opts ...func(*UpdateRemoteAccessVpnParams)) *UpdateRemoteAccessVpnParams {
 	p := &UpdateRemoteAccessVpnParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Updates remote access vpn
-func (s *VPNService) UpdateRemoteAccessVpn(p *UpdateRemoteAccessVpnParams) (*UpdateRemoteAccessVpnResponse, error) {
-	resp, err := s.cs.newRequest("updateRemoteAccessVpn", p.toURLValues())
+func (s *VPNService) UpdateRemoteAccessVpn(p *UpdateRemoteAccessVpnParams, opts ...OptionFunc) (*UpdateRemoteAccessVpnResponse, error) {
+	return s.UpdateRemoteAccessVpnWithContext(context.Background(), p, opts...)
+}
+
+// UpdateRemoteAccessVpnWithContext is the same as UpdateRemoteAccessVpn, but the request is cancelled when the context is done
+func (s *VPNService) UpdateRemoteAccessVpnWithContext(ctx context.Context, p *UpdateRemoteAccessVpnParams, opts ...OptionFunc) (*UpdateRemoteAccessVpnResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.UpdateRemoteAccessVpnRawWithContext(ctx, p.toURLValues())
+}
+
+// UpdateRemoteAccessVpnRaw is the same as UpdateRemoteAccessVpn, but takes the params as url.Values instead of typed params
+func (s *VPNService) UpdateRemoteAccessVpnRaw(v url.Values) (*UpdateRemoteAccessVpnResponse, error) {
+	return s.UpdateRemoteAccessVpnRawWithContext(context.Background(), v)
+}
+
+// UpdateRemoteAccessVpnRawWithContext is the same as UpdateRemoteAccessVpnRaw, but the request is cancelled when the context is done
+func (s *VPNService) UpdateRemoteAccessVpnRawWithContext(ctx context.Context, v url.Values) (*UpdateRemoteAccessVpnResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "updateRemoteAccessVpn", "updateremoteaccessvpnresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -2405,9 +4522,9 @@ func (s *VPNService) UpdateRemoteAccessVpn(p *UpdateRemoteAccessVpnParams) (*Upd
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -2446,6 +4563,16 @@ type UpdateVpnConnectionParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *UpdateVpnConnectionParams) Clone() *UpdateVpnConnectionParams {
+	c := &UpdateVpnConnectionParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *UpdateVpnConnectionParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -2464,6 +4591,16 @@ func (p *UpdateVpnConnectionParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *UpdateVpnConnectionParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of updateVpnConnection", k)
+		}
+	}
+	return nil
+}
+
 func (p *UpdateVpnConnectionParams) SetCustomid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2472,6 +4609,13 @@ func (p *UpdateVpnConnectionParams) SetCustomid(v string) {
 	return
 }
 
+// SetCustomidIfUnset sets customid to v, unless it is already set
+func (p *UpdateVpnConnectionParams) SetCustomidIfUnset(v string) {
+	if _, found := p.p["customid"]; !found {
+		p.SetCustomid(v)
+	}
+}
+
 func (p *UpdateVpnConnectionParams) SetFordisplay(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2480,6 +4624,13 @@ func (p *UpdateVpnConnectionParams) SetFordisplay(v bool) {
 	return
 }
 
+// SetFordisplayIfUnset sets fordisplay to v, unless it is already set
+func (p *UpdateVpnConnectionParams) SetFordisplayIfUnset(v bool) {
+	if _, found := p.p["fordisplay"]; !found {
+		p.SetFordisplay(v)
+	}
+}
+
 func (p *UpdateVpnConnectionParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2488,18 +4639,54 @@ func (p *UpdateVpnConnectionParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *UpdateVpnConnectionParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 // You should always use this function to get a new UpdateVpnConnectionParams instance,
-// as then you are sure you have configured all required params
-func (s *VPNService) NewUpdateVpnConnectionParams(id string) *UpdateVpnConnectionParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *VPNService) NewUpdateVpnConnectionParams(id string, opts ...func(*UpdateVpnConnectionParams)) *UpdateVpnConnectionParams {
 	p := &UpdateVpnConnectionParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Updates site to site vpn connection
-func (s *VPNService) UpdateVpnConnection(p *UpdateVpnConnectionParams) (*UpdateVpnConnectionResponse, error) {
-	resp, err := s.cs.newRequest("updateVpnConnection", p.toURLValues())
+func (s *VPNService) UpdateVpnConnection(p *UpdateVpnConnectionParams, opts ...OptionFunc) (*UpdateVpnConnectionResponse, error) {
+	return s.UpdateVpnConnectionWithContext(context.Background(), p, opts...)
+}
+
+// UpdateVpnConnectionWithContext is the same as UpdateVpnConnection, but the request is cancelled when the context is done
+func (s *VPNService) UpdateVpnConnectionWithContext(ctx context.Context, p *UpdateVpnConnectionParams, opts ...OptionFunc) (*UpdateVpnConnectionResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.UpdateVpnConnectionRawWithContext(ctx, p.toURLValues())
+}
+
+// UpdateVpnConnectionRaw is the same as UpdateVpnConnection, but takes the params as url.Values instead of typed params
+func (s *VPNService) UpdateVpnConnectionRaw(v url.Values) (*UpdateVpnConnectionResponse, error) {
+	return s.UpdateVpnConnectionRawWithContext(context.Background(), v)
+}
+
+// UpdateVpnConnectionRawWithContext is the same as UpdateVpnConnectionRaw, but the request is cancelled when the context is done
+func (s *VPNService) UpdateVpnConnectionRawWithContext(ctx context.Context, v url.Values) (*UpdateVpnConnectionResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "updateVpnConnection", "updatevpnconnectionresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -2511,9 +4698,9 @@ func (s *VPNService) UpdateVpnConnection(p *UpdateVpnConnectionParams) (*UpdateV
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -2563,6 +4750,16 @@ type UpdateVpnCustomerGatewayParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *UpdateVpnCustomerGatewayParams) Clone() *UpdateVpnCustomerGatewayParams {
+	c := &UpdateVpnCustomerGatewayParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *UpdateVpnCustomerGatewayParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -2614,6 +4811,16 @@ func (p *UpdateVpnCustomerGatewayParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *UpdateVpnCustomerGatewayParams) validate() error {
+	for _, k := range []string{"cidrlist", "esppolicy", "gateway", "id", "ikepolicy", "ipsecpsk"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of updateVpnCustomerGateway", k)
+		}
+	}
+	return nil
+}
+
 func (p *UpdateVpnCustomerGatewayParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2622,6 +4829,13 @@ func (p *UpdateVpnCustomerGatewayParams) SetAccount(v string) {
 	return
 }
 
+// SetAccountIfUnset sets account to v, unless it is already set
+func (p *UpdateVpnCustomerGatewayParams) SetAccountIfUnset(v string) {
+	if _, found := p.p["account"]; !found {
+		p.SetAccount(v)
+	}
+}
+
 func (p *UpdateVpnCustomerGatewayParams) SetCidrlist(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2630,6 +4844,13 @@ func (p *UpdateVpnCustomerGatewayParams) SetCidrlist(v string) {
 	return
 }
 
+// SetCidrlistIfUnset sets cidrlist to v, unless it is already set
+func (p *UpdateVpnCustomerGatewayParams) SetCidrlistIfUnset(v string) {
+	if _, found := p.p["cidrlist"]; !found {
+		p.SetCidrlist(v)
+	}
+}
+
 func (p *UpdateVpnCustomerGatewayParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2638,6 +4859,13 @@ func (p *UpdateVpnCustomerGatewayParams) SetDomainid(v string) {
 	return
 }
 
+// SetDomainidIfUnset sets domainid to v, unless it is already set
+func (p *UpdateVpnCustomerGatewayParams) SetDomainidIfUnset(v string) {
+	if _, found := p.p["domainid"]; !found {
+		p.SetDomainid(v)
+	}
+}
+
 func (p *UpdateVpnCustomerGatewayParams) SetDpd(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2646,6 +4874,13 @@ func (p *UpdateVpnCustomerGatewayParams) SetDpd(v bool) {
 	return
 }
 
+// SetDpdIfUnset sets dpd to v, unless it is already set
+func (p *UpdateVpnCustomerGatewayParams) SetDpdIfUnset(v bool) {
+	if _, found := p.p["dpd"]; !found {
+		p.SetDpd(v)
+	}
+}
+
 func (p *UpdateVpnCustomerGatewayParams) SetEsplifetime(v int64) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2654,6 +4889,13 @@ func (p *UpdateVpnCustomerGatewayParams) SetEsplifetime(v int64) {
 	return
 }
 
+// SetEsplifetimeIfUnset sets esplifetime to v, unless it is already set
+func (p *UpdateVpnCustomerGatewayParams) SetEsplifetimeIfUnset(v int64) {
+	if _, found := p.p["esplifetime"]; !found {
+		p.SetEsplifetime(v)
+	}
+}
+
 func (p *UpdateVpnCustomerGatewayParams) SetEsppolicy(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2662,6 +4904,13 @@ func (p *UpdateVpnCustomerGatewayParams) SetEsppolicy(v string) {
 	return
 }
 
+// SetEsppolicyIfUnset sets esppolicy to v, unless it is already set
+func (p *UpdateVpnCustomerGatewayParams) SetEsppolicyIfUnset(v string) {
+	if _, found := p.p["esppolicy"]; !found {
+		p.SetEsppolicy(v)
+	}
+}
+
 func (p *UpdateVpnCustomerGatewayParams) SetForceencap(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2670,6 +4919,13 @@ func (p *UpdateVpnCustomerGatewayParams) SetForceencap(v bool) {
 	return
 }
 
+// SetForceencapIfUnset sets forceencap to v, unless it is already set
+func (p *UpdateVpnCustomerGatewayParams) SetForceencapIfUnset(v bool) {
+	if _, found := p.p["forceencap"]; !found {
+		p.SetForceencap(v)
+	}
+}
+
 func (p *UpdateVpnCustomerGatewayParams) SetGateway(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2678,6 +4934,13 @@ func (p *UpdateVpnCustomerGatewayParams) SetGateway(v string) {
 	return
 }
 
+// SetGatewayIfUnset sets gateway to v, unless it is already set
+func (p *UpdateVpnCustomerGatewayParams) SetGatewayIfUnset(v string) {
+	if _, found := p.p["gateway"]; !found {
+		p.SetGateway(v)
+	}
+}
+
 func (p *UpdateVpnCustomerGatewayParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2686,6 +4949,13 @@ func (p *UpdateVpnCustomerGatewayParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *UpdateVpnCustomerGatewayParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 func (p *UpdateVpnCustomerGatewayParams) SetIkelifetime(v int64) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2694,6 +4964,13 @@ func (p *UpdateVpnCustomerGatewayParams) SetIkelifetime(v int64) {
 	return
 }
 
+// SetIkelifetimeIfUnset sets ikelifetime to v, unless it is already set
+func (p *UpdateVpnCustomerGatewayParams) SetIkelifetimeIfUnset(v int64) {
+	if _, found := p.p["ikelifetime"]; !found {
+		p.SetIkelifetime(v)
+	}
+}
+
 func (p *UpdateVpnCustomerGatewayParams) SetIkepolicy(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2702,6 +4979,13 @@ func (p *UpdateVpnCustomerGatewayParams) SetIkepolicy(v string) {
 	return
 }
 
+// SetIkepolicyIfUnset sets ikepolicy to v, unless it is already set
+func (p *UpdateVpnCustomerGatewayParams) SetIkepolicyIfUnset(v string) {
+	if _, found := p.p["ikepolicy"]; !found {
+		p.SetIkepolicy(v)
+	}
+}
+
 func (p *UpdateVpnCustomerGatewayParams) SetIpsecpsk(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2710,6 +4994,13 @@ func (p *UpdateVpnCustomerGatewayParams) SetIpsecpsk(v string) {
 	return
 }
 
+// SetIpsecpskIfUnset sets ipsecpsk to v, unless it is already set
+func (p *UpdateVpnCustomerGatewayParams) SetIpsecpskIfUnset(v string) {
+	if _, found := p.p["ipsecpsk"]; !found {
+		p.SetIpsecpsk(v)
+	}
+}
+
 func (p *UpdateVpnCustomerGatewayParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2718,9 +5009,17 @@ func (p *UpdateVpnCustomerGatewayParams) SetName(v string) {
 	return
 }
 
+// SetNameIfUnset sets name to v, unless it is already set
+func (p *UpdateVpnCustomerGatewayParams) SetNameIfUnset(v string) {
+	if _, found := p.p["name"]; !found {
+		p.SetName(v)
+	}
+}
+
 // You should always use this function to get a new UpdateVpnCustomerGatewayParams instance,
-// as then you are sure you have configured all required params
-func (s *VPNService) NewUpdateVpnCustomerGatewayParams(cidrlist string, esppolicy string, gateway string, id string, ikepolicy string, ipsecpsk string) *UpdateVpnCustomerGatewayParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *VPNService) NewUpdateVpnCustomerGatewayParams(cidrlist string, esppolicy string, gateway string, id string, ikepolicy string, ipsecpsk string, opts ...func(*UpdateVpnCustomerGatewayParams)) *UpdateVpnCustomerGatewayParams {
 	p := &UpdateVpnCustomerGatewayParams{}
 	p.p = make(map[string]interface{})
 	p.p["cidrlist"] = cidrlist
@@ -2729,12 +5028,86 @@ func (s *VPNService) NewUpdateVpnCustomerGatewayParams(cidrlist string, esppolic
 	p.p["id"] = id
 	p.p["ikepolicy"] = ikepolicy
 	p.p["ipsecpsk"] = ipsecpsk
+	for _, fn := range opts {
+		fn(p)
+	}
+	return p
+}
+
+// UpdateVpnCustomerGatewayOpts contains all params that can be set on a UpdateVpnCustomerGatewayParams.
+// Optional params are pointers (or slices and maps) which are only set when not nil.
+type UpdateVpnCustomerGatewayOpts struct {
+	Account     *string
+	Cidrlist    string
+	Domainid    *string
+	Dpd         *bool
+	Esplifetime *int64
+	Esppolicy   string
+	Forceencap  *bool
+	Gateway     string
+	Id          string
+	Ikelifetime *int64
+	Ikepolicy   string
+	Ipsecpsk    string
+	Name        *string
+}
+
+// NewUpdateVpnCustomerGatewayParamsFromOpts is an alternative for NewUpdateVpnCustomerGatewayParams which takes all params using
+// an options struct, as that is easier to read for APIs with many required params
+func (s *VPNService) NewUpdateVpnCustomerGatewayParamsFromOpts(o UpdateVpnCustomerGatewayOpts) *UpdateVpnCustomerGatewayParams {
+	p := s.NewUpdateVpnCustomerGatewayParams(o.Cidrlist, o.Esppolicy, o.Gateway, o.Id, o.Ikepolicy, o.Ipsecpsk)
+	if o.Account != nil {
+		p.p["account"] = *o.Account
+	}
+	if o.Domainid != nil {
+		p.p["domainid"] = *o.Domainid
+	}
+	if o.Dpd != nil {
+		p.p["dpd"] = *o.Dpd
+	}
+	if o.Esplifetime != nil {
+		p.p["esplifetime"] = *o.Esplifetime
+	}
+	if o.Forceencap != nil {
+		p.p["forceencap"] = *o.Forceencap
+	}
+	if o.Ikelifetime != nil {
+		p.p["ikelifetime"] = *o.Ikelifetime
+	}
+	if o.Name != nil {
+		p.p["name"] = *o.Name
+	}
 	return p
 }
 
 // Update site to site vpn customer gateway
-func (s *VPNService) UpdateVpnCustomerGateway(p *UpdateVpnCustomerGatewayParams) (*UpdateVpnCustomerGatewayResponse, error) {
-	resp, err := s.cs.newRequest("updateVpnCustomerGateway", p.toURLValues())
+func (s *VPNService) UpdateVpnCustomerGateway(p *UpdateVpnCustomerGatewayParams, opts ...OptionFunc) (*UpdateVpnCustomerGatewayResponse, error) {
+	return s.UpdateVpnCustomerGatewayWithContext(context.Background(), p, opts...)
+}
+
+// UpdateVpnCustomerGatewayWithContext is the same as UpdateVpnCustomerGateway, but the request is cancelled when the context is done
+func (s *VPNService) UpdateVpnCustomerGatewayWithContext(ctx context.Context, p *UpdateVpnCustomerGatewayParams, opts ...OptionFunc) (*UpdateVpnCustomerGatewayResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.UpdateVpnCustomerGatewayRawWithContext(ctx, p.toURLValues())
+}
+
+// UpdateVpnCustomerGatewayRaw is the same as UpdateVpnCustomerGateway, but takes the params as url.Values instead of typed params
+func (s *VPNService) UpdateVpnCustomerGatewayRaw(v url.Values) (*UpdateVpnCustomerGatewayResponse, error) {
+	return s.UpdateVpnCustomerGatewayRawWithContext(context.Background(), v)
+}
+
+// UpdateVpnCustomerGatewayRawWithContext is the same as UpdateVpnCustomerGatewayRaw, but the request is cancelled when the context is done
+func (s *VPNService) UpdateVpnCustomerGatewayRawWithContext(ctx context.Context, v url.Values) (*UpdateVpnCustomerGatewayResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "updateVpnCustomerGateway", "updatevpncustomergatewayresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -2746,9 +5119,9 @@ func (s *VPNService) UpdateVpnCustomerGateway(p *UpdateVpnCustomerGatewayParams)
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -2793,6 +5166,16 @@ type UpdateVpnGatewayParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *UpdateVpnGatewayParams) Clone() *UpdateVpnGatewayParams {
+	c := &UpdateVpnGatewayParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *UpdateVpnGatewayParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -2811,6 +5194,16 @@ func (p *UpdateVpnGatewayParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *UpdateVpnGatewayParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of updateVpnGateway", k)
+		}
+	}
+	return nil
+}
+
 func (p *UpdateVpnGatewayParams) SetCustomid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2819,6 +5212,13 @@ func (p *UpdateVpnGatewayParams) SetCustomid(v string) {
 	return
 }
 
+// SetCustomidIfUnset sets customid to v, unless it is already set
+func (p *UpdateVpnGatewayParams) SetCustomidIfUnset(v string) {
+	if _, found := p.p["customid"]; !found {
+		p.SetCustomid(v)
+	}
+}
+
 func (p *UpdateVpnGatewayParams) SetFordisplay(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2827,6 +5227,13 @@ func (p *UpdateVpnGatewayParams) SetFordisplay(v bool) {
 	return
 }
 
+// SetFordisplayIfUnset sets fordisplay to v, unless it is already set
+func (p *UpdateVpnGatewayParams) SetFordisplayIfUnset(v bool) {
+	if _, found := p.p["fordisplay"]; !found {
+		p.SetFordisplay(v)
+	}
+}
+
 func (p *UpdateVpnGatewayParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2835,18 +5242,54 @@ func (p *UpdateVpnGatewayParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *UpdateVpnGatewayParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 // You should always use this function to get a new UpdateVpnGatewayParams instance,
-// as then you are sure you have configured all required params
-func (s *VPNService) NewUpdateVpnGatewayParams(id string) *UpdateVpnGatewayParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *VPNService) NewUpdateVpnGatewayParams(id string, opts ...func(*UpdateVpnGatewayParams)) *UpdateVpnGatewayParams {
 	p := &UpdateVpnGatewayParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Updates site to site vpn local gateway
-func (s *VPNService) UpdateVpnGateway(p *UpdateVpnGatewayParams) (*UpdateVpnGatewayResponse, error) {
-	resp, err := s.cs.newRequest("updateVpnGateway", p.toURLValues())
+func (s *VPNService) UpdateVpnGateway(p *UpdateVpnGatewayParams, opts ...OptionFunc) (*UpdateVpnGatewayResponse, error) {
+	return s.UpdateVpnGatewayWithContext(context.Background(), p, opts...)
+}
+
+// UpdateVpnGatewayWithContext is the same as UpdateVpnGateway, but the request is cancelled when the context is done
+func (s *VPNService) UpdateVpnGatewayWithContext(ctx context.Context, p *UpdateVpnGatewayParams, opts ...OptionFunc) (*UpdateVpnGatewayResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.UpdateVpnGatewayRawWithContext(ctx, p.toURLValues())
+}
+
+// UpdateVpnGatewayRaw is the same as UpdateVpnGateway, but takes the params as url.Values instead of typed params
+func (s *VPNService) UpdateVpnGatewayRaw(v url.Values) (*UpdateVpnGatewayResponse, error) {
+	return s.UpdateVpnGatewayRawWithContext(context.Background(), v)
+}
+
+// UpdateVpnGatewayRawWithContext is the same as UpdateVpnGatewayRaw, but the request is cancelled when the context is done
+func (s *VPNService) UpdateVpnGatewayRawWithContext(ctx context.Context, v url.Values) (*UpdateVpnGatewayResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "updateVpnGateway", "updatevpngatewayresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -2858,9 +5301,9 @@ func (s *VPNService) UpdateVpnGateway(p *UpdateVpnGatewayParams) (*UpdateVpnGate
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
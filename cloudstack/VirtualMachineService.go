@@ -17,17 +17,522 @@
 package cloudstack
 
 import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
 	"encoding/json"
+	"encoding/pem"
+	"errors"
 	"fmt"
 	"net/url"
+	"sort"
 	"strconv"
 	"strings"
 )
 
+// DeployWithCapacityRetry deploys the virtual machine and waits for the deploy to finish. When the deploy
+// fails because of insufficient capacity (see IsInsufficientCapacity), it is retried in each of the
+// candidate clusters in turn (by setting the clusterid of the params) until a deploy succeeds. As a cluster
+// belongs to a single pod, any pod or host set in the params is cleared on a retry. Any other error is
+// returned immediately, and when the deploy fails in all candidates the last error is returned. The params
+// are copied, so the params of the caller are not changed. The options are used when deploying and getting
+// the deployed virtual machine.
+func (s *VirtualMachineService) DeployWithCapacityRetry(p *DeployVirtualMachineParams, candidateClusterIDs []string, opts ...OptionFunc) (*VirtualMachine, error) {
+	p = p.Clone()
+
+	vm, err := s.deployAndWait(p, opts...)
+	for _, clusterid := range candidateClusterIDs {
+		if err == nil || !IsInsufficientCapacity(err) {
+			break
+		}
+		delete(p.p, "hostid")
+		delete(p.p, "podid")
+		p.p["clusterid"] = clusterid
+		vm, err = s.deployAndWait(p, opts...)
+	}
+	return vm, err
+}
+
+// Deploys the virtual machine, waits for the async job to finish and returns the deployed virtual machine
+func (s *VirtualMachineService) deployAndWait(p *DeployVirtualMachineParams, opts ...OptionFunc) (*VirtualMachine, error) {
+	r, err := s.DeployVirtualMachine(p, opts...)
+	if err == nil && !s.cs.async {
+		// An async client already waited for the job to finish
+		_, err = s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	vm, _, err := s.GetVirtualMachineByID(r.Id, opts...)
+	return vm, err
+}
+
+// SetOVFProperties sets the values (by key) of the OVF properties of the deploy-as-is template, which
+// are sent as properties[i].key and properties[i].value. These properties are supported by CloudStack
+// 4.15 and later. Use ListTemplateOVFProperties and ValidateOVFProperties to validate the values first.
+func (p *DeployVirtualMachineParams) SetOVFProperties(v map[string]string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["properties"] = v
+}
+
+// SetNetworkByName resolves the ID of the network with the given name and adds it to the network IDs
+// of the params, so it can be called multiple times to deploy into multiple networks. If the zone ID
+// of the params is already set, only networks in that zone are matched. The options are used when
+// resolving the network ID.
+func (p *DeployVirtualMachineParams) SetNetworkByName(cs *CloudStackClient, name string, opts ...OptionFunc) error {
+	if zoneid, ok := p.p["zoneid"].(string); ok && zoneid != "" {
+		zoneOpt := func(cs *CloudStackClient, lp interface{}) error {
+			if lp, ok := lp.(*ListNetworksParams); ok {
+				lp.SetZoneid(zoneid)
+			}
+			return nil
+		}
+		opts = append([]OptionFunc{zoneOpt}, opts...)
+	}
+
+	id, _, err := cs.Network.GetNetworkID(name, opts...)
+	if err != nil {
+		return fmt.Errorf("Failed to resolve network %s: %w", name, err)
+	}
+
+	ids, _ := p.p["networkids"].([]string)
+	p.SetNetworkids(append(ids[:len(ids):len(ids)], id))
+	return nil
+}
+
+// ListVirtualMachinesAllZones lists the virtual machines matching the given params in every zone. It
+// first lists all zones and then lists the virtual machines of each zone in parallel, using at most
+// concurrency concurrent requests. If a zone ID is set in the params it will be overwritten. When
+// listing fails for some zones, the virtual machines of all other zones are returned together with
+// an error containing the failures by zone ID.
+func (s *VirtualMachineService) ListVirtualMachinesAllZones(p *ListVirtualMachinesParams, concurrency int) ([]*VirtualMachine, error) {
+	zones, err := s.cs.Zone.ListZones(s.cs.Zone.NewListZonesParams())
+	if err != nil {
+		return nil, err
+	}
+
+	vms := make([][]*VirtualMachine, len(zones.Zones))
+	errs := make([]error, len(zones.Zones))
+
+	runConcurrently(len(zones.Zones), concurrency, func(i int) {
+		zp := p.Clone()
+		zp.SetZoneid(zones.Zones[i].Id)
+
+		l, err := s.ListVirtualMachines(zp)
+		if err != nil {
+			errs[i] = fmt.Errorf("Failed to list virtual machines in zone %s: %v", zones.Zones[i].Name, err)
+			return
+		}
+		vms[i] = l.VirtualMachines
+	})
+
+	var r BulkResult[*VirtualMachine]
+	for i, z := range zones.Zones {
+		if errs[i] != nil {
+			r.fail(z.Id, errs[i])
+			continue
+		}
+		r.Succeeded = append(r.Succeeded, vms[i]...)
+	}
+
+	return r.Succeeded, r.Err()
+}
+
+// VMSpec contains the deploy relevant configuration of a virtual machine, as exported by ExportSpec.
+// It can be stored (e.g. as JSON) and used to recreate the virtual machine later on.
+type VMSpec struct {
+	Name              string         `json:"name"`
+	DisplayName       string         `json:"displayname,omitempty"`
+	Group             string         `json:"group,omitempty"`
+	ZoneID            string         `json:"zoneid"`
+	ServiceOfferingID string         `json:"serviceofferingid"`
+	TemplateID        string         `json:"templateid"`
+	Hypervisor        HypervisorType `json:"hypervisor,omitempty"`
+	Keypair           string         `json:"keypair,omitempty"`
+	RootDiskSize      int64          `json:"rootdisksize,omitempty"` // In GB
+
+	Account   string `json:"account,omitempty"`
+	DomainID  string `json:"domainid,omitempty"`
+	ProjectID string `json:"projectid,omitempty"`
+
+	Details  map[string]string `json:"details,omitempty"`
+	UserData string            `json:"userdata,omitempty"` // Base64 encoded
+
+	Networks         []VMSpecNetwork `json:"networks,omitempty"` // The default network first
+	AffinityGroupIDs []string        `json:"affinitygroupids,omitempty"`
+	SecurityGroupIDs []string        `json:"securitygroupids,omitempty"`
+	DataDisks        []VMSpecDisk    `json:"datadisks,omitempty"` // Ordered by device ID
+}
+
+// VMSpecNetwork contains the configuration of a NIC of a virtual machine
+type VMSpecNetwork struct {
+	NetworkID  string `json:"networkid"`
+	IPAddress  string `json:"ipaddress,omitempty"`
+	IP6Address string `json:"ip6address,omitempty"`
+}
+
+// VMSpecDisk contains the configuration of a data disk of a virtual machine
+type VMSpecDisk struct {
+	Name           string `json:"name"`
+	DiskOfferingID string `json:"diskofferingid"`
+	Size           int64  `json:"size"` // In GB
+	DeviceID       int64  `json:"deviceid"`
+}
+
+// ExportSpec gathers the deploy relevant configuration of the virtual machine: its offering, template,
+// networks, data disks, affinity groups, security groups and userdata. Runtime state, like the host
+// it runs on, is not part of the spec. The options are applied to the params of every command that
+// supports them.
+func (s *VirtualMachineService) ExportSpec(vmid string, opts ...OptionFunc) (*VMSpec, error) {
+	vm, _, err := s.GetVirtualMachineByID(vmid, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	spec := &VMSpec{
+		Name:              vm.Name,
+		DisplayName:       vm.Displayname,
+		Group:             vm.Group,
+		ZoneID:            vm.Zoneid,
+		ServiceOfferingID: vm.Serviceofferingid,
+		TemplateID:        vm.Templateid,
+		Hypervisor:        HypervisorType(vm.Hypervisor),
+		Keypair:           vm.Keypair,
+		Account:           vm.Account,
+		DomainID:          vm.Domainid,
+		ProjectID:         vm.Projectid,
+	}
+	if len(vm.Details) > 0 {
+		spec.Details = make(map[string]string, len(vm.Details))
+		for k, v := range vm.Details {
+			spec.Details[k] = v
+		}
+	}
+
+	// The first network given when deploying becomes the default network
+	nics := vm.Nic
+	sort.SliceStable(nics, func(i, j int) bool {
+		if nics[i].Isdefault != nics[j].Isdefault {
+			return nics[i].Isdefault
+		}
+		di, _ := strconv.Atoi(nics[i].Deviceid)
+		dj, _ := strconv.Atoi(nics[j].Deviceid)
+		return di < dj
+	})
+	for _, nic := range nics {
+		spec.Networks = append(spec.Networks, VMSpecNetwork{
+			NetworkID:  nic.Networkid,
+			IPAddress:  nic.Ipaddress,
+			IP6Address: nic.Ip6address,
+		})
+	}
+	for _, ag := range vm.Affinitygroup {
+		spec.AffinityGroupIDs = append(spec.AffinityGroupIDs, ag.Id)
+	}
+	for _, sg := range vm.Securitygroup {
+		spec.SecurityGroupIDs = append(spec.SecurityGroupIDs, sg.Id)
+	}
+
+	ud, err := s.cs.User.GetVirtualMachineUserData(s.cs.User.NewGetVirtualMachineUserDataParams(vmid))
+	if err != nil {
+		return nil, fmt.Errorf("Failed to get the userdata of virtual machine %s: %v", vmid, err)
+	}
+	spec.UserData = ud.Userdata
+
+	p := s.cs.Volume.NewListVolumesParams()
+	p.SetVirtualmachineid(vmid)
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	l, err := s.cs.Volume.ListVolumes(p)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to list the volumes of virtual machine %s: %v", vmid, err)
+	}
+	for _, v := range l.Volumes {
+		switch v.Type {
+		case "ROOT":
+			spec.RootDiskSize = v.Size >> 30
+		case "DATADISK":
+			spec.DataDisks = append(spec.DataDisks, VMSpecDisk{
+				Name:           v.Name,
+				DiskOfferingID: v.Diskofferingid,
+				Size:           v.Size >> 30,
+				DeviceID:       v.Deviceid,
+			})
+		}
+	}
+	sort.Slice(spec.DataDisks, func(i, j int) bool {
+		return spec.DataDisks[i].DeviceID < spec.DataDisks[j].DeviceID
+	})
+
+	return spec, nil
+}
+
+// DeployFromSpec deploys a new virtual machine using a spec as exported by ExportSpec, for example to
+// recreate a virtual machine or to deploy a copy of it in another zone or account (by changing the
+// spec first). The virtual machine is deployed in the default network of the spec, after which the
+// other networks are added and the data disks are created and attached, waiting for every async job
+// to finish. The IP addresses of the spec are not reused, as they usually still belong to the original
+// virtual machine. The options are applied to the params of every command that supports them.
+//
+// When the virtual machine cannot be deployed an error is returned, which explicitly mentions when the
+// template is not available in the zone of the spec. When the virtual machine is deployed, but some
+// of the networks or data disks could not be added, the virtual machine is returned together with an
+// error describing every part of the spec that could not be reproduced.
+func (s *VirtualMachineService) DeployFromSpec(spec *VMSpec, opts ...OptionFunc) (*VirtualMachine, error) {
+	tp := s.cs.Template.NewListTemplatesParams("executable")
+	tp.SetId(spec.TemplateID)
+	tp.SetZoneid(spec.ZoneID)
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, tp); err != nil {
+			return nil, err
+		}
+	}
+
+	tl, err := s.cs.Template.ListTemplates(tp)
+	if err != nil {
+		return nil, err
+	}
+	if tl.Count == 0 {
+		return nil, fmt.Errorf("Template %s is not available in zone %s", spec.TemplateID, spec.ZoneID)
+	}
+
+	p := s.NewDeployVirtualMachineParams(spec.ServiceOfferingID, spec.TemplateID, spec.ZoneID)
+	for k, v := range map[string]string{
+		"name":        spec.Name,
+		"displayname": spec.DisplayName,
+		"group":       spec.Group,
+		"hypervisor":  string(spec.Hypervisor),
+		"keypair":     spec.Keypair,
+		"account":     spec.Account,
+		"domainid":    spec.DomainID,
+		"projectid":   spec.ProjectID,
+		"userdata":    spec.UserData,
+	} {
+		if v != "" {
+			p.p[k] = v
+		}
+	}
+	if spec.RootDiskSize > 0 {
+		p.SetRootdisksize(spec.RootDiskSize)
+	}
+	if len(spec.Details) > 0 {
+		p.SetDetails(spec.Details)
+	}
+	if len(spec.AffinityGroupIDs) > 0 {
+		p.SetAffinitygroupids(spec.AffinityGroupIDs)
+	}
+	if len(spec.SecurityGroupIDs) > 0 {
+		p.SetSecuritygroupids(spec.SecurityGroupIDs)
+	}
+	if len(spec.Networks) > 0 {
+		p.SetNetworkids([]string{spec.Networks[0].NetworkID})
+	}
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	r, err := s.DeployVirtualMachine(p)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to deploy virtual machine %s: %v", spec.Name, err)
+	}
+
+	// An async client already waited for the job to finish
+	if !s.cs.async {
+		if _, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout); err != nil {
+			return nil, fmt.Errorf("Failed to deploy virtual machine %s: %v", spec.Name, err)
+		}
+	}
+
+	var failed []error
+	if len(spec.Networks) > 1 {
+		for _, n := range spec.Networks[1:] {
+			if _, err := s.cs.Nic.AttachNetwork(r.Id, n.NetworkID, opts...); err != nil {
+				failed = append(failed, fmt.Errorf("Failed to add network %s: %v", n.NetworkID, err))
+			}
+		}
+	}
+	for _, d := range spec.DataDisks {
+		if err := s.createAndAttachDataDisk(spec, d, r.Id, opts...); err != nil {
+			failed = append(failed, fmt.Errorf("Failed to add data disk %s: %v", d.Name, err))
+		}
+	}
+
+	vm, _, err := s.GetVirtualMachineByID(r.Id, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if len(failed) > 0 {
+		return vm, fmt.Errorf("Virtual machine %s is deployed, but not all of its spec could be reproduced: %w", vm.Id, errors.Join(failed...))
+	}
+
+	return vm, nil
+}
+
+// Creates a data disk as described in the spec and attaches it to the virtual machine
+func (s *VirtualMachineService) createAndAttachDataDisk(spec *VMSpec, d VMSpecDisk, vmid string, opts ...OptionFunc) error {
+	p := s.cs.Volume.NewCreateVolumeParams()
+	p.SetName(d.Name)
+	p.SetDiskofferingid(d.DiskOfferingID)
+	p.SetZoneid(spec.ZoneID)
+	if spec.Account != "" {
+		p.SetAccount(spec.Account)
+	}
+	if spec.DomainID != "" {
+		p.SetDomainid(spec.DomainID)
+	}
+	if spec.ProjectID != "" {
+		p.SetProjectid(spec.ProjectID)
+	}
+
+	// The size can only be set when using a custom disk offering
+	do, _, err := s.cs.DiskOffering.GetDiskOfferingByID(d.DiskOfferingID, opts...)
+	if err != nil {
+		return err
+	}
+	if do.Iscustomized {
+		p.SetSize(d.Size)
+	}
+
+	r, err := s.cs.Volume.CreateVolume(p)
+	if err != nil {
+		return err
+	}
+	if _, err := s.cs.Volume.waitForVolumeJob(r.JobID, r.Id, opts...); err != nil {
+		return err
+	}
+
+	_, err = s.cs.Volume.AttachAndWait(r.Id, vmid, opts...)
+	return err
+}
+
+// ErrNoPassword is returned (wrapped) by GetDecryptedPassword when no password is set for the virtual machine
+var ErrNoPassword = errors.New("No password set")
+
+// GetDecryptedPassword gets the encrypted password of the virtual machine and decrypts it using the
+// PEM encoded (PKCS #1 or PKCS #8) RSA private key of the SSH keypair the virtual machine was deployed
+// with. When the virtual machine has no password set, an ErrNoPassword is returned.
+func (s *VirtualMachineService) GetDecryptedPassword(vmid string, privateKeyPEM []byte) (string, error) {
+	r, err := s.GetVMPassword(s.NewGetVMPasswordParams(vmid))
+	if err != nil {
+		if isNoPasswordError(err) {
+			return "", fmt.Errorf("%w for virtual machine %s", ErrNoPassword, vmid)
+		}
+		return "", err
+	}
+	if r.Encryptedpassword == "" {
+		return "", fmt.Errorf("%w for virtual machine %s", ErrNoPassword, vmid)
+	}
+
+	block, _ := pem.Decode(privateKeyPEM)
+	if block == nil {
+		return "", errors.New("Unable to decode the PEM encoded private key")
+	}
+
+	var key *rsa.PrivateKey
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		key, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "PRIVATE KEY":
+		var k interface{}
+		if k, err = x509.ParsePKCS8PrivateKey(block.Bytes); err == nil {
+			var ok bool
+			if key, ok = k.(*rsa.PrivateKey); !ok {
+				err = errors.New("Private key is not an RSA key")
+			}
+		}
+	default:
+		err = fmt.Errorf("Unsupported private key type: %s", block.Type)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	encrypted, err := base64.StdEncoding.DecodeString(r.Encryptedpassword)
+	if err != nil {
+		return "", err
+	}
+
+	password, err := rsa.DecryptPKCS1v15(rand.Reader, key, encrypted)
+	if err != nil {
+		return "", fmt.Errorf("Unable to decrypt the password of virtual machine %s: %v", vmid, err)
+	}
+
+	return string(password), nil
+}
+
+// Returns true if the error means the virtual machine has no password set. CloudStack reports this as a
+// parameter error (error code 431), which is also used for any other invalid parameter, so the error text
+// is matched as well to tell them apart.
+func isNoPasswordError(err error) bool {
+	var ae *APIError
+	if !errors.As(err, &ae) || ae.ErrorCode != 431 {
+		return false
+	}
+	return strings.Contains(ae.ErrorText, "No password for VM")
+}
+
+// UpdateMany runs UpdateVirtualMachine for all given IDs in parallel, using at most concurrency concurrent requests.
+// For each ID the params are populated with the ID and then passed to mutate, which should set the
+// changes to apply. The result contains the updated resources (in the order of the IDs) and the error
+// of every update that failed by ID. The returned error contains all failures, or is nil if none failed.
+// The updated resources are returned as the UpdateVirtualMachineResponse of every update, which contains the same
+// fields as the resource itself, so no additional request is needed per resource to get them. The
+// options are used for every update.
+func (s *VirtualMachineService) UpdateMany(ids []string, mutate func(*UpdateVirtualMachineParams), concurrency int, opts ...OptionFunc) (BulkResult[*UpdateVirtualMachineResponse], error) {
+	rs := make([]*UpdateVirtualMachineResponse, len(ids))
+	errs := make([]error, len(ids))
+
+	runConcurrently(len(ids), concurrency, func(i int) {
+		p := &UpdateVirtualMachineParams{p: map[string]interface{}{"id": ids[i]}}
+		if mutate != nil {
+			mutate(p)
+		}
+
+		r, err := s.UpdateVirtualMachine(p, opts...)
+		if err != nil {
+			errs[i] = err
+			return
+		}
+		rs[i] = r
+	})
+
+	var r BulkResult[*UpdateVirtualMachineResponse]
+	for i, id := range ids {
+		if errs[i] != nil {
+			r.fail(id, errs[i])
+			continue
+		}
+		r.Succeeded = append(r.Succeeded, rs[i])
+	}
+
+	return r, r.Err()
+}
+
 type AddNicToVirtualMachineParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *AddNicToVirtualMachineParams) Clone() *AddNicToVirtualMachineParams {
+	c := &AddNicToVirtualMachineParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *AddNicToVirtualMachineParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -45,6 +550,16 @@ func (p *AddNicToVirtualMachineParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *AddNicToVirtualMachineParams) validate() error {
+	for _, k := range []string{"networkid", "virtualmachineid"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of addNicToVirtualMachine", k)
+		}
+	}
+	return nil
+}
+
 func (p *AddNicToVirtualMachineParams) SetIpaddress(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -53,6 +568,13 @@ func (p *AddNicToVirtualMachineParams) SetIpaddress(v string) {
 	return
 }
 
+// SetIpaddressIfUnset sets ipaddress to v, unless it is already set
+func (p *AddNicToVirtualMachineParams) SetIpaddressIfUnset(v string) {
+	if _, found := p.p["ipaddress"]; !found {
+		p.SetIpaddress(v)
+	}
+}
+
 func (p *AddNicToVirtualMachineParams) SetNetworkid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -61,6 +583,13 @@ func (p *AddNicToVirtualMachineParams) SetNetworkid(v string) {
 	return
 }
 
+// SetNetworkidIfUnset sets networkid to v, unless it is already set
+func (p *AddNicToVirtualMachineParams) SetNetworkidIfUnset(v string) {
+	if _, found := p.p["networkid"]; !found {
+		p.SetNetworkid(v)
+	}
+}
+
 func (p *AddNicToVirtualMachineParams) SetVirtualmachineid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -69,19 +598,55 @@ func (p *AddNicToVirtualMachineParams) SetVirtualmachineid(v string) {
 	return
 }
 
+// SetVirtualmachineidIfUnset sets virtualmachineid to v, unless it is already set
+func (p *AddNicToVirtualMachineParams) SetVirtualmachineidIfUnset(v string) {
+	if _, found := p.p["virtualmachineid"]; !found {
+		p.SetVirtualmachineid(v)
+	}
+}
+
 // You should always use this function to get a new AddNicToVirtualMachineParams instance,
-// as then you are sure you have configured all required params
-func (s *VirtualMachineService) NewAddNicToVirtualMachineParams(networkid string, virtualmachineid string) *AddNicToVirtualMachineParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *VirtualMachineService) NewAddNicToVirtualMachineParams(networkid string, virtualmachineid string, opts ...func(*AddNicToVirtualMachineParams)) *AddNicToVirtualMachineParams {
 	p := &AddNicToVirtualMachineParams{}
 	p.p = make(map[string]interface{})
 	p.p["networkid"] = networkid
 	p.p["virtualmachineid"] = virtualmachineid
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Adds VM to specified network by creating a NIC
-func (s *VirtualMachineService) AddNicToVirtualMachine(p *AddNicToVirtualMachineParams) (*AddNicToVirtualMachineResponse, error) {
-	resp, err := s.cs.newRequest("addNicToVirtualMachine", p.toURLValues())
+func (s *VirtualMachineService) AddNicToVirtualMachine(p *AddNicToVirtualMachineParams, opts ...OptionFunc) (*AddNicToVirtualMachineResponse, error) {
+	return s.AddNicToVirtualMachineWithContext(context.Background(), p, opts...)
+}
+
+// AddNicToVirtualMachineWithContext is the same as AddNicToVirtualMachine, but the request is cancelled when the context is done
+func (s *VirtualMachineService) AddNicToVirtualMachineWithContext(ctx context.Context, p *AddNicToVirtualMachineParams, opts ...OptionFunc) (*AddNicToVirtualMachineResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.AddNicToVirtualMachineRawWithContext(ctx, p.toURLValues())
+}
+
+// AddNicToVirtualMachineRaw is the same as AddNicToVirtualMachine, but takes the params as url.Values instead of typed params
+func (s *VirtualMachineService) AddNicToVirtualMachineRaw(v url.Values) (*AddNicToVirtualMachineResponse, error) {
+	return s.AddNicToVirtualMachineRawWithContext(context.Background(), v)
+}
+
+// AddNicToVirtualMachineRawWithContext is the same as AddNicToVirtualMachineRaw, but the request is cancelled when the context is done
+func (s *VirtualMachineService) AddNicToVirtualMachineRawWithContext(ctx context.Context, v url.Values) (*AddNicToVirtualMachineResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "addNicToVirtualMachine", "addnictovirtualmachineresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -93,9 +658,9 @@ func (s *VirtualMachineService) AddNicToVirtualMachine(p *AddNicToVirtualMachine
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -129,43 +694,43 @@ type AddNicToVirtualMachineResponse struct {
 		Type              string   `json:"type"`
 		VirtualmachineIds []string `json:"virtualmachineIds"`
 	} `json:"affinitygroup"`
-	Cpunumber             int               `json:"cpunumber"`
-	Cpuspeed              int               `json:"cpuspeed"`
-	Cpuused               string            `json:"cpuused"`
-	Created               string            `json:"created"`
-	Details               map[string]string `json:"details"`
-	Diskioread            int64             `json:"diskioread"`
-	Diskiowrite           int64             `json:"diskiowrite"`
-	Diskkbsread           int64             `json:"diskkbsread"`
-	Diskkbswrite          int64             `json:"diskkbswrite"`
-	Diskofferingid        string            `json:"diskofferingid"`
-	Diskofferingname      string            `json:"diskofferingname"`
-	Displayname           string            `json:"displayname"`
-	Displayvm             bool              `json:"displayvm"`
-	Domain                string            `json:"domain"`
-	Domainid              string            `json:"domainid"`
-	Forvirtualnetwork     bool              `json:"forvirtualnetwork"`
-	Group                 string            `json:"group"`
-	Groupid               string            `json:"groupid"`
-	Guestosid             string            `json:"guestosid"`
-	Haenable              bool              `json:"haenable"`
-	Hostid                string            `json:"hostid"`
-	Hostname              string            `json:"hostname"`
-	Hypervisor            string            `json:"hypervisor"`
-	Id                    string            `json:"id"`
-	Instancename          string            `json:"instancename"`
-	Isdynamicallyscalable bool              `json:"isdynamicallyscalable"`
-	Isodisplaytext        string            `json:"isodisplaytext"`
-	Isoid                 string            `json:"isoid"`
-	Isoname               string            `json:"isoname"`
-	Keypair               string            `json:"keypair"`
-	Memory                int               `json:"memory"`
-	Memoryintfreekbs      int64             `json:"memoryintfreekbs"`
-	Memorykbs             int64             `json:"memorykbs"`
-	Memorytargetkbs       int64             `json:"memorytargetkbs"`
-	Name                  string            `json:"name"`
-	Networkkbsread        int64             `json:"networkkbsread"`
-	Networkkbswrite       int64             `json:"networkkbswrite"`
+	Cpunumber             int       `json:"cpunumber"`
+	Cpuspeed              int       `json:"cpuspeed"`
+	Cpuused               string    `json:"cpuused"`
+	Created               string    `json:"created"`
+	Details               StringMap `json:"details"`
+	Diskioread            int64     `json:"diskioread"`
+	Diskiowrite           int64     `json:"diskiowrite"`
+	Diskkbsread           int64     `json:"diskkbsread"`
+	Diskkbswrite          int64     `json:"diskkbswrite"`
+	Diskofferingid        string    `json:"diskofferingid"`
+	Diskofferingname      string    `json:"diskofferingname"`
+	Displayname           string    `json:"displayname"`
+	Displayvm             bool      `json:"displayvm"`
+	Domain                string    `json:"domain"`
+	Domainid              string    `json:"domainid"`
+	Forvirtualnetwork     bool      `json:"forvirtualnetwork"`
+	Group                 string    `json:"group"`
+	Groupid               string    `json:"groupid"`
+	Guestosid             string    `json:"guestosid"`
+	Haenable              bool      `json:"haenable"`
+	Hostid                string    `json:"hostid"`
+	Hostname              string    `json:"hostname"`
+	Hypervisor            string    `json:"hypervisor"`
+	Id                    string    `json:"id"`
+	Instancename          string    `json:"instancename"`
+	Isdynamicallyscalable bool      `json:"isdynamicallyscalable"`
+	Isodisplaytext        string    `json:"isodisplaytext"`
+	Isoid                 string    `json:"isoid"`
+	Isoname               string    `json:"isoname"`
+	Keypair               string    `json:"keypair"`
+	Memory                int       `json:"memory"`
+	Memoryintfreekbs      int64     `json:"memoryintfreekbs"`
+	Memorykbs             int64     `json:"memorykbs"`
+	Memorytargetkbs       int64     `json:"memorytargetkbs"`
+	Name                  string    `json:"name"`
+	Networkkbsread        int64     `json:"networkkbsread"`
+	Networkkbswrite       int64     `json:"networkkbswrite"`
 	Nic                   []struct {
 		Broadcasturi         string `json:"broadcasturi"`
 		Deviceid             string `json:"deviceid"`
@@ -288,6 +853,16 @@ type AssignVirtualMachineParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *AssignVirtualMachineParams) Clone() *AssignVirtualMachineParams {
+	c := &AssignVirtualMachineParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *AssignVirtualMachineParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -313,6 +888,16 @@ func (p *AssignVirtualMachineParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *AssignVirtualMachineParams) validate() error {
+	for _, k := range []string{"account", "domainid", "virtualmachineid"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of assignVirtualMachine", k)
+		}
+	}
+	return nil
+}
+
 func (p *AssignVirtualMachineParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -321,6 +906,13 @@ func (p *AssignVirtualMachineParams) SetAccount(v string) {
 	return
 }
 
+// SetAccountIfUnset sets account to v, unless it is already set
+func (p *AssignVirtualMachineParams) SetAccountIfUnset(v string) {
+	if _, found := p.p["account"]; !found {
+		p.SetAccount(v)
+	}
+}
+
 func (p *AssignVirtualMachineParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -329,6 +921,13 @@ func (p *AssignVirtualMachineParams) SetDomainid(v string) {
 	return
 }
 
+// SetDomainidIfUnset sets domainid to v, unless it is already set
+func (p *AssignVirtualMachineParams) SetDomainidIfUnset(v string) {
+	if _, found := p.p["domainid"]; !found {
+		p.SetDomainid(v)
+	}
+}
+
 func (p *AssignVirtualMachineParams) SetNetworkids(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -337,6 +936,18 @@ func (p *AssignVirtualMachineParams) SetNetworkids(v []string) {
 	return
 }
 
+// SetNetworkidsIfUnset sets networkids to v, unless it is already set
+func (p *AssignVirtualMachineParams) SetNetworkidsIfUnset(v []string) {
+	if _, found := p.p["networkids"]; !found {
+		p.SetNetworkids(v)
+	}
+}
+
+// SetNetworkidsCSV splits v on commas and sets the resulting values
+func (p *AssignVirtualMachineParams) SetNetworkidsCSV(v string) {
+	p.SetNetworkids(splitCSV(v))
+}
+
 func (p *AssignVirtualMachineParams) SetSecuritygroupids(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -345,6 +956,18 @@ func (p *AssignVirtualMachineParams) SetSecuritygroupids(v []string) {
 	return
 }
 
+// SetSecuritygroupidsIfUnset sets securitygroupids to v, unless it is already set
+func (p *AssignVirtualMachineParams) SetSecuritygroupidsIfUnset(v []string) {
+	if _, found := p.p["securitygroupids"]; !found {
+		p.SetSecuritygroupids(v)
+	}
+}
+
+// SetSecuritygroupidsCSV splits v on commas and sets the resulting values
+func (p *AssignVirtualMachineParams) SetSecuritygroupidsCSV(v string) {
+	p.SetSecuritygroupids(splitCSV(v))
+}
+
 func (p *AssignVirtualMachineParams) SetVirtualmachineid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -353,20 +976,79 @@ func (p *AssignVirtualMachineParams) SetVirtualmachineid(v string) {
 	return
 }
 
+// SetVirtualmachineidIfUnset sets virtualmachineid to v, unless it is already set
+func (p *AssignVirtualMachineParams) SetVirtualmachineidIfUnset(v string) {
+	if _, found := p.p["virtualmachineid"]; !found {
+		p.SetVirtualmachineid(v)
+	}
+}
+
 // You should always use this function to get a new AssignVirtualMachineParams instance,
-// as then you are sure you have configured all required params
-func (s *VirtualMachineService) NewAssignVirtualMachineParams(account string, domainid string, virtualmachineid string) *AssignVirtualMachineParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *VirtualMachineService) NewAssignVirtualMachineParams(account string, domainid string, virtualmachineid string, opts ...func(*AssignVirtualMachineParams)) *AssignVirtualMachineParams {
 	p := &AssignVirtualMachineParams{}
 	p.p = make(map[string]interface{})
 	p.p["account"] = account
 	p.p["domainid"] = domainid
 	p.p["virtualmachineid"] = virtualmachineid
+	for _, fn := range opts {
+		fn(p)
+	}
+	return p
+}
+
+// AssignVirtualMachineOpts contains all params that can be set on a AssignVirtualMachineParams.
+// Optional params are pointers (or slices and maps) which are only set when not nil.
+type AssignVirtualMachineOpts struct {
+	Account          string
+	Domainid         string
+	Networkids       []string
+	Securitygroupids []string
+	Virtualmachineid string
+}
+
+// NewAssignVirtualMachineParamsFromOpts is an alternative for NewAssignVirtualMachineParams which takes all params using
+// an options struct, as that is easier to read for APIs with many required params
+func (s *VirtualMachineService) NewAssignVirtualMachineParamsFromOpts(o AssignVirtualMachineOpts) *AssignVirtualMachineParams {
+	p := s.NewAssignVirtualMachineParams(o.Account, o.Domainid, o.Virtualmachineid)
+	if o.Networkids != nil {
+		p.p["networkids"] = o.Networkids
+	}
+	if o.Securitygroupids != nil {
+		p.p["securitygroupids"] = o.Securitygroupids
+	}
 	return p
 }
 
 // Change ownership of a VM from one account to another. This API is available for Basic zones with security groups and Advanced zones with guest networks. A root administrator can reassign a VM from any account to any other account in any domain. A domain administrator can reassign a VM to any account in the same domain.
-func (s *VirtualMachineService) AssignVirtualMachine(p *AssignVirtualMachineParams) (*AssignVirtualMachineResponse, error) {
-	resp, err := s.cs.newRequest("assignVirtualMachine", p.toURLValues())
+func (s *VirtualMachineService) AssignVirtualMachine(p *AssignVirtualMachineParams, opts ...OptionFunc) (*AssignVirtualMachineResponse, error) {
+	return s.AssignVirtualMachineWithContext(context.Background(), p, opts...)
+}
+
+// AssignVirtualMachineWithContext is the same as AssignVirtualMachine, but the request is cancelled when the context is done
+func (s *VirtualMachineService) AssignVirtualMachineWithContext(ctx context.Context, p *AssignVirtualMachineParams, opts ...OptionFunc) (*AssignVirtualMachineResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.AssignVirtualMachineRawWithContext(ctx, p.toURLValues())
+}
+
+// AssignVirtualMachineRaw is the same as AssignVirtualMachine, but takes the params as url.Values instead of typed params
+func (s *VirtualMachineService) AssignVirtualMachineRaw(v url.Values) (*AssignVirtualMachineResponse, error) {
+	return s.AssignVirtualMachineRawWithContext(context.Background(), v)
+}
+
+// AssignVirtualMachineRawWithContext is the same as AssignVirtualMachineRaw, but the request is cancelled when the context is done
+func (s *VirtualMachineService) AssignVirtualMachineRawWithContext(ctx context.Context, v url.Values) (*AssignVirtualMachineResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "assignVirtualMachine", "assignvirtualmachineresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -393,43 +1075,43 @@ type AssignVirtualMachineResponse struct {
 		Type              string   `json:"type"`
 		VirtualmachineIds []string `json:"virtualmachineIds"`
 	} `json:"affinitygroup"`
-	Cpunumber             int               `json:"cpunumber"`
-	Cpuspeed              int               `json:"cpuspeed"`
-	Cpuused               string            `json:"cpuused"`
-	Created               string            `json:"created"`
-	Details               map[string]string `json:"details"`
-	Diskioread            int64             `json:"diskioread"`
-	Diskiowrite           int64             `json:"diskiowrite"`
-	Diskkbsread           int64             `json:"diskkbsread"`
-	Diskkbswrite          int64             `json:"diskkbswrite"`
-	Diskofferingid        string            `json:"diskofferingid"`
-	Diskofferingname      string            `json:"diskofferingname"`
-	Displayname           string            `json:"displayname"`
-	Displayvm             bool              `json:"displayvm"`
-	Domain                string            `json:"domain"`
-	Domainid              string            `json:"domainid"`
-	Forvirtualnetwork     bool              `json:"forvirtualnetwork"`
-	Group                 string            `json:"group"`
-	Groupid               string            `json:"groupid"`
-	Guestosid             string            `json:"guestosid"`
-	Haenable              bool              `json:"haenable"`
-	Hostid                string            `json:"hostid"`
-	Hostname              string            `json:"hostname"`
-	Hypervisor            string            `json:"hypervisor"`
-	Id                    string            `json:"id"`
-	Instancename          string            `json:"instancename"`
-	Isdynamicallyscalable bool              `json:"isdynamicallyscalable"`
-	Isodisplaytext        string            `json:"isodisplaytext"`
-	Isoid                 string            `json:"isoid"`
-	Isoname               string            `json:"isoname"`
-	Keypair               string            `json:"keypair"`
-	Memory                int               `json:"memory"`
-	Memoryintfreekbs      int64             `json:"memoryintfreekbs"`
-	Memorykbs             int64             `json:"memorykbs"`
-	Memorytargetkbs       int64             `json:"memorytargetkbs"`
-	Name                  string            `json:"name"`
-	Networkkbsread        int64             `json:"networkkbsread"`
-	Networkkbswrite       int64             `json:"networkkbswrite"`
+	Cpunumber             int       `json:"cpunumber"`
+	Cpuspeed              int       `json:"cpuspeed"`
+	Cpuused               string    `json:"cpuused"`
+	Created               string    `json:"created"`
+	Details               StringMap `json:"details"`
+	Diskioread            int64     `json:"diskioread"`
+	Diskiowrite           int64     `json:"diskiowrite"`
+	Diskkbsread           int64     `json:"diskkbsread"`
+	Diskkbswrite          int64     `json:"diskkbswrite"`
+	Diskofferingid        string    `json:"diskofferingid"`
+	Diskofferingname      string    `json:"diskofferingname"`
+	Displayname           string    `json:"displayname"`
+	Displayvm             bool      `json:"displayvm"`
+	Domain                string    `json:"domain"`
+	Domainid              string    `json:"domainid"`
+	Forvirtualnetwork     bool      `json:"forvirtualnetwork"`
+	Group                 string    `json:"group"`
+	Groupid               string    `json:"groupid"`
+	Guestosid             string    `json:"guestosid"`
+	Haenable              bool      `json:"haenable"`
+	Hostid                string    `json:"hostid"`
+	Hostname              string    `json:"hostname"`
+	Hypervisor            string    `json:"hypervisor"`
+	Id                    string    `json:"id"`
+	Instancename          string    `json:"instancename"`
+	Isdynamicallyscalable bool      `json:"isdynamicallyscalable"`
+	Isodisplaytext        string    `json:"isodisplaytext"`
+	Isoid                 string    `json:"isoid"`
+	Isoname               string    `json:"isoname"`
+	Keypair               string    `json:"keypair"`
+	Memory                int       `json:"memory"`
+	Memoryintfreekbs      int64     `json:"memoryintfreekbs"`
+	Memorykbs             int64     `json:"memorykbs"`
+	Memorytargetkbs       int64     `json:"memorytargetkbs"`
+	Name                  string    `json:"name"`
+	Networkkbsread        int64     `json:"networkkbsread"`
+	Networkkbswrite       int64     `json:"networkkbswrite"`
 	Nic                   []struct {
 		Broadcasturi         string `json:"broadcasturi"`
 		Deviceid             string `json:"deviceid"`
@@ -552,16 +1234,25 @@ type ChangeServiceForVirtualMachineParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ChangeServiceForVirtualMachineParams) Clone() *ChangeServiceForVirtualMachineParams {
+	c := &ChangeServiceForVirtualMachineParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ChangeServiceForVirtualMachineParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
 	}
 	if v, found := p.p["details"]; found {
-		i := 0
-		for k, vv := range v.(map[string]string) {
-			u.Set(fmt.Sprintf("details[%d].%s", i, k), vv)
-			i++
+		m := v.(map[string]string)
+		for i, k := range getSortedKeysFromMap(m) {
+			u.Set(fmt.Sprintf("details[%d].%s", i, k), m[k])
 		}
 	}
 	if v, found := p.p["id"]; found {
@@ -573,6 +1264,16 @@ func (p *ChangeServiceForVirtualMachineParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *ChangeServiceForVirtualMachineParams) validate() error {
+	for _, k := range []string{"id", "serviceofferingid"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of changeServiceForVirtualMachine", k)
+		}
+	}
+	return nil
+}
+
 func (p *ChangeServiceForVirtualMachineParams) SetDetails(v map[string]string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -581,6 +1282,13 @@ func (p *ChangeServiceForVirtualMachineParams) SetDetails(v map[string]string) {
 	return
 }
 
+// SetDetailsIfUnset sets details to v, unless it is already set
+func (p *ChangeServiceForVirtualMachineParams) SetDetailsIfUnset(v map[string]string) {
+	if _, found := p.p["details"]; !found {
+		p.SetDetails(v)
+	}
+}
+
 func (p *ChangeServiceForVirtualMachineParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -589,6 +1297,13 @@ func (p *ChangeServiceForVirtualMachineParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *ChangeServiceForVirtualMachineParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 func (p *ChangeServiceForVirtualMachineParams) SetServiceofferingid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -597,19 +1312,55 @@ func (p *ChangeServiceForVirtualMachineParams) SetServiceofferingid(v string) {
 	return
 }
 
+// SetServiceofferingidIfUnset sets serviceofferingid to v, unless it is already set
+func (p *ChangeServiceForVirtualMachineParams) SetServiceofferingidIfUnset(v string) {
+	if _, found := p.p["serviceofferingid"]; !found {
+		p.SetServiceofferingid(v)
+	}
+}
+
 // You should always use this function to get a new ChangeServiceForVirtualMachineParams instance,
-// as then you are sure you have configured all required params
-func (s *VirtualMachineService) NewChangeServiceForVirtualMachineParams(id string, serviceofferingid string) *ChangeServiceForVirtualMachineParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *VirtualMachineService) NewChangeServiceForVirtualMachineParams(id string, serviceofferingid string, opts ...func(*ChangeServiceForVirtualMachineParams)) *ChangeServiceForVirtualMachineParams {
 	p := &ChangeServiceForVirtualMachineParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
 	p.p["serviceofferingid"] = serviceofferingid
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Changes the service offering for a virtual machine. The virtual machine must be in a "Stopped" state for this command to take effect.
-func (s *VirtualMachineService) ChangeServiceForVirtualMachine(p *ChangeServiceForVirtualMachineParams) (*ChangeServiceForVirtualMachineResponse, error) {
-	resp, err := s.cs.newRequest("changeServiceForVirtualMachine", p.toURLValues())
+func (s *VirtualMachineService) ChangeServiceForVirtualMachine(p *ChangeServiceForVirtualMachineParams, opts ...OptionFunc) (*ChangeServiceForVirtualMachineResponse, error) {
+	return s.ChangeServiceForVirtualMachineWithContext(context.Background(), p, opts...)
+}
+
+// ChangeServiceForVirtualMachineWithContext is the same as ChangeServiceForVirtualMachine, but the request is cancelled when the context is done
+func (s *VirtualMachineService) ChangeServiceForVirtualMachineWithContext(ctx context.Context, p *ChangeServiceForVirtualMachineParams, opts ...OptionFunc) (*ChangeServiceForVirtualMachineResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.ChangeServiceForVirtualMachineRawWithContext(ctx, p.toURLValues())
+}
+
+// ChangeServiceForVirtualMachineRaw is the same as ChangeServiceForVirtualMachine, but takes the params as url.Values instead of typed params
+func (s *VirtualMachineService) ChangeServiceForVirtualMachineRaw(v url.Values) (*ChangeServiceForVirtualMachineResponse, error) {
+	return s.ChangeServiceForVirtualMachineRawWithContext(context.Background(), v)
+}
+
+// ChangeServiceForVirtualMachineRawWithContext is the same as ChangeServiceForVirtualMachineRaw, but the request is cancelled when the context is done
+func (s *VirtualMachineService) ChangeServiceForVirtualMachineRawWithContext(ctx context.Context, v url.Values) (*ChangeServiceForVirtualMachineResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "changeServiceForVirtualMachine", "changeserviceforvirtualmachineresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -636,43 +1387,43 @@ type ChangeServiceForVirtualMachineResponse struct {
 		Type              string   `json:"type"`
 		VirtualmachineIds []string `json:"virtualmachineIds"`
 	} `json:"affinitygroup"`
-	Cpunumber             int               `json:"cpunumber"`
-	Cpuspeed              int               `json:"cpuspeed"`
-	Cpuused               string            `json:"cpuused"`
-	Created               string            `json:"created"`
-	Details               map[string]string `json:"details"`
-	Diskioread            int64             `json:"diskioread"`
-	Diskiowrite           int64             `json:"diskiowrite"`
-	Diskkbsread           int64             `json:"diskkbsread"`
-	Diskkbswrite          int64             `json:"diskkbswrite"`
-	Diskofferingid        string            `json:"diskofferingid"`
-	Diskofferingname      string            `json:"diskofferingname"`
-	Displayname           string            `json:"displayname"`
-	Displayvm             bool              `json:"displayvm"`
-	Domain                string            `json:"domain"`
-	Domainid              string            `json:"domainid"`
-	Forvirtualnetwork     bool              `json:"forvirtualnetwork"`
-	Group                 string            `json:"group"`
-	Groupid               string            `json:"groupid"`
-	Guestosid             string            `json:"guestosid"`
-	Haenable              bool              `json:"haenable"`
-	Hostid                string            `json:"hostid"`
-	Hostname              string            `json:"hostname"`
-	Hypervisor            string            `json:"hypervisor"`
-	Id                    string            `json:"id"`
-	Instancename          string            `json:"instancename"`
-	Isdynamicallyscalable bool              `json:"isdynamicallyscalable"`
-	Isodisplaytext        string            `json:"isodisplaytext"`
-	Isoid                 string            `json:"isoid"`
-	Isoname               string            `json:"isoname"`
-	Keypair               string            `json:"keypair"`
-	Memory                int               `json:"memory"`
-	Memoryintfreekbs      int64             `json:"memoryintfreekbs"`
-	Memorykbs             int64             `json:"memorykbs"`
-	Memorytargetkbs       int64             `json:"memorytargetkbs"`
-	Name                  string            `json:"name"`
-	Networkkbsread        int64             `json:"networkkbsread"`
-	Networkkbswrite       int64             `json:"networkkbswrite"`
+	Cpunumber             int       `json:"cpunumber"`
+	Cpuspeed              int       `json:"cpuspeed"`
+	Cpuused               string    `json:"cpuused"`
+	Created               string    `json:"created"`
+	Details               StringMap `json:"details"`
+	Diskioread            int64     `json:"diskioread"`
+	Diskiowrite           int64     `json:"diskiowrite"`
+	Diskkbsread           int64     `json:"diskkbsread"`
+	Diskkbswrite          int64     `json:"diskkbswrite"`
+	Diskofferingid        string    `json:"diskofferingid"`
+	Diskofferingname      string    `json:"diskofferingname"`
+	Displayname           string    `json:"displayname"`
+	Displayvm             bool      `json:"displayvm"`
+	Domain                string    `json:"domain"`
+	Domainid              string    `json:"domainid"`
+	Forvirtualnetwork     bool      `json:"forvirtualnetwork"`
+	Group                 string    `json:"group"`
+	Groupid               string    `json:"groupid"`
+	Guestosid             string    `json:"guestosid"`
+	Haenable              bool      `json:"haenable"`
+	Hostid                string    `json:"hostid"`
+	Hostname              string    `json:"hostname"`
+	Hypervisor            string    `json:"hypervisor"`
+	Id                    string    `json:"id"`
+	Instancename          string    `json:"instancename"`
+	Isdynamicallyscalable bool      `json:"isdynamicallyscalable"`
+	Isodisplaytext        string    `json:"isodisplaytext"`
+	Isoid                 string    `json:"isoid"`
+	Isoname               string    `json:"isoname"`
+	Keypair               string    `json:"keypair"`
+	Memory                int       `json:"memory"`
+	Memoryintfreekbs      int64     `json:"memoryintfreekbs"`
+	Memorykbs             int64     `json:"memorykbs"`
+	Memorytargetkbs       int64     `json:"memorytargetkbs"`
+	Name                  string    `json:"name"`
+	Networkkbsread        int64     `json:"networkkbsread"`
+	Networkkbswrite       int64     `json:"networkkbswrite"`
 	Nic                   []struct {
 		Broadcasturi         string `json:"broadcasturi"`
 		Deviceid             string `json:"deviceid"`
@@ -795,6 +1546,16 @@ type CleanVMReservationsParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *CleanVMReservationsParams) Clone() *CleanVMReservationsParams {
+	c := &CleanVMReservationsParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *CleanVMReservationsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -804,16 +1565,41 @@ func (p *CleanVMReservationsParams) toURLValues() url.Values {
 }
 
 // You should always use this function to get a new CleanVMReservationsParams instance,
-// as then you are sure you have configured all required params
-func (s *VirtualMachineService) NewCleanVMReservationsParams() *CleanVMReservationsParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *VirtualMachineService) NewCleanVMReservationsParams(opts ...func(*CleanVMReservationsParams)) *CleanVMReservationsParams {
 	p := &CleanVMReservationsParams{}
 	p.p = make(map[string]interface{})
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Cleanups VM reservations in the database.
-func (s *VirtualMachineService) CleanVMReservations(p *CleanVMReservationsParams) (*CleanVMReservationsResponse, error) {
-	resp, err := s.cs.newRequest("cleanVMReservations", p.toURLValues())
+func (s *VirtualMachineService) CleanVMReservations(p *CleanVMReservationsParams, opts ...OptionFunc) (*CleanVMReservationsResponse, error) {
+	return s.CleanVMReservationsWithContext(context.Background(), p, opts...)
+}
+
+// CleanVMReservationsWithContext is the same as CleanVMReservations, but the request is cancelled when the context is done
+func (s *VirtualMachineService) CleanVMReservationsWithContext(ctx context.Context, p *CleanVMReservationsParams, opts ...OptionFunc) (*CleanVMReservationsResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	return s.CleanVMReservationsRawWithContext(ctx, p.toURLValues())
+}
+
+// CleanVMReservationsRaw is the same as CleanVMReservations, but takes the params as url.Values instead of typed params
+func (s *VirtualMachineService) CleanVMReservationsRaw(v url.Values) (*CleanVMReservationsResponse, error) {
+	return s.CleanVMReservationsRawWithContext(context.Background(), v)
+}
+
+// CleanVMReservationsRawWithContext is the same as CleanVMReservationsRaw, but the request is cancelled when the context is done
+func (s *VirtualMachineService) CleanVMReservationsRawWithContext(ctx context.Context, v url.Values) (*CleanVMReservationsResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "cleanVMReservations", "cleanvmreservationsresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -825,9 +1611,9 @@ func (s *VirtualMachineService) CleanVMReservations(p *CleanVMReservationsParams
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -851,6 +1637,16 @@ type DeployVirtualMachineParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *DeployVirtualMachineParams) Clone() *DeployVirtualMachineParams {
+	c := &DeployVirtualMachineParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeployVirtualMachineParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -874,10 +1670,9 @@ func (p *DeployVirtualMachineParams) toURLValues() url.Values {
 		u.Set("deploymentplanner", v.(string))
 	}
 	if v, found := p.p["details"]; found {
-		i := 0
-		for k, vv := range v.(map[string]string) {
-			u.Set(fmt.Sprintf("details[%d].%s", i, k), vv)
-			i++
+		m := v.(map[string]string)
+		for i, k := range getSortedKeysFromMap(m) {
+			u.Set(fmt.Sprintf("details[%d].%s", i, k), m[k])
 		}
 	}
 	if v, found := p.p["diskofferingid"]; found {
@@ -909,11 +1704,10 @@ func (p *DeployVirtualMachineParams) toURLValues() url.Values {
 		u.Set("ipaddress", v.(string))
 	}
 	if v, found := p.p["iptonetworklist"]; found {
-		i := 0
-		for k, vv := range v.(map[string]string) {
+		m := v.(map[string]string)
+		for i, k := range getSortedKeysFromMap(m) {
 			u.Set(fmt.Sprintf("iptonetworklist[%d].key", i), k)
-			u.Set(fmt.Sprintf("iptonetworklist[%d].value", i), vv)
-			i++
+			u.Set(fmt.Sprintf("iptonetworklist[%d].value", i), m[k])
 		}
 	}
 	if v, found := p.p["keyboard"]; found {
@@ -964,9 +1758,29 @@ func (p *DeployVirtualMachineParams) toURLValues() url.Values {
 	if v, found := p.p["zoneid"]; found {
 		u.Set("zoneid", v.(string))
 	}
+	if v, found := p.p["properties"]; found {
+		m := v.(map[string]string)
+		for i, k := range getSortedKeysFromMap(m) {
+			u.Set(fmt.Sprintf("properties[%d].key", i), k)
+			u.Set(fmt.Sprintf("properties[%d].value", i), m[k])
+		}
+	}
+	if v, found := p.p["clusterid"]; found {
+		u.Set("clusterid", v.(string))
+	}
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *DeployVirtualMachineParams) validate() error {
+	for _, k := range []string{"serviceofferingid", "templateid", "zoneid"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of deployVirtualMachine", k)
+		}
+	}
+	return nil
+}
+
 func (p *DeployVirtualMachineParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -975,6 +1789,13 @@ func (p *DeployVirtualMachineParams) SetAccount(v string) {
 	return
 }
 
+// SetAccountIfUnset sets account to v, unless it is already set
+func (p *DeployVirtualMachineParams) SetAccountIfUnset(v string) {
+	if _, found := p.p["account"]; !found {
+		p.SetAccount(v)
+	}
+}
+
 func (p *DeployVirtualMachineParams) SetAffinitygroupids(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -983,6 +1804,18 @@ func (p *DeployVirtualMachineParams) SetAffinitygroupids(v []string) {
 	return
 }
 
+// SetAffinitygroupidsIfUnset sets affinitygroupids to v, unless it is already set
+func (p *DeployVirtualMachineParams) SetAffinitygroupidsIfUnset(v []string) {
+	if _, found := p.p["affinitygroupids"]; !found {
+		p.SetAffinitygroupids(v)
+	}
+}
+
+// SetAffinitygroupidsCSV splits v on commas and sets the resulting values
+func (p *DeployVirtualMachineParams) SetAffinitygroupidsCSV(v string) {
+	p.SetAffinitygroupids(splitCSV(v))
+}
+
 func (p *DeployVirtualMachineParams) SetAffinitygroupnames(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -991,6 +1824,18 @@ func (p *DeployVirtualMachineParams) SetAffinitygroupnames(v []string) {
 	return
 }
 
+// SetAffinitygroupnamesIfUnset sets affinitygroupnames to v, unless it is already set
+func (p *DeployVirtualMachineParams) SetAffinitygroupnamesIfUnset(v []string) {
+	if _, found := p.p["affinitygroupnames"]; !found {
+		p.SetAffinitygroupnames(v)
+	}
+}
+
+// SetAffinitygroupnamesCSV splits v on commas and sets the resulting values
+func (p *DeployVirtualMachineParams) SetAffinitygroupnamesCSV(v string) {
+	p.SetAffinitygroupnames(splitCSV(v))
+}
+
 func (p *DeployVirtualMachineParams) SetCustomid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -999,6 +1844,13 @@ func (p *DeployVirtualMachineParams) SetCustomid(v string) {
 	return
 }
 
+// SetCustomidIfUnset sets customid to v, unless it is already set
+func (p *DeployVirtualMachineParams) SetCustomidIfUnset(v string) {
+	if _, found := p.p["customid"]; !found {
+		p.SetCustomid(v)
+	}
+}
+
 func (p *DeployVirtualMachineParams) SetDeploymentplanner(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1007,6 +1859,13 @@ func (p *DeployVirtualMachineParams) SetDeploymentplanner(v string) {
 	return
 }
 
+// SetDeploymentplannerIfUnset sets deploymentplanner to v, unless it is already set
+func (p *DeployVirtualMachineParams) SetDeploymentplannerIfUnset(v string) {
+	if _, found := p.p["deploymentplanner"]; !found {
+		p.SetDeploymentplanner(v)
+	}
+}
+
 func (p *DeployVirtualMachineParams) SetDetails(v map[string]string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1015,6 +1874,13 @@ func (p *DeployVirtualMachineParams) SetDetails(v map[string]string) {
 	return
 }
 
+// SetDetailsIfUnset sets details to v, unless it is already set
+func (p *DeployVirtualMachineParams) SetDetailsIfUnset(v map[string]string) {
+	if _, found := p.p["details"]; !found {
+		p.SetDetails(v)
+	}
+}
+
 func (p *DeployVirtualMachineParams) SetDiskofferingid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1023,6 +1889,13 @@ func (p *DeployVirtualMachineParams) SetDiskofferingid(v string) {
 	return
 }
 
+// SetDiskofferingidIfUnset sets diskofferingid to v, unless it is already set
+func (p *DeployVirtualMachineParams) SetDiskofferingidIfUnset(v string) {
+	if _, found := p.p["diskofferingid"]; !found {
+		p.SetDiskofferingid(v)
+	}
+}
+
 func (p *DeployVirtualMachineParams) SetDisplayname(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1031,6 +1904,13 @@ func (p *DeployVirtualMachineParams) SetDisplayname(v string) {
 	return
 }
 
+// SetDisplaynameIfUnset sets displayname to v, unless it is already set
+func (p *DeployVirtualMachineParams) SetDisplaynameIfUnset(v string) {
+	if _, found := p.p["displayname"]; !found {
+		p.SetDisplayname(v)
+	}
+}
+
 func (p *DeployVirtualMachineParams) SetDisplayvm(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1039,6 +1919,13 @@ func (p *DeployVirtualMachineParams) SetDisplayvm(v bool) {
 	return
 }
 
+// SetDisplayvmIfUnset sets displayvm to v, unless it is already set
+func (p *DeployVirtualMachineParams) SetDisplayvmIfUnset(v bool) {
+	if _, found := p.p["displayvm"]; !found {
+		p.SetDisplayvm(v)
+	}
+}
+
 func (p *DeployVirtualMachineParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1047,6 +1934,13 @@ func (p *DeployVirtualMachineParams) SetDomainid(v string) {
 	return
 }
 
+// SetDomainidIfUnset sets domainid to v, unless it is already set
+func (p *DeployVirtualMachineParams) SetDomainidIfUnset(v string) {
+	if _, found := p.p["domainid"]; !found {
+		p.SetDomainid(v)
+	}
+}
+
 func (p *DeployVirtualMachineParams) SetGroup(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1055,6 +1949,13 @@ func (p *DeployVirtualMachineParams) SetGroup(v string) {
 	return
 }
 
+// SetGroupIfUnset sets group to v, unless it is already set
+func (p *DeployVirtualMachineParams) SetGroupIfUnset(v string) {
+	if _, found := p.p["group"]; !found {
+		p.SetGroup(v)
+	}
+}
+
 func (p *DeployVirtualMachineParams) SetHostid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1063,14 +1964,28 @@ func (p *DeployVirtualMachineParams) SetHostid(v string) {
 	return
 }
 
-func (p *DeployVirtualMachineParams) SetHypervisor(v string) {
+// SetHostidIfUnset sets hostid to v, unless it is already set
+func (p *DeployVirtualMachineParams) SetHostidIfUnset(v string) {
+	if _, found := p.p["hostid"]; !found {
+		p.SetHostid(v)
+	}
+}
+
+func (p *DeployVirtualMachineParams) SetHypervisor(v HypervisorType) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	p.p["hypervisor"] = v
+	p.p["hypervisor"] = string(v)
 	return
 }
 
+// SetHypervisorIfUnset sets hypervisor to v, unless it is already set
+func (p *DeployVirtualMachineParams) SetHypervisorIfUnset(v HypervisorType) {
+	if _, found := p.p["hypervisor"]; !found {
+		p.SetHypervisor(v)
+	}
+}
+
 func (p *DeployVirtualMachineParams) SetIp6address(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1079,6 +1994,13 @@ func (p *DeployVirtualMachineParams) SetIp6address(v string) {
 	return
 }
 
+// SetIp6addressIfUnset sets ip6address to v, unless it is already set
+func (p *DeployVirtualMachineParams) SetIp6addressIfUnset(v string) {
+	if _, found := p.p["ip6address"]; !found {
+		p.SetIp6address(v)
+	}
+}
+
 func (p *DeployVirtualMachineParams) SetIpaddress(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1087,6 +2009,13 @@ func (p *DeployVirtualMachineParams) SetIpaddress(v string) {
 	return
 }
 
+// SetIpaddressIfUnset sets ipaddress to v, unless it is already set
+func (p *DeployVirtualMachineParams) SetIpaddressIfUnset(v string) {
+	if _, found := p.p["ipaddress"]; !found {
+		p.SetIpaddress(v)
+	}
+}
+
 func (p *DeployVirtualMachineParams) SetIptonetworklist(v map[string]string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1095,6 +2024,13 @@ func (p *DeployVirtualMachineParams) SetIptonetworklist(v map[string]string) {
 	return
 }
 
+// SetIptonetworklistIfUnset sets iptonetworklist to v, unless it is already set
+func (p *DeployVirtualMachineParams) SetIptonetworklistIfUnset(v map[string]string) {
+	if _, found := p.p["iptonetworklist"]; !found {
+		p.SetIptonetworklist(v)
+	}
+}
+
 func (p *DeployVirtualMachineParams) SetKeyboard(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1103,6 +2039,13 @@ func (p *DeployVirtualMachineParams) SetKeyboard(v string) {
 	return
 }
 
+// SetKeyboardIfUnset sets keyboard to v, unless it is already set
+func (p *DeployVirtualMachineParams) SetKeyboardIfUnset(v string) {
+	if _, found := p.p["keyboard"]; !found {
+		p.SetKeyboard(v)
+	}
+}
+
 func (p *DeployVirtualMachineParams) SetKeypair(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1111,6 +2054,13 @@ func (p *DeployVirtualMachineParams) SetKeypair(v string) {
 	return
 }
 
+// SetKeypairIfUnset sets keypair to v, unless it is already set
+func (p *DeployVirtualMachineParams) SetKeypairIfUnset(v string) {
+	if _, found := p.p["keypair"]; !found {
+		p.SetKeypair(v)
+	}
+}
+
 func (p *DeployVirtualMachineParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1119,6 +2069,13 @@ func (p *DeployVirtualMachineParams) SetName(v string) {
 	return
 }
 
+// SetNameIfUnset sets name to v, unless it is already set
+func (p *DeployVirtualMachineParams) SetNameIfUnset(v string) {
+	if _, found := p.p["name"]; !found {
+		p.SetName(v)
+	}
+}
+
 func (p *DeployVirtualMachineParams) SetNetworkids(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1127,6 +2084,18 @@ func (p *DeployVirtualMachineParams) SetNetworkids(v []string) {
 	return
 }
 
+// SetNetworkidsIfUnset sets networkids to v, unless it is already set
+func (p *DeployVirtualMachineParams) SetNetworkidsIfUnset(v []string) {
+	if _, found := p.p["networkids"]; !found {
+		p.SetNetworkids(v)
+	}
+}
+
+// SetNetworkidsCSV splits v on commas and sets the resulting values
+func (p *DeployVirtualMachineParams) SetNetworkidsCSV(v string) {
+	p.SetNetworkids(splitCSV(v))
+}
+
 func (p *DeployVirtualMachineParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1135,6 +2104,13 @@ func (p *DeployVirtualMachineParams) SetProjectid(v string) {
 	return
 }
 
+// SetProjectidIfUnset sets projectid to v, unless it is already set
+func (p *DeployVirtualMachineParams) SetProjectidIfUnset(v string) {
+	if _, found := p.p["projectid"]; !found {
+		p.SetProjectid(v)
+	}
+}
+
 func (p *DeployVirtualMachineParams) SetRootdisksize(v int64) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1143,6 +2119,13 @@ func (p *DeployVirtualMachineParams) SetRootdisksize(v int64) {
 	return
 }
 
+// SetRootdisksizeIfUnset sets rootdisksize to v, unless it is already set
+func (p *DeployVirtualMachineParams) SetRootdisksizeIfUnset(v int64) {
+	if _, found := p.p["rootdisksize"]; !found {
+		p.SetRootdisksize(v)
+	}
+}
+
 func (p *DeployVirtualMachineParams) SetSecuritygroupids(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1151,6 +2134,18 @@ func (p *DeployVirtualMachineParams) SetSecuritygroupids(v []string) {
 	return
 }
 
+// SetSecuritygroupidsIfUnset sets securitygroupids to v, unless it is already set
+func (p *DeployVirtualMachineParams) SetSecuritygroupidsIfUnset(v []string) {
+	if _, found := p.p["securitygroupids"]; !found {
+		p.SetSecuritygroupids(v)
+	}
+}
+
+// SetSecuritygroupidsCSV splits v on commas and sets the resulting values
+func (p *DeployVirtualMachineParams) SetSecuritygroupidsCSV(v string) {
+	p.SetSecuritygroupids(splitCSV(v))
+}
+
 func (p *DeployVirtualMachineParams) SetSecuritygroupnames(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1159,6 +2154,18 @@ func (p *DeployVirtualMachineParams) SetSecuritygroupnames(v []string) {
 	return
 }
 
+// SetSecuritygroupnamesIfUnset sets securitygroupnames to v, unless it is already set
+func (p *DeployVirtualMachineParams) SetSecuritygroupnamesIfUnset(v []string) {
+	if _, found := p.p["securitygroupnames"]; !found {
+		p.SetSecuritygroupnames(v)
+	}
+}
+
+// SetSecuritygroupnamesCSV splits v on commas and sets the resulting values
+func (p *DeployVirtualMachineParams) SetSecuritygroupnamesCSV(v string) {
+	p.SetSecuritygroupnames(splitCSV(v))
+}
+
 func (p *DeployVirtualMachineParams) SetServiceofferingid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1167,6 +2174,13 @@ func (p *DeployVirtualMachineParams) SetServiceofferingid(v string) {
 	return
 }
 
+// SetServiceofferingidIfUnset sets serviceofferingid to v, unless it is already set
+func (p *DeployVirtualMachineParams) SetServiceofferingidIfUnset(v string) {
+	if _, found := p.p["serviceofferingid"]; !found {
+		p.SetServiceofferingid(v)
+	}
+}
+
 func (p *DeployVirtualMachineParams) SetSize(v int64) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1175,6 +2189,13 @@ func (p *DeployVirtualMachineParams) SetSize(v int64) {
 	return
 }
 
+// SetSizeIfUnset sets size to v, unless it is already set
+func (p *DeployVirtualMachineParams) SetSizeIfUnset(v int64) {
+	if _, found := p.p["size"]; !found {
+		p.SetSize(v)
+	}
+}
+
 func (p *DeployVirtualMachineParams) SetStartvm(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1183,6 +2204,13 @@ func (p *DeployVirtualMachineParams) SetStartvm(v bool) {
 	return
 }
 
+// SetStartvmIfUnset sets startvm to v, unless it is already set
+func (p *DeployVirtualMachineParams) SetStartvmIfUnset(v bool) {
+	if _, found := p.p["startvm"]; !found {
+		p.SetStartvm(v)
+	}
+}
+
 func (p *DeployVirtualMachineParams) SetTemplateid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1191,6 +2219,13 @@ func (p *DeployVirtualMachineParams) SetTemplateid(v string) {
 	return
 }
 
+// SetTemplateidIfUnset sets templateid to v, unless it is already set
+func (p *DeployVirtualMachineParams) SetTemplateidIfUnset(v string) {
+	if _, found := p.p["templateid"]; !found {
+		p.SetTemplateid(v)
+	}
+}
+
 func (p *DeployVirtualMachineParams) SetUserdata(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1199,6 +2234,13 @@ func (p *DeployVirtualMachineParams) SetUserdata(v string) {
 	return
 }
 
+// SetUserdataIfUnset sets userdata to v, unless it is already set
+func (p *DeployVirtualMachineParams) SetUserdataIfUnset(v string) {
+	if _, found := p.p["userdata"]; !found {
+		p.SetUserdata(v)
+	}
+}
+
 func (p *DeployVirtualMachineParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1207,20 +2249,187 @@ func (p *DeployVirtualMachineParams) SetZoneid(v string) {
 	return
 }
 
+// SetZoneidIfUnset sets zoneid to v, unless it is already set
+func (p *DeployVirtualMachineParams) SetZoneidIfUnset(v string) {
+	if _, found := p.p["zoneid"]; !found {
+		p.SetZoneid(v)
+	}
+}
+
 // You should always use this function to get a new DeployVirtualMachineParams instance,
-// as then you are sure you have configured all required params
-func (s *VirtualMachineService) NewDeployVirtualMachineParams(serviceofferingid string, templateid string, zoneid string) *DeployVirtualMachineParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *VirtualMachineService) NewDeployVirtualMachineParams(serviceofferingid string, templateid string, zoneid string, opts ...func(*DeployVirtualMachineParams)) *DeployVirtualMachineParams {
 	p := &DeployVirtualMachineParams{}
 	p.p = make(map[string]interface{})
 	p.p["serviceofferingid"] = serviceofferingid
 	p.p["templateid"] = templateid
 	p.p["zoneid"] = zoneid
+	for _, fn := range opts {
+		fn(p)
+	}
+	return p
+}
+
+// DeployVirtualMachineOpts contains all params that can be set on a DeployVirtualMachineParams.
+// Optional params are pointers (or slices and maps) which are only set when not nil.
+type DeployVirtualMachineOpts struct {
+	Account            *string
+	Affinitygroupids   []string
+	Affinitygroupnames []string
+	Customid           *string
+	Deploymentplanner  *string
+	Details            map[string]string
+	Diskofferingid     *string
+	Displayname        *string
+	Displayvm          *bool
+	Domainid           *string
+	Group              *string
+	Hostid             *string
+	Hypervisor         *HypervisorType
+	Ip6address         *string
+	Ipaddress          *string
+	Iptonetworklist    map[string]string
+	Keyboard           *string
+	Keypair            *string
+	Name               *string
+	Networkids         []string
+	Projectid          *string
+	Rootdisksize       *int64
+	Securitygroupids   []string
+	Securitygroupnames []string
+	Serviceofferingid  string
+	Size               *int64
+	Startvm            *bool
+	Templateid         string
+	Userdata           *string
+	Zoneid             string
+}
+
+// NewDeployVirtualMachineParamsFromOpts is an alternative for NewDeployVirtualMachineParams which takes all params using
+// an options struct, as that is easier to read for APIs with many required params
+func (s *VirtualMachineService) NewDeployVirtualMachineParamsFromOpts(o DeployVirtualMachineOpts) *DeployVirtualMachineParams {
+	p := s.NewDeployVirtualMachineParams(o.Serviceofferingid, o.Templateid, o.Zoneid)
+	if o.Account != nil {
+		p.p["account"] = *o.Account
+	}
+	if o.Affinitygroupids != nil {
+		p.p["affinitygroupids"] = o.Affinitygroupids
+	}
+	if o.Affinitygroupnames != nil {
+		p.p["affinitygroupnames"] = o.Affinitygroupnames
+	}
+	if o.Customid != nil {
+		p.p["customid"] = *o.Customid
+	}
+	if o.Deploymentplanner != nil {
+		p.p["deploymentplanner"] = *o.Deploymentplanner
+	}
+	if o.Details != nil {
+		p.p["details"] = o.Details
+	}
+	if o.Diskofferingid != nil {
+		p.p["diskofferingid"] = *o.Diskofferingid
+	}
+	if o.Displayname != nil {
+		p.p["displayname"] = *o.Displayname
+	}
+	if o.Displayvm != nil {
+		p.p["displayvm"] = *o.Displayvm
+	}
+	if o.Domainid != nil {
+		p.p["domainid"] = *o.Domainid
+	}
+	if o.Group != nil {
+		p.p["group"] = *o.Group
+	}
+	if o.Hostid != nil {
+		p.p["hostid"] = *o.Hostid
+	}
+	if o.Hypervisor != nil {
+		p.p["hypervisor"] = string(*o.Hypervisor)
+	}
+	if o.Ip6address != nil {
+		p.p["ip6address"] = *o.Ip6address
+	}
+	if o.Ipaddress != nil {
+		p.p["ipaddress"] = *o.Ipaddress
+	}
+	if o.Iptonetworklist != nil {
+		p.p["iptonetworklist"] = o.Iptonetworklist
+	}
+	if o.Keyboard != nil {
+		p.p["keyboard"] = *o.Keyboard
+	}
+	if o.Keypair != nil {
+		p.p["keypair"] = *o.Keypair
+	}
+	if o.Name != nil {
+		p.p["name"] = *o.Name
+	}
+	if o.Networkids != nil {
+		p.p["networkids"] = o.Networkids
+	}
+	if o.Projectid != nil {
+		p.p["projectid"] = *o.Projectid
+	}
+	if o.Rootdisksize != nil {
+		p.p["rootdisksize"] = *o.Rootdisksize
+	}
+	if o.Securitygroupids != nil {
+		p.p["securitygroupids"] = o.Securitygroupids
+	}
+	if o.Securitygroupnames != nil {
+		p.p["securitygroupnames"] = o.Securitygroupnames
+	}
+	if o.Size != nil {
+		p.p["size"] = *o.Size
+	}
+	if o.Startvm != nil {
+		p.p["startvm"] = *o.Startvm
+	}
+	if o.Userdata != nil {
+		p.p["userdata"] = *o.Userdata
+	}
 	return p
 }
 
 // Creates and automatically starts a virtual machine based on a service offering, disk offering, and template.
-func (s *VirtualMachineService) DeployVirtualMachine(p *DeployVirtualMachineParams) (*DeployVirtualMachineResponse, error) {
-	resp, err := s.cs.newRequest("deployVirtualMachine", p.toURLValues())
+func (s *VirtualMachineService) DeployVirtualMachine(p *DeployVirtualMachineParams, opts ...OptionFunc) (*DeployVirtualMachineResponse, error) {
+	return s.DeployVirtualMachineWithContext(context.Background(), p, opts...)
+}
+
+// DeployVirtualMachineWithContext is the same as DeployVirtualMachine, but the request is cancelled when the context is done
+func (s *VirtualMachineService) DeployVirtualMachineWithContext(ctx context.Context, p *DeployVirtualMachineParams, opts ...OptionFunc) (*DeployVirtualMachineResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	if err := checkMutuallyExclusive(p.p, "iptonetworklist", "networkids"); err != nil {
+		return nil, err
+	}
+
+	if err := checkMutuallyExclusive(p.p, "securitygroupids", "securitygroupnames"); err != nil {
+		return nil, err
+	}
+
+	return s.DeployVirtualMachineRawWithContext(ctx, p.toURLValues())
+}
+
+// DeployVirtualMachineRaw is the same as DeployVirtualMachine, but takes the params as url.Values instead of typed params
+func (s *VirtualMachineService) DeployVirtualMachineRaw(v url.Values) (*DeployVirtualMachineResponse, error) {
+	return s.DeployVirtualMachineRawWithContext(context.Background(), v)
+}
+
+// DeployVirtualMachineRawWithContext is the same as DeployVirtualMachineRaw, but the request is cancelled when the context is done
+func (s *VirtualMachineService) DeployVirtualMachineRawWithContext(ctx context.Context, v url.Values) (*DeployVirtualMachineResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "deployVirtualMachine", "deployvirtualmachineresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -1232,15 +2441,15 @@ func (s *VirtualMachineService) DeployVirtualMachine(p *DeployVirtualMachinePara
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
+		b, err = getRawValueByKey(b, "virtualmachine")
 		if err != nil {
 			return nil, err
 		}
@@ -1268,43 +2477,43 @@ type DeployVirtualMachineResponse struct {
 		Type              string   `json:"type"`
 		VirtualmachineIds []string `json:"virtualmachineIds"`
 	} `json:"affinitygroup"`
-	Cpunumber             int               `json:"cpunumber"`
-	Cpuspeed              int               `json:"cpuspeed"`
-	Cpuused               string            `json:"cpuused"`
-	Created               string            `json:"created"`
-	Details               map[string]string `json:"details"`
-	Diskioread            int64             `json:"diskioread"`
-	Diskiowrite           int64             `json:"diskiowrite"`
-	Diskkbsread           int64             `json:"diskkbsread"`
-	Diskkbswrite          int64             `json:"diskkbswrite"`
-	Diskofferingid        string            `json:"diskofferingid"`
-	Diskofferingname      string            `json:"diskofferingname"`
-	Displayname           string            `json:"displayname"`
-	Displayvm             bool              `json:"displayvm"`
-	Domain                string            `json:"domain"`
-	Domainid              string            `json:"domainid"`
-	Forvirtualnetwork     bool              `json:"forvirtualnetwork"`
-	Group                 string            `json:"group"`
-	Groupid               string            `json:"groupid"`
-	Guestosid             string            `json:"guestosid"`
-	Haenable              bool              `json:"haenable"`
-	Hostid                string            `json:"hostid"`
-	Hostname              string            `json:"hostname"`
-	Hypervisor            string            `json:"hypervisor"`
-	Id                    string            `json:"id"`
-	Instancename          string            `json:"instancename"`
-	Isdynamicallyscalable bool              `json:"isdynamicallyscalable"`
-	Isodisplaytext        string            `json:"isodisplaytext"`
-	Isoid                 string            `json:"isoid"`
-	Isoname               string            `json:"isoname"`
-	Keypair               string            `json:"keypair"`
-	Memory                int               `json:"memory"`
-	Memoryintfreekbs      int64             `json:"memoryintfreekbs"`
-	Memorykbs             int64             `json:"memorykbs"`
-	Memorytargetkbs       int64             `json:"memorytargetkbs"`
-	Name                  string            `json:"name"`
-	Networkkbsread        int64             `json:"networkkbsread"`
-	Networkkbswrite       int64             `json:"networkkbswrite"`
+	Cpunumber             int       `json:"cpunumber"`
+	Cpuspeed              int       `json:"cpuspeed"`
+	Cpuused               string    `json:"cpuused"`
+	Created               string    `json:"created"`
+	Details               StringMap `json:"details"`
+	Diskioread            int64     `json:"diskioread"`
+	Diskiowrite           int64     `json:"diskiowrite"`
+	Diskkbsread           int64     `json:"diskkbsread"`
+	Diskkbswrite          int64     `json:"diskkbswrite"`
+	Diskofferingid        string    `json:"diskofferingid"`
+	Diskofferingname      string    `json:"diskofferingname"`
+	Displayname           string    `json:"displayname"`
+	Displayvm             bool      `json:"displayvm"`
+	Domain                string    `json:"domain"`
+	Domainid              string    `json:"domainid"`
+	Forvirtualnetwork     bool      `json:"forvirtualnetwork"`
+	Group                 string    `json:"group"`
+	Groupid               string    `json:"groupid"`
+	Guestosid             string    `json:"guestosid"`
+	Haenable              bool      `json:"haenable"`
+	Hostid                string    `json:"hostid"`
+	Hostname              string    `json:"hostname"`
+	Hypervisor            string    `json:"hypervisor"`
+	Id                    string    `json:"id"`
+	Instancename          string    `json:"instancename"`
+	Isdynamicallyscalable bool      `json:"isdynamicallyscalable"`
+	Isodisplaytext        string    `json:"isodisplaytext"`
+	Isoid                 string    `json:"isoid"`
+	Isoname               string    `json:"isoname"`
+	Keypair               string    `json:"keypair"`
+	Memory                int       `json:"memory"`
+	Memoryintfreekbs      int64     `json:"memoryintfreekbs"`
+	Memorykbs             int64     `json:"memorykbs"`
+	Memorytargetkbs       int64     `json:"memorytargetkbs"`
+	Name                  string    `json:"name"`
+	Networkkbsread        int64     `json:"networkkbsread"`
+	Networkkbswrite       int64     `json:"networkkbswrite"`
 	Nic                   []struct {
 		Broadcasturi         string `json:"broadcasturi"`
 		Deviceid             string `json:"deviceid"`
@@ -1427,6 +2636,16 @@ type DestroyVirtualMachineParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *DestroyVirtualMachineParams) Clone() *DestroyVirtualMachineParams {
+	c := &DestroyVirtualMachineParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DestroyVirtualMachineParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1442,6 +2661,16 @@ func (p *DestroyVirtualMachineParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *DestroyVirtualMachineParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of destroyVirtualMachine", k)
+		}
+	}
+	return nil
+}
+
 func (p *DestroyVirtualMachineParams) SetExpunge(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1450,6 +2679,13 @@ func (p *DestroyVirtualMachineParams) SetExpunge(v bool) {
 	return
 }
 
+// SetExpungeIfUnset sets expunge to v, unless it is already set
+func (p *DestroyVirtualMachineParams) SetExpungeIfUnset(v bool) {
+	if _, found := p.p["expunge"]; !found {
+		p.SetExpunge(v)
+	}
+}
+
 func (p *DestroyVirtualMachineParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1458,18 +2694,54 @@ func (p *DestroyVirtualMachineParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *DestroyVirtualMachineParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 // You should always use this function to get a new DestroyVirtualMachineParams instance,
-// as then you are sure you have configured all required params
-func (s *VirtualMachineService) NewDestroyVirtualMachineParams(id string) *DestroyVirtualMachineParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *VirtualMachineService) NewDestroyVirtualMachineParams(id string, opts ...func(*DestroyVirtualMachineParams)) *DestroyVirtualMachineParams {
 	p := &DestroyVirtualMachineParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Destroys a virtual machine.
-func (s *VirtualMachineService) DestroyVirtualMachine(p *DestroyVirtualMachineParams) (*DestroyVirtualMachineResponse, error) {
-	resp, err := s.cs.newRequest("destroyVirtualMachine", p.toURLValues())
+func (s *VirtualMachineService) DestroyVirtualMachine(p *DestroyVirtualMachineParams, opts ...OptionFunc) (*DestroyVirtualMachineResponse, error) {
+	return s.DestroyVirtualMachineWithContext(context.Background(), p, opts...)
+}
+
+// DestroyVirtualMachineWithContext is the same as DestroyVirtualMachine, but the request is cancelled when the context is done
+func (s *VirtualMachineService) DestroyVirtualMachineWithContext(ctx context.Context, p *DestroyVirtualMachineParams, opts ...OptionFunc) (*DestroyVirtualMachineResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.DestroyVirtualMachineRawWithContext(ctx, p.toURLValues())
+}
+
+// DestroyVirtualMachineRaw is the same as DestroyVirtualMachine, but takes the params as url.Values instead of typed params
+func (s *VirtualMachineService) DestroyVirtualMachineRaw(v url.Values) (*DestroyVirtualMachineResponse, error) {
+	return s.DestroyVirtualMachineRawWithContext(context.Background(), v)
+}
+
+// DestroyVirtualMachineRawWithContext is the same as DestroyVirtualMachineRaw, but the request is cancelled when the context is done
+func (s *VirtualMachineService) DestroyVirtualMachineRawWithContext(ctx context.Context, v url.Values) (*DestroyVirtualMachineResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "destroyVirtualMachine", "destroyvirtualmachineresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -1481,9 +2753,9 @@ func (s *VirtualMachineService) DestroyVirtualMachine(p *DestroyVirtualMachinePa
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -1517,43 +2789,43 @@ type DestroyVirtualMachineResponse struct {
 		Type              string   `json:"type"`
 		VirtualmachineIds []string `json:"virtualmachineIds"`
 	} `json:"affinitygroup"`
-	Cpunumber             int               `json:"cpunumber"`
-	Cpuspeed              int               `json:"cpuspeed"`
-	Cpuused               string            `json:"cpuused"`
-	Created               string            `json:"created"`
-	Details               map[string]string `json:"details"`
-	Diskioread            int64             `json:"diskioread"`
-	Diskiowrite           int64             `json:"diskiowrite"`
-	Diskkbsread           int64             `json:"diskkbsread"`
-	Diskkbswrite          int64             `json:"diskkbswrite"`
-	Diskofferingid        string            `json:"diskofferingid"`
-	Diskofferingname      string            `json:"diskofferingname"`
-	Displayname           string            `json:"displayname"`
-	Displayvm             bool              `json:"displayvm"`
-	Domain                string            `json:"domain"`
-	Domainid              string            `json:"domainid"`
-	Forvirtualnetwork     bool              `json:"forvirtualnetwork"`
-	Group                 string            `json:"group"`
-	Groupid               string            `json:"groupid"`
-	Guestosid             string            `json:"guestosid"`
-	Haenable              bool              `json:"haenable"`
-	Hostid                string            `json:"hostid"`
-	Hostname              string            `json:"hostname"`
-	Hypervisor            string            `json:"hypervisor"`
-	Id                    string            `json:"id"`
-	Instancename          string            `json:"instancename"`
-	Isdynamicallyscalable bool              `json:"isdynamicallyscalable"`
-	Isodisplaytext        string            `json:"isodisplaytext"`
-	Isoid                 string            `json:"isoid"`
-	Isoname               string            `json:"isoname"`
-	Keypair               string            `json:"keypair"`
-	Memory                int               `json:"memory"`
-	Memoryintfreekbs      int64             `json:"memoryintfreekbs"`
-	Memorykbs             int64             `json:"memorykbs"`
-	Memorytargetkbs       int64             `json:"memorytargetkbs"`
-	Name                  string            `json:"name"`
-	Networkkbsread        int64             `json:"networkkbsread"`
-	Networkkbswrite       int64             `json:"networkkbswrite"`
+	Cpunumber             int       `json:"cpunumber"`
+	Cpuspeed              int       `json:"cpuspeed"`
+	Cpuused               string    `json:"cpuused"`
+	Created               string    `json:"created"`
+	Details               StringMap `json:"details"`
+	Diskioread            int64     `json:"diskioread"`
+	Diskiowrite           int64     `json:"diskiowrite"`
+	Diskkbsread           int64     `json:"diskkbsread"`
+	Diskkbswrite          int64     `json:"diskkbswrite"`
+	Diskofferingid        string    `json:"diskofferingid"`
+	Diskofferingname      string    `json:"diskofferingname"`
+	Displayname           string    `json:"displayname"`
+	Displayvm             bool      `json:"displayvm"`
+	Domain                string    `json:"domain"`
+	Domainid              string    `json:"domainid"`
+	Forvirtualnetwork     bool      `json:"forvirtualnetwork"`
+	Group                 string    `json:"group"`
+	Groupid               string    `json:"groupid"`
+	Guestosid             string    `json:"guestosid"`
+	Haenable              bool      `json:"haenable"`
+	Hostid                string    `json:"hostid"`
+	Hostname              string    `json:"hostname"`
+	Hypervisor            string    `json:"hypervisor"`
+	Id                    string    `json:"id"`
+	Instancename          string    `json:"instancename"`
+	Isdynamicallyscalable bool      `json:"isdynamicallyscalable"`
+	Isodisplaytext        string    `json:"isodisplaytext"`
+	Isoid                 string    `json:"isoid"`
+	Isoname               string    `json:"isoname"`
+	Keypair               string    `json:"keypair"`
+	Memory                int       `json:"memory"`
+	Memoryintfreekbs      int64     `json:"memoryintfreekbs"`
+	Memorykbs             int64     `json:"memorykbs"`
+	Memorytargetkbs       int64     `json:"memorytargetkbs"`
+	Name                  string    `json:"name"`
+	Networkkbsread        int64     `json:"networkkbsread"`
+	Networkkbswrite       int64     `json:"networkkbswrite"`
 	Nic                   []struct {
 		Broadcasturi         string `json:"broadcasturi"`
 		Deviceid             string `json:"deviceid"`
@@ -1676,6 +2948,16 @@ type ExpungeVirtualMachineParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ExpungeVirtualMachineParams) Clone() *ExpungeVirtualMachineParams {
+	c := &ExpungeVirtualMachineParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ExpungeVirtualMachineParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1687,6 +2969,16 @@ func (p *ExpungeVirtualMachineParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *ExpungeVirtualMachineParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of expungeVirtualMachine", k)
+		}
+	}
+	return nil
+}
+
 func (p *ExpungeVirtualMachineParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1695,18 +2987,54 @@ func (p *ExpungeVirtualMachineParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *ExpungeVirtualMachineParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 // You should always use this function to get a new ExpungeVirtualMachineParams instance,
-// as then you are sure you have configured all required params
-func (s *VirtualMachineService) NewExpungeVirtualMachineParams(id string) *ExpungeVirtualMachineParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *VirtualMachineService) NewExpungeVirtualMachineParams(id string, opts ...func(*ExpungeVirtualMachineParams)) *ExpungeVirtualMachineParams {
 	p := &ExpungeVirtualMachineParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Expunge a virtual machine. Once expunged, it cannot be recoverd.
-func (s *VirtualMachineService) ExpungeVirtualMachine(p *ExpungeVirtualMachineParams) (*ExpungeVirtualMachineResponse, error) {
-	resp, err := s.cs.newRequest("expungeVirtualMachine", p.toURLValues())
+func (s *VirtualMachineService) ExpungeVirtualMachine(p *ExpungeVirtualMachineParams, opts ...OptionFunc) (*ExpungeVirtualMachineResponse, error) {
+	return s.ExpungeVirtualMachineWithContext(context.Background(), p, opts...)
+}
+
+// ExpungeVirtualMachineWithContext is the same as ExpungeVirtualMachine, but the request is cancelled when the context is done
+func (s *VirtualMachineService) ExpungeVirtualMachineWithContext(ctx context.Context, p *ExpungeVirtualMachineParams, opts ...OptionFunc) (*ExpungeVirtualMachineResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.ExpungeVirtualMachineRawWithContext(ctx, p.toURLValues())
+}
+
+// ExpungeVirtualMachineRaw is the same as ExpungeVirtualMachine, but takes the params as url.Values instead of typed params
+func (s *VirtualMachineService) ExpungeVirtualMachineRaw(v url.Values) (*ExpungeVirtualMachineResponse, error) {
+	return s.ExpungeVirtualMachineRawWithContext(context.Background(), v)
+}
+
+// ExpungeVirtualMachineRawWithContext is the same as ExpungeVirtualMachineRaw, but the request is cancelled when the context is done
+func (s *VirtualMachineService) ExpungeVirtualMachineRawWithContext(ctx context.Context, v url.Values) (*ExpungeVirtualMachineResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "expungeVirtualMachine", "expungevirtualmachineresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -1718,9 +3046,9 @@ func (s *VirtualMachineService) ExpungeVirtualMachine(p *ExpungeVirtualMachinePa
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -1744,6 +3072,16 @@ type GetVMPasswordParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *GetVMPasswordParams) Clone() *GetVMPasswordParams {
+	c := &GetVMPasswordParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *GetVMPasswordParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1755,6 +3093,16 @@ func (p *GetVMPasswordParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *GetVMPasswordParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of getVMPassword", k)
+		}
+	}
+	return nil
+}
+
 func (p *GetVMPasswordParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1763,22 +3111,62 @@ func (p *GetVMPasswordParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *GetVMPasswordParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 // You should always use this function to get a new GetVMPasswordParams instance,
-// as then you are sure you have configured all required params
-func (s *VirtualMachineService) NewGetVMPasswordParams(id string) *GetVMPasswordParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *VirtualMachineService) NewGetVMPasswordParams(id string, opts ...func(*GetVMPasswordParams)) *GetVMPasswordParams {
 	p := &GetVMPasswordParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Returns an encrypted password for the VM
-func (s *VirtualMachineService) GetVMPassword(p *GetVMPasswordParams) (*GetVMPasswordResponse, error) {
-	resp, err := s.cs.newRequest("getVMPassword", p.toURLValues())
+func (s *VirtualMachineService) GetVMPassword(p *GetVMPasswordParams, opts ...OptionFunc) (*GetVMPasswordResponse, error) {
+	return s.GetVMPasswordWithContext(context.Background(), p, opts...)
+}
+
+// GetVMPasswordWithContext is the same as GetVMPassword, but the request is cancelled when the context is done
+func (s *VirtualMachineService) GetVMPasswordWithContext(ctx context.Context, p *GetVMPasswordParams, opts ...OptionFunc) (*GetVMPasswordResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.GetVMPasswordRawWithContext(ctx, p.toURLValues())
+}
+
+// GetVMPasswordRaw is the same as GetVMPassword, but takes the params as url.Values instead of typed params
+func (s *VirtualMachineService) GetVMPasswordRaw(v url.Values) (*GetVMPasswordResponse, error) {
+	return s.GetVMPasswordRawWithContext(context.Background(), v)
+}
+
+// GetVMPasswordRawWithContext is the same as GetVMPasswordRaw, but the request is cancelled when the context is done
+func (s *VirtualMachineService) GetVMPasswordRawWithContext(ctx context.Context, v url.Values) (*GetVMPasswordResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "getVMPassword", "getvmpasswordresponse", v)
 	if err != nil {
 		return nil, err
 	}
 
+	if resp, err = getRawValueByKey(resp, "password"); err != nil {
+		return nil, err
+	}
+
 	var r GetVMPasswordResponse
 	if err := json.Unmarshal(resp, &r); err != nil {
 		return nil, err
@@ -1795,6 +3183,16 @@ type ListVirtualMachinesParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ListVirtualMachinesParams) Clone() *ListVirtualMachinesParams {
+	c := &ListVirtualMachinesParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListVirtualMachinesParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1893,11 +3291,10 @@ func (p *ListVirtualMachinesParams) toURLValues() url.Values {
 		u.Set("storageid", v.(string))
 	}
 	if v, found := p.p["tags"]; found {
-		i := 0
-		for k, vv := range v.(map[string]string) {
+		m := v.(map[string]string)
+		for i, k := range getSortedKeysFromMap(m) {
 			u.Set(fmt.Sprintf("tags[%d].key", i), k)
-			u.Set(fmt.Sprintf("tags[%d].value", i), vv)
-			i++
+			u.Set(fmt.Sprintf("tags[%d].value", i), m[k])
 		}
 	}
 	if v, found := p.p["templateid"]; found {
@@ -1923,6 +3320,13 @@ func (p *ListVirtualMachinesParams) SetAccount(v string) {
 	return
 }
 
+// SetAccountIfUnset sets account to v, unless it is already set
+func (p *ListVirtualMachinesParams) SetAccountIfUnset(v string) {
+	if _, found := p.p["account"]; !found {
+		p.SetAccount(v)
+	}
+}
+
 func (p *ListVirtualMachinesParams) SetAffinitygroupid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1931,6 +3335,13 @@ func (p *ListVirtualMachinesParams) SetAffinitygroupid(v string) {
 	return
 }
 
+// SetAffinitygroupidIfUnset sets affinitygroupid to v, unless it is already set
+func (p *ListVirtualMachinesParams) SetAffinitygroupidIfUnset(v string) {
+	if _, found := p.p["affinitygroupid"]; !found {
+		p.SetAffinitygroupid(v)
+	}
+}
+
 func (p *ListVirtualMachinesParams) SetDetails(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1939,6 +3350,18 @@ func (p *ListVirtualMachinesParams) SetDetails(v []string) {
 	return
 }
 
+// SetDetailsIfUnset sets details to v, unless it is already set
+func (p *ListVirtualMachinesParams) SetDetailsIfUnset(v []string) {
+	if _, found := p.p["details"]; !found {
+		p.SetDetails(v)
+	}
+}
+
+// SetDetailsCSV splits v on commas and sets the resulting values
+func (p *ListVirtualMachinesParams) SetDetailsCSV(v string) {
+	p.SetDetails(splitCSV(v))
+}
+
 func (p *ListVirtualMachinesParams) SetDisplayvm(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1947,6 +3370,13 @@ func (p *ListVirtualMachinesParams) SetDisplayvm(v bool) {
 	return
 }
 
+// SetDisplayvmIfUnset sets displayvm to v, unless it is already set
+func (p *ListVirtualMachinesParams) SetDisplayvmIfUnset(v bool) {
+	if _, found := p.p["displayvm"]; !found {
+		p.SetDisplayvm(v)
+	}
+}
+
 func (p *ListVirtualMachinesParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1955,6 +3385,13 @@ func (p *ListVirtualMachinesParams) SetDomainid(v string) {
 	return
 }
 
+// SetDomainidIfUnset sets domainid to v, unless it is already set
+func (p *ListVirtualMachinesParams) SetDomainidIfUnset(v string) {
+	if _, found := p.p["domainid"]; !found {
+		p.SetDomainid(v)
+	}
+}
+
 func (p *ListVirtualMachinesParams) SetForvirtualnetwork(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1963,6 +3400,13 @@ func (p *ListVirtualMachinesParams) SetForvirtualnetwork(v bool) {
 	return
 }
 
+// SetForvirtualnetworkIfUnset sets forvirtualnetwork to v, unless it is already set
+func (p *ListVirtualMachinesParams) SetForvirtualnetworkIfUnset(v bool) {
+	if _, found := p.p["forvirtualnetwork"]; !found {
+		p.SetForvirtualnetwork(v)
+	}
+}
+
 func (p *ListVirtualMachinesParams) SetGroupid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1971,6 +3415,13 @@ func (p *ListVirtualMachinesParams) SetGroupid(v string) {
 	return
 }
 
+// SetGroupidIfUnset sets groupid to v, unless it is already set
+func (p *ListVirtualMachinesParams) SetGroupidIfUnset(v string) {
+	if _, found := p.p["groupid"]; !found {
+		p.SetGroupid(v)
+	}
+}
+
 func (p *ListVirtualMachinesParams) SetHostid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1979,14 +3430,28 @@ func (p *ListVirtualMachinesParams) SetHostid(v string) {
 	return
 }
 
-func (p *ListVirtualMachinesParams) SetHypervisor(v string) {
+// SetHostidIfUnset sets hostid to v, unless it is already set
+func (p *ListVirtualMachinesParams) SetHostidIfUnset(v string) {
+	if _, found := p.p["hostid"]; !found {
+		p.SetHostid(v)
+	}
+}
+
+func (p *ListVirtualMachinesParams) SetHypervisor(v HypervisorType) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	p.p["hypervisor"] = v
+	p.p["hypervisor"] = string(v)
 	return
 }
 
+// SetHypervisorIfUnset sets hypervisor to v, unless it is already set
+func (p *ListVirtualMachinesParams) SetHypervisorIfUnset(v HypervisorType) {
+	if _, found := p.p["hypervisor"]; !found {
+		p.SetHypervisor(v)
+	}
+}
+
 func (p *ListVirtualMachinesParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1995,6 +3460,13 @@ func (p *ListVirtualMachinesParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *ListVirtualMachinesParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 func (p *ListVirtualMachinesParams) SetIds(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2003,6 +3475,18 @@ func (p *ListVirtualMachinesParams) SetIds(v []string) {
 	return
 }
 
+// SetIdsIfUnset sets ids to v, unless it is already set
+func (p *ListVirtualMachinesParams) SetIdsIfUnset(v []string) {
+	if _, found := p.p["ids"]; !found {
+		p.SetIds(v)
+	}
+}
+
+// SetIdsCSV splits v on commas and sets the resulting values
+func (p *ListVirtualMachinesParams) SetIdsCSV(v string) {
+	p.SetIds(splitCSV(v))
+}
+
 func (p *ListVirtualMachinesParams) SetIsoid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2011,6 +3495,13 @@ func (p *ListVirtualMachinesParams) SetIsoid(v string) {
 	return
 }
 
+// SetIsoidIfUnset sets isoid to v, unless it is already set
+func (p *ListVirtualMachinesParams) SetIsoidIfUnset(v string) {
+	if _, found := p.p["isoid"]; !found {
+		p.SetIsoid(v)
+	}
+}
+
 func (p *ListVirtualMachinesParams) SetIsrecursive(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2019,6 +3510,13 @@ func (p *ListVirtualMachinesParams) SetIsrecursive(v bool) {
 	return
 }
 
+// SetIsrecursiveIfUnset sets isrecursive to v, unless it is already set
+func (p *ListVirtualMachinesParams) SetIsrecursiveIfUnset(v bool) {
+	if _, found := p.p["isrecursive"]; !found {
+		p.SetIsrecursive(v)
+	}
+}
+
 func (p *ListVirtualMachinesParams) SetKeypair(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2027,6 +3525,13 @@ func (p *ListVirtualMachinesParams) SetKeypair(v string) {
 	return
 }
 
+// SetKeypairIfUnset sets keypair to v, unless it is already set
+func (p *ListVirtualMachinesParams) SetKeypairIfUnset(v string) {
+	if _, found := p.p["keypair"]; !found {
+		p.SetKeypair(v)
+	}
+}
+
 func (p *ListVirtualMachinesParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2035,6 +3540,13 @@ func (p *ListVirtualMachinesParams) SetKeyword(v string) {
 	return
 }
 
+// SetKeywordIfUnset sets keyword to v, unless it is already set
+func (p *ListVirtualMachinesParams) SetKeywordIfUnset(v string) {
+	if _, found := p.p["keyword"]; !found {
+		p.SetKeyword(v)
+	}
+}
+
 func (p *ListVirtualMachinesParams) SetListall(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2043,6 +3555,13 @@ func (p *ListVirtualMachinesParams) SetListall(v bool) {
 	return
 }
 
+// SetListallIfUnset sets listall to v, unless it is already set
+func (p *ListVirtualMachinesParams) SetListallIfUnset(v bool) {
+	if _, found := p.p["listall"]; !found {
+		p.SetListall(v)
+	}
+}
+
 func (p *ListVirtualMachinesParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2051,6 +3570,13 @@ func (p *ListVirtualMachinesParams) SetName(v string) {
 	return
 }
 
+// SetNameIfUnset sets name to v, unless it is already set
+func (p *ListVirtualMachinesParams) SetNameIfUnset(v string) {
+	if _, found := p.p["name"]; !found {
+		p.SetName(v)
+	}
+}
+
 func (p *ListVirtualMachinesParams) SetNetworkid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2059,6 +3585,13 @@ func (p *ListVirtualMachinesParams) SetNetworkid(v string) {
 	return
 }
 
+// SetNetworkidIfUnset sets networkid to v, unless it is already set
+func (p *ListVirtualMachinesParams) SetNetworkidIfUnset(v string) {
+	if _, found := p.p["networkid"]; !found {
+		p.SetNetworkid(v)
+	}
+}
+
 func (p *ListVirtualMachinesParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2067,6 +3600,13 @@ func (p *ListVirtualMachinesParams) SetPage(v int) {
 	return
 }
 
+// SetPageIfUnset sets page to v, unless it is already set
+func (p *ListVirtualMachinesParams) SetPageIfUnset(v int) {
+	if _, found := p.p["page"]; !found {
+		p.SetPage(v)
+	}
+}
+
 func (p *ListVirtualMachinesParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2075,6 +3615,13 @@ func (p *ListVirtualMachinesParams) SetPagesize(v int) {
 	return
 }
 
+// SetPagesizeIfUnset sets pagesize to v, unless it is already set
+func (p *ListVirtualMachinesParams) SetPagesizeIfUnset(v int) {
+	if _, found := p.p["pagesize"]; !found {
+		p.SetPagesize(v)
+	}
+}
+
 func (p *ListVirtualMachinesParams) SetPodid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2083,6 +3630,13 @@ func (p *ListVirtualMachinesParams) SetPodid(v string) {
 	return
 }
 
+// SetPodidIfUnset sets podid to v, unless it is already set
+func (p *ListVirtualMachinesParams) SetPodidIfUnset(v string) {
+	if _, found := p.p["podid"]; !found {
+		p.SetPodid(v)
+	}
+}
+
 func (p *ListVirtualMachinesParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2091,6 +3645,13 @@ func (p *ListVirtualMachinesParams) SetProjectid(v string) {
 	return
 }
 
+// SetProjectidIfUnset sets projectid to v, unless it is already set
+func (p *ListVirtualMachinesParams) SetProjectidIfUnset(v string) {
+	if _, found := p.p["projectid"]; !found {
+		p.SetProjectid(v)
+	}
+}
+
 func (p *ListVirtualMachinesParams) SetServiceofferingid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2099,14 +3660,28 @@ func (p *ListVirtualMachinesParams) SetServiceofferingid(v string) {
 	return
 }
 
-func (p *ListVirtualMachinesParams) SetState(v string) {
+// SetServiceofferingidIfUnset sets serviceofferingid to v, unless it is already set
+func (p *ListVirtualMachinesParams) SetServiceofferingidIfUnset(v string) {
+	if _, found := p.p["serviceofferingid"]; !found {
+		p.SetServiceofferingid(v)
+	}
+}
+
+func (p *ListVirtualMachinesParams) SetState(v VirtualMachineState) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	p.p["state"] = v
+	p.p["state"] = string(v)
 	return
 }
 
+// SetStateIfUnset sets state to v, unless it is already set
+func (p *ListVirtualMachinesParams) SetStateIfUnset(v VirtualMachineState) {
+	if _, found := p.p["state"]; !found {
+		p.SetState(v)
+	}
+}
+
 func (p *ListVirtualMachinesParams) SetStorageid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2115,6 +3690,13 @@ func (p *ListVirtualMachinesParams) SetStorageid(v string) {
 	return
 }
 
+// SetStorageidIfUnset sets storageid to v, unless it is already set
+func (p *ListVirtualMachinesParams) SetStorageidIfUnset(v string) {
+	if _, found := p.p["storageid"]; !found {
+		p.SetStorageid(v)
+	}
+}
+
 func (p *ListVirtualMachinesParams) SetTags(v map[string]string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2123,6 +3705,13 @@ func (p *ListVirtualMachinesParams) SetTags(v map[string]string) {
 	return
 }
 
+// SetTagsIfUnset sets tags to v, unless it is already set
+func (p *ListVirtualMachinesParams) SetTagsIfUnset(v map[string]string) {
+	if _, found := p.p["tags"]; !found {
+		p.SetTags(v)
+	}
+}
+
 func (p *ListVirtualMachinesParams) SetTemplateid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2131,6 +3720,13 @@ func (p *ListVirtualMachinesParams) SetTemplateid(v string) {
 	return
 }
 
+// SetTemplateidIfUnset sets templateid to v, unless it is already set
+func (p *ListVirtualMachinesParams) SetTemplateidIfUnset(v string) {
+	if _, found := p.p["templateid"]; !found {
+		p.SetTemplateid(v)
+	}
+}
+
 func (p *ListVirtualMachinesParams) SetUserid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2139,6 +3735,13 @@ func (p *ListVirtualMachinesParams) SetUserid(v string) {
 	return
 }
 
+// SetUseridIfUnset sets userid to v, unless it is already set
+func (p *ListVirtualMachinesParams) SetUseridIfUnset(v string) {
+	if _, found := p.p["userid"]; !found {
+		p.SetUserid(v)
+	}
+}
+
 func (p *ListVirtualMachinesParams) SetVpcid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2147,6 +3750,13 @@ func (p *ListVirtualMachinesParams) SetVpcid(v string) {
 	return
 }
 
+// SetVpcidIfUnset sets vpcid to v, unless it is already set
+func (p *ListVirtualMachinesParams) SetVpcidIfUnset(v string) {
+	if _, found := p.p["vpcid"]; !found {
+		p.SetVpcid(v)
+	}
+}
+
 func (p *ListVirtualMachinesParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2155,14 +3765,34 @@ func (p *ListVirtualMachinesParams) SetZoneid(v string) {
 	return
 }
 
+// SetZoneidIfUnset sets zoneid to v, unless it is already set
+func (p *ListVirtualMachinesParams) SetZoneidIfUnset(v string) {
+	if _, found := p.p["zoneid"]; !found {
+		p.SetZoneid(v)
+	}
+}
+
+var _ ListAllSetter = (*ListVirtualMachinesParams)(nil)
+
 // You should always use this function to get a new ListVirtualMachinesParams instance,
-// as then you are sure you have configured all required params
-func (s *VirtualMachineService) NewListVirtualMachinesParams() *ListVirtualMachinesParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *VirtualMachineService) NewListVirtualMachinesParams(opts ...func(*ListVirtualMachinesParams)) *ListVirtualMachinesParams {
 	p := &ListVirtualMachinesParams{}
 	p.p = make(map[string]interface{})
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
+func (p *ListVirtualMachinesParams) setFirstMatch() {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p[firstMatchKey] = true
+}
+
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *VirtualMachineService) GetVirtualMachineID(name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListVirtualMachinesParams{}
@@ -2170,7 +3800,7 @@ func (s *VirtualMachineService) GetVirtualMachineID(name string, opts ...OptionF
 
 	p.p["name"] = name
 
-	for _, fn := range append(s.cs.options, opts...) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
 		if err := fn(s.cs, p); err != nil {
 			return "", -1, err
 		}
@@ -2182,7 +3812,7 @@ func (s *VirtualMachineService) GetVirtualMachineID(name string, opts ...OptionF
 	}
 
 	if l.Count == 0 {
-		return "", l.Count, fmt.Errorf("No match found for %s: %+v", name, l)
+		return "", l.Count, fmt.Errorf("%w for %s: %+v", ErrNotFound, name, l)
 	}
 
 	if l.Count == 1 {
@@ -2195,6 +3825,10 @@ func (s *VirtualMachineService) GetVirtualMachineID(name string, opts ...OptionF
 				return v.Id, l.Count, nil
 			}
 		}
+
+		if _, ok := p.p[firstMatchKey]; ok && len(l.VirtualMachines) > 0 {
+			return l.VirtualMachines[0].Id, l.Count, nil
+		}
 	}
 	return "", l.Count, fmt.Errorf("Could not find an exact match for %s: %+v", name, l)
 }
@@ -2220,7 +3854,7 @@ func (s *VirtualMachineService) GetVirtualMachineByID(id string, opts ...OptionF
 
 	p.p["id"] = id
 
-	for _, fn := range append(s.cs.options, opts...) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
 		if err := fn(s.cs, p); err != nil {
 			return nil, -1, err
 		}
@@ -2231,24 +3865,60 @@ func (s *VirtualMachineService) GetVirtualMachineByID(id string, opts ...OptionF
 		if strings.Contains(err.Error(), fmt.Sprintf(
 			"Invalid parameter id value=%s due to incorrect long value format, "+
 				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+			return nil, 0, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
 	}
 
 	if l.Count == 1 {
 		return l.VirtualMachines[0], l.Count, nil
 	}
+
+	if _, ok := p.p[firstMatchKey]; ok && len(l.VirtualMachines) > 0 {
+		return l.VirtualMachines[0], l.Count, nil
+	}
 	return nil, l.Count, fmt.Errorf("There is more then one result for VirtualMachine UUID: %s!", id)
 }
 
+// VirtualMachineExists returns true if a VirtualMachine with the given ID exists. When no match is found
+// it returns false, while any other error is returned as is.
+func (s *VirtualMachineService) VirtualMachineExists(id string, opts ...OptionFunc) (bool, error) {
+	_, count, err := s.GetVirtualMachineByID(id, opts...)
+	if errors.Is(err, ErrNotFound) {
+		return false, nil
+	}
+	if count > 0 {
+		return true, nil
+	}
+	return false, err
+}
+
 // List the virtual machines owned by the account.
 func (s *VirtualMachineService) ListVirtualMachines(p *ListVirtualMachinesParams) (*ListVirtualMachinesResponse, error) {
-	resp, err := s.cs.newRequest("listVirtualMachines", p.toURLValues())
+	return s.ListVirtualMachinesWithContext(context.Background(), p)
+}
+
+// ListVirtualMachinesWithContext is the same as ListVirtualMachines, but the request is cancelled when the context is done
+func (s *VirtualMachineService) ListVirtualMachinesWithContext(ctx context.Context, p *ListVirtualMachinesParams) (*ListVirtualMachinesResponse, error) {
+	if err := checkMutuallyExclusive(p.p, "account", "projectid"); err != nil {
+		return nil, err
+	}
+
+	return s.ListVirtualMachinesRawWithContext(ctx, p.toURLValues())
+}
+
+// ListVirtualMachinesRaw is the same as ListVirtualMachines, but takes the params as url.Values instead of typed params
+func (s *VirtualMachineService) ListVirtualMachinesRaw(v url.Values) (*ListVirtualMachinesResponse, error) {
+	return s.ListVirtualMachinesRawWithContext(context.Background(), v)
+}
+
+// ListVirtualMachinesRawWithContext is the same as ListVirtualMachinesRaw, but the request is cancelled when the context is done
+func (s *VirtualMachineService) ListVirtualMachinesRawWithContext(ctx context.Context, v url.Values) (*ListVirtualMachinesResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "listVirtualMachines", "listvirtualmachinesresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -2261,6 +3931,93 @@ func (s *VirtualMachineService) ListVirtualMachines(p *ListVirtualMachinesParams
 	return &r, nil
 }
 
+// StreamVirtualMachines fetches the results of ListVirtualMachines page by page in the background and sends them on the
+// returned channel. Both returned channels are closed when done. If fetching a page fails, or the context
+// is done before all results are sent, the error is sent on the error channel and fetching stops. A
+// request for a page in flight is cancelled when the context is done. The page and pagesize set in the
+// params are ignored.
+func (s *VirtualMachineService) StreamVirtualMachines(ctx context.Context, p *ListVirtualMachinesParams) (<-chan *VirtualMachine, <-chan error) {
+	ch := make(chan *VirtualMachine)
+	errs := make(chan error, 1)
+
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesize(500)
+
+	go func() {
+		defer close(errs)
+		defer close(ch)
+
+		for page, count := 1, 0; ; page++ {
+			pp.SetPage(page)
+
+			l, err := s.ListVirtualMachinesWithContext(ctx, pp)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			for _, v := range l.VirtualMachines {
+				select {
+				case ch <- v:
+				case <-ctx.Done():
+					errs <- ctx.Err()
+					return
+				}
+			}
+
+			count += len(l.VirtualMachines)
+			if len(l.VirtualMachines) < 500 || count >= l.Count {
+				return
+			}
+		}
+	}()
+
+	return ch, errs
+}
+
+// ListVirtualMachinesWhere returns all results of ListVirtualMachines for which pred returns true. Note that the results are
+// filtered client-side, so all results matching the params are fetched first.
+// All pages are fetched, so the page and pagesize set in the params are ignored.
+func (s *VirtualMachineService) ListVirtualMachinesWhere(p *ListVirtualMachinesParams, pred func(*VirtualMachine) bool) ([]*VirtualMachine, error) {
+	var r []*VirtualMachine
+	ch, errs := s.StreamVirtualMachines(context.Background(), p)
+	for v := range ch {
+		if pred(v) {
+			r = append(r, v)
+		}
+	}
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ListAllVirtualMachines returns the results of ListVirtualMachines across all pages, by calling ListVirtualMachines page by page until all
+// results are fetched. The pagesize set in the params is used for every page (500 if not set), and the
+// page set in the params is ignored.
+func (s *VirtualMachineService) ListAllVirtualMachines(p *ListVirtualMachinesParams) ([]*VirtualMachine, error) {
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesizeIfUnset(500)
+	pagesize := pp.p["pagesize"].(int)
+
+	var r []*VirtualMachine
+	for page := 1; ; page++ {
+		pp.SetPage(page)
+
+		l, err := s.ListVirtualMachines(pp)
+		if err != nil {
+			return nil, err
+		}
+		r = append(r, l.VirtualMachines...)
+
+		if len(l.VirtualMachines) < pagesize || len(r) >= l.Count {
+			return r, nil
+		}
+	}
+}
+
 type ListVirtualMachinesResponse struct {
 	Count           int               `json:"count"`
 	VirtualMachines []*VirtualMachine `json:"virtualmachine"`
@@ -2280,43 +4037,43 @@ type VirtualMachine struct {
 		Type              string   `json:"type"`
 		VirtualmachineIds []string `json:"virtualmachineIds"`
 	} `json:"affinitygroup"`
-	Cpunumber             int               `json:"cpunumber"`
-	Cpuspeed              int               `json:"cpuspeed"`
-	Cpuused               string            `json:"cpuused"`
-	Created               string            `json:"created"`
-	Details               map[string]string `json:"details"`
-	Diskioread            int64             `json:"diskioread"`
-	Diskiowrite           int64             `json:"diskiowrite"`
-	Diskkbsread           int64             `json:"diskkbsread"`
-	Diskkbswrite          int64             `json:"diskkbswrite"`
-	Diskofferingid        string            `json:"diskofferingid"`
-	Diskofferingname      string            `json:"diskofferingname"`
-	Displayname           string            `json:"displayname"`
-	Displayvm             bool              `json:"displayvm"`
-	Domain                string            `json:"domain"`
-	Domainid              string            `json:"domainid"`
-	Forvirtualnetwork     bool              `json:"forvirtualnetwork"`
-	Group                 string            `json:"group"`
-	Groupid               string            `json:"groupid"`
-	Guestosid             string            `json:"guestosid"`
-	Haenable              bool              `json:"haenable"`
-	Hostid                string            `json:"hostid"`
-	Hostname              string            `json:"hostname"`
-	Hypervisor            string            `json:"hypervisor"`
-	Id                    string            `json:"id"`
-	Instancename          string            `json:"instancename"`
-	Isdynamicallyscalable bool              `json:"isdynamicallyscalable"`
-	Isodisplaytext        string            `json:"isodisplaytext"`
-	Isoid                 string            `json:"isoid"`
-	Isoname               string            `json:"isoname"`
-	Keypair               string            `json:"keypair"`
-	Memory                int               `json:"memory"`
-	Memoryintfreekbs      int64             `json:"memoryintfreekbs"`
-	Memorykbs             int64             `json:"memorykbs"`
-	Memorytargetkbs       int64             `json:"memorytargetkbs"`
-	Name                  string            `json:"name"`
-	Networkkbsread        int64             `json:"networkkbsread"`
-	Networkkbswrite       int64             `json:"networkkbswrite"`
+	Cpunumber             int       `json:"cpunumber"`
+	Cpuspeed              int       `json:"cpuspeed"`
+	Cpuused               string    `json:"cpuused"`
+	Created               string    `json:"created"`
+	Details               StringMap `json:"details"`
+	Diskioread            int64     `json:"diskioread"`
+	Diskiowrite           int64     `json:"diskiowrite"`
+	Diskkbsread           int64     `json:"diskkbsread"`
+	Diskkbswrite          int64     `json:"diskkbswrite"`
+	Diskofferingid        string    `json:"diskofferingid"`
+	Diskofferingname      string    `json:"diskofferingname"`
+	Displayname           string    `json:"displayname"`
+	Displayvm             bool      `json:"displayvm"`
+	Domain                string    `json:"domain"`
+	Domainid              string    `json:"domainid"`
+	Forvirtualnetwork     bool      `json:"forvirtualnetwork"`
+	Group                 string    `json:"group"`
+	Groupid               string    `json:"groupid"`
+	Guestosid             string    `json:"guestosid"`
+	Haenable              bool      `json:"haenable"`
+	Hostid                string    `json:"hostid"`
+	Hostname              string    `json:"hostname"`
+	Hypervisor            string    `json:"hypervisor"`
+	Id                    string    `json:"id"`
+	Instancename          string    `json:"instancename"`
+	Isdynamicallyscalable bool      `json:"isdynamicallyscalable"`
+	Isodisplaytext        string    `json:"isodisplaytext"`
+	Isoid                 string    `json:"isoid"`
+	Isoname               string    `json:"isoname"`
+	Keypair               string    `json:"keypair"`
+	Memory                int       `json:"memory"`
+	Memoryintfreekbs      int64     `json:"memoryintfreekbs"`
+	Memorykbs             int64     `json:"memorykbs"`
+	Memorytargetkbs       int64     `json:"memorytargetkbs"`
+	Name                  string    `json:"name"`
+	Networkkbsread        int64     `json:"networkkbsread"`
+	Networkkbswrite       int64     `json:"networkkbswrite"`
 	Nic                   []struct {
 		Broadcasturi         string `json:"broadcasturi"`
 		Deviceid             string `json:"deviceid"`
@@ -2439,6 +4196,16 @@ type MigrateVirtualMachineParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *MigrateVirtualMachineParams) Clone() *MigrateVirtualMachineParams {
+	c := &MigrateVirtualMachineParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *MigrateVirtualMachineParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -2456,6 +4223,16 @@ func (p *MigrateVirtualMachineParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *MigrateVirtualMachineParams) validate() error {
+	for _, k := range []string{"virtualmachineid"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of migrateVirtualMachine", k)
+		}
+	}
+	return nil
+}
+
 func (p *MigrateVirtualMachineParams) SetHostid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2464,6 +4241,13 @@ func (p *MigrateVirtualMachineParams) SetHostid(v string) {
 	return
 }
 
+// SetHostidIfUnset sets hostid to v, unless it is already set
+func (p *MigrateVirtualMachineParams) SetHostidIfUnset(v string) {
+	if _, found := p.p["hostid"]; !found {
+		p.SetHostid(v)
+	}
+}
+
 func (p *MigrateVirtualMachineParams) SetStorageid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2472,6 +4256,13 @@ func (p *MigrateVirtualMachineParams) SetStorageid(v string) {
 	return
 }
 
+// SetStorageidIfUnset sets storageid to v, unless it is already set
+func (p *MigrateVirtualMachineParams) SetStorageidIfUnset(v string) {
+	if _, found := p.p["storageid"]; !found {
+		p.SetStorageid(v)
+	}
+}
+
 func (p *MigrateVirtualMachineParams) SetVirtualmachineid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2480,18 +4271,54 @@ func (p *MigrateVirtualMachineParams) SetVirtualmachineid(v string) {
 	return
 }
 
+// SetVirtualmachineidIfUnset sets virtualmachineid to v, unless it is already set
+func (p *MigrateVirtualMachineParams) SetVirtualmachineidIfUnset(v string) {
+	if _, found := p.p["virtualmachineid"]; !found {
+		p.SetVirtualmachineid(v)
+	}
+}
+
 // You should always use this function to get a new MigrateVirtualMachineParams instance,
-// as then you are sure you have configured all required params
-func (s *VirtualMachineService) NewMigrateVirtualMachineParams(virtualmachineid string) *MigrateVirtualMachineParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *VirtualMachineService) NewMigrateVirtualMachineParams(virtualmachineid string, opts ...func(*MigrateVirtualMachineParams)) *MigrateVirtualMachineParams {
 	p := &MigrateVirtualMachineParams{}
 	p.p = make(map[string]interface{})
 	p.p["virtualmachineid"] = virtualmachineid
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Attempts Migration of a VM to a different host or Root volume of the vm to a different storage pool
-func (s *VirtualMachineService) MigrateVirtualMachine(p *MigrateVirtualMachineParams) (*MigrateVirtualMachineResponse, error) {
-	resp, err := s.cs.newRequest("migrateVirtualMachine", p.toURLValues())
+func (s *VirtualMachineService) MigrateVirtualMachine(p *MigrateVirtualMachineParams, opts ...OptionFunc) (*MigrateVirtualMachineResponse, error) {
+	return s.MigrateVirtualMachineWithContext(context.Background(), p, opts...)
+}
+
+// MigrateVirtualMachineWithContext is the same as MigrateVirtualMachine, but the request is cancelled when the context is done
+func (s *VirtualMachineService) MigrateVirtualMachineWithContext(ctx context.Context, p *MigrateVirtualMachineParams, opts ...OptionFunc) (*MigrateVirtualMachineResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.MigrateVirtualMachineRawWithContext(ctx, p.toURLValues())
+}
+
+// MigrateVirtualMachineRaw is the same as MigrateVirtualMachine, but takes the params as url.Values instead of typed params
+func (s *VirtualMachineService) MigrateVirtualMachineRaw(v url.Values) (*MigrateVirtualMachineResponse, error) {
+	return s.MigrateVirtualMachineRawWithContext(context.Background(), v)
+}
+
+// MigrateVirtualMachineRawWithContext is the same as MigrateVirtualMachineRaw, but the request is cancelled when the context is done
+func (s *VirtualMachineService) MigrateVirtualMachineRawWithContext(ctx context.Context, v url.Values) (*MigrateVirtualMachineResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "migrateVirtualMachine", "migratevirtualmachineresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -2503,9 +4330,9 @@ func (s *VirtualMachineService) MigrateVirtualMachine(p *MigrateVirtualMachinePa
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -2539,43 +4366,43 @@ type MigrateVirtualMachineResponse struct {
 		Type              string   `json:"type"`
 		VirtualmachineIds []string `json:"virtualmachineIds"`
 	} `json:"affinitygroup"`
-	Cpunumber             int               `json:"cpunumber"`
-	Cpuspeed              int               `json:"cpuspeed"`
-	Cpuused               string            `json:"cpuused"`
-	Created               string            `json:"created"`
-	Details               map[string]string `json:"details"`
-	Diskioread            int64             `json:"diskioread"`
-	Diskiowrite           int64             `json:"diskiowrite"`
-	Diskkbsread           int64             `json:"diskkbsread"`
-	Diskkbswrite          int64             `json:"diskkbswrite"`
-	Diskofferingid        string            `json:"diskofferingid"`
-	Diskofferingname      string            `json:"diskofferingname"`
-	Displayname           string            `json:"displayname"`
-	Displayvm             bool              `json:"displayvm"`
-	Domain                string            `json:"domain"`
-	Domainid              string            `json:"domainid"`
-	Forvirtualnetwork     bool              `json:"forvirtualnetwork"`
-	Group                 string            `json:"group"`
-	Groupid               string            `json:"groupid"`
-	Guestosid             string            `json:"guestosid"`
-	Haenable              bool              `json:"haenable"`
-	Hostid                string            `json:"hostid"`
-	Hostname              string            `json:"hostname"`
-	Hypervisor            string            `json:"hypervisor"`
-	Id                    string            `json:"id"`
-	Instancename          string            `json:"instancename"`
-	Isdynamicallyscalable bool              `json:"isdynamicallyscalable"`
-	Isodisplaytext        string            `json:"isodisplaytext"`
-	Isoid                 string            `json:"isoid"`
-	Isoname               string            `json:"isoname"`
-	Keypair               string            `json:"keypair"`
-	Memory                int               `json:"memory"`
-	Memoryintfreekbs      int64             `json:"memoryintfreekbs"`
-	Memorykbs             int64             `json:"memorykbs"`
-	Memorytargetkbs       int64             `json:"memorytargetkbs"`
-	Name                  string            `json:"name"`
-	Networkkbsread        int64             `json:"networkkbsread"`
-	Networkkbswrite       int64             `json:"networkkbswrite"`
+	Cpunumber             int       `json:"cpunumber"`
+	Cpuspeed              int       `json:"cpuspeed"`
+	Cpuused               string    `json:"cpuused"`
+	Created               string    `json:"created"`
+	Details               StringMap `json:"details"`
+	Diskioread            int64     `json:"diskioread"`
+	Diskiowrite           int64     `json:"diskiowrite"`
+	Diskkbsread           int64     `json:"diskkbsread"`
+	Diskkbswrite          int64     `json:"diskkbswrite"`
+	Diskofferingid        string    `json:"diskofferingid"`
+	Diskofferingname      string    `json:"diskofferingname"`
+	Displayname           string    `json:"displayname"`
+	Displayvm             bool      `json:"displayvm"`
+	Domain                string    `json:"domain"`
+	Domainid              string    `json:"domainid"`
+	Forvirtualnetwork     bool      `json:"forvirtualnetwork"`
+	Group                 string    `json:"group"`
+	Groupid               string    `json:"groupid"`
+	Guestosid             string    `json:"guestosid"`
+	Haenable              bool      `json:"haenable"`
+	Hostid                string    `json:"hostid"`
+	Hostname              string    `json:"hostname"`
+	Hypervisor            string    `json:"hypervisor"`
+	Id                    string    `json:"id"`
+	Instancename          string    `json:"instancename"`
+	Isdynamicallyscalable bool      `json:"isdynamicallyscalable"`
+	Isodisplaytext        string    `json:"isodisplaytext"`
+	Isoid                 string    `json:"isoid"`
+	Isoname               string    `json:"isoname"`
+	Keypair               string    `json:"keypair"`
+	Memory                int       `json:"memory"`
+	Memoryintfreekbs      int64     `json:"memoryintfreekbs"`
+	Memorykbs             int64     `json:"memorykbs"`
+	Memorytargetkbs       int64     `json:"memorytargetkbs"`
+	Name                  string    `json:"name"`
+	Networkkbsread        int64     `json:"networkkbsread"`
+	Networkkbswrite       int64     `json:"networkkbswrite"`
 	Nic                   []struct {
 		Broadcasturi         string `json:"broadcasturi"`
 		Deviceid             string `json:"deviceid"`
@@ -2698,6 +4525,16 @@ type MigrateVirtualMachineWithVolumeParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *MigrateVirtualMachineWithVolumeParams) Clone() *MigrateVirtualMachineWithVolumeParams {
+	c := &MigrateVirtualMachineWithVolumeParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *MigrateVirtualMachineWithVolumeParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -2707,11 +4544,10 @@ func (p *MigrateVirtualMachineWithVolumeParams) toURLValues() url.Values {
 		u.Set("hostid", v.(string))
 	}
 	if v, found := p.p["migrateto"]; found {
-		i := 0
-		for k, vv := range v.(map[string]string) {
+		m := v.(map[string]string)
+		for i, k := range getSortedKeysFromMap(m) {
 			u.Set(fmt.Sprintf("migrateto[%d].key", i), k)
-			u.Set(fmt.Sprintf("migrateto[%d].value", i), vv)
-			i++
+			u.Set(fmt.Sprintf("migrateto[%d].value", i), m[k])
 		}
 	}
 	if v, found := p.p["virtualmachineid"]; found {
@@ -2720,6 +4556,16 @@ func (p *MigrateVirtualMachineWithVolumeParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *MigrateVirtualMachineWithVolumeParams) validate() error {
+	for _, k := range []string{"hostid", "virtualmachineid"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of migrateVirtualMachineWithVolume", k)
+		}
+	}
+	return nil
+}
+
 func (p *MigrateVirtualMachineWithVolumeParams) SetHostid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2728,6 +4574,13 @@ func (p *MigrateVirtualMachineWithVolumeParams) SetHostid(v string) {
 	return
 }
 
+// SetHostidIfUnset sets hostid to v, unless it is already set
+func (p *MigrateVirtualMachineWithVolumeParams) SetHostidIfUnset(v string) {
+	if _, found := p.p["hostid"]; !found {
+		p.SetHostid(v)
+	}
+}
+
 func (p *MigrateVirtualMachineWithVolumeParams) SetMigrateto(v map[string]string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2736,6 +4589,13 @@ func (p *MigrateVirtualMachineWithVolumeParams) SetMigrateto(v map[string]string
 	return
 }
 
+// SetMigratetoIfUnset sets migrateto to v, unless it is already set
+func (p *MigrateVirtualMachineWithVolumeParams) SetMigratetoIfUnset(v map[string]string) {
+	if _, found := p.p["migrateto"]; !found {
+		p.SetMigrateto(v)
+	}
+}
+
 func (p *MigrateVirtualMachineWithVolumeParams) SetVirtualmachineid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2744,19 +4604,55 @@ func (p *MigrateVirtualMachineWithVolumeParams) SetVirtualmachineid(v string) {
 	return
 }
 
+// SetVirtualmachineidIfUnset sets virtualmachineid to v, unless it is already set
+func (p *MigrateVirtualMachineWithVolumeParams) SetVirtualmachineidIfUnset(v string) {
+	if _, found := p.p["virtualmachineid"]; !found {
+		p.SetVirtualmachineid(v)
+	}
+}
+
 // You should always use this function to get a new MigrateVirtualMachineWithVolumeParams instance,
-// as then you are sure you have configured all required params
-func (s *VirtualMachineService) NewMigrateVirtualMachineWithVolumeParams(hostid string, virtualmachineid string) *MigrateVirtualMachineWithVolumeParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *VirtualMachineService) NewMigrateVirtualMachineWithVolumeParams(hostid string, virtualmachineid string, opts ...func(*MigrateVirtualMachineWithVolumeParams)) *MigrateVirtualMachineWithVolumeParams {
 	p := &MigrateVirtualMachineWithVolumeParams{}
 	p.p = make(map[string]interface{})
 	p.p["hostid"] = hostid
 	p.p["virtualmachineid"] = virtualmachineid
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Attempts Migration of a VM with its volumes to a different host
-func (s *VirtualMachineService) MigrateVirtualMachineWithVolume(p *MigrateVirtualMachineWithVolumeParams) (*MigrateVirtualMachineWithVolumeResponse, error) {
-	resp, err := s.cs.newRequest("migrateVirtualMachineWithVolume", p.toURLValues())
+func (s *VirtualMachineService) MigrateVirtualMachineWithVolume(p *MigrateVirtualMachineWithVolumeParams, opts ...OptionFunc) (*MigrateVirtualMachineWithVolumeResponse, error) {
+	return s.MigrateVirtualMachineWithVolumeWithContext(context.Background(), p, opts...)
+}
+
+// MigrateVirtualMachineWithVolumeWithContext is the same as MigrateVirtualMachineWithVolume, but the request is cancelled when the context is done
+func (s *VirtualMachineService) MigrateVirtualMachineWithVolumeWithContext(ctx context.Context, p *MigrateVirtualMachineWithVolumeParams, opts ...OptionFunc) (*MigrateVirtualMachineWithVolumeResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.MigrateVirtualMachineWithVolumeRawWithContext(ctx, p.toURLValues())
+}
+
+// MigrateVirtualMachineWithVolumeRaw is the same as MigrateVirtualMachineWithVolume, but takes the params as url.Values instead of typed params
+func (s *VirtualMachineService) MigrateVirtualMachineWithVolumeRaw(v url.Values) (*MigrateVirtualMachineWithVolumeResponse, error) {
+	return s.MigrateVirtualMachineWithVolumeRawWithContext(context.Background(), v)
+}
+
+// MigrateVirtualMachineWithVolumeRawWithContext is the same as MigrateVirtualMachineWithVolumeRaw, but the request is cancelled when the context is done
+func (s *VirtualMachineService) MigrateVirtualMachineWithVolumeRawWithContext(ctx context.Context, v url.Values) (*MigrateVirtualMachineWithVolumeResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "migrateVirtualMachineWithVolume", "migratevirtualmachinewithvolumeresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -2768,9 +4664,9 @@ func (s *VirtualMachineService) MigrateVirtualMachineWithVolume(p *MigrateVirtua
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -2804,43 +4700,43 @@ type MigrateVirtualMachineWithVolumeResponse struct {
 		Type              string   `json:"type"`
 		VirtualmachineIds []string `json:"virtualmachineIds"`
 	} `json:"affinitygroup"`
-	Cpunumber             int               `json:"cpunumber"`
-	Cpuspeed              int               `json:"cpuspeed"`
-	Cpuused               string            `json:"cpuused"`
-	Created               string            `json:"created"`
-	Details               map[string]string `json:"details"`
-	Diskioread            int64             `json:"diskioread"`
-	Diskiowrite           int64             `json:"diskiowrite"`
-	Diskkbsread           int64             `json:"diskkbsread"`
-	Diskkbswrite          int64             `json:"diskkbswrite"`
-	Diskofferingid        string            `json:"diskofferingid"`
-	Diskofferingname      string            `json:"diskofferingname"`
-	Displayname           string            `json:"displayname"`
-	Displayvm             bool              `json:"displayvm"`
-	Domain                string            `json:"domain"`
-	Domainid              string            `json:"domainid"`
-	Forvirtualnetwork     bool              `json:"forvirtualnetwork"`
-	Group                 string            `json:"group"`
-	Groupid               string            `json:"groupid"`
-	Guestosid             string            `json:"guestosid"`
-	Haenable              bool              `json:"haenable"`
-	Hostid                string            `json:"hostid"`
-	Hostname              string            `json:"hostname"`
-	Hypervisor            string            `json:"hypervisor"`
-	Id                    string            `json:"id"`
-	Instancename          string            `json:"instancename"`
-	Isdynamicallyscalable bool              `json:"isdynamicallyscalable"`
-	Isodisplaytext        string            `json:"isodisplaytext"`
-	Isoid                 string            `json:"isoid"`
-	Isoname               string            `json:"isoname"`
-	Keypair               string            `json:"keypair"`
-	Memory                int               `json:"memory"`
-	Memoryintfreekbs      int64             `json:"memoryintfreekbs"`
-	Memorykbs             int64             `json:"memorykbs"`
-	Memorytargetkbs       int64             `json:"memorytargetkbs"`
-	Name                  string            `json:"name"`
-	Networkkbsread        int64             `json:"networkkbsread"`
-	Networkkbswrite       int64             `json:"networkkbswrite"`
+	Cpunumber             int       `json:"cpunumber"`
+	Cpuspeed              int       `json:"cpuspeed"`
+	Cpuused               string    `json:"cpuused"`
+	Created               string    `json:"created"`
+	Details               StringMap `json:"details"`
+	Diskioread            int64     `json:"diskioread"`
+	Diskiowrite           int64     `json:"diskiowrite"`
+	Diskkbsread           int64     `json:"diskkbsread"`
+	Diskkbswrite          int64     `json:"diskkbswrite"`
+	Diskofferingid        string    `json:"diskofferingid"`
+	Diskofferingname      string    `json:"diskofferingname"`
+	Displayname           string    `json:"displayname"`
+	Displayvm             bool      `json:"displayvm"`
+	Domain                string    `json:"domain"`
+	Domainid              string    `json:"domainid"`
+	Forvirtualnetwork     bool      `json:"forvirtualnetwork"`
+	Group                 string    `json:"group"`
+	Groupid               string    `json:"groupid"`
+	Guestosid             string    `json:"guestosid"`
+	Haenable              bool      `json:"haenable"`
+	Hostid                string    `json:"hostid"`
+	Hostname              string    `json:"hostname"`
+	Hypervisor            string    `json:"hypervisor"`
+	Id                    string    `json:"id"`
+	Instancename          string    `json:"instancename"`
+	Isdynamicallyscalable bool      `json:"isdynamicallyscalable"`
+	Isodisplaytext        string    `json:"isodisplaytext"`
+	Isoid                 string    `json:"isoid"`
+	Isoname               string    `json:"isoname"`
+	Keypair               string    `json:"keypair"`
+	Memory                int       `json:"memory"`
+	Memoryintfreekbs      int64     `json:"memoryintfreekbs"`
+	Memorykbs             int64     `json:"memorykbs"`
+	Memorytargetkbs       int64     `json:"memorytargetkbs"`
+	Name                  string    `json:"name"`
+	Networkkbsread        int64     `json:"networkkbsread"`
+	Networkkbswrite       int64     `json:"networkkbswrite"`
 	Nic                   []struct {
 		Broadcasturi         string `json:"broadcasturi"`
 		Deviceid             string `json:"deviceid"`
@@ -2963,6 +4859,16 @@ type RebootVirtualMachineParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *RebootVirtualMachineParams) Clone() *RebootVirtualMachineParams {
+	c := &RebootVirtualMachineParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *RebootVirtualMachineParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -2974,6 +4880,16 @@ func (p *RebootVirtualMachineParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *RebootVirtualMachineParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of rebootVirtualMachine", k)
+		}
+	}
+	return nil
+}
+
 func (p *RebootVirtualMachineParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2982,18 +4898,54 @@ func (p *RebootVirtualMachineParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *RebootVirtualMachineParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 // You should always use this function to get a new RebootVirtualMachineParams instance,
-// as then you are sure you have configured all required params
-func (s *VirtualMachineService) NewRebootVirtualMachineParams(id string) *RebootVirtualMachineParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *VirtualMachineService) NewRebootVirtualMachineParams(id string, opts ...func(*RebootVirtualMachineParams)) *RebootVirtualMachineParams {
 	p := &RebootVirtualMachineParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Reboots a virtual machine.
-func (s *VirtualMachineService) RebootVirtualMachine(p *RebootVirtualMachineParams) (*RebootVirtualMachineResponse, error) {
-	resp, err := s.cs.newRequest("rebootVirtualMachine", p.toURLValues())
+func (s *VirtualMachineService) RebootVirtualMachine(p *RebootVirtualMachineParams, opts ...OptionFunc) (*RebootVirtualMachineResponse, error) {
+	return s.RebootVirtualMachineWithContext(context.Background(), p, opts...)
+}
+
+// RebootVirtualMachineWithContext is the same as RebootVirtualMachine, but the request is cancelled when the context is done
+func (s *VirtualMachineService) RebootVirtualMachineWithContext(ctx context.Context, p *RebootVirtualMachineParams, opts ...OptionFunc) (*RebootVirtualMachineResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.RebootVirtualMachineRawWithContext(ctx, p.toURLValues())
+}
+
+// RebootVirtualMachineRaw is the same as RebootVirtualMachine, but takes the params as url.Values instead of typed params
+func (s *VirtualMachineService) RebootVirtualMachineRaw(v url.Values) (*RebootVirtualMachineResponse, error) {
+	return s.RebootVirtualMachineRawWithContext(context.Background(), v)
+}
+
+// RebootVirtualMachineRawWithContext is the same as RebootVirtualMachineRaw, but the request is cancelled when the context is done
+func (s *VirtualMachineService) RebootVirtualMachineRawWithContext(ctx context.Context, v url.Values) (*RebootVirtualMachineResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "rebootVirtualMachine", "rebootvirtualmachineresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -3005,9 +4957,9 @@ func (s *VirtualMachineService) RebootVirtualMachine(p *RebootVirtualMachinePara
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -3041,43 +4993,43 @@ type RebootVirtualMachineResponse struct {
 		Type              string   `json:"type"`
 		VirtualmachineIds []string `json:"virtualmachineIds"`
 	} `json:"affinitygroup"`
-	Cpunumber             int               `json:"cpunumber"`
-	Cpuspeed              int               `json:"cpuspeed"`
-	Cpuused               string            `json:"cpuused"`
-	Created               string            `json:"created"`
-	Details               map[string]string `json:"details"`
-	Diskioread            int64             `json:"diskioread"`
-	Diskiowrite           int64             `json:"diskiowrite"`
-	Diskkbsread           int64             `json:"diskkbsread"`
-	Diskkbswrite          int64             `json:"diskkbswrite"`
-	Diskofferingid        string            `json:"diskofferingid"`
-	Diskofferingname      string            `json:"diskofferingname"`
-	Displayname           string            `json:"displayname"`
-	Displayvm             bool              `json:"displayvm"`
-	Domain                string            `json:"domain"`
-	Domainid              string            `json:"domainid"`
-	Forvirtualnetwork     bool              `json:"forvirtualnetwork"`
-	Group                 string            `json:"group"`
-	Groupid               string            `json:"groupid"`
-	Guestosid             string            `json:"guestosid"`
-	Haenable              bool              `json:"haenable"`
-	Hostid                string            `json:"hostid"`
-	Hostname              string            `json:"hostname"`
-	Hypervisor            string            `json:"hypervisor"`
-	Id                    string            `json:"id"`
-	Instancename          string            `json:"instancename"`
-	Isdynamicallyscalable bool              `json:"isdynamicallyscalable"`
-	Isodisplaytext        string            `json:"isodisplaytext"`
-	Isoid                 string            `json:"isoid"`
-	Isoname               string            `json:"isoname"`
-	Keypair               string            `json:"keypair"`
-	Memory                int               `json:"memory"`
-	Memoryintfreekbs      int64             `json:"memoryintfreekbs"`
-	Memorykbs             int64             `json:"memorykbs"`
-	Memorytargetkbs       int64             `json:"memorytargetkbs"`
-	Name                  string            `json:"name"`
-	Networkkbsread        int64             `json:"networkkbsread"`
-	Networkkbswrite       int64             `json:"networkkbswrite"`
+	Cpunumber             int       `json:"cpunumber"`
+	Cpuspeed              int       `json:"cpuspeed"`
+	Cpuused               string    `json:"cpuused"`
+	Created               string    `json:"created"`
+	Details               StringMap `json:"details"`
+	Diskioread            int64     `json:"diskioread"`
+	Diskiowrite           int64     `json:"diskiowrite"`
+	Diskkbsread           int64     `json:"diskkbsread"`
+	Diskkbswrite          int64     `json:"diskkbswrite"`
+	Diskofferingid        string    `json:"diskofferingid"`
+	Diskofferingname      string    `json:"diskofferingname"`
+	Displayname           string    `json:"displayname"`
+	Displayvm             bool      `json:"displayvm"`
+	Domain                string    `json:"domain"`
+	Domainid              string    `json:"domainid"`
+	Forvirtualnetwork     bool      `json:"forvirtualnetwork"`
+	Group                 string    `json:"group"`
+	Groupid               string    `json:"groupid"`
+	Guestosid             string    `json:"guestosid"`
+	Haenable              bool      `json:"haenable"`
+	Hostid                string    `json:"hostid"`
+	Hostname              string    `json:"hostname"`
+	Hypervisor            string    `json:"hypervisor"`
+	Id                    string    `json:"id"`
+	Instancename          string    `json:"instancename"`
+	Isdynamicallyscalable bool      `json:"isdynamicallyscalable"`
+	Isodisplaytext        string    `json:"isodisplaytext"`
+	Isoid                 string    `json:"isoid"`
+	Isoname               string    `json:"isoname"`
+	Keypair               string    `json:"keypair"`
+	Memory                int       `json:"memory"`
+	Memoryintfreekbs      int64     `json:"memoryintfreekbs"`
+	Memorykbs             int64     `json:"memorykbs"`
+	Memorytargetkbs       int64     `json:"memorytargetkbs"`
+	Name                  string    `json:"name"`
+	Networkkbsread        int64     `json:"networkkbsread"`
+	Networkkbswrite       int64     `json:"networkkbswrite"`
 	Nic                   []struct {
 		Broadcasturi         string `json:"broadcasturi"`
 		Deviceid             string `json:"deviceid"`
@@ -3200,6 +5152,16 @@ type RecoverVirtualMachineParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *RecoverVirtualMachineParams) Clone() *RecoverVirtualMachineParams {
+	c := &RecoverVirtualMachineParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *RecoverVirtualMachineParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -3211,6 +5173,16 @@ func (p *RecoverVirtualMachineParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *RecoverVirtualMachineParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of recoverVirtualMachine", k)
+		}
+	}
+	return nil
+}
+
 func (p *RecoverVirtualMachineParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3219,18 +5191,54 @@ func (p *RecoverVirtualMachineParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *RecoverVirtualMachineParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 // You should always use this function to get a new RecoverVirtualMachineParams instance,
-// as then you are sure you have configured all required params
-func (s *VirtualMachineService) NewRecoverVirtualMachineParams(id string) *RecoverVirtualMachineParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *VirtualMachineService) NewRecoverVirtualMachineParams(id string, opts ...func(*RecoverVirtualMachineParams)) *RecoverVirtualMachineParams {
 	p := &RecoverVirtualMachineParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Recovers a virtual machine.
-func (s *VirtualMachineService) RecoverVirtualMachine(p *RecoverVirtualMachineParams) (*RecoverVirtualMachineResponse, error) {
-	resp, err := s.cs.newRequest("recoverVirtualMachine", p.toURLValues())
+func (s *VirtualMachineService) RecoverVirtualMachine(p *RecoverVirtualMachineParams, opts ...OptionFunc) (*RecoverVirtualMachineResponse, error) {
+	return s.RecoverVirtualMachineWithContext(context.Background(), p, opts...)
+}
+
+// RecoverVirtualMachineWithContext is the same as RecoverVirtualMachine, but the request is cancelled when the context is done
+func (s *VirtualMachineService) RecoverVirtualMachineWithContext(ctx context.Context, p *RecoverVirtualMachineParams, opts ...OptionFunc) (*RecoverVirtualMachineResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.RecoverVirtualMachineRawWithContext(ctx, p.toURLValues())
+}
+
+// RecoverVirtualMachineRaw is the same as RecoverVirtualMachine, but takes the params as url.Values instead of typed params
+func (s *VirtualMachineService) RecoverVirtualMachineRaw(v url.Values) (*RecoverVirtualMachineResponse, error) {
+	return s.RecoverVirtualMachineRawWithContext(context.Background(), v)
+}
+
+// RecoverVirtualMachineRawWithContext is the same as RecoverVirtualMachineRaw, but the request is cancelled when the context is done
+func (s *VirtualMachineService) RecoverVirtualMachineRawWithContext(ctx context.Context, v url.Values) (*RecoverVirtualMachineResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "recoverVirtualMachine", "recovervirtualmachineresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -3257,43 +5265,43 @@ type RecoverVirtualMachineResponse struct {
 		Type              string   `json:"type"`
 		VirtualmachineIds []string `json:"virtualmachineIds"`
 	} `json:"affinitygroup"`
-	Cpunumber             int               `json:"cpunumber"`
-	Cpuspeed              int               `json:"cpuspeed"`
-	Cpuused               string            `json:"cpuused"`
-	Created               string            `json:"created"`
-	Details               map[string]string `json:"details"`
-	Diskioread            int64             `json:"diskioread"`
-	Diskiowrite           int64             `json:"diskiowrite"`
-	Diskkbsread           int64             `json:"diskkbsread"`
-	Diskkbswrite          int64             `json:"diskkbswrite"`
-	Diskofferingid        string            `json:"diskofferingid"`
-	Diskofferingname      string            `json:"diskofferingname"`
-	Displayname           string            `json:"displayname"`
-	Displayvm             bool              `json:"displayvm"`
-	Domain                string            `json:"domain"`
-	Domainid              string            `json:"domainid"`
-	Forvirtualnetwork     bool              `json:"forvirtualnetwork"`
-	Group                 string            `json:"group"`
-	Groupid               string            `json:"groupid"`
-	Guestosid             string            `json:"guestosid"`
-	Haenable              bool              `json:"haenable"`
-	Hostid                string            `json:"hostid"`
-	Hostname              string            `json:"hostname"`
-	Hypervisor            string            `json:"hypervisor"`
-	Id                    string            `json:"id"`
-	Instancename          string            `json:"instancename"`
-	Isdynamicallyscalable bool              `json:"isdynamicallyscalable"`
-	Isodisplaytext        string            `json:"isodisplaytext"`
-	Isoid                 string            `json:"isoid"`
-	Isoname               string            `json:"isoname"`
-	Keypair               string            `json:"keypair"`
-	Memory                int               `json:"memory"`
-	Memoryintfreekbs      int64             `json:"memoryintfreekbs"`
-	Memorykbs             int64             `json:"memorykbs"`
-	Memorytargetkbs       int64             `json:"memorytargetkbs"`
-	Name                  string            `json:"name"`
-	Networkkbsread        int64             `json:"networkkbsread"`
-	Networkkbswrite       int64             `json:"networkkbswrite"`
+	Cpunumber             int       `json:"cpunumber"`
+	Cpuspeed              int       `json:"cpuspeed"`
+	Cpuused               string    `json:"cpuused"`
+	Created               string    `json:"created"`
+	Details               StringMap `json:"details"`
+	Diskioread            int64     `json:"diskioread"`
+	Diskiowrite           int64     `json:"diskiowrite"`
+	Diskkbsread           int64     `json:"diskkbsread"`
+	Diskkbswrite          int64     `json:"diskkbswrite"`
+	Diskofferingid        string    `json:"diskofferingid"`
+	Diskofferingname      string    `json:"diskofferingname"`
+	Displayname           string    `json:"displayname"`
+	Displayvm             bool      `json:"displayvm"`
+	Domain                string    `json:"domain"`
+	Domainid              string    `json:"domainid"`
+	Forvirtualnetwork     bool      `json:"forvirtualnetwork"`
+	Group                 string    `json:"group"`
+	Groupid               string    `json:"groupid"`
+	Guestosid             string    `json:"guestosid"`
+	Haenable              bool      `json:"haenable"`
+	Hostid                string    `json:"hostid"`
+	Hostname              string    `json:"hostname"`
+	Hypervisor            string    `json:"hypervisor"`
+	Id                    string    `json:"id"`
+	Instancename          string    `json:"instancename"`
+	Isdynamicallyscalable bool      `json:"isdynamicallyscalable"`
+	Isodisplaytext        string    `json:"isodisplaytext"`
+	Isoid                 string    `json:"isoid"`
+	Isoname               string    `json:"isoname"`
+	Keypair               string    `json:"keypair"`
+	Memory                int       `json:"memory"`
+	Memoryintfreekbs      int64     `json:"memoryintfreekbs"`
+	Memorykbs             int64     `json:"memorykbs"`
+	Memorytargetkbs       int64     `json:"memorytargetkbs"`
+	Name                  string    `json:"name"`
+	Networkkbsread        int64     `json:"networkkbsread"`
+	Networkkbswrite       int64     `json:"networkkbswrite"`
 	Nic                   []struct {
 		Broadcasturi         string `json:"broadcasturi"`
 		Deviceid             string `json:"deviceid"`
@@ -3416,6 +5424,16 @@ type RemoveNicFromVirtualMachineParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *RemoveNicFromVirtualMachineParams) Clone() *RemoveNicFromVirtualMachineParams {
+	c := &RemoveNicFromVirtualMachineParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *RemoveNicFromVirtualMachineParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -3430,6 +5448,16 @@ func (p *RemoveNicFromVirtualMachineParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *RemoveNicFromVirtualMachineParams) validate() error {
+	for _, k := range []string{"nicid", "virtualmachineid"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of removeNicFromVirtualMachine", k)
+		}
+	}
+	return nil
+}
+
 func (p *RemoveNicFromVirtualMachineParams) SetNicid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3438,27 +5466,70 @@ func (p *RemoveNicFromVirtualMachineParams) SetNicid(v string) {
 	return
 }
 
-func (p *RemoveNicFromVirtualMachineParams) SetVirtualmachineid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// SetNicidIfUnset sets nicid to v, unless it is already set
+func (p *RemoveNicFromVirtualMachineParams) SetNicidIfUnset(v string) {
+	if _, found := p.p["nicid"]; !found {
+		p.SetNicid(v)
+	}
+}
+
+func (p *RemoveNicFromVirtualMachineParams) SetVirtualmachineid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["virtualmachineid"] = v
+	return
+}
+
+// SetVirtualmachineidIfUnset sets virtualmachineid to v, unless it is already set
+func (p *RemoveNicFromVirtualMachineParams) SetVirtualmachineidIfUnset(v string) {
+	if _, found := p.p["virtualmachineid"]; !found {
+		p.SetVirtualmachineid(v)
+	}
+}
+
+// You should always use this function to get a new RemoveNicFromVirtualMachineParams instance,
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *VirtualMachineService) NewRemoveNicFromVirtualMachineParams(nicid string, virtualmachineid string, opts ...func(*RemoveNicFromVirtualMachineParams)) *RemoveNicFromVirtualMachineParams {
+	p := &RemoveNicFromVirtualMachineParams{}
+	p.p = make(map[string]interface{})
+	p.p["nicid"] = nicid
+	p.p["virtualmachineid"] = virtualmachineid
+	for _, fn := range opts {
+		fn(p)
+	}
+	return p
+}
+
+// Removes VM from specified network by deleting a NIC
+func (s *VirtualMachineService) RemoveNicFromVirtualMachine(p *RemoveNicFromVirtualMachineParams, opts ...OptionFunc) (*RemoveNicFromVirtualMachineResponse, error) {
+	return s.RemoveNicFromVirtualMachineWithContext(context.Background(), p, opts...)
+}
+
+// RemoveNicFromVirtualMachineWithContext is the same as RemoveNicFromVirtualMachine, but the request is cancelled when the context is done
+func (s *VirtualMachineService) RemoveNicFromVirtualMachineWithContext(ctx context.Context, p *RemoveNicFromVirtualMachineParams, opts ...OptionFunc) (*RemoveNicFromVirtualMachineResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
 	}
-	p.p["virtualmachineid"] = v
-	return
+
+	return s.RemoveNicFromVirtualMachineRawWithContext(ctx, p.toURLValues())
 }
 
-// You should always use this function to get a new RemoveNicFromVirtualMachineParams instance,
-// as then you are sure you have configured all required params
-func (s *VirtualMachineService) NewRemoveNicFromVirtualMachineParams(nicid string, virtualmachineid string) *RemoveNicFromVirtualMachineParams {
-	p := &RemoveNicFromVirtualMachineParams{}
-	p.p = make(map[string]interface{})
-	p.p["nicid"] = nicid
-	p.p["virtualmachineid"] = virtualmachineid
-	return p
+// RemoveNicFromVirtualMachineRaw is the same as RemoveNicFromVirtualMachine, but takes the params as url.Values instead of typed params
+func (s *VirtualMachineService) RemoveNicFromVirtualMachineRaw(v url.Values) (*RemoveNicFromVirtualMachineResponse, error) {
+	return s.RemoveNicFromVirtualMachineRawWithContext(context.Background(), v)
 }
 
-// Removes VM from specified network by deleting a NIC
-func (s *VirtualMachineService) RemoveNicFromVirtualMachine(p *RemoveNicFromVirtualMachineParams) (*RemoveNicFromVirtualMachineResponse, error) {
-	resp, err := s.cs.newRequest("removeNicFromVirtualMachine", p.toURLValues())
+// RemoveNicFromVirtualMachineRawWithContext is the same as RemoveNicFromVirtualMachineRaw, but the request is cancelled when the context is done
+func (s *VirtualMachineService) RemoveNicFromVirtualMachineRawWithContext(ctx context.Context, v url.Values) (*RemoveNicFromVirtualMachineResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "removeNicFromVirtualMachine", "removenicfromvirtualmachineresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -3470,9 +5541,9 @@ func (s *VirtualMachineService) RemoveNicFromVirtualMachine(p *RemoveNicFromVirt
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -3506,43 +5577,43 @@ type RemoveNicFromVirtualMachineResponse struct {
 		Type              string   `json:"type"`
 		VirtualmachineIds []string `json:"virtualmachineIds"`
 	} `json:"affinitygroup"`
-	Cpunumber             int               `json:"cpunumber"`
-	Cpuspeed              int               `json:"cpuspeed"`
-	Cpuused               string            `json:"cpuused"`
-	Created               string            `json:"created"`
-	Details               map[string]string `json:"details"`
-	Diskioread            int64             `json:"diskioread"`
-	Diskiowrite           int64             `json:"diskiowrite"`
-	Diskkbsread           int64             `json:"diskkbsread"`
-	Diskkbswrite          int64             `json:"diskkbswrite"`
-	Diskofferingid        string            `json:"diskofferingid"`
-	Diskofferingname      string            `json:"diskofferingname"`
-	Displayname           string            `json:"displayname"`
-	Displayvm             bool              `json:"displayvm"`
-	Domain                string            `json:"domain"`
-	Domainid              string            `json:"domainid"`
-	Forvirtualnetwork     bool              `json:"forvirtualnetwork"`
-	Group                 string            `json:"group"`
-	Groupid               string            `json:"groupid"`
-	Guestosid             string            `json:"guestosid"`
-	Haenable              bool              `json:"haenable"`
-	Hostid                string            `json:"hostid"`
-	Hostname              string            `json:"hostname"`
-	Hypervisor            string            `json:"hypervisor"`
-	Id                    string            `json:"id"`
-	Instancename          string            `json:"instancename"`
-	Isdynamicallyscalable bool              `json:"isdynamicallyscalable"`
-	Isodisplaytext        string            `json:"isodisplaytext"`
-	Isoid                 string            `json:"isoid"`
-	Isoname               string            `json:"isoname"`
-	Keypair               string            `json:"keypair"`
-	Memory                int               `json:"memory"`
-	Memoryintfreekbs      int64             `json:"memoryintfreekbs"`
-	Memorykbs             int64             `json:"memorykbs"`
-	Memorytargetkbs       int64             `json:"memorytargetkbs"`
-	Name                  string            `json:"name"`
-	Networkkbsread        int64             `json:"networkkbsread"`
-	Networkkbswrite       int64             `json:"networkkbswrite"`
+	Cpunumber             int       `json:"cpunumber"`
+	Cpuspeed              int       `json:"cpuspeed"`
+	Cpuused               string    `json:"cpuused"`
+	Created               string    `json:"created"`
+	Details               StringMap `json:"details"`
+	Diskioread            int64     `json:"diskioread"`
+	Diskiowrite           int64     `json:"diskiowrite"`
+	Diskkbsread           int64     `json:"diskkbsread"`
+	Diskkbswrite          int64     `json:"diskkbswrite"`
+	Diskofferingid        string    `json:"diskofferingid"`
+	Diskofferingname      string    `json:"diskofferingname"`
+	Displayname           string    `json:"displayname"`
+	Displayvm             bool      `json:"displayvm"`
+	Domain                string    `json:"domain"`
+	Domainid              string    `json:"domainid"`
+	Forvirtualnetwork     bool      `json:"forvirtualnetwork"`
+	Group                 string    `json:"group"`
+	Groupid               string    `json:"groupid"`
+	Guestosid             string    `json:"guestosid"`
+	Haenable              bool      `json:"haenable"`
+	Hostid                string    `json:"hostid"`
+	Hostname              string    `json:"hostname"`
+	Hypervisor            string    `json:"hypervisor"`
+	Id                    string    `json:"id"`
+	Instancename          string    `json:"instancename"`
+	Isdynamicallyscalable bool      `json:"isdynamicallyscalable"`
+	Isodisplaytext        string    `json:"isodisplaytext"`
+	Isoid                 string    `json:"isoid"`
+	Isoname               string    `json:"isoname"`
+	Keypair               string    `json:"keypair"`
+	Memory                int       `json:"memory"`
+	Memoryintfreekbs      int64     `json:"memoryintfreekbs"`
+	Memorykbs             int64     `json:"memorykbs"`
+	Memorytargetkbs       int64     `json:"memorytargetkbs"`
+	Name                  string    `json:"name"`
+	Networkkbsread        int64     `json:"networkkbsread"`
+	Networkkbswrite       int64     `json:"networkkbswrite"`
 	Nic                   []struct {
 		Broadcasturi         string `json:"broadcasturi"`
 		Deviceid             string `json:"deviceid"`
@@ -3665,6 +5736,16 @@ type ResetPasswordForVirtualMachineParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ResetPasswordForVirtualMachineParams) Clone() *ResetPasswordForVirtualMachineParams {
+	c := &ResetPasswordForVirtualMachineParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ResetPasswordForVirtualMachineParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -3676,6 +5757,16 @@ func (p *ResetPasswordForVirtualMachineParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *ResetPasswordForVirtualMachineParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of resetPasswordForVirtualMachine", k)
+		}
+	}
+	return nil
+}
+
 func (p *ResetPasswordForVirtualMachineParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3684,18 +5775,54 @@ func (p *ResetPasswordForVirtualMachineParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *ResetPasswordForVirtualMachineParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 // You should always use this function to get a new ResetPasswordForVirtualMachineParams instance,
-// as then you are sure you have configured all required params
-func (s *VirtualMachineService) NewResetPasswordForVirtualMachineParams(id string) *ResetPasswordForVirtualMachineParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *VirtualMachineService) NewResetPasswordForVirtualMachineParams(id string, opts ...func(*ResetPasswordForVirtualMachineParams)) *ResetPasswordForVirtualMachineParams {
 	p := &ResetPasswordForVirtualMachineParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Resets the password for virtual machine. The virtual machine must be in a "Stopped" state and the template must already support this feature for this command to take effect. [async]
-func (s *VirtualMachineService) ResetPasswordForVirtualMachine(p *ResetPasswordForVirtualMachineParams) (*ResetPasswordForVirtualMachineResponse, error) {
-	resp, err := s.cs.newRequest("resetPasswordForVirtualMachine", p.toURLValues())
+func (s *VirtualMachineService) ResetPasswordForVirtualMachine(p *ResetPasswordForVirtualMachineParams, opts ...OptionFunc) (*ResetPasswordForVirtualMachineResponse, error) {
+	return s.ResetPasswordForVirtualMachineWithContext(context.Background(), p, opts...)
+}
+
+// ResetPasswordForVirtualMachineWithContext is the same as ResetPasswordForVirtualMachine, but the request is cancelled when the context is done
+func (s *VirtualMachineService) ResetPasswordForVirtualMachineWithContext(ctx context.Context, p *ResetPasswordForVirtualMachineParams, opts ...OptionFunc) (*ResetPasswordForVirtualMachineResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.ResetPasswordForVirtualMachineRawWithContext(ctx, p.toURLValues())
+}
+
+// ResetPasswordForVirtualMachineRaw is the same as ResetPasswordForVirtualMachine, but takes the params as url.Values instead of typed params
+func (s *VirtualMachineService) ResetPasswordForVirtualMachineRaw(v url.Values) (*ResetPasswordForVirtualMachineResponse, error) {
+	return s.ResetPasswordForVirtualMachineRawWithContext(context.Background(), v)
+}
+
+// ResetPasswordForVirtualMachineRawWithContext is the same as ResetPasswordForVirtualMachineRaw, but the request is cancelled when the context is done
+func (s *VirtualMachineService) ResetPasswordForVirtualMachineRawWithContext(ctx context.Context, v url.Values) (*ResetPasswordForVirtualMachineResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "resetPasswordForVirtualMachine", "resetpasswordforvirtualmachineresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -3707,9 +5834,9 @@ func (s *VirtualMachineService) ResetPasswordForVirtualMachine(p *ResetPasswordF
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -3743,43 +5870,43 @@ type ResetPasswordForVirtualMachineResponse struct {
 		Type              string   `json:"type"`
 		VirtualmachineIds []string `json:"virtualmachineIds"`
 	} `json:"affinitygroup"`
-	Cpunumber             int               `json:"cpunumber"`
-	Cpuspeed              int               `json:"cpuspeed"`
-	Cpuused               string            `json:"cpuused"`
-	Created               string            `json:"created"`
-	Details               map[string]string `json:"details"`
-	Diskioread            int64             `json:"diskioread"`
-	Diskiowrite           int64             `json:"diskiowrite"`
-	Diskkbsread           int64             `json:"diskkbsread"`
-	Diskkbswrite          int64             `json:"diskkbswrite"`
-	Diskofferingid        string            `json:"diskofferingid"`
-	Diskofferingname      string            `json:"diskofferingname"`
-	Displayname           string            `json:"displayname"`
-	Displayvm             bool              `json:"displayvm"`
-	Domain                string            `json:"domain"`
-	Domainid              string            `json:"domainid"`
-	Forvirtualnetwork     bool              `json:"forvirtualnetwork"`
-	Group                 string            `json:"group"`
-	Groupid               string            `json:"groupid"`
-	Guestosid             string            `json:"guestosid"`
-	Haenable              bool              `json:"haenable"`
-	Hostid                string            `json:"hostid"`
-	Hostname              string            `json:"hostname"`
-	Hypervisor            string            `json:"hypervisor"`
-	Id                    string            `json:"id"`
-	Instancename          string            `json:"instancename"`
-	Isdynamicallyscalable bool              `json:"isdynamicallyscalable"`
-	Isodisplaytext        string            `json:"isodisplaytext"`
-	Isoid                 string            `json:"isoid"`
-	Isoname               string            `json:"isoname"`
-	Keypair               string            `json:"keypair"`
-	Memory                int               `json:"memory"`
-	Memoryintfreekbs      int64             `json:"memoryintfreekbs"`
-	Memorykbs             int64             `json:"memorykbs"`
-	Memorytargetkbs       int64             `json:"memorytargetkbs"`
-	Name                  string            `json:"name"`
-	Networkkbsread        int64             `json:"networkkbsread"`
-	Networkkbswrite       int64             `json:"networkkbswrite"`
+	Cpunumber             int       `json:"cpunumber"`
+	Cpuspeed              int       `json:"cpuspeed"`
+	Cpuused               string    `json:"cpuused"`
+	Created               string    `json:"created"`
+	Details               StringMap `json:"details"`
+	Diskioread            int64     `json:"diskioread"`
+	Diskiowrite           int64     `json:"diskiowrite"`
+	Diskkbsread           int64     `json:"diskkbsread"`
+	Diskkbswrite          int64     `json:"diskkbswrite"`
+	Diskofferingid        string    `json:"diskofferingid"`
+	Diskofferingname      string    `json:"diskofferingname"`
+	Displayname           string    `json:"displayname"`
+	Displayvm             bool      `json:"displayvm"`
+	Domain                string    `json:"domain"`
+	Domainid              string    `json:"domainid"`
+	Forvirtualnetwork     bool      `json:"forvirtualnetwork"`
+	Group                 string    `json:"group"`
+	Groupid               string    `json:"groupid"`
+	Guestosid             string    `json:"guestosid"`
+	Haenable              bool      `json:"haenable"`
+	Hostid                string    `json:"hostid"`
+	Hostname              string    `json:"hostname"`
+	Hypervisor            string    `json:"hypervisor"`
+	Id                    string    `json:"id"`
+	Instancename          string    `json:"instancename"`
+	Isdynamicallyscalable bool      `json:"isdynamicallyscalable"`
+	Isodisplaytext        string    `json:"isodisplaytext"`
+	Isoid                 string    `json:"isoid"`
+	Isoname               string    `json:"isoname"`
+	Keypair               string    `json:"keypair"`
+	Memory                int       `json:"memory"`
+	Memoryintfreekbs      int64     `json:"memoryintfreekbs"`
+	Memorykbs             int64     `json:"memorykbs"`
+	Memorytargetkbs       int64     `json:"memorytargetkbs"`
+	Name                  string    `json:"name"`
+	Networkkbsread        int64     `json:"networkkbsread"`
+	Networkkbswrite       int64     `json:"networkkbswrite"`
 	Nic                   []struct {
 		Broadcasturi         string `json:"broadcasturi"`
 		Deviceid             string `json:"deviceid"`
@@ -3902,6 +6029,16 @@ type RestoreVirtualMachineParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *RestoreVirtualMachineParams) Clone() *RestoreVirtualMachineParams {
+	c := &RestoreVirtualMachineParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *RestoreVirtualMachineParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -3916,6 +6053,16 @@ func (p *RestoreVirtualMachineParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *RestoreVirtualMachineParams) validate() error {
+	for _, k := range []string{"virtualmachineid"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of restoreVirtualMachine", k)
+		}
+	}
+	return nil
+}
+
 func (p *RestoreVirtualMachineParams) SetTemplateid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3924,6 +6071,13 @@ func (p *RestoreVirtualMachineParams) SetTemplateid(v string) {
 	return
 }
 
+// SetTemplateidIfUnset sets templateid to v, unless it is already set
+func (p *RestoreVirtualMachineParams) SetTemplateidIfUnset(v string) {
+	if _, found := p.p["templateid"]; !found {
+		p.SetTemplateid(v)
+	}
+}
+
 func (p *RestoreVirtualMachineParams) SetVirtualmachineid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3932,18 +6086,54 @@ func (p *RestoreVirtualMachineParams) SetVirtualmachineid(v string) {
 	return
 }
 
+// SetVirtualmachineidIfUnset sets virtualmachineid to v, unless it is already set
+func (p *RestoreVirtualMachineParams) SetVirtualmachineidIfUnset(v string) {
+	if _, found := p.p["virtualmachineid"]; !found {
+		p.SetVirtualmachineid(v)
+	}
+}
+
 // You should always use this function to get a new RestoreVirtualMachineParams instance,
-// as then you are sure you have configured all required params
-func (s *VirtualMachineService) NewRestoreVirtualMachineParams(virtualmachineid string) *RestoreVirtualMachineParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *VirtualMachineService) NewRestoreVirtualMachineParams(virtualmachineid string, opts ...func(*RestoreVirtualMachineParams)) *RestoreVirtualMachineParams {
 	p := &RestoreVirtualMachineParams{}
 	p.p = make(map[string]interface{})
 	p.p["virtualmachineid"] = virtualmachineid
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Restore a VM to original template/ISO or new template/ISO
-func (s *VirtualMachineService) RestoreVirtualMachine(p *RestoreVirtualMachineParams) (*RestoreVirtualMachineResponse, error) {
-	resp, err := s.cs.newRequest("restoreVirtualMachine", p.toURLValues())
+func (s *VirtualMachineService) RestoreVirtualMachine(p *RestoreVirtualMachineParams, opts ...OptionFunc) (*RestoreVirtualMachineResponse, error) {
+	return s.RestoreVirtualMachineWithContext(context.Background(), p, opts...)
+}
+
+// RestoreVirtualMachineWithContext is the same as RestoreVirtualMachine, but the request is cancelled when the context is done
+func (s *VirtualMachineService) RestoreVirtualMachineWithContext(ctx context.Context, p *RestoreVirtualMachineParams, opts ...OptionFunc) (*RestoreVirtualMachineResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.RestoreVirtualMachineRawWithContext(ctx, p.toURLValues())
+}
+
+// RestoreVirtualMachineRaw is the same as RestoreVirtualMachine, but takes the params as url.Values instead of typed params
+func (s *VirtualMachineService) RestoreVirtualMachineRaw(v url.Values) (*RestoreVirtualMachineResponse, error) {
+	return s.RestoreVirtualMachineRawWithContext(context.Background(), v)
+}
+
+// RestoreVirtualMachineRawWithContext is the same as RestoreVirtualMachineRaw, but the request is cancelled when the context is done
+func (s *VirtualMachineService) RestoreVirtualMachineRawWithContext(ctx context.Context, v url.Values) (*RestoreVirtualMachineResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "restoreVirtualMachine", "restorevirtualmachineresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -3955,9 +6145,9 @@ func (s *VirtualMachineService) RestoreVirtualMachine(p *RestoreVirtualMachinePa
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -3991,43 +6181,43 @@ type RestoreVirtualMachineResponse struct {
 		Type              string   `json:"type"`
 		VirtualmachineIds []string `json:"virtualmachineIds"`
 	} `json:"affinitygroup"`
-	Cpunumber             int               `json:"cpunumber"`
-	Cpuspeed              int               `json:"cpuspeed"`
-	Cpuused               string            `json:"cpuused"`
-	Created               string            `json:"created"`
-	Details               map[string]string `json:"details"`
-	Diskioread            int64             `json:"diskioread"`
-	Diskiowrite           int64             `json:"diskiowrite"`
-	Diskkbsread           int64             `json:"diskkbsread"`
-	Diskkbswrite          int64             `json:"diskkbswrite"`
-	Diskofferingid        string            `json:"diskofferingid"`
-	Diskofferingname      string            `json:"diskofferingname"`
-	Displayname           string            `json:"displayname"`
-	Displayvm             bool              `json:"displayvm"`
-	Domain                string            `json:"domain"`
-	Domainid              string            `json:"domainid"`
-	Forvirtualnetwork     bool              `json:"forvirtualnetwork"`
-	Group                 string            `json:"group"`
-	Groupid               string            `json:"groupid"`
-	Guestosid             string            `json:"guestosid"`
-	Haenable              bool              `json:"haenable"`
-	Hostid                string            `json:"hostid"`
-	Hostname              string            `json:"hostname"`
-	Hypervisor            string            `json:"hypervisor"`
-	Id                    string            `json:"id"`
-	Instancename          string            `json:"instancename"`
-	Isdynamicallyscalable bool              `json:"isdynamicallyscalable"`
-	Isodisplaytext        string            `json:"isodisplaytext"`
-	Isoid                 string            `json:"isoid"`
-	Isoname               string            `json:"isoname"`
-	Keypair               string            `json:"keypair"`
-	Memory                int               `json:"memory"`
-	Memoryintfreekbs      int64             `json:"memoryintfreekbs"`
-	Memorykbs             int64             `json:"memorykbs"`
-	Memorytargetkbs       int64             `json:"memorytargetkbs"`
-	Name                  string            `json:"name"`
-	Networkkbsread        int64             `json:"networkkbsread"`
-	Networkkbswrite       int64             `json:"networkkbswrite"`
+	Cpunumber             int       `json:"cpunumber"`
+	Cpuspeed              int       `json:"cpuspeed"`
+	Cpuused               string    `json:"cpuused"`
+	Created               string    `json:"created"`
+	Details               StringMap `json:"details"`
+	Diskioread            int64     `json:"diskioread"`
+	Diskiowrite           int64     `json:"diskiowrite"`
+	Diskkbsread           int64     `json:"diskkbsread"`
+	Diskkbswrite          int64     `json:"diskkbswrite"`
+	Diskofferingid        string    `json:"diskofferingid"`
+	Diskofferingname      string    `json:"diskofferingname"`
+	Displayname           string    `json:"displayname"`
+	Displayvm             bool      `json:"displayvm"`
+	Domain                string    `json:"domain"`
+	Domainid              string    `json:"domainid"`
+	Forvirtualnetwork     bool      `json:"forvirtualnetwork"`
+	Group                 string    `json:"group"`
+	Groupid               string    `json:"groupid"`
+	Guestosid             string    `json:"guestosid"`
+	Haenable              bool      `json:"haenable"`
+	Hostid                string    `json:"hostid"`
+	Hostname              string    `json:"hostname"`
+	Hypervisor            string    `json:"hypervisor"`
+	Id                    string    `json:"id"`
+	Instancename          string    `json:"instancename"`
+	Isdynamicallyscalable bool      `json:"isdynamicallyscalable"`
+	Isodisplaytext        string    `json:"isodisplaytext"`
+	Isoid                 string    `json:"isoid"`
+	Isoname               string    `json:"isoname"`
+	Keypair               string    `json:"keypair"`
+	Memory                int       `json:"memory"`
+	Memoryintfreekbs      int64     `json:"memoryintfreekbs"`
+	Memorykbs             int64     `json:"memorykbs"`
+	Memorytargetkbs       int64     `json:"memorytargetkbs"`
+	Name                  string    `json:"name"`
+	Networkkbsread        int64     `json:"networkkbsread"`
+	Networkkbswrite       int64     `json:"networkkbswrite"`
 	Nic                   []struct {
 		Broadcasturi         string `json:"broadcasturi"`
 		Deviceid             string `json:"deviceid"`
@@ -4150,16 +6340,25 @@ type ScaleVirtualMachineParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ScaleVirtualMachineParams) Clone() *ScaleVirtualMachineParams {
+	c := &ScaleVirtualMachineParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ScaleVirtualMachineParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
 	}
 	if v, found := p.p["details"]; found {
-		i := 0
-		for k, vv := range v.(map[string]string) {
-			u.Set(fmt.Sprintf("details[%d].%s", i, k), vv)
-			i++
+		m := v.(map[string]string)
+		for i, k := range getSortedKeysFromMap(m) {
+			u.Set(fmt.Sprintf("details[%d].%s", i, k), m[k])
 		}
 	}
 	if v, found := p.p["id"]; found {
@@ -4171,6 +6370,16 @@ func (p *ScaleVirtualMachineParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *ScaleVirtualMachineParams) validate() error {
+	for _, k := range []string{"id", "serviceofferingid"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of scaleVirtualMachine", k)
+		}
+	}
+	return nil
+}
+
 func (p *ScaleVirtualMachineParams) SetDetails(v map[string]string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -4179,6 +6388,13 @@ func (p *ScaleVirtualMachineParams) SetDetails(v map[string]string) {
 	return
 }
 
+// SetDetailsIfUnset sets details to v, unless it is already set
+func (p *ScaleVirtualMachineParams) SetDetailsIfUnset(v map[string]string) {
+	if _, found := p.p["details"]; !found {
+		p.SetDetails(v)
+	}
+}
+
 func (p *ScaleVirtualMachineParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -4187,6 +6403,13 @@ func (p *ScaleVirtualMachineParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *ScaleVirtualMachineParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 func (p *ScaleVirtualMachineParams) SetServiceofferingid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -4195,19 +6418,55 @@ func (p *ScaleVirtualMachineParams) SetServiceofferingid(v string) {
 	return
 }
 
+// SetServiceofferingidIfUnset sets serviceofferingid to v, unless it is already set
+func (p *ScaleVirtualMachineParams) SetServiceofferingidIfUnset(v string) {
+	if _, found := p.p["serviceofferingid"]; !found {
+		p.SetServiceofferingid(v)
+	}
+}
+
 // You should always use this function to get a new ScaleVirtualMachineParams instance,
-// as then you are sure you have configured all required params
-func (s *VirtualMachineService) NewScaleVirtualMachineParams(id string, serviceofferingid string) *ScaleVirtualMachineParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *VirtualMachineService) NewScaleVirtualMachineParams(id string, serviceofferingid string, opts ...func(*ScaleVirtualMachineParams)) *ScaleVirtualMachineParams {
 	p := &ScaleVirtualMachineParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
 	p.p["serviceofferingid"] = serviceofferingid
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Scales the virtual machine to a new service offering.
-func (s *VirtualMachineService) ScaleVirtualMachine(p *ScaleVirtualMachineParams) (*ScaleVirtualMachineResponse, error) {
-	resp, err := s.cs.newRequest("scaleVirtualMachine", p.toURLValues())
+func (s *VirtualMachineService) ScaleVirtualMachine(p *ScaleVirtualMachineParams, opts ...OptionFunc) (*ScaleVirtualMachineResponse, error) {
+	return s.ScaleVirtualMachineWithContext(context.Background(), p, opts...)
+}
+
+// ScaleVirtualMachineWithContext is the same as ScaleVirtualMachine, but the request is cancelled when the context is done
+func (s *VirtualMachineService) ScaleVirtualMachineWithContext(ctx context.Context, p *ScaleVirtualMachineParams, opts ...OptionFunc) (*ScaleVirtualMachineResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.ScaleVirtualMachineRawWithContext(ctx, p.toURLValues())
+}
+
+// ScaleVirtualMachineRaw is the same as ScaleVirtualMachine, but takes the params as url.Values instead of typed params
+func (s *VirtualMachineService) ScaleVirtualMachineRaw(v url.Values) (*ScaleVirtualMachineResponse, error) {
+	return s.ScaleVirtualMachineRawWithContext(context.Background(), v)
+}
+
+// ScaleVirtualMachineRawWithContext is the same as ScaleVirtualMachineRaw, but the request is cancelled when the context is done
+func (s *VirtualMachineService) ScaleVirtualMachineRawWithContext(ctx context.Context, v url.Values) (*ScaleVirtualMachineResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "scaleVirtualMachine", "scalevirtualmachineresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -4219,9 +6478,9 @@ func (s *VirtualMachineService) ScaleVirtualMachine(p *ScaleVirtualMachineParams
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -4245,6 +6504,16 @@ type StartVirtualMachineParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *StartVirtualMachineParams) Clone() *StartVirtualMachineParams {
+	c := &StartVirtualMachineParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *StartVirtualMachineParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -4262,6 +6531,16 @@ func (p *StartVirtualMachineParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *StartVirtualMachineParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of startVirtualMachine", k)
+		}
+	}
+	return nil
+}
+
 func (p *StartVirtualMachineParams) SetDeploymentplanner(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -4270,6 +6549,13 @@ func (p *StartVirtualMachineParams) SetDeploymentplanner(v string) {
 	return
 }
 
+// SetDeploymentplannerIfUnset sets deploymentplanner to v, unless it is already set
+func (p *StartVirtualMachineParams) SetDeploymentplannerIfUnset(v string) {
+	if _, found := p.p["deploymentplanner"]; !found {
+		p.SetDeploymentplanner(v)
+	}
+}
+
 func (p *StartVirtualMachineParams) SetHostid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -4278,6 +6564,13 @@ func (p *StartVirtualMachineParams) SetHostid(v string) {
 	return
 }
 
+// SetHostidIfUnset sets hostid to v, unless it is already set
+func (p *StartVirtualMachineParams) SetHostidIfUnset(v string) {
+	if _, found := p.p["hostid"]; !found {
+		p.SetHostid(v)
+	}
+}
+
 func (p *StartVirtualMachineParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -4286,18 +6579,54 @@ func (p *StartVirtualMachineParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *StartVirtualMachineParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 // You should always use this function to get a new StartVirtualMachineParams instance,
-// as then you are sure you have configured all required params
-func (s *VirtualMachineService) NewStartVirtualMachineParams(id string) *StartVirtualMachineParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *VirtualMachineService) NewStartVirtualMachineParams(id string, opts ...func(*StartVirtualMachineParams)) *StartVirtualMachineParams {
 	p := &StartVirtualMachineParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Starts a virtual machine.
-func (s *VirtualMachineService) StartVirtualMachine(p *StartVirtualMachineParams) (*StartVirtualMachineResponse, error) {
-	resp, err := s.cs.newRequest("startVirtualMachine", p.toURLValues())
+func (s *VirtualMachineService) StartVirtualMachine(p *StartVirtualMachineParams, opts ...OptionFunc) (*StartVirtualMachineResponse, error) {
+	return s.StartVirtualMachineWithContext(context.Background(), p, opts...)
+}
+
+// StartVirtualMachineWithContext is the same as StartVirtualMachine, but the request is cancelled when the context is done
+func (s *VirtualMachineService) StartVirtualMachineWithContext(ctx context.Context, p *StartVirtualMachineParams, opts ...OptionFunc) (*StartVirtualMachineResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.StartVirtualMachineRawWithContext(ctx, p.toURLValues())
+}
+
+// StartVirtualMachineRaw is the same as StartVirtualMachine, but takes the params as url.Values instead of typed params
+func (s *VirtualMachineService) StartVirtualMachineRaw(v url.Values) (*StartVirtualMachineResponse, error) {
+	return s.StartVirtualMachineRawWithContext(context.Background(), v)
+}
+
+// StartVirtualMachineRawWithContext is the same as StartVirtualMachineRaw, but the request is cancelled when the context is done
+func (s *VirtualMachineService) StartVirtualMachineRawWithContext(ctx context.Context, v url.Values) (*StartVirtualMachineResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "startVirtualMachine", "startvirtualmachineresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -4309,9 +6638,9 @@ func (s *VirtualMachineService) StartVirtualMachine(p *StartVirtualMachineParams
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -4345,43 +6674,43 @@ type StartVirtualMachineResponse struct {
 		Type              string   `json:"type"`
 		VirtualmachineIds []string `json:"virtualmachineIds"`
 	} `json:"affinitygroup"`
-	Cpunumber             int               `json:"cpunumber"`
-	Cpuspeed              int               `json:"cpuspeed"`
-	Cpuused               string            `json:"cpuused"`
-	Created               string            `json:"created"`
-	Details               map[string]string `json:"details"`
-	Diskioread            int64             `json:"diskioread"`
-	Diskiowrite           int64             `json:"diskiowrite"`
-	Diskkbsread           int64             `json:"diskkbsread"`
-	Diskkbswrite          int64             `json:"diskkbswrite"`
-	Diskofferingid        string            `json:"diskofferingid"`
-	Diskofferingname      string            `json:"diskofferingname"`
-	Displayname           string            `json:"displayname"`
-	Displayvm             bool              `json:"displayvm"`
-	Domain                string            `json:"domain"`
-	Domainid              string            `json:"domainid"`
-	Forvirtualnetwork     bool              `json:"forvirtualnetwork"`
-	Group                 string            `json:"group"`
-	Groupid               string            `json:"groupid"`
-	Guestosid             string            `json:"guestosid"`
-	Haenable              bool              `json:"haenable"`
-	Hostid                string            `json:"hostid"`
-	Hostname              string            `json:"hostname"`
-	Hypervisor            string            `json:"hypervisor"`
-	Id                    string            `json:"id"`
-	Instancename          string            `json:"instancename"`
-	Isdynamicallyscalable bool              `json:"isdynamicallyscalable"`
-	Isodisplaytext        string            `json:"isodisplaytext"`
-	Isoid                 string            `json:"isoid"`
-	Isoname               string            `json:"isoname"`
-	Keypair               string            `json:"keypair"`
-	Memory                int               `json:"memory"`
-	Memoryintfreekbs      int64             `json:"memoryintfreekbs"`
-	Memorykbs             int64             `json:"memorykbs"`
-	Memorytargetkbs       int64             `json:"memorytargetkbs"`
-	Name                  string            `json:"name"`
-	Networkkbsread        int64             `json:"networkkbsread"`
-	Networkkbswrite       int64             `json:"networkkbswrite"`
+	Cpunumber             int       `json:"cpunumber"`
+	Cpuspeed              int       `json:"cpuspeed"`
+	Cpuused               string    `json:"cpuused"`
+	Created               string    `json:"created"`
+	Details               StringMap `json:"details"`
+	Diskioread            int64     `json:"diskioread"`
+	Diskiowrite           int64     `json:"diskiowrite"`
+	Diskkbsread           int64     `json:"diskkbsread"`
+	Diskkbswrite          int64     `json:"diskkbswrite"`
+	Diskofferingid        string    `json:"diskofferingid"`
+	Diskofferingname      string    `json:"diskofferingname"`
+	Displayname           string    `json:"displayname"`
+	Displayvm             bool      `json:"displayvm"`
+	Domain                string    `json:"domain"`
+	Domainid              string    `json:"domainid"`
+	Forvirtualnetwork     bool      `json:"forvirtualnetwork"`
+	Group                 string    `json:"group"`
+	Groupid               string    `json:"groupid"`
+	Guestosid             string    `json:"guestosid"`
+	Haenable              bool      `json:"haenable"`
+	Hostid                string    `json:"hostid"`
+	Hostname              string    `json:"hostname"`
+	Hypervisor            string    `json:"hypervisor"`
+	Id                    string    `json:"id"`
+	Instancename          string    `json:"instancename"`
+	Isdynamicallyscalable bool      `json:"isdynamicallyscalable"`
+	Isodisplaytext        string    `json:"isodisplaytext"`
+	Isoid                 string    `json:"isoid"`
+	Isoname               string    `json:"isoname"`
+	Keypair               string    `json:"keypair"`
+	Memory                int       `json:"memory"`
+	Memoryintfreekbs      int64     `json:"memoryintfreekbs"`
+	Memorykbs             int64     `json:"memorykbs"`
+	Memorytargetkbs       int64     `json:"memorytargetkbs"`
+	Name                  string    `json:"name"`
+	Networkkbsread        int64     `json:"networkkbsread"`
+	Networkkbswrite       int64     `json:"networkkbswrite"`
 	Nic                   []struct {
 		Broadcasturi         string `json:"broadcasturi"`
 		Deviceid             string `json:"deviceid"`
@@ -4504,6 +6833,16 @@ type StopVirtualMachineParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *StopVirtualMachineParams) Clone() *StopVirtualMachineParams {
+	c := &StopVirtualMachineParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *StopVirtualMachineParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -4519,6 +6858,16 @@ func (p *StopVirtualMachineParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *StopVirtualMachineParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of stopVirtualMachine", k)
+		}
+	}
+	return nil
+}
+
 func (p *StopVirtualMachineParams) SetForced(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -4527,6 +6876,13 @@ func (p *StopVirtualMachineParams) SetForced(v bool) {
 	return
 }
 
+// SetForcedIfUnset sets forced to v, unless it is already set
+func (p *StopVirtualMachineParams) SetForcedIfUnset(v bool) {
+	if _, found := p.p["forced"]; !found {
+		p.SetForced(v)
+	}
+}
+
 func (p *StopVirtualMachineParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -4535,18 +6891,54 @@ func (p *StopVirtualMachineParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *StopVirtualMachineParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 // You should always use this function to get a new StopVirtualMachineParams instance,
-// as then you are sure you have configured all required params
-func (s *VirtualMachineService) NewStopVirtualMachineParams(id string) *StopVirtualMachineParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *VirtualMachineService) NewStopVirtualMachineParams(id string, opts ...func(*StopVirtualMachineParams)) *StopVirtualMachineParams {
 	p := &StopVirtualMachineParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Stops a virtual machine.
-func (s *VirtualMachineService) StopVirtualMachine(p *StopVirtualMachineParams) (*StopVirtualMachineResponse, error) {
-	resp, err := s.cs.newRequest("stopVirtualMachine", p.toURLValues())
+func (s *VirtualMachineService) StopVirtualMachine(p *StopVirtualMachineParams, opts ...OptionFunc) (*StopVirtualMachineResponse, error) {
+	return s.StopVirtualMachineWithContext(context.Background(), p, opts...)
+}
+
+// StopVirtualMachineWithContext is the same as StopVirtualMachine, but the request is cancelled when the context is done
+func (s *VirtualMachineService) StopVirtualMachineWithContext(ctx context.Context, p *StopVirtualMachineParams, opts ...OptionFunc) (*StopVirtualMachineResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.StopVirtualMachineRawWithContext(ctx, p.toURLValues())
+}
+
+// StopVirtualMachineRaw is the same as StopVirtualMachine, but takes the params as url.Values instead of typed params
+func (s *VirtualMachineService) StopVirtualMachineRaw(v url.Values) (*StopVirtualMachineResponse, error) {
+	return s.StopVirtualMachineRawWithContext(context.Background(), v)
+}
+
+// StopVirtualMachineRawWithContext is the same as StopVirtualMachineRaw, but the request is cancelled when the context is done
+func (s *VirtualMachineService) StopVirtualMachineRawWithContext(ctx context.Context, v url.Values) (*StopVirtualMachineResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "stopVirtualMachine", "stopvirtualmachineresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -4558,9 +6950,9 @@ func (s *VirtualMachineService) StopVirtualMachine(p *StopVirtualMachineParams)
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -4594,43 +6986,43 @@ type StopVirtualMachineResponse struct {
 		Type              string   `json:"type"`
 		VirtualmachineIds []string `json:"virtualmachineIds"`
 	} `json:"affinitygroup"`
-	Cpunumber             int               `json:"cpunumber"`
-	Cpuspeed              int               `json:"cpuspeed"`
-	Cpuused               string            `json:"cpuused"`
-	Created               string            `json:"created"`
-	Details               map[string]string `json:"details"`
-	Diskioread            int64             `json:"diskioread"`
-	Diskiowrite           int64             `json:"diskiowrite"`
-	Diskkbsread           int64             `json:"diskkbsread"`
-	Diskkbswrite          int64             `json:"diskkbswrite"`
-	Diskofferingid        string            `json:"diskofferingid"`
-	Diskofferingname      string            `json:"diskofferingname"`
-	Displayname           string            `json:"displayname"`
-	Displayvm             bool              `json:"displayvm"`
-	Domain                string            `json:"domain"`
-	Domainid              string            `json:"domainid"`
-	Forvirtualnetwork     bool              `json:"forvirtualnetwork"`
-	Group                 string            `json:"group"`
-	Groupid               string            `json:"groupid"`
-	Guestosid             string            `json:"guestosid"`
-	Haenable              bool              `json:"haenable"`
-	Hostid                string            `json:"hostid"`
-	Hostname              string            `json:"hostname"`
-	Hypervisor            string            `json:"hypervisor"`
-	Id                    string            `json:"id"`
-	Instancename          string            `json:"instancename"`
-	Isdynamicallyscalable bool              `json:"isdynamicallyscalable"`
-	Isodisplaytext        string            `json:"isodisplaytext"`
-	Isoid                 string            `json:"isoid"`
-	Isoname               string            `json:"isoname"`
-	Keypair               string            `json:"keypair"`
-	Memory                int               `json:"memory"`
-	Memoryintfreekbs      int64             `json:"memoryintfreekbs"`
-	Memorykbs             int64             `json:"memorykbs"`
-	Memorytargetkbs       int64             `json:"memorytargetkbs"`
-	Name                  string            `json:"name"`
-	Networkkbsread        int64             `json:"networkkbsread"`
-	Networkkbswrite       int64             `json:"networkkbswrite"`
+	Cpunumber             int       `json:"cpunumber"`
+	Cpuspeed              int       `json:"cpuspeed"`
+	Cpuused               string    `json:"cpuused"`
+	Created               string    `json:"created"`
+	Details               StringMap `json:"details"`
+	Diskioread            int64     `json:"diskioread"`
+	Diskiowrite           int64     `json:"diskiowrite"`
+	Diskkbsread           int64     `json:"diskkbsread"`
+	Diskkbswrite          int64     `json:"diskkbswrite"`
+	Diskofferingid        string    `json:"diskofferingid"`
+	Diskofferingname      string    `json:"diskofferingname"`
+	Displayname           string    `json:"displayname"`
+	Displayvm             bool      `json:"displayvm"`
+	Domain                string    `json:"domain"`
+	Domainid              string    `json:"domainid"`
+	Forvirtualnetwork     bool      `json:"forvirtualnetwork"`
+	Group                 string    `json:"group"`
+	Groupid               string    `json:"groupid"`
+	Guestosid             string    `json:"guestosid"`
+	Haenable              bool      `json:"haenable"`
+	Hostid                string    `json:"hostid"`
+	Hostname              string    `json:"hostname"`
+	Hypervisor            string    `json:"hypervisor"`
+	Id                    string    `json:"id"`
+	Instancename          string    `json:"instancename"`
+	Isdynamicallyscalable bool      `json:"isdynamicallyscalable"`
+	Isodisplaytext        string    `json:"isodisplaytext"`
+	Isoid                 string    `json:"isoid"`
+	Isoname               string    `json:"isoname"`
+	Keypair               string    `json:"keypair"`
+	Memory                int       `json:"memory"`
+	Memoryintfreekbs      int64     `json:"memoryintfreekbs"`
+	Memorykbs             int64     `json:"memorykbs"`
+	Memorytargetkbs       int64     `json:"memorytargetkbs"`
+	Name                  string    `json:"name"`
+	Networkkbsread        int64     `json:"networkkbsread"`
+	Networkkbswrite       int64     `json:"networkkbswrite"`
 	Nic                   []struct {
 		Broadcasturi         string `json:"broadcasturi"`
 		Deviceid             string `json:"deviceid"`
@@ -4753,6 +7145,16 @@ type UpdateDefaultNicForVirtualMachineParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *UpdateDefaultNicForVirtualMachineParams) Clone() *UpdateDefaultNicForVirtualMachineParams {
+	c := &UpdateDefaultNicForVirtualMachineParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *UpdateDefaultNicForVirtualMachineParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -4767,6 +7169,16 @@ func (p *UpdateDefaultNicForVirtualMachineParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *UpdateDefaultNicForVirtualMachineParams) validate() error {
+	for _, k := range []string{"nicid", "virtualmachineid"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of updateDefaultNicForVirtualMachine", k)
+		}
+	}
+	return nil
+}
+
 func (p *UpdateDefaultNicForVirtualMachineParams) SetNicid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -4775,6 +7187,13 @@ func (p *UpdateDefaultNicForVirtualMachineParams) SetNicid(v string) {
 	return
 }
 
+// SetNicidIfUnset sets nicid to v, unless it is already set
+func (p *UpdateDefaultNicForVirtualMachineParams) SetNicidIfUnset(v string) {
+	if _, found := p.p["nicid"]; !found {
+		p.SetNicid(v)
+	}
+}
+
 func (p *UpdateDefaultNicForVirtualMachineParams) SetVirtualmachineid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -4783,19 +7202,55 @@ func (p *UpdateDefaultNicForVirtualMachineParams) SetVirtualmachineid(v string)
 	return
 }
 
+// SetVirtualmachineidIfUnset sets virtualmachineid to v, unless it is already set
+func (p *UpdateDefaultNicForVirtualMachineParams) SetVirtualmachineidIfUnset(v string) {
+	if _, found := p.p["virtualmachineid"]; !found {
+		p.SetVirtualmachineid(v)
+	}
+}
+
 // You should always use this function to get a new UpdateDefaultNicForVirtualMachineParams instance,
-// as then you are sure you have configured all required params
-func (s *VirtualMachineService) NewUpdateDefaultNicForVirtualMachineParams(nicid string, virtualmachineid string) *UpdateDefaultNicForVirtualMachineParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *VirtualMachineService) NewUpdateDefaultNicForVirtualMachineParams(nicid string, virtualmachineid string, opts ...func(*UpdateDefaultNicForVirtualMachineParams)) *UpdateDefaultNicForVirtualMachineParams {
 	p := &UpdateDefaultNicForVirtualMachineParams{}
 	p.p = make(map[string]interface{})
 	p.p["nicid"] = nicid
 	p.p["virtualmachineid"] = virtualmachineid
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Changes the default NIC on a VM
-func (s *VirtualMachineService) UpdateDefaultNicForVirtualMachine(p *UpdateDefaultNicForVirtualMachineParams) (*UpdateDefaultNicForVirtualMachineResponse, error) {
-	resp, err := s.cs.newRequest("updateDefaultNicForVirtualMachine", p.toURLValues())
+func (s *VirtualMachineService) UpdateDefaultNicForVirtualMachine(p *UpdateDefaultNicForVirtualMachineParams, opts ...OptionFunc) (*UpdateDefaultNicForVirtualMachineResponse, error) {
+	return s.UpdateDefaultNicForVirtualMachineWithContext(context.Background(), p, opts...)
+}
+
+// UpdateDefaultNicForVirtualMachineWithContext is the same as UpdateDefaultNicForVirtualMachine, but the request is cancelled when the context is done
+func (s *VirtualMachineService) UpdateDefaultNicForVirtualMachineWithContext(ctx context.Context, p *UpdateDefaultNicForVirtualMachineParams, opts ...OptionFunc) (*UpdateDefaultNicForVirtualMachineResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.UpdateDefaultNicForVirtualMachineRawWithContext(ctx, p.toURLValues())
+}
+
+// UpdateDefaultNicForVirtualMachineRaw is the same as UpdateDefaultNicForVirtualMachine, but takes the params as url.Values instead of typed params
+func (s *VirtualMachineService) UpdateDefaultNicForVirtualMachineRaw(v url.Values) (*UpdateDefaultNicForVirtualMachineResponse, error) {
+	return s.UpdateDefaultNicForVirtualMachineRawWithContext(context.Background(), v)
+}
+
+// UpdateDefaultNicForVirtualMachineRawWithContext is the same as UpdateDefaultNicForVirtualMachineRaw, but the request is cancelled when the context is done
+func (s *VirtualMachineService) UpdateDefaultNicForVirtualMachineRawWithContext(ctx context.Context, v url.Values) (*UpdateDefaultNicForVirtualMachineResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "updateDefaultNicForVirtualMachine", "updatedefaultnicforvirtualmachineresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -4807,9 +7262,9 @@ func (s *VirtualMachineService) UpdateDefaultNicForVirtualMachine(p *UpdateDefau
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -4843,43 +7298,43 @@ type UpdateDefaultNicForVirtualMachineResponse struct {
 		Type              string   `json:"type"`
 		VirtualmachineIds []string `json:"virtualmachineIds"`
 	} `json:"affinitygroup"`
-	Cpunumber             int               `json:"cpunumber"`
-	Cpuspeed              int               `json:"cpuspeed"`
-	Cpuused               string            `json:"cpuused"`
-	Created               string            `json:"created"`
-	Details               map[string]string `json:"details"`
-	Diskioread            int64             `json:"diskioread"`
-	Diskiowrite           int64             `json:"diskiowrite"`
-	Diskkbsread           int64             `json:"diskkbsread"`
-	Diskkbswrite          int64             `json:"diskkbswrite"`
-	Diskofferingid        string            `json:"diskofferingid"`
-	Diskofferingname      string            `json:"diskofferingname"`
-	Displayname           string            `json:"displayname"`
-	Displayvm             bool              `json:"displayvm"`
-	Domain                string            `json:"domain"`
-	Domainid              string            `json:"domainid"`
-	Forvirtualnetwork     bool              `json:"forvirtualnetwork"`
-	Group                 string            `json:"group"`
-	Groupid               string            `json:"groupid"`
-	Guestosid             string            `json:"guestosid"`
-	Haenable              bool              `json:"haenable"`
-	Hostid                string            `json:"hostid"`
-	Hostname              string            `json:"hostname"`
-	Hypervisor            string            `json:"hypervisor"`
-	Id                    string            `json:"id"`
-	Instancename          string            `json:"instancename"`
-	Isdynamicallyscalable bool              `json:"isdynamicallyscalable"`
-	Isodisplaytext        string            `json:"isodisplaytext"`
-	Isoid                 string            `json:"isoid"`
-	Isoname               string            `json:"isoname"`
-	Keypair               string            `json:"keypair"`
-	Memory                int               `json:"memory"`
-	Memoryintfreekbs      int64             `json:"memoryintfreekbs"`
-	Memorykbs             int64             `json:"memorykbs"`
-	Memorytargetkbs       int64             `json:"memorytargetkbs"`
-	Name                  string            `json:"name"`
-	Networkkbsread        int64             `json:"networkkbsread"`
-	Networkkbswrite       int64             `json:"networkkbswrite"`
+	Cpunumber             int       `json:"cpunumber"`
+	Cpuspeed              int       `json:"cpuspeed"`
+	Cpuused               string    `json:"cpuused"`
+	Created               string    `json:"created"`
+	Details               StringMap `json:"details"`
+	Diskioread            int64     `json:"diskioread"`
+	Diskiowrite           int64     `json:"diskiowrite"`
+	Diskkbsread           int64     `json:"diskkbsread"`
+	Diskkbswrite          int64     `json:"diskkbswrite"`
+	Diskofferingid        string    `json:"diskofferingid"`
+	Diskofferingname      string    `json:"diskofferingname"`
+	Displayname           string    `json:"displayname"`
+	Displayvm             bool      `json:"displayvm"`
+	Domain                string    `json:"domain"`
+	Domainid              string    `json:"domainid"`
+	Forvirtualnetwork     bool      `json:"forvirtualnetwork"`
+	Group                 string    `json:"group"`
+	Groupid               string    `json:"groupid"`
+	Guestosid             string    `json:"guestosid"`
+	Haenable              bool      `json:"haenable"`
+	Hostid                string    `json:"hostid"`
+	Hostname              string    `json:"hostname"`
+	Hypervisor            string    `json:"hypervisor"`
+	Id                    string    `json:"id"`
+	Instancename          string    `json:"instancename"`
+	Isdynamicallyscalable bool      `json:"isdynamicallyscalable"`
+	Isodisplaytext        string    `json:"isodisplaytext"`
+	Isoid                 string    `json:"isoid"`
+	Isoname               string    `json:"isoname"`
+	Keypair               string    `json:"keypair"`
+	Memory                int       `json:"memory"`
+	Memoryintfreekbs      int64     `json:"memoryintfreekbs"`
+	Memorykbs             int64     `json:"memorykbs"`
+	Memorytargetkbs       int64     `json:"memorytargetkbs"`
+	Name                  string    `json:"name"`
+	Networkkbsread        int64     `json:"networkkbsread"`
+	Networkkbswrite       int64     `json:"networkkbswrite"`
 	Nic                   []struct {
 		Broadcasturi         string `json:"broadcasturi"`
 		Deviceid             string `json:"deviceid"`
@@ -5002,6 +7457,16 @@ type UpdateVirtualMachineParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *UpdateVirtualMachineParams) Clone() *UpdateVirtualMachineParams {
+	c := &UpdateVirtualMachineParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *UpdateVirtualMachineParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -5011,10 +7476,9 @@ func (p *UpdateVirtualMachineParams) toURLValues() url.Values {
 		u.Set("customid", v.(string))
 	}
 	if v, found := p.p["details"]; found {
-		i := 0
-		for k, vv := range v.(map[string]string) {
-			u.Set(fmt.Sprintf("details[%d].%s", i, k), vv)
-			i++
+		m := v.(map[string]string)
+		for i, k := range getSortedKeysFromMap(m) {
+			u.Set(fmt.Sprintf("details[%d].%s", i, k), m[k])
 		}
 	}
 	if v, found := p.p["displayname"]; found {
@@ -5061,6 +7525,16 @@ func (p *UpdateVirtualMachineParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *UpdateVirtualMachineParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of updateVirtualMachine", k)
+		}
+	}
+	return nil
+}
+
 func (p *UpdateVirtualMachineParams) SetCustomid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -5069,6 +7543,13 @@ func (p *UpdateVirtualMachineParams) SetCustomid(v string) {
 	return
 }
 
+// SetCustomidIfUnset sets customid to v, unless it is already set
+func (p *UpdateVirtualMachineParams) SetCustomidIfUnset(v string) {
+	if _, found := p.p["customid"]; !found {
+		p.SetCustomid(v)
+	}
+}
+
 func (p *UpdateVirtualMachineParams) SetDetails(v map[string]string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -5077,6 +7558,13 @@ func (p *UpdateVirtualMachineParams) SetDetails(v map[string]string) {
 	return
 }
 
+// SetDetailsIfUnset sets details to v, unless it is already set
+func (p *UpdateVirtualMachineParams) SetDetailsIfUnset(v map[string]string) {
+	if _, found := p.p["details"]; !found {
+		p.SetDetails(v)
+	}
+}
+
 func (p *UpdateVirtualMachineParams) SetDisplayname(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -5085,6 +7573,13 @@ func (p *UpdateVirtualMachineParams) SetDisplayname(v string) {
 	return
 }
 
+// SetDisplaynameIfUnset sets displayname to v, unless it is already set
+func (p *UpdateVirtualMachineParams) SetDisplaynameIfUnset(v string) {
+	if _, found := p.p["displayname"]; !found {
+		p.SetDisplayname(v)
+	}
+}
+
 func (p *UpdateVirtualMachineParams) SetDisplayvm(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -5093,6 +7588,13 @@ func (p *UpdateVirtualMachineParams) SetDisplayvm(v bool) {
 	return
 }
 
+// SetDisplayvmIfUnset sets displayvm to v, unless it is already set
+func (p *UpdateVirtualMachineParams) SetDisplayvmIfUnset(v bool) {
+	if _, found := p.p["displayvm"]; !found {
+		p.SetDisplayvm(v)
+	}
+}
+
 func (p *UpdateVirtualMachineParams) SetGroup(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -5101,6 +7603,13 @@ func (p *UpdateVirtualMachineParams) SetGroup(v string) {
 	return
 }
 
+// SetGroupIfUnset sets group to v, unless it is already set
+func (p *UpdateVirtualMachineParams) SetGroupIfUnset(v string) {
+	if _, found := p.p["group"]; !found {
+		p.SetGroup(v)
+	}
+}
+
 func (p *UpdateVirtualMachineParams) SetHaenable(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -5109,6 +7618,13 @@ func (p *UpdateVirtualMachineParams) SetHaenable(v bool) {
 	return
 }
 
+// SetHaenableIfUnset sets haenable to v, unless it is already set
+func (p *UpdateVirtualMachineParams) SetHaenableIfUnset(v bool) {
+	if _, found := p.p["haenable"]; !found {
+		p.SetHaenable(v)
+	}
+}
+
 func (p *UpdateVirtualMachineParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -5117,6 +7633,13 @@ func (p *UpdateVirtualMachineParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *UpdateVirtualMachineParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 func (p *UpdateVirtualMachineParams) SetInstancename(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -5125,6 +7648,13 @@ func (p *UpdateVirtualMachineParams) SetInstancename(v string) {
 	return
 }
 
+// SetInstancenameIfUnset sets instancename to v, unless it is already set
+func (p *UpdateVirtualMachineParams) SetInstancenameIfUnset(v string) {
+	if _, found := p.p["instancename"]; !found {
+		p.SetInstancename(v)
+	}
+}
+
 func (p *UpdateVirtualMachineParams) SetIsdynamicallyscalable(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -5133,6 +7663,13 @@ func (p *UpdateVirtualMachineParams) SetIsdynamicallyscalable(v bool) {
 	return
 }
 
+// SetIsdynamicallyscalableIfUnset sets isdynamicallyscalable to v, unless it is already set
+func (p *UpdateVirtualMachineParams) SetIsdynamicallyscalableIfUnset(v bool) {
+	if _, found := p.p["isdynamicallyscalable"]; !found {
+		p.SetIsdynamicallyscalable(v)
+	}
+}
+
 func (p *UpdateVirtualMachineParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -5141,6 +7678,13 @@ func (p *UpdateVirtualMachineParams) SetName(v string) {
 	return
 }
 
+// SetNameIfUnset sets name to v, unless it is already set
+func (p *UpdateVirtualMachineParams) SetNameIfUnset(v string) {
+	if _, found := p.p["name"]; !found {
+		p.SetName(v)
+	}
+}
+
 func (p *UpdateVirtualMachineParams) SetOstypeid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -5149,6 +7693,13 @@ func (p *UpdateVirtualMachineParams) SetOstypeid(v string) {
 	return
 }
 
+// SetOstypeidIfUnset sets ostypeid to v, unless it is already set
+func (p *UpdateVirtualMachineParams) SetOstypeidIfUnset(v string) {
+	if _, found := p.p["ostypeid"]; !found {
+		p.SetOstypeid(v)
+	}
+}
+
 func (p *UpdateVirtualMachineParams) SetSecuritygroupids(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -5157,6 +7708,18 @@ func (p *UpdateVirtualMachineParams) SetSecuritygroupids(v []string) {
 	return
 }
 
+// SetSecuritygroupidsIfUnset sets securitygroupids to v, unless it is already set
+func (p *UpdateVirtualMachineParams) SetSecuritygroupidsIfUnset(v []string) {
+	if _, found := p.p["securitygroupids"]; !found {
+		p.SetSecuritygroupids(v)
+	}
+}
+
+// SetSecuritygroupidsCSV splits v on commas and sets the resulting values
+func (p *UpdateVirtualMachineParams) SetSecuritygroupidsCSV(v string) {
+	p.SetSecuritygroupids(splitCSV(v))
+}
+
 func (p *UpdateVirtualMachineParams) SetSecuritygroupnames(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -5165,6 +7728,18 @@ func (p *UpdateVirtualMachineParams) SetSecuritygroupnames(v []string) {
 	return
 }
 
+// SetSecuritygroupnamesIfUnset sets securitygroupnames to v, unless it is already set
+func (p *UpdateVirtualMachineParams) SetSecuritygroupnamesIfUnset(v []string) {
+	if _, found := p.p["securitygroupnames"]; !found {
+		p.SetSecuritygroupnames(v)
+	}
+}
+
+// SetSecuritygroupnamesCSV splits v on commas and sets the resulting values
+func (p *UpdateVirtualMachineParams) SetSecuritygroupnamesCSV(v string) {
+	p.SetSecuritygroupnames(splitCSV(v))
+}
+
 func (p *UpdateVirtualMachineParams) SetUserdata(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -5173,18 +7748,58 @@ func (p *UpdateVirtualMachineParams) SetUserdata(v string) {
 	return
 }
 
+// SetUserdataIfUnset sets userdata to v, unless it is already set
+func (p *UpdateVirtualMachineParams) SetUserdataIfUnset(v string) {
+	if _, found := p.p["userdata"]; !found {
+		p.SetUserdata(v)
+	}
+}
+
 // You should always use this function to get a new UpdateVirtualMachineParams instance,
-// as then you are sure you have configured all required params
-func (s *VirtualMachineService) NewUpdateVirtualMachineParams(id string) *UpdateVirtualMachineParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *VirtualMachineService) NewUpdateVirtualMachineParams(id string, opts ...func(*UpdateVirtualMachineParams)) *UpdateVirtualMachineParams {
 	p := &UpdateVirtualMachineParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Updates properties of a virtual machine. The VM has to be stopped and restarted for the new properties to take effect. UpdateVirtualMachine does not first check whether the VM is stopped. Therefore, stop the VM manually before issuing this call.
-func (s *VirtualMachineService) UpdateVirtualMachine(p *UpdateVirtualMachineParams) (*UpdateVirtualMachineResponse, error) {
-	resp, err := s.cs.newRequest("updateVirtualMachine", p.toURLValues())
+func (s *VirtualMachineService) UpdateVirtualMachine(p *UpdateVirtualMachineParams, opts ...OptionFunc) (*UpdateVirtualMachineResponse, error) {
+	return s.UpdateVirtualMachineWithContext(context.Background(), p, opts...)
+}
+
+// UpdateVirtualMachineWithContext is the same as UpdateVirtualMachine, but the request is cancelled when the context is done
+func (s *VirtualMachineService) UpdateVirtualMachineWithContext(ctx context.Context, p *UpdateVirtualMachineParams, opts ...OptionFunc) (*UpdateVirtualMachineResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	if err := checkMutuallyExclusive(p.p, "securitygroupids", "securitygroupnames"); err != nil {
+		return nil, err
+	}
+
+	return s.UpdateVirtualMachineRawWithContext(ctx, p.toURLValues())
+}
+
+// UpdateVirtualMachineRaw is the same as UpdateVirtualMachine, but takes the params as url.Values instead of typed params
+func (s *VirtualMachineService) UpdateVirtualMachineRaw(v url.Values) (*UpdateVirtualMachineResponse, error) {
+	return s.UpdateVirtualMachineRawWithContext(context.Background(), v)
+}
+
+// UpdateVirtualMachineRawWithContext is the same as UpdateVirtualMachineRaw, but the request is cancelled when the context is done
+func (s *VirtualMachineService) UpdateVirtualMachineRawWithContext(ctx context.Context, v url.Values) (*UpdateVirtualMachineResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "updateVirtualMachine", "updatevirtualmachineresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -5211,43 +7826,43 @@ type UpdateVirtualMachineResponse struct {
 		Type              string   `json:"type"`
 		VirtualmachineIds []string `json:"virtualmachineIds"`
 	} `json:"affinitygroup"`
-	Cpunumber             int               `json:"cpunumber"`
-	Cpuspeed              int               `json:"cpuspeed"`
-	Cpuused               string            `json:"cpuused"`
-	Created               string            `json:"created"`
-	Details               map[string]string `json:"details"`
-	Diskioread            int64             `json:"diskioread"`
-	Diskiowrite           int64             `json:"diskiowrite"`
-	Diskkbsread           int64             `json:"diskkbsread"`
-	Diskkbswrite          int64             `json:"diskkbswrite"`
-	Diskofferingid        string            `json:"diskofferingid"`
-	Diskofferingname      string            `json:"diskofferingname"`
-	Displayname           string            `json:"displayname"`
-	Displayvm             bool              `json:"displayvm"`
-	Domain                string            `json:"domain"`
-	Domainid              string            `json:"domainid"`
-	Forvirtualnetwork     bool              `json:"forvirtualnetwork"`
-	Group                 string            `json:"group"`
-	Groupid               string            `json:"groupid"`
-	Guestosid             string            `json:"guestosid"`
-	Haenable              bool              `json:"haenable"`
-	Hostid                string            `json:"hostid"`
-	Hostname              string            `json:"hostname"`
-	Hypervisor            string            `json:"hypervisor"`
-	Id                    string            `json:"id"`
-	Instancename          string            `json:"instancename"`
-	Isdynamicallyscalable bool              `json:"isdynamicallyscalable"`
-	Isodisplaytext        string            `json:"isodisplaytext"`
-	Isoid                 string            `json:"isoid"`
-	Isoname               string            `json:"isoname"`
-	Keypair               string            `json:"keypair"`
-	Memory                int               `json:"memory"`
-	Memoryintfreekbs      int64             `json:"memoryintfreekbs"`
-	Memorykbs             int64             `json:"memorykbs"`
-	Memorytargetkbs       int64             `json:"memorytargetkbs"`
-	Name                  string            `json:"name"`
-	Networkkbsread        int64             `json:"networkkbsread"`
-	Networkkbswrite       int64             `json:"networkkbswrite"`
+	Cpunumber             int       `json:"cpunumber"`
+	Cpuspeed              int       `json:"cpuspeed"`
+	Cpuused               string    `json:"cpuused"`
+	Created               string    `json:"created"`
+	Details               StringMap `json:"details"`
+	Diskioread            int64     `json:"diskioread"`
+	Diskiowrite           int64     `json:"diskiowrite"`
+	Diskkbsread           int64     `json:"diskkbsread"`
+	Diskkbswrite          int64     `json:"diskkbswrite"`
+	Diskofferingid        string    `json:"diskofferingid"`
+	Diskofferingname      string    `json:"diskofferingname"`
+	Displayname           string    `json:"displayname"`
+	Displayvm             bool      `json:"displayvm"`
+	Domain                string    `json:"domain"`
+	Domainid              string    `json:"domainid"`
+	Forvirtualnetwork     bool      `json:"forvirtualnetwork"`
+	Group                 string    `json:"group"`
+	Groupid               string    `json:"groupid"`
+	Guestosid             string    `json:"guestosid"`
+	Haenable              bool      `json:"haenable"`
+	Hostid                string    `json:"hostid"`
+	Hostname              string    `json:"hostname"`
+	Hypervisor            string    `json:"hypervisor"`
+	Id                    string    `json:"id"`
+	Instancename          string    `json:"instancename"`
+	Isdynamicallyscalable bool      `json:"isdynamicallyscalable"`
+	Isodisplaytext        string    `json:"isodisplaytext"`
+	Isoid                 string    `json:"isoid"`
+	Isoname               string    `json:"isoname"`
+	Keypair               string    `json:"keypair"`
+	Memory                int       `json:"memory"`
+	Memoryintfreekbs      int64     `json:"memoryintfreekbs"`
+	Memorykbs             int64     `json:"memorykbs"`
+	Memorytargetkbs       int64     `json:"memorytargetkbs"`
+	Name                  string    `json:"name"`
+	Networkkbsread        int64     `json:"networkkbsread"`
+	Networkkbswrite       int64     `json:"networkkbswrite"`
 	Nic                   []struct {
 		Broadcasturi         string `json:"broadcasturi"`
 		Deviceid             string `json:"deviceid"`
@@ -17,17 +17,161 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/url"
 	"strconv"
 	"strings"
+	"time"
 )
 
+// ExtractToFile extracts the volume from the given zone using the given mode (which should be HTTP_DOWNLOAD),
+// waits for the async job to finish and downloads the extracted volume to destPath.
+func (s *VolumeService) ExtractToFile(volumeid, zoneid, mode, destPath string) error {
+	r, err := s.ExtractVolume(s.NewExtractVolumeParams(volumeid, mode, zoneid))
+	if err != nil {
+		return err
+	}
+
+	// An async client already waited for the job to finish
+	if !s.cs.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		if err != nil {
+			return err
+		}
+		if b, err = getRawValue(b); err != nil {
+			return err
+		}
+		if err := json.Unmarshal(b, r); err != nil {
+			return err
+		}
+	}
+
+	if r.Url == "" {
+		return fmt.Errorf("No download URL returned when extracting volume %s", volumeid)
+	}
+	return s.cs.downloadToFile(r.Url, destPath)
+}
+
+// AttachAndWait attaches the volume to the virtual machine, waits for the async job to finish and
+// returns the updated volume. The options are used when getting the updated volume.
+func (s *VolumeService) AttachAndWait(volumeid, vmid string, opts ...OptionFunc) (*Volume, error) {
+	r, err := s.AttachVolume(s.NewAttachVolumeParams(volumeid, vmid))
+	if err != nil {
+		return nil, err
+	}
+
+	return s.waitForVolumeJob(r.JobID, volumeid, opts...)
+}
+
+// DetachAndWait detaches the volume from its virtual machine, waits for the async job to finish and
+// returns the updated volume. The options are used when getting the updated volume.
+func (s *VolumeService) DetachAndWait(volumeid string, opts ...OptionFunc) (*Volume, error) {
+	p := s.NewDetachVolumeParams()
+	p.SetId(volumeid)
+
+	r, err := s.DetachVolume(p)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.waitForVolumeJob(r.JobID, volumeid, opts...)
+}
+
+// ResizeAndWait resizes the volume to the given size in GB, waits for the async job to finish and
+// returns the updated volume. The options are used when getting the updated volume.
+func (s *VolumeService) ResizeAndWait(volumeid string, newSizeGB int64, opts ...OptionFunc) (*Volume, error) {
+	p := s.NewResizeVolumeParams(volumeid)
+	p.SetSize(newSizeGB)
+
+	r, err := s.ResizeVolume(p)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.waitForVolumeJob(r.JobID, volumeid, opts...)
+}
+
+func (s *VolumeService) waitForVolumeJob(jobid, volumeid string, opts ...OptionFunc) (*Volume, error) {
+	// An async client already waited for the job to finish
+	if !s.cs.async {
+		if _, err := s.cs.GetAsyncJobResult(jobid, s.cs.timeout); err != nil {
+			return nil, err
+		}
+	}
+
+	v, _, err := s.GetVolumeByID(volumeid, opts...)
+	return v, err
+}
+
+// UpdateMany runs UpdateVolume for all given IDs in parallel, using at most concurrency concurrent requests.
+// For each ID the params are populated with the ID and then passed to mutate, which should set the
+// changes to apply. The result contains the updated resources (in the order of the IDs) and the error
+// of every update that failed by ID. The returned error contains all failures, or is nil if none failed.
+// The updated resources are returned as the UpdateVolumeResponse of every update, which contains the same
+// fields as the resource itself, so no additional request is needed per resource to get them. The
+// options are used for every update.
+func (s *VolumeService) UpdateMany(ids []string, mutate func(*UpdateVolumeParams), concurrency int, opts ...OptionFunc) (BulkResult[*UpdateVolumeResponse], error) {
+	rs := make([]*UpdateVolumeResponse, len(ids))
+	errs := make([]error, len(ids))
+
+	runConcurrently(len(ids), concurrency, func(i int) {
+		p := &UpdateVolumeParams{p: map[string]interface{}{"id": ids[i]}}
+		if mutate != nil {
+			mutate(p)
+		}
+
+		r, err := s.UpdateVolume(p, opts...)
+		if err != nil {
+			errs[i] = err
+			return
+		}
+
+		// An async client already waited for the job to finish
+		if !s.cs.async {
+			b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+			if err == nil {
+				b, err = getRawValue(b)
+			}
+			if err == nil {
+				err = json.Unmarshal(b, r)
+			}
+			if err != nil {
+				errs[i] = err
+				return
+			}
+		}
+		rs[i] = r
+	})
+
+	var r BulkResult[*UpdateVolumeResponse]
+	for i, id := range ids {
+		if errs[i] != nil {
+			r.fail(id, errs[i])
+			continue
+		}
+		r.Succeeded = append(r.Succeeded, rs[i])
+	}
+
+	return r, r.Err()
+}
+
 type AttachVolumeParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *AttachVolumeParams) Clone() *AttachVolumeParams {
+	c := &AttachVolumeParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *AttachVolumeParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -46,6 +190,16 @@ func (p *AttachVolumeParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *AttachVolumeParams) validate() error {
+	for _, k := range []string{"id", "virtualmachineid"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of attachVolume", k)
+		}
+	}
+	return nil
+}
+
 func (p *AttachVolumeParams) SetDeviceid(v int64) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -54,6 +208,13 @@ func (p *AttachVolumeParams) SetDeviceid(v int64) {
 	return
 }
 
+// SetDeviceidIfUnset sets deviceid to v, unless it is already set
+func (p *AttachVolumeParams) SetDeviceidIfUnset(v int64) {
+	if _, found := p.p["deviceid"]; !found {
+		p.SetDeviceid(v)
+	}
+}
+
 func (p *AttachVolumeParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -62,6 +223,13 @@ func (p *AttachVolumeParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *AttachVolumeParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 func (p *AttachVolumeParams) SetVirtualmachineid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -70,19 +238,55 @@ func (p *AttachVolumeParams) SetVirtualmachineid(v string) {
 	return
 }
 
+// SetVirtualmachineidIfUnset sets virtualmachineid to v, unless it is already set
+func (p *AttachVolumeParams) SetVirtualmachineidIfUnset(v string) {
+	if _, found := p.p["virtualmachineid"]; !found {
+		p.SetVirtualmachineid(v)
+	}
+}
+
 // You should always use this function to get a new AttachVolumeParams instance,
-// as then you are sure you have configured all required params
-func (s *VolumeService) NewAttachVolumeParams(id string, virtualmachineid string) *AttachVolumeParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *VolumeService) NewAttachVolumeParams(id string, virtualmachineid string, opts ...func(*AttachVolumeParams)) *AttachVolumeParams {
 	p := &AttachVolumeParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
 	p.p["virtualmachineid"] = virtualmachineid
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Attaches a disk volume to a virtual machine.
-func (s *VolumeService) AttachVolume(p *AttachVolumeParams) (*AttachVolumeResponse, error) {
-	resp, err := s.cs.newRequest("attachVolume", p.toURLValues())
+func (s *VolumeService) AttachVolume(p *AttachVolumeParams, opts ...OptionFunc) (*AttachVolumeResponse, error) {
+	return s.AttachVolumeWithContext(context.Background(), p, opts...)
+}
+
+// AttachVolumeWithContext is the same as AttachVolume, but the request is cancelled when the context is done
+func (s *VolumeService) AttachVolumeWithContext(ctx context.Context, p *AttachVolumeParams, opts ...OptionFunc) (*AttachVolumeResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.AttachVolumeRawWithContext(ctx, p.toURLValues())
+}
+
+// AttachVolumeRaw is the same as AttachVolume, but takes the params as url.Values instead of typed params
+func (s *VolumeService) AttachVolumeRaw(v url.Values) (*AttachVolumeResponse, error) {
+	return s.AttachVolumeRawWithContext(context.Background(), v)
+}
+
+// AttachVolumeRawWithContext is the same as AttachVolumeRaw, but the request is cancelled when the context is done
+func (s *VolumeService) AttachVolumeRawWithContext(ctx context.Context, v url.Values) (*AttachVolumeResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "attachVolume", "attachvolumeresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -94,15 +298,15 @@ func (s *VolumeService) AttachVolume(p *AttachVolumeParams) (*AttachVolumeRespon
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
+		b, err = getRawValueByKey(b, "volume")
 		if err != nil {
 			return nil, err
 		}
@@ -173,6 +377,16 @@ type CreateVolumeParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *CreateVolumeParams) Clone() *CreateVolumeParams {
+	c := &CreateVolumeParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *CreateVolumeParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -232,6 +446,13 @@ func (p *CreateVolumeParams) SetAccount(v string) {
 	return
 }
 
+// SetAccountIfUnset sets account to v, unless it is already set
+func (p *CreateVolumeParams) SetAccountIfUnset(v string) {
+	if _, found := p.p["account"]; !found {
+		p.SetAccount(v)
+	}
+}
+
 func (p *CreateVolumeParams) SetCustomid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -240,6 +461,13 @@ func (p *CreateVolumeParams) SetCustomid(v string) {
 	return
 }
 
+// SetCustomidIfUnset sets customid to v, unless it is already set
+func (p *CreateVolumeParams) SetCustomidIfUnset(v string) {
+	if _, found := p.p["customid"]; !found {
+		p.SetCustomid(v)
+	}
+}
+
 func (p *CreateVolumeParams) SetDiskofferingid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -248,6 +476,13 @@ func (p *CreateVolumeParams) SetDiskofferingid(v string) {
 	return
 }
 
+// SetDiskofferingidIfUnset sets diskofferingid to v, unless it is already set
+func (p *CreateVolumeParams) SetDiskofferingidIfUnset(v string) {
+	if _, found := p.p["diskofferingid"]; !found {
+		p.SetDiskofferingid(v)
+	}
+}
+
 func (p *CreateVolumeParams) SetDisplayvolume(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -256,6 +491,13 @@ func (p *CreateVolumeParams) SetDisplayvolume(v bool) {
 	return
 }
 
+// SetDisplayvolumeIfUnset sets displayvolume to v, unless it is already set
+func (p *CreateVolumeParams) SetDisplayvolumeIfUnset(v bool) {
+	if _, found := p.p["displayvolume"]; !found {
+		p.SetDisplayvolume(v)
+	}
+}
+
 func (p *CreateVolumeParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -264,6 +506,13 @@ func (p *CreateVolumeParams) SetDomainid(v string) {
 	return
 }
 
+// SetDomainidIfUnset sets domainid to v, unless it is already set
+func (p *CreateVolumeParams) SetDomainidIfUnset(v string) {
+	if _, found := p.p["domainid"]; !found {
+		p.SetDomainid(v)
+	}
+}
+
 func (p *CreateVolumeParams) SetMaxiops(v int64) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -272,6 +521,13 @@ func (p *CreateVolumeParams) SetMaxiops(v int64) {
 	return
 }
 
+// SetMaxiopsIfUnset sets maxiops to v, unless it is already set
+func (p *CreateVolumeParams) SetMaxiopsIfUnset(v int64) {
+	if _, found := p.p["maxiops"]; !found {
+		p.SetMaxiops(v)
+	}
+}
+
 func (p *CreateVolumeParams) SetMiniops(v int64) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -280,6 +536,13 @@ func (p *CreateVolumeParams) SetMiniops(v int64) {
 	return
 }
 
+// SetMiniopsIfUnset sets miniops to v, unless it is already set
+func (p *CreateVolumeParams) SetMiniopsIfUnset(v int64) {
+	if _, found := p.p["miniops"]; !found {
+		p.SetMiniops(v)
+	}
+}
+
 func (p *CreateVolumeParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -288,6 +551,13 @@ func (p *CreateVolumeParams) SetName(v string) {
 	return
 }
 
+// SetNameIfUnset sets name to v, unless it is already set
+func (p *CreateVolumeParams) SetNameIfUnset(v string) {
+	if _, found := p.p["name"]; !found {
+		p.SetName(v)
+	}
+}
+
 func (p *CreateVolumeParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -296,6 +566,13 @@ func (p *CreateVolumeParams) SetProjectid(v string) {
 	return
 }
 
+// SetProjectidIfUnset sets projectid to v, unless it is already set
+func (p *CreateVolumeParams) SetProjectidIfUnset(v string) {
+	if _, found := p.p["projectid"]; !found {
+		p.SetProjectid(v)
+	}
+}
+
 func (p *CreateVolumeParams) SetSize(v int64) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -304,6 +581,13 @@ func (p *CreateVolumeParams) SetSize(v int64) {
 	return
 }
 
+// SetSizeIfUnset sets size to v, unless it is already set
+func (p *CreateVolumeParams) SetSizeIfUnset(v int64) {
+	if _, found := p.p["size"]; !found {
+		p.SetSize(v)
+	}
+}
+
 func (p *CreateVolumeParams) SetSnapshotid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -312,6 +596,13 @@ func (p *CreateVolumeParams) SetSnapshotid(v string) {
 	return
 }
 
+// SetSnapshotidIfUnset sets snapshotid to v, unless it is already set
+func (p *CreateVolumeParams) SetSnapshotidIfUnset(v string) {
+	if _, found := p.p["snapshotid"]; !found {
+		p.SetSnapshotid(v)
+	}
+}
+
 func (p *CreateVolumeParams) SetVirtualmachineid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -320,6 +611,13 @@ func (p *CreateVolumeParams) SetVirtualmachineid(v string) {
 	return
 }
 
+// SetVirtualmachineidIfUnset sets virtualmachineid to v, unless it is already set
+func (p *CreateVolumeParams) SetVirtualmachineidIfUnset(v string) {
+	if _, found := p.p["virtualmachineid"]; !found {
+		p.SetVirtualmachineid(v)
+	}
+}
+
 func (p *CreateVolumeParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -328,17 +626,49 @@ func (p *CreateVolumeParams) SetZoneid(v string) {
 	return
 }
 
+// SetZoneidIfUnset sets zoneid to v, unless it is already set
+func (p *CreateVolumeParams) SetZoneidIfUnset(v string) {
+	if _, found := p.p["zoneid"]; !found {
+		p.SetZoneid(v)
+	}
+}
+
 // You should always use this function to get a new CreateVolumeParams instance,
-// as then you are sure you have configured all required params
-func (s *VolumeService) NewCreateVolumeParams() *CreateVolumeParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *VolumeService) NewCreateVolumeParams(opts ...func(*CreateVolumeParams)) *CreateVolumeParams {
 	p := &CreateVolumeParams{}
 	p.p = make(map[string]interface{})
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Creates a disk volume from a disk offering. This disk volume must still be attached to a virtual machine to make use of it.
-func (s *VolumeService) CreateVolume(p *CreateVolumeParams) (*CreateVolumeResponse, error) {
-	resp, err := s.cs.newRequest("createVolume", p.toURLValues())
+func (s *VolumeService) CreateVolume(p *CreateVolumeParams, opts ...OptionFunc) (*CreateVolumeResponse, error) {
+	return s.CreateVolumeWithContext(context.Background(), p, opts...)
+}
+
+// CreateVolumeWithContext is the same as CreateVolume, but the request is cancelled when the context is done
+func (s *VolumeService) CreateVolumeWithContext(ctx context.Context, p *CreateVolumeParams, opts ...OptionFunc) (*CreateVolumeResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	return s.CreateVolumeRawWithContext(ctx, p.toURLValues())
+}
+
+// CreateVolumeRaw is the same as CreateVolume, but takes the params as url.Values instead of typed params
+func (s *VolumeService) CreateVolumeRaw(v url.Values) (*CreateVolumeResponse, error) {
+	return s.CreateVolumeRawWithContext(context.Background(), v)
+}
+
+// CreateVolumeRawWithContext is the same as CreateVolumeRaw, but the request is cancelled when the context is done
+func (s *VolumeService) CreateVolumeRawWithContext(ctx context.Context, v url.Values) (*CreateVolumeResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "createVolume", "createvolumeresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -350,15 +680,15 @@ func (s *VolumeService) CreateVolume(p *CreateVolumeParams) (*CreateVolumeRespon
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
+		b, err = getRawValueByKey(b, "volume")
 		if err != nil {
 			return nil, err
 		}
@@ -429,6 +759,16 @@ type DeleteVolumeParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *DeleteVolumeParams) Clone() *DeleteVolumeParams {
+	c := &DeleteVolumeParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeleteVolumeParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -440,6 +780,16 @@ func (p *DeleteVolumeParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *DeleteVolumeParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of deleteVolume", k)
+		}
+	}
+	return nil
+}
+
 func (p *DeleteVolumeParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -448,18 +798,54 @@ func (p *DeleteVolumeParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *DeleteVolumeParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 // You should always use this function to get a new DeleteVolumeParams instance,
-// as then you are sure you have configured all required params
-func (s *VolumeService) NewDeleteVolumeParams(id string) *DeleteVolumeParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *VolumeService) NewDeleteVolumeParams(id string, opts ...func(*DeleteVolumeParams)) *DeleteVolumeParams {
 	p := &DeleteVolumeParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Deletes a detached disk volume.
-func (s *VolumeService) DeleteVolume(p *DeleteVolumeParams) (*DeleteVolumeResponse, error) {
-	resp, err := s.cs.newRequest("deleteVolume", p.toURLValues())
+func (s *VolumeService) DeleteVolume(p *DeleteVolumeParams, opts ...OptionFunc) (*DeleteVolumeResponse, error) {
+	return s.DeleteVolumeWithContext(context.Background(), p, opts...)
+}
+
+// DeleteVolumeWithContext is the same as DeleteVolume, but the request is cancelled when the context is done
+func (s *VolumeService) DeleteVolumeWithContext(ctx context.Context, p *DeleteVolumeParams, opts ...OptionFunc) (*DeleteVolumeResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.DeleteVolumeRawWithContext(ctx, p.toURLValues())
+}
+
+// DeleteVolumeRaw is the same as DeleteVolume, but takes the params as url.Values instead of typed params
+func (s *VolumeService) DeleteVolumeRaw(v url.Values) (*DeleteVolumeResponse, error) {
+	return s.DeleteVolumeRawWithContext(context.Background(), v)
+}
+
+// DeleteVolumeRawWithContext is the same as DeleteVolumeRaw, but the request is cancelled when the context is done
+func (s *VolumeService) DeleteVolumeRawWithContext(ctx context.Context, v url.Values) (*DeleteVolumeResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteVolume", "deletevolumeresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -472,6 +858,34 @@ func (s *VolumeService) DeleteVolume(p *DeleteVolumeParams) (*DeleteVolumeRespon
 	return &r, nil
 }
 
+// WaitForVolumeDeleted polls until the Volume with the given ID no longer exists, the timeout
+// expires or the context is cancelled. Deleted resources may still be listed for a short while
+// after the delete command finished, so this can be used to make sure they are really gone. The
+// options are passed to GetVolumeByID, so a resource outside of the default scope can be found.
+func (s *VolumeService) WaitForVolumeDeleted(ctx context.Context, id string, timeout time.Duration, opts ...OptionFunc) error {
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	for {
+		_, count, err := s.GetVolumeByID(id, opts...)
+		if count == 0 {
+			return nil
+		}
+		if count < 0 {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(2 * time.Second):
+		}
+	}
+}
+
 type DeleteVolumeResponse struct {
 	Displaytext string `json:"displaytext"`
 	Success     bool   `json:"success"`
@@ -500,6 +914,16 @@ type DetachVolumeParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *DetachVolumeParams) Clone() *DetachVolumeParams {
+	c := &DetachVolumeParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DetachVolumeParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -526,6 +950,13 @@ func (p *DetachVolumeParams) SetDeviceid(v int64) {
 	return
 }
 
+// SetDeviceidIfUnset sets deviceid to v, unless it is already set
+func (p *DetachVolumeParams) SetDeviceidIfUnset(v int64) {
+	if _, found := p.p["deviceid"]; !found {
+		p.SetDeviceid(v)
+	}
+}
+
 func (p *DetachVolumeParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -534,6 +965,13 @@ func (p *DetachVolumeParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *DetachVolumeParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 func (p *DetachVolumeParams) SetVirtualmachineid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -542,17 +980,49 @@ func (p *DetachVolumeParams) SetVirtualmachineid(v string) {
 	return
 }
 
+// SetVirtualmachineidIfUnset sets virtualmachineid to v, unless it is already set
+func (p *DetachVolumeParams) SetVirtualmachineidIfUnset(v string) {
+	if _, found := p.p["virtualmachineid"]; !found {
+		p.SetVirtualmachineid(v)
+	}
+}
+
 // You should always use this function to get a new DetachVolumeParams instance,
-// as then you are sure you have configured all required params
-func (s *VolumeService) NewDetachVolumeParams() *DetachVolumeParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *VolumeService) NewDetachVolumeParams(opts ...func(*DetachVolumeParams)) *DetachVolumeParams {
 	p := &DetachVolumeParams{}
 	p.p = make(map[string]interface{})
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Detaches a disk volume from a virtual machine.
-func (s *VolumeService) DetachVolume(p *DetachVolumeParams) (*DetachVolumeResponse, error) {
-	resp, err := s.cs.newRequest("detachVolume", p.toURLValues())
+func (s *VolumeService) DetachVolume(p *DetachVolumeParams, opts ...OptionFunc) (*DetachVolumeResponse, error) {
+	return s.DetachVolumeWithContext(context.Background(), p, opts...)
+}
+
+// DetachVolumeWithContext is the same as DetachVolume, but the request is cancelled when the context is done
+func (s *VolumeService) DetachVolumeWithContext(ctx context.Context, p *DetachVolumeParams, opts ...OptionFunc) (*DetachVolumeResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	return s.DetachVolumeRawWithContext(ctx, p.toURLValues())
+}
+
+// DetachVolumeRaw is the same as DetachVolume, but takes the params as url.Values instead of typed params
+func (s *VolumeService) DetachVolumeRaw(v url.Values) (*DetachVolumeResponse, error) {
+	return s.DetachVolumeRawWithContext(context.Background(), v)
+}
+
+// DetachVolumeRawWithContext is the same as DetachVolumeRaw, but the request is cancelled when the context is done
+func (s *VolumeService) DetachVolumeRawWithContext(ctx context.Context, v url.Values) (*DetachVolumeResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "detachVolume", "detachvolumeresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -564,15 +1034,15 @@ func (s *VolumeService) DetachVolume(p *DetachVolumeParams) (*DetachVolumeRespon
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
+		b, err = getRawValueByKey(b, "volume")
 		if err != nil {
 			return nil, err
 		}
@@ -643,6 +1113,16 @@ type ExtractVolumeParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ExtractVolumeParams) Clone() *ExtractVolumeParams {
+	c := &ExtractVolumeParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ExtractVolumeParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -663,6 +1143,16 @@ func (p *ExtractVolumeParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *ExtractVolumeParams) validate() error {
+	for _, k := range []string{"id", "mode", "zoneid"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of extractVolume", k)
+		}
+	}
+	return nil
+}
+
 func (p *ExtractVolumeParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -671,6 +1161,13 @@ func (p *ExtractVolumeParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *ExtractVolumeParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 func (p *ExtractVolumeParams) SetMode(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -679,6 +1176,13 @@ func (p *ExtractVolumeParams) SetMode(v string) {
 	return
 }
 
+// SetModeIfUnset sets mode to v, unless it is already set
+func (p *ExtractVolumeParams) SetModeIfUnset(v string) {
+	if _, found := p.p["mode"]; !found {
+		p.SetMode(v)
+	}
+}
+
 func (p *ExtractVolumeParams) SetUrl(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -687,6 +1191,13 @@ func (p *ExtractVolumeParams) SetUrl(v string) {
 	return
 }
 
+// SetUrlIfUnset sets url to v, unless it is already set
+func (p *ExtractVolumeParams) SetUrlIfUnset(v string) {
+	if _, found := p.p["url"]; !found {
+		p.SetUrl(v)
+	}
+}
+
 func (p *ExtractVolumeParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -695,20 +1206,75 @@ func (p *ExtractVolumeParams) SetZoneid(v string) {
 	return
 }
 
+// SetZoneidIfUnset sets zoneid to v, unless it is already set
+func (p *ExtractVolumeParams) SetZoneidIfUnset(v string) {
+	if _, found := p.p["zoneid"]; !found {
+		p.SetZoneid(v)
+	}
+}
+
 // You should always use this function to get a new ExtractVolumeParams instance,
-// as then you are sure you have configured all required params
-func (s *VolumeService) NewExtractVolumeParams(id string, mode string, zoneid string) *ExtractVolumeParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *VolumeService) NewExtractVolumeParams(id string, mode string, zoneid string, opts ...func(*ExtractVolumeParams)) *ExtractVolumeParams {
 	p := &ExtractVolumeParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
 	p.p["mode"] = mode
 	p.p["zoneid"] = zoneid
+	for _, fn := range opts {
+		fn(p)
+	}
+	return p
+}
+
+// ExtractVolumeOpts contains all params that can be set on a ExtractVolumeParams.
+// Optional params are pointers (or slices and maps) which are only set when not nil.
+type ExtractVolumeOpts struct {
+	Id     string
+	Mode   string
+	Url    *string
+	Zoneid string
+}
+
+// NewExtractVolumeParamsFromOpts is an alternative for NewExtractVolumeParams which takes all params using
+// an options struct, as that is easier to read for APIs with many required params
+func (s *VolumeService) NewExtractVolumeParamsFromOpts(o ExtractVolumeOpts) *ExtractVolumeParams {
+	p := s.NewExtractVolumeParams(o.Id, o.Mode, o.Zoneid)
+	if o.Url != nil {
+		p.p["url"] = *o.Url
+	}
 	return p
 }
 
 // Extracts volume
-func (s *VolumeService) ExtractVolume(p *ExtractVolumeParams) (*ExtractVolumeResponse, error) {
-	resp, err := s.cs.newRequest("extractVolume", p.toURLValues())
+func (s *VolumeService) ExtractVolume(p *ExtractVolumeParams, opts ...OptionFunc) (*ExtractVolumeResponse, error) {
+	return s.ExtractVolumeWithContext(context.Background(), p, opts...)
+}
+
+// ExtractVolumeWithContext is the same as ExtractVolume, but the request is cancelled when the context is done
+func (s *VolumeService) ExtractVolumeWithContext(ctx context.Context, p *ExtractVolumeParams, opts ...OptionFunc) (*ExtractVolumeResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.ExtractVolumeRawWithContext(ctx, p.toURLValues())
+}
+
+// ExtractVolumeRaw is the same as ExtractVolume, but takes the params as url.Values instead of typed params
+func (s *VolumeService) ExtractVolumeRaw(v url.Values) (*ExtractVolumeResponse, error) {
+	return s.ExtractVolumeRawWithContext(context.Background(), v)
+}
+
+// ExtractVolumeRawWithContext is the same as ExtractVolumeRaw, but the request is cancelled when the context is done
+func (s *VolumeService) ExtractVolumeRawWithContext(ctx context.Context, v url.Values) (*ExtractVolumeResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "extractVolume", "extractvolumeresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -720,9 +1286,9 @@ func (s *VolumeService) ExtractVolume(p *ExtractVolumeParams) (*ExtractVolumeRes
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -763,6 +1329,16 @@ type GetPathForVolumeParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *GetPathForVolumeParams) Clone() *GetPathForVolumeParams {
+	c := &GetPathForVolumeParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *GetPathForVolumeParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -774,6 +1350,16 @@ func (p *GetPathForVolumeParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *GetPathForVolumeParams) validate() error {
+	for _, k := range []string{"volumeid"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of getPathForVolume", k)
+		}
+	}
+	return nil
+}
+
 func (p *GetPathForVolumeParams) SetVolumeid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -782,18 +1368,54 @@ func (p *GetPathForVolumeParams) SetVolumeid(v string) {
 	return
 }
 
+// SetVolumeidIfUnset sets volumeid to v, unless it is already set
+func (p *GetPathForVolumeParams) SetVolumeidIfUnset(v string) {
+	if _, found := p.p["volumeid"]; !found {
+		p.SetVolumeid(v)
+	}
+}
+
 // You should always use this function to get a new GetPathForVolumeParams instance,
-// as then you are sure you have configured all required params
-func (s *VolumeService) NewGetPathForVolumeParams(volumeid string) *GetPathForVolumeParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *VolumeService) NewGetPathForVolumeParams(volumeid string, opts ...func(*GetPathForVolumeParams)) *GetPathForVolumeParams {
 	p := &GetPathForVolumeParams{}
 	p.p = make(map[string]interface{})
 	p.p["volumeid"] = volumeid
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Get the path associated with the provided volume UUID
-func (s *VolumeService) GetPathForVolume(p *GetPathForVolumeParams) (*GetPathForVolumeResponse, error) {
-	resp, err := s.cs.newRequest("getPathForVolume", p.toURLValues())
+func (s *VolumeService) GetPathForVolume(p *GetPathForVolumeParams, opts ...OptionFunc) (*GetPathForVolumeResponse, error) {
+	return s.GetPathForVolumeWithContext(context.Background(), p, opts...)
+}
+
+// GetPathForVolumeWithContext is the same as GetPathForVolume, but the request is cancelled when the context is done
+func (s *VolumeService) GetPathForVolumeWithContext(ctx context.Context, p *GetPathForVolumeParams, opts ...OptionFunc) (*GetPathForVolumeResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.GetPathForVolumeRawWithContext(ctx, p.toURLValues())
+}
+
+// GetPathForVolumeRaw is the same as GetPathForVolume, but takes the params as url.Values instead of typed params
+func (s *VolumeService) GetPathForVolumeRaw(v url.Values) (*GetPathForVolumeResponse, error) {
+	return s.GetPathForVolumeRawWithContext(context.Background(), v)
+}
+
+// GetPathForVolumeRawWithContext is the same as GetPathForVolumeRaw, but the request is cancelled when the context is done
+func (s *VolumeService) GetPathForVolumeRawWithContext(ctx context.Context, v url.Values) (*GetPathForVolumeResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "getPathForVolume", "getpathforvolumeresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -814,6 +1436,16 @@ type GetSolidFireVolumeAccessGroupIdParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *GetSolidFireVolumeAccessGroupIdParams) Clone() *GetSolidFireVolumeAccessGroupIdParams {
+	c := &GetSolidFireVolumeAccessGroupIdParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *GetSolidFireVolumeAccessGroupIdParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -828,6 +1460,16 @@ func (p *GetSolidFireVolumeAccessGroupIdParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *GetSolidFireVolumeAccessGroupIdParams) validate() error {
+	for _, k := range []string{"clusterid", "storageid"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of getSolidFireVolumeAccessGroupId", k)
+		}
+	}
+	return nil
+}
+
 func (p *GetSolidFireVolumeAccessGroupIdParams) SetClusterid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -836,6 +1478,13 @@ func (p *GetSolidFireVolumeAccessGroupIdParams) SetClusterid(v string) {
 	return
 }
 
+// SetClusteridIfUnset sets clusterid to v, unless it is already set
+func (p *GetSolidFireVolumeAccessGroupIdParams) SetClusteridIfUnset(v string) {
+	if _, found := p.p["clusterid"]; !found {
+		p.SetClusterid(v)
+	}
+}
+
 func (p *GetSolidFireVolumeAccessGroupIdParams) SetStorageid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -844,19 +1493,55 @@ func (p *GetSolidFireVolumeAccessGroupIdParams) SetStorageid(v string) {
 	return
 }
 
+// SetStorageidIfUnset sets storageid to v, unless it is already set
+func (p *GetSolidFireVolumeAccessGroupIdParams) SetStorageidIfUnset(v string) {
+	if _, found := p.p["storageid"]; !found {
+		p.SetStorageid(v)
+	}
+}
+
 // You should always use this function to get a new GetSolidFireVolumeAccessGroupIdParams instance,
-// as then you are sure you have configured all required params
-func (s *VolumeService) NewGetSolidFireVolumeAccessGroupIdParams(clusterid string, storageid string) *GetSolidFireVolumeAccessGroupIdParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *VolumeService) NewGetSolidFireVolumeAccessGroupIdParams(clusterid string, storageid string, opts ...func(*GetSolidFireVolumeAccessGroupIdParams)) *GetSolidFireVolumeAccessGroupIdParams {
 	p := &GetSolidFireVolumeAccessGroupIdParams{}
 	p.p = make(map[string]interface{})
 	p.p["clusterid"] = clusterid
 	p.p["storageid"] = storageid
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Get the SF Volume Access Group ID
-func (s *VolumeService) GetSolidFireVolumeAccessGroupId(p *GetSolidFireVolumeAccessGroupIdParams) (*GetSolidFireVolumeAccessGroupIdResponse, error) {
-	resp, err := s.cs.newRequest("getSolidFireVolumeAccessGroupId", p.toURLValues())
+func (s *VolumeService) GetSolidFireVolumeAccessGroupId(p *GetSolidFireVolumeAccessGroupIdParams, opts ...OptionFunc) (*GetSolidFireVolumeAccessGroupIdResponse, error) {
+	return s.GetSolidFireVolumeAccessGroupIdWithContext(context.Background(), p, opts...)
+}
+
+// GetSolidFireVolumeAccessGroupIdWithContext is the same as GetSolidFireVolumeAccessGroupId, but the request is cancelled when the context is done
+func (s *VolumeService) GetSolidFireVolumeAccessGroupIdWithContext(ctx context.Context, p *GetSolidFireVolumeAccessGroupIdParams, opts ...OptionFunc) (*GetSolidFireVolumeAccessGroupIdResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.GetSolidFireVolumeAccessGroupIdRawWithContext(ctx, p.toURLValues())
+}
+
+// GetSolidFireVolumeAccessGroupIdRaw is the same as GetSolidFireVolumeAccessGroupId, but takes the params as url.Values instead of typed params
+func (s *VolumeService) GetSolidFireVolumeAccessGroupIdRaw(v url.Values) (*GetSolidFireVolumeAccessGroupIdResponse, error) {
+	return s.GetSolidFireVolumeAccessGroupIdRawWithContext(context.Background(), v)
+}
+
+// GetSolidFireVolumeAccessGroupIdRawWithContext is the same as GetSolidFireVolumeAccessGroupIdRaw, but the request is cancelled when the context is done
+func (s *VolumeService) GetSolidFireVolumeAccessGroupIdRawWithContext(ctx context.Context, v url.Values) (*GetSolidFireVolumeAccessGroupIdResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "getSolidFireVolumeAccessGroupId", "getsolidfirevolumeaccessgroupidresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -877,6 +1562,16 @@ type GetSolidFireVolumeSizeParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *GetSolidFireVolumeSizeParams) Clone() *GetSolidFireVolumeSizeParams {
+	c := &GetSolidFireVolumeSizeParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *GetSolidFireVolumeSizeParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -888,6 +1583,16 @@ func (p *GetSolidFireVolumeSizeParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *GetSolidFireVolumeSizeParams) validate() error {
+	for _, k := range []string{"volumeid"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of getSolidFireVolumeSize", k)
+		}
+	}
+	return nil
+}
+
 func (p *GetSolidFireVolumeSizeParams) SetVolumeid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -896,18 +1601,54 @@ func (p *GetSolidFireVolumeSizeParams) SetVolumeid(v string) {
 	return
 }
 
+// SetVolumeidIfUnset sets volumeid to v, unless it is already set
+func (p *GetSolidFireVolumeSizeParams) SetVolumeidIfUnset(v string) {
+	if _, found := p.p["volumeid"]; !found {
+		p.SetVolumeid(v)
+	}
+}
+
 // You should always use this function to get a new GetSolidFireVolumeSizeParams instance,
-// as then you are sure you have configured all required params
-func (s *VolumeService) NewGetSolidFireVolumeSizeParams(volumeid string) *GetSolidFireVolumeSizeParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *VolumeService) NewGetSolidFireVolumeSizeParams(volumeid string, opts ...func(*GetSolidFireVolumeSizeParams)) *GetSolidFireVolumeSizeParams {
 	p := &GetSolidFireVolumeSizeParams{}
 	p.p = make(map[string]interface{})
 	p.p["volumeid"] = volumeid
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Get the SF volume size including Hypervisor Snapshot Reserve
-func (s *VolumeService) GetSolidFireVolumeSize(p *GetSolidFireVolumeSizeParams) (*GetSolidFireVolumeSizeResponse, error) {
-	resp, err := s.cs.newRequest("getSolidFireVolumeSize", p.toURLValues())
+func (s *VolumeService) GetSolidFireVolumeSize(p *GetSolidFireVolumeSizeParams, opts ...OptionFunc) (*GetSolidFireVolumeSizeResponse, error) {
+	return s.GetSolidFireVolumeSizeWithContext(context.Background(), p, opts...)
+}
+
+// GetSolidFireVolumeSizeWithContext is the same as GetSolidFireVolumeSize, but the request is cancelled when the context is done
+func (s *VolumeService) GetSolidFireVolumeSizeWithContext(ctx context.Context, p *GetSolidFireVolumeSizeParams, opts ...OptionFunc) (*GetSolidFireVolumeSizeResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.GetSolidFireVolumeSizeRawWithContext(ctx, p.toURLValues())
+}
+
+// GetSolidFireVolumeSizeRaw is the same as GetSolidFireVolumeSize, but takes the params as url.Values instead of typed params
+func (s *VolumeService) GetSolidFireVolumeSizeRaw(v url.Values) (*GetSolidFireVolumeSizeResponse, error) {
+	return s.GetSolidFireVolumeSizeRawWithContext(context.Background(), v)
+}
+
+// GetSolidFireVolumeSizeRawWithContext is the same as GetSolidFireVolumeSizeRaw, but the request is cancelled when the context is done
+func (s *VolumeService) GetSolidFireVolumeSizeRawWithContext(ctx context.Context, v url.Values) (*GetSolidFireVolumeSizeResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "getSolidFireVolumeSize", "getsolidfirevolumesizeresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -928,6 +1669,16 @@ type GetUploadParamsForVolumeParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *GetUploadParamsForVolumeParams) Clone() *GetUploadParamsForVolumeParams {
+	c := &GetUploadParamsForVolumeParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *GetUploadParamsForVolumeParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -963,6 +1714,16 @@ func (p *GetUploadParamsForVolumeParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *GetUploadParamsForVolumeParams) validate() error {
+	for _, k := range []string{"format", "name", "zoneid"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of getUploadParamsForVolume", k)
+		}
+	}
+	return nil
+}
+
 func (p *GetUploadParamsForVolumeParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -971,6 +1732,13 @@ func (p *GetUploadParamsForVolumeParams) SetAccount(v string) {
 	return
 }
 
+// SetAccountIfUnset sets account to v, unless it is already set
+func (p *GetUploadParamsForVolumeParams) SetAccountIfUnset(v string) {
+	if _, found := p.p["account"]; !found {
+		p.SetAccount(v)
+	}
+}
+
 func (p *GetUploadParamsForVolumeParams) SetChecksum(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -979,6 +1747,13 @@ func (p *GetUploadParamsForVolumeParams) SetChecksum(v string) {
 	return
 }
 
+// SetChecksumIfUnset sets checksum to v, unless it is already set
+func (p *GetUploadParamsForVolumeParams) SetChecksumIfUnset(v string) {
+	if _, found := p.p["checksum"]; !found {
+		p.SetChecksum(v)
+	}
+}
+
 func (p *GetUploadParamsForVolumeParams) SetDiskofferingid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -987,6 +1762,13 @@ func (p *GetUploadParamsForVolumeParams) SetDiskofferingid(v string) {
 	return
 }
 
+// SetDiskofferingidIfUnset sets diskofferingid to v, unless it is already set
+func (p *GetUploadParamsForVolumeParams) SetDiskofferingidIfUnset(v string) {
+	if _, found := p.p["diskofferingid"]; !found {
+		p.SetDiskofferingid(v)
+	}
+}
+
 func (p *GetUploadParamsForVolumeParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -995,6 +1777,13 @@ func (p *GetUploadParamsForVolumeParams) SetDomainid(v string) {
 	return
 }
 
+// SetDomainidIfUnset sets domainid to v, unless it is already set
+func (p *GetUploadParamsForVolumeParams) SetDomainidIfUnset(v string) {
+	if _, found := p.p["domainid"]; !found {
+		p.SetDomainid(v)
+	}
+}
+
 func (p *GetUploadParamsForVolumeParams) SetFormat(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1003,6 +1792,13 @@ func (p *GetUploadParamsForVolumeParams) SetFormat(v string) {
 	return
 }
 
+// SetFormatIfUnset sets format to v, unless it is already set
+func (p *GetUploadParamsForVolumeParams) SetFormatIfUnset(v string) {
+	if _, found := p.p["format"]; !found {
+		p.SetFormat(v)
+	}
+}
+
 func (p *GetUploadParamsForVolumeParams) SetImagestoreuuid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1011,6 +1807,13 @@ func (p *GetUploadParamsForVolumeParams) SetImagestoreuuid(v string) {
 	return
 }
 
+// SetImagestoreuuidIfUnset sets imagestoreuuid to v, unless it is already set
+func (p *GetUploadParamsForVolumeParams) SetImagestoreuuidIfUnset(v string) {
+	if _, found := p.p["imagestoreuuid"]; !found {
+		p.SetImagestoreuuid(v)
+	}
+}
+
 func (p *GetUploadParamsForVolumeParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1019,6 +1822,13 @@ func (p *GetUploadParamsForVolumeParams) SetName(v string) {
 	return
 }
 
+// SetNameIfUnset sets name to v, unless it is already set
+func (p *GetUploadParamsForVolumeParams) SetNameIfUnset(v string) {
+	if _, found := p.p["name"]; !found {
+		p.SetName(v)
+	}
+}
+
 func (p *GetUploadParamsForVolumeParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1027,6 +1837,13 @@ func (p *GetUploadParamsForVolumeParams) SetProjectid(v string) {
 	return
 }
 
+// SetProjectidIfUnset sets projectid to v, unless it is already set
+func (p *GetUploadParamsForVolumeParams) SetProjectidIfUnset(v string) {
+	if _, found := p.p["projectid"]; !found {
+		p.SetProjectid(v)
+	}
+}
+
 func (p *GetUploadParamsForVolumeParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1035,20 +1852,95 @@ func (p *GetUploadParamsForVolumeParams) SetZoneid(v string) {
 	return
 }
 
+// SetZoneidIfUnset sets zoneid to v, unless it is already set
+func (p *GetUploadParamsForVolumeParams) SetZoneidIfUnset(v string) {
+	if _, found := p.p["zoneid"]; !found {
+		p.SetZoneid(v)
+	}
+}
+
 // You should always use this function to get a new GetUploadParamsForVolumeParams instance,
-// as then you are sure you have configured all required params
-func (s *VolumeService) NewGetUploadParamsForVolumeParams(format string, name string, zoneid string) *GetUploadParamsForVolumeParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *VolumeService) NewGetUploadParamsForVolumeParams(format string, name string, zoneid string, opts ...func(*GetUploadParamsForVolumeParams)) *GetUploadParamsForVolumeParams {
 	p := &GetUploadParamsForVolumeParams{}
 	p.p = make(map[string]interface{})
 	p.p["format"] = format
 	p.p["name"] = name
 	p.p["zoneid"] = zoneid
+	for _, fn := range opts {
+		fn(p)
+	}
+	return p
+}
+
+// GetUploadParamsForVolumeOpts contains all params that can be set on a GetUploadParamsForVolumeParams.
+// Optional params are pointers (or slices and maps) which are only set when not nil.
+type GetUploadParamsForVolumeOpts struct {
+	Account        *string
+	Checksum       *string
+	Diskofferingid *string
+	Domainid       *string
+	Format         string
+	Imagestoreuuid *string
+	Name           string
+	Projectid      *string
+	Zoneid         string
+}
+
+// NewGetUploadParamsForVolumeParamsFromOpts is an alternative for NewGetUploadParamsForVolumeParams which takes all params using
+// an options struct, as that is easier to read for APIs with many required params
+func (s *VolumeService) NewGetUploadParamsForVolumeParamsFromOpts(o GetUploadParamsForVolumeOpts) *GetUploadParamsForVolumeParams {
+	p := s.NewGetUploadParamsForVolumeParams(o.Format, o.Name, o.Zoneid)
+	if o.Account != nil {
+		p.p["account"] = *o.Account
+	}
+	if o.Checksum != nil {
+		p.p["checksum"] = *o.Checksum
+	}
+	if o.Diskofferingid != nil {
+		p.p["diskofferingid"] = *o.Diskofferingid
+	}
+	if o.Domainid != nil {
+		p.p["domainid"] = *o.Domainid
+	}
+	if o.Imagestoreuuid != nil {
+		p.p["imagestoreuuid"] = *o.Imagestoreuuid
+	}
+	if o.Projectid != nil {
+		p.p["projectid"] = *o.Projectid
+	}
 	return p
 }
 
 // Upload a data disk to the cloudstack cloud.
-func (s *VolumeService) GetUploadParamsForVolume(p *GetUploadParamsForVolumeParams) (*GetUploadParamsForVolumeResponse, error) {
-	resp, err := s.cs.newRequest("getUploadParamsForVolume", p.toURLValues())
+func (s *VolumeService) GetUploadParamsForVolume(p *GetUploadParamsForVolumeParams, opts ...OptionFunc) (*GetUploadParamsForVolumeResponse, error) {
+	return s.GetUploadParamsForVolumeWithContext(context.Background(), p, opts...)
+}
+
+// GetUploadParamsForVolumeWithContext is the same as GetUploadParamsForVolume, but the request is cancelled when the context is done
+func (s *VolumeService) GetUploadParamsForVolumeWithContext(ctx context.Context, p *GetUploadParamsForVolumeParams, opts ...OptionFunc) (*GetUploadParamsForVolumeResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.GetUploadParamsForVolumeRawWithContext(ctx, p.toURLValues())
+}
+
+// GetUploadParamsForVolumeRaw is the same as GetUploadParamsForVolume, but takes the params as url.Values instead of typed params
+func (s *VolumeService) GetUploadParamsForVolumeRaw(v url.Values) (*GetUploadParamsForVolumeResponse, error) {
+	return s.GetUploadParamsForVolumeRawWithContext(context.Background(), v)
+}
+
+// GetUploadParamsForVolumeRawWithContext is the same as GetUploadParamsForVolumeRaw, but the request is cancelled when the context is done
+func (s *VolumeService) GetUploadParamsForVolumeRawWithContext(ctx context.Context, v url.Values) (*GetUploadParamsForVolumeResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "getUploadParamsForVolume", "postuploadvolumeresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -1073,6 +1965,16 @@ type GetVolumeiScsiNameParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *GetVolumeiScsiNameParams) Clone() *GetVolumeiScsiNameParams {
+	c := &GetVolumeiScsiNameParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *GetVolumeiScsiNameParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1084,6 +1986,16 @@ func (p *GetVolumeiScsiNameParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *GetVolumeiScsiNameParams) validate() error {
+	for _, k := range []string{"volumeid"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of getVolumeiScsiName", k)
+		}
+	}
+	return nil
+}
+
 func (p *GetVolumeiScsiNameParams) SetVolumeid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1092,18 +2004,54 @@ func (p *GetVolumeiScsiNameParams) SetVolumeid(v string) {
 	return
 }
 
+// SetVolumeidIfUnset sets volumeid to v, unless it is already set
+func (p *GetVolumeiScsiNameParams) SetVolumeidIfUnset(v string) {
+	if _, found := p.p["volumeid"]; !found {
+		p.SetVolumeid(v)
+	}
+}
+
 // You should always use this function to get a new GetVolumeiScsiNameParams instance,
-// as then you are sure you have configured all required params
-func (s *VolumeService) NewGetVolumeiScsiNameParams(volumeid string) *GetVolumeiScsiNameParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *VolumeService) NewGetVolumeiScsiNameParams(volumeid string, opts ...func(*GetVolumeiScsiNameParams)) *GetVolumeiScsiNameParams {
 	p := &GetVolumeiScsiNameParams{}
 	p.p = make(map[string]interface{})
 	p.p["volumeid"] = volumeid
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Get Volume's iSCSI Name
-func (s *VolumeService) GetVolumeiScsiName(p *GetVolumeiScsiNameParams) (*GetVolumeiScsiNameResponse, error) {
-	resp, err := s.cs.newRequest("getVolumeiScsiName", p.toURLValues())
+func (s *VolumeService) GetVolumeiScsiName(p *GetVolumeiScsiNameParams, opts ...OptionFunc) (*GetVolumeiScsiNameResponse, error) {
+	return s.GetVolumeiScsiNameWithContext(context.Background(), p, opts...)
+}
+
+// GetVolumeiScsiNameWithContext is the same as GetVolumeiScsiName, but the request is cancelled when the context is done
+func (s *VolumeService) GetVolumeiScsiNameWithContext(ctx context.Context, p *GetVolumeiScsiNameParams, opts ...OptionFunc) (*GetVolumeiScsiNameResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.GetVolumeiScsiNameRawWithContext(ctx, p.toURLValues())
+}
+
+// GetVolumeiScsiNameRaw is the same as GetVolumeiScsiName, but takes the params as url.Values instead of typed params
+func (s *VolumeService) GetVolumeiScsiNameRaw(v url.Values) (*GetVolumeiScsiNameResponse, error) {
+	return s.GetVolumeiScsiNameRawWithContext(context.Background(), v)
+}
+
+// GetVolumeiScsiNameRawWithContext is the same as GetVolumeiScsiNameRaw, but the request is cancelled when the context is done
+func (s *VolumeService) GetVolumeiScsiNameRawWithContext(ctx context.Context, v url.Values) (*GetVolumeiScsiNameResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "getVolumeiScsiName", "getvolumeiscsinameresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -1124,6 +2072,16 @@ type ListVolumesParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ListVolumesParams) Clone() *ListVolumesParams {
+	c := &ListVolumesParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListVolumesParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1184,11 +2142,10 @@ func (p *ListVolumesParams) toURLValues() url.Values {
 		u.Set("storageid", v.(string))
 	}
 	if v, found := p.p["tags"]; found {
-		i := 0
-		for k, vv := range v.(map[string]string) {
+		m := v.(map[string]string)
+		for i, k := range getSortedKeysFromMap(m) {
 			u.Set(fmt.Sprintf("tags[%d].key", i), k)
-			u.Set(fmt.Sprintf("tags[%d].value", i), vv)
-			i++
+			u.Set(fmt.Sprintf("tags[%d].value", i), m[k])
 		}
 	}
 	if v, found := p.p["type"]; found {
@@ -1211,6 +2168,13 @@ func (p *ListVolumesParams) SetAccount(v string) {
 	return
 }
 
+// SetAccountIfUnset sets account to v, unless it is already set
+func (p *ListVolumesParams) SetAccountIfUnset(v string) {
+	if _, found := p.p["account"]; !found {
+		p.SetAccount(v)
+	}
+}
+
 func (p *ListVolumesParams) SetDiskofferingid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1219,6 +2183,13 @@ func (p *ListVolumesParams) SetDiskofferingid(v string) {
 	return
 }
 
+// SetDiskofferingidIfUnset sets diskofferingid to v, unless it is already set
+func (p *ListVolumesParams) SetDiskofferingidIfUnset(v string) {
+	if _, found := p.p["diskofferingid"]; !found {
+		p.SetDiskofferingid(v)
+	}
+}
+
 func (p *ListVolumesParams) SetDisplayvolume(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1227,6 +2198,13 @@ func (p *ListVolumesParams) SetDisplayvolume(v bool) {
 	return
 }
 
+// SetDisplayvolumeIfUnset sets displayvolume to v, unless it is already set
+func (p *ListVolumesParams) SetDisplayvolumeIfUnset(v bool) {
+	if _, found := p.p["displayvolume"]; !found {
+		p.SetDisplayvolume(v)
+	}
+}
+
 func (p *ListVolumesParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1235,6 +2213,13 @@ func (p *ListVolumesParams) SetDomainid(v string) {
 	return
 }
 
+// SetDomainidIfUnset sets domainid to v, unless it is already set
+func (p *ListVolumesParams) SetDomainidIfUnset(v string) {
+	if _, found := p.p["domainid"]; !found {
+		p.SetDomainid(v)
+	}
+}
+
 func (p *ListVolumesParams) SetHostid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1243,6 +2228,13 @@ func (p *ListVolumesParams) SetHostid(v string) {
 	return
 }
 
+// SetHostidIfUnset sets hostid to v, unless it is already set
+func (p *ListVolumesParams) SetHostidIfUnset(v string) {
+	if _, found := p.p["hostid"]; !found {
+		p.SetHostid(v)
+	}
+}
+
 func (p *ListVolumesParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1251,6 +2243,13 @@ func (p *ListVolumesParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *ListVolumesParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 func (p *ListVolumesParams) SetIds(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1259,6 +2258,18 @@ func (p *ListVolumesParams) SetIds(v []string) {
 	return
 }
 
+// SetIdsIfUnset sets ids to v, unless it is already set
+func (p *ListVolumesParams) SetIdsIfUnset(v []string) {
+	if _, found := p.p["ids"]; !found {
+		p.SetIds(v)
+	}
+}
+
+// SetIdsCSV splits v on commas and sets the resulting values
+func (p *ListVolumesParams) SetIdsCSV(v string) {
+	p.SetIds(splitCSV(v))
+}
+
 func (p *ListVolumesParams) SetIsrecursive(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1267,6 +2278,13 @@ func (p *ListVolumesParams) SetIsrecursive(v bool) {
 	return
 }
 
+// SetIsrecursiveIfUnset sets isrecursive to v, unless it is already set
+func (p *ListVolumesParams) SetIsrecursiveIfUnset(v bool) {
+	if _, found := p.p["isrecursive"]; !found {
+		p.SetIsrecursive(v)
+	}
+}
+
 func (p *ListVolumesParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1275,6 +2293,13 @@ func (p *ListVolumesParams) SetKeyword(v string) {
 	return
 }
 
+// SetKeywordIfUnset sets keyword to v, unless it is already set
+func (p *ListVolumesParams) SetKeywordIfUnset(v string) {
+	if _, found := p.p["keyword"]; !found {
+		p.SetKeyword(v)
+	}
+}
+
 func (p *ListVolumesParams) SetListall(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1283,6 +2308,13 @@ func (p *ListVolumesParams) SetListall(v bool) {
 	return
 }
 
+// SetListallIfUnset sets listall to v, unless it is already set
+func (p *ListVolumesParams) SetListallIfUnset(v bool) {
+	if _, found := p.p["listall"]; !found {
+		p.SetListall(v)
+	}
+}
+
 func (p *ListVolumesParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1291,6 +2323,13 @@ func (p *ListVolumesParams) SetName(v string) {
 	return
 }
 
+// SetNameIfUnset sets name to v, unless it is already set
+func (p *ListVolumesParams) SetNameIfUnset(v string) {
+	if _, found := p.p["name"]; !found {
+		p.SetName(v)
+	}
+}
+
 func (p *ListVolumesParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1299,6 +2338,13 @@ func (p *ListVolumesParams) SetPage(v int) {
 	return
 }
 
+// SetPageIfUnset sets page to v, unless it is already set
+func (p *ListVolumesParams) SetPageIfUnset(v int) {
+	if _, found := p.p["page"]; !found {
+		p.SetPage(v)
+	}
+}
+
 func (p *ListVolumesParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1307,6 +2353,13 @@ func (p *ListVolumesParams) SetPagesize(v int) {
 	return
 }
 
+// SetPagesizeIfUnset sets pagesize to v, unless it is already set
+func (p *ListVolumesParams) SetPagesizeIfUnset(v int) {
+	if _, found := p.p["pagesize"]; !found {
+		p.SetPagesize(v)
+	}
+}
+
 func (p *ListVolumesParams) SetPodid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1315,6 +2368,13 @@ func (p *ListVolumesParams) SetPodid(v string) {
 	return
 }
 
+// SetPodidIfUnset sets podid to v, unless it is already set
+func (p *ListVolumesParams) SetPodidIfUnset(v string) {
+	if _, found := p.p["podid"]; !found {
+		p.SetPodid(v)
+	}
+}
+
 func (p *ListVolumesParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1323,6 +2383,13 @@ func (p *ListVolumesParams) SetProjectid(v string) {
 	return
 }
 
+// SetProjectidIfUnset sets projectid to v, unless it is already set
+func (p *ListVolumesParams) SetProjectidIfUnset(v string) {
+	if _, found := p.p["projectid"]; !found {
+		p.SetProjectid(v)
+	}
+}
+
 func (p *ListVolumesParams) SetStorageid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1331,6 +2398,13 @@ func (p *ListVolumesParams) SetStorageid(v string) {
 	return
 }
 
+// SetStorageidIfUnset sets storageid to v, unless it is already set
+func (p *ListVolumesParams) SetStorageidIfUnset(v string) {
+	if _, found := p.p["storageid"]; !found {
+		p.SetStorageid(v)
+	}
+}
+
 func (p *ListVolumesParams) SetTags(v map[string]string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1339,6 +2413,13 @@ func (p *ListVolumesParams) SetTags(v map[string]string) {
 	return
 }
 
+// SetTagsIfUnset sets tags to v, unless it is already set
+func (p *ListVolumesParams) SetTagsIfUnset(v map[string]string) {
+	if _, found := p.p["tags"]; !found {
+		p.SetTags(v)
+	}
+}
+
 func (p *ListVolumesParams) SetType(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1347,6 +2428,13 @@ func (p *ListVolumesParams) SetType(v string) {
 	return
 }
 
+// SetTypeIfUnset sets type to v, unless it is already set
+func (p *ListVolumesParams) SetTypeIfUnset(v string) {
+	if _, found := p.p["type"]; !found {
+		p.SetType(v)
+	}
+}
+
 func (p *ListVolumesParams) SetVirtualmachineid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1355,6 +2443,13 @@ func (p *ListVolumesParams) SetVirtualmachineid(v string) {
 	return
 }
 
+// SetVirtualmachineidIfUnset sets virtualmachineid to v, unless it is already set
+func (p *ListVolumesParams) SetVirtualmachineidIfUnset(v string) {
+	if _, found := p.p["virtualmachineid"]; !found {
+		p.SetVirtualmachineid(v)
+	}
+}
+
 func (p *ListVolumesParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1363,14 +2458,34 @@ func (p *ListVolumesParams) SetZoneid(v string) {
 	return
 }
 
+// SetZoneidIfUnset sets zoneid to v, unless it is already set
+func (p *ListVolumesParams) SetZoneidIfUnset(v string) {
+	if _, found := p.p["zoneid"]; !found {
+		p.SetZoneid(v)
+	}
+}
+
+var _ ListAllSetter = (*ListVolumesParams)(nil)
+
 // You should always use this function to get a new ListVolumesParams instance,
-// as then you are sure you have configured all required params
-func (s *VolumeService) NewListVolumesParams() *ListVolumesParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *VolumeService) NewListVolumesParams(opts ...func(*ListVolumesParams)) *ListVolumesParams {
 	p := &ListVolumesParams{}
 	p.p = make(map[string]interface{})
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
+func (p *ListVolumesParams) setFirstMatch() {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p[firstMatchKey] = true
+}
+
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *VolumeService) GetVolumeID(name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListVolumesParams{}
@@ -1378,7 +2493,7 @@ func (s *VolumeService) GetVolumeID(name string, opts ...OptionFunc) (string, in
 
 	p.p["name"] = name
 
-	for _, fn := range append(s.cs.options, opts...) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
 		if err := fn(s.cs, p); err != nil {
 			return "", -1, err
 		}
@@ -1390,7 +2505,7 @@ func (s *VolumeService) GetVolumeID(name string, opts ...OptionFunc) (string, in
 	}
 
 	if l.Count == 0 {
-		return "", l.Count, fmt.Errorf("No match found for %s: %+v", name, l)
+		return "", l.Count, fmt.Errorf("%w for %s: %+v", ErrNotFound, name, l)
 	}
 
 	if l.Count == 1 {
@@ -1403,6 +2518,10 @@ func (s *VolumeService) GetVolumeID(name string, opts ...OptionFunc) (string, in
 				return v.Id, l.Count, nil
 			}
 		}
+
+		if _, ok := p.p[firstMatchKey]; ok && len(l.Volumes) > 0 {
+			return l.Volumes[0].Id, l.Count, nil
+		}
 	}
 	return "", l.Count, fmt.Errorf("Could not find an exact match for %s: %+v", name, l)
 }
@@ -1428,7 +2547,7 @@ func (s *VolumeService) GetVolumeByID(id string, opts ...OptionFunc) (*Volume, i
 
 	p.p["id"] = id
 
-	for _, fn := range append(s.cs.options, opts...) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
 		if err := fn(s.cs, p); err != nil {
 			return nil, -1, err
 		}
@@ -1439,24 +2558,60 @@ func (s *VolumeService) GetVolumeByID(id string, opts ...OptionFunc) (*Volume, i
 		if strings.Contains(err.Error(), fmt.Sprintf(
 			"Invalid parameter id value=%s due to incorrect long value format, "+
 				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+			return nil, 0, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
 	}
 
 	if l.Count == 1 {
 		return l.Volumes[0], l.Count, nil
 	}
+
+	if _, ok := p.p[firstMatchKey]; ok && len(l.Volumes) > 0 {
+		return l.Volumes[0], l.Count, nil
+	}
 	return nil, l.Count, fmt.Errorf("There is more then one result for Volume UUID: %s!", id)
 }
 
+// VolumeExists returns true if a Volume with the given ID exists. When no match is found
+// it returns false, while any other error is returned as is.
+func (s *VolumeService) VolumeExists(id string, opts ...OptionFunc) (bool, error) {
+	_, count, err := s.GetVolumeByID(id, opts...)
+	if errors.Is(err, ErrNotFound) {
+		return false, nil
+	}
+	if count > 0 {
+		return true, nil
+	}
+	return false, err
+}
+
 // Lists all volumes.
 func (s *VolumeService) ListVolumes(p *ListVolumesParams) (*ListVolumesResponse, error) {
-	resp, err := s.cs.newRequest("listVolumes", p.toURLValues())
+	return s.ListVolumesWithContext(context.Background(), p)
+}
+
+// ListVolumesWithContext is the same as ListVolumes, but the request is cancelled when the context is done
+func (s *VolumeService) ListVolumesWithContext(ctx context.Context, p *ListVolumesParams) (*ListVolumesResponse, error) {
+	if err := checkMutuallyExclusive(p.p, "account", "projectid"); err != nil {
+		return nil, err
+	}
+
+	return s.ListVolumesRawWithContext(ctx, p.toURLValues())
+}
+
+// ListVolumesRaw is the same as ListVolumes, but takes the params as url.Values instead of typed params
+func (s *VolumeService) ListVolumesRaw(v url.Values) (*ListVolumesResponse, error) {
+	return s.ListVolumesRawWithContext(context.Background(), v)
+}
+
+// ListVolumesRawWithContext is the same as ListVolumesRaw, but the request is cancelled when the context is done
+func (s *VolumeService) ListVolumesRawWithContext(ctx context.Context, v url.Values) (*ListVolumesResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "listVolumes", "listvolumesresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -1469,6 +2624,93 @@ func (s *VolumeService) ListVolumes(p *ListVolumesParams) (*ListVolumesResponse,
 	return &r, nil
 }
 
+// StreamVolumes fetches the results of ListVolumes page by page in the background and sends them on the
+// returned channel. Both returned channels are closed when done. If fetching a page fails, or the context
+// is done before all results are sent, the error is sent on the error channel and fetching stops. A
+// request for a page in flight is cancelled when the context is done. The page and pagesize set in the
+// params are ignored.
+func (s *VolumeService) StreamVolumes(ctx context.Context, p *ListVolumesParams) (<-chan *Volume, <-chan error) {
+	ch := make(chan *Volume)
+	errs := make(chan error, 1)
+
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesize(500)
+
+	go func() {
+		defer close(errs)
+		defer close(ch)
+
+		for page, count := 1, 0; ; page++ {
+			pp.SetPage(page)
+
+			l, err := s.ListVolumesWithContext(ctx, pp)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			for _, v := range l.Volumes {
+				select {
+				case ch <- v:
+				case <-ctx.Done():
+					errs <- ctx.Err()
+					return
+				}
+			}
+
+			count += len(l.Volumes)
+			if len(l.Volumes) < 500 || count >= l.Count {
+				return
+			}
+		}
+	}()
+
+	return ch, errs
+}
+
+// ListVolumesWhere returns all results of ListVolumes for which pred returns true. Note that the results are
+// filtered client-side, so all results matching the params are fetched first.
+// All pages are fetched, so the page and pagesize set in the params are ignored.
+func (s *VolumeService) ListVolumesWhere(p *ListVolumesParams, pred func(*Volume) bool) ([]*Volume, error) {
+	var r []*Volume
+	ch, errs := s.StreamVolumes(context.Background(), p)
+	for v := range ch {
+		if pred(v) {
+			r = append(r, v)
+		}
+	}
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ListAllVolumes returns the results of ListVolumes across all pages, by calling ListVolumes page by page until all
+// results are fetched. The pagesize set in the params is used for every page (500 if not set), and the
+// page set in the params is ignored.
+func (s *VolumeService) ListAllVolumes(p *ListVolumesParams) ([]*Volume, error) {
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesizeIfUnset(500)
+	pagesize := pp.p["pagesize"].(int)
+
+	var r []*Volume
+	for page := 1; ; page++ {
+		pp.SetPage(page)
+
+		l, err := s.ListVolumes(pp)
+		if err != nil {
+			return nil, err
+		}
+		r = append(r, l.Volumes...)
+
+		if len(l.Volumes) < pagesize || len(r) >= l.Count {
+			return r, nil
+		}
+	}
+}
+
 type ListVolumesResponse struct {
 	Count   int       `json:"count"`
 	Volumes []*Volume `json:"volume"`
@@ -1531,6 +2773,16 @@ type MigrateVolumeParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *MigrateVolumeParams) Clone() *MigrateVolumeParams {
+	c := &MigrateVolumeParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *MigrateVolumeParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1549,6 +2801,16 @@ func (p *MigrateVolumeParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *MigrateVolumeParams) validate() error {
+	for _, k := range []string{"storageid", "volumeid"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of migrateVolume", k)
+		}
+	}
+	return nil
+}
+
 func (p *MigrateVolumeParams) SetLivemigrate(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1557,6 +2819,13 @@ func (p *MigrateVolumeParams) SetLivemigrate(v bool) {
 	return
 }
 
+// SetLivemigrateIfUnset sets livemigrate to v, unless it is already set
+func (p *MigrateVolumeParams) SetLivemigrateIfUnset(v bool) {
+	if _, found := p.p["livemigrate"]; !found {
+		p.SetLivemigrate(v)
+	}
+}
+
 func (p *MigrateVolumeParams) SetStorageid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1565,6 +2834,13 @@ func (p *MigrateVolumeParams) SetStorageid(v string) {
 	return
 }
 
+// SetStorageidIfUnset sets storageid to v, unless it is already set
+func (p *MigrateVolumeParams) SetStorageidIfUnset(v string) {
+	if _, found := p.p["storageid"]; !found {
+		p.SetStorageid(v)
+	}
+}
+
 func (p *MigrateVolumeParams) SetVolumeid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1573,19 +2849,55 @@ func (p *MigrateVolumeParams) SetVolumeid(v string) {
 	return
 }
 
+// SetVolumeidIfUnset sets volumeid to v, unless it is already set
+func (p *MigrateVolumeParams) SetVolumeidIfUnset(v string) {
+	if _, found := p.p["volumeid"]; !found {
+		p.SetVolumeid(v)
+	}
+}
+
 // You should always use this function to get a new MigrateVolumeParams instance,
-// as then you are sure you have configured all required params
-func (s *VolumeService) NewMigrateVolumeParams(storageid string, volumeid string) *MigrateVolumeParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *VolumeService) NewMigrateVolumeParams(storageid string, volumeid string, opts ...func(*MigrateVolumeParams)) *MigrateVolumeParams {
 	p := &MigrateVolumeParams{}
 	p.p = make(map[string]interface{})
 	p.p["storageid"] = storageid
 	p.p["volumeid"] = volumeid
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Migrate volume
-func (s *VolumeService) MigrateVolume(p *MigrateVolumeParams) (*MigrateVolumeResponse, error) {
-	resp, err := s.cs.newRequest("migrateVolume", p.toURLValues())
+func (s *VolumeService) MigrateVolume(p *MigrateVolumeParams, opts ...OptionFunc) (*MigrateVolumeResponse, error) {
+	return s.MigrateVolumeWithContext(context.Background(), p, opts...)
+}
+
+// MigrateVolumeWithContext is the same as MigrateVolume, but the request is cancelled when the context is done
+func (s *VolumeService) MigrateVolumeWithContext(ctx context.Context, p *MigrateVolumeParams, opts ...OptionFunc) (*MigrateVolumeResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.MigrateVolumeRawWithContext(ctx, p.toURLValues())
+}
+
+// MigrateVolumeRaw is the same as MigrateVolume, but takes the params as url.Values instead of typed params
+func (s *VolumeService) MigrateVolumeRaw(v url.Values) (*MigrateVolumeResponse, error) {
+	return s.MigrateVolumeRawWithContext(context.Background(), v)
+}
+
+// MigrateVolumeRawWithContext is the same as MigrateVolumeRaw, but the request is cancelled when the context is done
+func (s *VolumeService) MigrateVolumeRawWithContext(ctx context.Context, v url.Values) (*MigrateVolumeResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "migrateVolume", "migratevolumeresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -1597,9 +2909,9 @@ func (s *VolumeService) MigrateVolume(p *MigrateVolumeParams) (*MigrateVolumeRes
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -1676,6 +2988,16 @@ type ResizeVolumeParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ResizeVolumeParams) Clone() *ResizeVolumeParams {
+	c := &ResizeVolumeParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ResizeVolumeParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1706,6 +3028,16 @@ func (p *ResizeVolumeParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *ResizeVolumeParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of resizeVolume", k)
+		}
+	}
+	return nil
+}
+
 func (p *ResizeVolumeParams) SetDiskofferingid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1714,6 +3046,13 @@ func (p *ResizeVolumeParams) SetDiskofferingid(v string) {
 	return
 }
 
+// SetDiskofferingidIfUnset sets diskofferingid to v, unless it is already set
+func (p *ResizeVolumeParams) SetDiskofferingidIfUnset(v string) {
+	if _, found := p.p["diskofferingid"]; !found {
+		p.SetDiskofferingid(v)
+	}
+}
+
 func (p *ResizeVolumeParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1722,6 +3061,13 @@ func (p *ResizeVolumeParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *ResizeVolumeParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 func (p *ResizeVolumeParams) SetMaxiops(v int64) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1730,6 +3076,13 @@ func (p *ResizeVolumeParams) SetMaxiops(v int64) {
 	return
 }
 
+// SetMaxiopsIfUnset sets maxiops to v, unless it is already set
+func (p *ResizeVolumeParams) SetMaxiopsIfUnset(v int64) {
+	if _, found := p.p["maxiops"]; !found {
+		p.SetMaxiops(v)
+	}
+}
+
 func (p *ResizeVolumeParams) SetMiniops(v int64) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1738,6 +3091,13 @@ func (p *ResizeVolumeParams) SetMiniops(v int64) {
 	return
 }
 
+// SetMiniopsIfUnset sets miniops to v, unless it is already set
+func (p *ResizeVolumeParams) SetMiniopsIfUnset(v int64) {
+	if _, found := p.p["miniops"]; !found {
+		p.SetMiniops(v)
+	}
+}
+
 func (p *ResizeVolumeParams) SetShrinkok(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1746,6 +3106,13 @@ func (p *ResizeVolumeParams) SetShrinkok(v bool) {
 	return
 }
 
+// SetShrinkokIfUnset sets shrinkok to v, unless it is already set
+func (p *ResizeVolumeParams) SetShrinkokIfUnset(v bool) {
+	if _, found := p.p["shrinkok"]; !found {
+		p.SetShrinkok(v)
+	}
+}
+
 func (p *ResizeVolumeParams) SetSize(v int64) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1754,18 +3121,54 @@ func (p *ResizeVolumeParams) SetSize(v int64) {
 	return
 }
 
+// SetSizeIfUnset sets size to v, unless it is already set
+func (p *ResizeVolumeParams) SetSizeIfUnset(v int64) {
+	if _, found := p.p["size"]; !found {
+		p.SetSize(v)
+	}
+}
+
 // You should always use this function to get a new ResizeVolumeParams instance,
-// as then you are sure you have configured all required params
-func (s *VolumeService) NewResizeVolumeParams(id string) *ResizeVolumeParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *VolumeService) NewResizeVolumeParams(id string, opts ...func(*ResizeVolumeParams)) *ResizeVolumeParams {
 	p := &ResizeVolumeParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Resizes a volume
-func (s *VolumeService) ResizeVolume(p *ResizeVolumeParams) (*ResizeVolumeResponse, error) {
-	resp, err := s.cs.newRequest("resizeVolume", p.toURLValues())
+func (s *VolumeService) ResizeVolume(p *ResizeVolumeParams, opts ...OptionFunc) (*ResizeVolumeResponse, error) {
+	return s.ResizeVolumeWithContext(context.Background(), p, opts...)
+}
+
+// ResizeVolumeWithContext is the same as ResizeVolume, but the request is cancelled when the context is done
+func (s *VolumeService) ResizeVolumeWithContext(ctx context.Context, p *ResizeVolumeParams, opts ...OptionFunc) (*ResizeVolumeResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.ResizeVolumeRawWithContext(ctx, p.toURLValues())
+}
+
+// ResizeVolumeRaw is the same as ResizeVolume, but takes the params as url.Values instead of typed params
+func (s *VolumeService) ResizeVolumeRaw(v url.Values) (*ResizeVolumeResponse, error) {
+	return s.ResizeVolumeRawWithContext(context.Background(), v)
+}
+
+// ResizeVolumeRawWithContext is the same as ResizeVolumeRaw, but the request is cancelled when the context is done
+func (s *VolumeService) ResizeVolumeRawWithContext(ctx context.Context, v url.Values) (*ResizeVolumeResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "resizeVolume", "resizevolumeresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -1777,9 +3180,9 @@ func (s *VolumeService) ResizeVolume(p *ResizeVolumeParams) (*ResizeVolumeRespon
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -1856,6 +3259,16 @@ type UpdateVolumeParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *UpdateVolumeParams) Clone() *UpdateVolumeParams {
+	c := &UpdateVolumeParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *UpdateVolumeParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1894,6 +3307,13 @@ func (p *UpdateVolumeParams) SetChaininfo(v string) {
 	return
 }
 
+// SetChaininfoIfUnset sets chaininfo to v, unless it is already set
+func (p *UpdateVolumeParams) SetChaininfoIfUnset(v string) {
+	if _, found := p.p["chaininfo"]; !found {
+		p.SetChaininfo(v)
+	}
+}
+
 func (p *UpdateVolumeParams) SetCustomid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1902,6 +3322,13 @@ func (p *UpdateVolumeParams) SetCustomid(v string) {
 	return
 }
 
+// SetCustomidIfUnset sets customid to v, unless it is already set
+func (p *UpdateVolumeParams) SetCustomidIfUnset(v string) {
+	if _, found := p.p["customid"]; !found {
+		p.SetCustomid(v)
+	}
+}
+
 func (p *UpdateVolumeParams) SetDisplayvolume(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1910,6 +3337,13 @@ func (p *UpdateVolumeParams) SetDisplayvolume(v bool) {
 	return
 }
 
+// SetDisplayvolumeIfUnset sets displayvolume to v, unless it is already set
+func (p *UpdateVolumeParams) SetDisplayvolumeIfUnset(v bool) {
+	if _, found := p.p["displayvolume"]; !found {
+		p.SetDisplayvolume(v)
+	}
+}
+
 func (p *UpdateVolumeParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1918,6 +3352,13 @@ func (p *UpdateVolumeParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *UpdateVolumeParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 func (p *UpdateVolumeParams) SetPath(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1926,6 +3367,13 @@ func (p *UpdateVolumeParams) SetPath(v string) {
 	return
 }
 
+// SetPathIfUnset sets path to v, unless it is already set
+func (p *UpdateVolumeParams) SetPathIfUnset(v string) {
+	if _, found := p.p["path"]; !found {
+		p.SetPath(v)
+	}
+}
+
 func (p *UpdateVolumeParams) SetState(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1934,6 +3382,13 @@ func (p *UpdateVolumeParams) SetState(v string) {
 	return
 }
 
+// SetStateIfUnset sets state to v, unless it is already set
+func (p *UpdateVolumeParams) SetStateIfUnset(v string) {
+	if _, found := p.p["state"]; !found {
+		p.SetState(v)
+	}
+}
+
 func (p *UpdateVolumeParams) SetStorageid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1942,17 +3397,49 @@ func (p *UpdateVolumeParams) SetStorageid(v string) {
 	return
 }
 
+// SetStorageidIfUnset sets storageid to v, unless it is already set
+func (p *UpdateVolumeParams) SetStorageidIfUnset(v string) {
+	if _, found := p.p["storageid"]; !found {
+		p.SetStorageid(v)
+	}
+}
+
 // You should always use this function to get a new UpdateVolumeParams instance,
-// as then you are sure you have configured all required params
-func (s *VolumeService) NewUpdateVolumeParams() *UpdateVolumeParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *VolumeService) NewUpdateVolumeParams(opts ...func(*UpdateVolumeParams)) *UpdateVolumeParams {
 	p := &UpdateVolumeParams{}
 	p.p = make(map[string]interface{})
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Updates the volume.
-func (s *VolumeService) UpdateVolume(p *UpdateVolumeParams) (*UpdateVolumeResponse, error) {
-	resp, err := s.cs.newRequest("updateVolume", p.toURLValues())
+func (s *VolumeService) UpdateVolume(p *UpdateVolumeParams, opts ...OptionFunc) (*UpdateVolumeResponse, error) {
+	return s.UpdateVolumeWithContext(context.Background(), p, opts...)
+}
+
+// UpdateVolumeWithContext is the same as UpdateVolume, but the request is cancelled when the context is done
+func (s *VolumeService) UpdateVolumeWithContext(ctx context.Context, p *UpdateVolumeParams, opts ...OptionFunc) (*UpdateVolumeResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	return s.UpdateVolumeRawWithContext(ctx, p.toURLValues())
+}
+
+// UpdateVolumeRaw is the same as UpdateVolume, but takes the params as url.Values instead of typed params
+func (s *VolumeService) UpdateVolumeRaw(v url.Values) (*UpdateVolumeResponse, error) {
+	return s.UpdateVolumeRawWithContext(context.Background(), v)
+}
+
+// UpdateVolumeRawWithContext is the same as UpdateVolumeRaw, but the request is cancelled when the context is done
+func (s *VolumeService) UpdateVolumeRawWithContext(ctx context.Context, v url.Values) (*UpdateVolumeResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "updateVolume", "updatevolumeresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -1964,9 +3451,9 @@ func (s *VolumeService) UpdateVolume(p *UpdateVolumeParams) (*UpdateVolumeRespon
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -2043,6 +3530,16 @@ type UploadVolumeParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *UploadVolumeParams) Clone() *UploadVolumeParams {
+	c := &UploadVolumeParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *UploadVolumeParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -2081,6 +3578,16 @@ func (p *UploadVolumeParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *UploadVolumeParams) validate() error {
+	for _, k := range []string{"format", "name", "url", "zoneid"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of uploadVolume", k)
+		}
+	}
+	return nil
+}
+
 func (p *UploadVolumeParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2089,6 +3596,13 @@ func (p *UploadVolumeParams) SetAccount(v string) {
 	return
 }
 
+// SetAccountIfUnset sets account to v, unless it is already set
+func (p *UploadVolumeParams) SetAccountIfUnset(v string) {
+	if _, found := p.p["account"]; !found {
+		p.SetAccount(v)
+	}
+}
+
 func (p *UploadVolumeParams) SetChecksum(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2097,6 +3611,13 @@ func (p *UploadVolumeParams) SetChecksum(v string) {
 	return
 }
 
+// SetChecksumIfUnset sets checksum to v, unless it is already set
+func (p *UploadVolumeParams) SetChecksumIfUnset(v string) {
+	if _, found := p.p["checksum"]; !found {
+		p.SetChecksum(v)
+	}
+}
+
 func (p *UploadVolumeParams) SetDiskofferingid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2105,6 +3626,13 @@ func (p *UploadVolumeParams) SetDiskofferingid(v string) {
 	return
 }
 
+// SetDiskofferingidIfUnset sets diskofferingid to v, unless it is already set
+func (p *UploadVolumeParams) SetDiskofferingidIfUnset(v string) {
+	if _, found := p.p["diskofferingid"]; !found {
+		p.SetDiskofferingid(v)
+	}
+}
+
 func (p *UploadVolumeParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2113,6 +3641,13 @@ func (p *UploadVolumeParams) SetDomainid(v string) {
 	return
 }
 
+// SetDomainidIfUnset sets domainid to v, unless it is already set
+func (p *UploadVolumeParams) SetDomainidIfUnset(v string) {
+	if _, found := p.p["domainid"]; !found {
+		p.SetDomainid(v)
+	}
+}
+
 func (p *UploadVolumeParams) SetFormat(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2121,6 +3656,13 @@ func (p *UploadVolumeParams) SetFormat(v string) {
 	return
 }
 
+// SetFormatIfUnset sets format to v, unless it is already set
+func (p *UploadVolumeParams) SetFormatIfUnset(v string) {
+	if _, found := p.p["format"]; !found {
+		p.SetFormat(v)
+	}
+}
+
 func (p *UploadVolumeParams) SetImagestoreuuid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2129,6 +3671,13 @@ func (p *UploadVolumeParams) SetImagestoreuuid(v string) {
 	return
 }
 
+// SetImagestoreuuidIfUnset sets imagestoreuuid to v, unless it is already set
+func (p *UploadVolumeParams) SetImagestoreuuidIfUnset(v string) {
+	if _, found := p.p["imagestoreuuid"]; !found {
+		p.SetImagestoreuuid(v)
+	}
+}
+
 func (p *UploadVolumeParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2137,6 +3686,13 @@ func (p *UploadVolumeParams) SetName(v string) {
 	return
 }
 
+// SetNameIfUnset sets name to v, unless it is already set
+func (p *UploadVolumeParams) SetNameIfUnset(v string) {
+	if _, found := p.p["name"]; !found {
+		p.SetName(v)
+	}
+}
+
 func (p *UploadVolumeParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2145,6 +3701,13 @@ func (p *UploadVolumeParams) SetProjectid(v string) {
 	return
 }
 
+// SetProjectidIfUnset sets projectid to v, unless it is already set
+func (p *UploadVolumeParams) SetProjectidIfUnset(v string) {
+	if _, found := p.p["projectid"]; !found {
+		p.SetProjectid(v)
+	}
+}
+
 func (p *UploadVolumeParams) SetUrl(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2153,6 +3716,13 @@ func (p *UploadVolumeParams) SetUrl(v string) {
 	return
 }
 
+// SetUrlIfUnset sets url to v, unless it is already set
+func (p *UploadVolumeParams) SetUrlIfUnset(v string) {
+	if _, found := p.p["url"]; !found {
+		p.SetUrl(v)
+	}
+}
+
 func (p *UploadVolumeParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2161,21 +3731,97 @@ func (p *UploadVolumeParams) SetZoneid(v string) {
 	return
 }
 
+// SetZoneidIfUnset sets zoneid to v, unless it is already set
+func (p *UploadVolumeParams) SetZoneidIfUnset(v string) {
+	if _, found := p.p["zoneid"]; !found {
+		p.SetZoneid(v)
+	}
+}
+
 // You should always use this function to get a new UploadVolumeParams instance,
-// as then you are sure you have configured all required params
-func (s *VolumeService) NewUploadVolumeParams(format string, name string, url string, zoneid string) *UploadVolumeParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *VolumeService) NewUploadVolumeParams(format string, name string, url string, zoneid string, opts ...func(*UploadVolumeParams)) *UploadVolumeParams {
 	p := &UploadVolumeParams{}
 	p.p = make(map[string]interface{})
 	p.p["format"] = format
 	p.p["name"] = name
 	p.p["url"] = url
 	p.p["zoneid"] = zoneid
+	for _, fn := range opts {
+		fn(p)
+	}
+	return p
+}
+
+// UploadVolumeOpts contains all params that can be set on a UploadVolumeParams.
+// Optional params are pointers (or slices and maps) which are only set when not nil.
+type UploadVolumeOpts struct {
+	Account        *string
+	Checksum       *string
+	Diskofferingid *string
+	Domainid       *string
+	Format         string
+	Imagestoreuuid *string
+	Name           string
+	Projectid      *string
+	Url            string
+	Zoneid         string
+}
+
+// NewUploadVolumeParamsFromOpts is an alternative for NewUploadVolumeParams which takes all params using
+// an options struct, as that is easier to read for APIs with many required params
+func (s *VolumeService) NewUploadVolumeParamsFromOpts(o UploadVolumeOpts) *UploadVolumeParams {
+	p := s.NewUploadVolumeParams(o.Format, o.Name, o.Url, o.Zoneid)
+	if o.Account != nil {
+		p.p["account"] = *o.Account
+	}
+	if o.Checksum != nil {
+		p.p["checksum"] = *o.Checksum
+	}
+	if o.Diskofferingid != nil {
+		p.p["diskofferingid"] = *o.Diskofferingid
+	}
+	if o.Domainid != nil {
+		p.p["domainid"] = *o.Domainid
+	}
+	if o.Imagestoreuuid != nil {
+		p.p["imagestoreuuid"] = *o.Imagestoreuuid
+	}
+	if o.Projectid != nil {
+		p.p["projectid"] = *o.Projectid
+	}
 	return p
 }
 
 // Uploads a data disk.
-func (s *VolumeService) UploadVolume(p *UploadVolumeParams) (*UploadVolumeResponse, error) {
-	resp, err := s.cs.newRequest("uploadVolume", p.toURLValues())
+func (s *VolumeService) UploadVolume(p *UploadVolumeParams, opts ...OptionFunc) (*UploadVolumeResponse, error) {
+	return s.UploadVolumeWithContext(context.Background(), p, opts...)
+}
+
+// UploadVolumeWithContext is the same as UploadVolume, but the request is cancelled when the context is done
+func (s *VolumeService) UploadVolumeWithContext(ctx context.Context, p *UploadVolumeParams, opts ...OptionFunc) (*UploadVolumeResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.UploadVolumeRawWithContext(ctx, p.toURLValues())
+}
+
+// UploadVolumeRaw is the same as UploadVolume, but takes the params as url.Values instead of typed params
+func (s *VolumeService) UploadVolumeRaw(v url.Values) (*UploadVolumeResponse, error) {
+	return s.UploadVolumeRawWithContext(context.Background(), v)
+}
+
+// UploadVolumeRawWithContext is the same as UploadVolumeRaw, but the request is cancelled when the context is done
+func (s *VolumeService) UploadVolumeRawWithContext(ctx context.Context, v url.Values) (*UploadVolumeResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "uploadVolume", "uploadvolumeresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -2187,9 +3833,9 @@ func (s *VolumeService) UploadVolume(p *UploadVolumeParams) (*UploadVolumeRespon
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
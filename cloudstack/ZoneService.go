@@ -17,17 +17,67 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/url"
 	"strconv"
 	"strings"
+	"time"
 )
 
+// UpdateMany runs UpdateZone for all given IDs in parallel, using at most concurrency concurrent requests.
+// For each ID the params are populated with the ID and then passed to mutate, which should set the
+// changes to apply. The result contains the updated resources (in the order of the IDs) and the error
+// of every update that failed by ID. The returned error contains all failures, or is nil if none failed.
+// The updated resources are returned as the UpdateZoneResponse of every update, which contains the same
+// fields as the resource itself, so no additional request is needed per resource to get them. The
+// options are used for every update.
+func (s *ZoneService) UpdateMany(ids []string, mutate func(*UpdateZoneParams), concurrency int, opts ...OptionFunc) (BulkResult[*UpdateZoneResponse], error) {
+	rs := make([]*UpdateZoneResponse, len(ids))
+	errs := make([]error, len(ids))
+
+	runConcurrently(len(ids), concurrency, func(i int) {
+		p := &UpdateZoneParams{p: map[string]interface{}{"id": ids[i]}}
+		if mutate != nil {
+			mutate(p)
+		}
+
+		r, err := s.UpdateZone(p, opts...)
+		if err != nil {
+			errs[i] = err
+			return
+		}
+		rs[i] = r
+	})
+
+	var r BulkResult[*UpdateZoneResponse]
+	for i, id := range ids {
+		if errs[i] != nil {
+			r.fail(id, errs[i])
+			continue
+		}
+		r.Succeeded = append(r.Succeeded, rs[i])
+	}
+
+	return r, r.Err()
+}
+
 type AddVmwareDcParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *AddVmwareDcParams) Clone() *AddVmwareDcParams {
+	c := &AddVmwareDcParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *AddVmwareDcParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -51,6 +101,16 @@ func (p *AddVmwareDcParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *AddVmwareDcParams) validate() error {
+	for _, k := range []string{"name", "vcenter", "zoneid"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of addVmwareDc", k)
+		}
+	}
+	return nil
+}
+
 func (p *AddVmwareDcParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -59,6 +119,13 @@ func (p *AddVmwareDcParams) SetName(v string) {
 	return
 }
 
+// SetNameIfUnset sets name to v, unless it is already set
+func (p *AddVmwareDcParams) SetNameIfUnset(v string) {
+	if _, found := p.p["name"]; !found {
+		p.SetName(v)
+	}
+}
+
 func (p *AddVmwareDcParams) SetPassword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -67,6 +134,13 @@ func (p *AddVmwareDcParams) SetPassword(v string) {
 	return
 }
 
+// SetPasswordIfUnset sets password to v, unless it is already set
+func (p *AddVmwareDcParams) SetPasswordIfUnset(v string) {
+	if _, found := p.p["password"]; !found {
+		p.SetPassword(v)
+	}
+}
+
 func (p *AddVmwareDcParams) SetUsername(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -75,6 +149,13 @@ func (p *AddVmwareDcParams) SetUsername(v string) {
 	return
 }
 
+// SetUsernameIfUnset sets username to v, unless it is already set
+func (p *AddVmwareDcParams) SetUsernameIfUnset(v string) {
+	if _, found := p.p["username"]; !found {
+		p.SetUsername(v)
+	}
+}
+
 func (p *AddVmwareDcParams) SetVcenter(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -83,6 +164,13 @@ func (p *AddVmwareDcParams) SetVcenter(v string) {
 	return
 }
 
+// SetVcenterIfUnset sets vcenter to v, unless it is already set
+func (p *AddVmwareDcParams) SetVcenterIfUnset(v string) {
+	if _, found := p.p["vcenter"]; !found {
+		p.SetVcenter(v)
+	}
+}
+
 func (p *AddVmwareDcParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -91,20 +179,79 @@ func (p *AddVmwareDcParams) SetZoneid(v string) {
 	return
 }
 
+// SetZoneidIfUnset sets zoneid to v, unless it is already set
+func (p *AddVmwareDcParams) SetZoneidIfUnset(v string) {
+	if _, found := p.p["zoneid"]; !found {
+		p.SetZoneid(v)
+	}
+}
+
 // You should always use this function to get a new AddVmwareDcParams instance,
-// as then you are sure you have configured all required params
-func (s *ZoneService) NewAddVmwareDcParams(name string, vcenter string, zoneid string) *AddVmwareDcParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *ZoneService) NewAddVmwareDcParams(name string, vcenter string, zoneid string, opts ...func(*AddVmwareDcParams)) *AddVmwareDcParams {
 	p := &AddVmwareDcParams{}
 	p.p = make(map[string]interface{})
 	p.p["name"] = name
 	p.p["vcenter"] = vcenter
 	p.p["zoneid"] = zoneid
+	for _, fn := range opts {
+		fn(p)
+	}
+	return p
+}
+
+// AddVmwareDcOpts contains all params that can be set on a AddVmwareDcParams.
+// Optional params are pointers (or slices and maps) which are only set when not nil.
+type AddVmwareDcOpts struct {
+	Name     string
+	Password *string
+	Username *string
+	Vcenter  string
+	Zoneid   string
+}
+
+// NewAddVmwareDcParamsFromOpts is an alternative for NewAddVmwareDcParams which takes all params using
+// an options struct, as that is easier to read for APIs with many required params
+func (s *ZoneService) NewAddVmwareDcParamsFromOpts(o AddVmwareDcOpts) *AddVmwareDcParams {
+	p := s.NewAddVmwareDcParams(o.Name, o.Vcenter, o.Zoneid)
+	if o.Password != nil {
+		p.p["password"] = *o.Password
+	}
+	if o.Username != nil {
+		p.p["username"] = *o.Username
+	}
 	return p
 }
 
 // Adds a VMware datacenter to specified zone
-func (s *ZoneService) AddVmwareDc(p *AddVmwareDcParams) (*AddVmwareDcResponse, error) {
-	resp, err := s.cs.newRequest("addVmwareDc", p.toURLValues())
+func (s *ZoneService) AddVmwareDc(p *AddVmwareDcParams, opts ...OptionFunc) (*AddVmwareDcResponse, error) {
+	return s.AddVmwareDcWithContext(context.Background(), p, opts...)
+}
+
+// AddVmwareDcWithContext is the same as AddVmwareDc, but the request is cancelled when the context is done
+func (s *ZoneService) AddVmwareDcWithContext(ctx context.Context, p *AddVmwareDcParams, opts ...OptionFunc) (*AddVmwareDcResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.AddVmwareDcRawWithContext(ctx, p.toURLValues())
+}
+
+// AddVmwareDcRaw is the same as AddVmwareDc, but takes the params as url.Values instead of typed params
+func (s *ZoneService) AddVmwareDcRaw(v url.Values) (*AddVmwareDcResponse, error) {
+	return s.AddVmwareDcRawWithContext(context.Background(), v)
+}
+
+// AddVmwareDcRawWithContext is the same as AddVmwareDcRaw, but the request is cancelled when the context is done
+func (s *ZoneService) AddVmwareDcRawWithContext(ctx context.Context, v url.Values) (*AddVmwareDcResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "addVmwareDc", "addvmwaredcresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -128,6 +275,16 @@ type CreateZoneParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *CreateZoneParams) Clone() *CreateZoneParams {
+	c := &CreateZoneParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *CreateZoneParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -180,6 +337,16 @@ func (p *CreateZoneParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *CreateZoneParams) validate() error {
+	for _, k := range []string{"dns1", "internaldns1", "name", "networktype"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of createZone", k)
+		}
+	}
+	return nil
+}
+
 func (p *CreateZoneParams) SetAllocationstate(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -188,6 +355,13 @@ func (p *CreateZoneParams) SetAllocationstate(v string) {
 	return
 }
 
+// SetAllocationstateIfUnset sets allocationstate to v, unless it is already set
+func (p *CreateZoneParams) SetAllocationstateIfUnset(v string) {
+	if _, found := p.p["allocationstate"]; !found {
+		p.SetAllocationstate(v)
+	}
+}
+
 func (p *CreateZoneParams) SetDns1(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -196,6 +370,13 @@ func (p *CreateZoneParams) SetDns1(v string) {
 	return
 }
 
+// SetDns1IfUnset sets dns1 to v, unless it is already set
+func (p *CreateZoneParams) SetDns1IfUnset(v string) {
+	if _, found := p.p["dns1"]; !found {
+		p.SetDns1(v)
+	}
+}
+
 func (p *CreateZoneParams) SetDns2(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -204,6 +385,13 @@ func (p *CreateZoneParams) SetDns2(v string) {
 	return
 }
 
+// SetDns2IfUnset sets dns2 to v, unless it is already set
+func (p *CreateZoneParams) SetDns2IfUnset(v string) {
+	if _, found := p.p["dns2"]; !found {
+		p.SetDns2(v)
+	}
+}
+
 func (p *CreateZoneParams) SetDomain(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -212,6 +400,13 @@ func (p *CreateZoneParams) SetDomain(v string) {
 	return
 }
 
+// SetDomainIfUnset sets domain to v, unless it is already set
+func (p *CreateZoneParams) SetDomainIfUnset(v string) {
+	if _, found := p.p["domain"]; !found {
+		p.SetDomain(v)
+	}
+}
+
 func (p *CreateZoneParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -220,6 +415,13 @@ func (p *CreateZoneParams) SetDomainid(v string) {
 	return
 }
 
+// SetDomainidIfUnset sets domainid to v, unless it is already set
+func (p *CreateZoneParams) SetDomainidIfUnset(v string) {
+	if _, found := p.p["domainid"]; !found {
+		p.SetDomainid(v)
+	}
+}
+
 func (p *CreateZoneParams) SetGuestcidraddress(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -228,6 +430,13 @@ func (p *CreateZoneParams) SetGuestcidraddress(v string) {
 	return
 }
 
+// SetGuestcidraddressIfUnset sets guestcidraddress to v, unless it is already set
+func (p *CreateZoneParams) SetGuestcidraddressIfUnset(v string) {
+	if _, found := p.p["guestcidraddress"]; !found {
+		p.SetGuestcidraddress(v)
+	}
+}
+
 func (p *CreateZoneParams) SetInternaldns1(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -236,6 +445,13 @@ func (p *CreateZoneParams) SetInternaldns1(v string) {
 	return
 }
 
+// SetInternaldns1IfUnset sets internaldns1 to v, unless it is already set
+func (p *CreateZoneParams) SetInternaldns1IfUnset(v string) {
+	if _, found := p.p["internaldns1"]; !found {
+		p.SetInternaldns1(v)
+	}
+}
+
 func (p *CreateZoneParams) SetInternaldns2(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -244,6 +460,13 @@ func (p *CreateZoneParams) SetInternaldns2(v string) {
 	return
 }
 
+// SetInternaldns2IfUnset sets internaldns2 to v, unless it is already set
+func (p *CreateZoneParams) SetInternaldns2IfUnset(v string) {
+	if _, found := p.p["internaldns2"]; !found {
+		p.SetInternaldns2(v)
+	}
+}
+
 func (p *CreateZoneParams) SetIp6dns1(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -252,6 +475,13 @@ func (p *CreateZoneParams) SetIp6dns1(v string) {
 	return
 }
 
+// SetIp6dns1IfUnset sets ip6dns1 to v, unless it is already set
+func (p *CreateZoneParams) SetIp6dns1IfUnset(v string) {
+	if _, found := p.p["ip6dns1"]; !found {
+		p.SetIp6dns1(v)
+	}
+}
+
 func (p *CreateZoneParams) SetIp6dns2(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -260,6 +490,13 @@ func (p *CreateZoneParams) SetIp6dns2(v string) {
 	return
 }
 
+// SetIp6dns2IfUnset sets ip6dns2 to v, unless it is already set
+func (p *CreateZoneParams) SetIp6dns2IfUnset(v string) {
+	if _, found := p.p["ip6dns2"]; !found {
+		p.SetIp6dns2(v)
+	}
+}
+
 func (p *CreateZoneParams) SetLocalstorageenabled(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -268,6 +505,13 @@ func (p *CreateZoneParams) SetLocalstorageenabled(v bool) {
 	return
 }
 
+// SetLocalstorageenabledIfUnset sets localstorageenabled to v, unless it is already set
+func (p *CreateZoneParams) SetLocalstorageenabledIfUnset(v bool) {
+	if _, found := p.p["localstorageenabled"]; !found {
+		p.SetLocalstorageenabled(v)
+	}
+}
+
 func (p *CreateZoneParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -276,6 +520,13 @@ func (p *CreateZoneParams) SetName(v string) {
 	return
 }
 
+// SetNameIfUnset sets name to v, unless it is already set
+func (p *CreateZoneParams) SetNameIfUnset(v string) {
+	if _, found := p.p["name"]; !found {
+		p.SetName(v)
+	}
+}
+
 func (p *CreateZoneParams) SetNetworktype(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -284,6 +535,13 @@ func (p *CreateZoneParams) SetNetworktype(v string) {
 	return
 }
 
+// SetNetworktypeIfUnset sets networktype to v, unless it is already set
+func (p *CreateZoneParams) SetNetworktypeIfUnset(v string) {
+	if _, found := p.p["networktype"]; !found {
+		p.SetNetworktype(v)
+	}
+}
+
 func (p *CreateZoneParams) SetSecuritygroupenabled(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -292,21 +550,113 @@ func (p *CreateZoneParams) SetSecuritygroupenabled(v bool) {
 	return
 }
 
+// SetSecuritygroupenabledIfUnset sets securitygroupenabled to v, unless it is already set
+func (p *CreateZoneParams) SetSecuritygroupenabledIfUnset(v bool) {
+	if _, found := p.p["securitygroupenabled"]; !found {
+		p.SetSecuritygroupenabled(v)
+	}
+}
+
 // You should always use this function to get a new CreateZoneParams instance,
-// as then you are sure you have configured all required params
-func (s *ZoneService) NewCreateZoneParams(dns1 string, internaldns1 string, name string, networktype string) *CreateZoneParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *ZoneService) NewCreateZoneParams(dns1 string, internaldns1 string, name string, networktype string, opts ...func(*CreateZoneParams)) *CreateZoneParams {
 	p := &CreateZoneParams{}
 	p.p = make(map[string]interface{})
 	p.p["dns1"] = dns1
 	p.p["internaldns1"] = internaldns1
 	p.p["name"] = name
 	p.p["networktype"] = networktype
+	for _, fn := range opts {
+		fn(p)
+	}
+	return p
+}
+
+// CreateZoneOpts contains all params that can be set on a CreateZoneParams.
+// Optional params are pointers (or slices and maps) which are only set when not nil.
+type CreateZoneOpts struct {
+	Allocationstate      *string
+	Dns1                 string
+	Dns2                 *string
+	Domain               *string
+	Domainid             *string
+	Guestcidraddress     *string
+	Internaldns1         string
+	Internaldns2         *string
+	Ip6dns1              *string
+	Ip6dns2              *string
+	Localstorageenabled  *bool
+	Name                 string
+	Networktype          string
+	Securitygroupenabled *bool
+}
+
+// NewCreateZoneParamsFromOpts is an alternative for NewCreateZoneParams which takes all params using
+// an options struct, as that is easier to read for APIs with many required params
+func (s *ZoneService) NewCreateZoneParamsFromOpts(o CreateZoneOpts) *CreateZoneParams {
+	p := s.NewCreateZoneParams(o.Dns1, o.Internaldns1, o.Name, o.Networktype)
+	if o.Allocationstate != nil {
+		p.p["allocationstate"] = *o.Allocationstate
+	}
+	if o.Dns2 != nil {
+		p.p["dns2"] = *o.Dns2
+	}
+	if o.Domain != nil {
+		p.p["domain"] = *o.Domain
+	}
+	if o.Domainid != nil {
+		p.p["domainid"] = *o.Domainid
+	}
+	if o.Guestcidraddress != nil {
+		p.p["guestcidraddress"] = *o.Guestcidraddress
+	}
+	if o.Internaldns2 != nil {
+		p.p["internaldns2"] = *o.Internaldns2
+	}
+	if o.Ip6dns1 != nil {
+		p.p["ip6dns1"] = *o.Ip6dns1
+	}
+	if o.Ip6dns2 != nil {
+		p.p["ip6dns2"] = *o.Ip6dns2
+	}
+	if o.Localstorageenabled != nil {
+		p.p["localstorageenabled"] = *o.Localstorageenabled
+	}
+	if o.Securitygroupenabled != nil {
+		p.p["securitygroupenabled"] = *o.Securitygroupenabled
+	}
 	return p
 }
 
 // Creates a Zone.
-func (s *ZoneService) CreateZone(p *CreateZoneParams) (*CreateZoneResponse, error) {
-	resp, err := s.cs.newRequest("createZone", p.toURLValues())
+func (s *ZoneService) CreateZone(p *CreateZoneParams, opts ...OptionFunc) (*CreateZoneResponse, error) {
+	return s.CreateZoneWithContext(context.Background(), p, opts...)
+}
+
+// CreateZoneWithContext is the same as CreateZone, but the request is cancelled when the context is done
+func (s *ZoneService) CreateZoneWithContext(ctx context.Context, p *CreateZoneParams, opts ...OptionFunc) (*CreateZoneResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.CreateZoneRawWithContext(ctx, p.toURLValues())
+}
+
+// CreateZoneRaw is the same as CreateZone, but takes the params as url.Values instead of typed params
+func (s *ZoneService) CreateZoneRaw(v url.Values) (*CreateZoneResponse, error) {
+	return s.CreateZoneRawWithContext(context.Background(), v)
+}
+
+// CreateZoneRawWithContext is the same as CreateZoneRaw, but the request is cancelled when the context is done
+func (s *ZoneService) CreateZoneRawWithContext(ctx context.Context, v url.Values) (*CreateZoneResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "createZone", "createzoneresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -333,25 +683,25 @@ type CreateZoneResponse struct {
 		Zoneid        string `json:"zoneid"`
 		Zonename      string `json:"zonename"`
 	} `json:"capacity"`
-	Description           string            `json:"description"`
-	Dhcpprovider          string            `json:"dhcpprovider"`
-	Displaytext           string            `json:"displaytext"`
-	Dns1                  string            `json:"dns1"`
-	Dns2                  string            `json:"dns2"`
-	Domain                string            `json:"domain"`
-	Domainid              string            `json:"domainid"`
-	Domainname            string            `json:"domainname"`
-	Guestcidraddress      string            `json:"guestcidraddress"`
-	Id                    string            `json:"id"`
-	Internaldns1          string            `json:"internaldns1"`
-	Internaldns2          string            `json:"internaldns2"`
-	Ip6dns1               string            `json:"ip6dns1"`
-	Ip6dns2               string            `json:"ip6dns2"`
-	Localstorageenabled   bool              `json:"localstorageenabled"`
-	Name                  string            `json:"name"`
-	Networktype           string            `json:"networktype"`
-	Resourcedetails       map[string]string `json:"resourcedetails"`
-	Securitygroupsenabled bool              `json:"securitygroupsenabled"`
+	Description           string    `json:"description"`
+	Dhcpprovider          string    `json:"dhcpprovider"`
+	Displaytext           string    `json:"displaytext"`
+	Dns1                  string    `json:"dns1"`
+	Dns2                  string    `json:"dns2"`
+	Domain                string    `json:"domain"`
+	Domainid              string    `json:"domainid"`
+	Domainname            string    `json:"domainname"`
+	Guestcidraddress      string    `json:"guestcidraddress"`
+	Id                    string    `json:"id"`
+	Internaldns1          string    `json:"internaldns1"`
+	Internaldns2          string    `json:"internaldns2"`
+	Ip6dns1               string    `json:"ip6dns1"`
+	Ip6dns2               string    `json:"ip6dns2"`
+	Localstorageenabled   bool      `json:"localstorageenabled"`
+	Name                  string    `json:"name"`
+	Networktype           string    `json:"networktype"`
+	Resourcedetails       StringMap `json:"resourcedetails"`
+	Securitygroupsenabled bool      `json:"securitygroupsenabled"`
 	Tags                  []struct {
 		Account      string `json:"account"`
 		Customer     string `json:"customer"`
@@ -371,6 +721,16 @@ type DedicateZoneParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *DedicateZoneParams) Clone() *DedicateZoneParams {
+	c := &DedicateZoneParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DedicateZoneParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -388,6 +748,16 @@ func (p *DedicateZoneParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *DedicateZoneParams) validate() error {
+	for _, k := range []string{"domainid", "zoneid"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of dedicateZone", k)
+		}
+	}
+	return nil
+}
+
 func (p *DedicateZoneParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -396,6 +766,13 @@ func (p *DedicateZoneParams) SetAccount(v string) {
 	return
 }
 
+// SetAccountIfUnset sets account to v, unless it is already set
+func (p *DedicateZoneParams) SetAccountIfUnset(v string) {
+	if _, found := p.p["account"]; !found {
+		p.SetAccount(v)
+	}
+}
+
 func (p *DedicateZoneParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -404,6 +781,13 @@ func (p *DedicateZoneParams) SetDomainid(v string) {
 	return
 }
 
+// SetDomainidIfUnset sets domainid to v, unless it is already set
+func (p *DedicateZoneParams) SetDomainidIfUnset(v string) {
+	if _, found := p.p["domainid"]; !found {
+		p.SetDomainid(v)
+	}
+}
+
 func (p *DedicateZoneParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -412,19 +796,55 @@ func (p *DedicateZoneParams) SetZoneid(v string) {
 	return
 }
 
+// SetZoneidIfUnset sets zoneid to v, unless it is already set
+func (p *DedicateZoneParams) SetZoneidIfUnset(v string) {
+	if _, found := p.p["zoneid"]; !found {
+		p.SetZoneid(v)
+	}
+}
+
 // You should always use this function to get a new DedicateZoneParams instance,
-// as then you are sure you have configured all required params
-func (s *ZoneService) NewDedicateZoneParams(domainid string, zoneid string) *DedicateZoneParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *ZoneService) NewDedicateZoneParams(domainid string, zoneid string, opts ...func(*DedicateZoneParams)) *DedicateZoneParams {
 	p := &DedicateZoneParams{}
 	p.p = make(map[string]interface{})
 	p.p["domainid"] = domainid
 	p.p["zoneid"] = zoneid
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Dedicates a zones.
-func (s *ZoneService) DedicateZone(p *DedicateZoneParams) (*DedicateZoneResponse, error) {
-	resp, err := s.cs.newRequest("dedicateZone", p.toURLValues())
+func (s *ZoneService) DedicateZone(p *DedicateZoneParams, opts ...OptionFunc) (*DedicateZoneResponse, error) {
+	return s.DedicateZoneWithContext(context.Background(), p, opts...)
+}
+
+// DedicateZoneWithContext is the same as DedicateZone, but the request is cancelled when the context is done
+func (s *ZoneService) DedicateZoneWithContext(ctx context.Context, p *DedicateZoneParams, opts ...OptionFunc) (*DedicateZoneResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.DedicateZoneRawWithContext(ctx, p.toURLValues())
+}
+
+// DedicateZoneRaw is the same as DedicateZone, but takes the params as url.Values instead of typed params
+func (s *ZoneService) DedicateZoneRaw(v url.Values) (*DedicateZoneResponse, error) {
+	return s.DedicateZoneRawWithContext(context.Background(), v)
+}
+
+// DedicateZoneRawWithContext is the same as DedicateZoneRaw, but the request is cancelled when the context is done
+func (s *ZoneService) DedicateZoneRawWithContext(ctx context.Context, v url.Values) (*DedicateZoneResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "dedicateZone", "dedicatezoneresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -436,9 +856,9 @@ func (s *ZoneService) DedicateZone(p *DedicateZoneParams) (*DedicateZoneResponse
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -471,6 +891,16 @@ type DeleteZoneParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *DeleteZoneParams) Clone() *DeleteZoneParams {
+	c := &DeleteZoneParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeleteZoneParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -482,6 +912,16 @@ func (p *DeleteZoneParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *DeleteZoneParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of deleteZone", k)
+		}
+	}
+	return nil
+}
+
 func (p *DeleteZoneParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -490,18 +930,54 @@ func (p *DeleteZoneParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *DeleteZoneParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 // You should always use this function to get a new DeleteZoneParams instance,
-// as then you are sure you have configured all required params
-func (s *ZoneService) NewDeleteZoneParams(id string) *DeleteZoneParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *ZoneService) NewDeleteZoneParams(id string, opts ...func(*DeleteZoneParams)) *DeleteZoneParams {
 	p := &DeleteZoneParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Deletes a Zone.
-func (s *ZoneService) DeleteZone(p *DeleteZoneParams) (*DeleteZoneResponse, error) {
-	resp, err := s.cs.newRequest("deleteZone", p.toURLValues())
+func (s *ZoneService) DeleteZone(p *DeleteZoneParams, opts ...OptionFunc) (*DeleteZoneResponse, error) {
+	return s.DeleteZoneWithContext(context.Background(), p, opts...)
+}
+
+// DeleteZoneWithContext is the same as DeleteZone, but the request is cancelled when the context is done
+func (s *ZoneService) DeleteZoneWithContext(ctx context.Context, p *DeleteZoneParams, opts ...OptionFunc) (*DeleteZoneResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.DeleteZoneRawWithContext(ctx, p.toURLValues())
+}
+
+// DeleteZoneRaw is the same as DeleteZone, but takes the params as url.Values instead of typed params
+func (s *ZoneService) DeleteZoneRaw(v url.Values) (*DeleteZoneResponse, error) {
+	return s.DeleteZoneRawWithContext(context.Background(), v)
+}
+
+// DeleteZoneRawWithContext is the same as DeleteZoneRaw, but the request is cancelled when the context is done
+func (s *ZoneService) DeleteZoneRawWithContext(ctx context.Context, v url.Values) (*DeleteZoneResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteZone", "deletezoneresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -514,6 +990,34 @@ func (s *ZoneService) DeleteZone(p *DeleteZoneParams) (*DeleteZoneResponse, erro
 	return &r, nil
 }
 
+// WaitForZoneDeleted polls until the Zone with the given ID no longer exists, the timeout
+// expires or the context is cancelled. Deleted resources may still be listed for a short while
+// after the delete command finished, so this can be used to make sure they are really gone. The
+// options are passed to GetZoneByID, so a resource outside of the default scope can be found.
+func (s *ZoneService) WaitForZoneDeleted(ctx context.Context, id string, timeout time.Duration, opts ...OptionFunc) error {
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	for {
+		_, count, err := s.GetZoneByID(id, opts...)
+		if count == 0 {
+			return nil
+		}
+		if count < 0 {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(2 * time.Second):
+		}
+	}
+}
+
 type DeleteZoneResponse struct {
 	Displaytext string `json:"displaytext"`
 	Success     bool   `json:"success"`
@@ -542,6 +1046,16 @@ type DisableOutOfBandManagementForZoneParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *DisableOutOfBandManagementForZoneParams) Clone() *DisableOutOfBandManagementForZoneParams {
+	c := &DisableOutOfBandManagementForZoneParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DisableOutOfBandManagementForZoneParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -553,6 +1067,16 @@ func (p *DisableOutOfBandManagementForZoneParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *DisableOutOfBandManagementForZoneParams) validate() error {
+	for _, k := range []string{"zoneid"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of disableOutOfBandManagementForZone", k)
+		}
+	}
+	return nil
+}
+
 func (p *DisableOutOfBandManagementForZoneParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -561,18 +1085,54 @@ func (p *DisableOutOfBandManagementForZoneParams) SetZoneid(v string) {
 	return
 }
 
+// SetZoneidIfUnset sets zoneid to v, unless it is already set
+func (p *DisableOutOfBandManagementForZoneParams) SetZoneidIfUnset(v string) {
+	if _, found := p.p["zoneid"]; !found {
+		p.SetZoneid(v)
+	}
+}
+
 // You should always use this function to get a new DisableOutOfBandManagementForZoneParams instance,
-// as then you are sure you have configured all required params
-func (s *ZoneService) NewDisableOutOfBandManagementForZoneParams(zoneid string) *DisableOutOfBandManagementForZoneParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *ZoneService) NewDisableOutOfBandManagementForZoneParams(zoneid string, opts ...func(*DisableOutOfBandManagementForZoneParams)) *DisableOutOfBandManagementForZoneParams {
 	p := &DisableOutOfBandManagementForZoneParams{}
 	p.p = make(map[string]interface{})
 	p.p["zoneid"] = zoneid
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Disables out-of-band management for a zone
-func (s *ZoneService) DisableOutOfBandManagementForZone(p *DisableOutOfBandManagementForZoneParams) (*DisableOutOfBandManagementForZoneResponse, error) {
-	resp, err := s.cs.newRequest("disableOutOfBandManagementForZone", p.toURLValues())
+func (s *ZoneService) DisableOutOfBandManagementForZone(p *DisableOutOfBandManagementForZoneParams, opts ...OptionFunc) (*DisableOutOfBandManagementForZoneResponse, error) {
+	return s.DisableOutOfBandManagementForZoneWithContext(context.Background(), p, opts...)
+}
+
+// DisableOutOfBandManagementForZoneWithContext is the same as DisableOutOfBandManagementForZone, but the request is cancelled when the context is done
+func (s *ZoneService) DisableOutOfBandManagementForZoneWithContext(ctx context.Context, p *DisableOutOfBandManagementForZoneParams, opts ...OptionFunc) (*DisableOutOfBandManagementForZoneResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.DisableOutOfBandManagementForZoneRawWithContext(ctx, p.toURLValues())
+}
+
+// DisableOutOfBandManagementForZoneRaw is the same as DisableOutOfBandManagementForZone, but takes the params as url.Values instead of typed params
+func (s *ZoneService) DisableOutOfBandManagementForZoneRaw(v url.Values) (*DisableOutOfBandManagementForZoneResponse, error) {
+	return s.DisableOutOfBandManagementForZoneRawWithContext(context.Background(), v)
+}
+
+// DisableOutOfBandManagementForZoneRawWithContext is the same as DisableOutOfBandManagementForZoneRaw, but the request is cancelled when the context is done
+func (s *ZoneService) DisableOutOfBandManagementForZoneRawWithContext(ctx context.Context, v url.Values) (*DisableOutOfBandManagementForZoneResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "disableOutOfBandManagementForZone", "disableoutofbandmanagementforzoneresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -584,9 +1144,9 @@ func (s *ZoneService) DisableOutOfBandManagementForZone(p *DisableOutOfBandManag
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -624,6 +1184,16 @@ type EnableOutOfBandManagementForZoneParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *EnableOutOfBandManagementForZoneParams) Clone() *EnableOutOfBandManagementForZoneParams {
+	c := &EnableOutOfBandManagementForZoneParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *EnableOutOfBandManagementForZoneParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -635,6 +1205,16 @@ func (p *EnableOutOfBandManagementForZoneParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *EnableOutOfBandManagementForZoneParams) validate() error {
+	for _, k := range []string{"zoneid"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of enableOutOfBandManagementForZone", k)
+		}
+	}
+	return nil
+}
+
 func (p *EnableOutOfBandManagementForZoneParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -643,18 +1223,54 @@ func (p *EnableOutOfBandManagementForZoneParams) SetZoneid(v string) {
 	return
 }
 
+// SetZoneidIfUnset sets zoneid to v, unless it is already set
+func (p *EnableOutOfBandManagementForZoneParams) SetZoneidIfUnset(v string) {
+	if _, found := p.p["zoneid"]; !found {
+		p.SetZoneid(v)
+	}
+}
+
 // You should always use this function to get a new EnableOutOfBandManagementForZoneParams instance,
-// as then you are sure you have configured all required params
-func (s *ZoneService) NewEnableOutOfBandManagementForZoneParams(zoneid string) *EnableOutOfBandManagementForZoneParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *ZoneService) NewEnableOutOfBandManagementForZoneParams(zoneid string, opts ...func(*EnableOutOfBandManagementForZoneParams)) *EnableOutOfBandManagementForZoneParams {
 	p := &EnableOutOfBandManagementForZoneParams{}
 	p.p = make(map[string]interface{})
 	p.p["zoneid"] = zoneid
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Enables out-of-band management for a zone
-func (s *ZoneService) EnableOutOfBandManagementForZone(p *EnableOutOfBandManagementForZoneParams) (*EnableOutOfBandManagementForZoneResponse, error) {
-	resp, err := s.cs.newRequest("enableOutOfBandManagementForZone", p.toURLValues())
+func (s *ZoneService) EnableOutOfBandManagementForZone(p *EnableOutOfBandManagementForZoneParams, opts ...OptionFunc) (*EnableOutOfBandManagementForZoneResponse, error) {
+	return s.EnableOutOfBandManagementForZoneWithContext(context.Background(), p, opts...)
+}
+
+// EnableOutOfBandManagementForZoneWithContext is the same as EnableOutOfBandManagementForZone, but the request is cancelled when the context is done
+func (s *ZoneService) EnableOutOfBandManagementForZoneWithContext(ctx context.Context, p *EnableOutOfBandManagementForZoneParams, opts ...OptionFunc) (*EnableOutOfBandManagementForZoneResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.EnableOutOfBandManagementForZoneRawWithContext(ctx, p.toURLValues())
+}
+
+// EnableOutOfBandManagementForZoneRaw is the same as EnableOutOfBandManagementForZone, but takes the params as url.Values instead of typed params
+func (s *ZoneService) EnableOutOfBandManagementForZoneRaw(v url.Values) (*EnableOutOfBandManagementForZoneResponse, error) {
+	return s.EnableOutOfBandManagementForZoneRawWithContext(context.Background(), v)
+}
+
+// EnableOutOfBandManagementForZoneRawWithContext is the same as EnableOutOfBandManagementForZoneRaw, but the request is cancelled when the context is done
+func (s *ZoneService) EnableOutOfBandManagementForZoneRawWithContext(ctx context.Context, v url.Values) (*EnableOutOfBandManagementForZoneResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "enableOutOfBandManagementForZone", "enableoutofbandmanagementforzoneresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -666,9 +1282,9 @@ func (s *ZoneService) EnableOutOfBandManagementForZone(p *EnableOutOfBandManagem
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -706,6 +1322,16 @@ type ListDedicatedZonesParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ListDedicatedZonesParams) Clone() *ListDedicatedZonesParams {
+	c := &ListDedicatedZonesParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListDedicatedZonesParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -745,6 +1371,13 @@ func (p *ListDedicatedZonesParams) SetAccount(v string) {
 	return
 }
 
+// SetAccountIfUnset sets account to v, unless it is already set
+func (p *ListDedicatedZonesParams) SetAccountIfUnset(v string) {
+	if _, found := p.p["account"]; !found {
+		p.SetAccount(v)
+	}
+}
+
 func (p *ListDedicatedZonesParams) SetAffinitygroupid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -753,14 +1386,28 @@ func (p *ListDedicatedZonesParams) SetAffinitygroupid(v string) {
 	return
 }
 
-func (p *ListDedicatedZonesParams) SetDomainid(v string) {
-	if p.p == nil {
+// SetAffinitygroupidIfUnset sets affinitygroupid to v, unless it is already set
+func (p *ListDedicatedZonesParams) SetAffinitygroupidIfUnset(v string) {
+	if _, found := p.p["affinitygroupid"]; !found {
+		p.SetAffinitygroupid(v)
+	}
+}
+
+func (p *ListDedicatedZonesParams) SetDomainid(v string) {
+	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
 	p.p["domainid"] = v
 	return
 }
 
+// SetDomainidIfUnset sets domainid to v, unless it is already set
+func (p *ListDedicatedZonesParams) SetDomainidIfUnset(v string) {
+	if _, found := p.p["domainid"]; !found {
+		p.SetDomainid(v)
+	}
+}
+
 func (p *ListDedicatedZonesParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -769,6 +1416,13 @@ func (p *ListDedicatedZonesParams) SetKeyword(v string) {
 	return
 }
 
+// SetKeywordIfUnset sets keyword to v, unless it is already set
+func (p *ListDedicatedZonesParams) SetKeywordIfUnset(v string) {
+	if _, found := p.p["keyword"]; !found {
+		p.SetKeyword(v)
+	}
+}
+
 func (p *ListDedicatedZonesParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -777,6 +1431,13 @@ func (p *ListDedicatedZonesParams) SetPage(v int) {
 	return
 }
 
+// SetPageIfUnset sets page to v, unless it is already set
+func (p *ListDedicatedZonesParams) SetPageIfUnset(v int) {
+	if _, found := p.p["page"]; !found {
+		p.SetPage(v)
+	}
+}
+
 func (p *ListDedicatedZonesParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -785,6 +1446,13 @@ func (p *ListDedicatedZonesParams) SetPagesize(v int) {
 	return
 }
 
+// SetPagesizeIfUnset sets pagesize to v, unless it is already set
+func (p *ListDedicatedZonesParams) SetPagesizeIfUnset(v int) {
+	if _, found := p.p["pagesize"]; !found {
+		p.SetPagesize(v)
+	}
+}
+
 func (p *ListDedicatedZonesParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -793,17 +1461,43 @@ func (p *ListDedicatedZonesParams) SetZoneid(v string) {
 	return
 }
 
+// SetZoneidIfUnset sets zoneid to v, unless it is already set
+func (p *ListDedicatedZonesParams) SetZoneidIfUnset(v string) {
+	if _, found := p.p["zoneid"]; !found {
+		p.SetZoneid(v)
+	}
+}
+
 // You should always use this function to get a new ListDedicatedZonesParams instance,
-// as then you are sure you have configured all required params
-func (s *ZoneService) NewListDedicatedZonesParams() *ListDedicatedZonesParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *ZoneService) NewListDedicatedZonesParams(opts ...func(*ListDedicatedZonesParams)) *ListDedicatedZonesParams {
 	p := &ListDedicatedZonesParams{}
 	p.p = make(map[string]interface{})
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // List dedicated zones.
 func (s *ZoneService) ListDedicatedZones(p *ListDedicatedZonesParams) (*ListDedicatedZonesResponse, error) {
-	resp, err := s.cs.newRequest("listDedicatedZones", p.toURLValues())
+	return s.ListDedicatedZonesWithContext(context.Background(), p)
+}
+
+// ListDedicatedZonesWithContext is the same as ListDedicatedZones, but the request is cancelled when the context is done
+func (s *ZoneService) ListDedicatedZonesWithContext(ctx context.Context, p *ListDedicatedZonesParams) (*ListDedicatedZonesResponse, error) {
+	return s.ListDedicatedZonesRawWithContext(ctx, p.toURLValues())
+}
+
+// ListDedicatedZonesRaw is the same as ListDedicatedZones, but takes the params as url.Values instead of typed params
+func (s *ZoneService) ListDedicatedZonesRaw(v url.Values) (*ListDedicatedZonesResponse, error) {
+	return s.ListDedicatedZonesRawWithContext(context.Background(), v)
+}
+
+// ListDedicatedZonesRawWithContext is the same as ListDedicatedZonesRaw, but the request is cancelled when the context is done
+func (s *ZoneService) ListDedicatedZonesRawWithContext(ctx context.Context, v url.Values) (*ListDedicatedZonesResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "listDedicatedZones", "listdedicatedzonesresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -816,6 +1510,93 @@ func (s *ZoneService) ListDedicatedZones(p *ListDedicatedZonesParams) (*ListDedi
 	return &r, nil
 }
 
+// StreamDedicatedZones fetches the results of ListDedicatedZones page by page in the background and sends them on the
+// returned channel. Both returned channels are closed when done. If fetching a page fails, or the context
+// is done before all results are sent, the error is sent on the error channel and fetching stops. A
+// request for a page in flight is cancelled when the context is done. The page and pagesize set in the
+// params are ignored.
+func (s *ZoneService) StreamDedicatedZones(ctx context.Context, p *ListDedicatedZonesParams) (<-chan *DedicatedZone, <-chan error) {
+	ch := make(chan *DedicatedZone)
+	errs := make(chan error, 1)
+
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesize(500)
+
+	go func() {
+		defer close(errs)
+		defer close(ch)
+
+		for page, count := 1, 0; ; page++ {
+			pp.SetPage(page)
+
+			l, err := s.ListDedicatedZonesWithContext(ctx, pp)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			for _, v := range l.DedicatedZones {
+				select {
+				case ch <- v:
+				case <-ctx.Done():
+					errs <- ctx.Err()
+					return
+				}
+			}
+
+			count += len(l.DedicatedZones)
+			if len(l.DedicatedZones) < 500 || count >= l.Count {
+				return
+			}
+		}
+	}()
+
+	return ch, errs
+}
+
+// ListDedicatedZonesWhere returns all results of ListDedicatedZones for which pred returns true. Note that the results are
+// filtered client-side, so all results matching the params are fetched first.
+// All pages are fetched, so the page and pagesize set in the params are ignored.
+func (s *ZoneService) ListDedicatedZonesWhere(p *ListDedicatedZonesParams, pred func(*DedicatedZone) bool) ([]*DedicatedZone, error) {
+	var r []*DedicatedZone
+	ch, errs := s.StreamDedicatedZones(context.Background(), p)
+	for v := range ch {
+		if pred(v) {
+			r = append(r, v)
+		}
+	}
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ListAllDedicatedZones returns the results of ListDedicatedZones across all pages, by calling ListDedicatedZones page by page until all
+// results are fetched. The pagesize set in the params is used for every page (500 if not set), and the
+// page set in the params is ignored.
+func (s *ZoneService) ListAllDedicatedZones(p *ListDedicatedZonesParams) ([]*DedicatedZone, error) {
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesizeIfUnset(500)
+	pagesize := pp.p["pagesize"].(int)
+
+	var r []*DedicatedZone
+	for page := 1; ; page++ {
+		pp.SetPage(page)
+
+		l, err := s.ListDedicatedZones(pp)
+		if err != nil {
+			return nil, err
+		}
+		r = append(r, l.DedicatedZones...)
+
+		if len(l.DedicatedZones) < pagesize || len(r) >= l.Count {
+			return r, nil
+		}
+	}
+}
+
 type ListDedicatedZonesResponse struct {
 	Count          int              `json:"count"`
 	DedicatedZones []*DedicatedZone `json:"dedicatedzone"`
@@ -834,6 +1615,16 @@ type ListVmwareDcsParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ListVmwareDcsParams) Clone() *ListVmwareDcsParams {
+	c := &ListVmwareDcsParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListVmwareDcsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -856,6 +1647,16 @@ func (p *ListVmwareDcsParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *ListVmwareDcsParams) validate() error {
+	for _, k := range []string{"zoneid"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of listVmwareDcs", k)
+		}
+	}
+	return nil
+}
+
 func (p *ListVmwareDcsParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -864,6 +1665,13 @@ func (p *ListVmwareDcsParams) SetKeyword(v string) {
 	return
 }
 
+// SetKeywordIfUnset sets keyword to v, unless it is already set
+func (p *ListVmwareDcsParams) SetKeywordIfUnset(v string) {
+	if _, found := p.p["keyword"]; !found {
+		p.SetKeyword(v)
+	}
+}
+
 func (p *ListVmwareDcsParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -872,6 +1680,13 @@ func (p *ListVmwareDcsParams) SetPage(v int) {
 	return
 }
 
+// SetPageIfUnset sets page to v, unless it is already set
+func (p *ListVmwareDcsParams) SetPageIfUnset(v int) {
+	if _, found := p.p["page"]; !found {
+		p.SetPage(v)
+	}
+}
+
 func (p *ListVmwareDcsParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -880,6 +1695,13 @@ func (p *ListVmwareDcsParams) SetPagesize(v int) {
 	return
 }
 
+// SetPagesizeIfUnset sets pagesize to v, unless it is already set
+func (p *ListVmwareDcsParams) SetPagesizeIfUnset(v int) {
+	if _, found := p.p["pagesize"]; !found {
+		p.SetPagesize(v)
+	}
+}
+
 func (p *ListVmwareDcsParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -888,15 +1710,33 @@ func (p *ListVmwareDcsParams) SetZoneid(v string) {
 	return
 }
 
+// SetZoneidIfUnset sets zoneid to v, unless it is already set
+func (p *ListVmwareDcsParams) SetZoneidIfUnset(v string) {
+	if _, found := p.p["zoneid"]; !found {
+		p.SetZoneid(v)
+	}
+}
+
 // You should always use this function to get a new ListVmwareDcsParams instance,
-// as then you are sure you have configured all required params
-func (s *ZoneService) NewListVmwareDcsParams(zoneid string) *ListVmwareDcsParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *ZoneService) NewListVmwareDcsParams(zoneid string, opts ...func(*ListVmwareDcsParams)) *ListVmwareDcsParams {
 	p := &ListVmwareDcsParams{}
 	p.p = make(map[string]interface{})
 	p.p["zoneid"] = zoneid
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
+func (p *ListVmwareDcsParams) setFirstMatch() {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p[firstMatchKey] = true
+}
+
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *ZoneService) GetVmwareDcID(keyword string, zoneid string, opts ...OptionFunc) (string, int, error) {
 	p := &ListVmwareDcsParams{}
@@ -905,7 +1745,7 @@ func (s *ZoneService) GetVmwareDcID(keyword string, zoneid string, opts ...Optio
 	p.p["keyword"] = keyword
 	p.p["zoneid"] = zoneid
 
-	for _, fn := range append(s.cs.options, opts...) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
 		if err := fn(s.cs, p); err != nil {
 			return "", -1, err
 		}
@@ -917,7 +1757,7 @@ func (s *ZoneService) GetVmwareDcID(keyword string, zoneid string, opts ...Optio
 	}
 
 	if l.Count == 0 {
-		return "", l.Count, fmt.Errorf("No match found for %s: %+v", keyword, l)
+		return "", l.Count, fmt.Errorf("%w for %s: %+v", ErrNotFound, keyword, l)
 	}
 
 	if l.Count == 1 {
@@ -930,13 +1770,36 @@ func (s *ZoneService) GetVmwareDcID(keyword string, zoneid string, opts ...Optio
 				return v.Id, l.Count, nil
 			}
 		}
+
+		if _, ok := p.p[firstMatchKey]; ok && len(l.VmwareDcs) > 0 {
+			return l.VmwareDcs[0].Id, l.Count, nil
+		}
 	}
 	return "", l.Count, fmt.Errorf("Could not find an exact match for %s: %+v", keyword, l)
 }
 
 // Retrieves VMware DC(s) associated with a zone.
 func (s *ZoneService) ListVmwareDcs(p *ListVmwareDcsParams) (*ListVmwareDcsResponse, error) {
-	resp, err := s.cs.newRequest("listVmwareDcs", p.toURLValues())
+	return s.ListVmwareDcsWithContext(context.Background(), p)
+}
+
+// ListVmwareDcsWithContext is the same as ListVmwareDcs, but the request is cancelled when the context is done
+func (s *ZoneService) ListVmwareDcsWithContext(ctx context.Context, p *ListVmwareDcsParams) (*ListVmwareDcsResponse, error) {
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.ListVmwareDcsRawWithContext(ctx, p.toURLValues())
+}
+
+// ListVmwareDcsRaw is the same as ListVmwareDcs, but takes the params as url.Values instead of typed params
+func (s *ZoneService) ListVmwareDcsRaw(v url.Values) (*ListVmwareDcsResponse, error) {
+	return s.ListVmwareDcsRawWithContext(context.Background(), v)
+}
+
+// ListVmwareDcsRawWithContext is the same as ListVmwareDcsRaw, but the request is cancelled when the context is done
+func (s *ZoneService) ListVmwareDcsRawWithContext(ctx context.Context, v url.Values) (*ListVmwareDcsResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "listVmwareDcs", "listvmwaredcsresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -949,6 +1812,93 @@ func (s *ZoneService) ListVmwareDcs(p *ListVmwareDcsParams) (*ListVmwareDcsRespo
 	return &r, nil
 }
 
+// StreamVmwareDcs fetches the results of ListVmwareDcs page by page in the background and sends them on the
+// returned channel. Both returned channels are closed when done. If fetching a page fails, or the context
+// is done before all results are sent, the error is sent on the error channel and fetching stops. A
+// request for a page in flight is cancelled when the context is done. The page and pagesize set in the
+// params are ignored.
+func (s *ZoneService) StreamVmwareDcs(ctx context.Context, p *ListVmwareDcsParams) (<-chan *VmwareDc, <-chan error) {
+	ch := make(chan *VmwareDc)
+	errs := make(chan error, 1)
+
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesize(500)
+
+	go func() {
+		defer close(errs)
+		defer close(ch)
+
+		for page, count := 1, 0; ; page++ {
+			pp.SetPage(page)
+
+			l, err := s.ListVmwareDcsWithContext(ctx, pp)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			for _, v := range l.VmwareDcs {
+				select {
+				case ch <- v:
+				case <-ctx.Done():
+					errs <- ctx.Err()
+					return
+				}
+			}
+
+			count += len(l.VmwareDcs)
+			if len(l.VmwareDcs) < 500 || count >= l.Count {
+				return
+			}
+		}
+	}()
+
+	return ch, errs
+}
+
+// ListVmwareDcsWhere returns all results of ListVmwareDcs for which pred returns true. Note that the results are
+// filtered client-side, so all results matching the params are fetched first.
+// All pages are fetched, so the page and pagesize set in the params are ignored.
+func (s *ZoneService) ListVmwareDcsWhere(p *ListVmwareDcsParams, pred func(*VmwareDc) bool) ([]*VmwareDc, error) {
+	var r []*VmwareDc
+	ch, errs := s.StreamVmwareDcs(context.Background(), p)
+	for v := range ch {
+		if pred(v) {
+			r = append(r, v)
+		}
+	}
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ListAllVmwareDcs returns the results of ListVmwareDcs across all pages, by calling ListVmwareDcs page by page until all
+// results are fetched. The pagesize set in the params is used for every page (500 if not set), and the
+// page set in the params is ignored.
+func (s *ZoneService) ListAllVmwareDcs(p *ListVmwareDcsParams) ([]*VmwareDc, error) {
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesizeIfUnset(500)
+	pagesize := pp.p["pagesize"].(int)
+
+	var r []*VmwareDc
+	for page := 1; ; page++ {
+		pp.SetPage(page)
+
+		l, err := s.ListVmwareDcs(pp)
+		if err != nil {
+			return nil, err
+		}
+		r = append(r, l.VmwareDcs...)
+
+		if len(l.VmwareDcs) < pagesize || len(r) >= l.Count {
+			return r, nil
+		}
+	}
+}
+
 type ListVmwareDcsResponse struct {
 	Count     int         `json:"count"`
 	VmwareDcs []*VmwareDc `json:"vmwaredc"`
@@ -965,6 +1915,16 @@ type ListZonesParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ListZonesParams) Clone() *ListZonesParams {
+	c := &ListZonesParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListZonesParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1002,11 +1962,10 @@ func (p *ListZonesParams) toURLValues() url.Values {
 		u.Set("showcapacities", vv)
 	}
 	if v, found := p.p["tags"]; found {
-		i := 0
-		for k, vv := range v.(map[string]string) {
+		m := v.(map[string]string)
+		for i, k := range getSortedKeysFromMap(m) {
 			u.Set(fmt.Sprintf("tags[%d].key", i), k)
-			u.Set(fmt.Sprintf("tags[%d].value", i), vv)
-			i++
+			u.Set(fmt.Sprintf("tags[%d].value", i), m[k])
 		}
 	}
 	return u
@@ -1020,6 +1979,13 @@ func (p *ListZonesParams) SetAvailable(v bool) {
 	return
 }
 
+// SetAvailableIfUnset sets available to v, unless it is already set
+func (p *ListZonesParams) SetAvailableIfUnset(v bool) {
+	if _, found := p.p["available"]; !found {
+		p.SetAvailable(v)
+	}
+}
+
 func (p *ListZonesParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1028,6 +1994,13 @@ func (p *ListZonesParams) SetDomainid(v string) {
 	return
 }
 
+// SetDomainidIfUnset sets domainid to v, unless it is already set
+func (p *ListZonesParams) SetDomainidIfUnset(v string) {
+	if _, found := p.p["domainid"]; !found {
+		p.SetDomainid(v)
+	}
+}
+
 func (p *ListZonesParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1036,6 +2009,13 @@ func (p *ListZonesParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *ListZonesParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 func (p *ListZonesParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1044,6 +2024,13 @@ func (p *ListZonesParams) SetKeyword(v string) {
 	return
 }
 
+// SetKeywordIfUnset sets keyword to v, unless it is already set
+func (p *ListZonesParams) SetKeywordIfUnset(v string) {
+	if _, found := p.p["keyword"]; !found {
+		p.SetKeyword(v)
+	}
+}
+
 func (p *ListZonesParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1052,6 +2039,13 @@ func (p *ListZonesParams) SetName(v string) {
 	return
 }
 
+// SetNameIfUnset sets name to v, unless it is already set
+func (p *ListZonesParams) SetNameIfUnset(v string) {
+	if _, found := p.p["name"]; !found {
+		p.SetName(v)
+	}
+}
+
 func (p *ListZonesParams) SetNetworktype(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1060,6 +2054,13 @@ func (p *ListZonesParams) SetNetworktype(v string) {
 	return
 }
 
+// SetNetworktypeIfUnset sets networktype to v, unless it is already set
+func (p *ListZonesParams) SetNetworktypeIfUnset(v string) {
+	if _, found := p.p["networktype"]; !found {
+		p.SetNetworktype(v)
+	}
+}
+
 func (p *ListZonesParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1068,6 +2069,13 @@ func (p *ListZonesParams) SetPage(v int) {
 	return
 }
 
+// SetPageIfUnset sets page to v, unless it is already set
+func (p *ListZonesParams) SetPageIfUnset(v int) {
+	if _, found := p.p["page"]; !found {
+		p.SetPage(v)
+	}
+}
+
 func (p *ListZonesParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1076,6 +2084,13 @@ func (p *ListZonesParams) SetPagesize(v int) {
 	return
 }
 
+// SetPagesizeIfUnset sets pagesize to v, unless it is already set
+func (p *ListZonesParams) SetPagesizeIfUnset(v int) {
+	if _, found := p.p["pagesize"]; !found {
+		p.SetPagesize(v)
+	}
+}
+
 func (p *ListZonesParams) SetShowcapacities(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1084,6 +2099,13 @@ func (p *ListZonesParams) SetShowcapacities(v bool) {
 	return
 }
 
+// SetShowcapacitiesIfUnset sets showcapacities to v, unless it is already set
+func (p *ListZonesParams) SetShowcapacitiesIfUnset(v bool) {
+	if _, found := p.p["showcapacities"]; !found {
+		p.SetShowcapacities(v)
+	}
+}
+
 func (p *ListZonesParams) SetTags(v map[string]string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1092,14 +2114,32 @@ func (p *ListZonesParams) SetTags(v map[string]string) {
 	return
 }
 
+// SetTagsIfUnset sets tags to v, unless it is already set
+func (p *ListZonesParams) SetTagsIfUnset(v map[string]string) {
+	if _, found := p.p["tags"]; !found {
+		p.SetTags(v)
+	}
+}
+
 // You should always use this function to get a new ListZonesParams instance,
-// as then you are sure you have configured all required params
-func (s *ZoneService) NewListZonesParams() *ListZonesParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *ZoneService) NewListZonesParams(opts ...func(*ListZonesParams)) *ListZonesParams {
 	p := &ListZonesParams{}
 	p.p = make(map[string]interface{})
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
+func (p *ListZonesParams) setFirstMatch() {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p[firstMatchKey] = true
+}
+
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *ZoneService) GetZoneID(name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListZonesParams{}
@@ -1107,7 +2147,7 @@ func (s *ZoneService) GetZoneID(name string, opts ...OptionFunc) (string, int, e
 
 	p.p["name"] = name
 
-	for _, fn := range append(s.cs.options, opts...) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
 		if err := fn(s.cs, p); err != nil {
 			return "", -1, err
 		}
@@ -1119,7 +2159,7 @@ func (s *ZoneService) GetZoneID(name string, opts ...OptionFunc) (string, int, e
 	}
 
 	if l.Count == 0 {
-		return "", l.Count, fmt.Errorf("No match found for %s: %+v", name, l)
+		return "", l.Count, fmt.Errorf("%w for %s: %+v", ErrNotFound, name, l)
 	}
 
 	if l.Count == 1 {
@@ -1132,6 +2172,10 @@ func (s *ZoneService) GetZoneID(name string, opts ...OptionFunc) (string, int, e
 				return v.Id, l.Count, nil
 			}
 		}
+
+		if _, ok := p.p[firstMatchKey]; ok && len(l.Zones) > 0 {
+			return l.Zones[0].Id, l.Count, nil
+		}
 	}
 	return "", l.Count, fmt.Errorf("Could not find an exact match for %s: %+v", name, l)
 }
@@ -1157,7 +2201,7 @@ func (s *ZoneService) GetZoneByID(id string, opts ...OptionFunc) (*Zone, int, er
 
 	p.p["id"] = id
 
-	for _, fn := range append(s.cs.options, opts...) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
 		if err := fn(s.cs, p); err != nil {
 			return nil, -1, err
 		}
@@ -1168,24 +2212,56 @@ func (s *ZoneService) GetZoneByID(id string, opts ...OptionFunc) (*Zone, int, er
 		if strings.Contains(err.Error(), fmt.Sprintf(
 			"Invalid parameter id value=%s due to incorrect long value format, "+
 				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+			return nil, 0, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
 	}
 
 	if l.Count == 1 {
 		return l.Zones[0], l.Count, nil
 	}
+
+	if _, ok := p.p[firstMatchKey]; ok && len(l.Zones) > 0 {
+		return l.Zones[0], l.Count, nil
+	}
 	return nil, l.Count, fmt.Errorf("There is more then one result for Zone UUID: %s!", id)
 }
 
+// ZoneExists returns true if a Zone with the given ID exists. When no match is found
+// it returns false, while any other error is returned as is.
+func (s *ZoneService) ZoneExists(id string, opts ...OptionFunc) (bool, error) {
+	_, count, err := s.GetZoneByID(id, opts...)
+	if errors.Is(err, ErrNotFound) {
+		return false, nil
+	}
+	if count > 0 {
+		return true, nil
+	}
+	return false, err
+}
+
 // Lists zones
 func (s *ZoneService) ListZones(p *ListZonesParams) (*ListZonesResponse, error) {
-	resp, err := s.cs.newRequest("listZones", p.toURLValues())
+	return s.ListZonesWithContext(context.Background(), p)
+}
+
+// ListZonesWithContext is the same as ListZones, but the request is cancelled when the context is done
+func (s *ZoneService) ListZonesWithContext(ctx context.Context, p *ListZonesParams) (*ListZonesResponse, error) {
+	return s.ListZonesRawWithContext(ctx, p.toURLValues())
+}
+
+// ListZonesRaw is the same as ListZones, but takes the params as url.Values instead of typed params
+func (s *ZoneService) ListZonesRaw(v url.Values) (*ListZonesResponse, error) {
+	return s.ListZonesRawWithContext(context.Background(), v)
+}
+
+// ListZonesRawWithContext is the same as ListZonesRaw, but the request is cancelled when the context is done
+func (s *ZoneService) ListZonesRawWithContext(ctx context.Context, v url.Values) (*ListZonesResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "listZones", "listzonesresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -1198,6 +2274,93 @@ func (s *ZoneService) ListZones(p *ListZonesParams) (*ListZonesResponse, error)
 	return &r, nil
 }
 
+// StreamZones fetches the results of ListZones page by page in the background and sends them on the
+// returned channel. Both returned channels are closed when done. If fetching a page fails, or the context
+// is done before all results are sent, the error is sent on the error channel and fetching stops. A
+// request for a page in flight is cancelled when the context is done. The page and pagesize set in the
+// params are ignored.
+func (s *ZoneService) StreamZones(ctx context.Context, p *ListZonesParams) (<-chan *Zone, <-chan error) {
+	ch := make(chan *Zone)
+	errs := make(chan error, 1)
+
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesize(500)
+
+	go func() {
+		defer close(errs)
+		defer close(ch)
+
+		for page, count := 1, 0; ; page++ {
+			pp.SetPage(page)
+
+			l, err := s.ListZonesWithContext(ctx, pp)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			for _, v := range l.Zones {
+				select {
+				case ch <- v:
+				case <-ctx.Done():
+					errs <- ctx.Err()
+					return
+				}
+			}
+
+			count += len(l.Zones)
+			if len(l.Zones) < 500 || count >= l.Count {
+				return
+			}
+		}
+	}()
+
+	return ch, errs
+}
+
+// ListZonesWhere returns all results of ListZones for which pred returns true. Note that the results are
+// filtered client-side, so all results matching the params are fetched first.
+// All pages are fetched, so the page and pagesize set in the params are ignored.
+func (s *ZoneService) ListZonesWhere(p *ListZonesParams, pred func(*Zone) bool) ([]*Zone, error) {
+	var r []*Zone
+	ch, errs := s.StreamZones(context.Background(), p)
+	for v := range ch {
+		if pred(v) {
+			r = append(r, v)
+		}
+	}
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ListAllZones returns the results of ListZones across all pages, by calling ListZones page by page until all
+// results are fetched. The pagesize set in the params is used for every page (500 if not set), and the
+// page set in the params is ignored.
+func (s *ZoneService) ListAllZones(p *ListZonesParams) ([]*Zone, error) {
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesizeIfUnset(500)
+	pagesize := pp.p["pagesize"].(int)
+
+	var r []*Zone
+	for page := 1; ; page++ {
+		pp.SetPage(page)
+
+		l, err := s.ListZones(pp)
+		if err != nil {
+			return nil, err
+		}
+		r = append(r, l.Zones...)
+
+		if len(l.Zones) < pagesize || len(r) >= l.Count {
+			return r, nil
+		}
+	}
+}
+
 type ListZonesResponse struct {
 	Count int     `json:"count"`
 	Zones []*Zone `json:"zone"`
@@ -1217,25 +2380,25 @@ type Zone struct {
 		Zoneid        string `json:"zoneid"`
 		Zonename      string `json:"zonename"`
 	} `json:"capacity"`
-	Description           string            `json:"description"`
-	Dhcpprovider          string            `json:"dhcpprovider"`
-	Displaytext           string            `json:"displaytext"`
-	Dns1                  string            `json:"dns1"`
-	Dns2                  string            `json:"dns2"`
-	Domain                string            `json:"domain"`
-	Domainid              string            `json:"domainid"`
-	Domainname            string            `json:"domainname"`
-	Guestcidraddress      string            `json:"guestcidraddress"`
-	Id                    string            `json:"id"`
-	Internaldns1          string            `json:"internaldns1"`
-	Internaldns2          string            `json:"internaldns2"`
-	Ip6dns1               string            `json:"ip6dns1"`
-	Ip6dns2               string            `json:"ip6dns2"`
-	Localstorageenabled   bool              `json:"localstorageenabled"`
-	Name                  string            `json:"name"`
-	Networktype           string            `json:"networktype"`
-	Resourcedetails       map[string]string `json:"resourcedetails"`
-	Securitygroupsenabled bool              `json:"securitygroupsenabled"`
+	Description           string    `json:"description"`
+	Dhcpprovider          string    `json:"dhcpprovider"`
+	Displaytext           string    `json:"displaytext"`
+	Dns1                  string    `json:"dns1"`
+	Dns2                  string    `json:"dns2"`
+	Domain                string    `json:"domain"`
+	Domainid              string    `json:"domainid"`
+	Domainname            string    `json:"domainname"`
+	Guestcidraddress      string    `json:"guestcidraddress"`
+	Id                    string    `json:"id"`
+	Internaldns1          string    `json:"internaldns1"`
+	Internaldns2          string    `json:"internaldns2"`
+	Ip6dns1               string    `json:"ip6dns1"`
+	Ip6dns2               string    `json:"ip6dns2"`
+	Localstorageenabled   bool      `json:"localstorageenabled"`
+	Name                  string    `json:"name"`
+	Networktype           string    `json:"networktype"`
+	Resourcedetails       StringMap `json:"resourcedetails"`
+	Securitygroupsenabled bool      `json:"securitygroupsenabled"`
 	Tags                  []struct {
 		Account      string `json:"account"`
 		Customer     string `json:"customer"`
@@ -1255,6 +2418,16 @@ type ReleaseDedicatedZoneParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ReleaseDedicatedZoneParams) Clone() *ReleaseDedicatedZoneParams {
+	c := &ReleaseDedicatedZoneParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ReleaseDedicatedZoneParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1266,6 +2439,16 @@ func (p *ReleaseDedicatedZoneParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *ReleaseDedicatedZoneParams) validate() error {
+	for _, k := range []string{"zoneid"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of releaseDedicatedZone", k)
+		}
+	}
+	return nil
+}
+
 func (p *ReleaseDedicatedZoneParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1274,18 +2457,54 @@ func (p *ReleaseDedicatedZoneParams) SetZoneid(v string) {
 	return
 }
 
+// SetZoneidIfUnset sets zoneid to v, unless it is already set
+func (p *ReleaseDedicatedZoneParams) SetZoneidIfUnset(v string) {
+	if _, found := p.p["zoneid"]; !found {
+		p.SetZoneid(v)
+	}
+}
+
 // You should always use this function to get a new ReleaseDedicatedZoneParams instance,
-// as then you are sure you have configured all required params
-func (s *ZoneService) NewReleaseDedicatedZoneParams(zoneid string) *ReleaseDedicatedZoneParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *ZoneService) NewReleaseDedicatedZoneParams(zoneid string, opts ...func(*ReleaseDedicatedZoneParams)) *ReleaseDedicatedZoneParams {
 	p := &ReleaseDedicatedZoneParams{}
 	p.p = make(map[string]interface{})
 	p.p["zoneid"] = zoneid
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Release dedication of zone
-func (s *ZoneService) ReleaseDedicatedZone(p *ReleaseDedicatedZoneParams) (*ReleaseDedicatedZoneResponse, error) {
-	resp, err := s.cs.newRequest("releaseDedicatedZone", p.toURLValues())
+func (s *ZoneService) ReleaseDedicatedZone(p *ReleaseDedicatedZoneParams, opts ...OptionFunc) (*ReleaseDedicatedZoneResponse, error) {
+	return s.ReleaseDedicatedZoneWithContext(context.Background(), p, opts...)
+}
+
+// ReleaseDedicatedZoneWithContext is the same as ReleaseDedicatedZone, but the request is cancelled when the context is done
+func (s *ZoneService) ReleaseDedicatedZoneWithContext(ctx context.Context, p *ReleaseDedicatedZoneParams, opts ...OptionFunc) (*ReleaseDedicatedZoneResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.ReleaseDedicatedZoneRawWithContext(ctx, p.toURLValues())
+}
+
+// ReleaseDedicatedZoneRaw is the same as ReleaseDedicatedZone, but takes the params as url.Values instead of typed params
+func (s *ZoneService) ReleaseDedicatedZoneRaw(v url.Values) (*ReleaseDedicatedZoneResponse, error) {
+	return s.ReleaseDedicatedZoneRawWithContext(context.Background(), v)
+}
+
+// ReleaseDedicatedZoneRawWithContext is the same as ReleaseDedicatedZoneRaw, but the request is cancelled when the context is done
+func (s *ZoneService) ReleaseDedicatedZoneRawWithContext(ctx context.Context, v url.Values) (*ReleaseDedicatedZoneResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "releaseDedicatedZone", "releasededicatedzoneresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -1297,9 +2516,9 @@ func (s *ZoneService) ReleaseDedicatedZone(p *ReleaseDedicatedZoneParams) (*Rele
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
 				return &r, err
 			}
 			return nil, err
@@ -1323,6 +2542,16 @@ type RemoveVmwareDcParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *RemoveVmwareDcParams) Clone() *RemoveVmwareDcParams {
+	c := &RemoveVmwareDcParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *RemoveVmwareDcParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1334,6 +2563,16 @@ func (p *RemoveVmwareDcParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *RemoveVmwareDcParams) validate() error {
+	for _, k := range []string{"zoneid"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of removeVmwareDc", k)
+		}
+	}
+	return nil
+}
+
 func (p *RemoveVmwareDcParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1342,18 +2581,54 @@ func (p *RemoveVmwareDcParams) SetZoneid(v string) {
 	return
 }
 
+// SetZoneidIfUnset sets zoneid to v, unless it is already set
+func (p *RemoveVmwareDcParams) SetZoneidIfUnset(v string) {
+	if _, found := p.p["zoneid"]; !found {
+		p.SetZoneid(v)
+	}
+}
+
 // You should always use this function to get a new RemoveVmwareDcParams instance,
-// as then you are sure you have configured all required params
-func (s *ZoneService) NewRemoveVmwareDcParams(zoneid string) *RemoveVmwareDcParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *ZoneService) NewRemoveVmwareDcParams(zoneid string, opts ...func(*RemoveVmwareDcParams)) *RemoveVmwareDcParams {
 	p := &RemoveVmwareDcParams{}
 	p.p = make(map[string]interface{})
 	p.p["zoneid"] = zoneid
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Remove a VMware datacenter from a zone.
-func (s *ZoneService) RemoveVmwareDc(p *RemoveVmwareDcParams) (*RemoveVmwareDcResponse, error) {
-	resp, err := s.cs.newRequest("removeVmwareDc", p.toURLValues())
+func (s *ZoneService) RemoveVmwareDc(p *RemoveVmwareDcParams, opts ...OptionFunc) (*RemoveVmwareDcResponse, error) {
+	return s.RemoveVmwareDcWithContext(context.Background(), p, opts...)
+}
+
+// RemoveVmwareDcWithContext is the same as RemoveVmwareDc, but the request is cancelled when the context is done
+func (s *ZoneService) RemoveVmwareDcWithContext(ctx context.Context, p *RemoveVmwareDcParams, opts ...OptionFunc) (*RemoveVmwareDcResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.RemoveVmwareDcRawWithContext(ctx, p.toURLValues())
+}
+
+// RemoveVmwareDcRaw is the same as RemoveVmwareDc, but takes the params as url.Values instead of typed params
+func (s *ZoneService) RemoveVmwareDcRaw(v url.Values) (*RemoveVmwareDcResponse, error) {
+	return s.RemoveVmwareDcRawWithContext(context.Background(), v)
+}
+
+// RemoveVmwareDcRawWithContext is the same as RemoveVmwareDcRaw, but the request is cancelled when the context is done
+func (s *ZoneService) RemoveVmwareDcRawWithContext(ctx context.Context, v url.Values) (*RemoveVmwareDcResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "removeVmwareDc", "removevmwaredcresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -1394,6 +2669,16 @@ type UpdateZoneParams struct {
 	p map[string]interface{}
 }
 
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *UpdateZoneParams) Clone() *UpdateZoneParams {
+	c := &UpdateZoneParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *UpdateZoneParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1403,10 +2688,17 @@ func (p *UpdateZoneParams) toURLValues() url.Values {
 		u.Set("allocationstate", v.(string))
 	}
 	if v, found := p.p["details"]; found {
-		i := 0
-		for k, vv := range v.(map[string]string) {
-			u.Set(fmt.Sprintf("details[%d].%s", i, k), vv)
-			i++
+		switch m := v.(type) {
+		case []KeyValuePair:
+			for i, kv := range m {
+				u.Set(fmt.Sprintf("details[%d].key", i), kv.Key)
+				u.Set(fmt.Sprintf("details[%d].value", i), kv.Value)
+			}
+		case map[string]string:
+			for i, k := range getSortedKeysFromMap(m) {
+				u.Set(fmt.Sprintf("details[%d].key", i), k)
+				u.Set(fmt.Sprintf("details[%d].value", i), m[k])
+			}
 		}
 	}
 	if v, found := p.p["dhcpprovider"]; found {
@@ -1457,6 +2749,16 @@ func (p *UpdateZoneParams) toURLValues() url.Values {
 	return u
 }
 
+// Returns an error naming the first required param which is not set
+func (p *UpdateZoneParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of updateZone", k)
+		}
+	}
+	return nil
+}
+
 func (p *UpdateZoneParams) SetAllocationstate(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1465,6 +2767,13 @@ func (p *UpdateZoneParams) SetAllocationstate(v string) {
 	return
 }
 
+// SetAllocationstateIfUnset sets allocationstate to v, unless it is already set
+func (p *UpdateZoneParams) SetAllocationstateIfUnset(v string) {
+	if _, found := p.p["allocationstate"]; !found {
+		p.SetAllocationstate(v)
+	}
+}
+
 func (p *UpdateZoneParams) SetDetails(v map[string]string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1473,6 +2782,21 @@ func (p *UpdateZoneParams) SetDetails(v map[string]string) {
 	return
 }
 
+// SetDetailsIfUnset sets details to v, unless it is already set
+func (p *UpdateZoneParams) SetDetailsIfUnset(v map[string]string) {
+	if _, found := p.p["details"]; !found {
+		p.SetDetails(v)
+	}
+}
+
+// SetDetailsKV sets the details as key/value pairs, which are encoded in the given order
+func (p *UpdateZoneParams) SetDetailsKV(v []KeyValuePair) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["details"] = v
+}
+
 func (p *UpdateZoneParams) SetDhcpprovider(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1481,6 +2805,13 @@ func (p *UpdateZoneParams) SetDhcpprovider(v string) {
 	return
 }
 
+// SetDhcpproviderIfUnset sets dhcpprovider to v, unless it is already set
+func (p *UpdateZoneParams) SetDhcpproviderIfUnset(v string) {
+	if _, found := p.p["dhcpprovider"]; !found {
+		p.SetDhcpprovider(v)
+	}
+}
+
 func (p *UpdateZoneParams) SetDns1(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1489,6 +2820,13 @@ func (p *UpdateZoneParams) SetDns1(v string) {
 	return
 }
 
+// SetDns1IfUnset sets dns1 to v, unless it is already set
+func (p *UpdateZoneParams) SetDns1IfUnset(v string) {
+	if _, found := p.p["dns1"]; !found {
+		p.SetDns1(v)
+	}
+}
+
 func (p *UpdateZoneParams) SetDns2(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1497,6 +2835,13 @@ func (p *UpdateZoneParams) SetDns2(v string) {
 	return
 }
 
+// SetDns2IfUnset sets dns2 to v, unless it is already set
+func (p *UpdateZoneParams) SetDns2IfUnset(v string) {
+	if _, found := p.p["dns2"]; !found {
+		p.SetDns2(v)
+	}
+}
+
 func (p *UpdateZoneParams) SetDnssearchorder(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1505,6 +2850,18 @@ func (p *UpdateZoneParams) SetDnssearchorder(v []string) {
 	return
 }
 
+// SetDnssearchorderIfUnset sets dnssearchorder to v, unless it is already set
+func (p *UpdateZoneParams) SetDnssearchorderIfUnset(v []string) {
+	if _, found := p.p["dnssearchorder"]; !found {
+		p.SetDnssearchorder(v)
+	}
+}
+
+// SetDnssearchorderCSV splits v on commas and sets the resulting values
+func (p *UpdateZoneParams) SetDnssearchorderCSV(v string) {
+	p.SetDnssearchorder(splitCSV(v))
+}
+
 func (p *UpdateZoneParams) SetDomain(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1513,6 +2870,13 @@ func (p *UpdateZoneParams) SetDomain(v string) {
 	return
 }
 
+// SetDomainIfUnset sets domain to v, unless it is already set
+func (p *UpdateZoneParams) SetDomainIfUnset(v string) {
+	if _, found := p.p["domain"]; !found {
+		p.SetDomain(v)
+	}
+}
+
 func (p *UpdateZoneParams) SetGuestcidraddress(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1521,6 +2885,13 @@ func (p *UpdateZoneParams) SetGuestcidraddress(v string) {
 	return
 }
 
+// SetGuestcidraddressIfUnset sets guestcidraddress to v, unless it is already set
+func (p *UpdateZoneParams) SetGuestcidraddressIfUnset(v string) {
+	if _, found := p.p["guestcidraddress"]; !found {
+		p.SetGuestcidraddress(v)
+	}
+}
+
 func (p *UpdateZoneParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1529,6 +2900,13 @@ func (p *UpdateZoneParams) SetId(v string) {
 	return
 }
 
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *UpdateZoneParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
 func (p *UpdateZoneParams) SetInternaldns1(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1537,6 +2915,13 @@ func (p *UpdateZoneParams) SetInternaldns1(v string) {
 	return
 }
 
+// SetInternaldns1IfUnset sets internaldns1 to v, unless it is already set
+func (p *UpdateZoneParams) SetInternaldns1IfUnset(v string) {
+	if _, found := p.p["internaldns1"]; !found {
+		p.SetInternaldns1(v)
+	}
+}
+
 func (p *UpdateZoneParams) SetInternaldns2(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1545,6 +2930,13 @@ func (p *UpdateZoneParams) SetInternaldns2(v string) {
 	return
 }
 
+// SetInternaldns2IfUnset sets internaldns2 to v, unless it is already set
+func (p *UpdateZoneParams) SetInternaldns2IfUnset(v string) {
+	if _, found := p.p["internaldns2"]; !found {
+		p.SetInternaldns2(v)
+	}
+}
+
 func (p *UpdateZoneParams) SetIp6dns1(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1553,6 +2945,13 @@ func (p *UpdateZoneParams) SetIp6dns1(v string) {
 	return
 }
 
+// SetIp6dns1IfUnset sets ip6dns1 to v, unless it is already set
+func (p *UpdateZoneParams) SetIp6dns1IfUnset(v string) {
+	if _, found := p.p["ip6dns1"]; !found {
+		p.SetIp6dns1(v)
+	}
+}
+
 func (p *UpdateZoneParams) SetIp6dns2(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1561,6 +2960,13 @@ func (p *UpdateZoneParams) SetIp6dns2(v string) {
 	return
 }
 
+// SetIp6dns2IfUnset sets ip6dns2 to v, unless it is already set
+func (p *UpdateZoneParams) SetIp6dns2IfUnset(v string) {
+	if _, found := p.p["ip6dns2"]; !found {
+		p.SetIp6dns2(v)
+	}
+}
+
 func (p *UpdateZoneParams) SetIspublic(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1569,6 +2975,13 @@ func (p *UpdateZoneParams) SetIspublic(v bool) {
 	return
 }
 
+// SetIspublicIfUnset sets ispublic to v, unless it is already set
+func (p *UpdateZoneParams) SetIspublicIfUnset(v bool) {
+	if _, found := p.p["ispublic"]; !found {
+		p.SetIspublic(v)
+	}
+}
+
 func (p *UpdateZoneParams) SetLocalstorageenabled(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1577,6 +2990,13 @@ func (p *UpdateZoneParams) SetLocalstorageenabled(v bool) {
 	return
 }
 
+// SetLocalstorageenabledIfUnset sets localstorageenabled to v, unless it is already set
+func (p *UpdateZoneParams) SetLocalstorageenabledIfUnset(v bool) {
+	if _, found := p.p["localstorageenabled"]; !found {
+		p.SetLocalstorageenabled(v)
+	}
+}
+
 func (p *UpdateZoneParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1585,18 +3005,54 @@ func (p *UpdateZoneParams) SetName(v string) {
 	return
 }
 
+// SetNameIfUnset sets name to v, unless it is already set
+func (p *UpdateZoneParams) SetNameIfUnset(v string) {
+	if _, found := p.p["name"]; !found {
+		p.SetName(v)
+	}
+}
+
 // You should always use this function to get a new UpdateZoneParams instance,
-// as then you are sure you have configured all required params
-func (s *ZoneService) NewUpdateZoneParams(id string) *UpdateZoneParams {
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *ZoneService) NewUpdateZoneParams(id string, opts ...func(*UpdateZoneParams)) *UpdateZoneParams {
 	p := &UpdateZoneParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
 	return p
 }
 
 // Updates a Zone.
-func (s *ZoneService) UpdateZone(p *UpdateZoneParams) (*UpdateZoneResponse, error) {
-	resp, err := s.cs.newRequest("updateZone", p.toURLValues())
+func (s *ZoneService) UpdateZone(p *UpdateZoneParams, opts ...OptionFunc) (*UpdateZoneResponse, error) {
+	return s.UpdateZoneWithContext(context.Background(), p, opts...)
+}
+
+// UpdateZoneWithContext is the same as UpdateZone, but the request is cancelled when the context is done
+func (s *ZoneService) UpdateZoneWithContext(ctx context.Context, p *UpdateZoneParams, opts ...OptionFunc) (*UpdateZoneResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.UpdateZoneRawWithContext(ctx, p.toURLValues())
+}
+
+// UpdateZoneRaw is the same as UpdateZone, but takes the params as url.Values instead of typed params
+func (s *ZoneService) UpdateZoneRaw(v url.Values) (*UpdateZoneResponse, error) {
+	return s.UpdateZoneRawWithContext(context.Background(), v)
+}
+
+// UpdateZoneRawWithContext is the same as UpdateZoneRaw, but the request is cancelled when the context is done
+func (s *ZoneService) UpdateZoneRawWithContext(ctx context.Context, v url.Values) (*UpdateZoneResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "updateZone", "updatezoneresponse", v)
 	if err != nil {
 		return nil, err
 	}
@@ -1623,25 +3079,25 @@ type UpdateZoneResponse struct {
 		Zoneid        string `json:"zoneid"`
 		Zonename      string `json:"zonename"`
 	} `json:"capacity"`
-	Description           string            `json:"description"`
-	Dhcpprovider          string            `json:"dhcpprovider"`
-	Displaytext           string            `json:"displaytext"`
-	Dns1                  string            `json:"dns1"`
-	Dns2                  string            `json:"dns2"`
-	Domain                string            `json:"domain"`
-	Domainid              string            `json:"domainid"`
-	Domainname            string            `json:"domainname"`
-	Guestcidraddress      string            `json:"guestcidraddress"`
-	Id                    string            `json:"id"`
-	Internaldns1          string            `json:"internaldns1"`
-	Internaldns2          string            `json:"internaldns2"`
-	Ip6dns1               string            `json:"ip6dns1"`
-	Ip6dns2               string            `json:"ip6dns2"`
-	Localstorageenabled   bool              `json:"localstorageenabled"`
-	Name                  string            `json:"name"`
-	Networktype           string            `json:"networktype"`
-	Resourcedetails       map[string]string `json:"resourcedetails"`
-	Securitygroupsenabled bool              `json:"securitygroupsenabled"`
+	Description           string    `json:"description"`
+	Dhcpprovider          string    `json:"dhcpprovider"`
+	Displaytext           string    `json:"displaytext"`
+	Dns1                  string    `json:"dns1"`
+	Dns2                  string    `json:"dns2"`
+	Domain                string    `json:"domain"`
+	Domainid              string    `json:"domainid"`
+	Domainname            string    `json:"domainname"`
+	Guestcidraddress      string    `json:"guestcidraddress"`
+	Id                    string    `json:"id"`
+	Internaldns1          string    `json:"internaldns1"`
+	Internaldns2          string    `json:"internaldns2"`
+	Ip6dns1               string    `json:"ip6dns1"`
+	Ip6dns2               string    `json:"ip6dns2"`
+	Localstorageenabled   bool      `json:"localstorageenabled"`
+	Name                  string    `json:"name"`
+	Networktype           string    `json:"networktype"`
+	Resourcedetails       StringMap `json:"resourcedetails"`
+	Securitygroupsenabled bool      `json:"securitygroupsenabled"`
 	Tags                  []struct {
 		Account      string `json:"account"`
 		Customer     string `json:"customer"`
@@ -0,0 +1,209 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstack
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha1"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// Run with -race to detect concurrent access of the default options
+func TestDefaultOptionsConcurrentUse(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"listzonesresponse":{"count":1,"zone":[{"id":"%s","name":"zone1"}]}}`, r.FormValue("id"))
+	}))
+	defer ts.Close()
+
+	cs := NewClient(ts.URL, "apikey", "secret", false)
+	noop := func(*CloudStackClient, interface{}) error { return nil }
+
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 50; j++ {
+				cs.DefaultOptions(noop, noop)
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 50; j++ {
+				if _, _, err := cs.Zone.GetZoneByID("zone-id", noop); err != nil {
+					t.Error(err)
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+// The body of POST requests must be encoded exactly as the params were signed, including values
+// containing a plus sign or a space, which are escaped differently by other encoders
+func TestPOSTBodyMatchesSignedString(t *testing.T) {
+	var body string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			t.Error(err)
+		}
+		body = string(b)
+		fmt.Fprint(w, `{"deployvirtualmachineresponse":{"id":"vm-id","jobid":"job-id"}}`)
+	}))
+	defer ts.Close()
+
+	cs := NewClient(ts.URL, "apikey", "secret", false)
+	p := cs.VirtualMachine.NewDeployVirtualMachineParams("offering-id", "template-id", "zone-id")
+	p.SetName("my vm")
+	p.SetDisplayname("web+db server")
+	p.SetUserdata("aGk+Lw==")
+
+	if _, err := cs.VirtualMachine.DeployVirtualMachine(p); err != nil {
+		t.Fatal(err)
+	}
+
+	i := strings.Index(body, "&signature=")
+	if i < 0 {
+		t.Fatalf("Expected the body to contain the signature, got: %s", body)
+	}
+	signed, signature := body[:i], body[i+len("&signature="):]
+
+	params, err := url.ParseQuery(signed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for k, want := range map[string]string{"name": "my vm", "displayname": "web+db server", "userdata": "aGk+Lw=="} {
+		if got := params.Get(k); got != want {
+			t.Errorf("Expected %s to be %q, got %q", k, want, got)
+		}
+	}
+
+	// The server signs the params as sent, so the body must be the signed string
+	if want := encodeValues(params); signed != want {
+		t.Errorf("Expected the body to be the signed string:\n%s\ngot:\n%s", want, signed)
+	}
+
+	mac := hmac.New(sha1.New, []byte("secret"))
+	mac.Write([]byte(strings.Replace(strings.ToLower(signed), "+", "%20", -1)))
+	want := base64.StdEncoding.EncodeToString(mac.Sum(nil))
+	if got, err := url.QueryUnescape(signature); err != nil || got != want {
+		t.Errorf("Expected the signature to be %q, got %q (%v)", want, got, err)
+	}
+}
+
+// Returns a server which rate limits the first limited requests with the given Retry-After header, and
+// lists a single zone for all other requests
+func newRateLimitedServer(limited int32, retryAfter string) (*httptest.Server, *int32) {
+	var requests int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&requests, 1) <= limited {
+			w.Header().Set("Retry-After", retryAfter)
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		fmt.Fprint(w, `{"listzonesresponse":{"count":1,"zone":[{"id":"zone-id","name":"zone1"}]}}`)
+	}))
+	return ts, &requests
+}
+
+func TestRateLimitRetry(t *testing.T) {
+	ts, requests := newRateLimitedServer(1, "1")
+	defer ts.Close()
+
+	cs := NewClient(ts.URL, "apikey", "secret", false, WithRetryableErrorCodes(http.StatusTooManyRequests))
+
+	start := time.Now()
+	l, err := cs.Zone.ListZones(cs.Zone.NewListZonesParams())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if l.Count != 1 || atomic.LoadInt32(requests) != 2 {
+		t.Errorf("Expected 1 zone after 2 requests, got %d zones after %d requests", l.Count, atomic.LoadInt32(requests))
+	}
+	if waited := time.Since(start); waited < time.Second {
+		t.Errorf("Expected to wait for the Retry-After duration of 1s before retrying, waited %s", waited)
+	}
+}
+
+func TestRateLimitRetryCancelled(t *testing.T) {
+	ts, requests := newRateLimitedServer(1, "60")
+	defer ts.Close()
+
+	cs := NewClient(ts.URL, "apikey", "secret", false, WithRetryableErrorCodes(http.StatusTooManyRequests))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, err := cs.Zone.ListZonesWithContext(ctx, cs.Zone.NewListZonesParams())
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected the context deadline to be exceeded, got: %v", err)
+	}
+	if waited := time.Since(start); waited > 10*time.Second {
+		t.Errorf("Expected to stop waiting when the context is done, waited %s", waited)
+	}
+	if n := atomic.LoadInt32(requests); n != 1 {
+		t.Errorf("Expected 1 request, got %d", n)
+	}
+}
+
+func TestRateLimitError(t *testing.T) {
+	date := time.Now().Add(time.Hour).UTC().Format(http.TimeFormat)
+
+	tests := []struct {
+		retryAfter string
+		want       time.Duration
+	}{
+		{"120", 120 * time.Second},
+		{date, time.Hour},
+	}
+
+	for _, tt := range tests {
+		ts, requests := newRateLimitedServer(1, tt.retryAfter)
+		cs := NewClient(ts.URL, "apikey", "secret", false)
+
+		_, err := cs.Zone.ListZones(cs.Zone.NewListZonesParams())
+		ts.Close()
+
+		var rl *RateLimitError
+		if !errors.As(err, &rl) {
+			t.Errorf("Retry-After %q: expected a *RateLimitError, got: %v", tt.retryAfter, err)
+			continue
+		}
+		// An HTTP date only has a precision of seconds
+		if d := tt.want - rl.RetryAfter; d < 0 || d > 2*time.Second {
+			t.Errorf("Retry-After %q: expected RetryAfter to be %s, got %s", tt.retryAfter, tt.want, rl.RetryAfter)
+		}
+		if n := atomic.LoadInt32(requests); n != 1 {
+			t.Errorf("Retry-After %q: expected no retries when retries are disabled, got %d requests", tt.retryAfter, n)
+		}
+	}
+}
@@ -0,0 +1,3957 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstack
+
+import (
+	"context"
+	"io"
+	"net/url"
+	"time"
+)
+
+// APIDiscoveryServiceIface contains all exported methods of APIDiscoveryService, so callers can depend on it to mock the service
+type APIDiscoveryServiceIface interface {
+	ListApis(p *ListApisParams) (*ListApisResponse, error)
+	ListApisRaw(v url.Values) (*ListApisResponse, error)
+	ListApisRawWithContext(ctx context.Context, v url.Values) (*ListApisResponse, error)
+	ListApisWhere(p *ListApisParams, pred func(*Api) bool) ([]*Api, error)
+	ListApisWithContext(ctx context.Context, p *ListApisParams) (*ListApisResponse, error)
+	NewListApisParams(opts ...func(*ListApisParams)) *ListApisParams
+}
+
+var _ APIDiscoveryServiceIface = (*APIDiscoveryService)(nil)
+
+// AccountServiceIface contains all exported methods of AccountService, so callers can depend on it to mock the service
+type AccountServiceIface interface {
+	AccountExists(id string, opts ...OptionFunc) (bool, error)
+	AddAccountToProject(p *AddAccountToProjectParams, opts ...OptionFunc) (*AddAccountToProjectResponse, error)
+	AddAccountToProjectRaw(v url.Values) (*AddAccountToProjectResponse, error)
+	AddAccountToProjectRawWithContext(ctx context.Context, v url.Values) (*AddAccountToProjectResponse, error)
+	AddAccountToProjectWithContext(ctx context.Context, p *AddAccountToProjectParams, opts ...OptionFunc) (*AddAccountToProjectResponse, error)
+	CreateAccount(p *CreateAccountParams, opts ...OptionFunc) (*CreateAccountResponse, error)
+	CreateAccountRaw(v url.Values) (*CreateAccountResponse, error)
+	CreateAccountRawWithContext(ctx context.Context, v url.Values) (*CreateAccountResponse, error)
+	CreateAccountWithContext(ctx context.Context, p *CreateAccountParams, opts ...OptionFunc) (*CreateAccountResponse, error)
+	DeleteAccount(p *DeleteAccountParams, opts ...OptionFunc) (*DeleteAccountResponse, error)
+	DeleteAccountFromProject(p *DeleteAccountFromProjectParams, opts ...OptionFunc) (*DeleteAccountFromProjectResponse, error)
+	DeleteAccountFromProjectRaw(v url.Values) (*DeleteAccountFromProjectResponse, error)
+	DeleteAccountFromProjectRawWithContext(ctx context.Context, v url.Values) (*DeleteAccountFromProjectResponse, error)
+	DeleteAccountFromProjectWithContext(ctx context.Context, p *DeleteAccountFromProjectParams, opts ...OptionFunc) (*DeleteAccountFromProjectResponse, error)
+	DeleteAccountRaw(v url.Values) (*DeleteAccountResponse, error)
+	DeleteAccountRawWithContext(ctx context.Context, v url.Values) (*DeleteAccountResponse, error)
+	DeleteAccountWithContext(ctx context.Context, p *DeleteAccountParams, opts ...OptionFunc) (*DeleteAccountResponse, error)
+	DisableAccount(p *DisableAccountParams, opts ...OptionFunc) (*DisableAccountResponse, error)
+	DisableAccountRaw(v url.Values) (*DisableAccountResponse, error)
+	DisableAccountRawWithContext(ctx context.Context, v url.Values) (*DisableAccountResponse, error)
+	DisableAccountWithContext(ctx context.Context, p *DisableAccountParams, opts ...OptionFunc) (*DisableAccountResponse, error)
+	EnableAccount(p *EnableAccountParams, opts ...OptionFunc) (*EnableAccountResponse, error)
+	EnableAccountRaw(v url.Values) (*EnableAccountResponse, error)
+	EnableAccountRawWithContext(ctx context.Context, v url.Values) (*EnableAccountResponse, error)
+	EnableAccountWithContext(ctx context.Context, p *EnableAccountParams, opts ...OptionFunc) (*EnableAccountResponse, error)
+	GetAccountByID(id string, opts ...OptionFunc) (*Account, int, error)
+	GetAccountByName(name string, opts ...OptionFunc) (*Account, int, error)
+	GetAccountID(name string, opts ...OptionFunc) (string, int, error)
+	GetProjectAccountID(keyword string, projectid string, opts ...OptionFunc) (string, int, error)
+	GetSolidFireAccountId(p *GetSolidFireAccountIdParams, opts ...OptionFunc) (*GetSolidFireAccountIdResponse, error)
+	GetSolidFireAccountIdRaw(v url.Values) (*GetSolidFireAccountIdResponse, error)
+	GetSolidFireAccountIdRawWithContext(ctx context.Context, v url.Values) (*GetSolidFireAccountIdResponse, error)
+	GetSolidFireAccountIdWithContext(ctx context.Context, p *GetSolidFireAccountIdParams, opts ...OptionFunc) (*GetSolidFireAccountIdResponse, error)
+	ListAccounts(p *ListAccountsParams) (*ListAccountsResponse, error)
+	ListAccountsRaw(v url.Values) (*ListAccountsResponse, error)
+	ListAccountsRawWithContext(ctx context.Context, v url.Values) (*ListAccountsResponse, error)
+	ListAccountsWhere(p *ListAccountsParams, pred func(*Account) bool) ([]*Account, error)
+	ListAccountsWithContext(ctx context.Context, p *ListAccountsParams) (*ListAccountsResponse, error)
+	ListAllAccounts(p *ListAccountsParams) ([]*Account, error)
+	ListAllProjectAccounts(p *ListProjectAccountsParams) ([]*ProjectAccount, error)
+	ListProjectAccounts(p *ListProjectAccountsParams) (*ListProjectAccountsResponse, error)
+	ListProjectAccountsRaw(v url.Values) (*ListProjectAccountsResponse, error)
+	ListProjectAccountsRawWithContext(ctx context.Context, v url.Values) (*ListProjectAccountsResponse, error)
+	ListProjectAccountsWhere(p *ListProjectAccountsParams, pred func(*ProjectAccount) bool) ([]*ProjectAccount, error)
+	ListProjectAccountsWithContext(ctx context.Context, p *ListProjectAccountsParams) (*ListProjectAccountsResponse, error)
+	LockAccount(p *LockAccountParams, opts ...OptionFunc) (*LockAccountResponse, error)
+	LockAccountRaw(v url.Values) (*LockAccountResponse, error)
+	LockAccountRawWithContext(ctx context.Context, v url.Values) (*LockAccountResponse, error)
+	LockAccountWithContext(ctx context.Context, p *LockAccountParams, opts ...OptionFunc) (*LockAccountResponse, error)
+	MarkDefaultZoneForAccount(p *MarkDefaultZoneForAccountParams, opts ...OptionFunc) (*MarkDefaultZoneForAccountResponse, error)
+	MarkDefaultZoneForAccountRaw(v url.Values) (*MarkDefaultZoneForAccountResponse, error)
+	MarkDefaultZoneForAccountRawWithContext(ctx context.Context, v url.Values) (*MarkDefaultZoneForAccountResponse, error)
+	MarkDefaultZoneForAccountWithContext(ctx context.Context, p *MarkDefaultZoneForAccountParams, opts ...OptionFunc) (*MarkDefaultZoneForAccountResponse, error)
+	NewAddAccountToProjectParams(projectid string, opts ...func(*AddAccountToProjectParams)) *AddAccountToProjectParams
+	NewCreateAccountParams(email string, firstname string, lastname string, password string, username string, opts ...func(*CreateAccountParams)) *CreateAccountParams
+	NewCreateAccountParamsFromOpts(o CreateAccountOpts) *CreateAccountParams
+	NewDeleteAccountFromProjectParams(account string, projectid string, opts ...func(*DeleteAccountFromProjectParams)) *DeleteAccountFromProjectParams
+	NewDeleteAccountParams(id string, opts ...func(*DeleteAccountParams)) *DeleteAccountParams
+	NewDisableAccountParams(lock bool, opts ...func(*DisableAccountParams)) *DisableAccountParams
+	NewEnableAccountParams(opts ...func(*EnableAccountParams)) *EnableAccountParams
+	NewGetSolidFireAccountIdParams(accountid string, storageid string, opts ...func(*GetSolidFireAccountIdParams)) *GetSolidFireAccountIdParams
+	NewListAccountsParams(opts ...func(*ListAccountsParams)) *ListAccountsParams
+	NewListProjectAccountsParams(projectid string, opts ...func(*ListProjectAccountsParams)) *ListProjectAccountsParams
+	NewLockAccountParams(account string, domainid string, opts ...func(*LockAccountParams)) *LockAccountParams
+	NewMarkDefaultZoneForAccountParams(account string, domainid string, zoneid string, opts ...func(*MarkDefaultZoneForAccountParams)) *MarkDefaultZoneForAccountParams
+	NewMarkDefaultZoneForAccountParamsFromOpts(o MarkDefaultZoneForAccountOpts) *MarkDefaultZoneForAccountParams
+	NewUpdateAccountParams(newname string, opts ...func(*UpdateAccountParams)) *UpdateAccountParams
+	StreamAccounts(ctx context.Context, p *ListAccountsParams) (<-chan *Account, <-chan error)
+	StreamProjectAccounts(ctx context.Context, p *ListProjectAccountsParams) (<-chan *ProjectAccount, <-chan error)
+	UpdateAccount(p *UpdateAccountParams, opts ...OptionFunc) (*UpdateAccountResponse, error)
+	UpdateAccountRaw(v url.Values) (*UpdateAccountResponse, error)
+	UpdateAccountRawWithContext(ctx context.Context, v url.Values) (*UpdateAccountResponse, error)
+	UpdateAccountWithContext(ctx context.Context, p *UpdateAccountParams, opts ...OptionFunc) (*UpdateAccountResponse, error)
+	WaitForAccountDeleted(ctx context.Context, id string, timeout time.Duration, opts ...OptionFunc) error
+}
+
+var _ AccountServiceIface = (*AccountService)(nil)
+
+// AddressServiceIface contains all exported methods of AddressService, so callers can depend on it to mock the service
+type AddressServiceIface interface {
+	AllocateAndWait(zoneid string, opts ...OptionFunc) (*PublicIpAddress, error)
+	AssociateIpAddress(p *AssociateIpAddressParams, opts ...OptionFunc) (*AssociateIpAddressResponse, error)
+	AssociateIpAddressRaw(v url.Values) (*AssociateIpAddressResponse, error)
+	AssociateIpAddressRawWithContext(ctx context.Context, v url.Values) (*AssociateIpAddressResponse, error)
+	AssociateIpAddressWithContext(ctx context.Context, p *AssociateIpAddressParams, opts ...OptionFunc) (*AssociateIpAddressResponse, error)
+	DisassociateIpAddress(p *DisassociateIpAddressParams, opts ...OptionFunc) (*DisassociateIpAddressResponse, error)
+	DisassociateIpAddressRaw(v url.Values) (*DisassociateIpAddressResponse, error)
+	DisassociateIpAddressRawWithContext(ctx context.Context, v url.Values) (*DisassociateIpAddressResponse, error)
+	DisassociateIpAddressWithContext(ctx context.Context, p *DisassociateIpAddressParams, opts ...OptionFunc) (*DisassociateIpAddressResponse, error)
+	GetPublicIpAddressByID(id string, opts ...OptionFunc) (*PublicIpAddress, int, error)
+	ListAllPublicIpAddresses(p *ListPublicIpAddressesParams) ([]*PublicIpAddress, error)
+	ListPublicIpAddresses(p *ListPublicIpAddressesParams) (*ListPublicIpAddressesResponse, error)
+	ListPublicIpAddressesRaw(v url.Values) (*ListPublicIpAddressesResponse, error)
+	ListPublicIpAddressesRawWithContext(ctx context.Context, v url.Values) (*ListPublicIpAddressesResponse, error)
+	ListPublicIpAddressesWhere(p *ListPublicIpAddressesParams, pred func(*PublicIpAddress) bool) ([]*PublicIpAddress, error)
+	ListPublicIpAddressesWithContext(ctx context.Context, p *ListPublicIpAddressesParams) (*ListPublicIpAddressesResponse, error)
+	NewAssociateIpAddressParams(opts ...func(*AssociateIpAddressParams)) *AssociateIpAddressParams
+	NewDisassociateIpAddressParams(id string, opts ...func(*DisassociateIpAddressParams)) *DisassociateIpAddressParams
+	NewListPublicIpAddressesParams(opts ...func(*ListPublicIpAddressesParams)) *ListPublicIpAddressesParams
+	NewUpdateIpAddressParams(id string, opts ...func(*UpdateIpAddressParams)) *UpdateIpAddressParams
+	PublicIpAddressExists(id string, opts ...OptionFunc) (bool, error)
+	StreamPublicIpAddresses(ctx context.Context, p *ListPublicIpAddressesParams) (<-chan *PublicIpAddress, <-chan error)
+	UpdateIpAddress(p *UpdateIpAddressParams, opts ...OptionFunc) (*UpdateIpAddressResponse, error)
+	UpdateIpAddressRaw(v url.Values) (*UpdateIpAddressResponse, error)
+	UpdateIpAddressRawWithContext(ctx context.Context, v url.Values) (*UpdateIpAddressResponse, error)
+	UpdateIpAddressWithContext(ctx context.Context, p *UpdateIpAddressParams, opts ...OptionFunc) (*UpdateIpAddressResponse, error)
+}
+
+var _ AddressServiceIface = (*AddressService)(nil)
+
+// AffinityGroupServiceIface contains all exported methods of AffinityGroupService, so callers can depend on it to mock the service
+type AffinityGroupServiceIface interface {
+	AffinityGroupExists(id string, opts ...OptionFunc) (bool, error)
+	CreateAffinityGroup(p *CreateAffinityGroupParams, opts ...OptionFunc) (*CreateAffinityGroupResponse, error)
+	CreateAffinityGroupRaw(v url.Values) (*CreateAffinityGroupResponse, error)
+	CreateAffinityGroupRawWithContext(ctx context.Context, v url.Values) (*CreateAffinityGroupResponse, error)
+	CreateAffinityGroupWithContext(ctx context.Context, p *CreateAffinityGroupParams, opts ...OptionFunc) (*CreateAffinityGroupResponse, error)
+	DeleteAffinityGroup(p *DeleteAffinityGroupParams, opts ...OptionFunc) (*DeleteAffinityGroupResponse, error)
+	DeleteAffinityGroupRaw(v url.Values) (*DeleteAffinityGroupResponse, error)
+	DeleteAffinityGroupRawWithContext(ctx context.Context, v url.Values) (*DeleteAffinityGroupResponse, error)
+	DeleteAffinityGroupWithContext(ctx context.Context, p *DeleteAffinityGroupParams, opts ...OptionFunc) (*DeleteAffinityGroupResponse, error)
+	GetAffinityGroupByID(id string, opts ...OptionFunc) (*AffinityGroup, int, error)
+	GetAffinityGroupByName(name string, opts ...OptionFunc) (*AffinityGroup, int, error)
+	GetAffinityGroupID(name string, opts ...OptionFunc) (string, int, error)
+	ListAffinityGroupTypes(p *ListAffinityGroupTypesParams) (*ListAffinityGroupTypesResponse, error)
+	ListAffinityGroupTypesRaw(v url.Values) (*ListAffinityGroupTypesResponse, error)
+	ListAffinityGroupTypesRawWithContext(ctx context.Context, v url.Values) (*ListAffinityGroupTypesResponse, error)
+	ListAffinityGroupTypesWhere(p *ListAffinityGroupTypesParams, pred func(*AffinityGroupType) bool) ([]*AffinityGroupType, error)
+	ListAffinityGroupTypesWithContext(ctx context.Context, p *ListAffinityGroupTypesParams) (*ListAffinityGroupTypesResponse, error)
+	ListAffinityGroups(p *ListAffinityGroupsParams) (*ListAffinityGroupsResponse, error)
+	ListAffinityGroupsRaw(v url.Values) (*ListAffinityGroupsResponse, error)
+	ListAffinityGroupsRawWithContext(ctx context.Context, v url.Values) (*ListAffinityGroupsResponse, error)
+	ListAffinityGroupsWhere(p *ListAffinityGroupsParams, pred func(*AffinityGroup) bool) ([]*AffinityGroup, error)
+	ListAffinityGroupsWithContext(ctx context.Context, p *ListAffinityGroupsParams) (*ListAffinityGroupsResponse, error)
+	ListAllAffinityGroupTypes(p *ListAffinityGroupTypesParams) ([]*AffinityGroupType, error)
+	ListAllAffinityGroups(p *ListAffinityGroupsParams) ([]*AffinityGroup, error)
+	NewCreateAffinityGroupParams(name string, affinityGroupType string, opts ...func(*CreateAffinityGroupParams)) *CreateAffinityGroupParams
+	NewDeleteAffinityGroupParams(opts ...func(*DeleteAffinityGroupParams)) *DeleteAffinityGroupParams
+	NewListAffinityGroupTypesParams(opts ...func(*ListAffinityGroupTypesParams)) *ListAffinityGroupTypesParams
+	NewListAffinityGroupsParams(opts ...func(*ListAffinityGroupsParams)) *ListAffinityGroupsParams
+	NewUpdateVMAffinityGroupParams(id string, opts ...func(*UpdateVMAffinityGroupParams)) *UpdateVMAffinityGroupParams
+	StreamAffinityGroupTypes(ctx context.Context, p *ListAffinityGroupTypesParams) (<-chan *AffinityGroupType, <-chan error)
+	StreamAffinityGroups(ctx context.Context, p *ListAffinityGroupsParams) (<-chan *AffinityGroup, <-chan error)
+	UpdateVMAffinityGroup(p *UpdateVMAffinityGroupParams, opts ...OptionFunc) (*UpdateVMAffinityGroupResponse, error)
+	UpdateVMAffinityGroupRaw(v url.Values) (*UpdateVMAffinityGroupResponse, error)
+	UpdateVMAffinityGroupRawWithContext(ctx context.Context, v url.Values) (*UpdateVMAffinityGroupResponse, error)
+	UpdateVMAffinityGroupWithContext(ctx context.Context, p *UpdateVMAffinityGroupParams, opts ...OptionFunc) (*UpdateVMAffinityGroupResponse, error)
+	WaitForAffinityGroupDeleted(ctx context.Context, id string, timeout time.Duration, opts ...OptionFunc) error
+}
+
+var _ AffinityGroupServiceIface = (*AffinityGroupService)(nil)
+
+// AlertServiceIface contains all exported methods of AlertService, so callers can depend on it to mock the service
+type AlertServiceIface interface {
+	AlertExists(id string, opts ...OptionFunc) (bool, error)
+	ArchiveAlerts(p *ArchiveAlertsParams, opts ...OptionFunc) (*ArchiveAlertsResponse, error)
+	ArchiveAlertsRaw(v url.Values) (*ArchiveAlertsResponse, error)
+	ArchiveAlertsRawWithContext(ctx context.Context, v url.Values) (*ArchiveAlertsResponse, error)
+	ArchiveAlertsWithContext(ctx context.Context, p *ArchiveAlertsParams, opts ...OptionFunc) (*ArchiveAlertsResponse, error)
+	DeleteAlerts(p *DeleteAlertsParams, opts ...OptionFunc) (*DeleteAlertsResponse, error)
+	DeleteAlertsRaw(v url.Values) (*DeleteAlertsResponse, error)
+	DeleteAlertsRawWithContext(ctx context.Context, v url.Values) (*DeleteAlertsResponse, error)
+	DeleteAlertsWithContext(ctx context.Context, p *DeleteAlertsParams, opts ...OptionFunc) (*DeleteAlertsResponse, error)
+	GenerateAlert(p *GenerateAlertParams, opts ...OptionFunc) (*GenerateAlertResponse, error)
+	GenerateAlertRaw(v url.Values) (*GenerateAlertResponse, error)
+	GenerateAlertRawWithContext(ctx context.Context, v url.Values) (*GenerateAlertResponse, error)
+	GenerateAlertWithContext(ctx context.Context, p *GenerateAlertParams, opts ...OptionFunc) (*GenerateAlertResponse, error)
+	GetAlertByID(id string, opts ...OptionFunc) (*Alert, int, error)
+	GetAlertByName(name string, opts ...OptionFunc) (*Alert, int, error)
+	GetAlertID(name string, opts ...OptionFunc) (string, int, error)
+	ListAlerts(p *ListAlertsParams) (*ListAlertsResponse, error)
+	ListAlertsRaw(v url.Values) (*ListAlertsResponse, error)
+	ListAlertsRawWithContext(ctx context.Context, v url.Values) (*ListAlertsResponse, error)
+	ListAlertsWhere(p *ListAlertsParams, pred func(*Alert) bool) ([]*Alert, error)
+	ListAlertsWithContext(ctx context.Context, p *ListAlertsParams) (*ListAlertsResponse, error)
+	ListAllAlerts(p *ListAlertsParams) ([]*Alert, error)
+	NewArchiveAlertsParams(opts ...func(*ArchiveAlertsParams)) *ArchiveAlertsParams
+	NewDeleteAlertsParams(opts ...func(*DeleteAlertsParams)) *DeleteAlertsParams
+	NewGenerateAlertParams(description string, name string, alertType int, opts ...func(*GenerateAlertParams)) *GenerateAlertParams
+	NewGenerateAlertParamsFromOpts(o GenerateAlertOpts) *GenerateAlertParams
+	NewListAlertsParams(opts ...func(*ListAlertsParams)) *ListAlertsParams
+	StreamAlerts(ctx context.Context, p *ListAlertsParams) (<-chan *Alert, <-chan error)
+}
+
+var _ AlertServiceIface = (*AlertService)(nil)
+
+// AnnotationServiceIface contains all exported methods of AnnotationService, so callers can depend on it to mock the service
+type AnnotationServiceIface interface {
+	AddNote(entityType, entityId, note string) (*Annotation, error)
+	ListNotes(entityType, entityId string) ([]*Annotation, error)
+	RemoveNote(id string) error
+}
+
+var _ AnnotationServiceIface = (*AnnotationService)(nil)
+
+// AsyncjobServiceIface contains all exported methods of AsyncjobService, so callers can depend on it to mock the service
+type AsyncjobServiceIface interface {
+	ListAllAsyncJobs(p *ListAsyncJobsParams) ([]*AsyncJob, error)
+	ListAsyncJobs(p *ListAsyncJobsParams) (*ListAsyncJobsResponse, error)
+	ListAsyncJobsRaw(v url.Values) (*ListAsyncJobsResponse, error)
+	ListAsyncJobsRawWithContext(ctx context.Context, v url.Values) (*ListAsyncJobsResponse, error)
+	ListAsyncJobsWhere(p *ListAsyncJobsParams, pred func(*AsyncJob) bool) ([]*AsyncJob, error)
+	ListAsyncJobsWithContext(ctx context.Context, p *ListAsyncJobsParams) (*ListAsyncJobsResponse, error)
+	NewListAsyncJobsParams(opts ...func(*ListAsyncJobsParams)) *ListAsyncJobsParams
+	NewQueryAsyncJobResultParams(jobid string, opts ...func(*QueryAsyncJobResultParams)) *QueryAsyncJobResultParams
+	QueryAsyncJobResult(p *QueryAsyncJobResultParams, opts ...OptionFunc) (*QueryAsyncJobResultResponse, error)
+	QueryAsyncJobResultRaw(v url.Values) (*QueryAsyncJobResultResponse, error)
+	QueryAsyncJobResultRawWithContext(ctx context.Context, v url.Values) (*QueryAsyncJobResultResponse, error)
+	QueryAsyncJobResultWithContext(ctx context.Context, p *QueryAsyncJobResultParams, opts ...OptionFunc) (*QueryAsyncJobResultResponse, error)
+	StreamAsyncJobs(ctx context.Context, p *ListAsyncJobsParams) (<-chan *AsyncJob, <-chan error)
+}
+
+var _ AsyncjobServiceIface = (*AsyncjobService)(nil)
+
+// AuthenticationServiceIface contains all exported methods of AuthenticationService, so callers can depend on it to mock the service
+type AuthenticationServiceIface interface {
+	Login(p *LoginParams, opts ...OptionFunc) (*LoginResponse, error)
+	LoginRaw(v url.Values) (*LoginResponse, error)
+	LoginRawWithContext(ctx context.Context, v url.Values) (*LoginResponse, error)
+	LoginWithContext(ctx context.Context, p *LoginParams, opts ...OptionFunc) (*LoginResponse, error)
+	Logout(p *LogoutParams, opts ...OptionFunc) (*LogoutResponse, error)
+	LogoutRaw(v url.Values) (*LogoutResponse, error)
+	LogoutRawWithContext(ctx context.Context, v url.Values) (*LogoutResponse, error)
+	LogoutWithContext(ctx context.Context, p *LogoutParams, opts ...OptionFunc) (*LogoutResponse, error)
+	NewLoginParams(password string, username string, opts ...func(*LoginParams)) *LoginParams
+	NewLogoutParams(opts ...func(*LogoutParams)) *LogoutParams
+}
+
+var _ AuthenticationServiceIface = (*AuthenticationService)(nil)
+
+// AutoScaleServiceIface contains all exported methods of AutoScaleService, so callers can depend on it to mock the service
+type AutoScaleServiceIface interface {
+	AutoScalePolicyExists(id string, opts ...OptionFunc) (bool, error)
+	AutoScaleVmGroupExists(id string, opts ...OptionFunc) (bool, error)
+	AutoScaleVmProfileExists(id string, opts ...OptionFunc) (bool, error)
+	ConditionExists(id string, opts ...OptionFunc) (bool, error)
+	CounterExists(id string, opts ...OptionFunc) (bool, error)
+	CreateAutoScalePolicy(p *CreateAutoScalePolicyParams, opts ...OptionFunc) (*CreateAutoScalePolicyResponse, error)
+	CreateAutoScalePolicyRaw(v url.Values) (*CreateAutoScalePolicyResponse, error)
+	CreateAutoScalePolicyRawWithContext(ctx context.Context, v url.Values) (*CreateAutoScalePolicyResponse, error)
+	CreateAutoScalePolicyWithContext(ctx context.Context, p *CreateAutoScalePolicyParams, opts ...OptionFunc) (*CreateAutoScalePolicyResponse, error)
+	CreateAutoScaleVmGroup(p *CreateAutoScaleVmGroupParams, opts ...OptionFunc) (*CreateAutoScaleVmGroupResponse, error)
+	CreateAutoScaleVmGroupRaw(v url.Values) (*CreateAutoScaleVmGroupResponse, error)
+	CreateAutoScaleVmGroupRawWithContext(ctx context.Context, v url.Values) (*CreateAutoScaleVmGroupResponse, error)
+	CreateAutoScaleVmGroupWithContext(ctx context.Context, p *CreateAutoScaleVmGroupParams, opts ...OptionFunc) (*CreateAutoScaleVmGroupResponse, error)
+	CreateAutoScaleVmProfile(p *CreateAutoScaleVmProfileParams, opts ...OptionFunc) (*CreateAutoScaleVmProfileResponse, error)
+	CreateAutoScaleVmProfileRaw(v url.Values) (*CreateAutoScaleVmProfileResponse, error)
+	CreateAutoScaleVmProfileRawWithContext(ctx context.Context, v url.Values) (*CreateAutoScaleVmProfileResponse, error)
+	CreateAutoScaleVmProfileWithContext(ctx context.Context, p *CreateAutoScaleVmProfileParams, opts ...OptionFunc) (*CreateAutoScaleVmProfileResponse, error)
+	CreateCondition(p *CreateConditionParams, opts ...OptionFunc) (*CreateConditionResponse, error)
+	CreateConditionRaw(v url.Values) (*CreateConditionResponse, error)
+	CreateConditionRawWithContext(ctx context.Context, v url.Values) (*CreateConditionResponse, error)
+	CreateConditionWithContext(ctx context.Context, p *CreateConditionParams, opts ...OptionFunc) (*CreateConditionResponse, error)
+	CreateCounter(p *CreateCounterParams, opts ...OptionFunc) (*CreateCounterResponse, error)
+	CreateCounterRaw(v url.Values) (*CreateCounterResponse, error)
+	CreateCounterRawWithContext(ctx context.Context, v url.Values) (*CreateCounterResponse, error)
+	CreateCounterWithContext(ctx context.Context, p *CreateCounterParams, opts ...OptionFunc) (*CreateCounterResponse, error)
+	DeleteAutoScalePolicy(p *DeleteAutoScalePolicyParams, opts ...OptionFunc) (*DeleteAutoScalePolicyResponse, error)
+	DeleteAutoScalePolicyRaw(v url.Values) (*DeleteAutoScalePolicyResponse, error)
+	DeleteAutoScalePolicyRawWithContext(ctx context.Context, v url.Values) (*DeleteAutoScalePolicyResponse, error)
+	DeleteAutoScalePolicyWithContext(ctx context.Context, p *DeleteAutoScalePolicyParams, opts ...OptionFunc) (*DeleteAutoScalePolicyResponse, error)
+	DeleteAutoScaleVmGroup(p *DeleteAutoScaleVmGroupParams, opts ...OptionFunc) (*DeleteAutoScaleVmGroupResponse, error)
+	DeleteAutoScaleVmGroupRaw(v url.Values) (*DeleteAutoScaleVmGroupResponse, error)
+	DeleteAutoScaleVmGroupRawWithContext(ctx context.Context, v url.Values) (*DeleteAutoScaleVmGroupResponse, error)
+	DeleteAutoScaleVmGroupWithContext(ctx context.Context, p *DeleteAutoScaleVmGroupParams, opts ...OptionFunc) (*DeleteAutoScaleVmGroupResponse, error)
+	DeleteAutoScaleVmProfile(p *DeleteAutoScaleVmProfileParams, opts ...OptionFunc) (*DeleteAutoScaleVmProfileResponse, error)
+	DeleteAutoScaleVmProfileRaw(v url.Values) (*DeleteAutoScaleVmProfileResponse, error)
+	DeleteAutoScaleVmProfileRawWithContext(ctx context.Context, v url.Values) (*DeleteAutoScaleVmProfileResponse, error)
+	DeleteAutoScaleVmProfileWithContext(ctx context.Context, p *DeleteAutoScaleVmProfileParams, opts ...OptionFunc) (*DeleteAutoScaleVmProfileResponse, error)
+	DeleteCondition(p *DeleteConditionParams, opts ...OptionFunc) (*DeleteConditionResponse, error)
+	DeleteConditionRaw(v url.Values) (*DeleteConditionResponse, error)
+	DeleteConditionRawWithContext(ctx context.Context, v url.Values) (*DeleteConditionResponse, error)
+	DeleteConditionWithContext(ctx context.Context, p *DeleteConditionParams, opts ...OptionFunc) (*DeleteConditionResponse, error)
+	DeleteCounter(p *DeleteCounterParams, opts ...OptionFunc) (*DeleteCounterResponse, error)
+	DeleteCounterRaw(v url.Values) (*DeleteCounterResponse, error)
+	DeleteCounterRawWithContext(ctx context.Context, v url.Values) (*DeleteCounterResponse, error)
+	DeleteCounterWithContext(ctx context.Context, p *DeleteCounterParams, opts ...OptionFunc) (*DeleteCounterResponse, error)
+	DisableAutoScaleVmGroup(p *DisableAutoScaleVmGroupParams, opts ...OptionFunc) (*DisableAutoScaleVmGroupResponse, error)
+	DisableAutoScaleVmGroupRaw(v url.Values) (*DisableAutoScaleVmGroupResponse, error)
+	DisableAutoScaleVmGroupRawWithContext(ctx context.Context, v url.Values) (*DisableAutoScaleVmGroupResponse, error)
+	DisableAutoScaleVmGroupWithContext(ctx context.Context, p *DisableAutoScaleVmGroupParams, opts ...OptionFunc) (*DisableAutoScaleVmGroupResponse, error)
+	EnableAutoScaleVmGroup(p *EnableAutoScaleVmGroupParams, opts ...OptionFunc) (*EnableAutoScaleVmGroupResponse, error)
+	EnableAutoScaleVmGroupRaw(v url.Values) (*EnableAutoScaleVmGroupResponse, error)
+	EnableAutoScaleVmGroupRawWithContext(ctx context.Context, v url.Values) (*EnableAutoScaleVmGroupResponse, error)
+	EnableAutoScaleVmGroupWithContext(ctx context.Context, p *EnableAutoScaleVmGroupParams, opts ...OptionFunc) (*EnableAutoScaleVmGroupResponse, error)
+	GetAutoScalePolicyByID(id string, opts ...OptionFunc) (*AutoScalePolicy, int, error)
+	GetAutoScaleVmGroupByID(id string, opts ...OptionFunc) (*AutoScaleVmGroup, int, error)
+	GetAutoScaleVmProfileByID(id string, opts ...OptionFunc) (*AutoScaleVmProfile, int, error)
+	GetConditionByID(id string, opts ...OptionFunc) (*Condition, int, error)
+	GetCounterByID(id string, opts ...OptionFunc) (*Counter, int, error)
+	GetCounterByName(name string, opts ...OptionFunc) (*Counter, int, error)
+	GetCounterID(name string, opts ...OptionFunc) (string, int, error)
+	ListAllAutoScalePolicies(p *ListAutoScalePoliciesParams) ([]*AutoScalePolicy, error)
+	ListAllAutoScaleVmGroups(p *ListAutoScaleVmGroupsParams) ([]*AutoScaleVmGroup, error)
+	ListAllAutoScaleVmProfiles(p *ListAutoScaleVmProfilesParams) ([]*AutoScaleVmProfile, error)
+	ListAllConditions(p *ListConditionsParams) ([]*Condition, error)
+	ListAllCounters(p *ListCountersParams) ([]*Counter, error)
+	ListAutoScalePolicies(p *ListAutoScalePoliciesParams) (*ListAutoScalePoliciesResponse, error)
+	ListAutoScalePoliciesRaw(v url.Values) (*ListAutoScalePoliciesResponse, error)
+	ListAutoScalePoliciesRawWithContext(ctx context.Context, v url.Values) (*ListAutoScalePoliciesResponse, error)
+	ListAutoScalePoliciesWhere(p *ListAutoScalePoliciesParams, pred func(*AutoScalePolicy) bool) ([]*AutoScalePolicy, error)
+	ListAutoScalePoliciesWithContext(ctx context.Context, p *ListAutoScalePoliciesParams) (*ListAutoScalePoliciesResponse, error)
+	ListAutoScaleVmGroups(p *ListAutoScaleVmGroupsParams) (*ListAutoScaleVmGroupsResponse, error)
+	ListAutoScaleVmGroupsRaw(v url.Values) (*ListAutoScaleVmGroupsResponse, error)
+	ListAutoScaleVmGroupsRawWithContext(ctx context.Context, v url.Values) (*ListAutoScaleVmGroupsResponse, error)
+	ListAutoScaleVmGroupsWhere(p *ListAutoScaleVmGroupsParams, pred func(*AutoScaleVmGroup) bool) ([]*AutoScaleVmGroup, error)
+	ListAutoScaleVmGroupsWithContext(ctx context.Context, p *ListAutoScaleVmGroupsParams) (*ListAutoScaleVmGroupsResponse, error)
+	ListAutoScaleVmProfiles(p *ListAutoScaleVmProfilesParams) (*ListAutoScaleVmProfilesResponse, error)
+	ListAutoScaleVmProfilesRaw(v url.Values) (*ListAutoScaleVmProfilesResponse, error)
+	ListAutoScaleVmProfilesRawWithContext(ctx context.Context, v url.Values) (*ListAutoScaleVmProfilesResponse, error)
+	ListAutoScaleVmProfilesWhere(p *ListAutoScaleVmProfilesParams, pred func(*AutoScaleVmProfile) bool) ([]*AutoScaleVmProfile, error)
+	ListAutoScaleVmProfilesWithContext(ctx context.Context, p *ListAutoScaleVmProfilesParams) (*ListAutoScaleVmProfilesResponse, error)
+	ListConditions(p *ListConditionsParams) (*ListConditionsResponse, error)
+	ListConditionsRaw(v url.Values) (*ListConditionsResponse, error)
+	ListConditionsRawWithContext(ctx context.Context, v url.Values) (*ListConditionsResponse, error)
+	ListConditionsWhere(p *ListConditionsParams, pred func(*Condition) bool) ([]*Condition, error)
+	ListConditionsWithContext(ctx context.Context, p *ListConditionsParams) (*ListConditionsResponse, error)
+	ListCounters(p *ListCountersParams) (*ListCountersResponse, error)
+	ListCountersRaw(v url.Values) (*ListCountersResponse, error)
+	ListCountersRawWithContext(ctx context.Context, v url.Values) (*ListCountersResponse, error)
+	ListCountersWhere(p *ListCountersParams, pred func(*Counter) bool) ([]*Counter, error)
+	ListCountersWithContext(ctx context.Context, p *ListCountersParams) (*ListCountersResponse, error)
+	NewCreateAutoScalePolicyParams(action string, conditionids []string, duration int, opts ...func(*CreateAutoScalePolicyParams)) *CreateAutoScalePolicyParams
+	NewCreateAutoScalePolicyParamsFromOpts(o CreateAutoScalePolicyOpts) *CreateAutoScalePolicyParams
+	NewCreateAutoScaleVmGroupParams(lbruleid string, maxmembers int, minmembers int, scaledownpolicyids []string, scaleuppolicyids []string, vmprofileid string, opts ...func(*CreateAutoScaleVmGroupParams)) *CreateAutoScaleVmGroupParams
+	NewCreateAutoScaleVmGroupParamsFromOpts(o CreateAutoScaleVmGroupOpts) *CreateAutoScaleVmGroupParams
+	NewCreateAutoScaleVmProfileParams(serviceofferingid string, templateid string, zoneid string, opts ...func(*CreateAutoScaleVmProfileParams)) *CreateAutoScaleVmProfileParams
+	NewCreateAutoScaleVmProfileParamsFromOpts(o CreateAutoScaleVmProfileOpts) *CreateAutoScaleVmProfileParams
+	NewCreateConditionParams(counterid string, relationaloperator string, threshold int64, opts ...func(*CreateConditionParams)) *CreateConditionParams
+	NewCreateConditionParamsFromOpts(o CreateConditionOpts) *CreateConditionParams
+	NewCreateCounterParams(name string, source string, value string, opts ...func(*CreateCounterParams)) *CreateCounterParams
+	NewCreateCounterParamsFromOpts(o CreateCounterOpts) *CreateCounterParams
+	NewDeleteAutoScalePolicyParams(id string, opts ...func(*DeleteAutoScalePolicyParams)) *DeleteAutoScalePolicyParams
+	NewDeleteAutoScaleVmGroupParams(id string, opts ...func(*DeleteAutoScaleVmGroupParams)) *DeleteAutoScaleVmGroupParams
+	NewDeleteAutoScaleVmProfileParams(id string, opts ...func(*DeleteAutoScaleVmProfileParams)) *DeleteAutoScaleVmProfileParams
+	NewDeleteConditionParams(id string, opts ...func(*DeleteConditionParams)) *DeleteConditionParams
+	NewDeleteCounterParams(id string, opts ...func(*DeleteCounterParams)) *DeleteCounterParams
+	NewDisableAutoScaleVmGroupParams(id string, opts ...func(*DisableAutoScaleVmGroupParams)) *DisableAutoScaleVmGroupParams
+	NewEnableAutoScaleVmGroupParams(id string, opts ...func(*EnableAutoScaleVmGroupParams)) *EnableAutoScaleVmGroupParams
+	NewListAutoScalePoliciesParams(opts ...func(*ListAutoScalePoliciesParams)) *ListAutoScalePoliciesParams
+	NewListAutoScaleVmGroupsParams(opts ...func(*ListAutoScaleVmGroupsParams)) *ListAutoScaleVmGroupsParams
+	NewListAutoScaleVmProfilesParams(opts ...func(*ListAutoScaleVmProfilesParams)) *ListAutoScaleVmProfilesParams
+	NewListConditionsParams(opts ...func(*ListConditionsParams)) *ListConditionsParams
+	NewListCountersParams(opts ...func(*ListCountersParams)) *ListCountersParams
+	NewUpdateAutoScalePolicyParams(id string, opts ...func(*UpdateAutoScalePolicyParams)) *UpdateAutoScalePolicyParams
+	NewUpdateAutoScaleVmGroupParams(id string, opts ...func(*UpdateAutoScaleVmGroupParams)) *UpdateAutoScaleVmGroupParams
+	NewUpdateAutoScaleVmProfileParams(id string, opts ...func(*UpdateAutoScaleVmProfileParams)) *UpdateAutoScaleVmProfileParams
+	StreamAutoScalePolicies(ctx context.Context, p *ListAutoScalePoliciesParams) (<-chan *AutoScalePolicy, <-chan error)
+	StreamAutoScaleVmGroups(ctx context.Context, p *ListAutoScaleVmGroupsParams) (<-chan *AutoScaleVmGroup, <-chan error)
+	StreamAutoScaleVmProfiles(ctx context.Context, p *ListAutoScaleVmProfilesParams) (<-chan *AutoScaleVmProfile, <-chan error)
+	StreamConditions(ctx context.Context, p *ListConditionsParams) (<-chan *Condition, <-chan error)
+	StreamCounters(ctx context.Context, p *ListCountersParams) (<-chan *Counter, <-chan error)
+	UpdateAutoScalePolicy(p *UpdateAutoScalePolicyParams, opts ...OptionFunc) (*UpdateAutoScalePolicyResponse, error)
+	UpdateAutoScalePolicyRaw(v url.Values) (*UpdateAutoScalePolicyResponse, error)
+	UpdateAutoScalePolicyRawWithContext(ctx context.Context, v url.Values) (*UpdateAutoScalePolicyResponse, error)
+	UpdateAutoScalePolicyWithContext(ctx context.Context, p *UpdateAutoScalePolicyParams, opts ...OptionFunc) (*UpdateAutoScalePolicyResponse, error)
+	UpdateAutoScaleVmGroup(p *UpdateAutoScaleVmGroupParams, opts ...OptionFunc) (*UpdateAutoScaleVmGroupResponse, error)
+	UpdateAutoScaleVmGroupRaw(v url.Values) (*UpdateAutoScaleVmGroupResponse, error)
+	UpdateAutoScaleVmGroupRawWithContext(ctx context.Context, v url.Values) (*UpdateAutoScaleVmGroupResponse, error)
+	UpdateAutoScaleVmGroupWithContext(ctx context.Context, p *UpdateAutoScaleVmGroupParams, opts ...OptionFunc) (*UpdateAutoScaleVmGroupResponse, error)
+	UpdateAutoScaleVmProfile(p *UpdateAutoScaleVmProfileParams, opts ...OptionFunc) (*UpdateAutoScaleVmProfileResponse, error)
+	UpdateAutoScaleVmProfileRaw(v url.Values) (*UpdateAutoScaleVmProfileResponse, error)
+	UpdateAutoScaleVmProfileRawWithContext(ctx context.Context, v url.Values) (*UpdateAutoScaleVmProfileResponse, error)
+	UpdateAutoScaleVmProfileWithContext(ctx context.Context, p *UpdateAutoScaleVmProfileParams, opts ...OptionFunc) (*UpdateAutoScaleVmProfileResponse, error)
+	WaitForAutoScalePolicyDeleted(ctx context.Context, id string, timeout time.Duration, opts ...OptionFunc) error
+	WaitForAutoScaleVmGroupDeleted(ctx context.Context, id string, timeout time.Duration, opts ...OptionFunc) error
+	WaitForAutoScaleVmProfileDeleted(ctx context.Context, id string, timeout time.Duration, opts ...OptionFunc) error
+	WaitForConditionDeleted(ctx context.Context, id string, timeout time.Duration, opts ...OptionFunc) error
+	WaitForCounterDeleted(ctx context.Context, id string, timeout time.Duration, opts ...OptionFunc) error
+}
+
+var _ AutoScaleServiceIface = (*AutoScaleService)(nil)
+
+// BaremetalServiceIface contains all exported methods of BaremetalService, so callers can depend on it to mock the service
+type BaremetalServiceIface interface {
+	AddBaremetalDhcp(p *AddBaremetalDhcpParams, opts ...OptionFunc) (*AddBaremetalDhcpResponse, error)
+	AddBaremetalDhcpRaw(v url.Values) (*AddBaremetalDhcpResponse, error)
+	AddBaremetalDhcpRawWithContext(ctx context.Context, v url.Values) (*AddBaremetalDhcpResponse, error)
+	AddBaremetalDhcpWithContext(ctx context.Context, p *AddBaremetalDhcpParams, opts ...OptionFunc) (*AddBaremetalDhcpResponse, error)
+	AddBaremetalPxeKickStartServer(p *AddBaremetalPxeKickStartServerParams, opts ...OptionFunc) (*AddBaremetalPxeKickStartServerResponse, error)
+	AddBaremetalPxeKickStartServerRaw(v url.Values) (*AddBaremetalPxeKickStartServerResponse, error)
+	AddBaremetalPxeKickStartServerRawWithContext(ctx context.Context, v url.Values) (*AddBaremetalPxeKickStartServerResponse, error)
+	AddBaremetalPxeKickStartServerWithContext(ctx context.Context, p *AddBaremetalPxeKickStartServerParams, opts ...OptionFunc) (*AddBaremetalPxeKickStartServerResponse, error)
+	AddBaremetalPxePingServer(p *AddBaremetalPxePingServerParams, opts ...OptionFunc) (*AddBaremetalPxePingServerResponse, error)
+	AddBaremetalPxePingServerRaw(v url.Values) (*AddBaremetalPxePingServerResponse, error)
+	AddBaremetalPxePingServerRawWithContext(ctx context.Context, v url.Values) (*AddBaremetalPxePingServerResponse, error)
+	AddBaremetalPxePingServerWithContext(ctx context.Context, p *AddBaremetalPxePingServerParams, opts ...OptionFunc) (*AddBaremetalPxePingServerResponse, error)
+	AddBaremetalRct(p *AddBaremetalRctParams, opts ...OptionFunc) (*AddBaremetalRctResponse, error)
+	AddBaremetalRctRaw(v url.Values) (*AddBaremetalRctResponse, error)
+	AddBaremetalRctRawWithContext(ctx context.Context, v url.Values) (*AddBaremetalRctResponse, error)
+	AddBaremetalRctWithContext(ctx context.Context, p *AddBaremetalRctParams, opts ...OptionFunc) (*AddBaremetalRctResponse, error)
+	DeleteBaremetalRct(p *DeleteBaremetalRctParams, opts ...OptionFunc) (*DeleteBaremetalRctResponse, error)
+	DeleteBaremetalRctRaw(v url.Values) (*DeleteBaremetalRctResponse, error)
+	DeleteBaremetalRctRawWithContext(ctx context.Context, v url.Values) (*DeleteBaremetalRctResponse, error)
+	DeleteBaremetalRctWithContext(ctx context.Context, p *DeleteBaremetalRctParams, opts ...OptionFunc) (*DeleteBaremetalRctResponse, error)
+	ListAllBaremetalDhcp(p *ListBaremetalDhcpParams) ([]*BaremetalDhcp, error)
+	ListAllBaremetalPxeServers(p *ListBaremetalPxeServersParams) ([]*BaremetalPxeServer, error)
+	ListAllBaremetalRct(p *ListBaremetalRctParams) ([]*BaremetalRct, error)
+	ListBaremetalDhcp(p *ListBaremetalDhcpParams) (*ListBaremetalDhcpResponse, error)
+	ListBaremetalDhcpRaw(v url.Values) (*ListBaremetalDhcpResponse, error)
+	ListBaremetalDhcpRawWithContext(ctx context.Context, v url.Values) (*ListBaremetalDhcpResponse, error)
+	ListBaremetalDhcpWhere(p *ListBaremetalDhcpParams, pred func(*BaremetalDhcp) bool) ([]*BaremetalDhcp, error)
+	ListBaremetalDhcpWithContext(ctx context.Context, p *ListBaremetalDhcpParams) (*ListBaremetalDhcpResponse, error)
+	ListBaremetalPxeServers(p *ListBaremetalPxeServersParams) (*ListBaremetalPxeServersResponse, error)
+	ListBaremetalPxeServersRaw(v url.Values) (*ListBaremetalPxeServersResponse, error)
+	ListBaremetalPxeServersRawWithContext(ctx context.Context, v url.Values) (*ListBaremetalPxeServersResponse, error)
+	ListBaremetalPxeServersWhere(p *ListBaremetalPxeServersParams, pred func(*BaremetalPxeServer) bool) ([]*BaremetalPxeServer, error)
+	ListBaremetalPxeServersWithContext(ctx context.Context, p *ListBaremetalPxeServersParams) (*ListBaremetalPxeServersResponse, error)
+	ListBaremetalRct(p *ListBaremetalRctParams) (*ListBaremetalRctResponse, error)
+	ListBaremetalRctRaw(v url.Values) (*ListBaremetalRctResponse, error)
+	ListBaremetalRctRawWithContext(ctx context.Context, v url.Values) (*ListBaremetalRctResponse, error)
+	ListBaremetalRctWhere(p *ListBaremetalRctParams, pred func(*BaremetalRct) bool) ([]*BaremetalRct, error)
+	ListBaremetalRctWithContext(ctx context.Context, p *ListBaremetalRctParams) (*ListBaremetalRctResponse, error)
+	NewAddBaremetalDhcpParams(dhcpservertype string, password string, physicalnetworkid string, url string, username string, opts ...func(*AddBaremetalDhcpParams)) *AddBaremetalDhcpParams
+	NewAddBaremetalDhcpParamsFromOpts(o AddBaremetalDhcpOpts) *AddBaremetalDhcpParams
+	NewAddBaremetalPxeKickStartServerParams(password string, physicalnetworkid string, pxeservertype string, tftpdir string, url string, username string, opts ...func(*AddBaremetalPxeKickStartServerParams)) *AddBaremetalPxeKickStartServerParams
+	NewAddBaremetalPxeKickStartServerParamsFromOpts(o AddBaremetalPxeKickStartServerOpts) *AddBaremetalPxeKickStartServerParams
+	NewAddBaremetalPxePingServerParams(password string, physicalnetworkid string, pingdir string, pingstorageserverip string, pxeservertype string, tftpdir string, url string, username string, opts ...func(*AddBaremetalPxePingServerParams)) *AddBaremetalPxePingServerParams
+	NewAddBaremetalPxePingServerParamsFromOpts(o AddBaremetalPxePingServerOpts) *AddBaremetalPxePingServerParams
+	NewAddBaremetalRctParams(baremetalrcturl string, opts ...func(*AddBaremetalRctParams)) *AddBaremetalRctParams
+	NewDeleteBaremetalRctParams(id string, opts ...func(*DeleteBaremetalRctParams)) *DeleteBaremetalRctParams
+	NewListBaremetalDhcpParams(physicalnetworkid string, opts ...func(*ListBaremetalDhcpParams)) *ListBaremetalDhcpParams
+	NewListBaremetalPxeServersParams(physicalnetworkid string, opts ...func(*ListBaremetalPxeServersParams)) *ListBaremetalPxeServersParams
+	NewListBaremetalRctParams(opts ...func(*ListBaremetalRctParams)) *ListBaremetalRctParams
+	NewNotifyBaremetalProvisionDoneParams(mac string, opts ...func(*NotifyBaremetalProvisionDoneParams)) *NotifyBaremetalProvisionDoneParams
+	NotifyBaremetalProvisionDone(p *NotifyBaremetalProvisionDoneParams, opts ...OptionFunc) (*NotifyBaremetalProvisionDoneResponse, error)
+	NotifyBaremetalProvisionDoneRaw(v url.Values) (*NotifyBaremetalProvisionDoneResponse, error)
+	NotifyBaremetalProvisionDoneRawWithContext(ctx context.Context, v url.Values) (*NotifyBaremetalProvisionDoneResponse, error)
+	NotifyBaremetalProvisionDoneWithContext(ctx context.Context, p *NotifyBaremetalProvisionDoneParams, opts ...OptionFunc) (*NotifyBaremetalProvisionDoneResponse, error)
+	StreamBaremetalDhcp(ctx context.Context, p *ListBaremetalDhcpParams) (<-chan *BaremetalDhcp, <-chan error)
+	StreamBaremetalPxeServers(ctx context.Context, p *ListBaremetalPxeServersParams) (<-chan *BaremetalPxeServer, <-chan error)
+	StreamBaremetalRct(ctx context.Context, p *ListBaremetalRctParams) (<-chan *BaremetalRct, <-chan error)
+}
+
+var _ BaremetalServiceIface = (*BaremetalService)(nil)
+
+// BigSwitchBCFServiceIface contains all exported methods of BigSwitchBCFService, so callers can depend on it to mock the service
+type BigSwitchBCFServiceIface interface {
+	AddBigSwitchBcfDevice(p *AddBigSwitchBcfDeviceParams, opts ...OptionFunc) (*AddBigSwitchBcfDeviceResponse, error)
+	AddBigSwitchBcfDeviceRaw(v url.Values) (*AddBigSwitchBcfDeviceResponse, error)
+	AddBigSwitchBcfDeviceRawWithContext(ctx context.Context, v url.Values) (*AddBigSwitchBcfDeviceResponse, error)
+	AddBigSwitchBcfDeviceWithContext(ctx context.Context, p *AddBigSwitchBcfDeviceParams, opts ...OptionFunc) (*AddBigSwitchBcfDeviceResponse, error)
+	DeleteBigSwitchBcfDevice(p *DeleteBigSwitchBcfDeviceParams, opts ...OptionFunc) (*DeleteBigSwitchBcfDeviceResponse, error)
+	DeleteBigSwitchBcfDeviceRaw(v url.Values) (*DeleteBigSwitchBcfDeviceResponse, error)
+	DeleteBigSwitchBcfDeviceRawWithContext(ctx context.Context, v url.Values) (*DeleteBigSwitchBcfDeviceResponse, error)
+	DeleteBigSwitchBcfDeviceWithContext(ctx context.Context, p *DeleteBigSwitchBcfDeviceParams, opts ...OptionFunc) (*DeleteBigSwitchBcfDeviceResponse, error)
+	ListAllBigSwitchBcfDevices(p *ListBigSwitchBcfDevicesParams) ([]*BigSwitchBcfDevice, error)
+	ListBigSwitchBcfDevices(p *ListBigSwitchBcfDevicesParams) (*ListBigSwitchBcfDevicesResponse, error)
+	ListBigSwitchBcfDevicesRaw(v url.Values) (*ListBigSwitchBcfDevicesResponse, error)
+	ListBigSwitchBcfDevicesRawWithContext(ctx context.Context, v url.Values) (*ListBigSwitchBcfDevicesResponse, error)
+	ListBigSwitchBcfDevicesWhere(p *ListBigSwitchBcfDevicesParams, pred func(*BigSwitchBcfDevice) bool) ([]*BigSwitchBcfDevice, error)
+	ListBigSwitchBcfDevicesWithContext(ctx context.Context, p *ListBigSwitchBcfDevicesParams) (*ListBigSwitchBcfDevicesResponse, error)
+	NewAddBigSwitchBcfDeviceParams(hostname string, nat bool, password string, physicalnetworkid string, username string, opts ...func(*AddBigSwitchBcfDeviceParams)) *AddBigSwitchBcfDeviceParams
+	NewAddBigSwitchBcfDeviceParamsFromOpts(o AddBigSwitchBcfDeviceOpts) *AddBigSwitchBcfDeviceParams
+	NewDeleteBigSwitchBcfDeviceParams(bcfdeviceid string, opts ...func(*DeleteBigSwitchBcfDeviceParams)) *DeleteBigSwitchBcfDeviceParams
+	NewListBigSwitchBcfDevicesParams(opts ...func(*ListBigSwitchBcfDevicesParams)) *ListBigSwitchBcfDevicesParams
+	StreamBigSwitchBcfDevices(ctx context.Context, p *ListBigSwitchBcfDevicesParams) (<-chan *BigSwitchBcfDevice, <-chan error)
+}
+
+var _ BigSwitchBCFServiceIface = (*BigSwitchBCFService)(nil)
+
+// BrocadeVCSServiceIface contains all exported methods of BrocadeVCSService, so callers can depend on it to mock the service
+type BrocadeVCSServiceIface interface {
+	AddBrocadeVcsDevice(p *AddBrocadeVcsDeviceParams, opts ...OptionFunc) (*AddBrocadeVcsDeviceResponse, error)
+	AddBrocadeVcsDeviceRaw(v url.Values) (*AddBrocadeVcsDeviceResponse, error)
+	AddBrocadeVcsDeviceRawWithContext(ctx context.Context, v url.Values) (*AddBrocadeVcsDeviceResponse, error)
+	AddBrocadeVcsDeviceWithContext(ctx context.Context, p *AddBrocadeVcsDeviceParams, opts ...OptionFunc) (*AddBrocadeVcsDeviceResponse, error)
+	DeleteBrocadeVcsDevice(p *DeleteBrocadeVcsDeviceParams, opts ...OptionFunc) (*DeleteBrocadeVcsDeviceResponse, error)
+	DeleteBrocadeVcsDeviceRaw(v url.Values) (*DeleteBrocadeVcsDeviceResponse, error)
+	DeleteBrocadeVcsDeviceRawWithContext(ctx context.Context, v url.Values) (*DeleteBrocadeVcsDeviceResponse, error)
+	DeleteBrocadeVcsDeviceWithContext(ctx context.Context, p *DeleteBrocadeVcsDeviceParams, opts ...OptionFunc) (*DeleteBrocadeVcsDeviceResponse, error)
+	GetBrocadeVcsDeviceNetworkID(keyword string, vcsdeviceid string, opts ...OptionFunc) (string, int, error)
+	ListAllBrocadeVcsDeviceNetworks(p *ListBrocadeVcsDeviceNetworksParams) ([]*BrocadeVcsDeviceNetwork, error)
+	ListAllBrocadeVcsDevices(p *ListBrocadeVcsDevicesParams) ([]*BrocadeVcsDevice, error)
+	ListBrocadeVcsDeviceNetworks(p *ListBrocadeVcsDeviceNetworksParams) (*ListBrocadeVcsDeviceNetworksResponse, error)
+	ListBrocadeVcsDeviceNetworksRaw(v url.Values) (*ListBrocadeVcsDeviceNetworksResponse, error)
+	ListBrocadeVcsDeviceNetworksRawWithContext(ctx context.Context, v url.Values) (*ListBrocadeVcsDeviceNetworksResponse, error)
+	ListBrocadeVcsDeviceNetworksWhere(p *ListBrocadeVcsDeviceNetworksParams, pred func(*BrocadeVcsDeviceNetwork) bool) ([]*BrocadeVcsDeviceNetwork, error)
+	ListBrocadeVcsDeviceNetworksWithContext(ctx context.Context, p *ListBrocadeVcsDeviceNetworksParams) (*ListBrocadeVcsDeviceNetworksResponse, error)
+	ListBrocadeVcsDevices(p *ListBrocadeVcsDevicesParams) (*ListBrocadeVcsDevicesResponse, error)
+	ListBrocadeVcsDevicesRaw(v url.Values) (*ListBrocadeVcsDevicesResponse, error)
+	ListBrocadeVcsDevicesRawWithContext(ctx context.Context, v url.Values) (*ListBrocadeVcsDevicesResponse, error)
+	ListBrocadeVcsDevicesWhere(p *ListBrocadeVcsDevicesParams, pred func(*BrocadeVcsDevice) bool) ([]*BrocadeVcsDevice, error)
+	ListBrocadeVcsDevicesWithContext(ctx context.Context, p *ListBrocadeVcsDevicesParams) (*ListBrocadeVcsDevicesResponse, error)
+	NewAddBrocadeVcsDeviceParams(hostname string, password string, physicalnetworkid string, username string, opts ...func(*AddBrocadeVcsDeviceParams)) *AddBrocadeVcsDeviceParams
+	NewAddBrocadeVcsDeviceParamsFromOpts(o AddBrocadeVcsDeviceOpts) *AddBrocadeVcsDeviceParams
+	NewDeleteBrocadeVcsDeviceParams(vcsdeviceid string, opts ...func(*DeleteBrocadeVcsDeviceParams)) *DeleteBrocadeVcsDeviceParams
+	NewListBrocadeVcsDeviceNetworksParams(vcsdeviceid string, opts ...func(*ListBrocadeVcsDeviceNetworksParams)) *ListBrocadeVcsDeviceNetworksParams
+	NewListBrocadeVcsDevicesParams(opts ...func(*ListBrocadeVcsDevicesParams)) *ListBrocadeVcsDevicesParams
+	StreamBrocadeVcsDeviceNetworks(ctx context.Context, p *ListBrocadeVcsDeviceNetworksParams) (<-chan *BrocadeVcsDeviceNetwork, <-chan error)
+	StreamBrocadeVcsDevices(ctx context.Context, p *ListBrocadeVcsDevicesParams) (<-chan *BrocadeVcsDevice, <-chan error)
+}
+
+var _ BrocadeVCSServiceIface = (*BrocadeVCSService)(nil)
+
+// CertificateServiceIface contains all exported methods of CertificateService, so callers can depend on it to mock the service
+type CertificateServiceIface interface {
+	NewUploadCustomCertificateParams(certificate string, domainsuffix string, opts ...func(*UploadCustomCertificateParams)) *UploadCustomCertificateParams
+	UploadCustomCertificate(p *UploadCustomCertificateParams, opts ...OptionFunc) (*UploadCustomCertificateResponse, error)
+	UploadCustomCertificateRaw(v url.Values) (*UploadCustomCertificateResponse, error)
+	UploadCustomCertificateRawWithContext(ctx context.Context, v url.Values) (*UploadCustomCertificateResponse, error)
+	UploadCustomCertificateWithContext(ctx context.Context, p *UploadCustomCertificateParams, opts ...OptionFunc) (*UploadCustomCertificateResponse, error)
+}
+
+var _ CertificateServiceIface = (*CertificateService)(nil)
+
+// CloudIdentifierServiceIface contains all exported methods of CloudIdentifierService, so callers can depend on it to mock the service
+type CloudIdentifierServiceIface interface {
+	GetCloudIdentifier(p *GetCloudIdentifierParams, opts ...OptionFunc) (*GetCloudIdentifierResponse, error)
+	GetCloudIdentifierRaw(v url.Values) (*GetCloudIdentifierResponse, error)
+	GetCloudIdentifierRawWithContext(ctx context.Context, v url.Values) (*GetCloudIdentifierResponse, error)
+	GetCloudIdentifierWithContext(ctx context.Context, p *GetCloudIdentifierParams, opts ...OptionFunc) (*GetCloudIdentifierResponse, error)
+	NewGetCloudIdentifierParams(userid string, opts ...func(*GetCloudIdentifierParams)) *GetCloudIdentifierParams
+}
+
+var _ CloudIdentifierServiceIface = (*CloudIdentifierService)(nil)
+
+// ClusterServiceIface contains all exported methods of ClusterService, so callers can depend on it to mock the service
+type ClusterServiceIface interface {
+	AddCluster(p *AddClusterParams, opts ...OptionFunc) (*AddClusterResponse, error)
+	AddClusterRaw(v url.Values) (*AddClusterResponse, error)
+	AddClusterRawWithContext(ctx context.Context, v url.Values) (*AddClusterResponse, error)
+	AddClusterWithContext(ctx context.Context, p *AddClusterParams, opts ...OptionFunc) (*AddClusterResponse, error)
+	ClusterExists(id string, opts ...OptionFunc) (bool, error)
+	DedicateCluster(p *DedicateClusterParams, opts ...OptionFunc) (*DedicateClusterResponse, error)
+	DedicateClusterRaw(v url.Values) (*DedicateClusterResponse, error)
+	DedicateClusterRawWithContext(ctx context.Context, v url.Values) (*DedicateClusterResponse, error)
+	DedicateClusterWithContext(ctx context.Context, p *DedicateClusterParams, opts ...OptionFunc) (*DedicateClusterResponse, error)
+	DeleteCluster(p *DeleteClusterParams, opts ...OptionFunc) (*DeleteClusterResponse, error)
+	DeleteClusterRaw(v url.Values) (*DeleteClusterResponse, error)
+	DeleteClusterRawWithContext(ctx context.Context, v url.Values) (*DeleteClusterResponse, error)
+	DeleteClusterWithContext(ctx context.Context, p *DeleteClusterParams, opts ...OptionFunc) (*DeleteClusterResponse, error)
+	DisableOutOfBandManagementForCluster(p *DisableOutOfBandManagementForClusterParams, opts ...OptionFunc) (*DisableOutOfBandManagementForClusterResponse, error)
+	DisableOutOfBandManagementForClusterRaw(v url.Values) (*DisableOutOfBandManagementForClusterResponse, error)
+	DisableOutOfBandManagementForClusterRawWithContext(ctx context.Context, v url.Values) (*DisableOutOfBandManagementForClusterResponse, error)
+	DisableOutOfBandManagementForClusterWithContext(ctx context.Context, p *DisableOutOfBandManagementForClusterParams, opts ...OptionFunc) (*DisableOutOfBandManagementForClusterResponse, error)
+	EnableOutOfBandManagementForCluster(p *EnableOutOfBandManagementForClusterParams, opts ...OptionFunc) (*EnableOutOfBandManagementForClusterResponse, error)
+	EnableOutOfBandManagementForClusterRaw(v url.Values) (*EnableOutOfBandManagementForClusterResponse, error)
+	EnableOutOfBandManagementForClusterRawWithContext(ctx context.Context, v url.Values) (*EnableOutOfBandManagementForClusterResponse, error)
+	EnableOutOfBandManagementForClusterWithContext(ctx context.Context, p *EnableOutOfBandManagementForClusterParams, opts ...OptionFunc) (*EnableOutOfBandManagementForClusterResponse, error)
+	GetClusterByID(id string, opts ...OptionFunc) (*Cluster, int, error)
+	GetClusterByName(name string, opts ...OptionFunc) (*Cluster, int, error)
+	GetClusterID(name string, opts ...OptionFunc) (string, int, error)
+	ListAllClusters(p *ListClustersParams) ([]*Cluster, error)
+	ListAllDedicatedClusters(p *ListDedicatedClustersParams) ([]*DedicatedCluster, error)
+	ListClusters(p *ListClustersParams) (*ListClustersResponse, error)
+	ListClustersRaw(v url.Values) (*ListClustersResponse, error)
+	ListClustersRawWithContext(ctx context.Context, v url.Values) (*ListClustersResponse, error)
+	ListClustersWhere(p *ListClustersParams, pred func(*Cluster) bool) ([]*Cluster, error)
+	ListClustersWithContext(ctx context.Context, p *ListClustersParams) (*ListClustersResponse, error)
+	ListDedicatedClusters(p *ListDedicatedClustersParams) (*ListDedicatedClustersResponse, error)
+	ListDedicatedClustersRaw(v url.Values) (*ListDedicatedClustersResponse, error)
+	ListDedicatedClustersRawWithContext(ctx context.Context, v url.Values) (*ListDedicatedClustersResponse, error)
+	ListDedicatedClustersWhere(p *ListDedicatedClustersParams, pred func(*DedicatedCluster) bool) ([]*DedicatedCluster, error)
+	ListDedicatedClustersWithContext(ctx context.Context, p *ListDedicatedClustersParams) (*ListDedicatedClustersResponse, error)
+	NewAddClusterParams(clustername string, clustertype string, hypervisor string, podid string, zoneid string, opts ...func(*AddClusterParams)) *AddClusterParams
+	NewAddClusterParamsFromOpts(o AddClusterOpts) *AddClusterParams
+	NewDedicateClusterParams(clusterid string, domainid string, opts ...func(*DedicateClusterParams)) *DedicateClusterParams
+	NewDeleteClusterParams(id string, opts ...func(*DeleteClusterParams)) *DeleteClusterParams
+	NewDisableOutOfBandManagementForClusterParams(clusterid string, opts ...func(*DisableOutOfBandManagementForClusterParams)) *DisableOutOfBandManagementForClusterParams
+	NewEnableOutOfBandManagementForClusterParams(clusterid string, opts ...func(*EnableOutOfBandManagementForClusterParams)) *EnableOutOfBandManagementForClusterParams
+	NewListClustersParams(opts ...func(*ListClustersParams)) *ListClustersParams
+	NewListDedicatedClustersParams(opts ...func(*ListDedicatedClustersParams)) *ListDedicatedClustersParams
+	NewReleaseDedicatedClusterParams(clusterid string, opts ...func(*ReleaseDedicatedClusterParams)) *ReleaseDedicatedClusterParams
+	NewUpdateClusterParams(id string, opts ...func(*UpdateClusterParams)) *UpdateClusterParams
+	ReleaseDedicatedCluster(p *ReleaseDedicatedClusterParams, opts ...OptionFunc) (*ReleaseDedicatedClusterResponse, error)
+	ReleaseDedicatedClusterRaw(v url.Values) (*ReleaseDedicatedClusterResponse, error)
+	ReleaseDedicatedClusterRawWithContext(ctx context.Context, v url.Values) (*ReleaseDedicatedClusterResponse, error)
+	ReleaseDedicatedClusterWithContext(ctx context.Context, p *ReleaseDedicatedClusterParams, opts ...OptionFunc) (*ReleaseDedicatedClusterResponse, error)
+	StreamClusters(ctx context.Context, p *ListClustersParams) (<-chan *Cluster, <-chan error)
+	StreamDedicatedClusters(ctx context.Context, p *ListDedicatedClustersParams) (<-chan *DedicatedCluster, <-chan error)
+	UpdateCluster(p *UpdateClusterParams, opts ...OptionFunc) (*UpdateClusterResponse, error)
+	UpdateClusterRaw(v url.Values) (*UpdateClusterResponse, error)
+	UpdateClusterRawWithContext(ctx context.Context, v url.Values) (*UpdateClusterResponse, error)
+	UpdateClusterWithContext(ctx context.Context, p *UpdateClusterParams, opts ...OptionFunc) (*UpdateClusterResponse, error)
+	UpdateMany(ids []string, mutate func(*UpdateClusterParams), concurrency int, opts ...OptionFunc) (BulkResult[*UpdateClusterResponse], error)
+	WaitForClusterDeleted(ctx context.Context, id string, timeout time.Duration, opts ...OptionFunc) error
+}
+
+var _ ClusterServiceIface = (*ClusterService)(nil)
+
+// ConfigurationServiceIface contains all exported methods of ConfigurationService, so callers can depend on it to mock the service
+type ConfigurationServiceIface interface {
+	DetectDrift(desired map[string]string, scope ConfigScope) (map[string]ConfigDiff, error)
+	ListAllConfigurations(p *ListConfigurationsParams) ([]*Configuration, error)
+	ListAllDeploymentPlanners(p *ListDeploymentPlannersParams) ([]*DeploymentPlanner, error)
+	ListCapabilities(p *ListCapabilitiesParams) (*ListCapabilitiesResponse, error)
+	ListCapabilitiesRaw(v url.Values) (*ListCapabilitiesResponse, error)
+	ListCapabilitiesRawWithContext(ctx context.Context, v url.Values) (*ListCapabilitiesResponse, error)
+	ListCapabilitiesWhere(p *ListCapabilitiesParams, pred func(*Capability) bool) ([]*Capability, error)
+	ListCapabilitiesWithContext(ctx context.Context, p *ListCapabilitiesParams) (*ListCapabilitiesResponse, error)
+	ListConfigurations(p *ListConfigurationsParams) (*ListConfigurationsResponse, error)
+	ListConfigurationsRaw(v url.Values) (*ListConfigurationsResponse, error)
+	ListConfigurationsRawWithContext(ctx context.Context, v url.Values) (*ListConfigurationsResponse, error)
+	ListConfigurationsWhere(p *ListConfigurationsParams, pred func(*Configuration) bool) ([]*Configuration, error)
+	ListConfigurationsWithContext(ctx context.Context, p *ListConfigurationsParams) (*ListConfigurationsResponse, error)
+	ListDeploymentPlanners(p *ListDeploymentPlannersParams) (*ListDeploymentPlannersResponse, error)
+	ListDeploymentPlannersRaw(v url.Values) (*ListDeploymentPlannersResponse, error)
+	ListDeploymentPlannersRawWithContext(ctx context.Context, v url.Values) (*ListDeploymentPlannersResponse, error)
+	ListDeploymentPlannersWhere(p *ListDeploymentPlannersParams, pred func(*DeploymentPlanner) bool) ([]*DeploymentPlanner, error)
+	ListDeploymentPlannersWithContext(ctx context.Context, p *ListDeploymentPlannersParams) (*ListDeploymentPlannersResponse, error)
+	NewListCapabilitiesParams(opts ...func(*ListCapabilitiesParams)) *ListCapabilitiesParams
+	NewListConfigurationsParams(opts ...func(*ListConfigurationsParams)) *ListConfigurationsParams
+	NewListDeploymentPlannersParams(opts ...func(*ListDeploymentPlannersParams)) *ListDeploymentPlannersParams
+	NewUpdateConfigurationParams(name string, opts ...func(*UpdateConfigurationParams)) *UpdateConfigurationParams
+	StreamConfigurations(ctx context.Context, p *ListConfigurationsParams) (<-chan *Configuration, <-chan error)
+	StreamDeploymentPlanners(ctx context.Context, p *ListDeploymentPlannersParams) (<-chan *DeploymentPlanner, <-chan error)
+	UpdateConfiguration(p *UpdateConfigurationParams, opts ...OptionFunc) (*UpdateConfigurationResponse, error)
+	UpdateConfigurationRaw(v url.Values) (*UpdateConfigurationResponse, error)
+	UpdateConfigurationRawWithContext(ctx context.Context, v url.Values) (*UpdateConfigurationResponse, error)
+	UpdateConfigurationWithContext(ctx context.Context, p *UpdateConfigurationParams, opts ...OptionFunc) (*UpdateConfigurationResponse, error)
+}
+
+var _ ConfigurationServiceIface = (*ConfigurationService)(nil)
+
+// CustomServiceIface contains all exported methods of CustomService, so callers can depend on it to mock the service
+type CustomServiceIface interface {
+	CustomRequest(api string, p *CustomServiceParams, result interface{}) error
+	CustomRequestWithContext(ctx context.Context, api string, p *CustomServiceParams, result interface{}) error
+}
+
+var _ CustomServiceIface = (*CustomService)(nil)
+
+// DiskOfferingServiceIface contains all exported methods of DiskOfferingService, so callers can depend on it to mock the service
+type DiskOfferingServiceIface interface {
+	CreateDiskOffering(p *CreateDiskOfferingParams, opts ...OptionFunc) (*CreateDiskOfferingResponse, error)
+	CreateDiskOfferingRaw(v url.Values) (*CreateDiskOfferingResponse, error)
+	CreateDiskOfferingRawWithContext(ctx context.Context, v url.Values) (*CreateDiskOfferingResponse, error)
+	CreateDiskOfferingWithContext(ctx context.Context, p *CreateDiskOfferingParams, opts ...OptionFunc) (*CreateDiskOfferingResponse, error)
+	DeleteDiskOffering(p *DeleteDiskOfferingParams, opts ...OptionFunc) (*DeleteDiskOfferingResponse, error)
+	DeleteDiskOfferingRaw(v url.Values) (*DeleteDiskOfferingResponse, error)
+	DeleteDiskOfferingRawWithContext(ctx context.Context, v url.Values) (*DeleteDiskOfferingResponse, error)
+	DeleteDiskOfferingWithContext(ctx context.Context, p *DeleteDiskOfferingParams, opts ...OptionFunc) (*DeleteDiskOfferingResponse, error)
+	DiskOfferingExists(id string, opts ...OptionFunc) (bool, error)
+	GetDiskOfferingByID(id string, opts ...OptionFunc) (*DiskOffering, int, error)
+	GetDiskOfferingByName(name string, opts ...OptionFunc) (*DiskOffering, int, error)
+	GetDiskOfferingID(name string, opts ...OptionFunc) (string, int, error)
+	InvalidateResolveCache()
+	ListAllDiskOfferings(p *ListDiskOfferingsParams) ([]*DiskOffering, error)
+	ListDiskOfferings(p *ListDiskOfferingsParams) (*ListDiskOfferingsResponse, error)
+	ListDiskOfferingsRaw(v url.Values) (*ListDiskOfferingsResponse, error)
+	ListDiskOfferingsRawWithContext(ctx context.Context, v url.Values) (*ListDiskOfferingsResponse, error)
+	ListDiskOfferingsWhere(p *ListDiskOfferingsParams, pred func(*DiskOffering) bool) ([]*DiskOffering, error)
+	ListDiskOfferingsWithContext(ctx context.Context, p *ListDiskOfferingsParams) (*ListDiskOfferingsResponse, error)
+	NewCreateDiskOfferingParams(displaytext string, name string, opts ...func(*CreateDiskOfferingParams)) *CreateDiskOfferingParams
+	NewDeleteDiskOfferingParams(id string, opts ...func(*DeleteDiskOfferingParams)) *DeleteDiskOfferingParams
+	NewListDiskOfferingsParams(opts ...func(*ListDiskOfferingsParams)) *ListDiskOfferingsParams
+	NewUpdateDiskOfferingParams(id string, opts ...func(*UpdateDiskOfferingParams)) *UpdateDiskOfferingParams
+	ResolveOffering(name string) (*DiskOffering, error)
+	SetResolveTTL(ttl time.Duration)
+	StreamDiskOfferings(ctx context.Context, p *ListDiskOfferingsParams) (<-chan *DiskOffering, <-chan error)
+	UpdateDiskOffering(p *UpdateDiskOfferingParams, opts ...OptionFunc) (*UpdateDiskOfferingResponse, error)
+	UpdateDiskOfferingRaw(v url.Values) (*UpdateDiskOfferingResponse, error)
+	UpdateDiskOfferingRawWithContext(ctx context.Context, v url.Values) (*UpdateDiskOfferingResponse, error)
+	UpdateDiskOfferingWithContext(ctx context.Context, p *UpdateDiskOfferingParams, opts ...OptionFunc) (*UpdateDiskOfferingResponse, error)
+	UpdateMany(ids []string, mutate func(*UpdateDiskOfferingParams), concurrency int, opts ...OptionFunc) (BulkResult[*UpdateDiskOfferingResponse], error)
+	WaitForDiskOfferingDeleted(ctx context.Context, id string, timeout time.Duration, opts ...OptionFunc) error
+}
+
+var _ DiskOfferingServiceIface = (*DiskOfferingService)(nil)
+
+// DomainServiceIface contains all exported methods of DomainService, so callers can depend on it to mock the service
+type DomainServiceIface interface {
+	CreateDomain(p *CreateDomainParams, opts ...OptionFunc) (*CreateDomainResponse, error)
+	CreateDomainRaw(v url.Values) (*CreateDomainResponse, error)
+	CreateDomainRawWithContext(ctx context.Context, v url.Values) (*CreateDomainResponse, error)
+	CreateDomainWithContext(ctx context.Context, p *CreateDomainParams, opts ...OptionFunc) (*CreateDomainResponse, error)
+	DeleteDomain(p *DeleteDomainParams, opts ...OptionFunc) (*DeleteDomainResponse, error)
+	DeleteDomainRaw(v url.Values) (*DeleteDomainResponse, error)
+	DeleteDomainRawWithContext(ctx context.Context, v url.Values) (*DeleteDomainResponse, error)
+	DeleteDomainWithContext(ctx context.Context, p *DeleteDomainParams, opts ...OptionFunc) (*DeleteDomainResponse, error)
+	DomainChildrenExists(id string, opts ...OptionFunc) (bool, error)
+	DomainExists(id string, opts ...OptionFunc) (bool, error)
+	GetDomainByID(id string, opts ...OptionFunc) (*Domain, int, error)
+	GetDomainByName(name string, opts ...OptionFunc) (*Domain, int, error)
+	GetDomainChildrenByID(id string, opts ...OptionFunc) (*DomainChildren, int, error)
+	GetDomainChildrenByName(name string, opts ...OptionFunc) (*DomainChildren, int, error)
+	GetDomainChildrenID(name string, opts ...OptionFunc) (string, int, error)
+	GetDomainID(name string, opts ...OptionFunc) (string, int, error)
+	ListAllDomainChildren(p *ListDomainChildrenParams) ([]*DomainChildren, error)
+	ListAllDomains(p *ListDomainsParams) ([]*Domain, error)
+	ListDomainChildren(p *ListDomainChildrenParams) (*ListDomainChildrenResponse, error)
+	ListDomainChildrenRaw(v url.Values) (*ListDomainChildrenResponse, error)
+	ListDomainChildrenRawWithContext(ctx context.Context, v url.Values) (*ListDomainChildrenResponse, error)
+	ListDomainChildrenWhere(p *ListDomainChildrenParams, pred func(*DomainChildren) bool) ([]*DomainChildren, error)
+	ListDomainChildrenWithContext(ctx context.Context, p *ListDomainChildrenParams) (*ListDomainChildrenResponse, error)
+	ListDomains(p *ListDomainsParams) (*ListDomainsResponse, error)
+	ListDomainsRaw(v url.Values) (*ListDomainsResponse, error)
+	ListDomainsRawWithContext(ctx context.Context, v url.Values) (*ListDomainsResponse, error)
+	ListDomainsWhere(p *ListDomainsParams, pred func(*Domain) bool) ([]*Domain, error)
+	ListDomainsWithContext(ctx context.Context, p *ListDomainsParams) (*ListDomainsResponse, error)
+	NewCreateDomainParams(name string, opts ...func(*CreateDomainParams)) *CreateDomainParams
+	NewDeleteDomainParams(id string, opts ...func(*DeleteDomainParams)) *DeleteDomainParams
+	NewListDomainChildrenParams(opts ...func(*ListDomainChildrenParams)) *ListDomainChildrenParams
+	NewListDomainsParams(opts ...func(*ListDomainsParams)) *ListDomainsParams
+	NewUpdateDomainParams(id string, opts ...func(*UpdateDomainParams)) *UpdateDomainParams
+	StreamDomainChildren(ctx context.Context, p *ListDomainChildrenParams) (<-chan *DomainChildren, <-chan error)
+	StreamDomains(ctx context.Context, p *ListDomainsParams) (<-chan *Domain, <-chan error)
+	UpdateDomain(p *UpdateDomainParams, opts ...OptionFunc) (*UpdateDomainResponse, error)
+	UpdateDomainRaw(v url.Values) (*UpdateDomainResponse, error)
+	UpdateDomainRawWithContext(ctx context.Context, v url.Values) (*UpdateDomainResponse, error)
+	UpdateDomainWithContext(ctx context.Context, p *UpdateDomainParams, opts ...OptionFunc) (*UpdateDomainResponse, error)
+	UpdateMany(ids []string, mutate func(*UpdateDomainParams), concurrency int, opts ...OptionFunc) (BulkResult[*UpdateDomainResponse], error)
+	WaitForDomainDeleted(ctx context.Context, id string, timeout time.Duration, opts ...OptionFunc) error
+}
+
+var _ DomainServiceIface = (*DomainService)(nil)
+
+// EventServiceIface contains all exported methods of EventService, so callers can depend on it to mock the service
+type EventServiceIface interface {
+	ArchiveEvents(p *ArchiveEventsParams, opts ...OptionFunc) (*ArchiveEventsResponse, error)
+	ArchiveEventsRaw(v url.Values) (*ArchiveEventsResponse, error)
+	ArchiveEventsRawWithContext(ctx context.Context, v url.Values) (*ArchiveEventsResponse, error)
+	ArchiveEventsWithContext(ctx context.Context, p *ArchiveEventsParams, opts ...OptionFunc) (*ArchiveEventsResponse, error)
+	DeleteEvents(p *DeleteEventsParams, opts ...OptionFunc) (*DeleteEventsResponse, error)
+	DeleteEventsRaw(v url.Values) (*DeleteEventsResponse, error)
+	DeleteEventsRawWithContext(ctx context.Context, v url.Values) (*DeleteEventsResponse, error)
+	DeleteEventsWithContext(ctx context.Context, p *DeleteEventsParams, opts ...OptionFunc) (*DeleteEventsResponse, error)
+	EventExists(id string, opts ...OptionFunc) (bool, error)
+	GetEventByID(id string, opts ...OptionFunc) (*Event, int, error)
+	ListAllEvents(p *ListEventsParams) ([]*Event, error)
+	ListEventTypes(p *ListEventTypesParams) (*ListEventTypesResponse, error)
+	ListEventTypesRaw(v url.Values) (*ListEventTypesResponse, error)
+	ListEventTypesRawWithContext(ctx context.Context, v url.Values) (*ListEventTypesResponse, error)
+	ListEventTypesWhere(p *ListEventTypesParams, pred func(*EventType) bool) ([]*EventType, error)
+	ListEventTypesWithContext(ctx context.Context, p *ListEventTypesParams) (*ListEventTypesResponse, error)
+	ListEvents(p *ListEventsParams) (*ListEventsResponse, error)
+	ListEventsRaw(v url.Values) (*ListEventsResponse, error)
+	ListEventsRawWithContext(ctx context.Context, v url.Values) (*ListEventsResponse, error)
+	ListEventsWhere(p *ListEventsParams, pred func(*Event) bool) ([]*Event, error)
+	ListEventsWithContext(ctx context.Context, p *ListEventsParams) (*ListEventsResponse, error)
+	NewArchiveEventsParams(opts ...func(*ArchiveEventsParams)) *ArchiveEventsParams
+	NewDeleteEventsParams(opts ...func(*DeleteEventsParams)) *DeleteEventsParams
+	NewListEventTypesParams(opts ...func(*ListEventTypesParams)) *ListEventTypesParams
+	NewListEventsParams(opts ...func(*ListEventsParams)) *ListEventsParams
+	StreamEvents(ctx context.Context, p *ListEventsParams) (<-chan *Event, <-chan error)
+	WatchEvents(ctx context.Context, since time.Time, interval time.Duration, opts ...OptionFunc) <-chan *Event
+}
+
+var _ EventServiceIface = (*EventService)(nil)
+
+// ExtFirewallServiceIface contains all exported methods of ExtFirewallService, so callers can depend on it to mock the service
+type ExtFirewallServiceIface interface {
+	AddExternalFirewall(p *AddExternalFirewallParams, opts ...OptionFunc) (*AddExternalFirewallResponse, error)
+	AddExternalFirewallRaw(v url.Values) (*AddExternalFirewallResponse, error)
+	AddExternalFirewallRawWithContext(ctx context.Context, v url.Values) (*AddExternalFirewallResponse, error)
+	AddExternalFirewallWithContext(ctx context.Context, p *AddExternalFirewallParams, opts ...OptionFunc) (*AddExternalFirewallResponse, error)
+	DeleteExternalFirewall(p *DeleteExternalFirewallParams, opts ...OptionFunc) (*DeleteExternalFirewallResponse, error)
+	DeleteExternalFirewallRaw(v url.Values) (*DeleteExternalFirewallResponse, error)
+	DeleteExternalFirewallRawWithContext(ctx context.Context, v url.Values) (*DeleteExternalFirewallResponse, error)
+	DeleteExternalFirewallWithContext(ctx context.Context, p *DeleteExternalFirewallParams, opts ...OptionFunc) (*DeleteExternalFirewallResponse, error)
+	ListAllExternalFirewalls(p *ListExternalFirewallsParams) ([]*ExternalFirewall, error)
+	ListExternalFirewalls(p *ListExternalFirewallsParams) (*ListExternalFirewallsResponse, error)
+	ListExternalFirewallsRaw(v url.Values) (*ListExternalFirewallsResponse, error)
+	ListExternalFirewallsRawWithContext(ctx context.Context, v url.Values) (*ListExternalFirewallsResponse, error)
+	ListExternalFirewallsWhere(p *ListExternalFirewallsParams, pred func(*ExternalFirewall) bool) ([]*ExternalFirewall, error)
+	ListExternalFirewallsWithContext(ctx context.Context, p *ListExternalFirewallsParams) (*ListExternalFirewallsResponse, error)
+	NewAddExternalFirewallParams(password string, url string, username string, zoneid string, opts ...func(*AddExternalFirewallParams)) *AddExternalFirewallParams
+	NewAddExternalFirewallParamsFromOpts(o AddExternalFirewallOpts) *AddExternalFirewallParams
+	NewDeleteExternalFirewallParams(id string, opts ...func(*DeleteExternalFirewallParams)) *DeleteExternalFirewallParams
+	NewListExternalFirewallsParams(zoneid string, opts ...func(*ListExternalFirewallsParams)) *ListExternalFirewallsParams
+	StreamExternalFirewalls(ctx context.Context, p *ListExternalFirewallsParams) (<-chan *ExternalFirewall, <-chan error)
+}
+
+var _ ExtFirewallServiceIface = (*ExtFirewallService)(nil)
+
+// ExtLoadBalancerServiceIface contains all exported methods of ExtLoadBalancerService, so callers can depend on it to mock the service
+type ExtLoadBalancerServiceIface interface {
+	AddExternalLoadBalancer(p *AddExternalLoadBalancerParams, opts ...OptionFunc) (*AddExternalLoadBalancerResponse, error)
+	AddExternalLoadBalancerRaw(v url.Values) (*AddExternalLoadBalancerResponse, error)
+	AddExternalLoadBalancerRawWithContext(ctx context.Context, v url.Values) (*AddExternalLoadBalancerResponse, error)
+	AddExternalLoadBalancerWithContext(ctx context.Context, p *AddExternalLoadBalancerParams, opts ...OptionFunc) (*AddExternalLoadBalancerResponse, error)
+	DeleteExternalLoadBalancer(p *DeleteExternalLoadBalancerParams, opts ...OptionFunc) (*DeleteExternalLoadBalancerResponse, error)
+	DeleteExternalLoadBalancerRaw(v url.Values) (*DeleteExternalLoadBalancerResponse, error)
+	DeleteExternalLoadBalancerRawWithContext(ctx context.Context, v url.Values) (*DeleteExternalLoadBalancerResponse, error)
+	DeleteExternalLoadBalancerWithContext(ctx context.Context, p *DeleteExternalLoadBalancerParams, opts ...OptionFunc) (*DeleteExternalLoadBalancerResponse, error)
+	GetExternalLoadBalancerID(keyword string, opts ...OptionFunc) (string, int, error)
+	ListAllExternalLoadBalancers(p *ListExternalLoadBalancersParams) ([]*ExternalLoadBalancer, error)
+	ListExternalLoadBalancers(p *ListExternalLoadBalancersParams) (*ListExternalLoadBalancersResponse, error)
+	ListExternalLoadBalancersRaw(v url.Values) (*ListExternalLoadBalancersResponse, error)
+	ListExternalLoadBalancersRawWithContext(ctx context.Context, v url.Values) (*ListExternalLoadBalancersResponse, error)
+	ListExternalLoadBalancersWhere(p *ListExternalLoadBalancersParams, pred func(*ExternalLoadBalancer) bool) ([]*ExternalLoadBalancer, error)
+	ListExternalLoadBalancersWithContext(ctx context.Context, p *ListExternalLoadBalancersParams) (*ListExternalLoadBalancersResponse, error)
+	NewAddExternalLoadBalancerParams(password string, url string, username string, zoneid string, opts ...func(*AddExternalLoadBalancerParams)) *AddExternalLoadBalancerParams
+	NewAddExternalLoadBalancerParamsFromOpts(o AddExternalLoadBalancerOpts) *AddExternalLoadBalancerParams
+	NewDeleteExternalLoadBalancerParams(id string, opts ...func(*DeleteExternalLoadBalancerParams)) *DeleteExternalLoadBalancerParams
+	NewListExternalLoadBalancersParams(opts ...func(*ListExternalLoadBalancersParams)) *ListExternalLoadBalancersParams
+	StreamExternalLoadBalancers(ctx context.Context, p *ListExternalLoadBalancersParams) (<-chan *ExternalLoadBalancer, <-chan error)
+}
+
+var _ ExtLoadBalancerServiceIface = (*ExtLoadBalancerService)(nil)
+
+// ExternalDeviceServiceIface contains all exported methods of ExternalDeviceService, so callers can depend on it to mock the service
+type ExternalDeviceServiceIface interface {
+	AddCiscoAsa1000vResource(p *AddCiscoAsa1000vResourceParams, opts ...OptionFunc) (*AddCiscoAsa1000vResourceResponse, error)
+	AddCiscoAsa1000vResourceRaw(v url.Values) (*AddCiscoAsa1000vResourceResponse, error)
+	AddCiscoAsa1000vResourceRawWithContext(ctx context.Context, v url.Values) (*AddCiscoAsa1000vResourceResponse, error)
+	AddCiscoAsa1000vResourceWithContext(ctx context.Context, p *AddCiscoAsa1000vResourceParams, opts ...OptionFunc) (*AddCiscoAsa1000vResourceResponse, error)
+	AddCiscoVnmcResource(p *AddCiscoVnmcResourceParams, opts ...OptionFunc) (*AddCiscoVnmcResourceResponse, error)
+	AddCiscoVnmcResourceRaw(v url.Values) (*AddCiscoVnmcResourceResponse, error)
+	AddCiscoVnmcResourceRawWithContext(ctx context.Context, v url.Values) (*AddCiscoVnmcResourceResponse, error)
+	AddCiscoVnmcResourceWithContext(ctx context.Context, p *AddCiscoVnmcResourceParams, opts ...OptionFunc) (*AddCiscoVnmcResourceResponse, error)
+	DeleteCiscoAsa1000vResource(p *DeleteCiscoAsa1000vResourceParams, opts ...OptionFunc) (*DeleteCiscoAsa1000vResourceResponse, error)
+	DeleteCiscoAsa1000vResourceRaw(v url.Values) (*DeleteCiscoAsa1000vResourceResponse, error)
+	DeleteCiscoAsa1000vResourceRawWithContext(ctx context.Context, v url.Values) (*DeleteCiscoAsa1000vResourceResponse, error)
+	DeleteCiscoAsa1000vResourceWithContext(ctx context.Context, p *DeleteCiscoAsa1000vResourceParams, opts ...OptionFunc) (*DeleteCiscoAsa1000vResourceResponse, error)
+	DeleteCiscoNexusVSM(p *DeleteCiscoNexusVSMParams, opts ...OptionFunc) (*DeleteCiscoNexusVSMResponse, error)
+	DeleteCiscoNexusVSMRaw(v url.Values) (*DeleteCiscoNexusVSMResponse, error)
+	DeleteCiscoNexusVSMRawWithContext(ctx context.Context, v url.Values) (*DeleteCiscoNexusVSMResponse, error)
+	DeleteCiscoNexusVSMWithContext(ctx context.Context, p *DeleteCiscoNexusVSMParams, opts ...OptionFunc) (*DeleteCiscoNexusVSMResponse, error)
+	DeleteCiscoVnmcResource(p *DeleteCiscoVnmcResourceParams, opts ...OptionFunc) (*DeleteCiscoVnmcResourceResponse, error)
+	DeleteCiscoVnmcResourceRaw(v url.Values) (*DeleteCiscoVnmcResourceResponse, error)
+	DeleteCiscoVnmcResourceRawWithContext(ctx context.Context, v url.Values) (*DeleteCiscoVnmcResourceResponse, error)
+	DeleteCiscoVnmcResourceWithContext(ctx context.Context, p *DeleteCiscoVnmcResourceParams, opts ...OptionFunc) (*DeleteCiscoVnmcResourceResponse, error)
+	DisableCiscoNexusVSM(p *DisableCiscoNexusVSMParams, opts ...OptionFunc) (*DisableCiscoNexusVSMResponse, error)
+	DisableCiscoNexusVSMRaw(v url.Values) (*DisableCiscoNexusVSMResponse, error)
+	DisableCiscoNexusVSMRawWithContext(ctx context.Context, v url.Values) (*DisableCiscoNexusVSMResponse, error)
+	DisableCiscoNexusVSMWithContext(ctx context.Context, p *DisableCiscoNexusVSMParams, opts ...OptionFunc) (*DisableCiscoNexusVSMResponse, error)
+	EnableCiscoNexusVSM(p *EnableCiscoNexusVSMParams, opts ...OptionFunc) (*EnableCiscoNexusVSMResponse, error)
+	EnableCiscoNexusVSMRaw(v url.Values) (*EnableCiscoNexusVSMResponse, error)
+	EnableCiscoNexusVSMRawWithContext(ctx context.Context, v url.Values) (*EnableCiscoNexusVSMResponse, error)
+	EnableCiscoNexusVSMWithContext(ctx context.Context, p *EnableCiscoNexusVSMParams, opts ...OptionFunc) (*EnableCiscoNexusVSMResponse, error)
+	ListAllCiscoAsa1000vResources(p *ListCiscoAsa1000vResourcesParams) ([]*CiscoAsa1000vResource, error)
+	ListAllCiscoNexusVSMs(p *ListCiscoNexusVSMsParams) ([]*CiscoNexusVSM, error)
+	ListAllCiscoVnmcResources(p *ListCiscoVnmcResourcesParams) ([]*CiscoVnmcResource, error)
+	ListCiscoAsa1000vResources(p *ListCiscoAsa1000vResourcesParams) (*ListCiscoAsa1000vResourcesResponse, error)
+	ListCiscoAsa1000vResourcesRaw(v url.Values) (*ListCiscoAsa1000vResourcesResponse, error)
+	ListCiscoAsa1000vResourcesRawWithContext(ctx context.Context, v url.Values) (*ListCiscoAsa1000vResourcesResponse, error)
+	ListCiscoAsa1000vResourcesWhere(p *ListCiscoAsa1000vResourcesParams, pred func(*CiscoAsa1000vResource) bool) ([]*CiscoAsa1000vResource, error)
+	ListCiscoAsa1000vResourcesWithContext(ctx context.Context, p *ListCiscoAsa1000vResourcesParams) (*ListCiscoAsa1000vResourcesResponse, error)
+	ListCiscoNexusVSMs(p *ListCiscoNexusVSMsParams) (*ListCiscoNexusVSMsResponse, error)
+	ListCiscoNexusVSMsRaw(v url.Values) (*ListCiscoNexusVSMsResponse, error)
+	ListCiscoNexusVSMsRawWithContext(ctx context.Context, v url.Values) (*ListCiscoNexusVSMsResponse, error)
+	ListCiscoNexusVSMsWhere(p *ListCiscoNexusVSMsParams, pred func(*CiscoNexusVSM) bool) ([]*CiscoNexusVSM, error)
+	ListCiscoNexusVSMsWithContext(ctx context.Context, p *ListCiscoNexusVSMsParams) (*ListCiscoNexusVSMsResponse, error)
+	ListCiscoVnmcResources(p *ListCiscoVnmcResourcesParams) (*ListCiscoVnmcResourcesResponse, error)
+	ListCiscoVnmcResourcesRaw(v url.Values) (*ListCiscoVnmcResourcesResponse, error)
+	ListCiscoVnmcResourcesRawWithContext(ctx context.Context, v url.Values) (*ListCiscoVnmcResourcesResponse, error)
+	ListCiscoVnmcResourcesWhere(p *ListCiscoVnmcResourcesParams, pred func(*CiscoVnmcResource) bool) ([]*CiscoVnmcResource, error)
+	ListCiscoVnmcResourcesWithContext(ctx context.Context, p *ListCiscoVnmcResourcesParams) (*ListCiscoVnmcResourcesResponse, error)
+	NewAddCiscoAsa1000vResourceParams(clusterid string, hostname string, insideportprofile string, physicalnetworkid string, opts ...func(*AddCiscoAsa1000vResourceParams)) *AddCiscoAsa1000vResourceParams
+	NewAddCiscoAsa1000vResourceParamsFromOpts(o AddCiscoAsa1000vResourceOpts) *AddCiscoAsa1000vResourceParams
+	NewAddCiscoVnmcResourceParams(hostname string, password string, physicalnetworkid string, username string, opts ...func(*AddCiscoVnmcResourceParams)) *AddCiscoVnmcResourceParams
+	NewAddCiscoVnmcResourceParamsFromOpts(o AddCiscoVnmcResourceOpts) *AddCiscoVnmcResourceParams
+	NewDeleteCiscoAsa1000vResourceParams(resourceid string, opts ...func(*DeleteCiscoAsa1000vResourceParams)) *DeleteCiscoAsa1000vResourceParams
+	NewDeleteCiscoNexusVSMParams(id string, opts ...func(*DeleteCiscoNexusVSMParams)) *DeleteCiscoNexusVSMParams
+	NewDeleteCiscoVnmcResourceParams(resourceid string, opts ...func(*DeleteCiscoVnmcResourceParams)) *DeleteCiscoVnmcResourceParams
+	NewDisableCiscoNexusVSMParams(id string, opts ...func(*DisableCiscoNexusVSMParams)) *DisableCiscoNexusVSMParams
+	NewEnableCiscoNexusVSMParams(id string, opts ...func(*EnableCiscoNexusVSMParams)) *EnableCiscoNexusVSMParams
+	NewListCiscoAsa1000vResourcesParams(opts ...func(*ListCiscoAsa1000vResourcesParams)) *ListCiscoAsa1000vResourcesParams
+	NewListCiscoNexusVSMsParams(opts ...func(*ListCiscoNexusVSMsParams)) *ListCiscoNexusVSMsParams
+	NewListCiscoVnmcResourcesParams(opts ...func(*ListCiscoVnmcResourcesParams)) *ListCiscoVnmcResourcesParams
+	StreamCiscoAsa1000vResources(ctx context.Context, p *ListCiscoAsa1000vResourcesParams) (<-chan *CiscoAsa1000vResource, <-chan error)
+	StreamCiscoNexusVSMs(ctx context.Context, p *ListCiscoNexusVSMsParams) (<-chan *CiscoNexusVSM, <-chan error)
+	StreamCiscoVnmcResources(ctx context.Context, p *ListCiscoVnmcResourcesParams) (<-chan *CiscoVnmcResource, <-chan error)
+}
+
+var _ ExternalDeviceServiceIface = (*ExternalDeviceService)(nil)
+
+// FirewallServiceIface contains all exported methods of FirewallService, so callers can depend on it to mock the service
+type FirewallServiceIface interface {
+	AddPaloAltoFirewall(p *AddPaloAltoFirewallParams, opts ...OptionFunc) (*AddPaloAltoFirewallResponse, error)
+	AddPaloAltoFirewallRaw(v url.Values) (*AddPaloAltoFirewallResponse, error)
+	AddPaloAltoFirewallRawWithContext(ctx context.Context, v url.Values) (*AddPaloAltoFirewallResponse, error)
+	AddPaloAltoFirewallWithContext(ctx context.Context, p *AddPaloAltoFirewallParams, opts ...OptionFunc) (*AddPaloAltoFirewallResponse, error)
+	AddSrxFirewall(p *AddSrxFirewallParams, opts ...OptionFunc) (*AddSrxFirewallResponse, error)
+	AddSrxFirewallRaw(v url.Values) (*AddSrxFirewallResponse, error)
+	AddSrxFirewallRawWithContext(ctx context.Context, v url.Values) (*AddSrxFirewallResponse, error)
+	AddSrxFirewallWithContext(ctx context.Context, p *AddSrxFirewallParams, opts ...OptionFunc) (*AddSrxFirewallResponse, error)
+	ConfigurePaloAltoFirewall(p *ConfigurePaloAltoFirewallParams, opts ...OptionFunc) (*PaloAltoFirewallResponse, error)
+	ConfigurePaloAltoFirewallRaw(v url.Values) (*PaloAltoFirewallResponse, error)
+	ConfigurePaloAltoFirewallRawWithContext(ctx context.Context, v url.Values) (*PaloAltoFirewallResponse, error)
+	ConfigurePaloAltoFirewallWithContext(ctx context.Context, p *ConfigurePaloAltoFirewallParams, opts ...OptionFunc) (*PaloAltoFirewallResponse, error)
+	ConfigureSrxFirewall(p *ConfigureSrxFirewallParams, opts ...OptionFunc) (*SrxFirewallResponse, error)
+	ConfigureSrxFirewallRaw(v url.Values) (*SrxFirewallResponse, error)
+	ConfigureSrxFirewallRawWithContext(ctx context.Context, v url.Values) (*SrxFirewallResponse, error)
+	ConfigureSrxFirewallWithContext(ctx context.Context, p *ConfigureSrxFirewallParams, opts ...OptionFunc) (*SrxFirewallResponse, error)
+	CreateEgressFirewallRule(p *CreateEgressFirewallRuleParams, opts ...OptionFunc) (*CreateEgressFirewallRuleResponse, error)
+	CreateEgressFirewallRuleRaw(v url.Values) (*CreateEgressFirewallRuleResponse, error)
+	CreateEgressFirewallRuleRawWithContext(ctx context.Context, v url.Values) (*CreateEgressFirewallRuleResponse, error)
+	CreateEgressFirewallRuleWithContext(ctx context.Context, p *CreateEgressFirewallRuleParams, opts ...OptionFunc) (*CreateEgressFirewallRuleResponse, error)
+	CreateFirewallRule(p *CreateFirewallRuleParams, opts ...OptionFunc) (*CreateFirewallRuleResponse, error)
+	CreateFirewallRuleRaw(v url.Values) (*CreateFirewallRuleResponse, error)
+	CreateFirewallRuleRawWithContext(ctx context.Context, v url.Values) (*CreateFirewallRuleResponse, error)
+	CreateFirewallRuleWithContext(ctx context.Context, p *CreateFirewallRuleParams, opts ...OptionFunc) (*CreateFirewallRuleResponse, error)
+	CreatePortForwardingRule(p *CreatePortForwardingRuleParams, opts ...OptionFunc) (*CreatePortForwardingRuleResponse, error)
+	CreatePortForwardingRuleRaw(v url.Values) (*CreatePortForwardingRuleResponse, error)
+	CreatePortForwardingRuleRawWithContext(ctx context.Context, v url.Values) (*CreatePortForwardingRuleResponse, error)
+	CreatePortForwardingRuleWithContext(ctx context.Context, p *CreatePortForwardingRuleParams, opts ...OptionFunc) (*CreatePortForwardingRuleResponse, error)
+	DeleteEgressFirewallRule(p *DeleteEgressFirewallRuleParams, opts ...OptionFunc) (*DeleteEgressFirewallRuleResponse, error)
+	DeleteEgressFirewallRuleRaw(v url.Values) (*DeleteEgressFirewallRuleResponse, error)
+	DeleteEgressFirewallRuleRawWithContext(ctx context.Context, v url.Values) (*DeleteEgressFirewallRuleResponse, error)
+	DeleteEgressFirewallRuleWithContext(ctx context.Context, p *DeleteEgressFirewallRuleParams, opts ...OptionFunc) (*DeleteEgressFirewallRuleResponse, error)
+	DeleteFirewallRule(p *DeleteFirewallRuleParams, opts ...OptionFunc) (*DeleteFirewallRuleResponse, error)
+	DeleteFirewallRuleRaw(v url.Values) (*DeleteFirewallRuleResponse, error)
+	DeleteFirewallRuleRawWithContext(ctx context.Context, v url.Values) (*DeleteFirewallRuleResponse, error)
+	DeleteFirewallRuleWithContext(ctx context.Context, p *DeleteFirewallRuleParams, opts ...OptionFunc) (*DeleteFirewallRuleResponse, error)
+	DeletePaloAltoFirewall(p *DeletePaloAltoFirewallParams, opts ...OptionFunc) (*DeletePaloAltoFirewallResponse, error)
+	DeletePaloAltoFirewallRaw(v url.Values) (*DeletePaloAltoFirewallResponse, error)
+	DeletePaloAltoFirewallRawWithContext(ctx context.Context, v url.Values) (*DeletePaloAltoFirewallResponse, error)
+	DeletePaloAltoFirewallWithContext(ctx context.Context, p *DeletePaloAltoFirewallParams, opts ...OptionFunc) (*DeletePaloAltoFirewallResponse, error)
+	DeletePortForwardingRule(p *DeletePortForwardingRuleParams, opts ...OptionFunc) (*DeletePortForwardingRuleResponse, error)
+	DeletePortForwardingRuleRaw(v url.Values) (*DeletePortForwardingRuleResponse, error)
+	DeletePortForwardingRuleRawWithContext(ctx context.Context, v url.Values) (*DeletePortForwardingRuleResponse, error)
+	DeletePortForwardingRuleWithContext(ctx context.Context, p *DeletePortForwardingRuleParams, opts ...OptionFunc) (*DeletePortForwardingRuleResponse, error)
+	DeleteSrxFirewall(p *DeleteSrxFirewallParams, opts ...OptionFunc) (*DeleteSrxFirewallResponse, error)
+	DeleteSrxFirewallRaw(v url.Values) (*DeleteSrxFirewallResponse, error)
+	DeleteSrxFirewallRawWithContext(ctx context.Context, v url.Values) (*DeleteSrxFirewallResponse, error)
+	DeleteSrxFirewallWithContext(ctx context.Context, p *DeleteSrxFirewallParams, opts ...OptionFunc) (*DeleteSrxFirewallResponse, error)
+	EgressFirewallRuleExists(id string, opts ...OptionFunc) (bool, error)
+	FirewallRuleExists(id string, opts ...OptionFunc) (bool, error)
+	GetEgressFirewallRuleByID(id string, opts ...OptionFunc) (*EgressFirewallRule, int, error)
+	GetFirewallRuleByID(id string, opts ...OptionFunc) (*FirewallRule, int, error)
+	GetPortForwardingRuleByID(id string, opts ...OptionFunc) (*PortForwardingRule, int, error)
+	ListAllEgressFirewallRules(p *ListEgressFirewallRulesParams) ([]*EgressFirewallRule, error)
+	ListAllFirewallRules(p *ListFirewallRulesParams) ([]*FirewallRule, error)
+	ListAllPaloAltoFirewalls(p *ListPaloAltoFirewallsParams) ([]*PaloAltoFirewall, error)
+	ListAllPortForwardingRules(p *ListPortForwardingRulesParams) ([]*PortForwardingRule, error)
+	ListAllSrxFirewalls(p *ListSrxFirewallsParams) ([]*SrxFirewall, error)
+	ListEgressFirewallRules(p *ListEgressFirewallRulesParams) (*ListEgressFirewallRulesResponse, error)
+	ListEgressFirewallRulesRaw(v url.Values) (*ListEgressFirewallRulesResponse, error)
+	ListEgressFirewallRulesRawWithContext(ctx context.Context, v url.Values) (*ListEgressFirewallRulesResponse, error)
+	ListEgressFirewallRulesWhere(p *ListEgressFirewallRulesParams, pred func(*EgressFirewallRule) bool) ([]*EgressFirewallRule, error)
+	ListEgressFirewallRulesWithContext(ctx context.Context, p *ListEgressFirewallRulesParams) (*ListEgressFirewallRulesResponse, error)
+	ListFirewallRules(p *ListFirewallRulesParams) (*ListFirewallRulesResponse, error)
+	ListFirewallRulesRaw(v url.Values) (*ListFirewallRulesResponse, error)
+	ListFirewallRulesRawWithContext(ctx context.Context, v url.Values) (*ListFirewallRulesResponse, error)
+	ListFirewallRulesWhere(p *ListFirewallRulesParams, pred func(*FirewallRule) bool) ([]*FirewallRule, error)
+	ListFirewallRulesWithContext(ctx context.Context, p *ListFirewallRulesParams) (*ListFirewallRulesResponse, error)
+	ListPaloAltoFirewalls(p *ListPaloAltoFirewallsParams) (*ListPaloAltoFirewallsResponse, error)
+	ListPaloAltoFirewallsRaw(v url.Values) (*ListPaloAltoFirewallsResponse, error)
+	ListPaloAltoFirewallsRawWithContext(ctx context.Context, v url.Values) (*ListPaloAltoFirewallsResponse, error)
+	ListPaloAltoFirewallsWhere(p *ListPaloAltoFirewallsParams, pred func(*PaloAltoFirewall) bool) ([]*PaloAltoFirewall, error)
+	ListPaloAltoFirewallsWithContext(ctx context.Context, p *ListPaloAltoFirewallsParams) (*ListPaloAltoFirewallsResponse, error)
+	ListPortForwardingRules(p *ListPortForwardingRulesParams) (*ListPortForwardingRulesResponse, error)
+	ListPortForwardingRulesRaw(v url.Values) (*ListPortForwardingRulesResponse, error)
+	ListPortForwardingRulesRawWithContext(ctx context.Context, v url.Values) (*ListPortForwardingRulesResponse, error)
+	ListPortForwardingRulesWhere(p *ListPortForwardingRulesParams, pred func(*PortForwardingRule) bool) ([]*PortForwardingRule, error)
+	ListPortForwardingRulesWithContext(ctx context.Context, p *ListPortForwardingRulesParams) (*ListPortForwardingRulesResponse, error)
+	ListSrxFirewalls(p *ListSrxFirewallsParams) (*ListSrxFirewallsResponse, error)
+	ListSrxFirewallsRaw(v url.Values) (*ListSrxFirewallsResponse, error)
+	ListSrxFirewallsRawWithContext(ctx context.Context, v url.Values) (*ListSrxFirewallsResponse, error)
+	ListSrxFirewallsWhere(p *ListSrxFirewallsParams, pred func(*SrxFirewall) bool) ([]*SrxFirewall, error)
+	ListSrxFirewallsWithContext(ctx context.Context, p *ListSrxFirewallsParams) (*ListSrxFirewallsResponse, error)
+	NewAddPaloAltoFirewallParams(networkdevicetype string, password string, physicalnetworkid string, url string, username string, opts ...func(*AddPaloAltoFirewallParams)) *AddPaloAltoFirewallParams
+	NewAddPaloAltoFirewallParamsFromOpts(o AddPaloAltoFirewallOpts) *AddPaloAltoFirewallParams
+	NewAddSrxFirewallParams(networkdevicetype string, password string, physicalnetworkid string, url string, username string, opts ...func(*AddSrxFirewallParams)) *AddSrxFirewallParams
+	NewAddSrxFirewallParamsFromOpts(o AddSrxFirewallOpts) *AddSrxFirewallParams
+	NewConfigurePaloAltoFirewallParams(fwdeviceid string, opts ...func(*ConfigurePaloAltoFirewallParams)) *ConfigurePaloAltoFirewallParams
+	NewConfigureSrxFirewallParams(fwdeviceid string, opts ...func(*ConfigureSrxFirewallParams)) *ConfigureSrxFirewallParams
+	NewCreateEgressFirewallRuleParams(networkid string, protocol string, opts ...func(*CreateEgressFirewallRuleParams)) *CreateEgressFirewallRuleParams
+	NewCreateFirewallRuleParams(ipaddressid string, protocol string, opts ...func(*CreateFirewallRuleParams)) *CreateFirewallRuleParams
+	NewCreatePortForwardingRuleParams(ipaddressid string, privateport int, protocol string, publicport int, virtualmachineid string, opts ...func(*CreatePortForwardingRuleParams)) *CreatePortForwardingRuleParams
+	NewCreatePortForwardingRuleParamsFromOpts(o CreatePortForwardingRuleOpts) *CreatePortForwardingRuleParams
+	NewDeleteEgressFirewallRuleParams(id string, opts ...func(*DeleteEgressFirewallRuleParams)) *DeleteEgressFirewallRuleParams
+	NewDeleteFirewallRuleParams(id string, opts ...func(*DeleteFirewallRuleParams)) *DeleteFirewallRuleParams
+	NewDeletePaloAltoFirewallParams(fwdeviceid string, opts ...func(*DeletePaloAltoFirewallParams)) *DeletePaloAltoFirewallParams
+	NewDeletePortForwardingRuleParams(id string, opts ...func(*DeletePortForwardingRuleParams)) *DeletePortForwardingRuleParams
+	NewDeleteSrxFirewallParams(fwdeviceid string, opts ...func(*DeleteSrxFirewallParams)) *DeleteSrxFirewallParams
+	NewListEgressFirewallRulesParams(opts ...func(*ListEgressFirewallRulesParams)) *ListEgressFirewallRulesParams
+	NewListFirewallRulesParams(opts ...func(*ListFirewallRulesParams)) *ListFirewallRulesParams
+	NewListPaloAltoFirewallsParams(opts ...func(*ListPaloAltoFirewallsParams)) *ListPaloAltoFirewallsParams
+	NewListPortForwardingRulesParams(opts ...func(*ListPortForwardingRulesParams)) *ListPortForwardingRulesParams
+	NewListSrxFirewallsParams(opts ...func(*ListSrxFirewallsParams)) *ListSrxFirewallsParams
+	NewUpdateEgressFirewallRuleParams(id string, opts ...func(*UpdateEgressFirewallRuleParams)) *UpdateEgressFirewallRuleParams
+	NewUpdateFirewallRuleParams(id string, opts ...func(*UpdateFirewallRuleParams)) *UpdateFirewallRuleParams
+	NewUpdatePortForwardingRuleParams(id string, opts ...func(*UpdatePortForwardingRuleParams)) *UpdatePortForwardingRuleParams
+	PortForwardingRuleExists(id string, opts ...OptionFunc) (bool, error)
+	StreamEgressFirewallRules(ctx context.Context, p *ListEgressFirewallRulesParams) (<-chan *EgressFirewallRule, <-chan error)
+	StreamFirewallRules(ctx context.Context, p *ListFirewallRulesParams) (<-chan *FirewallRule, <-chan error)
+	StreamPaloAltoFirewalls(ctx context.Context, p *ListPaloAltoFirewallsParams) (<-chan *PaloAltoFirewall, <-chan error)
+	StreamPortForwardingRules(ctx context.Context, p *ListPortForwardingRulesParams) (<-chan *PortForwardingRule, <-chan error)
+	StreamSrxFirewalls(ctx context.Context, p *ListSrxFirewallsParams) (<-chan *SrxFirewall, <-chan error)
+	UpdateEgressFirewallRule(p *UpdateEgressFirewallRuleParams, opts ...OptionFunc) (*UpdateEgressFirewallRuleResponse, error)
+	UpdateEgressFirewallRuleRaw(v url.Values) (*UpdateEgressFirewallRuleResponse, error)
+	UpdateEgressFirewallRuleRawWithContext(ctx context.Context, v url.Values) (*UpdateEgressFirewallRuleResponse, error)
+	UpdateEgressFirewallRuleWithContext(ctx context.Context, p *UpdateEgressFirewallRuleParams, opts ...OptionFunc) (*UpdateEgressFirewallRuleResponse, error)
+	UpdateFirewallRule(p *UpdateFirewallRuleParams, opts ...OptionFunc) (*UpdateFirewallRuleResponse, error)
+	UpdateFirewallRuleRaw(v url.Values) (*UpdateFirewallRuleResponse, error)
+	UpdateFirewallRuleRawWithContext(ctx context.Context, v url.Values) (*UpdateFirewallRuleResponse, error)
+	UpdateFirewallRuleWithContext(ctx context.Context, p *UpdateFirewallRuleParams, opts ...OptionFunc) (*UpdateFirewallRuleResponse, error)
+	UpdatePortForwardingRule(p *UpdatePortForwardingRuleParams, opts ...OptionFunc) (*UpdatePortForwardingRuleResponse, error)
+	UpdatePortForwardingRuleRaw(v url.Values) (*UpdatePortForwardingRuleResponse, error)
+	UpdatePortForwardingRuleRawWithContext(ctx context.Context, v url.Values) (*UpdatePortForwardingRuleResponse, error)
+	UpdatePortForwardingRuleWithContext(ctx context.Context, p *UpdatePortForwardingRuleParams, opts ...OptionFunc) (*UpdatePortForwardingRuleResponse, error)
+	WaitForEgressFirewallRuleDeleted(ctx context.Context, id string, timeout time.Duration, opts ...OptionFunc) error
+	WaitForFirewallRuleDeleted(ctx context.Context, id string, timeout time.Duration, opts ...OptionFunc) error
+	WaitForPortForwardingRuleDeleted(ctx context.Context, id string, timeout time.Duration, opts ...OptionFunc) error
+}
+
+var _ FirewallServiceIface = (*FirewallService)(nil)
+
+// GuestOSServiceIface contains all exported methods of GuestOSService, so callers can depend on it to mock the service
+type GuestOSServiceIface interface {
+	AddGuestOs(p *AddGuestOsParams, opts ...OptionFunc) (*AddGuestOsResponse, error)
+	AddGuestOsMapping(p *AddGuestOsMappingParams, opts ...OptionFunc) (*AddGuestOsMappingResponse, error)
+	AddGuestOsMappingRaw(v url.Values) (*AddGuestOsMappingResponse, error)
+	AddGuestOsMappingRawWithContext(ctx context.Context, v url.Values) (*AddGuestOsMappingResponse, error)
+	AddGuestOsMappingWithContext(ctx context.Context, p *AddGuestOsMappingParams, opts ...OptionFunc) (*AddGuestOsMappingResponse, error)
+	AddGuestOsRaw(v url.Values) (*AddGuestOsResponse, error)
+	AddGuestOsRawWithContext(ctx context.Context, v url.Values) (*AddGuestOsResponse, error)
+	AddGuestOsWithContext(ctx context.Context, p *AddGuestOsParams, opts ...OptionFunc) (*AddGuestOsResponse, error)
+	GetGuestOsMappingByID(id string, opts ...OptionFunc) (*GuestOsMapping, int, error)
+	GetOsCategoryByID(id string, opts ...OptionFunc) (*OsCategory, int, error)
+	GetOsCategoryByName(name string, opts ...OptionFunc) (*OsCategory, int, error)
+	GetOsCategoryID(name string, opts ...OptionFunc) (string, int, error)
+	GetOsTypeByID(id string, opts ...OptionFunc) (*OsType, int, error)
+	GuestOsMappingExists(id string, opts ...OptionFunc) (bool, error)
+	ListAllGuestOsMapping(p *ListGuestOsMappingParams) ([]*GuestOsMapping, error)
+	ListAllOsCategories(p *ListOsCategoriesParams) ([]*OsCategory, error)
+	ListAllOsTypes(p *ListOsTypesParams) ([]*OsType, error)
+	ListGuestOsMapping(p *ListGuestOsMappingParams) (*ListGuestOsMappingResponse, error)
+	ListGuestOsMappingRaw(v url.Values) (*ListGuestOsMappingResponse, error)
+	ListGuestOsMappingRawWithContext(ctx context.Context, v url.Values) (*ListGuestOsMappingResponse, error)
+	ListGuestOsMappingWhere(p *ListGuestOsMappingParams, pred func(*GuestOsMapping) bool) ([]*GuestOsMapping, error)
+	ListGuestOsMappingWithContext(ctx context.Context, p *ListGuestOsMappingParams) (*ListGuestOsMappingResponse, error)
+	ListOsCategories(p *ListOsCategoriesParams) (*ListOsCategoriesResponse, error)
+	ListOsCategoriesRaw(v url.Values) (*ListOsCategoriesResponse, error)
+	ListOsCategoriesRawWithContext(ctx context.Context, v url.Values) (*ListOsCategoriesResponse, error)
+	ListOsCategoriesWhere(p *ListOsCategoriesParams, pred func(*OsCategory) bool) ([]*OsCategory, error)
+	ListOsCategoriesWithContext(ctx context.Context, p *ListOsCategoriesParams) (*ListOsCategoriesResponse, error)
+	ListOsTypes(p *ListOsTypesParams) (*ListOsTypesResponse, error)
+	ListOsTypesRaw(v url.Values) (*ListOsTypesResponse, error)
+	ListOsTypesRawWithContext(ctx context.Context, v url.Values) (*ListOsTypesResponse, error)
+	ListOsTypesWhere(p *ListOsTypesParams, pred func(*OsType) bool) ([]*OsType, error)
+	ListOsTypesWithContext(ctx context.Context, p *ListOsTypesParams) (*ListOsTypesResponse, error)
+	NewAddGuestOsMappingParams(hypervisor string, hypervisorversion string, osnameforhypervisor string, opts ...func(*AddGuestOsMappingParams)) *AddGuestOsMappingParams
+	NewAddGuestOsMappingParamsFromOpts(o AddGuestOsMappingOpts) *AddGuestOsMappingParams
+	NewAddGuestOsParams(oscategoryid string, osdisplayname string, opts ...func(*AddGuestOsParams)) *AddGuestOsParams
+	NewListGuestOsMappingParams(opts ...func(*ListGuestOsMappingParams)) *ListGuestOsMappingParams
+	NewListOsCategoriesParams(opts ...func(*ListOsCategoriesParams)) *ListOsCategoriesParams
+	NewListOsTypesParams(opts ...func(*ListOsTypesParams)) *ListOsTypesParams
+	NewRemoveGuestOsMappingParams(id string, opts ...func(*RemoveGuestOsMappingParams)) *RemoveGuestOsMappingParams
+	NewRemoveGuestOsParams(id string, opts ...func(*RemoveGuestOsParams)) *RemoveGuestOsParams
+	NewUpdateGuestOsMappingParams(id string, osnameforhypervisor string, opts ...func(*UpdateGuestOsMappingParams)) *UpdateGuestOsMappingParams
+	NewUpdateGuestOsParams(id string, osdisplayname string, opts ...func(*UpdateGuestOsParams)) *UpdateGuestOsParams
+	OSTypeID(description string) (string, error)
+	OSTypeName(id string) (string, error)
+	OsCategoryExists(id string, opts ...OptionFunc) (bool, error)
+	OsTypeExists(id string, opts ...OptionFunc) (bool, error)
+	RefreshOSTypes() error
+	RemoveGuestOs(p *RemoveGuestOsParams, opts ...OptionFunc) (*RemoveGuestOsResponse, error)
+	RemoveGuestOsMapping(p *RemoveGuestOsMappingParams, opts ...OptionFunc) (*RemoveGuestOsMappingResponse, error)
+	RemoveGuestOsMappingRaw(v url.Values) (*RemoveGuestOsMappingResponse, error)
+	RemoveGuestOsMappingRawWithContext(ctx context.Context, v url.Values) (*RemoveGuestOsMappingResponse, error)
+	RemoveGuestOsMappingWithContext(ctx context.Context, p *RemoveGuestOsMappingParams, opts ...OptionFunc) (*RemoveGuestOsMappingResponse, error)
+	RemoveGuestOsRaw(v url.Values) (*RemoveGuestOsResponse, error)
+	RemoveGuestOsRawWithContext(ctx context.Context, v url.Values) (*RemoveGuestOsResponse, error)
+	RemoveGuestOsWithContext(ctx context.Context, p *RemoveGuestOsParams, opts ...OptionFunc) (*RemoveGuestOsResponse, error)
+	StreamGuestOsMapping(ctx context.Context, p *ListGuestOsMappingParams) (<-chan *GuestOsMapping, <-chan error)
+	StreamOsCategories(ctx context.Context, p *ListOsCategoriesParams) (<-chan *OsCategory, <-chan error)
+	StreamOsTypes(ctx context.Context, p *ListOsTypesParams) (<-chan *OsType, <-chan error)
+	UpdateGuestOs(p *UpdateGuestOsParams, opts ...OptionFunc) (*UpdateGuestOsResponse, error)
+	UpdateGuestOsMapping(p *UpdateGuestOsMappingParams, opts ...OptionFunc) (*UpdateGuestOsMappingResponse, error)
+	UpdateGuestOsMappingRaw(v url.Values) (*UpdateGuestOsMappingResponse, error)
+	UpdateGuestOsMappingRawWithContext(ctx context.Context, v url.Values) (*UpdateGuestOsMappingResponse, error)
+	UpdateGuestOsMappingWithContext(ctx context.Context, p *UpdateGuestOsMappingParams, opts ...OptionFunc) (*UpdateGuestOsMappingResponse, error)
+	UpdateGuestOsRaw(v url.Values) (*UpdateGuestOsResponse, error)
+	UpdateGuestOsRawWithContext(ctx context.Context, v url.Values) (*UpdateGuestOsResponse, error)
+	UpdateGuestOsWithContext(ctx context.Context, p *UpdateGuestOsParams, opts ...OptionFunc) (*UpdateGuestOsResponse, error)
+}
+
+var _ GuestOSServiceIface = (*GuestOSService)(nil)
+
+// HostServiceIface contains all exported methods of HostService, so callers can depend on it to mock the service
+type HostServiceIface interface {
+	AddBaremetalHost(p *AddBaremetalHostParams, opts ...OptionFunc) (*AddBaremetalHostResponse, error)
+	AddBaremetalHostRaw(v url.Values) (*AddBaremetalHostResponse, error)
+	AddBaremetalHostRawWithContext(ctx context.Context, v url.Values) (*AddBaremetalHostResponse, error)
+	AddBaremetalHostWithContext(ctx context.Context, p *AddBaremetalHostParams, opts ...OptionFunc) (*AddBaremetalHostResponse, error)
+	AddGloboDnsHost(p *AddGloboDnsHostParams, opts ...OptionFunc) (*AddGloboDnsHostResponse, error)
+	AddGloboDnsHostRaw(v url.Values) (*AddGloboDnsHostResponse, error)
+	AddGloboDnsHostRawWithContext(ctx context.Context, v url.Values) (*AddGloboDnsHostResponse, error)
+	AddGloboDnsHostWithContext(ctx context.Context, p *AddGloboDnsHostParams, opts ...OptionFunc) (*AddGloboDnsHostResponse, error)
+	AddHost(p *AddHostParams, opts ...OptionFunc) (*AddHostResponse, error)
+	AddHostRaw(v url.Values) (*AddHostResponse, error)
+	AddHostRawWithContext(ctx context.Context, v url.Values) (*AddHostResponse, error)
+	AddHostWithContext(ctx context.Context, p *AddHostParams, opts ...OptionFunc) (*AddHostResponse, error)
+	AddSecondaryStorage(p *AddSecondaryStorageParams, opts ...OptionFunc) (*AddSecondaryStorageResponse, error)
+	AddSecondaryStorageRaw(v url.Values) (*AddSecondaryStorageResponse, error)
+	AddSecondaryStorageRawWithContext(ctx context.Context, v url.Values) (*AddSecondaryStorageResponse, error)
+	AddSecondaryStorageWithContext(ctx context.Context, p *AddSecondaryStorageParams, opts ...OptionFunc) (*AddSecondaryStorageResponse, error)
+	CancelHostMaintenance(p *CancelHostMaintenanceParams, opts ...OptionFunc) (*CancelHostMaintenanceResponse, error)
+	CancelHostMaintenanceRaw(v url.Values) (*CancelHostMaintenanceResponse, error)
+	CancelHostMaintenanceRawWithContext(ctx context.Context, v url.Values) (*CancelHostMaintenanceResponse, error)
+	CancelHostMaintenanceWithContext(ctx context.Context, p *CancelHostMaintenanceParams, opts ...OptionFunc) (*CancelHostMaintenanceResponse, error)
+	CancelMaintenanceAndWait(hostid string, timeout time.Duration, opts ...OptionFunc) (*Host, error)
+	DedicateHost(p *DedicateHostParams, opts ...OptionFunc) (*DedicateHostResponse, error)
+	DedicateHostRaw(v url.Values) (*DedicateHostResponse, error)
+	DedicateHostRawWithContext(ctx context.Context, v url.Values) (*DedicateHostResponse, error)
+	DedicateHostWithContext(ctx context.Context, p *DedicateHostParams, opts ...OptionFunc) (*DedicateHostResponse, error)
+	DeleteHost(p *DeleteHostParams, opts ...OptionFunc) (*DeleteHostResponse, error)
+	DeleteHostRaw(v url.Values) (*DeleteHostResponse, error)
+	DeleteHostRawWithContext(ctx context.Context, v url.Values) (*DeleteHostResponse, error)
+	DeleteHostWithContext(ctx context.Context, p *DeleteHostParams, opts ...OptionFunc) (*DeleteHostResponse, error)
+	DisableOutOfBandManagementForHost(p *DisableOutOfBandManagementForHostParams, opts ...OptionFunc) (*DisableOutOfBandManagementForHostResponse, error)
+	DisableOutOfBandManagementForHostRaw(v url.Values) (*DisableOutOfBandManagementForHostResponse, error)
+	DisableOutOfBandManagementForHostRawWithContext(ctx context.Context, v url.Values) (*DisableOutOfBandManagementForHostResponse, error)
+	DisableOutOfBandManagementForHostWithContext(ctx context.Context, p *DisableOutOfBandManagementForHostParams, opts ...OptionFunc) (*DisableOutOfBandManagementForHostResponse, error)
+	EnableOutOfBandManagementForHost(p *EnableOutOfBandManagementForHostParams, opts ...OptionFunc) (*EnableOutOfBandManagementForHostResponse, error)
+	EnableOutOfBandManagementForHostRaw(v url.Values) (*EnableOutOfBandManagementForHostResponse, error)
+	EnableOutOfBandManagementForHostRawWithContext(ctx context.Context, v url.Values) (*EnableOutOfBandManagementForHostResponse, error)
+	EnableOutOfBandManagementForHostWithContext(ctx context.Context, p *EnableOutOfBandManagementForHostParams, opts ...OptionFunc) (*EnableOutOfBandManagementForHostResponse, error)
+	EnterMaintenanceAndWait(hostid string, timeout time.Duration, opts ...OptionFunc) (*Host, error)
+	FindHostsForMigration(p *FindHostsForMigrationParams, opts ...OptionFunc) (*FindHostsForMigrationResponse, error)
+	FindHostsForMigrationRaw(v url.Values) (*FindHostsForMigrationResponse, error)
+	FindHostsForMigrationRawWithContext(ctx context.Context, v url.Values) (*FindHostsForMigrationResponse, error)
+	FindHostsForMigrationWithContext(ctx context.Context, p *FindHostsForMigrationParams, opts ...OptionFunc) (*FindHostsForMigrationResponse, error)
+	GetHostByID(id string, opts ...OptionFunc) (*Host, int, error)
+	GetHostByName(name string, opts ...OptionFunc) (*Host, int, error)
+	GetHostID(name string, opts ...OptionFunc) (string, int, error)
+	GetHostTagID(keyword string, opts ...OptionFunc) (string, int, error)
+	HostExists(id string, opts ...OptionFunc) (bool, error)
+	ListAllDedicatedHosts(p *ListDedicatedHostsParams) ([]*DedicatedHost, error)
+	ListAllHostTags(p *ListHostTagsParams) ([]*HostTag, error)
+	ListAllHosts(p *ListHostsParams) ([]*Host, error)
+	ListDedicatedHosts(p *ListDedicatedHostsParams) (*ListDedicatedHostsResponse, error)
+	ListDedicatedHostsRaw(v url.Values) (*ListDedicatedHostsResponse, error)
+	ListDedicatedHostsRawWithContext(ctx context.Context, v url.Values) (*ListDedicatedHostsResponse, error)
+	ListDedicatedHostsWhere(p *ListDedicatedHostsParams, pred func(*DedicatedHost) bool) ([]*DedicatedHost, error)
+	ListDedicatedHostsWithContext(ctx context.Context, p *ListDedicatedHostsParams) (*ListDedicatedHostsResponse, error)
+	ListHostTags(p *ListHostTagsParams) (*ListHostTagsResponse, error)
+	ListHostTagsRaw(v url.Values) (*ListHostTagsResponse, error)
+	ListHostTagsRawWithContext(ctx context.Context, v url.Values) (*ListHostTagsResponse, error)
+	ListHostTagsWhere(p *ListHostTagsParams, pred func(*HostTag) bool) ([]*HostTag, error)
+	ListHostTagsWithContext(ctx context.Context, p *ListHostTagsParams) (*ListHostTagsResponse, error)
+	ListHosts(p *ListHostsParams) (*ListHostsResponse, error)
+	ListHostsRaw(v url.Values) (*ListHostsResponse, error)
+	ListHostsRawWithContext(ctx context.Context, v url.Values) (*ListHostsResponse, error)
+	ListHostsWhere(p *ListHostsParams, pred func(*Host) bool) ([]*Host, error)
+	ListHostsWithContext(ctx context.Context, p *ListHostsParams) (*ListHostsResponse, error)
+	NewAddBaremetalHostParams(hypervisor string, password string, podid string, url string, username string, zoneid string, opts ...func(*AddBaremetalHostParams)) *AddBaremetalHostParams
+	NewAddBaremetalHostParamsFromOpts(o AddBaremetalHostOpts) *AddBaremetalHostParams
+	NewAddGloboDnsHostParams(password string, physicalnetworkid string, url string, username string, opts ...func(*AddGloboDnsHostParams)) *AddGloboDnsHostParams
+	NewAddGloboDnsHostParamsFromOpts(o AddGloboDnsHostOpts) *AddGloboDnsHostParams
+	NewAddHostParams(hypervisor string, password string, podid string, url string, username string, zoneid string, opts ...func(*AddHostParams)) *AddHostParams
+	NewAddHostParamsFromOpts(o AddHostOpts) *AddHostParams
+	NewAddSecondaryStorageParams(url string, opts ...func(*AddSecondaryStorageParams)) *AddSecondaryStorageParams
+	NewCancelHostMaintenanceParams(id string, opts ...func(*CancelHostMaintenanceParams)) *CancelHostMaintenanceParams
+	NewDedicateHostParams(domainid string, hostid string, opts ...func(*DedicateHostParams)) *DedicateHostParams
+	NewDeleteHostParams(id string, opts ...func(*DeleteHostParams)) *DeleteHostParams
+	NewDisableOutOfBandManagementForHostParams(hostid string, opts ...func(*DisableOutOfBandManagementForHostParams)) *DisableOutOfBandManagementForHostParams
+	NewEnableOutOfBandManagementForHostParams(hostid string, opts ...func(*EnableOutOfBandManagementForHostParams)) *EnableOutOfBandManagementForHostParams
+	NewFindHostsForMigrationParams(virtualmachineid string, opts ...func(*FindHostsForMigrationParams)) *FindHostsForMigrationParams
+	NewListDedicatedHostsParams(opts ...func(*ListDedicatedHostsParams)) *ListDedicatedHostsParams
+	NewListHostTagsParams(opts ...func(*ListHostTagsParams)) *ListHostTagsParams
+	NewListHostsParams(opts ...func(*ListHostsParams)) *ListHostsParams
+	NewPrepareHostForMaintenanceParams(id string, opts ...func(*PrepareHostForMaintenanceParams)) *PrepareHostForMaintenanceParams
+	NewReconnectHostParams(id string, opts ...func(*ReconnectHostParams)) *ReconnectHostParams
+	NewReleaseDedicatedHostParams(hostid string, opts ...func(*ReleaseDedicatedHostParams)) *ReleaseDedicatedHostParams
+	NewReleaseHostReservationParams(id string, opts ...func(*ReleaseHostReservationParams)) *ReleaseHostReservationParams
+	NewUpdateHostParams(id string, opts ...func(*UpdateHostParams)) *UpdateHostParams
+	NewUpdateHostPasswordParams(password string, username string, opts ...func(*UpdateHostPasswordParams)) *UpdateHostPasswordParams
+	PrepareHostForMaintenance(p *PrepareHostForMaintenanceParams, opts ...OptionFunc) (*PrepareHostForMaintenanceResponse, error)
+	PrepareHostForMaintenanceRaw(v url.Values) (*PrepareHostForMaintenanceResponse, error)
+	PrepareHostForMaintenanceRawWithContext(ctx context.Context, v url.Values) (*PrepareHostForMaintenanceResponse, error)
+	PrepareHostForMaintenanceWithContext(ctx context.Context, p *PrepareHostForMaintenanceParams, opts ...OptionFunc) (*PrepareHostForMaintenanceResponse, error)
+	ReconnectHost(p *ReconnectHostParams, opts ...OptionFunc) (*ReconnectHostResponse, error)
+	ReconnectHostRaw(v url.Values) (*ReconnectHostResponse, error)
+	ReconnectHostRawWithContext(ctx context.Context, v url.Values) (*ReconnectHostResponse, error)
+	ReconnectHostWithContext(ctx context.Context, p *ReconnectHostParams, opts ...OptionFunc) (*ReconnectHostResponse, error)
+	ReleaseDedicatedHost(p *ReleaseDedicatedHostParams, opts ...OptionFunc) (*ReleaseDedicatedHostResponse, error)
+	ReleaseDedicatedHostRaw(v url.Values) (*ReleaseDedicatedHostResponse, error)
+	ReleaseDedicatedHostRawWithContext(ctx context.Context, v url.Values) (*ReleaseDedicatedHostResponse, error)
+	ReleaseDedicatedHostWithContext(ctx context.Context, p *ReleaseDedicatedHostParams, opts ...OptionFunc) (*ReleaseDedicatedHostResponse, error)
+	ReleaseHostReservation(p *ReleaseHostReservationParams, opts ...OptionFunc) (*ReleaseHostReservationResponse, error)
+	ReleaseHostReservationRaw(v url.Values) (*ReleaseHostReservationResponse, error)
+	ReleaseHostReservationRawWithContext(ctx context.Context, v url.Values) (*ReleaseHostReservationResponse, error)
+	ReleaseHostReservationWithContext(ctx context.Context, p *ReleaseHostReservationParams, opts ...OptionFunc) (*ReleaseHostReservationResponse, error)
+	StreamDedicatedHosts(ctx context.Context, p *ListDedicatedHostsParams) (<-chan *DedicatedHost, <-chan error)
+	StreamHostTags(ctx context.Context, p *ListHostTagsParams) (<-chan *HostTag, <-chan error)
+	StreamHosts(ctx context.Context, p *ListHostsParams) (<-chan *Host, <-chan error)
+	UpdateHost(p *UpdateHostParams, opts ...OptionFunc) (*UpdateHostResponse, error)
+	UpdateHostPassword(p *UpdateHostPasswordParams, opts ...OptionFunc) (*UpdateHostPasswordResponse, error)
+	UpdateHostPasswordRaw(v url.Values) (*UpdateHostPasswordResponse, error)
+	UpdateHostPasswordRawWithContext(ctx context.Context, v url.Values) (*UpdateHostPasswordResponse, error)
+	UpdateHostPasswordWithContext(ctx context.Context, p *UpdateHostPasswordParams, opts ...OptionFunc) (*UpdateHostPasswordResponse, error)
+	UpdateHostRaw(v url.Values) (*UpdateHostResponse, error)
+	UpdateHostRawWithContext(ctx context.Context, v url.Values) (*UpdateHostResponse, error)
+	UpdateHostWithContext(ctx context.Context, p *UpdateHostParams, opts ...OptionFunc) (*UpdateHostResponse, error)
+	UpdateMany(ids []string, mutate func(*UpdateHostParams), concurrency int, opts ...OptionFunc) (BulkResult[*UpdateHostResponse], error)
+	WaitForHostDeleted(ctx context.Context, id string, timeout time.Duration, opts ...OptionFunc) error
+}
+
+var _ HostServiceIface = (*HostService)(nil)
+
+// HypervisorServiceIface contains all exported methods of HypervisorService, so callers can depend on it to mock the service
+type HypervisorServiceIface interface {
+	GetHypervisorCapabilityByID(id string, opts ...OptionFunc) (*HypervisorCapability, int, error)
+	HypervisorCapabilityExists(id string, opts ...OptionFunc) (bool, error)
+	ListAllHypervisorCapabilities(p *ListHypervisorCapabilitiesParams) ([]*HypervisorCapability, error)
+	ListHypervisorCapabilities(p *ListHypervisorCapabilitiesParams) (*ListHypervisorCapabilitiesResponse, error)
+	ListHypervisorCapabilitiesRaw(v url.Values) (*ListHypervisorCapabilitiesResponse, error)
+	ListHypervisorCapabilitiesRawWithContext(ctx context.Context, v url.Values) (*ListHypervisorCapabilitiesResponse, error)
+	ListHypervisorCapabilitiesWhere(p *ListHypervisorCapabilitiesParams, pred func(*HypervisorCapability) bool) ([]*HypervisorCapability, error)
+	ListHypervisorCapabilitiesWithContext(ctx context.Context, p *ListHypervisorCapabilitiesParams) (*ListHypervisorCapabilitiesResponse, error)
+	ListHypervisors(p *ListHypervisorsParams) (*ListHypervisorsResponse, error)
+	ListHypervisorsRaw(v url.Values) (*ListHypervisorsResponse, error)
+	ListHypervisorsRawWithContext(ctx context.Context, v url.Values) (*ListHypervisorsResponse, error)
+	ListHypervisorsWhere(p *ListHypervisorsParams, pred func(*Hypervisor) bool) ([]*Hypervisor, error)
+	ListHypervisorsWithContext(ctx context.Context, p *ListHypervisorsParams) (*ListHypervisorsResponse, error)
+	NewListHypervisorCapabilitiesParams(opts ...func(*ListHypervisorCapabilitiesParams)) *ListHypervisorCapabilitiesParams
+	NewListHypervisorsParams(opts ...func(*ListHypervisorsParams)) *ListHypervisorsParams
+	NewUpdateHypervisorCapabilitiesParams(opts ...func(*UpdateHypervisorCapabilitiesParams)) *UpdateHypervisorCapabilitiesParams
+	StreamHypervisorCapabilities(ctx context.Context, p *ListHypervisorCapabilitiesParams) (<-chan *HypervisorCapability, <-chan error)
+	UpdateHypervisorCapabilities(p *UpdateHypervisorCapabilitiesParams, opts ...OptionFunc) (*UpdateHypervisorCapabilitiesResponse, error)
+	UpdateHypervisorCapabilitiesRaw(v url.Values) (*UpdateHypervisorCapabilitiesResponse, error)
+	UpdateHypervisorCapabilitiesRawWithContext(ctx context.Context, v url.Values) (*UpdateHypervisorCapabilitiesResponse, error)
+	UpdateHypervisorCapabilitiesWithContext(ctx context.Context, p *UpdateHypervisorCapabilitiesParams, opts ...OptionFunc) (*UpdateHypervisorCapabilitiesResponse, error)
+}
+
+var _ HypervisorServiceIface = (*HypervisorService)(nil)
+
+// ISOServiceIface contains all exported methods of ISOService, so callers can depend on it to mock the service
+type ISOServiceIface interface {
+	AttachIso(p *AttachIsoParams, opts ...OptionFunc) (*AttachIsoResponse, error)
+	AttachIsoRaw(v url.Values) (*AttachIsoResponse, error)
+	AttachIsoRawWithContext(ctx context.Context, v url.Values) (*AttachIsoResponse, error)
+	AttachIsoWithContext(ctx context.Context, p *AttachIsoParams, opts ...OptionFunc) (*AttachIsoResponse, error)
+	CopyIso(p *CopyIsoParams, opts ...OptionFunc) (*CopyIsoResponse, error)
+	CopyIsoRaw(v url.Values) (*CopyIsoResponse, error)
+	CopyIsoRawWithContext(ctx context.Context, v url.Values) (*CopyIsoResponse, error)
+	CopyIsoWithContext(ctx context.Context, p *CopyIsoParams, opts ...OptionFunc) (*CopyIsoResponse, error)
+	DeleteIso(p *DeleteIsoParams, opts ...OptionFunc) (*DeleteIsoResponse, error)
+	DeleteIsoRaw(v url.Values) (*DeleteIsoResponse, error)
+	DeleteIsoRawWithContext(ctx context.Context, v url.Values) (*DeleteIsoResponse, error)
+	DeleteIsoWithContext(ctx context.Context, p *DeleteIsoParams, opts ...OptionFunc) (*DeleteIsoResponse, error)
+	DetachIso(p *DetachIsoParams, opts ...OptionFunc) (*DetachIsoResponse, error)
+	DetachIsoRaw(v url.Values) (*DetachIsoResponse, error)
+	DetachIsoRawWithContext(ctx context.Context, v url.Values) (*DetachIsoResponse, error)
+	DetachIsoWithContext(ctx context.Context, p *DetachIsoParams, opts ...OptionFunc) (*DetachIsoResponse, error)
+	ExtractIso(p *ExtractIsoParams, opts ...OptionFunc) (*ExtractIsoResponse, error)
+	ExtractIsoRaw(v url.Values) (*ExtractIsoResponse, error)
+	ExtractIsoRawWithContext(ctx context.Context, v url.Values) (*ExtractIsoResponse, error)
+	ExtractIsoWithContext(ctx context.Context, p *ExtractIsoParams, opts ...OptionFunc) (*ExtractIsoResponse, error)
+	GetIsoByID(id string, opts ...OptionFunc) (*Iso, int, error)
+	GetIsoByName(name string, isofilter string, zoneid string, opts ...OptionFunc) (*Iso, int, error)
+	GetIsoID(name string, isofilter string, zoneid string, opts ...OptionFunc) (string, int, error)
+	GetIsoPermissionByID(id string, opts ...OptionFunc) (*IsoPermission, int, error)
+	IsoExists(id string, opts ...OptionFunc) (bool, error)
+	IsoPermissionExists(id string, opts ...OptionFunc) (bool, error)
+	ListAllIsos(p *ListIsosParams) ([]*Iso, error)
+	ListIsoPermissions(p *ListIsoPermissionsParams) (*ListIsoPermissionsResponse, error)
+	ListIsoPermissionsRaw(v url.Values) (*ListIsoPermissionsResponse, error)
+	ListIsoPermissionsRawWithContext(ctx context.Context, v url.Values) (*ListIsoPermissionsResponse, error)
+	ListIsoPermissionsWhere(p *ListIsoPermissionsParams, pred func(*IsoPermission) bool) ([]*IsoPermission, error)
+	ListIsoPermissionsWithContext(ctx context.Context, p *ListIsoPermissionsParams) (*ListIsoPermissionsResponse, error)
+	ListIsos(p *ListIsosParams) (*ListIsosResponse, error)
+	ListIsosRaw(v url.Values) (*ListIsosResponse, error)
+	ListIsosRawWithContext(ctx context.Context, v url.Values) (*ListIsosResponse, error)
+	ListIsosWhere(p *ListIsosParams, pred func(*Iso) bool) ([]*Iso, error)
+	ListIsosWithContext(ctx context.Context, p *ListIsosParams) (*ListIsosResponse, error)
+	NewAttachIsoParams(id string, virtualmachineid string, opts ...func(*AttachIsoParams)) *AttachIsoParams
+	NewCopyIsoParams(destzoneid string, id string, opts ...func(*CopyIsoParams)) *CopyIsoParams
+	NewDeleteIsoParams(id string, opts ...func(*DeleteIsoParams)) *DeleteIsoParams
+	NewDetachIsoParams(virtualmachineid string, opts ...func(*DetachIsoParams)) *DetachIsoParams
+	NewExtractIsoParams(id string, mode string, opts ...func(*ExtractIsoParams)) *ExtractIsoParams
+	NewListIsoPermissionsParams(id string, opts ...func(*ListIsoPermissionsParams)) *ListIsoPermissionsParams
+	NewListIsosParams(opts ...func(*ListIsosParams)) *ListIsosParams
+	NewRegisterIsoParams(displaytext string, name string, url string, zoneid string, opts ...func(*RegisterIsoParams)) *RegisterIsoParams
+	NewRegisterIsoParamsFromOpts(o RegisterIsoOpts) *RegisterIsoParams
+	NewUpdateIsoParams(id string, opts ...func(*UpdateIsoParams)) *UpdateIsoParams
+	NewUpdateIsoPermissionsParams(id string, opts ...func(*UpdateIsoPermissionsParams)) *UpdateIsoPermissionsParams
+	RegisterIso(p *RegisterIsoParams, opts ...OptionFunc) (*RegisterIsoResponse, error)
+	RegisterIsoRaw(v url.Values) (*RegisterIsoResponse, error)
+	RegisterIsoRawWithContext(ctx context.Context, v url.Values) (*RegisterIsoResponse, error)
+	RegisterIsoWithContext(ctx context.Context, p *RegisterIsoParams, opts ...OptionFunc) (*RegisterIsoResponse, error)
+	StreamIsos(ctx context.Context, p *ListIsosParams) (<-chan *Iso, <-chan error)
+	UpdateIso(p *UpdateIsoParams, opts ...OptionFunc) (*UpdateIsoResponse, error)
+	UpdateIsoPermissions(p *UpdateIsoPermissionsParams, opts ...OptionFunc) (*UpdateIsoPermissionsResponse, error)
+	UpdateIsoPermissionsRaw(v url.Values) (*UpdateIsoPermissionsResponse, error)
+	UpdateIsoPermissionsRawWithContext(ctx context.Context, v url.Values) (*UpdateIsoPermissionsResponse, error)
+	UpdateIsoPermissionsWithContext(ctx context.Context, p *UpdateIsoPermissionsParams, opts ...OptionFunc) (*UpdateIsoPermissionsResponse, error)
+	UpdateIsoRaw(v url.Values) (*UpdateIsoResponse, error)
+	UpdateIsoRawWithContext(ctx context.Context, v url.Values) (*UpdateIsoResponse, error)
+	UpdateIsoWithContext(ctx context.Context, p *UpdateIsoParams, opts ...OptionFunc) (*UpdateIsoResponse, error)
+	UpdateMany(ids []string, mutate func(*UpdateIsoParams), concurrency int, opts ...OptionFunc) (BulkResult[*UpdateIsoResponse], error)
+	WaitForIsoDeleted(ctx context.Context, id string, timeout time.Duration, opts ...OptionFunc) error
+}
+
+var _ ISOServiceIface = (*ISOService)(nil)
+
+// ImageStoreServiceIface contains all exported methods of ImageStoreService, so callers can depend on it to mock the service
+type ImageStoreServiceIface interface {
+	AddImageStore(p *AddImageStoreParams, opts ...OptionFunc) (*AddImageStoreResponse, error)
+	AddImageStoreRaw(v url.Values) (*AddImageStoreResponse, error)
+	AddImageStoreRawWithContext(ctx context.Context, v url.Values) (*AddImageStoreResponse, error)
+	AddImageStoreS3(p *AddImageStoreS3Params, opts ...OptionFunc) (*AddImageStoreS3Response, error)
+	AddImageStoreS3Raw(v url.Values) (*AddImageStoreS3Response, error)
+	AddImageStoreS3RawWithContext(ctx context.Context, v url.Values) (*AddImageStoreS3Response, error)
+	AddImageStoreS3WithContext(ctx context.Context, p *AddImageStoreS3Params, opts ...OptionFunc) (*AddImageStoreS3Response, error)
+	AddImageStoreWithContext(ctx context.Context, p *AddImageStoreParams, opts ...OptionFunc) (*AddImageStoreResponse, error)
+	CreateSecondaryStagingStore(p *CreateSecondaryStagingStoreParams, opts ...OptionFunc) (*CreateSecondaryStagingStoreResponse, error)
+	CreateSecondaryStagingStoreRaw(v url.Values) (*CreateSecondaryStagingStoreResponse, error)
+	CreateSecondaryStagingStoreRawWithContext(ctx context.Context, v url.Values) (*CreateSecondaryStagingStoreResponse, error)
+	CreateSecondaryStagingStoreWithContext(ctx context.Context, p *CreateSecondaryStagingStoreParams, opts ...OptionFunc) (*CreateSecondaryStagingStoreResponse, error)
+	DeleteImageStore(p *DeleteImageStoreParams, opts ...OptionFunc) (*DeleteImageStoreResponse, error)
+	DeleteImageStoreRaw(v url.Values) (*DeleteImageStoreResponse, error)
+	DeleteImageStoreRawWithContext(ctx context.Context, v url.Values) (*DeleteImageStoreResponse, error)
+	DeleteImageStoreWithContext(ctx context.Context, p *DeleteImageStoreParams, opts ...OptionFunc) (*DeleteImageStoreResponse, error)
+	DeleteSecondaryStagingStore(p *DeleteSecondaryStagingStoreParams, opts ...OptionFunc) (*DeleteSecondaryStagingStoreResponse, error)
+	DeleteSecondaryStagingStoreRaw(v url.Values) (*DeleteSecondaryStagingStoreResponse, error)
+	DeleteSecondaryStagingStoreRawWithContext(ctx context.Context, v url.Values) (*DeleteSecondaryStagingStoreResponse, error)
+	DeleteSecondaryStagingStoreWithContext(ctx context.Context, p *DeleteSecondaryStagingStoreParams, opts ...OptionFunc) (*DeleteSecondaryStagingStoreResponse, error)
+	GetImageStoreByID(id string, opts ...OptionFunc) (*ImageStore, int, error)
+	GetImageStoreByName(name string, opts ...OptionFunc) (*ImageStore, int, error)
+	GetImageStoreID(name string, opts ...OptionFunc) (string, int, error)
+	GetSecondaryStagingStoreByID(id string, opts ...OptionFunc) (*SecondaryStagingStore, int, error)
+	GetSecondaryStagingStoreByName(name string, opts ...OptionFunc) (*SecondaryStagingStore, int, error)
+	GetSecondaryStagingStoreID(name string, opts ...OptionFunc) (string, int, error)
+	ImageStoreExists(id string, opts ...OptionFunc) (bool, error)
+	ListAllImageStores(p *ListImageStoresParams) ([]*ImageStore, error)
+	ListAllSecondaryStagingStores(p *ListSecondaryStagingStoresParams) ([]*SecondaryStagingStore, error)
+	ListImageStores(p *ListImageStoresParams) (*ListImageStoresResponse, error)
+	ListImageStoresRaw(v url.Values) (*ListImageStoresResponse, error)
+	ListImageStoresRawWithContext(ctx context.Context, v url.Values) (*ListImageStoresResponse, error)
+	ListImageStoresWhere(p *ListImageStoresParams, pred func(*ImageStore) bool) ([]*ImageStore, error)
+	ListImageStoresWithContext(ctx context.Context, p *ListImageStoresParams) (*ListImageStoresResponse, error)
+	ListSecondaryStagingStores(p *ListSecondaryStagingStoresParams) (*ListSecondaryStagingStoresResponse, error)
+	ListSecondaryStagingStoresRaw(v url.Values) (*ListSecondaryStagingStoresResponse, error)
+	ListSecondaryStagingStoresRawWithContext(ctx context.Context, v url.Values) (*ListSecondaryStagingStoresResponse, error)
+	ListSecondaryStagingStoresWhere(p *ListSecondaryStagingStoresParams, pred func(*SecondaryStagingStore) bool) ([]*SecondaryStagingStore, error)
+	ListSecondaryStagingStoresWithContext(ctx context.Context, p *ListSecondaryStagingStoresParams) (*ListSecondaryStagingStoresResponse, error)
+	NewAddImageStoreParams(provider string, opts ...func(*AddImageStoreParams)) *AddImageStoreParams
+	NewAddImageStoreS3Params(accesskey string, bucket string, endpoint string, secretkey string, opts ...func(*AddImageStoreS3Params)) *AddImageStoreS3Params
+	NewAddImageStoreS3ParamsFromOpts(o AddImageStoreS3Opts) *AddImageStoreS3Params
+	NewCreateSecondaryStagingStoreParams(url string, opts ...func(*CreateSecondaryStagingStoreParams)) *CreateSecondaryStagingStoreParams
+	NewDeleteImageStoreParams(id string, opts ...func(*DeleteImageStoreParams)) *DeleteImageStoreParams
+	NewDeleteSecondaryStagingStoreParams(id string, opts ...func(*DeleteSecondaryStagingStoreParams)) *DeleteSecondaryStagingStoreParams
+	NewListImageStoresParams(opts ...func(*ListImageStoresParams)) *ListImageStoresParams
+	NewListSecondaryStagingStoresParams(opts ...func(*ListSecondaryStagingStoresParams)) *ListSecondaryStagingStoresParams
+	NewUpdateCloudToUseObjectStoreParams(provider string, opts ...func(*UpdateCloudToUseObjectStoreParams)) *UpdateCloudToUseObjectStoreParams
+	SecondaryStagingStoreExists(id string, opts ...OptionFunc) (bool, error)
+	StreamImageStores(ctx context.Context, p *ListImageStoresParams) (<-chan *ImageStore, <-chan error)
+	StreamSecondaryStagingStores(ctx context.Context, p *ListSecondaryStagingStoresParams) (<-chan *SecondaryStagingStore, <-chan error)
+	UpdateCloudToUseObjectStore(p *UpdateCloudToUseObjectStoreParams, opts ...OptionFunc) (*UpdateCloudToUseObjectStoreResponse, error)
+	UpdateCloudToUseObjectStoreRaw(v url.Values) (*UpdateCloudToUseObjectStoreResponse, error)
+	UpdateCloudToUseObjectStoreRawWithContext(ctx context.Context, v url.Values) (*UpdateCloudToUseObjectStoreResponse, error)
+	UpdateCloudToUseObjectStoreWithContext(ctx context.Context, p *UpdateCloudToUseObjectStoreParams, opts ...OptionFunc) (*UpdateCloudToUseObjectStoreResponse, error)
+	WaitForImageStoreDeleted(ctx context.Context, id string, timeout time.Duration, opts ...OptionFunc) error
+	WaitForSecondaryStagingStoreDeleted(ctx context.Context, id string, timeout time.Duration, opts ...OptionFunc) error
+}
+
+var _ ImageStoreServiceIface = (*ImageStoreService)(nil)
+
+// InternalLBServiceIface contains all exported methods of InternalLBService, so callers can depend on it to mock the service
+type InternalLBServiceIface interface {
+	ConfigureInternalLoadBalancerElement(p *ConfigureInternalLoadBalancerElementParams, opts ...OptionFunc) (*InternalLoadBalancerElementResponse, error)
+	ConfigureInternalLoadBalancerElementRaw(v url.Values) (*InternalLoadBalancerElementResponse, error)
+	ConfigureInternalLoadBalancerElementRawWithContext(ctx context.Context, v url.Values) (*InternalLoadBalancerElementResponse, error)
+	ConfigureInternalLoadBalancerElementWithContext(ctx context.Context, p *ConfigureInternalLoadBalancerElementParams, opts ...OptionFunc) (*InternalLoadBalancerElementResponse, error)
+	CreateInternalLoadBalancerElement(p *CreateInternalLoadBalancerElementParams, opts ...OptionFunc) (*CreateInternalLoadBalancerElementResponse, error)
+	CreateInternalLoadBalancerElementRaw(v url.Values) (*CreateInternalLoadBalancerElementResponse, error)
+	CreateInternalLoadBalancerElementRawWithContext(ctx context.Context, v url.Values) (*CreateInternalLoadBalancerElementResponse, error)
+	CreateInternalLoadBalancerElementWithContext(ctx context.Context, p *CreateInternalLoadBalancerElementParams, opts ...OptionFunc) (*CreateInternalLoadBalancerElementResponse, error)
+	GetInternalLoadBalancerElementByID(id string, opts ...OptionFunc) (*InternalLoadBalancerElement, int, error)
+	GetInternalLoadBalancerVMByID(id string, opts ...OptionFunc) (*InternalLoadBalancerVM, int, error)
+	GetInternalLoadBalancerVMByName(name string, opts ...OptionFunc) (*InternalLoadBalancerVM, int, error)
+	GetInternalLoadBalancerVMID(name string, opts ...OptionFunc) (string, int, error)
+	InternalLoadBalancerElementExists(id string, opts ...OptionFunc) (bool, error)
+	InternalLoadBalancerVMExists(id string, opts ...OptionFunc) (bool, error)
+	ListAllInternalLoadBalancerElements(p *ListInternalLoadBalancerElementsParams) ([]*InternalLoadBalancerElement, error)
+	ListAllInternalLoadBalancerVMs(p *ListInternalLoadBalancerVMsParams) ([]*InternalLoadBalancerVM, error)
+	ListInternalLoadBalancerElements(p *ListInternalLoadBalancerElementsParams) (*ListInternalLoadBalancerElementsResponse, error)
+	ListInternalLoadBalancerElementsRaw(v url.Values) (*ListInternalLoadBalancerElementsResponse, error)
+	ListInternalLoadBalancerElementsRawWithContext(ctx context.Context, v url.Values) (*ListInternalLoadBalancerElementsResponse, error)
+	ListInternalLoadBalancerElementsWhere(p *ListInternalLoadBalancerElementsParams, pred func(*InternalLoadBalancerElement) bool) ([]*InternalLoadBalancerElement, error)
+	ListInternalLoadBalancerElementsWithContext(ctx context.Context, p *ListInternalLoadBalancerElementsParams) (*ListInternalLoadBalancerElementsResponse, error)
+	ListInternalLoadBalancerVMs(p *ListInternalLoadBalancerVMsParams) (*ListInternalLoadBalancerVMsResponse, error)
+	ListInternalLoadBalancerVMsRaw(v url.Values) (*ListInternalLoadBalancerVMsResponse, error)
+	ListInternalLoadBalancerVMsRawWithContext(ctx context.Context, v url.Values) (*ListInternalLoadBalancerVMsResponse, error)
+	ListInternalLoadBalancerVMsWhere(p *ListInternalLoadBalancerVMsParams, pred func(*InternalLoadBalancerVM) bool) ([]*InternalLoadBalancerVM, error)
+	ListInternalLoadBalancerVMsWithContext(ctx context.Context, p *ListInternalLoadBalancerVMsParams) (*ListInternalLoadBalancerVMsResponse, error)
+	NewConfigureInternalLoadBalancerElementParams(enabled bool, id string, opts ...func(*ConfigureInternalLoadBalancerElementParams)) *ConfigureInternalLoadBalancerElementParams
+	NewCreateInternalLoadBalancerElementParams(nspid string, opts ...func(*CreateInternalLoadBalancerElementParams)) *CreateInternalLoadBalancerElementParams
+	NewListInternalLoadBalancerElementsParams(opts ...func(*ListInternalLoadBalancerElementsParams)) *ListInternalLoadBalancerElementsParams
+	NewListInternalLoadBalancerVMsParams(opts ...func(*ListInternalLoadBalancerVMsParams)) *ListInternalLoadBalancerVMsParams
+	NewStartInternalLoadBalancerVMParams(id string, opts ...func(*StartInternalLoadBalancerVMParams)) *StartInternalLoadBalancerVMParams
+	NewStopInternalLoadBalancerVMParams(id string, opts ...func(*StopInternalLoadBalancerVMParams)) *StopInternalLoadBalancerVMParams
+	StartInternalLoadBalancerVM(p *StartInternalLoadBalancerVMParams, opts ...OptionFunc) (*StartInternalLoadBalancerVMResponse, error)
+	StartInternalLoadBalancerVMRaw(v url.Values) (*StartInternalLoadBalancerVMResponse, error)
+	StartInternalLoadBalancerVMRawWithContext(ctx context.Context, v url.Values) (*StartInternalLoadBalancerVMResponse, error)
+	StartInternalLoadBalancerVMWithContext(ctx context.Context, p *StartInternalLoadBalancerVMParams, opts ...OptionFunc) (*StartInternalLoadBalancerVMResponse, error)
+	StopInternalLoadBalancerVM(p *StopInternalLoadBalancerVMParams, opts ...OptionFunc) (*StopInternalLoadBalancerVMResponse, error)
+	StopInternalLoadBalancerVMRaw(v url.Values) (*StopInternalLoadBalancerVMResponse, error)
+	StopInternalLoadBalancerVMRawWithContext(ctx context.Context, v url.Values) (*StopInternalLoadBalancerVMResponse, error)
+	StopInternalLoadBalancerVMWithContext(ctx context.Context, p *StopInternalLoadBalancerVMParams, opts ...OptionFunc) (*StopInternalLoadBalancerVMResponse, error)
+	StreamInternalLoadBalancerElements(ctx context.Context, p *ListInternalLoadBalancerElementsParams) (<-chan *InternalLoadBalancerElement, <-chan error)
+	StreamInternalLoadBalancerVMs(ctx context.Context, p *ListInternalLoadBalancerVMsParams) (<-chan *InternalLoadBalancerVM, <-chan error)
+}
+
+var _ InternalLBServiceIface = (*InternalLBService)(nil)
+
+// LDAPServiceIface contains all exported methods of LDAPService, so callers can depend on it to mock the service
+type LDAPServiceIface interface {
+	AddLdapConfiguration(p *AddLdapConfigurationParams, opts ...OptionFunc) (*AddLdapConfigurationResponse, error)
+	AddLdapConfigurationRaw(v url.Values) (*AddLdapConfigurationResponse, error)
+	AddLdapConfigurationRawWithContext(ctx context.Context, v url.Values) (*AddLdapConfigurationResponse, error)
+	AddLdapConfigurationWithContext(ctx context.Context, p *AddLdapConfigurationParams, opts ...OptionFunc) (*AddLdapConfigurationResponse, error)
+	DeleteLdapConfiguration(p *DeleteLdapConfigurationParams, opts ...OptionFunc) (*DeleteLdapConfigurationResponse, error)
+	DeleteLdapConfigurationRaw(v url.Values) (*DeleteLdapConfigurationResponse, error)
+	DeleteLdapConfigurationRawWithContext(ctx context.Context, v url.Values) (*DeleteLdapConfigurationResponse, error)
+	DeleteLdapConfigurationWithContext(ctx context.Context, p *DeleteLdapConfigurationParams, opts ...OptionFunc) (*DeleteLdapConfigurationResponse, error)
+	ImportLdapUsers(p *ImportLdapUsersParams, opts ...OptionFunc) (*ImportLdapUsersResponse, error)
+	ImportLdapUsersRaw(v url.Values) (*ImportLdapUsersResponse, error)
+	ImportLdapUsersRawWithContext(ctx context.Context, v url.Values) (*ImportLdapUsersResponse, error)
+	ImportLdapUsersWithContext(ctx context.Context, p *ImportLdapUsersParams, opts ...OptionFunc) (*ImportLdapUsersResponse, error)
+	LdapConfig(p *LdapConfigParams, opts ...OptionFunc) (*LdapConfigResponse, error)
+	LdapConfigRaw(v url.Values) (*LdapConfigResponse, error)
+	LdapConfigRawWithContext(ctx context.Context, v url.Values) (*LdapConfigResponse, error)
+	LdapConfigWithContext(ctx context.Context, p *LdapConfigParams, opts ...OptionFunc) (*LdapConfigResponse, error)
+	LdapCreateAccount(p *LdapCreateAccountParams, opts ...OptionFunc) (*LdapCreateAccountResponse, error)
+	LdapCreateAccountRaw(v url.Values) (*LdapCreateAccountResponse, error)
+	LdapCreateAccountRawWithContext(ctx context.Context, v url.Values) (*LdapCreateAccountResponse, error)
+	LdapCreateAccountWithContext(ctx context.Context, p *LdapCreateAccountParams, opts ...OptionFunc) (*LdapCreateAccountResponse, error)
+	LdapRemove(p *LdapRemoveParams, opts ...OptionFunc) (*LdapRemoveResponse, error)
+	LdapRemoveRaw(v url.Values) (*LdapRemoveResponse, error)
+	LdapRemoveRawWithContext(ctx context.Context, v url.Values) (*LdapRemoveResponse, error)
+	LdapRemoveWithContext(ctx context.Context, p *LdapRemoveParams, opts ...OptionFunc) (*LdapRemoveResponse, error)
+	LinkDomainToLdap(p *LinkDomainToLdapParams, opts ...OptionFunc) (*LinkDomainToLdapResponse, error)
+	LinkDomainToLdapRaw(v url.Values) (*LinkDomainToLdapResponse, error)
+	LinkDomainToLdapRawWithContext(ctx context.Context, v url.Values) (*LinkDomainToLdapResponse, error)
+	LinkDomainToLdapWithContext(ctx context.Context, p *LinkDomainToLdapParams, opts ...OptionFunc) (*LinkDomainToLdapResponse, error)
+	ListAllLdapConfigurations(p *ListLdapConfigurationsParams) ([]*LdapConfiguration, error)
+	ListAllLdapUsers(p *ListLdapUsersParams) ([]*LdapUser, error)
+	ListLdapConfigurations(p *ListLdapConfigurationsParams) (*ListLdapConfigurationsResponse, error)
+	ListLdapConfigurationsRaw(v url.Values) (*ListLdapConfigurationsResponse, error)
+	ListLdapConfigurationsRawWithContext(ctx context.Context, v url.Values) (*ListLdapConfigurationsResponse, error)
+	ListLdapConfigurationsWhere(p *ListLdapConfigurationsParams, pred func(*LdapConfiguration) bool) ([]*LdapConfiguration, error)
+	ListLdapConfigurationsWithContext(ctx context.Context, p *ListLdapConfigurationsParams) (*ListLdapConfigurationsResponse, error)
+	ListLdapUsers(p *ListLdapUsersParams) (*ListLdapUsersResponse, error)
+	ListLdapUsersRaw(v url.Values) (*ListLdapUsersResponse, error)
+	ListLdapUsersRawWithContext(ctx context.Context, v url.Values) (*ListLdapUsersResponse, error)
+	ListLdapUsersWhere(p *ListLdapUsersParams, pred func(*LdapUser) bool) ([]*LdapUser, error)
+	ListLdapUsersWithContext(ctx context.Context, p *ListLdapUsersParams) (*ListLdapUsersResponse, error)
+	NewAddLdapConfigurationParams(hostname string, port int, opts ...func(*AddLdapConfigurationParams)) *AddLdapConfigurationParams
+	NewDeleteLdapConfigurationParams(hostname string, opts ...func(*DeleteLdapConfigurationParams)) *DeleteLdapConfigurationParams
+	NewImportLdapUsersParams(opts ...func(*ImportLdapUsersParams)) *ImportLdapUsersParams
+	NewLdapConfigParams(opts ...func(*LdapConfigParams)) *LdapConfigParams
+	NewLdapCreateAccountParams(username string, opts ...func(*LdapCreateAccountParams)) *LdapCreateAccountParams
+	NewLdapRemoveParams(opts ...func(*LdapRemoveParams)) *LdapRemoveParams
+	NewLinkDomainToLdapParams(accounttype int, domainid string, name string, lDAPType string, opts ...func(*LinkDomainToLdapParams)) *LinkDomainToLdapParams
+	NewLinkDomainToLdapParamsFromOpts(o LinkDomainToLdapOpts) *LinkDomainToLdapParams
+	NewListLdapConfigurationsParams(opts ...func(*ListLdapConfigurationsParams)) *ListLdapConfigurationsParams
+	NewListLdapUsersParams(opts ...func(*ListLdapUsersParams)) *ListLdapUsersParams
+	NewSearchLdapParams(query string, opts ...func(*SearchLdapParams)) *SearchLdapParams
+	SearchLdap(p *SearchLdapParams, opts ...OptionFunc) (*SearchLdapResponse, error)
+	SearchLdapRaw(v url.Values) (*SearchLdapResponse, error)
+	SearchLdapRawWithContext(ctx context.Context, v url.Values) (*SearchLdapResponse, error)
+	SearchLdapWithContext(ctx context.Context, p *SearchLdapParams, opts ...OptionFunc) (*SearchLdapResponse, error)
+	StreamLdapConfigurations(ctx context.Context, p *ListLdapConfigurationsParams) (<-chan *LdapConfiguration, <-chan error)
+	StreamLdapUsers(ctx context.Context, p *ListLdapUsersParams) (<-chan *LdapUser, <-chan error)
+}
+
+var _ LDAPServiceIface = (*LDAPService)(nil)
+
+// LimitServiceIface contains all exported methods of LimitService, so callers can depend on it to mock the service
+type LimitServiceIface interface {
+	GetApiLimit(p *GetApiLimitParams, opts ...OptionFunc) (*GetApiLimitResponse, error)
+	GetApiLimitRaw(v url.Values) (*GetApiLimitResponse, error)
+	GetApiLimitRawWithContext(ctx context.Context, v url.Values) (*GetApiLimitResponse, error)
+	GetApiLimitWithContext(ctx context.Context, p *GetApiLimitParams, opts ...OptionFunc) (*GetApiLimitResponse, error)
+	ListAllResourceLimits(p *ListResourceLimitsParams) ([]*ResourceLimit, error)
+	ListResourceLimits(p *ListResourceLimitsParams) (*ListResourceLimitsResponse, error)
+	ListResourceLimitsRaw(v url.Values) (*ListResourceLimitsResponse, error)
+	ListResourceLimitsRawWithContext(ctx context.Context, v url.Values) (*ListResourceLimitsResponse, error)
+	ListResourceLimitsWhere(p *ListResourceLimitsParams, pred func(*ResourceLimit) bool) ([]*ResourceLimit, error)
+	ListResourceLimitsWithContext(ctx context.Context, p *ListResourceLimitsParams) (*ListResourceLimitsResponse, error)
+	NewGetApiLimitParams(opts ...func(*GetApiLimitParams)) *GetApiLimitParams
+	NewListResourceLimitsParams(opts ...func(*ListResourceLimitsParams)) *ListResourceLimitsParams
+	NewResetApiLimitParams(opts ...func(*ResetApiLimitParams)) *ResetApiLimitParams
+	NewUpdateResourceCountParams(domainid string, opts ...func(*UpdateResourceCountParams)) *UpdateResourceCountParams
+	NewUpdateResourceLimitParams(resourcetype int, opts ...func(*UpdateResourceLimitParams)) *UpdateResourceLimitParams
+	ResetApiLimit(p *ResetApiLimitParams, opts ...OptionFunc) (*ResetApiLimitResponse, error)
+	ResetApiLimitRaw(v url.Values) (*ResetApiLimitResponse, error)
+	ResetApiLimitRawWithContext(ctx context.Context, v url.Values) (*ResetApiLimitResponse, error)
+	ResetApiLimitWithContext(ctx context.Context, p *ResetApiLimitParams, opts ...OptionFunc) (*ResetApiLimitResponse, error)
+	StreamResourceLimits(ctx context.Context, p *ListResourceLimitsParams) (<-chan *ResourceLimit, <-chan error)
+	UpdateResourceCount(p *UpdateResourceCountParams, opts ...OptionFunc) (*UpdateResourceCountResponse, error)
+	UpdateResourceCountRaw(v url.Values) (*UpdateResourceCountResponse, error)
+	UpdateResourceCountRawWithContext(ctx context.Context, v url.Values) (*UpdateResourceCountResponse, error)
+	UpdateResourceCountWithContext(ctx context.Context, p *UpdateResourceCountParams, opts ...OptionFunc) (*UpdateResourceCountResponse, error)
+	UpdateResourceLimit(p *UpdateResourceLimitParams, opts ...OptionFunc) (*UpdateResourceLimitResponse, error)
+	UpdateResourceLimitRaw(v url.Values) (*UpdateResourceLimitResponse, error)
+	UpdateResourceLimitRawWithContext(ctx context.Context, v url.Values) (*UpdateResourceLimitResponse, error)
+	UpdateResourceLimitWithContext(ctx context.Context, p *UpdateResourceLimitParams, opts ...OptionFunc) (*UpdateResourceLimitResponse, error)
+}
+
+var _ LimitServiceIface = (*LimitService)(nil)
+
+// LoadBalancerServiceIface contains all exported methods of LoadBalancerService, so callers can depend on it to mock the service
+type LoadBalancerServiceIface interface {
+	AddF5LoadBalancer(p *AddF5LoadBalancerParams, opts ...OptionFunc) (*AddF5LoadBalancerResponse, error)
+	AddF5LoadBalancerRaw(v url.Values) (*AddF5LoadBalancerResponse, error)
+	AddF5LoadBalancerRawWithContext(ctx context.Context, v url.Values) (*AddF5LoadBalancerResponse, error)
+	AddF5LoadBalancerWithContext(ctx context.Context, p *AddF5LoadBalancerParams, opts ...OptionFunc) (*AddF5LoadBalancerResponse, error)
+	AddNetscalerLoadBalancer(p *AddNetscalerLoadBalancerParams, opts ...OptionFunc) (*AddNetscalerLoadBalancerResponse, error)
+	AddNetscalerLoadBalancerRaw(v url.Values) (*AddNetscalerLoadBalancerResponse, error)
+	AddNetscalerLoadBalancerRawWithContext(ctx context.Context, v url.Values) (*AddNetscalerLoadBalancerResponse, error)
+	AddNetscalerLoadBalancerWithContext(ctx context.Context, p *AddNetscalerLoadBalancerParams, opts ...OptionFunc) (*AddNetscalerLoadBalancerResponse, error)
+	AssignCertToLoadBalancer(p *AssignCertToLoadBalancerParams, opts ...OptionFunc) (*AssignCertToLoadBalancerResponse, error)
+	AssignCertToLoadBalancerRaw(v url.Values) (*AssignCertToLoadBalancerResponse, error)
+	AssignCertToLoadBalancerRawWithContext(ctx context.Context, v url.Values) (*AssignCertToLoadBalancerResponse, error)
+	AssignCertToLoadBalancerWithContext(ctx context.Context, p *AssignCertToLoadBalancerParams, opts ...OptionFunc) (*AssignCertToLoadBalancerResponse, error)
+	AssignToGlobalLoadBalancerRule(p *AssignToGlobalLoadBalancerRuleParams, opts ...OptionFunc) (*AssignToGlobalLoadBalancerRuleResponse, error)
+	AssignToGlobalLoadBalancerRuleRaw(v url.Values) (*AssignToGlobalLoadBalancerRuleResponse, error)
+	AssignToGlobalLoadBalancerRuleRawWithContext(ctx context.Context, v url.Values) (*AssignToGlobalLoadBalancerRuleResponse, error)
+	AssignToGlobalLoadBalancerRuleWithContext(ctx context.Context, p *AssignToGlobalLoadBalancerRuleParams, opts ...OptionFunc) (*AssignToGlobalLoadBalancerRuleResponse, error)
+	AssignToLoadBalancerRule(p *AssignToLoadBalancerRuleParams, opts ...OptionFunc) (*AssignToLoadBalancerRuleResponse, error)
+	AssignToLoadBalancerRuleRaw(v url.Values) (*AssignToLoadBalancerRuleResponse, error)
+	AssignToLoadBalancerRuleRawWithContext(ctx context.Context, v url.Values) (*AssignToLoadBalancerRuleResponse, error)
+	AssignToLoadBalancerRuleWithContext(ctx context.Context, p *AssignToLoadBalancerRuleParams, opts ...OptionFunc) (*AssignToLoadBalancerRuleResponse, error)
+	ConfigureF5LoadBalancer(p *ConfigureF5LoadBalancerParams, opts ...OptionFunc) (*F5LoadBalancerResponse, error)
+	ConfigureF5LoadBalancerRaw(v url.Values) (*F5LoadBalancerResponse, error)
+	ConfigureF5LoadBalancerRawWithContext(ctx context.Context, v url.Values) (*F5LoadBalancerResponse, error)
+	ConfigureF5LoadBalancerWithContext(ctx context.Context, p *ConfigureF5LoadBalancerParams, opts ...OptionFunc) (*F5LoadBalancerResponse, error)
+	ConfigureNetscalerLoadBalancer(p *ConfigureNetscalerLoadBalancerParams, opts ...OptionFunc) (*NetscalerLoadBalancerResponse, error)
+	ConfigureNetscalerLoadBalancerRaw(v url.Values) (*NetscalerLoadBalancerResponse, error)
+	ConfigureNetscalerLoadBalancerRawWithContext(ctx context.Context, v url.Values) (*NetscalerLoadBalancerResponse, error)
+	ConfigureNetscalerLoadBalancerWithContext(ctx context.Context, p *ConfigureNetscalerLoadBalancerParams, opts ...OptionFunc) (*NetscalerLoadBalancerResponse, error)
+	CreateGlobalLoadBalancerRule(p *CreateGlobalLoadBalancerRuleParams, opts ...OptionFunc) (*CreateGlobalLoadBalancerRuleResponse, error)
+	CreateGlobalLoadBalancerRuleRaw(v url.Values) (*CreateGlobalLoadBalancerRuleResponse, error)
+	CreateGlobalLoadBalancerRuleRawWithContext(ctx context.Context, v url.Values) (*CreateGlobalLoadBalancerRuleResponse, error)
+	CreateGlobalLoadBalancerRuleWithContext(ctx context.Context, p *CreateGlobalLoadBalancerRuleParams, opts ...OptionFunc) (*CreateGlobalLoadBalancerRuleResponse, error)
+	CreateLBHealthCheckPolicy(p *CreateLBHealthCheckPolicyParams, opts ...OptionFunc) (*CreateLBHealthCheckPolicyResponse, error)
+	CreateLBHealthCheckPolicyRaw(v url.Values) (*CreateLBHealthCheckPolicyResponse, error)
+	CreateLBHealthCheckPolicyRawWithContext(ctx context.Context, v url.Values) (*CreateLBHealthCheckPolicyResponse, error)
+	CreateLBHealthCheckPolicyWithContext(ctx context.Context, p *CreateLBHealthCheckPolicyParams, opts ...OptionFunc) (*CreateLBHealthCheckPolicyResponse, error)
+	CreateLBStickinessPolicy(p *CreateLBStickinessPolicyParams, opts ...OptionFunc) (*CreateLBStickinessPolicyResponse, error)
+	CreateLBStickinessPolicyRaw(v url.Values) (*CreateLBStickinessPolicyResponse, error)
+	CreateLBStickinessPolicyRawWithContext(ctx context.Context, v url.Values) (*CreateLBStickinessPolicyResponse, error)
+	CreateLBStickinessPolicyWithContext(ctx context.Context, p *CreateLBStickinessPolicyParams, opts ...OptionFunc) (*CreateLBStickinessPolicyResponse, error)
+	CreateLoadBalancer(p *CreateLoadBalancerParams, opts ...OptionFunc) (*CreateLoadBalancerResponse, error)
+	CreateLoadBalancerRaw(v url.Values) (*CreateLoadBalancerResponse, error)
+	CreateLoadBalancerRawWithContext(ctx context.Context, v url.Values) (*CreateLoadBalancerResponse, error)
+	CreateLoadBalancerRule(p *CreateLoadBalancerRuleParams, opts ...OptionFunc) (*CreateLoadBalancerRuleResponse, error)
+	CreateLoadBalancerRuleRaw(v url.Values) (*CreateLoadBalancerRuleResponse, error)
+	CreateLoadBalancerRuleRawWithContext(ctx context.Context, v url.Values) (*CreateLoadBalancerRuleResponse, error)
+	CreateLoadBalancerRuleWithContext(ctx context.Context, p *CreateLoadBalancerRuleParams, opts ...OptionFunc) (*CreateLoadBalancerRuleResponse, error)
+	CreateLoadBalancerWithContext(ctx context.Context, p *CreateLoadBalancerParams, opts ...OptionFunc) (*CreateLoadBalancerResponse, error)
+	DeleteF5LoadBalancer(p *DeleteF5LoadBalancerParams, opts ...OptionFunc) (*DeleteF5LoadBalancerResponse, error)
+	DeleteF5LoadBalancerRaw(v url.Values) (*DeleteF5LoadBalancerResponse, error)
+	DeleteF5LoadBalancerRawWithContext(ctx context.Context, v url.Values) (*DeleteF5LoadBalancerResponse, error)
+	DeleteF5LoadBalancerWithContext(ctx context.Context, p *DeleteF5LoadBalancerParams, opts ...OptionFunc) (*DeleteF5LoadBalancerResponse, error)
+	DeleteGlobalLoadBalancerRule(p *DeleteGlobalLoadBalancerRuleParams, opts ...OptionFunc) (*DeleteGlobalLoadBalancerRuleResponse, error)
+	DeleteGlobalLoadBalancerRuleRaw(v url.Values) (*DeleteGlobalLoadBalancerRuleResponse, error)
+	DeleteGlobalLoadBalancerRuleRawWithContext(ctx context.Context, v url.Values) (*DeleteGlobalLoadBalancerRuleResponse, error)
+	DeleteGlobalLoadBalancerRuleWithContext(ctx context.Context, p *DeleteGlobalLoadBalancerRuleParams, opts ...OptionFunc) (*DeleteGlobalLoadBalancerRuleResponse, error)
+	DeleteLBHealthCheckPolicy(p *DeleteLBHealthCheckPolicyParams, opts ...OptionFunc) (*DeleteLBHealthCheckPolicyResponse, error)
+	DeleteLBHealthCheckPolicyRaw(v url.Values) (*DeleteLBHealthCheckPolicyResponse, error)
+	DeleteLBHealthCheckPolicyRawWithContext(ctx context.Context, v url.Values) (*DeleteLBHealthCheckPolicyResponse, error)
+	DeleteLBHealthCheckPolicyWithContext(ctx context.Context, p *DeleteLBHealthCheckPolicyParams, opts ...OptionFunc) (*DeleteLBHealthCheckPolicyResponse, error)
+	DeleteLBStickinessPolicy(p *DeleteLBStickinessPolicyParams, opts ...OptionFunc) (*DeleteLBStickinessPolicyResponse, error)
+	DeleteLBStickinessPolicyRaw(v url.Values) (*DeleteLBStickinessPolicyResponse, error)
+	DeleteLBStickinessPolicyRawWithContext(ctx context.Context, v url.Values) (*DeleteLBStickinessPolicyResponse, error)
+	DeleteLBStickinessPolicyWithContext(ctx context.Context, p *DeleteLBStickinessPolicyParams, opts ...OptionFunc) (*DeleteLBStickinessPolicyResponse, error)
+	DeleteLoadBalancer(p *DeleteLoadBalancerParams, opts ...OptionFunc) (*DeleteLoadBalancerResponse, error)
+	DeleteLoadBalancerRaw(v url.Values) (*DeleteLoadBalancerResponse, error)
+	DeleteLoadBalancerRawWithContext(ctx context.Context, v url.Values) (*DeleteLoadBalancerResponse, error)
+	DeleteLoadBalancerRule(p *DeleteLoadBalancerRuleParams, opts ...OptionFunc) (*DeleteLoadBalancerRuleResponse, error)
+	DeleteLoadBalancerRuleRaw(v url.Values) (*DeleteLoadBalancerRuleResponse, error)
+	DeleteLoadBalancerRuleRawWithContext(ctx context.Context, v url.Values) (*DeleteLoadBalancerRuleResponse, error)
+	DeleteLoadBalancerRuleWithContext(ctx context.Context, p *DeleteLoadBalancerRuleParams, opts ...OptionFunc) (*DeleteLoadBalancerRuleResponse, error)
+	DeleteLoadBalancerWithContext(ctx context.Context, p *DeleteLoadBalancerParams, opts ...OptionFunc) (*DeleteLoadBalancerResponse, error)
+	DeleteNetscalerLoadBalancer(p *DeleteNetscalerLoadBalancerParams, opts ...OptionFunc) (*DeleteNetscalerLoadBalancerResponse, error)
+	DeleteNetscalerLoadBalancerRaw(v url.Values) (*DeleteNetscalerLoadBalancerResponse, error)
+	DeleteNetscalerLoadBalancerRawWithContext(ctx context.Context, v url.Values) (*DeleteNetscalerLoadBalancerResponse, error)
+	DeleteNetscalerLoadBalancerWithContext(ctx context.Context, p *DeleteNetscalerLoadBalancerParams, opts ...OptionFunc) (*DeleteNetscalerLoadBalancerResponse, error)
+	DeleteSslCert(p *DeleteSslCertParams, opts ...OptionFunc) (*DeleteSslCertResponse, error)
+	DeleteSslCertRaw(v url.Values) (*DeleteSslCertResponse, error)
+	DeleteSslCertRawWithContext(ctx context.Context, v url.Values) (*DeleteSslCertResponse, error)
+	DeleteSslCertWithContext(ctx context.Context, p *DeleteSslCertParams, opts ...OptionFunc) (*DeleteSslCertResponse, error)
+	GetGlobalLoadBalancerRuleByID(id string, opts ...OptionFunc) (*GlobalLoadBalancerRule, int, error)
+	GetGlobalLoadBalancerRuleByName(name string, opts ...OptionFunc) (*GlobalLoadBalancerRule, int, error)
+	GetGlobalLoadBalancerRuleID(keyword string, opts ...OptionFunc) (string, int, error)
+	GetLBHealthCheckPolicyByID(id string, opts ...OptionFunc) (*LBHealthCheckPolicy, int, error)
+	GetLBStickinessPolicyByID(id string, opts ...OptionFunc) (*LBStickinessPolicy, int, error)
+	GetLoadBalancerByID(id string, opts ...OptionFunc) (*LoadBalancer, int, error)
+	GetLoadBalancerByName(name string, opts ...OptionFunc) (*LoadBalancer, int, error)
+	GetLoadBalancerID(name string, opts ...OptionFunc) (string, int, error)
+	GetLoadBalancerRuleByID(id string, opts ...OptionFunc) (*LoadBalancerRule, int, error)
+	GetLoadBalancerRuleByName(name string, opts ...OptionFunc) (*LoadBalancerRule, int, error)
+	GetLoadBalancerRuleID(name string, opts ...OptionFunc) (string, int, error)
+	GetLoadBalancerRuleInstanceByID(id string, opts ...OptionFunc) (*VirtualMachine, int, error)
+	GlobalLoadBalancerRuleExists(id string, opts ...OptionFunc) (bool, error)
+	LBHealthCheckPolicyExists(id string, opts ...OptionFunc) (bool, error)
+	LBStickinessPolicyExists(id string, opts ...OptionFunc) (bool, error)
+	ListAllF5LoadBalancers(p *ListF5LoadBalancersParams) ([]*F5LoadBalancer, error)
+	ListAllGlobalLoadBalancerRules(p *ListGlobalLoadBalancerRulesParams) ([]*GlobalLoadBalancerRule, error)
+	ListAllLBHealthCheckPolicies(p *ListLBHealthCheckPoliciesParams) ([]*LBHealthCheckPolicy, error)
+	ListAllLBStickinessPolicies(p *ListLBStickinessPoliciesParams) ([]*LBStickinessPolicy, error)
+	ListAllLoadBalancerRules(p *ListLoadBalancerRulesParams) ([]*LoadBalancerRule, error)
+	ListAllLoadBalancers(p *ListLoadBalancersParams) ([]*LoadBalancer, error)
+	ListAllNetscalerLoadBalancers(p *ListNetscalerLoadBalancersParams) ([]*NetscalerLoadBalancer, error)
+	ListF5LoadBalancers(p *ListF5LoadBalancersParams) (*ListF5LoadBalancersResponse, error)
+	ListF5LoadBalancersRaw(v url.Values) (*ListF5LoadBalancersResponse, error)
+	ListF5LoadBalancersRawWithContext(ctx context.Context, v url.Values) (*ListF5LoadBalancersResponse, error)
+	ListF5LoadBalancersWhere(p *ListF5LoadBalancersParams, pred func(*F5LoadBalancer) bool) ([]*F5LoadBalancer, error)
+	ListF5LoadBalancersWithContext(ctx context.Context, p *ListF5LoadBalancersParams) (*ListF5LoadBalancersResponse, error)
+	ListGlobalLoadBalancerRules(p *ListGlobalLoadBalancerRulesParams) (*ListGlobalLoadBalancerRulesResponse, error)
+	ListGlobalLoadBalancerRulesRaw(v url.Values) (*ListGlobalLoadBalancerRulesResponse, error)
+	ListGlobalLoadBalancerRulesRawWithContext(ctx context.Context, v url.Values) (*ListGlobalLoadBalancerRulesResponse, error)
+	ListGlobalLoadBalancerRulesWhere(p *ListGlobalLoadBalancerRulesParams, pred func(*GlobalLoadBalancerRule) bool) ([]*GlobalLoadBalancerRule, error)
+	ListGlobalLoadBalancerRulesWithContext(ctx context.Context, p *ListGlobalLoadBalancerRulesParams) (*ListGlobalLoadBalancerRulesResponse, error)
+	ListLBHealthCheckPolicies(p *ListLBHealthCheckPoliciesParams) (*ListLBHealthCheckPoliciesResponse, error)
+	ListLBHealthCheckPoliciesRaw(v url.Values) (*ListLBHealthCheckPoliciesResponse, error)
+	ListLBHealthCheckPoliciesRawWithContext(ctx context.Context, v url.Values) (*ListLBHealthCheckPoliciesResponse, error)
+	ListLBHealthCheckPoliciesWhere(p *ListLBHealthCheckPoliciesParams, pred func(*LBHealthCheckPolicy) bool) ([]*LBHealthCheckPolicy, error)
+	ListLBHealthCheckPoliciesWithContext(ctx context.Context, p *ListLBHealthCheckPoliciesParams) (*ListLBHealthCheckPoliciesResponse, error)
+	ListLBStickinessPolicies(p *ListLBStickinessPoliciesParams) (*ListLBStickinessPoliciesResponse, error)
+	ListLBStickinessPoliciesRaw(v url.Values) (*ListLBStickinessPoliciesResponse, error)
+	ListLBStickinessPoliciesRawWithContext(ctx context.Context, v url.Values) (*ListLBStickinessPoliciesResponse, error)
+	ListLBStickinessPoliciesWhere(p *ListLBStickinessPoliciesParams, pred func(*LBStickinessPolicy) bool) ([]*LBStickinessPolicy, error)
+	ListLBStickinessPoliciesWithContext(ctx context.Context, p *ListLBStickinessPoliciesParams) (*ListLBStickinessPoliciesResponse, error)
+	ListLoadBalancerRuleInstances(p *ListLoadBalancerRuleInstancesParams) (*ListLoadBalancerRuleInstancesResponse, error)
+	ListLoadBalancerRuleInstancesRaw(v url.Values) (*ListLoadBalancerRuleInstancesResponse, error)
+	ListLoadBalancerRuleInstancesRawWithContext(ctx context.Context, v url.Values) (*ListLoadBalancerRuleInstancesResponse, error)
+	ListLoadBalancerRuleInstancesWithContext(ctx context.Context, p *ListLoadBalancerRuleInstancesParams) (*ListLoadBalancerRuleInstancesResponse, error)
+	ListLoadBalancerRules(p *ListLoadBalancerRulesParams) (*ListLoadBalancerRulesResponse, error)
+	ListLoadBalancerRulesRaw(v url.Values) (*ListLoadBalancerRulesResponse, error)
+	ListLoadBalancerRulesRawWithContext(ctx context.Context, v url.Values) (*ListLoadBalancerRulesResponse, error)
+	ListLoadBalancerRulesWhere(p *ListLoadBalancerRulesParams, pred func(*LoadBalancerRule) bool) ([]*LoadBalancerRule, error)
+	ListLoadBalancerRulesWithContext(ctx context.Context, p *ListLoadBalancerRulesParams) (*ListLoadBalancerRulesResponse, error)
+	ListLoadBalancers(p *ListLoadBalancersParams) (*ListLoadBalancersResponse, error)
+	ListLoadBalancersRaw(v url.Values) (*ListLoadBalancersResponse, error)
+	ListLoadBalancersRawWithContext(ctx context.Context, v url.Values) (*ListLoadBalancersResponse, error)
+	ListLoadBalancersWhere(p *ListLoadBalancersParams, pred func(*LoadBalancer) bool) ([]*LoadBalancer, error)
+	ListLoadBalancersWithContext(ctx context.Context, p *ListLoadBalancersParams) (*ListLoadBalancersResponse, error)
+	ListNetscalerLoadBalancers(p *ListNetscalerLoadBalancersParams) (*ListNetscalerLoadBalancersResponse, error)
+	ListNetscalerLoadBalancersRaw(v url.Values) (*ListNetscalerLoadBalancersResponse, error)
+	ListNetscalerLoadBalancersRawWithContext(ctx context.Context, v url.Values) (*ListNetscalerLoadBalancersResponse, error)
+	ListNetscalerLoadBalancersWhere(p *ListNetscalerLoadBalancersParams, pred func(*NetscalerLoadBalancer) bool) ([]*NetscalerLoadBalancer, error)
+	ListNetscalerLoadBalancersWithContext(ctx context.Context, p *ListNetscalerLoadBalancersParams) (*ListNetscalerLoadBalancersResponse, error)
+	ListSslCerts(p *ListSslCertsParams) (*ListSslCertsResponse, error)
+	ListSslCertsRaw(v url.Values) (*ListSslCertsResponse, error)
+	ListSslCertsRawWithContext(ctx context.Context, v url.Values) (*ListSslCertsResponse, error)
+	ListSslCertsWhere(p *ListSslCertsParams, pred func(*SslCert) bool) ([]*SslCert, error)
+	ListSslCertsWithContext(ctx context.Context, p *ListSslCertsParams) (*ListSslCertsResponse, error)
+	LoadBalancerExists(id string, opts ...OptionFunc) (bool, error)
+	LoadBalancerRuleExists(id string, opts ...OptionFunc) (bool, error)
+	LoadBalancerRuleInstanceExists(id string, opts ...OptionFunc) (bool, error)
+	NewAddF5LoadBalancerParams(networkdevicetype string, password string, physicalnetworkid string, url string, username string, opts ...func(*AddF5LoadBalancerParams)) *AddF5LoadBalancerParams
+	NewAddF5LoadBalancerParamsFromOpts(o AddF5LoadBalancerOpts) *AddF5LoadBalancerParams
+	NewAddNetscalerLoadBalancerParams(networkdevicetype string, password string, physicalnetworkid string, url string, username string, opts ...func(*AddNetscalerLoadBalancerParams)) *AddNetscalerLoadBalancerParams
+	NewAddNetscalerLoadBalancerParamsFromOpts(o AddNetscalerLoadBalancerOpts) *AddNetscalerLoadBalancerParams
+	NewAssignCertToLoadBalancerParams(certid string, lbruleid string, opts ...func(*AssignCertToLoadBalancerParams)) *AssignCertToLoadBalancerParams
+	NewAssignToGlobalLoadBalancerRuleParams(id string, loadbalancerrulelist []string, opts ...func(*AssignToGlobalLoadBalancerRuleParams)) *AssignToGlobalLoadBalancerRuleParams
+	NewAssignToLoadBalancerRuleParams(id string, opts ...func(*AssignToLoadBalancerRuleParams)) *AssignToLoadBalancerRuleParams
+	NewConfigureF5LoadBalancerParams(lbdeviceid string, opts ...func(*ConfigureF5LoadBalancerParams)) *ConfigureF5LoadBalancerParams
+	NewConfigureNetscalerLoadBalancerParams(lbdeviceid string, opts ...func(*ConfigureNetscalerLoadBalancerParams)) *ConfigureNetscalerLoadBalancerParams
+	NewCreateGlobalLoadBalancerRuleParams(gslbdomainname string, gslbservicetype string, name string, regionid int, opts ...func(*CreateGlobalLoadBalancerRuleParams)) *CreateGlobalLoadBalancerRuleParams
+	NewCreateGlobalLoadBalancerRuleParamsFromOpts(o CreateGlobalLoadBalancerRuleOpts) *CreateGlobalLoadBalancerRuleParams
+	NewCreateLBHealthCheckPolicyParams(lbruleid string, opts ...func(*CreateLBHealthCheckPolicyParams)) *CreateLBHealthCheckPolicyParams
+	NewCreateLBStickinessPolicyParams(lbruleid string, methodname string, name string, opts ...func(*CreateLBStickinessPolicyParams)) *CreateLBStickinessPolicyParams
+	NewCreateLBStickinessPolicyParamsFromOpts(o CreateLBStickinessPolicyOpts) *CreateLBStickinessPolicyParams
+	NewCreateLoadBalancerParams(algorithm string, instanceport int, name string, networkid string, scheme string, sourceipaddressnetworkid string, sourceport int, opts ...func(*CreateLoadBalancerParams)) *CreateLoadBalancerParams
+	NewCreateLoadBalancerParamsFromOpts(o CreateLoadBalancerOpts) *CreateLoadBalancerParams
+	NewCreateLoadBalancerRuleParams(algorithm string, name string, privateport int, publicport int, opts ...func(*CreateLoadBalancerRuleParams)) *CreateLoadBalancerRuleParams
+	NewCreateLoadBalancerRuleParamsFromOpts(o CreateLoadBalancerRuleOpts) *CreateLoadBalancerRuleParams
+	NewDeleteF5LoadBalancerParams(lbdeviceid string, opts ...func(*DeleteF5LoadBalancerParams)) *DeleteF5LoadBalancerParams
+	NewDeleteGlobalLoadBalancerRuleParams(id string, opts ...func(*DeleteGlobalLoadBalancerRuleParams)) *DeleteGlobalLoadBalancerRuleParams
+	NewDeleteLBHealthCheckPolicyParams(id string, opts ...func(*DeleteLBHealthCheckPolicyParams)) *DeleteLBHealthCheckPolicyParams
+	NewDeleteLBStickinessPolicyParams(id string, opts ...func(*DeleteLBStickinessPolicyParams)) *DeleteLBStickinessPolicyParams
+	NewDeleteLoadBalancerParams(id string, opts ...func(*DeleteLoadBalancerParams)) *DeleteLoadBalancerParams
+	NewDeleteLoadBalancerRuleParams(id string, opts ...func(*DeleteLoadBalancerRuleParams)) *DeleteLoadBalancerRuleParams
+	NewDeleteNetscalerLoadBalancerParams(lbdeviceid string, opts ...func(*DeleteNetscalerLoadBalancerParams)) *DeleteNetscalerLoadBalancerParams
+	NewDeleteSslCertParams(id string, opts ...func(*DeleteSslCertParams)) *DeleteSslCertParams
+	NewListF5LoadBalancersParams(opts ...func(*ListF5LoadBalancersParams)) *ListF5LoadBalancersParams
+	NewListGlobalLoadBalancerRulesParams(opts ...func(*ListGlobalLoadBalancerRulesParams)) *ListGlobalLoadBalancerRulesParams
+	NewListLBHealthCheckPoliciesParams(opts ...func(*ListLBHealthCheckPoliciesParams)) *ListLBHealthCheckPoliciesParams
+	NewListLBStickinessPoliciesParams(opts ...func(*ListLBStickinessPoliciesParams)) *ListLBStickinessPoliciesParams
+	NewListLoadBalancerRuleInstancesParams(id string, opts ...func(*ListLoadBalancerRuleInstancesParams)) *ListLoadBalancerRuleInstancesParams
+	NewListLoadBalancerRulesParams(opts ...func(*ListLoadBalancerRulesParams)) *ListLoadBalancerRulesParams
+	NewListLoadBalancersParams(opts ...func(*ListLoadBalancersParams)) *ListLoadBalancersParams
+	NewListNetscalerLoadBalancersParams(opts ...func(*ListNetscalerLoadBalancersParams)) *ListNetscalerLoadBalancersParams
+	NewListSslCertsParams(opts ...func(*ListSslCertsParams)) *ListSslCertsParams
+	NewRemoveCertFromLoadBalancerParams(lbruleid string, opts ...func(*RemoveCertFromLoadBalancerParams)) *RemoveCertFromLoadBalancerParams
+	NewRemoveFromGlobalLoadBalancerRuleParams(id string, loadbalancerrulelist []string, opts ...func(*RemoveFromGlobalLoadBalancerRuleParams)) *RemoveFromGlobalLoadBalancerRuleParams
+	NewRemoveFromLoadBalancerRuleParams(id string, opts ...func(*RemoveFromLoadBalancerRuleParams)) *RemoveFromLoadBalancerRuleParams
+	NewUpdateGlobalLoadBalancerRuleParams(id string, opts ...func(*UpdateGlobalLoadBalancerRuleParams)) *UpdateGlobalLoadBalancerRuleParams
+	NewUpdateLBHealthCheckPolicyParams(id string, opts ...func(*UpdateLBHealthCheckPolicyParams)) *UpdateLBHealthCheckPolicyParams
+	NewUpdateLBStickinessPolicyParams(id string, opts ...func(*UpdateLBStickinessPolicyParams)) *UpdateLBStickinessPolicyParams
+	NewUpdateLoadBalancerParams(id string, opts ...func(*UpdateLoadBalancerParams)) *UpdateLoadBalancerParams
+	NewUpdateLoadBalancerRuleParams(id string, opts ...func(*UpdateLoadBalancerRuleParams)) *UpdateLoadBalancerRuleParams
+	NewUploadSslCertParams(certificate string, privatekey string, opts ...func(*UploadSslCertParams)) *UploadSslCertParams
+	RemoveCertFromLoadBalancer(p *RemoveCertFromLoadBalancerParams, opts ...OptionFunc) (*RemoveCertFromLoadBalancerResponse, error)
+	RemoveCertFromLoadBalancerRaw(v url.Values) (*RemoveCertFromLoadBalancerResponse, error)
+	RemoveCertFromLoadBalancerRawWithContext(ctx context.Context, v url.Values) (*RemoveCertFromLoadBalancerResponse, error)
+	RemoveCertFromLoadBalancerWithContext(ctx context.Context, p *RemoveCertFromLoadBalancerParams, opts ...OptionFunc) (*RemoveCertFromLoadBalancerResponse, error)
+	RemoveFromGlobalLoadBalancerRule(p *RemoveFromGlobalLoadBalancerRuleParams, opts ...OptionFunc) (*RemoveFromGlobalLoadBalancerRuleResponse, error)
+	RemoveFromGlobalLoadBalancerRuleRaw(v url.Values) (*RemoveFromGlobalLoadBalancerRuleResponse, error)
+	RemoveFromGlobalLoadBalancerRuleRawWithContext(ctx context.Context, v url.Values) (*RemoveFromGlobalLoadBalancerRuleResponse, error)
+	RemoveFromGlobalLoadBalancerRuleWithContext(ctx context.Context, p *RemoveFromGlobalLoadBalancerRuleParams, opts ...OptionFunc) (*RemoveFromGlobalLoadBalancerRuleResponse, error)
+	RemoveFromLoadBalancerRule(p *RemoveFromLoadBalancerRuleParams, opts ...OptionFunc) (*RemoveFromLoadBalancerRuleResponse, error)
+	RemoveFromLoadBalancerRuleRaw(v url.Values) (*RemoveFromLoadBalancerRuleResponse, error)
+	RemoveFromLoadBalancerRuleRawWithContext(ctx context.Context, v url.Values) (*RemoveFromLoadBalancerRuleResponse, error)
+	RemoveFromLoadBalancerRuleWithContext(ctx context.Context, p *RemoveFromLoadBalancerRuleParams, opts ...OptionFunc) (*RemoveFromLoadBalancerRuleResponse, error)
+	StreamF5LoadBalancers(ctx context.Context, p *ListF5LoadBalancersParams) (<-chan *F5LoadBalancer, <-chan error)
+	StreamGlobalLoadBalancerRules(ctx context.Context, p *ListGlobalLoadBalancerRulesParams) (<-chan *GlobalLoadBalancerRule, <-chan error)
+	StreamLBHealthCheckPolicies(ctx context.Context, p *ListLBHealthCheckPoliciesParams) (<-chan *LBHealthCheckPolicy, <-chan error)
+	StreamLBStickinessPolicies(ctx context.Context, p *ListLBStickinessPoliciesParams) (<-chan *LBStickinessPolicy, <-chan error)
+	StreamLoadBalancerRules(ctx context.Context, p *ListLoadBalancerRulesParams) (<-chan *LoadBalancerRule, <-chan error)
+	StreamLoadBalancers(ctx context.Context, p *ListLoadBalancersParams) (<-chan *LoadBalancer, <-chan error)
+	StreamNetscalerLoadBalancers(ctx context.Context, p *ListNetscalerLoadBalancersParams) (<-chan *NetscalerLoadBalancer, <-chan error)
+	UpdateGlobalLoadBalancerRule(p *UpdateGlobalLoadBalancerRuleParams, opts ...OptionFunc) (*UpdateGlobalLoadBalancerRuleResponse, error)
+	UpdateGlobalLoadBalancerRuleRaw(v url.Values) (*UpdateGlobalLoadBalancerRuleResponse, error)
+	UpdateGlobalLoadBalancerRuleRawWithContext(ctx context.Context, v url.Values) (*UpdateGlobalLoadBalancerRuleResponse, error)
+	UpdateGlobalLoadBalancerRuleWithContext(ctx context.Context, p *UpdateGlobalLoadBalancerRuleParams, opts ...OptionFunc) (*UpdateGlobalLoadBalancerRuleResponse, error)
+	UpdateLBHealthCheckPolicy(p *UpdateLBHealthCheckPolicyParams, opts ...OptionFunc) (*UpdateLBHealthCheckPolicyResponse, error)
+	UpdateLBHealthCheckPolicyRaw(v url.Values) (*UpdateLBHealthCheckPolicyResponse, error)
+	UpdateLBHealthCheckPolicyRawWithContext(ctx context.Context, v url.Values) (*UpdateLBHealthCheckPolicyResponse, error)
+	UpdateLBHealthCheckPolicyWithContext(ctx context.Context, p *UpdateLBHealthCheckPolicyParams, opts ...OptionFunc) (*UpdateLBHealthCheckPolicyResponse, error)
+	UpdateLBStickinessPolicy(p *UpdateLBStickinessPolicyParams, opts ...OptionFunc) (*UpdateLBStickinessPolicyResponse, error)
+	UpdateLBStickinessPolicyRaw(v url.Values) (*UpdateLBStickinessPolicyResponse, error)
+	UpdateLBStickinessPolicyRawWithContext(ctx context.Context, v url.Values) (*UpdateLBStickinessPolicyResponse, error)
+	UpdateLBStickinessPolicyWithContext(ctx context.Context, p *UpdateLBStickinessPolicyParams, opts ...OptionFunc) (*UpdateLBStickinessPolicyResponse, error)
+	UpdateLoadBalancer(p *UpdateLoadBalancerParams, opts ...OptionFunc) (*UpdateLoadBalancerResponse, error)
+	UpdateLoadBalancerRaw(v url.Values) (*UpdateLoadBalancerResponse, error)
+	UpdateLoadBalancerRawWithContext(ctx context.Context, v url.Values) (*UpdateLoadBalancerResponse, error)
+	UpdateLoadBalancerRule(p *UpdateLoadBalancerRuleParams, opts ...OptionFunc) (*UpdateLoadBalancerRuleResponse, error)
+	UpdateLoadBalancerRuleRaw(v url.Values) (*UpdateLoadBalancerRuleResponse, error)
+	UpdateLoadBalancerRuleRawWithContext(ctx context.Context, v url.Values) (*UpdateLoadBalancerRuleResponse, error)
+	UpdateLoadBalancerRuleWithContext(ctx context.Context, p *UpdateLoadBalancerRuleParams, opts ...OptionFunc) (*UpdateLoadBalancerRuleResponse, error)
+	UpdateLoadBalancerWithContext(ctx context.Context, p *UpdateLoadBalancerParams, opts ...OptionFunc) (*UpdateLoadBalancerResponse, error)
+	UpdateMany(ids []string, mutate func(*UpdateLoadBalancerParams), concurrency int, opts ...OptionFunc) (BulkResult[*UpdateLoadBalancerResponse], error)
+	UploadSslCert(p *UploadSslCertParams, opts ...OptionFunc) (*UploadSslCertResponse, error)
+	UploadSslCertRaw(v url.Values) (*UploadSslCertResponse, error)
+	UploadSslCertRawWithContext(ctx context.Context, v url.Values) (*UploadSslCertResponse, error)
+	UploadSslCertWithContext(ctx context.Context, p *UploadSslCertParams, opts ...OptionFunc) (*UploadSslCertResponse, error)
+	WaitForGlobalLoadBalancerRuleDeleted(ctx context.Context, id string, timeout time.Duration, opts ...OptionFunc) error
+	WaitForLBHealthCheckPolicyDeleted(ctx context.Context, id string, timeout time.Duration, opts ...OptionFunc) error
+	WaitForLBStickinessPolicyDeleted(ctx context.Context, id string, timeout time.Duration, opts ...OptionFunc) error
+	WaitForLoadBalancerDeleted(ctx context.Context, id string, timeout time.Duration, opts ...OptionFunc) error
+	WaitForLoadBalancerRuleDeleted(ctx context.Context, id string, timeout time.Duration, opts ...OptionFunc) error
+}
+
+var _ LoadBalancerServiceIface = (*LoadBalancerService)(nil)
+
+// NATServiceIface contains all exported methods of NATService, so callers can depend on it to mock the service
+type NATServiceIface interface {
+	CreateIpForwardingRule(p *CreateIpForwardingRuleParams, opts ...OptionFunc) (*CreateIpForwardingRuleResponse, error)
+	CreateIpForwardingRuleRaw(v url.Values) (*CreateIpForwardingRuleResponse, error)
+	CreateIpForwardingRuleRawWithContext(ctx context.Context, v url.Values) (*CreateIpForwardingRuleResponse, error)
+	CreateIpForwardingRuleWithContext(ctx context.Context, p *CreateIpForwardingRuleParams, opts ...OptionFunc) (*CreateIpForwardingRuleResponse, error)
+	DeleteIpForwardingRule(p *DeleteIpForwardingRuleParams, opts ...OptionFunc) (*DeleteIpForwardingRuleResponse, error)
+	DeleteIpForwardingRuleRaw(v url.Values) (*DeleteIpForwardingRuleResponse, error)
+	DeleteIpForwardingRuleRawWithContext(ctx context.Context, v url.Values) (*DeleteIpForwardingRuleResponse, error)
+	DeleteIpForwardingRuleWithContext(ctx context.Context, p *DeleteIpForwardingRuleParams, opts ...OptionFunc) (*DeleteIpForwardingRuleResponse, error)
+	DisableStaticNat(p *DisableStaticNatParams, opts ...OptionFunc) (*DisableStaticNatResponse, error)
+	DisableStaticNatRaw(v url.Values) (*DisableStaticNatResponse, error)
+	DisableStaticNatRawWithContext(ctx context.Context, v url.Values) (*DisableStaticNatResponse, error)
+	DisableStaticNatWithContext(ctx context.Context, p *DisableStaticNatParams, opts ...OptionFunc) (*DisableStaticNatResponse, error)
+	EnableStaticNat(p *EnableStaticNatParams, opts ...OptionFunc) (*EnableStaticNatResponse, error)
+	EnableStaticNatRaw(v url.Values) (*EnableStaticNatResponse, error)
+	EnableStaticNatRawWithContext(ctx context.Context, v url.Values) (*EnableStaticNatResponse, error)
+	EnableStaticNatWithContext(ctx context.Context, p *EnableStaticNatParams, opts ...OptionFunc) (*EnableStaticNatResponse, error)
+	GetIpForwardingRuleByID(id string, opts ...OptionFunc) (*IpForwardingRule, int, error)
+	IpForwardingRuleExists(id string, opts ...OptionFunc) (bool, error)
+	ListAllIpForwardingRules(p *ListIpForwardingRulesParams) ([]*IpForwardingRule, error)
+	ListIpForwardingRules(p *ListIpForwardingRulesParams) (*ListIpForwardingRulesResponse, error)
+	ListIpForwardingRulesRaw(v url.Values) (*ListIpForwardingRulesResponse, error)
+	ListIpForwardingRulesRawWithContext(ctx context.Context, v url.Values) (*ListIpForwardingRulesResponse, error)
+	ListIpForwardingRulesWhere(p *ListIpForwardingRulesParams, pred func(*IpForwardingRule) bool) ([]*IpForwardingRule, error)
+	ListIpForwardingRulesWithContext(ctx context.Context, p *ListIpForwardingRulesParams) (*ListIpForwardingRulesResponse, error)
+	NewCreateIpForwardingRuleParams(ipaddressid string, protocol string, startport int, opts ...func(*CreateIpForwardingRuleParams)) *CreateIpForwardingRuleParams
+	NewCreateIpForwardingRuleParamsFromOpts(o CreateIpForwardingRuleOpts) *CreateIpForwardingRuleParams
+	NewDeleteIpForwardingRuleParams(id string, opts ...func(*DeleteIpForwardingRuleParams)) *DeleteIpForwardingRuleParams
+	NewDisableStaticNatParams(ipaddressid string, opts ...func(*DisableStaticNatParams)) *DisableStaticNatParams
+	NewEnableStaticNatParams(ipaddressid string, virtualmachineid string, opts ...func(*EnableStaticNatParams)) *EnableStaticNatParams
+	NewListIpForwardingRulesParams(opts ...func(*ListIpForwardingRulesParams)) *ListIpForwardingRulesParams
+	StreamIpForwardingRules(ctx context.Context, p *ListIpForwardingRulesParams) (<-chan *IpForwardingRule, <-chan error)
+	WaitForIpForwardingRuleDeleted(ctx context.Context, id string, timeout time.Duration, opts ...OptionFunc) error
+}
+
+var _ NATServiceIface = (*NATService)(nil)
+
+// NetworkACLServiceIface contains all exported methods of NetworkACLService, so callers can depend on it to mock the service
+type NetworkACLServiceIface interface {
+	CreateNetworkACL(p *CreateNetworkACLParams, opts ...OptionFunc) (*CreateNetworkACLResponse, error)
+	CreateNetworkACLList(p *CreateNetworkACLListParams, opts ...OptionFunc) (*CreateNetworkACLListResponse, error)
+	CreateNetworkACLListRaw(v url.Values) (*CreateNetworkACLListResponse, error)
+	CreateNetworkACLListRawWithContext(ctx context.Context, v url.Values) (*CreateNetworkACLListResponse, error)
+	CreateNetworkACLListWithContext(ctx context.Context, p *CreateNetworkACLListParams, opts ...OptionFunc) (*CreateNetworkACLListResponse, error)
+	CreateNetworkACLRaw(v url.Values) (*CreateNetworkACLResponse, error)
+	CreateNetworkACLRawWithContext(ctx context.Context, v url.Values) (*CreateNetworkACLResponse, error)
+	CreateNetworkACLWithContext(ctx context.Context, p *CreateNetworkACLParams, opts ...OptionFunc) (*CreateNetworkACLResponse, error)
+	DeleteNetworkACL(p *DeleteNetworkACLParams, opts ...OptionFunc) (*DeleteNetworkACLResponse, error)
+	DeleteNetworkACLList(p *DeleteNetworkACLListParams, opts ...OptionFunc) (*DeleteNetworkACLListResponse, error)
+	DeleteNetworkACLListRaw(v url.Values) (*DeleteNetworkACLListResponse, error)
+	DeleteNetworkACLListRawWithContext(ctx context.Context, v url.Values) (*DeleteNetworkACLListResponse, error)
+	DeleteNetworkACLListWithContext(ctx context.Context, p *DeleteNetworkACLListParams, opts ...OptionFunc) (*DeleteNetworkACLListResponse, error)
+	DeleteNetworkACLRaw(v url.Values) (*DeleteNetworkACLResponse, error)
+	DeleteNetworkACLRawWithContext(ctx context.Context, v url.Values) (*DeleteNetworkACLResponse, error)
+	DeleteNetworkACLWithContext(ctx context.Context, p *DeleteNetworkACLParams, opts ...OptionFunc) (*DeleteNetworkACLResponse, error)
+	GetNetworkACLByID(id string, opts ...OptionFunc) (*NetworkACL, int, error)
+	GetNetworkACLListByID(id string, opts ...OptionFunc) (*NetworkACLList, int, error)
+	GetNetworkACLListByName(name string, opts ...OptionFunc) (*NetworkACLList, int, error)
+	GetNetworkACLListID(name string, opts ...OptionFunc) (string, int, error)
+	ListAllNetworkACLLists(p *ListNetworkACLListsParams) ([]*NetworkACLList, error)
+	ListAllNetworkACLs(p *ListNetworkACLsParams) ([]*NetworkACL, error)
+	ListNetworkACLLists(p *ListNetworkACLListsParams) (*ListNetworkACLListsResponse, error)
+	ListNetworkACLListsRaw(v url.Values) (*ListNetworkACLListsResponse, error)
+	ListNetworkACLListsRawWithContext(ctx context.Context, v url.Values) (*ListNetworkACLListsResponse, error)
+	ListNetworkACLListsWhere(p *ListNetworkACLListsParams, pred func(*NetworkACLList) bool) ([]*NetworkACLList, error)
+	ListNetworkACLListsWithContext(ctx context.Context, p *ListNetworkACLListsParams) (*ListNetworkACLListsResponse, error)
+	ListNetworkACLs(p *ListNetworkACLsParams) (*ListNetworkACLsResponse, error)
+	ListNetworkACLsRaw(v url.Values) (*ListNetworkACLsResponse, error)
+	ListNetworkACLsRawWithContext(ctx context.Context, v url.Values) (*ListNetworkACLsResponse, error)
+	ListNetworkACLsWhere(p *ListNetworkACLsParams, pred func(*NetworkACL) bool) ([]*NetworkACL, error)
+	ListNetworkACLsWithContext(ctx context.Context, p *ListNetworkACLsParams) (*ListNetworkACLsResponse, error)
+	NetworkACLExists(id string, opts ...OptionFunc) (bool, error)
+	NetworkACLListExists(id string, opts ...OptionFunc) (bool, error)
+	NewCreateNetworkACLListParams(name string, vpcid string, opts ...func(*CreateNetworkACLListParams)) *CreateNetworkACLListParams
+	NewCreateNetworkACLParams(protocol string, opts ...func(*CreateNetworkACLParams)) *CreateNetworkACLParams
+	NewDeleteNetworkACLListParams(id string, opts ...func(*DeleteNetworkACLListParams)) *DeleteNetworkACLListParams
+	NewDeleteNetworkACLParams(id string, opts ...func(*DeleteNetworkACLParams)) *DeleteNetworkACLParams
+	NewListNetworkACLListsParams(opts ...func(*ListNetworkACLListsParams)) *ListNetworkACLListsParams
+	NewListNetworkACLsParams(opts ...func(*ListNetworkACLsParams)) *ListNetworkACLsParams
+	NewReplaceNetworkACLListParams(aclid string, opts ...func(*ReplaceNetworkACLListParams)) *ReplaceNetworkACLListParams
+	NewUpdateNetworkACLItemParams(id string, opts ...func(*UpdateNetworkACLItemParams)) *UpdateNetworkACLItemParams
+	NewUpdateNetworkACLListParams(id string, opts ...func(*UpdateNetworkACLListParams)) *UpdateNetworkACLListParams
+	ReplaceNetworkACLList(p *ReplaceNetworkACLListParams, opts ...OptionFunc) (*ReplaceNetworkACLListResponse, error)
+	ReplaceNetworkACLListRaw(v url.Values) (*ReplaceNetworkACLListResponse, error)
+	ReplaceNetworkACLListRawWithContext(ctx context.Context, v url.Values) (*ReplaceNetworkACLListResponse, error)
+	ReplaceNetworkACLListWithContext(ctx context.Context, p *ReplaceNetworkACLListParams, opts ...OptionFunc) (*ReplaceNetworkACLListResponse, error)
+	StreamNetworkACLLists(ctx context.Context, p *ListNetworkACLListsParams) (<-chan *NetworkACLList, <-chan error)
+	StreamNetworkACLs(ctx context.Context, p *ListNetworkACLsParams) (<-chan *NetworkACL, <-chan error)
+	UpdateNetworkACLItem(p *UpdateNetworkACLItemParams, opts ...OptionFunc) (*UpdateNetworkACLItemResponse, error)
+	UpdateNetworkACLItemRaw(v url.Values) (*UpdateNetworkACLItemResponse, error)
+	UpdateNetworkACLItemRawWithContext(ctx context.Context, v url.Values) (*UpdateNetworkACLItemResponse, error)
+	UpdateNetworkACLItemWithContext(ctx context.Context, p *UpdateNetworkACLItemParams, opts ...OptionFunc) (*UpdateNetworkACLItemResponse, error)
+	UpdateNetworkACLList(p *UpdateNetworkACLListParams, opts ...OptionFunc) (*UpdateNetworkACLListResponse, error)
+	UpdateNetworkACLListRaw(v url.Values) (*UpdateNetworkACLListResponse, error)
+	UpdateNetworkACLListRawWithContext(ctx context.Context, v url.Values) (*UpdateNetworkACLListResponse, error)
+	UpdateNetworkACLListWithContext(ctx context.Context, p *UpdateNetworkACLListParams, opts ...OptionFunc) (*UpdateNetworkACLListResponse, error)
+	WaitForNetworkACLDeleted(ctx context.Context, id string, timeout time.Duration, opts ...OptionFunc) error
+	WaitForNetworkACLListDeleted(ctx context.Context, id string, timeout time.Duration, opts ...OptionFunc) error
+}
+
+var _ NetworkACLServiceIface = (*NetworkACLService)(nil)
+
+// NetworkDeviceServiceIface contains all exported methods of NetworkDeviceService, so callers can depend on it to mock the service
+type NetworkDeviceServiceIface interface {
+	AddNetworkDevice(p *AddNetworkDeviceParams, opts ...OptionFunc) (*AddNetworkDeviceResponse, error)
+	AddNetworkDeviceRaw(v url.Values) (*AddNetworkDeviceResponse, error)
+	AddNetworkDeviceRawWithContext(ctx context.Context, v url.Values) (*AddNetworkDeviceResponse, error)
+	AddNetworkDeviceWithContext(ctx context.Context, p *AddNetworkDeviceParams, opts ...OptionFunc) (*AddNetworkDeviceResponse, error)
+	DeleteNetworkDevice(p *DeleteNetworkDeviceParams, opts ...OptionFunc) (*DeleteNetworkDeviceResponse, error)
+	DeleteNetworkDeviceRaw(v url.Values) (*DeleteNetworkDeviceResponse, error)
+	DeleteNetworkDeviceRawWithContext(ctx context.Context, v url.Values) (*DeleteNetworkDeviceResponse, error)
+	DeleteNetworkDeviceWithContext(ctx context.Context, p *DeleteNetworkDeviceParams, opts ...OptionFunc) (*DeleteNetworkDeviceResponse, error)
+	ListAllNetworkDevice(p *ListNetworkDeviceParams) ([]*NetworkDevice, error)
+	ListNetworkDevice(p *ListNetworkDeviceParams) (*ListNetworkDeviceResponse, error)
+	ListNetworkDeviceRaw(v url.Values) (*ListNetworkDeviceResponse, error)
+	ListNetworkDeviceRawWithContext(ctx context.Context, v url.Values) (*ListNetworkDeviceResponse, error)
+	ListNetworkDeviceWhere(p *ListNetworkDeviceParams, pred func(*NetworkDevice) bool) ([]*NetworkDevice, error)
+	ListNetworkDeviceWithContext(ctx context.Context, p *ListNetworkDeviceParams) (*ListNetworkDeviceResponse, error)
+	NewAddNetworkDeviceParams(opts ...func(*AddNetworkDeviceParams)) *AddNetworkDeviceParams
+	NewDeleteNetworkDeviceParams(id string, opts ...func(*DeleteNetworkDeviceParams)) *DeleteNetworkDeviceParams
+	NewListNetworkDeviceParams(opts ...func(*ListNetworkDeviceParams)) *ListNetworkDeviceParams
+	StreamNetworkDevice(ctx context.Context, p *ListNetworkDeviceParams) (<-chan *NetworkDevice, <-chan error)
+}
+
+var _ NetworkDeviceServiceIface = (*NetworkDeviceService)(nil)
+
+// NetworkOfferingServiceIface contains all exported methods of NetworkOfferingService, so callers can depend on it to mock the service
+type NetworkOfferingServiceIface interface {
+	CreateNetworkOffering(p *CreateNetworkOfferingParams, opts ...OptionFunc) (*CreateNetworkOfferingResponse, error)
+	CreateNetworkOfferingRaw(v url.Values) (*CreateNetworkOfferingResponse, error)
+	CreateNetworkOfferingRawWithContext(ctx context.Context, v url.Values) (*CreateNetworkOfferingResponse, error)
+	CreateNetworkOfferingWithContext(ctx context.Context, p *CreateNetworkOfferingParams, opts ...OptionFunc) (*CreateNetworkOfferingResponse, error)
+	DeleteNetworkOffering(p *DeleteNetworkOfferingParams, opts ...OptionFunc) (*DeleteNetworkOfferingResponse, error)
+	DeleteNetworkOfferingRaw(v url.Values) (*DeleteNetworkOfferingResponse, error)
+	DeleteNetworkOfferingRawWithContext(ctx context.Context, v url.Values) (*DeleteNetworkOfferingResponse, error)
+	DeleteNetworkOfferingWithContext(ctx context.Context, p *DeleteNetworkOfferingParams, opts ...OptionFunc) (*DeleteNetworkOfferingResponse, error)
+	GetNetworkOfferingByID(id string, opts ...OptionFunc) (*NetworkOffering, int, error)
+	GetNetworkOfferingByName(name string, opts ...OptionFunc) (*NetworkOffering, int, error)
+	GetNetworkOfferingID(name string, opts ...OptionFunc) (string, int, error)
+	ListAllNetworkOfferings(p *ListNetworkOfferingsParams) ([]*NetworkOffering, error)
+	ListNetworkOfferings(p *ListNetworkOfferingsParams) (*ListNetworkOfferingsResponse, error)
+	ListNetworkOfferingsRaw(v url.Values) (*ListNetworkOfferingsResponse, error)
+	ListNetworkOfferingsRawWithContext(ctx context.Context, v url.Values) (*ListNetworkOfferingsResponse, error)
+	ListNetworkOfferingsWhere(p *ListNetworkOfferingsParams, pred func(*NetworkOffering) bool) ([]*NetworkOffering, error)
+	ListNetworkOfferingsWithContext(ctx context.Context, p *ListNetworkOfferingsParams) (*ListNetworkOfferingsResponse, error)
+	NetworkOfferingExists(id string, opts ...OptionFunc) (bool, error)
+	NewCreateNetworkOfferingParams(displaytext string, guestiptype string, name string, supportedservices []string, traffictype string, opts ...func(*CreateNetworkOfferingParams)) *CreateNetworkOfferingParams
+	NewCreateNetworkOfferingParamsFromOpts(o CreateNetworkOfferingOpts) *CreateNetworkOfferingParams
+	NewDeleteNetworkOfferingParams(id string, opts ...func(*DeleteNetworkOfferingParams)) *DeleteNetworkOfferingParams
+	NewListNetworkOfferingsParams(opts ...func(*ListNetworkOfferingsParams)) *ListNetworkOfferingsParams
+	NewUpdateNetworkOfferingParams(opts ...func(*UpdateNetworkOfferingParams)) *UpdateNetworkOfferingParams
+	StreamNetworkOfferings(ctx context.Context, p *ListNetworkOfferingsParams) (<-chan *NetworkOffering, <-chan error)
+	UpdateMany(ids []string, mutate func(*UpdateNetworkOfferingParams), concurrency int, opts ...OptionFunc) (BulkResult[*UpdateNetworkOfferingResponse], error)
+	UpdateNetworkOffering(p *UpdateNetworkOfferingParams, opts ...OptionFunc) (*UpdateNetworkOfferingResponse, error)
+	UpdateNetworkOfferingRaw(v url.Values) (*UpdateNetworkOfferingResponse, error)
+	UpdateNetworkOfferingRawWithContext(ctx context.Context, v url.Values) (*UpdateNetworkOfferingResponse, error)
+	UpdateNetworkOfferingWithContext(ctx context.Context, p *UpdateNetworkOfferingParams, opts ...OptionFunc) (*UpdateNetworkOfferingResponse, error)
+	WaitForNetworkOfferingDeleted(ctx context.Context, id string, timeout time.Duration, opts ...OptionFunc) error
+}
+
+var _ NetworkOfferingServiceIface = (*NetworkOfferingService)(nil)
+
+// NetworkServiceIface contains all exported methods of NetworkService, so callers can depend on it to mock the service
+type NetworkServiceIface interface {
+	AddNetworkServiceProvider(p *AddNetworkServiceProviderParams, opts ...OptionFunc) (*AddNetworkServiceProviderResponse, error)
+	AddNetworkServiceProviderRaw(v url.Values) (*AddNetworkServiceProviderResponse, error)
+	AddNetworkServiceProviderRawWithContext(ctx context.Context, v url.Values) (*AddNetworkServiceProviderResponse, error)
+	AddNetworkServiceProviderWithContext(ctx context.Context, p *AddNetworkServiceProviderParams, opts ...OptionFunc) (*AddNetworkServiceProviderResponse, error)
+	AddOpenDaylightController(p *AddOpenDaylightControllerParams, opts ...OptionFunc) (*AddOpenDaylightControllerResponse, error)
+	AddOpenDaylightControllerRaw(v url.Values) (*AddOpenDaylightControllerResponse, error)
+	AddOpenDaylightControllerRawWithContext(ctx context.Context, v url.Values) (*AddOpenDaylightControllerResponse, error)
+	AddOpenDaylightControllerWithContext(ctx context.Context, p *AddOpenDaylightControllerParams, opts ...OptionFunc) (*AddOpenDaylightControllerResponse, error)
+	CreateNetwork(p *CreateNetworkParams, opts ...OptionFunc) (*CreateNetworkResponse, error)
+	CreateNetworkAndWaitImplemented(p *CreateNetworkParams, timeout time.Duration, opts ...OptionFunc) (*Network, error)
+	CreateNetworkRaw(v url.Values) (*CreateNetworkResponse, error)
+	CreateNetworkRawWithContext(ctx context.Context, v url.Values) (*CreateNetworkResponse, error)
+	CreateNetworkWithContext(ctx context.Context, p *CreateNetworkParams, opts ...OptionFunc) (*CreateNetworkResponse, error)
+	CreatePhysicalNetwork(p *CreatePhysicalNetworkParams, opts ...OptionFunc) (*CreatePhysicalNetworkResponse, error)
+	CreatePhysicalNetworkRaw(v url.Values) (*CreatePhysicalNetworkResponse, error)
+	CreatePhysicalNetworkRawWithContext(ctx context.Context, v url.Values) (*CreatePhysicalNetworkResponse, error)
+	CreatePhysicalNetworkWithContext(ctx context.Context, p *CreatePhysicalNetworkParams, opts ...OptionFunc) (*CreatePhysicalNetworkResponse, error)
+	CreateServiceInstance(p *CreateServiceInstanceParams, opts ...OptionFunc) (*CreateServiceInstanceResponse, error)
+	CreateServiceInstanceRaw(v url.Values) (*CreateServiceInstanceResponse, error)
+	CreateServiceInstanceRawWithContext(ctx context.Context, v url.Values) (*CreateServiceInstanceResponse, error)
+	CreateServiceInstanceWithContext(ctx context.Context, p *CreateServiceInstanceParams, opts ...OptionFunc) (*CreateServiceInstanceResponse, error)
+	CreateStorageNetworkIpRange(p *CreateStorageNetworkIpRangeParams, opts ...OptionFunc) (*CreateStorageNetworkIpRangeResponse, error)
+	CreateStorageNetworkIpRangeRaw(v url.Values) (*CreateStorageNetworkIpRangeResponse, error)
+	CreateStorageNetworkIpRangeRawWithContext(ctx context.Context, v url.Values) (*CreateStorageNetworkIpRangeResponse, error)
+	CreateStorageNetworkIpRangeWithContext(ctx context.Context, p *CreateStorageNetworkIpRangeParams, opts ...OptionFunc) (*CreateStorageNetworkIpRangeResponse, error)
+	DedicatePublicIpRange(p *DedicatePublicIpRangeParams, opts ...OptionFunc) (*DedicatePublicIpRangeResponse, error)
+	DedicatePublicIpRangeRaw(v url.Values) (*DedicatePublicIpRangeResponse, error)
+	DedicatePublicIpRangeRawWithContext(ctx context.Context, v url.Values) (*DedicatePublicIpRangeResponse, error)
+	DedicatePublicIpRangeWithContext(ctx context.Context, p *DedicatePublicIpRangeParams, opts ...OptionFunc) (*DedicatePublicIpRangeResponse, error)
+	DeleteNetwork(p *DeleteNetworkParams, opts ...OptionFunc) (*DeleteNetworkResponse, error)
+	DeleteNetworkRaw(v url.Values) (*DeleteNetworkResponse, error)
+	DeleteNetworkRawWithContext(ctx context.Context, v url.Values) (*DeleteNetworkResponse, error)
+	DeleteNetworkServiceProvider(p *DeleteNetworkServiceProviderParams, opts ...OptionFunc) (*DeleteNetworkServiceProviderResponse, error)
+	DeleteNetworkServiceProviderRaw(v url.Values) (*DeleteNetworkServiceProviderResponse, error)
+	DeleteNetworkServiceProviderRawWithContext(ctx context.Context, v url.Values) (*DeleteNetworkServiceProviderResponse, error)
+	DeleteNetworkServiceProviderWithContext(ctx context.Context, p *DeleteNetworkServiceProviderParams, opts ...OptionFunc) (*DeleteNetworkServiceProviderResponse, error)
+	DeleteNetworkWithContext(ctx context.Context, p *DeleteNetworkParams, opts ...OptionFunc) (*DeleteNetworkResponse, error)
+	DeleteOpenDaylightController(p *DeleteOpenDaylightControllerParams, opts ...OptionFunc) (*DeleteOpenDaylightControllerResponse, error)
+	DeleteOpenDaylightControllerRaw(v url.Values) (*DeleteOpenDaylightControllerResponse, error)
+	DeleteOpenDaylightControllerRawWithContext(ctx context.Context, v url.Values) (*DeleteOpenDaylightControllerResponse, error)
+	DeleteOpenDaylightControllerWithContext(ctx context.Context, p *DeleteOpenDaylightControllerParams, opts ...OptionFunc) (*DeleteOpenDaylightControllerResponse, error)
+	DeletePhysicalNetwork(p *DeletePhysicalNetworkParams, opts ...OptionFunc) (*DeletePhysicalNetworkResponse, error)
+	DeletePhysicalNetworkRaw(v url.Values) (*DeletePhysicalNetworkResponse, error)
+	DeletePhysicalNetworkRawWithContext(ctx context.Context, v url.Values) (*DeletePhysicalNetworkResponse, error)
+	DeletePhysicalNetworkWithContext(ctx context.Context, p *DeletePhysicalNetworkParams, opts ...OptionFunc) (*DeletePhysicalNetworkResponse, error)
+	DeleteStorageNetworkIpRange(p *DeleteStorageNetworkIpRangeParams, opts ...OptionFunc) (*DeleteStorageNetworkIpRangeResponse, error)
+	DeleteStorageNetworkIpRangeRaw(v url.Values) (*DeleteStorageNetworkIpRangeResponse, error)
+	DeleteStorageNetworkIpRangeRawWithContext(ctx context.Context, v url.Values) (*DeleteStorageNetworkIpRangeResponse, error)
+	DeleteStorageNetworkIpRangeWithContext(ctx context.Context, p *DeleteStorageNetworkIpRangeParams, opts ...OptionFunc) (*DeleteStorageNetworkIpRangeResponse, error)
+	GetF5LoadBalancerNetworkID(keyword string, lbdeviceid string, opts ...OptionFunc) (string, int, error)
+	GetNetscalerLoadBalancerNetworkID(keyword string, lbdeviceid string, opts ...OptionFunc) (string, int, error)
+	GetNetworkByID(id string, opts ...OptionFunc) (*Network, int, error)
+	GetNetworkByName(name string, opts ...OptionFunc) (*Network, int, error)
+	GetNetworkID(keyword string, opts ...OptionFunc) (string, int, error)
+	GetNetworkServiceProviderID(name string, opts ...OptionFunc) (string, int, error)
+	GetNiciraNvpDeviceNetworkID(keyword string, nvpdeviceid string, opts ...OptionFunc) (string, int, error)
+	GetOpenDaylightControllerByID(id string, opts ...OptionFunc) (*OpenDaylightController, int, error)
+	GetPaloAltoFirewallNetworkID(keyword string, lbdeviceid string, opts ...OptionFunc) (string, int, error)
+	GetPhysicalNetworkByID(id string, opts ...OptionFunc) (*PhysicalNetwork, int, error)
+	GetPhysicalNetworkByName(name string, opts ...OptionFunc) (*PhysicalNetwork, int, error)
+	GetPhysicalNetworkID(name string, opts ...OptionFunc) (string, int, error)
+	GetSrxFirewallNetworkID(keyword string, lbdeviceid string, opts ...OptionFunc) (string, int, error)
+	GetStorageNetworkIpRangeByID(id string, opts ...OptionFunc) (*StorageNetworkIpRange, int, error)
+	ListAllF5LoadBalancerNetworks(p *ListF5LoadBalancerNetworksParams) ([]*F5LoadBalancerNetwork, error)
+	ListAllNetscalerLoadBalancerNetworks(p *ListNetscalerLoadBalancerNetworksParams) ([]*NetscalerLoadBalancerNetwork, error)
+	ListAllNetworkIsolationMethods(p *ListNetworkIsolationMethodsParams) ([]*NetworkIsolationMethod, error)
+	ListAllNetworkServiceProviders(p *ListNetworkServiceProvidersParams) ([]*NetworkServiceProvider, error)
+	ListAllNetworks(p *ListNetworksParams) ([]*Network, error)
+	ListAllNiciraNvpDeviceNetworks(p *ListNiciraNvpDeviceNetworksParams) ([]*NiciraNvpDeviceNetwork, error)
+	ListAllPaloAltoFirewallNetworks(p *ListPaloAltoFirewallNetworksParams) ([]*PaloAltoFirewallNetwork, error)
+	ListAllPhysicalNetworks(p *ListPhysicalNetworksParams) ([]*PhysicalNetwork, error)
+	ListAllSrxFirewallNetworks(p *ListSrxFirewallNetworksParams) ([]*SrxFirewallNetwork, error)
+	ListAllStorageNetworkIpRange(p *ListStorageNetworkIpRangeParams) ([]*StorageNetworkIpRange, error)
+	ListAllSupportedNetworkServices(p *ListSupportedNetworkServicesParams) ([]*SupportedNetworkService, error)
+	ListF5LoadBalancerNetworks(p *ListF5LoadBalancerNetworksParams) (*ListF5LoadBalancerNetworksResponse, error)
+	ListF5LoadBalancerNetworksRaw(v url.Values) (*ListF5LoadBalancerNetworksResponse, error)
+	ListF5LoadBalancerNetworksRawWithContext(ctx context.Context, v url.Values) (*ListF5LoadBalancerNetworksResponse, error)
+	ListF5LoadBalancerNetworksWhere(p *ListF5LoadBalancerNetworksParams, pred func(*F5LoadBalancerNetwork) bool) ([]*F5LoadBalancerNetwork, error)
+	ListF5LoadBalancerNetworksWithContext(ctx context.Context, p *ListF5LoadBalancerNetworksParams) (*ListF5LoadBalancerNetworksResponse, error)
+	ListNetscalerLoadBalancerNetworks(p *ListNetscalerLoadBalancerNetworksParams) (*ListNetscalerLoadBalancerNetworksResponse, error)
+	ListNetscalerLoadBalancerNetworksRaw(v url.Values) (*ListNetscalerLoadBalancerNetworksResponse, error)
+	ListNetscalerLoadBalancerNetworksRawWithContext(ctx context.Context, v url.Values) (*ListNetscalerLoadBalancerNetworksResponse, error)
+	ListNetscalerLoadBalancerNetworksWhere(p *ListNetscalerLoadBalancerNetworksParams, pred func(*NetscalerLoadBalancerNetwork) bool) ([]*NetscalerLoadBalancerNetwork, error)
+	ListNetscalerLoadBalancerNetworksWithContext(ctx context.Context, p *ListNetscalerLoadBalancerNetworksParams) (*ListNetscalerLoadBalancerNetworksResponse, error)
+	ListNetworkIsolationMethods(p *ListNetworkIsolationMethodsParams) (*ListNetworkIsolationMethodsResponse, error)
+	ListNetworkIsolationMethodsRaw(v url.Values) (*ListNetworkIsolationMethodsResponse, error)
+	ListNetworkIsolationMethodsRawWithContext(ctx context.Context, v url.Values) (*ListNetworkIsolationMethodsResponse, error)
+	ListNetworkIsolationMethodsWhere(p *ListNetworkIsolationMethodsParams, pred func(*NetworkIsolationMethod) bool) ([]*NetworkIsolationMethod, error)
+	ListNetworkIsolationMethodsWithContext(ctx context.Context, p *ListNetworkIsolationMethodsParams) (*ListNetworkIsolationMethodsResponse, error)
+	ListNetworkServiceProviders(p *ListNetworkServiceProvidersParams) (*ListNetworkServiceProvidersResponse, error)
+	ListNetworkServiceProvidersRaw(v url.Values) (*ListNetworkServiceProvidersResponse, error)
+	ListNetworkServiceProvidersRawWithContext(ctx context.Context, v url.Values) (*ListNetworkServiceProvidersResponse, error)
+	ListNetworkServiceProvidersWhere(p *ListNetworkServiceProvidersParams, pred func(*NetworkServiceProvider) bool) ([]*NetworkServiceProvider, error)
+	ListNetworkServiceProvidersWithContext(ctx context.Context, p *ListNetworkServiceProvidersParams) (*ListNetworkServiceProvidersResponse, error)
+	ListNetworks(p *ListNetworksParams) (*ListNetworksResponse, error)
+	ListNetworksRaw(v url.Values) (*ListNetworksResponse, error)
+	ListNetworksRawWithContext(ctx context.Context, v url.Values) (*ListNetworksResponse, error)
+	ListNetworksWhere(p *ListNetworksParams, pred func(*Network) bool) ([]*Network, error)
+	ListNetworksWithContext(ctx context.Context, p *ListNetworksParams) (*ListNetworksResponse, error)
+	ListNiciraNvpDeviceNetworks(p *ListNiciraNvpDeviceNetworksParams) (*ListNiciraNvpDeviceNetworksResponse, error)
+	ListNiciraNvpDeviceNetworksRaw(v url.Values) (*ListNiciraNvpDeviceNetworksResponse, error)
+	ListNiciraNvpDeviceNetworksRawWithContext(ctx context.Context, v url.Values) (*ListNiciraNvpDeviceNetworksResponse, error)
+	ListNiciraNvpDeviceNetworksWhere(p *ListNiciraNvpDeviceNetworksParams, pred func(*NiciraNvpDeviceNetwork) bool) ([]*NiciraNvpDeviceNetwork, error)
+	ListNiciraNvpDeviceNetworksWithContext(ctx context.Context, p *ListNiciraNvpDeviceNetworksParams) (*ListNiciraNvpDeviceNetworksResponse, error)
+	ListOpenDaylightControllers(p *ListOpenDaylightControllersParams) (*ListOpenDaylightControllersResponse, error)
+	ListOpenDaylightControllersRaw(v url.Values) (*ListOpenDaylightControllersResponse, error)
+	ListOpenDaylightControllersRawWithContext(ctx context.Context, v url.Values) (*ListOpenDaylightControllersResponse, error)
+	ListOpenDaylightControllersWhere(p *ListOpenDaylightControllersParams, pred func(*OpenDaylightController) bool) ([]*OpenDaylightController, error)
+	ListOpenDaylightControllersWithContext(ctx context.Context, p *ListOpenDaylightControllersParams) (*ListOpenDaylightControllersResponse, error)
+	ListPaloAltoFirewallNetworks(p *ListPaloAltoFirewallNetworksParams) (*ListPaloAltoFirewallNetworksResponse, error)
+	ListPaloAltoFirewallNetworksRaw(v url.Values) (*ListPaloAltoFirewallNetworksResponse, error)
+	ListPaloAltoFirewallNetworksRawWithContext(ctx context.Context, v url.Values) (*ListPaloAltoFirewallNetworksResponse, error)
+	ListPaloAltoFirewallNetworksWhere(p *ListPaloAltoFirewallNetworksParams, pred func(*PaloAltoFirewallNetwork) bool) ([]*PaloAltoFirewallNetwork, error)
+	ListPaloAltoFirewallNetworksWithContext(ctx context.Context, p *ListPaloAltoFirewallNetworksParams) (*ListPaloAltoFirewallNetworksResponse, error)
+	ListPhysicalNetworks(p *ListPhysicalNetworksParams) (*ListPhysicalNetworksResponse, error)
+	ListPhysicalNetworksRaw(v url.Values) (*ListPhysicalNetworksResponse, error)
+	ListPhysicalNetworksRawWithContext(ctx context.Context, v url.Values) (*ListPhysicalNetworksResponse, error)
+	ListPhysicalNetworksWhere(p *ListPhysicalNetworksParams, pred func(*PhysicalNetwork) bool) ([]*PhysicalNetwork, error)
+	ListPhysicalNetworksWithContext(ctx context.Context, p *ListPhysicalNetworksParams) (*ListPhysicalNetworksResponse, error)
+	ListSrxFirewallNetworks(p *ListSrxFirewallNetworksParams) (*ListSrxFirewallNetworksResponse, error)
+	ListSrxFirewallNetworksRaw(v url.Values) (*ListSrxFirewallNetworksResponse, error)
+	ListSrxFirewallNetworksRawWithContext(ctx context.Context, v url.Values) (*ListSrxFirewallNetworksResponse, error)
+	ListSrxFirewallNetworksWhere(p *ListSrxFirewallNetworksParams, pred func(*SrxFirewallNetwork) bool) ([]*SrxFirewallNetwork, error)
+	ListSrxFirewallNetworksWithContext(ctx context.Context, p *ListSrxFirewallNetworksParams) (*ListSrxFirewallNetworksResponse, error)
+	ListStorageNetworkIpRange(p *ListStorageNetworkIpRangeParams) (*ListStorageNetworkIpRangeResponse, error)
+	ListStorageNetworkIpRangeRaw(v url.Values) (*ListStorageNetworkIpRangeResponse, error)
+	ListStorageNetworkIpRangeRawWithContext(ctx context.Context, v url.Values) (*ListStorageNetworkIpRangeResponse, error)
+	ListStorageNetworkIpRangeWhere(p *ListStorageNetworkIpRangeParams, pred func(*StorageNetworkIpRange) bool) ([]*StorageNetworkIpRange, error)
+	ListStorageNetworkIpRangeWithContext(ctx context.Context, p *ListStorageNetworkIpRangeParams) (*ListStorageNetworkIpRangeResponse, error)
+	ListSupportedNetworkServices(p *ListSupportedNetworkServicesParams) (*ListSupportedNetworkServicesResponse, error)
+	ListSupportedNetworkServicesRaw(v url.Values) (*ListSupportedNetworkServicesResponse, error)
+	ListSupportedNetworkServicesRawWithContext(ctx context.Context, v url.Values) (*ListSupportedNetworkServicesResponse, error)
+	ListSupportedNetworkServicesWhere(p *ListSupportedNetworkServicesParams, pred func(*SupportedNetworkService) bool) ([]*SupportedNetworkService, error)
+	ListSupportedNetworkServicesWithContext(ctx context.Context, p *ListSupportedNetworkServicesParams) (*ListSupportedNetworkServicesResponse, error)
+	NetworkExists(id string, opts ...OptionFunc) (bool, error)
+	NewAddNetworkServiceProviderParams(name string, physicalnetworkid string, opts ...func(*AddNetworkServiceProviderParams)) *AddNetworkServiceProviderParams
+	NewAddOpenDaylightControllerParams(password string, physicalnetworkid string, url string, username string, opts ...func(*AddOpenDaylightControllerParams)) *AddOpenDaylightControllerParams
+	NewAddOpenDaylightControllerParamsFromOpts(o AddOpenDaylightControllerOpts) *AddOpenDaylightControllerParams
+	NewCreateNetworkParams(displaytext string, name string, networkofferingid string, zoneid string, opts ...func(*CreateNetworkParams)) *CreateNetworkParams
+	NewCreateNetworkParamsFromOpts(o CreateNetworkOpts) *CreateNetworkParams
+	NewCreatePhysicalNetworkParams(name string, zoneid string, opts ...func(*CreatePhysicalNetworkParams)) *CreatePhysicalNetworkParams
+	NewCreateServiceInstanceParams(leftnetworkid string, name string, rightnetworkid string, serviceofferingid string, templateid string, zoneid string, opts ...func(*CreateServiceInstanceParams)) *CreateServiceInstanceParams
+	NewCreateServiceInstanceParamsFromOpts(o CreateServiceInstanceOpts) *CreateServiceInstanceParams
+	NewCreateStorageNetworkIpRangeParams(gateway string, netmask string, podid string, startip string, opts ...func(*CreateStorageNetworkIpRangeParams)) *CreateStorageNetworkIpRangeParams
+	NewCreateStorageNetworkIpRangeParamsFromOpts(o CreateStorageNetworkIpRangeOpts) *CreateStorageNetworkIpRangeParams
+	NewDedicatePublicIpRangeParams(domainid string, id string, opts ...func(*DedicatePublicIpRangeParams)) *DedicatePublicIpRangeParams
+	NewDeleteNetworkParams(id string, opts ...func(*DeleteNetworkParams)) *DeleteNetworkParams
+	NewDeleteNetworkServiceProviderParams(id string, opts ...func(*DeleteNetworkServiceProviderParams)) *DeleteNetworkServiceProviderParams
+	NewDeleteOpenDaylightControllerParams(id string, opts ...func(*DeleteOpenDaylightControllerParams)) *DeleteOpenDaylightControllerParams
+	NewDeletePhysicalNetworkParams(id string, opts ...func(*DeletePhysicalNetworkParams)) *DeletePhysicalNetworkParams
+	NewDeleteStorageNetworkIpRangeParams(id string, opts ...func(*DeleteStorageNetworkIpRangeParams)) *DeleteStorageNetworkIpRangeParams
+	NewListF5LoadBalancerNetworksParams(lbdeviceid string, opts ...func(*ListF5LoadBalancerNetworksParams)) *ListF5LoadBalancerNetworksParams
+	NewListNetscalerLoadBalancerNetworksParams(lbdeviceid string, opts ...func(*ListNetscalerLoadBalancerNetworksParams)) *ListNetscalerLoadBalancerNetworksParams
+	NewListNetworkIsolationMethodsParams(opts ...func(*ListNetworkIsolationMethodsParams)) *ListNetworkIsolationMethodsParams
+	NewListNetworkServiceProvidersParams(opts ...func(*ListNetworkServiceProvidersParams)) *ListNetworkServiceProvidersParams
+	NewListNetworksParams(opts ...func(*ListNetworksParams)) *ListNetworksParams
+	NewListNiciraNvpDeviceNetworksParams(nvpdeviceid string, opts ...func(*ListNiciraNvpDeviceNetworksParams)) *ListNiciraNvpDeviceNetworksParams
+	NewListOpenDaylightControllersParams(opts ...func(*ListOpenDaylightControllersParams)) *ListOpenDaylightControllersParams
+	NewListPaloAltoFirewallNetworksParams(lbdeviceid string, opts ...func(*ListPaloAltoFirewallNetworksParams)) *ListPaloAltoFirewallNetworksParams
+	NewListPhysicalNetworksParams(opts ...func(*ListPhysicalNetworksParams)) *ListPhysicalNetworksParams
+	NewListSrxFirewallNetworksParams(lbdeviceid string, opts ...func(*ListSrxFirewallNetworksParams)) *ListSrxFirewallNetworksParams
+	NewListStorageNetworkIpRangeParams(opts ...func(*ListStorageNetworkIpRangeParams)) *ListStorageNetworkIpRangeParams
+	NewListSupportedNetworkServicesParams(opts ...func(*ListSupportedNetworkServicesParams)) *ListSupportedNetworkServicesParams
+	NewReleasePublicIpRangeParams(id string, opts ...func(*ReleasePublicIpRangeParams)) *ReleasePublicIpRangeParams
+	NewRestartNetworkParams(id string, opts ...func(*RestartNetworkParams)) *RestartNetworkParams
+	NewUpdateNetworkParams(id string, opts ...func(*UpdateNetworkParams)) *UpdateNetworkParams
+	NewUpdateNetworkServiceProviderParams(id string, opts ...func(*UpdateNetworkServiceProviderParams)) *UpdateNetworkServiceProviderParams
+	NewUpdatePhysicalNetworkParams(id string, opts ...func(*UpdatePhysicalNetworkParams)) *UpdatePhysicalNetworkParams
+	NewUpdateStorageNetworkIpRangeParams(id string, opts ...func(*UpdateStorageNetworkIpRangeParams)) *UpdateStorageNetworkIpRangeParams
+	OpenDaylightControllerExists(id string, opts ...OptionFunc) (bool, error)
+	PhysicalNetworkExists(id string, opts ...OptionFunc) (bool, error)
+	ReleasePublicIpRange(p *ReleasePublicIpRangeParams, opts ...OptionFunc) (*ReleasePublicIpRangeResponse, error)
+	ReleasePublicIpRangeRaw(v url.Values) (*ReleasePublicIpRangeResponse, error)
+	ReleasePublicIpRangeRawWithContext(ctx context.Context, v url.Values) (*ReleasePublicIpRangeResponse, error)
+	ReleasePublicIpRangeWithContext(ctx context.Context, p *ReleasePublicIpRangeParams, opts ...OptionFunc) (*ReleasePublicIpRangeResponse, error)
+	RestartNetwork(p *RestartNetworkParams, opts ...OptionFunc) (*RestartNetworkResponse, error)
+	RestartNetworkRaw(v url.Values) (*RestartNetworkResponse, error)
+	RestartNetworkRawWithContext(ctx context.Context, v url.Values) (*RestartNetworkResponse, error)
+	RestartNetworkWithContext(ctx context.Context, p *RestartNetworkParams, opts ...OptionFunc) (*RestartNetworkResponse, error)
+	StorageNetworkIpRangeExists(id string, opts ...OptionFunc) (bool, error)
+	StreamF5LoadBalancerNetworks(ctx context.Context, p *ListF5LoadBalancerNetworksParams) (<-chan *F5LoadBalancerNetwork, <-chan error)
+	StreamNetscalerLoadBalancerNetworks(ctx context.Context, p *ListNetscalerLoadBalancerNetworksParams) (<-chan *NetscalerLoadBalancerNetwork, <-chan error)
+	StreamNetworkIsolationMethods(ctx context.Context, p *ListNetworkIsolationMethodsParams) (<-chan *NetworkIsolationMethod, <-chan error)
+	StreamNetworkServiceProviders(ctx context.Context, p *ListNetworkServiceProvidersParams) (<-chan *NetworkServiceProvider, <-chan error)
+	StreamNetworks(ctx context.Context, p *ListNetworksParams) (<-chan *Network, <-chan error)
+	StreamNiciraNvpDeviceNetworks(ctx context.Context, p *ListNiciraNvpDeviceNetworksParams) (<-chan *NiciraNvpDeviceNetwork, <-chan error)
+	StreamPaloAltoFirewallNetworks(ctx context.Context, p *ListPaloAltoFirewallNetworksParams) (<-chan *PaloAltoFirewallNetwork, <-chan error)
+	StreamPhysicalNetworks(ctx context.Context, p *ListPhysicalNetworksParams) (<-chan *PhysicalNetwork, <-chan error)
+	StreamSrxFirewallNetworks(ctx context.Context, p *ListSrxFirewallNetworksParams) (<-chan *SrxFirewallNetwork, <-chan error)
+	StreamStorageNetworkIpRange(ctx context.Context, p *ListStorageNetworkIpRangeParams) (<-chan *StorageNetworkIpRange, <-chan error)
+	StreamSupportedNetworkServices(ctx context.Context, p *ListSupportedNetworkServicesParams) (<-chan *SupportedNetworkService, <-chan error)
+	UpdateMany(ids []string, mutate func(*UpdateNetworkParams), concurrency int, opts ...OptionFunc) (BulkResult[*UpdateNetworkResponse], error)
+	UpdateNetwork(p *UpdateNetworkParams, opts ...OptionFunc) (*UpdateNetworkResponse, error)
+	UpdateNetworkRaw(v url.Values) (*UpdateNetworkResponse, error)
+	UpdateNetworkRawWithContext(ctx context.Context, v url.Values) (*UpdateNetworkResponse, error)
+	UpdateNetworkServiceProvider(p *UpdateNetworkServiceProviderParams, opts ...OptionFunc) (*UpdateNetworkServiceProviderResponse, error)
+	UpdateNetworkServiceProviderRaw(v url.Values) (*UpdateNetworkServiceProviderResponse, error)
+	UpdateNetworkServiceProviderRawWithContext(ctx context.Context, v url.Values) (*UpdateNetworkServiceProviderResponse, error)
+	UpdateNetworkServiceProviderWithContext(ctx context.Context, p *UpdateNetworkServiceProviderParams, opts ...OptionFunc) (*UpdateNetworkServiceProviderResponse, error)
+	UpdateNetworkWithContext(ctx context.Context, p *UpdateNetworkParams, opts ...OptionFunc) (*UpdateNetworkResponse, error)
+	UpdatePhysicalNetwork(p *UpdatePhysicalNetworkParams, opts ...OptionFunc) (*UpdatePhysicalNetworkResponse, error)
+	UpdatePhysicalNetworkRaw(v url.Values) (*UpdatePhysicalNetworkResponse, error)
+	UpdatePhysicalNetworkRawWithContext(ctx context.Context, v url.Values) (*UpdatePhysicalNetworkResponse, error)
+	UpdatePhysicalNetworkWithContext(ctx context.Context, p *UpdatePhysicalNetworkParams, opts ...OptionFunc) (*UpdatePhysicalNetworkResponse, error)
+	UpdateStorageNetworkIpRange(p *UpdateStorageNetworkIpRangeParams, opts ...OptionFunc) (*UpdateStorageNetworkIpRangeResponse, error)
+	UpdateStorageNetworkIpRangeRaw(v url.Values) (*UpdateStorageNetworkIpRangeResponse, error)
+	UpdateStorageNetworkIpRangeRawWithContext(ctx context.Context, v url.Values) (*UpdateStorageNetworkIpRangeResponse, error)
+	UpdateStorageNetworkIpRangeWithContext(ctx context.Context, p *UpdateStorageNetworkIpRangeParams, opts ...OptionFunc) (*UpdateStorageNetworkIpRangeResponse, error)
+	WaitForNetworkDeleted(ctx context.Context, id string, timeout time.Duration, opts ...OptionFunc) error
+	WaitForOpenDaylightControllerDeleted(ctx context.Context, id string, timeout time.Duration, opts ...OptionFunc) error
+	WaitForPhysicalNetworkDeleted(ctx context.Context, id string, timeout time.Duration, opts ...OptionFunc) error
+	WaitForStorageNetworkIpRangeDeleted(ctx context.Context, id string, timeout time.Duration, opts ...OptionFunc) error
+}
+
+var _ NetworkServiceIface = (*NetworkService)(nil)
+
+// NicServiceIface contains all exported methods of NicService, so callers can depend on it to mock the service
+type NicServiceIface interface {
+	AddIpToNic(p *AddIpToNicParams, opts ...OptionFunc) (*AddIpToNicResponse, error)
+	AddIpToNicRaw(v url.Values) (*AddIpToNicResponse, error)
+	AddIpToNicRawWithContext(ctx context.Context, v url.Values) (*AddIpToNicResponse, error)
+	AddIpToNicWithContext(ctx context.Context, p *AddIpToNicParams, opts ...OptionFunc) (*AddIpToNicResponse, error)
+	AttachNetwork(vmid, networkid string, opts ...OptionFunc) (*VirtualMachine, error)
+	DetachNetwork(vmid, nicid string, opts ...OptionFunc) (*VirtualMachine, error)
+	ListAllNics(p *ListNicsParams) ([]*Nic, error)
+	ListNics(p *ListNicsParams) (*ListNicsResponse, error)
+	ListNicsRaw(v url.Values) (*ListNicsResponse, error)
+	ListNicsRawWithContext(ctx context.Context, v url.Values) (*ListNicsResponse, error)
+	ListNicsWhere(p *ListNicsParams, pred func(*Nic) bool) ([]*Nic, error)
+	ListNicsWithContext(ctx context.Context, p *ListNicsParams) (*ListNicsResponse, error)
+	NewAddIpToNicParams(nicid string, opts ...func(*AddIpToNicParams)) *AddIpToNicParams
+	NewListNicsParams(virtualmachineid string, opts ...func(*ListNicsParams)) *ListNicsParams
+	NewRemoveIpFromNicParams(id string, opts ...func(*RemoveIpFromNicParams)) *RemoveIpFromNicParams
+	NewUpdateVmNicIpParams(nicid string, opts ...func(*UpdateVmNicIpParams)) *UpdateVmNicIpParams
+	RemoveIpFromNic(p *RemoveIpFromNicParams, opts ...OptionFunc) (*RemoveIpFromNicResponse, error)
+	RemoveIpFromNicRaw(v url.Values) (*RemoveIpFromNicResponse, error)
+	RemoveIpFromNicRawWithContext(ctx context.Context, v url.Values) (*RemoveIpFromNicResponse, error)
+	RemoveIpFromNicWithContext(ctx context.Context, p *RemoveIpFromNicParams, opts ...OptionFunc) (*RemoveIpFromNicResponse, error)
+	StreamNics(ctx context.Context, p *ListNicsParams) (<-chan *Nic, <-chan error)
+	UpdateVmNicIp(p *UpdateVmNicIpParams, opts ...OptionFunc) (*UpdateVmNicIpResponse, error)
+	UpdateVmNicIpRaw(v url.Values) (*UpdateVmNicIpResponse, error)
+	UpdateVmNicIpRawWithContext(ctx context.Context, v url.Values) (*UpdateVmNicIpResponse, error)
+	UpdateVmNicIpWithContext(ctx context.Context, p *UpdateVmNicIpParams, opts ...OptionFunc) (*UpdateVmNicIpResponse, error)
+}
+
+var _ NicServiceIface = (*NicService)(nil)
+
+// NiciraNVPServiceIface contains all exported methods of NiciraNVPService, so callers can depend on it to mock the service
+type NiciraNVPServiceIface interface {
+	AddNiciraNvpDevice(p *AddNiciraNvpDeviceParams, opts ...OptionFunc) (*AddNiciraNvpDeviceResponse, error)
+	AddNiciraNvpDeviceRaw(v url.Values) (*AddNiciraNvpDeviceResponse, error)
+	AddNiciraNvpDeviceRawWithContext(ctx context.Context, v url.Values) (*AddNiciraNvpDeviceResponse, error)
+	AddNiciraNvpDeviceWithContext(ctx context.Context, p *AddNiciraNvpDeviceParams, opts ...OptionFunc) (*AddNiciraNvpDeviceResponse, error)
+	DeleteNiciraNvpDevice(p *DeleteNiciraNvpDeviceParams, opts ...OptionFunc) (*DeleteNiciraNvpDeviceResponse, error)
+	DeleteNiciraNvpDeviceRaw(v url.Values) (*DeleteNiciraNvpDeviceResponse, error)
+	DeleteNiciraNvpDeviceRawWithContext(ctx context.Context, v url.Values) (*DeleteNiciraNvpDeviceResponse, error)
+	DeleteNiciraNvpDeviceWithContext(ctx context.Context, p *DeleteNiciraNvpDeviceParams, opts ...OptionFunc) (*DeleteNiciraNvpDeviceResponse, error)
+	ListAllNiciraNvpDevices(p *ListNiciraNvpDevicesParams) ([]*NiciraNvpDevice, error)
+	ListNiciraNvpDevices(p *ListNiciraNvpDevicesParams) (*ListNiciraNvpDevicesResponse, error)
+	ListNiciraNvpDevicesRaw(v url.Values) (*ListNiciraNvpDevicesResponse, error)
+	ListNiciraNvpDevicesRawWithContext(ctx context.Context, v url.Values) (*ListNiciraNvpDevicesResponse, error)
+	ListNiciraNvpDevicesWhere(p *ListNiciraNvpDevicesParams, pred func(*NiciraNvpDevice) bool) ([]*NiciraNvpDevice, error)
+	ListNiciraNvpDevicesWithContext(ctx context.Context, p *ListNiciraNvpDevicesParams) (*ListNiciraNvpDevicesResponse, error)
+	NewAddNiciraNvpDeviceParams(hostname string, password string, physicalnetworkid string, transportzoneuuid string, username string, opts ...func(*AddNiciraNvpDeviceParams)) *AddNiciraNvpDeviceParams
+	NewAddNiciraNvpDeviceParamsFromOpts(o AddNiciraNvpDeviceOpts) *AddNiciraNvpDeviceParams
+	NewDeleteNiciraNvpDeviceParams(nvpdeviceid string, opts ...func(*DeleteNiciraNvpDeviceParams)) *DeleteNiciraNvpDeviceParams
+	NewListNiciraNvpDevicesParams(opts ...func(*ListNiciraNvpDevicesParams)) *ListNiciraNvpDevicesParams
+	StreamNiciraNvpDevices(ctx context.Context, p *ListNiciraNvpDevicesParams) (<-chan *NiciraNvpDevice, <-chan error)
+}
+
+var _ NiciraNVPServiceIface = (*NiciraNVPService)(nil)
+
+// NuageVSPServiceIface contains all exported methods of NuageVSPService, so callers can depend on it to mock the service
+type NuageVSPServiceIface interface {
+	AddNuageVspDevice(p *AddNuageVspDeviceParams, opts ...OptionFunc) (*AddNuageVspDeviceResponse, error)
+	AddNuageVspDeviceRaw(v url.Values) (*AddNuageVspDeviceResponse, error)
+	AddNuageVspDeviceRawWithContext(ctx context.Context, v url.Values) (*AddNuageVspDeviceResponse, error)
+	AddNuageVspDeviceWithContext(ctx context.Context, p *AddNuageVspDeviceParams, opts ...OptionFunc) (*AddNuageVspDeviceResponse, error)
+	DeleteNuageVspDevice(p *DeleteNuageVspDeviceParams, opts ...OptionFunc) (*DeleteNuageVspDeviceResponse, error)
+	DeleteNuageVspDeviceRaw(v url.Values) (*DeleteNuageVspDeviceResponse, error)
+	DeleteNuageVspDeviceRawWithContext(ctx context.Context, v url.Values) (*DeleteNuageVspDeviceResponse, error)
+	DeleteNuageVspDeviceWithContext(ctx context.Context, p *DeleteNuageVspDeviceParams, opts ...OptionFunc) (*DeleteNuageVspDeviceResponse, error)
+	ListAllNuageVspDevices(p *ListNuageVspDevicesParams) ([]*NuageVspDevice, error)
+	ListNuageVspDevices(p *ListNuageVspDevicesParams) (*ListNuageVspDevicesResponse, error)
+	ListNuageVspDevicesRaw(v url.Values) (*ListNuageVspDevicesResponse, error)
+	ListNuageVspDevicesRawWithContext(ctx context.Context, v url.Values) (*ListNuageVspDevicesResponse, error)
+	ListNuageVspDevicesWhere(p *ListNuageVspDevicesParams, pred func(*NuageVspDevice) bool) ([]*NuageVspDevice, error)
+	ListNuageVspDevicesWithContext(ctx context.Context, p *ListNuageVspDevicesParams) (*ListNuageVspDevicesResponse, error)
+	NewAddNuageVspDeviceParams(hostname string, password string, physicalnetworkid string, port int, username string, opts ...func(*AddNuageVspDeviceParams)) *AddNuageVspDeviceParams
+	NewAddNuageVspDeviceParamsFromOpts(o AddNuageVspDeviceOpts) *AddNuageVspDeviceParams
+	NewDeleteNuageVspDeviceParams(vspdeviceid string, opts ...func(*DeleteNuageVspDeviceParams)) *DeleteNuageVspDeviceParams
+	NewListNuageVspDevicesParams(opts ...func(*ListNuageVspDevicesParams)) *ListNuageVspDevicesParams
+	NewUpdateNuageVspDeviceParams(physicalnetworkid string, opts ...func(*UpdateNuageVspDeviceParams)) *UpdateNuageVspDeviceParams
+	StreamNuageVspDevices(ctx context.Context, p *ListNuageVspDevicesParams) (<-chan *NuageVspDevice, <-chan error)
+	UpdateNuageVspDevice(p *UpdateNuageVspDeviceParams, opts ...OptionFunc) (*UpdateNuageVspDeviceResponse, error)
+	UpdateNuageVspDeviceRaw(v url.Values) (*UpdateNuageVspDeviceResponse, error)
+	UpdateNuageVspDeviceRawWithContext(ctx context.Context, v url.Values) (*UpdateNuageVspDeviceResponse, error)
+	UpdateNuageVspDeviceWithContext(ctx context.Context, p *UpdateNuageVspDeviceParams, opts ...OptionFunc) (*UpdateNuageVspDeviceResponse, error)
+}
+
+var _ NuageVSPServiceIface = (*NuageVSPService)(nil)
+
+// OutofbandManagementServiceIface contains all exported methods of OutofbandManagementService, so callers can depend on it to mock the service
+type OutofbandManagementServiceIface interface {
+	ChangeOutOfBandManagementPassword(p *ChangeOutOfBandManagementPasswordParams, opts ...OptionFunc) (*ChangeOutOfBandManagementPasswordResponse, error)
+	ChangeOutOfBandManagementPasswordRaw(v url.Values) (*ChangeOutOfBandManagementPasswordResponse, error)
+	ChangeOutOfBandManagementPasswordRawWithContext(ctx context.Context, v url.Values) (*ChangeOutOfBandManagementPasswordResponse, error)
+	ChangeOutOfBandManagementPasswordWithContext(ctx context.Context, p *ChangeOutOfBandManagementPasswordParams, opts ...OptionFunc) (*ChangeOutOfBandManagementPasswordResponse, error)
+	ConfigureOutOfBandManagement(p *ConfigureOutOfBandManagementParams, opts ...OptionFunc) (*OutOfBandManagementResponse, error)
+	ConfigureOutOfBandManagementRaw(v url.Values) (*OutOfBandManagementResponse, error)
+	ConfigureOutOfBandManagementRawWithContext(ctx context.Context, v url.Values) (*OutOfBandManagementResponse, error)
+	ConfigureOutOfBandManagementWithContext(ctx context.Context, p *ConfigureOutOfBandManagementParams, opts ...OptionFunc) (*OutOfBandManagementResponse, error)
+	IssueOutOfBandManagementPowerAction(p *IssueOutOfBandManagementPowerActionParams, opts ...OptionFunc) (*IssueOutOfBandManagementPowerActionResponse, error)
+	IssueOutOfBandManagementPowerActionRaw(v url.Values) (*IssueOutOfBandManagementPowerActionResponse, error)
+	IssueOutOfBandManagementPowerActionRawWithContext(ctx context.Context, v url.Values) (*IssueOutOfBandManagementPowerActionResponse, error)
+	IssueOutOfBandManagementPowerActionWithContext(ctx context.Context, p *IssueOutOfBandManagementPowerActionParams, opts ...OptionFunc) (*IssueOutOfBandManagementPowerActionResponse, error)
+	NewChangeOutOfBandManagementPasswordParams(hostid string, opts ...func(*ChangeOutOfBandManagementPasswordParams)) *ChangeOutOfBandManagementPasswordParams
+	NewConfigureOutOfBandManagementParams(address string, driver string, hostid string, password string, port string, username string, opts ...func(*ConfigureOutOfBandManagementParams)) *ConfigureOutOfBandManagementParams
+	NewConfigureOutOfBandManagementParamsFromOpts(o ConfigureOutOfBandManagementOpts) *ConfigureOutOfBandManagementParams
+	NewIssueOutOfBandManagementPowerActionParams(action string, hostid string, opts ...func(*IssueOutOfBandManagementPowerActionParams)) *IssueOutOfBandManagementPowerActionParams
+}
+
+var _ OutofbandManagementServiceIface = (*OutofbandManagementService)(nil)
+
+// OvsElementServiceIface contains all exported methods of OvsElementService, so callers can depend on it to mock the service
+type OvsElementServiceIface interface {
+	ConfigureOvsElement(p *ConfigureOvsElementParams, opts ...OptionFunc) (*OvsElementResponse, error)
+	ConfigureOvsElementRaw(v url.Values) (*OvsElementResponse, error)
+	ConfigureOvsElementRawWithContext(ctx context.Context, v url.Values) (*OvsElementResponse, error)
+	ConfigureOvsElementWithContext(ctx context.Context, p *ConfigureOvsElementParams, opts ...OptionFunc) (*OvsElementResponse, error)
+	GetOvsElementByID(id string, opts ...OptionFunc) (*OvsElement, int, error)
+	ListAllOvsElements(p *ListOvsElementsParams) ([]*OvsElement, error)
+	ListOvsElements(p *ListOvsElementsParams) (*ListOvsElementsResponse, error)
+	ListOvsElementsRaw(v url.Values) (*ListOvsElementsResponse, error)
+	ListOvsElementsRawWithContext(ctx context.Context, v url.Values) (*ListOvsElementsResponse, error)
+	ListOvsElementsWhere(p *ListOvsElementsParams, pred func(*OvsElement) bool) ([]*OvsElement, error)
+	ListOvsElementsWithContext(ctx context.Context, p *ListOvsElementsParams) (*ListOvsElementsResponse, error)
+	NewConfigureOvsElementParams(enabled bool, id string, opts ...func(*ConfigureOvsElementParams)) *ConfigureOvsElementParams
+	NewListOvsElementsParams(opts ...func(*ListOvsElementsParams)) *ListOvsElementsParams
+	OvsElementExists(id string, opts ...OptionFunc) (bool, error)
+	StreamOvsElements(ctx context.Context, p *ListOvsElementsParams) (<-chan *OvsElement, <-chan error)
+}
+
+var _ OvsElementServiceIface = (*OvsElementService)(nil)
+
+// PodServiceIface contains all exported methods of PodService, so callers can depend on it to mock the service
+type PodServiceIface interface {
+	CreatePod(p *CreatePodParams, opts ...OptionFunc) (*CreatePodResponse, error)
+	CreatePodRaw(v url.Values) (*CreatePodResponse, error)
+	CreatePodRawWithContext(ctx context.Context, v url.Values) (*CreatePodResponse, error)
+	CreatePodWithContext(ctx context.Context, p *CreatePodParams, opts ...OptionFunc) (*CreatePodResponse, error)
+	DedicatePod(p *DedicatePodParams, opts ...OptionFunc) (*DedicatePodResponse, error)
+	DedicatePodRaw(v url.Values) (*DedicatePodResponse, error)
+	DedicatePodRawWithContext(ctx context.Context, v url.Values) (*DedicatePodResponse, error)
+	DedicatePodWithContext(ctx context.Context, p *DedicatePodParams, opts ...OptionFunc) (*DedicatePodResponse, error)
+	DeletePod(p *DeletePodParams, opts ...OptionFunc) (*DeletePodResponse, error)
+	DeletePodRaw(v url.Values) (*DeletePodResponse, error)
+	DeletePodRawWithContext(ctx context.Context, v url.Values) (*DeletePodResponse, error)
+	DeletePodWithContext(ctx context.Context, p *DeletePodParams, opts ...OptionFunc) (*DeletePodResponse, error)
+	GetPodByID(id string, opts ...OptionFunc) (*Pod, int, error)
+	GetPodByName(name string, opts ...OptionFunc) (*Pod, int, error)
+	GetPodID(name string, opts ...OptionFunc) (string, int, error)
+	ListAllDedicatedPods(p *ListDedicatedPodsParams) ([]*DedicatedPod, error)
+	ListAllPods(p *ListPodsParams) ([]*Pod, error)
+	ListDedicatedPods(p *ListDedicatedPodsParams) (*ListDedicatedPodsResponse, error)
+	ListDedicatedPodsRaw(v url.Values) (*ListDedicatedPodsResponse, error)
+	ListDedicatedPodsRawWithContext(ctx context.Context, v url.Values) (*ListDedicatedPodsResponse, error)
+	ListDedicatedPodsWhere(p *ListDedicatedPodsParams, pred func(*DedicatedPod) bool) ([]*DedicatedPod, error)
+	ListDedicatedPodsWithContext(ctx context.Context, p *ListDedicatedPodsParams) (*ListDedicatedPodsResponse, error)
+	ListPods(p *ListPodsParams) (*ListPodsResponse, error)
+	ListPodsRaw(v url.Values) (*ListPodsResponse, error)
+	ListPodsRawWithContext(ctx context.Context, v url.Values) (*ListPodsResponse, error)
+	ListPodsWhere(p *ListPodsParams, pred func(*Pod) bool) ([]*Pod, error)
+	ListPodsWithContext(ctx context.Context, p *ListPodsParams) (*ListPodsResponse, error)
+	NewCreatePodParams(gateway string, name string, netmask string, startip string, zoneid string, opts ...func(*CreatePodParams)) *CreatePodParams
+	NewCreatePodParamsFromOpts(o CreatePodOpts) *CreatePodParams
+	NewDedicatePodParams(domainid string, podid string, opts ...func(*DedicatePodParams)) *DedicatePodParams
+	NewDeletePodParams(id string, opts ...func(*DeletePodParams)) *DeletePodParams
+	NewListDedicatedPodsParams(opts ...func(*ListDedicatedPodsParams)) *ListDedicatedPodsParams
+	NewListPodsParams(opts ...func(*ListPodsParams)) *ListPodsParams
+	NewReleaseDedicatedPodParams(podid string, opts ...func(*ReleaseDedicatedPodParams)) *ReleaseDedicatedPodParams
+	NewUpdatePodParams(id string, opts ...func(*UpdatePodParams)) *UpdatePodParams
+	PodExists(id string, opts ...OptionFunc) (bool, error)
+	ReleaseDedicatedPod(p *ReleaseDedicatedPodParams, opts ...OptionFunc) (*ReleaseDedicatedPodResponse, error)
+	ReleaseDedicatedPodRaw(v url.Values) (*ReleaseDedicatedPodResponse, error)
+	ReleaseDedicatedPodRawWithContext(ctx context.Context, v url.Values) (*ReleaseDedicatedPodResponse, error)
+	ReleaseDedicatedPodWithContext(ctx context.Context, p *ReleaseDedicatedPodParams, opts ...OptionFunc) (*ReleaseDedicatedPodResponse, error)
+	StreamDedicatedPods(ctx context.Context, p *ListDedicatedPodsParams) (<-chan *DedicatedPod, <-chan error)
+	StreamPods(ctx context.Context, p *ListPodsParams) (<-chan *Pod, <-chan error)
+	UpdateMany(ids []string, mutate func(*UpdatePodParams), concurrency int, opts ...OptionFunc) (BulkResult[*UpdatePodResponse], error)
+	UpdatePod(p *UpdatePodParams, opts ...OptionFunc) (*UpdatePodResponse, error)
+	UpdatePodRaw(v url.Values) (*UpdatePodResponse, error)
+	UpdatePodRawWithContext(ctx context.Context, v url.Values) (*UpdatePodResponse, error)
+	UpdatePodWithContext(ctx context.Context, p *UpdatePodParams, opts ...OptionFunc) (*UpdatePodResponse, error)
+	WaitForPodDeleted(ctx context.Context, id string, timeout time.Duration, opts ...OptionFunc) error
+}
+
+var _ PodServiceIface = (*PodService)(nil)
+
+// PoolServiceIface contains all exported methods of PoolService, so callers can depend on it to mock the service
+type PoolServiceIface interface {
+	CreateStoragePool(p *CreateStoragePoolParams, opts ...OptionFunc) (*CreateStoragePoolResponse, error)
+	CreateStoragePoolRaw(v url.Values) (*CreateStoragePoolResponse, error)
+	CreateStoragePoolRawWithContext(ctx context.Context, v url.Values) (*CreateStoragePoolResponse, error)
+	CreateStoragePoolWithContext(ctx context.Context, p *CreateStoragePoolParams, opts ...OptionFunc) (*CreateStoragePoolResponse, error)
+	DeleteStoragePool(p *DeleteStoragePoolParams, opts ...OptionFunc) (*DeleteStoragePoolResponse, error)
+	DeleteStoragePoolRaw(v url.Values) (*DeleteStoragePoolResponse, error)
+	DeleteStoragePoolRawWithContext(ctx context.Context, v url.Values) (*DeleteStoragePoolResponse, error)
+	DeleteStoragePoolWithContext(ctx context.Context, p *DeleteStoragePoolParams, opts ...OptionFunc) (*DeleteStoragePoolResponse, error)
+	FindStoragePoolsForMigration(p *FindStoragePoolsForMigrationParams, opts ...OptionFunc) (*FindStoragePoolsForMigrationResponse, error)
+	FindStoragePoolsForMigrationRaw(v url.Values) (*FindStoragePoolsForMigrationResponse, error)
+	FindStoragePoolsForMigrationRawWithContext(ctx context.Context, v url.Values) (*FindStoragePoolsForMigrationResponse, error)
+	FindStoragePoolsForMigrationWithContext(ctx context.Context, p *FindStoragePoolsForMigrationParams, opts ...OptionFunc) (*FindStoragePoolsForMigrationResponse, error)
+	GetStoragePoolByID(id string, opts ...OptionFunc) (*StoragePool, int, error)
+	GetStoragePoolByName(name string, opts ...OptionFunc) (*StoragePool, int, error)
+	GetStoragePoolID(name string, opts ...OptionFunc) (string, int, error)
+	ListAllStoragePools(p *ListStoragePoolsParams) ([]*StoragePool, error)
+	ListStoragePools(p *ListStoragePoolsParams) (*ListStoragePoolsResponse, error)
+	ListStoragePoolsRaw(v url.Values) (*ListStoragePoolsResponse, error)
+	ListStoragePoolsRawWithContext(ctx context.Context, v url.Values) (*ListStoragePoolsResponse, error)
+	ListStoragePoolsWhere(p *ListStoragePoolsParams, pred func(*StoragePool) bool) ([]*StoragePool, error)
+	ListStoragePoolsWithContext(ctx context.Context, p *ListStoragePoolsParams) (*ListStoragePoolsResponse, error)
+	NewCreateStoragePoolParams(name string, url string, zoneid string, opts ...func(*CreateStoragePoolParams)) *CreateStoragePoolParams
+	NewCreateStoragePoolParamsFromOpts(o CreateStoragePoolOpts) *CreateStoragePoolParams
+	NewDeleteStoragePoolParams(id string, opts ...func(*DeleteStoragePoolParams)) *DeleteStoragePoolParams
+	NewFindStoragePoolsForMigrationParams(id string, opts ...func(*FindStoragePoolsForMigrationParams)) *FindStoragePoolsForMigrationParams
+	NewListStoragePoolsParams(opts ...func(*ListStoragePoolsParams)) *ListStoragePoolsParams
+	NewUpdateStoragePoolParams(id string, opts ...func(*UpdateStoragePoolParams)) *UpdateStoragePoolParams
+	StoragePoolExists(id string, opts ...OptionFunc) (bool, error)
+	StreamStoragePools(ctx context.Context, p *ListStoragePoolsParams) (<-chan *StoragePool, <-chan error)
+	UpdateStoragePool(p *UpdateStoragePoolParams, opts ...OptionFunc) (*UpdateStoragePoolResponse, error)
+	UpdateStoragePoolRaw(v url.Values) (*UpdateStoragePoolResponse, error)
+	UpdateStoragePoolRawWithContext(ctx context.Context, v url.Values) (*UpdateStoragePoolResponse, error)
+	UpdateStoragePoolWithContext(ctx context.Context, p *UpdateStoragePoolParams, opts ...OptionFunc) (*UpdateStoragePoolResponse, error)
+	WaitForStoragePoolDeleted(ctx context.Context, id string, timeout time.Duration, opts ...OptionFunc) error
+}
+
+var _ PoolServiceIface = (*PoolService)(nil)
+
+// PortableIPServiceIface contains all exported methods of PortableIPService, so callers can depend on it to mock the service
+type PortableIPServiceIface interface {
+	CreatePortableIpRange(p *CreatePortableIpRangeParams, opts ...OptionFunc) (*CreatePortableIpRangeResponse, error)
+	CreatePortableIpRangeRaw(v url.Values) (*CreatePortableIpRangeResponse, error)
+	CreatePortableIpRangeRawWithContext(ctx context.Context, v url.Values) (*CreatePortableIpRangeResponse, error)
+	CreatePortableIpRangeWithContext(ctx context.Context, p *CreatePortableIpRangeParams, opts ...OptionFunc) (*CreatePortableIpRangeResponse, error)
+	DeletePortableIpRange(p *DeletePortableIpRangeParams, opts ...OptionFunc) (*DeletePortableIpRangeResponse, error)
+	DeletePortableIpRangeRaw(v url.Values) (*DeletePortableIpRangeResponse, error)
+	DeletePortableIpRangeRawWithContext(ctx context.Context, v url.Values) (*DeletePortableIpRangeResponse, error)
+	DeletePortableIpRangeWithContext(ctx context.Context, p *DeletePortableIpRangeParams, opts ...OptionFunc) (*DeletePortableIpRangeResponse, error)
+	GetPortableIpRangeByID(id string, opts ...OptionFunc) (*PortableIpRange, int, error)
+	ListAllPortableIpRanges(p *ListPortableIpRangesParams) ([]*PortableIpRange, error)
+	ListPortableIpRanges(p *ListPortableIpRangesParams) (*ListPortableIpRangesResponse, error)
+	ListPortableIpRangesRaw(v url.Values) (*ListPortableIpRangesResponse, error)
+	ListPortableIpRangesRawWithContext(ctx context.Context, v url.Values) (*ListPortableIpRangesResponse, error)
+	ListPortableIpRangesWhere(p *ListPortableIpRangesParams, pred func(*PortableIpRange) bool) ([]*PortableIpRange, error)
+	ListPortableIpRangesWithContext(ctx context.Context, p *ListPortableIpRangesParams) (*ListPortableIpRangesResponse, error)
+	NewCreatePortableIpRangeParams(endip string, gateway string, netmask string, regionid int, startip string, opts ...func(*CreatePortableIpRangeParams)) *CreatePortableIpRangeParams
+	NewCreatePortableIpRangeParamsFromOpts(o CreatePortableIpRangeOpts) *CreatePortableIpRangeParams
+	NewDeletePortableIpRangeParams(id string, opts ...func(*DeletePortableIpRangeParams)) *DeletePortableIpRangeParams
+	NewListPortableIpRangesParams(opts ...func(*ListPortableIpRangesParams)) *ListPortableIpRangesParams
+	PortableIpRangeExists(id string, opts ...OptionFunc) (bool, error)
+	StreamPortableIpRanges(ctx context.Context, p *ListPortableIpRangesParams) (<-chan *PortableIpRange, <-chan error)
+	WaitForPortableIpRangeDeleted(ctx context.Context, id string, timeout time.Duration, opts ...OptionFunc) error
+}
+
+var _ PortableIPServiceIface = (*PortableIPService)(nil)
+
+// ProjectServiceIface contains all exported methods of ProjectService, so callers can depend on it to mock the service
+type ProjectServiceIface interface {
+	ActivateProject(p *ActivateProjectParams, opts ...OptionFunc) (*ActivateProjectResponse, error)
+	ActivateProjectRaw(v url.Values) (*ActivateProjectResponse, error)
+	ActivateProjectRawWithContext(ctx context.Context, v url.Values) (*ActivateProjectResponse, error)
+	ActivateProjectWithContext(ctx context.Context, p *ActivateProjectParams, opts ...OptionFunc) (*ActivateProjectResponse, error)
+	CreateProject(p *CreateProjectParams, opts ...OptionFunc) (*CreateProjectResponse, error)
+	CreateProjectRaw(v url.Values) (*CreateProjectResponse, error)
+	CreateProjectRawWithContext(ctx context.Context, v url.Values) (*CreateProjectResponse, error)
+	CreateProjectWithContext(ctx context.Context, p *CreateProjectParams, opts ...OptionFunc) (*CreateProjectResponse, error)
+	DeleteProject(p *DeleteProjectParams, opts ...OptionFunc) (*DeleteProjectResponse, error)
+	DeleteProjectInvitation(p *DeleteProjectInvitationParams, opts ...OptionFunc) (*DeleteProjectInvitationResponse, error)
+	DeleteProjectInvitationRaw(v url.Values) (*DeleteProjectInvitationResponse, error)
+	DeleteProjectInvitationRawWithContext(ctx context.Context, v url.Values) (*DeleteProjectInvitationResponse, error)
+	DeleteProjectInvitationWithContext(ctx context.Context, p *DeleteProjectInvitationParams, opts ...OptionFunc) (*DeleteProjectInvitationResponse, error)
+	DeleteProjectRaw(v url.Values) (*DeleteProjectResponse, error)
+	DeleteProjectRawWithContext(ctx context.Context, v url.Values) (*DeleteProjectResponse, error)
+	DeleteProjectWithContext(ctx context.Context, p *DeleteProjectParams, opts ...OptionFunc) (*DeleteProjectResponse, error)
+	GetProjectByID(id string, opts ...OptionFunc) (*Project, int, error)
+	GetProjectByName(name string, opts ...OptionFunc) (*Project, int, error)
+	GetProjectID(name string, opts ...OptionFunc) (string, int, error)
+	GetProjectInvitationByID(id string, opts ...OptionFunc) (*ProjectInvitation, int, error)
+	ListAllProjectInvitations(p *ListProjectInvitationsParams) ([]*ProjectInvitation, error)
+	ListAllProjects(p *ListProjectsParams) ([]*Project, error)
+	ListProjectInvitations(p *ListProjectInvitationsParams) (*ListProjectInvitationsResponse, error)
+	ListProjectInvitationsRaw(v url.Values) (*ListProjectInvitationsResponse, error)
+	ListProjectInvitationsRawWithContext(ctx context.Context, v url.Values) (*ListProjectInvitationsResponse, error)
+	ListProjectInvitationsWhere(p *ListProjectInvitationsParams, pred func(*ProjectInvitation) bool) ([]*ProjectInvitation, error)
+	ListProjectInvitationsWithContext(ctx context.Context, p *ListProjectInvitationsParams) (*ListProjectInvitationsResponse, error)
+	ListProjects(p *ListProjectsParams) (*ListProjectsResponse, error)
+	ListProjectsRaw(v url.Values) (*ListProjectsResponse, error)
+	ListProjectsRawWithContext(ctx context.Context, v url.Values) (*ListProjectsResponse, error)
+	ListProjectsWhere(p *ListProjectsParams, pred func(*Project) bool) ([]*Project, error)
+	ListProjectsWithContext(ctx context.Context, p *ListProjectsParams) (*ListProjectsResponse, error)
+	NewActivateProjectParams(id string, opts ...func(*ActivateProjectParams)) *ActivateProjectParams
+	NewCreateProjectParams(displaytext string, name string, opts ...func(*CreateProjectParams)) *CreateProjectParams
+	NewDeleteProjectInvitationParams(id string, opts ...func(*DeleteProjectInvitationParams)) *DeleteProjectInvitationParams
+	NewDeleteProjectParams(id string, opts ...func(*DeleteProjectParams)) *DeleteProjectParams
+	NewListProjectInvitationsParams(opts ...func(*ListProjectInvitationsParams)) *ListProjectInvitationsParams
+	NewListProjectsParams(opts ...func(*ListProjectsParams)) *ListProjectsParams
+	NewSuspendProjectParams(id string, opts ...func(*SuspendProjectParams)) *SuspendProjectParams
+	NewUpdateProjectInvitationParams(projectid string, opts ...func(*UpdateProjectInvitationParams)) *UpdateProjectInvitationParams
+	NewUpdateProjectParams(id string, opts ...func(*UpdateProjectParams)) *UpdateProjectParams
+	ProjectExists(id string, opts ...OptionFunc) (bool, error)
+	ProjectInvitationExists(id string, opts ...OptionFunc) (bool, error)
+	StreamProjectInvitations(ctx context.Context, p *ListProjectInvitationsParams) (<-chan *ProjectInvitation, <-chan error)
+	StreamProjects(ctx context.Context, p *ListProjectsParams) (<-chan *Project, <-chan error)
+	SuspendProject(p *SuspendProjectParams, opts ...OptionFunc) (*SuspendProjectResponse, error)
+	SuspendProjectRaw(v url.Values) (*SuspendProjectResponse, error)
+	SuspendProjectRawWithContext(ctx context.Context, v url.Values) (*SuspendProjectResponse, error)
+	SuspendProjectWithContext(ctx context.Context, p *SuspendProjectParams, opts ...OptionFunc) (*SuspendProjectResponse, error)
+	UpdateMany(ids []string, mutate func(*UpdateProjectParams), concurrency int, opts ...OptionFunc) (BulkResult[*UpdateProjectResponse], error)
+	UpdateProject(p *UpdateProjectParams, opts ...OptionFunc) (*UpdateProjectResponse, error)
+	UpdateProjectInvitation(p *UpdateProjectInvitationParams, opts ...OptionFunc) (*UpdateProjectInvitationResponse, error)
+	UpdateProjectInvitationRaw(v url.Values) (*UpdateProjectInvitationResponse, error)
+	UpdateProjectInvitationRawWithContext(ctx context.Context, v url.Values) (*UpdateProjectInvitationResponse, error)
+	UpdateProjectInvitationWithContext(ctx context.Context, p *UpdateProjectInvitationParams, opts ...OptionFunc) (*UpdateProjectInvitationResponse, error)
+	UpdateProjectRaw(v url.Values) (*UpdateProjectResponse, error)
+	UpdateProjectRawWithContext(ctx context.Context, v url.Values) (*UpdateProjectResponse, error)
+	UpdateProjectWithContext(ctx context.Context, p *UpdateProjectParams, opts ...OptionFunc) (*UpdateProjectResponse, error)
+	WaitForProjectDeleted(ctx context.Context, id string, timeout time.Duration, opts ...OptionFunc) error
+	WaitForProjectInvitationDeleted(ctx context.Context, id string, timeout time.Duration, opts ...OptionFunc) error
+}
+
+var _ ProjectServiceIface = (*ProjectService)(nil)
+
+// QuotaServiceIface contains all exported methods of QuotaService, so callers can depend on it to mock the service
+type QuotaServiceIface interface {
+	NewQuotaIsEnabledParams(opts ...func(*QuotaIsEnabledParams)) *QuotaIsEnabledParams
+	QuotaIsEnabled(p *QuotaIsEnabledParams, opts ...OptionFunc) (*QuotaIsEnabledResponse, error)
+	QuotaIsEnabledRaw(v url.Values) (*QuotaIsEnabledResponse, error)
+	QuotaIsEnabledRawWithContext(ctx context.Context, v url.Values) (*QuotaIsEnabledResponse, error)
+	QuotaIsEnabledWithContext(ctx context.Context, p *QuotaIsEnabledParams, opts ...OptionFunc) (*QuotaIsEnabledResponse, error)
+}
+
+var _ QuotaServiceIface = (*QuotaService)(nil)
+
+// RegionServiceIface contains all exported methods of RegionService, so callers can depend on it to mock the service
+type RegionServiceIface interface {
+	AddRegion(p *AddRegionParams, opts ...OptionFunc) (*AddRegionResponse, error)
+	AddRegionRaw(v url.Values) (*AddRegionResponse, error)
+	AddRegionRawWithContext(ctx context.Context, v url.Values) (*AddRegionResponse, error)
+	AddRegionWithContext(ctx context.Context, p *AddRegionParams, opts ...OptionFunc) (*AddRegionResponse, error)
+	ListAllRegions(p *ListRegionsParams) ([]*Region, error)
+	ListRegions(p *ListRegionsParams) (*ListRegionsResponse, error)
+	ListRegionsRaw(v url.Values) (*ListRegionsResponse, error)
+	ListRegionsRawWithContext(ctx context.Context, v url.Values) (*ListRegionsResponse, error)
+	ListRegionsWhere(p *ListRegionsParams, pred func(*Region) bool) ([]*Region, error)
+	ListRegionsWithContext(ctx context.Context, p *ListRegionsParams) (*ListRegionsResponse, error)
+	NewAddRegionParams(endpoint string, id int, name string, opts ...func(*AddRegionParams)) *AddRegionParams
+	NewAddRegionParamsFromOpts(o AddRegionOpts) *AddRegionParams
+	NewListRegionsParams(opts ...func(*ListRegionsParams)) *ListRegionsParams
+	NewRemoveRegionParams(id int, opts ...func(*RemoveRegionParams)) *RemoveRegionParams
+	NewUpdateRegionParams(id int, opts ...func(*UpdateRegionParams)) *UpdateRegionParams
+	RemoveRegion(p *RemoveRegionParams, opts ...OptionFunc) (*RemoveRegionResponse, error)
+	RemoveRegionRaw(v url.Values) (*RemoveRegionResponse, error)
+	RemoveRegionRawWithContext(ctx context.Context, v url.Values) (*RemoveRegionResponse, error)
+	RemoveRegionWithContext(ctx context.Context, p *RemoveRegionParams, opts ...OptionFunc) (*RemoveRegionResponse, error)
+	StreamRegions(ctx context.Context, p *ListRegionsParams) (<-chan *Region, <-chan error)
+	UpdateRegion(p *UpdateRegionParams, opts ...OptionFunc) (*UpdateRegionResponse, error)
+	UpdateRegionRaw(v url.Values) (*UpdateRegionResponse, error)
+	UpdateRegionRawWithContext(ctx context.Context, v url.Values) (*UpdateRegionResponse, error)
+	UpdateRegionWithContext(ctx context.Context, p *UpdateRegionParams, opts ...OptionFunc) (*UpdateRegionResponse, error)
+}
+
+var _ RegionServiceIface = (*RegionService)(nil)
+
+// ResourcemetadataServiceIface contains all exported methods of ResourcemetadataService, so callers can depend on it to mock the service
+type ResourcemetadataServiceIface interface {
+	AddResourceDetail(p *AddResourceDetailParams, opts ...OptionFunc) (*AddResourceDetailResponse, error)
+	AddResourceDetailRaw(v url.Values) (*AddResourceDetailResponse, error)
+	AddResourceDetailRawWithContext(ctx context.Context, v url.Values) (*AddResourceDetailResponse, error)
+	AddResourceDetailWithContext(ctx context.Context, p *AddResourceDetailParams, opts ...OptionFunc) (*AddResourceDetailResponse, error)
+	GetVolumeSnapshotDetails(p *GetVolumeSnapshotDetailsParams, opts ...OptionFunc) (*GetVolumeSnapshotDetailsResponse, error)
+	GetVolumeSnapshotDetailsRaw(v url.Values) (*GetVolumeSnapshotDetailsResponse, error)
+	GetVolumeSnapshotDetailsRawWithContext(ctx context.Context, v url.Values) (*GetVolumeSnapshotDetailsResponse, error)
+	GetVolumeSnapshotDetailsWithContext(ctx context.Context, p *GetVolumeSnapshotDetailsParams, opts ...OptionFunc) (*GetVolumeSnapshotDetailsResponse, error)
+	ListAllResourceDetails(p *ListResourceDetailsParams) ([]*ResourceDetail, error)
+	ListResourceDetails(p *ListResourceDetailsParams) (*ListResourceDetailsResponse, error)
+	ListResourceDetailsRaw(v url.Values) (*ListResourceDetailsResponse, error)
+	ListResourceDetailsRawWithContext(ctx context.Context, v url.Values) (*ListResourceDetailsResponse, error)
+	ListResourceDetailsWhere(p *ListResourceDetailsParams, pred func(*ResourceDetail) bool) ([]*ResourceDetail, error)
+	ListResourceDetailsWithContext(ctx context.Context, p *ListResourceDetailsParams) (*ListResourceDetailsResponse, error)
+	NewAddResourceDetailParams(details map[string]string, resourceid string, resourcetype string, opts ...func(*AddResourceDetailParams)) *AddResourceDetailParams
+	NewAddResourceDetailParamsFromOpts(o AddResourceDetailOpts) *AddResourceDetailParams
+	NewGetVolumeSnapshotDetailsParams(snapshotid string, opts ...func(*GetVolumeSnapshotDetailsParams)) *GetVolumeSnapshotDetailsParams
+	NewListResourceDetailsParams(resourcetype string, opts ...func(*ListResourceDetailsParams)) *ListResourceDetailsParams
+	NewRemoveResourceDetailParams(resourceid string, resourcetype string, opts ...func(*RemoveResourceDetailParams)) *RemoveResourceDetailParams
+	RemoveResourceDetail(p *RemoveResourceDetailParams, opts ...OptionFunc) (*RemoveResourceDetailResponse, error)
+	RemoveResourceDetailRaw(v url.Values) (*RemoveResourceDetailResponse, error)
+	RemoveResourceDetailRawWithContext(ctx context.Context, v url.Values) (*RemoveResourceDetailResponse, error)
+	RemoveResourceDetailWithContext(ctx context.Context, p *RemoveResourceDetailParams, opts ...OptionFunc) (*RemoveResourceDetailResponse, error)
+	StreamResourceDetails(ctx context.Context, p *ListResourceDetailsParams) (<-chan *ResourceDetail, <-chan error)
+}
+
+var _ ResourcemetadataServiceIface = (*ResourcemetadataService)(nil)
+
+// ResourcetagsServiceIface contains all exported methods of ResourcetagsService, so callers can depend on it to mock the service
+type ResourcetagsServiceIface interface {
+	CreateTags(p *CreateTagsParams, opts ...OptionFunc) (*CreateTagsResponse, error)
+	CreateTagsRaw(v url.Values) (*CreateTagsResponse, error)
+	CreateTagsRawWithContext(ctx context.Context, v url.Values) (*CreateTagsResponse, error)
+	CreateTagsWithContext(ctx context.Context, p *CreateTagsParams, opts ...OptionFunc) (*CreateTagsResponse, error)
+	DeleteTags(p *DeleteTagsParams, opts ...OptionFunc) (*DeleteTagsResponse, error)
+	DeleteTagsRaw(v url.Values) (*DeleteTagsResponse, error)
+	DeleteTagsRawWithContext(ctx context.Context, v url.Values) (*DeleteTagsResponse, error)
+	DeleteTagsWithContext(ctx context.Context, p *DeleteTagsParams, opts ...OptionFunc) (*DeleteTagsResponse, error)
+	GetStorageTagID(keyword string, opts ...OptionFunc) (string, int, error)
+	ListAllStorageTags(p *ListStorageTagsParams) ([]*StorageTag, error)
+	ListAllTags(p *ListTagsParams) ([]*Tag, error)
+	ListStorageTags(p *ListStorageTagsParams) (*ListStorageTagsResponse, error)
+	ListStorageTagsRaw(v url.Values) (*ListStorageTagsResponse, error)
+	ListStorageTagsRawWithContext(ctx context.Context, v url.Values) (*ListStorageTagsResponse, error)
+	ListStorageTagsWhere(p *ListStorageTagsParams, pred func(*StorageTag) bool) ([]*StorageTag, error)
+	ListStorageTagsWithContext(ctx context.Context, p *ListStorageTagsParams) (*ListStorageTagsResponse, error)
+	ListTags(p *ListTagsParams) (*ListTagsResponse, error)
+	ListTagsRaw(v url.Values) (*ListTagsResponse, error)
+	ListTagsRawWithContext(ctx context.Context, v url.Values) (*ListTagsResponse, error)
+	ListTagsWhere(p *ListTagsParams, pred func(*Tag) bool) ([]*Tag, error)
+	ListTagsWithContext(ctx context.Context, p *ListTagsParams) (*ListTagsResponse, error)
+	NewCreateTagsParams(resourceids []string, resourcetype string, tags map[string]string, opts ...func(*CreateTagsParams)) *CreateTagsParams
+	NewCreateTagsParamsFromOpts(o CreateTagsOpts) *CreateTagsParams
+	NewDeleteTagsParams(resourceids []string, resourcetype string, opts ...func(*DeleteTagsParams)) *DeleteTagsParams
+	NewListStorageTagsParams(opts ...func(*ListStorageTagsParams)) *ListStorageTagsParams
+	NewListTagsParams(opts ...func(*ListTagsParams)) *ListTagsParams
+	StreamStorageTags(ctx context.Context, p *ListStorageTagsParams) (<-chan *StorageTag, <-chan error)
+	StreamTags(ctx context.Context, p *ListTagsParams) (<-chan *Tag, <-chan error)
+}
+
+var _ ResourcetagsServiceIface = (*ResourcetagsService)(nil)
+
+// RoleServiceIface contains all exported methods of RoleService, so callers can depend on it to mock the service
+type RoleServiceIface interface {
+	CreateRole(p *CreateRoleParams, opts ...OptionFunc) (*CreateRoleResponse, error)
+	CreateRolePermission(p *CreateRolePermissionParams, opts ...OptionFunc) (*CreateRolePermissionResponse, error)
+	CreateRolePermissionRaw(v url.Values) (*CreateRolePermissionResponse, error)
+	CreateRolePermissionRawWithContext(ctx context.Context, v url.Values) (*CreateRolePermissionResponse, error)
+	CreateRolePermissionWithContext(ctx context.Context, p *CreateRolePermissionParams, opts ...OptionFunc) (*CreateRolePermissionResponse, error)
+	CreateRoleRaw(v url.Values) (*CreateRoleResponse, error)
+	CreateRoleRawWithContext(ctx context.Context, v url.Values) (*CreateRoleResponse, error)
+	CreateRoleWithContext(ctx context.Context, p *CreateRoleParams, opts ...OptionFunc) (*CreateRoleResponse, error)
+	DeleteRole(p *DeleteRoleParams, opts ...OptionFunc) (*DeleteRoleResponse, error)
+	DeleteRolePermission(p *DeleteRolePermissionParams, opts ...OptionFunc) (*DeleteRolePermissionResponse, error)
+	DeleteRolePermissionRaw(v url.Values) (*DeleteRolePermissionResponse, error)
+	DeleteRolePermissionRawWithContext(ctx context.Context, v url.Values) (*DeleteRolePermissionResponse, error)
+	DeleteRolePermissionWithContext(ctx context.Context, p *DeleteRolePermissionParams, opts ...OptionFunc) (*DeleteRolePermissionResponse, error)
+	DeleteRoleRaw(v url.Values) (*DeleteRoleResponse, error)
+	DeleteRoleRawWithContext(ctx context.Context, v url.Values) (*DeleteRoleResponse, error)
+	DeleteRoleWithContext(ctx context.Context, p *DeleteRoleParams, opts ...OptionFunc) (*DeleteRoleResponse, error)
+	GetRoleByID(id string, opts ...OptionFunc) (*Role, int, error)
+	GetRoleByName(name string, opts ...OptionFunc) (*Role, int, error)
+	GetRoleID(name string, opts ...OptionFunc) (string, int, error)
+	ListRolePermissions(p *ListRolePermissionsParams) (*ListRolePermissionsResponse, error)
+	ListRolePermissionsRaw(v url.Values) (*ListRolePermissionsResponse, error)
+	ListRolePermissionsRawWithContext(ctx context.Context, v url.Values) (*ListRolePermissionsResponse, error)
+	ListRolePermissionsWhere(p *ListRolePermissionsParams, pred func(*RolePermission) bool) ([]*RolePermission, error)
+	ListRolePermissionsWithContext(ctx context.Context, p *ListRolePermissionsParams) (*ListRolePermissionsResponse, error)
+	ListRoles(p *ListRolesParams) (*ListRolesResponse, error)
+	ListRolesRaw(v url.Values) (*ListRolesResponse, error)
+	ListRolesRawWithContext(ctx context.Context, v url.Values) (*ListRolesResponse, error)
+	ListRolesWhere(p *ListRolesParams, pred func(*Role) bool) ([]*Role, error)
+	ListRolesWithContext(ctx context.Context, p *ListRolesParams) (*ListRolesResponse, error)
+	NewCreateRoleParams(name string, roleType string, opts ...func(*CreateRoleParams)) *CreateRoleParams
+	NewCreateRolePermissionParams(permission string, roleid string, rule string, opts ...func(*CreateRolePermissionParams)) *CreateRolePermissionParams
+	NewCreateRolePermissionParamsFromOpts(o CreateRolePermissionOpts) *CreateRolePermissionParams
+	NewDeleteRoleParams(id string, opts ...func(*DeleteRoleParams)) *DeleteRoleParams
+	NewDeleteRolePermissionParams(id string, opts ...func(*DeleteRolePermissionParams)) *DeleteRolePermissionParams
+	NewListRolePermissionsParams(opts ...func(*ListRolePermissionsParams)) *ListRolePermissionsParams
+	NewListRolesParams(opts ...func(*ListRolesParams)) *ListRolesParams
+	NewUpdateRoleParams(id string, opts ...func(*UpdateRoleParams)) *UpdateRoleParams
+	NewUpdateRolePermissionParams(roleid string, ruleorder []string, opts ...func(*UpdateRolePermissionParams)) *UpdateRolePermissionParams
+	RoleExists(id string, opts ...OptionFunc) (bool, error)
+	UpdateMany(ids []string, mutate func(*UpdateRoleParams), concurrency int, opts ...OptionFunc) (BulkResult[*UpdateRoleResponse], error)
+	UpdateRole(p *UpdateRoleParams, opts ...OptionFunc) (*UpdateRoleResponse, error)
+	UpdateRolePermission(p *UpdateRolePermissionParams, opts ...OptionFunc) (*UpdateRolePermissionResponse, error)
+	UpdateRolePermissionRaw(v url.Values) (*UpdateRolePermissionResponse, error)
+	UpdateRolePermissionRawWithContext(ctx context.Context, v url.Values) (*UpdateRolePermissionResponse, error)
+	UpdateRolePermissionWithContext(ctx context.Context, p *UpdateRolePermissionParams, opts ...OptionFunc) (*UpdateRolePermissionResponse, error)
+	UpdateRoleRaw(v url.Values) (*UpdateRoleResponse, error)
+	UpdateRoleRawWithContext(ctx context.Context, v url.Values) (*UpdateRoleResponse, error)
+	UpdateRoleWithContext(ctx context.Context, p *UpdateRoleParams, opts ...OptionFunc) (*UpdateRoleResponse, error)
+	WaitForRoleDeleted(ctx context.Context, id string, timeout time.Duration, opts ...OptionFunc) error
+}
+
+var _ RoleServiceIface = (*RoleService)(nil)
+
+// RouterServiceIface contains all exported methods of RouterService, so callers can depend on it to mock the service
+type RouterServiceIface interface {
+	ChangeServiceForRouter(p *ChangeServiceForRouterParams, opts ...OptionFunc) (*ChangeServiceForRouterResponse, error)
+	ChangeServiceForRouterRaw(v url.Values) (*ChangeServiceForRouterResponse, error)
+	ChangeServiceForRouterRawWithContext(ctx context.Context, v url.Values) (*ChangeServiceForRouterResponse, error)
+	ChangeServiceForRouterWithContext(ctx context.Context, p *ChangeServiceForRouterParams, opts ...OptionFunc) (*ChangeServiceForRouterResponse, error)
+	ConfigureVirtualRouterElement(p *ConfigureVirtualRouterElementParams, opts ...OptionFunc) (*VirtualRouterElementResponse, error)
+	ConfigureVirtualRouterElementRaw(v url.Values) (*VirtualRouterElementResponse, error)
+	ConfigureVirtualRouterElementRawWithContext(ctx context.Context, v url.Values) (*VirtualRouterElementResponse, error)
+	ConfigureVirtualRouterElementWithContext(ctx context.Context, p *ConfigureVirtualRouterElementParams, opts ...OptionFunc) (*VirtualRouterElementResponse, error)
+	CreateVirtualRouterElement(p *CreateVirtualRouterElementParams, opts ...OptionFunc) (*CreateVirtualRouterElementResponse, error)
+	CreateVirtualRouterElementRaw(v url.Values) (*CreateVirtualRouterElementResponse, error)
+	CreateVirtualRouterElementRawWithContext(ctx context.Context, v url.Values) (*CreateVirtualRouterElementResponse, error)
+	CreateVirtualRouterElementWithContext(ctx context.Context, p *CreateVirtualRouterElementParams, opts ...OptionFunc) (*CreateVirtualRouterElementResponse, error)
+	DestroyRouter(p *DestroyRouterParams, opts ...OptionFunc) (*DestroyRouterResponse, error)
+	DestroyRouterRaw(v url.Values) (*DestroyRouterResponse, error)
+	DestroyRouterRawWithContext(ctx context.Context, v url.Values) (*DestroyRouterResponse, error)
+	DestroyRouterWithContext(ctx context.Context, p *DestroyRouterParams, opts ...OptionFunc) (*DestroyRouterResponse, error)
+	GetRouterByID(id string, opts ...OptionFunc) (*Router, int, error)
+	GetRouterByName(name string, opts ...OptionFunc) (*Router, int, error)
+	GetRouterID(name string, opts ...OptionFunc) (string, int, error)
+	GetVirtualRouterElementByID(id string, opts ...OptionFunc) (*VirtualRouterElement, int, error)
+	ListAllRouters(p *ListRoutersParams) ([]*Router, error)
+	ListAllVirtualRouterElements(p *ListVirtualRouterElementsParams) ([]*VirtualRouterElement, error)
+	ListRouters(p *ListRoutersParams) (*ListRoutersResponse, error)
+	ListRoutersRaw(v url.Values) (*ListRoutersResponse, error)
+	ListRoutersRawWithContext(ctx context.Context, v url.Values) (*ListRoutersResponse, error)
+	ListRoutersWhere(p *ListRoutersParams, pred func(*Router) bool) ([]*Router, error)
+	ListRoutersWithContext(ctx context.Context, p *ListRoutersParams) (*ListRoutersResponse, error)
+	ListVirtualRouterElements(p *ListVirtualRouterElementsParams) (*ListVirtualRouterElementsResponse, error)
+	ListVirtualRouterElementsRaw(v url.Values) (*ListVirtualRouterElementsResponse, error)
+	ListVirtualRouterElementsRawWithContext(ctx context.Context, v url.Values) (*ListVirtualRouterElementsResponse, error)
+	ListVirtualRouterElementsWhere(p *ListVirtualRouterElementsParams, pred func(*VirtualRouterElement) bool) ([]*VirtualRouterElement, error)
+	ListVirtualRouterElementsWithContext(ctx context.Context, p *ListVirtualRouterElementsParams) (*ListVirtualRouterElementsResponse, error)
+	MigrateRouterAndWait(routerid, hostid string, opts ...OptionFunc) (*Router, error)
+	NewChangeServiceForRouterParams(id string, serviceofferingid string, opts ...func(*ChangeServiceForRouterParams)) *ChangeServiceForRouterParams
+	NewConfigureVirtualRouterElementParams(enabled bool, id string, opts ...func(*ConfigureVirtualRouterElementParams)) *ConfigureVirtualRouterElementParams
+	NewCreateVirtualRouterElementParams(nspid string, opts ...func(*CreateVirtualRouterElementParams)) *CreateVirtualRouterElementParams
+	NewDestroyRouterParams(id string, opts ...func(*DestroyRouterParams)) *DestroyRouterParams
+	NewListRoutersParams(opts ...func(*ListRoutersParams)) *ListRoutersParams
+	NewListVirtualRouterElementsParams(opts ...func(*ListVirtualRouterElementsParams)) *ListVirtualRouterElementsParams
+	NewRebootRouterParams(id string, opts ...func(*RebootRouterParams)) *RebootRouterParams
+	NewStartRouterParams(id string, opts ...func(*StartRouterParams)) *StartRouterParams
+	NewStopRouterParams(id string, opts ...func(*StopRouterParams)) *StopRouterParams
+	RebootRouter(p *RebootRouterParams, opts ...OptionFunc) (*RebootRouterResponse, error)
+	RebootRouterRaw(v url.Values) (*RebootRouterResponse, error)
+	RebootRouterRawWithContext(ctx context.Context, v url.Values) (*RebootRouterResponse, error)
+	RebootRouterWithContext(ctx context.Context, p *RebootRouterParams, opts ...OptionFunc) (*RebootRouterResponse, error)
+	RouterExists(id string, opts ...OptionFunc) (bool, error)
+	StartRouter(p *StartRouterParams, opts ...OptionFunc) (*StartRouterResponse, error)
+	StartRouterRaw(v url.Values) (*StartRouterResponse, error)
+	StartRouterRawWithContext(ctx context.Context, v url.Values) (*StartRouterResponse, error)
+	StartRouterWithContext(ctx context.Context, p *StartRouterParams, opts ...OptionFunc) (*StartRouterResponse, error)
+	StopRouter(p *StopRouterParams, opts ...OptionFunc) (*StopRouterResponse, error)
+	StopRouterRaw(v url.Values) (*StopRouterResponse, error)
+	StopRouterRawWithContext(ctx context.Context, v url.Values) (*StopRouterResponse, error)
+	StopRouterWithContext(ctx context.Context, p *StopRouterParams, opts ...OptionFunc) (*StopRouterResponse, error)
+	StreamRouters(ctx context.Context, p *ListRoutersParams) (<-chan *Router, <-chan error)
+	StreamVirtualRouterElements(ctx context.Context, p *ListVirtualRouterElementsParams) (<-chan *VirtualRouterElement, <-chan error)
+	VirtualRouterElementExists(id string, opts ...OptionFunc) (bool, error)
+}
+
+var _ RouterServiceIface = (*RouterService)(nil)
+
+// SSHServiceIface contains all exported methods of SSHService, so callers can depend on it to mock the service
+type SSHServiceIface interface {
+	CreateSSHKeyPair(p *CreateSSHKeyPairParams, opts ...OptionFunc) (*CreateSSHKeyPairResponse, error)
+	CreateSSHKeyPairRaw(v url.Values) (*CreateSSHKeyPairResponse, error)
+	CreateSSHKeyPairRawWithContext(ctx context.Context, v url.Values) (*CreateSSHKeyPairResponse, error)
+	CreateSSHKeyPairWithContext(ctx context.Context, p *CreateSSHKeyPairParams, opts ...OptionFunc) (*CreateSSHKeyPairResponse, error)
+	DeleteSSHKeyPair(p *DeleteSSHKeyPairParams, opts ...OptionFunc) (*DeleteSSHKeyPairResponse, error)
+	DeleteSSHKeyPairRaw(v url.Values) (*DeleteSSHKeyPairResponse, error)
+	DeleteSSHKeyPairRawWithContext(ctx context.Context, v url.Values) (*DeleteSSHKeyPairResponse, error)
+	DeleteSSHKeyPairWithContext(ctx context.Context, p *DeleteSSHKeyPairParams, opts ...OptionFunc) (*DeleteSSHKeyPairResponse, error)
+	ListAllSSHKeyPairs(p *ListSSHKeyPairsParams) ([]*SSHKeyPair, error)
+	ListSSHKeyPairs(p *ListSSHKeyPairsParams) (*ListSSHKeyPairsResponse, error)
+	ListSSHKeyPairsRaw(v url.Values) (*ListSSHKeyPairsResponse, error)
+	ListSSHKeyPairsRawWithContext(ctx context.Context, v url.Values) (*ListSSHKeyPairsResponse, error)
+	ListSSHKeyPairsWhere(p *ListSSHKeyPairsParams, pred func(*SSHKeyPair) bool) ([]*SSHKeyPair, error)
+	ListSSHKeyPairsWithContext(ctx context.Context, p *ListSSHKeyPairsParams) (*ListSSHKeyPairsResponse, error)
+	NewCreateSSHKeyPairParams(name string, opts ...func(*CreateSSHKeyPairParams)) *CreateSSHKeyPairParams
+	NewDeleteSSHKeyPairParams(name string, opts ...func(*DeleteSSHKeyPairParams)) *DeleteSSHKeyPairParams
+	NewListSSHKeyPairsParams(opts ...func(*ListSSHKeyPairsParams)) *ListSSHKeyPairsParams
+	NewRegisterSSHKeyPairParams(name string, publickey string, opts ...func(*RegisterSSHKeyPairParams)) *RegisterSSHKeyPairParams
+	NewResetSSHKeyForVirtualMachineParams(id string, keypair string, opts ...func(*ResetSSHKeyForVirtualMachineParams)) *ResetSSHKeyForVirtualMachineParams
+	RegisterSSHKeyPair(p *RegisterSSHKeyPairParams, opts ...OptionFunc) (*RegisterSSHKeyPairResponse, error)
+	RegisterSSHKeyPairRaw(v url.Values) (*RegisterSSHKeyPairResponse, error)
+	RegisterSSHKeyPairRawWithContext(ctx context.Context, v url.Values) (*RegisterSSHKeyPairResponse, error)
+	RegisterSSHKeyPairWithContext(ctx context.Context, p *RegisterSSHKeyPairParams, opts ...OptionFunc) (*RegisterSSHKeyPairResponse, error)
+	ResetSSHKeyForVirtualMachine(p *ResetSSHKeyForVirtualMachineParams, opts ...OptionFunc) (*ResetSSHKeyForVirtualMachineResponse, error)
+	ResetSSHKeyForVirtualMachineRaw(v url.Values) (*ResetSSHKeyForVirtualMachineResponse, error)
+	ResetSSHKeyForVirtualMachineRawWithContext(ctx context.Context, v url.Values) (*ResetSSHKeyForVirtualMachineResponse, error)
+	ResetSSHKeyForVirtualMachineWithContext(ctx context.Context, p *ResetSSHKeyForVirtualMachineParams, opts ...OptionFunc) (*ResetSSHKeyForVirtualMachineResponse, error)
+	ResetVMKeyAndWait(vmid, keypairName string, stopAndStart bool, opts ...OptionFunc) (*VirtualMachine, error)
+	StreamSSHKeyPairs(ctx context.Context, p *ListSSHKeyPairsParams) (<-chan *SSHKeyPair, <-chan error)
+}
+
+var _ SSHServiceIface = (*SSHService)(nil)
+
+// SecurityGroupServiceIface contains all exported methods of SecurityGroupService, so callers can depend on it to mock the service
+type SecurityGroupServiceIface interface {
+	AuthorizeSecurityGroupEgress(p *AuthorizeSecurityGroupEgressParams, opts ...OptionFunc) (*AuthorizeSecurityGroupEgressResponse, error)
+	AuthorizeSecurityGroupEgressRaw(v url.Values) (*AuthorizeSecurityGroupEgressResponse, error)
+	AuthorizeSecurityGroupEgressRawWithContext(ctx context.Context, v url.Values) (*AuthorizeSecurityGroupEgressResponse, error)
+	AuthorizeSecurityGroupEgressWithContext(ctx context.Context, p *AuthorizeSecurityGroupEgressParams, opts ...OptionFunc) (*AuthorizeSecurityGroupEgressResponse, error)
+	AuthorizeSecurityGroupIngress(p *AuthorizeSecurityGroupIngressParams, opts ...OptionFunc) (*AuthorizeSecurityGroupIngressResponse, error)
+	AuthorizeSecurityGroupIngressRaw(v url.Values) (*AuthorizeSecurityGroupIngressResponse, error)
+	AuthorizeSecurityGroupIngressRawWithContext(ctx context.Context, v url.Values) (*AuthorizeSecurityGroupIngressResponse, error)
+	AuthorizeSecurityGroupIngressWithContext(ctx context.Context, p *AuthorizeSecurityGroupIngressParams, opts ...OptionFunc) (*AuthorizeSecurityGroupIngressResponse, error)
+	CreateSecurityGroup(p *CreateSecurityGroupParams, opts ...OptionFunc) (*CreateSecurityGroupResponse, error)
+	CreateSecurityGroupRaw(v url.Values) (*CreateSecurityGroupResponse, error)
+	CreateSecurityGroupRawWithContext(ctx context.Context, v url.Values) (*CreateSecurityGroupResponse, error)
+	CreateSecurityGroupWithContext(ctx context.Context, p *CreateSecurityGroupParams, opts ...OptionFunc) (*CreateSecurityGroupResponse, error)
+	DeleteSecurityGroup(p *DeleteSecurityGroupParams, opts ...OptionFunc) (*DeleteSecurityGroupResponse, error)
+	DeleteSecurityGroupRaw(v url.Values) (*DeleteSecurityGroupResponse, error)
+	DeleteSecurityGroupRawWithContext(ctx context.Context, v url.Values) (*DeleteSecurityGroupResponse, error)
+	DeleteSecurityGroupWithContext(ctx context.Context, p *DeleteSecurityGroupParams, opts ...OptionFunc) (*DeleteSecurityGroupResponse, error)
+	GetSecurityGroupByID(id string, opts ...OptionFunc) (*SecurityGroup, int, error)
+	GetSecurityGroupByName(name string, opts ...OptionFunc) (*SecurityGroup, int, error)
+	GetSecurityGroupID(keyword string, opts ...OptionFunc) (string, int, error)
+	ListAllSecurityGroups(p *ListSecurityGroupsParams) ([]*SecurityGroup, error)
+	ListSecurityGroups(p *ListSecurityGroupsParams) (*ListSecurityGroupsResponse, error)
+	ListSecurityGroupsRaw(v url.Values) (*ListSecurityGroupsResponse, error)
+	ListSecurityGroupsRawWithContext(ctx context.Context, v url.Values) (*ListSecurityGroupsResponse, error)
+	ListSecurityGroupsWhere(p *ListSecurityGroupsParams, pred func(*SecurityGroup) bool) ([]*SecurityGroup, error)
+	ListSecurityGroupsWithContext(ctx context.Context, p *ListSecurityGroupsParams) (*ListSecurityGroupsResponse, error)
+	NewAuthorizeSecurityGroupEgressParams(opts ...func(*AuthorizeSecurityGroupEgressParams)) *AuthorizeSecurityGroupEgressParams
+	NewAuthorizeSecurityGroupIngressParams(opts ...func(*AuthorizeSecurityGroupIngressParams)) *AuthorizeSecurityGroupIngressParams
+	NewCreateSecurityGroupParams(name string, opts ...func(*CreateSecurityGroupParams)) *CreateSecurityGroupParams
+	NewDeleteSecurityGroupParams(opts ...func(*DeleteSecurityGroupParams)) *DeleteSecurityGroupParams
+	NewListSecurityGroupsParams(opts ...func(*ListSecurityGroupsParams)) *ListSecurityGroupsParams
+	NewRevokeSecurityGroupEgressParams(id string, opts ...func(*RevokeSecurityGroupEgressParams)) *RevokeSecurityGroupEgressParams
+	NewRevokeSecurityGroupIngressParams(id string, opts ...func(*RevokeSecurityGroupIngressParams)) *RevokeSecurityGroupIngressParams
+	ReconcileIngress(groupid string, desired []IngressRuleSpec, opts ...OptionFunc) error
+	RevokeSecurityGroupEgress(p *RevokeSecurityGroupEgressParams, opts ...OptionFunc) (*RevokeSecurityGroupEgressResponse, error)
+	RevokeSecurityGroupEgressRaw(v url.Values) (*RevokeSecurityGroupEgressResponse, error)
+	RevokeSecurityGroupEgressRawWithContext(ctx context.Context, v url.Values) (*RevokeSecurityGroupEgressResponse, error)
+	RevokeSecurityGroupEgressWithContext(ctx context.Context, p *RevokeSecurityGroupEgressParams, opts ...OptionFunc) (*RevokeSecurityGroupEgressResponse, error)
+	RevokeSecurityGroupIngress(p *RevokeSecurityGroupIngressParams, opts ...OptionFunc) (*RevokeSecurityGroupIngressResponse, error)
+	RevokeSecurityGroupIngressRaw(v url.Values) (*RevokeSecurityGroupIngressResponse, error)
+	RevokeSecurityGroupIngressRawWithContext(ctx context.Context, v url.Values) (*RevokeSecurityGroupIngressResponse, error)
+	RevokeSecurityGroupIngressWithContext(ctx context.Context, p *RevokeSecurityGroupIngressParams, opts ...OptionFunc) (*RevokeSecurityGroupIngressResponse, error)
+	SecurityGroupExists(id string, opts ...OptionFunc) (bool, error)
+	StreamSecurityGroups(ctx context.Context, p *ListSecurityGroupsParams) (<-chan *SecurityGroup, <-chan error)
+	WaitForSecurityGroupDeleted(ctx context.Context, id string, timeout time.Duration, opts ...OptionFunc) error
+}
+
+var _ SecurityGroupServiceIface = (*SecurityGroupService)(nil)
+
+// ServiceOfferingServiceIface contains all exported methods of ServiceOfferingService, so callers can depend on it to mock the service
+type ServiceOfferingServiceIface interface {
+	CreateServiceOffering(p *CreateServiceOfferingParams, opts ...OptionFunc) (*CreateServiceOfferingResponse, error)
+	CreateServiceOfferingRaw(v url.Values) (*CreateServiceOfferingResponse, error)
+	CreateServiceOfferingRawWithContext(ctx context.Context, v url.Values) (*CreateServiceOfferingResponse, error)
+	CreateServiceOfferingWithContext(ctx context.Context, p *CreateServiceOfferingParams, opts ...OptionFunc) (*CreateServiceOfferingResponse, error)
+	DeleteServiceOffering(p *DeleteServiceOfferingParams, opts ...OptionFunc) (*DeleteServiceOfferingResponse, error)
+	DeleteServiceOfferingRaw(v url.Values) (*DeleteServiceOfferingResponse, error)
+	DeleteServiceOfferingRawWithContext(ctx context.Context, v url.Values) (*DeleteServiceOfferingResponse, error)
+	DeleteServiceOfferingWithContext(ctx context.Context, p *DeleteServiceOfferingParams, opts ...OptionFunc) (*DeleteServiceOfferingResponse, error)
+	GetServiceOfferingByID(id string, opts ...OptionFunc) (*ServiceOffering, int, error)
+	GetServiceOfferingByName(name string, opts ...OptionFunc) (*ServiceOffering, int, error)
+	GetServiceOfferingID(name string, opts ...OptionFunc) (string, int, error)
+	InvalidateResolveCache()
+	ListAllServiceOfferings(p *ListServiceOfferingsParams) ([]*ServiceOffering, error)
+	ListServiceOfferings(p *ListServiceOfferingsParams) (*ListServiceOfferingsResponse, error)
+	ListServiceOfferingsRaw(v url.Values) (*ListServiceOfferingsResponse, error)
+	ListServiceOfferingsRawWithContext(ctx context.Context, v url.Values) (*ListServiceOfferingsResponse, error)
+	ListServiceOfferingsWhere(p *ListServiceOfferingsParams, pred func(*ServiceOffering) bool) ([]*ServiceOffering, error)
+	ListServiceOfferingsWithContext(ctx context.Context, p *ListServiceOfferingsParams) (*ListServiceOfferingsResponse, error)
+	NewCreateServiceOfferingParams(displaytext string, name string, opts ...func(*CreateServiceOfferingParams)) *CreateServiceOfferingParams
+	NewDeleteServiceOfferingParams(id string, opts ...func(*DeleteServiceOfferingParams)) *DeleteServiceOfferingParams
+	NewListServiceOfferingsParams(opts ...func(*ListServiceOfferingsParams)) *ListServiceOfferingsParams
+	NewUpdateServiceOfferingParams(id string, opts ...func(*UpdateServiceOfferingParams)) *UpdateServiceOfferingParams
+	ResolveOffering(name string) (*ServiceOffering, error)
+	ServiceOfferingExists(id string, opts ...OptionFunc) (bool, error)
+	SetResolveTTL(ttl time.Duration)
+	StreamServiceOfferings(ctx context.Context, p *ListServiceOfferingsParams) (<-chan *ServiceOffering, <-chan error)
+	UpdateMany(ids []string, mutate func(*UpdateServiceOfferingParams), concurrency int, opts ...OptionFunc) (BulkResult[*UpdateServiceOfferingResponse], error)
+	UpdateServiceOffering(p *UpdateServiceOfferingParams, opts ...OptionFunc) (*UpdateServiceOfferingResponse, error)
+	UpdateServiceOfferingRaw(v url.Values) (*UpdateServiceOfferingResponse, error)
+	UpdateServiceOfferingRawWithContext(ctx context.Context, v url.Values) (*UpdateServiceOfferingResponse, error)
+	UpdateServiceOfferingWithContext(ctx context.Context, p *UpdateServiceOfferingParams, opts ...OptionFunc) (*UpdateServiceOfferingResponse, error)
+	WaitForServiceOfferingDeleted(ctx context.Context, id string, timeout time.Duration, opts ...OptionFunc) error
+}
+
+var _ ServiceOfferingServiceIface = (*ServiceOfferingService)(nil)
+
+// SnapshotServiceIface contains all exported methods of SnapshotService, so callers can depend on it to mock the service
+type SnapshotServiceIface interface {
+	CreateSnapshot(p *CreateSnapshotParams, opts ...OptionFunc) (*CreateSnapshotResponse, error)
+	CreateSnapshotAndWaitReady(volumeid string, timeout time.Duration, opts ...OptionFunc) (*Snapshot, error)
+	CreateSnapshotPolicy(p *CreateSnapshotPolicyParams, opts ...OptionFunc) (*CreateSnapshotPolicyResponse, error)
+	CreateSnapshotPolicyRaw(v url.Values) (*CreateSnapshotPolicyResponse, error)
+	CreateSnapshotPolicyRawWithContext(ctx context.Context, v url.Values) (*CreateSnapshotPolicyResponse, error)
+	CreateSnapshotPolicyWithContext(ctx context.Context, p *CreateSnapshotPolicyParams, opts ...OptionFunc) (*CreateSnapshotPolicyResponse, error)
+	CreateSnapshotRaw(v url.Values) (*CreateSnapshotResponse, error)
+	CreateSnapshotRawWithContext(ctx context.Context, v url.Values) (*CreateSnapshotResponse, error)
+	CreateSnapshotWithContext(ctx context.Context, p *CreateSnapshotParams, opts ...OptionFunc) (*CreateSnapshotResponse, error)
+	CreateVMSnapshot(p *CreateVMSnapshotParams, opts ...OptionFunc) (*CreateVMSnapshotResponse, error)
+	CreateVMSnapshotRaw(v url.Values) (*CreateVMSnapshotResponse, error)
+	CreateVMSnapshotRawWithContext(ctx context.Context, v url.Values) (*CreateVMSnapshotResponse, error)
+	CreateVMSnapshotWithContext(ctx context.Context, p *CreateVMSnapshotParams, opts ...OptionFunc) (*CreateVMSnapshotResponse, error)
+	DeleteSnapshot(p *DeleteSnapshotParams, opts ...OptionFunc) (*DeleteSnapshotResponse, error)
+	DeleteSnapshotPolicies(p *DeleteSnapshotPoliciesParams, opts ...OptionFunc) (*DeleteSnapshotPoliciesResponse, error)
+	DeleteSnapshotPoliciesRaw(v url.Values) (*DeleteSnapshotPoliciesResponse, error)
+	DeleteSnapshotPoliciesRawWithContext(ctx context.Context, v url.Values) (*DeleteSnapshotPoliciesResponse, error)
+	DeleteSnapshotPoliciesWithContext(ctx context.Context, p *DeleteSnapshotPoliciesParams, opts ...OptionFunc) (*DeleteSnapshotPoliciesResponse, error)
+	DeleteSnapshotRaw(v url.Values) (*DeleteSnapshotResponse, error)
+	DeleteSnapshotRawWithContext(ctx context.Context, v url.Values) (*DeleteSnapshotResponse, error)
+	DeleteSnapshotWithContext(ctx context.Context, p *DeleteSnapshotParams, opts ...OptionFunc) (*DeleteSnapshotResponse, error)
+	DeleteVMSnapshot(p *DeleteVMSnapshotParams, opts ...OptionFunc) (*DeleteVMSnapshotResponse, error)
+	DeleteVMSnapshotRaw(v url.Values) (*DeleteVMSnapshotResponse, error)
+	DeleteVMSnapshotRawWithContext(ctx context.Context, v url.Values) (*DeleteVMSnapshotResponse, error)
+	DeleteVMSnapshotWithContext(ctx context.Context, p *DeleteVMSnapshotParams, opts ...OptionFunc) (*DeleteVMSnapshotResponse, error)
+	GetSnapshotByID(id string, opts ...OptionFunc) (*Snapshot, int, error)
+	GetSnapshotByName(name string, opts ...OptionFunc) (*Snapshot, int, error)
+	GetSnapshotID(name string, opts ...OptionFunc) (string, int, error)
+	GetSnapshotPolicyByID(id string, opts ...OptionFunc) (*SnapshotPolicy, int, error)
+	GetVMSnapshotID(name string, opts ...OptionFunc) (string, int, error)
+	ListAllSnapshotPolicies(p *ListSnapshotPoliciesParams) ([]*SnapshotPolicy, error)
+	ListAllSnapshots(p *ListSnapshotsParams) ([]*Snapshot, error)
+	ListAllVMSnapshot(p *ListVMSnapshotParams) ([]*VMSnapshot, error)
+	ListSnapshotPolicies(p *ListSnapshotPoliciesParams) (*ListSnapshotPoliciesResponse, error)
+	ListSnapshotPoliciesRaw(v url.Values) (*ListSnapshotPoliciesResponse, error)
+	ListSnapshotPoliciesRawWithContext(ctx context.Context, v url.Values) (*ListSnapshotPoliciesResponse, error)
+	ListSnapshotPoliciesWhere(p *ListSnapshotPoliciesParams, pred func(*SnapshotPolicy) bool) ([]*SnapshotPolicy, error)
+	ListSnapshotPoliciesWithContext(ctx context.Context, p *ListSnapshotPoliciesParams) (*ListSnapshotPoliciesResponse, error)
+	ListSnapshots(p *ListSnapshotsParams) (*ListSnapshotsResponse, error)
+	ListSnapshotsRaw(v url.Values) (*ListSnapshotsResponse, error)
+	ListSnapshotsRawWithContext(ctx context.Context, v url.Values) (*ListSnapshotsResponse, error)
+	ListSnapshotsWhere(p *ListSnapshotsParams, pred func(*Snapshot) bool) ([]*Snapshot, error)
+	ListSnapshotsWithContext(ctx context.Context, p *ListSnapshotsParams) (*ListSnapshotsResponse, error)
+	ListVMSnapshot(p *ListVMSnapshotParams) (*ListVMSnapshotResponse, error)
+	ListVMSnapshotRaw(v url.Values) (*ListVMSnapshotResponse, error)
+	ListVMSnapshotRawWithContext(ctx context.Context, v url.Values) (*ListVMSnapshotResponse, error)
+	ListVMSnapshotWhere(p *ListVMSnapshotParams, pred func(*VMSnapshot) bool) ([]*VMSnapshot, error)
+	ListVMSnapshotWithContext(ctx context.Context, p *ListVMSnapshotParams) (*ListVMSnapshotResponse, error)
+	NewCreateSnapshotParams(volumeid string, opts ...func(*CreateSnapshotParams)) *CreateSnapshotParams
+	NewCreateSnapshotPolicyParams(intervaltype string, maxsnaps int, schedule string, timezone string, volumeid string, opts ...func(*CreateSnapshotPolicyParams)) *CreateSnapshotPolicyParams
+	NewCreateSnapshotPolicyParamsFromOpts(o CreateSnapshotPolicyOpts) *CreateSnapshotPolicyParams
+	NewCreateVMSnapshotParams(virtualmachineid string, opts ...func(*CreateVMSnapshotParams)) *CreateVMSnapshotParams
+	NewDeleteSnapshotParams(id string, opts ...func(*DeleteSnapshotParams)) *DeleteSnapshotParams
+	NewDeleteSnapshotPoliciesParams(opts ...func(*DeleteSnapshotPoliciesParams)) *DeleteSnapshotPoliciesParams
+	NewDeleteVMSnapshotParams(vmsnapshotid string, opts ...func(*DeleteVMSnapshotParams)) *DeleteVMSnapshotParams
+	NewListSnapshotPoliciesParams(opts ...func(*ListSnapshotPoliciesParams)) *ListSnapshotPoliciesParams
+	NewListSnapshotsParams(opts ...func(*ListSnapshotsParams)) *ListSnapshotsParams
+	NewListVMSnapshotParams(opts ...func(*ListVMSnapshotParams)) *ListVMSnapshotParams
+	NewRevertSnapshotParams(id string, opts ...func(*RevertSnapshotParams)) *RevertSnapshotParams
+	NewRevertToVMSnapshotParams(vmsnapshotid string, opts ...func(*RevertToVMSnapshotParams)) *RevertToVMSnapshotParams
+	NewUpdateSnapshotPolicyParams(opts ...func(*UpdateSnapshotPolicyParams)) *UpdateSnapshotPolicyParams
+	RevertSnapshot(p *RevertSnapshotParams, opts ...OptionFunc) (*RevertSnapshotResponse, error)
+	RevertSnapshotRaw(v url.Values) (*RevertSnapshotResponse, error)
+	RevertSnapshotRawWithContext(ctx context.Context, v url.Values) (*RevertSnapshotResponse, error)
+	RevertSnapshotWithContext(ctx context.Context, p *RevertSnapshotParams, opts ...OptionFunc) (*RevertSnapshotResponse, error)
+	RevertToVMSnapshot(p *RevertToVMSnapshotParams, opts ...OptionFunc) (*RevertToVMSnapshotResponse, error)
+	RevertToVMSnapshotRaw(v url.Values) (*RevertToVMSnapshotResponse, error)
+	RevertToVMSnapshotRawWithContext(ctx context.Context, v url.Values) (*RevertToVMSnapshotResponse, error)
+	RevertToVMSnapshotWithContext(ctx context.Context, p *RevertToVMSnapshotParams, opts ...OptionFunc) (*RevertToVMSnapshotResponse, error)
+	SnapshotExists(id string, opts ...OptionFunc) (bool, error)
+	SnapshotPolicyExists(id string, opts ...OptionFunc) (bool, error)
+	StreamSnapshotPolicies(ctx context.Context, p *ListSnapshotPoliciesParams) (<-chan *SnapshotPolicy, <-chan error)
+	StreamSnapshots(ctx context.Context, p *ListSnapshotsParams) (<-chan *Snapshot, <-chan error)
+	StreamVMSnapshot(ctx context.Context, p *ListVMSnapshotParams) (<-chan *VMSnapshot, <-chan error)
+	UpdateSnapshotPolicy(p *UpdateSnapshotPolicyParams, opts ...OptionFunc) (*UpdateSnapshotPolicyResponse, error)
+	UpdateSnapshotPolicyRaw(v url.Values) (*UpdateSnapshotPolicyResponse, error)
+	UpdateSnapshotPolicyRawWithContext(ctx context.Context, v url.Values) (*UpdateSnapshotPolicyResponse, error)
+	UpdateSnapshotPolicyWithContext(ctx context.Context, p *UpdateSnapshotPolicyParams, opts ...OptionFunc) (*UpdateSnapshotPolicyResponse, error)
+	WaitForSnapshotDeleted(ctx context.Context, id string, timeout time.Duration, opts ...OptionFunc) error
+}
+
+var _ SnapshotServiceIface = (*SnapshotService)(nil)
+
+// StoragePoolServiceIface contains all exported methods of StoragePoolService, so callers can depend on it to mock the service
+type StoragePoolServiceIface interface {
+	CancelStorageMaintenance(p *CancelStorageMaintenanceParams, opts ...OptionFunc) (*CancelStorageMaintenanceResponse, error)
+	CancelStorageMaintenanceRaw(v url.Values) (*CancelStorageMaintenanceResponse, error)
+	CancelStorageMaintenanceRawWithContext(ctx context.Context, v url.Values) (*CancelStorageMaintenanceResponse, error)
+	CancelStorageMaintenanceWithContext(ctx context.Context, p *CancelStorageMaintenanceParams, opts ...OptionFunc) (*CancelStorageMaintenanceResponse, error)
+	EnableStorageMaintenance(p *EnableStorageMaintenanceParams, opts ...OptionFunc) (*EnableStorageMaintenanceResponse, error)
+	EnableStorageMaintenanceRaw(v url.Values) (*EnableStorageMaintenanceResponse, error)
+	EnableStorageMaintenanceRawWithContext(ctx context.Context, v url.Values) (*EnableStorageMaintenanceResponse, error)
+	EnableStorageMaintenanceWithContext(ctx context.Context, p *EnableStorageMaintenanceParams, opts ...OptionFunc) (*EnableStorageMaintenanceResponse, error)
+	ListAllStorageProviders(p *ListStorageProvidersParams) ([]*StorageProvider, error)
+	ListStorageProviders(p *ListStorageProvidersParams) (*ListStorageProvidersResponse, error)
+	ListStorageProvidersRaw(v url.Values) (*ListStorageProvidersResponse, error)
+	ListStorageProvidersRawWithContext(ctx context.Context, v url.Values) (*ListStorageProvidersResponse, error)
+	ListStorageProvidersWhere(p *ListStorageProvidersParams, pred func(*StorageProvider) bool) ([]*StorageProvider, error)
+	ListStorageProvidersWithContext(ctx context.Context, p *ListStorageProvidersParams) (*ListStorageProvidersResponse, error)
+	NewCancelStorageMaintenanceParams(id string, opts ...func(*CancelStorageMaintenanceParams)) *CancelStorageMaintenanceParams
+	NewEnableStorageMaintenanceParams(id string, opts ...func(*EnableStorageMaintenanceParams)) *EnableStorageMaintenanceParams
+	NewListStorageProvidersParams(storagePoolType string, opts ...func(*ListStorageProvidersParams)) *ListStorageProvidersParams
+	StreamStorageProviders(ctx context.Context, p *ListStorageProvidersParams) (<-chan *StorageProvider, <-chan error)
+}
+
+var _ StoragePoolServiceIface = (*StoragePoolService)(nil)
+
+// StratosphereSSPServiceIface contains all exported methods of StratosphereSSPService, so callers can depend on it to mock the service
+type StratosphereSSPServiceIface interface {
+	AddStratosphereSsp(p *AddStratosphereSspParams, opts ...OptionFunc) (*AddStratosphereSspResponse, error)
+	AddStratosphereSspRaw(v url.Values) (*AddStratosphereSspResponse, error)
+	AddStratosphereSspRawWithContext(ctx context.Context, v url.Values) (*AddStratosphereSspResponse, error)
+	AddStratosphereSspWithContext(ctx context.Context, p *AddStratosphereSspParams, opts ...OptionFunc) (*AddStratosphereSspResponse, error)
+	DeleteStratosphereSsp(p *DeleteStratosphereSspParams, opts ...OptionFunc) (*DeleteStratosphereSspResponse, error)
+	DeleteStratosphereSspRaw(v url.Values) (*DeleteStratosphereSspResponse, error)
+	DeleteStratosphereSspRawWithContext(ctx context.Context, v url.Values) (*DeleteStratosphereSspResponse, error)
+	DeleteStratosphereSspWithContext(ctx context.Context, p *DeleteStratosphereSspParams, opts ...OptionFunc) (*DeleteStratosphereSspResponse, error)
+	NewAddStratosphereSspParams(name string, url string, zoneid string, opts ...func(*AddStratosphereSspParams)) *AddStratosphereSspParams
+	NewAddStratosphereSspParamsFromOpts(o AddStratosphereSspOpts) *AddStratosphereSspParams
+	NewDeleteStratosphereSspParams(hostid string, opts ...func(*DeleteStratosphereSspParams)) *DeleteStratosphereSspParams
+}
+
+var _ StratosphereSSPServiceIface = (*StratosphereSSPService)(nil)
+
+// SwiftServiceIface contains all exported methods of SwiftService, so callers can depend on it to mock the service
+type SwiftServiceIface interface {
+	AddSwift(p *AddSwiftParams, opts ...OptionFunc) (*AddSwiftResponse, error)
+	AddSwiftRaw(v url.Values) (*AddSwiftResponse, error)
+	AddSwiftRawWithContext(ctx context.Context, v url.Values) (*AddSwiftResponse, error)
+	AddSwiftWithContext(ctx context.Context, p *AddSwiftParams, opts ...OptionFunc) (*AddSwiftResponse, error)
+	GetSwiftID(keyword string, opts ...OptionFunc) (string, int, error)
+	ListAllSwifts(p *ListSwiftsParams) ([]*Swift, error)
+	ListSwifts(p *ListSwiftsParams) (*ListSwiftsResponse, error)
+	ListSwiftsRaw(v url.Values) (*ListSwiftsResponse, error)
+	ListSwiftsRawWithContext(ctx context.Context, v url.Values) (*ListSwiftsResponse, error)
+	ListSwiftsWhere(p *ListSwiftsParams, pred func(*Swift) bool) ([]*Swift, error)
+	ListSwiftsWithContext(ctx context.Context, p *ListSwiftsParams) (*ListSwiftsResponse, error)
+	NewAddSwiftParams(url string, opts ...func(*AddSwiftParams)) *AddSwiftParams
+	NewListSwiftsParams(opts ...func(*ListSwiftsParams)) *ListSwiftsParams
+	StreamSwifts(ctx context.Context, p *ListSwiftsParams) (<-chan *Swift, <-chan error)
+}
+
+var _ SwiftServiceIface = (*SwiftService)(nil)
+
+// SystemCapacityServiceIface contains all exported methods of SystemCapacityService, so callers can depend on it to mock the service
+type SystemCapacityServiceIface interface {
+	ListAllCapacity(p *ListCapacityParams) ([]*Capacity, error)
+	ListCapacity(p *ListCapacityParams) (*ListCapacityResponse, error)
+	ListCapacityRaw(v url.Values) (*ListCapacityResponse, error)
+	ListCapacityRawWithContext(ctx context.Context, v url.Values) (*ListCapacityResponse, error)
+	ListCapacityWhere(p *ListCapacityParams, pred func(*Capacity) bool) ([]*Capacity, error)
+	ListCapacityWithContext(ctx context.Context, p *ListCapacityParams) (*ListCapacityResponse, error)
+	NewListCapacityParams(opts ...func(*ListCapacityParams)) *ListCapacityParams
+	StreamCapacity(ctx context.Context, p *ListCapacityParams) (<-chan *Capacity, <-chan error)
+	WatchCapacity(ctx context.Context, zoneid string, interval time.Duration, threshold float64, opts ...OptionFunc) <-chan CapacityAlert
+}
+
+var _ SystemCapacityServiceIface = (*SystemCapacityService)(nil)
+
+// SystemVMServiceIface contains all exported methods of SystemVMService, so callers can depend on it to mock the service
+type SystemVMServiceIface interface {
+	ChangeServiceForSystemVm(p *ChangeServiceForSystemVmParams, opts ...OptionFunc) (*ChangeServiceForSystemVmResponse, error)
+	ChangeServiceForSystemVmRaw(v url.Values) (*ChangeServiceForSystemVmResponse, error)
+	ChangeServiceForSystemVmRawWithContext(ctx context.Context, v url.Values) (*ChangeServiceForSystemVmResponse, error)
+	ChangeServiceForSystemVmWithContext(ctx context.Context, p *ChangeServiceForSystemVmParams, opts ...OptionFunc) (*ChangeServiceForSystemVmResponse, error)
+	DestroySystemVm(p *DestroySystemVmParams, opts ...OptionFunc) (*DestroySystemVmResponse, error)
+	DestroySystemVmRaw(v url.Values) (*DestroySystemVmResponse, error)
+	DestroySystemVmRawWithContext(ctx context.Context, v url.Values) (*DestroySystemVmResponse, error)
+	DestroySystemVmWithContext(ctx context.Context, p *DestroySystemVmParams, opts ...OptionFunc) (*DestroySystemVmResponse, error)
+	GetSystemVmByID(id string, opts ...OptionFunc) (*SystemVm, int, error)
+	GetSystemVmByName(name string, opts ...OptionFunc) (*SystemVm, int, error)
+	GetSystemVmID(name string, opts ...OptionFunc) (string, int, error)
+	ListAllSystemVms(p *ListSystemVmsParams) ([]*SystemVm, error)
+	ListSystemVms(p *ListSystemVmsParams) (*ListSystemVmsResponse, error)
+	ListSystemVmsRaw(v url.Values) (*ListSystemVmsResponse, error)
+	ListSystemVmsRawWithContext(ctx context.Context, v url.Values) (*ListSystemVmsResponse, error)
+	ListSystemVmsWhere(p *ListSystemVmsParams, pred func(*SystemVm) bool) ([]*SystemVm, error)
+	ListSystemVmsWithContext(ctx context.Context, p *ListSystemVmsParams) (*ListSystemVmsResponse, error)
+	MigrateSystemVMAndWait(systemvmid, hostid string, opts ...OptionFunc) (*SystemVm, error)
+	MigrateSystemVm(p *MigrateSystemVmParams, opts ...OptionFunc) (*MigrateSystemVmResponse, error)
+	MigrateSystemVmRaw(v url.Values) (*MigrateSystemVmResponse, error)
+	MigrateSystemVmRawWithContext(ctx context.Context, v url.Values) (*MigrateSystemVmResponse, error)
+	MigrateSystemVmWithContext(ctx context.Context, p *MigrateSystemVmParams, opts ...OptionFunc) (*MigrateSystemVmResponse, error)
+	NewChangeServiceForSystemVmParams(id string, serviceofferingid string, opts ...func(*ChangeServiceForSystemVmParams)) *ChangeServiceForSystemVmParams
+	NewDestroySystemVmParams(id string, opts ...func(*DestroySystemVmParams)) *DestroySystemVmParams
+	NewListSystemVmsParams(opts ...func(*ListSystemVmsParams)) *ListSystemVmsParams
+	NewMigrateSystemVmParams(hostid string, virtualmachineid string, opts ...func(*MigrateSystemVmParams)) *MigrateSystemVmParams
+	NewRebootSystemVmParams(id string, opts ...func(*RebootSystemVmParams)) *RebootSystemVmParams
+	NewScaleSystemVmParams(id string, serviceofferingid string, opts ...func(*ScaleSystemVmParams)) *ScaleSystemVmParams
+	NewStartSystemVmParams(id string, opts ...func(*StartSystemVmParams)) *StartSystemVmParams
+	NewStopSystemVmParams(id string, opts ...func(*StopSystemVmParams)) *StopSystemVmParams
+	RebootSystemVm(p *RebootSystemVmParams, opts ...OptionFunc) (*RebootSystemVmResponse, error)
+	RebootSystemVmRaw(v url.Values) (*RebootSystemVmResponse, error)
+	RebootSystemVmRawWithContext(ctx context.Context, v url.Values) (*RebootSystemVmResponse, error)
+	RebootSystemVmWithContext(ctx context.Context, p *RebootSystemVmParams, opts ...OptionFunc) (*RebootSystemVmResponse, error)
+	ScaleSystemVm(p *ScaleSystemVmParams, opts ...OptionFunc) (*ScaleSystemVmResponse, error)
+	ScaleSystemVmRaw(v url.Values) (*ScaleSystemVmResponse, error)
+	ScaleSystemVmRawWithContext(ctx context.Context, v url.Values) (*ScaleSystemVmResponse, error)
+	ScaleSystemVmWithContext(ctx context.Context, p *ScaleSystemVmParams, opts ...OptionFunc) (*ScaleSystemVmResponse, error)
+	StartSystemVm(p *StartSystemVmParams, opts ...OptionFunc) (*StartSystemVmResponse, error)
+	StartSystemVmRaw(v url.Values) (*StartSystemVmResponse, error)
+	StartSystemVmRawWithContext(ctx context.Context, v url.Values) (*StartSystemVmResponse, error)
+	StartSystemVmWithContext(ctx context.Context, p *StartSystemVmParams, opts ...OptionFunc) (*StartSystemVmResponse, error)
+	StopSystemVm(p *StopSystemVmParams, opts ...OptionFunc) (*StopSystemVmResponse, error)
+	StopSystemVmRaw(v url.Values) (*StopSystemVmResponse, error)
+	StopSystemVmRawWithContext(ctx context.Context, v url.Values) (*StopSystemVmResponse, error)
+	StopSystemVmWithContext(ctx context.Context, p *StopSystemVmParams, opts ...OptionFunc) (*StopSystemVmResponse, error)
+	StreamSystemVms(ctx context.Context, p *ListSystemVmsParams) (<-chan *SystemVm, <-chan error)
+	SystemVmExists(id string, opts ...OptionFunc) (bool, error)
+}
+
+var _ SystemVMServiceIface = (*SystemVMService)(nil)
+
+// TemplateServiceIface contains all exported methods of TemplateService, so callers can depend on it to mock the service
+type TemplateServiceIface interface {
+	CopyTemplate(p *CopyTemplateParams, opts ...OptionFunc) (*CopyTemplateResponse, error)
+	CopyTemplateRaw(v url.Values) (*CopyTemplateResponse, error)
+	CopyTemplateRawWithContext(ctx context.Context, v url.Values) (*CopyTemplateResponse, error)
+	CopyTemplateWithContext(ctx context.Context, p *CopyTemplateParams, opts ...OptionFunc) (*CopyTemplateResponse, error)
+	CreateTemplate(p *CreateTemplateParams, opts ...OptionFunc) (*CreateTemplateResponse, error)
+	CreateTemplateRaw(v url.Values) (*CreateTemplateResponse, error)
+	CreateTemplateRawWithContext(ctx context.Context, v url.Values) (*CreateTemplateResponse, error)
+	CreateTemplateWithContext(ctx context.Context, p *CreateTemplateParams, opts ...OptionFunc) (*CreateTemplateResponse, error)
+	DeleteTemplate(p *DeleteTemplateParams, opts ...OptionFunc) (*DeleteTemplateResponse, error)
+	DeleteTemplateRaw(v url.Values) (*DeleteTemplateResponse, error)
+	DeleteTemplateRawWithContext(ctx context.Context, v url.Values) (*DeleteTemplateResponse, error)
+	DeleteTemplateWithContext(ctx context.Context, p *DeleteTemplateParams, opts ...OptionFunc) (*DeleteTemplateResponse, error)
+	ExtractTemplate(p *ExtractTemplateParams, opts ...OptionFunc) (*ExtractTemplateResponse, error)
+	ExtractTemplateRaw(v url.Values) (*ExtractTemplateResponse, error)
+	ExtractTemplateRawWithContext(ctx context.Context, v url.Values) (*ExtractTemplateResponse, error)
+	ExtractTemplateWithContext(ctx context.Context, p *ExtractTemplateParams, opts ...OptionFunc) (*ExtractTemplateResponse, error)
+	ExtractToFile(templateid, zoneid, mode, destPath string) error
+	FindTemplate(name, zone string, filters ...string) (*Template, error)
+	GetTemplateByID(id string, templatefilter string, opts ...OptionFunc) (*Template, int, error)
+	GetTemplateByName(name string, templatefilter string, zoneid string, opts ...OptionFunc) (*Template, int, error)
+	GetTemplateID(name string, templatefilter string, zoneid string, opts ...OptionFunc) (string, int, error)
+	GetTemplatePermissionByID(id string, opts ...OptionFunc) (*TemplatePermission, int, error)
+	GetUploadParamsForTemplate(p *GetUploadParamsForTemplateParams, opts ...OptionFunc) (*GetUploadParamsForTemplateResponse, error)
+	GetUploadParamsForTemplateRaw(v url.Values) (*GetUploadParamsForTemplateResponse, error)
+	GetUploadParamsForTemplateRawWithContext(ctx context.Context, v url.Values) (*GetUploadParamsForTemplateResponse, error)
+	GetUploadParamsForTemplateWithContext(ctx context.Context, p *GetUploadParamsForTemplateParams, opts ...OptionFunc) (*GetUploadParamsForTemplateResponse, error)
+	ListAllTemplates(p *ListTemplatesParams) ([]*Template, error)
+	ListTemplateOVFProperties(templateid string) ([]*OVFProperty, error)
+	ListTemplatePermissions(p *ListTemplatePermissionsParams) (*ListTemplatePermissionsResponse, error)
+	ListTemplatePermissionsRaw(v url.Values) (*ListTemplatePermissionsResponse, error)
+	ListTemplatePermissionsRawWithContext(ctx context.Context, v url.Values) (*ListTemplatePermissionsResponse, error)
+	ListTemplatePermissionsWhere(p *ListTemplatePermissionsParams, pred func(*TemplatePermission) bool) ([]*TemplatePermission, error)
+	ListTemplatePermissionsWithContext(ctx context.Context, p *ListTemplatePermissionsParams) (*ListTemplatePermissionsResponse, error)
+	ListTemplates(p *ListTemplatesParams) (*ListTemplatesResponse, error)
+	ListTemplatesRaw(v url.Values) (*ListTemplatesResponse, error)
+	ListTemplatesRawWithContext(ctx context.Context, v url.Values) (*ListTemplatesResponse, error)
+	ListTemplatesWhere(p *ListTemplatesParams, pred func(*Template) bool) ([]*Template, error)
+	ListTemplatesWithContext(ctx context.Context, p *ListTemplatesParams) (*ListTemplatesResponse, error)
+	NewCopyTemplateParams(destzoneid string, id string, opts ...func(*CopyTemplateParams)) *CopyTemplateParams
+	NewCreateTemplateParams(displaytext string, name string, ostypeid string, opts ...func(*CreateTemplateParams)) *CreateTemplateParams
+	NewCreateTemplateParamsFromOpts(o CreateTemplateOpts) *CreateTemplateParams
+	NewDeleteTemplateParams(id string, opts ...func(*DeleteTemplateParams)) *DeleteTemplateParams
+	NewExtractTemplateParams(id string, mode string, opts ...func(*ExtractTemplateParams)) *ExtractTemplateParams
+	NewGetUploadParamsForTemplateParams(displaytext string, format string, hypervisor string, name string, ostypeid string, zoneid string, opts ...func(*GetUploadParamsForTemplateParams)) *GetUploadParamsForTemplateParams
+	NewGetUploadParamsForTemplateParamsFromOpts(o GetUploadParamsForTemplateOpts) *GetUploadParamsForTemplateParams
+	NewListTemplatePermissionsParams(id string, opts ...func(*ListTemplatePermissionsParams)) *ListTemplatePermissionsParams
+	NewListTemplatesParams(templatefilter string, opts ...func(*ListTemplatesParams)) *ListTemplatesParams
+	NewPrepareTemplateParams(templateid string, zoneid string, opts ...func(*PrepareTemplateParams)) *PrepareTemplateParams
+	NewRegisterTemplateParams(displaytext string, format string, hypervisor string, name string, ostypeid string, url string, zoneid string, opts ...func(*RegisterTemplateParams)) *RegisterTemplateParams
+	NewRegisterTemplateParamsFromOpts(o RegisterTemplateOpts) *RegisterTemplateParams
+	NewUpdateTemplateParams(id string, opts ...func(*UpdateTemplateParams)) *UpdateTemplateParams
+	NewUpdateTemplatePermissionsParams(id string, opts ...func(*UpdateTemplatePermissionsParams)) *UpdateTemplatePermissionsParams
+	NewUpgradeRouterTemplateParams(opts ...func(*UpgradeRouterTemplateParams)) *UpgradeRouterTemplateParams
+	PrepareTemplate(p *PrepareTemplateParams, opts ...OptionFunc) (*PrepareTemplateResponse, error)
+	PrepareTemplateRaw(v url.Values) (*PrepareTemplateResponse, error)
+	PrepareTemplateRawWithContext(ctx context.Context, v url.Values) (*PrepareTemplateResponse, error)
+	PrepareTemplateWithContext(ctx context.Context, p *PrepareTemplateParams, opts ...OptionFunc) (*PrepareTemplateResponse, error)
+	RegisterTemplate(p *RegisterTemplateParams, opts ...OptionFunc) (*RegisterTemplateResponse, error)
+	RegisterTemplateRaw(v url.Values) (*RegisterTemplateResponse, error)
+	RegisterTemplateRawWithContext(ctx context.Context, v url.Values) (*RegisterTemplateResponse, error)
+	RegisterTemplateWithContext(ctx context.Context, p *RegisterTemplateParams, opts ...OptionFunc) (*RegisterTemplateResponse, error)
+	StreamTemplates(ctx context.Context, p *ListTemplatesParams) (<-chan *Template, <-chan error)
+	TemplateExists(id string, templatefilter string, opts ...OptionFunc) (bool, error)
+	TemplatePermissionExists(id string, opts ...OptionFunc) (bool, error)
+	UpdateMany(ids []string, mutate func(*UpdateTemplateParams), concurrency int, opts ...OptionFunc) (BulkResult[*UpdateTemplateResponse], error)
+	UpdateTemplate(p *UpdateTemplateParams, opts ...OptionFunc) (*UpdateTemplateResponse, error)
+	UpdateTemplatePermissions(p *UpdateTemplatePermissionsParams, opts ...OptionFunc) (*UpdateTemplatePermissionsResponse, error)
+	UpdateTemplatePermissionsRaw(v url.Values) (*UpdateTemplatePermissionsResponse, error)
+	UpdateTemplatePermissionsRawWithContext(ctx context.Context, v url.Values) (*UpdateTemplatePermissionsResponse, error)
+	UpdateTemplatePermissionsWithContext(ctx context.Context, p *UpdateTemplatePermissionsParams, opts ...OptionFunc) (*UpdateTemplatePermissionsResponse, error)
+	UpdateTemplateRaw(v url.Values) (*UpdateTemplateResponse, error)
+	UpdateTemplateRawWithContext(ctx context.Context, v url.Values) (*UpdateTemplateResponse, error)
+	UpdateTemplateWithContext(ctx context.Context, p *UpdateTemplateParams, opts ...OptionFunc) (*UpdateTemplateResponse, error)
+	UpgradeRouterTemplate(p *UpgradeRouterTemplateParams, opts ...OptionFunc) (*UpgradeRouterTemplateResponse, error)
+	UpgradeRouterTemplateRaw(v url.Values) (*UpgradeRouterTemplateResponse, error)
+	UpgradeRouterTemplateRawWithContext(ctx context.Context, v url.Values) (*UpgradeRouterTemplateResponse, error)
+	UpgradeRouterTemplateWithContext(ctx context.Context, p *UpgradeRouterTemplateParams, opts ...OptionFunc) (*UpgradeRouterTemplateResponse, error)
+	UploadTemplate(up *GetUploadParamsForTemplateResponse, r io.Reader) error
+}
+
+var _ TemplateServiceIface = (*TemplateService)(nil)
+
+// UCSServiceIface contains all exported methods of UCSService, so callers can depend on it to mock the service
+type UCSServiceIface interface {
+	AddUcsManager(p *AddUcsManagerParams, opts ...OptionFunc) (*AddUcsManagerResponse, error)
+	AddUcsManagerRaw(v url.Values) (*AddUcsManagerResponse, error)
+	AddUcsManagerRawWithContext(ctx context.Context, v url.Values) (*AddUcsManagerResponse, error)
+	AddUcsManagerWithContext(ctx context.Context, p *AddUcsManagerParams, opts ...OptionFunc) (*AddUcsManagerResponse, error)
+	AssociateUcsProfileToBlade(p *AssociateUcsProfileToBladeParams, opts ...OptionFunc) (*AssociateUcsProfileToBladeResponse, error)
+	AssociateUcsProfileToBladeRaw(v url.Values) (*AssociateUcsProfileToBladeResponse, error)
+	AssociateUcsProfileToBladeRawWithContext(ctx context.Context, v url.Values) (*AssociateUcsProfileToBladeResponse, error)
+	AssociateUcsProfileToBladeWithContext(ctx context.Context, p *AssociateUcsProfileToBladeParams, opts ...OptionFunc) (*AssociateUcsProfileToBladeResponse, error)
+	DeleteUcsManager(p *DeleteUcsManagerParams, opts ...OptionFunc) (*DeleteUcsManagerResponse, error)
+	DeleteUcsManagerRaw(v url.Values) (*DeleteUcsManagerResponse, error)
+	DeleteUcsManagerRawWithContext(ctx context.Context, v url.Values) (*DeleteUcsManagerResponse, error)
+	DeleteUcsManagerWithContext(ctx context.Context, p *DeleteUcsManagerParams, opts ...OptionFunc) (*DeleteUcsManagerResponse, error)
+	GetUcsManagerByID(id string, opts ...OptionFunc) (*UcsManager, int, error)
+	GetUcsManagerByName(name string, opts ...OptionFunc) (*UcsManager, int, error)
+	GetUcsManagerID(keyword string, opts ...OptionFunc) (string, int, error)
+	ListAllUcsBlades(p *ListUcsBladesParams) ([]*UcsBlade, error)
+	ListAllUcsManagers(p *ListUcsManagersParams) ([]*UcsManager, error)
+	ListAllUcsProfiles(p *ListUcsProfilesParams) ([]*UcsProfile, error)
+	ListUcsBlades(p *ListUcsBladesParams) (*ListUcsBladesResponse, error)
+	ListUcsBladesRaw(v url.Values) (*ListUcsBladesResponse, error)
+	ListUcsBladesRawWithContext(ctx context.Context, v url.Values) (*ListUcsBladesResponse, error)
+	ListUcsBladesWhere(p *ListUcsBladesParams, pred func(*UcsBlade) bool) ([]*UcsBlade, error)
+	ListUcsBladesWithContext(ctx context.Context, p *ListUcsBladesParams) (*ListUcsBladesResponse, error)
+	ListUcsManagers(p *ListUcsManagersParams) (*ListUcsManagersResponse, error)
+	ListUcsManagersRaw(v url.Values) (*ListUcsManagersResponse, error)
+	ListUcsManagersRawWithContext(ctx context.Context, v url.Values) (*ListUcsManagersResponse, error)
+	ListUcsManagersWhere(p *ListUcsManagersParams, pred func(*UcsManager) bool) ([]*UcsManager, error)
+	ListUcsManagersWithContext(ctx context.Context, p *ListUcsManagersParams) (*ListUcsManagersResponse, error)
+	ListUcsProfiles(p *ListUcsProfilesParams) (*ListUcsProfilesResponse, error)
+	ListUcsProfilesRaw(v url.Values) (*ListUcsProfilesResponse, error)
+	ListUcsProfilesRawWithContext(ctx context.Context, v url.Values) (*ListUcsProfilesResponse, error)
+	ListUcsProfilesWhere(p *ListUcsProfilesParams, pred func(*UcsProfile) bool) ([]*UcsProfile, error)
+	ListUcsProfilesWithContext(ctx context.Context, p *ListUcsProfilesParams) (*ListUcsProfilesResponse, error)
+	NewAddUcsManagerParams(password string, url string, username string, zoneid string, opts ...func(*AddUcsManagerParams)) *AddUcsManagerParams
+	NewAddUcsManagerParamsFromOpts(o AddUcsManagerOpts) *AddUcsManagerParams
+	NewAssociateUcsProfileToBladeParams(bladeid string, profiledn string, ucsmanagerid string, opts ...func(*AssociateUcsProfileToBladeParams)) *AssociateUcsProfileToBladeParams
+	NewAssociateUcsProfileToBladeParamsFromOpts(o AssociateUcsProfileToBladeOpts) *AssociateUcsProfileToBladeParams
+	NewDeleteUcsManagerParams(ucsmanagerid string, opts ...func(*DeleteUcsManagerParams)) *DeleteUcsManagerParams
+	NewListUcsBladesParams(ucsmanagerid string, opts ...func(*ListUcsBladesParams)) *ListUcsBladesParams
+	NewListUcsManagersParams(opts ...func(*ListUcsManagersParams)) *ListUcsManagersParams
+	NewListUcsProfilesParams(ucsmanagerid string, opts ...func(*ListUcsProfilesParams)) *ListUcsProfilesParams
+	StreamUcsBlades(ctx context.Context, p *ListUcsBladesParams) (<-chan *UcsBlade, <-chan error)
+	StreamUcsManagers(ctx context.Context, p *ListUcsManagersParams) (<-chan *UcsManager, <-chan error)
+	StreamUcsProfiles(ctx context.Context, p *ListUcsProfilesParams) (<-chan *UcsProfile, <-chan error)
+	UcsManagerExists(id string, opts ...OptionFunc) (bool, error)
+}
+
+var _ UCSServiceIface = (*UCSService)(nil)
+
+// UsageServiceIface contains all exported methods of UsageService, so callers can depend on it to mock the service
+type UsageServiceIface interface {
+	AddTrafficMonitor(p *AddTrafficMonitorParams, opts ...OptionFunc) (*AddTrafficMonitorResponse, error)
+	AddTrafficMonitorRaw(v url.Values) (*AddTrafficMonitorResponse, error)
+	AddTrafficMonitorRawWithContext(ctx context.Context, v url.Values) (*AddTrafficMonitorResponse, error)
+	AddTrafficMonitorWithContext(ctx context.Context, p *AddTrafficMonitorParams, opts ...OptionFunc) (*AddTrafficMonitorResponse, error)
+	AddTrafficType(p *AddTrafficTypeParams, opts ...OptionFunc) (*AddTrafficTypeResponse, error)
+	AddTrafficTypeRaw(v url.Values) (*AddTrafficTypeResponse, error)
+	AddTrafficTypeRawWithContext(ctx context.Context, v url.Values) (*AddTrafficTypeResponse, error)
+	AddTrafficTypeWithContext(ctx context.Context, p *AddTrafficTypeParams, opts ...OptionFunc) (*AddTrafficTypeResponse, error)
+	DeleteTrafficMonitor(p *DeleteTrafficMonitorParams, opts ...OptionFunc) (*DeleteTrafficMonitorResponse, error)
+	DeleteTrafficMonitorRaw(v url.Values) (*DeleteTrafficMonitorResponse, error)
+	DeleteTrafficMonitorRawWithContext(ctx context.Context, v url.Values) (*DeleteTrafficMonitorResponse, error)
+	DeleteTrafficMonitorWithContext(ctx context.Context, p *DeleteTrafficMonitorParams, opts ...OptionFunc) (*DeleteTrafficMonitorResponse, error)
+	DeleteTrafficType(p *DeleteTrafficTypeParams, opts ...OptionFunc) (*DeleteTrafficTypeResponse, error)
+	DeleteTrafficTypeRaw(v url.Values) (*DeleteTrafficTypeResponse, error)
+	DeleteTrafficTypeRawWithContext(ctx context.Context, v url.Values) (*DeleteTrafficTypeResponse, error)
+	DeleteTrafficTypeWithContext(ctx context.Context, p *DeleteTrafficTypeParams, opts ...OptionFunc) (*DeleteTrafficTypeResponse, error)
+	GenerateUsageRecords(p *GenerateUsageRecordsParams, opts ...OptionFunc) (*GenerateUsageRecordsResponse, error)
+	GenerateUsageRecordsRaw(v url.Values) (*GenerateUsageRecordsResponse, error)
+	GenerateUsageRecordsRawWithContext(ctx context.Context, v url.Values) (*GenerateUsageRecordsResponse, error)
+	GenerateUsageRecordsWithContext(ctx context.Context, p *GenerateUsageRecordsParams, opts ...OptionFunc) (*GenerateUsageRecordsResponse, error)
+	GetTrafficTypeID(keyword string, physicalnetworkid string, opts ...OptionFunc) (string, int, error)
+	ListAllTrafficMonitors(p *ListTrafficMonitorsParams) ([]*TrafficMonitor, error)
+	ListAllTrafficTypeImplementors(p *ListTrafficTypeImplementorsParams) ([]*TrafficTypeImplementor, error)
+	ListAllTrafficTypes(p *ListTrafficTypesParams) ([]*TrafficType, error)
+	ListAllUsageRecords(p *ListUsageRecordsParams) ([]*UsageRecord, error)
+	ListTrafficMonitors(p *ListTrafficMonitorsParams) (*ListTrafficMonitorsResponse, error)
+	ListTrafficMonitorsRaw(v url.Values) (*ListTrafficMonitorsResponse, error)
+	ListTrafficMonitorsRawWithContext(ctx context.Context, v url.Values) (*ListTrafficMonitorsResponse, error)
+	ListTrafficMonitorsWhere(p *ListTrafficMonitorsParams, pred func(*TrafficMonitor) bool) ([]*TrafficMonitor, error)
+	ListTrafficMonitorsWithContext(ctx context.Context, p *ListTrafficMonitorsParams) (*ListTrafficMonitorsResponse, error)
+	ListTrafficTypeImplementors(p *ListTrafficTypeImplementorsParams) (*ListTrafficTypeImplementorsResponse, error)
+	ListTrafficTypeImplementorsRaw(v url.Values) (*ListTrafficTypeImplementorsResponse, error)
+	ListTrafficTypeImplementorsRawWithContext(ctx context.Context, v url.Values) (*ListTrafficTypeImplementorsResponse, error)
+	ListTrafficTypeImplementorsWhere(p *ListTrafficTypeImplementorsParams, pred func(*TrafficTypeImplementor) bool) ([]*TrafficTypeImplementor, error)
+	ListTrafficTypeImplementorsWithContext(ctx context.Context, p *ListTrafficTypeImplementorsParams) (*ListTrafficTypeImplementorsResponse, error)
+	ListTrafficTypes(p *ListTrafficTypesParams) (*ListTrafficTypesResponse, error)
+	ListTrafficTypesRaw(v url.Values) (*ListTrafficTypesResponse, error)
+	ListTrafficTypesRawWithContext(ctx context.Context, v url.Values) (*ListTrafficTypesResponse, error)
+	ListTrafficTypesWhere(p *ListTrafficTypesParams, pred func(*TrafficType) bool) ([]*TrafficType, error)
+	ListTrafficTypesWithContext(ctx context.Context, p *ListTrafficTypesParams) (*ListTrafficTypesResponse, error)
+	ListUsageRecords(p *ListUsageRecordsParams) (*ListUsageRecordsResponse, error)
+	ListUsageRecordsRaw(v url.Values) (*ListUsageRecordsResponse, error)
+	ListUsageRecordsRawWithContext(ctx context.Context, v url.Values) (*ListUsageRecordsResponse, error)
+	ListUsageRecordsWhere(p *ListUsageRecordsParams, pred func(*UsageRecord) bool) ([]*UsageRecord, error)
+	ListUsageRecordsWithContext(ctx context.Context, p *ListUsageRecordsParams) (*ListUsageRecordsResponse, error)
+	ListUsageTypes(p *ListUsageTypesParams) (*ListUsageTypesResponse, error)
+	ListUsageTypesRaw(v url.Values) (*ListUsageTypesResponse, error)
+	ListUsageTypesRawWithContext(ctx context.Context, v url.Values) (*ListUsageTypesResponse, error)
+	ListUsageTypesWhere(p *ListUsageTypesParams, pred func(*UsageType) bool) ([]*UsageType, error)
+	ListUsageTypesWithContext(ctx context.Context, p *ListUsageTypesParams) (*ListUsageTypesResponse, error)
+	NewAddTrafficMonitorParams(url string, zoneid string, opts ...func(*AddTrafficMonitorParams)) *AddTrafficMonitorParams
+	NewAddTrafficTypeParams(physicalnetworkid string, traffictype string, opts ...func(*AddTrafficTypeParams)) *AddTrafficTypeParams
+	NewDeleteTrafficMonitorParams(id string, opts ...func(*DeleteTrafficMonitorParams)) *DeleteTrafficMonitorParams
+	NewDeleteTrafficTypeParams(id string, opts ...func(*DeleteTrafficTypeParams)) *DeleteTrafficTypeParams
+	NewGenerateUsageRecordsParams(enddate string, startdate string, opts ...func(*GenerateUsageRecordsParams)) *GenerateUsageRecordsParams
+	NewListTrafficMonitorsParams(zoneid string, opts ...func(*ListTrafficMonitorsParams)) *ListTrafficMonitorsParams
+	NewListTrafficTypeImplementorsParams(opts ...func(*ListTrafficTypeImplementorsParams)) *ListTrafficTypeImplementorsParams
+	NewListTrafficTypesParams(physicalnetworkid string, opts ...func(*ListTrafficTypesParams)) *ListTrafficTypesParams
+	NewListUsageRecordsParams(enddate string, startdate string, opts ...func(*ListUsageRecordsParams)) *ListUsageRecordsParams
+	NewListUsageTypesParams(opts ...func(*ListUsageTypesParams)) *ListUsageTypesParams
+	NewRemoveRawUsageRecordsParams(interval int, opts ...func(*RemoveRawUsageRecordsParams)) *RemoveRawUsageRecordsParams
+	NewUpdateTrafficTypeParams(id string, opts ...func(*UpdateTrafficTypeParams)) *UpdateTrafficTypeParams
+	RemoveRawUsageRecords(p *RemoveRawUsageRecordsParams, opts ...OptionFunc) (*RemoveRawUsageRecordsResponse, error)
+	RemoveRawUsageRecordsRaw(v url.Values) (*RemoveRawUsageRecordsResponse, error)
+	RemoveRawUsageRecordsRawWithContext(ctx context.Context, v url.Values) (*RemoveRawUsageRecordsResponse, error)
+	RemoveRawUsageRecordsWithContext(ctx context.Context, p *RemoveRawUsageRecordsParams, opts ...OptionFunc) (*RemoveRawUsageRecordsResponse, error)
+	StreamTrafficMonitors(ctx context.Context, p *ListTrafficMonitorsParams) (<-chan *TrafficMonitor, <-chan error)
+	StreamTrafficTypeImplementors(ctx context.Context, p *ListTrafficTypeImplementorsParams) (<-chan *TrafficTypeImplementor, <-chan error)
+	StreamTrafficTypes(ctx context.Context, p *ListTrafficTypesParams) (<-chan *TrafficType, <-chan error)
+	StreamUsageRecords(ctx context.Context, p *ListUsageRecordsParams) (<-chan *UsageRecord, <-chan error)
+	UpdateTrafficType(p *UpdateTrafficTypeParams, opts ...OptionFunc) (*UpdateTrafficTypeResponse, error)
+	UpdateTrafficTypeRaw(v url.Values) (*UpdateTrafficTypeResponse, error)
+	UpdateTrafficTypeRawWithContext(ctx context.Context, v url.Values) (*UpdateTrafficTypeResponse, error)
+	UpdateTrafficTypeWithContext(ctx context.Context, p *UpdateTrafficTypeParams, opts ...OptionFunc) (*UpdateTrafficTypeResponse, error)
+}
+
+var _ UsageServiceIface = (*UsageService)(nil)
+
+// UserServiceIface contains all exported methods of UserService, so callers can depend on it to mock the service
+type UserServiceIface interface {
+	CreateUser(p *CreateUserParams, opts ...OptionFunc) (*CreateUserResponse, error)
+	CreateUserRaw(v url.Values) (*CreateUserResponse, error)
+	CreateUserRawWithContext(ctx context.Context, v url.Values) (*CreateUserResponse, error)
+	CreateUserWithContext(ctx context.Context, p *CreateUserParams, opts ...OptionFunc) (*CreateUserResponse, error)
+	DeleteUser(p *DeleteUserParams, opts ...OptionFunc) (*DeleteUserResponse, error)
+	DeleteUserRaw(v url.Values) (*DeleteUserResponse, error)
+	DeleteUserRawWithContext(ctx context.Context, v url.Values) (*DeleteUserResponse, error)
+	DeleteUserWithContext(ctx context.Context, p *DeleteUserParams, opts ...OptionFunc) (*DeleteUserResponse, error)
+	DisableUser(p *DisableUserParams, opts ...OptionFunc) (*DisableUserResponse, error)
+	DisableUserRaw(v url.Values) (*DisableUserResponse, error)
+	DisableUserRawWithContext(ctx context.Context, v url.Values) (*DisableUserResponse, error)
+	DisableUserWithContext(ctx context.Context, p *DisableUserParams, opts ...OptionFunc) (*DisableUserResponse, error)
+	EnableUser(p *EnableUserParams, opts ...OptionFunc) (*EnableUserResponse, error)
+	EnableUserRaw(v url.Values) (*EnableUserResponse, error)
+	EnableUserRawWithContext(ctx context.Context, v url.Values) (*EnableUserResponse, error)
+	EnableUserWithContext(ctx context.Context, p *EnableUserParams, opts ...OptionFunc) (*EnableUserResponse, error)
+	GetUser(p *GetUserParams, opts ...OptionFunc) (*GetUserResponse, error)
+	GetUserByID(id string, opts ...OptionFunc) (*User, int, error)
+	GetUserRaw(v url.Values) (*GetUserResponse, error)
+	GetUserRawWithContext(ctx context.Context, v url.Values) (*GetUserResponse, error)
+	GetUserWithContext(ctx context.Context, p *GetUserParams, opts ...OptionFunc) (*GetUserResponse, error)
+	GetVirtualMachineUserData(p *GetVirtualMachineUserDataParams, opts ...OptionFunc) (*GetVirtualMachineUserDataResponse, error)
+	GetVirtualMachineUserDataRaw(v url.Values) (*GetVirtualMachineUserDataResponse, error)
+	GetVirtualMachineUserDataRawWithContext(ctx context.Context, v url.Values) (*GetVirtualMachineUserDataResponse, error)
+	GetVirtualMachineUserDataWithContext(ctx context.Context, p *GetVirtualMachineUserDataParams, opts ...OptionFunc) (*GetVirtualMachineUserDataResponse, error)
+	ListAllUsers(p *ListUsersParams) ([]*User, error)
+	ListUsers(p *ListUsersParams) (*ListUsersResponse, error)
+	ListUsersRaw(v url.Values) (*ListUsersResponse, error)
+	ListUsersRawWithContext(ctx context.Context, v url.Values) (*ListUsersResponse, error)
+	ListUsersWhere(p *ListUsersParams, pred func(*User) bool) ([]*User, error)
+	ListUsersWithContext(ctx context.Context, p *ListUsersParams) (*ListUsersResponse, error)
+	LockUser(p *LockUserParams, opts ...OptionFunc) (*LockUserResponse, error)
+	LockUserRaw(v url.Values) (*LockUserResponse, error)
+	LockUserRawWithContext(ctx context.Context, v url.Values) (*LockUserResponse, error)
+	LockUserWithContext(ctx context.Context, p *LockUserParams, opts ...OptionFunc) (*LockUserResponse, error)
+	NewCreateUserParams(account string, email string, firstname string, lastname string, password string, username string, opts ...func(*CreateUserParams)) *CreateUserParams
+	NewCreateUserParamsFromOpts(o CreateUserOpts) *CreateUserParams
+	NewDeleteUserParams(id string, opts ...func(*DeleteUserParams)) *DeleteUserParams
+	NewDisableUserParams(id string, opts ...func(*DisableUserParams)) *DisableUserParams
+	NewEnableUserParams(id string, opts ...func(*EnableUserParams)) *EnableUserParams
+	NewGetUserParams(userapikey string, opts ...func(*GetUserParams)) *GetUserParams
+	NewGetVirtualMachineUserDataParams(virtualmachineid string, opts ...func(*GetVirtualMachineUserDataParams)) *GetVirtualMachineUserDataParams
+	NewListUsersParams(opts ...func(*ListUsersParams)) *ListUsersParams
+	NewLockUserParams(id string, opts ...func(*LockUserParams)) *LockUserParams
+	NewRegisterUserKeysParams(id string, opts ...func(*RegisterUserKeysParams)) *RegisterUserKeysParams
+	NewUpdateUserParams(id string, opts ...func(*UpdateUserParams)) *UpdateUserParams
+	RegisterUserKeys(p *RegisterUserKeysParams, opts ...OptionFunc) (*RegisterUserKeysResponse, error)
+	RegisterUserKeysRaw(v url.Values) (*RegisterUserKeysResponse, error)
+	RegisterUserKeysRawWithContext(ctx context.Context, v url.Values) (*RegisterUserKeysResponse, error)
+	RegisterUserKeysWithContext(ctx context.Context, p *RegisterUserKeysParams, opts ...OptionFunc) (*RegisterUserKeysResponse, error)
+	RotateKeys(userid string, updateClient bool) (string, string, error)
+	StreamUsers(ctx context.Context, p *ListUsersParams) (<-chan *User, <-chan error)
+	UpdateMany(ids []string, mutate func(*UpdateUserParams), concurrency int, opts ...OptionFunc) (BulkResult[*UpdateUserResponse], error)
+	UpdateUser(p *UpdateUserParams, opts ...OptionFunc) (*UpdateUserResponse, error)
+	UpdateUserRaw(v url.Values) (*UpdateUserResponse, error)
+	UpdateUserRawWithContext(ctx context.Context, v url.Values) (*UpdateUserResponse, error)
+	UpdateUserWithContext(ctx context.Context, p *UpdateUserParams, opts ...OptionFunc) (*UpdateUserResponse, error)
+	UserExists(id string, opts ...OptionFunc) (bool, error)
+	WaitForUserDeleted(ctx context.Context, id string, timeout time.Duration, opts ...OptionFunc) error
+}
+
+var _ UserServiceIface = (*UserService)(nil)
+
+// VLANServiceIface contains all exported methods of VLANService, so callers can depend on it to mock the service
+type VLANServiceIface interface {
+	CreateVlanIpRange(p *CreateVlanIpRangeParams, opts ...OptionFunc) (*CreateVlanIpRangeResponse, error)
+	CreateVlanIpRangeRaw(v url.Values) (*CreateVlanIpRangeResponse, error)
+	CreateVlanIpRangeRawWithContext(ctx context.Context, v url.Values) (*CreateVlanIpRangeResponse, error)
+	CreateVlanIpRangeWithContext(ctx context.Context, p *CreateVlanIpRangeParams, opts ...OptionFunc) (*CreateVlanIpRangeResponse, error)
+	DedicateGuestVlanRange(p *DedicateGuestVlanRangeParams, opts ...OptionFunc) (*DedicateGuestVlanRangeResponse, error)
+	DedicateGuestVlanRangeRaw(v url.Values) (*DedicateGuestVlanRangeResponse, error)
+	DedicateGuestVlanRangeRawWithContext(ctx context.Context, v url.Values) (*DedicateGuestVlanRangeResponse, error)
+	DedicateGuestVlanRangeWithContext(ctx context.Context, p *DedicateGuestVlanRangeParams, opts ...OptionFunc) (*DedicateGuestVlanRangeResponse, error)
+	DedicatedGuestVlanRangeExists(id string, opts ...OptionFunc) (bool, error)
+	DeleteVlanIpRange(p *DeleteVlanIpRangeParams, opts ...OptionFunc) (*DeleteVlanIpRangeResponse, error)
+	DeleteVlanIpRangeRaw(v url.Values) (*DeleteVlanIpRangeResponse, error)
+	DeleteVlanIpRangeRawWithContext(ctx context.Context, v url.Values) (*DeleteVlanIpRangeResponse, error)
+	DeleteVlanIpRangeWithContext(ctx context.Context, p *DeleteVlanIpRangeParams, opts ...OptionFunc) (*DeleteVlanIpRangeResponse, error)
+	FindFreeVlanRange(zoneid string, desiredSize int, opts ...OptionFunc) (string, string, error)
+	GetDedicatedGuestVlanRangeByID(id string, opts ...OptionFunc) (*DedicatedGuestVlanRange, int, error)
+	GetVlanIpRangeByID(id string, opts ...OptionFunc) (*VlanIpRange, int, error)
+	ListAllDedicatedGuestVlanRanges(p *ListDedicatedGuestVlanRangesParams) ([]*DedicatedGuestVlanRange, error)
+	ListAllVlanIpRanges(p *ListVlanIpRangesParams) ([]*VlanIpRange, error)
+	ListDedicatedGuestVlanRanges(p *ListDedicatedGuestVlanRangesParams) (*ListDedicatedGuestVlanRangesResponse, error)
+	ListDedicatedGuestVlanRangesRaw(v url.Values) (*ListDedicatedGuestVlanRangesResponse, error)
+	ListDedicatedGuestVlanRangesRawWithContext(ctx context.Context, v url.Values) (*ListDedicatedGuestVlanRangesResponse, error)
+	ListDedicatedGuestVlanRangesWhere(p *ListDedicatedGuestVlanRangesParams, pred func(*DedicatedGuestVlanRange) bool) ([]*DedicatedGuestVlanRange, error)
+	ListDedicatedGuestVlanRangesWithContext(ctx context.Context, p *ListDedicatedGuestVlanRangesParams) (*ListDedicatedGuestVlanRangesResponse, error)
+	ListVlanIpRanges(p *ListVlanIpRangesParams) (*ListVlanIpRangesResponse, error)
+	ListVlanIpRangesRaw(v url.Values) (*ListVlanIpRangesResponse, error)
+	ListVlanIpRangesRawWithContext(ctx context.Context, v url.Values) (*ListVlanIpRangesResponse, error)
+	ListVlanIpRangesWhere(p *ListVlanIpRangesParams, pred func(*VlanIpRange) bool) ([]*VlanIpRange, error)
+	ListVlanIpRangesWithContext(ctx context.Context, p *ListVlanIpRangesParams) (*ListVlanIpRangesResponse, error)
+	NewCreateVlanIpRangeParams(opts ...func(*CreateVlanIpRangeParams)) *CreateVlanIpRangeParams
+	NewDedicateGuestVlanRangeParams(physicalnetworkid string, vlanrange string, opts ...func(*DedicateGuestVlanRangeParams)) *DedicateGuestVlanRangeParams
+	NewDeleteVlanIpRangeParams(id string, opts ...func(*DeleteVlanIpRangeParams)) *DeleteVlanIpRangeParams
+	NewListDedicatedGuestVlanRangesParams(opts ...func(*ListDedicatedGuestVlanRangesParams)) *ListDedicatedGuestVlanRangesParams
+	NewListVlanIpRangesParams(opts ...func(*ListVlanIpRangesParams)) *ListVlanIpRangesParams
+	NewReleaseDedicatedGuestVlanRangeParams(id string, opts ...func(*ReleaseDedicatedGuestVlanRangeParams)) *ReleaseDedicatedGuestVlanRangeParams
+	ReleaseDedicatedGuestVlanRange(p *ReleaseDedicatedGuestVlanRangeParams, opts ...OptionFunc) (*ReleaseDedicatedGuestVlanRangeResponse, error)
+	ReleaseDedicatedGuestVlanRangeRaw(v url.Values) (*ReleaseDedicatedGuestVlanRangeResponse, error)
+	ReleaseDedicatedGuestVlanRangeRawWithContext(ctx context.Context, v url.Values) (*ReleaseDedicatedGuestVlanRangeResponse, error)
+	ReleaseDedicatedGuestVlanRangeWithContext(ctx context.Context, p *ReleaseDedicatedGuestVlanRangeParams, opts ...OptionFunc) (*ReleaseDedicatedGuestVlanRangeResponse, error)
+	StreamDedicatedGuestVlanRanges(ctx context.Context, p *ListDedicatedGuestVlanRangesParams) (<-chan *DedicatedGuestVlanRange, <-chan error)
+	StreamVlanIpRanges(ctx context.Context, p *ListVlanIpRangesParams) (<-chan *VlanIpRange, <-chan error)
+	VlanIpRangeExists(id string, opts ...OptionFunc) (bool, error)
+	WaitForVlanIpRangeDeleted(ctx context.Context, id string, timeout time.Duration, opts ...OptionFunc) error
+}
+
+var _ VLANServiceIface = (*VLANService)(nil)
+
+// VMGroupServiceIface contains all exported methods of VMGroupService, so callers can depend on it to mock the service
+type VMGroupServiceIface interface {
+	CreateInstanceGroup(p *CreateInstanceGroupParams, opts ...OptionFunc) (*CreateInstanceGroupResponse, error)
+	CreateInstanceGroupRaw(v url.Values) (*CreateInstanceGroupResponse, error)
+	CreateInstanceGroupRawWithContext(ctx context.Context, v url.Values) (*CreateInstanceGroupResponse, error)
+	CreateInstanceGroupWithContext(ctx context.Context, p *CreateInstanceGroupParams, opts ...OptionFunc) (*CreateInstanceGroupResponse, error)
+	DeleteInstanceGroup(p *DeleteInstanceGroupParams, opts ...OptionFunc) (*DeleteInstanceGroupResponse, error)
+	DeleteInstanceGroupRaw(v url.Values) (*DeleteInstanceGroupResponse, error)
+	DeleteInstanceGroupRawWithContext(ctx context.Context, v url.Values) (*DeleteInstanceGroupResponse, error)
+	DeleteInstanceGroupWithContext(ctx context.Context, p *DeleteInstanceGroupParams, opts ...OptionFunc) (*DeleteInstanceGroupResponse, error)
+	GetInstanceGroupByID(id string, opts ...OptionFunc) (*InstanceGroup, int, error)
+	GetInstanceGroupByName(name string, opts ...OptionFunc) (*InstanceGroup, int, error)
+	GetInstanceGroupID(name string, opts ...OptionFunc) (string, int, error)
+	InstanceGroupExists(id string, opts ...OptionFunc) (bool, error)
+	ListAllInstanceGroups(p *ListInstanceGroupsParams) ([]*InstanceGroup, error)
+	ListInstanceGroups(p *ListInstanceGroupsParams) (*ListInstanceGroupsResponse, error)
+	ListInstanceGroupsRaw(v url.Values) (*ListInstanceGroupsResponse, error)
+	ListInstanceGroupsRawWithContext(ctx context.Context, v url.Values) (*ListInstanceGroupsResponse, error)
+	ListInstanceGroupsWhere(p *ListInstanceGroupsParams, pred func(*InstanceGroup) bool) ([]*InstanceGroup, error)
+	ListInstanceGroupsWithContext(ctx context.Context, p *ListInstanceGroupsParams) (*ListInstanceGroupsResponse, error)
+	NewCreateInstanceGroupParams(name string, opts ...func(*CreateInstanceGroupParams)) *CreateInstanceGroupParams
+	NewDeleteInstanceGroupParams(id string, opts ...func(*DeleteInstanceGroupParams)) *DeleteInstanceGroupParams
+	NewListInstanceGroupsParams(opts ...func(*ListInstanceGroupsParams)) *ListInstanceGroupsParams
+	NewUpdateInstanceGroupParams(id string, opts ...func(*UpdateInstanceGroupParams)) *UpdateInstanceGroupParams
+	StreamInstanceGroups(ctx context.Context, p *ListInstanceGroupsParams) (<-chan *InstanceGroup, <-chan error)
+	UpdateInstanceGroup(p *UpdateInstanceGroupParams, opts ...OptionFunc) (*UpdateInstanceGroupResponse, error)
+	UpdateInstanceGroupRaw(v url.Values) (*UpdateInstanceGroupResponse, error)
+	UpdateInstanceGroupRawWithContext(ctx context.Context, v url.Values) (*UpdateInstanceGroupResponse, error)
+	UpdateInstanceGroupWithContext(ctx context.Context, p *UpdateInstanceGroupParams, opts ...OptionFunc) (*UpdateInstanceGroupResponse, error)
+	WaitForInstanceGroupDeleted(ctx context.Context, id string, timeout time.Duration, opts ...OptionFunc) error
+}
+
+var _ VMGroupServiceIface = (*VMGroupService)(nil)
+
+// VPCServiceIface contains all exported methods of VPCService, so callers can depend on it to mock the service
+type VPCServiceIface interface {
+	CreatePrivateGateway(p *CreatePrivateGatewayParams, opts ...OptionFunc) (*CreatePrivateGatewayResponse, error)
+	CreatePrivateGatewayRaw(v url.Values) (*CreatePrivateGatewayResponse, error)
+	CreatePrivateGatewayRawWithContext(ctx context.Context, v url.Values) (*CreatePrivateGatewayResponse, error)
+	CreatePrivateGatewayWithContext(ctx context.Context, p *CreatePrivateGatewayParams, opts ...OptionFunc) (*CreatePrivateGatewayResponse, error)
+	CreateStaticRoute(p *CreateStaticRouteParams, opts ...OptionFunc) (*CreateStaticRouteResponse, error)
+	CreateStaticRouteRaw(v url.Values) (*CreateStaticRouteResponse, error)
+	CreateStaticRouteRawWithContext(ctx context.Context, v url.Values) (*CreateStaticRouteResponse, error)
+	CreateStaticRouteWithContext(ctx context.Context, p *CreateStaticRouteParams, opts ...OptionFunc) (*CreateStaticRouteResponse, error)
+	CreateVPC(p *CreateVPCParams, opts ...OptionFunc) (*CreateVPCResponse, error)
+	CreateVPCOffering(p *CreateVPCOfferingParams, opts ...OptionFunc) (*CreateVPCOfferingResponse, error)
+	CreateVPCOfferingRaw(v url.Values) (*CreateVPCOfferingResponse, error)
+	CreateVPCOfferingRawWithContext(ctx context.Context, v url.Values) (*CreateVPCOfferingResponse, error)
+	CreateVPCOfferingWithContext(ctx context.Context, p *CreateVPCOfferingParams, opts ...OptionFunc) (*CreateVPCOfferingResponse, error)
+	CreateVPCRaw(v url.Values) (*CreateVPCResponse, error)
+	CreateVPCRawWithContext(ctx context.Context, v url.Values) (*CreateVPCResponse, error)
+	CreateVPCWithContext(ctx context.Context, p *CreateVPCParams, opts ...OptionFunc) (*CreateVPCResponse, error)
+	CreateVPCWithTier(spec VPCTierSpec, opts ...OptionFunc) (*VPC, *Network, error)
+	DeletePrivateGateway(p *DeletePrivateGatewayParams, opts ...OptionFunc) (*DeletePrivateGatewayResponse, error)
+	DeletePrivateGatewayRaw(v url.Values) (*DeletePrivateGatewayResponse, error)
+	DeletePrivateGatewayRawWithContext(ctx context.Context, v url.Values) (*DeletePrivateGatewayResponse, error)
+	DeletePrivateGatewayWithContext(ctx context.Context, p *DeletePrivateGatewayParams, opts ...OptionFunc) (*DeletePrivateGatewayResponse, error)
+	DeleteStaticRoute(p *DeleteStaticRouteParams, opts ...OptionFunc) (*DeleteStaticRouteResponse, error)
+	DeleteStaticRouteRaw(v url.Values) (*DeleteStaticRouteResponse, error)
+	DeleteStaticRouteRawWithContext(ctx context.Context, v url.Values) (*DeleteStaticRouteResponse, error)
+	DeleteStaticRouteWithContext(ctx context.Context, p *DeleteStaticRouteParams, opts ...OptionFunc) (*DeleteStaticRouteResponse, error)
+	DeleteVPC(p *DeleteVPCParams, opts ...OptionFunc) (*DeleteVPCResponse, error)
+	DeleteVPCOffering(p *DeleteVPCOfferingParams, opts ...OptionFunc) (*DeleteVPCOfferingResponse, error)
+	DeleteVPCOfferingRaw(v url.Values) (*DeleteVPCOfferingResponse, error)
+	DeleteVPCOfferingRawWithContext(ctx context.Context, v url.Values) (*DeleteVPCOfferingResponse, error)
+	DeleteVPCOfferingWithContext(ctx context.Context, p *DeleteVPCOfferingParams, opts ...OptionFunc) (*DeleteVPCOfferingResponse, error)
+	DeleteVPCRaw(v url.Values) (*DeleteVPCResponse, error)
+	DeleteVPCRawWithContext(ctx context.Context, v url.Values) (*DeleteVPCResponse, error)
+	DeleteVPCWithContext(ctx context.Context, p *DeleteVPCParams, opts ...OptionFunc) (*DeleteVPCResponse, error)
+	GetPrivateGatewayByID(id string, opts ...OptionFunc) (*PrivateGateway, int, error)
+	GetStaticRouteByID(id string, opts ...OptionFunc) (*StaticRoute, int, error)
+	GetVPCByID(id string, opts ...OptionFunc) (*VPC, int, error)
+	GetVPCByName(name string, opts ...OptionFunc) (*VPC, int, error)
+	GetVPCID(name string, opts ...OptionFunc) (string, int, error)
+	GetVPCOfferingByID(id string, opts ...OptionFunc) (*VPCOffering, int, error)
+	GetVPCOfferingByName(name string, opts ...OptionFunc) (*VPCOffering, int, error)
+	GetVPCOfferingID(name string, opts ...OptionFunc) (string, int, error)
+	ListAllPrivateGateways(p *ListPrivateGatewaysParams) ([]*PrivateGateway, error)
+	ListAllStaticRoutes(p *ListStaticRoutesParams) ([]*StaticRoute, error)
+	ListAllVPCOfferings(p *ListVPCOfferingsParams) ([]*VPCOffering, error)
+	ListAllVPCs(p *ListVPCsParams) ([]*VPC, error)
+	ListPrivateGateways(p *ListPrivateGatewaysParams) (*ListPrivateGatewaysResponse, error)
+	ListPrivateGatewaysRaw(v url.Values) (*ListPrivateGatewaysResponse, error)
+	ListPrivateGatewaysRawWithContext(ctx context.Context, v url.Values) (*ListPrivateGatewaysResponse, error)
+	ListPrivateGatewaysWhere(p *ListPrivateGatewaysParams, pred func(*PrivateGateway) bool) ([]*PrivateGateway, error)
+	ListPrivateGatewaysWithContext(ctx context.Context, p *ListPrivateGatewaysParams) (*ListPrivateGatewaysResponse, error)
+	ListStaticRoutes(p *ListStaticRoutesParams) (*ListStaticRoutesResponse, error)
+	ListStaticRoutesRaw(v url.Values) (*ListStaticRoutesResponse, error)
+	ListStaticRoutesRawWithContext(ctx context.Context, v url.Values) (*ListStaticRoutesResponse, error)
+	ListStaticRoutesWhere(p *ListStaticRoutesParams, pred func(*StaticRoute) bool) ([]*StaticRoute, error)
+	ListStaticRoutesWithContext(ctx context.Context, p *ListStaticRoutesParams) (*ListStaticRoutesResponse, error)
+	ListVPCOfferings(p *ListVPCOfferingsParams) (*ListVPCOfferingsResponse, error)
+	ListVPCOfferingsRaw(v url.Values) (*ListVPCOfferingsResponse, error)
+	ListVPCOfferingsRawWithContext(ctx context.Context, v url.Values) (*ListVPCOfferingsResponse, error)
+	ListVPCOfferingsWhere(p *ListVPCOfferingsParams, pred func(*VPCOffering) bool) ([]*VPCOffering, error)
+	ListVPCOfferingsWithContext(ctx context.Context, p *ListVPCOfferingsParams) (*ListVPCOfferingsResponse, error)
+	ListVPCs(p *ListVPCsParams) (*ListVPCsResponse, error)
+	ListVPCsRaw(v url.Values) (*ListVPCsResponse, error)
+	ListVPCsRawWithContext(ctx context.Context, v url.Values) (*ListVPCsResponse, error)
+	ListVPCsWhere(p *ListVPCsParams, pred func(*VPC) bool) ([]*VPC, error)
+	ListVPCsWithContext(ctx context.Context, p *ListVPCsParams) (*ListVPCsResponse, error)
+	NewCreatePrivateGatewayParams(gateway string, ipaddress string, netmask string, vlan string, vpcid string, opts ...func(*CreatePrivateGatewayParams)) *CreatePrivateGatewayParams
+	NewCreatePrivateGatewayParamsFromOpts(o CreatePrivateGatewayOpts) *CreatePrivateGatewayParams
+	NewCreateStaticRouteParams(cidr string, gatewayid string, opts ...func(*CreateStaticRouteParams)) *CreateStaticRouteParams
+	NewCreateVPCOfferingParams(displaytext string, name string, supportedservices []string, opts ...func(*CreateVPCOfferingParams)) *CreateVPCOfferingParams
+	NewCreateVPCOfferingParamsFromOpts(o CreateVPCOfferingOpts) *CreateVPCOfferingParams
+	NewCreateVPCParams(cidr string, displaytext string, name string, vpcofferingid string, zoneid string, opts ...func(*CreateVPCParams)) *CreateVPCParams
+	NewCreateVPCParamsFromOpts(o CreateVPCOpts) *CreateVPCParams
+	NewDeletePrivateGatewayParams(id string, opts ...func(*DeletePrivateGatewayParams)) *DeletePrivateGatewayParams
+	NewDeleteStaticRouteParams(id string, opts ...func(*DeleteStaticRouteParams)) *DeleteStaticRouteParams
+	NewDeleteVPCOfferingParams(id string, opts ...func(*DeleteVPCOfferingParams)) *DeleteVPCOfferingParams
+	NewDeleteVPCParams(id string, opts ...func(*DeleteVPCParams)) *DeleteVPCParams
+	NewListPrivateGatewaysParams(opts ...func(*ListPrivateGatewaysParams)) *ListPrivateGatewaysParams
+	NewListStaticRoutesParams(opts ...func(*ListStaticRoutesParams)) *ListStaticRoutesParams
+	NewListVPCOfferingsParams(opts ...func(*ListVPCOfferingsParams)) *ListVPCOfferingsParams
+	NewListVPCsParams(opts ...func(*ListVPCsParams)) *ListVPCsParams
+	NewRestartVPCParams(id string, opts ...func(*RestartVPCParams)) *RestartVPCParams
+	NewUpdateVPCOfferingParams(id string, opts ...func(*UpdateVPCOfferingParams)) *UpdateVPCOfferingParams
+	NewUpdateVPCParams(id string, opts ...func(*UpdateVPCParams)) *UpdateVPCParams
+	PrivateGatewayExists(id string, opts ...OptionFunc) (bool, error)
+	RestartVPC(p *RestartVPCParams, opts ...OptionFunc) (*RestartVPCResponse, error)
+	RestartVPCRaw(v url.Values) (*RestartVPCResponse, error)
+	RestartVPCRawWithContext(ctx context.Context, v url.Values) (*RestartVPCResponse, error)
+	RestartVPCWithContext(ctx context.Context, p *RestartVPCParams, opts ...OptionFunc) (*RestartVPCResponse, error)
+	StaticRouteExists(id string, opts ...OptionFunc) (bool, error)
+	StreamPrivateGateways(ctx context.Context, p *ListPrivateGatewaysParams) (<-chan *PrivateGateway, <-chan error)
+	StreamStaticRoutes(ctx context.Context, p *ListStaticRoutesParams) (<-chan *StaticRoute, <-chan error)
+	StreamVPCOfferings(ctx context.Context, p *ListVPCOfferingsParams) (<-chan *VPCOffering, <-chan error)
+	StreamVPCs(ctx context.Context, p *ListVPCsParams) (<-chan *VPC, <-chan error)
+	UpdateMany(ids []string, mutate func(*UpdateVPCParams), concurrency int, opts ...OptionFunc) (BulkResult[*UpdateVPCResponse], error)
+	UpdateVPC(p *UpdateVPCParams, opts ...OptionFunc) (*UpdateVPCResponse, error)
+	UpdateVPCOffering(p *UpdateVPCOfferingParams, opts ...OptionFunc) (*UpdateVPCOfferingResponse, error)
+	UpdateVPCOfferingRaw(v url.Values) (*UpdateVPCOfferingResponse, error)
+	UpdateVPCOfferingRawWithContext(ctx context.Context, v url.Values) (*UpdateVPCOfferingResponse, error)
+	UpdateVPCOfferingWithContext(ctx context.Context, p *UpdateVPCOfferingParams, opts ...OptionFunc) (*UpdateVPCOfferingResponse, error)
+	UpdateVPCRaw(v url.Values) (*UpdateVPCResponse, error)
+	UpdateVPCRawWithContext(ctx context.Context, v url.Values) (*UpdateVPCResponse, error)
+	UpdateVPCWithContext(ctx context.Context, p *UpdateVPCParams, opts ...OptionFunc) (*UpdateVPCResponse, error)
+	VPCExists(id string, opts ...OptionFunc) (bool, error)
+	VPCOfferingExists(id string, opts ...OptionFunc) (bool, error)
+	WaitForPrivateGatewayDeleted(ctx context.Context, id string, timeout time.Duration, opts ...OptionFunc) error
+	WaitForStaticRouteDeleted(ctx context.Context, id string, timeout time.Duration, opts ...OptionFunc) error
+	WaitForVPCDeleted(ctx context.Context, id string, timeout time.Duration, opts ...OptionFunc) error
+	WaitForVPCOfferingDeleted(ctx context.Context, id string, timeout time.Duration, opts ...OptionFunc) error
+}
+
+var _ VPCServiceIface = (*VPCService)(nil)
+
+// VPNServiceIface contains all exported methods of VPNService, so callers can depend on it to mock the service
+type VPNServiceIface interface {
+	AddVpnUser(p *AddVpnUserParams, opts ...OptionFunc) (*AddVpnUserResponse, error)
+	AddVpnUserRaw(v url.Values) (*AddVpnUserResponse, error)
+	AddVpnUserRawWithContext(ctx context.Context, v url.Values) (*AddVpnUserResponse, error)
+	AddVpnUserWithContext(ctx context.Context, p *AddVpnUserParams, opts ...OptionFunc) (*AddVpnUserResponse, error)
+	CreateRemoteAccessVpn(p *CreateRemoteAccessVpnParams, opts ...OptionFunc) (*CreateRemoteAccessVpnResponse, error)
+	CreateRemoteAccessVpnRaw(v url.Values) (*CreateRemoteAccessVpnResponse, error)
+	CreateRemoteAccessVpnRawWithContext(ctx context.Context, v url.Values) (*CreateRemoteAccessVpnResponse, error)
+	CreateRemoteAccessVpnWithContext(ctx context.Context, p *CreateRemoteAccessVpnParams, opts ...OptionFunc) (*CreateRemoteAccessVpnResponse, error)
+	CreateVpnConnection(p *CreateVpnConnectionParams, opts ...OptionFunc) (*CreateVpnConnectionResponse, error)
+	CreateVpnConnectionRaw(v url.Values) (*CreateVpnConnectionResponse, error)
+	CreateVpnConnectionRawWithContext(ctx context.Context, v url.Values) (*CreateVpnConnectionResponse, error)
+	CreateVpnConnectionWithContext(ctx context.Context, p *CreateVpnConnectionParams, opts ...OptionFunc) (*CreateVpnConnectionResponse, error)
+	CreateVpnCustomerGateway(p *CreateVpnCustomerGatewayParams, opts ...OptionFunc) (*CreateVpnCustomerGatewayResponse, error)
+	CreateVpnCustomerGatewayRaw(v url.Values) (*CreateVpnCustomerGatewayResponse, error)
+	CreateVpnCustomerGatewayRawWithContext(ctx context.Context, v url.Values) (*CreateVpnCustomerGatewayResponse, error)
+	CreateVpnCustomerGatewayWithContext(ctx context.Context, p *CreateVpnCustomerGatewayParams, opts ...OptionFunc) (*CreateVpnCustomerGatewayResponse, error)
+	CreateVpnGateway(p *CreateVpnGatewayParams, opts ...OptionFunc) (*CreateVpnGatewayResponse, error)
+	CreateVpnGatewayRaw(v url.Values) (*CreateVpnGatewayResponse, error)
+	CreateVpnGatewayRawWithContext(ctx context.Context, v url.Values) (*CreateVpnGatewayResponse, error)
+	CreateVpnGatewayWithContext(ctx context.Context, p *CreateVpnGatewayParams, opts ...OptionFunc) (*CreateVpnGatewayResponse, error)
+	DeleteRemoteAccessVpn(p *DeleteRemoteAccessVpnParams, opts ...OptionFunc) (*DeleteRemoteAccessVpnResponse, error)
+	DeleteRemoteAccessVpnRaw(v url.Values) (*DeleteRemoteAccessVpnResponse, error)
+	DeleteRemoteAccessVpnRawWithContext(ctx context.Context, v url.Values) (*DeleteRemoteAccessVpnResponse, error)
+	DeleteRemoteAccessVpnWithContext(ctx context.Context, p *DeleteRemoteAccessVpnParams, opts ...OptionFunc) (*DeleteRemoteAccessVpnResponse, error)
+	DeleteVpnConnection(p *DeleteVpnConnectionParams, opts ...OptionFunc) (*DeleteVpnConnectionResponse, error)
+	DeleteVpnConnectionRaw(v url.Values) (*DeleteVpnConnectionResponse, error)
+	DeleteVpnConnectionRawWithContext(ctx context.Context, v url.Values) (*DeleteVpnConnectionResponse, error)
+	DeleteVpnConnectionWithContext(ctx context.Context, p *DeleteVpnConnectionParams, opts ...OptionFunc) (*DeleteVpnConnectionResponse, error)
+	DeleteVpnCustomerGateway(p *DeleteVpnCustomerGatewayParams, opts ...OptionFunc) (*DeleteVpnCustomerGatewayResponse, error)
+	DeleteVpnCustomerGatewayRaw(v url.Values) (*DeleteVpnCustomerGatewayResponse, error)
+	DeleteVpnCustomerGatewayRawWithContext(ctx context.Context, v url.Values) (*DeleteVpnCustomerGatewayResponse, error)
+	DeleteVpnCustomerGatewayWithContext(ctx context.Context, p *DeleteVpnCustomerGatewayParams, opts ...OptionFunc) (*DeleteVpnCustomerGatewayResponse, error)
+	DeleteVpnGateway(p *DeleteVpnGatewayParams, opts ...OptionFunc) (*DeleteVpnGatewayResponse, error)
+	DeleteVpnGatewayRaw(v url.Values) (*DeleteVpnGatewayResponse, error)
+	DeleteVpnGatewayRawWithContext(ctx context.Context, v url.Values) (*DeleteVpnGatewayResponse, error)
+	DeleteVpnGatewayWithContext(ctx context.Context, p *DeleteVpnGatewayParams, opts ...OptionFunc) (*DeleteVpnGatewayResponse, error)
+	GetRemoteAccessVpnByID(id string, opts ...OptionFunc) (*RemoteAccessVpn, int, error)
+	GetVpnConnectionByID(id string, opts ...OptionFunc) (*VpnConnection, int, error)
+	GetVpnCustomerGatewayByID(id string, opts ...OptionFunc) (*VpnCustomerGateway, int, error)
+	GetVpnCustomerGatewayByName(name string, opts ...OptionFunc) (*VpnCustomerGateway, int, error)
+	GetVpnCustomerGatewayID(keyword string, opts ...OptionFunc) (string, int, error)
+	GetVpnGatewayByID(id string, opts ...OptionFunc) (*VpnGateway, int, error)
+	GetVpnUserByID(id string, opts ...OptionFunc) (*VpnUser, int, error)
+	ListAllRemoteAccessVpns(p *ListRemoteAccessVpnsParams) ([]*RemoteAccessVpn, error)
+	ListAllVpnConnections(p *ListVpnConnectionsParams) ([]*VpnConnection, error)
+	ListAllVpnCustomerGateways(p *ListVpnCustomerGatewaysParams) ([]*VpnCustomerGateway, error)
+	ListAllVpnGateways(p *ListVpnGatewaysParams) ([]*VpnGateway, error)
+	ListAllVpnUsers(p *ListVpnUsersParams) ([]*VpnUser, error)
+	ListRemoteAccessVpns(p *ListRemoteAccessVpnsParams) (*ListRemoteAccessVpnsResponse, error)
+	ListRemoteAccessVpnsRaw(v url.Values) (*ListRemoteAccessVpnsResponse, error)
+	ListRemoteAccessVpnsRawWithContext(ctx context.Context, v url.Values) (*ListRemoteAccessVpnsResponse, error)
+	ListRemoteAccessVpnsWhere(p *ListRemoteAccessVpnsParams, pred func(*RemoteAccessVpn) bool) ([]*RemoteAccessVpn, error)
+	ListRemoteAccessVpnsWithContext(ctx context.Context, p *ListRemoteAccessVpnsParams) (*ListRemoteAccessVpnsResponse, error)
+	ListVpnConnections(p *ListVpnConnectionsParams) (*ListVpnConnectionsResponse, error)
+	ListVpnConnectionsRaw(v url.Values) (*ListVpnConnectionsResponse, error)
+	ListVpnConnectionsRawWithContext(ctx context.Context, v url.Values) (*ListVpnConnectionsResponse, error)
+	ListVpnConnectionsWhere(p *ListVpnConnectionsParams, pred func(*VpnConnection) bool) ([]*VpnConnection, error)
+	ListVpnConnectionsWithContext(ctx context.Context, p *ListVpnConnectionsParams) (*ListVpnConnectionsResponse, error)
+	ListVpnCustomerGateways(p *ListVpnCustomerGatewaysParams) (*ListVpnCustomerGatewaysResponse, error)
+	ListVpnCustomerGatewaysRaw(v url.Values) (*ListVpnCustomerGatewaysResponse, error)
+	ListVpnCustomerGatewaysRawWithContext(ctx context.Context, v url.Values) (*ListVpnCustomerGatewaysResponse, error)
+	ListVpnCustomerGatewaysWhere(p *ListVpnCustomerGatewaysParams, pred func(*VpnCustomerGateway) bool) ([]*VpnCustomerGateway, error)
+	ListVpnCustomerGatewaysWithContext(ctx context.Context, p *ListVpnCustomerGatewaysParams) (*ListVpnCustomerGatewaysResponse, error)
+	ListVpnGateways(p *ListVpnGatewaysParams) (*ListVpnGatewaysResponse, error)
+	ListVpnGatewaysRaw(v url.Values) (*ListVpnGatewaysResponse, error)
+	ListVpnGatewaysRawWithContext(ctx context.Context, v url.Values) (*ListVpnGatewaysResponse, error)
+	ListVpnGatewaysWhere(p *ListVpnGatewaysParams, pred func(*VpnGateway) bool) ([]*VpnGateway, error)
+	ListVpnGatewaysWithContext(ctx context.Context, p *ListVpnGatewaysParams) (*ListVpnGatewaysResponse, error)
+	ListVpnUsers(p *ListVpnUsersParams) (*ListVpnUsersResponse, error)
+	ListVpnUsersRaw(v url.Values) (*ListVpnUsersResponse, error)
+	ListVpnUsersRawWithContext(ctx context.Context, v url.Values) (*ListVpnUsersResponse, error)
+	ListVpnUsersWhere(p *ListVpnUsersParams, pred func(*VpnUser) bool) ([]*VpnUser, error)
+	ListVpnUsersWithContext(ctx context.Context, p *ListVpnUsersParams) (*ListVpnUsersResponse, error)
+	NewAddVpnUserParams(password string, username string, opts ...func(*AddVpnUserParams)) *AddVpnUserParams
+	NewCreateRemoteAccessVpnParams(publicipid string, opts ...func(*CreateRemoteAccessVpnParams)) *CreateRemoteAccessVpnParams
+	NewCreateVpnConnectionParams(s2scustomergatewayid string, s2svpngatewayid string, opts ...func(*CreateVpnConnectionParams)) *CreateVpnConnectionParams
+	NewCreateVpnCustomerGatewayParams(cidrlist string, esppolicy string, gateway string, ikepolicy string, ipsecpsk string, opts ...func(*CreateVpnCustomerGatewayParams)) *CreateVpnCustomerGatewayParams
+	NewCreateVpnCustomerGatewayParamsFromOpts(o CreateVpnCustomerGatewayOpts) *CreateVpnCustomerGatewayParams
+	NewCreateVpnGatewayParams(vpcid string, opts ...func(*CreateVpnGatewayParams)) *CreateVpnGatewayParams
+	NewDeleteRemoteAccessVpnParams(publicipid string, opts ...func(*DeleteRemoteAccessVpnParams)) *DeleteRemoteAccessVpnParams
+	NewDeleteVpnConnectionParams(id string, opts ...func(*DeleteVpnConnectionParams)) *DeleteVpnConnectionParams
+	NewDeleteVpnCustomerGatewayParams(id string, opts ...func(*DeleteVpnCustomerGatewayParams)) *DeleteVpnCustomerGatewayParams
+	NewDeleteVpnGatewayParams(id string, opts ...func(*DeleteVpnGatewayParams)) *DeleteVpnGatewayParams
+	NewListRemoteAccessVpnsParams(opts ...func(*ListRemoteAccessVpnsParams)) *ListRemoteAccessVpnsParams
+	NewListVpnConnectionsParams(opts ...func(*ListVpnConnectionsParams)) *ListVpnConnectionsParams
+	NewListVpnCustomerGatewaysParams(opts ...func(*ListVpnCustomerGatewaysParams)) *ListVpnCustomerGatewaysParams
+	NewListVpnGatewaysParams(opts ...func(*ListVpnGatewaysParams)) *ListVpnGatewaysParams
+	NewListVpnUsersParams(opts ...func(*ListVpnUsersParams)) *ListVpnUsersParams
+	NewRemoveVpnUserParams(username string, opts ...func(*RemoveVpnUserParams)) *RemoveVpnUserParams
+	NewResetVpnConnectionParams(id string, opts ...func(*ResetVpnConnectionParams)) *ResetVpnConnectionParams
+	NewUpdateRemoteAccessVpnParams(id string, opts ...func(*UpdateRemoteAccessVpnParams)) *UpdateRemoteAccessVpnParams
+	NewUpdateVpnConnectionParams(id string, opts ...func(*UpdateVpnConnectionParams)) *UpdateVpnConnectionParams
+	NewUpdateVpnCustomerGatewayParams(cidrlist string, esppolicy string, gateway string, id string, ikepolicy string, ipsecpsk string, opts ...func(*UpdateVpnCustomerGatewayParams)) *UpdateVpnCustomerGatewayParams
+	NewUpdateVpnCustomerGatewayParamsFromOpts(o UpdateVpnCustomerGatewayOpts) *UpdateVpnCustomerGatewayParams
+	NewUpdateVpnGatewayParams(id string, opts ...func(*UpdateVpnGatewayParams)) *UpdateVpnGatewayParams
+	RemoteAccessVpnExists(id string, opts ...OptionFunc) (bool, error)
+	RemoveVpnUser(p *RemoveVpnUserParams, opts ...OptionFunc) (*RemoveVpnUserResponse, error)
+	RemoveVpnUserRaw(v url.Values) (*RemoveVpnUserResponse, error)
+	RemoveVpnUserRawWithContext(ctx context.Context, v url.Values) (*RemoveVpnUserResponse, error)
+	RemoveVpnUserWithContext(ctx context.Context, p *RemoveVpnUserParams, opts ...OptionFunc) (*RemoveVpnUserResponse, error)
+	ResetVpnConnection(p *ResetVpnConnectionParams, opts ...OptionFunc) (*ResetVpnConnectionResponse, error)
+	ResetVpnConnectionRaw(v url.Values) (*ResetVpnConnectionResponse, error)
+	ResetVpnConnectionRawWithContext(ctx context.Context, v url.Values) (*ResetVpnConnectionResponse, error)
+	ResetVpnConnectionWithContext(ctx context.Context, p *ResetVpnConnectionParams, opts ...OptionFunc) (*ResetVpnConnectionResponse, error)
+	StreamRemoteAccessVpns(ctx context.Context, p *ListRemoteAccessVpnsParams) (<-chan *RemoteAccessVpn, <-chan error)
+	StreamVpnConnections(ctx context.Context, p *ListVpnConnectionsParams) (<-chan *VpnConnection, <-chan error)
+	StreamVpnCustomerGateways(ctx context.Context, p *ListVpnCustomerGatewaysParams) (<-chan *VpnCustomerGateway, <-chan error)
+	StreamVpnGateways(ctx context.Context, p *ListVpnGatewaysParams) (<-chan *VpnGateway, <-chan error)
+	StreamVpnUsers(ctx context.Context, p *ListVpnUsersParams) (<-chan *VpnUser, <-chan error)
+	UpdateRemoteAccessVpn(p *UpdateRemoteAccessVpnParams, opts ...OptionFunc) (*UpdateRemoteAccessVpnResponse, error)
+	UpdateRemoteAccessVpnRaw(v url.Values) (*UpdateRemoteAccessVpnResponse, error)
+	UpdateRemoteAccessVpnRawWithContext(ctx context.Context, v url.Values) (*UpdateRemoteAccessVpnResponse, error)
+	UpdateRemoteAccessVpnWithContext(ctx context.Context, p *UpdateRemoteAccessVpnParams, opts ...OptionFunc) (*UpdateRemoteAccessVpnResponse, error)
+	UpdateVpnConnection(p *UpdateVpnConnectionParams, opts ...OptionFunc) (*UpdateVpnConnectionResponse, error)
+	UpdateVpnConnectionRaw(v url.Values) (*UpdateVpnConnectionResponse, error)
+	UpdateVpnConnectionRawWithContext(ctx context.Context, v url.Values) (*UpdateVpnConnectionResponse, error)
+	UpdateVpnConnectionWithContext(ctx context.Context, p *UpdateVpnConnectionParams, opts ...OptionFunc) (*UpdateVpnConnectionResponse, error)
+	UpdateVpnCustomerGateway(p *UpdateVpnCustomerGatewayParams, opts ...OptionFunc) (*UpdateVpnCustomerGatewayResponse, error)
+	UpdateVpnCustomerGatewayRaw(v url.Values) (*UpdateVpnCustomerGatewayResponse, error)
+	UpdateVpnCustomerGatewayRawWithContext(ctx context.Context, v url.Values) (*UpdateVpnCustomerGatewayResponse, error)
+	UpdateVpnCustomerGatewayWithContext(ctx context.Context, p *UpdateVpnCustomerGatewayParams, opts ...OptionFunc) (*UpdateVpnCustomerGatewayResponse, error)
+	UpdateVpnGateway(p *UpdateVpnGatewayParams, opts ...OptionFunc) (*UpdateVpnGatewayResponse, error)
+	UpdateVpnGatewayRaw(v url.Values) (*UpdateVpnGatewayResponse, error)
+	UpdateVpnGatewayRawWithContext(ctx context.Context, v url.Values) (*UpdateVpnGatewayResponse, error)
+	UpdateVpnGatewayWithContext(ctx context.Context, p *UpdateVpnGatewayParams, opts ...OptionFunc) (*UpdateVpnGatewayResponse, error)
+	VpnConnectionExists(id string, opts ...OptionFunc) (bool, error)
+	VpnCustomerGatewayExists(id string, opts ...OptionFunc) (bool, error)
+	VpnGatewayExists(id string, opts ...OptionFunc) (bool, error)
+	VpnUserExists(id string, opts ...OptionFunc) (bool, error)
+	WaitForVpnConnectionDeleted(ctx context.Context, id string, timeout time.Duration, opts ...OptionFunc) error
+	WaitForVpnCustomerGatewayDeleted(ctx context.Context, id string, timeout time.Duration, opts ...OptionFunc) error
+	WaitForVpnGatewayDeleted(ctx context.Context, id string, timeout time.Duration, opts ...OptionFunc) error
+}
+
+var _ VPNServiceIface = (*VPNService)(nil)
+
+// VirtualMachineServiceIface contains all exported methods of VirtualMachineService, so callers can depend on it to mock the service
+type VirtualMachineServiceIface interface {
+	AddNicToVirtualMachine(p *AddNicToVirtualMachineParams, opts ...OptionFunc) (*AddNicToVirtualMachineResponse, error)
+	AddNicToVirtualMachineRaw(v url.Values) (*AddNicToVirtualMachineResponse, error)
+	AddNicToVirtualMachineRawWithContext(ctx context.Context, v url.Values) (*AddNicToVirtualMachineResponse, error)
+	AddNicToVirtualMachineWithContext(ctx context.Context, p *AddNicToVirtualMachineParams, opts ...OptionFunc) (*AddNicToVirtualMachineResponse, error)
+	AssignVirtualMachine(p *AssignVirtualMachineParams, opts ...OptionFunc) (*AssignVirtualMachineResponse, error)
+	AssignVirtualMachineRaw(v url.Values) (*AssignVirtualMachineResponse, error)
+	AssignVirtualMachineRawWithContext(ctx context.Context, v url.Values) (*AssignVirtualMachineResponse, error)
+	AssignVirtualMachineWithContext(ctx context.Context, p *AssignVirtualMachineParams, opts ...OptionFunc) (*AssignVirtualMachineResponse, error)
+	ChangeServiceForVirtualMachine(p *ChangeServiceForVirtualMachineParams, opts ...OptionFunc) (*ChangeServiceForVirtualMachineResponse, error)
+	ChangeServiceForVirtualMachineRaw(v url.Values) (*ChangeServiceForVirtualMachineResponse, error)
+	ChangeServiceForVirtualMachineRawWithContext(ctx context.Context, v url.Values) (*ChangeServiceForVirtualMachineResponse, error)
+	ChangeServiceForVirtualMachineWithContext(ctx context.Context, p *ChangeServiceForVirtualMachineParams, opts ...OptionFunc) (*ChangeServiceForVirtualMachineResponse, error)
+	CleanVMReservations(p *CleanVMReservationsParams, opts ...OptionFunc) (*CleanVMReservationsResponse, error)
+	CleanVMReservationsRaw(v url.Values) (*CleanVMReservationsResponse, error)
+	CleanVMReservationsRawWithContext(ctx context.Context, v url.Values) (*CleanVMReservationsResponse, error)
+	CleanVMReservationsWithContext(ctx context.Context, p *CleanVMReservationsParams, opts ...OptionFunc) (*CleanVMReservationsResponse, error)
+	DeployFromSpec(spec *VMSpec, opts ...OptionFunc) (*VirtualMachine, error)
+	DeployVirtualMachine(p *DeployVirtualMachineParams, opts ...OptionFunc) (*DeployVirtualMachineResponse, error)
+	DeployVirtualMachineRaw(v url.Values) (*DeployVirtualMachineResponse, error)
+	DeployVirtualMachineRawWithContext(ctx context.Context, v url.Values) (*DeployVirtualMachineResponse, error)
+	DeployVirtualMachineWithContext(ctx context.Context, p *DeployVirtualMachineParams, opts ...OptionFunc) (*DeployVirtualMachineResponse, error)
+	DeployWithCapacityRetry(p *DeployVirtualMachineParams, candidateClusterIDs []string, opts ...OptionFunc) (*VirtualMachine, error)
+	DestroyVirtualMachine(p *DestroyVirtualMachineParams, opts ...OptionFunc) (*DestroyVirtualMachineResponse, error)
+	DestroyVirtualMachineRaw(v url.Values) (*DestroyVirtualMachineResponse, error)
+	DestroyVirtualMachineRawWithContext(ctx context.Context, v url.Values) (*DestroyVirtualMachineResponse, error)
+	DestroyVirtualMachineWithContext(ctx context.Context, p *DestroyVirtualMachineParams, opts ...OptionFunc) (*DestroyVirtualMachineResponse, error)
+	ExportSpec(vmid string, opts ...OptionFunc) (*VMSpec, error)
+	ExpungeVirtualMachine(p *ExpungeVirtualMachineParams, opts ...OptionFunc) (*ExpungeVirtualMachineResponse, error)
+	ExpungeVirtualMachineRaw(v url.Values) (*ExpungeVirtualMachineResponse, error)
+	ExpungeVirtualMachineRawWithContext(ctx context.Context, v url.Values) (*ExpungeVirtualMachineResponse, error)
+	ExpungeVirtualMachineWithContext(ctx context.Context, p *ExpungeVirtualMachineParams, opts ...OptionFunc) (*ExpungeVirtualMachineResponse, error)
+	GetDecryptedPassword(vmid string, privateKeyPEM []byte) (string, error)
+	GetVMPassword(p *GetVMPasswordParams, opts ...OptionFunc) (*GetVMPasswordResponse, error)
+	GetVMPasswordRaw(v url.Values) (*GetVMPasswordResponse, error)
+	GetVMPasswordRawWithContext(ctx context.Context, v url.Values) (*GetVMPasswordResponse, error)
+	GetVMPasswordWithContext(ctx context.Context, p *GetVMPasswordParams, opts ...OptionFunc) (*GetVMPasswordResponse, error)
+	GetVirtualMachineByID(id string, opts ...OptionFunc) (*VirtualMachine, int, error)
+	GetVirtualMachineByName(name string, opts ...OptionFunc) (*VirtualMachine, int, error)
+	GetVirtualMachineID(name string, opts ...OptionFunc) (string, int, error)
+	ListAllVirtualMachines(p *ListVirtualMachinesParams) ([]*VirtualMachine, error)
+	ListVirtualMachines(p *ListVirtualMachinesParams) (*ListVirtualMachinesResponse, error)
+	ListVirtualMachinesAllZones(p *ListVirtualMachinesParams, concurrency int) ([]*VirtualMachine, error)
+	ListVirtualMachinesRaw(v url.Values) (*ListVirtualMachinesResponse, error)
+	ListVirtualMachinesRawWithContext(ctx context.Context, v url.Values) (*ListVirtualMachinesResponse, error)
+	ListVirtualMachinesWhere(p *ListVirtualMachinesParams, pred func(*VirtualMachine) bool) ([]*VirtualMachine, error)
+	ListVirtualMachinesWithContext(ctx context.Context, p *ListVirtualMachinesParams) (*ListVirtualMachinesResponse, error)
+	MigrateVirtualMachine(p *MigrateVirtualMachineParams, opts ...OptionFunc) (*MigrateVirtualMachineResponse, error)
+	MigrateVirtualMachineRaw(v url.Values) (*MigrateVirtualMachineResponse, error)
+	MigrateVirtualMachineRawWithContext(ctx context.Context, v url.Values) (*MigrateVirtualMachineResponse, error)
+	MigrateVirtualMachineWithContext(ctx context.Context, p *MigrateVirtualMachineParams, opts ...OptionFunc) (*MigrateVirtualMachineResponse, error)
+	MigrateVirtualMachineWithVolume(p *MigrateVirtualMachineWithVolumeParams, opts ...OptionFunc) (*MigrateVirtualMachineWithVolumeResponse, error)
+	MigrateVirtualMachineWithVolumeRaw(v url.Values) (*MigrateVirtualMachineWithVolumeResponse, error)
+	MigrateVirtualMachineWithVolumeRawWithContext(ctx context.Context, v url.Values) (*MigrateVirtualMachineWithVolumeResponse, error)
+	MigrateVirtualMachineWithVolumeWithContext(ctx context.Context, p *MigrateVirtualMachineWithVolumeParams, opts ...OptionFunc) (*MigrateVirtualMachineWithVolumeResponse, error)
+	NewAddNicToVirtualMachineParams(networkid string, virtualmachineid string, opts ...func(*AddNicToVirtualMachineParams)) *AddNicToVirtualMachineParams
+	NewAssignVirtualMachineParams(account string, domainid string, virtualmachineid string, opts ...func(*AssignVirtualMachineParams)) *AssignVirtualMachineParams
+	NewAssignVirtualMachineParamsFromOpts(o AssignVirtualMachineOpts) *AssignVirtualMachineParams
+	NewChangeServiceForVirtualMachineParams(id string, serviceofferingid string, opts ...func(*ChangeServiceForVirtualMachineParams)) *ChangeServiceForVirtualMachineParams
+	NewCleanVMReservationsParams(opts ...func(*CleanVMReservationsParams)) *CleanVMReservationsParams
+	NewDeployVirtualMachineParams(serviceofferingid string, templateid string, zoneid string, opts ...func(*DeployVirtualMachineParams)) *DeployVirtualMachineParams
+	NewDeployVirtualMachineParamsFromOpts(o DeployVirtualMachineOpts) *DeployVirtualMachineParams
+	NewDestroyVirtualMachineParams(id string, opts ...func(*DestroyVirtualMachineParams)) *DestroyVirtualMachineParams
+	NewExpungeVirtualMachineParams(id string, opts ...func(*ExpungeVirtualMachineParams)) *ExpungeVirtualMachineParams
+	NewGetVMPasswordParams(id string, opts ...func(*GetVMPasswordParams)) *GetVMPasswordParams
+	NewListVirtualMachinesParams(opts ...func(*ListVirtualMachinesParams)) *ListVirtualMachinesParams
+	NewMigrateVirtualMachineParams(virtualmachineid string, opts ...func(*MigrateVirtualMachineParams)) *MigrateVirtualMachineParams
+	NewMigrateVirtualMachineWithVolumeParams(hostid string, virtualmachineid string, opts ...func(*MigrateVirtualMachineWithVolumeParams)) *MigrateVirtualMachineWithVolumeParams
+	NewRebootVirtualMachineParams(id string, opts ...func(*RebootVirtualMachineParams)) *RebootVirtualMachineParams
+	NewRecoverVirtualMachineParams(id string, opts ...func(*RecoverVirtualMachineParams)) *RecoverVirtualMachineParams
+	NewRemoveNicFromVirtualMachineParams(nicid string, virtualmachineid string, opts ...func(*RemoveNicFromVirtualMachineParams)) *RemoveNicFromVirtualMachineParams
+	NewResetPasswordForVirtualMachineParams(id string, opts ...func(*ResetPasswordForVirtualMachineParams)) *ResetPasswordForVirtualMachineParams
+	NewRestoreVirtualMachineParams(virtualmachineid string, opts ...func(*RestoreVirtualMachineParams)) *RestoreVirtualMachineParams
+	NewScaleVirtualMachineParams(id string, serviceofferingid string, opts ...func(*ScaleVirtualMachineParams)) *ScaleVirtualMachineParams
+	NewStartVirtualMachineParams(id string, opts ...func(*StartVirtualMachineParams)) *StartVirtualMachineParams
+	NewStopVirtualMachineParams(id string, opts ...func(*StopVirtualMachineParams)) *StopVirtualMachineParams
+	NewUpdateDefaultNicForVirtualMachineParams(nicid string, virtualmachineid string, opts ...func(*UpdateDefaultNicForVirtualMachineParams)) *UpdateDefaultNicForVirtualMachineParams
+	NewUpdateVirtualMachineParams(id string, opts ...func(*UpdateVirtualMachineParams)) *UpdateVirtualMachineParams
+	RebootVirtualMachine(p *RebootVirtualMachineParams, opts ...OptionFunc) (*RebootVirtualMachineResponse, error)
+	RebootVirtualMachineRaw(v url.Values) (*RebootVirtualMachineResponse, error)
+	RebootVirtualMachineRawWithContext(ctx context.Context, v url.Values) (*RebootVirtualMachineResponse, error)
+	RebootVirtualMachineWithContext(ctx context.Context, p *RebootVirtualMachineParams, opts ...OptionFunc) (*RebootVirtualMachineResponse, error)
+	RecoverVirtualMachine(p *RecoverVirtualMachineParams, opts ...OptionFunc) (*RecoverVirtualMachineResponse, error)
+	RecoverVirtualMachineRaw(v url.Values) (*RecoverVirtualMachineResponse, error)
+	RecoverVirtualMachineRawWithContext(ctx context.Context, v url.Values) (*RecoverVirtualMachineResponse, error)
+	RecoverVirtualMachineWithContext(ctx context.Context, p *RecoverVirtualMachineParams, opts ...OptionFunc) (*RecoverVirtualMachineResponse, error)
+	RemoveNicFromVirtualMachine(p *RemoveNicFromVirtualMachineParams, opts ...OptionFunc) (*RemoveNicFromVirtualMachineResponse, error)
+	RemoveNicFromVirtualMachineRaw(v url.Values) (*RemoveNicFromVirtualMachineResponse, error)
+	RemoveNicFromVirtualMachineRawWithContext(ctx context.Context, v url.Values) (*RemoveNicFromVirtualMachineResponse, error)
+	RemoveNicFromVirtualMachineWithContext(ctx context.Context, p *RemoveNicFromVirtualMachineParams, opts ...OptionFunc) (*RemoveNicFromVirtualMachineResponse, error)
+	ResetPasswordForVirtualMachine(p *ResetPasswordForVirtualMachineParams, opts ...OptionFunc) (*ResetPasswordForVirtualMachineResponse, error)
+	ResetPasswordForVirtualMachineRaw(v url.Values) (*ResetPasswordForVirtualMachineResponse, error)
+	ResetPasswordForVirtualMachineRawWithContext(ctx context.Context, v url.Values) (*ResetPasswordForVirtualMachineResponse, error)
+	ResetPasswordForVirtualMachineWithContext(ctx context.Context, p *ResetPasswordForVirtualMachineParams, opts ...OptionFunc) (*ResetPasswordForVirtualMachineResponse, error)
+	RestoreVirtualMachine(p *RestoreVirtualMachineParams, opts ...OptionFunc) (*RestoreVirtualMachineResponse, error)
+	RestoreVirtualMachineRaw(v url.Values) (*RestoreVirtualMachineResponse, error)
+	RestoreVirtualMachineRawWithContext(ctx context.Context, v url.Values) (*RestoreVirtualMachineResponse, error)
+	RestoreVirtualMachineWithContext(ctx context.Context, p *RestoreVirtualMachineParams, opts ...OptionFunc) (*RestoreVirtualMachineResponse, error)
+	ScaleVirtualMachine(p *ScaleVirtualMachineParams, opts ...OptionFunc) (*ScaleVirtualMachineResponse, error)
+	ScaleVirtualMachineRaw(v url.Values) (*ScaleVirtualMachineResponse, error)
+	ScaleVirtualMachineRawWithContext(ctx context.Context, v url.Values) (*ScaleVirtualMachineResponse, error)
+	ScaleVirtualMachineWithContext(ctx context.Context, p *ScaleVirtualMachineParams, opts ...OptionFunc) (*ScaleVirtualMachineResponse, error)
+	StartVirtualMachine(p *StartVirtualMachineParams, opts ...OptionFunc) (*StartVirtualMachineResponse, error)
+	StartVirtualMachineRaw(v url.Values) (*StartVirtualMachineResponse, error)
+	StartVirtualMachineRawWithContext(ctx context.Context, v url.Values) (*StartVirtualMachineResponse, error)
+	StartVirtualMachineWithContext(ctx context.Context, p *StartVirtualMachineParams, opts ...OptionFunc) (*StartVirtualMachineResponse, error)
+	StopVirtualMachine(p *StopVirtualMachineParams, opts ...OptionFunc) (*StopVirtualMachineResponse, error)
+	StopVirtualMachineRaw(v url.Values) (*StopVirtualMachineResponse, error)
+	StopVirtualMachineRawWithContext(ctx context.Context, v url.Values) (*StopVirtualMachineResponse, error)
+	StopVirtualMachineWithContext(ctx context.Context, p *StopVirtualMachineParams, opts ...OptionFunc) (*StopVirtualMachineResponse, error)
+	StreamVirtualMachines(ctx context.Context, p *ListVirtualMachinesParams) (<-chan *VirtualMachine, <-chan error)
+	UpdateDefaultNicForVirtualMachine(p *UpdateDefaultNicForVirtualMachineParams, opts ...OptionFunc) (*UpdateDefaultNicForVirtualMachineResponse, error)
+	UpdateDefaultNicForVirtualMachineRaw(v url.Values) (*UpdateDefaultNicForVirtualMachineResponse, error)
+	UpdateDefaultNicForVirtualMachineRawWithContext(ctx context.Context, v url.Values) (*UpdateDefaultNicForVirtualMachineResponse, error)
+	UpdateDefaultNicForVirtualMachineWithContext(ctx context.Context, p *UpdateDefaultNicForVirtualMachineParams, opts ...OptionFunc) (*UpdateDefaultNicForVirtualMachineResponse, error)
+	UpdateMany(ids []string, mutate func(*UpdateVirtualMachineParams), concurrency int, opts ...OptionFunc) (BulkResult[*UpdateVirtualMachineResponse], error)
+	UpdateVirtualMachine(p *UpdateVirtualMachineParams, opts ...OptionFunc) (*UpdateVirtualMachineResponse, error)
+	UpdateVirtualMachineRaw(v url.Values) (*UpdateVirtualMachineResponse, error)
+	UpdateVirtualMachineRawWithContext(ctx context.Context, v url.Values) (*UpdateVirtualMachineResponse, error)
+	UpdateVirtualMachineWithContext(ctx context.Context, p *UpdateVirtualMachineParams, opts ...OptionFunc) (*UpdateVirtualMachineResponse, error)
+	VirtualMachineExists(id string, opts ...OptionFunc) (bool, error)
+}
+
+var _ VirtualMachineServiceIface = (*VirtualMachineService)(nil)
+
+// VolumeServiceIface contains all exported methods of VolumeService, so callers can depend on it to mock the service
+type VolumeServiceIface interface {
+	AttachAndWait(volumeid, vmid string, opts ...OptionFunc) (*Volume, error)
+	AttachVolume(p *AttachVolumeParams, opts ...OptionFunc) (*AttachVolumeResponse, error)
+	AttachVolumeRaw(v url.Values) (*AttachVolumeResponse, error)
+	AttachVolumeRawWithContext(ctx context.Context, v url.Values) (*AttachVolumeResponse, error)
+	AttachVolumeWithContext(ctx context.Context, p *AttachVolumeParams, opts ...OptionFunc) (*AttachVolumeResponse, error)
+	CreateVolume(p *CreateVolumeParams, opts ...OptionFunc) (*CreateVolumeResponse, error)
+	CreateVolumeRaw(v url.Values) (*CreateVolumeResponse, error)
+	CreateVolumeRawWithContext(ctx context.Context, v url.Values) (*CreateVolumeResponse, error)
+	CreateVolumeWithContext(ctx context.Context, p *CreateVolumeParams, opts ...OptionFunc) (*CreateVolumeResponse, error)
+	DeleteVolume(p *DeleteVolumeParams, opts ...OptionFunc) (*DeleteVolumeResponse, error)
+	DeleteVolumeRaw(v url.Values) (*DeleteVolumeResponse, error)
+	DeleteVolumeRawWithContext(ctx context.Context, v url.Values) (*DeleteVolumeResponse, error)
+	DeleteVolumeWithContext(ctx context.Context, p *DeleteVolumeParams, opts ...OptionFunc) (*DeleteVolumeResponse, error)
+	DetachAndWait(volumeid string, opts ...OptionFunc) (*Volume, error)
+	DetachVolume(p *DetachVolumeParams, opts ...OptionFunc) (*DetachVolumeResponse, error)
+	DetachVolumeRaw(v url.Values) (*DetachVolumeResponse, error)
+	DetachVolumeRawWithContext(ctx context.Context, v url.Values) (*DetachVolumeResponse, error)
+	DetachVolumeWithContext(ctx context.Context, p *DetachVolumeParams, opts ...OptionFunc) (*DetachVolumeResponse, error)
+	ExtractToFile(volumeid, zoneid, mode, destPath string) error
+	ExtractVolume(p *ExtractVolumeParams, opts ...OptionFunc) (*ExtractVolumeResponse, error)
+	ExtractVolumeRaw(v url.Values) (*ExtractVolumeResponse, error)
+	ExtractVolumeRawWithContext(ctx context.Context, v url.Values) (*ExtractVolumeResponse, error)
+	ExtractVolumeWithContext(ctx context.Context, p *ExtractVolumeParams, opts ...OptionFunc) (*ExtractVolumeResponse, error)
+	GetPathForVolume(p *GetPathForVolumeParams, opts ...OptionFunc) (*GetPathForVolumeResponse, error)
+	GetPathForVolumeRaw(v url.Values) (*GetPathForVolumeResponse, error)
+	GetPathForVolumeRawWithContext(ctx context.Context, v url.Values) (*GetPathForVolumeResponse, error)
+	GetPathForVolumeWithContext(ctx context.Context, p *GetPathForVolumeParams, opts ...OptionFunc) (*GetPathForVolumeResponse, error)
+	GetSolidFireVolumeAccessGroupId(p *GetSolidFireVolumeAccessGroupIdParams, opts ...OptionFunc) (*GetSolidFireVolumeAccessGroupIdResponse, error)
+	GetSolidFireVolumeAccessGroupIdRaw(v url.Values) (*GetSolidFireVolumeAccessGroupIdResponse, error)
+	GetSolidFireVolumeAccessGroupIdRawWithContext(ctx context.Context, v url.Values) (*GetSolidFireVolumeAccessGroupIdResponse, error)
+	GetSolidFireVolumeAccessGroupIdWithContext(ctx context.Context, p *GetSolidFireVolumeAccessGroupIdParams, opts ...OptionFunc) (*GetSolidFireVolumeAccessGroupIdResponse, error)
+	GetSolidFireVolumeSize(p *GetSolidFireVolumeSizeParams, opts ...OptionFunc) (*GetSolidFireVolumeSizeResponse, error)
+	GetSolidFireVolumeSizeRaw(v url.Values) (*GetSolidFireVolumeSizeResponse, error)
+	GetSolidFireVolumeSizeRawWithContext(ctx context.Context, v url.Values) (*GetSolidFireVolumeSizeResponse, error)
+	GetSolidFireVolumeSizeWithContext(ctx context.Context, p *GetSolidFireVolumeSizeParams, opts ...OptionFunc) (*GetSolidFireVolumeSizeResponse, error)
+	GetUploadParamsForVolume(p *GetUploadParamsForVolumeParams, opts ...OptionFunc) (*GetUploadParamsForVolumeResponse, error)
+	GetUploadParamsForVolumeRaw(v url.Values) (*GetUploadParamsForVolumeResponse, error)
+	GetUploadParamsForVolumeRawWithContext(ctx context.Context, v url.Values) (*GetUploadParamsForVolumeResponse, error)
+	GetUploadParamsForVolumeWithContext(ctx context.Context, p *GetUploadParamsForVolumeParams, opts ...OptionFunc) (*GetUploadParamsForVolumeResponse, error)
+	GetVolumeByID(id string, opts ...OptionFunc) (*Volume, int, error)
+	GetVolumeByName(name string, opts ...OptionFunc) (*Volume, int, error)
+	GetVolumeID(name string, opts ...OptionFunc) (string, int, error)
+	GetVolumeiScsiName(p *GetVolumeiScsiNameParams, opts ...OptionFunc) (*GetVolumeiScsiNameResponse, error)
+	GetVolumeiScsiNameRaw(v url.Values) (*GetVolumeiScsiNameResponse, error)
+	GetVolumeiScsiNameRawWithContext(ctx context.Context, v url.Values) (*GetVolumeiScsiNameResponse, error)
+	GetVolumeiScsiNameWithContext(ctx context.Context, p *GetVolumeiScsiNameParams, opts ...OptionFunc) (*GetVolumeiScsiNameResponse, error)
+	ListAllVolumes(p *ListVolumesParams) ([]*Volume, error)
+	ListVolumes(p *ListVolumesParams) (*ListVolumesResponse, error)
+	ListVolumesRaw(v url.Values) (*ListVolumesResponse, error)
+	ListVolumesRawWithContext(ctx context.Context, v url.Values) (*ListVolumesResponse, error)
+	ListVolumesWhere(p *ListVolumesParams, pred func(*Volume) bool) ([]*Volume, error)
+	ListVolumesWithContext(ctx context.Context, p *ListVolumesParams) (*ListVolumesResponse, error)
+	MigrateVolume(p *MigrateVolumeParams, opts ...OptionFunc) (*MigrateVolumeResponse, error)
+	MigrateVolumeRaw(v url.Values) (*MigrateVolumeResponse, error)
+	MigrateVolumeRawWithContext(ctx context.Context, v url.Values) (*MigrateVolumeResponse, error)
+	MigrateVolumeWithContext(ctx context.Context, p *MigrateVolumeParams, opts ...OptionFunc) (*MigrateVolumeResponse, error)
+	NewAttachVolumeParams(id string, virtualmachineid string, opts ...func(*AttachVolumeParams)) *AttachVolumeParams
+	NewCreateVolumeParams(opts ...func(*CreateVolumeParams)) *CreateVolumeParams
+	NewDeleteVolumeParams(id string, opts ...func(*DeleteVolumeParams)) *DeleteVolumeParams
+	NewDetachVolumeParams(opts ...func(*DetachVolumeParams)) *DetachVolumeParams
+	NewExtractVolumeParams(id string, mode string, zoneid string, opts ...func(*ExtractVolumeParams)) *ExtractVolumeParams
+	NewExtractVolumeParamsFromOpts(o ExtractVolumeOpts) *ExtractVolumeParams
+	NewGetPathForVolumeParams(volumeid string, opts ...func(*GetPathForVolumeParams)) *GetPathForVolumeParams
+	NewGetSolidFireVolumeAccessGroupIdParams(clusterid string, storageid string, opts ...func(*GetSolidFireVolumeAccessGroupIdParams)) *GetSolidFireVolumeAccessGroupIdParams
+	NewGetSolidFireVolumeSizeParams(volumeid string, opts ...func(*GetSolidFireVolumeSizeParams)) *GetSolidFireVolumeSizeParams
+	NewGetUploadParamsForVolumeParams(format string, name string, zoneid string, opts ...func(*GetUploadParamsForVolumeParams)) *GetUploadParamsForVolumeParams
+	NewGetUploadParamsForVolumeParamsFromOpts(o GetUploadParamsForVolumeOpts) *GetUploadParamsForVolumeParams
+	NewGetVolumeiScsiNameParams(volumeid string, opts ...func(*GetVolumeiScsiNameParams)) *GetVolumeiScsiNameParams
+	NewListVolumesParams(opts ...func(*ListVolumesParams)) *ListVolumesParams
+	NewMigrateVolumeParams(storageid string, volumeid string, opts ...func(*MigrateVolumeParams)) *MigrateVolumeParams
+	NewResizeVolumeParams(id string, opts ...func(*ResizeVolumeParams)) *ResizeVolumeParams
+	NewUpdateVolumeParams(opts ...func(*UpdateVolumeParams)) *UpdateVolumeParams
+	NewUploadVolumeParams(format string, name string, url string, zoneid string, opts ...func(*UploadVolumeParams)) *UploadVolumeParams
+	NewUploadVolumeParamsFromOpts(o UploadVolumeOpts) *UploadVolumeParams
+	ResizeAndWait(volumeid string, newSizeGB int64, opts ...OptionFunc) (*Volume, error)
+	ResizeVolume(p *ResizeVolumeParams, opts ...OptionFunc) (*ResizeVolumeResponse, error)
+	ResizeVolumeRaw(v url.Values) (*ResizeVolumeResponse, error)
+	ResizeVolumeRawWithContext(ctx context.Context, v url.Values) (*ResizeVolumeResponse, error)
+	ResizeVolumeWithContext(ctx context.Context, p *ResizeVolumeParams, opts ...OptionFunc) (*ResizeVolumeResponse, error)
+	StreamVolumes(ctx context.Context, p *ListVolumesParams) (<-chan *Volume, <-chan error)
+	UpdateMany(ids []string, mutate func(*UpdateVolumeParams), concurrency int, opts ...OptionFunc) (BulkResult[*UpdateVolumeResponse], error)
+	UpdateVolume(p *UpdateVolumeParams, opts ...OptionFunc) (*UpdateVolumeResponse, error)
+	UpdateVolumeRaw(v url.Values) (*UpdateVolumeResponse, error)
+	UpdateVolumeRawWithContext(ctx context.Context, v url.Values) (*UpdateVolumeResponse, error)
+	UpdateVolumeWithContext(ctx context.Context, p *UpdateVolumeParams, opts ...OptionFunc) (*UpdateVolumeResponse, error)
+	UploadVolume(p *UploadVolumeParams, opts ...OptionFunc) (*UploadVolumeResponse, error)
+	UploadVolumeRaw(v url.Values) (*UploadVolumeResponse, error)
+	UploadVolumeRawWithContext(ctx context.Context, v url.Values) (*UploadVolumeResponse, error)
+	UploadVolumeWithContext(ctx context.Context, p *UploadVolumeParams, opts ...OptionFunc) (*UploadVolumeResponse, error)
+	VolumeExists(id string, opts ...OptionFunc) (bool, error)
+	WaitForVolumeDeleted(ctx context.Context, id string, timeout time.Duration, opts ...OptionFunc) error
+}
+
+var _ VolumeServiceIface = (*VolumeService)(nil)
+
+// ZoneServiceIface contains all exported methods of ZoneService, so callers can depend on it to mock the service
+type ZoneServiceIface interface {
+	AddVmwareDc(p *AddVmwareDcParams, opts ...OptionFunc) (*AddVmwareDcResponse, error)
+	AddVmwareDcRaw(v url.Values) (*AddVmwareDcResponse, error)
+	AddVmwareDcRawWithContext(ctx context.Context, v url.Values) (*AddVmwareDcResponse, error)
+	AddVmwareDcWithContext(ctx context.Context, p *AddVmwareDcParams, opts ...OptionFunc) (*AddVmwareDcResponse, error)
+	CreateZone(p *CreateZoneParams, opts ...OptionFunc) (*CreateZoneResponse, error)
+	CreateZoneRaw(v url.Values) (*CreateZoneResponse, error)
+	CreateZoneRawWithContext(ctx context.Context, v url.Values) (*CreateZoneResponse, error)
+	CreateZoneWithContext(ctx context.Context, p *CreateZoneParams, opts ...OptionFunc) (*CreateZoneResponse, error)
+	DedicateZone(p *DedicateZoneParams, opts ...OptionFunc) (*DedicateZoneResponse, error)
+	DedicateZoneRaw(v url.Values) (*DedicateZoneResponse, error)
+	DedicateZoneRawWithContext(ctx context.Context, v url.Values) (*DedicateZoneResponse, error)
+	DedicateZoneWithContext(ctx context.Context, p *DedicateZoneParams, opts ...OptionFunc) (*DedicateZoneResponse, error)
+	DeleteZone(p *DeleteZoneParams, opts ...OptionFunc) (*DeleteZoneResponse, error)
+	DeleteZoneRaw(v url.Values) (*DeleteZoneResponse, error)
+	DeleteZoneRawWithContext(ctx context.Context, v url.Values) (*DeleteZoneResponse, error)
+	DeleteZoneWithContext(ctx context.Context, p *DeleteZoneParams, opts ...OptionFunc) (*DeleteZoneResponse, error)
+	DisableOutOfBandManagementForZone(p *DisableOutOfBandManagementForZoneParams, opts ...OptionFunc) (*DisableOutOfBandManagementForZoneResponse, error)
+	DisableOutOfBandManagementForZoneRaw(v url.Values) (*DisableOutOfBandManagementForZoneResponse, error)
+	DisableOutOfBandManagementForZoneRawWithContext(ctx context.Context, v url.Values) (*DisableOutOfBandManagementForZoneResponse, error)
+	DisableOutOfBandManagementForZoneWithContext(ctx context.Context, p *DisableOutOfBandManagementForZoneParams, opts ...OptionFunc) (*DisableOutOfBandManagementForZoneResponse, error)
+	EnableOutOfBandManagementForZone(p *EnableOutOfBandManagementForZoneParams, opts ...OptionFunc) (*EnableOutOfBandManagementForZoneResponse, error)
+	EnableOutOfBandManagementForZoneRaw(v url.Values) (*EnableOutOfBandManagementForZoneResponse, error)
+	EnableOutOfBandManagementForZoneRawWithContext(ctx context.Context, v url.Values) (*EnableOutOfBandManagementForZoneResponse, error)
+	EnableOutOfBandManagementForZoneWithContext(ctx context.Context, p *EnableOutOfBandManagementForZoneParams, opts ...OptionFunc) (*EnableOutOfBandManagementForZoneResponse, error)
+	GetVmwareDcID(keyword string, zoneid string, opts ...OptionFunc) (string, int, error)
+	GetZoneByID(id string, opts ...OptionFunc) (*Zone, int, error)
+	GetZoneByName(name string, opts ...OptionFunc) (*Zone, int, error)
+	GetZoneID(name string, opts ...OptionFunc) (string, int, error)
+	ListAllDedicatedZones(p *ListDedicatedZonesParams) ([]*DedicatedZone, error)
+	ListAllVmwareDcs(p *ListVmwareDcsParams) ([]*VmwareDc, error)
+	ListAllZones(p *ListZonesParams) ([]*Zone, error)
+	ListDedicatedZones(p *ListDedicatedZonesParams) (*ListDedicatedZonesResponse, error)
+	ListDedicatedZonesRaw(v url.Values) (*ListDedicatedZonesResponse, error)
+	ListDedicatedZonesRawWithContext(ctx context.Context, v url.Values) (*ListDedicatedZonesResponse, error)
+	ListDedicatedZonesWhere(p *ListDedicatedZonesParams, pred func(*DedicatedZone) bool) ([]*DedicatedZone, error)
+	ListDedicatedZonesWithContext(ctx context.Context, p *ListDedicatedZonesParams) (*ListDedicatedZonesResponse, error)
+	ListVmwareDcs(p *ListVmwareDcsParams) (*ListVmwareDcsResponse, error)
+	ListVmwareDcsRaw(v url.Values) (*ListVmwareDcsResponse, error)
+	ListVmwareDcsRawWithContext(ctx context.Context, v url.Values) (*ListVmwareDcsResponse, error)
+	ListVmwareDcsWhere(p *ListVmwareDcsParams, pred func(*VmwareDc) bool) ([]*VmwareDc, error)
+	ListVmwareDcsWithContext(ctx context.Context, p *ListVmwareDcsParams) (*ListVmwareDcsResponse, error)
+	ListZones(p *ListZonesParams) (*ListZonesResponse, error)
+	ListZonesRaw(v url.Values) (*ListZonesResponse, error)
+	ListZonesRawWithContext(ctx context.Context, v url.Values) (*ListZonesResponse, error)
+	ListZonesWhere(p *ListZonesParams, pred func(*Zone) bool) ([]*Zone, error)
+	ListZonesWithContext(ctx context.Context, p *ListZonesParams) (*ListZonesResponse, error)
+	NewAddVmwareDcParams(name string, vcenter string, zoneid string, opts ...func(*AddVmwareDcParams)) *AddVmwareDcParams
+	NewAddVmwareDcParamsFromOpts(o AddVmwareDcOpts) *AddVmwareDcParams
+	NewCreateZoneParams(dns1 string, internaldns1 string, name string, networktype string, opts ...func(*CreateZoneParams)) *CreateZoneParams
+	NewCreateZoneParamsFromOpts(o CreateZoneOpts) *CreateZoneParams
+	NewDedicateZoneParams(domainid string, zoneid string, opts ...func(*DedicateZoneParams)) *DedicateZoneParams
+	NewDeleteZoneParams(id string, opts ...func(*DeleteZoneParams)) *DeleteZoneParams
+	NewDisableOutOfBandManagementForZoneParams(zoneid string, opts ...func(*DisableOutOfBandManagementForZoneParams)) *DisableOutOfBandManagementForZoneParams
+	NewEnableOutOfBandManagementForZoneParams(zoneid string, opts ...func(*EnableOutOfBandManagementForZoneParams)) *EnableOutOfBandManagementForZoneParams
+	NewListDedicatedZonesParams(opts ...func(*ListDedicatedZonesParams)) *ListDedicatedZonesParams
+	NewListVmwareDcsParams(zoneid string, opts ...func(*ListVmwareDcsParams)) *ListVmwareDcsParams
+	NewListZonesParams(opts ...func(*ListZonesParams)) *ListZonesParams
+	NewReleaseDedicatedZoneParams(zoneid string, opts ...func(*ReleaseDedicatedZoneParams)) *ReleaseDedicatedZoneParams
+	NewRemoveVmwareDcParams(zoneid string, opts ...func(*RemoveVmwareDcParams)) *RemoveVmwareDcParams
+	NewUpdateZoneParams(id string, opts ...func(*UpdateZoneParams)) *UpdateZoneParams
+	ReleaseDedicatedZone(p *ReleaseDedicatedZoneParams, opts ...OptionFunc) (*ReleaseDedicatedZoneResponse, error)
+	ReleaseDedicatedZoneRaw(v url.Values) (*ReleaseDedicatedZoneResponse, error)
+	ReleaseDedicatedZoneRawWithContext(ctx context.Context, v url.Values) (*ReleaseDedicatedZoneResponse, error)
+	ReleaseDedicatedZoneWithContext(ctx context.Context, p *ReleaseDedicatedZoneParams, opts ...OptionFunc) (*ReleaseDedicatedZoneResponse, error)
+	RemoveVmwareDc(p *RemoveVmwareDcParams, opts ...OptionFunc) (*RemoveVmwareDcResponse, error)
+	RemoveVmwareDcRaw(v url.Values) (*RemoveVmwareDcResponse, error)
+	RemoveVmwareDcRawWithContext(ctx context.Context, v url.Values) (*RemoveVmwareDcResponse, error)
+	RemoveVmwareDcWithContext(ctx context.Context, p *RemoveVmwareDcParams, opts ...OptionFunc) (*RemoveVmwareDcResponse, error)
+	StreamDedicatedZones(ctx context.Context, p *ListDedicatedZonesParams) (<-chan *DedicatedZone, <-chan error)
+	StreamVmwareDcs(ctx context.Context, p *ListVmwareDcsParams) (<-chan *VmwareDc, <-chan error)
+	StreamZones(ctx context.Context, p *ListZonesParams) (<-chan *Zone, <-chan error)
+	UpdateMany(ids []string, mutate func(*UpdateZoneParams), concurrency int, opts ...OptionFunc) (BulkResult[*UpdateZoneResponse], error)
+	UpdateZone(p *UpdateZoneParams, opts ...OptionFunc) (*UpdateZoneResponse, error)
+	UpdateZoneRaw(v url.Values) (*UpdateZoneResponse, error)
+	UpdateZoneRawWithContext(ctx context.Context, v url.Values) (*UpdateZoneResponse, error)
+	UpdateZoneWithContext(ctx context.Context, p *UpdateZoneParams, opts ...OptionFunc) (*UpdateZoneResponse, error)
+	WaitForZoneDeleted(ctx context.Context, id string, timeout time.Duration, opts ...OptionFunc) error
+	ZoneExists(id string, opts ...OptionFunc) (bool, error)
+}
+
+var _ ZoneServiceIface = (*ZoneService)(nil)
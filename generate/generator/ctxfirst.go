@@ -0,0 +1,241 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package generator
+
+import (
+	"bytes"
+	"go/ast"
+	"go/format"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// ContextFirst rewrites the generated (and goimports'ed) package in dir, so every function and method
+// which issues requests takes a context.Context as its first argument. The context is threaded through
+// to the requests instead of passing a background context. This is done by rewriting the code instead of
+// generating it, as the context has to be threaded through all helpers, including the ones written by
+// hand. Every function that uses a background context, or calls a function that was rewritten, is
+// rewritten as well. Functions which already take a context keep their signature, but pass their
+// context on. The functions of tests keep their signature as well, and pass a background context to
+// the rewritten functions. Imports are not updated, so goimports should be run on dir afterwards.
+func ContextFirst(dir string) error {
+	names, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return err
+	}
+	sort.Strings(names)
+
+	fset := token.NewFileSet()
+	files := make([]*ast.File, 0, len(names))
+	for _, name := range names {
+		f, err := parser.ParseFile(fset, name, nil, parser.ParseComments)
+		if err != nil {
+			return err
+		}
+		files = append(files, f)
+	}
+
+	info := &types.Info{
+		Defs: make(map[*ast.Ident]types.Object),
+		Uses: make(map[*ast.Ident]types.Object),
+	}
+	conf := &types.Config{Importer: importer.ForCompiler(fset, "source", nil)}
+	if _, err := conf.Check(pkg, fset, files, info); err != nil {
+		return err
+	}
+
+	var decls []*ast.FuncDecl
+	tests := make(map[*ast.FuncDecl]bool)
+	for i, f := range files {
+		for _, d := range f.Decls {
+			if fd, ok := d.(*ast.FuncDecl); ok && fd.Body != nil {
+				decls = append(decls, fd)
+				tests[fd] = strings.HasSuffix(names[i], "_test.go")
+			}
+		}
+	}
+
+	// Functions which already take a context, by the name of their context param
+	ctxParams := make(map[*ast.FuncDecl]string)
+	for _, fd := range decls {
+		if name, ok := contextParam(fd, info); ok {
+			ctxParams[fd] = name
+		}
+	}
+
+	// Find all functions to rewrite, until no more functions need to be rewritten
+	rewrite := make(map[types.Object]bool)
+	for changed := true; changed; {
+		changed = false
+		for _, fd := range decls {
+			obj := info.Defs[fd.Name]
+			if _, ok := ctxParams[fd]; ok || tests[fd] || rewrite[obj] {
+				continue
+			}
+			if usesBackgroundContext(fd.Body, info) || callsAny(fd.Body, info, rewrite) {
+				rewrite[obj] = true
+				changed = true
+			}
+		}
+	}
+
+	for _, fd := range decls {
+		name, ok := ctxParams[fd]
+		switch {
+		case ok:
+			passContext(fd.Body, contextIdent(name), false, info, rewrite)
+		case tests[fd]:
+			passContext(fd.Body, backgroundContext, false, info, rewrite)
+		case rewrite[info.Defs[fd.Name]]:
+			addContextParam(fd, "ctx", info)
+			passContext(fd.Body, contextIdent("ctx"), true, info, rewrite)
+		}
+	}
+
+	for i, f := range files {
+		var buf bytes.Buffer
+		if err := format.Node(&buf, fset, f); err != nil {
+			return err
+		}
+		if err := ioutil.WriteFile(names[i], buf.Bytes(), 0644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Returns the name of the first param of the function if it is a context.Context
+func contextParam(fd *ast.FuncDecl, info *types.Info) (string, bool) {
+	sig := info.Defs[fd.Name].Type().(*types.Signature)
+	if sig.Params().Len() == 0 || !isContextType(sig.Params().At(0).Type()) {
+		return "", false
+	}
+	return sig.Params().At(0).Name(), true
+}
+
+func isContextType(t types.Type) bool {
+	n, ok := t.(*types.Named)
+	return ok && n.Obj().Pkg() != nil && n.Obj().Pkg().Path() == "context" && n.Obj().Name() == "Context"
+}
+
+// Returns the function called by the call expression, if it is a declared function or method
+func calledFunc(call *ast.CallExpr, info *types.Info) types.Object {
+	var obj types.Object
+	switch fn := call.Fun.(type) {
+	case *ast.Ident:
+		obj = info.Uses[fn]
+	case *ast.SelectorExpr:
+		obj = info.Uses[fn.Sel]
+	}
+	// Use the generic function (or method) for calls of an instance of it
+	if fn, ok := obj.(*types.Func); ok {
+		return fn.Origin()
+	}
+	return obj
+}
+
+func isBackgroundContext(n ast.Node, info *types.Info) bool {
+	call, ok := n.(*ast.CallExpr)
+	if !ok {
+		return false
+	}
+	obj := calledFunc(call, info)
+	return obj != nil && obj.Pkg() != nil && obj.Pkg().Path() == "context" && obj.Name() == "Background"
+}
+
+func usesBackgroundContext(body *ast.BlockStmt, info *types.Info) bool {
+	found := false
+	ast.Inspect(body, func(n ast.Node) bool {
+		found = found || isBackgroundContext(n, info)
+		return !found
+	})
+	return found
+}
+
+func callsAny(body *ast.BlockStmt, info *types.Info, funcs map[types.Object]bool) bool {
+	found := false
+	ast.Inspect(body, func(n ast.Node) bool {
+		if call, ok := n.(*ast.CallExpr); ok && funcs[calledFunc(call, info)] {
+			found = true
+		}
+		return !found
+	})
+	return found
+}
+
+// Adds a context param with the given name as the first param of the function, and removes the
+// statement that declares a background context with the same name (if any), as the param replaces it
+func addContextParam(fd *ast.FuncDecl, name string, info *types.Info) {
+	param := &ast.Field{
+		Names: []*ast.Ident{ast.NewIdent(name)},
+		Type:  &ast.SelectorExpr{X: ast.NewIdent("context"), Sel: ast.NewIdent("Context")},
+	}
+	fd.Type.Params.List = append([]*ast.Field{param}, fd.Type.Params.List...)
+
+	for i, stmt := range fd.Body.List {
+		as, ok := stmt.(*ast.AssignStmt)
+		if !ok || as.Tok != token.DEFINE || len(as.Lhs) != 1 || len(as.Rhs) != 1 {
+			continue
+		}
+		if id, ok := as.Lhs[0].(*ast.Ident); ok && id.Name == name && isBackgroundContext(as.Rhs[0], info) {
+			// Move the opening brace to the removed statement, so its line is not printed as a blank line
+			if i == 0 {
+				fd.Body.Lbrace = stmt.Pos()
+			}
+			fd.Body.List = append(fd.Body.List[:i], fd.Body.List[i+1:]...)
+			return
+		}
+	}
+}
+
+// Returns a function creating the expression that refers to the context with the given name
+func contextIdent(name string) func() ast.Expr {
+	return func() ast.Expr {
+		return ast.NewIdent(name)
+	}
+}
+
+// Creates the expression that creates a background context
+func backgroundContext() ast.Expr {
+	return &ast.CallExpr{Fun: &ast.SelectorExpr{X: ast.NewIdent("context"), Sel: ast.NewIdent("Background")}}
+}
+
+// Passes the context created by ctx to all calls of rewritten functions. For rewritten functions (so not
+// for functions which already took a context) it is also used instead of any background context.
+func passContext(body *ast.BlockStmt, ctx func() ast.Expr, rewritten bool, info *types.Info, rewrite map[types.Object]bool) {
+	ast.Inspect(body, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+		if rewrite[calledFunc(call, info)] {
+			call.Args = append([]ast.Expr{ctx()}, call.Args...)
+		}
+		for i, arg := range call.Args {
+			if rewritten && isBackgroundContext(arg, info) {
+				call.Args[i] = ctx()
+			}
+		}
+		return true
+	})
+}
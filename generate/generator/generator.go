@@ -0,0 +1,6586 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package generator generates the cloudstack package from the output of the
+// listApis command, so the complete CloudStack API can be used in a typed way.
+package generator
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// APIInfo maps service names to the names of the APIs they contain
+type APIInfo map[string][]string
+
+const pkg = "cloudstack"
+
+// Config contains the options used to customize the generated code
+type Config struct {
+	// StampCommand records the originating command in every response type
+	StampCommand bool
+
+	// NoCustom omits the CustomService, which can be used to call arbitrary commands
+	NoCustom bool
+
+	// ValidatedIPSetters adds setters for IP and CIDR params that validate the value
+	ValidatedIPSetters bool
+
+	// OmitEmpty adds omitempty to the JSON tags of all response fields
+	OmitEmpty bool
+
+	// OptionalPointers uses pointer types for the boolean and numeric response fields, so a field the
+	// server omitted (nil) can be told apart from a field set to its zero value
+	OptionalPointers bool
+
+	// Facade adds CRUD style resource types for resources that map cleanly to CRUD commands
+	Facade bool
+
+	// MaxAPIsPerFile splits the code of services with more APIs over multiple files; 0 means no limit
+	MaxAPIsPerFile int
+}
+
+// Returns the code to get the value of the given optional response field expression, which only
+// differs from the expression itself when optional pointers are enabled
+func (cfg *Config) value(expr string) string {
+	if cfg.OptionalPointers {
+		return "valueOf(" + expr + ")"
+	}
+	return expr
+}
+
+// AllServices contains all services for which code will be generated
+type AllServices struct {
+	services services
+	warnings []string
+	cfg      *Config
+}
+
+type apiInfoNotFoundError struct {
+	api string
+}
+
+func (e *apiInfoNotFoundError) Error() string {
+	return fmt.Sprintf("Could not find API details for: %s", e.api)
+}
+
+type mergeConflictError struct {
+	api   string
+	field string
+	msg   string
+}
+
+func (e *mergeConflictError) Error() string {
+	return fmt.Sprintf("API %s has conflicting %s: %s", e.api, e.field, e.msg)
+}
+
+// Service contains the APIs for which code will be generated in a single file
+type Service struct {
+	name string
+	apis []*API
+	cfg  *Config
+
+	p  func(format string, args ...interface{}) // print raw
+	pn func(format string, args ...interface{}) // print with indent and newline
+}
+
+type services []*Service
+
+// Add functions for the Sort interface
+func (s services) Len() int {
+	return len(s)
+}
+
+func (s services) Less(i, j int) bool {
+	return s[i].name < s[j].name
+}
+
+func (s services) Swap(i, j int) {
+	s[i], s[j] = s[j], s[i]
+}
+
+// APIParams represents a list of API params
+type APIParams []*APIParam
+
+// Add functions for the Sort interface
+func (s APIParams) Len() int {
+	return len(s)
+}
+
+func (s APIParams) Less(i, j int) bool {
+	return s[i].Name < s[j].Name
+}
+
+func (s APIParams) Swap(i, j int) {
+	s[i], s[j] = s[j], s[i]
+}
+
+// API represents an API endpoint we can call
+type API struct {
+	Name        string       `json:"name"`
+	Description string       `json:"description"`
+	Isasync     bool         `json:"isasync"`
+	Params      APIParams    `json:"params"`
+	Response    APIResponses `json:"response"`
+}
+
+// APIParam represents a single API parameter
+type APIParam struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Type        string `json:"type"`
+	Required    bool   `json:"required"`
+
+	required int // Number of merged versions in which the param is required
+}
+
+// APIResponse represents a API response
+type APIResponse struct {
+	Name        string       `json:"name"`
+	Description string       `json:"description"`
+	Type        string       `json:"type"`
+	Response    APIResponses `json:"response"`
+}
+
+// APIResponses represents a list of API responses
+type APIResponses []*APIResponse
+
+// Add functions for the Sort interface
+func (s APIResponses) Len() int {
+	return len(s)
+}
+
+func (s APIResponses) Less(i, j int) bool {
+	return s[i].Name < s[j].Name
+}
+
+func (s APIResponses) Swap(i, j int) {
+	s[i], s[j] = s[j], s[i]
+}
+
+// Services returns all services sorted by name
+func (as *AllServices) Services() []*Service {
+	return as.services
+}
+
+// Warnings returns the issues found while grouping the APIs into services, which do not prevent
+// generating the code but might result in code that does not work as expected
+func (as *AllServices) Warnings() []string {
+	return as.warnings
+}
+
+// WriteGeneralCode writes the code shared by all services to outdir
+func (as *AllServices) WriteGeneralCode(outdir string) error {
+	code, err := as.GeneralCode()
+	if err != nil {
+		return err
+	}
+
+	file := path.Join(outdir, "cloudstack.go")
+	return ioutil.WriteFile(file, code, 0644)
+}
+
+// GeneralCode returns the formatted code shared by all services
+func (as *AllServices) GeneralCode() ([]byte, error) {
+	// Buffer the output in memory, for gofmt'ing later in the defer.
+	var buf bytes.Buffer
+	p := func(format string, args ...interface{}) {
+		_, err := fmt.Fprintf(&buf, format, args...)
+		if err != nil {
+			panic(err)
+		}
+	}
+	pn := func(format string, args ...interface{}) {
+		p(format+"\n", args...)
+	}
+	pn("//")
+	pn("// Copyright 2018, Sander van Harmelen")
+	pn("//")
+	pn("// Licensed under the Apache License, Version 2.0 (the \"License\");")
+	pn("// you may not use this file except in compliance with the License.")
+	pn("// You may obtain a copy of the License at")
+	pn("//")
+	pn("//     http://www.apache.org/licenses/LICENSE-2.0")
+	pn("//")
+	pn("// Unless required by applicable law or agreed to in writing, software")
+	pn("// distributed under the License is distributed on an \"AS IS\" BASIS,")
+	pn("// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.")
+	pn("// See the License for the specific language governing permissions and")
+	pn("// limitations under the License.")
+	pn("//")
+	pn("")
+	pn("package %s", pkg)
+	pn("")
+	pn("// UnlimitedResourceID is a special ID to define an unlimited resource")
+	pn("const UnlimitedResourceID = \"-1\"")
+	pn("")
+	pn("var idRegex = regexp.MustCompile(`^([0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}|-1)$`)")
+	pn("")
+	pn("// IsID return true if the passed ID is either a UUID or a UnlimitedResourceID")
+	pn("func IsID(id string) bool {")
+	pn("	return idRegex.MatchString(id)")
+	pn("}")
+	pn("")
+	pn("// OptionFunc can be passed to the courtesy helper functions and to all calls except the list calls, to set")
+	pn("// additional parameters. The default options of the client are applied before the options passed in.")
+	pn("type OptionFunc func(*CloudStackClient, interface{}) error")
+	pn("")
+	pn("// ClientOption can be passed to new client functions to set custom options")
+	pn("type ClientOption func(*CloudStackClient)")
+	pn("")
+	pn("type CSError struct {")
+	pn("	ErrorCode   int    `json:\"errorcode\"`")
+	pn("	CSErrorCode int    `json:\"cserrorcode\"`")
+	pn("	ErrorText   string `json:\"errortext\"`")
+	pn("}")
+	pn("")
+	pn("func (e *CSError) Error() error {")
+	pn("	return fmt.Errorf(\"CloudStack API error %%d (CSExceptionErrorCode: %%d): %%s\", e.ErrorCode, e.CSErrorCode, e.ErrorText)")
+	pn("}")
+	pn("")
+	pn("// IsInsufficientCapacity returns true if the error means there was not enough capacity to execute the")
+	pn("// command, which is reported using error code 533, both by the API and by failed async jobs")
+	pn("func IsInsufficientCapacity(err error) bool {")
+	pn("	var ae *APIError")
+	pn("	if errors.As(err, &ae) {")
+	pn("		return ae.ErrorCode == 533")
+	pn("	}")
+	pn("	var je *AsyncJobError")
+	pn("	return errors.As(err, &je) && je.ErrorCode == 533")
+	pn("}")
+	pn("")
+	pn("type CloudStackClient struct {")
+	pn("	HTTPGETOnly bool // If `true` only use HTTP GET calls")
+	pn("")
+	pn("	client  *http.Client // The http client for communicating")
+	pn("	baseURL string       // The base URL of the API")
+	pn("	apiKey  string       // Api key")
+	pn("	secret  string       // Secret key")
+	pn("	sessionKey string    // Session key of the session started using Login, used instead of signing")
+	pn("	credMu  sync.RWMutex // Protects the api and secret key, as these can be rotated, and the session key")
+	pn("	async   bool         // Wait for async calls to finish")
+	pn("	options []OptionFunc // A list of option functions to apply to all API calls")
+	pn("	optMu   sync.RWMutex // Protects the default options, as these can be changed while in use")
+	pn("	timeout int64        // Max waiting timeout in seconds for async jobs to finish; defaults to 300 seconds")
+	pn("	maxPolls int         // Max number of polls for async jobs to finish; defaults to no limit")
+	pn("	pollInterval   time.Duration // Initial interval between polls for async jobs, added to the interval after every poll")
+	pn("	pollBackoffMax time.Duration // Max interval between polls for async jobs")
+	pn("")
+	pn("	retryCodes map[int]bool // Error codes for which a failed command will be retried")
+	pn("	retryAll   bool         // Also retry commands that are not idempotent")
+	pn("")
+	pn("	beforeRequest   func(string, url.Values) error // Called with the params of every command before signing")
+	pn("	jobEventHandler func(JobLifecycleEvent)        // Called when the state or progress of a polled async job changes")
+	pn("	endpointResolver func(string, url.Values) string // Returns the base URL to use for a command")
+	pn("	paramTransform   func(string, url.Values) url.Values // Returns the params to send for a command")
+	pn("	requestLogger    func(*http.Request, *http.Response, []byte) // Called with the redacted request and the response of every command")
+	pn("")
+	pn("	strictResponse bool // Verify the response object belongs to the requested command")
+	pn("")
+	pn("	signatureVersion int           // The version of the signatures; only version 3 differs from the default")
+	pn("	signatureExpiry  time.Duration // The time version 3 signatures are valid after signing")
+	pn("	signatureHash    crypto.Hash   // The hash used to sign requests; 0 means SHA1")
+	pn("")
+	pn("	apiParamsMu sync.Mutex                 // Protects the cached params")
+	pn("	apiParams   map[string]map[string]bool // The params declared by the server by command, cached by ValidateParams")
+	pn("")
+	for _, s := range as.services {
+		pn("  %s *%s", strings.TrimSuffix(s.name, "Service"), s.name)
+	}
+	pn("}")
+	pn("")
+	pn("// Creates a new client for communicating with CloudStack")
+	pn("func newClient(apiurl string, apikey string, secret string, async bool, verifyssl bool, options ...ClientOption) *CloudStackClient {")
+	pn("	jar, _ := cookiejar.New(nil)")
+	pn("	cs := &CloudStackClient{")
+	pn("		client: &http.Client{")
+	pn("			Jar: jar,")
+	pn("			Transport: &http.Transport{")
+	pn("				Proxy:           http.ProxyFromEnvironment,")
+	pn("				TLSClientConfig: &tls.Config{InsecureSkipVerify: !verifyssl}, // If verifyssl is true, skipping the verify should be false and vice versa")
+	pn("			},")
+	pn("		Timeout: time.Duration(60 * time.Second),")
+	pn("		},")
+	pn("		baseURL: apiurl,")
+	pn("		apiKey:  apikey,")
+	pn("		secret:  secret,")
+	pn("		async:   async,")
+	pn("		options: []OptionFunc{},")
+	pn("		timeout: 300,")
+	pn("		pollInterval:   defaultAsyncPollInterval,")
+	pn("		pollBackoffMax: defaultAsyncBackoffMax,")
+	pn("	}")
+	for _, s := range as.services {
+		pn("	cs.%s = New%s(cs)", strings.TrimSuffix(s.name, "Service"), s.name)
+	}
+	pn("")
+	pn("	for _, fn := range options {")
+	pn("		fn(cs)")
+	pn("	}")
+	pn("")
+	pn("	return cs")
+	pn("}")
+	pn("")
+	pn("// Default non-async client. So for async calls you need to implement and check the async job result yourself. When using")
+	pn("// HTTPS with a self-signed certificate to connect to your CloudStack API, you would probably want to set 'verifyssl' to")
+	pn("// false so the call ignores the SSL errors/warnings.")
+	pn("func NewClient(apiurl string, apikey string, secret string, verifyssl bool, options ...ClientOption) *CloudStackClient {")
+	pn("	cs := newClient(apiurl, apikey, secret, false, verifyssl, options...)")
+	pn("	return cs")
+	pn("}")
+	pn("")
+	pn("// For sync API calls this client behaves exactly the same as a standard client call, but for async API calls")
+	pn("// this client will wait until the async job is finished or until the configured AsyncTimeout is reached. When the async")
+	pn("// job finishes successfully it will return actual object received from the API and nil, but when the timout is")
+	pn("// reached it will return the initial object containing the async job ID for the running job and a warning.")
+	pn("func NewAsyncClient(apiurl string, apikey string, secret string, verifyssl bool, options ...ClientOption) *CloudStackClient {")
+	pn("	cs := newClient(apiurl, apikey, secret, true, verifyssl, options...)")
+	pn("	return cs")
+	pn("}")
+	pn("")
+	pn("// The environment variables read by NewClientFromEnv. When more than one variable is given for a")
+	pn("// setting, the first one which is set takes precedence.")
+	pn("var (")
+	pn("	envAPIURL    = []string{\"CLOUDSTACK_API_URL\", \"CLOUDSTACK_ENDPOINT\"}")
+	pn("	envAPIKey    = []string{\"CLOUDSTACK_API_KEY\", \"CLOUDSTACK_KEY\"}")
+	pn("	envSecretKey = []string{\"CLOUDSTACK_SECRET_KEY\", \"CLOUDSTACK_SECRET\"}")
+	pn("	envVerifySSL = []string{\"CLOUDSTACK_VERIFY_SSL\"}")
+	pn(")")
+	pn("")
+	pn("// NewClientFromEnv returns a new (non-async) client configured using environment variables, as")
+	pn("// used by cloudmonkey and other tools of the CloudStack ecosystem:")
+	pn("//")
+	pn("//	CLOUDSTACK_API_URL (or CLOUDSTACK_ENDPOINT)       the URL of the API, required")
+	pn("//	CLOUDSTACK_API_KEY (or CLOUDSTACK_KEY)            the api key, required")
+	pn("//	CLOUDSTACK_SECRET_KEY (or CLOUDSTACK_SECRET)      the secret key, required")
+	pn("//	CLOUDSTACK_VERIFY_SSL                             whether to verify the SSL certificate, defaults to true")
+	pn("//")
+	pn("// An error is returned when a required variable is not set or a variable has an invalid value.")
+	pn("func NewClientFromEnv(options ...ClientOption) (*CloudStackClient, error) {")
+	pn("	var missing []string")
+	pn("	lookup := func(names []string) string {")
+	pn("		for _, name := range names {")
+	pn("			if v := os.Getenv(name); v != \"\" {")
+	pn("				return v")
+	pn("			}")
+	pn("		}")
+	pn("		missing = append(missing, names[0])")
+	pn("		return \"\"")
+	pn("	}")
+	pn("")
+	pn("	apiurl, apikey, secret := lookup(envAPIURL), lookup(envAPIKey), lookup(envSecretKey)")
+	pn("	if len(missing) > 0 {")
+	pn("		return nil, fmt.Errorf(\"Missing required environment variable(s): %%s\", strings.Join(missing, \", \"))")
+	pn("	}")
+	pn("")
+	pn("	verifyssl := true")
+	pn("	if v := os.Getenv(envVerifySSL[0]); v != \"\" {")
+	pn("		var err error")
+	pn("		if verifyssl, err = strconv.ParseBool(v); err != nil {")
+	pn("			return nil, fmt.Errorf(\"Invalid value %%q for environment variable %%s: %%v\", v, envVerifySSL[0], err)")
+	pn("		}")
+	pn("	}")
+	pn("")
+	pn("	return NewClient(apiurl, apikey, secret, verifyssl, options...), nil")
+	pn("}")
+	pn("// NewClientFromProfile returns a new (non-async) client configured using a profile of a cloudmonkey")
+	pn("// config file. When path is empty the default config file of cloudmonkey (~/.cmk/config) is used and")
+	pn("// when profile is empty the profile which is configured as the default profile in the file is used.")
+	pn("// The url, apikey and secretkey of the profile are required. Whether to verify the SSL certificate is")
+	pn("// read from verifycert (or verifysslcert as used by older versions of cloudmonkey), defaults to true.")
+	pn("func NewClientFromProfile(path string, profile string, options ...ClientOption) (*CloudStackClient, error) {")
+	pn("	if path == \"\" {")
+	pn("		home, err := os.UserHomeDir()")
+	pn("		if err != nil {")
+	pn("			return nil, err")
+	pn("		}")
+	pn("		path = filepath.Join(home, \".cmk\", \"config\")")
+	pn("	}")
+	pn("")
+	pn("	sections, err := parseINIFile(path)")
+	pn("	if err != nil {")
+	pn("		return nil, err")
+	pn("	}")
+	pn("")
+	pn("	if profile == \"\" {")
+	pn("		// The default profile is set in the global part of the file")
+	pn("		// or in the core section used by older versions of cloudmonkey")
+	pn("		for _, section := range []string{\"\", \"core\"} {")
+	pn("			if profile = sections[section][\"profile\"]; profile != \"\" {")
+	pn("				break")
+	pn("			}")
+	pn("		}")
+	pn("		if profile == \"\" {")
+	pn("			return nil, fmt.Errorf(\"No profile given and no default profile configured in %%s\", path)")
+	pn("		}")
+	pn("	}")
+	pn("")
+	pn("	values, found := sections[profile]")
+	pn("	if !found {")
+	pn("		return nil, fmt.Errorf(\"Profile %%s not found in %%s\", profile, path)")
+	pn("	}")
+	pn("")
+	pn("	var missing []string")
+	pn("	for _, key := range []string{\"url\", \"apikey\", \"secretkey\"} {")
+	pn("		if values[key] == \"\" {")
+	pn("			missing = append(missing, key)")
+	pn("		}")
+	pn("	}")
+	pn("	if len(missing) > 0 {")
+	pn("		return nil, fmt.Errorf(\"Missing required setting(s) in profile %%s of %%s: %%s\", profile, path, strings.Join(missing, \", \"))")
+	pn("	}")
+	pn("")
+	pn("	verifyssl := true")
+	pn("	for _, key := range []string{\"verifycert\", \"verifysslcert\"} {")
+	pn("		if v := values[key]; v != \"\" {")
+	pn("			if verifyssl, err = strconv.ParseBool(v); err != nil {")
+	pn("				return nil, fmt.Errorf(\"Invalid value %%q for %%s in profile %%s of %%s: %%v\", v, key, profile, path, err)")
+	pn("			}")
+	pn("			break")
+	pn("		}")
+	pn("	}")
+	pn("")
+	pn("	return NewClient(values[\"url\"], values[\"apikey\"], values[\"secretkey\"], verifyssl, options...), nil")
+	pn("}")
+	pn("")
+	pn("// Parses an INI style file into the key/value pairs by section name. Key/value pairs before the")
+	pn("// first section are returned for the section with an empty name. Keys are lowercased.")
+	pn("func parseINIFile(path string) (map[string]map[string]string, error) {")
+	pn("	f, err := os.Open(path)")
+	pn("	if err != nil {")
+	pn("		return nil, err")
+	pn("	}")
+	pn("	defer f.Close()")
+	pn("")
+	pn("	section := \"\"")
+	pn("	sections := map[string]map[string]string{section: {}}")
+	pn("")
+	pn("	scanner := bufio.NewScanner(f)")
+	pn("	for n := 1; scanner.Scan(); n++ {")
+	pn("		line := strings.TrimSpace(scanner.Text())")
+	pn("		if line == \"\" || strings.HasPrefix(line, \"#\") || strings.HasPrefix(line, \";\") {")
+	pn("			continue")
+	pn("		}")
+	pn("")
+	pn("		if strings.HasPrefix(line, \"[\") && strings.HasSuffix(line, \"]\") {")
+	pn("			section = strings.TrimSpace(line[1 : len(line)-1])")
+	pn("			if _, found := sections[section]; !found {")
+	pn("				sections[section] = make(map[string]string)")
+	pn("			}")
+	pn("			continue")
+	pn("		}")
+	pn("")
+	pn("		kv := strings.SplitN(line, \"=\", 2)")
+	pn("		if len(kv) != 2 {")
+	pn("			return nil, fmt.Errorf(\"Invalid line %%d in %%s: %%s\", n, path, line)")
+	pn("		}")
+	pn("		sections[section][strings.ToLower(strings.TrimSpace(kv[0]))] = strings.TrimSpace(kv[1])")
+	pn("	}")
+	pn("	if err := scanner.Err(); err != nil {")
+	pn("		return nil, err")
+	pn("	}")
+	pn("")
+	pn("	return sections, nil")
+	pn("}")
+	pn("// When using the async client an api call will wait for the async call to finish before returning. The default is to poll for 300 seconds")
+	pn("// seconds, to check if the async job is finished.")
+	pn("func (cs *CloudStackClient) AsyncTimeout(timeoutInSeconds int64) {")
+	pn("	cs.timeout = timeoutInSeconds")
+	pn("}")
+	pn("")
+	pn("// WithRetryableErrorCodes makes the client retry commands that fail with one of the given error")
+	pn("// codes, which can be either the HTTP error code or the CloudStack exception error code. Failed")
+	pn("// commands are retried up to 3 times using an exponential backoff. By default only idempotent")
+	pn("// commands (list, get and query commands) are retried, see WithRetryNonIdempotentCommands. When 429")
+	pn("// is one of the codes, rate limited commands are always retried, waiting for the duration of the")
+	pn("// Retry-After header (if any) instead of using the backoff, as these commands were not executed.")
+	pn("func WithRetryableErrorCodes(codes ...int) ClientOption {")
+	pn("	return func(cs *CloudStackClient) {")
+	pn("		cs.retryCodes = make(map[int]bool, len(codes))")
+	pn("		for _, code := range codes {")
+	pn("			cs.retryCodes[code] = true")
+	pn("		}")
+	pn("	}")
+	pn("}")
+	pn("")
+	pn("// WithRetryNonIdempotentCommands makes the client also retry commands that are not idempotent")
+	pn("// when they fail with one of the codes configured using WithRetryableErrorCodes. Only use this")
+	pn("// when you are sure the configured error codes mean the command did not change anything.")
+	pn("func WithRetryNonIdempotentCommands() ClientOption {")
+	pn("	return func(cs *CloudStackClient) {")
+	pn("		cs.retryAll = true")
+	pn("	}")
+	pn("}")
+	pn("")
+	pn("// WithBeforeRequest sets a hook that is called for every command after all params are")
+	pn("// assembled, but before the request is signed. The hook can modify the params as needed")
+	pn("// and when it returns an error, the command is aborted and the error is returned.")
+	pn("func WithBeforeRequest(fn func(command string, params url.Values) error) ClientOption {")
+	pn("	return func(cs *CloudStackClient) {")
+	pn("		cs.beforeRequest = fn")
+	pn("	}")
+	pn("}")
+	pn("")
+	pn("// WithStrictResponseMatching makes the client verify that the response object of every command")
+	pn("// is the one of the requested command (e.g. deployvirtualmachineresponse for deployVirtualMachine)")
+	pn("// before unwrapping it, and return an error otherwise. This catches responses mixed up by")
+	pn("// misconfigured proxies or caches, which would otherwise silently decode into empty results.")
+	pn("// The responses of commands unknown to this package (like custom commands) are not verified.")
+	pn("func WithStrictResponseMatching() ClientOption {")
+	pn("	return func(cs *CloudStackClient) {")
+	pn("		cs.strictResponse = true")
+	pn("	}")
+	pn("}")
+	pn("")
+	pn("// WithJobEventHandler sets a handler that is called by GetAsyncJobResult (and so by every async command")
+	pn("// of an async client) when it observes a change of the state or progress of the polled job. This makes")
+	pn("// it possible to observe all async jobs of the client in one place. The handler is called synchronously")
+	pn("// from the polling goroutine, so it should return quickly.")
+	pn("func WithJobEventHandler(fn func(JobLifecycleEvent)) ClientOption {")
+	pn("	return func(cs *CloudStackClient) {")
+	pn("		cs.jobEventHandler = fn")
+	pn("	}")
+	pn("}")
+	pn("")
+	pn("// WithEndpointResolver sets a func that is called for every command with its signed params, and returns")
+	pn("// the base URL to send the command to. This can be used to route commands to different (for example")
+	pn("// regional) endpoints sharing the same keys, based on the command or its params. When the func returns")
+	pn("// an empty string, the base URL of the client is used.")
+	pn("func WithEndpointResolver(fn func(command string, params url.Values) string) ClientOption {")
+	pn("	return func(cs *CloudStackClient) {")
+	pn("		cs.endpointResolver = fn")
+	pn("	}")
+	pn("}")
+	pn("")
+	pn("// The time version 3 signatures are valid after signing, unless configured otherwise")
+	pn("const defaultSignatureExpiry = 10 * time.Minute")
+	pn("")
+	pn("// WithSignatureVersion sets the version of the signatures of the requests. When set to 3, the signed")
+	pn("// params include signatureversion=3 and an expires timestamp, after which CloudStack rejects the request")
+	pn("// (see WithSignatureExpiry). Any other version uses the default signatures, which do not expire.")
+	pn("func WithSignatureVersion(version int) ClientOption {")
+	pn("	return func(cs *CloudStackClient) {")
+	pn("		cs.signatureVersion = version")
+	pn("	}")
+	pn("}")
+	pn("")
+	pn("// WithSignatureExpiry makes the signatures of the requests expire after the given duration (10 minutes if")
+	pn("// the duration is not positive), which is useful when signed requests are passed on to other systems (see")
+	pn("// PrepareRequest). As only version 3 signatures can expire, this also sets the signature version to 3.")
+	pn("func WithSignatureExpiry(d time.Duration) ClientOption {")
+	pn("	return func(cs *CloudStackClient) {")
+	pn("		cs.signatureVersion = 3")
+	pn("		cs.signatureExpiry = d")
+	pn("	}")
+	pn("}")
+	pn("")
+	pn("// WithSignatureHash sets the hash used to compute the HMAC signatures of the requests, for deployments")
+	pn("// that require SHA256 signatures. Only crypto.SHA1 (the default) and crypto.SHA256 are supported; any other")
+	pn("// hash is a programming error, so it panics right away instead of failing every request later on.")
+	pn("func WithSignatureHash(h crypto.Hash) ClientOption {")
+	pn("	if _, err := signatureHashFunc(h); err != nil {")
+	pn("		panic(err)")
+	pn("	}")
+	pn("	return func(cs *CloudStackClient) {")
+	pn("		cs.signatureHash = h")
+	pn("	}")
+	pn("}")
+	pn("")
+	pn("// WithQueryParamTransform sets a func that is called with the params of every command before the common")
+	pn("// params (like the api key) are added and the request is signed, and returns the params to send instead.")
+	pn("// This can be used to adapt the params to CloudStack compatible APIs that renamed params or expect extra")
+	pn("// params, without regenerating this package. The func is given a copy of the params, which it can modify")
+	pn("// and return. Any before request hook is called after the transform.")
+	pn("func WithQueryParamTransform(fn func(command string, in url.Values) url.Values) ClientOption {")
+	pn("	return func(cs *CloudStackClient) {")
+	pn("		cs.paramTransform = fn")
+	pn("	}")
+	pn("}")
+	pn("")
+	pn("// WithRequestLogger sets a func that is called for every request sent for a command, after the response")
+	pn("// is read, which can be used to log the requests and responses. The request is a copy in which the")
+	pn("// credentials (like the signature and the api key) are redacted, and the body is the raw JSON body of")
+	pn("// the response, as the body of the response itself is already read. Failed requests that are retried")
+	pn("// are passed to the func as well, but requests that fail without a response are not. The streamed")
+	pn("// responses of Query are not passed to the func either.")
+	pn("func WithRequestLogger(fn func(req *http.Request, resp *http.Response, body []byte)) ClientOption {")
+	pn("	return func(cs *CloudStackClient) {")
+	pn("		cs.requestLogger = fn")
+	pn("	}")
+	pn("}")
+	pn("")
+	pn("// WithMaxAsyncPolls limits the number of times the result of an async job is polled, in")
+	pn("// addition to the configured timeout. When the limit is reached before the job is finished,")
+	pn("// an AsyncMaxPollsErr is returned. A limit of 0 (the default) means no limit.")
+	pn("func WithMaxAsyncPolls(n int) ClientOption {")
+	pn("	return func(cs *CloudStackClient) {")
+	pn("		cs.maxPolls = n")
+	pn("	}")
+	pn("}")
+	pn("")
+	pn("// The initial interval between polls for async jobs and the max interval, unless configured otherwise")
+	pn("const (")
+	pn("	defaultAsyncPollInterval = 1 * time.Second")
+	pn("	defaultAsyncBackoffMax   = 15 * time.Second")
+	pn(")")
+	pn("")
+	pn("// WithAsyncPollInterval sets the interval between the first polls for the result of an async job. After")
+	pn("// every poll the interval is increased by this interval, up to the max set using WithAsyncBackoffMax.")
+	pn("// Environments in which jobs finish fast can use a shorter interval, to return results sooner. The")
+	pn("// default is 1 second, and a duration of 0 (or less) restores the default.")
+	pn("func WithAsyncPollInterval(d time.Duration) ClientOption {")
+	pn("	return func(cs *CloudStackClient) {")
+	pn("		if d <= 0 {")
+	pn("			d = defaultAsyncPollInterval")
+	pn("		}")
+	pn("		cs.pollInterval = d")
+	pn("	}")
+	pn("}")
+	pn("")
+	pn("// WithAsyncBackoffMax sets the max interval between polls for the result of an async job. The default")
+	pn("// is 15 seconds, and a duration of 0 (or less) restores the default.")
+	pn("func WithAsyncBackoffMax(d time.Duration) ClientOption {")
+	pn("	return func(cs *CloudStackClient) {")
+	pn("		if d <= 0 {")
+	pn("			d = defaultAsyncBackoffMax")
+	pn("		}")
+	pn("		cs.pollBackoffMax = d")
+	pn("	}")
+	pn("}")
+	pn("")
+	pn("// WithTLSMinVersion sets the minimum TLS version (e.g. tls.VersionTLS12) used to connect to the API.")
+	pn("// This only applies to the default HTTP transport of the client, so it does nothing when the client")
+	pn("// uses a custom transport.")
+	pn("func WithTLSMinVersion(version uint16) ClientOption {")
+	pn("	return func(cs *CloudStackClient) {")
+	pn("		if c := cs.tlsConfig(); c != nil {")
+	pn("			c.MinVersion = version")
+	pn("		}")
+	pn("	}")
+	pn("}")
+	pn("")
+	pn("// WithTLSCipherSuites sets the cipher suites (e.g. tls.TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256) used to")
+	pn("// connect to the API, which only affects TLS 1.2 and earlier as the TLS 1.3 cipher suites are not")
+	pn("// configurable. This only applies to the default HTTP transport of the client, so it does nothing")
+	pn("// when the client uses a custom transport.")
+	pn("func WithTLSCipherSuites(suites []uint16) ClientOption {")
+	pn("	return func(cs *CloudStackClient) {")
+	pn("		if c := cs.tlsConfig(); c != nil {")
+	pn("			c.CipherSuites = append([]uint16(nil), suites...)")
+	pn("		}")
+	pn("	}")
+	pn("}")
+	pn("")
+	pn("// Returns the TLS config of the default HTTP transport, or nil when the client uses a custom transport")
+	pn("func (cs *CloudStackClient) tlsConfig() *tls.Config {")
+	pn("	t, ok := cs.client.Transport.(*http.Transport)")
+	pn("	if !ok {")
+	pn("		return nil")
+	pn("	}")
+	pn("	if t.TLSClientConfig == nil {")
+	pn("		t.TLSClientConfig = &tls.Config{}")
+	pn("	}")
+	pn("	return t.TLSClientConfig")
+	pn("}")
+	pn("")
+	pn("// Set any default options that would be added to all API calls that support it. This is safe to")
+	pn("// call while the client is in use by other goroutines, which keep using the options they started with.")
+	pn("func (cs *CloudStackClient) DefaultOptions(options ...OptionFunc) {")
+	pn("	cs.optMu.Lock()")
+	pn("	defer cs.optMu.Unlock()")
+	pn("")
+	pn("	// Copy the options, so the caller cannot modify them in place while in use")
+	pn("	cs.options = append([]OptionFunc{}, options...)")
+	pn("}")
+	pn("")
+	pn("// Returns the default options followed by the given options. The returned slice is always a")
+	pn("// new slice, so it never shares its backing array with the default options.")
+	pn("func (cs *CloudStackClient) withDefaultOptions(opts []OptionFunc) []OptionFunc {")
+	pn("	cs.optMu.RLock()")
+	pn("	defer cs.optMu.RUnlock()")
+	pn("")
+	pn("	return append(cs.options[:len(cs.options):len(cs.options)], opts...)")
+	pn("}")
+	pn("")
+	pn("// ClientConfig is a snapshot of the configuration of a client. It never contains the api or secret key.")
+	pn("type ClientConfig struct {")
+	pn("	BaseURL                    string        // The base URL of the API, with any user info redacted")
+	pn("	HTTPGETOnly                bool          // Only use HTTP GET calls")
+	pn("	Async                      bool          // Wait for async calls to finish")
+	pn("	AsyncTimeout               time.Duration // Max time to wait for async jobs to finish")
+	pn("	MaxAsyncPolls              int           // Max number of polls for async jobs to finish; 0 means no limit")
+	pn("	AsyncPollInterval          time.Duration // Initial interval between polls for async jobs")
+	pn("	AsyncBackoffMax            time.Duration // Max interval between polls for async jobs")
+	pn("	DefaultOptions             int           // The number of default options applied to all API calls")
+	pn("	RetryableErrorCodes        []int         // Error codes for which a failed command will be retried (sorted)")
+	pn("	RetryNonIdempotentCommands bool          // Also retry commands that are not idempotent")
+	pn("	StrictResponseMatching     bool          // Verify the response object belongs to the requested command")
+	pn("	BeforeRequestHook          bool          // A before request hook is set")
+	pn("	JobEventHandler            bool          // A job event handler is set")
+	pn("	EndpointResolver           bool          // An endpoint resolver is set")
+	pn("	QueryParamTransform        bool          // A query param transform is set")
+	pn("	RequestLogger              bool          // A request logger is set")
+	pn("	SignatureVersion           int           // The version of the signatures (1 or 3)")
+	pn("	SignatureExpiry            time.Duration // The time version 3 signatures are valid after signing")
+	pn("	SignatureHash              crypto.Hash   // The hash used to sign requests")
+	pn("}")
+	pn("")
+	pn("// Config returns a snapshot of the current configuration of the client, which can be used to check how")
+	pn("// the client is configured when debugging or wrapping it.")
+	pn("func (cs *CloudStackClient) Config() ClientConfig {")
+	pn("	baseURL := cs.baseURL")
+	pn("	if u, err := url.Parse(cs.baseURL); err == nil {")
+	pn("		baseURL = u.Redacted()")
+	pn("	}")
+	pn("")
+	pn("	codes := make([]int, 0, len(cs.retryCodes))")
+	pn("	for code := range cs.retryCodes {")
+	pn("		codes = append(codes, code)")
+	pn("	}")
+	pn("	sort.Ints(codes)")
+	pn("")
+	pn("	cs.optMu.RLock()")
+	pn("	options := len(cs.options)")
+	pn("	cs.optMu.RUnlock()")
+	pn("")
+	pn("	sigVersion, sigExpiry := 1, time.Duration(0)")
+	pn("	if cs.signatureVersion == 3 {")
+	pn("		sigVersion, sigExpiry = 3, cs.signatureExpiry")
+	pn("		if sigExpiry <= 0 {")
+	pn("			sigExpiry = defaultSignatureExpiry")
+	pn("		}")
+	pn("	}")
+	pn("")
+	pn("	sigHash := cs.signatureHash")
+	pn("	if sigHash == 0 {")
+	pn("		sigHash = crypto.SHA1")
+	pn("	}")
+	pn("")
+	pn("	return ClientConfig{")
+	pn("		BaseURL:                    baseURL,")
+	pn("		HTTPGETOnly:                cs.HTTPGETOnly,")
+	pn("		Async:                      cs.async,")
+	pn("		AsyncTimeout:               time.Duration(cs.timeout) * time.Second,")
+	pn("		MaxAsyncPolls:              cs.maxPolls,")
+	pn("		AsyncPollInterval:          cs.pollInterval,")
+	pn("		AsyncBackoffMax:            cs.pollBackoffMax,")
+	pn("		DefaultOptions:             options,")
+	pn("		RetryableErrorCodes:        codes,")
+	pn("		RetryNonIdempotentCommands: cs.retryAll,")
+	pn("		StrictResponseMatching:     cs.strictResponse,")
+	pn("		BeforeRequestHook:          cs.beforeRequest != nil,")
+	pn("		JobEventHandler:            cs.jobEventHandler != nil,")
+	pn("		EndpointResolver:           cs.endpointResolver != nil,")
+	pn("		QueryParamTransform:        cs.paramTransform != nil,")
+	pn("		RequestLogger:              cs.requestLogger != nil,")
+	pn("		SignatureVersion:           sigVersion,")
+	pn("		SignatureExpiry:            sigExpiry,")
+	pn("		SignatureHash:              sigHash,")
+	pn("	}")
+	pn("}")
+	pn("")
+	pn("// ToURLValuer is implemented by the params types of all commands")
+	pn("type ToURLValuer interface {")
+	pn("	toURLValues() url.Values")
+	pn("}")
+	pn("")
+	pn("// ValidateParams verifies that the server declares all params set in p for the command, using the")
+	pn("// listApis command of the server. This catches params which are not supported by the version of the")
+	pn("// server (which may differ from the version this package is generated for) before sending the command.")
+	pn("// The declared params are cached, so the server is only queried once for every command.")
+	pn("func (cs *CloudStackClient) ValidateParams(command string, p ToURLValuer) error {")
+	pn("	declared, err := cs.declaredParams(command)")
+	pn("	if err != nil {")
+	pn("		return err")
+	pn("	}")
+	pn("")
+	pn("	var unknown []string")
+	pn("	seen := make(map[string]bool)")
+	pn("	for k := range p.toURLValues() {")
+	pn("		// Map params are sent as name[i].key, so only check (and report) the name once")
+	pn("		if i := strings.Index(k, \"[\"); i >= 0 {")
+	pn("			k = k[:i]")
+	pn("		}")
+	pn("		if !declared[strings.ToLower(k)] && !seen[k] {")
+	pn("			unknown = append(unknown, k)")
+	pn("		}")
+	pn("		seen[k] = true")
+	pn("	}")
+	pn("	if len(unknown) > 0 {")
+	pn("		sort.Strings(unknown)")
+	pn("		return fmt.Errorf(\"The server does not support these params of %%s: %%s\", command, strings.Join(unknown, \", \"))")
+	pn("	}")
+	pn("	return nil")
+	pn("}")
+	pn("")
+	pn("// Returns the (lower cased) names of the params the server declares for the command")
+	pn("func (cs *CloudStackClient) declaredParams(command string) (map[string]bool, error) {")
+	pn("	cs.apiParamsMu.Lock()")
+	pn("	defer cs.apiParamsMu.Unlock()")
+	pn("")
+	pn("	if declared, ok := cs.apiParams[command]; ok {")
+	pn("		return declared, nil")
+	pn("	}")
+	pn("")
+	pn("	p := cs.APIDiscovery.NewListApisParams()")
+	pn("	p.SetName(command)")
+	pn("")
+	pn("	l, err := cs.APIDiscovery.ListApis(p)")
+	pn("	if err != nil {")
+	pn("		return nil, err")
+	pn("	}")
+	pn("")
+	pn("	for _, a := range l.Apis {")
+	pn("		if !strings.EqualFold(a.Name, command) {")
+	pn("			continue")
+	pn("		}")
+	pn("")
+	pn("		declared := make(map[string]bool, len(a.Params))")
+	pn("		for _, ap := range a.Params {")
+	pn("			declared[strings.ToLower(ap.Name)] = true")
+	pn("		}")
+	pn("		if cs.apiParams == nil {")
+	pn("			cs.apiParams = make(map[string]map[string]bool)")
+	pn("		}")
+	pn("		cs.apiParams[command] = declared")
+	pn("")
+	pn("		return declared, nil")
+	pn("	}")
+	pn("	return nil, fmt.Errorf(\"The server does not support the command %%s\", command)")
+	pn("}")
+	pn("")
+	pn("// PrepareRequest builds and signs the request for the command with the given params, exactly as the")
+	pn("// client would send it, but without sending it. This can be used to send the request later or from")
+	pn("// elsewhere, for example after it has been approved. It returns the HTTP method and the URL to use,")
+	pn("// and for POST requests the body to send form encoded (the signed params are part of the URL of GET")
+	pn("// requests, so their body is nil).")
+	pn("func (cs *CloudStackClient) PrepareRequest(command string, p ToURLValuer) (string, string, url.Values, error) {")
+	pn("	params := cs.commandParams(command, p.toURLValues())")
+	pn("	s, signature, err := cs.signParams(command, params)")
+	pn("	if err != nil {")
+	pn("		return \"\", \"\", nil, err")
+	pn("	}")
+	pn("	baseURL := cs.endpoint(command, params)")
+	pn("")
+	pn("	if !cs.usePOST(command) {")
+	pn("		return \"GET\", baseURL + \"?\" + signedQuery(s, signature), nil, nil")
+	pn("	}")
+	pn("	if signature != \"\" {")
+	pn("		params.Set(\"signature\", signature)")
+	pn("	}")
+	pn("	return \"POST\", baseURL, params, nil")
+	pn("}")
+	pn("")
+	pn("// Login starts a session for the user with the given username and password, using the login API, after")
+	pn("// which all requests are authenticated using the session key and cookie of the session instead of being")
+	pn("// signed using the api and secret key. This allows using the client for users without api keys. The")
+	pn("// domain is the path of the domain of the user (like \"ROOT/customers\"), or an empty string for users of")
+	pn("// the ROOT domain.")
+	pn("func (cs *CloudStackClient) Login(username string, password string, domain string) (*LoginResponse, error) {")
+	pn("	p := cs.Authentication.NewLoginParams(password, username)")
+	pn("	if domain != \"\" {")
+	pn("		p.SetDomain(domain)")
+	pn("	}")
+	pn("	r, err := cs.Authentication.Login(p)")
+	pn("	if err != nil {")
+	pn("		return nil, err")
+	pn("	}")
+	pn("	cs.setSessionKey(r.Sessionkey)")
+	pn("	return r, nil")
+	pn("}")
+	pn("")
+	pn("// Logout ends the session started using Login, using the logout API, and clears the cookies of the")
+	pn("// client. Following requests are signed using the api and secret key again. The session is ended even")
+	pn("// if the logout API returns an error. Logout should not be called while other requests are in progress.")
+	pn("func (cs *CloudStackClient) Logout() error {")
+	pn("	_, err := cs.Authentication.Logout(cs.Authentication.NewLogoutParams())")
+	pn("	cs.setSessionKey(\"\")")
+	pn("	if cs.client.Jar != nil {")
+	pn("		jar, _ := cookiejar.New(nil)")
+	pn("		cs.client.Jar = jar")
+	pn("	}")
+	pn("	return err")
+	pn("}")
+	pn("var AsyncTimeoutErr = errors.New(\"Timeout while waiting for async job to finish\")")
+	pn("")
+	pn("// AsyncMaxPollsErr is returned when an async job is not finished within the number of polls")
+	pn("// configured using WithMaxAsyncPolls")
+	pn("var AsyncMaxPollsErr = errors.New(\"Max number of polls reached while waiting for async job to finish\")")
+	pn("")
+	pn("// ErrNotFound is returned (wrapped) by the courtesy helper functions when no match is found")
+	pn("var ErrNotFound = errors.New(\"No match found\")")
+	pn("")
+	pn("// AsyncJobError is returned when an async job failed. It contains the error details of the failed job, so")
+	pn("// callers can check the error code of the failure using errors.As.")
+	pn("type AsyncJobError struct {")
+	pn("	JobID       string")
+	pn("	ErrorCode   int")
+	pn("	CSErrorCode int")
+	pn("	ErrorText   string")
+	pn("	Result      json.RawMessage // The raw result of the failed job")
+	pn("}")
+	pn("")
+	pn("func (e *AsyncJobError) Error() string {")
+	pn("	if e.ErrorText == \"\" {")
+	pn("		return fmt.Sprintf(\"Undefined error: %%s\", string(e.Result))")
+	pn("	}")
+	pn("	return e.ErrorText")
+	pn("}")
+	pn("")
+	pn("// Returns the error of the failed job, with the error details decoded from the result of the job, which")
+	pn("// is either the error text or an object containing the error code and text")
+	pn("func newAsyncJobError(jobid string, r *QueryAsyncJobResultResponse) *AsyncJobError {")
+	pn("	e := &AsyncJobError{JobID: jobid, Result: r.Jobresult}")
+	pn("	if text, ok := r.ResultText(); ok {")
+	pn("		e.ErrorText = text")
+	pn("	} else if cse, err := decodeCSError(r.Jobresult); err == nil {")
+	pn("		e.ErrorCode, e.CSErrorCode, e.ErrorText = cse.ErrorCode, cse.CSErrorCode, cse.ErrorText")
+	pn("	}")
+	pn("	return e")
+	pn("}")
+	pn("")
+	pn("// JobState is the state of an async job. CloudStack does not distinguish between queued and running")
+	pn("// jobs, both are pending.")
+	pn("type JobState string")
+	pn("")
+	pn("const (")
+	pn("	JobPending   JobState = \"pending\"")
+	pn("	JobSucceeded JobState = \"succeeded\"")
+	pn("	JobFailed    JobState = \"failed\"")
+	pn(")")
+	pn("")
+	pn("// JobLifecycleEvent describes a change of the state or progress of an async job, as observed while polling it")
+	pn("type JobLifecycleEvent struct {")
+	pn("	JobID    string")
+	pn("	Command  string   // The command class of the job, e.g. org.apache.cloudstack.api.command.user.vm.DeployVMCmd")
+	pn("	State    JobState")
+	pn("	Progress int      // The processing status (jobprocstatus) of the job")
+	pn("	Err      error    // The error of a failed job")
+	pn("}")
+	pn("")
+	pn("// Calls the job event handler (if any) when the state or progress of the job changed since the last event")
+	pn("func (cs *CloudStackClient) emitJobEvent(last *JobLifecycleEvent, jobid string, r *QueryAsyncJobResultResponse, err error) {")
+	pn("	if cs.jobEventHandler == nil {")
+	pn("		return")
+	pn("	}")
+	pn("")
+	pn("	state := JobPending")
+	pn("	switch %s {", as.cfg.value("r.Jobstatus"))
+	pn("	case 1:")
+	pn("		state = JobSucceeded")
+	pn("	case 2:")
+	pn("		state = JobFailed")
+	pn("	}")
+	pn("	if last.State == state && last.Progress == %s {", as.cfg.value("r.Jobprocstatus"))
+	pn("		return")
+	pn("	}")
+	pn("")
+	pn("	*last = JobLifecycleEvent{JobID: jobid, Command: r.Cmd, State: state, Progress: %s, Err: err}", as.cfg.value("r.Jobprocstatus"))
+	pn("	cs.jobEventHandler(*last)")
+	pn("}")
+	pn("")
+	pn("// A helper function that you can use to get the result of a running async job. If the job is not finished within the configured")
+	pn("// timeout, the async job returns a AsyncTimeoutErr. If the job is not finished within the configured max number of polls,")
+	pn("// the async job returns a AsyncMaxPollsErr.")
+	pn("func (cs *CloudStackClient) GetAsyncJobResult(jobid string, timeout int64) (json.RawMessage, error) {")
+	pn("	return cs.GetAsyncJobResultWithContext(context.Background(), jobid, timeout)")
+	pn("}")
+	pn("")
+	pn("// GetAsyncJobResultWithContext is the same as GetAsyncJobResult, but stops polling and returns the error of")
+	pn("// the context as soon as the context is done")
+	pn("func (cs *CloudStackClient) GetAsyncJobResultWithContext(ctx context.Context, jobid string, timeout int64) (json.RawMessage, error) {")
+	pn("	var interval time.Duration")
+	pn("	var last JobLifecycleEvent")
+	pn("	currentTime := time.Now().Unix()")
+	pn("")
+	pn("	for polls := 1; ; polls++ {")
+	pn("		p := cs.Asyncjob.NewQueryAsyncJobResultParams(jobid)")
+	pn("		r, err := cs.Asyncjob.QueryAsyncJobResultWithContext(ctx, p)")
+	pn("		if err != nil {")
+	pn("			return nil, err")
+	pn("		}")
+	pn("")
+	pn("		// Status 1 means the job is finished successfully")
+	pn("		if %s == 1 {", as.cfg.value("r.Jobstatus"))
+	pn("			cs.emitJobEvent(&last, jobid, r, nil)")
+	pn("			return r.Jobresult, nil")
+	pn("		}")
+	pn("")
+	pn("		// When the status is 2, the job has failed")
+	pn("		if %s == 2 {", as.cfg.value("r.Jobstatus"))
+	pn("			err := newAsyncJobError(jobid, r)")
+	pn("			cs.emitJobEvent(&last, jobid, r, err)")
+	pn("			return nil, err")
+	pn("		}")
+	pn("")
+	pn("		cs.emitJobEvent(&last, jobid, r, nil)")
+	pn("")
+	pn("		if time.Now().Unix()-currentTime > timeout {")
+	pn("			return nil, AsyncTimeoutErr")
+	pn("		}")
+	pn("")
+	pn("		if cs.maxPolls > 0 && polls >= cs.maxPolls {")
+	pn("			return nil, AsyncMaxPollsErr")
+	pn("		}")
+	pn("")
+	pn("		// Add an (extremely simple) exponential backoff like feature to prevent")
+	pn("		// flooding the CloudStack API")
+	pn("		if interval < cs.pollBackoffMax {")
+	pn("			interval += cs.pollInterval")
+	pn("			if interval > cs.pollBackoffMax {")
+	pn("				interval = cs.pollBackoffMax")
+	pn("			}")
+	pn("		}")
+	pn("")
+	pn("		select {")
+	pn("		case <-ctx.Done():")
+	pn("			return nil, ctx.Err()")
+	pn("		case <-time.After(interval):")
+	pn("		}")
+	pn("	}")
+	pn("}")
+	pn("")
+	pn("// Execute the request against a CS API. Will return the raw JSON data returned by the API and nil if")
+	pn("// no error occured. If the API returns an error the result will be nil and the HTTP error code and CS")
+	pn("// error details. If a processing (code) error occurs the result will be nil and the generated error")
+	pn("func (cs *CloudStackClient) newRequest(api string, params url.Values) (json.RawMessage, error) {")
+	pn("	return cs.newRequestWithContext(context.Background(), api, responseKeys[api], params)")
+	pn("}")
+	pn("")
+	pn("// Same as newRequest, but the request (including any retries) is cancelled when the context is done.")
+	pn("// The key is the key of the object the response is wrapped in, or an empty string if it is unknown.")
+	pn("func (cs *CloudStackClient) newRequestWithContext(ctx context.Context, api string, key string, params url.Values) (json.RawMessage, error) {")
+	pn("	params = cs.commandParams(api, params)")
+	pn("")
+	pn("	s, signature, err := cs.signParams(api, params)")
+	pn("	if err != nil {")
+	pn("		return nil, err")
+	pn("	}")
+	pn("	baseURL := cs.endpoint(api, params)")
+	pn("")
+	pn("	for retry := 0; ; retry++ {")
+	pn("		// Backoff exponentially, starting with half a second")
+	pn("		wait := (1 << uint(retry)) * 500 * time.Millisecond")
+	pn("")
+	pn("		b, e, err := cs.doRequest(ctx, baseURL, api, key, s, signature)")
+	pn("		if err != nil {")
+	pn("			// A rate limited command was not executed, so it can always be retried")
+	pn("			rl, ok := err.(*RateLimitError)")
+	pn("			if !ok || retry == 3 || !cs.retryCodes[http.StatusTooManyRequests] {")
+	pn("				return nil, err")
+	pn("			}")
+	pn("			if rl.RetryAfter > 0 {")
+	pn("				wait = rl.RetryAfter")
+	pn("			}")
+	pn("		} else {")
+	pn("			if e == nil {")
+	pn("				return b, nil")
+	pn("			}")
+	pn("			if retry == 3 || !cs.isRetryable(api, e) {")
+	pn("				return nil, e")
+	pn("			}")
+	pn("		}")
+	pn("")
+	pn("		select {")
+	pn("		case <-ctx.Done():")
+	pn("			return nil, ctx.Err()")
+	pn("		case <-time.After(wait):")
+	pn("		}")
+	pn("	}")
+	pn("}")
+	pn("")
+	pn("// Returns a copy of the params of the command, so the ones passed in are not modified, transformed by the")
+	pn("// query param transform (if any)")
+	pn("func (cs *CloudStackClient) commandParams(api string, params url.Values) url.Values {")
+	pn("	ps := url.Values{}")
+	pn("	for k, v := range params {")
+	pn("		ps[k] = append([]string(nil), v...)")
+	pn("	}")
+	pn("	if cs.paramTransform != nil {")
+	pn("		if ps = cs.paramTransform(api, ps); ps == nil {")
+	pn("			ps = url.Values{}")
+	pn("		}")
+	pn("	}")
+	pn("	return ps")
+	pn("}")
+	pn("")
+	pn("// Adds the common params to the params of the command and signs them. Will return the encoded")
+	pn("// params and the signature, or an error if the before request hook returned an error. While logged")
+	pn("// in using Login, the session key is added instead and the signature is empty. The login command")
+	pn("// itself is never signed, as it authenticates using the username and password.")
+	pn("func (cs *CloudStackClient) signParams(api string, params url.Values) (string, string, error) {")
+	pn("	cs.credMu.RLock()")
+	pn("	apiKey, secret, sessionKey := cs.apiKey, cs.secret, cs.sessionKey")
+	pn("	cs.credMu.RUnlock()")
+	pn("")
+	pn("	sign := api != \"login\" && sessionKey == \"\"")
+	pn("	switch {")
+	pn("	case sign:")
+	pn("		params.Set(\"apiKey\", apiKey)")
+	pn("	case api != \"login\":")
+	pn("		params.Set(\"sessionkey\", sessionKey)")
+	pn("	}")
+	pn("	params.Set(\"command\", api)")
+	pn("	params.Set(\"response\", \"json\")")
+	pn("")
+	pn("	// Version 3 signatures expire, so the signed request cannot be (re)used after the expiry")
+	pn("	if sign && cs.signatureVersion == 3 {")
+	pn("		expiry := cs.signatureExpiry")
+	pn("		if expiry <= 0 {")
+	pn("			expiry = defaultSignatureExpiry")
+	pn("		}")
+	pn("		params.Set(\"signatureversion\", \"3\")")
+	pn("		params.Set(\"expires\", time.Now().Add(expiry).UTC().Format(\"2006-01-02T15:04:05-0700\"))")
+	pn("	}")
+	pn("")
+	pn("	if cs.beforeRequest != nil {")
+	pn("		if err := cs.beforeRequest(api, params); err != nil {")
+	pn("			return \"\", \"\", err")
+	pn("		}")
+	pn("	}")
+	pn("")
+	pn("	if !sign {")
+	pn("		return encodeValues(params), \"\", nil")
+	pn("	}")
+	pn("")
+	pn("	// Generate signature for API call")
+	pn("	// * Serialize parameters, URL encoding only values and sort them by key, done by encodeValues")
+	pn("	// * Convert the entire argument string to lowercase")
+	pn("	// * Replace all instances of '+' to '%%20'")
+	pn("	// * Calculate HMAC SHA1 (or the configured hash) of argument string with CloudStack secret")
+	pn("	// * URL encode the string and convert to base64")
+	pn("	newHash, err := signatureHashFunc(cs.signatureHash)")
+	pn("	if err != nil {")
+	pn("		return \"\", \"\", err")
+	pn("	}")
+	pn("	s := encodeValues(params)")
+	pn("	s2 := strings.ToLower(s)")
+	pn("	s3 := strings.Replace(s2, \"+\", \"%%20\", -1)")
+	pn("	mac := hmac.New(newHash, []byte(secret))")
+	pn("	mac.Write([]byte(s3))")
+	pn("	signature := base64.StdEncoding.EncodeToString(mac.Sum(nil))")
+	pn("")
+	pn("	return s, signature, nil")
+	pn("}")
+	pn("")
+	pn("// Returns the constructor of the given signature hash, where 0 means the default SHA1 hash")
+	pn("func signatureHashFunc(h crypto.Hash) (func() hash.Hash, error) {")
+	pn("	switch h {")
+	pn("	case 0, crypto.SHA1:")
+	pn("		return sha1.New, nil")
+	pn("	case crypto.SHA256:")
+	pn("		return sha256.New, nil")
+	pn("	}")
+	pn("	return nil, fmt.Errorf(\"Unsupported signature hash: %%v (only SHA1 and SHA256 are supported)\", h)")
+	pn("}")
+	pn("")
+	pn("// Returns the base URL to use for the command, which is the configured base URL unless an endpoint")
+	pn("// resolver is set that returns another one")
+	pn("func (cs *CloudStackClient) endpoint(api string, params url.Values) string {")
+	pn("	if cs.endpointResolver != nil {")
+	pn("		if u := cs.endpointResolver(api, params); u != \"\" {")
+	pn("			return u")
+	pn("		}")
+	pn("	}")
+	pn("	return cs.baseURL")
+	pn("}")
+	pn("")
+	pn("// Replaces the api and secret key used to sign all following requests")
+	pn("func (cs *CloudStackClient) setCredentials(apiKey string, secret string) {")
+	pn("	cs.credMu.Lock()")
+	pn("	defer cs.credMu.Unlock()")
+	pn("	cs.apiKey, cs.secret = apiKey, secret")
+	pn("}")
+	pn("")
+	pn("// Sets the session key used to authenticate all following requests instead of signing them, where an")
+	pn("// empty key ends the session")
+	pn("func (cs *CloudStackClient) setSessionKey(key string) {")
+	pn("	cs.credMu.Lock()")
+	pn("	defer cs.credMu.Unlock()")
+	pn("	cs.sessionKey = key")
+	pn("}")
+	pn("")
+	pn("// Returns the encoded params with the signature appended, unless the params were not signed")
+	pn("func signedQuery(s string, signature string) string {")
+	pn("	if signature == \"\" {")
+	pn("		return s")
+	pn("	}")
+	pn("	return s + \"&signature=\" + url.QueryEscape(signature)")
+	pn("}")
+	pn("")
+	pn("// Creates the HTTP request to the base URL for the signed params of the command")
+	pn("func (cs *CloudStackClient) buildRequest(ctx context.Context, baseURL string, api string, s string, signature string) (*http.Request, error) {")
+	pn("	if cs.usePOST(api) {")
+	pn("		// Build the body from the signed string, so the params are sent encoded exactly")
+	pn("		// as they were signed, instead of re-encoding them (with different escaping)")
+	pn("		body := signedQuery(s, signature)")
+	pn("")
+	pn("		// Create a POST request")
+	pn("		req, err := http.NewRequestWithContext(ctx, \"POST\", baseURL, strings.NewReader(body))")
+	pn("		if err != nil {")
+	pn("			return nil, err")
+	pn("		}")
+	pn("		req.Header.Set(\"Content-Type\", \"application/x-www-form-urlencoded\")")
+	pn("		return req, nil")
+	pn("	}")
+	pn("")
+	pn("	// Create the final URL before we issue the request")
+	pn("	url := baseURL + \"?\" + signedQuery(s, signature)")
+	pn("")
+	pn("	// Create a GET request")
+	pn("	return http.NewRequestWithContext(ctx, \"GET\", url, nil)")
+	pn("}")
+	pn("")
+	pn("// The params that are redacted in the requests passed to the request logger")
+	pn("var redactedParams = []string{\"apiKey\", \"password\", \"sessionkey\", \"signature\"}")
+	pn("")
+	pn("// Returns a copy of the request for the signed params, with the values of the params that contain")
+	pn("// credentials redacted. The params are part of the URL of GET requests and of the body of POST requests.")
+	pn("func redactRequest(req *http.Request, s string, signature string) *http.Request {")
+	pn("	r := req.Clone(req.Context())")
+	pn("")
+	pn("	params, err := url.ParseQuery(signedQuery(s, signature))")
+	pn("	if err != nil {")
+	pn("		params = url.Values{}")
+	pn("	}")
+	pn("	for _, k := range redactedParams {")
+	pn("		if params.Get(k) != \"\" {")
+	pn("			params.Set(k, \"xxxxx\")")
+	pn("		}")
+	pn("	}")
+	pn("	encoded := params.Encode()")
+	pn("")
+	pn("	if r.Method != \"POST\" {")
+	pn("		r.URL.RawQuery = encoded")
+	pn("		return r")
+	pn("	}")
+	pn("	r.Body = ioutil.NopCloser(strings.NewReader(encoded))")
+	pn("	r.ContentLength = int64(len(encoded))")
+	pn("	r.GetBody = func() (io.ReadCloser, error) {")
+	pn("		return ioutil.NopCloser(strings.NewReader(encoded)), nil")
+	pn("	}")
+	pn("	return r")
+	pn("}")
+	pn("")
+	pn("// Returns true if the command should be sent using a POST request. The deployVirtualMachine API")
+	pn("// should be called using a POST call so we don't have to worry about the userdata size.")
+	pn("func (cs *CloudStackClient) usePOST(api string) bool {")
+	pn("	return !cs.HTTPGETOnly && (api == \"deployVirtualMachine\" || api == \"login\" || api == \"updateVirtualMachine\")")
+	pn("}")
+	pn("")
+	pn("// Issue a single signed request. Will return the raw JSON data returned by the API if no error occured,")
+	pn("// or the CS error details if the API returned an error.")
+	pn("func (cs *CloudStackClient) doRequest(ctx context.Context, baseURL string, api string, key string, s string, signature string) (json.RawMessage, *APIError, error) {")
+	pn("	req, err := cs.buildRequest(ctx, baseURL, api, s, signature)")
+	pn("	if err != nil {")
+	pn("		return nil, nil, err")
+	pn("	}")
+	pn("")
+	pn("	resp, err := cs.client.Do(req)")
+	pn("	if err != nil {")
+	pn("		return nil, nil, err")
+	pn("	}")
+	pn("	defer resp.Body.Close()")
+	pn("")
+	pn("	b, err := ioutil.ReadAll(resp.Body)")
+	pn("	if err != nil {")
+	pn("		return nil, nil, err")
+	pn("	}")
+	pn("")
+	pn("	if cs.requestLogger != nil {")
+	pn("		cs.requestLogger(redactRequest(req, s, signature), resp, b)")
+	pn("	}")
+	pn("")
+	pn("	if resp.StatusCode == http.StatusTooManyRequests {")
+	pn("		return nil, nil, newRateLimitError(resp, b)")
+	pn("	}")
+	pn("")
+	pn("	if resp.StatusCode != 200 {")
+	pn("		return nil, newAPIError(resp.StatusCode, b), nil")
+	pn("	}")
+	pn("")
+	pn("	if cs.strictResponse && key != \"\" {")
+	pn("		if err := checkResponseKey(api, key, b); err != nil {")
+	pn("			return nil, nil, err")
+	pn("		}")
+	pn("	}")
+	pn("")
+	pn("	// Need to get the raw value to make the result play nice, which is the value of the response")
+	pn("	// object with the key of the command (when known), even if the response contains other keys")
+	pn("	b, err = getRawValueByKey(b, key)")
+	pn("	if err != nil {")
+	pn("		return nil, nil, err")
+	pn("	}")
+	pn("	return b, nil, nil")
+	pn("}")
+	pn("")
+	pn("// RateLimitError is returned when the server (or a gateway in front of it) rate limited the command,")
+	pn("// which means the command was not executed. RetryAfter is the duration the server asked to wait before")
+	pn("// sending another command, or 0 if it did not say. Rate limited commands are retried (after waiting this")
+	pn("// duration) when 429 is one of the error codes configured using WithRetryableErrorCodes.")
+	pn("type RateLimitError struct {")
+	pn("	RetryAfter time.Duration")
+	pn("	ErrorText  string")
+	pn("}")
+	pn("")
+	pn("func (e *RateLimitError) Error() string {")
+	pn("	msg := \"Rate limited by the server\"")
+	pn("	if e.RetryAfter > 0 {")
+	pn("		msg += fmt.Sprintf(\", retry after %%s\", e.RetryAfter)")
+	pn("	}")
+	pn("	if e.ErrorText != \"\" {")
+	pn("		msg += \": \" + e.ErrorText")
+	pn("	}")
+	pn("	return msg")
+	pn("}")
+	pn("")
+	pn("// Creates the rate limit error for a 429 response with the given body, which may contain the CS error")
+	pn("// details when the rate limit was applied by CloudStack itself")
+	pn("func newRateLimitError(resp *http.Response, b []byte) *RateLimitError {")
+	pn("	e := &RateLimitError{RetryAfter: parseRetryAfter(resp.Header.Get(\"Retry-After\"), time.Now())}")
+	pn("	if raw, err := getRawValue(b); err == nil {")
+	pn("		if cse, err := decodeCSError(raw); err == nil {")
+	pn("			e.ErrorText = cse.ErrorText")
+	pn("		}")
+	pn("	}")
+	pn("	return e")
+	pn("}")
+	pn("")
+	pn("// Parses the value of a Retry-After header, which is either a number of seconds or an HTTP date.")
+	pn("// Returns 0 if the value is empty, invalid or already in the past.")
+	pn("func parseRetryAfter(v string, now time.Time) time.Duration {")
+	pn("	v = strings.TrimSpace(v)")
+	pn("	if v == \"\" {")
+	pn("		return 0")
+	pn("	}")
+	pn("	if secs, err := strconv.Atoi(v); err == nil {")
+	pn("		if secs < 0 {")
+	pn("			return 0")
+	pn("		}")
+	pn("		return time.Duration(secs) * time.Second")
+	pn("	}")
+	pn("	t, err := http.ParseTime(v)")
+	pn("	if err != nil || !t.After(now) {")
+	pn("		return 0")
+	pn("	}")
+	pn("	return t.Sub(now)")
+	pn("}")
+	pn("")
+	pn("// Verifies the raw response is wrapped in the response object with the given key, to detect")
+	pn("// responses for other commands served by misconfigured proxies or caches")
+	pn("func checkResponseKey(api string, key string, b json.RawMessage) error {")
+	pn("	var m map[string]json.RawMessage")
+	pn("	if err := json.Unmarshal(b, &m); err != nil {")
+	pn("		return err")
+	pn("	}")
+	pn("	if _, ok := m[key]; ok {")
+	pn("		return nil")
+	pn("	}")
+	pn("")
+	pn("	keys := make([]string, 0, len(m))")
+	pn("	for k := range m {")
+	pn("		keys = append(keys, k)")
+	pn("	}")
+	pn("	sort.Strings(keys)")
+	pn("	return fmt.Errorf(\"Expected the response of %%s to contain %%s, but got: %%s\", api, key, strings.Join(keys, \", \"))")
+	pn("}")
+	pn("")
+	pn("// Decodes the CS error details from the raw value of an error response")
+	pn("func decodeCSError(b json.RawMessage) (*CSError, error) {")
+	pn("	var e CSError")
+	pn("	if err := json.Unmarshal(b, &e); err != nil {")
+	pn("		return nil, err")
+	pn("	}")
+	pn("	return &e, nil")
+	pn("}")
+	pn("")
+	pn("// APIError is returned when the API responds with an error. Next to the details of the CloudStack error")
+	pn("// it contains the HTTP status code and the raw response body, so callers can use errors.As to inspect")
+	pn("// the error, for example to handle specific error codes.")
+	pn("type APIError struct {")
+	pn("	HTTPStatusCode int")
+	pn("	ErrorCode      int")
+	pn("	CSErrorCode    int")
+	pn("	ErrorText      string")
+	pn("	Raw            json.RawMessage")
+	pn("}")
+	pn("")
+	pn("func (e *APIError) Error() string {")
+	pn("	if e.ErrorCode == 0 && e.CSErrorCode == 0 {")
+	pn("		return fmt.Sprintf(\"CloudStack API error (HTTP status %%d): %%s\", e.HTTPStatusCode, e.ErrorText)")
+	pn("	}")
+	pn("	return fmt.Sprintf(\"CloudStack API error %%d (CSExceptionErrorCode: %%d): %%s\", e.ErrorCode, e.CSErrorCode, e.ErrorText)")
+	pn("}")
+	pn("")
+	pn("// Creates the API error for an error response with the given HTTP status code and body. When the body")
+	pn("// does not contain the CloudStack error details (for example the HTML error page of a proxy), the error")
+	pn("// text describes why the body could not be decoded instead.")
+	pn("func newAPIError(status int, body []byte) *APIError {")
+	pn("	e := &APIError{HTTPStatusCode: status, Raw: body}")
+	pn("")
+	pn("	b, err := getRawValue(body)")
+	pn("	if err != nil {")
+	pn("		e.ErrorText = fmt.Sprintf(\"Unable to decode the error response: %%v\", err)")
+	pn("		return e")
+	pn("	}")
+	pn("	cse, err := decodeCSError(b)")
+	pn("	if err != nil {")
+	pn("		e.ErrorText = fmt.Sprintf(\"Unable to decode the error response: %%v\", err)")
+	pn("		return e")
+	pn("	}")
+	pn("	e.ErrorCode, e.CSErrorCode, e.ErrorText = cse.ErrorCode, cse.CSErrorCode, cse.ErrorText")
+	pn("	return e")
+	pn("}")
+	pn("")
+	pn("// Returns true if the command should be retried after failing with the given error")
+	pn("func (cs *CloudStackClient) isRetryable(api string, e *APIError) bool {")
+	pn("	if !cs.retryCodes[e.ErrorCode] && !cs.retryCodes[e.CSErrorCode] {")
+	pn("		return false")
+	pn("	}")
+	pn("	return cs.retryAll || strings.HasPrefix(api, \"list\") || strings.HasPrefix(api, \"get\") || strings.HasPrefix(api, \"query\")")
+	pn("}")
+	pn("")
+	pn("// Query issues a signed request for any command and returns a decoder positioned at the value of the")
+	pn("// response object, so (large) responses can be decoded without buffering them in memory first. This")
+	pn("// can be used for commands that are not (yet) supported by this package. The returned close function")
+	pn("// must be called when done decoding, to close the response body.")
+	pn("func (cs *CloudStackClient) Query(ctx context.Context, command string, params url.Values) (*json.Decoder, func() error, error) {")
+	pn("	ps := cs.commandParams(command, params)")
+	pn("")
+	pn("	s, signature, err := cs.signParams(command, ps)")
+	pn("	if err != nil {")
+	pn("		return nil, nil, err")
+	pn("	}")
+	pn("")
+	pn("	req, err := cs.buildRequest(ctx, cs.endpoint(command, ps), command, s, signature)")
+	pn("	if err != nil {")
+	pn("		return nil, nil, err")
+	pn("	}")
+	pn("")
+	pn("	resp, err := cs.client.Do(req)")
+	pn("	if err != nil {")
+	pn("		return nil, nil, err")
+	pn("	}")
+	pn("")
+	pn("	if resp.StatusCode != 200 {")
+	pn("		defer resp.Body.Close()")
+	pn("")
+	pn("		b, err := ioutil.ReadAll(resp.Body)")
+	pn("		if err != nil {")
+	pn("			return nil, nil, err")
+	pn("		}")
+	pn("")
+	pn("		if resp.StatusCode == http.StatusTooManyRequests {")
+	pn("			return nil, nil, newRateLimitError(resp, b)")
+	pn("		}")
+	pn("")
+	pn("		return nil, nil, newAPIError(resp.StatusCode, b)")
+	pn("	}")
+	pn("")
+	pn("	// Skip the opening brace and the key of the response object")
+	pn("	// to position the decoder at the value of the response object")
+	pn("	dec := json.NewDecoder(resp.Body)")
+	pn("	if t, err := dec.Token(); err != nil || t != json.Delim('{') {")
+	pn("		resp.Body.Close()")
+	pn("		return nil, nil, fmt.Errorf(\"Unable to extract the raw value from the response of %%s\", command)")
+	pn("	}")
+	pn("	t, err := dec.Token()")
+	pn("	if err != nil || t == json.Delim('}') {")
+	pn("		resp.Body.Close()")
+	pn("		return nil, nil, fmt.Errorf(\"Unable to extract the raw value from the response of %%s\", command)")
+	pn("	}")
+	pn("	// Skip the values of any other keys, to position the decoder at the value of the response object")
+	pn("	if key, ok := responseKeys[command]; ok {")
+	pn("		for t != key {")
+	pn("			var skip json.RawMessage")
+	pn("			if err := dec.Decode(&skip); err != nil {")
+	pn("				resp.Body.Close()")
+	pn("				return nil, nil, err")
+	pn("			}")
+	pn("			if t, err = dec.Token(); err != nil || t == json.Delim('}') {")
+	pn("				resp.Body.Close()")
+	pn("				return nil, nil, fmt.Errorf(\"Expected the response of %%s to contain %%s\", command, key)")
+	pn("			}")
+	pn("		}")
+	pn("	}")
+	pn("")
+	pn("	return dec, resp.Body.Close, nil")
+	pn("}")
+	pn("// Custom version of net/url Encode that only URL escapes values")
+	pn("// Unmodified portions here remain under BSD license of The Go Authors: https://go.googlesource.com/go/+/master/LICENSE")
+	pn("func encodeValues(v url.Values) string {")
+	pn("	if v == nil {")
+	pn("		return \"\"")
+	pn("	}")
+	pn("	var buf bytes.Buffer")
+	pn("	keys := make([]string, 0, len(v))")
+	pn("	for k := range v {")
+	pn("		keys = append(keys, k)")
+	pn("	}")
+	pn("	sort.Strings(keys)")
+	pn("	for _, k := range keys {")
+	pn("		vs := v[k]")
+	pn("		prefix := k + \"=\"")
+	pn("		for _, v := range vs {")
+	pn("			if buf.Len() > 0 {")
+	pn("				buf.WriteByte('&')")
+	pn("			}")
+	pn("			buf.WriteString(prefix)")
+	pn("			buf.WriteString(url.QueryEscape(v))")
+	pn("		}")
+	pn("	}")
+	pn("	return buf.String()")
+	pn("}")
+	pn("")
+	pn("// Returns the keys of the map in sorted order, so map params are always encoded the same way")
+	pn("func getSortedKeysFromMap(m map[string]string) []string {")
+	pn("	keys := make([]string, 0, len(m))")
+	pn("	for k := range m {")
+	pn("		keys = append(keys, k)")
+	pn("	}")
+	pn("	sort.Strings(keys)")
+	pn("	return keys")
+	pn("}")
+	pn("")
+	pn("// Returns an error if more than one of the given mutually exclusive params is set")
+	pn("func checkMutuallyExclusive(p map[string]interface{}, params ...string) error {")
+	pn("	var set []string")
+	pn("	for _, param := range params {")
+	pn("		if _, found := p[param]; found {")
+	pn("			set = append(set, param)")
+	pn("		}")
+	pn("	}")
+	pn("	if len(set) > 1 {")
+	pn("		return fmt.Errorf(\"Params %%s are mutually exclusive, only one of them can be set\", strings.Join(set, \", \"))")
+	pn("	}")
+	pn("	return nil")
+	pn("}")
+	pn("")
+	pn("// A simple cache with a TTL, used to memoize lookups of resources which rarely change. A TTL of")
+	pn("// 0 means the cached values never expire.")
+	pn("type lookupCache struct {")
+	pn("	sync.Mutex")
+	pn("	ttl     time.Duration")
+	pn("	entries map[string]lookupCacheEntry")
+	pn("}")
+	pn("")
+	pn("type lookupCacheEntry struct {")
+	pn("	value   interface{}")
+	pn("	expires time.Time")
+	pn("}")
+	pn("")
+	pn("func newLookupCache(ttl time.Duration) *lookupCache {")
+	pn("	return &lookupCache{ttl: ttl, entries: make(map[string]lookupCacheEntry)}")
+	pn("}")
+	pn("")
+	pn("func (c *lookupCache) get(key string) (interface{}, bool) {")
+	pn("	c.Lock()")
+	pn("	defer c.Unlock()")
+	pn("")
+	pn("	e, found := c.entries[key]")
+	pn("	if !found || (c.ttl > 0 && time.Now().After(e.expires)) {")
+	pn("		return nil, false")
+	pn("	}")
+	pn("	return e.value, true")
+	pn("}")
+	pn("")
+	pn("func (c *lookupCache) set(key string, v interface{}) {")
+	pn("	c.Lock()")
+	pn("	defer c.Unlock()")
+	pn("")
+	pn("	c.entries[key] = lookupCacheEntry{value: v, expires: time.Now().Add(c.ttl)}")
+	pn("}")
+	pn("")
+	pn("func (c *lookupCache) setTTL(ttl time.Duration) {")
+	pn("	c.Lock()")
+	pn("	defer c.Unlock()")
+	pn("")
+	pn("	c.ttl = ttl")
+	pn("}")
+	pn("")
+	pn("func (c *lookupCache) invalidate() {")
+	pn("	c.Lock()")
+	pn("	defer c.Unlock()")
+	pn("")
+	pn("	c.entries = make(map[string]lookupCacheEntry)")
+	pn("}")
+	pn("")
+	pn("// Splits a comma separated string into its trimmed, non-empty values")
+	pn("func splitCSV(s string) []string {")
+	pn("	var vs []string")
+	pn("	for _, v := range strings.Split(s, \",\") {")
+	pn("		if v = strings.TrimSpace(v); v != \"\" {")
+	pn("			vs = append(vs, v)")
+	pn("		}")
+	pn("	}")
+	pn("	return vs")
+	pn("}")
+	pn("")
+	pn("// Generic function to get the raw value wrapped in a response as json.RawMessage. If the response")
+	pn("// is not wrapped in an object with a single key, the response itself is returned as is.")
+	pn("func getRawValue(b json.RawMessage) (json.RawMessage, error) {")
+	pn("	var m map[string]json.RawMessage")
+	pn("	if err := json.Unmarshal(b, &m); err != nil {")
+	pn("		return nil, err")
+	pn("	}")
+	pn("	if len(m) > 1 {")
+	pn("		return b, nil")
+	pn("	}")
+	pn("	for _, v := range m {")
+	pn("		// A wrapped value is always an object, so anything else means it is not wrapped")
+	pn("		if v = bytes.TrimSpace(v); len(v) == 0 || v[0] != '{' {")
+	pn("			return b, nil")
+	pn("		}")
+	pn("		return v, nil")
+	pn("	}")
+	pn("	return nil, fmt.Errorf(\"Unable to extract the raw value from:\\n\\n%%s\\n\\n\", string(b))")
+	pn("}")
+	pn("")
+	pn("// Same as getRawValue, but extracts the value of the given key instead of the first value, so")
+	pn("// responses which contain other keys next to the expected object are unwrapped deterministically.")
+	pn("// If the response does not contain the key, this falls back to getRawValue.")
+	pn("func getRawValueByKey(b json.RawMessage, key string) (json.RawMessage, error) {")
+	pn("	var m map[string]json.RawMessage")
+	pn("	if err := json.Unmarshal(b, &m); err != nil {")
+	pn("		return nil, err")
+	pn("	}")
+	pn("	if v, ok := m[key]; ok {")
+	pn("		return v, nil")
+	pn("	}")
+	pn("	return getRawValue(b)")
+	pn("}")
+	pn("")
+	pn("// Same as getRawValue, but returns the response as is when it contains any of the given fields, so")
+	pn("// results with a single field are not mistaken for a wrapper when they are returned unwrapped.")
+	pn("func getRawValueUnlessHas(b json.RawMessage, fields ...string) (json.RawMessage, error) {")
+	pn("	var m map[string]json.RawMessage")
+	pn("	if err := json.Unmarshal(b, &m); err != nil {")
+	pn("		return nil, err")
+	pn("	}")
+	pn("	for _, f := range fields {")
+	pn("		if _, ok := m[f]; ok {")
+	pn("			return b, nil")
+	pn("		}")
+	pn("	}")
+	pn("	return getRawValue(b)")
+	pn("}")
+	pn("")
+	pn("// KeyValuePair is a single key/value pair of a map param, used to set the pairs in a specific order")
+	pn("type KeyValuePair struct {")
+	pn("	Key   string")
+	pn("	Value string")
+	pn("}")
+	pn("")
+	pn("// StringMap is used for response fields of the map type. It decodes tolerantly, as CloudStack")
+	pn("// does not always return these maps with string values: non-string values are converted to their")
+	pn("// JSON text, and null or an empty string or list results in an empty map.")
+	pn("type StringMap map[string]string")
+	pn("")
+	pn("// UnmarshalJSON implements the json.Unmarshaler interface")
+	pn("func (m *StringMap) UnmarshalJSON(b []byte) error {")
+	pn("	b = bytes.TrimSpace(b)")
+	pn("	if len(b) == 0 || b[0] != '{' {")
+	pn("		var v interface{}")
+	pn("		if err := json.Unmarshal(b, &v); err != nil {")
+	pn("			return err")
+	pn("		}")
+	pn("		switch v := v.(type) {")
+	pn("		case nil:")
+	pn("			*m = nil")
+	pn("			return nil")
+	pn("		case string:")
+	pn("			if v == \"\" {")
+	pn("				*m = nil")
+	pn("				return nil")
+	pn("			}")
+	pn("		case []interface{}:")
+	pn("			if len(v) == 0 {")
+	pn("				*m = nil")
+	pn("				return nil")
+	pn("			}")
+	pn("		}")
+	pn("		return fmt.Errorf(\"Unable to decode %%s into a map\", string(b))")
+	pn("	}")
+	pn("")
+	pn("	var raw map[string]json.RawMessage")
+	pn("	if err := json.Unmarshal(b, &raw); err != nil {")
+	pn("		return err")
+	pn("	}")
+	pn("")
+	pn("	r := make(StringMap, len(raw))")
+	pn("	for k, v := range raw {")
+	pn("		var s string")
+	pn("		if err := json.Unmarshal(v, &s); err != nil {")
+	pn("			// Not a string, so use the JSON text of the value")
+	pn("			s = string(bytes.TrimSpace(v))")
+	pn("		}")
+	pn("		r[k] = s")
+	pn("	}")
+	pn("	*m = r")
+	pn("	return nil")
+	pn("}")
+	pn("// BulkResult is the result of a helper that executes a command for multiple resources (or zones)")
+	pn("// at once. Succeeded contains the results of all commands that succeeded and Failed contains the")
+	pn("// error of every command that failed, keyed by the ID of the resource (or zone) it failed for.")
+	pn("type BulkResult[T any] struct {")
+	pn("	Succeeded []T")
+	pn("	Failed    map[string]error")
+	pn("}")
+	pn("")
+	pn("// Adds the error of a failed command for the given ID")
+	pn("func (r *BulkResult[T]) fail(id string, err error) {")
+	pn("	if r.Failed == nil {")
+	pn("		r.Failed = make(map[string]error)")
+	pn("	}")
+	pn("	r.Failed[id] = err")
+	pn("}")
+	pn("")
+	pn("// Err returns all failures joined into a single error (sorted by ID), or nil if nothing failed")
+	pn("func (r *BulkResult[T]) Err() error {")
+	pn("	ids := make([]string, 0, len(r.Failed))")
+	pn("	for id := range r.Failed {")
+	pn("		ids = append(ids, id)")
+	pn("	}")
+	pn("	sort.Strings(ids)")
+	pn("")
+	pn("	errs := make([]error, 0, len(ids))")
+	pn("	for _, id := range ids {")
+	pn("		errs = append(errs, fmt.Errorf(\"%%s: %%w\", id, r.Failed[id]))")
+	pn("	}")
+	pn("	return errors.Join(errs...)")
+	pn("}")
+	pn("")
+	if as.cfg.OptionalPointers {
+		pn("// Returns the value of an optional response field, or the zero value if the field was omitted")
+		pn("func valueOf[T any](v *T) T {")
+		pn("	if v == nil {")
+		pn("		var zero T")
+		pn("		return zero")
+		pn("	}")
+		pn("	return *v")
+		pn("}")
+		pn("")
+	}
+	pn("// Downloads the given URL to destPath using the HTTP client of the client. The download is written")
+	pn("// to a temporary file next to destPath first, which is only renamed to destPath once the download")
+	pn("// is complete and verified, so destPath never contains a partial download.")
+	pn("func (cs *CloudStackClient) downloadToFile(rawURL, destPath string) error {")
+	pn("	resp, err := cs.client.Get(rawURL)")
+	pn("	if err != nil {")
+	pn("		return err")
+	pn("	}")
+	pn("	defer resp.Body.Close()")
+	pn("")
+	pn("	if resp.StatusCode != http.StatusOK {")
+	pn("		return fmt.Errorf(\"Failed to download %%s: %%s\", rawURL, resp.Status)")
+	pn("	}")
+	pn("")
+	pn("	f, err := os.CreateTemp(filepath.Dir(destPath), filepath.Base(destPath)+\".*.part\")")
+	pn("	if err != nil {")
+	pn("		return err")
+	pn("	}")
+	pn("	// Does nothing when the download is complete, as the file is renamed by then")
+	pn("	defer os.Remove(f.Name())")
+	pn("")
+	pn("	n, err := io.Copy(f, resp.Body)")
+	pn("	if cerr := f.Close(); err == nil {")
+	pn("		err = cerr")
+	pn("	}")
+	pn("	if err != nil {")
+	pn("		return fmt.Errorf(\"Failed to download %%s: %%w\", rawURL, err)")
+	pn("	}")
+	pn("	if resp.ContentLength >= 0 && n != resp.ContentLength {")
+	pn("		return fmt.Errorf(\"Failed to download %%s: got %%d bytes, expected %%d\", rawURL, n, resp.ContentLength)")
+	pn("	}")
+	pn("")
+	pn("	return os.Rename(f.Name(), destPath)")
+	pn("}")
+	pn("// Calls fn for every index in [0, n) using at most the given number of concurrent goroutines")
+	pn("func runConcurrently(n int, concurrency int, fn func(i int)) {")
+	pn("	if concurrency < 1 {")
+	pn("		concurrency = 1")
+	pn("	}")
+	pn("")
+	pn("	var wg sync.WaitGroup")
+	pn("	sem := make(chan struct{}, concurrency)")
+	pn("	for i := 0; i < n; i++ {")
+	pn("		wg.Add(1)")
+	pn("		sem <- struct{}{}")
+	pn("		go func(i int) {")
+	pn("			defer func() {")
+	pn("				<-sem")
+	pn("				wg.Done()")
+	pn("			}()")
+	pn("			fn(i)")
+	pn("		}(i)")
+	pn("	}")
+	pn("	wg.Wait()")
+	pn("}")
+	pn("// ProjectIDSetter is an interface that every type that can set a project ID must implement")
+	pn("type ProjectIDSetter interface {")
+	pn("	SetProjectid(string)")
+	pn("}")
+	pn("")
+	pn("// WithProject takes either a project name or ID and sets the `projectid` parameter")
+	pn("func WithProject(project string) OptionFunc {")
+	pn("	return func(cs *CloudStackClient, p interface{}) error {")
+	pn("		ps, ok := p.(ProjectIDSetter)")
+	pn("")
+	pn("		if !ok || project == \"\" {")
+	pn("			return nil")
+	pn("		}")
+	pn("")
+	pn("		if !IsID(project) {")
+	pn("			id, _, err := cs.Project.GetProjectID(project)")
+	pn("			if err != nil {")
+	pn("				return err")
+	pn("			}")
+	pn("			project = id")
+	pn("		}")
+	pn("")
+	pn("		ps.SetProjectid(project)")
+	pn("")
+	pn("		return nil")
+	pn("	}")
+	pn("}")
+	pn("")
+	pn("// VPCIDSetter is an interface that every type that can set a vpc ID must implement")
+	pn("type VPCIDSetter interface {")
+	pn("	SetVpcid(string)")
+	pn("}")
+	pn("")
+	pn("// WithVPCID takes a vpc ID and sets the `vpcid` parameter")
+	pn("func WithVPCID(id string) OptionFunc {")
+	pn("	return func(cs *CloudStackClient, p interface{}) error {")
+	pn("		vs, ok := p.(VPCIDSetter)")
+	pn("")
+	pn("		if !ok || id == \"\" {")
+	pn("			return nil")
+	pn("		}")
+	pn("")
+	pn("		vs.SetVpcid(id)")
+	pn("")
+	pn("		return nil")
+	pn("	}")
+	pn("}")
+	pn("")
+	pn("// The key used to mark params for which the helpers should return the first match")
+	pn("const firstMatchKey = \"_firstmatch\"")
+	pn("")
+	pn("// firstMatchSetter is implemented by all params used by the courtesy helper functions")
+	pn("type firstMatchSetter interface {")
+	pn("	setFirstMatch()")
+	pn("}")
+	pn("")
+	pn("// WithFirstMatch makes the courtesy helper functions return the first result when they find")
+	pn("// multiple results, instead of returning an error")
+	pn("func WithFirstMatch() OptionFunc {")
+	pn("	return func(cs *CloudStackClient, p interface{}) error {")
+	pn("		if fs, ok := p.(firstMatchSetter); ok {")
+	pn("			fs.setFirstMatch()")
+	pn("		}")
+	pn("")
+	pn("		return nil")
+	pn("	}")
+	pn("}")
+	pn("")
+	pn("// ListAllSetter is an interface that every type that can set listall must implement")
+	pn("type ListAllSetter interface {")
+	pn("	SetListall(bool)")
+	pn("}")
+	pn("")
+	pn("// WithListAll sets the `listall` parameter to true, so all resources the caller is authorized to")
+	pn("// see are listed and not only the resources owned by the caller. This can for example be used with")
+	pn("// the GetXxxID and GetXxxByID helpers to resolve resources across domains and accounts.")
+	pn("func WithListAll() OptionFunc {")
+	pn("	return func(cs *CloudStackClient, p interface{}) error {")
+	pn("		if ls, ok := p.(ListAllSetter); ok {")
+	pn("			ls.SetListall(true)")
+	pn("		}")
+	pn("")
+	pn("		return nil")
+	pn("	}")
+	pn("}")
+	pn("")
+	for _, et := range as.enumTypesUsed() {
+		pn("// %s is the type of the params which only take one of the values below", et)
+		pn("type %s string", et)
+		pn("")
+		pn("const (")
+		for _, v := range enumTypes[et] {
+			pn("	%s%s %s = \"%s\"", et, v.name, et, v.value)
+		}
+		pn(")")
+		pn("")
+	}
+	pn("// The key of the object CloudStack wraps the response of each command in")
+	pn("var responseKeys = map[string]string{")
+	for _, s := range as.services {
+		for _, a := range s.apis {
+			pn("	\"%s\": \"%s\",", a.Name, responseKey(a))
+		}
+	}
+	pn("}")
+	pn("")
+	for _, s := range as.services {
+		pn("type %s struct {", s.name)
+		pn("  cs *CloudStackClient")
+		if _, found := cachedServices[s.name]; found {
+			pn("  cache *lookupCache")
+		}
+		pn("}")
+		pn("")
+		pn("func New%s(cs *CloudStackClient) *%s {", s.name, s.name)
+		if ttl, found := cachedServices[s.name]; found {
+			pn("	return &%s{cs: cs, cache: newLookupCache(%s)}", s.name, ttl)
+		} else {
+			pn("	return &%s{cs: cs}", s.name)
+		}
+		pn("}")
+		pn("")
+	}
+
+	clean, err := format.Source(buf.Bytes())
+	if err != nil {
+		return buf.Bytes(), err
+	}
+	return clean, err
+}
+
+// Name returns the name of the service
+func (s *Service) Name() string {
+	return s.name
+}
+
+// WriteGeneratedCode writes the code of the service to outdir. When the service has more APIs than
+// allowed per file, the code is split over the file of the service and numbered additional files.
+func (s *Service) WriteGeneratedCode(outdir string) error {
+	files, err := s.GenerateFiles()
+	if err != nil {
+		return err
+	}
+
+	// Remove any additional files of a previous run which are no longer generated
+	stale, err := filepath.Glob(path.Join(outdir, s.name+"_*.go"))
+	if err != nil {
+		return err
+	}
+	for _, file := range stale {
+		if _, found := files[filepath.Base(file)]; !found {
+			if err := os.Remove(file); err != nil {
+				return err
+			}
+		}
+	}
+
+	for name, code := range files {
+		if err := ioutil.WriteFile(path.Join(outdir, name), code, 0644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GenerateFiles returns the formatted code of the service by file name. The code is only split over
+// multiple files when the service has more APIs than allowed per file. The first file is then named
+// after the service and contains all code that is not specific to one of the APIs, the additional
+// files are numbered (e.g. NetworkService_1.go).
+func (s *Service) GenerateFiles() (map[string][]byte, error) {
+	max := s.cfg.MaxAPIsPerFile
+	if max <= 0 || len(s.apis) <= max {
+		code, err := s.GenerateCode()
+		if err != nil {
+			return nil, err
+		}
+		return map[string][]byte{s.name + ".go": code}, nil
+	}
+
+	files := make(map[string][]byte)
+	for i := 0; i*max < len(s.apis); i++ {
+		end := (i + 1) * max
+		if end > len(s.apis) {
+			end = len(s.apis)
+		}
+
+		name := fmt.Sprintf("%s_%d.go", s.name, i)
+		if i == 0 {
+			name = s.name + ".go"
+		}
+
+		code, err := s.generateCode(s.apis[i*max:end], i == 0)
+		if err != nil {
+			return nil, err
+		}
+		files[name] = code
+	}
+	return files, nil
+}
+
+// GenerateCode returns the formatted code of the service
+func (s *Service) GenerateCode() ([]byte, error) {
+	return s.generateCode(s.apis, true)
+}
+
+// Generates the formatted code for the given APIs of the service. Only the primary file contains the
+// code that is not specific to one of the APIs, like the helpers of the service and the facade types.
+func (s *Service) generateCode(apis []*API, primary bool) ([]byte, error) {
+	// Buffer the output in memory, for gofmt'ing later in the defer.
+	var buf bytes.Buffer
+	s.p = func(format string, args ...interface{}) {
+		_, err := fmt.Fprintf(&buf, format, args...)
+		if err != nil {
+			panic(err)
+		}
+	}
+	s.pn = func(format string, args ...interface{}) {
+		s.p(format+"\n", args...)
+	}
+	pn := s.pn
+
+	pn("//")
+	pn("// Copyright 2018, Sander van Harmelen")
+	pn("//")
+	pn("// Licensed under the Apache License, Version 2.0 (the \"License\");")
+	pn("// you may not use this file except in compliance with the License.")
+	pn("// You may obtain a copy of the License at")
+	pn("//")
+	pn("//     http://www.apache.org/licenses/LICENSE-2.0")
+	pn("//")
+	pn("// Unless required by applicable law or agreed to in writing, software")
+	pn("// distributed under the License is distributed on an \"AS IS\" BASIS,")
+	pn("// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.")
+	pn("// See the License for the specific language governing permissions and")
+	pn("// limitations under the License.")
+	pn("//")
+	pn("")
+	pn("package %s", pkg)
+	pn("")
+	if !primary {
+		s.generateAPICode(apis)
+		return s.format(&buf)
+	}
+	s.generateResponseConverterFuncs()
+	if s.name == "CustomService" {
+		pn("type CustomServiceParams struct {")
+		pn("	p map[string]interface{}")
+		pn("}")
+		pn("")
+		pn("func (p *CustomServiceParams) toURLValues() url.Values {")
+		pn("	u := url.Values{}")
+		pn("	if p.p == nil {")
+		pn("		return u")
+		pn("	}")
+		pn("")
+		pn("	for k, v := range p.p {")
+		pn("		switch t := v.(type) {")
+		pn("		case bool:")
+		pn("			u.Set(k, strconv.FormatBool(t))")
+		pn("		case int:")
+		pn("			u.Set(k, strconv.Itoa(t))")
+		pn("		case int64:")
+		pn("			vv := strconv.FormatInt(t, 10)")
+		pn("			u.Set(k, vv)")
+		pn("		case string:")
+		pn("			u.Set(k, t)")
+		pn("		case []string:")
+		pn("			u.Set(k, strings.Join(t, \", \"))")
+		pn("		case map[string]string:")
+		pn("			for i, kk := range getSortedKeysFromMap(t) {")
+		pn("				u.Set(fmt.Sprintf(\"%%s[%%d].%%s\", k, i, kk), t[kk])")
+		pn("			}")
+		pn("		}")
+		pn("	}")
+		pn("")
+		pn("	return u")
+		pn("}")
+		pn("")
+		pn("func (p *CustomServiceParams) SetParam(param string, v interface{}) {")
+		pn("	if p.p == nil {")
+		pn("		p.p = make(map[string]interface{})")
+		pn("	}")
+		pn("	p.p[param] = v")
+		pn("	return")
+		pn("}")
+		pn("")
+		pn("func (s *CustomService) CustomRequest(api string, p *CustomServiceParams, result interface{}) error {")
+		pn("	return s.CustomRequestWithContext(context.Background(), api, p, result)")
+		pn("}")
+		pn("")
+		pn("// CustomRequestWithContext is the same as CustomRequest, but the request is cancelled when the context is done")
+		pn("func (s *CustomService) CustomRequestWithContext(ctx context.Context, api string, p *CustomServiceParams, result interface{}) error {")
+		pn("	resp, err := s.cs.newRequestWithContext(ctx, api, responseKeys[api], p.toURLValues())")
+		pn("	if err != nil {")
+		pn("		return err")
+		pn("	}")
+		pn("")
+		pn("	return json.Unmarshal(resp, result)")
+		pn("}")
+	}
+	if s.name == "TemplateService" {
+		pn("// OVFProperty is a property of a deploy-as-is (OVF) template, which can be set when deploying a")
+		pn("// virtual machine from the template using SetOVFProperties")
+		pn("type OVFProperty struct {")
+		pn("	Key              string `json:\"key\"`")
+		pn("	Type             string `json:\"type\"`       // The OVF type, e.g. string, boolean, int, uint8 or real")
+		pn("	Value            string `json:\"value\"`      // The default value")
+		pn("	Qualifiers       string `json:\"qualifiers\"` // E.g. ValueMap{\"a\",\"b\"} or MinLen(1),MaxLen(8)")
+		pn("	Userconfigurable bool   `json:\"userconfigurable\"`")
+		pn("	Label            string `json:\"label\"`")
+		pn("	Description      string `json:\"description\"`")
+		pn("	Password         bool   `json:\"password\"`")
+		pn("}")
+		pn("")
+		pn("// ListTemplateOVFProperties lists the OVF properties of the deploy-as-is template. This command is not")
+		pn("// part of the API this package is generated for, as it was added in CloudStack 4.15.")
+		pn("func (s *TemplateService) ListTemplateOVFProperties(templateid string) ([]*OVFProperty, error) {")
+		pn("	resp, err := s.cs.newRequest(\"listTemplateOVFProperties\", url.Values{\"id\": []string{templateid}})")
+		pn("	if err != nil {")
+		pn("		return nil, err")
+		pn("	}")
+		pn("")
+		pn("	var r struct {")
+		pn("		Count         int            `json:\"count\"`")
+		pn("		OVFProperties []*OVFProperty `json:\"ovfproperty\"`")
+		pn("	}")
+		pn("	if err := json.Unmarshal(resp, &r); err != nil {")
+		pn("		return nil, err")
+		pn("	}")
+		pn("")
+		pn("	return r.OVFProperties, nil")
+		pn("}")
+		pn("")
+		pn("var ovfValueMapRegex = regexp.MustCompile(`ValueMap\\{(.*?)\\}`)")
+		pn("")
+		pn("// ValidateOVFProperties verifies the values (by key) can be set for the OVF properties of a template.")
+		pn("// It returns an error for values of unknown properties or properties which are not user configurable,")
+		pn("// for values which do not match the type of their property, and for values which are not in the value")
+		pn("// map of their property (if it has one).")
+		pn("func ValidateOVFProperties(properties []*OVFProperty, values map[string]string) error {")
+		pn("	byKey := make(map[string]*OVFProperty, len(properties))")
+		pn("	for _, p := range properties {")
+		pn("		byKey[p.Key] = p")
+		pn("	}")
+		pn("")
+		pn("	var errs []error")
+		pn("	for _, k := range getSortedKeysFromMap(values) {")
+		pn("		p, v := byKey[k], values[k]")
+		pn("		if p == nil {")
+		pn("			errs = append(errs, fmt.Errorf(\"Unknown OVF property %%s\", k))")
+		pn("			continue")
+		pn("		}")
+		pn("		if !p.Userconfigurable {")
+		pn("			errs = append(errs, fmt.Errorf(\"OVF property %%s is not user configurable\", k))")
+		pn("			continue")
+		pn("		}")
+		pn("")
+		pn("		var err error")
+		pn("		switch {")
+		pn("		case p.Type == \"boolean\":")
+		pn("			_, err = strconv.ParseBool(v)")
+		pn("		case p.Type == \"real\":")
+		pn("			_, err = strconv.ParseFloat(v, 64)")
+		pn("		case strings.HasPrefix(p.Type, \"uint\"):")
+		pn("			_, err = strconv.ParseUint(v, 10, 64)")
+		pn("		case strings.HasPrefix(p.Type, \"int\") || strings.HasPrefix(p.Type, \"sint\"):")
+		pn("			_, err = strconv.ParseInt(v, 10, 64)")
+		pn("		}")
+		pn("		if err != nil {")
+		pn("			errs = append(errs, fmt.Errorf(\"Invalid value %%q for OVF property %%s of type %%s\", v, k, p.Type))")
+		pn("			continue")
+		pn("		}")
+		pn("")
+		pn("		if m := ovfValueMapRegex.FindStringSubmatch(p.Qualifiers); m != nil {")
+		pn("			allowed := strings.Split(m[1], \",\")")
+		pn("			found := false")
+		pn("			for i := range allowed {")
+		pn("				allowed[i] = strings.Trim(strings.TrimSpace(allowed[i]), `\"`)")
+		pn("				found = found || allowed[i] == v")
+		pn("			}")
+		pn("			if !found {")
+		pn("				errs = append(errs, fmt.Errorf(\"Invalid value %%q for OVF property %%s, must be one of: %%s\", v, k, strings.Join(allowed, \", \")))")
+		pn("			}")
+		pn("		}")
+		pn("	}")
+		pn("	return errors.Join(errs...)")
+		pn("}")
+		pn("")
+		pn("// ExtractToFile extracts the template from the given zone (if not empty) using the given mode (which should")
+		pn("// be HTTP_DOWNLOAD), waits for the async job to finish and downloads the extracted template to destPath.")
+		pn("func (s *TemplateService) ExtractToFile(templateid, zoneid, mode, destPath string) error {")
+		pn("	p := s.NewExtractTemplateParams(templateid, mode)")
+		pn("	if zoneid != \"\" {")
+		pn("		p.SetZoneid(zoneid)")
+		pn("	}")
+		pn("")
+		pn("	r, err := s.ExtractTemplate(p)")
+		pn("	if err != nil {")
+		pn("		return err")
+		pn("	}")
+		pn("")
+		pn("	// An async client already waited for the job to finish")
+		pn("	if !s.cs.async {")
+		pn("		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)")
+		pn("		if err != nil {")
+		pn("			return err")
+		pn("		}")
+		pn("		if b, err = getRawValue(b); err != nil {")
+		pn("			return err")
+		pn("		}")
+		pn("		if err := json.Unmarshal(b, r); err != nil {")
+		pn("			return err")
+		pn("		}")
+		pn("	}")
+		pn("")
+		pn("	if r.Url == \"\" {")
+		pn("		return fmt.Errorf(\"No download URL returned when extracting template %%s\", templateid)")
+		pn("	}")
+		pn("	return s.cs.downloadToFile(r.Url, destPath)")
+		pn("}")
+		pn("// FindTemplate searches for a template by name, trying each of the given template filters")
+		pn("// in order until a unique match is found. If no filters are given it will try the featured,")
+		pn("// community and self filters. The zone can be either a zone name or ID and can be left empty")
+		pn("// to search across all zones.")
+		pn("func (s *TemplateService) FindTemplate(name, zone string, filters ...string) (*Template, error) {")
+		pn("	if len(filters) == 0 {")
+		pn("		filters = []string{\"featured\", \"community\", \"self\"}")
+		pn("	}")
+		pn("")
+		pn("	if zone != \"\" && !IsID(zone) {")
+		pn("		id, _, err := s.cs.Zone.GetZoneID(zone)")
+		pn("		if err != nil {")
+		pn("			return nil, err")
+		pn("		}")
+		pn("		zone = id")
+		pn("	}")
+		pn("")
+		pn("	ambiguous := false")
+		pn("	for _, filter := range filters {")
+		pn("		p := s.NewListTemplatesParams(filter)")
+		pn("		p.SetName(name)")
+		pn("		if zone != \"\" {")
+		pn("			p.SetZoneid(zone)")
+		pn("		}")
+		pn("")
+		pn("		l, err := s.ListTemplates(p)")
+		pn("		if err != nil {")
+		pn("			return nil, err")
+		pn("		}")
+		pn("")
+		pn("		// The same template is returned once for every zone it is available")
+		pn("		// in, so we need to dedup the results by ID before checking them")
+		pn("		var match *Template")
+		pn("		ids := make(map[string]bool)")
+		pn("		for _, t := range l.Templates {")
+		pn("			if t.Name == name && !ids[t.Id] {")
+		pn("				ids[t.Id] = true")
+		pn("				match = t")
+		pn("			}")
+		pn("		}")
+		pn("")
+		pn("		switch len(ids) {")
+		pn("		case 0:")
+		pn("			continue")
+		pn("		case 1:")
+		pn("			return match, nil")
+		pn("		default:")
+		pn("			ambiguous = true")
+		pn("		}")
+		pn("	}")
+		pn("")
+		pn("	if ambiguous {")
+		pn("		return nil, fmt.Errorf(\"Could not find a unique match for %%s using filters: %%v\", name, filters)")
+		pn("	}")
+		pn("	return nil, fmt.Errorf(\"%%w for %%s using filters: %%v\", ErrNotFound, name, filters)")
+		pn("}")
+		pn("")
+		pn("// UploadTemplate uploads the template data read from r to the secondary storage, using the")
+		pn("// upload params returned by GetUploadParamsForTemplate. The upload is done with a multipart")
+		pn("// POST call which is authenticated with the signature, metadata and expiry date contained in")
+		pn("// the upload params, instead of with the API key and secret used for regular API calls.")
+		pn("func (s *TemplateService) UploadTemplate(up *GetUploadParamsForTemplateResponse, r io.Reader) error {")
+		pn("	pr, pw := io.Pipe()")
+		pn("	defer pr.Close()")
+		pn("")
+		pn("	// Stream the multipart body so the template doesn't need to be buffered in memory")
+		pn("	mw := multipart.NewWriter(pw)")
+		pn("	go func() {")
+		pn("		part, err := mw.CreateFormFile(\"file\", up.Id)")
+		pn("		if err == nil {")
+		pn("			_, err = io.Copy(part, r)")
+		pn("		}")
+		pn("		if err == nil {")
+		pn("			err = mw.Close()")
+		pn("		}")
+		pn("		pw.CloseWithError(err)")
+		pn("	}()")
+		pn("")
+		pn("	req, err := http.NewRequest(\"POST\", up.PostURL, pr)")
+		pn("	if err != nil {")
+		pn("		return err")
+		pn("	}")
+		pn("	req.Header.Set(\"Content-Type\", mw.FormDataContentType())")
+		pn("	req.Header.Set(\"X-signature\", up.Signature)")
+		pn("	req.Header.Set(\"X-metadata\", up.Metadata)")
+		pn("	req.Header.Set(\"X-expires\", up.Expires)")
+		pn("")
+		pn("	// Uploading a template will usually take a lot longer than")
+		pn("	// the timeout configured for regular API calls")
+		pn("	client := *s.cs.client")
+		pn("	client.Timeout = 0")
+		pn("")
+		pn("	resp, err := client.Do(req)")
+		pn("	if err != nil {")
+		pn("		return err")
+		pn("	}")
+		pn("	defer resp.Body.Close()")
+		pn("")
+		pn("	if resp.StatusCode != http.StatusOK {")
+		pn("		b, _ := ioutil.ReadAll(resp.Body)")
+		pn("		return fmt.Errorf(\"Failed to upload template %%s: %%s: %%s\", up.Id, resp.Status, string(b))")
+		pn("	}")
+		pn("	return nil")
+		pn("}")
+		pn("")
+	}
+
+	if s.name == "VirtualMachineService" {
+		pn("// DeployWithCapacityRetry deploys the virtual machine and waits for the deploy to finish. When the deploy")
+		pn("// fails because of insufficient capacity (see IsInsufficientCapacity), it is retried in each of the")
+		pn("// candidate clusters in turn (by setting the clusterid of the params) until a deploy succeeds. As a cluster")
+		pn("// belongs to a single pod, any pod or host set in the params is cleared on a retry. Any other error is")
+		pn("// returned immediately, and when the deploy fails in all candidates the last error is returned. The params")
+		pn("// are copied, so the params of the caller are not changed. The options are used when deploying and getting")
+		pn("// the deployed virtual machine.")
+		pn("func (s *VirtualMachineService) DeployWithCapacityRetry(p *DeployVirtualMachineParams, candidateClusterIDs []string, opts ...OptionFunc) (*VirtualMachine, error) {")
+		pn("	p = p.Clone()")
+		pn("")
+		pn("	vm, err := s.deployAndWait(p, opts...)")
+		pn("	for _, clusterid := range candidateClusterIDs {")
+		pn("		if err == nil || !IsInsufficientCapacity(err) {")
+		pn("			break")
+		pn("		}")
+		pn("		delete(p.p, \"hostid\")")
+		pn("		delete(p.p, \"podid\")")
+		pn("		p.p[\"clusterid\"] = clusterid")
+		pn("		vm, err = s.deployAndWait(p, opts...)")
+		pn("	}")
+		pn("	return vm, err")
+		pn("}")
+		pn("")
+		pn("// Deploys the virtual machine, waits for the async job to finish and returns the deployed virtual machine")
+		pn("func (s *VirtualMachineService) deployAndWait(p *DeployVirtualMachineParams, opts ...OptionFunc) (*VirtualMachine, error) {")
+		pn("	r, err := s.DeployVirtualMachine(p, opts...)")
+		pn("	if err == nil && !s.cs.async {")
+		pn("		// An async client already waited for the job to finish")
+		pn("		_, err = s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)")
+		pn("	}")
+		pn("	if err != nil {")
+		pn("		return nil, err")
+		pn("	}")
+		pn("")
+		pn("	vm, _, err := s.GetVirtualMachineByID(r.Id, opts...)")
+		pn("	return vm, err")
+		pn("}")
+		pn("")
+		pn("// SetOVFProperties sets the values (by key) of the OVF properties of the deploy-as-is template, which")
+		pn("// are sent as properties[i].key and properties[i].value. These properties are supported by CloudStack")
+		pn("// 4.15 and later. Use ListTemplateOVFProperties and ValidateOVFProperties to validate the values first.")
+		pn("func (p *DeployVirtualMachineParams) SetOVFProperties(v map[string]string) {")
+		pn("	if p.p == nil {")
+		pn("		p.p = make(map[string]interface{})")
+		pn("	}")
+		pn("	p.p[\"properties\"] = v")
+		pn("}")
+		pn("")
+		pn("// SetNetworkByName resolves the ID of the network with the given name and adds it to the network IDs")
+		pn("// of the params, so it can be called multiple times to deploy into multiple networks. If the zone ID")
+		pn("// of the params is already set, only networks in that zone are matched. The options are used when")
+		pn("// resolving the network ID.")
+		pn("func (p *DeployVirtualMachineParams) SetNetworkByName(cs *CloudStackClient, name string, opts ...OptionFunc) error {")
+		pn("	if zoneid, ok := p.p[\"zoneid\"].(string); ok && zoneid != \"\" {")
+		pn("		zoneOpt := func(cs *CloudStackClient, lp interface{}) error {")
+		pn("			if lp, ok := lp.(*ListNetworksParams); ok {")
+		pn("				lp.SetZoneid(zoneid)")
+		pn("			}")
+		pn("			return nil")
+		pn("		}")
+		pn("		opts = append([]OptionFunc{zoneOpt}, opts...)")
+		pn("	}")
+		pn("")
+		pn("	id, _, err := cs.Network.GetNetworkID(name, opts...)")
+		pn("	if err != nil {")
+		pn("		return fmt.Errorf(\"Failed to resolve network %%s: %%w\", name, err)")
+		pn("	}")
+		pn("")
+		pn("	ids, _ := p.p[\"networkids\"].([]string)")
+		pn("	p.SetNetworkids(append(ids[:len(ids):len(ids)], id))")
+		pn("	return nil")
+		pn("}")
+		pn("// ListVirtualMachinesAllZones lists the virtual machines matching the given params in every zone. It")
+		pn("// first lists all zones and then lists the virtual machines of each zone in parallel, using at most")
+		pn("// concurrency concurrent requests. If a zone ID is set in the params it will be overwritten. When")
+		pn("// listing fails for some zones, the virtual machines of all other zones are returned together with")
+		pn("// an error containing the failures by zone ID.")
+		pn("func (s *VirtualMachineService) ListVirtualMachinesAllZones(p *ListVirtualMachinesParams, concurrency int) ([]*VirtualMachine, error) {")
+		pn("	zones, err := s.cs.Zone.ListZones(s.cs.Zone.NewListZonesParams())")
+		pn("	if err != nil {")
+		pn("		return nil, err")
+		pn("	}")
+		pn("")
+		pn("	vms := make([][]*VirtualMachine, len(zones.Zones))")
+		pn("	errs := make([]error, len(zones.Zones))")
+		pn("")
+		pn("	runConcurrently(len(zones.Zones), concurrency, func(i int) {")
+		pn("		zp := p.Clone()")
+		pn("		zp.SetZoneid(zones.Zones[i].Id)")
+		pn("")
+		pn("		l, err := s.ListVirtualMachines(zp)")
+		pn("		if err != nil {")
+		pn("			errs[i] = fmt.Errorf(\"Failed to list virtual machines in zone %%s: %%v\", zones.Zones[i].Name, err)")
+		pn("			return")
+		pn("		}")
+		pn("		vms[i] = l.VirtualMachines")
+		pn("	})")
+		pn("")
+		pn("	var r BulkResult[*VirtualMachine]")
+		pn("	for i, z := range zones.Zones {")
+		pn("		if errs[i] != nil {")
+		pn("			r.fail(z.Id, errs[i])")
+		pn("			continue")
+		pn("		}")
+		pn("		r.Succeeded = append(r.Succeeded, vms[i]...)")
+		pn("	}")
+		pn("")
+		pn("	return r.Succeeded, r.Err()")
+		pn("}")
+		pn("")
+		pn("// VMSpec contains the deploy relevant configuration of a virtual machine, as exported by ExportSpec.")
+		pn("// It can be stored (e.g. as JSON) and used to recreate the virtual machine later on.")
+		pn("type VMSpec struct {")
+		pn("	Name              string `json:\"name\"`")
+		pn("	DisplayName       string `json:\"displayname,omitempty\"`")
+		pn("	Group             string `json:\"group,omitempty\"`")
+		pn("	ZoneID            string `json:\"zoneid\"`")
+		pn("	ServiceOfferingID string `json:\"serviceofferingid\"`")
+		pn("	TemplateID        string `json:\"templateid\"`")
+		pn("	Hypervisor        HypervisorType `json:\"hypervisor,omitempty\"`")
+		pn("	Keypair           string `json:\"keypair,omitempty\"`")
+		pn("	RootDiskSize      int64  `json:\"rootdisksize,omitempty\"` // In GB")
+		pn("")
+		pn("	Account   string `json:\"account,omitempty\"`")
+		pn("	DomainID  string `json:\"domainid,omitempty\"`")
+		pn("	ProjectID string `json:\"projectid,omitempty\"`")
+		pn("")
+		pn("	Details  map[string]string `json:\"details,omitempty\"`")
+		pn("	UserData string            `json:\"userdata,omitempty\"` // Base64 encoded")
+		pn("")
+		pn("	Networks          []VMSpecNetwork `json:\"networks,omitempty\"` // The default network first")
+		pn("	AffinityGroupIDs  []string        `json:\"affinitygroupids,omitempty\"`")
+		pn("	SecurityGroupIDs  []string        `json:\"securitygroupids,omitempty\"`")
+		pn("	DataDisks         []VMSpecDisk    `json:\"datadisks,omitempty\"` // Ordered by device ID")
+		pn("}")
+		pn("")
+		pn("// VMSpecNetwork contains the configuration of a NIC of a virtual machine")
+		pn("type VMSpecNetwork struct {")
+		pn("	NetworkID  string `json:\"networkid\"`")
+		pn("	IPAddress  string `json:\"ipaddress,omitempty\"`")
+		pn("	IP6Address string `json:\"ip6address,omitempty\"`")
+		pn("}")
+		pn("")
+		pn("// VMSpecDisk contains the configuration of a data disk of a virtual machine")
+		pn("type VMSpecDisk struct {")
+		pn("	Name           string `json:\"name\"`")
+		pn("	DiskOfferingID string `json:\"diskofferingid\"`")
+		pn("	Size           int64  `json:\"size\"` // In GB")
+		pn("	DeviceID       int64  `json:\"deviceid\"`")
+		pn("}")
+		pn("")
+		pn("// ExportSpec gathers the deploy relevant configuration of the virtual machine: its offering, template,")
+		pn("// networks, data disks, affinity groups, security groups and userdata. Runtime state, like the host")
+		pn("// it runs on, is not part of the spec. The options are applied to the params of every command that")
+		pn("// supports them.")
+		pn("func (s *VirtualMachineService) ExportSpec(vmid string, opts ...OptionFunc) (*VMSpec, error) {")
+		pn("	vm, _, err := s.GetVirtualMachineByID(vmid, opts...)")
+		pn("	if err != nil {")
+		pn("		return nil, err")
+		pn("	}")
+		pn("")
+		pn("	spec := &VMSpec{")
+		pn("		Name:              vm.Name,")
+		pn("		DisplayName:       vm.Displayname,")
+		pn("		Group:             vm.Group,")
+		pn("		ZoneID:            vm.Zoneid,")
+		pn("		ServiceOfferingID: vm.Serviceofferingid,")
+		pn("		TemplateID:        vm.Templateid,")
+		pn("		Hypervisor:        HypervisorType(vm.Hypervisor),")
+		pn("		Keypair:           vm.Keypair,")
+		pn("		Account:           vm.Account,")
+		pn("		DomainID:          vm.Domainid,")
+		pn("		ProjectID:         vm.Projectid,")
+		pn("	}")
+		pn("	if len(vm.Details) > 0 {")
+		pn("		spec.Details = make(map[string]string, len(vm.Details))")
+		pn("		for k, v := range vm.Details {")
+		pn("			spec.Details[k] = v")
+		pn("		}")
+		pn("	}")
+		pn("")
+		pn("	// The first network given when deploying becomes the default network")
+		pn("	nics := vm.Nic")
+		pn("	sort.SliceStable(nics, func(i, j int) bool {")
+		pn("		if %s != %s {", s.cfg.value("nics[i].Isdefault"), s.cfg.value("nics[j].Isdefault"))
+		pn("			return %s", s.cfg.value("nics[i].Isdefault"))
+		pn("		}")
+		pn("		di, _ := strconv.Atoi(nics[i].Deviceid)")
+		pn("		dj, _ := strconv.Atoi(nics[j].Deviceid)")
+		pn("		return di < dj")
+		pn("	})")
+		pn("	for _, nic := range nics {")
+		pn("		spec.Networks = append(spec.Networks, VMSpecNetwork{")
+		pn("			NetworkID:  nic.Networkid,")
+		pn("			IPAddress:  nic.Ipaddress,")
+		pn("			IP6Address: nic.Ip6address,")
+		pn("		})")
+		pn("	}")
+		pn("	for _, ag := range vm.Affinitygroup {")
+		pn("		spec.AffinityGroupIDs = append(spec.AffinityGroupIDs, ag.Id)")
+		pn("	}")
+		pn("	for _, sg := range vm.Securitygroup {")
+		pn("		spec.SecurityGroupIDs = append(spec.SecurityGroupIDs, sg.Id)")
+		pn("	}")
+		pn("")
+		pn("	ud, err := s.cs.User.GetVirtualMachineUserData(s.cs.User.NewGetVirtualMachineUserDataParams(vmid))")
+		pn("	if err != nil {")
+		pn("		return nil, fmt.Errorf(\"Failed to get the userdata of virtual machine %%s: %%v\", vmid, err)")
+		pn("	}")
+		pn("	spec.UserData = ud.Userdata")
+		pn("")
+		pn("	p := s.cs.Volume.NewListVolumesParams()")
+		pn("	p.SetVirtualmachineid(vmid)")
+		pn("	for _, fn := range s.cs.withDefaultOptions(opts) {")
+		pn("		if err := fn(s.cs, p); err != nil {")
+		pn("			return nil, err")
+		pn("		}")
+		pn("	}")
+		pn("")
+		pn("	l, err := s.cs.Volume.ListVolumes(p)")
+		pn("	if err != nil {")
+		pn("		return nil, fmt.Errorf(\"Failed to list the volumes of virtual machine %%s: %%v\", vmid, err)")
+		pn("	}")
+		pn("	for _, v := range l.Volumes {")
+		pn("		switch v.Type {")
+		pn("		case \"ROOT\":")
+		pn("			spec.RootDiskSize = %s >> 30", s.cfg.value("v.Size"))
+		pn("		case \"DATADISK\":")
+		pn("			spec.DataDisks = append(spec.DataDisks, VMSpecDisk{")
+		pn("				Name:           v.Name,")
+		pn("				DiskOfferingID: v.Diskofferingid,")
+		pn("				Size:           %s >> 30,", s.cfg.value("v.Size"))
+		pn("				DeviceID:       %s,", s.cfg.value("v.Deviceid"))
+		pn("			})")
+		pn("		}")
+		pn("	}")
+		pn("	sort.Slice(spec.DataDisks, func(i, j int) bool {")
+		pn("		return spec.DataDisks[i].DeviceID < spec.DataDisks[j].DeviceID")
+		pn("	})")
+		pn("")
+		pn("	return spec, nil")
+		pn("}")
+		pn("// DeployFromSpec deploys a new virtual machine using a spec as exported by ExportSpec, for example to")
+		pn("// recreate a virtual machine or to deploy a copy of it in another zone or account (by changing the")
+		pn("// spec first). The virtual machine is deployed in the default network of the spec, after which the")
+		pn("// other networks are added and the data disks are created and attached, waiting for every async job")
+		pn("// to finish. The IP addresses of the spec are not reused, as they usually still belong to the original")
+		pn("// virtual machine. The options are applied to the params of every command that supports them.")
+		pn("//")
+		pn("// When the virtual machine cannot be deployed an error is returned, which explicitly mentions when the")
+		pn("// template is not available in the zone of the spec. When the virtual machine is deployed, but some")
+		pn("// of the networks or data disks could not be added, the virtual machine is returned together with an")
+		pn("// error describing every part of the spec that could not be reproduced.")
+		pn("func (s *VirtualMachineService) DeployFromSpec(spec *VMSpec, opts ...OptionFunc) (*VirtualMachine, error) {")
+		pn("	tp := s.cs.Template.NewListTemplatesParams(\"executable\")")
+		pn("	tp.SetId(spec.TemplateID)")
+		pn("	tp.SetZoneid(spec.ZoneID)")
+		pn("	for _, fn := range s.cs.withDefaultOptions(opts) {")
+		pn("		if err := fn(s.cs, tp); err != nil {")
+		pn("			return nil, err")
+		pn("		}")
+		pn("	}")
+		pn("")
+		pn("	tl, err := s.cs.Template.ListTemplates(tp)")
+		pn("	if err != nil {")
+		pn("		return nil, err")
+		pn("	}")
+		pn("	if tl.Count == 0 {")
+		pn("		return nil, fmt.Errorf(\"Template %%s is not available in zone %%s\", spec.TemplateID, spec.ZoneID)")
+		pn("	}")
+		pn("")
+		pn("	p := s.NewDeployVirtualMachineParams(spec.ServiceOfferingID, spec.TemplateID, spec.ZoneID)")
+		pn("	for k, v := range map[string]string{")
+		pn("		\"name\":        spec.Name,")
+		pn("		\"displayname\": spec.DisplayName,")
+		pn("		\"group\":       spec.Group,")
+		pn("		\"hypervisor\":  string(spec.Hypervisor),")
+		pn("		\"keypair\":     spec.Keypair,")
+		pn("		\"account\":     spec.Account,")
+		pn("		\"domainid\":    spec.DomainID,")
+		pn("		\"projectid\":   spec.ProjectID,")
+		pn("		\"userdata\":    spec.UserData,")
+		pn("	} {")
+		pn("		if v != \"\" {")
+		pn("			p.p[k] = v")
+		pn("		}")
+		pn("	}")
+		pn("	if spec.RootDiskSize > 0 {")
+		pn("		p.SetRootdisksize(spec.RootDiskSize)")
+		pn("	}")
+		pn("	if len(spec.Details) > 0 {")
+		pn("		p.SetDetails(spec.Details)")
+		pn("	}")
+		pn("	if len(spec.AffinityGroupIDs) > 0 {")
+		pn("		p.SetAffinitygroupids(spec.AffinityGroupIDs)")
+		pn("	}")
+		pn("	if len(spec.SecurityGroupIDs) > 0 {")
+		pn("		p.SetSecuritygroupids(spec.SecurityGroupIDs)")
+		pn("	}")
+		pn("	if len(spec.Networks) > 0 {")
+		pn("		p.SetNetworkids([]string{spec.Networks[0].NetworkID})")
+		pn("	}")
+		pn("	for _, fn := range s.cs.withDefaultOptions(opts) {")
+		pn("		if err := fn(s.cs, p); err != nil {")
+		pn("			return nil, err")
+		pn("		}")
+		pn("	}")
+		pn("")
+		pn("	r, err := s.DeployVirtualMachine(p)")
+		pn("	if err != nil {")
+		pn("		return nil, fmt.Errorf(\"Failed to deploy virtual machine %%s: %%v\", spec.Name, err)")
+		pn("	}")
+		pn("")
+		pn("	// An async client already waited for the job to finish")
+		pn("	if !s.cs.async {")
+		pn("		if _, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout); err != nil {")
+		pn("			return nil, fmt.Errorf(\"Failed to deploy virtual machine %%s: %%v\", spec.Name, err)")
+		pn("		}")
+		pn("	}")
+		pn("")
+		pn("	var failed []error")
+		pn("	if len(spec.Networks) > 1 {")
+		pn("		for _, n := range spec.Networks[1:] {")
+		pn("			if _, err := s.cs.Nic.AttachNetwork(r.Id, n.NetworkID, opts...); err != nil {")
+		pn("				failed = append(failed, fmt.Errorf(\"Failed to add network %%s: %%v\", n.NetworkID, err))")
+		pn("			}")
+		pn("		}")
+		pn("	}")
+		pn("	for _, d := range spec.DataDisks {")
+		pn("		if err := s.createAndAttachDataDisk(spec, d, r.Id, opts...); err != nil {")
+		pn("			failed = append(failed, fmt.Errorf(\"Failed to add data disk %%s: %%v\", d.Name, err))")
+		pn("		}")
+		pn("	}")
+		pn("")
+		pn("	vm, _, err := s.GetVirtualMachineByID(r.Id, opts...)")
+		pn("	if err != nil {")
+		pn("		return nil, err")
+		pn("	}")
+		pn("	if len(failed) > 0 {")
+		pn("		return vm, fmt.Errorf(\"Virtual machine %%s is deployed, but not all of its spec could be reproduced: %%w\", vm.Id, errors.Join(failed...))")
+		pn("	}")
+		pn("")
+		pn("	return vm, nil")
+		pn("}")
+		pn("")
+		pn("// Creates a data disk as described in the spec and attaches it to the virtual machine")
+		pn("func (s *VirtualMachineService) createAndAttachDataDisk(spec *VMSpec, d VMSpecDisk, vmid string, opts ...OptionFunc) error {")
+		pn("	p := s.cs.Volume.NewCreateVolumeParams()")
+		pn("	p.SetName(d.Name)")
+		pn("	p.SetDiskofferingid(d.DiskOfferingID)")
+		pn("	p.SetZoneid(spec.ZoneID)")
+		pn("	if spec.Account != \"\" {")
+		pn("		p.SetAccount(spec.Account)")
+		pn("	}")
+		pn("	if spec.DomainID != \"\" {")
+		pn("		p.SetDomainid(spec.DomainID)")
+		pn("	}")
+		pn("	if spec.ProjectID != \"\" {")
+		pn("		p.SetProjectid(spec.ProjectID)")
+		pn("	}")
+		pn("")
+		pn("	// The size can only be set when using a custom disk offering")
+		pn("	do, _, err := s.cs.DiskOffering.GetDiskOfferingByID(d.DiskOfferingID, opts...)")
+		pn("	if err != nil {")
+		pn("		return err")
+		pn("	}")
+		pn("	if %s {", s.cfg.value("do.Iscustomized"))
+		pn("		p.SetSize(d.Size)")
+		pn("	}")
+		pn("")
+		pn("	r, err := s.cs.Volume.CreateVolume(p)")
+		pn("	if err != nil {")
+		pn("		return err")
+		pn("	}")
+		pn("	if _, err := s.cs.Volume.waitForVolumeJob(r.JobID, r.Id, opts...); err != nil {")
+		pn("		return err")
+		pn("	}")
+		pn("")
+		pn("	_, err = s.cs.Volume.AttachAndWait(r.Id, vmid, opts...)")
+		pn("	return err")
+		pn("}")
+		pn("")
+		pn("// ErrNoPassword is returned (wrapped) by GetDecryptedPassword when no password is set for the virtual machine")
+		pn("var ErrNoPassword = errors.New(\"No password set\")")
+		pn("")
+		pn("// GetDecryptedPassword gets the encrypted password of the virtual machine and decrypts it using the")
+		pn("// PEM encoded (PKCS #1 or PKCS #8) RSA private key of the SSH keypair the virtual machine was deployed")
+		pn("// with. When the virtual machine has no password set, an ErrNoPassword is returned.")
+		pn("func (s *VirtualMachineService) GetDecryptedPassword(vmid string, privateKeyPEM []byte) (string, error) {")
+		pn("	r, err := s.GetVMPassword(s.NewGetVMPasswordParams(vmid))")
+		pn("	if err != nil {")
+		pn("		if isNoPasswordError(err) {")
+		pn("			return \"\", fmt.Errorf(\"%%w for virtual machine %%s\", ErrNoPassword, vmid)")
+		pn("		}")
+		pn("		return \"\", err")
+		pn("	}")
+		pn("	if r.Encryptedpassword == \"\" {")
+		pn("		return \"\", fmt.Errorf(\"%%w for virtual machine %%s\", ErrNoPassword, vmid)")
+		pn("	}")
+		pn("")
+		pn("	block, _ := pem.Decode(privateKeyPEM)")
+		pn("	if block == nil {")
+		pn("		return \"\", errors.New(\"Unable to decode the PEM encoded private key\")")
+		pn("	}")
+		pn("")
+		pn("	var key *rsa.PrivateKey")
+		pn("	switch block.Type {")
+		pn("	case \"RSA PRIVATE KEY\":")
+		pn("		key, err = x509.ParsePKCS1PrivateKey(block.Bytes)")
+		pn("	case \"PRIVATE KEY\":")
+		pn("		var k interface{}")
+		pn("		if k, err = x509.ParsePKCS8PrivateKey(block.Bytes); err == nil {")
+		pn("			var ok bool")
+		pn("			if key, ok = k.(*rsa.PrivateKey); !ok {")
+		pn("				err = errors.New(\"Private key is not an RSA key\")")
+		pn("			}")
+		pn("		}")
+		pn("	default:")
+		pn("		err = fmt.Errorf(\"Unsupported private key type: %%s\", block.Type)")
+		pn("	}")
+		pn("	if err != nil {")
+		pn("		return \"\", err")
+		pn("	}")
+		pn("")
+		pn("	encrypted, err := base64.StdEncoding.DecodeString(r.Encryptedpassword)")
+		pn("	if err != nil {")
+		pn("		return \"\", err")
+		pn("	}")
+		pn("")
+		pn("	password, err := rsa.DecryptPKCS1v15(rand.Reader, key, encrypted)")
+		pn("	if err != nil {")
+		pn("		return \"\", fmt.Errorf(\"Unable to decrypt the password of virtual machine %%s: %%v\", vmid, err)")
+		pn("	}")
+		pn("")
+		pn("	return string(password), nil")
+		pn("}")
+		pn("")
+		pn("// Returns true if the error means the virtual machine has no password set. CloudStack reports this as a")
+		pn("// parameter error (error code 431), which is also used for any other invalid parameter, so the error text")
+		pn("// is matched as well to tell them apart.")
+		pn("func isNoPasswordError(err error) bool {")
+		pn("	var ae *APIError")
+		pn("	if !errors.As(err, &ae) || ae.ErrorCode != 431 {")
+		pn("		return false")
+		pn("	}")
+		pn("	return strings.Contains(ae.ErrorText, \"No password for VM\")")
+		pn("}")
+		pn("")
+	}
+	if s.name == "AsyncjobService" {
+		pn("// ResultText returns the job result and true if the result type of the job is text, which")
+		pn("// is for example the case for the error message of a failed job.")
+		pn("func (r *QueryAsyncJobResultResponse) ResultText() (string, bool) {")
+		pn("	if r.Jobresulttype != \"text\" {")
+		pn("		return \"\", false")
+		pn("	}")
+		pn("")
+		pn("	var text string")
+		pn("	if err := json.Unmarshal(r.Jobresult, &text); err != nil {")
+		pn("		return string(r.Jobresult), true")
+		pn("	}")
+		pn("	return text, true")
+		pn("}")
+		pn("")
+		pn("// ResultAs decodes the job result into out. If the result type of the job is text instead")
+		pn("// of an object, the text is returned as an error.")
+		pn("func (r *QueryAsyncJobResultResponse) ResultAs(out interface{}) error {")
+		pn("	if text, ok := r.ResultText(); ok {")
+		pn("		return fmt.Errorf(\"Unable to decode text job result: %%s\", text)")
+		pn("	}")
+		pn("	return json.Unmarshal(r.Jobresult, out)")
+		pn("}")
+		pn("")
+		pn("// InstanceRef returns the type and ID of the resource the job acted on, for example")
+		pn("// \"VirtualMachine\" and the ID of the deployed virtual machine. Both are empty if the")
+		pn("// job is not related to a specific resource.")
+		pn("func (r *QueryAsyncJobResultResponse) InstanceRef() (resourceType, id string) {")
+		pn("	return r.Jobinstancetype, r.Jobinstanceid")
+		pn("}")
+		pn("")
+	}
+	if s.name == "AnnotationService" {
+		pn("// Annotation represents a note attached to a resource")
+		pn("type Annotation struct {")
+		pn("	Annotation string `json:\"annotation\"`")
+		pn("	Created    string `json:\"created\"`")
+		pn("	Entityid   string `json:\"entityid\"`")
+		pn("	Entitytype string `json:\"entitytype\"`")
+		pn("	Id         string `json:\"id\"`")
+		pn("	Removed    string `json:\"removed\"`")
+		pn("	Userid     string `json:\"userid\"`")
+		pn("}")
+		pn("")
+		pn("// AddNote attaches a note to the entity with the given type (e.g. HOST or VM) and ID. The")
+		pn("// annotations API is only available on CloudStack 4.11 and later.")
+		pn("func (s *AnnotationService) AddNote(entityType, entityId, note string) (*Annotation, error) {")
+		pn("	p := url.Values{}")
+		pn("	p.Set(\"entitytype\", entityType)")
+		pn("	p.Set(\"entityid\", entityId)")
+		pn("	p.Set(\"annotation\", note)")
+		pn("")
+		pn("	resp, err := s.cs.newRequest(\"addAnnotation\", p)")
+		pn("	if err != nil {")
+		pn("		return nil, annotationError(err)")
+		pn("	}")
+		pn("")
+		pn("	if resp, err = getRawValue(resp); err != nil {")
+		pn("		return nil, err")
+		pn("	}")
+		pn("")
+		pn("	var r Annotation")
+		pn("	if err := json.Unmarshal(resp, &r); err != nil {")
+		pn("		return nil, err")
+		pn("	}")
+		pn("")
+		pn("	return &r, nil")
+		pn("}")
+		pn("")
+		pn("// ListNotes returns all notes attached to the entity with the given type and ID. The")
+		pn("// annotations API is only available on CloudStack 4.11 and later.")
+		pn("func (s *AnnotationService) ListNotes(entityType, entityId string) ([]*Annotation, error) {")
+		pn("	p := url.Values{}")
+		pn("	p.Set(\"entitytype\", entityType)")
+		pn("	p.Set(\"entityid\", entityId)")
+		pn("")
+		pn("	resp, err := s.cs.newRequest(\"listAnnotations\", p)")
+		pn("	if err != nil {")
+		pn("		return nil, annotationError(err)")
+		pn("	}")
+		pn("")
+		pn("	var r struct {")
+		pn("		Count       int           `json:\"count\"`")
+		pn("		Annotations []*Annotation `json:\"annotation\"`")
+		pn("	}")
+		pn("	if err := json.Unmarshal(resp, &r); err != nil {")
+		pn("		return nil, err")
+		pn("	}")
+		pn("")
+		pn("	return r.Annotations, nil")
+		pn("}")
+		pn("")
+		pn("// RemoveNote removes the note with the given ID. The annotations API is only available")
+		pn("// on CloudStack 4.11 and later.")
+		pn("func (s *AnnotationService) RemoveNote(id string) error {")
+		pn("	p := url.Values{}")
+		pn("	p.Set(\"id\", id)")
+		pn("")
+		pn("	if _, err := s.cs.newRequest(\"removeAnnotation\", p); err != nil {")
+		pn("		return annotationError(err)")
+		pn("	}")
+		pn("")
+		pn("	return nil")
+		pn("}")
+		pn("")
+		pn("// Returns a clear error when the annotations API is not available on the server")
+		pn("func annotationError(err error) error {")
+		pn("	if strings.Contains(err.Error(), \"CloudStack API error 432\") {")
+		pn("		return fmt.Errorf(\"The annotations API is not available on this server, it requires CloudStack 4.11 or later: %%v\", err)")
+		pn("	}")
+		pn("	return err")
+		pn("}")
+		pn("")
+	}
+	if s.name == "NicService" {
+		pn("// AttachNetwork adds a NIC for the given network to the virtual machine, waits for the async job to")
+		pn("// finish and returns the updated virtual machine. The options are applied to the add NIC params and")
+		pn("// used when getting the updated virtual machine.")
+		pn("func (s *NicService) AttachNetwork(vmid, networkid string, opts ...OptionFunc) (*VirtualMachine, error) {")
+		pn("	p := s.cs.VirtualMachine.NewAddNicToVirtualMachineParams(networkid, vmid)")
+		pn("")
+		pn("	for _, fn := range s.cs.withDefaultOptions(opts) {")
+		pn("		if err := fn(s.cs, p); err != nil {")
+		pn("			return nil, err")
+		pn("		}")
+		pn("	}")
+		pn("")
+		pn("	r, err := s.cs.VirtualMachine.AddNicToVirtualMachine(p)")
+		pn("	if err != nil {")
+		pn("		return nil, err")
+		pn("	}")
+		pn("")
+		pn("	return s.waitForNicJob(r.JobID, vmid, opts...)")
+		pn("}")
+		pn("")
+		pn("// DetachNetwork removes the given NIC from the virtual machine, waits for the async job to finish")
+		pn("// and returns the updated virtual machine. The options are used when getting the updated virtual machine.")
+		pn("func (s *NicService) DetachNetwork(vmid, nicid string, opts ...OptionFunc) (*VirtualMachine, error) {")
+		pn("	p := s.cs.VirtualMachine.NewRemoveNicFromVirtualMachineParams(nicid, vmid)")
+		pn("")
+		pn("	r, err := s.cs.VirtualMachine.RemoveNicFromVirtualMachine(p)")
+		pn("	if err != nil {")
+		pn("		return nil, err")
+		pn("	}")
+		pn("")
+		pn("	return s.waitForNicJob(r.JobID, vmid, opts...)")
+		pn("}")
+		pn("")
+		pn("func (s *NicService) waitForNicJob(jobid, vmid string, opts ...OptionFunc) (*VirtualMachine, error) {")
+		pn("	// An async client already waited for the job to finish")
+		pn("	if !s.cs.async {")
+		pn("		if _, err := s.cs.GetAsyncJobResult(jobid, s.cs.timeout); err != nil {")
+		pn("			return nil, err")
+		pn("		}")
+		pn("	}")
+		pn("")
+		pn("	vm, _, err := s.cs.VirtualMachine.GetVirtualMachineByID(vmid, opts...)")
+		pn("	return vm, err")
+		pn("}")
+		pn("")
+	}
+	if tn, found := resolveOfferingServices[s.name]; found {
+		pn("// ResolveOffering returns the %[1]s with the given name. Results are cached on the service for", tn)
+		pn("// the configured TTL (5 minutes by default), so repeated lookups don't result in additional list calls.")
+		pn("func (s *%[1]sService) ResolveOffering(name string) (*%[1]s, error) {", tn)
+		pn("	if v, found := s.cache.get(name); found {")
+		pn("		return v.(*%[1]s), nil", tn)
+		pn("	}")
+		pn("")
+		pn("	o, _, err := s.Get%[1]sByName(name)", tn)
+		pn("	if err != nil {")
+		pn("		return nil, err")
+		pn("	}")
+		pn("")
+		pn("	s.cache.set(name, o)")
+		pn("")
+		pn("	return o, nil")
+		pn("}")
+		pn("")
+		pn("// SetResolveTTL sets the TTL of the offerings cached by ResolveOffering. A TTL of 0 caches")
+		pn("// the offerings until InvalidateResolveCache is called.")
+		pn("func (s *%[1]sService) SetResolveTTL(ttl time.Duration) {", tn)
+		pn("	s.cache.setTTL(ttl)")
+		pn("}")
+		pn("")
+		pn("// InvalidateResolveCache removes all offerings cached by ResolveOffering")
+		pn("func (s *%[1]sService) InvalidateResolveCache() {", tn)
+		pn("	s.cache.invalidate()")
+		pn("}")
+		pn("")
+	}
+	if s.name == "AsyncjobService" && s.hasAPI("listAsyncJobs") {
+		pn("// The number of jobs from which on QueryJobs lists the jobs using a single call, instead of querying them")
+		pn("// one by one, as listing all jobs is expensive when only a few of them are needed")
+		pn("const minJobsToList = 10")
+		pn("")
+		pn("// QueryJobs returns the current state of the async jobs with the given IDs, mapped by job ID. When")
+		pn("// querying many jobs, it uses a single listAsyncJobs call to get the state of all jobs at once, and only")
+		pn("// falls back to querying jobs one by one for the jobs which are not listed, or if listAsyncJobs is not")
+		pn("// available to the caller. Fewer jobs are queried one by one, to avoid listing all jobs of the account.")
+		pn("func (cs *CloudStackClient) QueryJobs(jobids []string) (map[string]*AsyncJob, error) {")
+		pn("	jobs := make(map[string]*AsyncJob, len(jobids))")
+		pn("")
+		pn("	if len(jobids) >= minJobsToList {")
+		pn("		if err := cs.listJobs(jobids, jobs); err != nil {")
+		pn("			return nil, err")
+		pn("		}")
+		pn("	}")
+		pn("")
+		pn("	for _, id := range jobids {")
+		pn("		if _, found := jobs[id]; found {")
+		pn("			continue")
+		pn("		}")
+		pn("")
+		pn("		resp, err := cs.newRequest(\"queryAsyncJobResult\", cs.Asyncjob.NewQueryAsyncJobResultParams(id).toURLValues())")
+		pn("		if err != nil {")
+		pn("			return nil, err")
+		pn("		}")
+		pn("")
+		pn("		var j AsyncJob")
+		pn("		if err := json.Unmarshal(resp, &j); err != nil {")
+		pn("			return nil, err")
+		pn("		}")
+		pn("		jobs[id] = &j")
+		pn("	}")
+		pn("")
+		pn("	return jobs, nil")
+		pn("}")
+		pn("")
+		pn("// Adds the listed jobs with the given IDs to the jobs. When listAsyncJobs is not available to the caller")
+		pn("// (error code 432) no jobs are added, so all jobs are queried one by one instead.")
+		pn("func (cs *CloudStackClient) listJobs(jobids []string, jobs map[string]*AsyncJob) error {")
+		pn("	p := cs.Asyncjob.NewListAsyncJobsParams()")
+		pn("	p.SetListall(true)")
+		pn("")
+		pn("	// The listApis response doesn't include the jobid field of the listed jobs,")
+		pn("	// so decode it next to the generated AsyncJob type")
+		pn("	var l struct {")
+		pn("		AsyncJobs []struct {")
+		pn("			JobID string `json:\"jobid\"`")
+		pn("			AsyncJob")
+		pn("		} `json:\"asyncjobs\"`")
+		pn("	}")
+		pn("")
+		pn("	resp, err := cs.newRequest(\"listAsyncJobs\", p.toURLValues())")
+		pn("	if err != nil {")
+		pn("		var ae *APIError")
+		pn("		if errors.As(err, &ae) && ae.ErrorCode == 432 {")
+		pn("			return nil")
+		pn("		}")
+		pn("		return err")
+		pn("	}")
+		pn("	if err := json.Unmarshal(resp, &l); err != nil {")
+		pn("		return err")
+		pn("	}")
+		pn("")
+		pn("	wanted := make(map[string]bool, len(jobids))")
+		pn("	for _, id := range jobids {")
+		pn("		wanted[id] = true")
+		pn("	}")
+		pn("	for i := range l.AsyncJobs {")
+		pn("		if j := &l.AsyncJobs[i]; wanted[j.JobID] {")
+		pn("			jobs[j.JobID] = &j.AsyncJob")
+		pn("		}")
+		pn("	}")
+		pn("	return nil")
+		pn("}")
+		pn("")
+	}
+	if s.name == "EventService" {
+		pn("// WatchEvents polls for new events every interval, starting with the events created since the given time,")
+		pn("// and emits every event once on the returned channel in the order they were created. Pagination is handled")
+		pn("// internally and failed polls are retried on the next interval. The channel is closed when the context is")
+		pn("// done. The since time is sent as is, so it should be in the timezone of the management server.")
+		pn("func (s *EventService) WatchEvents(ctx context.Context, since time.Time, interval time.Duration, opts ...OptionFunc) <-chan *Event {")
+		pn("	ch := make(chan *Event)")
+		pn("")
+		pn("	go func() {")
+		pn("		defer close(ch)")
+		pn("")
+		pn("		cursor := since")
+		pn("")
+		pn("		// The events seen at or after the cursor, used to dedupe events")
+		pn("		seen := make(map[string]time.Time)")
+		pn("")
+		pn("		for {")
+		pn("			events, err := s.listEventsSince(cursor, opts...)")
+		pn("			if err == nil {")
+		pn("				for _, e := range events {")
+		pn("					created, err := time.Parse(\"2006-01-02T15:04:05-0700\", e.Created)")
+		pn("					if err != nil {")
+		pn("						created = cursor")
+		pn("					}")
+		pn("					if created.Before(cursor) {")
+		pn("						continue")
+		pn("					}")
+		pn("					if _, found := seen[e.Id]; found {")
+		pn("						continue")
+		pn("					}")
+		pn("					seen[e.Id] = created")
+		pn("")
+		pn("					select {")
+		pn("					case ch <- e:")
+		pn("					case <-ctx.Done():")
+		pn("						return")
+		pn("					}")
+		pn("")
+		pn("					if created.After(cursor) {")
+		pn("						cursor = created")
+		pn("					}")
+		pn("				}")
+		pn("")
+		pn("				for id, created := range seen {")
+		pn("					if created.Before(cursor) {")
+		pn("						delete(seen, id)")
+		pn("					}")
+		pn("				}")
+		pn("			}")
+		pn("")
+		pn("			select {")
+		pn("			case <-ctx.Done():")
+		pn("				return")
+		pn("			case <-time.After(interval):")
+		pn("			}")
+		pn("		}")
+		pn("	}()")
+		pn("")
+		pn("	return ch")
+		pn("}")
+		pn("")
+		pn("// Lists all events created since the given time, sorted by the time they were created")
+		pn("func (s *EventService) listEventsSince(since time.Time, opts ...OptionFunc) ([]*Event, error) {")
+		pn("	var events []*Event")
+		pn("")
+		pn("	for page := 1; ; page++ {")
+		pn("		p := s.NewListEventsParams()")
+		pn("		p.SetStartdate(since.Format(\"2006-01-02 15:04:05\"))")
+		pn("		p.SetPage(page)")
+		pn("		p.SetPagesize(500)")
+		pn("")
+		pn("		for _, fn := range s.cs.withDefaultOptions(opts) {")
+		pn("			if err := fn(s.cs, p); err != nil {")
+		pn("				return nil, err")
+		pn("			}")
+		pn("		}")
+		pn("")
+		pn("		l, err := s.ListEvents(p)")
+		pn("		if err != nil {")
+		pn("			return nil, err")
+		pn("		}")
+		pn("		events = append(events, l.Events...)")
+		pn("")
+		pn("		if len(l.Events) < 500 || len(events) >= l.Count {")
+		pn("			break")
+		pn("		}")
+		pn("	}")
+		pn("")
+		pn("	sort.SliceStable(events, func(i, j int) bool {")
+		pn("		return events[i].Created < events[j].Created")
+		pn("	})")
+		pn("")
+		pn("	return events, nil")
+		pn("}")
+		pn("")
+	}
+	if s.name == "AddressService" {
+		pn("// AllocateAndWait associates a new public IP address in the given zone, waits for the async job")
+		pn("// to finish and then waits until the IP address is allocated. The options are applied to the")
+		pn("// associate IP address params and used when getting the IP address.")
+		pn("func (s *AddressService) AllocateAndWait(zoneid string, opts ...OptionFunc) (*PublicIpAddress, error) {")
+		pn("	p := s.NewAssociateIpAddressParams()")
+		pn("	p.SetZoneid(zoneid)")
+		pn("")
+		pn("	for _, fn := range s.cs.withDefaultOptions(opts) {")
+		pn("		if err := fn(s.cs, p); err != nil {")
+		pn("			return nil, err")
+		pn("		}")
+		pn("	}")
+		pn("")
+		pn("	r, err := s.AssociateIpAddress(p)")
+		pn("	if err != nil {")
+		pn("		return nil, fmt.Errorf(\"Failed to associate a public IP address in zone %%s: %%v\", zoneid, err)")
+		pn("	}")
+		pn("")
+		pn("	// An async client already waited for the job to finish")
+		pn("	if !s.cs.async {")
+		pn("		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)")
+		pn("		if err != nil {")
+		pn("			return nil, fmt.Errorf(\"Failed to associate a public IP address in zone %%s: %%v\", zoneid, err)")
+		pn("		}")
+		pn("		if b, err = getRawValue(b); err != nil {")
+		pn("			return nil, err")
+		pn("		}")
+		pn("		if err := json.Unmarshal(b, r); err != nil {")
+		pn("			return nil, err")
+		pn("		}")
+		pn("	}")
+		pn("")
+		pn("	if r.Id == \"\" {")
+		pn("		return nil, fmt.Errorf(\"Failed to associate a public IP address in zone %%s: no ID returned\", zoneid)")
+		pn("	}")
+		pn("")
+		pn("	deadline := time.Now().Add(time.Duration(s.cs.timeout) * time.Second)")
+		pn("	for {")
+		pn("		ip, _, err := s.GetPublicIpAddressByID(r.Id, opts...)")
+		pn("		if err != nil {")
+		pn("			return nil, err")
+		pn("		}")
+		pn("")
+		pn("		switch ip.State {")
+		pn("		case \"Allocated\":")
+		pn("			return ip, nil")
+		pn("		case \"Allocating\":")
+		pn("		default:")
+		pn("			return nil, fmt.Errorf(\"Public IP address %%s is in unexpected state %%s\", ip.Ipaddress, ip.State)")
+		pn("		}")
+		pn("")
+		pn("		if time.Now().After(deadline) {")
+		pn("			return nil, AsyncTimeoutErr")
+		pn("		}")
+		pn("")
+		pn("		time.Sleep(2 * time.Second)")
+		pn("	}")
+		pn("}")
+		pn("")
+	}
+	if s.name == "GuestOSService" {
+		pn("// OSTypeName returns the description of the OS type with the given ID. All OS types are listed")
+		pn("// once and cached on the service, use RefreshOSTypes to list them again.")
+		pn("func (s *GuestOSService) OSTypeName(id string) (string, error) {")
+		pn("	osTypes, err := s.osTypes()")
+		pn("	if err != nil {")
+		pn("		return \"\", err")
+		pn("	}")
+		pn("")
+		pn("	for _, o := range osTypes {")
+		pn("		if o.Id == id {")
+		pn("			return o.Description, nil")
+		pn("		}")
+		pn("	}")
+		pn("	return \"\", fmt.Errorf(\"%%w for OS type ID %%s\", ErrNotFound, id)")
+		pn("}")
+		pn("")
+		pn("// OSTypeID returns the ID of the OS type with the given description, ignoring case. All OS types")
+		pn("// are listed once and cached on the service, use RefreshOSTypes to list them again.")
+		pn("func (s *GuestOSService) OSTypeID(description string) (string, error) {")
+		pn("	osTypes, err := s.osTypes()")
+		pn("	if err != nil {")
+		pn("		return \"\", err")
+		pn("	}")
+		pn("")
+		pn("	for _, o := range osTypes {")
+		pn("		if strings.EqualFold(o.Description, description) {")
+		pn("			return o.Id, nil")
+		pn("		}")
+		pn("	}")
+		pn("	return \"\", fmt.Errorf(\"%%w for OS type %%s\", ErrNotFound, description)")
+		pn("}")
+		pn("")
+		pn("// RefreshOSTypes lists all OS types again and replaces the cached OS types")
+		pn("func (s *GuestOSService) RefreshOSTypes() error {")
+		pn("	s.cache.invalidate()")
+		pn("	_, err := s.osTypes()")
+		pn("	return err")
+		pn("}")
+		pn("")
+		pn("func (s *GuestOSService) osTypes() ([]*OsType, error) {")
+		pn("	if v, found := s.cache.get(\"ostypes\"); found {")
+		pn("		return v.([]*OsType), nil")
+		pn("	}")
+		pn("")
+		pn("	l, err := s.ListOsTypes(s.NewListOsTypesParams())")
+		pn("	if err != nil {")
+		pn("		return nil, err")
+		pn("	}")
+		pn("")
+		pn("	s.cache.set(\"ostypes\", l.OsTypes)")
+		pn("")
+		pn("	return l.OsTypes, nil")
+		pn("}")
+		pn("")
+	}
+	if s.name == "VolumeService" {
+		pn("// ExtractToFile extracts the volume from the given zone using the given mode (which should be HTTP_DOWNLOAD),")
+		pn("// waits for the async job to finish and downloads the extracted volume to destPath.")
+		pn("func (s *VolumeService) ExtractToFile(volumeid, zoneid, mode, destPath string) error {")
+		pn("	r, err := s.ExtractVolume(s.NewExtractVolumeParams(volumeid, mode, zoneid))")
+		pn("	if err != nil {")
+		pn("		return err")
+		pn("	}")
+		pn("")
+		pn("	// An async client already waited for the job to finish")
+		pn("	if !s.cs.async {")
+		pn("		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)")
+		pn("		if err != nil {")
+		pn("			return err")
+		pn("		}")
+		pn("		if b, err = getRawValue(b); err != nil {")
+		pn("			return err")
+		pn("		}")
+		pn("		if err := json.Unmarshal(b, r); err != nil {")
+		pn("			return err")
+		pn("		}")
+		pn("	}")
+		pn("")
+		pn("	if r.Url == \"\" {")
+		pn("		return fmt.Errorf(\"No download URL returned when extracting volume %%s\", volumeid)")
+		pn("	}")
+		pn("	return s.cs.downloadToFile(r.Url, destPath)")
+		pn("}")
+		pn("// AttachAndWait attaches the volume to the virtual machine, waits for the async job to finish and")
+		pn("// returns the updated volume. The options are used when getting the updated volume.")
+		pn("func (s *VolumeService) AttachAndWait(volumeid, vmid string, opts ...OptionFunc) (*Volume, error) {")
+		pn("	r, err := s.AttachVolume(s.NewAttachVolumeParams(volumeid, vmid))")
+		pn("	if err != nil {")
+		pn("		return nil, err")
+		pn("	}")
+		pn("")
+		pn("	return s.waitForVolumeJob(r.JobID, volumeid, opts...)")
+		pn("}")
+		pn("")
+		pn("// DetachAndWait detaches the volume from its virtual machine, waits for the async job to finish and")
+		pn("// returns the updated volume. The options are used when getting the updated volume.")
+		pn("func (s *VolumeService) DetachAndWait(volumeid string, opts ...OptionFunc) (*Volume, error) {")
+		pn("	p := s.NewDetachVolumeParams()")
+		pn("	p.SetId(volumeid)")
+		pn("")
+		pn("	r, err := s.DetachVolume(p)")
+		pn("	if err != nil {")
+		pn("		return nil, err")
+		pn("	}")
+		pn("")
+		pn("	return s.waitForVolumeJob(r.JobID, volumeid, opts...)")
+		pn("}")
+		pn("")
+		pn("// ResizeAndWait resizes the volume to the given size in GB, waits for the async job to finish and")
+		pn("// returns the updated volume. The options are used when getting the updated volume.")
+		pn("func (s *VolumeService) ResizeAndWait(volumeid string, newSizeGB int64, opts ...OptionFunc) (*Volume, error) {")
+		pn("	p := s.NewResizeVolumeParams(volumeid)")
+		pn("	p.SetSize(newSizeGB)")
+		pn("")
+		pn("	r, err := s.ResizeVolume(p)")
+		pn("	if err != nil {")
+		pn("		return nil, err")
+		pn("	}")
+		pn("")
+		pn("	return s.waitForVolumeJob(r.JobID, volumeid, opts...)")
+		pn("}")
+		pn("")
+		pn("func (s *VolumeService) waitForVolumeJob(jobid, volumeid string, opts ...OptionFunc) (*Volume, error) {")
+		pn("	// An async client already waited for the job to finish")
+		pn("	if !s.cs.async {")
+		pn("		if _, err := s.cs.GetAsyncJobResult(jobid, s.cs.timeout); err != nil {")
+		pn("			return nil, err")
+		pn("		}")
+		pn("	}")
+		pn("")
+		pn("	v, _, err := s.GetVolumeByID(volumeid, opts...)")
+		pn("	return v, err")
+		pn("}")
+		pn("")
+	}
+	if s.name == "SystemVMService" {
+		pn("// MigrateSystemVMAndWait migrates the system VM to the given host, waits for the async job to finish")
+		pn("// and returns the updated system VM. The options are used when getting the updated system VM.")
+		pn("func (s *SystemVMService) MigrateSystemVMAndWait(systemvmid, hostid string, opts ...OptionFunc) (*SystemVm, error) {")
+		pn("	if err := s.migrateAndWait(systemvmid, hostid); err != nil {")
+		pn("		return nil, err")
+		pn("	}")
+		pn("")
+		pn("	vm, _, err := s.GetSystemVmByID(systemvmid, opts...)")
+		pn("	return vm, err")
+		pn("}")
+		pn("")
+		pn("// Migrates the system VM or router to the given host and waits for the async job to finish")
+		pn("func (s *SystemVMService) migrateAndWait(id, hostid string) error {")
+		pn("	r, err := s.MigrateSystemVm(s.NewMigrateSystemVmParams(hostid, id))")
+		pn("	if err == nil && !s.cs.async {")
+		pn("		// An async client already waited for the job to finish")
+		pn("		_, err = s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)")
+		pn("	}")
+		pn("	if err != nil {")
+		pn("		if IsInsufficientCapacity(err) {")
+		pn("			return fmt.Errorf(\"Insufficient capacity to migrate %%s to host %%s: %%w\", id, hostid, err)")
+		pn("		}")
+		pn("		return fmt.Errorf(\"Failed to migrate %%s to host %%s: %%w\", id, hostid, err)")
+		pn("	}")
+		pn("	return nil")
+		pn("}")
+		pn("")
+	}
+	if s.name == "RouterService" {
+		pn("// MigrateRouterAndWait migrates the virtual router to the given host, waits for the async job to finish")
+		pn("// and returns the updated router. The options are used when getting the updated router.")
+		pn("func (s *RouterService) MigrateRouterAndWait(routerid, hostid string, opts ...OptionFunc) (*Router, error) {")
+		pn("	// Virtual routers are system VMs, so they are migrated using migrateSystemVm")
+		pn("	if err := s.cs.SystemVM.migrateAndWait(routerid, hostid); err != nil {")
+		pn("		return nil, err")
+		pn("	}")
+		pn("")
+		pn("	r, _, err := s.GetRouterByID(routerid, opts...)")
+		pn("	return r, err")
+		pn("}")
+		pn("")
+	}
+	if s.name == "UsageService" {
+		pn("// UsageDateFormat is the format of the start and end dates of the usage records")
+		pn("const UsageDateFormat = \"2006-01-02\"")
+		pn("")
+		pn("// RawUsageValue returns the raw usage of the record as a number")
+		pn("func (r *UsageRecord) RawUsageValue() (float64, error) {")
+		pn("	return strconv.ParseFloat(strings.TrimSpace(r.Rawusage), 64)")
+		pn("}")
+		pn("")
+		pn("// UsageValue returns the usage of the record as a number together with its unit, for example")
+		pn("// 24 and \"Hrs\" for a usage of \"24 Hrs\"")
+		pn("func (r *UsageRecord) UsageValue() (float64, string, error) {")
+		pn("	fields := strings.Fields(r.Usage)")
+		pn("	if len(fields) == 0 {")
+		pn("		return 0, \"\", fmt.Errorf(\"Unable to parse usage %%q\", r.Usage)")
+		pn("	}")
+		pn("")
+		pn("	v, err := strconv.ParseFloat(fields[0], 64)")
+		pn("	if err != nil {")
+		pn("		return 0, \"\", fmt.Errorf(\"Unable to parse usage %%q: %%v\", r.Usage, err)")
+		pn("	}")
+		pn("")
+		pn("	return v, strings.Join(fields[1:], \" \"), nil")
+		pn("}")
+		pn("")
+		pn("// Checks the start and end date of the params before ListAllUsageRecords lists the usage records page")
+		pn("// by page, as the dates should be formatted using UsageDateFormat, optionally followed by a time")
+		pn("// (\"2006-01-02 15:04:05\")")
+		pn("func checkUsageDates(p *ListUsageRecordsParams) error {")
+		pn("	for _, k := range []string{\"startdate\", \"enddate\"} {")
+		pn("		d, _ := p.p[k].(string)")
+		pn("		if _, err := time.Parse(UsageDateFormat, d); err != nil {")
+		pn("			if _, err := time.Parse(UsageDateFormat+\" 15:04:05\", d); err != nil {")
+		pn("				return fmt.Errorf(\"Invalid usage %%s %%q, expected the format %%s\", k, d, UsageDateFormat)")
+		pn("			}")
+		pn("		}")
+		pn("	}")
+		pn("	return nil")
+		pn("}")
+		pn("")
+	}
+	if s.name == "VPCService" {
+		pn("// VPCTierSpec describes a VPC with a single network tier, as created by CreateVPCWithTier")
+		pn("type VPCTierSpec struct {")
+		pn("	ZoneID        string")
+		pn("	VPCName       string // Used as the name and display text of the VPC")
+		pn("	VPCCIDR       string")
+		pn("	VPCOfferingID string")
+		pn("")
+		pn("	TierName          string // Used as the name and display text of the tier")
+		pn("	TierGateway       string")
+		pn("	TierNetmask       string")
+		pn("	NetworkOfferingID string")
+		pn("")
+		pn("	// When ACL rules are given, a new ACL list named after the tier is created with these rules and")
+		pn("	// used for the tier. Otherwise the tier uses the default ACL list of the network offering.")
+		pn("	ACLRules []*CreateNetworkACLParams")
+		pn("}")
+		pn("")
+		pn("// CreateVPCWithTier creates a VPC, an ACL list with the given rules (if any) and a network tier in")
+		pn("// the VPC using that ACL list, waiting for every async job to finish. The options are applied to")
+		pn("// the params of every command that supports them.")
+		pn("//")
+		pn("// If any step fails, the rollback is best-effort: the already created ACL list and VPC are deleted")
+		pn("// in reverse order, but when the rollback also fails, the error describes which resources may be")
+		pn("// left behind and the caller has to clean them up. When everything was created but getting the VPC or")
+		pn("// network tier fails, nothing is rolled back and the error contains the IDs of both.")
+		pn("func (s *VPCService) CreateVPCWithTier(spec VPCTierSpec, opts ...OptionFunc) (*VPC, *Network, error) {")
+		pn("	var rollback []func() error")
+		pn("	fail := func(err error) (*VPC, *Network, error) {")
+		pn("		for i := len(rollback) - 1; i >= 0; i-- {")
+		pn("			if rerr := rollback[i](); rerr != nil {")
+		pn("				err = fmt.Errorf(\"%%v (rollback failed: %%v)\", err, rerr)")
+		pn("			}")
+		pn("		}")
+		pn("		return nil, nil, err")
+		pn("	}")
+		pn("	apply := func(p interface{}) error {")
+		pn("		for _, fn := range s.cs.withDefaultOptions(opts) {")
+		pn("			if err := fn(s.cs, p); err != nil {")
+		pn("				return err")
+		pn("			}")
+		pn("		}")
+		pn("		return nil")
+		pn("	}")
+		pn("")
+		pn("	vp := s.NewCreateVPCParams(spec.VPCCIDR, spec.VPCName, spec.VPCName, spec.VPCOfferingID, spec.ZoneID)")
+		pn("	if err := apply(vp); err != nil {")
+		pn("		return nil, nil, err")
+		pn("	}")
+		pn("	vr, err := s.CreateVPC(vp)")
+		pn("	if err == nil {")
+		pn("		err = s.waitForJob(vr.JobID)")
+		pn("	}")
+		pn("	if err != nil {")
+		pn("		return fail(fmt.Errorf(\"Failed to create VPC %%s: %%v\", spec.VPCName, err))")
+		pn("	}")
+		pn("	rollback = append(rollback, func() error {")
+		pn("		r, err := s.DeleteVPC(s.NewDeleteVPCParams(vr.Id))")
+		pn("		if err == nil {")
+		pn("			err = s.waitForJob(r.JobID)")
+		pn("		}")
+		pn("		if err != nil {")
+		pn("			return fmt.Errorf(\"VPC %%s may be left behind: %%v\", vr.Id, err)")
+		pn("		}")
+		pn("		return nil")
+		pn("	})")
+		pn("")
+		pn("	var aclid string")
+		pn("	if len(spec.ACLRules) > 0 {")
+		pn("		ap := s.cs.NetworkACL.NewCreateNetworkACLListParams(spec.TierName, vr.Id)")
+		pn("		if err := apply(ap); err != nil {")
+		pn("			return fail(err)")
+		pn("		}")
+		pn("		ar, err := s.cs.NetworkACL.CreateNetworkACLList(ap)")
+		pn("		if err == nil {")
+		pn("			err = s.waitForJob(ar.JobID)")
+		pn("		}")
+		pn("		if err != nil {")
+		pn("			return fail(fmt.Errorf(\"Failed to create ACL list %%s: %%v\", spec.TierName, err))")
+		pn("		}")
+		pn("		aclid = ar.Id")
+		pn("		rollback = append(rollback, func() error {")
+		pn("			r, err := s.cs.NetworkACL.DeleteNetworkACLList(s.cs.NetworkACL.NewDeleteNetworkACLListParams(aclid))")
+		pn("			if err == nil {")
+		pn("				err = s.waitForJob(r.JobID)")
+		pn("			}")
+		pn("			if err != nil {")
+		pn("				return fmt.Errorf(\"ACL list %%s may be left behind: %%v\", aclid, err)")
+		pn("			}")
+		pn("			return nil")
+		pn("		})")
+		pn("")
+		pn("		for _, rule := range spec.ACLRules {")
+		pn("			// Set the ACL list on a copy, so the params of the caller are not changed")
+		pn("			rp := rule.Clone()")
+		pn("			rp.SetAclid(aclid)")
+		pn("			rr, err := s.cs.NetworkACL.CreateNetworkACL(rp)")
+		pn("			if err == nil {")
+		pn("				err = s.waitForJob(rr.JobID)")
+		pn("			}")
+		pn("			if err != nil {")
+		pn("				return fail(fmt.Errorf(\"Failed to create ACL rule in ACL list %%s: %%v\", spec.TierName, err))")
+		pn("			}")
+		pn("		}")
+		pn("	}")
+		pn("")
+		pn("	np := s.cs.Network.NewCreateNetworkParams(spec.TierName, spec.TierName, spec.NetworkOfferingID, spec.ZoneID)")
+		pn("	np.SetVpcid(vr.Id)")
+		pn("	np.SetGateway(spec.TierGateway)")
+		pn("	np.SetNetmask(spec.TierNetmask)")
+		pn("	if aclid != \"\" {")
+		pn("		np.SetAclid(aclid)")
+		pn("	}")
+		pn("	if err := apply(np); err != nil {")
+		pn("		return fail(err)")
+		pn("	}")
+		pn("	nr, err := s.cs.Network.CreateNetwork(np)")
+		pn("	if err != nil {")
+		pn("		return fail(fmt.Errorf(\"Failed to create network tier %%s: %%v\", spec.TierName, err))")
+		pn("	}")
+		pn("")
+		pn("	// Everything was created, so report the created resources instead of rolling back")
+		pn("	vpc, _, err := s.GetVPCByID(vr.Id, opts...)")
+		pn("	if err != nil {")
+		pn("		return nil, nil, fmt.Errorf(\"Created VPC %%s with network tier %%s, but failed to get the VPC: %%w\", vr.Id, nr.Id, err)")
+		pn("	}")
+		pn("	network, _, err := s.cs.Network.GetNetworkByID(nr.Id, opts...)")
+		pn("	if err != nil {")
+		pn("		return nil, nil, fmt.Errorf(\"Created VPC %%s with network tier %%s, but failed to get the network tier: %%w\", vr.Id, nr.Id, err)")
+		pn("	}")
+		pn("")
+		pn("	return vpc, network, nil")
+		pn("}")
+		pn("")
+		pn("func (s *VPCService) waitForJob(jobid string) error {")
+		pn("	// An async client already waited for the job to finish")
+		pn("	if s.cs.async {")
+		pn("		return nil")
+		pn("	}")
+		pn("	_, err := s.cs.GetAsyncJobResult(jobid, s.cs.timeout)")
+		pn("	return err")
+		pn("}")
+		pn("")
+	}
+	if s.name == "UserService" {
+		pn("// RotateKeys generates a new api and secret key for the given user and returns them. The old keys")
+		pn("// of the user stop working immediately, so if the client itself uses the keys of this user, pass")
+		pn("// true for updateClient to make the client sign all following requests with the new keys.")
+		pn("func (s *UserService) RotateKeys(userid string, updateClient bool) (string, string, error) {")
+		pn("	r, err := s.RegisterUserKeys(s.NewRegisterUserKeysParams(userid))")
+		pn("	if err != nil {")
+		pn("		return \"\", \"\", err")
+		pn("	}")
+		pn("	if r.Apikey == \"\" || r.Secretkey == \"\" {")
+		pn("		return \"\", \"\", fmt.Errorf(\"No keys returned when registering new keys for user %%s\", userid)")
+		pn("	}")
+		pn("")
+		pn("	if updateClient {")
+		pn("		s.cs.setCredentials(r.Apikey, r.Secretkey)")
+		pn("	}")
+		pn("")
+		pn("	return r.Apikey, r.Secretkey, nil")
+		pn("}")
+	}
+
+	if s.name == "VLANService" {
+		pn("// The first and last valid VLAN ID")
+		pn("const (")
+		pn("	minVlanID = 1")
+		pn("	maxVlanID = 4094")
+		pn(")")
+		pn("")
+		pn("// FindFreeVlanRange lists the VLAN IP ranges of the given zone and returns the first and last VLAN")
+		pn("// ID of the first range of desiredSize consecutive VLAN IDs which is not used by any of them. This")
+		pn("// only looks up a free range, so nothing is created or reserved. VLANs which are used by other means")
+		pn("// than a VLAN IP range (e.g. by guest networks) are not taken into account.")
+		pn("func (s *VLANService) FindFreeVlanRange(zoneid string, desiredSize int, opts ...OptionFunc) (string, string, error) {")
+		pn("	if desiredSize < 1 || desiredSize > maxVlanID-minVlanID+1 {")
+		pn("		return \"\", \"\", fmt.Errorf(\"Invalid VLAN range size %%d, expected a size between 1 and %%d\", desiredSize, maxVlanID-minVlanID+1)")
+		pn("	}")
+		pn("")
+		pn("	var used [][2]int")
+		pn("	for page, count := 1, 0; ; page++ {")
+		pn("		p := s.NewListVlanIpRangesParams()")
+		pn("		p.SetZoneid(zoneid)")
+		pn("		p.SetPage(page)")
+		pn("		p.SetPagesize(500)")
+		pn("")
+		pn("		for _, fn := range s.cs.withDefaultOptions(opts) {")
+		pn("			if err := fn(s.cs, p); err != nil {")
+		pn("				return \"\", \"\", err")
+		pn("			}")
+		pn("		}")
+		pn("")
+		pn("		l, err := s.ListVlanIpRanges(p)")
+		pn("		if err != nil {")
+		pn("			return \"\", \"\", err")
+		pn("		}")
+		pn("		for _, r := range l.VlanIpRanges {")
+		pn("			if start, end, ok := parseVlanRange(r.Vlan); ok {")
+		pn("				used = append(used, [2]int{start, end})")
+		pn("			}")
+		pn("		}")
+		pn("")
+		pn("		count += len(l.VlanIpRanges)")
+		pn("		if len(l.VlanIpRanges) < 500 || count >= l.Count {")
+		pn("			break")
+		pn("		}")
+		pn("	}")
+		pn("")
+		pn("	sort.Slice(used, func(i, j int) bool {")
+		pn("		return used[i][0] < used[j][0]")
+		pn("	})")
+		pn("")
+		pn("	// Walk the used ranges in order and stop at the first gap that is big enough")
+		pn("	next := minVlanID")
+		pn("	for _, u := range used {")
+		pn("		if u[0]-next >= desiredSize {")
+		pn("			break")
+		pn("		}")
+		pn("		if u[1] >= next {")
+		pn("			next = u[1] + 1")
+		pn("		}")
+		pn("	}")
+		pn("")
+		pn("	if next+desiredSize-1 > maxVlanID {")
+		pn("		return \"\", \"\", fmt.Errorf(\"No free range of %%d VLANs found in zone %%s\", desiredSize, zoneid)")
+		pn("	}")
+		pn("")
+		pn("	return strconv.Itoa(next), strconv.Itoa(next + desiredSize - 1), nil")
+		pn("}")
+		pn("")
+		pn("// Parses a VLAN as returned by listVlanIpRanges (e.g. \"100\", \"vlan://100\" or \"vlan://100-200\")")
+		pn("// into its first and last VLAN ID. Returns false for untagged or otherwise unparsable VLANs.")
+		pn("func parseVlanRange(vlan string) (int, int, bool) {")
+		pn("	parts := strings.SplitN(strings.TrimPrefix(vlan, \"vlan://\"), \"-\", 2)")
+		pn("")
+		pn("	start, err := strconv.Atoi(strings.TrimSpace(parts[0]))")
+		pn("	if err != nil {")
+		pn("		return 0, 0, false")
+		pn("	}")
+		pn("")
+		pn("	end := start")
+		pn("	if len(parts) == 2 {")
+		pn("		if end, err = strconv.Atoi(strings.TrimSpace(parts[1])); err != nil {")
+		pn("			return 0, 0, false")
+		pn("		}")
+		pn("	}")
+		pn("	if end < start {")
+		pn("		start, end = end, start")
+		pn("	}")
+		pn("")
+		pn("	return start, end, true")
+		pn("}")
+	}
+
+	if s.name == "LimitService" {
+		pn("// IsUnlimited returns true if the resource limit has no maximum")
+		pn("func (r *ResourceLimit) IsUnlimited() bool {")
+		pn("	return isUnlimitedMax(%s)", s.cfg.value("r.Max"))
+		pn("}")
+		pn("")
+		pn("// RemainingPercent returns the percentage of the resource limit which is still available given the")
+		pn("// used amount, between 0 and 100. An unlimited resource always has 100 percent remaining.")
+		pn("func (r *ResourceLimit) RemainingPercent(used int64) float64 {")
+		pn("	return remainingPercent(%s, used)", s.cfg.value("r.Max"))
+		pn("}")
+		pn("")
+		pn("// IsUnlimited returns true if the updated resource limit has no maximum")
+		pn("func (r *UpdateResourceLimitResponse) IsUnlimited() bool {")
+		pn("	return isUnlimitedMax(%s)", s.cfg.value("r.Max"))
+		pn("}")
+		pn("")
+		pn("// RemainingPercent returns the percentage of the updated resource limit which is still available")
+		pn("// given the used amount, between 0 and 100. An unlimited resource always has 100 percent remaining.")
+		pn("func (r *UpdateResourceLimitResponse) RemainingPercent(used int64) float64 {")
+		pn("	return remainingPercent(%s, used)", s.cfg.value("r.Max"))
+		pn("}")
+		pn("")
+		pn("// Returns true if the max of a resource limit is the unlimited sentinel")
+		pn("func isUnlimitedMax(max int64) bool {")
+		pn("	return strconv.FormatInt(max, 10) == UnlimitedResourceID")
+		pn("}")
+		pn("")
+		pn("// Returns the percentage of max which is still available given the used amount")
+		pn("func remainingPercent(max int64, used int64) float64 {")
+		pn("	if isUnlimitedMax(max) {")
+		pn("		return 100")
+		pn("	}")
+		pn("	if max <= 0 || used >= max {")
+		pn("		return 0")
+		pn("	}")
+		pn("	if used <= 0 {")
+		pn("		return 100")
+		pn("	}")
+		pn("	return float64(max-used) / float64(max) * 100")
+		pn("}")
+	}
+
+	if s.name == "HostService" {
+		pn("// ErrHostMaintenanceFailed is returned (wrapped) by EnterMaintenanceAndWait and CancelMaintenanceAndWait")
+		pn("// when the host ends up in an error state, for example because not all virtual machines running on the")
+		pn("// host could be migrated to other hosts")
+		pn("var ErrHostMaintenanceFailed = errors.New(\"Host maintenance failed\")")
+		pn("")
+		pn("// EnterMaintenanceAndWait prepares the host for maintenance and polls until its resource state is")
+		pn("// Maintenance or the timeout expires (a timeout of 0 means no timeout). When the host ends up in an")
+		pn("// error state instead, the host is returned together with an ErrHostMaintenanceFailed error.")
+		pn("func (s *HostService) EnterMaintenanceAndWait(hostid string, timeout time.Duration, opts ...OptionFunc) (*Host, error) {")
+		pn("	r, err := s.PrepareHostForMaintenance(s.NewPrepareHostForMaintenanceParams(hostid))")
+		pn("	if err != nil {")
+		pn("		return nil, err")
+		pn("	}")
+		pn("	return s.waitForResourceState(context.Background(), r.JobID, hostid, \"Maintenance\", timeout, opts...)")
+		pn("}")
+		pn("")
+		pn("// CancelMaintenanceAndWait cancels the maintenance of the host and polls until its resource state is")
+		pn("// Enabled or the timeout expires (a timeout of 0 means no timeout). When the host ends up in an error")
+		pn("// state instead, the host is returned together with an ErrHostMaintenanceFailed error.")
+		pn("func (s *HostService) CancelMaintenanceAndWait(hostid string, timeout time.Duration, opts ...OptionFunc) (*Host, error) {")
+		pn("	r, err := s.CancelHostMaintenance(s.NewCancelHostMaintenanceParams(hostid))")
+		pn("	if err != nil {")
+		pn("		return nil, err")
+		pn("	}")
+		pn("	return s.waitForResourceState(context.Background(), r.JobID, hostid, \"Enabled\", timeout, opts...)")
+		pn("}")
+		pn("")
+		pn("// Waits for the job to finish and then polls until the host reaches the given resource state")
+		pn("func (s *HostService) waitForResourceState(ctx context.Context, jobid, hostid, state string, timeout time.Duration, opts ...OptionFunc) (*Host, error) {")
+		pn("	if timeout > 0 {")
+		pn("		var cancel context.CancelFunc")
+		pn("		ctx, cancel = context.WithTimeout(ctx, timeout)")
+		pn("		defer cancel()")
+		pn("	}")
+		pn("")
+		pn("	// An async client already waited for the job to finish")
+		pn("	if !s.cs.async {")
+		pn("		if _, err := s.cs.GetAsyncJobResult(jobid, s.cs.timeout); err != nil {")
+		pn("			return nil, err")
+		pn("		}")
+		pn("	}")
+		pn("")
+		pn("	for {")
+		pn("		h, _, err := s.GetHostByID(hostid, opts...)")
+		pn("		if err != nil {")
+		pn("			return nil, err")
+		pn("		}")
+		pn("		if h.Resourcestate == state {")
+		pn("			return h, nil")
+		pn("		}")
+		pn("		// E.g. ErrorInMaintenance or ErrorInPrepareForMaintenance")
+		pn("		if strings.HasPrefix(h.Resourcestate, \"Error\") {")
+		pn("			return h, fmt.Errorf(\"%%w: host %%s is in resource state %%s\", ErrHostMaintenanceFailed, hostid, h.Resourcestate)")
+		pn("		}")
+		pn("")
+		pn("		select {")
+		pn("		case <-ctx.Done():")
+		pn("			return h, fmt.Errorf(\"Timeout waiting for host %%s to reach resource state %%s, last state was %%s: %%w\", hostid, state, h.Resourcestate, ctx.Err())")
+		pn("		case <-time.After(2 * time.Second):")
+		pn("		}")
+		pn("	}")
+		pn("}")
+	}
+
+	if s.name == "AccountService" {
+		pn("// AccountInventory contains the resources owned by an account, as listed by ListAccountResources")
+		pn("type AccountInventory struct {")
+		pn("	Account  string")
+		pn("	DomainID string")
+		pn("")
+		pn("	VirtualMachines   []*VirtualMachine")
+		pn("	Volumes           []*Volume")
+		pn("	Networks          []*Network")
+		pn("	PublicIpAddresses []*PublicIpAddress")
+		pn("	Snapshots         []*Snapshot")
+		pn("}")
+		pn("")
+		pn("// The max number of concurrent list calls made by ListAccountResources")
+		pn("const accountResourcesConcurrency = 3")
+		pn("")
+		pn("// The page size used by ListAccountResources")
+		pn("const accountResourcesPageSize = 500")
+		pn("")
+		pn("// ListAccountResources lists the virtual machines, volumes, networks, public IP addresses and snapshots")
+		pn("// owned by the account in the given domain. The resources are listed concurrently (at most 3 list calls")
+		pn("// at a time) and page by page. When listing some of the resources fails, the inventory is returned with")
+		pn("// all other resources together with an error containing every failure.")
+		pn("func (cs *CloudStackClient) ListAccountResources(account, domainid string) (*AccountInventory, error) {")
+		pn("	inv := &AccountInventory{Account: account, DomainID: domainid}")
+		pn("")
+		pn("	// Every list function appends the resources of one page to its own field of the inventory")
+		pn("	// and returns the number of resources in the page and the total number of resources")
+		pn("	lists := []struct {")
+		pn("		name string")
+		pn("		list func(page int) (int, int, error)")
+		pn("	}{")
+		pn("		{\"virtual machines\", func(page int) (int, int, error) {")
+		pn("			p := cs.VirtualMachine.NewListVirtualMachinesParams()")
+		pn("			p.SetAccount(account)")
+		pn("			p.SetDomainid(domainid)")
+		pn("			p.SetPage(page)")
+		pn("			p.SetPagesize(accountResourcesPageSize)")
+		pn("")
+		pn("			l, err := cs.VirtualMachine.ListVirtualMachines(p)")
+		pn("			if err != nil {")
+		pn("				return 0, 0, err")
+		pn("			}")
+		pn("			inv.VirtualMachines = append(inv.VirtualMachines, l.VirtualMachines...)")
+		pn("			return len(l.VirtualMachines), l.Count, nil")
+		pn("		}},")
+		pn("		{\"volumes\", func(page int) (int, int, error) {")
+		pn("			p := cs.Volume.NewListVolumesParams()")
+		pn("			p.SetAccount(account)")
+		pn("			p.SetDomainid(domainid)")
+		pn("			p.SetPage(page)")
+		pn("			p.SetPagesize(accountResourcesPageSize)")
+		pn("")
+		pn("			l, err := cs.Volume.ListVolumes(p)")
+		pn("			if err != nil {")
+		pn("				return 0, 0, err")
+		pn("			}")
+		pn("			inv.Volumes = append(inv.Volumes, l.Volumes...)")
+		pn("			return len(l.Volumes), l.Count, nil")
+		pn("		}},")
+		pn("		{\"networks\", func(page int) (int, int, error) {")
+		pn("			p := cs.Network.NewListNetworksParams()")
+		pn("			p.SetAccount(account)")
+		pn("			p.SetDomainid(domainid)")
+		pn("			p.SetPage(page)")
+		pn("			p.SetPagesize(accountResourcesPageSize)")
+		pn("")
+		pn("			l, err := cs.Network.ListNetworks(p)")
+		pn("			if err != nil {")
+		pn("				return 0, 0, err")
+		pn("			}")
+		pn("			inv.Networks = append(inv.Networks, l.Networks...)")
+		pn("			return len(l.Networks), l.Count, nil")
+		pn("		}},")
+		pn("		{\"public IP addresses\", func(page int) (int, int, error) {")
+		pn("			p := cs.Address.NewListPublicIpAddressesParams()")
+		pn("			p.SetAccount(account)")
+		pn("			p.SetDomainid(domainid)")
+		pn("			p.SetPage(page)")
+		pn("			p.SetPagesize(accountResourcesPageSize)")
+		pn("")
+		pn("			l, err := cs.Address.ListPublicIpAddresses(p)")
+		pn("			if err != nil {")
+		pn("				return 0, 0, err")
+		pn("			}")
+		pn("			inv.PublicIpAddresses = append(inv.PublicIpAddresses, l.PublicIpAddresses...)")
+		pn("			return len(l.PublicIpAddresses), l.Count, nil")
+		pn("		}},")
+		pn("		{\"snapshots\", func(page int) (int, int, error) {")
+		pn("			p := cs.Snapshot.NewListSnapshotsParams()")
+		pn("			p.SetAccount(account)")
+		pn("			p.SetDomainid(domainid)")
+		pn("			p.SetPage(page)")
+		pn("			p.SetPagesize(accountResourcesPageSize)")
+		pn("")
+		pn("			l, err := cs.Snapshot.ListSnapshots(p)")
+		pn("			if err != nil {")
+		pn("				return 0, 0, err")
+		pn("			}")
+		pn("			inv.Snapshots = append(inv.Snapshots, l.Snapshots...)")
+		pn("			return len(l.Snapshots), l.Count, nil")
+		pn("		}},")
+		pn("	}")
+		pn("")
+		pn("	errs := make([]error, len(lists))")
+		pn("	runConcurrently(len(lists), accountResourcesConcurrency, func(i int) {")
+		pn("		for page, total := 1, 0; ; page++ {")
+		pn("			n, count, err := lists[i].list(page)")
+		pn("			if err != nil {")
+		pn("				errs[i] = fmt.Errorf(\"Failed to list the %%s of account %%s: %%v\", lists[i].name, account, err)")
+		pn("				return")
+		pn("			}")
+		pn("			total += n")
+		pn("			if n < accountResourcesPageSize || total >= count {")
+		pn("				return")
+		pn("			}")
+		pn("		}")
+		pn("	})")
+		pn("")
+		pn("	return inv, errors.Join(errs...)")
+		pn("}")
+	}
+
+	if s.name == "SystemCapacityService" {
+		pn("// CapacityAlert is emitted by WatchCapacity when the used percentage of a capacity crosses the threshold")
+		pn("type CapacityAlert struct {")
+		pn("	Capacity    *Capacity")
+		pn("	PercentUsed float64")
+		pn("	Threshold   float64")
+		pn("}")
+		pn("")
+		pn("// PercentUsed returns the used percentage of the capacity as a number")
+		pn("func (c *Capacity) PercentUsed() (float64, error) {")
+		pn("	return strconv.ParseFloat(strings.TrimSpace(c.Percentused), 64)")
+		pn("}")
+		pn("")
+		pn("// WatchCapacity polls the capacity of the given zone (or of all zones when zoneid is empty) every interval")
+		pn("// and emits an alert on the returned channel when the used percentage of a capacity reaches the threshold.")
+		pn("// A capacity (identified by its type, zone, pod and cluster) is only alerted once, until its used percentage")
+		pn("// drops below the threshold again. Failed polls are retried on the next interval. The channel is closed when")
+		pn("// the context is done.")
+		pn("func (s *SystemCapacityService) WatchCapacity(ctx context.Context, zoneid string, interval time.Duration, threshold float64, opts ...OptionFunc) <-chan CapacityAlert {")
+		pn("	ch := make(chan CapacityAlert)")
+		pn("")
+		pn("	go func() {")
+		pn("		defer close(ch)")
+		pn("")
+		pn("		// The capacities which are above the threshold and have been alerted")
+		pn("		alerted := make(map[string]bool)")
+		pn("")
+		pn("		for {")
+		pn("			capacities, err := s.listCapacityOfZone(zoneid, opts...)")
+		pn("			if err == nil {")
+		pn("				for _, c := range capacities {")
+		pn("					used, err := c.PercentUsed()")
+		pn("					if err != nil {")
+		pn("						continue")
+		pn("					}")
+		pn("")
+		pn("					key := fmt.Sprintf(\"%%d/%%s/%%s/%%s\", c.Type, c.Zoneid, c.Podid, c.Clusterid)")
+		pn("					if used < threshold {")
+		pn("						delete(alerted, key)")
+		pn("						continue")
+		pn("					}")
+		pn("					if alerted[key] {")
+		pn("						continue")
+		pn("					}")
+		pn("					alerted[key] = true")
+		pn("")
+		pn("					select {")
+		pn("					case ch <- CapacityAlert{Capacity: c, PercentUsed: used, Threshold: threshold}:")
+		pn("					case <-ctx.Done():")
+		pn("						return")
+		pn("					}")
+		pn("				}")
+		pn("			}")
+		pn("")
+		pn("			select {")
+		pn("			case <-ctx.Done():")
+		pn("				return")
+		pn("			case <-time.After(interval):")
+		pn("			}")
+		pn("		}")
+		pn("	}()")
+		pn("")
+		pn("	return ch")
+		pn("}")
+		pn("")
+		pn("// Lists the capacity of the given zone, or of all zones when zoneid is empty")
+		pn("func (s *SystemCapacityService) listCapacityOfZone(zoneid string, opts ...OptionFunc) ([]*Capacity, error) {")
+		pn("	p := s.NewListCapacityParams()")
+		pn("	if zoneid != \"\" {")
+		pn("		p.SetZoneid(zoneid)")
+		pn("	}")
+		pn("")
+		pn("	for _, fn := range s.cs.withDefaultOptions(opts) {")
+		pn("		if err := fn(s.cs, p); err != nil {")
+		pn("			return nil, err")
+		pn("		}")
+		pn("	}")
+		pn("")
+		pn("	l, err := s.ListCapacity(p)")
+		pn("	if err != nil {")
+		pn("		return nil, err")
+		pn("	}")
+		pn("	return l.Capacity, nil")
+		pn("}")
+	}
+
+	if s.name == "SnapshotService" {
+		pn("// ErrSnapshotFailed is returned (wrapped) by CreateSnapshotAndWaitReady when the snapshot ends up in a")
+		pn("// state in which it will never be backed up")
+		pn("var ErrSnapshotFailed = errors.New(\"Snapshot failed\")")
+		pn("")
+		pn("// CreateSnapshotAndWaitReady creates a snapshot of the volume and polls until its state is BackedUp or")
+		pn("// the timeout expires (a timeout of 0 means no timeout), as the snapshot may still be creating or backing")
+		pn("// up after the async job finished. When the snapshot ends up in the Error or Destroyed state instead, the")
+		pn("// snapshot is returned together with an ErrSnapshotFailed error. The options are used when getting the")
+		pn("// snapshot.")
+		pn("func (s *SnapshotService) CreateSnapshotAndWaitReady(volumeid string, timeout time.Duration, opts ...OptionFunc) (*Snapshot, error) {")
+		pn("	ctx := context.Background()")
+		pn("	if timeout > 0 {")
+		pn("		var cancel context.CancelFunc")
+		pn("		ctx, cancel = context.WithTimeout(ctx, timeout)")
+		pn("		defer cancel()")
+		pn("	}")
+		pn("")
+		pn("	r, err := s.CreateSnapshot(s.NewCreateSnapshotParams(volumeid))")
+		pn("	if err != nil {")
+		pn("		return nil, err")
+		pn("	}")
+		pn("")
+		pn("	// An async client already waited for the job to finish")
+		pn("	if !s.cs.async {")
+		pn("		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)")
+		pn("		if err != nil {")
+		pn("			return nil, err")
+		pn("		}")
+		pn("		if b, err = getRawValueByKey(b, \"snapshot\"); err != nil {")
+		pn("			return nil, err")
+		pn("		}")
+		pn("		if err := json.Unmarshal(b, r); err != nil {")
+		pn("			return nil, err")
+		pn("		}")
+		pn("	}")
+		pn("")
+		pn("	for {")
+		pn("		snapshot, _, err := s.GetSnapshotByID(r.Id, opts...)")
+		pn("		if err != nil {")
+		pn("			return nil, err")
+		pn("		}")
+		pn("		switch snapshot.State {")
+		pn("		case \"BackedUp\":")
+		pn("			return snapshot, nil")
+		pn("		case \"Error\", \"Destroying\", \"Destroyed\":")
+		pn("			return snapshot, fmt.Errorf(\"%%w: snapshot %%s of volume %%s is in state %%s\", ErrSnapshotFailed, r.Id, volumeid, snapshot.State)")
+		pn("		}")
+		pn("")
+		pn("		select {")
+		pn("		case <-ctx.Done():")
+		pn("			return snapshot, fmt.Errorf(\"Timeout waiting for snapshot %%s to be backed up, last state was %%s: %%w\", r.Id, snapshot.State, ctx.Err())")
+		pn("		case <-time.After(2 * time.Second):")
+		pn("		}")
+		pn("	}")
+		pn("}")
+	}
+	if s.name == "SSHService" {
+		pn("// ResetVMKeyAndWait resets the SSH key of the virtual machine to the given key pair, waits for the async")
+		pn("// job to finish and returns the updated virtual machine. The API requires the virtual machine to be stopped,")
+		pn("// so if it is not stopped an error is returned, unless stopAndStart is true. In that case the virtual")
+		pn("// machine is stopped before the reset and started again afterwards (also when the reset failed). The")
+		pn("// options are used when getting the virtual machine.")
+		pn("func (s *SSHService) ResetVMKeyAndWait(vmid, keypairName string, stopAndStart bool, opts ...OptionFunc) (*VirtualMachine, error) {")
+		pn("	vm, _, err := s.cs.VirtualMachine.GetVirtualMachineByID(vmid, opts...)")
+		pn("	if err != nil {")
+		pn("		return nil, err")
+		pn("	}")
+		pn("")
+		pn("	restart := false")
+		pn("	if vm.State != \"Stopped\" {")
+		pn("		if !stopAndStart {")
+		pn("			return nil, fmt.Errorf(\"Virtual machine %%s must be stopped to reset its SSH key, but is %%s\", vmid, vm.State)")
+		pn("		}")
+		pn("		r, err := s.cs.VirtualMachine.StopVirtualMachine(s.cs.VirtualMachine.NewStopVirtualMachineParams(vmid))")
+		pn("		if err == nil {")
+		pn("			err = s.waitForJob(r.JobID)")
+		pn("		}")
+		pn("		if err != nil {")
+		pn("			return nil, fmt.Errorf(\"Failed to stop virtual machine %%s: %%w\", vmid, err)")
+		pn("		}")
+		pn("		restart = true")
+		pn("	}")
+		pn("")
+		pn("	r, err := s.ResetSSHKeyForVirtualMachine(s.NewResetSSHKeyForVirtualMachineParams(vmid, keypairName))")
+		pn("	if err == nil {")
+		pn("		err = s.waitForJob(r.JobID)")
+		pn("	}")
+		pn("	if err != nil {")
+		pn("		err = fmt.Errorf(\"Failed to reset the SSH key of virtual machine %%s: %%w\", vmid, err)")
+		pn("	}")
+		pn("")
+		pn("	if restart {")
+		pn("		r, serr := s.cs.VirtualMachine.StartVirtualMachine(s.cs.VirtualMachine.NewStartVirtualMachineParams(vmid))")
+		pn("		if serr == nil {")
+		pn("			serr = s.waitForJob(r.JobID)")
+		pn("		}")
+		pn("		if serr != nil && err == nil {")
+		pn("			err = fmt.Errorf(\"Failed to start virtual machine %%s: %%w\", vmid, serr)")
+		pn("		}")
+		pn("	}")
+		pn("	if err != nil {")
+		pn("		return nil, err")
+		pn("	}")
+		pn("")
+		pn("	vm, _, err = s.cs.VirtualMachine.GetVirtualMachineByID(vmid, opts...)")
+		pn("	return vm, err")
+		pn("}")
+		pn("")
+		pn("func (s *SSHService) waitForJob(jobid string) error {")
+		pn("	// An async client already waited for the job to finish")
+		pn("	if s.cs.async {")
+		pn("		return nil")
+		pn("	}")
+		pn("	_, err := s.cs.GetAsyncJobResult(jobid, s.cs.timeout)")
+		pn("	return err")
+		pn("}")
+		pn("")
+	}
+	if s.name == "NetworkService" {
+		pn("// ErrRouterFailed is returned (wrapped) by CreateNetworkAndWaitImplemented when a virtual router of the")
+		pn("// network failed to deploy")
+		pn("var ErrRouterFailed = errors.New(\"Virtual router failed\")")
+		pn("")
+		pn("// CreateNetworkAndWaitImplemented creates the network and polls until its state is Implemented (or Setup,")
+		pn("// the final state of networks without a virtual router) or the timeout expires (a timeout of 0 means no")
+		pn("// timeout), so VMs can be deployed into it right away. When a virtual router of the network ends up in the")
+		pn("// Error state, the network is returned together with an ErrRouterFailed error. Note that a non-persistent")
+		pn("// isolated network is only implemented when the first VM is deployed into it, so the network offering")
+		pn("// should be persistent. The options are used when getting the network and listing its routers.")
+		pn("func (s *NetworkService) CreateNetworkAndWaitImplemented(p *CreateNetworkParams, timeout time.Duration, opts ...OptionFunc) (*Network, error) {")
+		pn("	ctx := context.Background()")
+		pn("	if timeout > 0 {")
+		pn("		var cancel context.CancelFunc")
+		pn("		ctx, cancel = context.WithTimeout(ctx, timeout)")
+		pn("		defer cancel()")
+		pn("	}")
+		pn("")
+		pn("	r, err := s.CreateNetwork(p)")
+		pn("	if err != nil {")
+		pn("		return nil, err")
+		pn("	}")
+		pn("")
+		pn("	for {")
+		pn("		network, _, err := s.GetNetworkByID(r.Id, opts...)")
+		pn("		if err != nil {")
+		pn("			return nil, err")
+		pn("		}")
+		pn("		switch network.State {")
+		pn("		case \"Implemented\", \"Setup\":")
+		pn("			return network, nil")
+		pn("		}")
+		pn("")
+		pn("		rp := s.cs.Router.NewListRoutersParams()")
+		pn("		rp.SetNetworkid(r.Id)")
+		pn("		for _, fn := range s.cs.withDefaultOptions(opts) {")
+		pn("			if err := fn(s.cs, rp); err != nil {")
+		pn("				return nil, err")
+		pn("			}")
+		pn("		}")
+		pn("		l, err := s.cs.Router.ListRouters(rp)")
+		pn("		if err != nil {")
+		pn("			return nil, err")
+		pn("		}")
+		pn("		for _, router := range l.Routers {")
+		pn("			if router.State == \"Error\" {")
+		pn("				return network, fmt.Errorf(\"%%w: router %%s of network %%s is in state %%s\", ErrRouterFailed, router.Id, r.Id, router.State)")
+		pn("			}")
+		pn("		}")
+		pn("")
+		pn("		select {")
+		pn("		case <-ctx.Done():")
+		pn("			return network, fmt.Errorf(\"Timeout waiting for network %%s to be implemented, last state was %%s: %%w\", r.Id, network.State, ctx.Err())")
+		pn("		case <-time.After(2 * time.Second):")
+		pn("		}")
+		pn("	}")
+		pn("}")
+		pn("")
+	}
+	if s.name == "ConfigurationService" {
+		pn("// ConfigScope is the scope of the configuration settings compared by DetectDrift. At most one of")
+		pn("// the IDs should be set; when none is set, the global settings are compared.")
+		pn("type ConfigScope struct {")
+		pn("	AccountID string")
+		pn("	ClusterID string")
+		pn("	StorageID string")
+		pn("	ZoneID    string")
+		pn("}")
+		pn("")
+		pn("// ConfigDiff is the difference between the current and the desired value of a configuration")
+		pn("// setting. Missing is true when the setting does not exist (in the scope) on the server.")
+		pn("type ConfigDiff struct {")
+		pn("	Current string")
+		pn("	Desired string")
+		pn("	Missing bool")
+		pn("}")
+		pn("")
+		pn("// DetectDrift compares the configuration settings in the scope with the desired values, and returns")
+		pn("// the difference for every setting of which the current value differs from the desired value, or")
+		pn("// which does not exist on the server, keyed by the name of the setting. Settings that are not in")
+		pn("// desired are ignored.")
+		pn("func (s *ConfigurationService) DetectDrift(desired map[string]string, scope ConfigScope) (map[string]ConfigDiff, error) {")
+		pn("	p := s.NewListConfigurationsParams()")
+		pn("	if scope.AccountID != \"\" {")
+		pn("		p.SetAccountid(scope.AccountID)")
+		pn("	}")
+		pn("	if scope.ClusterID != \"\" {")
+		pn("		p.SetClusterid(scope.ClusterID)")
+		pn("	}")
+		pn("	if scope.StorageID != \"\" {")
+		pn("		p.SetStorageid(scope.StorageID)")
+		pn("	}")
+		pn("	if scope.ZoneID != \"\" {")
+		pn("		p.SetZoneid(scope.ZoneID)")
+		pn("	}")
+		pn("")
+		pn("	l, err := s.ListConfigurationsWhere(p, func(c *Configuration) bool {")
+		pn("		_, ok := desired[c.Name]")
+		pn("		return ok")
+		pn("	})")
+		pn("	if err != nil {")
+		pn("		return nil, err")
+		pn("	}")
+		pn("")
+		pn("	current := make(map[string]string, len(l))")
+		pn("	for _, c := range l {")
+		pn("		current[c.Name] = c.Value")
+		pn("	}")
+		pn("")
+		pn("	drift := make(map[string]ConfigDiff)")
+		pn("	for name, want := range desired {")
+		pn("		have, ok := current[name]")
+		pn("		if !ok {")
+		pn("			drift[name] = ConfigDiff{Desired: want, Missing: true}")
+		pn("		} else if have != want {")
+		pn("			drift[name] = ConfigDiff{Current: have, Desired: want}")
+		pn("		}")
+		pn("	}")
+		pn("	return drift, nil")
+		pn("}")
+	}
+	if s.name == "SecurityGroupService" {
+		pn("// IngressRuleSpec describes an ingress rule of a security group, as used by ReconcileIngress. The")
+		pn("// source of the traffic is either the CIDR, or the security group with the given name owned by the")
+		pn("// given account. The ports are only used for the tcp and udp protocols, and the ICMP type and code")
+		pn("// only for the icmp protocol. When EndPort is 0 it is the same as StartPort.")
+		pn("type IngressRuleSpec struct {")
+		pn("	Protocol          Protocol")
+		pn("	CIDR              string")
+		pn("	SecurityGroupName string")
+		pn("	Account           string")
+		pn("	StartPort         int")
+		pn("	EndPort           int")
+		pn("	ICMPType          int")
+		pn("	ICMPCode          int")
+		pn("}")
+		pn("")
+		pn("// Returns the spec with the fields that do not apply to its protocol or source cleared, so it can be")
+		pn("// compared with other specs")
+		pn("func (r IngressRuleSpec) normalize() IngressRuleSpec {")
+		pn("	r.Protocol = Protocol(strings.ToLower(string(r.Protocol)))")
+		pn("	if r.CIDR != \"\" {")
+		pn("		r.SecurityGroupName, r.Account = \"\", \"\"")
+		pn("	}")
+		pn("	if r.Protocol == ProtocolTCP || r.Protocol == ProtocolUDP {")
+		pn("		if r.EndPort == 0 {")
+		pn("			r.EndPort = r.StartPort")
+		pn("		}")
+		pn("	} else {")
+		pn("		r.StartPort, r.EndPort = 0, 0")
+		pn("	}")
+		pn("	if r.Protocol != ProtocolICMP {")
+		pn("		r.ICMPType, r.ICMPCode = 0, 0")
+		pn("	}")
+		pn("	return r")
+		pn("}")
+		pn("")
+		pn("// ReconcileIngress makes the ingress rules of the security group match the desired rules. It first")
+		pn("// authorizes the desired rules the group does not have yet and then revokes the rules of the group")
+		pn("// that are not desired, waiting for every command to finish. Rules that already match are left")
+		pn("// untouched. All changes are attempted even when some of them fail, in which case the failures are")
+		pn("// returned joined into a single error. The options are used when getting the security group.")
+		pn("func (s *SecurityGroupService) ReconcileIngress(groupid string, desired []IngressRuleSpec, opts ...OptionFunc) error {")
+		pn("	sg, _, err := s.GetSecurityGroupByID(groupid, opts...)")
+		pn("	if err != nil {")
+		pn("		return err")
+		pn("	}")
+		pn("")
+		pn("	// The IDs of the current rules by their spec")
+		pn("	current := make(map[IngressRuleSpec]string, len(sg.Ingressrule))")
+		pn("	for _, r := range sg.Ingressrule {")
+		pn("		spec := IngressRuleSpec{")
+		pn("			Protocol:          Protocol(r.Protocol),")
+		pn("			CIDR:              r.Cidr,")
+		pn("			SecurityGroupName: r.Securitygroupname,")
+		pn("			Account:           r.Account,")
+		pn("			StartPort:         %s,", s.cfg.value("r.Startport"))
+		pn("			EndPort:           %s,", s.cfg.value("r.Endport"))
+		pn("			ICMPType:          %s,", s.cfg.value("r.Icmptype"))
+		pn("			ICMPCode:          %s,", s.cfg.value("r.Icmpcode"))
+		pn("		}")
+		pn("		current[spec.normalize()] = r.Ruleid")
+		pn("	}")
+		pn("")
+		pn("	var errs []error")
+		pn("	wanted := make(map[IngressRuleSpec]bool, len(desired))")
+		pn("	for _, r := range desired {")
+		pn("		r = r.normalize()")
+		pn("		if wanted[r] {")
+		pn("			continue")
+		pn("		}")
+		pn("		wanted[r] = true")
+		pn("")
+		pn("		if _, ok := current[r]; ok {")
+		pn("			continue")
+		pn("		}")
+		pn("		if err := s.authorizeIngress(groupid, r); err != nil {")
+		pn("			errs = append(errs, fmt.Errorf(\"Failed to authorize ingress rule %%+v: %%w\", r, err))")
+		pn("		}")
+		pn("	}")
+		pn("")
+		pn("	var revoke []string")
+		pn("	for r, id := range current {")
+		pn("		if !wanted[r] {")
+		pn("			revoke = append(revoke, id)")
+		pn("		}")
+		pn("	}")
+		pn("	sort.Strings(revoke)")
+		pn("")
+		pn("	for _, id := range revoke {")
+		pn("		r, err := s.RevokeSecurityGroupIngress(s.NewRevokeSecurityGroupIngressParams(id))")
+		pn("		if err == nil {")
+		pn("			err = s.waitForJob(r.JobID)")
+		pn("		}")
+		pn("		if err != nil {")
+		pn("			errs = append(errs, fmt.Errorf(\"Failed to revoke ingress rule %%s: %%w\", id, err))")
+		pn("		}")
+		pn("	}")
+		pn("")
+		pn("	return errors.Join(errs...)")
+		pn("}")
+		pn("")
+		pn("// Authorizes the ingress rule for the security group and waits for the async job to finish")
+		pn("func (s *SecurityGroupService) authorizeIngress(groupid string, r IngressRuleSpec) error {")
+		pn("	p := s.NewAuthorizeSecurityGroupIngressParams()")
+		pn("	p.SetSecuritygroupid(groupid)")
+		pn("	p.SetProtocol(r.Protocol)")
+		pn("	if r.CIDR != \"\" {")
+		pn("		p.SetCidrlist([]string{r.CIDR})")
+		pn("	} else {")
+		pn("		p.SetUsersecuritygrouplist(map[string]string{r.Account: r.SecurityGroupName})")
+		pn("	}")
+		pn("	switch r.Protocol {")
+		pn("	case ProtocolTCP, ProtocolUDP:")
+		pn("		p.SetStartport(r.StartPort)")
+		pn("		p.SetEndport(r.EndPort)")
+		pn("	case ProtocolICMP:")
+		pn("		p.SetIcmptype(r.ICMPType)")
+		pn("		p.SetIcmpcode(r.ICMPCode)")
+		pn("	}")
+		pn("")
+		pn("	resp, err := s.AuthorizeSecurityGroupIngress(p)")
+		pn("	if err != nil {")
+		pn("		return err")
+		pn("	}")
+		pn("	return s.waitForJob(resp.JobID)")
+		pn("}")
+		pn("")
+		pn("func (s *SecurityGroupService) waitForJob(jobid string) error {")
+		pn("	// An async client already waited for the job to finish")
+		pn("	if s.cs.async {")
+		pn("		return nil")
+		pn("	}")
+		pn("	_, err := s.cs.GetAsyncJobResult(jobid, s.cs.timeout)")
+		pn("	return err")
+		pn("}")
+	}
+	s.generateUpdateManyFunc()
+	s.generateAPICode(apis)
+
+	if s.cfg.Facade {
+		s.generateFacadeTypes()
+	}
+
+	return s.format(&buf)
+}
+
+// Generates the code for each of the given APIs
+func (s *Service) generateAPICode(apis []*API) {
+	for _, a := range apis {
+		s.generateParamType(a)
+		s.generateCloneFunc(a)
+		s.generateToURLValuesFunc(a)
+		s.generateValidateFunc(a)
+		s.generateParamSettersFunc(a)
+		s.generateNewParamTypeFunc(a)
+		s.generateNewParamTypeFromOptsFunc(a)
+		s.generateHelperFuncs(a)
+		s.generateNewAPICallFunc(a)
+		s.generateWaitForDeletedFunc(a)
+		s.generateStreamFunc(a)
+		s.generateListWhereFunc(a)
+		s.generateListAllFunc(a)
+		s.generateResponseType(a)
+	}
+}
+
+// Formats the generated code, or writes it to stdout and returns the error if it cannot be formatted
+func (s *Service) format(buf *bytes.Buffer) ([]byte, error) {
+	clean, err := format.Source(buf.Bytes())
+	if err != nil {
+		buf.WriteTo(os.Stdout)
+		return buf.Bytes(), err
+	}
+	return clean, nil
+}
+
+func (s *Service) generateParamType(a *API) {
+	pn := s.pn
+
+	pn("type %s struct {", capitalize(a.Name+"Params"))
+	pn("	p map[string]interface{}")
+	pn("}\n")
+	return
+}
+
+// Generates a Clone func for the params, which copies the set params into new params
+func (s *Service) generateCloneFunc(a *API) {
+	pn := s.pn
+	tn := capitalize(a.Name + "Params")
+
+	pn("// Clone returns a copy of the params, so the copy can be changed without changing these params.")
+	pn("// Slice and map values are shared, so they should be replaced instead of modified in place.")
+	pn("func (p *%s) Clone() *%s {", tn, tn)
+	pn("	c := &%s{p: make(map[string]interface{}, len(p.p))}", tn)
+	pn("	for k, v := range p.p {")
+	pn("		c.p[k] = v")
+	pn("	}")
+	pn("	return c")
+	pn("}")
+	pn("")
+}
+
+func (s *Service) generateToURLValuesFunc(a *API) {
+	pn := s.pn
+
+	pn("func (p *%s) toURLValues() url.Values {", capitalize(a.Name+"Params"))
+	pn("	u := url.Values{}")
+	pn("	if p.p == nil {")
+	pn("		return u")
+	pn("	}")
+	properties, clusterid := false, false
+	for _, ap := range a.Params {
+		pn("	if v, found := p.p[\"%s\"]; found {", ap.Name)
+		s.generateConvertCode(a, ap.Name, mapType(ap.Type))
+		pn("	}")
+		properties = properties || ap.Name == "properties"
+		clusterid = clusterid || ap.Name == "clusterid"
+	}
+	// The OVF properties set by SetOVFProperties, which are not part of the API this is generated for
+	if a.Name == "deployVirtualMachine" && !properties {
+		pn("	if v, found := p.p[\"properties\"]; found {")
+		s.generateConvertCode(a, "properties", "map[string]string")
+		pn("	}")
+	}
+	// The cluster set by DeployWithCapacityRetry, which is not part of older versions of the API
+	if a.Name == "deployVirtualMachine" && !clusterid {
+		pn("	if v, found := p.p[\"clusterid\"]; found {")
+		s.generateConvertCode(a, "clusterid", "string")
+		pn("	}")
+	}
+	pn("	return u")
+	pn("}")
+	pn("")
+	return
+}
+
+func (s *Service) generateConvertCode(a *API, name, typ string) {
+	pn := s.pn
+
+	switch typ {
+	case "string":
+		pn("u.Set(\"%s\", v.(string))", name)
+	case "int":
+		pn("vv := strconv.Itoa(v.(int))")
+		pn("u.Set(\"%s\", vv)", name)
+	case "int64":
+		pn("vv := strconv.FormatInt(v.(int64), 10)")
+		pn("u.Set(\"%s\", vv)", name)
+	case "bool":
+		pn("vv := strconv.FormatBool(v.(bool))")
+		pn("u.Set(\"%s\", vv)", name)
+	case "[]string":
+		pn("vv := strings.Join(v.([]string), \",\")")
+		pn("u.Set(\"%s\", vv)", name)
+	case "map[string]string":
+		keyValue, _ := detailsEncoding(a)
+		if name == "details" && keyValue {
+			// The details can also be set as ordered key/value pairs
+			pn("switch m := v.(type) {")
+			pn("case []KeyValuePair:")
+			pn("	for i, kv := range m {")
+			pn("		u.Set(fmt.Sprintf(\"%s[%%d].key\", i), kv.Key)", name)
+			pn("		u.Set(fmt.Sprintf(\"%s[%%d].value\", i), kv.Value)", name)
+			pn("	}")
+			pn("case map[string]string:")
+			pn("	for i, k := range getSortedKeysFromMap(m) {")
+			pn("		u.Set(fmt.Sprintf(\"%s[%%d].key\", i), k)", name)
+			pn("		u.Set(fmt.Sprintf(\"%s[%%d].value\", i), m[k])", name)
+			pn("	}")
+			pn("}")
+			return
+		}
+		pn("m := v.(map[string]string)")
+		pn("for i, k := range getSortedKeysFromMap(m) {")
+		switch {
+		case name == "details" && !keyValue:
+			pn("	u.Set(fmt.Sprintf(\"%s[%%d].%%s\", i, k), m[k])", name)
+		case name == "serviceproviderlist":
+			pn("	u.Set(fmt.Sprintf(\"%s[%%d].service\", i), k)", name)
+			pn("	u.Set(fmt.Sprintf(\"%s[%%d].provider\", i), m[k])", name)
+		case name == "usersecuritygrouplist":
+			pn("	u.Set(fmt.Sprintf(\"%s[%%d].account\", i), k)", name)
+			pn("	u.Set(fmt.Sprintf(\"%s[%%d].group\", i), m[k])", name)
+		default:
+			pn("	u.Set(fmt.Sprintf(\"%s[%%d].key\", i), k)", name)
+			pn("	u.Set(fmt.Sprintf(\"%s[%%d].value\", i), m[k])", name)
+		}
+		pn("}")
+	}
+	return
+}
+
+// Curated set of commands with a details param, mapped to true when the details must be encoded
+// using separate key and value params (details[0].key=k&details[0].value=v) and to false when they
+// must be encoded inline (details[0].k=v). Commands which are not in this set are checked for hints
+// in the description of their details param.
+var detailsRequireKeyValue = map[string]bool{
+	"addResourceDetail":           true,
+	"createNetworkOffering":       false,
+	"createSecondaryStagingStore": true,
+	"createStoragePool":           false,
+	"deployVirtualMachine":        false,
+	"getUploadParamsForTemplate":  false,
+	"updateVirtualMachine":        false,
+	"updateZone":                  true,
+}
+
+// Returns true if the details param of the API must be encoded using separate key and value params,
+// and false if it must be encoded inline. The second value is false if the encoding could not be
+// determined from either the curated set or the description of the param.
+func detailsEncoding(a *API) (bool, bool) {
+	var details *APIParam
+	for _, ap := range a.Params {
+		if ap.Name == "details" && ap.Type == "map" {
+			details = ap
+			break
+		}
+	}
+	if details == nil {
+		return false, true
+	}
+
+	if keyValue, found := detailsRequireKeyValue[a.Name]; found {
+		return keyValue, true
+	}
+
+	// The examples in the descriptions show which encoding is expected
+	desc := strings.ToLower(details.Description)
+	switch {
+	case strings.Contains(desc, "].key=") && strings.Contains(desc, "].value="):
+		return true, true
+	case strings.Contains(desc, "].keyname=keyvalue"), strings.Contains(desc, "].name=value"):
+		return false, true
+	}
+
+	return false, false
+}
+
+func (s *Service) parseParamName(name string) string {
+	if name != "type" {
+		return name
+	}
+	return uncapitalize(strings.TrimSuffix(s.name, "Service")) + "Type"
+}
+
+// Returns the (sorted) names of the required params of the API
+func requiredParamNames(a *API) []string {
+	var names []string
+	for _, ap := range a.Params {
+		if ap.Required {
+			names = append(names, ap.Name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Generates a validate func for APIs with required params, which verifies all required params are
+// set, as the params type can also be created without using the NewXxxParams func
+func (s *Service) generateValidateFunc(a *API) {
+	pn := s.pn
+
+	names := requiredParamNames(a)
+	if len(names) == 0 {
+		return
+	}
+
+	pn("// Returns an error naming the first required param which is not set")
+	pn("func (p *%s) validate() error {", capitalize(a.Name+"Params"))
+	pn("	for _, k := range []string{\"%s\"} {", strings.Join(names, "\", \""))
+	pn("		if _, found := p.p[k]; !found {")
+	pn("			return fmt.Errorf(\"Missing required param %%s of %s\", k)", a.Name)
+	pn("		}")
+	pn("	}")
+	pn("	return nil")
+	pn("}")
+	pn("")
+}
+
+func (s *Service) generateParamSettersFunc(a *API) {
+	pn := s.pn
+	found := make(map[string]bool)
+
+	for _, ap := range a.Params {
+		if !found[ap.Name] {
+			// Params of an enum type are set using the enum type, but stored as a string
+			typ, value := mapType(ap.Type), "v"
+			if et := enumType(a, ap); et != "" {
+				typ, value = et, "string(v)"
+			}
+			pn("func (p *%s) Set%s(v %s) {", capitalize(a.Name+"Params"), capitalize(ap.Name), typ)
+			pn("	if p.p == nil {")
+			pn("		p.p = make(map[string]interface{})")
+			pn("	}")
+			pn("	p.p[\"%s\"] = %s", ap.Name, value)
+			pn("	return")
+			pn("}")
+			pn("")
+			pn("// Set%sIfUnset sets %s to v, unless it is already set", capitalize(ap.Name), ap.Name)
+			pn("func (p *%s) Set%sIfUnset(v %s) {", capitalize(a.Name+"Params"), capitalize(ap.Name), typ)
+			pn("	if _, found := p.p[\"%s\"]; !found {", ap.Name)
+			pn("		p.Set%s(v)", capitalize(ap.Name))
+			pn("	}")
+			pn("}")
+			pn("")
+			if s.cfg.ValidatedIPSetters && mapType(ap.Type) == "string" {
+				s.generateValidatedIPSetterFunc(a, ap)
+			}
+			if mapType(ap.Type) == "[]string" {
+				pn("// Set%sCSV splits v on commas and sets the resulting values", capitalize(ap.Name))
+				pn("func (p *%s) Set%sCSV(v string) {", capitalize(a.Name+"Params"), capitalize(ap.Name))
+				pn("	p.Set%s(splitCSV(v))", capitalize(ap.Name))
+				pn("}")
+				pn("")
+			}
+			if keyValue, _ := detailsEncoding(a); ap.Name == "details" && keyValue {
+				pn("// SetDetailsKV sets the details as key/value pairs, which are encoded in the given order")
+				pn("func (p *%s) SetDetailsKV(v []KeyValuePair) {", capitalize(a.Name+"Params"))
+				pn("	if p.p == nil {")
+				pn("		p.p = make(map[string]interface{})")
+				pn("	}")
+				pn("	p.p[\"details\"] = v")
+				pn("}")
+				pn("")
+			}
+			found[ap.Name] = true
+		}
+	}
+
+	for _, ap := range a.Params {
+		if ap.Name == "listall" && mapType(ap.Type) == "bool" {
+			pn("var _ ListAllSetter = (*%s)(nil)", capitalize(a.Name+"Params"))
+			pn("")
+			break
+		}
+	}
+	return
+}
+
+// Params which contain an IP address, mapped to true if the address must be an IPv6 address
+var ipParams = map[string]bool{
+	"endip":           false,
+	"endipv6":         true,
+	"gateway":         false,
+	"ip6address":      true,
+	"ip6dns1":         true,
+	"ip6dns2":         true,
+	"ip6gateway":      true,
+	"ipaddress":       false,
+	"netmask":         false,
+	"sourceipaddress": false,
+	"startip":         false,
+	"startipv6":       true,
+	"vmguestip":       false,
+	"vsmipaddress":    false,
+}
+
+// Params which contain a CIDR, mapped to true if the CIDR must be an IPv6 CIDR
+var cidrParams = map[string]bool{
+	"cidr":             false,
+	"guestcidraddress": false,
+	"guestvmcidr":      false,
+	"ip6cidr":          true,
+}
+
+// Returns the names of the enum types used by the params of any of the APIs, sorted by name
+func (as *AllServices) enumTypesUsed() []string {
+	used := make(map[string]bool)
+	for _, s := range as.services {
+		for _, a := range s.apis {
+			for _, ap := range a.Params {
+				if et := enumType(a, ap); et != "" {
+					used[et] = true
+				}
+			}
+		}
+	}
+
+	names := make([]string, 0, len(used))
+	for et := range used {
+		names = append(names, et)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// An enum value, generated as a constant named after the enum type and the name of the value
+type enumValue struct {
+	name  string
+	value string
+}
+
+// Curated enum types, which are generated as typed strings with a constant for each of their values
+var enumTypes = map[string][]enumValue{
+	"ACLType": {
+		{"Account", "Account"},
+		{"Domain", "Domain"},
+	},
+	"HypervisorType": {
+		{"BareMetal", "BareMetal"},
+		{"HyperV", "Hyperv"},
+		{"KVM", "KVM"},
+		{"LXC", "LXC"},
+		{"Ovm", "Ovm"},
+		{"Ovm3", "Ovm3"},
+		{"Simulator", "Simulator"},
+		{"VMware", "VMware"},
+		{"XenServer", "XenServer"},
+	},
+	"IntervalType": {
+		{"Hourly", "HOURLY"},
+		{"Daily", "DAILY"},
+		{"Weekly", "WEEKLY"},
+		{"Monthly", "MONTHLY"},
+	},
+	"Protocol": {
+		{"TCP", "tcp"},
+		{"UDP", "udp"},
+		{"ICMP", "icmp"},
+		{"All", "all"},
+	},
+	"ProvisioningType": {
+		{"Thin", "thin"},
+		{"Sparse", "sparse"},
+		{"Fat", "fat"},
+	},
+	"StorageType": {
+		{"Local", "local"},
+		{"Shared", "shared"},
+	},
+	"TemplateFilter": {
+		{"Featured", "featured"},
+		{"Self", "self"},
+		{"SelfExecutable", "selfexecutable"},
+		{"SharedExecutable", "sharedexecutable"},
+		{"Executable", "executable"},
+		{"Community", "community"},
+		{"All", "all"},
+	},
+	"VirtualMachineState": {
+		{"Running", "Running"},
+		{"Stopped", "Stopped"},
+		{"Present", "Present"},
+		{"Destroyed", "Destroyed"},
+		{"Expunged", "Expunged"},
+	},
+}
+
+// The enum types of the params which only take the values of an enum type, by param name. Params of which
+// the values differ per command are keyed by the command and the param name instead.
+var enumParams = map[string]string{
+	"acltype":                                "ACLType",
+	"hypervisor":                             "HypervisorType",
+	"intervaltype":                           "IntervalType",
+	"isofilter":                              "TemplateFilter",
+	"provisioningtype":                       "ProvisioningType",
+	"storagetype":                            "StorageType",
+	"templatefilter":                         "TemplateFilter",
+	"authorizeSecurityGroupEgress.protocol":  "Protocol",
+	"authorizeSecurityGroupIngress.protocol": "Protocol",
+	"createEgressFirewallRule.protocol":      "Protocol",
+	"createFirewallRule.protocol":            "Protocol",
+	"createIpForwardingRule.protocol":        "Protocol",
+	"createNetworkACL.protocol":              "Protocol",
+	"createPortForwardingRule.protocol":      "Protocol",
+	"listNetworkACLs.protocol":               "Protocol",
+	"updateNetworkACLItem.protocol":          "Protocol",
+	"listVirtualMachines.state":              "VirtualMachineState",
+	"listVirtualMachinesMetrics.state":       "VirtualMachineState",
+}
+
+// Returns the enum type of the param, or an empty string if the param is not of an enum type
+func enumType(a *API, ap *APIParam) string {
+	if mapType(ap.Type) != "string" {
+		return ""
+	}
+	if et, ok := enumParams[a.Name+"."+ap.Name]; ok {
+		return et
+	}
+	return enumParams[ap.Name]
+}
+
+func (s *Service) generateValidatedIPSetterFunc(a *API, ap *APIParam) {
+	pn := s.pn
+
+	if v6, found := ipParams[ap.Name]; found {
+		pn("// Set%sValidated validates ip and sets it as %s", capitalize(ap.Name), ap.Name)
+		pn("func (p *%s) Set%sValidated(ip net.IP) error {", capitalize(a.Name+"Params"), capitalize(ap.Name))
+		if v6 {
+			pn("	if ip.To16() == nil || ip.To4() != nil {")
+			pn("		return fmt.Errorf(\"Invalid IPv6 address for %s: %%v\", ip)", ap.Name)
+		} else {
+			pn("	if ip.To16() == nil {")
+			pn("		return fmt.Errorf(\"Invalid IP address for %s: %%v\", ip)", ap.Name)
+		}
+		pn("	}")
+		pn("	p.Set%s(ip.String())", capitalize(ap.Name))
+		pn("	return nil")
+		pn("}")
+		pn("")
+	}
+
+	if v6, found := cidrParams[ap.Name]; found {
+		pn("// Set%sValidated validates cidr and sets it as %s", capitalize(ap.Name), ap.Name)
+		pn("func (p *%s) Set%sValidated(cidr *net.IPNet) error {", capitalize(a.Name+"Params"), capitalize(ap.Name))
+		pn("	if cidr == nil {")
+		pn("		return fmt.Errorf(\"Invalid CIDR for %s: %%v\", cidr)", ap.Name)
+		pn("	}")
+		pn("	if _, bits := cidr.Mask.Size(); bits == 0 || cidr.IP.To16() == nil {")
+		pn("		return fmt.Errorf(\"Invalid CIDR for %s: %%v\", cidr)", ap.Name)
+		pn("	}")
+		if v6 {
+			pn("	if cidr.IP.To4() != nil {")
+			pn("		return fmt.Errorf(\"Invalid IPv6 CIDR for %s: %%v\", cidr)", ap.Name)
+			pn("	}")
+		}
+		pn("	p.Set%s(cidr.String())", capitalize(ap.Name))
+		pn("	return nil")
+		pn("}")
+		pn("")
+	}
+}
+
+func (s *Service) generateNewParamTypeFunc(a *API) {
+	p, pn := s.p, s.pn
+	tn := capitalize(a.Name + "Params")
+	rp := APIParams{}
+
+	// Generate the function signature
+	pn("// You should always use this function to get a new %s instance,", tn)
+	pn("// as then you are sure you have configured all required params. Any")
+	pn("// additional functions are called with the new instance to set optional params")
+	p("func (s *%s) New%s(", s.name, tn)
+	for _, ap := range a.Params {
+		if ap.Required {
+			rp = append(rp, ap)
+			p("%s %s, ", s.parseParamName(ap.Name), mapType(ap.Type))
+		}
+	}
+	pn("opts ...func(*%s)) *%s {", tn, tn)
+
+	// Generate the function body
+	pn("	p := &%s{}", tn)
+	pn("	p.p = make(map[string]interface{})")
+	sort.Sort(rp)
+	for _, ap := range rp {
+		pn("	p.p[\"%s\"] = %s", ap.Name, s.parseParamName(ap.Name))
+	}
+	pn("	for _, fn := range opts {")
+	pn("		fn(p)")
+	pn("	}")
+	pn("	return p")
+	pn("}")
+	pn("")
+	return
+}
+
+// The number of required params from which on an additional options struct based
+// constructor is generated, as positional args become unreadable for these APIs
+const minRequiredParamsForOpts = 3
+
+func (s *Service) generateNewParamTypeFromOptsFunc(a *API) {
+	pn := s.pn
+	tn := capitalize(a.Name + "Params")
+	on := capitalize(a.Name + "Opts")
+
+	var rp APIParams
+	for _, ap := range a.Params {
+		if ap.Required {
+			rp = append(rp, ap)
+		}
+	}
+	if len(rp) < minRequiredParamsForOpts {
+		return
+	}
+
+	// Generate the options struct
+	pn("// %s contains all params that can be set on a %s.", on, tn)
+	pn("// Optional params are pointers (or slices and maps) which are only set when not nil.")
+	pn("type %s struct {", on)
+	found := make(map[string]bool)
+	for _, ap := range a.Params {
+		if found[ap.Name] {
+			continue
+		}
+		found[ap.Name] = true
+
+		typ := mapType(ap.Type)
+		if et := enumType(a, ap); et != "" {
+			typ = et
+		}
+		if !ap.Required && isScalarType(mapType(ap.Type)) {
+			typ = "*" + typ
+		}
+		pn("	%s %s", capitalize(ap.Name), typ)
+	}
+	pn("}")
+	pn("")
+
+	// Generate the function signature
+	pn("// New%sFromOpts is an alternative for New%s which takes all params using", tn, tn)
+	pn("// an options struct, as that is easier to read for APIs with many required params")
+	pn("func (s *%s) New%sFromOpts(o %s) *%s {", s.name, tn, on, tn)
+
+	// Generate the function body
+	p := s.p
+	p("	p := s.New%s(", tn)
+	for _, ap := range rp {
+		if enumType(a, ap) != "" {
+			p("string(o.%s), ", capitalize(ap.Name))
+		} else {
+			p("o.%s, ", capitalize(ap.Name))
+		}
+	}
+	pn(")")
+	found = make(map[string]bool)
+	for _, ap := range a.Params {
+		if ap.Required || found[ap.Name] {
+			continue
+		}
+		found[ap.Name] = true
+
+		pn("	if o.%s != nil {", capitalize(ap.Name))
+		switch {
+		case enumType(a, ap) != "":
+			pn("		p.p[\"%s\"] = string(*o.%s)", ap.Name, capitalize(ap.Name))
+		case isScalarType(mapType(ap.Type)):
+			pn("		p.p[\"%s\"] = *o.%s", ap.Name, capitalize(ap.Name))
+		default:
+			pn("		p.p[\"%s\"] = o.%s", ap.Name, capitalize(ap.Name))
+		}
+		pn("	}")
+	}
+	pn("	return p")
+	pn("}")
+	pn("")
+	return
+}
+
+func isScalarType(typ string) bool {
+	switch typ {
+	case "string", "int", "int64", "bool":
+		return true
+	}
+	return false
+}
+
+func (s *Service) generateHelperFuncs(a *API) {
+	p, pn := s.p, s.pn
+
+	// Make the params support WithFirstMatch, when generating the first helper function
+	firstMatch := false
+	generateFirstMatch := func(ln string) {
+		if firstMatch {
+			return
+		}
+		pn("func (p *List%sParams) setFirstMatch() {", ln)
+		pn("	if p.p == nil {")
+		pn("		p.p = make(map[string]interface{})")
+		pn("	}")
+		pn("	p.p[firstMatchKey] = true")
+		pn("}")
+		pn("")
+		firstMatch = true
+	}
+
+	if strings.HasPrefix(a.Name, "list") {
+		v, found := hasNameOrKeywordParamField(a.Params)
+		if found && hasIDAndNameResponseField(a.Response) {
+			ln := strings.TrimPrefix(a.Name, "list")
+
+			// Check if ID is a required parameters and bail if so
+			for _, ap := range a.Params {
+				if ap.Required && ap.Name == "id" {
+					return
+				}
+			}
+
+			generateFirstMatch(ln)
+
+			// Generate the function signature
+			pn("// This is a courtesy helper function, which in some cases may not work as expected!")
+			p("func (s *%s) Get%sID(%s string, ", s.name, parseSingular(ln), v)
+			for _, ap := range a.Params {
+				if ap.Required {
+					p("%s %s, ", s.parseParamName(ap.Name), mapType(ap.Type))
+				}
+			}
+			if parseSingular(ln) == "Iso" {
+				p("isofilter string, ")
+			}
+			if parseSingular(ln) == "Template" || parseSingular(ln) == "Iso" {
+				p("zoneid string, ")
+			}
+			pn("opts ...OptionFunc) (string, int, error) {")
+
+			// Generate the function body
+			pn("	p := &List%sParams{}", ln)
+			pn("	p.p = make(map[string]interface{})")
+			pn("")
+			pn("	p.p[\"%s\"] = %s", v, v)
+			for _, ap := range a.Params {
+				if ap.Required {
+					pn("	p.p[\"%s\"] = %s", ap.Name, s.parseParamName(ap.Name))
+				}
+			}
+			if parseSingular(ln) == "Iso" {
+				pn("	p.p[\"isofilter\"] = isofilter")
+			}
+			if parseSingular(ln) == "Template" || parseSingular(ln) == "Iso" {
+				pn("	p.p[\"zoneid\"] = zoneid")
+			}
+			pn("")
+			pn("	for _, fn := range s.cs.withDefaultOptions(opts) {")
+			pn("		if err := fn(s.cs, p); err != nil {")
+			pn("			return \"\", -1, err")
+			pn("		}")
+			pn("	}")
+			pn("")
+			pn("	l, err := s.List%s(p)", ln)
+			pn("	if err != nil {")
+			pn("		return \"\", -1, err")
+			pn("	}")
+			pn("")
+			if ln == "AffinityGroups" {
+				pn("	// This is needed because of a bug with the listAffinityGroup call. It reports the")
+				pn("	// number of VirtualMachines in the groups as being the number of groups found.")
+				pn("	l.Count = len(l.%s)", ln)
+				pn("")
+			}
+			pn("	if l.Count == 0 {")
+			pn("	  return \"\", l.Count, fmt.Errorf(\"%%w for %%s: %%+v\", ErrNotFound, %s, l)", v)
+			pn("	}")
+			pn("")
+			pn("	if l.Count == 1 {")
+			pn("	  return l.%s[0].Id, l.Count, nil", ln)
+			pn("	}")
+			pn("")
+			pn(" 	if l.Count > 1 {")
+			pn("    for _, v := range l.%s {", ln)
+			pn("      if v.Name == %s {", v)
+			pn("        return v.Id, l.Count, nil")
+			pn("      }")
+			pn("    }")
+			pn("")
+			pn("		if _, ok := p.p[firstMatchKey]; ok && len(l.%s) > 0 {", ln)
+			pn("			return l.%s[0].Id, l.Count, nil", ln)
+			pn("		}")
+			pn("	}")
+			pn("  return \"\", l.Count, fmt.Errorf(\"Could not find an exact match for %%s: %%+v\", %s, l)", v)
+			pn("}\n")
+			pn("")
+
+			if hasIDParamField(a.Params) {
+				// Generate the function signature
+				pn("// This is a courtesy helper function, which in some cases may not work as expected!")
+				p("func (s *%s) Get%sByName(name string, ", s.name, parseSingular(ln))
+				for _, ap := range a.Params {
+					if ap.Required {
+						p("%s %s, ", s.parseParamName(ap.Name), mapType(ap.Type))
+					}
+				}
+				if parseSingular(ln) == "Iso" {
+					p("isofilter string, ")
+				}
+				if parseSingular(ln) == "Template" || parseSingular(ln) == "Iso" {
+					p("zoneid string, ")
+				}
+				pn("opts ...OptionFunc) (*%s, int, error) {", parseSingular(ln))
+
+				// Generate the function body
+				p("  id, count, err := s.Get%sID(name, ", parseSingular(ln))
+				for _, ap := range a.Params {
+					if ap.Required {
+						p("%s, ", s.parseParamName(ap.Name))
+					}
+				}
+				if parseSingular(ln) == "Iso" {
+					p("isofilter, ")
+				}
+				if parseSingular(ln) == "Template" || parseSingular(ln) == "Iso" {
+					p("zoneid, ")
+				}
+				pn("opts...)")
+				pn("  if err != nil {")
+				pn("    return nil, count, err")
+				pn("  }")
+				pn("")
+				p("  r, count, err := s.Get%sByID(id, ", parseSingular(ln))
+				for _, ap := range a.Params {
+					if ap.Required {
+						p("%s, ", s.parseParamName(ap.Name))
+					}
+				}
+				pn("opts...)")
+				pn("  if err != nil {")
+				pn("    return nil, count, err")
+				pn("  }")
+				pn("	return r, count, nil")
+				pn("}")
+				pn("")
+			}
+		}
+
+		if hasIDParamField(a.Params) {
+			ln := strings.TrimPrefix(a.Name, "list")
+			generateFirstMatch(ln)
+
+			// Generate the function signature
+			pn("// This is a courtesy helper function, which in some cases may not work as expected!")
+			p("func (s *%s) Get%sByID(id string, ", s.name, parseSingular(ln))
+			for _, ap := range a.Params {
+				if ap.Required && s.parseParamName(ap.Name) != "id" {
+					p("%s %s, ", ap.Name, mapType(ap.Type))
+				}
+			}
+			if ln == "LoadBalancerRuleInstances" {
+				pn("opts ...OptionFunc) (*VirtualMachine, int, error) {")
+			} else {
+				pn("opts ...OptionFunc) (*%s, int, error) {", parseSingular(ln))
+			}
+
+			// Generate the function body
+			pn("	p := &List%sParams{}", ln)
+			pn("	p.p = make(map[string]interface{})")
+			pn("")
+			pn("	p.p[\"id\"] = id")
+			for _, ap := range a.Params {
+				if ap.Required && s.parseParamName(ap.Name) != "id" {
+					pn("	p.p[\"%s\"] = %s", ap.Name, s.parseParamName(ap.Name))
+				}
+			}
+			pn("")
+			pn("	for _, fn := range s.cs.withDefaultOptions(opts) {")
+			pn("		if err := fn(s.cs, p); err != nil {")
+			pn("			return nil, -1, err")
+			pn("		}")
+			pn("	}")
+			pn("")
+			pn("	l, err := s.List%s(p)", ln)
+			pn("	if err != nil {")
+			pn("		if strings.Contains(err.Error(), fmt.Sprintf(")
+			pn("			\"Invalid parameter id value=%%s due to incorrect long value format, \"+")
+			pn("				\"or entity does not exist\", id)) {")
+			pn("			return nil, 0, fmt.Errorf(\"%%w for %%s: %%+v\", ErrNotFound, id, l)")
+			pn("		}")
+			pn("		return nil, -1, err")
+			pn("	}")
+			pn("")
+			if ln == "AffinityGroups" {
+				pn("	// This is needed because of a bug with the listAffinityGroup call. It reports the")
+				pn("	// number of VirtualMachines in the groups as being the number of groups found.")
+				pn("	l.Count = len(l.%s)", ln)
+				pn("")
+			}
+			pn("	if l.Count == 0 {")
+			pn("	  return nil, l.Count, fmt.Errorf(\"%%w for %%s: %%+v\", ErrNotFound, id, l)")
+			pn("	}")
+			pn("")
+			pn("	if l.Count == 1 {")
+			pn("	  return l.%s[0], l.Count, nil", ln)
+			pn("	}")
+			pn("")
+			pn("	if _, ok := p.p[firstMatchKey]; ok && len(l.%s) > 0 {", ln)
+			pn("		return l.%s[0], l.Count, nil", ln)
+			pn("	}")
+			pn("  return nil, l.Count, fmt.Errorf(\"There is more then one result for %s UUID: %%s!\", id)", parseSingular(ln))
+			pn("}\n")
+			pn("")
+
+			// Generate the function signature
+			pn("// %sExists returns true if a %s with the given ID exists. When no match is found", parseSingular(ln), parseSingular(ln))
+			pn("// it returns false, while any other error is returned as is.")
+			p("func (s *%s) %sExists(id string, ", s.name, parseSingular(ln))
+			for _, ap := range a.Params {
+				if ap.Required && s.parseParamName(ap.Name) != "id" {
+					p("%s %s, ", ap.Name, mapType(ap.Type))
+				}
+			}
+			pn("opts ...OptionFunc) (bool, error) {")
+
+			// Generate the function body
+			p("	_, count, err := s.Get%sByID(id, ", parseSingular(ln))
+			for _, ap := range a.Params {
+				if ap.Required && s.parseParamName(ap.Name) != "id" {
+					p("%s, ", ap.Name)
+				}
+			}
+			pn("opts...)")
+			pn("	if errors.Is(err, ErrNotFound) {")
+			pn("		return false, nil")
+			pn("	}")
+			pn("	if count > 0 {")
+			pn("		return true, nil")
+			pn("	}")
+			pn("	return false, err")
+			pn("}")
+			pn("")
+		}
+	}
+	return
+}
+
+func (s *Service) generateWaitForDeletedFunc(a *API) {
+	pn := s.pn
+
+	if !strings.HasPrefix(a.Name, "delete") || !hasIDParamField(a.Params) {
+		return
+	}
+	for _, ap := range a.Params {
+		if ap.Required && ap.Name != "id" {
+			return
+		}
+	}
+
+	// Find a matching list API with a GetXxxByID helper that only requires an ID
+	rn := strings.TrimPrefix(a.Name, "delete")
+	found := false
+	for _, l := range s.apis {
+		if !strings.HasPrefix(l.Name, "list") || parseSingular(strings.TrimPrefix(l.Name, "list")) != rn {
+			continue
+		}
+		if !hasIDParamField(l.Params) {
+			continue
+		}
+		found = true
+		for _, ap := range l.Params {
+			if ap.Required {
+				found = false
+			}
+		}
+	}
+	if !found {
+		return
+	}
+
+	// Generate the function signature
+	pn("// WaitFor%sDeleted polls until the %s with the given ID no longer exists, the timeout", rn, rn)
+	pn("// expires or the context is cancelled. Deleted resources may still be listed for a short while")
+	pn("// after the delete command finished, so this can be used to make sure they are really gone. The")
+	pn("// options are passed to Get%sByID, so a resource outside of the default scope can be found.", rn)
+	pn("func (s *%s) WaitFor%sDeleted(ctx context.Context, id string, timeout time.Duration, opts ...OptionFunc) error {", s.name, rn)
+
+	// Generate the function body
+	pn("	if timeout > 0 {")
+	pn("		var cancel context.CancelFunc")
+	pn("		ctx, cancel = context.WithTimeout(ctx, timeout)")
+	pn("		defer cancel()")
+	pn("	}")
+	pn("")
+	pn("	for {")
+	pn("		_, count, err := s.Get%sByID(id, opts...)", rn)
+	pn("		if count == 0 {")
+	pn("			return nil")
+	pn("		}")
+	pn("		if count < 0 {")
+	pn("			return err")
+	pn("		}")
+	pn("")
+	pn("		select {")
+	pn("		case <-ctx.Done():")
+	pn("			return ctx.Err()")
+	pn("		case <-time.After(2 * time.Second):")
+	pn("		}")
+	pn("	}")
+	pn("}")
+	pn("")
+}
+
+// Generates an UpdateMany func for services with an update command for their resource that only requires
+// the ID of the resource, which applies the same update to multiple resources
+func (s *Service) generateUpdateManyFunc() {
+	pn := s.pn
+
+	var a *API
+	for _, api := range s.apis {
+		if strings.EqualFold(api.Name, "update"+strings.TrimSuffix(s.name, "Service")) {
+			a = api
+		}
+	}
+	if a == nil || !hasIDParamField(a.Params) {
+		return
+	}
+	for _, ap := range a.Params {
+		if ap.Required && ap.Name != "id" {
+			return
+		}
+	}
+
+	n := capitalize(a.Name)
+	rn := strings.TrimPrefix(n, "Configure") + "Response"
+
+	pn("// UpdateMany runs %s for all given IDs in parallel, using at most concurrency concurrent requests.", n)
+	pn("// For each ID the params are populated with the ID and then passed to mutate, which should set the")
+	pn("// changes to apply. The result contains the updated resources (in the order of the IDs) and the error")
+	pn("// of every update that failed by ID. The returned error contains all failures, or is nil if none failed.")
+	pn("// The updated resources are returned as the %s of every update, which contains the same", rn)
+	pn("// fields as the resource itself, so no additional request is needed per resource to get them. The")
+	pn("// options are used for every update.")
+	pn("func (s *%s) UpdateMany(ids []string, mutate func(*%sParams), concurrency int, opts ...OptionFunc) (BulkResult[*%s], error) {", s.name, n, rn)
+	pn("	rs := make([]*%s, len(ids))", rn)
+	pn("	errs := make([]error, len(ids))")
+	pn("")
+	pn("	runConcurrently(len(ids), concurrency, func(i int) {")
+	pn("		p := &%sParams{p: map[string]interface{}{\"id\": ids[i]}}", n)
+	pn("		if mutate != nil {")
+	pn("			mutate(p)")
+	pn("		}")
+	pn("")
+	pn("		r, err := s.%s(p, opts...)", n)
+	pn("		if err != nil {")
+	pn("			errs[i] = err")
+	pn("			return")
+	pn("		}")
+	if a.Isasync {
+		pn("")
+		pn("		// An async client already waited for the job to finish")
+		pn("		if !s.cs.async {")
+		pn("			b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)")
+		pn("			if err == nil {")
+		pn("				b, err = %s", unwrapCode(a, "b"))
+		pn("			}")
+		pn("			if err == nil {")
+		pn("				err = json.Unmarshal(b, r)")
+		pn("			}")
+		pn("			if err != nil {")
+		pn("				errs[i] = err")
+		pn("				return")
+		pn("			}")
+		pn("		}")
+	}
+	pn("		rs[i] = r")
+	pn("	})")
+	pn("")
+	pn("	var r BulkResult[*%s]", rn)
+	pn("	for i, id := range ids {")
+	pn("		if errs[i] != nil {")
+	pn("			r.fail(id, errs[i])")
+	pn("			continue")
+	pn("		}")
+	pn("		r.Succeeded = append(r.Succeeded, rs[i])")
+	pn("	}")
+	pn("")
+	pn("	return r, r.Err()")
+	pn("}")
+	pn("")
+}
+
+// Returns true if the API is a list API with a regular list response
+func isListAPI(a *API) bool {
+	return strings.HasPrefix(a.Name, "list") && a.Name != "listLoadBalancerRuleInstances"
+}
+
+// Returns true if the API has both a page and pagesize param
+func supportsPaging(a *API) bool {
+	page, pagesize := false, false
+	for _, ap := range a.Params {
+		switch ap.Name {
+		case "page":
+			page = true
+		case "pagesize":
+			pagesize = true
+		}
+	}
+	return page && pagesize
+}
+
+// Generates a ListXxxWhere func for every list API, which lists all results (across all pages if the
+// API supports paging) and filters them client-side using a predicate
+func (s *Service) generateListWhereFunc(a *API) {
+	pn := s.pn
+
+	if !isListAPI(a) {
+		return
+	}
+
+	n := capitalize(a.Name)
+	ln := capitalize(strings.TrimPrefix(a.Name, "list"))
+	tn := parseSingular(ln)
+
+	pn("// %sWhere returns all results of %s for which pred returns true. Note that the results are", n, n)
+	pn("// filtered client-side, so all results matching the params are fetched first.")
+	if supportsPaging(a) {
+		pn("// All pages are fetched, so the page and pagesize set in the params are ignored.")
+	}
+	pn("func (s *%s) %sWhere(p *%sParams, pred func(*%s) bool) ([]*%s, error) {", s.name, n, n, tn, tn)
+	pn("	var r []*%s", tn)
+	if supportsPaging(a) {
+		pn("	ch, errs := s.Stream%s(context.Background(), p)", ln)
+		pn("	for v := range ch {")
+		pn("		if pred(v) {")
+		pn("			r = append(r, v)")
+		pn("		}")
+		pn("	}")
+		pn("	if err := <-errs; err != nil {")
+		pn("		return nil, err")
+		pn("	}")
+	} else {
+		pn("	l, err := s.%s(p)", n)
+		pn("	if err != nil {")
+		pn("		return nil, err")
+		pn("	}")
+		pn("	for _, v := range l.%s {", ln)
+		pn("		if pred(v) {")
+		pn("			r = append(r, v)")
+		pn("		}")
+		pn("	}")
+	}
+	pn("	return r, nil")
+	pn("}")
+	pn("")
+}
+
+// List APIs mapped to a func written by hand, which ListAllXxx calls to check the params before listing
+// the pages
+var listAllParamChecks = map[string]string{
+	"listUsageRecords": "checkUsageDates",
+}
+
+// Generates a ListAllXxx func for every list API that supports paging, which returns all results
+// across all pages
+func (s *Service) generateListAllFunc(a *API) {
+	pn := s.pn
+
+	if !isListAPI(a) || !supportsPaging(a) {
+		return
+	}
+
+	n := capitalize(a.Name)
+	ln := capitalize(strings.TrimPrefix(a.Name, "list"))
+	tn := parseSingular(ln)
+
+	pn("// ListAll%s returns the results of %s across all pages, by calling %s page by page until all", ln, n, n)
+	pn("// results are fetched. The pagesize set in the params is used for every page (500 if not set), and the")
+	pn("// page set in the params is ignored.")
+	pn("func (s *%s) ListAll%s(p *%sParams) ([]*%s, error) {", s.name, ln, n, tn)
+	pn("	// Copy the params so the ones passed in are not modified")
+	pn("	pp := p.Clone()")
+	pn("	pp.SetPagesizeIfUnset(500)")
+	pn("	pagesize := pp.p[\"pagesize\"].(int)")
+	pn("")
+	if check, ok := listAllParamChecks[a.Name]; ok {
+		pn("	if err := %s(pp); err != nil {", check)
+		pn("		return nil, err")
+		pn("	}")
+		pn("")
+	}
+	pn("	var r []*%s", tn)
+	pn("	for page := 1; ; page++ {")
+	pn("		pp.SetPage(page)")
+	pn("")
+	pn("		l, err := s.%s(pp)", n)
+	pn("		if err != nil {")
+	pn("			return nil, err")
+	pn("		}")
+	pn("		r = append(r, l.%s...)", ln)
+	pn("")
+	pn("		if len(l.%s) < pagesize || len(r) >= l.Count {", ln)
+	pn("			return r, nil")
+	pn("		}")
+	pn("	}")
+	pn("}")
+	pn("")
+}
+
+// Generates a StreamXxx func for every list API that supports paging, which sends all results
+// across all pages on a channel
+func (s *Service) generateStreamFunc(a *API) {
+	pn := s.pn
+
+	if !isListAPI(a) || !supportsPaging(a) {
+		return
+	}
+
+	n := capitalize(a.Name)
+	ln := capitalize(strings.TrimPrefix(a.Name, "list"))
+	tn := parseSingular(ln)
+
+	pn("// Stream%s fetches the results of %s page by page in the background and sends them on the", ln, n)
+	pn("// returned channel. Both returned channels are closed when done. If fetching a page fails, or the context")
+	pn("// is done before all results are sent, the error is sent on the error channel and fetching stops. A")
+	pn("// request for a page in flight is cancelled when the context is done. The page and pagesize set in the")
+	pn("// params are ignored.")
+	pn("func (s *%s) Stream%s(ctx context.Context, p *%sParams) (<-chan *%s, <-chan error) {", s.name, ln, n, tn)
+	pn("	ch := make(chan *%s)", tn)
+	pn("	errs := make(chan error, 1)")
+	pn("")
+	pn("	// Copy the params so the ones passed in are not modified")
+	pn("	pp := p.Clone()")
+	pn("	pp.SetPagesize(500)")
+	pn("")
+	pn("	go func() {")
+	pn("		defer close(errs)")
+	pn("		defer close(ch)")
+	pn("")
+	pn("		for page, count := 1, 0; ; page++ {")
+	pn("			pp.SetPage(page)")
+	pn("")
+	pn("			l, err := s.%sWithContext(ctx, pp)", n)
+	pn("			if err != nil {")
+	pn("				errs <- err")
+	pn("				return")
+	pn("			}")
+	pn("")
+	pn("			for _, v := range l.%s {", ln)
+	pn("				select {")
+	pn("				case ch <- v:")
+	pn("				case <-ctx.Done():")
+	pn("					errs <- ctx.Err()")
+	pn("					return")
+	pn("				}")
+	pn("			}")
+	pn("")
+	pn("			count += len(l.%s)", ln)
+	pn("			if len(l.%s) < 500 || count >= l.Count {", ln)
+	pn("				return")
+	pn("			}")
+	pn("		}")
+	pn("	}()")
+	pn("")
+	pn("	return ch, errs")
+	pn("}")
+	pn("")
+}
+
+func hasNameOrKeywordParamField(params APIParams) (v string, found bool) {
+	for _, p := range params {
+		if p.Name == "keyword" && mapType(p.Type) == "string" {
+			v = "keyword"
+			found = true
+		}
+		if p.Name == "name" && mapType(p.Type) == "string" {
+			return "name", true
+		}
+
+	}
+	return v, found
+}
+
+// Generates a CRUD style facade for every resource of the service that has a list, create, update
+// and delete command, and which can be listed and deleted using only its ID
+func (s *Service) generateFacadeTypes() {
+	pn := s.pn
+
+	apis := make(map[string]*API)
+	for _, a := range s.apis {
+		apis[a.Name] = a
+	}
+
+	for _, l := range s.apis {
+		if !strings.HasPrefix(l.Name, "list") || l.Name == "listLoadBalancerRuleInstances" {
+			continue
+		}
+		ln := strings.TrimPrefix(l.Name, "list")
+		rn := parseSingular(ln)
+
+		c, u, d := apis["create"+rn], apis["update"+rn], apis["delete"+rn]
+		if c == nil || u == nil || d == nil || !hasIDParamField(l.Params) || !hasIDParamField(d.Params) {
+			continue
+		}
+
+		clean := true
+		for _, ap := range l.Params {
+			clean = clean && !ap.Required
+		}
+		for _, ap := range d.Params {
+			clean = clean && (!ap.Required || ap.Name == "id")
+		}
+		if !clean {
+			continue
+		}
+
+		pn("// %sResource provides CRUD style access to the %s resources", rn, rn)
+		pn("type %sResource struct {", rn)
+		pn("	s *%s", s.name)
+		pn("}")
+		pn("")
+		pn("// %sResource returns a CRUD style facade for the %s resources", rn, rn)
+		pn("func (s *%s) %sResource() *%sResource {", s.name, rn, rn)
+		pn("	return &%sResource{s: s}", rn)
+		pn("}")
+		pn("")
+		pn("// List lists the %s resources matching the params", rn)
+		pn("func (r *%sResource) List(p *List%sParams) ([]*%s, error) {", rn, ln, rn)
+		pn("	l, err := r.s.List%s(p)", ln)
+		pn("	if err != nil {")
+		pn("		return nil, err")
+		pn("	}")
+		pn("	return l.%s, nil", ln)
+		pn("}")
+		pn("")
+		pn("// Get gets the %s resource with the given ID", rn)
+		pn("func (r *%sResource) Get(id string, opts ...OptionFunc) (*%s, error) {", rn, rn)
+		pn("	v, _, err := r.s.Get%sByID(id, opts...)", rn)
+		pn("	return v, err")
+		pn("}")
+		pn("")
+		pn("// Create creates a %s resource", rn)
+		pn("func (r *%sResource) Create(p *Create%sParams) (*Create%sResponse, error) {", rn, rn, rn)
+		pn("	return r.s.Create%s(p)", rn)
+		pn("}")
+		pn("")
+		pn("// Update updates a %s resource", rn)
+		pn("func (r *%sResource) Update(p *Update%sParams) (*Update%sResponse, error) {", rn, rn, rn)
+		pn("	return r.s.Update%s(p)", rn)
+		pn("}")
+		pn("")
+		pn("// Delete deletes the %s resource with the given ID", rn)
+		pn("func (r *%sResource) Delete(id string) error {", rn)
+		pn("	p := &Delete%sParams{}", rn)
+		pn("	p.SetId(id)")
+		pn("	_, err := r.s.Delete%s(p)", rn)
+		pn("	return err")
+		pn("}")
+		pn("")
+	}
+}
+
+func (s *Service) hasAPI(name string) bool {
+	for _, a := range s.apis {
+		if a.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+func hasIDParamField(params APIParams) bool {
+	for _, p := range params {
+		if p.Name == "id" && mapType(p.Type) == "string" {
+			return true
+		}
+	}
+	return false
+}
+
+func hasIDAndNameResponseField(resp APIResponses) bool {
+	id := false
+	name := false
+
+	for _, r := range resp {
+		if r.Name == "id" && mapType(r.Type) == "string" {
+			id = true
+		}
+		if r.Name == "name" && mapType(r.Type) == "string" {
+			name = true
+		}
+	}
+	return id && name
+}
+
+// Services which have a cache for their helpers, mapped to the default TTL of the cache
+var cachedServices = map[string]string{
+	"DiskOfferingService":    "5 * time.Minute",
+	"GuestOSService":         "0",
+	"ServiceOfferingService": "5 * time.Minute",
+}
+
+// Services for which a cached ResolveOffering helper is generated, mapped to their offering type
+var resolveOfferingServices = map[string]string{
+	"DiskOfferingService":    "DiskOffering",
+	"ServiceOfferingService": "ServiceOffering",
+}
+
+// Curated set of async commands of which the result only has a single field, so getRawValue cannot
+// tell an unwrapped result from a wrapper. The result of these commands is only unwrapped when it does
+// not contain any of the fields of the response. Add commands here when they fail with "Unable to
+// extract the raw value" errors, or when their result ends up empty after unwrapping.
+var noUnwrapResponses = map[string]bool{
+	"addBaremetalPxeKickStartServer": true,
+	"uploadCustomCertificate":        true,
+}
+
+// Commands of which the response is wrapped in an object with another key than the lowercased name
+// of the command followed by "response"
+var responseKeyOverrides = map[string]string{
+	"addIpToNic":                 "addiptovmnicresponse",
+	"addLdapConfiguration":       "ldapconfigurationresponse",
+	"deleteLdapConfiguration":    "ldapconfigurationresponse",
+	"getUploadParamsForTemplate": "postuploadtemplateresponse",
+	"getUploadParamsForVolume":   "postuploadvolumeresponse",
+	"importLdapUsers":            "ldapuserresponse",
+	"ldapCreateAccount":          "createaccountresponse",
+	"listLdapConfigurations":     "ldapconfigurationresponse",
+	"listLdapUsers":              "ldapuserresponse",
+	"removeIpFromNic":            "removeipfromvmnicresponse",
+	"searchLdap":                 "ldapuserresponse",
+	"updateVMAffinityGroup":      "updatevirtualmachineresponse",
+}
+
+// Returns the key of the object CloudStack wraps the response of the command in
+func responseKey(a *API) string {
+	if key, ok := responseKeyOverrides[a.Name]; ok {
+		return key
+	}
+	return strings.ToLower(a.Name) + "response"
+}
+
+// Curated set of commands mapped to the key of the object their result is wrapped in, inside the
+// response object. The result of these commands is unwrapped by this key instead of by taking the
+// first value of the response.
+var resultKeys = map[string]string{
+	"associateIpAddress":        "ipaddress",
+	"attachVolume":              "volume",
+	"createAccount":             "account",
+	"createNetwork":             "network",
+	"createNetworkOffering":     "networkoffering",
+	"createSSHKeyPair":          "keypair",
+	"createSecurityGroup":       "securitygroup",
+	"createServiceOffering":     "serviceoffering",
+	"createSnapshot":            "snapshot",
+	"createUser":                "user",
+	"createVolume":              "volume",
+	"deployVirtualMachine":      "virtualmachine",
+	"detachVolume":              "volume",
+	"getVMPassword":             "password",
+	"getVirtualMachineUserData": "virtualmachineuserdata",
+	"registerSSHKeyPair":        "keypair",
+	"registerUserKeys":          "userkeys",
+}
+
+// Returns the code that unwraps the raw value of the result in the given variable
+func unwrapCode(a *API, v string) string {
+	if key, ok := resultKeys[a.Name]; ok {
+		return fmt.Sprintf("getRawValueByKey(%s, \"%s\")", v, key)
+	}
+	if noUnwrapResponses[a.Name] {
+		var fields []string
+		for _, r := range a.Response {
+			fields = append(fields, fmt.Sprintf("%q", r.Name))
+		}
+		return fmt.Sprintf("getRawValueUnlessHas(%s, %s)", v, strings.Join(fields, ", "))
+	}
+	return fmt.Sprintf("getRawValue(%s)", v)
+}
+
+// Curated sets of params which CloudStack rejects when more than one of them is set
+var mutuallyExclusiveParams = map[string][][]string{
+	"createTemplate":        {{"snapshotid", "volumeid"}},
+	"deployVirtualMachine":  {{"iptonetworklist", "networkids"}, {"securitygroupids", "securitygroupnames"}},
+	"listNetworks":          {{"account", "projectid"}},
+	"listPublicIpAddresses": {{"account", "projectid"}},
+	"listSnapshots":         {{"account", "projectid"}},
+	"listVirtualMachines":   {{"account", "projectid"}},
+	"listVolumes":           {{"account", "projectid"}},
+	"updateVirtualMachine":  {{"securitygroupids", "securitygroupnames"}},
+}
+
+// Returns the curated mutually exclusive param sets of which all params are known to the API
+func exclusiveParamSets(a *API) [][]string {
+	known := make(map[string]bool)
+	for _, p := range a.Params {
+		known[p.Name] = true
+	}
+
+	var sets [][]string
+	for _, set := range mutuallyExclusiveParams[a.Name] {
+		ok := true
+		for _, param := range set {
+			ok = ok && known[param]
+		}
+		if ok {
+			sets = append(sets, set)
+		}
+	}
+	return sets
+}
+
+func (s *Service) generateNewAPICallFunc(a *API) {
+	pn := s.pn
+	n := capitalize(a.Name)
+
+	rn := strings.TrimPrefix(n, "Configure") + "Response"
+
+	// All calls except the list calls take options, which are applied to the params. The list calls don't,
+	// as the courtesy helpers calling them already applied the options to their params.
+	opts, args := "", ""
+	if !strings.HasPrefix(a.Name, "list") {
+		opts, args = ", opts ...OptionFunc", ", opts..."
+	}
+
+	// Generate the function signature
+	pn("// %s", a.Description)
+	pn("func (s *%s) %s(p *%s%s) (*%s, error) {", s.name, n, n+"Params", opts, rn)
+	pn("	return s.%sWithContext(context.Background(), p%s)", n, args)
+	pn("}")
+	pn("")
+
+	// Generate the context function signature
+	pn("// %sWithContext is the same as %s, but the request is cancelled when the context is done", n, n)
+	pn("func (s *%s) %sWithContext(ctx context.Context, p *%s%s) (*%s, error) {", s.name, n, n+"Params", opts, rn)
+
+	// Generate the context function body
+	if opts != "" {
+		pn("	for _, fn := range s.cs.withDefaultOptions(opts) {")
+		pn("		if err := fn(s.cs, p); err != nil {")
+		pn("			return nil, err")
+		pn("		}")
+		pn("	}")
+		pn("")
+	}
+	if len(requiredParamNames(a)) > 0 {
+		pn("	if err := p.validate(); err != nil {")
+		pn("		return nil, err")
+		pn("	}")
+		pn("")
+	}
+	for _, set := range exclusiveParamSets(a) {
+		pn("	if err := checkMutuallyExclusive(p.p, \"%s\"); err != nil {", strings.Join(set, "\", \""))
+		pn("		return nil, err")
+		pn("	}")
+		pn("")
+	}
+	pn("	return s.%sRawWithContext(ctx, p.toURLValues())", n)
+	pn("}")
+	pn("")
+
+	// Generate the raw function signature
+	pn("// %sRaw is the same as %s, but takes the params as url.Values instead of typed params", n, n)
+	pn("func (s *%s) %sRaw(v url.Values) (*%s, error) {", s.name, n, rn)
+	pn("	return s.%sRawWithContext(context.Background(), v)", n)
+	pn("}")
+	pn("")
+
+	// Generate the raw context function signature
+	pn("// %sRawWithContext is the same as %sRaw, but the request is cancelled when the context is done", n, n)
+	pn("func (s *%s) %sRawWithContext(ctx context.Context, v url.Values) (*%s, error) {", s.name, n, rn)
+
+	// Generate the raw context function body
+	if n == "QueryAsyncJobResult" {
+		pn("	var resp json.RawMessage")
+		pn("	var err error")
+		pn("")
+		pn("	// We should be able to retry on failure as this call is idempotent")
+		pn("	for i := 0; i < 3; i++ {")
+		pn("		resp, err = s.cs.newRequestWithContext(ctx, \"%s\", \"%s\", v)", a.Name, responseKey(a))
+		pn("		if err == nil || ctx.Err() != nil {")
+		pn("			break")
+		pn("		}")
+		pn("		select {")
+		pn("		case <-ctx.Done():")
+		pn("		case <-time.After(500 * time.Millisecond):")
+		pn("		}")
+		pn("	}")
+	} else {
+		pn("	resp, err := s.cs.newRequestWithContext(ctx, \"%s\", \"%s\", v)", a.Name, responseKey(a))
+	}
+	pn("	if err != nil {")
+	pn("		return nil, err")
+	pn("	}")
+	pn("")
+	switch n {
+	case "CreateAccount", "CreateUser", "RegisterUserKeys", "CreateNetwork", "CreateNetworkOffering", "CreateSecurityGroup", "CreateServiceOffering", "CreateSSHKeyPair", "RegisterSSHKeyPair", "GetVMPassword", "GetVirtualMachineUserData":
+		pn("	if resp, err = %s; err != nil {", unwrapCode(a, "resp"))
+		pn("		return nil, err")
+		pn("	}")
+		pn("")
+	}
+	if rc, ok := s.responseConverter(a); ok && rc.sync && !a.Isasync {
+		pn("		resp, err = %s(resp)", rc.fn)
+		pn("		if err != nil {")
+		pn("			return nil, err")
+		pn("		}")
+		pn("")
+	}
+	pn("	var r %s", rn)
+	pn("	if err := json.Unmarshal(resp, &r); err != nil {")
+	pn("		return nil, err")
+	pn("	}")
+	pn("")
+	if s.cfg.StampCommand {
+		pn("	r.cmd = \"%s\"", a.Name)
+		pn("")
+	}
+	if a.Isasync {
+		pn("	// If we have a async client, we need to wait for the async result")
+		pn("	if s.cs.async {")
+		pn("		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)")
+		pn("		if err != nil {")
+		pn("			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {")
+		pn("				return &r, err")
+		pn("			}")
+		pn("			return nil, err")
+		pn("		}")
+		pn("")
+		if !isSuccessOnlyResponse(a.Response) {
+			pn("		b, err = %s", unwrapCode(a, "b"))
+			pn("		if err != nil {")
+			pn("		  return nil, err")
+			pn("		}")
+			pn("")
+		}
+		if rc, ok := s.responseConverter(a); ok && rc.async {
+			pn("		b, err = %s(b)", rc.fn)
+			pn("		if err != nil {")
+			pn("			return nil, err")
+			pn("		}")
+			pn("")
+		}
+		pn("		if err := json.Unmarshal(b, &r); err != nil {")
+		pn("			return nil, err")
+		pn("		}")
+		pn("	}")
+		pn("")
+	}
+	pn("	return &r, nil")
+	pn("}")
+	pn("")
+}
+
+// A custom decode step for commands of which the response does not match the generated response type,
+// for example because older versions of CloudStack return it in a different shape
+type responseConverter struct {
+	fn    string                                // The name of the generated conversion function
+	sync  bool                                  // Convert the response of (sync) commands
+	async bool                                  // Convert the result of async jobs
+	code  func(pn func(string, ...interface{})) // Generates the conversion function(s)
+}
+
+// Curated registry of custom decode steps by command name, or by service name to apply them to every
+// command of the service. To fix a command of which the response cannot be decoded, add a function that
+// generates a conversion function with the signature func([]byte) ([]byte, error) and register it here.
+var responseConverters = map[string]responseConverter{
+	"FirewallService":               {fn: "convertFirewallServiceResponse", sync: true, async: true, code: generateFirewallServiceConverter},
+	"authorizeSecurityGroupEgress":  {fn: "convertAuthorizeSecurityGroupEgressResponse", async: true, code: generateAuthorizeSecurityGroupEgressConverter},
+	"authorizeSecurityGroupIngress": {fn: "convertAuthorizeSecurityGroupIngressResponse", async: true, code: generateAuthorizeSecurityGroupIngressConverter},
+}
+
+// Returns the custom decode step of the command, if any
+func (s *Service) responseConverter(a *API) (responseConverter, bool) {
+	if rc, ok := responseConverters[a.Name]; ok {
+		return rc, true
+	}
+	rc, ok := responseConverters[s.name]
+	return rc, ok
+}
+
+// Generates the conversion functions of all custom decode steps used by the service
+func (s *Service) generateResponseConverterFuncs() {
+	done := make(map[string]bool)
+	for _, a := range s.apis {
+		if rc, ok := s.responseConverter(a); ok && !done[rc.fn] {
+			rc.code(s.pn)
+			done[rc.fn] = true
+		}
+	}
+}
+
+func generateFirewallServiceConverter(pn func(string, ...interface{})) {
+	pn("// Helper function for maintaining backwards compatibility")
+	pn("func convertFirewallServiceResponse(b []byte) ([]byte, error) {")
+	pn("	var raw map[string]interface{}")
+	pn("	if err := json.Unmarshal(b, &raw); err != nil {")
+	pn("		return nil, err")
+	pn("	}")
+	pn("")
+	pn("	if _, ok := raw[\"firewallrule\"]; ok {")
+	pn("		return convertFirewallServiceListResponse(b)")
+	pn("	}")
+	pn("")
+	pn("	for _, k := range []string{\"endport\", \"startport\"} {")
+	pn("		if sVal, ok := raw[k].(string); ok {")
+	pn("			iVal, err := strconv.Atoi(sVal)")
+	pn("			if err != nil {")
+	pn("				return nil, err")
+	pn("			}")
+	pn("			raw[k] = iVal")
+	pn("		}")
+	pn("	}")
+	pn("")
+	pn("	return json.Marshal(raw)")
+	pn("}")
+	pn("")
+	pn("// Helper function for maintaining backwards compatibility")
+	pn("func convertFirewallServiceListResponse(b []byte) ([]byte, error) {")
+	pn("	var rawList struct {")
+	pn("		Count         int                      `json:\"count\"`")
+	pn("		FirewallRules []map[string]interface{} `json:\"firewallrule\"`")
+	pn("	}")
+	pn("")
+	pn("	if err := json.Unmarshal(b, &rawList); err != nil {")
+	pn("		return nil, err")
+	pn("	}")
+	pn("")
+	pn("	for _, r := range rawList.FirewallRules {")
+	pn("		for _, k := range []string{\"endport\", \"startport\"} {")
+	pn("			if sVal, ok := r[k].(string); ok {")
+	pn("				iVal, err := strconv.Atoi(sVal)")
+	pn("				if err != nil {")
+	pn("					return nil, err")
+	pn("				}")
+	pn("				r[k] = iVal")
+	pn("			}")
+	pn("		}")
+	pn("	}")
+	pn("")
+	pn("	return json.Marshal(rawList)")
+	pn("}")
+	pn("")
+}
+
+func generateAuthorizeSecurityGroupIngressConverter(pn func(string, ...interface{})) {
+	pn("// Helper function for maintaining backwards compatibility")
+	pn("func convertAuthorizeSecurityGroupIngressResponse(b []byte) ([]byte, error) {")
+	pn("	var raw struct {")
+	pn("		Ingressrule []interface{} `json:\"ingressrule\"`")
+	pn("	}")
+	pn("	if err := json.Unmarshal(b, &raw); err != nil {")
+	pn("		return nil, err")
+	pn("	}")
+	pn("")
+	pn("	if len(raw.Ingressrule) != 1 {")
+	pn("		return b, nil")
+	pn("	}")
+	pn("")
+	pn("	return json.Marshal(raw.Ingressrule[0])")
+	pn("}")
+	pn("")
+}
+
+func generateAuthorizeSecurityGroupEgressConverter(pn func(string, ...interface{})) {
+	pn("// Helper function for maintaining backwards compatibility")
+	pn("func convertAuthorizeSecurityGroupEgressResponse(b []byte) ([]byte, error) {")
+	pn("	var raw struct {")
+	pn("		Egressrule []interface{} `json:\"egressrule\"`")
+	pn("	}")
+	pn("	if err := json.Unmarshal(b, &raw); err != nil {")
+	pn("		return nil, err")
+	pn("	}")
+	pn("")
+	pn("	if len(raw.Egressrule) != 1 {")
+	pn("		return b, nil")
+	pn("	}")
+	pn("")
+	pn("	return json.Marshal(raw.Egressrule[0])")
+	pn("}")
+	pn("")
+}
+
+func isSuccessOnlyResponse(resp APIResponses) bool {
+	success := false
+	displaytext := false
+
+	for _, r := range resp {
+		if r.Name == "displaytext" {
+			displaytext = true
+		}
+		if r.Name == "success" {
+			success = true
+		}
+	}
+	return displaytext && success
+}
+
+func (s *Service) generateResponseType(a *API) {
+	pn := s.pn
+	tn := capitalize(strings.TrimPrefix(a.Name, "configure") + "Response")
+	ln := capitalize(strings.TrimPrefix(a.Name, "list"))
+
+	// If this is a 'list' response, we need an seperate list struct. There seem to be other
+	// types of responses that also need a seperate list struct, so checking on exact matches
+	// for those once.
+	if s.cfg.StampCommand {
+		defer s.generateSourceCommandFunc(tn)
+	}
+
+	if strings.HasPrefix(a.Name, "list") || a.Name == "registerTemplate" {
+		pn("type %s struct {", tn)
+		if s.cfg.StampCommand {
+			pn("	cmd string")
+		}
+		pn("	Count int `json:\"count%s\"`", s.omitEmpty())
+
+		// This nasty check is for some specific response that do not behave consistent
+		switch a.Name {
+		case "listAsyncJobs":
+			pn("	%s []*%s `json:\"%s%s\"`", ln, parseSingular(ln), "asyncjobs", s.omitEmpty())
+		case "listEgressFirewallRules":
+			pn("	%s []*%s `json:\"%s%s\"`", ln, parseSingular(ln), "firewallrule", s.omitEmpty())
+		case "listLoadBalancerRuleInstances":
+			pn("	LBRuleVMIDIPs []*%s `json:\"%s%s\"`", parseSingular(ln), "lbrulevmidip", s.omitEmpty())
+			pn("	LoadBalancerRuleInstances []*VirtualMachine `json:\"%s%s\"`", strings.ToLower(parseSingular(ln)), s.omitEmpty())
+		case "registerTemplate":
+			pn("	%s []*%s `json:\"%s%s\"`", ln, parseSingular(ln), "template", s.omitEmpty())
+		default:
+			pn("	%s []*%s `json:\"%s%s\"`", ln, parseSingular(ln), strings.ToLower(parseSingular(ln)), s.omitEmpty())
+		}
+		pn("}")
+		pn("")
+		tn = parseSingular(ln)
+	}
+
+	pn("type %s struct {", tn)
+	if s.cfg.StampCommand && tn == capitalize(strings.TrimPrefix(a.Name, "configure")+"Response") {
+		pn("	cmd string")
+	}
+	if a.Isasync {
+		pn("	JobID string `json:\"jobid%s\"`", s.omitEmpty())
+	}
+	sort.Sort(a.Response)
+	customMarshal := s.recusiveGenerateResponseType(a.Response, a.Isasync, false)
+	pn("}")
+	pn("")
+
+	floats := floatResponseFields(a.Response)
+	if customMarshal || len(floats) > 0 {
+		pn("func (r *%s) UnmarshalJSON(b []byte) error {", tn)
+		if len(floats) > 0 {
+			pn("	// Some versions return these numbers as strings")
+			pn("	var raw map[string]json.RawMessage")
+			pn("	if err := json.Unmarshal(b, &raw); err != nil {")
+			pn("		return err")
+			pn("	}")
+			pn("")
+			pn("	coerced := false")
+			pn("	for _, k := range []string{\"%s\"} {", strings.Join(floats, "\", \""))
+			pn("		var v string")
+			pn("		if json.Unmarshal(raw[k], &v) != nil {")
+			pn("			continue")
+			pn("		}")
+			pn("		f, err := strconv.ParseFloat(strings.TrimSpace(v), 64)")
+			pn("		if err != nil {")
+			pn("			return fmt.Errorf(\"Unable to decode %%s %%q as a number: %%v\", k, v, err)")
+			pn("		}")
+			pn("		raw[k] = json.RawMessage(strconv.FormatFloat(f, 'g', -1, 64))")
+			pn("		coerced = true")
+			pn("	}")
+			pn("")
+			pn("	if coerced {")
+			pn("		nb, err := json.Marshal(raw)")
+			pn("		if err != nil {")
+			pn("			return err")
+			pn("		}")
+			pn("		b = nb")
+			pn("	}")
+			pn("")
+		}
+		if customMarshal {
+			pn("	var m map[string]interface{}")
+			pn("	err := json.Unmarshal(b, &m)")
+			pn("	if err != nil {")
+			pn("		return err")
+			pn("	}")
+			pn("")
+			pn("	if success, ok := m[\"success\"].(string); ok {")
+			pn("		m[\"success\"] = success == \"true\"")
+			pn("		b, err = json.Marshal(m)")
+			pn("		if err != nil {")
+			pn("			return err")
+			pn("		}")
+			pn("	}")
+			pn("")
+		}
+		pn("	type alias %s", tn)
+		pn("	return json.Unmarshal(b, (*alias)(r))")
+		pn("}")
+		pn("")
+	}
+}
+
+// Returns the names of the (not nested) response fields of a floating point type
+func floatResponseFields(resp APIResponses) []string {
+	var floats []string
+	found := make(map[string]bool)
+	for _, r := range resp {
+		if r.Response == nil && !found[r.Name] && mapResponseType(r.Type) == "float64" {
+			floats = append(floats, r.Name)
+			found[r.Name] = true
+		}
+	}
+	return floats
+}
+
+func (s *Service) generateSourceCommandFunc(tn string) {
+	pn := s.pn
+
+	pn("// SourceCommand returns the name of the command that produced this response")
+	pn("func (r *%s) SourceCommand() string {", tn)
+	pn("	return r.cmd")
+	pn("}")
+	pn("")
+}
+
+func parseSingular(n string) string {
+	if strings.HasSuffix(n, "ies") {
+		return strings.TrimSuffix(n, "ies") + "y"
+	}
+	if strings.HasSuffix(n, "sses") {
+		return strings.TrimSuffix(n, "es")
+	}
+	return strings.TrimSuffix(n, "s")
+}
+
+// Returns the option to add to the JSON tags of the response fields
+func (s *Service) omitEmpty() string {
+	if s.cfg.OmitEmpty {
+		return ",omitempty"
+	}
+	return ""
+}
+
+// Returns the Go type of a response field, which is a pointer for boolean and numeric fields when
+// optional pointers are enabled
+func (s *Service) responseFieldType(t string) string {
+	typ := mapResponseType(t)
+	switch typ {
+	case "bool", "int", "int64", "float64":
+		if s.cfg.OptionalPointers {
+			return "*" + typ
+		}
+	}
+	return typ
+}
+
+func (s *Service) recusiveGenerateResponseType(resp APIResponses, async, customMarshal bool) bool {
+	pn := s.pn
+	found := make(map[string]bool)
+
+	for _, r := range resp {
+		if r.Name == "" {
+			continue
+		}
+		if r.Name == "secondaryip" {
+			pn("%s []struct {", capitalize(r.Name))
+			pn("Id string `json:\"id%s\"`", s.omitEmpty())
+			pn("Ipaddress string `json:\"ipaddress%s\"`", s.omitEmpty())
+			pn("} `json:\"%s%s\"`", r.Name, s.omitEmpty())
+			continue
+		}
+		if r.Response != nil {
+			pn("%s []struct {", capitalize(r.Name))
+			sort.Sort(r.Response)
+			customMarshal = s.recusiveGenerateResponseType(r.Response, async, customMarshal)
+			pn("} `json:\"%s%s\"`", r.Name, s.omitEmpty())
+		} else {
+			if !found[r.Name] {
+				// This code is needed because the response field is different for sync and async calls :(
+				if r.Name == "success" {
+					pn("%s bool `json:\"%s%s\"`", capitalize(r.Name), r.Name, s.omitEmpty())
+					if !async {
+						customMarshal = true
+					}
+				} else {
+					pn("%s %s `json:\"%s%s\"`", capitalize(r.Name), s.responseFieldType(r.Type), r.Name, s.omitEmpty())
+				}
+				found[r.Name] = true
+			}
+		}
+	}
+
+	return customMarshal
+}
+
+// GetAllServices groups the given APIs into services using the given layout. It returns an error
+// for every API in the layout for which no details could be found in the given API info.
+func GetAllServices(ai map[string]*API, layout APIInfo, cfg *Config) (*AllServices, []error) {
+	if cfg == nil {
+		cfg = &Config{}
+	}
+
+	// Generate a complete set of services with their methods (APIs)
+	as := &AllServices{cfg: cfg}
+	errors := []error{}
+	// Process the services and their APIs in sorted order, so the generated code
+	// (and any errors) are the same for every run
+	names := make([]string, 0, len(layout))
+	for sn := range layout {
+		names = append(names, sn)
+	}
+	sort.Strings(names)
+
+	for _, sn := range names {
+		apis := append([]string(nil), layout[sn]...)
+		sort.Strings(apis)
+
+		s := &Service{name: sn, cfg: cfg}
+		for _, api := range apis {
+			a, found := ai[api]
+			if !found {
+				errors = append(errors, &apiInfoNotFoundError{api})
+				continue
+			}
+			s.apis = append(s.apis, a)
+		}
+		for _, apis := range s.apis {
+			sort.Sort(apis.Params)
+		}
+		for _, a := range s.apis {
+			if _, ok := detailsEncoding(a); !ok {
+				as.warnings = append(as.warnings, fmt.Sprintf(
+					"Unable to determine the encoding of the details param of %s, using inline encoding (add it to detailsRequireKeyValue)", a.Name))
+			}
+		}
+		as.services = append(as.services, s)
+	}
+
+	// Add an extra field to enable adding a custom service
+	if !cfg.NoCustom {
+		as.services = append(as.services, &Service{name: "CustomService", cfg: cfg})
+	}
+
+	// Add an extra service for the annotations API helpers, as the annotations API is
+	// not yet part of the listApis output of the CloudStack version this is generated for
+	if _, found := layout["AnnotationService"]; !found {
+		as.services = append(as.services, &Service{name: "AnnotationService", cfg: cfg})
+	}
+
+	sort.Sort(as.services)
+	return as, errors
+}
+
+// GetAPIInfo reads the saved JSON output of listApis and returns a map with all APIs by name
+func GetAPIInfo(listApis string) (map[string]*API, error) {
+	apis, err := ioutil.ReadFile(listApis)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAPIInfo(apis)
+}
+
+// ParseAPIInfo decodes the JSON output of listApis and returns a map with all APIs by name. The
+// output can be passed either as returned by the API or with the listapisresponse object removed.
+func ParseAPIInfo(b []byte) (map[string]*API, error) {
+	var ar struct {
+		Count int    `json:"count"`
+		APIs  []*API `json:"api"`
+	}
+
+	var wrapped struct {
+		Response json.RawMessage `json:"listapisresponse"`
+	}
+	if err := json.Unmarshal(b, &wrapped); err != nil {
+		return nil, err
+	}
+	if wrapped.Response != nil {
+		b = wrapped.Response
+	}
+
+	if err := json.Unmarshal(b, &ar); err != nil {
+		return nil, err
+	}
+
+	// Make a map of all retrieved APIs
+	ai := make(map[string]*API)
+	for _, api := range ar.APIs {
+		ai[api.Name] = api
+	}
+	return ai, nil
+}
+
+// MergeAPIInfo merges the API info of multiple CloudStack versions, so the generated code works
+// with all of them. The params and response fields of every API are unioned, where a param is only
+// required if it is required in all versions. Conflicting integer types are widened, any other
+// conflicts are resolved in favor of the first API info and returned as errors.
+func MergeAPIInfo(ais ...map[string]*API) (map[string]*API, []error) {
+	merged := make(map[string]*API)
+	errors := []error{}
+
+	// Count in how many versions every API exists
+	count := make(map[string]int)
+	for _, ai := range ais {
+		for name := range ai {
+			count[name]++
+		}
+	}
+
+	for _, ai := range ais {
+		// Merge the APIs in sorted order, so any errors are always in the same order
+		names := make([]string, 0, len(ai))
+		for name := range ai {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		for _, name := range names {
+			api := ai[name]
+			m, found := merged[name]
+			if !found {
+				m = &API{Name: api.Name, Description: api.Description, Isasync: api.Isasync}
+				merged[name] = m
+			} else if m.Isasync != api.Isasync {
+				errors = append(errors, &mergeConflictError{name, "isasync", "async in only some versions"})
+			}
+			if m.Description == "" {
+				m.Description = api.Description
+			}
+			mergeParams(m, api.Params, &errors)
+			m.Response = mergeResponses(name, m.Response, api.Response, &errors)
+		}
+	}
+
+	// A param is only required if it is required in all versions
+	for name, api := range merged {
+		for _, p := range api.Params {
+			if p.required < count[name] {
+				p.Required = false
+			}
+		}
+	}
+
+	return merged, errors
+}
+
+func mergeParams(m *API, params APIParams, errors *[]error) {
+	for _, p := range params {
+		var mp *APIParam
+		for _, existing := range m.Params {
+			if existing.Name == p.Name {
+				mp = existing
+				break
+			}
+		}
+		if mp == nil {
+			mp = &APIParam{Name: p.Name, Description: p.Description, Type: p.Type, Required: true}
+			m.Params = append(m.Params, mp)
+		}
+		if p.Required {
+			mp.required++
+		}
+		if typ, ok := mergeType(mp.Type, p.Type); ok {
+			mp.Type = typ
+		} else {
+			*errors = append(*errors, &mergeConflictError{m.Name, "param " + p.Name, fmt.Sprintf("%s and %s", mp.Type, p.Type)})
+		}
+	}
+}
+
+func mergeResponses(api string, merged, resp APIResponses, errors *[]error) APIResponses {
+	for _, r := range resp {
+		var mr *APIResponse
+		for _, existing := range merged {
+			if existing.Name == r.Name {
+				mr = existing
+				break
+			}
+		}
+		if mr == nil {
+			mr = &APIResponse{Name: r.Name, Description: r.Description, Type: r.Type}
+			merged = append(merged, mr)
+		}
+		if typ, ok := mergeType(mr.Type, r.Type); ok {
+			mr.Type = typ
+		} else {
+			*errors = append(*errors, &mergeConflictError{api, "response field " + r.Name, fmt.Sprintf("%s and %s", mr.Type, r.Type)})
+		}
+		mr.Response = mergeResponses(api, mr.Response, r.Response, errors)
+	}
+	return merged
+}
+
+// Returns the type that can hold the values of both types, if there is one
+func mergeType(a, b string) (string, bool) {
+	if mapType(a) == mapType(b) {
+		return a, true
+	}
+	ints := map[string]bool{"short": true, "int": true, "integer": true, "long": true}
+	if ints[a] && ints[b] {
+		return "long", true
+	}
+	return a, false
+}
+
+func mapType(t string) string {
+	switch t {
+	case "boolean":
+		return "bool"
+	case "short", "int", "integer":
+		return "int"
+	case "long":
+		return "int64"
+	case "float", "double":
+		return "float64"
+	case "list":
+		return "[]string"
+	case "map":
+		return "map[string]string"
+	case "set":
+		return "[]interface{}"
+	case "responseobject":
+		return "json.RawMessage"
+	case "uservmresponse":
+		// This is a really specific anomaly of the API
+		return "*VirtualMachine"
+	case "outofbandmanagementresponse":
+		return "OutOfBandManagementResponse"
+	default:
+		return "string"
+	}
+}
+
+// Same as mapType, but for the types of response fields
+func mapResponseType(t string) string {
+	if t == "map" {
+		return "StringMap"
+	}
+	return mapType(t)
+}
+
+func capitalize(s string) string {
+	r := []rune(s)
+	r[0] = unicode.ToUpper(r[0])
+	return string(r)
+}
+
+func uncapitalize(s string) string {
+	r := []rune(s)
+	r[0] = unicode.ToLower(r[0])
+	return string(r)
+}
@@ -0,0 +1,119 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package generator
+
+import (
+	"bytes"
+	"flag"
+	"io/ioutil"
+	"path"
+	"path/filepath"
+	"testing"
+)
+
+var updateGolden = flag.Bool("update-golden", false, "update the golden files instead of verifying them")
+
+// TestGolden compares the code generated for a small fixture covering sync, async and list APIs
+// and the special cased services with the golden files, so every change to the generated code
+// is made explicit. When the changes are intended, run the test with -update-golden.
+func TestGolden(t *testing.T) {
+	const dir = "testdata/golden"
+
+	ai, err := GetAPIInfo("testdata/listApis.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Only use the APIs found in the fixture, so a small
+	// representative fixture can be used for the golden files
+	as, errs := GetAllServices(ai, filterLayout(Layout, ai), &Config{})
+	for _, err := range errs {
+		t.Errorf("Failed to get the services: %v", err)
+	}
+
+	files := make(map[string][]byte)
+
+	code, err := as.GeneralCode()
+	if err != nil {
+		t.Fatalf("Failed to generate the general code: %v", err)
+	}
+	files["cloudstack.go"] = code
+
+	for _, s := range as.Services() {
+		code, err := s.GenerateCode()
+		if err != nil {
+			t.Fatalf("Failed to generate %s: %v", s.Name(), err)
+		}
+		files[s.Name()+".go"] = code
+	}
+
+	if *updateGolden {
+		for name, code := range files {
+			if err := ioutil.WriteFile(path.Join(dir, name), code, 0644); err != nil {
+				t.Fatal(err)
+			}
+		}
+		return
+	}
+
+	for name, code := range files {
+		want, err := ioutil.ReadFile(path.Join(dir, name))
+		if err != nil {
+			t.Errorf("Failed to read the golden file: %v", err)
+			continue
+		}
+		if line, ok := firstDiff(want, code); ok {
+			t.Errorf("Generated code differs from %s at line %d", path.Join(dir, name), line)
+		}
+	}
+
+	// Golden files of services which are no longer generated are stale
+	golden, err := filepath.Glob(path.Join(dir, "*.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, file := range golden {
+		if _, found := files[filepath.Base(file)]; !found {
+			t.Errorf("Golden file %s is no longer generated", file)
+		}
+	}
+}
+
+// Returns a layout containing only the services and APIs found in the API info
+func filterLayout(layout APIInfo, ai map[string]*API) APIInfo {
+	filtered := make(APIInfo)
+	for sn, apis := range layout {
+		for _, api := range apis {
+			if _, found := ai[api]; found {
+				filtered[sn] = append(filtered[sn], api)
+			}
+		}
+	}
+	return filtered
+}
+
+// Returns the first line number at which a and b differ, if they differ at all
+func firstDiff(a, b []byte) (int, bool) {
+	al := bytes.Split(a, []byte("\n"))
+	bl := bytes.Split(b, []byte("\n"))
+	for i := 0; i < len(al) || i < len(bl); i++ {
+		if i >= len(al) || i >= len(bl) || !bytes.Equal(al[i], bl[i]) {
+			return i + 1, true
+		}
+	}
+	return 0, false
+}
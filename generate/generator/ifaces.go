@@ -0,0 +1,141 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package generator
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// InterfacesFile is the name of the file ServiceInterfaces writes the interfaces to
+const InterfacesFile = "interfaces.go"
+
+// ServiceInterfaces writes an interface for every service of the generated package in dir to the
+// interfaces file, containing all exported methods of the service. Callers can depend on these
+// interfaces instead of on the services, so the services can be mocked in tests. The interfaces are
+// derived from the generated (and goimports'ed) code instead of being generated with it, so they
+// include the helpers written by hand and match the methods after the context was made the first
+// argument. Imports are not added, so goimports should be run on dir afterwards.
+func ServiceInterfaces(dir string) error {
+	names, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return err
+	}
+	sort.Strings(names)
+
+	// The method declarations of every service, by service name
+	fset := token.NewFileSet()
+	methods := make(map[string][]*ast.FuncDecl)
+	for _, name := range names {
+		if filepath.Base(name) == InterfacesFile {
+			continue
+		}
+		f, err := parser.ParseFile(fset, name, nil, 0)
+		if err != nil {
+			return err
+		}
+		for _, d := range f.Decls {
+			fd, ok := d.(*ast.FuncDecl)
+			if !ok || !fd.Name.IsExported() {
+				continue
+			}
+			if sn, ok := serviceReceiver(fd); ok {
+				methods[sn] = append(methods[sn], fd)
+			}
+		}
+	}
+
+	services := make([]string, 0, len(methods))
+	for sn := range methods {
+		services = append(services, sn)
+	}
+	sort.Strings(services)
+
+	var buf bytes.Buffer
+	pn := func(format string, args ...interface{}) {
+		fmt.Fprintf(&buf, format+"\n", args...)
+	}
+
+	pn("//")
+	pn("// Copyright 2018, Sander van Harmelen")
+	pn("//")
+	pn("// Licensed under the Apache License, Version 2.0 (the \"License\");")
+	pn("// you may not use this file except in compliance with the License.")
+	pn("// You may obtain a copy of the License at")
+	pn("//")
+	pn("//     http://www.apache.org/licenses/LICENSE-2.0")
+	pn("//")
+	pn("// Unless required by applicable law or agreed to in writing, software")
+	pn("// distributed under the License is distributed on an \"AS IS\" BASIS,")
+	pn("// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.")
+	pn("// See the License for the specific language governing permissions and")
+	pn("// limitations under the License.")
+	pn("//")
+	pn("")
+	pn("package %s", pkg)
+	pn("")
+	for _, sn := range services {
+		fds := methods[sn]
+		sort.Slice(fds, func(i, j int) bool {
+			return fds[i].Name.Name < fds[j].Name.Name
+		})
+
+		pn("// %sIface contains all exported methods of %s, so callers can depend on it to mock the service", sn, sn)
+		pn("type %sIface interface {", sn)
+		for _, fd := range fds {
+			var sig bytes.Buffer
+			if err := format.Node(&sig, fset, fd.Type); err != nil {
+				return err
+			}
+			pn("	%s%s", fd.Name.Name, strings.TrimPrefix(sig.String(), "func"))
+		}
+		pn("}")
+		pn("")
+		pn("var _ %sIface = (*%s)(nil)", sn, sn)
+		pn("")
+	}
+
+	code, err := format.Source(buf.Bytes())
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(filepath.Join(dir, InterfacesFile), code, 0644)
+}
+
+// Returns the name of the service if the function is a method of a service
+func serviceReceiver(fd *ast.FuncDecl) (string, bool) {
+	if fd.Recv == nil || len(fd.Recv.List) != 1 {
+		return "", false
+	}
+	star, ok := fd.Recv.List[0].Type.(*ast.StarExpr)
+	if !ok {
+		return "", false
+	}
+	id, ok := star.X.(*ast.Ident)
+	if !ok || !strings.HasSuffix(id.Name, "Service") {
+		return "", false
+	}
+	return id.Name, true
+}
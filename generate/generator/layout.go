@@ -14,9 +14,10 @@
 // limitations under the License.
 //
 
-package main
+package generator
 
-var layout = apiInfo{
+// Layout defines how the APIs are grouped into services
+var Layout = APIInfo{
 	"LoadBalancerService": {
 		"addF5LoadBalancer",
 		"addNetscalerLoadBalancer",
@@ -0,0 +1,177 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstack
+
+// ResultText returns the job result and true if the result type of the job is text, which
+// is for example the case for the error message of a failed job.
+func (r *QueryAsyncJobResultResponse) ResultText() (string, bool) {
+	if r.Jobresulttype != "text" {
+		return "", false
+	}
+
+	var text string
+	if err := json.Unmarshal(r.Jobresult, &text); err != nil {
+		return string(r.Jobresult), true
+	}
+	return text, true
+}
+
+// ResultAs decodes the job result into out. If the result type of the job is text instead
+// of an object, the text is returned as an error.
+func (r *QueryAsyncJobResultResponse) ResultAs(out interface{}) error {
+	if text, ok := r.ResultText(); ok {
+		return fmt.Errorf("Unable to decode text job result: %s", text)
+	}
+	return json.Unmarshal(r.Jobresult, out)
+}
+
+// InstanceRef returns the type and ID of the resource the job acted on, for example
+// "VirtualMachine" and the ID of the deployed virtual machine. Both are empty if the
+// job is not related to a specific resource.
+func (r *QueryAsyncJobResultResponse) InstanceRef() (resourceType, id string) {
+	return r.Jobinstancetype, r.Jobinstanceid
+}
+
+type QueryAsyncJobResultParams struct {
+	p map[string]interface{}
+}
+
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *QueryAsyncJobResultParams) Clone() *QueryAsyncJobResultParams {
+	c := &QueryAsyncJobResultParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
+func (p *QueryAsyncJobResultParams) toURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["jobid"]; found {
+		u.Set("jobid", v.(string))
+	}
+	return u
+}
+
+// Returns an error naming the first required param which is not set
+func (p *QueryAsyncJobResultParams) validate() error {
+	for _, k := range []string{"jobid"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of queryAsyncJobResult", k)
+		}
+	}
+	return nil
+}
+
+func (p *QueryAsyncJobResultParams) SetJobid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["jobid"] = v
+	return
+}
+
+// SetJobidIfUnset sets jobid to v, unless it is already set
+func (p *QueryAsyncJobResultParams) SetJobidIfUnset(v string) {
+	if _, found := p.p["jobid"]; !found {
+		p.SetJobid(v)
+	}
+}
+
+// You should always use this function to get a new QueryAsyncJobResultParams instance,
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *AsyncjobService) NewQueryAsyncJobResultParams(jobid string, opts ...func(*QueryAsyncJobResultParams)) *QueryAsyncJobResultParams {
+	p := &QueryAsyncJobResultParams{}
+	p.p = make(map[string]interface{})
+	p.p["jobid"] = jobid
+	for _, fn := range opts {
+		fn(p)
+	}
+	return p
+}
+
+// Retrieves the current status of asynchronous job.
+func (s *AsyncjobService) QueryAsyncJobResult(p *QueryAsyncJobResultParams, opts ...OptionFunc) (*QueryAsyncJobResultResponse, error) {
+	return s.QueryAsyncJobResultWithContext(context.Background(), p, opts...)
+}
+
+// QueryAsyncJobResultWithContext is the same as QueryAsyncJobResult, but the request is cancelled when the context is done
+func (s *AsyncjobService) QueryAsyncJobResultWithContext(ctx context.Context, p *QueryAsyncJobResultParams, opts ...OptionFunc) (*QueryAsyncJobResultResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.QueryAsyncJobResultRawWithContext(ctx, p.toURLValues())
+}
+
+// QueryAsyncJobResultRaw is the same as QueryAsyncJobResult, but takes the params as url.Values instead of typed params
+func (s *AsyncjobService) QueryAsyncJobResultRaw(v url.Values) (*QueryAsyncJobResultResponse, error) {
+	return s.QueryAsyncJobResultRawWithContext(context.Background(), v)
+}
+
+// QueryAsyncJobResultRawWithContext is the same as QueryAsyncJobResultRaw, but the request is cancelled when the context is done
+func (s *AsyncjobService) QueryAsyncJobResultRawWithContext(ctx context.Context, v url.Values) (*QueryAsyncJobResultResponse, error) {
+	var resp json.RawMessage
+	var err error
+
+	// We should be able to retry on failure as this call is idempotent
+	for i := 0; i < 3; i++ {
+		resp, err = s.cs.newRequestWithContext(ctx, "queryAsyncJobResult", "queryasyncjobresultresponse", v)
+		if err == nil || ctx.Err() != nil {
+			break
+		}
+		select {
+		case <-ctx.Done():
+		case <-time.After(500 * time.Millisecond):
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var r QueryAsyncJobResultResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+type QueryAsyncJobResultResponse struct {
+	Accountid       string          `json:"accountid"`
+	Cmd             string          `json:"cmd"`
+	Created         string          `json:"created"`
+	Jobinstanceid   string          `json:"jobinstanceid"`
+	Jobinstancetype string          `json:"jobinstancetype"`
+	Jobprocstatus   int             `json:"jobprocstatus"`
+	Jobresult       json.RawMessage `json:"jobresult"`
+	Jobresultcode   int             `json:"jobresultcode"`
+	Jobresulttype   string          `json:"jobresulttype"`
+	Jobstatus       int             `json:"jobstatus"`
+	Userid          string          `json:"userid"`
+}
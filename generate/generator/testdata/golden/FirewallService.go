@@ -0,0 +1,869 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstack
+
+// Helper function for maintaining backwards compatibility
+func convertFirewallServiceResponse(b []byte) ([]byte, error) {
+	var raw map[string]interface{}
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return nil, err
+	}
+
+	if _, ok := raw["firewallrule"]; ok {
+		return convertFirewallServiceListResponse(b)
+	}
+
+	for _, k := range []string{"endport", "startport"} {
+		if sVal, ok := raw[k].(string); ok {
+			iVal, err := strconv.Atoi(sVal)
+			if err != nil {
+				return nil, err
+			}
+			raw[k] = iVal
+		}
+	}
+
+	return json.Marshal(raw)
+}
+
+// Helper function for maintaining backwards compatibility
+func convertFirewallServiceListResponse(b []byte) ([]byte, error) {
+	var rawList struct {
+		Count         int                      `json:"count"`
+		FirewallRules []map[string]interface{} `json:"firewallrule"`
+	}
+
+	if err := json.Unmarshal(b, &rawList); err != nil {
+		return nil, err
+	}
+
+	for _, r := range rawList.FirewallRules {
+		for _, k := range []string{"endport", "startport"} {
+			if sVal, ok := r[k].(string); ok {
+				iVal, err := strconv.Atoi(sVal)
+				if err != nil {
+					return nil, err
+				}
+				r[k] = iVal
+			}
+		}
+	}
+
+	return json.Marshal(rawList)
+}
+
+type CreateFirewallRuleParams struct {
+	p map[string]interface{}
+}
+
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *CreateFirewallRuleParams) Clone() *CreateFirewallRuleParams {
+	c := &CreateFirewallRuleParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
+func (p *CreateFirewallRuleParams) toURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["cidrlist"]; found {
+		vv := strings.Join(v.([]string), ",")
+		u.Set("cidrlist", vv)
+	}
+	if v, found := p.p["endport"]; found {
+		vv := strconv.Itoa(v.(int))
+		u.Set("endport", vv)
+	}
+	if v, found := p.p["fordisplay"]; found {
+		vv := strconv.FormatBool(v.(bool))
+		u.Set("fordisplay", vv)
+	}
+	if v, found := p.p["icmpcode"]; found {
+		vv := strconv.Itoa(v.(int))
+		u.Set("icmpcode", vv)
+	}
+	if v, found := p.p["icmptype"]; found {
+		vv := strconv.Itoa(v.(int))
+		u.Set("icmptype", vv)
+	}
+	if v, found := p.p["ipaddressid"]; found {
+		u.Set("ipaddressid", v.(string))
+	}
+	if v, found := p.p["protocol"]; found {
+		u.Set("protocol", v.(string))
+	}
+	if v, found := p.p["startport"]; found {
+		vv := strconv.Itoa(v.(int))
+		u.Set("startport", vv)
+	}
+	if v, found := p.p["type"]; found {
+		u.Set("type", v.(string))
+	}
+	return u
+}
+
+// Returns an error naming the first required param which is not set
+func (p *CreateFirewallRuleParams) validate() error {
+	for _, k := range []string{"ipaddressid", "protocol"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of createFirewallRule", k)
+		}
+	}
+	return nil
+}
+
+func (p *CreateFirewallRuleParams) SetCidrlist(v []string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["cidrlist"] = v
+	return
+}
+
+// SetCidrlistIfUnset sets cidrlist to v, unless it is already set
+func (p *CreateFirewallRuleParams) SetCidrlistIfUnset(v []string) {
+	if _, found := p.p["cidrlist"]; !found {
+		p.SetCidrlist(v)
+	}
+}
+
+// SetCidrlistCSV splits v on commas and sets the resulting values
+func (p *CreateFirewallRuleParams) SetCidrlistCSV(v string) {
+	p.SetCidrlist(splitCSV(v))
+}
+
+func (p *CreateFirewallRuleParams) SetEndport(v int) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["endport"] = v
+	return
+}
+
+// SetEndportIfUnset sets endport to v, unless it is already set
+func (p *CreateFirewallRuleParams) SetEndportIfUnset(v int) {
+	if _, found := p.p["endport"]; !found {
+		p.SetEndport(v)
+	}
+}
+
+func (p *CreateFirewallRuleParams) SetFordisplay(v bool) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["fordisplay"] = v
+	return
+}
+
+// SetFordisplayIfUnset sets fordisplay to v, unless it is already set
+func (p *CreateFirewallRuleParams) SetFordisplayIfUnset(v bool) {
+	if _, found := p.p["fordisplay"]; !found {
+		p.SetFordisplay(v)
+	}
+}
+
+func (p *CreateFirewallRuleParams) SetIcmpcode(v int) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["icmpcode"] = v
+	return
+}
+
+// SetIcmpcodeIfUnset sets icmpcode to v, unless it is already set
+func (p *CreateFirewallRuleParams) SetIcmpcodeIfUnset(v int) {
+	if _, found := p.p["icmpcode"]; !found {
+		p.SetIcmpcode(v)
+	}
+}
+
+func (p *CreateFirewallRuleParams) SetIcmptype(v int) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["icmptype"] = v
+	return
+}
+
+// SetIcmptypeIfUnset sets icmptype to v, unless it is already set
+func (p *CreateFirewallRuleParams) SetIcmptypeIfUnset(v int) {
+	if _, found := p.p["icmptype"]; !found {
+		p.SetIcmptype(v)
+	}
+}
+
+func (p *CreateFirewallRuleParams) SetIpaddressid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["ipaddressid"] = v
+	return
+}
+
+// SetIpaddressidIfUnset sets ipaddressid to v, unless it is already set
+func (p *CreateFirewallRuleParams) SetIpaddressidIfUnset(v string) {
+	if _, found := p.p["ipaddressid"]; !found {
+		p.SetIpaddressid(v)
+	}
+}
+
+func (p *CreateFirewallRuleParams) SetProtocol(v Protocol) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["protocol"] = string(v)
+	return
+}
+
+// SetProtocolIfUnset sets protocol to v, unless it is already set
+func (p *CreateFirewallRuleParams) SetProtocolIfUnset(v Protocol) {
+	if _, found := p.p["protocol"]; !found {
+		p.SetProtocol(v)
+	}
+}
+
+func (p *CreateFirewallRuleParams) SetStartport(v int) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["startport"] = v
+	return
+}
+
+// SetStartportIfUnset sets startport to v, unless it is already set
+func (p *CreateFirewallRuleParams) SetStartportIfUnset(v int) {
+	if _, found := p.p["startport"]; !found {
+		p.SetStartport(v)
+	}
+}
+
+func (p *CreateFirewallRuleParams) SetType(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["type"] = v
+	return
+}
+
+// SetTypeIfUnset sets type to v, unless it is already set
+func (p *CreateFirewallRuleParams) SetTypeIfUnset(v string) {
+	if _, found := p.p["type"]; !found {
+		p.SetType(v)
+	}
+}
+
+// You should always use this function to get a new CreateFirewallRuleParams instance,
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *FirewallService) NewCreateFirewallRuleParams(ipaddressid string, protocol string, opts ...func(*CreateFirewallRuleParams)) *CreateFirewallRuleParams {
+	p := &CreateFirewallRuleParams{}
+	p.p = make(map[string]interface{})
+	p.p["ipaddressid"] = ipaddressid
+	p.p["protocol"] = protocol
+	for _, fn := range opts {
+		fn(p)
+	}
+	return p
+}
+
+// Creates a firewall rule for a given IP address
+func (s *FirewallService) CreateFirewallRule(p *CreateFirewallRuleParams, opts ...OptionFunc) (*CreateFirewallRuleResponse, error) {
+	return s.CreateFirewallRuleWithContext(context.Background(), p, opts...)
+}
+
+// CreateFirewallRuleWithContext is the same as CreateFirewallRule, but the request is cancelled when the context is done
+func (s *FirewallService) CreateFirewallRuleWithContext(ctx context.Context, p *CreateFirewallRuleParams, opts ...OptionFunc) (*CreateFirewallRuleResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.CreateFirewallRuleRawWithContext(ctx, p.toURLValues())
+}
+
+// CreateFirewallRuleRaw is the same as CreateFirewallRule, but takes the params as url.Values instead of typed params
+func (s *FirewallService) CreateFirewallRuleRaw(v url.Values) (*CreateFirewallRuleResponse, error) {
+	return s.CreateFirewallRuleRawWithContext(context.Background(), v)
+}
+
+// CreateFirewallRuleRawWithContext is the same as CreateFirewallRuleRaw, but the request is cancelled when the context is done
+func (s *FirewallService) CreateFirewallRuleRawWithContext(ctx context.Context, v url.Values) (*CreateFirewallRuleResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "createFirewallRule", "createfirewallruleresponse", v)
+	if err != nil {
+		return nil, err
+	}
+
+	var r CreateFirewallRuleResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	// If we have a async client, we need to wait for the async result
+	if s.cs.async {
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
+		if err != nil {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
+				return &r, err
+			}
+			return nil, err
+		}
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		b, err = convertFirewallServiceResponse(b)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := json.Unmarshal(b, &r); err != nil {
+			return nil, err
+		}
+	}
+
+	return &r, nil
+}
+
+type CreateFirewallRuleResponse struct {
+	JobID       string `json:"jobid"`
+	Cidrlist    string `json:"cidrlist"`
+	Endport     int    `json:"endport"`
+	Fordisplay  bool   `json:"fordisplay"`
+	Icmpcode    int    `json:"icmpcode"`
+	Icmptype    int    `json:"icmptype"`
+	Id          string `json:"id"`
+	Ipaddress   string `json:"ipaddress"`
+	Ipaddressid string `json:"ipaddressid"`
+	Networkid   string `json:"networkid"`
+	Protocol    string `json:"protocol"`
+	Startport   int    `json:"startport"`
+	State       string `json:"state"`
+	Tags        []struct {
+		Account      string `json:"account"`
+		Customer     string `json:"customer"`
+		Domain       string `json:"domain"`
+		Domainid     string `json:"domainid"`
+		Key          string `json:"key"`
+		Project      string `json:"project"`
+		Projectid    string `json:"projectid"`
+		Resourceid   string `json:"resourceid"`
+		Resourcetype string `json:"resourcetype"`
+		Value        string `json:"value"`
+	} `json:"tags"`
+}
+
+type ListFirewallRulesParams struct {
+	p map[string]interface{}
+}
+
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ListFirewallRulesParams) Clone() *ListFirewallRulesParams {
+	c := &ListFirewallRulesParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
+func (p *ListFirewallRulesParams) toURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["account"]; found {
+		u.Set("account", v.(string))
+	}
+	if v, found := p.p["domainid"]; found {
+		u.Set("domainid", v.(string))
+	}
+	if v, found := p.p["fordisplay"]; found {
+		vv := strconv.FormatBool(v.(bool))
+		u.Set("fordisplay", vv)
+	}
+	if v, found := p.p["id"]; found {
+		u.Set("id", v.(string))
+	}
+	if v, found := p.p["ipaddressid"]; found {
+		u.Set("ipaddressid", v.(string))
+	}
+	if v, found := p.p["isrecursive"]; found {
+		vv := strconv.FormatBool(v.(bool))
+		u.Set("isrecursive", vv)
+	}
+	if v, found := p.p["keyword"]; found {
+		u.Set("keyword", v.(string))
+	}
+	if v, found := p.p["listall"]; found {
+		vv := strconv.FormatBool(v.(bool))
+		u.Set("listall", vv)
+	}
+	if v, found := p.p["networkid"]; found {
+		u.Set("networkid", v.(string))
+	}
+	if v, found := p.p["page"]; found {
+		vv := strconv.Itoa(v.(int))
+		u.Set("page", vv)
+	}
+	if v, found := p.p["pagesize"]; found {
+		vv := strconv.Itoa(v.(int))
+		u.Set("pagesize", vv)
+	}
+	if v, found := p.p["projectid"]; found {
+		u.Set("projectid", v.(string))
+	}
+	if v, found := p.p["tags"]; found {
+		m := v.(map[string]string)
+		for i, k := range getSortedKeysFromMap(m) {
+			u.Set(fmt.Sprintf("tags[%d].key", i), k)
+			u.Set(fmt.Sprintf("tags[%d].value", i), m[k])
+		}
+	}
+	return u
+}
+
+func (p *ListFirewallRulesParams) SetAccount(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["account"] = v
+	return
+}
+
+// SetAccountIfUnset sets account to v, unless it is already set
+func (p *ListFirewallRulesParams) SetAccountIfUnset(v string) {
+	if _, found := p.p["account"]; !found {
+		p.SetAccount(v)
+	}
+}
+
+func (p *ListFirewallRulesParams) SetDomainid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["domainid"] = v
+	return
+}
+
+// SetDomainidIfUnset sets domainid to v, unless it is already set
+func (p *ListFirewallRulesParams) SetDomainidIfUnset(v string) {
+	if _, found := p.p["domainid"]; !found {
+		p.SetDomainid(v)
+	}
+}
+
+func (p *ListFirewallRulesParams) SetFordisplay(v bool) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["fordisplay"] = v
+	return
+}
+
+// SetFordisplayIfUnset sets fordisplay to v, unless it is already set
+func (p *ListFirewallRulesParams) SetFordisplayIfUnset(v bool) {
+	if _, found := p.p["fordisplay"]; !found {
+		p.SetFordisplay(v)
+	}
+}
+
+func (p *ListFirewallRulesParams) SetId(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["id"] = v
+	return
+}
+
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *ListFirewallRulesParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
+func (p *ListFirewallRulesParams) SetIpaddressid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["ipaddressid"] = v
+	return
+}
+
+// SetIpaddressidIfUnset sets ipaddressid to v, unless it is already set
+func (p *ListFirewallRulesParams) SetIpaddressidIfUnset(v string) {
+	if _, found := p.p["ipaddressid"]; !found {
+		p.SetIpaddressid(v)
+	}
+}
+
+func (p *ListFirewallRulesParams) SetIsrecursive(v bool) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["isrecursive"] = v
+	return
+}
+
+// SetIsrecursiveIfUnset sets isrecursive to v, unless it is already set
+func (p *ListFirewallRulesParams) SetIsrecursiveIfUnset(v bool) {
+	if _, found := p.p["isrecursive"]; !found {
+		p.SetIsrecursive(v)
+	}
+}
+
+func (p *ListFirewallRulesParams) SetKeyword(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["keyword"] = v
+	return
+}
+
+// SetKeywordIfUnset sets keyword to v, unless it is already set
+func (p *ListFirewallRulesParams) SetKeywordIfUnset(v string) {
+	if _, found := p.p["keyword"]; !found {
+		p.SetKeyword(v)
+	}
+}
+
+func (p *ListFirewallRulesParams) SetListall(v bool) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["listall"] = v
+	return
+}
+
+// SetListallIfUnset sets listall to v, unless it is already set
+func (p *ListFirewallRulesParams) SetListallIfUnset(v bool) {
+	if _, found := p.p["listall"]; !found {
+		p.SetListall(v)
+	}
+}
+
+func (p *ListFirewallRulesParams) SetNetworkid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["networkid"] = v
+	return
+}
+
+// SetNetworkidIfUnset sets networkid to v, unless it is already set
+func (p *ListFirewallRulesParams) SetNetworkidIfUnset(v string) {
+	if _, found := p.p["networkid"]; !found {
+		p.SetNetworkid(v)
+	}
+}
+
+func (p *ListFirewallRulesParams) SetPage(v int) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["page"] = v
+	return
+}
+
+// SetPageIfUnset sets page to v, unless it is already set
+func (p *ListFirewallRulesParams) SetPageIfUnset(v int) {
+	if _, found := p.p["page"]; !found {
+		p.SetPage(v)
+	}
+}
+
+func (p *ListFirewallRulesParams) SetPagesize(v int) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["pagesize"] = v
+	return
+}
+
+// SetPagesizeIfUnset sets pagesize to v, unless it is already set
+func (p *ListFirewallRulesParams) SetPagesizeIfUnset(v int) {
+	if _, found := p.p["pagesize"]; !found {
+		p.SetPagesize(v)
+	}
+}
+
+func (p *ListFirewallRulesParams) SetProjectid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["projectid"] = v
+	return
+}
+
+// SetProjectidIfUnset sets projectid to v, unless it is already set
+func (p *ListFirewallRulesParams) SetProjectidIfUnset(v string) {
+	if _, found := p.p["projectid"]; !found {
+		p.SetProjectid(v)
+	}
+}
+
+func (p *ListFirewallRulesParams) SetTags(v map[string]string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["tags"] = v
+	return
+}
+
+// SetTagsIfUnset sets tags to v, unless it is already set
+func (p *ListFirewallRulesParams) SetTagsIfUnset(v map[string]string) {
+	if _, found := p.p["tags"]; !found {
+		p.SetTags(v)
+	}
+}
+
+var _ ListAllSetter = (*ListFirewallRulesParams)(nil)
+
+// You should always use this function to get a new ListFirewallRulesParams instance,
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *FirewallService) NewListFirewallRulesParams(opts ...func(*ListFirewallRulesParams)) *ListFirewallRulesParams {
+	p := &ListFirewallRulesParams{}
+	p.p = make(map[string]interface{})
+	for _, fn := range opts {
+		fn(p)
+	}
+	return p
+}
+
+func (p *ListFirewallRulesParams) setFirstMatch() {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p[firstMatchKey] = true
+}
+
+// This is a courtesy helper function, which in some cases may not work as expected!
+func (s *FirewallService) GetFirewallRuleByID(id string, opts ...OptionFunc) (*FirewallRule, int, error) {
+	p := &ListFirewallRulesParams{}
+	p.p = make(map[string]interface{})
+
+	p.p["id"] = id
+
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, -1, err
+		}
+	}
+
+	l, err := s.ListFirewallRules(p)
+	if err != nil {
+		if strings.Contains(err.Error(), fmt.Sprintf(
+			"Invalid parameter id value=%s due to incorrect long value format, "+
+				"or entity does not exist", id)) {
+			return nil, 0, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
+		}
+		return nil, -1, err
+	}
+
+	if l.Count == 0 {
+		return nil, l.Count, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
+	}
+
+	if l.Count == 1 {
+		return l.FirewallRules[0], l.Count, nil
+	}
+
+	if _, ok := p.p[firstMatchKey]; ok && len(l.FirewallRules) > 0 {
+		return l.FirewallRules[0], l.Count, nil
+	}
+	return nil, l.Count, fmt.Errorf("There is more then one result for FirewallRule UUID: %s!", id)
+}
+
+// FirewallRuleExists returns true if a FirewallRule with the given ID exists. When no match is found
+// it returns false, while any other error is returned as is.
+func (s *FirewallService) FirewallRuleExists(id string, opts ...OptionFunc) (bool, error) {
+	_, count, err := s.GetFirewallRuleByID(id, opts...)
+	if errors.Is(err, ErrNotFound) {
+		return false, nil
+	}
+	if count > 0 {
+		return true, nil
+	}
+	return false, err
+}
+
+// Lists all firewall rules for an IP address.
+func (s *FirewallService) ListFirewallRules(p *ListFirewallRulesParams) (*ListFirewallRulesResponse, error) {
+	return s.ListFirewallRulesWithContext(context.Background(), p)
+}
+
+// ListFirewallRulesWithContext is the same as ListFirewallRules, but the request is cancelled when the context is done
+func (s *FirewallService) ListFirewallRulesWithContext(ctx context.Context, p *ListFirewallRulesParams) (*ListFirewallRulesResponse, error) {
+	return s.ListFirewallRulesRawWithContext(ctx, p.toURLValues())
+}
+
+// ListFirewallRulesRaw is the same as ListFirewallRules, but takes the params as url.Values instead of typed params
+func (s *FirewallService) ListFirewallRulesRaw(v url.Values) (*ListFirewallRulesResponse, error) {
+	return s.ListFirewallRulesRawWithContext(context.Background(), v)
+}
+
+// ListFirewallRulesRawWithContext is the same as ListFirewallRulesRaw, but the request is cancelled when the context is done
+func (s *FirewallService) ListFirewallRulesRawWithContext(ctx context.Context, v url.Values) (*ListFirewallRulesResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "listFirewallRules", "listfirewallrulesresponse", v)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err = convertFirewallServiceResponse(resp)
+	if err != nil {
+		return nil, err
+	}
+
+	var r ListFirewallRulesResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+// StreamFirewallRules fetches the results of ListFirewallRules page by page in the background and sends them on the
+// returned channel. Both returned channels are closed when done. If fetching a page fails, or the context
+// is done before all results are sent, the error is sent on the error channel and fetching stops. A
+// request for a page in flight is cancelled when the context is done. The page and pagesize set in the
+// params are ignored.
+func (s *FirewallService) StreamFirewallRules(ctx context.Context, p *ListFirewallRulesParams) (<-chan *FirewallRule, <-chan error) {
+	ch := make(chan *FirewallRule)
+	errs := make(chan error, 1)
+
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesize(500)
+
+	go func() {
+		defer close(errs)
+		defer close(ch)
+
+		for page, count := 1, 0; ; page++ {
+			pp.SetPage(page)
+
+			l, err := s.ListFirewallRulesWithContext(ctx, pp)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			for _, v := range l.FirewallRules {
+				select {
+				case ch <- v:
+				case <-ctx.Done():
+					errs <- ctx.Err()
+					return
+				}
+			}
+
+			count += len(l.FirewallRules)
+			if len(l.FirewallRules) < 500 || count >= l.Count {
+				return
+			}
+		}
+	}()
+
+	return ch, errs
+}
+
+// ListFirewallRulesWhere returns all results of ListFirewallRules for which pred returns true. Note that the results are
+// filtered client-side, so all results matching the params are fetched first.
+// All pages are fetched, so the page and pagesize set in the params are ignored.
+func (s *FirewallService) ListFirewallRulesWhere(p *ListFirewallRulesParams, pred func(*FirewallRule) bool) ([]*FirewallRule, error) {
+	var r []*FirewallRule
+	ch, errs := s.StreamFirewallRules(context.Background(), p)
+	for v := range ch {
+		if pred(v) {
+			r = append(r, v)
+		}
+	}
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ListAllFirewallRules returns the results of ListFirewallRules across all pages, by calling ListFirewallRules page by page until all
+// results are fetched. The pagesize set in the params is used for every page (500 if not set), and the
+// page set in the params is ignored.
+func (s *FirewallService) ListAllFirewallRules(p *ListFirewallRulesParams) ([]*FirewallRule, error) {
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesizeIfUnset(500)
+	pagesize := pp.p["pagesize"].(int)
+
+	var r []*FirewallRule
+	for page := 1; ; page++ {
+		pp.SetPage(page)
+
+		l, err := s.ListFirewallRules(pp)
+		if err != nil {
+			return nil, err
+		}
+		r = append(r, l.FirewallRules...)
+
+		if len(l.FirewallRules) < pagesize || len(r) >= l.Count {
+			return r, nil
+		}
+	}
+}
+
+type ListFirewallRulesResponse struct {
+	Count         int             `json:"count"`
+	FirewallRules []*FirewallRule `json:"firewallrule"`
+}
+
+type FirewallRule struct {
+	Cidrlist    string `json:"cidrlist"`
+	Endport     int    `json:"endport"`
+	Fordisplay  bool   `json:"fordisplay"`
+	Icmpcode    int    `json:"icmpcode"`
+	Icmptype    int    `json:"icmptype"`
+	Id          string `json:"id"`
+	Ipaddress   string `json:"ipaddress"`
+	Ipaddressid string `json:"ipaddressid"`
+	Networkid   string `json:"networkid"`
+	Protocol    string `json:"protocol"`
+	Startport   int    `json:"startport"`
+	State       string `json:"state"`
+	Tags        []struct {
+		Account      string `json:"account"`
+		Customer     string `json:"customer"`
+		Domain       string `json:"domain"`
+		Domainid     string `json:"domainid"`
+		Key          string `json:"key"`
+		Project      string `json:"project"`
+		Projectid    string `json:"projectid"`
+		Resourceid   string `json:"resourceid"`
+		Resourcetype string `json:"resourcetype"`
+		Value        string `json:"value"`
+	} `json:"tags"`
+}
@@ -0,0 +1,1031 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstack
+
+// ErrRouterFailed is returned (wrapped) by CreateNetworkAndWaitImplemented when a virtual router of the
+// network failed to deploy
+var ErrRouterFailed = errors.New("Virtual router failed")
+
+// CreateNetworkAndWaitImplemented creates the network and polls until its state is Implemented (or Setup,
+// the final state of networks without a virtual router) or the timeout expires (a timeout of 0 means no
+// timeout), so VMs can be deployed into it right away. When a virtual router of the network ends up in the
+// Error state, the network is returned together with an ErrRouterFailed error. Note that a non-persistent
+// isolated network is only implemented when the first VM is deployed into it, so the network offering
+// should be persistent. The options are used when getting the network and listing its routers.
+func (s *NetworkService) CreateNetworkAndWaitImplemented(p *CreateNetworkParams, timeout time.Duration, opts ...OptionFunc) (*Network, error) {
+	ctx := context.Background()
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	r, err := s.CreateNetwork(p)
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		network, _, err := s.GetNetworkByID(r.Id, opts...)
+		if err != nil {
+			return nil, err
+		}
+		switch network.State {
+		case "Implemented", "Setup":
+			return network, nil
+		}
+
+		rp := s.cs.Router.NewListRoutersParams()
+		rp.SetNetworkid(r.Id)
+		for _, fn := range s.cs.withDefaultOptions(opts) {
+			if err := fn(s.cs, rp); err != nil {
+				return nil, err
+			}
+		}
+		l, err := s.cs.Router.ListRouters(rp)
+		if err != nil {
+			return nil, err
+		}
+		for _, router := range l.Routers {
+			if router.State == "Error" {
+				return network, fmt.Errorf("%w: router %s of network %s is in state %s", ErrRouterFailed, router.Id, r.Id, router.State)
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return network, fmt.Errorf("Timeout waiting for network %s to be implemented, last state was %s: %w", r.Id, network.State, ctx.Err())
+		case <-time.After(2 * time.Second):
+		}
+	}
+}
+
+type DeleteNetworkParams struct {
+	p map[string]interface{}
+}
+
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *DeleteNetworkParams) Clone() *DeleteNetworkParams {
+	c := &DeleteNetworkParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
+func (p *DeleteNetworkParams) toURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["forced"]; found {
+		vv := strconv.FormatBool(v.(bool))
+		u.Set("forced", vv)
+	}
+	if v, found := p.p["id"]; found {
+		u.Set("id", v.(string))
+	}
+	return u
+}
+
+// Returns an error naming the first required param which is not set
+func (p *DeleteNetworkParams) validate() error {
+	for _, k := range []string{"id"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of deleteNetwork", k)
+		}
+	}
+	return nil
+}
+
+func (p *DeleteNetworkParams) SetForced(v bool) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["forced"] = v
+	return
+}
+
+// SetForcedIfUnset sets forced to v, unless it is already set
+func (p *DeleteNetworkParams) SetForcedIfUnset(v bool) {
+	if _, found := p.p["forced"]; !found {
+		p.SetForced(v)
+	}
+}
+
+func (p *DeleteNetworkParams) SetId(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["id"] = v
+	return
+}
+
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *DeleteNetworkParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
+// You should always use this function to get a new DeleteNetworkParams instance,
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *NetworkService) NewDeleteNetworkParams(id string, opts ...func(*DeleteNetworkParams)) *DeleteNetworkParams {
+	p := &DeleteNetworkParams{}
+	p.p = make(map[string]interface{})
+	p.p["id"] = id
+	for _, fn := range opts {
+		fn(p)
+	}
+	return p
+}
+
+// Deletes a network
+func (s *NetworkService) DeleteNetwork(p *DeleteNetworkParams, opts ...OptionFunc) (*DeleteNetworkResponse, error) {
+	return s.DeleteNetworkWithContext(context.Background(), p, opts...)
+}
+
+// DeleteNetworkWithContext is the same as DeleteNetwork, but the request is cancelled when the context is done
+func (s *NetworkService) DeleteNetworkWithContext(ctx context.Context, p *DeleteNetworkParams, opts ...OptionFunc) (*DeleteNetworkResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.DeleteNetworkRawWithContext(ctx, p.toURLValues())
+}
+
+// DeleteNetworkRaw is the same as DeleteNetwork, but takes the params as url.Values instead of typed params
+func (s *NetworkService) DeleteNetworkRaw(v url.Values) (*DeleteNetworkResponse, error) {
+	return s.DeleteNetworkRawWithContext(context.Background(), v)
+}
+
+// DeleteNetworkRawWithContext is the same as DeleteNetworkRaw, but the request is cancelled when the context is done
+func (s *NetworkService) DeleteNetworkRawWithContext(ctx context.Context, v url.Values) (*DeleteNetworkResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteNetwork", "deletenetworkresponse", v)
+	if err != nil {
+		return nil, err
+	}
+
+	var r DeleteNetworkResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	// If we have a async client, we need to wait for the async result
+	if s.cs.async {
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
+		if err != nil {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
+				return &r, err
+			}
+			return nil, err
+		}
+
+		if err := json.Unmarshal(b, &r); err != nil {
+			return nil, err
+		}
+	}
+
+	return &r, nil
+}
+
+// WaitForNetworkDeleted polls until the Network with the given ID no longer exists, the timeout
+// expires or the context is cancelled. Deleted resources may still be listed for a short while
+// after the delete command finished, so this can be used to make sure they are really gone. The
+// options are passed to GetNetworkByID, so a resource outside of the default scope can be found.
+func (s *NetworkService) WaitForNetworkDeleted(ctx context.Context, id string, timeout time.Duration, opts ...OptionFunc) error {
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	for {
+		_, count, err := s.GetNetworkByID(id, opts...)
+		if count == 0 {
+			return nil
+		}
+		if count < 0 {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(2 * time.Second):
+		}
+	}
+}
+
+type DeleteNetworkResponse struct {
+	JobID       string `json:"jobid"`
+	Displaytext string `json:"displaytext"`
+	Success     bool   `json:"success"`
+}
+
+type ListNetworksParams struct {
+	p map[string]interface{}
+}
+
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ListNetworksParams) Clone() *ListNetworksParams {
+	c := &ListNetworksParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
+func (p *ListNetworksParams) toURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["account"]; found {
+		u.Set("account", v.(string))
+	}
+	if v, found := p.p["acltype"]; found {
+		u.Set("acltype", v.(string))
+	}
+	if v, found := p.p["canusefordeploy"]; found {
+		vv := strconv.FormatBool(v.(bool))
+		u.Set("canusefordeploy", vv)
+	}
+	if v, found := p.p["displaynetwork"]; found {
+		vv := strconv.FormatBool(v.(bool))
+		u.Set("displaynetwork", vv)
+	}
+	if v, found := p.p["domainid"]; found {
+		u.Set("domainid", v.(string))
+	}
+	if v, found := p.p["forvpc"]; found {
+		vv := strconv.FormatBool(v.(bool))
+		u.Set("forvpc", vv)
+	}
+	if v, found := p.p["id"]; found {
+		u.Set("id", v.(string))
+	}
+	if v, found := p.p["isrecursive"]; found {
+		vv := strconv.FormatBool(v.(bool))
+		u.Set("isrecursive", vv)
+	}
+	if v, found := p.p["issystem"]; found {
+		vv := strconv.FormatBool(v.(bool))
+		u.Set("issystem", vv)
+	}
+	if v, found := p.p["keyword"]; found {
+		u.Set("keyword", v.(string))
+	}
+	if v, found := p.p["listall"]; found {
+		vv := strconv.FormatBool(v.(bool))
+		u.Set("listall", vv)
+	}
+	if v, found := p.p["page"]; found {
+		vv := strconv.Itoa(v.(int))
+		u.Set("page", vv)
+	}
+	if v, found := p.p["pagesize"]; found {
+		vv := strconv.Itoa(v.(int))
+		u.Set("pagesize", vv)
+	}
+	if v, found := p.p["physicalnetworkid"]; found {
+		u.Set("physicalnetworkid", v.(string))
+	}
+	if v, found := p.p["projectid"]; found {
+		u.Set("projectid", v.(string))
+	}
+	if v, found := p.p["restartrequired"]; found {
+		vv := strconv.FormatBool(v.(bool))
+		u.Set("restartrequired", vv)
+	}
+	if v, found := p.p["specifyipranges"]; found {
+		vv := strconv.FormatBool(v.(bool))
+		u.Set("specifyipranges", vv)
+	}
+	if v, found := p.p["supportedservices"]; found {
+		vv := strings.Join(v.([]string), ",")
+		u.Set("supportedservices", vv)
+	}
+	if v, found := p.p["tags"]; found {
+		m := v.(map[string]string)
+		for i, k := range getSortedKeysFromMap(m) {
+			u.Set(fmt.Sprintf("tags[%d].key", i), k)
+			u.Set(fmt.Sprintf("tags[%d].value", i), m[k])
+		}
+	}
+	if v, found := p.p["traffictype"]; found {
+		u.Set("traffictype", v.(string))
+	}
+	if v, found := p.p["type"]; found {
+		u.Set("type", v.(string))
+	}
+	if v, found := p.p["vpcid"]; found {
+		u.Set("vpcid", v.(string))
+	}
+	if v, found := p.p["zoneid"]; found {
+		u.Set("zoneid", v.(string))
+	}
+	return u
+}
+
+func (p *ListNetworksParams) SetAccount(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["account"] = v
+	return
+}
+
+// SetAccountIfUnset sets account to v, unless it is already set
+func (p *ListNetworksParams) SetAccountIfUnset(v string) {
+	if _, found := p.p["account"]; !found {
+		p.SetAccount(v)
+	}
+}
+
+func (p *ListNetworksParams) SetAcltype(v ACLType) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["acltype"] = string(v)
+	return
+}
+
+// SetAcltypeIfUnset sets acltype to v, unless it is already set
+func (p *ListNetworksParams) SetAcltypeIfUnset(v ACLType) {
+	if _, found := p.p["acltype"]; !found {
+		p.SetAcltype(v)
+	}
+}
+
+func (p *ListNetworksParams) SetCanusefordeploy(v bool) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["canusefordeploy"] = v
+	return
+}
+
+// SetCanusefordeployIfUnset sets canusefordeploy to v, unless it is already set
+func (p *ListNetworksParams) SetCanusefordeployIfUnset(v bool) {
+	if _, found := p.p["canusefordeploy"]; !found {
+		p.SetCanusefordeploy(v)
+	}
+}
+
+func (p *ListNetworksParams) SetDisplaynetwork(v bool) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["displaynetwork"] = v
+	return
+}
+
+// SetDisplaynetworkIfUnset sets displaynetwork to v, unless it is already set
+func (p *ListNetworksParams) SetDisplaynetworkIfUnset(v bool) {
+	if _, found := p.p["displaynetwork"]; !found {
+		p.SetDisplaynetwork(v)
+	}
+}
+
+func (p *ListNetworksParams) SetDomainid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["domainid"] = v
+	return
+}
+
+// SetDomainidIfUnset sets domainid to v, unless it is already set
+func (p *ListNetworksParams) SetDomainidIfUnset(v string) {
+	if _, found := p.p["domainid"]; !found {
+		p.SetDomainid(v)
+	}
+}
+
+func (p *ListNetworksParams) SetForvpc(v bool) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["forvpc"] = v
+	return
+}
+
+// SetForvpcIfUnset sets forvpc to v, unless it is already set
+func (p *ListNetworksParams) SetForvpcIfUnset(v bool) {
+	if _, found := p.p["forvpc"]; !found {
+		p.SetForvpc(v)
+	}
+}
+
+func (p *ListNetworksParams) SetId(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["id"] = v
+	return
+}
+
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *ListNetworksParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
+func (p *ListNetworksParams) SetIsrecursive(v bool) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["isrecursive"] = v
+	return
+}
+
+// SetIsrecursiveIfUnset sets isrecursive to v, unless it is already set
+func (p *ListNetworksParams) SetIsrecursiveIfUnset(v bool) {
+	if _, found := p.p["isrecursive"]; !found {
+		p.SetIsrecursive(v)
+	}
+}
+
+func (p *ListNetworksParams) SetIssystem(v bool) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["issystem"] = v
+	return
+}
+
+// SetIssystemIfUnset sets issystem to v, unless it is already set
+func (p *ListNetworksParams) SetIssystemIfUnset(v bool) {
+	if _, found := p.p["issystem"]; !found {
+		p.SetIssystem(v)
+	}
+}
+
+func (p *ListNetworksParams) SetKeyword(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["keyword"] = v
+	return
+}
+
+// SetKeywordIfUnset sets keyword to v, unless it is already set
+func (p *ListNetworksParams) SetKeywordIfUnset(v string) {
+	if _, found := p.p["keyword"]; !found {
+		p.SetKeyword(v)
+	}
+}
+
+func (p *ListNetworksParams) SetListall(v bool) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["listall"] = v
+	return
+}
+
+// SetListallIfUnset sets listall to v, unless it is already set
+func (p *ListNetworksParams) SetListallIfUnset(v bool) {
+	if _, found := p.p["listall"]; !found {
+		p.SetListall(v)
+	}
+}
+
+func (p *ListNetworksParams) SetPage(v int) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["page"] = v
+	return
+}
+
+// SetPageIfUnset sets page to v, unless it is already set
+func (p *ListNetworksParams) SetPageIfUnset(v int) {
+	if _, found := p.p["page"]; !found {
+		p.SetPage(v)
+	}
+}
+
+func (p *ListNetworksParams) SetPagesize(v int) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["pagesize"] = v
+	return
+}
+
+// SetPagesizeIfUnset sets pagesize to v, unless it is already set
+func (p *ListNetworksParams) SetPagesizeIfUnset(v int) {
+	if _, found := p.p["pagesize"]; !found {
+		p.SetPagesize(v)
+	}
+}
+
+func (p *ListNetworksParams) SetPhysicalnetworkid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["physicalnetworkid"] = v
+	return
+}
+
+// SetPhysicalnetworkidIfUnset sets physicalnetworkid to v, unless it is already set
+func (p *ListNetworksParams) SetPhysicalnetworkidIfUnset(v string) {
+	if _, found := p.p["physicalnetworkid"]; !found {
+		p.SetPhysicalnetworkid(v)
+	}
+}
+
+func (p *ListNetworksParams) SetProjectid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["projectid"] = v
+	return
+}
+
+// SetProjectidIfUnset sets projectid to v, unless it is already set
+func (p *ListNetworksParams) SetProjectidIfUnset(v string) {
+	if _, found := p.p["projectid"]; !found {
+		p.SetProjectid(v)
+	}
+}
+
+func (p *ListNetworksParams) SetRestartrequired(v bool) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["restartrequired"] = v
+	return
+}
+
+// SetRestartrequiredIfUnset sets restartrequired to v, unless it is already set
+func (p *ListNetworksParams) SetRestartrequiredIfUnset(v bool) {
+	if _, found := p.p["restartrequired"]; !found {
+		p.SetRestartrequired(v)
+	}
+}
+
+func (p *ListNetworksParams) SetSpecifyipranges(v bool) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["specifyipranges"] = v
+	return
+}
+
+// SetSpecifyiprangesIfUnset sets specifyipranges to v, unless it is already set
+func (p *ListNetworksParams) SetSpecifyiprangesIfUnset(v bool) {
+	if _, found := p.p["specifyipranges"]; !found {
+		p.SetSpecifyipranges(v)
+	}
+}
+
+func (p *ListNetworksParams) SetSupportedservices(v []string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["supportedservices"] = v
+	return
+}
+
+// SetSupportedservicesIfUnset sets supportedservices to v, unless it is already set
+func (p *ListNetworksParams) SetSupportedservicesIfUnset(v []string) {
+	if _, found := p.p["supportedservices"]; !found {
+		p.SetSupportedservices(v)
+	}
+}
+
+// SetSupportedservicesCSV splits v on commas and sets the resulting values
+func (p *ListNetworksParams) SetSupportedservicesCSV(v string) {
+	p.SetSupportedservices(splitCSV(v))
+}
+
+func (p *ListNetworksParams) SetTags(v map[string]string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["tags"] = v
+	return
+}
+
+// SetTagsIfUnset sets tags to v, unless it is already set
+func (p *ListNetworksParams) SetTagsIfUnset(v map[string]string) {
+	if _, found := p.p["tags"]; !found {
+		p.SetTags(v)
+	}
+}
+
+func (p *ListNetworksParams) SetTraffictype(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["traffictype"] = v
+	return
+}
+
+// SetTraffictypeIfUnset sets traffictype to v, unless it is already set
+func (p *ListNetworksParams) SetTraffictypeIfUnset(v string) {
+	if _, found := p.p["traffictype"]; !found {
+		p.SetTraffictype(v)
+	}
+}
+
+func (p *ListNetworksParams) SetType(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["type"] = v
+	return
+}
+
+// SetTypeIfUnset sets type to v, unless it is already set
+func (p *ListNetworksParams) SetTypeIfUnset(v string) {
+	if _, found := p.p["type"]; !found {
+		p.SetType(v)
+	}
+}
+
+func (p *ListNetworksParams) SetVpcid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["vpcid"] = v
+	return
+}
+
+// SetVpcidIfUnset sets vpcid to v, unless it is already set
+func (p *ListNetworksParams) SetVpcidIfUnset(v string) {
+	if _, found := p.p["vpcid"]; !found {
+		p.SetVpcid(v)
+	}
+}
+
+func (p *ListNetworksParams) SetZoneid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["zoneid"] = v
+	return
+}
+
+// SetZoneidIfUnset sets zoneid to v, unless it is already set
+func (p *ListNetworksParams) SetZoneidIfUnset(v string) {
+	if _, found := p.p["zoneid"]; !found {
+		p.SetZoneid(v)
+	}
+}
+
+var _ ListAllSetter = (*ListNetworksParams)(nil)
+
+// You should always use this function to get a new ListNetworksParams instance,
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *NetworkService) NewListNetworksParams(opts ...func(*ListNetworksParams)) *ListNetworksParams {
+	p := &ListNetworksParams{}
+	p.p = make(map[string]interface{})
+	for _, fn := range opts {
+		fn(p)
+	}
+	return p
+}
+
+func (p *ListNetworksParams) setFirstMatch() {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p[firstMatchKey] = true
+}
+
+// This is a courtesy helper function, which in some cases may not work as expected!
+func (s *NetworkService) GetNetworkID(keyword string, opts ...OptionFunc) (string, int, error) {
+	p := &ListNetworksParams{}
+	p.p = make(map[string]interface{})
+
+	p.p["keyword"] = keyword
+
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return "", -1, err
+		}
+	}
+
+	l, err := s.ListNetworks(p)
+	if err != nil {
+		return "", -1, err
+	}
+
+	if l.Count == 0 {
+		return "", l.Count, fmt.Errorf("%w for %s: %+v", ErrNotFound, keyword, l)
+	}
+
+	if l.Count == 1 {
+		return l.Networks[0].Id, l.Count, nil
+	}
+
+	if l.Count > 1 {
+		for _, v := range l.Networks {
+			if v.Name == keyword {
+				return v.Id, l.Count, nil
+			}
+		}
+
+		if _, ok := p.p[firstMatchKey]; ok && len(l.Networks) > 0 {
+			return l.Networks[0].Id, l.Count, nil
+		}
+	}
+	return "", l.Count, fmt.Errorf("Could not find an exact match for %s: %+v", keyword, l)
+}
+
+// This is a courtesy helper function, which in some cases may not work as expected!
+func (s *NetworkService) GetNetworkByName(name string, opts ...OptionFunc) (*Network, int, error) {
+	id, count, err := s.GetNetworkID(name, opts...)
+	if err != nil {
+		return nil, count, err
+	}
+
+	r, count, err := s.GetNetworkByID(id, opts...)
+	if err != nil {
+		return nil, count, err
+	}
+	return r, count, nil
+}
+
+// This is a courtesy helper function, which in some cases may not work as expected!
+func (s *NetworkService) GetNetworkByID(id string, opts ...OptionFunc) (*Network, int, error) {
+	p := &ListNetworksParams{}
+	p.p = make(map[string]interface{})
+
+	p.p["id"] = id
+
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, -1, err
+		}
+	}
+
+	l, err := s.ListNetworks(p)
+	if err != nil {
+		if strings.Contains(err.Error(), fmt.Sprintf(
+			"Invalid parameter id value=%s due to incorrect long value format, "+
+				"or entity does not exist", id)) {
+			return nil, 0, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
+		}
+		return nil, -1, err
+	}
+
+	if l.Count == 0 {
+		return nil, l.Count, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
+	}
+
+	if l.Count == 1 {
+		return l.Networks[0], l.Count, nil
+	}
+
+	if _, ok := p.p[firstMatchKey]; ok && len(l.Networks) > 0 {
+		return l.Networks[0], l.Count, nil
+	}
+	return nil, l.Count, fmt.Errorf("There is more then one result for Network UUID: %s!", id)
+}
+
+// NetworkExists returns true if a Network with the given ID exists. When no match is found
+// it returns false, while any other error is returned as is.
+func (s *NetworkService) NetworkExists(id string, opts ...OptionFunc) (bool, error) {
+	_, count, err := s.GetNetworkByID(id, opts...)
+	if errors.Is(err, ErrNotFound) {
+		return false, nil
+	}
+	if count > 0 {
+		return true, nil
+	}
+	return false, err
+}
+
+// Lists all available networks.
+func (s *NetworkService) ListNetworks(p *ListNetworksParams) (*ListNetworksResponse, error) {
+	return s.ListNetworksWithContext(context.Background(), p)
+}
+
+// ListNetworksWithContext is the same as ListNetworks, but the request is cancelled when the context is done
+func (s *NetworkService) ListNetworksWithContext(ctx context.Context, p *ListNetworksParams) (*ListNetworksResponse, error) {
+	if err := checkMutuallyExclusive(p.p, "account", "projectid"); err != nil {
+		return nil, err
+	}
+
+	return s.ListNetworksRawWithContext(ctx, p.toURLValues())
+}
+
+// ListNetworksRaw is the same as ListNetworks, but takes the params as url.Values instead of typed params
+func (s *NetworkService) ListNetworksRaw(v url.Values) (*ListNetworksResponse, error) {
+	return s.ListNetworksRawWithContext(context.Background(), v)
+}
+
+// ListNetworksRawWithContext is the same as ListNetworksRaw, but the request is cancelled when the context is done
+func (s *NetworkService) ListNetworksRawWithContext(ctx context.Context, v url.Values) (*ListNetworksResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "listNetworks", "listnetworksresponse", v)
+	if err != nil {
+		return nil, err
+	}
+
+	var r ListNetworksResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+// StreamNetworks fetches the results of ListNetworks page by page in the background and sends them on the
+// returned channel. Both returned channels are closed when done. If fetching a page fails, or the context
+// is done before all results are sent, the error is sent on the error channel and fetching stops. A
+// request for a page in flight is cancelled when the context is done. The page and pagesize set in the
+// params are ignored.
+func (s *NetworkService) StreamNetworks(ctx context.Context, p *ListNetworksParams) (<-chan *Network, <-chan error) {
+	ch := make(chan *Network)
+	errs := make(chan error, 1)
+
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesize(500)
+
+	go func() {
+		defer close(errs)
+		defer close(ch)
+
+		for page, count := 1, 0; ; page++ {
+			pp.SetPage(page)
+
+			l, err := s.ListNetworksWithContext(ctx, pp)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			for _, v := range l.Networks {
+				select {
+				case ch <- v:
+				case <-ctx.Done():
+					errs <- ctx.Err()
+					return
+				}
+			}
+
+			count += len(l.Networks)
+			if len(l.Networks) < 500 || count >= l.Count {
+				return
+			}
+		}
+	}()
+
+	return ch, errs
+}
+
+// ListNetworksWhere returns all results of ListNetworks for which pred returns true. Note that the results are
+// filtered client-side, so all results matching the params are fetched first.
+// All pages are fetched, so the page and pagesize set in the params are ignored.
+func (s *NetworkService) ListNetworksWhere(p *ListNetworksParams, pred func(*Network) bool) ([]*Network, error) {
+	var r []*Network
+	ch, errs := s.StreamNetworks(context.Background(), p)
+	for v := range ch {
+		if pred(v) {
+			r = append(r, v)
+		}
+	}
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ListAllNetworks returns the results of ListNetworks across all pages, by calling ListNetworks page by page until all
+// results are fetched. The pagesize set in the params is used for every page (500 if not set), and the
+// page set in the params is ignored.
+func (s *NetworkService) ListAllNetworks(p *ListNetworksParams) ([]*Network, error) {
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesizeIfUnset(500)
+	pagesize := pp.p["pagesize"].(int)
+
+	var r []*Network
+	for page := 1; ; page++ {
+		pp.SetPage(page)
+
+		l, err := s.ListNetworks(pp)
+		if err != nil {
+			return nil, err
+		}
+		r = append(r, l.Networks...)
+
+		if len(l.Networks) < pagesize || len(r) >= l.Count {
+			return r, nil
+		}
+	}
+}
+
+type ListNetworksResponse struct {
+	Count    int        `json:"count"`
+	Networks []*Network `json:"network"`
+}
+
+type Network struct {
+	Account                     string `json:"account"`
+	Aclid                       string `json:"aclid"`
+	Acltype                     string `json:"acltype"`
+	Broadcastdomaintype         string `json:"broadcastdomaintype"`
+	Broadcasturi                string `json:"broadcasturi"`
+	Canusefordeploy             bool   `json:"canusefordeploy"`
+	Cidr                        string `json:"cidr"`
+	Displaynetwork              bool   `json:"displaynetwork"`
+	Displaytext                 string `json:"displaytext"`
+	Dns1                        string `json:"dns1"`
+	Dns2                        string `json:"dns2"`
+	Domain                      string `json:"domain"`
+	Domainid                    string `json:"domainid"`
+	Gateway                     string `json:"gateway"`
+	Id                          string `json:"id"`
+	Ip6cidr                     string `json:"ip6cidr"`
+	Ip6gateway                  string `json:"ip6gateway"`
+	Isdefault                   bool   `json:"isdefault"`
+	Ispersistent                bool   `json:"ispersistent"`
+	Issystem                    bool   `json:"issystem"`
+	Name                        string `json:"name"`
+	Netmask                     string `json:"netmask"`
+	Networkcidr                 string `json:"networkcidr"`
+	Networkdomain               string `json:"networkdomain"`
+	Networkofferingavailability string `json:"networkofferingavailability"`
+	Networkofferingconservemode bool   `json:"networkofferingconservemode"`
+	Networkofferingdisplaytext  string `json:"networkofferingdisplaytext"`
+	Networkofferingid           string `json:"networkofferingid"`
+	Networkofferingname         string `json:"networkofferingname"`
+	Physicalnetworkid           string `json:"physicalnetworkid"`
+	Project                     string `json:"project"`
+	Projectid                   string `json:"projectid"`
+	Related                     string `json:"related"`
+	Reservediprange             string `json:"reservediprange"`
+	Restartrequired             bool   `json:"restartrequired"`
+	Service                     []struct {
+		Capability []struct {
+			Canchooseservicecapability bool   `json:"canchooseservicecapability"`
+			Name                       string `json:"name"`
+			Value                      string `json:"value"`
+		} `json:"capability"`
+		Name     string `json:"name"`
+		Provider []struct {
+			Canenableindividualservice   bool     `json:"canenableindividualservice"`
+			Destinationphysicalnetworkid string   `json:"destinationphysicalnetworkid"`
+			Id                           string   `json:"id"`
+			Name                         string   `json:"name"`
+			Physicalnetworkid            string   `json:"physicalnetworkid"`
+			Servicelist                  []string `json:"servicelist"`
+			State                        string   `json:"state"`
+		} `json:"provider"`
+	} `json:"service"`
+	Specifyipranges  bool   `json:"specifyipranges"`
+	State            string `json:"state"`
+	Strechedl2subnet bool   `json:"strechedl2subnet"`
+	Subdomainaccess  bool   `json:"subdomainaccess"`
+	Tags             []struct {
+		Account      string `json:"account"`
+		Customer     string `json:"customer"`
+		Domain       string `json:"domain"`
+		Domainid     string `json:"domainid"`
+		Key          string `json:"key"`
+		Project      string `json:"project"`
+		Projectid    string `json:"projectid"`
+		Resourceid   string `json:"resourceid"`
+		Resourcetype string `json:"resourcetype"`
+		Value        string `json:"value"`
+	} `json:"tags"`
+	Traffictype       string        `json:"traffictype"`
+	Type              string        `json:"type"`
+	Vlan              string        `json:"vlan"`
+	Vpcid             string        `json:"vpcid"`
+	Zoneid            string        `json:"zoneid"`
+	Zonename          string        `json:"zonename"`
+	Zonesnetworkspans []interface{} `json:"zonesnetworkspans"`
+}
@@ -0,0 +1,592 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstack
+
+// ResetVMKeyAndWait resets the SSH key of the virtual machine to the given key pair, waits for the async
+// job to finish and returns the updated virtual machine. The API requires the virtual machine to be stopped,
+// so if it is not stopped an error is returned, unless stopAndStart is true. In that case the virtual
+// machine is stopped before the reset and started again afterwards (also when the reset failed). The
+// options are used when getting the virtual machine.
+func (s *SSHService) ResetVMKeyAndWait(vmid, keypairName string, stopAndStart bool, opts ...OptionFunc) (*VirtualMachine, error) {
+	vm, _, err := s.cs.VirtualMachine.GetVirtualMachineByID(vmid, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	restart := false
+	if vm.State != "Stopped" {
+		if !stopAndStart {
+			return nil, fmt.Errorf("Virtual machine %s must be stopped to reset its SSH key, but is %s", vmid, vm.State)
+		}
+		r, err := s.cs.VirtualMachine.StopVirtualMachine(s.cs.VirtualMachine.NewStopVirtualMachineParams(vmid))
+		if err == nil {
+			err = s.waitForJob(r.JobID)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("Failed to stop virtual machine %s: %w", vmid, err)
+		}
+		restart = true
+	}
+
+	r, err := s.ResetSSHKeyForVirtualMachine(s.NewResetSSHKeyForVirtualMachineParams(vmid, keypairName))
+	if err == nil {
+		err = s.waitForJob(r.JobID)
+	}
+	if err != nil {
+		err = fmt.Errorf("Failed to reset the SSH key of virtual machine %s: %w", vmid, err)
+	}
+
+	if restart {
+		r, serr := s.cs.VirtualMachine.StartVirtualMachine(s.cs.VirtualMachine.NewStartVirtualMachineParams(vmid))
+		if serr == nil {
+			serr = s.waitForJob(r.JobID)
+		}
+		if serr != nil && err == nil {
+			err = fmt.Errorf("Failed to start virtual machine %s: %w", vmid, serr)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	vm, _, err = s.cs.VirtualMachine.GetVirtualMachineByID(vmid, opts...)
+	return vm, err
+}
+
+func (s *SSHService) waitForJob(jobid string) error {
+	// An async client already waited for the job to finish
+	if s.cs.async {
+		return nil
+	}
+	_, err := s.cs.GetAsyncJobResult(jobid, s.cs.timeout)
+	return err
+}
+
+type CreateSSHKeyPairParams struct {
+	p map[string]interface{}
+}
+
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *CreateSSHKeyPairParams) Clone() *CreateSSHKeyPairParams {
+	c := &CreateSSHKeyPairParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
+func (p *CreateSSHKeyPairParams) toURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["account"]; found {
+		u.Set("account", v.(string))
+	}
+	if v, found := p.p["domainid"]; found {
+		u.Set("domainid", v.(string))
+	}
+	if v, found := p.p["name"]; found {
+		u.Set("name", v.(string))
+	}
+	if v, found := p.p["projectid"]; found {
+		u.Set("projectid", v.(string))
+	}
+	return u
+}
+
+// Returns an error naming the first required param which is not set
+func (p *CreateSSHKeyPairParams) validate() error {
+	for _, k := range []string{"name"} {
+		if _, found := p.p[k]; !found {
+			return fmt.Errorf("Missing required param %s of createSSHKeyPair", k)
+		}
+	}
+	return nil
+}
+
+func (p *CreateSSHKeyPairParams) SetAccount(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["account"] = v
+	return
+}
+
+// SetAccountIfUnset sets account to v, unless it is already set
+func (p *CreateSSHKeyPairParams) SetAccountIfUnset(v string) {
+	if _, found := p.p["account"]; !found {
+		p.SetAccount(v)
+	}
+}
+
+func (p *CreateSSHKeyPairParams) SetDomainid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["domainid"] = v
+	return
+}
+
+// SetDomainidIfUnset sets domainid to v, unless it is already set
+func (p *CreateSSHKeyPairParams) SetDomainidIfUnset(v string) {
+	if _, found := p.p["domainid"]; !found {
+		p.SetDomainid(v)
+	}
+}
+
+func (p *CreateSSHKeyPairParams) SetName(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["name"] = v
+	return
+}
+
+// SetNameIfUnset sets name to v, unless it is already set
+func (p *CreateSSHKeyPairParams) SetNameIfUnset(v string) {
+	if _, found := p.p["name"]; !found {
+		p.SetName(v)
+	}
+}
+
+func (p *CreateSSHKeyPairParams) SetProjectid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["projectid"] = v
+	return
+}
+
+// SetProjectidIfUnset sets projectid to v, unless it is already set
+func (p *CreateSSHKeyPairParams) SetProjectidIfUnset(v string) {
+	if _, found := p.p["projectid"]; !found {
+		p.SetProjectid(v)
+	}
+}
+
+// You should always use this function to get a new CreateSSHKeyPairParams instance,
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *SSHService) NewCreateSSHKeyPairParams(name string, opts ...func(*CreateSSHKeyPairParams)) *CreateSSHKeyPairParams {
+	p := &CreateSSHKeyPairParams{}
+	p.p = make(map[string]interface{})
+	p.p["name"] = name
+	for _, fn := range opts {
+		fn(p)
+	}
+	return p
+}
+
+// Create a new keypair and returns the private key
+func (s *SSHService) CreateSSHKeyPair(p *CreateSSHKeyPairParams, opts ...OptionFunc) (*CreateSSHKeyPairResponse, error) {
+	return s.CreateSSHKeyPairWithContext(context.Background(), p, opts...)
+}
+
+// CreateSSHKeyPairWithContext is the same as CreateSSHKeyPair, but the request is cancelled when the context is done
+func (s *SSHService) CreateSSHKeyPairWithContext(ctx context.Context, p *CreateSSHKeyPairParams, opts ...OptionFunc) (*CreateSSHKeyPairResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.CreateSSHKeyPairRawWithContext(ctx, p.toURLValues())
+}
+
+// CreateSSHKeyPairRaw is the same as CreateSSHKeyPair, but takes the params as url.Values instead of typed params
+func (s *SSHService) CreateSSHKeyPairRaw(v url.Values) (*CreateSSHKeyPairResponse, error) {
+	return s.CreateSSHKeyPairRawWithContext(context.Background(), v)
+}
+
+// CreateSSHKeyPairRawWithContext is the same as CreateSSHKeyPairRaw, but the request is cancelled when the context is done
+func (s *SSHService) CreateSSHKeyPairRawWithContext(ctx context.Context, v url.Values) (*CreateSSHKeyPairResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "createSSHKeyPair", "createsshkeypairresponse", v)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp, err = getRawValueByKey(resp, "keypair"); err != nil {
+		return nil, err
+	}
+
+	var r CreateSSHKeyPairResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+type CreateSSHKeyPairResponse struct {
+	Privatekey string `json:"privatekey"`
+}
+
+type ListSSHKeyPairsParams struct {
+	p map[string]interface{}
+}
+
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ListSSHKeyPairsParams) Clone() *ListSSHKeyPairsParams {
+	c := &ListSSHKeyPairsParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
+func (p *ListSSHKeyPairsParams) toURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["account"]; found {
+		u.Set("account", v.(string))
+	}
+	if v, found := p.p["domainid"]; found {
+		u.Set("domainid", v.(string))
+	}
+	if v, found := p.p["fingerprint"]; found {
+		u.Set("fingerprint", v.(string))
+	}
+	if v, found := p.p["isrecursive"]; found {
+		vv := strconv.FormatBool(v.(bool))
+		u.Set("isrecursive", vv)
+	}
+	if v, found := p.p["keyword"]; found {
+		u.Set("keyword", v.(string))
+	}
+	if v, found := p.p["listall"]; found {
+		vv := strconv.FormatBool(v.(bool))
+		u.Set("listall", vv)
+	}
+	if v, found := p.p["name"]; found {
+		u.Set("name", v.(string))
+	}
+	if v, found := p.p["page"]; found {
+		vv := strconv.Itoa(v.(int))
+		u.Set("page", vv)
+	}
+	if v, found := p.p["pagesize"]; found {
+		vv := strconv.Itoa(v.(int))
+		u.Set("pagesize", vv)
+	}
+	if v, found := p.p["projectid"]; found {
+		u.Set("projectid", v.(string))
+	}
+	return u
+}
+
+func (p *ListSSHKeyPairsParams) SetAccount(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["account"] = v
+	return
+}
+
+// SetAccountIfUnset sets account to v, unless it is already set
+func (p *ListSSHKeyPairsParams) SetAccountIfUnset(v string) {
+	if _, found := p.p["account"]; !found {
+		p.SetAccount(v)
+	}
+}
+
+func (p *ListSSHKeyPairsParams) SetDomainid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["domainid"] = v
+	return
+}
+
+// SetDomainidIfUnset sets domainid to v, unless it is already set
+func (p *ListSSHKeyPairsParams) SetDomainidIfUnset(v string) {
+	if _, found := p.p["domainid"]; !found {
+		p.SetDomainid(v)
+	}
+}
+
+func (p *ListSSHKeyPairsParams) SetFingerprint(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["fingerprint"] = v
+	return
+}
+
+// SetFingerprintIfUnset sets fingerprint to v, unless it is already set
+func (p *ListSSHKeyPairsParams) SetFingerprintIfUnset(v string) {
+	if _, found := p.p["fingerprint"]; !found {
+		p.SetFingerprint(v)
+	}
+}
+
+func (p *ListSSHKeyPairsParams) SetIsrecursive(v bool) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["isrecursive"] = v
+	return
+}
+
+// SetIsrecursiveIfUnset sets isrecursive to v, unless it is already set
+func (p *ListSSHKeyPairsParams) SetIsrecursiveIfUnset(v bool) {
+	if _, found := p.p["isrecursive"]; !found {
+		p.SetIsrecursive(v)
+	}
+}
+
+func (p *ListSSHKeyPairsParams) SetKeyword(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["keyword"] = v
+	return
+}
+
+// SetKeywordIfUnset sets keyword to v, unless it is already set
+func (p *ListSSHKeyPairsParams) SetKeywordIfUnset(v string) {
+	if _, found := p.p["keyword"]; !found {
+		p.SetKeyword(v)
+	}
+}
+
+func (p *ListSSHKeyPairsParams) SetListall(v bool) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["listall"] = v
+	return
+}
+
+// SetListallIfUnset sets listall to v, unless it is already set
+func (p *ListSSHKeyPairsParams) SetListallIfUnset(v bool) {
+	if _, found := p.p["listall"]; !found {
+		p.SetListall(v)
+	}
+}
+
+func (p *ListSSHKeyPairsParams) SetName(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["name"] = v
+	return
+}
+
+// SetNameIfUnset sets name to v, unless it is already set
+func (p *ListSSHKeyPairsParams) SetNameIfUnset(v string) {
+	if _, found := p.p["name"]; !found {
+		p.SetName(v)
+	}
+}
+
+func (p *ListSSHKeyPairsParams) SetPage(v int) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["page"] = v
+	return
+}
+
+// SetPageIfUnset sets page to v, unless it is already set
+func (p *ListSSHKeyPairsParams) SetPageIfUnset(v int) {
+	if _, found := p.p["page"]; !found {
+		p.SetPage(v)
+	}
+}
+
+func (p *ListSSHKeyPairsParams) SetPagesize(v int) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["pagesize"] = v
+	return
+}
+
+// SetPagesizeIfUnset sets pagesize to v, unless it is already set
+func (p *ListSSHKeyPairsParams) SetPagesizeIfUnset(v int) {
+	if _, found := p.p["pagesize"]; !found {
+		p.SetPagesize(v)
+	}
+}
+
+func (p *ListSSHKeyPairsParams) SetProjectid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["projectid"] = v
+	return
+}
+
+// SetProjectidIfUnset sets projectid to v, unless it is already set
+func (p *ListSSHKeyPairsParams) SetProjectidIfUnset(v string) {
+	if _, found := p.p["projectid"]; !found {
+		p.SetProjectid(v)
+	}
+}
+
+var _ ListAllSetter = (*ListSSHKeyPairsParams)(nil)
+
+// You should always use this function to get a new ListSSHKeyPairsParams instance,
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *SSHService) NewListSSHKeyPairsParams(opts ...func(*ListSSHKeyPairsParams)) *ListSSHKeyPairsParams {
+	p := &ListSSHKeyPairsParams{}
+	p.p = make(map[string]interface{})
+	for _, fn := range opts {
+		fn(p)
+	}
+	return p
+}
+
+// List registered keypairs
+func (s *SSHService) ListSSHKeyPairs(p *ListSSHKeyPairsParams) (*ListSSHKeyPairsResponse, error) {
+	return s.ListSSHKeyPairsWithContext(context.Background(), p)
+}
+
+// ListSSHKeyPairsWithContext is the same as ListSSHKeyPairs, but the request is cancelled when the context is done
+func (s *SSHService) ListSSHKeyPairsWithContext(ctx context.Context, p *ListSSHKeyPairsParams) (*ListSSHKeyPairsResponse, error) {
+	return s.ListSSHKeyPairsRawWithContext(ctx, p.toURLValues())
+}
+
+// ListSSHKeyPairsRaw is the same as ListSSHKeyPairs, but takes the params as url.Values instead of typed params
+func (s *SSHService) ListSSHKeyPairsRaw(v url.Values) (*ListSSHKeyPairsResponse, error) {
+	return s.ListSSHKeyPairsRawWithContext(context.Background(), v)
+}
+
+// ListSSHKeyPairsRawWithContext is the same as ListSSHKeyPairsRaw, but the request is cancelled when the context is done
+func (s *SSHService) ListSSHKeyPairsRawWithContext(ctx context.Context, v url.Values) (*ListSSHKeyPairsResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "listSSHKeyPairs", "listsshkeypairsresponse", v)
+	if err != nil {
+		return nil, err
+	}
+
+	var r ListSSHKeyPairsResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+// StreamSSHKeyPairs fetches the results of ListSSHKeyPairs page by page in the background and sends them on the
+// returned channel. Both returned channels are closed when done. If fetching a page fails, or the context
+// is done before all results are sent, the error is sent on the error channel and fetching stops. A
+// request for a page in flight is cancelled when the context is done. The page and pagesize set in the
+// params are ignored.
+func (s *SSHService) StreamSSHKeyPairs(ctx context.Context, p *ListSSHKeyPairsParams) (<-chan *SSHKeyPair, <-chan error) {
+	ch := make(chan *SSHKeyPair)
+	errs := make(chan error, 1)
+
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesize(500)
+
+	go func() {
+		defer close(errs)
+		defer close(ch)
+
+		for page, count := 1, 0; ; page++ {
+			pp.SetPage(page)
+
+			l, err := s.ListSSHKeyPairsWithContext(ctx, pp)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			for _, v := range l.SSHKeyPairs {
+				select {
+				case ch <- v:
+				case <-ctx.Done():
+					errs <- ctx.Err()
+					return
+				}
+			}
+
+			count += len(l.SSHKeyPairs)
+			if len(l.SSHKeyPairs) < 500 || count >= l.Count {
+				return
+			}
+		}
+	}()
+
+	return ch, errs
+}
+
+// ListSSHKeyPairsWhere returns all results of ListSSHKeyPairs for which pred returns true. Note that the results are
+// filtered client-side, so all results matching the params are fetched first.
+// All pages are fetched, so the page and pagesize set in the params are ignored.
+func (s *SSHService) ListSSHKeyPairsWhere(p *ListSSHKeyPairsParams, pred func(*SSHKeyPair) bool) ([]*SSHKeyPair, error) {
+	var r []*SSHKeyPair
+	ch, errs := s.StreamSSHKeyPairs(context.Background(), p)
+	for v := range ch {
+		if pred(v) {
+			r = append(r, v)
+		}
+	}
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ListAllSSHKeyPairs returns the results of ListSSHKeyPairs across all pages, by calling ListSSHKeyPairs page by page until all
+// results are fetched. The pagesize set in the params is used for every page (500 if not set), and the
+// page set in the params is ignored.
+func (s *SSHService) ListAllSSHKeyPairs(p *ListSSHKeyPairsParams) ([]*SSHKeyPair, error) {
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesizeIfUnset(500)
+	pagesize := pp.p["pagesize"].(int)
+
+	var r []*SSHKeyPair
+	for page := 1; ; page++ {
+		pp.SetPage(page)
+
+		l, err := s.ListSSHKeyPairs(pp)
+		if err != nil {
+			return nil, err
+		}
+		r = append(r, l.SSHKeyPairs...)
+
+		if len(l.SSHKeyPairs) < pagesize || len(r) >= l.Count {
+			return r, nil
+		}
+	}
+}
+
+type ListSSHKeyPairsResponse struct {
+	Count       int           `json:"count"`
+	SSHKeyPairs []*SSHKeyPair `json:"sshkeypair"`
+}
+
+type SSHKeyPair struct {
+	Account     string `json:"account"`
+	Domain      string `json:"domain"`
+	Domainid    string `json:"domainid"`
+	Fingerprint string `json:"fingerprint"`
+	Name        string `json:"name"`
+}
@@ -0,0 +1,1082 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstack
+
+// Helper function for maintaining backwards compatibility
+func convertAuthorizeSecurityGroupIngressResponse(b []byte) ([]byte, error) {
+	var raw struct {
+		Ingressrule []interface{} `json:"ingressrule"`
+	}
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return nil, err
+	}
+
+	if len(raw.Ingressrule) != 1 {
+		return b, nil
+	}
+
+	return json.Marshal(raw.Ingressrule[0])
+}
+
+// IngressRuleSpec describes an ingress rule of a security group, as used by ReconcileIngress. The
+// source of the traffic is either the CIDR, or the security group with the given name owned by the
+// given account. The ports are only used for the tcp and udp protocols, and the ICMP type and code
+// only for the icmp protocol. When EndPort is 0 it is the same as StartPort.
+type IngressRuleSpec struct {
+	Protocol          Protocol
+	CIDR              string
+	SecurityGroupName string
+	Account           string
+	StartPort         int
+	EndPort           int
+	ICMPType          int
+	ICMPCode          int
+}
+
+// Returns the spec with the fields that do not apply to its protocol or source cleared, so it can be
+// compared with other specs
+func (r IngressRuleSpec) normalize() IngressRuleSpec {
+	r.Protocol = Protocol(strings.ToLower(string(r.Protocol)))
+	if r.CIDR != "" {
+		r.SecurityGroupName, r.Account = "", ""
+	}
+	if r.Protocol == ProtocolTCP || r.Protocol == ProtocolUDP {
+		if r.EndPort == 0 {
+			r.EndPort = r.StartPort
+		}
+	} else {
+		r.StartPort, r.EndPort = 0, 0
+	}
+	if r.Protocol != ProtocolICMP {
+		r.ICMPType, r.ICMPCode = 0, 0
+	}
+	return r
+}
+
+// ReconcileIngress makes the ingress rules of the security group match the desired rules. It first
+// authorizes the desired rules the group does not have yet and then revokes the rules of the group
+// that are not desired, waiting for every command to finish. Rules that already match are left
+// untouched. All changes are attempted even when some of them fail, in which case the failures are
+// returned joined into a single error. The options are used when getting the security group.
+func (s *SecurityGroupService) ReconcileIngress(groupid string, desired []IngressRuleSpec, opts ...OptionFunc) error {
+	sg, _, err := s.GetSecurityGroupByID(groupid, opts...)
+	if err != nil {
+		return err
+	}
+
+	// The IDs of the current rules by their spec
+	current := make(map[IngressRuleSpec]string, len(sg.Ingressrule))
+	for _, r := range sg.Ingressrule {
+		spec := IngressRuleSpec{
+			Protocol:          Protocol(r.Protocol),
+			CIDR:              r.Cidr,
+			SecurityGroupName: r.Securitygroupname,
+			Account:           r.Account,
+			StartPort:         r.Startport,
+			EndPort:           r.Endport,
+			ICMPType:          r.Icmptype,
+			ICMPCode:          r.Icmpcode,
+		}
+		current[spec.normalize()] = r.Ruleid
+	}
+
+	var errs []error
+	wanted := make(map[IngressRuleSpec]bool, len(desired))
+	for _, r := range desired {
+		r = r.normalize()
+		if wanted[r] {
+			continue
+		}
+		wanted[r] = true
+
+		if _, ok := current[r]; ok {
+			continue
+		}
+		if err := s.authorizeIngress(groupid, r); err != nil {
+			errs = append(errs, fmt.Errorf("Failed to authorize ingress rule %+v: %w", r, err))
+		}
+	}
+
+	var revoke []string
+	for r, id := range current {
+		if !wanted[r] {
+			revoke = append(revoke, id)
+		}
+	}
+	sort.Strings(revoke)
+
+	for _, id := range revoke {
+		r, err := s.RevokeSecurityGroupIngress(s.NewRevokeSecurityGroupIngressParams(id))
+		if err == nil {
+			err = s.waitForJob(r.JobID)
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("Failed to revoke ingress rule %s: %w", id, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// Authorizes the ingress rule for the security group and waits for the async job to finish
+func (s *SecurityGroupService) authorizeIngress(groupid string, r IngressRuleSpec) error {
+	p := s.NewAuthorizeSecurityGroupIngressParams()
+	p.SetSecuritygroupid(groupid)
+	p.SetProtocol(r.Protocol)
+	if r.CIDR != "" {
+		p.SetCidrlist([]string{r.CIDR})
+	} else {
+		p.SetUsersecuritygrouplist(map[string]string{r.Account: r.SecurityGroupName})
+	}
+	switch r.Protocol {
+	case ProtocolTCP, ProtocolUDP:
+		p.SetStartport(r.StartPort)
+		p.SetEndport(r.EndPort)
+	case ProtocolICMP:
+		p.SetIcmptype(r.ICMPType)
+		p.SetIcmpcode(r.ICMPCode)
+	}
+
+	resp, err := s.AuthorizeSecurityGroupIngress(p)
+	if err != nil {
+		return err
+	}
+	return s.waitForJob(resp.JobID)
+}
+
+func (s *SecurityGroupService) waitForJob(jobid string) error {
+	// An async client already waited for the job to finish
+	if s.cs.async {
+		return nil
+	}
+	_, err := s.cs.GetAsyncJobResult(jobid, s.cs.timeout)
+	return err
+}
+
+type AuthorizeSecurityGroupIngressParams struct {
+	p map[string]interface{}
+}
+
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *AuthorizeSecurityGroupIngressParams) Clone() *AuthorizeSecurityGroupIngressParams {
+	c := &AuthorizeSecurityGroupIngressParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
+func (p *AuthorizeSecurityGroupIngressParams) toURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["account"]; found {
+		u.Set("account", v.(string))
+	}
+	if v, found := p.p["cidrlist"]; found {
+		vv := strings.Join(v.([]string), ",")
+		u.Set("cidrlist", vv)
+	}
+	if v, found := p.p["domainid"]; found {
+		u.Set("domainid", v.(string))
+	}
+	if v, found := p.p["endport"]; found {
+		vv := strconv.Itoa(v.(int))
+		u.Set("endport", vv)
+	}
+	if v, found := p.p["icmpcode"]; found {
+		vv := strconv.Itoa(v.(int))
+		u.Set("icmpcode", vv)
+	}
+	if v, found := p.p["icmptype"]; found {
+		vv := strconv.Itoa(v.(int))
+		u.Set("icmptype", vv)
+	}
+	if v, found := p.p["projectid"]; found {
+		u.Set("projectid", v.(string))
+	}
+	if v, found := p.p["protocol"]; found {
+		u.Set("protocol", v.(string))
+	}
+	if v, found := p.p["securitygroupid"]; found {
+		u.Set("securitygroupid", v.(string))
+	}
+	if v, found := p.p["securitygroupname"]; found {
+		u.Set("securitygroupname", v.(string))
+	}
+	if v, found := p.p["startport"]; found {
+		vv := strconv.Itoa(v.(int))
+		u.Set("startport", vv)
+	}
+	if v, found := p.p["usersecuritygrouplist"]; found {
+		m := v.(map[string]string)
+		for i, k := range getSortedKeysFromMap(m) {
+			u.Set(fmt.Sprintf("usersecuritygrouplist[%d].account", i), k)
+			u.Set(fmt.Sprintf("usersecuritygrouplist[%d].group", i), m[k])
+		}
+	}
+	return u
+}
+
+func (p *AuthorizeSecurityGroupIngressParams) SetAccount(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["account"] = v
+	return
+}
+
+// SetAccountIfUnset sets account to v, unless it is already set
+func (p *AuthorizeSecurityGroupIngressParams) SetAccountIfUnset(v string) {
+	if _, found := p.p["account"]; !found {
+		p.SetAccount(v)
+	}
+}
+
+func (p *AuthorizeSecurityGroupIngressParams) SetCidrlist(v []string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["cidrlist"] = v
+	return
+}
+
+// SetCidrlistIfUnset sets cidrlist to v, unless it is already set
+func (p *AuthorizeSecurityGroupIngressParams) SetCidrlistIfUnset(v []string) {
+	if _, found := p.p["cidrlist"]; !found {
+		p.SetCidrlist(v)
+	}
+}
+
+// SetCidrlistCSV splits v on commas and sets the resulting values
+func (p *AuthorizeSecurityGroupIngressParams) SetCidrlistCSV(v string) {
+	p.SetCidrlist(splitCSV(v))
+}
+
+func (p *AuthorizeSecurityGroupIngressParams) SetDomainid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["domainid"] = v
+	return
+}
+
+// SetDomainidIfUnset sets domainid to v, unless it is already set
+func (p *AuthorizeSecurityGroupIngressParams) SetDomainidIfUnset(v string) {
+	if _, found := p.p["domainid"]; !found {
+		p.SetDomainid(v)
+	}
+}
+
+func (p *AuthorizeSecurityGroupIngressParams) SetEndport(v int) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["endport"] = v
+	return
+}
+
+// SetEndportIfUnset sets endport to v, unless it is already set
+func (p *AuthorizeSecurityGroupIngressParams) SetEndportIfUnset(v int) {
+	if _, found := p.p["endport"]; !found {
+		p.SetEndport(v)
+	}
+}
+
+func (p *AuthorizeSecurityGroupIngressParams) SetIcmpcode(v int) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["icmpcode"] = v
+	return
+}
+
+// SetIcmpcodeIfUnset sets icmpcode to v, unless it is already set
+func (p *AuthorizeSecurityGroupIngressParams) SetIcmpcodeIfUnset(v int) {
+	if _, found := p.p["icmpcode"]; !found {
+		p.SetIcmpcode(v)
+	}
+}
+
+func (p *AuthorizeSecurityGroupIngressParams) SetIcmptype(v int) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["icmptype"] = v
+	return
+}
+
+// SetIcmptypeIfUnset sets icmptype to v, unless it is already set
+func (p *AuthorizeSecurityGroupIngressParams) SetIcmptypeIfUnset(v int) {
+	if _, found := p.p["icmptype"]; !found {
+		p.SetIcmptype(v)
+	}
+}
+
+func (p *AuthorizeSecurityGroupIngressParams) SetProjectid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["projectid"] = v
+	return
+}
+
+// SetProjectidIfUnset sets projectid to v, unless it is already set
+func (p *AuthorizeSecurityGroupIngressParams) SetProjectidIfUnset(v string) {
+	if _, found := p.p["projectid"]; !found {
+		p.SetProjectid(v)
+	}
+}
+
+func (p *AuthorizeSecurityGroupIngressParams) SetProtocol(v Protocol) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["protocol"] = string(v)
+	return
+}
+
+// SetProtocolIfUnset sets protocol to v, unless it is already set
+func (p *AuthorizeSecurityGroupIngressParams) SetProtocolIfUnset(v Protocol) {
+	if _, found := p.p["protocol"]; !found {
+		p.SetProtocol(v)
+	}
+}
+
+func (p *AuthorizeSecurityGroupIngressParams) SetSecuritygroupid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["securitygroupid"] = v
+	return
+}
+
+// SetSecuritygroupidIfUnset sets securitygroupid to v, unless it is already set
+func (p *AuthorizeSecurityGroupIngressParams) SetSecuritygroupidIfUnset(v string) {
+	if _, found := p.p["securitygroupid"]; !found {
+		p.SetSecuritygroupid(v)
+	}
+}
+
+func (p *AuthorizeSecurityGroupIngressParams) SetSecuritygroupname(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["securitygroupname"] = v
+	return
+}
+
+// SetSecuritygroupnameIfUnset sets securitygroupname to v, unless it is already set
+func (p *AuthorizeSecurityGroupIngressParams) SetSecuritygroupnameIfUnset(v string) {
+	if _, found := p.p["securitygroupname"]; !found {
+		p.SetSecuritygroupname(v)
+	}
+}
+
+func (p *AuthorizeSecurityGroupIngressParams) SetStartport(v int) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["startport"] = v
+	return
+}
+
+// SetStartportIfUnset sets startport to v, unless it is already set
+func (p *AuthorizeSecurityGroupIngressParams) SetStartportIfUnset(v int) {
+	if _, found := p.p["startport"]; !found {
+		p.SetStartport(v)
+	}
+}
+
+func (p *AuthorizeSecurityGroupIngressParams) SetUsersecuritygrouplist(v map[string]string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["usersecuritygrouplist"] = v
+	return
+}
+
+// SetUsersecuritygrouplistIfUnset sets usersecuritygrouplist to v, unless it is already set
+func (p *AuthorizeSecurityGroupIngressParams) SetUsersecuritygrouplistIfUnset(v map[string]string) {
+	if _, found := p.p["usersecuritygrouplist"]; !found {
+		p.SetUsersecuritygrouplist(v)
+	}
+}
+
+// You should always use this function to get a new AuthorizeSecurityGroupIngressParams instance,
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *SecurityGroupService) NewAuthorizeSecurityGroupIngressParams(opts ...func(*AuthorizeSecurityGroupIngressParams)) *AuthorizeSecurityGroupIngressParams {
+	p := &AuthorizeSecurityGroupIngressParams{}
+	p.p = make(map[string]interface{})
+	for _, fn := range opts {
+		fn(p)
+	}
+	return p
+}
+
+// Authorizes a particular ingress rule for this security group
+func (s *SecurityGroupService) AuthorizeSecurityGroupIngress(p *AuthorizeSecurityGroupIngressParams, opts ...OptionFunc) (*AuthorizeSecurityGroupIngressResponse, error) {
+	return s.AuthorizeSecurityGroupIngressWithContext(context.Background(), p, opts...)
+}
+
+// AuthorizeSecurityGroupIngressWithContext is the same as AuthorizeSecurityGroupIngress, but the request is cancelled when the context is done
+func (s *SecurityGroupService) AuthorizeSecurityGroupIngressWithContext(ctx context.Context, p *AuthorizeSecurityGroupIngressParams, opts ...OptionFunc) (*AuthorizeSecurityGroupIngressResponse, error) {
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	return s.AuthorizeSecurityGroupIngressRawWithContext(ctx, p.toURLValues())
+}
+
+// AuthorizeSecurityGroupIngressRaw is the same as AuthorizeSecurityGroupIngress, but takes the params as url.Values instead of typed params
+func (s *SecurityGroupService) AuthorizeSecurityGroupIngressRaw(v url.Values) (*AuthorizeSecurityGroupIngressResponse, error) {
+	return s.AuthorizeSecurityGroupIngressRawWithContext(context.Background(), v)
+}
+
+// AuthorizeSecurityGroupIngressRawWithContext is the same as AuthorizeSecurityGroupIngressRaw, but the request is cancelled when the context is done
+func (s *SecurityGroupService) AuthorizeSecurityGroupIngressRawWithContext(ctx context.Context, v url.Values) (*AuthorizeSecurityGroupIngressResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "authorizeSecurityGroupIngress", "authorizesecuritygroupingressresponse", v)
+	if err != nil {
+		return nil, err
+	}
+
+	var r AuthorizeSecurityGroupIngressResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	// If we have a async client, we need to wait for the async result
+	if s.cs.async {
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
+		if err != nil {
+			if err == AsyncTimeoutErr || err == AsyncMaxPollsErr {
+				return &r, err
+			}
+			return nil, err
+		}
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		b, err = convertAuthorizeSecurityGroupIngressResponse(b)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := json.Unmarshal(b, &r); err != nil {
+			return nil, err
+		}
+	}
+
+	return &r, nil
+}
+
+type AuthorizeSecurityGroupIngressResponse struct {
+	JobID             string `json:"jobid"`
+	Account           string `json:"account"`
+	Cidr              string `json:"cidr"`
+	Endport           int    `json:"endport"`
+	Icmpcode          int    `json:"icmpcode"`
+	Icmptype          int    `json:"icmptype"`
+	Protocol          string `json:"protocol"`
+	Ruleid            string `json:"ruleid"`
+	Securitygroupname string `json:"securitygroupname"`
+	Startport         int    `json:"startport"`
+	Tags              []struct {
+		Account      string `json:"account"`
+		Customer     string `json:"customer"`
+		Domain       string `json:"domain"`
+		Domainid     string `json:"domainid"`
+		Key          string `json:"key"`
+		Project      string `json:"project"`
+		Projectid    string `json:"projectid"`
+		Resourceid   string `json:"resourceid"`
+		Resourcetype string `json:"resourcetype"`
+		Value        string `json:"value"`
+	} `json:"tags"`
+}
+
+type ListSecurityGroupsParams struct {
+	p map[string]interface{}
+}
+
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ListSecurityGroupsParams) Clone() *ListSecurityGroupsParams {
+	c := &ListSecurityGroupsParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
+func (p *ListSecurityGroupsParams) toURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["account"]; found {
+		u.Set("account", v.(string))
+	}
+	if v, found := p.p["domainid"]; found {
+		u.Set("domainid", v.(string))
+	}
+	if v, found := p.p["id"]; found {
+		u.Set("id", v.(string))
+	}
+	if v, found := p.p["isrecursive"]; found {
+		vv := strconv.FormatBool(v.(bool))
+		u.Set("isrecursive", vv)
+	}
+	if v, found := p.p["keyword"]; found {
+		u.Set("keyword", v.(string))
+	}
+	if v, found := p.p["listall"]; found {
+		vv := strconv.FormatBool(v.(bool))
+		u.Set("listall", vv)
+	}
+	if v, found := p.p["page"]; found {
+		vv := strconv.Itoa(v.(int))
+		u.Set("page", vv)
+	}
+	if v, found := p.p["pagesize"]; found {
+		vv := strconv.Itoa(v.(int))
+		u.Set("pagesize", vv)
+	}
+	if v, found := p.p["projectid"]; found {
+		u.Set("projectid", v.(string))
+	}
+	if v, found := p.p["securitygroupname"]; found {
+		u.Set("securitygroupname", v.(string))
+	}
+	if v, found := p.p["tags"]; found {
+		m := v.(map[string]string)
+		for i, k := range getSortedKeysFromMap(m) {
+			u.Set(fmt.Sprintf("tags[%d].key", i), k)
+			u.Set(fmt.Sprintf("tags[%d].value", i), m[k])
+		}
+	}
+	if v, found := p.p["virtualmachineid"]; found {
+		u.Set("virtualmachineid", v.(string))
+	}
+	return u
+}
+
+func (p *ListSecurityGroupsParams) SetAccount(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["account"] = v
+	return
+}
+
+// SetAccountIfUnset sets account to v, unless it is already set
+func (p *ListSecurityGroupsParams) SetAccountIfUnset(v string) {
+	if _, found := p.p["account"]; !found {
+		p.SetAccount(v)
+	}
+}
+
+func (p *ListSecurityGroupsParams) SetDomainid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["domainid"] = v
+	return
+}
+
+// SetDomainidIfUnset sets domainid to v, unless it is already set
+func (p *ListSecurityGroupsParams) SetDomainidIfUnset(v string) {
+	if _, found := p.p["domainid"]; !found {
+		p.SetDomainid(v)
+	}
+}
+
+func (p *ListSecurityGroupsParams) SetId(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["id"] = v
+	return
+}
+
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *ListSecurityGroupsParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
+func (p *ListSecurityGroupsParams) SetIsrecursive(v bool) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["isrecursive"] = v
+	return
+}
+
+// SetIsrecursiveIfUnset sets isrecursive to v, unless it is already set
+func (p *ListSecurityGroupsParams) SetIsrecursiveIfUnset(v bool) {
+	if _, found := p.p["isrecursive"]; !found {
+		p.SetIsrecursive(v)
+	}
+}
+
+func (p *ListSecurityGroupsParams) SetKeyword(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["keyword"] = v
+	return
+}
+
+// SetKeywordIfUnset sets keyword to v, unless it is already set
+func (p *ListSecurityGroupsParams) SetKeywordIfUnset(v string) {
+	if _, found := p.p["keyword"]; !found {
+		p.SetKeyword(v)
+	}
+}
+
+func (p *ListSecurityGroupsParams) SetListall(v bool) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["listall"] = v
+	return
+}
+
+// SetListallIfUnset sets listall to v, unless it is already set
+func (p *ListSecurityGroupsParams) SetListallIfUnset(v bool) {
+	if _, found := p.p["listall"]; !found {
+		p.SetListall(v)
+	}
+}
+
+func (p *ListSecurityGroupsParams) SetPage(v int) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["page"] = v
+	return
+}
+
+// SetPageIfUnset sets page to v, unless it is already set
+func (p *ListSecurityGroupsParams) SetPageIfUnset(v int) {
+	if _, found := p.p["page"]; !found {
+		p.SetPage(v)
+	}
+}
+
+func (p *ListSecurityGroupsParams) SetPagesize(v int) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["pagesize"] = v
+	return
+}
+
+// SetPagesizeIfUnset sets pagesize to v, unless it is already set
+func (p *ListSecurityGroupsParams) SetPagesizeIfUnset(v int) {
+	if _, found := p.p["pagesize"]; !found {
+		p.SetPagesize(v)
+	}
+}
+
+func (p *ListSecurityGroupsParams) SetProjectid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["projectid"] = v
+	return
+}
+
+// SetProjectidIfUnset sets projectid to v, unless it is already set
+func (p *ListSecurityGroupsParams) SetProjectidIfUnset(v string) {
+	if _, found := p.p["projectid"]; !found {
+		p.SetProjectid(v)
+	}
+}
+
+func (p *ListSecurityGroupsParams) SetSecuritygroupname(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["securitygroupname"] = v
+	return
+}
+
+// SetSecuritygroupnameIfUnset sets securitygroupname to v, unless it is already set
+func (p *ListSecurityGroupsParams) SetSecuritygroupnameIfUnset(v string) {
+	if _, found := p.p["securitygroupname"]; !found {
+		p.SetSecuritygroupname(v)
+	}
+}
+
+func (p *ListSecurityGroupsParams) SetTags(v map[string]string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["tags"] = v
+	return
+}
+
+// SetTagsIfUnset sets tags to v, unless it is already set
+func (p *ListSecurityGroupsParams) SetTagsIfUnset(v map[string]string) {
+	if _, found := p.p["tags"]; !found {
+		p.SetTags(v)
+	}
+}
+
+func (p *ListSecurityGroupsParams) SetVirtualmachineid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["virtualmachineid"] = v
+	return
+}
+
+// SetVirtualmachineidIfUnset sets virtualmachineid to v, unless it is already set
+func (p *ListSecurityGroupsParams) SetVirtualmachineidIfUnset(v string) {
+	if _, found := p.p["virtualmachineid"]; !found {
+		p.SetVirtualmachineid(v)
+	}
+}
+
+var _ ListAllSetter = (*ListSecurityGroupsParams)(nil)
+
+// You should always use this function to get a new ListSecurityGroupsParams instance,
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *SecurityGroupService) NewListSecurityGroupsParams(opts ...func(*ListSecurityGroupsParams)) *ListSecurityGroupsParams {
+	p := &ListSecurityGroupsParams{}
+	p.p = make(map[string]interface{})
+	for _, fn := range opts {
+		fn(p)
+	}
+	return p
+}
+
+func (p *ListSecurityGroupsParams) setFirstMatch() {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p[firstMatchKey] = true
+}
+
+// This is a courtesy helper function, which in some cases may not work as expected!
+func (s *SecurityGroupService) GetSecurityGroupID(keyword string, opts ...OptionFunc) (string, int, error) {
+	p := &ListSecurityGroupsParams{}
+	p.p = make(map[string]interface{})
+
+	p.p["keyword"] = keyword
+
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return "", -1, err
+		}
+	}
+
+	l, err := s.ListSecurityGroups(p)
+	if err != nil {
+		return "", -1, err
+	}
+
+	if l.Count == 0 {
+		return "", l.Count, fmt.Errorf("%w for %s: %+v", ErrNotFound, keyword, l)
+	}
+
+	if l.Count == 1 {
+		return l.SecurityGroups[0].Id, l.Count, nil
+	}
+
+	if l.Count > 1 {
+		for _, v := range l.SecurityGroups {
+			if v.Name == keyword {
+				return v.Id, l.Count, nil
+			}
+		}
+
+		if _, ok := p.p[firstMatchKey]; ok && len(l.SecurityGroups) > 0 {
+			return l.SecurityGroups[0].Id, l.Count, nil
+		}
+	}
+	return "", l.Count, fmt.Errorf("Could not find an exact match for %s: %+v", keyword, l)
+}
+
+// This is a courtesy helper function, which in some cases may not work as expected!
+func (s *SecurityGroupService) GetSecurityGroupByName(name string, opts ...OptionFunc) (*SecurityGroup, int, error) {
+	id, count, err := s.GetSecurityGroupID(name, opts...)
+	if err != nil {
+		return nil, count, err
+	}
+
+	r, count, err := s.GetSecurityGroupByID(id, opts...)
+	if err != nil {
+		return nil, count, err
+	}
+	return r, count, nil
+}
+
+// This is a courtesy helper function, which in some cases may not work as expected!
+func (s *SecurityGroupService) GetSecurityGroupByID(id string, opts ...OptionFunc) (*SecurityGroup, int, error) {
+	p := &ListSecurityGroupsParams{}
+	p.p = make(map[string]interface{})
+
+	p.p["id"] = id
+
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, -1, err
+		}
+	}
+
+	l, err := s.ListSecurityGroups(p)
+	if err != nil {
+		if strings.Contains(err.Error(), fmt.Sprintf(
+			"Invalid parameter id value=%s due to incorrect long value format, "+
+				"or entity does not exist", id)) {
+			return nil, 0, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
+		}
+		return nil, -1, err
+	}
+
+	if l.Count == 0 {
+		return nil, l.Count, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
+	}
+
+	if l.Count == 1 {
+		return l.SecurityGroups[0], l.Count, nil
+	}
+
+	if _, ok := p.p[firstMatchKey]; ok && len(l.SecurityGroups) > 0 {
+		return l.SecurityGroups[0], l.Count, nil
+	}
+	return nil, l.Count, fmt.Errorf("There is more then one result for SecurityGroup UUID: %s!", id)
+}
+
+// SecurityGroupExists returns true if a SecurityGroup with the given ID exists. When no match is found
+// it returns false, while any other error is returned as is.
+func (s *SecurityGroupService) SecurityGroupExists(id string, opts ...OptionFunc) (bool, error) {
+	_, count, err := s.GetSecurityGroupByID(id, opts...)
+	if errors.Is(err, ErrNotFound) {
+		return false, nil
+	}
+	if count > 0 {
+		return true, nil
+	}
+	return false, err
+}
+
+// Lists security groups
+func (s *SecurityGroupService) ListSecurityGroups(p *ListSecurityGroupsParams) (*ListSecurityGroupsResponse, error) {
+	return s.ListSecurityGroupsWithContext(context.Background(), p)
+}
+
+// ListSecurityGroupsWithContext is the same as ListSecurityGroups, but the request is cancelled when the context is done
+func (s *SecurityGroupService) ListSecurityGroupsWithContext(ctx context.Context, p *ListSecurityGroupsParams) (*ListSecurityGroupsResponse, error) {
+	return s.ListSecurityGroupsRawWithContext(ctx, p.toURLValues())
+}
+
+// ListSecurityGroupsRaw is the same as ListSecurityGroups, but takes the params as url.Values instead of typed params
+func (s *SecurityGroupService) ListSecurityGroupsRaw(v url.Values) (*ListSecurityGroupsResponse, error) {
+	return s.ListSecurityGroupsRawWithContext(context.Background(), v)
+}
+
+// ListSecurityGroupsRawWithContext is the same as ListSecurityGroupsRaw, but the request is cancelled when the context is done
+func (s *SecurityGroupService) ListSecurityGroupsRawWithContext(ctx context.Context, v url.Values) (*ListSecurityGroupsResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "listSecurityGroups", "listsecuritygroupsresponse", v)
+	if err != nil {
+		return nil, err
+	}
+
+	var r ListSecurityGroupsResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+// StreamSecurityGroups fetches the results of ListSecurityGroups page by page in the background and sends them on the
+// returned channel. Both returned channels are closed when done. If fetching a page fails, or the context
+// is done before all results are sent, the error is sent on the error channel and fetching stops. A
+// request for a page in flight is cancelled when the context is done. The page and pagesize set in the
+// params are ignored.
+func (s *SecurityGroupService) StreamSecurityGroups(ctx context.Context, p *ListSecurityGroupsParams) (<-chan *SecurityGroup, <-chan error) {
+	ch := make(chan *SecurityGroup)
+	errs := make(chan error, 1)
+
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesize(500)
+
+	go func() {
+		defer close(errs)
+		defer close(ch)
+
+		for page, count := 1, 0; ; page++ {
+			pp.SetPage(page)
+
+			l, err := s.ListSecurityGroupsWithContext(ctx, pp)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			for _, v := range l.SecurityGroups {
+				select {
+				case ch <- v:
+				case <-ctx.Done():
+					errs <- ctx.Err()
+					return
+				}
+			}
+
+			count += len(l.SecurityGroups)
+			if len(l.SecurityGroups) < 500 || count >= l.Count {
+				return
+			}
+		}
+	}()
+
+	return ch, errs
+}
+
+// ListSecurityGroupsWhere returns all results of ListSecurityGroups for which pred returns true. Note that the results are
+// filtered client-side, so all results matching the params are fetched first.
+// All pages are fetched, so the page and pagesize set in the params are ignored.
+func (s *SecurityGroupService) ListSecurityGroupsWhere(p *ListSecurityGroupsParams, pred func(*SecurityGroup) bool) ([]*SecurityGroup, error) {
+	var r []*SecurityGroup
+	ch, errs := s.StreamSecurityGroups(context.Background(), p)
+	for v := range ch {
+		if pred(v) {
+			r = append(r, v)
+		}
+	}
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ListAllSecurityGroups returns the results of ListSecurityGroups across all pages, by calling ListSecurityGroups page by page until all
+// results are fetched. The pagesize set in the params is used for every page (500 if not set), and the
+// page set in the params is ignored.
+func (s *SecurityGroupService) ListAllSecurityGroups(p *ListSecurityGroupsParams) ([]*SecurityGroup, error) {
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesizeIfUnset(500)
+	pagesize := pp.p["pagesize"].(int)
+
+	var r []*SecurityGroup
+	for page := 1; ; page++ {
+		pp.SetPage(page)
+
+		l, err := s.ListSecurityGroups(pp)
+		if err != nil {
+			return nil, err
+		}
+		r = append(r, l.SecurityGroups...)
+
+		if len(l.SecurityGroups) < pagesize || len(r) >= l.Count {
+			return r, nil
+		}
+	}
+}
+
+type ListSecurityGroupsResponse struct {
+	Count          int              `json:"count"`
+	SecurityGroups []*SecurityGroup `json:"securitygroup"`
+}
+
+type SecurityGroup struct {
+	Account     string `json:"account"`
+	Description string `json:"description"`
+	Domain      string `json:"domain"`
+	Domainid    string `json:"domainid"`
+	Egressrule  []struct {
+		Account           string `json:"account"`
+		Cidr              string `json:"cidr"`
+		Endport           int    `json:"endport"`
+		Icmpcode          int    `json:"icmpcode"`
+		Icmptype          int    `json:"icmptype"`
+		Protocol          string `json:"protocol"`
+		Ruleid            string `json:"ruleid"`
+		Securitygroupname string `json:"securitygroupname"`
+		Startport         int    `json:"startport"`
+		Tags              []struct {
+			Account      string `json:"account"`
+			Customer     string `json:"customer"`
+			Domain       string `json:"domain"`
+			Domainid     string `json:"domainid"`
+			Key          string `json:"key"`
+			Project      string `json:"project"`
+			Projectid    string `json:"projectid"`
+			Resourceid   string `json:"resourceid"`
+			Resourcetype string `json:"resourcetype"`
+			Value        string `json:"value"`
+		} `json:"tags"`
+	} `json:"egressrule"`
+	Id          string `json:"id"`
+	Ingressrule []struct {
+		Account           string `json:"account"`
+		Cidr              string `json:"cidr"`
+		Endport           int    `json:"endport"`
+		Icmpcode          int    `json:"icmpcode"`
+		Icmptype          int    `json:"icmptype"`
+		Protocol          string `json:"protocol"`
+		Ruleid            string `json:"ruleid"`
+		Securitygroupname string `json:"securitygroupname"`
+		Startport         int    `json:"startport"`
+		Tags              []struct {
+			Account      string `json:"account"`
+			Customer     string `json:"customer"`
+			Domain       string `json:"domain"`
+			Domainid     string `json:"domainid"`
+			Key          string `json:"key"`
+			Project      string `json:"project"`
+			Projectid    string `json:"projectid"`
+			Resourceid   string `json:"resourceid"`
+			Resourcetype string `json:"resourcetype"`
+			Value        string `json:"value"`
+		} `json:"tags"`
+	} `json:"ingressrule"`
+	Name      string `json:"name"`
+	Project   string `json:"project"`
+	Projectid string `json:"projectid"`
+	Tags      []struct {
+		Account      string `json:"account"`
+		Customer     string `json:"customer"`
+		Domain       string `json:"domain"`
+		Domainid     string `json:"domainid"`
+		Key          string `json:"key"`
+		Project      string `json:"project"`
+		Projectid    string `json:"projectid"`
+		Resourceid   string `json:"resourceid"`
+		Resourcetype string `json:"resourcetype"`
+		Value        string `json:"value"`
+	} `json:"tags"`
+	Virtualmachinecount int           `json:"virtualmachinecount"`
+	Virtualmachineids   []interface{} `json:"virtualmachineids"`
+}
@@ -0,0 +1,520 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstack
+
+type ListZonesParams struct {
+	p map[string]interface{}
+}
+
+// Clone returns a copy of the params, so the copy can be changed without changing these params.
+// Slice and map values are shared, so they should be replaced instead of modified in place.
+func (p *ListZonesParams) Clone() *ListZonesParams {
+	c := &ListZonesParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
+func (p *ListZonesParams) toURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["available"]; found {
+		vv := strconv.FormatBool(v.(bool))
+		u.Set("available", vv)
+	}
+	if v, found := p.p["domainid"]; found {
+		u.Set("domainid", v.(string))
+	}
+	if v, found := p.p["id"]; found {
+		u.Set("id", v.(string))
+	}
+	if v, found := p.p["keyword"]; found {
+		u.Set("keyword", v.(string))
+	}
+	if v, found := p.p["name"]; found {
+		u.Set("name", v.(string))
+	}
+	if v, found := p.p["networktype"]; found {
+		u.Set("networktype", v.(string))
+	}
+	if v, found := p.p["page"]; found {
+		vv := strconv.Itoa(v.(int))
+		u.Set("page", vv)
+	}
+	if v, found := p.p["pagesize"]; found {
+		vv := strconv.Itoa(v.(int))
+		u.Set("pagesize", vv)
+	}
+	if v, found := p.p["showcapacities"]; found {
+		vv := strconv.FormatBool(v.(bool))
+		u.Set("showcapacities", vv)
+	}
+	if v, found := p.p["tags"]; found {
+		m := v.(map[string]string)
+		for i, k := range getSortedKeysFromMap(m) {
+			u.Set(fmt.Sprintf("tags[%d].key", i), k)
+			u.Set(fmt.Sprintf("tags[%d].value", i), m[k])
+		}
+	}
+	return u
+}
+
+func (p *ListZonesParams) SetAvailable(v bool) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["available"] = v
+	return
+}
+
+// SetAvailableIfUnset sets available to v, unless it is already set
+func (p *ListZonesParams) SetAvailableIfUnset(v bool) {
+	if _, found := p.p["available"]; !found {
+		p.SetAvailable(v)
+	}
+}
+
+func (p *ListZonesParams) SetDomainid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["domainid"] = v
+	return
+}
+
+// SetDomainidIfUnset sets domainid to v, unless it is already set
+func (p *ListZonesParams) SetDomainidIfUnset(v string) {
+	if _, found := p.p["domainid"]; !found {
+		p.SetDomainid(v)
+	}
+}
+
+func (p *ListZonesParams) SetId(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["id"] = v
+	return
+}
+
+// SetIdIfUnset sets id to v, unless it is already set
+func (p *ListZonesParams) SetIdIfUnset(v string) {
+	if _, found := p.p["id"]; !found {
+		p.SetId(v)
+	}
+}
+
+func (p *ListZonesParams) SetKeyword(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["keyword"] = v
+	return
+}
+
+// SetKeywordIfUnset sets keyword to v, unless it is already set
+func (p *ListZonesParams) SetKeywordIfUnset(v string) {
+	if _, found := p.p["keyword"]; !found {
+		p.SetKeyword(v)
+	}
+}
+
+func (p *ListZonesParams) SetName(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["name"] = v
+	return
+}
+
+// SetNameIfUnset sets name to v, unless it is already set
+func (p *ListZonesParams) SetNameIfUnset(v string) {
+	if _, found := p.p["name"]; !found {
+		p.SetName(v)
+	}
+}
+
+func (p *ListZonesParams) SetNetworktype(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["networktype"] = v
+	return
+}
+
+// SetNetworktypeIfUnset sets networktype to v, unless it is already set
+func (p *ListZonesParams) SetNetworktypeIfUnset(v string) {
+	if _, found := p.p["networktype"]; !found {
+		p.SetNetworktype(v)
+	}
+}
+
+func (p *ListZonesParams) SetPage(v int) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["page"] = v
+	return
+}
+
+// SetPageIfUnset sets page to v, unless it is already set
+func (p *ListZonesParams) SetPageIfUnset(v int) {
+	if _, found := p.p["page"]; !found {
+		p.SetPage(v)
+	}
+}
+
+func (p *ListZonesParams) SetPagesize(v int) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["pagesize"] = v
+	return
+}
+
+// SetPagesizeIfUnset sets pagesize to v, unless it is already set
+func (p *ListZonesParams) SetPagesizeIfUnset(v int) {
+	if _, found := p.p["pagesize"]; !found {
+		p.SetPagesize(v)
+	}
+}
+
+func (p *ListZonesParams) SetShowcapacities(v bool) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["showcapacities"] = v
+	return
+}
+
+// SetShowcapacitiesIfUnset sets showcapacities to v, unless it is already set
+func (p *ListZonesParams) SetShowcapacitiesIfUnset(v bool) {
+	if _, found := p.p["showcapacities"]; !found {
+		p.SetShowcapacities(v)
+	}
+}
+
+func (p *ListZonesParams) SetTags(v map[string]string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["tags"] = v
+	return
+}
+
+// SetTagsIfUnset sets tags to v, unless it is already set
+func (p *ListZonesParams) SetTagsIfUnset(v map[string]string) {
+	if _, found := p.p["tags"]; !found {
+		p.SetTags(v)
+	}
+}
+
+// You should always use this function to get a new ListZonesParams instance,
+// as then you are sure you have configured all required params. Any
+// additional functions are called with the new instance to set optional params
+func (s *ZoneService) NewListZonesParams(opts ...func(*ListZonesParams)) *ListZonesParams {
+	p := &ListZonesParams{}
+	p.p = make(map[string]interface{})
+	for _, fn := range opts {
+		fn(p)
+	}
+	return p
+}
+
+func (p *ListZonesParams) setFirstMatch() {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p[firstMatchKey] = true
+}
+
+// This is a courtesy helper function, which in some cases may not work as expected!
+func (s *ZoneService) GetZoneID(name string, opts ...OptionFunc) (string, int, error) {
+	p := &ListZonesParams{}
+	p.p = make(map[string]interface{})
+
+	p.p["name"] = name
+
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return "", -1, err
+		}
+	}
+
+	l, err := s.ListZones(p)
+	if err != nil {
+		return "", -1, err
+	}
+
+	if l.Count == 0 {
+		return "", l.Count, fmt.Errorf("%w for %s: %+v", ErrNotFound, name, l)
+	}
+
+	if l.Count == 1 {
+		return l.Zones[0].Id, l.Count, nil
+	}
+
+	if l.Count > 1 {
+		for _, v := range l.Zones {
+			if v.Name == name {
+				return v.Id, l.Count, nil
+			}
+		}
+
+		if _, ok := p.p[firstMatchKey]; ok && len(l.Zones) > 0 {
+			return l.Zones[0].Id, l.Count, nil
+		}
+	}
+	return "", l.Count, fmt.Errorf("Could not find an exact match for %s: %+v", name, l)
+}
+
+// This is a courtesy helper function, which in some cases may not work as expected!
+func (s *ZoneService) GetZoneByName(name string, opts ...OptionFunc) (*Zone, int, error) {
+	id, count, err := s.GetZoneID(name, opts...)
+	if err != nil {
+		return nil, count, err
+	}
+
+	r, count, err := s.GetZoneByID(id, opts...)
+	if err != nil {
+		return nil, count, err
+	}
+	return r, count, nil
+}
+
+// This is a courtesy helper function, which in some cases may not work as expected!
+func (s *ZoneService) GetZoneByID(id string, opts ...OptionFunc) (*Zone, int, error) {
+	p := &ListZonesParams{}
+	p.p = make(map[string]interface{})
+
+	p.p["id"] = id
+
+	for _, fn := range s.cs.withDefaultOptions(opts) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, -1, err
+		}
+	}
+
+	l, err := s.ListZones(p)
+	if err != nil {
+		if strings.Contains(err.Error(), fmt.Sprintf(
+			"Invalid parameter id value=%s due to incorrect long value format, "+
+				"or entity does not exist", id)) {
+			return nil, 0, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
+		}
+		return nil, -1, err
+	}
+
+	if l.Count == 0 {
+		return nil, l.Count, fmt.Errorf("%w for %s: %+v", ErrNotFound, id, l)
+	}
+
+	if l.Count == 1 {
+		return l.Zones[0], l.Count, nil
+	}
+
+	if _, ok := p.p[firstMatchKey]; ok && len(l.Zones) > 0 {
+		return l.Zones[0], l.Count, nil
+	}
+	return nil, l.Count, fmt.Errorf("There is more then one result for Zone UUID: %s!", id)
+}
+
+// ZoneExists returns true if a Zone with the given ID exists. When no match is found
+// it returns false, while any other error is returned as is.
+func (s *ZoneService) ZoneExists(id string, opts ...OptionFunc) (bool, error) {
+	_, count, err := s.GetZoneByID(id, opts...)
+	if errors.Is(err, ErrNotFound) {
+		return false, nil
+	}
+	if count > 0 {
+		return true, nil
+	}
+	return false, err
+}
+
+// Lists zones
+func (s *ZoneService) ListZones(p *ListZonesParams) (*ListZonesResponse, error) {
+	return s.ListZonesWithContext(context.Background(), p)
+}
+
+// ListZonesWithContext is the same as ListZones, but the request is cancelled when the context is done
+func (s *ZoneService) ListZonesWithContext(ctx context.Context, p *ListZonesParams) (*ListZonesResponse, error) {
+	return s.ListZonesRawWithContext(ctx, p.toURLValues())
+}
+
+// ListZonesRaw is the same as ListZones, but takes the params as url.Values instead of typed params
+func (s *ZoneService) ListZonesRaw(v url.Values) (*ListZonesResponse, error) {
+	return s.ListZonesRawWithContext(context.Background(), v)
+}
+
+// ListZonesRawWithContext is the same as ListZonesRaw, but the request is cancelled when the context is done
+func (s *ZoneService) ListZonesRawWithContext(ctx context.Context, v url.Values) (*ListZonesResponse, error) {
+	resp, err := s.cs.newRequestWithContext(ctx, "listZones", "listzonesresponse", v)
+	if err != nil {
+		return nil, err
+	}
+
+	var r ListZonesResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+// StreamZones fetches the results of ListZones page by page in the background and sends them on the
+// returned channel. Both returned channels are closed when done. If fetching a page fails, or the context
+// is done before all results are sent, the error is sent on the error channel and fetching stops. A
+// request for a page in flight is cancelled when the context is done. The page and pagesize set in the
+// params are ignored.
+func (s *ZoneService) StreamZones(ctx context.Context, p *ListZonesParams) (<-chan *Zone, <-chan error) {
+	ch := make(chan *Zone)
+	errs := make(chan error, 1)
+
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesize(500)
+
+	go func() {
+		defer close(errs)
+		defer close(ch)
+
+		for page, count := 1, 0; ; page++ {
+			pp.SetPage(page)
+
+			l, err := s.ListZonesWithContext(ctx, pp)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			for _, v := range l.Zones {
+				select {
+				case ch <- v:
+				case <-ctx.Done():
+					errs <- ctx.Err()
+					return
+				}
+			}
+
+			count += len(l.Zones)
+			if len(l.Zones) < 500 || count >= l.Count {
+				return
+			}
+		}
+	}()
+
+	return ch, errs
+}
+
+// ListZonesWhere returns all results of ListZones for which pred returns true. Note that the results are
+// filtered client-side, so all results matching the params are fetched first.
+// All pages are fetched, so the page and pagesize set in the params are ignored.
+func (s *ZoneService) ListZonesWhere(p *ListZonesParams, pred func(*Zone) bool) ([]*Zone, error) {
+	var r []*Zone
+	ch, errs := s.StreamZones(context.Background(), p)
+	for v := range ch {
+		if pred(v) {
+			r = append(r, v)
+		}
+	}
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ListAllZones returns the results of ListZones across all pages, by calling ListZones page by page until all
+// results are fetched. The pagesize set in the params is used for every page (500 if not set), and the
+// page set in the params is ignored.
+func (s *ZoneService) ListAllZones(p *ListZonesParams) ([]*Zone, error) {
+	// Copy the params so the ones passed in are not modified
+	pp := p.Clone()
+	pp.SetPagesizeIfUnset(500)
+	pagesize := pp.p["pagesize"].(int)
+
+	var r []*Zone
+	for page := 1; ; page++ {
+		pp.SetPage(page)
+
+		l, err := s.ListZones(pp)
+		if err != nil {
+			return nil, err
+		}
+		r = append(r, l.Zones...)
+
+		if len(l.Zones) < pagesize || len(r) >= l.Count {
+			return r, nil
+		}
+	}
+}
+
+type ListZonesResponse struct {
+	Count int     `json:"count"`
+	Zones []*Zone `json:"zone"`
+}
+
+type Zone struct {
+	Allocationstate string `json:"allocationstate"`
+	Capacity        []struct {
+		Capacitytotal int64  `json:"capacitytotal"`
+		Capacityused  int64  `json:"capacityused"`
+		Clusterid     string `json:"clusterid"`
+		Clustername   string `json:"clustername"`
+		Percentused   string `json:"percentused"`
+		Podid         string `json:"podid"`
+		Podname       string `json:"podname"`
+		Type          int    `json:"type"`
+		Zoneid        string `json:"zoneid"`
+		Zonename      string `json:"zonename"`
+	} `json:"capacity"`
+	Description           string    `json:"description"`
+	Dhcpprovider          string    `json:"dhcpprovider"`
+	Displaytext           string    `json:"displaytext"`
+	Dns1                  string    `json:"dns1"`
+	Dns2                  string    `json:"dns2"`
+	Domain                string    `json:"domain"`
+	Domainid              string    `json:"domainid"`
+	Domainname            string    `json:"domainname"`
+	Guestcidraddress      string    `json:"guestcidraddress"`
+	Id                    string    `json:"id"`
+	Internaldns1          string    `json:"internaldns1"`
+	Internaldns2          string    `json:"internaldns2"`
+	Ip6dns1               string    `json:"ip6dns1"`
+	Ip6dns2               string    `json:"ip6dns2"`
+	Localstorageenabled   bool      `json:"localstorageenabled"`
+	Name                  string    `json:"name"`
+	Networktype           string    `json:"networktype"`
+	Resourcedetails       StringMap `json:"resourcedetails"`
+	Securitygroupsenabled bool      `json:"securitygroupsenabled"`
+	Tags                  []struct {
+		Account      string `json:"account"`
+		Customer     string `json:"customer"`
+		Domain       string `json:"domain"`
+		Domainid     string `json:"domainid"`
+		Key          string `json:"key"`
+		Project      string `json:"project"`
+		Projectid    string `json:"projectid"`
+		Resourceid   string `json:"resourceid"`
+		Resourcetype string `json:"resourcetype"`
+		Value        string `json:"value"`
+	} `json:"tags"`
+	Zonetoken string `json:"zonetoken"`
+}
@@ -0,0 +1,1810 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstack
+
+// UnlimitedResourceID is a special ID to define an unlimited resource
+const UnlimitedResourceID = "-1"
+
+var idRegex = regexp.MustCompile(`^([0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}|-1)$`)
+
+// IsID return true if the passed ID is either a UUID or a UnlimitedResourceID
+func IsID(id string) bool {
+	return idRegex.MatchString(id)
+}
+
+// OptionFunc can be passed to the courtesy helper functions and to all calls except the list calls, to set
+// additional parameters. The default options of the client are applied before the options passed in.
+type OptionFunc func(*CloudStackClient, interface{}) error
+
+// ClientOption can be passed to new client functions to set custom options
+type ClientOption func(*CloudStackClient)
+
+type CSError struct {
+	ErrorCode   int    `json:"errorcode"`
+	CSErrorCode int    `json:"cserrorcode"`
+	ErrorText   string `json:"errortext"`
+}
+
+func (e *CSError) Error() error {
+	return fmt.Errorf("CloudStack API error %d (CSExceptionErrorCode: %d): %s", e.ErrorCode, e.CSErrorCode, e.ErrorText)
+}
+
+// IsInsufficientCapacity returns true if the error means there was not enough capacity to execute the
+// command, which is reported using error code 533, both by the API and by failed async jobs
+func IsInsufficientCapacity(err error) bool {
+	var ae *APIError
+	if errors.As(err, &ae) {
+		return ae.ErrorCode == 533
+	}
+	var je *AsyncJobError
+	return errors.As(err, &je) && je.ErrorCode == 533
+}
+
+type CloudStackClient struct {
+	HTTPGETOnly bool // If `true` only use HTTP GET calls
+
+	client         *http.Client  // The http client for communicating
+	baseURL        string        // The base URL of the API
+	apiKey         string        // Api key
+	secret         string        // Secret key
+	sessionKey     string        // Session key of the session started using Login, used instead of signing
+	credMu         sync.RWMutex  // Protects the api and secret key, as these can be rotated, and the session key
+	async          bool          // Wait for async calls to finish
+	options        []OptionFunc  // A list of option functions to apply to all API calls
+	optMu          sync.RWMutex  // Protects the default options, as these can be changed while in use
+	timeout        int64         // Max waiting timeout in seconds for async jobs to finish; defaults to 300 seconds
+	maxPolls       int           // Max number of polls for async jobs to finish; defaults to no limit
+	pollInterval   time.Duration // Initial interval between polls for async jobs, added to the interval after every poll
+	pollBackoffMax time.Duration // Max interval between polls for async jobs
+
+	retryCodes map[int]bool // Error codes for which a failed command will be retried
+	retryAll   bool         // Also retry commands that are not idempotent
+
+	beforeRequest    func(string, url.Values) error              // Called with the params of every command before signing
+	jobEventHandler  func(JobLifecycleEvent)                     // Called when the state or progress of a polled async job changes
+	endpointResolver func(string, url.Values) string             // Returns the base URL to use for a command
+	paramTransform   func(string, url.Values) url.Values         // Returns the params to send for a command
+	requestLogger    func(*http.Request, *http.Response, []byte) // Called with the redacted request and the response of every command
+
+	strictResponse bool // Verify the response object belongs to the requested command
+
+	signatureVersion int           // The version of the signatures; only version 3 differs from the default
+	signatureExpiry  time.Duration // The time version 3 signatures are valid after signing
+	signatureHash    crypto.Hash   // The hash used to sign requests; 0 means SHA1
+
+	apiParamsMu sync.Mutex                 // Protects the cached params
+	apiParams   map[string]map[string]bool // The params declared by the server by command, cached by ValidateParams
+
+	Annotation    *AnnotationService
+	Asyncjob      *AsyncjobService
+	Custom        *CustomService
+	Firewall      *FirewallService
+	Network       *NetworkService
+	SSH           *SSHService
+	SecurityGroup *SecurityGroupService
+	Zone          *ZoneService
+}
+
+// Creates a new client for communicating with CloudStack
+func newClient(apiurl string, apikey string, secret string, async bool, verifyssl bool, options ...ClientOption) *CloudStackClient {
+	jar, _ := cookiejar.New(nil)
+	cs := &CloudStackClient{
+		client: &http.Client{
+			Jar: jar,
+			Transport: &http.Transport{
+				Proxy:           http.ProxyFromEnvironment,
+				TLSClientConfig: &tls.Config{InsecureSkipVerify: !verifyssl}, // If verifyssl is true, skipping the verify should be false and vice versa
+			},
+			Timeout: time.Duration(60 * time.Second),
+		},
+		baseURL:        apiurl,
+		apiKey:         apikey,
+		secret:         secret,
+		async:          async,
+		options:        []OptionFunc{},
+		timeout:        300,
+		pollInterval:   defaultAsyncPollInterval,
+		pollBackoffMax: defaultAsyncBackoffMax,
+	}
+	cs.Annotation = NewAnnotationService(cs)
+	cs.Asyncjob = NewAsyncjobService(cs)
+	cs.Custom = NewCustomService(cs)
+	cs.Firewall = NewFirewallService(cs)
+	cs.Network = NewNetworkService(cs)
+	cs.SSH = NewSSHService(cs)
+	cs.SecurityGroup = NewSecurityGroupService(cs)
+	cs.Zone = NewZoneService(cs)
+
+	for _, fn := range options {
+		fn(cs)
+	}
+
+	return cs
+}
+
+// Default non-async client. So for async calls you need to implement and check the async job result yourself. When using
+// HTTPS with a self-signed certificate to connect to your CloudStack API, you would probably want to set 'verifyssl' to
+// false so the call ignores the SSL errors/warnings.
+func NewClient(apiurl string, apikey string, secret string, verifyssl bool, options ...ClientOption) *CloudStackClient {
+	cs := newClient(apiurl, apikey, secret, false, verifyssl, options...)
+	return cs
+}
+
+// For sync API calls this client behaves exactly the same as a standard client call, but for async API calls
+// this client will wait until the async job is finished or until the configured AsyncTimeout is reached. When the async
+// job finishes successfully it will return actual object received from the API and nil, but when the timout is
+// reached it will return the initial object containing the async job ID for the running job and a warning.
+func NewAsyncClient(apiurl string, apikey string, secret string, verifyssl bool, options ...ClientOption) *CloudStackClient {
+	cs := newClient(apiurl, apikey, secret, true, verifyssl, options...)
+	return cs
+}
+
+// The environment variables read by NewClientFromEnv. When more than one variable is given for a
+// setting, the first one which is set takes precedence.
+var (
+	envAPIURL    = []string{"CLOUDSTACK_API_URL", "CLOUDSTACK_ENDPOINT"}
+	envAPIKey    = []string{"CLOUDSTACK_API_KEY", "CLOUDSTACK_KEY"}
+	envSecretKey = []string{"CLOUDSTACK_SECRET_KEY", "CLOUDSTACK_SECRET"}
+	envVerifySSL = []string{"CLOUDSTACK_VERIFY_SSL"}
+)
+
+// NewClientFromEnv returns a new (non-async) client configured using environment variables, as
+// used by cloudmonkey and other tools of the CloudStack ecosystem:
+//
+//	CLOUDSTACK_API_URL (or CLOUDSTACK_ENDPOINT)       the URL of the API, required
+//	CLOUDSTACK_API_KEY (or CLOUDSTACK_KEY)            the api key, required
+//	CLOUDSTACK_SECRET_KEY (or CLOUDSTACK_SECRET)      the secret key, required
+//	CLOUDSTACK_VERIFY_SSL                             whether to verify the SSL certificate, defaults to true
+//
+// An error is returned when a required variable is not set or a variable has an invalid value.
+func NewClientFromEnv(options ...ClientOption) (*CloudStackClient, error) {
+	var missing []string
+	lookup := func(names []string) string {
+		for _, name := range names {
+			if v := os.Getenv(name); v != "" {
+				return v
+			}
+		}
+		missing = append(missing, names[0])
+		return ""
+	}
+
+	apiurl, apikey, secret := lookup(envAPIURL), lookup(envAPIKey), lookup(envSecretKey)
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("Missing required environment variable(s): %s", strings.Join(missing, ", "))
+	}
+
+	verifyssl := true
+	if v := os.Getenv(envVerifySSL[0]); v != "" {
+		var err error
+		if verifyssl, err = strconv.ParseBool(v); err != nil {
+			return nil, fmt.Errorf("Invalid value %q for environment variable %s: %v", v, envVerifySSL[0], err)
+		}
+	}
+
+	return NewClient(apiurl, apikey, secret, verifyssl, options...), nil
+}
+
+// NewClientFromProfile returns a new (non-async) client configured using a profile of a cloudmonkey
+// config file. When path is empty the default config file of cloudmonkey (~/.cmk/config) is used and
+// when profile is empty the profile which is configured as the default profile in the file is used.
+// The url, apikey and secretkey of the profile are required. Whether to verify the SSL certificate is
+// read from verifycert (or verifysslcert as used by older versions of cloudmonkey), defaults to true.
+func NewClientFromProfile(path string, profile string, options ...ClientOption) (*CloudStackClient, error) {
+	if path == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return nil, err
+		}
+		path = filepath.Join(home, ".cmk", "config")
+	}
+
+	sections, err := parseINIFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if profile == "" {
+		// The default profile is set in the global part of the file
+		// or in the core section used by older versions of cloudmonkey
+		for _, section := range []string{"", "core"} {
+			if profile = sections[section]["profile"]; profile != "" {
+				break
+			}
+		}
+		if profile == "" {
+			return nil, fmt.Errorf("No profile given and no default profile configured in %s", path)
+		}
+	}
+
+	values, found := sections[profile]
+	if !found {
+		return nil, fmt.Errorf("Profile %s not found in %s", profile, path)
+	}
+
+	var missing []string
+	for _, key := range []string{"url", "apikey", "secretkey"} {
+		if values[key] == "" {
+			missing = append(missing, key)
+		}
+	}
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("Missing required setting(s) in profile %s of %s: %s", profile, path, strings.Join(missing, ", "))
+	}
+
+	verifyssl := true
+	for _, key := range []string{"verifycert", "verifysslcert"} {
+		if v := values[key]; v != "" {
+			if verifyssl, err = strconv.ParseBool(v); err != nil {
+				return nil, fmt.Errorf("Invalid value %q for %s in profile %s of %s: %v", v, key, profile, path, err)
+			}
+			break
+		}
+	}
+
+	return NewClient(values["url"], values["apikey"], values["secretkey"], verifyssl, options...), nil
+}
+
+// Parses an INI style file into the key/value pairs by section name. Key/value pairs before the
+// first section are returned for the section with an empty name. Keys are lowercased.
+func parseINIFile(path string) (map[string]map[string]string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	section := ""
+	sections := map[string]map[string]string{section: {}}
+
+	scanner := bufio.NewScanner(f)
+	for n := 1; scanner.Scan(); n++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";") {
+			continue
+		}
+
+		if strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]") {
+			section = strings.TrimSpace(line[1 : len(line)-1])
+			if _, found := sections[section]; !found {
+				sections[section] = make(map[string]string)
+			}
+			continue
+		}
+
+		kv := strings.SplitN(line, "=", 2)
+		if len(kv) != 2 {
+			return nil, fmt.Errorf("Invalid line %d in %s: %s", n, path, line)
+		}
+		sections[section][strings.ToLower(strings.TrimSpace(kv[0]))] = strings.TrimSpace(kv[1])
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return sections, nil
+}
+
+// When using the async client an api call will wait for the async call to finish before returning. The default is to poll for 300 seconds
+// seconds, to check if the async job is finished.
+func (cs *CloudStackClient) AsyncTimeout(timeoutInSeconds int64) {
+	cs.timeout = timeoutInSeconds
+}
+
+// WithRetryableErrorCodes makes the client retry commands that fail with one of the given error
+// codes, which can be either the HTTP error code or the CloudStack exception error code. Failed
+// commands are retried up to 3 times using an exponential backoff. By default only idempotent
+// commands (list, get and query commands) are retried, see WithRetryNonIdempotentCommands. When 429
+// is one of the codes, rate limited commands are always retried, waiting for the duration of the
+// Retry-After header (if any) instead of using the backoff, as these commands were not executed.
+func WithRetryableErrorCodes(codes ...int) ClientOption {
+	return func(cs *CloudStackClient) {
+		cs.retryCodes = make(map[int]bool, len(codes))
+		for _, code := range codes {
+			cs.retryCodes[code] = true
+		}
+	}
+}
+
+// WithRetryNonIdempotentCommands makes the client also retry commands that are not idempotent
+// when they fail with one of the codes configured using WithRetryableErrorCodes. Only use this
+// when you are sure the configured error codes mean the command did not change anything.
+func WithRetryNonIdempotentCommands() ClientOption {
+	return func(cs *CloudStackClient) {
+		cs.retryAll = true
+	}
+}
+
+// WithBeforeRequest sets a hook that is called for every command after all params are
+// assembled, but before the request is signed. The hook can modify the params as needed
+// and when it returns an error, the command is aborted and the error is returned.
+func WithBeforeRequest(fn func(command string, params url.Values) error) ClientOption {
+	return func(cs *CloudStackClient) {
+		cs.beforeRequest = fn
+	}
+}
+
+// WithStrictResponseMatching makes the client verify that the response object of every command
+// is the one of the requested command (e.g. deployvirtualmachineresponse for deployVirtualMachine)
+// before unwrapping it, and return an error otherwise. This catches responses mixed up by
+// misconfigured proxies or caches, which would otherwise silently decode into empty results.
+// The responses of commands unknown to this package (like custom commands) are not verified.
+func WithStrictResponseMatching() ClientOption {
+	return func(cs *CloudStackClient) {
+		cs.strictResponse = true
+	}
+}
+
+// WithJobEventHandler sets a handler that is called by GetAsyncJobResult (and so by every async command
+// of an async client) when it observes a change of the state or progress of the polled job. This makes
+// it possible to observe all async jobs of the client in one place. The handler is called synchronously
+// from the polling goroutine, so it should return quickly.
+func WithJobEventHandler(fn func(JobLifecycleEvent)) ClientOption {
+	return func(cs *CloudStackClient) {
+		cs.jobEventHandler = fn
+	}
+}
+
+// WithEndpointResolver sets a func that is called for every command with its signed params, and returns
+// the base URL to send the command to. This can be used to route commands to different (for example
+// regional) endpoints sharing the same keys, based on the command or its params. When the func returns
+// an empty string, the base URL of the client is used.
+func WithEndpointResolver(fn func(command string, params url.Values) string) ClientOption {
+	return func(cs *CloudStackClient) {
+		cs.endpointResolver = fn
+	}
+}
+
+// The time version 3 signatures are valid after signing, unless configured otherwise
+const defaultSignatureExpiry = 10 * time.Minute
+
+// WithSignatureVersion sets the version of the signatures of the requests. When set to 3, the signed
+// params include signatureversion=3 and an expires timestamp, after which CloudStack rejects the request
+// (see WithSignatureExpiry). Any other version uses the default signatures, which do not expire.
+func WithSignatureVersion(version int) ClientOption {
+	return func(cs *CloudStackClient) {
+		cs.signatureVersion = version
+	}
+}
+
+// WithSignatureExpiry makes the signatures of the requests expire after the given duration (10 minutes if
+// the duration is not positive), which is useful when signed requests are passed on to other systems (see
+// PrepareRequest). As only version 3 signatures can expire, this also sets the signature version to 3.
+func WithSignatureExpiry(d time.Duration) ClientOption {
+	return func(cs *CloudStackClient) {
+		cs.signatureVersion = 3
+		cs.signatureExpiry = d
+	}
+}
+
+// WithSignatureHash sets the hash used to compute the HMAC signatures of the requests, for deployments
+// that require SHA256 signatures. Only crypto.SHA1 (the default) and crypto.SHA256 are supported; any other
+// hash is a programming error, so it panics right away instead of failing every request later on.
+func WithSignatureHash(h crypto.Hash) ClientOption {
+	if _, err := signatureHashFunc(h); err != nil {
+		panic(err)
+	}
+	return func(cs *CloudStackClient) {
+		cs.signatureHash = h
+	}
+}
+
+// WithQueryParamTransform sets a func that is called with the params of every command before the common
+// params (like the api key) are added and the request is signed, and returns the params to send instead.
+// This can be used to adapt the params to CloudStack compatible APIs that renamed params or expect extra
+// params, without regenerating this package. The func is given a copy of the params, which it can modify
+// and return. Any before request hook is called after the transform.
+func WithQueryParamTransform(fn func(command string, in url.Values) url.Values) ClientOption {
+	return func(cs *CloudStackClient) {
+		cs.paramTransform = fn
+	}
+}
+
+// WithRequestLogger sets a func that is called for every request sent for a command, after the response
+// is read, which can be used to log the requests and responses. The request is a copy in which the
+// credentials (like the signature and the api key) are redacted, and the body is the raw JSON body of
+// the response, as the body of the response itself is already read. Failed requests that are retried
+// are passed to the func as well, but requests that fail without a response are not. The streamed
+// responses of Query are not passed to the func either.
+func WithRequestLogger(fn func(req *http.Request, resp *http.Response, body []byte)) ClientOption {
+	return func(cs *CloudStackClient) {
+		cs.requestLogger = fn
+	}
+}
+
+// WithMaxAsyncPolls limits the number of times the result of an async job is polled, in
+// addition to the configured timeout. When the limit is reached before the job is finished,
+// an AsyncMaxPollsErr is returned. A limit of 0 (the default) means no limit.
+func WithMaxAsyncPolls(n int) ClientOption {
+	return func(cs *CloudStackClient) {
+		cs.maxPolls = n
+	}
+}
+
+// The initial interval between polls for async jobs and the max interval, unless configured otherwise
+const (
+	defaultAsyncPollInterval = 1 * time.Second
+	defaultAsyncBackoffMax   = 15 * time.Second
+)
+
+// WithAsyncPollInterval sets the interval between the first polls for the result of an async job. After
+// every poll the interval is increased by this interval, up to the max set using WithAsyncBackoffMax.
+// Environments in which jobs finish fast can use a shorter interval, to return results sooner. The
+// default is 1 second, and a duration of 0 (or less) restores the default.
+func WithAsyncPollInterval(d time.Duration) ClientOption {
+	return func(cs *CloudStackClient) {
+		if d <= 0 {
+			d = defaultAsyncPollInterval
+		}
+		cs.pollInterval = d
+	}
+}
+
+// WithAsyncBackoffMax sets the max interval between polls for the result of an async job. The default
+// is 15 seconds, and a duration of 0 (or less) restores the default.
+func WithAsyncBackoffMax(d time.Duration) ClientOption {
+	return func(cs *CloudStackClient) {
+		if d <= 0 {
+			d = defaultAsyncBackoffMax
+		}
+		cs.pollBackoffMax = d
+	}
+}
+
+// WithTLSMinVersion sets the minimum TLS version (e.g. tls.VersionTLS12) used to connect to the API.
+// This only applies to the default HTTP transport of the client, so it does nothing when the client
+// uses a custom transport.
+func WithTLSMinVersion(version uint16) ClientOption {
+	return func(cs *CloudStackClient) {
+		if c := cs.tlsConfig(); c != nil {
+			c.MinVersion = version
+		}
+	}
+}
+
+// WithTLSCipherSuites sets the cipher suites (e.g. tls.TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256) used to
+// connect to the API, which only affects TLS 1.2 and earlier as the TLS 1.3 cipher suites are not
+// configurable. This only applies to the default HTTP transport of the client, so it does nothing
+// when the client uses a custom transport.
+func WithTLSCipherSuites(suites []uint16) ClientOption {
+	return func(cs *CloudStackClient) {
+		if c := cs.tlsConfig(); c != nil {
+			c.CipherSuites = append([]uint16(nil), suites...)
+		}
+	}
+}
+
+// Returns the TLS config of the default HTTP transport, or nil when the client uses a custom transport
+func (cs *CloudStackClient) tlsConfig() *tls.Config {
+	t, ok := cs.client.Transport.(*http.Transport)
+	if !ok {
+		return nil
+	}
+	if t.TLSClientConfig == nil {
+		t.TLSClientConfig = &tls.Config{}
+	}
+	return t.TLSClientConfig
+}
+
+// Set any default options that would be added to all API calls that support it. This is safe to
+// call while the client is in use by other goroutines, which keep using the options they started with.
+func (cs *CloudStackClient) DefaultOptions(options ...OptionFunc) {
+	cs.optMu.Lock()
+	defer cs.optMu.Unlock()
+
+	// Copy the options, so the caller cannot modify them in place while in use
+	cs.options = append([]OptionFunc{}, options...)
+}
+
+// Returns the default options followed by the given options. The returned slice is always a
+// new slice, so it never shares its backing array with the default options.
+func (cs *CloudStackClient) withDefaultOptions(opts []OptionFunc) []OptionFunc {
+	cs.optMu.RLock()
+	defer cs.optMu.RUnlock()
+
+	return append(cs.options[:len(cs.options):len(cs.options)], opts...)
+}
+
+// ClientConfig is a snapshot of the configuration of a client. It never contains the api or secret key.
+type ClientConfig struct {
+	BaseURL                    string        // The base URL of the API, with any user info redacted
+	HTTPGETOnly                bool          // Only use HTTP GET calls
+	Async                      bool          // Wait for async calls to finish
+	AsyncTimeout               time.Duration // Max time to wait for async jobs to finish
+	MaxAsyncPolls              int           // Max number of polls for async jobs to finish; 0 means no limit
+	AsyncPollInterval          time.Duration // Initial interval between polls for async jobs
+	AsyncBackoffMax            time.Duration // Max interval between polls for async jobs
+	DefaultOptions             int           // The number of default options applied to all API calls
+	RetryableErrorCodes        []int         // Error codes for which a failed command will be retried (sorted)
+	RetryNonIdempotentCommands bool          // Also retry commands that are not idempotent
+	StrictResponseMatching     bool          // Verify the response object belongs to the requested command
+	BeforeRequestHook          bool          // A before request hook is set
+	JobEventHandler            bool          // A job event handler is set
+	EndpointResolver           bool          // An endpoint resolver is set
+	QueryParamTransform        bool          // A query param transform is set
+	RequestLogger              bool          // A request logger is set
+	SignatureVersion           int           // The version of the signatures (1 or 3)
+	SignatureExpiry            time.Duration // The time version 3 signatures are valid after signing
+	SignatureHash              crypto.Hash   // The hash used to sign requests
+}
+
+// Config returns a snapshot of the current configuration of the client, which can be used to check how
+// the client is configured when debugging or wrapping it.
+func (cs *CloudStackClient) Config() ClientConfig {
+	baseURL := cs.baseURL
+	if u, err := url.Parse(cs.baseURL); err == nil {
+		baseURL = u.Redacted()
+	}
+
+	codes := make([]int, 0, len(cs.retryCodes))
+	for code := range cs.retryCodes {
+		codes = append(codes, code)
+	}
+	sort.Ints(codes)
+
+	cs.optMu.RLock()
+	options := len(cs.options)
+	cs.optMu.RUnlock()
+
+	sigVersion, sigExpiry := 1, time.Duration(0)
+	if cs.signatureVersion == 3 {
+		sigVersion, sigExpiry = 3, cs.signatureExpiry
+		if sigExpiry <= 0 {
+			sigExpiry = defaultSignatureExpiry
+		}
+	}
+
+	sigHash := cs.signatureHash
+	if sigHash == 0 {
+		sigHash = crypto.SHA1
+	}
+
+	return ClientConfig{
+		BaseURL:                    baseURL,
+		HTTPGETOnly:                cs.HTTPGETOnly,
+		Async:                      cs.async,
+		AsyncTimeout:               time.Duration(cs.timeout) * time.Second,
+		MaxAsyncPolls:              cs.maxPolls,
+		AsyncPollInterval:          cs.pollInterval,
+		AsyncBackoffMax:            cs.pollBackoffMax,
+		DefaultOptions:             options,
+		RetryableErrorCodes:        codes,
+		RetryNonIdempotentCommands: cs.retryAll,
+		StrictResponseMatching:     cs.strictResponse,
+		BeforeRequestHook:          cs.beforeRequest != nil,
+		JobEventHandler:            cs.jobEventHandler != nil,
+		EndpointResolver:           cs.endpointResolver != nil,
+		QueryParamTransform:        cs.paramTransform != nil,
+		RequestLogger:              cs.requestLogger != nil,
+		SignatureVersion:           sigVersion,
+		SignatureExpiry:            sigExpiry,
+		SignatureHash:              sigHash,
+	}
+}
+
+// ToURLValuer is implemented by the params types of all commands
+type ToURLValuer interface {
+	toURLValues() url.Values
+}
+
+// ValidateParams verifies that the server declares all params set in p for the command, using the
+// listApis command of the server. This catches params which are not supported by the version of the
+// server (which may differ from the version this package is generated for) before sending the command.
+// The declared params are cached, so the server is only queried once for every command.
+func (cs *CloudStackClient) ValidateParams(command string, p ToURLValuer) error {
+	declared, err := cs.declaredParams(command)
+	if err != nil {
+		return err
+	}
+
+	var unknown []string
+	seen := make(map[string]bool)
+	for k := range p.toURLValues() {
+		// Map params are sent as name[i].key, so only check (and report) the name once
+		if i := strings.Index(k, "["); i >= 0 {
+			k = k[:i]
+		}
+		if !declared[strings.ToLower(k)] && !seen[k] {
+			unknown = append(unknown, k)
+		}
+		seen[k] = true
+	}
+	if len(unknown) > 0 {
+		sort.Strings(unknown)
+		return fmt.Errorf("The server does not support these params of %s: %s", command, strings.Join(unknown, ", "))
+	}
+	return nil
+}
+
+// Returns the (lower cased) names of the params the server declares for the command
+func (cs *CloudStackClient) declaredParams(command string) (map[string]bool, error) {
+	cs.apiParamsMu.Lock()
+	defer cs.apiParamsMu.Unlock()
+
+	if declared, ok := cs.apiParams[command]; ok {
+		return declared, nil
+	}
+
+	p := cs.APIDiscovery.NewListApisParams()
+	p.SetName(command)
+
+	l, err := cs.APIDiscovery.ListApis(p)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, a := range l.Apis {
+		if !strings.EqualFold(a.Name, command) {
+			continue
+		}
+
+		declared := make(map[string]bool, len(a.Params))
+		for _, ap := range a.Params {
+			declared[strings.ToLower(ap.Name)] = true
+		}
+		if cs.apiParams == nil {
+			cs.apiParams = make(map[string]map[string]bool)
+		}
+		cs.apiParams[command] = declared
+
+		return declared, nil
+	}
+	return nil, fmt.Errorf("The server does not support the command %s", command)
+}
+
+// PrepareRequest builds and signs the request for the command with the given params, exactly as the
+// client would send it, but without sending it. This can be used to send the request later or from
+// elsewhere, for example after it has been approved. It returns the HTTP method and the URL to use,
+// and for POST requests the body to send form encoded (the signed params are part of the URL of GET
+// requests, so their body is nil).
+func (cs *CloudStackClient) PrepareRequest(command string, p ToURLValuer) (string, string, url.Values, error) {
+	params := cs.commandParams(command, p.toURLValues())
+	s, signature, err := cs.signParams(command, params)
+	if err != nil {
+		return "", "", nil, err
+	}
+	baseURL := cs.endpoint(command, params)
+
+	if !cs.usePOST(command) {
+		return "GET", baseURL + "?" + signedQuery(s, signature), nil, nil
+	}
+	if signature != "" {
+		params.Set("signature", signature)
+	}
+	return "POST", baseURL, params, nil
+}
+
+// Login starts a session for the user with the given username and password, using the login API, after
+// which all requests are authenticated using the session key and cookie of the session instead of being
+// signed using the api and secret key. This allows using the client for users without api keys. The
+// domain is the path of the domain of the user (like "ROOT/customers"), or an empty string for users of
+// the ROOT domain.
+func (cs *CloudStackClient) Login(username string, password string, domain string) (*LoginResponse, error) {
+	p := cs.Authentication.NewLoginParams(password, username)
+	if domain != "" {
+		p.SetDomain(domain)
+	}
+	r, err := cs.Authentication.Login(p)
+	if err != nil {
+		return nil, err
+	}
+	cs.setSessionKey(r.Sessionkey)
+	return r, nil
+}
+
+// Logout ends the session started using Login, using the logout API, and clears the cookies of the
+// client. Following requests are signed using the api and secret key again. The session is ended even
+// if the logout API returns an error. Logout should not be called while other requests are in progress.
+func (cs *CloudStackClient) Logout() error {
+	_, err := cs.Authentication.Logout(cs.Authentication.NewLogoutParams())
+	cs.setSessionKey("")
+	if cs.client.Jar != nil {
+		jar, _ := cookiejar.New(nil)
+		cs.client.Jar = jar
+	}
+	return err
+}
+
+var AsyncTimeoutErr = errors.New("Timeout while waiting for async job to finish")
+
+// AsyncMaxPollsErr is returned when an async job is not finished within the number of polls
+// configured using WithMaxAsyncPolls
+var AsyncMaxPollsErr = errors.New("Max number of polls reached while waiting for async job to finish")
+
+// ErrNotFound is returned (wrapped) by the courtesy helper functions when no match is found
+var ErrNotFound = errors.New("No match found")
+
+// AsyncJobError is returned when an async job failed. It contains the error details of the failed job, so
+// callers can check the error code of the failure using errors.As.
+type AsyncJobError struct {
+	JobID       string
+	ErrorCode   int
+	CSErrorCode int
+	ErrorText   string
+	Result      json.RawMessage // The raw result of the failed job
+}
+
+func (e *AsyncJobError) Error() string {
+	if e.ErrorText == "" {
+		return fmt.Sprintf("Undefined error: %s", string(e.Result))
+	}
+	return e.ErrorText
+}
+
+// Returns the error of the failed job, with the error details decoded from the result of the job, which
+// is either the error text or an object containing the error code and text
+func newAsyncJobError(jobid string, r *QueryAsyncJobResultResponse) *AsyncJobError {
+	e := &AsyncJobError{JobID: jobid, Result: r.Jobresult}
+	if text, ok := r.ResultText(); ok {
+		e.ErrorText = text
+	} else if cse, err := decodeCSError(r.Jobresult); err == nil {
+		e.ErrorCode, e.CSErrorCode, e.ErrorText = cse.ErrorCode, cse.CSErrorCode, cse.ErrorText
+	}
+	return e
+}
+
+// JobState is the state of an async job. CloudStack does not distinguish between queued and running
+// jobs, both are pending.
+type JobState string
+
+const (
+	JobPending   JobState = "pending"
+	JobSucceeded JobState = "succeeded"
+	JobFailed    JobState = "failed"
+)
+
+// JobLifecycleEvent describes a change of the state or progress of an async job, as observed while polling it
+type JobLifecycleEvent struct {
+	JobID    string
+	Command  string // The command class of the job, e.g. org.apache.cloudstack.api.command.user.vm.DeployVMCmd
+	State    JobState
+	Progress int   // The processing status (jobprocstatus) of the job
+	Err      error // The error of a failed job
+}
+
+// Calls the job event handler (if any) when the state or progress of the job changed since the last event
+func (cs *CloudStackClient) emitJobEvent(last *JobLifecycleEvent, jobid string, r *QueryAsyncJobResultResponse, err error) {
+	if cs.jobEventHandler == nil {
+		return
+	}
+
+	state := JobPending
+	switch r.Jobstatus {
+	case 1:
+		state = JobSucceeded
+	case 2:
+		state = JobFailed
+	}
+	if last.State == state && last.Progress == r.Jobprocstatus {
+		return
+	}
+
+	*last = JobLifecycleEvent{JobID: jobid, Command: r.Cmd, State: state, Progress: r.Jobprocstatus, Err: err}
+	cs.jobEventHandler(*last)
+}
+
+// A helper function that you can use to get the result of a running async job. If the job is not finished within the configured
+// timeout, the async job returns a AsyncTimeoutErr. If the job is not finished within the configured max number of polls,
+// the async job returns a AsyncMaxPollsErr.
+func (cs *CloudStackClient) GetAsyncJobResult(jobid string, timeout int64) (json.RawMessage, error) {
+	return cs.GetAsyncJobResultWithContext(context.Background(), jobid, timeout)
+}
+
+// GetAsyncJobResultWithContext is the same as GetAsyncJobResult, but stops polling and returns the error of
+// the context as soon as the context is done
+func (cs *CloudStackClient) GetAsyncJobResultWithContext(ctx context.Context, jobid string, timeout int64) (json.RawMessage, error) {
+	var interval time.Duration
+	var last JobLifecycleEvent
+	currentTime := time.Now().Unix()
+
+	for polls := 1; ; polls++ {
+		p := cs.Asyncjob.NewQueryAsyncJobResultParams(jobid)
+		r, err := cs.Asyncjob.QueryAsyncJobResultWithContext(ctx, p)
+		if err != nil {
+			return nil, err
+		}
+
+		// Status 1 means the job is finished successfully
+		if r.Jobstatus == 1 {
+			cs.emitJobEvent(&last, jobid, r, nil)
+			return r.Jobresult, nil
+		}
+
+		// When the status is 2, the job has failed
+		if r.Jobstatus == 2 {
+			err := newAsyncJobError(jobid, r)
+			cs.emitJobEvent(&last, jobid, r, err)
+			return nil, err
+		}
+
+		cs.emitJobEvent(&last, jobid, r, nil)
+
+		if time.Now().Unix()-currentTime > timeout {
+			return nil, AsyncTimeoutErr
+		}
+
+		if cs.maxPolls > 0 && polls >= cs.maxPolls {
+			return nil, AsyncMaxPollsErr
+		}
+
+		// Add an (extremely simple) exponential backoff like feature to prevent
+		// flooding the CloudStack API
+		if interval < cs.pollBackoffMax {
+			interval += cs.pollInterval
+			if interval > cs.pollBackoffMax {
+				interval = cs.pollBackoffMax
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(interval):
+		}
+	}
+}
+
+// Execute the request against a CS API. Will return the raw JSON data returned by the API and nil if
+// no error occured. If the API returns an error the result will be nil and the HTTP error code and CS
+// error details. If a processing (code) error occurs the result will be nil and the generated error
+func (cs *CloudStackClient) newRequest(api string, params url.Values) (json.RawMessage, error) {
+	return cs.newRequestWithContext(context.Background(), api, responseKeys[api], params)
+}
+
+// Same as newRequest, but the request (including any retries) is cancelled when the context is done.
+// The key is the key of the object the response is wrapped in, or an empty string if it is unknown.
+func (cs *CloudStackClient) newRequestWithContext(ctx context.Context, api string, key string, params url.Values) (json.RawMessage, error) {
+	params = cs.commandParams(api, params)
+
+	s, signature, err := cs.signParams(api, params)
+	if err != nil {
+		return nil, err
+	}
+	baseURL := cs.endpoint(api, params)
+
+	for retry := 0; ; retry++ {
+		// Backoff exponentially, starting with half a second
+		wait := (1 << uint(retry)) * 500 * time.Millisecond
+
+		b, e, err := cs.doRequest(ctx, baseURL, api, key, s, signature)
+		if err != nil {
+			// A rate limited command was not executed, so it can always be retried
+			rl, ok := err.(*RateLimitError)
+			if !ok || retry == 3 || !cs.retryCodes[http.StatusTooManyRequests] {
+				return nil, err
+			}
+			if rl.RetryAfter > 0 {
+				wait = rl.RetryAfter
+			}
+		} else {
+			if e == nil {
+				return b, nil
+			}
+			if retry == 3 || !cs.isRetryable(api, e) {
+				return nil, e
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(wait):
+		}
+	}
+}
+
+// Returns a copy of the params of the command, so the ones passed in are not modified, transformed by the
+// query param transform (if any)
+func (cs *CloudStackClient) commandParams(api string, params url.Values) url.Values {
+	ps := url.Values{}
+	for k, v := range params {
+		ps[k] = append([]string(nil), v...)
+	}
+	if cs.paramTransform != nil {
+		if ps = cs.paramTransform(api, ps); ps == nil {
+			ps = url.Values{}
+		}
+	}
+	return ps
+}
+
+// Adds the common params to the params of the command and signs them. Will return the encoded
+// params and the signature, or an error if the before request hook returned an error. While logged
+// in using Login, the session key is added instead and the signature is empty. The login command
+// itself is never signed, as it authenticates using the username and password.
+func (cs *CloudStackClient) signParams(api string, params url.Values) (string, string, error) {
+	cs.credMu.RLock()
+	apiKey, secret, sessionKey := cs.apiKey, cs.secret, cs.sessionKey
+	cs.credMu.RUnlock()
+
+	sign := api != "login" && sessionKey == ""
+	switch {
+	case sign:
+		params.Set("apiKey", apiKey)
+	case api != "login":
+		params.Set("sessionkey", sessionKey)
+	}
+	params.Set("command", api)
+	params.Set("response", "json")
+
+	// Version 3 signatures expire, so the signed request cannot be (re)used after the expiry
+	if sign && cs.signatureVersion == 3 {
+		expiry := cs.signatureExpiry
+		if expiry <= 0 {
+			expiry = defaultSignatureExpiry
+		}
+		params.Set("signatureversion", "3")
+		params.Set("expires", time.Now().Add(expiry).UTC().Format("2006-01-02T15:04:05-0700"))
+	}
+
+	if cs.beforeRequest != nil {
+		if err := cs.beforeRequest(api, params); err != nil {
+			return "", "", err
+		}
+	}
+
+	if !sign {
+		return encodeValues(params), "", nil
+	}
+
+	// Generate signature for API call
+	// * Serialize parameters, URL encoding only values and sort them by key, done by encodeValues
+	// * Convert the entire argument string to lowercase
+	// * Replace all instances of '+' to '%20'
+	// * Calculate HMAC SHA1 (or the configured hash) of argument string with CloudStack secret
+	// * URL encode the string and convert to base64
+	newHash, err := signatureHashFunc(cs.signatureHash)
+	if err != nil {
+		return "", "", err
+	}
+	s := encodeValues(params)
+	s2 := strings.ToLower(s)
+	s3 := strings.Replace(s2, "+", "%20", -1)
+	mac := hmac.New(newHash, []byte(secret))
+	mac.Write([]byte(s3))
+	signature := base64.StdEncoding.EncodeToString(mac.Sum(nil))
+
+	return s, signature, nil
+}
+
+// Returns the constructor of the given signature hash, where 0 means the default SHA1 hash
+func signatureHashFunc(h crypto.Hash) (func() hash.Hash, error) {
+	switch h {
+	case 0, crypto.SHA1:
+		return sha1.New, nil
+	case crypto.SHA256:
+		return sha256.New, nil
+	}
+	return nil, fmt.Errorf("Unsupported signature hash: %v (only SHA1 and SHA256 are supported)", h)
+}
+
+// Returns the base URL to use for the command, which is the configured base URL unless an endpoint
+// resolver is set that returns another one
+func (cs *CloudStackClient) endpoint(api string, params url.Values) string {
+	if cs.endpointResolver != nil {
+		if u := cs.endpointResolver(api, params); u != "" {
+			return u
+		}
+	}
+	return cs.baseURL
+}
+
+// Replaces the api and secret key used to sign all following requests
+func (cs *CloudStackClient) setCredentials(apiKey string, secret string) {
+	cs.credMu.Lock()
+	defer cs.credMu.Unlock()
+	cs.apiKey, cs.secret = apiKey, secret
+}
+
+// Sets the session key used to authenticate all following requests instead of signing them, where an
+// empty key ends the session
+func (cs *CloudStackClient) setSessionKey(key string) {
+	cs.credMu.Lock()
+	defer cs.credMu.Unlock()
+	cs.sessionKey = key
+}
+
+// Returns the encoded params with the signature appended, unless the params were not signed
+func signedQuery(s string, signature string) string {
+	if signature == "" {
+		return s
+	}
+	return s + "&signature=" + url.QueryEscape(signature)
+}
+
+// Creates the HTTP request to the base URL for the signed params of the command
+func (cs *CloudStackClient) buildRequest(ctx context.Context, baseURL string, api string, s string, signature string) (*http.Request, error) {
+	if cs.usePOST(api) {
+		// Build the body from the signed string, so the params are sent encoded exactly
+		// as they were signed, instead of re-encoding them (with different escaping)
+		body := signedQuery(s, signature)
+
+		// Create a POST request
+		req, err := http.NewRequestWithContext(ctx, "POST", baseURL, strings.NewReader(body))
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		return req, nil
+	}
+
+	// Create the final URL before we issue the request
+	url := baseURL + "?" + signedQuery(s, signature)
+
+	// Create a GET request
+	return http.NewRequestWithContext(ctx, "GET", url, nil)
+}
+
+// The params that are redacted in the requests passed to the request logger
+var redactedParams = []string{"apiKey", "password", "sessionkey", "signature"}
+
+// Returns a copy of the request for the signed params, with the values of the params that contain
+// credentials redacted. The params are part of the URL of GET requests and of the body of POST requests.
+func redactRequest(req *http.Request, s string, signature string) *http.Request {
+	r := req.Clone(req.Context())
+
+	params, err := url.ParseQuery(signedQuery(s, signature))
+	if err != nil {
+		params = url.Values{}
+	}
+	for _, k := range redactedParams {
+		if params.Get(k) != "" {
+			params.Set(k, "xxxxx")
+		}
+	}
+	encoded := params.Encode()
+
+	if r.Method != "POST" {
+		r.URL.RawQuery = encoded
+		return r
+	}
+	r.Body = ioutil.NopCloser(strings.NewReader(encoded))
+	r.ContentLength = int64(len(encoded))
+	r.GetBody = func() (io.ReadCloser, error) {
+		return ioutil.NopCloser(strings.NewReader(encoded)), nil
+	}
+	return r
+}
+
+// Returns true if the command should be sent using a POST request. The deployVirtualMachine API
+// should be called using a POST call so we don't have to worry about the userdata size.
+func (cs *CloudStackClient) usePOST(api string) bool {
+	return !cs.HTTPGETOnly && (api == "deployVirtualMachine" || api == "login" || api == "updateVirtualMachine")
+}
+
+// Issue a single signed request. Will return the raw JSON data returned by the API if no error occured,
+// or the CS error details if the API returned an error.
+func (cs *CloudStackClient) doRequest(ctx context.Context, baseURL string, api string, key string, s string, signature string) (json.RawMessage, *APIError, error) {
+	req, err := cs.buildRequest(ctx, baseURL, api, s, signature)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resp, err := cs.client.Do(req)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer resp.Body.Close()
+
+	b, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if cs.requestLogger != nil {
+		cs.requestLogger(redactRequest(req, s, signature), resp, b)
+	}
+
+	if resp.StatusCode == http.StatusTooManyRequests {
+		return nil, nil, newRateLimitError(resp, b)
+	}
+
+	if resp.StatusCode != 200 {
+		return nil, newAPIError(resp.StatusCode, b), nil
+	}
+
+	if cs.strictResponse && key != "" {
+		if err := checkResponseKey(api, key, b); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	// Need to get the raw value to make the result play nice, which is the value of the response
+	// object with the key of the command (when known), even if the response contains other keys
+	b, err = getRawValueByKey(b, key)
+	if err != nil {
+		return nil, nil, err
+	}
+	return b, nil, nil
+}
+
+// RateLimitError is returned when the server (or a gateway in front of it) rate limited the command,
+// which means the command was not executed. RetryAfter is the duration the server asked to wait before
+// sending another command, or 0 if it did not say. Rate limited commands are retried (after waiting this
+// duration) when 429 is one of the error codes configured using WithRetryableErrorCodes.
+type RateLimitError struct {
+	RetryAfter time.Duration
+	ErrorText  string
+}
+
+func (e *RateLimitError) Error() string {
+	msg := "Rate limited by the server"
+	if e.RetryAfter > 0 {
+		msg += fmt.Sprintf(", retry after %s", e.RetryAfter)
+	}
+	if e.ErrorText != "" {
+		msg += ": " + e.ErrorText
+	}
+	return msg
+}
+
+// Creates the rate limit error for a 429 response with the given body, which may contain the CS error
+// details when the rate limit was applied by CloudStack itself
+func newRateLimitError(resp *http.Response, b []byte) *RateLimitError {
+	e := &RateLimitError{RetryAfter: parseRetryAfter(resp.Header.Get("Retry-After"), time.Now())}
+	if raw, err := getRawValue(b); err == nil {
+		if cse, err := decodeCSError(raw); err == nil {
+			e.ErrorText = cse.ErrorText
+		}
+	}
+	return e
+}
+
+// Parses the value of a Retry-After header, which is either a number of seconds or an HTTP date.
+// Returns 0 if the value is empty, invalid or already in the past.
+func parseRetryAfter(v string, now time.Time) time.Duration {
+	v = strings.TrimSpace(v)
+	if v == "" {
+		return 0
+	}
+	if secs, err := strconv.Atoi(v); err == nil {
+		if secs < 0 {
+			return 0
+		}
+		return time.Duration(secs) * time.Second
+	}
+	t, err := http.ParseTime(v)
+	if err != nil || !t.After(now) {
+		return 0
+	}
+	return t.Sub(now)
+}
+
+// Verifies the raw response is wrapped in the response object with the given key, to detect
+// responses for other commands served by misconfigured proxies or caches
+func checkResponseKey(api string, key string, b json.RawMessage) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+	if _, ok := m[key]; ok {
+		return nil
+	}
+
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return fmt.Errorf("Expected the response of %s to contain %s, but got: %s", api, key, strings.Join(keys, ", "))
+}
+
+// Decodes the CS error details from the raw value of an error response
+func decodeCSError(b json.RawMessage) (*CSError, error) {
+	var e CSError
+	if err := json.Unmarshal(b, &e); err != nil {
+		return nil, err
+	}
+	return &e, nil
+}
+
+// APIError is returned when the API responds with an error. Next to the details of the CloudStack error
+// it contains the HTTP status code and the raw response body, so callers can use errors.As to inspect
+// the error, for example to handle specific error codes.
+type APIError struct {
+	HTTPStatusCode int
+	ErrorCode      int
+	CSErrorCode    int
+	ErrorText      string
+	Raw            json.RawMessage
+}
+
+func (e *APIError) Error() string {
+	if e.ErrorCode == 0 && e.CSErrorCode == 0 {
+		return fmt.Sprintf("CloudStack API error (HTTP status %d): %s", e.HTTPStatusCode, e.ErrorText)
+	}
+	return fmt.Sprintf("CloudStack API error %d (CSExceptionErrorCode: %d): %s", e.ErrorCode, e.CSErrorCode, e.ErrorText)
+}
+
+// Creates the API error for an error response with the given HTTP status code and body. When the body
+// does not contain the CloudStack error details (for example the HTML error page of a proxy), the error
+// text describes why the body could not be decoded instead.
+func newAPIError(status int, body []byte) *APIError {
+	e := &APIError{HTTPStatusCode: status, Raw: body}
+
+	b, err := getRawValue(body)
+	if err != nil {
+		e.ErrorText = fmt.Sprintf("Unable to decode the error response: %v", err)
+		return e
+	}
+	cse, err := decodeCSError(b)
+	if err != nil {
+		e.ErrorText = fmt.Sprintf("Unable to decode the error response: %v", err)
+		return e
+	}
+	e.ErrorCode, e.CSErrorCode, e.ErrorText = cse.ErrorCode, cse.CSErrorCode, cse.ErrorText
+	return e
+}
+
+// Returns true if the command should be retried after failing with the given error
+func (cs *CloudStackClient) isRetryable(api string, e *APIError) bool {
+	if !cs.retryCodes[e.ErrorCode] && !cs.retryCodes[e.CSErrorCode] {
+		return false
+	}
+	return cs.retryAll || strings.HasPrefix(api, "list") || strings.HasPrefix(api, "get") || strings.HasPrefix(api, "query")
+}
+
+// Query issues a signed request for any command and returns a decoder positioned at the value of the
+// response object, so (large) responses can be decoded without buffering them in memory first. This
+// can be used for commands that are not (yet) supported by this package. The returned close function
+// must be called when done decoding, to close the response body.
+func (cs *CloudStackClient) Query(ctx context.Context, command string, params url.Values) (*json.Decoder, func() error, error) {
+	ps := cs.commandParams(command, params)
+
+	s, signature, err := cs.signParams(command, ps)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := cs.buildRequest(ctx, cs.endpoint(command, ps), command, s, signature)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resp, err := cs.client.Do(req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if resp.StatusCode != 200 {
+		defer resp.Body.Close()
+
+		b, err := ioutil.ReadAll(resp.Body)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if resp.StatusCode == http.StatusTooManyRequests {
+			return nil, nil, newRateLimitError(resp, b)
+		}
+
+		return nil, nil, newAPIError(resp.StatusCode, b)
+	}
+
+	// Skip the opening brace and the key of the response object
+	// to position the decoder at the value of the response object
+	dec := json.NewDecoder(resp.Body)
+	if t, err := dec.Token(); err != nil || t != json.Delim('{') {
+		resp.Body.Close()
+		return nil, nil, fmt.Errorf("Unable to extract the raw value from the response of %s", command)
+	}
+	t, err := dec.Token()
+	if err != nil || t == json.Delim('}') {
+		resp.Body.Close()
+		return nil, nil, fmt.Errorf("Unable to extract the raw value from the response of %s", command)
+	}
+	// Skip the values of any other keys, to position the decoder at the value of the response object
+	if key, ok := responseKeys[command]; ok {
+		for t != key {
+			var skip json.RawMessage
+			if err := dec.Decode(&skip); err != nil {
+				resp.Body.Close()
+				return nil, nil, err
+			}
+			if t, err = dec.Token(); err != nil || t == json.Delim('}') {
+				resp.Body.Close()
+				return nil, nil, fmt.Errorf("Expected the response of %s to contain %s", command, key)
+			}
+		}
+	}
+
+	return dec, resp.Body.Close, nil
+}
+
+// Custom version of net/url Encode that only URL escapes values
+// Unmodified portions here remain under BSD license of The Go Authors: https://go.googlesource.com/go/+/master/LICENSE
+func encodeValues(v url.Values) string {
+	if v == nil {
+		return ""
+	}
+	var buf bytes.Buffer
+	keys := make([]string, 0, len(v))
+	for k := range v {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		vs := v[k]
+		prefix := k + "="
+		for _, v := range vs {
+			if buf.Len() > 0 {
+				buf.WriteByte('&')
+			}
+			buf.WriteString(prefix)
+			buf.WriteString(url.QueryEscape(v))
+		}
+	}
+	return buf.String()
+}
+
+// Returns the keys of the map in sorted order, so map params are always encoded the same way
+func getSortedKeysFromMap(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// Returns an error if more than one of the given mutually exclusive params is set
+func checkMutuallyExclusive(p map[string]interface{}, params ...string) error {
+	var set []string
+	for _, param := range params {
+		if _, found := p[param]; found {
+			set = append(set, param)
+		}
+	}
+	if len(set) > 1 {
+		return fmt.Errorf("Params %s are mutually exclusive, only one of them can be set", strings.Join(set, ", "))
+	}
+	return nil
+}
+
+// A simple cache with a TTL, used to memoize lookups of resources which rarely change. A TTL of
+// 0 means the cached values never expire.
+type lookupCache struct {
+	sync.Mutex
+	ttl     time.Duration
+	entries map[string]lookupCacheEntry
+}
+
+type lookupCacheEntry struct {
+	value   interface{}
+	expires time.Time
+}
+
+func newLookupCache(ttl time.Duration) *lookupCache {
+	return &lookupCache{ttl: ttl, entries: make(map[string]lookupCacheEntry)}
+}
+
+func (c *lookupCache) get(key string) (interface{}, bool) {
+	c.Lock()
+	defer c.Unlock()
+
+	e, found := c.entries[key]
+	if !found || (c.ttl > 0 && time.Now().After(e.expires)) {
+		return nil, false
+	}
+	return e.value, true
+}
+
+func (c *lookupCache) set(key string, v interface{}) {
+	c.Lock()
+	defer c.Unlock()
+
+	c.entries[key] = lookupCacheEntry{value: v, expires: time.Now().Add(c.ttl)}
+}
+
+func (c *lookupCache) setTTL(ttl time.Duration) {
+	c.Lock()
+	defer c.Unlock()
+
+	c.ttl = ttl
+}
+
+func (c *lookupCache) invalidate() {
+	c.Lock()
+	defer c.Unlock()
+
+	c.entries = make(map[string]lookupCacheEntry)
+}
+
+// Splits a comma separated string into its trimmed, non-empty values
+func splitCSV(s string) []string {
+	var vs []string
+	for _, v := range strings.Split(s, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			vs = append(vs, v)
+		}
+	}
+	return vs
+}
+
+// Generic function to get the raw value wrapped in a response as json.RawMessage. If the response
+// is not wrapped in an object with a single key, the response itself is returned as is.
+func getRawValue(b json.RawMessage) (json.RawMessage, error) {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return nil, err
+	}
+	if len(m) > 1 {
+		return b, nil
+	}
+	for _, v := range m {
+		// A wrapped value is always an object, so anything else means it is not wrapped
+		if v = bytes.TrimSpace(v); len(v) == 0 || v[0] != '{' {
+			return b, nil
+		}
+		return v, nil
+	}
+	return nil, fmt.Errorf("Unable to extract the raw value from:\n\n%s\n\n", string(b))
+}
+
+// Same as getRawValue, but extracts the value of the given key instead of the first value, so
+// responses which contain other keys next to the expected object are unwrapped deterministically.
+// If the response does not contain the key, this falls back to getRawValue.
+func getRawValueByKey(b json.RawMessage, key string) (json.RawMessage, error) {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return nil, err
+	}
+	if v, ok := m[key]; ok {
+		return v, nil
+	}
+	return getRawValue(b)
+}
+
+// Same as getRawValue, but returns the response as is when it contains any of the given fields, so
+// results with a single field are not mistaken for a wrapper when they are returned unwrapped.
+func getRawValueUnlessHas(b json.RawMessage, fields ...string) (json.RawMessage, error) {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return nil, err
+	}
+	for _, f := range fields {
+		if _, ok := m[f]; ok {
+			return b, nil
+		}
+	}
+	return getRawValue(b)
+}
+
+// KeyValuePair is a single key/value pair of a map param, used to set the pairs in a specific order
+type KeyValuePair struct {
+	Key   string
+	Value string
+}
+
+// StringMap is used for response fields of the map type. It decodes tolerantly, as CloudStack
+// does not always return these maps with string values: non-string values are converted to their
+// JSON text, and null or an empty string or list results in an empty map.
+type StringMap map[string]string
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (m *StringMap) UnmarshalJSON(b []byte) error {
+	b = bytes.TrimSpace(b)
+	if len(b) == 0 || b[0] != '{' {
+		var v interface{}
+		if err := json.Unmarshal(b, &v); err != nil {
+			return err
+		}
+		switch v := v.(type) {
+		case nil:
+			*m = nil
+			return nil
+		case string:
+			if v == "" {
+				*m = nil
+				return nil
+			}
+		case []interface{}:
+			if len(v) == 0 {
+				*m = nil
+				return nil
+			}
+		}
+		return fmt.Errorf("Unable to decode %s into a map", string(b))
+	}
+
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return err
+	}
+
+	r := make(StringMap, len(raw))
+	for k, v := range raw {
+		var s string
+		if err := json.Unmarshal(v, &s); err != nil {
+			// Not a string, so use the JSON text of the value
+			s = string(bytes.TrimSpace(v))
+		}
+		r[k] = s
+	}
+	*m = r
+	return nil
+}
+
+// BulkResult is the result of a helper that executes a command for multiple resources (or zones)
+// at once. Succeeded contains the results of all commands that succeeded and Failed contains the
+// error of every command that failed, keyed by the ID of the resource (or zone) it failed for.
+type BulkResult[T any] struct {
+	Succeeded []T
+	Failed    map[string]error
+}
+
+// Adds the error of a failed command for the given ID
+func (r *BulkResult[T]) fail(id string, err error) {
+	if r.Failed == nil {
+		r.Failed = make(map[string]error)
+	}
+	r.Failed[id] = err
+}
+
+// Err returns all failures joined into a single error (sorted by ID), or nil if nothing failed
+func (r *BulkResult[T]) Err() error {
+	ids := make([]string, 0, len(r.Failed))
+	for id := range r.Failed {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	errs := make([]error, 0, len(ids))
+	for _, id := range ids {
+		errs = append(errs, fmt.Errorf("%s: %w", id, r.Failed[id]))
+	}
+	return errors.Join(errs...)
+}
+
+// Downloads the given URL to destPath using the HTTP client of the client. The download is written
+// to a temporary file next to destPath first, which is only renamed to destPath once the download
+// is complete and verified, so destPath never contains a partial download.
+func (cs *CloudStackClient) downloadToFile(rawURL, destPath string) error {
+	resp, err := cs.client.Get(rawURL)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("Failed to download %s: %s", rawURL, resp.Status)
+	}
+
+	f, err := os.CreateTemp(filepath.Dir(destPath), filepath.Base(destPath)+".*.part")
+	if err != nil {
+		return err
+	}
+	// Does nothing when the download is complete, as the file is renamed by then
+	defer os.Remove(f.Name())
+
+	n, err := io.Copy(f, resp.Body)
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return fmt.Errorf("Failed to download %s: %w", rawURL, err)
+	}
+	if resp.ContentLength >= 0 && n != resp.ContentLength {
+		return fmt.Errorf("Failed to download %s: got %d bytes, expected %d", rawURL, n, resp.ContentLength)
+	}
+
+	return os.Rename(f.Name(), destPath)
+}
+
+// Calls fn for every index in [0, n) using at most the given number of concurrent goroutines
+func runConcurrently(n int, concurrency int, fn func(i int)) {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, concurrency)
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			fn(i)
+		}(i)
+	}
+	wg.Wait()
+}
+
+// ProjectIDSetter is an interface that every type that can set a project ID must implement
+type ProjectIDSetter interface {
+	SetProjectid(string)
+}
+
+// WithProject takes either a project name or ID and sets the `projectid` parameter
+func WithProject(project string) OptionFunc {
+	return func(cs *CloudStackClient, p interface{}) error {
+		ps, ok := p.(ProjectIDSetter)
+
+		if !ok || project == "" {
+			return nil
+		}
+
+		if !IsID(project) {
+			id, _, err := cs.Project.GetProjectID(project)
+			if err != nil {
+				return err
+			}
+			project = id
+		}
+
+		ps.SetProjectid(project)
+
+		return nil
+	}
+}
+
+// VPCIDSetter is an interface that every type that can set a vpc ID must implement
+type VPCIDSetter interface {
+	SetVpcid(string)
+}
+
+// WithVPCID takes a vpc ID and sets the `vpcid` parameter
+func WithVPCID(id string) OptionFunc {
+	return func(cs *CloudStackClient, p interface{}) error {
+		vs, ok := p.(VPCIDSetter)
+
+		if !ok || id == "" {
+			return nil
+		}
+
+		vs.SetVpcid(id)
+
+		return nil
+	}
+}
+
+// The key used to mark params for which the helpers should return the first match
+const firstMatchKey = "_firstmatch"
+
+// firstMatchSetter is implemented by all params used by the courtesy helper functions
+type firstMatchSetter interface {
+	setFirstMatch()
+}
+
+// WithFirstMatch makes the courtesy helper functions return the first result when they find
+// multiple results, instead of returning an error
+func WithFirstMatch() OptionFunc {
+	return func(cs *CloudStackClient, p interface{}) error {
+		if fs, ok := p.(firstMatchSetter); ok {
+			fs.setFirstMatch()
+		}
+
+		return nil
+	}
+}
+
+// ListAllSetter is an interface that every type that can set listall must implement
+type ListAllSetter interface {
+	SetListall(bool)
+}
+
+// WithListAll sets the `listall` parameter to true, so all resources the caller is authorized to
+// see are listed and not only the resources owned by the caller. This can for example be used with
+// the GetXxxID and GetXxxByID helpers to resolve resources across domains and accounts.
+func WithListAll() OptionFunc {
+	return func(cs *CloudStackClient, p interface{}) error {
+		if ls, ok := p.(ListAllSetter); ok {
+			ls.SetListall(true)
+		}
+
+		return nil
+	}
+}
+
+// ACLType is the type of the params which only take one of the values below
+type ACLType string
+
+const (
+	ACLTypeAccount ACLType = "Account"
+	ACLTypeDomain  ACLType = "Domain"
+)
+
+// Protocol is the type of the params which only take one of the values below
+type Protocol string
+
+const (
+	ProtocolTCP  Protocol = "tcp"
+	ProtocolUDP  Protocol = "udp"
+	ProtocolICMP Protocol = "icmp"
+	ProtocolAll  Protocol = "all"
+)
+
+// The key of the object CloudStack wraps the response of each command in
+var responseKeys = map[string]string{
+	"queryAsyncJobResult":           "queryasyncjobresultresponse",
+	"createFirewallRule":            "createfirewallruleresponse",
+	"listFirewallRules":             "listfirewallrulesresponse",
+	"deleteNetwork":                 "deletenetworkresponse",
+	"listNetworks":                  "listnetworksresponse",
+	"createSSHKeyPair":              "createsshkeypairresponse",
+	"listSSHKeyPairs":               "listsshkeypairsresponse",
+	"authorizeSecurityGroupIngress": "authorizesecuritygroupingressresponse",
+	"listSecurityGroups":            "listsecuritygroupsresponse",
+	"listZones":                     "listzonesresponse",
+}
+
+type AnnotationService struct {
+	cs *CloudStackClient
+}
+
+func NewAnnotationService(cs *CloudStackClient) *AnnotationService {
+	return &AnnotationService{cs: cs}
+}
+
+type AsyncjobService struct {
+	cs *CloudStackClient
+}
+
+func NewAsyncjobService(cs *CloudStackClient) *AsyncjobService {
+	return &AsyncjobService{cs: cs}
+}
+
+type CustomService struct {
+	cs *CloudStackClient
+}
+
+func NewCustomService(cs *CloudStackClient) *CustomService {
+	return &CustomService{cs: cs}
+}
+
+type FirewallService struct {
+	cs *CloudStackClient
+}
+
+func NewFirewallService(cs *CloudStackClient) *FirewallService {
+	return &FirewallService{cs: cs}
+}
+
+type NetworkService struct {
+	cs *CloudStackClient
+}
+
+func NewNetworkService(cs *CloudStackClient) *NetworkService {
+	return &NetworkService{cs: cs}
+}
+
+type SSHService struct {
+	cs *CloudStackClient
+}
+
+func NewSSHService(cs *CloudStackClient) *SSHService {
+	return &SSHService{cs: cs}
+}
+
+type SecurityGroupService struct {
+	cs *CloudStackClient
+}
+
+func NewSecurityGroupService(cs *CloudStackClient) *SecurityGroupService {
+	return &SecurityGroupService{cs: cs}
+}
+
+type ZoneService struct {
+	cs *CloudStackClient
+}
+
+func NewZoneService(cs *CloudStackClient) *ZoneService {
+	return &ZoneService{cs: cs}
+}